@@ -91,49 +91,165 @@ func (m *Alert) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Alert proto.InternalMessageInfo
 
+// AlertHistoryRequest specifies which alerts to summarize the history of
+type AlertHistoryRequest struct {
+	// Only include alerts for this organization's Apps, Clusters, or Cloudlets
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	// Start of the time range, defaults to 48 hours before the end time
+	StartTime distributed_match_engine.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time"`
+	// End of the time range, defaults to now
+	EndTime distributed_match_engine.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time"`
+	// Only include alerts with all of these labels
+	Labels map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *AlertHistoryRequest) Reset()         { *m = AlertHistoryRequest{} }
+func (m *AlertHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*AlertHistoryRequest) ProtoMessage()    {}
+func (*AlertHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{1}
+}
+func (m *AlertHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlertHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlertHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlertHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertHistoryRequest.Merge(m, src)
+}
+func (m *AlertHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AlertHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertHistoryRequest proto.InternalMessageInfo
+
+// AlertHistory summarizes how often an alert fired and resolved
+type AlertHistory struct {
+	// Alert name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Number of times the alert fired
+	FiredCount uint32 `protobuf:"varint,2,opt,name=fired_count,json=firedCount,proto3" json:"fired_count,omitempty"`
+	// Number of times the alert resolved
+	ResolvedCount uint32 `protobuf:"varint,3,opt,name=resolved_count,json=resolvedCount,proto3" json:"resolved_count,omitempty"`
+	// Total time the resolved alerts were firing
+	TotalDuration Duration `protobuf:"varint,4,opt,name=total_duration,json=totalDuration,proto3,casttype=Duration" json:"total_duration,omitempty"`
+	// Longest time a resolved alert was firing
+	MaxDuration Duration `protobuf:"varint,5,opt,name=max_duration,json=maxDuration,proto3,casttype=Duration" json:"max_duration,omitempty"`
+	// Last time the alert fired
+	LastFiredAt distributed_match_engine.Timestamp `protobuf:"bytes,6,opt,name=last_fired_at,json=lastFiredAt,proto3" json:"last_fired_at"`
+	// Last time the alert resolved
+	LastResolvedAt distributed_match_engine.Timestamp `protobuf:"bytes,7,opt,name=last_resolved_at,json=lastResolvedAt,proto3" json:"last_resolved_at"`
+}
+
+func (m *AlertHistory) Reset()         { *m = AlertHistory{} }
+func (m *AlertHistory) String() string { return proto.CompactTextString(m) }
+func (*AlertHistory) ProtoMessage()    {}
+func (*AlertHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{2}
+}
+func (m *AlertHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlertHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlertHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlertHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertHistory.Merge(m, src)
+}
+func (m *AlertHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *AlertHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertHistory proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Alert)(nil), "edgeproto.Alert")
 	proto.RegisterMapType((map[string]string)(nil), "edgeproto.Alert.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "edgeproto.Alert.LabelsEntry")
+	proto.RegisterType((*AlertHistoryRequest)(nil), "edgeproto.AlertHistoryRequest")
+	proto.RegisterMapType((map[string]string)(nil), "edgeproto.AlertHistoryRequest.LabelsEntry")
+	proto.RegisterType((*AlertHistory)(nil), "edgeproto.AlertHistory")
 }
 
 func init() { proto.RegisterFile("alert.proto", fileDescriptor_3b11b2fb4e5b6d61) }
 
 var fileDescriptor_3b11b2fb4e5b6d61 = []byte{
-	// 520 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xb1, 0x8f, 0x12, 0x4f,
-	0x14, 0x66, 0xe0, 0xe0, 0xc7, 0xce, 0xe6, 0x12, 0x7e, 0x9b, 0x2b, 0x06, 0x82, 0x2b, 0x62, 0x43,
-	0x2e, 0xb8, 0x6b, 0xd0, 0x44, 0xa5, 0x30, 0x01, 0x63, 0x61, 0xb4, 0xda, 0x33, 0xb6, 0x64, 0xd8,
-	0x7d, 0x2e, 0xa3, 0xcb, 0x0c, 0xd9, 0x1d, 0x20, 0x74, 0xc6, 0xc6, 0xf6, 0x72, 0xfe, 0x03, 0x96,
-	0xd6, 0xfc, 0x15, 0x94, 0x24, 0x36, 0x56, 0xe6, 0x04, 0x0b, 0x63, 0x65, 0xbc, 0x3d, 0x6b, 0xb3,
-	0xb3, 0x78, 0x47, 0xb8, 0xca, 0xee, 0xfb, 0xde, 0xfb, 0xbe, 0x79, 0xdf, 0xcc, 0x3c, 0xac, 0xd3,
-	0x00, 0x42, 0x69, 0x8d, 0x42, 0x21, 0x85, 0xa1, 0x81, 0xe7, 0x83, 0x82, 0x95, 0xaa, 0x2f, 0x84,
-	0x1f, 0x80, 0x4d, 0x47, 0xcc, 0xa6, 0x9c, 0x0b, 0x49, 0x25, 0x13, 0x3c, 0x4a, 0x85, 0x95, 0x6b,
-	0x52, 0x88, 0x20, 0xb2, 0x15, 0xf1, 0x81, 0x5f, 0x80, 0x4d, 0x7b, 0xdf, 0x1b, 0x82, 0x1d, 0x08,
-	0x77, 0x43, 0x0f, 0x7c, 0xe1, 0x0b, 0x05, 0xed, 0x04, 0xa5, 0xd5, 0xfa, 0xbb, 0x3d, 0x9c, 0xef,
-	0x24, 0xc3, 0x8d, 0xbb, 0xb8, 0x10, 0xd0, 0x3e, 0x04, 0x11, 0x41, 0xb5, 0x5c, 0x43, 0x6f, 0x55,
-	0xad, 0x8b, 0x1c, 0x96, 0x52, 0x58, 0xcf, 0x54, 0xfb, 0x31, 0x97, 0xe1, 0xcc, 0xd9, 0x68, 0x8d,
-	0x47, 0x58, 0xdf, 0x0a, 0x46, 0xb2, 0xca, 0x7a, 0xe3, 0x8a, 0xb5, 0x73, 0xa9, 0x49, 0xfd, 0xdb,
-	0x2e, 0xe3, 0x00, 0xe7, 0x23, 0x49, 0x25, 0x90, 0x5c, 0x0d, 0x35, 0x34, 0x27, 0x25, 0xc6, 0x11,
-	0xd6, 0xa8, 0x2b, 0xd9, 0x04, 0x7a, 0x54, 0x92, 0xbd, 0x1a, 0x6a, 0xe8, 0xad, 0x9b, 0x96, 0xc7,
-	0x22, 0x19, 0xb2, 0xfe, 0x58, 0x82, 0xd7, 0x1b, 0x52, 0xe9, 0x0e, 0x7a, 0xc0, 0x7d, 0xc6, 0xc1,
-	0x7a, 0xce, 0x86, 0x10, 0x49, 0x3a, 0x1c, 0x75, 0xff, 0x3f, 0x99, 0x97, 0x35, 0xf9, 0x97, 0x2e,
-	0xbe, 0x5c, 0xcf, 0x38, 0xc5, 0xf4, 0xa0, 0x8e, 0x4c, 0x46, 0x4d, 0x68, 0x30, 0x06, 0x92, 0xaf,
-	0xa1, 0x06, 0x72, 0x52, 0x62, 0x1c, 0x62, 0x8d, 0x0b, 0xc9, 0x5e, 0xce, 0x7a, 0xcc, 0x23, 0x85,
-	0x1a, 0x6a, 0xe4, 0xba, 0xfb, 0x1f, 0x63, 0x82, 0x4e, 0xe6, 0xe5, 0x3c, 0x17, 0xee, 0x70, 0xe4,
-	0x14, 0xd3, 0xfe, 0x13, 0xcf, 0xb8, 0x85, 0xb1, 0x2b, 0xb8, 0x0c, 0x45, 0x10, 0x40, 0x48, 0xfe,
-	0x4b, 0x12, 0xef, 0x8a, 0xb7, 0x04, 0x95, 0x07, 0x58, 0xdf, 0x7a, 0x37, 0xa3, 0x84, 0x73, 0xaf,
-	0x61, 0x46, 0x90, 0xba, 0x68, 0x02, 0x2f, 0x13, 0x65, 0xd3, 0xcb, 0x2b, 0xd2, 0xce, 0xde, 0x47,
-	0x95, 0x87, 0xb8, 0xb4, 0xfb, 0x6e, 0xff, 0xe2, 0x6f, 0x37, 0xbf, 0x9f, 0x11, 0xf4, 0xf3, 0x8c,
-	0xa0, 0x37, 0x31, 0x41, 0xc7, 0x31, 0x41, 0x1f, 0x62, 0x82, 0x4e, 0x63, 0x82, 0x7e, 0x9d, 0x93,
-	0xa2, 0xfa, 0x97, 0xa7, 0x30, 0x9b, 0xff, 0x26, 0x7b, 0x5c, 0x70, 0x68, 0x4d, 0x70, 0x5a, 0xeb,
-	0x8c, 0x98, 0xf1, 0x0a, 0x6b, 0x47, 0x03, 0x31, 0x4d, 0x17, 0xa3, 0xb4, 0xfb, 0x9b, 0x95, 0x2b,
-	0x95, 0xfa, 0xbd, 0x1f, 0x31, 0x29, 0x3b, 0x10, 0x89, 0x71, 0xe8, 0x82, 0x2a, 0x35, 0x3b, 0x6e,
-	0x12, 0xfb, 0x05, 0x83, 0x69, 0x73, 0x79, 0x4e, 0xd0, 0xdb, 0x4f, 0xdf, 0xde, 0x67, 0x4b, 0x75,
-	0xdd, 0x8e, 0x06, 0x62, 0x6a, 0xab, 0x7d, 0x6f, 0xa3, 0xc3, 0xdb, 0xa8, 0x5b, 0x5d, 0x7c, 0x35,
-	0x33, 0x8b, 0x95, 0x89, 0x96, 0x2b, 0x13, 0x9d, 0xae, 0x4c, 0x74, 0xbc, 0x36, 0x33, 0xcb, 0xb5,
-	0x99, 0xf9, 0xbc, 0x36, 0x33, 0xfd, 0x82, 0x9a, 0x72, 0xe7, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x40, 0x5d, 0xa5, 0xd5, 0x22, 0x03, 0x00, 0x00,
+	// 789 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x4f, 0x33, 0x45,
+	0x18, 0xee, 0x6c, 0x7f, 0xd0, 0xce, 0xb6, 0xa4, 0x8e, 0x24, 0x0c, 0x0d, 0x2e, 0xb5, 0x86, 0xa4,
+	0x21, 0xd8, 0x25, 0x60, 0xa2, 0x72, 0x30, 0x69, 0x41, 0x82, 0x51, 0x63, 0xb2, 0x18, 0xaf, 0x9b,
+	0x61, 0x77, 0xd8, 0xae, 0xee, 0xce, 0xd4, 0xdd, 0x29, 0x50, 0x4f, 0xc6, 0x8b, 0x37, 0x43, 0x30,
+	0xde, 0x3d, 0x7a, 0xe6, 0xaf, 0xe8, 0x91, 0xc4, 0x8b, 0x27, 0x82, 0xe0, 0xc1, 0x70, 0x32, 0xb2,
+	0x78, 0xf0, 0xf4, 0x65, 0x67, 0xdb, 0x52, 0x0a, 0x7c, 0xf9, 0x9a, 0xef, 0xf6, 0xbe, 0xef, 0x3c,
+	0xcf, 0xf3, 0x3e, 0xef, 0xbe, 0x6f, 0x0b, 0x55, 0xe2, 0xd1, 0x40, 0x34, 0x3a, 0x01, 0x17, 0x1c,
+	0x15, 0xa8, 0xed, 0x50, 0x19, 0x56, 0x16, 0x1d, 0xce, 0x1d, 0x8f, 0xea, 0xa4, 0xe3, 0xea, 0x84,
+	0x31, 0x2e, 0x88, 0x70, 0x39, 0x0b, 0x13, 0x60, 0xe5, 0x2d, 0xc1, 0xb9, 0x17, 0xea, 0x32, 0x71,
+	0x28, 0x1b, 0x05, 0x83, 0xe7, 0x92, 0xed, 0x53, 0xdd, 0xe3, 0xd6, 0x20, 0x9d, 0x73, 0xb8, 0xc3,
+	0x65, 0xa8, 0xc7, 0x51, 0x52, 0xad, 0xfd, 0x98, 0x81, 0xd9, 0x66, 0xdc, 0x1c, 0xbd, 0x07, 0x73,
+	0x1e, 0xd9, 0xa7, 0x5e, 0x88, 0x41, 0x35, 0x5d, 0x57, 0xd7, 0x17, 0x1b, 0x23, 0x1f, 0x0d, 0x89,
+	0x68, 0x7c, 0x26, 0x9f, 0x3f, 0x66, 0x22, 0xe8, 0x19, 0x03, 0x2c, 0xda, 0x82, 0xea, 0x98, 0x31,
+	0xac, 0x48, 0xea, 0xdb, 0x8f, 0xa8, 0xcd, 0x7b, 0x4c, 0xc2, 0x1f, 0x67, 0xa1, 0x39, 0x98, 0x0d,
+	0x05, 0x11, 0x14, 0xa7, 0xab, 0xa0, 0x5e, 0x30, 0x92, 0x04, 0xed, 0xc1, 0x02, 0xb1, 0x84, 0x7b,
+	0x48, 0x4d, 0x22, 0x70, 0xa6, 0x0a, 0xea, 0xea, 0xfa, 0x3b, 0x0d, 0xdb, 0x0d, 0x45, 0xe0, 0xee,
+	0x77, 0x05, 0xb5, 0x4d, 0x9f, 0x08, 0xab, 0x6d, 0x52, 0xe6, 0xb8, 0x8c, 0x36, 0xbe, 0x74, 0x7d,
+	0x1a, 0x0a, 0xe2, 0x77, 0x5a, 0x6f, 0x9c, 0x9e, 0x2d, 0x14, 0xc4, 0x30, 0xed, 0x5f, 0x2c, 0xa5,
+	0x8c, 0x7c, 0x22, 0xd4, 0x14, 0x71, 0xab, 0x43, 0xe2, 0x75, 0x29, 0xce, 0x56, 0x41, 0x1d, 0x18,
+	0x49, 0x82, 0x56, 0x60, 0x81, 0x71, 0xe1, 0x1e, 0xf4, 0x4c, 0xd7, 0xc6, 0xb9, 0x2a, 0xa8, 0xa7,
+	0x5b, 0xa5, 0xdf, 0x22, 0x0c, 0x4e, 0xcf, 0x16, 0xb2, 0x8c, 0x5b, 0x7e, 0xc7, 0xc8, 0x27, 0xef,
+	0x9f, 0xd8, 0xe8, 0x5d, 0x08, 0x2d, 0xce, 0x44, 0xc0, 0x3d, 0x8f, 0x06, 0x78, 0x26, 0x76, 0x3c,
+	0x09, 0x1e, 0x03, 0x54, 0x3e, 0x84, 0xea, 0xd8, 0x77, 0x43, 0x65, 0x98, 0xfe, 0x86, 0xf6, 0x30,
+	0x90, 0x83, 0xc6, 0xe1, 0xbd, 0x23, 0x25, 0x19, 0x5e, 0x26, 0x9b, 0xca, 0x07, 0xa0, 0xf2, 0x11,
+	0x2c, 0x4f, 0x7e, 0xb7, 0x69, 0xf8, 0x9b, 0xab, 0x7f, 0xdf, 0x62, 0xf0, 0xcf, 0x2d, 0x06, 0xdf,
+	0x47, 0x18, 0x9c, 0x44, 0x18, 0xfc, 0x1a, 0x61, 0x70, 0x19, 0x61, 0xf0, 0xef, 0x1d, 0xce, 0xcb,
+	0xbd, 0x7c, 0x4a, 0x7b, 0x67, 0xff, 0xe1, 0x0c, 0xe3, 0x8c, 0xd6, 0xfa, 0x0a, 0x7c, 0x53, 0x16,
+	0x77, 0xdd, 0x50, 0xf0, 0xa0, 0x67, 0xd0, 0x6f, 0xbb, 0x34, 0x14, 0xa8, 0x06, 0x8b, 0x3c, 0x70,
+	0x08, 0x73, 0xbf, 0x93, 0x3e, 0x06, 0xad, 0x1f, 0xd4, 0xd0, 0x2e, 0x84, 0xa1, 0x20, 0x81, 0x30,
+	0xe3, 0xcf, 0x8e, 0x95, 0x57, 0xdf, 0x55, 0x46, 0xae, 0xa7, 0x20, 0xc9, 0x71, 0x15, 0x6d, 0xc3,
+	0x3c, 0x65, 0x76, 0xa2, 0x93, 0x9e, 0x56, 0x67, 0x86, 0x32, 0x5b, 0xaa, 0xb4, 0x46, 0xb7, 0x9c,
+	0x91, 0x07, 0xb9, 0x32, 0x79, 0x90, 0x0f, 0x67, 0x7c, 0xea, 0xb2, 0x5f, 0x63, 0x71, 0xb5, 0x48,
+	0x81, 0xc5, 0xf1, 0x36, 0x08, 0xc1, 0x0c, 0x23, 0x3e, 0x1d, 0xb0, 0x65, 0x8c, 0x96, 0xa0, 0x7a,
+	0xe0, 0x06, 0xd4, 0x36, 0x2d, 0xde, 0x65, 0x42, 0x8a, 0x94, 0x0c, 0x28, 0x4b, 0x5b, 0x71, 0x05,
+	0x2d, 0xc3, 0xd9, 0x80, 0x86, 0xdc, 0x3b, 0x1c, 0x61, 0xd2, 0x12, 0x53, 0x1a, 0x56, 0x13, 0xd8,
+	0x06, 0x9c, 0x15, 0x5c, 0x10, 0xcf, 0xb4, 0xbb, 0x41, 0xb2, 0xa1, 0x8c, 0x3c, 0xe0, 0xe2, 0xff,
+	0x17, 0x4b, 0xf9, 0xed, 0x41, 0xcd, 0x28, 0x49, 0xcc, 0x30, 0x45, 0x3a, 0x2c, 0xfa, 0xe4, 0xf8,
+	0x9e, 0x92, 0x7d, 0x82, 0xa2, 0xfa, 0xe4, 0x78, 0x44, 0xf8, 0x1c, 0x96, 0x3c, 0x12, 0x0a, 0x33,
+	0xb1, 0x4c, 0x04, 0xce, 0x4d, 0xbb, 0x1c, 0x35, 0xe6, 0xef, 0xc4, 0xf4, 0xa6, 0x40, 0x7b, 0xb0,
+	0x2c, 0xe5, 0x46, 0x03, 0x12, 0x81, 0x67, 0xa6, 0x55, 0x9c, 0x8d, 0x25, 0x8c, 0x81, 0x42, 0x53,
+	0xac, 0xff, 0xa4, 0xc0, 0xe4, 0xac, 0x9b, 0x1d, 0x17, 0x7d, 0x0d, 0x0b, 0x7b, 0x6d, 0x7e, 0x24,
+	0x73, 0x54, 0x9e, 0xdc, 0x7f, 0xe5, 0x51, 0xa5, 0xf6, 0xfe, 0x4d, 0x84, 0x17, 0x62, 0xb5, 0x6e,
+	0x60, 0x51, 0x59, 0x5a, 0x6d, 0x5a, 0xf1, 0xec, 0x5f, 0xb9, 0xf4, 0x68, 0xf5, 0xfc, 0x0e, 0x83,
+	0x1f, 0x7e, 0xff, 0xeb, 0x67, 0xa5, 0xbc, 0x09, 0x56, 0x6a, 0xaa, 0x1e, 0xb6, 0xf9, 0x91, 0x2e,
+	0xff, 0xb5, 0xd7, 0x00, 0xfa, 0x05, 0xc0, 0xf2, 0xa8, 0xd9, 0x70, 0xe7, 0xda, 0xcb, 0x6f, 0xae,
+	0x32, 0xff, 0xcc, 0x7b, 0x6d, 0xe7, 0x26, 0xc2, 0xcb, 0xcf, 0x1a, 0xf9, 0x62, 0xec, 0x77, 0x27,
+	0x0d, 0xcd, 0xc7, 0x86, 0xd0, 0x98, 0xa1, 0x76, 0xa2, 0xb3, 0x06, 0x5a, 0x8b, 0xfd, 0x3f, 0xb5,
+	0x54, 0xff, 0x4a, 0x03, 0xe7, 0x57, 0x1a, 0xb8, 0xbc, 0xd2, 0xc0, 0xc9, 0xb5, 0x96, 0x3a, 0xbf,
+	0xd6, 0x52, 0x7f, 0x5c, 0x6b, 0xa9, 0xfd, 0x9c, 0xec, 0xbd, 0xf1, 0x62, 0x00, 0xe8, 0x84, 0xe8,
+	0x8b, 0x7d, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -150,6 +266,8 @@ const _ = grpc.SupportPackageIsVersion4
 type AlertApiClient interface {
 	// Show alerts
 	ShowAlert(ctx context.Context, in *Alert, opts ...grpc.CallOption) (AlertApi_ShowAlertClient, error)
+	// Show how often alerts fired and how long they were firing, per alert name
+	ShowAlertHistory(ctx context.Context, in *AlertHistoryRequest, opts ...grpc.CallOption) (AlertApi_ShowAlertHistoryClient, error)
 }
 
 type alertApiClient struct {
@@ -192,10 +310,44 @@ func (x *alertApiShowAlertClient) Recv() (*Alert, error) {
 	return m, nil
 }
 
+func (c *alertApiClient) ShowAlertHistory(ctx context.Context, in *AlertHistoryRequest, opts ...grpc.CallOption) (AlertApi_ShowAlertHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AlertApi_serviceDesc.Streams[1], "/edgeproto.AlertApi/ShowAlertHistory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &alertApiShowAlertHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AlertApi_ShowAlertHistoryClient interface {
+	Recv() (*AlertHistory, error)
+	grpc.ClientStream
+}
+
+type alertApiShowAlertHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *alertApiShowAlertHistoryClient) Recv() (*AlertHistory, error) {
+	m := new(AlertHistory)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // AlertApiServer is the server API for AlertApi service.
 type AlertApiServer interface {
 	// Show alerts
 	ShowAlert(*Alert, AlertApi_ShowAlertServer) error
+	// Show how often alerts fired and how long they were firing, per alert name
+	ShowAlertHistory(*AlertHistoryRequest, AlertApi_ShowAlertHistoryServer) error
 }
 
 // UnimplementedAlertApiServer can be embedded to have forward compatible implementations.
@@ -205,6 +357,9 @@ type UnimplementedAlertApiServer struct {
 func (*UnimplementedAlertApiServer) ShowAlert(req *Alert, srv AlertApi_ShowAlertServer) error {
 	return status.Errorf(codes.Unimplemented, "method ShowAlert not implemented")
 }
+func (*UnimplementedAlertApiServer) ShowAlertHistory(req *AlertHistoryRequest, srv AlertApi_ShowAlertHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method ShowAlertHistory not implemented")
+}
 
 func RegisterAlertApiServer(s *grpc.Server, srv AlertApiServer) {
 	s.RegisterService(&_AlertApi_serviceDesc, srv)
@@ -231,6 +386,27 @@ func (x *alertApiShowAlertServer) Send(m *Alert) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _AlertApi_ShowAlertHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AlertHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AlertApiServer).ShowAlertHistory(m, &alertApiShowAlertHistoryServer{stream})
+}
+
+type AlertApi_ShowAlertHistoryServer interface {
+	Send(*AlertHistory) error
+	grpc.ServerStream
+}
+
+type alertApiShowAlertHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *alertApiShowAlertHistoryServer) Send(m *AlertHistory) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _AlertApi_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "edgeproto.AlertApi",
 	HandlerType: (*AlertApiServer)(nil),
@@ -241,6 +417,11 @@ var _AlertApi_serviceDesc = grpc.ServiceDesc{
 			Handler:       _AlertApi_ShowAlert_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ShowAlertHistory",
+			Handler:       _AlertApi_ShowAlertHistory_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "alert.proto",
 }
@@ -341,6 +522,145 @@ func (m *Alert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AlertHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlertHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlertHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintAlert(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAlert(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAlert(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.EndTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAlert(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAlert(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Organization) > 0 {
+		i -= len(m.Organization)
+		copy(dAtA[i:], m.Organization)
+		i = encodeVarintAlert(dAtA, i, uint64(len(m.Organization)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlertHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlertHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlertHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LastResolvedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAlert(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.LastFiredAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAlert(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.MaxDuration != 0 {
+		i = encodeVarintAlert(dAtA, i, uint64(m.MaxDuration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalDuration != 0 {
+		i = encodeVarintAlert(dAtA, i, uint64(m.TotalDuration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ResolvedCount != 0 {
+		i = encodeVarintAlert(dAtA, i, uint64(m.ResolvedCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FiredCount != 0 {
+		i = encodeVarintAlert(dAtA, i, uint64(m.FiredCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAlert(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAlert(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAlert(v)
 	base := offset
@@ -1220,50 +1540,193 @@ func IgnoreAlertFields(taglist string) cmp.Option {
 	return cmpopts.IgnoreFields(Alert{}, names...)
 }
 
-type MatchOptions struct {
-	// Filter will ignore 0 or nil fields on the passed in object
-	Filter bool
-	// IgnoreBackend will ignore fields that were marked backend in .proto
-	IgnoreBackend bool
-	// Sort repeated (arrays) of Key objects so matching does not
-	// fail due to order.
-	SortArrayedKeys bool
+func (m *AlertHistoryRequest) Clone() *AlertHistoryRequest {
+	cp := &AlertHistoryRequest{}
+	cp.DeepCopyIn(m)
+	return cp
 }
 
-type MatchOpt func(*MatchOptions)
-
-func MatchFilter() MatchOpt {
-	return func(opts *MatchOptions) {
-		opts.Filter = true
+func (m *AlertHistoryRequest) CopyInFields(src *AlertHistoryRequest) int {
+	updateListAction := "replace"
+	changed := 0
+	if m.Organization != src.Organization {
+		m.Organization = src.Organization
+		changed++
+	}
+	if m.StartTime.Seconds != src.StartTime.Seconds {
+		m.StartTime.Seconds = src.StartTime.Seconds
+		changed++
+	}
+	if m.StartTime.Nanos != src.StartTime.Nanos {
+		m.StartTime.Nanos = src.StartTime.Nanos
+		changed++
+	}
+	if m.EndTime.Seconds != src.EndTime.Seconds {
+		m.EndTime.Seconds = src.EndTime.Seconds
+		changed++
+	}
+	if m.EndTime.Nanos != src.EndTime.Nanos {
+		m.EndTime.Nanos = src.EndTime.Nanos
+		changed++
+	}
+	if src.Labels != nil {
+		if updateListAction == "add" {
+			for k0, v := range src.Labels {
+				m.Labels[k0] = v
+				changed++
+			}
+		} else if updateListAction == "remove" {
+			for k0, _ := range src.Labels {
+				if _, ok := m.Labels[k0]; ok {
+					delete(m.Labels, k0)
+					changed++
+				}
+			}
+		} else {
+			m.Labels = make(map[string]string)
+			for k0, v := range src.Labels {
+				m.Labels[k0] = v
+			}
+			changed++
+		}
+	} else if m.Labels != nil {
+		m.Labels = nil
+		changed++
 	}
+	return changed
 }
 
-func MatchIgnoreBackend() MatchOpt {
-	return func(opts *MatchOptions) {
-		opts.IgnoreBackend = true
+func (m *AlertHistoryRequest) DeepCopyIn(src *AlertHistoryRequest) {
+	m.Organization = src.Organization
+	m.StartTime = src.StartTime
+	m.EndTime = src.EndTime
+	if src.Labels != nil {
+		m.Labels = make(map[string]string)
+		for k, v := range src.Labels {
+			m.Labels[k] = v
+		}
+	} else {
+		m.Labels = nil
 	}
 }
 
-func MatchSortArrayedKeys() MatchOpt {
-	return func(opts *MatchOptions) {
-		opts.SortArrayedKeys = true
-	}
+// Helper method to check that enums have valid values
+func (m *AlertHistoryRequest) ValidateEnums() error {
+	return nil
 }
 
-func applyMatchOptions(opts *MatchOptions, args ...MatchOpt) {
-	for _, f := range args {
-		f(opts)
-	}
+func (s *AlertHistoryRequest) ClearTagged(tags map[string]struct{}) {
 }
 
-type FieldMap struct {
-	fields map[string]struct{}
+func (m *AlertHistory) Clone() *AlertHistory {
+	cp := &AlertHistory{}
+	cp.DeepCopyIn(m)
+	return cp
 }
 
-func MakeFieldMap(fields []string) *FieldMap {
-	fmap := &FieldMap{}
-	fmap.fields = map[string]struct{}{}
-	if fields == nil {
+func (m *AlertHistory) CopyInFields(src *AlertHistory) int {
+	changed := 0
+	if m.Name != src.Name {
+		m.Name = src.Name
+		changed++
+	}
+	if m.FiredCount != src.FiredCount {
+		m.FiredCount = src.FiredCount
+		changed++
+	}
+	if m.ResolvedCount != src.ResolvedCount {
+		m.ResolvedCount = src.ResolvedCount
+		changed++
+	}
+	if m.TotalDuration != src.TotalDuration {
+		m.TotalDuration = src.TotalDuration
+		changed++
+	}
+	if m.MaxDuration != src.MaxDuration {
+		m.MaxDuration = src.MaxDuration
+		changed++
+	}
+	if m.LastFiredAt.Seconds != src.LastFiredAt.Seconds {
+		m.LastFiredAt.Seconds = src.LastFiredAt.Seconds
+		changed++
+	}
+	if m.LastFiredAt.Nanos != src.LastFiredAt.Nanos {
+		m.LastFiredAt.Nanos = src.LastFiredAt.Nanos
+		changed++
+	}
+	if m.LastResolvedAt.Seconds != src.LastResolvedAt.Seconds {
+		m.LastResolvedAt.Seconds = src.LastResolvedAt.Seconds
+		changed++
+	}
+	if m.LastResolvedAt.Nanos != src.LastResolvedAt.Nanos {
+		m.LastResolvedAt.Nanos = src.LastResolvedAt.Nanos
+		changed++
+	}
+	return changed
+}
+
+func (m *AlertHistory) DeepCopyIn(src *AlertHistory) {
+	m.Name = src.Name
+	m.FiredCount = src.FiredCount
+	m.ResolvedCount = src.ResolvedCount
+	m.TotalDuration = src.TotalDuration
+	m.MaxDuration = src.MaxDuration
+	m.LastFiredAt = src.LastFiredAt
+	m.LastResolvedAt = src.LastResolvedAt
+}
+
+// Helper method to check that enums have valid values
+func (m *AlertHistory) ValidateEnums() error {
+	return nil
+}
+
+func (s *AlertHistory) ClearTagged(tags map[string]struct{}) {
+}
+
+type MatchOptions struct {
+	// Filter will ignore 0 or nil fields on the passed in object
+	Filter bool
+	// IgnoreBackend will ignore fields that were marked backend in .proto
+	IgnoreBackend bool
+	// Sort repeated (arrays) of Key objects so matching does not
+	// fail due to order.
+	SortArrayedKeys bool
+}
+
+type MatchOpt func(*MatchOptions)
+
+func MatchFilter() MatchOpt {
+	return func(opts *MatchOptions) {
+		opts.Filter = true
+	}
+}
+
+func MatchIgnoreBackend() MatchOpt {
+	return func(opts *MatchOptions) {
+		opts.IgnoreBackend = true
+	}
+}
+
+func MatchSortArrayedKeys() MatchOpt {
+	return func(opts *MatchOptions) {
+		opts.SortArrayedKeys = true
+	}
+}
+
+func applyMatchOptions(opts *MatchOptions, args ...MatchOpt) {
+	for _, f := range args {
+		f(opts)
+	}
+}
+
+type FieldMap struct {
+	fields map[string]struct{}
+}
+
+func MakeFieldMap(fields []string) *FieldMap {
+	fmap := &FieldMap{}
+	fmap.fields = map[string]struct{}{}
+	if fields == nil {
 		return fmap
 	}
 	for _, set := range fields {
@@ -1355,6 +1818,12 @@ func NewOptionalSTM(stm concurrency.STM) *OptionalSTM {
 // represented as strings.
 func EnumDecodeHook(from, to reflect.Type, data interface{}) (interface{}, error) {
 	switch to {
+	case reflect.TypeOf(AlertMetricsSource(0)):
+		return ParseAlertMetricsSource(data)
+	case reflect.TypeOf(AlertReceiverType(0)):
+		return ParseAlertReceiverType(data)
+	case reflect.TypeOf(FindCloudletDistribution(0)):
+		return ParseFindCloudletDistribution(data)
 	case reflect.TypeOf(OptResNames(0)):
 		return ParseOptResNames(data)
 	case reflect.TypeOf(Liveness(0)):
@@ -1385,6 +1854,8 @@ func EnumDecodeHook(from, to reflect.Type, data interface{}) (interface{}, error
 		return ParseInfraApiAccess(data)
 	case reflect.TypeOf(OSType(0)):
 		return ParseOSType(data)
+	case reflect.TypeOf(MaintenanceWindowState(0)):
+		return ParseMaintenanceWindowState(data)
 	case reflect.TypeOf(ReportSchedule(0)):
 		return ParseReportSchedule(data)
 	case reflect.TypeOf(VMState(0)):
@@ -1403,8 +1874,12 @@ func EnumDecodeHook(from, to reflect.Type, data interface{}) (interface{}, error
 		return ParseFlowRateLimitAlgorithm(data)
 	case reflect.TypeOf(MaxReqsRateLimitAlgorithm(0)):
 		return ParseMaxReqsRateLimitAlgorithm(data)
+	case reflect.TypeOf(AppRolloutState(0)):
+		return ParseAppRolloutState(data)
 	case reflect.TypeOf(NoticeAction(0)):
 		return ParseNoticeAction(data)
+	case reflect.TypeOf(NoticeCompression(0)):
+		return ParseNoticeCompression(data)
 	case reflect.TypeOf(StreamState(0)):
 		return ParseStreamState(data)
 	case reflect.TypeOf(VersionHash(0)):
@@ -1419,6 +1894,12 @@ func EnumDecodeHook(from, to reflect.Type, data interface{}) (interface{}, error
 // valid values, and a bool that indicates if a type was matched.
 func GetEnumParseHelp(t reflect.Type) (string, string, bool) {
 	switch t {
+	case reflect.TypeOf(AlertMetricsSource(0)):
+		return "AlertMetricsSource", ", valid values are one of App, LoadBalancer, or 0, 1", true
+	case reflect.TypeOf(AlertReceiverType(0)):
+		return "AlertReceiverType", ", valid values are one of Unknown, Webhook, Email, Slack, PagerDuty, or 0, 1, 2, 3, 4", true
+	case reflect.TypeOf(FindCloudletDistribution(0)):
+		return "FindCloudletDistribution", ", valid values are one of Random, WeightedRandom, RoundRobin, or 0, 1, 2", true
 	case reflect.TypeOf(OptResNames(0)):
 		return "OptResNames", ", valid values are one of Gpu, Nas, Nic, or 0, 1, 2", true
 	case reflect.TypeOf(Liveness(0)):
@@ -1449,6 +1930,8 @@ func GetEnumParseHelp(t reflect.Type) (string, string, bool) {
 		return "InfraApiAccess", ", valid values are one of DirectAccess, RestrictedAccess, or 0, 1", true
 	case reflect.TypeOf(OSType(0)):
 		return "OSType", ", valid values are one of Linux, Windows, Others, or 0, 1, 20", true
+	case reflect.TypeOf(MaintenanceWindowState(0)):
+		return "MaintenanceWindowState", ", valid values are one of Scheduled, Failover, InProgress, or 0, 1, 2", true
 	case reflect.TypeOf(ReportSchedule(0)):
 		return "ReportSchedule", ", valid values are one of EveryWeek, Every15Days, EveryMonth, or 0, 1, 3", true
 	case reflect.TypeOf(VMState(0)):
@@ -1460,33 +1943,45 @@ func GetEnumParseHelp(t reflect.Type) (string, string, bool) {
 	case reflect.TypeOf(NetworkConnectionType(0)):
 		return "NetworkConnectionType", ", valid values are one of Undefined, ConnectToLoadBalancer, ConnectToClusterNodes, ConnectToAll, or 0, 1, 2, 3", true
 	case reflect.TypeOf(ApiEndpointType(0)):
-		return "ApiEndpointType", ", valid values are one of UnknownApiEndpointType, Dme, or 0, 1", true
+		return "ApiEndpointType", ", valid values are one of UnknownApiEndpointType, Dme, Controller, or 0, 1, 2", true
 	case reflect.TypeOf(RateLimitTarget(0)):
 		return "RateLimitTarget", ", valid values are one of UnknownTarget, AllRequests, PerIp, PerUser, or 0, 1, 2, 3", true
 	case reflect.TypeOf(FlowRateLimitAlgorithm(0)):
 		return "FlowRateLimitAlgorithm", ", valid values are one of UnknownFlowAlgorithm, TokenBucketAlgorithm, LeakyBucketAlgorithm, or 0, 1, 2", true
 	case reflect.TypeOf(MaxReqsRateLimitAlgorithm(0)):
 		return "MaxReqsRateLimitAlgorithm", ", valid values are one of UnknownMaxReqsAlgorithm, FixedWindowAlgorithm, or 0, 1", true
+	case reflect.TypeOf(AppRolloutState(0)):
+		return "AppRolloutState", ", valid values are one of Unknown, InProgress, Paused, Completed, RollingBack, RolledBack, Failed, or 0, 1, 2, 3, 4, 5, 6", true
 	case reflect.TypeOf(NoticeAction(0)):
-		return "NoticeAction", ", valid values are one of None, Update, Delete, Version, SendallEnd, or 0, 1, 2, 3, 4", true
+		return "NoticeAction", ", valid values are one of None, Update, Delete, Version, SendallEnd, Batch, or 0, 1, 2, 3, 4, 5", true
+	case reflect.TypeOf(NoticeCompression(0)):
+		return "NoticeCompression", ", valid values are one of None, Gzip, Zstd, or 0, 1, 2", true
 	case reflect.TypeOf(StreamState(0)):
 		return "StreamState", ", valid values are one of Unknown, Start, Stop, Error, or 0, 1, 2, 3", true
 	case reflect.TypeOf(VersionHash(0)):
-		return "VersionHash", ", valid values are one of D41D8Cd98F00B204E9800998Ecf8427E, C2D882033B0C14F28Cece41Cf4010060, 14Ae4C721C1Bace6E8379D0061A72A77, Eff9D3A6C74Fd02840Efce05D1984E8D, Eac56710C013D954Db31Eeb306B514A4, 75883D14000640B2Ecf694Fe8Ef9192B, E65C39Ec2A489834Dd06E87F7239F9A8, B25B4E18E9A1Dadfd3006E23Fabfbf95, Abec45B13Db5Cd29E3Bcf63D3B80Be29, 2D0B51B0Cb6Eaff42225Cd1795E168E7, or 0, 52, 53, 54, 55, 56, 57, 58, 59, 60", true
+		return "VersionHash", ", valid values are one of D41D8Cd98F00B204E9800998Ecf8427E, C2D882033B0C14F28Cece41Cf4010060, 14Ae4C721C1Bace6E8379D0061A72A77, Eff9D3A6C74Fd02840Efce05D1984E8D, Eac56710C013D954Db31Eeb306B514A4, 75883D14000640B2Ecf694Fe8Ef9192B, E65C39Ec2A489834Dd06E87F7239F9A8, B25B4E18E9A1Dadfd3006E23Fabfbf95, Abec45B13Db5Cd29E3Bcf63D3B80Be29, 2D0B51B0Cb6Eaff42225Cd1795E168E7, Bc1137F8B94A59Cf27408Cd1083D85C7, 969Dbb43Bfdb718B1C9B10532D763384, or 0, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62", true
 	}
 	return "", "", false
 }
 
 var ShowMethodNames = map[string]struct{}{
 	"ShowAlert":                    struct{}{},
+	"ShowAlertHistory":             struct{}{},
 	"ShowAlertPolicy":              struct{}{},
+	"ShowAlertPolicyHistory":       struct{}{},
+	"ShowAlertReceiver":            struct{}{},
+	"ShowAlertSilence":             struct{}{},
 	"ShowSettings":                 struct{}{},
 	"ShowFlavor":                   struct{}{},
+	"ShowFlavorHistory":            struct{}{},
 	"ShowOperatorCode":             struct{}{},
 	"ShowResTagTable":              struct{}{},
 	"ShowAutoScalePolicy":          struct{}{},
+	"ShowAutoScalePolicyHistory":   struct{}{},
 	"ShowTrustPolicy":              struct{}{},
+	"ShowTrustPolicyHistory":       struct{}{},
 	"ShowApp":                      struct{}{},
+	"ShowAppHistory":               struct{}{},
 	"ShowZonesForAppDeployment":    struct{}{},
 	"ShowAppInst":                  struct{}{},
 	"ShowAppInstInfo":              struct{}{},
@@ -1499,10 +1994,12 @@ var ShowMethodNames = map[string]struct{}{
 	"ShowCloudletMetrics":          struct{}{},
 	"ShowCloudletManagedCluster":   struct{}{},
 	"ShowZone":                     struct{}{},
+	"ShowZoneHistory":              struct{}{},
 	"ShowZoneGPUs":                 struct{}{},
 	"ShowZonePool":                 struct{}{},
 	"ShowVMPool":                   struct{}{},
 	"ShowAutoProvPolicy":           struct{}{},
+	"ShowAutoProvPolicyHistory":    struct{}{},
 	"ShowTrustPolicyException":     struct{}{},
 	"ShowNetwork":                  struct{}{},
 	"ShowClusterInst":              struct{}{},
@@ -1510,9 +2007,12 @@ var ShowMethodNames = map[string]struct{}{
 	"ShowCloudletRefs":             struct{}{},
 	"ShowClusterRefs":              struct{}{},
 	"ShowAppInstRefs":              struct{}{},
+	"ShowGeoFencePolicy":           struct{}{},
+	"ShowGeoFencePolicyHistory":    struct{}{},
 	"ShowRateLimitSettings":        struct{}{},
 	"ShowFlowRateLimitSettings":    struct{}{},
 	"ShowMaxReqsRateLimitSettings": struct{}{},
+	"ShowAppRollout":               struct{}{},
 	"ShowCloudletNode":             struct{}{},
 	"ShowController":               struct{}{},
 	"ShowSvcNode":                  struct{}{},
@@ -1528,6 +2028,10 @@ func IsShow(cmd string) bool {
 var AllKeyTags = []string{
 	"alert",
 	"alertorg",
+	"alertreceiver",
+	"alertreceiverorg",
+	"alertsilence",
+	"alertsilenceorg",
 	"apiendpointtype",
 	"apiname",
 	"app",
@@ -1577,6 +2081,10 @@ var AllKeyTags = []string{
 var AllKeyTagsMap = map[string]struct{}{
 	"alert":               struct{}{},
 	"alertorg":            struct{}{},
+	"alertreceiver":       struct{}{},
+	"alertreceiverorg":    struct{}{},
+	"alertsilence":        struct{}{},
+	"alertsilenceorg":     struct{}{},
 	"apiendpointtype":     struct{}{},
 	"apiname":             struct{}{},
 	"app":                 struct{}{},
@@ -1626,15 +2134,17 @@ var AllKeyTagsMap = map[string]struct{}{
 // References generated from the refers_to and tracks_refs_by protogen options
 func GetReferencesMap() map[string][]string {
 	refs := make(map[string][]string)
-	refs["AllData"] = []string{"AlertPolicy", "App", "AutoProvPolicy", "AutoScalePolicy", "Cloudlet", "ClusterInst", "Flavor", "GPUDriver", "Network", "PlatformFeatures", "ResTagTable", "TrustPolicy", "VMPool", "Zone", "ZonePool"}
-	refs["App"] = []string{"AlertPolicy", "AutoProvPolicy", "Flavor"}
+	refs["AllData"] = []string{"AlertPolicy", "App", "AutoProvPolicy", "AutoScalePolicy", "Cloudlet", "ClusterInst", "Flavor", "GPUDriver", "GeoFencePolicy", "Network", "PlatformFeatures", "ResTagTable", "TrustPolicy", "VMPool", "Zone", "ZonePool"}
+	refs["App"] = []string{"AlertPolicy", "AutoProvPolicy", "Flavor", "GeoFencePolicy"}
 	refs["AppAlertPolicy"] = []string{"AlertPolicy", "App"}
 	refs["AppAutoProvPolicy"] = []string{"App", "AutoProvPolicy"}
+	refs["AppHistory"] = []string{"AlertPolicy", "AutoProvPolicy", "Flavor", "GeoFencePolicy"}
 	refs["AppInst"] = []string{"App", "Cloudlet", "ClusterInst", "Flavor"}
 	refs["AppInstKeyV1"] = []string{"ClusterInst"}
 	refs["AppInstKeyV2"] = []string{"Cloudlet"}
 	refs["AppInstRefs"] = []string{"AppInst"}
 	refs["AutoProvPolicy"] = []string{"Zone"}
+	refs["AutoProvPolicyHistory"] = []string{"Zone"}
 	refs["AutoProvPolicyZone"] = []string{"AutoProvPolicy", "Zone"}
 	refs["Cloudlet"] = []string{"Flavor", "GPUDriver", "PlatformFeatures", "ResTagTable", "TrustPolicy", "VMPool"}
 	refs["CloudletRefs"] = []string{"AppInst", "ClusterInst"}
@@ -1643,7 +2153,8 @@ func GetReferencesMap() map[string][]string {
 	refs["ClusterInstKeyV1"] = []string{"Cloudlet"}
 	refs["ClusterInstKeyV2"] = []string{"Cloudlet"}
 	refs["ClusterRefs"] = []string{"AppInst"}
-	refs["DeploymentZoneRequest"] = []string{"AlertPolicy", "AutoProvPolicy", "Flavor"}
+	refs["DataSnapshot"] = []string{"AlertPolicy", "App", "AutoProvPolicy", "AutoScalePolicy", "Cloudlet", "ClusterInst", "Flavor", "GPUDriver", "GeoFencePolicy", "Network", "PlatformFeatures", "ResTagTable", "TrustPolicy", "VMPool", "Zone", "ZonePool"}
+	refs["DeploymentZoneRequest"] = []string{"AlertPolicy", "AutoProvPolicy", "Flavor", "GeoFencePolicy"}
 	refs["GPUConfig"] = []string{"GPUDriver"}
 	refs["Network"] = []string{"Cloudlet"}
 	refs["NetworkKey"] = []string{"Cloudlet"}
@@ -1695,6 +2206,60 @@ func (m *Alert) Size() (n int) {
 	return n
 }
 
+func (m *AlertHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Organization)
+	if l > 0 {
+		n += 1 + l + sovAlert(uint64(l))
+	}
+	l = m.StartTime.Size()
+	n += 1 + l + sovAlert(uint64(l))
+	l = m.EndTime.Size()
+	n += 1 + l + sovAlert(uint64(l))
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAlert(uint64(len(k))) + 1 + len(v) + sovAlert(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAlert(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *AlertHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAlert(uint64(l))
+	}
+	if m.FiredCount != 0 {
+		n += 1 + sovAlert(uint64(m.FiredCount))
+	}
+	if m.ResolvedCount != 0 {
+		n += 1 + sovAlert(uint64(m.ResolvedCount))
+	}
+	if m.TotalDuration != 0 {
+		n += 1 + sovAlert(uint64(m.TotalDuration))
+	}
+	if m.MaxDuration != 0 {
+		n += 1 + sovAlert(uint64(m.MaxDuration))
+	}
+	l = m.LastFiredAt.Size()
+	n += 1 + l + sovAlert(uint64(l))
+	l = m.LastResolvedAt.Size()
+	n += 1 + l + sovAlert(uint64(l))
+	return n
+}
+
 func sovAlert(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2132,6 +2697,505 @@ func (m *Alert) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AlertHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAlert
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlertHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlertHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlert
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Organization = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAlert
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAlert
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAlert
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAlert
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAlert
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAlert
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAlert
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAlert
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAlert
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAlert
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAlert(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAlert
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAlert(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAlert
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlertHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAlert
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlertHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlertHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlert
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FiredCount", wireType)
+			}
+			m.FiredCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FiredCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedCount", wireType)
+			}
+			m.ResolvedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDuration", wireType)
+			}
+			m.TotalDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalDuration |= Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+			}
+			m.MaxDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDuration |= Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFiredAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAlert
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastFiredAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastResolvedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAlert
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastResolvedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAlert(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAlert
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAlert(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,6 +6,7 @@ package edgeproto
 import (
 	context "context"
 	"encoding/json"
+	"errors"
 	fmt "fmt"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
 	"github.com/edgexr/edge-cloud-platform/pkg/objstore"
@@ -24,6 +25,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 	reflect "reflect"
+	"strconv"
 	strings "strings"
 )
 
@@ -38,6 +40,39 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AlertMetricsSource
+//
+// # AlertMetricsSource specifies which metrics a custom alert expression is evaluated against
+//
+// 0: `ALERT_METRICS_SOURCE_APP`
+// 1: `ALERT_METRICS_SOURCE_LOAD_BALANCER`
+type AlertMetricsSource int32
+
+const (
+	// Metrics exposed by the application pods, evaluated by the Kubernetes cluster Prometheus
+	AlertMetricsSource_ALERT_METRICS_SOURCE_APP AlertMetricsSource = 0
+	// Load balancer metrics such as envoy connection stats, evaluated by the cloudlet Prometheus
+	AlertMetricsSource_ALERT_METRICS_SOURCE_LOAD_BALANCER AlertMetricsSource = 1
+)
+
+var AlertMetricsSource_name = map[int32]string{
+	0: "ALERT_METRICS_SOURCE_APP",
+	1: "ALERT_METRICS_SOURCE_LOAD_BALANCER",
+}
+
+var AlertMetricsSource_value = map[string]int32{
+	"ALERT_METRICS_SOURCE_APP":           0,
+	"ALERT_METRICS_SOURCE_LOAD_BALANCER": 1,
+}
+
+func (x AlertMetricsSource) String() string {
+	return proto.EnumName(AlertMetricsSource_name, int32(x))
+}
+
+func (AlertMetricsSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_acb416d0b807474a, []int{0}
+}
+
 type AlertPolicyKey struct {
 	// Name of the organization for the app that this alert can be applied to
 	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
@@ -102,6 +137,10 @@ type AlertPolicy struct {
 	Description string `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`
 	// Preparing to be deleted
 	DeletePrepare bool `protobuf:"varint,12,opt,name=delete_prepare,json=deletePrepare,proto3" json:"delete_prepare,omitempty"`
+	// Custom Prometheus (PromQL) alert expression, for example "rate(http_errors_total[5m]) > 5". Label matchers that scope every metric to the AppInst are added automatically
+	CustomExpression string `protobuf:"bytes,13,opt,name=custom_expression,json=customExpression,proto3" json:"custom_expression,omitempty"`
+	// Metrics the custom expression is evaluated against, one of "App" (metrics exposed by the application) or "LoadBalancer" (load balancer metrics)
+	CustomMetricsSource AlertMetricsSource `protobuf:"varint,14,opt,name=custom_metrics_source,json=customMetricsSource,proto3,enum=edgeproto.AlertMetricsSource" json:"custom_metrics_source,omitempty"`
 }
 
 func (m *AlertPolicy) Reset()         { *m = AlertPolicy{} }
@@ -137,71 +176,127 @@ func (m *AlertPolicy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AlertPolicy proto.InternalMessageInfo
 
+// AlertPolicyHistory is an Alert Policy as of a past revision
+type AlertPolicyHistory struct {
+	// Database revision of the change
+	Revision int64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// AlertPolicy as of the revision
+	Obj AlertPolicy `protobuf:"bytes,2,opt,name=obj,proto3" json:"obj"`
+}
+
+func (m *AlertPolicyHistory) Reset()         { *m = AlertPolicyHistory{} }
+func (m *AlertPolicyHistory) String() string { return proto.CompactTextString(m) }
+func (*AlertPolicyHistory) ProtoMessage()    {}
+func (*AlertPolicyHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acb416d0b807474a, []int{2}
+}
+func (m *AlertPolicyHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlertPolicyHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlertPolicyHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlertPolicyHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertPolicyHistory.Merge(m, src)
+}
+func (m *AlertPolicyHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *AlertPolicyHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertPolicyHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertPolicyHistory proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("edgeproto.AlertMetricsSource", AlertMetricsSource_name, AlertMetricsSource_value)
 	proto.RegisterType((*AlertPolicyKey)(nil), "edgeproto.AlertPolicyKey")
 	proto.RegisterType((*AlertPolicy)(nil), "edgeproto.AlertPolicy")
 	proto.RegisterMapType((map[string]string)(nil), "edgeproto.AlertPolicy.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "edgeproto.AlertPolicy.LabelsEntry")
+	proto.RegisterType((*AlertPolicyHistory)(nil), "edgeproto.AlertPolicyHistory")
 }
 
 func init() { proto.RegisterFile("alertpolicy.proto", fileDescriptor_acb416d0b807474a) }
 
 var fileDescriptor_acb416d0b807474a = []byte{
-	// 856 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0xc7, 0x33, 0x9b, 0xcd, 0x36, 0x19, 0x27, 0x6d, 0xe2, 0x2e, 0xab, 0x69, 0xd4, 0x66, 0xa3,
-	0x5c, 0x88, 0x2a, 0x2b, 0x2e, 0x69, 0x91, 0x20, 0x52, 0x90, 0x92, 0x0d, 0x07, 0xd4, 0x16, 0x2a,
-	0x6f, 0x0b, 0xc7, 0xc8, 0x75, 0x1e, 0xee, 0x68, 0x6d, 0x8f, 0x35, 0xb6, 0x13, 0x85, 0x13, 0xe2,
-	0xc8, 0xa9, 0x82, 0x03, 0x85, 0x13, 0x47, 0xc4, 0x09, 0xf5, 0x82, 0xd4, 0xbf, 0x60, 0x0f, 0x1c,
-	0x2a, 0x21, 0x21, 0x4e, 0x15, 0x64, 0x39, 0xa0, 0x9e, 0x90, 0x36, 0x1b, 0x21, 0x4e, 0xc8, 0x63,
-	0x37, 0xeb, 0xfc, 0x28, 0x82, 0xbd, 0x70, 0x9b, 0x79, 0xef, 0xfb, 0x9d, 0xf9, 0x64, 0xf2, 0x7d,
-	0xc6, 0x25, 0xdd, 0x02, 0xee, 0xbb, 0xcc, 0xa2, 0xc6, 0xb8, 0xe1, 0x72, 0xe6, 0x33, 0x39, 0x07,
-	0x03, 0x13, 0xc4, 0xb2, 0x7c, 0xd9, 0x64, 0xcc, 0xb4, 0x40, 0xd5, 0x5d, 0xaa, 0xea, 0x8e, 0xc3,
-	0x7c, 0xdd, 0xa7, 0xcc, 0xf1, 0x22, 0x61, 0x39, 0xcf, 0xc1, 0x0b, 0x2c, 0x3f, 0xde, 0x5d, 0xf1,
-	0x19, 0xb3, 0x3c, 0x55, 0x6c, 0x4c, 0x70, 0xe6, 0x8b, 0xb8, 0xbd, 0x6d, 0x32, 0x93, 0x89, 0xa5,
-	0x1a, 0xae, 0xa2, 0x6a, 0x8d, 0xe1, 0xf3, 0x9d, 0x10, 0xe0, 0x8e, 0x00, 0xb8, 0x09, 0x63, 0xf9,
-	0x1a, 0xce, 0x33, 0x6e, 0xea, 0x0e, 0xfd, 0x48, 0xdc, 0x45, 0x50, 0x15, 0xd5, 0x73, 0xdd, 0xfc,
-	0x93, 0x19, 0xc9, 0x0a, 0x54, 0xc6, 0x4d, 0x6d, 0x41, 0x21, 0x5f, 0xc1, 0x9b, 0x8e, 0x6e, 0x03,
-	0xd9, 0x10, 0xca, 0xdc, 0x93, 0x19, 0xc9, 0x08, 0xa5, 0x26, 0xca, 0xad, 0xfc, 0xef, 0xc7, 0x04,
-	0xfd, 0x79, 0x4c, 0xd0, 0x77, 0x5f, 0xef, 0xa2, 0xda, 0x4f, 0xe7, 0xb0, 0x94, 0xb8, 0x51, 0xde,
-	0xc1, 0x5b, 0x1f, 0x52, 0xb0, 0x06, 0x1e, 0x41, 0xd5, 0x74, 0x3d, 0xa7, 0xc5, 0x3b, 0xf9, 0x35,
-	0x9c, 0x3e, 0x80, 0xb1, 0x38, 0x53, 0x6a, 0x5e, 0x6a, 0xcc, 0x9f, 0xa4, 0xb1, 0x88, 0xdb, 0xdd,
-	0x3c, 0x7c, 0xb6, 0x9b, 0xd2, 0x42, 0xad, 0xdc, 0xc4, 0xaf, 0x18, 0x6e, 0xd0, 0x0f, 0x7c, 0x6a,
-	0xc5, 0x68, 0x7d, 0x8b, 0xda, 0xd4, 0x27, 0xe9, 0x2a, 0xaa, 0x17, 0xb4, 0x8b, 0x86, 0x1b, 0xdc,
-	0x3b, 0xed, 0xdd, 0x0a, 0x5b, 0xa1, 0xc7, 0x06, 0x7b, 0x8d, 0x67, 0x33, 0xf2, 0xd8, 0x60, 0xaf,
-	0x78, 0x6e, 0xe0, 0x9d, 0x01, 0xf5, 0x0e, 0xd6, 0x98, 0x32, 0xc2, 0xb4, 0x1d, 0x76, 0x57, 0x5c,
-	0x57, 0x71, 0x49, 0x37, 0x7c, 0x3a, 0x84, 0xbe, 0xc1, 0x9c, 0x17, 0x86, 0x2d, 0x61, 0xb8, 0x10,
-	0x35, 0xf6, 0x98, 0x13, 0x6b, 0xcb, 0x38, 0xeb, 0xc1, 0x10, 0x38, 0xf5, 0xc7, 0xe4, 0x5c, 0xf8,
-	0xaa, 0xda, 0x7c, 0x2f, 0xab, 0x38, 0xef, 0x73, 0x6a, 0x9a, 0xc0, 0xfb, 0x3e, 0xb5, 0x81, 0x64,
-	0xab, 0xa8, 0x9e, 0xee, 0xe6, 0xff, 0x7a, 0xb6, 0x9b, 0xed, 0x05, 0x5c, 0x5c, 0xa8, 0x49, 0xb1,
-	0xe2, 0x2e, 0xb5, 0x41, 0x6e, 0xe1, 0x2d, 0x4b, 0xbf, 0x0f, 0x96, 0x47, 0x72, 0xd5, 0x74, 0x5d,
-	0x6a, 0xd6, 0xd6, 0x3f, 0x66, 0xe3, 0x96, 0x10, 0xbd, 0xed, 0xf8, 0x7c, 0xac, 0xc5, 0x0e, 0xf9,
-	0x1d, 0x2c, 0x25, 0x62, 0x47, 0xb0, 0x38, 0xe0, 0xd5, 0x97, 0x1c, 0xd0, 0x39, 0x55, 0x46, 0xa7,
-	0x24, 0xbd, 0x72, 0x15, 0x4b, 0x03, 0xf0, 0x0c, 0x4e, 0x5d, 0x11, 0x2b, 0x49, 0xfc, 0xac, 0x64,
-	0x49, 0xbe, 0x81, 0xcf, 0x0f, 0xc0, 0x02, 0x1f, 0xfa, 0x2e, 0x07, 0x57, 0xe7, 0x40, 0xf2, 0x55,
-	0x54, 0xcf, 0x76, 0x0b, 0xdf, 0x4c, 0x09, 0xfa, 0xec, 0xf1, 0xa5, 0x8c, 0xc3, 0x0c, 0xdb, 0xd5,
-	0x0a, 0x91, 0xe8, 0x4e, 0xa4, 0x29, 0xbf, 0x89, 0xa5, 0x04, 0xb9, 0x5c, 0x8c, 0x72, 0x23, 0x52,
-	0x1b, 0xc5, 0x62, 0x1b, 0x67, 0x86, 0xba, 0x15, 0xc4, 0xf9, 0xd4, 0xa2, 0x4d, 0x6b, 0xe3, 0x0d,
-	0x54, 0x7e, 0x0b, 0x17, 0x97, 0x99, 0xff, 0x8b, 0xbf, 0xf5, 0xe9, 0x46, 0x18, 0xed, 0x3f, 0x8e,
-	0x09, 0xfa, 0x78, 0x4a, 0xd0, 0xc3, 0x29, 0x41, 0x8f, 0x42, 0xd2, 0x13, 0x52, 0xe8, 0x25, 0x11,
-	0xbf, 0x3a, 0x21, 0x3f, 0xa0, 0x70, 0x14, 0xda, 0x37, 0x61, 0xdc, 0x78, 0x57, 0xb7, 0x41, 0x79,
-	0x31, 0x49, 0xa2, 0xf2, 0x5e, 0x62, 0x98, 0x14, 0xc3, 0x0d, 0x12, 0xb9, 0x6a, 0xef, 0xad, 0x66,
-	0x56, 0xb1, 0xc1, 0x4e, 0x4a, 0x6e, 0xaf, 0x46, 0x54, 0x09, 0x13, 0x98, 0xd4, 0xf4, 0xd6, 0x24,
-	0x52, 0x89, 0x52, 0x17, 0xa6, 0x11, 0x0c, 0xf1, 0x02, 0xed, 0xce, 0x62, 0x0e, 0x95, 0x38, 0x46,
-	0x61, 0xce, 0xda, 0x77, 0x4f, 0x23, 0xf5, 0x78, 0x46, 0x8a, 0x07, 0x30, 0x6e, 0x27, 0xc1, 0x9b,
-	0x5f, 0x66, 0x16, 0x3e, 0x25, 0x1d, 0x97, 0xca, 0xdf, 0x23, 0x5c, 0xda, 0xe3, 0xa0, 0xfb, 0xb0,
-	0x30, 0xf1, 0xeb, 0xe3, 0x53, 0x2e, 0x25, 0xea, 0x9a, 0xf8, 0xb0, 0xd5, 0x46, 0xcf, 0xa7, 0xe4,
-	0x75, 0x0d, 0x3c, 0x16, 0x70, 0x03, 0x7a, 0x30, 0x04, 0x8b, 0xb9, 0xc0, 0x23, 0xbd, 0xd2, 0x11,
-	0xd0, 0xb7, 0x75, 0x47, 0x37, 0x41, 0x59, 0x7e, 0xc7, 0xc9, 0x09, 0xc9, 0xee, 0xc7, 0x23, 0xf3,
-	0xed, 0x8c, 0x14, 0x97, 0xfb, 0x9f, 0xfc, 0xf8, 0xdb, 0xe7, 0x1b, 0xa4, 0x76, 0x51, 0x35, 0x04,
-	0x9f, 0x9a, 0xf8, 0x0a, 0xb7, 0xd0, 0x55, 0xf9, 0x0b, 0x84, 0x4b, 0xd1, 0x7f, 0x78, 0x46, 0xf2,
-	0x0f, 0xce, 0x4c, 0x3e, 0x27, 0x8b, 0x62, 0xbe, 0x8e, 0xec, 0x9e, 0x3b, 0xd0, 0xff, 0x4f, 0xb2,
-	0x40, 0xdc, 0xbf, 0x4c, 0xf6, 0x08, 0xe1, 0x0b, 0xfb, 0x0f, 0xd8, 0xe8, 0xdf, 0x70, 0xbd, 0xa4,
-	0x5e, 0xdb, 0x7f, 0x3e, 0x25, 0xd7, 0xff, 0x19, 0xee, 0x7d, 0x0a, 0xa3, 0xf5, 0x68, 0x3b, 0xb5,
-	0x92, 0xea, 0x3d, 0x60, 0xa3, 0x25, 0xb0, 0x6b, 0xa8, 0x7b, 0xf9, 0xf0, 0xd7, 0x4a, 0xea, 0x70,
-	0x52, 0x41, 0x4f, 0x27, 0x15, 0xf4, 0xcb, 0xa4, 0x82, 0x1e, 0x1e, 0x55, 0x52, 0x4f, 0x8f, 0x2a,
-	0xa9, 0x9f, 0x8f, 0x2a, 0xa9, 0xfb, 0x5b, 0x82, 0xe3, 0xfa, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x25, 0x5d, 0x4b, 0xbe, 0x8b, 0x07, 0x00, 0x00,
+	// 1066 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xc4, 0x4d, 0xb0, 0xc7, 0x4e, 0x6a, 0x4f, 0xd3, 0x68, 0x6a, 0x25, 0x8e, 0xb5, 0x07,
+	0xb0, 0x82, 0x65, 0x97, 0xb4, 0x48, 0x10, 0xc9, 0x48, 0xb6, 0x63, 0x89, 0xaa, 0x49, 0x13, 0xd6,
+	0x09, 0x48, 0x1c, 0x58, 0x36, 0xeb, 0xc7, 0x66, 0x9b, 0xdd, 0x9d, 0xd5, 0xee, 0x3a, 0xc1, 0x9c,
+	0x10, 0x7f, 0x41, 0x04, 0x07, 0xaa, 0x9e, 0x38, 0x22, 0x4e, 0xd0, 0x0b, 0x52, 0xff, 0x82, 0x5c,
+	0x40, 0x95, 0xb8, 0x70, 0xaa, 0x20, 0xe1, 0x80, 0x7a, 0x42, 0xca, 0x0f, 0x21, 0x4e, 0x68, 0x67,
+	0x36, 0xce, 0xfa, 0x47, 0x10, 0xe4, 0xc2, 0x6d, 0xe6, 0xbd, 0xef, 0x9b, 0xf9, 0xf6, 0xcd, 0xfb,
+	0xde, 0xe2, 0xac, 0x6a, 0x82, 0xeb, 0x3b, 0xcc, 0x34, 0xb4, 0x6e, 0xd9, 0x71, 0x99, 0xcf, 0x48,
+	0x12, 0xda, 0x3a, 0xf0, 0x65, 0x6e, 0x56, 0x67, 0x4c, 0x37, 0xa1, 0xa2, 0x3a, 0x46, 0x45, 0xb5,
+	0x6d, 0xe6, 0xab, 0xbe, 0xc1, 0x6c, 0x4f, 0x00, 0x73, 0x69, 0x17, 0xbc, 0x8e, 0xe9, 0x87, 0xbb,
+	0x39, 0x9f, 0x31, 0xd3, 0xab, 0xf0, 0x8d, 0x0e, 0x76, 0x6f, 0x11, 0xa6, 0xa7, 0x75, 0xa6, 0x33,
+	0xbe, 0xac, 0x04, 0x2b, 0x11, 0x95, 0x18, 0x9e, 0xaa, 0x05, 0x02, 0xd6, 0xb9, 0x80, 0xfb, 0xd0,
+	0x25, 0xb7, 0x71, 0x9a, 0xb9, 0xba, 0x6a, 0x1b, 0x9f, 0xf0, 0xbb, 0x28, 0x2a, 0xa0, 0x62, 0xb2,
+	0x9e, 0x7e, 0x7a, 0x46, 0x13, 0x5c, 0x2a, 0x73, 0x75, 0xb9, 0x0f, 0x41, 0xe6, 0xf0, 0x35, 0x5b,
+	0xb5, 0x80, 0x8e, 0x71, 0x64, 0xf2, 0xe9, 0x19, 0x1d, 0xe7, 0x48, 0x99, 0x87, 0x97, 0xd2, 0xbf,
+	0x1f, 0x53, 0xf4, 0xe7, 0x31, 0x45, 0xdf, 0x7e, 0x35, 0x8f, 0xa4, 0xc7, 0x49, 0x9c, 0x8a, 0xdc,
+	0x48, 0x66, 0xf0, 0xc4, 0x47, 0x06, 0x98, 0x6d, 0x8f, 0xa2, 0x42, 0xbc, 0x98, 0x94, 0xc3, 0x1d,
+	0x79, 0x0d, 0xc7, 0x77, 0xa0, 0xcb, 0xcf, 0x4c, 0x2d, 0xde, 0x2a, 0xf7, 0x4a, 0x52, 0xee, 0x97,
+	0x5b, 0xbf, 0x76, 0xf0, 0x7c, 0x3e, 0x26, 0x07, 0x58, 0xb2, 0x88, 0x6f, 0x6a, 0x4e, 0x47, 0xe9,
+	0xf8, 0x86, 0x19, 0x4a, 0x53, 0x4c, 0xc3, 0x32, 0x7c, 0x1a, 0x2f, 0xa0, 0xe2, 0xa4, 0x7c, 0x43,
+	0x73, 0x3a, 0x9b, 0x17, 0xb9, 0x95, 0x20, 0x15, 0x70, 0x2c, 0xb0, 0x46, 0x70, 0xae, 0x09, 0x8e,
+	0x05, 0xd6, 0x10, 0xe7, 0x2e, 0x9e, 0x69, 0x1b, 0xde, 0xce, 0x08, 0xd2, 0x38, 0x27, 0x4d, 0x07,
+	0xd9, 0x21, 0xd6, 0x02, 0xce, 0xaa, 0x9a, 0x6f, 0xec, 0x82, 0xa2, 0x31, 0xfb, 0x9c, 0x30, 0xc1,
+	0x09, 0xd7, 0x45, 0xa2, 0xc1, 0xec, 0x10, 0x9b, 0xc3, 0x09, 0x0f, 0x76, 0xc1, 0x35, 0xfc, 0x2e,
+	0x7d, 0x29, 0xa8, 0xaa, 0xdc, 0xdb, 0x93, 0x0a, 0x4e, 0xfb, 0xae, 0xa1, 0xeb, 0xe0, 0x2a, 0xbe,
+	0x61, 0x01, 0x4d, 0x14, 0x50, 0x31, 0x5e, 0x4f, 0xff, 0xf5, 0x7c, 0x3e, 0xb1, 0xdc, 0x71, 0xf9,
+	0x85, 0x72, 0x2a, 0x44, 0x6c, 0x18, 0x16, 0x90, 0x25, 0x3c, 0x61, 0xaa, 0x5b, 0x60, 0x7a, 0x34,
+	0x59, 0x88, 0x17, 0x53, 0x8b, 0xd2, 0xe8, 0x62, 0x96, 0x57, 0x38, 0xa8, 0x69, 0xfb, 0x6e, 0x57,
+	0x0e, 0x19, 0xe4, 0x1e, 0x4e, 0x45, 0xda, 0x8e, 0x62, 0x7e, 0xc0, 0x2b, 0x97, 0x1c, 0x50, 0xbb,
+	0x40, 0x8a, 0x53, 0xa2, 0x5c, 0x52, 0xc0, 0xa9, 0x36, 0x78, 0x9a, 0x6b, 0x38, 0xbc, 0xad, 0x52,
+	0xfc, 0xb3, 0xa2, 0x21, 0x72, 0x17, 0x4f, 0xb5, 0xc1, 0x04, 0x1f, 0x14, 0xc7, 0x05, 0x47, 0x75,
+	0x81, 0xa6, 0x0b, 0xa8, 0x98, 0xa8, 0x4f, 0x7e, 0x7d, 0x42, 0xd1, 0xe7, 0x4f, 0x6e, 0x8d, 0xdb,
+	0x4c, 0xb3, 0x1c, 0x79, 0x52, 0x80, 0xd6, 0x05, 0x86, 0xbc, 0x8a, 0xb3, 0x5a, 0xc7, 0xf3, 0x99,
+	0xa5, 0xc0, 0xc7, 0x8e, 0x0b, 0x9e, 0x17, 0x9c, 0x3e, 0xc9, 0x4f, 0xcf, 0x88, 0x44, 0xb3, 0x17,
+	0x27, 0xef, 0xe0, 0x9b, 0x21, 0xd8, 0x02, 0xdf, 0x35, 0x34, 0x4f, 0xf1, 0x58, 0xc7, 0xd5, 0x80,
+	0x4e, 0x15, 0x50, 0x71, 0x6a, 0x71, 0x6e, 0xf0, 0xcb, 0x56, 0x05, 0xaa, 0xc5, 0x41, 0xf2, 0x0d,
+	0xc1, 0xed, 0x0b, 0xe6, 0xde, 0xc4, 0xa9, 0x48, 0xe5, 0x48, 0x46, 0xf4, 0x2d, 0x77, 0x8d, 0x68,
+	0xcb, 0x69, 0x3c, 0xbe, 0xab, 0x9a, 0x9d, 0xd0, 0x1f, 0xb2, 0xd8, 0x2c, 0x8d, 0xbd, 0x81, 0x72,
+	0x6f, 0xe1, 0xcc, 0x60, 0xcd, 0xfe, 0x0b, 0x7f, 0xe9, 0xc7, 0xb1, 0xc0, 0x5a, 0x7f, 0x1c, 0x53,
+	0xf4, 0xe9, 0x09, 0x45, 0xfb, 0x27, 0x14, 0x3d, 0x0a, 0x2a, 0x75, 0x4a, 0x27, 0x97, 0xa3, 0x25,
+	0x7a, 0x7c, 0x4a, 0xf7, 0xc7, 0x02, 0x2b, 0x56, 0xef, 0x43, 0xb7, 0xfc, 0x40, 0xb5, 0xa0, 0x74,
+	0xee, 0x64, 0x1e, 0x59, 0x8b, 0x98, 0xb9, 0xa4, 0x39, 0x9d, 0x48, 0x5f, 0x57, 0x1b, 0xc3, 0x9e,
+	0x29, 0x59, 0x60, 0x45, 0x21, 0xab, 0xc3, 0x16, 0x29, 0x05, 0x0e, 0x88, 0x62, 0x96, 0x47, 0x38,
+	0xa2, 0x24, 0xba, 0x3e, 0x70, 0x03, 0x68, 0xbc, 0x02, 0xd5, 0x5a, 0xbf, 0x0f, 0x4a, 0x61, 0x1b,
+	0x07, 0x7d, 0x5e, 0xdd, 0xb8, 0x68, 0xe9, 0x92, 0x78, 0x87, 0x8b, 0xf7, 0xae, 0x36, 0x06, 0x1e,
+	0xba, 0x14, 0x3e, 0xaf, 0x78, 0xdd, 0x6a, 0x63, 0xf8, 0xdd, 0x9e, 0x9c, 0xd1, 0xcc, 0x0e, 0x74,
+	0xab, 0xd1, 0x8f, 0x97, 0x3e, 0xc4, 0x24, 0xd2, 0xd0, 0x6f, 0x1b, 0x9e, 0xcf, 0xdc, 0x6e, 0xe0,
+	0x46, 0x17, 0x76, 0x0d, 0xef, 0x7c, 0x1a, 0xc6, 0xe5, 0xde, 0x9e, 0x94, 0x71, 0x9c, 0x6d, 0x3d,
+	0x0c, 0xc7, 0xd4, 0xcc, 0x68, 0x63, 0x9c, 0xcf, 0x28, 0xb6, 0xf5, 0x70, 0xe1, 0xfd, 0xf0, 0x86,
+	0x3e, 0x2d, 0x64, 0x16, 0xd3, 0xda, 0x4a, 0x53, 0xde, 0x50, 0x56, 0x9b, 0x1b, 0xf2, 0xbd, 0x46,
+	0x4b, 0x69, 0xad, 0x6d, 0xca, 0x8d, 0xa6, 0x52, 0x5b, 0x5f, 0xcf, 0xc4, 0xc8, 0xcb, 0x58, 0x1a,
+	0x99, 0x5d, 0x59, 0xab, 0x2d, 0x2b, 0xf5, 0xda, 0x4a, 0xed, 0x41, 0xa3, 0x29, 0x67, 0xd0, 0xe2,
+	0x0f, 0x13, 0x7d, 0xc3, 0xbc, 0xe6, 0x18, 0xe4, 0x7b, 0x84, 0xb3, 0x0d, 0x17, 0x54, 0x1f, 0xfa,
+	0x66, 0xee, 0x68, 0x9d, 0xb9, 0x6c, 0x24, 0x2e, 0xf3, 0x5f, 0x8b, 0xb4, 0xf7, 0xe2, 0x84, 0xbe,
+	0x2e, 0x83, 0xa8, 0xe3, 0x32, 0xec, 0x82, 0xc9, 0x1c, 0x70, 0x05, 0xbe, 0x54, 0xe3, 0xcf, 0xb6,
+	0xaa, 0xda, 0xaa, 0x0e, 0xa5, 0xc1, 0x4e, 0x3a, 0x3c, 0xa5, 0x89, 0x56, 0x38, 0xb4, 0xbe, 0x39,
+	0xa3, 0x99, 0xc1, 0xfc, 0x67, 0x3f, 0xfd, 0xf6, 0xc5, 0x18, 0x95, 0x6e, 0x54, 0x34, 0xae, 0xaf,
+	0x12, 0xf9, 0x0f, 0x2e, 0xa1, 0x05, 0xf2, 0x25, 0xc2, 0x59, 0xd1, 0xc5, 0x57, 0x54, 0xfe, 0xde,
+	0x95, 0x95, 0xf7, 0x94, 0x89, 0x41, 0x33, 0x4a, 0xd9, 0xa6, 0xd3, 0x56, 0xff, 0x4f, 0x65, 0x1d,
+	0x7e, 0xff, 0xa0, 0xb2, 0x47, 0x08, 0x5f, 0x6f, 0x6d, 0xb3, 0xbd, 0x7f, 0xa3, 0xeb, 0x92, 0xb8,
+	0xd4, 0x7a, 0x71, 0x42, 0xef, 0xfc, 0xb3, 0xb8, 0x77, 0x0d, 0xd8, 0x1b, 0x2d, 0x6d, 0x46, 0xca,
+	0x56, 0xbc, 0x6d, 0xb6, 0x37, 0x20, 0xec, 0x36, 0x22, 0xdf, 0x21, 0x3c, 0x33, 0x20, 0xed, 0xdc,
+	0x5e, 0x97, 0x29, 0x9c, 0x1b, 0x1d, 0x0f, 0x69, 0xd2, 0x07, 0x57, 0x14, 0x7a, 0x70, 0x4a, 0x11,
+	0x17, 0x3b, 0x27, 0xd1, 0x21, 0xb1, 0xdb, 0xe2, 0x70, 0xae, 0xb9, 0x3e, 0x7b, 0xf0, 0x6b, 0x3e,
+	0x76, 0x70, 0x98, 0x47, 0xcf, 0x0e, 0xf3, 0xe8, 0x97, 0xc3, 0x3c, 0xda, 0x3f, 0xca, 0xc7, 0x9e,
+	0x1d, 0xe5, 0x63, 0x3f, 0x1f, 0xe5, 0x63, 0x5b, 0x13, 0x5c, 0xd9, 0x9d, 0xbf, 0x03, 0x00, 0x00,
+	0xff, 0xff, 0x6b, 0xf0, 0xa5, 0xcc, 0xc1, 0x09, 0x00, 0x00,
 }
 
 func (this *AlertPolicyKey) GoString() string {
@@ -244,6 +339,8 @@ type AlertPolicyApiClient interface {
 	UpdateAlertPolicy(ctx context.Context, in *AlertPolicy, opts ...grpc.CallOption) (*Result, error)
 	// Show Alert Policies. Any fields specified will be used to filter results.
 	ShowAlertPolicy(ctx context.Context, in *AlertPolicy, opts ...grpc.CallOption) (AlertPolicyApi_ShowAlertPolicyClient, error)
+	// Show the past revisions of an Alert Policy, newest first
+	ShowAlertPolicyHistory(ctx context.Context, in *AlertPolicy, opts ...grpc.CallOption) (AlertPolicyApi_ShowAlertPolicyHistoryClient, error)
 }
 
 type alertPolicyApiClient struct {
@@ -313,6 +410,38 @@ func (x *alertPolicyApiShowAlertPolicyClient) Recv() (*AlertPolicy, error) {
 	return m, nil
 }
 
+func (c *alertPolicyApiClient) ShowAlertPolicyHistory(ctx context.Context, in *AlertPolicy, opts ...grpc.CallOption) (AlertPolicyApi_ShowAlertPolicyHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AlertPolicyApi_serviceDesc.Streams[1], "/edgeproto.AlertPolicyApi/ShowAlertPolicyHistory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &alertPolicyApiShowAlertPolicyHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AlertPolicyApi_ShowAlertPolicyHistoryClient interface {
+	Recv() (*AlertPolicyHistory, error)
+	grpc.ClientStream
+}
+
+type alertPolicyApiShowAlertPolicyHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *alertPolicyApiShowAlertPolicyHistoryClient) Recv() (*AlertPolicyHistory, error) {
+	m := new(AlertPolicyHistory)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // AlertPolicyApiServer is the server API for AlertPolicyApi service.
 type AlertPolicyApiServer interface {
 	// Create an Alert Policy
@@ -323,6 +452,8 @@ type AlertPolicyApiServer interface {
 	UpdateAlertPolicy(context.Context, *AlertPolicy) (*Result, error)
 	// Show Alert Policies. Any fields specified will be used to filter results.
 	ShowAlertPolicy(*AlertPolicy, AlertPolicyApi_ShowAlertPolicyServer) error
+	// Show the past revisions of an Alert Policy, newest first
+	ShowAlertPolicyHistory(*AlertPolicy, AlertPolicyApi_ShowAlertPolicyHistoryServer) error
 }
 
 // UnimplementedAlertPolicyApiServer can be embedded to have forward compatible implementations.
@@ -341,6 +472,9 @@ func (*UnimplementedAlertPolicyApiServer) UpdateAlertPolicy(ctx context.Context,
 func (*UnimplementedAlertPolicyApiServer) ShowAlertPolicy(req *AlertPolicy, srv AlertPolicyApi_ShowAlertPolicyServer) error {
 	return status.Errorf(codes.Unimplemented, "method ShowAlertPolicy not implemented")
 }
+func (*UnimplementedAlertPolicyApiServer) ShowAlertPolicyHistory(req *AlertPolicy, srv AlertPolicyApi_ShowAlertPolicyHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method ShowAlertPolicyHistory not implemented")
+}
 
 func RegisterAlertPolicyApiServer(s *grpc.Server, srv AlertPolicyApiServer) {
 	s.RegisterService(&_AlertPolicyApi_serviceDesc, srv)
@@ -421,6 +555,27 @@ func (x *alertPolicyApiShowAlertPolicyServer) Send(m *AlertPolicy) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _AlertPolicyApi_ShowAlertPolicyHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AlertPolicy)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AlertPolicyApiServer).ShowAlertPolicyHistory(m, &alertPolicyApiShowAlertPolicyHistoryServer{stream})
+}
+
+type AlertPolicyApi_ShowAlertPolicyHistoryServer interface {
+	Send(*AlertPolicyHistory) error
+	grpc.ServerStream
+}
+
+type alertPolicyApiShowAlertPolicyHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *alertPolicyApiShowAlertPolicyHistoryServer) Send(m *AlertPolicyHistory) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _AlertPolicyApi_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "edgeproto.AlertPolicyApi",
 	HandlerType: (*AlertPolicyApiServer)(nil),
@@ -444,6 +599,11 @@ var _AlertPolicyApi_serviceDesc = grpc.ServiceDesc{
 			Handler:       _AlertPolicyApi_ShowAlertPolicy_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ShowAlertPolicyHistory",
+			Handler:       _AlertPolicyApi_ShowAlertPolicyHistory_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "alertpolicy.proto",
 }
@@ -505,6 +665,18 @@ func (m *AlertPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CustomMetricsSource != 0 {
+		i = encodeVarintAlertpolicy(dAtA, i, uint64(m.CustomMetricsSource))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.CustomExpression) > 0 {
+		i -= len(m.CustomExpression)
+		copy(dAtA[i:], m.CustomExpression)
+		i = encodeVarintAlertpolicy(dAtA, i, uint64(len(m.CustomExpression)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	if m.DeletePrepare {
 		i--
 		if m.DeletePrepare {
@@ -614,6 +786,44 @@ func (m *AlertPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AlertPolicyHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlertPolicyHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlertPolicyHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Obj.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAlertpolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Revision != 0 {
+		i = encodeVarintAlertpolicy(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAlertpolicy(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAlertpolicy(v)
 	base := offset
@@ -815,6 +1025,16 @@ func (m *AlertPolicy) Matches(o *AlertPolicy, fopts ...MatchOpt) bool {
 			}
 		}
 	}
+	if !opts.Filter || o.CustomExpression != "" {
+		if o.CustomExpression != m.CustomExpression {
+			return false
+		}
+	}
+	if !opts.Filter || o.CustomMetricsSource != 0 {
+		if o.CustomMetricsSource != m.CustomMetricsSource {
+			return false
+		}
+	}
 	return true
 }
 
@@ -835,6 +1055,8 @@ const AlertPolicyFieldAnnotationsKey = "10.1"
 const AlertPolicyFieldAnnotationsValue = "10.2"
 const AlertPolicyFieldDescription = "11"
 const AlertPolicyFieldDeletePrepare = "12"
+const AlertPolicyFieldCustomExpression = "13"
+const AlertPolicyFieldCustomMetricsSource = "14"
 
 var AlertPolicyAllFields = []string{
 	AlertPolicyFieldKeyOrganization,
@@ -851,6 +1073,8 @@ var AlertPolicyAllFields = []string{
 	AlertPolicyFieldAnnotationsValue,
 	AlertPolicyFieldDescription,
 	AlertPolicyFieldDeletePrepare,
+	AlertPolicyFieldCustomExpression,
+	AlertPolicyFieldCustomMetricsSource,
 }
 
 var AlertPolicyAllFieldsMap = NewFieldMap(map[string]struct{}{
@@ -868,6 +1092,8 @@ var AlertPolicyAllFieldsMap = NewFieldMap(map[string]struct{}{
 	AlertPolicyFieldAnnotationsValue:     struct{}{},
 	AlertPolicyFieldDescription:          struct{}{},
 	AlertPolicyFieldDeletePrepare:        struct{}{},
+	AlertPolicyFieldCustomExpression:     struct{}{},
+	AlertPolicyFieldCustomMetricsSource:  struct{}{},
 })
 
 var AlertPolicyAllFieldsStringMap = map[string]string{
@@ -885,6 +1111,8 @@ var AlertPolicyAllFieldsStringMap = map[string]string{
 	AlertPolicyFieldAnnotationsValue:     "Annotations Value",
 	AlertPolicyFieldDescription:          "Description",
 	AlertPolicyFieldDeletePrepare:        "Delete Prepare",
+	AlertPolicyFieldCustomExpression:     "Custom Expression",
+	AlertPolicyFieldCustomMetricsSource:  "Custom Metrics Source",
 }
 
 func (m *AlertPolicy) IsKeyField(s string) bool {
@@ -962,6 +1190,12 @@ func (m *AlertPolicy) DiffFields(o *AlertPolicy, fields *FieldMap) {
 	if m.DeletePrepare != o.DeletePrepare {
 		fields.Set(AlertPolicyFieldDeletePrepare)
 	}
+	if m.CustomExpression != o.CustomExpression {
+		fields.Set(AlertPolicyFieldCustomExpression)
+	}
+	if m.CustomMetricsSource != o.CustomMetricsSource {
+		fields.Set(AlertPolicyFieldCustomMetricsSource)
+	}
 }
 
 func (m *AlertPolicy) GetDiffFields(o *AlertPolicy) *FieldMap {
@@ -984,6 +1218,8 @@ var UpdateAlertPolicyFieldsMap = NewFieldMap(map[string]struct{}{
 	AlertPolicyFieldAnnotationsKey:       struct{}{},
 	AlertPolicyFieldAnnotationsValue:     struct{}{},
 	AlertPolicyFieldDescription:          struct{}{},
+	AlertPolicyFieldCustomExpression:     struct{}{},
+	AlertPolicyFieldCustomMetricsSource:  struct{}{},
 })
 
 func (m *AlertPolicy) ValidateUpdateFields() error {
@@ -1137,6 +1373,18 @@ func (m *AlertPolicy) CopyInFields(src *AlertPolicy) int {
 			changed++
 		}
 	}
+	if fmap.Has("13") {
+		if m.CustomExpression != src.CustomExpression {
+			m.CustomExpression = src.CustomExpression
+			changed++
+		}
+	}
+	if fmap.Has("14") {
+		if m.CustomMetricsSource != src.CustomMetricsSource {
+			m.CustomMetricsSource = src.CustomMetricsSource
+			changed++
+		}
+	}
 	return changed
 }
 
@@ -1166,6 +1414,8 @@ func (m *AlertPolicy) DeepCopyIn(src *AlertPolicy) {
 	}
 	m.Description = src.Description
 	m.DeletePrepare = src.DeletePrepare
+	m.CustomExpression = src.CustomExpression
+	m.CustomMetricsSource = src.CustomMetricsSource
 }
 
 func (s *AlertPolicy) HasFields() bool {
@@ -1833,6 +2083,9 @@ func (m *AlertPolicy) ValidateEnums() error {
 	if err := m.Key.ValidateEnums(); err != nil {
 		return err
 	}
+	if _, ok := AlertMetricsSource_name[int32(m.CustomMetricsSource)]; !ok {
+		return errors.New("invalid CustomMetricsSource")
+	}
 	return nil
 }
 
@@ -1855,6 +2108,257 @@ func IgnoreAlertPolicyFields(taglist string) cmp.Option {
 	return cmpopts.IgnoreFields(AlertPolicy{}, names...)
 }
 
+func (m *AlertPolicyHistory) Clone() *AlertPolicyHistory {
+	cp := &AlertPolicyHistory{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *AlertPolicyHistory) CopyInFields(src *AlertPolicyHistory) int {
+	updateListAction := "replace"
+	changed := 0
+	if m.Revision != src.Revision {
+		m.Revision = src.Revision
+		changed++
+	}
+	if m.Obj.Key.Organization != src.Obj.Key.Organization {
+		m.Obj.Key.Organization = src.Obj.Key.Organization
+		changed++
+	}
+	if m.Obj.Key.Name != src.Obj.Key.Name {
+		m.Obj.Key.Name = src.Obj.Key.Name
+		changed++
+	}
+	if m.Obj.CpuUtilizationLimit != src.Obj.CpuUtilizationLimit {
+		m.Obj.CpuUtilizationLimit = src.Obj.CpuUtilizationLimit
+		changed++
+	}
+	if m.Obj.MemUtilizationLimit != src.Obj.MemUtilizationLimit {
+		m.Obj.MemUtilizationLimit = src.Obj.MemUtilizationLimit
+		changed++
+	}
+	if m.Obj.DiskUtilizationLimit != src.Obj.DiskUtilizationLimit {
+		m.Obj.DiskUtilizationLimit = src.Obj.DiskUtilizationLimit
+		changed++
+	}
+	if m.Obj.ActiveConnLimit != src.Obj.ActiveConnLimit {
+		m.Obj.ActiveConnLimit = src.Obj.ActiveConnLimit
+		changed++
+	}
+	if m.Obj.Severity != src.Obj.Severity {
+		m.Obj.Severity = src.Obj.Severity
+		changed++
+	}
+	if m.Obj.TriggerTime != src.Obj.TriggerTime {
+		m.Obj.TriggerTime = src.Obj.TriggerTime
+		changed++
+	}
+	if src.Obj.Labels != nil {
+		if updateListAction == "add" {
+			for k1, v := range src.Obj.Labels {
+				m.Obj.Labels[k1] = v
+				changed++
+			}
+		} else if updateListAction == "remove" {
+			for k1, _ := range src.Obj.Labels {
+				if _, ok := m.Obj.Labels[k1]; ok {
+					delete(m.Obj.Labels, k1)
+					changed++
+				}
+			}
+		} else {
+			m.Obj.Labels = make(map[string]string)
+			for k1, v := range src.Obj.Labels {
+				m.Obj.Labels[k1] = v
+			}
+			changed++
+		}
+	} else if m.Obj.Labels != nil {
+		m.Obj.Labels = nil
+		changed++
+	}
+	if src.Obj.Annotations != nil {
+		if updateListAction == "add" {
+			for k1, v := range src.Obj.Annotations {
+				m.Obj.Annotations[k1] = v
+				changed++
+			}
+		} else if updateListAction == "remove" {
+			for k1, _ := range src.Obj.Annotations {
+				if _, ok := m.Obj.Annotations[k1]; ok {
+					delete(m.Obj.Annotations, k1)
+					changed++
+				}
+			}
+		} else {
+			m.Obj.Annotations = make(map[string]string)
+			for k1, v := range src.Obj.Annotations {
+				m.Obj.Annotations[k1] = v
+			}
+			changed++
+		}
+	} else if m.Obj.Annotations != nil {
+		m.Obj.Annotations = nil
+		changed++
+	}
+	if m.Obj.Description != src.Obj.Description {
+		m.Obj.Description = src.Obj.Description
+		changed++
+	}
+	if m.Obj.DeletePrepare != src.Obj.DeletePrepare {
+		m.Obj.DeletePrepare = src.Obj.DeletePrepare
+		changed++
+	}
+	if m.Obj.CustomExpression != src.Obj.CustomExpression {
+		m.Obj.CustomExpression = src.Obj.CustomExpression
+		changed++
+	}
+	if m.Obj.CustomMetricsSource != src.Obj.CustomMetricsSource {
+		m.Obj.CustomMetricsSource = src.Obj.CustomMetricsSource
+		changed++
+	}
+	return changed
+}
+
+func (m *AlertPolicyHistory) DeepCopyIn(src *AlertPolicyHistory) {
+	m.Revision = src.Revision
+	m.Obj.DeepCopyIn(&src.Obj)
+}
+
+// Helper method to check that enums have valid values
+func (m *AlertPolicyHistory) ValidateEnums() error {
+	if err := m.Obj.ValidateEnums(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *AlertPolicyHistory) ClearTagged(tags map[string]struct{}) {
+	s.Obj.ClearTagged(tags)
+}
+
+func IgnoreAlertPolicyHistoryFields(taglist string) cmp.Option {
+	names := []string{}
+	tags := make(map[string]struct{})
+	for _, tag := range strings.Split(taglist, ",") {
+		tags[tag] = struct{}{}
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Obj.DeletePrepare")
+	}
+	return cmpopts.IgnoreFields(AlertPolicyHistory{}, names...)
+}
+
+var AlertMetricsSourceStrings = []string{
+	"ALERT_METRICS_SOURCE_APP",
+	"ALERT_METRICS_SOURCE_LOAD_BALANCER",
+}
+
+const (
+	AlertMetricsSourceALERT_METRICS_SOURCE_APP           uint64 = 1 << 0
+	AlertMetricsSourceALERT_METRICS_SOURCE_LOAD_BALANCER uint64 = 1 << 1
+)
+
+var AlertMetricsSource_CamelName = map[int32]string{
+	// ALERT_METRICS_SOURCE_APP -> AlertMetricsSourceApp
+	0: "AlertMetricsSourceApp",
+	// ALERT_METRICS_SOURCE_LOAD_BALANCER -> AlertMetricsSourceLoadBalancer
+	1: "AlertMetricsSourceLoadBalancer",
+}
+var AlertMetricsSource_CamelValue = map[string]int32{
+	"AlertMetricsSourceApp":          0,
+	"AlertMetricsSourceLoadBalancer": 1,
+}
+
+func ParseAlertMetricsSource(data interface{}) (AlertMetricsSource, error) {
+	if val, ok := data.(AlertMetricsSource); ok {
+		return val, nil
+	} else if str, ok := data.(string); ok {
+		val, ok := AlertMetricsSource_CamelValue[util.CamelCase(str)]
+		if !ok {
+			// may have omitted common prefix
+			val, ok = AlertMetricsSource_CamelValue["AlertMetricsSource"+util.CamelCase(str)]
+		}
+		if !ok {
+			// may be int value instead of enum name
+			ival, err := strconv.Atoi(str)
+			val = int32(ival)
+			if err == nil {
+				_, ok = AlertMetricsSource_CamelName[val]
+			}
+		}
+		if !ok {
+			return AlertMetricsSource(0), fmt.Errorf("Invalid AlertMetricsSource value %q", str)
+		}
+		return AlertMetricsSource(val), nil
+	} else if ival, ok := data.(int32); ok {
+		if _, ok := AlertMetricsSource_CamelName[ival]; ok {
+			return AlertMetricsSource(ival), nil
+		} else {
+			return AlertMetricsSource(0), fmt.Errorf("Invalid AlertMetricsSource value %d", ival)
+		}
+	}
+	return AlertMetricsSource(0), fmt.Errorf("Invalid AlertMetricsSource value %v", data)
+}
+
+func (e *AlertMetricsSource) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	err := unmarshal(&str)
+	if err != nil {
+		return err
+	}
+	val, err := ParseAlertMetricsSource(str)
+	if err != nil {
+		return err
+	}
+	*e = val
+	return nil
+}
+
+func (e AlertMetricsSource) MarshalYAML() (interface{}, error) {
+	str := proto.EnumName(AlertMetricsSource_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "AlertMetricsSource")
+	return str, nil
+}
+
+// custom JSON encoding/decoding
+func (e *AlertMetricsSource) UnmarshalJSON(b []byte) error {
+	var str string
+	err := json.Unmarshal(b, &str)
+	if err == nil {
+		val, err := ParseAlertMetricsSource(str)
+		if err != nil {
+			return &json.UnmarshalTypeError{
+				Value: "string " + str,
+				Type:  reflect.TypeOf(AlertMetricsSource(0)),
+			}
+		}
+		*e = AlertMetricsSource(val)
+		return nil
+	}
+	var ival int32
+	err = json.Unmarshal(b, &ival)
+	if err == nil {
+		val, err := ParseAlertMetricsSource(ival)
+		if err == nil {
+			*e = val
+			return nil
+		}
+	}
+	return &json.UnmarshalTypeError{
+		Value: "value " + string(b),
+		Type:  reflect.TypeOf(AlertMetricsSource(0)),
+	}
+}
+
+func (e AlertMetricsSource) MarshalJSON() ([]byte, error) {
+	str := proto.EnumName(AlertMetricsSource_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "AlertMetricsSource")
+	return json.Marshal(str)
+}
+
+var AlertMetricsSourceCommonPrefix = "AlertMetricsSource"
+
 func (m *AlertPolicy) IsValidArgsForCreateAlertPolicy() error {
 	if m.DeletePrepare != false {
 		return fmt.Errorf("Invalid field specified: DeletePrepare, this field is only for internal use")
@@ -1949,6 +2453,27 @@ func (m *AlertPolicy) Size() (n int) {
 	if m.DeletePrepare {
 		n += 2
 	}
+	l = len(m.CustomExpression)
+	if l > 0 {
+		n += 1 + l + sovAlertpolicy(uint64(l))
+	}
+	if m.CustomMetricsSource != 0 {
+		n += 1 + sovAlertpolicy(uint64(m.CustomMetricsSource))
+	}
+	return n
+}
+
+func (m *AlertPolicyHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		n += 1 + sovAlertpolicy(uint64(m.Revision))
+	}
+	l = m.Obj.Size()
+	n += 1 + l + sovAlertpolicy(uint64(l))
 	return n
 }
 
@@ -2599,6 +3124,159 @@ func (m *AlertPolicy) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DeletePrepare = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomExpression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlertpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlertpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CustomExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomMetricsSource", wireType)
+			}
+			m.CustomMetricsSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CustomMetricsSource |= AlertMetricsSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAlertpolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAlertpolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlertPolicyHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAlertpolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlertPolicyHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlertPolicyHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Obj", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAlertpolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlertpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Obj.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAlertpolicy(dAtA[iNdEx:])
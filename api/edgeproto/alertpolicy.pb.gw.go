@@ -160,6 +160,31 @@ func request_AlertPolicyApi_ShowAlertPolicy_0(ctx context.Context, marshaler run
 
 }
 
+func request_AlertPolicyApi_ShowAlertPolicyHistory_0(ctx context.Context, marshaler runtime.Marshaler, client AlertPolicyApiClient, req *http.Request, pathParams map[string]string) (AlertPolicyApi_ShowAlertPolicyHistoryClient, runtime.ServerMetadata, error) {
+	var protoReq AlertPolicy
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	stream, err := client.ShowAlertPolicyHistory(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+
+}
+
 // RegisterAlertPolicyApiHandlerServer registers the http handlers for service AlertPolicyApi to "mux".
 // UnaryRPC     :call AlertPolicyApiServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
@@ -242,6 +267,13 @@ func RegisterAlertPolicyApiHandlerServer(ctx context.Context, mux *runtime.Serve
 		return
 	})
 
+	mux.Handle("POST", pattern_AlertPolicyApi_ShowAlertPolicyHistory_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+
 	return nil
 }
 
@@ -363,6 +395,26 @@ func RegisterAlertPolicyApiHandlerClient(ctx context.Context, mux *runtime.Serve
 
 	})
 
+	mux.Handle("POST", pattern_AlertPolicyApi_ShowAlertPolicyHistory_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_AlertPolicyApi_ShowAlertPolicyHistory_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_AlertPolicyApi_ShowAlertPolicyHistory_0(ctx, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+
+	})
+
 	return nil
 }
 
@@ -374,6 +426,8 @@ var (
 	pattern_AlertPolicyApi_UpdateAlertPolicy_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"update", "alertpolicy"}, "", runtime.AssumeColonVerbOpt(true)))
 
 	pattern_AlertPolicyApi_ShowAlertPolicy_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"show", "alertpolicy"}, "", runtime.AssumeColonVerbOpt(true)))
+
+	pattern_AlertPolicyApi_ShowAlertPolicyHistory_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"show", "alertpolicyhistory"}, "", runtime.AssumeColonVerbOpt(true)))
 )
 
 var (
@@ -384,4 +438,6 @@ var (
 	forward_AlertPolicyApi_UpdateAlertPolicy_0 = runtime.ForwardResponseMessage
 
 	forward_AlertPolicyApi_ShowAlertPolicy_0 = runtime.ForwardResponseStream
+
+	forward_AlertPolicyApi_ShowAlertPolicyHistory_0 = runtime.ForwardResponseStream
 )
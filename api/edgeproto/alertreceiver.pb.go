@@ -0,0 +1,2496 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: alertreceiver.proto
+
+package edgeproto
+
+import (
+	context "context"
+	"encoding/json"
+	"errors"
+	fmt "fmt"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/objstore"
+	"github.com/edgexr/edge-cloud-platform/pkg/util"
+	_ "github.com/edgexr/edge-cloud-platform/tools/protogen"
+	_ "github.com/gogo/googleapis/google/api"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	"go.etcd.io/etcd/client/v3/concurrency"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	"strconv"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AlertReceiverType
+//
+// # AlertReceiverType specifies how alert notifications are delivered
+//
+// 0: `ALERT_RECEIVER_TYPE_UNKNOWN`
+// 1: `ALERT_RECEIVER_TYPE_WEBHOOK`
+// 2: `ALERT_RECEIVER_TYPE_EMAIL`
+// 3: `ALERT_RECEIVER_TYPE_SLACK`
+// 4: `ALERT_RECEIVER_TYPE_PAGER_DUTY`
+type AlertReceiverType int32
+
+const (
+	// Unknown receiver type
+	AlertReceiverType_ALERT_RECEIVER_TYPE_UNKNOWN AlertReceiverType = 0
+	// Alerts are posted as JSON to a webhook URL
+	AlertReceiverType_ALERT_RECEIVER_TYPE_WEBHOOK AlertReceiverType = 1
+	// Alerts are sent by email via SMTP
+	AlertReceiverType_ALERT_RECEIVER_TYPE_EMAIL AlertReceiverType = 2
+	// Alerts are posted as messages to a Slack-compatible incoming webhook URL
+	AlertReceiverType_ALERT_RECEIVER_TYPE_SLACK AlertReceiverType = 3
+	// Alerts are sent as trigger and resolve events to a PagerDuty-style events API
+	AlertReceiverType_ALERT_RECEIVER_TYPE_PAGER_DUTY AlertReceiverType = 4
+)
+
+var AlertReceiverType_name = map[int32]string{
+	0: "ALERT_RECEIVER_TYPE_UNKNOWN",
+	1: "ALERT_RECEIVER_TYPE_WEBHOOK",
+	2: "ALERT_RECEIVER_TYPE_EMAIL",
+	3: "ALERT_RECEIVER_TYPE_SLACK",
+	4: "ALERT_RECEIVER_TYPE_PAGER_DUTY",
+}
+
+var AlertReceiverType_value = map[string]int32{
+	"ALERT_RECEIVER_TYPE_UNKNOWN":    0,
+	"ALERT_RECEIVER_TYPE_WEBHOOK":    1,
+	"ALERT_RECEIVER_TYPE_EMAIL":      2,
+	"ALERT_RECEIVER_TYPE_SLACK":      3,
+	"ALERT_RECEIVER_TYPE_PAGER_DUTY": 4,
+}
+
+func (x AlertReceiverType) String() string {
+	return proto.EnumName(AlertReceiverType_name, int32(x))
+}
+
+func (AlertReceiverType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e4b99b7c0dd1b431, []int{0}
+}
+
+type AlertReceiverKey struct {
+	// Name of the organization that the receiver belongs to
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	// Alert Receiver name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *AlertReceiverKey) Reset()         { *m = AlertReceiverKey{} }
+func (m *AlertReceiverKey) String() string { return proto.CompactTextString(m) }
+func (*AlertReceiverKey) ProtoMessage()    {}
+func (*AlertReceiverKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4b99b7c0dd1b431, []int{0}
+}
+func (m *AlertReceiverKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlertReceiverKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlertReceiverKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlertReceiverKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertReceiverKey.Merge(m, src)
+}
+func (m *AlertReceiverKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *AlertReceiverKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertReceiverKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertReceiverKey proto.InternalMessageInfo
+
+// AlertReceiver is sent notifications when alerts fire and resolve.
+// Receivers only receive alerts for their organization's Apps,
+// Clusters, and Cloudlets, except for receivers of the edge cloud
+// organization, which receive all alerts.
+type AlertReceiver struct {
+	// Fields are used for the Update API to specify which fields to apply
+	Fields []string `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	// Unique identifier key
+	Key AlertReceiverKey `protobuf:"bytes,2,opt,name=key,proto3" json:"key"`
+	// Receiver type, one of "Webhook", "Email", "Slack", or "PagerDuty"
+	Type AlertReceiverType `protobuf:"varint,3,opt,name=type,proto3,enum=edgeproto.AlertReceiverType" json:"type,omitempty"`
+	// Minimum alert severity to notify for, one of "info", "warning", "error". Defaults to all severities
+	Severity string `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
+	// Only notify for alerts with all of these labels
+	Labels map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Email address for Email receivers
+	Email string `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	// URL for Webhook and Slack receivers, optional events API URL for PagerDuty receivers
+	Url string `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
+	// Integration (routing) key for PagerDuty receivers
+	PagerDutyIntegrationKey string `protobuf:"bytes,8,opt,name=pager_duty_integration_key,json=pagerDutyIntegrationKey,proto3" json:"pager_duty_integration_key,omitempty"`
+}
+
+func (m *AlertReceiver) Reset()         { *m = AlertReceiver{} }
+func (m *AlertReceiver) String() string { return proto.CompactTextString(m) }
+func (*AlertReceiver) ProtoMessage()    {}
+func (*AlertReceiver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4b99b7c0dd1b431, []int{1}
+}
+func (m *AlertReceiver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlertReceiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlertReceiver.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlertReceiver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertReceiver.Merge(m, src)
+}
+func (m *AlertReceiver) XXX_Size() int {
+	return m.Size()
+}
+func (m *AlertReceiver) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertReceiver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertReceiver proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("edgeproto.AlertReceiverType", AlertReceiverType_name, AlertReceiverType_value)
+	proto.RegisterType((*AlertReceiverKey)(nil), "edgeproto.AlertReceiverKey")
+	proto.RegisterType((*AlertReceiver)(nil), "edgeproto.AlertReceiver")
+	proto.RegisterMapType((map[string]string)(nil), "edgeproto.AlertReceiver.LabelsEntry")
+}
+
+func init() { proto.RegisterFile("alertreceiver.proto", fileDescriptor_e4b99b7c0dd1b431) }
+
+var fileDescriptor_e4b99b7c0dd1b431 = []byte{
+	// 758 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x93, 0xbf, 0x4f, 0x1b, 0x49,
+	0x14, 0xc7, 0x3d, 0xfe, 0x75, 0x30, 0xc0, 0x69, 0x3d, 0xf6, 0xdd, 0xcd, 0x19, 0x58, 0x2c, 0xeb,
+	0x4e, 0xf2, 0x71, 0xc8, 0x8b, 0x4c, 0xc3, 0xf9, 0x8e, 0xc2, 0x86, 0xd5, 0x1d, 0xb2, 0xb1, 0xd1,
+	0x62, 0x40, 0x54, 0xd6, 0x62, 0xbf, 0x5b, 0x56, 0x2c, 0xbb, 0xd6, 0xee, 0x1a, 0xb4, 0xa9, 0x92,
+	0x74, 0xe9, 0x22, 0x21, 0x45, 0x51, 0xaa, 0xd4, 0x69, 0x12, 0xa5, 0x89, 0xc4, 0x5f, 0x40, 0x89,
+	0x94, 0x26, 0x55, 0x14, 0x4c, 0x8a, 0x88, 0x2a, 0x12, 0xc6, 0x4a, 0x19, 0xed, 0xd8, 0x22, 0xb6,
+	0x63, 0x4b, 0x89, 0x52, 0xa4, 0x7b, 0x6f, 0xbe, 0xdf, 0xf7, 0xe6, 0x33, 0x6f, 0x66, 0x70, 0x58,
+	0xd6, 0xc0, 0xb4, 0x4d, 0xa8, 0x80, 0x7a, 0x08, 0x66, 0xb2, 0x66, 0x1a, 0xb6, 0x41, 0x46, 0xa1,
+	0xaa, 0x00, 0x0b, 0xa3, 0x53, 0x8a, 0x61, 0x28, 0x1a, 0x08, 0x72, 0x4d, 0x15, 0x64, 0x5d, 0x37,
+	0x6c, 0xd9, 0x56, 0x0d, 0xdd, 0x6a, 0x1b, 0xa3, 0xe3, 0x26, 0x58, 0x75, 0xcd, 0xee, 0x64, 0xd3,
+	0xb6, 0x61, 0x68, 0x96, 0xc0, 0x12, 0x05, 0xf4, 0x9b, 0xa0, 0x23, 0x47, 0x14, 0x43, 0x31, 0x58,
+	0x28, 0xb8, 0x51, 0x7b, 0x35, 0x7e, 0x07, 0x61, 0x2e, 0xe3, 0x32, 0x48, 0x1d, 0x86, 0x1c, 0x38,
+	0x64, 0x11, 0x8f, 0x1b, 0xa6, 0x22, 0xeb, 0xea, 0x2d, 0xb6, 0x1d, 0x45, 0x31, 0x94, 0x18, 0xcd,
+	0x46, 0x4e, 0x5a, 0x94, 0xeb, 0xe1, 0x35, 0x4c, 0x45, 0xea, 0x71, 0x92, 0xdf, 0xb1, 0x5f, 0x97,
+	0x0f, 0x80, 0x7a, 0x59, 0x45, 0xe8, 0xa4, 0x45, 0x27, 0x7a, 0x2a, 0x24, 0x26, 0xa7, 0xc7, 0xdf,
+	0x5d, 0x51, 0xf4, 0xe1, 0x8a, 0xa2, 0x67, 0x8f, 0x67, 0x50, 0xfc, 0xdc, 0x87, 0x27, 0x7a, 0x18,
+	0xc8, 0xcf, 0x38, 0xf8, 0xbf, 0x0a, 0x5a, 0xd5, 0xa2, 0x28, 0xe6, 0x4b, 0x8c, 0x4a, 0x9d, 0x8c,
+	0x2c, 0x60, 0xdf, 0x3e, 0x38, 0xac, 0xfb, 0x58, 0x6a, 0x32, 0x79, 0x33, 0xa7, 0x64, 0xff, 0x11,
+	0xb2, 0xfe, 0xd3, 0xd7, 0x33, 0x1e, 0xc9, 0x75, 0x93, 0x79, 0xec, 0xb7, 0x9d, 0x1a, 0x50, 0x5f,
+	0x0c, 0x25, 0x7e, 0x4c, 0x4d, 0x0d, 0xab, 0x2a, 0x39, 0x35, 0x90, 0x98, 0x93, 0x44, 0xf1, 0x88,
+	0x05, 0x87, 0x60, 0xaa, 0xb6, 0x43, 0xfd, 0xee, 0x49, 0xa4, 0x9b, 0x9c, 0xfc, 0x83, 0x83, 0x9a,
+	0xbc, 0x0b, 0x9a, 0x45, 0x03, 0x31, 0x5f, 0x62, 0x2c, 0xf5, 0xdb, 0xb0, 0x7e, 0xc9, 0x3c, 0xb3,
+	0x89, 0xba, 0x6d, 0x3a, 0x52, 0xa7, 0x86, 0x44, 0x70, 0x00, 0x0e, 0x64, 0x55, 0xa3, 0x41, 0xd6,
+	0xb6, 0x9d, 0x10, 0x0e, 0xfb, 0xea, 0xa6, 0x46, 0x7f, 0x60, 0x6b, 0x6e, 0x48, 0xfe, 0xc6, 0xd1,
+	0x9a, 0xac, 0x80, 0x59, 0xae, 0xd6, 0x6d, 0xa7, 0xac, 0xea, 0x36, 0x28, 0x26, 0x9b, 0x70, 0xd9,
+	0x3d, 0xff, 0x08, 0x33, 0xfe, 0xc2, 0x1c, 0x2b, 0x75, 0xdb, 0x59, 0xfd, 0xa4, 0xe7, 0xc0, 0x89,
+	0xfe, 0x85, 0xc7, 0xba, 0xf6, 0x76, 0xbb, 0xbb, 0x45, 0xa8, 0xdd, 0xdd, 0x9d, 0x48, 0x04, 0x07,
+	0x0e, 0x65, 0xad, 0xde, 0xb9, 0x26, 0xa9, 0x9d, 0xa4, 0xbd, 0x8b, 0x28, 0xbd, 0xed, 0x5e, 0xcc,
+	0xfb, 0x2b, 0x8a, 0x6e, 0x37, 0x29, 0x7a, 0xd8, 0xa4, 0xe8, 0xd1, 0x35, 0x15, 0xdc, 0x2b, 0x5b,
+	0xca, 0x81, 0x93, 0x2c, 0xc8, 0x07, 0x30, 0xd7, 0xff, 0x02, 0x98, 0x52, 0xec, 0x7a, 0x04, 0xcf,
+	0x5b, 0x94, 0xdb, 0x07, 0x67, 0xa9, 0x7b, 0x6d, 0xf6, 0x05, 0xc2, 0xa1, 0xcf, 0xc6, 0x4d, 0x66,
+	0xf0, 0x64, 0x26, 0x2f, 0x4a, 0xa5, 0xb2, 0x24, 0x2e, 0x8b, 0xab, 0x5b, 0xa2, 0x54, 0x2e, 0xed,
+	0xac, 0x8b, 0xe5, 0xcd, 0x42, 0xae, 0x50, 0xdc, 0x2e, 0x70, 0x9e, 0x61, 0x86, 0x6d, 0x31, 0xfb,
+	0x5f, 0xb1, 0x98, 0xe3, 0x10, 0x99, 0xc6, 0xbf, 0x0e, 0x32, 0x88, 0x6b, 0x99, 0xd5, 0x3c, 0xe7,
+	0x1d, 0x26, 0x6f, 0xe4, 0x33, 0xcb, 0x39, 0xce, 0x47, 0xe2, 0x98, 0x1f, 0x24, 0xaf, 0x67, 0xfe,
+	0x15, 0xa5, 0xf2, 0xca, 0x66, 0x69, 0x87, 0xf3, 0xa7, 0xee, 0x05, 0xfa, 0x7e, 0x48, 0xa6, 0xa6,
+	0x92, 0xa7, 0x08, 0x87, 0x97, 0x4d, 0x90, 0x6d, 0xe8, 0x7d, 0xb8, 0x74, 0xd8, 0x6b, 0x88, 0x86,
+	0xba, 0x14, 0x89, 0x7d, 0xdb, 0xf8, 0xfe, 0x65, 0x93, 0xfe, 0x29, 0x81, 0x65, 0xd4, 0xcd, 0x4a,
+	0xbb, 0xcf, 0x5c, 0xa6, 0xe2, 0x4e, 0x6b, 0x4d, 0xd6, 0x65, 0x05, 0xe6, 0xfa, 0x07, 0xdb, 0xb8,
+	0xa6, 0x7e, 0x77, 0x6c, 0x4f, 0x5a, 0x94, 0xeb, 0xd7, 0xee, 0xbe, 0x7c, 0x7b, 0xec, 0x8d, 0xa6,
+	0xd1, 0x6c, 0xfc, 0x27, 0xa1, 0xc2, 0xc0, 0x84, 0x9e, 0x8b, 0x22, 0xc7, 0x08, 0x87, 0x57, 0x40,
+	0x83, 0x6f, 0x22, 0x2e, 0x7e, 0x25, 0x71, 0x37, 0x55, 0x95, 0x6d, 0x3e, 0x80, 0x6a, 0xb3, 0x56,
+	0x95, 0xbf, 0x1b, 0x55, 0x9d, 0x6d, 0xde, 0x47, 0xf5, 0x00, 0xe1, 0xd0, 0xc6, 0x9e, 0x71, 0xf4,
+	0xa5, 0x4c, 0x43, 0x95, 0x78, 0xee, 0xb2, 0x49, 0xff, 0x18, 0x84, 0xb6, 0xa5, 0xc2, 0xd1, 0x60,
+	0x30, 0xea, 0x82, 0x85, 0x05, 0x6b, 0xcf, 0x38, 0xea, 0xc5, 0x9a, 0x47, 0xd9, 0xa9, 0xd3, 0x73,
+	0xde, 0x73, 0xda, 0xe0, 0xd1, 0x59, 0x83, 0x47, 0x6f, 0x1a, 0x3c, 0xba, 0x7f, 0xc1, 0x7b, 0xce,
+	0x2e, 0x78, 0xcf, 0xab, 0x0b, 0xde, 0xb3, 0x1b, 0x64, 0x04, 0x0b, 0x1f, 0x07, 0x00, 0x5c, 0xe8,
+	0x23, 0xc9, 0x55, 0x06, 0x00, 0x00,
+}
+
+func (this *AlertReceiverKey) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&edgeproto.AlertReceiverKey{")
+	s = append(s, "Organization: "+fmt.Sprintf("%#v", this.Organization)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringAlertreceiver(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AlertReceiverApiClient is the client API for AlertReceiverApi service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AlertReceiverApiClient interface {
+	// Create an Alert Receiver
+	CreateAlertReceiver(ctx context.Context, in *AlertReceiver, opts ...grpc.CallOption) (*Result, error)
+	// Delete an Alert Receiver
+	DeleteAlertReceiver(ctx context.Context, in *AlertReceiver, opts ...grpc.CallOption) (*Result, error)
+	// Update an Alert Receiver
+	UpdateAlertReceiver(ctx context.Context, in *AlertReceiver, opts ...grpc.CallOption) (*Result, error)
+	// Show Alert Receivers. Any fields specified will be used to filter results.
+	ShowAlertReceiver(ctx context.Context, in *AlertReceiver, opts ...grpc.CallOption) (AlertReceiverApi_ShowAlertReceiverClient, error)
+}
+
+type alertReceiverApiClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAlertReceiverApiClient(cc *grpc.ClientConn) AlertReceiverApiClient {
+	return &alertReceiverApiClient{cc}
+}
+
+func (c *alertReceiverApiClient) CreateAlertReceiver(ctx context.Context, in *AlertReceiver, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/edgeproto.AlertReceiverApi/CreateAlertReceiver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertReceiverApiClient) DeleteAlertReceiver(ctx context.Context, in *AlertReceiver, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/edgeproto.AlertReceiverApi/DeleteAlertReceiver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertReceiverApiClient) UpdateAlertReceiver(ctx context.Context, in *AlertReceiver, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/edgeproto.AlertReceiverApi/UpdateAlertReceiver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertReceiverApiClient) ShowAlertReceiver(ctx context.Context, in *AlertReceiver, opts ...grpc.CallOption) (AlertReceiverApi_ShowAlertReceiverClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AlertReceiverApi_serviceDesc.Streams[0], "/edgeproto.AlertReceiverApi/ShowAlertReceiver", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &alertReceiverApiShowAlertReceiverClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AlertReceiverApi_ShowAlertReceiverClient interface {
+	Recv() (*AlertReceiver, error)
+	grpc.ClientStream
+}
+
+type alertReceiverApiShowAlertReceiverClient struct {
+	grpc.ClientStream
+}
+
+func (x *alertReceiverApiShowAlertReceiverClient) Recv() (*AlertReceiver, error) {
+	m := new(AlertReceiver)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AlertReceiverApiServer is the server API for AlertReceiverApi service.
+type AlertReceiverApiServer interface {
+	// Create an Alert Receiver
+	CreateAlertReceiver(context.Context, *AlertReceiver) (*Result, error)
+	// Delete an Alert Receiver
+	DeleteAlertReceiver(context.Context, *AlertReceiver) (*Result, error)
+	// Update an Alert Receiver
+	UpdateAlertReceiver(context.Context, *AlertReceiver) (*Result, error)
+	// Show Alert Receivers. Any fields specified will be used to filter results.
+	ShowAlertReceiver(*AlertReceiver, AlertReceiverApi_ShowAlertReceiverServer) error
+}
+
+// UnimplementedAlertReceiverApiServer can be embedded to have forward compatible implementations.
+type UnimplementedAlertReceiverApiServer struct {
+}
+
+func (*UnimplementedAlertReceiverApiServer) CreateAlertReceiver(ctx context.Context, req *AlertReceiver) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlertReceiver not implemented")
+}
+func (*UnimplementedAlertReceiverApiServer) DeleteAlertReceiver(ctx context.Context, req *AlertReceiver) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlertReceiver not implemented")
+}
+func (*UnimplementedAlertReceiverApiServer) UpdateAlertReceiver(ctx context.Context, req *AlertReceiver) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAlertReceiver not implemented")
+}
+func (*UnimplementedAlertReceiverApiServer) ShowAlertReceiver(req *AlertReceiver, srv AlertReceiverApi_ShowAlertReceiverServer) error {
+	return status.Errorf(codes.Unimplemented, "method ShowAlertReceiver not implemented")
+}
+
+func RegisterAlertReceiverApiServer(s *grpc.Server, srv AlertReceiverApiServer) {
+	s.RegisterService(&_AlertReceiverApi_serviceDesc, srv)
+}
+
+func _AlertReceiverApi_CreateAlertReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertReceiver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertReceiverApiServer).CreateAlertReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgeproto.AlertReceiverApi/CreateAlertReceiver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertReceiverApiServer).CreateAlertReceiver(ctx, req.(*AlertReceiver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertReceiverApi_DeleteAlertReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertReceiver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertReceiverApiServer).DeleteAlertReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgeproto.AlertReceiverApi/DeleteAlertReceiver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertReceiverApiServer).DeleteAlertReceiver(ctx, req.(*AlertReceiver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertReceiverApi_UpdateAlertReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertReceiver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertReceiverApiServer).UpdateAlertReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgeproto.AlertReceiverApi/UpdateAlertReceiver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertReceiverApiServer).UpdateAlertReceiver(ctx, req.(*AlertReceiver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertReceiverApi_ShowAlertReceiver_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AlertReceiver)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AlertReceiverApiServer).ShowAlertReceiver(m, &alertReceiverApiShowAlertReceiverServer{stream})
+}
+
+type AlertReceiverApi_ShowAlertReceiverServer interface {
+	Send(*AlertReceiver) error
+	grpc.ServerStream
+}
+
+type alertReceiverApiShowAlertReceiverServer struct {
+	grpc.ServerStream
+}
+
+func (x *alertReceiverApiShowAlertReceiverServer) Send(m *AlertReceiver) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _AlertReceiverApi_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "edgeproto.AlertReceiverApi",
+	HandlerType: (*AlertReceiverApiServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAlertReceiver",
+			Handler:    _AlertReceiverApi_CreateAlertReceiver_Handler,
+		},
+		{
+			MethodName: "DeleteAlertReceiver",
+			Handler:    _AlertReceiverApi_DeleteAlertReceiver_Handler,
+		},
+		{
+			MethodName: "UpdateAlertReceiver",
+			Handler:    _AlertReceiverApi_UpdateAlertReceiver_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ShowAlertReceiver",
+			Handler:       _AlertReceiverApi_ShowAlertReceiver_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "alertreceiver.proto",
+}
+
+func (m *AlertReceiverKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlertReceiverKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlertReceiverKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAlertreceiver(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Organization) > 0 {
+		i -= len(m.Organization)
+		copy(dAtA[i:], m.Organization)
+		i = encodeVarintAlertreceiver(dAtA, i, uint64(len(m.Organization)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlertReceiver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlertReceiver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlertReceiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PagerDutyIntegrationKey) > 0 {
+		i -= len(m.PagerDutyIntegrationKey)
+		copy(dAtA[i:], m.PagerDutyIntegrationKey)
+		i = encodeVarintAlertreceiver(dAtA, i, uint64(len(m.PagerDutyIntegrationKey)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintAlertreceiver(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintAlertreceiver(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintAlertreceiver(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAlertreceiver(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAlertreceiver(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Severity) > 0 {
+		i -= len(m.Severity)
+		copy(dAtA[i:], m.Severity)
+		i = encodeVarintAlertreceiver(dAtA, i, uint64(len(m.Severity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintAlertreceiver(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAlertreceiver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fields[iNdEx])
+			copy(dAtA[i:], m.Fields[iNdEx])
+			i = encodeVarintAlertreceiver(dAtA, i, uint64(len(m.Fields[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAlertreceiver(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAlertreceiver(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AlertReceiverKey) Matches(o *AlertReceiverKey, fopts ...MatchOpt) bool {
+	opts := MatchOptions{}
+	applyMatchOptions(&opts, fopts...)
+	if o == nil {
+		if opts.Filter {
+			return true
+		}
+		return false
+	}
+	if !opts.Filter || o.Organization != "" {
+		if o.Organization != m.Organization {
+			return false
+		}
+	}
+	if !opts.Filter || o.Name != "" {
+		if o.Name != m.Name {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *AlertReceiverKey) Clone() *AlertReceiverKey {
+	cp := &AlertReceiverKey{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *AlertReceiverKey) CopyInFields(src *AlertReceiverKey) int {
+	changed := 0
+	if m.Organization != src.Organization {
+		m.Organization = src.Organization
+		changed++
+	}
+	if m.Name != src.Name {
+		m.Name = src.Name
+		changed++
+	}
+	return changed
+}
+
+func (m *AlertReceiverKey) DeepCopyIn(src *AlertReceiverKey) {
+	m.Organization = src.Organization
+	m.Name = src.Name
+}
+
+func (m *AlertReceiverKey) GetKeyString() string {
+	key, err := json.Marshal(m)
+	if err != nil {
+		log.FatalLog("Failed to marshal AlertReceiverKey key string", "obj", m)
+	}
+	return string(key)
+}
+
+func AlertReceiverKeyStringParse(str string, key *AlertReceiverKey) {
+	err := json.Unmarshal([]byte(str), key)
+	if err != nil {
+		log.FatalLog("Failed to unmarshal AlertReceiverKey key string", "str", str)
+	}
+}
+
+func (m *AlertReceiverKey) NotFoundError() error {
+	return fmt.Errorf("AlertReceiver key %s not found", m.GetKeyString())
+}
+
+func (m *AlertReceiverKey) ExistsError() error {
+	return fmt.Errorf("AlertReceiver key %s already exists", m.GetKeyString())
+}
+
+func (m *AlertReceiverKey) BeingDeletedError() error {
+	return fmt.Errorf("AlertReceiver %s is being deleted", m.GetKeyString())
+}
+
+var AlertReceiverKeyTagOrganization = "alertreceiverorg"
+var AlertReceiverKeyTagName = "alertreceiver"
+
+func (m *AlertReceiverKey) GetTags() map[string]string {
+	tags := make(map[string]string)
+	m.AddTags(tags)
+	return tags
+}
+
+func (m *AlertReceiverKey) AddTagsByFunc(addTag AddTagFunc) {
+	addTag("alertreceiverorg", m.Organization)
+	addTag("alertreceiver", m.Name)
+}
+
+func (m *AlertReceiverKey) AddTags(tags map[string]string) {
+	tagMap := TagMap(tags)
+	m.AddTagsByFunc(tagMap.AddTag)
+}
+
+// Helper method to check that enums have valid values
+func (m *AlertReceiverKey) ValidateEnums() error {
+	return nil
+}
+
+func (s *AlertReceiverKey) ClearTagged(tags map[string]struct{}) {
+}
+
+func (m *AlertReceiver) Matches(o *AlertReceiver, fopts ...MatchOpt) bool {
+	opts := MatchOptions{}
+	applyMatchOptions(&opts, fopts...)
+	if o == nil {
+		if opts.Filter {
+			return true
+		}
+		return false
+	}
+	if !m.Key.Matches(&o.Key, fopts...) {
+		return false
+	}
+	if !opts.Filter || o.Type != 0 {
+		if o.Type != m.Type {
+			return false
+		}
+	}
+	if !opts.Filter || o.Severity != "" {
+		if o.Severity != m.Severity {
+			return false
+		}
+	}
+	if !opts.Filter || o.Labels != nil {
+		if len(m.Labels) == 0 && len(o.Labels) > 0 || len(m.Labels) > 0 && len(o.Labels) == 0 {
+			return false
+		} else if m.Labels != nil && o.Labels != nil {
+			if !opts.Filter && len(m.Labels) != len(o.Labels) {
+				return false
+			}
+			for k, _ := range o.Labels {
+				_, ok := m.Labels[k]
+				if !ok {
+					return false
+				}
+				if o.Labels[k] != m.Labels[k] {
+					return false
+				}
+			}
+		}
+	}
+	if !opts.Filter || o.Email != "" {
+		if o.Email != m.Email {
+			return false
+		}
+	}
+	if !opts.Filter || o.Url != "" {
+		if o.Url != m.Url {
+			return false
+		}
+	}
+	if !opts.Filter || o.PagerDutyIntegrationKey != "" {
+		if o.PagerDutyIntegrationKey != m.PagerDutyIntegrationKey {
+			return false
+		}
+	}
+	return true
+}
+
+const AlertReceiverFieldKey = "2"
+const AlertReceiverFieldKeyOrganization = "2.1"
+const AlertReceiverFieldKeyName = "2.2"
+const AlertReceiverFieldType = "3"
+const AlertReceiverFieldSeverity = "4"
+const AlertReceiverFieldLabels = "5"
+const AlertReceiverFieldLabelsKey = "5.1"
+const AlertReceiverFieldLabelsValue = "5.2"
+const AlertReceiverFieldEmail = "6"
+const AlertReceiverFieldUrl = "7"
+const AlertReceiverFieldPagerDutyIntegrationKey = "8"
+
+var AlertReceiverAllFields = []string{
+	AlertReceiverFieldKeyOrganization,
+	AlertReceiverFieldKeyName,
+	AlertReceiverFieldType,
+	AlertReceiverFieldSeverity,
+	AlertReceiverFieldLabelsKey,
+	AlertReceiverFieldLabelsValue,
+	AlertReceiverFieldEmail,
+	AlertReceiverFieldUrl,
+	AlertReceiverFieldPagerDutyIntegrationKey,
+}
+
+var AlertReceiverAllFieldsMap = NewFieldMap(map[string]struct{}{
+	AlertReceiverFieldKeyOrganization:         struct{}{},
+	AlertReceiverFieldKeyName:                 struct{}{},
+	AlertReceiverFieldType:                    struct{}{},
+	AlertReceiverFieldSeverity:                struct{}{},
+	AlertReceiverFieldLabelsKey:               struct{}{},
+	AlertReceiverFieldLabelsValue:             struct{}{},
+	AlertReceiverFieldEmail:                   struct{}{},
+	AlertReceiverFieldUrl:                     struct{}{},
+	AlertReceiverFieldPagerDutyIntegrationKey: struct{}{},
+})
+
+var AlertReceiverAllFieldsStringMap = map[string]string{
+	AlertReceiverFieldKeyOrganization:         "Key Organization",
+	AlertReceiverFieldKeyName:                 "Key Name",
+	AlertReceiverFieldType:                    "Type",
+	AlertReceiverFieldSeverity:                "Severity",
+	AlertReceiverFieldLabelsKey:               "Labels Key",
+	AlertReceiverFieldLabelsValue:             "Labels Value",
+	AlertReceiverFieldEmail:                   "Email",
+	AlertReceiverFieldUrl:                     "Url",
+	AlertReceiverFieldPagerDutyIntegrationKey: "Pager Duty Integration Key",
+}
+
+func (m *AlertReceiver) IsKeyField(s string) bool {
+	return strings.HasPrefix(s, AlertReceiverFieldKey+".") || s == AlertReceiverFieldKey
+}
+
+func (m *AlertReceiver) DiffFields(o *AlertReceiver, fields *FieldMap) {
+	if m.Key.Organization != o.Key.Organization {
+		fields.Set(AlertReceiverFieldKeyOrganization)
+		fields.Set(AlertReceiverFieldKey)
+	}
+	if m.Key.Name != o.Key.Name {
+		fields.Set(AlertReceiverFieldKeyName)
+		fields.Set(AlertReceiverFieldKey)
+	}
+	if m.Type != o.Type {
+		fields.Set(AlertReceiverFieldType)
+	}
+	if m.Severity != o.Severity {
+		fields.Set(AlertReceiverFieldSeverity)
+	}
+	if m.Labels != nil && o.Labels != nil {
+		if len(m.Labels) != len(o.Labels) {
+			fields.Set(AlertReceiverFieldLabels)
+		} else {
+			for k0, _ := range m.Labels {
+				_, vok0 := o.Labels[k0]
+				if !vok0 {
+					fields.Set(AlertReceiverFieldLabels)
+				} else {
+					if m.Labels[k0] != o.Labels[k0] {
+						fields.Set(AlertReceiverFieldLabels)
+						break
+					}
+				}
+			}
+		}
+	} else if (m.Labels != nil && o.Labels == nil) || (m.Labels == nil && o.Labels != nil) {
+		fields.Set(AlertReceiverFieldLabels)
+	}
+	if m.Email != o.Email {
+		fields.Set(AlertReceiverFieldEmail)
+	}
+	if m.Url != o.Url {
+		fields.Set(AlertReceiverFieldUrl)
+	}
+	if m.PagerDutyIntegrationKey != o.PagerDutyIntegrationKey {
+		fields.Set(AlertReceiverFieldPagerDutyIntegrationKey)
+	}
+}
+
+func (m *AlertReceiver) GetDiffFields(o *AlertReceiver) *FieldMap {
+	diffFields := NewFieldMap(nil)
+	m.DiffFields(o, diffFields)
+	return diffFields
+}
+
+var UpdateAlertReceiverFieldsMap = NewFieldMap(map[string]struct{}{
+	AlertReceiverFieldType:                    struct{}{},
+	AlertReceiverFieldSeverity:                struct{}{},
+	AlertReceiverFieldLabels:                  struct{}{},
+	AlertReceiverFieldLabelsKey:               struct{}{},
+	AlertReceiverFieldLabelsValue:             struct{}{},
+	AlertReceiverFieldEmail:                   struct{}{},
+	AlertReceiverFieldUrl:                     struct{}{},
+	AlertReceiverFieldPagerDutyIntegrationKey: struct{}{},
+})
+
+func (m *AlertReceiver) ValidateUpdateFields() error {
+	return m.ValidateUpdateFieldsCustom(UpdateAlertReceiverFieldsMap)
+}
+
+func (m *AlertReceiver) ValidateUpdateFieldsCustom(allowedFields *FieldMap) error {
+	if m.Fields == nil {
+		return fmt.Errorf("nothing specified to update")
+	}
+	fmap := MakeFieldMap(m.Fields)
+	badFieldStrs := []string{}
+	for _, field := range fmap.Fields() {
+		if m.IsKeyField(field) {
+			continue
+		}
+		if !allowedFields.Has(field) {
+			if _, ok := AlertReceiverAllFieldsStringMap[field]; !ok {
+				continue
+			}
+			badFieldStrs = append(badFieldStrs, AlertReceiverAllFieldsStringMap[field])
+		}
+	}
+	if len(badFieldStrs) > 0 {
+		return fmt.Errorf("specified field(s) %s cannot be modified", strings.Join(badFieldStrs, ","))
+	}
+	return nil
+}
+
+func (m *AlertReceiver) Clone() *AlertReceiver {
+	cp := &AlertReceiver{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *AlertReceiver) CopyInFields(src *AlertReceiver) int {
+	updateListAction := "replace"
+	changed := 0
+	fmap := MakeFieldMap(src.Fields)
+	if fmap.HasOrHasChild("2") {
+		if fmap.Has("2.1") {
+			if m.Key.Organization != src.Key.Organization {
+				m.Key.Organization = src.Key.Organization
+				changed++
+			}
+		}
+		if fmap.Has("2.2") {
+			if m.Key.Name != src.Key.Name {
+				m.Key.Name = src.Key.Name
+				changed++
+			}
+		}
+	}
+	if fmap.Has("3") {
+		if m.Type != src.Type {
+			m.Type = src.Type
+			changed++
+		}
+	}
+	if fmap.Has("4") {
+		if m.Severity != src.Severity {
+			m.Severity = src.Severity
+			changed++
+		}
+	}
+	if fmap.HasOrHasChild("5") {
+		if src.Labels != nil {
+			if updateListAction == "add" {
+				for k0, v := range src.Labels {
+					m.Labels[k0] = v
+					changed++
+				}
+			} else if updateListAction == "remove" {
+				for k0, _ := range src.Labels {
+					if _, ok := m.Labels[k0]; ok {
+						delete(m.Labels, k0)
+						changed++
+					}
+				}
+			} else {
+				m.Labels = make(map[string]string)
+				for k0, v := range src.Labels {
+					m.Labels[k0] = v
+				}
+				changed++
+			}
+		} else if m.Labels != nil {
+			m.Labels = nil
+			changed++
+		}
+	}
+	if fmap.Has("6") {
+		if m.Email != src.Email {
+			m.Email = src.Email
+			changed++
+		}
+	}
+	if fmap.Has("7") {
+		if m.Url != src.Url {
+			m.Url = src.Url
+			changed++
+		}
+	}
+	if fmap.Has("8") {
+		if m.PagerDutyIntegrationKey != src.PagerDutyIntegrationKey {
+			m.PagerDutyIntegrationKey = src.PagerDutyIntegrationKey
+			changed++
+		}
+	}
+	return changed
+}
+
+func (m *AlertReceiver) DeepCopyIn(src *AlertReceiver) {
+	m.Key.DeepCopyIn(&src.Key)
+	m.Type = src.Type
+	m.Severity = src.Severity
+	if src.Labels != nil {
+		m.Labels = make(map[string]string)
+		for k, v := range src.Labels {
+			m.Labels[k] = v
+		}
+	} else {
+		m.Labels = nil
+	}
+	m.Email = src.Email
+	m.Url = src.Url
+	m.PagerDutyIntegrationKey = src.PagerDutyIntegrationKey
+}
+
+func (s *AlertReceiver) HasFields() bool {
+	return true
+}
+
+type AlertReceiverStore interface {
+	Create(ctx context.Context, m *AlertReceiver, wait func(int64)) (*Result, error)
+	Update(ctx context.Context, m *AlertReceiver, wait func(int64)) (*Result, error)
+	Delete(ctx context.Context, m *AlertReceiver, wait func(int64)) (*Result, error)
+	Put(ctx context.Context, m *AlertReceiver, wait func(int64), ops ...objstore.KVOp) (*Result, error)
+	LoadOne(key string) (*AlertReceiver, int64, error)
+	Get(ctx context.Context, key *AlertReceiverKey, buf *AlertReceiver) bool
+	STMGet(stm concurrency.STM, key *AlertReceiverKey, buf *AlertReceiver) bool
+	STMPut(stm concurrency.STM, obj *AlertReceiver, ops ...objstore.KVOp)
+	STMDel(stm concurrency.STM, key *AlertReceiverKey)
+	STMHas(stm concurrency.STM, key *AlertReceiverKey) bool
+}
+
+type AlertReceiverStoreImpl struct {
+	kvstore objstore.KVStore
+}
+
+func NewAlertReceiverStore(kvstore objstore.KVStore) *AlertReceiverStoreImpl {
+	return &AlertReceiverStoreImpl{kvstore: kvstore}
+}
+
+func (s *AlertReceiverStoreImpl) Create(ctx context.Context, m *AlertReceiver, wait func(int64)) (*Result, error) {
+	err := m.Validate(AlertReceiverAllFieldsMap)
+	if err != nil {
+		return nil, err
+	}
+	key := objstore.DbKeyString("AlertReceiver", m.GetKey())
+	val, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	rev, err := s.kvstore.Create(ctx, key, string(val))
+	if err != nil {
+		return nil, err
+	}
+	if wait != nil {
+		wait(rev)
+	}
+	return &Result{}, err
+}
+
+func (s *AlertReceiverStoreImpl) Update(ctx context.Context, m *AlertReceiver, wait func(int64)) (*Result, error) {
+	fmap := MakeFieldMap(m.Fields)
+	err := m.Validate(fmap)
+	if err != nil {
+		return nil, err
+	}
+	key := objstore.DbKeyString("AlertReceiver", m.GetKey())
+	var vers int64 = 0
+	curBytes, vers, _, err := s.kvstore.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	var cur AlertReceiver
+	err = json.Unmarshal(curBytes, &cur)
+	if err != nil {
+		return nil, err
+	}
+	cur.CopyInFields(m)
+	// never save fields
+	cur.Fields = nil
+	val, err := json.Marshal(cur)
+	if err != nil {
+		return nil, err
+	}
+	rev, err := s.kvstore.Update(ctx, key, string(val), vers)
+	if err != nil {
+		return nil, err
+	}
+	if wait != nil {
+		wait(rev)
+	}
+	return &Result{}, err
+}
+
+func (s *AlertReceiverStoreImpl) Put(ctx context.Context, m *AlertReceiver, wait func(int64), ops ...objstore.KVOp) (*Result, error) {
+	err := m.Validate(AlertReceiverAllFieldsMap)
+	m.Fields = nil
+	if err != nil {
+		return nil, err
+	}
+	key := objstore.DbKeyString("AlertReceiver", m.GetKey())
+	var val []byte
+	val, err = json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	rev, err := s.kvstore.Put(ctx, key, string(val), ops...)
+	if err != nil {
+		return nil, err
+	}
+	if wait != nil {
+		wait(rev)
+	}
+	return &Result{}, err
+}
+
+func (s *AlertReceiverStoreImpl) Delete(ctx context.Context, m *AlertReceiver, wait func(int64)) (*Result, error) {
+	err := m.GetKey().ValidateKey()
+	if err != nil {
+		return nil, err
+	}
+	key := objstore.DbKeyString("AlertReceiver", m.GetKey())
+	rev, err := s.kvstore.Delete(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if wait != nil {
+		wait(rev)
+	}
+	return &Result{}, err
+}
+
+func (s *AlertReceiverStoreImpl) LoadOne(key string) (*AlertReceiver, int64, error) {
+	val, rev, _, err := s.kvstore.Get(key)
+	if err != nil {
+		return nil, 0, err
+	}
+	var obj AlertReceiver
+	err = json.Unmarshal(val, &obj)
+	if err != nil {
+		log.DebugLog(log.DebugLevelApi, "Failed to parse AlertReceiver data", "val", string(val), "err", err)
+		return nil, 0, err
+	}
+	return &obj, rev, nil
+}
+
+func (s *AlertReceiverStoreImpl) Get(ctx context.Context, key *AlertReceiverKey, buf *AlertReceiver) bool {
+	keystr := objstore.DbKeyString("AlertReceiver", key)
+	val, _, _, err := s.kvstore.Get(keystr)
+	if err != nil {
+		return false
+	}
+	return s.parseGetData(val, buf)
+}
+
+func (s *AlertReceiverStoreImpl) STMGet(stm concurrency.STM, key *AlertReceiverKey, buf *AlertReceiver) bool {
+	keystr := objstore.DbKeyString("AlertReceiver", key)
+	valstr := stm.Get(keystr)
+	return s.parseGetData([]byte(valstr), buf)
+}
+
+func (s *AlertReceiverStoreImpl) STMHas(stm concurrency.STM, key *AlertReceiverKey) bool {
+	keystr := objstore.DbKeyString("AlertReceiver", key)
+	return stm.Get(keystr) != ""
+}
+
+func (s *AlertReceiverStoreImpl) parseGetData(val []byte, buf *AlertReceiver) bool {
+	if len(val) == 0 {
+		return false
+	}
+	if buf != nil {
+		// clear buf, because empty values in val won't
+		// overwrite non-empty values in buf.
+		*buf = AlertReceiver{}
+		err := json.Unmarshal(val, buf)
+		if err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *AlertReceiverStoreImpl) STMPut(stm concurrency.STM, obj *AlertReceiver, ops ...objstore.KVOp) {
+	keystr := objstore.DbKeyString("AlertReceiver", obj.GetKey())
+
+	val, err := json.Marshal(obj)
+	if err != nil {
+		log.InfoLog("AlertReceiver json marshal failed", "obj", obj, "err", err)
+	}
+	v3opts := GetSTMOpts(ops...)
+	stm.Put(keystr, string(val), v3opts...)
+}
+
+func (s *AlertReceiverStoreImpl) STMDel(stm concurrency.STM, key *AlertReceiverKey) {
+	keystr := objstore.DbKeyString("AlertReceiver", key)
+	stm.Del(keystr)
+}
+
+func StoreListAlertReceiver(ctx context.Context, kvstore objstore.KVStore) ([]AlertReceiver, error) {
+	keyPrefix := objstore.DbKeyPrefixString("AlertReceiver") + "/"
+	objs := []AlertReceiver{}
+	err := kvstore.List(keyPrefix, func(key, val []byte, rev, modRev int64) error {
+		obj := AlertReceiver{}
+		err := json.Unmarshal(val, &obj)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal AlertReceiver json %s, %s", string(val), err)
+		}
+		objs = append(objs, obj)
+		return nil
+	})
+	return objs, err
+}
+
+type AlertReceiverKeyWatcher struct {
+	cb func(ctx context.Context)
+}
+
+type AlertReceiverCacheData struct {
+	Obj    *AlertReceiver
+	ModRev int64
+}
+
+func (s *AlertReceiverCacheData) Clone() *AlertReceiverCacheData {
+	cp := AlertReceiverCacheData{}
+	if s.Obj != nil {
+		cp.Obj = &AlertReceiver{}
+		cp.Obj.DeepCopyIn(s.Obj)
+	}
+	cp.ModRev = s.ModRev
+	return &cp
+}
+
+// AlertReceiverCache caches AlertReceiver objects in memory in a hash table
+// and keeps them in sync with the database.
+type AlertReceiverCache struct {
+	Objs          map[AlertReceiverKey]*AlertReceiverCacheData
+	Mux           util.Mutex
+	List          map[AlertReceiverKey]struct{}
+	FlushAll      bool
+	NotifyCbs     []func(ctx context.Context, obj *AlertReceiver, modRev int64)
+	UpdatedCbs    []func(ctx context.Context, old *AlertReceiver, new *AlertReceiver)
+	DeletedCbs    []func(ctx context.Context, old *AlertReceiver)
+	KeyWatchers   map[AlertReceiverKey][]*AlertReceiverKeyWatcher
+	UpdatedKeyCbs []func(ctx context.Context, key *AlertReceiverKey)
+	DeletedKeyCbs []func(ctx context.Context, key *AlertReceiverKey)
+	Store         AlertReceiverStore
+}
+
+func NewAlertReceiverCache() *AlertReceiverCache {
+	cache := AlertReceiverCache{}
+	InitAlertReceiverCache(&cache)
+	return &cache
+}
+
+func InitAlertReceiverCache(cache *AlertReceiverCache) {
+	cache.Objs = make(map[AlertReceiverKey]*AlertReceiverCacheData)
+	cache.KeyWatchers = make(map[AlertReceiverKey][]*AlertReceiverKeyWatcher)
+	cache.NotifyCbs = nil
+	cache.UpdatedCbs = nil
+	cache.DeletedCbs = nil
+	cache.UpdatedKeyCbs = nil
+	cache.DeletedKeyCbs = nil
+}
+
+func (c *AlertReceiverCache) GetTypeString() string {
+	return "AlertReceiver"
+}
+
+func (c *AlertReceiverCache) Get(key *AlertReceiverKey, valbuf *AlertReceiver) bool {
+	var modRev int64
+	return c.GetWithRev(key, valbuf, &modRev)
+}
+
+// STMGet gets from the store if STM is set, otherwise gets from cache
+func (c *AlertReceiverCache) STMGet(ostm *OptionalSTM, key *AlertReceiverKey, valbuf *AlertReceiver) bool {
+	if ostm.stm != nil {
+		if c.Store == nil {
+			// panic, otherwise if we fallback to cache, we may silently
+			// introduce race conditions and intermittent failures due to
+			// reading from cache during a transaction.
+			panic("AlertReceiverCache store not set, cannot read via STM")
+		}
+		return c.Store.STMGet(ostm.stm, key, valbuf)
+	}
+	var modRev int64
+	return c.GetWithRev(key, valbuf, &modRev)
+}
+
+func (c *AlertReceiverCache) GetWithRev(key *AlertReceiverKey, valbuf *AlertReceiver, modRev *int64) bool {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	inst, found := c.Objs[*key]
+	if found {
+		valbuf.DeepCopyIn(inst.Obj)
+		*modRev = inst.ModRev
+	}
+	return found
+}
+
+func (c *AlertReceiverCache) HasKey(key *AlertReceiverKey) bool {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	_, found := c.Objs[*key]
+	return found
+}
+
+func (c *AlertReceiverCache) GetAllKeys(ctx context.Context, cb func(key *AlertReceiverKey, modRev int64)) {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	for key, data := range c.Objs {
+		cb(&key, data.ModRev)
+	}
+}
+
+func (c *AlertReceiverCache) GetAllLocked(ctx context.Context, cb func(obj *AlertReceiver, modRev int64)) {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	for _, data := range c.Objs {
+		cb(data.Obj, data.ModRev)
+	}
+}
+
+func (c *AlertReceiverCache) Update(ctx context.Context, in *AlertReceiver, modRev int64) {
+	c.UpdateModFunc(ctx, in.GetKey(), modRev, func(old *AlertReceiver) (*AlertReceiver, bool) {
+		return in, true
+	})
+}
+
+func (c *AlertReceiverCache) UpdateModFunc(ctx context.Context, key *AlertReceiverKey, modRev int64, modFunc func(old *AlertReceiver) (new *AlertReceiver, changed bool)) {
+	c.Mux.Lock()
+	var old *AlertReceiver
+	if oldData, found := c.Objs[*key]; found {
+		old = oldData.Obj
+	}
+	new, changed := modFunc(old)
+	if !changed {
+		c.Mux.Unlock()
+		return
+	}
+	if len(c.UpdatedCbs) > 0 || len(c.NotifyCbs) > 0 {
+		newCopy := &AlertReceiver{}
+		newCopy.DeepCopyIn(new)
+		for _, cb := range c.UpdatedCbs {
+			defer cb(ctx, old, newCopy)
+		}
+		for _, cb := range c.NotifyCbs {
+			if cb != nil {
+				defer cb(ctx, newCopy, modRev)
+			}
+		}
+	}
+	for _, cb := range c.UpdatedKeyCbs {
+		defer cb(ctx, key)
+	}
+	store := &AlertReceiver{}
+	store.DeepCopyIn(new)
+	c.Objs[new.GetKeyVal()] = &AlertReceiverCacheData{
+		Obj:    store,
+		ModRev: modRev,
+	}
+	log.SpanLog(ctx, log.DebugLevelApi, "cache update", "new", store)
+	c.Mux.Unlock()
+	c.TriggerKeyWatchers(ctx, new.GetKey())
+}
+
+func (c *AlertReceiverCache) Delete(ctx context.Context, in *AlertReceiver, modRev int64) {
+	c.DeleteCondFunc(ctx, in, modRev, func(old *AlertReceiver) bool {
+		return true
+	})
+}
+
+func (c *AlertReceiverCache) DeleteCondFunc(ctx context.Context, in *AlertReceiver, modRev int64, condFunc func(old *AlertReceiver) bool) {
+	c.Mux.Lock()
+	var old *AlertReceiver
+	oldData, found := c.Objs[in.GetKeyVal()]
+	if found {
+		old = oldData.Obj
+		if !condFunc(old) {
+			c.Mux.Unlock()
+			return
+		}
+	}
+	delete(c.Objs, in.GetKeyVal())
+	log.SpanLog(ctx, log.DebugLevelApi, "cache delete", "key", in.GetKeyVal())
+	c.Mux.Unlock()
+	obj := old
+	if obj == nil {
+		obj = in
+	}
+	for _, cb := range c.NotifyCbs {
+		if cb != nil {
+			cb(ctx, obj, modRev)
+		}
+	}
+	if old != nil {
+		for _, cb := range c.DeletedCbs {
+			cb(ctx, old)
+		}
+	}
+	for _, cb := range c.DeletedKeyCbs {
+		cb(ctx, in.GetKey())
+	}
+	c.TriggerKeyWatchers(ctx, in.GetKey())
+}
+
+func (c *AlertReceiverCache) Prune(ctx context.Context, validKeys map[AlertReceiverKey]struct{}) {
+	log.SpanLog(ctx, log.DebugLevelApi, "Prune AlertReceiver", "numValidKeys", len(validKeys))
+	notify := make(map[AlertReceiverKey]*AlertReceiverCacheData)
+	c.Mux.Lock()
+	for key, _ := range c.Objs {
+		if _, ok := validKeys[key]; !ok {
+			if len(c.NotifyCbs) > 0 || len(c.DeletedKeyCbs) > 0 || len(c.DeletedCbs) > 0 {
+				notify[key] = c.Objs[key]
+			}
+			delete(c.Objs, key)
+		}
+	}
+	c.Mux.Unlock()
+	for key, old := range notify {
+		obj := old.Obj
+		if obj == nil {
+			obj = &AlertReceiver{}
+			obj.SetKey(&key)
+		}
+		for _, cb := range c.NotifyCbs {
+			if cb != nil {
+				cb(ctx, obj, old.ModRev)
+			}
+		}
+		for _, cb := range c.DeletedKeyCbs {
+			cb(ctx, &key)
+		}
+		if old.Obj != nil {
+			for _, cb := range c.DeletedCbs {
+				cb(ctx, old.Obj)
+			}
+		}
+		c.TriggerKeyWatchers(ctx, &key)
+	}
+}
+
+func (c *AlertReceiverCache) GetCount() int {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	return len(c.Objs)
+}
+
+func (c *AlertReceiverCache) Flush(ctx context.Context, notifyId int64) {
+}
+
+func (c *AlertReceiverCache) Show(filter *AlertReceiver, cb func(ret *AlertReceiver) error) error {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	for _, data := range c.Objs {
+		if !data.Obj.Matches(filter, MatchFilter()) {
+			continue
+		}
+		err := cb(data.Obj)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func AlertReceiverGenericNotifyCb(fn func(key *AlertReceiverKey, old *AlertReceiver)) func(objstore.ObjKey, objstore.Obj) {
+	return func(objkey objstore.ObjKey, obj objstore.Obj) {
+		fn(objkey.(*AlertReceiverKey), obj.(*AlertReceiver))
+	}
+}
+
+func (c *AlertReceiverCache) SetNotifyCb(fn func(ctx context.Context, obj *AlertReceiver, modRev int64)) {
+	c.NotifyCbs = []func(ctx context.Context, obj *AlertReceiver, modRev int64){fn}
+}
+
+func (c *AlertReceiverCache) SetUpdatedCb(fn func(ctx context.Context, old *AlertReceiver, new *AlertReceiver)) {
+	c.UpdatedCbs = []func(ctx context.Context, old *AlertReceiver, new *AlertReceiver){fn}
+}
+
+func (c *AlertReceiverCache) SetDeletedCb(fn func(ctx context.Context, old *AlertReceiver)) {
+	c.DeletedCbs = []func(ctx context.Context, old *AlertReceiver){fn}
+}
+
+func (c *AlertReceiverCache) SetUpdatedKeyCb(fn func(ctx context.Context, key *AlertReceiverKey)) {
+	c.UpdatedKeyCbs = []func(ctx context.Context, key *AlertReceiverKey){fn}
+}
+
+func (c *AlertReceiverCache) SetDeletedKeyCb(fn func(ctx context.Context, key *AlertReceiverKey)) {
+	c.DeletedKeyCbs = []func(ctx context.Context, key *AlertReceiverKey){fn}
+}
+
+func (c *AlertReceiverCache) AddUpdatedCb(fn func(ctx context.Context, old *AlertReceiver, new *AlertReceiver)) {
+	c.UpdatedCbs = append(c.UpdatedCbs, fn)
+}
+
+func (c *AlertReceiverCache) AddDeletedCb(fn func(ctx context.Context, old *AlertReceiver)) {
+	c.DeletedCbs = append(c.DeletedCbs, fn)
+}
+
+func (c *AlertReceiverCache) AddNotifyCb(fn func(ctx context.Context, obj *AlertReceiver, modRev int64)) {
+	c.NotifyCbs = append(c.NotifyCbs, fn)
+}
+
+func (c *AlertReceiverCache) AddUpdatedKeyCb(fn func(ctx context.Context, key *AlertReceiverKey)) {
+	c.UpdatedKeyCbs = append(c.UpdatedKeyCbs, fn)
+}
+
+func (c *AlertReceiverCache) AddDeletedKeyCb(fn func(ctx context.Context, key *AlertReceiverKey)) {
+	c.DeletedKeyCbs = append(c.DeletedKeyCbs, fn)
+}
+
+func (c *AlertReceiverCache) SetFlushAll() {
+	c.FlushAll = true
+}
+
+func (c *AlertReceiverCache) WatchKey(key *AlertReceiverKey, cb func(ctx context.Context)) context.CancelFunc {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	list, ok := c.KeyWatchers[*key]
+	if !ok {
+		list = make([]*AlertReceiverKeyWatcher, 0)
+	}
+	watcher := AlertReceiverKeyWatcher{cb: cb}
+	c.KeyWatchers[*key] = append(list, &watcher)
+	log.DebugLog(log.DebugLevelApi, "Watching AlertReceiver", "key", key)
+	return func() {
+		c.Mux.Lock()
+		defer c.Mux.Unlock()
+		list, ok := c.KeyWatchers[*key]
+		if !ok {
+			return
+		}
+		for ii, _ := range list {
+			if list[ii] != &watcher {
+				continue
+			}
+			if len(list) == 1 {
+				delete(c.KeyWatchers, *key)
+				return
+			}
+			list[ii] = list[len(list)-1]
+			list[len(list)-1] = nil
+			c.KeyWatchers[*key] = list[:len(list)-1]
+			return
+		}
+	}
+}
+
+func (c *AlertReceiverCache) TriggerKeyWatchers(ctx context.Context, key *AlertReceiverKey) {
+	watchers := make([]*AlertReceiverKeyWatcher, 0)
+	c.Mux.Lock()
+	if list, ok := c.KeyWatchers[*key]; ok {
+		watchers = append(watchers, list...)
+	}
+	c.Mux.Unlock()
+	for ii, _ := range watchers {
+		watchers[ii].cb(ctx)
+	}
+}
+
+// Note that we explicitly ignore the global revision number, because of the way
+// the notify framework sends updates (by hashing keys and doing lookups, instead
+// of sequentially through a history buffer), updates may be done out-of-order
+// or multiple updates compressed into one update, so the state of the cache at
+// any point in time may not by in sync with a particular database revision number.
+
+func (c *AlertReceiverCache) SyncUpdate(ctx context.Context, key, val []byte, rev, modRev int64) {
+	obj := AlertReceiver{}
+	err := json.Unmarshal(val, &obj)
+	if err != nil {
+		log.WarnLog("Failed to parse AlertReceiver data", "val", string(val), "err", err)
+		return
+	}
+	c.Update(ctx, &obj, modRev)
+	c.Mux.Lock()
+	if c.List != nil {
+		c.List[obj.GetKeyVal()] = struct{}{}
+	}
+	c.Mux.Unlock()
+}
+
+func (c *AlertReceiverCache) SyncDelete(ctx context.Context, key []byte, rev, modRev int64) {
+	obj := AlertReceiver{}
+	keystr := objstore.DbKeyPrefixRemove(string(key))
+	AlertReceiverKeyStringParse(keystr, obj.GetKey())
+	c.Delete(ctx, &obj, modRev)
+}
+
+func (c *AlertReceiverCache) SyncListStart(ctx context.Context) {
+	c.List = make(map[AlertReceiverKey]struct{})
+}
+
+func (c *AlertReceiverCache) SyncListEnd(ctx context.Context) {
+	deleted := make(map[AlertReceiverKey]*AlertReceiverCacheData)
+	c.Mux.Lock()
+	for key, val := range c.Objs {
+		if _, found := c.List[key]; !found {
+			deleted[key] = val
+			delete(c.Objs, key)
+		}
+	}
+	c.List = nil
+	c.Mux.Unlock()
+	for key, val := range deleted {
+		obj := val.Obj
+		if obj == nil {
+			obj = &AlertReceiver{}
+			obj.SetKey(&key)
+		}
+		for _, cb := range c.NotifyCbs {
+			if cb != nil {
+				cb(ctx, obj, val.ModRev)
+			}
+		}
+		for _, cb := range c.DeletedKeyCbs {
+			cb(ctx, &key)
+		}
+		if val.Obj != nil {
+			for _, cb := range c.DeletedCbs {
+				cb(ctx, val.Obj)
+			}
+		}
+		c.TriggerKeyWatchers(ctx, &key)
+	}
+}
+
+func (s *AlertReceiverCache) InitCacheWithSync(sync DataSync) {
+	InitAlertReceiverCache(s)
+	s.InitSync(sync)
+}
+
+func (s *AlertReceiverCache) InitSync(sync DataSync) {
+	if sync != nil {
+		s.Store = NewAlertReceiverStore(sync.GetKVStore())
+		sync.RegisterCache(s)
+	}
+}
+
+func InitAlertReceiverCacheWithStore(cache *AlertReceiverCache, store AlertReceiverStore) {
+	InitAlertReceiverCache(cache)
+	cache.Store = store
+}
+
+func (c *AlertReceiverCache) UsesOrg(org string) bool {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	for key, _ := range c.Objs {
+		if key.Organization == org {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *AlertReceiver) GetObjKey() objstore.ObjKey {
+	return m.GetKey()
+}
+
+func (m *AlertReceiver) GetKey() *AlertReceiverKey {
+	return &m.Key
+}
+
+func (m *AlertReceiver) GetKeyVal() AlertReceiverKey {
+	return m.Key
+}
+
+func (m *AlertReceiver) SetKey(key *AlertReceiverKey) {
+	m.Key = *key
+}
+
+func CmpSortAlertReceiver(a AlertReceiver, b AlertReceiver) bool {
+	return a.Key.GetKeyString() < b.Key.GetKeyString()
+}
+
+// Helper method to check that enums have valid values
+// NOTE: ValidateEnums checks all Fields even if some are not set
+func (m *AlertReceiver) ValidateEnums() error {
+	if err := m.Key.ValidateEnums(); err != nil {
+		return err
+	}
+	if _, ok := AlertReceiverType_name[int32(m.Type)]; !ok {
+		return errors.New("invalid Type")
+	}
+	return nil
+}
+
+func (s *AlertReceiver) ClearTagged(tags map[string]struct{}) {
+	s.Key.ClearTagged(tags)
+}
+
+var AlertReceiverTypeStrings = []string{
+	"ALERT_RECEIVER_TYPE_UNKNOWN",
+	"ALERT_RECEIVER_TYPE_WEBHOOK",
+	"ALERT_RECEIVER_TYPE_EMAIL",
+	"ALERT_RECEIVER_TYPE_SLACK",
+	"ALERT_RECEIVER_TYPE_PAGER_DUTY",
+}
+
+const (
+	AlertReceiverTypeALERT_RECEIVER_TYPE_UNKNOWN    uint64 = 1 << 0
+	AlertReceiverTypeALERT_RECEIVER_TYPE_WEBHOOK    uint64 = 1 << 1
+	AlertReceiverTypeALERT_RECEIVER_TYPE_EMAIL      uint64 = 1 << 2
+	AlertReceiverTypeALERT_RECEIVER_TYPE_SLACK      uint64 = 1 << 3
+	AlertReceiverTypeALERT_RECEIVER_TYPE_PAGER_DUTY uint64 = 1 << 4
+)
+
+var AlertReceiverType_CamelName = map[int32]string{
+	// ALERT_RECEIVER_TYPE_UNKNOWN -> AlertReceiverTypeUnknown
+	0: "AlertReceiverTypeUnknown",
+	// ALERT_RECEIVER_TYPE_WEBHOOK -> AlertReceiverTypeWebhook
+	1: "AlertReceiverTypeWebhook",
+	// ALERT_RECEIVER_TYPE_EMAIL -> AlertReceiverTypeEmail
+	2: "AlertReceiverTypeEmail",
+	// ALERT_RECEIVER_TYPE_SLACK -> AlertReceiverTypeSlack
+	3: "AlertReceiverTypeSlack",
+	// ALERT_RECEIVER_TYPE_PAGER_DUTY -> AlertReceiverTypePagerDuty
+	4: "AlertReceiverTypePagerDuty",
+}
+var AlertReceiverType_CamelValue = map[string]int32{
+	"AlertReceiverTypeUnknown":   0,
+	"AlertReceiverTypeWebhook":   1,
+	"AlertReceiverTypeEmail":     2,
+	"AlertReceiverTypeSlack":     3,
+	"AlertReceiverTypePagerDuty": 4,
+}
+
+func ParseAlertReceiverType(data interface{}) (AlertReceiverType, error) {
+	if val, ok := data.(AlertReceiverType); ok {
+		return val, nil
+	} else if str, ok := data.(string); ok {
+		val, ok := AlertReceiverType_CamelValue[util.CamelCase(str)]
+		if !ok {
+			// may have omitted common prefix
+			val, ok = AlertReceiverType_CamelValue["AlertReceiverType"+util.CamelCase(str)]
+		}
+		if !ok {
+			// may be int value instead of enum name
+			ival, err := strconv.Atoi(str)
+			val = int32(ival)
+			if err == nil {
+				_, ok = AlertReceiverType_CamelName[val]
+			}
+		}
+		if !ok {
+			return AlertReceiverType(0), fmt.Errorf("Invalid AlertReceiverType value %q", str)
+		}
+		return AlertReceiverType(val), nil
+	} else if ival, ok := data.(int32); ok {
+		if _, ok := AlertReceiverType_CamelName[ival]; ok {
+			return AlertReceiverType(ival), nil
+		} else {
+			return AlertReceiverType(0), fmt.Errorf("Invalid AlertReceiverType value %d", ival)
+		}
+	}
+	return AlertReceiverType(0), fmt.Errorf("Invalid AlertReceiverType value %v", data)
+}
+
+func (e *AlertReceiverType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	err := unmarshal(&str)
+	if err != nil {
+		return err
+	}
+	val, err := ParseAlertReceiverType(str)
+	if err != nil {
+		return err
+	}
+	*e = val
+	return nil
+}
+
+func (e AlertReceiverType) MarshalYAML() (interface{}, error) {
+	str := proto.EnumName(AlertReceiverType_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "AlertReceiverType")
+	return str, nil
+}
+
+// custom JSON encoding/decoding
+func (e *AlertReceiverType) UnmarshalJSON(b []byte) error {
+	var str string
+	err := json.Unmarshal(b, &str)
+	if err == nil {
+		val, err := ParseAlertReceiverType(str)
+		if err != nil {
+			return &json.UnmarshalTypeError{
+				Value: "string " + str,
+				Type:  reflect.TypeOf(AlertReceiverType(0)),
+			}
+		}
+		*e = AlertReceiverType(val)
+		return nil
+	}
+	var ival int32
+	err = json.Unmarshal(b, &ival)
+	if err == nil {
+		val, err := ParseAlertReceiverType(ival)
+		if err == nil {
+			*e = val
+			return nil
+		}
+	}
+	return &json.UnmarshalTypeError{
+		Value: "value " + string(b),
+		Type:  reflect.TypeOf(AlertReceiverType(0)),
+	}
+}
+
+func (e AlertReceiverType) MarshalJSON() ([]byte, error) {
+	str := proto.EnumName(AlertReceiverType_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "AlertReceiverType")
+	return json.Marshal(str)
+}
+
+var AlertReceiverTypeCommonPrefix = "AlertReceiverType"
+
+func (m *AlertReceiver) IsValidArgsForCreateAlertReceiver() error {
+	return nil
+}
+
+func (m *AlertReceiver) IsValidArgsForDeleteAlertReceiver() error {
+	return nil
+}
+
+func (m *AlertReceiver) IsValidArgsForUpdateAlertReceiver() error {
+	return nil
+}
+
+func (m *AlertReceiverKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Organization)
+	if l > 0 {
+		n += 1 + l + sovAlertreceiver(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAlertreceiver(uint64(l))
+	}
+	return n
+}
+
+func (m *AlertReceiver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovAlertreceiver(uint64(l))
+		}
+	}
+	l = m.Key.Size()
+	n += 1 + l + sovAlertreceiver(uint64(l))
+	if m.Type != 0 {
+		n += 1 + sovAlertreceiver(uint64(m.Type))
+	}
+	l = len(m.Severity)
+	if l > 0 {
+		n += 1 + l + sovAlertreceiver(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAlertreceiver(uint64(len(k))) + 1 + len(v) + sovAlertreceiver(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAlertreceiver(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovAlertreceiver(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovAlertreceiver(uint64(l))
+	}
+	l = len(m.PagerDutyIntegrationKey)
+	if l > 0 {
+		n += 1 + l + sovAlertreceiver(uint64(l))
+	}
+	return n
+}
+
+func sovAlertreceiver(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAlertreceiver(x uint64) (n int) {
+	return sovAlertreceiver(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AlertReceiverKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAlertreceiver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlertReceiverKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlertReceiverKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertreceiver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlertreceiver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlertreceiver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Organization = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertreceiver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlertreceiver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlertreceiver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAlertreceiver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAlertreceiver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlertReceiver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAlertreceiver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlertReceiver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlertReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertreceiver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlertreceiver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlertreceiver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertreceiver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAlertreceiver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlertreceiver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertreceiver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= AlertReceiverType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertreceiver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlertreceiver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlertreceiver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Severity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertreceiver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAlertreceiver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlertreceiver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAlertreceiver
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAlertreceiver
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAlertreceiver
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAlertreceiver
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAlertreceiver
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAlertreceiver
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAlertreceiver
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAlertreceiver(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAlertreceiver
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertreceiver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlertreceiver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlertreceiver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertreceiver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlertreceiver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlertreceiver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PagerDutyIntegrationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertreceiver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlertreceiver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlertreceiver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PagerDutyIntegrationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAlertreceiver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAlertreceiver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAlertreceiver(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAlertreceiver
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAlertreceiver
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAlertreceiver
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAlertreceiver
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAlertreceiver
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAlertreceiver
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAlertreceiver        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAlertreceiver          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAlertreceiver = fmt.Errorf("proto: unexpected end of group")
+)
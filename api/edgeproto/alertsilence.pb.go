@@ -0,0 +1,2507 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: alertsilence.proto
+
+package edgeproto
+
+import (
+	context "context"
+	"encoding/json"
+	fmt "fmt"
+	distributed_match_engine "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/objstore"
+	"github.com/edgexr/edge-cloud-platform/pkg/util"
+	_ "github.com/edgexr/edge-cloud-platform/tools/protogen"
+	_ "github.com/gogo/googleapis/google/api"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	"go.etcd.io/etcd/client/v3/concurrency"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type AlertSilenceKey struct {
+	// Name of the organization that the silence belongs to
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	// Alert Silence name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *AlertSilenceKey) Reset()         { *m = AlertSilenceKey{} }
+func (m *AlertSilenceKey) String() string { return proto.CompactTextString(m) }
+func (*AlertSilenceKey) ProtoMessage()    {}
+func (*AlertSilenceKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ea30e568c99f46, []int{0}
+}
+func (m *AlertSilenceKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlertSilenceKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlertSilenceKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlertSilenceKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertSilenceKey.Merge(m, src)
+}
+func (m *AlertSilenceKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *AlertSilenceKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertSilenceKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertSilenceKey proto.InternalMessageInfo
+
+// AlertMatcher matches an alert label
+type AlertMatcher struct {
+	// Label name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Label value, or a regular expression matching the whole label value if regex is set
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Match the value as a regular expression
+	Regex bool `protobuf:"varint,3,opt,name=regex,proto3" json:"regex,omitempty"`
+}
+
+func (m *AlertMatcher) Reset()         { *m = AlertMatcher{} }
+func (m *AlertMatcher) String() string { return proto.CompactTextString(m) }
+func (*AlertMatcher) ProtoMessage()    {}
+func (*AlertMatcher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ea30e568c99f46, []int{1}
+}
+func (m *AlertMatcher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlertMatcher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlertMatcher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlertMatcher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertMatcher.Merge(m, src)
+}
+func (m *AlertMatcher) XXX_Size() int {
+	return m.Size()
+}
+func (m *AlertMatcher) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertMatcher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertMatcher proto.InternalMessageInfo
+
+// AlertSilence suppresses matching alerts during a time window.
+// Silenced alerts are not sent to alert receivers and are not
+// shown by ShowAlert. Silences only apply to alerts for their
+// organization's Apps, Clusters, and Cloudlets, except for silences
+// of the edge cloud organization, which apply to all alerts.
+type AlertSilence struct {
+	// Fields are used for the Update API to specify which fields to apply
+	Fields []string `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	// Unique identifier key
+	Key AlertSilenceKey `protobuf:"bytes,2,opt,name=key,proto3" json:"key"`
+	// Alerts with labels that match all of the matchers are silenced
+	Matchers []AlertMatcher `protobuf:"bytes,3,rep,name=matchers,proto3" json:"matchers"`
+	// Start of the silence, defaults to the time the silence is created
+	StartTime distributed_match_engine.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time"`
+	// End of the silence
+	EndTime distributed_match_engine.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time"`
+	// Name of the user that created the silence
+	CreatedBy string `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// Reason for the silence
+	Comment string `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (m *AlertSilence) Reset()         { *m = AlertSilence{} }
+func (m *AlertSilence) String() string { return proto.CompactTextString(m) }
+func (*AlertSilence) ProtoMessage()    {}
+func (*AlertSilence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ea30e568c99f46, []int{2}
+}
+func (m *AlertSilence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlertSilence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlertSilence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlertSilence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertSilence.Merge(m, src)
+}
+func (m *AlertSilence) XXX_Size() int {
+	return m.Size()
+}
+func (m *AlertSilence) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertSilence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertSilence proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*AlertSilenceKey)(nil), "edgeproto.AlertSilenceKey")
+	proto.RegisterType((*AlertMatcher)(nil), "edgeproto.AlertMatcher")
+	proto.RegisterType((*AlertSilence)(nil), "edgeproto.AlertSilence")
+}
+
+func init() { proto.RegisterFile("alertsilence.proto", fileDescriptor_c2ea30e568c99f46) }
+
+var fileDescriptor_c2ea30e568c99f46 = []byte{
+	// 669 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x93, 0xbf, 0x6f, 0xd3, 0x4c,
+	0x18, 0xc7, 0x73, 0x4d, 0xfa, 0x23, 0xd7, 0xbc, 0x6a, 0xde, 0x7b, 0xab, 0xb7, 0xf7, 0x46, 0xad,
+	0x1b, 0xe5, 0x65, 0x08, 0x50, 0xd9, 0x28, 0x0c, 0x88, 0x4a, 0x1d, 0x1a, 0x3a, 0x20, 0xaa, 0x16,
+	0xc9, 0x85, 0xae, 0xd5, 0xc5, 0x7e, 0x70, 0xad, 0xda, 0x77, 0x91, 0x7d, 0xa1, 0x84, 0x01, 0x21,
+	0xfe, 0x82, 0x0a, 0x16, 0xc4, 0xc4, 0xc0, 0xc4, 0x84, 0x18, 0x18, 0xfa, 0x17, 0x74, 0xac, 0xc4,
+	0xc2, 0x84, 0xa0, 0x65, 0x40, 0x9d, 0x90, 0x12, 0x22, 0x46, 0xe4, 0xb3, 0xa9, 0x9c, 0xd0, 0x4a,
+	0x54, 0x0c, 0x6c, 0xf7, 0xfc, 0xfa, 0x7e, 0x3f, 0xbe, 0xe7, 0x8c, 0x09, 0xf3, 0x20, 0x90, 0xa1,
+	0xeb, 0x01, 0xb7, 0x40, 0x6f, 0x06, 0x42, 0x0a, 0x92, 0x07, 0xdb, 0x01, 0x75, 0x2c, 0x4d, 0x3b,
+	0x42, 0x38, 0x1e, 0x18, 0xac, 0xe9, 0x1a, 0x8c, 0x73, 0x21, 0x99, 0x74, 0x05, 0x0f, 0xe3, 0xc6,
+	0x52, 0x21, 0x80, 0xb0, 0xe5, 0xc9, 0x24, 0x9a, 0x91, 0x42, 0x78, 0xa1, 0xa1, 0x02, 0x07, 0xf8,
+	0xf1, 0x21, 0x29, 0x4f, 0x3a, 0xc2, 0x11, 0xea, 0x68, 0x44, 0xa7, 0x24, 0xfb, 0x97, 0xed, 0x83,
+	0xe1, 0x09, 0x2b, 0x0e, 0x2b, 0x0f, 0xf0, 0xc4, 0x62, 0x04, 0xb4, 0x16, 0x03, 0x2d, 0x43, 0x9b,
+	0x5c, 0xc1, 0x05, 0x11, 0x38, 0x8c, 0xbb, 0xf7, 0x95, 0x37, 0x45, 0x65, 0x54, 0xcd, 0xd7, 0xff,
+	0xd9, 0xed, 0xd1, 0x89, 0x34, 0xbb, 0x08, 0x1c, 0xb3, 0xaf, 0x91, 0x9c, 0xc3, 0x39, 0xce, 0x7c,
+	0xa0, 0x43, 0x6a, 0xa0, 0xb8, 0xdb, 0xa3, 0x85, 0xf4, 0x80, 0xa9, 0xaa, 0xf3, 0x85, 0xcf, 0x1d,
+	0x8a, 0xbe, 0x75, 0x28, 0x7a, 0xf5, 0x7c, 0x16, 0x55, 0x56, 0x71, 0x41, 0xf9, 0xaf, 0x30, 0x69,
+	0x6d, 0x42, 0x40, 0x48, 0xa2, 0xa1, 0x4c, 0xe3, 0x09, 0x32, 0x89, 0x87, 0xef, 0x32, 0xaf, 0x95,
+	0x08, 0x9b, 0x71, 0x10, 0x65, 0x03, 0x70, 0xe0, 0x1e, 0xcd, 0x96, 0x51, 0x75, 0xcc, 0x8c, 0x83,
+	0xca, 0x9b, 0x2c, 0x2e, 0xa4, 0x3f, 0x88, 0xfc, 0x8b, 0x47, 0xee, 0xb8, 0xe0, 0xd9, 0x21, 0x45,
+	0xe5, 0x6c, 0x35, 0x6f, 0x26, 0x11, 0xa9, 0xe1, 0xec, 0x16, 0xb4, 0x95, 0xe4, 0x78, 0xad, 0xa4,
+	0x1f, 0x6f, 0x40, 0x1f, 0xb8, 0x8e, 0x7a, 0x6e, 0xef, 0xfd, 0x6c, 0xc6, 0x8c, 0x9a, 0xc9, 0x55,
+	0x3c, 0xe6, 0xc7, 0x9c, 0x21, 0xcd, 0x96, 0xb3, 0xd5, 0xf1, 0xda, 0xd4, 0xe0, 0x60, 0xf2, 0x1d,
+	0xc9, 0xd4, 0x71, 0x3b, 0xb9, 0x8e, 0x71, 0x28, 0x59, 0x20, 0x37, 0xa4, 0xeb, 0x03, 0xcd, 0x29,
+	0xd7, 0xff, 0x75, 0xdb, 0x0d, 0x65, 0xe0, 0x36, 0x5a, 0x12, 0xec, 0x0d, 0xd5, 0xba, 0x01, 0xdc,
+	0x71, 0x39, 0xe8, 0xb7, 0x5c, 0x1f, 0x42, 0xc9, 0xfc, 0x66, 0x22, 0x94, 0x57, 0xc3, 0x51, 0x96,
+	0x2c, 0xe1, 0x31, 0xe0, 0x76, 0xac, 0x33, 0x7c, 0x56, 0x9d, 0x51, 0xe0, 0xb6, 0x52, 0x99, 0xc1,
+	0xd8, 0x0a, 0x80, 0x45, 0x03, 0x8d, 0x36, 0x1d, 0x51, 0x17, 0x9b, 0x4f, 0x32, 0xf5, 0x36, 0xa1,
+	0x78, 0xd4, 0x12, 0xbe, 0x0f, 0x5c, 0xd2, 0x51, 0x55, 0xfb, 0x11, 0xce, 0xaf, 0x47, 0xeb, 0xfb,
+	0xd2, 0xa1, 0xe8, 0x61, 0x97, 0xa2, 0xa7, 0x5d, 0x8a, 0x9e, 0x7d, 0xa5, 0x7a, 0xb4, 0xa6, 0x85,
+	0x65, 0x68, 0xeb, 0xab, 0xcc, 0x87, 0xb9, 0x81, 0x57, 0xa2, 0x0a, 0x37, 0x53, 0x0f, 0xe5, 0x75,
+	0x8f, 0x16, 0xb7, 0xa0, 0xbd, 0x90, 0xce, 0xd5, 0x3a, 0xb9, 0xfe, 0x97, 0xb8, 0xd8, 0x74, 0xc9,
+	0x0b, 0x84, 0xc9, 0x35, 0xc5, 0xd4, 0xb7, 0xd2, 0xa9, 0x53, 0xb6, 0x55, 0xfa, 0x3b, 0x55, 0x30,
+	0xd5, 0x9f, 0x52, 0x69, 0x1c, 0x75, 0xe9, 0x45, 0x13, 0x42, 0xd1, 0x0a, 0xac, 0x58, 0x65, 0x6e,
+	0xd1, 0x8a, 0x0c, 0x57, 0x18, 0x67, 0x0e, 0xcc, 0x0d, 0xb2, 0xbd, 0xec, 0xd1, 0xe2, 0x60, 0xee,
+	0xd1, 0xdb, 0x4f, 0x4f, 0x86, 0xfe, 0x9b, 0x47, 0x17, 0x2a, 0x93, 0x46, 0x7c, 0x45, 0x46, 0xfa,
+	0x13, 0xc9, 0x0e, 0xc2, 0x64, 0x09, 0x3c, 0xf8, 0x0d, 0xcc, 0xd5, 0x33, 0x62, 0xa6, 0x91, 0x6c,
+	0x65, 0xfd, 0x33, 0xd2, 0xed, 0xa6, 0xcd, 0xfe, 0x10, 0x52, 0x4b, 0x59, 0xf7, 0x23, 0x3d, 0x46,
+	0xb8, 0xb8, 0xb6, 0x29, 0xb6, 0x7f, 0x0d, 0xe8, 0xb4, 0x42, 0xe5, 0xc6, 0x51, 0x97, 0x9e, 0x3f,
+	0x09, 0x6b, 0xdd, 0x85, 0xed, 0x93, 0xa1, 0xa6, 0x22, 0x28, 0x62, 0x84, 0x9b, 0x62, 0xbb, 0x0f,
+	0xe9, 0x12, 0xaa, 0x4f, 0xef, 0x7d, 0xd4, 0x32, 0x7b, 0x07, 0x1a, 0xda, 0x3f, 0xd0, 0xd0, 0x87,
+	0x03, 0x0d, 0xed, 0x1c, 0x6a, 0x99, 0xfd, 0x43, 0x2d, 0xf3, 0xee, 0x50, 0xcb, 0x34, 0x46, 0x94,
+	0xff, 0xe5, 0xef, 0x03, 0x00, 0x9b, 0x6a, 0x9f, 0xb8, 0xb4, 0x05, 0x00, 0x00,
+}
+
+func (this *AlertSilenceKey) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&edgeproto.AlertSilenceKey{")
+	s = append(s, "Organization: "+fmt.Sprintf("%#v", this.Organization)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringAlertsilence(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AlertSilenceApiClient is the client API for AlertSilenceApi service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AlertSilenceApiClient interface {
+	// Create an Alert Silence
+	CreateAlertSilence(ctx context.Context, in *AlertSilence, opts ...grpc.CallOption) (*Result, error)
+	// Delete an Alert Silence
+	DeleteAlertSilence(ctx context.Context, in *AlertSilence, opts ...grpc.CallOption) (*Result, error)
+	// Update an Alert Silence
+	UpdateAlertSilence(ctx context.Context, in *AlertSilence, opts ...grpc.CallOption) (*Result, error)
+	// Show Alert Silences. Any fields specified will be used to filter results.
+	ShowAlertSilence(ctx context.Context, in *AlertSilence, opts ...grpc.CallOption) (AlertSilenceApi_ShowAlertSilenceClient, error)
+}
+
+type alertSilenceApiClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAlertSilenceApiClient(cc *grpc.ClientConn) AlertSilenceApiClient {
+	return &alertSilenceApiClient{cc}
+}
+
+func (c *alertSilenceApiClient) CreateAlertSilence(ctx context.Context, in *AlertSilence, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/edgeproto.AlertSilenceApi/CreateAlertSilence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertSilenceApiClient) DeleteAlertSilence(ctx context.Context, in *AlertSilence, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/edgeproto.AlertSilenceApi/DeleteAlertSilence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertSilenceApiClient) UpdateAlertSilence(ctx context.Context, in *AlertSilence, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/edgeproto.AlertSilenceApi/UpdateAlertSilence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertSilenceApiClient) ShowAlertSilence(ctx context.Context, in *AlertSilence, opts ...grpc.CallOption) (AlertSilenceApi_ShowAlertSilenceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AlertSilenceApi_serviceDesc.Streams[0], "/edgeproto.AlertSilenceApi/ShowAlertSilence", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &alertSilenceApiShowAlertSilenceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AlertSilenceApi_ShowAlertSilenceClient interface {
+	Recv() (*AlertSilence, error)
+	grpc.ClientStream
+}
+
+type alertSilenceApiShowAlertSilenceClient struct {
+	grpc.ClientStream
+}
+
+func (x *alertSilenceApiShowAlertSilenceClient) Recv() (*AlertSilence, error) {
+	m := new(AlertSilence)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AlertSilenceApiServer is the server API for AlertSilenceApi service.
+type AlertSilenceApiServer interface {
+	// Create an Alert Silence
+	CreateAlertSilence(context.Context, *AlertSilence) (*Result, error)
+	// Delete an Alert Silence
+	DeleteAlertSilence(context.Context, *AlertSilence) (*Result, error)
+	// Update an Alert Silence
+	UpdateAlertSilence(context.Context, *AlertSilence) (*Result, error)
+	// Show Alert Silences. Any fields specified will be used to filter results.
+	ShowAlertSilence(*AlertSilence, AlertSilenceApi_ShowAlertSilenceServer) error
+}
+
+// UnimplementedAlertSilenceApiServer can be embedded to have forward compatible implementations.
+type UnimplementedAlertSilenceApiServer struct {
+}
+
+func (*UnimplementedAlertSilenceApiServer) CreateAlertSilence(ctx context.Context, req *AlertSilence) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlertSilence not implemented")
+}
+func (*UnimplementedAlertSilenceApiServer) DeleteAlertSilence(ctx context.Context, req *AlertSilence) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlertSilence not implemented")
+}
+func (*UnimplementedAlertSilenceApiServer) UpdateAlertSilence(ctx context.Context, req *AlertSilence) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAlertSilence not implemented")
+}
+func (*UnimplementedAlertSilenceApiServer) ShowAlertSilence(req *AlertSilence, srv AlertSilenceApi_ShowAlertSilenceServer) error {
+	return status.Errorf(codes.Unimplemented, "method ShowAlertSilence not implemented")
+}
+
+func RegisterAlertSilenceApiServer(s *grpc.Server, srv AlertSilenceApiServer) {
+	s.RegisterService(&_AlertSilenceApi_serviceDesc, srv)
+}
+
+func _AlertSilenceApi_CreateAlertSilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertSilence)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertSilenceApiServer).CreateAlertSilence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgeproto.AlertSilenceApi/CreateAlertSilence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertSilenceApiServer).CreateAlertSilence(ctx, req.(*AlertSilence))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertSilenceApi_DeleteAlertSilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertSilence)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertSilenceApiServer).DeleteAlertSilence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgeproto.AlertSilenceApi/DeleteAlertSilence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertSilenceApiServer).DeleteAlertSilence(ctx, req.(*AlertSilence))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertSilenceApi_UpdateAlertSilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertSilence)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertSilenceApiServer).UpdateAlertSilence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgeproto.AlertSilenceApi/UpdateAlertSilence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertSilenceApiServer).UpdateAlertSilence(ctx, req.(*AlertSilence))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertSilenceApi_ShowAlertSilence_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AlertSilence)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AlertSilenceApiServer).ShowAlertSilence(m, &alertSilenceApiShowAlertSilenceServer{stream})
+}
+
+type AlertSilenceApi_ShowAlertSilenceServer interface {
+	Send(*AlertSilence) error
+	grpc.ServerStream
+}
+
+type alertSilenceApiShowAlertSilenceServer struct {
+	grpc.ServerStream
+}
+
+func (x *alertSilenceApiShowAlertSilenceServer) Send(m *AlertSilence) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _AlertSilenceApi_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "edgeproto.AlertSilenceApi",
+	HandlerType: (*AlertSilenceApiServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAlertSilence",
+			Handler:    _AlertSilenceApi_CreateAlertSilence_Handler,
+		},
+		{
+			MethodName: "DeleteAlertSilence",
+			Handler:    _AlertSilenceApi_DeleteAlertSilence_Handler,
+		},
+		{
+			MethodName: "UpdateAlertSilence",
+			Handler:    _AlertSilenceApi_UpdateAlertSilence_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ShowAlertSilence",
+			Handler:       _AlertSilenceApi_ShowAlertSilence_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "alertsilence.proto",
+}
+
+func (m *AlertSilenceKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlertSilenceKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlertSilenceKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAlertsilence(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Organization) > 0 {
+		i -= len(m.Organization)
+		copy(dAtA[i:], m.Organization)
+		i = encodeVarintAlertsilence(dAtA, i, uint64(len(m.Organization)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlertMatcher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlertMatcher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlertMatcher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Regex {
+		i--
+		if m.Regex {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintAlertsilence(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAlertsilence(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlertSilence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlertSilence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlertSilence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintAlertsilence(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CreatedBy) > 0 {
+		i -= len(m.CreatedBy)
+		copy(dAtA[i:], m.CreatedBy)
+		i = encodeVarintAlertsilence(dAtA, i, uint64(len(m.CreatedBy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.EndTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAlertsilence(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAlertsilence(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Matchers) > 0 {
+		for iNdEx := len(m.Matchers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Matchers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAlertsilence(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAlertsilence(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fields[iNdEx])
+			copy(dAtA[i:], m.Fields[iNdEx])
+			i = encodeVarintAlertsilence(dAtA, i, uint64(len(m.Fields[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAlertsilence(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAlertsilence(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AlertSilenceKey) Matches(o *AlertSilenceKey, fopts ...MatchOpt) bool {
+	opts := MatchOptions{}
+	applyMatchOptions(&opts, fopts...)
+	if o == nil {
+		if opts.Filter {
+			return true
+		}
+		return false
+	}
+	if !opts.Filter || o.Organization != "" {
+		if o.Organization != m.Organization {
+			return false
+		}
+	}
+	if !opts.Filter || o.Name != "" {
+		if o.Name != m.Name {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *AlertSilenceKey) Clone() *AlertSilenceKey {
+	cp := &AlertSilenceKey{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *AlertSilenceKey) CopyInFields(src *AlertSilenceKey) int {
+	changed := 0
+	if m.Organization != src.Organization {
+		m.Organization = src.Organization
+		changed++
+	}
+	if m.Name != src.Name {
+		m.Name = src.Name
+		changed++
+	}
+	return changed
+}
+
+func (m *AlertSilenceKey) DeepCopyIn(src *AlertSilenceKey) {
+	m.Organization = src.Organization
+	m.Name = src.Name
+}
+
+func (m *AlertSilenceKey) GetKeyString() string {
+	key, err := json.Marshal(m)
+	if err != nil {
+		log.FatalLog("Failed to marshal AlertSilenceKey key string", "obj", m)
+	}
+	return string(key)
+}
+
+func AlertSilenceKeyStringParse(str string, key *AlertSilenceKey) {
+	err := json.Unmarshal([]byte(str), key)
+	if err != nil {
+		log.FatalLog("Failed to unmarshal AlertSilenceKey key string", "str", str)
+	}
+}
+
+func (m *AlertSilenceKey) NotFoundError() error {
+	return fmt.Errorf("AlertSilence key %s not found", m.GetKeyString())
+}
+
+func (m *AlertSilenceKey) ExistsError() error {
+	return fmt.Errorf("AlertSilence key %s already exists", m.GetKeyString())
+}
+
+func (m *AlertSilenceKey) BeingDeletedError() error {
+	return fmt.Errorf("AlertSilence %s is being deleted", m.GetKeyString())
+}
+
+var AlertSilenceKeyTagOrganization = "alertsilenceorg"
+var AlertSilenceKeyTagName = "alertsilence"
+
+func (m *AlertSilenceKey) GetTags() map[string]string {
+	tags := make(map[string]string)
+	m.AddTags(tags)
+	return tags
+}
+
+func (m *AlertSilenceKey) AddTagsByFunc(addTag AddTagFunc) {
+	addTag("alertsilenceorg", m.Organization)
+	addTag("alertsilence", m.Name)
+}
+
+func (m *AlertSilenceKey) AddTags(tags map[string]string) {
+	tagMap := TagMap(tags)
+	m.AddTagsByFunc(tagMap.AddTag)
+}
+
+// Helper method to check that enums have valid values
+func (m *AlertSilenceKey) ValidateEnums() error {
+	return nil
+}
+
+func (s *AlertSilenceKey) ClearTagged(tags map[string]struct{}) {
+}
+
+func (m *AlertMatcher) Clone() *AlertMatcher {
+	cp := &AlertMatcher{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *AlertMatcher) CopyInFields(src *AlertMatcher) int {
+	changed := 0
+	if m.Name != src.Name {
+		m.Name = src.Name
+		changed++
+	}
+	if m.Value != src.Value {
+		m.Value = src.Value
+		changed++
+	}
+	if m.Regex != src.Regex {
+		m.Regex = src.Regex
+		changed++
+	}
+	return changed
+}
+
+func (m *AlertMatcher) DeepCopyIn(src *AlertMatcher) {
+	m.Name = src.Name
+	m.Value = src.Value
+	m.Regex = src.Regex
+}
+
+// Helper method to check that enums have valid values
+func (m *AlertMatcher) ValidateEnums() error {
+	return nil
+}
+
+func (s *AlertMatcher) ClearTagged(tags map[string]struct{}) {
+}
+
+func (m *AlertSilence) Matches(o *AlertSilence, fopts ...MatchOpt) bool {
+	opts := MatchOptions{}
+	applyMatchOptions(&opts, fopts...)
+	if o == nil {
+		if opts.Filter {
+			return true
+		}
+		return false
+	}
+	if !m.Key.Matches(&o.Key, fopts...) {
+		return false
+	}
+	if !opts.Filter || o.Matchers != nil {
+		if len(m.Matchers) == 0 && len(o.Matchers) > 0 || len(m.Matchers) > 0 && len(o.Matchers) == 0 {
+			return false
+		} else if m.Matchers != nil && o.Matchers != nil {
+			if !opts.Filter && len(m.Matchers) != len(o.Matchers) {
+				return false
+			}
+		}
+	}
+	if !opts.Filter || o.CreatedBy != "" {
+		if o.CreatedBy != m.CreatedBy {
+			return false
+		}
+	}
+	if !opts.Filter || o.Comment != "" {
+		if o.Comment != m.Comment {
+			return false
+		}
+	}
+	return true
+}
+
+const AlertSilenceFieldKey = "2"
+const AlertSilenceFieldKeyOrganization = "2.1"
+const AlertSilenceFieldKeyName = "2.2"
+const AlertSilenceFieldMatchers = "3"
+const AlertSilenceFieldMatchersName = "3.1"
+const AlertSilenceFieldMatchersValue = "3.2"
+const AlertSilenceFieldMatchersRegex = "3.3"
+const AlertSilenceFieldStartTime = "4"
+const AlertSilenceFieldStartTimeSeconds = "4.1"
+const AlertSilenceFieldStartTimeNanos = "4.2"
+const AlertSilenceFieldEndTime = "5"
+const AlertSilenceFieldEndTimeSeconds = "5.1"
+const AlertSilenceFieldEndTimeNanos = "5.2"
+const AlertSilenceFieldCreatedBy = "6"
+const AlertSilenceFieldComment = "7"
+
+var AlertSilenceAllFields = []string{
+	AlertSilenceFieldKeyOrganization,
+	AlertSilenceFieldKeyName,
+	AlertSilenceFieldMatchersName,
+	AlertSilenceFieldMatchersValue,
+	AlertSilenceFieldMatchersRegex,
+	AlertSilenceFieldStartTimeSeconds,
+	AlertSilenceFieldStartTimeNanos,
+	AlertSilenceFieldEndTimeSeconds,
+	AlertSilenceFieldEndTimeNanos,
+	AlertSilenceFieldCreatedBy,
+	AlertSilenceFieldComment,
+}
+
+var AlertSilenceAllFieldsMap = NewFieldMap(map[string]struct{}{
+	AlertSilenceFieldKeyOrganization:  struct{}{},
+	AlertSilenceFieldKeyName:          struct{}{},
+	AlertSilenceFieldMatchersName:     struct{}{},
+	AlertSilenceFieldMatchersValue:    struct{}{},
+	AlertSilenceFieldMatchersRegex:    struct{}{},
+	AlertSilenceFieldStartTimeSeconds: struct{}{},
+	AlertSilenceFieldStartTimeNanos:   struct{}{},
+	AlertSilenceFieldEndTimeSeconds:   struct{}{},
+	AlertSilenceFieldEndTimeNanos:     struct{}{},
+	AlertSilenceFieldCreatedBy:        struct{}{},
+	AlertSilenceFieldComment:          struct{}{},
+})
+
+var AlertSilenceAllFieldsStringMap = map[string]string{
+	AlertSilenceFieldKeyOrganization:  "Key Organization",
+	AlertSilenceFieldKeyName:          "Key Name",
+	AlertSilenceFieldMatchersName:     "Matchers Name",
+	AlertSilenceFieldMatchersValue:    "Matchers Value",
+	AlertSilenceFieldMatchersRegex:    "Matchers Regex",
+	AlertSilenceFieldStartTimeSeconds: "Start Time Seconds",
+	AlertSilenceFieldStartTimeNanos:   "Start Time Nanos",
+	AlertSilenceFieldEndTimeSeconds:   "End Time Seconds",
+	AlertSilenceFieldEndTimeNanos:     "End Time Nanos",
+	AlertSilenceFieldCreatedBy:        "Created By",
+	AlertSilenceFieldComment:          "Comment",
+}
+
+func (m *AlertSilence) IsKeyField(s string) bool {
+	return strings.HasPrefix(s, AlertSilenceFieldKey+".") || s == AlertSilenceFieldKey
+}
+
+func (m *AlertSilence) DiffFields(o *AlertSilence, fields *FieldMap) {
+	if m.Key.Organization != o.Key.Organization {
+		fields.Set(AlertSilenceFieldKeyOrganization)
+		fields.Set(AlertSilenceFieldKey)
+	}
+	if m.Key.Name != o.Key.Name {
+		fields.Set(AlertSilenceFieldKeyName)
+		fields.Set(AlertSilenceFieldKey)
+	}
+	if len(m.Matchers) != len(o.Matchers) {
+		fields.Set(AlertSilenceFieldMatchers)
+	} else {
+		for i0 := 0; i0 < len(m.Matchers); i0++ {
+			if m.Matchers[i0].Name != o.Matchers[i0].Name {
+				fields.Set(AlertSilenceFieldMatchersName)
+				fields.Set(AlertSilenceFieldMatchers)
+			}
+			if m.Matchers[i0].Value != o.Matchers[i0].Value {
+				fields.Set(AlertSilenceFieldMatchersValue)
+				fields.Set(AlertSilenceFieldMatchers)
+			}
+			if m.Matchers[i0].Regex != o.Matchers[i0].Regex {
+				fields.Set(AlertSilenceFieldMatchersRegex)
+				fields.Set(AlertSilenceFieldMatchers)
+			}
+		}
+	}
+	if m.StartTime.Seconds != o.StartTime.Seconds {
+		fields.Set(AlertSilenceFieldStartTimeSeconds)
+		fields.Set(AlertSilenceFieldStartTime)
+	}
+	if m.StartTime.Nanos != o.StartTime.Nanos {
+		fields.Set(AlertSilenceFieldStartTimeNanos)
+		fields.Set(AlertSilenceFieldStartTime)
+	}
+	if m.EndTime.Seconds != o.EndTime.Seconds {
+		fields.Set(AlertSilenceFieldEndTimeSeconds)
+		fields.Set(AlertSilenceFieldEndTime)
+	}
+	if m.EndTime.Nanos != o.EndTime.Nanos {
+		fields.Set(AlertSilenceFieldEndTimeNanos)
+		fields.Set(AlertSilenceFieldEndTime)
+	}
+	if m.CreatedBy != o.CreatedBy {
+		fields.Set(AlertSilenceFieldCreatedBy)
+	}
+	if m.Comment != o.Comment {
+		fields.Set(AlertSilenceFieldComment)
+	}
+}
+
+func (m *AlertSilence) GetDiffFields(o *AlertSilence) *FieldMap {
+	diffFields := NewFieldMap(nil)
+	m.DiffFields(o, diffFields)
+	return diffFields
+}
+
+var UpdateAlertSilenceFieldsMap = NewFieldMap(map[string]struct{}{
+	AlertSilenceFieldMatchers:         struct{}{},
+	AlertSilenceFieldMatchersName:     struct{}{},
+	AlertSilenceFieldMatchersValue:    struct{}{},
+	AlertSilenceFieldMatchersRegex:    struct{}{},
+	AlertSilenceFieldStartTime:        struct{}{},
+	AlertSilenceFieldStartTimeSeconds: struct{}{},
+	AlertSilenceFieldStartTimeNanos:   struct{}{},
+	AlertSilenceFieldEndTime:          struct{}{},
+	AlertSilenceFieldEndTimeSeconds:   struct{}{},
+	AlertSilenceFieldEndTimeNanos:     struct{}{},
+	AlertSilenceFieldCreatedBy:        struct{}{},
+	AlertSilenceFieldComment:          struct{}{},
+})
+
+func (m *AlertSilence) ValidateUpdateFields() error {
+	return m.ValidateUpdateFieldsCustom(UpdateAlertSilenceFieldsMap)
+}
+
+func (m *AlertSilence) ValidateUpdateFieldsCustom(allowedFields *FieldMap) error {
+	if m.Fields == nil {
+		return fmt.Errorf("nothing specified to update")
+	}
+	fmap := MakeFieldMap(m.Fields)
+	badFieldStrs := []string{}
+	for _, field := range fmap.Fields() {
+		if m.IsKeyField(field) {
+			continue
+		}
+		if !allowedFields.Has(field) {
+			if _, ok := AlertSilenceAllFieldsStringMap[field]; !ok {
+				continue
+			}
+			badFieldStrs = append(badFieldStrs, AlertSilenceAllFieldsStringMap[field])
+		}
+	}
+	if len(badFieldStrs) > 0 {
+		return fmt.Errorf("specified field(s) %s cannot be modified", strings.Join(badFieldStrs, ","))
+	}
+	return nil
+}
+
+func (m *AlertSilence) Clone() *AlertSilence {
+	cp := &AlertSilence{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *AlertSilence) AddMatchers(vals ...AlertMatcher) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Matchers {
+		cur[v.String()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.String()]; found {
+			continue // duplicate
+		}
+		m.Matchers = append(m.Matchers, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AlertSilence) RemoveMatchers(vals ...AlertMatcher) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.String()] = struct{}{}
+	}
+	for i := len(m.Matchers); i >= 0; i-- {
+		if _, found := remove[m.Matchers[i].String()]; found {
+			m.Matchers = append(m.Matchers[:i], m.Matchers[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AlertSilence) CopyInFields(src *AlertSilence) int {
+	updateListAction := "replace"
+	changed := 0
+	fmap := MakeFieldMap(src.Fields)
+	if fmap.HasOrHasChild("2") {
+		if fmap.Has("2.1") {
+			if m.Key.Organization != src.Key.Organization {
+				m.Key.Organization = src.Key.Organization
+				changed++
+			}
+		}
+		if fmap.Has("2.2") {
+			if m.Key.Name != src.Key.Name {
+				m.Key.Name = src.Key.Name
+				changed++
+			}
+		}
+	}
+	if fmap.HasOrHasChild("3") {
+		if src.Matchers != nil {
+			if updateListAction == "add" {
+				changed += m.AddMatchers(src.Matchers...)
+			} else if updateListAction == "remove" {
+				changed += m.RemoveMatchers(src.Matchers...)
+			} else {
+				m.Matchers = make([]AlertMatcher, 0)
+				for k0, _ := range src.Matchers {
+					m.Matchers = append(m.Matchers, *src.Matchers[k0].Clone())
+				}
+				changed++
+			}
+		} else if m.Matchers != nil {
+			m.Matchers = nil
+			changed++
+		}
+	}
+	if fmap.HasOrHasChild("4") {
+		if fmap.Has("4.1") {
+			if m.StartTime.Seconds != src.StartTime.Seconds {
+				m.StartTime.Seconds = src.StartTime.Seconds
+				changed++
+			}
+		}
+		if fmap.Has("4.2") {
+			if m.StartTime.Nanos != src.StartTime.Nanos {
+				m.StartTime.Nanos = src.StartTime.Nanos
+				changed++
+			}
+		}
+	}
+	if fmap.HasOrHasChild("5") {
+		if fmap.Has("5.1") {
+			if m.EndTime.Seconds != src.EndTime.Seconds {
+				m.EndTime.Seconds = src.EndTime.Seconds
+				changed++
+			}
+		}
+		if fmap.Has("5.2") {
+			if m.EndTime.Nanos != src.EndTime.Nanos {
+				m.EndTime.Nanos = src.EndTime.Nanos
+				changed++
+			}
+		}
+	}
+	if fmap.Has("6") {
+		if m.CreatedBy != src.CreatedBy {
+			m.CreatedBy = src.CreatedBy
+			changed++
+		}
+	}
+	if fmap.Has("7") {
+		if m.Comment != src.Comment {
+			m.Comment = src.Comment
+			changed++
+		}
+	}
+	return changed
+}
+
+func (m *AlertSilence) DeepCopyIn(src *AlertSilence) {
+	m.Key.DeepCopyIn(&src.Key)
+	if src.Matchers != nil {
+		m.Matchers = make([]AlertMatcher, len(src.Matchers), len(src.Matchers))
+		for ii, s := range src.Matchers {
+			m.Matchers[ii].DeepCopyIn(&s)
+		}
+	} else {
+		m.Matchers = nil
+	}
+	m.StartTime = src.StartTime
+	m.EndTime = src.EndTime
+	m.CreatedBy = src.CreatedBy
+	m.Comment = src.Comment
+}
+
+func (s *AlertSilence) HasFields() bool {
+	return true
+}
+
+type AlertSilenceStore interface {
+	Create(ctx context.Context, m *AlertSilence, wait func(int64)) (*Result, error)
+	Update(ctx context.Context, m *AlertSilence, wait func(int64)) (*Result, error)
+	Delete(ctx context.Context, m *AlertSilence, wait func(int64)) (*Result, error)
+	Put(ctx context.Context, m *AlertSilence, wait func(int64), ops ...objstore.KVOp) (*Result, error)
+	LoadOne(key string) (*AlertSilence, int64, error)
+	Get(ctx context.Context, key *AlertSilenceKey, buf *AlertSilence) bool
+	STMGet(stm concurrency.STM, key *AlertSilenceKey, buf *AlertSilence) bool
+	STMPut(stm concurrency.STM, obj *AlertSilence, ops ...objstore.KVOp)
+	STMDel(stm concurrency.STM, key *AlertSilenceKey)
+	STMHas(stm concurrency.STM, key *AlertSilenceKey) bool
+}
+
+type AlertSilenceStoreImpl struct {
+	kvstore objstore.KVStore
+}
+
+func NewAlertSilenceStore(kvstore objstore.KVStore) *AlertSilenceStoreImpl {
+	return &AlertSilenceStoreImpl{kvstore: kvstore}
+}
+
+func (s *AlertSilenceStoreImpl) Create(ctx context.Context, m *AlertSilence, wait func(int64)) (*Result, error) {
+	err := m.Validate(AlertSilenceAllFieldsMap)
+	if err != nil {
+		return nil, err
+	}
+	key := objstore.DbKeyString("AlertSilence", m.GetKey())
+	val, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	rev, err := s.kvstore.Create(ctx, key, string(val))
+	if err != nil {
+		return nil, err
+	}
+	if wait != nil {
+		wait(rev)
+	}
+	return &Result{}, err
+}
+
+func (s *AlertSilenceStoreImpl) Update(ctx context.Context, m *AlertSilence, wait func(int64)) (*Result, error) {
+	fmap := MakeFieldMap(m.Fields)
+	err := m.Validate(fmap)
+	if err != nil {
+		return nil, err
+	}
+	key := objstore.DbKeyString("AlertSilence", m.GetKey())
+	var vers int64 = 0
+	curBytes, vers, _, err := s.kvstore.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	var cur AlertSilence
+	err = json.Unmarshal(curBytes, &cur)
+	if err != nil {
+		return nil, err
+	}
+	cur.CopyInFields(m)
+	// never save fields
+	cur.Fields = nil
+	val, err := json.Marshal(cur)
+	if err != nil {
+		return nil, err
+	}
+	rev, err := s.kvstore.Update(ctx, key, string(val), vers)
+	if err != nil {
+		return nil, err
+	}
+	if wait != nil {
+		wait(rev)
+	}
+	return &Result{}, err
+}
+
+func (s *AlertSilenceStoreImpl) Put(ctx context.Context, m *AlertSilence, wait func(int64), ops ...objstore.KVOp) (*Result, error) {
+	err := m.Validate(AlertSilenceAllFieldsMap)
+	m.Fields = nil
+	if err != nil {
+		return nil, err
+	}
+	key := objstore.DbKeyString("AlertSilence", m.GetKey())
+	var val []byte
+	val, err = json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	rev, err := s.kvstore.Put(ctx, key, string(val), ops...)
+	if err != nil {
+		return nil, err
+	}
+	if wait != nil {
+		wait(rev)
+	}
+	return &Result{}, err
+}
+
+func (s *AlertSilenceStoreImpl) Delete(ctx context.Context, m *AlertSilence, wait func(int64)) (*Result, error) {
+	err := m.GetKey().ValidateKey()
+	if err != nil {
+		return nil, err
+	}
+	key := objstore.DbKeyString("AlertSilence", m.GetKey())
+	rev, err := s.kvstore.Delete(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if wait != nil {
+		wait(rev)
+	}
+	return &Result{}, err
+}
+
+func (s *AlertSilenceStoreImpl) LoadOne(key string) (*AlertSilence, int64, error) {
+	val, rev, _, err := s.kvstore.Get(key)
+	if err != nil {
+		return nil, 0, err
+	}
+	var obj AlertSilence
+	err = json.Unmarshal(val, &obj)
+	if err != nil {
+		log.DebugLog(log.DebugLevelApi, "Failed to parse AlertSilence data", "val", string(val), "err", err)
+		return nil, 0, err
+	}
+	return &obj, rev, nil
+}
+
+func (s *AlertSilenceStoreImpl) Get(ctx context.Context, key *AlertSilenceKey, buf *AlertSilence) bool {
+	keystr := objstore.DbKeyString("AlertSilence", key)
+	val, _, _, err := s.kvstore.Get(keystr)
+	if err != nil {
+		return false
+	}
+	return s.parseGetData(val, buf)
+}
+
+func (s *AlertSilenceStoreImpl) STMGet(stm concurrency.STM, key *AlertSilenceKey, buf *AlertSilence) bool {
+	keystr := objstore.DbKeyString("AlertSilence", key)
+	valstr := stm.Get(keystr)
+	return s.parseGetData([]byte(valstr), buf)
+}
+
+func (s *AlertSilenceStoreImpl) STMHas(stm concurrency.STM, key *AlertSilenceKey) bool {
+	keystr := objstore.DbKeyString("AlertSilence", key)
+	return stm.Get(keystr) != ""
+}
+
+func (s *AlertSilenceStoreImpl) parseGetData(val []byte, buf *AlertSilence) bool {
+	if len(val) == 0 {
+		return false
+	}
+	if buf != nil {
+		// clear buf, because empty values in val won't
+		// overwrite non-empty values in buf.
+		*buf = AlertSilence{}
+		err := json.Unmarshal(val, buf)
+		if err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *AlertSilenceStoreImpl) STMPut(stm concurrency.STM, obj *AlertSilence, ops ...objstore.KVOp) {
+	keystr := objstore.DbKeyString("AlertSilence", obj.GetKey())
+
+	val, err := json.Marshal(obj)
+	if err != nil {
+		log.InfoLog("AlertSilence json marshal failed", "obj", obj, "err", err)
+	}
+	v3opts := GetSTMOpts(ops...)
+	stm.Put(keystr, string(val), v3opts...)
+}
+
+func (s *AlertSilenceStoreImpl) STMDel(stm concurrency.STM, key *AlertSilenceKey) {
+	keystr := objstore.DbKeyString("AlertSilence", key)
+	stm.Del(keystr)
+}
+
+func StoreListAlertSilence(ctx context.Context, kvstore objstore.KVStore) ([]AlertSilence, error) {
+	keyPrefix := objstore.DbKeyPrefixString("AlertSilence") + "/"
+	objs := []AlertSilence{}
+	err := kvstore.List(keyPrefix, func(key, val []byte, rev, modRev int64) error {
+		obj := AlertSilence{}
+		err := json.Unmarshal(val, &obj)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal AlertSilence json %s, %s", string(val), err)
+		}
+		objs = append(objs, obj)
+		return nil
+	})
+	return objs, err
+}
+
+type AlertSilenceKeyWatcher struct {
+	cb func(ctx context.Context)
+}
+
+type AlertSilenceCacheData struct {
+	Obj    *AlertSilence
+	ModRev int64
+}
+
+func (s *AlertSilenceCacheData) Clone() *AlertSilenceCacheData {
+	cp := AlertSilenceCacheData{}
+	if s.Obj != nil {
+		cp.Obj = &AlertSilence{}
+		cp.Obj.DeepCopyIn(s.Obj)
+	}
+	cp.ModRev = s.ModRev
+	return &cp
+}
+
+// AlertSilenceCache caches AlertSilence objects in memory in a hash table
+// and keeps them in sync with the database.
+type AlertSilenceCache struct {
+	Objs          map[AlertSilenceKey]*AlertSilenceCacheData
+	Mux           util.Mutex
+	List          map[AlertSilenceKey]struct{}
+	FlushAll      bool
+	NotifyCbs     []func(ctx context.Context, obj *AlertSilence, modRev int64)
+	UpdatedCbs    []func(ctx context.Context, old *AlertSilence, new *AlertSilence)
+	DeletedCbs    []func(ctx context.Context, old *AlertSilence)
+	KeyWatchers   map[AlertSilenceKey][]*AlertSilenceKeyWatcher
+	UpdatedKeyCbs []func(ctx context.Context, key *AlertSilenceKey)
+	DeletedKeyCbs []func(ctx context.Context, key *AlertSilenceKey)
+	Store         AlertSilenceStore
+}
+
+func NewAlertSilenceCache() *AlertSilenceCache {
+	cache := AlertSilenceCache{}
+	InitAlertSilenceCache(&cache)
+	return &cache
+}
+
+func InitAlertSilenceCache(cache *AlertSilenceCache) {
+	cache.Objs = make(map[AlertSilenceKey]*AlertSilenceCacheData)
+	cache.KeyWatchers = make(map[AlertSilenceKey][]*AlertSilenceKeyWatcher)
+	cache.NotifyCbs = nil
+	cache.UpdatedCbs = nil
+	cache.DeletedCbs = nil
+	cache.UpdatedKeyCbs = nil
+	cache.DeletedKeyCbs = nil
+}
+
+func (c *AlertSilenceCache) GetTypeString() string {
+	return "AlertSilence"
+}
+
+func (c *AlertSilenceCache) Get(key *AlertSilenceKey, valbuf *AlertSilence) bool {
+	var modRev int64
+	return c.GetWithRev(key, valbuf, &modRev)
+}
+
+// STMGet gets from the store if STM is set, otherwise gets from cache
+func (c *AlertSilenceCache) STMGet(ostm *OptionalSTM, key *AlertSilenceKey, valbuf *AlertSilence) bool {
+	if ostm.stm != nil {
+		if c.Store == nil {
+			// panic, otherwise if we fallback to cache, we may silently
+			// introduce race conditions and intermittent failures due to
+			// reading from cache during a transaction.
+			panic("AlertSilenceCache store not set, cannot read via STM")
+		}
+		return c.Store.STMGet(ostm.stm, key, valbuf)
+	}
+	var modRev int64
+	return c.GetWithRev(key, valbuf, &modRev)
+}
+
+func (c *AlertSilenceCache) GetWithRev(key *AlertSilenceKey, valbuf *AlertSilence, modRev *int64) bool {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	inst, found := c.Objs[*key]
+	if found {
+		valbuf.DeepCopyIn(inst.Obj)
+		*modRev = inst.ModRev
+	}
+	return found
+}
+
+func (c *AlertSilenceCache) HasKey(key *AlertSilenceKey) bool {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	_, found := c.Objs[*key]
+	return found
+}
+
+func (c *AlertSilenceCache) GetAllKeys(ctx context.Context, cb func(key *AlertSilenceKey, modRev int64)) {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	for key, data := range c.Objs {
+		cb(&key, data.ModRev)
+	}
+}
+
+func (c *AlertSilenceCache) GetAllLocked(ctx context.Context, cb func(obj *AlertSilence, modRev int64)) {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	for _, data := range c.Objs {
+		cb(data.Obj, data.ModRev)
+	}
+}
+
+func (c *AlertSilenceCache) Update(ctx context.Context, in *AlertSilence, modRev int64) {
+	c.UpdateModFunc(ctx, in.GetKey(), modRev, func(old *AlertSilence) (*AlertSilence, bool) {
+		return in, true
+	})
+}
+
+func (c *AlertSilenceCache) UpdateModFunc(ctx context.Context, key *AlertSilenceKey, modRev int64, modFunc func(old *AlertSilence) (new *AlertSilence, changed bool)) {
+	c.Mux.Lock()
+	var old *AlertSilence
+	if oldData, found := c.Objs[*key]; found {
+		old = oldData.Obj
+	}
+	new, changed := modFunc(old)
+	if !changed {
+		c.Mux.Unlock()
+		return
+	}
+	if len(c.UpdatedCbs) > 0 || len(c.NotifyCbs) > 0 {
+		newCopy := &AlertSilence{}
+		newCopy.DeepCopyIn(new)
+		for _, cb := range c.UpdatedCbs {
+			defer cb(ctx, old, newCopy)
+		}
+		for _, cb := range c.NotifyCbs {
+			if cb != nil {
+				defer cb(ctx, newCopy, modRev)
+			}
+		}
+	}
+	for _, cb := range c.UpdatedKeyCbs {
+		defer cb(ctx, key)
+	}
+	store := &AlertSilence{}
+	store.DeepCopyIn(new)
+	c.Objs[new.GetKeyVal()] = &AlertSilenceCacheData{
+		Obj:    store,
+		ModRev: modRev,
+	}
+	log.SpanLog(ctx, log.DebugLevelApi, "cache update", "new", store)
+	c.Mux.Unlock()
+	c.TriggerKeyWatchers(ctx, new.GetKey())
+}
+
+func (c *AlertSilenceCache) Delete(ctx context.Context, in *AlertSilence, modRev int64) {
+	c.DeleteCondFunc(ctx, in, modRev, func(old *AlertSilence) bool {
+		return true
+	})
+}
+
+func (c *AlertSilenceCache) DeleteCondFunc(ctx context.Context, in *AlertSilence, modRev int64, condFunc func(old *AlertSilence) bool) {
+	c.Mux.Lock()
+	var old *AlertSilence
+	oldData, found := c.Objs[in.GetKeyVal()]
+	if found {
+		old = oldData.Obj
+		if !condFunc(old) {
+			c.Mux.Unlock()
+			return
+		}
+	}
+	delete(c.Objs, in.GetKeyVal())
+	log.SpanLog(ctx, log.DebugLevelApi, "cache delete", "key", in.GetKeyVal())
+	c.Mux.Unlock()
+	obj := old
+	if obj == nil {
+		obj = in
+	}
+	for _, cb := range c.NotifyCbs {
+		if cb != nil {
+			cb(ctx, obj, modRev)
+		}
+	}
+	if old != nil {
+		for _, cb := range c.DeletedCbs {
+			cb(ctx, old)
+		}
+	}
+	for _, cb := range c.DeletedKeyCbs {
+		cb(ctx, in.GetKey())
+	}
+	c.TriggerKeyWatchers(ctx, in.GetKey())
+}
+
+func (c *AlertSilenceCache) Prune(ctx context.Context, validKeys map[AlertSilenceKey]struct{}) {
+	log.SpanLog(ctx, log.DebugLevelApi, "Prune AlertSilence", "numValidKeys", len(validKeys))
+	notify := make(map[AlertSilenceKey]*AlertSilenceCacheData)
+	c.Mux.Lock()
+	for key, _ := range c.Objs {
+		if _, ok := validKeys[key]; !ok {
+			if len(c.NotifyCbs) > 0 || len(c.DeletedKeyCbs) > 0 || len(c.DeletedCbs) > 0 {
+				notify[key] = c.Objs[key]
+			}
+			delete(c.Objs, key)
+		}
+	}
+	c.Mux.Unlock()
+	for key, old := range notify {
+		obj := old.Obj
+		if obj == nil {
+			obj = &AlertSilence{}
+			obj.SetKey(&key)
+		}
+		for _, cb := range c.NotifyCbs {
+			if cb != nil {
+				cb(ctx, obj, old.ModRev)
+			}
+		}
+		for _, cb := range c.DeletedKeyCbs {
+			cb(ctx, &key)
+		}
+		if old.Obj != nil {
+			for _, cb := range c.DeletedCbs {
+				cb(ctx, old.Obj)
+			}
+		}
+		c.TriggerKeyWatchers(ctx, &key)
+	}
+}
+
+func (c *AlertSilenceCache) GetCount() int {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	return len(c.Objs)
+}
+
+func (c *AlertSilenceCache) Flush(ctx context.Context, notifyId int64) {
+}
+
+func (c *AlertSilenceCache) Show(filter *AlertSilence, cb func(ret *AlertSilence) error) error {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	for _, data := range c.Objs {
+		if !data.Obj.Matches(filter, MatchFilter()) {
+			continue
+		}
+		err := cb(data.Obj)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func AlertSilenceGenericNotifyCb(fn func(key *AlertSilenceKey, old *AlertSilence)) func(objstore.ObjKey, objstore.Obj) {
+	return func(objkey objstore.ObjKey, obj objstore.Obj) {
+		fn(objkey.(*AlertSilenceKey), obj.(*AlertSilence))
+	}
+}
+
+func (c *AlertSilenceCache) SetNotifyCb(fn func(ctx context.Context, obj *AlertSilence, modRev int64)) {
+	c.NotifyCbs = []func(ctx context.Context, obj *AlertSilence, modRev int64){fn}
+}
+
+func (c *AlertSilenceCache) SetUpdatedCb(fn func(ctx context.Context, old *AlertSilence, new *AlertSilence)) {
+	c.UpdatedCbs = []func(ctx context.Context, old *AlertSilence, new *AlertSilence){fn}
+}
+
+func (c *AlertSilenceCache) SetDeletedCb(fn func(ctx context.Context, old *AlertSilence)) {
+	c.DeletedCbs = []func(ctx context.Context, old *AlertSilence){fn}
+}
+
+func (c *AlertSilenceCache) SetUpdatedKeyCb(fn func(ctx context.Context, key *AlertSilenceKey)) {
+	c.UpdatedKeyCbs = []func(ctx context.Context, key *AlertSilenceKey){fn}
+}
+
+func (c *AlertSilenceCache) SetDeletedKeyCb(fn func(ctx context.Context, key *AlertSilenceKey)) {
+	c.DeletedKeyCbs = []func(ctx context.Context, key *AlertSilenceKey){fn}
+}
+
+func (c *AlertSilenceCache) AddUpdatedCb(fn func(ctx context.Context, old *AlertSilence, new *AlertSilence)) {
+	c.UpdatedCbs = append(c.UpdatedCbs, fn)
+}
+
+func (c *AlertSilenceCache) AddDeletedCb(fn func(ctx context.Context, old *AlertSilence)) {
+	c.DeletedCbs = append(c.DeletedCbs, fn)
+}
+
+func (c *AlertSilenceCache) AddNotifyCb(fn func(ctx context.Context, obj *AlertSilence, modRev int64)) {
+	c.NotifyCbs = append(c.NotifyCbs, fn)
+}
+
+func (c *AlertSilenceCache) AddUpdatedKeyCb(fn func(ctx context.Context, key *AlertSilenceKey)) {
+	c.UpdatedKeyCbs = append(c.UpdatedKeyCbs, fn)
+}
+
+func (c *AlertSilenceCache) AddDeletedKeyCb(fn func(ctx context.Context, key *AlertSilenceKey)) {
+	c.DeletedKeyCbs = append(c.DeletedKeyCbs, fn)
+}
+
+func (c *AlertSilenceCache) SetFlushAll() {
+	c.FlushAll = true
+}
+
+func (c *AlertSilenceCache) WatchKey(key *AlertSilenceKey, cb func(ctx context.Context)) context.CancelFunc {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	list, ok := c.KeyWatchers[*key]
+	if !ok {
+		list = make([]*AlertSilenceKeyWatcher, 0)
+	}
+	watcher := AlertSilenceKeyWatcher{cb: cb}
+	c.KeyWatchers[*key] = append(list, &watcher)
+	log.DebugLog(log.DebugLevelApi, "Watching AlertSilence", "key", key)
+	return func() {
+		c.Mux.Lock()
+		defer c.Mux.Unlock()
+		list, ok := c.KeyWatchers[*key]
+		if !ok {
+			return
+		}
+		for ii, _ := range list {
+			if list[ii] != &watcher {
+				continue
+			}
+			if len(list) == 1 {
+				delete(c.KeyWatchers, *key)
+				return
+			}
+			list[ii] = list[len(list)-1]
+			list[len(list)-1] = nil
+			c.KeyWatchers[*key] = list[:len(list)-1]
+			return
+		}
+	}
+}
+
+func (c *AlertSilenceCache) TriggerKeyWatchers(ctx context.Context, key *AlertSilenceKey) {
+	watchers := make([]*AlertSilenceKeyWatcher, 0)
+	c.Mux.Lock()
+	if list, ok := c.KeyWatchers[*key]; ok {
+		watchers = append(watchers, list...)
+	}
+	c.Mux.Unlock()
+	for ii, _ := range watchers {
+		watchers[ii].cb(ctx)
+	}
+}
+
+// Note that we explicitly ignore the global revision number, because of the way
+// the notify framework sends updates (by hashing keys and doing lookups, instead
+// of sequentially through a history buffer), updates may be done out-of-order
+// or multiple updates compressed into one update, so the state of the cache at
+// any point in time may not by in sync with a particular database revision number.
+
+func (c *AlertSilenceCache) SyncUpdate(ctx context.Context, key, val []byte, rev, modRev int64) {
+	obj := AlertSilence{}
+	err := json.Unmarshal(val, &obj)
+	if err != nil {
+		log.WarnLog("Failed to parse AlertSilence data", "val", string(val), "err", err)
+		return
+	}
+	c.Update(ctx, &obj, modRev)
+	c.Mux.Lock()
+	if c.List != nil {
+		c.List[obj.GetKeyVal()] = struct{}{}
+	}
+	c.Mux.Unlock()
+}
+
+func (c *AlertSilenceCache) SyncDelete(ctx context.Context, key []byte, rev, modRev int64) {
+	obj := AlertSilence{}
+	keystr := objstore.DbKeyPrefixRemove(string(key))
+	AlertSilenceKeyStringParse(keystr, obj.GetKey())
+	c.Delete(ctx, &obj, modRev)
+}
+
+func (c *AlertSilenceCache) SyncListStart(ctx context.Context) {
+	c.List = make(map[AlertSilenceKey]struct{})
+}
+
+func (c *AlertSilenceCache) SyncListEnd(ctx context.Context) {
+	deleted := make(map[AlertSilenceKey]*AlertSilenceCacheData)
+	c.Mux.Lock()
+	for key, val := range c.Objs {
+		if _, found := c.List[key]; !found {
+			deleted[key] = val
+			delete(c.Objs, key)
+		}
+	}
+	c.List = nil
+	c.Mux.Unlock()
+	for key, val := range deleted {
+		obj := val.Obj
+		if obj == nil {
+			obj = &AlertSilence{}
+			obj.SetKey(&key)
+		}
+		for _, cb := range c.NotifyCbs {
+			if cb != nil {
+				cb(ctx, obj, val.ModRev)
+			}
+		}
+		for _, cb := range c.DeletedKeyCbs {
+			cb(ctx, &key)
+		}
+		if val.Obj != nil {
+			for _, cb := range c.DeletedCbs {
+				cb(ctx, val.Obj)
+			}
+		}
+		c.TriggerKeyWatchers(ctx, &key)
+	}
+}
+
+func (s *AlertSilenceCache) InitCacheWithSync(sync DataSync) {
+	InitAlertSilenceCache(s)
+	s.InitSync(sync)
+}
+
+func (s *AlertSilenceCache) InitSync(sync DataSync) {
+	if sync != nil {
+		s.Store = NewAlertSilenceStore(sync.GetKVStore())
+		sync.RegisterCache(s)
+	}
+}
+
+func InitAlertSilenceCacheWithStore(cache *AlertSilenceCache, store AlertSilenceStore) {
+	InitAlertSilenceCache(cache)
+	cache.Store = store
+}
+
+func (c *AlertSilenceCache) UsesOrg(org string) bool {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	for key, _ := range c.Objs {
+		if key.Organization == org {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *AlertSilence) GetObjKey() objstore.ObjKey {
+	return m.GetKey()
+}
+
+func (m *AlertSilence) GetKey() *AlertSilenceKey {
+	return &m.Key
+}
+
+func (m *AlertSilence) GetKeyVal() AlertSilenceKey {
+	return m.Key
+}
+
+func (m *AlertSilence) SetKey(key *AlertSilenceKey) {
+	m.Key = *key
+}
+
+func CmpSortAlertSilence(a AlertSilence, b AlertSilence) bool {
+	return a.Key.GetKeyString() < b.Key.GetKeyString()
+}
+
+// Helper method to check that enums have valid values
+// NOTE: ValidateEnums checks all Fields even if some are not set
+func (m *AlertSilence) ValidateEnums() error {
+	if err := m.Key.ValidateEnums(); err != nil {
+		return err
+	}
+	for _, e := range m.Matchers {
+		if err := e.ValidateEnums(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *AlertSilence) ClearTagged(tags map[string]struct{}) {
+	s.Key.ClearTagged(tags)
+	if s.Matchers != nil {
+		for ii := 0; ii < len(s.Matchers); ii++ {
+			s.Matchers[ii].ClearTagged(tags)
+		}
+	}
+}
+
+func (m *AlertSilence) IsValidArgsForCreateAlertSilence() error {
+	return nil
+}
+
+func (m *AlertSilence) IsValidArgsForDeleteAlertSilence() error {
+	return nil
+}
+
+func (m *AlertSilence) IsValidArgsForUpdateAlertSilence() error {
+	return nil
+}
+
+func (m *AlertSilenceKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Organization)
+	if l > 0 {
+		n += 1 + l + sovAlertsilence(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAlertsilence(uint64(l))
+	}
+	return n
+}
+
+func (m *AlertMatcher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAlertsilence(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAlertsilence(uint64(l))
+	}
+	if m.Regex {
+		n += 2
+	}
+	return n
+}
+
+func (m *AlertSilence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovAlertsilence(uint64(l))
+		}
+	}
+	l = m.Key.Size()
+	n += 1 + l + sovAlertsilence(uint64(l))
+	if len(m.Matchers) > 0 {
+		for _, e := range m.Matchers {
+			l = e.Size()
+			n += 1 + l + sovAlertsilence(uint64(l))
+		}
+	}
+	l = m.StartTime.Size()
+	n += 1 + l + sovAlertsilence(uint64(l))
+	l = m.EndTime.Size()
+	n += 1 + l + sovAlertsilence(uint64(l))
+	l = len(m.CreatedBy)
+	if l > 0 {
+		n += 1 + l + sovAlertsilence(uint64(l))
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovAlertsilence(uint64(l))
+	}
+	return n
+}
+
+func sovAlertsilence(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAlertsilence(x uint64) (n int) {
+	return sovAlertsilence(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AlertSilenceKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAlertsilence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlertSilenceKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlertSilenceKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertsilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Organization = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertsilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAlertsilence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlertMatcher) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAlertsilence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlertMatcher: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlertMatcher: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertsilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertsilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regex", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertsilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Regex = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAlertsilence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlertSilence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAlertsilence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlertSilence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlertSilence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertsilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertsilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matchers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertsilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Matchers = append(m.Matchers, AlertMatcher{})
+			if err := m.Matchers[len(m.Matchers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertsilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertsilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertsilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertsilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAlertsilence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAlertsilence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAlertsilence(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAlertsilence
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAlertsilence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAlertsilence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAlertsilence
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAlertsilence
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAlertsilence
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAlertsilence        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAlertsilence          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAlertsilence = fmt.Errorf("proto: unexpected end of group")
+)
@@ -52,6 +52,7 @@ type AllData struct {
 	AutoScalePolicies          []AutoScalePolicy           `protobuf:"bytes,13,rep,name=auto_scale_policies,json=autoScalePolicies,proto3" json:"auto_scale_policies"`
 	IdleReservableClusterInsts *IdleReservableClusterInsts `protobuf:"bytes,20,opt,name=idle_reservable_cluster_insts,json=idleReservableClusterInsts,proto3" json:"idle_reservable_cluster_insts,omitempty"`
 	ClusterInsts               []ClusterInst               `protobuf:"bytes,15,rep,name=cluster_insts,json=clusterInsts,proto3" json:"cluster_insts"`
+	GeoFencePolicies           []GeoFencePolicy            `protobuf:"bytes,30,rep,name=geo_fence_policies,json=geoFencePolicies,proto3" json:"geo_fence_policies"`
 	Apps                       []App                       `protobuf:"bytes,16,rep,name=apps,proto3" json:"apps"`
 	AppInstances               []AppInst                   `protobuf:"bytes,17,rep,name=app_instances,json=appInstances,proto3" json:"app_instances"`
 	AppInstRefs                []AppInstRefs               `protobuf:"bytes,18,rep,name=app_inst_refs,json=appInstRefs,proto3" json:"app_inst_refs"`
@@ -103,67 +104,69 @@ func init() {
 func init() { proto.RegisterFile("alldata.proto", fileDescriptor_8eca40466c9a5f17) }
 
 var fileDescriptor_8eca40466c9a5f17 = []byte{
-	// 948 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x55, 0x4f, 0x6f, 0x63, 0x35,
-	0x10, 0x4f, 0xa0, 0x6c, 0x53, 0xa7, 0x49, 0x1b, 0xf7, 0x9f, 0x37, 0xdb, 0x66, 0x2b, 0x10, 0x52,
-	0x25, 0xa4, 0x56, 0x14, 0x24, 0x10, 0x08, 0x41, 0x9b, 0xb2, 0xa8, 0x12, 0x5d, 0xa2, 0x6c, 0x41,
-	0x08, 0x09, 0x59, 0xde, 0x17, 0xe7, 0xf1, 0x84, 0x13, 0x7b, 0x3d, 0x4e, 0xda, 0xee, 0xa7, 0xe0,
-	0xc8, 0x91, 0x1b, 0x5f, 0xa5, 0xc7, 0x3d, 0x72, 0x42, 0xd0, 0x7e, 0x87, 0x9e, 0x57, 0xf6, 0xb3,
-	0x5f, 0x9c, 0xa6, 0xb9, 0x79, 0x7e, 0xf3, 0x9b, 0xdf, 0xcc, 0xf3, 0x78, 0xe6, 0xa1, 0x1a, 0x13,
-	0xa2, 0xc7, 0x0c, 0xdb, 0x57, 0x5a, 0x1a, 0x89, 0x97, 0x78, 0x2f, 0xe5, 0xee, 0xd8, 0xdc, 0x31,
-	0x52, 0x0a, 0x38, 0x70, 0x46, 0xca, 0x87, 0xc5, 0x21, 0x67, 0x36, 0xeb, 0xc0, 0x8d, 0xc9, 0x86,
-	0x29, 0x78, 0x7b, 0xb9, 0x2f, 0xd8, 0x58, 0x6a, 0x6f, 0x61, 0xa9, 0xb8, 0x66, 0x46, 0xea, 0x44,
-	0xf6, 0xb8, 0xc7, 0x1a, 0x9a, 0x83, 0x61, 0xa9, 0x61, 0x2f, 0x45, 0x80, 0xea, 0x89, 0x90, 0xa3,
-	0x9e, 0xe0, 0xc6, 0xdb, 0xe8, 0xb5, 0x1c, 0x16, 0x3e, 0x7b, 0x56, 0x52, 0x8a, 0x90, 0x60, 0x3c,
-	0x88, 0xac, 0x75, 0x36, 0x32, 0x52, 0x69, 0x39, 0x56, 0x52, 0x64, 0xc9, 0x95, 0x47, 0x37, 0x2c,
-	0x0a, 0x09, 0x13, 0x7c, 0x0a, 0x6e, 0x18, 0x3d, 0x02, 0x33, 0x05, 0x35, 0x23, 0x88, 0x5f, 0x26,
-	0x5c, 0x99, 0x4c, 0x86, 0x4f, 0xab, 0x0d, 0xb9, 0xb9, 0x90, 0xfa, 0xf7, 0x10, 0x9d, 0x88, 0x11,
-	0x18, 0xae, 0xb3, 0x21, 0x84, 0x3a, 0x97, 0x98, 0x52, 0x81, 0xcc, 0x94, 0x8a, 0x3c, 0x48, 0xf3,
-	0x7e, 0xb8, 0x92, 0x06, 0x13, 0x5c, 0x4f, 0xa7, 0x5d, 0xd1, 0xcc, 0x70, 0x91, 0x0d, 0xb2, 0xc0,
-	0x5f, 0x4f, 0x65, 0x2a, 0xdd, 0xf1, 0xc0, 0x9e, 0x72, 0xf4, 0xfd, 0xbf, 0xeb, 0x68, 0xf1, 0x48,
-	0x88, 0x13, 0x66, 0x18, 0xfe, 0x18, 0x2d, 0xe6, 0x57, 0x0b, 0xe4, 0x9d, 0xdd, 0x77, 0xf7, 0xaa,
-	0x87, 0x8d, 0xfd, 0xa2, 0x49, 0xfb, 0xcf, 0x9c, 0xe7, 0x78, 0xe1, 0xfa, 0xdf, 0xa7, 0xa5, 0x6e,
-	0xe0, 0xe1, 0x03, 0x54, 0x09, 0xdd, 0x21, 0xe5, 0xdd, 0xf2, 0x5e, 0xf5, 0x70, 0x2d, 0x8a, 0x79,
-	0xe1, 0x5d, 0xdd, 0x82, 0x84, 0x4f, 0x50, 0x3d, 0x34, 0x8c, 0xda, 0x8e, 0x01, 0x59, 0x70, 0xa9,
-	0xb6, 0xa2, 0xb0, 0x1f, 0x3c, 0xa1, 0x2d, 0x7b, 0xdc, 0x27, 0xac, 0xc9, 0x08, 0x03, 0x7c, 0x8c,
-	0xea, 0x9a, 0x03, 0x35, 0x2c, 0xa5, 0xae, 0xc9, 0x40, 0x1e, 0x39, 0x95, 0xcd, 0x48, 0xa5, 0xcb,
-	0xe1, 0x9c, 0xa5, 0xe7, 0xd6, 0xed, 0x45, 0x96, 0xf5, 0x04, 0x02, 0xdc, 0x46, 0x75, 0xd7, 0x19,
-	0xea, 0xae, 0x2d, 0xe3, 0x40, 0xea, 0x33, 0x1a, 0xe7, 0x96, 0xd0, 0x71, 0xd7, 0x1a, 0x0a, 0x31,
-	0x05, 0x94, 0x71, 0xc0, 0x5f, 0xa2, 0x6a, 0xaa, 0x46, 0xb4, 0xa7, 0xb3, 0x31, 0xd7, 0x40, 0x36,
-	0x9c, 0xc2, 0x7a, 0xa4, 0xf0, 0x5d, 0xe7, 0xc7, 0x13, 0xe7, 0xf4, 0xf1, 0x28, 0x55, 0xa3, 0x1c,
-	0x00, 0xfc, 0x1c, 0x35, 0x94, 0x60, 0xa6, 0x2f, 0xf5, 0x80, 0xf6, 0x39, 0x33, 0x23, 0xcd, 0x81,
-	0x6c, 0x3b, 0x89, 0x27, 0x91, 0x44, 0xc7, 0x73, 0x9e, 0x79, 0x8a, 0x57, 0x5a, 0x55, 0xf7, 0x70,
-	0xfc, 0x11, 0x7a, 0xcf, 0xbe, 0x64, 0x20, 0x3b, 0x4e, 0x63, 0x25, 0xd2, 0xf8, 0x45, 0x0e, 0xc3,
-	0x2d, 0xe4, 0x1c, 0xfc, 0x19, 0x5a, 0x0a, 0x23, 0x01, 0x64, 0xd1, 0x05, 0xc4, 0xad, 0x6b, 0x7b,
-	0x9f, 0x0f, 0x9a, 0x70, 0x6d, 0x07, 0x83, 0x41, 0xb3, 0x61, 0x5f, 0x02, 0xa9, 0xcc, 0x74, 0x30,
-	0x44, 0x9f, 0x0e, 0xfb, 0x32, 0x5c, 0x5c, 0x12, 0x61, 0x80, 0x3f, 0x47, 0x6e, 0x02, 0xa9, 0x1d,
-	0x34, 0x20, 0x4b, 0x33, 0xf9, 0x6d, 0xc1, 0x1d, 0x29, 0x45, 0xc8, 0xff, 0xda, 0xdb, 0x80, 0x3f,
-	0x45, 0x15, 0x3f, 0x35, 0x40, 0x1e, 0xbb, 0x38, 0x1c, 0xc5, 0x3d, 0xcf, 0x5d, 0x3e, 0xac, 0x60,
-	0xe2, 0x33, 0x84, 0xed, 0xc4, 0x52, 0x3b, 0xc8, 0x93, 0x8e, 0x57, 0x5d, 0xfc, 0xe3, 0x28, 0xfe,
-	0x68, 0x64, 0x64, 0x47, 0xcb, 0xf1, 0x54, 0xd3, 0x57, 0x59, 0x8c, 0xda, 0xbe, 0xff, 0x8c, 0x36,
-	0xef, 0xc9, 0x5d, 0xd1, 0xfc, 0xee, 0x97, 0x9d, 0xe4, 0xce, 0x5c, 0xc9, 0xa8, 0x13, 0x6b, 0x6c,
-	0xc6, 0x03, 0xb8, 0x83, 0x1c, 0x4c, 0xdd, 0x6e, 0x99, 0x54, 0x5a, 0x73, 0xb2, 0xcd, 0x7b, 0xb2,
-	0x2f, 0x2c, 0x69, 0xaa, 0xd4, 0x06, 0x9b, 0x82, 0x6d, 0xad, 0xbf, 0xa1, 0x9d, 0xac, 0x27, 0x38,
-	0xd5, 0x1c, 0xb8, 0x1e, 0xdb, 0xc7, 0x4f, 0xfd, 0x9e, 0xa1, 0x76, 0x9d, 0x00, 0x59, 0x77, 0x83,
-	0xfb, 0x61, 0xa4, 0x7d, 0xda, 0x13, 0xbc, 0x5b, 0xd0, 0xdb, 0x39, 0xfb, 0xd4, 0x92, 0xbb, 0xcd,
-	0x6c, 0xae, 0x0f, 0x1f, 0xa1, 0xda, 0xb4, 0xf2, 0xca, 0xcc, 0x44, 0x45, 0xfc, 0x30, 0x95, 0x49,
-	0x2c, 0xb1, 0x87, 0x16, 0x98, 0x52, 0x40, 0x56, 0x5d, 0x64, 0x3d, 0xfe, 0x5e, 0xa5, 0x7c, 0x84,
-	0x63, 0xe0, 0xaf, 0x90, 0x5d, 0x88, 0x2e, 0x11, 0x1b, 0x26, 0x1c, 0x48, 0x63, 0xe6, 0x31, 0x1c,
-	0x29, 0x15, 0x27, 0x62, 0xb9, 0xe9, 0xd8, 0xf8, 0x9b, 0x49, 0x38, 0xb5, 0x9b, 0x94, 0xe0, 0x99,
-	0x5a, 0x7d, 0x78, 0x97, 0xf7, 0xc3, 0xcc, 0x55, 0xd9, 0x04, 0xc2, 0x5f, 0xa3, 0x50, 0x7a, 0x2e,
-	0xf0, 0x64, 0xde, 0xc7, 0xc6, 0x02, 0xc9, 0x04, 0xc2, 0x87, 0xa8, 0x32, 0x1e, 0xf8, 0x09, 0x58,
-	0x9b, 0x59, 0xb8, 0x3f, 0x9d, 0x45, 0xef, 0x7f, 0x71, 0x3c, 0xc8, 0x5f, 0x7f, 0x1b, 0xd5, 0xdd,
-	0xae, 0x9f, 0xbc, 0x8c, 0xcd, 0xd9, 0xba, 0x2d, 0x61, 0x7a, 0x6b, 0xb1, 0x02, 0xb2, 0x2f, 0x82,
-	0x22, 0xd2, 0x17, 0xf2, 0x82, 0xda, 0x5f, 0x04, 0x75, 0xff, 0x08, 0x5a, 0x6c, 0xf1, 0x2d, 0x27,
-	0xb7, 0x3b, 0xb5, 0xf9, 0xe5, 0x45, 0x97, 0x19, 0xfe, 0xbd, 0x25, 0x86, 0x95, 0xee, 0x85, 0x37,
-	0xfa, 0x0f, 0x39, 0x71, 0x86, 0xb6, 0x07, 0xec, 0x92, 0x6a, 0xfe, 0x0a, 0x1e, 0x4c, 0x42, 0x5c,
-	0x92, 0x0f, 0xa2, 0x24, 0x67, 0xec, 0xb2, 0xcb, 0x5f, 0xc1, 0xbc, 0x3c, 0x64, 0x30, 0xc7, 0x8f,
-	0x7f, 0x45, 0x5b, 0xd1, 0x1a, 0xbf, 0xa2, 0xc5, 0x2f, 0x16, 0x48, 0xd3, 0x65, 0x79, 0xfa, 0xf0,
-	0x3e, 0xff, 0x36, 0xf0, 0xc2, 0x97, 0x98, 0x07, 0x7c, 0xf0, 0x45, 0xe5, 0xcf, 0x3b, 0x52, 0xfe,
-	0xeb, 0x8e, 0x94, 0x8e, 0xb7, 0xaf, 0xff, 0x6f, 0x95, 0xae, 0x6f, 0x5a, 0xe5, 0x37, 0x37, 0xad,
-	0xf2, 0x7f, 0x37, 0xad, 0xf2, 0x1f, 0xb7, 0xad, 0xd2, 0x9b, 0xdb, 0x56, 0xe9, 0x9f, 0xdb, 0x56,
-	0xe9, 0xe5, 0x23, 0x97, 0xe0, 0x93, 0xb7, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x11, 0x84, 0x11,
-	0xe6, 0x08, 0x00, 0x00,
+	// 980 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x55, 0xdf, 0x6e, 0x23, 0xb5,
+	0x17, 0x4e, 0x7e, 0xbf, 0xb2, 0x4d, 0x9d, 0x26, 0x6d, 0xdc, 0x7f, 0xde, 0x6c, 0x9b, 0xad, 0x40,
+	0x48, 0x95, 0x90, 0x5a, 0x51, 0x90, 0x40, 0x20, 0x04, 0x6d, 0x4a, 0x57, 0x95, 0xe8, 0x12, 0x65,
+	0x0b, 0x42, 0x48, 0xc8, 0xf2, 0x4e, 0x3c, 0xc3, 0x08, 0x67, 0xec, 0xb5, 0x9d, 0xb4, 0xdd, 0xa7,
+	0xe0, 0x92, 0x4b, 0x1e, 0xa7, 0x97, 0x7b, 0xc9, 0x15, 0x82, 0xf6, 0x1d, 0x7a, 0x8d, 0xec, 0xb1,
+	0x27, 0x9e, 0x4e, 0x73, 0x37, 0xfe, 0xce, 0x77, 0xbe, 0x73, 0x7c, 0xce, 0x1c, 0x1f, 0xd0, 0x22,
+	0x8c, 0x8d, 0x88, 0x26, 0xfb, 0x42, 0x72, 0xcd, 0xe1, 0x12, 0x1d, 0x25, 0xd4, 0x7e, 0x76, 0x77,
+	0x34, 0xe7, 0x4c, 0x1d, 0xd8, 0x43, 0x42, 0xb3, 0xe2, 0x23, 0x67, 0x76, 0xdb, 0x8a, 0x6a, 0x9d,
+	0x66, 0x89, 0x72, 0xe7, 0xe5, 0x98, 0x91, 0x29, 0x97, 0xee, 0x04, 0xb9, 0xa0, 0x92, 0x68, 0x2e,
+	0x23, 0x3e, 0xa2, 0x0e, 0xeb, 0x48, 0xaa, 0x34, 0x49, 0x34, 0x79, 0xcd, 0x3c, 0xd4, 0x8e, 0x18,
+	0x9f, 0x8c, 0x18, 0xd5, 0xee, 0x0c, 0xde, 0xf2, 0xac, 0xb0, 0x99, 0x6f, 0xc1, 0x39, 0xf3, 0x01,
+	0xa6, 0xe3, 0xe0, 0xb4, 0x4e, 0x26, 0x9a, 0x0b, 0xc9, 0xa7, 0x82, 0xb3, 0x34, 0xba, 0x76, 0xe8,
+	0x86, 0x41, 0x55, 0x44, 0x18, 0x2d, 0xc1, 0x1d, 0x2d, 0x27, 0x4a, 0x97, 0xa0, 0x6e, 0x00, 0xd1,
+	0xab, 0x88, 0x0a, 0x9d, 0x72, 0x7f, 0xb5, 0x56, 0x46, 0xf5, 0x25, 0x97, 0xbf, 0x79, 0xef, 0x88,
+	0x4d, 0x94, 0xa6, 0x32, 0xcd, 0x94, 0xcf, 0x73, 0x89, 0x08, 0xe1, 0xc9, 0x44, 0x88, 0xc0, 0x02,
+	0x24, 0x8d, 0x7d, 0x49, 0x3a, 0x84, 0x51, 0x59, 0x0e, 0xbb, 0x9e, 0x50, 0x1e, 0xd3, 0x2c, 0x2a,
+	0xe7, 0xb7, 0x22, 0x89, 0xa6, 0x2c, 0x1d, 0xa7, 0xba, 0xa0, 0xf1, 0x84, 0xdb, 0xcf, 0x03, 0xf3,
+	0x95, 0xa3, 0xef, 0xdf, 0xb7, 0xc1, 0xe2, 0x11, 0x63, 0x27, 0x44, 0x13, 0xf8, 0x31, 0x58, 0xcc,
+	0x0b, 0xae, 0xd0, 0xff, 0x76, 0xff, 0xbf, 0xd7, 0x3c, 0xec, 0xec, 0x17, 0xad, 0xdb, 0x3f, 0xb5,
+	0x96, 0xe3, 0x85, 0x9b, 0xbf, 0x9f, 0xd7, 0x86, 0x9e, 0x07, 0x0f, 0x40, 0xc3, 0xf7, 0x0c, 0xd5,
+	0x77, 0xeb, 0x7b, 0xcd, 0xc3, 0xb5, 0xc0, 0xe7, 0x95, 0x33, 0x0d, 0x0b, 0x12, 0x3c, 0x01, 0x6d,
+	0xdf, 0x46, 0x6c, 0xfa, 0xa8, 0xd0, 0x82, 0x0d, 0xb5, 0x15, 0xb8, 0x7d, 0xef, 0x08, 0x7d, 0x3e,
+	0xa2, 0x2e, 0x60, 0x8b, 0x07, 0x98, 0x82, 0xc7, 0xa0, 0x2d, 0xa9, 0xc2, 0x9a, 0x24, 0xd8, 0xb6,
+	0x5e, 0xa1, 0x27, 0x56, 0x65, 0x33, 0x50, 0x19, 0x52, 0x75, 0x41, 0x92, 0x0b, 0x63, 0x76, 0x22,
+	0xcb, 0x72, 0x06, 0x29, 0xd8, 0x07, 0x6d, 0xdb, 0x2f, 0x6c, 0xcb, 0x96, 0x52, 0x85, 0xda, 0x15,
+	0x8d, 0x0b, 0x43, 0x18, 0xd8, 0xb2, 0xfa, 0x44, 0x74, 0x01, 0xa5, 0x54, 0xc1, 0x2f, 0x41, 0x33,
+	0x11, 0x13, 0x3c, 0x92, 0xe9, 0x94, 0x4a, 0x85, 0x36, 0xac, 0xc2, 0x7a, 0xa0, 0xf0, 0x62, 0xf0,
+	0xc3, 0x89, 0x35, 0x3a, 0x7f, 0x90, 0x88, 0x49, 0x0e, 0x28, 0xf8, 0x12, 0x74, 0x04, 0x23, 0x3a,
+	0xe6, 0x72, 0x8c, 0x63, 0x4a, 0xf4, 0x44, 0x52, 0x85, 0xb6, 0xad, 0xc4, 0xb3, 0x40, 0x62, 0xe0,
+	0x38, 0xa7, 0x8e, 0xe2, 0x94, 0x56, 0xc5, 0x03, 0x1c, 0x7e, 0x04, 0xde, 0x33, 0xff, 0xb7, 0x42,
+	0x3b, 0x56, 0x63, 0x25, 0xd0, 0xf8, 0x99, 0x67, 0xbe, 0x0a, 0x39, 0x07, 0x7e, 0x06, 0x96, 0xfc,
+	0xa0, 0x28, 0xb4, 0x68, 0x1d, 0xc2, 0xd6, 0xf5, 0x9d, 0xcd, 0x39, 0xcd, 0xb8, 0xa6, 0x83, 0xfe,
+	0x80, 0xd3, 0x2c, 0xe6, 0x0a, 0x35, 0x2a, 0x1d, 0xf4, 0xde, 0x67, 0x59, 0xcc, 0x7d, 0xe1, 0xa2,
+	0x00, 0x53, 0xf0, 0x73, 0x60, 0xe7, 0x12, 0x9b, 0xf1, 0x53, 0x68, 0xa9, 0x12, 0xdf, 0x24, 0x3c,
+	0xe0, 0x9c, 0xf9, 0xf8, 0x6f, 0xdd, 0x59, 0xc1, 0x4f, 0x41, 0xc3, 0xcd, 0x92, 0x42, 0x4f, 0xad,
+	0x1f, 0x0c, 0xfc, 0x5e, 0xe6, 0x26, 0xe7, 0x56, 0x30, 0xe1, 0x39, 0x80, 0x66, 0x8e, 0xb1, 0x19,
+	0xef, 0x59, 0xc7, 0x9b, 0xd6, 0xff, 0x69, 0xe0, 0x7f, 0x34, 0xd1, 0x7c, 0x20, 0xf9, 0xb4, 0xd4,
+	0xf4, 0x55, 0x12, 0xa2, 0xa6, 0xef, 0x3f, 0x81, 0xcd, 0x07, 0x72, 0xd7, 0x38, 0xaf, 0xfd, 0xb2,
+	0x95, 0xdc, 0x99, 0x2b, 0x19, 0x74, 0x62, 0x8d, 0x54, 0x2c, 0x0a, 0x0e, 0x80, 0x85, 0xb1, 0x7d,
+	0x71, 0x66, 0x99, 0xb6, 0xac, 0x6c, 0xf7, 0x81, 0xec, 0x2b, 0x43, 0x2a, 0xa5, 0xda, 0x21, 0x25,
+	0xd8, 0xe4, 0xfa, 0x2b, 0xd8, 0x49, 0x47, 0x8c, 0x62, 0x49, 0x15, 0x95, 0x53, 0xf3, 0xf3, 0x63,
+	0xf7, 0xfa, 0x60, 0xf3, 0xc8, 0x28, 0xb4, 0x6e, 0x07, 0xf7, 0xc3, 0x40, 0xfb, 0x6c, 0xc4, 0xe8,
+	0xb0, 0xa0, 0xf7, 0x73, 0xf6, 0x99, 0x21, 0x0f, 0xbb, 0xe9, 0x5c, 0x1b, 0x3c, 0x02, 0xad, 0xb2,
+	0xf2, 0x4a, 0x65, 0xa2, 0x02, 0xbe, 0x9f, 0xca, 0x28, 0x94, 0x38, 0x07, 0x30, 0xa1, 0x1c, 0xdb,
+	0xf7, 0x6c, 0x76, 0xfb, 0x5e, 0xa5, 0x4f, 0x2f, 0x28, 0x3f, 0x35, 0x9c, 0x72, 0x9f, 0x92, 0x10,
+	0x35, 0x77, 0xdf, 0x03, 0x0b, 0x44, 0x08, 0x85, 0x56, 0xad, 0x40, 0x3b, 0x2c, 0x9f, 0x10, 0xce,
+	0xcb, 0x32, 0xe0, 0x57, 0xc0, 0xbc, 0xba, 0x36, 0x6f, 0x92, 0x45, 0x54, 0xa1, 0x4e, 0xe5, 0xdf,
+	0x3a, 0x12, 0x22, 0xcc, 0x9b, 0xe4, 0x47, 0xcb, 0x86, 0xdf, 0xcc, 0xdc, 0xb1, 0x79, 0xae, 0x11,
+	0xac, 0x5c, 0xdd, 0xb9, 0x0f, 0x69, 0xec, 0x47, 0xb8, 0x49, 0x66, 0x10, 0xfc, 0x1a, 0xf8, 0x4a,
+	0xe4, 0x02, 0xcf, 0xe6, 0xd5, 0x2e, 0x14, 0x88, 0x66, 0x10, 0x3c, 0x04, 0x8d, 0xe9, 0xd8, 0x0d,
+	0xd4, 0x5a, 0xe5, 0xfd, 0xfe, 0xf1, 0x3c, 0x18, 0xa7, 0xc5, 0xe9, 0x38, 0x1f, 0xa6, 0x3e, 0x68,
+	0xdb, 0x85, 0x32, 0x2b, 0xf5, 0x66, 0x35, 0x6f, 0x43, 0x28, 0x3f, 0x82, 0xa4, 0x80, 0x4c, 0x91,
+	0x31, 0x40, 0x31, 0xe3, 0x97, 0xd8, 0x6c, 0x1c, 0x6c, 0x57, 0x0e, 0x2e, 0x96, 0xc2, 0x96, 0x95,
+	0xdb, 0x2d, 0x2d, 0x12, 0x7e, 0x39, 0x24, 0x9a, 0x7e, 0x67, 0x88, 0x7e, 0x43, 0x38, 0xe1, 0x8d,
+	0xf8, 0x31, 0x23, 0x4c, 0xc1, 0xf6, 0x98, 0x5c, 0x61, 0x49, 0xdf, 0xa8, 0x47, 0x83, 0x20, 0x1b,
+	0xe4, 0x83, 0x20, 0xc8, 0x39, 0xb9, 0x1a, 0xd2, 0x37, 0x6a, 0x5e, 0x1c, 0x34, 0x9e, 0x63, 0x87,
+	0xbf, 0x80, 0xad, 0x60, 0x2b, 0x5c, 0xe3, 0x62, 0x8f, 0x2b, 0xd4, 0xb5, 0x51, 0x9e, 0x3f, 0xbe,
+	0x1e, 0xbe, 0xf5, 0x3c, 0x7f, 0x13, 0xfd, 0x88, 0x4d, 0x7d, 0xd1, 0xf8, 0xe3, 0x1e, 0xd5, 0xff,
+	0xbc, 0x47, 0xb5, 0xe3, 0xed, 0x9b, 0x7f, 0x7b, 0xb5, 0x9b, 0xdb, 0x5e, 0xfd, 0xdd, 0x6d, 0xaf,
+	0xfe, 0xcf, 0x6d, 0xaf, 0xfe, 0xfb, 0x5d, 0xaf, 0xf6, 0xee, 0xae, 0x57, 0xfb, 0xeb, 0xae, 0x57,
+	0x7b, 0xfd, 0xc4, 0x06, 0xf8, 0xe4, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xf5, 0x22, 0x74,
+	0x4b, 0x09, 0x00, 0x00,
 }
 
 func (m *AllData) Marshal() (dAtA []byte, err error) {
@@ -186,6 +189,22 @@ func (m *AllData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GeoFencePolicies) > 0 {
+		for iNdEx := len(m.GeoFencePolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GeoFencePolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAlldata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
 	if len(m.Zones) > 0 {
 		for iNdEx := len(m.Zones) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1369,6 +1388,37 @@ func (m *AllData) RemoveZones(vals ...Zone) int {
 	return changes
 }
 
+func (m *AllData) AddGeoFencePolicies(vals ...GeoFencePolicy) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.GeoFencePolicies {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.GeoFencePolicies = append(m.GeoFencePolicies, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AllData) RemoveGeoFencePolicies(vals ...GeoFencePolicy) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.GeoFencePolicies); i >= 0; i-- {
+		if _, found := remove[m.GeoFencePolicies[i].GetKey().GetKeyString()]; found {
+			m.GeoFencePolicies = append(m.GeoFencePolicies[:i], m.GeoFencePolicies[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
 func (m *AllData) DeepCopyIn(src *AllData) {
 	if src.Settings != nil {
 		var tmp_Settings Settings
@@ -1576,6 +1626,14 @@ func (m *AllData) DeepCopyIn(src *AllData) {
 	} else {
 		m.Zones = nil
 	}
+	if src.GeoFencePolicies != nil {
+		m.GeoFencePolicies = make([]GeoFencePolicy, len(src.GeoFencePolicies), len(src.GeoFencePolicies))
+		for ii, s := range src.GeoFencePolicies {
+			m.GeoFencePolicies[ii].DeepCopyIn(&s)
+		}
+	} else {
+		m.GeoFencePolicies = nil
+	}
 }
 
 // Helper method to check that enums have valid values
@@ -1710,6 +1768,11 @@ func (m *AllData) ValidateEnums() error {
 			return err
 		}
 	}
+	for _, e := range m.GeoFencePolicies {
+		if err := e.ValidateEnums(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -1840,6 +1903,11 @@ func (s *AllData) ClearTagged(tags map[string]struct{}) {
 			s.Zones[ii].ClearTagged(tags)
 		}
 	}
+	if s.GeoFencePolicies != nil {
+		for ii := 0; ii < len(s.GeoFencePolicies); ii++ {
+			s.GeoFencePolicies[ii].ClearTagged(tags)
+		}
+	}
 }
 
 func IgnoreAllDataFields(taglist string) cmp.Option {
@@ -2007,6 +2075,9 @@ func IgnoreAllDataFields(taglist string) cmp.Option {
 	if _, found := tags["timestamp"]; found {
 		names = append(names, "Zones.UpdatedAt")
 	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "GeoFencePolicies.DeletePrepare")
+	}
 	return cmpopts.IgnoreFields(AllData{}, names...)
 }
 
@@ -2089,6 +2160,9 @@ func (m *AllData) IsEmpty() bool {
 	if m.Zones != nil {
 		return false
 	}
+	if m.GeoFencePolicies != nil {
+		return false
+	}
 	return true
 }
 
@@ -2215,6 +2289,11 @@ func (m *AllData) StoreRead(ctx context.Context, kvstore objstore.KVStore) error
 		return err
 	}
 	m.Zones = zones
+	geo_fence_policies, err := StoreListGeoFencePolicy(ctx, kvstore)
+	if err != nil {
+		return err
+	}
+	m.GeoFencePolicies = geo_fence_policies
 	return nil
 }
 
@@ -2376,6 +2455,12 @@ func (m *AllData) Size() (n int) {
 			n += 2 + l + sovAlldata(uint64(l))
 		}
 	}
+	if len(m.GeoFencePolicies) > 0 {
+		for _, e := range m.GeoFencePolicies {
+			l = e.Size()
+			n += 2 + l + sovAlldata(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3302,6 +3387,40 @@ func (m *AllData) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeoFencePolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlldata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAlldata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlldata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GeoFencePolicies = append(m.GeoFencePolicies, GeoFencePolicy{})
+			if err := m.GeoFencePolicies[len(m.GeoFencePolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAlldata(dAtA[iNdEx:])
@@ -478,6 +478,12 @@ type App struct {
 	ManagesOwnNamespaces bool `protobuf:"varint,55,opt,name=manages_own_namespaces,json=managesOwnNamespaces,proto3" json:"manages_own_namespaces,omitempty"`
 	// Internal compatibility version
 	CompatibilityVersion uint32 `protobuf:"varint,56,opt,name=compatibility_version,json=compatibilityVersion,proto3" json:"compatibility_version,omitempty"`
+	// Weights used by FindCloudlet to rank AppInsts, overrides the global settings
+	FindCloudletScoreWeights *FindCloudletScoreWeights `protobuf:"bytes,57,opt,name=find_cloudlet_score_weights,json=findCloudletScoreWeights,proto3" json:"find_cloudlet_score_weights,omitempty"`
+	// Geo-fence policy name, restricts which cloudlets may serve devices by location
+	GeoFencePolicy string `protobuf:"bytes,58,opt,name=geo_fence_policy,json=geoFencePolicy,proto3" json:"geo_fence_policy,omitempty"`
+	// Replica auto scale policy for Kubernetes deployments. When set, Kubernetes resources are per replica, and resources for the maximum number of replicas are reserved.
+	ReplicaAutoScalePolicy *ReplicaAutoScalePolicy `protobuf:"bytes,59,opt,name=replica_auto_scale_policy,json=replicaAutoScalePolicy,proto3" json:"replica_auto_scale_policy,omitempty"`
 	// Vendor-specific data
 	Tags map[string]string `protobuf:"bytes,100,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
@@ -559,6 +565,57 @@ func (m *ServerlessConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ServerlessConfig proto.InternalMessageInfo
 
+// ReplicaAutoScalePolicy scales the number of replicas of a Kubernetes App.
+// Cpu and memory targets are applied by a Kubernetes HorizontalPodAutoscaler,
+// an active connections target is applied by the cloudlet's monitoring service.
+type ReplicaAutoScalePolicy struct {
+	// Minimum number of replicas
+	MinReplicas uint32 `protobuf:"varint,1,opt,name=min_replicas,json=minReplicas,proto3" json:"min_replicas,omitempty"`
+	// Maximum number of replicas
+	MaxReplicas uint32 `protobuf:"varint,2,opt,name=max_replicas,json=maxReplicas,proto3" json:"max_replicas,omitempty"`
+	// Target average cpu utilization per replica (percentage 1 to 100 of requested cpu), 0 means disabled
+	TargetCpu uint32 `protobuf:"varint,3,opt,name=target_cpu,json=targetCpu,proto3" json:"target_cpu,omitempty"`
+	// Target average memory utilization per replica (percentage 1 to 100 of requested memory), 0 means disabled
+	TargetMem uint32 `protobuf:"varint,4,opt,name=target_mem,json=targetMem,proto3" json:"target_mem,omitempty"`
+	// Target number of active connections per replica, 0 means disabled
+	TargetActiveConnections uint64 `protobuf:"varint,5,opt,name=target_active_connections,json=targetActiveConnections,proto3" json:"target_active_connections,omitempty"`
+	// Stabilization window is the time for which past recommendations are considered before scaling down
+	StabilizationWindowSec uint32 `protobuf:"varint,6,opt,name=stabilization_window_sec,json=stabilizationWindowSec,proto3" json:"stabilization_window_sec,omitempty"`
+}
+
+func (m *ReplicaAutoScalePolicy) Reset()         { *m = ReplicaAutoScalePolicy{} }
+func (m *ReplicaAutoScalePolicy) String() string { return proto.CompactTextString(m) }
+func (*ReplicaAutoScalePolicy) ProtoMessage()    {}
+func (*ReplicaAutoScalePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{4}
+}
+func (m *ReplicaAutoScalePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicaAutoScalePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicaAutoScalePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplicaAutoScalePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicaAutoScalePolicy.Merge(m, src)
+}
+func (m *ReplicaAutoScalePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicaAutoScalePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicaAutoScalePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicaAutoScalePolicy proto.InternalMessageInfo
+
 type GpuConfig struct {
 	// GPU Type
 	Type GpuType `protobuf:"varint,1,opt,name=type,proto3,enum=edgeproto.GpuType" json:"type,omitempty"`
@@ -574,7 +631,7 @@ func (m *GpuConfig) Reset()         { *m = GpuConfig{} }
 func (m *GpuConfig) String() string { return proto.CompactTextString(m) }
 func (*GpuConfig) ProtoMessage()    {}
 func (*GpuConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{4}
+	return fileDescriptor_e0f9056a14b86d47, []int{5}
 }
 func (m *GpuConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -615,7 +672,7 @@ func (m *AppAutoProvPolicy) Reset()         { *m = AppAutoProvPolicy{} }
 func (m *AppAutoProvPolicy) String() string { return proto.CompactTextString(m) }
 func (*AppAutoProvPolicy) ProtoMessage()    {}
 func (*AppAutoProvPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{5}
+	return fileDescriptor_e0f9056a14b86d47, []int{6}
 }
 func (m *AppAutoProvPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +712,7 @@ func (m *AppAlertPolicy) Reset()         { *m = AppAlertPolicy{} }
 func (m *AppAlertPolicy) String() string { return proto.CompactTextString(m) }
 func (*AppAlertPolicy) ProtoMessage()    {}
 func (*AppAlertPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{6}
+	return fileDescriptor_e0f9056a14b86d47, []int{7}
 }
 func (m *AppAlertPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -697,7 +754,7 @@ func (m *DeploymentZoneRequest) Reset()         { *m = DeploymentZoneRequest{} }
 func (m *DeploymentZoneRequest) String() string { return proto.CompactTextString(m) }
 func (*DeploymentZoneRequest) ProtoMessage()    {}
 func (*DeploymentZoneRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{7}
+	return fileDescriptor_e0f9056a14b86d47, []int{8}
 }
 func (m *DeploymentZoneRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -726,6 +783,47 @@ func (m *DeploymentZoneRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeploymentZoneRequest proto.InternalMessageInfo
 
+// AppHistory is an Application as of a past revision
+type AppHistory struct {
+	// Database revision of the change
+	Revision int64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// App as of the revision
+	Obj App `protobuf:"bytes,2,opt,name=obj,proto3" json:"obj"`
+}
+
+func (m *AppHistory) Reset()         { *m = AppHistory{} }
+func (m *AppHistory) String() string { return proto.CompactTextString(m) }
+func (*AppHistory) ProtoMessage()    {}
+func (*AppHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{9}
+}
+func (m *AppHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppHistory.Merge(m, src)
+}
+func (m *AppHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppHistory proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("edgeproto.ImageType", ImageType_name, ImageType_value)
 	proto.RegisterEnum("edgeproto.QosSessionProfile", QosSessionProfile_name, QosSessionProfile_value)
@@ -741,196 +839,216 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "edgeproto.App.SecretEnvVarsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "edgeproto.App.TagsEntry")
 	proto.RegisterType((*ServerlessConfig)(nil), "edgeproto.ServerlessConfig")
+	proto.RegisterType((*ReplicaAutoScalePolicy)(nil), "edgeproto.ReplicaAutoScalePolicy")
 	proto.RegisterType((*GpuConfig)(nil), "edgeproto.GpuConfig")
 	proto.RegisterType((*AppAutoProvPolicy)(nil), "edgeproto.AppAutoProvPolicy")
 	proto.RegisterType((*AppAlertPolicy)(nil), "edgeproto.AppAlertPolicy")
 	proto.RegisterType((*DeploymentZoneRequest)(nil), "edgeproto.DeploymentZoneRequest")
+	proto.RegisterType((*AppHistory)(nil), "edgeproto.AppHistory")
 }
 
 func init() { proto.RegisterFile("app.proto", fileDescriptor_e0f9056a14b86d47) }
 
 var fileDescriptor_e0f9056a14b86d47 = []byte{
-	// 2873 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
-	0xf5, 0xd7, 0x4a, 0xd4, 0x07, 0x47, 0x22, 0xb5, 0x1a, 0x4b, 0xf6, 0x48, 0xb6, 0x65, 0x99, 0xb6,
-	0xf3, 0x57, 0x14, 0x5a, 0xb2, 0x9d, 0xc4, 0x4e, 0xf4, 0xff, 0xe7, 0xdf, 0xac, 0x24, 0x4a, 0x56,
-	0x45, 0x93, 0xf4, 0x52, 0x1f, 0x71, 0xd1, 0x62, 0x31, 0xe2, 0x8e, 0xa8, 0x8d, 0xf6, 0x63, 0xbc,
-	0x1f, 0x54, 0x99, 0x53, 0x50, 0xa0, 0x87, 0x16, 0x41, 0x91, 0xa6, 0xe8, 0x07, 0x82, 0x02, 0x6d,
-	0x11, 0x14, 0xcd, 0xb1, 0xcd, 0xa5, 0x45, 0x4e, 0x45, 0x4f, 0x46, 0x4e, 0x01, 0x7a, 0x09, 0x7a,
-	0x08, 0xda, 0xa4, 0x87, 0x42, 0xa7, 0x02, 0x91, 0xd4, 0x8f, 0x53, 0x31, 0x33, 0xbb, 0xe4, 0x92,
-	0xa2, 0x81, 0xc8, 0x09, 0xd0, 0xdb, 0xce, 0xef, 0xbd, 0x79, 0xf3, 0xe6, 0x37, 0xef, 0xcd, 0x7b,
-	0x43, 0x82, 0x24, 0xa6, 0x74, 0x96, 0xba, 0x8e, 0xef, 0xc0, 0x24, 0xd1, 0xab, 0x84, 0x7f, 0x4e,
-	0x5c, 0xa8, 0x3a, 0x4e, 0xd5, 0x24, 0x73, 0x98, 0x1a, 0x73, 0xd8, 0xb6, 0x1d, 0x1f, 0xfb, 0x86,
-	0x63, 0x7b, 0x42, 0x71, 0x62, 0xc8, 0x25, 0x5e, 0x60, 0xfa, 0xe1, 0x68, 0xa4, 0x62, 0x3a, 0x81,
-	0x6e, 0x12, 0x7f, 0x8f, 0xd4, 0x23, 0xc8, 0x77, 0x03, 0xcf, 0xa7, 0x8e, 0x69, 0x54, 0x22, 0xe8,
-	0xa2, 0xef, 0x38, 0xa6, 0x37, 0xc7, 0x07, 0x55, 0x62, 0x37, 0x3e, 0x22, 0x93, 0x3b, 0x26, 0xae,
-	0x39, 0x6e, 0x38, 0x1a, 0x76, 0x89, 0xe7, 0x04, 0x6e, 0x85, 0x44, 0x2b, 0xa6, 0x74, 0x52, 0x31,
-	0x2c, 0x6c, 0x86, 0xc3, 0xd1, 0xaa, 0x53, 0x75, 0xf8, 0xe7, 0x1c, 0xfb, 0x6a, 0x28, 0x59, 0x64,
-	0xce, 0x74, 0x2a, 0x62, 0x98, 0xf9, 0x8e, 0x04, 0xfa, 0x14, 0x4a, 0xd7, 0x48, 0x1d, 0xce, 0x82,
-	0x21, 0xc7, 0xad, 0x62, 0xdb, 0x78, 0x8d, 0xef, 0x03, 0x49, 0x53, 0xd2, 0x74, 0x72, 0x01, 0xbc,
-	0x7f, 0x8c, 0xfa, 0x30, 0xa5, 0x8e, 0x5b, 0x55, 0x5b, 0xe4, 0xf0, 0x3c, 0x48, 0xd8, 0xd8, 0x22,
-	0xa8, 0x9b, 0xeb, 0xf5, 0xbf, 0x7f, 0x8c, 0x7a, 0x30, 0xa5, 0x2a, 0x07, 0xe1, 0x55, 0xd0, 0x5f,
-	0x23, 0xae, 0xc7, 0xec, 0xf4, 0xb4, 0xd8, 0xa9, 0x11, 0x57, 0x8d, 0x44, 0xf3, 0x43, 0x7f, 0xfb,
-	0x0c, 0x49, 0xff, 0xfc, 0x0c, 0x49, 0xbf, 0xfe, 0xf9, 0x25, 0x29, 0xf3, 0x02, 0x00, 0x8b, 0x8e,
-	0xbd, 0x63, 0x54, 0x97, 0x0d, 0x93, 0x40, 0x08, 0x12, 0x7b, 0x86, 0xad, 0x0b, 0x37, 0x54, 0xfe,
-	0x0d, 0xcf, 0x82, 0xbe, 0x0a, 0xd7, 0x10, 0x8b, 0xaa, 0xe1, 0x28, 0xf3, 0x43, 0x04, 0x7a, 0x14,
-	0x4a, 0x99, 0x7c, 0xc7, 0x20, 0xa6, 0xee, 0x21, 0x69, 0xaa, 0x87, 0xc9, 0xc5, 0x08, 0x3e, 0x0d,
-	0x7a, 0xf6, 0x48, 0x9d, 0x4f, 0x1a, 0xbc, 0x35, 0x32, 0xdb, 0x38, 0xc2, 0x59, 0xb1, 0xf5, 0x85,
-	0xc4, 0xa3, 0x8f, 0x2f, 0x75, 0xa9, 0x4c, 0x07, 0x5e, 0x01, 0xc0, 0xb0, 0x70, 0x95, 0x68, 0x14,
-	0xfb, 0xbb, 0x28, 0xc1, 0x7d, 0x4f, 0xbc, 0x7b, 0x88, 0x24, 0x35, 0xc9, 0xf1, 0x12, 0xf6, 0x77,
-	0xe1, 0xb3, 0x91, 0x92, 0x5f, 0xa7, 0x04, 0xf5, 0x4e, 0x49, 0xd3, 0xe9, 0x5b, 0xa3, 0x31, 0xb3,
-	0xab, 0x4c, 0xb8, 0x5e, 0xa7, 0x24, 0x9c, 0xc4, 0x3e, 0xe1, 0x65, 0x30, 0x84, 0x2b, 0x15, 0xe2,
-	0x79, 0x1a, 0x75, 0x5c, 0xdf, 0x43, 0xfd, 0x7c, 0x0b, 0x83, 0x02, 0x2b, 0x31, 0x08, 0xae, 0x81,
-	0xb4, 0x4e, 0x76, 0x70, 0x60, 0xfa, 0x9a, 0x38, 0x6a, 0x94, 0xe4, 0x2e, 0xc7, 0x6d, 0x2f, 0x73,
-	0x01, 0xf3, 0x3a, 0x7d, 0x70, 0x8c, 0xfa, 0xc4, 0x90, 0xfb, 0x9f, 0x0a, 0xe7, 0x0a, 0x08, 0xde,
-	0x04, 0xc3, 0x38, 0xf0, 0x77, 0x35, 0x1a, 0x6c, 0x9b, 0x46, 0x45, 0x63, 0x04, 0x0c, 0xf1, 0xed,
-	0x24, 0xdf, 0x7a, 0x6f, 0xbc, 0xd7, 0x76, 0x2a, 0x16, 0x55, 0x53, 0x4c, 0xa3, 0xc4, 0x15, 0x58,
-	0x08, 0x20, 0xd0, 0x5f, 0x71, 0x2c, 0x0b, 0xdb, 0x3a, 0x4a, 0x71, 0xef, 0xa2, 0x21, 0x73, 0x3e,
-	0xfc, 0xd4, 0xb0, 0x5b, 0xf5, 0xd0, 0x2c, 0xe7, 0x77, 0x30, 0xc4, 0x14, 0xb7, 0xea, 0xc1, 0x29,
-	0x30, 0x18, 0xcb, 0x02, 0x94, 0x0e, 0xb7, 0xd7, 0x84, 0xe0, 0x55, 0x00, 0x74, 0x42, 0x4d, 0xa7,
-	0x6e, 0x11, 0xdb, 0x47, 0xc3, 0x31, 0x6e, 0x63, 0x38, 0x7c, 0x1e, 0x9c, 0x69, 0x8e, 0x34, 0x0b,
-	0xdb, 0xc6, 0x0e, 0xf1, 0x7c, 0x24, 0xc7, 0xd4, 0x61, 0x53, 0xe1, 0x5e, 0x28, 0x87, 0x77, 0xc0,
-	0x68, 0x6c, 0x5a, 0x95, 0xd8, 0xc4, 0xc5, 0xbe, 0xe3, 0xa2, 0x91, 0xd8, 0xbc, 0x98, 0xe1, 0x95,
-	0x48, 0x01, 0xde, 0x00, 0xa3, 0xd8, 0xd6, 0x5d, 0xc7, 0xd0, 0x35, 0x8a, 0x2b, 0x7b, 0xec, 0x58,
-	0x79, 0x5c, 0x43, 0xbe, 0x01, 0x18, 0xca, 0x4a, 0x42, 0x54, 0x60, 0xc1, 0x3d, 0x0b, 0xfa, 0x75,
-	0x62, 0x6a, 0x0e, 0xf5, 0xd1, 0x28, 0x3f, 0xfb, 0xb1, 0xd8, 0xf9, 0x2c, 0x11, 0x93, 0xf8, 0xe2,
-	0xf0, 0xfb, 0x74, 0x62, 0x16, 0xa9, 0x0f, 0xe7, 0x18, 0xad, 0x2c, 0x50, 0x3d, 0x34, 0x36, 0xd5,
-	0x33, 0x3d, 0xd8, 0xa2, 0xdf, 0x0c, 0x79, 0x35, 0xd2, 0x82, 0x59, 0x00, 0xbd, 0x0a, 0x36, 0x89,
-	0xb6, 0x6f, 0xf8, 0xbb, 0x5a, 0xc5, 0x0c, 0x3c, 0x9f, 0xb8, 0xe8, 0xec, 0x94, 0x34, 0x3d, 0xa0,
-	0xca, 0x5c, 0xb2, 0x65, 0xf8, 0xbb, 0x8b, 0x02, 0x87, 0xd7, 0x40, 0xda, 0xb0, 0x7d, 0xe2, 0xda,
-	0xd8, 0x0c, 0x43, 0xeb, 0x1c, 0xd7, 0x4c, 0x45, 0xa8, 0x08, 0xae, 0x6b, 0x60, 0xc0, 0x25, 0x35,
-	0x83, 0xe7, 0x24, 0x6a, 0x0f, 0x84, 0x86, 0x08, 0x5e, 0x01, 0x29, 0x67, 0x67, 0xc7, 0xa8, 0x18,
-	0xd8, 0xd4, 0x76, 0x1e, 0xea, 0x36, 0x1a, 0xe7, 0x3c, 0x0c, 0x45, 0xe0, 0xf2, 0x43, 0xdd, 0x66,
-	0x89, 0x66, 0xe9, 0xcf, 0x7b, 0x81, 0x85, 0x26, 0x44, 0x22, 0x8a, 0x11, 0x9c, 0x06, 0x32, 0x0e,
-	0x7c, 0x47, 0xa3, 0xae, 0x53, 0xd3, 0xc4, 0xd5, 0x86, 0x2e, 0x70, 0x8d, 0x34, 0xc3, 0x4b, 0xae,
-	0x53, 0x2b, 0x71, 0x14, 0xde, 0x06, 0x61, 0xe4, 0x8b, 0x1c, 0xba, 0x78, 0x82, 0x47, 0x85, 0x4b,
-	0x39, 0x8f, 0x00, 0x37, 0xbe, 0xe1, 0x33, 0x2c, 0x45, 0x18, 0xc3, 0x1a, 0x75, 0x09, 0xc5, 0x2e,
-	0x41, 0x97, 0xd8, 0x66, 0xc3, 0x03, 0x4e, 0x09, 0x59, 0x49, 0x88, 0xe0, 0xcb, 0x00, 0xb6, 0xb9,
-	0x63, 0x10, 0x0f, 0x4d, 0xb1, 0xd8, 0x5d, 0x80, 0x07, 0xc7, 0x28, 0xad, 0xb4, 0x38, 0xa5, 0xca,
-	0x2d, 0x4e, 0x1a, 0xc4, 0x83, 0xd7, 0x01, 0xf4, 0x89, 0x45, 0x4d, 0xec, 0x13, 0x4d, 0x27, 0xa6,
-	0x61, 0x19, 0xec, 0x24, 0x2e, 0xf3, 0x2d, 0x8d, 0x44, 0x92, 0xa5, 0x48, 0x00, 0x33, 0x20, 0xe5,
-	0xed, 0x19, 0x54, 0xdb, 0xad, 0x84, 0x27, 0x91, 0x11, 0x59, 0xc0, 0xc0, 0xbb, 0x15, 0x71, 0x0e,
-	0x0f, 0x00, 0xa8, 0xb8, 0x04, 0xfb, 0x44, 0xd7, 0xb0, 0x8f, 0xae, 0xf0, 0x04, 0xbf, 0x32, 0xab,
-	0x1b, 0x9e, 0xef, 0x1a, 0xdb, 0x01, 0x83, 0x2d, 0xec, 0x57, 0x76, 0x35, 0x62, 0x57, 0x0d, 0x9b,
-	0xcc, 0xae, 0x1b, 0x16, 0xf1, 0x7c, 0x6c, 0xd1, 0x85, 0x31, 0xb6, 0xc5, 0xb7, 0xde, 0x1b, 0x4f,
-	0xfa, 0x11, 0xc4, 0xd3, 0x3e, 0x19, 0x5a, 0x53, 0x7c, 0x66, 0x3a, 0xa0, 0x7a, 0x64, 0xfa, 0xea,
-	0x17, 0x37, 0x1d, 0x5a, 0x53, 0x7c, 0x76, 0x35, 0xf0, 0x7a, 0x45, 0x74, 0x74, 0x8d, 0x47, 0x57,
-	0x34, 0x84, 0x18, 0x5c, 0x74, 0xc9, 0xc3, 0xc0, 0x70, 0x89, 0xae, 0x39, 0x81, 0xbf, 0xed, 0x04,
-	0xb6, 0xae, 0x55, 0x1c, 0xdb, 0x26, 0x15, 0x71, 0x13, 0x3c, 0xc5, 0x63, 0xfe, 0x5c, 0xec, 0x6c,
-	0xcb, 0xa4, 0x12, 0xb8, 0x86, 0x5f, 0x57, 0x03, 0x93, 0x84, 0x97, 0xef, 0xf9, 0xc8, 0x46, 0x31,
-	0x34, 0xb1, 0xd8, 0xb4, 0x00, 0x9f, 0x06, 0x32, 0x36, 0x4d, 0x67, 0x5f, 0xf3, 0x88, 0x5b, 0x23,
-	0xae, 0x49, 0x3c, 0x0f, 0xfd, 0x0f, 0xf7, 0x62, 0x98, 0xe3, 0xe5, 0x06, 0x0c, 0xef, 0x82, 0x91,
-	0xa6, 0x92, 0x16, 0x56, 0x8b, 0x69, 0xce, 0xc4, 0xf9, 0x16, 0x0f, 0x22, 0x1d, 0x91, 0x7f, 0xaa,
-	0xec, 0xb5, 0x21, 0xf0, 0x7f, 0x41, 0xba, 0x66, 0x69, 0x98, 0x52, 0xcd, 0x09, 0x83, 0xf4, 0x69,
-	0x1e, 0xa4, 0x67, 0x63, 0x66, 0x36, 0x2d, 0x85, 0xd2, 0xa2, 0x88, 0xd2, 0xc1, 0x5a, 0x73, 0x00,
-	0x6f, 0x83, 0x34, 0x36, 0x89, 0xeb, 0x37, 0xa3, 0x6e, 0x86, 0x47, 0xdd, 0xf0, 0xc1, 0x31, 0x1a,
-	0x54, 0x98, 0x24, 0x0c, 0xb9, 0x14, 0x6e, 0x0c, 0x58, 0xbc, 0xe5, 0xc1, 0x99, 0x87, 0x8e, 0xa7,
-	0x79, 0xc4, 0x63, 0xc9, 0xc8, 0x02, 0x77, 0xc7, 0x30, 0x09, 0x7a, 0x86, 0xaf, 0x7c, 0x21, 0xb6,
-	0xf2, 0x7d, 0xc7, 0x2b, 0x0b, 0xa5, 0x92, 0xd0, 0x51, 0x47, 0x1e, 0xb6, 0x43, 0xf0, 0xff, 0xc1,
-	0x68, 0xdc, 0x9a, 0x1e, 0xb8, 0xa2, 0xb4, 0x67, 0xa7, 0xa4, 0xe9, 0x9e, 0x85, 0xa1, 0x7f, 0x7f,
-	0x7c, 0x69, 0x60, 0x29, 0xc4, 0x54, 0xd8, 0x9c, 0x1e, 0x61, 0xf0, 0x32, 0x48, 0x56, 0x4d, 0x67,
-	0x1b, 0x9b, 0x9a, 0xa1, 0xa3, 0xeb, 0xb1, 0x8b, 0x74, 0x40, 0xc0, 0xab, 0x3a, 0xbc, 0x0d, 0x06,
-	0x88, 0x5d, 0xd3, 0x6a, 0xd8, 0xf5, 0xd0, 0x1c, 0x3f, 0xe8, 0xf3, 0xad, 0xf5, 0x75, 0x36, 0x67,
-	0xd7, 0x36, 0xb1, 0xeb, 0xe5, 0x6c, 0xdf, 0xad, 0xab, 0xfd, 0x44, 0x8c, 0xe0, 0x2a, 0x18, 0xf6,
-	0x48, 0xc5, 0x25, 0xbe, 0xd6, 0x98, 0x7e, 0x83, 0x4f, 0xbf, 0xdc, 0x36, 0xbd, 0xcc, 0xb5, 0x5a,
-	0x8c, 0xa4, 0xbc, 0x38, 0xc6, 0x6e, 0x4b, 0x11, 0xa7, 0x9a, 0x69, 0x78, 0xbe, 0x86, 0x79, 0xd0,
-	0xa0, 0x9b, 0x3c, 0xf3, 0x64, 0x21, 0xc9, 0x1b, 0x9e, 0xaf, 0x70, 0x1c, 0xde, 0x07, 0xa3, 0x7b,
-	0xc1, 0x36, 0x71, 0x6d, 0xe2, 0x13, 0x4f, 0x6b, 0xf4, 0x50, 0xe8, 0x16, 0x8f, 0x91, 0xc9, 0xd8,
-	0xea, 0x6b, 0x0d, 0x35, 0x35, 0xd2, 0x52, 0xcf, 0xec, 0x9d, 0x04, 0xe1, 0x57, 0x40, 0xda, 0x76,
-	0x74, 0x12, 0x33, 0xf6, 0x2c, 0x37, 0x86, 0x62, 0xc6, 0x0a, 0x8e, 0x4e, 0x9a, 0x66, 0x52, 0x76,
-	0x7c, 0x08, 0xaf, 0x82, 0x3e, 0x67, 0xfb, 0x55, 0x46, 0xf2, 0x73, 0x9c, 0xe4, 0x54, 0x98, 0x8e,
-	0xe1, 0xe5, 0xdc, 0xeb, 0x6c, 0xbf, 0xba, 0xaa, 0xc3, 0x35, 0x30, 0xcc, 0xa2, 0x31, 0x5e, 0x64,
-	0x9f, 0xe7, 0x94, 0x65, 0xda, 0x28, 0x53, 0x28, 0x55, 0x9a, 0x4a, 0x82, 0xb3, 0x34, 0x6e, 0x01,
-	0xd9, 0x35, 0x6f, 0x78, 0x9a, 0xe7, 0x63, 0x5b, 0xc7, 0xa6, 0x63, 0x13, 0x74, 0x9b, 0xe7, 0xd3,
-	0x90, 0xe1, 0x95, 0x1b, 0x18, 0x7c, 0x0e, 0x9c, 0xb5, 0xb0, 0x8d, 0xab, 0xc4, 0xd3, 0x9c, 0x7d,
-	0x9b, 0x97, 0x45, 0x8f, 0x62, 0xb6, 0xc1, 0x3b, 0x5c, 0x7b, 0x34, 0x94, 0x16, 0xf7, 0xed, 0x42,
-	0x43, 0x06, 0x17, 0xc0, 0x58, 0xc5, 0xb1, 0x28, 0xf6, 0x8d, 0x6d, 0xc3, 0x34, 0xfc, 0xba, 0x16,
-	0x75, 0x82, 0x2f, 0x4c, 0x49, 0xd3, 0xa9, 0xf6, 0xcd, 0x8d, 0xb6, 0xe8, 0x6e, 0x0a, 0x55, 0x98,
-	0x05, 0x09, 0x1f, 0x57, 0x3d, 0xa4, 0xf3, 0x0d, 0xa2, 0xb6, 0x0d, 0xae, 0xe3, 0x6a, 0xb8, 0x2d,
-	0xae, 0x35, 0x31, 0x0f, 0x86, 0xe2, 0x01, 0x02, 0x65, 0xd1, 0xef, 0x89, 0xd6, 0x91, 0xb7, 0x75,
-	0xa3, 0xa0, 0xb7, 0x86, 0xcd, 0x20, 0xec, 0x56, 0x55, 0x31, 0x98, 0xef, 0x7e, 0x41, 0x9a, 0x78,
-	0x19, 0xc0, 0x93, 0x21, 0x76, 0x2a, 0x0b, 0x0a, 0x38, 0xd3, 0x81, 0xf1, 0x53, 0x99, 0xb8, 0x03,
-	0x92, 0x8d, 0x3d, 0x9d, 0x66, 0xe2, 0xfc, 0xbf, 0x24, 0xd6, 0x42, 0xff, 0xfd, 0x33, 0x24, 0xbd,
-	0x7e, 0x88, 0xa4, 0x37, 0x0f, 0x91, 0xf4, 0x93, 0x43, 0x24, 0x3d, 0x62, 0x0c, 0x1f, 0xa1, 0xfc,
-	0x52, 0xbc, 0x1a, 0x66, 0x17, 0xa3, 0x3a, 0x91, 0xdd, 0x88, 0xae, 0xf5, 0xec, 0x12, 0xef, 0x50,
-	0xb2, 0xad, 0x75, 0x30, 0xbb, 0xd8, 0xe1, 0x48, 0xde, 0x3e, 0x42, 0xdf, 0xc0, 0x94, 0xb2, 0x18,
-	0x78, 0x69, 0x8d, 0xd4, 0x67, 0xd9, 0x81, 0x67, 0x45, 0x43, 0xef, 0x71, 0x20, 0xd4, 0xcb, 0x8a,
-	0xc7, 0x02, 0x87, 0x8a, 0xb1, 0xf7, 0x42, 0x36, 0xec, 0x4e, 0x45, 0x63, 0xfb, 0xd2, 0x52, 0xbc,
-	0x57, 0xe5, 0xc6, 0xde, 0x3b, 0x46, 0xf2, 0x1e, 0xa9, 0xbf, 0x14, 0x9f, 0xf4, 0x87, 0x63, 0x84,
-	0x84, 0x4f, 0x6b, 0xa4, 0x3e, 0xdf, 0xea, 0xe5, 0x57, 0x13, 0x03, 0xe7, 0xe5, 0x0b, 0xea, 0x44,
-	0xd4, 0x31, 0x7b, 0xbb, 0x98, 0x95, 0xa0, 0x9a, 0x63, 0x06, 0x16, 0xd1, 0x3c, 0xe3, 0x35, 0x92,
-	0xf9, 0x8d, 0x04, 0xe4, 0xf6, 0x9b, 0x1e, 0x5e, 0x07, 0xbd, 0xb5, 0x0a, 0x0d, 0x3c, 0x4e, 0x70,
-	0xeb, 0x73, 0x60, 0x43, 0x27, 0x95, 0xdb, 0xcf, 0x85, 0x15, 0x49, 0x68, 0xb1, 0xd3, 0x70, 0xb1,
-	0xc5, 0x99, 0x4f, 0xa8, 0xec, 0x93, 0xf5, 0xc2, 0x96, 0x61, 0x6b, 0x2e, 0xa1, 0xa6, 0x51, 0xc1,
-	0x1e, 0x7f, 0xe0, 0xa4, 0xd4, 0x41, 0xcb, 0xb0, 0xd5, 0x10, 0x82, 0x2f, 0x02, 0x50, 0xa5, 0x41,
-	0x54, 0x7e, 0x12, 0x27, 0x9a, 0xf8, 0x15, 0x1a, 0x08, 0x6f, 0xc2, 0xb5, 0x92, 0xd5, 0x08, 0xc8,
-	0xf8, 0x20, 0xd9, 0x90, 0xc2, 0xa7, 0x40, 0x82, 0x57, 0x1e, 0x89, 0xdf, 0xff, 0xb0, 0xd5, 0x02,
-	0xaf, 0x3a, 0x5c, 0xce, 0x02, 0xc4, 0x72, 0x74, 0x62, 0x46, 0x01, 0xc2, 0x07, 0xf0, 0x1c, 0xe8,
-	0xb7, 0x03, 0x4b, 0xab, 0xd2, 0x80, 0xfb, 0xd8, 0xab, 0xf6, 0xd9, 0x81, 0xb5, 0x42, 0x83, 0x68,
-	0x4f, 0x89, 0xc6, 0x9e, 0x32, 0x3f, 0xee, 0x06, 0x23, 0x2c, 0x88, 0x5b, 0x9b, 0xb4, 0x3b, 0xa0,
-	0x9f, 0xdd, 0x38, 0x51, 0x34, 0x76, 0x7c, 0x3b, 0x0d, 0x1e, 0x1c, 0x23, 0xf6, 0xf8, 0xe2, 0xfb,
-	0x60, 0x2f, 0x3c, 0xf6, 0x90, 0xf8, 0xbf, 0x0e, 0x7d, 0xa0, 0x78, 0x27, 0x76, 0x6a, 0xbb, 0xda,
-	0x7a, 0xc3, 0xf9, 0xef, 0x4a, 0x6f, 0x1f, 0xa1, 0x5c, 0x14, 0x6c, 0x62, 0x9d, 0xd6, 0x78, 0x0b,
-	0xb1, 0xb6, 0x90, 0x0b, 0xd1, 0x78, 0x00, 0x7d, 0x70, 0x84, 0x5a, 0x0c, 0xb4, 0x4d, 0xec, 0x30,
-	0xa3, 0x2d, 0x17, 0x32, 0xef, 0x74, 0x83, 0x34, 0x63, 0xa6, 0x59, 0xb3, 0x9f, 0x9c, 0x96, 0x5b,
-	0x60, 0x28, 0xd6, 0x15, 0x44, 0x94, 0x9c, 0xe8, 0x09, 0x06, 0x9b, 0x3d, 0x41, 0x7d, 0xfe, 0x1d,
-	0x46, 0x06, 0xfe, 0x52, 0xc8, 0xc8, 0x72, 0xbb, 0x62, 0x6d, 0x61, 0xad, 0xb9, 0xce, 0x07, 0x47,
-	0x68, 0xfe, 0xb4, 0x44, 0x35, 0x67, 0x67, 0x7e, 0xdb, 0x0d, 0xc6, 0x96, 0x1a, 0x8f, 0xab, 0xaf,
-	0x39, 0x36, 0x51, 0xc9, 0xc3, 0x80, 0xbd, 0xcb, 0xa6, 0x40, 0x0f, 0xa6, 0x34, 0x24, 0x2a, 0xdd,
-	0x4a, 0x94, 0xca, 0x44, 0xf0, 0x2a, 0x48, 0xeb, 0x6e, 0x5d, 0x73, 0x03, 0x5b, 0x13, 0xef, 0x33,
-	0xce, 0xcb, 0x80, 0x3a, 0xa4, 0xbb, 0x75, 0x35, 0xb0, 0x85, 0x59, 0x78, 0x1e, 0x24, 0x59, 0x30,
-	0xb3, 0xc2, 0x19, 0xa5, 0xdc, 0x80, 0x1d, 0x58, 0xac, 0xae, 0x7a, 0xf3, 0xbf, 0x63, 0xd7, 0xdd,
-	0x1a, 0x2b, 0x0d, 0xad, 0x57, 0x1e, 0x43, 0x9a, 0xd7, 0x1e, 0x1b, 0x35, 0xaf, 0xbe, 0x50, 0x9b,
-	0x5f, 0x7f, 0xac, 0x68, 0xb6, 0x1c, 0xfb, 0xdb, 0x47, 0x88, 0xc4, 0x38, 0x9f, 0xed, 0x44, 0xfa,
-	0xec, 0x97, 0x71, 0xeb, 0xcd, 0xbc, 0x21, 0x81, 0x64, 0xe3, 0xf7, 0x02, 0x78, 0x16, 0xc0, 0xd5,
-	0x7b, 0xca, 0x4a, 0x4e, 0x5b, 0x7f, 0x50, 0xca, 0x69, 0x1b, 0x85, 0xb5, 0x42, 0x71, 0xab, 0x20,
-	0x77, 0xc1, 0x31, 0x30, 0x12, 0xc3, 0x97, 0x8a, 0x8b, 0x6b, 0x39, 0x55, 0x96, 0xe0, 0x19, 0x30,
-	0x1c, 0x83, 0xef, 0x2f, 0x16, 0xb7, 0xe4, 0xee, 0x36, 0xf0, 0x6e, 0x2e, 0x7f, 0x4f, 0xee, 0x81,
-	0x10, 0xa4, 0x63, 0x60, 0x71, 0x73, 0x59, 0x4e, 0x9c, 0xc0, 0x14, 0xb9, 0x77, 0xe6, 0x7b, 0x12,
-	0x18, 0x39, 0xd1, 0x5b, 0x32, 0x93, 0xf7, 0x8b, 0x65, 0xad, 0x50, 0xd4, 0x4a, 0xea, 0x6a, 0x51,
-	0x5d, 0x5d, 0x7f, 0x20, 0x77, 0x45, 0x60, 0xbe, 0xb8, 0xa5, 0xe5, 0x95, 0xf5, 0x5c, 0x61, 0xf1,
-	0x81, 0x2c, 0xc1, 0x71, 0x30, 0xc6, 0xc0, 0xf5, 0xbb, 0x6a, 0x71, 0x63, 0xe5, 0x6e, 0x69, 0x63,
-	0x5d, 0x5b, 0x2a, 0x6e, 0x15, 0xb4, 0xb2, 0xdc, 0xfd, 0x38, 0x11, 0xf3, 0xee, 0x31, 0xa2, 0xbc,
-	0x9c, 0x98, 0xf9, 0x95, 0x04, 0x06, 0x63, 0x6d, 0x36, 0x63, 0x62, 0xf3, 0x9e, 0xa6, 0x94, 0x4a,
-	0x5a, 0xb1, 0x1c, 0x23, 0xe8, 0x0c, 0x18, 0x6e, 0xc2, 0xf9, 0xd5, 0xc2, 0xc6, 0x2b, 0xb2, 0x04,
-	0x11, 0x18, 0x6d, 0x82, 0x5b, 0xab, 0x85, 0xa5, 0xe2, 0x56, 0x59, 0xbb, 0x79, 0x43, 0xee, 0x86,
-	0x13, 0xe0, 0xec, 0x49, 0xc9, 0xad, 0x1b, 0x37, 0x6f, 0xc9, 0x3d, 0x8f, 0x95, 0xdd, 0x96, 0x13,
-	0x8f, 0x95, 0xbd, 0x28, 0xf7, 0xce, 0xdc, 0x04, 0xa0, 0xf9, 0xf8, 0x67, 0xe4, 0x16, 0x8a, 0x9a,
-	0xb2, 0xb1, 0x5e, 0xd4, 0x96, 0x72, 0xf9, 0xdc, 0x7a, 0x4e, 0xee, 0x82, 0xc3, 0x60, 0x30, 0x0e,
-	0x48, 0x33, 0x7b, 0x00, 0x34, 0xdf, 0xb9, 0xf0, 0x29, 0x90, 0x51, 0x16, 0x17, 0x73, 0xe5, 0x72,
-	0x78, 0xca, 0xb9, 0x65, 0x65, 0x23, 0xbf, 0xae, 0x2d, 0x17, 0x55, 0x6d, 0x29, 0x57, 0xca, 0x17,
-	0x1f, 0xdc, 0xcb, 0x15, 0xd6, 0xe5, 0x2e, 0x16, 0x24, 0x2d, 0x7a, 0xab, 0x6a, 0x6e, 0x71, 0x5d,
-	0x96, 0xe0, 0x45, 0x30, 0x1e, 0xc7, 0xf3, 0x45, 0x65, 0x49, 0x5b, 0x50, 0xf2, 0x4a, 0x61, 0x31,
-	0xa7, 0xca, 0xdd, 0x33, 0x65, 0xd0, 0x1f, 0x56, 0x0d, 0x38, 0x02, 0x52, 0x2b, 0xa5, 0x0d, 0xa1,
-	0x56, 0x28, 0x16, 0x98, 0x6f, 0x32, 0x18, 0x6a, 0x40, 0x4a, 0x81, 0x1d, 0x65, 0x5c, 0x69, 0x73,
-	0xa5, 0xb4, 0x21, 0x77, 0xb7, 0x28, 0x95, 0x16, 0x57, 0xe5, 0x9e, 0x5b, 0xdf, 0x1f, 0xe2, 0x3f,
-	0x20, 0x2a, 0xd4, 0x80, 0x2c, 0x92, 0x45, 0xb2, 0x29, 0x94, 0xc2, 0xb6, 0x54, 0x9f, 0x88, 0xdf,
-	0x91, 0x2a, 0xff, 0x69, 0x34, 0xf3, 0xf5, 0x83, 0x43, 0x34, 0x13, 0x75, 0xc1, 0x0a, 0xa5, 0x5e,
-	0x56, 0xf4, 0xe8, 0xf7, 0x78, 0x57, 0x99, 0x6d, 0xcf, 0xa5, 0x0f, 0x8f, 0x90, 0xf4, 0xa7, 0x23,
-	0x24, 0x6f, 0xb4, 0xb5, 0xf4, 0xdf, 0xfa, 0xe3, 0x5f, 0x7f, 0xd0, 0x2d, 0x67, 0x06, 0xe7, 0xc4,
-	0x43, 0x78, 0x0e, 0x53, 0x3a, 0x2f, 0xcd, 0x70, 0x77, 0xc4, 0x79, 0xfc, 0x97, 0xdc, 0x11, 0xbf,
-	0x45, 0x44, 0xee, 0x7c, 0x13, 0x24, 0x85, 0xe6, 0xe7, 0xf4, 0xe6, 0xee, 0xe9, 0xbd, 0x69, 0xac,
-	0x2c, 0x1e, 0x3d, 0xd1, 0xca, 0xdf, 0x96, 0x40, 0x7f, 0x79, 0xd7, 0xd9, 0xef, 0xb4, 0x70, 0xdb,
-	0x38, 0xf3, 0xca, 0xc1, 0x21, 0x9a, 0xee, 0xb0, 0xea, 0xa6, 0x41, 0xf6, 0x4f, 0xc7, 0x40, 0x3a,
-	0x93, 0x9c, 0xf3, 0x76, 0x9d, 0xfd, 0xd0, 0x8b, 0x1b, 0x12, 0xfc, 0x99, 0x04, 0x46, 0x15, 0x5d,
-	0x3f, 0xd9, 0x66, 0x5c, 0x68, 0x75, 0xa2, 0x55, 0xda, 0x89, 0x9b, 0xcd, 0x83, 0x43, 0x74, 0xfd,
-	0xf1, 0xdc, 0x74, 0x28, 0x56, 0x8f, 0x22, 0x7a, 0xce, 0x67, 0xce, 0xce, 0x61, 0x5d, 0x67, 0x5e,
-	0xb1, 0xae, 0x83, 0x35, 0x28, 0xa2, 0x20, 0x32, 0xa6, 0x7e, 0x29, 0x81, 0x73, 0x2a, 0xb1, 0x9c,
-	0x1a, 0xf9, 0x12, 0x9c, 0x7c, 0xf0, 0xe4, 0x4e, 0x4e, 0x66, 0xc6, 0xe7, 0x5c, 0xee, 0x47, 0x67,
-	0x3f, 0x7f, 0x24, 0x81, 0x91, 0x90, 0xc9, 0x58, 0x5b, 0x32, 0xde, 0xe6, 0x61, 0x53, 0xd4, 0xc9,
-	0xbd, 0xf2, 0x93, 0xbb, 0x87, 0x32, 0x67, 0x1a, 0x1c, 0x36, 0x3b, 0x0a, 0xe6, 0xd8, 0x4f, 0x25,
-	0x30, 0xda, 0x24, 0xf0, 0x89, 0x7d, 0xfb, 0x82, 0xe7, 0x1b, 0xa3, 0xae, 0xd5, 0xbd, 0x5f, 0x48,
-	0x60, 0x9c, 0x65, 0x02, 0xeb, 0x4f, 0xbc, 0x65, 0xc7, 0x55, 0x28, 0x6d, 0x36, 0x2d, 0x70, 0xaa,
-	0xe5, 0x57, 0xdc, 0x0e, 0xbd, 0xcc, 0x44, 0xbc, 0x01, 0x67, 0xf8, 0x1a, 0xa9, 0x67, 0xf2, 0x07,
-	0x87, 0x68, 0x3c, 0xf2, 0x95, 0x1b, 0x8e, 0xa7, 0xcc, 0xbb, 0x47, 0x48, 0x6a, 0xa4, 0xe6, 0xe5,
-	0xcc, 0x05, 0x9e, 0x12, 0x16, 0xa6, 0xd4, 0xb0, 0xab, 0x73, 0xcd, 0x5f, 0xa3, 0x5f, 0x63, 0xf3,
-	0x44, 0x96, 0xfc, 0x5e, 0x02, 0x29, 0xe6, 0xa3, 0xf8, 0x55, 0xfe, 0xf3, 0xe4, 0xec, 0x1b, 0xd2,
-	0x69, 0x92, 0xb6, 0x53, 0xc2, 0x1e, 0x1c, 0xa1, 0x6b, 0x8d, 0x0e, 0xe7, 0x44, 0x0b, 0x13, 0x6b,
-	0x73, 0x5e, 0x3f, 0x46, 0xd2, 0x47, 0xff, 0x08, 0xb7, 0x33, 0x96, 0x91, 0x45, 0x86, 0x8b, 0x7f,
-	0x18, 0x30, 0xa5, 0x62, 0x0b, 0x0b, 0x17, 0x1e, 0xfd, 0x65, 0xb2, 0xeb, 0xd1, 0x27, 0x93, 0xd2,
-	0x87, 0x9f, 0x4c, 0x4a, 0x7f, 0xfe, 0x64, 0x52, 0x7a, 0xf3, 0xd3, 0xc9, 0xae, 0x0f, 0x3f, 0x9d,
-	0xec, 0xfa, 0xe8, 0xd3, 0xc9, 0xae, 0xed, 0x3e, 0xee, 0xf9, 0xb3, 0xff, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0x85, 0x04, 0x10, 0x37, 0x55, 0x1b, 0x00, 0x00,
+	// 3155 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4b, 0x6c, 0x1c, 0x47,
+	0x7a, 0x66, 0xf3, 0x3d, 0x45, 0xce, 0xb0, 0x59, 0x22, 0xa9, 0x22, 0x25, 0x51, 0xd4, 0xe8, 0x11,
+	0x9a, 0xa6, 0x48, 0x4a, 0xb6, 0x25, 0x99, 0xb6, 0x13, 0x37, 0xc9, 0x21, 0xc5, 0x90, 0x9a, 0x19,
+	0xf5, 0xf0, 0x61, 0x05, 0x0e, 0x0a, 0xc5, 0xee, 0xe2, 0xb0, 0xc5, 0x7e, 0xa9, 0x1f, 0x43, 0x8f,
+	0x4f, 0x46, 0x80, 0x04, 0x48, 0x60, 0x04, 0x86, 0x83, 0x3c, 0x20, 0x04, 0x48, 0x02, 0x23, 0x88,
+	0x8f, 0x89, 0x2f, 0x09, 0x7c, 0x0a, 0xf6, 0x24, 0xf8, 0x64, 0x60, 0x2f, 0xc6, 0x1e, 0x8c, 0x5d,
+	0x7b, 0x0f, 0x0b, 0x9e, 0x16, 0x30, 0xc9, 0x7d, 0x9c, 0x16, 0x55, 0xd5, 0x3d, 0xd3, 0x33, 0x1c,
+	0x01, 0xa6, 0x6c, 0x60, 0x6f, 0x5d, 0xdf, 0xff, 0xd7, 0x5f, 0x7f, 0xff, 0x8f, 0xfa, 0xff, 0xbf,
+	0x1b, 0xa4, 0x88, 0xeb, 0xce, 0xb8, 0x9e, 0x13, 0x38, 0x30, 0x45, 0xf5, 0x32, 0xe5, 0x8f, 0x63,
+	0x17, 0xcb, 0x8e, 0x53, 0x36, 0xe9, 0x2c, 0x71, 0x8d, 0x59, 0x62, 0xdb, 0x4e, 0x40, 0x02, 0xc3,
+	0xb1, 0x7d, 0xc1, 0x38, 0xd6, 0xef, 0x51, 0x3f, 0x34, 0x83, 0x68, 0x35, 0xa8, 0x99, 0x4e, 0xa8,
+	0x9b, 0x34, 0xd8, 0xa7, 0xd5, 0x18, 0x0a, 0xbc, 0xd0, 0x0f, 0x5c, 0xc7, 0x34, 0xb4, 0x18, 0xba,
+	0x14, 0x38, 0x8e, 0xe9, 0xcf, 0xf2, 0x45, 0x99, 0xda, 0xb5, 0x87, 0x58, 0xe4, 0xae, 0x49, 0x2a,
+	0x8e, 0x17, 0xad, 0x06, 0x3c, 0xea, 0x3b, 0xa1, 0xa7, 0xd1, 0xf8, 0xc4, 0xb4, 0x4e, 0x35, 0xc3,
+	0x22, 0x66, 0xb4, 0x1c, 0x2a, 0x3b, 0x65, 0x87, 0x3f, 0xce, 0xb2, 0xa7, 0x1a, 0x93, 0x45, 0x67,
+	0x4d, 0x47, 0x8b, 0x96, 0x19, 0x9f, 0x06, 0x81, 0x61, 0x97, 0x23, 0x19, 0xd9, 0xbf, 0x95, 0x40,
+	0xb7, 0xe2, 0xba, 0x6b, 0xb4, 0x0a, 0x67, 0x40, 0xbf, 0xe3, 0x95, 0x89, 0x6d, 0xbc, 0xcf, 0xdf,
+	0x0b, 0x49, 0x13, 0xd2, 0x64, 0x6a, 0x01, 0x7c, 0x7e, 0x82, 0xba, 0x89, 0xeb, 0x3a, 0x5e, 0x59,
+	0x6d, 0xa0, 0xc3, 0x0b, 0xa0, 0xd3, 0x26, 0x16, 0x45, 0xed, 0x9c, 0xaf, 0xe7, 0xf3, 0x13, 0xd4,
+	0x41, 0x5c, 0x57, 0xe5, 0x20, 0xbc, 0x06, 0x7a, 0x2a, 0xd4, 0xf3, 0x99, 0x9c, 0x8e, 0x06, 0x39,
+	0x15, 0xea, 0xa9, 0x31, 0x69, 0xbe, 0xff, 0x57, 0xdf, 0x21, 0xe9, 0xb7, 0xdf, 0x21, 0xe9, 0xbf,
+	0xff, 0xfd, 0xb2, 0x94, 0xbd, 0x07, 0xc0, 0xa2, 0x63, 0xef, 0x1a, 0xe5, 0x65, 0xc3, 0xa4, 0x10,
+	0x82, 0xce, 0x7d, 0xc3, 0xd6, 0x85, 0x1a, 0x2a, 0x7f, 0x86, 0x23, 0xa0, 0x5b, 0xe3, 0x1c, 0xe2,
+	0x50, 0x35, 0x5a, 0x65, 0xff, 0x71, 0x0c, 0x74, 0x28, 0xae, 0xcb, 0xe8, 0xbb, 0x06, 0x35, 0x75,
+	0x1f, 0x49, 0x13, 0x1d, 0x8c, 0x2e, 0x56, 0xf0, 0x25, 0xd0, 0xb1, 0x4f, 0xab, 0x7c, 0x53, 0xdf,
+	0xed, 0xc1, 0x99, 0x9a, 0x4b, 0x67, 0xc4, 0xab, 0x2f, 0x74, 0x3e, 0xfb, 0xfa, 0x72, 0x9b, 0xca,
+	0x78, 0xe0, 0x55, 0x00, 0x0c, 0x8b, 0x94, 0x29, 0x76, 0x49, 0xb0, 0x87, 0x3a, 0xb9, 0xee, 0x9d,
+	0x9f, 0x1e, 0x21, 0x49, 0x4d, 0x71, 0xbc, 0x48, 0x82, 0x3d, 0xf8, 0x4a, 0xcc, 0x14, 0x54, 0x5d,
+	0x8a, 0xba, 0x26, 0xa4, 0xc9, 0xcc, 0xed, 0xa1, 0x84, 0xd8, 0x55, 0x46, 0xdc, 0xa8, 0xba, 0x34,
+	0xda, 0xc4, 0x1e, 0xe1, 0x15, 0xd0, 0x4f, 0x34, 0x8d, 0xfa, 0x3e, 0x76, 0x1d, 0x2f, 0xf0, 0x51,
+	0x0f, 0x7f, 0x85, 0x3e, 0x81, 0x15, 0x19, 0x04, 0xd7, 0x40, 0x46, 0xa7, 0xbb, 0x24, 0x34, 0x03,
+	0x2c, 0x5c, 0x8f, 0x52, 0x5c, 0xe5, 0xa4, 0xec, 0x65, 0x4e, 0x60, 0x5a, 0x67, 0x0e, 0x4f, 0x50,
+	0xb7, 0x58, 0x72, 0xfd, 0xd3, 0xd1, 0x5e, 0x01, 0xc1, 0x5b, 0x60, 0x80, 0x84, 0xc1, 0x1e, 0x76,
+	0xc3, 0x1d, 0xd3, 0xd0, 0x30, 0x33, 0x40, 0x3f, 0x7f, 0x9d, 0xd4, 0xc7, 0x9f, 0x8d, 0x76, 0xd9,
+	0x8e, 0x66, 0xb9, 0x6a, 0x9a, 0x71, 0x14, 0x39, 0x03, 0x0b, 0x01, 0x04, 0x7a, 0x34, 0xc7, 0xb2,
+	0x88, 0xad, 0xa3, 0x34, 0xd7, 0x2e, 0x5e, 0x32, 0xe5, 0xa3, 0x47, 0x4c, 0xbc, 0xb2, 0x8f, 0x66,
+	0xb8, 0x7d, 0xfb, 0x22, 0x4c, 0xf1, 0xca, 0x3e, 0x9c, 0x00, 0x7d, 0x89, 0xac, 0x40, 0x99, 0xe8,
+	0xf5, 0xea, 0x10, 0xbc, 0x06, 0x80, 0x4e, 0x5d, 0xd3, 0xa9, 0x5a, 0xd4, 0x0e, 0xd0, 0x40, 0xc2,
+	0xb6, 0x09, 0x1c, 0xbe, 0x06, 0xce, 0xd5, 0x57, 0xd8, 0x22, 0xb6, 0xb1, 0x4b, 0xfd, 0x00, 0xc9,
+	0x09, 0x76, 0x58, 0x67, 0x78, 0x10, 0xd1, 0xe1, 0x5d, 0x30, 0x94, 0xd8, 0x56, 0xa6, 0x36, 0xf5,
+	0x48, 0xe0, 0x78, 0x68, 0x30, 0xb1, 0x2f, 0x21, 0x78, 0x25, 0x66, 0x80, 0x73, 0x60, 0x88, 0xd8,
+	0xba, 0xe7, 0x18, 0x3a, 0x76, 0x89, 0xb6, 0xcf, 0xdc, 0xca, 0xe3, 0x1a, 0xf2, 0x17, 0x80, 0x11,
+	0xad, 0x28, 0x48, 0x79, 0x16, 0xdc, 0x33, 0xa0, 0x47, 0xa7, 0x26, 0x76, 0xdc, 0x00, 0x0d, 0x71,
+	0xdf, 0x0f, 0x27, 0xfc, 0xb3, 0x44, 0x4d, 0x1a, 0x08, 0xe7, 0x77, 0xeb, 0xd4, 0x2c, 0xb8, 0x01,
+	0x9c, 0x05, 0x3d, 0x22, 0x50, 0x7d, 0x34, 0x3c, 0xd1, 0x31, 0xd9, 0xd7, 0xc0, 0x5f, 0x0f, 0x79,
+	0x35, 0xe6, 0x82, 0xd3, 0x00, 0xfa, 0x1a, 0x31, 0x29, 0x3e, 0x30, 0x82, 0x3d, 0xac, 0x99, 0xa1,
+	0x1f, 0x50, 0x0f, 0x8d, 0x4c, 0x48, 0x93, 0xbd, 0xaa, 0xcc, 0x29, 0xdb, 0x46, 0xb0, 0xb7, 0x28,
+	0x70, 0x78, 0x1d, 0x64, 0x0c, 0x3b, 0xa0, 0x9e, 0x4d, 0xcc, 0x28, 0xb4, 0xce, 0x73, 0xce, 0x74,
+	0x8c, 0x8a, 0xe0, 0xba, 0x0e, 0x7a, 0x3d, 0x5a, 0x31, 0x78, 0x4e, 0xa2, 0xe6, 0x40, 0xa8, 0x91,
+	0xe0, 0x55, 0x90, 0x76, 0x76, 0x77, 0x0d, 0xcd, 0x20, 0x26, 0xde, 0x7d, 0xa2, 0xdb, 0x68, 0x94,
+	0xdb, 0xa1, 0x3f, 0x06, 0x97, 0x9f, 0xe8, 0x36, 0x4b, 0x34, 0x4b, 0x7f, 0xcd, 0x0f, 0x2d, 0x34,
+	0x26, 0x12, 0x51, 0xac, 0xe0, 0x24, 0x90, 0x49, 0x18, 0x38, 0xd8, 0xf5, 0x9c, 0x0a, 0x16, 0x57,
+	0x1d, 0xba, 0xc8, 0x39, 0x32, 0x0c, 0x2f, 0x7a, 0x4e, 0xa5, 0xc8, 0x51, 0x78, 0x07, 0x44, 0x91,
+	0x2f, 0x72, 0xe8, 0xd2, 0x29, 0x3b, 0x2a, 0x9c, 0xca, 0xed, 0x08, 0x48, 0xed, 0x19, 0xbe, 0xcc,
+	0x52, 0x84, 0x59, 0x18, 0xbb, 0x1e, 0x75, 0x89, 0x47, 0xd1, 0x65, 0xf6, 0xb2, 0x91, 0x83, 0xd3,
+	0x82, 0x56, 0x14, 0x24, 0xf8, 0x36, 0x80, 0x4d, 0xea, 0x18, 0xd4, 0x47, 0x13, 0x2c, 0x76, 0x17,
+	0xe0, 0xe1, 0x09, 0xca, 0x28, 0x0d, 0x4a, 0xa9, 0x72, 0x83, 0x92, 0x06, 0xf5, 0xe1, 0x4d, 0x00,
+	0x03, 0x6a, 0xb9, 0x26, 0x09, 0x28, 0xd6, 0xa9, 0x69, 0x58, 0x06, 0xf3, 0xc4, 0x15, 0xfe, 0x4a,
+	0x83, 0x31, 0x65, 0x29, 0x26, 0xc0, 0x2c, 0x48, 0xfb, 0xfb, 0x86, 0x8b, 0xf7, 0xb4, 0xc8, 0x13,
+	0x59, 0x91, 0x05, 0x0c, 0xbc, 0xaf, 0x09, 0x3f, 0x3c, 0x02, 0x40, 0xf3, 0x28, 0x09, 0xa8, 0x8e,
+	0x49, 0x80, 0xae, 0xf2, 0x04, 0xbf, 0x3a, 0xa3, 0x1b, 0x7e, 0xe0, 0x19, 0x3b, 0x21, 0x83, 0x2d,
+	0x12, 0x68, 0x7b, 0x98, 0xda, 0x65, 0xc3, 0xa6, 0x33, 0x1b, 0x86, 0x45, 0xfd, 0x80, 0x58, 0xee,
+	0xc2, 0x30, 0x7b, 0xc5, 0x8f, 0x3f, 0x1b, 0x4d, 0x05, 0x31, 0xc4, 0xd3, 0x3e, 0x15, 0x49, 0x53,
+	0x02, 0x26, 0x3a, 0x74, 0xf5, 0x58, 0xf4, 0xb5, 0x1f, 0x2e, 0x3a, 0x92, 0xa6, 0x04, 0xec, 0x6a,
+	0xe0, 0xf5, 0x8b, 0xea, 0xe8, 0x3a, 0x8f, 0xae, 0x78, 0x09, 0x09, 0xb8, 0xe4, 0xd1, 0x27, 0xa1,
+	0xe1, 0x51, 0x1d, 0x3b, 0x61, 0xb0, 0xe3, 0x84, 0xb6, 0x8e, 0x35, 0xc7, 0xb6, 0xa9, 0x26, 0x6e,
+	0x82, 0x1b, 0x3c, 0xe6, 0xcf, 0x27, 0x7c, 0x5b, 0xa2, 0x5a, 0xe8, 0x19, 0x41, 0x55, 0x0d, 0x4d,
+	0x1a, 0x5d, 0xbe, 0x17, 0x62, 0x19, 0x85, 0x48, 0xc4, 0x62, 0x5d, 0x02, 0x7c, 0x09, 0xc8, 0xc4,
+	0x34, 0x9d, 0x03, 0xec, 0x53, 0xaf, 0x42, 0x3d, 0x93, 0xfa, 0x3e, 0xfa, 0x13, 0xae, 0xc5, 0x00,
+	0xc7, 0x4b, 0x35, 0x18, 0xde, 0x07, 0x83, 0x75, 0x26, 0x1c, 0x55, 0x8b, 0x49, 0x6e, 0x89, 0x0b,
+	0x0d, 0x1a, 0xc4, 0x3c, 0x22, 0xff, 0x54, 0xd9, 0x6f, 0x42, 0xe0, 0x1b, 0x20, 0x53, 0xb1, 0x30,
+	0x71, 0x5d, 0xec, 0x44, 0x41, 0xfa, 0x12, 0x0f, 0xd2, 0x91, 0x84, 0x98, 0x2d, 0x4b, 0x71, 0xdd,
+	0x82, 0x88, 0xd2, 0xbe, 0x4a, 0x7d, 0x01, 0xef, 0x80, 0x0c, 0x31, 0xa9, 0x17, 0xd4, 0xa3, 0x6e,
+	0x8a, 0x47, 0xdd, 0xc0, 0xe1, 0x09, 0xea, 0x53, 0x18, 0x25, 0x0a, 0xb9, 0x34, 0xa9, 0x2d, 0x58,
+	0xbc, 0xad, 0x83, 0x73, 0x4f, 0x1c, 0x1f, 0xfb, 0xd4, 0x67, 0xc9, 0xc8, 0x02, 0x77, 0xd7, 0x30,
+	0x29, 0x7a, 0x99, 0x9f, 0x7c, 0x31, 0x71, 0xf2, 0x43, 0xc7, 0x2f, 0x09, 0xa6, 0xa2, 0xe0, 0x51,
+	0x07, 0x9f, 0x34, 0x43, 0xf0, 0x4f, 0xc1, 0x50, 0x52, 0x9a, 0x1e, 0x7a, 0xa2, 0xb4, 0x4f, 0x4f,
+	0x48, 0x93, 0x1d, 0x0b, 0xfd, 0xbf, 0xff, 0xfa, 0x72, 0xef, 0x52, 0x84, 0xa9, 0xb0, 0xbe, 0x3d,
+	0xc6, 0xe0, 0x15, 0x90, 0x2a, 0x9b, 0xce, 0x0e, 0x31, 0xb1, 0xa1, 0xa3, 0x9b, 0x89, 0x8b, 0xb4,
+	0x57, 0xc0, 0xab, 0x3a, 0xbc, 0x03, 0x7a, 0xa9, 0x5d, 0xc1, 0x15, 0xe2, 0xf9, 0x68, 0x76, 0xa2,
+	0xa3, 0xc9, 0xcc, 0x8a, 0xeb, 0xce, 0xe4, 0xec, 0xca, 0x16, 0xf1, 0xfc, 0x9c, 0x1d, 0x78, 0x55,
+	0xb5, 0x87, 0x8a, 0x15, 0x5c, 0x05, 0x03, 0x3e, 0xd5, 0x3c, 0x1a, 0xe0, 0xda, 0xf6, 0x39, 0xbe,
+	0xfd, 0x4a, 0xd3, 0xf6, 0x12, 0xe7, 0x6a, 0x10, 0x92, 0xf6, 0x93, 0x18, 0xbb, 0x2d, 0x45, 0x9c,
+	0x62, 0xd3, 0xf0, 0x03, 0x4c, 0x78, 0xd0, 0xa0, 0x5b, 0x3c, 0xf3, 0x64, 0x41, 0x59, 0x37, 0xfc,
+	0x40, 0xe1, 0x38, 0x7c, 0x08, 0x86, 0xf6, 0xc3, 0x1d, 0xea, 0xd9, 0x34, 0xa0, 0x3e, 0xae, 0xf5,
+	0x54, 0xe8, 0x36, 0x8f, 0x91, 0xf1, 0xc4, 0xe9, 0x6b, 0x35, 0x36, 0x35, 0xe6, 0x52, 0xcf, 0xed,
+	0x9f, 0x06, 0xe1, 0x9f, 0x81, 0x8c, 0xed, 0xe8, 0x34, 0x21, 0xec, 0x15, 0x2e, 0x0c, 0x25, 0x84,
+	0xe5, 0x1d, 0x9d, 0xd6, 0xc5, 0xa4, 0xed, 0xe4, 0x12, 0x5e, 0x03, 0xdd, 0xce, 0xce, 0x63, 0x66,
+	0xe4, 0x57, 0xb9, 0x91, 0xd3, 0x51, 0x3a, 0x46, 0x97, 0x73, 0x97, 0xb3, 0xf3, 0x78, 0x55, 0x87,
+	0x6b, 0x60, 0x80, 0x45, 0x63, 0xb2, 0xc8, 0xbe, 0xc6, 0x4d, 0x96, 0x6d, 0x32, 0x99, 0xe2, 0xba,
+	0x4a, 0x9d, 0x49, 0xd8, 0x2c, 0x43, 0x1a, 0x40, 0x76, 0xcd, 0x1b, 0x3e, 0xf6, 0x03, 0x62, 0xeb,
+	0xc4, 0x74, 0x6c, 0x8a, 0xee, 0xf0, 0x7c, 0xea, 0x37, 0xfc, 0x52, 0x0d, 0x83, 0xaf, 0x82, 0x11,
+	0x8b, 0xd8, 0xa4, 0x4c, 0x7d, 0xec, 0x1c, 0xd8, 0xbc, 0x2c, 0xfa, 0x2e, 0x61, 0x2f, 0x78, 0x97,
+	0x73, 0x0f, 0x45, 0xd4, 0xc2, 0x81, 0x9d, 0xaf, 0xd1, 0xe0, 0x02, 0x18, 0xd6, 0x1c, 0xcb, 0x25,
+	0x81, 0xb1, 0x63, 0x98, 0x46, 0x50, 0xc5, 0x71, 0x27, 0x78, 0x6f, 0x42, 0x9a, 0x4c, 0x37, 0xbf,
+	0xdc, 0x50, 0x03, 0xef, 0x96, 0x60, 0x85, 0x3b, 0xe0, 0xc2, 0xae, 0xc1, 0xee, 0x91, 0xa8, 0x8d,
+	0xc6, 0xbe, 0xe6, 0x78, 0x14, 0x1f, 0x50, 0xa3, 0xbc, 0x17, 0xf8, 0xe8, 0xf5, 0xe8, 0x6a, 0x4b,
+	0xb4, 0x45, 0x86, 0xad, 0x2f, 0x46, 0xcc, 0x25, 0xc6, 0xbb, 0x2d, 0x58, 0x55, 0xb4, 0xfb, 0x1c,
+	0x0a, 0x7c, 0x13, 0xc8, 0x65, 0xea, 0xe0, 0x5d, 0x6a, 0x6b, 0x34, 0x2e, 0x56, 0xf3, 0x13, 0x52,
+	0x5c, 0x1b, 0x56, 0xa8, 0xb3, 0xcc, 0x48, 0x51, 0xa2, 0x66, 0xca, 0x0d, 0x6b, 0xf8, 0x2e, 0x18,
+	0xf5, 0xa8, 0x6b, 0x1a, 0x1a, 0xc1, 0xbc, 0xc6, 0x88, 0x82, 0x1d, 0x89, 0x79, 0x63, 0x42, 0x6a,
+	0x0a, 0x65, 0x55, 0xf0, 0xb2, 0x82, 0x53, 0x62, 0x9c, 0x91, 0xd4, 0x11, 0xaf, 0x25, 0x0e, 0xa7,
+	0x41, 0x67, 0x40, 0xca, 0x3e, 0xd2, 0x27, 0x3a, 0x9a, 0x02, 0x89, 0x39, 0x78, 0x83, 0x94, 0x23,
+	0xb7, 0x72, 0xae, 0xb1, 0x79, 0xd0, 0x9f, 0x4c, 0x10, 0x28, 0x8b, 0x7e, 0x57, 0xb4, 0xce, 0xec,
+	0x11, 0x0e, 0x81, 0xae, 0x0a, 0x31, 0xc3, 0xa8, 0x5b, 0x57, 0xc5, 0x62, 0xbe, 0xfd, 0x9e, 0x34,
+	0xf6, 0x36, 0x80, 0xa7, 0x53, 0xec, 0x4c, 0x12, 0x14, 0x70, 0xae, 0x45, 0xc4, 0x9d, 0x49, 0xc4,
+	0x5d, 0x90, 0xaa, 0xbd, 0xd3, 0x59, 0x36, 0xce, 0xff, 0x4e, 0x62, 0x23, 0xc4, 0xaf, 0xbf, 0x43,
+	0xd2, 0x07, 0x47, 0x48, 0xfa, 0xe8, 0x08, 0x49, 0xff, 0x72, 0x84, 0xa4, 0x67, 0x2c, 0xc2, 0x8e,
+	0xd1, 0xfa, 0x52, 0xb2, 0x1b, 0x98, 0x5e, 0x8c, 0xeb, 0xe4, 0xf4, 0x66, 0x5c, 0xd6, 0xa6, 0x97,
+	0x78, 0x87, 0x36, 0xdd, 0xd8, 0x07, 0x4c, 0x2f, 0xb6, 0x08, 0xc9, 0xa7, 0xc7, 0xe8, 0x2f, 0x89,
+	0xeb, 0xb2, 0x1c, 0x78, 0x6b, 0x8d, 0x56, 0x67, 0x58, 0xc0, 0x4f, 0x8b, 0x81, 0xc6, 0xe7, 0x40,
+	0xc4, 0x37, 0x2d, 0x86, 0x25, 0x0e, 0x15, 0x12, 0xf3, 0xd2, 0x74, 0xd4, 0x9d, 0x8b, 0xc6, 0xfe,
+	0xad, 0xa5, 0x64, 0xaf, 0xce, 0x85, 0x7d, 0x76, 0x82, 0xe4, 0x7d, 0x5a, 0x7d, 0x2b, 0xb9, 0xe9,
+	0x27, 0x27, 0x08, 0x09, 0x9d, 0xd6, 0x68, 0x75, 0xbe, 0x51, 0xcb, 0x3f, 0xef, 0xec, 0xbd, 0x20,
+	0x5f, 0x54, 0xc7, 0xe2, 0x89, 0xc1, 0xdf, 0x23, 0xac, 0x04, 0x57, 0x1c, 0x33, 0xb4, 0x28, 0xf6,
+	0x8d, 0xf7, 0x69, 0xf6, 0x7f, 0x24, 0x20, 0x37, 0x57, 0x3a, 0x78, 0x13, 0x74, 0x55, 0x34, 0x37,
+	0xf4, 0x91, 0x74, 0x6a, 0x1c, 0xda, 0xd4, 0xa9, 0x76, 0xe7, 0xd5, 0xa8, 0x22, 0x0b, 0x2e, 0xe6,
+	0x0d, 0x8f, 0x58, 0xdc, 0xf2, 0x9d, 0x2a, 0x7b, 0x64, 0xb3, 0x80, 0x65, 0xd8, 0x38, 0x8a, 0x5c,
+	0x9f, 0x0f, 0x78, 0x69, 0xb5, 0xcf, 0x32, 0xec, 0x28, 0xc8, 0x7d, 0xf8, 0x3a, 0x00, 0x65, 0x37,
+	0x8c, 0xcb, 0x6f, 0xe7, 0xa9, 0x21, 0x66, 0xc5, 0x0d, 0x85, 0x36, 0xd1, 0x59, 0xa9, 0x72, 0x0c,
+	0x64, 0x3f, 0x6e, 0x07, 0x23, 0xad, 0x93, 0xe5, 0xd4, 0xc1, 0xd2, 0xe9, 0x83, 0x19, 0x0b, 0x79,
+	0xaf, 0xce, 0xd2, 0x1e, 0xb1, 0x90, 0xf7, 0x6a, 0x2c, 0x97, 0x00, 0x08, 0x88, 0x57, 0xa6, 0x01,
+	0xd6, 0xdc, 0x30, 0x52, 0x3e, 0x25, 0x90, 0x45, 0x37, 0x4c, 0x90, 0x2d, 0x6a, 0xa1, 0xce, 0x24,
+	0xf9, 0x01, 0xb5, 0xe0, 0x3c, 0x18, 0x8d, 0xc8, 0xac, 0xce, 0x54, 0x68, 0x43, 0xa7, 0xd3, 0xc5,
+	0x8d, 0x74, 0x5e, 0x30, 0x28, 0x9c, 0x9e, 0x6c, 0x63, 0xee, 0x01, 0xe4, 0x07, 0x84, 0x45, 0x95,
+	0xf0, 0x2e, 0x3e, 0x30, 0x6c, 0x9d, 0x77, 0x35, 0x1a, 0xea, 0xe6, 0x07, 0x8d, 0x34, 0xd0, 0xb7,
+	0x39, 0xb9, 0x44, 0xb5, 0x6c, 0x00, 0x52, 0x35, 0x93, 0xc1, 0x1b, 0xa0, 0x93, 0xb7, 0x23, 0x12,
+	0x6f, 0x0a, 0x60, 0xa3, 0x59, 0x79, 0x2b, 0xc2, 0xe9, 0x2c, 0x6b, 0x2c, 0x47, 0xa7, 0x66, 0x9c,
+	0x35, 0x7c, 0x01, 0xcf, 0x83, 0x1e, 0x3b, 0xb4, 0x70, 0x39, 0x7a, 0xf7, 0x2e, 0xb5, 0xdb, 0x0e,
+	0xad, 0x15, 0x37, 0x8c, 0x1d, 0xdd, 0x59, 0x73, 0x74, 0xf6, 0x9f, 0xdb, 0xc1, 0x20, 0xcb, 0xec,
+	0xc6, 0xce, 0xfd, 0x2e, 0xe8, 0x61, 0x65, 0x28, 0x4e, 0xd1, 0x96, 0x03, 0x75, 0xdf, 0xe1, 0x09,
+	0x62, 0x13, 0x39, 0x77, 0x2e, 0x1b, 0xfb, 0xd9, 0x74, 0xf9, 0x66, 0x8b, 0xe1, 0xa0, 0xbd, 0x7e,
+	0xdf, 0x36, 0xf5, 0xe2, 0x4d, 0x03, 0xc3, 0xfc, 0xdf, 0x49, 0x4f, 0x8f, 0x51, 0x2e, 0xce, 0x40,
+	0x71, 0x4e, 0x63, 0x12, 0x46, 0x58, 0x53, 0x1e, 0x46, 0x68, 0x32, 0xab, 0xbe, 0x38, 0x46, 0x0d,
+	0x02, 0x9a, 0x36, 0xb6, 0xd8, 0xd1, 0x74, 0x41, 0x64, 0x3f, 0x69, 0x07, 0x19, 0x66, 0x99, 0x7a,
+	0x23, 0xf7, 0xe2, 0x66, 0xb9, 0x0d, 0xfa, 0x13, 0xad, 0x62, 0x6c, 0x92, 0x53, 0x8d, 0x62, 0x5f,
+	0xbd, 0x51, 0xac, 0xce, 0x7f, 0xc2, 0x8c, 0x41, 0x7e, 0x14, 0x63, 0x4c, 0x73, 0xb9, 0xe2, 0x6c,
+	0x21, 0xad, 0x7e, 0xce, 0x17, 0xc7, 0x68, 0xfe, 0xac, 0x86, 0xaa, 0xef, 0xce, 0xfe, 0x6f, 0x3b,
+	0x18, 0x5e, 0xaa, 0x4d, 0xdc, 0x7f, 0xe1, 0xd8, 0x54, 0xa5, 0x4f, 0x42, 0x36, 0xac, 0x4f, 0x00,
+	0xf6, 0xad, 0x28, 0x32, 0x54, 0xa6, 0xd1, 0x50, 0x2a, 0x23, 0xc1, 0x6b, 0x20, 0xa3, 0x7b, 0x55,
+	0xec, 0x85, 0x36, 0x16, 0x43, 0x3b, 0xb7, 0x4b, 0xaf, 0xda, 0xaf, 0x7b, 0x55, 0x35, 0xb4, 0x85,
+	0x58, 0x78, 0x01, 0xa4, 0x58, 0x30, 0xb3, 0x6e, 0x2a, 0xbe, 0x87, 0x7a, 0xed, 0xd0, 0x62, 0xcd,
+	0x96, 0x3f, 0xff, 0x7f, 0xac, 0x06, 0xac, 0xb1, 0x7a, 0xd9, 0x58, 0x07, 0x18, 0x52, 0xaf, 0x05,
+	0x6c, 0x55, 0xaf, 0x07, 0x11, 0x37, 0xaf, 0x09, 0xac, 0x93, 0x6a, 0x70, 0xfb, 0xd3, 0x63, 0x44,
+	0x13, 0x36, 0x9f, 0x69, 0x65, 0xf4, 0x99, 0x1f, 0xa3, 0x14, 0x64, 0x8b, 0x00, 0x28, 0xae, 0x7b,
+	0xdf, 0xf0, 0x03, 0xc7, 0xab, 0xc2, 0xb1, 0xc4, 0xe4, 0xce, 0x4c, 0xd6, 0x91, 0x18, 0xd7, 0x6f,
+	0x80, 0x0e, 0x67, 0xe7, 0x31, 0x6a, 0x6f, 0x65, 0xc9, 0xf8, 0xbb, 0x96, 0xb3, 0xf3, 0x78, 0xea,
+	0x43, 0x09, 0xa4, 0x6a, 0x9f, 0xa5, 0xe0, 0x08, 0x80, 0xab, 0x0f, 0x94, 0x95, 0x1c, 0xde, 0x78,
+	0x54, 0xcc, 0xe1, 0xcd, 0xfc, 0x5a, 0xbe, 0xb0, 0x9d, 0x97, 0xdb, 0xe0, 0x30, 0x18, 0x4c, 0xe0,
+	0x4b, 0x85, 0xc5, 0xb5, 0x9c, 0x2a, 0x4b, 0xf0, 0x1c, 0x18, 0x48, 0xc0, 0x0f, 0x17, 0x0b, 0xdb,
+	0x72, 0x7b, 0x13, 0x78, 0x3f, 0xb7, 0xfe, 0x40, 0xee, 0x80, 0x10, 0x64, 0x12, 0x60, 0x61, 0x6b,
+	0x59, 0xee, 0x3c, 0x85, 0x29, 0x72, 0xd7, 0xd4, 0xdf, 0x4b, 0x60, 0xf0, 0xd4, 0x08, 0xc3, 0x44,
+	0x3e, 0x2c, 0x94, 0x70, 0xbe, 0x80, 0x8b, 0xea, 0x6a, 0x41, 0x5d, 0xdd, 0x78, 0x24, 0xb7, 0xc5,
+	0xe0, 0x7a, 0x61, 0x1b, 0xaf, 0x2b, 0x1b, 0xb9, 0xfc, 0xe2, 0x23, 0x59, 0x82, 0xa3, 0x60, 0x98,
+	0x81, 0x1b, 0xf7, 0xd5, 0xc2, 0xe6, 0xca, 0xfd, 0xe2, 0xe6, 0x06, 0x5e, 0x2a, 0x6c, 0xe7, 0x71,
+	0x49, 0x6e, 0x7f, 0x1e, 0x89, 0x69, 0xf7, 0x1c, 0xd2, 0xba, 0xdc, 0x39, 0xf5, 0x5f, 0x12, 0xe8,
+	0x4b, 0x4c, 0x73, 0xcc, 0x12, 0x5b, 0x0f, 0xb0, 0x52, 0x2c, 0xe2, 0x42, 0x29, 0x61, 0xa0, 0x73,
+	0x60, 0xa0, 0x0e, 0xaf, 0xaf, 0xe6, 0x37, 0xdf, 0x91, 0x25, 0x88, 0xc0, 0x50, 0x1d, 0xdc, 0x5e,
+	0xcd, 0x2f, 0x15, 0xb6, 0x4b, 0xf8, 0xd6, 0x9c, 0xdc, 0x0e, 0xc7, 0xc0, 0xc8, 0x69, 0xca, 0xed,
+	0xb9, 0x5b, 0xb7, 0xe5, 0x8e, 0xe7, 0xd2, 0xee, 0xc8, 0x9d, 0xcf, 0xa5, 0xbd, 0x2e, 0x77, 0x4d,
+	0xdd, 0x02, 0xa0, 0xfe, 0x8d, 0x89, 0x19, 0x37, 0x5f, 0xc0, 0xca, 0xe6, 0x46, 0x01, 0x2f, 0xe5,
+	0xd6, 0x73, 0x1b, 0x39, 0xb9, 0x0d, 0x0e, 0x80, 0xbe, 0x24, 0x20, 0x4d, 0xed, 0x03, 0x50, 0xff,
+	0x9c, 0x02, 0x6f, 0x80, 0xac, 0xb2, 0xb8, 0x98, 0x2b, 0x95, 0x22, 0x2f, 0xe7, 0x96, 0x95, 0xcd,
+	0xf5, 0x0d, 0xbc, 0x5c, 0x50, 0xf1, 0x52, 0xae, 0xb8, 0x5e, 0x78, 0xf4, 0x20, 0x97, 0xdf, 0x90,
+	0xdb, 0x58, 0x90, 0x34, 0xf0, 0xad, 0xaa, 0xb9, 0xc5, 0x0d, 0x59, 0x82, 0x97, 0xc0, 0x68, 0x12,
+	0x5f, 0x2f, 0x28, 0x4b, 0x78, 0x41, 0x59, 0x57, 0xf2, 0x8b, 0x39, 0x55, 0x6e, 0x9f, 0x2a, 0x81,
+	0x9e, 0xa8, 0x0e, 0xc1, 0x41, 0x90, 0x5e, 0x29, 0x6e, 0x0a, 0xb6, 0x7c, 0x21, 0xcf, 0x74, 0x93,
+	0x41, 0x7f, 0x0d, 0x52, 0xf2, 0xcc, 0x95, 0x49, 0xa6, 0xad, 0x95, 0xe2, 0xa6, 0xdc, 0xde, 0xc0,
+	0x54, 0x5c, 0x5c, 0x95, 0x3b, 0x6e, 0x3f, 0x4d, 0xf3, 0xef, 0xd4, 0x8a, 0x6b, 0x40, 0x16, 0xc9,
+	0x22, 0x7d, 0xd9, 0x27, 0xdf, 0xa6, 0x90, 0x1f, 0x1b, 0x6c, 0xe8, 0xb9, 0xd9, 0x17, 0xf9, 0xec,
+	0xbb, 0x87, 0x47, 0x68, 0x2a, 0x1e, 0xb6, 0x14, 0xd7, 0xf5, 0xa7, 0xc5, 0x28, 0xf8, 0x80, 0x0f,
+	0x2f, 0xd3, 0xcd, 0xd9, 0xf9, 0xe5, 0x31, 0x92, 0x7e, 0x76, 0x8c, 0xe4, 0xcd, 0xa6, 0xc9, 0xf1,
+	0xaf, 0x7e, 0xfa, 0xcb, 0x7f, 0x68, 0x97, 0xe7, 0xa5, 0xa9, 0x6c, 0xdf, 0xac, 0xf8, 0xe4, 0x32,
+	0xcb, 0x2e, 0x2a, 0xa6, 0x8e, 0xf0, 0xc7, 0x1f, 0x4f, 0x1d, 0xf1, 0xd5, 0x8b, 0xab, 0xf3, 0x1e,
+	0x48, 0x09, 0xce, 0xef, 0xa9, 0xcd, 0xfd, 0xb3, 0x6b, 0x93, 0x3c, 0x59, 0x8c, 0xd7, 0xfc, 0xe4,
+	0xbf, 0x96, 0x40, 0x4f, 0x69, 0xcf, 0x39, 0x68, 0x75, 0x70, 0xd3, 0x3a, 0xfb, 0xce, 0xe1, 0x11,
+	0x9a, 0x6c, 0x71, 0xea, 0x96, 0x41, 0x0f, 0xce, 0x66, 0x81, 0x0c, 0xd3, 0x23, 0x35, 0xeb, 0xef,
+	0x39, 0x07, 0x4c, 0x8b, 0x39, 0x09, 0xfe, 0x8d, 0x04, 0x32, 0x91, 0x1e, 0xf1, 0x05, 0xda, 0xac,
+	0xce, 0x70, 0xe3, 0x3a, 0x62, 0xcb, 0xae, 0x9d, 0x45, 0xab, 0x67, 0xb1, 0x25, 0x86, 0x99, 0x06,
+	0x72, 0x4d, 0x83, 0x3d, 0x21, 0x6c, 0x4e, 0x82, 0xff, 0x26, 0x81, 0x21, 0x45, 0xd7, 0x4f, 0x77,
+	0x50, 0x17, 0x1b, 0x8f, 0x6f, 0xa4, 0xb6, 0x72, 0xd2, 0xd6, 0xe1, 0x11, 0xba, 0xf9, 0x7c, 0x27,
+	0xb5, 0xa8, 0xc3, 0x35, 0xed, 0x2e, 0x30, 0xed, 0x46, 0x66, 0x89, 0xae, 0x33, 0xe5, 0x58, 0x4f,
+	0xc5, 0xda, 0x2f, 0x51, 0xee, 0xe1, 0x7f, 0x4a, 0xe0, 0xbc, 0x4a, 0x2d, 0xa7, 0x42, 0x7f, 0x04,
+	0x25, 0x1f, 0xbd, 0xb8, 0x92, 0xe3, 0x4c, 0xc9, 0xd1, 0x59, 0x8f, 0xab, 0xd2, 0x42, 0xcf, 0x7f,
+	0x92, 0xc0, 0x60, 0x64, 0xc9, 0x44, 0xc7, 0x35, 0xda, 0xa4, 0x61, 0x9d, 0xd4, 0x4a, 0xbd, 0xd2,
+	0x8b, 0xab, 0x87, 0x98, 0x7a, 0xe7, 0x6a, 0x36, 0xac, 0xf7, 0x4b, 0xf0, 0x5f, 0x25, 0x30, 0x54,
+	0x37, 0xe0, 0x0b, 0xeb, 0xf6, 0xc3, 0xfd, 0x9b, 0x30, 0x5d, 0x42, 0xbd, 0xff, 0x90, 0xc0, 0x28,
+	0x4b, 0x05, 0xd6, 0x7a, 0xf9, 0xcb, 0x8e, 0xa7, 0xb8, 0x6e, 0xbd, 0x1f, 0x83, 0x13, 0x0d, 0x7f,
+	0x2d, 0x5a, 0xb4, 0x69, 0x63, 0xc9, 0xd9, 0x82, 0xe1, 0x6b, 0xb4, 0x9a, 0x5d, 0x3f, 0x3c, 0x42,
+	0xa3, 0xb1, 0xae, 0x5c, 0x70, 0x32, 0x4b, 0x3e, 0x3d, 0x46, 0x52, 0xed, 0x8e, 0xb8, 0xc2, 0x74,
+	0xbb, 0xc8, 0x33, 0xc3, 0x22, 0xae, 0x6b, 0xd8, 0xe5, 0xd9, 0xfa, 0x0f, 0x98, 0xf7, 0xd9, 0xd6,
+	0x39, 0x09, 0xfe, 0xbf, 0x04, 0xd2, 0x4c, 0x47, 0xf1, 0x17, 0xea, 0xfb, 0x5c, 0x1e, 0x1f, 0x4a,
+	0x67, 0xc9, 0xd3, 0x56, 0x37, 0xc7, 0xe1, 0x31, 0xba, 0x5e, 0x6b, 0xde, 0x4e, 0x75, 0x67, 0x89,
+	0x0e, 0xee, 0x83, 0x13, 0x24, 0x7d, 0xf5, 0x9b, 0x16, 0x89, 0x2e, 0x7e, 0xaa, 0x11, 0xd7, 0xf5,
+	0xe7, 0xa4, 0x85, 0x8b, 0xcf, 0x7e, 0x31, 0xde, 0xf6, 0xec, 0x9b, 0x71, 0xe9, 0xcb, 0x6f, 0xc6,
+	0xa5, 0x9f, 0x7f, 0x33, 0x2e, 0x7d, 0xf4, 0xed, 0x78, 0xdb, 0x97, 0xdf, 0x8e, 0xb7, 0x7d, 0xf5,
+	0xed, 0x78, 0xdb, 0x4e, 0x37, 0xd7, 0xfc, 0x95, 0x3f, 0x0c, 0x00, 0x71, 0x4c, 0x9c, 0x0d, 0x55,
+	0x1e, 0x00, 0x00,
 }
 
 func (this *AppKey) GoString() string {
@@ -974,6 +1092,8 @@ type AppApiClient interface {
 	UpdateApp(ctx context.Context, in *App, opts ...grpc.CallOption) (*Result, error)
 	// Show Applications. Lists all application definitions. Any fields specified will be used to filter results.
 	ShowApp(ctx context.Context, in *App, opts ...grpc.CallOption) (AppApi_ShowAppClient, error)
+	// Show the past revisions of an Application, newest first
+	ShowAppHistory(ctx context.Context, in *App, opts ...grpc.CallOption) (AppApi_ShowAppHistoryClient, error)
 	// Add an AutoProvPolicy to the application definition
 	AddAppAutoProvPolicy(ctx context.Context, in *AppAutoProvPolicy, opts ...grpc.CallOption) (*Result, error)
 	// Remove an AutoProvPolicy from the application definition
@@ -1055,6 +1175,38 @@ func (x *appApiShowAppClient) Recv() (*App, error) {
 	return m, nil
 }
 
+func (c *appApiClient) ShowAppHistory(ctx context.Context, in *App, opts ...grpc.CallOption) (AppApi_ShowAppHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AppApi_serviceDesc.Streams[1], "/edgeproto.AppApi/ShowAppHistory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &appApiShowAppHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AppApi_ShowAppHistoryClient interface {
+	Recv() (*AppHistory, error)
+	grpc.ClientStream
+}
+
+type appApiShowAppHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *appApiShowAppHistoryClient) Recv() (*AppHistory, error) {
+	m := new(AppHistory)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *appApiClient) AddAppAutoProvPolicy(ctx context.Context, in *AppAutoProvPolicy, opts ...grpc.CallOption) (*Result, error) {
 	out := new(Result)
 	err := c.cc.Invoke(ctx, "/edgeproto.AppApi/AddAppAutoProvPolicy", in, out, opts...)
@@ -1092,7 +1244,7 @@ func (c *appApiClient) RemoveAppAlertPolicy(ctx context.Context, in *AppAlertPol
 }
 
 func (c *appApiClient) ShowZonesForAppDeployment(ctx context.Context, in *DeploymentZoneRequest, opts ...grpc.CallOption) (AppApi_ShowZonesForAppDeploymentClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_AppApi_serviceDesc.Streams[1], "/edgeproto.AppApi/ShowZonesForAppDeployment", opts...)
+	stream, err := c.cc.NewStream(ctx, &_AppApi_serviceDesc.Streams[2], "/edgeproto.AppApi/ShowZonesForAppDeployment", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1124,7 +1276,7 @@ func (x *appApiShowZonesForAppDeploymentClient) Recv() (*ZoneKey, error) {
 }
 
 func (c *appApiClient) ShowPublicApp(ctx context.Context, in *App, opts ...grpc.CallOption) (AppApi_ShowPublicAppClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_AppApi_serviceDesc.Streams[2], "/edgeproto.AppApi/ShowPublicApp", opts...)
+	stream, err := c.cc.NewStream(ctx, &_AppApi_serviceDesc.Streams[3], "/edgeproto.AppApi/ShowPublicApp", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1165,6 +1317,8 @@ type AppApiServer interface {
 	UpdateApp(context.Context, *App) (*Result, error)
 	// Show Applications. Lists all application definitions. Any fields specified will be used to filter results.
 	ShowApp(*App, AppApi_ShowAppServer) error
+	// Show the past revisions of an Application, newest first
+	ShowAppHistory(*App, AppApi_ShowAppHistoryServer) error
 	// Add an AutoProvPolicy to the application definition
 	AddAppAutoProvPolicy(context.Context, *AppAutoProvPolicy) (*Result, error)
 	// Remove an AutoProvPolicy from the application definition
@@ -1195,6 +1349,9 @@ func (*UnimplementedAppApiServer) UpdateApp(ctx context.Context, req *App) (*Res
 func (*UnimplementedAppApiServer) ShowApp(req *App, srv AppApi_ShowAppServer) error {
 	return status.Errorf(codes.Unimplemented, "method ShowApp not implemented")
 }
+func (*UnimplementedAppApiServer) ShowAppHistory(req *App, srv AppApi_ShowAppHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method ShowAppHistory not implemented")
+}
 func (*UnimplementedAppApiServer) AddAppAutoProvPolicy(ctx context.Context, req *AppAutoProvPolicy) (*Result, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddAppAutoProvPolicy not implemented")
 }
@@ -1293,6 +1450,27 @@ func (x *appApiShowAppServer) Send(m *App) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _AppApi_ShowAppHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(App)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AppApiServer).ShowAppHistory(m, &appApiShowAppHistoryServer{stream})
+}
+
+type AppApi_ShowAppHistoryServer interface {
+	Send(*AppHistory) error
+	grpc.ServerStream
+}
+
+type appApiShowAppHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *appApiShowAppHistoryServer) Send(m *AppHistory) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _AppApi_AddAppAutoProvPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AppAutoProvPolicy)
 	if err := dec(in); err != nil {
@@ -1446,6 +1624,11 @@ var _AppApi_serviceDesc = grpc.ServiceDesc{
 			Handler:       _AppApi_ShowApp_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ShowAppHistory",
+			Handler:       _AppApi_ShowAppHistory_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ShowZonesForAppDeployment",
 			Handler:       _AppApi_ShowZonesForAppDeployment_Handler,
@@ -1582,6 +1765,43 @@ func (m *App) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xa2
 		}
 	}
+	if m.ReplicaAutoScalePolicy != nil {
+		{
+			size, err := m.ReplicaAutoScalePolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.GeoFencePolicy) > 0 {
+		i -= len(m.GeoFencePolicy)
+		copy(dAtA[i:], m.GeoFencePolicy)
+		i = encodeVarintApp(dAtA, i, uint64(len(m.GeoFencePolicy)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.FindCloudletScoreWeights != nil {
+		{
+			size, err := m.FindCloudletScoreWeights.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xca
+	}
 	if m.CompatibilityVersion != 0 {
 		i = encodeVarintApp(dAtA, i, uint64(m.CompatibilityVersion))
 		i--
@@ -2142,6 +2362,59 @@ func (m *ServerlessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReplicaAutoScalePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicaAutoScalePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicaAutoScalePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StabilizationWindowSec != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.StabilizationWindowSec))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TargetActiveConnections != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.TargetActiveConnections))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TargetMem != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.TargetMem))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TargetCpu != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.TargetCpu))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxReplicas != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.MaxReplicas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinReplicas != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.MinReplicas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GpuConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2317,6 +2590,44 @@ func (m *DeploymentZoneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AppHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Obj.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Revision != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintApp(dAtA []byte, offset int, v uint64) int {
 	offset -= sovApp(v)
 	base := offset
@@ -2820,6 +3131,23 @@ func (m *App) Matches(o *App, fopts ...MatchOpt) bool {
 			}
 		}
 	}
+	if !opts.Filter || o.FindCloudletScoreWeights != nil {
+		if m.FindCloudletScoreWeights == nil && o.FindCloudletScoreWeights != nil || m.FindCloudletScoreWeights != nil && o.FindCloudletScoreWeights == nil {
+			return false
+		} else if m.FindCloudletScoreWeights != nil && o.FindCloudletScoreWeights != nil {
+		}
+	}
+	if !opts.Filter || o.GeoFencePolicy != "" {
+		if o.GeoFencePolicy != m.GeoFencePolicy {
+			return false
+		}
+	}
+	if !opts.Filter || o.ReplicaAutoScalePolicy != nil {
+		if m.ReplicaAutoScalePolicy == nil && o.ReplicaAutoScalePolicy != nil || m.ReplicaAutoScalePolicy != nil && o.ReplicaAutoScalePolicy == nil {
+			return false
+		} else if m.ReplicaAutoScalePolicy != nil && o.ReplicaAutoScalePolicy != nil {
+		}
+	}
 	if !opts.Filter || o.Tags != nil {
 		if len(m.Tags) == 0 && len(o.Tags) > 0 || len(m.Tags) > 0 && len(o.Tags) == 0 {
 			return false
@@ -2991,6 +3319,19 @@ const AppFieldAppAnnotationsValue = "53.2"
 const AppFieldIsStandalone = "54"
 const AppFieldManagesOwnNamespaces = "55"
 const AppFieldCompatibilityVersion = "56"
+const AppFieldFindCloudletScoreWeights = "57"
+const AppFieldFindCloudletScoreWeightsDistance = "57.1"
+const AppFieldFindCloudletScoreWeightsLatency = "57.2"
+const AppFieldFindCloudletScoreWeightsResourceUsage = "57.3"
+const AppFieldFindCloudletScoreWeightsHealth = "57.4"
+const AppFieldGeoFencePolicy = "58"
+const AppFieldReplicaAutoScalePolicy = "59"
+const AppFieldReplicaAutoScalePolicyMinReplicas = "59.1"
+const AppFieldReplicaAutoScalePolicyMaxReplicas = "59.2"
+const AppFieldReplicaAutoScalePolicyTargetCpu = "59.3"
+const AppFieldReplicaAutoScalePolicyTargetMem = "59.4"
+const AppFieldReplicaAutoScalePolicyTargetActiveConnections = "59.5"
+const AppFieldReplicaAutoScalePolicyStabilizationWindowSec = "59.6"
 const AppFieldTags = "100"
 const AppFieldTagsKey = "100.1"
 const AppFieldTagsValue = "100.2"
@@ -3116,6 +3457,17 @@ var AppAllFields = []string{
 	AppFieldIsStandalone,
 	AppFieldManagesOwnNamespaces,
 	AppFieldCompatibilityVersion,
+	AppFieldFindCloudletScoreWeightsDistance,
+	AppFieldFindCloudletScoreWeightsLatency,
+	AppFieldFindCloudletScoreWeightsResourceUsage,
+	AppFieldFindCloudletScoreWeightsHealth,
+	AppFieldGeoFencePolicy,
+	AppFieldReplicaAutoScalePolicyMinReplicas,
+	AppFieldReplicaAutoScalePolicyMaxReplicas,
+	AppFieldReplicaAutoScalePolicyTargetCpu,
+	AppFieldReplicaAutoScalePolicyTargetMem,
+	AppFieldReplicaAutoScalePolicyTargetActiveConnections,
+	AppFieldReplicaAutoScalePolicyStabilizationWindowSec,
 	AppFieldTagsKey,
 	AppFieldTagsValue,
 }
@@ -3241,6 +3593,17 @@ var AppAllFieldsMap = NewFieldMap(map[string]struct{}{
 	AppFieldIsStandalone:                                         struct{}{},
 	AppFieldManagesOwnNamespaces:                                 struct{}{},
 	AppFieldCompatibilityVersion:                                 struct{}{},
+	AppFieldFindCloudletScoreWeightsDistance:                     struct{}{},
+	AppFieldFindCloudletScoreWeightsLatency:                      struct{}{},
+	AppFieldFindCloudletScoreWeightsResourceUsage:                struct{}{},
+	AppFieldFindCloudletScoreWeightsHealth:                       struct{}{},
+	AppFieldGeoFencePolicy:                                       struct{}{},
+	AppFieldReplicaAutoScalePolicyMinReplicas:                    struct{}{},
+	AppFieldReplicaAutoScalePolicyMaxReplicas:                    struct{}{},
+	AppFieldReplicaAutoScalePolicyTargetCpu:                      struct{}{},
+	AppFieldReplicaAutoScalePolicyTargetMem:                      struct{}{},
+	AppFieldReplicaAutoScalePolicyTargetActiveConnections:        struct{}{},
+	AppFieldReplicaAutoScalePolicyStabilizationWindowSec:         struct{}{},
 	AppFieldTagsKey:                                              struct{}{},
 	AppFieldTagsValue:                                            struct{}{},
 })
@@ -3366,6 +3729,17 @@ var AppAllFieldsStringMap = map[string]string{
 	AppFieldIsStandalone:                                         "Is Standalone",
 	AppFieldManagesOwnNamespaces:                                 "Manages Own Namespaces",
 	AppFieldCompatibilityVersion:                                 "Compatibility Version",
+	AppFieldFindCloudletScoreWeightsDistance:                     "Find Cloudlet Score Weights Distance",
+	AppFieldFindCloudletScoreWeightsLatency:                      "Find Cloudlet Score Weights Latency",
+	AppFieldFindCloudletScoreWeightsResourceUsage:                "Find Cloudlet Score Weights Resource Usage",
+	AppFieldFindCloudletScoreWeightsHealth:                       "Find Cloudlet Score Weights Health",
+	AppFieldGeoFencePolicy:                                       "Geo Fence Policy",
+	AppFieldReplicaAutoScalePolicyMinReplicas:                    "Replica Auto Scale Policy Min Replicas",
+	AppFieldReplicaAutoScalePolicyMaxReplicas:                    "Replica Auto Scale Policy Max Replicas",
+	AppFieldReplicaAutoScalePolicyTargetCpu:                      "Replica Auto Scale Policy Target Cpu",
+	AppFieldReplicaAutoScalePolicyTargetMem:                      "Replica Auto Scale Policy Target Mem",
+	AppFieldReplicaAutoScalePolicyTargetActiveConnections:        "Replica Auto Scale Policy Target Active Connections",
+	AppFieldReplicaAutoScalePolicyStabilizationWindowSec:         "Replica Auto Scale Policy Stabilization Window Sec",
 	AppFieldTagsKey:                                              "Tags Key",
 	AppFieldTagsValue:                                            "Tags Value",
 }
@@ -4172,6 +4546,57 @@ func (m *App) DiffFields(o *App, fields *FieldMap) {
 	if m.CompatibilityVersion != o.CompatibilityVersion {
 		fields.Set(AppFieldCompatibilityVersion)
 	}
+	if m.FindCloudletScoreWeights != nil && o.FindCloudletScoreWeights != nil {
+		if m.FindCloudletScoreWeights.Distance != o.FindCloudletScoreWeights.Distance {
+			fields.Set(AppFieldFindCloudletScoreWeightsDistance)
+			fields.Set(AppFieldFindCloudletScoreWeights)
+		}
+		if m.FindCloudletScoreWeights.Latency != o.FindCloudletScoreWeights.Latency {
+			fields.Set(AppFieldFindCloudletScoreWeightsLatency)
+			fields.Set(AppFieldFindCloudletScoreWeights)
+		}
+		if m.FindCloudletScoreWeights.ResourceUsage != o.FindCloudletScoreWeights.ResourceUsage {
+			fields.Set(AppFieldFindCloudletScoreWeightsResourceUsage)
+			fields.Set(AppFieldFindCloudletScoreWeights)
+		}
+		if m.FindCloudletScoreWeights.Health != o.FindCloudletScoreWeights.Health {
+			fields.Set(AppFieldFindCloudletScoreWeightsHealth)
+			fields.Set(AppFieldFindCloudletScoreWeights)
+		}
+	} else if (m.FindCloudletScoreWeights != nil && o.FindCloudletScoreWeights == nil) || (m.FindCloudletScoreWeights == nil && o.FindCloudletScoreWeights != nil) {
+		fields.Set(AppFieldFindCloudletScoreWeights)
+	}
+	if m.GeoFencePolicy != o.GeoFencePolicy {
+		fields.Set(AppFieldGeoFencePolicy)
+	}
+	if m.ReplicaAutoScalePolicy != nil && o.ReplicaAutoScalePolicy != nil {
+		if m.ReplicaAutoScalePolicy.MinReplicas != o.ReplicaAutoScalePolicy.MinReplicas {
+			fields.Set(AppFieldReplicaAutoScalePolicyMinReplicas)
+			fields.Set(AppFieldReplicaAutoScalePolicy)
+		}
+		if m.ReplicaAutoScalePolicy.MaxReplicas != o.ReplicaAutoScalePolicy.MaxReplicas {
+			fields.Set(AppFieldReplicaAutoScalePolicyMaxReplicas)
+			fields.Set(AppFieldReplicaAutoScalePolicy)
+		}
+		if m.ReplicaAutoScalePolicy.TargetCpu != o.ReplicaAutoScalePolicy.TargetCpu {
+			fields.Set(AppFieldReplicaAutoScalePolicyTargetCpu)
+			fields.Set(AppFieldReplicaAutoScalePolicy)
+		}
+		if m.ReplicaAutoScalePolicy.TargetMem != o.ReplicaAutoScalePolicy.TargetMem {
+			fields.Set(AppFieldReplicaAutoScalePolicyTargetMem)
+			fields.Set(AppFieldReplicaAutoScalePolicy)
+		}
+		if m.ReplicaAutoScalePolicy.TargetActiveConnections != o.ReplicaAutoScalePolicy.TargetActiveConnections {
+			fields.Set(AppFieldReplicaAutoScalePolicyTargetActiveConnections)
+			fields.Set(AppFieldReplicaAutoScalePolicy)
+		}
+		if m.ReplicaAutoScalePolicy.StabilizationWindowSec != o.ReplicaAutoScalePolicy.StabilizationWindowSec {
+			fields.Set(AppFieldReplicaAutoScalePolicyStabilizationWindowSec)
+			fields.Set(AppFieldReplicaAutoScalePolicy)
+		}
+	} else if (m.ReplicaAutoScalePolicy != nil && o.ReplicaAutoScalePolicy == nil) || (m.ReplicaAutoScalePolicy == nil && o.ReplicaAutoScalePolicy != nil) {
+		fields.Set(AppFieldReplicaAutoScalePolicy)
+	}
 	if m.Tags != nil && o.Tags != nil {
 		if len(m.Tags) != len(o.Tags) {
 			fields.Set(AppFieldTags)
@@ -4336,9 +4761,22 @@ var UpdateAppFieldsMap = NewFieldMap(map[string]struct{}{
 	AppFieldAppAnnotationsValue:                                  struct{}{},
 	AppFieldIsStandalone:                                         struct{}{},
 	AppFieldManagesOwnNamespaces:                                 struct{}{},
-	AppFieldTags:                                                 struct{}{},
-	AppFieldTagsKey:                                              struct{}{},
-	AppFieldTagsValue:                                            struct{}{},
+	AppFieldFindCloudletScoreWeights:                             struct{}{},
+	AppFieldFindCloudletScoreWeightsDistance:                     struct{}{},
+	AppFieldFindCloudletScoreWeightsLatency:                      struct{}{},
+	AppFieldFindCloudletScoreWeightsResourceUsage:                struct{}{},
+	AppFieldFindCloudletScoreWeightsHealth:                       struct{}{},
+	AppFieldGeoFencePolicy:                                       struct{}{},
+	AppFieldReplicaAutoScalePolicy:                               struct{}{},
+	AppFieldReplicaAutoScalePolicyMinReplicas:                    struct{}{},
+	AppFieldReplicaAutoScalePolicyMaxReplicas:                    struct{}{},
+	AppFieldReplicaAutoScalePolicyTargetCpu:                      struct{}{},
+	AppFieldReplicaAutoScalePolicyTargetMem:                      struct{}{},
+	AppFieldReplicaAutoScalePolicyTargetActiveConnections:        struct{}{},
+	AppFieldReplicaAutoScalePolicyStabilizationWindowSec:         struct{}{},
+	AppFieldTags:      struct{}{},
+	AppFieldTagsKey:   struct{}{},
+	AppFieldTagsValue: struct{}{},
 })
 
 func (m *App) ValidateUpdateFields() error {
@@ -5548,6 +5986,92 @@ func (m *App) CopyInFields(src *App) int {
 			changed++
 		}
 	}
+	if fmap.HasOrHasChild("57") {
+		if src.FindCloudletScoreWeights != nil {
+			if m.FindCloudletScoreWeights == nil {
+				m.FindCloudletScoreWeights = &FindCloudletScoreWeights{}
+			}
+			if fmap.Has("57.1") {
+				if m.FindCloudletScoreWeights.Distance != src.FindCloudletScoreWeights.Distance {
+					m.FindCloudletScoreWeights.Distance = src.FindCloudletScoreWeights.Distance
+					changed++
+				}
+			}
+			if fmap.Has("57.2") {
+				if m.FindCloudletScoreWeights.Latency != src.FindCloudletScoreWeights.Latency {
+					m.FindCloudletScoreWeights.Latency = src.FindCloudletScoreWeights.Latency
+					changed++
+				}
+			}
+			if fmap.Has("57.3") {
+				if m.FindCloudletScoreWeights.ResourceUsage != src.FindCloudletScoreWeights.ResourceUsage {
+					m.FindCloudletScoreWeights.ResourceUsage = src.FindCloudletScoreWeights.ResourceUsage
+					changed++
+				}
+			}
+			if fmap.Has("57.4") {
+				if m.FindCloudletScoreWeights.Health != src.FindCloudletScoreWeights.Health {
+					m.FindCloudletScoreWeights.Health = src.FindCloudletScoreWeights.Health
+					changed++
+				}
+			}
+		} else if m.FindCloudletScoreWeights != nil {
+			m.FindCloudletScoreWeights = nil
+			changed++
+		}
+	}
+	if fmap.Has("58") {
+		if m.GeoFencePolicy != src.GeoFencePolicy {
+			m.GeoFencePolicy = src.GeoFencePolicy
+			changed++
+		}
+	}
+	if fmap.HasOrHasChild("59") {
+		if src.ReplicaAutoScalePolicy != nil {
+			if m.ReplicaAutoScalePolicy == nil {
+				m.ReplicaAutoScalePolicy = &ReplicaAutoScalePolicy{}
+			}
+			if fmap.Has("59.1") {
+				if m.ReplicaAutoScalePolicy.MinReplicas != src.ReplicaAutoScalePolicy.MinReplicas {
+					m.ReplicaAutoScalePolicy.MinReplicas = src.ReplicaAutoScalePolicy.MinReplicas
+					changed++
+				}
+			}
+			if fmap.Has("59.2") {
+				if m.ReplicaAutoScalePolicy.MaxReplicas != src.ReplicaAutoScalePolicy.MaxReplicas {
+					m.ReplicaAutoScalePolicy.MaxReplicas = src.ReplicaAutoScalePolicy.MaxReplicas
+					changed++
+				}
+			}
+			if fmap.Has("59.3") {
+				if m.ReplicaAutoScalePolicy.TargetCpu != src.ReplicaAutoScalePolicy.TargetCpu {
+					m.ReplicaAutoScalePolicy.TargetCpu = src.ReplicaAutoScalePolicy.TargetCpu
+					changed++
+				}
+			}
+			if fmap.Has("59.4") {
+				if m.ReplicaAutoScalePolicy.TargetMem != src.ReplicaAutoScalePolicy.TargetMem {
+					m.ReplicaAutoScalePolicy.TargetMem = src.ReplicaAutoScalePolicy.TargetMem
+					changed++
+				}
+			}
+			if fmap.Has("59.5") {
+				if m.ReplicaAutoScalePolicy.TargetActiveConnections != src.ReplicaAutoScalePolicy.TargetActiveConnections {
+					m.ReplicaAutoScalePolicy.TargetActiveConnections = src.ReplicaAutoScalePolicy.TargetActiveConnections
+					changed++
+				}
+			}
+			if fmap.Has("59.6") {
+				if m.ReplicaAutoScalePolicy.StabilizationWindowSec != src.ReplicaAutoScalePolicy.StabilizationWindowSec {
+					m.ReplicaAutoScalePolicy.StabilizationWindowSec = src.ReplicaAutoScalePolicy.StabilizationWindowSec
+					changed++
+				}
+			}
+		} else if m.ReplicaAutoScalePolicy != nil {
+			m.ReplicaAutoScalePolicy = nil
+			changed++
+		}
+	}
 	if fmap.HasOrHasChild("100") {
 		if src.Tags != nil {
 			if updateListAction == "add" {
@@ -5701,7 +6225,22 @@ func (m *App) DeepCopyIn(src *App) {
 	m.IsStandalone = src.IsStandalone
 	m.ManagesOwnNamespaces = src.ManagesOwnNamespaces
 	m.CompatibilityVersion = src.CompatibilityVersion
-	if src.Tags != nil {
+	if src.FindCloudletScoreWeights != nil {
+		var tmp_FindCloudletScoreWeights FindCloudletScoreWeights
+		tmp_FindCloudletScoreWeights.DeepCopyIn(src.FindCloudletScoreWeights)
+		m.FindCloudletScoreWeights = &tmp_FindCloudletScoreWeights
+	} else {
+		m.FindCloudletScoreWeights = nil
+	}
+	m.GeoFencePolicy = src.GeoFencePolicy
+	if src.ReplicaAutoScalePolicy != nil {
+		var tmp_ReplicaAutoScalePolicy ReplicaAutoScalePolicy
+		tmp_ReplicaAutoScalePolicy.DeepCopyIn(src.ReplicaAutoScalePolicy)
+		m.ReplicaAutoScalePolicy = &tmp_ReplicaAutoScalePolicy
+	} else {
+		m.ReplicaAutoScalePolicy = nil
+	}
+	if src.Tags != nil {
 		m.Tags = make(map[string]string)
 		for k, v := range src.Tags {
 			m.Tags[k] = v
@@ -6526,6 +7065,16 @@ func (m *App) ValidateEnums() error {
 			return err
 		}
 	}
+	if m.FindCloudletScoreWeights != nil {
+		if err := m.FindCloudletScoreWeights.ValidateEnums(); err != nil {
+			return err
+		}
+	}
+	if m.ReplicaAutoScalePolicy != nil {
+		if err := m.ReplicaAutoScalePolicy.ValidateEnums(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -6569,6 +7118,12 @@ func (s *App) ClearTagged(tags map[string]struct{}) {
 	if _, found := tags["nocmp"]; found {
 		s.CompatibilityVersion = 0
 	}
+	if s.FindCloudletScoreWeights != nil {
+		s.FindCloudletScoreWeights.ClearTagged(tags)
+	}
+	if s.ReplicaAutoScalePolicy != nil {
+		s.ReplicaAutoScalePolicy.ClearTagged(tags)
+	}
 }
 
 func IgnoreAppFields(taglist string) cmp.Option {
@@ -6664,6 +7219,58 @@ func (s *ServerlessConfig) ClearTagged(tags map[string]struct{}) {
 	s.GpuConfig.ClearTagged(tags)
 }
 
+func (m *ReplicaAutoScalePolicy) Clone() *ReplicaAutoScalePolicy {
+	cp := &ReplicaAutoScalePolicy{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *ReplicaAutoScalePolicy) CopyInFields(src *ReplicaAutoScalePolicy) int {
+	changed := 0
+	if m.MinReplicas != src.MinReplicas {
+		m.MinReplicas = src.MinReplicas
+		changed++
+	}
+	if m.MaxReplicas != src.MaxReplicas {
+		m.MaxReplicas = src.MaxReplicas
+		changed++
+	}
+	if m.TargetCpu != src.TargetCpu {
+		m.TargetCpu = src.TargetCpu
+		changed++
+	}
+	if m.TargetMem != src.TargetMem {
+		m.TargetMem = src.TargetMem
+		changed++
+	}
+	if m.TargetActiveConnections != src.TargetActiveConnections {
+		m.TargetActiveConnections = src.TargetActiveConnections
+		changed++
+	}
+	if m.StabilizationWindowSec != src.StabilizationWindowSec {
+		m.StabilizationWindowSec = src.StabilizationWindowSec
+		changed++
+	}
+	return changed
+}
+
+func (m *ReplicaAutoScalePolicy) DeepCopyIn(src *ReplicaAutoScalePolicy) {
+	m.MinReplicas = src.MinReplicas
+	m.MaxReplicas = src.MaxReplicas
+	m.TargetCpu = src.TargetCpu
+	m.TargetMem = src.TargetMem
+	m.TargetActiveConnections = src.TargetActiveConnections
+	m.StabilizationWindowSec = src.StabilizationWindowSec
+}
+
+// Helper method to check that enums have valid values
+func (m *ReplicaAutoScalePolicy) ValidateEnums() error {
+	return nil
+}
+
+func (s *ReplicaAutoScalePolicy) ClearTagged(tags map[string]struct{}) {
+}
+
 func (m *GpuConfig) Clone() *GpuConfig {
 	cp := &GpuConfig{}
 	cp.DeepCopyIn(m)
@@ -7786,6 +8393,66 @@ func (m *DeploymentZoneRequest) CopyInFields(src *DeploymentZoneRequest) int {
 			m.App.CompatibilityVersion = src.App.CompatibilityVersion
 			changed++
 		}
+		if src.App.FindCloudletScoreWeights != nil {
+			if m.App.FindCloudletScoreWeights == nil {
+				m.App.FindCloudletScoreWeights = &FindCloudletScoreWeights{}
+			}
+			if m.App.FindCloudletScoreWeights.Distance != src.App.FindCloudletScoreWeights.Distance {
+				m.App.FindCloudletScoreWeights.Distance = src.App.FindCloudletScoreWeights.Distance
+				changed++
+			}
+			if m.App.FindCloudletScoreWeights.Latency != src.App.FindCloudletScoreWeights.Latency {
+				m.App.FindCloudletScoreWeights.Latency = src.App.FindCloudletScoreWeights.Latency
+				changed++
+			}
+			if m.App.FindCloudletScoreWeights.ResourceUsage != src.App.FindCloudletScoreWeights.ResourceUsage {
+				m.App.FindCloudletScoreWeights.ResourceUsage = src.App.FindCloudletScoreWeights.ResourceUsage
+				changed++
+			}
+			if m.App.FindCloudletScoreWeights.Health != src.App.FindCloudletScoreWeights.Health {
+				m.App.FindCloudletScoreWeights.Health = src.App.FindCloudletScoreWeights.Health
+				changed++
+			}
+		} else if m.App.FindCloudletScoreWeights != nil {
+			m.App.FindCloudletScoreWeights = nil
+			changed++
+		}
+		if m.App.GeoFencePolicy != src.App.GeoFencePolicy {
+			m.App.GeoFencePolicy = src.App.GeoFencePolicy
+			changed++
+		}
+		if src.App.ReplicaAutoScalePolicy != nil {
+			if m.App.ReplicaAutoScalePolicy == nil {
+				m.App.ReplicaAutoScalePolicy = &ReplicaAutoScalePolicy{}
+			}
+			if m.App.ReplicaAutoScalePolicy.MinReplicas != src.App.ReplicaAutoScalePolicy.MinReplicas {
+				m.App.ReplicaAutoScalePolicy.MinReplicas = src.App.ReplicaAutoScalePolicy.MinReplicas
+				changed++
+			}
+			if m.App.ReplicaAutoScalePolicy.MaxReplicas != src.App.ReplicaAutoScalePolicy.MaxReplicas {
+				m.App.ReplicaAutoScalePolicy.MaxReplicas = src.App.ReplicaAutoScalePolicy.MaxReplicas
+				changed++
+			}
+			if m.App.ReplicaAutoScalePolicy.TargetCpu != src.App.ReplicaAutoScalePolicy.TargetCpu {
+				m.App.ReplicaAutoScalePolicy.TargetCpu = src.App.ReplicaAutoScalePolicy.TargetCpu
+				changed++
+			}
+			if m.App.ReplicaAutoScalePolicy.TargetMem != src.App.ReplicaAutoScalePolicy.TargetMem {
+				m.App.ReplicaAutoScalePolicy.TargetMem = src.App.ReplicaAutoScalePolicy.TargetMem
+				changed++
+			}
+			if m.App.ReplicaAutoScalePolicy.TargetActiveConnections != src.App.ReplicaAutoScalePolicy.TargetActiveConnections {
+				m.App.ReplicaAutoScalePolicy.TargetActiveConnections = src.App.ReplicaAutoScalePolicy.TargetActiveConnections
+				changed++
+			}
+			if m.App.ReplicaAutoScalePolicy.StabilizationWindowSec != src.App.ReplicaAutoScalePolicy.StabilizationWindowSec {
+				m.App.ReplicaAutoScalePolicy.StabilizationWindowSec = src.App.ReplicaAutoScalePolicy.StabilizationWindowSec
+				changed++
+			}
+		} else if m.App.ReplicaAutoScalePolicy != nil {
+			m.App.ReplicaAutoScalePolicy = nil
+			changed++
+		}
 		if src.App.Tags != nil {
 			if updateListAction == "add" {
 				for k1, v := range src.App.Tags {
@@ -7880,147 +8547,1267 @@ func IgnoreDeploymentZoneRequestFields(taglist string) cmp.Option {
 	return cmpopts.IgnoreFields(DeploymentZoneRequest{}, names...)
 }
 
-var ImageTypeStrings = []string{
-	"IMAGE_TYPE_UNKNOWN",
-	"IMAGE_TYPE_DOCKER",
-	"IMAGE_TYPE_QCOW",
-	"IMAGE_TYPE_HELM",
-	"IMAGE_TYPE_OVF",
-	"IMAGE_TYPE_OVA",
+func (m *AppHistory) Clone() *AppHistory {
+	cp := &AppHistory{}
+	cp.DeepCopyIn(m)
+	return cp
 }
 
-const (
-	ImageTypeIMAGE_TYPE_UNKNOWN uint64 = 1 << 0
-	ImageTypeIMAGE_TYPE_DOCKER  uint64 = 1 << 1
-	ImageTypeIMAGE_TYPE_QCOW    uint64 = 1 << 2
-	ImageTypeIMAGE_TYPE_HELM    uint64 = 1 << 3
-	ImageTypeIMAGE_TYPE_OVF     uint64 = 1 << 4
-	ImageTypeIMAGE_TYPE_OVA     uint64 = 1 << 5
-)
-
-var ImageType_CamelName = map[int32]string{
-	// IMAGE_TYPE_UNKNOWN -> ImageTypeUnknown
-	0: "ImageTypeUnknown",
-	// IMAGE_TYPE_DOCKER -> ImageTypeDocker
-	1: "ImageTypeDocker",
-	// IMAGE_TYPE_QCOW -> ImageTypeQcow
-	2: "ImageTypeQcow",
-	// IMAGE_TYPE_HELM -> ImageTypeHelm
-	3: "ImageTypeHelm",
-	// IMAGE_TYPE_OVF -> ImageTypeOvf
-	4: "ImageTypeOvf",
-	// IMAGE_TYPE_OVA -> ImageTypeOva
-	5: "ImageTypeOva",
-}
-var ImageType_CamelValue = map[string]int32{
-	"ImageTypeUnknown": 0,
-	"ImageTypeDocker":  1,
-	"ImageTypeQcow":    2,
-	"ImageTypeHelm":    3,
-	"ImageTypeOvf":     4,
-	"ImageTypeOva":     5,
+func (m *AppHistory) AddObjConfigs(vals ...*ConfigFile) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Obj.Configs {
+		cur[v.String()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.String()]; found {
+			continue // duplicate
+		}
+		m.Obj.Configs = append(m.Obj.Configs, v)
+		changes++
+	}
+	return changes
 }
 
-func ParseImageType(data interface{}) (ImageType, error) {
-	if val, ok := data.(ImageType); ok {
-		return val, nil
-	} else if str, ok := data.(string); ok {
-		val, ok := ImageType_CamelValue[util.CamelCase(str)]
-		if !ok {
-			// may have omitted common prefix
-			val, ok = ImageType_CamelValue["ImageType"+util.CamelCase(str)]
-		}
-		if !ok {
-			// may be int value instead of enum name
-			ival, err := strconv.Atoi(str)
-			val = int32(ival)
-			if err == nil {
-				_, ok = ImageType_CamelName[val]
-			}
-		}
-		if !ok {
-			return ImageType(0), fmt.Errorf("Invalid ImageType value %q", str)
-		}
-		return ImageType(val), nil
-	} else if ival, ok := data.(int32); ok {
-		if _, ok := ImageType_CamelName[ival]; ok {
-			return ImageType(ival), nil
-		} else {
-			return ImageType(0), fmt.Errorf("Invalid ImageType value %d", ival)
+func (m *AppHistory) RemoveObjConfigs(vals ...*ConfigFile) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.String()] = struct{}{}
+	}
+	for i := len(m.Obj.Configs); i >= 0; i-- {
+		if _, found := remove[m.Obj.Configs[i].String()]; found {
+			m.Obj.Configs = append(m.Obj.Configs[:i], m.Obj.Configs[i+1:]...)
+			changes++
 		}
 	}
-	return ImageType(0), fmt.Errorf("Invalid ImageType value %v", data)
+	return changes
 }
 
-func (e *ImageType) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	var str string
-	err := unmarshal(&str)
-	if err != nil {
-		return err
+func (m *AppHistory) AddObjAutoProvPolicies(vals ...string) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Obj.AutoProvPolicies {
+		cur[v] = struct{}{}
 	}
-	val, err := ParseImageType(str)
-	if err != nil {
-		return err
+	for _, v := range vals {
+		if _, found := cur[v]; found {
+			continue // duplicate
+		}
+		m.Obj.AutoProvPolicies = append(m.Obj.AutoProvPolicies, v)
+		changes++
 	}
-	*e = val
-	return nil
+	return changes
 }
 
-func (e ImageType) MarshalYAML() (interface{}, error) {
-	str := proto.EnumName(ImageType_CamelName, int32(e))
-	str = strings.TrimPrefix(str, "ImageType")
-	return str, nil
+func (m *AppHistory) RemoveObjAutoProvPolicies(vals ...string) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v] = struct{}{}
+	}
+	for i := len(m.Obj.AutoProvPolicies); i >= 0; i-- {
+		if _, found := remove[m.Obj.AutoProvPolicies[i]]; found {
+			m.Obj.AutoProvPolicies = append(m.Obj.AutoProvPolicies[:i], m.Obj.AutoProvPolicies[i+1:]...)
+			changes++
+		}
+	}
+	return changes
 }
 
-// custom JSON encoding/decoding
-func (e *ImageType) UnmarshalJSON(b []byte) error {
-	var str string
-	err := json.Unmarshal(b, &str)
-	if err == nil {
-		val, err := ParseImageType(str)
-		if err != nil {
-			return &json.UnmarshalTypeError{
-				Value: "string " + str,
-				Type:  reflect.TypeOf(ImageType(0)),
-			}
-		}
-		*e = ImageType(val)
-		return nil
+func (m *AppHistory) AddObjRequiredOutboundConnections(vals ...SecurityRule) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Obj.RequiredOutboundConnections {
+		cur[v.String()] = struct{}{}
 	}
-	var ival int32
-	err = json.Unmarshal(b, &ival)
-	if err == nil {
-		val, err := ParseImageType(ival)
-		if err == nil {
-			*e = val
-			return nil
+	for _, v := range vals {
+		if _, found := cur[v.String()]; found {
+			continue // duplicate
 		}
+		m.Obj.RequiredOutboundConnections = append(m.Obj.RequiredOutboundConnections, v)
+		changes++
 	}
-	return &json.UnmarshalTypeError{
-		Value: "value " + string(b),
-		Type:  reflect.TypeOf(ImageType(0)),
-	}
+	return changes
 }
 
-func (e ImageType) MarshalJSON() ([]byte, error) {
-	str := proto.EnumName(ImageType_CamelName, int32(e))
-	str = strings.TrimPrefix(str, "ImageType")
-	return json.Marshal(str)
+func (m *AppHistory) RemoveObjRequiredOutboundConnections(vals ...SecurityRule) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.String()] = struct{}{}
+	}
+	for i := len(m.Obj.RequiredOutboundConnections); i >= 0; i-- {
+		if _, found := remove[m.Obj.RequiredOutboundConnections[i].String()]; found {
+			m.Obj.RequiredOutboundConnections = append(m.Obj.RequiredOutboundConnections[:i], m.Obj.RequiredOutboundConnections[i+1:]...)
+			changes++
+		}
+	}
+	return changes
 }
 
-var ImageTypeCommonPrefix = "ImageType"
-
-var QosSessionProfileStrings = []string{
-	"QOS_NO_PRIORITY",
-	"QOS_LOW_LATENCY",
-	"QOS_THROUGHPUT_DOWN_S",
-	"QOS_THROUGHPUT_DOWN_M",
-	"QOS_THROUGHPUT_DOWN_L",
+func (m *AppHistory) AddObjAlertPolicies(vals ...string) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Obj.AlertPolicies {
+		cur[v] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v]; found {
+			continue // duplicate
+		}
+		m.Obj.AlertPolicies = append(m.Obj.AlertPolicies, v)
+		changes++
+	}
+	return changes
 }
 
-const (
-	QosSessionProfileQOS_NO_PRIORITY       uint64 = 1 << 0
-	QosSessionProfileQOS_LOW_LATENCY       uint64 = 1 << 1
+func (m *AppHistory) RemoveObjAlertPolicies(vals ...string) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v] = struct{}{}
+	}
+	for i := len(m.Obj.AlertPolicies); i >= 0; i-- {
+		if _, found := remove[m.Obj.AlertPolicies[i]]; found {
+			m.Obj.AlertPolicies = append(m.Obj.AlertPolicies[:i], m.Obj.AlertPolicies[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppHistory) AddObjCommandArgs(vals ...string) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Obj.CommandArgs {
+		cur[v] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v]; found {
+			continue // duplicate
+		}
+		m.Obj.CommandArgs = append(m.Obj.CommandArgs, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppHistory) RemoveObjCommandArgs(vals ...string) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v] = struct{}{}
+	}
+	for i := len(m.Obj.CommandArgs); i >= 0; i-- {
+		if _, found := remove[m.Obj.CommandArgs[i]]; found {
+			m.Obj.CommandArgs = append(m.Obj.CommandArgs[:i], m.Obj.CommandArgs[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppHistory) AddObjKubernetesResourcesCpuPoolTopologyMinNodeGpus(vals ...*GPUResource) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Obj.KubernetesResources.CpuPool.Topology.MinNodeGpus {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Obj.KubernetesResources.CpuPool.Topology.MinNodeGpus = append(m.Obj.KubernetesResources.CpuPool.Topology.MinNodeGpus, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppHistory) RemoveObjKubernetesResourcesCpuPoolTopologyMinNodeGpus(vals ...*GPUResource) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Obj.KubernetesResources.CpuPool.Topology.MinNodeGpus); i >= 0; i-- {
+		if _, found := remove[m.Obj.KubernetesResources.CpuPool.Topology.MinNodeGpus[i].GetKey().GetKeyString()]; found {
+			m.Obj.KubernetesResources.CpuPool.Topology.MinNodeGpus = append(m.Obj.KubernetesResources.CpuPool.Topology.MinNodeGpus[:i], m.Obj.KubernetesResources.CpuPool.Topology.MinNodeGpus[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppHistory) AddObjKubernetesResourcesCpuPoolTotalGpus(vals ...*GPUResource) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Obj.KubernetesResources.CpuPool.TotalGpus {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Obj.KubernetesResources.CpuPool.TotalGpus = append(m.Obj.KubernetesResources.CpuPool.TotalGpus, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppHistory) RemoveObjKubernetesResourcesCpuPoolTotalGpus(vals ...*GPUResource) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Obj.KubernetesResources.CpuPool.TotalGpus); i >= 0; i-- {
+		if _, found := remove[m.Obj.KubernetesResources.CpuPool.TotalGpus[i].GetKey().GetKeyString()]; found {
+			m.Obj.KubernetesResources.CpuPool.TotalGpus = append(m.Obj.KubernetesResources.CpuPool.TotalGpus[:i], m.Obj.KubernetesResources.CpuPool.TotalGpus[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppHistory) AddObjKubernetesResourcesGpuPoolTopologyMinNodeGpus(vals ...*GPUResource) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Obj.KubernetesResources.GpuPool.Topology.MinNodeGpus {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Obj.KubernetesResources.GpuPool.Topology.MinNodeGpus = append(m.Obj.KubernetesResources.GpuPool.Topology.MinNodeGpus, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppHistory) RemoveObjKubernetesResourcesGpuPoolTopologyMinNodeGpus(vals ...*GPUResource) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Obj.KubernetesResources.GpuPool.Topology.MinNodeGpus); i >= 0; i-- {
+		if _, found := remove[m.Obj.KubernetesResources.GpuPool.Topology.MinNodeGpus[i].GetKey().GetKeyString()]; found {
+			m.Obj.KubernetesResources.GpuPool.Topology.MinNodeGpus = append(m.Obj.KubernetesResources.GpuPool.Topology.MinNodeGpus[:i], m.Obj.KubernetesResources.GpuPool.Topology.MinNodeGpus[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppHistory) AddObjKubernetesResourcesGpuPoolTotalGpus(vals ...*GPUResource) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Obj.KubernetesResources.GpuPool.TotalGpus {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Obj.KubernetesResources.GpuPool.TotalGpus = append(m.Obj.KubernetesResources.GpuPool.TotalGpus, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppHistory) RemoveObjKubernetesResourcesGpuPoolTotalGpus(vals ...*GPUResource) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Obj.KubernetesResources.GpuPool.TotalGpus); i >= 0; i-- {
+		if _, found := remove[m.Obj.KubernetesResources.GpuPool.TotalGpus[i].GetKey().GetKeyString()]; found {
+			m.Obj.KubernetesResources.GpuPool.TotalGpus = append(m.Obj.KubernetesResources.GpuPool.TotalGpus[:i], m.Obj.KubernetesResources.GpuPool.TotalGpus[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppHistory) AddObjNodeResourcesGpus(vals ...*GPUResource) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Obj.NodeResources.Gpus {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Obj.NodeResources.Gpus = append(m.Obj.NodeResources.Gpus, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppHistory) RemoveObjNodeResourcesGpus(vals ...*GPUResource) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Obj.NodeResources.Gpus); i >= 0; i-- {
+		if _, found := remove[m.Obj.NodeResources.Gpus[i].GetKey().GetKeyString()]; found {
+			m.Obj.NodeResources.Gpus = append(m.Obj.NodeResources.Gpus[:i], m.Obj.NodeResources.Gpus[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppHistory) CopyInFields(src *AppHistory) int {
+	updateListAction := "replace"
+	changed := 0
+	if m.Revision != src.Revision {
+		m.Revision = src.Revision
+		changed++
+	}
+	if m.Obj.Key.Organization != src.Obj.Key.Organization {
+		m.Obj.Key.Organization = src.Obj.Key.Organization
+		changed++
+	}
+	if m.Obj.Key.Name != src.Obj.Key.Name {
+		m.Obj.Key.Name = src.Obj.Key.Name
+		changed++
+	}
+	if m.Obj.Key.Version != src.Obj.Key.Version {
+		m.Obj.Key.Version = src.Obj.Key.Version
+		changed++
+	}
+	if m.Obj.ImagePath != src.Obj.ImagePath {
+		m.Obj.ImagePath = src.Obj.ImagePath
+		changed++
+	}
+	if m.Obj.ImageType != src.Obj.ImageType {
+		m.Obj.ImageType = src.Obj.ImageType
+		changed++
+	}
+	if m.Obj.AccessPorts != src.Obj.AccessPorts {
+		m.Obj.AccessPorts = src.Obj.AccessPorts
+		changed++
+	}
+	if m.Obj.DefaultFlavor.Name != src.Obj.DefaultFlavor.Name {
+		m.Obj.DefaultFlavor.Name = src.Obj.DefaultFlavor.Name
+		changed++
+	}
+	if m.Obj.AuthPublicKey != src.Obj.AuthPublicKey {
+		m.Obj.AuthPublicKey = src.Obj.AuthPublicKey
+		changed++
+	}
+	if m.Obj.Command != src.Obj.Command {
+		m.Obj.Command = src.Obj.Command
+		changed++
+	}
+	if m.Obj.Annotations != src.Obj.Annotations {
+		m.Obj.Annotations = src.Obj.Annotations
+		changed++
+	}
+	if m.Obj.Deployment != src.Obj.Deployment {
+		m.Obj.Deployment = src.Obj.Deployment
+		changed++
+	}
+	if m.Obj.DeploymentManifest != src.Obj.DeploymentManifest {
+		m.Obj.DeploymentManifest = src.Obj.DeploymentManifest
+		changed++
+	}
+	if m.Obj.DeploymentGenerator != src.Obj.DeploymentGenerator {
+		m.Obj.DeploymentGenerator = src.Obj.DeploymentGenerator
+		changed++
+	}
+	if m.Obj.AndroidPackageName != src.Obj.AndroidPackageName {
+		m.Obj.AndroidPackageName = src.Obj.AndroidPackageName
+		changed++
+	}
+	if m.Obj.DelOpt != src.Obj.DelOpt {
+		m.Obj.DelOpt = src.Obj.DelOpt
+		changed++
+	}
+	if src.Obj.Configs != nil {
+		if updateListAction == "add" {
+			changed += m.AddObjConfigs(src.Obj.Configs...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveObjConfigs(src.Obj.Configs...)
+		} else {
+			m.Obj.Configs = make([]*ConfigFile, 0)
+			for k1, _ := range src.Obj.Configs {
+				m.Obj.Configs = append(m.Obj.Configs, src.Obj.Configs[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Obj.Configs != nil {
+		m.Obj.Configs = nil
+		changed++
+	}
+	if m.Obj.ScaleWithCluster != src.Obj.ScaleWithCluster {
+		m.Obj.ScaleWithCluster = src.Obj.ScaleWithCluster
+		changed++
+	}
+	if m.Obj.InternalPorts != src.Obj.InternalPorts {
+		m.Obj.InternalPorts = src.Obj.InternalPorts
+		changed++
+	}
+	if m.Obj.Revision != src.Obj.Revision {
+		m.Obj.Revision = src.Obj.Revision
+		changed++
+	}
+	if m.Obj.OfficialFqdn != src.Obj.OfficialFqdn {
+		m.Obj.OfficialFqdn = src.Obj.OfficialFqdn
+		changed++
+	}
+	if m.Obj.Md5Sum != src.Obj.Md5Sum {
+		m.Obj.Md5Sum = src.Obj.Md5Sum
+		changed++
+	}
+	if m.Obj.AutoProvPolicy != src.Obj.AutoProvPolicy {
+		m.Obj.AutoProvPolicy = src.Obj.AutoProvPolicy
+		changed++
+	}
+	if m.Obj.AccessType != src.Obj.AccessType {
+		m.Obj.AccessType = src.Obj.AccessType
+		changed++
+	}
+	if m.Obj.DeletePrepare != src.Obj.DeletePrepare {
+		m.Obj.DeletePrepare = src.Obj.DeletePrepare
+		changed++
+	}
+	if src.Obj.AutoProvPolicies != nil {
+		if updateListAction == "add" {
+			changed += m.AddObjAutoProvPolicies(src.Obj.AutoProvPolicies...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveObjAutoProvPolicies(src.Obj.AutoProvPolicies...)
+		} else {
+			m.Obj.AutoProvPolicies = make([]string, 0)
+			m.Obj.AutoProvPolicies = append(m.Obj.AutoProvPolicies, src.Obj.AutoProvPolicies...)
+			changed++
+		}
+	} else if m.Obj.AutoProvPolicies != nil {
+		m.Obj.AutoProvPolicies = nil
+		changed++
+	}
+	if m.Obj.TemplateDelimiter != src.Obj.TemplateDelimiter {
+		m.Obj.TemplateDelimiter = src.Obj.TemplateDelimiter
+		changed++
+	}
+	if m.Obj.SkipHcPorts != src.Obj.SkipHcPorts {
+		m.Obj.SkipHcPorts = src.Obj.SkipHcPorts
+		changed++
+	}
+	if m.Obj.CreatedAt.Seconds != src.Obj.CreatedAt.Seconds {
+		m.Obj.CreatedAt.Seconds = src.Obj.CreatedAt.Seconds
+		changed++
+	}
+	if m.Obj.CreatedAt.Nanos != src.Obj.CreatedAt.Nanos {
+		m.Obj.CreatedAt.Nanos = src.Obj.CreatedAt.Nanos
+		changed++
+	}
+	if m.Obj.UpdatedAt.Seconds != src.Obj.UpdatedAt.Seconds {
+		m.Obj.UpdatedAt.Seconds = src.Obj.UpdatedAt.Seconds
+		changed++
+	}
+	if m.Obj.UpdatedAt.Nanos != src.Obj.UpdatedAt.Nanos {
+		m.Obj.UpdatedAt.Nanos = src.Obj.UpdatedAt.Nanos
+		changed++
+	}
+	if m.Obj.Trusted != src.Obj.Trusted {
+		m.Obj.Trusted = src.Obj.Trusted
+		changed++
+	}
+	if src.Obj.RequiredOutboundConnections != nil {
+		if updateListAction == "add" {
+			changed += m.AddObjRequiredOutboundConnections(src.Obj.RequiredOutboundConnections...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveObjRequiredOutboundConnections(src.Obj.RequiredOutboundConnections...)
+		} else {
+			m.Obj.RequiredOutboundConnections = make([]SecurityRule, 0)
+			for k1, _ := range src.Obj.RequiredOutboundConnections {
+				m.Obj.RequiredOutboundConnections = append(m.Obj.RequiredOutboundConnections, *src.Obj.RequiredOutboundConnections[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Obj.RequiredOutboundConnections != nil {
+		m.Obj.RequiredOutboundConnections = nil
+		changed++
+	}
+	if m.Obj.AllowServerless != src.Obj.AllowServerless {
+		m.Obj.AllowServerless = src.Obj.AllowServerless
+		changed++
+	}
+	if src.Obj.ServerlessConfig != nil {
+		if m.Obj.ServerlessConfig == nil {
+			m.Obj.ServerlessConfig = &ServerlessConfig{}
+		}
+		if m.Obj.ServerlessConfig.Vcpus.Whole != src.Obj.ServerlessConfig.Vcpus.Whole {
+			m.Obj.ServerlessConfig.Vcpus.Whole = src.Obj.ServerlessConfig.Vcpus.Whole
+			changed++
+		}
+		if m.Obj.ServerlessConfig.Vcpus.Nanos != src.Obj.ServerlessConfig.Vcpus.Nanos {
+			m.Obj.ServerlessConfig.Vcpus.Nanos = src.Obj.ServerlessConfig.Vcpus.Nanos
+			changed++
+		}
+		if m.Obj.ServerlessConfig.Ram != src.Obj.ServerlessConfig.Ram {
+			m.Obj.ServerlessConfig.Ram = src.Obj.ServerlessConfig.Ram
+			changed++
+		}
+		if m.Obj.ServerlessConfig.MinReplicas != src.Obj.ServerlessConfig.MinReplicas {
+			m.Obj.ServerlessConfig.MinReplicas = src.Obj.ServerlessConfig.MinReplicas
+			changed++
+		}
+		if m.Obj.ServerlessConfig.GpuConfig.Type != src.Obj.ServerlessConfig.GpuConfig.Type {
+			m.Obj.ServerlessConfig.GpuConfig.Type = src.Obj.ServerlessConfig.GpuConfig.Type
+			changed++
+		}
+		if m.Obj.ServerlessConfig.GpuConfig.Model != src.Obj.ServerlessConfig.GpuConfig.Model {
+			m.Obj.ServerlessConfig.GpuConfig.Model = src.Obj.ServerlessConfig.GpuConfig.Model
+			changed++
+		}
+		if m.Obj.ServerlessConfig.GpuConfig.NumGpu != src.Obj.ServerlessConfig.GpuConfig.NumGpu {
+			m.Obj.ServerlessConfig.GpuConfig.NumGpu = src.Obj.ServerlessConfig.GpuConfig.NumGpu
+			changed++
+		}
+		if m.Obj.ServerlessConfig.GpuConfig.Ram != src.Obj.ServerlessConfig.GpuConfig.Ram {
+			m.Obj.ServerlessConfig.GpuConfig.Ram = src.Obj.ServerlessConfig.GpuConfig.Ram
+			changed++
+		}
+	} else if m.Obj.ServerlessConfig != nil {
+		m.Obj.ServerlessConfig = nil
+		changed++
+	}
+	if m.Obj.VmAppOsType != src.Obj.VmAppOsType {
+		m.Obj.VmAppOsType = src.Obj.VmAppOsType
+		changed++
+	}
+	if src.Obj.AlertPolicies != nil {
+		if updateListAction == "add" {
+			changed += m.AddObjAlertPolicies(src.Obj.AlertPolicies...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveObjAlertPolicies(src.Obj.AlertPolicies...)
+		} else {
+			m.Obj.AlertPolicies = make([]string, 0)
+			m.Obj.AlertPolicies = append(m.Obj.AlertPolicies, src.Obj.AlertPolicies...)
+			changed++
+		}
+	} else if m.Obj.AlertPolicies != nil {
+		m.Obj.AlertPolicies = nil
+		changed++
+	}
+	if m.Obj.QosSessionProfile != src.Obj.QosSessionProfile {
+		m.Obj.QosSessionProfile = src.Obj.QosSessionProfile
+		changed++
+	}
+	if m.Obj.QosSessionDuration != src.Obj.QosSessionDuration {
+		m.Obj.QosSessionDuration = src.Obj.QosSessionDuration
+		changed++
+	}
+	if m.Obj.GlobalId != src.Obj.GlobalId {
+		m.Obj.GlobalId = src.Obj.GlobalId
+		changed++
+	}
+	if src.Obj.CommandArgs != nil {
+		if updateListAction == "add" {
+			changed += m.AddObjCommandArgs(src.Obj.CommandArgs...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveObjCommandArgs(src.Obj.CommandArgs...)
+		} else {
+			m.Obj.CommandArgs = make([]string, 0)
+			m.Obj.CommandArgs = append(m.Obj.CommandArgs, src.Obj.CommandArgs...)
+			changed++
+		}
+	} else if m.Obj.CommandArgs != nil {
+		m.Obj.CommandArgs = nil
+		changed++
+	}
+	if src.Obj.EnvVars != nil {
+		if updateListAction == "add" {
+			for k1, v := range src.Obj.EnvVars {
+				m.Obj.EnvVars[k1] = v
+				changed++
+			}
+		} else if updateListAction == "remove" {
+			for k1, _ := range src.Obj.EnvVars {
+				if _, ok := m.Obj.EnvVars[k1]; ok {
+					delete(m.Obj.EnvVars, k1)
+					changed++
+				}
+			}
+		} else {
+			m.Obj.EnvVars = make(map[string]string)
+			for k1, v := range src.Obj.EnvVars {
+				m.Obj.EnvVars[k1] = v
+			}
+			changed++
+		}
+	} else if m.Obj.EnvVars != nil {
+		m.Obj.EnvVars = nil
+		changed++
+	}
+	if src.Obj.SecretEnvVars != nil {
+		if updateListAction == "add" {
+			for k1, v := range src.Obj.SecretEnvVars {
+				m.Obj.SecretEnvVars[k1] = v
+				changed++
+			}
+		} else if updateListAction == "remove" {
+			for k1, _ := range src.Obj.SecretEnvVars {
+				if _, ok := m.Obj.SecretEnvVars[k1]; ok {
+					delete(m.Obj.SecretEnvVars, k1)
+					changed++
+				}
+			}
+		} else {
+			m.Obj.SecretEnvVars = make(map[string]string)
+			for k1, v := range src.Obj.SecretEnvVars {
+				m.Obj.SecretEnvVars[k1] = v
+			}
+			changed++
+		}
+	} else if m.Obj.SecretEnvVars != nil {
+		m.Obj.SecretEnvVars = nil
+		changed++
+	}
+	if m.Obj.UpdateListAction != src.Obj.UpdateListAction {
+		m.Obj.UpdateListAction = src.Obj.UpdateListAction
+		changed++
+	}
+	if src.Obj.KubernetesResources != nil {
+		if m.Obj.KubernetesResources == nil {
+			m.Obj.KubernetesResources = &KubernetesResources{}
+		}
+		if src.Obj.KubernetesResources.CpuPool != nil {
+			if m.Obj.KubernetesResources.CpuPool == nil {
+				m.Obj.KubernetesResources.CpuPool = &NodePoolResources{}
+			}
+			if m.Obj.KubernetesResources.CpuPool.TotalVcpus.Whole != src.Obj.KubernetesResources.CpuPool.TotalVcpus.Whole {
+				m.Obj.KubernetesResources.CpuPool.TotalVcpus.Whole = src.Obj.KubernetesResources.CpuPool.TotalVcpus.Whole
+				changed++
+			}
+			if m.Obj.KubernetesResources.CpuPool.TotalVcpus.Nanos != src.Obj.KubernetesResources.CpuPool.TotalVcpus.Nanos {
+				m.Obj.KubernetesResources.CpuPool.TotalVcpus.Nanos = src.Obj.KubernetesResources.CpuPool.TotalVcpus.Nanos
+				changed++
+			}
+			if m.Obj.KubernetesResources.CpuPool.TotalMemory != src.Obj.KubernetesResources.CpuPool.TotalMemory {
+				m.Obj.KubernetesResources.CpuPool.TotalMemory = src.Obj.KubernetesResources.CpuPool.TotalMemory
+				changed++
+			}
+			if m.Obj.KubernetesResources.CpuPool.TotalDisk != src.Obj.KubernetesResources.CpuPool.TotalDisk {
+				m.Obj.KubernetesResources.CpuPool.TotalDisk = src.Obj.KubernetesResources.CpuPool.TotalDisk
+				changed++
+			}
+			if src.Obj.KubernetesResources.CpuPool.TotalOptRes != nil {
+				if updateListAction == "add" {
+					for k3, v := range src.Obj.KubernetesResources.CpuPool.TotalOptRes {
+						m.Obj.KubernetesResources.CpuPool.TotalOptRes[k3] = v
+						changed++
+					}
+				} else if updateListAction == "remove" {
+					for k3, _ := range src.Obj.KubernetesResources.CpuPool.TotalOptRes {
+						if _, ok := m.Obj.KubernetesResources.CpuPool.TotalOptRes[k3]; ok {
+							delete(m.Obj.KubernetesResources.CpuPool.TotalOptRes, k3)
+							changed++
+						}
+					}
+				} else {
+					m.Obj.KubernetesResources.CpuPool.TotalOptRes = make(map[string]string)
+					for k3, v := range src.Obj.KubernetesResources.CpuPool.TotalOptRes {
+						m.Obj.KubernetesResources.CpuPool.TotalOptRes[k3] = v
+					}
+					changed++
+				}
+			} else if m.Obj.KubernetesResources.CpuPool.TotalOptRes != nil {
+				m.Obj.KubernetesResources.CpuPool.TotalOptRes = nil
+				changed++
+			}
+			if m.Obj.KubernetesResources.CpuPool.Topology.MinNodeVcpus != src.Obj.KubernetesResources.CpuPool.Topology.MinNodeVcpus {
+				m.Obj.KubernetesResources.CpuPool.Topology.MinNodeVcpus = src.Obj.KubernetesResources.CpuPool.Topology.MinNodeVcpus
+				changed++
+			}
+			if m.Obj.KubernetesResources.CpuPool.Topology.MinNodeMemory != src.Obj.KubernetesResources.CpuPool.Topology.MinNodeMemory {
+				m.Obj.KubernetesResources.CpuPool.Topology.MinNodeMemory = src.Obj.KubernetesResources.CpuPool.Topology.MinNodeMemory
+				changed++
+			}
+			if m.Obj.KubernetesResources.CpuPool.Topology.MinNodeDisk != src.Obj.KubernetesResources.CpuPool.Topology.MinNodeDisk {
+				m.Obj.KubernetesResources.CpuPool.Topology.MinNodeDisk = src.Obj.KubernetesResources.CpuPool.Topology.MinNodeDisk
+				changed++
+			}
+			if src.Obj.KubernetesResources.CpuPool.Topology.MinNodeOptRes != nil {
+				if updateListAction == "add" {
+					for k4, v := range src.Obj.KubernetesResources.CpuPool.Topology.MinNodeOptRes {
+						m.Obj.KubernetesResources.CpuPool.Topology.MinNodeOptRes[k4] = v
+						changed++
+					}
+				} else if updateListAction == "remove" {
+					for k4, _ := range src.Obj.KubernetesResources.CpuPool.Topology.MinNodeOptRes {
+						if _, ok := m.Obj.KubernetesResources.CpuPool.Topology.MinNodeOptRes[k4]; ok {
+							delete(m.Obj.KubernetesResources.CpuPool.Topology.MinNodeOptRes, k4)
+							changed++
+						}
+					}
+				} else {
+					m.Obj.KubernetesResources.CpuPool.Topology.MinNodeOptRes = make(map[string]string)
+					for k4, v := range src.Obj.KubernetesResources.CpuPool.Topology.MinNodeOptRes {
+						m.Obj.KubernetesResources.CpuPool.Topology.MinNodeOptRes[k4] = v
+					}
+					changed++
+				}
+			} else if m.Obj.KubernetesResources.CpuPool.Topology.MinNodeOptRes != nil {
+				m.Obj.KubernetesResources.CpuPool.Topology.MinNodeOptRes = nil
+				changed++
+			}
+			if m.Obj.KubernetesResources.CpuPool.Topology.MinNumberOfNodes != src.Obj.KubernetesResources.CpuPool.Topology.MinNumberOfNodes {
+				m.Obj.KubernetesResources.CpuPool.Topology.MinNumberOfNodes = src.Obj.KubernetesResources.CpuPool.Topology.MinNumberOfNodes
+				changed++
+			}
+			if src.Obj.KubernetesResources.CpuPool.Topology.MinNodeGpus != nil {
+				if updateListAction == "add" {
+					changed += m.AddObjKubernetesResourcesCpuPoolTopologyMinNodeGpus(src.Obj.KubernetesResources.CpuPool.Topology.MinNodeGpus...)
+				} else if updateListAction == "remove" {
+					changed += m.RemoveObjKubernetesResourcesCpuPoolTopologyMinNodeGpus(src.Obj.KubernetesResources.CpuPool.Topology.MinNodeGpus...)
+				} else {
+					m.Obj.KubernetesResources.CpuPool.Topology.MinNodeGpus = make([]*GPUResource, 0)
+					for k4, _ := range src.Obj.KubernetesResources.CpuPool.Topology.MinNodeGpus {
+						m.Obj.KubernetesResources.CpuPool.Topology.MinNodeGpus = append(m.Obj.KubernetesResources.CpuPool.Topology.MinNodeGpus, src.Obj.KubernetesResources.CpuPool.Topology.MinNodeGpus[k4].Clone())
+					}
+					changed++
+				}
+			} else if m.Obj.KubernetesResources.CpuPool.Topology.MinNodeGpus != nil {
+				m.Obj.KubernetesResources.CpuPool.Topology.MinNodeGpus = nil
+				changed++
+			}
+			if src.Obj.KubernetesResources.CpuPool.TotalGpus != nil {
+				if updateListAction == "add" {
+					changed += m.AddObjKubernetesResourcesCpuPoolTotalGpus(src.Obj.KubernetesResources.CpuPool.TotalGpus...)
+				} else if updateListAction == "remove" {
+					changed += m.RemoveObjKubernetesResourcesCpuPoolTotalGpus(src.Obj.KubernetesResources.CpuPool.TotalGpus...)
+				} else {
+					m.Obj.KubernetesResources.CpuPool.TotalGpus = make([]*GPUResource, 0)
+					for k3, _ := range src.Obj.KubernetesResources.CpuPool.TotalGpus {
+						m.Obj.KubernetesResources.CpuPool.TotalGpus = append(m.Obj.KubernetesResources.CpuPool.TotalGpus, src.Obj.KubernetesResources.CpuPool.TotalGpus[k3].Clone())
+					}
+					changed++
+				}
+			} else if m.Obj.KubernetesResources.CpuPool.TotalGpus != nil {
+				m.Obj.KubernetesResources.CpuPool.TotalGpus = nil
+				changed++
+			}
+		} else if m.Obj.KubernetesResources.CpuPool != nil {
+			m.Obj.KubernetesResources.CpuPool = nil
+			changed++
+		}
+		if src.Obj.KubernetesResources.GpuPool != nil {
+			if m.Obj.KubernetesResources.GpuPool == nil {
+				m.Obj.KubernetesResources.GpuPool = &NodePoolResources{}
+			}
+			if m.Obj.KubernetesResources.GpuPool.TotalVcpus.Whole != src.Obj.KubernetesResources.GpuPool.TotalVcpus.Whole {
+				m.Obj.KubernetesResources.GpuPool.TotalVcpus.Whole = src.Obj.KubernetesResources.GpuPool.TotalVcpus.Whole
+				changed++
+			}
+			if m.Obj.KubernetesResources.GpuPool.TotalVcpus.Nanos != src.Obj.KubernetesResources.GpuPool.TotalVcpus.Nanos {
+				m.Obj.KubernetesResources.GpuPool.TotalVcpus.Nanos = src.Obj.KubernetesResources.GpuPool.TotalVcpus.Nanos
+				changed++
+			}
+			if m.Obj.KubernetesResources.GpuPool.TotalMemory != src.Obj.KubernetesResources.GpuPool.TotalMemory {
+				m.Obj.KubernetesResources.GpuPool.TotalMemory = src.Obj.KubernetesResources.GpuPool.TotalMemory
+				changed++
+			}
+			if m.Obj.KubernetesResources.GpuPool.TotalDisk != src.Obj.KubernetesResources.GpuPool.TotalDisk {
+				m.Obj.KubernetesResources.GpuPool.TotalDisk = src.Obj.KubernetesResources.GpuPool.TotalDisk
+				changed++
+			}
+			if src.Obj.KubernetesResources.GpuPool.TotalOptRes != nil {
+				if updateListAction == "add" {
+					for k3, v := range src.Obj.KubernetesResources.GpuPool.TotalOptRes {
+						m.Obj.KubernetesResources.GpuPool.TotalOptRes[k3] = v
+						changed++
+					}
+				} else if updateListAction == "remove" {
+					for k3, _ := range src.Obj.KubernetesResources.GpuPool.TotalOptRes {
+						if _, ok := m.Obj.KubernetesResources.GpuPool.TotalOptRes[k3]; ok {
+							delete(m.Obj.KubernetesResources.GpuPool.TotalOptRes, k3)
+							changed++
+						}
+					}
+				} else {
+					m.Obj.KubernetesResources.GpuPool.TotalOptRes = make(map[string]string)
+					for k3, v := range src.Obj.KubernetesResources.GpuPool.TotalOptRes {
+						m.Obj.KubernetesResources.GpuPool.TotalOptRes[k3] = v
+					}
+					changed++
+				}
+			} else if m.Obj.KubernetesResources.GpuPool.TotalOptRes != nil {
+				m.Obj.KubernetesResources.GpuPool.TotalOptRes = nil
+				changed++
+			}
+			if m.Obj.KubernetesResources.GpuPool.Topology.MinNodeVcpus != src.Obj.KubernetesResources.GpuPool.Topology.MinNodeVcpus {
+				m.Obj.KubernetesResources.GpuPool.Topology.MinNodeVcpus = src.Obj.KubernetesResources.GpuPool.Topology.MinNodeVcpus
+				changed++
+			}
+			if m.Obj.KubernetesResources.GpuPool.Topology.MinNodeMemory != src.Obj.KubernetesResources.GpuPool.Topology.MinNodeMemory {
+				m.Obj.KubernetesResources.GpuPool.Topology.MinNodeMemory = src.Obj.KubernetesResources.GpuPool.Topology.MinNodeMemory
+				changed++
+			}
+			if m.Obj.KubernetesResources.GpuPool.Topology.MinNodeDisk != src.Obj.KubernetesResources.GpuPool.Topology.MinNodeDisk {
+				m.Obj.KubernetesResources.GpuPool.Topology.MinNodeDisk = src.Obj.KubernetesResources.GpuPool.Topology.MinNodeDisk
+				changed++
+			}
+			if src.Obj.KubernetesResources.GpuPool.Topology.MinNodeOptRes != nil {
+				if updateListAction == "add" {
+					for k4, v := range src.Obj.KubernetesResources.GpuPool.Topology.MinNodeOptRes {
+						m.Obj.KubernetesResources.GpuPool.Topology.MinNodeOptRes[k4] = v
+						changed++
+					}
+				} else if updateListAction == "remove" {
+					for k4, _ := range src.Obj.KubernetesResources.GpuPool.Topology.MinNodeOptRes {
+						if _, ok := m.Obj.KubernetesResources.GpuPool.Topology.MinNodeOptRes[k4]; ok {
+							delete(m.Obj.KubernetesResources.GpuPool.Topology.MinNodeOptRes, k4)
+							changed++
+						}
+					}
+				} else {
+					m.Obj.KubernetesResources.GpuPool.Topology.MinNodeOptRes = make(map[string]string)
+					for k4, v := range src.Obj.KubernetesResources.GpuPool.Topology.MinNodeOptRes {
+						m.Obj.KubernetesResources.GpuPool.Topology.MinNodeOptRes[k4] = v
+					}
+					changed++
+				}
+			} else if m.Obj.KubernetesResources.GpuPool.Topology.MinNodeOptRes != nil {
+				m.Obj.KubernetesResources.GpuPool.Topology.MinNodeOptRes = nil
+				changed++
+			}
+			if m.Obj.KubernetesResources.GpuPool.Topology.MinNumberOfNodes != src.Obj.KubernetesResources.GpuPool.Topology.MinNumberOfNodes {
+				m.Obj.KubernetesResources.GpuPool.Topology.MinNumberOfNodes = src.Obj.KubernetesResources.GpuPool.Topology.MinNumberOfNodes
+				changed++
+			}
+			if src.Obj.KubernetesResources.GpuPool.Topology.MinNodeGpus != nil {
+				if updateListAction == "add" {
+					changed += m.AddObjKubernetesResourcesGpuPoolTopologyMinNodeGpus(src.Obj.KubernetesResources.GpuPool.Topology.MinNodeGpus...)
+				} else if updateListAction == "remove" {
+					changed += m.RemoveObjKubernetesResourcesGpuPoolTopologyMinNodeGpus(src.Obj.KubernetesResources.GpuPool.Topology.MinNodeGpus...)
+				} else {
+					m.Obj.KubernetesResources.GpuPool.Topology.MinNodeGpus = make([]*GPUResource, 0)
+					for k4, _ := range src.Obj.KubernetesResources.GpuPool.Topology.MinNodeGpus {
+						m.Obj.KubernetesResources.GpuPool.Topology.MinNodeGpus = append(m.Obj.KubernetesResources.GpuPool.Topology.MinNodeGpus, src.Obj.KubernetesResources.GpuPool.Topology.MinNodeGpus[k4].Clone())
+					}
+					changed++
+				}
+			} else if m.Obj.KubernetesResources.GpuPool.Topology.MinNodeGpus != nil {
+				m.Obj.KubernetesResources.GpuPool.Topology.MinNodeGpus = nil
+				changed++
+			}
+			if src.Obj.KubernetesResources.GpuPool.TotalGpus != nil {
+				if updateListAction == "add" {
+					changed += m.AddObjKubernetesResourcesGpuPoolTotalGpus(src.Obj.KubernetesResources.GpuPool.TotalGpus...)
+				} else if updateListAction == "remove" {
+					changed += m.RemoveObjKubernetesResourcesGpuPoolTotalGpus(src.Obj.KubernetesResources.GpuPool.TotalGpus...)
+				} else {
+					m.Obj.KubernetesResources.GpuPool.TotalGpus = make([]*GPUResource, 0)
+					for k3, _ := range src.Obj.KubernetesResources.GpuPool.TotalGpus {
+						m.Obj.KubernetesResources.GpuPool.TotalGpus = append(m.Obj.KubernetesResources.GpuPool.TotalGpus, src.Obj.KubernetesResources.GpuPool.TotalGpus[k3].Clone())
+					}
+					changed++
+				}
+			} else if m.Obj.KubernetesResources.GpuPool.TotalGpus != nil {
+				m.Obj.KubernetesResources.GpuPool.TotalGpus = nil
+				changed++
+			}
+		} else if m.Obj.KubernetesResources.GpuPool != nil {
+			m.Obj.KubernetesResources.GpuPool = nil
+			changed++
+		}
+		if m.Obj.KubernetesResources.MinKubernetesVersion != src.Obj.KubernetesResources.MinKubernetesVersion {
+			m.Obj.KubernetesResources.MinKubernetesVersion = src.Obj.KubernetesResources.MinKubernetesVersion
+			changed++
+		}
+	} else if m.Obj.KubernetesResources != nil {
+		m.Obj.KubernetesResources = nil
+		changed++
+	}
+	if src.Obj.NodeResources != nil {
+		if m.Obj.NodeResources == nil {
+			m.Obj.NodeResources = &NodeResources{}
+		}
+		if m.Obj.NodeResources.Vcpus != src.Obj.NodeResources.Vcpus {
+			m.Obj.NodeResources.Vcpus = src.Obj.NodeResources.Vcpus
+			changed++
+		}
+		if m.Obj.NodeResources.Ram != src.Obj.NodeResources.Ram {
+			m.Obj.NodeResources.Ram = src.Obj.NodeResources.Ram
+			changed++
+		}
+		if m.Obj.NodeResources.Disk != src.Obj.NodeResources.Disk {
+			m.Obj.NodeResources.Disk = src.Obj.NodeResources.Disk
+			changed++
+		}
+		if src.Obj.NodeResources.OptResMap != nil {
+			if updateListAction == "add" {
+				for k2, v := range src.Obj.NodeResources.OptResMap {
+					m.Obj.NodeResources.OptResMap[k2] = v
+					changed++
+				}
+			} else if updateListAction == "remove" {
+				for k2, _ := range src.Obj.NodeResources.OptResMap {
+					if _, ok := m.Obj.NodeResources.OptResMap[k2]; ok {
+						delete(m.Obj.NodeResources.OptResMap, k2)
+						changed++
+					}
+				}
+			} else {
+				m.Obj.NodeResources.OptResMap = make(map[string]string)
+				for k2, v := range src.Obj.NodeResources.OptResMap {
+					m.Obj.NodeResources.OptResMap[k2] = v
+				}
+				changed++
+			}
+		} else if m.Obj.NodeResources.OptResMap != nil {
+			m.Obj.NodeResources.OptResMap = nil
+			changed++
+		}
+		if m.Obj.NodeResources.InfraNodeFlavor != src.Obj.NodeResources.InfraNodeFlavor {
+			m.Obj.NodeResources.InfraNodeFlavor = src.Obj.NodeResources.InfraNodeFlavor
+			changed++
+		}
+		if m.Obj.NodeResources.ExternalVolumeSize != src.Obj.NodeResources.ExternalVolumeSize {
+			m.Obj.NodeResources.ExternalVolumeSize = src.Obj.NodeResources.ExternalVolumeSize
+			changed++
+		}
+		if src.Obj.NodeResources.Gpus != nil {
+			if updateListAction == "add" {
+				changed += m.AddObjNodeResourcesGpus(src.Obj.NodeResources.Gpus...)
+			} else if updateListAction == "remove" {
+				changed += m.RemoveObjNodeResourcesGpus(src.Obj.NodeResources.Gpus...)
+			} else {
+				m.Obj.NodeResources.Gpus = make([]*GPUResource, 0)
+				for k2, _ := range src.Obj.NodeResources.Gpus {
+					m.Obj.NodeResources.Gpus = append(m.Obj.NodeResources.Gpus, src.Obj.NodeResources.Gpus[k2].Clone())
+				}
+				changed++
+			}
+		} else if m.Obj.NodeResources.Gpus != nil {
+			m.Obj.NodeResources.Gpus = nil
+			changed++
+		}
+	} else if m.Obj.NodeResources != nil {
+		m.Obj.NodeResources = nil
+		changed++
+	}
+	if m.Obj.ObjId != src.Obj.ObjId {
+		m.Obj.ObjId = src.Obj.ObjId
+		changed++
+	}
+	if src.Obj.AppAnnotations != nil {
+		if updateListAction == "add" {
+			for k1, v := range src.Obj.AppAnnotations {
+				m.Obj.AppAnnotations[k1] = v
+				changed++
+			}
+		} else if updateListAction == "remove" {
+			for k1, _ := range src.Obj.AppAnnotations {
+				if _, ok := m.Obj.AppAnnotations[k1]; ok {
+					delete(m.Obj.AppAnnotations, k1)
+					changed++
+				}
+			}
+		} else {
+			m.Obj.AppAnnotations = make(map[string]string)
+			for k1, v := range src.Obj.AppAnnotations {
+				m.Obj.AppAnnotations[k1] = v
+			}
+			changed++
+		}
+	} else if m.Obj.AppAnnotations != nil {
+		m.Obj.AppAnnotations = nil
+		changed++
+	}
+	if m.Obj.IsStandalone != src.Obj.IsStandalone {
+		m.Obj.IsStandalone = src.Obj.IsStandalone
+		changed++
+	}
+	if m.Obj.ManagesOwnNamespaces != src.Obj.ManagesOwnNamespaces {
+		m.Obj.ManagesOwnNamespaces = src.Obj.ManagesOwnNamespaces
+		changed++
+	}
+	if m.Obj.CompatibilityVersion != src.Obj.CompatibilityVersion {
+		m.Obj.CompatibilityVersion = src.Obj.CompatibilityVersion
+		changed++
+	}
+	if src.Obj.FindCloudletScoreWeights != nil {
+		if m.Obj.FindCloudletScoreWeights == nil {
+			m.Obj.FindCloudletScoreWeights = &FindCloudletScoreWeights{}
+		}
+		if m.Obj.FindCloudletScoreWeights.Distance != src.Obj.FindCloudletScoreWeights.Distance {
+			m.Obj.FindCloudletScoreWeights.Distance = src.Obj.FindCloudletScoreWeights.Distance
+			changed++
+		}
+		if m.Obj.FindCloudletScoreWeights.Latency != src.Obj.FindCloudletScoreWeights.Latency {
+			m.Obj.FindCloudletScoreWeights.Latency = src.Obj.FindCloudletScoreWeights.Latency
+			changed++
+		}
+		if m.Obj.FindCloudletScoreWeights.ResourceUsage != src.Obj.FindCloudletScoreWeights.ResourceUsage {
+			m.Obj.FindCloudletScoreWeights.ResourceUsage = src.Obj.FindCloudletScoreWeights.ResourceUsage
+			changed++
+		}
+		if m.Obj.FindCloudletScoreWeights.Health != src.Obj.FindCloudletScoreWeights.Health {
+			m.Obj.FindCloudletScoreWeights.Health = src.Obj.FindCloudletScoreWeights.Health
+			changed++
+		}
+	} else if m.Obj.FindCloudletScoreWeights != nil {
+		m.Obj.FindCloudletScoreWeights = nil
+		changed++
+	}
+	if m.Obj.GeoFencePolicy != src.Obj.GeoFencePolicy {
+		m.Obj.GeoFencePolicy = src.Obj.GeoFencePolicy
+		changed++
+	}
+	if src.Obj.ReplicaAutoScalePolicy != nil {
+		if m.Obj.ReplicaAutoScalePolicy == nil {
+			m.Obj.ReplicaAutoScalePolicy = &ReplicaAutoScalePolicy{}
+		}
+		if m.Obj.ReplicaAutoScalePolicy.MinReplicas != src.Obj.ReplicaAutoScalePolicy.MinReplicas {
+			m.Obj.ReplicaAutoScalePolicy.MinReplicas = src.Obj.ReplicaAutoScalePolicy.MinReplicas
+			changed++
+		}
+		if m.Obj.ReplicaAutoScalePolicy.MaxReplicas != src.Obj.ReplicaAutoScalePolicy.MaxReplicas {
+			m.Obj.ReplicaAutoScalePolicy.MaxReplicas = src.Obj.ReplicaAutoScalePolicy.MaxReplicas
+			changed++
+		}
+		if m.Obj.ReplicaAutoScalePolicy.TargetCpu != src.Obj.ReplicaAutoScalePolicy.TargetCpu {
+			m.Obj.ReplicaAutoScalePolicy.TargetCpu = src.Obj.ReplicaAutoScalePolicy.TargetCpu
+			changed++
+		}
+		if m.Obj.ReplicaAutoScalePolicy.TargetMem != src.Obj.ReplicaAutoScalePolicy.TargetMem {
+			m.Obj.ReplicaAutoScalePolicy.TargetMem = src.Obj.ReplicaAutoScalePolicy.TargetMem
+			changed++
+		}
+		if m.Obj.ReplicaAutoScalePolicy.TargetActiveConnections != src.Obj.ReplicaAutoScalePolicy.TargetActiveConnections {
+			m.Obj.ReplicaAutoScalePolicy.TargetActiveConnections = src.Obj.ReplicaAutoScalePolicy.TargetActiveConnections
+			changed++
+		}
+		if m.Obj.ReplicaAutoScalePolicy.StabilizationWindowSec != src.Obj.ReplicaAutoScalePolicy.StabilizationWindowSec {
+			m.Obj.ReplicaAutoScalePolicy.StabilizationWindowSec = src.Obj.ReplicaAutoScalePolicy.StabilizationWindowSec
+			changed++
+		}
+	} else if m.Obj.ReplicaAutoScalePolicy != nil {
+		m.Obj.ReplicaAutoScalePolicy = nil
+		changed++
+	}
+	if src.Obj.Tags != nil {
+		if updateListAction == "add" {
+			for k1, v := range src.Obj.Tags {
+				m.Obj.Tags[k1] = v
+				changed++
+			}
+		} else if updateListAction == "remove" {
+			for k1, _ := range src.Obj.Tags {
+				if _, ok := m.Obj.Tags[k1]; ok {
+					delete(m.Obj.Tags, k1)
+					changed++
+				}
+			}
+		} else {
+			m.Obj.Tags = make(map[string]string)
+			for k1, v := range src.Obj.Tags {
+				m.Obj.Tags[k1] = v
+			}
+			changed++
+		}
+	} else if m.Obj.Tags != nil {
+		m.Obj.Tags = nil
+		changed++
+	}
+	return changed
+}
+
+func (m *AppHistory) DeepCopyIn(src *AppHistory) {
+	m.Revision = src.Revision
+	m.Obj.DeepCopyIn(&src.Obj)
+}
+
+// Helper method to check that enums have valid values
+func (m *AppHistory) ValidateEnums() error {
+	if err := m.Obj.ValidateEnums(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *AppHistory) ClearTagged(tags map[string]struct{}) {
+	s.Obj.ClearTagged(tags)
+}
+
+func IgnoreAppHistoryFields(taglist string) cmp.Option {
+	names := []string{}
+	tags := make(map[string]struct{})
+	for _, tag := range strings.Split(taglist, ",") {
+		tags[tag] = struct{}{}
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Obj.AuthPublicKey")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Obj.Revision")
+	}
+	if _, found := tags["timestamp"]; found {
+		names = append(names, "Obj.CreatedAt")
+	}
+	if _, found := tags["timestamp"]; found {
+		names = append(names, "Obj.UpdatedAt")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Obj.ObjId")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Obj.CompatibilityVersion")
+	}
+	return cmpopts.IgnoreFields(AppHistory{}, names...)
+}
+
+var ImageTypeStrings = []string{
+	"IMAGE_TYPE_UNKNOWN",
+	"IMAGE_TYPE_DOCKER",
+	"IMAGE_TYPE_QCOW",
+	"IMAGE_TYPE_HELM",
+	"IMAGE_TYPE_OVF",
+	"IMAGE_TYPE_OVA",
+}
+
+const (
+	ImageTypeIMAGE_TYPE_UNKNOWN uint64 = 1 << 0
+	ImageTypeIMAGE_TYPE_DOCKER  uint64 = 1 << 1
+	ImageTypeIMAGE_TYPE_QCOW    uint64 = 1 << 2
+	ImageTypeIMAGE_TYPE_HELM    uint64 = 1 << 3
+	ImageTypeIMAGE_TYPE_OVF     uint64 = 1 << 4
+	ImageTypeIMAGE_TYPE_OVA     uint64 = 1 << 5
+)
+
+var ImageType_CamelName = map[int32]string{
+	// IMAGE_TYPE_UNKNOWN -> ImageTypeUnknown
+	0: "ImageTypeUnknown",
+	// IMAGE_TYPE_DOCKER -> ImageTypeDocker
+	1: "ImageTypeDocker",
+	// IMAGE_TYPE_QCOW -> ImageTypeQcow
+	2: "ImageTypeQcow",
+	// IMAGE_TYPE_HELM -> ImageTypeHelm
+	3: "ImageTypeHelm",
+	// IMAGE_TYPE_OVF -> ImageTypeOvf
+	4: "ImageTypeOvf",
+	// IMAGE_TYPE_OVA -> ImageTypeOva
+	5: "ImageTypeOva",
+}
+var ImageType_CamelValue = map[string]int32{
+	"ImageTypeUnknown": 0,
+	"ImageTypeDocker":  1,
+	"ImageTypeQcow":    2,
+	"ImageTypeHelm":    3,
+	"ImageTypeOvf":     4,
+	"ImageTypeOva":     5,
+}
+
+func ParseImageType(data interface{}) (ImageType, error) {
+	if val, ok := data.(ImageType); ok {
+		return val, nil
+	} else if str, ok := data.(string); ok {
+		val, ok := ImageType_CamelValue[util.CamelCase(str)]
+		if !ok {
+			// may have omitted common prefix
+			val, ok = ImageType_CamelValue["ImageType"+util.CamelCase(str)]
+		}
+		if !ok {
+			// may be int value instead of enum name
+			ival, err := strconv.Atoi(str)
+			val = int32(ival)
+			if err == nil {
+				_, ok = ImageType_CamelName[val]
+			}
+		}
+		if !ok {
+			return ImageType(0), fmt.Errorf("Invalid ImageType value %q", str)
+		}
+		return ImageType(val), nil
+	} else if ival, ok := data.(int32); ok {
+		if _, ok := ImageType_CamelName[ival]; ok {
+			return ImageType(ival), nil
+		} else {
+			return ImageType(0), fmt.Errorf("Invalid ImageType value %d", ival)
+		}
+	}
+	return ImageType(0), fmt.Errorf("Invalid ImageType value %v", data)
+}
+
+func (e *ImageType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	err := unmarshal(&str)
+	if err != nil {
+		return err
+	}
+	val, err := ParseImageType(str)
+	if err != nil {
+		return err
+	}
+	*e = val
+	return nil
+}
+
+func (e ImageType) MarshalYAML() (interface{}, error) {
+	str := proto.EnumName(ImageType_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "ImageType")
+	return str, nil
+}
+
+// custom JSON encoding/decoding
+func (e *ImageType) UnmarshalJSON(b []byte) error {
+	var str string
+	err := json.Unmarshal(b, &str)
+	if err == nil {
+		val, err := ParseImageType(str)
+		if err != nil {
+			return &json.UnmarshalTypeError{
+				Value: "string " + str,
+				Type:  reflect.TypeOf(ImageType(0)),
+			}
+		}
+		*e = ImageType(val)
+		return nil
+	}
+	var ival int32
+	err = json.Unmarshal(b, &ival)
+	if err == nil {
+		val, err := ParseImageType(ival)
+		if err == nil {
+			*e = val
+			return nil
+		}
+	}
+	return &json.UnmarshalTypeError{
+		Value: "value " + string(b),
+		Type:  reflect.TypeOf(ImageType(0)),
+	}
+}
+
+func (e ImageType) MarshalJSON() ([]byte, error) {
+	str := proto.EnumName(ImageType_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "ImageType")
+	return json.Marshal(str)
+}
+
+var ImageTypeCommonPrefix = "ImageType"
+
+var QosSessionProfileStrings = []string{
+	"QOS_NO_PRIORITY",
+	"QOS_LOW_LATENCY",
+	"QOS_THROUGHPUT_DOWN_S",
+	"QOS_THROUGHPUT_DOWN_M",
+	"QOS_THROUGHPUT_DOWN_L",
+}
+
+const (
+	QosSessionProfileQOS_NO_PRIORITY       uint64 = 1 << 0
+	QosSessionProfileQOS_LOW_LATENCY       uint64 = 1 << 1
 	QosSessionProfileQOS_THROUGHPUT_DOWN_S uint64 = 1 << 2
 	QosSessionProfileQOS_THROUGHPUT_DOWN_M uint64 = 1 << 3
 	QosSessionProfileQOS_THROUGHPUT_DOWN_L uint64 = 1 << 4
@@ -8977,6 +10764,18 @@ func (m *App) Size() (n int) {
 	if m.CompatibilityVersion != 0 {
 		n += 2 + sovApp(uint64(m.CompatibilityVersion))
 	}
+	if m.FindCloudletScoreWeights != nil {
+		l = m.FindCloudletScoreWeights.Size()
+		n += 2 + l + sovApp(uint64(l))
+	}
+	l = len(m.GeoFencePolicy)
+	if l > 0 {
+		n += 2 + l + sovApp(uint64(l))
+	}
+	if m.ReplicaAutoScalePolicy != nil {
+		l = m.ReplicaAutoScalePolicy.Size()
+		n += 2 + l + sovApp(uint64(l))
+	}
 	if len(m.Tags) > 0 {
 		for k, v := range m.Tags {
 			_ = k
@@ -9007,6 +10806,33 @@ func (m *ServerlessConfig) Size() (n int) {
 	return n
 }
 
+func (m *ReplicaAutoScalePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinReplicas != 0 {
+		n += 1 + sovApp(uint64(m.MinReplicas))
+	}
+	if m.MaxReplicas != 0 {
+		n += 1 + sovApp(uint64(m.MaxReplicas))
+	}
+	if m.TargetCpu != 0 {
+		n += 1 + sovApp(uint64(m.TargetCpu))
+	}
+	if m.TargetMem != 0 {
+		n += 1 + sovApp(uint64(m.TargetMem))
+	}
+	if m.TargetActiveConnections != 0 {
+		n += 1 + sovApp(uint64(m.TargetActiveConnections))
+	}
+	if m.StabilizationWindowSec != 0 {
+		n += 1 + sovApp(uint64(m.StabilizationWindowSec))
+	}
+	return n
+}
+
 func (m *GpuConfig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9078,6 +10904,20 @@ func (m *DeploymentZoneRequest) Size() (n int) {
 	return n
 }
 
+func (m *AppHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		n += 1 + sovApp(uint64(m.Revision))
+	}
+	l = m.Obj.Size()
+	n += 1 + l + sovApp(uint64(l))
+	return n
+}
+
 func sovApp(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -11034,11 +12874,115 @@ func (m *App) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompatibilityVersion |= uint32(b&0x7F) << shift
+				m.CompatibilityVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 57:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FindCloudletScoreWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FindCloudletScoreWeights == nil {
+				m.FindCloudletScoreWeights = &FindCloudletScoreWeights{}
+			}
+			if err := m.FindCloudletScoreWeights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeoFencePolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GeoFencePolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaAutoScalePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReplicaAutoScalePolicy == nil {
+				m.ReplicaAutoScalePolicy = &ReplicaAutoScalePolicy{}
+			}
+			if err := m.ReplicaAutoScalePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 100:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
@@ -11341,6 +13285,170 @@ func (m *ServerlessConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReplicaAutoScalePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicaAutoScalePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicaAutoScalePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReplicas", wireType)
+			}
+			m.MinReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinReplicas |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReplicas", wireType)
+			}
+			m.MaxReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReplicas |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetCpu", wireType)
+			}
+			m.TargetCpu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetCpu |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetMem", wireType)
+			}
+			m.TargetMem = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetMem |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetActiveConnections", wireType)
+			}
+			m.TargetActiveConnections = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetActiveConnections |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StabilizationWindowSec", wireType)
+			}
+			m.StabilizationWindowSec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StabilizationWindowSec |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GpuConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11835,6 +13943,108 @@ func (m *DeploymentZoneRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AppHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Obj", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Obj.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipApp(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
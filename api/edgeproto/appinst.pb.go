@@ -393,6 +393,10 @@ type AppInst struct {
 	NodeResources *NodeResources `protobuf:"bytes,56,opt,name=node_resources,json=nodeResources,proto3" json:"node_resources,omitempty"`
 	// A standalone AppInst will not share a cluster with another AppInst unless explicitly targeted to the same cluster
 	IsStandalone bool `protobuf:"varint,57,opt,name=is_standalone,json=isStandalone,proto3" json:"is_standalone,omitempty"`
+	// Number of replicas for Kubernetes deployments, 0 uses the App manifest default
+	Replicas int32 `protobuf:"varint,58,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	// Preview the create without applying it, reports the chosen cloudlet and cluster, the resources required, and why other candidates were skipped
+	DryRun bool `protobuf:"varint,59,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	// Vendor-specific data
 	Tags map[string]string `protobuf:"bytes,100,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
@@ -436,6 +440,8 @@ var xxx_messageInfo_AppInst proto.InternalMessageInfo
 type AppInstRuntime struct {
 	// List of container names
 	ContainerIds []string `protobuf:"bytes,1,rep,name=container_ids,json=containerIds,proto3" json:"container_ids,omitempty"`
+	// Number of running replicas for Kubernetes deployments
+	Replicas int32 `protobuf:"varint,2,opt,name=replicas,proto3" json:"replicas,omitempty"`
 }
 
 func (m *AppInstRuntime) Reset()         { *m = AppInstRuntime{} }
@@ -534,6 +540,57 @@ func (m *InstPort) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InstPort proto.InternalMessageInfo
 
+// AppInstMove
+//
+// AppInstMove moves an AppInst to a different cloudlet. The AppInst
+// keeps its name and FQDN. A temporary instance serves clients on the
+// target while the AppInst is recreated there.
+type AppInstMove struct {
+	// AppInst to move
+	Key AppInstKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
+	// Target zone, defaults to the zone of the AppInst
+	TargetZoneKey ZoneKey `protobuf:"bytes,2,opt,name=target_zone_key,json=targetZoneKey,proto3" json:"target_zone_key"`
+	// Target cloudlet, if not specified a cloudlet in the target zone is chosen automatically
+	TargetCloudletKey CloudletKey `protobuf:"bytes,3,opt,name=target_cloudlet_key,json=targetCloudletKey,proto3" json:"target_cloudlet_key"`
+	// Target cluster, if not specified a cluster is chosen or created automatically
+	TargetClusterKey ClusterKey `protobuf:"bytes,4,opt,name=target_cluster_key,json=targetClusterKey,proto3" json:"target_cluster_key"`
+	// Time to wait for the replacement AppInst to pass health checks, defaults to 5m
+	HealthCheckTimeout Duration `protobuf:"varint,6,opt,name=health_check_timeout,json=healthCheckTimeout,proto3,casttype=Duration" json:"health_check_timeout,omitempty"`
+}
+
+func (m *AppInstMove) Reset()         { *m = AppInstMove{} }
+func (m *AppInstMove) String() string { return proto.CompactTextString(m) }
+func (*AppInstMove) ProtoMessage()    {}
+func (*AppInstMove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94c89dd623ab567d, []int{7}
+}
+func (m *AppInstMove) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppInstMove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppInstMove.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppInstMove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppInstMove.Merge(m, src)
+}
+func (m *AppInstMove) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppInstMove) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppInstMove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppInstMove proto.InternalMessageInfo
+
 // AppInstInfo provides information from the Cloudlet Resource Manager about the state of the AppInst on the Cloudlet. Whereas the AppInst defines the intent of instantiating an App on a Cloudlet, the AppInstInfo defines the current state of trying to apply that intent on the physical resources of the Cloudlet.
 type AppInstInfo struct {
 	// Fields are used for the Update API to specify which fields to apply
@@ -564,7 +621,7 @@ func (m *AppInstInfo) Reset()         { *m = AppInstInfo{} }
 func (m *AppInstInfo) String() string { return proto.CompactTextString(m) }
 func (*AppInstInfo) ProtoMessage()    {}
 func (*AppInstInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_94c89dd623ab567d, []int{7}
+	return fileDescriptor_94c89dd623ab567d, []int{8}
 }
 func (m *AppInstInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -606,7 +663,7 @@ func (m *AppInstMetrics) Reset()         { *m = AppInstMetrics{} }
 func (m *AppInstMetrics) String() string { return proto.CompactTextString(m) }
 func (*AppInstMetrics) ProtoMessage()    {}
 func (*AppInstMetrics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_94c89dd623ab567d, []int{8}
+	return fileDescriptor_94c89dd623ab567d, []int{9}
 }
 func (m *AppInstMetrics) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -647,7 +704,7 @@ func (m *AppInstLookup) Reset()         { *m = AppInstLookup{} }
 func (m *AppInstLookup) String() string { return proto.CompactTextString(m) }
 func (*AppInstLookup) ProtoMessage()    {}
 func (*AppInstLookup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_94c89dd623ab567d, []int{9}
+	return fileDescriptor_94c89dd623ab567d, []int{10}
 }
 func (m *AppInstLookup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,7 +745,7 @@ func (m *AppInstLookup2) Reset()         { *m = AppInstLookup2{} }
 func (m *AppInstLookup2) String() string { return proto.CompactTextString(m) }
 func (*AppInstLookup2) ProtoMessage()    {}
 func (*AppInstLookup2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_94c89dd623ab567d, []int{10}
+	return fileDescriptor_94c89dd623ab567d, []int{11}
 }
 func (m *AppInstLookup2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -726,7 +783,7 @@ func (m *AppInstLatency) Reset()         { *m = AppInstLatency{} }
 func (m *AppInstLatency) String() string { return proto.CompactTextString(m) }
 func (*AppInstLatency) ProtoMessage()    {}
 func (*AppInstLatency) Descriptor() ([]byte, []int) {
-	return fileDescriptor_94c89dd623ab567d, []int{11}
+	return fileDescriptor_94c89dd623ab567d, []int{12}
 }
 func (m *AppInstLatency) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -767,7 +824,7 @@ func (m *FedAppInstKey) Reset()         { *m = FedAppInstKey{} }
 func (m *FedAppInstKey) String() string { return proto.CompactTextString(m) }
 func (*FedAppInstKey) ProtoMessage()    {}
 func (*FedAppInstKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_94c89dd623ab567d, []int{12}
+	return fileDescriptor_94c89dd623ab567d, []int{13}
 }
 func (m *FedAppInstKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -808,7 +865,7 @@ func (m *FedAppInst) Reset()         { *m = FedAppInst{} }
 func (m *FedAppInst) String() string { return proto.CompactTextString(m) }
 func (*FedAppInst) ProtoMessage()    {}
 func (*FedAppInst) Descriptor() ([]byte, []int) {
-	return fileDescriptor_94c89dd623ab567d, []int{13}
+	return fileDescriptor_94c89dd623ab567d, []int{14}
 }
 func (m *FedAppInst) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -855,7 +912,7 @@ func (m *FedAppInstEvent) Reset()         { *m = FedAppInstEvent{} }
 func (m *FedAppInstEvent) String() string { return proto.CompactTextString(m) }
 func (*FedAppInstEvent) ProtoMessage()    {}
 func (*FedAppInstEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_94c89dd623ab567d, []int{14}
+	return fileDescriptor_94c89dd623ab567d, []int{15}
 }
 func (m *FedAppInstEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -896,6 +953,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "edgeproto.AppInst.TagsEntry")
 	proto.RegisterType((*AppInstRuntime)(nil), "edgeproto.AppInstRuntime")
 	proto.RegisterType((*InstPort)(nil), "edgeproto.InstPort")
+	proto.RegisterType((*AppInstMove)(nil), "edgeproto.AppInstMove")
 	proto.RegisterType((*AppInstInfo)(nil), "edgeproto.AppInstInfo")
 	proto.RegisterType((*AppInstMetrics)(nil), "edgeproto.AppInstMetrics")
 	proto.RegisterType((*AppInstLookup)(nil), "edgeproto.AppInstLookup")
@@ -909,207 +967,221 @@ func init() {
 func init() { proto.RegisterFile("appinst.proto", fileDescriptor_94c89dd623ab567d) }
 
 var fileDescriptor_94c89dd623ab567d = []byte{
-	// 3186 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
-	0xf5, 0xf7, 0x4a, 0x14, 0x45, 0x0e, 0x49, 0x89, 0x1a, 0x7d, 0x78, 0xac, 0xc8, 0xb2, 0x4c, 0xc7,
-	0x89, 0xe2, 0xff, 0x5a, 0xb2, 0xe5, 0x44, 0x4e, 0x14, 0x28, 0xfe, 0x4b, 0x8e, 0x94, 0x28, 0xb6,
-	0x25, 0x67, 0xf5, 0x91, 0xb6, 0x97, 0xc5, 0x6a, 0x77, 0x48, 0x6d, 0xb4, 0xdc, 0xd9, 0xec, 0x2e,
-	0x69, 0xcb, 0x40, 0x81, 0x36, 0x40, 0x81, 0xa2, 0x87, 0x20, 0x4d, 0x0f, 0x2d, 0xd2, 0x1e, 0x02,
-	0xb4, 0x87, 0x1c, 0x7a, 0x48, 0x0c, 0x14, 0x05, 0x7c, 0x28, 0x8a, 0x1e, 0x82, 0x20, 0x27, 0x03,
-	0xbd, 0x04, 0x39, 0x14, 0x6e, 0xd2, 0x43, 0xe1, 0x53, 0x00, 0x4b, 0x4a, 0xd1, 0x53, 0x31, 0x1f,
-	0xbb, 0x9c, 0x25, 0x29, 0xd7, 0xb2, 0x7b, 0xe3, 0xbe, 0xf7, 0x7b, 0x6f, 0xde, 0x7b, 0xf3, 0xe6,
-	0xcd, 0x7b, 0x43, 0x50, 0x30, 0x3c, 0xcf, 0x76, 0x83, 0x70, 0xc2, 0xf3, 0x49, 0x48, 0x60, 0x16,
-	0x5b, 0x15, 0xcc, 0x7e, 0x0e, 0x8f, 0x54, 0x08, 0xa9, 0x38, 0x78, 0xd2, 0xf0, 0xec, 0x49, 0xc3,
-	0x75, 0x49, 0x68, 0x84, 0x36, 0x71, 0x03, 0x0e, 0x1c, 0xce, 0xfb, 0x38, 0xa8, 0x39, 0x42, 0x6c,
-	0xf8, 0x78, 0x48, 0x88, 0x13, 0x4c, 0xb2, 0x8f, 0x0a, 0x76, 0xe3, 0x1f, 0x82, 0x9d, 0x35, 0x3c,
-	0x2f, 0x92, 0x2b, 0x3b, 0x46, 0x9d, 0xf8, 0xe2, 0xab, 0xd7, 0xc7, 0x01, 0xa9, 0xf9, 0x26, 0x8e,
-	0xd5, 0x9a, 0xa4, 0x5a, 0x25, 0x91, 0x5c, 0x9f, 0xe9, 0x90, 0x9a, 0xe5, 0xe0, 0x70, 0x1b, 0xef,
-	0x08, 0xd2, 0xa0, 0x51, 0x0b, 0x49, 0x60, 0x1a, 0x0e, 0xf6, 0x88, 0x63, 0x9b, 0x11, 0xb9, 0x60,
-	0x3a, 0xb5, 0x20, 0xc4, 0x91, 0xde, 0x82, 0x55, 0xc5, 0x93, 0x0e, 0x31, 0xc5, 0x67, 0x3f, 0xfd,
-	0x34, 0x3c, 0x2f, 0xa1, 0x7c, 0xa0, 0x42, 0x2a, 0x84, 0xfd, 0x9c, 0xa4, 0xbf, 0x04, 0x15, 0xc6,
-	0x01, 0x88, 0xcd, 0x2f, 0xdd, 0x53, 0xc0, 0xd1, 0x0d, 0xdb, 0x0f, 0x6b, 0x86, 0x73, 0x99, 0x2f,
-	0xb3, 0xe4, 0x06, 0xe1, 0x15, 0xbc, 0xb3, 0x71, 0x1e, 0xbe, 0x02, 0x72, 0x62, 0x69, 0x7d, 0x1b,
-	0xef, 0x20, 0x65, 0x4c, 0x19, 0xcf, 0x4d, 0x1d, 0x9d, 0x88, 0xb5, 0x4c, 0x08, 0x09, 0x86, 0x9e,
-	0x4f, 0x7d, 0xfe, 0xb7, 0x13, 0x47, 0x34, 0x60, 0xc6, 0x34, 0x78, 0x09, 0xe4, 0x23, 0x27, 0x99,
-	0x82, 0x0e, 0xa6, 0x60, 0x28, 0xa1, 0x80, 0xb3, 0xaf, 0xe0, 0x1d, 0x21, 0x9f, 0x33, 0x1b, 0x24,
-	0x38, 0x0d, 0xf2, 0xc4, 0xaf, 0x18, 0xae, 0x7d, 0x8b, 0xed, 0x0f, 0xea, 0x1c, 0x53, 0xc6, 0xb3,
-	0xf3, 0xf0, 0xce, 0x3e, 0x8a, 0x96, 0x21, 0x7e, 0xe5, 0xee, 0x3e, 0x52, 0xb4, 0x04, 0x6e, 0x26,
-	0xff, 0xcf, 0x07, 0x48, 0xf9, 0xd7, 0x03, 0xa4, 0x7c, 0xf2, 0xd1, 0x09, 0xa5, 0xf4, 0x07, 0x05,
-	0xe4, 0xe7, 0x3c, 0xaf, 0xe1, 0xd7, 0x39, 0xd0, 0x6d, 0x78, 0x9e, 0xe4, 0x53, 0x9f, 0x64, 0xd2,
-	0x9c, 0xe7, 0x35, 0xac, 0x49, 0x1b, 0xec, 0x0b, 0x62, 0x50, 0x8c, 0x22, 0x41, 0x13, 0x8a, 0x89,
-	0xa6, 0x98, 0x68, 0x49, 0x12, 0x3d, 0x20, 0x8e, 0xf3, 0x47, 0x3f, 0xde, 0x45, 0xca, 0xfd, 0x7d,
-	0x94, 0x93, 0x38, 0x4c, 0x7d, 0x8f, 0x99, 0x80, 0x36, 0xd9, 0xfd, 0x97, 0xa4, 0xdd, 0x53, 0xf0,
-	0x24, 0x48, 0xb9, 0x46, 0x15, 0x33, 0xa3, 0xb3, 0xf3, 0x85, 0x3b, 0xfb, 0x28, 0x2b, 0x32, 0xbc,
-	0x3e, 0xa5, 0x31, 0x16, 0x7c, 0xbe, 0x29, 0x62, 0x1d, 0x0c, 0x5a, 0xbc, 0xb3, 0x8f, 0xf2, 0x31,
-	0x94, 0xf8, 0x95, 0x64, 0xbc, 0xe0, 0x95, 0xa6, 0x8d, 0xea, 0x7c, 0xe8, 0x46, 0x15, 0xef, 0xef,
-	0xa3, 0x4c, 0x44, 0x68, 0xd9, 0xb4, 0x26, 0x27, 0x08, 0x00, 0x0d, 0x1f, 0xe0, 0x89, 0x84, 0x07,
-	0xb9, 0x3b, 0xfb, 0xa8, 0x5b, 0x98, 0x25, 0xec, 0x9f, 0x6a, 0x6b, 0x7f, 0x0f, 0xdd, 0x71, 0x01,
-	0x6c, 0xb1, 0xbe, 0x69, 0xc1, 0xdf, 0x8e, 0x80, 0x6e, 0xb1, 0x22, 0x1c, 0x02, 0xe9, 0xb2, 0x8d,
-	0x1d, 0x2b, 0x40, 0xca, 0x58, 0xe7, 0x78, 0x56, 0x13, 0x5f, 0xf0, 0x2c, 0xe8, 0x6c, 0xe4, 0xe3,
-	0x60, 0x72, 0xf3, 0x85, 0xa9, 0x22, 0x01, 0x28, 0x0e, 0x5e, 0x6c, 0xe4, 0x8b, 0x7a, 0x50, 0xbe,
-	0xe4, 0xee, 0xef, 0xa3, 0xce, 0x39, 0xcf, 0x4b, 0xa4, 0xcd, 0x95, 0xe4, 0x01, 0x3a, 0xdb, 0xb2,
-	0x5e, 0xe3, 0x00, 0xcd, 0xf7, 0xb7, 0x4b, 0x10, 0xf9, 0x34, 0x35, 0x6f, 0xd2, 0x85, 0x27, 0xd8,
-	0x24, 0x78, 0x01, 0x64, 0x6e, 0x11, 0x17, 0x33, 0x45, 0x2f, 0x30, 0x45, 0x50, 0x52, 0xf4, 0x03,
-	0xe2, 0xe2, 0x46, 0x0c, 0xba, 0x6f, 0xf1, 0x4f, 0xb8, 0x28, 0x59, 0xe0, 0x10, 0x53, 0xa4, 0xc9,
-	0xf1, 0x09, 0xcb, 0x0e, 0x42, 0xdf, 0xde, 0xac, 0x85, 0xd8, 0xd2, 0xab, 0x46, 0x68, 0x6e, 0xe9,
-	0xd8, 0xad, 0xd8, 0x2e, 0x9e, 0xb8, 0x4a, 0xcc, 0xe6, 0x63, 0x7d, 0x95, 0x98, 0x70, 0x08, 0x74,
-	0xd6, 0x7c, 0x9b, 0x1d, 0xa0, 0xec, 0x7c, 0x8a, 0x1e, 0x0e, 0x8d, 0x12, 0xe0, 0x29, 0x00, 0x02,
-	0x5a, 0x89, 0x4d, 0x9d, 0xb2, 0xa7, 0x24, 0x76, 0x96, 0xd3, 0xd7, 0x7d, 0x1b, 0xbe, 0x00, 0x32,
-	0x8e, 0x5d, 0xc7, 0x2e, 0x0e, 0x02, 0x94, 0x1e, 0x53, 0xc6, 0x7b, 0xa6, 0xfa, 0x25, 0xcb, 0xaf,
-	0x0a, 0x96, 0x90, 0x8b, 0xa1, 0xf0, 0xff, 0x41, 0xbe, 0x6a, 0x78, 0x1e, 0xb6, 0x74, 0x8f, 0xf8,
-	0x61, 0x80, 0xb2, 0x63, 0x9d, 0xe3, 0xb9, 0x84, 0x28, 0x0d, 0xfa, 0x75, 0xe2, 0x87, 0xf3, 0x19,
-	0x2a, 0xca, 0xad, 0xe6, 0x22, 0x94, 0x4a, 0x35, 0xa4, 0x79, 0x7d, 0x47, 0x79, 0xe6, 0xf7, 0x80,
-	0x24, 0xbb, 0xc8, 0x18, 0x34, 0x64, 0x50, 0x9c, 0xf5, 0x34, 0x27, 0xf1, 0x74, 0xe0, 0x72, 0xf0,
-	0x59, 0xd0, 0x1b, 0xc7, 0x4f, 0xa8, 0x3a, 0x43, 0x9d, 0xa4, 0x75, 0x80, 0x93, 0xb9, 0x10, 0xbc,
-	0x00, 0xba, 0xa8, 0xc3, 0x18, 0xf5, 0x30, 0x07, 0xe5, 0x92, 0xbb, 0xe6, 0x1b, 0xe6, 0x36, 0xb6,
-	0x56, 0x29, 0x5b, 0x38, 0xc9, 0xb1, 0x70, 0x04, 0xa4, 0xb1, 0xef, 0x13, 0x3f, 0x40, 0xbd, 0x34,
-	0xd9, 0x05, 0x53, 0xd0, 0xe0, 0x4b, 0x20, 0x6f, 0xfa, 0x55, 0x9d, 0xd4, 0xb1, 0xef, 0xdb, 0x16,
-	0x46, 0x45, 0xa6, 0x39, 0x91, 0x3d, 0xda, 0xb5, 0x15, 0xc1, 0xd5, 0x72, 0xa6, 0x5f, 0x8d, 0x3e,
-	0xe0, 0x3c, 0xc8, 0xfb, 0x35, 0x37, 0xb4, 0xab, 0x58, 0xb7, 0xdd, 0x32, 0x41, 0x7d, 0xcc, 0xfd,
-	0x63, 0xad, 0xc7, 0x46, 0xe3, 0xa8, 0x68, 0xcb, 0x85, 0xd0, 0x92, 0x5b, 0x26, 0xf0, 0xfb, 0x00,
-	0x98, 0x3e, 0x36, 0x68, 0x86, 0x18, 0x21, 0x1a, 0x64, 0x1a, 0x4e, 0x1d, 0x9c, 0x38, 0x6b, 0x76,
-	0x15, 0x07, 0xa1, 0x51, 0xf5, 0xe6, 0x07, 0xa9, 0x17, 0x1f, 0xdc, 0x3e, 0x96, 0x0d, 0x23, 0x12,
-	0x53, 0x9e, 0x15, 0xda, 0xe6, 0x42, 0xb8, 0x0c, 0x86, 0xe8, 0xbd, 0xa9, 0xc7, 0x05, 0xda, 0xd3,
-	0x0d, 0xd3, 0xa4, 0xe9, 0x31, 0xd4, 0x92, 0x1e, 0x4b, 0xde, 0x1c, 0x63, 0x89, 0xe0, 0xf4, 0x53,
-	0xc1, 0xe8, 0xcc, 0x09, 0x16, 0x3c, 0x0d, 0x32, 0x3e, 0xae, 0xdb, 0x01, 0x2d, 0x3f, 0x88, 0xe5,
-	0x60, 0xf6, 0x83, 0xdb, 0xc7, 0xba, 0x5c, 0x62, 0x56, 0x3d, 0x2d, 0x66, 0x41, 0x15, 0xe4, 0xcb,
-	0xc4, 0x37, 0xb1, 0x5e, 0xf3, 0x2c, 0xba, 0x55, 0xc7, 0xc6, 0x94, 0xf1, 0x8c, 0x0c, 0xcd, 0x31,
-	0xf6, 0x3a, 0xe3, 0xc2, 0x29, 0xd0, 0xcb, 0x71, 0x7a, 0xb5, 0xe6, 0x84, 0xb6, 0xe7, 0x60, 0x34,
-	0xdc, 0x2c, 0xd0, 0xc3, 0x11, 0xd7, 0x04, 0x00, 0x4e, 0x82, 0x6e, 0x93, 0xb8, 0x65, 0xbb, 0x12,
-	0xa0, 0xa7, 0x58, 0xb6, 0x26, 0x2a, 0x07, 0xe3, 0x2c, 0xda, 0x0e, 0xd6, 0x22, 0x14, 0x5c, 0x06,
-	0xf9, 0x2d, 0x6c, 0x38, 0xe1, 0x96, 0x6e, 0x6e, 0x61, 0x73, 0x1b, 0x1d, 0x67, 0xfe, 0x9f, 0x3e,
-	0x38, 0xcc, 0xaf, 0x33, 0xf4, 0x65, 0x0a, 0x16, 0x11, 0xc9, 0x6d, 0x35, 0x48, 0x70, 0x1a, 0xe4,
-	0x3c, 0x72, 0x03, 0xfb, 0x3a, 0x4f, 0xc6, 0x13, 0x4c, 0x9d, 0x6c, 0xc4, 0x75, 0xca, 0x65, 0xa9,
-	0xa8, 0x01, 0x2f, 0xfe, 0x0d, 0xa7, 0xc1, 0x00, 0xbe, 0x19, 0x62, 0xdf, 0x35, 0x1c, 0xbd, 0x4e,
-	0x9c, 0x5a, 0x15, 0xeb, 0x81, 0x7d, 0x0b, 0xa3, 0xb1, 0x31, 0x65, 0x3c, 0x25, 0x16, 0x82, 0x11,
-	0x62, 0x83, 0x01, 0x56, 0xed, 0x5b, 0x18, 0x9e, 0x07, 0x7d, 0x46, 0xdd, 0xb0, 0x1d, 0x63, 0xd3,
-	0x76, 0xec, 0x70, 0x47, 0xa7, 0x75, 0x07, 0x9d, 0x94, 0xca, 0x40, 0x51, 0x66, 0xd3, 0x22, 0x05,
-	0x4f, 0x82, 0x6c, 0xbd, 0x1a, 0x1d, 0xa6, 0x92, 0x04, 0xcd, 0xd4, 0xab, 0xe2, 0x30, 0x1d, 0x07,
-	0xdd, 0xc4, 0x0b, 0x75, 0x1f, 0x07, 0xe8, 0x94, 0x04, 0x48, 0x13, 0x2f, 0xd4, 0x70, 0x40, 0x33,
-	0x93, 0xc7, 0x9d, 0x65, 0xe6, 0xd3, 0x4f, 0x9e, 0x99, 0x42, 0xdb, 0x5c, 0x08, 0xcf, 0x81, 0x3e,
-	0x1f, 0x1b, 0x4e, 0x9c, 0x99, 0xec, 0xea, 0x3b, 0x2d, 0xd9, 0xd0, 0x4b, 0xd9, 0x22, 0xff, 0x96,
-	0xe9, 0xf5, 0x67, 0x81, 0x21, 0xdb, 0x15, 0x91, 0xa3, 0x75, 0x4a, 0x0f, 0x89, 0xee, 0x6c, 0xea,
-	0xb6, 0x87, 0x9e, 0x61, 0x19, 0x70, 0xa6, 0xf5, 0xd0, 0x4d, 0x2c, 0x09, 0x01, 0x5a, 0xa5, 0xd6,
-	0xc8, 0xd5, 0xcd, 0x25, 0x6f, 0xc1, 0x0d, 0xfd, 0x9d, 0x28, 0xce, 0x76, 0x0b, 0x1b, 0x9e, 0x04,
-	0x79, 0x0b, 0x5b, 0xb6, 0xc9, 0x9c, 0xb6, 0x3d, 0xf4, 0x2c, 0xcd, 0x44, 0x2d, 0x17, 0xd3, 0x18,
-	0x24, 0x5b, 0x73, 0xed, 0x77, 0x6a, 0x58, 0xb7, 0x2d, 0x34, 0x2e, 0xc7, 0x95, 0x93, 0x97, 0x2c,
-	0x0a, 0xb1, 0xdc, 0x40, 0x77, 0x8c, 0x4d, 0xec, 0xa0, 0xe7, 0x64, 0x88, 0xe5, 0x06, 0x57, 0x29,
-	0x15, 0xbe, 0x0c, 0xba, 0xcb, 0xd8, 0x62, 0x97, 0xcc, 0xff, 0xb1, 0xc0, 0x22, 0xb9, 0x66, 0x62,
-	0x4b, 0xba, 0x6e, 0x1b, 0x45, 0x37, 0x5d, 0xc6, 0x16, 0xbd, 0x6d, 0xe6, 0xc1, 0xa0, 0x49, 0xaa,
-	0x9e, 0x11, 0xda, 0x22, 0x1d, 0xea, 0xd8, 0x67, 0x87, 0x72, 0x62, 0x4c, 0x19, 0x2f, 0xcc, 0x17,
-	0x44, 0xf8, 0xc5, 0xe1, 0x19, 0x48, 0x60, 0x37, 0x38, 0x14, 0x7e, 0x0f, 0xf4, 0xd7, 0x79, 0x53,
-	0xa6, 0xcb, 0x17, 0xf1, 0xe4, 0xc3, 0x2e, 0xe2, 0xbe, 0x84, 0x62, 0x66, 0x52, 0x5f, 0x3d, 0xd1,
-	0xd9, 0xf1, 0x4e, 0x26, 0x87, 0x5d, 0x63, 0xd3, 0xc1, 0xba, 0xed, 0xd5, 0xa7, 0xd1, 0x39, 0x16,
-	0x42, 0xc0, 0x49, 0x4b, 0x5e, 0x7d, 0x1a, 0x3e, 0x0d, 0xd2, 0x64, 0xf3, 0x6d, 0x1a, 0xbe, 0xf3,
-	0xbc, 0x5d, 0x4b, 0xda, 0xdb, 0x45, 0x36, 0xdf, 0x5e, 0xb2, 0xe0, 0x02, 0xc8, 0x49, 0xf3, 0x07,
-	0x7a, 0x9e, 0xed, 0xf2, 0xa9, 0x36, 0xbb, 0x3c, 0xd7, 0x40, 0xb1, 0xed, 0xd5, 0x64, 0x39, 0x78,
-	0x16, 0xe4, 0xac, 0x4d, 0xbd, 0x4a, 0x2c, 0xec, 0xd0, 0x15, 0xa7, 0xc7, 0x94, 0xf1, 0xae, 0xe6,
-	0x15, 0xb3, 0xd6, 0xe6, 0x35, 0x0a, 0x58, 0xb2, 0xe0, 0x9b, 0x60, 0x60, 0xbb, 0xb6, 0x89, 0x7d,
-	0x17, 0x87, 0x38, 0xd0, 0xe3, 0x39, 0x05, 0x5d, 0x64, 0x71, 0x19, 0x95, 0x96, 0xbf, 0x12, 0xc3,
-	0xb4, 0x08, 0xa5, 0xf5, 0x6f, 0xb7, 0x12, 0xe1, 0x25, 0xd0, 0xe3, 0x12, 0x0b, 0x4b, 0xca, 0x5e,
-	0x6c, 0xd9, 0xf1, 0x65, 0x62, 0xe1, 0x86, 0x9a, 0x82, 0x2b, 0x7f, 0xc2, 0x53, 0xa0, 0x60, 0x07,
-	0xb4, 0xd2, 0xb8, 0x96, 0xe1, 0xd0, 0x83, 0xff, 0x12, 0x0b, 0x69, 0xde, 0x0e, 0x56, 0x63, 0x1a,
-	0x3c, 0x07, 0x52, 0xa1, 0x51, 0x09, 0x90, 0xc5, 0xe2, 0x34, 0xd2, 0x26, 0x4e, 0x6b, 0x46, 0x45,
-	0x04, 0x88, 0x21, 0x87, 0x17, 0xc0, 0xd1, 0x03, 0x0e, 0x08, 0x2c, 0xf2, 0x2e, 0x90, 0xf5, 0xa2,
-	0xbc, 0xd1, 0x1b, 0x00, 0x5d, 0x75, 0xc3, 0xa9, 0x61, 0xde, 0x76, 0x6a, 0xfc, 0x63, 0xa6, 0xe3,
-	0x45, 0x65, 0xf8, 0x15, 0x50, 0x6c, 0xde, 0x81, 0x43, 0xc9, 0x5f, 0x04, 0xd9, 0xd8, 0xb2, 0xc3,
-	0x08, 0xce, 0xfc, 0x2e, 0x4d, 0xbb, 0xdb, 0x6f, 0x1f, 0x20, 0xe5, 0x47, 0xbb, 0x48, 0x79, 0x7f,
-	0x17, 0x29, 0xbf, 0xda, 0x45, 0xca, 0x27, 0xf4, 0xb0, 0xec, 0x22, 0xe5, 0x4b, 0xba, 0xc3, 0x7b,
-	0xe8, 0x93, 0x8e, 0xcb, 0x8d, 0xf6, 0x4a, 0x5d, 0xf7, 0x6d, 0x75, 0x35, 0xea, 0x97, 0xd4, 0x6b,
-	0x8d, 0x16, 0x46, 0x8d, 0xba, 0x23, 0xf5, 0x72, 0x74, 0x7b, 0xaa, 0x9a, 0xb8, 0xcf, 0xd4, 0x05,
-	0xd6, 0x27, 0xa8, 0x5a, 0xe3, 0xd2, 0x56, 0x37, 0x44, 0x09, 0x55, 0x17, 0x5a, 0x6a, 0xb5, 0x3a,
-	0xd7, 0x54, 0x89, 0xd9, 0x8a, 0x58, 0x5d, 0x8f, 0x8a, 0x9f, 0xba, 0xc2, 0xca, 0xab, 0xba, 0xba,
-	0x65, 0xf8, 0xd8, 0x92, 0x05, 0x5b, 0xaf, 0x5c, 0xb5, 0x75, 0x87, 0xd4, 0x75, 0x51, 0x66, 0xd4,
-	0x57, 0x45, 0x31, 0x51, 0x17, 0x59, 0x59, 0x50, 0x79, 0xbf, 0x3d, 0xb1, 0x22, 0x4d, 0x00, 0xea,
-	0xe5, 0x36, 0x67, 0x5f, 0xdd, 0x68, 0x3e, 0xb3, 0xaa, 0xd4, 0x1f, 0x7f, 0xb8, 0x87, 0x7e, 0xd6,
-	0x29, 0x26, 0x0a, 0x5a, 0x96, 0x67, 0xa9, 0x4e, 0x5a, 0x82, 0xd5, 0xc6, 0x98, 0x31, 0xdb, 0xb2,
-	0x8e, 0xe1, 0x79, 0x0c, 0x2c, 0x6c, 0x88, 0xf0, 0xb4, 0x30, 0x45, 0xb4, 0x68, 0x75, 0xc3, 0xf3,
-	0xa8, 0x8a, 0x76, 0xd6, 0xd2, 0x6b, 0x6d, 0x56, 0xb4, 0xd8, 0x5c, 0x07, 0xa5, 0x50, 0x74, 0x44,
-	0x6c, 0x81, 0x97, 0xb1, 0x25, 0xf3, 0x17, 0xb1, 0x85, 0x7d, 0x1a, 0xe7, 0x04, 0x30, 0x6a, 0x22,
-	0x67, 0x25, 0x3f, 0xb9, 0xfe, 0x88, 0x43, 0x75, 0xc8, 0xcc, 0x84, 0x78, 0x39, 0x52, 0xda, 0x8c,
-	0x3a, 0x68, 0x35, 0x16, 0xd7, 0xd9, 0x46, 0x7c, 0xa3, 0xb5, 0xa2, 0xc1, 0x5c, 0x66, 0x25, 0x57,
-	0x62, 0x59, 0x35, 0xcb, 0x93, 0x8b, 0x49, 0x7d, 0xb5, 0x87, 0xba, 0x85, 0x73, 0xb7, 0xf7, 0xd1,
-	0xd9, 0x6d, 0xbc, 0x33, 0x9b, 0x90, 0xa8, 0x1b, 0xce, 0x81, 0x86, 0x7f, 0xf4, 0x1d, 0x52, 0xde,
-	0x48, 0x65, 0x46, 0x8a, 0xc7, 0xdf, 0x48, 0x65, 0x46, 0x8b, 0x27, 0x34, 0x18, 0xb0, 0x9c, 0x93,
-	0x5b, 0x0f, 0xad, 0xc7, 0xf3, 0xed, 0xba, 0x61, 0xee, 0xe8, 0xfc, 0x65, 0xa5, 0xf4, 0x32, 0xe8,
-	0x49, 0x36, 0xad, 0xf0, 0x39, 0x50, 0x30, 0x89, 0x1b, 0x1a, 0xb6, 0x4b, 0x7b, 0xc8, 0x68, 0x64,
-	0x14, 0x57, 0x5a, 0x3e, 0x66, 0x2d, 0x59, 0x41, 0xe9, 0xbd, 0x4e, 0x90, 0x89, 0xa6, 0x05, 0x38,
-	0x0d, 0xba, 0x58, 0x05, 0x62, 0xc7, 0xb9, 0x67, 0x6a, 0xec, 0x21, 0xd3, 0xd0, 0x75, 0x8a, 0xd3,
-	0x38, 0x9c, 0xd5, 0x3b, 0xf9, 0xaa, 0x67, 0x47, 0xbf, 0x4b, 0xcb, 0xcb, 0xf7, 0x35, 0xbd, 0x65,
-	0xbc, 0xda, 0xa6, 0x63, 0x9b, 0x1c, 0xd2, 0xc9, 0x20, 0x80, 0x93, 0x62, 0x80, 0x11, 0x6e, 0xe9,
-	0x9e, 0x8f, 0xcb, 0xf6, 0x4d, 0x3e, 0x52, 0x69, 0x80, 0x92, 0xae, 0x33, 0x0a, 0x05, 0x94, 0xdf,
-	0xb1, 0xdc, 0x08, 0xd0, 0xc5, 0x01, 0x94, 0x24, 0x00, 0xc7, 0x40, 0x06, 0xbb, 0x7c, 0x2a, 0x62,
-	0xf3, 0x54, 0x97, 0xd6, 0x8d, 0x5d, 0x56, 0x2f, 0x68, 0x9d, 0x0a, 0x9d, 0x00, 0x75, 0xb3, 0x42,
-	0x4c, 0x7f, 0xd2, 0x3a, 0x45, 0x7d, 0xb9, 0x89, 0x32, 0x8c, 0xc6, 0x3f, 0xe0, 0x18, 0x9d, 0xad,
-	0x6e, 0xea, 0xde, 0x76, 0xc8, 0xfb, 0xbc, 0xec, 0x98, 0x32, 0xde, 0xa9, 0x81, 0xaa, 0x71, 0xf3,
-	0xfa, 0x76, 0xc8, 0x3a, 0xbb, 0x33, 0xa0, 0x2f, 0x76, 0xb6, 0x6e, 0x07, 0x3a, 0x71, 0x9d, 0x1d,
-	0x04, 0x98, 0x8e, 0xde, 0x88, 0xb1, 0x61, 0x07, 0x2b, 0xae, 0xb3, 0x03, 0x7b, 0x40, 0x87, 0x6d,
-	0xa1, 0x1c, 0x33, 0xb4, 0xc3, 0xa6, 0x7d, 0x46, 0x3e, 0xc0, 0x7e, 0xdd, 0x36, 0x31, 0x6f, 0xa0,
-	0xf2, 0x8c, 0x93, 0x13, 0x34, 0x9a, 0x3d, 0xa5, 0xcf, 0x52, 0x20, 0x27, 0xb6, 0x93, 0x4d, 0x1b,
-	0xff, 0xa3, 0xb9, 0xff, 0x19, 0x90, 0x75, 0x49, 0x68, 0x97, 0x77, 0xe8, 0x9d, 0x4a, 0x63, 0xdf,
-	0x99, 0x18, 0x05, 0x38, 0x6f, 0xc9, 0x82, 0x67, 0xa3, 0x71, 0x2d, 0xf5, 0xd0, 0x71, 0x2d, 0x1a,
-	0xd4, 0x86, 0xe2, 0x41, 0xad, 0x8b, 0x5b, 0x27, 0x46, 0xb4, 0xe6, 0x39, 0x2b, 0xfd, 0x18, 0x73,
-	0xd6, 0x45, 0x90, 0xa6, 0x8b, 0xd4, 0xf8, 0xae, 0x25, 0x9d, 0x5c, 0x65, 0x0c, 0x0a, 0x93, 0xbb,
-	0x2d, 0x0e, 0x6f, 0xee, 0xf5, 0x33, 0x8f, 0xda, 0xeb, 0x8b, 0x59, 0x3e, 0xdb, 0x3c, 0xcb, 0x4b,
-	0xad, 0x1f, 0x38, 0x74, 0xeb, 0x37, 0x0d, 0xb2, 0xe5, 0x78, 0x52, 0xcf, 0x1d, 0x3c, 0xa9, 0xf3,
-	0x00, 0x64, 0xca, 0xe2, 0x7e, 0x9b, 0xb9, 0xd4, 0x7c, 0x57, 0x7e, 0xb4, 0x8b, 0x94, 0x3b, 0xbb,
-	0x28, 0x2f, 0x6f, 0xc3, 0xbd, 0x5d, 0xa4, 0xdc, 0xde, 0x47, 0x29, 0x97, 0xb8, 0xf8, 0xdb, 0x7d,
-	0xa4, 0xdc, 0xfe, 0x0e, 0x45, 0x0f, 0x46, 0xa5, 0x89, 0xb8, 0x2c, 0x5c, 0xc3, 0xa1, 0x6f, 0x9b,
-	0x01, 0x1c, 0x01, 0xd9, 0x80, 0x54, 0x71, 0xb8, 0x65, 0xbb, 0x15, 0x76, 0x7a, 0x52, 0x5a, 0x83,
-	0x50, 0xfa, 0xa9, 0x02, 0x0a, 0x42, 0xe0, 0x2a, 0x21, 0xdb, 0x35, 0x2f, 0x4a, 0x31, 0xe5, 0x11,
-	0x53, 0xec, 0x25, 0x00, 0x78, 0x45, 0x92, 0x1e, 0x48, 0x07, 0x12, 0x51, 0xa7, 0xcc, 0x86, 0x50,
-	0xd6, 0x8b, 0x08, 0x33, 0x85, 0x2f, 0xf6, 0x51, 0x36, 0xe6, 0x97, 0x7e, 0xae, 0xc4, 0xb6, 0x73,
-	0x53, 0xa6, 0x0e, 0x6b, 0xcb, 0x93, 0x3e, 0xd7, 0xce, 0xf4, 0x7e, 0xc1, 0x9e, 0xb0, 0x62, 0x42,
-	0xe9, 0x81, 0x64, 0x93, 0x11, 0x62, 0xd7, 0xdc, 0x39, 0xa4, 0x4d, 0x33, 0x9f, 0x2a, 0x1f, 0xee,
-	0xa1, 0xdf, 0x2b, 0x87, 0xbe, 0xa9, 0xe3, 0xbb, 0x90, 0x72, 0x1e, 0x7a, 0x1f, 0x36, 0x03, 0xda,
-	0xaa, 0x11, 0xf7, 0x6f, 0x33, 0xb6, 0xed, 0xcd, 0x48, 0x77, 0xa2, 0x90, 0xc8, 0x70, 0x78, 0x09,
-	0xf4, 0x8a, 0xdb, 0xd5, 0x26, 0xae, 0x2e, 0xbd, 0x80, 0x0e, 0xdd, 0xd9, 0x47, 0x3d, 0x0d, 0x16,
-	0xe5, 0xb0, 0xe7, 0x6c, 0x89, 0xc6, 0xe6, 0xc2, 0xf3, 0x20, 0x67, 0x78, 0x1e, 0x7f, 0x7b, 0xb6,
-	0x2d, 0xf1, 0x2a, 0xda, 0x27, 0x3d, 0x00, 0xdb, 0x16, 0x93, 0xa3, 0x9f, 0xac, 0x0a, 0x5a, 0x4d,
-	0xaf, 0xa2, 0xbf, 0x54, 0x00, 0x68, 0xd8, 0x04, 0xcf, 0xc9, 0xbb, 0x70, 0xf0, 0xc9, 0x94, 0x92,
-	0x63, 0x16, 0xe4, 0x63, 0x0b, 0x1e, 0xb1, 0x86, 0x02, 0x23, 0xa6, 0xcc, 0x20, 0xf9, 0x64, 0xca,
-	0xa7, 0xaf, 0x74, 0x4f, 0x01, 0xbd, 0x8d, 0x55, 0x17, 0xea, 0xd8, 0x7d, 0x1c, 0xf3, 0xe2, 0x12,
-	0xdc, 0xf1, 0x48, 0x25, 0x18, 0x81, 0xee, 0x2a, 0x0e, 0x02, 0xa3, 0x82, 0xf9, 0x7f, 0x0a, 0x5a,
-	0xf4, 0x09, 0x27, 0x41, 0x17, 0x2f, 0x3b, 0xa9, 0xff, 0x56, 0x76, 0x38, 0x0e, 0x3e, 0x25, 0x4f,
-	0xca, 0xfc, 0x7a, 0x8d, 0x67, 0xe4, 0x99, 0xd4, 0x9f, 0x77, 0x91, 0x72, 0xe6, 0xbd, 0x0e, 0x00,
-	0x1a, 0xe5, 0x13, 0x1e, 0x07, 0xfd, 0xd7, 0x57, 0xde, 0x5a, 0xd0, 0xf4, 0xd5, 0xb5, 0xb9, 0xb5,
-	0x05, 0x7d, 0x7d, 0xf9, 0xca, 0xf2, 0xca, 0x5b, 0xcb, 0xc5, 0x23, 0xc3, 0xa9, 0xf7, 0xf7, 0x91,
-	0x02, 0x47, 0x00, 0xe4, 0xec, 0x95, 0x65, 0x5d, 0x5b, 0x78, 0x73, 0x7d, 0x61, 0x75, 0x6d, 0xe1,
-	0xd5, 0xa2, 0x22, 0xb8, 0x83, 0x20, 0xc7, 0xb8, 0x4b, 0xcb, 0xaf, 0xe9, 0x2b, 0xcb, 0xc5, 0x0e,
-	0x41, 0xce, 0x83, 0x4c, 0x24, 0x54, 0xec, 0x6c, 0xac, 0xb0, 0xb2, 0xb8, 0x28, 0xe9, 0x48, 0x09,
-	0xf0, 0x10, 0xc8, 0x37, 0x74, 0x2c, 0x2e, 0x16, 0xbb, 0x04, 0xbd, 0x00, 0xb2, 0xb1, 0x58, 0x31,
-	0x0d, 0x87, 0x41, 0x51, 0x5b, 0x98, 0x5f, 0x59, 0x59, 0x93, 0x54, 0x74, 0x0b, 0x68, 0x3f, 0xc8,
-	0x72, 0xde, 0xd2, 0xf2, 0x6b, 0xc5, 0x8c, 0x20, 0x02, 0x90, 0xe6, 0xc4, 0x62, 0x16, 0x3e, 0x05,
-	0xfa, 0x64, 0x27, 0x17, 0x34, 0x6d, 0x45, 0x2b, 0x02, 0x0e, 0x9c, 0xfa, 0x32, 0x13, 0xff, 0x2b,
-	0x30, 0xe7, 0xd9, 0xf0, 0x8f, 0x0a, 0x28, 0xf0, 0x89, 0x24, 0xca, 0x4f, 0xd8, 0x9a, 0x57, 0xc3,
-	0xf2, 0xa3, 0xbb, 0xc6, 0xfe, 0xa0, 0x2b, 0xfd, 0xf0, 0xfe, 0x2e, 0x9a, 0x88, 0x46, 0x48, 0x81,
-	0x0b, 0xd4, 0x39, 0x93, 0x9e, 0x9b, 0x6b, 0x86, 0x6b, 0x54, 0xb0, 0xda, 0x7c, 0xa4, 0x3f, 0xde,
-	0x43, 0xca, 0xdd, 0x3d, 0xa4, 0x7c, 0xb5, 0x87, 0x4e, 0xaf, 0x27, 0xde, 0xdb, 0xd4, 0xc5, 0xc6,
-	0x7b, 0x9d, 0xda, 0xd8, 0xae, 0x77, 0xff, 0xfa, 0x8f, 0x5f, 0x74, 0x0c, 0x94, 0x7a, 0x27, 0xf9,
-	0x8b, 0xe3, 0xa4, 0x38, 0x70, 0x33, 0xca, 0x99, 0x73, 0x0a, 0xfc, 0x8d, 0x02, 0x0a, 0xaf, 0x62,
-	0x07, 0x1f, 0xda, 0xf2, 0xcd, 0x27, 0xb2, 0x1c, 0xb4, 0x31, 0xcf, 0x62, 0x66, 0x24, 0xcd, 0xfb,
-	0xb7, 0x02, 0x7a, 0x34, 0x5c, 0xf6, 0x71, 0xb0, 0x75, 0x48, 0xfb, 0xfe, 0xa4, 0x3c, 0x9e, 0x81,
-	0x5f, 0xed, 0xa1, 0x55, 0x31, 0x34, 0xb6, 0x1b, 0xf4, 0xf8, 0xab, 0x65, 0x20, 0x85, 0x57, 0x95,
-	0xde, 0x20, 0x5b, 0x87, 0xc5, 0x68, 0x02, 0xbd, 0xbf, 0x87, 0x20, 0x2f, 0xbe, 0xf2, 0xff, 0x65,
-	0xcc, 0xf7, 0xc1, 0x52, 0x71, 0xd2, 0xe7, 0x3e, 0x26, 0x9d, 0xff, 0x71, 0x07, 0x28, 0xf0, 0xdd,
-	0x3c, 0xa4, 0xef, 0x9f, 0x3d, 0xbe, 0xef, 0x7e, 0x3b, 0xa7, 0x1f, 0x92, 0x66, 0x8f, 0xe4, 0xbc,
-	0x18, 0x79, 0xd5, 0xf6, 0x93, 0x6c, 0x9c, 0x00, 0xfc, 0xdd, 0x31, 0x19, 0x83, 0x9f, 0x28, 0x20,
-	0xb7, 0xba, 0x45, 0x6e, 0x3c, 0x2c, 0x02, 0x6d, 0x68, 0xa5, 0xab, 0xf7, 0x77, 0x91, 0x7a, 0x40,
-	0x04, 0x36, 0x6c, 0x7c, 0xa3, 0xc5, 0x7f, 0x9a, 0x98, 0xcc, 0x12, 0x58, 0x2a, 0x4c, 0x06, 0x5b,
-	0xe4, 0x46, 0xd2, 0x8e, 0x2d, 0x30, 0xf8, 0xba, 0xe1, 0x5a, 0x0e, 0x6e, 0xae, 0xf4, 0xc3, 0x6d,
-	0x8b, 0x3b, 0xe3, 0xb5, 0xdb, 0x9a, 0x31, 0xb6, 0xc6, 0x70, 0x69, 0x70, 0x92, 0x5e, 0x90, 0x14,
-	0x15, 0xad, 0x43, 0x3b, 0xe6, 0x19, 0xe5, 0xcc, 0x54, 0x10, 0x77, 0x1c, 0xb4, 0xd1, 0xa5, 0xd5,
-	0xc5, 0x00, 0xbd, 0x52, 0x08, 0xf8, 0x7c, 0xd0, 0xea, 0x32, 0xa5, 0x0f, 0x1f, 0x40, 0x2f, 0x8d,
-	0xb0, 0x65, 0x87, 0x4a, 0x7d, 0x09, 0xd7, 0xc4, 0x92, 0xe7, 0x94, 0xa9, 0x77, 0x15, 0xd0, 0x97,
-	0xec, 0x1b, 0xe9, 0xc2, 0x55, 0x00, 0xa5, 0x85, 0xa3, 0x86, 0xb2, 0x4d, 0x3f, 0x2f, 0x58, 0xc3,
-	0x07, 0xb3, 0x4a, 0x27, 0x98, 0x05, 0xc7, 0x4a, 0x03, 0x09, 0x0b, 0xaa, 0x9c, 0xcb, 0x8d, 0xf8,
-	0xb4, 0x61, 0x84, 0x68, 0xb6, 0xa8, 0x11, 0xbf, 0x56, 0xc0, 0xa0, 0x86, 0xdf, 0xa9, 0x61, 0x5a,
-	0x6a, 0x13, 0x9d, 0x58, 0x9b, 0xd5, 0x04, 0xab, 0x5d, 0xe4, 0xd7, 0x0e, 0x7f, 0x26, 0x98, 0xc9,
-	0x23, 0xa5, 0xa3, 0x93, 0x3e, 0x5f, 0x3f, 0xb2, 0xda, 0xe1, 0xab, 0xcc, 0x28, 0x67, 0xe6, 0x47,
-	0x3e, 0xff, 0xfb, 0xe8, 0x91, 0xcf, 0xbf, 0x1e, 0x55, 0xee, 0x7e, 0x3d, 0xaa, 0xdc, 0xfb, 0x7a,
-	0x54, 0x79, 0xff, 0x9b, 0xd1, 0x23, 0x77, 0xbf, 0x19, 0x3d, 0xf2, 0xe5, 0x37, 0xa3, 0x47, 0x36,
-	0xd3, 0xcc, 0x82, 0x0b, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x79, 0x13, 0xc6, 0xc8, 0x21,
-	0x00, 0x00,
+	// 3411 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x5f, 0x68, 0x1c, 0x57,
+	0x77, 0xf7, 0x48, 0xab, 0xd5, 0xee, 0xdd, 0x5d, 0x69, 0x75, 0xf5, 0xc7, 0xd7, 0x8a, 0x2c, 0xaf,
+	0xd7, 0x71, 0xa2, 0xb8, 0x63, 0x49, 0x96, 0x13, 0x39, 0x51, 0x50, 0x1c, 0xc9, 0x96, 0x12, 0xc5,
+	0xb2, 0xe4, 0x8c, 0xfe, 0xa4, 0x0d, 0x94, 0x61, 0x34, 0x73, 0x77, 0x35, 0xd1, 0xec, 0xcc, 0x64,
+	0x66, 0x76, 0x6d, 0x19, 0x0a, 0x6d, 0xa0, 0x10, 0xfa, 0x10, 0xd2, 0xf4, 0xa1, 0x25, 0x7d, 0x09,
+	0xb4, 0x0f, 0x79, 0xe8, 0x43, 0x62, 0x28, 0x05, 0x3f, 0x95, 0x42, 0x4b, 0x08, 0x14, 0x0c, 0x7d,
+	0x09, 0x79, 0x08, 0xa9, 0xd3, 0x87, 0xe2, 0xa7, 0x80, 0x25, 0xe5, 0xe3, 0x83, 0xef, 0xe3, 0xe3,
+	0xfe, 0x99, 0x99, 0x3b, 0xbb, 0x2b, 0xc5, 0xb2, 0xbf, 0xb7, 0x9d, 0x73, 0x7e, 0xe7, 0xcf, 0x3d,
+	0xf7, 0xdc, 0x73, 0xcf, 0x3d, 0x0b, 0x0a, 0x9a, 0xeb, 0x9a, 0xb6, 0x1f, 0x8c, 0xbb, 0x9e, 0x13,
+	0x38, 0x30, 0x8b, 0x8d, 0x2a, 0xa6, 0x3f, 0x87, 0x47, 0xaa, 0x8e, 0x53, 0xb5, 0xf0, 0x84, 0xe6,
+	0x9a, 0x13, 0x9a, 0x6d, 0x3b, 0x81, 0x16, 0x98, 0x8e, 0xed, 0x33, 0xe0, 0x70, 0xde, 0xc3, 0x7e,
+	0xdd, 0xe2, 0x62, 0xc3, 0xa7, 0x03, 0xc7, 0xb1, 0xfc, 0x09, 0xfa, 0x51, 0xc5, 0x76, 0xf4, 0x83,
+	0xb3, 0xb3, 0x9a, 0xeb, 0x86, 0x72, 0x15, 0x4b, 0x6b, 0x38, 0x1e, 0xff, 0xea, 0xf5, 0xb0, 0xef,
+	0xd4, 0x3d, 0x1d, 0x47, 0x6a, 0x75, 0xa7, 0x56, 0x73, 0x42, 0xb9, 0x3e, 0xdd, 0x72, 0xea, 0x86,
+	0x85, 0x83, 0x1d, 0xbc, 0xcb, 0x49, 0x83, 0x5a, 0x3d, 0x70, 0x7c, 0x5d, 0xb3, 0xb0, 0xeb, 0x58,
+	0xa6, 0x1e, 0x92, 0x0b, 0xba, 0x55, 0xf7, 0x03, 0x1c, 0xea, 0x2d, 0x18, 0x35, 0x3c, 0x61, 0x39,
+	0x3a, 0xff, 0xec, 0x27, 0x9f, 0x9a, 0xeb, 0x26, 0x94, 0x0f, 0x54, 0x9d, 0xaa, 0x43, 0x7f, 0x4e,
+	0x90, 0x5f, 0x9c, 0x0a, 0xa3, 0x00, 0x44, 0xee, 0x97, 0x7f, 0x94, 0xc0, 0xc9, 0x4d, 0xd3, 0x0b,
+	0xea, 0x9a, 0x75, 0x8d, 0x99, 0x59, 0xb2, 0xfd, 0xe0, 0x06, 0xde, 0xdd, 0xbc, 0x04, 0xdf, 0x00,
+	0x39, 0x6e, 0x5a, 0xdd, 0xc1, 0xbb, 0x48, 0x2a, 0x49, 0x63, 0xb9, 0xa9, 0x93, 0xe3, 0x91, 0x96,
+	0x71, 0x2e, 0x41, 0xd1, 0xf3, 0xa9, 0x6f, 0x7e, 0x38, 0x73, 0x42, 0x01, 0x7a, 0x44, 0x83, 0x57,
+	0x41, 0x3e, 0x5c, 0x24, 0x55, 0xd0, 0x41, 0x15, 0x0c, 0x25, 0x14, 0x30, 0xf6, 0x0d, 0xbc, 0xcb,
+	0xe5, 0x73, 0x7a, 0x4c, 0x82, 0xd3, 0x20, 0xef, 0x78, 0x55, 0xcd, 0x36, 0xef, 0xd2, 0xfd, 0x41,
+	0x9d, 0x25, 0x69, 0x2c, 0x3b, 0x0f, 0xef, 0x1f, 0xa0, 0xd0, 0x8c, 0xe3, 0x55, 0x1f, 0x1c, 0x20,
+	0x49, 0x49, 0xe0, 0x66, 0xf2, 0xff, 0xff, 0x18, 0x49, 0xbf, 0x79, 0x8c, 0xa4, 0xaf, 0xbe, 0x38,
+	0x23, 0x95, 0xff, 0x55, 0x02, 0xf9, 0x39, 0xd7, 0x8d, 0xd7, 0x35, 0x09, 0xba, 0x35, 0xd7, 0x15,
+	0xd6, 0xd4, 0x27, 0xb8, 0x34, 0xe7, 0xba, 0xb1, 0x37, 0x69, 0x8d, 0x7e, 0x41, 0x0c, 0x8a, 0x61,
+	0x24, 0x48, 0x42, 0x51, 0xd1, 0x14, 0x15, 0x2d, 0x0b, 0xa2, 0x87, 0xc4, 0x71, 0xfe, 0xe4, 0x97,
+	0x7b, 0x48, 0x7a, 0x74, 0x80, 0x72, 0x02, 0x87, 0xaa, 0xef, 0xd1, 0x13, 0xd0, 0x26, 0xbf, 0xff,
+	0x23, 0xe9, 0xf7, 0x14, 0x3c, 0x0b, 0x52, 0xb6, 0x56, 0xc3, 0xd4, 0xe9, 0xec, 0x7c, 0xe1, 0xfe,
+	0x01, 0xca, 0xf2, 0x0c, 0x6f, 0x4c, 0x29, 0x94, 0x05, 0x5f, 0x6e, 0x8a, 0x58, 0x07, 0x85, 0x16,
+	0xef, 0x1f, 0xa0, 0x7c, 0x04, 0x75, 0xbc, 0x6a, 0x32, 0x5e, 0xf0, 0x46, 0xd3, 0x46, 0x75, 0x1e,
+	0xb9, 0x51, 0xc5, 0x47, 0x07, 0x28, 0x13, 0x12, 0x5a, 0x36, 0xad, 0x69, 0x11, 0x0e, 0x00, 0xf1,
+	0x1a, 0xe0, 0x99, 0xc4, 0x0a, 0x72, 0xf7, 0x0f, 0x50, 0x37, 0x77, 0x8b, 0xfb, 0x3f, 0xd5, 0xd6,
+	0xff, 0x1e, 0xb2, 0xe3, 0x1c, 0xd8, 0xe2, 0x7d, 0x93, 0xc1, 0xef, 0x46, 0x40, 0x37, 0xb7, 0x08,
+	0x87, 0x40, 0xba, 0x62, 0x62, 0xcb, 0xf0, 0x91, 0x54, 0xea, 0x1c, 0xcb, 0x2a, 0xfc, 0x0b, 0x5e,
+	0x04, 0x9d, 0x71, 0x3e, 0x0e, 0x26, 0x37, 0x9f, 0xbb, 0xca, 0x13, 0x80, 0xe0, 0xe0, 0x95, 0x38,
+	0x5f, 0xe4, 0xc3, 0xf2, 0x25, 0xf7, 0xe8, 0x00, 0x75, 0xce, 0xb9, 0x6e, 0x22, 0x6d, 0x6e, 0x24,
+	0x0f, 0xd0, 0xc5, 0x16, 0x7b, 0xf1, 0x01, 0x9a, 0xef, 0x6f, 0x97, 0x20, 0xe2, 0x69, 0x6a, 0xde,
+	0xa4, 0xcb, 0xcf, 0xb0, 0x49, 0xf0, 0x32, 0xc8, 0xdc, 0x75, 0x6c, 0x4c, 0x15, 0xbd, 0x42, 0x15,
+	0x41, 0x41, 0xd1, 0xfb, 0x8e, 0x8d, 0xe3, 0x18, 0x74, 0xdf, 0x65, 0x9f, 0x70, 0x51, 0xf0, 0xc0,
+	0x72, 0x74, 0x9e, 0x26, 0xa7, 0xc7, 0x0d, 0xd3, 0x0f, 0x3c, 0x73, 0xab, 0x1e, 0x60, 0x43, 0xad,
+	0x69, 0x81, 0xbe, 0xad, 0x62, 0xbb, 0x6a, 0xda, 0x78, 0x7c, 0xd9, 0xd1, 0x9b, 0x8f, 0xf5, 0xb2,
+	0xa3, 0xc3, 0x21, 0xd0, 0x59, 0xf7, 0x4c, 0x7a, 0x80, 0xb2, 0xf3, 0x29, 0x72, 0x38, 0x14, 0x42,
+	0x80, 0xe7, 0x00, 0xf0, 0x49, 0x25, 0xd6, 0x55, 0xc2, 0x9e, 0x12, 0xd8, 0x59, 0x46, 0xdf, 0xf0,
+	0x4c, 0xf8, 0x0a, 0xc8, 0x58, 0x66, 0x03, 0xdb, 0xd8, 0xf7, 0x51, 0xba, 0x24, 0x8d, 0xf5, 0x4c,
+	0xf5, 0x0b, 0x9e, 0x2f, 0x73, 0x16, 0x97, 0x8b, 0xa0, 0xf0, 0x4d, 0x90, 0xaf, 0x69, 0xae, 0x8b,
+	0x0d, 0xd5, 0x75, 0xbc, 0xc0, 0x47, 0xd9, 0x52, 0xe7, 0x58, 0x2e, 0x21, 0x4a, 0x82, 0x7e, 0xcb,
+	0xf1, 0x82, 0xf9, 0x0c, 0x11, 0x65, 0x5e, 0x33, 0x11, 0x42, 0x25, 0x1a, 0xd2, 0xac, 0xbe, 0xa3,
+	0x3c, 0x5d, 0xf7, 0x80, 0x20, 0xbb, 0x48, 0x19, 0x24, 0x64, 0x90, 0x9f, 0xf5, 0x34, 0x23, 0xb1,
+	0x74, 0x60, 0x72, 0xf0, 0x45, 0xd0, 0x1b, 0xc5, 0x8f, 0xab, 0xba, 0x40, 0x16, 0xa9, 0xf4, 0x84,
+	0x64, 0x26, 0x04, 0x2f, 0x83, 0x2e, 0xb2, 0x60, 0x8c, 0x7a, 0xe8, 0x02, 0xc5, 0x92, 0xbb, 0xee,
+	0x69, 0xfa, 0x0e, 0x36, 0xd6, 0x08, 0x9b, 0x2f, 0x92, 0x61, 0xe1, 0x08, 0x48, 0x63, 0xcf, 0x73,
+	0x3c, 0x1f, 0xf5, 0x92, 0x64, 0xe7, 0x4c, 0x4e, 0x83, 0xaf, 0x81, 0xbc, 0xee, 0xd5, 0x54, 0xa7,
+	0x81, 0x3d, 0xcf, 0x34, 0x30, 0x2a, 0x52, 0xcd, 0x89, 0xec, 0x51, 0x6e, 0xae, 0x72, 0xae, 0x92,
+	0xd3, 0xbd, 0x5a, 0xf8, 0x01, 0xe7, 0x41, 0xde, 0xab, 0xdb, 0x81, 0x59, 0xc3, 0xaa, 0x69, 0x57,
+	0x1c, 0xd4, 0x47, 0x97, 0x7f, 0xaa, 0xf5, 0xd8, 0x28, 0x0c, 0x15, 0x6e, 0x39, 0x17, 0x5a, 0xb2,
+	0x2b, 0x0e, 0xfc, 0x33, 0x00, 0x74, 0x0f, 0x6b, 0x24, 0x43, 0xb4, 0x00, 0x0d, 0x52, 0x0d, 0xe7,
+	0x0e, 0x4f, 0x9c, 0x75, 0xb3, 0x86, 0xfd, 0x40, 0xab, 0xb9, 0xf3, 0x83, 0x64, 0x15, 0x9f, 0xdd,
+	0x3b, 0x95, 0x0d, 0x42, 0x12, 0x55, 0x9e, 0xe5, 0xda, 0xe6, 0x02, 0xb8, 0x02, 0x86, 0xc8, 0xbd,
+	0xa9, 0x46, 0x05, 0xda, 0x55, 0x35, 0x5d, 0x27, 0xe9, 0x31, 0xd4, 0x92, 0x1e, 0x4b, 0xee, 0x1c,
+	0x65, 0xf1, 0xe0, 0xf4, 0x13, 0xc1, 0xf0, 0xcc, 0x71, 0x16, 0x3c, 0x0f, 0x32, 0x1e, 0x6e, 0x98,
+	0x3e, 0x29, 0x3f, 0x88, 0xe6, 0x60, 0xf6, 0xb3, 0x7b, 0xa7, 0xba, 0x6c, 0x47, 0xaf, 0xb9, 0x4a,
+	0xc4, 0x82, 0x32, 0xc8, 0x57, 0x1c, 0x4f, 0xc7, 0x6a, 0xdd, 0x35, 0xc8, 0x56, 0x9d, 0x2a, 0x49,
+	0x63, 0x19, 0x11, 0x9a, 0xa3, 0xec, 0x0d, 0xca, 0x85, 0x53, 0xa0, 0x97, 0xe1, 0xd4, 0x5a, 0xdd,
+	0x0a, 0x4c, 0xd7, 0xc2, 0x68, 0xb8, 0x59, 0xa0, 0x87, 0x21, 0x6e, 0x72, 0x00, 0x9c, 0x00, 0xdd,
+	0xba, 0x63, 0x57, 0xcc, 0xaa, 0x8f, 0x9e, 0x2b, 0x75, 0x36, 0x57, 0x0e, 0xca, 0x59, 0x34, 0x2d,
+	0xac, 0x84, 0x28, 0xb8, 0x02, 0xf2, 0xdb, 0x58, 0xb3, 0x82, 0x6d, 0x55, 0xdf, 0xc6, 0xfa, 0x0e,
+	0x3a, 0x4d, 0xd7, 0x7f, 0xfe, 0xf0, 0x30, 0xbf, 0x4d, 0xd1, 0xd7, 0x08, 0x98, 0x47, 0x24, 0xb7,
+	0x1d, 0x93, 0xe0, 0x34, 0xc8, 0xb9, 0xce, 0x6d, 0xec, 0xa9, 0x2c, 0x19, 0xcf, 0x50, 0x75, 0xa2,
+	0x13, 0xb7, 0x08, 0x97, 0xa6, 0xa2, 0x02, 0xdc, 0xe8, 0x37, 0x9c, 0x06, 0x03, 0xf8, 0x4e, 0x80,
+	0x3d, 0x5b, 0xb3, 0xd4, 0x86, 0x63, 0xd5, 0x6b, 0x58, 0xf5, 0xcd, 0xbb, 0x18, 0x95, 0x4a, 0xd2,
+	0x58, 0x8a, 0x1b, 0x82, 0x21, 0x62, 0x93, 0x02, 0xd6, 0xcc, 0xbb, 0x18, 0x5e, 0x02, 0x7d, 0x5a,
+	0x43, 0x33, 0x2d, 0x6d, 0xcb, 0xb4, 0xcc, 0x60, 0x57, 0x25, 0x75, 0x07, 0x9d, 0x15, 0xca, 0x40,
+	0x51, 0x64, 0x93, 0x22, 0x05, 0xcf, 0x82, 0x6c, 0xa3, 0x16, 0x1e, 0xa6, 0xb2, 0x00, 0xcd, 0x34,
+	0x6a, 0xfc, 0x30, 0x9d, 0x06, 0xdd, 0x8e, 0x1b, 0xa8, 0x1e, 0xf6, 0xd1, 0x39, 0x01, 0x90, 0x76,
+	0xdc, 0x40, 0xc1, 0x3e, 0xc9, 0x4c, 0x16, 0x77, 0x9a, 0x99, 0xcf, 0x3f, 0x7b, 0x66, 0x72, 0x6d,
+	0x73, 0x01, 0x9c, 0x04, 0x7d, 0x1e, 0xd6, 0xac, 0x28, 0x33, 0xe9, 0xd5, 0x77, 0x5e, 0xf0, 0xa1,
+	0x97, 0xb0, 0x79, 0xfe, 0xad, 0x90, 0xeb, 0xcf, 0x00, 0x43, 0xa6, 0xcd, 0x23, 0x47, 0xea, 0x94,
+	0x1a, 0x38, 0xaa, 0xb5, 0xa5, 0x9a, 0x2e, 0x7a, 0x81, 0x66, 0xc0, 0x85, 0xd6, 0x43, 0x37, 0xbe,
+	0xc4, 0x05, 0x48, 0x95, 0x5a, 0x77, 0x96, 0xb7, 0x96, 0xdc, 0x05, 0x3b, 0xf0, 0x76, 0xc3, 0x38,
+	0x9b, 0x2d, 0x6c, 0x78, 0x16, 0xe4, 0x0d, 0x6c, 0x98, 0x3a, 0x5d, 0xb4, 0xe9, 0xa2, 0x17, 0x49,
+	0x26, 0x2a, 0xb9, 0x88, 0x46, 0x21, 0xd9, 0xba, 0x6d, 0x7e, 0x58, 0xc7, 0xaa, 0x69, 0xa0, 0x31,
+	0x31, 0xae, 0x8c, 0xbc, 0x64, 0x10, 0x88, 0x61, 0xfb, 0xaa, 0xa5, 0x6d, 0x61, 0x0b, 0xbd, 0x24,
+	0x42, 0x0c, 0xdb, 0x5f, 0x26, 0x54, 0xf8, 0x3a, 0xe8, 0xae, 0x60, 0x83, 0x5e, 0x32, 0x7f, 0x42,
+	0x03, 0x8b, 0xc4, 0x9a, 0x89, 0x0d, 0xe1, 0xba, 0x8d, 0x8b, 0x6e, 0xba, 0x82, 0x0d, 0x72, 0xdb,
+	0xcc, 0x83, 0x41, 0xdd, 0xa9, 0xb9, 0x5a, 0x60, 0xf2, 0x74, 0x68, 0x60, 0x8f, 0x1e, 0xca, 0xf1,
+	0x92, 0x34, 0x56, 0x98, 0x2f, 0xf0, 0xf0, 0xf3, 0xc3, 0x33, 0x90, 0xc0, 0x6e, 0x32, 0x28, 0xfc,
+	0x53, 0xd0, 0xdf, 0x60, 0x4d, 0x99, 0x2a, 0x5e, 0xc4, 0x13, 0x47, 0x5d, 0xc4, 0x7d, 0x09, 0xc5,
+	0xd4, 0xa5, 0xbe, 0x46, 0xa2, 0xb3, 0x63, 0x9d, 0x4c, 0x0e, 0xdb, 0xda, 0x96, 0x85, 0x55, 0xd3,
+	0x6d, 0x4c, 0xa3, 0x49, 0x1a, 0x42, 0xc0, 0x48, 0x4b, 0x6e, 0x63, 0x1a, 0x3e, 0x0f, 0xd2, 0xce,
+	0xd6, 0x07, 0x24, 0x7c, 0x97, 0x58, 0xbb, 0x96, 0xf4, 0xb7, 0xcb, 0xd9, 0xfa, 0x60, 0xc9, 0x80,
+	0x0b, 0x20, 0x27, 0xbc, 0x3f, 0xd0, 0xcb, 0x74, 0x97, 0xcf, 0xb5, 0xd9, 0xe5, 0xb9, 0x18, 0x45,
+	0xb7, 0x57, 0x11, 0xe5, 0xe0, 0x45, 0x90, 0x33, 0xb6, 0xd4, 0x9a, 0x63, 0x60, 0x8b, 0x58, 0x9c,
+	0x2e, 0x49, 0x63, 0x5d, 0xcd, 0x16, 0xb3, 0xc6, 0xd6, 0x4d, 0x02, 0x58, 0x32, 0xe0, 0xbb, 0x60,
+	0x60, 0xa7, 0xbe, 0x85, 0x3d, 0x1b, 0x07, 0xd8, 0x57, 0xa3, 0x77, 0x0a, 0xba, 0x42, 0xe3, 0x32,
+	0x2a, 0x98, 0xbf, 0x11, 0xc1, 0x94, 0x10, 0xa5, 0xf4, 0xef, 0xb4, 0x12, 0xe1, 0x55, 0xd0, 0x63,
+	0x3b, 0x06, 0x16, 0x94, 0xbd, 0xda, 0xb2, 0xe3, 0x2b, 0x8e, 0x81, 0x63, 0x35, 0x05, 0x5b, 0xfc,
+	0x84, 0xe7, 0x40, 0xc1, 0xf4, 0x49, 0xa5, 0xb1, 0x0d, 0xcd, 0x22, 0x07, 0xff, 0x35, 0x1a, 0xd2,
+	0xbc, 0xe9, 0xaf, 0x45, 0x34, 0x38, 0x4c, 0x6a, 0xb3, 0x6b, 0x99, 0xba, 0xe6, 0xa3, 0x19, 0xb2,
+	0x48, 0x25, 0xfa, 0x86, 0x27, 0x41, 0xb7, 0xe1, 0xed, 0xaa, 0x5e, 0xdd, 0x46, 0xaf, 0x53, 0xd1,
+	0xb4, 0xe1, 0xed, 0x2a, 0x75, 0x1b, 0x4e, 0x82, 0x54, 0xa0, 0x55, 0x7d, 0x64, 0xd0, 0xe0, 0x8e,
+	0xb4, 0x09, 0xee, 0xba, 0x56, 0xe5, 0x51, 0xa5, 0xc8, 0xe1, 0x05, 0x70, 0xf2, 0x90, 0x53, 0x05,
+	0x8b, 0xac, 0x75, 0xa4, 0x0d, 0x2c, 0xeb, 0x0e, 0x07, 0x40, 0x57, 0x43, 0xb3, 0xea, 0x98, 0xf5,
+	0xaa, 0x0a, 0xfb, 0x98, 0xe9, 0x78, 0x55, 0x1a, 0x7e, 0x03, 0x14, 0x9b, 0xb7, 0xed, 0x58, 0xf2,
+	0x57, 0x40, 0x36, 0xf2, 0xec, 0x38, 0x82, 0x33, 0xff, 0x9c, 0x26, 0x2d, 0xf1, 0xcf, 0x8f, 0x91,
+	0xf4, 0x97, 0x7b, 0x48, 0xfa, 0x74, 0x0f, 0x49, 0xff, 0xb0, 0x87, 0xa4, 0xaf, 0xc8, 0x09, 0xdb,
+	0x43, 0xd2, 0x77, 0x24, 0x2d, 0xf6, 0xd1, 0x57, 0x1d, 0xd7, 0xe2, 0x9e, 0x4c, 0xde, 0xf0, 0x4c,
+	0x79, 0x2d, 0x6c, 0xb2, 0xe4, 0x9b, 0x71, 0xdf, 0x23, 0x87, 0x2d, 0x95, 0x7c, 0x2d, 0xbc, 0x72,
+	0x65, 0x85, 0x5f, 0x82, 0xf2, 0x02, 0x6d, 0x2e, 0x64, 0x25, 0xbe, 0xe9, 0xe5, 0x4d, 0x5e, 0x77,
+	0xe5, 0x85, 0x96, 0x02, 0x2f, 0xcf, 0x35, 0x95, 0x6f, 0x6a, 0x11, 0xcb, 0x1b, 0x61, 0xc5, 0x94,
+	0x57, 0x69, 0x4d, 0x96, 0xd7, 0xb6, 0x35, 0x0f, 0x1b, 0xa2, 0x60, 0xeb, 0x3d, 0x2d, 0xb7, 0xee,
+	0x90, 0xbc, 0xc1, 0x6b, 0x93, 0x7c, 0x9d, 0x57, 0x20, 0x79, 0x91, 0xd6, 0x12, 0x99, 0x35, 0xe9,
+	0xe3, 0xab, 0xc2, 0xb3, 0x41, 0xbe, 0xd6, 0xa6, 0x60, 0xc8, 0x9b, 0xcd, 0x07, 0x5d, 0x16, 0x9a,
+	0xea, 0xcf, 0xf7, 0xd1, 0xdf, 0x74, 0xf2, 0x67, 0x08, 0xa9, 0xe5, 0xb3, 0x44, 0x27, 0xa9, 0xdb,
+	0x72, 0xfc, 0x36, 0x99, 0x6d, 0xb1, 0xa3, 0xb9, 0x2e, 0x05, 0x73, 0x1f, 0x42, 0x3c, 0xa9, 0x66,
+	0x21, 0x2d, 0xb4, 0xae, 0xb9, 0x2e, 0x51, 0xd1, 0xce, 0x5b, 0x72, 0x17, 0xce, 0xf2, 0xbe, 0x9c,
+	0xe9, 0x20, 0x14, 0x82, 0x0e, 0x89, 0x2d, 0xf0, 0x0a, 0x36, 0x44, 0xfe, 0x22, 0x36, 0xb0, 0x47,
+	0xe2, 0x9c, 0x00, 0x86, 0x9d, 0xe7, 0xac, 0xb0, 0x4e, 0xa6, 0x3f, 0xe4, 0x10, 0x1d, 0x22, 0x33,
+	0x21, 0x5e, 0x09, 0x95, 0x36, 0xa3, 0x0e, 0xb3, 0x46, 0xe3, 0x3a, 0x1b, 0xc7, 0x37, 0xb4, 0x15,
+	0xbe, 0xe6, 0x45, 0x56, 0xd2, 0x12, 0xcd, 0xaa, 0x59, 0x96, 0x5c, 0x54, 0xea, 0xfb, 0x7d, 0xd4,
+	0xcd, 0x17, 0x77, 0xef, 0x00, 0x5d, 0xdc, 0xc1, 0xbb, 0xb3, 0x09, 0x89, 0x86, 0x66, 0x1d, 0xea,
+	0xf8, 0x17, 0xbf, 0x20, 0xe9, 0x9d, 0x54, 0x66, 0xa4, 0x78, 0xfa, 0x9d, 0x54, 0x66, 0xb4, 0x78,
+	0x46, 0x81, 0x3e, 0xcd, 0x39, 0xb1, 0x5f, 0x51, 0x7a, 0x5c, 0xcf, 0x6c, 0x68, 0xfa, 0xae, 0xca,
+	0xc6, 0x31, 0xe5, 0x3f, 0x07, 0x3d, 0xc9, 0x4e, 0x17, 0xbe, 0x04, 0x0a, 0xba, 0x63, 0x07, 0x9a,
+	0x69, 0x93, 0xc6, 0x33, 0x7c, 0x67, 0xf2, 0x7b, 0x30, 0x1f, 0xb1, 0x96, 0x0c, 0x1f, 0x96, 0x84,
+	0xd2, 0xd5, 0x41, 0xeb, 0x33, 0xbf, 0x2d, 0x43, 0x6a, 0xf9, 0x93, 0x4e, 0x90, 0x09, 0x1f, 0x21,
+	0x70, 0x1a, 0x74, 0xd1, 0x1a, 0x45, 0x0f, 0x7c, 0xcf, 0x54, 0xe9, 0x88, 0x47, 0xd6, 0x2d, 0x82,
+	0x53, 0x18, 0x9c, 0x96, 0x51, 0xb1, 0x83, 0x60, 0xb6, 0x94, 0xbc, 0xd8, 0x06, 0x90, 0xcb, 0xcb,
+	0xad, 0x6f, 0x59, 0xa6, 0xce, 0x20, 0x9d, 0x14, 0x02, 0x18, 0x29, 0x02, 0x68, 0xc1, 0xb6, 0xea,
+	0x7a, 0xb8, 0x62, 0xde, 0x61, 0x2f, 0x35, 0x05, 0x10, 0xd2, 0x2d, 0x4a, 0x21, 0x80, 0xca, 0x87,
+	0x86, 0x1d, 0x02, 0xba, 0x18, 0x80, 0x90, 0x38, 0xe0, 0x14, 0xc8, 0x60, 0x9b, 0x3d, 0xb6, 0xe8,
+	0x33, 0xad, 0x4b, 0xe9, 0xc6, 0x36, 0xad, 0x28, 0xa4, 0x92, 0x05, 0x96, 0x8f, 0xba, 0x69, 0x91,
+	0x26, 0x3f, 0x49, 0x25, 0x23, 0x6b, 0xb9, 0x83, 0x32, 0x94, 0xc6, 0x3e, 0x60, 0x89, 0x3c, 0xd9,
+	0xee, 0xa8, 0xee, 0x4e, 0xc0, 0xda, 0xc7, 0x6c, 0x49, 0x1a, 0xeb, 0x54, 0x40, 0x4d, 0xbb, 0x73,
+	0x6b, 0x27, 0xa0, 0x0d, 0xe3, 0x05, 0xd0, 0x17, 0x2d, 0xb6, 0x61, 0xfa, 0xaa, 0x63, 0x5b, 0xbb,
+	0x08, 0x50, 0x1d, 0xbd, 0x21, 0x63, 0xd3, 0xf4, 0x57, 0x6d, 0x6b, 0x17, 0xf6, 0x80, 0x0e, 0xd3,
+	0x40, 0x39, 0xea, 0x68, 0x87, 0x49, 0xda, 0x97, 0xbc, 0x8f, 0xbd, 0x86, 0xa9, 0x63, 0xd6, 0x97,
+	0xe5, 0x29, 0x27, 0xc7, 0x69, 0x24, 0xbf, 0xca, 0x0f, 0x53, 0x20, 0xc7, 0x37, 0xfc, 0xa6, 0xd3,
+	0xc0, 0xe1, 0xd8, 0x40, 0x7a, 0xc2, 0xb1, 0xc1, 0x9b, 0xa0, 0x37, 0xd0, 0xbc, 0x2a, 0x0e, 0xd4,
+	0xe8, 0xa9, 0xdd, 0xf1, 0x2b, 0x4f, 0xed, 0x02, 0x13, 0xe0, 0x44, 0xb8, 0x0c, 0xfa, 0xb9, 0x86,
+	0x63, 0x8c, 0x67, 0x98, 0xa6, 0x3e, 0x26, 0x28, 0x30, 0xe0, 0x12, 0x80, 0x91, 0xb6, 0xb8, 0x17,
+	0x4a, 0x1d, 0xd5, 0x0b, 0x31, 0x5d, 0xc5, 0x50, 0x57, 0x48, 0x87, 0x6f, 0x80, 0x01, 0xf1, 0xa5,
+	0xa1, 0x92, 0xc3, 0xe0, 0xd4, 0xd9, 0x4e, 0x77, 0xce, 0xe7, 0x7f, 0xfb, 0xc3, 0x99, 0xcc, 0xf5,
+	0xba, 0x47, 0x4f, 0x9a, 0x02, 0x85, 0x27, 0xc5, 0x3a, 0xc3, 0xcd, 0x7c, 0xd4, 0xf1, 0xf9, 0x3e,
+	0xfa, 0x9d, 0x74, 0xec, 0x5a, 0x4a, 0xab, 0xe0, 0xba, 0x18, 0xa3, 0x64, 0x2d, 0x4c, 0xb2, 0xda,
+	0x17, 0xba, 0xf5, 0xe6, 0xc0, 0xb4, 0x96, 0xbb, 0x56, 0x48, 0xdb, 0x2a, 0xb6, 0xde, 0x14, 0x96,
+	0x96, 0x5a, 0xd6, 0x02, 0x10, 0xd5, 0xbc, 0x93, 0xca, 0x74, 0x15, 0xd3, 0xe5, 0xff, 0x8a, 0x93,
+	0x8c, 0xbe, 0x94, 0xff, 0x48, 0x33, 0xab, 0x17, 0x40, 0xd6, 0x76, 0x02, 0xb3, 0xb2, 0x4b, 0xfa,
+	0xc1, 0x4e, 0xba, 0x2d, 0xe2, 0x33, 0x96, 0xf1, 0x96, 0x0c, 0x78, 0x31, 0x1c, 0x35, 0xa4, 0x8e,
+	0x1c, 0x35, 0x84, 0x43, 0x86, 0xa1, 0x68, 0xc8, 0xd0, 0xc5, 0xbc, 0x63, 0x5f, 0x2d, 0x33, 0x82,
+	0xf4, 0x53, 0xcc, 0x08, 0xae, 0x80, 0x34, 0x31, 0x52, 0x67, 0xa5, 0x21, 0xb9, 0xc8, 0x35, 0xca,
+	0x20, 0x30, 0xf1, 0xa5, 0xc0, 0xe0, 0xcd, 0xef, 0xd4, 0xcc, 0x93, 0xbe, 0x53, 0xf9, 0x1c, 0x2a,
+	0xdb, 0x3c, 0x87, 0x12, 0x9e, 0x2d, 0xe0, 0xd8, 0xcf, 0x96, 0x69, 0x90, 0xad, 0x44, 0x53, 0xa6,
+	0xdc, 0xe1, 0x53, 0x26, 0x16, 0x80, 0x4c, 0x85, 0xb7, 0x59, 0x33, 0x57, 0x9b, 0x5b, 0xb6, 0x2f,
+	0xf6, 0x90, 0x74, 0x7f, 0x0f, 0xe5, 0xc5, 0x6d, 0xf8, 0x71, 0x0f, 0x49, 0xf7, 0x0e, 0x50, 0xca,
+	0x76, 0x6c, 0xfc, 0xf3, 0x01, 0x92, 0xee, 0xfd, 0x82, 0xc2, 0x61, 0x67, 0x79, 0x3c, 0xba, 0x9d,
+	0x6e, 0xe2, 0xc0, 0x33, 0x75, 0x1f, 0x8e, 0x80, 0xac, 0xef, 0xd4, 0x70, 0xb0, 0x6d, 0xda, 0x55,
+	0x5a, 0xa2, 0x53, 0x4a, 0x4c, 0x28, 0x7f, 0x2c, 0x81, 0x02, 0x17, 0x58, 0x76, 0x9c, 0x9d, 0xba,
+	0x7b, 0xdc, 0xfa, 0xf6, 0x1a, 0x00, 0xec, 0x62, 0x14, 0x4a, 0xdb, 0x40, 0x22, 0xea, 0x84, 0x19,
+	0x0b, 0x65, 0xdd, 0x90, 0x30, 0x53, 0xf8, 0xf6, 0x00, 0x65, 0x23, 0x7e, 0xf9, 0x6f, 0x25, 0xd0,
+	0x93, 0x70, 0x65, 0xea, 0xb8, 0xbe, 0x3c, 0xeb, 0x5f, 0x0d, 0x33, 0xbd, 0xdf, 0xd2, 0xf1, 0x6b,
+	0x44, 0x28, 0x3f, 0x16, 0x7c, 0xd2, 0x02, 0x6c, 0xeb, 0xbb, 0xc7, 0xf4, 0x69, 0xe6, 0x6b, 0xe9,
+	0xf3, 0x7d, 0xf4, 0x2f, 0xc7, 0x2f, 0x72, 0x51, 0xa5, 0x22, 0x9c, 0x23, 0xeb, 0x54, 0x33, 0xa0,
+	0xad, 0x1a, 0xde, 0x06, 0x36, 0x63, 0xdb, 0x36, 0x68, 0x64, 0x27, 0x0a, 0x89, 0x0c, 0x87, 0x57,
+	0x41, 0x2f, 0x6f, 0xf2, 0x4c, 0xc7, 0x56, 0x85, 0xe9, 0xfd, 0xd0, 0xfd, 0x03, 0xd4, 0x13, 0xb3,
+	0x08, 0x87, 0xfe, 0x15, 0x23, 0xd0, 0xe8, 0x4c, 0xe3, 0x12, 0xc8, 0x91, 0xe9, 0x39, 0xfd, 0xdf,
+	0xc4, 0x34, 0xf8, 0x44, 0xbf, 0x4f, 0xf8, 0xf3, 0xc2, 0x34, 0xa8, 0x5c, 0x56, 0x63, 0x46, 0x97,
+	0x8c, 0xa6, 0x89, 0xfe, 0xdf, 0x4b, 0x00, 0xc4, 0x3e, 0xc1, 0x49, 0x71, 0x17, 0x0e, 0x3f, 0x99,
+	0x42, 0x72, 0xcc, 0x82, 0x7c, 0xe4, 0xc1, 0x13, 0xd6, 0x50, 0xa0, 0x45, 0x94, 0x19, 0x24, 0x9e,
+	0x4c, 0xf1, 0xf4, 0x91, 0x3f, 0xcf, 0x7a, 0x63, 0xab, 0x0b, 0x0d, 0x6c, 0x3f, 0x8d, 0x7b, 0x51,
+	0x09, 0xee, 0x78, 0xa2, 0x12, 0x8c, 0x40, 0x77, 0x0d, 0xfb, 0xbe, 0x56, 0xc5, 0xec, 0xff, 0x30,
+	0x25, 0xfc, 0x84, 0x13, 0xa0, 0x8b, 0x95, 0x9d, 0xd4, 0xaf, 0x95, 0x1d, 0x86, 0x83, 0xcf, 0x89,
+	0x53, 0x1e, 0xd6, 0xc3, 0x45, 0xf3, 0x9d, 0x99, 0xd4, 0xbf, 0xef, 0x21, 0xe9, 0xc2, 0x27, 0x1d,
+	0x00, 0xc4, 0xe5, 0x13, 0x9e, 0x06, 0xfd, 0xb7, 0x56, 0xdf, 0x5b, 0x50, 0xd4, 0xb5, 0xf5, 0xb9,
+	0xf5, 0x05, 0x75, 0x63, 0xe5, 0xc6, 0xca, 0xea, 0x7b, 0x2b, 0xc5, 0x13, 0xc3, 0xa9, 0x4f, 0x0f,
+	0x90, 0x04, 0x47, 0x00, 0x64, 0xec, 0xd5, 0x15, 0x55, 0x59, 0x78, 0x77, 0x63, 0x61, 0x6d, 0x7d,
+	0xe1, 0x7a, 0x51, 0xe2, 0xdc, 0x41, 0x90, 0xa3, 0xdc, 0xa5, 0x95, 0xb7, 0xd4, 0xd5, 0x95, 0x62,
+	0x07, 0x27, 0xe7, 0x41, 0x26, 0x14, 0x2a, 0x76, 0xc6, 0x16, 0x56, 0x17, 0x17, 0x05, 0x1d, 0x29,
+	0x0e, 0x1e, 0x02, 0xf9, 0x58, 0xc7, 0xe2, 0x62, 0xb1, 0x8b, 0xd3, 0x0b, 0x20, 0x1b, 0x89, 0x15,
+	0xd3, 0x70, 0x18, 0x14, 0x95, 0x85, 0xf9, 0xd5, 0xd5, 0x75, 0x41, 0x45, 0x37, 0x87, 0xf6, 0x83,
+	0x2c, 0xe3, 0x2d, 0xad, 0xbc, 0x55, 0xcc, 0x70, 0x22, 0x00, 0x69, 0x46, 0x2c, 0x66, 0xe1, 0x73,
+	0xa0, 0x4f, 0x5c, 0xe4, 0x82, 0xa2, 0xac, 0x2a, 0x45, 0xc0, 0x80, 0x53, 0xbf, 0xcf, 0x46, 0xff,
+	0x68, 0xcd, 0xb9, 0x26, 0xfc, 0x37, 0x09, 0x14, 0xd8, 0xc3, 0x38, 0xcc, 0x4f, 0xd8, 0x9a, 0x57,
+	0xc3, 0xe2, 0x1f, 0x46, 0x0a, 0xfd, 0x73, 0xb9, 0xfc, 0x17, 0x8f, 0xf6, 0xd0, 0x78, 0x38, 0xfe,
+	0xe0, 0x38, 0x5f, 0x9e, 0xd3, 0xc9, 0xb9, 0xb9, 0xa9, 0xd9, 0x5a, 0x15, 0xcb, 0xcd, 0x47, 0xfa,
+	0xcb, 0x7d, 0x24, 0x3d, 0xd8, 0x47, 0xd2, 0xf7, 0xfb, 0xe8, 0xfc, 0x46, 0x62, 0x56, 0x2c, 0x2f,
+	0xc6, 0xb3, 0x66, 0x39, 0xde, 0xae, 0x8f, 0xfe, 0xe7, 0xff, 0xfe, 0xae, 0x63, 0x60, 0x46, 0xba,
+	0x50, 0xee, 0x9d, 0x60, 0x03, 0xf3, 0x09, 0x7e, 0xe6, 0x26, 0x25, 0xf8, 0x4f, 0x12, 0x28, 0x5c,
+	0xc7, 0x16, 0x3e, 0xb6, 0xe7, 0xe6, 0x33, 0x79, 0xde, 0x17, 0xbb, 0x27, 0x5f, 0xa7, 0x03, 0x1a,
+	0xd1, 0x4b, 0x83, 0x3a, 0x24, 0x78, 0xf9, 0x57, 0x1d, 0xa0, 0x47, 0xc1, 0x15, 0x0f, 0xfb, 0xdb,
+	0xc7, 0x74, 0xf3, 0x3f, 0xa5, 0xa7, 0xf3, 0xf3, 0xfb, 0x7d, 0xf4, 0x3e, 0x1f, 0x61, 0xb4, 0x1b,
+	0x3b, 0xb0, 0xc1, 0xbb, 0x2f, 0x44, 0x59, 0x16, 0xc6, 0xe8, 0xad, 0xa3, 0x8b, 0x68, 0x1e, 0xc2,
+	0x16, 0xfb, 0x68, 0x1f, 0x41, 0x56, 0x8a, 0xc5, 0x7f, 0x7e, 0x69, 0x08, 0x06, 0x49, 0x08, 0x8a,
+	0x13, 0x1e, 0x5b, 0xad, 0x10, 0x83, 0x8f, 0x3b, 0x40, 0x81, 0xed, 0xed, 0x31, 0x43, 0xf0, 0xdf,
+	0x4f, 0x1f, 0x82, 0xdd, 0x76, 0x6b, 0x3f, 0x22, 0xe9, 0x9e, 0x2c, 0x06, 0x6c, 0xb2, 0x21, 0xb7,
+	0x1f, 0xaf, 0xb4, 0x49, 0x07, 0x36, 0x4b, 0x17, 0x42, 0xf1, 0xd7, 0x12, 0xc8, 0xad, 0x6d, 0x3b,
+	0xb7, 0x8f, 0x0a, 0x44, 0x1b, 0x5a, 0x79, 0xf9, 0xd1, 0x1e, 0x92, 0x0f, 0x09, 0xc4, 0xa6, 0x89,
+	0x6f, 0xb7, 0x84, 0x81, 0x64, 0x2b, 0xf5, 0x04, 0x12, 0x4f, 0x0a, 0x13, 0xfe, 0xb6, 0x73, 0x5b,
+	0xf0, 0xe3, 0x13, 0x09, 0xe4, 0xc8, 0x9b, 0x30, 0xfa, 0xa7, 0xb9, 0xd5, 0x26, 0x61, 0xb7, 0xdb,
+	0x14, 0xe5, 0xe9, 0x8f, 0x8f, 0xe8, 0x50, 0xcd, 0x69, 0x88, 0x81, 0xd9, 0x06, 0x83, 0x6f, 0x6b,
+	0xb6, 0x61, 0xe1, 0xe6, 0xfb, 0x68, 0xb8, 0xed, 0x15, 0x44, 0x79, 0xed, 0xbc, 0x2b, 0x51, 0x1b,
+	0xc3, 0xc4, 0xc6, 0xe0, 0x04, 0xb9, 0xc9, 0x09, 0x30, 0xb4, 0x43, 0x5a, 0xfb, 0x29, 0x3f, 0xea,
+	0x8b, 0x48, 0x3b, 0x4e, 0x6a, 0xa0, 0x06, 0x7a, 0x85, 0x3d, 0x61, 0xaf, 0x98, 0xd6, 0x78, 0x10,
+	0xfa, 0xf0, 0x21, 0xf4, 0xf2, 0x08, 0x35, 0x3b, 0x44, 0xcc, 0xf6, 0x25, 0x62, 0x4d, 0x4c, 0x4e,
+	0x4a, 0x53, 0x1f, 0x49, 0xa0, 0x2f, 0xd9, 0xdd, 0x12, 0xc3, 0x35, 0x00, 0x05, 0xc3, 0x9c, 0x01,
+	0xdb, 0xbc, 0x3a, 0x38, 0x6b, 0xf8, 0x70, 0x56, 0xf9, 0x0c, 0xf5, 0xe0, 0x14, 0xf1, 0x60, 0x20,
+	0xe1, 0x41, 0x8d, 0x01, 0x26, 0xa5, 0xa9, 0xaf, 0x63, 0x27, 0x78, 0x4b, 0x48, 0x9c, 0xf8, 0x47,
+	0x09, 0x0c, 0x2a, 0xf8, 0xc3, 0x3a, 0xf6, 0x83, 0x24, 0xb3, 0x9d, 0x23, 0x9c, 0xd5, 0x2e, 0xf2,
+	0xeb, 0xc7, 0xcf, 0x0b, 0xea, 0xf2, 0x08, 0x71, 0xf9, 0xe4, 0x84, 0xc7, 0x5c, 0x08, 0xbd, 0xb6,
+	0x98, 0xa1, 0xf9, 0x91, 0x6f, 0xfe, 0x77, 0xf4, 0xc4, 0x37, 0x0f, 0x47, 0xa5, 0x07, 0x0f, 0x47,
+	0xa5, 0x1f, 0x1f, 0x8e, 0x4a, 0x9f, 0xfe, 0x34, 0x7a, 0xe2, 0xc1, 0x4f, 0xa3, 0x27, 0xbe, 0xfb,
+	0x69, 0xf4, 0xc4, 0x56, 0x9a, 0x7a, 0x70, 0xf9, 0x0f, 0x03, 0x00, 0x27, 0xf9, 0xf0, 0x9d, 0x2a,
+	0x25, 0x00, 0x00,
 }
 
 func (this *VirtualClusterInstKeyV1) GoString() string {
@@ -1203,6 +1275,11 @@ type AppInstApiClient interface {
 	// Show Application Instances. Lists all the Application instances managed by the Edge Controller.
 	// Any fields specified will be used to filter results.
 	ShowAppInst(ctx context.Context, in *AppInst, opts ...grpc.CallOption) (AppInstApi_ShowAppInstClient, error)
+	// Move Application Instance. Creates a replacement instance on another
+	// cloudlet, waits for it to become healthy, then deletes the original
+	// instance. Clients connected to the original instance are notified
+	// via edge events to switch to the replacement.
+	MoveAppInst(ctx context.Context, in *AppInstMove, opts ...grpc.CallOption) (AppInstApi_MoveAppInstClient, error)
 	HandleFedAppInstEvent(ctx context.Context, in *FedAppInstEvent, opts ...grpc.CallOption) (*Result, error)
 }
 
@@ -1374,6 +1451,38 @@ func (x *appInstApiShowAppInstClient) Recv() (*AppInst, error) {
 	return m, nil
 }
 
+func (c *appInstApiClient) MoveAppInst(ctx context.Context, in *AppInstMove, opts ...grpc.CallOption) (AppInstApi_MoveAppInstClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AppInstApi_serviceDesc.Streams[5], "/edgeproto.AppInstApi/MoveAppInst", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &appInstApiMoveAppInstClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AppInstApi_MoveAppInstClient interface {
+	Recv() (*Result, error)
+	grpc.ClientStream
+}
+
+type appInstApiMoveAppInstClient struct {
+	grpc.ClientStream
+}
+
+func (x *appInstApiMoveAppInstClient) Recv() (*Result, error) {
+	m := new(Result)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *appInstApiClient) HandleFedAppInstEvent(ctx context.Context, in *FedAppInstEvent, opts ...grpc.CallOption) (*Result, error) {
 	out := new(Result)
 	err := c.cc.Invoke(ctx, "/edgeproto.AppInstApi/HandleFedAppInstEvent", in, out, opts...)
@@ -1398,6 +1507,11 @@ type AppInstApiServer interface {
 	// Show Application Instances. Lists all the Application instances managed by the Edge Controller.
 	// Any fields specified will be used to filter results.
 	ShowAppInst(*AppInst, AppInstApi_ShowAppInstServer) error
+	// Move Application Instance. Creates a replacement instance on another
+	// cloudlet, waits for it to become healthy, then deletes the original
+	// instance. Clients connected to the original instance are notified
+	// via edge events to switch to the replacement.
+	MoveAppInst(*AppInstMove, AppInstApi_MoveAppInstServer) error
 	HandleFedAppInstEvent(context.Context, *FedAppInstEvent) (*Result, error)
 }
 
@@ -1420,6 +1534,9 @@ func (*UnimplementedAppInstApiServer) UpdateAppInst(req *AppInst, srv AppInstApi
 func (*UnimplementedAppInstApiServer) ShowAppInst(req *AppInst, srv AppInstApi_ShowAppInstServer) error {
 	return status.Errorf(codes.Unimplemented, "method ShowAppInst not implemented")
 }
+func (*UnimplementedAppInstApiServer) MoveAppInst(req *AppInstMove, srv AppInstApi_MoveAppInstServer) error {
+	return status.Errorf(codes.Unimplemented, "method MoveAppInst not implemented")
+}
 func (*UnimplementedAppInstApiServer) HandleFedAppInstEvent(ctx context.Context, req *FedAppInstEvent) (*Result, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HandleFedAppInstEvent not implemented")
 }
@@ -1533,6 +1650,27 @@ func (x *appInstApiShowAppInstServer) Send(m *AppInst) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _AppInstApi_MoveAppInst_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AppInstMove)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AppInstApiServer).MoveAppInst(m, &appInstApiMoveAppInstServer{stream})
+}
+
+type AppInstApi_MoveAppInstServer interface {
+	Send(*Result) error
+	grpc.ServerStream
+}
+
+type appInstApiMoveAppInstServer struct {
+	grpc.ServerStream
+}
+
+func (x *appInstApiMoveAppInstServer) Send(m *Result) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _AppInstApi_HandleFedAppInstEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FedAppInstEvent)
 	if err := dec(in); err != nil {
@@ -1586,6 +1724,11 @@ var _AppInstApi_serviceDesc = grpc.ServiceDesc{
 			Handler:       _AppInstApi_ShowAppInst_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "MoveAppInst",
+			Handler:       _AppInstApi_MoveAppInst_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "appinst.proto",
 }
@@ -2084,6 +2227,25 @@ func (m *AppInst) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xa2
 		}
 	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.Replicas != 0 {
+		i = encodeVarintAppinst(dAtA, i, uint64(m.Replicas))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd0
+	}
 	if m.IsStandalone {
 		i--
 		if m.IsStandalone {
@@ -2579,6 +2741,11 @@ func (m *AppInstRuntime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Replicas != 0 {
+		i = encodeVarintAppinst(dAtA, i, uint64(m.Replicas))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.ContainerIds) > 0 {
 		for iNdEx := len(m.ContainerIds) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ContainerIds[iNdEx])
@@ -2697,6 +2864,74 @@ func (m *InstPort) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AppInstMove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppInstMove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppInstMove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HealthCheckTimeout != 0 {
+		i = encodeVarintAppinst(dAtA, i, uint64(m.HealthCheckTimeout))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.TargetClusterKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAppinst(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.TargetCloudletKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAppinst(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TargetZoneKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAppinst(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAppinst(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *AppInstInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3891,6 +4126,16 @@ func (m *AppInst) Matches(o *AppInst, fopts ...MatchOpt) bool {
 			return false
 		}
 	}
+	if !opts.Filter || o.Replicas != 0 {
+		if o.Replicas != m.Replicas {
+			return false
+		}
+	}
+	if !opts.Filter || o.DryRun != false {
+		if o.DryRun != m.DryRun {
+			return false
+		}
+	}
 	if !opts.Filter || o.Tags != nil {
 		if len(m.Tags) == 0 && len(o.Tags) > 0 || len(m.Tags) > 0 && len(o.Tags) == 0 {
 			return false
@@ -3948,6 +4193,7 @@ const AppInstFieldErrors = "15"
 const AppInstFieldCrmOverride = "16"
 const AppInstFieldRuntimeInfo = "17"
 const AppInstFieldRuntimeInfoContainerIds = "17.1"
+const AppInstFieldRuntimeInfoReplicas = "17.2"
 const AppInstFieldCreatedAt = "21"
 const AppInstFieldCreatedAtSeconds = "21.1"
 const AppInstFieldCreatedAtNanos = "21.2"
@@ -4080,6 +4326,8 @@ const AppInstFieldNodeResourcesGpusVendor = "56.7.3"
 const AppInstFieldNodeResourcesGpusMemory = "56.7.4"
 const AppInstFieldNodeResourcesGpusInUse = "56.7.5"
 const AppInstFieldIsStandalone = "57"
+const AppInstFieldReplicas = "58"
+const AppInstFieldDryRun = "59"
 const AppInstFieldTags = "100"
 const AppInstFieldTagsKey = "100.1"
 const AppInstFieldTagsValue = "100.2"
@@ -4115,6 +4363,7 @@ var AppInstAllFields = []string{
 	AppInstFieldErrors,
 	AppInstFieldCrmOverride,
 	AppInstFieldRuntimeInfoContainerIds,
+	AppInstFieldRuntimeInfoReplicas,
 	AppInstFieldCreatedAtSeconds,
 	AppInstFieldCreatedAtNanos,
 	AppInstFieldAutoClusterIpAccess,
@@ -4218,6 +4467,8 @@ var AppInstAllFields = []string{
 	AppInstFieldNodeResourcesGpusMemory,
 	AppInstFieldNodeResourcesGpusInUse,
 	AppInstFieldIsStandalone,
+	AppInstFieldReplicas,
+	AppInstFieldDryRun,
 	AppInstFieldTagsKey,
 	AppInstFieldTagsValue,
 }
@@ -4253,6 +4504,7 @@ var AppInstAllFieldsMap = NewFieldMap(map[string]struct{}{
 	AppInstFieldErrors:                                               struct{}{},
 	AppInstFieldCrmOverride:                                          struct{}{},
 	AppInstFieldRuntimeInfoContainerIds:                              struct{}{},
+	AppInstFieldRuntimeInfoReplicas:                                  struct{}{},
 	AppInstFieldCreatedAtSeconds:                                     struct{}{},
 	AppInstFieldCreatedAtNanos:                                       struct{}{},
 	AppInstFieldAutoClusterIpAccess:                                  struct{}{},
@@ -4356,6 +4608,8 @@ var AppInstAllFieldsMap = NewFieldMap(map[string]struct{}{
 	AppInstFieldNodeResourcesGpusMemory:                              struct{}{},
 	AppInstFieldNodeResourcesGpusInUse:                               struct{}{},
 	AppInstFieldIsStandalone:                                         struct{}{},
+	AppInstFieldReplicas:                                             struct{}{},
+	AppInstFieldDryRun:                                               struct{}{},
 	AppInstFieldTagsKey:                                              struct{}{},
 	AppInstFieldTagsValue:                                            struct{}{},
 })
@@ -4391,6 +4645,7 @@ var AppInstAllFieldsStringMap = map[string]string{
 	AppInstFieldErrors:                                               "Errors",
 	AppInstFieldCrmOverride:                                          "Crm Override",
 	AppInstFieldRuntimeInfoContainerIds:                              "Runtime Info Container Ids",
+	AppInstFieldRuntimeInfoReplicas:                                  "Runtime Info Replicas",
 	AppInstFieldCreatedAtSeconds:                                     "Created At Seconds",
 	AppInstFieldCreatedAtNanos:                                       "Created At Nanos",
 	AppInstFieldAutoClusterIpAccess:                                  "Auto Cluster Ip Access",
@@ -4494,6 +4749,8 @@ var AppInstAllFieldsStringMap = map[string]string{
 	AppInstFieldNodeResourcesGpusMemory:                              "Node Resources Gpus Memory",
 	AppInstFieldNodeResourcesGpusInUse:                               "Node Resources Gpus In Use",
 	AppInstFieldIsStandalone:                                         "Is Standalone",
+	AppInstFieldReplicas:                                             "Replicas",
+	AppInstFieldDryRun:                                               "Dry Run",
 	AppInstFieldTagsKey:                                              "Tags Key",
 	AppInstFieldTagsValue:                                            "Tags Value",
 }
@@ -4646,6 +4903,10 @@ func (m *AppInst) DiffFields(o *AppInst, fields *FieldMap) {
 			}
 		}
 	}
+	if m.RuntimeInfo.Replicas != o.RuntimeInfo.Replicas {
+		fields.Set(AppInstFieldRuntimeInfoReplicas)
+		fields.Set(AppInstFieldRuntimeInfo)
+	}
 	if m.CreatedAt.Seconds != o.CreatedAt.Seconds {
 		fields.Set(AppInstFieldCreatedAtSeconds)
 		fields.Set(AppInstFieldCreatedAt)
@@ -5341,6 +5602,12 @@ func (m *AppInst) DiffFields(o *AppInst, fields *FieldMap) {
 	if m.IsStandalone != o.IsStandalone {
 		fields.Set(AppInstFieldIsStandalone)
 	}
+	if m.Replicas != o.Replicas {
+		fields.Set(AppInstFieldReplicas)
+	}
+	if m.DryRun != o.DryRun {
+		fields.Set(AppInstFieldDryRun)
+	}
 	if m.Tags != nil && o.Tags != nil {
 		if len(m.Tags) != len(o.Tags) {
 			fields.Set(AppInstFieldTags)
@@ -5465,6 +5732,7 @@ var UpdateAppInstFieldsMap = NewFieldMap(map[string]struct{}{
 	AppInstFieldNodeResourcesGpusMemory:                              struct{}{},
 	AppInstFieldNodeResourcesGpusInUse:                               struct{}{},
 	AppInstFieldIsStandalone:                                         struct{}{},
+	AppInstFieldReplicas:                                             struct{}{},
 	AppInstFieldTags:                                                 struct{}{},
 	AppInstFieldTagsKey:                                              struct{}{},
 	AppInstFieldTagsValue:                                            struct{}{},
@@ -5949,6 +6217,12 @@ func (m *AppInst) CopyInFields(src *AppInst) int {
 				changed++
 			}
 		}
+		if fmap.Has("17.2") {
+			if m.RuntimeInfo.Replicas != src.RuntimeInfo.Replicas {
+				m.RuntimeInfo.Replicas = src.RuntimeInfo.Replicas
+				changed++
+			}
+		}
 	}
 	if fmap.HasOrHasChild("21") {
 		if fmap.Has("21.1") {
@@ -6668,6 +6942,18 @@ func (m *AppInst) CopyInFields(src *AppInst) int {
 			changed++
 		}
 	}
+	if fmap.Has("58") {
+		if m.Replicas != src.Replicas {
+			m.Replicas = src.Replicas
+			changed++
+		}
+	}
+	if fmap.Has("59") {
+		if m.DryRun != src.DryRun {
+			m.DryRun = src.DryRun
+			changed++
+		}
+	}
 	if fmap.HasOrHasChild("100") {
 		if src.Tags != nil {
 			if updateListAction == "add" {
@@ -6791,6 +7077,8 @@ func (m *AppInst) DeepCopyIn(src *AppInst) {
 		m.NodeResources = nil
 	}
 	m.IsStandalone = src.IsStandalone
+	m.Replicas = src.Replicas
+	m.DryRun = src.DryRun
 	if src.Tags != nil {
 		m.Tags = make(map[string]string)
 		for k, v := range src.Tags {
@@ -7681,6 +7969,10 @@ func (m *AppInstRuntime) CopyInFields(src *AppInstRuntime) int {
 		m.ContainerIds = nil
 		changed++
 	}
+	if m.Replicas != src.Replicas {
+		m.Replicas = src.Replicas
+		changed++
+	}
 	return changed
 }
 
@@ -7693,6 +7985,7 @@ func (m *AppInstRuntime) DeepCopyIn(src *AppInstRuntime) {
 	} else {
 		m.ContainerIds = nil
 	}
+	m.Replicas = src.Replicas
 }
 
 // Helper method to check that enums have valid values
@@ -7788,6 +8081,113 @@ func (m *InstPort) ValidateEnums() error {
 func (s *InstPort) ClearTagged(tags map[string]struct{}) {
 }
 
+func (m *AppInstMove) Clone() *AppInstMove {
+	cp := &AppInstMove{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *AppInstMove) CopyInFields(src *AppInstMove) int {
+	changed := 0
+	if m.Key.Name != src.Key.Name {
+		m.Key.Name = src.Key.Name
+		changed++
+	}
+	if m.Key.Organization != src.Key.Organization {
+		m.Key.Organization = src.Key.Organization
+		changed++
+	}
+	if m.TargetZoneKey.Organization != src.TargetZoneKey.Organization {
+		m.TargetZoneKey.Organization = src.TargetZoneKey.Organization
+		changed++
+	}
+	if m.TargetZoneKey.Name != src.TargetZoneKey.Name {
+		m.TargetZoneKey.Name = src.TargetZoneKey.Name
+		changed++
+	}
+	if m.TargetZoneKey.FederatedOrganization != src.TargetZoneKey.FederatedOrganization {
+		m.TargetZoneKey.FederatedOrganization = src.TargetZoneKey.FederatedOrganization
+		changed++
+	}
+	if m.TargetCloudletKey.Organization != src.TargetCloudletKey.Organization {
+		m.TargetCloudletKey.Organization = src.TargetCloudletKey.Organization
+		changed++
+	}
+	if m.TargetCloudletKey.Name != src.TargetCloudletKey.Name {
+		m.TargetCloudletKey.Name = src.TargetCloudletKey.Name
+		changed++
+	}
+	if m.TargetCloudletKey.FederatedOrganization != src.TargetCloudletKey.FederatedOrganization {
+		m.TargetCloudletKey.FederatedOrganization = src.TargetCloudletKey.FederatedOrganization
+		changed++
+	}
+	if m.TargetClusterKey.Name != src.TargetClusterKey.Name {
+		m.TargetClusterKey.Name = src.TargetClusterKey.Name
+		changed++
+	}
+	if m.TargetClusterKey.Organization != src.TargetClusterKey.Organization {
+		m.TargetClusterKey.Organization = src.TargetClusterKey.Organization
+		changed++
+	}
+	if m.HealthCheckTimeout != src.HealthCheckTimeout {
+		m.HealthCheckTimeout = src.HealthCheckTimeout
+		changed++
+	}
+	return changed
+}
+
+func (m *AppInstMove) DeepCopyIn(src *AppInstMove) {
+	m.Key.DeepCopyIn(&src.Key)
+	m.TargetZoneKey.DeepCopyIn(&src.TargetZoneKey)
+	m.TargetCloudletKey.DeepCopyIn(&src.TargetCloudletKey)
+	m.TargetClusterKey.DeepCopyIn(&src.TargetClusterKey)
+	m.HealthCheckTimeout = src.HealthCheckTimeout
+}
+
+func (m *AppInstMove) GetObjKey() objstore.ObjKey {
+	return m.GetKey()
+}
+
+func (m *AppInstMove) GetKey() *AppInstKey {
+	return &m.Key
+}
+
+func (m *AppInstMove) GetKeyVal() AppInstKey {
+	return m.Key
+}
+
+func (m *AppInstMove) SetKey(key *AppInstKey) {
+	m.Key = *key
+}
+
+func CmpSortAppInstMove(a AppInstMove, b AppInstMove) bool {
+	return a.Key.GetKeyString() < b.Key.GetKeyString()
+}
+
+// Helper method to check that enums have valid values
+func (m *AppInstMove) ValidateEnums() error {
+	if err := m.Key.ValidateEnums(); err != nil {
+		return err
+	}
+	if err := m.TargetZoneKey.ValidateEnums(); err != nil {
+		return err
+	}
+	if err := m.TargetCloudletKey.ValidateEnums(); err != nil {
+		return err
+	}
+	if err := m.TargetClusterKey.ValidateEnums(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *AppInstMove) ClearTagged(tags map[string]struct{}) {
+	s.Key.ClearTagged(tags)
+	s.TargetZoneKey.ClearTagged(tags)
+	s.TargetCloudletKey.ClearTagged(tags)
+	s.TargetClusterKey.ClearTagged(tags)
+}
+
 func (m *AppInstInfo) Matches(o *AppInstInfo, fopts ...MatchOpt) bool {
 	opts := MatchOptions{}
 	applyMatchOptions(&opts, fopts...)
@@ -7870,6 +8270,7 @@ const AppInstInfoFieldState = "4"
 const AppInstInfoFieldErrors = "5"
 const AppInstInfoFieldRuntimeInfo = "6"
 const AppInstInfoFieldRuntimeInfoContainerIds = "6.1"
+const AppInstInfoFieldRuntimeInfoReplicas = "6.2"
 const AppInstInfoFieldStatus = "7"
 const AppInstInfoFieldStatusTaskNumber = "7.1"
 const AppInstInfoFieldStatusMaxTasks = "7.2"
@@ -7903,6 +8304,7 @@ var AppInstInfoAllFields = []string{
 	AppInstInfoFieldState,
 	AppInstInfoFieldErrors,
 	AppInstInfoFieldRuntimeInfoContainerIds,
+	AppInstInfoFieldRuntimeInfoReplicas,
 	AppInstInfoFieldStatusTaskNumber,
 	AppInstInfoFieldStatusMaxTasks,
 	AppInstInfoFieldStatusTaskName,
@@ -7934,6 +8336,7 @@ var AppInstInfoAllFieldsMap = NewFieldMap(map[string]struct{}{
 	AppInstInfoFieldState:                   struct{}{},
 	AppInstInfoFieldErrors:                  struct{}{},
 	AppInstInfoFieldRuntimeInfoContainerIds: struct{}{},
+	AppInstInfoFieldRuntimeInfoReplicas:     struct{}{},
 	AppInstInfoFieldStatusTaskNumber:        struct{}{},
 	AppInstInfoFieldStatusMaxTasks:          struct{}{},
 	AppInstInfoFieldStatusTaskName:          struct{}{},
@@ -7965,6 +8368,7 @@ var AppInstInfoAllFieldsStringMap = map[string]string{
 	AppInstInfoFieldState:                   "State",
 	AppInstInfoFieldErrors:                  "Errors",
 	AppInstInfoFieldRuntimeInfoContainerIds: "Runtime Info Container Ids",
+	AppInstInfoFieldRuntimeInfoReplicas:     "Runtime Info Replicas",
 	AppInstInfoFieldStatusTaskNumber:        "Status Task Number",
 	AppInstInfoFieldStatusMaxTasks:          "Status Max Tasks",
 	AppInstInfoFieldStatusTaskName:          "Status Task Name",
@@ -8030,6 +8434,10 @@ func (m *AppInstInfo) DiffFields(o *AppInstInfo, fields *FieldMap) {
 			}
 		}
 	}
+	if m.RuntimeInfo.Replicas != o.RuntimeInfo.Replicas {
+		fields.Set(AppInstInfoFieldRuntimeInfoReplicas)
+		fields.Set(AppInstInfoFieldRuntimeInfo)
+	}
 	if m.Status.TaskNumber != o.Status.TaskNumber {
 		fields.Set(AppInstInfoFieldStatusTaskNumber)
 		fields.Set(AppInstInfoFieldStatus)
@@ -8331,6 +8739,12 @@ func (m *AppInstInfo) CopyInFields(src *AppInstInfo) int {
 				changed++
 			}
 		}
+		if fmap.Has("6.2") {
+			if m.RuntimeInfo.Replicas != src.RuntimeInfo.Replicas {
+				m.RuntimeInfo.Replicas = src.RuntimeInfo.Replicas
+				changed++
+			}
+		}
 	}
 	if fmap.HasOrHasChild("7") {
 		if fmap.Has("7.1") {
@@ -10938,6 +11352,9 @@ func (m *AppInst) IsValidArgsForCreateAppInst() error {
 	if m.RuntimeInfo.ContainerIds != nil {
 		return fmt.Errorf("Invalid field specified: RuntimeInfo.ContainerIds, this field is only for internal use")
 	}
+	if m.RuntimeInfo.Replicas != 0 {
+		return fmt.Errorf("Invalid field specified: RuntimeInfo.Replicas, this field is only for internal use")
+	}
 	if m.CreatedAt.Seconds != 0 {
 		return fmt.Errorf("Invalid field specified: CreatedAt.Seconds, this field is only for internal use")
 	}
@@ -11059,6 +11476,9 @@ func (m *AppInst) IsValidArgsForDeleteAppInst() error {
 	if m.RuntimeInfo.ContainerIds != nil {
 		return fmt.Errorf("Invalid field specified: RuntimeInfo.ContainerIds, this field is only for internal use")
 	}
+	if m.RuntimeInfo.Replicas != 0 {
+		return fmt.Errorf("Invalid field specified: RuntimeInfo.Replicas, this field is only for internal use")
+	}
 	if m.CreatedAt.Seconds != 0 {
 		return fmt.Errorf("Invalid field specified: CreatedAt.Seconds, this field is only for internal use")
 	}
@@ -11128,6 +11548,9 @@ func (m *AppInst) IsValidArgsForDeleteAppInst() error {
 	if m.CloudletKey.FederatedOrganization != "" {
 		return fmt.Errorf("Invalid field specified: CloudletKey.FederatedOrganization, this field is only for internal use")
 	}
+	if m.DryRun != false {
+		return fmt.Errorf("Invalid field specified: DryRun, this field is only for internal use")
+	}
 	return nil
 }
 
@@ -11177,6 +11600,9 @@ func (m *AppInst) IsValidArgsForRefreshAppInst() error {
 	if m.RuntimeInfo.ContainerIds != nil {
 		return fmt.Errorf("Invalid field specified: RuntimeInfo.ContainerIds, this field is only for internal use")
 	}
+	if m.RuntimeInfo.Replicas != 0 {
+		return fmt.Errorf("Invalid field specified: RuntimeInfo.Replicas, this field is only for internal use")
+	}
 	if m.CreatedAt.Seconds != 0 {
 		return fmt.Errorf("Invalid field specified: CreatedAt.Seconds, this field is only for internal use")
 	}
@@ -11252,6 +11678,9 @@ func (m *AppInst) IsValidArgsForRefreshAppInst() error {
 	if m.CloudletKey.FederatedOrganization != "" {
 		return fmt.Errorf("Invalid field specified: CloudletKey.FederatedOrganization, this field is only for internal use")
 	}
+	if m.DryRun != false {
+		return fmt.Errorf("Invalid field specified: DryRun, this field is only for internal use")
+	}
 	return nil
 }
 
@@ -11298,6 +11727,9 @@ func (m *AppInst) IsValidArgsForUpdateAppInst() error {
 	if m.RuntimeInfo.ContainerIds != nil {
 		return fmt.Errorf("Invalid field specified: RuntimeInfo.ContainerIds, this field is only for internal use")
 	}
+	if m.RuntimeInfo.Replicas != 0 {
+		return fmt.Errorf("Invalid field specified: RuntimeInfo.Replicas, this field is only for internal use")
+	}
 	if m.CreatedAt.Seconds != 0 {
 		return fmt.Errorf("Invalid field specified: CreatedAt.Seconds, this field is only for internal use")
 	}
@@ -11388,6 +11820,13 @@ func (m *AppInst) IsValidArgsForUpdateAppInst() error {
 	if m.CloudletKey.FederatedOrganization != "" {
 		return fmt.Errorf("Invalid field specified: CloudletKey.FederatedOrganization, this field is only for internal use")
 	}
+	if m.DryRun != false {
+		return fmt.Errorf("Invalid field specified: DryRun, this field is only for internal use")
+	}
+	return nil
+}
+
+func (m *AppInstMove) IsValidArgsForMoveAppInst() error {
 	return nil
 }
 
@@ -11629,6 +12068,12 @@ func (m *AppInst) Size() (n int) {
 	if m.IsStandalone {
 		n += 3
 	}
+	if m.Replicas != 0 {
+		n += 2 + sovAppinst(uint64(m.Replicas))
+	}
+	if m.DryRun {
+		n += 3
+	}
 	if len(m.Tags) > 0 {
 		for k, v := range m.Tags {
 			_ = k
@@ -11652,6 +12097,9 @@ func (m *AppInstRuntime) Size() (n int) {
 			n += 1 + l + sovAppinst(uint64(l))
 		}
 	}
+	if m.Replicas != 0 {
+		n += 1 + sovAppinst(uint64(m.Replicas))
+	}
 	return n
 }
 
@@ -11704,6 +12152,26 @@ func (m *InstPort) Size() (n int) {
 	return n
 }
 
+func (m *AppInstMove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Key.Size()
+	n += 1 + l + sovAppinst(uint64(l))
+	l = m.TargetZoneKey.Size()
+	n += 1 + l + sovAppinst(uint64(l))
+	l = m.TargetCloudletKey.Size()
+	n += 1 + l + sovAppinst(uint64(l))
+	l = m.TargetClusterKey.Size()
+	n += 1 + l + sovAppinst(uint64(l))
+	if m.HealthCheckTimeout != 0 {
+		n += 1 + sovAppinst(uint64(m.HealthCheckTimeout))
+	}
+	return n
+}
+
 func (m *AppInstInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13896,6 +14364,45 @@ func (m *AppInst) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsStandalone = bool(v != 0)
+		case 58:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppinst
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 59:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppinst
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
 		case 100:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
@@ -14105,6 +14612,25 @@ func (m *AppInstRuntime) Unmarshal(dAtA []byte) error {
 			}
 			m.ContainerIds = append(m.ContainerIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppinst
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAppinst(dAtA[iNdEx:])
@@ -14459,6 +14985,207 @@ func (m *InstPort) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AppInstMove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAppinst
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppInstMove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppInstMove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppinst
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAppinst
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppinst
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetZoneKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppinst
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAppinst
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppinst
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetZoneKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetCloudletKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppinst
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAppinst
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppinst
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetCloudletKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetClusterKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppinst
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAppinst
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppinst
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetClusterKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthCheckTimeout", wireType)
+			}
+			m.HealthCheckTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppinst
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HealthCheckTimeout |= Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAppinst(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAppinst
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AppInstInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
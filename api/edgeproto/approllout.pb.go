@@ -0,0 +1,9479 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: approllout.proto
+
+package edgeproto
+
+import (
+	context "context"
+	"encoding/json"
+	"errors"
+	fmt "fmt"
+	distributed_match_engine "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/objstore"
+	"github.com/edgexr/edge-cloud-platform/pkg/util"
+	_ "github.com/edgexr/edge-cloud-platform/tools/protogen"
+	_ "github.com/gogo/googleapis/google/api"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"go.etcd.io/etcd/client/v3/concurrency"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	"sort"
+	"strconv"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AppRolloutState is the state of an App rollout
+type AppRolloutState int32
+
+const (
+	// Unknown state
+	AppRolloutState_APP_ROLLOUT_UNKNOWN AppRolloutState = 0
+	// App Instances are being updated
+	AppRolloutState_APP_ROLLOUT_IN_PROGRESS AppRolloutState = 1
+	// Paused between waves, waiting to be resumed
+	AppRolloutState_APP_ROLLOUT_PAUSED AppRolloutState = 2
+	// All App Instances were updated
+	AppRolloutState_APP_ROLLOUT_COMPLETED AppRolloutState = 3
+	// Rolling back to the previous App definition
+	AppRolloutState_APP_ROLLOUT_ROLLING_BACK AppRolloutState = 4
+	// Rolled back to the previous App definition
+	AppRolloutState_APP_ROLLOUT_ROLLED_BACK AppRolloutState = 5
+	// Rollback failed
+	AppRolloutState_APP_ROLLOUT_FAILED AppRolloutState = 6
+)
+
+var AppRolloutState_name = map[int32]string{
+	0: "APP_ROLLOUT_UNKNOWN",
+	1: "APP_ROLLOUT_IN_PROGRESS",
+	2: "APP_ROLLOUT_PAUSED",
+	3: "APP_ROLLOUT_COMPLETED",
+	4: "APP_ROLLOUT_ROLLING_BACK",
+	5: "APP_ROLLOUT_ROLLED_BACK",
+	6: "APP_ROLLOUT_FAILED",
+}
+
+var AppRolloutState_value = map[string]int32{
+	"APP_ROLLOUT_UNKNOWN":      0,
+	"APP_ROLLOUT_IN_PROGRESS":  1,
+	"APP_ROLLOUT_PAUSED":       2,
+	"APP_ROLLOUT_COMPLETED":    3,
+	"APP_ROLLOUT_ROLLING_BACK": 4,
+	"APP_ROLLOUT_ROLLED_BACK":  5,
+	"APP_ROLLOUT_FAILED":       6,
+}
+
+func (x AppRolloutState) String() string {
+	return proto.EnumName(AppRolloutState_name, int32(x))
+}
+
+func (AppRolloutState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e1f55e8f40c10d1f, []int{0}
+}
+
+// AppRolloutWave is a set of App Instances that are updated together
+type AppRolloutWave struct {
+	// App Instances updated in the wave
+	AppInsts []AppInstKey `protobuf:"bytes,1,rep,name=app_insts,json=appInsts,proto3" json:"app_insts"`
+	// Zone of the App Instances, for rollouts by zone
+	ZoneKey ZoneKey `protobuf:"bytes,2,opt,name=zone_key,json=zoneKey,proto3" json:"zone_key"`
+}
+
+func (m *AppRolloutWave) Reset()         { *m = AppRolloutWave{} }
+func (m *AppRolloutWave) String() string { return proto.CompactTextString(m) }
+func (*AppRolloutWave) ProtoMessage()    {}
+func (*AppRolloutWave) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1f55e8f40c10d1f, []int{0}
+}
+func (m *AppRolloutWave) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppRolloutWave) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppRolloutWave.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppRolloutWave) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppRolloutWave.Merge(m, src)
+}
+func (m *AppRolloutWave) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppRolloutWave) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppRolloutWave.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppRolloutWave proto.InternalMessageInfo
+
+// AppRollout updates the App Instances of an App to a new App definition
+// in waves. Health checks and alerts of the updated App Instances are
+// checked after each wave, and the rollout may be paused, or rolled back
+// to the previous App definition. A blue/green rollout is a single wave
+// of all App Instances, with the previous App definition kept for
+// rollback.
+type AppRollout struct {
+	// Fields are used for the Update API to specify which fields to apply
+	Fields []string `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	// App to roll out, only one rollout per App may be active at a time
+	Key AppKey `protobuf:"bytes,2,opt,name=key,proto3" json:"key"`
+	// New App definition, only the fields specified by its fields are updated, same as for UpdateApp
+	App *App `protobuf:"bytes,3,opt,name=app,proto3" json:"app,omitempty"`
+	// Cumulative percentages of App Instances updated by each wave, i.e. 10,50,100. Defaults to a single wave of all App Instances
+	WavePercentages []uint32 `protobuf:"varint,4,rep,packed,name=wave_percentages,json=wavePercentages,proto3" json:"wave_percentages,omitempty"`
+	// Update the App Instances one zone per wave, instead of by percentage
+	WavesByZone bool `protobuf:"varint,5,opt,name=waves_by_zone,json=wavesByZone,proto3" json:"waves_by_zone,omitempty"`
+	// Order of zones for waves by zone, remaining zones follow in order of name
+	Zones []ZoneKey `protobuf:"bytes,6,rep,name=zones,proto3" json:"zones"`
+	// Time to monitor health checks and alerts after each wave before continuing, defaults to 1m
+	WaveSoakTime Duration `protobuf:"varint,7,opt,name=wave_soak_time,json=waveSoakTime,proto3,casttype=Duration" json:"wave_soak_time,omitempty"`
+	// Pause after each wave until resumed
+	PauseBetweenWaves bool `protobuf:"varint,8,opt,name=pause_between_waves,json=pauseBetweenWaves,proto3" json:"pause_between_waves,omitempty"`
+	// Automatically roll back to the previous App definition if health checks fail or alerts fire
+	AutoRollback bool `protobuf:"varint,9,opt,name=auto_rollback,json=autoRollback,proto3" json:"auto_rollback,omitempty"`
+	// State of the rollout
+	State AppRolloutState `protobuf:"varint,10,opt,name=state,proto3,enum=edgeproto.AppRolloutState" json:"state,omitempty"`
+	// Number of waves completed
+	WavesDone int32 `protobuf:"varint,11,opt,name=waves_done,json=wavesDone,proto3" json:"waves_done,omitempty"`
+	// Waves of App Instances to update
+	Waves []AppRolloutWave `protobuf:"bytes,12,rep,name=waves,proto3" json:"waves"`
+	// App revision being rolled out
+	Revision string `protobuf:"bytes,13,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Previous App definition, restored on rollback
+	PreviousApp *App `protobuf:"bytes,14,opt,name=previous_app,json=previousApp,proto3" json:"previous_app,omitempty"`
+	// Any errors that paused or rolled back the rollout
+	Errors []string `protobuf:"bytes,15,rep,name=errors,proto3" json:"errors,omitempty"`
+	// Created at time
+	CreatedAt distributed_match_engine.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	// Updated at time
+	UpdatedAt distributed_match_engine.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+}
+
+func (m *AppRollout) Reset()         { *m = AppRollout{} }
+func (m *AppRollout) String() string { return proto.CompactTextString(m) }
+func (*AppRollout) ProtoMessage()    {}
+func (*AppRollout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1f55e8f40c10d1f, []int{1}
+}
+func (m *AppRollout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppRollout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppRollout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppRollout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppRollout.Merge(m, src)
+}
+func (m *AppRollout) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppRollout) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppRollout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppRollout proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("edgeproto.AppRolloutState", AppRolloutState_name, AppRolloutState_value)
+	proto.RegisterType((*AppRolloutWave)(nil), "edgeproto.AppRolloutWave")
+	proto.RegisterType((*AppRollout)(nil), "edgeproto.AppRollout")
+}
+
+func init() { proto.RegisterFile("approllout.proto", fileDescriptor_e1f55e8f40c10d1f) }
+
+var fileDescriptor_e1f55e8f40c10d1f = []byte{
+	// 1095 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xc1, 0x6b, 0x63, 0x45,
+	0x18, 0xcf, 0x6c, 0x9a, 0x6c, 0x32, 0x4d, 0xd2, 0xd7, 0xd9, 0xed, 0x76, 0x5a, 0x6b, 0xf6, 0x91,
+	0x5e, 0xb2, 0x25, 0x24, 0xd2, 0x05, 0xd1, 0xc5, 0x05, 0x93, 0x26, 0x96, 0xda, 0x36, 0x09, 0x2f,
+	0xed, 0x16, 0xbd, 0x3c, 0xa6, 0xc9, 0xf8, 0xfa, 0x48, 0xf2, 0x66, 0x78, 0xf3, 0xd2, 0x92, 0x5e,
+	0x14, 0x2f, 0xe2, 0x41, 0x10, 0xbd, 0x09, 0xde, 0x45, 0xf0, 0xb2, 0x7f, 0x45, 0x2f, 0xc2, 0x82,
+	0x17, 0x4f, 0x8b, 0xb6, 0x1e, 0x64, 0x41, 0x58, 0xd8, 0x6e, 0x41, 0x4f, 0x32, 0xf3, 0x5e, 0x9a,
+	0x34, 0x06, 0xc5, 0x0a, 0x9e, 0x32, 0xf3, 0xfb, 0xbe, 0xef, 0xf7, 0xfd, 0xe6, 0xf7, 0x4d, 0xe6,
+	0x41, 0x8d, 0x70, 0xee, 0xb2, 0x4e, 0x87, 0xf5, 0xbc, 0x3c, 0x77, 0x99, 0xc7, 0x50, 0x9c, 0xb6,
+	0x2c, 0xaa, 0x96, 0x8b, 0x4b, 0x16, 0x63, 0x56, 0x87, 0x16, 0x08, 0xb7, 0x0b, 0xc4, 0x71, 0x98,
+	0x47, 0x3c, 0x9b, 0x39, 0xc2, 0x4f, 0x5c, 0x4c, 0xb8, 0x54, 0xf4, 0x3a, 0x41, 0xd9, 0xe2, 0xab,
+	0x1e, 0x63, 0x1d, 0x51, 0x50, 0x1b, 0x8b, 0x3a, 0x97, 0x8b, 0x20, 0x7c, 0xdb, 0x62, 0x16, 0x53,
+	0xcb, 0x82, 0x5c, 0x05, 0x68, 0x9c, 0x70, 0x1e, 0x2c, 0x93, 0x84, 0x73, 0xdb, 0x11, 0x03, 0xba,
+	0xd9, 0x66, 0x87, 0xf5, 0x5a, 0x1d, 0xea, 0xb5, 0x69, 0x7f, 0x90, 0xd1, 0xea, 0xd2, 0x42, 0x87,
+	0x35, 0xfd, 0x6d, 0xe6, 0x43, 0x98, 0x2a, 0x72, 0x6e, 0xf8, 0xda, 0xf7, 0xc8, 0x21, 0x45, 0x6f,
+	0x40, 0xc9, 0x67, 0x4a, 0x16, 0x81, 0x81, 0x1e, 0xce, 0x4e, 0xaf, 0xce, 0xe5, 0x2f, 0x4f, 0x93,
+	0x2f, 0x72, 0xbe, 0xe1, 0x08, 0x6f, 0x93, 0xf6, 0x4b, 0x53, 0x27, 0x4f, 0xef, 0x86, 0x8c, 0x18,
+	0xf1, 0x11, 0x81, 0xee, 0xc3, 0xd8, 0x31, 0x73, 0xa8, 0xd9, 0xa6, 0x7d, 0x7c, 0x43, 0x07, 0xd9,
+	0xe9, 0x55, 0x34, 0x52, 0xf8, 0x3e, 0x73, 0xe8, 0xb0, 0xea, 0xe6, 0xb1, 0xbf, 0xcd, 0xfc, 0x7e,
+	0x13, 0xc2, 0xa1, 0x02, 0x74, 0x07, 0x46, 0x3f, 0xb0, 0x69, 0xa7, 0xe5, 0xb7, 0x8e, 0x1b, 0xc1,
+	0x0e, 0xdd, 0x83, 0xe1, 0x21, 0xed, 0xec, 0x55, 0x3d, 0x43, 0x56, 0x99, 0x83, 0x74, 0x18, 0x26,
+	0x9c, 0xe3, 0xb0, 0x4a, 0x4d, 0x5d, 0x4d, 0x35, 0x64, 0x08, 0xdd, 0x83, 0xda, 0x11, 0x39, 0xa4,
+	0x26, 0xa7, 0x6e, 0x93, 0x3a, 0x1e, 0xb1, 0xa8, 0xc0, 0x53, 0x7a, 0x38, 0x9b, 0x34, 0x66, 0x24,
+	0x5e, 0x1f, 0xc2, 0x28, 0x03, 0x93, 0x12, 0x12, 0xe6, 0x7e, 0xdf, 0x94, 0x92, 0x71, 0x44, 0x07,
+	0xd9, 0x98, 0x31, 0xad, 0xc0, 0x52, 0x5f, 0x1e, 0x0a, 0xe5, 0x61, 0x44, 0x86, 0x04, 0x8e, 0xea,
+	0xe1, 0xbf, 0x3d, 0xb4, 0x9f, 0x86, 0x56, 0x61, 0x4a, 0xb5, 0x17, 0x8c, 0xb4, 0x4d, 0xcf, 0xee,
+	0x52, 0x7c, 0x53, 0x07, 0xd9, 0x70, 0x29, 0xf1, 0xc7, 0xd3, 0xbb, 0xb1, 0x72, 0xcf, 0x55, 0xf7,
+	0xc3, 0x48, 0xc8, 0x9c, 0x06, 0x23, 0xed, 0x1d, 0xbb, 0x2b, 0x7b, 0xdc, 0xe2, 0xa4, 0x27, 0xa8,
+	0xb9, 0x4f, 0xbd, 0x23, 0x4a, 0x1d, 0x53, 0x09, 0xc0, 0x31, 0xa5, 0x66, 0x56, 0x85, 0x4a, 0x7e,
+	0x44, 0x0e, 0x51, 0xa0, 0x65, 0x98, 0x24, 0x3d, 0x8f, 0x99, 0xf2, 0x56, 0xee, 0x93, 0x66, 0x1b,
+	0xc7, 0x55, 0x66, 0x42, 0x82, 0x46, 0x80, 0xa1, 0xd7, 0x61, 0x44, 0x78, 0xc4, 0xa3, 0x18, 0xea,
+	0x20, 0x9b, 0x5a, 0x5d, 0x1c, 0xf3, 0xca, 0x1f, 0x49, 0x43, 0x66, 0x94, 0xa6, 0xbe, 0x39, 0xc7,
+	0xc0, 0xf0, 0xd3, 0xd1, 0x32, 0x84, 0xbe, 0x29, 0x2d, 0xe9, 0xc8, 0xb4, 0x0e, 0xb2, 0x91, 0x20,
+	0x21, 0xae, 0xf0, 0xb2, 0x74, 0xe5, 0x4d, 0x18, 0xf1, 0x35, 0x26, 0x94, 0x2b, 0x0b, 0x13, 0xc9,
+	0xa5, 0xd8, 0x52, 0x4c, 0x96, 0xfa, 0x06, 0xa9, 0x0a, 0xa4, 0xc3, 0x98, 0x4b, 0x0f, 0x6d, 0x61,
+	0x33, 0x07, 0x27, 0x75, 0x90, 0x8d, 0x07, 0xec, 0x97, 0x28, 0x7a, 0x1b, 0x26, 0xb8, 0xdc, 0xb0,
+	0x9e, 0x30, 0xe5, 0xb0, 0x53, 0x93, 0x86, 0x5d, 0x4a, 0xca, 0xaa, 0x2f, 0x1e, 0x2f, 0x44, 0x1c,
+	0xd6, 0xec, 0x72, 0x63, 0x7a, 0x50, 0x52, 0xe4, 0x1c, 0x2d, 0xc1, 0x28, 0x75, 0x5d, 0xe6, 0x0a,
+	0x3c, 0xa3, 0x87, 0x2f, 0x3b, 0x04, 0x18, 0x7a, 0x0f, 0xc2, 0xa6, 0x4b, 0x89, 0x47, 0x5b, 0x26,
+	0xf1, 0xb0, 0xa6, 0xd8, 0x97, 0xf3, 0x2d, 0x5b, 0x78, 0xae, 0xbd, 0xdf, 0x93, 0x70, 0x97, 0x78,
+	0xcd, 0x03, 0x93, 0x3a, 0x96, 0xed, 0xd0, 0xbc, 0x1c, 0x91, 0xf0, 0x48, 0x97, 0x97, 0xe6, 0x82,
+	0x96, 0x71, 0x6f, 0x00, 0xa9, 0x83, 0xc5, 0x03, 0xb6, 0xa2, 0x27, 0xa9, 0x7b, 0xbc, 0x35, 0xa0,
+	0x9e, 0xfd, 0xef, 0xd4, 0x01, 0x5b, 0xd1, 0x7b, 0xf0, 0x1d, 0xf8, 0xf5, 0x05, 0x06, 0xcf, 0x5f,
+	0x60, 0xf0, 0x91, 0x4c, 0x7d, 0x89, 0x2b, 0x6a, 0x78, 0xb9, 0xbd, 0xc1, 0x50, 0xfc, 0x55, 0xce,
+	0x08, 0x6c, 0xcc, 0xd5, 0x87, 0x86, 0xe4, 0x2a, 0xea, 0xe4, 0xb9, 0xb5, 0x81, 0xd0, 0xdc, 0xee,
+	0x80, 0xf7, 0xab, 0x97, 0xf8, 0x2d, 0xc2, 0xb9, 0x43, 0xba, 0xf4, 0xe1, 0x26, 0xed, 0xe7, 0xab,
+	0xa4, 0x4b, 0x73, 0x84, 0x73, 0xe6, 0x5a, 0x6a, 0x5f, 0x73, 0x2d, 0xe2, 0xd8, 0xc7, 0xea, 0xda,
+	0x4a, 0xfc, 0x90, 0xba, 0x42, 0x05, 0x1e, 0x51, 0x57, 0xb6, 0x79, 0x7c, 0x81, 0xb5, 0x36, 0xed,
+	0x3f, 0x1c, 0xcd, 0x7b, 0x7e, 0x81, 0xc1, 0xca, 0xf7, 0x00, 0xce, 0x8c, 0x5d, 0x34, 0x34, 0x0f,
+	0x6f, 0x15, 0xeb, 0x75, 0xd3, 0xa8, 0x6d, 0x6d, 0xd5, 0x76, 0x77, 0xcc, 0xdd, 0xea, 0x66, 0xb5,
+	0xb6, 0x57, 0xd5, 0x42, 0xe8, 0x15, 0x38, 0x3f, 0x1a, 0xd8, 0xa8, 0x9a, 0x75, 0xa3, 0xb6, 0x6e,
+	0x54, 0x1a, 0x0d, 0x0d, 0xa0, 0x3b, 0x10, 0x8d, 0x06, 0xeb, 0xc5, 0xdd, 0x46, 0xa5, 0xac, 0xdd,
+	0x40, 0x0b, 0x70, 0x6e, 0x14, 0x5f, 0xab, 0x6d, 0xd7, 0xb7, 0x2a, 0x3b, 0x95, 0xb2, 0x16, 0x46,
+	0x4b, 0x10, 0x8f, 0x86, 0xe4, 0xef, 0x46, 0x75, 0xdd, 0x2c, 0x15, 0xd7, 0x36, 0xb5, 0xa9, 0xf1,
+	0x6e, 0xf2, 0xb7, 0x52, 0xf6, 0x83, 0x91, 0xf1, 0x6e, 0xef, 0x14, 0x37, 0xb6, 0x2a, 0x65, 0x2d,
+	0xba, 0xfa, 0x5b, 0x14, 0x26, 0x87, 0xe7, 0x29, 0x72, 0x1b, 0x7d, 0x0d, 0xa0, 0xe6, 0x3b, 0x3a,
+	0xc4, 0xd1, 0xdc, 0xc4, 0xbf, 0xc2, 0xe2, 0xe8, 0xab, 0x66, 0xa8, 0x8f, 0x42, 0xc6, 0x7c, 0x76,
+	0x8e, 0x57, 0x0c, 0x2a, 0x58, 0xcf, 0x6d, 0x4a, 0x06, 0x91, 0x2b, 0x36, 0xa5, 0x7b, 0xdb, 0xc4,
+	0x21, 0x16, 0xcd, 0x8d, 0x9b, 0xff, 0xed, 0x05, 0xd6, 0xc6, 0xb1, 0x8f, 0x7f, 0xf8, 0xe5, 0xcb,
+	0x1b, 0xf3, 0x19, 0x54, 0xf0, 0x6f, 0x60, 0x61, 0xf8, 0xa5, 0x7a, 0x00, 0x56, 0xd0, 0xa7, 0x00,
+	0x6a, 0x65, 0xda, 0xa1, 0xd7, 0xd4, 0xb7, 0xf9, 0xef, 0xf4, 0x5d, 0x6a, 0x69, 0xa9, 0x96, 0x63,
+	0x5a, 0x3e, 0x01, 0x70, 0xa6, 0x2e, 0x1f, 0xb2, 0x6b, 0x49, 0x79, 0xf7, 0x1a, 0x52, 0xee, 0x64,
+	0x66, 0x0b, 0xea, 0xe9, 0x9c, 0xe0, 0x8a, 0xa4, 0xed, 0xfe, 0xbf, 0xae, 0xb8, 0xaa, 0xe5, 0x98,
+	0x96, 0xcf, 0x00, 0x44, 0x83, 0x07, 0xfb, 0x5a, 0x6a, 0xb6, 0xaf, 0xa1, 0x66, 0x21, 0x73, 0xbb,
+	0x30, 0xf8, 0x72, 0xfc, 0xd5, 0x9b, 0x54, 0xe3, 0x80, 0x1d, 0xfd, 0xb3, 0x96, 0xc9, 0x70, 0x66,
+	0xfd, 0xd9, 0x39, 0xce, 0x4e, 0xd0, 0xf3, 0xc8, 0xa6, 0x47, 0x93, 0xd5, 0xcc, 0x65, 0xb4, 0x82,
+	0x38, 0x60, 0x47, 0x57, 0x95, 0xbc, 0x06, 0x4a, 0x4b, 0x27, 0x3f, 0xa7, 0x43, 0x27, 0xa7, 0x69,
+	0xf0, 0xe4, 0x34, 0x0d, 0x7e, 0x3a, 0x4d, 0x83, 0xcf, 0xcf, 0xd2, 0xa1, 0x27, 0x67, 0xe9, 0xd0,
+	0x8f, 0x67, 0xe9, 0xd0, 0x7e, 0x54, 0xb5, 0xbe, 0xff, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xab,
+	0x9e, 0x9d, 0x66, 0x9d, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AppRolloutApiClient is the client API for AppRolloutApi service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AppRolloutApiClient interface {
+	// Start rolling out a new App definition to the App Instances of the App
+	CreateAppRollout(ctx context.Context, in *AppRollout, opts ...grpc.CallOption) (*Result, error)
+	// Delete an App rollout that is not in progress
+	DeleteAppRollout(ctx context.Context, in *AppRollout, opts ...grpc.CallOption) (*Result, error)
+	// Pause an App rollout after the current wave
+	PauseAppRollout(ctx context.Context, in *AppRollout, opts ...grpc.CallOption) (*Result, error)
+	// Resume a paused App rollout
+	ResumeAppRollout(ctx context.Context, in *AppRollout, opts ...grpc.CallOption) (*Result, error)
+	// Roll back an App rollout to the previous App definition
+	RollbackAppRollout(ctx context.Context, in *AppRollout, opts ...grpc.CallOption) (*Result, error)
+	// Show App rollouts
+	ShowAppRollout(ctx context.Context, in *AppRollout, opts ...grpc.CallOption) (AppRolloutApi_ShowAppRolloutClient, error)
+}
+
+type appRolloutApiClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAppRolloutApiClient(cc *grpc.ClientConn) AppRolloutApiClient {
+	return &appRolloutApiClient{cc}
+}
+
+func (c *appRolloutApiClient) CreateAppRollout(ctx context.Context, in *AppRollout, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/edgeproto.AppRolloutApi/CreateAppRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appRolloutApiClient) DeleteAppRollout(ctx context.Context, in *AppRollout, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/edgeproto.AppRolloutApi/DeleteAppRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appRolloutApiClient) PauseAppRollout(ctx context.Context, in *AppRollout, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/edgeproto.AppRolloutApi/PauseAppRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appRolloutApiClient) ResumeAppRollout(ctx context.Context, in *AppRollout, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/edgeproto.AppRolloutApi/ResumeAppRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appRolloutApiClient) RollbackAppRollout(ctx context.Context, in *AppRollout, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/edgeproto.AppRolloutApi/RollbackAppRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appRolloutApiClient) ShowAppRollout(ctx context.Context, in *AppRollout, opts ...grpc.CallOption) (AppRolloutApi_ShowAppRolloutClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AppRolloutApi_serviceDesc.Streams[0], "/edgeproto.AppRolloutApi/ShowAppRollout", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &appRolloutApiShowAppRolloutClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AppRolloutApi_ShowAppRolloutClient interface {
+	Recv() (*AppRollout, error)
+	grpc.ClientStream
+}
+
+type appRolloutApiShowAppRolloutClient struct {
+	grpc.ClientStream
+}
+
+func (x *appRolloutApiShowAppRolloutClient) Recv() (*AppRollout, error) {
+	m := new(AppRollout)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AppRolloutApiServer is the server API for AppRolloutApi service.
+type AppRolloutApiServer interface {
+	// Start rolling out a new App definition to the App Instances of the App
+	CreateAppRollout(context.Context, *AppRollout) (*Result, error)
+	// Delete an App rollout that is not in progress
+	DeleteAppRollout(context.Context, *AppRollout) (*Result, error)
+	// Pause an App rollout after the current wave
+	PauseAppRollout(context.Context, *AppRollout) (*Result, error)
+	// Resume a paused App rollout
+	ResumeAppRollout(context.Context, *AppRollout) (*Result, error)
+	// Roll back an App rollout to the previous App definition
+	RollbackAppRollout(context.Context, *AppRollout) (*Result, error)
+	// Show App rollouts
+	ShowAppRollout(*AppRollout, AppRolloutApi_ShowAppRolloutServer) error
+}
+
+// UnimplementedAppRolloutApiServer can be embedded to have forward compatible implementations.
+type UnimplementedAppRolloutApiServer struct {
+}
+
+func (*UnimplementedAppRolloutApiServer) CreateAppRollout(ctx context.Context, req *AppRollout) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAppRollout not implemented")
+}
+func (*UnimplementedAppRolloutApiServer) DeleteAppRollout(ctx context.Context, req *AppRollout) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAppRollout not implemented")
+}
+func (*UnimplementedAppRolloutApiServer) PauseAppRollout(ctx context.Context, req *AppRollout) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseAppRollout not implemented")
+}
+func (*UnimplementedAppRolloutApiServer) ResumeAppRollout(ctx context.Context, req *AppRollout) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeAppRollout not implemented")
+}
+func (*UnimplementedAppRolloutApiServer) RollbackAppRollout(ctx context.Context, req *AppRollout) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackAppRollout not implemented")
+}
+func (*UnimplementedAppRolloutApiServer) ShowAppRollout(req *AppRollout, srv AppRolloutApi_ShowAppRolloutServer) error {
+	return status.Errorf(codes.Unimplemented, "method ShowAppRollout not implemented")
+}
+
+func RegisterAppRolloutApiServer(s *grpc.Server, srv AppRolloutApiServer) {
+	s.RegisterService(&_AppRolloutApi_serviceDesc, srv)
+}
+
+func _AppRolloutApi_CreateAppRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppRollout)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppRolloutApiServer).CreateAppRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgeproto.AppRolloutApi/CreateAppRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppRolloutApiServer).CreateAppRollout(ctx, req.(*AppRollout))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppRolloutApi_DeleteAppRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppRollout)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppRolloutApiServer).DeleteAppRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgeproto.AppRolloutApi/DeleteAppRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppRolloutApiServer).DeleteAppRollout(ctx, req.(*AppRollout))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppRolloutApi_PauseAppRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppRollout)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppRolloutApiServer).PauseAppRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgeproto.AppRolloutApi/PauseAppRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppRolloutApiServer).PauseAppRollout(ctx, req.(*AppRollout))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppRolloutApi_ResumeAppRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppRollout)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppRolloutApiServer).ResumeAppRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgeproto.AppRolloutApi/ResumeAppRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppRolloutApiServer).ResumeAppRollout(ctx, req.(*AppRollout))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppRolloutApi_RollbackAppRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppRollout)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppRolloutApiServer).RollbackAppRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgeproto.AppRolloutApi/RollbackAppRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppRolloutApiServer).RollbackAppRollout(ctx, req.(*AppRollout))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppRolloutApi_ShowAppRollout_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AppRollout)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AppRolloutApiServer).ShowAppRollout(m, &appRolloutApiShowAppRolloutServer{stream})
+}
+
+type AppRolloutApi_ShowAppRolloutServer interface {
+	Send(*AppRollout) error
+	grpc.ServerStream
+}
+
+type appRolloutApiShowAppRolloutServer struct {
+	grpc.ServerStream
+}
+
+func (x *appRolloutApiShowAppRolloutServer) Send(m *AppRollout) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _AppRolloutApi_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "edgeproto.AppRolloutApi",
+	HandlerType: (*AppRolloutApiServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAppRollout",
+			Handler:    _AppRolloutApi_CreateAppRollout_Handler,
+		},
+		{
+			MethodName: "DeleteAppRollout",
+			Handler:    _AppRolloutApi_DeleteAppRollout_Handler,
+		},
+		{
+			MethodName: "PauseAppRollout",
+			Handler:    _AppRolloutApi_PauseAppRollout_Handler,
+		},
+		{
+			MethodName: "ResumeAppRollout",
+			Handler:    _AppRolloutApi_ResumeAppRollout_Handler,
+		},
+		{
+			MethodName: "RollbackAppRollout",
+			Handler:    _AppRolloutApi_RollbackAppRollout_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ShowAppRollout",
+			Handler:       _AppRolloutApi_ShowAppRollout_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "approllout.proto",
+}
+
+func (m *AppRolloutWave) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppRolloutWave) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppRolloutWave) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ZoneKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApprollout(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.AppInsts) > 0 {
+		for iNdEx := len(m.AppInsts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AppInsts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApprollout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AppRollout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppRollout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppRollout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApprollout(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApprollout(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Errors[iNdEx])
+			copy(dAtA[i:], m.Errors[iNdEx])
+			i = encodeVarintApprollout(dAtA, i, uint64(len(m.Errors[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.PreviousApp != nil {
+		{
+			size, err := m.PreviousApp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApprollout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Revision) > 0 {
+		i -= len(m.Revision)
+		copy(dAtA[i:], m.Revision)
+		i = encodeVarintApprollout(dAtA, i, uint64(len(m.Revision)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Waves) > 0 {
+		for iNdEx := len(m.Waves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Waves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApprollout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.WavesDone != 0 {
+		i = encodeVarintApprollout(dAtA, i, uint64(m.WavesDone))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.State != 0 {
+		i = encodeVarintApprollout(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.AutoRollback {
+		i--
+		if m.AutoRollback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PauseBetweenWaves {
+		i--
+		if m.PauseBetweenWaves {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.WaveSoakTime != 0 {
+		i = encodeVarintApprollout(dAtA, i, uint64(m.WaveSoakTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Zones) > 0 {
+		for iNdEx := len(m.Zones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Zones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApprollout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.WavesByZone {
+		i--
+		if m.WavesByZone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.WavePercentages) > 0 {
+		dAtA6 := make([]byte, len(m.WavePercentages)*10)
+		var j5 int
+		for _, num := range m.WavePercentages {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintApprollout(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.App != nil {
+		{
+			size, err := m.App.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApprollout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApprollout(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fields[iNdEx])
+			copy(dAtA[i:], m.Fields[iNdEx])
+			i = encodeVarintApprollout(dAtA, i, uint64(len(m.Fields[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApprollout(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApprollout(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AppRolloutWave) Clone() *AppRolloutWave {
+	cp := &AppRolloutWave{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *AppRolloutWave) AddAppInsts(vals ...AppInstKey) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.AppInsts {
+		cur[v.GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.AppInsts = append(m.AppInsts, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRolloutWave) RemoveAppInsts(vals ...AppInstKey) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKeyString()] = struct{}{}
+	}
+	for i := len(m.AppInsts); i >= 0; i-- {
+		if _, found := remove[m.AppInsts[i].GetKeyString()]; found {
+			m.AppInsts = append(m.AppInsts[:i], m.AppInsts[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRolloutWave) CopyInFields(src *AppRolloutWave) int {
+	updateListAction := "replace"
+	changed := 0
+	if src.AppInsts != nil {
+		if updateListAction == "add" {
+			changed += m.AddAppInsts(src.AppInsts...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveAppInsts(src.AppInsts...)
+		} else {
+			m.AppInsts = make([]AppInstKey, 0)
+			for k0, _ := range src.AppInsts {
+				m.AppInsts = append(m.AppInsts, *src.AppInsts[k0].Clone())
+			}
+			changed++
+		}
+	} else if m.AppInsts != nil {
+		m.AppInsts = nil
+		changed++
+	}
+	if m.ZoneKey.Organization != src.ZoneKey.Organization {
+		m.ZoneKey.Organization = src.ZoneKey.Organization
+		changed++
+	}
+	if m.ZoneKey.Name != src.ZoneKey.Name {
+		m.ZoneKey.Name = src.ZoneKey.Name
+		changed++
+	}
+	if m.ZoneKey.FederatedOrganization != src.ZoneKey.FederatedOrganization {
+		m.ZoneKey.FederatedOrganization = src.ZoneKey.FederatedOrganization
+		changed++
+	}
+	return changed
+}
+
+func (m *AppRolloutWave) DeepCopyIn(src *AppRolloutWave) {
+	if src.AppInsts != nil {
+		m.AppInsts = make([]AppInstKey, len(src.AppInsts), len(src.AppInsts))
+		for ii, s := range src.AppInsts {
+			m.AppInsts[ii].DeepCopyIn(&s)
+		}
+	} else {
+		m.AppInsts = nil
+	}
+	m.ZoneKey.DeepCopyIn(&src.ZoneKey)
+}
+
+// Helper method to check that enums have valid values
+func (m *AppRolloutWave) ValidateEnums() error {
+	for _, e := range m.AppInsts {
+		if err := e.ValidateEnums(); err != nil {
+			return err
+		}
+	}
+	if err := m.ZoneKey.ValidateEnums(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *AppRolloutWave) ClearTagged(tags map[string]struct{}) {
+	if s.AppInsts != nil {
+		for ii := 0; ii < len(s.AppInsts); ii++ {
+			s.AppInsts[ii].ClearTagged(tags)
+		}
+	}
+	s.ZoneKey.ClearTagged(tags)
+}
+
+func (m *AppRollout) Matches(o *AppRollout, fopts ...MatchOpt) bool {
+	opts := MatchOptions{}
+	applyMatchOptions(&opts, fopts...)
+	if o == nil {
+		if opts.Filter {
+			return true
+		}
+		return false
+	}
+	if !m.Key.Matches(&o.Key, fopts...) {
+		return false
+	}
+	if !opts.Filter || o.App != nil {
+		if m.App == nil && o.App != nil || m.App != nil && o.App == nil {
+			return false
+		} else if m.App != nil && o.App != nil {
+			if !m.App.Matches(o.App, fopts...) {
+				return false
+			}
+		}
+	}
+	if !opts.Filter || o.WavePercentages != nil {
+		if len(m.WavePercentages) == 0 && len(o.WavePercentages) > 0 || len(m.WavePercentages) > 0 && len(o.WavePercentages) == 0 {
+			return false
+		} else if m.WavePercentages != nil && o.WavePercentages != nil {
+			if !opts.Filter && len(m.WavePercentages) != len(o.WavePercentages) {
+				return false
+			}
+			found := 0
+			for oIndex, _ := range o.WavePercentages {
+				for mIndex, _ := range m.WavePercentages {
+					if o.WavePercentages[oIndex] == m.WavePercentages[mIndex] {
+						found++
+						break
+					}
+				}
+			}
+			if found != len(o.WavePercentages) {
+				return false
+			}
+		}
+	}
+	if !opts.Filter || o.WavesByZone != false {
+		if o.WavesByZone != m.WavesByZone {
+			return false
+		}
+	}
+	if !opts.Filter || o.Zones != nil {
+		if len(m.Zones) == 0 && len(o.Zones) > 0 || len(m.Zones) > 0 && len(o.Zones) == 0 {
+			return false
+		} else if m.Zones != nil && o.Zones != nil {
+			if !opts.Filter && len(m.Zones) != len(o.Zones) {
+				return false
+			}
+			if opts.SortArrayedKeys {
+				sort.Slice(m.Zones, func(i, j int) bool {
+					return m.Zones[i].GetKeyString() < m.Zones[j].GetKeyString()
+				})
+				sort.Slice(o.Zones, func(i, j int) bool {
+					return o.Zones[i].GetKeyString() < o.Zones[j].GetKeyString()
+				})
+			}
+			found := 0
+			for oIndex, _ := range o.Zones {
+				for mIndex, _ := range m.Zones {
+					if m.Zones[mIndex].Matches(&o.Zones[oIndex], fopts...) {
+						found++
+						break
+					}
+				}
+			}
+			if found != len(o.Zones) {
+				return false
+			}
+		}
+	}
+	if !opts.Filter || o.WaveSoakTime != 0 {
+		if o.WaveSoakTime != m.WaveSoakTime {
+			return false
+		}
+	}
+	if !opts.Filter || o.PauseBetweenWaves != false {
+		if o.PauseBetweenWaves != m.PauseBetweenWaves {
+			return false
+		}
+	}
+	if !opts.Filter || o.AutoRollback != false {
+		if o.AutoRollback != m.AutoRollback {
+			return false
+		}
+	}
+	if !opts.IgnoreBackend {
+		if !opts.Filter || o.State != 0 {
+			if o.State != m.State {
+				return false
+			}
+		}
+	}
+	if !opts.IgnoreBackend {
+		if !opts.Filter || o.WavesDone != 0 {
+			if o.WavesDone != m.WavesDone {
+				return false
+			}
+		}
+	}
+	if !opts.IgnoreBackend {
+		if !opts.Filter || o.Waves != nil {
+			if len(m.Waves) == 0 && len(o.Waves) > 0 || len(m.Waves) > 0 && len(o.Waves) == 0 {
+				return false
+			} else if m.Waves != nil && o.Waves != nil {
+				if !opts.Filter && len(m.Waves) != len(o.Waves) {
+					return false
+				}
+			}
+		}
+	}
+	if !opts.IgnoreBackend {
+		if !opts.Filter || o.Revision != "" {
+			if o.Revision != m.Revision {
+				return false
+			}
+		}
+	}
+	if !opts.IgnoreBackend {
+		if !opts.Filter || o.PreviousApp != nil {
+			if m.PreviousApp == nil && o.PreviousApp != nil || m.PreviousApp != nil && o.PreviousApp == nil {
+				return false
+			} else if m.PreviousApp != nil && o.PreviousApp != nil {
+				if !m.PreviousApp.Matches(o.PreviousApp, fopts...) {
+					return false
+				}
+			}
+		}
+	}
+	if !opts.IgnoreBackend {
+		if !opts.Filter || o.Errors != nil {
+			if len(m.Errors) == 0 && len(o.Errors) > 0 || len(m.Errors) > 0 && len(o.Errors) == 0 {
+				return false
+			} else if m.Errors != nil && o.Errors != nil {
+				if !opts.Filter && len(m.Errors) != len(o.Errors) {
+					return false
+				}
+				found := 0
+				for oIndex, _ := range o.Errors {
+					for mIndex, _ := range m.Errors {
+						if o.Errors[oIndex] == m.Errors[mIndex] {
+							found++
+							break
+						}
+					}
+				}
+				if found != len(o.Errors) {
+					return false
+				}
+			}
+		}
+	}
+	if !opts.IgnoreBackend {
+	}
+	if !opts.IgnoreBackend {
+	}
+	return true
+}
+
+const AppRolloutFieldKey = "2"
+const AppRolloutFieldKeyOrganization = "2.1"
+const AppRolloutFieldKeyName = "2.2"
+const AppRolloutFieldKeyVersion = "2.3"
+const AppRolloutFieldApp = "3"
+const AppRolloutFieldAppKey = "3.2"
+const AppRolloutFieldAppKeyOrganization = "3.2.1"
+const AppRolloutFieldAppKeyName = "3.2.2"
+const AppRolloutFieldAppKeyVersion = "3.2.3"
+const AppRolloutFieldAppImagePath = "3.4"
+const AppRolloutFieldAppImageType = "3.5"
+const AppRolloutFieldAppAccessPorts = "3.7"
+const AppRolloutFieldAppDefaultFlavor = "3.9"
+const AppRolloutFieldAppDefaultFlavorName = "3.9.1"
+const AppRolloutFieldAppAuthPublicKey = "3.12"
+const AppRolloutFieldAppCommand = "3.13"
+const AppRolloutFieldAppAnnotations = "3.14"
+const AppRolloutFieldAppDeployment = "3.15"
+const AppRolloutFieldAppDeploymentManifest = "3.16"
+const AppRolloutFieldAppDeploymentGenerator = "3.17"
+const AppRolloutFieldAppAndroidPackageName = "3.18"
+const AppRolloutFieldAppDelOpt = "3.20"
+const AppRolloutFieldAppConfigs = "3.21"
+const AppRolloutFieldAppConfigsKind = "3.21.1"
+const AppRolloutFieldAppConfigsConfig = "3.21.2"
+const AppRolloutFieldAppScaleWithCluster = "3.22"
+const AppRolloutFieldAppInternalPorts = "3.23"
+const AppRolloutFieldAppRevision = "3.24"
+const AppRolloutFieldAppOfficialFqdn = "3.25"
+const AppRolloutFieldAppMd5Sum = "3.26"
+const AppRolloutFieldAppAutoProvPolicy = "3.28"
+const AppRolloutFieldAppAccessType = "3.29"
+const AppRolloutFieldAppDeletePrepare = "3.31"
+const AppRolloutFieldAppAutoProvPolicies = "3.32"
+const AppRolloutFieldAppTemplateDelimiter = "3.33"
+const AppRolloutFieldAppSkipHcPorts = "3.34"
+const AppRolloutFieldAppCreatedAt = "3.35"
+const AppRolloutFieldAppCreatedAtSeconds = "3.35.1"
+const AppRolloutFieldAppCreatedAtNanos = "3.35.2"
+const AppRolloutFieldAppUpdatedAt = "3.36"
+const AppRolloutFieldAppUpdatedAtSeconds = "3.36.1"
+const AppRolloutFieldAppUpdatedAtNanos = "3.36.2"
+const AppRolloutFieldAppTrusted = "3.37"
+const AppRolloutFieldAppRequiredOutboundConnections = "3.38"
+const AppRolloutFieldAppRequiredOutboundConnectionsProtocol = "3.38.1"
+const AppRolloutFieldAppRequiredOutboundConnectionsPortRangeMin = "3.38.2"
+const AppRolloutFieldAppRequiredOutboundConnectionsPortRangeMax = "3.38.3"
+const AppRolloutFieldAppRequiredOutboundConnectionsRemoteCidr = "3.38.4"
+const AppRolloutFieldAppAllowServerless = "3.39"
+const AppRolloutFieldAppServerlessConfig = "3.40"
+const AppRolloutFieldAppServerlessConfigVcpus = "3.40.1"
+const AppRolloutFieldAppServerlessConfigVcpusWhole = "3.40.1.1"
+const AppRolloutFieldAppServerlessConfigVcpusNanos = "3.40.1.2"
+const AppRolloutFieldAppServerlessConfigRam = "3.40.2"
+const AppRolloutFieldAppServerlessConfigMinReplicas = "3.40.3"
+const AppRolloutFieldAppServerlessConfigGpuConfig = "3.40.4"
+const AppRolloutFieldAppServerlessConfigGpuConfigType = "3.40.4.1"
+const AppRolloutFieldAppServerlessConfigGpuConfigModel = "3.40.4.2"
+const AppRolloutFieldAppServerlessConfigGpuConfigNumGpu = "3.40.4.3"
+const AppRolloutFieldAppServerlessConfigGpuConfigRam = "3.40.4.4"
+const AppRolloutFieldAppVmAppOsType = "3.41"
+const AppRolloutFieldAppAlertPolicies = "3.42"
+const AppRolloutFieldAppQosSessionProfile = "3.43"
+const AppRolloutFieldAppQosSessionDuration = "3.44"
+const AppRolloutFieldAppGlobalId = "3.45"
+const AppRolloutFieldAppCommandArgs = "3.46"
+const AppRolloutFieldAppEnvVars = "3.47"
+const AppRolloutFieldAppEnvVarsKey = "3.47.1"
+const AppRolloutFieldAppEnvVarsValue = "3.47.2"
+const AppRolloutFieldAppSecretEnvVars = "3.48"
+const AppRolloutFieldAppSecretEnvVarsKey = "3.48.1"
+const AppRolloutFieldAppSecretEnvVarsValue = "3.48.2"
+const AppRolloutFieldAppUpdateListAction = "3.49"
+const AppRolloutFieldAppKubernetesResources = "3.50"
+const AppRolloutFieldAppKubernetesResourcesCpuPool = "3.50.1"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTotalVcpus = "3.50.1.1"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTotalVcpusWhole = "3.50.1.1.1"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTotalVcpusNanos = "3.50.1.1.2"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTotalMemory = "3.50.1.2"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTotalDisk = "3.50.1.3"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTotalOptRes = "3.50.1.4"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTotalOptResKey = "3.50.1.4.1"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTotalOptResValue = "3.50.1.4.2"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTopology = "3.50.1.5"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeVcpus = "3.50.1.5.1"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeMemory = "3.50.1.5.2"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeDisk = "3.50.1.5.3"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeOptRes = "3.50.1.5.4"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeOptResKey = "3.50.1.5.4.1"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeOptResValue = "3.50.1.5.4.2"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNumberOfNodes = "3.50.1.5.5"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpus = "3.50.1.5.6"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusModelId = "3.50.1.5.6.1"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusCount = "3.50.1.5.6.2"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusVendor = "3.50.1.5.6.3"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusMemory = "3.50.1.5.6.4"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusInUse = "3.50.1.5.6.5"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpus = "3.50.1.6"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusModelId = "3.50.1.6.1"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusCount = "3.50.1.6.2"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusVendor = "3.50.1.6.3"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusMemory = "3.50.1.6.4"
+const AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusInUse = "3.50.1.6.5"
+const AppRolloutFieldAppKubernetesResourcesGpuPool = "3.50.2"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTotalVcpus = "3.50.2.1"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTotalVcpusWhole = "3.50.2.1.1"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTotalVcpusNanos = "3.50.2.1.2"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTotalMemory = "3.50.2.2"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTotalDisk = "3.50.2.3"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTotalOptRes = "3.50.2.4"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTotalOptResKey = "3.50.2.4.1"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTotalOptResValue = "3.50.2.4.2"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTopology = "3.50.2.5"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeVcpus = "3.50.2.5.1"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeMemory = "3.50.2.5.2"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeDisk = "3.50.2.5.3"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeOptRes = "3.50.2.5.4"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeOptResKey = "3.50.2.5.4.1"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeOptResValue = "3.50.2.5.4.2"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNumberOfNodes = "3.50.2.5.5"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpus = "3.50.2.5.6"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusModelId = "3.50.2.5.6.1"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusCount = "3.50.2.5.6.2"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusVendor = "3.50.2.5.6.3"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusMemory = "3.50.2.5.6.4"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusInUse = "3.50.2.5.6.5"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpus = "3.50.2.6"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusModelId = "3.50.2.6.1"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusCount = "3.50.2.6.2"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusVendor = "3.50.2.6.3"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusMemory = "3.50.2.6.4"
+const AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusInUse = "3.50.2.6.5"
+const AppRolloutFieldAppKubernetesResourcesMinKubernetesVersion = "3.50.4"
+const AppRolloutFieldAppNodeResources = "3.51"
+const AppRolloutFieldAppNodeResourcesVcpus = "3.51.1"
+const AppRolloutFieldAppNodeResourcesRam = "3.51.2"
+const AppRolloutFieldAppNodeResourcesDisk = "3.51.3"
+const AppRolloutFieldAppNodeResourcesOptResMap = "3.51.4"
+const AppRolloutFieldAppNodeResourcesOptResMapKey = "3.51.4.1"
+const AppRolloutFieldAppNodeResourcesOptResMapValue = "3.51.4.2"
+const AppRolloutFieldAppNodeResourcesInfraNodeFlavor = "3.51.5"
+const AppRolloutFieldAppNodeResourcesExternalVolumeSize = "3.51.6"
+const AppRolloutFieldAppNodeResourcesGpus = "3.51.7"
+const AppRolloutFieldAppNodeResourcesGpusModelId = "3.51.7.1"
+const AppRolloutFieldAppNodeResourcesGpusCount = "3.51.7.2"
+const AppRolloutFieldAppNodeResourcesGpusVendor = "3.51.7.3"
+const AppRolloutFieldAppNodeResourcesGpusMemory = "3.51.7.4"
+const AppRolloutFieldAppNodeResourcesGpusInUse = "3.51.7.5"
+const AppRolloutFieldAppObjId = "3.52"
+const AppRolloutFieldAppAppAnnotations = "3.53"
+const AppRolloutFieldAppAppAnnotationsKey = "3.53.1"
+const AppRolloutFieldAppAppAnnotationsValue = "3.53.2"
+const AppRolloutFieldAppIsStandalone = "3.54"
+const AppRolloutFieldAppManagesOwnNamespaces = "3.55"
+const AppRolloutFieldAppCompatibilityVersion = "3.56"
+const AppRolloutFieldAppFindCloudletScoreWeights = "3.57"
+const AppRolloutFieldAppFindCloudletScoreWeightsDistance = "3.57.1"
+const AppRolloutFieldAppFindCloudletScoreWeightsLatency = "3.57.2"
+const AppRolloutFieldAppFindCloudletScoreWeightsResourceUsage = "3.57.3"
+const AppRolloutFieldAppFindCloudletScoreWeightsHealth = "3.57.4"
+const AppRolloutFieldAppGeoFencePolicy = "3.58"
+const AppRolloutFieldAppReplicaAutoScalePolicy = "3.59"
+const AppRolloutFieldAppReplicaAutoScalePolicyMinReplicas = "3.59.1"
+const AppRolloutFieldAppReplicaAutoScalePolicyMaxReplicas = "3.59.2"
+const AppRolloutFieldAppReplicaAutoScalePolicyTargetCpu = "3.59.3"
+const AppRolloutFieldAppReplicaAutoScalePolicyTargetMem = "3.59.4"
+const AppRolloutFieldAppReplicaAutoScalePolicyTargetActiveConnections = "3.59.5"
+const AppRolloutFieldAppReplicaAutoScalePolicyStabilizationWindowSec = "3.59.6"
+const AppRolloutFieldAppTags = "3.100"
+const AppRolloutFieldAppTagsKey = "3.100.1"
+const AppRolloutFieldAppTagsValue = "3.100.2"
+const AppRolloutFieldWavePercentages = "4"
+const AppRolloutFieldWavesByZone = "5"
+const AppRolloutFieldZones = "6"
+const AppRolloutFieldZonesOrganization = "6.1"
+const AppRolloutFieldZonesName = "6.2"
+const AppRolloutFieldZonesFederatedOrganization = "6.3"
+const AppRolloutFieldWaveSoakTime = "7"
+const AppRolloutFieldPauseBetweenWaves = "8"
+const AppRolloutFieldAutoRollback = "9"
+const AppRolloutFieldState = "10"
+const AppRolloutFieldWavesDone = "11"
+const AppRolloutFieldWaves = "12"
+const AppRolloutFieldWavesAppInsts = "12.1"
+const AppRolloutFieldWavesAppInstsName = "12.1.1"
+const AppRolloutFieldWavesAppInstsOrganization = "12.1.2"
+const AppRolloutFieldWavesZoneKey = "12.2"
+const AppRolloutFieldWavesZoneKeyOrganization = "12.2.1"
+const AppRolloutFieldWavesZoneKeyName = "12.2.2"
+const AppRolloutFieldWavesZoneKeyFederatedOrganization = "12.2.3"
+const AppRolloutFieldRevision = "13"
+const AppRolloutFieldPreviousApp = "14"
+const AppRolloutFieldPreviousAppKey = "14.2"
+const AppRolloutFieldPreviousAppKeyOrganization = "14.2.1"
+const AppRolloutFieldPreviousAppKeyName = "14.2.2"
+const AppRolloutFieldPreviousAppKeyVersion = "14.2.3"
+const AppRolloutFieldPreviousAppImagePath = "14.4"
+const AppRolloutFieldPreviousAppImageType = "14.5"
+const AppRolloutFieldPreviousAppAccessPorts = "14.7"
+const AppRolloutFieldPreviousAppDefaultFlavor = "14.9"
+const AppRolloutFieldPreviousAppDefaultFlavorName = "14.9.1"
+const AppRolloutFieldPreviousAppAuthPublicKey = "14.12"
+const AppRolloutFieldPreviousAppCommand = "14.13"
+const AppRolloutFieldPreviousAppAnnotations = "14.14"
+const AppRolloutFieldPreviousAppDeployment = "14.15"
+const AppRolloutFieldPreviousAppDeploymentManifest = "14.16"
+const AppRolloutFieldPreviousAppDeploymentGenerator = "14.17"
+const AppRolloutFieldPreviousAppAndroidPackageName = "14.18"
+const AppRolloutFieldPreviousAppDelOpt = "14.20"
+const AppRolloutFieldPreviousAppConfigs = "14.21"
+const AppRolloutFieldPreviousAppConfigsKind = "14.21.1"
+const AppRolloutFieldPreviousAppConfigsConfig = "14.21.2"
+const AppRolloutFieldPreviousAppScaleWithCluster = "14.22"
+const AppRolloutFieldPreviousAppInternalPorts = "14.23"
+const AppRolloutFieldPreviousAppRevision = "14.24"
+const AppRolloutFieldPreviousAppOfficialFqdn = "14.25"
+const AppRolloutFieldPreviousAppMd5Sum = "14.26"
+const AppRolloutFieldPreviousAppAutoProvPolicy = "14.28"
+const AppRolloutFieldPreviousAppAccessType = "14.29"
+const AppRolloutFieldPreviousAppDeletePrepare = "14.31"
+const AppRolloutFieldPreviousAppAutoProvPolicies = "14.32"
+const AppRolloutFieldPreviousAppTemplateDelimiter = "14.33"
+const AppRolloutFieldPreviousAppSkipHcPorts = "14.34"
+const AppRolloutFieldPreviousAppCreatedAt = "14.35"
+const AppRolloutFieldPreviousAppCreatedAtSeconds = "14.35.1"
+const AppRolloutFieldPreviousAppCreatedAtNanos = "14.35.2"
+const AppRolloutFieldPreviousAppUpdatedAt = "14.36"
+const AppRolloutFieldPreviousAppUpdatedAtSeconds = "14.36.1"
+const AppRolloutFieldPreviousAppUpdatedAtNanos = "14.36.2"
+const AppRolloutFieldPreviousAppTrusted = "14.37"
+const AppRolloutFieldPreviousAppRequiredOutboundConnections = "14.38"
+const AppRolloutFieldPreviousAppRequiredOutboundConnectionsProtocol = "14.38.1"
+const AppRolloutFieldPreviousAppRequiredOutboundConnectionsPortRangeMin = "14.38.2"
+const AppRolloutFieldPreviousAppRequiredOutboundConnectionsPortRangeMax = "14.38.3"
+const AppRolloutFieldPreviousAppRequiredOutboundConnectionsRemoteCidr = "14.38.4"
+const AppRolloutFieldPreviousAppAllowServerless = "14.39"
+const AppRolloutFieldPreviousAppServerlessConfig = "14.40"
+const AppRolloutFieldPreviousAppServerlessConfigVcpus = "14.40.1"
+const AppRolloutFieldPreviousAppServerlessConfigVcpusWhole = "14.40.1.1"
+const AppRolloutFieldPreviousAppServerlessConfigVcpusNanos = "14.40.1.2"
+const AppRolloutFieldPreviousAppServerlessConfigRam = "14.40.2"
+const AppRolloutFieldPreviousAppServerlessConfigMinReplicas = "14.40.3"
+const AppRolloutFieldPreviousAppServerlessConfigGpuConfig = "14.40.4"
+const AppRolloutFieldPreviousAppServerlessConfigGpuConfigType = "14.40.4.1"
+const AppRolloutFieldPreviousAppServerlessConfigGpuConfigModel = "14.40.4.2"
+const AppRolloutFieldPreviousAppServerlessConfigGpuConfigNumGpu = "14.40.4.3"
+const AppRolloutFieldPreviousAppServerlessConfigGpuConfigRam = "14.40.4.4"
+const AppRolloutFieldPreviousAppVmAppOsType = "14.41"
+const AppRolloutFieldPreviousAppAlertPolicies = "14.42"
+const AppRolloutFieldPreviousAppQosSessionProfile = "14.43"
+const AppRolloutFieldPreviousAppQosSessionDuration = "14.44"
+const AppRolloutFieldPreviousAppGlobalId = "14.45"
+const AppRolloutFieldPreviousAppCommandArgs = "14.46"
+const AppRolloutFieldPreviousAppEnvVars = "14.47"
+const AppRolloutFieldPreviousAppEnvVarsKey = "14.47.1"
+const AppRolloutFieldPreviousAppEnvVarsValue = "14.47.2"
+const AppRolloutFieldPreviousAppSecretEnvVars = "14.48"
+const AppRolloutFieldPreviousAppSecretEnvVarsKey = "14.48.1"
+const AppRolloutFieldPreviousAppSecretEnvVarsValue = "14.48.2"
+const AppRolloutFieldPreviousAppUpdateListAction = "14.49"
+const AppRolloutFieldPreviousAppKubernetesResources = "14.50"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPool = "14.50.1"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalVcpus = "14.50.1.1"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalVcpusWhole = "14.50.1.1.1"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalVcpusNanos = "14.50.1.1.2"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalMemory = "14.50.1.2"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalDisk = "14.50.1.3"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalOptRes = "14.50.1.4"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalOptResKey = "14.50.1.4.1"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalOptResValue = "14.50.1.4.2"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopology = "14.50.1.5"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeVcpus = "14.50.1.5.1"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeMemory = "14.50.1.5.2"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeDisk = "14.50.1.5.3"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeOptRes = "14.50.1.5.4"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeOptResKey = "14.50.1.5.4.1"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeOptResValue = "14.50.1.5.4.2"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNumberOfNodes = "14.50.1.5.5"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpus = "14.50.1.5.6"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusModelId = "14.50.1.5.6.1"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusCount = "14.50.1.5.6.2"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusVendor = "14.50.1.5.6.3"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusMemory = "14.50.1.5.6.4"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusInUse = "14.50.1.5.6.5"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpus = "14.50.1.6"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusModelId = "14.50.1.6.1"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusCount = "14.50.1.6.2"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusVendor = "14.50.1.6.3"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusMemory = "14.50.1.6.4"
+const AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusInUse = "14.50.1.6.5"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPool = "14.50.2"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalVcpus = "14.50.2.1"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalVcpusWhole = "14.50.2.1.1"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalVcpusNanos = "14.50.2.1.2"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalMemory = "14.50.2.2"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalDisk = "14.50.2.3"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalOptRes = "14.50.2.4"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalOptResKey = "14.50.2.4.1"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalOptResValue = "14.50.2.4.2"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopology = "14.50.2.5"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeVcpus = "14.50.2.5.1"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeMemory = "14.50.2.5.2"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeDisk = "14.50.2.5.3"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeOptRes = "14.50.2.5.4"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeOptResKey = "14.50.2.5.4.1"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeOptResValue = "14.50.2.5.4.2"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNumberOfNodes = "14.50.2.5.5"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpus = "14.50.2.5.6"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusModelId = "14.50.2.5.6.1"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusCount = "14.50.2.5.6.2"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusVendor = "14.50.2.5.6.3"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusMemory = "14.50.2.5.6.4"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusInUse = "14.50.2.5.6.5"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpus = "14.50.2.6"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusModelId = "14.50.2.6.1"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusCount = "14.50.2.6.2"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusVendor = "14.50.2.6.3"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusMemory = "14.50.2.6.4"
+const AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusInUse = "14.50.2.6.5"
+const AppRolloutFieldPreviousAppKubernetesResourcesMinKubernetesVersion = "14.50.4"
+const AppRolloutFieldPreviousAppNodeResources = "14.51"
+const AppRolloutFieldPreviousAppNodeResourcesVcpus = "14.51.1"
+const AppRolloutFieldPreviousAppNodeResourcesRam = "14.51.2"
+const AppRolloutFieldPreviousAppNodeResourcesDisk = "14.51.3"
+const AppRolloutFieldPreviousAppNodeResourcesOptResMap = "14.51.4"
+const AppRolloutFieldPreviousAppNodeResourcesOptResMapKey = "14.51.4.1"
+const AppRolloutFieldPreviousAppNodeResourcesOptResMapValue = "14.51.4.2"
+const AppRolloutFieldPreviousAppNodeResourcesInfraNodeFlavor = "14.51.5"
+const AppRolloutFieldPreviousAppNodeResourcesExternalVolumeSize = "14.51.6"
+const AppRolloutFieldPreviousAppNodeResourcesGpus = "14.51.7"
+const AppRolloutFieldPreviousAppNodeResourcesGpusModelId = "14.51.7.1"
+const AppRolloutFieldPreviousAppNodeResourcesGpusCount = "14.51.7.2"
+const AppRolloutFieldPreviousAppNodeResourcesGpusVendor = "14.51.7.3"
+const AppRolloutFieldPreviousAppNodeResourcesGpusMemory = "14.51.7.4"
+const AppRolloutFieldPreviousAppNodeResourcesGpusInUse = "14.51.7.5"
+const AppRolloutFieldPreviousAppObjId = "14.52"
+const AppRolloutFieldPreviousAppAppAnnotations = "14.53"
+const AppRolloutFieldPreviousAppAppAnnotationsKey = "14.53.1"
+const AppRolloutFieldPreviousAppAppAnnotationsValue = "14.53.2"
+const AppRolloutFieldPreviousAppIsStandalone = "14.54"
+const AppRolloutFieldPreviousAppManagesOwnNamespaces = "14.55"
+const AppRolloutFieldPreviousAppCompatibilityVersion = "14.56"
+const AppRolloutFieldPreviousAppFindCloudletScoreWeights = "14.57"
+const AppRolloutFieldPreviousAppFindCloudletScoreWeightsDistance = "14.57.1"
+const AppRolloutFieldPreviousAppFindCloudletScoreWeightsLatency = "14.57.2"
+const AppRolloutFieldPreviousAppFindCloudletScoreWeightsResourceUsage = "14.57.3"
+const AppRolloutFieldPreviousAppFindCloudletScoreWeightsHealth = "14.57.4"
+const AppRolloutFieldPreviousAppGeoFencePolicy = "14.58"
+const AppRolloutFieldPreviousAppReplicaAutoScalePolicy = "14.59"
+const AppRolloutFieldPreviousAppReplicaAutoScalePolicyMinReplicas = "14.59.1"
+const AppRolloutFieldPreviousAppReplicaAutoScalePolicyMaxReplicas = "14.59.2"
+const AppRolloutFieldPreviousAppReplicaAutoScalePolicyTargetCpu = "14.59.3"
+const AppRolloutFieldPreviousAppReplicaAutoScalePolicyTargetMem = "14.59.4"
+const AppRolloutFieldPreviousAppReplicaAutoScalePolicyTargetActiveConnections = "14.59.5"
+const AppRolloutFieldPreviousAppReplicaAutoScalePolicyStabilizationWindowSec = "14.59.6"
+const AppRolloutFieldPreviousAppTags = "14.100"
+const AppRolloutFieldPreviousAppTagsKey = "14.100.1"
+const AppRolloutFieldPreviousAppTagsValue = "14.100.2"
+const AppRolloutFieldErrors = "15"
+const AppRolloutFieldCreatedAt = "16"
+const AppRolloutFieldCreatedAtSeconds = "16.1"
+const AppRolloutFieldCreatedAtNanos = "16.2"
+const AppRolloutFieldUpdatedAt = "17"
+const AppRolloutFieldUpdatedAtSeconds = "17.1"
+const AppRolloutFieldUpdatedAtNanos = "17.2"
+
+var AppRolloutAllFields = []string{
+	AppRolloutFieldKeyOrganization,
+	AppRolloutFieldKeyName,
+	AppRolloutFieldKeyVersion,
+	AppRolloutFieldAppKeyOrganization,
+	AppRolloutFieldAppKeyName,
+	AppRolloutFieldAppKeyVersion,
+	AppRolloutFieldAppImagePath,
+	AppRolloutFieldAppImageType,
+	AppRolloutFieldAppAccessPorts,
+	AppRolloutFieldAppDefaultFlavorName,
+	AppRolloutFieldAppAuthPublicKey,
+	AppRolloutFieldAppCommand,
+	AppRolloutFieldAppAnnotations,
+	AppRolloutFieldAppDeployment,
+	AppRolloutFieldAppDeploymentManifest,
+	AppRolloutFieldAppDeploymentGenerator,
+	AppRolloutFieldAppAndroidPackageName,
+	AppRolloutFieldAppDelOpt,
+	AppRolloutFieldAppConfigsKind,
+	AppRolloutFieldAppConfigsConfig,
+	AppRolloutFieldAppScaleWithCluster,
+	AppRolloutFieldAppInternalPorts,
+	AppRolloutFieldAppRevision,
+	AppRolloutFieldAppOfficialFqdn,
+	AppRolloutFieldAppMd5Sum,
+	AppRolloutFieldAppAutoProvPolicy,
+	AppRolloutFieldAppAccessType,
+	AppRolloutFieldAppDeletePrepare,
+	AppRolloutFieldAppAutoProvPolicies,
+	AppRolloutFieldAppTemplateDelimiter,
+	AppRolloutFieldAppSkipHcPorts,
+	AppRolloutFieldAppCreatedAtSeconds,
+	AppRolloutFieldAppCreatedAtNanos,
+	AppRolloutFieldAppUpdatedAtSeconds,
+	AppRolloutFieldAppUpdatedAtNanos,
+	AppRolloutFieldAppTrusted,
+	AppRolloutFieldAppRequiredOutboundConnectionsProtocol,
+	AppRolloutFieldAppRequiredOutboundConnectionsPortRangeMin,
+	AppRolloutFieldAppRequiredOutboundConnectionsPortRangeMax,
+	AppRolloutFieldAppRequiredOutboundConnectionsRemoteCidr,
+	AppRolloutFieldAppAllowServerless,
+	AppRolloutFieldAppServerlessConfigVcpusWhole,
+	AppRolloutFieldAppServerlessConfigVcpusNanos,
+	AppRolloutFieldAppServerlessConfigRam,
+	AppRolloutFieldAppServerlessConfigMinReplicas,
+	AppRolloutFieldAppServerlessConfigGpuConfigType,
+	AppRolloutFieldAppServerlessConfigGpuConfigModel,
+	AppRolloutFieldAppServerlessConfigGpuConfigNumGpu,
+	AppRolloutFieldAppServerlessConfigGpuConfigRam,
+	AppRolloutFieldAppVmAppOsType,
+	AppRolloutFieldAppAlertPolicies,
+	AppRolloutFieldAppQosSessionProfile,
+	AppRolloutFieldAppQosSessionDuration,
+	AppRolloutFieldAppGlobalId,
+	AppRolloutFieldAppCommandArgs,
+	AppRolloutFieldAppEnvVarsKey,
+	AppRolloutFieldAppEnvVarsValue,
+	AppRolloutFieldAppSecretEnvVarsKey,
+	AppRolloutFieldAppSecretEnvVarsValue,
+	AppRolloutFieldAppUpdateListAction,
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalVcpusWhole,
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalVcpusNanos,
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalMemory,
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalDisk,
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalOptResKey,
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalOptResValue,
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeVcpus,
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeMemory,
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeDisk,
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeOptResKey,
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeOptResValue,
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNumberOfNodes,
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusModelId,
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusCount,
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusVendor,
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusMemory,
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusInUse,
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusModelId,
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusCount,
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusVendor,
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusMemory,
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusInUse,
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalVcpusWhole,
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalVcpusNanos,
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalMemory,
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalDisk,
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalOptResKey,
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalOptResValue,
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeVcpus,
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeMemory,
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeDisk,
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeOptResKey,
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeOptResValue,
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNumberOfNodes,
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusModelId,
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusCount,
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusVendor,
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusMemory,
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusInUse,
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusModelId,
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusCount,
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusVendor,
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusMemory,
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusInUse,
+	AppRolloutFieldAppKubernetesResourcesMinKubernetesVersion,
+	AppRolloutFieldAppNodeResourcesVcpus,
+	AppRolloutFieldAppNodeResourcesRam,
+	AppRolloutFieldAppNodeResourcesDisk,
+	AppRolloutFieldAppNodeResourcesOptResMapKey,
+	AppRolloutFieldAppNodeResourcesOptResMapValue,
+	AppRolloutFieldAppNodeResourcesInfraNodeFlavor,
+	AppRolloutFieldAppNodeResourcesExternalVolumeSize,
+	AppRolloutFieldAppNodeResourcesGpusModelId,
+	AppRolloutFieldAppNodeResourcesGpusCount,
+	AppRolloutFieldAppNodeResourcesGpusVendor,
+	AppRolloutFieldAppNodeResourcesGpusMemory,
+	AppRolloutFieldAppNodeResourcesGpusInUse,
+	AppRolloutFieldAppObjId,
+	AppRolloutFieldAppAppAnnotationsKey,
+	AppRolloutFieldAppAppAnnotationsValue,
+	AppRolloutFieldAppIsStandalone,
+	AppRolloutFieldAppManagesOwnNamespaces,
+	AppRolloutFieldAppCompatibilityVersion,
+	AppRolloutFieldAppFindCloudletScoreWeightsDistance,
+	AppRolloutFieldAppFindCloudletScoreWeightsLatency,
+	AppRolloutFieldAppFindCloudletScoreWeightsResourceUsage,
+	AppRolloutFieldAppFindCloudletScoreWeightsHealth,
+	AppRolloutFieldAppGeoFencePolicy,
+	AppRolloutFieldAppReplicaAutoScalePolicyMinReplicas,
+	AppRolloutFieldAppReplicaAutoScalePolicyMaxReplicas,
+	AppRolloutFieldAppReplicaAutoScalePolicyTargetCpu,
+	AppRolloutFieldAppReplicaAutoScalePolicyTargetMem,
+	AppRolloutFieldAppReplicaAutoScalePolicyTargetActiveConnections,
+	AppRolloutFieldAppReplicaAutoScalePolicyStabilizationWindowSec,
+	AppRolloutFieldAppTagsKey,
+	AppRolloutFieldAppTagsValue,
+	AppRolloutFieldWavePercentages,
+	AppRolloutFieldWavesByZone,
+	AppRolloutFieldZonesOrganization,
+	AppRolloutFieldZonesName,
+	AppRolloutFieldZonesFederatedOrganization,
+	AppRolloutFieldWaveSoakTime,
+	AppRolloutFieldPauseBetweenWaves,
+	AppRolloutFieldAutoRollback,
+	AppRolloutFieldState,
+	AppRolloutFieldWavesDone,
+	AppRolloutFieldWavesAppInstsName,
+	AppRolloutFieldWavesAppInstsOrganization,
+	AppRolloutFieldWavesZoneKeyOrganization,
+	AppRolloutFieldWavesZoneKeyName,
+	AppRolloutFieldWavesZoneKeyFederatedOrganization,
+	AppRolloutFieldRevision,
+	AppRolloutFieldPreviousAppKeyOrganization,
+	AppRolloutFieldPreviousAppKeyName,
+	AppRolloutFieldPreviousAppKeyVersion,
+	AppRolloutFieldPreviousAppImagePath,
+	AppRolloutFieldPreviousAppImageType,
+	AppRolloutFieldPreviousAppAccessPorts,
+	AppRolloutFieldPreviousAppDefaultFlavorName,
+	AppRolloutFieldPreviousAppAuthPublicKey,
+	AppRolloutFieldPreviousAppCommand,
+	AppRolloutFieldPreviousAppAnnotations,
+	AppRolloutFieldPreviousAppDeployment,
+	AppRolloutFieldPreviousAppDeploymentManifest,
+	AppRolloutFieldPreviousAppDeploymentGenerator,
+	AppRolloutFieldPreviousAppAndroidPackageName,
+	AppRolloutFieldPreviousAppDelOpt,
+	AppRolloutFieldPreviousAppConfigsKind,
+	AppRolloutFieldPreviousAppConfigsConfig,
+	AppRolloutFieldPreviousAppScaleWithCluster,
+	AppRolloutFieldPreviousAppInternalPorts,
+	AppRolloutFieldPreviousAppRevision,
+	AppRolloutFieldPreviousAppOfficialFqdn,
+	AppRolloutFieldPreviousAppMd5Sum,
+	AppRolloutFieldPreviousAppAutoProvPolicy,
+	AppRolloutFieldPreviousAppAccessType,
+	AppRolloutFieldPreviousAppDeletePrepare,
+	AppRolloutFieldPreviousAppAutoProvPolicies,
+	AppRolloutFieldPreviousAppTemplateDelimiter,
+	AppRolloutFieldPreviousAppSkipHcPorts,
+	AppRolloutFieldPreviousAppCreatedAtSeconds,
+	AppRolloutFieldPreviousAppCreatedAtNanos,
+	AppRolloutFieldPreviousAppUpdatedAtSeconds,
+	AppRolloutFieldPreviousAppUpdatedAtNanos,
+	AppRolloutFieldPreviousAppTrusted,
+	AppRolloutFieldPreviousAppRequiredOutboundConnectionsProtocol,
+	AppRolloutFieldPreviousAppRequiredOutboundConnectionsPortRangeMin,
+	AppRolloutFieldPreviousAppRequiredOutboundConnectionsPortRangeMax,
+	AppRolloutFieldPreviousAppRequiredOutboundConnectionsRemoteCidr,
+	AppRolloutFieldPreviousAppAllowServerless,
+	AppRolloutFieldPreviousAppServerlessConfigVcpusWhole,
+	AppRolloutFieldPreviousAppServerlessConfigVcpusNanos,
+	AppRolloutFieldPreviousAppServerlessConfigRam,
+	AppRolloutFieldPreviousAppServerlessConfigMinReplicas,
+	AppRolloutFieldPreviousAppServerlessConfigGpuConfigType,
+	AppRolloutFieldPreviousAppServerlessConfigGpuConfigModel,
+	AppRolloutFieldPreviousAppServerlessConfigGpuConfigNumGpu,
+	AppRolloutFieldPreviousAppServerlessConfigGpuConfigRam,
+	AppRolloutFieldPreviousAppVmAppOsType,
+	AppRolloutFieldPreviousAppAlertPolicies,
+	AppRolloutFieldPreviousAppQosSessionProfile,
+	AppRolloutFieldPreviousAppQosSessionDuration,
+	AppRolloutFieldPreviousAppGlobalId,
+	AppRolloutFieldPreviousAppCommandArgs,
+	AppRolloutFieldPreviousAppEnvVarsKey,
+	AppRolloutFieldPreviousAppEnvVarsValue,
+	AppRolloutFieldPreviousAppSecretEnvVarsKey,
+	AppRolloutFieldPreviousAppSecretEnvVarsValue,
+	AppRolloutFieldPreviousAppUpdateListAction,
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalVcpusWhole,
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalVcpusNanos,
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalMemory,
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalDisk,
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalOptResKey,
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalOptResValue,
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeVcpus,
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeMemory,
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeDisk,
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeOptResKey,
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeOptResValue,
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNumberOfNodes,
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusModelId,
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusCount,
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusVendor,
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusMemory,
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusInUse,
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusModelId,
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusCount,
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusVendor,
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusMemory,
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusInUse,
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalVcpusWhole,
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalVcpusNanos,
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalMemory,
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalDisk,
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalOptResKey,
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalOptResValue,
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeVcpus,
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeMemory,
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeDisk,
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeOptResKey,
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeOptResValue,
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNumberOfNodes,
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusModelId,
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusCount,
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusVendor,
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusMemory,
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusInUse,
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusModelId,
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusCount,
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusVendor,
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusMemory,
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusInUse,
+	AppRolloutFieldPreviousAppKubernetesResourcesMinKubernetesVersion,
+	AppRolloutFieldPreviousAppNodeResourcesVcpus,
+	AppRolloutFieldPreviousAppNodeResourcesRam,
+	AppRolloutFieldPreviousAppNodeResourcesDisk,
+	AppRolloutFieldPreviousAppNodeResourcesOptResMapKey,
+	AppRolloutFieldPreviousAppNodeResourcesOptResMapValue,
+	AppRolloutFieldPreviousAppNodeResourcesInfraNodeFlavor,
+	AppRolloutFieldPreviousAppNodeResourcesExternalVolumeSize,
+	AppRolloutFieldPreviousAppNodeResourcesGpusModelId,
+	AppRolloutFieldPreviousAppNodeResourcesGpusCount,
+	AppRolloutFieldPreviousAppNodeResourcesGpusVendor,
+	AppRolloutFieldPreviousAppNodeResourcesGpusMemory,
+	AppRolloutFieldPreviousAppNodeResourcesGpusInUse,
+	AppRolloutFieldPreviousAppObjId,
+	AppRolloutFieldPreviousAppAppAnnotationsKey,
+	AppRolloutFieldPreviousAppAppAnnotationsValue,
+	AppRolloutFieldPreviousAppIsStandalone,
+	AppRolloutFieldPreviousAppManagesOwnNamespaces,
+	AppRolloutFieldPreviousAppCompatibilityVersion,
+	AppRolloutFieldPreviousAppFindCloudletScoreWeightsDistance,
+	AppRolloutFieldPreviousAppFindCloudletScoreWeightsLatency,
+	AppRolloutFieldPreviousAppFindCloudletScoreWeightsResourceUsage,
+	AppRolloutFieldPreviousAppFindCloudletScoreWeightsHealth,
+	AppRolloutFieldPreviousAppGeoFencePolicy,
+	AppRolloutFieldPreviousAppReplicaAutoScalePolicyMinReplicas,
+	AppRolloutFieldPreviousAppReplicaAutoScalePolicyMaxReplicas,
+	AppRolloutFieldPreviousAppReplicaAutoScalePolicyTargetCpu,
+	AppRolloutFieldPreviousAppReplicaAutoScalePolicyTargetMem,
+	AppRolloutFieldPreviousAppReplicaAutoScalePolicyTargetActiveConnections,
+	AppRolloutFieldPreviousAppReplicaAutoScalePolicyStabilizationWindowSec,
+	AppRolloutFieldPreviousAppTagsKey,
+	AppRolloutFieldPreviousAppTagsValue,
+	AppRolloutFieldErrors,
+	AppRolloutFieldCreatedAtSeconds,
+	AppRolloutFieldCreatedAtNanos,
+	AppRolloutFieldUpdatedAtSeconds,
+	AppRolloutFieldUpdatedAtNanos,
+}
+
+var AppRolloutAllFieldsMap = NewFieldMap(map[string]struct{}{
+	AppRolloutFieldKeyOrganization:                                                 struct{}{},
+	AppRolloutFieldKeyName:                                                         struct{}{},
+	AppRolloutFieldKeyVersion:                                                      struct{}{},
+	AppRolloutFieldAppKeyOrganization:                                              struct{}{},
+	AppRolloutFieldAppKeyName:                                                      struct{}{},
+	AppRolloutFieldAppKeyVersion:                                                   struct{}{},
+	AppRolloutFieldAppImagePath:                                                    struct{}{},
+	AppRolloutFieldAppImageType:                                                    struct{}{},
+	AppRolloutFieldAppAccessPorts:                                                  struct{}{},
+	AppRolloutFieldAppDefaultFlavorName:                                            struct{}{},
+	AppRolloutFieldAppAuthPublicKey:                                                struct{}{},
+	AppRolloutFieldAppCommand:                                                      struct{}{},
+	AppRolloutFieldAppAnnotations:                                                  struct{}{},
+	AppRolloutFieldAppDeployment:                                                   struct{}{},
+	AppRolloutFieldAppDeploymentManifest:                                           struct{}{},
+	AppRolloutFieldAppDeploymentGenerator:                                          struct{}{},
+	AppRolloutFieldAppAndroidPackageName:                                           struct{}{},
+	AppRolloutFieldAppDelOpt:                                                       struct{}{},
+	AppRolloutFieldAppConfigsKind:                                                  struct{}{},
+	AppRolloutFieldAppConfigsConfig:                                                struct{}{},
+	AppRolloutFieldAppScaleWithCluster:                                             struct{}{},
+	AppRolloutFieldAppInternalPorts:                                                struct{}{},
+	AppRolloutFieldAppRevision:                                                     struct{}{},
+	AppRolloutFieldAppOfficialFqdn:                                                 struct{}{},
+	AppRolloutFieldAppMd5Sum:                                                       struct{}{},
+	AppRolloutFieldAppAutoProvPolicy:                                               struct{}{},
+	AppRolloutFieldAppAccessType:                                                   struct{}{},
+	AppRolloutFieldAppDeletePrepare:                                                struct{}{},
+	AppRolloutFieldAppAutoProvPolicies:                                             struct{}{},
+	AppRolloutFieldAppTemplateDelimiter:                                            struct{}{},
+	AppRolloutFieldAppSkipHcPorts:                                                  struct{}{},
+	AppRolloutFieldAppCreatedAtSeconds:                                             struct{}{},
+	AppRolloutFieldAppCreatedAtNanos:                                               struct{}{},
+	AppRolloutFieldAppUpdatedAtSeconds:                                             struct{}{},
+	AppRolloutFieldAppUpdatedAtNanos:                                               struct{}{},
+	AppRolloutFieldAppTrusted:                                                      struct{}{},
+	AppRolloutFieldAppRequiredOutboundConnectionsProtocol:                          struct{}{},
+	AppRolloutFieldAppRequiredOutboundConnectionsPortRangeMin:                      struct{}{},
+	AppRolloutFieldAppRequiredOutboundConnectionsPortRangeMax:                      struct{}{},
+	AppRolloutFieldAppRequiredOutboundConnectionsRemoteCidr:                        struct{}{},
+	AppRolloutFieldAppAllowServerless:                                              struct{}{},
+	AppRolloutFieldAppServerlessConfigVcpusWhole:                                   struct{}{},
+	AppRolloutFieldAppServerlessConfigVcpusNanos:                                   struct{}{},
+	AppRolloutFieldAppServerlessConfigRam:                                          struct{}{},
+	AppRolloutFieldAppServerlessConfigMinReplicas:                                  struct{}{},
+	AppRolloutFieldAppServerlessConfigGpuConfigType:                                struct{}{},
+	AppRolloutFieldAppServerlessConfigGpuConfigModel:                               struct{}{},
+	AppRolloutFieldAppServerlessConfigGpuConfigNumGpu:                              struct{}{},
+	AppRolloutFieldAppServerlessConfigGpuConfigRam:                                 struct{}{},
+	AppRolloutFieldAppVmAppOsType:                                                  struct{}{},
+	AppRolloutFieldAppAlertPolicies:                                                struct{}{},
+	AppRolloutFieldAppQosSessionProfile:                                            struct{}{},
+	AppRolloutFieldAppQosSessionDuration:                                           struct{}{},
+	AppRolloutFieldAppGlobalId:                                                     struct{}{},
+	AppRolloutFieldAppCommandArgs:                                                  struct{}{},
+	AppRolloutFieldAppEnvVarsKey:                                                   struct{}{},
+	AppRolloutFieldAppEnvVarsValue:                                                 struct{}{},
+	AppRolloutFieldAppSecretEnvVarsKey:                                             struct{}{},
+	AppRolloutFieldAppSecretEnvVarsValue:                                           struct{}{},
+	AppRolloutFieldAppUpdateListAction:                                             struct{}{},
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalVcpusWhole:                    struct{}{},
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalVcpusNanos:                    struct{}{},
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalMemory:                        struct{}{},
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalDisk:                          struct{}{},
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalOptResKey:                     struct{}{},
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalOptResValue:                   struct{}{},
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeVcpus:               struct{}{},
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeMemory:              struct{}{},
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeDisk:                struct{}{},
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeOptResKey:           struct{}{},
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeOptResValue:         struct{}{},
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNumberOfNodes:           struct{}{},
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusModelId:         struct{}{},
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusCount:           struct{}{},
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusVendor:          struct{}{},
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusMemory:          struct{}{},
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusInUse:           struct{}{},
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusModelId:                   struct{}{},
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusCount:                     struct{}{},
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusVendor:                    struct{}{},
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusMemory:                    struct{}{},
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusInUse:                     struct{}{},
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalVcpusWhole:                    struct{}{},
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalVcpusNanos:                    struct{}{},
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalMemory:                        struct{}{},
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalDisk:                          struct{}{},
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalOptResKey:                     struct{}{},
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalOptResValue:                   struct{}{},
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeVcpus:               struct{}{},
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeMemory:              struct{}{},
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeDisk:                struct{}{},
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeOptResKey:           struct{}{},
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeOptResValue:         struct{}{},
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNumberOfNodes:           struct{}{},
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusModelId:         struct{}{},
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusCount:           struct{}{},
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusVendor:          struct{}{},
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusMemory:          struct{}{},
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusInUse:           struct{}{},
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusModelId:                   struct{}{},
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusCount:                     struct{}{},
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusVendor:                    struct{}{},
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusMemory:                    struct{}{},
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusInUse:                     struct{}{},
+	AppRolloutFieldAppKubernetesResourcesMinKubernetesVersion:                      struct{}{},
+	AppRolloutFieldAppNodeResourcesVcpus:                                           struct{}{},
+	AppRolloutFieldAppNodeResourcesRam:                                             struct{}{},
+	AppRolloutFieldAppNodeResourcesDisk:                                            struct{}{},
+	AppRolloutFieldAppNodeResourcesOptResMapKey:                                    struct{}{},
+	AppRolloutFieldAppNodeResourcesOptResMapValue:                                  struct{}{},
+	AppRolloutFieldAppNodeResourcesInfraNodeFlavor:                                 struct{}{},
+	AppRolloutFieldAppNodeResourcesExternalVolumeSize:                              struct{}{},
+	AppRolloutFieldAppNodeResourcesGpusModelId:                                     struct{}{},
+	AppRolloutFieldAppNodeResourcesGpusCount:                                       struct{}{},
+	AppRolloutFieldAppNodeResourcesGpusVendor:                                      struct{}{},
+	AppRolloutFieldAppNodeResourcesGpusMemory:                                      struct{}{},
+	AppRolloutFieldAppNodeResourcesGpusInUse:                                       struct{}{},
+	AppRolloutFieldAppObjId:                                                        struct{}{},
+	AppRolloutFieldAppAppAnnotationsKey:                                            struct{}{},
+	AppRolloutFieldAppAppAnnotationsValue:                                          struct{}{},
+	AppRolloutFieldAppIsStandalone:                                                 struct{}{},
+	AppRolloutFieldAppManagesOwnNamespaces:                                         struct{}{},
+	AppRolloutFieldAppCompatibilityVersion:                                         struct{}{},
+	AppRolloutFieldAppFindCloudletScoreWeightsDistance:                             struct{}{},
+	AppRolloutFieldAppFindCloudletScoreWeightsLatency:                              struct{}{},
+	AppRolloutFieldAppFindCloudletScoreWeightsResourceUsage:                        struct{}{},
+	AppRolloutFieldAppFindCloudletScoreWeightsHealth:                               struct{}{},
+	AppRolloutFieldAppGeoFencePolicy:                                               struct{}{},
+	AppRolloutFieldAppReplicaAutoScalePolicyMinReplicas:                            struct{}{},
+	AppRolloutFieldAppReplicaAutoScalePolicyMaxReplicas:                            struct{}{},
+	AppRolloutFieldAppReplicaAutoScalePolicyTargetCpu:                              struct{}{},
+	AppRolloutFieldAppReplicaAutoScalePolicyTargetMem:                              struct{}{},
+	AppRolloutFieldAppReplicaAutoScalePolicyTargetActiveConnections:                struct{}{},
+	AppRolloutFieldAppReplicaAutoScalePolicyStabilizationWindowSec:                 struct{}{},
+	AppRolloutFieldAppTagsKey:                                                      struct{}{},
+	AppRolloutFieldAppTagsValue:                                                    struct{}{},
+	AppRolloutFieldWavePercentages:                                                 struct{}{},
+	AppRolloutFieldWavesByZone:                                                     struct{}{},
+	AppRolloutFieldZonesOrganization:                                               struct{}{},
+	AppRolloutFieldZonesName:                                                       struct{}{},
+	AppRolloutFieldZonesFederatedOrganization:                                      struct{}{},
+	AppRolloutFieldWaveSoakTime:                                                    struct{}{},
+	AppRolloutFieldPauseBetweenWaves:                                               struct{}{},
+	AppRolloutFieldAutoRollback:                                                    struct{}{},
+	AppRolloutFieldState:                                                           struct{}{},
+	AppRolloutFieldWavesDone:                                                       struct{}{},
+	AppRolloutFieldWavesAppInstsName:                                               struct{}{},
+	AppRolloutFieldWavesAppInstsOrganization:                                       struct{}{},
+	AppRolloutFieldWavesZoneKeyOrganization:                                        struct{}{},
+	AppRolloutFieldWavesZoneKeyName:                                                struct{}{},
+	AppRolloutFieldWavesZoneKeyFederatedOrganization:                               struct{}{},
+	AppRolloutFieldRevision:                                                        struct{}{},
+	AppRolloutFieldPreviousAppKeyOrganization:                                      struct{}{},
+	AppRolloutFieldPreviousAppKeyName:                                              struct{}{},
+	AppRolloutFieldPreviousAppKeyVersion:                                           struct{}{},
+	AppRolloutFieldPreviousAppImagePath:                                            struct{}{},
+	AppRolloutFieldPreviousAppImageType:                                            struct{}{},
+	AppRolloutFieldPreviousAppAccessPorts:                                          struct{}{},
+	AppRolloutFieldPreviousAppDefaultFlavorName:                                    struct{}{},
+	AppRolloutFieldPreviousAppAuthPublicKey:                                        struct{}{},
+	AppRolloutFieldPreviousAppCommand:                                              struct{}{},
+	AppRolloutFieldPreviousAppAnnotations:                                          struct{}{},
+	AppRolloutFieldPreviousAppDeployment:                                           struct{}{},
+	AppRolloutFieldPreviousAppDeploymentManifest:                                   struct{}{},
+	AppRolloutFieldPreviousAppDeploymentGenerator:                                  struct{}{},
+	AppRolloutFieldPreviousAppAndroidPackageName:                                   struct{}{},
+	AppRolloutFieldPreviousAppDelOpt:                                               struct{}{},
+	AppRolloutFieldPreviousAppConfigsKind:                                          struct{}{},
+	AppRolloutFieldPreviousAppConfigsConfig:                                        struct{}{},
+	AppRolloutFieldPreviousAppScaleWithCluster:                                     struct{}{},
+	AppRolloutFieldPreviousAppInternalPorts:                                        struct{}{},
+	AppRolloutFieldPreviousAppRevision:                                             struct{}{},
+	AppRolloutFieldPreviousAppOfficialFqdn:                                         struct{}{},
+	AppRolloutFieldPreviousAppMd5Sum:                                               struct{}{},
+	AppRolloutFieldPreviousAppAutoProvPolicy:                                       struct{}{},
+	AppRolloutFieldPreviousAppAccessType:                                           struct{}{},
+	AppRolloutFieldPreviousAppDeletePrepare:                                        struct{}{},
+	AppRolloutFieldPreviousAppAutoProvPolicies:                                     struct{}{},
+	AppRolloutFieldPreviousAppTemplateDelimiter:                                    struct{}{},
+	AppRolloutFieldPreviousAppSkipHcPorts:                                          struct{}{},
+	AppRolloutFieldPreviousAppCreatedAtSeconds:                                     struct{}{},
+	AppRolloutFieldPreviousAppCreatedAtNanos:                                       struct{}{},
+	AppRolloutFieldPreviousAppUpdatedAtSeconds:                                     struct{}{},
+	AppRolloutFieldPreviousAppUpdatedAtNanos:                                       struct{}{},
+	AppRolloutFieldPreviousAppTrusted:                                              struct{}{},
+	AppRolloutFieldPreviousAppRequiredOutboundConnectionsProtocol:                  struct{}{},
+	AppRolloutFieldPreviousAppRequiredOutboundConnectionsPortRangeMin:              struct{}{},
+	AppRolloutFieldPreviousAppRequiredOutboundConnectionsPortRangeMax:              struct{}{},
+	AppRolloutFieldPreviousAppRequiredOutboundConnectionsRemoteCidr:                struct{}{},
+	AppRolloutFieldPreviousAppAllowServerless:                                      struct{}{},
+	AppRolloutFieldPreviousAppServerlessConfigVcpusWhole:                           struct{}{},
+	AppRolloutFieldPreviousAppServerlessConfigVcpusNanos:                           struct{}{},
+	AppRolloutFieldPreviousAppServerlessConfigRam:                                  struct{}{},
+	AppRolloutFieldPreviousAppServerlessConfigMinReplicas:                          struct{}{},
+	AppRolloutFieldPreviousAppServerlessConfigGpuConfigType:                        struct{}{},
+	AppRolloutFieldPreviousAppServerlessConfigGpuConfigModel:                       struct{}{},
+	AppRolloutFieldPreviousAppServerlessConfigGpuConfigNumGpu:                      struct{}{},
+	AppRolloutFieldPreviousAppServerlessConfigGpuConfigRam:                         struct{}{},
+	AppRolloutFieldPreviousAppVmAppOsType:                                          struct{}{},
+	AppRolloutFieldPreviousAppAlertPolicies:                                        struct{}{},
+	AppRolloutFieldPreviousAppQosSessionProfile:                                    struct{}{},
+	AppRolloutFieldPreviousAppQosSessionDuration:                                   struct{}{},
+	AppRolloutFieldPreviousAppGlobalId:                                             struct{}{},
+	AppRolloutFieldPreviousAppCommandArgs:                                          struct{}{},
+	AppRolloutFieldPreviousAppEnvVarsKey:                                           struct{}{},
+	AppRolloutFieldPreviousAppEnvVarsValue:                                         struct{}{},
+	AppRolloutFieldPreviousAppSecretEnvVarsKey:                                     struct{}{},
+	AppRolloutFieldPreviousAppSecretEnvVarsValue:                                   struct{}{},
+	AppRolloutFieldPreviousAppUpdateListAction:                                     struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalVcpusWhole:            struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalVcpusNanos:            struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalMemory:                struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalDisk:                  struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalOptResKey:             struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalOptResValue:           struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeVcpus:       struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeMemory:      struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeDisk:        struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeOptResKey:   struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeOptResValue: struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNumberOfNodes:   struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusModelId: struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusCount:   struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusVendor:  struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusMemory:  struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusInUse:   struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusModelId:           struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusCount:             struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusVendor:            struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusMemory:            struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusInUse:             struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalVcpusWhole:            struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalVcpusNanos:            struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalMemory:                struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalDisk:                  struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalOptResKey:             struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalOptResValue:           struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeVcpus:       struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeMemory:      struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeDisk:        struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeOptResKey:   struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeOptResValue: struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNumberOfNodes:   struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusModelId: struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusCount:   struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusVendor:  struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusMemory:  struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusInUse:   struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusModelId:           struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusCount:             struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusVendor:            struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusMemory:            struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusInUse:             struct{}{},
+	AppRolloutFieldPreviousAppKubernetesResourcesMinKubernetesVersion:              struct{}{},
+	AppRolloutFieldPreviousAppNodeResourcesVcpus:                                   struct{}{},
+	AppRolloutFieldPreviousAppNodeResourcesRam:                                     struct{}{},
+	AppRolloutFieldPreviousAppNodeResourcesDisk:                                    struct{}{},
+	AppRolloutFieldPreviousAppNodeResourcesOptResMapKey:                            struct{}{},
+	AppRolloutFieldPreviousAppNodeResourcesOptResMapValue:                          struct{}{},
+	AppRolloutFieldPreviousAppNodeResourcesInfraNodeFlavor:                         struct{}{},
+	AppRolloutFieldPreviousAppNodeResourcesExternalVolumeSize:                      struct{}{},
+	AppRolloutFieldPreviousAppNodeResourcesGpusModelId:                             struct{}{},
+	AppRolloutFieldPreviousAppNodeResourcesGpusCount:                               struct{}{},
+	AppRolloutFieldPreviousAppNodeResourcesGpusVendor:                              struct{}{},
+	AppRolloutFieldPreviousAppNodeResourcesGpusMemory:                              struct{}{},
+	AppRolloutFieldPreviousAppNodeResourcesGpusInUse:                               struct{}{},
+	AppRolloutFieldPreviousAppObjId:                                                struct{}{},
+	AppRolloutFieldPreviousAppAppAnnotationsKey:                                    struct{}{},
+	AppRolloutFieldPreviousAppAppAnnotationsValue:                                  struct{}{},
+	AppRolloutFieldPreviousAppIsStandalone:                                         struct{}{},
+	AppRolloutFieldPreviousAppManagesOwnNamespaces:                                 struct{}{},
+	AppRolloutFieldPreviousAppCompatibilityVersion:                                 struct{}{},
+	AppRolloutFieldPreviousAppFindCloudletScoreWeightsDistance:                     struct{}{},
+	AppRolloutFieldPreviousAppFindCloudletScoreWeightsLatency:                      struct{}{},
+	AppRolloutFieldPreviousAppFindCloudletScoreWeightsResourceUsage:                struct{}{},
+	AppRolloutFieldPreviousAppFindCloudletScoreWeightsHealth:                       struct{}{},
+	AppRolloutFieldPreviousAppGeoFencePolicy:                                       struct{}{},
+	AppRolloutFieldPreviousAppReplicaAutoScalePolicyMinReplicas:                    struct{}{},
+	AppRolloutFieldPreviousAppReplicaAutoScalePolicyMaxReplicas:                    struct{}{},
+	AppRolloutFieldPreviousAppReplicaAutoScalePolicyTargetCpu:                      struct{}{},
+	AppRolloutFieldPreviousAppReplicaAutoScalePolicyTargetMem:                      struct{}{},
+	AppRolloutFieldPreviousAppReplicaAutoScalePolicyTargetActiveConnections:        struct{}{},
+	AppRolloutFieldPreviousAppReplicaAutoScalePolicyStabilizationWindowSec:         struct{}{},
+	AppRolloutFieldPreviousAppTagsKey:                                              struct{}{},
+	AppRolloutFieldPreviousAppTagsValue:                                            struct{}{},
+	AppRolloutFieldErrors:                                                          struct{}{},
+	AppRolloutFieldCreatedAtSeconds:                                                struct{}{},
+	AppRolloutFieldCreatedAtNanos:                                                  struct{}{},
+	AppRolloutFieldUpdatedAtSeconds:                                                struct{}{},
+	AppRolloutFieldUpdatedAtNanos:                                                  struct{}{},
+})
+
+var AppRolloutAllFieldsStringMap = map[string]string{
+	AppRolloutFieldKeyOrganization:                                                 "Key Organization",
+	AppRolloutFieldKeyName:                                                         "Key Name",
+	AppRolloutFieldKeyVersion:                                                      "Key Version",
+	AppRolloutFieldAppKeyOrganization:                                              "App Key Organization",
+	AppRolloutFieldAppKeyName:                                                      "App Key Name",
+	AppRolloutFieldAppKeyVersion:                                                   "App Key Version",
+	AppRolloutFieldAppImagePath:                                                    "App Image Path",
+	AppRolloutFieldAppImageType:                                                    "App Image Type",
+	AppRolloutFieldAppAccessPorts:                                                  "App Access Ports",
+	AppRolloutFieldAppDefaultFlavorName:                                            "App Default Flavor Name",
+	AppRolloutFieldAppAuthPublicKey:                                                "App Auth Public Key",
+	AppRolloutFieldAppCommand:                                                      "App Command",
+	AppRolloutFieldAppAnnotations:                                                  "App Annotations",
+	AppRolloutFieldAppDeployment:                                                   "App Deployment",
+	AppRolloutFieldAppDeploymentManifest:                                           "App Deployment Manifest",
+	AppRolloutFieldAppDeploymentGenerator:                                          "App Deployment Generator",
+	AppRolloutFieldAppAndroidPackageName:                                           "App Android Package Name",
+	AppRolloutFieldAppDelOpt:                                                       "App Del Opt",
+	AppRolloutFieldAppConfigsKind:                                                  "App Configs Kind",
+	AppRolloutFieldAppConfigsConfig:                                                "App Configs Config",
+	AppRolloutFieldAppScaleWithCluster:                                             "App Scale With Cluster",
+	AppRolloutFieldAppInternalPorts:                                                "App Internal Ports",
+	AppRolloutFieldAppRevision:                                                     "App Revision",
+	AppRolloutFieldAppOfficialFqdn:                                                 "App Official Fqdn",
+	AppRolloutFieldAppMd5Sum:                                                       "App Md5 Sum",
+	AppRolloutFieldAppAutoProvPolicy:                                               "App Auto Prov Policy",
+	AppRolloutFieldAppAccessType:                                                   "App Access Type",
+	AppRolloutFieldAppDeletePrepare:                                                "App Delete Prepare",
+	AppRolloutFieldAppAutoProvPolicies:                                             "App Auto Prov Policies",
+	AppRolloutFieldAppTemplateDelimiter:                                            "App Template Delimiter",
+	AppRolloutFieldAppSkipHcPorts:                                                  "App Skip Hc Ports",
+	AppRolloutFieldAppCreatedAtSeconds:                                             "App Created At Seconds",
+	AppRolloutFieldAppCreatedAtNanos:                                               "App Created At Nanos",
+	AppRolloutFieldAppUpdatedAtSeconds:                                             "App Updated At Seconds",
+	AppRolloutFieldAppUpdatedAtNanos:                                               "App Updated At Nanos",
+	AppRolloutFieldAppTrusted:                                                      "App Trusted",
+	AppRolloutFieldAppRequiredOutboundConnectionsProtocol:                          "App Required Outbound Connections Protocol",
+	AppRolloutFieldAppRequiredOutboundConnectionsPortRangeMin:                      "App Required Outbound Connections Port Range Min",
+	AppRolloutFieldAppRequiredOutboundConnectionsPortRangeMax:                      "App Required Outbound Connections Port Range Max",
+	AppRolloutFieldAppRequiredOutboundConnectionsRemoteCidr:                        "App Required Outbound Connections Remote Cidr",
+	AppRolloutFieldAppAllowServerless:                                              "App Allow Serverless",
+	AppRolloutFieldAppServerlessConfigVcpusWhole:                                   "App Serverless Config Vcpus Whole",
+	AppRolloutFieldAppServerlessConfigVcpusNanos:                                   "App Serverless Config Vcpus Nanos",
+	AppRolloutFieldAppServerlessConfigRam:                                          "App Serverless Config Ram",
+	AppRolloutFieldAppServerlessConfigMinReplicas:                                  "App Serverless Config Min Replicas",
+	AppRolloutFieldAppServerlessConfigGpuConfigType:                                "App Serverless Config Gpu Config Type",
+	AppRolloutFieldAppServerlessConfigGpuConfigModel:                               "App Serverless Config Gpu Config Model",
+	AppRolloutFieldAppServerlessConfigGpuConfigNumGpu:                              "App Serverless Config Gpu Config Num Gpu",
+	AppRolloutFieldAppServerlessConfigGpuConfigRam:                                 "App Serverless Config Gpu Config Ram",
+	AppRolloutFieldAppVmAppOsType:                                                  "App Vm App Os Type",
+	AppRolloutFieldAppAlertPolicies:                                                "App Alert Policies",
+	AppRolloutFieldAppQosSessionProfile:                                            "App Qos Session Profile",
+	AppRolloutFieldAppQosSessionDuration:                                           "App Qos Session Duration",
+	AppRolloutFieldAppGlobalId:                                                     "App Global Id",
+	AppRolloutFieldAppCommandArgs:                                                  "App Command Args",
+	AppRolloutFieldAppEnvVarsKey:                                                   "App Env Vars Key",
+	AppRolloutFieldAppEnvVarsValue:                                                 "App Env Vars Value",
+	AppRolloutFieldAppSecretEnvVarsKey:                                             "App Secret Env Vars Key",
+	AppRolloutFieldAppSecretEnvVarsValue:                                           "App Secret Env Vars Value",
+	AppRolloutFieldAppUpdateListAction:                                             "App Update List Action",
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalVcpusWhole:                    "App Kubernetes Resources Cpu Pool Total Vcpus Whole",
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalVcpusNanos:                    "App Kubernetes Resources Cpu Pool Total Vcpus Nanos",
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalMemory:                        "App Kubernetes Resources Cpu Pool Total Memory",
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalDisk:                          "App Kubernetes Resources Cpu Pool Total Disk",
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalOptResKey:                     "App Kubernetes Resources Cpu Pool Total Opt Res Key",
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalOptResValue:                   "App Kubernetes Resources Cpu Pool Total Opt Res Value",
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeVcpus:               "App Kubernetes Resources Cpu Pool Topology Min Node Vcpus",
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeMemory:              "App Kubernetes Resources Cpu Pool Topology Min Node Memory",
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeDisk:                "App Kubernetes Resources Cpu Pool Topology Min Node Disk",
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeOptResKey:           "App Kubernetes Resources Cpu Pool Topology Min Node Opt Res Key",
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeOptResValue:         "App Kubernetes Resources Cpu Pool Topology Min Node Opt Res Value",
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNumberOfNodes:           "App Kubernetes Resources Cpu Pool Topology Min Number Of Nodes",
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusModelId:         "App Kubernetes Resources Cpu Pool Topology Min Node Gpus Model Id",
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusCount:           "App Kubernetes Resources Cpu Pool Topology Min Node Gpus Count",
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusVendor:          "App Kubernetes Resources Cpu Pool Topology Min Node Gpus Vendor",
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusMemory:          "App Kubernetes Resources Cpu Pool Topology Min Node Gpus Memory",
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusInUse:           "App Kubernetes Resources Cpu Pool Topology Min Node Gpus In Use",
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusModelId:                   "App Kubernetes Resources Cpu Pool Total Gpus Model Id",
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusCount:                     "App Kubernetes Resources Cpu Pool Total Gpus Count",
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusVendor:                    "App Kubernetes Resources Cpu Pool Total Gpus Vendor",
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusMemory:                    "App Kubernetes Resources Cpu Pool Total Gpus Memory",
+	AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusInUse:                     "App Kubernetes Resources Cpu Pool Total Gpus In Use",
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalVcpusWhole:                    "App Kubernetes Resources Gpu Pool Total Vcpus Whole",
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalVcpusNanos:                    "App Kubernetes Resources Gpu Pool Total Vcpus Nanos",
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalMemory:                        "App Kubernetes Resources Gpu Pool Total Memory",
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalDisk:                          "App Kubernetes Resources Gpu Pool Total Disk",
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalOptResKey:                     "App Kubernetes Resources Gpu Pool Total Opt Res Key",
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalOptResValue:                   "App Kubernetes Resources Gpu Pool Total Opt Res Value",
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeVcpus:               "App Kubernetes Resources Gpu Pool Topology Min Node Vcpus",
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeMemory:              "App Kubernetes Resources Gpu Pool Topology Min Node Memory",
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeDisk:                "App Kubernetes Resources Gpu Pool Topology Min Node Disk",
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeOptResKey:           "App Kubernetes Resources Gpu Pool Topology Min Node Opt Res Key",
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeOptResValue:         "App Kubernetes Resources Gpu Pool Topology Min Node Opt Res Value",
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNumberOfNodes:           "App Kubernetes Resources Gpu Pool Topology Min Number Of Nodes",
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusModelId:         "App Kubernetes Resources Gpu Pool Topology Min Node Gpus Model Id",
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusCount:           "App Kubernetes Resources Gpu Pool Topology Min Node Gpus Count",
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusVendor:          "App Kubernetes Resources Gpu Pool Topology Min Node Gpus Vendor",
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusMemory:          "App Kubernetes Resources Gpu Pool Topology Min Node Gpus Memory",
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusInUse:           "App Kubernetes Resources Gpu Pool Topology Min Node Gpus In Use",
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusModelId:                   "App Kubernetes Resources Gpu Pool Total Gpus Model Id",
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusCount:                     "App Kubernetes Resources Gpu Pool Total Gpus Count",
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusVendor:                    "App Kubernetes Resources Gpu Pool Total Gpus Vendor",
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusMemory:                    "App Kubernetes Resources Gpu Pool Total Gpus Memory",
+	AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusInUse:                     "App Kubernetes Resources Gpu Pool Total Gpus In Use",
+	AppRolloutFieldAppKubernetesResourcesMinKubernetesVersion:                      "App Kubernetes Resources Min Kubernetes Version",
+	AppRolloutFieldAppNodeResourcesVcpus:                                           "App Node Resources Vcpus",
+	AppRolloutFieldAppNodeResourcesRam:                                             "App Node Resources Ram",
+	AppRolloutFieldAppNodeResourcesDisk:                                            "App Node Resources Disk",
+	AppRolloutFieldAppNodeResourcesOptResMapKey:                                    "App Node Resources Opt Res Map Key",
+	AppRolloutFieldAppNodeResourcesOptResMapValue:                                  "App Node Resources Opt Res Map Value",
+	AppRolloutFieldAppNodeResourcesInfraNodeFlavor:                                 "App Node Resources Infra Node Flavor",
+	AppRolloutFieldAppNodeResourcesExternalVolumeSize:                              "App Node Resources External Volume Size",
+	AppRolloutFieldAppNodeResourcesGpusModelId:                                     "App Node Resources Gpus Model Id",
+	AppRolloutFieldAppNodeResourcesGpusCount:                                       "App Node Resources Gpus Count",
+	AppRolloutFieldAppNodeResourcesGpusVendor:                                      "App Node Resources Gpus Vendor",
+	AppRolloutFieldAppNodeResourcesGpusMemory:                                      "App Node Resources Gpus Memory",
+	AppRolloutFieldAppNodeResourcesGpusInUse:                                       "App Node Resources Gpus In Use",
+	AppRolloutFieldAppObjId:                                                        "App Obj Id",
+	AppRolloutFieldAppAppAnnotationsKey:                                            "App App Annotations Key",
+	AppRolloutFieldAppAppAnnotationsValue:                                          "App App Annotations Value",
+	AppRolloutFieldAppIsStandalone:                                                 "App Is Standalone",
+	AppRolloutFieldAppManagesOwnNamespaces:                                         "App Manages Own Namespaces",
+	AppRolloutFieldAppCompatibilityVersion:                                         "App Compatibility Version",
+	AppRolloutFieldAppFindCloudletScoreWeightsDistance:                             "App Find Cloudlet Score Weights Distance",
+	AppRolloutFieldAppFindCloudletScoreWeightsLatency:                              "App Find Cloudlet Score Weights Latency",
+	AppRolloutFieldAppFindCloudletScoreWeightsResourceUsage:                        "App Find Cloudlet Score Weights Resource Usage",
+	AppRolloutFieldAppFindCloudletScoreWeightsHealth:                               "App Find Cloudlet Score Weights Health",
+	AppRolloutFieldAppGeoFencePolicy:                                               "App Geo Fence Policy",
+	AppRolloutFieldAppReplicaAutoScalePolicyMinReplicas:                            "App Replica Auto Scale Policy Min Replicas",
+	AppRolloutFieldAppReplicaAutoScalePolicyMaxReplicas:                            "App Replica Auto Scale Policy Max Replicas",
+	AppRolloutFieldAppReplicaAutoScalePolicyTargetCpu:                              "App Replica Auto Scale Policy Target Cpu",
+	AppRolloutFieldAppReplicaAutoScalePolicyTargetMem:                              "App Replica Auto Scale Policy Target Mem",
+	AppRolloutFieldAppReplicaAutoScalePolicyTargetActiveConnections:                "App Replica Auto Scale Policy Target Active Connections",
+	AppRolloutFieldAppReplicaAutoScalePolicyStabilizationWindowSec:                 "App Replica Auto Scale Policy Stabilization Window Sec",
+	AppRolloutFieldAppTagsKey:                                                      "App Tags Key",
+	AppRolloutFieldAppTagsValue:                                                    "App Tags Value",
+	AppRolloutFieldWavePercentages:                                                 "Wave Percentages",
+	AppRolloutFieldWavesByZone:                                                     "Waves By Zone",
+	AppRolloutFieldZonesOrganization:                                               "Zones Organization",
+	AppRolloutFieldZonesName:                                                       "Zones Name",
+	AppRolloutFieldZonesFederatedOrganization:                                      "Zones Federated Organization",
+	AppRolloutFieldWaveSoakTime:                                                    "Wave Soak Time",
+	AppRolloutFieldPauseBetweenWaves:                                               "Pause Between Waves",
+	AppRolloutFieldAutoRollback:                                                    "Auto Rollback",
+	AppRolloutFieldState:                                                           "State",
+	AppRolloutFieldWavesDone:                                                       "Waves Done",
+	AppRolloutFieldWavesAppInstsName:                                               "Waves App Insts Name",
+	AppRolloutFieldWavesAppInstsOrganization:                                       "Waves App Insts Organization",
+	AppRolloutFieldWavesZoneKeyOrganization:                                        "Waves Zone Key Organization",
+	AppRolloutFieldWavesZoneKeyName:                                                "Waves Zone Key Name",
+	AppRolloutFieldWavesZoneKeyFederatedOrganization:                               "Waves Zone Key Federated Organization",
+	AppRolloutFieldRevision:                                                        "Revision",
+	AppRolloutFieldPreviousAppKeyOrganization:                                      "Previous App Key Organization",
+	AppRolloutFieldPreviousAppKeyName:                                              "Previous App Key Name",
+	AppRolloutFieldPreviousAppKeyVersion:                                           "Previous App Key Version",
+	AppRolloutFieldPreviousAppImagePath:                                            "Previous App Image Path",
+	AppRolloutFieldPreviousAppImageType:                                            "Previous App Image Type",
+	AppRolloutFieldPreviousAppAccessPorts:                                          "Previous App Access Ports",
+	AppRolloutFieldPreviousAppDefaultFlavorName:                                    "Previous App Default Flavor Name",
+	AppRolloutFieldPreviousAppAuthPublicKey:                                        "Previous App Auth Public Key",
+	AppRolloutFieldPreviousAppCommand:                                              "Previous App Command",
+	AppRolloutFieldPreviousAppAnnotations:                                          "Previous App Annotations",
+	AppRolloutFieldPreviousAppDeployment:                                           "Previous App Deployment",
+	AppRolloutFieldPreviousAppDeploymentManifest:                                   "Previous App Deployment Manifest",
+	AppRolloutFieldPreviousAppDeploymentGenerator:                                  "Previous App Deployment Generator",
+	AppRolloutFieldPreviousAppAndroidPackageName:                                   "Previous App Android Package Name",
+	AppRolloutFieldPreviousAppDelOpt:                                               "Previous App Del Opt",
+	AppRolloutFieldPreviousAppConfigsKind:                                          "Previous App Configs Kind",
+	AppRolloutFieldPreviousAppConfigsConfig:                                        "Previous App Configs Config",
+	AppRolloutFieldPreviousAppScaleWithCluster:                                     "Previous App Scale With Cluster",
+	AppRolloutFieldPreviousAppInternalPorts:                                        "Previous App Internal Ports",
+	AppRolloutFieldPreviousAppRevision:                                             "Previous App Revision",
+	AppRolloutFieldPreviousAppOfficialFqdn:                                         "Previous App Official Fqdn",
+	AppRolloutFieldPreviousAppMd5Sum:                                               "Previous App Md5 Sum",
+	AppRolloutFieldPreviousAppAutoProvPolicy:                                       "Previous App Auto Prov Policy",
+	AppRolloutFieldPreviousAppAccessType:                                           "Previous App Access Type",
+	AppRolloutFieldPreviousAppDeletePrepare:                                        "Previous App Delete Prepare",
+	AppRolloutFieldPreviousAppAutoProvPolicies:                                     "Previous App Auto Prov Policies",
+	AppRolloutFieldPreviousAppTemplateDelimiter:                                    "Previous App Template Delimiter",
+	AppRolloutFieldPreviousAppSkipHcPorts:                                          "Previous App Skip Hc Ports",
+	AppRolloutFieldPreviousAppCreatedAtSeconds:                                     "Previous App Created At Seconds",
+	AppRolloutFieldPreviousAppCreatedAtNanos:                                       "Previous App Created At Nanos",
+	AppRolloutFieldPreviousAppUpdatedAtSeconds:                                     "Previous App Updated At Seconds",
+	AppRolloutFieldPreviousAppUpdatedAtNanos:                                       "Previous App Updated At Nanos",
+	AppRolloutFieldPreviousAppTrusted:                                              "Previous App Trusted",
+	AppRolloutFieldPreviousAppRequiredOutboundConnectionsProtocol:                  "Previous App Required Outbound Connections Protocol",
+	AppRolloutFieldPreviousAppRequiredOutboundConnectionsPortRangeMin:              "Previous App Required Outbound Connections Port Range Min",
+	AppRolloutFieldPreviousAppRequiredOutboundConnectionsPortRangeMax:              "Previous App Required Outbound Connections Port Range Max",
+	AppRolloutFieldPreviousAppRequiredOutboundConnectionsRemoteCidr:                "Previous App Required Outbound Connections Remote Cidr",
+	AppRolloutFieldPreviousAppAllowServerless:                                      "Previous App Allow Serverless",
+	AppRolloutFieldPreviousAppServerlessConfigVcpusWhole:                           "Previous App Serverless Config Vcpus Whole",
+	AppRolloutFieldPreviousAppServerlessConfigVcpusNanos:                           "Previous App Serverless Config Vcpus Nanos",
+	AppRolloutFieldPreviousAppServerlessConfigRam:                                  "Previous App Serverless Config Ram",
+	AppRolloutFieldPreviousAppServerlessConfigMinReplicas:                          "Previous App Serverless Config Min Replicas",
+	AppRolloutFieldPreviousAppServerlessConfigGpuConfigType:                        "Previous App Serverless Config Gpu Config Type",
+	AppRolloutFieldPreviousAppServerlessConfigGpuConfigModel:                       "Previous App Serverless Config Gpu Config Model",
+	AppRolloutFieldPreviousAppServerlessConfigGpuConfigNumGpu:                      "Previous App Serverless Config Gpu Config Num Gpu",
+	AppRolloutFieldPreviousAppServerlessConfigGpuConfigRam:                         "Previous App Serverless Config Gpu Config Ram",
+	AppRolloutFieldPreviousAppVmAppOsType:                                          "Previous App Vm App Os Type",
+	AppRolloutFieldPreviousAppAlertPolicies:                                        "Previous App Alert Policies",
+	AppRolloutFieldPreviousAppQosSessionProfile:                                    "Previous App Qos Session Profile",
+	AppRolloutFieldPreviousAppQosSessionDuration:                                   "Previous App Qos Session Duration",
+	AppRolloutFieldPreviousAppGlobalId:                                             "Previous App Global Id",
+	AppRolloutFieldPreviousAppCommandArgs:                                          "Previous App Command Args",
+	AppRolloutFieldPreviousAppEnvVarsKey:                                           "Previous App Env Vars Key",
+	AppRolloutFieldPreviousAppEnvVarsValue:                                         "Previous App Env Vars Value",
+	AppRolloutFieldPreviousAppSecretEnvVarsKey:                                     "Previous App Secret Env Vars Key",
+	AppRolloutFieldPreviousAppSecretEnvVarsValue:                                   "Previous App Secret Env Vars Value",
+	AppRolloutFieldPreviousAppUpdateListAction:                                     "Previous App Update List Action",
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalVcpusWhole:            "Previous App Kubernetes Resources Cpu Pool Total Vcpus Whole",
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalVcpusNanos:            "Previous App Kubernetes Resources Cpu Pool Total Vcpus Nanos",
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalMemory:                "Previous App Kubernetes Resources Cpu Pool Total Memory",
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalDisk:                  "Previous App Kubernetes Resources Cpu Pool Total Disk",
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalOptResKey:             "Previous App Kubernetes Resources Cpu Pool Total Opt Res Key",
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalOptResValue:           "Previous App Kubernetes Resources Cpu Pool Total Opt Res Value",
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeVcpus:       "Previous App Kubernetes Resources Cpu Pool Topology Min Node Vcpus",
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeMemory:      "Previous App Kubernetes Resources Cpu Pool Topology Min Node Memory",
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeDisk:        "Previous App Kubernetes Resources Cpu Pool Topology Min Node Disk",
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeOptResKey:   "Previous App Kubernetes Resources Cpu Pool Topology Min Node Opt Res Key",
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeOptResValue: "Previous App Kubernetes Resources Cpu Pool Topology Min Node Opt Res Value",
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNumberOfNodes:   "Previous App Kubernetes Resources Cpu Pool Topology Min Number Of Nodes",
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusModelId: "Previous App Kubernetes Resources Cpu Pool Topology Min Node Gpus Model Id",
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusCount:   "Previous App Kubernetes Resources Cpu Pool Topology Min Node Gpus Count",
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusVendor:  "Previous App Kubernetes Resources Cpu Pool Topology Min Node Gpus Vendor",
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusMemory:  "Previous App Kubernetes Resources Cpu Pool Topology Min Node Gpus Memory",
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusInUse:   "Previous App Kubernetes Resources Cpu Pool Topology Min Node Gpus In Use",
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusModelId:           "Previous App Kubernetes Resources Cpu Pool Total Gpus Model Id",
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusCount:             "Previous App Kubernetes Resources Cpu Pool Total Gpus Count",
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusVendor:            "Previous App Kubernetes Resources Cpu Pool Total Gpus Vendor",
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusMemory:            "Previous App Kubernetes Resources Cpu Pool Total Gpus Memory",
+	AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusInUse:             "Previous App Kubernetes Resources Cpu Pool Total Gpus In Use",
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalVcpusWhole:            "Previous App Kubernetes Resources Gpu Pool Total Vcpus Whole",
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalVcpusNanos:            "Previous App Kubernetes Resources Gpu Pool Total Vcpus Nanos",
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalMemory:                "Previous App Kubernetes Resources Gpu Pool Total Memory",
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalDisk:                  "Previous App Kubernetes Resources Gpu Pool Total Disk",
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalOptResKey:             "Previous App Kubernetes Resources Gpu Pool Total Opt Res Key",
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalOptResValue:           "Previous App Kubernetes Resources Gpu Pool Total Opt Res Value",
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeVcpus:       "Previous App Kubernetes Resources Gpu Pool Topology Min Node Vcpus",
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeMemory:      "Previous App Kubernetes Resources Gpu Pool Topology Min Node Memory",
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeDisk:        "Previous App Kubernetes Resources Gpu Pool Topology Min Node Disk",
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeOptResKey:   "Previous App Kubernetes Resources Gpu Pool Topology Min Node Opt Res Key",
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeOptResValue: "Previous App Kubernetes Resources Gpu Pool Topology Min Node Opt Res Value",
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNumberOfNodes:   "Previous App Kubernetes Resources Gpu Pool Topology Min Number Of Nodes",
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusModelId: "Previous App Kubernetes Resources Gpu Pool Topology Min Node Gpus Model Id",
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusCount:   "Previous App Kubernetes Resources Gpu Pool Topology Min Node Gpus Count",
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusVendor:  "Previous App Kubernetes Resources Gpu Pool Topology Min Node Gpus Vendor",
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusMemory:  "Previous App Kubernetes Resources Gpu Pool Topology Min Node Gpus Memory",
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusInUse:   "Previous App Kubernetes Resources Gpu Pool Topology Min Node Gpus In Use",
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusModelId:           "Previous App Kubernetes Resources Gpu Pool Total Gpus Model Id",
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusCount:             "Previous App Kubernetes Resources Gpu Pool Total Gpus Count",
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusVendor:            "Previous App Kubernetes Resources Gpu Pool Total Gpus Vendor",
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusMemory:            "Previous App Kubernetes Resources Gpu Pool Total Gpus Memory",
+	AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusInUse:             "Previous App Kubernetes Resources Gpu Pool Total Gpus In Use",
+	AppRolloutFieldPreviousAppKubernetesResourcesMinKubernetesVersion:              "Previous App Kubernetes Resources Min Kubernetes Version",
+	AppRolloutFieldPreviousAppNodeResourcesVcpus:                                   "Previous App Node Resources Vcpus",
+	AppRolloutFieldPreviousAppNodeResourcesRam:                                     "Previous App Node Resources Ram",
+	AppRolloutFieldPreviousAppNodeResourcesDisk:                                    "Previous App Node Resources Disk",
+	AppRolloutFieldPreviousAppNodeResourcesOptResMapKey:                            "Previous App Node Resources Opt Res Map Key",
+	AppRolloutFieldPreviousAppNodeResourcesOptResMapValue:                          "Previous App Node Resources Opt Res Map Value",
+	AppRolloutFieldPreviousAppNodeResourcesInfraNodeFlavor:                         "Previous App Node Resources Infra Node Flavor",
+	AppRolloutFieldPreviousAppNodeResourcesExternalVolumeSize:                      "Previous App Node Resources External Volume Size",
+	AppRolloutFieldPreviousAppNodeResourcesGpusModelId:                             "Previous App Node Resources Gpus Model Id",
+	AppRolloutFieldPreviousAppNodeResourcesGpusCount:                               "Previous App Node Resources Gpus Count",
+	AppRolloutFieldPreviousAppNodeResourcesGpusVendor:                              "Previous App Node Resources Gpus Vendor",
+	AppRolloutFieldPreviousAppNodeResourcesGpusMemory:                              "Previous App Node Resources Gpus Memory",
+	AppRolloutFieldPreviousAppNodeResourcesGpusInUse:                               "Previous App Node Resources Gpus In Use",
+	AppRolloutFieldPreviousAppObjId:                                                "Previous App Obj Id",
+	AppRolloutFieldPreviousAppAppAnnotationsKey:                                    "Previous App App Annotations Key",
+	AppRolloutFieldPreviousAppAppAnnotationsValue:                                  "Previous App App Annotations Value",
+	AppRolloutFieldPreviousAppIsStandalone:                                         "Previous App Is Standalone",
+	AppRolloutFieldPreviousAppManagesOwnNamespaces:                                 "Previous App Manages Own Namespaces",
+	AppRolloutFieldPreviousAppCompatibilityVersion:                                 "Previous App Compatibility Version",
+	AppRolloutFieldPreviousAppFindCloudletScoreWeightsDistance:                     "Previous App Find Cloudlet Score Weights Distance",
+	AppRolloutFieldPreviousAppFindCloudletScoreWeightsLatency:                      "Previous App Find Cloudlet Score Weights Latency",
+	AppRolloutFieldPreviousAppFindCloudletScoreWeightsResourceUsage:                "Previous App Find Cloudlet Score Weights Resource Usage",
+	AppRolloutFieldPreviousAppFindCloudletScoreWeightsHealth:                       "Previous App Find Cloudlet Score Weights Health",
+	AppRolloutFieldPreviousAppGeoFencePolicy:                                       "Previous App Geo Fence Policy",
+	AppRolloutFieldPreviousAppReplicaAutoScalePolicyMinReplicas:                    "Previous App Replica Auto Scale Policy Min Replicas",
+	AppRolloutFieldPreviousAppReplicaAutoScalePolicyMaxReplicas:                    "Previous App Replica Auto Scale Policy Max Replicas",
+	AppRolloutFieldPreviousAppReplicaAutoScalePolicyTargetCpu:                      "Previous App Replica Auto Scale Policy Target Cpu",
+	AppRolloutFieldPreviousAppReplicaAutoScalePolicyTargetMem:                      "Previous App Replica Auto Scale Policy Target Mem",
+	AppRolloutFieldPreviousAppReplicaAutoScalePolicyTargetActiveConnections:        "Previous App Replica Auto Scale Policy Target Active Connections",
+	AppRolloutFieldPreviousAppReplicaAutoScalePolicyStabilizationWindowSec:         "Previous App Replica Auto Scale Policy Stabilization Window Sec",
+	AppRolloutFieldPreviousAppTagsKey:                                              "Previous App Tags Key",
+	AppRolloutFieldPreviousAppTagsValue:                                            "Previous App Tags Value",
+	AppRolloutFieldErrors:                                                          "Errors",
+	AppRolloutFieldCreatedAtSeconds:                                                "Created At Seconds",
+	AppRolloutFieldCreatedAtNanos:                                                  "Created At Nanos",
+	AppRolloutFieldUpdatedAtSeconds:                                                "Updated At Seconds",
+	AppRolloutFieldUpdatedAtNanos:                                                  "Updated At Nanos",
+}
+
+func (m *AppRollout) IsKeyField(s string) bool {
+	return strings.HasPrefix(s, AppRolloutFieldKey+".") || s == AppRolloutFieldKey
+}
+
+func (m *AppRollout) DiffFields(o *AppRollout, fields *FieldMap) {
+	if m.Key.Organization != o.Key.Organization {
+		fields.Set(AppRolloutFieldKeyOrganization)
+		fields.Set(AppRolloutFieldKey)
+	}
+	if m.Key.Name != o.Key.Name {
+		fields.Set(AppRolloutFieldKeyName)
+		fields.Set(AppRolloutFieldKey)
+	}
+	if m.Key.Version != o.Key.Version {
+		fields.Set(AppRolloutFieldKeyVersion)
+		fields.Set(AppRolloutFieldKey)
+	}
+	if m.App != nil && o.App != nil {
+		if m.App.Key.Organization != o.App.Key.Organization {
+			fields.Set(AppRolloutFieldAppKeyOrganization)
+			fields.Set(AppRolloutFieldAppKey)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.Key.Name != o.App.Key.Name {
+			fields.Set(AppRolloutFieldAppKeyName)
+			fields.Set(AppRolloutFieldAppKey)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.Key.Version != o.App.Key.Version {
+			fields.Set(AppRolloutFieldAppKeyVersion)
+			fields.Set(AppRolloutFieldAppKey)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.ImagePath != o.App.ImagePath {
+			fields.Set(AppRolloutFieldAppImagePath)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.ImageType != o.App.ImageType {
+			fields.Set(AppRolloutFieldAppImageType)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.AccessPorts != o.App.AccessPorts {
+			fields.Set(AppRolloutFieldAppAccessPorts)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.DefaultFlavor.Name != o.App.DefaultFlavor.Name {
+			fields.Set(AppRolloutFieldAppDefaultFlavorName)
+			fields.Set(AppRolloutFieldAppDefaultFlavor)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.AuthPublicKey != o.App.AuthPublicKey {
+			fields.Set(AppRolloutFieldAppAuthPublicKey)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.Command != o.App.Command {
+			fields.Set(AppRolloutFieldAppCommand)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.Annotations != o.App.Annotations {
+			fields.Set(AppRolloutFieldAppAnnotations)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.Deployment != o.App.Deployment {
+			fields.Set(AppRolloutFieldAppDeployment)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.DeploymentManifest != o.App.DeploymentManifest {
+			fields.Set(AppRolloutFieldAppDeploymentManifest)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.DeploymentGenerator != o.App.DeploymentGenerator {
+			fields.Set(AppRolloutFieldAppDeploymentGenerator)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.AndroidPackageName != o.App.AndroidPackageName {
+			fields.Set(AppRolloutFieldAppAndroidPackageName)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.DelOpt != o.App.DelOpt {
+			fields.Set(AppRolloutFieldAppDelOpt)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.Configs != nil && o.App.Configs != nil {
+			if len(m.App.Configs) != len(o.App.Configs) {
+				fields.Set(AppRolloutFieldAppConfigs)
+				fields.Set(AppRolloutFieldApp)
+			} else {
+				for i1 := 0; i1 < len(m.App.Configs); i1++ {
+					if m.App.Configs[i1].Kind != o.App.Configs[i1].Kind {
+						fields.Set(AppRolloutFieldAppConfigsKind)
+						fields.Set(AppRolloutFieldAppConfigs)
+						fields.Set(AppRolloutFieldApp)
+					}
+					if m.App.Configs[i1].Config != o.App.Configs[i1].Config {
+						fields.Set(AppRolloutFieldAppConfigsConfig)
+						fields.Set(AppRolloutFieldAppConfigs)
+						fields.Set(AppRolloutFieldApp)
+					}
+				}
+			}
+		} else if (m.App.Configs != nil && o.App.Configs == nil) || (m.App.Configs == nil && o.App.Configs != nil) {
+			fields.Set(AppRolloutFieldAppConfigs)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.ScaleWithCluster != o.App.ScaleWithCluster {
+			fields.Set(AppRolloutFieldAppScaleWithCluster)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.InternalPorts != o.App.InternalPorts {
+			fields.Set(AppRolloutFieldAppInternalPorts)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.Revision != o.App.Revision {
+			fields.Set(AppRolloutFieldAppRevision)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.OfficialFqdn != o.App.OfficialFqdn {
+			fields.Set(AppRolloutFieldAppOfficialFqdn)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.Md5Sum != o.App.Md5Sum {
+			fields.Set(AppRolloutFieldAppMd5Sum)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.AutoProvPolicy != o.App.AutoProvPolicy {
+			fields.Set(AppRolloutFieldAppAutoProvPolicy)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.AccessType != o.App.AccessType {
+			fields.Set(AppRolloutFieldAppAccessType)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.DeletePrepare != o.App.DeletePrepare {
+			fields.Set(AppRolloutFieldAppDeletePrepare)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if len(m.App.AutoProvPolicies) != len(o.App.AutoProvPolicies) {
+			fields.Set(AppRolloutFieldAppAutoProvPolicies)
+			fields.Set(AppRolloutFieldApp)
+		} else {
+			for i1 := 0; i1 < len(m.App.AutoProvPolicies); i1++ {
+				if m.App.AutoProvPolicies[i1] != o.App.AutoProvPolicies[i1] {
+					fields.Set(AppRolloutFieldAppAutoProvPolicies)
+					fields.Set(AppRolloutFieldApp)
+					break
+				}
+			}
+		}
+		if m.App.TemplateDelimiter != o.App.TemplateDelimiter {
+			fields.Set(AppRolloutFieldAppTemplateDelimiter)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.SkipHcPorts != o.App.SkipHcPorts {
+			fields.Set(AppRolloutFieldAppSkipHcPorts)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.CreatedAt.Seconds != o.App.CreatedAt.Seconds {
+			fields.Set(AppRolloutFieldAppCreatedAtSeconds)
+			fields.Set(AppRolloutFieldAppCreatedAt)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.CreatedAt.Nanos != o.App.CreatedAt.Nanos {
+			fields.Set(AppRolloutFieldAppCreatedAtNanos)
+			fields.Set(AppRolloutFieldAppCreatedAt)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.UpdatedAt.Seconds != o.App.UpdatedAt.Seconds {
+			fields.Set(AppRolloutFieldAppUpdatedAtSeconds)
+			fields.Set(AppRolloutFieldAppUpdatedAt)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.UpdatedAt.Nanos != o.App.UpdatedAt.Nanos {
+			fields.Set(AppRolloutFieldAppUpdatedAtNanos)
+			fields.Set(AppRolloutFieldAppUpdatedAt)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.Trusted != o.App.Trusted {
+			fields.Set(AppRolloutFieldAppTrusted)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if len(m.App.RequiredOutboundConnections) != len(o.App.RequiredOutboundConnections) {
+			fields.Set(AppRolloutFieldAppRequiredOutboundConnections)
+			fields.Set(AppRolloutFieldApp)
+		} else {
+			for i1 := 0; i1 < len(m.App.RequiredOutboundConnections); i1++ {
+				if m.App.RequiredOutboundConnections[i1].Protocol != o.App.RequiredOutboundConnections[i1].Protocol {
+					fields.Set(AppRolloutFieldAppRequiredOutboundConnectionsProtocol)
+					fields.Set(AppRolloutFieldAppRequiredOutboundConnections)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.RequiredOutboundConnections[i1].PortRangeMin != o.App.RequiredOutboundConnections[i1].PortRangeMin {
+					fields.Set(AppRolloutFieldAppRequiredOutboundConnectionsPortRangeMin)
+					fields.Set(AppRolloutFieldAppRequiredOutboundConnections)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.RequiredOutboundConnections[i1].PortRangeMax != o.App.RequiredOutboundConnections[i1].PortRangeMax {
+					fields.Set(AppRolloutFieldAppRequiredOutboundConnectionsPortRangeMax)
+					fields.Set(AppRolloutFieldAppRequiredOutboundConnections)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.RequiredOutboundConnections[i1].RemoteCidr != o.App.RequiredOutboundConnections[i1].RemoteCidr {
+					fields.Set(AppRolloutFieldAppRequiredOutboundConnectionsRemoteCidr)
+					fields.Set(AppRolloutFieldAppRequiredOutboundConnections)
+					fields.Set(AppRolloutFieldApp)
+				}
+			}
+		}
+		if m.App.AllowServerless != o.App.AllowServerless {
+			fields.Set(AppRolloutFieldAppAllowServerless)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.ServerlessConfig != nil && o.App.ServerlessConfig != nil {
+			if m.App.ServerlessConfig.Vcpus.Whole != o.App.ServerlessConfig.Vcpus.Whole {
+				fields.Set(AppRolloutFieldAppServerlessConfigVcpusWhole)
+				fields.Set(AppRolloutFieldAppServerlessConfigVcpus)
+				fields.Set(AppRolloutFieldAppServerlessConfig)
+				fields.Set(AppRolloutFieldApp)
+			}
+			if m.App.ServerlessConfig.Vcpus.Nanos != o.App.ServerlessConfig.Vcpus.Nanos {
+				fields.Set(AppRolloutFieldAppServerlessConfigVcpusNanos)
+				fields.Set(AppRolloutFieldAppServerlessConfigVcpus)
+				fields.Set(AppRolloutFieldAppServerlessConfig)
+				fields.Set(AppRolloutFieldApp)
+			}
+			if m.App.ServerlessConfig.Ram != o.App.ServerlessConfig.Ram {
+				fields.Set(AppRolloutFieldAppServerlessConfigRam)
+				fields.Set(AppRolloutFieldAppServerlessConfig)
+				fields.Set(AppRolloutFieldApp)
+			}
+			if m.App.ServerlessConfig.MinReplicas != o.App.ServerlessConfig.MinReplicas {
+				fields.Set(AppRolloutFieldAppServerlessConfigMinReplicas)
+				fields.Set(AppRolloutFieldAppServerlessConfig)
+				fields.Set(AppRolloutFieldApp)
+			}
+			if m.App.ServerlessConfig.GpuConfig.Type != o.App.ServerlessConfig.GpuConfig.Type {
+				fields.Set(AppRolloutFieldAppServerlessConfigGpuConfigType)
+				fields.Set(AppRolloutFieldAppServerlessConfigGpuConfig)
+				fields.Set(AppRolloutFieldAppServerlessConfig)
+				fields.Set(AppRolloutFieldApp)
+			}
+			if m.App.ServerlessConfig.GpuConfig.Model != o.App.ServerlessConfig.GpuConfig.Model {
+				fields.Set(AppRolloutFieldAppServerlessConfigGpuConfigModel)
+				fields.Set(AppRolloutFieldAppServerlessConfigGpuConfig)
+				fields.Set(AppRolloutFieldAppServerlessConfig)
+				fields.Set(AppRolloutFieldApp)
+			}
+			if m.App.ServerlessConfig.GpuConfig.NumGpu != o.App.ServerlessConfig.GpuConfig.NumGpu {
+				fields.Set(AppRolloutFieldAppServerlessConfigGpuConfigNumGpu)
+				fields.Set(AppRolloutFieldAppServerlessConfigGpuConfig)
+				fields.Set(AppRolloutFieldAppServerlessConfig)
+				fields.Set(AppRolloutFieldApp)
+			}
+			if m.App.ServerlessConfig.GpuConfig.Ram != o.App.ServerlessConfig.GpuConfig.Ram {
+				fields.Set(AppRolloutFieldAppServerlessConfigGpuConfigRam)
+				fields.Set(AppRolloutFieldAppServerlessConfigGpuConfig)
+				fields.Set(AppRolloutFieldAppServerlessConfig)
+				fields.Set(AppRolloutFieldApp)
+			}
+		} else if (m.App.ServerlessConfig != nil && o.App.ServerlessConfig == nil) || (m.App.ServerlessConfig == nil && o.App.ServerlessConfig != nil) {
+			fields.Set(AppRolloutFieldAppServerlessConfig)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.VmAppOsType != o.App.VmAppOsType {
+			fields.Set(AppRolloutFieldAppVmAppOsType)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if len(m.App.AlertPolicies) != len(o.App.AlertPolicies) {
+			fields.Set(AppRolloutFieldAppAlertPolicies)
+			fields.Set(AppRolloutFieldApp)
+		} else {
+			for i1 := 0; i1 < len(m.App.AlertPolicies); i1++ {
+				if m.App.AlertPolicies[i1] != o.App.AlertPolicies[i1] {
+					fields.Set(AppRolloutFieldAppAlertPolicies)
+					fields.Set(AppRolloutFieldApp)
+					break
+				}
+			}
+		}
+		if m.App.QosSessionProfile != o.App.QosSessionProfile {
+			fields.Set(AppRolloutFieldAppQosSessionProfile)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.QosSessionDuration != o.App.QosSessionDuration {
+			fields.Set(AppRolloutFieldAppQosSessionDuration)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.GlobalId != o.App.GlobalId {
+			fields.Set(AppRolloutFieldAppGlobalId)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if len(m.App.CommandArgs) != len(o.App.CommandArgs) {
+			fields.Set(AppRolloutFieldAppCommandArgs)
+			fields.Set(AppRolloutFieldApp)
+		} else {
+			for i1 := 0; i1 < len(m.App.CommandArgs); i1++ {
+				if m.App.CommandArgs[i1] != o.App.CommandArgs[i1] {
+					fields.Set(AppRolloutFieldAppCommandArgs)
+					fields.Set(AppRolloutFieldApp)
+					break
+				}
+			}
+		}
+		if m.App.EnvVars != nil && o.App.EnvVars != nil {
+			if len(m.App.EnvVars) != len(o.App.EnvVars) {
+				fields.Set(AppRolloutFieldAppEnvVars)
+				fields.Set(AppRolloutFieldApp)
+			} else {
+				for k1, _ := range m.App.EnvVars {
+					_, vok1 := o.App.EnvVars[k1]
+					if !vok1 {
+						fields.Set(AppRolloutFieldAppEnvVars)
+						fields.Set(AppRolloutFieldApp)
+					} else {
+						if m.App.EnvVars[k1] != o.App.EnvVars[k1] {
+							fields.Set(AppRolloutFieldAppEnvVars)
+							fields.Set(AppRolloutFieldApp)
+							break
+						}
+					}
+				}
+			}
+		} else if (m.App.EnvVars != nil && o.App.EnvVars == nil) || (m.App.EnvVars == nil && o.App.EnvVars != nil) {
+			fields.Set(AppRolloutFieldAppEnvVars)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.SecretEnvVars != nil && o.App.SecretEnvVars != nil {
+			if len(m.App.SecretEnvVars) != len(o.App.SecretEnvVars) {
+				fields.Set(AppRolloutFieldAppSecretEnvVars)
+				fields.Set(AppRolloutFieldApp)
+			} else {
+				for k1, _ := range m.App.SecretEnvVars {
+					_, vok1 := o.App.SecretEnvVars[k1]
+					if !vok1 {
+						fields.Set(AppRolloutFieldAppSecretEnvVars)
+						fields.Set(AppRolloutFieldApp)
+					} else {
+						if m.App.SecretEnvVars[k1] != o.App.SecretEnvVars[k1] {
+							fields.Set(AppRolloutFieldAppSecretEnvVars)
+							fields.Set(AppRolloutFieldApp)
+							break
+						}
+					}
+				}
+			}
+		} else if (m.App.SecretEnvVars != nil && o.App.SecretEnvVars == nil) || (m.App.SecretEnvVars == nil && o.App.SecretEnvVars != nil) {
+			fields.Set(AppRolloutFieldAppSecretEnvVars)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.UpdateListAction != o.App.UpdateListAction {
+			fields.Set(AppRolloutFieldAppUpdateListAction)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.KubernetesResources != nil && o.App.KubernetesResources != nil {
+			if m.App.KubernetesResources.CpuPool != nil && o.App.KubernetesResources.CpuPool != nil {
+				if m.App.KubernetesResources.CpuPool.TotalVcpus.Whole != o.App.KubernetesResources.CpuPool.TotalVcpus.Whole {
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTotalVcpusWhole)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTotalVcpus)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+					fields.Set(AppRolloutFieldAppKubernetesResources)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.KubernetesResources.CpuPool.TotalVcpus.Nanos != o.App.KubernetesResources.CpuPool.TotalVcpus.Nanos {
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTotalVcpusNanos)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTotalVcpus)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+					fields.Set(AppRolloutFieldAppKubernetesResources)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.KubernetesResources.CpuPool.TotalMemory != o.App.KubernetesResources.CpuPool.TotalMemory {
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTotalMemory)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+					fields.Set(AppRolloutFieldAppKubernetesResources)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.KubernetesResources.CpuPool.TotalDisk != o.App.KubernetesResources.CpuPool.TotalDisk {
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTotalDisk)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+					fields.Set(AppRolloutFieldAppKubernetesResources)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.KubernetesResources.CpuPool.TotalOptRes != nil && o.App.KubernetesResources.CpuPool.TotalOptRes != nil {
+					if len(m.App.KubernetesResources.CpuPool.TotalOptRes) != len(o.App.KubernetesResources.CpuPool.TotalOptRes) {
+						fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTotalOptRes)
+						fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+						fields.Set(AppRolloutFieldAppKubernetesResources)
+						fields.Set(AppRolloutFieldApp)
+					} else {
+						for k3, _ := range m.App.KubernetesResources.CpuPool.TotalOptRes {
+							_, vok3 := o.App.KubernetesResources.CpuPool.TotalOptRes[k3]
+							if !vok3 {
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTotalOptRes)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+								fields.Set(AppRolloutFieldAppKubernetesResources)
+								fields.Set(AppRolloutFieldApp)
+							} else {
+								if m.App.KubernetesResources.CpuPool.TotalOptRes[k3] != o.App.KubernetesResources.CpuPool.TotalOptRes[k3] {
+									fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTotalOptRes)
+									fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+									fields.Set(AppRolloutFieldAppKubernetesResources)
+									fields.Set(AppRolloutFieldApp)
+									break
+								}
+							}
+						}
+					}
+				} else if (m.App.KubernetesResources.CpuPool.TotalOptRes != nil && o.App.KubernetesResources.CpuPool.TotalOptRes == nil) || (m.App.KubernetesResources.CpuPool.TotalOptRes == nil && o.App.KubernetesResources.CpuPool.TotalOptRes != nil) {
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTotalOptRes)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+					fields.Set(AppRolloutFieldAppKubernetesResources)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.KubernetesResources.CpuPool.Topology.MinNodeVcpus != o.App.KubernetesResources.CpuPool.Topology.MinNodeVcpus {
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeVcpus)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopology)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+					fields.Set(AppRolloutFieldAppKubernetesResources)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.KubernetesResources.CpuPool.Topology.MinNodeMemory != o.App.KubernetesResources.CpuPool.Topology.MinNodeMemory {
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeMemory)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopology)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+					fields.Set(AppRolloutFieldAppKubernetesResources)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.KubernetesResources.CpuPool.Topology.MinNodeDisk != o.App.KubernetesResources.CpuPool.Topology.MinNodeDisk {
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeDisk)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopology)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+					fields.Set(AppRolloutFieldAppKubernetesResources)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.KubernetesResources.CpuPool.Topology.MinNodeOptRes != nil && o.App.KubernetesResources.CpuPool.Topology.MinNodeOptRes != nil {
+					if len(m.App.KubernetesResources.CpuPool.Topology.MinNodeOptRes) != len(o.App.KubernetesResources.CpuPool.Topology.MinNodeOptRes) {
+						fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeOptRes)
+						fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopology)
+						fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+						fields.Set(AppRolloutFieldAppKubernetesResources)
+						fields.Set(AppRolloutFieldApp)
+					} else {
+						for k4, _ := range m.App.KubernetesResources.CpuPool.Topology.MinNodeOptRes {
+							_, vok4 := o.App.KubernetesResources.CpuPool.Topology.MinNodeOptRes[k4]
+							if !vok4 {
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeOptRes)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopology)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+								fields.Set(AppRolloutFieldAppKubernetesResources)
+								fields.Set(AppRolloutFieldApp)
+							} else {
+								if m.App.KubernetesResources.CpuPool.Topology.MinNodeOptRes[k4] != o.App.KubernetesResources.CpuPool.Topology.MinNodeOptRes[k4] {
+									fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeOptRes)
+									fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopology)
+									fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+									fields.Set(AppRolloutFieldAppKubernetesResources)
+									fields.Set(AppRolloutFieldApp)
+									break
+								}
+							}
+						}
+					}
+				} else if (m.App.KubernetesResources.CpuPool.Topology.MinNodeOptRes != nil && o.App.KubernetesResources.CpuPool.Topology.MinNodeOptRes == nil) || (m.App.KubernetesResources.CpuPool.Topology.MinNodeOptRes == nil && o.App.KubernetesResources.CpuPool.Topology.MinNodeOptRes != nil) {
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeOptRes)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopology)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+					fields.Set(AppRolloutFieldAppKubernetesResources)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.KubernetesResources.CpuPool.Topology.MinNumberOfNodes != o.App.KubernetesResources.CpuPool.Topology.MinNumberOfNodes {
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNumberOfNodes)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopology)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+					fields.Set(AppRolloutFieldAppKubernetesResources)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.KubernetesResources.CpuPool.Topology.MinNodeGpus != nil && o.App.KubernetesResources.CpuPool.Topology.MinNodeGpus != nil {
+					if len(m.App.KubernetesResources.CpuPool.Topology.MinNodeGpus) != len(o.App.KubernetesResources.CpuPool.Topology.MinNodeGpus) {
+						fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpus)
+						fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopology)
+						fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+						fields.Set(AppRolloutFieldAppKubernetesResources)
+						fields.Set(AppRolloutFieldApp)
+					} else {
+						for i4 := 0; i4 < len(m.App.KubernetesResources.CpuPool.Topology.MinNodeGpus); i4++ {
+							if m.App.KubernetesResources.CpuPool.Topology.MinNodeGpus[i4].ModelId != o.App.KubernetesResources.CpuPool.Topology.MinNodeGpus[i4].ModelId {
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusModelId)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpus)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopology)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+								fields.Set(AppRolloutFieldAppKubernetesResources)
+								fields.Set(AppRolloutFieldApp)
+							}
+							if m.App.KubernetesResources.CpuPool.Topology.MinNodeGpus[i4].Count != o.App.KubernetesResources.CpuPool.Topology.MinNodeGpus[i4].Count {
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusCount)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpus)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopology)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+								fields.Set(AppRolloutFieldAppKubernetesResources)
+								fields.Set(AppRolloutFieldApp)
+							}
+							if m.App.KubernetesResources.CpuPool.Topology.MinNodeGpus[i4].Vendor != o.App.KubernetesResources.CpuPool.Topology.MinNodeGpus[i4].Vendor {
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusVendor)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpus)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopology)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+								fields.Set(AppRolloutFieldAppKubernetesResources)
+								fields.Set(AppRolloutFieldApp)
+							}
+							if m.App.KubernetesResources.CpuPool.Topology.MinNodeGpus[i4].Memory != o.App.KubernetesResources.CpuPool.Topology.MinNodeGpus[i4].Memory {
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusMemory)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpus)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopology)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+								fields.Set(AppRolloutFieldAppKubernetesResources)
+								fields.Set(AppRolloutFieldApp)
+							}
+							if m.App.KubernetesResources.CpuPool.Topology.MinNodeGpus[i4].InUse != o.App.KubernetesResources.CpuPool.Topology.MinNodeGpus[i4].InUse {
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpusInUse)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpus)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopology)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+								fields.Set(AppRolloutFieldAppKubernetesResources)
+								fields.Set(AppRolloutFieldApp)
+							}
+						}
+					}
+				} else if (m.App.KubernetesResources.CpuPool.Topology.MinNodeGpus != nil && o.App.KubernetesResources.CpuPool.Topology.MinNodeGpus == nil) || (m.App.KubernetesResources.CpuPool.Topology.MinNodeGpus == nil && o.App.KubernetesResources.CpuPool.Topology.MinNodeGpus != nil) {
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopologyMinNodeGpus)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTopology)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+					fields.Set(AppRolloutFieldAppKubernetesResources)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.KubernetesResources.CpuPool.TotalGpus != nil && o.App.KubernetesResources.CpuPool.TotalGpus != nil {
+					if len(m.App.KubernetesResources.CpuPool.TotalGpus) != len(o.App.KubernetesResources.CpuPool.TotalGpus) {
+						fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpus)
+						fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+						fields.Set(AppRolloutFieldAppKubernetesResources)
+						fields.Set(AppRolloutFieldApp)
+					} else {
+						for i3 := 0; i3 < len(m.App.KubernetesResources.CpuPool.TotalGpus); i3++ {
+							if m.App.KubernetesResources.CpuPool.TotalGpus[i3].ModelId != o.App.KubernetesResources.CpuPool.TotalGpus[i3].ModelId {
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusModelId)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpus)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+								fields.Set(AppRolloutFieldAppKubernetesResources)
+								fields.Set(AppRolloutFieldApp)
+							}
+							if m.App.KubernetesResources.CpuPool.TotalGpus[i3].Count != o.App.KubernetesResources.CpuPool.TotalGpus[i3].Count {
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusCount)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpus)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+								fields.Set(AppRolloutFieldAppKubernetesResources)
+								fields.Set(AppRolloutFieldApp)
+							}
+							if m.App.KubernetesResources.CpuPool.TotalGpus[i3].Vendor != o.App.KubernetesResources.CpuPool.TotalGpus[i3].Vendor {
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusVendor)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpus)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+								fields.Set(AppRolloutFieldAppKubernetesResources)
+								fields.Set(AppRolloutFieldApp)
+							}
+							if m.App.KubernetesResources.CpuPool.TotalGpus[i3].Memory != o.App.KubernetesResources.CpuPool.TotalGpus[i3].Memory {
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusMemory)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpus)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+								fields.Set(AppRolloutFieldAppKubernetesResources)
+								fields.Set(AppRolloutFieldApp)
+							}
+							if m.App.KubernetesResources.CpuPool.TotalGpus[i3].InUse != o.App.KubernetesResources.CpuPool.TotalGpus[i3].InUse {
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpusInUse)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpus)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+								fields.Set(AppRolloutFieldAppKubernetesResources)
+								fields.Set(AppRolloutFieldApp)
+							}
+						}
+					}
+				} else if (m.App.KubernetesResources.CpuPool.TotalGpus != nil && o.App.KubernetesResources.CpuPool.TotalGpus == nil) || (m.App.KubernetesResources.CpuPool.TotalGpus == nil && o.App.KubernetesResources.CpuPool.TotalGpus != nil) {
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPoolTotalGpus)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+					fields.Set(AppRolloutFieldAppKubernetesResources)
+					fields.Set(AppRolloutFieldApp)
+				}
+			} else if (m.App.KubernetesResources.CpuPool != nil && o.App.KubernetesResources.CpuPool == nil) || (m.App.KubernetesResources.CpuPool == nil && o.App.KubernetesResources.CpuPool != nil) {
+				fields.Set(AppRolloutFieldAppKubernetesResourcesCpuPool)
+				fields.Set(AppRolloutFieldAppKubernetesResources)
+				fields.Set(AppRolloutFieldApp)
+			}
+			if m.App.KubernetesResources.GpuPool != nil && o.App.KubernetesResources.GpuPool != nil {
+				if m.App.KubernetesResources.GpuPool.TotalVcpus.Whole != o.App.KubernetesResources.GpuPool.TotalVcpus.Whole {
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTotalVcpusWhole)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTotalVcpus)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+					fields.Set(AppRolloutFieldAppKubernetesResources)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.KubernetesResources.GpuPool.TotalVcpus.Nanos != o.App.KubernetesResources.GpuPool.TotalVcpus.Nanos {
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTotalVcpusNanos)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTotalVcpus)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+					fields.Set(AppRolloutFieldAppKubernetesResources)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.KubernetesResources.GpuPool.TotalMemory != o.App.KubernetesResources.GpuPool.TotalMemory {
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTotalMemory)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+					fields.Set(AppRolloutFieldAppKubernetesResources)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.KubernetesResources.GpuPool.TotalDisk != o.App.KubernetesResources.GpuPool.TotalDisk {
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTotalDisk)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+					fields.Set(AppRolloutFieldAppKubernetesResources)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.KubernetesResources.GpuPool.TotalOptRes != nil && o.App.KubernetesResources.GpuPool.TotalOptRes != nil {
+					if len(m.App.KubernetesResources.GpuPool.TotalOptRes) != len(o.App.KubernetesResources.GpuPool.TotalOptRes) {
+						fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTotalOptRes)
+						fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+						fields.Set(AppRolloutFieldAppKubernetesResources)
+						fields.Set(AppRolloutFieldApp)
+					} else {
+						for k3, _ := range m.App.KubernetesResources.GpuPool.TotalOptRes {
+							_, vok3 := o.App.KubernetesResources.GpuPool.TotalOptRes[k3]
+							if !vok3 {
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTotalOptRes)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+								fields.Set(AppRolloutFieldAppKubernetesResources)
+								fields.Set(AppRolloutFieldApp)
+							} else {
+								if m.App.KubernetesResources.GpuPool.TotalOptRes[k3] != o.App.KubernetesResources.GpuPool.TotalOptRes[k3] {
+									fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTotalOptRes)
+									fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+									fields.Set(AppRolloutFieldAppKubernetesResources)
+									fields.Set(AppRolloutFieldApp)
+									break
+								}
+							}
+						}
+					}
+				} else if (m.App.KubernetesResources.GpuPool.TotalOptRes != nil && o.App.KubernetesResources.GpuPool.TotalOptRes == nil) || (m.App.KubernetesResources.GpuPool.TotalOptRes == nil && o.App.KubernetesResources.GpuPool.TotalOptRes != nil) {
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTotalOptRes)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+					fields.Set(AppRolloutFieldAppKubernetesResources)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.KubernetesResources.GpuPool.Topology.MinNodeVcpus != o.App.KubernetesResources.GpuPool.Topology.MinNodeVcpus {
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeVcpus)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopology)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+					fields.Set(AppRolloutFieldAppKubernetesResources)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.KubernetesResources.GpuPool.Topology.MinNodeMemory != o.App.KubernetesResources.GpuPool.Topology.MinNodeMemory {
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeMemory)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopology)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+					fields.Set(AppRolloutFieldAppKubernetesResources)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.KubernetesResources.GpuPool.Topology.MinNodeDisk != o.App.KubernetesResources.GpuPool.Topology.MinNodeDisk {
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeDisk)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopology)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+					fields.Set(AppRolloutFieldAppKubernetesResources)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.KubernetesResources.GpuPool.Topology.MinNodeOptRes != nil && o.App.KubernetesResources.GpuPool.Topology.MinNodeOptRes != nil {
+					if len(m.App.KubernetesResources.GpuPool.Topology.MinNodeOptRes) != len(o.App.KubernetesResources.GpuPool.Topology.MinNodeOptRes) {
+						fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeOptRes)
+						fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopology)
+						fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+						fields.Set(AppRolloutFieldAppKubernetesResources)
+						fields.Set(AppRolloutFieldApp)
+					} else {
+						for k4, _ := range m.App.KubernetesResources.GpuPool.Topology.MinNodeOptRes {
+							_, vok4 := o.App.KubernetesResources.GpuPool.Topology.MinNodeOptRes[k4]
+							if !vok4 {
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeOptRes)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopology)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+								fields.Set(AppRolloutFieldAppKubernetesResources)
+								fields.Set(AppRolloutFieldApp)
+							} else {
+								if m.App.KubernetesResources.GpuPool.Topology.MinNodeOptRes[k4] != o.App.KubernetesResources.GpuPool.Topology.MinNodeOptRes[k4] {
+									fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeOptRes)
+									fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopology)
+									fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+									fields.Set(AppRolloutFieldAppKubernetesResources)
+									fields.Set(AppRolloutFieldApp)
+									break
+								}
+							}
+						}
+					}
+				} else if (m.App.KubernetesResources.GpuPool.Topology.MinNodeOptRes != nil && o.App.KubernetesResources.GpuPool.Topology.MinNodeOptRes == nil) || (m.App.KubernetesResources.GpuPool.Topology.MinNodeOptRes == nil && o.App.KubernetesResources.GpuPool.Topology.MinNodeOptRes != nil) {
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeOptRes)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopology)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+					fields.Set(AppRolloutFieldAppKubernetesResources)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.KubernetesResources.GpuPool.Topology.MinNumberOfNodes != o.App.KubernetesResources.GpuPool.Topology.MinNumberOfNodes {
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNumberOfNodes)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopology)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+					fields.Set(AppRolloutFieldAppKubernetesResources)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.KubernetesResources.GpuPool.Topology.MinNodeGpus != nil && o.App.KubernetesResources.GpuPool.Topology.MinNodeGpus != nil {
+					if len(m.App.KubernetesResources.GpuPool.Topology.MinNodeGpus) != len(o.App.KubernetesResources.GpuPool.Topology.MinNodeGpus) {
+						fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpus)
+						fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopology)
+						fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+						fields.Set(AppRolloutFieldAppKubernetesResources)
+						fields.Set(AppRolloutFieldApp)
+					} else {
+						for i4 := 0; i4 < len(m.App.KubernetesResources.GpuPool.Topology.MinNodeGpus); i4++ {
+							if m.App.KubernetesResources.GpuPool.Topology.MinNodeGpus[i4].ModelId != o.App.KubernetesResources.GpuPool.Topology.MinNodeGpus[i4].ModelId {
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusModelId)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpus)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopology)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+								fields.Set(AppRolloutFieldAppKubernetesResources)
+								fields.Set(AppRolloutFieldApp)
+							}
+							if m.App.KubernetesResources.GpuPool.Topology.MinNodeGpus[i4].Count != o.App.KubernetesResources.GpuPool.Topology.MinNodeGpus[i4].Count {
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusCount)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpus)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopology)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+								fields.Set(AppRolloutFieldAppKubernetesResources)
+								fields.Set(AppRolloutFieldApp)
+							}
+							if m.App.KubernetesResources.GpuPool.Topology.MinNodeGpus[i4].Vendor != o.App.KubernetesResources.GpuPool.Topology.MinNodeGpus[i4].Vendor {
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusVendor)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpus)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopology)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+								fields.Set(AppRolloutFieldAppKubernetesResources)
+								fields.Set(AppRolloutFieldApp)
+							}
+							if m.App.KubernetesResources.GpuPool.Topology.MinNodeGpus[i4].Memory != o.App.KubernetesResources.GpuPool.Topology.MinNodeGpus[i4].Memory {
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusMemory)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpus)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopology)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+								fields.Set(AppRolloutFieldAppKubernetesResources)
+								fields.Set(AppRolloutFieldApp)
+							}
+							if m.App.KubernetesResources.GpuPool.Topology.MinNodeGpus[i4].InUse != o.App.KubernetesResources.GpuPool.Topology.MinNodeGpus[i4].InUse {
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpusInUse)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpus)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopology)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+								fields.Set(AppRolloutFieldAppKubernetesResources)
+								fields.Set(AppRolloutFieldApp)
+							}
+						}
+					}
+				} else if (m.App.KubernetesResources.GpuPool.Topology.MinNodeGpus != nil && o.App.KubernetesResources.GpuPool.Topology.MinNodeGpus == nil) || (m.App.KubernetesResources.GpuPool.Topology.MinNodeGpus == nil && o.App.KubernetesResources.GpuPool.Topology.MinNodeGpus != nil) {
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopologyMinNodeGpus)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTopology)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+					fields.Set(AppRolloutFieldAppKubernetesResources)
+					fields.Set(AppRolloutFieldApp)
+				}
+				if m.App.KubernetesResources.GpuPool.TotalGpus != nil && o.App.KubernetesResources.GpuPool.TotalGpus != nil {
+					if len(m.App.KubernetesResources.GpuPool.TotalGpus) != len(o.App.KubernetesResources.GpuPool.TotalGpus) {
+						fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpus)
+						fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+						fields.Set(AppRolloutFieldAppKubernetesResources)
+						fields.Set(AppRolloutFieldApp)
+					} else {
+						for i3 := 0; i3 < len(m.App.KubernetesResources.GpuPool.TotalGpus); i3++ {
+							if m.App.KubernetesResources.GpuPool.TotalGpus[i3].ModelId != o.App.KubernetesResources.GpuPool.TotalGpus[i3].ModelId {
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusModelId)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpus)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+								fields.Set(AppRolloutFieldAppKubernetesResources)
+								fields.Set(AppRolloutFieldApp)
+							}
+							if m.App.KubernetesResources.GpuPool.TotalGpus[i3].Count != o.App.KubernetesResources.GpuPool.TotalGpus[i3].Count {
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusCount)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpus)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+								fields.Set(AppRolloutFieldAppKubernetesResources)
+								fields.Set(AppRolloutFieldApp)
+							}
+							if m.App.KubernetesResources.GpuPool.TotalGpus[i3].Vendor != o.App.KubernetesResources.GpuPool.TotalGpus[i3].Vendor {
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusVendor)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpus)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+								fields.Set(AppRolloutFieldAppKubernetesResources)
+								fields.Set(AppRolloutFieldApp)
+							}
+							if m.App.KubernetesResources.GpuPool.TotalGpus[i3].Memory != o.App.KubernetesResources.GpuPool.TotalGpus[i3].Memory {
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusMemory)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpus)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+								fields.Set(AppRolloutFieldAppKubernetesResources)
+								fields.Set(AppRolloutFieldApp)
+							}
+							if m.App.KubernetesResources.GpuPool.TotalGpus[i3].InUse != o.App.KubernetesResources.GpuPool.TotalGpus[i3].InUse {
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpusInUse)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpus)
+								fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+								fields.Set(AppRolloutFieldAppKubernetesResources)
+								fields.Set(AppRolloutFieldApp)
+							}
+						}
+					}
+				} else if (m.App.KubernetesResources.GpuPool.TotalGpus != nil && o.App.KubernetesResources.GpuPool.TotalGpus == nil) || (m.App.KubernetesResources.GpuPool.TotalGpus == nil && o.App.KubernetesResources.GpuPool.TotalGpus != nil) {
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPoolTotalGpus)
+					fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+					fields.Set(AppRolloutFieldAppKubernetesResources)
+					fields.Set(AppRolloutFieldApp)
+				}
+			} else if (m.App.KubernetesResources.GpuPool != nil && o.App.KubernetesResources.GpuPool == nil) || (m.App.KubernetesResources.GpuPool == nil && o.App.KubernetesResources.GpuPool != nil) {
+				fields.Set(AppRolloutFieldAppKubernetesResourcesGpuPool)
+				fields.Set(AppRolloutFieldAppKubernetesResources)
+				fields.Set(AppRolloutFieldApp)
+			}
+			if m.App.KubernetesResources.MinKubernetesVersion != o.App.KubernetesResources.MinKubernetesVersion {
+				fields.Set(AppRolloutFieldAppKubernetesResourcesMinKubernetesVersion)
+				fields.Set(AppRolloutFieldAppKubernetesResources)
+				fields.Set(AppRolloutFieldApp)
+			}
+		} else if (m.App.KubernetesResources != nil && o.App.KubernetesResources == nil) || (m.App.KubernetesResources == nil && o.App.KubernetesResources != nil) {
+			fields.Set(AppRolloutFieldAppKubernetesResources)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.NodeResources != nil && o.App.NodeResources != nil {
+			if m.App.NodeResources.Vcpus != o.App.NodeResources.Vcpus {
+				fields.Set(AppRolloutFieldAppNodeResourcesVcpus)
+				fields.Set(AppRolloutFieldAppNodeResources)
+				fields.Set(AppRolloutFieldApp)
+			}
+			if m.App.NodeResources.Ram != o.App.NodeResources.Ram {
+				fields.Set(AppRolloutFieldAppNodeResourcesRam)
+				fields.Set(AppRolloutFieldAppNodeResources)
+				fields.Set(AppRolloutFieldApp)
+			}
+			if m.App.NodeResources.Disk != o.App.NodeResources.Disk {
+				fields.Set(AppRolloutFieldAppNodeResourcesDisk)
+				fields.Set(AppRolloutFieldAppNodeResources)
+				fields.Set(AppRolloutFieldApp)
+			}
+			if m.App.NodeResources.OptResMap != nil && o.App.NodeResources.OptResMap != nil {
+				if len(m.App.NodeResources.OptResMap) != len(o.App.NodeResources.OptResMap) {
+					fields.Set(AppRolloutFieldAppNodeResourcesOptResMap)
+					fields.Set(AppRolloutFieldAppNodeResources)
+					fields.Set(AppRolloutFieldApp)
+				} else {
+					for k2, _ := range m.App.NodeResources.OptResMap {
+						_, vok2 := o.App.NodeResources.OptResMap[k2]
+						if !vok2 {
+							fields.Set(AppRolloutFieldAppNodeResourcesOptResMap)
+							fields.Set(AppRolloutFieldAppNodeResources)
+							fields.Set(AppRolloutFieldApp)
+						} else {
+							if m.App.NodeResources.OptResMap[k2] != o.App.NodeResources.OptResMap[k2] {
+								fields.Set(AppRolloutFieldAppNodeResourcesOptResMap)
+								fields.Set(AppRolloutFieldAppNodeResources)
+								fields.Set(AppRolloutFieldApp)
+								break
+							}
+						}
+					}
+				}
+			} else if (m.App.NodeResources.OptResMap != nil && o.App.NodeResources.OptResMap == nil) || (m.App.NodeResources.OptResMap == nil && o.App.NodeResources.OptResMap != nil) {
+				fields.Set(AppRolloutFieldAppNodeResourcesOptResMap)
+				fields.Set(AppRolloutFieldAppNodeResources)
+				fields.Set(AppRolloutFieldApp)
+			}
+			if m.App.NodeResources.InfraNodeFlavor != o.App.NodeResources.InfraNodeFlavor {
+				fields.Set(AppRolloutFieldAppNodeResourcesInfraNodeFlavor)
+				fields.Set(AppRolloutFieldAppNodeResources)
+				fields.Set(AppRolloutFieldApp)
+			}
+			if m.App.NodeResources.ExternalVolumeSize != o.App.NodeResources.ExternalVolumeSize {
+				fields.Set(AppRolloutFieldAppNodeResourcesExternalVolumeSize)
+				fields.Set(AppRolloutFieldAppNodeResources)
+				fields.Set(AppRolloutFieldApp)
+			}
+			if m.App.NodeResources.Gpus != nil && o.App.NodeResources.Gpus != nil {
+				if len(m.App.NodeResources.Gpus) != len(o.App.NodeResources.Gpus) {
+					fields.Set(AppRolloutFieldAppNodeResourcesGpus)
+					fields.Set(AppRolloutFieldAppNodeResources)
+					fields.Set(AppRolloutFieldApp)
+				} else {
+					for i2 := 0; i2 < len(m.App.NodeResources.Gpus); i2++ {
+						if m.App.NodeResources.Gpus[i2].ModelId != o.App.NodeResources.Gpus[i2].ModelId {
+							fields.Set(AppRolloutFieldAppNodeResourcesGpusModelId)
+							fields.Set(AppRolloutFieldAppNodeResourcesGpus)
+							fields.Set(AppRolloutFieldAppNodeResources)
+							fields.Set(AppRolloutFieldApp)
+						}
+						if m.App.NodeResources.Gpus[i2].Count != o.App.NodeResources.Gpus[i2].Count {
+							fields.Set(AppRolloutFieldAppNodeResourcesGpusCount)
+							fields.Set(AppRolloutFieldAppNodeResourcesGpus)
+							fields.Set(AppRolloutFieldAppNodeResources)
+							fields.Set(AppRolloutFieldApp)
+						}
+						if m.App.NodeResources.Gpus[i2].Vendor != o.App.NodeResources.Gpus[i2].Vendor {
+							fields.Set(AppRolloutFieldAppNodeResourcesGpusVendor)
+							fields.Set(AppRolloutFieldAppNodeResourcesGpus)
+							fields.Set(AppRolloutFieldAppNodeResources)
+							fields.Set(AppRolloutFieldApp)
+						}
+						if m.App.NodeResources.Gpus[i2].Memory != o.App.NodeResources.Gpus[i2].Memory {
+							fields.Set(AppRolloutFieldAppNodeResourcesGpusMemory)
+							fields.Set(AppRolloutFieldAppNodeResourcesGpus)
+							fields.Set(AppRolloutFieldAppNodeResources)
+							fields.Set(AppRolloutFieldApp)
+						}
+						if m.App.NodeResources.Gpus[i2].InUse != o.App.NodeResources.Gpus[i2].InUse {
+							fields.Set(AppRolloutFieldAppNodeResourcesGpusInUse)
+							fields.Set(AppRolloutFieldAppNodeResourcesGpus)
+							fields.Set(AppRolloutFieldAppNodeResources)
+							fields.Set(AppRolloutFieldApp)
+						}
+					}
+				}
+			} else if (m.App.NodeResources.Gpus != nil && o.App.NodeResources.Gpus == nil) || (m.App.NodeResources.Gpus == nil && o.App.NodeResources.Gpus != nil) {
+				fields.Set(AppRolloutFieldAppNodeResourcesGpus)
+				fields.Set(AppRolloutFieldAppNodeResources)
+				fields.Set(AppRolloutFieldApp)
+			}
+		} else if (m.App.NodeResources != nil && o.App.NodeResources == nil) || (m.App.NodeResources == nil && o.App.NodeResources != nil) {
+			fields.Set(AppRolloutFieldAppNodeResources)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.ObjId != o.App.ObjId {
+			fields.Set(AppRolloutFieldAppObjId)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.AppAnnotations != nil && o.App.AppAnnotations != nil {
+			if len(m.App.AppAnnotations) != len(o.App.AppAnnotations) {
+				fields.Set(AppRolloutFieldAppAppAnnotations)
+				fields.Set(AppRolloutFieldApp)
+			} else {
+				for k1, _ := range m.App.AppAnnotations {
+					_, vok1 := o.App.AppAnnotations[k1]
+					if !vok1 {
+						fields.Set(AppRolloutFieldAppAppAnnotations)
+						fields.Set(AppRolloutFieldApp)
+					} else {
+						if m.App.AppAnnotations[k1] != o.App.AppAnnotations[k1] {
+							fields.Set(AppRolloutFieldAppAppAnnotations)
+							fields.Set(AppRolloutFieldApp)
+							break
+						}
+					}
+				}
+			}
+		} else if (m.App.AppAnnotations != nil && o.App.AppAnnotations == nil) || (m.App.AppAnnotations == nil && o.App.AppAnnotations != nil) {
+			fields.Set(AppRolloutFieldAppAppAnnotations)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.IsStandalone != o.App.IsStandalone {
+			fields.Set(AppRolloutFieldAppIsStandalone)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.ManagesOwnNamespaces != o.App.ManagesOwnNamespaces {
+			fields.Set(AppRolloutFieldAppManagesOwnNamespaces)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.CompatibilityVersion != o.App.CompatibilityVersion {
+			fields.Set(AppRolloutFieldAppCompatibilityVersion)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.FindCloudletScoreWeights != nil && o.App.FindCloudletScoreWeights != nil {
+			if m.App.FindCloudletScoreWeights.Distance != o.App.FindCloudletScoreWeights.Distance {
+				fields.Set(AppRolloutFieldAppFindCloudletScoreWeightsDistance)
+				fields.Set(AppRolloutFieldAppFindCloudletScoreWeights)
+				fields.Set(AppRolloutFieldApp)
+			}
+			if m.App.FindCloudletScoreWeights.Latency != o.App.FindCloudletScoreWeights.Latency {
+				fields.Set(AppRolloutFieldAppFindCloudletScoreWeightsLatency)
+				fields.Set(AppRolloutFieldAppFindCloudletScoreWeights)
+				fields.Set(AppRolloutFieldApp)
+			}
+			if m.App.FindCloudletScoreWeights.ResourceUsage != o.App.FindCloudletScoreWeights.ResourceUsage {
+				fields.Set(AppRolloutFieldAppFindCloudletScoreWeightsResourceUsage)
+				fields.Set(AppRolloutFieldAppFindCloudletScoreWeights)
+				fields.Set(AppRolloutFieldApp)
+			}
+			if m.App.FindCloudletScoreWeights.Health != o.App.FindCloudletScoreWeights.Health {
+				fields.Set(AppRolloutFieldAppFindCloudletScoreWeightsHealth)
+				fields.Set(AppRolloutFieldAppFindCloudletScoreWeights)
+				fields.Set(AppRolloutFieldApp)
+			}
+		} else if (m.App.FindCloudletScoreWeights != nil && o.App.FindCloudletScoreWeights == nil) || (m.App.FindCloudletScoreWeights == nil && o.App.FindCloudletScoreWeights != nil) {
+			fields.Set(AppRolloutFieldAppFindCloudletScoreWeights)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.GeoFencePolicy != o.App.GeoFencePolicy {
+			fields.Set(AppRolloutFieldAppGeoFencePolicy)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.ReplicaAutoScalePolicy != nil && o.App.ReplicaAutoScalePolicy != nil {
+			if m.App.ReplicaAutoScalePolicy.MinReplicas != o.App.ReplicaAutoScalePolicy.MinReplicas {
+				fields.Set(AppRolloutFieldAppReplicaAutoScalePolicyMinReplicas)
+				fields.Set(AppRolloutFieldAppReplicaAutoScalePolicy)
+				fields.Set(AppRolloutFieldApp)
+			}
+			if m.App.ReplicaAutoScalePolicy.MaxReplicas != o.App.ReplicaAutoScalePolicy.MaxReplicas {
+				fields.Set(AppRolloutFieldAppReplicaAutoScalePolicyMaxReplicas)
+				fields.Set(AppRolloutFieldAppReplicaAutoScalePolicy)
+				fields.Set(AppRolloutFieldApp)
+			}
+			if m.App.ReplicaAutoScalePolicy.TargetCpu != o.App.ReplicaAutoScalePolicy.TargetCpu {
+				fields.Set(AppRolloutFieldAppReplicaAutoScalePolicyTargetCpu)
+				fields.Set(AppRolloutFieldAppReplicaAutoScalePolicy)
+				fields.Set(AppRolloutFieldApp)
+			}
+			if m.App.ReplicaAutoScalePolicy.TargetMem != o.App.ReplicaAutoScalePolicy.TargetMem {
+				fields.Set(AppRolloutFieldAppReplicaAutoScalePolicyTargetMem)
+				fields.Set(AppRolloutFieldAppReplicaAutoScalePolicy)
+				fields.Set(AppRolloutFieldApp)
+			}
+			if m.App.ReplicaAutoScalePolicy.TargetActiveConnections != o.App.ReplicaAutoScalePolicy.TargetActiveConnections {
+				fields.Set(AppRolloutFieldAppReplicaAutoScalePolicyTargetActiveConnections)
+				fields.Set(AppRolloutFieldAppReplicaAutoScalePolicy)
+				fields.Set(AppRolloutFieldApp)
+			}
+			if m.App.ReplicaAutoScalePolicy.StabilizationWindowSec != o.App.ReplicaAutoScalePolicy.StabilizationWindowSec {
+				fields.Set(AppRolloutFieldAppReplicaAutoScalePolicyStabilizationWindowSec)
+				fields.Set(AppRolloutFieldAppReplicaAutoScalePolicy)
+				fields.Set(AppRolloutFieldApp)
+			}
+		} else if (m.App.ReplicaAutoScalePolicy != nil && o.App.ReplicaAutoScalePolicy == nil) || (m.App.ReplicaAutoScalePolicy == nil && o.App.ReplicaAutoScalePolicy != nil) {
+			fields.Set(AppRolloutFieldAppReplicaAutoScalePolicy)
+			fields.Set(AppRolloutFieldApp)
+		}
+		if m.App.Tags != nil && o.App.Tags != nil {
+			if len(m.App.Tags) != len(o.App.Tags) {
+				fields.Set(AppRolloutFieldAppTags)
+				fields.Set(AppRolloutFieldApp)
+			} else {
+				for k1, _ := range m.App.Tags {
+					_, vok1 := o.App.Tags[k1]
+					if !vok1 {
+						fields.Set(AppRolloutFieldAppTags)
+						fields.Set(AppRolloutFieldApp)
+					} else {
+						if m.App.Tags[k1] != o.App.Tags[k1] {
+							fields.Set(AppRolloutFieldAppTags)
+							fields.Set(AppRolloutFieldApp)
+							break
+						}
+					}
+				}
+			}
+		} else if (m.App.Tags != nil && o.App.Tags == nil) || (m.App.Tags == nil && o.App.Tags != nil) {
+			fields.Set(AppRolloutFieldAppTags)
+			fields.Set(AppRolloutFieldApp)
+		}
+	} else if (m.App != nil && o.App == nil) || (m.App == nil && o.App != nil) {
+		fields.Set(AppRolloutFieldApp)
+	}
+	if len(m.WavePercentages) != len(o.WavePercentages) {
+		fields.Set(AppRolloutFieldWavePercentages)
+	} else {
+		for i0 := 0; i0 < len(m.WavePercentages); i0++ {
+			if m.WavePercentages[i0] != o.WavePercentages[i0] {
+				fields.Set(AppRolloutFieldWavePercentages)
+				break
+			}
+		}
+	}
+	if m.WavesByZone != o.WavesByZone {
+		fields.Set(AppRolloutFieldWavesByZone)
+	}
+	if len(m.Zones) != len(o.Zones) {
+		fields.Set(AppRolloutFieldZones)
+	} else {
+		for i0 := 0; i0 < len(m.Zones); i0++ {
+			if m.Zones[i0].Organization != o.Zones[i0].Organization {
+				fields.Set(AppRolloutFieldZonesOrganization)
+				fields.Set(AppRolloutFieldZones)
+			}
+			if m.Zones[i0].Name != o.Zones[i0].Name {
+				fields.Set(AppRolloutFieldZonesName)
+				fields.Set(AppRolloutFieldZones)
+			}
+			if m.Zones[i0].FederatedOrganization != o.Zones[i0].FederatedOrganization {
+				fields.Set(AppRolloutFieldZonesFederatedOrganization)
+				fields.Set(AppRolloutFieldZones)
+			}
+		}
+	}
+	if m.WaveSoakTime != o.WaveSoakTime {
+		fields.Set(AppRolloutFieldWaveSoakTime)
+	}
+	if m.PauseBetweenWaves != o.PauseBetweenWaves {
+		fields.Set(AppRolloutFieldPauseBetweenWaves)
+	}
+	if m.AutoRollback != o.AutoRollback {
+		fields.Set(AppRolloutFieldAutoRollback)
+	}
+	if m.State != o.State {
+		fields.Set(AppRolloutFieldState)
+	}
+	if m.WavesDone != o.WavesDone {
+		fields.Set(AppRolloutFieldWavesDone)
+	}
+	if len(m.Waves) != len(o.Waves) {
+		fields.Set(AppRolloutFieldWaves)
+	} else {
+		for i0 := 0; i0 < len(m.Waves); i0++ {
+			if len(m.Waves[i0].AppInsts) != len(o.Waves[i0].AppInsts) {
+				fields.Set(AppRolloutFieldWavesAppInsts)
+				fields.Set(AppRolloutFieldWaves)
+			} else {
+				for i1 := 0; i1 < len(m.Waves[i0].AppInsts); i1++ {
+					if m.Waves[i0].AppInsts[i1].Name != o.Waves[i0].AppInsts[i1].Name {
+						fields.Set(AppRolloutFieldWavesAppInstsName)
+						fields.Set(AppRolloutFieldWavesAppInsts)
+						fields.Set(AppRolloutFieldWaves)
+					}
+					if m.Waves[i0].AppInsts[i1].Organization != o.Waves[i0].AppInsts[i1].Organization {
+						fields.Set(AppRolloutFieldWavesAppInstsOrganization)
+						fields.Set(AppRolloutFieldWavesAppInsts)
+						fields.Set(AppRolloutFieldWaves)
+					}
+				}
+			}
+			if m.Waves[i0].ZoneKey.Organization != o.Waves[i0].ZoneKey.Organization {
+				fields.Set(AppRolloutFieldWavesZoneKeyOrganization)
+				fields.Set(AppRolloutFieldWavesZoneKey)
+				fields.Set(AppRolloutFieldWaves)
+			}
+			if m.Waves[i0].ZoneKey.Name != o.Waves[i0].ZoneKey.Name {
+				fields.Set(AppRolloutFieldWavesZoneKeyName)
+				fields.Set(AppRolloutFieldWavesZoneKey)
+				fields.Set(AppRolloutFieldWaves)
+			}
+			if m.Waves[i0].ZoneKey.FederatedOrganization != o.Waves[i0].ZoneKey.FederatedOrganization {
+				fields.Set(AppRolloutFieldWavesZoneKeyFederatedOrganization)
+				fields.Set(AppRolloutFieldWavesZoneKey)
+				fields.Set(AppRolloutFieldWaves)
+			}
+		}
+	}
+	if m.Revision != o.Revision {
+		fields.Set(AppRolloutFieldRevision)
+	}
+	if m.PreviousApp != nil && o.PreviousApp != nil {
+		if m.PreviousApp.Key.Organization != o.PreviousApp.Key.Organization {
+			fields.Set(AppRolloutFieldPreviousAppKeyOrganization)
+			fields.Set(AppRolloutFieldPreviousAppKey)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.Key.Name != o.PreviousApp.Key.Name {
+			fields.Set(AppRolloutFieldPreviousAppKeyName)
+			fields.Set(AppRolloutFieldPreviousAppKey)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.Key.Version != o.PreviousApp.Key.Version {
+			fields.Set(AppRolloutFieldPreviousAppKeyVersion)
+			fields.Set(AppRolloutFieldPreviousAppKey)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.ImagePath != o.PreviousApp.ImagePath {
+			fields.Set(AppRolloutFieldPreviousAppImagePath)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.ImageType != o.PreviousApp.ImageType {
+			fields.Set(AppRolloutFieldPreviousAppImageType)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.AccessPorts != o.PreviousApp.AccessPorts {
+			fields.Set(AppRolloutFieldPreviousAppAccessPorts)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.DefaultFlavor.Name != o.PreviousApp.DefaultFlavor.Name {
+			fields.Set(AppRolloutFieldPreviousAppDefaultFlavorName)
+			fields.Set(AppRolloutFieldPreviousAppDefaultFlavor)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.AuthPublicKey != o.PreviousApp.AuthPublicKey {
+			fields.Set(AppRolloutFieldPreviousAppAuthPublicKey)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.Command != o.PreviousApp.Command {
+			fields.Set(AppRolloutFieldPreviousAppCommand)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.Annotations != o.PreviousApp.Annotations {
+			fields.Set(AppRolloutFieldPreviousAppAnnotations)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.Deployment != o.PreviousApp.Deployment {
+			fields.Set(AppRolloutFieldPreviousAppDeployment)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.DeploymentManifest != o.PreviousApp.DeploymentManifest {
+			fields.Set(AppRolloutFieldPreviousAppDeploymentManifest)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.DeploymentGenerator != o.PreviousApp.DeploymentGenerator {
+			fields.Set(AppRolloutFieldPreviousAppDeploymentGenerator)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.AndroidPackageName != o.PreviousApp.AndroidPackageName {
+			fields.Set(AppRolloutFieldPreviousAppAndroidPackageName)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.DelOpt != o.PreviousApp.DelOpt {
+			fields.Set(AppRolloutFieldPreviousAppDelOpt)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.Configs != nil && o.PreviousApp.Configs != nil {
+			if len(m.PreviousApp.Configs) != len(o.PreviousApp.Configs) {
+				fields.Set(AppRolloutFieldPreviousAppConfigs)
+				fields.Set(AppRolloutFieldPreviousApp)
+			} else {
+				for i1 := 0; i1 < len(m.PreviousApp.Configs); i1++ {
+					if m.PreviousApp.Configs[i1].Kind != o.PreviousApp.Configs[i1].Kind {
+						fields.Set(AppRolloutFieldPreviousAppConfigsKind)
+						fields.Set(AppRolloutFieldPreviousAppConfigs)
+						fields.Set(AppRolloutFieldPreviousApp)
+					}
+					if m.PreviousApp.Configs[i1].Config != o.PreviousApp.Configs[i1].Config {
+						fields.Set(AppRolloutFieldPreviousAppConfigsConfig)
+						fields.Set(AppRolloutFieldPreviousAppConfigs)
+						fields.Set(AppRolloutFieldPreviousApp)
+					}
+				}
+			}
+		} else if (m.PreviousApp.Configs != nil && o.PreviousApp.Configs == nil) || (m.PreviousApp.Configs == nil && o.PreviousApp.Configs != nil) {
+			fields.Set(AppRolloutFieldPreviousAppConfigs)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.ScaleWithCluster != o.PreviousApp.ScaleWithCluster {
+			fields.Set(AppRolloutFieldPreviousAppScaleWithCluster)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.InternalPorts != o.PreviousApp.InternalPorts {
+			fields.Set(AppRolloutFieldPreviousAppInternalPorts)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.Revision != o.PreviousApp.Revision {
+			fields.Set(AppRolloutFieldPreviousAppRevision)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.OfficialFqdn != o.PreviousApp.OfficialFqdn {
+			fields.Set(AppRolloutFieldPreviousAppOfficialFqdn)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.Md5Sum != o.PreviousApp.Md5Sum {
+			fields.Set(AppRolloutFieldPreviousAppMd5Sum)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.AutoProvPolicy != o.PreviousApp.AutoProvPolicy {
+			fields.Set(AppRolloutFieldPreviousAppAutoProvPolicy)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.AccessType != o.PreviousApp.AccessType {
+			fields.Set(AppRolloutFieldPreviousAppAccessType)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.DeletePrepare != o.PreviousApp.DeletePrepare {
+			fields.Set(AppRolloutFieldPreviousAppDeletePrepare)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if len(m.PreviousApp.AutoProvPolicies) != len(o.PreviousApp.AutoProvPolicies) {
+			fields.Set(AppRolloutFieldPreviousAppAutoProvPolicies)
+			fields.Set(AppRolloutFieldPreviousApp)
+		} else {
+			for i1 := 0; i1 < len(m.PreviousApp.AutoProvPolicies); i1++ {
+				if m.PreviousApp.AutoProvPolicies[i1] != o.PreviousApp.AutoProvPolicies[i1] {
+					fields.Set(AppRolloutFieldPreviousAppAutoProvPolicies)
+					fields.Set(AppRolloutFieldPreviousApp)
+					break
+				}
+			}
+		}
+		if m.PreviousApp.TemplateDelimiter != o.PreviousApp.TemplateDelimiter {
+			fields.Set(AppRolloutFieldPreviousAppTemplateDelimiter)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.SkipHcPorts != o.PreviousApp.SkipHcPorts {
+			fields.Set(AppRolloutFieldPreviousAppSkipHcPorts)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.CreatedAt.Seconds != o.PreviousApp.CreatedAt.Seconds {
+			fields.Set(AppRolloutFieldPreviousAppCreatedAtSeconds)
+			fields.Set(AppRolloutFieldPreviousAppCreatedAt)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.CreatedAt.Nanos != o.PreviousApp.CreatedAt.Nanos {
+			fields.Set(AppRolloutFieldPreviousAppCreatedAtNanos)
+			fields.Set(AppRolloutFieldPreviousAppCreatedAt)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.UpdatedAt.Seconds != o.PreviousApp.UpdatedAt.Seconds {
+			fields.Set(AppRolloutFieldPreviousAppUpdatedAtSeconds)
+			fields.Set(AppRolloutFieldPreviousAppUpdatedAt)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.UpdatedAt.Nanos != o.PreviousApp.UpdatedAt.Nanos {
+			fields.Set(AppRolloutFieldPreviousAppUpdatedAtNanos)
+			fields.Set(AppRolloutFieldPreviousAppUpdatedAt)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.Trusted != o.PreviousApp.Trusted {
+			fields.Set(AppRolloutFieldPreviousAppTrusted)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if len(m.PreviousApp.RequiredOutboundConnections) != len(o.PreviousApp.RequiredOutboundConnections) {
+			fields.Set(AppRolloutFieldPreviousAppRequiredOutboundConnections)
+			fields.Set(AppRolloutFieldPreviousApp)
+		} else {
+			for i1 := 0; i1 < len(m.PreviousApp.RequiredOutboundConnections); i1++ {
+				if m.PreviousApp.RequiredOutboundConnections[i1].Protocol != o.PreviousApp.RequiredOutboundConnections[i1].Protocol {
+					fields.Set(AppRolloutFieldPreviousAppRequiredOutboundConnectionsProtocol)
+					fields.Set(AppRolloutFieldPreviousAppRequiredOutboundConnections)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.RequiredOutboundConnections[i1].PortRangeMin != o.PreviousApp.RequiredOutboundConnections[i1].PortRangeMin {
+					fields.Set(AppRolloutFieldPreviousAppRequiredOutboundConnectionsPortRangeMin)
+					fields.Set(AppRolloutFieldPreviousAppRequiredOutboundConnections)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.RequiredOutboundConnections[i1].PortRangeMax != o.PreviousApp.RequiredOutboundConnections[i1].PortRangeMax {
+					fields.Set(AppRolloutFieldPreviousAppRequiredOutboundConnectionsPortRangeMax)
+					fields.Set(AppRolloutFieldPreviousAppRequiredOutboundConnections)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.RequiredOutboundConnections[i1].RemoteCidr != o.PreviousApp.RequiredOutboundConnections[i1].RemoteCidr {
+					fields.Set(AppRolloutFieldPreviousAppRequiredOutboundConnectionsRemoteCidr)
+					fields.Set(AppRolloutFieldPreviousAppRequiredOutboundConnections)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+			}
+		}
+		if m.PreviousApp.AllowServerless != o.PreviousApp.AllowServerless {
+			fields.Set(AppRolloutFieldPreviousAppAllowServerless)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.ServerlessConfig != nil && o.PreviousApp.ServerlessConfig != nil {
+			if m.PreviousApp.ServerlessConfig.Vcpus.Whole != o.PreviousApp.ServerlessConfig.Vcpus.Whole {
+				fields.Set(AppRolloutFieldPreviousAppServerlessConfigVcpusWhole)
+				fields.Set(AppRolloutFieldPreviousAppServerlessConfigVcpus)
+				fields.Set(AppRolloutFieldPreviousAppServerlessConfig)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+			if m.PreviousApp.ServerlessConfig.Vcpus.Nanos != o.PreviousApp.ServerlessConfig.Vcpus.Nanos {
+				fields.Set(AppRolloutFieldPreviousAppServerlessConfigVcpusNanos)
+				fields.Set(AppRolloutFieldPreviousAppServerlessConfigVcpus)
+				fields.Set(AppRolloutFieldPreviousAppServerlessConfig)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+			if m.PreviousApp.ServerlessConfig.Ram != o.PreviousApp.ServerlessConfig.Ram {
+				fields.Set(AppRolloutFieldPreviousAppServerlessConfigRam)
+				fields.Set(AppRolloutFieldPreviousAppServerlessConfig)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+			if m.PreviousApp.ServerlessConfig.MinReplicas != o.PreviousApp.ServerlessConfig.MinReplicas {
+				fields.Set(AppRolloutFieldPreviousAppServerlessConfigMinReplicas)
+				fields.Set(AppRolloutFieldPreviousAppServerlessConfig)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+			if m.PreviousApp.ServerlessConfig.GpuConfig.Type != o.PreviousApp.ServerlessConfig.GpuConfig.Type {
+				fields.Set(AppRolloutFieldPreviousAppServerlessConfigGpuConfigType)
+				fields.Set(AppRolloutFieldPreviousAppServerlessConfigGpuConfig)
+				fields.Set(AppRolloutFieldPreviousAppServerlessConfig)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+			if m.PreviousApp.ServerlessConfig.GpuConfig.Model != o.PreviousApp.ServerlessConfig.GpuConfig.Model {
+				fields.Set(AppRolloutFieldPreviousAppServerlessConfigGpuConfigModel)
+				fields.Set(AppRolloutFieldPreviousAppServerlessConfigGpuConfig)
+				fields.Set(AppRolloutFieldPreviousAppServerlessConfig)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+			if m.PreviousApp.ServerlessConfig.GpuConfig.NumGpu != o.PreviousApp.ServerlessConfig.GpuConfig.NumGpu {
+				fields.Set(AppRolloutFieldPreviousAppServerlessConfigGpuConfigNumGpu)
+				fields.Set(AppRolloutFieldPreviousAppServerlessConfigGpuConfig)
+				fields.Set(AppRolloutFieldPreviousAppServerlessConfig)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+			if m.PreviousApp.ServerlessConfig.GpuConfig.Ram != o.PreviousApp.ServerlessConfig.GpuConfig.Ram {
+				fields.Set(AppRolloutFieldPreviousAppServerlessConfigGpuConfigRam)
+				fields.Set(AppRolloutFieldPreviousAppServerlessConfigGpuConfig)
+				fields.Set(AppRolloutFieldPreviousAppServerlessConfig)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+		} else if (m.PreviousApp.ServerlessConfig != nil && o.PreviousApp.ServerlessConfig == nil) || (m.PreviousApp.ServerlessConfig == nil && o.PreviousApp.ServerlessConfig != nil) {
+			fields.Set(AppRolloutFieldPreviousAppServerlessConfig)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.VmAppOsType != o.PreviousApp.VmAppOsType {
+			fields.Set(AppRolloutFieldPreviousAppVmAppOsType)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if len(m.PreviousApp.AlertPolicies) != len(o.PreviousApp.AlertPolicies) {
+			fields.Set(AppRolloutFieldPreviousAppAlertPolicies)
+			fields.Set(AppRolloutFieldPreviousApp)
+		} else {
+			for i1 := 0; i1 < len(m.PreviousApp.AlertPolicies); i1++ {
+				if m.PreviousApp.AlertPolicies[i1] != o.PreviousApp.AlertPolicies[i1] {
+					fields.Set(AppRolloutFieldPreviousAppAlertPolicies)
+					fields.Set(AppRolloutFieldPreviousApp)
+					break
+				}
+			}
+		}
+		if m.PreviousApp.QosSessionProfile != o.PreviousApp.QosSessionProfile {
+			fields.Set(AppRolloutFieldPreviousAppQosSessionProfile)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.QosSessionDuration != o.PreviousApp.QosSessionDuration {
+			fields.Set(AppRolloutFieldPreviousAppQosSessionDuration)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.GlobalId != o.PreviousApp.GlobalId {
+			fields.Set(AppRolloutFieldPreviousAppGlobalId)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if len(m.PreviousApp.CommandArgs) != len(o.PreviousApp.CommandArgs) {
+			fields.Set(AppRolloutFieldPreviousAppCommandArgs)
+			fields.Set(AppRolloutFieldPreviousApp)
+		} else {
+			for i1 := 0; i1 < len(m.PreviousApp.CommandArgs); i1++ {
+				if m.PreviousApp.CommandArgs[i1] != o.PreviousApp.CommandArgs[i1] {
+					fields.Set(AppRolloutFieldPreviousAppCommandArgs)
+					fields.Set(AppRolloutFieldPreviousApp)
+					break
+				}
+			}
+		}
+		if m.PreviousApp.EnvVars != nil && o.PreviousApp.EnvVars != nil {
+			if len(m.PreviousApp.EnvVars) != len(o.PreviousApp.EnvVars) {
+				fields.Set(AppRolloutFieldPreviousAppEnvVars)
+				fields.Set(AppRolloutFieldPreviousApp)
+			} else {
+				for k1, _ := range m.PreviousApp.EnvVars {
+					_, vok1 := o.PreviousApp.EnvVars[k1]
+					if !vok1 {
+						fields.Set(AppRolloutFieldPreviousAppEnvVars)
+						fields.Set(AppRolloutFieldPreviousApp)
+					} else {
+						if m.PreviousApp.EnvVars[k1] != o.PreviousApp.EnvVars[k1] {
+							fields.Set(AppRolloutFieldPreviousAppEnvVars)
+							fields.Set(AppRolloutFieldPreviousApp)
+							break
+						}
+					}
+				}
+			}
+		} else if (m.PreviousApp.EnvVars != nil && o.PreviousApp.EnvVars == nil) || (m.PreviousApp.EnvVars == nil && o.PreviousApp.EnvVars != nil) {
+			fields.Set(AppRolloutFieldPreviousAppEnvVars)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.SecretEnvVars != nil && o.PreviousApp.SecretEnvVars != nil {
+			if len(m.PreviousApp.SecretEnvVars) != len(o.PreviousApp.SecretEnvVars) {
+				fields.Set(AppRolloutFieldPreviousAppSecretEnvVars)
+				fields.Set(AppRolloutFieldPreviousApp)
+			} else {
+				for k1, _ := range m.PreviousApp.SecretEnvVars {
+					_, vok1 := o.PreviousApp.SecretEnvVars[k1]
+					if !vok1 {
+						fields.Set(AppRolloutFieldPreviousAppSecretEnvVars)
+						fields.Set(AppRolloutFieldPreviousApp)
+					} else {
+						if m.PreviousApp.SecretEnvVars[k1] != o.PreviousApp.SecretEnvVars[k1] {
+							fields.Set(AppRolloutFieldPreviousAppSecretEnvVars)
+							fields.Set(AppRolloutFieldPreviousApp)
+							break
+						}
+					}
+				}
+			}
+		} else if (m.PreviousApp.SecretEnvVars != nil && o.PreviousApp.SecretEnvVars == nil) || (m.PreviousApp.SecretEnvVars == nil && o.PreviousApp.SecretEnvVars != nil) {
+			fields.Set(AppRolloutFieldPreviousAppSecretEnvVars)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.UpdateListAction != o.PreviousApp.UpdateListAction {
+			fields.Set(AppRolloutFieldPreviousAppUpdateListAction)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.KubernetesResources != nil && o.PreviousApp.KubernetesResources != nil {
+			if m.PreviousApp.KubernetesResources.CpuPool != nil && o.PreviousApp.KubernetesResources.CpuPool != nil {
+				if m.PreviousApp.KubernetesResources.CpuPool.TotalVcpus.Whole != o.PreviousApp.KubernetesResources.CpuPool.TotalVcpus.Whole {
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalVcpusWhole)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalVcpus)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.KubernetesResources.CpuPool.TotalVcpus.Nanos != o.PreviousApp.KubernetesResources.CpuPool.TotalVcpus.Nanos {
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalVcpusNanos)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalVcpus)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.KubernetesResources.CpuPool.TotalMemory != o.PreviousApp.KubernetesResources.CpuPool.TotalMemory {
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalMemory)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.KubernetesResources.CpuPool.TotalDisk != o.PreviousApp.KubernetesResources.CpuPool.TotalDisk {
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalDisk)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.KubernetesResources.CpuPool.TotalOptRes != nil && o.PreviousApp.KubernetesResources.CpuPool.TotalOptRes != nil {
+					if len(m.PreviousApp.KubernetesResources.CpuPool.TotalOptRes) != len(o.PreviousApp.KubernetesResources.CpuPool.TotalOptRes) {
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalOptRes)
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+						fields.Set(AppRolloutFieldPreviousApp)
+					} else {
+						for k3, _ := range m.PreviousApp.KubernetesResources.CpuPool.TotalOptRes {
+							_, vok3 := o.PreviousApp.KubernetesResources.CpuPool.TotalOptRes[k3]
+							if !vok3 {
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalOptRes)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+							} else {
+								if m.PreviousApp.KubernetesResources.CpuPool.TotalOptRes[k3] != o.PreviousApp.KubernetesResources.CpuPool.TotalOptRes[k3] {
+									fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalOptRes)
+									fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+									fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+									fields.Set(AppRolloutFieldPreviousApp)
+									break
+								}
+							}
+						}
+					}
+				} else if (m.PreviousApp.KubernetesResources.CpuPool.TotalOptRes != nil && o.PreviousApp.KubernetesResources.CpuPool.TotalOptRes == nil) || (m.PreviousApp.KubernetesResources.CpuPool.TotalOptRes == nil && o.PreviousApp.KubernetesResources.CpuPool.TotalOptRes != nil) {
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalOptRes)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeVcpus != o.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeVcpus {
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeVcpus)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopology)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeMemory != o.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeMemory {
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeMemory)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopology)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeDisk != o.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeDisk {
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeDisk)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopology)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeOptRes != nil && o.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeOptRes != nil {
+					if len(m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeOptRes) != len(o.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeOptRes) {
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeOptRes)
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopology)
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+						fields.Set(AppRolloutFieldPreviousApp)
+					} else {
+						for k4, _ := range m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeOptRes {
+							_, vok4 := o.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeOptRes[k4]
+							if !vok4 {
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeOptRes)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopology)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+							} else {
+								if m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeOptRes[k4] != o.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeOptRes[k4] {
+									fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeOptRes)
+									fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopology)
+									fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+									fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+									fields.Set(AppRolloutFieldPreviousApp)
+									break
+								}
+							}
+						}
+					}
+				} else if (m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeOptRes != nil && o.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeOptRes == nil) || (m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeOptRes == nil && o.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeOptRes != nil) {
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeOptRes)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopology)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNumberOfNodes != o.PreviousApp.KubernetesResources.CpuPool.Topology.MinNumberOfNodes {
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNumberOfNodes)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopology)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus != nil && o.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus != nil {
+					if len(m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus) != len(o.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus) {
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpus)
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopology)
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+						fields.Set(AppRolloutFieldPreviousApp)
+					} else {
+						for i4 := 0; i4 < len(m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus); i4++ {
+							if m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus[i4].ModelId != o.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus[i4].ModelId {
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusModelId)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpus)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopology)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+							}
+							if m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus[i4].Count != o.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus[i4].Count {
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusCount)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpus)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopology)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+							}
+							if m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus[i4].Vendor != o.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus[i4].Vendor {
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusVendor)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpus)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopology)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+							}
+							if m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus[i4].Memory != o.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus[i4].Memory {
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusMemory)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpus)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopology)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+							}
+							if m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus[i4].InUse != o.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus[i4].InUse {
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpusInUse)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpus)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopology)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+							}
+						}
+					}
+				} else if (m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus != nil && o.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus == nil) || (m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus == nil && o.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus != nil) {
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpus)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTopology)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.KubernetesResources.CpuPool.TotalGpus != nil && o.PreviousApp.KubernetesResources.CpuPool.TotalGpus != nil {
+					if len(m.PreviousApp.KubernetesResources.CpuPool.TotalGpus) != len(o.PreviousApp.KubernetesResources.CpuPool.TotalGpus) {
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpus)
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+						fields.Set(AppRolloutFieldPreviousApp)
+					} else {
+						for i3 := 0; i3 < len(m.PreviousApp.KubernetesResources.CpuPool.TotalGpus); i3++ {
+							if m.PreviousApp.KubernetesResources.CpuPool.TotalGpus[i3].ModelId != o.PreviousApp.KubernetesResources.CpuPool.TotalGpus[i3].ModelId {
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusModelId)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpus)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+							}
+							if m.PreviousApp.KubernetesResources.CpuPool.TotalGpus[i3].Count != o.PreviousApp.KubernetesResources.CpuPool.TotalGpus[i3].Count {
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusCount)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpus)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+							}
+							if m.PreviousApp.KubernetesResources.CpuPool.TotalGpus[i3].Vendor != o.PreviousApp.KubernetesResources.CpuPool.TotalGpus[i3].Vendor {
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusVendor)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpus)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+							}
+							if m.PreviousApp.KubernetesResources.CpuPool.TotalGpus[i3].Memory != o.PreviousApp.KubernetesResources.CpuPool.TotalGpus[i3].Memory {
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusMemory)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpus)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+							}
+							if m.PreviousApp.KubernetesResources.CpuPool.TotalGpus[i3].InUse != o.PreviousApp.KubernetesResources.CpuPool.TotalGpus[i3].InUse {
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpusInUse)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpus)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+							}
+						}
+					}
+				} else if (m.PreviousApp.KubernetesResources.CpuPool.TotalGpus != nil && o.PreviousApp.KubernetesResources.CpuPool.TotalGpus == nil) || (m.PreviousApp.KubernetesResources.CpuPool.TotalGpus == nil && o.PreviousApp.KubernetesResources.CpuPool.TotalGpus != nil) {
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPoolTotalGpus)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+			} else if (m.PreviousApp.KubernetesResources.CpuPool != nil && o.PreviousApp.KubernetesResources.CpuPool == nil) || (m.PreviousApp.KubernetesResources.CpuPool == nil && o.PreviousApp.KubernetesResources.CpuPool != nil) {
+				fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesCpuPool)
+				fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+			if m.PreviousApp.KubernetesResources.GpuPool != nil && o.PreviousApp.KubernetesResources.GpuPool != nil {
+				if m.PreviousApp.KubernetesResources.GpuPool.TotalVcpus.Whole != o.PreviousApp.KubernetesResources.GpuPool.TotalVcpus.Whole {
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalVcpusWhole)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalVcpus)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.KubernetesResources.GpuPool.TotalVcpus.Nanos != o.PreviousApp.KubernetesResources.GpuPool.TotalVcpus.Nanos {
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalVcpusNanos)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalVcpus)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.KubernetesResources.GpuPool.TotalMemory != o.PreviousApp.KubernetesResources.GpuPool.TotalMemory {
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalMemory)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.KubernetesResources.GpuPool.TotalDisk != o.PreviousApp.KubernetesResources.GpuPool.TotalDisk {
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalDisk)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.KubernetesResources.GpuPool.TotalOptRes != nil && o.PreviousApp.KubernetesResources.GpuPool.TotalOptRes != nil {
+					if len(m.PreviousApp.KubernetesResources.GpuPool.TotalOptRes) != len(o.PreviousApp.KubernetesResources.GpuPool.TotalOptRes) {
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalOptRes)
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+						fields.Set(AppRolloutFieldPreviousApp)
+					} else {
+						for k3, _ := range m.PreviousApp.KubernetesResources.GpuPool.TotalOptRes {
+							_, vok3 := o.PreviousApp.KubernetesResources.GpuPool.TotalOptRes[k3]
+							if !vok3 {
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalOptRes)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+							} else {
+								if m.PreviousApp.KubernetesResources.GpuPool.TotalOptRes[k3] != o.PreviousApp.KubernetesResources.GpuPool.TotalOptRes[k3] {
+									fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalOptRes)
+									fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+									fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+									fields.Set(AppRolloutFieldPreviousApp)
+									break
+								}
+							}
+						}
+					}
+				} else if (m.PreviousApp.KubernetesResources.GpuPool.TotalOptRes != nil && o.PreviousApp.KubernetesResources.GpuPool.TotalOptRes == nil) || (m.PreviousApp.KubernetesResources.GpuPool.TotalOptRes == nil && o.PreviousApp.KubernetesResources.GpuPool.TotalOptRes != nil) {
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalOptRes)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeVcpus != o.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeVcpus {
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeVcpus)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopology)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeMemory != o.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeMemory {
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeMemory)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopology)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeDisk != o.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeDisk {
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeDisk)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopology)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeOptRes != nil && o.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeOptRes != nil {
+					if len(m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeOptRes) != len(o.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeOptRes) {
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeOptRes)
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopology)
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+						fields.Set(AppRolloutFieldPreviousApp)
+					} else {
+						for k4, _ := range m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeOptRes {
+							_, vok4 := o.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeOptRes[k4]
+							if !vok4 {
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeOptRes)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopology)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+							} else {
+								if m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeOptRes[k4] != o.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeOptRes[k4] {
+									fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeOptRes)
+									fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopology)
+									fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+									fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+									fields.Set(AppRolloutFieldPreviousApp)
+									break
+								}
+							}
+						}
+					}
+				} else if (m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeOptRes != nil && o.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeOptRes == nil) || (m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeOptRes == nil && o.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeOptRes != nil) {
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeOptRes)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopology)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNumberOfNodes != o.PreviousApp.KubernetesResources.GpuPool.Topology.MinNumberOfNodes {
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNumberOfNodes)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopology)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus != nil && o.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus != nil {
+					if len(m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus) != len(o.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus) {
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpus)
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopology)
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+						fields.Set(AppRolloutFieldPreviousApp)
+					} else {
+						for i4 := 0; i4 < len(m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus); i4++ {
+							if m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus[i4].ModelId != o.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus[i4].ModelId {
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusModelId)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpus)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopology)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+							}
+							if m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus[i4].Count != o.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus[i4].Count {
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusCount)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpus)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopology)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+							}
+							if m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus[i4].Vendor != o.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus[i4].Vendor {
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusVendor)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpus)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopology)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+							}
+							if m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus[i4].Memory != o.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus[i4].Memory {
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusMemory)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpus)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopology)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+							}
+							if m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus[i4].InUse != o.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus[i4].InUse {
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpusInUse)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpus)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopology)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+							}
+						}
+					}
+				} else if (m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus != nil && o.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus == nil) || (m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus == nil && o.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus != nil) {
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpus)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTopology)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+				if m.PreviousApp.KubernetesResources.GpuPool.TotalGpus != nil && o.PreviousApp.KubernetesResources.GpuPool.TotalGpus != nil {
+					if len(m.PreviousApp.KubernetesResources.GpuPool.TotalGpus) != len(o.PreviousApp.KubernetesResources.GpuPool.TotalGpus) {
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpus)
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+						fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+						fields.Set(AppRolloutFieldPreviousApp)
+					} else {
+						for i3 := 0; i3 < len(m.PreviousApp.KubernetesResources.GpuPool.TotalGpus); i3++ {
+							if m.PreviousApp.KubernetesResources.GpuPool.TotalGpus[i3].ModelId != o.PreviousApp.KubernetesResources.GpuPool.TotalGpus[i3].ModelId {
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusModelId)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpus)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+							}
+							if m.PreviousApp.KubernetesResources.GpuPool.TotalGpus[i3].Count != o.PreviousApp.KubernetesResources.GpuPool.TotalGpus[i3].Count {
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusCount)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpus)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+							}
+							if m.PreviousApp.KubernetesResources.GpuPool.TotalGpus[i3].Vendor != o.PreviousApp.KubernetesResources.GpuPool.TotalGpus[i3].Vendor {
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusVendor)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpus)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+							}
+							if m.PreviousApp.KubernetesResources.GpuPool.TotalGpus[i3].Memory != o.PreviousApp.KubernetesResources.GpuPool.TotalGpus[i3].Memory {
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusMemory)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpus)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+							}
+							if m.PreviousApp.KubernetesResources.GpuPool.TotalGpus[i3].InUse != o.PreviousApp.KubernetesResources.GpuPool.TotalGpus[i3].InUse {
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpusInUse)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpus)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+								fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+							}
+						}
+					}
+				} else if (m.PreviousApp.KubernetesResources.GpuPool.TotalGpus != nil && o.PreviousApp.KubernetesResources.GpuPool.TotalGpus == nil) || (m.PreviousApp.KubernetesResources.GpuPool.TotalGpus == nil && o.PreviousApp.KubernetesResources.GpuPool.TotalGpus != nil) {
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPoolTotalGpus)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+					fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				}
+			} else if (m.PreviousApp.KubernetesResources.GpuPool != nil && o.PreviousApp.KubernetesResources.GpuPool == nil) || (m.PreviousApp.KubernetesResources.GpuPool == nil && o.PreviousApp.KubernetesResources.GpuPool != nil) {
+				fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesGpuPool)
+				fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+			if m.PreviousApp.KubernetesResources.MinKubernetesVersion != o.PreviousApp.KubernetesResources.MinKubernetesVersion {
+				fields.Set(AppRolloutFieldPreviousAppKubernetesResourcesMinKubernetesVersion)
+				fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+		} else if (m.PreviousApp.KubernetesResources != nil && o.PreviousApp.KubernetesResources == nil) || (m.PreviousApp.KubernetesResources == nil && o.PreviousApp.KubernetesResources != nil) {
+			fields.Set(AppRolloutFieldPreviousAppKubernetesResources)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.NodeResources != nil && o.PreviousApp.NodeResources != nil {
+			if m.PreviousApp.NodeResources.Vcpus != o.PreviousApp.NodeResources.Vcpus {
+				fields.Set(AppRolloutFieldPreviousAppNodeResourcesVcpus)
+				fields.Set(AppRolloutFieldPreviousAppNodeResources)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+			if m.PreviousApp.NodeResources.Ram != o.PreviousApp.NodeResources.Ram {
+				fields.Set(AppRolloutFieldPreviousAppNodeResourcesRam)
+				fields.Set(AppRolloutFieldPreviousAppNodeResources)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+			if m.PreviousApp.NodeResources.Disk != o.PreviousApp.NodeResources.Disk {
+				fields.Set(AppRolloutFieldPreviousAppNodeResourcesDisk)
+				fields.Set(AppRolloutFieldPreviousAppNodeResources)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+			if m.PreviousApp.NodeResources.OptResMap != nil && o.PreviousApp.NodeResources.OptResMap != nil {
+				if len(m.PreviousApp.NodeResources.OptResMap) != len(o.PreviousApp.NodeResources.OptResMap) {
+					fields.Set(AppRolloutFieldPreviousAppNodeResourcesOptResMap)
+					fields.Set(AppRolloutFieldPreviousAppNodeResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				} else {
+					for k2, _ := range m.PreviousApp.NodeResources.OptResMap {
+						_, vok2 := o.PreviousApp.NodeResources.OptResMap[k2]
+						if !vok2 {
+							fields.Set(AppRolloutFieldPreviousAppNodeResourcesOptResMap)
+							fields.Set(AppRolloutFieldPreviousAppNodeResources)
+							fields.Set(AppRolloutFieldPreviousApp)
+						} else {
+							if m.PreviousApp.NodeResources.OptResMap[k2] != o.PreviousApp.NodeResources.OptResMap[k2] {
+								fields.Set(AppRolloutFieldPreviousAppNodeResourcesOptResMap)
+								fields.Set(AppRolloutFieldPreviousAppNodeResources)
+								fields.Set(AppRolloutFieldPreviousApp)
+								break
+							}
+						}
+					}
+				}
+			} else if (m.PreviousApp.NodeResources.OptResMap != nil && o.PreviousApp.NodeResources.OptResMap == nil) || (m.PreviousApp.NodeResources.OptResMap == nil && o.PreviousApp.NodeResources.OptResMap != nil) {
+				fields.Set(AppRolloutFieldPreviousAppNodeResourcesOptResMap)
+				fields.Set(AppRolloutFieldPreviousAppNodeResources)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+			if m.PreviousApp.NodeResources.InfraNodeFlavor != o.PreviousApp.NodeResources.InfraNodeFlavor {
+				fields.Set(AppRolloutFieldPreviousAppNodeResourcesInfraNodeFlavor)
+				fields.Set(AppRolloutFieldPreviousAppNodeResources)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+			if m.PreviousApp.NodeResources.ExternalVolumeSize != o.PreviousApp.NodeResources.ExternalVolumeSize {
+				fields.Set(AppRolloutFieldPreviousAppNodeResourcesExternalVolumeSize)
+				fields.Set(AppRolloutFieldPreviousAppNodeResources)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+			if m.PreviousApp.NodeResources.Gpus != nil && o.PreviousApp.NodeResources.Gpus != nil {
+				if len(m.PreviousApp.NodeResources.Gpus) != len(o.PreviousApp.NodeResources.Gpus) {
+					fields.Set(AppRolloutFieldPreviousAppNodeResourcesGpus)
+					fields.Set(AppRolloutFieldPreviousAppNodeResources)
+					fields.Set(AppRolloutFieldPreviousApp)
+				} else {
+					for i2 := 0; i2 < len(m.PreviousApp.NodeResources.Gpus); i2++ {
+						if m.PreviousApp.NodeResources.Gpus[i2].ModelId != o.PreviousApp.NodeResources.Gpus[i2].ModelId {
+							fields.Set(AppRolloutFieldPreviousAppNodeResourcesGpusModelId)
+							fields.Set(AppRolloutFieldPreviousAppNodeResourcesGpus)
+							fields.Set(AppRolloutFieldPreviousAppNodeResources)
+							fields.Set(AppRolloutFieldPreviousApp)
+						}
+						if m.PreviousApp.NodeResources.Gpus[i2].Count != o.PreviousApp.NodeResources.Gpus[i2].Count {
+							fields.Set(AppRolloutFieldPreviousAppNodeResourcesGpusCount)
+							fields.Set(AppRolloutFieldPreviousAppNodeResourcesGpus)
+							fields.Set(AppRolloutFieldPreviousAppNodeResources)
+							fields.Set(AppRolloutFieldPreviousApp)
+						}
+						if m.PreviousApp.NodeResources.Gpus[i2].Vendor != o.PreviousApp.NodeResources.Gpus[i2].Vendor {
+							fields.Set(AppRolloutFieldPreviousAppNodeResourcesGpusVendor)
+							fields.Set(AppRolloutFieldPreviousAppNodeResourcesGpus)
+							fields.Set(AppRolloutFieldPreviousAppNodeResources)
+							fields.Set(AppRolloutFieldPreviousApp)
+						}
+						if m.PreviousApp.NodeResources.Gpus[i2].Memory != o.PreviousApp.NodeResources.Gpus[i2].Memory {
+							fields.Set(AppRolloutFieldPreviousAppNodeResourcesGpusMemory)
+							fields.Set(AppRolloutFieldPreviousAppNodeResourcesGpus)
+							fields.Set(AppRolloutFieldPreviousAppNodeResources)
+							fields.Set(AppRolloutFieldPreviousApp)
+						}
+						if m.PreviousApp.NodeResources.Gpus[i2].InUse != o.PreviousApp.NodeResources.Gpus[i2].InUse {
+							fields.Set(AppRolloutFieldPreviousAppNodeResourcesGpusInUse)
+							fields.Set(AppRolloutFieldPreviousAppNodeResourcesGpus)
+							fields.Set(AppRolloutFieldPreviousAppNodeResources)
+							fields.Set(AppRolloutFieldPreviousApp)
+						}
+					}
+				}
+			} else if (m.PreviousApp.NodeResources.Gpus != nil && o.PreviousApp.NodeResources.Gpus == nil) || (m.PreviousApp.NodeResources.Gpus == nil && o.PreviousApp.NodeResources.Gpus != nil) {
+				fields.Set(AppRolloutFieldPreviousAppNodeResourcesGpus)
+				fields.Set(AppRolloutFieldPreviousAppNodeResources)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+		} else if (m.PreviousApp.NodeResources != nil && o.PreviousApp.NodeResources == nil) || (m.PreviousApp.NodeResources == nil && o.PreviousApp.NodeResources != nil) {
+			fields.Set(AppRolloutFieldPreviousAppNodeResources)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.ObjId != o.PreviousApp.ObjId {
+			fields.Set(AppRolloutFieldPreviousAppObjId)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.AppAnnotations != nil && o.PreviousApp.AppAnnotations != nil {
+			if len(m.PreviousApp.AppAnnotations) != len(o.PreviousApp.AppAnnotations) {
+				fields.Set(AppRolloutFieldPreviousAppAppAnnotations)
+				fields.Set(AppRolloutFieldPreviousApp)
+			} else {
+				for k1, _ := range m.PreviousApp.AppAnnotations {
+					_, vok1 := o.PreviousApp.AppAnnotations[k1]
+					if !vok1 {
+						fields.Set(AppRolloutFieldPreviousAppAppAnnotations)
+						fields.Set(AppRolloutFieldPreviousApp)
+					} else {
+						if m.PreviousApp.AppAnnotations[k1] != o.PreviousApp.AppAnnotations[k1] {
+							fields.Set(AppRolloutFieldPreviousAppAppAnnotations)
+							fields.Set(AppRolloutFieldPreviousApp)
+							break
+						}
+					}
+				}
+			}
+		} else if (m.PreviousApp.AppAnnotations != nil && o.PreviousApp.AppAnnotations == nil) || (m.PreviousApp.AppAnnotations == nil && o.PreviousApp.AppAnnotations != nil) {
+			fields.Set(AppRolloutFieldPreviousAppAppAnnotations)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.IsStandalone != o.PreviousApp.IsStandalone {
+			fields.Set(AppRolloutFieldPreviousAppIsStandalone)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.ManagesOwnNamespaces != o.PreviousApp.ManagesOwnNamespaces {
+			fields.Set(AppRolloutFieldPreviousAppManagesOwnNamespaces)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.CompatibilityVersion != o.PreviousApp.CompatibilityVersion {
+			fields.Set(AppRolloutFieldPreviousAppCompatibilityVersion)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.FindCloudletScoreWeights != nil && o.PreviousApp.FindCloudletScoreWeights != nil {
+			if m.PreviousApp.FindCloudletScoreWeights.Distance != o.PreviousApp.FindCloudletScoreWeights.Distance {
+				fields.Set(AppRolloutFieldPreviousAppFindCloudletScoreWeightsDistance)
+				fields.Set(AppRolloutFieldPreviousAppFindCloudletScoreWeights)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+			if m.PreviousApp.FindCloudletScoreWeights.Latency != o.PreviousApp.FindCloudletScoreWeights.Latency {
+				fields.Set(AppRolloutFieldPreviousAppFindCloudletScoreWeightsLatency)
+				fields.Set(AppRolloutFieldPreviousAppFindCloudletScoreWeights)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+			if m.PreviousApp.FindCloudletScoreWeights.ResourceUsage != o.PreviousApp.FindCloudletScoreWeights.ResourceUsage {
+				fields.Set(AppRolloutFieldPreviousAppFindCloudletScoreWeightsResourceUsage)
+				fields.Set(AppRolloutFieldPreviousAppFindCloudletScoreWeights)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+			if m.PreviousApp.FindCloudletScoreWeights.Health != o.PreviousApp.FindCloudletScoreWeights.Health {
+				fields.Set(AppRolloutFieldPreviousAppFindCloudletScoreWeightsHealth)
+				fields.Set(AppRolloutFieldPreviousAppFindCloudletScoreWeights)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+		} else if (m.PreviousApp.FindCloudletScoreWeights != nil && o.PreviousApp.FindCloudletScoreWeights == nil) || (m.PreviousApp.FindCloudletScoreWeights == nil && o.PreviousApp.FindCloudletScoreWeights != nil) {
+			fields.Set(AppRolloutFieldPreviousAppFindCloudletScoreWeights)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.GeoFencePolicy != o.PreviousApp.GeoFencePolicy {
+			fields.Set(AppRolloutFieldPreviousAppGeoFencePolicy)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.ReplicaAutoScalePolicy != nil && o.PreviousApp.ReplicaAutoScalePolicy != nil {
+			if m.PreviousApp.ReplicaAutoScalePolicy.MinReplicas != o.PreviousApp.ReplicaAutoScalePolicy.MinReplicas {
+				fields.Set(AppRolloutFieldPreviousAppReplicaAutoScalePolicyMinReplicas)
+				fields.Set(AppRolloutFieldPreviousAppReplicaAutoScalePolicy)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+			if m.PreviousApp.ReplicaAutoScalePolicy.MaxReplicas != o.PreviousApp.ReplicaAutoScalePolicy.MaxReplicas {
+				fields.Set(AppRolloutFieldPreviousAppReplicaAutoScalePolicyMaxReplicas)
+				fields.Set(AppRolloutFieldPreviousAppReplicaAutoScalePolicy)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+			if m.PreviousApp.ReplicaAutoScalePolicy.TargetCpu != o.PreviousApp.ReplicaAutoScalePolicy.TargetCpu {
+				fields.Set(AppRolloutFieldPreviousAppReplicaAutoScalePolicyTargetCpu)
+				fields.Set(AppRolloutFieldPreviousAppReplicaAutoScalePolicy)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+			if m.PreviousApp.ReplicaAutoScalePolicy.TargetMem != o.PreviousApp.ReplicaAutoScalePolicy.TargetMem {
+				fields.Set(AppRolloutFieldPreviousAppReplicaAutoScalePolicyTargetMem)
+				fields.Set(AppRolloutFieldPreviousAppReplicaAutoScalePolicy)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+			if m.PreviousApp.ReplicaAutoScalePolicy.TargetActiveConnections != o.PreviousApp.ReplicaAutoScalePolicy.TargetActiveConnections {
+				fields.Set(AppRolloutFieldPreviousAppReplicaAutoScalePolicyTargetActiveConnections)
+				fields.Set(AppRolloutFieldPreviousAppReplicaAutoScalePolicy)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+			if m.PreviousApp.ReplicaAutoScalePolicy.StabilizationWindowSec != o.PreviousApp.ReplicaAutoScalePolicy.StabilizationWindowSec {
+				fields.Set(AppRolloutFieldPreviousAppReplicaAutoScalePolicyStabilizationWindowSec)
+				fields.Set(AppRolloutFieldPreviousAppReplicaAutoScalePolicy)
+				fields.Set(AppRolloutFieldPreviousApp)
+			}
+		} else if (m.PreviousApp.ReplicaAutoScalePolicy != nil && o.PreviousApp.ReplicaAutoScalePolicy == nil) || (m.PreviousApp.ReplicaAutoScalePolicy == nil && o.PreviousApp.ReplicaAutoScalePolicy != nil) {
+			fields.Set(AppRolloutFieldPreviousAppReplicaAutoScalePolicy)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+		if m.PreviousApp.Tags != nil && o.PreviousApp.Tags != nil {
+			if len(m.PreviousApp.Tags) != len(o.PreviousApp.Tags) {
+				fields.Set(AppRolloutFieldPreviousAppTags)
+				fields.Set(AppRolloutFieldPreviousApp)
+			} else {
+				for k1, _ := range m.PreviousApp.Tags {
+					_, vok1 := o.PreviousApp.Tags[k1]
+					if !vok1 {
+						fields.Set(AppRolloutFieldPreviousAppTags)
+						fields.Set(AppRolloutFieldPreviousApp)
+					} else {
+						if m.PreviousApp.Tags[k1] != o.PreviousApp.Tags[k1] {
+							fields.Set(AppRolloutFieldPreviousAppTags)
+							fields.Set(AppRolloutFieldPreviousApp)
+							break
+						}
+					}
+				}
+			}
+		} else if (m.PreviousApp.Tags != nil && o.PreviousApp.Tags == nil) || (m.PreviousApp.Tags == nil && o.PreviousApp.Tags != nil) {
+			fields.Set(AppRolloutFieldPreviousAppTags)
+			fields.Set(AppRolloutFieldPreviousApp)
+		}
+	} else if (m.PreviousApp != nil && o.PreviousApp == nil) || (m.PreviousApp == nil && o.PreviousApp != nil) {
+		fields.Set(AppRolloutFieldPreviousApp)
+	}
+	if len(m.Errors) != len(o.Errors) {
+		fields.Set(AppRolloutFieldErrors)
+	} else {
+		for i0 := 0; i0 < len(m.Errors); i0++ {
+			if m.Errors[i0] != o.Errors[i0] {
+				fields.Set(AppRolloutFieldErrors)
+				break
+			}
+		}
+	}
+	if m.CreatedAt.Seconds != o.CreatedAt.Seconds {
+		fields.Set(AppRolloutFieldCreatedAtSeconds)
+		fields.Set(AppRolloutFieldCreatedAt)
+	}
+	if m.CreatedAt.Nanos != o.CreatedAt.Nanos {
+		fields.Set(AppRolloutFieldCreatedAtNanos)
+		fields.Set(AppRolloutFieldCreatedAt)
+	}
+	if m.UpdatedAt.Seconds != o.UpdatedAt.Seconds {
+		fields.Set(AppRolloutFieldUpdatedAtSeconds)
+		fields.Set(AppRolloutFieldUpdatedAt)
+	}
+	if m.UpdatedAt.Nanos != o.UpdatedAt.Nanos {
+		fields.Set(AppRolloutFieldUpdatedAtNanos)
+		fields.Set(AppRolloutFieldUpdatedAt)
+	}
+}
+
+func (m *AppRollout) GetDiffFields(o *AppRollout) *FieldMap {
+	diffFields := NewFieldMap(nil)
+	m.DiffFields(o, diffFields)
+	return diffFields
+}
+
+func (m *AppRollout) Clone() *AppRollout {
+	cp := &AppRollout{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *AppRollout) AddAppConfigs(vals ...*ConfigFile) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.App.Configs {
+		cur[v.String()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.String()]; found {
+			continue // duplicate
+		}
+		m.App.Configs = append(m.App.Configs, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRollout) RemoveAppConfigs(vals ...*ConfigFile) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.String()] = struct{}{}
+	}
+	for i := len(m.App.Configs); i >= 0; i-- {
+		if _, found := remove[m.App.Configs[i].String()]; found {
+			m.App.Configs = append(m.App.Configs[:i], m.App.Configs[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRollout) AddAppAutoProvPolicies(vals ...string) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.App.AutoProvPolicies {
+		cur[v] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v]; found {
+			continue // duplicate
+		}
+		m.App.AutoProvPolicies = append(m.App.AutoProvPolicies, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRollout) RemoveAppAutoProvPolicies(vals ...string) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v] = struct{}{}
+	}
+	for i := len(m.App.AutoProvPolicies); i >= 0; i-- {
+		if _, found := remove[m.App.AutoProvPolicies[i]]; found {
+			m.App.AutoProvPolicies = append(m.App.AutoProvPolicies[:i], m.App.AutoProvPolicies[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRollout) AddAppRequiredOutboundConnections(vals ...SecurityRule) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.App.RequiredOutboundConnections {
+		cur[v.String()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.String()]; found {
+			continue // duplicate
+		}
+		m.App.RequiredOutboundConnections = append(m.App.RequiredOutboundConnections, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRollout) RemoveAppRequiredOutboundConnections(vals ...SecurityRule) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.String()] = struct{}{}
+	}
+	for i := len(m.App.RequiredOutboundConnections); i >= 0; i-- {
+		if _, found := remove[m.App.RequiredOutboundConnections[i].String()]; found {
+			m.App.RequiredOutboundConnections = append(m.App.RequiredOutboundConnections[:i], m.App.RequiredOutboundConnections[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRollout) AddAppAlertPolicies(vals ...string) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.App.AlertPolicies {
+		cur[v] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v]; found {
+			continue // duplicate
+		}
+		m.App.AlertPolicies = append(m.App.AlertPolicies, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRollout) RemoveAppAlertPolicies(vals ...string) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v] = struct{}{}
+	}
+	for i := len(m.App.AlertPolicies); i >= 0; i-- {
+		if _, found := remove[m.App.AlertPolicies[i]]; found {
+			m.App.AlertPolicies = append(m.App.AlertPolicies[:i], m.App.AlertPolicies[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRollout) AddAppCommandArgs(vals ...string) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.App.CommandArgs {
+		cur[v] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v]; found {
+			continue // duplicate
+		}
+		m.App.CommandArgs = append(m.App.CommandArgs, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRollout) RemoveAppCommandArgs(vals ...string) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v] = struct{}{}
+	}
+	for i := len(m.App.CommandArgs); i >= 0; i-- {
+		if _, found := remove[m.App.CommandArgs[i]]; found {
+			m.App.CommandArgs = append(m.App.CommandArgs[:i], m.App.CommandArgs[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRollout) AddAppKubernetesResourcesCpuPoolTopologyMinNodeGpus(vals ...*GPUResource) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.App.KubernetesResources.CpuPool.Topology.MinNodeGpus {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.App.KubernetesResources.CpuPool.Topology.MinNodeGpus = append(m.App.KubernetesResources.CpuPool.Topology.MinNodeGpus, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRollout) RemoveAppKubernetesResourcesCpuPoolTopologyMinNodeGpus(vals ...*GPUResource) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.App.KubernetesResources.CpuPool.Topology.MinNodeGpus); i >= 0; i-- {
+		if _, found := remove[m.App.KubernetesResources.CpuPool.Topology.MinNodeGpus[i].GetKey().GetKeyString()]; found {
+			m.App.KubernetesResources.CpuPool.Topology.MinNodeGpus = append(m.App.KubernetesResources.CpuPool.Topology.MinNodeGpus[:i], m.App.KubernetesResources.CpuPool.Topology.MinNodeGpus[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRollout) AddAppKubernetesResourcesCpuPoolTotalGpus(vals ...*GPUResource) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.App.KubernetesResources.CpuPool.TotalGpus {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.App.KubernetesResources.CpuPool.TotalGpus = append(m.App.KubernetesResources.CpuPool.TotalGpus, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRollout) RemoveAppKubernetesResourcesCpuPoolTotalGpus(vals ...*GPUResource) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.App.KubernetesResources.CpuPool.TotalGpus); i >= 0; i-- {
+		if _, found := remove[m.App.KubernetesResources.CpuPool.TotalGpus[i].GetKey().GetKeyString()]; found {
+			m.App.KubernetesResources.CpuPool.TotalGpus = append(m.App.KubernetesResources.CpuPool.TotalGpus[:i], m.App.KubernetesResources.CpuPool.TotalGpus[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRollout) AddAppKubernetesResourcesGpuPoolTopologyMinNodeGpus(vals ...*GPUResource) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.App.KubernetesResources.GpuPool.Topology.MinNodeGpus {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.App.KubernetesResources.GpuPool.Topology.MinNodeGpus = append(m.App.KubernetesResources.GpuPool.Topology.MinNodeGpus, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRollout) RemoveAppKubernetesResourcesGpuPoolTopologyMinNodeGpus(vals ...*GPUResource) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.App.KubernetesResources.GpuPool.Topology.MinNodeGpus); i >= 0; i-- {
+		if _, found := remove[m.App.KubernetesResources.GpuPool.Topology.MinNodeGpus[i].GetKey().GetKeyString()]; found {
+			m.App.KubernetesResources.GpuPool.Topology.MinNodeGpus = append(m.App.KubernetesResources.GpuPool.Topology.MinNodeGpus[:i], m.App.KubernetesResources.GpuPool.Topology.MinNodeGpus[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRollout) AddAppKubernetesResourcesGpuPoolTotalGpus(vals ...*GPUResource) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.App.KubernetesResources.GpuPool.TotalGpus {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.App.KubernetesResources.GpuPool.TotalGpus = append(m.App.KubernetesResources.GpuPool.TotalGpus, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRollout) RemoveAppKubernetesResourcesGpuPoolTotalGpus(vals ...*GPUResource) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.App.KubernetesResources.GpuPool.TotalGpus); i >= 0; i-- {
+		if _, found := remove[m.App.KubernetesResources.GpuPool.TotalGpus[i].GetKey().GetKeyString()]; found {
+			m.App.KubernetesResources.GpuPool.TotalGpus = append(m.App.KubernetesResources.GpuPool.TotalGpus[:i], m.App.KubernetesResources.GpuPool.TotalGpus[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRollout) AddAppNodeResourcesGpus(vals ...*GPUResource) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.App.NodeResources.Gpus {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.App.NodeResources.Gpus = append(m.App.NodeResources.Gpus, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRollout) RemoveAppNodeResourcesGpus(vals ...*GPUResource) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.App.NodeResources.Gpus); i >= 0; i-- {
+		if _, found := remove[m.App.NodeResources.Gpus[i].GetKey().GetKeyString()]; found {
+			m.App.NodeResources.Gpus = append(m.App.NodeResources.Gpus[:i], m.App.NodeResources.Gpus[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRollout) AddWavePercentages(vals ...uint32) int {
+	changes := 0
+	cur := make(map[uint32]struct{})
+	for _, v := range m.WavePercentages {
+		cur[v] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v]; found {
+			continue // duplicate
+		}
+		m.WavePercentages = append(m.WavePercentages, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRollout) RemoveWavePercentages(vals ...uint32) int {
+	changes := 0
+	remove := make(map[uint32]struct{})
+	for _, v := range vals {
+		remove[v] = struct{}{}
+	}
+	for i := len(m.WavePercentages); i >= 0; i-- {
+		if _, found := remove[m.WavePercentages[i]]; found {
+			m.WavePercentages = append(m.WavePercentages[:i], m.WavePercentages[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRollout) AddZones(vals ...ZoneKey) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Zones {
+		cur[v.GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Zones = append(m.Zones, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRollout) RemoveZones(vals ...ZoneKey) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Zones); i >= 0; i-- {
+		if _, found := remove[m.Zones[i].GetKeyString()]; found {
+			m.Zones = append(m.Zones[:i], m.Zones[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRollout) AddWaves(vals ...AppRolloutWave) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Waves {
+		cur[v.String()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.String()]; found {
+			continue // duplicate
+		}
+		m.Waves = append(m.Waves, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRollout) RemoveWaves(vals ...AppRolloutWave) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.String()] = struct{}{}
+	}
+	for i := len(m.Waves); i >= 0; i-- {
+		if _, found := remove[m.Waves[i].String()]; found {
+			m.Waves = append(m.Waves[:i], m.Waves[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRollout) AddPreviousAppConfigs(vals ...*ConfigFile) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.PreviousApp.Configs {
+		cur[v.String()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.String()]; found {
+			continue // duplicate
+		}
+		m.PreviousApp.Configs = append(m.PreviousApp.Configs, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRollout) RemovePreviousAppConfigs(vals ...*ConfigFile) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.String()] = struct{}{}
+	}
+	for i := len(m.PreviousApp.Configs); i >= 0; i-- {
+		if _, found := remove[m.PreviousApp.Configs[i].String()]; found {
+			m.PreviousApp.Configs = append(m.PreviousApp.Configs[:i], m.PreviousApp.Configs[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRollout) AddPreviousAppAutoProvPolicies(vals ...string) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.PreviousApp.AutoProvPolicies {
+		cur[v] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v]; found {
+			continue // duplicate
+		}
+		m.PreviousApp.AutoProvPolicies = append(m.PreviousApp.AutoProvPolicies, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRollout) RemovePreviousAppAutoProvPolicies(vals ...string) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v] = struct{}{}
+	}
+	for i := len(m.PreviousApp.AutoProvPolicies); i >= 0; i-- {
+		if _, found := remove[m.PreviousApp.AutoProvPolicies[i]]; found {
+			m.PreviousApp.AutoProvPolicies = append(m.PreviousApp.AutoProvPolicies[:i], m.PreviousApp.AutoProvPolicies[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRollout) AddPreviousAppRequiredOutboundConnections(vals ...SecurityRule) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.PreviousApp.RequiredOutboundConnections {
+		cur[v.String()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.String()]; found {
+			continue // duplicate
+		}
+		m.PreviousApp.RequiredOutboundConnections = append(m.PreviousApp.RequiredOutboundConnections, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRollout) RemovePreviousAppRequiredOutboundConnections(vals ...SecurityRule) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.String()] = struct{}{}
+	}
+	for i := len(m.PreviousApp.RequiredOutboundConnections); i >= 0; i-- {
+		if _, found := remove[m.PreviousApp.RequiredOutboundConnections[i].String()]; found {
+			m.PreviousApp.RequiredOutboundConnections = append(m.PreviousApp.RequiredOutboundConnections[:i], m.PreviousApp.RequiredOutboundConnections[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRollout) AddPreviousAppAlertPolicies(vals ...string) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.PreviousApp.AlertPolicies {
+		cur[v] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v]; found {
+			continue // duplicate
+		}
+		m.PreviousApp.AlertPolicies = append(m.PreviousApp.AlertPolicies, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRollout) RemovePreviousAppAlertPolicies(vals ...string) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v] = struct{}{}
+	}
+	for i := len(m.PreviousApp.AlertPolicies); i >= 0; i-- {
+		if _, found := remove[m.PreviousApp.AlertPolicies[i]]; found {
+			m.PreviousApp.AlertPolicies = append(m.PreviousApp.AlertPolicies[:i], m.PreviousApp.AlertPolicies[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRollout) AddPreviousAppCommandArgs(vals ...string) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.PreviousApp.CommandArgs {
+		cur[v] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v]; found {
+			continue // duplicate
+		}
+		m.PreviousApp.CommandArgs = append(m.PreviousApp.CommandArgs, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRollout) RemovePreviousAppCommandArgs(vals ...string) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v] = struct{}{}
+	}
+	for i := len(m.PreviousApp.CommandArgs); i >= 0; i-- {
+		if _, found := remove[m.PreviousApp.CommandArgs[i]]; found {
+			m.PreviousApp.CommandArgs = append(m.PreviousApp.CommandArgs[:i], m.PreviousApp.CommandArgs[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRollout) AddPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpus(vals ...*GPUResource) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus = append(m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRollout) RemovePreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpus(vals ...*GPUResource) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus); i >= 0; i-- {
+		if _, found := remove[m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus[i].GetKey().GetKeyString()]; found {
+			m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus = append(m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus[:i], m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRollout) AddPreviousAppKubernetesResourcesCpuPoolTotalGpus(vals ...*GPUResource) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.PreviousApp.KubernetesResources.CpuPool.TotalGpus {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.PreviousApp.KubernetesResources.CpuPool.TotalGpus = append(m.PreviousApp.KubernetesResources.CpuPool.TotalGpus, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRollout) RemovePreviousAppKubernetesResourcesCpuPoolTotalGpus(vals ...*GPUResource) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.PreviousApp.KubernetesResources.CpuPool.TotalGpus); i >= 0; i-- {
+		if _, found := remove[m.PreviousApp.KubernetesResources.CpuPool.TotalGpus[i].GetKey().GetKeyString()]; found {
+			m.PreviousApp.KubernetesResources.CpuPool.TotalGpus = append(m.PreviousApp.KubernetesResources.CpuPool.TotalGpus[:i], m.PreviousApp.KubernetesResources.CpuPool.TotalGpus[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRollout) AddPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpus(vals ...*GPUResource) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus = append(m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRollout) RemovePreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpus(vals ...*GPUResource) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus); i >= 0; i-- {
+		if _, found := remove[m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus[i].GetKey().GetKeyString()]; found {
+			m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus = append(m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus[:i], m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRollout) AddPreviousAppKubernetesResourcesGpuPoolTotalGpus(vals ...*GPUResource) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.PreviousApp.KubernetesResources.GpuPool.TotalGpus {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.PreviousApp.KubernetesResources.GpuPool.TotalGpus = append(m.PreviousApp.KubernetesResources.GpuPool.TotalGpus, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRollout) RemovePreviousAppKubernetesResourcesGpuPoolTotalGpus(vals ...*GPUResource) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.PreviousApp.KubernetesResources.GpuPool.TotalGpus); i >= 0; i-- {
+		if _, found := remove[m.PreviousApp.KubernetesResources.GpuPool.TotalGpus[i].GetKey().GetKeyString()]; found {
+			m.PreviousApp.KubernetesResources.GpuPool.TotalGpus = append(m.PreviousApp.KubernetesResources.GpuPool.TotalGpus[:i], m.PreviousApp.KubernetesResources.GpuPool.TotalGpus[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRollout) AddPreviousAppNodeResourcesGpus(vals ...*GPUResource) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.PreviousApp.NodeResources.Gpus {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.PreviousApp.NodeResources.Gpus = append(m.PreviousApp.NodeResources.Gpus, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRollout) RemovePreviousAppNodeResourcesGpus(vals ...*GPUResource) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.PreviousApp.NodeResources.Gpus); i >= 0; i-- {
+		if _, found := remove[m.PreviousApp.NodeResources.Gpus[i].GetKey().GetKeyString()]; found {
+			m.PreviousApp.NodeResources.Gpus = append(m.PreviousApp.NodeResources.Gpus[:i], m.PreviousApp.NodeResources.Gpus[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRollout) AddErrors(vals ...string) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Errors {
+		cur[v] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v]; found {
+			continue // duplicate
+		}
+		m.Errors = append(m.Errors, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AppRollout) RemoveErrors(vals ...string) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v] = struct{}{}
+	}
+	for i := len(m.Errors); i >= 0; i-- {
+		if _, found := remove[m.Errors[i]]; found {
+			m.Errors = append(m.Errors[:i], m.Errors[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AppRollout) CopyInFields(src *AppRollout) int {
+	updateListAction := "replace"
+	changed := 0
+	fmap := MakeFieldMap(src.Fields)
+	if fmap.HasOrHasChild("2") {
+		if fmap.Has("2.1") {
+			if m.Key.Organization != src.Key.Organization {
+				m.Key.Organization = src.Key.Organization
+				changed++
+			}
+		}
+		if fmap.Has("2.2") {
+			if m.Key.Name != src.Key.Name {
+				m.Key.Name = src.Key.Name
+				changed++
+			}
+		}
+		if fmap.Has("2.3") {
+			if m.Key.Version != src.Key.Version {
+				m.Key.Version = src.Key.Version
+				changed++
+			}
+		}
+	}
+	if fmap.HasOrHasChild("3") {
+		if src.App != nil {
+			if m.App == nil {
+				m.App = &App{}
+			}
+			if fmap.HasOrHasChild("3.2") {
+				if fmap.Has("3.2.1") {
+					if m.App.Key.Organization != src.App.Key.Organization {
+						m.App.Key.Organization = src.App.Key.Organization
+						changed++
+					}
+				}
+				if fmap.Has("3.2.2") {
+					if m.App.Key.Name != src.App.Key.Name {
+						m.App.Key.Name = src.App.Key.Name
+						changed++
+					}
+				}
+				if fmap.Has("3.2.3") {
+					if m.App.Key.Version != src.App.Key.Version {
+						m.App.Key.Version = src.App.Key.Version
+						changed++
+					}
+				}
+			}
+			if fmap.Has("3.4") {
+				if m.App.ImagePath != src.App.ImagePath {
+					m.App.ImagePath = src.App.ImagePath
+					changed++
+				}
+			}
+			if fmap.Has("3.5") {
+				if m.App.ImageType != src.App.ImageType {
+					m.App.ImageType = src.App.ImageType
+					changed++
+				}
+			}
+			if fmap.Has("3.7") {
+				if m.App.AccessPorts != src.App.AccessPorts {
+					m.App.AccessPorts = src.App.AccessPorts
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("3.9") {
+				if fmap.Has("3.9.1") {
+					if m.App.DefaultFlavor.Name != src.App.DefaultFlavor.Name {
+						m.App.DefaultFlavor.Name = src.App.DefaultFlavor.Name
+						changed++
+					}
+				}
+			}
+			if fmap.Has("3.12") {
+				if m.App.AuthPublicKey != src.App.AuthPublicKey {
+					m.App.AuthPublicKey = src.App.AuthPublicKey
+					changed++
+				}
+			}
+			if fmap.Has("3.13") {
+				if m.App.Command != src.App.Command {
+					m.App.Command = src.App.Command
+					changed++
+				}
+			}
+			if fmap.Has("3.14") {
+				if m.App.Annotations != src.App.Annotations {
+					m.App.Annotations = src.App.Annotations
+					changed++
+				}
+			}
+			if fmap.Has("3.15") {
+				if m.App.Deployment != src.App.Deployment {
+					m.App.Deployment = src.App.Deployment
+					changed++
+				}
+			}
+			if fmap.Has("3.16") {
+				if m.App.DeploymentManifest != src.App.DeploymentManifest {
+					m.App.DeploymentManifest = src.App.DeploymentManifest
+					changed++
+				}
+			}
+			if fmap.Has("3.17") {
+				if m.App.DeploymentGenerator != src.App.DeploymentGenerator {
+					m.App.DeploymentGenerator = src.App.DeploymentGenerator
+					changed++
+				}
+			}
+			if fmap.Has("3.18") {
+				if m.App.AndroidPackageName != src.App.AndroidPackageName {
+					m.App.AndroidPackageName = src.App.AndroidPackageName
+					changed++
+				}
+			}
+			if fmap.Has("3.20") {
+				if m.App.DelOpt != src.App.DelOpt {
+					m.App.DelOpt = src.App.DelOpt
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("3.21") {
+				if src.App.Configs != nil {
+					if updateListAction == "add" {
+						changed += m.AddAppConfigs(src.App.Configs...)
+					} else if updateListAction == "remove" {
+						changed += m.RemoveAppConfigs(src.App.Configs...)
+					} else {
+						m.App.Configs = make([]*ConfigFile, 0)
+						for k1, _ := range src.App.Configs {
+							m.App.Configs = append(m.App.Configs, src.App.Configs[k1].Clone())
+						}
+						changed++
+					}
+				} else if m.App.Configs != nil {
+					m.App.Configs = nil
+					changed++
+				}
+			}
+			if fmap.Has("3.22") {
+				if m.App.ScaleWithCluster != src.App.ScaleWithCluster {
+					m.App.ScaleWithCluster = src.App.ScaleWithCluster
+					changed++
+				}
+			}
+			if fmap.Has("3.23") {
+				if m.App.InternalPorts != src.App.InternalPorts {
+					m.App.InternalPorts = src.App.InternalPorts
+					changed++
+				}
+			}
+			if fmap.Has("3.24") {
+				if m.App.Revision != src.App.Revision {
+					m.App.Revision = src.App.Revision
+					changed++
+				}
+			}
+			if fmap.Has("3.25") {
+				if m.App.OfficialFqdn != src.App.OfficialFqdn {
+					m.App.OfficialFqdn = src.App.OfficialFqdn
+					changed++
+				}
+			}
+			if fmap.Has("3.26") {
+				if m.App.Md5Sum != src.App.Md5Sum {
+					m.App.Md5Sum = src.App.Md5Sum
+					changed++
+				}
+			}
+			if fmap.Has("3.28") {
+				if m.App.AutoProvPolicy != src.App.AutoProvPolicy {
+					m.App.AutoProvPolicy = src.App.AutoProvPolicy
+					changed++
+				}
+			}
+			if fmap.Has("3.29") {
+				if m.App.AccessType != src.App.AccessType {
+					m.App.AccessType = src.App.AccessType
+					changed++
+				}
+			}
+			if fmap.Has("3.31") {
+				if m.App.DeletePrepare != src.App.DeletePrepare {
+					m.App.DeletePrepare = src.App.DeletePrepare
+					changed++
+				}
+			}
+			if fmap.Has("3.32") {
+				if src.App.AutoProvPolicies != nil {
+					if updateListAction == "add" {
+						changed += m.AddAppAutoProvPolicies(src.App.AutoProvPolicies...)
+					} else if updateListAction == "remove" {
+						changed += m.RemoveAppAutoProvPolicies(src.App.AutoProvPolicies...)
+					} else {
+						m.App.AutoProvPolicies = make([]string, 0)
+						m.App.AutoProvPolicies = append(m.App.AutoProvPolicies, src.App.AutoProvPolicies...)
+						changed++
+					}
+				} else if m.App.AutoProvPolicies != nil {
+					m.App.AutoProvPolicies = nil
+					changed++
+				}
+			}
+			if fmap.Has("3.33") {
+				if m.App.TemplateDelimiter != src.App.TemplateDelimiter {
+					m.App.TemplateDelimiter = src.App.TemplateDelimiter
+					changed++
+				}
+			}
+			if fmap.Has("3.34") {
+				if m.App.SkipHcPorts != src.App.SkipHcPorts {
+					m.App.SkipHcPorts = src.App.SkipHcPorts
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("3.35") {
+				if fmap.Has("3.35.1") {
+					if m.App.CreatedAt.Seconds != src.App.CreatedAt.Seconds {
+						m.App.CreatedAt.Seconds = src.App.CreatedAt.Seconds
+						changed++
+					}
+				}
+				if fmap.Has("3.35.2") {
+					if m.App.CreatedAt.Nanos != src.App.CreatedAt.Nanos {
+						m.App.CreatedAt.Nanos = src.App.CreatedAt.Nanos
+						changed++
+					}
+				}
+			}
+			if fmap.HasOrHasChild("3.36") {
+				if fmap.Has("3.36.1") {
+					if m.App.UpdatedAt.Seconds != src.App.UpdatedAt.Seconds {
+						m.App.UpdatedAt.Seconds = src.App.UpdatedAt.Seconds
+						changed++
+					}
+				}
+				if fmap.Has("3.36.2") {
+					if m.App.UpdatedAt.Nanos != src.App.UpdatedAt.Nanos {
+						m.App.UpdatedAt.Nanos = src.App.UpdatedAt.Nanos
+						changed++
+					}
+				}
+			}
+			if fmap.Has("3.37") {
+				if m.App.Trusted != src.App.Trusted {
+					m.App.Trusted = src.App.Trusted
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("3.38") {
+				if src.App.RequiredOutboundConnections != nil {
+					if updateListAction == "add" {
+						changed += m.AddAppRequiredOutboundConnections(src.App.RequiredOutboundConnections...)
+					} else if updateListAction == "remove" {
+						changed += m.RemoveAppRequiredOutboundConnections(src.App.RequiredOutboundConnections...)
+					} else {
+						m.App.RequiredOutboundConnections = make([]SecurityRule, 0)
+						for k1, _ := range src.App.RequiredOutboundConnections {
+							m.App.RequiredOutboundConnections = append(m.App.RequiredOutboundConnections, *src.App.RequiredOutboundConnections[k1].Clone())
+						}
+						changed++
+					}
+				} else if m.App.RequiredOutboundConnections != nil {
+					m.App.RequiredOutboundConnections = nil
+					changed++
+				}
+			}
+			if fmap.Has("3.39") {
+				if m.App.AllowServerless != src.App.AllowServerless {
+					m.App.AllowServerless = src.App.AllowServerless
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("3.40") {
+				if src.App.ServerlessConfig != nil {
+					if m.App.ServerlessConfig == nil {
+						m.App.ServerlessConfig = &ServerlessConfig{}
+					}
+					if fmap.HasOrHasChild("3.40.1") {
+						if m.App.ServerlessConfig.Vcpus.Whole != src.App.ServerlessConfig.Vcpus.Whole {
+							m.App.ServerlessConfig.Vcpus.Whole = src.App.ServerlessConfig.Vcpus.Whole
+							changed++
+						}
+						if m.App.ServerlessConfig.Vcpus.Nanos != src.App.ServerlessConfig.Vcpus.Nanos {
+							m.App.ServerlessConfig.Vcpus.Nanos = src.App.ServerlessConfig.Vcpus.Nanos
+							changed++
+						}
+					}
+					if fmap.Has("3.40.2") {
+						if m.App.ServerlessConfig.Ram != src.App.ServerlessConfig.Ram {
+							m.App.ServerlessConfig.Ram = src.App.ServerlessConfig.Ram
+							changed++
+						}
+					}
+					if fmap.Has("3.40.3") {
+						if m.App.ServerlessConfig.MinReplicas != src.App.ServerlessConfig.MinReplicas {
+							m.App.ServerlessConfig.MinReplicas = src.App.ServerlessConfig.MinReplicas
+							changed++
+						}
+					}
+					if fmap.HasOrHasChild("3.40.4") {
+						if fmap.Has("3.40.4.1") {
+							if m.App.ServerlessConfig.GpuConfig.Type != src.App.ServerlessConfig.GpuConfig.Type {
+								m.App.ServerlessConfig.GpuConfig.Type = src.App.ServerlessConfig.GpuConfig.Type
+								changed++
+							}
+						}
+						if fmap.Has("3.40.4.2") {
+							if m.App.ServerlessConfig.GpuConfig.Model != src.App.ServerlessConfig.GpuConfig.Model {
+								m.App.ServerlessConfig.GpuConfig.Model = src.App.ServerlessConfig.GpuConfig.Model
+								changed++
+							}
+						}
+						if fmap.Has("3.40.4.3") {
+							if m.App.ServerlessConfig.GpuConfig.NumGpu != src.App.ServerlessConfig.GpuConfig.NumGpu {
+								m.App.ServerlessConfig.GpuConfig.NumGpu = src.App.ServerlessConfig.GpuConfig.NumGpu
+								changed++
+							}
+						}
+						if fmap.Has("3.40.4.4") {
+							if m.App.ServerlessConfig.GpuConfig.Ram != src.App.ServerlessConfig.GpuConfig.Ram {
+								m.App.ServerlessConfig.GpuConfig.Ram = src.App.ServerlessConfig.GpuConfig.Ram
+								changed++
+							}
+						}
+					}
+				} else if m.App.ServerlessConfig != nil {
+					m.App.ServerlessConfig = nil
+					changed++
+				}
+			}
+			if fmap.Has("3.41") {
+				if m.App.VmAppOsType != src.App.VmAppOsType {
+					m.App.VmAppOsType = src.App.VmAppOsType
+					changed++
+				}
+			}
+			if fmap.Has("3.42") {
+				if src.App.AlertPolicies != nil {
+					if updateListAction == "add" {
+						changed += m.AddAppAlertPolicies(src.App.AlertPolicies...)
+					} else if updateListAction == "remove" {
+						changed += m.RemoveAppAlertPolicies(src.App.AlertPolicies...)
+					} else {
+						m.App.AlertPolicies = make([]string, 0)
+						m.App.AlertPolicies = append(m.App.AlertPolicies, src.App.AlertPolicies...)
+						changed++
+					}
+				} else if m.App.AlertPolicies != nil {
+					m.App.AlertPolicies = nil
+					changed++
+				}
+			}
+			if fmap.Has("3.43") {
+				if m.App.QosSessionProfile != src.App.QosSessionProfile {
+					m.App.QosSessionProfile = src.App.QosSessionProfile
+					changed++
+				}
+			}
+			if fmap.Has("3.44") {
+				if m.App.QosSessionDuration != src.App.QosSessionDuration {
+					m.App.QosSessionDuration = src.App.QosSessionDuration
+					changed++
+				}
+			}
+			if fmap.Has("3.45") {
+				if m.App.GlobalId != src.App.GlobalId {
+					m.App.GlobalId = src.App.GlobalId
+					changed++
+				}
+			}
+			if fmap.Has("3.46") {
+				if src.App.CommandArgs != nil {
+					if updateListAction == "add" {
+						changed += m.AddAppCommandArgs(src.App.CommandArgs...)
+					} else if updateListAction == "remove" {
+						changed += m.RemoveAppCommandArgs(src.App.CommandArgs...)
+					} else {
+						m.App.CommandArgs = make([]string, 0)
+						m.App.CommandArgs = append(m.App.CommandArgs, src.App.CommandArgs...)
+						changed++
+					}
+				} else if m.App.CommandArgs != nil {
+					m.App.CommandArgs = nil
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("3.47") {
+				if src.App.EnvVars != nil {
+					if updateListAction == "add" {
+						for k1, v := range src.App.EnvVars {
+							m.App.EnvVars[k1] = v
+							changed++
+						}
+					} else if updateListAction == "remove" {
+						for k1, _ := range src.App.EnvVars {
+							if _, ok := m.App.EnvVars[k1]; ok {
+								delete(m.App.EnvVars, k1)
+								changed++
+							}
+						}
+					} else {
+						m.App.EnvVars = make(map[string]string)
+						for k1, v := range src.App.EnvVars {
+							m.App.EnvVars[k1] = v
+						}
+						changed++
+					}
+				} else if m.App.EnvVars != nil {
+					m.App.EnvVars = nil
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("3.48") {
+				if src.App.SecretEnvVars != nil {
+					if updateListAction == "add" {
+						for k1, v := range src.App.SecretEnvVars {
+							m.App.SecretEnvVars[k1] = v
+							changed++
+						}
+					} else if updateListAction == "remove" {
+						for k1, _ := range src.App.SecretEnvVars {
+							if _, ok := m.App.SecretEnvVars[k1]; ok {
+								delete(m.App.SecretEnvVars, k1)
+								changed++
+							}
+						}
+					} else {
+						m.App.SecretEnvVars = make(map[string]string)
+						for k1, v := range src.App.SecretEnvVars {
+							m.App.SecretEnvVars[k1] = v
+						}
+						changed++
+					}
+				} else if m.App.SecretEnvVars != nil {
+					m.App.SecretEnvVars = nil
+					changed++
+				}
+			}
+			if fmap.Has("3.49") {
+				if m.App.UpdateListAction != src.App.UpdateListAction {
+					m.App.UpdateListAction = src.App.UpdateListAction
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("3.50") {
+				if src.App.KubernetesResources != nil {
+					if m.App.KubernetesResources == nil {
+						m.App.KubernetesResources = &KubernetesResources{}
+					}
+					if fmap.HasOrHasChild("3.50.1") {
+						if src.App.KubernetesResources.CpuPool != nil {
+							if m.App.KubernetesResources.CpuPool == nil {
+								m.App.KubernetesResources.CpuPool = &NodePoolResources{}
+							}
+							if fmap.HasOrHasChild("3.50.1.1") {
+								if m.App.KubernetesResources.CpuPool.TotalVcpus.Whole != src.App.KubernetesResources.CpuPool.TotalVcpus.Whole {
+									m.App.KubernetesResources.CpuPool.TotalVcpus.Whole = src.App.KubernetesResources.CpuPool.TotalVcpus.Whole
+									changed++
+								}
+								if m.App.KubernetesResources.CpuPool.TotalVcpus.Nanos != src.App.KubernetesResources.CpuPool.TotalVcpus.Nanos {
+									m.App.KubernetesResources.CpuPool.TotalVcpus.Nanos = src.App.KubernetesResources.CpuPool.TotalVcpus.Nanos
+									changed++
+								}
+							}
+							if fmap.Has("3.50.1.2") {
+								if m.App.KubernetesResources.CpuPool.TotalMemory != src.App.KubernetesResources.CpuPool.TotalMemory {
+									m.App.KubernetesResources.CpuPool.TotalMemory = src.App.KubernetesResources.CpuPool.TotalMemory
+									changed++
+								}
+							}
+							if fmap.Has("3.50.1.3") {
+								if m.App.KubernetesResources.CpuPool.TotalDisk != src.App.KubernetesResources.CpuPool.TotalDisk {
+									m.App.KubernetesResources.CpuPool.TotalDisk = src.App.KubernetesResources.CpuPool.TotalDisk
+									changed++
+								}
+							}
+							if fmap.HasOrHasChild("3.50.1.4") {
+								if src.App.KubernetesResources.CpuPool.TotalOptRes != nil {
+									if updateListAction == "add" {
+										for k3, v := range src.App.KubernetesResources.CpuPool.TotalOptRes {
+											m.App.KubernetesResources.CpuPool.TotalOptRes[k3] = v
+											changed++
+										}
+									} else if updateListAction == "remove" {
+										for k3, _ := range src.App.KubernetesResources.CpuPool.TotalOptRes {
+											if _, ok := m.App.KubernetesResources.CpuPool.TotalOptRes[k3]; ok {
+												delete(m.App.KubernetesResources.CpuPool.TotalOptRes, k3)
+												changed++
+											}
+										}
+									} else {
+										m.App.KubernetesResources.CpuPool.TotalOptRes = make(map[string]string)
+										for k3, v := range src.App.KubernetesResources.CpuPool.TotalOptRes {
+											m.App.KubernetesResources.CpuPool.TotalOptRes[k3] = v
+										}
+										changed++
+									}
+								} else if m.App.KubernetesResources.CpuPool.TotalOptRes != nil {
+									m.App.KubernetesResources.CpuPool.TotalOptRes = nil
+									changed++
+								}
+							}
+							if fmap.HasOrHasChild("3.50.1.5") {
+								if fmap.Has("3.50.1.5.1") {
+									if m.App.KubernetesResources.CpuPool.Topology.MinNodeVcpus != src.App.KubernetesResources.CpuPool.Topology.MinNodeVcpus {
+										m.App.KubernetesResources.CpuPool.Topology.MinNodeVcpus = src.App.KubernetesResources.CpuPool.Topology.MinNodeVcpus
+										changed++
+									}
+								}
+								if fmap.Has("3.50.1.5.2") {
+									if m.App.KubernetesResources.CpuPool.Topology.MinNodeMemory != src.App.KubernetesResources.CpuPool.Topology.MinNodeMemory {
+										m.App.KubernetesResources.CpuPool.Topology.MinNodeMemory = src.App.KubernetesResources.CpuPool.Topology.MinNodeMemory
+										changed++
+									}
+								}
+								if fmap.Has("3.50.1.5.3") {
+									if m.App.KubernetesResources.CpuPool.Topology.MinNodeDisk != src.App.KubernetesResources.CpuPool.Topology.MinNodeDisk {
+										m.App.KubernetesResources.CpuPool.Topology.MinNodeDisk = src.App.KubernetesResources.CpuPool.Topology.MinNodeDisk
+										changed++
+									}
+								}
+								if fmap.HasOrHasChild("3.50.1.5.4") {
+									if src.App.KubernetesResources.CpuPool.Topology.MinNodeOptRes != nil {
+										if updateListAction == "add" {
+											for k4, v := range src.App.KubernetesResources.CpuPool.Topology.MinNodeOptRes {
+												m.App.KubernetesResources.CpuPool.Topology.MinNodeOptRes[k4] = v
+												changed++
+											}
+										} else if updateListAction == "remove" {
+											for k4, _ := range src.App.KubernetesResources.CpuPool.Topology.MinNodeOptRes {
+												if _, ok := m.App.KubernetesResources.CpuPool.Topology.MinNodeOptRes[k4]; ok {
+													delete(m.App.KubernetesResources.CpuPool.Topology.MinNodeOptRes, k4)
+													changed++
+												}
+											}
+										} else {
+											m.App.KubernetesResources.CpuPool.Topology.MinNodeOptRes = make(map[string]string)
+											for k4, v := range src.App.KubernetesResources.CpuPool.Topology.MinNodeOptRes {
+												m.App.KubernetesResources.CpuPool.Topology.MinNodeOptRes[k4] = v
+											}
+											changed++
+										}
+									} else if m.App.KubernetesResources.CpuPool.Topology.MinNodeOptRes != nil {
+										m.App.KubernetesResources.CpuPool.Topology.MinNodeOptRes = nil
+										changed++
+									}
+								}
+								if fmap.Has("3.50.1.5.5") {
+									if m.App.KubernetesResources.CpuPool.Topology.MinNumberOfNodes != src.App.KubernetesResources.CpuPool.Topology.MinNumberOfNodes {
+										m.App.KubernetesResources.CpuPool.Topology.MinNumberOfNodes = src.App.KubernetesResources.CpuPool.Topology.MinNumberOfNodes
+										changed++
+									}
+								}
+								if fmap.HasOrHasChild("3.50.1.5.6") {
+									if src.App.KubernetesResources.CpuPool.Topology.MinNodeGpus != nil {
+										if updateListAction == "add" {
+											changed += m.AddAppKubernetesResourcesCpuPoolTopologyMinNodeGpus(src.App.KubernetesResources.CpuPool.Topology.MinNodeGpus...)
+										} else if updateListAction == "remove" {
+											changed += m.RemoveAppKubernetesResourcesCpuPoolTopologyMinNodeGpus(src.App.KubernetesResources.CpuPool.Topology.MinNodeGpus...)
+										} else {
+											m.App.KubernetesResources.CpuPool.Topology.MinNodeGpus = make([]*GPUResource, 0)
+											for k4, _ := range src.App.KubernetesResources.CpuPool.Topology.MinNodeGpus {
+												m.App.KubernetesResources.CpuPool.Topology.MinNodeGpus = append(m.App.KubernetesResources.CpuPool.Topology.MinNodeGpus, src.App.KubernetesResources.CpuPool.Topology.MinNodeGpus[k4].Clone())
+											}
+											changed++
+										}
+									} else if m.App.KubernetesResources.CpuPool.Topology.MinNodeGpus != nil {
+										m.App.KubernetesResources.CpuPool.Topology.MinNodeGpus = nil
+										changed++
+									}
+								}
+							}
+							if fmap.HasOrHasChild("3.50.1.6") {
+								if src.App.KubernetesResources.CpuPool.TotalGpus != nil {
+									if updateListAction == "add" {
+										changed += m.AddAppKubernetesResourcesCpuPoolTotalGpus(src.App.KubernetesResources.CpuPool.TotalGpus...)
+									} else if updateListAction == "remove" {
+										changed += m.RemoveAppKubernetesResourcesCpuPoolTotalGpus(src.App.KubernetesResources.CpuPool.TotalGpus...)
+									} else {
+										m.App.KubernetesResources.CpuPool.TotalGpus = make([]*GPUResource, 0)
+										for k3, _ := range src.App.KubernetesResources.CpuPool.TotalGpus {
+											m.App.KubernetesResources.CpuPool.TotalGpus = append(m.App.KubernetesResources.CpuPool.TotalGpus, src.App.KubernetesResources.CpuPool.TotalGpus[k3].Clone())
+										}
+										changed++
+									}
+								} else if m.App.KubernetesResources.CpuPool.TotalGpus != nil {
+									m.App.KubernetesResources.CpuPool.TotalGpus = nil
+									changed++
+								}
+							}
+						} else if m.App.KubernetesResources.CpuPool != nil {
+							m.App.KubernetesResources.CpuPool = nil
+							changed++
+						}
+					}
+					if fmap.HasOrHasChild("3.50.2") {
+						if src.App.KubernetesResources.GpuPool != nil {
+							if m.App.KubernetesResources.GpuPool == nil {
+								m.App.KubernetesResources.GpuPool = &NodePoolResources{}
+							}
+							if fmap.HasOrHasChild("3.50.2.1") {
+								if m.App.KubernetesResources.GpuPool.TotalVcpus.Whole != src.App.KubernetesResources.GpuPool.TotalVcpus.Whole {
+									m.App.KubernetesResources.GpuPool.TotalVcpus.Whole = src.App.KubernetesResources.GpuPool.TotalVcpus.Whole
+									changed++
+								}
+								if m.App.KubernetesResources.GpuPool.TotalVcpus.Nanos != src.App.KubernetesResources.GpuPool.TotalVcpus.Nanos {
+									m.App.KubernetesResources.GpuPool.TotalVcpus.Nanos = src.App.KubernetesResources.GpuPool.TotalVcpus.Nanos
+									changed++
+								}
+							}
+							if fmap.Has("3.50.2.2") {
+								if m.App.KubernetesResources.GpuPool.TotalMemory != src.App.KubernetesResources.GpuPool.TotalMemory {
+									m.App.KubernetesResources.GpuPool.TotalMemory = src.App.KubernetesResources.GpuPool.TotalMemory
+									changed++
+								}
+							}
+							if fmap.Has("3.50.2.3") {
+								if m.App.KubernetesResources.GpuPool.TotalDisk != src.App.KubernetesResources.GpuPool.TotalDisk {
+									m.App.KubernetesResources.GpuPool.TotalDisk = src.App.KubernetesResources.GpuPool.TotalDisk
+									changed++
+								}
+							}
+							if fmap.HasOrHasChild("3.50.2.4") {
+								if src.App.KubernetesResources.GpuPool.TotalOptRes != nil {
+									if updateListAction == "add" {
+										for k3, v := range src.App.KubernetesResources.GpuPool.TotalOptRes {
+											m.App.KubernetesResources.GpuPool.TotalOptRes[k3] = v
+											changed++
+										}
+									} else if updateListAction == "remove" {
+										for k3, _ := range src.App.KubernetesResources.GpuPool.TotalOptRes {
+											if _, ok := m.App.KubernetesResources.GpuPool.TotalOptRes[k3]; ok {
+												delete(m.App.KubernetesResources.GpuPool.TotalOptRes, k3)
+												changed++
+											}
+										}
+									} else {
+										m.App.KubernetesResources.GpuPool.TotalOptRes = make(map[string]string)
+										for k3, v := range src.App.KubernetesResources.GpuPool.TotalOptRes {
+											m.App.KubernetesResources.GpuPool.TotalOptRes[k3] = v
+										}
+										changed++
+									}
+								} else if m.App.KubernetesResources.GpuPool.TotalOptRes != nil {
+									m.App.KubernetesResources.GpuPool.TotalOptRes = nil
+									changed++
+								}
+							}
+							if fmap.HasOrHasChild("3.50.2.5") {
+								if fmap.Has("3.50.2.5.1") {
+									if m.App.KubernetesResources.GpuPool.Topology.MinNodeVcpus != src.App.KubernetesResources.GpuPool.Topology.MinNodeVcpus {
+										m.App.KubernetesResources.GpuPool.Topology.MinNodeVcpus = src.App.KubernetesResources.GpuPool.Topology.MinNodeVcpus
+										changed++
+									}
+								}
+								if fmap.Has("3.50.2.5.2") {
+									if m.App.KubernetesResources.GpuPool.Topology.MinNodeMemory != src.App.KubernetesResources.GpuPool.Topology.MinNodeMemory {
+										m.App.KubernetesResources.GpuPool.Topology.MinNodeMemory = src.App.KubernetesResources.GpuPool.Topology.MinNodeMemory
+										changed++
+									}
+								}
+								if fmap.Has("3.50.2.5.3") {
+									if m.App.KubernetesResources.GpuPool.Topology.MinNodeDisk != src.App.KubernetesResources.GpuPool.Topology.MinNodeDisk {
+										m.App.KubernetesResources.GpuPool.Topology.MinNodeDisk = src.App.KubernetesResources.GpuPool.Topology.MinNodeDisk
+										changed++
+									}
+								}
+								if fmap.HasOrHasChild("3.50.2.5.4") {
+									if src.App.KubernetesResources.GpuPool.Topology.MinNodeOptRes != nil {
+										if updateListAction == "add" {
+											for k4, v := range src.App.KubernetesResources.GpuPool.Topology.MinNodeOptRes {
+												m.App.KubernetesResources.GpuPool.Topology.MinNodeOptRes[k4] = v
+												changed++
+											}
+										} else if updateListAction == "remove" {
+											for k4, _ := range src.App.KubernetesResources.GpuPool.Topology.MinNodeOptRes {
+												if _, ok := m.App.KubernetesResources.GpuPool.Topology.MinNodeOptRes[k4]; ok {
+													delete(m.App.KubernetesResources.GpuPool.Topology.MinNodeOptRes, k4)
+													changed++
+												}
+											}
+										} else {
+											m.App.KubernetesResources.GpuPool.Topology.MinNodeOptRes = make(map[string]string)
+											for k4, v := range src.App.KubernetesResources.GpuPool.Topology.MinNodeOptRes {
+												m.App.KubernetesResources.GpuPool.Topology.MinNodeOptRes[k4] = v
+											}
+											changed++
+										}
+									} else if m.App.KubernetesResources.GpuPool.Topology.MinNodeOptRes != nil {
+										m.App.KubernetesResources.GpuPool.Topology.MinNodeOptRes = nil
+										changed++
+									}
+								}
+								if fmap.Has("3.50.2.5.5") {
+									if m.App.KubernetesResources.GpuPool.Topology.MinNumberOfNodes != src.App.KubernetesResources.GpuPool.Topology.MinNumberOfNodes {
+										m.App.KubernetesResources.GpuPool.Topology.MinNumberOfNodes = src.App.KubernetesResources.GpuPool.Topology.MinNumberOfNodes
+										changed++
+									}
+								}
+								if fmap.HasOrHasChild("3.50.2.5.6") {
+									if src.App.KubernetesResources.GpuPool.Topology.MinNodeGpus != nil {
+										if updateListAction == "add" {
+											changed += m.AddAppKubernetesResourcesGpuPoolTopologyMinNodeGpus(src.App.KubernetesResources.GpuPool.Topology.MinNodeGpus...)
+										} else if updateListAction == "remove" {
+											changed += m.RemoveAppKubernetesResourcesGpuPoolTopologyMinNodeGpus(src.App.KubernetesResources.GpuPool.Topology.MinNodeGpus...)
+										} else {
+											m.App.KubernetesResources.GpuPool.Topology.MinNodeGpus = make([]*GPUResource, 0)
+											for k4, _ := range src.App.KubernetesResources.GpuPool.Topology.MinNodeGpus {
+												m.App.KubernetesResources.GpuPool.Topology.MinNodeGpus = append(m.App.KubernetesResources.GpuPool.Topology.MinNodeGpus, src.App.KubernetesResources.GpuPool.Topology.MinNodeGpus[k4].Clone())
+											}
+											changed++
+										}
+									} else if m.App.KubernetesResources.GpuPool.Topology.MinNodeGpus != nil {
+										m.App.KubernetesResources.GpuPool.Topology.MinNodeGpus = nil
+										changed++
+									}
+								}
+							}
+							if fmap.HasOrHasChild("3.50.2.6") {
+								if src.App.KubernetesResources.GpuPool.TotalGpus != nil {
+									if updateListAction == "add" {
+										changed += m.AddAppKubernetesResourcesGpuPoolTotalGpus(src.App.KubernetesResources.GpuPool.TotalGpus...)
+									} else if updateListAction == "remove" {
+										changed += m.RemoveAppKubernetesResourcesGpuPoolTotalGpus(src.App.KubernetesResources.GpuPool.TotalGpus...)
+									} else {
+										m.App.KubernetesResources.GpuPool.TotalGpus = make([]*GPUResource, 0)
+										for k3, _ := range src.App.KubernetesResources.GpuPool.TotalGpus {
+											m.App.KubernetesResources.GpuPool.TotalGpus = append(m.App.KubernetesResources.GpuPool.TotalGpus, src.App.KubernetesResources.GpuPool.TotalGpus[k3].Clone())
+										}
+										changed++
+									}
+								} else if m.App.KubernetesResources.GpuPool.TotalGpus != nil {
+									m.App.KubernetesResources.GpuPool.TotalGpus = nil
+									changed++
+								}
+							}
+						} else if m.App.KubernetesResources.GpuPool != nil {
+							m.App.KubernetesResources.GpuPool = nil
+							changed++
+						}
+					}
+					if fmap.Has("3.50.4") {
+						if m.App.KubernetesResources.MinKubernetesVersion != src.App.KubernetesResources.MinKubernetesVersion {
+							m.App.KubernetesResources.MinKubernetesVersion = src.App.KubernetesResources.MinKubernetesVersion
+							changed++
+						}
+					}
+				} else if m.App.KubernetesResources != nil {
+					m.App.KubernetesResources = nil
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("3.51") {
+				if src.App.NodeResources != nil {
+					if m.App.NodeResources == nil {
+						m.App.NodeResources = &NodeResources{}
+					}
+					if fmap.Has("3.51.1") {
+						if m.App.NodeResources.Vcpus != src.App.NodeResources.Vcpus {
+							m.App.NodeResources.Vcpus = src.App.NodeResources.Vcpus
+							changed++
+						}
+					}
+					if fmap.Has("3.51.2") {
+						if m.App.NodeResources.Ram != src.App.NodeResources.Ram {
+							m.App.NodeResources.Ram = src.App.NodeResources.Ram
+							changed++
+						}
+					}
+					if fmap.Has("3.51.3") {
+						if m.App.NodeResources.Disk != src.App.NodeResources.Disk {
+							m.App.NodeResources.Disk = src.App.NodeResources.Disk
+							changed++
+						}
+					}
+					if fmap.HasOrHasChild("3.51.4") {
+						if src.App.NodeResources.OptResMap != nil {
+							if updateListAction == "add" {
+								for k2, v := range src.App.NodeResources.OptResMap {
+									m.App.NodeResources.OptResMap[k2] = v
+									changed++
+								}
+							} else if updateListAction == "remove" {
+								for k2, _ := range src.App.NodeResources.OptResMap {
+									if _, ok := m.App.NodeResources.OptResMap[k2]; ok {
+										delete(m.App.NodeResources.OptResMap, k2)
+										changed++
+									}
+								}
+							} else {
+								m.App.NodeResources.OptResMap = make(map[string]string)
+								for k2, v := range src.App.NodeResources.OptResMap {
+									m.App.NodeResources.OptResMap[k2] = v
+								}
+								changed++
+							}
+						} else if m.App.NodeResources.OptResMap != nil {
+							m.App.NodeResources.OptResMap = nil
+							changed++
+						}
+					}
+					if fmap.Has("3.51.5") {
+						if m.App.NodeResources.InfraNodeFlavor != src.App.NodeResources.InfraNodeFlavor {
+							m.App.NodeResources.InfraNodeFlavor = src.App.NodeResources.InfraNodeFlavor
+							changed++
+						}
+					}
+					if fmap.Has("3.51.6") {
+						if m.App.NodeResources.ExternalVolumeSize != src.App.NodeResources.ExternalVolumeSize {
+							m.App.NodeResources.ExternalVolumeSize = src.App.NodeResources.ExternalVolumeSize
+							changed++
+						}
+					}
+					if fmap.HasOrHasChild("3.51.7") {
+						if src.App.NodeResources.Gpus != nil {
+							if updateListAction == "add" {
+								changed += m.AddAppNodeResourcesGpus(src.App.NodeResources.Gpus...)
+							} else if updateListAction == "remove" {
+								changed += m.RemoveAppNodeResourcesGpus(src.App.NodeResources.Gpus...)
+							} else {
+								m.App.NodeResources.Gpus = make([]*GPUResource, 0)
+								for k2, _ := range src.App.NodeResources.Gpus {
+									m.App.NodeResources.Gpus = append(m.App.NodeResources.Gpus, src.App.NodeResources.Gpus[k2].Clone())
+								}
+								changed++
+							}
+						} else if m.App.NodeResources.Gpus != nil {
+							m.App.NodeResources.Gpus = nil
+							changed++
+						}
+					}
+				} else if m.App.NodeResources != nil {
+					m.App.NodeResources = nil
+					changed++
+				}
+			}
+			if fmap.Has("3.52") {
+				if m.App.ObjId != src.App.ObjId {
+					m.App.ObjId = src.App.ObjId
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("3.53") {
+				if src.App.AppAnnotations != nil {
+					if updateListAction == "add" {
+						for k1, v := range src.App.AppAnnotations {
+							m.App.AppAnnotations[k1] = v
+							changed++
+						}
+					} else if updateListAction == "remove" {
+						for k1, _ := range src.App.AppAnnotations {
+							if _, ok := m.App.AppAnnotations[k1]; ok {
+								delete(m.App.AppAnnotations, k1)
+								changed++
+							}
+						}
+					} else {
+						m.App.AppAnnotations = make(map[string]string)
+						for k1, v := range src.App.AppAnnotations {
+							m.App.AppAnnotations[k1] = v
+						}
+						changed++
+					}
+				} else if m.App.AppAnnotations != nil {
+					m.App.AppAnnotations = nil
+					changed++
+				}
+			}
+			if fmap.Has("3.54") {
+				if m.App.IsStandalone != src.App.IsStandalone {
+					m.App.IsStandalone = src.App.IsStandalone
+					changed++
+				}
+			}
+			if fmap.Has("3.55") {
+				if m.App.ManagesOwnNamespaces != src.App.ManagesOwnNamespaces {
+					m.App.ManagesOwnNamespaces = src.App.ManagesOwnNamespaces
+					changed++
+				}
+			}
+			if fmap.Has("3.56") {
+				if m.App.CompatibilityVersion != src.App.CompatibilityVersion {
+					m.App.CompatibilityVersion = src.App.CompatibilityVersion
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("3.57") {
+				if src.App.FindCloudletScoreWeights != nil {
+					if m.App.FindCloudletScoreWeights == nil {
+						m.App.FindCloudletScoreWeights = &FindCloudletScoreWeights{}
+					}
+					if fmap.Has("3.57.1") {
+						if m.App.FindCloudletScoreWeights.Distance != src.App.FindCloudletScoreWeights.Distance {
+							m.App.FindCloudletScoreWeights.Distance = src.App.FindCloudletScoreWeights.Distance
+							changed++
+						}
+					}
+					if fmap.Has("3.57.2") {
+						if m.App.FindCloudletScoreWeights.Latency != src.App.FindCloudletScoreWeights.Latency {
+							m.App.FindCloudletScoreWeights.Latency = src.App.FindCloudletScoreWeights.Latency
+							changed++
+						}
+					}
+					if fmap.Has("3.57.3") {
+						if m.App.FindCloudletScoreWeights.ResourceUsage != src.App.FindCloudletScoreWeights.ResourceUsage {
+							m.App.FindCloudletScoreWeights.ResourceUsage = src.App.FindCloudletScoreWeights.ResourceUsage
+							changed++
+						}
+					}
+					if fmap.Has("3.57.4") {
+						if m.App.FindCloudletScoreWeights.Health != src.App.FindCloudletScoreWeights.Health {
+							m.App.FindCloudletScoreWeights.Health = src.App.FindCloudletScoreWeights.Health
+							changed++
+						}
+					}
+				} else if m.App.FindCloudletScoreWeights != nil {
+					m.App.FindCloudletScoreWeights = nil
+					changed++
+				}
+			}
+			if fmap.Has("3.58") {
+				if m.App.GeoFencePolicy != src.App.GeoFencePolicy {
+					m.App.GeoFencePolicy = src.App.GeoFencePolicy
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("3.59") {
+				if src.App.ReplicaAutoScalePolicy != nil {
+					if m.App.ReplicaAutoScalePolicy == nil {
+						m.App.ReplicaAutoScalePolicy = &ReplicaAutoScalePolicy{}
+					}
+					if fmap.Has("3.59.1") {
+						if m.App.ReplicaAutoScalePolicy.MinReplicas != src.App.ReplicaAutoScalePolicy.MinReplicas {
+							m.App.ReplicaAutoScalePolicy.MinReplicas = src.App.ReplicaAutoScalePolicy.MinReplicas
+							changed++
+						}
+					}
+					if fmap.Has("3.59.2") {
+						if m.App.ReplicaAutoScalePolicy.MaxReplicas != src.App.ReplicaAutoScalePolicy.MaxReplicas {
+							m.App.ReplicaAutoScalePolicy.MaxReplicas = src.App.ReplicaAutoScalePolicy.MaxReplicas
+							changed++
+						}
+					}
+					if fmap.Has("3.59.3") {
+						if m.App.ReplicaAutoScalePolicy.TargetCpu != src.App.ReplicaAutoScalePolicy.TargetCpu {
+							m.App.ReplicaAutoScalePolicy.TargetCpu = src.App.ReplicaAutoScalePolicy.TargetCpu
+							changed++
+						}
+					}
+					if fmap.Has("3.59.4") {
+						if m.App.ReplicaAutoScalePolicy.TargetMem != src.App.ReplicaAutoScalePolicy.TargetMem {
+							m.App.ReplicaAutoScalePolicy.TargetMem = src.App.ReplicaAutoScalePolicy.TargetMem
+							changed++
+						}
+					}
+					if fmap.Has("3.59.5") {
+						if m.App.ReplicaAutoScalePolicy.TargetActiveConnections != src.App.ReplicaAutoScalePolicy.TargetActiveConnections {
+							m.App.ReplicaAutoScalePolicy.TargetActiveConnections = src.App.ReplicaAutoScalePolicy.TargetActiveConnections
+							changed++
+						}
+					}
+					if fmap.Has("3.59.6") {
+						if m.App.ReplicaAutoScalePolicy.StabilizationWindowSec != src.App.ReplicaAutoScalePolicy.StabilizationWindowSec {
+							m.App.ReplicaAutoScalePolicy.StabilizationWindowSec = src.App.ReplicaAutoScalePolicy.StabilizationWindowSec
+							changed++
+						}
+					}
+				} else if m.App.ReplicaAutoScalePolicy != nil {
+					m.App.ReplicaAutoScalePolicy = nil
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("3.100") {
+				if src.App.Tags != nil {
+					if updateListAction == "add" {
+						for k1, v := range src.App.Tags {
+							m.App.Tags[k1] = v
+							changed++
+						}
+					} else if updateListAction == "remove" {
+						for k1, _ := range src.App.Tags {
+							if _, ok := m.App.Tags[k1]; ok {
+								delete(m.App.Tags, k1)
+								changed++
+							}
+						}
+					} else {
+						m.App.Tags = make(map[string]string)
+						for k1, v := range src.App.Tags {
+							m.App.Tags[k1] = v
+						}
+						changed++
+					}
+				} else if m.App.Tags != nil {
+					m.App.Tags = nil
+					changed++
+				}
+			}
+		} else if m.App != nil {
+			m.App = nil
+			changed++
+		}
+	}
+	if fmap.Has("4") {
+		if src.WavePercentages != nil {
+			if updateListAction == "add" {
+				changed += m.AddWavePercentages(src.WavePercentages...)
+			} else if updateListAction == "remove" {
+				changed += m.RemoveWavePercentages(src.WavePercentages...)
+			} else {
+				m.WavePercentages = make([]uint32, 0)
+				m.WavePercentages = append(m.WavePercentages, src.WavePercentages...)
+				changed++
+			}
+		} else if m.WavePercentages != nil {
+			m.WavePercentages = nil
+			changed++
+		}
+	}
+	if fmap.Has("5") {
+		if m.WavesByZone != src.WavesByZone {
+			m.WavesByZone = src.WavesByZone
+			changed++
+		}
+	}
+	if fmap.HasOrHasChild("6") {
+		if src.Zones != nil {
+			if updateListAction == "add" {
+				changed += m.AddZones(src.Zones...)
+			} else if updateListAction == "remove" {
+				changed += m.RemoveZones(src.Zones...)
+			} else {
+				m.Zones = make([]ZoneKey, 0)
+				for k0, _ := range src.Zones {
+					m.Zones = append(m.Zones, *src.Zones[k0].Clone())
+				}
+				changed++
+			}
+		} else if m.Zones != nil {
+			m.Zones = nil
+			changed++
+		}
+	}
+	if fmap.Has("7") {
+		if m.WaveSoakTime != src.WaveSoakTime {
+			m.WaveSoakTime = src.WaveSoakTime
+			changed++
+		}
+	}
+	if fmap.Has("8") {
+		if m.PauseBetweenWaves != src.PauseBetweenWaves {
+			m.PauseBetweenWaves = src.PauseBetweenWaves
+			changed++
+		}
+	}
+	if fmap.Has("9") {
+		if m.AutoRollback != src.AutoRollback {
+			m.AutoRollback = src.AutoRollback
+			changed++
+		}
+	}
+	if fmap.Has("10") {
+		if m.State != src.State {
+			m.State = src.State
+			changed++
+		}
+	}
+	if fmap.Has("11") {
+		if m.WavesDone != src.WavesDone {
+			m.WavesDone = src.WavesDone
+			changed++
+		}
+	}
+	if fmap.HasOrHasChild("12") {
+		if src.Waves != nil {
+			if updateListAction == "add" {
+				changed += m.AddWaves(src.Waves...)
+			} else if updateListAction == "remove" {
+				changed += m.RemoveWaves(src.Waves...)
+			} else {
+				m.Waves = make([]AppRolloutWave, 0)
+				for k0, _ := range src.Waves {
+					m.Waves = append(m.Waves, *src.Waves[k0].Clone())
+				}
+				changed++
+			}
+		} else if m.Waves != nil {
+			m.Waves = nil
+			changed++
+		}
+	}
+	if fmap.Has("13") {
+		if m.Revision != src.Revision {
+			m.Revision = src.Revision
+			changed++
+		}
+	}
+	if fmap.HasOrHasChild("14") {
+		if src.PreviousApp != nil {
+			if m.PreviousApp == nil {
+				m.PreviousApp = &App{}
+			}
+			if fmap.HasOrHasChild("14.2") {
+				if fmap.Has("14.2.1") {
+					if m.PreviousApp.Key.Organization != src.PreviousApp.Key.Organization {
+						m.PreviousApp.Key.Organization = src.PreviousApp.Key.Organization
+						changed++
+					}
+				}
+				if fmap.Has("14.2.2") {
+					if m.PreviousApp.Key.Name != src.PreviousApp.Key.Name {
+						m.PreviousApp.Key.Name = src.PreviousApp.Key.Name
+						changed++
+					}
+				}
+				if fmap.Has("14.2.3") {
+					if m.PreviousApp.Key.Version != src.PreviousApp.Key.Version {
+						m.PreviousApp.Key.Version = src.PreviousApp.Key.Version
+						changed++
+					}
+				}
+			}
+			if fmap.Has("14.4") {
+				if m.PreviousApp.ImagePath != src.PreviousApp.ImagePath {
+					m.PreviousApp.ImagePath = src.PreviousApp.ImagePath
+					changed++
+				}
+			}
+			if fmap.Has("14.5") {
+				if m.PreviousApp.ImageType != src.PreviousApp.ImageType {
+					m.PreviousApp.ImageType = src.PreviousApp.ImageType
+					changed++
+				}
+			}
+			if fmap.Has("14.7") {
+				if m.PreviousApp.AccessPorts != src.PreviousApp.AccessPorts {
+					m.PreviousApp.AccessPorts = src.PreviousApp.AccessPorts
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("14.9") {
+				if fmap.Has("14.9.1") {
+					if m.PreviousApp.DefaultFlavor.Name != src.PreviousApp.DefaultFlavor.Name {
+						m.PreviousApp.DefaultFlavor.Name = src.PreviousApp.DefaultFlavor.Name
+						changed++
+					}
+				}
+			}
+			if fmap.Has("14.12") {
+				if m.PreviousApp.AuthPublicKey != src.PreviousApp.AuthPublicKey {
+					m.PreviousApp.AuthPublicKey = src.PreviousApp.AuthPublicKey
+					changed++
+				}
+			}
+			if fmap.Has("14.13") {
+				if m.PreviousApp.Command != src.PreviousApp.Command {
+					m.PreviousApp.Command = src.PreviousApp.Command
+					changed++
+				}
+			}
+			if fmap.Has("14.14") {
+				if m.PreviousApp.Annotations != src.PreviousApp.Annotations {
+					m.PreviousApp.Annotations = src.PreviousApp.Annotations
+					changed++
+				}
+			}
+			if fmap.Has("14.15") {
+				if m.PreviousApp.Deployment != src.PreviousApp.Deployment {
+					m.PreviousApp.Deployment = src.PreviousApp.Deployment
+					changed++
+				}
+			}
+			if fmap.Has("14.16") {
+				if m.PreviousApp.DeploymentManifest != src.PreviousApp.DeploymentManifest {
+					m.PreviousApp.DeploymentManifest = src.PreviousApp.DeploymentManifest
+					changed++
+				}
+			}
+			if fmap.Has("14.17") {
+				if m.PreviousApp.DeploymentGenerator != src.PreviousApp.DeploymentGenerator {
+					m.PreviousApp.DeploymentGenerator = src.PreviousApp.DeploymentGenerator
+					changed++
+				}
+			}
+			if fmap.Has("14.18") {
+				if m.PreviousApp.AndroidPackageName != src.PreviousApp.AndroidPackageName {
+					m.PreviousApp.AndroidPackageName = src.PreviousApp.AndroidPackageName
+					changed++
+				}
+			}
+			if fmap.Has("14.20") {
+				if m.PreviousApp.DelOpt != src.PreviousApp.DelOpt {
+					m.PreviousApp.DelOpt = src.PreviousApp.DelOpt
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("14.21") {
+				if src.PreviousApp.Configs != nil {
+					if updateListAction == "add" {
+						changed += m.AddPreviousAppConfigs(src.PreviousApp.Configs...)
+					} else if updateListAction == "remove" {
+						changed += m.RemovePreviousAppConfigs(src.PreviousApp.Configs...)
+					} else {
+						m.PreviousApp.Configs = make([]*ConfigFile, 0)
+						for k1, _ := range src.PreviousApp.Configs {
+							m.PreviousApp.Configs = append(m.PreviousApp.Configs, src.PreviousApp.Configs[k1].Clone())
+						}
+						changed++
+					}
+				} else if m.PreviousApp.Configs != nil {
+					m.PreviousApp.Configs = nil
+					changed++
+				}
+			}
+			if fmap.Has("14.22") {
+				if m.PreviousApp.ScaleWithCluster != src.PreviousApp.ScaleWithCluster {
+					m.PreviousApp.ScaleWithCluster = src.PreviousApp.ScaleWithCluster
+					changed++
+				}
+			}
+			if fmap.Has("14.23") {
+				if m.PreviousApp.InternalPorts != src.PreviousApp.InternalPorts {
+					m.PreviousApp.InternalPorts = src.PreviousApp.InternalPorts
+					changed++
+				}
+			}
+			if fmap.Has("14.24") {
+				if m.PreviousApp.Revision != src.PreviousApp.Revision {
+					m.PreviousApp.Revision = src.PreviousApp.Revision
+					changed++
+				}
+			}
+			if fmap.Has("14.25") {
+				if m.PreviousApp.OfficialFqdn != src.PreviousApp.OfficialFqdn {
+					m.PreviousApp.OfficialFqdn = src.PreviousApp.OfficialFqdn
+					changed++
+				}
+			}
+			if fmap.Has("14.26") {
+				if m.PreviousApp.Md5Sum != src.PreviousApp.Md5Sum {
+					m.PreviousApp.Md5Sum = src.PreviousApp.Md5Sum
+					changed++
+				}
+			}
+			if fmap.Has("14.28") {
+				if m.PreviousApp.AutoProvPolicy != src.PreviousApp.AutoProvPolicy {
+					m.PreviousApp.AutoProvPolicy = src.PreviousApp.AutoProvPolicy
+					changed++
+				}
+			}
+			if fmap.Has("14.29") {
+				if m.PreviousApp.AccessType != src.PreviousApp.AccessType {
+					m.PreviousApp.AccessType = src.PreviousApp.AccessType
+					changed++
+				}
+			}
+			if fmap.Has("14.31") {
+				if m.PreviousApp.DeletePrepare != src.PreviousApp.DeletePrepare {
+					m.PreviousApp.DeletePrepare = src.PreviousApp.DeletePrepare
+					changed++
+				}
+			}
+			if fmap.Has("14.32") {
+				if src.PreviousApp.AutoProvPolicies != nil {
+					if updateListAction == "add" {
+						changed += m.AddPreviousAppAutoProvPolicies(src.PreviousApp.AutoProvPolicies...)
+					} else if updateListAction == "remove" {
+						changed += m.RemovePreviousAppAutoProvPolicies(src.PreviousApp.AutoProvPolicies...)
+					} else {
+						m.PreviousApp.AutoProvPolicies = make([]string, 0)
+						m.PreviousApp.AutoProvPolicies = append(m.PreviousApp.AutoProvPolicies, src.PreviousApp.AutoProvPolicies...)
+						changed++
+					}
+				} else if m.PreviousApp.AutoProvPolicies != nil {
+					m.PreviousApp.AutoProvPolicies = nil
+					changed++
+				}
+			}
+			if fmap.Has("14.33") {
+				if m.PreviousApp.TemplateDelimiter != src.PreviousApp.TemplateDelimiter {
+					m.PreviousApp.TemplateDelimiter = src.PreviousApp.TemplateDelimiter
+					changed++
+				}
+			}
+			if fmap.Has("14.34") {
+				if m.PreviousApp.SkipHcPorts != src.PreviousApp.SkipHcPorts {
+					m.PreviousApp.SkipHcPorts = src.PreviousApp.SkipHcPorts
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("14.35") {
+				if fmap.Has("14.35.1") {
+					if m.PreviousApp.CreatedAt.Seconds != src.PreviousApp.CreatedAt.Seconds {
+						m.PreviousApp.CreatedAt.Seconds = src.PreviousApp.CreatedAt.Seconds
+						changed++
+					}
+				}
+				if fmap.Has("14.35.2") {
+					if m.PreviousApp.CreatedAt.Nanos != src.PreviousApp.CreatedAt.Nanos {
+						m.PreviousApp.CreatedAt.Nanos = src.PreviousApp.CreatedAt.Nanos
+						changed++
+					}
+				}
+			}
+			if fmap.HasOrHasChild("14.36") {
+				if fmap.Has("14.36.1") {
+					if m.PreviousApp.UpdatedAt.Seconds != src.PreviousApp.UpdatedAt.Seconds {
+						m.PreviousApp.UpdatedAt.Seconds = src.PreviousApp.UpdatedAt.Seconds
+						changed++
+					}
+				}
+				if fmap.Has("14.36.2") {
+					if m.PreviousApp.UpdatedAt.Nanos != src.PreviousApp.UpdatedAt.Nanos {
+						m.PreviousApp.UpdatedAt.Nanos = src.PreviousApp.UpdatedAt.Nanos
+						changed++
+					}
+				}
+			}
+			if fmap.Has("14.37") {
+				if m.PreviousApp.Trusted != src.PreviousApp.Trusted {
+					m.PreviousApp.Trusted = src.PreviousApp.Trusted
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("14.38") {
+				if src.PreviousApp.RequiredOutboundConnections != nil {
+					if updateListAction == "add" {
+						changed += m.AddPreviousAppRequiredOutboundConnections(src.PreviousApp.RequiredOutboundConnections...)
+					} else if updateListAction == "remove" {
+						changed += m.RemovePreviousAppRequiredOutboundConnections(src.PreviousApp.RequiredOutboundConnections...)
+					} else {
+						m.PreviousApp.RequiredOutboundConnections = make([]SecurityRule, 0)
+						for k1, _ := range src.PreviousApp.RequiredOutboundConnections {
+							m.PreviousApp.RequiredOutboundConnections = append(m.PreviousApp.RequiredOutboundConnections, *src.PreviousApp.RequiredOutboundConnections[k1].Clone())
+						}
+						changed++
+					}
+				} else if m.PreviousApp.RequiredOutboundConnections != nil {
+					m.PreviousApp.RequiredOutboundConnections = nil
+					changed++
+				}
+			}
+			if fmap.Has("14.39") {
+				if m.PreviousApp.AllowServerless != src.PreviousApp.AllowServerless {
+					m.PreviousApp.AllowServerless = src.PreviousApp.AllowServerless
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("14.40") {
+				if src.PreviousApp.ServerlessConfig != nil {
+					if m.PreviousApp.ServerlessConfig == nil {
+						m.PreviousApp.ServerlessConfig = &ServerlessConfig{}
+					}
+					if fmap.HasOrHasChild("14.40.1") {
+						if m.PreviousApp.ServerlessConfig.Vcpus.Whole != src.PreviousApp.ServerlessConfig.Vcpus.Whole {
+							m.PreviousApp.ServerlessConfig.Vcpus.Whole = src.PreviousApp.ServerlessConfig.Vcpus.Whole
+							changed++
+						}
+						if m.PreviousApp.ServerlessConfig.Vcpus.Nanos != src.PreviousApp.ServerlessConfig.Vcpus.Nanos {
+							m.PreviousApp.ServerlessConfig.Vcpus.Nanos = src.PreviousApp.ServerlessConfig.Vcpus.Nanos
+							changed++
+						}
+					}
+					if fmap.Has("14.40.2") {
+						if m.PreviousApp.ServerlessConfig.Ram != src.PreviousApp.ServerlessConfig.Ram {
+							m.PreviousApp.ServerlessConfig.Ram = src.PreviousApp.ServerlessConfig.Ram
+							changed++
+						}
+					}
+					if fmap.Has("14.40.3") {
+						if m.PreviousApp.ServerlessConfig.MinReplicas != src.PreviousApp.ServerlessConfig.MinReplicas {
+							m.PreviousApp.ServerlessConfig.MinReplicas = src.PreviousApp.ServerlessConfig.MinReplicas
+							changed++
+						}
+					}
+					if fmap.HasOrHasChild("14.40.4") {
+						if fmap.Has("14.40.4.1") {
+							if m.PreviousApp.ServerlessConfig.GpuConfig.Type != src.PreviousApp.ServerlessConfig.GpuConfig.Type {
+								m.PreviousApp.ServerlessConfig.GpuConfig.Type = src.PreviousApp.ServerlessConfig.GpuConfig.Type
+								changed++
+							}
+						}
+						if fmap.Has("14.40.4.2") {
+							if m.PreviousApp.ServerlessConfig.GpuConfig.Model != src.PreviousApp.ServerlessConfig.GpuConfig.Model {
+								m.PreviousApp.ServerlessConfig.GpuConfig.Model = src.PreviousApp.ServerlessConfig.GpuConfig.Model
+								changed++
+							}
+						}
+						if fmap.Has("14.40.4.3") {
+							if m.PreviousApp.ServerlessConfig.GpuConfig.NumGpu != src.PreviousApp.ServerlessConfig.GpuConfig.NumGpu {
+								m.PreviousApp.ServerlessConfig.GpuConfig.NumGpu = src.PreviousApp.ServerlessConfig.GpuConfig.NumGpu
+								changed++
+							}
+						}
+						if fmap.Has("14.40.4.4") {
+							if m.PreviousApp.ServerlessConfig.GpuConfig.Ram != src.PreviousApp.ServerlessConfig.GpuConfig.Ram {
+								m.PreviousApp.ServerlessConfig.GpuConfig.Ram = src.PreviousApp.ServerlessConfig.GpuConfig.Ram
+								changed++
+							}
+						}
+					}
+				} else if m.PreviousApp.ServerlessConfig != nil {
+					m.PreviousApp.ServerlessConfig = nil
+					changed++
+				}
+			}
+			if fmap.Has("14.41") {
+				if m.PreviousApp.VmAppOsType != src.PreviousApp.VmAppOsType {
+					m.PreviousApp.VmAppOsType = src.PreviousApp.VmAppOsType
+					changed++
+				}
+			}
+			if fmap.Has("14.42") {
+				if src.PreviousApp.AlertPolicies != nil {
+					if updateListAction == "add" {
+						changed += m.AddPreviousAppAlertPolicies(src.PreviousApp.AlertPolicies...)
+					} else if updateListAction == "remove" {
+						changed += m.RemovePreviousAppAlertPolicies(src.PreviousApp.AlertPolicies...)
+					} else {
+						m.PreviousApp.AlertPolicies = make([]string, 0)
+						m.PreviousApp.AlertPolicies = append(m.PreviousApp.AlertPolicies, src.PreviousApp.AlertPolicies...)
+						changed++
+					}
+				} else if m.PreviousApp.AlertPolicies != nil {
+					m.PreviousApp.AlertPolicies = nil
+					changed++
+				}
+			}
+			if fmap.Has("14.43") {
+				if m.PreviousApp.QosSessionProfile != src.PreviousApp.QosSessionProfile {
+					m.PreviousApp.QosSessionProfile = src.PreviousApp.QosSessionProfile
+					changed++
+				}
+			}
+			if fmap.Has("14.44") {
+				if m.PreviousApp.QosSessionDuration != src.PreviousApp.QosSessionDuration {
+					m.PreviousApp.QosSessionDuration = src.PreviousApp.QosSessionDuration
+					changed++
+				}
+			}
+			if fmap.Has("14.45") {
+				if m.PreviousApp.GlobalId != src.PreviousApp.GlobalId {
+					m.PreviousApp.GlobalId = src.PreviousApp.GlobalId
+					changed++
+				}
+			}
+			if fmap.Has("14.46") {
+				if src.PreviousApp.CommandArgs != nil {
+					if updateListAction == "add" {
+						changed += m.AddPreviousAppCommandArgs(src.PreviousApp.CommandArgs...)
+					} else if updateListAction == "remove" {
+						changed += m.RemovePreviousAppCommandArgs(src.PreviousApp.CommandArgs...)
+					} else {
+						m.PreviousApp.CommandArgs = make([]string, 0)
+						m.PreviousApp.CommandArgs = append(m.PreviousApp.CommandArgs, src.PreviousApp.CommandArgs...)
+						changed++
+					}
+				} else if m.PreviousApp.CommandArgs != nil {
+					m.PreviousApp.CommandArgs = nil
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("14.47") {
+				if src.PreviousApp.EnvVars != nil {
+					if updateListAction == "add" {
+						for k1, v := range src.PreviousApp.EnvVars {
+							m.PreviousApp.EnvVars[k1] = v
+							changed++
+						}
+					} else if updateListAction == "remove" {
+						for k1, _ := range src.PreviousApp.EnvVars {
+							if _, ok := m.PreviousApp.EnvVars[k1]; ok {
+								delete(m.PreviousApp.EnvVars, k1)
+								changed++
+							}
+						}
+					} else {
+						m.PreviousApp.EnvVars = make(map[string]string)
+						for k1, v := range src.PreviousApp.EnvVars {
+							m.PreviousApp.EnvVars[k1] = v
+						}
+						changed++
+					}
+				} else if m.PreviousApp.EnvVars != nil {
+					m.PreviousApp.EnvVars = nil
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("14.48") {
+				if src.PreviousApp.SecretEnvVars != nil {
+					if updateListAction == "add" {
+						for k1, v := range src.PreviousApp.SecretEnvVars {
+							m.PreviousApp.SecretEnvVars[k1] = v
+							changed++
+						}
+					} else if updateListAction == "remove" {
+						for k1, _ := range src.PreviousApp.SecretEnvVars {
+							if _, ok := m.PreviousApp.SecretEnvVars[k1]; ok {
+								delete(m.PreviousApp.SecretEnvVars, k1)
+								changed++
+							}
+						}
+					} else {
+						m.PreviousApp.SecretEnvVars = make(map[string]string)
+						for k1, v := range src.PreviousApp.SecretEnvVars {
+							m.PreviousApp.SecretEnvVars[k1] = v
+						}
+						changed++
+					}
+				} else if m.PreviousApp.SecretEnvVars != nil {
+					m.PreviousApp.SecretEnvVars = nil
+					changed++
+				}
+			}
+			if fmap.Has("14.49") {
+				if m.PreviousApp.UpdateListAction != src.PreviousApp.UpdateListAction {
+					m.PreviousApp.UpdateListAction = src.PreviousApp.UpdateListAction
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("14.50") {
+				if src.PreviousApp.KubernetesResources != nil {
+					if m.PreviousApp.KubernetesResources == nil {
+						m.PreviousApp.KubernetesResources = &KubernetesResources{}
+					}
+					if fmap.HasOrHasChild("14.50.1") {
+						if src.PreviousApp.KubernetesResources.CpuPool != nil {
+							if m.PreviousApp.KubernetesResources.CpuPool == nil {
+								m.PreviousApp.KubernetesResources.CpuPool = &NodePoolResources{}
+							}
+							if fmap.HasOrHasChild("14.50.1.1") {
+								if m.PreviousApp.KubernetesResources.CpuPool.TotalVcpus.Whole != src.PreviousApp.KubernetesResources.CpuPool.TotalVcpus.Whole {
+									m.PreviousApp.KubernetesResources.CpuPool.TotalVcpus.Whole = src.PreviousApp.KubernetesResources.CpuPool.TotalVcpus.Whole
+									changed++
+								}
+								if m.PreviousApp.KubernetesResources.CpuPool.TotalVcpus.Nanos != src.PreviousApp.KubernetesResources.CpuPool.TotalVcpus.Nanos {
+									m.PreviousApp.KubernetesResources.CpuPool.TotalVcpus.Nanos = src.PreviousApp.KubernetesResources.CpuPool.TotalVcpus.Nanos
+									changed++
+								}
+							}
+							if fmap.Has("14.50.1.2") {
+								if m.PreviousApp.KubernetesResources.CpuPool.TotalMemory != src.PreviousApp.KubernetesResources.CpuPool.TotalMemory {
+									m.PreviousApp.KubernetesResources.CpuPool.TotalMemory = src.PreviousApp.KubernetesResources.CpuPool.TotalMemory
+									changed++
+								}
+							}
+							if fmap.Has("14.50.1.3") {
+								if m.PreviousApp.KubernetesResources.CpuPool.TotalDisk != src.PreviousApp.KubernetesResources.CpuPool.TotalDisk {
+									m.PreviousApp.KubernetesResources.CpuPool.TotalDisk = src.PreviousApp.KubernetesResources.CpuPool.TotalDisk
+									changed++
+								}
+							}
+							if fmap.HasOrHasChild("14.50.1.4") {
+								if src.PreviousApp.KubernetesResources.CpuPool.TotalOptRes != nil {
+									if updateListAction == "add" {
+										for k3, v := range src.PreviousApp.KubernetesResources.CpuPool.TotalOptRes {
+											m.PreviousApp.KubernetesResources.CpuPool.TotalOptRes[k3] = v
+											changed++
+										}
+									} else if updateListAction == "remove" {
+										for k3, _ := range src.PreviousApp.KubernetesResources.CpuPool.TotalOptRes {
+											if _, ok := m.PreviousApp.KubernetesResources.CpuPool.TotalOptRes[k3]; ok {
+												delete(m.PreviousApp.KubernetesResources.CpuPool.TotalOptRes, k3)
+												changed++
+											}
+										}
+									} else {
+										m.PreviousApp.KubernetesResources.CpuPool.TotalOptRes = make(map[string]string)
+										for k3, v := range src.PreviousApp.KubernetesResources.CpuPool.TotalOptRes {
+											m.PreviousApp.KubernetesResources.CpuPool.TotalOptRes[k3] = v
+										}
+										changed++
+									}
+								} else if m.PreviousApp.KubernetesResources.CpuPool.TotalOptRes != nil {
+									m.PreviousApp.KubernetesResources.CpuPool.TotalOptRes = nil
+									changed++
+								}
+							}
+							if fmap.HasOrHasChild("14.50.1.5") {
+								if fmap.Has("14.50.1.5.1") {
+									if m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeVcpus != src.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeVcpus {
+										m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeVcpus = src.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeVcpus
+										changed++
+									}
+								}
+								if fmap.Has("14.50.1.5.2") {
+									if m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeMemory != src.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeMemory {
+										m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeMemory = src.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeMemory
+										changed++
+									}
+								}
+								if fmap.Has("14.50.1.5.3") {
+									if m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeDisk != src.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeDisk {
+										m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeDisk = src.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeDisk
+										changed++
+									}
+								}
+								if fmap.HasOrHasChild("14.50.1.5.4") {
+									if src.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeOptRes != nil {
+										if updateListAction == "add" {
+											for k4, v := range src.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeOptRes {
+												m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeOptRes[k4] = v
+												changed++
+											}
+										} else if updateListAction == "remove" {
+											for k4, _ := range src.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeOptRes {
+												if _, ok := m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeOptRes[k4]; ok {
+													delete(m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeOptRes, k4)
+													changed++
+												}
+											}
+										} else {
+											m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeOptRes = make(map[string]string)
+											for k4, v := range src.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeOptRes {
+												m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeOptRes[k4] = v
+											}
+											changed++
+										}
+									} else if m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeOptRes != nil {
+										m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeOptRes = nil
+										changed++
+									}
+								}
+								if fmap.Has("14.50.1.5.5") {
+									if m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNumberOfNodes != src.PreviousApp.KubernetesResources.CpuPool.Topology.MinNumberOfNodes {
+										m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNumberOfNodes = src.PreviousApp.KubernetesResources.CpuPool.Topology.MinNumberOfNodes
+										changed++
+									}
+								}
+								if fmap.HasOrHasChild("14.50.1.5.6") {
+									if src.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus != nil {
+										if updateListAction == "add" {
+											changed += m.AddPreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpus(src.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus...)
+										} else if updateListAction == "remove" {
+											changed += m.RemovePreviousAppKubernetesResourcesCpuPoolTopologyMinNodeGpus(src.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus...)
+										} else {
+											m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus = make([]*GPUResource, 0)
+											for k4, _ := range src.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus {
+												m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus = append(m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus, src.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus[k4].Clone())
+											}
+											changed++
+										}
+									} else if m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus != nil {
+										m.PreviousApp.KubernetesResources.CpuPool.Topology.MinNodeGpus = nil
+										changed++
+									}
+								}
+							}
+							if fmap.HasOrHasChild("14.50.1.6") {
+								if src.PreviousApp.KubernetesResources.CpuPool.TotalGpus != nil {
+									if updateListAction == "add" {
+										changed += m.AddPreviousAppKubernetesResourcesCpuPoolTotalGpus(src.PreviousApp.KubernetesResources.CpuPool.TotalGpus...)
+									} else if updateListAction == "remove" {
+										changed += m.RemovePreviousAppKubernetesResourcesCpuPoolTotalGpus(src.PreviousApp.KubernetesResources.CpuPool.TotalGpus...)
+									} else {
+										m.PreviousApp.KubernetesResources.CpuPool.TotalGpus = make([]*GPUResource, 0)
+										for k3, _ := range src.PreviousApp.KubernetesResources.CpuPool.TotalGpus {
+											m.PreviousApp.KubernetesResources.CpuPool.TotalGpus = append(m.PreviousApp.KubernetesResources.CpuPool.TotalGpus, src.PreviousApp.KubernetesResources.CpuPool.TotalGpus[k3].Clone())
+										}
+										changed++
+									}
+								} else if m.PreviousApp.KubernetesResources.CpuPool.TotalGpus != nil {
+									m.PreviousApp.KubernetesResources.CpuPool.TotalGpus = nil
+									changed++
+								}
+							}
+						} else if m.PreviousApp.KubernetesResources.CpuPool != nil {
+							m.PreviousApp.KubernetesResources.CpuPool = nil
+							changed++
+						}
+					}
+					if fmap.HasOrHasChild("14.50.2") {
+						if src.PreviousApp.KubernetesResources.GpuPool != nil {
+							if m.PreviousApp.KubernetesResources.GpuPool == nil {
+								m.PreviousApp.KubernetesResources.GpuPool = &NodePoolResources{}
+							}
+							if fmap.HasOrHasChild("14.50.2.1") {
+								if m.PreviousApp.KubernetesResources.GpuPool.TotalVcpus.Whole != src.PreviousApp.KubernetesResources.GpuPool.TotalVcpus.Whole {
+									m.PreviousApp.KubernetesResources.GpuPool.TotalVcpus.Whole = src.PreviousApp.KubernetesResources.GpuPool.TotalVcpus.Whole
+									changed++
+								}
+								if m.PreviousApp.KubernetesResources.GpuPool.TotalVcpus.Nanos != src.PreviousApp.KubernetesResources.GpuPool.TotalVcpus.Nanos {
+									m.PreviousApp.KubernetesResources.GpuPool.TotalVcpus.Nanos = src.PreviousApp.KubernetesResources.GpuPool.TotalVcpus.Nanos
+									changed++
+								}
+							}
+							if fmap.Has("14.50.2.2") {
+								if m.PreviousApp.KubernetesResources.GpuPool.TotalMemory != src.PreviousApp.KubernetesResources.GpuPool.TotalMemory {
+									m.PreviousApp.KubernetesResources.GpuPool.TotalMemory = src.PreviousApp.KubernetesResources.GpuPool.TotalMemory
+									changed++
+								}
+							}
+							if fmap.Has("14.50.2.3") {
+								if m.PreviousApp.KubernetesResources.GpuPool.TotalDisk != src.PreviousApp.KubernetesResources.GpuPool.TotalDisk {
+									m.PreviousApp.KubernetesResources.GpuPool.TotalDisk = src.PreviousApp.KubernetesResources.GpuPool.TotalDisk
+									changed++
+								}
+							}
+							if fmap.HasOrHasChild("14.50.2.4") {
+								if src.PreviousApp.KubernetesResources.GpuPool.TotalOptRes != nil {
+									if updateListAction == "add" {
+										for k3, v := range src.PreviousApp.KubernetesResources.GpuPool.TotalOptRes {
+											m.PreviousApp.KubernetesResources.GpuPool.TotalOptRes[k3] = v
+											changed++
+										}
+									} else if updateListAction == "remove" {
+										for k3, _ := range src.PreviousApp.KubernetesResources.GpuPool.TotalOptRes {
+											if _, ok := m.PreviousApp.KubernetesResources.GpuPool.TotalOptRes[k3]; ok {
+												delete(m.PreviousApp.KubernetesResources.GpuPool.TotalOptRes, k3)
+												changed++
+											}
+										}
+									} else {
+										m.PreviousApp.KubernetesResources.GpuPool.TotalOptRes = make(map[string]string)
+										for k3, v := range src.PreviousApp.KubernetesResources.GpuPool.TotalOptRes {
+											m.PreviousApp.KubernetesResources.GpuPool.TotalOptRes[k3] = v
+										}
+										changed++
+									}
+								} else if m.PreviousApp.KubernetesResources.GpuPool.TotalOptRes != nil {
+									m.PreviousApp.KubernetesResources.GpuPool.TotalOptRes = nil
+									changed++
+								}
+							}
+							if fmap.HasOrHasChild("14.50.2.5") {
+								if fmap.Has("14.50.2.5.1") {
+									if m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeVcpus != src.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeVcpus {
+										m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeVcpus = src.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeVcpus
+										changed++
+									}
+								}
+								if fmap.Has("14.50.2.5.2") {
+									if m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeMemory != src.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeMemory {
+										m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeMemory = src.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeMemory
+										changed++
+									}
+								}
+								if fmap.Has("14.50.2.5.3") {
+									if m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeDisk != src.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeDisk {
+										m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeDisk = src.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeDisk
+										changed++
+									}
+								}
+								if fmap.HasOrHasChild("14.50.2.5.4") {
+									if src.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeOptRes != nil {
+										if updateListAction == "add" {
+											for k4, v := range src.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeOptRes {
+												m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeOptRes[k4] = v
+												changed++
+											}
+										} else if updateListAction == "remove" {
+											for k4, _ := range src.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeOptRes {
+												if _, ok := m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeOptRes[k4]; ok {
+													delete(m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeOptRes, k4)
+													changed++
+												}
+											}
+										} else {
+											m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeOptRes = make(map[string]string)
+											for k4, v := range src.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeOptRes {
+												m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeOptRes[k4] = v
+											}
+											changed++
+										}
+									} else if m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeOptRes != nil {
+										m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeOptRes = nil
+										changed++
+									}
+								}
+								if fmap.Has("14.50.2.5.5") {
+									if m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNumberOfNodes != src.PreviousApp.KubernetesResources.GpuPool.Topology.MinNumberOfNodes {
+										m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNumberOfNodes = src.PreviousApp.KubernetesResources.GpuPool.Topology.MinNumberOfNodes
+										changed++
+									}
+								}
+								if fmap.HasOrHasChild("14.50.2.5.6") {
+									if src.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus != nil {
+										if updateListAction == "add" {
+											changed += m.AddPreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpus(src.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus...)
+										} else if updateListAction == "remove" {
+											changed += m.RemovePreviousAppKubernetesResourcesGpuPoolTopologyMinNodeGpus(src.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus...)
+										} else {
+											m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus = make([]*GPUResource, 0)
+											for k4, _ := range src.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus {
+												m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus = append(m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus, src.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus[k4].Clone())
+											}
+											changed++
+										}
+									} else if m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus != nil {
+										m.PreviousApp.KubernetesResources.GpuPool.Topology.MinNodeGpus = nil
+										changed++
+									}
+								}
+							}
+							if fmap.HasOrHasChild("14.50.2.6") {
+								if src.PreviousApp.KubernetesResources.GpuPool.TotalGpus != nil {
+									if updateListAction == "add" {
+										changed += m.AddPreviousAppKubernetesResourcesGpuPoolTotalGpus(src.PreviousApp.KubernetesResources.GpuPool.TotalGpus...)
+									} else if updateListAction == "remove" {
+										changed += m.RemovePreviousAppKubernetesResourcesGpuPoolTotalGpus(src.PreviousApp.KubernetesResources.GpuPool.TotalGpus...)
+									} else {
+										m.PreviousApp.KubernetesResources.GpuPool.TotalGpus = make([]*GPUResource, 0)
+										for k3, _ := range src.PreviousApp.KubernetesResources.GpuPool.TotalGpus {
+											m.PreviousApp.KubernetesResources.GpuPool.TotalGpus = append(m.PreviousApp.KubernetesResources.GpuPool.TotalGpus, src.PreviousApp.KubernetesResources.GpuPool.TotalGpus[k3].Clone())
+										}
+										changed++
+									}
+								} else if m.PreviousApp.KubernetesResources.GpuPool.TotalGpus != nil {
+									m.PreviousApp.KubernetesResources.GpuPool.TotalGpus = nil
+									changed++
+								}
+							}
+						} else if m.PreviousApp.KubernetesResources.GpuPool != nil {
+							m.PreviousApp.KubernetesResources.GpuPool = nil
+							changed++
+						}
+					}
+					if fmap.Has("14.50.4") {
+						if m.PreviousApp.KubernetesResources.MinKubernetesVersion != src.PreviousApp.KubernetesResources.MinKubernetesVersion {
+							m.PreviousApp.KubernetesResources.MinKubernetesVersion = src.PreviousApp.KubernetesResources.MinKubernetesVersion
+							changed++
+						}
+					}
+				} else if m.PreviousApp.KubernetesResources != nil {
+					m.PreviousApp.KubernetesResources = nil
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("14.51") {
+				if src.PreviousApp.NodeResources != nil {
+					if m.PreviousApp.NodeResources == nil {
+						m.PreviousApp.NodeResources = &NodeResources{}
+					}
+					if fmap.Has("14.51.1") {
+						if m.PreviousApp.NodeResources.Vcpus != src.PreviousApp.NodeResources.Vcpus {
+							m.PreviousApp.NodeResources.Vcpus = src.PreviousApp.NodeResources.Vcpus
+							changed++
+						}
+					}
+					if fmap.Has("14.51.2") {
+						if m.PreviousApp.NodeResources.Ram != src.PreviousApp.NodeResources.Ram {
+							m.PreviousApp.NodeResources.Ram = src.PreviousApp.NodeResources.Ram
+							changed++
+						}
+					}
+					if fmap.Has("14.51.3") {
+						if m.PreviousApp.NodeResources.Disk != src.PreviousApp.NodeResources.Disk {
+							m.PreviousApp.NodeResources.Disk = src.PreviousApp.NodeResources.Disk
+							changed++
+						}
+					}
+					if fmap.HasOrHasChild("14.51.4") {
+						if src.PreviousApp.NodeResources.OptResMap != nil {
+							if updateListAction == "add" {
+								for k2, v := range src.PreviousApp.NodeResources.OptResMap {
+									m.PreviousApp.NodeResources.OptResMap[k2] = v
+									changed++
+								}
+							} else if updateListAction == "remove" {
+								for k2, _ := range src.PreviousApp.NodeResources.OptResMap {
+									if _, ok := m.PreviousApp.NodeResources.OptResMap[k2]; ok {
+										delete(m.PreviousApp.NodeResources.OptResMap, k2)
+										changed++
+									}
+								}
+							} else {
+								m.PreviousApp.NodeResources.OptResMap = make(map[string]string)
+								for k2, v := range src.PreviousApp.NodeResources.OptResMap {
+									m.PreviousApp.NodeResources.OptResMap[k2] = v
+								}
+								changed++
+							}
+						} else if m.PreviousApp.NodeResources.OptResMap != nil {
+							m.PreviousApp.NodeResources.OptResMap = nil
+							changed++
+						}
+					}
+					if fmap.Has("14.51.5") {
+						if m.PreviousApp.NodeResources.InfraNodeFlavor != src.PreviousApp.NodeResources.InfraNodeFlavor {
+							m.PreviousApp.NodeResources.InfraNodeFlavor = src.PreviousApp.NodeResources.InfraNodeFlavor
+							changed++
+						}
+					}
+					if fmap.Has("14.51.6") {
+						if m.PreviousApp.NodeResources.ExternalVolumeSize != src.PreviousApp.NodeResources.ExternalVolumeSize {
+							m.PreviousApp.NodeResources.ExternalVolumeSize = src.PreviousApp.NodeResources.ExternalVolumeSize
+							changed++
+						}
+					}
+					if fmap.HasOrHasChild("14.51.7") {
+						if src.PreviousApp.NodeResources.Gpus != nil {
+							if updateListAction == "add" {
+								changed += m.AddPreviousAppNodeResourcesGpus(src.PreviousApp.NodeResources.Gpus...)
+							} else if updateListAction == "remove" {
+								changed += m.RemovePreviousAppNodeResourcesGpus(src.PreviousApp.NodeResources.Gpus...)
+							} else {
+								m.PreviousApp.NodeResources.Gpus = make([]*GPUResource, 0)
+								for k2, _ := range src.PreviousApp.NodeResources.Gpus {
+									m.PreviousApp.NodeResources.Gpus = append(m.PreviousApp.NodeResources.Gpus, src.PreviousApp.NodeResources.Gpus[k2].Clone())
+								}
+								changed++
+							}
+						} else if m.PreviousApp.NodeResources.Gpus != nil {
+							m.PreviousApp.NodeResources.Gpus = nil
+							changed++
+						}
+					}
+				} else if m.PreviousApp.NodeResources != nil {
+					m.PreviousApp.NodeResources = nil
+					changed++
+				}
+			}
+			if fmap.Has("14.52") {
+				if m.PreviousApp.ObjId != src.PreviousApp.ObjId {
+					m.PreviousApp.ObjId = src.PreviousApp.ObjId
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("14.53") {
+				if src.PreviousApp.AppAnnotations != nil {
+					if updateListAction == "add" {
+						for k1, v := range src.PreviousApp.AppAnnotations {
+							m.PreviousApp.AppAnnotations[k1] = v
+							changed++
+						}
+					} else if updateListAction == "remove" {
+						for k1, _ := range src.PreviousApp.AppAnnotations {
+							if _, ok := m.PreviousApp.AppAnnotations[k1]; ok {
+								delete(m.PreviousApp.AppAnnotations, k1)
+								changed++
+							}
+						}
+					} else {
+						m.PreviousApp.AppAnnotations = make(map[string]string)
+						for k1, v := range src.PreviousApp.AppAnnotations {
+							m.PreviousApp.AppAnnotations[k1] = v
+						}
+						changed++
+					}
+				} else if m.PreviousApp.AppAnnotations != nil {
+					m.PreviousApp.AppAnnotations = nil
+					changed++
+				}
+			}
+			if fmap.Has("14.54") {
+				if m.PreviousApp.IsStandalone != src.PreviousApp.IsStandalone {
+					m.PreviousApp.IsStandalone = src.PreviousApp.IsStandalone
+					changed++
+				}
+			}
+			if fmap.Has("14.55") {
+				if m.PreviousApp.ManagesOwnNamespaces != src.PreviousApp.ManagesOwnNamespaces {
+					m.PreviousApp.ManagesOwnNamespaces = src.PreviousApp.ManagesOwnNamespaces
+					changed++
+				}
+			}
+			if fmap.Has("14.56") {
+				if m.PreviousApp.CompatibilityVersion != src.PreviousApp.CompatibilityVersion {
+					m.PreviousApp.CompatibilityVersion = src.PreviousApp.CompatibilityVersion
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("14.57") {
+				if src.PreviousApp.FindCloudletScoreWeights != nil {
+					if m.PreviousApp.FindCloudletScoreWeights == nil {
+						m.PreviousApp.FindCloudletScoreWeights = &FindCloudletScoreWeights{}
+					}
+					if fmap.Has("14.57.1") {
+						if m.PreviousApp.FindCloudletScoreWeights.Distance != src.PreviousApp.FindCloudletScoreWeights.Distance {
+							m.PreviousApp.FindCloudletScoreWeights.Distance = src.PreviousApp.FindCloudletScoreWeights.Distance
+							changed++
+						}
+					}
+					if fmap.Has("14.57.2") {
+						if m.PreviousApp.FindCloudletScoreWeights.Latency != src.PreviousApp.FindCloudletScoreWeights.Latency {
+							m.PreviousApp.FindCloudletScoreWeights.Latency = src.PreviousApp.FindCloudletScoreWeights.Latency
+							changed++
+						}
+					}
+					if fmap.Has("14.57.3") {
+						if m.PreviousApp.FindCloudletScoreWeights.ResourceUsage != src.PreviousApp.FindCloudletScoreWeights.ResourceUsage {
+							m.PreviousApp.FindCloudletScoreWeights.ResourceUsage = src.PreviousApp.FindCloudletScoreWeights.ResourceUsage
+							changed++
+						}
+					}
+					if fmap.Has("14.57.4") {
+						if m.PreviousApp.FindCloudletScoreWeights.Health != src.PreviousApp.FindCloudletScoreWeights.Health {
+							m.PreviousApp.FindCloudletScoreWeights.Health = src.PreviousApp.FindCloudletScoreWeights.Health
+							changed++
+						}
+					}
+				} else if m.PreviousApp.FindCloudletScoreWeights != nil {
+					m.PreviousApp.FindCloudletScoreWeights = nil
+					changed++
+				}
+			}
+			if fmap.Has("14.58") {
+				if m.PreviousApp.GeoFencePolicy != src.PreviousApp.GeoFencePolicy {
+					m.PreviousApp.GeoFencePolicy = src.PreviousApp.GeoFencePolicy
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("14.59") {
+				if src.PreviousApp.ReplicaAutoScalePolicy != nil {
+					if m.PreviousApp.ReplicaAutoScalePolicy == nil {
+						m.PreviousApp.ReplicaAutoScalePolicy = &ReplicaAutoScalePolicy{}
+					}
+					if fmap.Has("14.59.1") {
+						if m.PreviousApp.ReplicaAutoScalePolicy.MinReplicas != src.PreviousApp.ReplicaAutoScalePolicy.MinReplicas {
+							m.PreviousApp.ReplicaAutoScalePolicy.MinReplicas = src.PreviousApp.ReplicaAutoScalePolicy.MinReplicas
+							changed++
+						}
+					}
+					if fmap.Has("14.59.2") {
+						if m.PreviousApp.ReplicaAutoScalePolicy.MaxReplicas != src.PreviousApp.ReplicaAutoScalePolicy.MaxReplicas {
+							m.PreviousApp.ReplicaAutoScalePolicy.MaxReplicas = src.PreviousApp.ReplicaAutoScalePolicy.MaxReplicas
+							changed++
+						}
+					}
+					if fmap.Has("14.59.3") {
+						if m.PreviousApp.ReplicaAutoScalePolicy.TargetCpu != src.PreviousApp.ReplicaAutoScalePolicy.TargetCpu {
+							m.PreviousApp.ReplicaAutoScalePolicy.TargetCpu = src.PreviousApp.ReplicaAutoScalePolicy.TargetCpu
+							changed++
+						}
+					}
+					if fmap.Has("14.59.4") {
+						if m.PreviousApp.ReplicaAutoScalePolicy.TargetMem != src.PreviousApp.ReplicaAutoScalePolicy.TargetMem {
+							m.PreviousApp.ReplicaAutoScalePolicy.TargetMem = src.PreviousApp.ReplicaAutoScalePolicy.TargetMem
+							changed++
+						}
+					}
+					if fmap.Has("14.59.5") {
+						if m.PreviousApp.ReplicaAutoScalePolicy.TargetActiveConnections != src.PreviousApp.ReplicaAutoScalePolicy.TargetActiveConnections {
+							m.PreviousApp.ReplicaAutoScalePolicy.TargetActiveConnections = src.PreviousApp.ReplicaAutoScalePolicy.TargetActiveConnections
+							changed++
+						}
+					}
+					if fmap.Has("14.59.6") {
+						if m.PreviousApp.ReplicaAutoScalePolicy.StabilizationWindowSec != src.PreviousApp.ReplicaAutoScalePolicy.StabilizationWindowSec {
+							m.PreviousApp.ReplicaAutoScalePolicy.StabilizationWindowSec = src.PreviousApp.ReplicaAutoScalePolicy.StabilizationWindowSec
+							changed++
+						}
+					}
+				} else if m.PreviousApp.ReplicaAutoScalePolicy != nil {
+					m.PreviousApp.ReplicaAutoScalePolicy = nil
+					changed++
+				}
+			}
+			if fmap.HasOrHasChild("14.100") {
+				if src.PreviousApp.Tags != nil {
+					if updateListAction == "add" {
+						for k1, v := range src.PreviousApp.Tags {
+							m.PreviousApp.Tags[k1] = v
+							changed++
+						}
+					} else if updateListAction == "remove" {
+						for k1, _ := range src.PreviousApp.Tags {
+							if _, ok := m.PreviousApp.Tags[k1]; ok {
+								delete(m.PreviousApp.Tags, k1)
+								changed++
+							}
+						}
+					} else {
+						m.PreviousApp.Tags = make(map[string]string)
+						for k1, v := range src.PreviousApp.Tags {
+							m.PreviousApp.Tags[k1] = v
+						}
+						changed++
+					}
+				} else if m.PreviousApp.Tags != nil {
+					m.PreviousApp.Tags = nil
+					changed++
+				}
+			}
+		} else if m.PreviousApp != nil {
+			m.PreviousApp = nil
+			changed++
+		}
+	}
+	if fmap.Has("15") {
+		if src.Errors != nil {
+			if updateListAction == "add" {
+				changed += m.AddErrors(src.Errors...)
+			} else if updateListAction == "remove" {
+				changed += m.RemoveErrors(src.Errors...)
+			} else {
+				m.Errors = make([]string, 0)
+				m.Errors = append(m.Errors, src.Errors...)
+				changed++
+			}
+		} else if m.Errors != nil {
+			m.Errors = nil
+			changed++
+		}
+	}
+	if fmap.HasOrHasChild("16") {
+		if fmap.Has("16.1") {
+			if m.CreatedAt.Seconds != src.CreatedAt.Seconds {
+				m.CreatedAt.Seconds = src.CreatedAt.Seconds
+				changed++
+			}
+		}
+		if fmap.Has("16.2") {
+			if m.CreatedAt.Nanos != src.CreatedAt.Nanos {
+				m.CreatedAt.Nanos = src.CreatedAt.Nanos
+				changed++
+			}
+		}
+	}
+	if fmap.HasOrHasChild("17") {
+		if fmap.Has("17.1") {
+			if m.UpdatedAt.Seconds != src.UpdatedAt.Seconds {
+				m.UpdatedAt.Seconds = src.UpdatedAt.Seconds
+				changed++
+			}
+		}
+		if fmap.Has("17.2") {
+			if m.UpdatedAt.Nanos != src.UpdatedAt.Nanos {
+				m.UpdatedAt.Nanos = src.UpdatedAt.Nanos
+				changed++
+			}
+		}
+	}
+	return changed
+}
+
+func (m *AppRollout) DeepCopyIn(src *AppRollout) {
+	m.Key.DeepCopyIn(&src.Key)
+	if src.App != nil {
+		var tmp_App App
+		tmp_App.DeepCopyIn(src.App)
+		m.App = &tmp_App
+	} else {
+		m.App = nil
+	}
+	if src.WavePercentages != nil {
+		m.WavePercentages = make([]uint32, len(src.WavePercentages), len(src.WavePercentages))
+		for ii, s := range src.WavePercentages {
+			m.WavePercentages[ii] = s
+		}
+	} else {
+		m.WavePercentages = nil
+	}
+	m.WavesByZone = src.WavesByZone
+	if src.Zones != nil {
+		m.Zones = make([]ZoneKey, len(src.Zones), len(src.Zones))
+		for ii, s := range src.Zones {
+			m.Zones[ii].DeepCopyIn(&s)
+		}
+	} else {
+		m.Zones = nil
+	}
+	m.WaveSoakTime = src.WaveSoakTime
+	m.PauseBetweenWaves = src.PauseBetweenWaves
+	m.AutoRollback = src.AutoRollback
+	m.State = src.State
+	m.WavesDone = src.WavesDone
+	if src.Waves != nil {
+		m.Waves = make([]AppRolloutWave, len(src.Waves), len(src.Waves))
+		for ii, s := range src.Waves {
+			m.Waves[ii].DeepCopyIn(&s)
+		}
+	} else {
+		m.Waves = nil
+	}
+	m.Revision = src.Revision
+	if src.PreviousApp != nil {
+		var tmp_PreviousApp App
+		tmp_PreviousApp.DeepCopyIn(src.PreviousApp)
+		m.PreviousApp = &tmp_PreviousApp
+	} else {
+		m.PreviousApp = nil
+	}
+	if src.Errors != nil {
+		m.Errors = make([]string, len(src.Errors), len(src.Errors))
+		for ii, s := range src.Errors {
+			m.Errors[ii] = s
+		}
+	} else {
+		m.Errors = nil
+	}
+	m.CreatedAt = src.CreatedAt
+	m.UpdatedAt = src.UpdatedAt
+}
+
+func (s *AppRollout) HasFields() bool {
+	return true
+}
+
+type AppRolloutStore interface {
+	Create(ctx context.Context, m *AppRollout, wait func(int64)) (*Result, error)
+	Update(ctx context.Context, m *AppRollout, wait func(int64)) (*Result, error)
+	Delete(ctx context.Context, m *AppRollout, wait func(int64)) (*Result, error)
+	Put(ctx context.Context, m *AppRollout, wait func(int64), ops ...objstore.KVOp) (*Result, error)
+	LoadOne(key string) (*AppRollout, int64, error)
+	Get(ctx context.Context, key *AppKey, buf *AppRollout) bool
+	STMGet(stm concurrency.STM, key *AppKey, buf *AppRollout) bool
+	STMPut(stm concurrency.STM, obj *AppRollout, ops ...objstore.KVOp)
+	STMDel(stm concurrency.STM, key *AppKey)
+	STMHas(stm concurrency.STM, key *AppKey) bool
+}
+
+type AppRolloutStoreImpl struct {
+	kvstore objstore.KVStore
+}
+
+func NewAppRolloutStore(kvstore objstore.KVStore) *AppRolloutStoreImpl {
+	return &AppRolloutStoreImpl{kvstore: kvstore}
+}
+
+func (s *AppRolloutStoreImpl) Create(ctx context.Context, m *AppRollout, wait func(int64)) (*Result, error) {
+	err := m.Validate(AppRolloutAllFieldsMap)
+	if err != nil {
+		return nil, err
+	}
+	key := objstore.DbKeyString("AppRollout", m.GetKey())
+	val, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	rev, err := s.kvstore.Create(ctx, key, string(val))
+	if err != nil {
+		return nil, err
+	}
+	if wait != nil {
+		wait(rev)
+	}
+	return &Result{}, err
+}
+
+func (s *AppRolloutStoreImpl) Update(ctx context.Context, m *AppRollout, wait func(int64)) (*Result, error) {
+	fmap := MakeFieldMap(m.Fields)
+	err := m.Validate(fmap)
+	if err != nil {
+		return nil, err
+	}
+	key := objstore.DbKeyString("AppRollout", m.GetKey())
+	var vers int64 = 0
+	curBytes, vers, _, err := s.kvstore.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	var cur AppRollout
+	err = json.Unmarshal(curBytes, &cur)
+	if err != nil {
+		return nil, err
+	}
+	cur.CopyInFields(m)
+	// never save fields
+	cur.Fields = nil
+	val, err := json.Marshal(cur)
+	if err != nil {
+		return nil, err
+	}
+	rev, err := s.kvstore.Update(ctx, key, string(val), vers)
+	if err != nil {
+		return nil, err
+	}
+	if wait != nil {
+		wait(rev)
+	}
+	return &Result{}, err
+}
+
+func (s *AppRolloutStoreImpl) Put(ctx context.Context, m *AppRollout, wait func(int64), ops ...objstore.KVOp) (*Result, error) {
+	err := m.Validate(AppRolloutAllFieldsMap)
+	m.Fields = nil
+	if err != nil {
+		return nil, err
+	}
+	key := objstore.DbKeyString("AppRollout", m.GetKey())
+	var val []byte
+	val, err = json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	rev, err := s.kvstore.Put(ctx, key, string(val), ops...)
+	if err != nil {
+		return nil, err
+	}
+	if wait != nil {
+		wait(rev)
+	}
+	return &Result{}, err
+}
+
+func (s *AppRolloutStoreImpl) Delete(ctx context.Context, m *AppRollout, wait func(int64)) (*Result, error) {
+	err := m.GetKey().ValidateKey()
+	if err != nil {
+		return nil, err
+	}
+	key := objstore.DbKeyString("AppRollout", m.GetKey())
+	rev, err := s.kvstore.Delete(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if wait != nil {
+		wait(rev)
+	}
+	return &Result{}, err
+}
+
+func (s *AppRolloutStoreImpl) LoadOne(key string) (*AppRollout, int64, error) {
+	val, rev, _, err := s.kvstore.Get(key)
+	if err != nil {
+		return nil, 0, err
+	}
+	var obj AppRollout
+	err = json.Unmarshal(val, &obj)
+	if err != nil {
+		log.DebugLog(log.DebugLevelApi, "Failed to parse AppRollout data", "val", string(val), "err", err)
+		return nil, 0, err
+	}
+	return &obj, rev, nil
+}
+
+func (s *AppRolloutStoreImpl) Get(ctx context.Context, key *AppKey, buf *AppRollout) bool {
+	keystr := objstore.DbKeyString("AppRollout", key)
+	val, _, _, err := s.kvstore.Get(keystr)
+	if err != nil {
+		return false
+	}
+	return s.parseGetData(val, buf)
+}
+
+func (s *AppRolloutStoreImpl) STMGet(stm concurrency.STM, key *AppKey, buf *AppRollout) bool {
+	keystr := objstore.DbKeyString("AppRollout", key)
+	valstr := stm.Get(keystr)
+	return s.parseGetData([]byte(valstr), buf)
+}
+
+func (s *AppRolloutStoreImpl) STMHas(stm concurrency.STM, key *AppKey) bool {
+	keystr := objstore.DbKeyString("AppRollout", key)
+	return stm.Get(keystr) != ""
+}
+
+func (s *AppRolloutStoreImpl) parseGetData(val []byte, buf *AppRollout) bool {
+	if len(val) == 0 {
+		return false
+	}
+	if buf != nil {
+		// clear buf, because empty values in val won't
+		// overwrite non-empty values in buf.
+		*buf = AppRollout{}
+		err := json.Unmarshal(val, buf)
+		if err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *AppRolloutStoreImpl) STMPut(stm concurrency.STM, obj *AppRollout, ops ...objstore.KVOp) {
+	keystr := objstore.DbKeyString("AppRollout", obj.GetKey())
+
+	val, err := json.Marshal(obj)
+	if err != nil {
+		log.InfoLog("AppRollout json marshal failed", "obj", obj, "err", err)
+	}
+	v3opts := GetSTMOpts(ops...)
+	stm.Put(keystr, string(val), v3opts...)
+}
+
+func (s *AppRolloutStoreImpl) STMDel(stm concurrency.STM, key *AppKey) {
+	keystr := objstore.DbKeyString("AppRollout", key)
+	stm.Del(keystr)
+}
+
+func StoreListAppRollout(ctx context.Context, kvstore objstore.KVStore) ([]AppRollout, error) {
+	keyPrefix := objstore.DbKeyPrefixString("AppRollout") + "/"
+	objs := []AppRollout{}
+	err := kvstore.List(keyPrefix, func(key, val []byte, rev, modRev int64) error {
+		obj := AppRollout{}
+		err := json.Unmarshal(val, &obj)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal AppRollout json %s, %s", string(val), err)
+		}
+		objs = append(objs, obj)
+		return nil
+	})
+	return objs, err
+}
+
+type AppRolloutKeyWatcher struct {
+	cb func(ctx context.Context)
+}
+
+type AppRolloutCacheData struct {
+	Obj    *AppRollout
+	ModRev int64
+}
+
+func (s *AppRolloutCacheData) Clone() *AppRolloutCacheData {
+	cp := AppRolloutCacheData{}
+	if s.Obj != nil {
+		cp.Obj = &AppRollout{}
+		cp.Obj.DeepCopyIn(s.Obj)
+	}
+	cp.ModRev = s.ModRev
+	return &cp
+}
+
+// AppRolloutCache caches AppRollout objects in memory in a hash table
+// and keeps them in sync with the database.
+type AppRolloutCache struct {
+	Objs          map[AppKey]*AppRolloutCacheData
+	Mux           util.Mutex
+	List          map[AppKey]struct{}
+	FlushAll      bool
+	NotifyCbs     []func(ctx context.Context, obj *AppRollout, modRev int64)
+	UpdatedCbs    []func(ctx context.Context, old *AppRollout, new *AppRollout)
+	DeletedCbs    []func(ctx context.Context, old *AppRollout)
+	KeyWatchers   map[AppKey][]*AppRolloutKeyWatcher
+	UpdatedKeyCbs []func(ctx context.Context, key *AppKey)
+	DeletedKeyCbs []func(ctx context.Context, key *AppKey)
+	Store         AppRolloutStore
+}
+
+func NewAppRolloutCache() *AppRolloutCache {
+	cache := AppRolloutCache{}
+	InitAppRolloutCache(&cache)
+	return &cache
+}
+
+func InitAppRolloutCache(cache *AppRolloutCache) {
+	cache.Objs = make(map[AppKey]*AppRolloutCacheData)
+	cache.KeyWatchers = make(map[AppKey][]*AppRolloutKeyWatcher)
+	cache.NotifyCbs = nil
+	cache.UpdatedCbs = nil
+	cache.DeletedCbs = nil
+	cache.UpdatedKeyCbs = nil
+	cache.DeletedKeyCbs = nil
+}
+
+func (c *AppRolloutCache) GetTypeString() string {
+	return "AppRollout"
+}
+
+func (c *AppRolloutCache) Get(key *AppKey, valbuf *AppRollout) bool {
+	var modRev int64
+	return c.GetWithRev(key, valbuf, &modRev)
+}
+
+// STMGet gets from the store if STM is set, otherwise gets from cache
+func (c *AppRolloutCache) STMGet(ostm *OptionalSTM, key *AppKey, valbuf *AppRollout) bool {
+	if ostm.stm != nil {
+		if c.Store == nil {
+			// panic, otherwise if we fallback to cache, we may silently
+			// introduce race conditions and intermittent failures due to
+			// reading from cache during a transaction.
+			panic("AppRolloutCache store not set, cannot read via STM")
+		}
+		return c.Store.STMGet(ostm.stm, key, valbuf)
+	}
+	var modRev int64
+	return c.GetWithRev(key, valbuf, &modRev)
+}
+
+func (c *AppRolloutCache) GetWithRev(key *AppKey, valbuf *AppRollout, modRev *int64) bool {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	inst, found := c.Objs[*key]
+	if found {
+		valbuf.DeepCopyIn(inst.Obj)
+		*modRev = inst.ModRev
+	}
+	return found
+}
+
+func (c *AppRolloutCache) HasKey(key *AppKey) bool {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	_, found := c.Objs[*key]
+	return found
+}
+
+func (c *AppRolloutCache) GetAllKeys(ctx context.Context, cb func(key *AppKey, modRev int64)) {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	for key, data := range c.Objs {
+		cb(&key, data.ModRev)
+	}
+}
+
+func (c *AppRolloutCache) GetAllLocked(ctx context.Context, cb func(obj *AppRollout, modRev int64)) {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	for _, data := range c.Objs {
+		cb(data.Obj, data.ModRev)
+	}
+}
+
+func (c *AppRolloutCache) Update(ctx context.Context, in *AppRollout, modRev int64) {
+	c.UpdateModFunc(ctx, in.GetKey(), modRev, func(old *AppRollout) (*AppRollout, bool) {
+		return in, true
+	})
+}
+
+func (c *AppRolloutCache) UpdateModFunc(ctx context.Context, key *AppKey, modRev int64, modFunc func(old *AppRollout) (new *AppRollout, changed bool)) {
+	c.Mux.Lock()
+	var old *AppRollout
+	if oldData, found := c.Objs[*key]; found {
+		old = oldData.Obj
+	}
+	new, changed := modFunc(old)
+	if !changed {
+		c.Mux.Unlock()
+		return
+	}
+	if len(c.UpdatedCbs) > 0 || len(c.NotifyCbs) > 0 {
+		newCopy := &AppRollout{}
+		newCopy.DeepCopyIn(new)
+		for _, cb := range c.UpdatedCbs {
+			defer cb(ctx, old, newCopy)
+		}
+		for _, cb := range c.NotifyCbs {
+			if cb != nil {
+				defer cb(ctx, newCopy, modRev)
+			}
+		}
+	}
+	for _, cb := range c.UpdatedKeyCbs {
+		defer cb(ctx, key)
+	}
+	store := &AppRollout{}
+	store.DeepCopyIn(new)
+	c.Objs[new.GetKeyVal()] = &AppRolloutCacheData{
+		Obj:    store,
+		ModRev: modRev,
+	}
+	log.SpanLog(ctx, log.DebugLevelApi, "cache update", "new", store)
+	c.Mux.Unlock()
+	c.TriggerKeyWatchers(ctx, new.GetKey())
+}
+
+func (c *AppRolloutCache) Delete(ctx context.Context, in *AppRollout, modRev int64) {
+	c.DeleteCondFunc(ctx, in, modRev, func(old *AppRollout) bool {
+		return true
+	})
+}
+
+func (c *AppRolloutCache) DeleteCondFunc(ctx context.Context, in *AppRollout, modRev int64, condFunc func(old *AppRollout) bool) {
+	c.Mux.Lock()
+	var old *AppRollout
+	oldData, found := c.Objs[in.GetKeyVal()]
+	if found {
+		old = oldData.Obj
+		if !condFunc(old) {
+			c.Mux.Unlock()
+			return
+		}
+	}
+	delete(c.Objs, in.GetKeyVal())
+	log.SpanLog(ctx, log.DebugLevelApi, "cache delete", "key", in.GetKeyVal())
+	c.Mux.Unlock()
+	obj := old
+	if obj == nil {
+		obj = in
+	}
+	for _, cb := range c.NotifyCbs {
+		if cb != nil {
+			cb(ctx, obj, modRev)
+		}
+	}
+	if old != nil {
+		for _, cb := range c.DeletedCbs {
+			cb(ctx, old)
+		}
+	}
+	for _, cb := range c.DeletedKeyCbs {
+		cb(ctx, in.GetKey())
+	}
+	c.TriggerKeyWatchers(ctx, in.GetKey())
+}
+
+func (c *AppRolloutCache) Prune(ctx context.Context, validKeys map[AppKey]struct{}) {
+	log.SpanLog(ctx, log.DebugLevelApi, "Prune AppRollout", "numValidKeys", len(validKeys))
+	notify := make(map[AppKey]*AppRolloutCacheData)
+	c.Mux.Lock()
+	for key, _ := range c.Objs {
+		if _, ok := validKeys[key]; !ok {
+			if len(c.NotifyCbs) > 0 || len(c.DeletedKeyCbs) > 0 || len(c.DeletedCbs) > 0 {
+				notify[key] = c.Objs[key]
+			}
+			delete(c.Objs, key)
+		}
+	}
+	c.Mux.Unlock()
+	for key, old := range notify {
+		obj := old.Obj
+		if obj == nil {
+			obj = &AppRollout{}
+			obj.SetKey(&key)
+		}
+		for _, cb := range c.NotifyCbs {
+			if cb != nil {
+				cb(ctx, obj, old.ModRev)
+			}
+		}
+		for _, cb := range c.DeletedKeyCbs {
+			cb(ctx, &key)
+		}
+		if old.Obj != nil {
+			for _, cb := range c.DeletedCbs {
+				cb(ctx, old.Obj)
+			}
+		}
+		c.TriggerKeyWatchers(ctx, &key)
+	}
+}
+
+func (c *AppRolloutCache) GetCount() int {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	return len(c.Objs)
+}
+
+func (c *AppRolloutCache) Flush(ctx context.Context, notifyId int64) {
+}
+
+func (c *AppRolloutCache) Show(filter *AppRollout, cb func(ret *AppRollout) error) error {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	for _, data := range c.Objs {
+		if !data.Obj.Matches(filter, MatchFilter()) {
+			continue
+		}
+		err := cb(data.Obj)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func AppRolloutGenericNotifyCb(fn func(key *AppKey, old *AppRollout)) func(objstore.ObjKey, objstore.Obj) {
+	return func(objkey objstore.ObjKey, obj objstore.Obj) {
+		fn(objkey.(*AppKey), obj.(*AppRollout))
+	}
+}
+
+func (c *AppRolloutCache) SetNotifyCb(fn func(ctx context.Context, obj *AppRollout, modRev int64)) {
+	c.NotifyCbs = []func(ctx context.Context, obj *AppRollout, modRev int64){fn}
+}
+
+func (c *AppRolloutCache) SetUpdatedCb(fn func(ctx context.Context, old *AppRollout, new *AppRollout)) {
+	c.UpdatedCbs = []func(ctx context.Context, old *AppRollout, new *AppRollout){fn}
+}
+
+func (c *AppRolloutCache) SetDeletedCb(fn func(ctx context.Context, old *AppRollout)) {
+	c.DeletedCbs = []func(ctx context.Context, old *AppRollout){fn}
+}
+
+func (c *AppRolloutCache) SetUpdatedKeyCb(fn func(ctx context.Context, key *AppKey)) {
+	c.UpdatedKeyCbs = []func(ctx context.Context, key *AppKey){fn}
+}
+
+func (c *AppRolloutCache) SetDeletedKeyCb(fn func(ctx context.Context, key *AppKey)) {
+	c.DeletedKeyCbs = []func(ctx context.Context, key *AppKey){fn}
+}
+
+func (c *AppRolloutCache) AddUpdatedCb(fn func(ctx context.Context, old *AppRollout, new *AppRollout)) {
+	c.UpdatedCbs = append(c.UpdatedCbs, fn)
+}
+
+func (c *AppRolloutCache) AddDeletedCb(fn func(ctx context.Context, old *AppRollout)) {
+	c.DeletedCbs = append(c.DeletedCbs, fn)
+}
+
+func (c *AppRolloutCache) AddNotifyCb(fn func(ctx context.Context, obj *AppRollout, modRev int64)) {
+	c.NotifyCbs = append(c.NotifyCbs, fn)
+}
+
+func (c *AppRolloutCache) AddUpdatedKeyCb(fn func(ctx context.Context, key *AppKey)) {
+	c.UpdatedKeyCbs = append(c.UpdatedKeyCbs, fn)
+}
+
+func (c *AppRolloutCache) AddDeletedKeyCb(fn func(ctx context.Context, key *AppKey)) {
+	c.DeletedKeyCbs = append(c.DeletedKeyCbs, fn)
+}
+
+func (c *AppRolloutCache) SetFlushAll() {
+	c.FlushAll = true
+}
+
+func (c *AppRolloutCache) WatchKey(key *AppKey, cb func(ctx context.Context)) context.CancelFunc {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	list, ok := c.KeyWatchers[*key]
+	if !ok {
+		list = make([]*AppRolloutKeyWatcher, 0)
+	}
+	watcher := AppRolloutKeyWatcher{cb: cb}
+	c.KeyWatchers[*key] = append(list, &watcher)
+	log.DebugLog(log.DebugLevelApi, "Watching AppRollout", "key", key)
+	return func() {
+		c.Mux.Lock()
+		defer c.Mux.Unlock()
+		list, ok := c.KeyWatchers[*key]
+		if !ok {
+			return
+		}
+		for ii, _ := range list {
+			if list[ii] != &watcher {
+				continue
+			}
+			if len(list) == 1 {
+				delete(c.KeyWatchers, *key)
+				return
+			}
+			list[ii] = list[len(list)-1]
+			list[len(list)-1] = nil
+			c.KeyWatchers[*key] = list[:len(list)-1]
+			return
+		}
+	}
+}
+
+func (c *AppRolloutCache) TriggerKeyWatchers(ctx context.Context, key *AppKey) {
+	watchers := make([]*AppRolloutKeyWatcher, 0)
+	c.Mux.Lock()
+	if list, ok := c.KeyWatchers[*key]; ok {
+		watchers = append(watchers, list...)
+	}
+	c.Mux.Unlock()
+	for ii, _ := range watchers {
+		watchers[ii].cb(ctx)
+	}
+}
+
+// Note that we explicitly ignore the global revision number, because of the way
+// the notify framework sends updates (by hashing keys and doing lookups, instead
+// of sequentially through a history buffer), updates may be done out-of-order
+// or multiple updates compressed into one update, so the state of the cache at
+// any point in time may not by in sync with a particular database revision number.
+
+func (c *AppRolloutCache) SyncUpdate(ctx context.Context, key, val []byte, rev, modRev int64) {
+	obj := AppRollout{}
+	err := json.Unmarshal(val, &obj)
+	if err != nil {
+		log.WarnLog("Failed to parse AppRollout data", "val", string(val), "err", err)
+		return
+	}
+	c.Update(ctx, &obj, modRev)
+	c.Mux.Lock()
+	if c.List != nil {
+		c.List[obj.GetKeyVal()] = struct{}{}
+	}
+	c.Mux.Unlock()
+}
+
+func (c *AppRolloutCache) SyncDelete(ctx context.Context, key []byte, rev, modRev int64) {
+	obj := AppRollout{}
+	keystr := objstore.DbKeyPrefixRemove(string(key))
+	AppKeyStringParse(keystr, obj.GetKey())
+	c.Delete(ctx, &obj, modRev)
+}
+
+func (c *AppRolloutCache) SyncListStart(ctx context.Context) {
+	c.List = make(map[AppKey]struct{})
+}
+
+func (c *AppRolloutCache) SyncListEnd(ctx context.Context) {
+	deleted := make(map[AppKey]*AppRolloutCacheData)
+	c.Mux.Lock()
+	for key, val := range c.Objs {
+		if _, found := c.List[key]; !found {
+			deleted[key] = val
+			delete(c.Objs, key)
+		}
+	}
+	c.List = nil
+	c.Mux.Unlock()
+	for key, val := range deleted {
+		obj := val.Obj
+		if obj == nil {
+			obj = &AppRollout{}
+			obj.SetKey(&key)
+		}
+		for _, cb := range c.NotifyCbs {
+			if cb != nil {
+				cb(ctx, obj, val.ModRev)
+			}
+		}
+		for _, cb := range c.DeletedKeyCbs {
+			cb(ctx, &key)
+		}
+		if val.Obj != nil {
+			for _, cb := range c.DeletedCbs {
+				cb(ctx, val.Obj)
+			}
+		}
+		c.TriggerKeyWatchers(ctx, &key)
+	}
+}
+
+func (s *AppRolloutCache) InitCacheWithSync(sync DataSync) {
+	InitAppRolloutCache(s)
+	s.InitSync(sync)
+}
+
+func (s *AppRolloutCache) InitSync(sync DataSync) {
+	if sync != nil {
+		s.Store = NewAppRolloutStore(sync.GetKVStore())
+		sync.RegisterCache(s)
+	}
+}
+
+func InitAppRolloutCacheWithStore(cache *AppRolloutCache, store AppRolloutStore) {
+	InitAppRolloutCache(cache)
+	cache.Store = store
+}
+
+func (c *AppRolloutCache) UsesOrg(org string) bool {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	for key, _ := range c.Objs {
+		if key.Organization == org {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *AppRollout) GetObjKey() objstore.ObjKey {
+	return m.GetKey()
+}
+
+func (m *AppRollout) GetKey() *AppKey {
+	return &m.Key
+}
+
+func (m *AppRollout) GetKeyVal() AppKey {
+	return m.Key
+}
+
+func (m *AppRollout) SetKey(key *AppKey) {
+	m.Key = *key
+}
+
+func CmpSortAppRollout(a AppRollout, b AppRollout) bool {
+	return a.Key.GetKeyString() < b.Key.GetKeyString()
+}
+
+// Helper method to check that enums have valid values
+// NOTE: ValidateEnums checks all Fields even if some are not set
+func (m *AppRollout) ValidateEnums() error {
+	if err := m.Key.ValidateEnums(); err != nil {
+		return err
+	}
+	if m.App != nil {
+		if err := m.App.ValidateEnums(); err != nil {
+			return err
+		}
+	}
+	for _, e := range m.Zones {
+		if err := e.ValidateEnums(); err != nil {
+			return err
+		}
+	}
+	if _, ok := AppRolloutState_name[int32(m.State)]; !ok {
+		return errors.New("invalid State")
+	}
+	for _, e := range m.Waves {
+		if err := e.ValidateEnums(); err != nil {
+			return err
+		}
+	}
+	if m.PreviousApp != nil {
+		if err := m.PreviousApp.ValidateEnums(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *AppRollout) ClearTagged(tags map[string]struct{}) {
+	s.Key.ClearTagged(tags)
+	if s.App != nil {
+		s.App.ClearTagged(tags)
+	}
+	if s.Zones != nil {
+		for ii := 0; ii < len(s.Zones); ii++ {
+			s.Zones[ii].ClearTagged(tags)
+		}
+	}
+	if s.Waves != nil {
+		for ii := 0; ii < len(s.Waves); ii++ {
+			s.Waves[ii].ClearTagged(tags)
+		}
+	}
+	if _, found := tags["nocmp"]; found {
+		s.PreviousApp = nil
+	}
+	if _, found := tags["timestamp"]; found {
+		s.CreatedAt = distributed_match_engine.Timestamp{}
+	}
+	if _, found := tags["timestamp"]; found {
+		s.UpdatedAt = distributed_match_engine.Timestamp{}
+	}
+}
+
+func IgnoreAppRolloutFields(taglist string) cmp.Option {
+	names := []string{}
+	tags := make(map[string]struct{})
+	for _, tag := range strings.Split(taglist, ",") {
+		tags[tag] = struct{}{}
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "App.AuthPublicKey")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "App.Revision")
+	}
+	if _, found := tags["timestamp"]; found {
+		names = append(names, "App.CreatedAt")
+	}
+	if _, found := tags["timestamp"]; found {
+		names = append(names, "App.UpdatedAt")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "App.ObjId")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "App.CompatibilityVersion")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "PreviousApp")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "PreviousApp.AuthPublicKey")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "PreviousApp.Revision")
+	}
+	if _, found := tags["timestamp"]; found {
+		names = append(names, "PreviousApp.CreatedAt")
+	}
+	if _, found := tags["timestamp"]; found {
+		names = append(names, "PreviousApp.UpdatedAt")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "PreviousApp.ObjId")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "PreviousApp.CompatibilityVersion")
+	}
+	if _, found := tags["timestamp"]; found {
+		names = append(names, "CreatedAt")
+	}
+	if _, found := tags["timestamp"]; found {
+		names = append(names, "UpdatedAt")
+	}
+	return cmpopts.IgnoreFields(AppRollout{}, names...)
+}
+
+var AppRolloutStateStrings = []string{
+	"APP_ROLLOUT_UNKNOWN",
+	"APP_ROLLOUT_IN_PROGRESS",
+	"APP_ROLLOUT_PAUSED",
+	"APP_ROLLOUT_COMPLETED",
+	"APP_ROLLOUT_ROLLING_BACK",
+	"APP_ROLLOUT_ROLLED_BACK",
+	"APP_ROLLOUT_FAILED",
+}
+
+const (
+	AppRolloutStateAPP_ROLLOUT_UNKNOWN      uint64 = 1 << 0
+	AppRolloutStateAPP_ROLLOUT_IN_PROGRESS  uint64 = 1 << 1
+	AppRolloutStateAPP_ROLLOUT_PAUSED       uint64 = 1 << 2
+	AppRolloutStateAPP_ROLLOUT_COMPLETED    uint64 = 1 << 3
+	AppRolloutStateAPP_ROLLOUT_ROLLING_BACK uint64 = 1 << 4
+	AppRolloutStateAPP_ROLLOUT_ROLLED_BACK  uint64 = 1 << 5
+	AppRolloutStateAPP_ROLLOUT_FAILED       uint64 = 1 << 6
+)
+
+var AppRolloutState_CamelName = map[int32]string{
+	// APP_ROLLOUT_UNKNOWN -> AppRolloutUnknown
+	0: "AppRolloutUnknown",
+	// APP_ROLLOUT_IN_PROGRESS -> AppRolloutInProgress
+	1: "AppRolloutInProgress",
+	// APP_ROLLOUT_PAUSED -> AppRolloutPaused
+	2: "AppRolloutPaused",
+	// APP_ROLLOUT_COMPLETED -> AppRolloutCompleted
+	3: "AppRolloutCompleted",
+	// APP_ROLLOUT_ROLLING_BACK -> AppRolloutRollingBack
+	4: "AppRolloutRollingBack",
+	// APP_ROLLOUT_ROLLED_BACK -> AppRolloutRolledBack
+	5: "AppRolloutRolledBack",
+	// APP_ROLLOUT_FAILED -> AppRolloutFailed
+	6: "AppRolloutFailed",
+}
+var AppRolloutState_CamelValue = map[string]int32{
+	"AppRolloutUnknown":     0,
+	"AppRolloutInProgress":  1,
+	"AppRolloutPaused":      2,
+	"AppRolloutCompleted":   3,
+	"AppRolloutRollingBack": 4,
+	"AppRolloutRolledBack":  5,
+	"AppRolloutFailed":      6,
+}
+
+func ParseAppRolloutState(data interface{}) (AppRolloutState, error) {
+	if val, ok := data.(AppRolloutState); ok {
+		return val, nil
+	} else if str, ok := data.(string); ok {
+		val, ok := AppRolloutState_CamelValue[util.CamelCase(str)]
+		if !ok {
+			// may have omitted common prefix
+			val, ok = AppRolloutState_CamelValue["AppRollout"+util.CamelCase(str)]
+		}
+		if !ok {
+			// may be int value instead of enum name
+			ival, err := strconv.Atoi(str)
+			val = int32(ival)
+			if err == nil {
+				_, ok = AppRolloutState_CamelName[val]
+			}
+		}
+		if !ok {
+			return AppRolloutState(0), fmt.Errorf("Invalid AppRolloutState value %q", str)
+		}
+		return AppRolloutState(val), nil
+	} else if ival, ok := data.(int32); ok {
+		if _, ok := AppRolloutState_CamelName[ival]; ok {
+			return AppRolloutState(ival), nil
+		} else {
+			return AppRolloutState(0), fmt.Errorf("Invalid AppRolloutState value %d", ival)
+		}
+	}
+	return AppRolloutState(0), fmt.Errorf("Invalid AppRolloutState value %v", data)
+}
+
+func (e *AppRolloutState) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	err := unmarshal(&str)
+	if err != nil {
+		return err
+	}
+	val, err := ParseAppRolloutState(str)
+	if err != nil {
+		return err
+	}
+	*e = val
+	return nil
+}
+
+func (e AppRolloutState) MarshalYAML() (interface{}, error) {
+	str := proto.EnumName(AppRolloutState_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "AppRollout")
+	return str, nil
+}
+
+// custom JSON encoding/decoding
+func (e *AppRolloutState) UnmarshalJSON(b []byte) error {
+	var str string
+	err := json.Unmarshal(b, &str)
+	if err == nil {
+		val, err := ParseAppRolloutState(str)
+		if err != nil {
+			return &json.UnmarshalTypeError{
+				Value: "string " + str,
+				Type:  reflect.TypeOf(AppRolloutState(0)),
+			}
+		}
+		*e = AppRolloutState(val)
+		return nil
+	}
+	var ival int32
+	err = json.Unmarshal(b, &ival)
+	if err == nil {
+		val, err := ParseAppRolloutState(ival)
+		if err == nil {
+			*e = val
+			return nil
+		}
+	}
+	return &json.UnmarshalTypeError{
+		Value: "value " + string(b),
+		Type:  reflect.TypeOf(AppRolloutState(0)),
+	}
+}
+
+func (e AppRolloutState) MarshalJSON() ([]byte, error) {
+	str := proto.EnumName(AppRolloutState_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "AppRollout")
+	return json.Marshal(str)
+}
+
+var AppRolloutStateCommonPrefix = "AppRollout"
+
+func (m *AppRollout) IsValidArgsForCreateAppRollout() error {
+	if m.State != 0 {
+		return fmt.Errorf("Invalid field specified: State, this field is only for internal use")
+	}
+	if m.WavesDone != 0 {
+		return fmt.Errorf("Invalid field specified: WavesDone, this field is only for internal use")
+	}
+	if m.Waves != nil {
+		return fmt.Errorf("Invalid field specified: Waves, this field is only for internal use")
+	}
+	if m.Revision != "" {
+		return fmt.Errorf("Invalid field specified: Revision, this field is only for internal use")
+	}
+	if m.PreviousApp != nil {
+		return fmt.Errorf("Invalid field specified: PreviousApp, this field is only for internal use")
+	}
+	if m.Errors != nil {
+		return fmt.Errorf("Invalid field specified: Errors, this field is only for internal use")
+	}
+	if m.CreatedAt.Seconds != 0 {
+		return fmt.Errorf("Invalid field specified: CreatedAt.Seconds, this field is only for internal use")
+	}
+	if m.CreatedAt.Nanos != 0 {
+		return fmt.Errorf("Invalid field specified: CreatedAt.Nanos, this field is only for internal use")
+	}
+	if m.UpdatedAt.Seconds != 0 {
+		return fmt.Errorf("Invalid field specified: UpdatedAt.Seconds, this field is only for internal use")
+	}
+	if m.UpdatedAt.Nanos != 0 {
+		return fmt.Errorf("Invalid field specified: UpdatedAt.Nanos, this field is only for internal use")
+	}
+	return nil
+}
+
+func (m *AppRollout) IsValidArgsForDeleteAppRollout() error {
+	if m.State != 0 {
+		return fmt.Errorf("Invalid field specified: State, this field is only for internal use")
+	}
+	if m.WavesDone != 0 {
+		return fmt.Errorf("Invalid field specified: WavesDone, this field is only for internal use")
+	}
+	if m.Waves != nil {
+		return fmt.Errorf("Invalid field specified: Waves, this field is only for internal use")
+	}
+	if m.Revision != "" {
+		return fmt.Errorf("Invalid field specified: Revision, this field is only for internal use")
+	}
+	if m.PreviousApp != nil {
+		return fmt.Errorf("Invalid field specified: PreviousApp, this field is only for internal use")
+	}
+	if m.Errors != nil {
+		return fmt.Errorf("Invalid field specified: Errors, this field is only for internal use")
+	}
+	if m.CreatedAt.Seconds != 0 {
+		return fmt.Errorf("Invalid field specified: CreatedAt.Seconds, this field is only for internal use")
+	}
+	if m.CreatedAt.Nanos != 0 {
+		return fmt.Errorf("Invalid field specified: CreatedAt.Nanos, this field is only for internal use")
+	}
+	if m.UpdatedAt.Seconds != 0 {
+		return fmt.Errorf("Invalid field specified: UpdatedAt.Seconds, this field is only for internal use")
+	}
+	if m.UpdatedAt.Nanos != 0 {
+		return fmt.Errorf("Invalid field specified: UpdatedAt.Nanos, this field is only for internal use")
+	}
+	return nil
+}
+
+func (m *AppRollout) IsValidArgsForPauseAppRollout() error {
+	if m.State != 0 {
+		return fmt.Errorf("Invalid field specified: State, this field is only for internal use")
+	}
+	if m.WavesDone != 0 {
+		return fmt.Errorf("Invalid field specified: WavesDone, this field is only for internal use")
+	}
+	if m.Waves != nil {
+		return fmt.Errorf("Invalid field specified: Waves, this field is only for internal use")
+	}
+	if m.Revision != "" {
+		return fmt.Errorf("Invalid field specified: Revision, this field is only for internal use")
+	}
+	if m.PreviousApp != nil {
+		return fmt.Errorf("Invalid field specified: PreviousApp, this field is only for internal use")
+	}
+	if m.Errors != nil {
+		return fmt.Errorf("Invalid field specified: Errors, this field is only for internal use")
+	}
+	if m.CreatedAt.Seconds != 0 {
+		return fmt.Errorf("Invalid field specified: CreatedAt.Seconds, this field is only for internal use")
+	}
+	if m.CreatedAt.Nanos != 0 {
+		return fmt.Errorf("Invalid field specified: CreatedAt.Nanos, this field is only for internal use")
+	}
+	if m.UpdatedAt.Seconds != 0 {
+		return fmt.Errorf("Invalid field specified: UpdatedAt.Seconds, this field is only for internal use")
+	}
+	if m.UpdatedAt.Nanos != 0 {
+		return fmt.Errorf("Invalid field specified: UpdatedAt.Nanos, this field is only for internal use")
+	}
+	return nil
+}
+
+func (m *AppRollout) IsValidArgsForResumeAppRollout() error {
+	if m.State != 0 {
+		return fmt.Errorf("Invalid field specified: State, this field is only for internal use")
+	}
+	if m.WavesDone != 0 {
+		return fmt.Errorf("Invalid field specified: WavesDone, this field is only for internal use")
+	}
+	if m.Waves != nil {
+		return fmt.Errorf("Invalid field specified: Waves, this field is only for internal use")
+	}
+	if m.Revision != "" {
+		return fmt.Errorf("Invalid field specified: Revision, this field is only for internal use")
+	}
+	if m.PreviousApp != nil {
+		return fmt.Errorf("Invalid field specified: PreviousApp, this field is only for internal use")
+	}
+	if m.Errors != nil {
+		return fmt.Errorf("Invalid field specified: Errors, this field is only for internal use")
+	}
+	if m.CreatedAt.Seconds != 0 {
+		return fmt.Errorf("Invalid field specified: CreatedAt.Seconds, this field is only for internal use")
+	}
+	if m.CreatedAt.Nanos != 0 {
+		return fmt.Errorf("Invalid field specified: CreatedAt.Nanos, this field is only for internal use")
+	}
+	if m.UpdatedAt.Seconds != 0 {
+		return fmt.Errorf("Invalid field specified: UpdatedAt.Seconds, this field is only for internal use")
+	}
+	if m.UpdatedAt.Nanos != 0 {
+		return fmt.Errorf("Invalid field specified: UpdatedAt.Nanos, this field is only for internal use")
+	}
+	return nil
+}
+
+func (m *AppRollout) IsValidArgsForRollbackAppRollout() error {
+	if m.State != 0 {
+		return fmt.Errorf("Invalid field specified: State, this field is only for internal use")
+	}
+	if m.WavesDone != 0 {
+		return fmt.Errorf("Invalid field specified: WavesDone, this field is only for internal use")
+	}
+	if m.Waves != nil {
+		return fmt.Errorf("Invalid field specified: Waves, this field is only for internal use")
+	}
+	if m.Revision != "" {
+		return fmt.Errorf("Invalid field specified: Revision, this field is only for internal use")
+	}
+	if m.PreviousApp != nil {
+		return fmt.Errorf("Invalid field specified: PreviousApp, this field is only for internal use")
+	}
+	if m.Errors != nil {
+		return fmt.Errorf("Invalid field specified: Errors, this field is only for internal use")
+	}
+	if m.CreatedAt.Seconds != 0 {
+		return fmt.Errorf("Invalid field specified: CreatedAt.Seconds, this field is only for internal use")
+	}
+	if m.CreatedAt.Nanos != 0 {
+		return fmt.Errorf("Invalid field specified: CreatedAt.Nanos, this field is only for internal use")
+	}
+	if m.UpdatedAt.Seconds != 0 {
+		return fmt.Errorf("Invalid field specified: UpdatedAt.Seconds, this field is only for internal use")
+	}
+	if m.UpdatedAt.Nanos != 0 {
+		return fmt.Errorf("Invalid field specified: UpdatedAt.Nanos, this field is only for internal use")
+	}
+	return nil
+}
+
+func (m *AppRolloutWave) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AppInsts) > 0 {
+		for _, e := range m.AppInsts {
+			l = e.Size()
+			n += 1 + l + sovApprollout(uint64(l))
+		}
+	}
+	l = m.ZoneKey.Size()
+	n += 1 + l + sovApprollout(uint64(l))
+	return n
+}
+
+func (m *AppRollout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovApprollout(uint64(l))
+		}
+	}
+	l = m.Key.Size()
+	n += 1 + l + sovApprollout(uint64(l))
+	if m.App != nil {
+		l = m.App.Size()
+		n += 1 + l + sovApprollout(uint64(l))
+	}
+	if len(m.WavePercentages) > 0 {
+		l = 0
+		for _, e := range m.WavePercentages {
+			l += sovApprollout(uint64(e))
+		}
+		n += 1 + sovApprollout(uint64(l)) + l
+	}
+	if m.WavesByZone {
+		n += 2
+	}
+	if len(m.Zones) > 0 {
+		for _, e := range m.Zones {
+			l = e.Size()
+			n += 1 + l + sovApprollout(uint64(l))
+		}
+	}
+	if m.WaveSoakTime != 0 {
+		n += 1 + sovApprollout(uint64(m.WaveSoakTime))
+	}
+	if m.PauseBetweenWaves {
+		n += 2
+	}
+	if m.AutoRollback {
+		n += 2
+	}
+	if m.State != 0 {
+		n += 1 + sovApprollout(uint64(m.State))
+	}
+	if m.WavesDone != 0 {
+		n += 1 + sovApprollout(uint64(m.WavesDone))
+	}
+	if len(m.Waves) > 0 {
+		for _, e := range m.Waves {
+			l = e.Size()
+			n += 1 + l + sovApprollout(uint64(l))
+		}
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovApprollout(uint64(l))
+	}
+	if m.PreviousApp != nil {
+		l = m.PreviousApp.Size()
+		n += 1 + l + sovApprollout(uint64(l))
+	}
+	if len(m.Errors) > 0 {
+		for _, s := range m.Errors {
+			l = len(s)
+			n += 1 + l + sovApprollout(uint64(l))
+		}
+	}
+	l = m.CreatedAt.Size()
+	n += 2 + l + sovApprollout(uint64(l))
+	l = m.UpdatedAt.Size()
+	n += 2 + l + sovApprollout(uint64(l))
+	return n
+}
+
+func sovApprollout(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozApprollout(x uint64) (n int) {
+	return sovApprollout(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AppRolloutWave) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApprollout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppRolloutWave: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppRolloutWave: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppInsts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApprollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppInsts = append(m.AppInsts, AppInstKey{})
+			if err := m.AppInsts[len(m.AppInsts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZoneKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApprollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ZoneKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApprollout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppRollout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApprollout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppRollout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppRollout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApprollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApprollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApprollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.App == nil {
+				m.App = &App{}
+			}
+			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApprollout
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.WavePercentages = append(m.WavePercentages, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApprollout
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthApprollout
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthApprollout
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.WavePercentages) == 0 {
+					m.WavePercentages = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApprollout
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.WavePercentages = append(m.WavePercentages, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field WavePercentages", wireType)
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WavesByZone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApprollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WavesByZone = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApprollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zones = append(m.Zones, ZoneKey{})
+			if err := m.Zones[len(m.Zones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaveSoakTime", wireType)
+			}
+			m.WaveSoakTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApprollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WaveSoakTime |= Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseBetweenWaves", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApprollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PauseBetweenWaves = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRollback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApprollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRollback = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApprollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= AppRolloutState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WavesDone", wireType)
+			}
+			m.WavesDone = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApprollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WavesDone |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Waves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApprollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Waves = append(m.Waves, AppRolloutWave{})
+			if err := m.Waves[len(m.Waves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApprollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousApp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApprollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousApp == nil {
+				m.PreviousApp = &App{}
+			}
+			if err := m.PreviousApp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApprollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApprollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApprollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApprollout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApprollout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipApprollout(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowApprollout
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowApprollout
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowApprollout
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthApprollout
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupApprollout
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthApprollout
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthApprollout        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowApprollout          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupApprollout = fmt.Errorf("proto: unexpected end of group")
+)
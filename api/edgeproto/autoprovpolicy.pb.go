@@ -227,6 +227,47 @@ func (m *AutoProvPolicyZone) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AutoProvPolicyZone proto.InternalMessageInfo
 
+// AutoProvPolicyHistory is an Auto Provisioning Policy as of a past revision
+type AutoProvPolicyHistory struct {
+	// Database revision of the change
+	Revision int64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// AutoProvPolicy as of the revision
+	Obj AutoProvPolicy `protobuf:"bytes,2,opt,name=obj,proto3" json:"obj"`
+}
+
+func (m *AutoProvPolicyHistory) Reset()         { *m = AutoProvPolicyHistory{} }
+func (m *AutoProvPolicyHistory) String() string { return proto.CompactTextString(m) }
+func (*AutoProvPolicyHistory) ProtoMessage()    {}
+func (*AutoProvPolicyHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_199b84e2b69e837c, []int{4}
+}
+func (m *AutoProvPolicyHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoProvPolicyHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoProvPolicyHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoProvPolicyHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoProvPolicyHistory.Merge(m, src)
+}
+func (m *AutoProvPolicyHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoProvPolicyHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoProvPolicyHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoProvPolicyHistory proto.InternalMessageInfo
+
 // AutoProvInfo notifies the controller when cloudlet maintenance failover is done.
 type AutoProvInfo struct {
 	// Fields are used for the Update API to specify which fields to apply
@@ -247,7 +288,7 @@ func (m *AutoProvInfo) Reset()         { *m = AutoProvInfo{} }
 func (m *AutoProvInfo) String() string { return proto.CompactTextString(m) }
 func (*AutoProvInfo) ProtoMessage()    {}
 func (*AutoProvInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_199b84e2b69e837c, []int{4}
+	return fileDescriptor_199b84e2b69e837c, []int{5}
 }
 func (m *AutoProvInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -281,88 +322,94 @@ func init() {
 	proto.RegisterType((*AutoProvCount)(nil), "edgeproto.AutoProvCount")
 	proto.RegisterType((*AutoProvCounts)(nil), "edgeproto.AutoProvCounts")
 	proto.RegisterType((*AutoProvPolicyZone)(nil), "edgeproto.AutoProvPolicyZone")
+	proto.RegisterType((*AutoProvPolicyHistory)(nil), "edgeproto.AutoProvPolicyHistory")
 	proto.RegisterType((*AutoProvInfo)(nil), "edgeproto.AutoProvInfo")
 }
 
 func init() { proto.RegisterFile("autoprovpolicy.proto", fileDescriptor_199b84e2b69e837c) }
 
 var fileDescriptor_199b84e2b69e837c = []byte{
-	// 1193 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x68, 0x1b, 0x47,
-	0x14, 0xd6, 0x58, 0xb2, 0x2d, 0x8d, 0xad, 0x10, 0x6d, 0x64, 0x67, 0xe2, 0x3a, 0x8a, 0x51, 0x69,
-	0x31, 0xa9, 0x90, 0x8c, 0x42, 0x4b, 0x71, 0x49, 0xc0, 0x76, 0x28, 0x98, 0xe0, 0x34, 0x6c, 0xda,
-	0x14, 0x72, 0xc8, 0x32, 0xd9, 0x7d, 0x56, 0x96, 0xec, 0xce, 0x2c, 0xbb, 0x23, 0x39, 0xf2, 0xa5,
-	0xa5, 0x3d, 0xb4, 0xc7, 0x90, 0x1e, 0x5a, 0x72, 0xea, 0xa1, 0x87, 0xd2, 0x3f, 0x8a, 0xa1, 0xf7,
-	0xd2, 0x93, 0x4f, 0xc5, 0x90, 0x4b, 0x4f, 0x25, 0xb5, 0x7b, 0x28, 0x3e, 0x05, 0x22, 0xe9, 0x5c,
-	0x66, 0x76, 0x25, 0xad, 0x54, 0x39, 0x98, 0xb8, 0xb7, 0x79, 0xef, 0x7d, 0x6f, 0xf6, 0x7b, 0xdf,
-	0xbc, 0xf7, 0x16, 0xe7, 0x69, 0x5d, 0x70, 0xcf, 0xe7, 0x0d, 0x8f, 0x3b, 0xb6, 0xd9, 0x2c, 0x7b,
-	0x3e, 0x17, 0x5c, 0xcb, 0x80, 0x55, 0x03, 0x75, 0x9c, 0x9b, 0xaf, 0x71, 0x5e, 0x73, 0xa0, 0x42,
-	0x3d, 0xbb, 0x42, 0x19, 0xe3, 0x82, 0x0a, 0x9b, 0xb3, 0x20, 0x04, 0xce, 0x4d, 0xfb, 0x10, 0xd4,
-	0x1d, 0x11, 0x59, 0xe7, 0x05, 0xe7, 0x4e, 0x50, 0x51, 0x46, 0x0d, 0x58, 0xef, 0x10, 0x85, 0xf3,
-	0x35, 0x5e, 0xe3, 0xea, 0x58, 0x91, 0xa7, 0xc8, 0x3b, 0x23, 0x19, 0x04, 0x26, 0x75, 0x20, 0x4e,
-	0x61, 0x2e, 0x67, 0x3a, 0xbc, 0x6e, 0x39, 0x20, 0xee, 0x43, 0xd7, 0x95, 0xa1, 0x9e, 0x17, 0x1d,
-	0xcf, 0x58, 0xae, 0xa4, 0xe4, 0x99, 0xdc, 0x75, 0x79, 0xf7, 0xfe, 0x0b, 0x11, 0x55, 0x65, 0xdd,
-	0xad, 0x6f, 0x56, 0x84, 0xed, 0x42, 0x20, 0xa8, 0x1b, 0x65, 0x15, 0x9f, 0xa4, 0xf0, 0xa9, 0x95,
-	0xba, 0xe0, 0x37, 0x7c, 0xde, 0xb8, 0xa1, 0x3e, 0xa6, 0xcd, 0xe2, 0x89, 0x4d, 0x1b, 0x1c, 0x2b,
-	0x20, 0x68, 0x21, 0xb9, 0x98, 0xd1, 0x23, 0x4b, 0x2b, 0xe1, 0xe4, 0x7d, 0x68, 0x92, 0xb1, 0x05,
-	0xb4, 0x38, 0x55, 0xcd, 0x97, 0x7b, 0x7a, 0x94, 0xc3, 0xbc, 0x6b, 0xd0, 0x5c, 0x4d, 0xed, 0xfe,
-	0x79, 0x21, 0xa1, 0x4b, 0x98, 0x56, 0xc6, 0x67, 0x2c, 0xf0, 0x1c, 0xde, 0x34, 0x4c, 0xc7, 0x06,
-	0x26, 0x0c, 0x93, 0xd7, 0x99, 0x20, 0xc9, 0x05, 0xb4, 0x98, 0xd5, 0x73, 0x61, 0x68, 0x4d, 0x45,
-	0xd6, 0x64, 0x40, 0x7b, 0x1b, 0xcf, 0x44, 0x78, 0x9b, 0x09, 0xf0, 0x1b, 0xd4, 0x89, 0x32, 0x52,
-	0x32, 0x63, 0x35, 0xf5, 0x79, 0x8b, 0x20, 0x3d, 0xba, 0x72, 0x3d, 0x42, 0x84, 0x99, 0x55, 0x3c,
-	0xbe, 0xcd, 0x19, 0x04, 0x64, 0x7c, 0x21, 0xb9, 0x38, 0x55, 0xd5, 0x62, 0xcc, 0x6e, 0x73, 0x06,
-	0x92, 0x57, 0xfa, 0xb0, 0x43, 0x52, 0xd2, 0xd0, 0x43, 0xa8, 0xb6, 0x84, 0xf3, 0xae, 0xcd, 0x0c,
-	0x6a, 0x0a, 0xbb, 0x01, 0x86, 0xcd, 0x02, 0x41, 0x99, 0x09, 0x01, 0x99, 0x50, 0xf4, 0x34, 0xd7,
-	0x66, 0x2b, 0x2a, 0xb4, 0xde, 0x8d, 0x68, 0xaf, 0xe2, 0xac, 0x4b, 0x1f, 0xc4, 0xa0, 0x93, 0x0a,
-	0x3a, 0xed, 0xd2, 0x07, 0x7d, 0x50, 0x15, 0xcf, 0xd4, 0xd9, 0xa8, 0xb2, 0xd3, 0x0a, 0x7c, 0xa6,
-	0x1b, 0x8c, 0x17, 0xfe, 0x16, 0x3e, 0xdb, 0xcb, 0x19, 0x2a, 0x3d, 0xa3, 0xb2, 0x7a, 0x57, 0x0e,
-	0x96, 0xfd, 0x06, 0x3e, 0x65, 0x81, 0x03, 0x02, 0x0c, 0xcf, 0x07, 0x8f, 0xfa, 0x40, 0xf0, 0x02,
-	0x5a, 0x4c, 0xaf, 0xa6, 0xbe, 0x95, 0x4a, 0x65, 0xc3, 0xd8, 0x8d, 0x30, 0xb4, 0xec, 0xfe, 0xf3,
-	0x9c, 0xa0, 0x67, 0xcf, 0x09, 0xfa, 0xb8, 0x45, 0xd0, 0xc3, 0x16, 0x41, 0x5f, 0xb5, 0x08, 0x7a,
-	0xd4, 0x26, 0xd9, 0xab, 0x71, 0xd8, 0xe3, 0x36, 0x79, 0x8d, 0x51, 0x17, 0x2e, 0x5f, 0x83, 0x66,
-	0xf9, 0x3a, 0x75, 0xa1, 0x44, 0x3d, 0x8f, 0xfb, 0x35, 0x65, 0xbf, 0xe7, 0xd7, 0x28, 0xb3, 0xb7,
-	0x55, 0xaf, 0xef, 0x74, 0xc8, 0xe9, 0xfb, 0xd0, 0xbc, 0x1c, 0xf7, 0xfd, 0xd6, 0x21, 0x93, 0x91,
-	0xde, 0xc5, 0x1f, 0x11, 0xce, 0x76, 0xbb, 0x2a, 0x64, 0xbb, 0x84, 0x27, 0xa9, 0xe7, 0x19, 0xb2,
-	0x81, 0x90, 0x6a, 0xa0, 0x5c, 0xec, 0x99, 0x56, 0x3c, 0xaf, 0xdf, 0x3d, 0x13, 0x54, 0x59, 0xda,
-	0x25, 0x9c, 0x96, 0x6f, 0x65, 0xf4, 0x7b, 0x6e, 0xd4, 0xcb, 0x86, 0x39, 0x93, 0xdb, 0xa1, 0xa9,
-	0xe5, 0xf1, 0x78, 0xbf, 0xcf, 0x52, 0x7a, 0x68, 0x68, 0x17, 0xf0, 0x94, 0xe7, 0x73, 0x13, 0x82,
-	0xc0, 0x60, 0x7c, 0x4b, 0x75, 0x54, 0x5a, 0xc7, 0x91, 0xeb, 0x3a, 0xdf, 0x2a, 0xfe, 0x8c, 0xfa,
-	0x53, 0xa0, 0xf8, 0x06, 0x5a, 0x11, 0x67, 0x2d, 0x17, 0x0c, 0xc6, 0x2d, 0x30, 0xa4, 0x26, 0x8a,
-	0x76, 0x46, 0x9f, 0xb2, 0x5c, 0xb8, 0xce, 0x2d, 0x90, 0xea, 0x68, 0x57, 0x70, 0xa6, 0x37, 0x4f,
-	0x11, 0xc7, 0xb9, 0x72, 0x38, 0x71, 0xe5, 0xee, 0xc4, 0x95, 0xdf, 0xef, 0x22, 0x22, 0xae, 0xfd,
-	0x14, 0x6d, 0x09, 0x4f, 0x28, 0x82, 0x01, 0x49, 0xaa, 0xd6, 0x25, 0x71, 0x4d, 0xe2, 0x74, 0xf4,
-	0x08, 0xb7, 0x9c, 0xfe, 0xb5, 0x45, 0xd0, 0xb3, 0x16, 0x49, 0x14, 0xbf, 0x1c, 0xc3, 0xda, 0xe0,
-	0xe0, 0x4a, 0x49, 0xb4, 0x2b, 0xe1, 0x90, 0xa2, 0x17, 0x0c, 0xe9, 0xec, 0x61, 0x87, 0x0c, 0xcd,
-	0x7c, 0x7f, 0x6c, 0xdf, 0x39, 0x96, 0xea, 0xd3, 0xdd, 0x79, 0x1a, 0x50, 0x7f, 0xf9, 0x53, 0xf4,
-	0xb8, 0x4d, 0x3e, 0x3a, 0x56, 0x07, 0x95, 0x64, 0xce, 0xe5, 0xe8, 0xbe, 0x10, 0x29, 0x3d, 0x12,
-	0xda, 0x75, 0x0e, 0xc0, 0x37, 0xc1, 0x02, 0x9f, 0x0a, 0xb0, 0xe2, 0x88, 0x77, 0xbb, 0xce, 0x38,
-	0xb4, 0xf8, 0xfd, 0x18, 0x9e, 0xee, 0x96, 0xb7, 0xce, 0x36, 0xf9, 0x91, 0x0b, 0xad, 0x1c, 0x5f,
-	0x68, 0xb3, 0xb1, 0x32, 0xd7, 0xa2, 0x3d, 0x3b, 0xb4, 0xd2, 0x5e, 0xc7, 0x19, 0xc6, 0x85, 0xbd,
-	0xd9, 0x34, 0x6c, 0x4b, 0x35, 0x58, 0x72, 0x35, 0xf3, 0x68, 0xe7, 0xdc, 0x38, 0xe3, 0xa6, 0xeb,
-	0xe9, 0xe9, 0x30, 0xb6, 0x6e, 0x69, 0x1f, 0xe2, 0x9c, 0x4b, 0xe5, 0x28, 0x33, 0xb9, 0x15, 0x8c,
-	0x40, 0x50, 0x01, 0xaa, 0xe9, 0x4e, 0x55, 0x2f, 0x96, 0x2d, 0x3b, 0x10, 0xbe, 0x7d, 0xb7, 0x2e,
-	0xc0, 0x32, 0x5c, 0x2a, 0xcc, 0x7b, 0x06, 0xb0, 0x9a, 0xcd, 0xa0, 0xbc, 0xd1, 0x4f, 0xb9, 0x29,
-	0x33, 0xf4, 0xd3, 0xee, 0x90, 0x47, 0x9b, 0xc7, 0x19, 0x93, 0xbb, 0x9e, 0x9c, 0x58, 0x4b, 0x6d,
-	0xbb, 0x8c, 0xde, 0x77, 0xc8, 0x32, 0xc1, 0xf7, 0xb9, 0x2f, 0xb7, 0x98, 0x2a, 0x33, 0xb4, 0x96,
-	0xe7, 0x87, 0x67, 0xff, 0x69, 0x8b, 0xa0, 0x9d, 0x0e, 0x49, 0x31, 0xce, 0xa0, 0xfa, 0x4b, 0x1a,
-	0xe7, 0x06, 0x9b, 0x61, 0xc5, 0xb3, 0xb5, 0xdf, 0x11, 0xce, 0xaf, 0xf9, 0x40, 0x05, 0x0c, 0xfd,
-	0x1c, 0xce, 0x8d, 0x68, 0xd1, 0x30, 0x34, 0x17, 0x9f, 0x68, 0x5d, 0xfd, 0x03, 0x8b, 0x9f, 0xa1,
-	0xc3, 0x16, 0x79, 0x53, 0x87, 0x80, 0xd7, 0x7d, 0x13, 0xae, 0x42, 0x03, 0x1c, 0xee, 0x81, 0x1f,
-	0x26, 0x94, 0xe4, 0x8a, 0xe5, 0x6c, 0x83, 0x32, 0x5a, 0x83, 0xd2, 0xf0, 0x8b, 0xef, 0xb5, 0x09,
-	0xda, 0x6f, 0x93, 0xdc, 0x86, 0xcd, 0xe4, 0xbc, 0x05, 0xa5, 0x0d, 0xfa, 0x40, 0x1d, 0xbe, 0xeb,
-	0x90, 0xd3, 0xc3, 0xe0, 0x4f, 0x9e, 0xfc, 0xfd, 0xc5, 0xd8, 0x2b, 0xc5, 0xd9, 0x8a, 0xa9, 0x38,
-	0x57, 0x06, 0xff, 0xe0, 0xcb, 0xe8, 0xa2, 0xf6, 0x35, 0xc2, 0xf9, 0x70, 0xd7, 0x9d, 0xa8, 0xa0,
-	0xdb, 0x2f, 0x5d, 0x4f, 0x8f, 0x62, 0xb8, 0x98, 0x47, 0x50, 0xfc, 0x06, 0xe1, 0xfc, 0x07, 0x9e,
-	0x75, 0x52, 0xcd, 0xef, 0x9c, 0x48, 0xf2, 0x1e, 0xcd, 0xba, 0x62, 0x32, 0x9a, 0xa6, 0x76, 0xf3,
-	0x1e, 0xdf, 0x3a, 0x3e, 0xc9, 0xa3, 0x43, 0xc5, 0x5b, 0x87, 0x2d, 0x72, 0xe9, 0xc5, 0x64, 0x6f,
-	0xd9, 0xb0, 0x35, 0x5a, 0xcd, 0x73, 0xc5, 0x7c, 0x25, 0xb8, 0xc7, 0xb7, 0xfe, 0x4b, 0x72, 0x09,
-	0x69, 0x3f, 0x21, 0x3c, 0xb3, 0x62, 0x59, 0x23, 0x56, 0xe4, 0xf9, 0x23, 0xe9, 0xc8, 0xf0, 0x28,
-	0x49, 0xcd, 0x97, 0x96, 0x74, 0xb7, 0x4d, 0x50, 0x4f, 0xd6, 0xf9, 0xe2, 0xd9, 0x0a, 0xb5, 0xac,
-	0x21, 0xba, 0x72, 0xed, 0x49, 0x5d, 0x7f, 0x40, 0x98, 0xe8, 0xe0, 0xf2, 0x06, 0xfc, 0x2f, 0x9c,
-	0xef, 0x9c, 0x88, 0x73, 0xaf, 0x0d, 0x7c, 0x77, 0x34, 0xdd, 0xd5, 0xf9, 0xdd, 0xbf, 0x0a, 0x89,
-	0xdd, 0xfd, 0x02, 0xda, 0xdb, 0x2f, 0xa0, 0xa7, 0xfb, 0x05, 0xf4, 0xf0, 0xa0, 0x90, 0xd8, 0x3b,
-	0x28, 0x24, 0xfe, 0x38, 0x28, 0x24, 0xee, 0x4e, 0x28, 0x2e, 0x97, 0xfe, 0x0d, 0x00, 0x00, 0xff,
-	0xff, 0x46, 0xf8, 0x81, 0x80, 0x4c, 0x0b, 0x00, 0x00,
+	// 1268 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xce, 0xc4, 0x4e, 0x62, 0x4f, 0x7e, 0xd4, 0x4c, 0x9d, 0x74, 0x92, 0xa6, 0xae, 0xb5, 0x08,
+	0x14, 0x95, 0xc8, 0x0e, 0xa9, 0x40, 0x28, 0xa8, 0x95, 0x92, 0x54, 0x88, 0xa8, 0x4a, 0xa9, 0xb6,
+	0x50, 0xa4, 0x1e, 0xba, 0x9a, 0xec, 0xbe, 0x38, 0xdb, 0xee, 0xce, 0xac, 0x76, 0xd7, 0x4e, 0xdd,
+	0x0b, 0x08, 0x0e, 0x70, 0xac, 0xca, 0x01, 0xd4, 0x13, 0x07, 0x0e, 0x88, 0x3f, 0xa1, 0xde, 0x91,
+	0x10, 0xa7, 0x9c, 0x50, 0xa5, 0x5e, 0x38, 0xa1, 0x92, 0x72, 0x40, 0x39, 0x55, 0xaa, 0xe3, 0x33,
+	0x9a, 0xd9, 0x5d, 0x7b, 0xed, 0x3a, 0x55, 0xd5, 0x70, 0x9b, 0xf7, 0xde, 0xf7, 0xc6, 0xdf, 0x7b,
+	0xf3, 0xbd, 0xb7, 0xc6, 0x05, 0x56, 0x0b, 0x85, 0xe7, 0x8b, 0xba, 0x27, 0x1c, 0xdb, 0x6c, 0x94,
+	0x3d, 0x5f, 0x84, 0x82, 0xe4, 0xc1, 0xaa, 0x82, 0x3a, 0xce, 0xce, 0x55, 0x85, 0xa8, 0x3a, 0x50,
+	0x61, 0x9e, 0x5d, 0x61, 0x9c, 0x8b, 0x90, 0x85, 0xb6, 0xe0, 0x41, 0x04, 0x9c, 0x1d, 0xf3, 0x21,
+	0xa8, 0x39, 0x61, 0x6c, 0x9d, 0x0a, 0x85, 0x70, 0x82, 0x8a, 0x32, 0xaa, 0xc0, 0xdb, 0x87, 0x38,
+	0x5c, 0xa8, 0x8a, 0xaa, 0x50, 0xc7, 0x8a, 0x3c, 0xc5, 0xde, 0x29, 0xc9, 0x20, 0x30, 0x99, 0x03,
+	0x69, 0x0a, 0xb3, 0x93, 0xa6, 0x23, 0x6a, 0x96, 0x03, 0xe1, 0x4d, 0x48, 0x5c, 0x79, 0xe6, 0x79,
+	0xf1, 0xf1, 0xb8, 0xe5, 0x4a, 0x4a, 0x9e, 0x29, 0x5c, 0x57, 0x24, 0xf7, 0x9f, 0x8e, 0xa9, 0x2a,
+	0x6b, 0xb3, 0xb6, 0x55, 0x09, 0x6d, 0x17, 0x82, 0x90, 0xb9, 0x71, 0x96, 0xf6, 0x30, 0x8b, 0x27,
+	0x56, 0x6a, 0xa1, 0xb8, 0xec, 0x8b, 0xfa, 0x65, 0xf5, 0x63, 0x64, 0x1a, 0x0f, 0x6f, 0xd9, 0xe0,
+	0x58, 0x01, 0x45, 0xa5, 0xcc, 0x7c, 0x5e, 0x8f, 0x2d, 0xb2, 0x80, 0x33, 0x37, 0xa1, 0x41, 0x07,
+	0x4b, 0x68, 0x7e, 0x74, 0xa9, 0x50, 0x6e, 0xf7, 0xa3, 0x1c, 0xe5, 0x5d, 0x84, 0xc6, 0x6a, 0x76,
+	0xf7, 0xaf, 0xd3, 0x03, 0xba, 0x84, 0x91, 0x32, 0x3e, 0x6e, 0x81, 0xe7, 0x88, 0x86, 0x61, 0x3a,
+	0x36, 0xf0, 0xd0, 0x30, 0x45, 0x8d, 0x87, 0x34, 0x53, 0x42, 0xf3, 0xe3, 0xfa, 0x64, 0x14, 0x5a,
+	0x53, 0x91, 0x35, 0x19, 0x20, 0x6f, 0xe3, 0xa9, 0x18, 0x6f, 0xf3, 0x10, 0xfc, 0x3a, 0x73, 0xe2,
+	0x8c, 0xac, 0xcc, 0x58, 0xcd, 0x7e, 0xd1, 0xa4, 0x48, 0x8f, 0xaf, 0x5c, 0x8f, 0x11, 0x51, 0xe6,
+	0x12, 0x1e, 0xba, 0x2d, 0x38, 0x04, 0x74, 0xa8, 0x94, 0x99, 0x1f, 0x5d, 0x22, 0x29, 0x66, 0xd7,
+	0x04, 0x07, 0xc9, 0x2b, 0xb7, 0xdf, 0xa2, 0x59, 0x69, 0xe8, 0x11, 0x94, 0x2c, 0xe2, 0x82, 0x6b,
+	0x73, 0x83, 0x99, 0xa1, 0x5d, 0x07, 0xc3, 0xe6, 0x41, 0xc8, 0xb8, 0x09, 0x01, 0x1d, 0x56, 0xf4,
+	0x88, 0x6b, 0xf3, 0x15, 0x15, 0x5a, 0x4f, 0x22, 0xe4, 0x15, 0x3c, 0xee, 0xb2, 0x5b, 0x29, 0xe8,
+	0x88, 0x82, 0x8e, 0xb9, 0xec, 0x56, 0x07, 0xb4, 0x84, 0xa7, 0x6a, 0xbc, 0x5f, 0xd9, 0x39, 0x05,
+	0x3e, 0x5e, 0xe3, 0xcf, 0x16, 0xfe, 0x16, 0x3e, 0x51, 0xe3, 0xfd, 0x4b, 0xcf, 0xab, 0xac, 0xf6,
+	0x95, 0xdd, 0x65, 0xbf, 0x8e, 0x27, 0x2c, 0x70, 0x20, 0x04, 0xc3, 0xf3, 0xc1, 0x63, 0x3e, 0x50,
+	0x5c, 0x42, 0xf3, 0xb9, 0xd5, 0xec, 0x77, 0xb2, 0x53, 0xe3, 0x51, 0xec, 0x72, 0x14, 0x5a, 0x76,
+	0xff, 0x7d, 0x4a, 0xd1, 0x93, 0xa7, 0x14, 0x7d, 0xd2, 0xa4, 0xe8, 0x4e, 0x93, 0xa2, 0xaf, 0x9b,
+	0x14, 0xdd, 0x3d, 0xa0, 0xe3, 0x17, 0xd2, 0xb0, 0x7b, 0x07, 0xf4, 0x55, 0xce, 0x5c, 0x38, 0x77,
+	0x11, 0x1a, 0xe5, 0x4b, 0xcc, 0x85, 0x05, 0xe6, 0x79, 0xc2, 0xaf, 0x2a, 0xfb, 0x7d, 0xbf, 0xca,
+	0xb8, 0x7d, 0x5b, 0x69, 0xfd, 0x7e, 0x8b, 0x1e, 0xbb, 0x09, 0x8d, 0x73, 0x69, 0xdf, 0xef, 0x2d,
+	0x3a, 0x12, 0xf7, 0x5b, 0xfb, 0x09, 0xe1, 0xf1, 0x44, 0x55, 0x11, 0xdb, 0x45, 0x3c, 0xc2, 0x3c,
+	0xcf, 0x90, 0x02, 0x42, 0x4a, 0x40, 0x93, 0xa9, 0x67, 0x5a, 0xf1, 0xbc, 0x8e, 0x7a, 0x86, 0x99,
+	0xb2, 0xc8, 0x59, 0x9c, 0x93, 0x6f, 0x65, 0x74, 0x34, 0xd7, 0xef, 0x65, 0xa3, 0x9c, 0x91, 0xdb,
+	0x91, 0x49, 0x0a, 0x78, 0xa8, 0xa3, 0xb3, 0xac, 0x1e, 0x19, 0xe4, 0x34, 0x1e, 0xf5, 0x7c, 0x61,
+	0x42, 0x10, 0x18, 0x5c, 0xec, 0x28, 0x45, 0xe5, 0x74, 0x1c, 0xbb, 0x2e, 0x89, 0x1d, 0xed, 0x17,
+	0x84, 0x27, 0xba, 0xf8, 0x06, 0x44, 0xc3, 0xe3, 0x96, 0x0b, 0x06, 0x17, 0x16, 0x18, 0xb2, 0x27,
+	0x8a, 0x76, 0x5e, 0x1f, 0xb5, 0x5c, 0xb8, 0x24, 0x2c, 0x90, 0xdd, 0x21, 0xe7, 0x71, 0xbe, 0x3d,
+	0x4f, 0x31, 0xc7, 0xd9, 0x72, 0x34, 0x71, 0xe5, 0x64, 0xe2, 0xca, 0x1f, 0x24, 0x88, 0x98, 0x6b,
+	0x27, 0x85, 0x2c, 0xe2, 0x61, 0x45, 0x30, 0xa0, 0x19, 0x25, 0x5d, 0x9a, 0xee, 0x49, 0x9a, 0x8e,
+	0x1e, 0xe3, 0x96, 0x73, 0xbf, 0x35, 0x29, 0x7a, 0xd2, 0xa4, 0x03, 0xda, 0x57, 0x83, 0x98, 0x74,
+	0x0f, 0xae, 0x6c, 0x09, 0x39, 0x1f, 0x0d, 0x29, 0x7a, 0xce, 0x90, 0x4e, 0xef, 0xb7, 0x68, 0xcf,
+	0xcc, 0x77, 0xc6, 0xf6, 0x9d, 0x17, 0xea, 0xfa, 0x58, 0x32, 0x4f, 0x5d, 0xdd, 0x5f, 0xfe, 0x0c,
+	0xdd, 0x3b, 0xa0, 0x1f, 0xbf, 0x90, 0x82, 0x16, 0x64, 0xce, 0xb9, 0xf8, 0xbe, 0x08, 0x29, 0x3d,
+	0x12, 0x9a, 0x38, 0xbb, 0xe0, 0x5b, 0x60, 0x81, 0xcf, 0x42, 0xb0, 0xd2, 0x88, 0x77, 0x13, 0x67,
+	0x1a, 0xaa, 0x6d, 0xe1, 0xa9, 0xee, 0xea, 0xde, 0xb3, 0x83, 0x50, 0xf8, 0x0d, 0x32, 0x8b, 0x73,
+	0x3e, 0xd4, 0xed, 0xc0, 0x16, 0x5c, 0x35, 0x28, 0xa3, 0xb7, 0x6d, 0xf2, 0x06, 0xce, 0x88, 0xcd,
+	0x1b, 0x71, 0xc9, 0x33, 0x7d, 0xde, 0x21, 0xba, 0x2a, 0xd9, 0x70, 0x62, 0xf3, 0x86, 0xf6, 0xc3,
+	0x20, 0x1e, 0x4b, 0xa2, 0xeb, 0x7c, 0x4b, 0x1c, 0xba, 0x38, 0xcb, 0xe9, 0xc5, 0x39, 0x9d, 0xba,
+	0x7b, 0x2d, 0xde, 0xe7, 0x3d, 0xab, 0xf3, 0x35, 0x9c, 0xe7, 0x22, 0xb4, 0xb7, 0x1a, 0x86, 0x6d,
+	0x29, 0x21, 0x67, 0x56, 0xf3, 0x77, 0xef, 0xcf, 0x0c, 0x71, 0x61, 0xba, 0x9e, 0x9e, 0x8b, 0x62,
+	0xeb, 0x16, 0xf9, 0x08, 0x4f, 0xba, 0x4c, 0xae, 0x0c, 0x2e, 0xb7, 0x8f, 0x11, 0x84, 0x2c, 0x04,
+	0x25, 0xee, 0x89, 0xa5, 0x33, 0x65, 0xcb, 0x0e, 0x42, 0xdf, 0xde, 0xac, 0x85, 0x60, 0x19, 0x2e,
+	0x0b, 0xcd, 0x6d, 0x03, 0x78, 0xd5, 0xe6, 0x50, 0xde, 0xe8, 0xa4, 0x5c, 0x91, 0x19, 0xfa, 0x31,
+	0xb7, 0xc7, 0x43, 0xe6, 0x70, 0xde, 0x14, 0xae, 0x27, 0x37, 0x83, 0xa5, 0xb6, 0x6a, 0x5e, 0xef,
+	0x38, 0x64, 0x99, 0xe0, 0xfb, 0xc2, 0x97, 0xdb, 0x52, 0x95, 0x19, 0x59, 0xcb, 0x73, 0xbd, 0x3b,
+	0xe6, 0x51, 0x93, 0xa2, 0xfb, 0x2d, 0x9a, 0xe5, 0x82, 0xc3, 0xd2, 0x41, 0x1e, 0x4f, 0x76, 0xf7,
+	0x72, 0xc5, 0xb3, 0xc9, 0x1f, 0x08, 0x17, 0xd6, 0x7c, 0x60, 0x21, 0x74, 0xc7, 0xc8, 0xe1, 0x4f,
+	0x30, 0x9b, 0xde, 0x1c, 0xba, 0xfa, 0xd6, 0x6a, 0x9f, 0xa3, 0xfd, 0x26, 0x7d, 0x53, 0x87, 0x40,
+	0xd4, 0x7c, 0x13, 0x2e, 0x40, 0x1d, 0x1c, 0xe1, 0x81, 0x1f, 0x25, 0x2c, 0xc8, 0x55, 0x2e, 0xf8,
+	0x06, 0xe3, 0xac, 0x0a, 0x0b, 0xbd, 0xca, 0x7a, 0x70, 0x40, 0xd1, 0xde, 0x01, 0x9d, 0xdc, 0xb0,
+	0xb9, 0x9c, 0xeb, 0x60, 0x61, 0x83, 0xdd, 0x52, 0x87, 0xef, 0x5b, 0xf4, 0x58, 0x2f, 0xf8, 0xd3,
+	0x87, 0xff, 0x7c, 0x39, 0x78, 0x52, 0x9b, 0xae, 0x98, 0x8a, 0x73, 0xa5, 0xfb, 0x9f, 0xc2, 0x32,
+	0x3a, 0x43, 0xbe, 0x41, 0xb8, 0x10, 0xed, 0xd4, 0x23, 0x15, 0x74, 0xed, 0xa5, 0xeb, 0x69, 0x53,
+	0x8c, 0x3e, 0x00, 0x7d, 0x28, 0x7e, 0x8b, 0x70, 0xe1, 0x43, 0xcf, 0x3a, 0x6a, 0xcf, 0xaf, 0x1f,
+	0xa9, 0xe5, 0x6d, 0x9a, 0x35, 0xc5, 0xa4, 0x3f, 0x4d, 0x72, 0x65, 0x5b, 0xec, 0xbc, 0x38, 0xc9,
+	0xc3, 0x43, 0xda, 0xd5, 0xfd, 0x26, 0x3d, 0xfb, 0x7c, 0xb2, 0x57, 0x6d, 0xd8, 0xe9, 0xdf, 0xcd,
+	0x19, 0xad, 0x50, 0x09, 0xb6, 0xc5, 0xce, 0xb3, 0x24, 0x17, 0x11, 0xf9, 0x15, 0xe1, 0x99, 0x67,
+	0x69, 0x26, 0x2b, 0xe7, 0x39, 0x6c, 0x4b, 0x87, 0x86, 0xe2, 0x64, 0x8d, 0xbd, 0x24, 0xe9, 0xdd,
+	0xa4, 0xbf, 0x25, 0xed, 0x64, 0x3f, 0xe2, 0xdb, 0xd1, 0xfd, 0x11, 0xff, 0x9f, 0x11, 0x9e, 0x5a,
+	0xb1, 0xac, 0x3e, 0x9f, 0x92, 0x53, 0x87, 0x12, 0x94, 0xe1, 0x7e, 0x92, 0x30, 0x5f, 0x5a, 0x12,
+	0x92, 0x72, 0x5b, 0x16, 0x73, 0xda, 0x89, 0x0a, 0xb3, 0xac, 0x1e, 0xd6, 0xf2, 0xf3, 0x20, 0x75,
+	0xf1, 0x23, 0xc2, 0x54, 0x07, 0x57, 0xd4, 0xe1, 0x7f, 0xe1, 0x7c, 0xfd, 0x48, 0x9c, 0xdb, 0x32,
+	0xf6, 0xdd, 0xfe, 0x74, 0x57, 0xe7, 0x76, 0xff, 0x2e, 0x0e, 0xec, 0xee, 0x15, 0xd1, 0x83, 0xbd,
+	0x22, 0x7a, 0xb4, 0x57, 0x44, 0x77, 0x1e, 0x17, 0x07, 0x1e, 0x3c, 0x2e, 0x0e, 0xfc, 0xf9, 0xb8,
+	0x38, 0xb0, 0x39, 0xac, 0xb8, 0x9c, 0xfd, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xed, 0xac, 0x9a, 0xc4,
+	0x74, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -385,6 +432,8 @@ type AutoProvPolicyApiClient interface {
 	UpdateAutoProvPolicy(ctx context.Context, in *AutoProvPolicy, opts ...grpc.CallOption) (*Result, error)
 	// Show Auto Provisioning Policies. Any fields specified will be used to filter results.
 	ShowAutoProvPolicy(ctx context.Context, in *AutoProvPolicy, opts ...grpc.CallOption) (AutoProvPolicyApi_ShowAutoProvPolicyClient, error)
+	// Show the past revisions of an Auto Provisioning Policy, newest first
+	ShowAutoProvPolicyHistory(ctx context.Context, in *AutoProvPolicy, opts ...grpc.CallOption) (AutoProvPolicyApi_ShowAutoProvPolicyHistoryClient, error)
 	// Add a Zone to the Auto Provisioning Policy
 	AddAutoProvPolicyZone(ctx context.Context, in *AutoProvPolicyZone, opts ...grpc.CallOption) (*Result, error)
 	// Remove a Zone from the Auto Provisioning Policy
@@ -458,6 +507,38 @@ func (x *autoProvPolicyApiShowAutoProvPolicyClient) Recv() (*AutoProvPolicy, err
 	return m, nil
 }
 
+func (c *autoProvPolicyApiClient) ShowAutoProvPolicyHistory(ctx context.Context, in *AutoProvPolicy, opts ...grpc.CallOption) (AutoProvPolicyApi_ShowAutoProvPolicyHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AutoProvPolicyApi_serviceDesc.Streams[1], "/edgeproto.AutoProvPolicyApi/ShowAutoProvPolicyHistory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &autoProvPolicyApiShowAutoProvPolicyHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AutoProvPolicyApi_ShowAutoProvPolicyHistoryClient interface {
+	Recv() (*AutoProvPolicyHistory, error)
+	grpc.ClientStream
+}
+
+type autoProvPolicyApiShowAutoProvPolicyHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *autoProvPolicyApiShowAutoProvPolicyHistoryClient) Recv() (*AutoProvPolicyHistory, error) {
+	m := new(AutoProvPolicyHistory)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *autoProvPolicyApiClient) AddAutoProvPolicyZone(ctx context.Context, in *AutoProvPolicyZone, opts ...grpc.CallOption) (*Result, error) {
 	out := new(Result)
 	err := c.cc.Invoke(ctx, "/edgeproto.AutoProvPolicyApi/AddAutoProvPolicyZone", in, out, opts...)
@@ -486,6 +567,8 @@ type AutoProvPolicyApiServer interface {
 	UpdateAutoProvPolicy(context.Context, *AutoProvPolicy) (*Result, error)
 	// Show Auto Provisioning Policies. Any fields specified will be used to filter results.
 	ShowAutoProvPolicy(*AutoProvPolicy, AutoProvPolicyApi_ShowAutoProvPolicyServer) error
+	// Show the past revisions of an Auto Provisioning Policy, newest first
+	ShowAutoProvPolicyHistory(*AutoProvPolicy, AutoProvPolicyApi_ShowAutoProvPolicyHistoryServer) error
 	// Add a Zone to the Auto Provisioning Policy
 	AddAutoProvPolicyZone(context.Context, *AutoProvPolicyZone) (*Result, error)
 	// Remove a Zone from the Auto Provisioning Policy
@@ -508,6 +591,9 @@ func (*UnimplementedAutoProvPolicyApiServer) UpdateAutoProvPolicy(ctx context.Co
 func (*UnimplementedAutoProvPolicyApiServer) ShowAutoProvPolicy(req *AutoProvPolicy, srv AutoProvPolicyApi_ShowAutoProvPolicyServer) error {
 	return status.Errorf(codes.Unimplemented, "method ShowAutoProvPolicy not implemented")
 }
+func (*UnimplementedAutoProvPolicyApiServer) ShowAutoProvPolicyHistory(req *AutoProvPolicy, srv AutoProvPolicyApi_ShowAutoProvPolicyHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method ShowAutoProvPolicyHistory not implemented")
+}
 func (*UnimplementedAutoProvPolicyApiServer) AddAutoProvPolicyZone(ctx context.Context, req *AutoProvPolicyZone) (*Result, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddAutoProvPolicyZone not implemented")
 }
@@ -594,6 +680,27 @@ func (x *autoProvPolicyApiShowAutoProvPolicyServer) Send(m *AutoProvPolicy) erro
 	return x.ServerStream.SendMsg(m)
 }
 
+func _AutoProvPolicyApi_ShowAutoProvPolicyHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AutoProvPolicy)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AutoProvPolicyApiServer).ShowAutoProvPolicyHistory(m, &autoProvPolicyApiShowAutoProvPolicyHistoryServer{stream})
+}
+
+type AutoProvPolicyApi_ShowAutoProvPolicyHistoryServer interface {
+	Send(*AutoProvPolicyHistory) error
+	grpc.ServerStream
+}
+
+type autoProvPolicyApiShowAutoProvPolicyHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *autoProvPolicyApiShowAutoProvPolicyHistoryServer) Send(m *AutoProvPolicyHistory) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _AutoProvPolicyApi_AddAutoProvPolicyZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AutoProvPolicyZone)
 	if err := dec(in); err != nil {
@@ -661,6 +768,11 @@ var _AutoProvPolicyApi_serviceDesc = grpc.ServiceDesc{
 			Handler:       _AutoProvPolicyApi_ShowAutoProvPolicy_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ShowAutoProvPolicyHistory",
+			Handler:       _AutoProvPolicyApi_ShowAutoProvPolicyHistory_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "autoprovpolicy.proto",
 }
@@ -916,6 +1028,44 @@ func (m *AutoProvPolicyZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoProvPolicyHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoProvPolicyHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoProvPolicyHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Obj.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAutoprovpolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Revision != 0 {
+		i = encodeVarintAutoprovpolicy(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AutoProvInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2416,6 +2566,122 @@ func (s *AutoProvPolicyZone) ClearTagged(tags map[string]struct{}) {
 	s.ZoneKey.ClearTagged(tags)
 }
 
+func (m *AutoProvPolicyHistory) Clone() *AutoProvPolicyHistory {
+	cp := &AutoProvPolicyHistory{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *AutoProvPolicyHistory) AddObjZones(vals ...*ZoneKey) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Obj.Zones {
+		cur[v.GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Obj.Zones = append(m.Obj.Zones, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AutoProvPolicyHistory) RemoveObjZones(vals ...*ZoneKey) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Obj.Zones); i >= 0; i-- {
+		if _, found := remove[m.Obj.Zones[i].GetKeyString()]; found {
+			m.Obj.Zones = append(m.Obj.Zones[:i], m.Obj.Zones[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AutoProvPolicyHistory) CopyInFields(src *AutoProvPolicyHistory) int {
+	updateListAction := "replace"
+	changed := 0
+	if m.Revision != src.Revision {
+		m.Revision = src.Revision
+		changed++
+	}
+	if m.Obj.Key.Organization != src.Obj.Key.Organization {
+		m.Obj.Key.Organization = src.Obj.Key.Organization
+		changed++
+	}
+	if m.Obj.Key.Name != src.Obj.Key.Name {
+		m.Obj.Key.Name = src.Obj.Key.Name
+		changed++
+	}
+	if m.Obj.DeployClientCount != src.Obj.DeployClientCount {
+		m.Obj.DeployClientCount = src.Obj.DeployClientCount
+		changed++
+	}
+	if m.Obj.DeployIntervalCount != src.Obj.DeployIntervalCount {
+		m.Obj.DeployIntervalCount = src.Obj.DeployIntervalCount
+		changed++
+	}
+	if src.Obj.Zones != nil {
+		if updateListAction == "add" {
+			changed += m.AddObjZones(src.Obj.Zones...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveObjZones(src.Obj.Zones...)
+		} else {
+			m.Obj.Zones = make([]*ZoneKey, 0)
+			for k1, _ := range src.Obj.Zones {
+				m.Obj.Zones = append(m.Obj.Zones, src.Obj.Zones[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Obj.Zones != nil {
+		m.Obj.Zones = nil
+		changed++
+	}
+	if m.Obj.MinActiveInstances != src.Obj.MinActiveInstances {
+		m.Obj.MinActiveInstances = src.Obj.MinActiveInstances
+		changed++
+	}
+	if m.Obj.MaxInstances != src.Obj.MaxInstances {
+		m.Obj.MaxInstances = src.Obj.MaxInstances
+		changed++
+	}
+	if m.Obj.UndeployClientCount != src.Obj.UndeployClientCount {
+		m.Obj.UndeployClientCount = src.Obj.UndeployClientCount
+		changed++
+	}
+	if m.Obj.UndeployIntervalCount != src.Obj.UndeployIntervalCount {
+		m.Obj.UndeployIntervalCount = src.Obj.UndeployIntervalCount
+		changed++
+	}
+	if m.Obj.DeletePrepare != src.Obj.DeletePrepare {
+		m.Obj.DeletePrepare = src.Obj.DeletePrepare
+		changed++
+	}
+	return changed
+}
+
+func (m *AutoProvPolicyHistory) DeepCopyIn(src *AutoProvPolicyHistory) {
+	m.Revision = src.Revision
+	m.Obj.DeepCopyIn(&src.Obj)
+}
+
+// Helper method to check that enums have valid values
+func (m *AutoProvPolicyHistory) ValidateEnums() error {
+	if err := m.Obj.ValidateEnums(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *AutoProvPolicyHistory) ClearTagged(tags map[string]struct{}) {
+	s.Obj.ClearTagged(tags)
+}
+
 func (m *AutoProvInfo) Matches(o *AutoProvInfo, fopts ...MatchOpt) bool {
 	opts := MatchOptions{}
 	applyMatchOptions(&opts, fopts...)
@@ -3578,6 +3844,20 @@ func (m *AutoProvPolicyZone) Size() (n int) {
 	return n
 }
 
+func (m *AutoProvPolicyHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		n += 1 + sovAutoprovpolicy(uint64(m.Revision))
+	}
+	l = m.Obj.Size()
+	n += 1 + l + sovAutoprovpolicy(uint64(l))
+	return n
+}
+
 func (m *AutoProvInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4322,6 +4602,108 @@ func (m *AutoProvPolicyZone) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AutoProvPolicyHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAutoprovpolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoProvPolicyHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoProvPolicyHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoprovpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Obj", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoprovpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAutoprovpolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoprovpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Obj.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAutoprovpolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAutoprovpolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AutoProvInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
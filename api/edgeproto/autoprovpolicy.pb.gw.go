@@ -160,6 +160,31 @@ func request_AutoProvPolicyApi_ShowAutoProvPolicy_0(ctx context.Context, marshal
 
 }
 
+func request_AutoProvPolicyApi_ShowAutoProvPolicyHistory_0(ctx context.Context, marshaler runtime.Marshaler, client AutoProvPolicyApiClient, req *http.Request, pathParams map[string]string) (AutoProvPolicyApi_ShowAutoProvPolicyHistoryClient, runtime.ServerMetadata, error) {
+	var protoReq AutoProvPolicy
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	stream, err := client.ShowAutoProvPolicyHistory(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+
+}
+
 func request_AutoProvPolicyApi_AddAutoProvPolicyZone_0(ctx context.Context, marshaler runtime.Marshaler, client AutoProvPolicyApiClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq AutoProvPolicyZone
 	var metadata runtime.ServerMetadata
@@ -310,6 +335,13 @@ func RegisterAutoProvPolicyApiHandlerServer(ctx context.Context, mux *runtime.Se
 		return
 	})
 
+	mux.Handle("POST", pattern_AutoProvPolicyApi_ShowAutoProvPolicyHistory_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+
 	mux.Handle("POST", pattern_AutoProvPolicyApi_AddAutoProvPolicyZone_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -477,6 +509,26 @@ func RegisterAutoProvPolicyApiHandlerClient(ctx context.Context, mux *runtime.Se
 
 	})
 
+	mux.Handle("POST", pattern_AutoProvPolicyApi_ShowAutoProvPolicyHistory_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_AutoProvPolicyApi_ShowAutoProvPolicyHistory_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_AutoProvPolicyApi_ShowAutoProvPolicyHistory_0(ctx, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+
+	})
+
 	mux.Handle("POST", pattern_AutoProvPolicyApi_AddAutoProvPolicyZone_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -529,6 +581,8 @@ var (
 
 	pattern_AutoProvPolicyApi_ShowAutoProvPolicy_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"show", "autoprovpolicy"}, "", runtime.AssumeColonVerbOpt(true)))
 
+	pattern_AutoProvPolicyApi_ShowAutoProvPolicyHistory_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"show", "autoprovpolicyhistory"}, "", runtime.AssumeColonVerbOpt(true)))
+
 	pattern_AutoProvPolicyApi_AddAutoProvPolicyZone_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"add", "autoprovpolicyzone"}, "", runtime.AssumeColonVerbOpt(true)))
 
 	pattern_AutoProvPolicyApi_RemoveAutoProvPolicyZone_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"rm", "autoprovpolicyzone"}, "", runtime.AssumeColonVerbOpt(true)))
@@ -543,6 +597,8 @@ var (
 
 	forward_AutoProvPolicyApi_ShowAutoProvPolicy_0 = runtime.ForwardResponseStream
 
+	forward_AutoProvPolicyApi_ShowAutoProvPolicyHistory_0 = runtime.ForwardResponseStream
+
 	forward_AutoProvPolicyApi_AddAutoProvPolicyZone_0 = runtime.ForwardResponseMessage
 
 	forward_AutoProvPolicyApi_RemoveAutoProvPolicyZone_0 = runtime.ForwardResponseMessage
@@ -138,64 +138,111 @@ func (m *AutoScalePolicy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AutoScalePolicy proto.InternalMessageInfo
 
+// AutoScalePolicyHistory is an Auto Scale Policy as of a past revision
+type AutoScalePolicyHistory struct {
+	// Database revision of the change
+	Revision int64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// AutoScalePolicy as of the revision
+	Obj AutoScalePolicy `protobuf:"bytes,2,opt,name=obj,proto3" json:"obj"`
+}
+
+func (m *AutoScalePolicyHistory) Reset()         { *m = AutoScalePolicyHistory{} }
+func (m *AutoScalePolicyHistory) String() string { return proto.CompactTextString(m) }
+func (*AutoScalePolicyHistory) ProtoMessage()    {}
+func (*AutoScalePolicyHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b83abf40cad3a321, []int{2}
+}
+func (m *AutoScalePolicyHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoScalePolicyHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoScalePolicyHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoScalePolicyHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoScalePolicyHistory.Merge(m, src)
+}
+func (m *AutoScalePolicyHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoScalePolicyHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoScalePolicyHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoScalePolicyHistory proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*PolicyKey)(nil), "edgeproto.PolicyKey")
 	proto.RegisterType((*AutoScalePolicy)(nil), "edgeproto.AutoScalePolicy")
+	proto.RegisterType((*AutoScalePolicyHistory)(nil), "edgeproto.AutoScalePolicyHistory")
 }
 
 func init() { proto.RegisterFile("autoscalepolicy.proto", fileDescriptor_b83abf40cad3a321) }
 
 var fileDescriptor_b83abf40cad3a321 = []byte{
-	// 783 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xb1, 0x6f, 0xeb, 0x44,
-	0x1c, 0xc7, 0x73, 0xaf, 0x79, 0xa1, 0xbe, 0x97, 0x3c, 0xf2, 0xfc, 0x5e, 0xda, 0x23, 0xb4, 0x6e,
-	0x94, 0x29, 0x40, 0x48, 0x68, 0x2b, 0x24, 0x14, 0xa9, 0x43, 0x92, 0x6e, 0x55, 0x4a, 0xe5, 0xb6,
-	0x74, 0x60, 0xb0, 0xae, 0xce, 0xaf, 0x8e, 0x55, 0xdb, 0x67, 0xd9, 0xe7, 0xa6, 0x61, 0x42, 0x88,
-	0x81, 0xb1, 0x82, 0x05, 0x31, 0x21, 0xb1, 0x54, 0x4c, 0x88, 0xb1, 0x7f, 0x41, 0x17, 0xa4, 0x4a,
-	0x2c, 0x48, 0x48, 0x08, 0x52, 0x06, 0x54, 0x31, 0x20, 0x35, 0x8d, 0x18, 0x91, 0xcf, 0x56, 0xea,
-	0x46, 0xa5, 0x12, 0xb0, 0xb0, 0xdd, 0xfd, 0x3e, 0xdf, 0x5f, 0xee, 0x7b, 0xbf, 0xfb, 0xc6, 0xb8,
-	0x40, 0x03, 0xce, 0x7c, 0x9d, 0x5a, 0xe0, 0x32, 0xcb, 0xd4, 0x07, 0x35, 0xd7, 0x63, 0x9c, 0xc9,
-	0x12, 0x74, 0x0d, 0x10, 0xcb, 0xe2, 0x82, 0xc1, 0x98, 0x61, 0x41, 0x9d, 0xba, 0x66, 0x9d, 0x3a,
-	0x0e, 0xe3, 0x94, 0x9b, 0xcc, 0xf1, 0x23, 0x61, 0x31, 0xeb, 0x81, 0x1f, 0x58, 0x3c, 0xde, 0x2d,
-	0x72, 0xc6, 0x2c, 0xbf, 0x2e, 0x36, 0x06, 0x38, 0x93, 0x45, 0x8c, 0x5f, 0x18, 0xcc, 0x60, 0x62,
-	0x59, 0x0f, 0x57, 0x51, 0xb5, 0x6c, 0x61, 0x69, 0x4b, 0x9c, 0xbd, 0x01, 0x03, 0x79, 0x19, 0x67,
-	0x99, 0x67, 0x50, 0xc7, 0xfc, 0x40, 0x1c, 0x43, 0x50, 0x09, 0x55, 0xa4, 0x56, 0xee, 0x6c, 0x4c,
-	0xa4, 0xc8, 0x20, 0xf3, 0x0c, 0xf5, 0x8e, 0x44, 0x56, 0x70, 0xda, 0xa1, 0x36, 0x90, 0x47, 0x42,
-	0x8a, 0xcf, 0xc6, 0x24, 0x13, 0x49, 0x55, 0x51, 0x6f, 0x64, 0x7f, 0xbb, 0x26, 0xe8, 0xcf, 0x6b,
-	0x82, 0xbe, 0xf9, 0x72, 0x09, 0x95, 0x7f, 0x4f, 0xe3, 0x97, 0x9b, 0x01, 0x67, 0xdb, 0xe1, 0x9d,
-	0xa3, 0x73, 0xe5, 0x39, 0x9c, 0x39, 0x30, 0xc1, 0xea, 0xfa, 0x04, 0x95, 0x66, 0x2a, 0x92, 0x1a,
-	0xef, 0xe4, 0x2a, 0x9e, 0x39, 0x84, 0x81, 0xf8, 0xe1, 0x27, 0x2b, 0x2f, 0x6a, 0x93, 0x99, 0xd4,
-	0x26, 0x7e, 0x5b, 0xe9, 0xf3, 0x9f, 0x96, 0x52, 0x6a, 0x28, 0x93, 0x5f, 0xc5, 0x92, 0x6d, 0x3a,
-	0x9a, 0xc3, 0xba, 0xe0, 0x93, 0x99, 0x12, 0xaa, 0xe4, 0xd4, 0x59, 0xdb, 0x74, 0x36, 0xc3, 0xbd,
-	0x80, 0xf4, 0x38, 0x86, 0xe9, 0x18, 0xd2, 0xe3, 0x08, 0xbe, 0x89, 0x9f, 0x8b, 0x27, 0xd0, 0x02,
-	0x57, 0xd3, 0xdd, 0x40, 0xe3, 0x3d, 0x0f, 0xfc, 0x1e, 0x79, 0x2c, 0x64, 0x79, 0x81, 0x76, 0xdd,
-	0xb6, 0x1b, 0xec, 0x88, 0xba, 0xbc, 0x8c, 0x0b, 0x91, 0xbc, 0xcb, 0xfa, 0x4e, 0xb2, 0x21, 0x23,
-	0x1a, 0x64, 0x01, 0xd7, 0x59, 0xdf, 0xb9, 0x6d, 0xa9, 0xe1, 0x3c, 0xf7, 0x4c, 0xc3, 0x00, 0x4f,
-	0xe3, 0xa6, 0x0d, 0x9a, 0x0f, 0x3a, 0x79, 0x29, 0x54, 0xb7, 0xd2, 0x9f, 0x8c, 0x08, 0x52, 0x9f,
-	0xc6, 0x74, 0xc7, 0xb4, 0x61, 0x1b, 0x74, 0xf9, 0x1d, 0x4c, 0x7c, 0x4e, 0xf7, 0x4d, 0x2b, 0x1e,
-	0xb2, 0xd6, 0x37, 0x9d, 0x2e, 0xeb, 0x8b, 0xbe, 0x59, 0x71, 0xca, 0xdc, 0x1d, 0xbe, 0x27, 0x70,
-	0xd8, 0xb9, 0x88, 0x31, 0xa7, 0x9e, 0x01, 0x3c, 0x34, 0x46, 0x24, 0xa1, 0x95, 0xa2, 0x4a, 0xdb,
-	0x0d, 0x12, 0xd8, 0x06, 0x9b, 0xe0, 0x24, 0xee, 0x80, 0x2d, 0x37, 0xf0, 0x2b, 0x31, 0xa6, 0x3a,
-	0x37, 0x8f, 0x40, 0xd3, 0x99, 0xe3, 0x80, 0x2e, 0x12, 0x47, 0x9e, 0x94, 0x50, 0x25, 0xad, 0xce,
-	0x47, 0x82, 0xa6, 0xe0, 0xed, 0x5b, 0x2c, 0xbf, 0x81, 0x9f, 0x76, 0xc1, 0x02, 0x0e, 0x9a, 0xeb,
-	0x81, 0x4b, 0x3d, 0x20, 0xd9, 0x12, 0xaa, 0xcc, 0xb6, 0xd2, 0xa7, 0xe1, 0x0d, 0x73, 0x11, 0xdb,
-	0x8a, 0x50, 0xe3, 0x20, 0x0c, 0xc5, 0x1f, 0xd7, 0x04, 0x7d, 0x38, 0x22, 0xe8, 0x64, 0x44, 0xd0,
-	0xe7, 0x23, 0x82, 0x3e, 0xbd, 0x21, 0xb9, 0xf5, 0xa4, 0xec, 0x8b, 0x1b, 0xf2, 0x5a, 0x98, 0xa1,
-	0xb5, 0x0d, 0x18, 0xd4, 0x36, 0xa9, 0x0d, 0x55, 0xdd, 0x0a, 0x7c, 0x0e, 0x1e, 0xf3, 0x0c, 0x51,
-	0x7b, 0x37, 0x11, 0xc4, 0x6f, 0xc7, 0x24, 0x7f, 0x08, 0x83, 0xb5, 0x64, 0x6d, 0xe5, 0xc7, 0xc7,
-	0x58, 0x9e, 0x8a, 0x5b, 0xd3, 0x35, 0xe5, 0xef, 0x10, 0x2e, 0xb4, 0x3d, 0xa0, 0x1c, 0xa6, 0xb3,
-	0x58, 0x4c, 0xc4, 0x6c, 0x8a, 0x15, 0x9f, 0x25, 0x98, 0x2a, 0xfe, 0x77, 0xe5, 0x8f, 0xd1, 0xd5,
-	0x88, 0xbc, 0xad, 0x82, 0xcf, 0x02, 0x4f, 0x87, 0x75, 0x38, 0x02, 0x8b, 0xb9, 0xe0, 0x45, 0x0d,
-	0xd5, 0xa6, 0x18, 0x4d, 0x87, 0x3a, 0xd4, 0x80, 0xea, 0xb4, 0xdf, 0xe1, 0x0d, 0x79, 0xd6, 0x89,
-	0xf3, 0x59, 0xed, 0xc4, 0x59, 0xfc, 0x7a, 0x4c, 0xf2, 0xd3, 0xc2, 0x8f, 0xbe, 0xff, 0xf5, 0xb3,
-	0x47, 0x0b, 0xe5, 0xf9, 0xba, 0x2e, 0x1c, 0xd7, 0xa7, 0x3e, 0x19, 0x0d, 0xf4, 0xba, 0xfc, 0x15,
-	0xc2, 0x85, 0x68, 0x72, 0xff, 0xf1, 0x3e, 0xef, 0xff, 0xeb, 0xeb, 0x4c, 0x5c, 0x46, 0x0f, 0xfd,
-	0x77, 0x2e, 0x77, 0xdd, 0x2e, 0xfd, 0x3f, 0xb8, 0x0c, 0x84, 0x8f, 0xfb, 0x5c, 0x9e, 0x22, 0xfc,
-	0x7c, 0xbb, 0xc7, 0xfa, 0xff, 0xc4, 0xe3, 0x03, 0xac, 0xbc, 0x77, 0x35, 0x22, 0xab, 0x0f, 0x9b,
-	0x7d, 0xcf, 0x84, 0xfe, 0xfd, 0x56, 0x8b, 0xe5, 0x42, 0xdd, 0xef, 0xb1, 0xfe, 0x3d, 0x46, 0xdf,
-	0x42, 0xad, 0x85, 0xf3, 0x5f, 0x94, 0xd4, 0xf9, 0x50, 0x41, 0x17, 0x43, 0x05, 0xfd, 0x3c, 0x54,
-	0xd0, 0xc9, 0xa5, 0x92, 0xba, 0xb8, 0x54, 0x52, 0x3f, 0x5c, 0x2a, 0xa9, 0xfd, 0x8c, 0xf0, 0xb3,
-	0xfa, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf9, 0x28, 0x27, 0x28, 0x64, 0x06, 0x00, 0x00,
+	// 862 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0x31, 0x6c, 0x1c, 0x45,
+	0x14, 0x86, 0x6f, 0x72, 0x87, 0xe3, 0x9d, 0xd8, 0xc1, 0x99, 0xe4, 0x9c, 0xe1, 0x70, 0x36, 0x97,
+	0xad, 0x0e, 0x38, 0xee, 0x88, 0x23, 0x24, 0x64, 0x29, 0x85, 0xcf, 0x2e, 0x90, 0x22, 0x87, 0x68,
+	0x9d, 0x90, 0x82, 0x62, 0x35, 0xb7, 0xf7, 0xb2, 0x37, 0xc9, 0xee, 0xce, 0x6a, 0x76, 0xd6, 0x97,
+	0xa3, 0x42, 0x88, 0x82, 0x32, 0x82, 0x06, 0xd1, 0x80, 0x44, 0x13, 0x51, 0x21, 0xca, 0x74, 0x74,
+	0x6e, 0x90, 0x22, 0xd1, 0x50, 0x21, 0xb0, 0x29, 0x50, 0x44, 0x81, 0xe4, 0xb3, 0x45, 0x89, 0x76,
+	0x76, 0x73, 0xd9, 0x9c, 0x2e, 0x27, 0x61, 0x1a, 0xba, 0x99, 0xf7, 0xfd, 0x6f, 0xe7, 0x9f, 0x99,
+	0x7f, 0x16, 0x57, 0x59, 0xa2, 0x44, 0xec, 0x32, 0x1f, 0x22, 0xe1, 0x73, 0x77, 0xd8, 0x8a, 0xa4,
+	0x50, 0x82, 0x18, 0xd0, 0xf3, 0x40, 0x0f, 0x6b, 0x2b, 0x9e, 0x10, 0x9e, 0x0f, 0x6d, 0x16, 0xf1,
+	0x36, 0x0b, 0x43, 0xa1, 0x98, 0xe2, 0x22, 0x8c, 0x33, 0x61, 0x6d, 0x41, 0x42, 0x9c, 0xf8, 0x2a,
+	0x9f, 0x5d, 0x50, 0x42, 0xf8, 0x71, 0x5b, 0x4f, 0x3c, 0x08, 0xc7, 0x83, 0x1c, 0x9f, 0xf3, 0x84,
+	0x27, 0xf4, 0xb0, 0x9d, 0x8e, 0xb2, 0xaa, 0xe5, 0x63, 0xe3, 0x86, 0x5e, 0xfb, 0x1a, 0x0c, 0xc9,
+	0x65, 0xbc, 0x20, 0xa4, 0xc7, 0x42, 0xfe, 0xa1, 0x5e, 0x86, 0xa2, 0x3a, 0x6a, 0x18, 0x9d, 0xc5,
+	0x47, 0x47, 0xd4, 0xc8, 0x0c, 0x0a, 0xe9, 0xd9, 0xcf, 0x49, 0x88, 0x89, 0x2b, 0x21, 0x0b, 0x80,
+	0x9e, 0xd0, 0x52, 0xfc, 0xe8, 0x88, 0xce, 0x65, 0x52, 0x5b, 0xd7, 0xd7, 0x16, 0xfe, 0x38, 0xa0,
+	0xe8, 0xef, 0x03, 0x8a, 0xbe, 0xfb, 0xfa, 0x22, 0xb2, 0xfe, 0xac, 0xe0, 0x97, 0xd7, 0x13, 0x25,
+	0xb6, 0xd3, 0x3d, 0x67, 0xeb, 0x92, 0x65, 0x3c, 0x77, 0x87, 0x83, 0xdf, 0x8b, 0x29, 0xaa, 0x97,
+	0x1b, 0x86, 0x9d, 0xcf, 0x48, 0x13, 0x97, 0xef, 0xc1, 0x50, 0x7f, 0xf8, 0xd4, 0xea, 0xb9, 0xd6,
+	0xf8, 0x4c, 0x5a, 0x63, 0xbf, 0x9d, 0xca, 0xee, 0x2f, 0x17, 0x4b, 0x76, 0x2a, 0x23, 0xaf, 0x62,
+	0x23, 0xe0, 0xa1, 0x13, 0x8a, 0x1e, 0xc4, 0xb4, 0x5c, 0x47, 0x8d, 0x45, 0x7b, 0x3e, 0xe0, 0xe1,
+	0xf5, 0x74, 0xae, 0x21, 0xbb, 0x9f, 0xc3, 0x4a, 0x0e, 0xd9, 0xfd, 0x0c, 0xbe, 0x89, 0xcf, 0xea,
+	0x2b, 0x70, 0x92, 0xc8, 0x71, 0xa3, 0xc4, 0x51, 0x7d, 0x09, 0x71, 0x9f, 0xbe, 0xa4, 0x65, 0x4b,
+	0x1a, 0xdd, 0x8a, 0x36, 0xa2, 0xe4, 0xa6, 0xae, 0x93, 0xcb, 0xb8, 0x9a, 0xc9, 0x7b, 0x62, 0x10,
+	0x16, 0x1b, 0xe6, 0x74, 0x03, 0xd1, 0x70, 0x53, 0x0c, 0xc2, 0x67, 0x2d, 0x2d, 0xbc, 0xa4, 0x24,
+	0xf7, 0x3c, 0x90, 0x8e, 0xe2, 0x01, 0x38, 0x31, 0xb8, 0xf4, 0x64, 0xaa, 0xee, 0x54, 0x3e, 0x1d,
+	0x51, 0x64, 0x9f, 0xce, 0xe9, 0x4d, 0x1e, 0xc0, 0x36, 0xb8, 0xe4, 0x1d, 0x4c, 0x63, 0xc5, 0xba,
+	0xdc, 0xcf, 0x0f, 0xd9, 0x19, 0xf0, 0xb0, 0x27, 0x06, 0xba, 0x6f, 0x5e, 0xaf, 0xb2, 0xfc, 0x1c,
+	0xbf, 0xad, 0x71, 0xda, 0x79, 0x01, 0x63, 0xc5, 0xa4, 0x07, 0x2a, 0x35, 0x46, 0x0d, 0xad, 0x35,
+	0xb2, 0xca, 0x46, 0x94, 0x14, 0x70, 0x00, 0x01, 0xc5, 0x45, 0xbc, 0x05, 0x01, 0x59, 0xc3, 0xaf,
+	0xe4, 0x98, 0xb9, 0x8a, 0xef, 0x80, 0xe3, 0x8a, 0x30, 0x04, 0x57, 0x27, 0x8e, 0x9e, 0xaa, 0xa3,
+	0x46, 0xc5, 0x3e, 0x9f, 0x09, 0xd6, 0x35, 0xdf, 0x78, 0x86, 0xc9, 0x1b, 0xf8, 0x74, 0x0f, 0x7c,
+	0x50, 0xe0, 0x44, 0x12, 0x22, 0x26, 0x81, 0x2e, 0xd4, 0x51, 0x63, 0xbe, 0x53, 0x79, 0x98, 0xee,
+	0x70, 0x31, 0x63, 0x37, 0x32, 0xb4, 0x76, 0x27, 0x0d, 0xc5, 0x5f, 0x07, 0x14, 0x7d, 0x34, 0xa2,
+	0xe8, 0xc1, 0x88, 0xa2, 0x2f, 0x46, 0x14, 0x7d, 0x76, 0x48, 0x17, 0x37, 0x8b, 0xb2, 0x2f, 0x0f,
+	0xe9, 0x6b, 0x69, 0x86, 0xae, 0x5e, 0x83, 0x61, 0xeb, 0x3a, 0x0b, 0xa0, 0xe9, 0xfa, 0x49, 0xac,
+	0x40, 0x0a, 0xe9, 0xe9, 0xda, 0x7b, 0x85, 0x20, 0x7e, 0x7f, 0x44, 0x97, 0xee, 0xc1, 0xf0, 0x6a,
+	0xb1, 0x66, 0xf5, 0xf1, 0xf2, 0x44, 0xda, 0xde, 0xe5, 0xb1, 0x12, 0x72, 0x48, 0x6a, 0x78, 0x5e,
+	0xc2, 0x0e, 0x8f, 0x9f, 0xa6, 0xbc, 0x6c, 0x8f, 0xe7, 0x64, 0x15, 0x97, 0x45, 0xf7, 0x6e, 0x1e,
+	0xbc, 0x5a, 0x21, 0x78, 0x13, 0xdf, 0x7a, 0x1a, 0x3f, 0xd1, 0xbd, 0xbb, 0xfa, 0xd5, 0x49, 0x4c,
+	0x26, 0xf0, 0x7a, 0xc4, 0xc9, 0x8f, 0x08, 0x57, 0x37, 0x24, 0x30, 0x05, 0x93, 0xa9, 0x9f, 0xf1,
+	0xdd, 0xda, 0x99, 0x02, 0xb3, 0xf5, 0x0b, 0xb7, 0x3e, 0x41, 0x4f, 0x46, 0xf4, 0x6d, 0x1b, 0x62,
+	0x91, 0x48, 0x17, 0x36, 0x61, 0x07, 0x7c, 0x11, 0x81, 0xcc, 0x1a, 0x9a, 0xeb, 0xfa, 0x12, 0xb6,
+	0x58, 0xc8, 0x3c, 0x68, 0x4e, 0x9e, 0xcc, 0xde, 0x21, 0x3d, 0xb3, 0x95, 0xbf, 0x84, 0xe6, 0x56,
+	0x9e, 0xfa, 0x6f, 0x8f, 0xe8, 0xd2, 0xa4, 0xf0, 0xe3, 0x9f, 0x7e, 0xff, 0xfc, 0xc4, 0x8a, 0x75,
+	0xbe, 0xed, 0x6a, 0xc7, 0xed, 0x89, 0x9f, 0xd3, 0x1a, 0x7a, 0x9d, 0x7c, 0x83, 0x70, 0x35, 0xbb,
+	0xa3, 0xff, 0xb8, 0x9f, 0x0f, 0x8e, 0xbd, 0x9d, 0xb1, 0xcb, 0x2c, 0x52, 0x2f, 0x72, 0x79, 0x2b,
+	0xea, 0xb1, 0xff, 0x83, 0xcb, 0x44, 0xfb, 0x98, 0xe6, 0xf2, 0x21, 0xc2, 0x67, 0xb7, 0xfb, 0x62,
+	0xf0, 0x6f, 0x3c, 0xce, 0x60, 0xd6, 0xed, 0x27, 0x23, 0x7a, 0x65, 0xb6, 0xd9, 0xf7, 0x39, 0x0c,
+	0xa6, 0x5b, 0xad, 0x59, 0xd5, 0x76, 0xdc, 0x17, 0x83, 0x29, 0x46, 0xdf, 0x42, 0xe4, 0x07, 0x84,
+	0x6b, 0x53, 0xac, 0x8e, 0x1f, 0xd3, 0x0c, 0xc7, 0x97, 0x5e, 0xcc, 0xf2, 0x76, 0xab, 0x7b, 0x4c,
+	0xe3, 0xbb, 0x87, 0x14, 0x69, 0xf3, 0x97, 0xac, 0x95, 0xa9, 0xe6, 0xfb, 0xd9, 0x02, 0x7a, 0x0f,
+	0x9d, 0x95, 0xdd, 0xdf, 0xcc, 0xd2, 0xee, 0x9e, 0x89, 0x1e, 0xef, 0x99, 0xe8, 0xd7, 0x3d, 0x13,
+	0x3d, 0xd8, 0x37, 0x4b, 0x8f, 0xf7, 0xcd, 0xd2, 0xcf, 0xfb, 0x66, 0xa9, 0x3b, 0xa7, 0x1d, 0x5e,
+	0xf9, 0x27, 0x00, 0x00, 0xff, 0xff, 0x9d, 0xda, 0xbb, 0xc7, 0x92, 0x07, 0x00, 0x00,
 }
 
 func (this *PolicyKey) GoString() string {
@@ -238,6 +285,8 @@ type AutoScalePolicyApiClient interface {
 	UpdateAutoScalePolicy(ctx context.Context, in *AutoScalePolicy, opts ...grpc.CallOption) (*Result, error)
 	// Show Auto Scale Policies. Any fields specified will be used to filter results.
 	ShowAutoScalePolicy(ctx context.Context, in *AutoScalePolicy, opts ...grpc.CallOption) (AutoScalePolicyApi_ShowAutoScalePolicyClient, error)
+	// Show the past revisions of an Auto Scale Policy, newest first
+	ShowAutoScalePolicyHistory(ctx context.Context, in *AutoScalePolicy, opts ...grpc.CallOption) (AutoScalePolicyApi_ShowAutoScalePolicyHistoryClient, error)
 }
 
 type autoScalePolicyApiClient struct {
@@ -307,6 +356,38 @@ func (x *autoScalePolicyApiShowAutoScalePolicyClient) Recv() (*AutoScalePolicy,
 	return m, nil
 }
 
+func (c *autoScalePolicyApiClient) ShowAutoScalePolicyHistory(ctx context.Context, in *AutoScalePolicy, opts ...grpc.CallOption) (AutoScalePolicyApi_ShowAutoScalePolicyHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AutoScalePolicyApi_serviceDesc.Streams[1], "/edgeproto.AutoScalePolicyApi/ShowAutoScalePolicyHistory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &autoScalePolicyApiShowAutoScalePolicyHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AutoScalePolicyApi_ShowAutoScalePolicyHistoryClient interface {
+	Recv() (*AutoScalePolicyHistory, error)
+	grpc.ClientStream
+}
+
+type autoScalePolicyApiShowAutoScalePolicyHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *autoScalePolicyApiShowAutoScalePolicyHistoryClient) Recv() (*AutoScalePolicyHistory, error) {
+	m := new(AutoScalePolicyHistory)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // AutoScalePolicyApiServer is the server API for AutoScalePolicyApi service.
 type AutoScalePolicyApiServer interface {
 	// Create an Auto Scale Policy
@@ -317,6 +398,8 @@ type AutoScalePolicyApiServer interface {
 	UpdateAutoScalePolicy(context.Context, *AutoScalePolicy) (*Result, error)
 	// Show Auto Scale Policies. Any fields specified will be used to filter results.
 	ShowAutoScalePolicy(*AutoScalePolicy, AutoScalePolicyApi_ShowAutoScalePolicyServer) error
+	// Show the past revisions of an Auto Scale Policy, newest first
+	ShowAutoScalePolicyHistory(*AutoScalePolicy, AutoScalePolicyApi_ShowAutoScalePolicyHistoryServer) error
 }
 
 // UnimplementedAutoScalePolicyApiServer can be embedded to have forward compatible implementations.
@@ -335,6 +418,9 @@ func (*UnimplementedAutoScalePolicyApiServer) UpdateAutoScalePolicy(ctx context.
 func (*UnimplementedAutoScalePolicyApiServer) ShowAutoScalePolicy(req *AutoScalePolicy, srv AutoScalePolicyApi_ShowAutoScalePolicyServer) error {
 	return status.Errorf(codes.Unimplemented, "method ShowAutoScalePolicy not implemented")
 }
+func (*UnimplementedAutoScalePolicyApiServer) ShowAutoScalePolicyHistory(req *AutoScalePolicy, srv AutoScalePolicyApi_ShowAutoScalePolicyHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method ShowAutoScalePolicyHistory not implemented")
+}
 
 func RegisterAutoScalePolicyApiServer(s *grpc.Server, srv AutoScalePolicyApiServer) {
 	s.RegisterService(&_AutoScalePolicyApi_serviceDesc, srv)
@@ -415,6 +501,27 @@ func (x *autoScalePolicyApiShowAutoScalePolicyServer) Send(m *AutoScalePolicy) e
 	return x.ServerStream.SendMsg(m)
 }
 
+func _AutoScalePolicyApi_ShowAutoScalePolicyHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AutoScalePolicy)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AutoScalePolicyApiServer).ShowAutoScalePolicyHistory(m, &autoScalePolicyApiShowAutoScalePolicyHistoryServer{stream})
+}
+
+type AutoScalePolicyApi_ShowAutoScalePolicyHistoryServer interface {
+	Send(*AutoScalePolicyHistory) error
+	grpc.ServerStream
+}
+
+type autoScalePolicyApiShowAutoScalePolicyHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *autoScalePolicyApiShowAutoScalePolicyHistoryServer) Send(m *AutoScalePolicyHistory) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _AutoScalePolicyApi_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "edgeproto.AutoScalePolicyApi",
 	HandlerType: (*AutoScalePolicyApiServer)(nil),
@@ -438,6 +545,11 @@ var _AutoScalePolicyApi_serviceDesc = grpc.ServiceDesc{
 			Handler:       _AutoScalePolicyApi_ShowAutoScalePolicy_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ShowAutoScalePolicyHistory",
+			Handler:       _AutoScalePolicyApi_ShowAutoScalePolicyHistory_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "autoscalepolicy.proto",
 }
@@ -576,6 +688,44 @@ func (m *AutoScalePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoScalePolicyHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoScalePolicyHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoScalePolicyHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Obj.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAutoscalepolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Revision != 0 {
+		i = encodeVarintAutoscalepolicy(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAutoscalepolicy(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAutoscalepolicy(v)
 	base := offset
@@ -1675,6 +1825,86 @@ func (s *AutoScalePolicy) ClearTagged(tags map[string]struct{}) {
 	s.Key.ClearTagged(tags)
 }
 
+func (m *AutoScalePolicyHistory) Clone() *AutoScalePolicyHistory {
+	cp := &AutoScalePolicyHistory{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *AutoScalePolicyHistory) CopyInFields(src *AutoScalePolicyHistory) int {
+	changed := 0
+	if m.Revision != src.Revision {
+		m.Revision = src.Revision
+		changed++
+	}
+	if m.Obj.Key.Organization != src.Obj.Key.Organization {
+		m.Obj.Key.Organization = src.Obj.Key.Organization
+		changed++
+	}
+	if m.Obj.Key.Name != src.Obj.Key.Name {
+		m.Obj.Key.Name = src.Obj.Key.Name
+		changed++
+	}
+	if m.Obj.MinNodes != src.Obj.MinNodes {
+		m.Obj.MinNodes = src.Obj.MinNodes
+		changed++
+	}
+	if m.Obj.MaxNodes != src.Obj.MaxNodes {
+		m.Obj.MaxNodes = src.Obj.MaxNodes
+		changed++
+	}
+	if m.Obj.ScaleUpCpuThresh != src.Obj.ScaleUpCpuThresh {
+		m.Obj.ScaleUpCpuThresh = src.Obj.ScaleUpCpuThresh
+		changed++
+	}
+	if m.Obj.ScaleDownCpuThresh != src.Obj.ScaleDownCpuThresh {
+		m.Obj.ScaleDownCpuThresh = src.Obj.ScaleDownCpuThresh
+		changed++
+	}
+	if m.Obj.TriggerTimeSec != src.Obj.TriggerTimeSec {
+		m.Obj.TriggerTimeSec = src.Obj.TriggerTimeSec
+		changed++
+	}
+	if m.Obj.StabilizationWindowSec != src.Obj.StabilizationWindowSec {
+		m.Obj.StabilizationWindowSec = src.Obj.StabilizationWindowSec
+		changed++
+	}
+	if m.Obj.TargetCpu != src.Obj.TargetCpu {
+		m.Obj.TargetCpu = src.Obj.TargetCpu
+		changed++
+	}
+	if m.Obj.TargetMem != src.Obj.TargetMem {
+		m.Obj.TargetMem = src.Obj.TargetMem
+		changed++
+	}
+	if m.Obj.TargetActiveConnections != src.Obj.TargetActiveConnections {
+		m.Obj.TargetActiveConnections = src.Obj.TargetActiveConnections
+		changed++
+	}
+	if m.Obj.DeletePrepare != src.Obj.DeletePrepare {
+		m.Obj.DeletePrepare = src.Obj.DeletePrepare
+		changed++
+	}
+	return changed
+}
+
+func (m *AutoScalePolicyHistory) DeepCopyIn(src *AutoScalePolicyHistory) {
+	m.Revision = src.Revision
+	m.Obj.DeepCopyIn(&src.Obj)
+}
+
+// Helper method to check that enums have valid values
+func (m *AutoScalePolicyHistory) ValidateEnums() error {
+	if err := m.Obj.ValidateEnums(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *AutoScalePolicyHistory) ClearTagged(tags map[string]struct{}) {
+	s.Obj.ClearTagged(tags)
+}
+
 func (m *AutoScalePolicy) IsValidArgsForCreateAutoScalePolicy() error {
 	if m.DeletePrepare != false {
 		return fmt.Errorf("Invalid field specified: DeletePrepare, this field is only for internal use")
@@ -1760,6 +1990,20 @@ func (m *AutoScalePolicy) Size() (n int) {
 	return n
 }
 
+func (m *AutoScalePolicyHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		n += 1 + sovAutoscalepolicy(uint64(m.Revision))
+	}
+	l = m.Obj.Size()
+	n += 1 + l + sovAutoscalepolicy(uint64(l))
+	return n
+}
+
 func sovAutoscalepolicy(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2186,6 +2430,108 @@ func (m *AutoScalePolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AutoScalePolicyHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAutoscalepolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoScalePolicyHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoScalePolicyHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoscalepolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Obj", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoscalepolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAutoscalepolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoscalepolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Obj.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAutoscalepolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAutoscalepolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAutoscalepolicy(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
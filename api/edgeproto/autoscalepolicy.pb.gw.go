@@ -160,6 +160,31 @@ func request_AutoScalePolicyApi_ShowAutoScalePolicy_0(ctx context.Context, marsh
 
 }
 
+func request_AutoScalePolicyApi_ShowAutoScalePolicyHistory_0(ctx context.Context, marshaler runtime.Marshaler, client AutoScalePolicyApiClient, req *http.Request, pathParams map[string]string) (AutoScalePolicyApi_ShowAutoScalePolicyHistoryClient, runtime.ServerMetadata, error) {
+	var protoReq AutoScalePolicy
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	stream, err := client.ShowAutoScalePolicyHistory(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+
+}
+
 // RegisterAutoScalePolicyApiHandlerServer registers the http handlers for service AutoScalePolicyApi to "mux".
 // UnaryRPC     :call AutoScalePolicyApiServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
@@ -242,6 +267,13 @@ func RegisterAutoScalePolicyApiHandlerServer(ctx context.Context, mux *runtime.S
 		return
 	})
 
+	mux.Handle("POST", pattern_AutoScalePolicyApi_ShowAutoScalePolicyHistory_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+
 	return nil
 }
 
@@ -363,6 +395,26 @@ func RegisterAutoScalePolicyApiHandlerClient(ctx context.Context, mux *runtime.S
 
 	})
 
+	mux.Handle("POST", pattern_AutoScalePolicyApi_ShowAutoScalePolicyHistory_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_AutoScalePolicyApi_ShowAutoScalePolicyHistory_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_AutoScalePolicyApi_ShowAutoScalePolicyHistory_0(ctx, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+
+	})
+
 	return nil
 }
 
@@ -374,6 +426,8 @@ var (
 	pattern_AutoScalePolicyApi_UpdateAutoScalePolicy_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"update", "autoscalepolicy"}, "", runtime.AssumeColonVerbOpt(true)))
 
 	pattern_AutoScalePolicyApi_ShowAutoScalePolicy_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"show", "autoscalepolicy"}, "", runtime.AssumeColonVerbOpt(true)))
+
+	pattern_AutoScalePolicyApi_ShowAutoScalePolicyHistory_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"show", "autoscalepolicyhistory"}, "", runtime.AssumeColonVerbOpt(true)))
 )
 
 var (
@@ -384,4 +438,6 @@ var (
 	forward_AutoScalePolicyApi_UpdateAutoScalePolicy_0 = runtime.ForwardResponseMessage
 
 	forward_AutoScalePolicyApi_ShowAutoScalePolicy_0 = runtime.ForwardResponseStream
+
+	forward_AutoScalePolicyApi_ShowAutoScalePolicyHistory_0 = runtime.ForwardResponseStream
 )
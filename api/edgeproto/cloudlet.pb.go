@@ -115,6 +115,42 @@ func (OSType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_3aea31a648a25d86, []int{1}
 }
 
+// MaintenanceWindowState is the progress of a scheduled maintenance window
+//
+// 0: `MAINTENANCE_WINDOW_SCHEDULED`
+// 1: `MAINTENANCE_WINDOW_FAILOVER`
+// 2: `MAINTENANCE_WINDOW_IN_PROGRESS`
+type MaintenanceWindowState int32
+
+const (
+	// Waiting for the maintenance window
+	MaintenanceWindowState_MAINTENANCE_WINDOW_SCHEDULED MaintenanceWindowState = 0
+	// New clients are no longer sent to the cloudlet, and auto-provisioned AppInsts are being moved off of it
+	MaintenanceWindowState_MAINTENANCE_WINDOW_FAILOVER MaintenanceWindowState = 1
+	// Cloudlet is under maintenance
+	MaintenanceWindowState_MAINTENANCE_WINDOW_IN_PROGRESS MaintenanceWindowState = 2
+)
+
+var MaintenanceWindowState_name = map[int32]string{
+	0: "MAINTENANCE_WINDOW_SCHEDULED",
+	1: "MAINTENANCE_WINDOW_FAILOVER",
+	2: "MAINTENANCE_WINDOW_IN_PROGRESS",
+}
+
+var MaintenanceWindowState_value = map[string]int32{
+	"MAINTENANCE_WINDOW_SCHEDULED":   0,
+	"MAINTENANCE_WINDOW_FAILOVER":    1,
+	"MAINTENANCE_WINDOW_IN_PROGRESS": 2,
+}
+
+func (x MaintenanceWindowState) String() string {
+	return proto.EnumName(MaintenanceWindowState_name, int32(x))
+}
+
+func (MaintenanceWindowState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3aea31a648a25d86, []int{2}
+}
+
 // Report Schedule
 //
 // # ReportSchedule is the interval for which report is to be generated
@@ -151,7 +187,7 @@ func (x ReportSchedule) String() string {
 }
 
 func (ReportSchedule) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_3aea31a648a25d86, []int{2}
+	return fileDescriptor_3aea31a648a25d86, []int{3}
 }
 
 // Operation time limits
@@ -897,6 +933,53 @@ func (m *GPUConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GPUConfig proto.InternalMessageInfo
 
+// MaintenanceWindow schedules cloudlet maintenance
+type MaintenanceWindow struct {
+	// Start time of the maintenance
+	StartTime distributed_match_engine.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time"`
+	// End time of the maintenance, after which the cloudlet returns to normal operation
+	EndTime distributed_match_engine.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time"`
+	// How long before the start time to stop sending new clients to the cloudlet and move auto-provisioned AppInsts off of it, defaults to the cloudlet maintenance timeout
+	FailoverLeadTime Duration `protobuf:"varint,3,opt,name=failover_lead_time,json=failoverLeadTime,proto3,casttype=Duration" json:"failover_lead_time,omitempty"`
+	// Do not move auto-provisioned AppInsts off of the cloudlet
+	NoFailover bool `protobuf:"varint,4,opt,name=no_failover,json=noFailover,proto3" json:"no_failover,omitempty"`
+	// Progress of the maintenance window
+	State MaintenanceWindowState `protobuf:"varint,5,opt,name=state,proto3,enum=edgeproto.MaintenanceWindowState" json:"state,omitempty"`
+}
+
+func (m *MaintenanceWindow) Reset()         { *m = MaintenanceWindow{} }
+func (m *MaintenanceWindow) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceWindow) ProtoMessage()    {}
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aea31a648a25d86, []int{14}
+}
+func (m *MaintenanceWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaintenanceWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceWindow.Merge(m, src)
+}
+func (m *MaintenanceWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceWindow proto.InternalMessageInfo
+
 // Cloudlet
 //
 // A Cloudlet is a set of compute resources at a particular location, provided by an Operator.
@@ -1022,13 +1105,17 @@ type Cloudlet struct {
 	Annotations map[string]string `protobuf:"bytes,64,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// database version model ID
 	DbModelId int32 `protobuf:"varint,66,opt,name=db_model_id,json=dbModelId,proto3" json:"db_model_id,omitempty"`
+	// Preview the update without applying it, reports the fields that would change and whether the CRM would be updated
+	DryRun bool `protobuf:"varint,67,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Scheduled maintenance, the cloudlet is put into maintenance at the start time and returned to normal operation at the end time
+	MaintenanceWindow *MaintenanceWindow `protobuf:"bytes,68,opt,name=maintenance_window,json=maintenanceWindow,proto3" json:"maintenance_window,omitempty"`
 }
 
 func (m *Cloudlet) Reset()         { *m = Cloudlet{} }
 func (m *Cloudlet) String() string { return proto.CompactTextString(m) }
 func (*Cloudlet) ProtoMessage()    {}
 func (*Cloudlet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aea31a648a25d86, []int{14}
+	return fileDescriptor_3aea31a648a25d86, []int{15}
 }
 func (m *Cloudlet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1070,7 +1157,7 @@ func (m *FlavorMatch) Reset()         { *m = FlavorMatch{} }
 func (m *FlavorMatch) String() string { return proto.CompactTextString(m) }
 func (*FlavorMatch) ProtoMessage()    {}
 func (*FlavorMatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aea31a648a25d86, []int{15}
+	return fileDescriptor_3aea31a648a25d86, []int{16}
 }
 func (m *FlavorMatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1108,7 +1195,7 @@ func (m *CloudletManifest) Reset()         { *m = CloudletManifest{} }
 func (m *CloudletManifest) String() string { return proto.CompactTextString(m) }
 func (*CloudletManifest) ProtoMessage()    {}
 func (*CloudletManifest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aea31a648a25d86, []int{16}
+	return fileDescriptor_3aea31a648a25d86, []int{17}
 }
 func (m *CloudletManifest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1158,7 +1245,7 @@ func (m *PropertyInfo) Reset()         { *m = PropertyInfo{} }
 func (m *PropertyInfo) String() string { return proto.CompactTextString(m) }
 func (*PropertyInfo) ProtoMessage()    {}
 func (*PropertyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aea31a648a25d86, []int{17}
+	return fileDescriptor_3aea31a648a25d86, []int{18}
 }
 func (m *PropertyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1201,7 +1288,7 @@ func (m *CloudletProps) Reset()         { *m = CloudletProps{} }
 func (m *CloudletProps) String() string { return proto.CompactTextString(m) }
 func (*CloudletProps) ProtoMessage()    {}
 func (*CloudletProps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aea31a648a25d86, []int{18}
+	return fileDescriptor_3aea31a648a25d86, []int{19}
 }
 func (m *CloudletProps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1243,7 +1330,7 @@ func (m *CloudletResourceQuotaProps) Reset()         { *m = CloudletResourceQuot
 func (m *CloudletResourceQuotaProps) String() string { return proto.CompactTextString(m) }
 func (*CloudletResourceQuotaProps) ProtoMessage()    {}
 func (*CloudletResourceQuotaProps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aea31a648a25d86, []int{19}
+	return fileDescriptor_3aea31a648a25d86, []int{20}
 }
 func (m *CloudletResourceQuotaProps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1287,7 +1374,7 @@ func (m *CloudletResourceUsage) Reset()         { *m = CloudletResourceUsage{} }
 func (m *CloudletResourceUsage) String() string { return proto.CompactTextString(m) }
 func (*CloudletResourceUsage) ProtoMessage()    {}
 func (*CloudletResourceUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aea31a648a25d86, []int{20}
+	return fileDescriptor_3aea31a648a25d86, []int{21}
 }
 func (m *CloudletResourceUsage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1327,7 +1414,7 @@ func (m *CloudletGPUUsage) Reset()         { *m = CloudletGPUUsage{} }
 func (m *CloudletGPUUsage) String() string { return proto.CompactTextString(m) }
 func (*CloudletGPUUsage) ProtoMessage()    {}
 func (*CloudletGPUUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aea31a648a25d86, []int{21}
+	return fileDescriptor_3aea31a648a25d86, []int{22}
 }
 func (m *CloudletGPUUsage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1367,7 +1454,7 @@ func (m *CloudletAllianceOrg) Reset()         { *m = CloudletAllianceOrg{} }
 func (m *CloudletAllianceOrg) String() string { return proto.CompactTextString(m) }
 func (*CloudletAllianceOrg) ProtoMessage()    {}
 func (*CloudletAllianceOrg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aea31a648a25d86, []int{22}
+	return fileDescriptor_3aea31a648a25d86, []int{23}
 }
 func (m *CloudletAllianceOrg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1396,6 +1483,49 @@ func (m *CloudletAllianceOrg) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CloudletAllianceOrg proto.InternalMessageInfo
 
+// CloudletEvacuate moves all AppInsts off of a cloudlet
+type CloudletEvacuate struct {
+	// Cloudlet to evacuate
+	Key CloudletKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
+	// Target zone for the AppInsts, defaults to the zone of each AppInst
+	TargetZoneKey ZoneKey `protobuf:"bytes,2,opt,name=target_zone_key,json=targetZoneKey,proto3" json:"target_zone_key"`
+	// Time to wait for each replacement AppInst to pass health checks, defaults to 5m
+	HealthCheckTimeout Duration `protobuf:"varint,3,opt,name=health_check_timeout,json=healthCheckTimeout,proto3,casttype=Duration" json:"health_check_timeout,omitempty"`
+}
+
+func (m *CloudletEvacuate) Reset()         { *m = CloudletEvacuate{} }
+func (m *CloudletEvacuate) String() string { return proto.CompactTextString(m) }
+func (*CloudletEvacuate) ProtoMessage()    {}
+func (*CloudletEvacuate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aea31a648a25d86, []int{24}
+}
+func (m *CloudletEvacuate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CloudletEvacuate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CloudletEvacuate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CloudletEvacuate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloudletEvacuate.Merge(m, src)
+}
+func (m *CloudletEvacuate) XXX_Size() int {
+	return m.Size()
+}
+func (m *CloudletEvacuate) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloudletEvacuate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloudletEvacuate proto.InternalMessageInfo
+
 // Flavor details from the Cloudlet
 type FlavorInfo struct {
 	// Name of the infra flavor
@@ -1416,7 +1546,7 @@ func (m *FlavorInfo) Reset()         { *m = FlavorInfo{} }
 func (m *FlavorInfo) String() string { return proto.CompactTextString(m) }
 func (*FlavorInfo) ProtoMessage()    {}
 func (*FlavorInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aea31a648a25d86, []int{23}
+	return fileDescriptor_3aea31a648a25d86, []int{25}
 }
 func (m *FlavorInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1456,7 +1586,7 @@ func (m *OSAZone) Reset()         { *m = OSAZone{} }
 func (m *OSAZone) String() string { return proto.CompactTextString(m) }
 func (*OSAZone) ProtoMessage()    {}
 func (*OSAZone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aea31a648a25d86, []int{24}
+	return fileDescriptor_3aea31a648a25d86, []int{26}
 }
 func (m *OSAZone) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1500,7 +1630,7 @@ func (m *OSImage) Reset()         { *m = OSImage{} }
 func (m *OSImage) String() string { return proto.CompactTextString(m) }
 func (*OSImage) ProtoMessage()    {}
 func (*OSImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aea31a648a25d86, []int{25}
+	return fileDescriptor_3aea31a648a25d86, []int{27}
 }
 func (m *OSImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1588,7 +1718,7 @@ func (m *CloudletInfo) Reset()         { *m = CloudletInfo{} }
 func (m *CloudletInfo) String() string { return proto.CompactTextString(m) }
 func (*CloudletInfo) ProtoMessage()    {}
 func (*CloudletInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aea31a648a25d86, []int{26}
+	return fileDescriptor_3aea31a648a25d86, []int{28}
 }
 func (m *CloudletInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1627,7 +1757,7 @@ func (m *CloudletMetrics) Reset()         { *m = CloudletMetrics{} }
 func (m *CloudletMetrics) String() string { return proto.CompactTextString(m) }
 func (*CloudletMetrics) ProtoMessage()    {}
 func (*CloudletMetrics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aea31a648a25d86, []int{27}
+	return fileDescriptor_3aea31a648a25d86, []int{29}
 }
 func (m *CloudletMetrics) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1667,7 +1797,7 @@ func (m *CloudletManagedClusterKey) Reset()         { *m = CloudletManagedCluste
 func (m *CloudletManagedClusterKey) String() string { return proto.CompactTextString(m) }
 func (*CloudletManagedClusterKey) ProtoMessage()    {}
 func (*CloudletManagedClusterKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aea31a648a25d86, []int{28}
+	return fileDescriptor_3aea31a648a25d86, []int{30}
 }
 func (m *CloudletManagedClusterKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1733,7 +1863,7 @@ func (m *CloudletManagedCluster) Reset()         { *m = CloudletManagedCluster{}
 func (m *CloudletManagedCluster) String() string { return proto.CompactTextString(m) }
 func (*CloudletManagedCluster) ProtoMessage()    {}
 func (*CloudletManagedCluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aea31a648a25d86, []int{29}
+	return fileDescriptor_3aea31a648a25d86, []int{31}
 }
 func (m *CloudletManagedCluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1765,6 +1895,7 @@ var xxx_messageInfo_CloudletManagedCluster proto.InternalMessageInfo
 func init() {
 	proto.RegisterEnum("edgeproto.InfraApiAccess", InfraApiAccess_name, InfraApiAccess_value)
 	proto.RegisterEnum("edgeproto.OSType", OSType_name, OSType_value)
+	proto.RegisterEnum("edgeproto.MaintenanceWindowState", MaintenanceWindowState_name, MaintenanceWindowState_value)
 	proto.RegisterEnum("edgeproto.ReportSchedule", ReportSchedule_name, ReportSchedule_value)
 	proto.RegisterType((*OperationTimeLimits)(nil), "edgeproto.OperationTimeLimits")
 	proto.RegisterType((*CloudletInternal)(nil), "edgeproto.CloudletInternal")
@@ -1787,6 +1918,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "edgeproto.GPUDriver.PropertiesEntry")
 	proto.RegisterType((*GPUConfig)(nil), "edgeproto.GPUConfig")
 	proto.RegisterMapType((map[string]string)(nil), "edgeproto.GPUConfig.PropertiesEntry")
+	proto.RegisterType((*MaintenanceWindow)(nil), "edgeproto.MaintenanceWindow")
 	proto.RegisterType((*Cloudlet)(nil), "edgeproto.Cloudlet")
 	proto.RegisterMapType((map[string]string)(nil), "edgeproto.Cloudlet.AccessVarsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "edgeproto.Cloudlet.AnnotationsEntry")
@@ -1801,6 +1933,7 @@ func init() {
 	proto.RegisterType((*CloudletResourceUsage)(nil), "edgeproto.CloudletResourceUsage")
 	proto.RegisterType((*CloudletGPUUsage)(nil), "edgeproto.CloudletGPUUsage")
 	proto.RegisterType((*CloudletAllianceOrg)(nil), "edgeproto.CloudletAllianceOrg")
+	proto.RegisterType((*CloudletEvacuate)(nil), "edgeproto.CloudletEvacuate")
 	proto.RegisterType((*FlavorInfo)(nil), "edgeproto.FlavorInfo")
 	proto.RegisterMapType((map[string]string)(nil), "edgeproto.FlavorInfo.PropMapEntry")
 	proto.RegisterType((*OSAZone)(nil), "edgeproto.OSAZone")
@@ -1815,463 +1948,482 @@ func init() {
 func init() { proto.RegisterFile("cloudlet.proto", fileDescriptor_3aea31a648a25d86) }
 
 var fileDescriptor_3aea31a648a25d86 = []byte{
-	// 7283 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x7c, 0x5b, 0x6c, 0x1c, 0x47,
-	0xb6, 0x98, 0x9a, 0xa4, 0xa8, 0x99, 0x33, 0x43, 0x72, 0x58, 0x7c, 0xa8, 0x49, 0x91, 0x14, 0xd5,
-	0x96, 0x2c, 0x59, 0x1e, 0x93, 0x6b, 0xca, 0xb2, 0x65, 0x5e, 0xcb, 0x36, 0x1f, 0x92, 0x4c, 0x53,
-	0x14, 0xe9, 0xa6, 0x1e, 0xb9, 0x0e, 0x92, 0x46, 0xb3, 0xbb, 0x66, 0xd8, 0x66, 0x4f, 0x77, 0xbb,
-	0xba, 0x67, 0xe4, 0xf1, 0xd7, 0xde, 0xfb, 0x73, 0x63, 0x04, 0xb8, 0xb8, 0xf1, 0xdd, 0x64, 0x37,
-	0x4e, 0x80, 0x75, 0x36, 0x6b, 0x64, 0x11, 0x24, 0xc0, 0xc2, 0xc8, 0xcf, 0x7a, 0xf7, 0x23, 0x09,
-	0x02, 0xc4, 0x49, 0x90, 0xc0, 0x8b, 0x04, 0xc9, 0xc2, 0x08, 0x76, 0x37, 0x76, 0x3e, 0x12, 0xe6,
-	0x23, 0x01, 0x96, 0x94, 0x37, 0xfb, 0x75, 0x51, 0x8f, 0x7e, 0xcd, 0xf4, 0x50, 0x22, 0x2d, 0xef,
-	0xfe, 0x4d, 0x9f, 0x3a, 0x75, 0xfa, 0xd4, 0xa9, 0x53, 0xa7, 0xce, 0xab, 0x07, 0xfa, 0x0d, 0xdb,
-	0xad, 0x9b, 0x36, 0x0e, 0x66, 0x3c, 0xe2, 0x06, 0x2e, 0xca, 0x63, 0xb3, 0x8a, 0xd9, 0xcf, 0xf1,
-	0x89, 0xaa, 0xeb, 0x56, 0x6d, 0x3c, 0xab, 0x7b, 0xd6, 0xac, 0xee, 0x38, 0x6e, 0xa0, 0x07, 0x96,
-	0xeb, 0xf8, 0x1c, 0x71, 0x7c, 0x32, 0x70, 0x5d, 0xdb, 0x9f, 0x65, 0x0f, 0x55, 0xec, 0x44, 0x3f,
-	0xc4, 0xf0, 0x60, 0x48, 0x77, 0x07, 0x37, 0x05, 0xa8, 0x58, 0xb1, 0xf5, 0x86, 0x4b, 0xc2, 0x27,
-	0x82, 0xfd, 0xba, 0x1d, 0x84, 0xe8, 0x04, 0xfb, 0x81, 0x5e, 0x0d, 0xf4, 0x2d, 0x1b, 0x87, 0x08,
-	0x86, 0x5b, 0xab, 0xb9, 0x21, 0xbd, 0x3e, 0xc3, 0xae, 0xfb, 0x01, 0x0e, 0x67, 0x0f, 0x5b, 0x4e,
-	0x85, 0xe8, 0x04, 0xfb, 0x6e, 0x9d, 0x18, 0x38, 0xe4, 0x29, 0xef, 0x92, 0x6a, 0x88, 0x6f, 0xd6,
-	0xf0, 0xac, 0xed, 0x1a, 0x21, 0x7e, 0xd5, 0xad, 0xba, 0xec, 0xe7, 0x2c, 0xfd, 0x25, 0xa0, 0x43,
-	0x14, 0x49, 0xf7, 0xbc, 0xd4, 0x9b, 0x06, 0x5a, 0xa8, 0x2a, 0xff, 0xa1, 0x1b, 0x86, 0xd6, 0x3d,
-	0x4c, 0xd8, 0xf2, 0x6f, 0x5b, 0x35, 0x7c, 0xd3, 0xaa, 0x59, 0x81, 0x8f, 0x56, 0xe1, 0x94, 0x41,
-	0xb0, 0x1e, 0x60, 0x4d, 0xf0, 0xa6, 0x59, 0x8e, 0x1f, 0x68, 0x81, 0x55, 0xc3, 0x6e, 0x3d, 0x90,
-	0xa5, 0x69, 0xe9, 0x42, 0xf7, 0x62, 0xf1, 0x77, 0xbf, 0x3c, 0x9d, 0x5b, 0xae, 0xf3, 0xc9, 0xaa,
-	0xcc, 0x27, 0x2c, 0x71, 0xfc, 0x15, 0xc7, 0x0f, 0x6e, 0x73, 0x6c, 0x4a, 0xac, 0xee, 0x99, 0x1d,
-	0x89, 0x75, 0x65, 0x11, 0xe3, 0x13, 0xb2, 0x89, 0x99, 0xd8, 0xc6, 0x9d, 0x88, 0x75, 0x67, 0x11,
-	0xe3, 0x13, 0x32, 0x88, 0x2d, 0xc1, 0x49, 0xb1, 0x4c, 0xdd, 0xf3, 0xd2, 0x84, 0x7a, 0x32, 0x08,
-	0x0d, 0x73, 0xe4, 0x05, 0xcf, 0x6b, 0x21, 0x22, 0x96, 0xd7, 0x46, 0xe4, 0x78, 0x16, 0x11, 0x8e,
-	0xdc, 0x4e, 0x44, 0x2c, 0xab, 0x8d, 0x48, 0x6f, 0x16, 0x11, 0x8e, 0x9c, 0x26, 0xa2, 0xfc, 0x56,
-	0x82, 0xd2, 0x92, 0xd0, 0xcd, 0x15, 0x27, 0xc0, 0xc4, 0xd1, 0x6d, 0x34, 0x0a, 0xbd, 0x15, 0x0b,
-	0xdb, 0xa6, 0x2f, 0x4b, 0xd3, 0xdd, 0x17, 0xf2, 0xaa, 0x78, 0x42, 0x33, 0xd0, 0xbd, 0x83, 0x9b,
-	0x4c, 0xfa, 0x85, 0xb9, 0xd1, 0x99, 0xe8, 0x6c, 0xcc, 0x84, 0x14, 0x56, 0x71, 0x73, 0xb1, 0xe7,
-	0xd3, 0x5f, 0x9e, 0x3e, 0xa6, 0x52, 0x44, 0xf4, 0x12, 0x1c, 0xf7, 0x88, 0xeb, 0xf9, 0x72, 0xf7,
-	0x74, 0xf7, 0x85, 0xc2, 0xdc, 0x93, 0x19, 0x33, 0xc2, 0x77, 0xce, 0x6c, 0x50, 0xc4, 0x6b, 0x4e,
-	0x40, 0x9a, 0x2a, 0x9f, 0x34, 0x7e, 0x05, 0x20, 0x06, 0xa2, 0x12, 0x7f, 0x37, 0x55, 0xa3, 0x3c,
-	0xa7, 0x3e, 0x0c, 0xc7, 0x1b, 0xba, 0x5d, 0xc7, 0x8c, 0x9f, 0xbc, 0xca, 0x1f, 0xe6, 0xbb, 0xae,
-	0x48, 0xf3, 0x67, 0xff, 0xd7, 0x6f, 0x64, 0xe9, 0xff, 0xfd, 0x46, 0x96, 0xbe, 0xbd, 0x27, 0x4b,
-	0x7f, 0xb1, 0x27, 0x4b, 0x1f, 0x3f, 0x90, 0x4b, 0x3b, 0xb8, 0x79, 0x75, 0x9d, 0x54, 0x75, 0xc7,
-	0x7a, 0x97, 0x09, 0x44, 0xf9, 0x93, 0x3c, 0xf4, 0x6f, 0xd8, 0x7a, 0x50, 0x71, 0x49, 0x6d, 0xc9,
-	0x75, 0x2a, 0x56, 0x15, 0x3d, 0x0f, 0x27, 0x0d, 0xd7, 0x09, 0x74, 0xcb, 0xc1, 0x44, 0x23, 0xb8,
-	0x6a, 0xf9, 0x01, 0x69, 0x6a, 0x9e, 0x1e, 0x6c, 0x8b, 0x17, 0x8f, 0x44, 0xc3, 0xaa, 0x18, 0xdd,
-	0xd0, 0x83, 0x6d, 0x74, 0x09, 0x46, 0xc3, 0x03, 0xae, 0x35, 0x6a, 0x9a, 0x55, 0xd3, 0xab, 0x98,
-	0x4f, 0xe3, 0xbc, 0x0d, 0x85, 0xa3, 0x77, 0x6b, 0x2b, 0x74, 0x8c, 0x4d, 0xba, 0x0c, 0x83, 0x8e,
-	0x1b, 0x58, 0x95, 0xa6, 0x66, 0x04, 0xc4, 0xd6, 0x74, 0xd3, 0x24, 0x3e, 0x53, 0xc6, 0xfc, 0x62,
-	0xfe, 0xfd, 0x8f, 0xc7, 0x8e, 0x3b, 0xae, 0x51, 0xf3, 0xd4, 0x01, 0x8e, 0xb3, 0x14, 0x10, 0x7b,
-	0x81, 0x62, 0x20, 0x05, 0xfa, 0x02, 0xdb, 0xd7, 0x0c, 0x4c, 0x02, 0xad, 0x62, 0xd9, 0x98, 0x69,
-	0x4c, 0x5e, 0x2d, 0x04, 0xb6, 0xbf, 0x84, 0x49, 0x70, 0xdd, 0xb2, 0x31, 0x9a, 0x86, 0x22, 0xc5,
-	0xd9, 0xc1, 0x4d, 0x8e, 0x32, 0xcc, 0x50, 0x20, 0xb0, 0xfd, 0x55, 0xdc, 0x64, 0x18, 0x53, 0x50,
-	0x60, 0x54, 0x74, 0x8e, 0x30, 0xc2, 0x10, 0xf2, 0x94, 0x86, 0xce, 0xc6, 0x5f, 0x86, 0x13, 0xd8,
-	0x69, 0x68, 0x0d, 0x9d, 0xc8, 0xbd, 0x6c, 0xf3, 0xce, 0x25, 0x36, 0x2f, 0x2d, 0xb5, 0x99, 0x6b,
-	0x4e, 0xe3, 0xae, 0x4e, 0xf8, 0xde, 0xf5, 0x62, 0xf6, 0x80, 0xca, 0x50, 0xf4, 0x04, 0x96, 0x16,
-	0xe8, 0x55, 0x39, 0xd7, 0xba, 0xae, 0x42, 0x38, 0x7c, 0x5b, 0xaf, 0xa2, 0x53, 0x90, 0x0f, 0xb0,
-	0x1f, 0x68, 0x35, 0xd7, 0xc4, 0x72, 0x7e, 0x5a, 0xba, 0x90, 0x53, 0x73, 0x14, 0xb0, 0xe6, 0x9a,
-	0x18, 0x4d, 0x42, 0x8f, 0xef, 0xe9, 0x8e, 0x0c, 0xad, 0x24, 0x18, 0x18, 0x9d, 0x81, 0xa2, 0x61,
-	0x63, 0xdd, 0xa9, 0x7b, 0x7c, 0x7a, 0x81, 0x4d, 0x2f, 0x08, 0x18, 0xa3, 0x30, 0x0a, 0xbd, 0x74,
-	0x33, 0x5d, 0x47, 0x2e, 0xb2, 0x75, 0x8a, 0x27, 0xf4, 0x14, 0x94, 0xa8, 0xad, 0xc3, 0xc4, 0xb0,
-	0x74, 0x9b, 0x49, 0xd4, 0x97, 0xfb, 0xd8, 0xf4, 0x81, 0x18, 0x4e, 0x85, 0xca, 0xa4, 0x5e, 0xf7,
-	0xb1, 0xd6, 0xd0, 0xeb, 0x76, 0xa0, 0x79, 0x3b, 0x96, 0xdc, 0xcf, 0x5f, 0x53, 0xf7, 0xf1, 0x5d,
-	0x0a, 0xdb, 0xd8, 0xb1, 0xa8, 0xd4, 0xe9, 0x49, 0x34, 0x1d, 0x5f, 0x23, 0xae, 0x1b, 0xc8, 0x25,
-	0x2e, 0x75, 0xdd, 0xf3, 0x96, 0x1d, 0x5f, 0x75, 0xdd, 0x00, 0x9d, 0x83, 0x7e, 0x13, 0x7b, 0xb6,
-	0xdb, 0xac, 0x61, 0x27, 0x60, 0x72, 0x19, 0x62, 0x38, 0x7d, 0x31, 0x94, 0x8a, 0xe3, 0x65, 0x18,
-	0x35, 0x48, 0x4d, 0xd3, 0x0d, 0x03, 0xfb, 0xbe, 0xe6, 0x11, 0xab, 0x41, 0x4d, 0x05, 0x55, 0xff,
-	0xd1, 0x56, 0x19, 0x0c, 0x19, 0xa4, 0xb6, 0xc0, 0xf0, 0x36, 0x38, 0xda, 0x2a, 0x6e, 0xa2, 0x67,
-	0x61, 0x40, 0xcc, 0xd5, 0x3d, 0x8b, 0x29, 0x96, 0x7c, 0xb2, 0x75, 0x62, 0x1f, 0xc7, 0x58, 0xf0,
-	0x2c, 0xaa, 0x56, 0x74, 0x07, 0x0c, 0xdd, 0xd8, 0xc6, 0x9a, 0x69, 0x11, 0x59, 0x66, 0x4c, 0xe5,
-	0x18, 0x60, 0xd9, 0x22, 0xe8, 0x0d, 0x98, 0xf6, 0xb1, 0xe1, 0x3a, 0xa6, 0x4e, 0x9a, 0x5a, 0x07,
-	0xce, 0xc6, 0x5a, 0x5f, 0x30, 0x11, 0x4d, 0x59, 0xca, 0x60, 0xf1, 0x02, 0x94, 0x82, 0x6d, 0xdd,
-	0x71, 0x7d, 0x8d, 0x60, 0xa3, 0xc1, 0x79, 0x1c, 0x67, 0xaf, 0xed, 0xe7, 0x70, 0x15, 0x1b, 0x0d,
-	0xc6, 0xd9, 0x0c, 0x0c, 0xe9, 0x8e, 0x6f, 0x6d, 0xd9, 0x58, 0xf3, 0xea, 0x5b, 0xb6, 0x65, 0x70,
-	0xe4, 0x53, 0x0c, 0x79, 0x50, 0x0c, 0x6d, 0xb0, 0x11, 0x86, 0xff, 0x2c, 0x8c, 0x60, 0xa7, 0xe1,
-	0x36, 0xb5, 0xfb, 0x56, 0xb0, 0xad, 0x19, 0x75, 0x62, 0xf3, 0xf3, 0x28, 0x4f, 0xb2, 0x19, 0x88,
-	0x0d, 0xde, 0xb3, 0x82, 0xed, 0xa5, 0x3a, 0xb1, 0xd9, 0x69, 0xa4, 0x53, 0x9c, 0xaa, 0xe5, 0xbc,
-	0xd3, 0x36, 0x65, 0x8a, 0x4f, 0x61, 0x83, 0xa9, 0x29, 0xe3, 0x2f, 0x42, 0x21, 0xa1, 0xf6, 0x87,
-	0xb1, 0x4e, 0xaf, 0xf7, 0xe4, 0x7a, 0x4a, 0xc7, 0x5f, 0xef, 0xc9, 0x4d, 0x94, 0x26, 0x95, 0x9f,
-	0x4b, 0x50, 0xba, 0x8e, 0x4d, 0x71, 0x9b, 0x0a, 0x2b, 0x34, 0x07, 0x23, 0x95, 0x08, 0xa6, 0x51,
-	0x8b, 0x83, 0xdf, 0x09, 0x34, 0xcb, 0x14, 0xe4, 0x87, 0x2a, 0xc9, 0x09, 0x74, 0x6c, 0xc5, 0xa4,
-	0x96, 0xcb, 0xd3, 0x49, 0x40, 0xed, 0x56, 0x62, 0x2e, 0x93, 0x14, 0x67, 0x60, 0x44, 0x0c, 0xc7,
-	0x6f, 0x63, 0xd2, 0xba, 0x00, 0xa5, 0x04, 0xbe, 0xb9, 0x45, 0x5f, 0x43, 0x6d, 0x50, 0x8f, 0xda,
-	0x1f, 0xc3, 0x97, 0xb7, 0x56, 0x4c, 0x74, 0x1e, 0x06, 0x12, 0x98, 0x8e, 0x5e, 0xc3, 0xec, 0xc2,
-	0xcb, 0x27, 0x11, 0x6f, 0xe9, 0x35, 0xac, 0xbc, 0x87, 0xa0, 0x14, 0x5a, 0x88, 0xeb, 0x58, 0x0f,
-	0xea, 0x04, 0xfb, 0xe8, 0x09, 0xe8, 0x8b, 0xed, 0x41, 0xd3, 0xc3, 0x62, 0x2d, 0x91, 0x91, 0xb8,
-	0xdd, 0xf4, 0x30, 0x55, 0x42, 0xc7, 0x35, 0x31, 0x47, 0x18, 0xe3, 0x4a, 0x48, 0x01, 0x6c, 0x70,
-	0x01, 0x26, 0xfd, 0xba, 0xe7, 0xb9, 0x24, 0xf0, 0xb5, 0x5a, 0xdd, 0x0e, 0x2c, 0x2d, 0xc0, 0x8e,
-	0xee, 0x04, 0xe1, 0xa5, 0xce, 0xd6, 0x99, 0x53, 0xc7, 0x43, 0xa4, 0x35, 0x8a, 0x73, 0x9b, 0xa1,
-	0x88, 0x6b, 0x1c, 0x3d, 0x07, 0xa3, 0x11, 0x09, 0x7f, 0x5b, 0x27, 0xd8, 0xd4, 0x1a, 0xae, 0x5d,
-	0xaf, 0x61, 0xb6, 0xe4, 0x9c, 0x3a, 0x1c, 0x8e, 0x6e, 0xb2, 0xc1, 0xbb, 0x6c, 0x8c, 0x6e, 0x47,
-	0x34, 0x2b, 0x20, 0x75, 0x3f, 0xd0, 0x3c, 0xd7, 0xb6, 0x8c, 0x26, 0x5b, 0x7e, 0x4e, 0x1d, 0x0a,
-	0x07, 0x6f, 0xd3, 0xb1, 0x0d, 0x36, 0x84, 0xae, 0x80, 0x1c, 0xcd, 0xd9, 0xa9, 0x6f, 0x61, 0xe2,
-	0xe0, 0x00, 0xfb, 0x9a, 0xeb, 0xd8, 0x4d, 0x66, 0xaf, 0x73, 0x6a, 0xc4, 0xc9, 0x6a, 0x34, 0xbc,
-	0xee, 0xd8, 0x4d, 0x74, 0x03, 0xa6, 0x13, 0x13, 0x08, 0x7e, 0xbb, 0x6e, 0x11, 0xec, 0x6b, 0xf7,
-	0x5d, 0xb2, 0x83, 0x89, 0x46, 0xa5, 0xe1, 0xb3, 0xeb, 0x3d, 0xa7, 0x4e, 0xc6, 0x78, 0xaa, 0x40,
-	0xbb, 0xc7, 0xb0, 0x6e, 0x51, 0x24, 0x76, 0x97, 0x85, 0x77, 0x92, 0x8f, 0x49, 0xc3, 0x32, 0xb0,
-	0xaf, 0xd9, 0xae, 0xa1, 0xdb, 0xf2, 0x09, 0x36, 0x7f, 0x24, 0x1c, 0xde, 0x14, 0xa3, 0x37, 0xe9,
-	0x20, 0x7a, 0x01, 0x64, 0xcb, 0xd3, 0x74, 0x9b, 0xa2, 0x06, 0xd8, 0xd4, 0x3c, 0x4c, 0xc2, 0xf9,
-	0xcc, 0x8a, 0xe7, 0xd4, 0x11, 0xcb, 0x5b, 0x08, 0x87, 0x37, 0x30, 0x11, 0xd3, 0xd1, 0x65, 0x38,
-	0x19, 0xad, 0x99, 0xdf, 0x80, 0x74, 0x1f, 0x35, 0xb7, 0x51, 0x11, 0x26, 0x3d, 0x12, 0x2f, 0x3b,
-	0x42, 0x74, 0x53, 0xd7, 0x1b, 0x95, 0xce, 0xd3, 0x74, 0x66, 0x1c, 0xb3, 0xa7, 0xe9, 0x68, 0x02,
-	0xc0, 0xf2, 0xe9, 0x65, 0xeb, 0xb9, 0xae, 0xcd, 0xee, 0x86, 0x9c, 0x9a, 0xb3, 0xfc, 0xbb, 0xb5,
-	0x0d, 0xd7, 0xb5, 0xd1, 0x49, 0x38, 0x61, 0xf9, 0x5a, 0x45, 0xdf, 0x09, 0xef, 0x83, 0x5e, 0xcb,
-	0xbf, 0xae, 0xef, 0x60, 0x31, 0x50, 0x73, 0x8d, 0x1d, 0x66, 0x6e, 0xd8, 0xc0, 0x9a, 0x6b, 0xec,
-	0xa0, 0x57, 0x61, 0x22, 0x62, 0x43, 0x37, 0x4d, 0x8b, 0xaa, 0xb3, 0x6e, 0x6b, 0x0e, 0x0e, 0xa8,
-	0xe8, 0x7d, 0x76, 0x73, 0x24, 0xb4, 0x6b, 0x21, 0x42, 0xb9, 0x25, 0x30, 0xd0, 0x2b, 0x30, 0x61,
-	0xf9, 0x9a, 0x6f, 0x39, 0x55, 0x1b, 0x27, 0x37, 0x3d, 0xd4, 0x4f, 0x7e, 0xb3, 0x8c, 0x59, 0xfe,
-	0x26, 0x43, 0x89, 0xf7, 0x3d, 0x54, 0xcf, 0x45, 0x98, 0x8a, 0x59, 0x08, 0x5d, 0x3a, 0x13, 0x9b,
-	0x16, 0xdf, 0x08, 0xcb, 0x13, 0x97, 0x4e, 0xcc, 0x04, 0xf7, 0xe5, 0x96, 0x43, 0x94, 0x15, 0x0f,
-	0xfd, 0x31, 0x5c, 0x8c, 0x68, 0x44, 0x07, 0x6e, 0xdb, 0xaa, 0x6e, 0x6b, 0x7a, 0x43, 0xb7, 0x6c,
-	0x7d, 0xcb, 0xb2, 0xad, 0xa0, 0xa9, 0xb9, 0x8e, 0xb6, 0x73, 0xc5, 0x97, 0x07, 0x18, 0xbd, 0x73,
-	0xe1, 0x8c, 0xf0, 0xd4, 0xbe, 0x66, 0x55, 0xb7, 0x17, 0x12, 0xe8, 0xeb, 0xce, 0xea, 0x15, 0x1f,
-	0x69, 0xf0, 0xcc, 0x23, 0x92, 0x36, 0x5d, 0x63, 0x07, 0x13, 0x76, 0xff, 0xe5, 0xd4, 0x0b, 0x0f,
-	0xa7, 0xbe, 0xcc, 0xf0, 0xd1, 0x75, 0x98, 0x76, 0xdc, 0x0c, 0xc9, 0x69, 0x7a, 0x3d, 0x70, 0x35,
-	0xdf, 0xd0, 0x6d, 0x2c, 0x0f, 0x32, 0x9a, 0x13, 0x8e, 0xdb, 0x26, 0xbe, 0x85, 0x7a, 0xe0, 0x6e,
-	0x52, 0x1c, 0xb4, 0x04, 0x53, 0x16, 0xbd, 0x9c, 0xf0, 0x56, 0xdd, 0xb2, 0x83, 0xac, 0xad, 0x40,
-	0x8c, 0xca, 0x29, 0xcb, 0xdf, 0x10, 0x48, 0xed, 0x9b, 0x51, 0x06, 0xe4, 0xb8, 0x11, 0x07, 0x62,
-	0x0d, 0xcc, 0x91, 0xca, 0xa9, 0x25, 0xc7, 0x15, 0x68, 0x9b, 0x1c, 0x8e, 0x26, 0x99, 0x36, 0x52,
-	0x0f, 0x69, 0xcb, 0x7d, 0x87, 0x79, 0x53, 0x39, 0x35, 0x6f, 0xf9, 0xd7, 0x38, 0x80, 0x5a, 0xbf,
-	0x58, 0xc7, 0xbd, 0xc6, 0xf3, 0xec, 0xf6, 0xca, 0xa9, 0xc5, 0x48, 0xb3, 0xbd, 0xc6, 0xf3, 0x68,
-	0x16, 0x86, 0xa3, 0xe3, 0x4e, 0x2f, 0x59, 0xd7, 0x61, 0x04, 0xe5, 0x09, 0x86, 0x3b, 0x18, 0x8e,
-	0x2d, 0x91, 0xda, 0xba, 0x43, 0x09, 0xd3, 0x6b, 0x2b, 0x3d, 0xa1, 0x52, 0xe1, 0x33, 0x26, 0xd9,
-	0x0c, 0x94, 0x9c, 0x51, 0xa9, 0xb0, 0x29, 0x73, 0xc9, 0x29, 0xd4, 0x83, 0x24, 0xb8, 0x42, 0xb0,
-	0xbf, 0xcd, 0x6e, 0xba, 0x9c, 0x3a, 0x14, 0x4d, 0xc1, 0x24, 0x50, 0xf9, 0x10, 0xd5, 0xeb, 0xb4,
-	0xe1, 0xf5, 0x6c, 0xcc, 0x0c, 0x11, 0x3b, 0x7a, 0xbe, 0x7c, 0x9a, 0xeb, 0x75, 0xca, 0xee, 0x7a,
-	0x36, 0xa6, 0x56, 0x88, 0x9e, 0x45, 0x1f, 0xbd, 0x08, 0x63, 0x35, 0xdd, 0xd1, 0xab, 0xd8, 0xa7,
-	0x4a, 0xc7, 0x2e, 0x34, 0xe2, 0xda, 0xc2, 0x96, 0x4d, 0x73, 0x6b, 0x28, 0x10, 0x56, 0xaf, 0xf8,
-	0x4b, 0x7c, 0x98, 0x1b, 0xb1, 0x33, 0x50, 0xac, 0xfb, 0xd8, 0xd7, 0x2c, 0xa7, 0x4a, 0xb0, 0xef,
-	0xcb, 0x67, 0x22, 0xaf, 0xcb, 0x5f, 0xe1, 0x20, 0xea, 0x1f, 0x44, 0x4b, 0xaa, 0x7a, 0x75, 0xcd,
-	0x24, 0x56, 0x03, 0x13, 0x59, 0x49, 0x4b, 0xed, 0x86, 0x57, 0x5f, 0x66, 0x03, 0xd4, 0x4b, 0x63,
-	0x24, 0xa9, 0x8b, 0xa6, 0xd9, 0x5b, 0xf2, 0x13, 0x0c, 0x11, 0x28, 0x8c, 0xfa, 0x68, 0x37, 0xb7,
-	0xd0, 0x2a, 0x28, 0xd1, 0x82, 0x23, 0x13, 0xca, 0x19, 0x34, 0x43, 0x8d, 0xf0, 0xe5, 0xb3, 0x6c,
-	0xde, 0xe9, 0x10, 0x33, 0x0c, 0x68, 0xd6, 0x38, 0x9e, 0xd0, 0x0f, 0x1f, 0xdd, 0x84, 0x82, 0xf0,
-	0x96, 0x1a, 0x3a, 0xf1, 0xe5, 0x51, 0xe6, 0x4c, 0x3f, 0x9d, 0xe1, 0x4c, 0x87, 0x57, 0xe5, 0x0c,
-	0xf7, 0x95, 0xee, 0xea, 0x44, 0x84, 0x43, 0xa0, 0x47, 0x00, 0xb4, 0x0a, 0x40, 0x83, 0x23, 0x4c,
-	0x02, 0x0b, 0xfb, 0xf2, 0xc9, 0x87, 0x13, 0xdb, 0x88, 0xb0, 0x05, 0xb1, 0x78, 0x3a, 0x7a, 0x13,
-	0xc6, 0xc2, 0xe0, 0x5e, 0x7b, 0xbb, 0xee, 0x06, 0xba, 0x96, 0xa0, 0x2d, 0x33, 0xda, 0x72, 0x82,
-	0xf6, 0x8a, 0x53, 0x21, 0xba, 0x2a, 0x26, 0x88, 0x30, 0xef, 0x64, 0x48, 0xe0, 0x0d, 0x3a, 0x3f,
-	0x7e, 0x19, 0x7a, 0x9a, 0x7a, 0xba, 0x2c, 0x38, 0xf5, 0x08, 0xf6, 0x74, 0x82, 0x65, 0x83, 0xca,
-	0x6b, 0xb1, 0xe7, 0x47, 0x7b, 0xb2, 0x44, 0xfd, 0x5d, 0x3a, 0xb6, 0xc1, 0x87, 0xc6, 0xef, 0xc2,
-	0x40, 0xcb, 0xa2, 0x33, 0x1c, 0xaa, 0x67, 0x92, 0x0e, 0x55, 0x61, 0xee, 0x64, 0x72, 0xd5, 0xfc,
-	0xbd, 0xcd, 0x15, 0xa7, 0xe2, 0x26, 0x3c, 0x2d, 0x4a, 0xb7, 0x65, 0xfd, 0x8f, 0x85, 0xee, 0xfc,
-	0x85, 0x8c, 0xf8, 0xb2, 0xc7, 0x71, 0x1d, 0xfc, 0xaf, 0xbf, 0x92, 0x8b, 0x1b, 0x09, 0x8f, 0x46,
-	0xf9, 0x55, 0x17, 0xf4, 0x87, 0x9a, 0xa1, 0x62, 0x7f, 0x4d, 0xf7, 0xd0, 0x7c, 0xcc, 0x41, 0xe7,
-	0x20, 0xba, 0xb4, 0xfb, 0x40, 0xce, 0x85, 0x80, 0x38, 0xa0, 0x7e, 0x03, 0x4e, 0xd4, 0x74, 0xcf,
-	0xb3, 0x9c, 0xaa, 0xdc, 0xd5, 0x31, 0xa4, 0xe6, 0xef, 0x99, 0x59, 0xe3, 0x88, 0x6c, 0xd9, 0x8b,
-	0x03, 0xbb, 0x0f, 0xe4, 0x82, 0x8a, 0xfd, 0xdb, 0x7a, 0xf5, 0xb6, 0xbe, 0x65, 0x63, 0x35, 0xa4,
-	0x33, 0x3e, 0x0f, 0xc5, 0x24, 0xe6, 0xa1, 0xe2, 0xec, 0x3f, 0x91, 0x3e, 0xd8, 0x97, 0xef, 0x84,
-	0x47, 0xe4, 0xea, 0x2a, 0x6e, 0xce, 0x50, 0x0f, 0xb0, 0x1c, 0x42, 0x5c, 0x52, 0x65, 0xc0, 0x64,
-	0xd8, 0x5d, 0x16, 0xde, 0x22, 0x36, 0xc3, 0xd1, 0xeb, 0x21, 0x20, 0x89, 0xf6, 0x83, 0x7d, 0x79,
-	0xac, 0xe3, 0xe0, 0xbf, 0xdf, 0x97, 0x4f, 0x08, 0xa6, 0x95, 0x2d, 0x28, 0x30, 0xc5, 0x8c, 0x7d,
-	0x67, 0xfc, 0x0e, 0x4f, 0x29, 0x84, 0x77, 0x37, 0xf7, 0x55, 0x85, 0xef, 0x1c, 0x0e, 0x8a, 0x5b,
-	0x9b, 0xb2, 0x8b, 0x4e, 0x43, 0x81, 0xe7, 0xe2, 0x38, 0x26, 0x5f, 0x26, 0x70, 0x10, 0xf3, 0x68,
-	0xff, 0x4c, 0x82, 0x3e, 0x35, 0xa9, 0xe8, 0x08, 0x41, 0x4f, 0x82, 0x2a, 0xfb, 0x9d, 0x96, 0x53,
-	0x8f, 0x90, 0x13, 0x75, 0x9b, 0x75, 0x9b, 0x5a, 0xda, 0x60, 0x9b, 0x5a, 0x53, 0xd7, 0xe6, 0xfe,
-	0xf5, 0x71, 0xb5, 0x9f, 0x81, 0x6f, 0x87, 0x50, 0x7a, 0x47, 0x44, 0xa7, 0x91, 0x39, 0xc0, 0xdc,
-	0xbb, 0x2e, 0x86, 0x40, 0xa6, 0x4f, 0x75, 0x28, 0xde, 0xd8, 0xb8, 0xc3, 0x2d, 0x19, 0x0d, 0xa3,
-	0xce, 0x24, 0xf9, 0x58, 0xec, 0xfb, 0xe4, 0x81, 0x9c, 0xaf, 0x7a, 0x75, 0x6e, 0x02, 0x05, 0x5b,
-	0xcf, 0x41, 0xd1, 0x4d, 0xc8, 0x8e, 0x2f, 0x6f, 0xb1, 0xf4, 0xc9, 0x03, 0xb9, 0x18, 0xa1, 0xba,
-	0xa4, 0xaa, 0xa6, 0xb0, 0xe6, 0x8b, 0x54, 0xc5, 0x7f, 0xfb, 0x1b, 0x59, 0xfa, 0xf1, 0x87, 0xa7,
-	0x25, 0xe5, 0xe7, 0x5d, 0xd0, 0x1f, 0xbd, 0x77, 0xb1, 0x6e, 0xd9, 0x66, 0xa6, 0x04, 0x4e, 0x43,
-	0x81, 0xd3, 0x4b, 0xe6, 0x3e, 0x80, 0x83, 0x58, 0xca, 0xe3, 0x22, 0x0c, 0x26, 0x10, 0x34, 0x83,
-	0x60, 0x53, 0xa4, 0x3c, 0xd4, 0x81, 0x18, 0x6d, 0x89, 0x82, 0xd1, 0x4b, 0x50, 0x72, 0x79, 0x9a,
-	0xd1, 0xa9, 0x6a, 0x7e, 0xd3, 0x0f, 0x70, 0x8d, 0x89, 0xa4, 0x7f, 0x6e, 0x30, 0xa1, 0xf4, 0xeb,
-	0x9b, 0x54, 0x2e, 0xea, 0x40, 0x84, 0xba, 0xc9, 0x30, 0x69, 0xa4, 0xbd, 0x43, 0x6f, 0x74, 0x5b,
-	0x6b, 0x60, 0xe2, 0xd3, 0x75, 0xf3, 0x34, 0x49, 0x1f, 0x87, 0xde, 0xe5, 0x40, 0xba, 0x3b, 0xdb,
-	0x4d, 0x8f, 0x3a, 0xb0, 0xbe, 0x4b, 0x34, 0xcb, 0xa9, 0xb8, 0xcc, 0xb9, 0xce, 0xab, 0xfd, 0x31,
-	0x98, 0x9e, 0x7e, 0x34, 0x0a, 0xbd, 0x35, 0xf3, 0xb2, 0x5f, 0xaf, 0x31, 0xe7, 0x39, 0xaf, 0x8a,
-	0x27, 0x74, 0x1e, 0x8a, 0x7e, 0xe0, 0x92, 0x28, 0xdf, 0xc3, 0xf3, 0x1c, 0xdc, 0xca, 0x15, 0xc4,
-	0x08, 0x5d, 0xd3, 0xfc, 0xc0, 0xfb, 0xfb, 0x72, 0x61, 0x33, 0x06, 0x28, 0xff, 0x57, 0x82, 0xe1,
-	0xb4, 0x4c, 0xd7, 0x70, 0x6d, 0x0b, 0x13, 0x34, 0x9b, 0x34, 0x10, 0x49, 0x73, 0x94, 0xdc, 0xf9,
-	0x64, 0x9a, 0xed, 0x32, 0x1c, 0xa7, 0x4e, 0x8c, 0x29, 0x2c, 0xd8, 0x58, 0xd6, 0x14, 0xf6, 0x02,
-	0x31, 0x89, 0x63, 0xd3, 0xbb, 0xd5, 0xaa, 0x3a, 0x2e, 0xc1, 0x9a, 0x1f, 0xe8, 0x41, 0x18, 0x03,
-	0x15, 0x38, 0x6c, 0x93, 0x82, 0xe6, 0x6f, 0x7e, 0xb0, 0x2f, 0x3f, 0x17, 0x69, 0x09, 0xdd, 0xe3,
-	0xf8, 0x90, 0x27, 0x95, 0xa7, 0xed, 0x94, 0x67, 0x26, 0xdc, 0x0c, 0x18, 0x4c, 0xf3, 0x73, 0x47,
-	0xbd, 0x89, 0xce, 0x42, 0x3f, 0x63, 0x47, 0xa3, 0x51, 0x77, 0x22, 0xd3, 0x56, 0x64, 0xd0, 0x3b,
-	0xc4, 0x66, 0x8a, 0x73, 0x01, 0x72, 0x0d, 0xdd, 0xb6, 0x4c, 0x2b, 0x68, 0x66, 0x26, 0x7f, 0xa3,
-	0x51, 0xe5, 0xa7, 0xbd, 0x90, 0x8f, 0xde, 0xd2, 0x31, 0x93, 0x39, 0x9b, 0xcc, 0x64, 0x3e, 0x8a,
-	0x8c, 0x5f, 0x80, 0x5e, 0xc6, 0x50, 0x98, 0xcb, 0x7c, 0xa8, 0x90, 0x05, 0x3a, 0x55, 0x44, 0xdb,
-	0x32, 0xb0, 0xe3, 0x63, 0xea, 0xf8, 0x54, 0xac, 0xaa, 0x38, 0xd7, 0x7d, 0x02, 0x1a, 0xdb, 0xad,
-	0x34, 0x9a, 0x26, 0xd4, 0x8d, 0xab, 0xed, 0x50, 0x0a, 0x7b, 0x8d, 0xeb, 0xde, 0x72, 0xca, 0x19,
-	0xe0, 0x69, 0xba, 0xb3, 0x59, 0x7c, 0x1d, 0xe8, 0x05, 0x0c, 0xc3, 0x71, 0xbe, 0xff, 0x5c, 0xb1,
-	0xf9, 0x43, 0x9b, 0x72, 0xe4, 0xda, 0x94, 0x23, 0xe3, 0x8a, 0xcf, 0x77, 0xbc, 0xe2, 0xd1, 0x73,
-	0x30, 0x14, 0x9e, 0x93, 0xad, 0xba, 0xb1, 0x83, 0x03, 0x6e, 0x6b, 0x21, 0x71, 0x5c, 0x06, 0x05,
-	0xc2, 0x22, 0x1b, 0x67, 0x96, 0x79, 0x09, 0x4e, 0xb5, 0x48, 0x25, 0x75, 0xd8, 0x0a, 0x89, 0xd9,
-	0x72, 0x4a, 0x42, 0x89, 0x83, 0x36, 0x7e, 0xf5, 0x51, 0xbc, 0x80, 0xce, 0x97, 0xdc, 0xae, 0xd4,
-	0x7a, 0xdb, 0x7f, 0x6f, 0x4f, 0x96, 0x7e, 0xbc, 0x27, 0x4b, 0x9f, 0xee, 0xc9, 0xd2, 0x2f, 0xf6,
-	0x64, 0xe9, 0xfd, 0x7d, 0x59, 0x65, 0x22, 0x29, 0xdf, 0x6c, 0xd9, 0xa5, 0xcd, 0x7a, 0xad, 0xbc,
-	0x9c, 0x94, 0x43, 0x79, 0xb3, 0x75, 0x8d, 0xe9, 0x39, 0x09, 0xbe, 0x8f, 0x7a, 0xf4, 0x7e, 0xb0,
-	0x2f, 0x97, 0x1e, 0xe5, 0x38, 0xfe, 0xe9, 0x57, 0xec, 0x02, 0xe0, 0x1a, 0xb2, 0xe0, 0x59, 0x1f,
-	0x7e, 0x25, 0x4b, 0xca, 0xa7, 0x5d, 0xec, 0xf4, 0x08, 0xa5, 0x5c, 0x84, 0x5e, 0xe1, 0x4d, 0x3f,
-	0xc4, 0x18, 0x0d, 0xee, 0x3e, 0x90, 0xe3, 0x53, 0xc7, 0xf5, 0x9f, 0xcf, 0x6c, 0x51, 0xd2, 0xae,
-	0x2c, 0x25, 0x15, 0x69, 0xe4, 0x83, 0x94, 0xb4, 0xfd, 0x14, 0x75, 0x1f, 0xea, 0x14, 0xf5, 0x74,
-	0x3c, 0x45, 0x5f, 0x57, 0x3d, 0x4e, 0xbe, 0xbf, 0x2f, 0x0f, 0x65, 0xec, 0xbb, 0xf2, 0xab, 0x33,
-	0x10, 0x79, 0x70, 0x8f, 0xad, 0xa2, 0xf2, 0x0a, 0xe4, 0x58, 0xe2, 0x25, 0xbc, 0xd0, 0x0a, 0x73,
-	0x93, 0x33, 0xa6, 0xe5, 0x07, 0xc4, 0xda, 0xaa, 0x07, 0xd8, 0xd4, 0x6a, 0x7a, 0x60, 0x6c, 0x6b,
-	0xd8, 0xa9, 0x5a, 0x0e, 0x9e, 0xb9, 0xe9, 0x1a, 0x62, 0x6e, 0x34, 0x89, 0x5e, 0xdb, 0xef, 0xba,
-	0x0e, 0x96, 0x17, 0xf8, 0xb5, 0x4d, 0x7f, 0xa3, 0x4b, 0x00, 0x96, 0x17, 0x85, 0xb8, 0xbd, 0xec,
-	0x8e, 0x1d, 0x4e, 0x3a, 0xfe, 0x9e, 0x08, 0x73, 0xd5, 0xbc, 0xe5, 0x25, 0x22, 0x5e, 0x6a, 0x19,
-	0x2c, 0x43, 0xb3, 0x3c, 0x5f, 0xd8, 0x8e, 0x3c, 0x87, 0xac, 0x78, 0x3e, 0x7a, 0x12, 0x06, 0x9c,
-	0x7a, 0x4d, 0x33, 0x9b, 0x8e, 0x5e, 0x13, 0x38, 0x39, 0xe6, 0xf6, 0xf4, 0x39, 0xf5, 0xda, 0x32,
-	0x87, 0x52, 0xbc, 0x6b, 0x50, 0x08, 0xac, 0x1a, 0xd6, 0x6c, 0x56, 0x44, 0x64, 0x16, 0xa4, 0x30,
-	0x37, 0x95, 0xbc, 0xe0, 0xdb, 0x4b, 0x8d, 0x62, 0x51, 0x10, 0xc4, 0xc5, 0xc7, 0x73, 0xd0, 0x8b,
-	0x09, 0x71, 0x89, 0x2f, 0x03, 0x95, 0xef, 0x62, 0x1f, 0xb5, 0x09, 0x71, 0x2e, 0x5a, 0x0c, 0xa2,
-	0x4b, 0xa1, 0xad, 0x2b, 0xb2, 0x45, 0x26, 0xf5, 0xf9, 0x36, 0xd1, 0x8d, 0x1d, 0x6c, 0xb2, 0x73,
-	0x2c, 0x4c, 0x8a, 0x30, 0x85, 0xaf, 0x40, 0x91, 0x45, 0xd7, 0x0d, 0x4c, 0x88, 0x65, 0x62, 0x96,
-	0xc7, 0xe9, 0x4f, 0x6f, 0x96, 0xba, 0xb6, 0x2e, 0x46, 0xc3, 0xab, 0xdf, 0x20, 0xb5, 0x10, 0x84,
-	0x66, 0xa1, 0x94, 0xc8, 0xfa, 0xf3, 0x14, 0x5c, 0x7f, 0xc2, 0x54, 0x0e, 0xc4, 0xa3, 0x3c, 0x05,
-	0xf7, 0x62, 0x6b, 0xb2, 0x74, 0x80, 0x19, 0xba, 0xe1, 0xdd, 0x07, 0x72, 0x5b, 0x66, 0xb5, 0x25,
-	0x85, 0x7a, 0x19, 0x44, 0xc1, 0x48, 0xf3, 0x89, 0x48, 0xab, 0x97, 0xb8, 0x6f, 0x98, 0x96, 0x48,
-	0x1f, 0xc7, 0xda, 0x24, 0x3c, 0xc9, 0xfe, 0x12, 0xf4, 0x72, 0x7f, 0x97, 0x25, 0x58, 0x0a, 0xa9,
-	0xed, 0xbf, 0xce, 0x06, 0xa8, 0x22, 0xf6, 0xef, 0x3e, 0x90, 0x7b, 0xf9, 0x23, 0x3f, 0xe3, 0x7c,
-	0x0e, 0x4b, 0xee, 0x6e, 0x37, 0x7d, 0xcb, 0xa0, 0x4e, 0x37, 0x35, 0xeb, 0x48, 0x24, 0x77, 0x05,
-	0x90, 0xd9, 0xf2, 0x2b, 0x71, 0x45, 0x69, 0x88, 0x59, 0x81, 0xd3, 0x19, 0xea, 0x9e, 0x59, 0x4b,
-	0x7a, 0x1a, 0x06, 0xe3, 0xaa, 0x5c, 0xe8, 0xce, 0xf1, 0x92, 0x56, 0x29, 0x1a, 0x08, 0x3d, 0xba,
-	0x3f, 0x82, 0x5e, 0x61, 0x21, 0x46, 0xda, 0xbc, 0xa1, 0x74, 0xdd, 0x6a, 0x31, 0x47, 0x45, 0xc2,
-	0x17, 0xc2, 0xa7, 0xa0, 0x7b, 0x50, 0x20, 0xd8, 0xd7, 0x02, 0xbd, 0xaa, 0xd5, 0x74, 0x4f, 0x04,
-	0xeb, 0x4a, 0x16, 0x9f, 0x3c, 0x96, 0x5a, 0xd3, 0x3d, 0x1e, 0x5f, 0x0d, 0x51, 0x52, 0xad, 0x31,
-	0x56, 0x9e, 0x84, 0x48, 0x68, 0x33, 0x9d, 0x05, 0xe0, 0x81, 0xfb, 0x13, 0x59, 0x84, 0x5b, 0x02,
-	0xe1, 0xd6, 0x7d, 0x4b, 0x26, 0x03, 0x2e, 0x40, 0x29, 0x2a, 0x36, 0x86, 0x62, 0xe1, 0xa5, 0x9b,
-	0xfe, 0x06, 0xaf, 0x33, 0x86, 0x42, 0x99, 0x02, 0x88, 0x75, 0x4c, 0xd4, 0x59, 0x12, 0x10, 0xb4,
-	0x04, 0x25, 0xd6, 0x41, 0xc0, 0xeb, 0x45, 0xec, 0x0d, 0x2c, 0x45, 0xd5, 0x9f, 0x12, 0x1f, 0x8b,
-	0xb3, 0x16, 0x3c, 0x8b, 0xb3, 0xa8, 0xf6, 0x5b, 0xa9, 0x67, 0x7a, 0x4e, 0x38, 0x11, 0x21, 0xff,
-	0x89, 0x36, 0xa3, 0x96, 0x08, 0xd4, 0xc4, 0x19, 0x2e, 0x58, 0x89, 0xd8, 0xed, 0x1e, 0x0c, 0xd6,
-	0x74, 0xcb, 0x61, 0x69, 0x7d, 0x23, 0x74, 0x3c, 0xa6, 0x18, 0x1b, 0x17, 0x3b, 0x5b, 0xb9, 0xb5,
-	0x78, 0x0a, 0x3b, 0xbc, 0x6a, 0xa9, 0xd6, 0x02, 0x41, 0x2b, 0x70, 0x26, 0x3c, 0xbd, 0x22, 0x77,
-	0xaf, 0xb5, 0x2b, 0x14, 0x4f, 0x63, 0x4d, 0x85, 0x88, 0x3c, 0x91, 0xbf, 0xd4, 0xaa, 0x5e, 0x4f,
-	0xc0, 0x89, 0x30, 0xe7, 0x3c, 0xcd, 0xce, 0x15, 0xd0, 0x33, 0x71, 0x77, 0x6d, 0xc3, 0x75, 0x6d,
-	0xb5, 0xb7, 0xc1, 0xb3, 0xcf, 0xaf, 0xc2, 0x48, 0xb2, 0x4a, 0xc6, 0xab, 0x56, 0xd4, 0xce, 0x9f,
-	0xc9, 0x3a, 0x8a, 0x28, 0x2e, 0xe1, 0x31, 0x4c, 0x1a, 0xd7, 0xbd, 0x0e, 0xa7, 0x13, 0x14, 0x76,
-	0x70, 0x53, 0xab, 0x7b, 0x55, 0xa2, 0x9b, 0x38, 0xac, 0x08, 0x98, 0x3c, 0xc1, 0x25, 0x2c, 0xc8,
-	0xa9, 0x88, 0xc4, 0x2a, 0x6e, 0xde, 0xe1, 0x98, 0xa2, 0x26, 0x60, 0xa2, 0x3f, 0x06, 0xe0, 0x4d,
-	0x08, 0xa6, 0xa6, 0x07, 0x2c, 0xdd, 0x45, 0x55, 0xaf, 0xa3, 0x3c, 0xa9, 0x9d, 0xf5, 0x03, 0xbd,
-	0xe6, 0x2d, 0x8e, 0x08, 0x3e, 0xf3, 0x41, 0x08, 0x62, 0x7b, 0x96, 0x17, 0xd4, 0x16, 0x02, 0x4a,
-	0x9a, 0xb7, 0x26, 0x30, 0xd2, 0x67, 0xbf, 0x3e, 0x69, 0x41, 0x6d, 0x21, 0x40, 0x73, 0x50, 0x4c,
-	0x15, 0x5b, 0xce, 0x31, 0xd1, 0xb1, 0x3c, 0x46, 0xa2, 0xd0, 0xa2, 0x16, 0x82, 0x44, 0xd5, 0x65,
-	0x15, 0x50, 0x72, 0x8e, 0xd0, 0xa0, 0x27, 0x1f, 0xc5, 0xd6, 0x97, 0x12, 0x74, 0xb8, 0xd2, 0xdc,
-	0x80, 0x81, 0x74, 0x76, 0xcc, 0x97, 0xcf, 0xb7, 0xe5, 0xc4, 0x52, 0x59, 0x01, 0xa1, 0xd3, 0xfd,
-	0xa9, 0x9c, 0x98, 0x8f, 0x6e, 0xc0, 0xb4, 0x89, 0x2b, 0xac, 0x70, 0x1c, 0x11, 0x6c, 0x4d, 0x09,
-	0x5c, 0x60, 0x77, 0xe3, 0xa4, 0xc0, 0x0b, 0xa9, 0x2e, 0xa4, 0x33, 0x04, 0x97, 0xa1, 0xff, 0x35,
-	0xd7, 0x0f, 0x44, 0x82, 0xd4, 0xc6, 0x44, 0x7e, 0x2a, 0x4b, 0x9f, 0x5a, 0x90, 0xa8, 0x75, 0xde,
-	0xd1, 0x2b, 0x3b, 0x7a, 0x94, 0xfd, 0xbe, 0xc8, 0xad, 0x33, 0x03, 0x86, 0xe9, 0xee, 0x49, 0x00,
-	0x8e, 0x54, 0xf7, 0x31, 0x91, 0x9f, 0xe6, 0xd7, 0x39, 0x83, 0xdc, 0xf1, 0x31, 0x61, 0xe1, 0x34,
-	0x1b, 0xf6, 0x74, 0xdf, 0xbf, 0xef, 0x12, 0x53, 0x2e, 0x8b, 0x70, 0x9a, 0x42, 0x37, 0x04, 0x10,
-	0xbd, 0x08, 0x50, 0xf5, 0xea, 0xa1, 0x01, 0x78, 0xa6, 0xed, 0x2a, 0x89, 0x9c, 0x3d, 0x21, 0xaa,
-	0x7c, 0xd5, 0xab, 0x8b, 0xc3, 0xbf, 0x02, 0x67, 0xb0, 0x43, 0xcd, 0xa6, 0x16, 0x0a, 0xcb, 0xc7,
-	0xa4, 0x81, 0x89, 0x4d, 0x0f, 0x40, 0xc8, 0xf9, 0x0c, 0x3f, 0xa3, 0x1c, 0x71, 0x99, 0xe3, 0x6d,
-	0x46, 0x68, 0xe1, 0x5a, 0x9e, 0x80, 0x3e, 0xdd, 0xb6, 0x2d, 0x66, 0x44, 0x5c, 0x52, 0xf5, 0xe5,
-	0x59, 0xe6, 0x73, 0x15, 0x43, 0xe0, 0x3a, 0xa9, 0x52, 0xc7, 0xe3, 0x74, 0xc7, 0x52, 0x8d, 0xe6,
-	0xde, 0x77, 0x30, 0x91, 0xbf, 0xc5, 0x96, 0x38, 0xe1, 0x67, 0x97, 0x6b, 0xd6, 0x29, 0x4e, 0x46,
-	0x10, 0xf4, 0x6c, 0xe7, 0x20, 0xe8, 0x25, 0x18, 0xef, 0x5c, 0x38, 0x91, 0xe7, 0xd8, 0xe2, 0x64,
-	0xaf, 0x43, 0x99, 0x04, 0x6d, 0xc2, 0xe9, 0xec, 0x2a, 0x7c, 0x6c, 0x5f, 0x2e, 0x65, 0xe9, 0xc3,
-	0xa9, 0x8c, 0x42, 0x7c, 0x64, 0x68, 0xfe, 0x06, 0x3c, 0x95, 0x49, 0x34, 0xd3, 0xe4, 0x3c, 0x97,
-	0x58, 0xda, 0xd9, 0x76, 0xaa, 0x19, 0xb6, 0xe7, 0x35, 0x18, 0x8b, 0xc9, 0xb7, 0x3a, 0x26, 0x97,
-	0xb3, 0xb8, 0x1d, 0x8d, 0xf0, 0x6f, 0xa5, 0x3c, 0x94, 0x33, 0x90, 0x37, 0x1d, 0x5f, 0xb3, 0xf5,
-	0x2d, 0x6c, 0xcb, 0xcf, 0x27, 0x02, 0xbf, 0x9c, 0xe9, 0xf8, 0x37, 0x29, 0x14, 0x3d, 0x09, 0x45,
-	0x91, 0xd4, 0xd7, 0x2a, 0x6f, 0x9b, 0x8e, 0xfc, 0x42, 0x02, 0x0b, 0x08, 0xcb, 0xed, 0x5f, 0x7f,
-	0xdb, 0x74, 0xd0, 0x25, 0x1a, 0x8b, 0x32, 0xd7, 0x35, 0x85, 0xfe, 0x4a, 0x02, 0xbd, 0xc4, 0x11,
-	0xd4, 0x78, 0x92, 0x0a, 0x83, 0xe9, 0xa2, 0x3c, 0xd5, 0xf0, 0x2b, 0x4c, 0xc3, 0x4f, 0x25, 0x9d,
-	0xa5, 0x96, 0x62, 0x7e, 0xc2, 0xc9, 0x28, 0x55, 0x5a, 0x0b, 0xfd, 0x0f, 0x09, 0x6f, 0x5f, 0x7c,
-	0x94, 0xf0, 0x16, 0xbd, 0x0a, 0x7d, 0xfc, 0xda, 0xe5, 0xce, 0x98, 0x2f, 0xcf, 0x33, 0x2b, 0x35,
-	0xd2, 0xe6, 0xc1, 0xad, 0x38, 0x15, 0x57, 0x50, 0xe3, 0x17, 0x35, 0x07, 0xb3, 0x22, 0x8b, 0xa8,
-	0x5c, 0xf1, 0x02, 0xf5, 0x1f, 0xf1, 0x58, 0x5f, 0xc0, 0x58, 0x55, 0x7a, 0x0a, 0x0a, 0xc9, 0x92,
-	0xd4, 0x55, 0x5e, 0xe0, 0x32, 0xa2, 0x52, 0xd4, 0x59, 0xe8, 0x75, 0xb7, 0xde, 0xd2, 0x2c, 0x53,
-	0x7e, 0x39, 0x6b, 0x53, 0x8f, 0xbb, 0x5b, 0x6f, 0xad, 0x98, 0xe8, 0x3a, 0x14, 0x12, 0x9d, 0x93,
-	0xf2, 0xab, 0x6d, 0xc1, 0x60, 0xec, 0x05, 0xc5, 0x68, 0xdc, 0x17, 0x4c, 0x4e, 0x44, 0xcf, 0x40,
-	0xc1, 0xdc, 0x62, 0xdd, 0x3e, 0x36, 0x7d, 0xe5, 0x22, 0x35, 0x9e, 0xad, 0xaf, 0xcc, 0x9b, 0x5b,
-	0x6b, 0x14, 0x61, 0xc5, 0xfc, 0x1a, 0xbd, 0x1a, 0xe3, 0xf7, 0xa0, 0x3f, 0xed, 0xe9, 0x65, 0xcc,
-	0x9e, 0x4d, 0x17, 0x10, 0xc6, 0xd2, 0xd7, 0x43, 0xe8, 0x0d, 0xae, 0xe2, 0x66, 0x92, 0xf0, 0xd5,
-	0x47, 0x29, 0x79, 0x74, 0xe6, 0xeb, 0x65, 0x28, 0xb5, 0x8a, 0xe8, 0x50, 0x51, 0xeb, 0xcf, 0x7a,
-	0x0e, 0x4a, 0x6a, 0x7c, 0xc6, 0x93, 0x1a, 0xef, 0x75, 0xdf, 0x14, 0x61, 0xe3, 0xcc, 0x6b, 0x2e,
-	0xb1, 0xde, 0xa5, 0xbe, 0x90, 0xbd, 0x60, 0x18, 0x75, 0xa2, 0x1b, 0xcd, 0x72, 0x34, 0x76, 0x97,
-	0x86, 0xce, 0x46, 0xd6, 0xc8, 0x92, 0x5b, 0x27, 0x3e, 0x8e, 0x9f, 0x37, 0x3d, 0x8c, 0xcd, 0xf8,
-	0x31, 0x72, 0x07, 0xca, 0x5c, 0xab, 0xcb, 0x3c, 0x89, 0x72, 0x8d, 0xc5, 0x6a, 0xe5, 0x76, 0x63,
-	0x55, 0x3e, 0xc0, 0xd2, 0x94, 0x37, 0x3b, 0x1b, 0xb9, 0x8c, 0xb1, 0x0c, 0x02, 0x4b, 0xa1, 0x57,
-	0x53, 0xbe, 0x13, 0x3a, 0x21, 0xe5, 0xdb, 0x2d, 0x4e, 0x41, 0x39, 0x7d, 0xb5, 0xb6, 0xe4, 0x76,
-	0x6e, 0x84, 0x97, 0xd9, 0x4c, 0x66, 0x1e, 0x48, 0x98, 0xa9, 0x72, 0x6c, 0x54, 0xd8, 0x82, 0x93,
-	0x56, 0xe6, 0xa0, 0x64, 0xd0, 0x1f, 0xa0, 0xce, 0x92, 0x95, 0x36, 0xfa, 0xf0, 0x2b, 0x59, 0x7a,
-	0xbd, 0x27, 0xf7, 0x52, 0xe9, 0xaa, 0xf2, 0xc3, 0x2e, 0x28, 0x70, 0x1b, 0xb2, 0x46, 0xbd, 0xbc,
-	0x30, 0x99, 0x21, 0x3d, 0x6a, 0x32, 0xa3, 0xa5, 0xee, 0xd2, 0xdd, 0x5a, 0x77, 0xa1, 0x81, 0x5f,
-	0xaa, 0xb5, 0x80, 0x65, 0x2e, 0x78, 0x2a, 0xa7, 0x94, 0x1c, 0x78, 0xd3, 0x75, 0xf0, 0xfc, 0xdf,
-	0x93, 0x3e, 0xd8, 0x97, 0xab, 0x87, 0x15, 0x12, 0x7b, 0xd9, 0xd5, 0xeb, 0xd1, 0x3b, 0x1f, 0x53,
-	0x79, 0x0a, 0x62, 0x8a, 0xca, 0x4c, 0xdc, 0x61, 0xbb, 0xa6, 0x3b, 0x56, 0x05, 0xfb, 0x01, 0x1a,
-	0x87, 0x5c, 0x4d, 0xfc, 0x16, 0x87, 0x33, 0x7a, 0x56, 0xfe, 0xa3, 0x04, 0xc5, 0x64, 0xe5, 0x31,
-	0xb3, 0xd4, 0x32, 0x0d, 0x05, 0x13, 0xfb, 0x06, 0xb1, 0xbc, 0xb8, 0xa8, 0xa3, 0x26, 0x41, 0xf1,
-	0xe1, 0xef, 0x4e, 0x1c, 0x7e, 0x34, 0x0a, 0xbd, 0x3e, 0x36, 0x08, 0x0e, 0x44, 0xf7, 0x92, 0x78,
-	0x42, 0x13, 0x90, 0xaf, 0xe9, 0x8e, 0xa9, 0x07, 0x2e, 0x09, 0x3b, 0x94, 0x62, 0x00, 0x65, 0xd7,
-	0x12, 0x8d, 0xba, 0xa2, 0xf9, 0x28, 0x7a, 0xa6, 0xbb, 0x18, 0xb8, 0x81, 0xa7, 0x09, 0xb2, 0xbc,
-	0xb7, 0x08, 0x28, 0x68, 0x93, 0x41, 0x94, 0xdf, 0x49, 0xd0, 0x17, 0x0a, 0x80, 0x35, 0xf4, 0x3e,
-	0x5a, 0x33, 0xd8, 0x6b, 0x19, 0x89, 0xc3, 0x0b, 0x19, 0x4a, 0xc5, 0x48, 0x1e, 0x98, 0x3c, 0x54,
-	0x5a, 0x2a, 0x60, 0x5c, 0x20, 0x29, 0xd8, 0x37, 0x55, 0x2a, 0x56, 0x3e, 0x92, 0x60, 0x3c, 0x51,
-	0x98, 0x4d, 0xd7, 0xca, 0x1f, 0x51, 0x12, 0x2f, 0x67, 0x48, 0xe2, 0x61, 0x85, 0xf9, 0x43, 0xae,
-	0x5f, 0xf9, 0x69, 0x17, 0x8c, 0xb4, 0xf2, 0x79, 0xc7, 0xd7, 0xab, 0xf8, 0x28, 0xa7, 0x9a, 0xfb,
-	0x23, 0x75, 0x3a, 0x5d, 0x74, 0xe5, 0x01, 0x03, 0x71, 0x82, 0x73, 0xd0, 0xc3, 0x0a, 0x6d, 0xdd,
-	0x8f, 0xb4, 0x10, 0x86, 0x4b, 0xe3, 0x8f, 0x28, 0x76, 0xf2, 0x0d, 0x97, 0x70, 0x33, 0xd0, 0xa3,
-	0x46, 0x25, 0xd3, 0x4d, 0x0a, 0x9c, 0x6f, 0xfc, 0x61, 0xec, 0xa4, 0xb2, 0x13, 0x1f, 0xf1, 0x1b,
-	0x1b, 0x77, 0x8e, 0x26, 0xb7, 0xf3, 0xd0, 0x53, 0xf5, 0xea, 0xe1, 0xfe, 0x0e, 0xa5, 0xa3, 0x26,
-	0x46, 0x52, 0x65, 0x08, 0xca, 0xdf, 0xee, 0x82, 0xa1, 0x90, 0xc6, 0x42, 0x1c, 0xd2, 0x1c, 0xfa,
-	0x85, 0x4a, 0x56, 0x61, 0xb8, 0xa5, 0x0c, 0xfc, 0x7d, 0x6a, 0x54, 0x9d, 0x43, 0x4a, 0x34, 0x8c,
-	0xb3, 0xe8, 0xa0, 0xfb, 0xf8, 0x4b, 0xff, 0xc5, 0x94, 0xe8, 0xdf, 0xeb, 0x02, 0x88, 0xfd, 0xdb,
-	0x8e, 0x85, 0x79, 0x83, 0x8b, 0x96, 0x17, 0xe6, 0xe9, 0x03, 0x3d, 0xde, 0x44, 0xaf, 0x89, 0x66,
-	0x57, 0xfa, 0x93, 0xce, 0x35, 0x2d, 0x7f, 0x47, 0xa8, 0x16, 0xfb, 0x8d, 0x2e, 0x8a, 0x5d, 0xe1,
-	0xd5, 0xb5, 0xd1, 0xf4, 0xae, 0x84, 0xaa, 0xca, 0x37, 0x06, 0x2d, 0x41, 0x8e, 0x9e, 0x3a, 0x96,
-	0x3a, 0x3c, 0xde, 0x96, 0x3a, 0x8c, 0x99, 0x64, 0xc6, 0x22, 0x4a, 0x1d, 0x72, 0x8f, 0xfc, 0x84,
-	0xc7, 0x61, 0xe3, 0xf3, 0xdc, 0xf8, 0x1f, 0xe0, 0x6f, 0x76, 0xf4, 0xea, 0x94, 0xcb, 0x70, 0x62,
-	0x7d, 0x73, 0x81, 0xde, 0x86, 0x99, 0x72, 0xa0, 0xb6, 0x3f, 0xd0, 0x03, 0x21, 0x88, 0xbc, 0x2a,
-	0x9e, 0x14, 0x42, 0xa7, 0xf1, 0x4e, 0xe8, 0xac, 0x69, 0x08, 0x7a, 0x02, 0xbd, 0x1a, 0x4e, 0x62,
-	0xbf, 0xd1, 0x54, 0xca, 0x24, 0x89, 0x9b, 0x3b, 0x61, 0x72, 0x4e, 0x43, 0x81, 0x8a, 0x4f, 0xa3,
-	0x36, 0x4c, 0x0f, 0xc4, 0x9d, 0x0d, 0x14, 0x74, 0x9d, 0x41, 0x94, 0xef, 0x17, 0xa1, 0x18, 0x7f,
-	0x03, 0xc2, 0x0b, 0xec, 0x8f, 0xa5, 0x42, 0x72, 0x35, 0x4c, 0xf1, 0x77, 0xb3, 0xb4, 0xcf, 0xf9,
-	0xce, 0xd9, 0xa8, 0x90, 0x00, 0xcf, 0x1a, 0x8a, 0x64, 0xff, 0x93, 0x90, 0x17, 0x61, 0xaa, 0x65,
-	0x8a, 0x0f, 0x7a, 0x12, 0x3d, 0xed, 0x39, 0x3e, 0xb6, 0x62, 0xa2, 0xa7, 0x00, 0x8c, 0x38, 0x0f,
-	0x73, 0xbc, 0xb5, 0xf9, 0x3d, 0x31, 0x88, 0x26, 0x00, 0x5c, 0x5f, 0xab, 0xe9, 0xef, 0x68, 0x54,
-	0xdf, 0x7a, 0x99, 0x72, 0xe5, 0x5c, 0x7f, 0x4d, 0x7f, 0x47, 0xd5, 0x6b, 0x48, 0x81, 0x3e, 0x31,
-	0xda, 0xa0, 0x26, 0x8c, 0x97, 0x52, 0x7a, 0xd4, 0x02, 0x43, 0xb8, 0xcb, 0x40, 0xe8, 0x4c, 0x8c,
-	0xe3, 0xda, 0x5a, 0x75, 0x8b, 0x95, 0x52, 0x7a, 0x54, 0xe0, 0x38, 0xae, 0x7d, 0x63, 0x8b, 0x8a,
-	0x4f, 0x14, 0x40, 0xf2, 0x5c, 0x7c, 0xa2, 0xe2, 0x31, 0x0b, 0x27, 0xc2, 0xb8, 0x10, 0x0e, 0x88,
-	0x0b, 0xd5, 0x10, 0x0b, 0xbd, 0x1a, 0x29, 0x49, 0x81, 0x89, 0x3c, 0x89, 0xbf, 0xc9, 0x06, 0x58,
-	0x1c, 0x39, 0xf8, 0xbd, 0xaf, 0x12, 0xd1, 0x16, 0x4f, 0xa2, 0xf3, 0x79, 0xd9, 0xe9, 0xfa, 0x62,
-	0x87, 0x74, 0xfd, 0x02, 0xa0, 0x36, 0x17, 0xcf, 0x97, 0xfb, 0x18, 0xab, 0x28, 0xd5, 0xe7, 0xc1,
-	0xf4, 0x5a, 0x1d, 0x6c, 0xf5, 0xfb, 0xe8, 0x12, 0xf3, 0xae, 0x68, 0x1d, 0xf6, 0xe5, 0xfe, 0x8c,
-	0x99, 0x4c, 0xb5, 0xa9, 0xc8, 0xd9, 0x0f, 0x1f, 0xcd, 0xc3, 0x58, 0xbc, 0x3d, 0x1a, 0xff, 0xec,
-	0x81, 0x60, 0x03, 0x5b, 0x0d, 0x6c, 0x8a, 0x96, 0xd8, 0x93, 0x31, 0xc2, 0x12, 0x1d, 0x57, 0xc5,
-	0x70, 0x76, 0x8e, 0xba, 0xf4, 0x18, 0x72, 0xd4, 0x6f, 0x02, 0x8a, 0xbe, 0xb4, 0xd3, 0x7c, 0x47,
-	0xf7, 0xfc, 0x6d, 0x37, 0x10, 0xd5, 0x98, 0x33, 0x9d, 0xee, 0x48, 0x7f, 0x53, 0x20, 0x26, 0xd2,
-	0x0c, 0x83, 0xa4, 0x75, 0x10, 0x5d, 0xcb, 0xcc, 0x8b, 0xa2, 0x03, 0xf3, 0xa2, 0x19, 0x19, 0xd1,
-	0x97, 0x61, 0xc4, 0x70, 0x6b, 0x9e, 0x1e, 0x58, 0x62, 0xb3, 0xc2, 0xcd, 0x1d, 0x9a, 0x96, 0x2e,
-	0xf4, 0x25, 0xd5, 0x7f, 0x38, 0x85, 0x17, 0xee, 0xf5, 0x8d, 0x94, 0xd1, 0x18, 0x66, 0x3b, 0x75,
-	0x3e, 0xf3, 0x9b, 0xb0, 0x8a, 0x7b, 0xa0, 0x43, 0x37, 0x07, 0xc0, 0xfa, 0x4f, 0xa9, 0x6b, 0xe0,
-	0xcb, 0x23, 0x6d, 0x17, 0xe6, 0x2d, 0xd7, 0xc4, 0x4c, 0xab, 0xd9, 0xe7, 0x04, 0xf4, 0x17, 0x6b,
-	0x13, 0xd5, 0x8d, 0xc0, 0x6a, 0x60, 0x96, 0xe5, 0xb2, 0x1c, 0x3f, 0xa0, 0xb2, 0xe7, 0x1f, 0xd4,
-	0xa8, 0x83, 0x7c, 0x68, 0x89, 0xd4, 0x56, 0xc4, 0x00, 0xb5, 0x60, 0xf4, 0x97, 0xb9, 0xc5, 0xd2,
-	0x62, 0xec, 0xfb, 0x99, 0x9c, 0x0a, 0x02, 0xb4, 0x44, 0x6a, 0xe8, 0x3c, 0x0c, 0x10, 0x6c, 0x63,
-	0xdd, 0x6f, 0x2b, 0xbe, 0x08, 0x70, 0xb8, 0xec, 0x90, 0x5b, 0xde, 0x2d, 0x3b, 0x93, 0xc9, 0x2d,
-	0x2b, 0x1f, 0x30, 0x6e, 0x59, 0xcb, 0xec, 0xd7, 0x2d, 0x4a, 0xff, 0xab, 0xb6, 0x9e, 0x85, 0x0f,
-	0xf7, 0x64, 0xe9, 0x93, 0x3d, 0xb9, 0x2f, 0x65, 0xf4, 0x68, 0x9c, 0xff, 0x6b, 0x1e, 0xeb, 0xf7,
-	0xf2, 0xb3, 0xfd, 0x07, 0x8b, 0x33, 0x59, 0xfb, 0xe4, 0xc7, 0x5f, 0xc5, 0x4d, 0x8f, 0xca, 0x13,
-	0x30, 0x10, 0x85, 0x4d, 0x38, 0x20, 0x96, 0xc1, 0xae, 0xec, 0x8a, 0xeb, 0x32, 0x6b, 0xdb, 0xa3,
-	0xd2, 0x9f, 0x4a, 0x0d, 0xc6, 0xb2, 0x1b, 0x6f, 0x57, 0x99, 0x27, 0x9a, 0x6c, 0x8e, 0x2b, 0x7c,
-	0xf2, 0x40, 0x3e, 0x61, 0xd4, 0x0c, 0x0a, 0x12, 0x37, 0xdb, 0x18, 0x74, 0x59, 0xa6, 0x68, 0x88,
-	0xcb, 0x7f, 0xf2, 0x40, 0x3e, 0x6e, 0xd4, 0x0c, 0xcb, 0x54, 0xbb, 0x2c, 0x73, 0x7e, 0x38, 0xec,
-	0x7f, 0x0b, 0x79, 0x63, 0x7d, 0x70, 0x7b, 0xc7, 0x61, 0x34, 0xfb, 0x7d, 0x1d, 0xef, 0xaf, 0x97,
-	0x92, 0xf7, 0x57, 0x56, 0xae, 0xab, 0x8d, 0xef, 0x74, 0xbd, 0xbf, 0x18, 0x75, 0x20, 0x53, 0x32,
-	0xdd, 0x8f, 0x70, 0x0d, 0x16, 0x8c, 0x18, 0x84, 0x5e, 0x82, 0x42, 0x98, 0xd4, 0xa6, 0xf3, 0x7b,
-	0xda, 0x6c, 0x7a, 0xdb, 0x7b, 0xc1, 0x88, 0x25, 0x38, 0x05, 0x40, 0xb0, 0x8f, 0x49, 0x43, 0xdf,
-	0x12, 0x1f, 0x1a, 0xe6, 0xd4, 0x04, 0x04, 0x9d, 0x81, 0x62, 0xf2, 0x53, 0x1c, 0x11, 0x1b, 0x16,
-	0x6a, 0xf1, 0xa7, 0x37, 0xe8, 0x12, 0xa0, 0x44, 0x82, 0x3d, 0x3c, 0x29, 0x27, 0x92, 0x7d, 0x3f,
-	0xf1, 0x78, 0x7c, 0x64, 0x10, 0xff, 0x44, 0x4f, 0x73, 0x89, 0x16, 0x35, 0x3c, 0x24, 0x7b, 0xeb,
-	0x4a, 0x7c, 0x7c, 0x9d, 0x84, 0xd9, 0x24, 0xf4, 0x74, 0x22, 0x44, 0xa8, 0x12, 0xb7, 0xee, 0xb1,
-	0x66, 0x82, 0x10, 0x3f, 0x0a, 0x14, 0x6e, 0xd0, 0x21, 0x34, 0x1e, 0x7a, 0x09, 0xc9, 0x06, 0x24,
-	0xe1, 0x02, 0x3c, 0x0b, 0x83, 0x6e, 0xd8, 0x74, 0xa0, 0xdb, 0xc2, 0x58, 0x26, 0x5b, 0x8d, 0x4a,
-	0x89, 0x61, 0xde, 0x27, 0xf7, 0xdf, 0xd9, 0x79, 0xfb, 0x60, 0x5f, 0xfe, 0x97, 0x52, 0xba, 0x53,
-	0xc7, 0x32, 0xd9, 0xef, 0x15, 0xb3, 0x2c, 0xa4, 0x7a, 0x35, 0x96, 0x77, 0x78, 0xb6, 0xd8, 0x33,
-	0x3d, 0x3c, 0x89, 0xa1, 0xd4, 0x09, 0x8b, 0x0e, 0x64, 0x62, 0xaf, 0xdb, 0x0f, 0x66, 0x72, 0xb0,
-	0xf3, 0x01, 0x4d, 0x62, 0x75, 0x3a, 0xa8, 0x03, 0x11, 0x7d, 0xce, 0xfb, 0xc5, 0x79, 0xe8, 0x4f,
-	0xd7, 0x7e, 0xd1, 0x20, 0xf4, 0x2d, 0xaf, 0xa8, 0xd7, 0x96, 0x6e, 0x6b, 0x0b, 0x4b, 0x4b, 0xd7,
-	0x36, 0x37, 0x4b, 0xc7, 0xd0, 0x08, 0x0c, 0xaa, 0xd7, 0x36, 0x6f, 0xab, 0x2b, 0x4b, 0xb7, 0xaf,
-	0x2d, 0x87, 0x60, 0xe9, 0x62, 0x19, 0x7a, 0x79, 0x8f, 0x26, 0xca, 0xc3, 0xf1, 0x9b, 0x2b, 0xb7,
-	0xee, 0xfc, 0xb5, 0xd2, 0x31, 0x54, 0x80, 0x13, 0xf7, 0x56, 0x6e, 0x2d, 0xaf, 0xdf, 0xdb, 0x2c,
-	0x49, 0x08, 0xa0, 0x77, 0xfd, 0xf6, 0x6b, 0xd7, 0xd4, 0xcd, 0xd2, 0xf0, 0xc5, 0xeb, 0xd0, 0xaf,
-	0x62, 0xcf, 0x25, 0xc1, 0xa6, 0xb1, 0x8d, 0xcd, 0xba, 0x8d, 0x51, 0x1f, 0xe4, 0xaf, 0x35, 0x30,
-	0x69, 0xde, 0xc3, 0x78, 0xa7, 0x74, 0x0c, 0x0d, 0x40, 0x81, 0x3d, 0x3e, 0x7b, 0x79, 0x59, 0x6f,
-	0xfa, 0x25, 0x09, 0xf5, 0x03, 0x30, 0xc0, 0x9a, 0xeb, 0x04, 0xdb, 0xa5, 0xee, 0xf1, 0x9e, 0xcf,
-	0x1f, 0xc8, 0xc7, 0xe6, 0xbe, 0xd3, 0x03, 0x43, 0xad, 0x9d, 0x12, 0x0b, 0x9e, 0x85, 0xfe, 0x91,
-	0x04, 0xc3, 0x9b, 0xdb, 0xee, 0xfd, 0xb6, 0xef, 0xd3, 0x4e, 0x1d, 0xd0, 0x44, 0x3f, 0x7e, 0xd0,
-	0xa0, 0xb2, 0xb6, 0xbb, 0x27, 0x5f, 0x08, 0x2f, 0xe4, 0x50, 0xbc, 0x7e, 0x79, 0xc1, 0xa0, 0xd2,
-	0xbc, 0x6b, 0xe1, 0xfb, 0x65, 0x7f, 0xc7, 0xf2, 0xb0, 0x53, 0x71, 0x89, 0x81, 0xff, 0xf4, 0x3f,
-	0xff, 0xcf, 0xbf, 0xec, 0x3a, 0xa5, 0x8c, 0xce, 0xfa, 0xdb, 0xee, 0xfd, 0xd9, 0x30, 0xca, 0xaf,
-	0x08, 0x5a, 0xf3, 0xd2, 0xc5, 0x6f, 0x49, 0xe8, 0xcf, 0x25, 0x18, 0x15, 0x89, 0xc5, 0x43, 0x71,
-	0x39, 0x98, 0x4e, 0x3c, 0xd7, 0xed, 0x40, 0x59, 0xde, 0xdd, 0x93, 0x27, 0x0f, 0xe4, 0x8d, 0x31,
-	0x34, 0xa9, 0xc8, 0xb3, 0xbc, 0x52, 0x95, 0xc5, 0x12, 0xfa, 0xb7, 0x12, 0x9c, 0xca, 0x12, 0xda,
-	0x75, 0x97, 0xf0, 0x58, 0x23, 0xf1, 0x62, 0x0a, 0x58, 0xc5, 0xcd, 0x83, 0x45, 0x56, 0xdf, 0xdd,
-	0x93, 0xc7, 0x42, 0xb6, 0x98, 0x13, 0x97, 0x64, 0xe9, 0x47, 0xfb, 0xb2, 0xf4, 0xd9, 0xbe, 0x2c,
-	0xed, 0xee, 0xcb, 0xe7, 0x53, 0x8a, 0xcc, 0x54, 0x32, 0x53, 0x69, 0xbf, 0xfd, 0x40, 0x96, 0x22,
-	0xd1, 0x52, 0x17, 0x32, 0x5b, 0xb4, 0x73, 0xff, 0xad, 0x98, 0xe8, 0x9f, 0xa6, 0xfa, 0xf0, 0x91,
-	0x04, 0x03, 0x3c, 0xf1, 0x1b, 0xf7, 0x8c, 0x0e, 0x67, 0x75, 0xb9, 0x65, 0x49, 0xb7, 0xba, 0xbb,
-	0x27, 0xcf, 0x76, 0x92, 0x2e, 0x37, 0xeb, 0xe5, 0xd6, 0xd3, 0x48, 0x17, 0xf7, 0x4f, 0x1f, 0xb4,
-	0x77, 0xe8, 0x31, 0xee, 0x47, 0x95, 0xc1, 0x59, 0x5e, 0x5c, 0x9f, 0x8d, 0x5a, 0xfc, 0xb8, 0x4e,
-	0xfc, 0x1d, 0x09, 0x06, 0xb8, 0x4e, 0x1c, 0x81, 0xcf, 0xcd, 0x23, 0xf2, 0x19, 0xf1, 0x24, 0x74,
-	0xa3, 0x85, 0xa7, 0x7f, 0x26, 0xc1, 0x00, 0x4f, 0x95, 0x1f, 0x81, 0x27, 0xe7, 0x88, 0x3c, 0x7d,
-	0xbe, 0x2f, 0x9f, 0x64, 0x6d, 0xb6, 0x7e, 0x99, 0x1a, 0x95, 0xf2, 0x4a, 0xdc, 0x90, 0x1a, 0xb1,
-	0xcb, 0x9b, 0x08, 0x5a, 0xd9, 0xfd, 0x73, 0x09, 0xfa, 0xa8, 0x16, 0x3f, 0x8c, 0xd9, 0x4c, 0xa8,
-	0xb2, 0xbe, 0xbb, 0x27, 0x3f, 0xd5, 0x51, 0x65, 0xb3, 0x38, 0xfd, 0x2c, 0x94, 0xe0, 0xb0, 0x32,
-	0xc0, 0x8f, 0x7b, 0x0b, 0x43, 0x5f, 0x4a, 0x30, 0xb8, 0x60, 0x9a, 0x2d, 0x7d, 0xf5, 0xa7, 0x3b,
-	0x36, 0x16, 0xf3, 0xf6, 0xf0, 0x2c, 0x61, 0x7e, 0x57, 0x3a, 0xa2, 0x34, 0xbf, 0xd8, 0x97, 0x5f,
-	0x66, 0xb4, 0xb9, 0xb9, 0xe7, 0x3f, 0x97, 0xa3, 0x46, 0x7c, 0x01, 0x10, 0x05, 0x0c, 0xfe, 0xb0,
-	0x9e, 0x6e, 0xb4, 0x67, 0x2b, 0x94, 0x95, 0xa1, 0x59, 0xdd, 0x34, 0xe3, 0x05, 0xb2, 0xde, 0x67,
-	0xbe, 0xca, 0xbf, 0xd5, 0x05, 0xc3, 0x2a, 0xae, 0xb9, 0x0d, 0xfc, 0x18, 0x16, 0xfa, 0x13, 0xe9,
-	0xe8, 0x6a, 0xb3, 0xde, 0x61, 0x75, 0x2d, 0x0b, 0x12, 0xd0, 0xd5, 0xe4, 0x67, 0x02, 0x02, 0xf6,
-	0x5a, 0xea, 0x93, 0x80, 0x2f, 0xf6, 0x65, 0x88, 0x65, 0x17, 0x59, 0x1f, 0xc2, 0xd6, 0x9a, 0x29,
-	0x8a, 0x8f, 0xbb, 0x60, 0xf8, 0x06, 0xcb, 0x10, 0xb6, 0xf4, 0xc0, 0x3f, 0x54, 0x14, 0x13, 0x1d,
-	0x11, 0xee, 0xa8, 0x37, 0x95, 0xcf, 0xa9, 0x54, 0x9e, 0x39, 0xd0, 0xcc, 0xb7, 0xca, 0xe4, 0x33,
-	0x2e, 0x13, 0xf2, 0x98, 0x65, 0xd2, 0xa6, 0x41, 0xec, 0x53, 0x8e, 0x94, 0x1a, 0x75, 0x10, 0x5b,
-	0x15, 0x07, 0x2d, 0x32, 0xab, 0x13, 0x9b, 0x5e, 0x3e, 0x3f, 0x94, 0x60, 0x2c, 0x29, 0xb4, 0x54,
-	0x85, 0x0c, 0x75, 0xea, 0x48, 0xce, 0x52, 0x9e, 0xbf, 0xbe, 0xbb, 0x27, 0x3f, 0xdb, 0x2a, 0xa5,
-	0x05, 0x47, 0xb7, 0x9b, 0x81, 0x65, 0xa4, 0xa4, 0xd5, 0x66, 0x98, 0xa7, 0x95, 0x53, 0x69, 0x0e,
-	0x45, 0x35, 0x9e, 0x57, 0xed, 0xe7, 0xa5, 0x8b, 0x73, 0xdf, 0x9e, 0x86, 0x42, 0x44, 0xd3, 0xb3,
-	0xd0, 0xae, 0x04, 0xfd, 0x4b, 0xe2, 0xdf, 0x6d, 0x44, 0x0f, 0xf0, 0x50, 0x86, 0xd7, 0x9e, 0xc5,
-	0xe7, 0x3f, 0x3f, 0xaa, 0x92, 0x8b, 0x4d, 0xcd, 0x47, 0x15, 0xeb, 0x2f, 0xf6, 0xe5, 0xb9, 0x5b,
-	0xc9, 0x76, 0xdb, 0xb8, 0xf6, 0x7a, 0x53, 0x0f, 0xac, 0xa0, 0x6e, 0x26, 0x8a, 0xb3, 0x37, 0x5d,
-	0xa7, 0xca, 0x40, 0x1d, 0xaf, 0xa7, 0x11, 0xa5, 0x14, 0x5e, 0x4f, 0xa1, 0xe3, 0xc9, 0x15, 0xfb,
-	0x03, 0x09, 0xfa, 0x97, 0xc5, 0x1f, 0xe6, 0x1c, 0x72, 0xb1, 0x7f, 0xf3, 0xe8, 0x07, 0x3a, 0x5e,
-	0x67, 0x64, 0x66, 0xc5, 0x45, 0xc5, 0xb8, 0x0b, 0x99, 0xfb, 0x2f, 0x5d, 0xd0, 0x7f, 0x47, 0xfc,
-	0x35, 0xd0, 0x21, 0x99, 0xfb, 0x6e, 0xd7, 0xd7, 0xdb, 0x89, 0x9f, 0x49, 0xc9, 0x8f, 0x01, 0xcb,
-	0xcb, 0xe9, 0x36, 0xdf, 0x32, 0x4f, 0xbf, 0x95, 0x37, 0x12, 0x5d, 0xb2, 0xe5, 0xd6, 0x86, 0xc3,
-	0x72, 0xb4, 0xc8, 0xf2, 0xdd, 0x54, 0x4f, 0x67, 0x82, 0x5a, 0x39, 0xed, 0x9c, 0x97, 0x13, 0x6d,
-	0x96, 0xe5, 0xf5, 0x03, 0xdb, 0x19, 0xcb, 0xfc, 0x73, 0xf9, 0xc4, 0x4b, 0xae, 0xc5, 0x4d, 0x1f,
-	0xd1, 0x9e, 0x8b, 0xfb, 0x34, 0xbd, 0xe7, 0x7f, 0x29, 0x41, 0x91, 0x5e, 0xa7, 0x07, 0x0b, 0x35,
-	0x0b, 0xa8, 0xdc, 0x3b, 0xb4, 0xb9, 0x6a, 0xdf, 0xed, 0x21, 0xa5, 0x9f, 0x5f, 0xaa, 0x69, 0xae,
-	0xfe, 0xa1, 0x04, 0x43, 0x37, 0x70, 0xd0, 0x56, 0x6f, 0xed, 0x10, 0x31, 0xa7, 0xdc, 0xd4, 0xd6,
-	0x49, 0xca, 0xc6, 0xee, 0x9e, 0xfc, 0xf4, 0x43, 0x76, 0xbf, 0xed, 0x90, 0x84, 0xc6, 0x2c, 0xe4,
-	0x6b, 0x36, 0xac, 0xeb, 0x52, 0x63, 0xf6, 0x13, 0x09, 0x4a, 0x09, 0xf6, 0x78, 0x0d, 0x50, 0xee,
-	0x54, 0xd4, 0x1c, 0xef, 0x38, 0xa2, 0xbc, 0x7d, 0x24, 0x5b, 0xf6, 0xf9, 0xbe, 0x0c, 0x71, 0x5a,
-	0xe9, 0x8b, 0xfd, 0xf4, 0xc7, 0xaa, 0xd1, 0x55, 0x9e, 0x62, 0x9f, 0xfd, 0x17, 0x13, 0xe5, 0xfd,
-	0x7f, 0x4b, 0x30, 0x99, 0xe0, 0x3d, 0xa3, 0x98, 0x79, 0x2e, 0xfb, 0x63, 0xd4, 0x16, 0xb4, 0xf1,
-	0x47, 0x43, 0x53, 0xde, 0xfd, 0xa6, 0x96, 0x78, 0x46, 0x99, 0x48, 0x2f, 0x31, 0x4c, 0x0d, 0xc4,
-	0x6b, 0xfd, 0x4f, 0x12, 0xc8, 0x19, 0x6b, 0xe5, 0x85, 0xbd, 0xe9, 0x03, 0xf8, 0x67, 0x18, 0xe3,
-	0x0f, 0xc5, 0x60, 0xee, 0xef, 0xa1, 0x8f, 0x00, 0x52, 0x93, 0xc5, 0x4e, 0x7a, 0xcc, 0xdd, 0x87,
-	0x2c, 0x88, 0x95, 0x60, 0xe9, 0x82, 0xfe, 0x8f, 0x04, 0x63, 0xc9, 0xd3, 0x9a, 0x5e, 0x51, 0xe6,
-	0xd1, 0x7d, 0xf8, 0x22, 0xbe, 0x73, 0x78, 0xbf, 0x63, 0x77, 0x5f, 0xbe, 0xd4, 0x96, 0xa0, 0x48,
-	0xa5, 0x1b, 0x0e, 0x8e, 0xef, 0x14, 0x65, 0x32, 0x7d, 0xec, 0xdb, 0xd7, 0xfa, 0x2d, 0x09, 0xfd,
-	0x57, 0x11, 0xe5, 0xb7, 0xd5, 0x64, 0x33, 0x17, 0x9a, 0x65, 0x03, 0xc2, 0x19, 0xca, 0x7b, 0xbf,
-	0xb7, 0x35, 0xa6, 0x53, 0x03, 0xe1, 0xfa, 0xaa, 0x5e, 0x3d, 0xb1, 0xb0, 0xbf, 0x2f, 0xc1, 0xc8,
-	0x82, 0x69, 0xa6, 0xbf, 0xf4, 0xf6, 0x2c, 0xa7, 0x8a, 0xc6, 0x3a, 0x7e, 0x08, 0x9e, 0x75, 0xb1,
-	0xa9, 0x47, 0xb8, 0xd7, 0x18, 0x7f, 0x63, 0xca, 0x30, 0xf5, 0xf4, 0xc5, 0xc7, 0xe3, 0x49, 0xe3,
-	0x8b, 0xfe, 0x81, 0x04, 0x32, 0x77, 0xf4, 0xbf, 0x36, 0x7b, 0x6f, 0x1c, 0x95, 0x3d, 0x6a, 0xbd,
-	0x48, 0x2d, 0x8b, 0xbb, 0x7f, 0x22, 0xc1, 0x68, 0x42, 0x72, 0xc9, 0xba, 0xf9, 0x54, 0x06, 0x6f,
-	0x89, 0xf1, 0x2c, 0x06, 0xdf, 0x3c, 0x02, 0x83, 0x51, 0x3c, 0x38, 0xa9, 0xc8, 0x54, 0x86, 0x89,
-	0x2a, 0x79, 0x8a, 0xd3, 0x8f, 0x24, 0x18, 0x4b, 0xcb, 0xf1, 0x6b, 0x32, 0x7b, 0xe7, 0xa8, 0xd2,
-	0x9c, 0x50, 0x4e, 0xce, 0x92, 0x5a, 0x27, 0x3e, 0xbf, 0x23, 0xc1, 0xc0, 0x75, 0xcb, 0x31, 0x93,
-	0x1d, 0x60, 0xa3, 0x6d, 0xc5, 0x45, 0x06, 0x1f, 0xef, 0x00, 0x67, 0x1b, 0x7d, 0xb8, 0xc3, 0xc5,
-	0x18, 0x1b, 0x57, 0x46, 0x66, 0x2b, 0x96, 0x93, 0xa9, 0x86, 0x3f, 0x91, 0x00, 0xd1, 0xb3, 0x2f,
-	0x9a, 0x5b, 0x0f, 0xca, 0x51, 0x65, 0x7e, 0xe4, 0xa4, 0xdc, 0xff, 0xc6, 0x92, 0x53, 0x74, 0xe3,
-	0xe9, 0xe1, 0x0e, 0xd9, 0x7e, 0xd7, 0x75, 0xb0, 0x28, 0xbb, 0x46, 0xc7, 0x7b, 0xf4, 0x06, 0x0e,
-	0x92, 0x93, 0xfd, 0x75, 0xa7, 0x23, 0xff, 0xc9, 0xe0, 0x27, 0xd5, 0x13, 0x41, 0x1d, 0x97, 0x73,
-	0x1d, 0x97, 0x90, 0x99, 0xe6, 0xa1, 0xbc, 0xd1, 0x3b, 0x84, 0xd5, 0x5f, 0x67, 0x93, 0x5d, 0x1b,
-	0x7e, 0x9c, 0x81, 0x52, 0x71, 0xc3, 0xdd, 0xc1, 0x51, 0x07, 0x65, 0x47, 0xaf, 0xaa, 0x43, 0x0e,
-	0xea, 0xd0, 0xbe, 0xd4, 0x94, 0x32, 0x36, 0x4b, 0xd8, 0x3b, 0xa3, 0x2d, 0xe6, 0xed, 0xe9, 0x3b,
-	0xb8, 0x49, 0xf7, 0xfa, 0xef, 0x4a, 0x30, 0x78, 0x03, 0x3b, 0x4c, 0xe4, 0x47, 0xe2, 0xea, 0xce,
-	0x51, 0xb8, 0xe2, 0xc1, 0x20, 0x7f, 0x6b, 0x36, 0x5f, 0xff, 0x42, 0x82, 0x33, 0x09, 0xf7, 0xa1,
-	0x43, 0xec, 0x7a, 0x08, 0x3e, 0xcd, 0xa3, 0x87, 0xae, 0x4f, 0x29, 0x67, 0xd3, 0xce, 0x41, 0xc7,
-	0x18, 0x96, 0x9e, 0xe8, 0xc1, 0xa5, 0x6d, 0xdd, 0xa9, 0x46, 0xaf, 0x58, 0xbe, 0xb5, 0x79, 0x18,
-	0x36, 0xd5, 0x43, 0x8a, 0x33, 0xd2, 0x3e, 0x7a, 0xad, 0x18, 0xec, 0xcd, 0x31, 0x9f, 0x66, 0xa8,
-	0x79, 0x1f, 0x49, 0x50, 0x14, 0xff, 0x45, 0xc4, 0xff, 0x8b, 0xf1, 0x10, 0x1c, 0x6d, 0x1f, 0x81,
-	0xa3, 0xdd, 0x7d, 0x79, 0x90, 0x9d, 0xe6, 0x4c, 0xbb, 0x93, 0x70, 0x3c, 0x18, 0x4b, 0x06, 0x26,
-	0x3c, 0xf6, 0x98, 0xfb, 0xc7, 0xdd, 0x71, 0xc5, 0x92, 0xfa, 0x66, 0x0b, 0x9e, 0x85, 0x7e, 0x20,
-	0x41, 0x29, 0xe9, 0x89, 0xb0, 0x56, 0x97, 0x93, 0x1d, 0x6a, 0xde, 0xe3, 0x9d, 0x06, 0xd8, 0x7d,
-	0x73, 0xf9, 0x91, 0xf6, 0xbf, 0xe3, 0xad, 0x73, 0x52, 0x41, 0x69, 0xcf, 0xc2, 0x72, 0x2a, 0x2e,
-	0x17, 0x70, 0x1d, 0xd0, 0x8a, 0xf3, 0x16, 0x36, 0x82, 0x47, 0xe3, 0x32, 0x43, 0xcc, 0x97, 0x8e,
-	0x70, 0xc5, 0xa0, 0x00, 0x06, 0xaf, 0x35, 0xac, 0xdf, 0xf3, 0x5b, 0xe7, 0xfe, 0x4c, 0x02, 0xd4,
-	0x52, 0x57, 0xa6, 0x1b, 0xf5, 0x36, 0x0c, 0x25, 0xf7, 0x29, 0xac, 0x38, 0x8f, 0x67, 0xc5, 0x87,
-	0x7c, 0x6c, 0xfc, 0x80, 0x31, 0x65, 0x3a, 0xd2, 0x97, 0x94, 0xcc, 0x6b, 0x7c, 0x98, 0xeb, 0xcb,
-	0xff, 0xef, 0xe9, 0x54, 0xbc, 0xa6, 0x0c, 0xfd, 0x3b, 0x09, 0xc6, 0x53, 0x1c, 0xa5, 0xcb, 0xcd,
-	0x67, 0x1e, 0x5a, 0x49, 0x1e, 0x7f, 0x38, 0x8a, 0x62, 0x64, 0xe9, 0x55, 0x4a, 0x9f, 0x3a, 0x55,
-	0x15, 0xd9, 0xfa, 0xce, 0x2a, 0xa7, 0xe3, 0xa5, 0x71, 0xca, 0xa2, 0x9c, 0x39, 0x4b, 0x70, 0x40,
-	0x74, 0x43, 0x44, 0xe5, 0xff, 0x46, 0x82, 0x29, 0xfe, 0x7f, 0xb9, 0x98, 0x1c, 0x7d, 0x3d, 0x19,
-	0x1a, 0xf0, 0xd6, 0xee, 0x9e, 0xfc, 0xc2, 0x43, 0x34, 0xa0, 0xd3, 0x0a, 0x22, 0xe3, 0x73, 0x4e,
-	0x99, 0xee, 0xbc, 0x0a, 0xce, 0x34, 0x5f, 0xc6, 0xa7, 0x12, 0x4c, 0x2f, 0x63, 0xf2, 0x4d, 0x2c,
-	0xc4, 0x7e, 0x1c, 0x0b, 0x39, 0xaf, 0x28, 0x9d, 0x16, 0x62, 0xe2, 0xd4, 0x52, 0x16, 0x27, 0x3e,
-	0xfd, 0x1f, 0x53, 0xc7, 0x3e, 0xfd, 0x62, 0x4a, 0xfa, 0xec, 0x8b, 0x29, 0xe9, 0xd7, 0x5f, 0x4c,
-	0x49, 0x7f, 0xf1, 0xe5, 0xd4, 0xb1, 0xcf, 0xbe, 0x9c, 0x3a, 0xf6, 0x8b, 0x2f, 0xa7, 0x8e, 0x6d,
-	0xf5, 0x32, 0xde, 0x2e, 0xfd, 0x55, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xdf, 0x1e, 0xb1, 0x0e,
-	0x5d, 0x00, 0x00,
+	// 7593 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x7c, 0x6d, 0x70, 0x1c, 0x57,
+	0x72, 0x18, 0x07, 0x00, 0xc1, 0xdd, 0xde, 0x05, 0xb0, 0x78, 0xf8, 0xe0, 0x00, 0x04, 0x41, 0x70,
+	0x44, 0x8a, 0x14, 0xb5, 0x02, 0x4e, 0xa0, 0x78, 0x47, 0xc1, 0x22, 0x4f, 0x20, 0x3e, 0x48, 0x1c,
+	0x01, 0x02, 0x9a, 0x05, 0xc9, 0xf8, 0x52, 0xa9, 0xa9, 0xc1, 0xcc, 0xc3, 0x62, 0x0e, 0xb3, 0x33,
+	0xa3, 0x37, 0xb3, 0x4b, 0xad, 0xfe, 0xc4, 0x76, 0x7e, 0x38, 0x57, 0xa9, 0x72, 0x5d, 0xe4, 0x4b,
+	0xec, 0x28, 0xa9, 0xf2, 0xf9, 0x62, 0x55, 0x5c, 0xa9, 0xfc, 0x70, 0x29, 0xf9, 0x73, 0x72, 0xaa,
+	0xf2, 0x51, 0xa9, 0x44, 0x49, 0x2a, 0x89, 0x5c, 0x71, 0x25, 0x2e, 0x55, 0xe2, 0x38, 0x52, 0x7e,
+	0x24, 0xc8, 0x8f, 0xa4, 0xea, 0x00, 0xca, 0xf6, 0x2f, 0xd7, 0xfb, 0x98, 0xaf, 0xdd, 0x59, 0x10,
+	0x00, 0xa9, 0xbb, 0x7f, 0x3b, 0xdd, 0xfd, 0x7a, 0xfa, 0xf5, 0xeb, 0xd7, 0xaf, 0x5f, 0x77, 0xcf,
+	0x42, 0xbf, 0x61, 0xbb, 0x75, 0xd3, 0xc6, 0xc1, 0xb4, 0x47, 0xdc, 0xc0, 0x45, 0x79, 0x6c, 0x56,
+	0x31, 0xfb, 0x39, 0x3e, 0x51, 0x75, 0xdd, 0xaa, 0x8d, 0x67, 0x74, 0xcf, 0x9a, 0xd1, 0x1d, 0xc7,
+	0x0d, 0xf4, 0xc0, 0x72, 0x1d, 0x9f, 0x13, 0x8e, 0x9f, 0x0f, 0x5c, 0xd7, 0xf6, 0x67, 0xd8, 0x43,
+	0x15, 0x3b, 0xd1, 0x0f, 0x81, 0x1e, 0x0c, 0xf9, 0xee, 0xe2, 0xa6, 0x00, 0x15, 0xb7, 0x6d, 0xbd,
+	0xe1, 0x92, 0xf0, 0x89, 0x60, 0xbf, 0x6e, 0x07, 0x21, 0x39, 0xc1, 0x7e, 0xa0, 0x57, 0x03, 0x7d,
+	0xcb, 0xc6, 0x21, 0x81, 0xe1, 0xd6, 0x6a, 0x6e, 0xc8, 0xaf, 0xcf, 0xb0, 0xeb, 0x7e, 0x80, 0xc3,
+	0xd1, 0xc3, 0x96, 0xb3, 0x4d, 0x74, 0x82, 0x7d, 0xb7, 0x4e, 0x0c, 0x1c, 0xca, 0x94, 0x77, 0x49,
+	0x35, 0xa4, 0x37, 0x6b, 0x78, 0xc6, 0x76, 0x8d, 0x90, 0xbe, 0xea, 0x56, 0x5d, 0xf6, 0x73, 0x86,
+	0xfe, 0x12, 0xd0, 0x21, 0x4a, 0xa4, 0x7b, 0x5e, 0xea, 0x4d, 0x03, 0x2d, 0x5c, 0x95, 0x7f, 0xdf,
+	0x0d, 0x43, 0xeb, 0x1e, 0x26, 0x6c, 0xfa, 0x9b, 0x56, 0x0d, 0xaf, 0x5a, 0x35, 0x2b, 0xf0, 0xd1,
+	0x7d, 0x38, 0x67, 0x10, 0xac, 0x07, 0x58, 0x13, 0xb2, 0x69, 0x96, 0xe3, 0x07, 0x5a, 0x60, 0xd5,
+	0xb0, 0x5b, 0x0f, 0x64, 0x69, 0x4a, 0xba, 0xda, 0x7d, 0xa7, 0xf8, 0xe7, 0x7f, 0x7c, 0x21, 0xb7,
+	0x58, 0xe7, 0x83, 0x55, 0x99, 0x0f, 0x58, 0xe0, 0xf4, 0x2b, 0x8e, 0x1f, 0x6c, 0x72, 0x6a, 0xca,
+	0xac, 0xee, 0x99, 0x1d, 0x99, 0x75, 0x65, 0x31, 0xe3, 0x03, 0xb2, 0x99, 0x99, 0xd8, 0xc6, 0x9d,
+	0x98, 0x75, 0x67, 0x31, 0xe3, 0x03, 0x32, 0x98, 0x2d, 0xc0, 0x59, 0x31, 0x4d, 0xdd, 0xf3, 0xd2,
+	0x8c, 0x7a, 0x32, 0x18, 0x0d, 0x73, 0xe2, 0x79, 0xcf, 0x6b, 0x61, 0x22, 0xa6, 0xd7, 0xc6, 0xe4,
+	0x74, 0x16, 0x13, 0x4e, 0xdc, 0xce, 0x44, 0x4c, 0xab, 0x8d, 0x49, 0x6f, 0x16, 0x13, 0x4e, 0x9c,
+	0x66, 0xa2, 0xfc, 0xa9, 0x04, 0xa5, 0x05, 0x61, 0x9b, 0x2b, 0x4e, 0x80, 0x89, 0xa3, 0xdb, 0x68,
+	0x14, 0x7a, 0xb7, 0x2d, 0x6c, 0x9b, 0xbe, 0x2c, 0x4d, 0x75, 0x5f, 0xcd, 0xab, 0xe2, 0x09, 0x4d,
+	0x43, 0xf7, 0x2e, 0x6e, 0x32, 0xed, 0x17, 0x66, 0x47, 0xa7, 0xa3, 0xbd, 0x31, 0x1d, 0x72, 0xb8,
+	0x8f, 0x9b, 0x77, 0x7a, 0x3e, 0xfd, 0xe3, 0x0b, 0xa7, 0x54, 0x4a, 0x88, 0xde, 0x82, 0xd3, 0x1e,
+	0x71, 0x3d, 0x5f, 0xee, 0x9e, 0xea, 0xbe, 0x5a, 0x98, 0x7d, 0x39, 0x63, 0x44, 0xf8, 0xce, 0xe9,
+	0x0d, 0x4a, 0xb8, 0xe4, 0x04, 0xa4, 0xa9, 0xf2, 0x41, 0xe3, 0x37, 0x01, 0x62, 0x20, 0x2a, 0xf1,
+	0x77, 0x53, 0x33, 0xca, 0x73, 0xee, 0xc3, 0x70, 0xba, 0xa1, 0xdb, 0x75, 0xcc, 0xe4, 0xc9, 0xab,
+	0xfc, 0x61, 0xae, 0xeb, 0xa6, 0x34, 0x77, 0xe9, 0x7f, 0xff, 0x54, 0x96, 0xfe, 0xff, 0x4f, 0x65,
+	0xe9, 0x97, 0xf6, 0x65, 0xe9, 0x07, 0xfb, 0xb2, 0xf4, 0xf1, 0x53, 0xb9, 0xb4, 0x8b, 0x9b, 0xb7,
+	0xd6, 0x49, 0x55, 0x77, 0xac, 0xf7, 0x99, 0x42, 0x94, 0x5f, 0xce, 0x43, 0xff, 0x86, 0xad, 0x07,
+	0xdb, 0x2e, 0xa9, 0x2d, 0xb8, 0xce, 0xb6, 0x55, 0x45, 0xdf, 0x84, 0xb3, 0x86, 0xeb, 0x04, 0xba,
+	0xe5, 0x60, 0xa2, 0x11, 0x5c, 0xb5, 0xfc, 0x80, 0x34, 0x35, 0x4f, 0x0f, 0x76, 0xc4, 0x8b, 0x47,
+	0x22, 0xb4, 0x2a, 0xb0, 0x1b, 0x7a, 0xb0, 0x83, 0xae, 0xc3, 0x68, 0xb8, 0xc1, 0xb5, 0x46, 0x4d,
+	0xb3, 0x6a, 0x7a, 0x15, 0xf3, 0x61, 0x5c, 0xb6, 0xa1, 0x10, 0xfb, 0xa8, 0xb6, 0x42, 0x71, 0x6c,
+	0xd0, 0x0d, 0x18, 0x74, 0xdc, 0xc0, 0xda, 0x6e, 0x6a, 0x46, 0x40, 0x6c, 0x4d, 0x37, 0x4d, 0xe2,
+	0x33, 0x63, 0xcc, 0xdf, 0xc9, 0x7f, 0xf0, 0xf1, 0xd8, 0x69, 0xc7, 0x35, 0x6a, 0x9e, 0x3a, 0xc0,
+	0x69, 0x16, 0x02, 0x62, 0xcf, 0x53, 0x0a, 0xa4, 0x40, 0x5f, 0x60, 0xfb, 0x9a, 0x81, 0x49, 0xa0,
+	0x6d, 0x5b, 0x36, 0x66, 0x16, 0x93, 0x57, 0x0b, 0x81, 0xed, 0x2f, 0x60, 0x12, 0x2c, 0x5b, 0x36,
+	0x46, 0x53, 0x50, 0xa4, 0x34, 0xbb, 0xb8, 0xc9, 0x49, 0x86, 0x19, 0x09, 0x04, 0xb6, 0x7f, 0x1f,
+	0x37, 0x19, 0xc5, 0x24, 0x14, 0x18, 0x17, 0x9d, 0x13, 0x8c, 0x30, 0x82, 0x3c, 0xe5, 0xa1, 0x33,
+	0xfc, 0x6d, 0x38, 0x83, 0x9d, 0x86, 0xd6, 0xd0, 0x89, 0xdc, 0xcb, 0x16, 0xef, 0x72, 0x62, 0xf1,
+	0xd2, 0x5a, 0x9b, 0x5e, 0x72, 0x1a, 0x8f, 0x74, 0xc2, 0xd7, 0xae, 0x17, 0xb3, 0x07, 0x54, 0x86,
+	0xa2, 0x27, 0xa8, 0xb4, 0x40, 0xaf, 0xca, 0xb9, 0xd6, 0x79, 0x15, 0x42, 0xf4, 0xa6, 0x5e, 0x45,
+	0xe7, 0x20, 0x1f, 0x60, 0x3f, 0xd0, 0x6a, 0xae, 0x89, 0xe5, 0xfc, 0x94, 0x74, 0x35, 0xa7, 0xe6,
+	0x28, 0x60, 0xcd, 0x35, 0x31, 0x3a, 0x0f, 0x3d, 0xbe, 0xa7, 0x3b, 0x32, 0xb4, 0xb2, 0x60, 0x60,
+	0x74, 0x11, 0x8a, 0x86, 0x8d, 0x75, 0xa7, 0xee, 0xf1, 0xe1, 0x05, 0x36, 0xbc, 0x20, 0x60, 0x8c,
+	0xc3, 0x28, 0xf4, 0xd2, 0xc5, 0x74, 0x1d, 0xb9, 0xc8, 0xe6, 0x29, 0x9e, 0xd0, 0x2b, 0x50, 0xa2,
+	0xbe, 0x0e, 0x13, 0xc3, 0xd2, 0x6d, 0xa6, 0x51, 0x5f, 0xee, 0x63, 0xc3, 0x07, 0x62, 0x38, 0x55,
+	0x2a, 0xd3, 0x7a, 0xdd, 0xc7, 0x5a, 0x43, 0xaf, 0xdb, 0x81, 0xe6, 0xed, 0x5a, 0x72, 0x3f, 0x7f,
+	0x4d, 0xdd, 0xc7, 0x8f, 0x28, 0x6c, 0x63, 0xd7, 0xa2, 0x5a, 0xa7, 0x3b, 0xd1, 0x74, 0x7c, 0x8d,
+	0xb8, 0x6e, 0x20, 0x97, 0xb8, 0xd6, 0x75, 0xcf, 0x5b, 0x74, 0x7c, 0xd5, 0x75, 0x03, 0x74, 0x19,
+	0xfa, 0x4d, 0xec, 0xd9, 0x6e, 0xb3, 0x86, 0x9d, 0x80, 0xe9, 0x65, 0x88, 0xd1, 0xf4, 0xc5, 0x50,
+	0xaa, 0x8e, 0xdb, 0x30, 0x6a, 0x90, 0x9a, 0xa6, 0x1b, 0x06, 0xf6, 0x7d, 0xcd, 0x23, 0x56, 0x83,
+	0xba, 0x0a, 0x6a, 0xfe, 0xa3, 0xad, 0x3a, 0x18, 0x32, 0x48, 0x6d, 0x9e, 0xd1, 0x6d, 0x70, 0xb2,
+	0xfb, 0xb8, 0x89, 0x5e, 0x87, 0x01, 0x31, 0x56, 0xf7, 0x2c, 0x66, 0x58, 0xf2, 0xd9, 0xd6, 0x81,
+	0x7d, 0x9c, 0x62, 0xde, 0xb3, 0xa8, 0x59, 0xd1, 0x15, 0x30, 0x74, 0x63, 0x07, 0x6b, 0xa6, 0x45,
+	0x64, 0x99, 0x09, 0x95, 0x63, 0x80, 0x45, 0x8b, 0xa0, 0x77, 0x60, 0xca, 0xc7, 0x86, 0xeb, 0x98,
+	0x3a, 0x69, 0x6a, 0x1d, 0x24, 0x1b, 0x6b, 0x7d, 0xc1, 0x44, 0x34, 0x64, 0x21, 0x43, 0xc4, 0xab,
+	0x50, 0x0a, 0x76, 0x74, 0xc7, 0xf5, 0x35, 0x82, 0x8d, 0x06, 0x97, 0x71, 0x9c, 0xbd, 0xb6, 0x9f,
+	0xc3, 0x55, 0x6c, 0x34, 0x98, 0x64, 0xd3, 0x30, 0xa4, 0x3b, 0xbe, 0xb5, 0x65, 0x63, 0xcd, 0xab,
+	0x6f, 0xd9, 0x96, 0xc1, 0x89, 0xcf, 0x31, 0xe2, 0x41, 0x81, 0xda, 0x60, 0x18, 0x46, 0xff, 0x3a,
+	0x8c, 0x60, 0xa7, 0xe1, 0x36, 0xb5, 0x27, 0x56, 0xb0, 0xa3, 0x19, 0x75, 0x62, 0xf3, 0xfd, 0x28,
+	0x9f, 0x67, 0x23, 0x10, 0x43, 0x3e, 0xb6, 0x82, 0x9d, 0x85, 0x3a, 0xb1, 0xd9, 0x6e, 0xa4, 0x43,
+	0x9c, 0xaa, 0xe5, 0xbc, 0xd7, 0x36, 0x64, 0x92, 0x0f, 0x61, 0xc8, 0xd4, 0x90, 0xf1, 0x37, 0xa1,
+	0x90, 0x30, 0xfb, 0xe3, 0x78, 0xa7, 0xef, 0xf4, 0xe4, 0x7a, 0x4a, 0xa7, 0xbf, 0xd3, 0x93, 0x9b,
+	0x28, 0x9d, 0x57, 0xfe, 0x40, 0x82, 0xd2, 0x32, 0x36, 0xc5, 0x69, 0x2a, 0xbc, 0xd0, 0x2c, 0x8c,
+	0x6c, 0x47, 0x30, 0x8d, 0x7a, 0x1c, 0xfc, 0x5e, 0xa0, 0x59, 0xa6, 0x60, 0x3f, 0xb4, 0x9d, 0x1c,
+	0x40, 0x71, 0x2b, 0x26, 0xf5, 0x5c, 0x9e, 0x4e, 0x02, 0xea, 0xb7, 0x12, 0x63, 0x99, 0xa6, 0xb8,
+	0x00, 0x23, 0x02, 0x1d, 0xbf, 0x8d, 0x69, 0xeb, 0x2a, 0x94, 0x12, 0xf4, 0xe6, 0x16, 0x7d, 0x0d,
+	0xf5, 0x41, 0x3d, 0x6a, 0x7f, 0x0c, 0x5f, 0xdc, 0x5a, 0x31, 0xd1, 0x15, 0x18, 0x48, 0x50, 0x3a,
+	0x7a, 0x0d, 0xb3, 0x03, 0x2f, 0x9f, 0x24, 0x7c, 0xa0, 0xd7, 0xb0, 0xf2, 0x7d, 0x04, 0xa5, 0xd0,
+	0x43, 0x2c, 0x63, 0x3d, 0xa8, 0x13, 0xec, 0xa3, 0x97, 0xa0, 0x2f, 0xf6, 0x07, 0x4d, 0x0f, 0x8b,
+	0xb9, 0x44, 0x4e, 0x62, 0xb3, 0xe9, 0x61, 0x6a, 0x84, 0x8e, 0x6b, 0x62, 0x4e, 0x30, 0xc6, 0x8d,
+	0x90, 0x02, 0x18, 0x72, 0x1e, 0xce, 0xfb, 0x75, 0xcf, 0x73, 0x49, 0xe0, 0x6b, 0xb5, 0xba, 0x1d,
+	0x58, 0x5a, 0x80, 0x1d, 0xdd, 0x09, 0xc2, 0x43, 0x9d, 0xcd, 0x33, 0xa7, 0x8e, 0x87, 0x44, 0x6b,
+	0x94, 0x66, 0x93, 0x91, 0x88, 0x63, 0x1c, 0xbd, 0x01, 0xa3, 0x11, 0x0b, 0x7f, 0x47, 0x27, 0xd8,
+	0xd4, 0x1a, 0xae, 0x5d, 0xaf, 0x61, 0x36, 0xe5, 0x9c, 0x3a, 0x1c, 0x62, 0x2b, 0x0c, 0xf9, 0x88,
+	0xe1, 0xe8, 0x72, 0x44, 0xa3, 0x02, 0x52, 0xf7, 0x03, 0xcd, 0x73, 0x6d, 0xcb, 0x68, 0xb2, 0xe9,
+	0xe7, 0xd4, 0xa1, 0x10, 0xb9, 0x49, 0x71, 0x1b, 0x0c, 0x85, 0x6e, 0x82, 0x1c, 0x8d, 0xd9, 0xad,
+	0x6f, 0x61, 0xe2, 0xe0, 0x00, 0xfb, 0x9a, 0xeb, 0xd8, 0x4d, 0xe6, 0xaf, 0x73, 0x6a, 0x24, 0xc9,
+	0xfd, 0x08, 0xbd, 0xee, 0xd8, 0x4d, 0x74, 0x17, 0xa6, 0x12, 0x03, 0x08, 0x7e, 0xb7, 0x6e, 0x11,
+	0xec, 0x6b, 0x4f, 0x5c, 0xb2, 0x8b, 0x89, 0x46, 0xb5, 0xe1, 0xb3, 0xe3, 0x3d, 0xa7, 0x9e, 0x8f,
+	0xe9, 0x54, 0x41, 0xf6, 0x98, 0x51, 0x3d, 0xa0, 0x44, 0xec, 0x2c, 0x0b, 0xcf, 0x24, 0x1f, 0x93,
+	0x86, 0x65, 0x60, 0x5f, 0xb3, 0x5d, 0x43, 0xb7, 0xe5, 0x33, 0x6c, 0xfc, 0x48, 0x88, 0xae, 0x08,
+	0xec, 0x2a, 0x45, 0xa2, 0x6f, 0x81, 0x6c, 0x79, 0x9a, 0x6e, 0x53, 0xd2, 0x00, 0x9b, 0x9a, 0x87,
+	0x49, 0x38, 0x9e, 0x79, 0xf1, 0x9c, 0x3a, 0x62, 0x79, 0xf3, 0x21, 0x7a, 0x03, 0x13, 0x31, 0x1c,
+	0xdd, 0x80, 0xb3, 0xd1, 0x9c, 0xf9, 0x09, 0x48, 0xd7, 0x51, 0x73, 0x1b, 0xdb, 0x72, 0x3e, 0xad,
+	0x5e, 0xb6, 0x85, 0xe8, 0xa2, 0xae, 0x37, 0xb6, 0x3b, 0x0f, 0xd3, 0xe5, 0xa1, 0x8e, 0xc3, 0x74,
+	0x34, 0x01, 0x60, 0xf9, 0xf4, 0xb0, 0xf5, 0x5c, 0xd7, 0x66, 0x67, 0x43, 0x4e, 0xcd, 0x59, 0xfe,
+	0xa3, 0xda, 0x86, 0xeb, 0xda, 0xe8, 0x2c, 0x9c, 0xb1, 0x7c, 0x6d, 0x5b, 0xdf, 0x0d, 0xcf, 0x83,
+	0x5e, 0xcb, 0x5f, 0xd6, 0x77, 0xb1, 0x40, 0xd4, 0x5c, 0x63, 0x57, 0x1e, 0x0f, 0x11, 0x6b, 0xae,
+	0xb1, 0x8b, 0xde, 0x86, 0x89, 0x48, 0x0c, 0xdd, 0x34, 0x2d, 0x6a, 0xce, 0xba, 0xad, 0x39, 0x38,
+	0xa0, 0xaa, 0xf7, 0xe5, 0x62, 0xda, 0xba, 0xe6, 0x23, 0x92, 0x07, 0x82, 0x02, 0x7d, 0x1b, 0x26,
+	0x2c, 0x5f, 0xf3, 0x2d, 0xa7, 0x6a, 0xe3, 0xe4, 0xa2, 0x87, 0xf6, 0xc9, 0x4f, 0x96, 0x31, 0xcb,
+	0xaf, 0x30, 0x92, 0x78, 0xdd, 0x43, 0xf3, 0xbc, 0x03, 0x93, 0xb1, 0x08, 0x61, 0x48, 0x67, 0x62,
+	0xd3, 0xe2, 0x0b, 0x61, 0x79, 0x72, 0x7f, 0x8b, 0x10, 0x3c, 0x96, 0x5b, 0x0c, 0x49, 0x56, 0x3c,
+	0xf4, 0x8b, 0x70, 0x2d, 0xe2, 0x11, 0x6d, 0xb8, 0x1d, 0xab, 0xba, 0xa3, 0xe9, 0x0d, 0xdd, 0xb2,
+	0xf5, 0x2d, 0xcb, 0xb6, 0x82, 0xa6, 0xe6, 0x3a, 0xda, 0xee, 0x4d, 0x5f, 0x1e, 0x60, 0xfc, 0x2e,
+	0x87, 0x23, 0xc2, 0x5d, 0x7b, 0xcf, 0xaa, 0xee, 0xcc, 0x27, 0xc8, 0xd7, 0x9d, 0xfb, 0x37, 0x7d,
+	0xa4, 0xc1, 0x6b, 0x47, 0x64, 0x6d, 0xba, 0xc6, 0x2e, 0x26, 0xec, 0xfc, 0xcb, 0xa9, 0x57, 0x9f,
+	0xcd, 0x7d, 0x91, 0xd1, 0xa3, 0x65, 0x98, 0x72, 0xdc, 0x0c, 0xcd, 0x69, 0x7a, 0x3d, 0x70, 0x35,
+	0xdf, 0xd0, 0x6d, 0x2c, 0x0f, 0x32, 0x9e, 0x13, 0x8e, 0xdb, 0xa6, 0xbe, 0xf9, 0x7a, 0xe0, 0x56,
+	0x28, 0x0d, 0x5a, 0x80, 0x49, 0x8b, 0x1e, 0x4e, 0x78, 0xab, 0x6e, 0xd9, 0x41, 0xd6, 0x52, 0x20,
+	0xc6, 0xe5, 0x9c, 0xe5, 0x6f, 0x08, 0xa2, 0xf6, 0xc5, 0x28, 0x03, 0x72, 0xdc, 0x48, 0x02, 0x31,
+	0x07, 0x16, 0x48, 0xe5, 0xd4, 0x92, 0xe3, 0x0a, 0xb2, 0x0a, 0x87, 0xa3, 0xf3, 0xcc, 0x1a, 0xb1,
+	0x59, 0xc5, 0x5b, 0xee, 0x7b, 0x2c, 0x9a, 0xca, 0xa9, 0x79, 0xcb, 0x5f, 0xe2, 0x00, 0xea, 0xfd,
+	0x62, 0x1b, 0xf7, 0x1a, 0xdf, 0x64, 0xa7, 0x57, 0x4e, 0x2d, 0x46, 0x96, 0xed, 0x35, 0xbe, 0x89,
+	0x66, 0x60, 0x38, 0xda, 0xee, 0xf4, 0x90, 0x75, 0x1d, 0xc6, 0x50, 0x9e, 0x60, 0xb4, 0x83, 0x21,
+	0x6e, 0x81, 0xd4, 0xd6, 0x9d, 0x25, 0x93, 0x1f, 0x5b, 0xe9, 0x01, 0xdb, 0xdb, 0x7c, 0xc4, 0x79,
+	0x36, 0x02, 0x25, 0x47, 0x6c, 0x6f, 0xb3, 0x21, 0xb3, 0xc9, 0x21, 0x98, 0x04, 0x1a, 0xc1, 0xdb,
+	0x04, 0xfb, 0x3b, 0xec, 0xa4, 0xcb, 0xa9, 0x43, 0xd1, 0x10, 0x4c, 0x02, 0x95, 0xa3, 0xa8, 0x5d,
+	0xa7, 0x1d, 0xaf, 0x67, 0x63, 0xe6, 0x88, 0xd8, 0xd6, 0xf3, 0xe5, 0x0b, 0xdc, 0xae, 0x53, 0x7e,
+	0xd7, 0xb3, 0x31, 0xf5, 0x42, 0x74, 0x2f, 0xfa, 0xe8, 0x4d, 0x18, 0xab, 0xe9, 0x8e, 0x5e, 0xc5,
+	0x3e, 0x35, 0x3a, 0x76, 0xa0, 0x11, 0xd7, 0x16, 0xbe, 0x6c, 0x8a, 0x7b, 0x43, 0x41, 0x70, 0xff,
+	0xa6, 0xbf, 0xc0, 0xd1, 0xdc, 0x89, 0x5d, 0x84, 0x62, 0xdd, 0xc7, 0xbe, 0x66, 0x39, 0x55, 0x82,
+	0x7d, 0x5f, 0xbe, 0x18, 0x45, 0x5d, 0xfe, 0x0a, 0x07, 0xd1, 0xf8, 0x20, 0x9a, 0x52, 0xd5, 0xab,
+	0x6b, 0x26, 0xb1, 0x1a, 0x98, 0xc8, 0x4a, 0x5a, 0x6b, 0x77, 0xbd, 0xfa, 0x22, 0x43, 0xd0, 0x28,
+	0x8d, 0xb1, 0x24, 0xae, 0x1b, 0x68, 0xf6, 0x96, 0xfc, 0x12, 0x23, 0x04, 0x0a, 0xa3, 0x31, 0xda,
+	0xea, 0x16, 0xba, 0x0f, 0x4a, 0x34, 0xe1, 0xc8, 0x85, 0x72, 0x01, 0xcd, 0xd0, 0x22, 0x7c, 0xf9,
+	0x12, 0x1b, 0x77, 0x21, 0xa4, 0x0c, 0x2f, 0x34, 0x6b, 0x9c, 0x4e, 0xd8, 0x87, 0x8f, 0x56, 0xa1,
+	0x20, 0xa2, 0xa5, 0x86, 0x4e, 0x7c, 0x79, 0x94, 0x05, 0xd3, 0xaf, 0x66, 0x04, 0xd3, 0xe1, 0x51,
+	0x39, 0xcd, 0x63, 0xa5, 0x47, 0x3a, 0x11, 0xd7, 0x21, 0xd0, 0x23, 0x00, 0xba, 0x0f, 0x40, 0x2f,
+	0x47, 0x98, 0x04, 0x16, 0xf6, 0xe5, 0xb3, 0xcf, 0x66, 0xb6, 0x11, 0x51, 0x0b, 0x66, 0xf1, 0x70,
+	0xf4, 0x5d, 0x18, 0x0b, 0x2f, 0xf7, 0xda, 0xbb, 0x75, 0x37, 0xd0, 0xb5, 0x04, 0x6f, 0x99, 0xf1,
+	0x96, 0x13, 0xbc, 0x57, 0x68, 0x8e, 0x41, 0x15, 0x03, 0xc4, 0x35, 0xef, 0x6c, 0xc8, 0xe0, 0x1d,
+	0x3a, 0x3e, 0x7e, 0x19, 0x7a, 0x15, 0xfa, 0xf9, 0x7d, 0x93, 0xee, 0x43, 0x4f, 0x27, 0x58, 0x36,
+	0xa8, 0xbe, 0xee, 0xf4, 0xfc, 0xee, 0xbe, 0x2c, 0xa9, 0x7d, 0x1c, 0xb7, 0xc1, 0x51, 0xe3, 0x8f,
+	0x60, 0xa0, 0x65, 0xd2, 0x19, 0x01, 0xd5, 0x6b, 0xc9, 0x80, 0xaa, 0x30, 0x7b, 0x36, 0x39, 0x6b,
+	0xfe, 0xde, 0xe6, 0x8a, 0xb3, 0xed, 0x26, 0x22, 0x2d, 0xca, 0xb7, 0x65, 0xfe, 0x2f, 0x84, 0xef,
+	0xdc, 0xd5, 0x8c, 0xfb, 0x65, 0x8f, 0xe3, 0x3a, 0xf8, 0x5f, 0x7e, 0x25, 0x17, 0x37, 0x12, 0x11,
+	0x8d, 0xf2, 0x3f, 0xba, 0xa0, 0x3f, 0xb4, 0x0c, 0x15, 0xfb, 0x6b, 0xba, 0x87, 0xe6, 0x62, 0x09,
+	0x3a, 0x5f, 0xa2, 0x4b, 0x7b, 0x4f, 0xe5, 0x5c, 0x08, 0x88, 0x2f, 0xd4, 0xef, 0xc0, 0x99, 0x9a,
+	0xee, 0x79, 0x96, 0x53, 0x95, 0xbb, 0x3a, 0x5e, 0xa9, 0xf9, 0x7b, 0xa6, 0xd7, 0x38, 0x21, 0x9b,
+	0xf6, 0x9d, 0x81, 0xbd, 0xa7, 0x72, 0x41, 0xc5, 0xfe, 0xa6, 0x5e, 0xdd, 0xa4, 0x49, 0x25, 0x35,
+	0xe4, 0x33, 0x3e, 0x07, 0xc5, 0x24, 0xe5, 0xb1, 0xee, 0xd9, 0xbf, 0x2c, 0x7d, 0x78, 0x20, 0x3f,
+	0x0c, 0xb7, 0xc8, 0xad, 0xfb, 0xb8, 0x39, 0x4d, 0x23, 0xc0, 0x72, 0x08, 0x71, 0x49, 0x95, 0x01,
+	0x93, 0xd7, 0xee, 0xb2, 0x88, 0x16, 0xb1, 0x19, 0x62, 0x97, 0x43, 0x40, 0x92, 0xec, 0xc7, 0x07,
+	0xf2, 0x58, 0x47, 0xe4, 0xbf, 0x3b, 0x90, 0xcf, 0x08, 0xa1, 0x95, 0x2d, 0x28, 0x30, 0xc3, 0x8c,
+	0x63, 0x67, 0xfc, 0x1e, 0x4f, 0x29, 0x84, 0x67, 0x37, 0x8f, 0x55, 0x45, 0xec, 0x1c, 0x22, 0xc5,
+	0xa9, 0x4d, 0xc5, 0x45, 0x17, 0xa0, 0xc0, 0x73, 0x71, 0x9c, 0x92, 0x4f, 0x13, 0x38, 0x88, 0x45,
+	0xb4, 0xbf, 0x2a, 0x41, 0x9f, 0x9a, 0x34, 0x74, 0x84, 0xa0, 0x27, 0xc1, 0x95, 0xfd, 0x4e, 0xeb,
+	0xa9, 0x47, 0xe8, 0x89, 0x86, 0xcd, 0xba, 0x4d, 0x3d, 0x6d, 0xb0, 0x43, 0xbd, 0xa9, 0x6b, 0xf3,
+	0xf8, 0xfa, 0xb4, 0xda, 0xcf, 0xc0, 0x9b, 0x21, 0x94, 0x9e, 0x11, 0xd1, 0x6e, 0x64, 0x01, 0x30,
+	0x8f, 0xae, 0x8b, 0x21, 0x90, 0xd9, 0x53, 0x1d, 0x8a, 0x77, 0x37, 0x1e, 0x72, 0x4f, 0x46, 0xaf,
+	0x51, 0x17, 0x93, 0x72, 0xdc, 0xe9, 0xfb, 0xe4, 0xa9, 0x9c, 0xaf, 0x7a, 0x75, 0xee, 0x02, 0x85,
+	0x58, 0x6f, 0x40, 0xd1, 0x4d, 0xe8, 0x8e, 0x4f, 0xef, 0x4e, 0xe9, 0x93, 0xa7, 0x72, 0x31, 0x22,
+	0x75, 0x49, 0x55, 0x4d, 0x51, 0xcd, 0x15, 0xa9, 0x89, 0xff, 0xe9, 0x4f, 0x65, 0xe9, 0xf7, 0x7e,
+	0x74, 0x41, 0x52, 0xfe, 0xa0, 0x0b, 0xfa, 0xa3, 0xf7, 0xde, 0xa9, 0x5b, 0xb6, 0x99, 0xa9, 0x81,
+	0x0b, 0x50, 0xe0, 0xfc, 0x92, 0xb9, 0x0f, 0xe0, 0x20, 0x96, 0xf2, 0xb8, 0x06, 0x83, 0x09, 0x02,
+	0xcd, 0x20, 0xd8, 0x14, 0x29, 0x0f, 0x75, 0x20, 0x26, 0x5b, 0xa0, 0x60, 0xf4, 0x16, 0x94, 0x5c,
+	0x9e, 0x66, 0x74, 0xaa, 0x9a, 0xdf, 0xf4, 0x03, 0x5c, 0x63, 0x2a, 0xe9, 0x9f, 0x1d, 0x4c, 0x18,
+	0xfd, 0x7a, 0x85, 0xea, 0x45, 0x1d, 0x88, 0x48, 0x2b, 0x8c, 0x92, 0xde, 0xb4, 0x77, 0x31, 0x71,
+	0xb0, 0xad, 0x35, 0x30, 0xf1, 0xe9, 0xbc, 0x79, 0x9a, 0xa4, 0x8f, 0x43, 0x1f, 0x71, 0x20, 0x5d,
+	0x9d, 0x9d, 0xa6, 0x47, 0x03, 0x58, 0xdf, 0xa5, 0x69, 0xc1, 0x6d, 0x97, 0x05, 0xd7, 0x79, 0xb5,
+	0x3f, 0x06, 0xd3, 0xdd, 0x4f, 0x53, 0x08, 0x35, 0xf3, 0x86, 0x5f, 0xaf, 0xb1, 0xe0, 0x39, 0xaf,
+	0x8a, 0x27, 0x74, 0x05, 0x8a, 0x7e, 0xe0, 0x92, 0x28, 0xdf, 0xc3, 0xf3, 0x1c, 0xdc, 0xcb, 0x15,
+	0x04, 0x86, 0xce, 0x69, 0x6e, 0xe0, 0x83, 0x03, 0xb9, 0x50, 0x89, 0x01, 0xca, 0xff, 0x93, 0x60,
+	0x38, 0xad, 0xd3, 0x35, 0x5c, 0xdb, 0xc2, 0x04, 0xcd, 0x24, 0x1d, 0x44, 0xd2, 0x1d, 0x25, 0x57,
+	0x3e, 0x99, 0x66, 0xbb, 0x01, 0xa7, 0x69, 0x10, 0x63, 0x0a, 0x0f, 0x36, 0x96, 0x35, 0x84, 0xbd,
+	0x40, 0x0c, 0xe2, 0xd4, 0xf4, 0x6c, 0xb5, 0xaa, 0x8e, 0x4b, 0xb0, 0xe6, 0x07, 0x7a, 0x10, 0xde,
+	0x81, 0x0a, 0x1c, 0x56, 0xa1, 0xa0, 0xb9, 0xd5, 0x0f, 0x0f, 0xe4, 0x37, 0x22, 0x2b, 0xa1, 0x6b,
+	0x1c, 0x6f, 0xf2, 0xa4, 0xf1, 0xb4, 0xed, 0xf2, 0xcc, 0x84, 0x9b, 0x01, 0x83, 0x69, 0x79, 0x1e,
+	0xaa, 0xab, 0xe8, 0x12, 0xf4, 0x33, 0x71, 0x34, 0x7a, 0xeb, 0x4e, 0x64, 0xda, 0x8a, 0x0c, 0xfa,
+	0x90, 0xd8, 0xcc, 0x70, 0xae, 0x42, 0xae, 0xa1, 0xdb, 0x96, 0x69, 0x05, 0xcd, 0xcc, 0xe4, 0x6f,
+	0x84, 0x55, 0x7e, 0xbf, 0x17, 0xf2, 0xd1, 0x5b, 0x3a, 0x66, 0x32, 0x67, 0x92, 0x99, 0xcc, 0xa3,
+	0xe8, 0xf8, 0x5b, 0xd0, 0xcb, 0x04, 0x0a, 0x73, 0x99, 0xcf, 0x54, 0xb2, 0x20, 0xa7, 0x86, 0x68,
+	0x5b, 0x06, 0x76, 0x7c, 0x4c, 0x03, 0x9f, 0x6d, 0xab, 0x2a, 0xf6, 0x75, 0x9f, 0x80, 0xc6, 0x7e,
+	0x2b, 0x4d, 0xa6, 0x09, 0x73, 0xe3, 0x66, 0x3b, 0x94, 0xa2, 0x5e, 0xe3, 0xb6, 0xb7, 0x98, 0x0a,
+	0x06, 0x78, 0x9a, 0xee, 0x52, 0x96, 0x5c, 0x87, 0x46, 0x01, 0xc3, 0x70, 0x9a, 0xaf, 0x3f, 0x37,
+	0x6c, 0xfe, 0xd0, 0x66, 0x1c, 0xb9, 0x36, 0xe3, 0xc8, 0x38, 0xe2, 0xf3, 0x1d, 0x8f, 0x78, 0xf4,
+	0x06, 0x0c, 0x85, 0xfb, 0x64, 0xab, 0x6e, 0xec, 0xe2, 0x80, 0xfb, 0x5a, 0x48, 0x6c, 0x97, 0x41,
+	0x41, 0x70, 0x87, 0xe1, 0x99, 0x67, 0x5e, 0x80, 0x73, 0x2d, 0x5a, 0x49, 0x6d, 0xb6, 0x42, 0x62,
+	0xb4, 0x9c, 0xd2, 0x50, 0x62, 0xa3, 0x8d, 0xdf, 0x3a, 0x4a, 0x14, 0xd0, 0xf9, 0x90, 0xdb, 0x93,
+	0x5a, 0x4f, 0xfb, 0xdf, 0xdc, 0x97, 0xa5, 0xdf, 0xdb, 0x97, 0xa5, 0x4f, 0xf7, 0x65, 0xe9, 0x8f,
+	0xf6, 0x65, 0xe9, 0x83, 0x03, 0x59, 0x65, 0x2a, 0x29, 0xaf, 0xb6, 0xac, 0x52, 0xa5, 0x5e, 0x2b,
+	0x2f, 0x26, 0xf5, 0x50, 0xae, 0xb4, 0xce, 0x31, 0x3d, 0x26, 0x21, 0xf7, 0x49, 0xb7, 0xde, 0x8f,
+	0x0f, 0xe4, 0xd2, 0x51, 0xb6, 0xe3, 0xaf, 0x7c, 0xc5, 0x0e, 0x00, 0x6e, 0x21, 0xf3, 0x9e, 0xf5,
+	0xa3, 0xaf, 0x64, 0x49, 0xf9, 0xb4, 0x8b, 0xed, 0x1e, 0x61, 0x94, 0x77, 0xa0, 0x57, 0x44, 0xd3,
+	0xcf, 0x70, 0x46, 0x83, 0x7b, 0x4f, 0xe5, 0x78, 0xd7, 0x71, 0xfb, 0xe7, 0x23, 0x5b, 0x8c, 0xb4,
+	0x2b, 0xcb, 0x48, 0xf9, 0xdb, 0x0e, 0x35, 0xd2, 0xf6, 0x5d, 0xd4, 0x7d, 0xac, 0x5d, 0xd4, 0xd3,
+	0x71, 0x17, 0x3d, 0xaf, 0x79, 0x9c, 0xfd, 0xe0, 0x40, 0x1e, 0xca, 0x58, 0x77, 0xe5, 0x9f, 0x75,
+	0xc1, 0xe0, 0x9a, 0x6e, 0x39, 0x2c, 0x4b, 0x65, 0xe0, 0xc7, 0x96, 0x63, 0xba, 0x4f, 0xd0, 0x3d,
+	0x00, 0x3f, 0xd0, 0x09, 0x2f, 0xd5, 0x08, 0xb5, 0xbe, 0x34, 0x6d, 0x5a, 0x7e, 0x40, 0xac, 0xad,
+	0x3a, 0xbd, 0xe6, 0xd7, 0xf4, 0xc0, 0xd8, 0xd1, 0x30, 0xcd, 0x39, 0xe2, 0x69, 0x5a, 0xa6, 0xf1,
+	0x03, 0xbd, 0xe6, 0x09, 0xaf, 0x92, 0x67, 0x83, 0x29, 0x14, 0x2d, 0x42, 0x0e, 0x3b, 0x26, 0xe7,
+	0xd3, 0x75, 0x5c, 0x3e, 0x67, 0xb0, 0x63, 0x32, 0x2e, 0x73, 0x80, 0xb6, 0x75, 0xcb, 0x76, 0xe9,
+	0x99, 0x6c, 0x63, 0x5d, 0xf0, 0xcb, 0x2a, 0x89, 0x95, 0x42, 0xba, 0x55, 0xac, 0xf3, 0xb1, 0x17,
+	0xa0, 0xe0, 0xb8, 0x5a, 0x08, 0x16, 0xe9, 0x30, 0x70, 0xdc, 0x65, 0x01, 0x41, 0xb7, 0x42, 0xd7,
+	0x72, 0x9a, 0x9d, 0xdb, 0x17, 0x13, 0xcb, 0xde, 0xa6, 0x19, 0xb6, 0x81, 0xc4, 0x5e, 0xe6, 0xa3,
+	0x94, 0xff, 0xae, 0x40, 0x14, 0x03, 0xbf, 0xb0, 0x9a, 0xd4, 0xb7, 0x21, 0xc7, 0x52, 0x57, 0x61,
+	0x48, 0x50, 0x98, 0x3d, 0xdf, 0x59, 0x6d, 0xab, 0xae, 0x21, 0xc6, 0x46, 0x83, 0x68, 0xe0, 0xf3,
+	0xbe, 0xeb, 0x60, 0x79, 0x9e, 0x07, 0x3e, 0xf4, 0x37, 0xba, 0x0e, 0x60, 0x79, 0x51, 0x92, 0xa0,
+	0x97, 0xcd, 0x76, 0x38, 0x79, 0x75, 0xf2, 0x44, 0xa2, 0x40, 0xcd, 0x5b, 0x5e, 0x22, 0x67, 0x40,
+	0xe7, 0x69, 0x19, 0x9a, 0xe5, 0xf9, 0xc2, 0xfb, 0xe6, 0x39, 0x64, 0xc5, 0xf3, 0xd1, 0xcb, 0x30,
+	0xe0, 0xd4, 0x6b, 0x9a, 0xd9, 0x74, 0xf4, 0x9a, 0xa0, 0xc9, 0xb1, 0xc0, 0xb1, 0xcf, 0xa9, 0xd7,
+	0x16, 0x39, 0x94, 0xd2, 0x2d, 0x41, 0x81, 0xae, 0x99, 0x66, 0xb3, 0x32, 0x2c, 0xf3, 0xc1, 0x85,
+	0xd9, 0xc9, 0x64, 0x88, 0xd4, 0x5e, 0xac, 0x15, 0x93, 0x82, 0x20, 0x82, 0xa0, 0xcb, 0xd0, 0x8b,
+	0x09, 0x71, 0x89, 0x2f, 0x03, 0xd5, 0xef, 0x9d, 0x3e, 0xba, 0x12, 0x71, 0x36, 0x5f, 0x20, 0xd1,
+	0xf5, 0x70, 0x49, 0x8b, 0x6c, 0x92, 0x49, 0x8f, 0xb0, 0x49, 0x74, 0x63, 0x17, 0x9b, 0xed, 0x0b,
+	0x89, 0xbe, 0x0d, 0x45, 0x96, 0x9f, 0x68, 0x60, 0x42, 0x2c, 0x13, 0xb3, 0x4c, 0x58, 0x7f, 0x7a,
+	0xb1, 0xd4, 0xb5, 0x75, 0x81, 0x0d, 0x83, 0x27, 0x83, 0xd4, 0x42, 0x10, 0x9a, 0x81, 0x52, 0xa2,
+	0x6e, 0xc2, 0x93, 0x98, 0xfd, 0x89, 0xc3, 0x66, 0x20, 0xc6, 0xf2, 0x24, 0xe6, 0x9b, 0xad, 0xe9,
+	0xe6, 0x01, 0x76, 0x54, 0x0c, 0xef, 0x3d, 0x95, 0xdb, 0x72, 0xd3, 0x2d, 0x49, 0xe8, 0x1b, 0x20,
+	0x4a, 0x6e, 0x9a, 0x4f, 0x44, 0x61, 0xa2, 0xc4, 0xa3, 0xeb, 0xb4, 0x46, 0xfa, 0x38, 0x55, 0x85,
+	0xf0, 0x32, 0xc5, 0x5b, 0xd0, 0xcb, 0x6f, 0x0c, 0x2c, 0x45, 0x55, 0x48, 0x2d, 0xff, 0x32, 0x43,
+	0x50, 0x43, 0xec, 0xdf, 0x7b, 0x2a, 0xf7, 0xf2, 0x47, 0xee, 0x25, 0xf9, 0x18, 0x96, 0x1e, 0xdf,
+	0x69, 0xfa, 0x96, 0xa1, 0xdb, 0xfc, 0x60, 0x44, 0x22, 0x3d, 0x2e, 0x80, 0xec, 0x34, 0xbc, 0x19,
+	0xd7, 0xe4, 0x86, 0x98, 0x1f, 0xbd, 0x90, 0x61, 0xee, 0x99, 0xd5, 0xb8, 0x57, 0x61, 0x30, 0xae,
+	0x6b, 0x86, 0x01, 0x31, 0x2f, 0x0a, 0x96, 0x22, 0x44, 0x18, 0x13, 0xff, 0x02, 0xf4, 0x0a, 0x1f,
+	0x3b, 0xd2, 0x16, 0x4f, 0xa6, 0x2b, 0x7f, 0x77, 0x72, 0x54, 0x25, 0x7c, 0x22, 0x7c, 0x08, 0x7a,
+	0x0c, 0x05, 0x82, 0x7d, 0x5a, 0xda, 0xd2, 0x6a, 0xba, 0x27, 0xd2, 0x1d, 0x4a, 0x96, 0x9c, 0xfc,
+	0x36, 0xba, 0xa6, 0x7b, 0xfc, 0x86, 0x3a, 0x44, 0x59, 0xb5, 0xde, 0x52, 0xf3, 0x24, 0x24, 0x42,
+	0x95, 0x74, 0x1e, 0x85, 0xa7, 0x3e, 0x5e, 0xca, 0x62, 0xdc, 0x92, 0x4a, 0x68, 0x5d, 0xb7, 0x64,
+	0x3a, 0xe5, 0x2a, 0x94, 0xa2, 0x72, 0x6d, 0xa8, 0x16, 0x5e, 0xfc, 0xea, 0x6f, 0xf0, 0x4a, 0x6d,
+	0xa8, 0x94, 0x49, 0x80, 0xd8, 0xc6, 0x44, 0xa5, 0x2a, 0x01, 0x41, 0x0b, 0x50, 0x62, 0x3d, 0x18,
+	0xbc, 0xe2, 0xc6, 0xde, 0xc0, 0x92, 0x7c, 0xfd, 0x29, 0xf5, 0xb1, 0x9b, 0x2a, 0x2d, 0xb9, 0x31,
+	0x02, 0xb5, 0xdf, 0x4a, 0x3d, 0xd3, 0x7d, 0xc2, 0x99, 0x08, 0xfd, 0x4f, 0xb4, 0x39, 0xb5, 0xc4,
+	0x55, 0x57, 0xec, 0xe1, 0x82, 0x15, 0x83, 0xd0, 0x63, 0x18, 0xac, 0xc5, 0x8e, 0x55, 0x84, 0x6e,
+	0x93, 0x4c, 0x8c, 0x6b, 0x9d, 0xbd, 0x5c, 0xc2, 0x17, 0xb3, 0xcd, 0xab, 0x96, 0x6a, 0x2d, 0x10,
+	0xb4, 0x02, 0x17, 0xc3, 0xdd, 0x2b, 0xaa, 0x1f, 0x5a, 0xbb, 0x41, 0xf1, 0x44, 0xe0, 0x64, 0x48,
+	0xc8, 0x4b, 0x21, 0x0b, 0xad, 0xe6, 0xf5, 0x12, 0x9c, 0x09, 0xb3, 0xf6, 0x53, 0x6c, 0x5f, 0x01,
+	0xdd, 0x13, 0x8f, 0xd6, 0x68, 0xae, 0x50, 0xed, 0x6d, 0xf0, 0xfc, 0xfd, 0xdb, 0x30, 0x92, 0xac,
+	0x33, 0xf2, 0xba, 0x1f, 0xf5, 0xf3, 0x17, 0xb3, 0xb6, 0x22, 0x8a, 0x8b, 0xa0, 0x8c, 0x92, 0xde,
+	0x8c, 0xbf, 0x03, 0x17, 0x12, 0x1c, 0x68, 0x25, 0xbc, 0xee, 0x55, 0x89, 0x6e, 0xe2, 0xb0, 0xa6,
+	0x62, 0xca, 0x4a, 0xc2, 0x83, 0x9c, 0x8b, 0x58, 0xdc, 0xc7, 0xcd, 0x87, 0x9c, 0x52, 0x54, 0x55,
+	0x4c, 0xf4, 0x8b, 0x00, 0xbc, 0x8d, 0xc3, 0xd4, 0xf4, 0x80, 0x25, 0x0c, 0x8f, 0x78, 0xd8, 0x8e,
+	0x08, 0x39, 0xf3, 0x41, 0x08, 0xe2, 0xa7, 0xb8, 0xe0, 0x36, 0x1f, 0x50, 0xd6, 0xbc, 0xb9, 0x83,
+	0xb1, 0xbe, 0xf4, 0xfc, 0xac, 0x05, 0xb7, 0xf9, 0x00, 0xcd, 0x42, 0x31, 0x55, 0xae, 0xba, 0xcc,
+	0x54, 0xc7, 0x32, 0x41, 0x89, 0x52, 0x95, 0x5a, 0x08, 0xe2, 0x07, 0x74, 0x1f, 0x50, 0x72, 0x8c,
+	0xb0, 0xa0, 0x97, 0x8f, 0xe2, 0xeb, 0x4b, 0x09, 0x3e, 0xdc, 0x68, 0xee, 0xc2, 0x40, 0x3a, 0xbf,
+	0xe8, 0xcb, 0x57, 0xda, 0xb2, 0x8a, 0xa9, 0xbc, 0x8a, 0xb0, 0xe9, 0xfe, 0x54, 0x56, 0xd1, 0xa7,
+	0x35, 0x31, 0x13, 0x6f, 0xb3, 0xd2, 0x7b, 0xc4, 0xb0, 0x35, 0xa9, 0x72, 0x95, 0x9d, 0x8d, 0xe7,
+	0x05, 0x5d, 0xc8, 0x75, 0x3e, 0x9d, 0x63, 0xb9, 0x01, 0xfd, 0xf7, 0x5c, 0x3f, 0x10, 0x29, 0x66,
+	0x1b, 0x13, 0xf9, 0x95, 0x2c, 0x7b, 0x6a, 0x21, 0xa2, 0xde, 0x79, 0x57, 0xdf, 0xde, 0xd5, 0xa3,
+	0xfa, 0xc1, 0x35, 0xee, 0x9d, 0x19, 0x30, 0x2c, 0x18, 0x9c, 0x07, 0xe0, 0x44, 0x75, 0x1f, 0x13,
+	0xf9, 0x55, 0x7e, 0x9c, 0x33, 0xc8, 0x43, 0x1f, 0x13, 0x96, 0x90, 0x60, 0x68, 0x4f, 0xf7, 0xfd,
+	0x27, 0x2e, 0x31, 0xe5, 0xb2, 0x48, 0x48, 0x50, 0xe8, 0x86, 0x00, 0xa2, 0x37, 0x01, 0x68, 0x12,
+	0x5b, 0x38, 0x80, 0xd7, 0xda, 0x8e, 0x92, 0x28, 0x5c, 0x0e, 0x03, 0xc2, 0xaa, 0x57, 0x17, 0x9b,
+	0x7f, 0x05, 0x2e, 0x62, 0x87, 0xba, 0x4d, 0x2d, 0x54, 0x16, 0x2d, 0xdb, 0x61, 0x62, 0xd3, 0x0d,
+	0x10, 0x4a, 0x3e, 0xcd, 0xf7, 0x28, 0x27, 0x5c, 0xe4, 0x74, 0x95, 0x88, 0x2c, 0x9c, 0xcb, 0x4b,
+	0xd0, 0xa7, 0xdb, 0xb6, 0xc5, 0x9c, 0x88, 0x4b, 0xaa, 0xbe, 0x3c, 0xc3, 0x62, 0xae, 0x62, 0x08,
+	0x5c, 0x27, 0x55, 0x1a, 0x78, 0x5c, 0xe8, 0x58, 0xec, 0xd2, 0xdc, 0x27, 0x0e, 0x26, 0xf2, 0x37,
+	0xd8, 0x14, 0x27, 0xfc, 0xec, 0x82, 0xd7, 0x3a, 0xa5, 0xc9, 0xb8, 0x46, 0xbe, 0xde, 0xf9, 0x1a,
+	0xf9, 0x16, 0x8c, 0x77, 0x2e, 0x3d, 0xc9, 0xb3, 0x6c, 0x72, 0xb2, 0xd7, 0xa1, 0xd0, 0x84, 0x2a,
+	0x70, 0x21, 0xbb, 0x8f, 0x21, 0xf6, 0x2f, 0xd7, 0xb3, 0xec, 0xe1, 0x5c, 0x46, 0x2b, 0x43, 0xe4,
+	0x68, 0xfe, 0x0a, 0xbc, 0x92, 0xc9, 0x34, 0xd3, 0xe5, 0xbc, 0x91, 0x98, 0xda, 0xa5, 0x76, 0xae,
+	0x19, 0xbe, 0xe7, 0x1e, 0x8c, 0xc5, 0xec, 0x5b, 0x03, 0x93, 0x1b, 0x59, 0xd2, 0x8e, 0x46, 0xf4,
+	0x0f, 0x52, 0x11, 0xca, 0x45, 0xc8, 0xd3, 0xd6, 0x14, 0x5b, 0xdf, 0xc2, 0xb6, 0xfc, 0xcd, 0xc4,
+	0xd5, 0x39, 0x67, 0x3a, 0xfe, 0x2a, 0x85, 0xa2, 0x97, 0xa1, 0x28, 0xca, 0x22, 0xda, 0xf6, 0xbb,
+	0xa6, 0x23, 0x7f, 0x2b, 0x41, 0x05, 0x84, 0x55, 0x47, 0x96, 0xdf, 0x35, 0x1d, 0x74, 0x1d, 0x86,
+	0x78, 0xa0, 0xaa, 0xa5, 0xc8, 0xbf, 0x9d, 0x20, 0x2f, 0x71, 0x02, 0x35, 0x1e, 0xa4, 0xc2, 0x60,
+	0xba, 0xad, 0x81, 0x5a, 0xf8, 0x4d, 0x66, 0xe1, 0xe7, 0x92, 0xc1, 0x52, 0x4b, 0x3b, 0x44, 0x22,
+	0xc8, 0x28, 0x6d, 0xb7, 0xe0, 0x9e, 0x95, 0x20, 0x78, 0xf3, 0x28, 0x09, 0x02, 0xf4, 0x36, 0xf4,
+	0xf1, 0x63, 0x97, 0x07, 0x63, 0xbe, 0x3c, 0xc7, 0xbc, 0xd4, 0x48, 0x5b, 0x04, 0x47, 0x33, 0x81,
+	0x82, 0x1b, 0x3f, 0xa8, 0x39, 0x98, 0x95, 0xa9, 0x44, 0xed, 0x8f, 0x97, 0xf8, 0x7f, 0x81, 0x67,
+	0x4b, 0x04, 0x8c, 0xd5, 0xf5, 0x27, 0xa1, 0x90, 0x2c, 0xea, 0xdd, 0x62, 0x14, 0x79, 0x23, 0x2a,
+	0xe6, 0x5d, 0x82, 0x5e, 0x77, 0xeb, 0x7b, 0xb4, 0xfd, 0xe2, 0x76, 0xd6, 0xa2, 0x9e, 0x76, 0xb7,
+	0xbe, 0xb7, 0x62, 0xa2, 0x65, 0x28, 0x24, 0x7a, 0x4f, 0xe5, 0xb7, 0xdb, 0xae, 0xd3, 0x71, 0x14,
+	0x14, 0x93, 0xf1, 0x58, 0x30, 0x39, 0x10, 0xbd, 0x06, 0x05, 0x73, 0x8b, 0xf5, 0x4b, 0xd9, 0xf4,
+	0x95, 0x77, 0xa8, 0xf3, 0x6c, 0x7d, 0x65, 0xde, 0xdc, 0xa2, 0xdd, 0x53, 0xf6, 0x8a, 0x49, 0xab,
+	0xe6, 0x26, 0x69, 0x6a, 0xa4, 0xee, 0xc8, 0x0b, 0xbc, 0x6a, 0x6e, 0x92, 0xa6, 0x5a, 0x77, 0xe8,
+	0x79, 0x91, 0x0c, 0x38, 0x9e, 0xb0, 0xab, 0x9c, 0xbc, 0xc8, 0x16, 0x75, 0xe2, 0xb0, 0xeb, 0x9e,
+	0x3a, 0x58, 0x6b, 0x05, 0x3d, 0x47, 0x4f, 0xcd, 0xf8, 0x63, 0xe8, 0x4f, 0xc7, 0x93, 0x19, 0xa3,
+	0x67, 0xd2, 0x85, 0x9e, 0xb1, 0xf4, 0x21, 0x14, 0xc6, 0x9c, 0xf7, 0x71, 0x33, 0xc9, 0xf8, 0xd6,
+	0x51, 0x4a, 0x53, 0x9d, 0xe5, 0xba, 0x0d, 0xa5, 0xd6, 0x85, 0x38, 0x56, 0x76, 0xe1, 0x0f, 0x7b,
+	0x0e, 0x4b, 0x3e, 0x7d, 0xc6, 0x93, 0x4f, 0xbf, 0xdd, 0xbd, 0x2a, 0x2e, 0xa7, 0xd3, 0xf7, 0x5c,
+	0x62, 0xbd, 0x4f, 0x23, 0x2e, 0x7b, 0xde, 0x30, 0xea, 0x44, 0x37, 0x9a, 0xe5, 0x08, 0xf7, 0x08,
+	0x93, 0xc0, 0x32, 0xb2, 0x30, 0x0b, 0x6e, 0x9d, 0xf8, 0x38, 0x7e, 0xae, 0x78, 0x18, 0x9b, 0xf1,
+	0x63, 0x14, 0x74, 0x94, 0xf9, 0xde, 0x29, 0xf3, 0x64, 0xd7, 0x12, 0xbb, 0x11, 0x96, 0xdb, 0x5d,
+	0x62, 0xf9, 0x10, 0x7f, 0x56, 0xae, 0x74, 0x76, 0xa5, 0x19, 0xb8, 0x0c, 0x06, 0x0b, 0x61, 0xec,
+	0x54, 0x7e, 0x18, 0x86, 0x3a, 0xe5, 0xcd, 0x96, 0xd0, 0xa3, 0x9c, 0x3e, 0xc0, 0x5b, 0x72, 0x70,
+	0x77, 0xc3, 0x23, 0x73, 0x3a, 0x33, 0x5f, 0x27, 0x9c, 0x61, 0x39, 0x76, 0x5d, 0xe5, 0x4a, 0x8b,
+	0x2f, 0xeb, 0x98, 0xb4, 0x2b, 0xb7, 0x19, 0xfa, 0x34, 0x13, 0xe8, 0xe7, 0x53, 0x27, 0xcb, 0x4a,
+	0xfb, 0xd1, 0x34, 0xdf, 0x77, 0x7a, 0x72, 0x6f, 0x95, 0x6e, 0x29, 0xbf, 0xd3, 0x05, 0x05, 0xee,
+	0xc1, 0xd6, 0x68, 0x8c, 0x89, 0xa6, 0x63, 0xc3, 0x3c, 0x52, 0x2a, 0xa5, 0xa5, 0x6e, 0xd6, 0xdd,
+	0x5a, 0x37, 0xa3, 0xd7, 0xce, 0x54, 0x6b, 0x08, 0xcb, 0x9b, 0xf0, 0x54, 0x5c, 0x29, 0x89, 0xf8,
+	0xae, 0xeb, 0xe0, 0xb9, 0xbf, 0x4d, 0x8b, 0x89, 0xd5, 0xe3, 0x2a, 0x89, 0xbd, 0xec, 0xd6, 0x72,
+	0xf4, 0xce, 0x17, 0x54, 0x5e, 0x84, 0x98, 0xa3, 0x32, 0x1d, 0x77, 0x48, 0xaf, 0xe9, 0x8e, 0xb5,
+	0x8d, 0xfd, 0x00, 0x8d, 0x43, 0xae, 0x26, 0x7e, 0x8b, 0x4d, 0x1b, 0x3d, 0x2b, 0xff, 0x41, 0x82,
+	0x62, 0xb2, 0x72, 0x9c, 0x59, 0x2a, 0x9b, 0x82, 0x82, 0x89, 0x7d, 0x83, 0x58, 0x5e, 0x5c, 0x94,
+	0x53, 0x93, 0xa0, 0xd8, 0x29, 0x74, 0x27, 0x9c, 0x02, 0x4d, 0x83, 0xf9, 0xd8, 0x20, 0x38, 0x10,
+	0xe9, 0x36, 0xf1, 0x84, 0x26, 0x20, 0x5f, 0xd3, 0x1d, 0x53, 0x0f, 0x5c, 0x12, 0x76, 0x98, 0xc5,
+	0x00, 0x2a, 0xae, 0x25, 0x1a, 0xad, 0x45, 0xf3, 0x58, 0xf4, 0x4c, 0x57, 0x31, 0x70, 0x03, 0x4f,
+	0x13, 0x6c, 0x79, 0x6f, 0x18, 0x50, 0x50, 0x85, 0x41, 0x94, 0x3f, 0x97, 0xa0, 0x2f, 0x54, 0x00,
+	0x9d, 0xd7, 0x11, 0x9b, 0xf9, 0xee, 0x65, 0x24, 0x7e, 0xaf, 0x66, 0x18, 0x15, 0x63, 0x79, 0x68,
+	0xf2, 0x57, 0x69, 0xa9, 0x60, 0x72, 0x85, 0xa4, 0x60, 0x5f, 0x57, 0xa9, 0x5f, 0xf9, 0x48, 0x82,
+	0xf1, 0x44, 0x61, 0x3d, 0xdd, 0xeb, 0x70, 0x44, 0x4d, 0xdc, 0xce, 0xd0, 0xc4, 0xb3, 0x1a, 0x2b,
+	0x8e, 0x39, 0x7f, 0xe5, 0xf7, 0xbb, 0x60, 0xa4, 0x55, 0xce, 0x87, 0x3e, 0x6d, 0x6e, 0x3d, 0xc1,
+	0xae, 0xe6, 0xd1, 0x50, 0x9d, 0x0e, 0x17, 0x5d, 0x95, 0xc0, 0x40, 0x9c, 0xe1, 0x2c, 0xf4, 0xb0,
+	0x42, 0x69, 0xf7, 0x91, 0x26, 0xc2, 0x68, 0xe9, 0xed, 0x27, 0xba, 0xb9, 0xf9, 0x86, 0x4b, 0xb8,
+	0x1b, 0xe8, 0x51, 0xa3, 0x92, 0x77, 0x85, 0x02, 0xe7, 0x1a, 0x3f, 0x1f, 0x3f, 0xa9, 0xec, 0xc6,
+	0x5b, 0xfc, 0xee, 0xc6, 0xc3, 0x93, 0xe9, 0xed, 0x0a, 0xf4, 0x54, 0xbd, 0x7a, 0xb8, 0xbe, 0x43,
+	0xe9, 0x3b, 0x1b, 0x63, 0xa9, 0x32, 0x02, 0xe5, 0x6f, 0x74, 0xc1, 0x50, 0xc8, 0x63, 0x3e, 0xbe,
+	0x50, 0x1d, 0xfb, 0x85, 0x4a, 0x56, 0x61, 0xbf, 0xa5, 0x8c, 0xff, 0x5b, 0xd4, 0xa9, 0x3a, 0xc7,
+	0xd4, 0x68, 0x78, 0xcb, 0xa3, 0x48, 0xf7, 0xc5, 0xb7, 0x6e, 0x14, 0x53, 0xaa, 0xff, 0xb3, 0xae,
+	0x58, 0xf7, 0x4b, 0x0d, 0xdd, 0xa8, 0xeb, 0xc1, 0xf1, 0x75, 0xff, 0x36, 0x0c, 0x04, 0x3a, 0xa9,
+	0xe2, 0x80, 0x1d, 0x31, 0x5a, 0x5c, 0x10, 0x40, 0x89, 0xb1, 0xf4, 0x94, 0x89, 0xc7, 0xf5, 0xf1,
+	0x01, 0x02, 0x88, 0x6e, 0xc3, 0xf0, 0x0e, 0xd6, 0x6d, 0xda, 0x94, 0xb0, 0x83, 0x8d, 0xdd, 0x43,
+	0x3f, 0x0e, 0x42, 0x9c, 0x72, 0x81, 0x12, 0x8a, 0xef, 0x68, 0xe6, 0x3e, 0xa6, 0x8a, 0xfe, 0x81,
+	0xf4, 0xb5, 0xd8, 0x6e, 0x99, 0x4e, 0xea, 0xd6, 0x66, 0x52, 0x5e, 0xce, 0x9b, 0xc2, 0xe9, 0xc8,
+	0x34, 0xea, 0xe8, 0x66, 0xff, 0xfd, 0x2e, 0x80, 0xf8, 0x66, 0xd3, 0xb1, 0xa9, 0xc5, 0xe0, 0x66,
+	0xcd, 0x9b, 0x5a, 0xe8, 0x03, 0x75, 0xad, 0x44, 0xaf, 0x89, 0x46, 0x71, 0xfa, 0x93, 0x8e, 0x35,
+	0x2d, 0x7f, 0x57, 0x6c, 0x6b, 0xf6, 0x1b, 0x5d, 0x13, 0x3b, 0x82, 0x57, 0xa6, 0x47, 0xd3, 0x3b,
+	0x22, 0x74, 0x13, 0x7c, 0x53, 0xa0, 0x05, 0xc8, 0x51, 0x8f, 0xc7, 0x92, 0xc6, 0xa7, 0xdb, 0x92,
+	0xc6, 0xb1, 0x90, 0xcc, 0x51, 0x47, 0x49, 0x63, 0x7e, 0x17, 0x3b, 0xe3, 0x71, 0x18, 0xed, 0x65,
+	0x4a, 0xa2, 0x8f, 0x13, 0x69, 0x2b, 0x37, 0xe0, 0xcc, 0x7a, 0x65, 0x9e, 0xea, 0x30, 0x53, 0x0f,
+	0xf4, 0xdc, 0x0d, 0xf4, 0x40, 0x28, 0x22, 0xaf, 0x8a, 0x27, 0x85, 0xd0, 0x61, 0xfc, 0x2b, 0x82,
+	0xac, 0x61, 0x08, 0x7a, 0x02, 0xbd, 0x1a, 0x0e, 0x62, 0xbf, 0x69, 0x2a, 0x39, 0x71, 0x1c, 0x88,
+	0xa8, 0x29, 0x86, 0xb0, 0x2e, 0x1a, 0xcb, 0xdf, 0xd5, 0xe8, 0xf9, 0xa1, 0x07, 0x22, 0x5e, 0x02,
+	0x0a, 0x5a, 0x66, 0x10, 0xe5, 0xb7, 0x8a, 0x50, 0x8c, 0xbf, 0x9f, 0xe2, 0xcd, 0x29, 0x2f, 0xa4,
+	0x36, 0x16, 0xd5, 0xeb, 0xba, 0x59, 0xc2, 0xef, 0x4a, 0xe7, 0x3c, 0x64, 0xc8, 0x80, 0xe7, 0x8b,
+	0xf9, 0x28, 0xf4, 0x32, 0x6d, 0xdf, 0x67, 0x09, 0x0a, 0xcb, 0x14, 0x1f, 0xc3, 0x25, 0xbe, 0x07,
+	0xc9, 0x71, 0xdc, 0x8a, 0x89, 0x5e, 0x01, 0x30, 0xe2, 0x0c, 0xdc, 0xe9, 0xd6, 0x0f, 0x47, 0x12,
+	0x48, 0xda, 0xe5, 0xed, 0xfa, 0x5a, 0x4d, 0x7f, 0x4f, 0xa3, 0xf6, 0xd6, 0xcb, 0x8c, 0x2b, 0xe7,
+	0xfa, 0x6b, 0xfa, 0x7b, 0xaa, 0x5e, 0xa3, 0x1f, 0xe5, 0x08, 0x6c, 0x83, 0x1e, 0x1f, 0xbc, 0x88,
+	0xd6, 0xa3, 0x16, 0x18, 0xc1, 0x23, 0x06, 0x42, 0x17, 0x63, 0x1a, 0xd7, 0xd6, 0xaa, 0x5b, 0xac,
+	0x88, 0xd6, 0xa3, 0x02, 0xa7, 0x71, 0xed, 0xbb, 0x5b, 0x54, 0x7d, 0xa2, 0xf4, 0x95, 0xe7, 0xea,
+	0xe3, 0x4f, 0x68, 0x06, 0xce, 0x84, 0x19, 0x01, 0x38, 0x24, 0x23, 0xa0, 0x86, 0x54, 0xe8, 0xed,
+	0xc8, 0x48, 0x0a, 0x53, 0x52, 0x0b, 0x7d, 0x85, 0x21, 0x58, 0x06, 0x61, 0xf0, 0x37, 0xbf, 0x4a,
+	0xdc, 0xb3, 0x79, 0xf9, 0x84, 0x8f, 0xcb, 0x2e, 0xd4, 0x14, 0x3b, 0x14, 0x6a, 0xe6, 0x01, 0xb5,
+	0x85, 0xd7, 0xf4, 0x03, 0xa6, 0xee, 0x16, 0xc7, 0x27, 0xec, 0x5a, 0x1d, 0x6c, 0x8d, 0xb9, 0xe9,
+	0x14, 0xf3, 0xae, 0x68, 0xbb, 0xf7, 0xe5, 0xfe, 0x8c, 0x91, 0xcc, 0xb4, 0xa9, 0xca, 0xd9, 0x0f,
+	0x1f, 0xcd, 0xc1, 0x58, 0xbc, 0x3c, 0x1a, 0xff, 0x64, 0x88, 0x60, 0x03, 0x5b, 0x0d, 0x6c, 0x8a,
+	0x76, 0xf2, 0xb3, 0x31, 0xc1, 0x02, 0xc5, 0xab, 0x02, 0x9d, 0x5d, 0x9d, 0x28, 0xbd, 0x80, 0xea,
+	0xc4, 0x77, 0x01, 0x45, 0x5f, 0xa9, 0x6a, 0xbe, 0xa3, 0x7b, 0xfe, 0x8e, 0x1b, 0x88, 0x3a, 0xdc,
+	0xc5, 0x4e, 0xf1, 0x89, 0x5f, 0x11, 0x84, 0x89, 0x04, 0xd3, 0x20, 0x69, 0x45, 0xa2, 0xa5, 0xcc,
+	0x8c, 0x38, 0x3a, 0x34, 0x23, 0x9e, 0x91, 0x0b, 0xbf, 0x0d, 0x23, 0x86, 0x5b, 0xf3, 0xf4, 0xc0,
+	0x12, 0x8b, 0x15, 0x2e, 0x2e, 0xfd, 0xc6, 0xa1, 0x2f, 0x69, 0xfe, 0xc3, 0x29, 0xba, 0x70, 0xad,
+	0xef, 0xa6, 0x9c, 0xc6, 0x30, 0x5b, 0xa9, 0x2b, 0x99, 0xdf, 0x53, 0x6e, 0xbb, 0x87, 0x06, 0xd3,
+	0xb3, 0x00, 0xac, 0x77, 0x9b, 0x86, 0x65, 0xbe, 0x3c, 0xd2, 0x16, 0xac, 0xd0, 0xbe, 0x6b, 0x66,
+	0xd5, 0x79, 0x47, 0xfc, 0x62, 0x2d, 0xd6, 0xba, 0x11, 0x58, 0x0d, 0xcc, 0xf2, 0x9b, 0x96, 0xe3,
+	0x07, 0x54, 0xf7, 0xfc, 0x63, 0x34, 0x75, 0x90, 0xa3, 0x16, 0x48, 0x6d, 0x45, 0x20, 0xa8, 0x07,
+	0xa3, 0xbf, 0xcc, 0x2d, 0x96, 0x10, 0x65, 0xdf, 0x9e, 0xe5, 0x54, 0x10, 0xa0, 0x05, 0x42, 0xbb,
+	0xe6, 0x06, 0x08, 0xb6, 0xb1, 0xee, 0xb7, 0x95, 0xdd, 0x04, 0x38, 0x9c, 0x76, 0x28, 0x2d, 0xef,
+	0x34, 0x9f, 0xce, 0x94, 0x96, 0x15, 0x8e, 0xf2, 0x8e, 0xf8, 0xe5, 0x3f, 0x6f, 0x43, 0xc7, 0xbf,
+	0x68, 0xeb, 0xf7, 0xf9, 0xd1, 0xbe, 0x2c, 0x7d, 0xb2, 0x2f, 0xf7, 0xa5, 0x9c, 0x1e, 0xcd, 0xbd,
+	0xfc, 0x09, 0xcf, 0xbf, 0xf4, 0xf2, 0xbd, 0xfd, 0x73, 0xbb, 0xe3, 0xb3, 0xd6, 0xe3, 0x8f, 0xbf,
+	0x8a, 0x1b, 0x86, 0x95, 0x97, 0x60, 0x20, 0xfc, 0xbd, 0x86, 0x03, 0x62, 0x19, 0xec, 0xc8, 0xde,
+	0x76, 0x5d, 0xe6, 0x6d, 0x7b, 0x54, 0xfa, 0x53, 0xa9, 0xc1, 0x58, 0x76, 0xd3, 0xfa, 0x7d, 0x76,
+	0x0b, 0x48, 0x36, 0x96, 0x16, 0x3e, 0x79, 0x2a, 0x9f, 0x31, 0x6a, 0x06, 0x05, 0x89, 0x93, 0x6d,
+	0x0c, 0xba, 0x2c, 0x53, 0x34, 0x93, 0xe6, 0x3f, 0x79, 0x2a, 0x9f, 0x36, 0x6a, 0x86, 0x65, 0xaa,
+	0x5d, 0x96, 0x39, 0x37, 0x1c, 0xf6, 0x8e, 0x86, 0xb2, 0xb1, 0x1e, 0xd2, 0xfd, 0xd3, 0x30, 0x9a,
+	0xfd, 0xbe, 0x8e, 0xe7, 0xd7, 0x5b, 0xc9, 0xf3, 0x2b, 0x2b, 0xcb, 0xd9, 0x26, 0x77, 0xba, 0xd3,
+	0xa3, 0x18, 0x75, 0xef, 0x53, 0x36, 0xdd, 0x47, 0x38, 0x06, 0x0b, 0x46, 0x0c, 0x42, 0x6f, 0x41,
+	0x21, 0x2c, 0x67, 0xd0, 0xf1, 0x3d, 0x6d, 0x3e, 0xbd, 0xed, 0xbd, 0x60, 0xc4, 0x1a, 0x9c, 0x04,
+	0x20, 0x98, 0x56, 0x60, 0x68, 0x56, 0x51, 0x5c, 0xc9, 0x13, 0x10, 0x9a, 0x33, 0x4e, 0x7e, 0xc6,
+	0x26, 0xee, 0xe5, 0x85, 0x5a, 0xfc, 0xd9, 0x1a, 0xba, 0x0e, 0x28, 0x51, 0x5a, 0x09, 0x77, 0xca,
+	0x99, 0x64, 0xcf, 0x5c, 0x8c, 0x8f, 0xb7, 0x0c, 0xe2, 0x9f, 0xb7, 0x6a, 0x2e, 0xd1, 0xa2, 0x56,
+	0x97, 0x64, 0x5f, 0x6a, 0x89, 0xe3, 0xd7, 0x49, 0x98, 0xe1, 0xa3, 0x35, 0x98, 0xe8, 0x7a, 0x56,
+	0x25, 0x6e, 0xdd, 0x93, 0xf3, 0x09, 0xfa, 0xe8, 0x92, 0x76, 0x97, 0xa2, 0xd0, 0x78, 0x18, 0x25,
+	0x24, 0x9b, 0xf7, 0x38, 0x08, 0xbd, 0x0e, 0x83, 0x6e, 0xd8, 0x6e, 0xa2, 0xdb, 0xc2, 0x59, 0x26,
+	0xdb, 0xf4, 0x4a, 0x09, 0x34, 0xef, 0x31, 0xfd, 0x6f, 0x6c, 0xbf, 0x7d, 0x78, 0x20, 0xff, 0x73,
+	0x29, 0xdd, 0xe5, 0x66, 0x99, 0xec, 0xf7, 0x8a, 0x59, 0x16, 0x5a, 0xbd, 0x15, 0xeb, 0x3b, 0xdc,
+	0x5b, 0xec, 0x99, 0x6e, 0x9e, 0x04, 0x2a, 0xb5, 0xc3, 0xa2, 0x0d, 0x99, 0x58, 0xeb, 0xf6, 0x8d,
+	0x99, 0x44, 0x76, 0xde, 0xa0, 0x49, 0xaa, 0x4e, 0x1b, 0x75, 0x20, 0xe2, 0xcf, 0x65, 0xbf, 0x36,
+	0x07, 0xfd, 0xe9, 0xaa, 0x3f, 0x1a, 0x84, 0xbe, 0xc5, 0x15, 0x75, 0x69, 0x61, 0x53, 0x9b, 0x5f,
+	0x58, 0x58, 0xaa, 0x54, 0x4a, 0xa7, 0xd0, 0x08, 0x0c, 0xaa, 0x4b, 0x95, 0x4d, 0x75, 0x65, 0x61,
+	0x73, 0x69, 0x31, 0x04, 0x4b, 0xd7, 0xca, 0xd0, 0xcb, 0xfb, 0x9b, 0x51, 0x1e, 0x4e, 0xaf, 0xae,
+	0x3c, 0x78, 0xf8, 0x97, 0x4a, 0xa7, 0x50, 0x01, 0xce, 0x3c, 0x5e, 0x79, 0xb0, 0xb8, 0xfe, 0xb8,
+	0x52, 0x92, 0x10, 0x40, 0xef, 0xfa, 0xe6, 0xbd, 0x25, 0xb5, 0x52, 0x1a, 0xbe, 0xf6, 0x57, 0x61,
+	0x34, 0xbb, 0xab, 0x0a, 0x4d, 0xc1, 0xc4, 0xda, 0xfc, 0xca, 0x83, 0xcd, 0xa5, 0x07, 0xf3, 0x0f,
+	0x16, 0x96, 0x34, 0x3e, 0x5c, 0xab, 0x2c, 0xdc, 0x5b, 0x5a, 0x7c, 0xb8, 0xba, 0xb4, 0x58, 0x3a,
+	0x85, 0x2e, 0xc0, 0xb9, 0x0c, 0x8a, 0xe5, 0xf9, 0x95, 0xd5, 0xf5, 0x47, 0x4b, 0x6a, 0x49, 0x42,
+	0x0a, 0x4c, 0x66, 0x10, 0xac, 0x3c, 0xd0, 0x36, 0xd4, 0xf5, 0xbb, 0x2a, 0x15, 0xb7, 0xeb, 0xda,
+	0x32, 0x4d, 0xc2, 0x7b, 0x2e, 0x09, 0x2a, 0xc6, 0x0e, 0x36, 0xeb, 0x36, 0x46, 0x7d, 0x90, 0x5f,
+	0x6a, 0x60, 0xd2, 0x7c, 0x8c, 0xf1, 0x6e, 0xe9, 0x14, 0x1a, 0x80, 0x02, 0x7b, 0x7c, 0xfd, 0xc6,
+	0xa2, 0xde, 0xf4, 0x4b, 0x12, 0xea, 0x07, 0x60, 0x80, 0x35, 0xd7, 0x09, 0x76, 0x4a, 0xdd, 0xe3,
+	0x3d, 0x9f, 0x3f, 0x95, 0x4f, 0xcd, 0xfe, 0xb0, 0x07, 0x86, 0x5a, 0x9b, 0x74, 0xe6, 0x3d, 0x0b,
+	0xfd, 0xb6, 0x04, 0xc3, 0x95, 0x1d, 0xf7, 0x49, 0x2b, 0x0e, 0x9d, 0x3b, 0xe4, 0x0b, 0x98, 0xf1,
+	0xc3, 0x90, 0xca, 0xda, 0xde, 0xbe, 0x7c, 0x35, 0x8c, 0x08, 0xc2, 0xf5, 0xf5, 0xcb, 0xf3, 0x06,
+	0x5d, 0xce, 0x47, 0x16, 0x7e, 0x52, 0xf6, 0x77, 0x2d, 0x0f, 0x3b, 0xdb, 0x2e, 0x31, 0xf0, 0xaf,
+	0xfc, 0xe7, 0xff, 0xf5, 0xeb, 0x5d, 0xe7, 0x94, 0xd1, 0x19, 0x7f, 0xc7, 0x7d, 0x32, 0x13, 0xa6,
+	0x78, 0xb6, 0x05, 0xaf, 0x39, 0xe9, 0xda, 0x37, 0x24, 0xf4, 0x6b, 0x12, 0x8c, 0x8a, 0x6c, 0xf3,
+	0xb1, 0xa4, 0x1c, 0x4c, 0x57, 0x23, 0xea, 0x76, 0xa0, 0x2c, 0xee, 0xed, 0xcb, 0xe7, 0x0f, 0x95,
+	0x8d, 0x09, 0x74, 0x5e, 0x91, 0x67, 0x78, 0x91, 0x34, 0x4b, 0x24, 0xf4, 0x6f, 0x24, 0x38, 0x97,
+	0xa5, 0xb4, 0x65, 0x97, 0xf0, 0xcb, 0x4e, 0xfb, 0x0d, 0xf9, 0x70, 0x95, 0xd5, 0xf7, 0xf6, 0xe5,
+	0xb1, 0x50, 0x2c, 0x3a, 0x22, 0x25, 0xd2, 0xef, 0x1e, 0xc8, 0xd2, 0x67, 0x07, 0xb2, 0xb4, 0x77,
+	0x20, 0x5f, 0x49, 0xed, 0x24, 0xb6, 0x27, 0x32, 0x77, 0xcd, 0x2f, 0x3d, 0x95, 0xa5, 0x48, 0xb5,
+	0x34, 0x86, 0xcd, 0x56, 0xed, 0xec, 0x7f, 0x2d, 0x26, 0x3e, 0x7e, 0xa0, 0xf6, 0xf0, 0x91, 0x04,
+	0x03, 0xbc, 0x1a, 0x10, 0x81, 0xd1, 0x70, 0x56, 0x8b, 0x6a, 0x96, 0x76, 0xab, 0x7b, 0xfb, 0xf2,
+	0x4c, 0x27, 0xed, 0xf2, 0x73, 0xa5, 0xdc, 0xea, 0x0e, 0xe8, 0xe4, 0xfe, 0xe1, 0xd3, 0xf6, 0xf6,
+	0x5a, 0x26, 0xfd, 0xa8, 0x32, 0x38, 0xc3, 0xfb, 0x3a, 0x66, 0xa2, 0xfe, 0x5c, 0x6e, 0x13, 0x7f,
+	0x53, 0x82, 0x01, 0x6e, 0x13, 0x27, 0x90, 0xb3, 0x72, 0x42, 0x39, 0x23, 0x99, 0x84, 0x6d, 0xb4,
+	0xc8, 0xf4, 0x8f, 0x24, 0x18, 0xe0, 0xf5, 0x93, 0x13, 0xc8, 0xe4, 0x9c, 0x50, 0xa6, 0xcf, 0x0f,
+	0xe4, 0xb3, 0xac, 0x47, 0xde, 0x2f, 0x53, 0xaf, 0x56, 0x5e, 0x89, 0xbb, 0xc9, 0x23, 0x71, 0x79,
+	0xff, 0x4a, 0xab, 0xb8, 0xbf, 0x26, 0x41, 0x1f, 0xb5, 0xe2, 0x67, 0x09, 0x9b, 0x09, 0x55, 0xd6,
+	0xf7, 0xf6, 0xe5, 0x57, 0x3a, 0x9a, 0x6c, 0x96, 0xa4, 0x9f, 0x85, 0x1a, 0x1c, 0x56, 0x06, 0xf8,
+	0x76, 0x6f, 0x11, 0xe8, 0x4b, 0x09, 0x06, 0xe7, 0x4d, 0xb3, 0xe5, 0xa3, 0x98, 0x0b, 0x1d, 0xbf,
+	0x0a, 0xe0, 0xdf, 0x76, 0x64, 0x29, 0xf3, 0x37, 0xa4, 0x13, 0x6a, 0xf3, 0x8b, 0x03, 0xf9, 0x36,
+	0xe3, 0xcd, 0xcf, 0x1b, 0xfe, 0x73, 0x31, 0xfa, 0x8a, 0x46, 0x00, 0x44, 0x55, 0x8b, 0x3f, 0xac,
+	0xa7, 0xbf, 0x92, 0x61, 0x33, 0x94, 0x95, 0xa1, 0x19, 0xdd, 0x34, 0xe3, 0x09, 0xb2, 0x0f, 0x17,
+	0xf8, 0x2c, 0xff, 0x7a, 0x17, 0x0c, 0xab, 0xb8, 0xe6, 0x36, 0xf0, 0x0b, 0x98, 0xe8, 0x4f, 0xa4,
+	0x93, 0x9b, 0xcd, 0x7a, 0x87, 0xd9, 0xb5, 0x4c, 0x48, 0x40, 0xef, 0x27, 0xbf, 0xf1, 0x11, 0xb0,
+	0x7b, 0xa9, 0xef, 0x79, 0xbe, 0x38, 0x90, 0x21, 0xd6, 0x5d, 0xe4, 0x7d, 0x08, 0x9b, 0x6b, 0xa6,
+	0x2a, 0x3e, 0xee, 0x82, 0xe1, 0xbb, 0x2c, 0x3d, 0xdc, 0xf2, 0x01, 0xcb, 0x33, 0x55, 0x31, 0xd1,
+	0x91, 0xe0, 0xa1, 0xba, 0xaa, 0x7c, 0x4e, 0xb5, 0xf2, 0xda, 0xa1, 0x6e, 0xbe, 0x55, 0x27, 0x9f,
+	0x71, 0x9d, 0x90, 0x17, 0xac, 0x93, 0x36, 0x0b, 0x62, 0xdf, 0x61, 0xa5, 0xcc, 0xa8, 0x83, 0xda,
+	0xaa, 0x38, 0x68, 0xd1, 0x59, 0x9d, 0xd8, 0xf4, 0xf0, 0xf9, 0x1d, 0x09, 0xc6, 0x92, 0x4a, 0x4b,
+	0x95, 0x4d, 0x51, 0xa7, 0xcf, 0x09, 0xb2, 0x8c, 0xe7, 0x2f, 0xef, 0xed, 0xcb, 0xaf, 0xb7, 0x6a,
+	0x69, 0xde, 0xd1, 0xed, 0x66, 0x60, 0x19, 0x29, 0x6d, 0xb5, 0x39, 0xe6, 0x29, 0xe5, 0x5c, 0x5a,
+	0x42, 0xd1, 0x08, 0xc2, 0x1b, 0x46, 0xe6, 0xa4, 0x6b, 0xb3, 0xff, 0xe9, 0x22, 0x14, 0x22, 0x9e,
+	0x9e, 0x85, 0xfe, 0x1a, 0xfd, 0x6a, 0x53, 0xfc, 0x35, 0x15, 0x87, 0xa2, 0xa1, 0x8c, 0x6b, 0x43,
+	0x96, 0x9c, 0xff, 0xfa, 0xa4, 0x46, 0x2e, 0x16, 0x55, 0x89, 0xda, 0x18, 0xca, 0x8b, 0xac, 0xc5,
+	0xa2, 0xbd, 0xa2, 0xfc, 0xc5, 0x81, 0x3c, 0xfb, 0x20, 0xd9, 0x02, 0x1e, 0x57, 0xea, 0x57, 0xf5,
+	0xc0, 0x0a, 0xea, 0x66, 0xa2, 0x94, 0xbf, 0xea, 0x3a, 0x55, 0x06, 0xea, 0x78, 0x6e, 0x8d, 0x28,
+	0xa5, 0xf0, 0xdc, 0x0a, 0x43, 0x62, 0x6e, 0xf1, 0xff, 0x58, 0x82, 0xfe, 0x45, 0xf1, 0x37, 0x58,
+	0xc7, 0xd4, 0xc2, 0x93, 0x93, 0xef, 0xf4, 0x23, 0x28, 0x20, 0x72, 0xcc, 0xe2, 0x68, 0x63, 0x62,
+	0x87, 0x52, 0xff, 0x61, 0x17, 0xf4, 0x3f, 0x14, 0xff, 0x04, 0x76, 0x4c, 0xa9, 0x7f, 0xa3, 0xeb,
+	0xf9, 0xd6, 0xee, 0x9f, 0x4a, 0xc9, 0x6f, 0x7f, 0xcb, 0x8b, 0xe9, 0x9e, 0xf4, 0x32, 0xcf, 0x18,
+	0x96, 0x37, 0x12, 0x2d, 0xdd, 0xe5, 0xd6, 0xee, 0xd8, 0x72, 0x3c, 0xfb, 0x47, 0xa9, 0x06, 0xe4,
+	0x04, 0xb7, 0x72, 0xfa, 0x3e, 0x51, 0x4e, 0xf4, 0x04, 0x97, 0xd7, 0x0f, 0xed, 0xbd, 0x2d, 0xf3,
+	0x7f, 0xc7, 0x48, 0xbc, 0x64, 0x29, 0xee, 0x50, 0x8a, 0x8c, 0x41, 0x9c, 0xc0, 0x69, 0x63, 0xf8,
+	0x75, 0x09, 0x8a, 0xf4, 0x00, 0x3e, 0x5c, 0xa9, 0x59, 0x40, 0xe5, 0xf1, 0xb1, 0x1d, 0xdc, 0xe7,
+	0x07, 0x72, 0x3e, 0x92, 0x91, 0xc9, 0x35, 0xa4, 0xf4, 0xf3, 0x63, 0x38, 0x2d, 0xd5, 0xdf, 0x93,
+	0x60, 0xe8, 0x2e, 0x0e, 0xda, 0xca, 0xf3, 0x1d, 0x2e, 0xf9, 0xe3, 0xe7, 0x32, 0xe0, 0xe1, 0x20,
+	0x65, 0x63, 0x6f, 0x5f, 0x7e, 0xf5, 0x19, 0xab, 0xdf, 0xb6, 0x7b, 0x42, 0xf7, 0x17, 0xca, 0x35,
+	0x13, 0xb6, 0x01, 0x50, 0xf7, 0xf7, 0x13, 0x09, 0x4a, 0x09, 0xf1, 0x78, 0xc9, 0x58, 0xee, 0x54,
+	0x03, 0x1f, 0xef, 0x88, 0x51, 0xde, 0x3d, 0x91, 0xf7, 0xfb, 0xfc, 0x40, 0x86, 0x38, 0x13, 0xf6,
+	0xc5, 0x41, 0xfa, 0xdb, 0xf4, 0xe8, 0xf0, 0x4f, 0x89, 0xcf, 0xfe, 0x7a, 0x8d, 0xca, 0xfe, 0x7f,
+	0x24, 0x38, 0x9f, 0x90, 0x3d, 0xa3, 0xf6, 0x7d, 0x39, 0xfb, 0xdb, 0xf3, 0x16, 0xb2, 0xf1, 0xa3,
+	0x91, 0x29, 0xef, 0x7f, 0x5d, 0x53, 0xbc, 0xa8, 0x4c, 0xa4, 0xa7, 0x18, 0x66, 0x33, 0xe2, 0xb9,
+	0xfe, 0x47, 0x09, 0xe4, 0x8c, 0xb9, 0xf2, 0x3a, 0xf0, 0xd4, 0x21, 0xf2, 0x33, 0x8a, 0xf1, 0x67,
+	0x52, 0x28, 0xce, 0x49, 0xb6, 0x00, 0x52, 0x93, 0xb5, 0x71, 0xba, 0xcd, 0xdd, 0x67, 0x4c, 0x88,
+	0x55, 0xec, 0xe9, 0x84, 0xfe, 0xaf, 0x04, 0x63, 0xc9, 0xdd, 0x9a, 0x9e, 0x51, 0xe6, 0xd6, 0x7d,
+	0xf6, 0x24, 0x7e, 0x78, 0xfc, 0x48, 0x65, 0xef, 0x40, 0xbe, 0xde, 0x0a, 0x2b, 0xa7, 0x32, 0x24,
+	0x87, 0xdf, 0x08, 0x15, 0xe5, 0x7c, 0x7a, 0xdb, 0xb7, 0xcf, 0xf5, 0x1b, 0x12, 0xfa, 0x2f, 0x22,
+	0x2f, 0xd0, 0x56, 0xc2, 0xcf, 0x9c, 0x68, 0x96, 0x0f, 0x08, 0x47, 0x28, 0xdf, 0xff, 0x99, 0xcd,
+	0x31, 0x9d, 0x4c, 0x08, 0xe7, 0x57, 0xf5, 0xea, 0x89, 0x89, 0xfd, 0x1d, 0x09, 0x46, 0xe6, 0x4d,
+	0x33, 0xfd, 0xc7, 0x0e, 0xf4, 0x5f, 0x0f, 0xd0, 0x58, 0xc7, 0xff, 0x7d, 0xc8, 0x3a, 0xd8, 0xd4,
+	0x13, 0x9c, 0x6b, 0x4c, 0xbe, 0x31, 0x65, 0x98, 0xde, 0x0d, 0xc4, 0x7f, 0x45, 0x24, 0x9d, 0x2f,
+	0xfa, 0xbb, 0x12, 0xc8, 0xfc, 0x6a, 0xf0, 0xdc, 0xe2, 0xbd, 0x73, 0x52, 0xf1, 0xa8, 0xf7, 0x22,
+	0xb5, 0x2c, 0xe9, 0xfe, 0x81, 0x04, 0xa3, 0x09, 0xcd, 0x25, 0xdb, 0x2c, 0x26, 0x33, 0x64, 0x4b,
+	0xe0, 0xb3, 0x04, 0xfc, 0xee, 0x09, 0x04, 0x8c, 0x6e, 0x90, 0x34, 0x3f, 0xa3, 0x9b, 0x66, 0xa2,
+	0xa9, 0x22, 0x25, 0xe9, 0x47, 0x12, 0x8c, 0xa5, 0xf5, 0xf8, 0x9c, 0xc2, 0x3e, 0x3c, 0xa9, 0x36,
+	0x27, 0x94, 0xb3, 0x33, 0xa4, 0xd6, 0x49, 0xce, 0x1f, 0x4a, 0x30, 0xb0, 0x6c, 0x39, 0x66, 0xb2,
+	0x61, 0x70, 0xb4, 0xad, 0x1e, 0xca, 0xe0, 0xe3, 0x1d, 0xe0, 0xca, 0x3b, 0xc7, 0xde, 0x5c, 0x4c,
+	0xb0, 0x71, 0x65, 0x64, 0x66, 0xdb, 0x72, 0x32, 0xcd, 0xf0, 0x27, 0x12, 0x20, 0xba, 0xf7, 0xf9,
+	0x6b, 0x0e, 0xcd, 0x6a, 0x65, 0x7e, 0x91, 0xa7, 0x3c, 0xf9, 0xda, 0xd2, 0x59, 0x74, 0xe1, 0xe9,
+	0xe6, 0x0e, 0xc5, 0xa6, 0xa9, 0x2d, 0x51, 0x29, 0x8e, 0xb6, 0xf7, 0xe8, 0x5d, 0x1c, 0x24, 0x07,
+	0xfb, 0xeb, 0x4e, 0x47, 0xf9, 0x93, 0xd7, 0xa5, 0xe4, 0x18, 0x16, 0xb8, 0x5c, 0xee, 0x38, 0x85,
+	0xcc, 0xc4, 0x10, 0x95, 0x8d, 0x9e, 0x21, 0x54, 0x26, 0x7f, 0x26, 0xd9, 0xe4, 0xe3, 0xc7, 0x39,
+	0x2b, 0x15, 0x37, 0xdc, 0x5d, 0x1c, 0x35, 0xe2, 0x76, 0x8c, 0xaa, 0x3a, 0x64, 0xad, 0x8e, 0x1d,
+	0x4b, 0x4d, 0x2a, 0x63, 0x33, 0x84, 0xbd, 0x33, 0x5a, 0x62, 0xfe, 0x2d, 0xc5, 0x2e, 0x6e, 0xd2,
+	0xb5, 0xfe, 0x5b, 0x12, 0x0c, 0xde, 0xc5, 0x0e, 0x53, 0xf9, 0x89, 0xa4, 0x7a, 0x78, 0x12, 0xa9,
+	0xf8, 0xf5, 0x91, 0xbf, 0x35, 0x5b, 0xae, 0x7f, 0x22, 0xc1, 0xc5, 0x44, 0xf8, 0xd0, 0xe1, 0xb6,
+	0x7b, 0x0c, 0x39, 0xcd, 0x93, 0x5f, 0x76, 0x5f, 0x51, 0x2e, 0xa5, 0x83, 0x83, 0x8e, 0xb7, 0x5e,
+	0xba, 0xa3, 0x07, 0x17, 0x76, 0x74, 0xa7, 0x1a, 0xbd, 0x62, 0xf1, 0x41, 0xe5, 0x38, 0x62, 0xaa,
+	0xc7, 0x54, 0x67, 0x64, 0x7d, 0xf4, 0x58, 0x31, 0xd8, 0x9b, 0x63, 0x39, 0xcd, 0xd0, 0xf2, 0x3e,
+	0x94, 0xa0, 0x14, 0x36, 0x82, 0x45, 0xb7, 0x8d, 0xac, 0x43, 0x3b, 0x24, 0xca, 0x12, 0x6d, 0xf3,
+	0x79, 0xb2, 0xa6, 0x67, 0x15, 0x34, 0x83, 0x05, 0xf3, 0x96, 0x0b, 0xc7, 0x47, 0x12, 0x14, 0xc5,
+	0xff, 0xa2, 0xf1, 0xff, 0x85, 0x3d, 0x86, 0xba, 0x76, 0x4e, 0xa0, 0xae, 0xbd, 0x03, 0x79, 0x90,
+	0xb9, 0x9a, 0x4c, 0xa7, 0x98, 0x88, 0x8a, 0x98, 0x48, 0x06, 0x26, 0x5c, 0xce, 0xd9, 0xbf, 0xdf,
+	0x1d, 0x57, 0x80, 0x69, 0xe0, 0x48, 0xb3, 0x1a, 0x3f, 0x96, 0xa0, 0x94, 0x0c, 0x93, 0x28, 0x3c,
+	0x95, 0x83, 0x49, 0x22, 0xc6, 0x3b, 0x21, 0xd8, 0x61, 0x78, 0xe3, 0x48, 0xc6, 0xd9, 0xf1, 0x48,
+	0xa4, 0x0a, 0x4e, 0x85, 0x3d, 0xb4, 0x19, 0x81, 0x2b, 0xb8, 0x0e, 0x68, 0xc5, 0xf9, 0x1e, 0x36,
+	0x82, 0xa3, 0x49, 0x99, 0xa1, 0xe6, 0xeb, 0x27, 0x58, 0x7a, 0x14, 0xc0, 0xe0, 0x52, 0xc3, 0xfa,
+	0x19, 0xbf, 0x75, 0xf6, 0x57, 0x25, 0x40, 0x2d, 0x75, 0x7a, 0xba, 0x50, 0xef, 0xc2, 0x50, 0x72,
+	0x9d, 0x04, 0x06, 0x8d, 0x67, 0x5d, 0x5e, 0x39, 0x6e, 0xfc, 0x10, 0x9c, 0x32, 0x15, 0xd9, 0x4b,
+	0x4a, 0xe7, 0x35, 0x8e, 0xe6, 0xf6, 0xf2, 0x67, 0x3d, 0x9d, 0x9a, 0x01, 0xa8, 0x40, 0xff, 0x56,
+	0x82, 0xf1, 0x94, 0x44, 0x29, 0x0a, 0x74, 0xf1, 0x99, 0x95, 0xf9, 0xf1, 0x67, 0x93, 0x28, 0x46,
+	0x96, 0x5d, 0xa5, 0xec, 0xa9, 0x53, 0x95, 0x96, 0xcd, 0xef, 0x92, 0x72, 0x21, 0x9e, 0x1a, 0xe7,
+	0x2c, 0xca, 0xc3, 0x33, 0x04, 0x07, 0x44, 0x37, 0xc4, 0x0e, 0xfe, 0x57, 0x12, 0x4c, 0xf2, 0xff,
+	0xee, 0xc6, 0xe4, 0xe4, 0xf3, 0xc9, 0xb0, 0x80, 0xef, 0xed, 0xed, 0xcb, 0xdf, 0x7a, 0x86, 0x05,
+	0x74, 0x9a, 0x41, 0xe4, 0x7a, 0x2e, 0x2b, 0x53, 0x9d, 0x67, 0xc1, 0x85, 0xe6, 0xd3, 0xf8, 0x54,
+	0x82, 0xa9, 0x45, 0x4c, 0xbe, 0x8e, 0x89, 0xd8, 0x2f, 0x62, 0x22, 0x57, 0x14, 0xa5, 0xd3, 0x44,
+	0x4c, 0x9c, 0x9a, 0xca, 0x9d, 0x89, 0x4f, 0xff, 0xe7, 0xe4, 0xa9, 0x4f, 0xbf, 0x98, 0x94, 0x3e,
+	0xfb, 0x62, 0x52, 0xfa, 0x93, 0x2f, 0x26, 0xa5, 0x1f, 0x7c, 0x39, 0x79, 0xea, 0xb3, 0x2f, 0x27,
+	0x4f, 0xfd, 0xd1, 0x97, 0x93, 0xa7, 0xb6, 0x7a, 0x99, 0x6c, 0xd7, 0xff, 0x22, 0x00, 0x00, 0xff,
+	0xff, 0x18, 0xc6, 0xff, 0x85, 0x9a, 0x61, 0x00, 0x00,
 }
 
 func (this *GPUDriverKey) GoString() string {
@@ -3077,6 +3229,9 @@ type CloudletApiClient interface {
 	// Change DNS for all the objects on the cloudlet(shared rootLb, appsinsts, clusterinsts).
 	// New DNS name is picked from the currently configured for this cloudlet
 	ChangeCloudletDNS(ctx context.Context, in *CloudletKey, opts ...grpc.CallOption) (CloudletApi_ChangeCloudletDNSClient, error)
+	// Evacuate Cloudlet. Moves all AppInsts on the cloudlet to other
+	// cloudlets, creating each replacement before deleting the original.
+	EvacuateCloudlet(ctx context.Context, in *CloudletEvacuate, opts ...grpc.CallOption) (CloudletApi_EvacuateCloudletClient, error)
 	// Refresh LB and Ingress TLS certificates for Cloudlet. This only works for cloudlets with CrmOnEdge=false.
 	RefreshCerts(ctx context.Context, in *CloudletKey, opts ...grpc.CallOption) (CloudletApi_RefreshCertsClient, error)
 }
@@ -3485,8 +3640,40 @@ func (x *cloudletApiChangeCloudletDNSClient) Recv() (*Result, error) {
 	return m, nil
 }
 
+func (c *cloudletApiClient) EvacuateCloudlet(ctx context.Context, in *CloudletEvacuate, opts ...grpc.CallOption) (CloudletApi_EvacuateCloudletClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CloudletApi_serviceDesc.Streams[9], "/edgeproto.CloudletApi/EvacuateCloudlet", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cloudletApiEvacuateCloudletClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CloudletApi_EvacuateCloudletClient interface {
+	Recv() (*Result, error)
+	grpc.ClientStream
+}
+
+type cloudletApiEvacuateCloudletClient struct {
+	grpc.ClientStream
+}
+
+func (x *cloudletApiEvacuateCloudletClient) Recv() (*Result, error) {
+	m := new(Result)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *cloudletApiClient) RefreshCerts(ctx context.Context, in *CloudletKey, opts ...grpc.CallOption) (CloudletApi_RefreshCertsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_CloudletApi_serviceDesc.Streams[9], "/edgeproto.CloudletApi/RefreshCerts", opts...)
+	stream, err := c.cc.NewStream(ctx, &_CloudletApi_serviceDesc.Streams[10], "/edgeproto.CloudletApi/RefreshCerts", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3565,6 +3752,9 @@ type CloudletApiServer interface {
 	// Change DNS for all the objects on the cloudlet(shared rootLb, appsinsts, clusterinsts).
 	// New DNS name is picked from the currently configured for this cloudlet
 	ChangeCloudletDNS(*CloudletKey, CloudletApi_ChangeCloudletDNSServer) error
+	// Evacuate Cloudlet. Moves all AppInsts on the cloudlet to other
+	// cloudlets, creating each replacement before deleting the original.
+	EvacuateCloudlet(*CloudletEvacuate, CloudletApi_EvacuateCloudletServer) error
 	// Refresh LB and Ingress TLS certificates for Cloudlet. This only works for cloudlets with CrmOnEdge=false.
 	RefreshCerts(*CloudletKey, CloudletApi_RefreshCertsServer) error
 }
@@ -3636,6 +3826,9 @@ func (*UnimplementedCloudletApiServer) GetCloudletGPUDriverLicenseConfig(ctx con
 func (*UnimplementedCloudletApiServer) ChangeCloudletDNS(req *CloudletKey, srv CloudletApi_ChangeCloudletDNSServer) error {
 	return status.Errorf(codes.Unimplemented, "method ChangeCloudletDNS not implemented")
 }
+func (*UnimplementedCloudletApiServer) EvacuateCloudlet(req *CloudletEvacuate, srv CloudletApi_EvacuateCloudletServer) error {
+	return status.Errorf(codes.Unimplemented, "method EvacuateCloudlet not implemented")
+}
 func (*UnimplementedCloudletApiServer) RefreshCerts(req *CloudletKey, srv CloudletApi_RefreshCertsServer) error {
 	return status.Errorf(codes.Unimplemented, "method RefreshCerts not implemented")
 }
@@ -4049,6 +4242,27 @@ func (x *cloudletApiChangeCloudletDNSServer) Send(m *Result) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _CloudletApi_EvacuateCloudlet_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CloudletEvacuate)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CloudletApiServer).EvacuateCloudlet(m, &cloudletApiEvacuateCloudletServer{stream})
+}
+
+type CloudletApi_EvacuateCloudletServer interface {
+	Send(*Result) error
+	grpc.ServerStream
+}
+
+type cloudletApiEvacuateCloudletServer struct {
+	grpc.ServerStream
+}
+
+func (x *cloudletApiEvacuateCloudletServer) Send(m *Result) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _CloudletApi_RefreshCerts_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(CloudletKey)
 	if err := stream.RecvMsg(m); err != nil {
@@ -4169,6 +4383,11 @@ var _CloudletApi_serviceDesc = grpc.ServiceDesc{
 			Handler:       _CloudletApi_ChangeCloudletDNS_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "EvacuateCloudlet",
+			Handler:       _CloudletApi_EvacuateCloudlet_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "RefreshCerts",
 			Handler:       _CloudletApi_RefreshCerts_Handler,
@@ -6091,6 +6310,69 @@ func (m *GPUConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MaintenanceWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		i = encodeVarintCloudlet(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NoFailover {
+		i--
+		if m.NoFailover {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FailoverLeadTime != 0 {
+		i = encodeVarintCloudlet(dAtA, i, uint64(m.FailoverLeadTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.EndTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCloudlet(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCloudlet(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Cloudlet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6111,6 +6393,32 @@ func (m *Cloudlet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaintenanceWindow != nil {
+		{
+			size, err := m.MaintenanceWindow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCloudlet(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x98
+	}
 	if m.DbModelId != 0 {
 		i = encodeVarintCloudlet(dAtA, i, uint64(m.DbModelId))
 		i--
@@ -7168,6 +7476,54 @@ func (m *CloudletAllianceOrg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CloudletEvacuate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CloudletEvacuate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CloudletEvacuate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HealthCheckTimeout != 0 {
+		i = encodeVarintCloudlet(dAtA, i, uint64(m.HealthCheckTimeout))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.TargetZoneKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCloudlet(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCloudlet(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *FlavorInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11882,13 +12238,71 @@ func (s *GPUConfig) ClearTagged(tags map[string]struct{}) {
 	s.Driver.ClearTagged(tags)
 }
 
-func (m *Cloudlet) Matches(o *Cloudlet, fopts ...MatchOpt) bool {
-	opts := MatchOptions{}
-	applyMatchOptions(&opts, fopts...)
-	if o == nil {
-		if opts.Filter {
-			return true
-		}
+func (m *MaintenanceWindow) Clone() *MaintenanceWindow {
+	cp := &MaintenanceWindow{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *MaintenanceWindow) CopyInFields(src *MaintenanceWindow) int {
+	changed := 0
+	if m.StartTime.Seconds != src.StartTime.Seconds {
+		m.StartTime.Seconds = src.StartTime.Seconds
+		changed++
+	}
+	if m.StartTime.Nanos != src.StartTime.Nanos {
+		m.StartTime.Nanos = src.StartTime.Nanos
+		changed++
+	}
+	if m.EndTime.Seconds != src.EndTime.Seconds {
+		m.EndTime.Seconds = src.EndTime.Seconds
+		changed++
+	}
+	if m.EndTime.Nanos != src.EndTime.Nanos {
+		m.EndTime.Nanos = src.EndTime.Nanos
+		changed++
+	}
+	if m.FailoverLeadTime != src.FailoverLeadTime {
+		m.FailoverLeadTime = src.FailoverLeadTime
+		changed++
+	}
+	if m.NoFailover != src.NoFailover {
+		m.NoFailover = src.NoFailover
+		changed++
+	}
+	if m.State != src.State {
+		m.State = src.State
+		changed++
+	}
+	return changed
+}
+
+func (m *MaintenanceWindow) DeepCopyIn(src *MaintenanceWindow) {
+	m.StartTime = src.StartTime
+	m.EndTime = src.EndTime
+	m.FailoverLeadTime = src.FailoverLeadTime
+	m.NoFailover = src.NoFailover
+	m.State = src.State
+}
+
+// Helper method to check that enums have valid values
+func (m *MaintenanceWindow) ValidateEnums() error {
+	if _, ok := MaintenanceWindowState_name[int32(m.State)]; !ok {
+		return errors.New("invalid State")
+	}
+	return nil
+}
+
+func (s *MaintenanceWindow) ClearTagged(tags map[string]struct{}) {
+}
+
+func (m *Cloudlet) Matches(o *Cloudlet, fopts ...MatchOpt) bool {
+	opts := MatchOptions{}
+	applyMatchOptions(&opts, fopts...)
+	if o == nil {
+		if opts.Filter {
+			return true
+		}
 		return false
 	}
 	if !m.Key.Matches(&o.Key, fopts...) {
@@ -12286,6 +12700,17 @@ func (m *Cloudlet) Matches(o *Cloudlet, fopts ...MatchOpt) bool {
 			}
 		}
 	}
+	if !opts.Filter || o.DryRun != false {
+		if o.DryRun != m.DryRun {
+			return false
+		}
+	}
+	if !opts.Filter || o.MaintenanceWindow != nil {
+		if m.MaintenanceWindow == nil && o.MaintenanceWindow != nil || m.MaintenanceWindow != nil && o.MaintenanceWindow == nil {
+			return false
+		} else if m.MaintenanceWindow != nil && o.MaintenanceWindow != nil {
+		}
+	}
 	return true
 }
 
@@ -12439,6 +12864,17 @@ const CloudletFieldAnnotationsKey = "64.1"
 const CloudletFieldAnnotationsValue = "64.2"
 const CloudletFieldZone = "65"
 const CloudletFieldDbModelId = "66"
+const CloudletFieldDryRun = "67"
+const CloudletFieldMaintenanceWindow = "68"
+const CloudletFieldMaintenanceWindowStartTime = "68.1"
+const CloudletFieldMaintenanceWindowStartTimeSeconds = "68.1.1"
+const CloudletFieldMaintenanceWindowStartTimeNanos = "68.1.2"
+const CloudletFieldMaintenanceWindowEndTime = "68.2"
+const CloudletFieldMaintenanceWindowEndTimeSeconds = "68.2.1"
+const CloudletFieldMaintenanceWindowEndTimeNanos = "68.2.2"
+const CloudletFieldMaintenanceWindowFailoverLeadTime = "68.3"
+const CloudletFieldMaintenanceWindowNoFailover = "68.4"
+const CloudletFieldMaintenanceWindowState = "68.5"
 
 var CloudletAllFields = []string{
 	CloudletFieldKeyOrganization,
@@ -12568,6 +13004,14 @@ var CloudletAllFields = []string{
 	CloudletFieldAnnotationsValue,
 	CloudletFieldZone,
 	CloudletFieldDbModelId,
+	CloudletFieldDryRun,
+	CloudletFieldMaintenanceWindowStartTimeSeconds,
+	CloudletFieldMaintenanceWindowStartTimeNanos,
+	CloudletFieldMaintenanceWindowEndTimeSeconds,
+	CloudletFieldMaintenanceWindowEndTimeNanos,
+	CloudletFieldMaintenanceWindowFailoverLeadTime,
+	CloudletFieldMaintenanceWindowNoFailover,
+	CloudletFieldMaintenanceWindowState,
 }
 
 var CloudletAllFieldsMap = NewFieldMap(map[string]struct{}{
@@ -12698,6 +13142,14 @@ var CloudletAllFieldsMap = NewFieldMap(map[string]struct{}{
 	CloudletFieldAnnotationsValue:                      struct{}{},
 	CloudletFieldZone:                                  struct{}{},
 	CloudletFieldDbModelId:                             struct{}{},
+	CloudletFieldDryRun:                                struct{}{},
+	CloudletFieldMaintenanceWindowStartTimeSeconds:     struct{}{},
+	CloudletFieldMaintenanceWindowStartTimeNanos:       struct{}{},
+	CloudletFieldMaintenanceWindowEndTimeSeconds:       struct{}{},
+	CloudletFieldMaintenanceWindowEndTimeNanos:         struct{}{},
+	CloudletFieldMaintenanceWindowFailoverLeadTime:     struct{}{},
+	CloudletFieldMaintenanceWindowNoFailover:           struct{}{},
+	CloudletFieldMaintenanceWindowState:                struct{}{},
 })
 
 var CloudletAllFieldsStringMap = map[string]string{
@@ -12828,6 +13280,14 @@ var CloudletAllFieldsStringMap = map[string]string{
 	CloudletFieldAnnotationsValue:                      "Annotations Value",
 	CloudletFieldZone:                                  "Zone",
 	CloudletFieldDbModelId:                             "Db Model Id",
+	CloudletFieldDryRun:                                "Dry Run",
+	CloudletFieldMaintenanceWindowStartTimeSeconds:     "Maintenance Window Start Time Seconds",
+	CloudletFieldMaintenanceWindowStartTimeNanos:       "Maintenance Window Start Time Nanos",
+	CloudletFieldMaintenanceWindowEndTimeSeconds:       "Maintenance Window End Time Seconds",
+	CloudletFieldMaintenanceWindowEndTimeNanos:         "Maintenance Window End Time Nanos",
+	CloudletFieldMaintenanceWindowFailoverLeadTime:     "Maintenance Window Failover Lead Time",
+	CloudletFieldMaintenanceWindowNoFailover:           "Maintenance Window No Failover",
+	CloudletFieldMaintenanceWindowState:                "Maintenance Window State",
 }
 
 func (m *Cloudlet) IsKeyField(s string) bool {
@@ -13447,6 +13907,45 @@ func (m *Cloudlet) DiffFields(o *Cloudlet, fields *FieldMap) {
 	if m.DbModelId != o.DbModelId {
 		fields.Set(CloudletFieldDbModelId)
 	}
+	if m.DryRun != o.DryRun {
+		fields.Set(CloudletFieldDryRun)
+	}
+	if m.MaintenanceWindow != nil && o.MaintenanceWindow != nil {
+		if m.MaintenanceWindow.StartTime.Seconds != o.MaintenanceWindow.StartTime.Seconds {
+			fields.Set(CloudletFieldMaintenanceWindowStartTimeSeconds)
+			fields.Set(CloudletFieldMaintenanceWindowStartTime)
+			fields.Set(CloudletFieldMaintenanceWindow)
+		}
+		if m.MaintenanceWindow.StartTime.Nanos != o.MaintenanceWindow.StartTime.Nanos {
+			fields.Set(CloudletFieldMaintenanceWindowStartTimeNanos)
+			fields.Set(CloudletFieldMaintenanceWindowStartTime)
+			fields.Set(CloudletFieldMaintenanceWindow)
+		}
+		if m.MaintenanceWindow.EndTime.Seconds != o.MaintenanceWindow.EndTime.Seconds {
+			fields.Set(CloudletFieldMaintenanceWindowEndTimeSeconds)
+			fields.Set(CloudletFieldMaintenanceWindowEndTime)
+			fields.Set(CloudletFieldMaintenanceWindow)
+		}
+		if m.MaintenanceWindow.EndTime.Nanos != o.MaintenanceWindow.EndTime.Nanos {
+			fields.Set(CloudletFieldMaintenanceWindowEndTimeNanos)
+			fields.Set(CloudletFieldMaintenanceWindowEndTime)
+			fields.Set(CloudletFieldMaintenanceWindow)
+		}
+		if m.MaintenanceWindow.FailoverLeadTime != o.MaintenanceWindow.FailoverLeadTime {
+			fields.Set(CloudletFieldMaintenanceWindowFailoverLeadTime)
+			fields.Set(CloudletFieldMaintenanceWindow)
+		}
+		if m.MaintenanceWindow.NoFailover != o.MaintenanceWindow.NoFailover {
+			fields.Set(CloudletFieldMaintenanceWindowNoFailover)
+			fields.Set(CloudletFieldMaintenanceWindow)
+		}
+		if m.MaintenanceWindow.State != o.MaintenanceWindow.State {
+			fields.Set(CloudletFieldMaintenanceWindowState)
+			fields.Set(CloudletFieldMaintenanceWindow)
+		}
+	} else if (m.MaintenanceWindow != nil && o.MaintenanceWindow == nil) || (m.MaintenanceWindow == nil && o.MaintenanceWindow != nil) {
+		fields.Set(CloudletFieldMaintenanceWindow)
+	}
 }
 
 func (m *Cloudlet) GetDiffFields(o *Cloudlet) *FieldMap {
@@ -13528,6 +14027,16 @@ var UpdateCloudletFieldsMap = NewFieldMap(map[string]struct{}{
 	CloudletFieldAnnotationsValue:                      struct{}{},
 	CloudletFieldZone:                                  struct{}{},
 	CloudletFieldDbModelId:                             struct{}{},
+	CloudletFieldDryRun:                                struct{}{},
+	CloudletFieldMaintenanceWindow:                     struct{}{},
+	CloudletFieldMaintenanceWindowStartTime:            struct{}{},
+	CloudletFieldMaintenanceWindowStartTimeSeconds:     struct{}{},
+	CloudletFieldMaintenanceWindowStartTimeNanos:       struct{}{},
+	CloudletFieldMaintenanceWindowEndTime:              struct{}{},
+	CloudletFieldMaintenanceWindowEndTimeSeconds:       struct{}{},
+	CloudletFieldMaintenanceWindowEndTimeNanos:         struct{}{},
+	CloudletFieldMaintenanceWindowFailoverLeadTime:     struct{}{},
+	CloudletFieldMaintenanceWindowNoFailover:           struct{}{},
 })
 
 func (m *Cloudlet) ValidateUpdateFields() error {
@@ -14530,6 +15039,68 @@ func (m *Cloudlet) CopyInFields(src *Cloudlet) int {
 			changed++
 		}
 	}
+	if fmap.Has("67") {
+		if m.DryRun != src.DryRun {
+			m.DryRun = src.DryRun
+			changed++
+		}
+	}
+	if fmap.HasOrHasChild("68") {
+		if src.MaintenanceWindow != nil {
+			if m.MaintenanceWindow == nil {
+				m.MaintenanceWindow = &MaintenanceWindow{}
+			}
+			if fmap.HasOrHasChild("68.1") {
+				if fmap.Has("68.1.1") {
+					if m.MaintenanceWindow.StartTime.Seconds != src.MaintenanceWindow.StartTime.Seconds {
+						m.MaintenanceWindow.StartTime.Seconds = src.MaintenanceWindow.StartTime.Seconds
+						changed++
+					}
+				}
+				if fmap.Has("68.1.2") {
+					if m.MaintenanceWindow.StartTime.Nanos != src.MaintenanceWindow.StartTime.Nanos {
+						m.MaintenanceWindow.StartTime.Nanos = src.MaintenanceWindow.StartTime.Nanos
+						changed++
+					}
+				}
+			}
+			if fmap.HasOrHasChild("68.2") {
+				if fmap.Has("68.2.1") {
+					if m.MaintenanceWindow.EndTime.Seconds != src.MaintenanceWindow.EndTime.Seconds {
+						m.MaintenanceWindow.EndTime.Seconds = src.MaintenanceWindow.EndTime.Seconds
+						changed++
+					}
+				}
+				if fmap.Has("68.2.2") {
+					if m.MaintenanceWindow.EndTime.Nanos != src.MaintenanceWindow.EndTime.Nanos {
+						m.MaintenanceWindow.EndTime.Nanos = src.MaintenanceWindow.EndTime.Nanos
+						changed++
+					}
+				}
+			}
+			if fmap.Has("68.3") {
+				if m.MaintenanceWindow.FailoverLeadTime != src.MaintenanceWindow.FailoverLeadTime {
+					m.MaintenanceWindow.FailoverLeadTime = src.MaintenanceWindow.FailoverLeadTime
+					changed++
+				}
+			}
+			if fmap.Has("68.4") {
+				if m.MaintenanceWindow.NoFailover != src.MaintenanceWindow.NoFailover {
+					m.MaintenanceWindow.NoFailover = src.MaintenanceWindow.NoFailover
+					changed++
+				}
+			}
+			if fmap.Has("68.5") {
+				if m.MaintenanceWindow.State != src.MaintenanceWindow.State {
+					m.MaintenanceWindow.State = src.MaintenanceWindow.State
+					changed++
+				}
+			}
+		} else if m.MaintenanceWindow != nil {
+			m.MaintenanceWindow = nil
+			changed++
+		}
+	}
 	return changed
 }
 
@@ -14653,6 +15224,14 @@ func (m *Cloudlet) DeepCopyIn(src *Cloudlet) {
 	}
 	m.Zone = src.Zone
 	m.DbModelId = src.DbModelId
+	m.DryRun = src.DryRun
+	if src.MaintenanceWindow != nil {
+		var tmp_MaintenanceWindow MaintenanceWindow
+		tmp_MaintenanceWindow.DeepCopyIn(src.MaintenanceWindow)
+		m.MaintenanceWindow = &tmp_MaintenanceWindow
+	} else {
+		m.MaintenanceWindow = nil
+	}
 }
 
 func (s *Cloudlet) HasFields() bool {
@@ -15369,6 +15948,11 @@ func (m *Cloudlet) ValidateEnums() error {
 			return err
 		}
 	}
+	if m.MaintenanceWindow != nil {
+		if err := m.MaintenanceWindow.ValidateEnums(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -15423,6 +16007,9 @@ func (s *Cloudlet) ClearTagged(tags map[string]struct{}) {
 	if _, found := tags["nocmp"]; found {
 		s.DbModelId = 0
 	}
+	if s.MaintenanceWindow != nil {
+		s.MaintenanceWindow.ClearTagged(tags)
+	}
 }
 
 func IgnoreCloudletFields(taglist string) cmp.Option {
@@ -16130,6 +16717,87 @@ func (s *CloudletAllianceOrg) ClearTagged(tags map[string]struct{}) {
 	s.Key.ClearTagged(tags)
 }
 
+func (m *CloudletEvacuate) Clone() *CloudletEvacuate {
+	cp := &CloudletEvacuate{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *CloudletEvacuate) CopyInFields(src *CloudletEvacuate) int {
+	changed := 0
+	if m.Key.Organization != src.Key.Organization {
+		m.Key.Organization = src.Key.Organization
+		changed++
+	}
+	if m.Key.Name != src.Key.Name {
+		m.Key.Name = src.Key.Name
+		changed++
+	}
+	if m.Key.FederatedOrganization != src.Key.FederatedOrganization {
+		m.Key.FederatedOrganization = src.Key.FederatedOrganization
+		changed++
+	}
+	if m.TargetZoneKey.Organization != src.TargetZoneKey.Organization {
+		m.TargetZoneKey.Organization = src.TargetZoneKey.Organization
+		changed++
+	}
+	if m.TargetZoneKey.Name != src.TargetZoneKey.Name {
+		m.TargetZoneKey.Name = src.TargetZoneKey.Name
+		changed++
+	}
+	if m.TargetZoneKey.FederatedOrganization != src.TargetZoneKey.FederatedOrganization {
+		m.TargetZoneKey.FederatedOrganization = src.TargetZoneKey.FederatedOrganization
+		changed++
+	}
+	if m.HealthCheckTimeout != src.HealthCheckTimeout {
+		m.HealthCheckTimeout = src.HealthCheckTimeout
+		changed++
+	}
+	return changed
+}
+
+func (m *CloudletEvacuate) DeepCopyIn(src *CloudletEvacuate) {
+	m.Key.DeepCopyIn(&src.Key)
+	m.TargetZoneKey.DeepCopyIn(&src.TargetZoneKey)
+	m.HealthCheckTimeout = src.HealthCheckTimeout
+}
+
+func (m *CloudletEvacuate) GetObjKey() objstore.ObjKey {
+	return m.GetKey()
+}
+
+func (m *CloudletEvacuate) GetKey() *CloudletKey {
+	return &m.Key
+}
+
+func (m *CloudletEvacuate) GetKeyVal() CloudletKey {
+	return m.Key
+}
+
+func (m *CloudletEvacuate) SetKey(key *CloudletKey) {
+	m.Key = *key
+}
+
+func CmpSortCloudletEvacuate(a CloudletEvacuate, b CloudletEvacuate) bool {
+	return a.Key.GetKeyString() < b.Key.GetKeyString()
+}
+
+// Helper method to check that enums have valid values
+func (m *CloudletEvacuate) ValidateEnums() error {
+	if err := m.Key.ValidateEnums(); err != nil {
+		return err
+	}
+	if err := m.TargetZoneKey.ValidateEnums(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *CloudletEvacuate) ClearTagged(tags map[string]struct{}) {
+	s.Key.ClearTagged(tags)
+	s.TargetZoneKey.ClearTagged(tags)
+}
+
 func (m *FlavorInfo) Clone() *FlavorInfo {
 	cp := &FlavorInfo{}
 	cp.DeepCopyIn(m)
@@ -20253,6 +20921,121 @@ func (e OSType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(str)
 }
 
+var MaintenanceWindowStateStrings = []string{
+	"MAINTENANCE_WINDOW_SCHEDULED",
+	"MAINTENANCE_WINDOW_FAILOVER",
+	"MAINTENANCE_WINDOW_IN_PROGRESS",
+}
+
+const (
+	MaintenanceWindowStateMAINTENANCE_WINDOW_SCHEDULED   uint64 = 1 << 0
+	MaintenanceWindowStateMAINTENANCE_WINDOW_FAILOVER    uint64 = 1 << 1
+	MaintenanceWindowStateMAINTENANCE_WINDOW_IN_PROGRESS uint64 = 1 << 2
+)
+
+var MaintenanceWindowState_CamelName = map[int32]string{
+	// MAINTENANCE_WINDOW_SCHEDULED -> MaintenanceWindowScheduled
+	0: "MaintenanceWindowScheduled",
+	// MAINTENANCE_WINDOW_FAILOVER -> MaintenanceWindowFailover
+	1: "MaintenanceWindowFailover",
+	// MAINTENANCE_WINDOW_IN_PROGRESS -> MaintenanceWindowInProgress
+	2: "MaintenanceWindowInProgress",
+}
+var MaintenanceWindowState_CamelValue = map[string]int32{
+	"MaintenanceWindowScheduled":  0,
+	"MaintenanceWindowFailover":   1,
+	"MaintenanceWindowInProgress": 2,
+}
+
+func ParseMaintenanceWindowState(data interface{}) (MaintenanceWindowState, error) {
+	if val, ok := data.(MaintenanceWindowState); ok {
+		return val, nil
+	} else if str, ok := data.(string); ok {
+		val, ok := MaintenanceWindowState_CamelValue[util.CamelCase(str)]
+		if !ok {
+			// may have omitted common prefix
+			val, ok = MaintenanceWindowState_CamelValue["MaintenanceWindow"+util.CamelCase(str)]
+		}
+		if !ok {
+			// may be int value instead of enum name
+			ival, err := strconv.Atoi(str)
+			val = int32(ival)
+			if err == nil {
+				_, ok = MaintenanceWindowState_CamelName[val]
+			}
+		}
+		if !ok {
+			return MaintenanceWindowState(0), fmt.Errorf("Invalid MaintenanceWindowState value %q", str)
+		}
+		return MaintenanceWindowState(val), nil
+	} else if ival, ok := data.(int32); ok {
+		if _, ok := MaintenanceWindowState_CamelName[ival]; ok {
+			return MaintenanceWindowState(ival), nil
+		} else {
+			return MaintenanceWindowState(0), fmt.Errorf("Invalid MaintenanceWindowState value %d", ival)
+		}
+	}
+	return MaintenanceWindowState(0), fmt.Errorf("Invalid MaintenanceWindowState value %v", data)
+}
+
+func (e *MaintenanceWindowState) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	err := unmarshal(&str)
+	if err != nil {
+		return err
+	}
+	val, err := ParseMaintenanceWindowState(str)
+	if err != nil {
+		return err
+	}
+	*e = val
+	return nil
+}
+
+func (e MaintenanceWindowState) MarshalYAML() (interface{}, error) {
+	str := proto.EnumName(MaintenanceWindowState_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "MaintenanceWindow")
+	return str, nil
+}
+
+// custom JSON encoding/decoding
+func (e *MaintenanceWindowState) UnmarshalJSON(b []byte) error {
+	var str string
+	err := json.Unmarshal(b, &str)
+	if err == nil {
+		val, err := ParseMaintenanceWindowState(str)
+		if err != nil {
+			return &json.UnmarshalTypeError{
+				Value: "string " + str,
+				Type:  reflect.TypeOf(MaintenanceWindowState(0)),
+			}
+		}
+		*e = MaintenanceWindowState(val)
+		return nil
+	}
+	var ival int32
+	err = json.Unmarshal(b, &ival)
+	if err == nil {
+		val, err := ParseMaintenanceWindowState(ival)
+		if err == nil {
+			*e = val
+			return nil
+		}
+	}
+	return &json.UnmarshalTypeError{
+		Value: "value " + string(b),
+		Type:  reflect.TypeOf(MaintenanceWindowState(0)),
+	}
+}
+
+func (e MaintenanceWindowState) MarshalJSON() ([]byte, error) {
+	str := proto.EnumName(MaintenanceWindowState_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "MaintenanceWindow")
+	return json.Marshal(str)
+}
+
+var MaintenanceWindowStateCommonPrefix = "MaintenanceWindow"
+
 var ReportScheduleStrings = []string{
 	"EveryWeek",
 	"Every15Days",
@@ -20603,6 +21386,12 @@ func (m *Cloudlet) IsValidArgsForCreateCloudlet() error {
 	if m.StaticRootLbFqdn != "" {
 		return fmt.Errorf("Invalid field specified: StaticRootLbFqdn, this field is only for internal use")
 	}
+	if m.DryRun != false {
+		return fmt.Errorf("Invalid field specified: DryRun, this field is only for internal use")
+	}
+	if m.MaintenanceWindow != nil {
+		return fmt.Errorf("Invalid field specified: MaintenanceWindow, this field is only for internal use")
+	}
 	return nil
 }
 
@@ -20733,6 +21522,12 @@ func (m *Cloudlet) IsValidArgsForDeleteCloudlet() error {
 	if m.StaticRootLbFqdn != "" {
 		return fmt.Errorf("Invalid field specified: StaticRootLbFqdn, this field is only for internal use")
 	}
+	if m.DryRun != false {
+		return fmt.Errorf("Invalid field specified: DryRun, this field is only for internal use")
+	}
+	if m.MaintenanceWindow != nil {
+		return fmt.Errorf("Invalid field specified: MaintenanceWindow, this field is only for internal use")
+	}
 	return nil
 }
 
@@ -20902,6 +21697,8 @@ func (m *Cloudlet) IsValidArgsForUpdateCloudlet() error {
 	if m.StaticRootLbFqdn != "" {
 		return fmt.Errorf("Invalid field specified: StaticRootLbFqdn, this field is only for internal use")
 	}
+	if m.MaintenanceWindow != nil {
+	}
 	return nil
 }
 
@@ -21057,6 +21854,8 @@ func (m *Cloudlet) IsValidArgsForShowCloudletResourceUsage() error {
 	if m.StaticRootLbFqdn != "" {
 		return fmt.Errorf("Invalid field specified: StaticRootLbFqdn, this field is only for internal use")
 	}
+	if m.MaintenanceWindow != nil {
+	}
 	return nil
 }
 
@@ -21104,6 +21903,10 @@ func (m *CloudletKey) IsValidArgsForChangeCloudletDNS() error {
 	return nil
 }
 
+func (m *CloudletEvacuate) IsValidArgsForEvacuateCloudlet() error {
+	return nil
+}
+
 func (m *CloudletKey) IsValidArgsForRefreshCerts() error {
 	return nil
 }
@@ -21718,6 +22521,28 @@ func (m *GPUConfig) Size() (n int) {
 	return n
 }
 
+func (m *MaintenanceWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StartTime.Size()
+	n += 1 + l + sovCloudlet(uint64(l))
+	l = m.EndTime.Size()
+	n += 1 + l + sovCloudlet(uint64(l))
+	if m.FailoverLeadTime != 0 {
+		n += 1 + sovCloudlet(uint64(m.FailoverLeadTime))
+	}
+	if m.NoFailover {
+		n += 2
+	}
+	if m.State != 0 {
+		n += 1 + sovCloudlet(uint64(m.State))
+	}
+	return n
+}
+
 func (m *Cloudlet) Size() (n int) {
 	if m == nil {
 		return 0
@@ -21957,6 +22782,13 @@ func (m *Cloudlet) Size() (n int) {
 	if m.DbModelId != 0 {
 		n += 2 + sovCloudlet(uint64(m.DbModelId))
 	}
+	if m.DryRun {
+		n += 3
+	}
+	if m.MaintenanceWindow != nil {
+		l = m.MaintenanceWindow.Size()
+		n += 2 + l + sovCloudlet(uint64(l))
+	}
 	return n
 }
 
@@ -22133,17 +22965,33 @@ func (m *CloudletAllianceOrg) Size() (n int) {
 	return n
 }
 
-func (m *FlavorInfo) Size() (n int) {
+func (m *CloudletEvacuate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovCloudlet(uint64(l))
+	l = m.Key.Size()
+	n += 1 + l + sovCloudlet(uint64(l))
+	l = m.TargetZoneKey.Size()
+	n += 1 + l + sovCloudlet(uint64(l))
+	if m.HealthCheckTimeout != 0 {
+		n += 1 + sovCloudlet(uint64(m.HealthCheckTimeout))
 	}
-	if m.Vcpus != 0 {
+	return n
+}
+
+func (m *FlavorInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCloudlet(uint64(l))
+	}
+	if m.Vcpus != 0 {
 		n += 1 + sovCloudlet(uint64(m.Vcpus))
 	}
 	if m.Ram != 0 {
@@ -26756,6 +27604,180 @@ func (m *GPUConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MaintenanceWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCloudlet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaintenanceWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaintenanceWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCloudlet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCloudlet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCloudlet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCloudlet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCloudlet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCloudlet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailoverLeadTime", wireType)
+			}
+			m.FailoverLeadTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCloudlet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailoverLeadTime |= Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoFailover", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCloudlet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoFailover = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCloudlet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= MaintenanceWindowState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCloudlet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCloudlet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Cloudlet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -28876,6 +29898,62 @@ func (m *Cloudlet) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 67:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCloudlet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 68:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCloudlet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCloudlet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCloudlet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaintenanceWindow == nil {
+				m.MaintenanceWindow = &MaintenanceWindow{}
+			}
+			if err := m.MaintenanceWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCloudlet(dAtA[iNdEx:])
@@ -30131,6 +31209,141 @@ func (m *CloudletAllianceOrg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CloudletEvacuate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCloudlet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloudletEvacuate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloudletEvacuate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCloudlet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCloudlet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCloudlet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetZoneKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCloudlet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCloudlet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCloudlet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetZoneKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthCheckTimeout", wireType)
+			}
+			m.HealthCheckTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCloudlet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HealthCheckTimeout |= Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCloudlet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCloudlet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *FlavorInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
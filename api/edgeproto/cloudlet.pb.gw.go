@@ -968,6 +968,31 @@ func request_CloudletApi_ChangeCloudletDNS_0(ctx context.Context, marshaler runt
 
 }
 
+func request_CloudletApi_EvacuateCloudlet_0(ctx context.Context, marshaler runtime.Marshaler, client CloudletApiClient, req *http.Request, pathParams map[string]string) (CloudletApi_EvacuateCloudletClient, runtime.ServerMetadata, error) {
+	var protoReq CloudletEvacuate
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	stream, err := client.EvacuateCloudlet(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+
+}
+
 func request_CloudletApi_RefreshCerts_0(ctx context.Context, marshaler runtime.Marshaler, client CloudletApiClient, req *http.Request, pathParams map[string]string) (CloudletApi_RefreshCertsClient, runtime.ServerMetadata, error) {
 	var protoReq CloudletKey
 	var metadata runtime.ServerMetadata
@@ -1606,6 +1631,13 @@ func RegisterCloudletApiHandlerServer(ctx context.Context, mux *runtime.ServeMux
 		return
 	})
 
+	mux.Handle("POST", pattern_CloudletApi_EvacuateCloudlet_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+
 	mux.Handle("POST", pattern_CloudletApi_RefreshCerts_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
 		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
@@ -2490,6 +2522,26 @@ func RegisterCloudletApiHandlerClient(ctx context.Context, mux *runtime.ServeMux
 
 	})
 
+	mux.Handle("POST", pattern_CloudletApi_EvacuateCloudlet_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_CloudletApi_EvacuateCloudlet_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_CloudletApi_EvacuateCloudlet_0(ctx, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+
+	})
+
 	mux.Handle("POST", pattern_CloudletApi_RefreshCerts_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -2556,6 +2608,8 @@ var (
 
 	pattern_CloudletApi_ChangeCloudletDNS_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"change", "cloudlet", "dns"}, "", runtime.AssumeColonVerbOpt(true)))
 
+	pattern_CloudletApi_EvacuateCloudlet_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"evacuate", "cloudlet"}, "", runtime.AssumeColonVerbOpt(true)))
+
 	pattern_CloudletApi_RefreshCerts_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"cloudlet", "refreshcert"}, "", runtime.AssumeColonVerbOpt(true)))
 )
 
@@ -2602,6 +2656,8 @@ var (
 
 	forward_CloudletApi_ChangeCloudletDNS_0 = runtime.ForwardResponseStream
 
+	forward_CloudletApi_EvacuateCloudlet_0 = runtime.ForwardResponseStream
+
 	forward_CloudletApi_RefreshCerts_0 = runtime.ForwardResponseStream
 )
 
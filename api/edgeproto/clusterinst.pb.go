@@ -232,6 +232,8 @@ type ClusterInst struct {
 	CloudletManagedClusterId string `protobuf:"bytes,50,opt,name=cloudlet_managed_cluster_id,json=cloudletManagedClusterId,proto3" json:"cloudlet_managed_cluster_id,omitempty"`
 	// Cloudlet managed cluster name, if cluster based on cloudlet managed cluster
 	CloudletManagedClusterName string `protobuf:"bytes,51,opt,name=cloudlet_managed_cluster_name,json=cloudletManagedClusterName,proto3" json:"cloudlet_managed_cluster_name,omitempty"`
+	// Preview the create without applying it, reports the chosen cloudlet, the resources required, and why other cloudlets were skipped
+	DryRun bool `protobuf:"varint,52,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	// Vendor-specific data
 	Tags map[string]string `protobuf:"bytes,100,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
@@ -477,163 +479,164 @@ func init() {
 func init() { proto.RegisterFile("clusterinst.proto", fileDescriptor_2d2ba73d39f00460) }
 
 var fileDescriptor_2d2ba73d39f00460 = []byte{
-	// 2484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x18, 0xdd, 0x6f, 0x14, 0xc7,
-	0x9d, 0xb1, 0xcf, 0xf6, 0xdd, 0x9c, 0xbf, 0x6e, 0x6c, 0xc3, 0x70, 0x80, 0x7d, 0x1c, 0x38, 0x38,
-	0xb0, 0xfe, 0xc8, 0xd1, 0x9a, 0xd6, 0x0a, 0x41, 0x67, 0x03, 0xad, 0x4b, 0x0c, 0x68, 0x0d, 0xa8,
-	0xad, 0x2a, 0xad, 0xd6, 0xbb, 0xe3, 0x63, 0xe3, 0xdd, 0x9d, 0xcd, 0x7e, 0x98, 0x1e, 0x2f, 0x8d,
-	0xaa, 0x4a, 0x7d, 0x45, 0xed, 0x4b, 0xcb, 0x43, 0x85, 0x2a, 0x55, 0x8a, 0xd4, 0x97, 0x94, 0xc7,
-	0xfc, 0x05, 0x3c, 0x22, 0xf5, 0x05, 0x45, 0x51, 0x94, 0x42, 0x2b, 0x21, 0x3f, 0xa5, 0xc2, 0x36,
-	0x69, 0xa5, 0x4a, 0xd5, 0xcc, 0xec, 0xde, 0xcd, 0x7d, 0x19, 0x62, 0x92, 0xb7, 0x9d, 0xdf, 0xd7,
-	0xfe, 0xe6, 0xf7, 0xfd, 0x1b, 0x98, 0x33, 0xec, 0x28, 0x08, 0x89, 0x6f, 0xb9, 0x41, 0x38, 0xe3,
-	0xf9, 0x34, 0xa4, 0x28, 0x43, 0xcc, 0x0a, 0xe1, 0x9f, 0xf9, 0xa3, 0x15, 0x4a, 0x2b, 0x36, 0x99,
-	0xd5, 0x3d, 0x6b, 0x56, 0x77, 0x5d, 0x1a, 0xea, 0xa1, 0x45, 0xdd, 0x40, 0x10, 0xe6, 0x8f, 0x85,
-	0x94, 0xda, 0xc1, 0x2c, 0x3f, 0x54, 0x88, 0x5b, 0xfb, 0x88, 0xd1, 0xfd, 0x3e, 0x09, 0x22, 0x3b,
-	0x4c, 0x4e, 0xeb, 0xb6, 0xbe, 0x49, 0xfd, 0xf8, 0x34, 0xe4, 0x93, 0x80, 0x46, 0xbe, 0x41, 0x12,
-	0x59, 0x03, 0xb1, 0x1e, 0xf1, 0x31, 0x67, 0xd8, 0x34, 0x32, 0x6d, 0x12, 0x6e, 0x90, 0x6a, 0x22,
-	0xc0, 0xa0, 0x8e, 0x43, 0x13, 0xe1, 0xa3, 0x96, 0xbb, 0xee, 0xeb, 0xcd, 0x52, 0x46, 0x2b, 0xb4,
-	0x42, 0xf9, 0xe7, 0x2c, 0xfb, 0x4a, 0x64, 0x9b, 0x0e, 0x99, 0xb5, 0xa9, 0x21, 0x8e, 0xc5, 0x7f,
-	0x01, 0x38, 0xbc, 0x24, 0xfe, 0xb6, 0xec, 0x06, 0xe1, 0x15, 0x52, 0xbd, 0xf5, 0x0e, 0x7a, 0x0f,
-	0x66, 0x63, 0x0d, 0xb4, 0x0d, 0x52, 0xc5, 0xa0, 0x00, 0xa6, 0xb2, 0xa5, 0x43, 0x33, 0x35, 0x53,
-	0xcc, 0xc4, 0x1c, 0x9c, 0x7a, 0x31, 0xf5, 0xe8, 0x8b, 0x89, 0x03, 0x2a, 0x34, 0x6a, 0x30, 0x74,
-	0x05, 0xf6, 0x27, 0x2a, 0x73, 0x01, 0x5d, 0x5c, 0xc0, 0xc1, 0x06, 0x01, 0x02, 0x7d, 0x85, 0x54,
-	0x17, 0x87, 0xb7, 0x76, 0x71, 0x3a, 0x01, 0x70, 0x59, 0x59, 0xa3, 0x8e, 0x46, 0xf3, 0xb0, 0x9f,
-	0xfa, 0x15, 0xdd, 0xb5, 0xee, 0x72, 0x7b, 0xe3, 0xee, 0x02, 0x98, 0xca, 0x2c, 0xa2, 0x4f, 0x77,
-	0x71, 0xf2, 0x4b, 0xea, 0x57, 0x1e, 0xef, 0x62, 0xa0, 0x36, 0xd0, 0x2d, 0xf4, 0x3f, 0x7f, 0x81,
-	0xc1, 0xd7, 0x2f, 0x30, 0xf8, 0xe4, 0xc1, 0x04, 0x28, 0xfe, 0xb5, 0xf5, 0x9e, 0x25, 0xf4, 0x6e,
-	0xbb, 0x7b, 0x8e, 0xb5, 0xbd, 0xe7, 0x77, 0x7c, 0xcb, 0x26, 0x6d, 0xff, 0x73, 0x18, 0x66, 0x25,
-	0x6d, 0xd1, 0x41, 0xd8, 0xbb, 0x6e, 0x11, 0xdb, 0x0c, 0x30, 0x28, 0x74, 0x4f, 0x65, 0xd4, 0xf8,
-	0x84, 0xa6, 0x61, 0x77, 0xfd, 0xcf, 0x7b, 0x2a, 0xce, 0xe8, 0x5a, 0x34, 0x7e, 0xfb, 0x4d, 0xfc,
-	0x72, 0x16, 0xa6, 0xef, 0x52, 0x97, 0x70, 0x41, 0x67, 0xb8, 0x20, 0x24, 0x09, 0xfa, 0x39, 0x75,
-	0x49, 0xfd, 0xef, 0x7d, 0x77, 0xc5, 0x11, 0xcd, 0xc3, 0x5e, 0x11, 0xfa, 0xdc, 0x8d, 0xd9, 0xd2,
-	0xa8, 0xc4, 0x72, 0x99, 0x23, 0x18, 0x53, 0xfa, 0xe3, 0x6d, 0x0c, 0x38, 0x63, 0x4c, 0x8d, 0xbe,
-	0x0f, 0xd3, 0xb6, 0xb5, 0x49, 0x5c, 0x12, 0x04, 0x38, 0x53, 0x00, 0x53, 0x83, 0xa5, 0x11, 0x89,
-	0xf3, 0xfd, 0x18, 0xb5, 0x98, 0x62, 0x8c, 0x6a, 0x8d, 0x14, 0x61, 0x98, 0xd2, 0xa3, 0x90, 0x62,
-	0x58, 0x00, 0x53, 0xe9, 0x18, 0xcb, 0x21, 0xe8, 0x2c, 0xec, 0x09, 0x42, 0x3d, 0x24, 0x38, 0xc5,
-	0xa5, 0xc9, 0xc1, 0x7d, 0xc3, 0xd7, 0x8d, 0x0d, 0x62, 0xae, 0x32, 0x74, 0xcc, 0x23, 0x68, 0xd1,
-	0x24, 0xec, 0x25, 0xbe, 0x4f, 0xfd, 0x00, 0xf7, 0x30, 0x37, 0x2c, 0x0e, 0x30, 0xe4, 0xef, 0x1e,
-	0x1e, 0xee, 0x71, 0xa9, 0xe1, 0x78, 0x6a, 0x8c, 0x44, 0x3f, 0x84, 0xfd, 0x86, 0xef, 0x68, 0x74,
-	0x93, 0xf8, 0xbe, 0x65, 0x12, 0xdc, 0xcb, 0x7f, 0xd1, 0x60, 0x66, 0x75, 0xe5, 0x5a, 0x8c, 0x55,
-	0xb3, 0x86, 0xef, 0x24, 0x07, 0x34, 0x0f, 0x33, 0x96, 0xa7, 0xe9, 0x86, 0xc1, 0x2e, 0xda, 0xd7,
-	0x72, 0xd1, 0x65, 0xaf, 0xcc, 0x51, 0xc9, 0x45, 0xad, 0xf8, 0x8c, 0xe6, 0x60, 0xbf, 0x6e, 0xdb,
-	0xd4, 0xd0, 0x43, 0x62, 0x6a, 0x96, 0x87, 0xd3, 0x3c, 0x49, 0x9a, 0xf4, 0xcb, 0xd6, 0x48, 0x96,
-	0x3d, 0x34, 0x09, 0xb3, 0x2e, 0x35, 0x89, 0x16, 0xbb, 0x23, 0xcb, 0x19, 0x84, 0x58, 0xc8, 0x10,
-	0xc2, 0x1b, 0xe8, 0x24, 0x84, 0x26, 0xf1, 0x6c, 0x5a, 0x75, 0x88, 0x1b, 0xe2, 0x21, 0x99, 0xaa,
-	0x0e, 0x47, 0x13, 0x30, 0xeb, 0x46, 0x8e, 0xe6, 0xe8, 0x2c, 0xe8, 0x02, 0x3c, 0x50, 0x00, 0x53,
-	0x03, 0x2a, 0x74, 0x23, 0x67, 0x45, 0x40, 0xd0, 0x11, 0x98, 0x61, 0x04, 0x4c, 0x70, 0x80, 0x07,
-	0x39, 0x3a, 0xed, 0x46, 0xce, 0x55, 0x76, 0x46, 0xf3, 0x70, 0x94, 0xfc, 0x32, 0x24, 0xbe, 0xab,
-	0xdb, 0xda, 0x26, 0xb5, 0x23, 0x87, 0x68, 0x81, 0x75, 0x97, 0xe0, 0x5c, 0x01, 0x4c, 0xa5, 0xe2,
-	0xbf, 0xa1, 0x84, 0xe2, 0x16, 0x27, 0x58, 0xb5, 0xee, 0x12, 0x74, 0x01, 0xe6, 0x98, 0x2f, 0xb5,
-	0xc0, 0xd0, 0x6d, 0xa2, 0x79, 0xd4, 0xb6, 0x8c, 0x2a, 0x46, 0x5c, 0xc5, 0x91, 0xad, 0x5d, 0x3c,
-	0x54, 0x8e, 0x42, 0xba, 0xca, 0x70, 0xd7, 0x39, 0x4a, 0x1d, 0xd2, 0x1b, 0x01, 0xe8, 0x0c, 0xcc,
-	0xe9, 0x9b, 0xba, 0x65, 0xeb, 0x6b, 0x96, 0x6d, 0x85, 0x55, 0x8d, 0x45, 0x29, 0x1e, 0x61, 0x02,
-	0xd4, 0x61, 0x19, 0xc1, 0x82, 0x19, 0x9d, 0x80, 0xd0, 0x72, 0xf4, 0x0a, 0xd1, 0x5c, 0xdd, 0x21,
-	0x78, 0x54, 0xb2, 0x44, 0x86, 0xc3, 0xaf, 0xea, 0x0e, 0x41, 0xe3, 0x10, 0xfa, 0x24, 0x20, 0xfe,
-	0xa6, 0xbe, 0x66, 0x13, 0x3c, 0xc6, 0xc2, 0x4e, 0x95, 0x20, 0xcc, 0xea, 0xe2, 0x44, 0x4c, 0x6d,
-	0xad, 0x8a, 0x0f, 0xca, 0xf6, 0x4c, 0x10, 0x8b, 0x55, 0xa4, 0x40, 0x14, 0xdc, 0xd6, 0x7d, 0x62,
-	0x36, 0xd8, 0xe3, 0x10, 0xb3, 0x87, 0x3a, 0x2c, 0x30, 0x92, 0x1d, 0x4a, 0x10, 0x09, 0xcb, 0x6b,
-	0xb2, 0x47, 0x0f, 0x4b, 0xb2, 0x87, 0x05, 0xfe, 0x6a, 0xdd, 0xaf, 0xef, 0xc2, 0x23, 0xc1, 0x86,
-	0xe5, 0x69, 0x2c, 0x50, 0x0d, 0x9b, 0xe8, 0x6e, 0xe4, 0x69, 0xd4, 0xd5, 0xd6, 0x75, 0xcb, 0x8e,
-	0x7c, 0x82, 0xf3, 0x5c, 0xf3, 0x43, 0x8c, 0x64, 0xc9, 0x77, 0x96, 0x04, 0xc1, 0x35, 0xf7, 0xb2,
-	0x40, 0xa3, 0x63, 0xb0, 0x8f, 0x7a, 0xa1, 0xe6, 0x93, 0x00, 0x1f, 0x91, 0x7e, 0xd3, 0x4b, 0xbd,
-	0x50, 0x25, 0x01, 0x2a, 0xc3, 0x4c, 0xad, 0x19, 0xe1, 0xa3, 0x3c, 0xd1, 0x0f, 0xcb, 0x51, 0xcc,
-	0xba, 0x95, 0x9a, 0x10, 0x48, 0xd9, 0x5e, 0xe7, 0x42, 0x3f, 0x83, 0xd0, 0xf0, 0x09, 0x0f, 0x67,
-	0x3d, 0xc4, 0xc7, 0xb8, 0x8c, 0x13, 0x33, 0xa6, 0x15, 0x84, 0xbe, 0xb5, 0x16, 0x31, 0xb0, 0xa3,
-	0x87, 0xc6, 0x6d, 0x8d, 0xb8, 0x15, 0xcb, 0x25, 0x33, 0x37, 0x2c, 0x87, 0x04, 0xa1, 0xee, 0x78,
-	0x8b, 0x63, 0x71, 0xcc, 0x67, 0xc2, 0x04, 0x24, 0x44, 0xc7, 0xd2, 0xca, 0x21, 0x13, 0x1d, 0x79,
-	0x66, 0x22, 0x7a, 0xfc, 0xcd, 0x45, 0xc7, 0xd2, 0xca, 0x21, 0xb2, 0xe1, 0x68, 0xec, 0x6c, 0xd6,
-	0x82, 0x34, 0xe2, 0x9a, 0xe2, 0x27, 0x13, 0x6f, 0xfc, 0x13, 0x24, 0xc9, 0xbd, 0xc4, 0xc4, 0x96,
-	0x43, 0x74, 0x1c, 0xf6, 0x3b, 0x91, 0x1d, 0x5a, 0x5a, 0x48, 0x5c, 0xdd, 0x0d, 0x71, 0x81, 0x3b,
-	0x2d, 0xcb, 0x61, 0x37, 0x38, 0x08, 0x9d, 0x82, 0x69, 0x97, 0x84, 0x77, 0xa8, 0xbf, 0x11, 0xe0,
-	0xe3, 0xbc, 0x66, 0x65, 0xb7, 0x76, 0x71, 0xdf, 0x55, 0x01, 0x53, 0x6b, 0x48, 0x74, 0x06, 0x0e,
-	0x9a, 0xc4, 0x26, 0x21, 0xd1, 0x3c, 0x9f, 0x78, 0xba, 0x4f, 0x70, 0x51, 0xaa, 0x99, 0x03, 0x02,
-	0x77, 0x5d, 0xa0, 0xd0, 0x71, 0x98, 0x31, 0xdd, 0x40, 0xb3, 0xf5, 0x35, 0x62, 0xe3, 0x13, 0x52,
-	0x00, 0xa4, 0x4d, 0x37, 0x78, 0x9f, 0x41, 0x59, 0xe5, 0x5d, 0xff, 0xd0, 0x74, 0xf1, 0x49, 0x09,
-	0xcb, 0x21, 0x2c, 0x05, 0x58, 0x35, 0xb5, 0x0c, 0x8d, 0x13, 0x9c, 0x92, 0x53, 0x40, 0x20, 0x2e,
-	0x33, 0xb2, 0x09, 0x98, 0x25, 0x2e, 0xcb, 0x19, 0xcd, 0xf2, 0x36, 0xe7, 0xf1, 0xa4, 0x48, 0x25,
-	0x01, 0x5a, 0xf6, 0x36, 0xe7, 0xd1, 0x49, 0xd8, 0x4b, 0xd7, 0x3e, 0xd0, 0x2c, 0x13, 0xbf, 0xd5,
-	0xae, 0xd8, 0xf5, 0xd0, 0xb5, 0x0f, 0x96, 0x4d, 0xb4, 0x08, 0xc7, 0x0c, 0xea, 0x78, 0x7a, 0x68,
-	0xc5, 0x39, 0xbe, 0x49, 0xfc, 0x80, 0x8d, 0x11, 0x53, 0xac, 0x08, 0x35, 0x33, 0x8d, 0x36, 0xd0,
-	0xde, 0x12, 0xa4, 0x68, 0x19, 0x66, 0xa5, 0x79, 0x0f, 0x9f, 0x2e, 0x74, 0x4f, 0x65, 0x4b, 0xa7,
-	0x5a, 0xbb, 0x2d, 0x6b, 0xd5, 0x33, 0xe5, 0x3a, 0xe5, 0x25, 0x37, 0xf4, 0xab, 0xaa, 0xcc, 0x8b,
-	0xa6, 0x61, 0xd6, 0x5c, 0xd3, 0x1c, 0x6a, 0x12, 0x9b, 0x69, 0xae, 0x14, 0xc0, 0x54, 0x4f, 0xb3,
-	0x12, 0x19, 0x73, 0x6d, 0x85, 0x11, 0x2c, 0x9b, 0xe8, 0x02, 0x1c, 0xe4, 0x29, 0x5d, 0xcf, 0xa6,
-	0x69, 0x1e, 0x49, 0x58, 0xfa, 0x39, 0x4b, 0xea, 0x5a, 0x32, 0xa9, 0x03, 0xae, 0x7c, 0x44, 0x25,
-	0xc8, 0x8b, 0xb9, 0xe6, 0xb1, 0xe1, 0x14, 0xcf, 0x70, 0xcd, 0x47, 0x9a, 0x98, 0xaf, 0x53, 0x6a,
-	0xab, 0x19, 0x37, 0xfe, 0x0a, 0xd0, 0x6d, 0x98, 0xe3, 0xf3, 0xa4, 0x26, 0x5f, 0x7a, 0x96, 0xb3,
-	0x2a, 0x1d, 0x2e, 0xcd, 0x33, 0xba, 0xf9, 0xe6, 0xcd, 0xf7, 0x1a, 0xb6, 0x9a, 0xa8, 0xd0, 0x34,
-	0x44, 0x1b, 0xd1, 0x1a, 0xf1, 0x5d, 0x12, 0x92, 0xa0, 0xe6, 0x99, 0x39, 0x5e, 0x80, 0x73, 0x75,
-	0x4c, 0xdd, 0x0f, 0xc7, 0x4d, 0x2b, 0xe0, 0x31, 0x61, 0x56, 0x5d, 0xdd, 0xb1, 0x0c, 0x4d, 0xf7,
-	0x3c, 0x36, 0xac, 0x6b, 0x9e, 0xad, 0x1b, 0x84, 0xb7, 0xa8, 0x77, 0x78, 0xa0, 0x8c, 0xc7, 0x84,
-	0x17, 0x05, 0x5d, 0x59, 0x90, 0x5d, 0x4f, 0xa8, 0xd0, 0x12, 0x3c, 0x52, 0x9b, 0x84, 0x1c, 0xdd,
-	0xd5, 0x2b, 0xc4, 0xd4, 0x92, 0x51, 0xd0, 0x32, 0x71, 0x49, 0x0a, 0x4a, 0x9c, 0x10, 0xae, 0x08,
-	0xba, 0xe4, 0xde, 0x26, 0xfa, 0x11, 0x3c, 0xd6, 0x51, 0x08, 0x6f, 0x12, 0x67, 0x25, 0x31, 0xf9,
-	0xf6, 0x62, 0x78, 0xd7, 0xf8, 0x1e, 0x4c, 0x85, 0x7a, 0x25, 0xc0, 0x26, 0x37, 0x72, 0xa1, 0x83,
-	0x91, 0x6f, 0xe8, 0x95, 0x38, 0xa4, 0x38, 0x75, 0xfe, 0x3d, 0x38, 0xdc, 0x6c, 0x72, 0x34, 0x2c,
-	0x06, 0x42, 0xc0, 0x4d, 0xc8, 0x67, 0xbe, 0x51, 0xd8, 0xb3, 0xa9, 0xdb, 0x11, 0xe1, 0x43, 0x62,
-	0x46, 0x15, 0x87, 0x85, 0xae, 0x1f, 0x80, 0xfc, 0x12, 0x1c, 0x6b, 0xeb, 0xb7, 0x6f, 0x24, 0xe4,
-	0x1c, 0xcc, 0xd4, 0xf4, 0xfa, 0x26, 0x8c, 0x0b, 0xf7, 0x52, 0x6c, 0xe2, 0xfd, 0xea, 0x05, 0x06,
-	0x1f, 0x6d, 0x63, 0x70, 0x6f, 0x1b, 0x83, 0x3f, 0x6c, 0x63, 0xf0, 0x09, 0x6b, 0x05, 0xdb, 0x18,
-	0x3c, 0x61, 0x91, 0xb3, 0x83, 0xff, 0x0d, 0x92, 0xd1, 0x4e, 0x61, 0xcd, 0x5c, 0x59, 0x69, 0x6a,
-	0x63, 0x8a, 0xf4, 0x79, 0xa9, 0x65, 0x40, 0x50, 0xca, 0xf5, 0x79, 0x47, 0x51, 0x6b, 0xdd, 0x55,
-	0xe1, 0x93, 0x9d, 0x72, 0x89, 0x0f, 0x6b, 0x4a, 0x2d, 0x57, 0x94, 0x72, 0x53, 0xc7, 0x57, 0x96,
-	0x92, 0x96, 0xa1, 0xdc, 0x4c, 0x2a, 0xbc, 0x72, 0x8d, 0x77, 0xb8, 0x58, 0x9a, 0x5c, 0x8d, 0x95,
-	0x8b, 0x72, 0x89, 0x54, 0x2e, 0xc6, 0x85, 0x50, 0x61, 0xc5, 0x8c, 0xff, 0x51, 0xd4, 0x35, 0x65,
-	0xa9, 0x4d, 0x85, 0x51, 0xa4, 0xb1, 0xfb, 0xfe, 0x0e, 0xfe, 0x1c, 0xc4, 0x51, 0x74, 0xfe, 0x0a,
-	0xa9, 0xce, 0xb0, 0x08, 0x51, 0x92, 0xe0, 0x39, 0x2f, 0x51, 0x36, 0x62, 0xa8, 0x5f, 0x69, 0x40,
-	0x5e, 0x93, 0x76, 0x21, 0x65, 0x9d, 0x98, 0xc4, 0x67, 0x37, 0x68, 0xa6, 0xba, 0x9c, 0x20, 0x1a,
-	0xc8, 0xeb, 0x7b, 0xd5, 0xf9, 0x56, 0x51, 0xdc, 0xda, 0xe7, 0x85, 0xd1, 0x85, 0x0a, 0x6c, 0x6c,
-	0x3a, 0x1f, 0x0f, 0xfc, 0x75, 0x08, 0xe3, 0x4e, 0x80, 0xb2, 0x84, 0xcf, 0x76, 0x70, 0x5f, 0x0c,
-	0x7f, 0xb8, 0x8b, 0x7b, 0x8d, 0x28, 0x08, 0xa9, 0xf3, 0xe0, 0x25, 0x06, 0x3f, 0x49, 0xa5, 0x87,
-	0x87, 0x73, 0xc5, 0x9b, 0x30, 0xbf, 0x6c, 0xda, 0x44, 0xad, 0x0d, 0x4d, 0x52, 0x0e, 0x04, 0xe8,
-	0x6d, 0x98, 0xb1, 0x4c, 0x9b, 0x68, 0xac, 0x3b, 0xf2, 0x40, 0xeb, 0x5e, 0xec, 0xff, 0xef, 0x17,
-	0x13, 0xe9, 0x8b, 0x91, 0xcf, 0xa5, 0xab, 0x69, 0x86, 0x66, 0xed, 0x74, 0xa1, 0xff, 0xeb, 0x1d,
-	0x0c, 0x1e, 0xee, 0xe2, 0x94, 0x4b, 0x5d, 0x52, 0xfc, 0x5f, 0x0a, 0x8e, 0xc6, 0x92, 0x12, 0x97,
-	0xdf, 0x0c, 0xf4, 0x0a, 0x49, 0x76, 0x28, 0xf0, 0x9a, 0x3b, 0x94, 0xbc, 0xf6, 0x74, 0xbd, 0xee,
-	0xda, 0x73, 0xa1, 0x69, 0xf1, 0xea, 0xde, 0x73, 0xf1, 0x4a, 0xb5, 0x2e, 0x5b, 0x65, 0x38, 0x14,
-	0xd2, 0x50, 0xb7, 0xa5, 0x4e, 0x90, 0xe2, 0xc5, 0x02, 0x77, 0x9a, 0xab, 0xd4, 0x41, 0xce, 0x50,
-	0x6f, 0x05, 0x3f, 0x86, 0x23, 0x86, 0x17, 0x89, 0x4e, 0x20, 0x89, 0xe9, 0x79, 0x85, 0x98, 0x9c,
-	0xe1, 0x45, 0xbc, 0x2f, 0x34, 0x48, 0xaa, 0xb4, 0x91, 0xd4, 0xfb, 0x2a, 0x49, 0x95, 0x16, 0x49,
-	0x93, 0x70, 0x30, 0xe1, 0xd7, 0x02, 0x83, 0xfa, 0x84, 0xef, 0x3c, 0x29, 0x75, 0x20, 0x81, 0xae,
-	0x32, 0x20, 0x3a, 0x07, 0x71, 0xab, 0xea, 0x31, 0x43, 0x9a, 0x33, 0x8c, 0x35, 0x6b, 0x59, 0x63,
-	0xac, 0x74, 0x62, 0xcc, 0x08, 0xc6, 0x4a, 0x5b, 0xc6, 0x71, 0x08, 0xeb, 0x05, 0x83, 0xaf, 0x8f,
-	0x19, 0x55, 0x82, 0x2c, 0xcc, 0xdd, 0xdf, 0xc1, 0x4a, 0x9b, 0x2c, 0xed, 0x98, 0x37, 0xc5, 0x5f,
-	0xc1, 0xfc, 0x52, 0xfb, 0x46, 0xe2, 0xae, 0xd3, 0x0e, 0x9d, 0x10, 0x74, 0xea, 0x84, 0x8d, 0x6d,
-	0xbd, 0xeb, 0x75, 0xda, 0x7a, 0xf1, 0xcf, 0x3d, 0x70, 0x48, 0x4a, 0x25, 0xfe, 0xdb, 0x6f, 0xe9,
-	0x5d, 0xe1, 0x2d, 0x98, 0x71, 0x69, 0x68, 0xad, 0x57, 0x59, 0xef, 0xec, 0xe6, 0x49, 0x99, 0xa9,
-	0xf7, 0xfd, 0xb4, 0xc0, 0x2d, 0x9b, 0x68, 0xfa, 0xf5, 0x96, 0xee, 0x64, 0xdd, 0x3e, 0xd8, 0xb8,
-	0x6e, 0xd7, 0xf6, 0xeb, 0x73, 0xb0, 0x97, 0x11, 0x44, 0x01, 0xdf, 0xac, 0x1b, 0x15, 0x5c, 0xe5,
-	0x08, 0x76, 0x39, 0xf9, 0x15, 0x41, 0x90, 0x37, 0xee, 0x25, 0x7d, 0xfb, 0xda, 0x4b, 0xdc, 0x3d,
-	0x7a, 0xbe, 0xe5, 0xae, 0x53, 0x1e, 0x8f, 0xd9, 0xd2, 0x64, 0x9b, 0xd4, 0x6e, 0x75, 0xfb, 0xde,
-	0xa3, 0x01, 0xf7, 0x90, 0xd1, 0x6e, 0x18, 0x9b, 0xe6, 0x0e, 0x9f, 0x6b, 0x3f, 0x27, 0x30, 0xb6,
-	0x0e, 0x03, 0x59, 0xbc, 0x0c, 0x36, 0xcf, 0x61, 0xdf, 0xca, 0x24, 0xb0, 0xb0, 0xd4, 0xdc, 0xcf,
-	0x1f, 0x6c, 0x63, 0xf0, 0xe9, 0x36, 0xee, 0x97, 0x5d, 0xfb, 0xe5, 0x76, 0xbd, 0x24, 0x7f, 0xb5,
-	0x8b, 0xc1, 0xc3, 0x97, 0x58, 0x7e, 0xe8, 0x2a, 0x3d, 0xcf, 0xc0, 0x41, 0xe9, 0x5c, 0xf6, 0x2c,
-	0xf4, 0x27, 0x00, 0x73, 0xa2, 0x13, 0x37, 0xbc, 0x88, 0xb5, 0xbf, 0x7c, 0x3e, 0x27, 0xc1, 0x55,
-	0xfe, 0xe4, 0x5a, 0xfc, 0xc5, 0xd6, 0x36, 0x2e, 0x25, 0x6e, 0x95, 0x9b, 0x89, 0x52, 0x36, 0xd8,
-	0x5d, 0x85, 0xd5, 0x95, 0xe6, 0x8c, 0xfd, 0x78, 0x07, 0x83, 0xc7, 0x3b, 0x18, 0xfc, 0xfa, 0xef,
-	0xff, 0xfc, 0x7d, 0x17, 0x2e, 0x8e, 0xcc, 0x8a, 0x0d, 0x72, 0x56, 0x7a, 0x23, 0x5e, 0x00, 0xa7,
-	0xe7, 0x00, 0xba, 0x0f, 0x60, 0x4e, 0x34, 0xff, 0x7d, 0x2a, 0xf8, 0xd3, 0xfd, 0x2b, 0x58, 0x53,
-	0x4e, 0x2c, 0x68, 0xad, 0xca, 0x3d, 0xef, 0x82, 0x39, 0x31, 0xbd, 0xec, 0x53, 0xb9, 0xbf, 0x74,
-	0xed, 0x5f, 0xbb, 0xcf, 0x76, 0xf0, 0x23, 0x90, 0x4c, 0x6a, 0xb5, 0xc7, 0xa0, 0xd6, 0x41, 0xab,
-	0xde, 0xeb, 0x95, 0xd5, 0xa6, 0xc7, 0x0d, 0x25, 0x79, 0xf2, 0x52, 0x2e, 0xd6, 0x9e, 0x9b, 0x94,
-	0xe5, 0xe4, 0xbd, 0x45, 0x89, 0x17, 0xd9, 0x40, 0x59, 0xa9, 0x2f, 0xbd, 0xf2, 0x34, 0xa5, 0x34,
-	0x6c, 0x47, 0x4a, 0x52, 0x17, 0x83, 0x85, 0x13, 0xa2, 0x62, 0x37, 0x00, 0x1a, 0x48, 0xaf, 0x34,
-	0x17, 0xdd, 0x9a, 0xa9, 0xc5, 0xba, 0xdf, 0x6a, 0xea, 0x3f, 0x02, 0x38, 0xb4, 0x7a, 0x9b, 0xde,
-	0x79, 0x1d, 0x43, 0x77, 0x80, 0x17, 0x6f, 0x6c, 0x6d, 0xe3, 0xb9, 0x3d, 0x8c, 0x7d, 0xcb, 0x22,
-	0x77, 0x5a, 0x4c, 0x5d, 0x8b, 0xd2, 0x83, 0xc5, 0xdc, 0x6c, 0x70, 0x9b, 0xde, 0x69, 0xd5, 0xed,
-	0x6f, 0x00, 0x16, 0x44, 0x8c, 0xee, 0x31, 0x5b, 0xc9, 0x45, 0xab, 0x33, 0x59, 0xbb, 0x20, 0x59,
-	0xdd, 0xda, 0xc6, 0xc5, 0x57, 0xc7, 0x08, 0x57, 0xf2, 0x54, 0xb1, 0x98, 0x44, 0x2b, 0x1b, 0xd5,
-	0xea, 0x8f, 0x63, 0x92, 0xd2, 0xc1, 0x02, 0x38, 0x8d, 0x3e, 0x07, 0x10, 0x4b, 0xf6, 0x6c, 0x9c,
-	0xda, 0x3a, 0x19, 0x76, 0xa2, 0x15, 0xde, 0xc0, 0x58, 0xfc, 0x0d, 0xd8, 0x8f, 0x89, 0xb7, 0x76,
-	0xf0, 0xe1, 0x96, 0x51, 0x38, 0xe9, 0xfb, 0x1f, 0xed, 0x62, 0xf0, 0xe4, 0x65, 0xec, 0x83, 0xc9,
-	0x62, 0xa1, 0xc5, 0x07, 0xb3, 0x49, 0xff, 0x98, 0x8d, 0x98, 0x0e, 0xdc, 0x25, 0xa5, 0xdf, 0x02,
-	0x88, 0x9a, 0xca, 0x36, 0x2b, 0x77, 0x1f, 0xc2, 0x91, 0xa6, 0x20, 0xe2, 0x7d, 0x20, 0xdf, 0xb9,
-	0xd8, 0xe7, 0xf7, 0xc0, 0x15, 0x0b, 0x5c, 0xa9, 0x7c, 0x71, 0xac, 0x45, 0x29, 0xd6, 0xad, 0xb8,
-	0x26, 0x8b, 0x47, 0x1f, 0xfd, 0x63, 0xfc, 0xc0, 0xa3, 0xa7, 0xe3, 0xe0, 0xf1, 0xd3, 0x71, 0xf0,
-	0xe5, 0xd3, 0x71, 0x70, 0xef, 0xd9, 0xf8, 0x81, 0xc7, 0xcf, 0xc6, 0x0f, 0x3c, 0x79, 0x36, 0x7e,
-	0x60, 0xad, 0x97, 0x0b, 0x3e, 0xfb, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x70, 0xa6, 0x55,
-	0x1d, 0x1b, 0x00, 0x00,
+	// 2510 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x18, 0x4b, 0x6f, 0x1b, 0xc7,
+	0xd9, 0x23, 0x51, 0x14, 0x39, 0xd4, 0x8b, 0x23, 0xc9, 0x1e, 0xd3, 0xb1, 0x44, 0xd3, 0x51, 0xac,
+	0x24, 0xab, 0x47, 0xe8, 0x54, 0x69, 0x85, 0x38, 0x01, 0x25, 0xd9, 0xad, 0xea, 0xc8, 0x36, 0x56,
+	0x8e, 0x81, 0x16, 0x05, 0x16, 0xcb, 0xdd, 0x11, 0xbd, 0xd1, 0xee, 0xce, 0x66, 0x1f, 0x72, 0xe9,
+	0x4b, 0x83, 0xa2, 0x40, 0xaf, 0x46, 0x7a, 0x69, 0x73, 0x28, 0x8c, 0xa2, 0x87, 0x00, 0xed, 0x21,
+	0xf5, 0x31, 0xbf, 0xc0, 0xb7, 0x1a, 0xe8, 0xc5, 0x08, 0x82, 0x20, 0xb5, 0x5b, 0xa0, 0xd0, 0xa5,
+	0x29, 0x2c, 0xc9, 0x69, 0x81, 0x02, 0xc5, 0xcc, 0xec, 0x92, 0xc3, 0x97, 0xec, 0xd8, 0xe9, 0x6d,
+	0xe7, 0x7b, 0xed, 0x37, 0xdf, 0xfb, 0x1b, 0x98, 0x37, 0xec, 0x28, 0x08, 0x89, 0x6f, 0xb9, 0x41,
+	0x38, 0xef, 0xf9, 0x34, 0xa4, 0x28, 0x4b, 0xcc, 0x1a, 0xe1, 0x9f, 0x85, 0x17, 0x6a, 0x94, 0xd6,
+	0x6c, 0xb2, 0xa0, 0x7b, 0xd6, 0x82, 0xee, 0xba, 0x34, 0xd4, 0x43, 0x8b, 0xba, 0x81, 0x20, 0x2c,
+	0x9c, 0x0c, 0x29, 0xb5, 0x83, 0x05, 0x7e, 0xa8, 0x11, 0xb7, 0xf1, 0x11, 0xa3, 0x87, 0x7c, 0x12,
+	0x44, 0x76, 0x98, 0x9c, 0xb6, 0x6c, 0x7d, 0x87, 0xfa, 0xf1, 0x69, 0xd4, 0x27, 0x01, 0x8d, 0x7c,
+	0x83, 0x24, 0xb2, 0x86, 0x63, 0x3d, 0xe2, 0x63, 0xde, 0xb0, 0x69, 0x64, 0xda, 0x24, 0xdc, 0x26,
+	0xf5, 0x44, 0x80, 0x41, 0x1d, 0x87, 0x26, 0xc2, 0x27, 0x2c, 0x77, 0xcb, 0xd7, 0xdb, 0xa5, 0x4c,
+	0xd4, 0x68, 0x8d, 0xf2, 0xcf, 0x05, 0xf6, 0x95, 0xc8, 0x36, 0x1d, 0xb2, 0x60, 0x53, 0x43, 0x1c,
+	0x4b, 0x7f, 0x07, 0x70, 0x6c, 0x55, 0xfc, 0x6d, 0xdd, 0x0d, 0xc2, 0x8b, 0xa4, 0x7e, 0xed, 0x35,
+	0xf4, 0x16, 0xcc, 0xc5, 0x1a, 0x68, 0xdb, 0xa4, 0x8e, 0x41, 0x11, 0xcc, 0xe6, 0xca, 0xc7, 0xe6,
+	0x1b, 0xa6, 0x98, 0x8f, 0x39, 0x38, 0xf5, 0x4a, 0xea, 0xee, 0x17, 0xd3, 0x47, 0x54, 0x68, 0x34,
+	0x60, 0xe8, 0x22, 0x1c, 0x4a, 0x54, 0xe6, 0x02, 0xfa, 0xb8, 0x80, 0xa3, 0x2d, 0x02, 0x04, 0xfa,
+	0x22, 0xa9, 0xaf, 0x8c, 0xed, 0x1e, 0xe0, 0x4c, 0x02, 0xe0, 0xb2, 0x72, 0x46, 0x13, 0x8d, 0x96,
+	0xe0, 0x10, 0xf5, 0x6b, 0xba, 0x6b, 0xdd, 0xe4, 0xf6, 0xc6, 0xfd, 0x45, 0x30, 0x9b, 0x5d, 0x41,
+	0x9f, 0x1e, 0xe0, 0xe4, 0x97, 0xd4, 0xaf, 0xdd, 0x3b, 0xc0, 0x40, 0x6d, 0xa1, 0x5b, 0x1e, 0xfa,
+	0xc7, 0x23, 0x0c, 0xbe, 0x7e, 0x84, 0xc1, 0x27, 0xb7, 0xa7, 0x41, 0xe9, 0x0f, 0x9d, 0xf7, 0x2c,
+	0xa3, 0x37, 0xbb, 0xdd, 0x73, 0xb2, 0xeb, 0x3d, 0xff, 0xcf, 0xb7, 0x6c, 0xd3, 0xf6, 0xc3, 0x02,
+	0xcc, 0x49, 0xda, 0xa2, 0xa3, 0x30, 0xbd, 0x65, 0x11, 0xdb, 0x0c, 0x30, 0x28, 0xf6, 0xcf, 0x66,
+	0xd5, 0xf8, 0x84, 0xe6, 0x60, 0x7f, 0xf3, 0xcf, 0x87, 0x2a, 0xce, 0xe8, 0x3a, 0x34, 0x7e, 0xf9,
+	0x79, 0xfc, 0x72, 0x16, 0x66, 0x6e, 0x52, 0x97, 0x70, 0x41, 0xaf, 0x72, 0x41, 0x48, 0x12, 0xf4,
+	0x63, 0xea, 0x92, 0xe6, 0xdf, 0x07, 0x6f, 0x8a, 0x23, 0x5a, 0x82, 0x69, 0x11, 0xfa, 0xdc, 0x8d,
+	0xb9, 0xf2, 0x84, 0xc4, 0x72, 0x81, 0x23, 0x18, 0x53, 0xe6, 0xe3, 0x3d, 0x0c, 0x38, 0x63, 0x4c,
+	0x8d, 0xbe, 0x03, 0x33, 0xb6, 0xb5, 0x43, 0x5c, 0x12, 0x04, 0x38, 0x5b, 0x04, 0xb3, 0x23, 0xe5,
+	0x71, 0x89, 0xf3, 0x9d, 0x18, 0xb5, 0x92, 0x62, 0x8c, 0x6a, 0x83, 0x14, 0x61, 0x98, 0xd2, 0xa3,
+	0x90, 0x62, 0x58, 0x04, 0xb3, 0x99, 0x18, 0xcb, 0x21, 0xe8, 0x2c, 0x1c, 0x08, 0x42, 0x3d, 0x24,
+	0x38, 0xc5, 0xa5, 0xc9, 0xc1, 0x7d, 0xd5, 0xd7, 0x8d, 0x6d, 0x62, 0x6e, 0x32, 0x74, 0xcc, 0x23,
+	0x68, 0xd1, 0x0c, 0x4c, 0x13, 0xdf, 0xa7, 0x7e, 0x80, 0x07, 0x98, 0x1b, 0x56, 0x86, 0x19, 0xf2,
+	0xc3, 0x3b, 0xc7, 0x07, 0x5c, 0x6a, 0x38, 0x9e, 0x1a, 0x23, 0xd1, 0xf7, 0xe0, 0x90, 0xe1, 0x3b,
+	0x1a, 0xdd, 0x21, 0xbe, 0x6f, 0x99, 0x04, 0xa7, 0xf9, 0x2f, 0x5a, 0xcc, 0xac, 0x6e, 0x5c, 0x8e,
+	0xb1, 0x6a, 0xce, 0xf0, 0x9d, 0xe4, 0x80, 0x96, 0x60, 0xd6, 0xf2, 0x34, 0xdd, 0x30, 0xd8, 0x45,
+	0x07, 0x3b, 0x2e, 0xba, 0xee, 0x55, 0x38, 0x2a, 0xb9, 0xa8, 0x15, 0x9f, 0xd1, 0x22, 0x1c, 0xd2,
+	0x6d, 0x9b, 0x1a, 0x7a, 0x48, 0x4c, 0xcd, 0xf2, 0x70, 0x86, 0x27, 0x49, 0x9b, 0x7e, 0xb9, 0x06,
+	0xc9, 0xba, 0x87, 0x66, 0x60, 0xce, 0xa5, 0x26, 0xd1, 0x62, 0x77, 0xe4, 0x38, 0x83, 0x10, 0x0b,
+	0x19, 0x42, 0x78, 0x03, 0xbd, 0x08, 0xa1, 0x49, 0x3c, 0x9b, 0xd6, 0x1d, 0xe2, 0x86, 0x78, 0x54,
+	0xa6, 0x6a, 0xc2, 0xd1, 0x34, 0xcc, 0xb9, 0x91, 0xa3, 0x39, 0x3a, 0x0b, 0xba, 0x00, 0x0f, 0x17,
+	0xc1, 0xec, 0xb0, 0x0a, 0xdd, 0xc8, 0xd9, 0x10, 0x10, 0x74, 0x02, 0x66, 0x19, 0x01, 0x13, 0x1c,
+	0xe0, 0x11, 0x8e, 0xce, 0xb8, 0x91, 0x73, 0x89, 0x9d, 0xd1, 0x12, 0x9c, 0x20, 0x3f, 0x0d, 0x89,
+	0xef, 0xea, 0xb6, 0xb6, 0x43, 0xed, 0xc8, 0x21, 0x5a, 0x60, 0xdd, 0x24, 0x38, 0x5f, 0x04, 0xb3,
+	0xa9, 0xf8, 0x6f, 0x28, 0xa1, 0xb8, 0xc6, 0x09, 0x36, 0xad, 0x9b, 0x04, 0xbd, 0x0d, 0xf3, 0xcc,
+	0x97, 0x5a, 0x60, 0xe8, 0x36, 0xd1, 0x3c, 0x6a, 0x5b, 0x46, 0x1d, 0x23, 0xae, 0xe2, 0xf8, 0xee,
+	0x01, 0x1e, 0xad, 0x44, 0x21, 0xdd, 0x64, 0xb8, 0x2b, 0x1c, 0xa5, 0x8e, 0xea, 0xad, 0x00, 0xf4,
+	0x2a, 0xcc, 0xeb, 0x3b, 0xba, 0x65, 0xeb, 0x55, 0xcb, 0xb6, 0xc2, 0xba, 0xc6, 0xa2, 0x14, 0x8f,
+	0x33, 0x01, 0xea, 0x98, 0x8c, 0x60, 0xc1, 0x8c, 0x4e, 0x43, 0x68, 0x39, 0x7a, 0x8d, 0x68, 0xae,
+	0xee, 0x10, 0x3c, 0x21, 0x59, 0x22, 0xcb, 0xe1, 0x97, 0x74, 0x87, 0xa0, 0x29, 0x08, 0x7d, 0x12,
+	0x10, 0x7f, 0x47, 0xaf, 0xda, 0x04, 0x4f, 0xb2, 0xb0, 0x53, 0x25, 0x08, 0xb3, 0xba, 0x38, 0x11,
+	0x53, 0xab, 0xd6, 0xf1, 0x51, 0xd9, 0x9e, 0x09, 0x62, 0xa5, 0x8e, 0x14, 0x88, 0x82, 0xeb, 0xba,
+	0x4f, 0xcc, 0x16, 0x7b, 0x1c, 0x63, 0xf6, 0x50, 0xc7, 0x04, 0x46, 0xb2, 0x43, 0x19, 0x22, 0x61,
+	0x79, 0x4d, 0xf6, 0xe8, 0x71, 0x49, 0xf6, 0x98, 0xc0, 0x5f, 0x6a, 0xfa, 0xf5, 0x4d, 0x78, 0x22,
+	0xd8, 0xb6, 0x3c, 0x8d, 0x05, 0xaa, 0x61, 0x13, 0xdd, 0x8d, 0x3c, 0x8d, 0xba, 0xda, 0x96, 0x6e,
+	0xd9, 0x91, 0x4f, 0x70, 0x81, 0x6b, 0x7e, 0x8c, 0x91, 0xac, 0xfa, 0xce, 0xaa, 0x20, 0xb8, 0xec,
+	0x5e, 0x10, 0x68, 0x74, 0x12, 0x0e, 0x52, 0x2f, 0xd4, 0x7c, 0x12, 0xe0, 0x13, 0xd2, 0x6f, 0xd2,
+	0xd4, 0x0b, 0x55, 0x12, 0xa0, 0x0a, 0xcc, 0x36, 0x9a, 0x11, 0x7e, 0x81, 0x27, 0xfa, 0x71, 0x39,
+	0x8a, 0x59, 0xb7, 0x52, 0x13, 0x02, 0x29, 0xdb, 0x9b, 0x5c, 0xe8, 0x47, 0x10, 0x1a, 0x3e, 0xe1,
+	0xe1, 0xac, 0x87, 0xf8, 0x24, 0x97, 0x71, 0x7a, 0xde, 0xb4, 0x82, 0xd0, 0xb7, 0xaa, 0x11, 0x03,
+	0x3b, 0x7a, 0x68, 0x5c, 0xd7, 0x88, 0x5b, 0xb3, 0x5c, 0x32, 0x7f, 0xd5, 0x72, 0x48, 0x10, 0xea,
+	0x8e, 0xb7, 0x32, 0x19, 0xc7, 0x7c, 0x36, 0x4c, 0x40, 0x42, 0x74, 0x2c, 0xad, 0x12, 0x32, 0xd1,
+	0x91, 0x67, 0x26, 0xa2, 0xa7, 0x9e, 0x5f, 0x74, 0x2c, 0xad, 0x12, 0x22, 0x1b, 0x4e, 0xc4, 0xce,
+	0x66, 0x2d, 0x48, 0x23, 0xae, 0x29, 0x7e, 0x32, 0xfd, 0xdc, 0x3f, 0x41, 0x92, 0xdc, 0xf3, 0x4c,
+	0x6c, 0x25, 0x44, 0xa7, 0xe0, 0x90, 0x13, 0xd9, 0xa1, 0xa5, 0x85, 0xc4, 0xd5, 0xdd, 0x10, 0x17,
+	0xb9, 0xd3, 0x72, 0x1c, 0x76, 0x95, 0x83, 0xd0, 0x19, 0x98, 0x71, 0x49, 0x78, 0x83, 0xfa, 0xdb,
+	0x01, 0x3e, 0xc5, 0x6b, 0x56, 0x6e, 0xf7, 0x00, 0x0f, 0x5e, 0x12, 0x30, 0xb5, 0x81, 0x44, 0xaf,
+	0xc2, 0x11, 0x93, 0xd8, 0x24, 0x24, 0x9a, 0xe7, 0x13, 0x4f, 0xf7, 0x09, 0x2e, 0x49, 0x35, 0x73,
+	0x58, 0xe0, 0xae, 0x08, 0x14, 0x3a, 0x05, 0xb3, 0xa6, 0x1b, 0x68, 0xb6, 0x5e, 0x25, 0x36, 0x3e,
+	0x2d, 0x05, 0x40, 0xc6, 0x74, 0x83, 0x77, 0x18, 0x94, 0x55, 0xde, 0xad, 0xf7, 0x4d, 0x17, 0xbf,
+	0x28, 0x61, 0x39, 0x84, 0xa5, 0x00, 0xab, 0xa6, 0x96, 0xa1, 0x71, 0x82, 0x33, 0x72, 0x0a, 0x08,
+	0xc4, 0x05, 0x46, 0x36, 0x0d, 0x73, 0xc4, 0x65, 0x39, 0xa3, 0x59, 0xde, 0xce, 0x12, 0x9e, 0x11,
+	0xa9, 0x24, 0x40, 0xeb, 0xde, 0xce, 0x12, 0x7a, 0x11, 0xa6, 0x69, 0xf5, 0x3d, 0xcd, 0x32, 0xf1,
+	0x4b, 0xdd, 0x8a, 0xdd, 0x00, 0xad, 0xbe, 0xb7, 0x6e, 0xa2, 0x15, 0x38, 0x69, 0x50, 0xc7, 0xd3,
+	0x43, 0x2b, 0xce, 0xf1, 0x1d, 0xe2, 0x07, 0x6c, 0x8c, 0x98, 0x65, 0x45, 0xa8, 0x9d, 0x69, 0xa2,
+	0x85, 0xf6, 0x9a, 0x20, 0x45, 0xeb, 0x30, 0x27, 0xcd, 0x7b, 0xf8, 0x95, 0x62, 0xff, 0x6c, 0xae,
+	0x7c, 0xa6, 0xb3, 0xdb, 0xb2, 0x56, 0x3d, 0x5f, 0x69, 0x52, 0x9e, 0x77, 0x43, 0xbf, 0xae, 0xca,
+	0xbc, 0x68, 0x0e, 0xe6, 0xcc, 0xaa, 0xe6, 0x50, 0x93, 0xd8, 0x4c, 0x73, 0xa5, 0x08, 0x66, 0x07,
+	0xda, 0x95, 0xc8, 0x9a, 0xd5, 0x0d, 0x46, 0xb0, 0x6e, 0xa2, 0xb7, 0xe1, 0x08, 0x4f, 0xe9, 0x66,
+	0x36, 0xcd, 0xf1, 0x48, 0xc2, 0xd2, 0xcf, 0x59, 0x52, 0x37, 0x92, 0x49, 0x1d, 0x76, 0xe5, 0x23,
+	0x2a, 0x43, 0x5e, 0xcc, 0x35, 0x8f, 0x0d, 0xa7, 0x78, 0x9e, 0x6b, 0x3e, 0xde, 0xc6, 0x7c, 0x85,
+	0x52, 0x5b, 0xcd, 0xba, 0xf1, 0x57, 0x80, 0xae, 0xc3, 0x3c, 0x9f, 0x27, 0x35, 0xf9, 0xd2, 0x0b,
+	0x9c, 0x55, 0xe9, 0x71, 0x69, 0x9e, 0xd1, 0xed, 0x37, 0x6f, 0xbf, 0xd7, 0x98, 0xd5, 0x46, 0x85,
+	0xe6, 0x20, 0xda, 0x8e, 0xaa, 0xc4, 0x77, 0x49, 0x48, 0x82, 0x86, 0x67, 0x16, 0x79, 0x01, 0xce,
+	0x37, 0x31, 0x4d, 0x3f, 0x9c, 0x32, 0xad, 0x80, 0xc7, 0x84, 0x59, 0x77, 0x75, 0xc7, 0x32, 0x34,
+	0xdd, 0xf3, 0xd8, 0xb0, 0xae, 0x79, 0xb6, 0x6e, 0x10, 0xde, 0xa2, 0x5e, 0xe3, 0x81, 0x32, 0x15,
+	0x13, 0xae, 0x09, 0xba, 0x8a, 0x20, 0xbb, 0x92, 0x50, 0xa1, 0x55, 0x78, 0xa2, 0x31, 0x09, 0x39,
+	0xba, 0xab, 0xd7, 0x88, 0xa9, 0x25, 0xa3, 0xa0, 0x65, 0xe2, 0xb2, 0x14, 0x94, 0x38, 0x21, 0xdc,
+	0x10, 0x74, 0xc9, 0xbd, 0x4d, 0xf4, 0x7d, 0x78, 0xb2, 0xa7, 0x10, 0xde, 0x24, 0xce, 0x4a, 0x62,
+	0x0a, 0xdd, 0xc5, 0xf0, 0xae, 0x71, 0x0c, 0x0e, 0x9a, 0x7e, 0x5d, 0xf3, 0x23, 0x17, 0xbf, 0xce,
+	0xd5, 0x4f, 0x9b, 0x7e, 0x5d, 0x8d, 0x5c, 0xf4, 0x3a, 0x4c, 0x85, 0x7a, 0x2d, 0xc0, 0x26, 0xb7,
+	0x7e, 0xb1, 0x87, 0xf5, 0xaf, 0xea, 0xb5, 0x38, 0xd6, 0x38, 0x75, 0xe1, 0x2d, 0x38, 0xd6, 0xee,
+	0x0b, 0x34, 0x26, 0x26, 0x45, 0xc0, 0x6d, 0xcb, 0x87, 0xc1, 0x09, 0x38, 0xb0, 0xa3, 0xdb, 0x11,
+	0xe1, 0xd3, 0x63, 0x56, 0x15, 0x87, 0xe5, 0xbe, 0xef, 0x82, 0xc2, 0x2a, 0x9c, 0xec, 0xea, 0xd0,
+	0x6f, 0x24, 0xe4, 0x0d, 0x98, 0x6d, 0xe8, 0xf5, 0x4d, 0x18, 0x97, 0x6f, 0xa5, 0xd8, 0x28, 0xfc,
+	0xd5, 0x23, 0x0c, 0x3e, 0xd8, 0xc3, 0xe0, 0xd6, 0x1e, 0x06, 0xbf, 0xde, 0xc3, 0xe0, 0x13, 0xd6,
+	0x23, 0xf6, 0x30, 0xb8, 0xcf, 0x42, 0x6a, 0x1f, 0xff, 0x0b, 0x24, 0x33, 0x9f, 0xc2, 0xba, 0xbc,
+	0xb2, 0xd1, 0xd6, 0xdf, 0x14, 0xe9, 0xf3, 0x7c, 0xc7, 0xe4, 0xa0, 0x54, 0x9a, 0x83, 0x90, 0xa2,
+	0x36, 0xda, 0xae, 0xc2, 0x47, 0x3e, 0xe5, 0x3c, 0x9f, 0xe2, 0x94, 0x46, 0x12, 0x29, 0x95, 0xb6,
+	0x51, 0x40, 0x59, 0x4d, 0x7a, 0x89, 0xf2, 0x6e, 0x52, 0xfa, 0x95, 0xcb, 0xbc, 0xf5, 0xc5, 0xd2,
+	0xe4, 0x32, 0xad, 0xac, 0xc9, 0xb5, 0x53, 0x59, 0x8b, 0x2b, 0xa4, 0xc2, 0xaa, 0x1c, 0xff, 0xa3,
+	0x28, 0x78, 0xca, 0x6a, 0x97, 0xd2, 0xa3, 0x48, 0xf3, 0xf8, 0x47, 0xfb, 0xf8, 0x73, 0x10, 0x87,
+	0xd7, 0xb9, 0x8b, 0xa4, 0x3e, 0xcf, 0x42, 0x47, 0x49, 0xa2, 0xea, 0x9c, 0x44, 0xd9, 0x8a, 0xa1,
+	0x7e, 0xad, 0x05, 0x79, 0x59, 0x5a, 0x92, 0x94, 0x2d, 0x62, 0x12, 0x9f, 0xdd, 0xa0, 0x9d, 0xea,
+	0x42, 0x82, 0x68, 0x21, 0x6f, 0x2e, 0x5c, 0xe7, 0x3a, 0x45, 0x71, 0x6b, 0x9f, 0x13, 0x46, 0x17,
+	0x2a, 0xb0, 0x79, 0xea, 0x5c, 0xbc, 0x09, 0x34, 0x21, 0x8c, 0x3b, 0x01, 0xca, 0x12, 0x3e, 0xdb,
+	0xc7, 0x83, 0x31, 0xfc, 0xce, 0x01, 0x4e, 0x1b, 0x51, 0x10, 0x52, 0xe7, 0xf6, 0x63, 0x0c, 0x7e,
+	0x98, 0xca, 0x8c, 0x8d, 0xe5, 0x4b, 0xef, 0xc2, 0xc2, 0xba, 0x69, 0x13, 0xb5, 0x31, 0x4d, 0x49,
+	0x39, 0x10, 0xa0, 0x97, 0x61, 0xd6, 0x32, 0x6d, 0xa2, 0xb1, 0xb6, 0xc9, 0x03, 0xad, 0x7f, 0x65,
+	0xe8, 0x3f, 0x5f, 0x4c, 0x67, 0xd6, 0x22, 0x9f, 0x4b, 0x57, 0x33, 0x0c, 0xcd, 0xfa, 0xec, 0xf2,
+	0xd0, 0xd7, 0xfb, 0x18, 0xdc, 0x39, 0xc0, 0x29, 0x97, 0xba, 0xa4, 0xf4, 0xdf, 0x14, 0x9c, 0x88,
+	0x25, 0x25, 0x2e, 0x7f, 0x37, 0xd0, 0x6b, 0x24, 0x59, 0xae, 0xc0, 0x53, 0x2e, 0x57, 0xf2, 0x3e,
+	0xd4, 0xf7, 0xb4, 0xfb, 0xd0, 0xdb, 0x6d, 0x1b, 0x59, 0xff, 0xa1, 0x1b, 0x59, 0xaa, 0x73, 0x0b,
+	0xab, 0xc0, 0xd1, 0x90, 0x86, 0xba, 0x2d, 0xb5, 0x88, 0x14, 0x2f, 0x16, 0xb8, 0xd7, 0xc0, 0xa5,
+	0x8e, 0x70, 0x86, 0x66, 0x8f, 0xf8, 0x01, 0x1c, 0x37, 0xbc, 0x48, 0xb4, 0x08, 0x49, 0xcc, 0xc0,
+	0x13, 0xc4, 0xe4, 0x0d, 0x2f, 0xe2, 0x0d, 0xa3, 0x45, 0x52, 0xad, 0x8b, 0xa4, 0xf4, 0x93, 0x24,
+	0xd5, 0x3a, 0x24, 0xcd, 0xc0, 0x91, 0x84, 0x5f, 0x0b, 0x0c, 0xea, 0x13, 0xbe, 0x0c, 0xa5, 0xd4,
+	0xe1, 0x04, 0xba, 0xc9, 0x80, 0xe8, 0x0d, 0x88, 0x3b, 0x55, 0x8f, 0x19, 0x32, 0x9c, 0x61, 0xb2,
+	0x5d, 0xcb, 0x06, 0x63, 0xad, 0x17, 0x63, 0x56, 0x30, 0xd6, 0xba, 0x32, 0x4e, 0x41, 0xd8, 0x2c,
+	0x18, 0x7c, 0xaf, 0xcc, 0xaa, 0x12, 0x64, 0x79, 0xf1, 0xa3, 0x7d, 0xac, 0x74, 0xc9, 0xd2, 0x9e,
+	0x79, 0x53, 0xfa, 0x19, 0x2c, 0xac, 0x76, 0xef, 0x30, 0xee, 0x16, 0xed, 0xd1, 0x22, 0x41, 0xaf,
+	0x16, 0xd9, 0xda, 0xef, 0xfb, 0x9e, 0xa6, 0xdf, 0x97, 0x7e, 0x37, 0x00, 0x47, 0xa5, 0x54, 0xe2,
+	0xbf, 0xfd, 0x96, 0x1e, 0x1c, 0x5e, 0x82, 0x59, 0x97, 0x86, 0xd6, 0x56, 0x9d, 0x35, 0xd5, 0x7e,
+	0x9e, 0x94, 0xd9, 0xe6, 0x40, 0x90, 0x11, 0xb8, 0x75, 0x13, 0xcd, 0x3d, 0xdd, 0x36, 0x9e, 0xec,
+	0xe1, 0x47, 0x5b, 0xf7, 0xf0, 0xc6, 0xe2, 0xfd, 0x06, 0x4c, 0x33, 0x82, 0x28, 0xe0, 0x2b, 0x77,
+	0xab, 0x82, 0x9b, 0x1c, 0xc1, 0x2e, 0x27, 0x3f, 0x2f, 0x08, 0xf2, 0xd6, 0x85, 0x65, 0xf0, 0x99,
+	0x16, 0x16, 0xf7, 0x90, 0x61, 0xc0, 0x72, 0xb7, 0x28, 0x8f, 0xc7, 0x5c, 0x79, 0xa6, 0x4b, 0x6a,
+	0x77, 0xba, 0xfd, 0xf0, 0x99, 0x81, 0x7b, 0xc8, 0xe8, 0x36, 0xa5, 0xcd, 0x71, 0x87, 0x2f, 0x76,
+	0x9f, 0x13, 0x18, 0x5b, 0x8f, 0x49, 0x2d, 0xde, 0x12, 0xdb, 0x07, 0xb4, 0x6f, 0x65, 0x12, 0x58,
+	0x5e, 0x6d, 0xef, 0xe7, 0xb7, 0xf7, 0x30, 0xf8, 0x74, 0x0f, 0x0f, 0xc9, 0xae, 0xfd, 0x72, 0xaf,
+	0x59, 0x92, 0xbf, 0x3a, 0xc0, 0xe0, 0xce, 0x63, 0x2c, 0xbf, 0x80, 0x95, 0xff, 0x9d, 0x85, 0x23,
+	0xd2, 0xb9, 0xe2, 0x59, 0xe8, 0xb7, 0x00, 0xe6, 0x45, 0x27, 0x6e, 0x79, 0x2a, 0xeb, 0x7e, 0xf9,
+	0x42, 0x5e, 0x82, 0xab, 0xfc, 0x2d, 0xb6, 0xf4, 0x93, 0xdd, 0x3d, 0x5c, 0x4e, 0xdc, 0x2a, 0x37,
+	0x13, 0xa5, 0x62, 0xb0, 0xbb, 0x0a, 0xab, 0x2b, 0xed, 0x19, 0xfb, 0xf1, 0x3e, 0x06, 0xf7, 0xf6,
+	0x31, 0xf8, 0xf9, 0x5f, 0xfe, 0xf6, 0xab, 0x3e, 0x5c, 0x1a, 0x5f, 0x10, 0xab, 0xe5, 0x82, 0xf4,
+	0x78, 0xbc, 0x0c, 0x5e, 0x59, 0x04, 0xe8, 0xf7, 0x00, 0xe6, 0x45, 0xf3, 0x7f, 0x46, 0x05, 0xab,
+	0xcf, 0xae, 0xe0, 0x67, 0xfb, 0x38, 0xbd, 0xc6, 0x87, 0xc8, 0x86, 0x9a, 0x62, 0x87, 0xeb, 0x54,
+	0xf3, 0x9f, 0x7d, 0x30, 0x2f, 0xe6, 0x98, 0x67, 0x54, 0xf3, 0x8f, 0x7d, 0xcf, 0xa5, 0xe7, 0x9f,
+	0x41, 0x32, 0xb3, 0x35, 0xde, 0x8b, 0x3a, 0x47, 0xae, 0x66, 0xd7, 0x57, 0x36, 0xdb, 0xde, 0x3f,
+	0x94, 0xe4, 0x55, 0x4c, 0x59, 0x6b, 0xbc, 0x48, 0x29, 0xeb, 0xc9, 0x93, 0x8c, 0x12, 0xef, 0xba,
+	0x81, 0xb2, 0xd1, 0xdc, 0x8b, 0xe5, 0xb9, 0x4a, 0x69, 0x59, 0xa0, 0x94, 0xa4, 0x42, 0x06, 0xcb,
+	0xa7, 0x45, 0xed, 0x6e, 0x01, 0xb4, 0x90, 0x5e, 0x6c, 0x2f, 0xbf, 0x4a, 0x9b, 0xc5, 0xc5, 0xc3,
+	0x40, 0xa7, 0xc5, 0x7f, 0x03, 0xe0, 0xe8, 0xe6, 0x75, 0x7a, 0xe3, 0x69, 0xec, 0xdd, 0x03, 0x5e,
+	0xba, 0xba, 0xbb, 0x87, 0x17, 0x0f, 0xb1, 0xf9, 0x35, 0x8b, 0xdc, 0xe8, 0xb0, 0x78, 0x23, 0x6c,
+	0x8f, 0x96, 0xf2, 0x0b, 0xc1, 0x75, 0x7a, 0xa3, 0x53, 0xb7, 0x3f, 0x01, 0x58, 0x14, 0x41, 0x7b,
+	0xc8, 0xb0, 0x25, 0x57, 0xb1, 0xde, 0x64, 0xdd, 0x62, 0x65, 0x73, 0x77, 0x0f, 0x97, 0x9e, 0x1c,
+	0x2a, 0x5c, 0xc9, 0x33, 0xa5, 0x52, 0x12, 0xb4, 0x6c, 0x76, 0x6b, 0x3e, 0xa3, 0x49, 0x4a, 0x07,
+	0xcb, 0xe0, 0x15, 0xf4, 0x39, 0x80, 0x58, 0xb2, 0x67, 0xeb, 0x18, 0xd7, 0xcb, 0xb0, 0xd3, 0x9d,
+	0xf0, 0x16, 0xc6, 0xd2, 0x2f, 0xc0, 0xb3, 0x98, 0x78, 0x77, 0x1f, 0x1f, 0xef, 0x98, 0x8d, 0x93,
+	0x41, 0xe0, 0x83, 0x03, 0x0c, 0xee, 0x3f, 0x8e, 0x7d, 0x30, 0x53, 0x2a, 0x76, 0xf8, 0x60, 0x21,
+	0x69, 0x28, 0x0b, 0x11, 0xd3, 0x81, 0xbb, 0xa4, 0xfc, 0x4b, 0x00, 0x51, 0x5b, 0x1d, 0x67, 0xf5,
+	0xef, 0x7d, 0x38, 0xde, 0x16, 0x44, 0xbc, 0x31, 0x14, 0x7a, 0x57, 0xff, 0xc2, 0x21, 0xb8, 0x52,
+	0x91, 0x2b, 0x55, 0x28, 0x4d, 0x76, 0x28, 0xc5, 0xda, 0x17, 0xd7, 0x64, 0xe5, 0x85, 0xbb, 0x7f,
+	0x9d, 0x3a, 0x72, 0xf7, 0xc1, 0x14, 0xb8, 0xf7, 0x60, 0x0a, 0x7c, 0xf9, 0x60, 0x0a, 0xdc, 0x7a,
+	0x38, 0x75, 0xe4, 0xde, 0xc3, 0xa9, 0x23, 0xf7, 0x1f, 0x4e, 0x1d, 0xa9, 0xa6, 0xb9, 0xe0, 0xb3,
+	0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x43, 0x5d, 0x74, 0xeb, 0x47, 0x1b, 0x00, 0x00,
 }
 
 func (this *ClusterInstKeyV1) GoString() string {
@@ -1315,6 +1318,18 @@ func (m *ClusterInst) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xa2
 		}
 	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa0
+	}
 	if len(m.CloudletManagedClusterName) > 0 {
 		i -= len(m.CloudletManagedClusterName)
 		copy(dAtA[i:], m.CloudletManagedClusterName)
@@ -2678,6 +2693,11 @@ func (m *ClusterInst) Matches(o *ClusterInst, fopts ...MatchOpt) bool {
 			}
 		}
 	}
+	if !opts.Filter || o.DryRun != false {
+		if o.DryRun != m.DryRun {
+			return false
+		}
+	}
 	if !opts.Filter || o.Tags != nil {
 		if len(m.Tags) == 0 && len(o.Tags) > 0 || len(m.Tags) > 0 && len(o.Tags) == 0 {
 			return false
@@ -2811,6 +2831,7 @@ const ClusterInstFieldKubernetesVersion = "48"
 const ClusterInstFieldDisableDynamicAppinstPlacement = "49"
 const ClusterInstFieldCloudletManagedClusterId = "50"
 const ClusterInstFieldCloudletManagedClusterName = "51"
+const ClusterInstFieldDryRun = "52"
 const ClusterInstFieldTags = "100"
 const ClusterInstFieldTagsKey = "100.1"
 const ClusterInstFieldTagsValue = "100.2"
@@ -2908,6 +2929,7 @@ var ClusterInstAllFields = []string{
 	ClusterInstFieldDisableDynamicAppinstPlacement,
 	ClusterInstFieldCloudletManagedClusterId,
 	ClusterInstFieldCloudletManagedClusterName,
+	ClusterInstFieldDryRun,
 	ClusterInstFieldTagsKey,
 	ClusterInstFieldTagsValue,
 }
@@ -3005,6 +3027,7 @@ var ClusterInstAllFieldsMap = NewFieldMap(map[string]struct{}{
 	ClusterInstFieldDisableDynamicAppinstPlacement:           struct{}{},
 	ClusterInstFieldCloudletManagedClusterId:                 struct{}{},
 	ClusterInstFieldCloudletManagedClusterName:               struct{}{},
+	ClusterInstFieldDryRun:                                   struct{}{},
 	ClusterInstFieldTagsKey:                                  struct{}{},
 	ClusterInstFieldTagsValue:                                struct{}{},
 })
@@ -3102,6 +3125,7 @@ var ClusterInstAllFieldsStringMap = map[string]string{
 	ClusterInstFieldDisableDynamicAppinstPlacement:           "Disable Dynamic Appinst Placement",
 	ClusterInstFieldCloudletManagedClusterId:                 "Cloudlet Managed Cluster Id",
 	ClusterInstFieldCloudletManagedClusterName:               "Cloudlet Managed Cluster Name",
+	ClusterInstFieldDryRun:                                   "Dry Run",
 	ClusterInstFieldTagsKey:                                  "Tags Key",
 	ClusterInstFieldTagsValue:                                "Tags Value",
 }
@@ -3626,6 +3650,9 @@ func (m *ClusterInst) DiffFields(o *ClusterInst, fields *FieldMap) {
 	if m.CloudletManagedClusterName != o.CloudletManagedClusterName {
 		fields.Set(ClusterInstFieldCloudletManagedClusterName)
 	}
+	if m.DryRun != o.DryRun {
+		fields.Set(ClusterInstFieldDryRun)
+	}
 	if m.Tags != nil && o.Tags != nil {
 		if len(m.Tags) != len(o.Tags) {
 			fields.Set(ClusterInstFieldTags)
@@ -4398,6 +4425,12 @@ func (m *ClusterInst) CopyInFields(src *ClusterInst) int {
 			changed++
 		}
 	}
+	if fmap.Has("52") {
+		if m.DryRun != src.DryRun {
+			m.DryRun = src.DryRun
+			changed++
+		}
+	}
 	if fmap.HasOrHasChild("100") {
 		if src.Tags != nil {
 			if updateListAction == "add" {
@@ -4518,6 +4551,7 @@ func (m *ClusterInst) DeepCopyIn(src *ClusterInst) {
 	m.DisableDynamicAppinstPlacement = src.DisableDynamicAppinstPlacement
 	m.CloudletManagedClusterId = src.CloudletManagedClusterId
 	m.CloudletManagedClusterName = src.CloudletManagedClusterName
+	m.DryRun = src.DryRun
 	if src.Tags != nil {
 		m.Tags = make(map[string]string)
 		for k, v := range src.Tags {
@@ -7837,6 +7871,9 @@ func (m *ClusterInst) IsValidArgsForDeleteClusterInst() error {
 	if m.CloudletKey.FederatedOrganization != "" {
 		return fmt.Errorf("Invalid field specified: CloudletKey.FederatedOrganization, this field is only for internal use")
 	}
+	if m.DryRun != false {
+		return fmt.Errorf("Invalid field specified: DryRun, this field is only for internal use")
+	}
 	return nil
 }
 
@@ -7954,6 +7991,9 @@ func (m *ClusterInst) IsValidArgsForUpdateClusterInst() error {
 	if m.KubernetesVersion != "" {
 		return fmt.Errorf("Invalid field specified: KubernetesVersion, this field is only for internal use")
 	}
+	if m.DryRun != false {
+		return fmt.Errorf("Invalid field specified: DryRun, this field is only for internal use")
+	}
 	return nil
 }
 
@@ -8254,6 +8294,9 @@ func (m *ClusterInst) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovClusterinst(uint64(l))
 	}
+	if m.DryRun {
+		n += 3
+	}
 	if len(m.Tags) > 0 {
 		for k, v := range m.Tags {
 			_ = k
@@ -10212,6 +10255,26 @@ func (m *ClusterInst) Unmarshal(dAtA []byte) error {
 			}
 			m.CloudletManagedClusterName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 52:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterinst
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
 		case 100:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
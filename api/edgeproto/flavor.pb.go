@@ -133,59 +133,106 @@ func (m *Flavor) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Flavor proto.InternalMessageInfo
 
+// FlavorHistory is a Flavor as of a past revision
+type FlavorHistory struct {
+	// Database revision of the change
+	Revision int64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Flavor as of the revision
+	Obj Flavor `protobuf:"bytes,2,opt,name=obj,proto3" json:"obj"`
+}
+
+func (m *FlavorHistory) Reset()         { *m = FlavorHistory{} }
+func (m *FlavorHistory) String() string { return proto.CompactTextString(m) }
+func (*FlavorHistory) ProtoMessage()    {}
+func (*FlavorHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_827a674ddbd1f38f, []int{2}
+}
+func (m *FlavorHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlavorHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlavorHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlavorHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlavorHistory.Merge(m, src)
+}
+func (m *FlavorHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlavorHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlavorHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlavorHistory proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*FlavorKey)(nil), "edgeproto.FlavorKey")
 	proto.RegisterType((*Flavor)(nil), "edgeproto.Flavor")
 	proto.RegisterMapType((map[string]string)(nil), "edgeproto.Flavor.OptResMapEntry")
+	proto.RegisterType((*FlavorHistory)(nil), "edgeproto.FlavorHistory")
 }
 
 func init() { proto.RegisterFile("flavor.proto", fileDescriptor_827a674ddbd1f38f) }
 
 var fileDescriptor_827a674ddbd1f38f = []byte{
-	// 676 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0x41, 0x4f, 0x13, 0x41,
-	0x14, 0xc7, 0x3b, 0x74, 0xa9, 0x74, 0x28, 0x45, 0x56, 0x42, 0x26, 0x0d, 0x2c, 0x4d, 0x0f, 0xa6,
-	0xc1, 0xa6, 0x6b, 0xf0, 0xa2, 0x8d, 0x18, 0x5b, 0x51, 0x0f, 0x88, 0x92, 0x35, 0x70, 0x25, 0x63,
-	0xf7, 0xb1, 0x6c, 0xda, 0xee, 0x6c, 0x66, 0xb6, 0x6d, 0x7a, 0x33, 0x7c, 0x02, 0xa2, 0x17, 0xe3,
-	0xc9, 0xc4, 0x8b, 0x47, 0xe3, 0xd1, 0x4f, 0xc0, 0x91, 0xc4, 0x83, 0x9e, 0x8c, 0x16, 0x0f, 0x86,
-	0x93, 0x09, 0x85, 0x78, 0x34, 0x3b, 0xb3, 0xd4, 0x12, 0x4c, 0x84, 0x44, 0x6f, 0xef, 0xbd, 0x79,
-	0x33, 0xbf, 0xf7, 0xff, 0xbf, 0xc1, 0xa9, 0x8d, 0x3a, 0x6d, 0x31, 0x5e, 0xf4, 0x39, 0x0b, 0x98,
-	0x9e, 0x04, 0xdb, 0x01, 0x19, 0x66, 0xa6, 0x1d, 0xc6, 0x9c, 0x3a, 0x98, 0xd4, 0x77, 0x4d, 0xea,
-	0x79, 0x2c, 0xa0, 0x81, 0xcb, 0x3c, 0xa1, 0x1a, 0x33, 0x33, 0x01, 0x63, 0x75, 0x61, 0xca, 0xc4,
-	0x01, 0xaf, 0x1f, 0x44, 0xc7, 0x29, 0x0e, 0xa2, 0x59, 0x0f, 0xa2, 0x6c, 0xd2, 0x61, 0x0e, 0x93,
-	0xa1, 0x19, 0x46, 0x51, 0x75, 0x9c, 0x83, 0x60, 0x4d, 0x5e, 0x85, 0xe8, 0xcd, 0xdc, 0x0d, 0x9c,
-	0xbc, 0x27, 0x87, 0x59, 0x82, 0x8e, 0x6e, 0x60, 0xcd, 0xa3, 0x0d, 0x20, 0x28, 0x8b, 0xf2, 0xc9,
-	0x0a, 0x7e, 0x7f, 0x44, 0x12, 0x6a, 0x52, 0x4b, 0xd6, 0x4b, 0xa9, 0xef, 0x07, 0x04, 0xfd, 0x3c,
-	0x20, 0xe8, 0xed, 0xab, 0x59, 0x94, 0x7b, 0x1d, 0xc7, 0x09, 0x75, 0x57, 0x9f, 0xc2, 0x89, 0x0d,
-	0x17, 0xea, 0xb6, 0x20, 0x28, 0x1b, 0xcf, 0x27, 0xad, 0x28, 0xd3, 0x0b, 0x38, 0x5e, 0x83, 0x0e,
-	0x19, 0xca, 0xa2, 0xfc, 0xe8, 0xfc, 0x64, 0xb1, 0x2f, 0xb4, 0xd8, 0x67, 0x56, 0xb4, 0x9d, 0xcf,
-	0xb3, 0x31, 0x2b, 0x6c, 0xd3, 0x2f, 0xe2, 0x38, 0xa7, 0x0d, 0x12, 0xcf, 0xa2, 0xbc, 0x66, 0x85,
-	0xa1, 0x3e, 0x89, 0x87, 0x5b, 0x55, 0xbf, 0x29, 0x88, 0x26, 0x6b, 0x2a, 0xd1, 0x75, 0xac, 0xd9,
-	0xae, 0xa8, 0x91, 0x61, 0x59, 0x94, 0xb1, 0x3e, 0x87, 0x35, 0x27, 0x6c, 0x1c, 0xc9, 0xc6, 0xf3,
-	0xa3, 0xf3, 0x53, 0x03, 0xa8, 0xfb, 0x2b, 0xab, 0x56, 0x24, 0xda, 0x92, 0x3d, 0xfa, 0x6d, 0x3c,
-	0xca, 0xfc, 0x60, 0x9d, 0x83, 0x58, 0x6f, 0x50, 0x9f, 0x24, 0xe4, 0x95, 0xec, 0xa9, 0xe9, 0x8a,
-	0x8f, 0xfc, 0xc0, 0x02, 0xb1, 0x4c, 0xfd, 0xbb, 0x5e, 0xc0, 0x3b, 0x56, 0x92, 0x1d, 0xe7, 0xfa,
-	0x15, 0x9c, 0xb6, 0xa1, 0x0e, 0x01, 0xac, 0xfb, 0x1c, 0x7c, 0xca, 0x81, 0x5c, 0xc8, 0xa2, 0xfc,
-	0x48, 0x45, 0x7b, 0xd3, 0x23, 0xc8, 0x1a, 0x53, 0x67, 0x2b, 0xea, 0x28, 0x73, 0x13, 0xa7, 0x4f,
-	0xbe, 0x14, 0x0a, 0x0d, 0x6d, 0x91, 0x36, 0x2b, 0xe9, 0xa1, 0x50, 0x5a, 0x6f, 0x82, 0xb4, 0x2a,
-	0x69, 0xa9, 0xa4, 0x34, 0x74, 0x1d, 0x95, 0x6e, 0x85, 0x9e, 0xff, 0x38, 0x20, 0xe8, 0x69, 0x8f,
-	0xa0, 0xed, 0x1e, 0x41, 0x2f, 0x7a, 0x04, 0x3d, 0x3b, 0x24, 0x63, 0x8b, 0x83, 0x90, 0x97, 0x87,
-	0x64, 0x2c, 0x5c, 0xd1, 0xc2, 0x12, 0x74, 0x8a, 0x0f, 0x69, 0x03, 0xde, 0x1d, 0x11, 0xcd, 0x63,
-	0x1e, 0xcc, 0x7f, 0x1c, 0x3e, 0xde, 0x70, 0xd9, 0x77, 0xf5, 0x2d, 0x84, 0x53, 0x77, 0x38, 0xd0,
-	0x00, 0xa2, 0xcd, 0x4d, 0x9c, 0x92, 0x9d, 0x19, 0x2c, 0x59, 0xf2, 0x4b, 0xe5, 0x1e, 0xec, 0xf7,
-	0xc8, 0xcc, 0xb1, 0x8b, 0xaa, 0x4d, 0x14, 0xca, 0xd5, 0xf0, 0x73, 0x2e, 0x53, 0x8f, 0x3a, 0x50,
-	0xe8, 0x1e, 0x92, 0xb4, 0x45, 0x1b, 0x85, 0xb5, 0x70, 0x43, 0x85, 0x45, 0x57, 0xd4, 0xb6, 0x3e,
-	0x7c, 0x7b, 0x3e, 0x74, 0x29, 0x97, 0x36, 0xab, 0x92, 0x67, 0xaa, 0x9f, 0x54, 0x42, 0x73, 0xba,
-	0x8f, 0x53, 0x6a, 0xf8, 0x73, 0xcd, 0x50, 0xfa, 0xeb, 0x0c, 0x7d, 0xa2, 0xf2, 0xff, 0x24, 0x71,
-	0xd5, 0xb7, 0xe9, 0xff, 0x23, 0x36, 0xe5, 0xeb, 0x03, 0xc4, 0x36, 0xc6, 0x8f, 0x37, 0x59, 0xfb,
-	0x6c, 0x3c, 0x55, 0xca, 0x95, 0xf7, 0x7b, 0xe4, 0xf2, 0x9f, 0x79, 0x6b, 0x2e, 0xb4, 0x0b, 0xa2,
-	0xe6, 0xfa, 0xe0, 0x6d, 0x30, 0x5e, 0x05, 0x09, 0x9e, 0xc8, 0xa5, 0x4c, 0xb1, 0xc9, 0xda, 0xbf,
-	0xb1, 0x57, 0x51, 0x28, 0xb5, 0x6c, 0xdb, 0xea, 0xb6, 0x05, 0xe2, 0xdf, 0x4b, 0xa5, 0xb6, 0xcd,
-	0x41, 0x0c, 0x48, 0x6d, 0xe1, 0x71, 0x0b, 0x1a, 0xac, 0x05, 0xe7, 0x85, 0x2e, 0x9c, 0x0d, 0x3a,
-	0x95, 0x9b, 0x30, 0xb9, 0x04, 0x9c, 0xe0, 0x56, 0xa6, 0x77, 0xbe, 0x1a, 0xb1, 0x9d, 0xae, 0x81,
-	0x76, 0xbb, 0x06, 0xfa, 0xd2, 0x35, 0xd0, 0xf6, 0x9e, 0x11, 0xdb, 0xdd, 0x33, 0x62, 0x9f, 0xf6,
-	0x8c, 0xd8, 0x93, 0x84, 0x44, 0x5d, 0xfb, 0x15, 0x00, 0x00, 0xff, 0xff, 0x42, 0xfc, 0x6d, 0x4c,
-	0x6c, 0x05, 0x00, 0x00,
+	// 757 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x3d, 0xd9, 0xad, 0x89, 0x27, 0xb6, 0x5b, 0x4f, 0xa3, 0x68, 0x64, 0xb5, 0x5b, 0x6b,
+	0x0f, 0xc8, 0x14, 0xcb, 0x8b, 0xc2, 0x05, 0x2c, 0x8a, 0xb0, 0x29, 0x3f, 0xa4, 0xb6, 0x50, 0x2d,
+	0x6a, 0xae, 0xd1, 0xc4, 0xfb, 0xb2, 0xd9, 0xda, 0xde, 0x59, 0xcd, 0xac, 0x6d, 0xf9, 0x86, 0xf2,
+	0x17, 0x44, 0x70, 0x41, 0x9c, 0x90, 0xb8, 0x70, 0x44, 0x1c, 0xf9, 0x0b, 0x7c, 0x8c, 0xc4, 0x85,
+	0x13, 0x02, 0x87, 0x03, 0xca, 0x09, 0x29, 0x76, 0xc4, 0x11, 0xed, 0xcc, 0xda, 0x71, 0xe4, 0x48,
+	0x24, 0x12, 0xbd, 0xbd, 0xf7, 0xe6, 0xcd, 0xfb, 0x7c, 0xdf, 0x0f, 0x9c, 0xdf, 0xef, 0xb2, 0x01,
+	0x17, 0xf5, 0x48, 0xf0, 0x98, 0x93, 0x1c, 0x78, 0x3e, 0x28, 0xb3, 0x7c, 0xcf, 0xe7, 0xdc, 0xef,
+	0x82, 0xc3, 0xa2, 0xc0, 0x61, 0x61, 0xc8, 0x63, 0x16, 0x07, 0x3c, 0x94, 0x3a, 0xb1, 0x7c, 0x3f,
+	0xe6, 0xbc, 0x2b, 0x1d, 0xe5, 0xf8, 0x10, 0x2e, 0x8c, 0xf4, 0x39, 0x2f, 0x40, 0xf6, 0xbb, 0x71,
+	0xea, 0x6d, 0xfa, 0xdc, 0xe7, 0xca, 0x74, 0x12, 0x2b, 0x8d, 0xde, 0x16, 0x20, 0x79, 0x5f, 0xb4,
+	0x21, 0xad, 0x69, 0xbf, 0x8b, 0x73, 0x1f, 0x2b, 0x31, 0x4f, 0x60, 0x44, 0x2c, 0x6c, 0x86, 0xac,
+	0x07, 0x14, 0x55, 0x50, 0x35, 0xd7, 0xc2, 0x3f, 0x9f, 0xd3, 0xac, 0x56, 0xea, 0xaa, 0x78, 0x23,
+	0xff, 0xd7, 0x19, 0x45, 0xff, 0x9c, 0x51, 0xf4, 0xe3, 0x77, 0x0f, 0x90, 0xfd, 0xbd, 0x81, 0xb3,
+	0xfa, 0x2f, 0xd9, 0xc2, 0xd9, 0xfd, 0x00, 0xba, 0x9e, 0xa4, 0xa8, 0x62, 0x54, 0x73, 0x6e, 0xea,
+	0x91, 0x1a, 0x36, 0x3a, 0x30, 0xa2, 0x6b, 0x15, 0x54, 0xdd, 0xd8, 0xde, 0xac, 0x2f, 0x1a, 0xad,
+	0x2f, 0x98, 0x2d, 0x73, 0xfc, 0xdb, 0x83, 0x8c, 0x9b, 0xa4, 0x91, 0x3b, 0xd8, 0x10, 0xac, 0x47,
+	0x8d, 0x0a, 0xaa, 0x9a, 0x6e, 0x62, 0x92, 0x4d, 0x7c, 0x6b, 0xd0, 0x8e, 0xfa, 0x92, 0x9a, 0x2a,
+	0xa6, 0x1d, 0x42, 0xb0, 0xe9, 0x05, 0xb2, 0x43, 0x6f, 0xa9, 0xa0, 0xb2, 0xc9, 0x43, 0x6c, 0xfa,
+	0x49, 0xe2, 0x7a, 0xc5, 0xa8, 0x6e, 0x6c, 0x6f, 0x2d, 0xa1, 0x3e, 0x79, 0xfe, 0xc2, 0x4d, 0x9b,
+	0x76, 0x55, 0x0e, 0xf9, 0x00, 0x6f, 0xf0, 0x28, 0xde, 0x15, 0x20, 0x77, 0x7b, 0x2c, 0xa2, 0x59,
+	0xf5, 0xa5, 0xb2, 0xa2, 0xae, 0xfe, 0x79, 0x14, 0xbb, 0x20, 0x9f, 0xb1, 0xe8, 0xa3, 0x30, 0x16,
+	0x23, 0x37, 0xc7, 0xe7, 0x3e, 0x79, 0x13, 0x17, 0x3d, 0xe8, 0x42, 0x0c, 0xbb, 0x91, 0x80, 0x88,
+	0x09, 0xa0, 0xaf, 0x55, 0x50, 0x75, 0xbd, 0x65, 0xfe, 0x30, 0xa5, 0xc8, 0x2d, 0xe8, 0xb7, 0xe7,
+	0xfa, 0xa9, 0xfc, 0x1e, 0x2e, 0x5e, 0xae, 0x44, 0xee, 0xe8, 0xb1, 0xa8, 0x31, 0xeb, 0xd6, 0x93,
+	0x46, 0x59, 0xb7, 0x0f, 0x6a, 0x54, 0x39, 0x57, 0x3b, 0x8d, 0xb5, 0x77, 0x50, 0xe3, 0xfd, 0x64,
+	0xe6, 0x7f, 0x9f, 0x51, 0xf4, 0xe5, 0x94, 0xa2, 0xa3, 0x29, 0x45, 0xdf, 0x4c, 0x29, 0xfa, 0x6a,
+	0x46, 0x0b, 0x8f, 0x97, 0x21, 0xdf, 0xce, 0x68, 0x21, 0x59, 0xd1, 0xa3, 0x27, 0x30, 0xaa, 0x7f,
+	0xc6, 0x7a, 0xf0, 0xd3, 0x39, 0x35, 0x43, 0x1e, 0x82, 0xbd, 0x83, 0x0b, 0xba, 0x9d, 0x4f, 0x03,
+	0x19, 0x73, 0x31, 0x22, 0x65, 0xbc, 0x2e, 0x60, 0x10, 0xc8, 0x80, 0x87, 0x4a, 0x81, 0xe1, 0x2e,
+	0x7c, 0xf2, 0x06, 0x36, 0xf8, 0xde, 0xcb, 0x74, 0x5f, 0xa5, 0x95, 0x89, 0xcc, 0x97, 0xc5, 0xf7,
+	0x5e, 0x6e, 0x9f, 0x66, 0xe7, 0x97, 0xd3, 0x8c, 0x02, 0x72, 0x88, 0x70, 0xfe, 0x43, 0x01, 0x2c,
+	0x86, 0xf4, 0x22, 0x56, 0x3f, 0x97, 0x97, 0x43, 0xae, 0x3a, 0x55, 0xfb, 0xe9, 0xe9, 0x94, 0xde,
+	0x9f, 0x6f, 0x47, 0xa7, 0xc9, 0x5a, 0xb3, 0x9d, 0x1c, 0xfd, 0x33, 0x16, 0x32, 0x1f, 0x6a, 0x93,
+	0x19, 0x2d, 0xba, 0xac, 0x57, 0xdb, 0x49, 0x36, 0x5f, 0x7b, 0x1c, 0xc8, 0xce, 0xe1, 0x2f, 0x7f,
+	0x7e, 0xbd, 0x76, 0xd7, 0x2e, 0x3a, 0x6d, 0xc5, 0x73, 0xf4, 0x85, 0x36, 0xd0, 0x43, 0x12, 0xe1,
+	0xbc, 0x1e, 0xca, 0x8d, 0x34, 0x34, 0xfe, 0x53, 0xc3, 0x82, 0xa8, 0xf7, 0x7a, 0x99, 0xf8, 0x22,
+	0xf2, 0xd8, 0xab, 0x23, 0xf6, 0x55, 0xf5, 0x25, 0xe2, 0x10, 0xe3, 0x2f, 0x0e, 0xf8, 0xf0, 0x7a,
+	0x3c, 0x1d, 0xb2, 0x9b, 0xa7, 0x53, 0xfa, 0xfa, 0xd5, 0xbc, 0x9d, 0x00, 0x86, 0x35, 0xd9, 0x09,
+	0x22, 0x08, 0xf7, 0xb9, 0x68, 0x83, 0x02, 0x97, 0xec, 0xbc, 0x23, 0x0f, 0xf8, 0xf0, 0x02, 0xfb,
+	0x16, 0x22, 0x47, 0x08, 0x97, 0x2e, 0xc8, 0xf3, 0x63, 0xba, 0x42, 0x00, 0x5d, 0x09, 0xa5, 0xc9,
+	0xf6, 0xd3, 0xeb, 0xeb, 0x18, 0xcf, 0x28, 0x52, 0x5a, 0xa8, 0x7d, 0x77, 0x59, 0xcb, 0x81, 0x2e,
+	0xa5, 0x25, 0x45, 0x38, 0xdf, 0xf4, 0x3c, 0x5d, 0xc8, 0x05, 0xf9, 0xff, 0x4f, 0x9f, 0x79, 0x9e,
+	0x00, 0xb9, 0x34, 0xfd, 0x01, 0xbe, 0xed, 0x42, 0x8f, 0x0f, 0xe0, 0xa6, 0xd0, 0x47, 0xd7, 0x83,
+	0x6e, 0xd9, 0x25, 0x47, 0x28, 0xc0, 0x25, 0x6e, 0xeb, 0xde, 0xf8, 0x0f, 0x2b, 0x33, 0x9e, 0x58,
+	0xe8, 0x78, 0x62, 0xa1, 0xdf, 0x27, 0x16, 0x3a, 0x3a, 0xb1, 0x32, 0xc7, 0x27, 0x56, 0xe6, 0xd7,
+	0x13, 0x2b, 0xb3, 0x97, 0x55, 0xa8, 0xb7, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x73, 0x34, 0x27,
+	0xe4, 0x57, 0x06, 0x00, 0x00,
 }
 
 func (this *FlavorKey) GoString() string {
@@ -227,6 +274,8 @@ type FlavorApiClient interface {
 	UpdateFlavor(ctx context.Context, in *Flavor, opts ...grpc.CallOption) (*Result, error)
 	// Show Flavors
 	ShowFlavor(ctx context.Context, in *Flavor, opts ...grpc.CallOption) (FlavorApi_ShowFlavorClient, error)
+	// Show the past revisions of a Flavor, newest first
+	ShowFlavorHistory(ctx context.Context, in *Flavor, opts ...grpc.CallOption) (FlavorApi_ShowFlavorHistoryClient, error)
 	// Add Optional Resource
 	AddFlavorRes(ctx context.Context, in *Flavor, opts ...grpc.CallOption) (*Result, error)
 	// Remove Optional Resource
@@ -300,6 +349,38 @@ func (x *flavorApiShowFlavorClient) Recv() (*Flavor, error) {
 	return m, nil
 }
 
+func (c *flavorApiClient) ShowFlavorHistory(ctx context.Context, in *Flavor, opts ...grpc.CallOption) (FlavorApi_ShowFlavorHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_FlavorApi_serviceDesc.Streams[1], "/edgeproto.FlavorApi/ShowFlavorHistory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &flavorApiShowFlavorHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FlavorApi_ShowFlavorHistoryClient interface {
+	Recv() (*FlavorHistory, error)
+	grpc.ClientStream
+}
+
+type flavorApiShowFlavorHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *flavorApiShowFlavorHistoryClient) Recv() (*FlavorHistory, error) {
+	m := new(FlavorHistory)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *flavorApiClient) AddFlavorRes(ctx context.Context, in *Flavor, opts ...grpc.CallOption) (*Result, error) {
 	out := new(Result)
 	err := c.cc.Invoke(ctx, "/edgeproto.FlavorApi/AddFlavorRes", in, out, opts...)
@@ -328,6 +409,8 @@ type FlavorApiServer interface {
 	UpdateFlavor(context.Context, *Flavor) (*Result, error)
 	// Show Flavors
 	ShowFlavor(*Flavor, FlavorApi_ShowFlavorServer) error
+	// Show the past revisions of a Flavor, newest first
+	ShowFlavorHistory(*Flavor, FlavorApi_ShowFlavorHistoryServer) error
 	// Add Optional Resource
 	AddFlavorRes(context.Context, *Flavor) (*Result, error)
 	// Remove Optional Resource
@@ -350,6 +433,9 @@ func (*UnimplementedFlavorApiServer) UpdateFlavor(ctx context.Context, req *Flav
 func (*UnimplementedFlavorApiServer) ShowFlavor(req *Flavor, srv FlavorApi_ShowFlavorServer) error {
 	return status.Errorf(codes.Unimplemented, "method ShowFlavor not implemented")
 }
+func (*UnimplementedFlavorApiServer) ShowFlavorHistory(req *Flavor, srv FlavorApi_ShowFlavorHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method ShowFlavorHistory not implemented")
+}
 func (*UnimplementedFlavorApiServer) AddFlavorRes(ctx context.Context, req *Flavor) (*Result, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddFlavorRes not implemented")
 }
@@ -436,6 +522,27 @@ func (x *flavorApiShowFlavorServer) Send(m *Flavor) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _FlavorApi_ShowFlavorHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Flavor)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FlavorApiServer).ShowFlavorHistory(m, &flavorApiShowFlavorHistoryServer{stream})
+}
+
+type FlavorApi_ShowFlavorHistoryServer interface {
+	Send(*FlavorHistory) error
+	grpc.ServerStream
+}
+
+type flavorApiShowFlavorHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *flavorApiShowFlavorHistoryServer) Send(m *FlavorHistory) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _FlavorApi_AddFlavorRes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Flavor)
 	if err := dec(in); err != nil {
@@ -503,6 +610,11 @@ var _FlavorApi_serviceDesc = grpc.ServiceDesc{
 			Handler:       _FlavorApi_ShowFlavor_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ShowFlavorHistory",
+			Handler:       _FlavorApi_ShowFlavorHistory_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "flavor.proto",
 }
@@ -637,6 +749,44 @@ func (m *Flavor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FlavorHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlavorHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlavorHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Obj.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFlavor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Revision != 0 {
+		i = encodeVarintFlavor(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFlavor(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFlavor(v)
 	base := offset
@@ -1814,6 +1964,130 @@ func (s *Flavor) ClearTagged(tags map[string]struct{}) {
 	}
 }
 
+func (m *FlavorHistory) Clone() *FlavorHistory {
+	cp := &FlavorHistory{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *FlavorHistory) AddObjGpus(vals ...*GPUResource) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Obj.Gpus {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Obj.Gpus = append(m.Obj.Gpus, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *FlavorHistory) RemoveObjGpus(vals ...*GPUResource) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Obj.Gpus); i >= 0; i-- {
+		if _, found := remove[m.Obj.Gpus[i].GetKey().GetKeyString()]; found {
+			m.Obj.Gpus = append(m.Obj.Gpus[:i], m.Obj.Gpus[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *FlavorHistory) CopyInFields(src *FlavorHistory) int {
+	updateListAction := "replace"
+	changed := 0
+	if m.Revision != src.Revision {
+		m.Revision = src.Revision
+		changed++
+	}
+	if m.Obj.Key.Name != src.Obj.Key.Name {
+		m.Obj.Key.Name = src.Obj.Key.Name
+		changed++
+	}
+	if m.Obj.Ram != src.Obj.Ram {
+		m.Obj.Ram = src.Obj.Ram
+		changed++
+	}
+	if m.Obj.Vcpus != src.Obj.Vcpus {
+		m.Obj.Vcpus = src.Obj.Vcpus
+		changed++
+	}
+	if m.Obj.Disk != src.Obj.Disk {
+		m.Obj.Disk = src.Obj.Disk
+		changed++
+	}
+	if src.Obj.OptResMap != nil {
+		if updateListAction == "add" {
+			for k1, v := range src.Obj.OptResMap {
+				m.Obj.OptResMap[k1] = v
+				changed++
+			}
+		} else if updateListAction == "remove" {
+			for k1, _ := range src.Obj.OptResMap {
+				if _, ok := m.Obj.OptResMap[k1]; ok {
+					delete(m.Obj.OptResMap, k1)
+					changed++
+				}
+			}
+		} else {
+			m.Obj.OptResMap = make(map[string]string)
+			for k1, v := range src.Obj.OptResMap {
+				m.Obj.OptResMap[k1] = v
+			}
+			changed++
+		}
+	} else if m.Obj.OptResMap != nil {
+		m.Obj.OptResMap = nil
+		changed++
+	}
+	if m.Obj.DeletePrepare != src.Obj.DeletePrepare {
+		m.Obj.DeletePrepare = src.Obj.DeletePrepare
+		changed++
+	}
+	if src.Obj.Gpus != nil {
+		if updateListAction == "add" {
+			changed += m.AddObjGpus(src.Obj.Gpus...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveObjGpus(src.Obj.Gpus...)
+		} else {
+			m.Obj.Gpus = make([]*GPUResource, 0)
+			for k1, _ := range src.Obj.Gpus {
+				m.Obj.Gpus = append(m.Obj.Gpus, src.Obj.Gpus[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Obj.Gpus != nil {
+		m.Obj.Gpus = nil
+		changed++
+	}
+	return changed
+}
+
+func (m *FlavorHistory) DeepCopyIn(src *FlavorHistory) {
+	m.Revision = src.Revision
+	m.Obj.DeepCopyIn(&src.Obj)
+}
+
+// Helper method to check that enums have valid values
+func (m *FlavorHistory) ValidateEnums() error {
+	if err := m.Obj.ValidateEnums(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *FlavorHistory) ClearTagged(tags map[string]struct{}) {
+	s.Obj.ClearTagged(tags)
+}
+
 func (m *Flavor) IsValidArgsForCreateFlavor() error {
 	if m.DeletePrepare != false {
 		return fmt.Errorf("Invalid field specified: DeletePrepare, this field is only for internal use")
@@ -1905,6 +2179,20 @@ func (m *Flavor) Size() (n int) {
 	return n
 }
 
+func (m *FlavorHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		n += 1 + sovFlavor(uint64(m.Revision))
+	}
+	l = m.Obj.Size()
+	n += 1 + l + sovFlavor(uint64(l))
+	return n
+}
+
 func sovFlavor(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2346,6 +2634,108 @@ func (m *Flavor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FlavorHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFlavor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlavorHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlavorHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlavor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Obj", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlavor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFlavor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFlavor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Obj.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFlavor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFlavor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipFlavor(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
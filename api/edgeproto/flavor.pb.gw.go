@@ -160,6 +160,31 @@ func request_FlavorApi_ShowFlavor_0(ctx context.Context, marshaler runtime.Marsh
 
 }
 
+func request_FlavorApi_ShowFlavorHistory_0(ctx context.Context, marshaler runtime.Marshaler, client FlavorApiClient, req *http.Request, pathParams map[string]string) (FlavorApi_ShowFlavorHistoryClient, runtime.ServerMetadata, error) {
+	var protoReq Flavor
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	stream, err := client.ShowFlavorHistory(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+
+}
+
 func request_FlavorApi_AddFlavorRes_0(ctx context.Context, marshaler runtime.Marshaler, client FlavorApiClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq Flavor
 	var metadata runtime.ServerMetadata
@@ -310,6 +335,13 @@ func RegisterFlavorApiHandlerServer(ctx context.Context, mux *runtime.ServeMux,
 		return
 	})
 
+	mux.Handle("POST", pattern_FlavorApi_ShowFlavorHistory_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+
 	mux.Handle("POST", pattern_FlavorApi_AddFlavorRes_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -477,6 +509,26 @@ func RegisterFlavorApiHandlerClient(ctx context.Context, mux *runtime.ServeMux,
 
 	})
 
+	mux.Handle("POST", pattern_FlavorApi_ShowFlavorHistory_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_FlavorApi_ShowFlavorHistory_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_FlavorApi_ShowFlavorHistory_0(ctx, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+
+	})
+
 	mux.Handle("POST", pattern_FlavorApi_AddFlavorRes_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -529,6 +581,8 @@ var (
 
 	pattern_FlavorApi_ShowFlavor_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"show", "flavor"}, "", runtime.AssumeColonVerbOpt(true)))
 
+	pattern_FlavorApi_ShowFlavorHistory_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"show", "flavorhistory"}, "", runtime.AssumeColonVerbOpt(true)))
+
 	pattern_FlavorApi_AddFlavorRes_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"addres", "flavor"}, "", runtime.AssumeColonVerbOpt(true)))
 
 	pattern_FlavorApi_RemoveFlavorRes_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"removeres", "flavor"}, "", runtime.AssumeColonVerbOpt(true)))
@@ -543,6 +597,8 @@ var (
 
 	forward_FlavorApi_ShowFlavor_0 = runtime.ForwardResponseStream
 
+	forward_FlavorApi_ShowFlavorHistory_0 = runtime.ForwardResponseStream
+
 	forward_FlavorApi_AddFlavorRes_0 = runtime.ForwardResponseMessage
 
 	forward_FlavorApi_RemoveFlavorRes_0 = runtime.ForwardResponseMessage
@@ -0,0 +1,2564 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: geofencepolicy.proto
+
+package edgeproto
+
+import (
+	context "context"
+	"encoding/json"
+	fmt "fmt"
+	distributed_match_engine "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/objstore"
+	"github.com/edgexr/edge-cloud-platform/pkg/util"
+	_ "github.com/edgexr/edge-cloud-platform/tools/protogen"
+	_ "github.com/gogo/googleapis/google/api"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"go.etcd.io/etcd/client/v3/concurrency"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GeoFence is an area defined by a polygon and/or a set of countries
+type GeoFence struct {
+	// Name of the geo-fence
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Vertices of a polygon enclosing the geo-fence, at least 3 are required
+	Polygon []distributed_match_engine.Loc `protobuf:"bytes,2,rep,name=polygon,proto3" json:"polygon"`
+	// ISO 3166-1 alpha-2 codes of countries enclosed by the geo-fence
+	CountryCodes []string `protobuf:"bytes,3,rep,name=country_codes,json=countryCodes,proto3" json:"country_codes,omitempty"`
+}
+
+func (m *GeoFence) Reset()         { *m = GeoFence{} }
+func (m *GeoFence) String() string { return proto.CompactTextString(m) }
+func (*GeoFence) ProtoMessage()    {}
+func (*GeoFence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7495638dfc313018, []int{0}
+}
+func (m *GeoFence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GeoFence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GeoFence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GeoFence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeoFence.Merge(m, src)
+}
+func (m *GeoFence) XXX_Size() int {
+	return m.Size()
+}
+func (m *GeoFence) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeoFence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeoFence proto.InternalMessageInfo
+
+// GeoFencePolicy restricts which cloudlets may serve devices by location.
+// A device within one or more of the geo-fences may only be served by
+// App Instances on cloudlets within the same geo-fences. Devices outside
+// of all geo-fences are not restricted.
+type GeoFencePolicy struct {
+	// Fields are used for the Update API to specify which fields to apply
+	Fields []string `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	// Unique identifier key
+	Key PolicyKey `protobuf:"bytes,2,opt,name=key,proto3" json:"key"`
+	// Geo-fences
+	Fences []GeoFence `protobuf:"bytes,3,rep,name=fences,proto3" json:"fences"`
+	// Preparing to be deleted
+	DeletePrepare bool `protobuf:"varint,4,opt,name=delete_prepare,json=deletePrepare,proto3" json:"delete_prepare,omitempty"`
+}
+
+func (m *GeoFencePolicy) Reset()         { *m = GeoFencePolicy{} }
+func (m *GeoFencePolicy) String() string { return proto.CompactTextString(m) }
+func (*GeoFencePolicy) ProtoMessage()    {}
+func (*GeoFencePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7495638dfc313018, []int{1}
+}
+func (m *GeoFencePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GeoFencePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GeoFencePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GeoFencePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeoFencePolicy.Merge(m, src)
+}
+func (m *GeoFencePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *GeoFencePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeoFencePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeoFencePolicy proto.InternalMessageInfo
+
+// GeoFencePolicyHistory is a Geo-Fence Policy as of a past revision
+type GeoFencePolicyHistory struct {
+	// Database revision of the change
+	Revision int64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// GeoFencePolicy as of the revision
+	Obj GeoFencePolicy `protobuf:"bytes,2,opt,name=obj,proto3" json:"obj"`
+}
+
+func (m *GeoFencePolicyHistory) Reset()         { *m = GeoFencePolicyHistory{} }
+func (m *GeoFencePolicyHistory) String() string { return proto.CompactTextString(m) }
+func (*GeoFencePolicyHistory) ProtoMessage()    {}
+func (*GeoFencePolicyHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7495638dfc313018, []int{2}
+}
+func (m *GeoFencePolicyHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GeoFencePolicyHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GeoFencePolicyHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GeoFencePolicyHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeoFencePolicyHistory.Merge(m, src)
+}
+func (m *GeoFencePolicyHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *GeoFencePolicyHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeoFencePolicyHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeoFencePolicyHistory proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*GeoFence)(nil), "edgeproto.GeoFence")
+	proto.RegisterType((*GeoFencePolicy)(nil), "edgeproto.GeoFencePolicy")
+	proto.RegisterType((*GeoFencePolicyHistory)(nil), "edgeproto.GeoFencePolicyHistory")
+}
+
+func init() { proto.RegisterFile("geofencepolicy.proto", fileDescriptor_7495638dfc313018) }
+
+var fileDescriptor_7495638dfc313018 = []byte{
+	// 708 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x93, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0x3b, 0x2d, 0x56, 0x18, 0x28, 0x81, 0xb5, 0x90, 0x69, 0x81, 0xda, 0xac, 0x31, 0x69,
+	0x4c, 0xd3, 0x95, 0xa2, 0x17, 0x12, 0x0e, 0xfc, 0x88, 0x9a, 0xe0, 0x0f, 0x52, 0x23, 0x07, 0x2f,
+	0xcd, 0x74, 0xf7, 0xb1, 0x5d, 0xd8, 0xce, 0x6c, 0x76, 0xb7, 0x90, 0xf5, 0x64, 0xf8, 0x0b, 0x88,
+	0x5e, 0x8c, 0x27, 0x13, 0x3d, 0x18, 0x8f, 0xc4, 0xab, 0xf7, 0x1e, 0x49, 0xbc, 0x78, 0x32, 0x0a,
+	0x1e, 0x0c, 0x27, 0x13, 0x0a, 0x5e, 0x4d, 0x67, 0xb7, 0x4d, 0x0b, 0x2d, 0x31, 0x78, 0xf0, 0xf6,
+	0x7e, 0x7c, 0xdf, 0xcc, 0x67, 0xde, 0x7b, 0x83, 0xe3, 0x3a, 0xf0, 0x35, 0x60, 0x2a, 0x58, 0xdc,
+	0x34, 0x54, 0x2f, 0x67, 0xd9, 0xdc, 0xe5, 0xd2, 0x00, 0x68, 0x3a, 0x08, 0x33, 0x39, 0xa9, 0x73,
+	0xae, 0x9b, 0xa0, 0x50, 0xcb, 0x50, 0x28, 0x63, 0xdc, 0xa5, 0xae, 0xc1, 0x99, 0xe3, 0x0b, 0x93,
+	0x43, 0x36, 0x38, 0x55, 0xd3, 0x0d, 0xbc, 0x29, 0x97, 0x73, 0xd3, 0x51, 0x84, 0xa3, 0x03, 0x6b,
+	0x19, 0x41, 0x3a, 0xae, 0x73, 0x9d, 0x0b, 0x53, 0x69, 0x58, 0x41, 0x74, 0x8c, 0x56, 0x5d, 0xee,
+	0xa8, 0xd4, 0xec, 0x40, 0x48, 0xc6, 0xb4, 0x0a, 0x28, 0x26, 0x57, 0x7d, 0x57, 0xde, 0x46, 0xb8,
+	0xff, 0x2e, 0xf0, 0x3b, 0x0d, 0x54, 0x49, 0xc2, 0x7d, 0x8c, 0x56, 0x80, 0xa0, 0x34, 0xca, 0x0c,
+	0x14, 0x84, 0x2d, 0xcd, 0xe1, 0xcb, 0x16, 0x37, 0x3d, 0x9d, 0x33, 0x12, 0x4e, 0x47, 0x32, 0x83,
+	0xf9, 0xa9, 0x9c, 0x66, 0x38, 0xae, 0x6d, 0x94, 0xaa, 0x2e, 0x68, 0xc5, 0x0a, 0x75, 0xd5, 0x72,
+	0x11, 0x98, 0x6e, 0x30, 0xc8, 0xdd, 0xe7, 0xea, 0x42, 0x5f, 0xed, 0xeb, 0xd5, 0x50, 0xa1, 0x59,
+	0x23, 0x5d, 0xc3, 0x31, 0x95, 0x57, 0x99, 0x6b, 0x7b, 0x45, 0x95, 0x6b, 0xe0, 0x90, 0x48, 0x3a,
+	0x92, 0x19, 0x28, 0x0c, 0x05, 0xc1, 0xc5, 0x46, 0x4c, 0x7e, 0x1b, 0xc6, 0xc3, 0x4d, 0x88, 0x15,
+	0x01, 0x2b, 0x8d, 0xe3, 0xe8, 0x9a, 0x01, 0xa6, 0xe6, 0x10, 0x24, 0x0a, 0x02, 0x4f, 0xca, 0xe2,
+	0xc8, 0x06, 0x78, 0x24, 0x9c, 0x46, 0x99, 0xc1, 0x7c, 0x3c, 0xd7, 0xea, 0x67, 0xce, 0xaf, 0x5b,
+	0x06, 0x2f, 0x20, 0x68, 0xc8, 0xa4, 0x69, 0x1c, 0x15, 0x43, 0xf0, 0xaf, 0x1d, 0xcc, 0x5f, 0x69,
+	0x2b, 0x68, 0x5e, 0x18, 0xe8, 0x03, 0xa1, 0x74, 0x0b, 0x0f, 0x6b, 0x60, 0x82, 0x0b, 0x45, 0xcb,
+	0x06, 0x8b, 0xda, 0x40, 0xfa, 0xd2, 0x28, 0xd3, 0xbf, 0x10, 0x7b, 0x5f, 0x27, 0xe8, 0xc5, 0x6e,
+	0xe2, 0x12, 0xe3, 0x6a, 0xc5, 0x2a, 0xc4, 0x7c, 0xd1, 0x8a, 0xaf, 0x99, 0x2d, 0xfd, 0x3c, 0x22,
+	0xe8, 0xd7, 0x11, 0x41, 0xcf, 0xeb, 0x04, 0xed, 0xd4, 0x09, 0x7a, 0xd5, 0x50, 0x1f, 0x93, 0xd8,
+	0x52, 0xbb, 0xec, 0xf5, 0x31, 0xb9, 0xde, 0x68, 0xea, 0xdc, 0x32, 0x78, 0xb9, 0x87, 0xb4, 0x02,
+	0x59, 0x6a, 0x59, 0xdc, 0xd6, 0x85, 0xff, 0xc8, 0xd6, 0x29, 0x33, 0x9e, 0x89, 0x35, 0xd8, 0x3d,
+	0x21, 0x23, 0x1b, 0xe0, 0xcd, 0xb5, 0xc7, 0xe4, 0x35, 0x3c, 0xd6, 0xd9, 0xa4, 0x7b, 0x86, 0xe3,
+	0x72, 0xdb, 0x93, 0x92, 0xb8, 0xdf, 0x86, 0x4d, 0xc3, 0x31, 0x38, 0x13, 0xa3, 0x8b, 0x14, 0x5a,
+	0xbe, 0x34, 0x8d, 0x23, 0xbc, 0xb4, 0x1e, 0xf4, 0x2b, 0xd1, 0xe5, 0xf9, 0xfe, 0x51, 0xcd, 0xa6,
+	0xf1, 0xd2, 0x7a, 0xfe, 0x77, 0x14, 0x8f, 0x76, 0x66, 0xe7, 0x2d, 0x43, 0xfa, 0x88, 0x70, 0x7c,
+	0xd1, 0x06, 0xea, 0xc2, 0xa9, 0x49, 0xf5, 0x3e, 0x34, 0x39, 0xda, 0x96, 0x2a, 0x88, 0x85, 0x96,
+	0xd9, 0x61, 0x9d, 0xdc, 0x2e, 0x80, 0xc3, 0xab, 0xb6, 0x0a, 0x4b, 0xb0, 0x09, 0x26, 0xb7, 0xc0,
+	0xf6, 0xf5, 0xd9, 0x79, 0xb5, 0xf1, 0xd0, 0x07, 0x94, 0x51, 0x1d, 0xb2, 0xa7, 0x7b, 0xf2, 0xe1,
+	0x84, 0x8c, 0x9c, 0x8e, 0x6d, 0x7f, 0xfe, 0xf1, 0x32, 0x3c, 0x21, 0x8f, 0x2b, 0xaa, 0x20, 0x53,
+	0x3a, 0x3f, 0xdd, 0x2c, 0xba, 0x21, 0xbd, 0x41, 0x38, 0xee, 0xcf, 0xe0, 0x9f, 0xb0, 0x9f, 0x5e,
+	0x18, 0xbb, 0x85, 0xe8, 0x2f, 0x4c, 0x0f, 0xc4, 0x27, 0x96, 0x46, 0xff, 0x3f, 0x62, 0x55, 0x50,
+	0x74, 0x41, 0x7c, 0x87, 0xb0, 0xf4, 0xb8, 0xcc, 0xb7, 0xfe, 0x1e, 0xb0, 0x77, 0x4a, 0x5e, 0x3d,
+	0xac, 0x93, 0x99, 0xf3, 0x41, 0x57, 0x0d, 0xd8, 0xea, 0x8e, 0x99, 0x90, 0xe3, 0x8a, 0x53, 0xe6,
+	0x5b, 0x67, 0x21, 0x6f, 0x22, 0xe9, 0x13, 0xc2, 0x89, 0xb3, 0x98, 0xcd, 0x6f, 0x72, 0x0e, 0x6d,
+	0xba, 0x67, 0x2a, 0x28, 0x96, 0xe9, 0x05, 0xa1, 0x6b, 0xc7, 0x04, 0x09, 0xf0, 0xb4, 0x3c, 0xd1,
+	0x0d, 0xbc, 0xec, 0x9f, 0x2f, 0xf8, 0x17, 0x26, 0x6b, 0xdf, 0x53, 0xa1, 0xda, 0x7e, 0x0a, 0xed,
+	0xed, 0xa7, 0xd0, 0xb7, 0xfd, 0x14, 0xda, 0x39, 0x48, 0x85, 0xf6, 0x0e, 0x52, 0xa1, 0x2f, 0x07,
+	0xa9, 0x50, 0x29, 0x2a, 0xf8, 0x66, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x30, 0x34, 0xf1,
+	0x5b, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GeoFencePolicyApiClient is the client API for GeoFencePolicyApi service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GeoFencePolicyApiClient interface {
+	// Create a Geo-Fence Policy
+	CreateGeoFencePolicy(ctx context.Context, in *GeoFencePolicy, opts ...grpc.CallOption) (*Result, error)
+	// Delete a Geo-Fence Policy
+	DeleteGeoFencePolicy(ctx context.Context, in *GeoFencePolicy, opts ...grpc.CallOption) (*Result, error)
+	// Update a Geo-Fence Policy
+	UpdateGeoFencePolicy(ctx context.Context, in *GeoFencePolicy, opts ...grpc.CallOption) (*Result, error)
+	// Show Geo-Fence Policies. Any fields specified will be used to filter results.
+	ShowGeoFencePolicy(ctx context.Context, in *GeoFencePolicy, opts ...grpc.CallOption) (GeoFencePolicyApi_ShowGeoFencePolicyClient, error)
+	// Show the past revisions of a Geo-Fence Policy, newest first
+	ShowGeoFencePolicyHistory(ctx context.Context, in *GeoFencePolicy, opts ...grpc.CallOption) (GeoFencePolicyApi_ShowGeoFencePolicyHistoryClient, error)
+}
+
+type geoFencePolicyApiClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGeoFencePolicyApiClient(cc *grpc.ClientConn) GeoFencePolicyApiClient {
+	return &geoFencePolicyApiClient{cc}
+}
+
+func (c *geoFencePolicyApiClient) CreateGeoFencePolicy(ctx context.Context, in *GeoFencePolicy, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/edgeproto.GeoFencePolicyApi/CreateGeoFencePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoFencePolicyApiClient) DeleteGeoFencePolicy(ctx context.Context, in *GeoFencePolicy, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/edgeproto.GeoFencePolicyApi/DeleteGeoFencePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoFencePolicyApiClient) UpdateGeoFencePolicy(ctx context.Context, in *GeoFencePolicy, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/edgeproto.GeoFencePolicyApi/UpdateGeoFencePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoFencePolicyApiClient) ShowGeoFencePolicy(ctx context.Context, in *GeoFencePolicy, opts ...grpc.CallOption) (GeoFencePolicyApi_ShowGeoFencePolicyClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoFencePolicyApi_serviceDesc.Streams[0], "/edgeproto.GeoFencePolicyApi/ShowGeoFencePolicy", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoFencePolicyApiShowGeoFencePolicyClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoFencePolicyApi_ShowGeoFencePolicyClient interface {
+	Recv() (*GeoFencePolicy, error)
+	grpc.ClientStream
+}
+
+type geoFencePolicyApiShowGeoFencePolicyClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoFencePolicyApiShowGeoFencePolicyClient) Recv() (*GeoFencePolicy, error) {
+	m := new(GeoFencePolicy)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoFencePolicyApiClient) ShowGeoFencePolicyHistory(ctx context.Context, in *GeoFencePolicy, opts ...grpc.CallOption) (GeoFencePolicyApi_ShowGeoFencePolicyHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoFencePolicyApi_serviceDesc.Streams[1], "/edgeproto.GeoFencePolicyApi/ShowGeoFencePolicyHistory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoFencePolicyApiShowGeoFencePolicyHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoFencePolicyApi_ShowGeoFencePolicyHistoryClient interface {
+	Recv() (*GeoFencePolicyHistory, error)
+	grpc.ClientStream
+}
+
+type geoFencePolicyApiShowGeoFencePolicyHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoFencePolicyApiShowGeoFencePolicyHistoryClient) Recv() (*GeoFencePolicyHistory, error) {
+	m := new(GeoFencePolicyHistory)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GeoFencePolicyApiServer is the server API for GeoFencePolicyApi service.
+type GeoFencePolicyApiServer interface {
+	// Create a Geo-Fence Policy
+	CreateGeoFencePolicy(context.Context, *GeoFencePolicy) (*Result, error)
+	// Delete a Geo-Fence Policy
+	DeleteGeoFencePolicy(context.Context, *GeoFencePolicy) (*Result, error)
+	// Update a Geo-Fence Policy
+	UpdateGeoFencePolicy(context.Context, *GeoFencePolicy) (*Result, error)
+	// Show Geo-Fence Policies. Any fields specified will be used to filter results.
+	ShowGeoFencePolicy(*GeoFencePolicy, GeoFencePolicyApi_ShowGeoFencePolicyServer) error
+	// Show the past revisions of a Geo-Fence Policy, newest first
+	ShowGeoFencePolicyHistory(*GeoFencePolicy, GeoFencePolicyApi_ShowGeoFencePolicyHistoryServer) error
+}
+
+// UnimplementedGeoFencePolicyApiServer can be embedded to have forward compatible implementations.
+type UnimplementedGeoFencePolicyApiServer struct {
+}
+
+func (*UnimplementedGeoFencePolicyApiServer) CreateGeoFencePolicy(ctx context.Context, req *GeoFencePolicy) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGeoFencePolicy not implemented")
+}
+func (*UnimplementedGeoFencePolicyApiServer) DeleteGeoFencePolicy(ctx context.Context, req *GeoFencePolicy) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGeoFencePolicy not implemented")
+}
+func (*UnimplementedGeoFencePolicyApiServer) UpdateGeoFencePolicy(ctx context.Context, req *GeoFencePolicy) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGeoFencePolicy not implemented")
+}
+func (*UnimplementedGeoFencePolicyApiServer) ShowGeoFencePolicy(req *GeoFencePolicy, srv GeoFencePolicyApi_ShowGeoFencePolicyServer) error {
+	return status.Errorf(codes.Unimplemented, "method ShowGeoFencePolicy not implemented")
+}
+func (*UnimplementedGeoFencePolicyApiServer) ShowGeoFencePolicyHistory(req *GeoFencePolicy, srv GeoFencePolicyApi_ShowGeoFencePolicyHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method ShowGeoFencePolicyHistory not implemented")
+}
+
+func RegisterGeoFencePolicyApiServer(s *grpc.Server, srv GeoFencePolicyApiServer) {
+	s.RegisterService(&_GeoFencePolicyApi_serviceDesc, srv)
+}
+
+func _GeoFencePolicyApi_CreateGeoFencePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeoFencePolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoFencePolicyApiServer).CreateGeoFencePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgeproto.GeoFencePolicyApi/CreateGeoFencePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoFencePolicyApiServer).CreateGeoFencePolicy(ctx, req.(*GeoFencePolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoFencePolicyApi_DeleteGeoFencePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeoFencePolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoFencePolicyApiServer).DeleteGeoFencePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgeproto.GeoFencePolicyApi/DeleteGeoFencePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoFencePolicyApiServer).DeleteGeoFencePolicy(ctx, req.(*GeoFencePolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoFencePolicyApi_UpdateGeoFencePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeoFencePolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoFencePolicyApiServer).UpdateGeoFencePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgeproto.GeoFencePolicyApi/UpdateGeoFencePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoFencePolicyApiServer).UpdateGeoFencePolicy(ctx, req.(*GeoFencePolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoFencePolicyApi_ShowGeoFencePolicy_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GeoFencePolicy)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoFencePolicyApiServer).ShowGeoFencePolicy(m, &geoFencePolicyApiShowGeoFencePolicyServer{stream})
+}
+
+type GeoFencePolicyApi_ShowGeoFencePolicyServer interface {
+	Send(*GeoFencePolicy) error
+	grpc.ServerStream
+}
+
+type geoFencePolicyApiShowGeoFencePolicyServer struct {
+	grpc.ServerStream
+}
+
+func (x *geoFencePolicyApiShowGeoFencePolicyServer) Send(m *GeoFencePolicy) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GeoFencePolicyApi_ShowGeoFencePolicyHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GeoFencePolicy)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoFencePolicyApiServer).ShowGeoFencePolicyHistory(m, &geoFencePolicyApiShowGeoFencePolicyHistoryServer{stream})
+}
+
+type GeoFencePolicyApi_ShowGeoFencePolicyHistoryServer interface {
+	Send(*GeoFencePolicyHistory) error
+	grpc.ServerStream
+}
+
+type geoFencePolicyApiShowGeoFencePolicyHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *geoFencePolicyApiShowGeoFencePolicyHistoryServer) Send(m *GeoFencePolicyHistory) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _GeoFencePolicyApi_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "edgeproto.GeoFencePolicyApi",
+	HandlerType: (*GeoFencePolicyApiServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGeoFencePolicy",
+			Handler:    _GeoFencePolicyApi_CreateGeoFencePolicy_Handler,
+		},
+		{
+			MethodName: "DeleteGeoFencePolicy",
+			Handler:    _GeoFencePolicyApi_DeleteGeoFencePolicy_Handler,
+		},
+		{
+			MethodName: "UpdateGeoFencePolicy",
+			Handler:    _GeoFencePolicyApi_UpdateGeoFencePolicy_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ShowGeoFencePolicy",
+			Handler:       _GeoFencePolicyApi_ShowGeoFencePolicy_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ShowGeoFencePolicyHistory",
+			Handler:       _GeoFencePolicyApi_ShowGeoFencePolicyHistory_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "geofencepolicy.proto",
+}
+
+func (m *GeoFence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeoFence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeoFence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CountryCodes) > 0 {
+		for iNdEx := len(m.CountryCodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CountryCodes[iNdEx])
+			copy(dAtA[i:], m.CountryCodes[iNdEx])
+			i = encodeVarintGeofencepolicy(dAtA, i, uint64(len(m.CountryCodes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Polygon) > 0 {
+		for iNdEx := len(m.Polygon) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Polygon[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGeofencepolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGeofencepolicy(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GeoFencePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeoFencePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeoFencePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeletePrepare {
+		i--
+		if m.DeletePrepare {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Fences) > 0 {
+		for iNdEx := len(m.Fences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGeofencepolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGeofencepolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fields[iNdEx])
+			copy(dAtA[i:], m.Fields[iNdEx])
+			i = encodeVarintGeofencepolicy(dAtA, i, uint64(len(m.Fields[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GeoFencePolicyHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeoFencePolicyHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeoFencePolicyHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Obj.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGeofencepolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Revision != 0 {
+		i = encodeVarintGeofencepolicy(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGeofencepolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGeofencepolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GeoFence) Clone() *GeoFence {
+	cp := &GeoFence{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *GeoFence) AddPolygon(vals ...distributed_match_engine.Loc) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Polygon {
+		cur[v.String()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.String()]; found {
+			continue // duplicate
+		}
+		m.Polygon = append(m.Polygon, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *GeoFence) RemovePolygon(vals ...distributed_match_engine.Loc) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.String()] = struct{}{}
+	}
+	for i := len(m.Polygon); i >= 0; i-- {
+		if _, found := remove[m.Polygon[i].String()]; found {
+			m.Polygon = append(m.Polygon[:i], m.Polygon[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *GeoFence) AddCountryCodes(vals ...string) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.CountryCodes {
+		cur[v] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v]; found {
+			continue // duplicate
+		}
+		m.CountryCodes = append(m.CountryCodes, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *GeoFence) RemoveCountryCodes(vals ...string) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v] = struct{}{}
+	}
+	for i := len(m.CountryCodes); i >= 0; i-- {
+		if _, found := remove[m.CountryCodes[i]]; found {
+			m.CountryCodes = append(m.CountryCodes[:i], m.CountryCodes[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *GeoFence) CopyInFields(src *GeoFence) int {
+	updateListAction := "replace"
+	changed := 0
+	if m.Name != src.Name {
+		m.Name = src.Name
+		changed++
+	}
+	if src.Polygon != nil {
+		if updateListAction == "add" {
+			changed += m.AddPolygon(src.Polygon...)
+		} else if updateListAction == "remove" {
+			changed += m.RemovePolygon(src.Polygon...)
+		} else {
+			m.Polygon = make([]distributed_match_engine.Loc, 0)
+			for k0, _ := range src.Polygon {
+				m.Polygon = append(m.Polygon, *src.Polygon[k0].Clone())
+			}
+			changed++
+		}
+	} else if m.Polygon != nil {
+		m.Polygon = nil
+		changed++
+	}
+	if src.CountryCodes != nil {
+		if updateListAction == "add" {
+			changed += m.AddCountryCodes(src.CountryCodes...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveCountryCodes(src.CountryCodes...)
+		} else {
+			m.CountryCodes = make([]string, 0)
+			m.CountryCodes = append(m.CountryCodes, src.CountryCodes...)
+			changed++
+		}
+	} else if m.CountryCodes != nil {
+		m.CountryCodes = nil
+		changed++
+	}
+	return changed
+}
+
+func (m *GeoFence) DeepCopyIn(src *GeoFence) {
+	m.Name = src.Name
+	if src.Polygon != nil {
+		m.Polygon = make([]distributed_match_engine.Loc, len(src.Polygon), len(src.Polygon))
+		for ii, s := range src.Polygon {
+			m.Polygon[ii] = s
+		}
+	} else {
+		m.Polygon = nil
+	}
+	if src.CountryCodes != nil {
+		m.CountryCodes = make([]string, len(src.CountryCodes), len(src.CountryCodes))
+		for ii, s := range src.CountryCodes {
+			m.CountryCodes[ii] = s
+		}
+	} else {
+		m.CountryCodes = nil
+	}
+}
+
+// Helper method to check that enums have valid values
+func (m *GeoFence) ValidateEnums() error {
+	return nil
+}
+
+func (s *GeoFence) ClearTagged(tags map[string]struct{}) {
+}
+
+func (m *GeoFencePolicy) Matches(o *GeoFencePolicy, fopts ...MatchOpt) bool {
+	opts := MatchOptions{}
+	applyMatchOptions(&opts, fopts...)
+	if o == nil {
+		if opts.Filter {
+			return true
+		}
+		return false
+	}
+	if !m.Key.Matches(&o.Key, fopts...) {
+		return false
+	}
+	if !opts.Filter || o.Fences != nil {
+		if len(m.Fences) == 0 && len(o.Fences) > 0 || len(m.Fences) > 0 && len(o.Fences) == 0 {
+			return false
+		} else if m.Fences != nil && o.Fences != nil {
+			if !opts.Filter && len(m.Fences) != len(o.Fences) {
+				return false
+			}
+		}
+	}
+	if !opts.IgnoreBackend {
+		if !opts.Filter || o.DeletePrepare != false {
+			if o.DeletePrepare != m.DeletePrepare {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+const GeoFencePolicyFieldKey = "2"
+const GeoFencePolicyFieldKeyOrganization = "2.1"
+const GeoFencePolicyFieldKeyName = "2.2"
+const GeoFencePolicyFieldFences = "3"
+const GeoFencePolicyFieldFencesName = "3.1"
+const GeoFencePolicyFieldFencesPolygon = "3.2"
+const GeoFencePolicyFieldFencesPolygonLatitude = "3.2.1"
+const GeoFencePolicyFieldFencesPolygonLongitude = "3.2.2"
+const GeoFencePolicyFieldFencesPolygonHorizontalAccuracy = "3.2.3"
+const GeoFencePolicyFieldFencesPolygonVerticalAccuracy = "3.2.4"
+const GeoFencePolicyFieldFencesPolygonAltitude = "3.2.5"
+const GeoFencePolicyFieldFencesPolygonCourse = "3.2.6"
+const GeoFencePolicyFieldFencesPolygonSpeed = "3.2.7"
+const GeoFencePolicyFieldFencesPolygonTimestamp = "3.2.8"
+const GeoFencePolicyFieldFencesPolygonTimestampSeconds = "3.2.8.1"
+const GeoFencePolicyFieldFencesPolygonTimestampNanos = "3.2.8.2"
+const GeoFencePolicyFieldFencesCountryCodes = "3.3"
+const GeoFencePolicyFieldDeletePrepare = "4"
+
+var GeoFencePolicyAllFields = []string{
+	GeoFencePolicyFieldKeyOrganization,
+	GeoFencePolicyFieldKeyName,
+	GeoFencePolicyFieldFencesName,
+	GeoFencePolicyFieldFencesPolygonLatitude,
+	GeoFencePolicyFieldFencesPolygonLongitude,
+	GeoFencePolicyFieldFencesPolygonHorizontalAccuracy,
+	GeoFencePolicyFieldFencesPolygonVerticalAccuracy,
+	GeoFencePolicyFieldFencesPolygonAltitude,
+	GeoFencePolicyFieldFencesPolygonCourse,
+	GeoFencePolicyFieldFencesPolygonSpeed,
+	GeoFencePolicyFieldFencesPolygonTimestampSeconds,
+	GeoFencePolicyFieldFencesPolygonTimestampNanos,
+	GeoFencePolicyFieldFencesCountryCodes,
+	GeoFencePolicyFieldDeletePrepare,
+}
+
+var GeoFencePolicyAllFieldsMap = NewFieldMap(map[string]struct{}{
+	GeoFencePolicyFieldKeyOrganization:                 struct{}{},
+	GeoFencePolicyFieldKeyName:                         struct{}{},
+	GeoFencePolicyFieldFencesName:                      struct{}{},
+	GeoFencePolicyFieldFencesPolygonLatitude:           struct{}{},
+	GeoFencePolicyFieldFencesPolygonLongitude:          struct{}{},
+	GeoFencePolicyFieldFencesPolygonHorizontalAccuracy: struct{}{},
+	GeoFencePolicyFieldFencesPolygonVerticalAccuracy:   struct{}{},
+	GeoFencePolicyFieldFencesPolygonAltitude:           struct{}{},
+	GeoFencePolicyFieldFencesPolygonCourse:             struct{}{},
+	GeoFencePolicyFieldFencesPolygonSpeed:              struct{}{},
+	GeoFencePolicyFieldFencesPolygonTimestampSeconds:   struct{}{},
+	GeoFencePolicyFieldFencesPolygonTimestampNanos:     struct{}{},
+	GeoFencePolicyFieldFencesCountryCodes:              struct{}{},
+	GeoFencePolicyFieldDeletePrepare:                   struct{}{},
+})
+
+var GeoFencePolicyAllFieldsStringMap = map[string]string{
+	GeoFencePolicyFieldKeyOrganization:                 "Key Organization",
+	GeoFencePolicyFieldKeyName:                         "Key Name",
+	GeoFencePolicyFieldFencesName:                      "Fences Name",
+	GeoFencePolicyFieldFencesPolygonLatitude:           "Fences Polygon Latitude",
+	GeoFencePolicyFieldFencesPolygonLongitude:          "Fences Polygon Longitude",
+	GeoFencePolicyFieldFencesPolygonHorizontalAccuracy: "Fences Polygon Horizontal Accuracy",
+	GeoFencePolicyFieldFencesPolygonVerticalAccuracy:   "Fences Polygon Vertical Accuracy",
+	GeoFencePolicyFieldFencesPolygonAltitude:           "Fences Polygon Altitude",
+	GeoFencePolicyFieldFencesPolygonCourse:             "Fences Polygon Course",
+	GeoFencePolicyFieldFencesPolygonSpeed:              "Fences Polygon Speed",
+	GeoFencePolicyFieldFencesPolygonTimestampSeconds:   "Fences Polygon Timestamp Seconds",
+	GeoFencePolicyFieldFencesPolygonTimestampNanos:     "Fences Polygon Timestamp Nanos",
+	GeoFencePolicyFieldFencesCountryCodes:              "Fences Country Codes",
+	GeoFencePolicyFieldDeletePrepare:                   "Delete Prepare",
+}
+
+func (m *GeoFencePolicy) IsKeyField(s string) bool {
+	return strings.HasPrefix(s, GeoFencePolicyFieldKey+".") || s == GeoFencePolicyFieldKey
+}
+
+func (m *GeoFencePolicy) DiffFields(o *GeoFencePolicy, fields *FieldMap) {
+	if m.Key.Organization != o.Key.Organization {
+		fields.Set(GeoFencePolicyFieldKeyOrganization)
+		fields.Set(GeoFencePolicyFieldKey)
+	}
+	if m.Key.Name != o.Key.Name {
+		fields.Set(GeoFencePolicyFieldKeyName)
+		fields.Set(GeoFencePolicyFieldKey)
+	}
+	if len(m.Fences) != len(o.Fences) {
+		fields.Set(GeoFencePolicyFieldFences)
+	} else {
+		for i0 := 0; i0 < len(m.Fences); i0++ {
+			if m.Fences[i0].Name != o.Fences[i0].Name {
+				fields.Set(GeoFencePolicyFieldFencesName)
+				fields.Set(GeoFencePolicyFieldFences)
+			}
+			if len(m.Fences[i0].Polygon) != len(o.Fences[i0].Polygon) {
+				fields.Set(GeoFencePolicyFieldFencesPolygon)
+				fields.Set(GeoFencePolicyFieldFences)
+			} else {
+				for i1 := 0; i1 < len(m.Fences[i0].Polygon); i1++ {
+					if m.Fences[i0].Polygon[i1].Latitude != o.Fences[i0].Polygon[i1].Latitude {
+						fields.Set(GeoFencePolicyFieldFencesPolygonLatitude)
+						fields.Set(GeoFencePolicyFieldFencesPolygon)
+						fields.Set(GeoFencePolicyFieldFences)
+					}
+					if m.Fences[i0].Polygon[i1].Longitude != o.Fences[i0].Polygon[i1].Longitude {
+						fields.Set(GeoFencePolicyFieldFencesPolygonLongitude)
+						fields.Set(GeoFencePolicyFieldFencesPolygon)
+						fields.Set(GeoFencePolicyFieldFences)
+					}
+					if m.Fences[i0].Polygon[i1].HorizontalAccuracy != o.Fences[i0].Polygon[i1].HorizontalAccuracy {
+						fields.Set(GeoFencePolicyFieldFencesPolygonHorizontalAccuracy)
+						fields.Set(GeoFencePolicyFieldFencesPolygon)
+						fields.Set(GeoFencePolicyFieldFences)
+					}
+					if m.Fences[i0].Polygon[i1].VerticalAccuracy != o.Fences[i0].Polygon[i1].VerticalAccuracy {
+						fields.Set(GeoFencePolicyFieldFencesPolygonVerticalAccuracy)
+						fields.Set(GeoFencePolicyFieldFencesPolygon)
+						fields.Set(GeoFencePolicyFieldFences)
+					}
+					if m.Fences[i0].Polygon[i1].Altitude != o.Fences[i0].Polygon[i1].Altitude {
+						fields.Set(GeoFencePolicyFieldFencesPolygonAltitude)
+						fields.Set(GeoFencePolicyFieldFencesPolygon)
+						fields.Set(GeoFencePolicyFieldFences)
+					}
+					if m.Fences[i0].Polygon[i1].Course != o.Fences[i0].Polygon[i1].Course {
+						fields.Set(GeoFencePolicyFieldFencesPolygonCourse)
+						fields.Set(GeoFencePolicyFieldFencesPolygon)
+						fields.Set(GeoFencePolicyFieldFences)
+					}
+					if m.Fences[i0].Polygon[i1].Speed != o.Fences[i0].Polygon[i1].Speed {
+						fields.Set(GeoFencePolicyFieldFencesPolygonSpeed)
+						fields.Set(GeoFencePolicyFieldFencesPolygon)
+						fields.Set(GeoFencePolicyFieldFences)
+					}
+					if m.Fences[i0].Polygon[i1].Timestamp != nil && o.Fences[i0].Polygon[i1].Timestamp != nil {
+						if m.Fences[i0].Polygon[i1].Timestamp.Seconds != o.Fences[i0].Polygon[i1].Timestamp.Seconds {
+							fields.Set(GeoFencePolicyFieldFencesPolygonTimestampSeconds)
+							fields.Set(GeoFencePolicyFieldFencesPolygonTimestamp)
+							fields.Set(GeoFencePolicyFieldFencesPolygon)
+							fields.Set(GeoFencePolicyFieldFences)
+						}
+						if m.Fences[i0].Polygon[i1].Timestamp.Nanos != o.Fences[i0].Polygon[i1].Timestamp.Nanos {
+							fields.Set(GeoFencePolicyFieldFencesPolygonTimestampNanos)
+							fields.Set(GeoFencePolicyFieldFencesPolygonTimestamp)
+							fields.Set(GeoFencePolicyFieldFencesPolygon)
+							fields.Set(GeoFencePolicyFieldFences)
+						}
+					} else if (m.Fences[i0].Polygon[i1].Timestamp != nil && o.Fences[i0].Polygon[i1].Timestamp == nil) || (m.Fences[i0].Polygon[i1].Timestamp == nil && o.Fences[i0].Polygon[i1].Timestamp != nil) {
+						fields.Set(GeoFencePolicyFieldFencesPolygonTimestamp)
+						fields.Set(GeoFencePolicyFieldFencesPolygon)
+						fields.Set(GeoFencePolicyFieldFences)
+					}
+				}
+			}
+			if len(m.Fences[i0].CountryCodes) != len(o.Fences[i0].CountryCodes) {
+				fields.Set(GeoFencePolicyFieldFencesCountryCodes)
+				fields.Set(GeoFencePolicyFieldFences)
+			} else {
+				for i1 := 0; i1 < len(m.Fences[i0].CountryCodes); i1++ {
+					if m.Fences[i0].CountryCodes[i1] != o.Fences[i0].CountryCodes[i1] {
+						fields.Set(GeoFencePolicyFieldFencesCountryCodes)
+						fields.Set(GeoFencePolicyFieldFences)
+						break
+					}
+				}
+			}
+		}
+	}
+	if m.DeletePrepare != o.DeletePrepare {
+		fields.Set(GeoFencePolicyFieldDeletePrepare)
+	}
+}
+
+func (m *GeoFencePolicy) GetDiffFields(o *GeoFencePolicy) *FieldMap {
+	diffFields := NewFieldMap(nil)
+	m.DiffFields(o, diffFields)
+	return diffFields
+}
+
+var UpdateGeoFencePolicyFieldsMap = NewFieldMap(map[string]struct{}{
+	GeoFencePolicyFieldFences:                          struct{}{},
+	GeoFencePolicyFieldFencesName:                      struct{}{},
+	GeoFencePolicyFieldFencesPolygon:                   struct{}{},
+	GeoFencePolicyFieldFencesPolygonLatitude:           struct{}{},
+	GeoFencePolicyFieldFencesPolygonLongitude:          struct{}{},
+	GeoFencePolicyFieldFencesPolygonHorizontalAccuracy: struct{}{},
+	GeoFencePolicyFieldFencesPolygonVerticalAccuracy:   struct{}{},
+	GeoFencePolicyFieldFencesPolygonAltitude:           struct{}{},
+	GeoFencePolicyFieldFencesPolygonCourse:             struct{}{},
+	GeoFencePolicyFieldFencesPolygonSpeed:              struct{}{},
+	GeoFencePolicyFieldFencesPolygonTimestamp:          struct{}{},
+	GeoFencePolicyFieldFencesPolygonTimestampSeconds:   struct{}{},
+	GeoFencePolicyFieldFencesPolygonTimestampNanos:     struct{}{},
+	GeoFencePolicyFieldFencesCountryCodes:              struct{}{},
+})
+
+func (m *GeoFencePolicy) ValidateUpdateFields() error {
+	return m.ValidateUpdateFieldsCustom(UpdateGeoFencePolicyFieldsMap)
+}
+
+func (m *GeoFencePolicy) ValidateUpdateFieldsCustom(allowedFields *FieldMap) error {
+	if m.Fields == nil {
+		return fmt.Errorf("nothing specified to update")
+	}
+	fmap := MakeFieldMap(m.Fields)
+	badFieldStrs := []string{}
+	for _, field := range fmap.Fields() {
+		if m.IsKeyField(field) {
+			continue
+		}
+		if !allowedFields.Has(field) {
+			if _, ok := GeoFencePolicyAllFieldsStringMap[field]; !ok {
+				continue
+			}
+			badFieldStrs = append(badFieldStrs, GeoFencePolicyAllFieldsStringMap[field])
+		}
+	}
+	if len(badFieldStrs) > 0 {
+		return fmt.Errorf("specified field(s) %s cannot be modified", strings.Join(badFieldStrs, ","))
+	}
+	return nil
+}
+
+func (m *GeoFencePolicy) Clone() *GeoFencePolicy {
+	cp := &GeoFencePolicy{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *GeoFencePolicy) AddFences(vals ...GeoFence) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Fences {
+		cur[v.String()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.String()]; found {
+			continue // duplicate
+		}
+		m.Fences = append(m.Fences, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *GeoFencePolicy) RemoveFences(vals ...GeoFence) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.String()] = struct{}{}
+	}
+	for i := len(m.Fences); i >= 0; i-- {
+		if _, found := remove[m.Fences[i].String()]; found {
+			m.Fences = append(m.Fences[:i], m.Fences[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *GeoFencePolicy) CopyInFields(src *GeoFencePolicy) int {
+	updateListAction := "replace"
+	changed := 0
+	fmap := MakeFieldMap(src.Fields)
+	if fmap.HasOrHasChild("2") {
+		if fmap.Has("2.1") {
+			if m.Key.Organization != src.Key.Organization {
+				m.Key.Organization = src.Key.Organization
+				changed++
+			}
+		}
+		if fmap.Has("2.2") {
+			if m.Key.Name != src.Key.Name {
+				m.Key.Name = src.Key.Name
+				changed++
+			}
+		}
+	}
+	if fmap.HasOrHasChild("3") {
+		if src.Fences != nil {
+			if updateListAction == "add" {
+				changed += m.AddFences(src.Fences...)
+			} else if updateListAction == "remove" {
+				changed += m.RemoveFences(src.Fences...)
+			} else {
+				m.Fences = make([]GeoFence, 0)
+				for k0, _ := range src.Fences {
+					m.Fences = append(m.Fences, *src.Fences[k0].Clone())
+				}
+				changed++
+			}
+		} else if m.Fences != nil {
+			m.Fences = nil
+			changed++
+		}
+	}
+	if fmap.Has("4") {
+		if m.DeletePrepare != src.DeletePrepare {
+			m.DeletePrepare = src.DeletePrepare
+			changed++
+		}
+	}
+	return changed
+}
+
+func (m *GeoFencePolicy) DeepCopyIn(src *GeoFencePolicy) {
+	m.Key.DeepCopyIn(&src.Key)
+	if src.Fences != nil {
+		m.Fences = make([]GeoFence, len(src.Fences), len(src.Fences))
+		for ii, s := range src.Fences {
+			m.Fences[ii].DeepCopyIn(&s)
+		}
+	} else {
+		m.Fences = nil
+	}
+	m.DeletePrepare = src.DeletePrepare
+}
+
+func (s *GeoFencePolicy) HasFields() bool {
+	return true
+}
+
+type GeoFencePolicyStore interface {
+	Create(ctx context.Context, m *GeoFencePolicy, wait func(int64)) (*Result, error)
+	Update(ctx context.Context, m *GeoFencePolicy, wait func(int64)) (*Result, error)
+	Delete(ctx context.Context, m *GeoFencePolicy, wait func(int64)) (*Result, error)
+	Put(ctx context.Context, m *GeoFencePolicy, wait func(int64), ops ...objstore.KVOp) (*Result, error)
+	LoadOne(key string) (*GeoFencePolicy, int64, error)
+	Get(ctx context.Context, key *PolicyKey, buf *GeoFencePolicy) bool
+	STMGet(stm concurrency.STM, key *PolicyKey, buf *GeoFencePolicy) bool
+	STMPut(stm concurrency.STM, obj *GeoFencePolicy, ops ...objstore.KVOp)
+	STMDel(stm concurrency.STM, key *PolicyKey)
+	STMHas(stm concurrency.STM, key *PolicyKey) bool
+}
+
+type GeoFencePolicyStoreImpl struct {
+	kvstore objstore.KVStore
+}
+
+func NewGeoFencePolicyStore(kvstore objstore.KVStore) *GeoFencePolicyStoreImpl {
+	return &GeoFencePolicyStoreImpl{kvstore: kvstore}
+}
+
+func (s *GeoFencePolicyStoreImpl) Create(ctx context.Context, m *GeoFencePolicy, wait func(int64)) (*Result, error) {
+	err := m.Validate(GeoFencePolicyAllFieldsMap)
+	if err != nil {
+		return nil, err
+	}
+	key := objstore.DbKeyString("GeoFencePolicy", m.GetKey())
+	val, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	rev, err := s.kvstore.Create(ctx, key, string(val))
+	if err != nil {
+		return nil, err
+	}
+	if wait != nil {
+		wait(rev)
+	}
+	return &Result{}, err
+}
+
+func (s *GeoFencePolicyStoreImpl) Update(ctx context.Context, m *GeoFencePolicy, wait func(int64)) (*Result, error) {
+	fmap := MakeFieldMap(m.Fields)
+	err := m.Validate(fmap)
+	if err != nil {
+		return nil, err
+	}
+	key := objstore.DbKeyString("GeoFencePolicy", m.GetKey())
+	var vers int64 = 0
+	curBytes, vers, _, err := s.kvstore.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	var cur GeoFencePolicy
+	err = json.Unmarshal(curBytes, &cur)
+	if err != nil {
+		return nil, err
+	}
+	cur.CopyInFields(m)
+	// never save fields
+	cur.Fields = nil
+	val, err := json.Marshal(cur)
+	if err != nil {
+		return nil, err
+	}
+	rev, err := s.kvstore.Update(ctx, key, string(val), vers)
+	if err != nil {
+		return nil, err
+	}
+	if wait != nil {
+		wait(rev)
+	}
+	return &Result{}, err
+}
+
+func (s *GeoFencePolicyStoreImpl) Put(ctx context.Context, m *GeoFencePolicy, wait func(int64), ops ...objstore.KVOp) (*Result, error) {
+	err := m.Validate(GeoFencePolicyAllFieldsMap)
+	m.Fields = nil
+	if err != nil {
+		return nil, err
+	}
+	key := objstore.DbKeyString("GeoFencePolicy", m.GetKey())
+	var val []byte
+	val, err = json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	rev, err := s.kvstore.Put(ctx, key, string(val), ops...)
+	if err != nil {
+		return nil, err
+	}
+	if wait != nil {
+		wait(rev)
+	}
+	return &Result{}, err
+}
+
+func (s *GeoFencePolicyStoreImpl) Delete(ctx context.Context, m *GeoFencePolicy, wait func(int64)) (*Result, error) {
+	err := m.GetKey().ValidateKey()
+	if err != nil {
+		return nil, err
+	}
+	key := objstore.DbKeyString("GeoFencePolicy", m.GetKey())
+	rev, err := s.kvstore.Delete(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if wait != nil {
+		wait(rev)
+	}
+	return &Result{}, err
+}
+
+func (s *GeoFencePolicyStoreImpl) LoadOne(key string) (*GeoFencePolicy, int64, error) {
+	val, rev, _, err := s.kvstore.Get(key)
+	if err != nil {
+		return nil, 0, err
+	}
+	var obj GeoFencePolicy
+	err = json.Unmarshal(val, &obj)
+	if err != nil {
+		log.DebugLog(log.DebugLevelApi, "Failed to parse GeoFencePolicy data", "val", string(val), "err", err)
+		return nil, 0, err
+	}
+	return &obj, rev, nil
+}
+
+func (s *GeoFencePolicyStoreImpl) Get(ctx context.Context, key *PolicyKey, buf *GeoFencePolicy) bool {
+	keystr := objstore.DbKeyString("GeoFencePolicy", key)
+	val, _, _, err := s.kvstore.Get(keystr)
+	if err != nil {
+		return false
+	}
+	return s.parseGetData(val, buf)
+}
+
+func (s *GeoFencePolicyStoreImpl) STMGet(stm concurrency.STM, key *PolicyKey, buf *GeoFencePolicy) bool {
+	keystr := objstore.DbKeyString("GeoFencePolicy", key)
+	valstr := stm.Get(keystr)
+	return s.parseGetData([]byte(valstr), buf)
+}
+
+func (s *GeoFencePolicyStoreImpl) STMHas(stm concurrency.STM, key *PolicyKey) bool {
+	keystr := objstore.DbKeyString("GeoFencePolicy", key)
+	return stm.Get(keystr) != ""
+}
+
+func (s *GeoFencePolicyStoreImpl) parseGetData(val []byte, buf *GeoFencePolicy) bool {
+	if len(val) == 0 {
+		return false
+	}
+	if buf != nil {
+		// clear buf, because empty values in val won't
+		// overwrite non-empty values in buf.
+		*buf = GeoFencePolicy{}
+		err := json.Unmarshal(val, buf)
+		if err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *GeoFencePolicyStoreImpl) STMPut(stm concurrency.STM, obj *GeoFencePolicy, ops ...objstore.KVOp) {
+	keystr := objstore.DbKeyString("GeoFencePolicy", obj.GetKey())
+
+	val, err := json.Marshal(obj)
+	if err != nil {
+		log.InfoLog("GeoFencePolicy json marshal failed", "obj", obj, "err", err)
+	}
+	v3opts := GetSTMOpts(ops...)
+	stm.Put(keystr, string(val), v3opts...)
+}
+
+func (s *GeoFencePolicyStoreImpl) STMDel(stm concurrency.STM, key *PolicyKey) {
+	keystr := objstore.DbKeyString("GeoFencePolicy", key)
+	stm.Del(keystr)
+}
+
+func StoreListGeoFencePolicy(ctx context.Context, kvstore objstore.KVStore) ([]GeoFencePolicy, error) {
+	keyPrefix := objstore.DbKeyPrefixString("GeoFencePolicy") + "/"
+	objs := []GeoFencePolicy{}
+	err := kvstore.List(keyPrefix, func(key, val []byte, rev, modRev int64) error {
+		obj := GeoFencePolicy{}
+		err := json.Unmarshal(val, &obj)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal GeoFencePolicy json %s, %s", string(val), err)
+		}
+		objs = append(objs, obj)
+		return nil
+	})
+	return objs, err
+}
+
+type GeoFencePolicyKeyWatcher struct {
+	cb func(ctx context.Context)
+}
+
+type GeoFencePolicyCacheData struct {
+	Obj    *GeoFencePolicy
+	ModRev int64
+}
+
+func (s *GeoFencePolicyCacheData) Clone() *GeoFencePolicyCacheData {
+	cp := GeoFencePolicyCacheData{}
+	if s.Obj != nil {
+		cp.Obj = &GeoFencePolicy{}
+		cp.Obj.DeepCopyIn(s.Obj)
+	}
+	cp.ModRev = s.ModRev
+	return &cp
+}
+
+// GeoFencePolicyCache caches GeoFencePolicy objects in memory in a hash table
+// and keeps them in sync with the database.
+type GeoFencePolicyCache struct {
+	Objs          map[PolicyKey]*GeoFencePolicyCacheData
+	Mux           util.Mutex
+	List          map[PolicyKey]struct{}
+	FlushAll      bool
+	NotifyCbs     []func(ctx context.Context, obj *GeoFencePolicy, modRev int64)
+	UpdatedCbs    []func(ctx context.Context, old *GeoFencePolicy, new *GeoFencePolicy)
+	DeletedCbs    []func(ctx context.Context, old *GeoFencePolicy)
+	KeyWatchers   map[PolicyKey][]*GeoFencePolicyKeyWatcher
+	UpdatedKeyCbs []func(ctx context.Context, key *PolicyKey)
+	DeletedKeyCbs []func(ctx context.Context, key *PolicyKey)
+	Store         GeoFencePolicyStore
+}
+
+func NewGeoFencePolicyCache() *GeoFencePolicyCache {
+	cache := GeoFencePolicyCache{}
+	InitGeoFencePolicyCache(&cache)
+	return &cache
+}
+
+func InitGeoFencePolicyCache(cache *GeoFencePolicyCache) {
+	cache.Objs = make(map[PolicyKey]*GeoFencePolicyCacheData)
+	cache.KeyWatchers = make(map[PolicyKey][]*GeoFencePolicyKeyWatcher)
+	cache.NotifyCbs = nil
+	cache.UpdatedCbs = nil
+	cache.DeletedCbs = nil
+	cache.UpdatedKeyCbs = nil
+	cache.DeletedKeyCbs = nil
+}
+
+func (c *GeoFencePolicyCache) GetTypeString() string {
+	return "GeoFencePolicy"
+}
+
+func (c *GeoFencePolicyCache) Get(key *PolicyKey, valbuf *GeoFencePolicy) bool {
+	var modRev int64
+	return c.GetWithRev(key, valbuf, &modRev)
+}
+
+// STMGet gets from the store if STM is set, otherwise gets from cache
+func (c *GeoFencePolicyCache) STMGet(ostm *OptionalSTM, key *PolicyKey, valbuf *GeoFencePolicy) bool {
+	if ostm.stm != nil {
+		if c.Store == nil {
+			// panic, otherwise if we fallback to cache, we may silently
+			// introduce race conditions and intermittent failures due to
+			// reading from cache during a transaction.
+			panic("GeoFencePolicyCache store not set, cannot read via STM")
+		}
+		return c.Store.STMGet(ostm.stm, key, valbuf)
+	}
+	var modRev int64
+	return c.GetWithRev(key, valbuf, &modRev)
+}
+
+func (c *GeoFencePolicyCache) GetWithRev(key *PolicyKey, valbuf *GeoFencePolicy, modRev *int64) bool {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	inst, found := c.Objs[*key]
+	if found {
+		valbuf.DeepCopyIn(inst.Obj)
+		*modRev = inst.ModRev
+	}
+	return found
+}
+
+func (c *GeoFencePolicyCache) HasKey(key *PolicyKey) bool {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	_, found := c.Objs[*key]
+	return found
+}
+
+func (c *GeoFencePolicyCache) GetAllKeys(ctx context.Context, cb func(key *PolicyKey, modRev int64)) {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	for key, data := range c.Objs {
+		cb(&key, data.ModRev)
+	}
+}
+
+func (c *GeoFencePolicyCache) GetAllLocked(ctx context.Context, cb func(obj *GeoFencePolicy, modRev int64)) {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	for _, data := range c.Objs {
+		cb(data.Obj, data.ModRev)
+	}
+}
+
+func (c *GeoFencePolicyCache) Update(ctx context.Context, in *GeoFencePolicy, modRev int64) {
+	c.UpdateModFunc(ctx, in.GetKey(), modRev, func(old *GeoFencePolicy) (*GeoFencePolicy, bool) {
+		return in, true
+	})
+}
+
+func (c *GeoFencePolicyCache) UpdateModFunc(ctx context.Context, key *PolicyKey, modRev int64, modFunc func(old *GeoFencePolicy) (new *GeoFencePolicy, changed bool)) {
+	c.Mux.Lock()
+	var old *GeoFencePolicy
+	if oldData, found := c.Objs[*key]; found {
+		old = oldData.Obj
+	}
+	new, changed := modFunc(old)
+	if !changed {
+		c.Mux.Unlock()
+		return
+	}
+	if len(c.UpdatedCbs) > 0 || len(c.NotifyCbs) > 0 {
+		newCopy := &GeoFencePolicy{}
+		newCopy.DeepCopyIn(new)
+		for _, cb := range c.UpdatedCbs {
+			defer cb(ctx, old, newCopy)
+		}
+		for _, cb := range c.NotifyCbs {
+			if cb != nil {
+				defer cb(ctx, newCopy, modRev)
+			}
+		}
+	}
+	for _, cb := range c.UpdatedKeyCbs {
+		defer cb(ctx, key)
+	}
+	store := &GeoFencePolicy{}
+	store.DeepCopyIn(new)
+	c.Objs[new.GetKeyVal()] = &GeoFencePolicyCacheData{
+		Obj:    store,
+		ModRev: modRev,
+	}
+	log.SpanLog(ctx, log.DebugLevelApi, "cache update", "new", store)
+	c.Mux.Unlock()
+	c.TriggerKeyWatchers(ctx, new.GetKey())
+}
+
+func (c *GeoFencePolicyCache) Delete(ctx context.Context, in *GeoFencePolicy, modRev int64) {
+	c.DeleteCondFunc(ctx, in, modRev, func(old *GeoFencePolicy) bool {
+		return true
+	})
+}
+
+func (c *GeoFencePolicyCache) DeleteCondFunc(ctx context.Context, in *GeoFencePolicy, modRev int64, condFunc func(old *GeoFencePolicy) bool) {
+	c.Mux.Lock()
+	var old *GeoFencePolicy
+	oldData, found := c.Objs[in.GetKeyVal()]
+	if found {
+		old = oldData.Obj
+		if !condFunc(old) {
+			c.Mux.Unlock()
+			return
+		}
+	}
+	delete(c.Objs, in.GetKeyVal())
+	log.SpanLog(ctx, log.DebugLevelApi, "cache delete", "key", in.GetKeyVal())
+	c.Mux.Unlock()
+	obj := old
+	if obj == nil {
+		obj = in
+	}
+	for _, cb := range c.NotifyCbs {
+		if cb != nil {
+			cb(ctx, obj, modRev)
+		}
+	}
+	if old != nil {
+		for _, cb := range c.DeletedCbs {
+			cb(ctx, old)
+		}
+	}
+	for _, cb := range c.DeletedKeyCbs {
+		cb(ctx, in.GetKey())
+	}
+	c.TriggerKeyWatchers(ctx, in.GetKey())
+}
+
+func (c *GeoFencePolicyCache) Prune(ctx context.Context, validKeys map[PolicyKey]struct{}) {
+	log.SpanLog(ctx, log.DebugLevelApi, "Prune GeoFencePolicy", "numValidKeys", len(validKeys))
+	notify := make(map[PolicyKey]*GeoFencePolicyCacheData)
+	c.Mux.Lock()
+	for key, _ := range c.Objs {
+		if _, ok := validKeys[key]; !ok {
+			if len(c.NotifyCbs) > 0 || len(c.DeletedKeyCbs) > 0 || len(c.DeletedCbs) > 0 {
+				notify[key] = c.Objs[key]
+			}
+			delete(c.Objs, key)
+		}
+	}
+	c.Mux.Unlock()
+	for key, old := range notify {
+		obj := old.Obj
+		if obj == nil {
+			obj = &GeoFencePolicy{}
+			obj.SetKey(&key)
+		}
+		for _, cb := range c.NotifyCbs {
+			if cb != nil {
+				cb(ctx, obj, old.ModRev)
+			}
+		}
+		for _, cb := range c.DeletedKeyCbs {
+			cb(ctx, &key)
+		}
+		if old.Obj != nil {
+			for _, cb := range c.DeletedCbs {
+				cb(ctx, old.Obj)
+			}
+		}
+		c.TriggerKeyWatchers(ctx, &key)
+	}
+}
+
+func (c *GeoFencePolicyCache) GetCount() int {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	return len(c.Objs)
+}
+
+func (c *GeoFencePolicyCache) Flush(ctx context.Context, notifyId int64) {
+}
+
+func (c *GeoFencePolicyCache) Show(filter *GeoFencePolicy, cb func(ret *GeoFencePolicy) error) error {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	for _, data := range c.Objs {
+		if !data.Obj.Matches(filter, MatchFilter()) {
+			continue
+		}
+		err := cb(data.Obj)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func GeoFencePolicyGenericNotifyCb(fn func(key *PolicyKey, old *GeoFencePolicy)) func(objstore.ObjKey, objstore.Obj) {
+	return func(objkey objstore.ObjKey, obj objstore.Obj) {
+		fn(objkey.(*PolicyKey), obj.(*GeoFencePolicy))
+	}
+}
+
+func (c *GeoFencePolicyCache) SetNotifyCb(fn func(ctx context.Context, obj *GeoFencePolicy, modRev int64)) {
+	c.NotifyCbs = []func(ctx context.Context, obj *GeoFencePolicy, modRev int64){fn}
+}
+
+func (c *GeoFencePolicyCache) SetUpdatedCb(fn func(ctx context.Context, old *GeoFencePolicy, new *GeoFencePolicy)) {
+	c.UpdatedCbs = []func(ctx context.Context, old *GeoFencePolicy, new *GeoFencePolicy){fn}
+}
+
+func (c *GeoFencePolicyCache) SetDeletedCb(fn func(ctx context.Context, old *GeoFencePolicy)) {
+	c.DeletedCbs = []func(ctx context.Context, old *GeoFencePolicy){fn}
+}
+
+func (c *GeoFencePolicyCache) SetUpdatedKeyCb(fn func(ctx context.Context, key *PolicyKey)) {
+	c.UpdatedKeyCbs = []func(ctx context.Context, key *PolicyKey){fn}
+}
+
+func (c *GeoFencePolicyCache) SetDeletedKeyCb(fn func(ctx context.Context, key *PolicyKey)) {
+	c.DeletedKeyCbs = []func(ctx context.Context, key *PolicyKey){fn}
+}
+
+func (c *GeoFencePolicyCache) AddUpdatedCb(fn func(ctx context.Context, old *GeoFencePolicy, new *GeoFencePolicy)) {
+	c.UpdatedCbs = append(c.UpdatedCbs, fn)
+}
+
+func (c *GeoFencePolicyCache) AddDeletedCb(fn func(ctx context.Context, old *GeoFencePolicy)) {
+	c.DeletedCbs = append(c.DeletedCbs, fn)
+}
+
+func (c *GeoFencePolicyCache) AddNotifyCb(fn func(ctx context.Context, obj *GeoFencePolicy, modRev int64)) {
+	c.NotifyCbs = append(c.NotifyCbs, fn)
+}
+
+func (c *GeoFencePolicyCache) AddUpdatedKeyCb(fn func(ctx context.Context, key *PolicyKey)) {
+	c.UpdatedKeyCbs = append(c.UpdatedKeyCbs, fn)
+}
+
+func (c *GeoFencePolicyCache) AddDeletedKeyCb(fn func(ctx context.Context, key *PolicyKey)) {
+	c.DeletedKeyCbs = append(c.DeletedKeyCbs, fn)
+}
+
+func (c *GeoFencePolicyCache) SetFlushAll() {
+	c.FlushAll = true
+}
+
+func (c *GeoFencePolicyCache) WatchKey(key *PolicyKey, cb func(ctx context.Context)) context.CancelFunc {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	list, ok := c.KeyWatchers[*key]
+	if !ok {
+		list = make([]*GeoFencePolicyKeyWatcher, 0)
+	}
+	watcher := GeoFencePolicyKeyWatcher{cb: cb}
+	c.KeyWatchers[*key] = append(list, &watcher)
+	log.DebugLog(log.DebugLevelApi, "Watching GeoFencePolicy", "key", key)
+	return func() {
+		c.Mux.Lock()
+		defer c.Mux.Unlock()
+		list, ok := c.KeyWatchers[*key]
+		if !ok {
+			return
+		}
+		for ii, _ := range list {
+			if list[ii] != &watcher {
+				continue
+			}
+			if len(list) == 1 {
+				delete(c.KeyWatchers, *key)
+				return
+			}
+			list[ii] = list[len(list)-1]
+			list[len(list)-1] = nil
+			c.KeyWatchers[*key] = list[:len(list)-1]
+			return
+		}
+	}
+}
+
+func (c *GeoFencePolicyCache) TriggerKeyWatchers(ctx context.Context, key *PolicyKey) {
+	watchers := make([]*GeoFencePolicyKeyWatcher, 0)
+	c.Mux.Lock()
+	if list, ok := c.KeyWatchers[*key]; ok {
+		watchers = append(watchers, list...)
+	}
+	c.Mux.Unlock()
+	for ii, _ := range watchers {
+		watchers[ii].cb(ctx)
+	}
+}
+
+// Note that we explicitly ignore the global revision number, because of the way
+// the notify framework sends updates (by hashing keys and doing lookups, instead
+// of sequentially through a history buffer), updates may be done out-of-order
+// or multiple updates compressed into one update, so the state of the cache at
+// any point in time may not by in sync with a particular database revision number.
+
+func (c *GeoFencePolicyCache) SyncUpdate(ctx context.Context, key, val []byte, rev, modRev int64) {
+	obj := GeoFencePolicy{}
+	err := json.Unmarshal(val, &obj)
+	if err != nil {
+		log.WarnLog("Failed to parse GeoFencePolicy data", "val", string(val), "err", err)
+		return
+	}
+	c.Update(ctx, &obj, modRev)
+	c.Mux.Lock()
+	if c.List != nil {
+		c.List[obj.GetKeyVal()] = struct{}{}
+	}
+	c.Mux.Unlock()
+}
+
+func (c *GeoFencePolicyCache) SyncDelete(ctx context.Context, key []byte, rev, modRev int64) {
+	obj := GeoFencePolicy{}
+	keystr := objstore.DbKeyPrefixRemove(string(key))
+	PolicyKeyStringParse(keystr, obj.GetKey())
+	c.Delete(ctx, &obj, modRev)
+}
+
+func (c *GeoFencePolicyCache) SyncListStart(ctx context.Context) {
+	c.List = make(map[PolicyKey]struct{})
+}
+
+func (c *GeoFencePolicyCache) SyncListEnd(ctx context.Context) {
+	deleted := make(map[PolicyKey]*GeoFencePolicyCacheData)
+	c.Mux.Lock()
+	for key, val := range c.Objs {
+		if _, found := c.List[key]; !found {
+			deleted[key] = val
+			delete(c.Objs, key)
+		}
+	}
+	c.List = nil
+	c.Mux.Unlock()
+	for key, val := range deleted {
+		obj := val.Obj
+		if obj == nil {
+			obj = &GeoFencePolicy{}
+			obj.SetKey(&key)
+		}
+		for _, cb := range c.NotifyCbs {
+			if cb != nil {
+				cb(ctx, obj, val.ModRev)
+			}
+		}
+		for _, cb := range c.DeletedKeyCbs {
+			cb(ctx, &key)
+		}
+		if val.Obj != nil {
+			for _, cb := range c.DeletedCbs {
+				cb(ctx, val.Obj)
+			}
+		}
+		c.TriggerKeyWatchers(ctx, &key)
+	}
+}
+
+func (s *GeoFencePolicyCache) InitCacheWithSync(sync DataSync) {
+	InitGeoFencePolicyCache(s)
+	s.InitSync(sync)
+}
+
+func (s *GeoFencePolicyCache) InitSync(sync DataSync) {
+	if sync != nil {
+		s.Store = NewGeoFencePolicyStore(sync.GetKVStore())
+		sync.RegisterCache(s)
+	}
+}
+
+func InitGeoFencePolicyCacheWithStore(cache *GeoFencePolicyCache, store GeoFencePolicyStore) {
+	InitGeoFencePolicyCache(cache)
+	cache.Store = store
+}
+
+func (c *GeoFencePolicyCache) UsesOrg(org string) bool {
+	c.Mux.Lock()
+	defer c.Mux.Unlock()
+	for key, _ := range c.Objs {
+		if key.Organization == org {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *GeoFencePolicy) GetObjKey() objstore.ObjKey {
+	return m.GetKey()
+}
+
+func (m *GeoFencePolicy) GetKey() *PolicyKey {
+	return &m.Key
+}
+
+func (m *GeoFencePolicy) GetKeyVal() PolicyKey {
+	return m.Key
+}
+
+func (m *GeoFencePolicy) SetKey(key *PolicyKey) {
+	m.Key = *key
+}
+
+func CmpSortGeoFencePolicy(a GeoFencePolicy, b GeoFencePolicy) bool {
+	return a.Key.GetKeyString() < b.Key.GetKeyString()
+}
+
+// Helper method to check that enums have valid values
+// NOTE: ValidateEnums checks all Fields even if some are not set
+func (m *GeoFencePolicy) ValidateEnums() error {
+	if err := m.Key.ValidateEnums(); err != nil {
+		return err
+	}
+	for _, e := range m.Fences {
+		if err := e.ValidateEnums(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *GeoFencePolicy) ClearTagged(tags map[string]struct{}) {
+	s.Key.ClearTagged(tags)
+	if s.Fences != nil {
+		for ii := 0; ii < len(s.Fences); ii++ {
+			s.Fences[ii].ClearTagged(tags)
+		}
+	}
+	if _, found := tags["nocmp"]; found {
+		s.DeletePrepare = false
+	}
+}
+
+func IgnoreGeoFencePolicyFields(taglist string) cmp.Option {
+	names := []string{}
+	tags := make(map[string]struct{})
+	for _, tag := range strings.Split(taglist, ",") {
+		tags[tag] = struct{}{}
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "DeletePrepare")
+	}
+	return cmpopts.IgnoreFields(GeoFencePolicy{}, names...)
+}
+
+func (m *GeoFencePolicyHistory) Clone() *GeoFencePolicyHistory {
+	cp := &GeoFencePolicyHistory{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *GeoFencePolicyHistory) AddObjFences(vals ...GeoFence) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Obj.Fences {
+		cur[v.String()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.String()]; found {
+			continue // duplicate
+		}
+		m.Obj.Fences = append(m.Obj.Fences, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *GeoFencePolicyHistory) RemoveObjFences(vals ...GeoFence) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.String()] = struct{}{}
+	}
+	for i := len(m.Obj.Fences); i >= 0; i-- {
+		if _, found := remove[m.Obj.Fences[i].String()]; found {
+			m.Obj.Fences = append(m.Obj.Fences[:i], m.Obj.Fences[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *GeoFencePolicyHistory) CopyInFields(src *GeoFencePolicyHistory) int {
+	updateListAction := "replace"
+	changed := 0
+	if m.Revision != src.Revision {
+		m.Revision = src.Revision
+		changed++
+	}
+	if m.Obj.Key.Organization != src.Obj.Key.Organization {
+		m.Obj.Key.Organization = src.Obj.Key.Organization
+		changed++
+	}
+	if m.Obj.Key.Name != src.Obj.Key.Name {
+		m.Obj.Key.Name = src.Obj.Key.Name
+		changed++
+	}
+	if src.Obj.Fences != nil {
+		if updateListAction == "add" {
+			changed += m.AddObjFences(src.Obj.Fences...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveObjFences(src.Obj.Fences...)
+		} else {
+			m.Obj.Fences = make([]GeoFence, 0)
+			for k1, _ := range src.Obj.Fences {
+				m.Obj.Fences = append(m.Obj.Fences, *src.Obj.Fences[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Obj.Fences != nil {
+		m.Obj.Fences = nil
+		changed++
+	}
+	if m.Obj.DeletePrepare != src.Obj.DeletePrepare {
+		m.Obj.DeletePrepare = src.Obj.DeletePrepare
+		changed++
+	}
+	return changed
+}
+
+func (m *GeoFencePolicyHistory) DeepCopyIn(src *GeoFencePolicyHistory) {
+	m.Revision = src.Revision
+	m.Obj.DeepCopyIn(&src.Obj)
+}
+
+// Helper method to check that enums have valid values
+func (m *GeoFencePolicyHistory) ValidateEnums() error {
+	if err := m.Obj.ValidateEnums(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *GeoFencePolicyHistory) ClearTagged(tags map[string]struct{}) {
+	s.Obj.ClearTagged(tags)
+}
+
+func IgnoreGeoFencePolicyHistoryFields(taglist string) cmp.Option {
+	names := []string{}
+	tags := make(map[string]struct{})
+	for _, tag := range strings.Split(taglist, ",") {
+		tags[tag] = struct{}{}
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Obj.DeletePrepare")
+	}
+	return cmpopts.IgnoreFields(GeoFencePolicyHistory{}, names...)
+}
+
+func (m *GeoFencePolicy) IsValidArgsForCreateGeoFencePolicy() error {
+	if m.DeletePrepare != false {
+		return fmt.Errorf("Invalid field specified: DeletePrepare, this field is only for internal use")
+	}
+	return nil
+}
+
+func (m *GeoFencePolicy) IsValidArgsForDeleteGeoFencePolicy() error {
+	if m.DeletePrepare != false {
+		return fmt.Errorf("Invalid field specified: DeletePrepare, this field is only for internal use")
+	}
+	return nil
+}
+
+func (m *GeoFencePolicy) IsValidArgsForUpdateGeoFencePolicy() error {
+	if m.DeletePrepare != false {
+		return fmt.Errorf("Invalid field specified: DeletePrepare, this field is only for internal use")
+	}
+	return nil
+}
+
+func (m *GeoFence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGeofencepolicy(uint64(l))
+	}
+	if len(m.Polygon) > 0 {
+		for _, e := range m.Polygon {
+			l = e.Size()
+			n += 1 + l + sovGeofencepolicy(uint64(l))
+		}
+	}
+	if len(m.CountryCodes) > 0 {
+		for _, s := range m.CountryCodes {
+			l = len(s)
+			n += 1 + l + sovGeofencepolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GeoFencePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovGeofencepolicy(uint64(l))
+		}
+	}
+	l = m.Key.Size()
+	n += 1 + l + sovGeofencepolicy(uint64(l))
+	if len(m.Fences) > 0 {
+		for _, e := range m.Fences {
+			l = e.Size()
+			n += 1 + l + sovGeofencepolicy(uint64(l))
+		}
+	}
+	if m.DeletePrepare {
+		n += 2
+	}
+	return n
+}
+
+func (m *GeoFencePolicyHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		n += 1 + sovGeofencepolicy(uint64(m.Revision))
+	}
+	l = m.Obj.Size()
+	n += 1 + l + sovGeofencepolicy(uint64(l))
+	return n
+}
+
+func sovGeofencepolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGeofencepolicy(x uint64) (n int) {
+	return sovGeofencepolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GeoFence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGeofencepolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeoFence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeoFence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGeofencepolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGeofencepolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGeofencepolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polygon", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGeofencepolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGeofencepolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGeofencepolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Polygon = append(m.Polygon, distributed_match_engine.Loc{})
+			if err := m.Polygon[len(m.Polygon)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountryCodes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGeofencepolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGeofencepolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGeofencepolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CountryCodes = append(m.CountryCodes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGeofencepolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGeofencepolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeoFencePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGeofencepolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeoFencePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeoFencePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGeofencepolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGeofencepolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGeofencepolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGeofencepolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGeofencepolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGeofencepolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGeofencepolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGeofencepolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGeofencepolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fences = append(m.Fences, GeoFence{})
+			if err := m.Fences[len(m.Fences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletePrepare", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGeofencepolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeletePrepare = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGeofencepolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGeofencepolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeoFencePolicyHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGeofencepolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeoFencePolicyHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeoFencePolicyHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGeofencepolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Obj", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGeofencepolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGeofencepolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGeofencepolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Obj.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGeofencepolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGeofencepolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGeofencepolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGeofencepolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGeofencepolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGeofencepolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGeofencepolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGeofencepolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGeofencepolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGeofencepolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGeofencepolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGeofencepolicy = fmt.Errorf("proto: unexpected end of group")
+)
@@ -20,6 +20,7 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	"strconv"
+	strings "strings"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -45,8 +46,11 @@ const (
 	NoticeAction_DELETE NoticeAction = 2
 	// Version exchange negotitation message
 	NoticeAction_VERSION NoticeAction = 3
-	// Initial send all finished message
+	// Initial send all finished message. Mod_rev is set to the
+	// revision the sent data is in sync with, if known.
 	NoticeAction_SENDALL_END NoticeAction = 4
+	// Batch of notices, see NoticeBatch
+	NoticeAction_BATCH NoticeAction = 5
 )
 
 var NoticeAction_name = map[int32]string{
@@ -55,6 +59,7 @@ var NoticeAction_name = map[int32]string{
 	2: "DELETE",
 	3: "VERSION",
 	4: "SENDALL_END",
+	5: "BATCH",
 }
 
 var NoticeAction_value = map[string]int32{
@@ -63,6 +68,7 @@ var NoticeAction_value = map[string]int32{
 	"DELETE":      2,
 	"VERSION":     3,
 	"SENDALL_END": 4,
+	"BATCH":       5,
 }
 
 func (x NoticeAction) String() string {
@@ -73,6 +79,77 @@ func (NoticeAction) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_642492014393dbdb, []int{0}
 }
 
+// NoticeCompression is the compression used for batched notices.
+type NoticeCompression int32
+
+const (
+	// No compression
+	NoticeCompression_NOTICE_COMPRESSION_NONE NoticeCompression = 0
+	// Gzip compression
+	NoticeCompression_NOTICE_COMPRESSION_GZIP NoticeCompression = 1
+	// Zstandard compression
+	NoticeCompression_NOTICE_COMPRESSION_ZSTD NoticeCompression = 2
+)
+
+var NoticeCompression_name = map[int32]string{
+	0: "NOTICE_COMPRESSION_NONE",
+	1: "NOTICE_COMPRESSION_GZIP",
+	2: "NOTICE_COMPRESSION_ZSTD",
+}
+
+var NoticeCompression_value = map[string]int32{
+	"NOTICE_COMPRESSION_NONE": 0,
+	"NOTICE_COMPRESSION_GZIP": 1,
+	"NOTICE_COMPRESSION_ZSTD": 2,
+}
+
+func (x NoticeCompression) String() string {
+	return proto.EnumName(NoticeCompression_name, int32(x))
+}
+
+func (NoticeCompression) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_642492014393dbdb, []int{1}
+}
+
+// NoticeBatch is a set of notices sent in a single BATCH notice.
+type NoticeBatch struct {
+	// Notices, in the order they were sent
+	Notices []Notice `protobuf:"bytes,1,rep,name=notices,proto3" json:"notices"`
+}
+
+func (m *NoticeBatch) Reset()         { *m = NoticeBatch{} }
+func (m *NoticeBatch) String() string { return proto.CompactTextString(m) }
+func (*NoticeBatch) ProtoMessage()    {}
+func (*NoticeBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_642492014393dbdb, []int{0}
+}
+func (m *NoticeBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NoticeBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NoticeBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NoticeBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NoticeBatch.Merge(m, src)
+}
+func (m *NoticeBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *NoticeBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_NoticeBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NoticeBatch proto.InternalMessageInfo
+
 type Notice struct {
 	// Action to perform
 	Action NoticeAction `protobuf:"varint,1,opt,name=action,proto3,enum=edgeproto.NoticeAction" json:"action,omitempty"`
@@ -92,13 +169,24 @@ type Notice struct {
 	Tags map[string]string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Filter by federated cloudlet
 	FilterFederatedCloudlet bool `protobuf:"varint,9,opt,name=filter_federated_cloudlet,json=filterFederatedCloudlet,proto3" json:"filter_federated_cloudlet,omitempty"`
+	// Revisions per wanted object that the client is in sync with,
+	// so that only changes since then need to be sent. In the reply,
+	// the revisions the server will send changes since.
+	SyncRevs map[string]int64 `protobuf:"bytes,10,rep,name=sync_revs,json=syncRevs,proto3" json:"sync_revs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Compression for batched notices. In the version exchange, the
+	// compression requested by the client, and in the reply, the
+	// compression that will be used by both sides.
+	Compression NoticeCompression `protobuf:"varint,11,opt,name=compression,proto3,enum=edgeproto.NoticeCompression" json:"compression,omitempty"`
+	// Marshaled NoticeBatch for the BATCH action, compressed
+	// according to compression.
+	Batch []byte `protobuf:"bytes,12,opt,name=batch,proto3" json:"batch,omitempty"`
 }
 
 func (m *Notice) Reset()         { *m = Notice{} }
 func (m *Notice) String() string { return proto.CompactTextString(m) }
 func (*Notice) ProtoMessage()    {}
 func (*Notice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_642492014393dbdb, []int{0}
+	return fileDescriptor_642492014393dbdb, []int{1}
 }
 func (m *Notice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -129,44 +217,57 @@ var xxx_messageInfo_Notice proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterEnum("edgeproto.NoticeAction", NoticeAction_name, NoticeAction_value)
+	proto.RegisterEnum("edgeproto.NoticeCompression", NoticeCompression_name, NoticeCompression_value)
+	proto.RegisterType((*NoticeBatch)(nil), "edgeproto.NoticeBatch")
 	proto.RegisterType((*Notice)(nil), "edgeproto.Notice")
+	proto.RegisterMapType((map[string]int64)(nil), "edgeproto.Notice.SyncRevsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "edgeproto.Notice.TagsEntry")
 }
 
 func init() { proto.RegisterFile("notice.proto", fileDescriptor_642492014393dbdb) }
 
 var fileDescriptor_642492014393dbdb = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x41, 0x8f, 0xd2, 0x40,
-	0x14, 0xc7, 0x3b, 0xdb, 0x6e, 0xa1, 0x0f, 0xd4, 0xee, 0x48, 0x42, 0x97, 0x35, 0xb5, 0xd9, 0x53,
-	0x63, 0x4c, 0x31, 0x78, 0xd0, 0x70, 0x63, 0xa5, 0x1a, 0x23, 0x29, 0x66, 0x40, 0xaf, 0x64, 0xa0,
-	0x43, 0xc3, 0x5a, 0x3a, 0xa4, 0x1d, 0x30, 0xfd, 0x16, 0x7e, 0x05, 0xbf, 0x0d, 0xc7, 0x3d, 0x7a,
-	0x32, 0x0a, 0x5f, 0xc4, 0x74, 0x0a, 0xc4, 0x84, 0xdb, 0xff, 0xbd, 0xf7, 0x9f, 0x7f, 0x7e, 0xef,
-	0x65, 0xa0, 0x9e, 0x70, 0xb1, 0x98, 0x31, 0x6f, 0x95, 0x72, 0xc1, 0xb1, 0xc1, 0xc2, 0x88, 0x49,
-	0xd9, 0xba, 0x8e, 0x38, 0x8f, 0x62, 0xd6, 0x96, 0xd5, 0x74, 0x3d, 0x6f, 0xd3, 0x24, 0x2f, 0x5d,
-	0xad, 0x46, 0xc4, 0x23, 0x2e, 0x65, 0xbb, 0x50, 0x65, 0xf7, 0xf6, 0xa7, 0x0a, 0x7a, 0x20, 0xc3,
-	0x70, 0x1b, 0x74, 0x3a, 0x13, 0x0b, 0x9e, 0x58, 0xc8, 0x41, 0xee, 0xe3, 0x4e, 0xd3, 0x3b, 0xe5,
-	0x7a, 0xa5, 0xa5, 0x27, 0xc7, 0xe4, 0x60, 0xc3, 0x16, 0x54, 0x36, 0x2c, 0xcd, 0x8a, 0x17, 0x17,
-	0x0e, 0x72, 0x1f, 0x91, 0x63, 0x89, 0x5f, 0x82, 0x4a, 0x93, 0xdc, 0x52, 0x1d, 0xe4, 0xd6, 0x3a,
-	0x0d, 0xaf, 0x84, 0xf2, 0x8e, 0x50, 0x5e, 0x2f, 0xc9, 0xef, 0xb4, 0xed, 0xef, 0xe7, 0x0a, 0x29,
-	0x6c, 0xf8, 0x06, 0x8c, 0xef, 0x34, 0x11, 0x13, 0x3e, 0xbd, 0xcf, 0x2c, 0xcd, 0x51, 0x5d, 0x83,
-	0x54, 0x8b, 0xc6, 0x70, 0x7a, 0x9f, 0x61, 0x0f, 0x9e, 0xce, 0x17, 0xb1, 0x60, 0xe9, 0x64, 0x16,
-	0xf3, 0x75, 0x18, 0x33, 0x31, 0xf9, 0xc6, 0x72, 0xeb, 0xd2, 0x41, 0x6e, 0x95, 0x5c, 0x95, 0xa3,
-	0x77, 0x87, 0xc9, 0x27, 0x96, 0x63, 0x0c, 0x5a, 0xb6, 0xa2, 0x89, 0xa5, 0x3b, 0xc8, 0x35, 0x88,
-	0xd4, 0xb8, 0x09, 0x95, 0x25, 0x0f, 0x27, 0x29, 0xdb, 0x58, 0x15, 0x07, 0xb9, 0x2a, 0xd1, 0x97,
-	0x3c, 0x24, 0x6c, 0x83, 0xdb, 0xa0, 0x09, 0x1a, 0x65, 0x56, 0xd5, 0x51, 0xdd, 0x5a, 0xe7, 0xe6,
-	0x6c, 0x61, 0x6f, 0x4c, 0xa3, 0xcc, 0x4f, 0x44, 0x9a, 0x13, 0x69, 0xc4, 0x5d, 0xb8, 0x3e, 0xd0,
-	0xcc, 0x59, 0xc8, 0x52, 0x2a, 0x58, 0x78, 0xe2, 0xb2, 0x0c, 0xc9, 0xd4, 0x2c, 0x0d, 0xef, 0x8f,
-	0xf3, 0x23, 0x5c, 0xeb, 0x0d, 0x18, 0xa7, 0x38, 0x6c, 0x82, 0x5a, 0xac, 0x81, 0x24, 0x65, 0x21,
-	0x71, 0x03, 0x2e, 0x37, 0x34, 0x5e, 0x33, 0x79, 0x4b, 0x83, 0x94, 0x45, 0xf7, 0xe2, 0x2d, 0x7a,
-	0x11, 0x40, 0xfd, 0xff, 0xfb, 0xe3, 0x2a, 0x68, 0xc1, 0x30, 0xf0, 0x4d, 0x05, 0x03, 0xe8, 0x5f,
-	0x3e, 0xf7, 0x7b, 0x63, 0xdf, 0x44, 0x85, 0xee, 0xfb, 0x03, 0x7f, 0xec, 0x9b, 0x17, 0xb8, 0x06,
-	0x95, 0xaf, 0x3e, 0x19, 0x7d, 0x1c, 0x06, 0xa6, 0x8a, 0x9f, 0x40, 0x6d, 0xe4, 0x07, 0xfd, 0xde,
-	0x60, 0x30, 0xf1, 0x83, 0xbe, 0xa9, 0x75, 0x3e, 0x80, 0x51, 0xe4, 0xcd, 0xf3, 0xde, 0x6a, 0x81,
-	0xbb, 0x50, 0x1f, 0x89, 0x94, 0xd1, 0xe5, 0xe1, 0x17, 0x5c, 0x9d, 0x1d, 0xa1, 0x75, 0xde, 0xba,
-	0x55, 0x5c, 0xf4, 0x0a, 0xdd, 0x3d, 0xdb, 0xfe, 0xb5, 0x95, 0xed, 0xce, 0x46, 0x0f, 0x3b, 0x1b,
-	0xfd, 0xd9, 0xd9, 0xe8, 0xc7, 0xde, 0x56, 0x1e, 0xf6, 0xb6, 0xf2, 0x6b, 0x6f, 0x2b, 0x53, 0x5d,
-	0xbe, 0x78, 0xfd, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x48, 0xfc, 0x58, 0xad, 0x02, 0x00, 0x00,
+	// 635 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xf6, 0xc6, 0xce, 0x1f, 0x8f, 0xd3, 0xdf, 0xcf, 0x5d, 0x2a, 0xc5, 0x4d, 0x2b, 0xd7, 0xea,
+	0xc9, 0xaa, 0x90, 0x03, 0xe1, 0x00, 0x2a, 0x08, 0x91, 0x26, 0xa6, 0x54, 0x14, 0xa7, 0xda, 0x04,
+	0x24, 0x7a, 0xb1, 0x1c, 0x67, 0x63, 0x52, 0x12, 0x3b, 0xb2, 0xdd, 0x20, 0xbf, 0x05, 0x8f, 0xd5,
+	0x63, 0x8f, 0x9c, 0x10, 0xb4, 0x6f, 0xc0, 0x13, 0x20, 0xaf, 0x9d, 0x12, 0x64, 0x10, 0xb7, 0x99,
+	0xfd, 0xbe, 0x6f, 0xe6, 0x9b, 0xd9, 0x5d, 0xa8, 0xfb, 0x41, 0x3c, 0x75, 0xa9, 0xb1, 0x08, 0x83,
+	0x38, 0xc0, 0x22, 0x1d, 0x7b, 0x94, 0x85, 0xcd, 0x6d, 0x2f, 0x08, 0xbc, 0x19, 0x6d, 0xb1, 0x6c,
+	0x74, 0x39, 0x69, 0x39, 0x7e, 0x92, 0xb1, 0x9a, 0x5b, 0x5e, 0xe0, 0x05, 0x2c, 0x6c, 0xa5, 0x51,
+	0x76, 0xba, 0xff, 0x02, 0x24, 0x8b, 0xd5, 0x3a, 0x72, 0x62, 0xf7, 0x03, 0x7e, 0x08, 0xd5, 0xac,
+	0x74, 0xa4, 0x20, 0x8d, 0xd7, 0xa5, 0xf6, 0xa6, 0x71, 0x57, 0xdc, 0xc8, 0x89, 0xc2, 0xd5, 0xd7,
+	0x3d, 0x8e, 0xac, 0x78, 0xfb, 0x3f, 0x04, 0xa8, 0x64, 0x08, 0x6e, 0x41, 0xc5, 0x71, 0xe3, 0x69,
+	0xe0, 0x2b, 0x48, 0x43, 0xfa, 0x7f, 0xed, 0x46, 0x41, 0xdc, 0x61, 0x30, 0xc9, 0x69, 0x58, 0x81,
+	0xea, 0x92, 0x86, 0x51, 0xaa, 0x28, 0x69, 0x48, 0xdf, 0x20, 0xab, 0x14, 0xdf, 0x07, 0xde, 0xf1,
+	0x13, 0x85, 0xd7, 0x90, 0x2e, 0xb5, 0xb7, 0x8c, 0x6c, 0x2c, 0x63, 0x35, 0x96, 0xd1, 0xf1, 0x93,
+	0xdc, 0x47, 0x4a, 0xc3, 0x3b, 0x20, 0x7e, 0x72, 0xfc, 0xd8, 0x0e, 0x46, 0x17, 0x91, 0x22, 0x68,
+	0xbc, 0x2e, 0x92, 0x5a, 0x7a, 0xd0, 0x1f, 0x5d, 0x44, 0xd8, 0x80, 0x7b, 0x93, 0xe9, 0x2c, 0xa6,
+	0xa1, 0xed, 0xce, 0x82, 0xcb, 0xf1, 0x8c, 0xc6, 0xf6, 0x47, 0x9a, 0x28, 0x65, 0x0d, 0xe9, 0x35,
+	0xb2, 0x99, 0x41, 0xdd, 0x1c, 0x79, 0x4d, 0x13, 0x8c, 0x41, 0x88, 0x16, 0x8e, 0xaf, 0x54, 0x34,
+	0xa4, 0x8b, 0x84, 0xc5, 0xb8, 0x01, 0xd5, 0x79, 0x30, 0xb6, 0x43, 0xba, 0x54, 0xaa, 0x1a, 0xd2,
+	0x79, 0x52, 0x99, 0x07, 0x63, 0x42, 0x97, 0xb8, 0x05, 0x42, 0xec, 0x78, 0x91, 0x52, 0x63, 0xdb,
+	0xda, 0x29, 0x0c, 0x6c, 0x0c, 0x1d, 0x2f, 0x32, 0xfd, 0x38, 0x4c, 0x08, 0x23, 0xe2, 0x43, 0xd8,
+	0xce, 0xdd, 0x4c, 0xe8, 0x98, 0x86, 0x4e, 0x4c, 0xc7, 0x77, 0xbe, 0x14, 0x91, 0x79, 0x6a, 0x64,
+	0x84, 0x97, 0x2b, 0x7c, 0x65, 0x0e, 0x3f, 0x03, 0x31, 0x4a, 0x7c, 0x37, 0xb5, 0x11, 0x29, 0xc0,
+	0x3a, 0xee, 0x15, 0x3b, 0x0e, 0x12, 0xdf, 0x25, 0x74, 0x99, 0x77, 0xad, 0x45, 0x79, 0x8a, 0x9f,
+	0x83, 0xe4, 0x06, 0xf3, 0x45, 0x48, 0x23, 0xb6, 0x70, 0x89, 0x5d, 0xd1, 0x6e, 0x41, 0xdf, 0xfd,
+	0xc5, 0x21, 0xeb, 0x02, 0xbc, 0x05, 0xe5, 0x51, 0xfa, 0x48, 0x94, 0xba, 0x86, 0xf4, 0x3a, 0xc9,
+	0x92, 0xe6, 0x63, 0x10, 0xef, 0x46, 0xc4, 0x32, 0xf0, 0xe9, 0x6a, 0x11, 0xdb, 0x5c, 0x1a, 0xa6,
+	0xa2, 0xa5, 0x33, 0xbb, 0xa4, 0xec, 0x7e, 0x45, 0x92, 0x25, 0x87, 0xa5, 0x27, 0xa8, 0xf9, 0x14,
+	0x36, 0x7e, 0x73, 0xfa, 0x2f, 0x31, 0xbf, 0x26, 0x3e, 0x78, 0x0f, 0xf5, 0xf5, 0x07, 0x85, 0x6b,
+	0x20, 0x58, 0x7d, 0xcb, 0x94, 0x39, 0x0c, 0x50, 0x79, 0x7b, 0xd6, 0xeb, 0x0c, 0x4d, 0x19, 0xa5,
+	0x71, 0xcf, 0x3c, 0x35, 0x87, 0xa6, 0x5c, 0xc2, 0x12, 0x54, 0xdf, 0x99, 0x64, 0x70, 0xd2, 0xb7,
+	0x64, 0x1e, 0xff, 0x0f, 0xd2, 0xc0, 0xb4, 0x7a, 0x9d, 0xd3, 0x53, 0xdb, 0xb4, 0x7a, 0xb2, 0x80,
+	0x45, 0x28, 0x1f, 0x75, 0x86, 0xdd, 0x57, 0x72, 0xf9, 0xe0, 0x02, 0x36, 0x0b, 0x8b, 0xc0, 0x3b,
+	0xd0, 0xb0, 0xfa, 0xc3, 0x93, 0xae, 0x69, 0x77, 0xfb, 0x6f, 0xce, 0x88, 0x39, 0x48, 0x0b, 0xd9,
+	0x79, 0xcb, 0x3f, 0x83, 0xc7, 0xe7, 0x27, 0x67, 0x32, 0xfa, 0x0b, 0x78, 0x3e, 0x18, 0xf6, 0xe4,
+	0x52, 0xfb, 0x18, 0xc4, 0xb4, 0xd7, 0x24, 0xe9, 0x2c, 0xa6, 0xf8, 0x10, 0xea, 0x83, 0x38, 0xa4,
+	0xce, 0x3c, 0xff, 0x4d, 0xc5, 0xaf, 0xd7, 0x2c, 0x1e, 0xed, 0x73, 0x3a, 0x7a, 0x80, 0x8e, 0x76,
+	0xaf, 0xbe, 0xab, 0xdc, 0xd5, 0x8d, 0x8a, 0xae, 0x6f, 0x54, 0xf4, 0xed, 0x46, 0x45, 0x9f, 0x6f,
+	0x55, 0xee, 0xfa, 0x56, 0xe5, 0xbe, 0xdc, 0xaa, 0xdc, 0xa8, 0xc2, 0x14, 0x8f, 0x7e, 0x06, 0x00,
+	0x00, 0xff, 0xff, 0xd7, 0xc7, 0xc7, 0x82, 0x37, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -283,6 +384,43 @@ var _NotifyApi_serviceDesc = grpc.ServiceDesc{
 	Metadata: "notice.proto",
 }
 
+func (m *NoticeBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NoticeBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NoticeBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Notices) > 0 {
+		for iNdEx := len(m.Notices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Notices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNotice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Notice) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -303,6 +441,35 @@ func (m *Notice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Batch) > 0 {
+		i -= len(m.Batch)
+		copy(dAtA[i:], m.Batch)
+		i = encodeVarintNotice(dAtA, i, uint64(len(m.Batch)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Compression != 0 {
+		i = encodeVarintNotice(dAtA, i, uint64(m.Compression))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.SyncRevs) > 0 {
+		for k := range m.SyncRevs {
+			v := m.SyncRevs[k]
+			baseI := i
+			i = encodeVarintNotice(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintNotice(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintNotice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.FilterFederatedCloudlet {
 		i--
 		if m.FilterFederatedCloudlet {
@@ -397,6 +564,94 @@ func encodeVarintNotice(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *NoticeBatch) Clone() *NoticeBatch {
+	cp := &NoticeBatch{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *NoticeBatch) AddNotices(vals ...Notice) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Notices {
+		cur[v.String()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.String()]; found {
+			continue // duplicate
+		}
+		m.Notices = append(m.Notices, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *NoticeBatch) RemoveNotices(vals ...Notice) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.String()] = struct{}{}
+	}
+	for i := len(m.Notices); i >= 0; i-- {
+		if _, found := remove[m.Notices[i].String()]; found {
+			m.Notices = append(m.Notices[:i], m.Notices[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *NoticeBatch) CopyInFields(src *NoticeBatch) int {
+	updateListAction := "replace"
+	changed := 0
+	if src.Notices != nil {
+		if updateListAction == "add" {
+			changed += m.AddNotices(src.Notices...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveNotices(src.Notices...)
+		} else {
+			m.Notices = make([]Notice, 0)
+			for k0, _ := range src.Notices {
+				m.Notices = append(m.Notices, *src.Notices[k0].Clone())
+			}
+			changed++
+		}
+	} else if m.Notices != nil {
+		m.Notices = nil
+		changed++
+	}
+	return changed
+}
+
+func (m *NoticeBatch) DeepCopyIn(src *NoticeBatch) {
+	if src.Notices != nil {
+		m.Notices = make([]Notice, len(src.Notices), len(src.Notices))
+		for ii, s := range src.Notices {
+			m.Notices[ii].DeepCopyIn(&s)
+		}
+	} else {
+		m.Notices = nil
+	}
+}
+
+// Helper method to check that enums have valid values
+func (m *NoticeBatch) ValidateEnums() error {
+	for _, e := range m.Notices {
+		if err := e.ValidateEnums(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *NoticeBatch) ClearTagged(tags map[string]struct{}) {
+	if s.Notices != nil {
+		for ii := 0; ii < len(s.Notices); ii++ {
+			s.Notices[ii].ClearTagged(tags)
+		}
+	}
+}
+
 func (m *Notice) Clone() *Notice {
 	cp := &Notice{}
 	cp.DeepCopyIn(m)
@@ -507,6 +762,38 @@ func (m *Notice) CopyInFields(src *Notice) int {
 		m.FilterFederatedCloudlet = src.FilterFederatedCloudlet
 		changed++
 	}
+	if src.SyncRevs != nil {
+		if updateListAction == "add" {
+			for k0, v := range src.SyncRevs {
+				m.SyncRevs[k0] = v
+				changed++
+			}
+		} else if updateListAction == "remove" {
+			for k0, _ := range src.SyncRevs {
+				if _, ok := m.SyncRevs[k0]; ok {
+					delete(m.SyncRevs, k0)
+					changed++
+				}
+			}
+		} else {
+			m.SyncRevs = make(map[string]int64)
+			for k0, v := range src.SyncRevs {
+				m.SyncRevs[k0] = v
+			}
+			changed++
+		}
+	} else if m.SyncRevs != nil {
+		m.SyncRevs = nil
+		changed++
+	}
+	if m.Compression != src.Compression {
+		m.Compression = src.Compression
+		changed++
+	}
+	if src.Batch != nil {
+		m.Batch = src.Batch
+		changed++
+	}
 	return changed
 }
 
@@ -534,6 +821,16 @@ func (m *Notice) DeepCopyIn(src *Notice) {
 		m.Tags = nil
 	}
 	m.FilterFederatedCloudlet = src.FilterFederatedCloudlet
+	if src.SyncRevs != nil {
+		m.SyncRevs = make(map[string]int64)
+		for k, v := range src.SyncRevs {
+			m.SyncRevs[k] = v
+		}
+	} else {
+		m.SyncRevs = nil
+	}
+	m.Compression = src.Compression
+	m.Batch = src.Batch
 }
 
 // Helper method to check that enums have valid values
@@ -541,6 +838,9 @@ func (m *Notice) ValidateEnums() error {
 	if _, ok := NoticeAction_name[int32(m.Action)]; !ok {
 		return errors.New("invalid Action")
 	}
+	if _, ok := NoticeCompression_name[int32(m.Compression)]; !ok {
+		return errors.New("invalid Compression")
+	}
 	return nil
 }
 
@@ -553,6 +853,7 @@ var NoticeActionStrings = []string{
 	"DELETE",
 	"VERSION",
 	"SENDALL_END",
+	"BATCH",
 }
 
 const (
@@ -561,6 +862,7 @@ const (
 	NoticeActionDELETE      uint64 = 1 << 2
 	NoticeActionVERSION     uint64 = 1 << 3
 	NoticeActionSENDALL_END uint64 = 1 << 4
+	NoticeActionBATCH       uint64 = 1 << 5
 )
 
 var NoticeAction_CamelName = map[int32]string{
@@ -574,6 +876,8 @@ var NoticeAction_CamelName = map[int32]string{
 	3: "Version",
 	// SENDALL_END -> SendallEnd
 	4: "SendallEnd",
+	// BATCH -> Batch
+	5: "Batch",
 }
 var NoticeAction_CamelValue = map[string]int32{
 	"None":       0,
@@ -581,6 +885,7 @@ var NoticeAction_CamelValue = map[string]int32{
 	"Delete":     2,
 	"Version":    3,
 	"SendallEnd": 4,
+	"Batch":      5,
 }
 
 func ParseNoticeAction(data interface{}) (NoticeAction, error) {
@@ -663,10 +968,141 @@ func (e NoticeAction) MarshalJSON() ([]byte, error) {
 	str := proto.EnumName(NoticeAction_CamelName, int32(e))
 	return json.Marshal(str)
 }
+
+var NoticeCompressionStrings = []string{
+	"NOTICE_COMPRESSION_NONE",
+	"NOTICE_COMPRESSION_GZIP",
+	"NOTICE_COMPRESSION_ZSTD",
+}
+
+const (
+	NoticeCompressionNOTICE_COMPRESSION_NONE uint64 = 1 << 0
+	NoticeCompressionNOTICE_COMPRESSION_GZIP uint64 = 1 << 1
+	NoticeCompressionNOTICE_COMPRESSION_ZSTD uint64 = 1 << 2
+)
+
+var NoticeCompression_CamelName = map[int32]string{
+	// NOTICE_COMPRESSION_NONE -> NoticeCompressionNone
+	0: "NoticeCompressionNone",
+	// NOTICE_COMPRESSION_GZIP -> NoticeCompressionGzip
+	1: "NoticeCompressionGzip",
+	// NOTICE_COMPRESSION_ZSTD -> NoticeCompressionZstd
+	2: "NoticeCompressionZstd",
+}
+var NoticeCompression_CamelValue = map[string]int32{
+	"NoticeCompressionNone": 0,
+	"NoticeCompressionGzip": 1,
+	"NoticeCompressionZstd": 2,
+}
+
+func ParseNoticeCompression(data interface{}) (NoticeCompression, error) {
+	if val, ok := data.(NoticeCompression); ok {
+		return val, nil
+	} else if str, ok := data.(string); ok {
+		val, ok := NoticeCompression_CamelValue[util.CamelCase(str)]
+		if !ok {
+			// may have omitted common prefix
+			val, ok = NoticeCompression_CamelValue["NoticeCompression"+util.CamelCase(str)]
+		}
+		if !ok {
+			// may be int value instead of enum name
+			ival, err := strconv.Atoi(str)
+			val = int32(ival)
+			if err == nil {
+				_, ok = NoticeCompression_CamelName[val]
+			}
+		}
+		if !ok {
+			return NoticeCompression(0), fmt.Errorf("Invalid NoticeCompression value %q", str)
+		}
+		return NoticeCompression(val), nil
+	} else if ival, ok := data.(int32); ok {
+		if _, ok := NoticeCompression_CamelName[ival]; ok {
+			return NoticeCompression(ival), nil
+		} else {
+			return NoticeCompression(0), fmt.Errorf("Invalid NoticeCompression value %d", ival)
+		}
+	}
+	return NoticeCompression(0), fmt.Errorf("Invalid NoticeCompression value %v", data)
+}
+
+func (e *NoticeCompression) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	err := unmarshal(&str)
+	if err != nil {
+		return err
+	}
+	val, err := ParseNoticeCompression(str)
+	if err != nil {
+		return err
+	}
+	*e = val
+	return nil
+}
+
+func (e NoticeCompression) MarshalYAML() (interface{}, error) {
+	str := proto.EnumName(NoticeCompression_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "NoticeCompression")
+	return str, nil
+}
+
+// custom JSON encoding/decoding
+func (e *NoticeCompression) UnmarshalJSON(b []byte) error {
+	var str string
+	err := json.Unmarshal(b, &str)
+	if err == nil {
+		val, err := ParseNoticeCompression(str)
+		if err != nil {
+			return &json.UnmarshalTypeError{
+				Value: "string " + str,
+				Type:  reflect.TypeOf(NoticeCompression(0)),
+			}
+		}
+		*e = NoticeCompression(val)
+		return nil
+	}
+	var ival int32
+	err = json.Unmarshal(b, &ival)
+	if err == nil {
+		val, err := ParseNoticeCompression(ival)
+		if err == nil {
+			*e = val
+			return nil
+		}
+	}
+	return &json.UnmarshalTypeError{
+		Value: "value " + string(b),
+		Type:  reflect.TypeOf(NoticeCompression(0)),
+	}
+}
+
+func (e NoticeCompression) MarshalJSON() ([]byte, error) {
+	str := proto.EnumName(NoticeCompression_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "NoticeCompression")
+	return json.Marshal(str)
+}
+
+var NoticeCompressionCommonPrefix = "NoticeCompression"
+
 func (m *Notice) IsValidArgsForStreamNotice() error {
 	return nil
 }
 
+func (m *NoticeBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Notices) > 0 {
+		for _, e := range m.Notices {
+			l = e.Size()
+			n += 1 + l + sovNotice(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Notice) Size() (n int) {
 	if m == nil {
 		return 0
@@ -708,6 +1144,21 @@ func (m *Notice) Size() (n int) {
 	if m.FilterFederatedCloudlet {
 		n += 2
 	}
+	if len(m.SyncRevs) > 0 {
+		for k, v := range m.SyncRevs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovNotice(uint64(len(k))) + 1 + sovNotice(uint64(v))
+			n += mapEntrySize + 1 + sovNotice(uint64(mapEntrySize))
+		}
+	}
+	if m.Compression != 0 {
+		n += 1 + sovNotice(uint64(m.Compression))
+	}
+	l = len(m.Batch)
+	if l > 0 {
+		n += 1 + l + sovNotice(uint64(l))
+	}
 	return n
 }
 
@@ -717,6 +1168,90 @@ func sovNotice(x uint64) (n int) {
 func sozNotice(x uint64) (n int) {
 	return sovNotice(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *NoticeBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNotice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoticeBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoticeBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNotice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Notices = append(m.Notices, Notice{})
+			if err := m.Notices[len(m.Notices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNotice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNotice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Notice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1067,6 +1602,172 @@ func (m *Notice) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.FilterFederatedCloudlet = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncRevs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNotice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncRevs == nil {
+				m.SyncRevs = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNotice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNotice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthNotice
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthNotice
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNotice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipNotice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthNotice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SyncRevs[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			m.Compression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compression |= NoticeCompression(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNotice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batch = append(m.Batch[:0], dAtA[iNdEx:postIndex]...)
+			if m.Batch == nil {
+				m.Batch = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNotice(dAtA[iNdEx:])
@@ -19,6 +19,9 @@ import (
 	"errors"
 	fmt "fmt"
 	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
 	"sort"
 	"strconv"
 	strings "strings"
@@ -309,6 +312,11 @@ func (s *App) Validate(fmap objstore.FieldMap) error {
 	if err = validateCustomizationConfigs(s.Configs); err != nil {
 		return err
 	}
+	if s.FindCloudletScoreWeights != nil {
+		if err = s.FindCloudletScoreWeights.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -463,6 +471,24 @@ func (s *Cloudlet) Validate(fmap objstore.FieldMap) error {
 	return nil
 }
 
+func (s *MaintenanceWindow) Validate() error {
+	if s.StartTime.Seconds == 0 && s.StartTime.Nanos == 0 {
+		return errors.New("Maintenance window start time must be specified")
+	}
+	if s.EndTime.Seconds == 0 && s.EndTime.Nanos == 0 {
+		return errors.New("Maintenance window end time must be specified")
+	}
+	start := dme.TimestampToTime(s.StartTime)
+	end := dme.TimestampToTime(s.EndTime)
+	if !end.After(start) {
+		return errors.New("Maintenance window end time must be after the start time")
+	}
+	if s.FailoverLeadTime < 0 {
+		return errors.New("Maintenance window failover lead time cannot be negative")
+	}
+	return nil
+}
+
 func (key *ZoneKey) ValidateKey() error {
 	if !util.ValidName(key.Organization) {
 		return fmt.Errorf("Invalid zone organization name %s", key.Organization)
@@ -654,6 +680,9 @@ func (s *AppInst) Validate(fmap objstore.FieldMap) error {
 	if err := validateCustomizationConfigs(s.Configs); err != nil {
 		return err
 	}
+	if s.Replicas < 0 {
+		return errors.New("Replicas cannot be negative")
+	}
 	return nil
 }
 
@@ -806,6 +835,46 @@ func (s *AutoScalePolicy) Validate(fmap objstore.FieldMap) error {
 	return nil
 }
 
+var ReplicaAutoScaleMaxReplicas uint32 = 100
+
+func (s *ReplicaAutoScalePolicy) Validate() error {
+	if s.MinReplicas == 0 {
+		return errors.New("Min replicas must be greater than 0")
+	}
+	if s.MaxReplicas > ReplicaAutoScaleMaxReplicas {
+		return fmt.Errorf("Max replicas cannot exceed %d", ReplicaAutoScaleMaxReplicas)
+	}
+	if s.MaxReplicas <= s.MinReplicas {
+		return errors.New("Max replicas must be greater than Min replicas")
+	}
+	if s.TargetCpu == 0 && s.TargetMem == 0 && s.TargetActiveConnections == 0 {
+		return errors.New("One of target cpu or target mem or target active connections must be specified")
+	}
+	if s.TargetCpu > 100 {
+		return errors.New("Target cpu must be between 0 (disabled) and 100")
+	}
+	if s.TargetMem > 100 {
+		return errors.New("Target mem must be between 0 (disabled) and 100")
+	}
+	if s.TargetActiveConnections > 0 && (s.TargetCpu > 0 || s.TargetMem > 0) {
+		// cpu and mem are scaled by Kubernetes, active connections
+		// are scaled by shepherd, and they cannot both manage the
+		// same deployment.
+		return errors.New("Target active connections cannot be combined with target cpu or target mem")
+	}
+	if s.StabilizationWindowSec == 0 {
+		s.StabilizationWindowSec = DefaultStabilizationWindowSec
+	}
+	return nil
+}
+
+// UsesHorizontalPodAutoscaler returns true if replicas are scaled
+// by a Kubernetes HorizontalPodAutoscaler, otherwise they are
+// scaled by shepherd.
+func (s *ReplicaAutoScalePolicy) UsesHorizontalPodAutoscaler() bool {
+	return s.TargetActiveConnections == 0
+}
+
 func (s *AutoProvPolicy) Validate(fmap objstore.FieldMap) error {
 	if err := s.GetKey().ValidateKey(); err != nil {
 		return err
@@ -819,6 +888,83 @@ func (s *AutoProvPolicy) Validate(fmap objstore.FieldMap) error {
 	return nil
 }
 
+func (s *GeoFencePolicy) Validate(fmap objstore.FieldMap) error {
+	if err := s.GetKey().ValidateKey(); err != nil {
+		return err
+	}
+	if len(s.Fences) == 0 {
+		return errors.New("At least one geo-fence must be specified")
+	}
+	names := make(map[string]struct{})
+	for ii, fence := range s.Fences {
+		if fence.Name == "" {
+			return fmt.Errorf("Geo-fence %d name cannot be empty", ii)
+		}
+		if _, found := names[fence.Name]; found {
+			return fmt.Errorf("Duplicate geo-fence name %s", fence.Name)
+		}
+		names[fence.Name] = struct{}{}
+		if err := fence.Validate(); err != nil {
+			return fmt.Errorf("Invalid geo-fence %s, %s", fence.Name, err)
+		}
+	}
+	return nil
+}
+
+var countryCodeRegex = regexp.MustCompile("^[A-Z]{2}$")
+
+func (s *GeoFence) Validate() error {
+	if len(s.Polygon) == 0 && len(s.CountryCodes) == 0 {
+		return errors.New("polygon or country codes must be specified")
+	}
+	if len(s.Polygon) > 0 && len(s.Polygon) < 3 {
+		return errors.New("polygon must have at least 3 points")
+	}
+	for _, loc := range s.Polygon {
+		if !util.IsLatitudeValid(loc.Latitude) {
+			return fmt.Errorf("invalid polygon latitude %v", loc.Latitude)
+		}
+		if !util.IsLongitudeValid(loc.Longitude) {
+			return fmt.Errorf("invalid polygon longitude %v", loc.Longitude)
+		}
+	}
+	for _, cc := range s.CountryCodes {
+		if !countryCodeRegex.MatchString(cc) {
+			return fmt.Errorf("invalid country code %q, must be an upper case ISO 3166-1 alpha-2 code", cc)
+		}
+	}
+	return nil
+}
+
+func (s *AppRollout) Validate(fmap objstore.FieldMap) error {
+	if err := s.GetKey().ValidateKey(); err != nil {
+		return err
+	}
+	if s.WavesByZone && len(s.WavePercentages) > 0 {
+		return errors.New("Wave percentages cannot be specified for waves by zone")
+	}
+	if !s.WavesByZone && len(s.Zones) > 0 {
+		return errors.New("Zones can only be specified for waves by zone")
+	}
+	var last uint32
+	for _, pct := range s.WavePercentages {
+		if pct == 0 || pct > 100 {
+			return fmt.Errorf("Invalid wave percentage %d, must be between 1 and 100", pct)
+		}
+		if pct <= last {
+			return errors.New("Wave percentages must be increasing")
+		}
+		last = pct
+	}
+	if len(s.WavePercentages) > 0 && last != 100 {
+		return errors.New("Last wave percentage must be 100")
+	}
+	if s.WaveSoakTime < 0 {
+		return errors.New("Wave soak time cannot be negative")
+	}
+	return nil
+}
+
 func (s *AutoProvInfo) Validate(fmap objstore.FieldMap) error {
 	return nil
 }
@@ -1422,9 +1568,20 @@ func (a *AlertPolicy) Validate(fmap objstore.FieldMap) error {
 			return errors.New("Active Connection Alerts should not include any other triggers")
 		}
 	}
+	// Custom expressions are evaluated on their own
+	if a.CustomExpression != "" {
+		if a.ActiveConnLimit != 0 || a.CpuUtilizationLimit != 0 || a.MemUtilizationLimit != 0 || a.DiskUtilizationLimit != 0 {
+			return errors.New("Custom expression alerts should not include any other triggers")
+		}
+	} else if a.CustomMetricsSource != AlertMetricsSource_ALERT_METRICS_SOURCE_APP {
+		return errors.New("Custom metrics source requires a custom expression")
+	}
+	if _, found := AlertMetricsSource_CamelName[int32(a.CustomMetricsSource)]; !found {
+		return fmt.Errorf("Invalid custom metrics source %d", a.CustomMetricsSource)
+	}
 	// at least one of the values for alert should be set
 	if a.ActiveConnLimit == 0 && a.CpuUtilizationLimit == 0 &&
-		a.MemUtilizationLimit == 0 && a.DiskUtilizationLimit == 0 {
+		a.MemUtilizationLimit == 0 && a.DiskUtilizationLimit == 0 && a.CustomExpression == "" {
 		return errors.New("At least one of the measurements for alert should be set")
 	}
 	// check CPU to be within 0-100 percent
@@ -1446,6 +1603,162 @@ func (a *AlertPolicy) Validate(fmap objstore.FieldMap) error {
 	return nil
 }
 
+func (key *AlertReceiverKey) ValidateKey() error {
+	if !util.ValidName(key.Name) {
+		return errors.New("Invalid alert receiver name")
+	}
+	if !util.ValidName(key.Organization) {
+		return errors.New("Invalid alert receiver organization")
+	}
+	return nil
+}
+
+func validateReceiverURL(in string) error {
+	u, err := url.Parse(in)
+	if err != nil {
+		return fmt.Errorf("Invalid URL %q, %s", in, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("Invalid URL %q, must be an http or https URL", in)
+	}
+	return nil
+}
+
+func (s *AlertReceiver) Validate(fmap objstore.FieldMap) error {
+	if err := s.GetKey().ValidateKey(); err != nil {
+		return err
+	}
+	switch s.Type {
+	case AlertReceiverType_ALERT_RECEIVER_TYPE_WEBHOOK, AlertReceiverType_ALERT_RECEIVER_TYPE_SLACK:
+		if s.Url == "" {
+			return errors.New("URL must be specified for Webhook and Slack receivers")
+		}
+		if err := validateReceiverURL(s.Url); err != nil {
+			return err
+		}
+	case AlertReceiverType_ALERT_RECEIVER_TYPE_EMAIL:
+		if s.Email == "" {
+			return errors.New("Email must be specified for Email receivers")
+		}
+		addr, err := mail.ParseAddress(s.Email)
+		if err != nil {
+			return fmt.Errorf("Invalid email %q, %s", s.Email, err)
+		}
+		// only the address is used for SMTP, drop any display name
+		s.Email = addr.Address
+	case AlertReceiverType_ALERT_RECEIVER_TYPE_PAGER_DUTY:
+		if s.PagerDutyIntegrationKey == "" {
+			return errors.New("PagerDuty integration key must be specified for PagerDuty receivers")
+		}
+		if s.Url != "" {
+			if err := validateReceiverURL(s.Url); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("Invalid receiver type %d", s.Type)
+	}
+	return nil
+}
+
+func (key *AlertSilenceKey) ValidateKey() error {
+	if !util.ValidName(key.Name) {
+		return errors.New("Invalid alert silence name")
+	}
+	if !util.ValidName(key.Organization) {
+		return errors.New("Invalid alert silence organization")
+	}
+	return nil
+}
+
+func (s *AlertMatcher) regexp() (*regexp.Regexp, error) {
+	// regex must match the whole label value
+	return regexp.Compile("^(?:" + s.Value + ")$")
+}
+
+func (s *AlertMatcher) Validate() error {
+	if s.Name == "" {
+		return errors.New("Matcher label name must be specified")
+	}
+	if s.Regex {
+		if _, err := s.regexp(); err != nil {
+			return fmt.Errorf("Invalid matcher regex %q, %s", s.Value, err)
+		}
+	}
+	return nil
+}
+
+func (s *AlertSilence) Validate(fmap objstore.FieldMap) error {
+	if err := s.GetKey().ValidateKey(); err != nil {
+		return err
+	}
+	if len(s.Matchers) == 0 {
+		return errors.New("At least one matcher must be specified")
+	}
+	for ii := range s.Matchers {
+		if err := s.Matchers[ii].Validate(); err != nil {
+			return err
+		}
+	}
+	if s.EndTime.Seconds == 0 && s.EndTime.Nanos == 0 {
+		return errors.New("Silence end time must be specified")
+	}
+	start := dme.TimestampToTime(s.StartTime)
+	end := dme.TimestampToTime(s.EndTime)
+	if !end.After(start) {
+		return errors.New("Silence end time must be after the start time")
+	}
+	return nil
+}
+
+// IsActive checks if the silence is in effect at the given time
+func (s *AlertSilence) IsActive(now time.Time) bool {
+	return !now.Before(dme.TimestampToTime(s.StartTime)) && now.Before(dme.TimestampToTime(s.EndTime))
+}
+
+// AlertMatchers are the matchers of an AlertSilence with their
+// regexes compiled, so they can be matched against many alerts.
+type AlertMatchers struct {
+	matchers []AlertMatcher
+	regexps  []*regexp.Regexp
+}
+
+// CompileMatchers compiles the matchers of the silence.
+func (s *AlertSilence) CompileMatchers() (*AlertMatchers, error) {
+	compiled := &AlertMatchers{
+		matchers: make([]AlertMatcher, len(s.Matchers)),
+		regexps:  make([]*regexp.Regexp, len(s.Matchers)),
+	}
+	for ii, matcher := range s.Matchers {
+		compiled.matchers[ii] = matcher
+		if !matcher.Regex {
+			continue
+		}
+		re, err := matcher.regexp()
+		if err != nil {
+			return nil, fmt.Errorf("Invalid matcher regex %q, %s", matcher.Value, err)
+		}
+		compiled.regexps[ii] = re
+	}
+	return compiled, nil
+}
+
+// Matches checks if the labels match all of the matchers. A
+// missing label is treated as an empty value.
+func (s *AlertMatchers) Matches(labels map[string]string) bool {
+	for ii, matcher := range s.matchers {
+		val := labels[matcher.Name]
+		if re := s.regexps[ii]; re != nil {
+			if !re.MatchString(val) {
+				return false
+			}
+		} else if val != matcher.Value {
+			return false
+		}
+	}
+	return true
+}
+
 // Check if AlertPolicies are different between two apps
 func (app *App) AppAlertPoliciesDifferent(other *App) bool {
 	alertsDiff := false
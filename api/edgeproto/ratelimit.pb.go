@@ -44,16 +44,19 @@ type ApiEndpointType int32
 const (
 	ApiEndpointType_UNKNOWN_API_ENDPOINT_TYPE ApiEndpointType = 0
 	ApiEndpointType_DME                       ApiEndpointType = 1
+	ApiEndpointType_CONTROLLER                ApiEndpointType = 2
 )
 
 var ApiEndpointType_name = map[int32]string{
 	0: "UNKNOWN_API_ENDPOINT_TYPE",
 	1: "DME",
+	2: "CONTROLLER",
 }
 
 var ApiEndpointType_value = map[string]int32{
 	"UNKNOWN_API_ENDPOINT_TYPE": 0,
 	"DME":                       1,
+	"CONTROLLER":                2,
 }
 
 func (x ApiEndpointType) String() string {
@@ -540,100 +543,101 @@ func init() {
 func init() { proto.RegisterFile("ratelimit.proto", fileDescriptor_9c81fd649b00920f) }
 
 var fileDescriptor_9c81fd649b00920f = []byte{
-	// 1479 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4d, 0x6c, 0x1b, 0x45,
-	0x14, 0xf6, 0xd8, 0xa1, 0x4d, 0x26, 0x6e, 0xec, 0x4c, 0xd3, 0x74, 0xeb, 0x38, 0x8e, 0xbb, 0x85,
-	0x2a, 0x8a, 0xdc, 0x38, 0x84, 0x03, 0x34, 0xd0, 0xaa, 0x76, 0xbd, 0x6d, 0x2d, 0xff, 0x24, 0x5d,
-	0x3b, 0xa4, 0x55, 0x05, 0xab, 0x6d, 0x32, 0x75, 0x57, 0xd8, 0xbb, 0x66, 0x77, 0xdd, 0xd4, 0x3d,
-	0x21, 0x0e, 0x70, 0x6c, 0x05, 0x42, 0xa0, 0x8a, 0x43, 0x2f, 0x15, 0x17, 0x0e, 0xa8, 0x5c, 0x20,
-	0x67, 0x84, 0x7a, 0x41, 0xaa, 0x84, 0x84, 0x90, 0x90, 0x2a, 0x48, 0x41, 0x42, 0x3d, 0x21, 0x25,
-	0xb1, 0x10, 0x27, 0x34, 0xb3, 0x3f, 0x59, 0xaf, 0xd7, 0x4e, 0x0a, 0x45, 0xe5, 0x36, 0x9e, 0xf7,
-	0xe6, 0xcd, 0xf7, 0xde, 0xfb, 0xbe, 0xd9, 0x67, 0x18, 0x52, 0x45, 0x1d, 0x57, 0xa5, 0x9a, 0xa4,
-	0x4f, 0xd7, 0x55, 0x45, 0x57, 0xd0, 0x00, 0x5e, 0xa9, 0x60, 0xba, 0x8c, 0x04, 0x55, 0xac, 0x35,
-	0xaa, 0xa6, 0x21, 0x12, 0xad, 0x28, 0x4a, 0xa5, 0x8a, 0x93, 0x62, 0x5d, 0x4a, 0x8a, 0xb2, 0xac,
-	0xe8, 0xa2, 0x2e, 0x29, 0xb2, 0x66, 0x5a, 0xc7, 0x75, 0x45, 0xa9, 0x6a, 0x49, 0xfa, 0xa3, 0x82,
-	0x65, 0x7b, 0x61, 0x9a, 0x47, 0x2a, 0x4a, 0x45, 0xa1, 0xcb, 0x24, 0x59, 0x19, 0xbb, 0xec, 0x5d,
-	0x00, 0x83, 0x67, 0xaa, 0xca, 0x6a, 0x09, 0xeb, 0xba, 0x24, 0x57, 0x34, 0x74, 0x0e, 0x0e, 0x5d,
-	0xa9, 0x2a, 0xab, 0x82, 0x58, 0xad, 0x28, 0xaa, 0xa4, 0x5f, 0xad, 0x31, 0x20, 0x0e, 0x26, 0x87,
-	0x66, 0x0f, 0x4f, 0xdb, 0xa8, 0xa6, 0xc9, 0x01, 0x5e, 0xd4, 0x71, 0x9e, 0x80, 0x4e, 0x59, 0x8e,
-	0xfc, 0x3e, 0x72, 0xd0, 0xfe, 0x89, 0x8e, 0xc2, 0x90, 0x8a, 0xdf, 0xd6, 0x84, 0x3a, 0x56, 0x05,
-	0x0d, 0x2f, 0x2b, 0xf2, 0x0a, 0xe3, 0x8f, 0x83, 0x49, 0xc0, 0xef, 0x23, 0xdb, 0x0b, 0x58, 0x2d,
-	0xd1, 0x4d, 0x34, 0x0e, 0xe1, 0xe5, 0x86, 0xaa, 0xe9, 0x82, 0x26, 0xdd, 0xc0, 0x4c, 0x20, 0x0e,
-	0x26, 0x03, 0xfc, 0x00, 0xdd, 0x29, 0x49, 0x37, 0xf0, 0x5c, 0xdf, 0xef, 0x1b, 0x0c, 0x60, 0xbf,
-	0x06, 0x90, 0x69, 0xbb, 0xd6, 0x02, 0x9c, 0xc3, 0x4d, 0x94, 0x86, 0x88, 0x62, 0xd6, 0xcc, 0x3d,
-	0x41, 0x16, 0x6b, 0x98, 0xe2, 0x1e, 0x48, 0x8f, 0xac, 0xb5, 0x98, 0x30, 0xb1, 0x5a, 0x46, 0x62,
-	0xe3, 0xe9, 0x8e, 0x15, 0xa2, 0x28, 0xd6, 0x30, 0xca, 0xc1, 0x21, 0xd2, 0x07, 0x81, 0x36, 0x42,
-	0x78, 0x0b, 0x37, 0x29, 0xd8, 0xc1, 0xd9, 0x09, 0x47, 0xde, 0x5e, 0x97, 0xa7, 0xfb, 0xee, 0x3f,
-	0x9c, 0xf0, 0xf1, 0x41, 0xd5, 0xb2, 0xe5, 0x70, 0x73, 0x2e, 0x48, 0x30, 0xff, 0xb9, 0xc1, 0x80,
-	0x2f, 0xee, 0x4c, 0x00, 0xf6, 0x87, 0x00, 0x3c, 0xe0, 0x89, 0x1d, 0x8d, 0xc2, 0x3d, 0x57, 0x24,
-	0x5c, 0x5d, 0xd1, 0x18, 0x10, 0x0f, 0x4c, 0x0e, 0xf0, 0xe6, 0x2f, 0xf4, 0x2a, 0x0c, 0x6c, 0x23,
-	0x38, 0xd2, 0xad, 0xf2, 0x9d, 0x28, 0xc8, 0x29, 0x74, 0x1c, 0xf6, 0x5b, 0xb9, 0xd2, 0x6a, 0x0e,
-	0xce, 0x1e, 0x74, 0x45, 0xb0, 0x0e, 0x9a, 0xa7, 0x6c, 0xf7, 0xb9, 0xcf, 0xfd, 0x04, 0xf8, 0x1f,
-	0x1b, 0x0c, 0x78, 0x67, 0x93, 0x01, 0xb7, 0x36, 0x19, 0x70, 0x7b, 0x8b, 0xb9, 0xe9, 0x77, 0x97,
-	0xef, 0x44, 0x0e, 0x37, 0xdb, 0x02, 0x91, 0x0a, 0x26, 0xc4, 0xba, 0x84, 0xe5, 0x95, 0xba, 0x22,
-	0xc9, 0xba, 0xde, 0xac, 0x1b, 0x4e, 0xbc, 0xa3, 0x2a, 0xd3, 0xa9, 0xba, 0xc4, 0x99, 0x0e, 0xe5,
-	0x66, 0x1d, 0x27, 0x6c, 0xde, 0xeb, 0xa2, 0x5a, 0xc1, 0x7a, 0xe7, 0x01, 0xfb, 0x47, 0x99, 0x3a,
-	0x90, 0x1b, 0xec, 0xeb, 0xdd, 0x91, 0x29, 0x04, 0x02, 0xd4, 0x26, 0xee, 0x09, 0x0b, 0x1d, 0x85,
-	0x6a, 0xf3, 0x32, 0x41, 0xd8, 0x57, 0xc7, 0xaa, 0x41, 0xc9, 0x6d, 0x27, 0xde, 0x49, 0xca, 0x04,
-	0xe5, 0x1f, 0x21, 0xe4, 0xb6, 0x43, 0xda, 0xa2, 0xe4, 0xbd, 0x16, 0xd3, 0x27, 0x2b, 0x32, 0x66,
-	0xbf, 0x02, 0x30, 0x54, 0x10, 0xaf, 0x93, 0x93, 0x76, 0x4b, 0x79, 0x88, 0x6a, 0xe2, 0x75, 0x81,
-	0x32, 0xdf, 0xad, 0xa1, 0xe7, 0x1d, 0x7d, 0x30, 0xcf, 0x79, 0xc8, 0x28, 0x5c, 0x33, 0x4c, 0xdb,
-	0x4a, 0x3a, 0x0c, 0x83, 0x66, 0xcc, 0x06, 0xd6, 0x74, 0x8d, 0xf2, 0x22, 0xc0, 0x0f, 0x1a, 0x7e,
-	0x74, 0x0b, 0x4d, 0xc2, 0x7e, 0x49, 0xd6, 0xb1, 0x7a, 0x4d, 0xac, 0x1a, 0x12, 0x4a, 0x07, 0xff,
-	0x7a, 0x38, 0xd1, 0x9f, 0x69, 0xa8, 0xf4, 0x8d, 0xe0, 0x6d, 0xab, 0xa9, 0xa7, 0x6f, 0x01, 0x1c,
-	0x73, 0x43, 0x70, 0x4a, 0x2a, 0x0f, 0x47, 0xed, 0x34, 0xbc, 0x64, 0x75, 0x70, 0xad, 0xc5, 0xec,
-	0xaf, 0x89, 0xd7, 0x89, 0x43, 0x9b, 0xb2, 0xf6, 0xd7, 0xda, 0x0b, 0xf2, 0x5f, 0x8b, 0xeb, 0xb7,
-	0x00, 0x64, 0xba, 0x25, 0xd2, 0x55, 0x5f, 0x27, 0x9d, 0xfa, 0x3a, 0xda, 0xa3, 0x2b, 0x5d, 0x24,
-	0xf6, 0x5a, 0x87, 0xc4, 0x22, 0x9d, 0x41, 0xba, 0xaa, 0xec, 0x9e, 0x97, 0xca, 0x3e, 0xf2, 0x7b,
-	0x54, 0x93, 0x32, 0xbd, 0xd0, 0x59, 0xd0, 0xff, 0x83, 0xd6, 0x4c, 0xb8, 0x1e, 0x72, 0x2b, 0xb8,
-	0xf8, 0x6b, 0xba, 0x52, 0xa2, 0xba, 0xbd, 0xe8, 0x66, 0xc2, 0x22, 0xe7, 0xb6, 0x35, 0x6b, 0xee,
-	0xd8, 0x5a, 0x7b, 0xcf, 0x0f, 0x47, 0x3c, 0x99, 0x7a, 0x14, 0xf6, 0x8b, 0x75, 0xc9, 0xc9, 0xcd,
-	0xc1, 0xb5, 0x16, 0xb3, 0xd7, 0xc4, 0xcf, 0x93, 0x05, 0xe5, 0xe0, 0x25, 0x38, 0x4c, 0xfc, 0xac,
-	0xb2, 0x09, 0xa4, 0x6e, 0x94, 0x01, 0x43, 0x6d, 0xcd, 0x73, 0x15, 0x2e, 0xbd, 0x7f, 0xad, 0xc5,
-	0x84, 0x5c, 0xe5, 0xe6, 0xc9, 0x86, 0xd3, 0x8b, 0x04, 0x77, 0x10, 0xdc, 0xa8, 0x31, 0x65, 0x46,
-	0x7b, 0x70, 0x57, 0x91, 0x8d, 0xe0, 0xae, 0xd6, 0xf0, 0x21, 0xb5, 0xdd, 0xcb, 0x45, 0xf8, 0x6f,
-	0xfa, 0xe0, 0x70, 0x27, 0xd3, 0x5f, 0x36, 0x18, 0x0d, 0x9e, 0x44, 0x56, 0x94, 0xca, 0x25, 0xb8,
-	0xaf, 0xed, 0xdb, 0xc9, 0xf8, 0xe3, 0x81, 0xc9, 0xc1, 0xd9, 0xe9, 0x5e, 0x21, 0xda, 0xde, 0x7e,
-	0x4e, 0xd6, 0xd5, 0x26, 0x1f, 0x74, 0x7e, 0x50, 0xd1, 0x1b, 0x70, 0xb8, 0xe3, 0xf5, 0x60, 0x02,
-	0x34, 0xf0, 0x8b, 0x3d, 0x03, 0xbb, 0xb8, 0x6e, 0xc4, 0x0e, 0xb9, 0x9e, 0x94, 0xc8, 0x05, 0x38,
-	0xdc, 0x81, 0x00, 0x85, 0xb7, 0x2b, 0x30, 0x60, 0xa4, 0x76, 0x0c, 0x3e, 0x77, 0x4d, 0xac, 0x36,
-	0xb0, 0xa9, 0xf3, 0x6e, 0x5f, 0x41, 0xde, 0xf0, 0x9a, 0xf3, 0xbf, 0x02, 0x22, 0x6f, 0xc2, 0x11,
-	0x2f, 0x08, 0x1e, 0xc1, 0x67, 0xda, 0x83, 0xf7, 0xd0, 0xbf, 0x23, 0xfe, 0xdc, 0xfb, 0xc0, 0x92,
-	0xfe, 0x07, 0x5b, 0x4c, 0xd4, 0x89, 0x22, 0xe1, 0x3a, 0x75, 0x7b, 0x8b, 0xb9, 0xe4, 0xa5, 0xf2,
-	0x27, 0x12, 0xb6, 0x87, 0x96, 0x4d, 0xf9, 0xb2, 0x22, 0x3c, 0xd0, 0x51, 0xfe, 0x8c, 0xa8, 0x8b,
-	0xe8, 0xa4, 0xe3, 0x6d, 0x03, 0xb4, 0x65, 0xd1, 0x5e, 0x2d, 0xeb, 0x78, 0xdd, 0xfa, 0x3f, 0x69,
-	0x31, 0xe0, 0x4e, 0x8b, 0xf1, 0x4d, 0x1d, 0x87, 0x21, 0x17, 0x5a, 0x34, 0x0e, 0x0f, 0x2d, 0x16,
-	0x73, 0xc5, 0xf9, 0xa5, 0xa2, 0x90, 0x5a, 0xc8, 0x0a, 0x5c, 0x31, 0xb3, 0x30, 0x9f, 0x2d, 0x96,
-	0x85, 0xf2, 0xc5, 0x05, 0x2e, 0xec, 0x43, 0x7b, 0x61, 0x20, 0x53, 0xe0, 0xc2, 0x60, 0xea, 0x3c,
-	0x0c, 0xb9, 0x92, 0x40, 0x08, 0x0e, 0x59, 0x47, 0xcb, 0x29, 0xfe, 0x2c, 0x57, 0x0e, 0xfb, 0x50,
-	0x18, 0x06, 0x53, 0xf9, 0xbc, 0xc0, 0x73, 0xe7, 0x17, 0xb9, 0x52, 0xb9, 0x14, 0x06, 0x08, 0xc2,
-	0x3d, 0x0b, 0x1c, 0x2f, 0x64, 0x17, 0xc2, 0x7e, 0x14, 0x84, 0xfd, 0x64, 0xbd, 0x58, 0xe2, 0xf8,
-	0x70, 0x60, 0xaa, 0x0a, 0x47, 0xbd, 0xe7, 0x56, 0x14, 0x81, 0xa3, 0x56, 0xe4, 0x33, 0xf9, 0xf9,
-	0x25, 0x21, 0x95, 0x3f, 0x3b, 0xcf, 0x67, 0xcb, 0xe7, 0x0a, 0x61, 0x1f, 0xb1, 0x95, 0xe7, 0x73,
-	0x5c, 0x51, 0x48, 0x2f, 0x9e, 0xce, 0x71, 0x65, 0x87, 0x0d, 0x10, 0x5b, 0x9e, 0x4b, 0xe5, 0x2e,
-	0x76, 0xda, 0xfc, 0x53, 0x4b, 0xf0, 0x50, 0xd7, 0x2f, 0x3c, 0x8a, 0xc1, 0x88, 0x75, 0x61, 0x21,
-	0x75, 0x81, 0xc0, 0x2f, 0xb9, 0x2f, 0x3d, 0x93, 0xbd, 0xc0, 0x65, 0x84, 0xa5, 0x6c, 0x31, 0xd3,
-	0x06, 0x08, 0xcc, 0xfe, 0x14, 0xf4, 0x78, 0x07, 0x53, 0x75, 0x09, 0x7d, 0x0c, 0xe0, 0x81, 0xd2,
-	0x55, 0xaf, 0x29, 0xb3, 0x67, 0xff, 0x22, 0x3d, 0xad, 0xec, 0xa9, 0xc7, 0x9b, 0x4c, 0x84, 0xc7,
-	0x9a, 0xd2, 0x50, 0x97, 0xf1, 0x69, 0x45, 0xbe, 0x22, 0x55, 0x12, 0xa9, 0x65, 0x32, 0x52, 0xbc,
-	0x2e, 0xe1, 0xd5, 0xc4, 0xbb, 0xdf, 0xff, 0xfa, 0xa1, 0x3f, 0xca, 0x1e, 0x4c, 0x6a, 0x57, 0x95,
-	0xd5, 0xa4, 0xcd, 0x45, 0x9b, 0x0c, 0x60, 0x6a, 0x06, 0xa0, 0xef, 0x00, 0x1c, 0x3b, 0xad, 0x62,
-	0x51, 0xc7, 0xde, 0x53, 0x70, 0x7c, 0xa7, 0x01, 0x37, 0x32, 0xec, 0xc4, 0x48, 0xff, 0x11, 0xb1,
-	0xcd, 0xc7, 0x9b, 0x4c, 0xd4, 0x13, 0x58, 0x41, 0x94, 0xc5, 0x0a, 0x4e, 0xac, 0x6f, 0x31, 0xc7,
-	0xba, 0x4c, 0x80, 0xde, 0x33, 0x1f, 0xcd, 0x85, 0x65, 0xc7, 0x93, 0xcb, 0x14, 0x6b, 0x92, 0xbc,
-	0x68, 0x5e, 0x19, 0xa1, 0x4f, 0x01, 0x1c, 0x5b, 0xac, 0xaf, 0x3c, 0xdd, 0x7c, 0xce, 0xed, 0x94,
-	0x8f, 0x0d, 0xaf, 0x41, 0xaf, 0xee, 0x0d, 0x2f, 0x83, 0xab, 0xf8, 0x19, 0xc1, 0x5b, 0xa1, 0x57,
-	0x77, 0x87, 0xf7, 0x19, 0x80, 0x87, 0x08, 0x4f, 0xff, 0x29, 0xb8, 0x1d, 0x3d, 0xd8, 0xcc, 0x2e,
-	0x38, 0x1b, 0x67, 0xc7, 0x0c, 0xce, 0x76, 0xc3, 0x39, 0x03, 0xd0, 0x63, 0x00, 0x63, 0x06, 0x6f,
-	0xbb, 0x0e, 0x98, 0x47, 0x76, 0x31, 0x3b, 0x7a, 0x95, 0xf3, 0x26, 0xd8, 0x05, 0x7d, 0x4f, 0x75,
-	0x9f, 0xa8, 0x3c, 0xa7, 0xa8, 0x8e, 0xe1, 0x89, 0x66, 0xfa, 0x02, 0x1b, 0xb7, 0x18, 0x6d, 0x8e,
-	0x6c, 0x9e, 0x6d, 0xb9, 0x0b, 0x60, 0xcc, 0x20, 0xf5, 0x53, 0x4f, 0x36, 0xb7, 0x2b, 0xee, 0x10,
-	0x9c, 0x26, 0xb5, 0x77, 0xc2, 0x69, 0xb0, 0xfb, 0x19, 0xe2, 0x34, 0x39, 0xde, 0x0b, 0xe7, 0x97,
-	0x00, 0x46, 0x09, 0xcd, 0xff, 0x1d, 0xca, 0xdd, 0x38, 0xb1, 0x67, 0x77, 0xc1, 0xf7, 0x23, 0x6c,
-	0xcc, 0xe0, 0x7b, 0x0f, 0xcc, 0x33, 0x20, 0x1d, 0xbd, 0xff, 0x4b, 0xcc, 0x77, 0x7f, 0x3d, 0x06,
-	0x1e, 0xac, 0xc7, 0xc0, 0xcf, 0xeb, 0x31, 0x70, 0xeb, 0x51, 0xcc, 0xf7, 0xe0, 0x51, 0xcc, 0xf7,
-	0xe3, 0xa3, 0x98, 0xef, 0xf2, 0x1e, 0x0a, 0xe4, 0xa5, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x2b,
-	0xa1, 0x65, 0x02, 0xb2, 0x12, 0x00, 0x00,
+	// 1495 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6c, 0x1b, 0x45,
+	0x17, 0xf7, 0xd8, 0xf9, 0xda, 0x64, 0xe2, 0xc6, 0xce, 0x34, 0x4d, 0xb7, 0x8e, 0xe3, 0xb8, 0xdb,
+	0xef, 0xab, 0xa2, 0xc8, 0x8d, 0xf3, 0xe5, 0x3b, 0x7c, 0x10, 0x68, 0x55, 0x3b, 0xde, 0xb6, 0x96,
+	0xff, 0xa5, 0x6b, 0x87, 0xb4, 0xaa, 0x60, 0xb5, 0x4d, 0xa6, 0xee, 0x0a, 0x7b, 0xd7, 0xec, 0xae,
+	0x9b, 0xba, 0x27, 0xc4, 0x01, 0x8e, 0xad, 0x40, 0x08, 0x54, 0x71, 0xe8, 0xa5, 0xe2, 0xc2, 0x01,
+	0x95, 0x0b, 0xe4, 0x8c, 0x50, 0x2f, 0x48, 0x95, 0x90, 0x10, 0x12, 0x52, 0x05, 0x29, 0x48, 0xa8,
+	0x27, 0xa4, 0x26, 0x16, 0xe2, 0x84, 0x66, 0xf6, 0x4f, 0xd6, 0xeb, 0xb5, 0x93, 0x42, 0x51, 0xb9,
+	0x8d, 0xe7, 0xbd, 0x79, 0xf3, 0x7b, 0xef, 0xfd, 0x7e, 0xb3, 0xcf, 0x30, 0xa4, 0x8a, 0x3a, 0xae,
+	0x49, 0x75, 0x49, 0x9f, 0x6d, 0xa8, 0x8a, 0xae, 0xa0, 0x21, 0xbc, 0x56, 0xc5, 0x74, 0x19, 0x09,
+	0xaa, 0x58, 0x6b, 0xd6, 0x4c, 0x43, 0x24, 0x5a, 0x55, 0x94, 0x6a, 0x0d, 0x27, 0xc5, 0x86, 0x94,
+	0x14, 0x65, 0x59, 0xd1, 0x45, 0x5d, 0x52, 0x64, 0xcd, 0xb4, 0x4e, 0xea, 0x8a, 0x52, 0xd3, 0x92,
+	0xf4, 0x47, 0x15, 0xcb, 0xf6, 0xc2, 0x34, 0x8f, 0x55, 0x95, 0xaa, 0x42, 0x97, 0x49, 0xb2, 0x32,
+	0x76, 0xd9, 0xbb, 0x00, 0x06, 0xcf, 0xd4, 0x94, 0xf5, 0x32, 0xd6, 0x75, 0x49, 0xae, 0x6a, 0xe8,
+	0x1c, 0x1c, 0xb9, 0x52, 0x53, 0xd6, 0x05, 0xb1, 0x56, 0x55, 0x54, 0x49, 0xbf, 0x5a, 0x67, 0x40,
+	0x1c, 0x4c, 0x8f, 0xcc, 0x1f, 0x9d, 0xb5, 0x51, 0xcd, 0x92, 0x03, 0xbc, 0xa8, 0xe3, 0x3c, 0x01,
+	0x9d, 0xb2, 0x1c, 0xf9, 0x03, 0xe4, 0xa0, 0xfd, 0x13, 0x1d, 0x87, 0x21, 0x15, 0xbf, 0xa1, 0x09,
+	0x0d, 0xac, 0x0a, 0x1a, 0x5e, 0x55, 0xe4, 0x35, 0xc6, 0x1f, 0x07, 0xd3, 0x80, 0x3f, 0x40, 0xb6,
+	0x97, 0xb0, 0x5a, 0xa6, 0x9b, 0x68, 0x12, 0xc2, 0xcb, 0x4d, 0x55, 0xd3, 0x05, 0x4d, 0xba, 0x81,
+	0x99, 0x40, 0x1c, 0x4c, 0x07, 0xf8, 0x21, 0xba, 0x53, 0x96, 0x6e, 0xe0, 0x85, 0x81, 0x5f, 0x9e,
+	0x30, 0x80, 0xfd, 0x02, 0x40, 0xa6, 0xe3, 0x5a, 0x0b, 0x70, 0x0e, 0xb7, 0x50, 0x1a, 0x22, 0x8a,
+	0x59, 0x33, 0xf7, 0x04, 0x59, 0xac, 0x63, 0x8a, 0x7b, 0x28, 0x3d, 0xb6, 0xd1, 0x66, 0xc2, 0xc4,
+	0x6a, 0x19, 0x89, 0x8d, 0xa7, 0x3b, 0x56, 0x88, 0xa2, 0x58, 0xc7, 0x28, 0x07, 0x47, 0x48, 0x1f,
+	0x04, 0xda, 0x08, 0xe1, 0x75, 0xdc, 0xa2, 0x60, 0x87, 0xe7, 0xa7, 0x1c, 0x79, 0x7b, 0x5d, 0x9e,
+	0x1e, 0xb8, 0xff, 0x70, 0xca, 0xc7, 0x07, 0x55, 0xcb, 0x96, 0xc3, 0xad, 0x85, 0x20, 0xc1, 0xfc,
+	0xdb, 0x13, 0x06, 0x7c, 0x7a, 0x67, 0x0a, 0xb0, 0xdf, 0x06, 0xe0, 0x21, 0x4f, 0xec, 0x68, 0x1c,
+	0xee, 0xbb, 0x22, 0xe1, 0xda, 0x9a, 0xc6, 0x80, 0x78, 0x60, 0x7a, 0x88, 0x37, 0x7f, 0xa1, 0x97,
+	0x60, 0x60, 0x07, 0xc1, 0xb1, 0x5e, 0x95, 0xef, 0x46, 0x41, 0x4e, 0xa1, 0x17, 0xe1, 0xa0, 0x95,
+	0x2b, 0xad, 0xe6, 0xf0, 0xfc, 0x61, 0x57, 0x04, 0xeb, 0xa0, 0x79, 0xca, 0x76, 0x5f, 0xf8, 0xc4,
+	0x4f, 0x80, 0xff, 0xfa, 0x84, 0x01, 0x6f, 0x6e, 0x31, 0xe0, 0xd6, 0x16, 0x03, 0x6e, 0x6f, 0x33,
+	0x37, 0xfd, 0xee, 0xf2, 0x9d, 0xcc, 0xe1, 0x56, 0x47, 0x20, 0x52, 0xc1, 0x84, 0xd8, 0x90, 0xb0,
+	0xbc, 0xd6, 0x50, 0x24, 0x59, 0xd7, 0x5b, 0x0d, 0xc3, 0x89, 0x77, 0x54, 0x65, 0x36, 0xd5, 0x90,
+	0x38, 0xd3, 0xa1, 0xd2, 0x6a, 0xe0, 0x84, 0xcd, 0x7b, 0x5d, 0x54, 0xab, 0x58, 0xef, 0x3e, 0x60,
+	0xff, 0xa8, 0x50, 0x07, 0x72, 0x83, 0x7d, 0xbd, 0x3b, 0x32, 0x85, 0x40, 0x80, 0xda, 0xc4, 0x3d,
+	0x69, 0xa1, 0xa3, 0x50, 0x6d, 0x5e, 0x26, 0x08, 0xfb, 0x1a, 0x58, 0x35, 0x28, 0xb9, 0xe3, 0xc4,
+	0x3b, 0x49, 0x99, 0xa0, 0xfc, 0x23, 0x84, 0xdc, 0x71, 0x48, 0x5b, 0x94, 0xbc, 0xd7, 0x66, 0x06,
+	0x64, 0x45, 0xc6, 0xec, 0xe7, 0x00, 0x86, 0x0a, 0xe2, 0x75, 0x72, 0xd2, 0x6e, 0x29, 0x0f, 0x51,
+	0x5d, 0xbc, 0x2e, 0x50, 0xe6, 0xbb, 0x35, 0xf4, 0x6f, 0x47, 0x1f, 0xcc, 0x73, 0x1e, 0x32, 0x0a,
+	0xd7, 0x0d, 0xd3, 0x8e, 0x92, 0x8e, 0xc2, 0xa0, 0x19, 0xb3, 0x89, 0x35, 0x5d, 0xa3, 0xbc, 0x08,
+	0xf0, 0xc3, 0x86, 0x1f, 0xdd, 0x42, 0xd3, 0x70, 0x50, 0x92, 0x75, 0xac, 0x5e, 0x13, 0x6b, 0x86,
+	0x84, 0xd2, 0xc1, 0xdf, 0x1f, 0x4e, 0x0d, 0x66, 0x9a, 0x2a, 0x7d, 0x23, 0x78, 0xdb, 0x6a, 0xea,
+	0xe9, 0x2b, 0x00, 0x27, 0xdc, 0x10, 0x9c, 0x92, 0xca, 0xc3, 0x71, 0x3b, 0x0d, 0x2f, 0x59, 0x1d,
+	0xde, 0x68, 0x33, 0x07, 0xeb, 0xe2, 0x75, 0xe2, 0xd0, 0xa1, 0xac, 0x83, 0xf5, 0xce, 0x82, 0xfc,
+	0xdd, 0xe2, 0xfa, 0x39, 0x00, 0x99, 0x5e, 0x89, 0xf4, 0xd4, 0xd7, 0x29, 0xa7, 0xbe, 0x8e, 0xf7,
+	0xe9, 0x4a, 0x0f, 0x89, 0xbd, 0xdc, 0x25, 0xb1, 0x48, 0x77, 0x90, 0x9e, 0x2a, 0xbb, 0xe7, 0xa5,
+	0xb2, 0xf7, 0xfd, 0x1e, 0xd5, 0xa4, 0x4c, 0x2f, 0x74, 0x17, 0xf4, 0x9f, 0xa0, 0x35, 0x13, 0xae,
+	0x87, 0xdc, 0x0a, 0x2e, 0xfe, 0x9a, 0xae, 0x94, 0xa8, 0x6e, 0x2f, 0xba, 0x99, 0xb0, 0xc8, 0xb9,
+	0x63, 0xcd, 0x9a, 0x3b, 0xb6, 0xd6, 0xde, 0xf6, 0xc3, 0x31, 0x4f, 0xa6, 0x1e, 0x87, 0x83, 0x62,
+	0x43, 0x72, 0x72, 0x73, 0x78, 0xa3, 0xcd, 0xec, 0x37, 0xf1, 0xf3, 0x64, 0x41, 0x39, 0x78, 0x09,
+	0x8e, 0x12, 0x3f, 0xab, 0x6c, 0x02, 0xa9, 0x1b, 0x65, 0xc0, 0x48, 0x47, 0xf3, 0x5c, 0x85, 0x4b,
+	0x1f, 0xdc, 0x68, 0x33, 0x21, 0x57, 0xb9, 0x79, 0xb2, 0xe1, 0xf4, 0x22, 0xc1, 0x1d, 0x04, 0x37,
+	0x6a, 0x4c, 0x99, 0xd1, 0x19, 0xdc, 0x55, 0x64, 0x23, 0xb8, 0xab, 0x35, 0x7c, 0x48, 0xed, 0xf4,
+	0x72, 0x11, 0xfe, 0xcb, 0x01, 0x38, 0xda, 0xcd, 0xf4, 0xff, 0x1b, 0x8c, 0x06, 0x4f, 0x23, 0x2b,
+	0x4a, 0xe5, 0x32, 0x3c, 0xd0, 0xf1, 0xed, 0x64, 0xfc, 0xf1, 0xc0, 0xf4, 0xf0, 0xfc, 0x6c, 0xbf,
+	0x10, 0x1d, 0x6f, 0x3f, 0x27, 0xeb, 0x6a, 0x8b, 0x0f, 0x3a, 0x3f, 0xa8, 0xe8, 0x55, 0x38, 0xda,
+	0xf5, 0x7a, 0x30, 0x01, 0x1a, 0xf8, 0xbf, 0x7d, 0x03, 0xbb, 0xb8, 0x6e, 0xc4, 0x0e, 0xb9, 0x9e,
+	0x94, 0xc8, 0x05, 0x38, 0xda, 0x85, 0x00, 0x85, 0x77, 0x2a, 0x30, 0x64, 0xa4, 0x76, 0x02, 0xfe,
+	0xeb, 0x9a, 0x58, 0x6b, 0x62, 0x53, 0xe7, 0xbd, 0xbe, 0x82, 0xbc, 0xe1, 0xb5, 0xe0, 0x7f, 0x01,
+	0x44, 0x5e, 0x83, 0x63, 0x5e, 0x10, 0x3c, 0x82, 0xcf, 0x75, 0x06, 0xef, 0xa3, 0x7f, 0x47, 0xfc,
+	0x85, 0x77, 0x80, 0x25, 0xfd, 0x77, 0xb7, 0x99, 0xa8, 0x13, 0x45, 0xc2, 0x75, 0xea, 0xf6, 0x36,
+	0x73, 0xc9, 0x4b, 0xe5, 0x4f, 0x25, 0x6c, 0x0f, 0x2d, 0x9b, 0xf2, 0x65, 0x45, 0x78, 0xa8, 0xab,
+	0xfc, 0x19, 0x51, 0x17, 0xd1, 0x29, 0xc7, 0xdb, 0x06, 0x68, 0xcb, 0xa2, 0xfd, 0x5a, 0xd6, 0xf5,
+	0xba, 0x0d, 0x7e, 0xd8, 0x66, 0xc0, 0x9d, 0x36, 0xe3, 0x9b, 0xc9, 0xc2, 0x90, 0x0b, 0x2d, 0x9a,
+	0x84, 0x47, 0x96, 0x8b, 0xb9, 0x62, 0x69, 0xa5, 0x28, 0xa4, 0x96, 0xb2, 0x02, 0x57, 0xcc, 0x2c,
+	0x95, 0xb2, 0xc5, 0x8a, 0x50, 0xb9, 0xb8, 0xc4, 0x85, 0x7d, 0x68, 0x3f, 0x0c, 0x64, 0x0a, 0x5c,
+	0x18, 0xa0, 0x11, 0x08, 0x17, 0x4b, 0xc5, 0x0a, 0x5f, 0xca, 0xe7, 0x39, 0x3e, 0xec, 0x9f, 0x39,
+	0x0f, 0x43, 0xae, 0xa4, 0x10, 0x82, 0x23, 0x56, 0xa8, 0x4a, 0x8a, 0x3f, 0xcb, 0x55, 0xc2, 0x3e,
+	0x14, 0x86, 0xc1, 0x54, 0x3e, 0x2f, 0xf0, 0xdc, 0xf9, 0x65, 0xae, 0x5c, 0x29, 0x87, 0x01, 0x82,
+	0x70, 0xdf, 0x12, 0xc7, 0x0b, 0xd9, 0xa5, 0xb0, 0x1f, 0x05, 0xe1, 0x20, 0x59, 0x2f, 0x97, 0x39,
+	0x3e, 0x1c, 0x98, 0xa9, 0xc1, 0x71, 0xef, 0x39, 0x16, 0x45, 0xe0, 0xb8, 0x15, 0xf9, 0x4c, 0xbe,
+	0xb4, 0x22, 0xa4, 0xf2, 0x67, 0x4b, 0x7c, 0xb6, 0x72, 0xae, 0x10, 0xf6, 0x11, 0x5b, 0xa5, 0x94,
+	0xe3, 0x8a, 0x42, 0x7a, 0x79, 0x31, 0xc7, 0x55, 0x1c, 0x36, 0x40, 0x6c, 0x79, 0x2e, 0x95, 0xbb,
+	0xd8, 0x6d, 0xf3, 0xcf, 0xac, 0xc0, 0x23, 0x3d, 0xbf, 0xf8, 0x28, 0x06, 0x23, 0xd6, 0x85, 0x85,
+	0xd4, 0x05, 0x02, 0xbf, 0xec, 0xbe, 0xf4, 0x4c, 0xf6, 0x02, 0x97, 0x11, 0x56, 0xb2, 0xc5, 0x4c,
+	0x07, 0x20, 0x30, 0xff, 0x7d, 0xd0, 0xe3, 0x5d, 0x4c, 0x35, 0x24, 0xf4, 0x01, 0x80, 0x87, 0xca,
+	0x57, 0xbd, 0xa6, 0xce, 0xbe, 0xfd, 0x8c, 0xf4, 0xb5, 0xb2, 0xa7, 0x1f, 0x6f, 0x31, 0x11, 0x1e,
+	0x6b, 0x4a, 0x53, 0x5d, 0xc5, 0x8b, 0x8a, 0x7c, 0x45, 0xaa, 0x26, 0x52, 0xab, 0x64, 0xc4, 0x78,
+	0x45, 0xc2, 0xeb, 0x89, 0xb7, 0xbe, 0xf9, 0xe9, 0x3d, 0x7f, 0x94, 0x3d, 0x9c, 0xd4, 0xae, 0x2a,
+	0xeb, 0x49, 0x9b, 0x9b, 0x36, 0x39, 0xc0, 0xcc, 0x1c, 0x40, 0x5f, 0x03, 0x38, 0xb1, 0xa8, 0x62,
+	0x51, 0xc7, 0xde, 0x53, 0x71, 0x7c, 0xb7, 0x81, 0x37, 0x32, 0xea, 0xc4, 0x48, 0xff, 0x21, 0xb1,
+	0xad, 0xc7, 0x5b, 0x4c, 0xd4, 0x13, 0x58, 0x41, 0x94, 0xc5, 0x2a, 0x4e, 0x6c, 0x6e, 0x33, 0x27,
+	0x7a, 0x4c, 0x84, 0xde, 0x33, 0x20, 0xcd, 0x85, 0x65, 0x27, 0x93, 0xab, 0x14, 0x6b, 0x92, 0xbc,
+	0x70, 0x5e, 0x19, 0xa1, 0x8f, 0x00, 0x9c, 0x58, 0x6e, 0xac, 0x3d, 0xdb, 0x7c, 0xce, 0xed, 0x96,
+	0x8f, 0x0d, 0xaf, 0x49, 0xaf, 0xee, 0x0f, 0x2f, 0x83, 0x6b, 0xf8, 0x39, 0xc1, 0x5b, 0xa3, 0x57,
+	0xf7, 0x86, 0xf7, 0x31, 0x80, 0x47, 0x08, 0x4f, 0xff, 0x2c, 0xb8, 0x5d, 0x3d, 0xd8, 0xcc, 0x1e,
+	0x38, 0x1b, 0x67, 0x27, 0x0c, 0xce, 0xf6, 0xc2, 0x39, 0x07, 0xd0, 0x63, 0x00, 0x63, 0x06, 0x6f,
+	0x7b, 0x0e, 0x9c, 0xc7, 0xf6, 0x30, 0x4b, 0x7a, 0x95, 0xf3, 0x26, 0xd8, 0x03, 0x7d, 0x4f, 0xf7,
+	0x9e, 0xb0, 0x3c, 0xa7, 0xaa, 0xae, 0x61, 0x8a, 0x66, 0xfa, 0x1f, 0x36, 0x6e, 0x31, 0xda, 0x1c,
+	0xe1, 0x3c, 0xdb, 0x72, 0x17, 0xc0, 0x98, 0x41, 0xea, 0x67, 0x9e, 0x6c, 0x6e, 0x4f, 0xdc, 0x21,
+	0x38, 0x4d, 0x6a, 0xef, 0x86, 0xd3, 0x60, 0xf7, 0x73, 0xc4, 0x69, 0x72, 0xbc, 0x1f, 0xce, 0xcf,
+	0x00, 0x8c, 0x12, 0x9a, 0xff, 0x35, 0x94, 0x7b, 0x71, 0x62, 0xcf, 0xee, 0x81, 0xef, 0xc7, 0xd8,
+	0x98, 0xc1, 0xf7, 0x3e, 0x98, 0xe7, 0x40, 0x3a, 0x7a, 0xff, 0xc7, 0x98, 0xef, 0xfe, 0x66, 0x0c,
+	0x3c, 0xd8, 0x8c, 0x81, 0x1f, 0x36, 0x63, 0xe0, 0xd6, 0xa3, 0x98, 0xef, 0xc1, 0xa3, 0x98, 0xef,
+	0xbb, 0x47, 0x31, 0xdf, 0xe5, 0x7d, 0x14, 0xc8, 0xff, 0xfe, 0x08, 0x00, 0x00, 0xff, 0xff, 0xf8,
+	0xec, 0x38, 0xff, 0xc2, 0x12, 0x00, 0x00,
 }
 
 func (this *FlowRateLimitSettingsKey) GoString() string {
@@ -4179,11 +4183,13 @@ func (m *RateLimitSettingsData) StoreRead(ctx context.Context, kvstore objstore.
 var ApiEndpointTypeStrings = []string{
 	"UNKNOWN_API_ENDPOINT_TYPE",
 	"DME",
+	"CONTROLLER",
 }
 
 const (
 	ApiEndpointTypeUNKNOWN_API_ENDPOINT_TYPE uint64 = 1 << 0
 	ApiEndpointTypeDME                       uint64 = 1 << 1
+	ApiEndpointTypeCONTROLLER                uint64 = 1 << 2
 )
 
 var ApiEndpointType_CamelName = map[int32]string{
@@ -4191,10 +4197,13 @@ var ApiEndpointType_CamelName = map[int32]string{
 	0: "UnknownApiEndpointType",
 	// DME -> Dme
 	1: "Dme",
+	// CONTROLLER -> Controller
+	2: "Controller",
 }
 var ApiEndpointType_CamelValue = map[string]int32{
 	"UnknownApiEndpointType": 0,
 	"Dme":                    1,
+	"Controller":             2,
 }
 
 func ParseApiEndpointType(data interface{}) (ApiEndpointType, error) {
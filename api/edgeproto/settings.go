@@ -15,6 +15,7 @@
 package edgeproto
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -163,6 +164,24 @@ func (s *Settings) Validate(fmap objstore.FieldMap) error {
 			v.CheckGT(f, s.PlatformHaInstancePollInterval, Duration(10*time.Millisecond))
 		case SettingsFieldCcrmApiTimeout:
 			v.CheckGT(f, s.CcrmApiTimeout, dur0)
+		case SettingsFieldFindCloudletScoreWeights:
+			// no validation
+		case SettingsFieldFindCloudletScoreWeightsDistance:
+			v.CheckGTE(f, s.FindCloudletScoreWeights.Distance, float64(0))
+		case SettingsFieldFindCloudletScoreWeightsLatency:
+			v.CheckGTE(f, s.FindCloudletScoreWeights.Latency, float64(0))
+		case SettingsFieldFindCloudletScoreWeightsResourceUsage:
+			v.CheckGTE(f, s.FindCloudletScoreWeights.ResourceUsage, float64(0))
+		case SettingsFieldFindCloudletScoreWeightsHealth:
+			v.CheckGTE(f, s.FindCloudletScoreWeights.Health, float64(0))
+		case SettingsFieldFindCloudletDistribution:
+			if err := s.ValidateEnums(); err != nil {
+				return err
+			}
+		case SettingsFieldFindCloudletStickySessions:
+			// no validation
+		case SettingsFieldMaxObjectHistoryRevisions:
+			v.CheckGT(f, s.MaxObjectHistoryRevisions, int32(0))
 		default:
 			// If this is a setting field (and not "fields"), ensure there is an entry in the switch
 			// above.  If no validation is to be done for a field, make an empty case entry
@@ -231,10 +250,29 @@ func GetDefaultSettings() *Settings {
 	s.PlatformHaInstanceActiveExpireTime = Duration(1 * time.Second)
 	s.PlatformHaInstancePollInterval = Duration(300 * time.Millisecond)
 	s.CcrmApiTimeout = Duration(30 * time.Second)
+	s.FindCloudletScoreWeights = FindCloudletScoreWeights{
+		Distance: 1,
+	}
+	s.MaxObjectHistoryRevisions = 20
 
 	return &s
 }
 
+// IsZero returns true if no weights are set.
+func (s *FindCloudletScoreWeights) IsZero() bool {
+	return s.Distance == 0 && s.Latency == 0 && s.ResourceUsage == 0 && s.Health == 0
+}
+
+func (s *FindCloudletScoreWeights) Validate() error {
+	if s.Distance < 0 || s.Latency < 0 || s.ResourceUsage < 0 || s.Health < 0 {
+		return errors.New("find cloudlet score weights cannot be negative")
+	}
+	if s.IsZero() {
+		return errors.New("at least one find cloudlet score weight must be set")
+	}
+	return nil
+}
+
 func (s *SettingsCache) Singular() *Settings {
 	cur := Settings{}
 	if s.Get(&SettingsKeySingular, &cur) {
@@ -7,6 +7,7 @@ import (
 	context "context"
 	encoding_binary "encoding/binary"
 	"encoding/json"
+	"errors"
 	fmt "fmt"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
 	"github.com/edgexr/edge-cloud-platform/pkg/objstore"
@@ -22,6 +23,8 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	reflect "reflect"
+	"strconv"
 	strings "strings"
 )
 
@@ -36,6 +39,40 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// FindCloudletDistribution determines how FindCloudlet chooses
+// between AppInsts whose scores are within VeryCloseDistanceKm of
+// the best score, to spread clients across them.
+type FindCloudletDistribution int32
+
+const (
+	// Choose an AppInst at random
+	FindCloudletDistribution_DISTRIBUTION_RANDOM FindCloudletDistribution = 0
+	// Choose an AppInst at random, weighted by the free resources of its cloudlet
+	FindCloudletDistribution_DISTRIBUTION_WEIGHTED_RANDOM FindCloudletDistribution = 1
+	// Choose each AppInst in turn
+	FindCloudletDistribution_DISTRIBUTION_ROUND_ROBIN FindCloudletDistribution = 2
+)
+
+var FindCloudletDistribution_name = map[int32]string{
+	0: "DISTRIBUTION_RANDOM",
+	1: "DISTRIBUTION_WEIGHTED_RANDOM",
+	2: "DISTRIBUTION_ROUND_ROBIN",
+}
+
+var FindCloudletDistribution_value = map[string]int32{
+	"DISTRIBUTION_RANDOM":          0,
+	"DISTRIBUTION_WEIGHTED_RANDOM": 1,
+	"DISTRIBUTION_ROUND_ROBIN":     2,
+}
+
+func (x FindCloudletDistribution) String() string {
+	return proto.EnumName(FindCloudletDistribution_name, int32(x))
+}
+
+func (FindCloudletDistribution) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6c7cab62fa432213, []int{0}
+}
+
 // Global settings
 type Settings struct {
 	// Fields are used for the Update API to specify which fields to apply
@@ -120,6 +157,14 @@ type Settings struct {
 	PlatformHaInstanceActiveExpireTime Duration `protobuf:"varint,43,opt,name=platform_ha_instance_active_expire_time,json=platformHaInstanceActiveExpireTime,proto3,casttype=Duration" json:"platform_ha_instance_active_expire_time,omitempty"`
 	// Timeout for controller platform-specific API calls to CCRM
 	CcrmApiTimeout Duration `protobuf:"varint,44,opt,name=ccrm_api_timeout,json=ccrmApiTimeout,proto3,casttype=Duration" json:"ccrm_api_timeout,omitempty"`
+	// Weights used by FindCloudlet to rank AppInsts, unless overridden by the App
+	FindCloudletScoreWeights FindCloudletScoreWeights `protobuf:"bytes,45,opt,name=find_cloudlet_score_weights,json=findCloudletScoreWeights,proto3" json:"find_cloudlet_score_weights"`
+	// How FindCloudlet distributes clients between AppInsts with near equal scores
+	FindCloudletDistribution FindCloudletDistribution `protobuf:"varint,46,opt,name=find_cloudlet_distribution,json=findCloudletDistribution,proto3,enum=edgeproto.FindCloudletDistribution" json:"find_cloudlet_distribution,omitempty"`
+	// Keep sending a client to the same AppInst until the client moves to a different location tile
+	FindCloudletStickySessions bool `protobuf:"varint,47,opt,name=find_cloudlet_sticky_sessions,json=findCloudletStickySessions,proto3" json:"find_cloudlet_sticky_sessions,omitempty"`
+	// Maximum number of past revisions returned by the object history APIs, older revisions are also lost when the database history is compacted
+	MaxObjectHistoryRevisions int32 `protobuf:"varint,48,opt,name=max_object_history_revisions,json=maxObjectHistoryRevisions,proto3" json:"max_object_history_revisions,omitempty"`
 }
 
 func (m *Settings) Reset()         { *m = Settings{} }
@@ -155,6 +200,54 @@ func (m *Settings) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Settings proto.InternalMessageInfo
 
+// FindCloudletScoreWeights determine how FindCloudlet ranks AppInsts.
+// Each AppInst is given a score, where lower is better. The score is
+// in units of distance (km), so each weight gives the distance that
+// one unit of its metric is equivalent to.
+type FindCloudletScoreWeights struct {
+	// Weight per km of distance from the client to the cloudlet
+	Distance float64 `protobuf:"fixed64,1,opt,name=distance,proto3" json:"distance,omitempty"`
+	// Weight per ms of average latency reported by clients of the AppInst
+	Latency float64 `protobuf:"fixed64,2,opt,name=latency,proto3" json:"latency,omitempty"`
+	// Weight per percent used of the most used cloudlet infra resource
+	ResourceUsage float64 `protobuf:"fixed64,3,opt,name=resource_usage,json=resourceUsage,proto3" json:"resource_usage,omitempty"`
+	// Weight added if the AppInst health check has not reported healthy
+	Health float64 `protobuf:"fixed64,4,opt,name=health,proto3" json:"health,omitempty"`
+}
+
+func (m *FindCloudletScoreWeights) Reset()         { *m = FindCloudletScoreWeights{} }
+func (m *FindCloudletScoreWeights) String() string { return proto.CompactTextString(m) }
+func (*FindCloudletScoreWeights) ProtoMessage()    {}
+func (*FindCloudletScoreWeights) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c7cab62fa432213, []int{1}
+}
+func (m *FindCloudletScoreWeights) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FindCloudletScoreWeights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FindCloudletScoreWeights.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FindCloudletScoreWeights) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindCloudletScoreWeights.Merge(m, src)
+}
+func (m *FindCloudletScoreWeights) XXX_Size() int {
+	return m.Size()
+}
+func (m *FindCloudletScoreWeights) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindCloudletScoreWeights.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindCloudletScoreWeights proto.InternalMessageInfo
+
 // Collection interval for Influxdb (Specifically used for cq intervals, because cannot gogoproto.casttype to Duration for repeated fields otherwise)
 type CollectionInterval struct {
 	// Collection interval for Influxdb (Specifically used for continuous query intervals) (Data from old continuous queries will be inaccessible if intervals are updated)
@@ -167,7 +260,7 @@ func (m *CollectionInterval) Reset()         { *m = CollectionInterval{} }
 func (m *CollectionInterval) String() string { return proto.CompactTextString(m) }
 func (*CollectionInterval) ProtoMessage()    {}
 func (*CollectionInterval) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c7cab62fa432213, []int{1}
+	return fileDescriptor_6c7cab62fa432213, []int{2}
 }
 func (m *CollectionInterval) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -197,109 +290,127 @@ func (m *CollectionInterval) XXX_DiscardUnknown() {
 var xxx_messageInfo_CollectionInterval proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("edgeproto.FindCloudletDistribution", FindCloudletDistribution_name, FindCloudletDistribution_value)
 	proto.RegisterType((*Settings)(nil), "edgeproto.Settings")
+	proto.RegisterType((*FindCloudletScoreWeights)(nil), "edgeproto.FindCloudletScoreWeights")
 	proto.RegisterType((*CollectionInterval)(nil), "edgeproto.CollectionInterval")
 }
 
 func init() { proto.RegisterFile("settings.proto", fileDescriptor_6c7cab62fa432213) }
 
 var fileDescriptor_6c7cab62fa432213 = []byte{
-	// 1509 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x97, 0xcf, 0x6f, 0x14, 0xc9,
-	0x15, 0xc7, 0xdd, 0x18, 0x88, 0x5d, 0x18, 0xe3, 0xb4, 0x7f, 0xd0, 0x1e, 0xc6, 0xe3, 0x61, 0x30,
-	0x62, 0x30, 0x16, 0x23, 0x81, 0x08, 0x8a, 0x91, 0x22, 0x0d, 0x33, 0x8e, 0x70, 0xc0, 0xc4, 0xf4,
-	0x18, 0x48, 0x22, 0x45, 0xa5, 0x72, 0xf7, 0x73, 0x4f, 0x85, 0xea, 0xae, 0xa6, 0xab, 0xda, 0x3f,
-	0x6e, 0x51, 0xfe, 0x02, 0xa4, 0x9c, 0xf2, 0x6f, 0xe4, 0xaf, 0xe0, 0x48, 0x94, 0x4b, 0x4e, 0xd1,
-	0x2e, 0xec, 0x61, 0x85, 0xf6, 0xb0, 0x5a, 0xcc, 0x6a, 0xb5, 0xa7, 0x55, 0x55, 0xff, 0x18, 0xdb,
-	0x53, 0xa0, 0xdd, 0xdb, 0x4c, 0xd5, 0xfb, 0x7e, 0xde, 0xab, 0x7e, 0xaf, 0xde, 0xeb, 0x46, 0x93,
-	0x02, 0xa4, 0xa4, 0x51, 0x20, 0x6e, 0xc6, 0x09, 0x97, 0xdc, 0x1e, 0x07, 0x3f, 0x00, 0xfd, 0xb3,
-	0x32, 0x91, 0x80, 0x48, 0x99, 0xcc, 0x36, 0x2a, 0xd5, 0x80, 0xf3, 0x80, 0x41, 0x8b, 0xc4, 0xb4,
-	0x45, 0xa2, 0x88, 0x4b, 0x22, 0x29, 0x8f, 0x72, 0x59, 0x65, 0x41, 0x72, 0xce, 0x44, 0x4b, 0xff,
-	0x09, 0x20, 0x2a, 0x7f, 0xe4, 0xdb, 0x33, 0x01, 0x0f, 0xb8, 0xfe, 0xd9, 0x52, 0xbf, 0xb2, 0xd5,
-	0xc6, 0x7f, 0xe6, 0xd1, 0x58, 0x2f, 0x77, 0x6f, 0xcf, 0xa1, 0xb3, 0x3b, 0x14, 0x98, 0x2f, 0x1c,
-	0xab, 0x3e, 0xda, 0x1c, 0x77, 0xf3, 0x7f, 0xf6, 0x5f, 0xd1, 0x92, 0xe8, 0x43, 0xdc, 0x87, 0xc4,
-	0xc7, 0x21, 0xc8, 0x84, 0x7a, 0x02, 0x7b, 0x9c, 0x31, 0xf0, 0x94, 0x7f, 0x4c, 0x23, 0x09, 0xc9,
-	0x2e, 0x61, 0xce, 0xa9, 0xba, 0xd5, 0x1c, 0xbd, 0x3f, 0xf1, 0xe3, 0xff, 0x17, 0xc7, 0xba, 0x69,
-	0xa2, 0x83, 0x73, 0x2f, 0x17, 0xca, 0x8d, 0x4c, 0xd8, 0x29, 0x75, 0xeb, 0xb9, 0xcc, 0xfe, 0x33,
-	0x6a, 0x94, 0x78, 0xc2, 0x20, 0x91, 0x18, 0x76, 0x09, 0x4b, 0xc9, 0x71, 0xf8, 0x8c, 0x01, 0xbe,
-	0x58, 0xe8, 0xda, 0x4a, 0xb6, 0x56, 0xaa, 0x4a, 0xf4, 0x53, 0x54, 0x1f, 0x8a, 0x5c, 0x78, 0x09,
-	0x89, 0x61, 0x00, 0x6e, 0x1a, 0xc0, 0x0b, 0x27, 0xa2, 0xee, 0x69, 0x4d, 0x89, 0x6d, 0xa3, 0xd2,
-	0x00, 0xf7, 0x81, 0x30, 0xd9, 0xc7, 0x5e, 0x1f, 0xbc, 0x17, 0x38, 0x51, 0xe6, 0x20, 0x9c, 0xd1,
-	0xba, 0xd5, 0x3c, 0xe3, 0x56, 0x0a, 0xa3, 0x07, 0xda, 0xa6, 0xa3, 0x4c, 0xdc, 0xcc, 0xc2, 0x7e,
-	0x82, 0x6a, 0x66, 0x44, 0x19, 0xd7, 0x69, 0x43, 0x5c, 0x97, 0x0c, 0xc4, 0x32, 0xaa, 0xbb, 0xc8,
-	0x21, 0xa9, 0xe4, 0xd8, 0x87, 0x98, 0xf1, 0x83, 0x12, 0x84, 0x05, 0x78, 0xce, 0x99, 0xba, 0xd5,
-	0xb4, 0xdc, 0x59, 0xb5, 0xdf, 0xd5, 0xdb, 0x85, 0xaa, 0x07, 0x9e, 0x7d, 0x1b, 0xcd, 0x1d, 0x15,
-	0xf2, 0x9d, 0x1d, 0x01, 0x52, 0xcb, 0xce, 0x6a, 0xd9, 0xf4, 0x40, 0xf6, 0x47, 0xbd, 0xa7, 0x44,
-	0xbf, 0x45, 0xf3, 0x47, 0x45, 0x21, 0xd9, 0x2f, 0x3d, 0x0a, 0xe7, 0x57, 0x75, 0xab, 0x79, 0xde,
-	0x9d, 0x1b, 0xe8, 0x36, 0xc8, 0x7e, 0xe1, 0x51, 0xd8, 0x1d, 0x74, 0xd1, 0x4b, 0x80, 0x48, 0xc0,
-	0x24, 0x8e, 0x31, 0x8d, 0x84, 0xc4, 0x92, 0x86, 0xc0, 0x53, 0xe9, 0x8c, 0x19, 0x0e, 0x3d, 0x93,
-	0x19, 0xb7, 0xe3, 0x78, 0x3d, 0x12, 0x72, 0x2b, 0xb3, 0x54, 0x90, 0x34, 0xf6, 0x8d, 0x90, 0x71,
-	0x13, 0x24, 0x33, 0x1e, 0x86, 0xf8, 0xc0, 0xc0, 0x04, 0x41, 0x26, 0x48, 0x66, 0x7c, 0x02, 0xf2,
-	0x10, 0x5d, 0xca, 0x8f, 0xe3, 0xb1, 0x54, 0x48, 0x48, 0x8e, 0x83, 0xce, 0x19, 0x40, 0x4e, 0x26,
-	0xe8, 0x64, 0xf6, 0x27, 0x60, 0xf9, 0xb1, 0x8c, 0xb0, 0x09, 0x13, 0x2c, 0x13, 0x98, 0x61, 0xf9,
-	0xf1, 0x8c, 0xb0, 0xf3, 0x26, 0x58, 0x26, 0x30, 0xc0, 0x56, 0x90, 0x1d, 0x12, 0x0d, 0x89, 0xb8,
-	0x0f, 0x78, 0x87, 0x91, 0x5d, 0x9e, 0x38, 0x93, 0x75, 0xab, 0x39, 0xee, 0x4e, 0x65, 0x3b, 0x8f,
-	0xb9, 0x0f, 0xbf, 0xd7, 0xeb, 0xf6, 0x1d, 0x74, 0x51, 0x95, 0x84, 0x4c, 0x88, 0xf7, 0x02, 0x7c,
-	0xec, 0x87, 0x2a, 0x06, 0x0a, 0x91, 0x14, 0xce, 0x94, 0xbe, 0x1c, 0x33, 0x21, 0xd9, 0xdf, 0xca,
-	0x76, 0xbb, 0x21, 0x74, 0xb2, 0x3d, 0x15, 0x31, 0x8d, 0x76, 0x58, 0xba, 0x8f, 0xfd, 0xed, 0xf2,
-	0xc6, 0x26, 0x20, 0x21, 0x52, 0xd1, 0x39, 0xb6, 0x29, 0xe2, 0x4c, 0xd0, 0xdd, 0xce, 0xef, 0xaa,
-	0x5b, 0x58, 0xdb, 0x8f, 0x51, 0xd5, 0x63, 0x3c, 0xf5, 0x19, 0x48, 0x1c, 0x12, 0x55, 0x9d, 0x11,
-	0x89, 0x3c, 0x28, 0xcf, 0x3f, 0xad, 0x02, 0x39, 0x41, 0xab, 0x14, 0x8a, 0x8d, 0x81, 0xa0, 0x78,
-	0x02, 0x6d, 0x34, 0x97, 0xe7, 0x66, 0x37, 0xc4, 0x31, 0xe7, 0xac, 0x24, 0xcd, 0x1a, 0xe2, 0x9a,
-	0xce, 0x6c, 0x9f, 0x85, 0x9b, 0x9c, 0xb3, 0xe1, 0xf4, 0xca, 0x24, 0x15, 0x12, 0xc7, 0x9c, 0x51,
-	0xef, 0xa0, 0xe4, 0xcc, 0x7d, 0x3a, 0xbd, 0x5b, 0xca, 0x7e, 0x53, 0x9b, 0x17, 0xb0, 0xbf, 0xa0,
-	0x2b, 0xea, 0xb9, 0x92, 0x98, 0x7e, 0xb6, 0x2d, 0x5f, 0x34, 0x75, 0x4e, 0x3f, 0x84, 0x76, 0x4c,
-	0x3f, 0xdd, 0x94, 0xb7, 0xd1, 0x35, 0x35, 0x86, 0x30, 0xec, 0xaa, 0xbc, 0x7c, 0x96, 0xef, 0x18,
-	0xf8, 0x57, 0x94, 0x78, 0x4d, 0x6b, 0x3f, 0xed, 0xc3, 0x47, 0x4d, 0x8f, 0x01, 0x89, 0xd2, 0x18,
-	0x27, 0x20, 0xd4, 0xda, 0x36, 0x03, 0xac, 0xbb, 0x4a, 0x59, 0xaf, 0x2a, 0x15, 0x34, 0x04, 0x67,
-	0xde, 0xe0, 0x64, 0x29, 0x57, 0xbb, 0xa5, 0xb8, 0x9d, 0x4a, 0x5e, 0x94, 0x6e, 0xae, 0xb4, 0x03,
-	0xb4, 0x3c, 0x28, 0xa9, 0xb2, 0x1e, 0x52, 0x41, 0x02, 0x30, 0x54, 0x58, 0xc5, 0xe0, 0xe7, 0x6a,
-	0x51, 0x61, 0x9d, 0x5c, 0xfd, 0x54, 0x89, 0x87, 0xca, 0xad, 0x5b, 0xb6, 0xb5, 0xd2, 0x4b, 0x91,
-	0xd7, 0x4b, 0x06, 0xea, 0x6c, 0xd1, 0x03, 0x32, 0xdb, 0x22, 0xa9, 0xdd, 0xb2, 0xaf, 0x0d, 0x51,
-	0xaa, 0x26, 0x4a, 0x71, 0xf9, 0x8f, 0x53, 0x7e, 0x87, 0xaa, 0x8c, 0x7b, 0xd9, 0x08, 0x95, 0x94,
-	0x01, 0x16, 0xd4, 0x07, 0xcc, 0x20, 0x0a, 0x64, 0x1f, 0xbf, 0x08, 0x9d, 0x05, 0x85, 0x72, 0x9d,
-	0xc2, 0x66, 0x8b, 0x32, 0xe8, 0x51, 0x1f, 0x1e, 0x69, 0x83, 0x87, 0xa1, 0xfd, 0x2f, 0x0b, 0xdd,
-	0x33, 0xe7, 0x3f, 0x92, 0x34, 0x4a, 0x79, 0x2a, 0xf0, 0xcb, 0x14, 0xd4, 0x24, 0x33, 0x95, 0x84,
-	0x70, 0x6a, 0xf5, 0xd1, 0xe6, 0xb9, 0x5b, 0x0b, 0x37, 0xcb, 0x57, 0x99, 0x9b, 0xc3, 0xf9, 0x77,
-	0xef, 0x18, 0x8a, 0xa4, 0xc0, 0x3f, 0xc9, 0xe8, 0xc3, 0x2a, 0xa1, 0x4a, 0x73, 0x90, 0x50, 0x9f,
-	0xef, 0x45, 0x82, 0x84, 0x31, 0x03, 0xdf, 0x90, 0xcd, 0x45, 0x53, 0x69, 0x16, 0xd9, 0xec, 0x0e,
-	0xa4, 0x43, 0xb9, 0x24, 0x47, 0x7d, 0x98, 0x1e, 0xc4, 0xc0, 0x47, 0xdd, 0xe0, 0xa3, 0x51, 0xf8,
-	0x58, 0x3b, 0x79, 0xc2, 0x81, 0x8b, 0x1e, 0x5a, 0x24, 0x71, 0xac, 0x1b, 0x72, 0xd6, 0x19, 0x71,
-	0x71, 0x19, 0xca, 0x9b, 0x75, 0xd9, 0x80, 0xae, 0xe6, 0xa2, 0xac, 0x63, 0x76, 0x32, 0x49, 0x79,
-	0xa5, 0x9e, 0xa3, 0xeb, 0xc5, 0xd5, 0xd1, 0xf7, 0x48, 0x78, 0x44, 0x5d, 0xa9, 0x5d, 0x48, 0x48,
-	0x40, 0xa3, 0x00, 0xfb, 0x39, 0x46, 0x4f, 0xf7, 0x86, 0x2e, 0x82, 0xa5, 0x5c, 0xa0, 0xee, 0x4e,
-	0x4f, 0x99, 0xb7, 0x0b, 0xeb, 0xc2, 0xa7, 0x1a, 0xf7, 0x9b, 0xa8, 0x66, 0x00, 0xab, 0xf7, 0x9d,
-	0x03, 0xec, 0x03, 0x23, 0x07, 0xce, 0x15, 0x43, 0xb0, 0x95, 0x93, 0x6c, 0xf5, 0xfa, 0x73, 0xd0,
-	0x55, 0xf6, 0xf6, 0x63, 0xb4, 0x90, 0xbd, 0xed, 0xe5, 0x3d, 0x30, 0xa4, 0x11, 0x96, 0x09, 0x0d,
-	0x02, 0x48, 0x74, 0xc5, 0x3b, 0x4b, 0x06, 0xe0, 0xbc, 0x96, 0x64, 0x6d, 0x70, 0x83, 0x46, 0x5b,
-	0x99, 0xbd, 0xaa, 0x7a, 0x35, 0x9f, 0x7c, 0x2a, 0x74, 0x0b, 0x49, 0xd4, 0xf5, 0x61, 0x34, 0xa4,
-	0xd2, 0xb9, 0x5a, 0xb7, 0x9a, 0x63, 0xee, 0x54, 0xbe, 0xe3, 0x12, 0x09, 0x8f, 0xd4, 0xba, 0xbd,
-	0x8a, 0x2a, 0x03, 0x2b, 0x7c, 0x74, 0x54, 0xd1, 0x58, 0x38, 0xd7, 0xf4, 0x93, 0x99, 0x4b, 0x0a,
-	0xf3, 0x8d, 0x72, 0x56, 0xad, 0xc7, 0xc2, 0x7e, 0x8e, 0x2e, 0x27, 0x20, 0x78, 0x9a, 0x78, 0x80,
-	0x45, 0x44, 0x62, 0xd1, 0xe7, 0x12, 0xcb, 0x7e, 0x02, 0xc4, 0x1f, 0xe4, 0xee, 0xba, 0x21, 0xfa,
-	0x5a, 0x21, 0xeb, 0xe5, 0xaa, 0x2d, 0x2d, 0x2a, 0xb3, 0xf7, 0x27, 0xd4, 0x88, 0x19, 0x91, 0x3b,
-	0x3c, 0x09, 0x71, 0x9f, 0xe8, 0x61, 0xad, 0x07, 0x56, 0xcc, 0x19, 0x1b, 0x90, 0x97, 0x4d, 0xe4,
-	0x42, 0xf7, 0x80, 0xac, 0xe7, 0xaa, 0x4d, 0xce, 0x58, 0x49, 0x26, 0xe8, 0x9a, 0x91, 0x4c, 0x3c,
-	0x49, 0x77, 0x01, 0xc3, 0x7e, 0x4c, 0x93, 0x6c, 0x30, 0x3a, 0x37, 0x4c, 0xf5, 0x3c, 0x8c, 0x6f,
-	0x6b, 0xe5, 0x9a, 0x16, 0xea, 0xe7, 0xff, 0x1b, 0x34, 0xe5, 0x79, 0x49, 0xa8, 0xc7, 0x51, 0xd1,
-	0xb1, 0x56, 0x0c, 0xac, 0x49, 0x65, 0xd5, 0x8e, 0x69, 0xde, 0xaa, 0x56, 0x6f, 0x7c, 0xfd, 0xc1,
-	0xb1, 0xbe, 0xfd, 0xe0, 0x58, 0x7f, 0x3f, 0x74, 0xac, 0x57, 0x87, 0x8e, 0xf5, 0xdd, 0x47, 0xe7,
-	0x5c, 0xf1, 0x4d, 0xf2, 0x10, 0x0e, 0x7e, 0xf8, 0xe8, 0x58, 0xff, 0xfe, 0xde, 0x39, 0x1d, 0xf1,
-	0x08, 0xfe, 0x70, 0x7a, 0xec, 0xc2, 0xd4, 0x94, 0x5b, 0x65, 0x9c, 0xf8, 0x78, 0x9b, 0x30, 0x15,
-	0x48, 0xa2, 0xb3, 0x17, 0xf3, 0x44, 0xe2, 0x84, 0x44, 0x01, 0x34, 0xfe, 0x86, 0x6c, 0xc3, 0xb0,
-	0x69, 0xa2, 0xb1, 0xf2, 0x09, 0x5a, 0x86, 0xb0, 0xca, 0x5d, 0x7b, 0x19, 0x8d, 0x0f, 0x6e, 0xb7,
-	0xe9, 0x9b, 0x66, 0xb0, 0x7d, 0xeb, 0x9b, 0x53, 0xa8, 0x8c, 0xb5, 0x1d, 0x53, 0x3b, 0x45, 0x93,
-	0x4f, 0x75, 0x43, 0x2e, 0x3f, 0xaa, 0xa6, 0x8f, 0xf4, 0xc0, 0x62, 0xb1, 0xf2, 0xeb, 0x23, 0x8b,
-	0xae, 0xfe, 0xc4, 0x6b, 0xdc, 0x7b, 0x7f, 0xe8, 0x54, 0xdd, 0xbc, 0x3e, 0x3a, 0x3c, 0xda, 0xa1,
-	0xc1, 0x4a, 0x5b, 0x1f, 0x61, 0x83, 0x44, 0x24, 0x80, 0x95, 0x7f, 0xfc, 0xf7, 0xab, 0x7f, 0x9e,
-	0x9a, 0x6d, 0x4c, 0xb5, 0xb2, 0x8e, 0xdf, 0x2a, 0xbe, 0x1a, 0x57, 0xad, 0x65, 0x5b, 0xa0, 0xf3,
-	0x6a, 0x08, 0xca, 0x5f, 0xec, 0x75, 0xf5, 0x67, 0x79, 0x9d, 0x69, 0x5c, 0x68, 0xa9, 0x09, 0x2d,
-	0x8f, 0x39, 0x7d, 0x89, 0x26, 0x7a, 0x7d, 0xbe, 0xf7, 0x79, 0x9f, 0xa6, 0xc5, 0xc6, 0xdd, 0xf7,
-	0x87, 0x4e, 0xc5, 0xe8, 0xf5, 0x19, 0x85, 0xbd, 0xcc, 0xe7, 0x74, 0x63, 0xb2, 0x25, 0xfa, 0x7c,
-	0xef, 0xa8, 0xcb, 0xfb, 0xd5, 0xd7, 0x5f, 0xd6, 0x46, 0x5e, 0xbf, 0xad, 0x59, 0x6f, 0xde, 0xd6,
-	0xac, 0x2f, 0xde, 0xd6, 0xac, 0x57, 0xef, 0x6a, 0x23, 0x6f, 0xde, 0xd5, 0x46, 0xfe, 0xf7, 0xae,
-	0x36, 0xb2, 0x7d, 0x56, 0xbb, 0xb9, 0xfd, 0x53, 0x00, 0x00, 0x00, 0xff, 0xff, 0x23, 0xba, 0xcc,
-	0xe1, 0x51, 0x0f, 0x00, 0x00,
+	// 1776 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x4d, 0x6f, 0x1c, 0xb7,
+	0x19, 0xd6, 0xd8, 0x8e, 0x23, 0xd1, 0xb6, 0xa2, 0x8e, 0x64, 0x99, 0x5e, 0xaf, 0x56, 0xeb, 0xb5,
+	0x0d, 0x6f, 0x14, 0xd7, 0x2a, 0x1c, 0xa4, 0x41, 0x1d, 0xa0, 0xc5, 0x4a, 0xab, 0xd4, 0xaa, 0x23,
+	0xc9, 0x99, 0x95, 0xe2, 0xb6, 0x40, 0x41, 0x50, 0x33, 0xdc, 0x59, 0xc6, 0x9c, 0xe1, 0x84, 0xe4,
+	0x48, 0xda, 0x5b, 0xd1, 0x3f, 0xd0, 0x00, 0x3d, 0xf5, 0xda, 0x9f, 0xd0, 0x5f, 0xe1, 0x63, 0x80,
+	0x5e, 0x7a, 0x0a, 0x5a, 0xbb, 0x87, 0x22, 0xe8, 0xa1, 0x68, 0xec, 0xa2, 0xe8, 0xa9, 0x20, 0x67,
+	0x38, 0xbb, 0xab, 0xa5, 0x8c, 0xe6, 0xb6, 0x43, 0x3e, 0xcf, 0xf3, 0xbe, 0xe4, 0xfb, 0x41, 0x72,
+	0xc1, 0xbc, 0x24, 0x4a, 0xd1, 0x34, 0x96, 0xf7, 0x33, 0xc1, 0x15, 0xf7, 0xe7, 0x48, 0x14, 0x13,
+	0xf3, 0xb3, 0x76, 0x59, 0x10, 0x99, 0x33, 0x55, 0x4c, 0xd4, 0xea, 0x31, 0xe7, 0x31, 0x23, 0xeb,
+	0x38, 0xa3, 0xeb, 0x38, 0x4d, 0xb9, 0xc2, 0x8a, 0xf2, 0xb4, 0xa4, 0xd5, 0x56, 0x14, 0xe7, 0x4c,
+	0xae, 0x9b, 0x8f, 0x98, 0xa4, 0xd5, 0x8f, 0x72, 0x7a, 0x29, 0xe6, 0x31, 0x37, 0x3f, 0xd7, 0xf5,
+	0xaf, 0x62, 0xb4, 0xf5, 0x87, 0x3a, 0x98, 0xed, 0x95, 0xe6, 0xfd, 0x65, 0x70, 0xb1, 0x4f, 0x09,
+	0x8b, 0x24, 0xf4, 0x9a, 0xe7, 0xdb, 0x73, 0x41, 0xf9, 0xe5, 0xff, 0x0a, 0xdc, 0x96, 0x03, 0x92,
+	0x0d, 0x88, 0x88, 0x50, 0x42, 0x94, 0xa0, 0xa1, 0x44, 0x21, 0x67, 0x8c, 0x84, 0xda, 0x3e, 0xa2,
+	0xa9, 0x22, 0xe2, 0x08, 0x33, 0x78, 0xae, 0xe9, 0xb5, 0xcf, 0x6f, 0x5c, 0xfe, 0xef, 0xd7, 0xab,
+	0xb3, 0xdd, 0x5c, 0x18, 0xe7, 0x82, 0x9b, 0x96, 0xb9, 0x53, 0x10, 0x37, 0x2b, 0xde, 0x76, 0x49,
+	0xf3, 0x7f, 0x01, 0x5a, 0x95, 0x3c, 0x66, 0x44, 0x28, 0x44, 0x8e, 0x30, 0xcb, 0xf1, 0xa4, 0xf8,
+	0x92, 0x43, 0x7c, 0xd5, 0xf2, 0x3a, 0x9a, 0xb6, 0x55, 0xb1, 0x2a, 0xe9, 0x03, 0xd0, 0x9c, 0xf2,
+	0x5c, 0x86, 0x02, 0x67, 0x64, 0x24, 0xdc, 0x76, 0x08, 0xaf, 0x9c, 0xf2, 0xba, 0x67, 0x38, 0x95,
+	0x6c, 0x07, 0x54, 0x00, 0x34, 0x20, 0x98, 0xa9, 0x01, 0x0a, 0x07, 0x24, 0x7c, 0x86, 0x84, 0x86,
+	0x13, 0x09, 0xcf, 0x37, 0xbd, 0xf6, 0x5b, 0x41, 0xcd, 0x82, 0x1e, 0x19, 0xcc, 0xa6, 0x86, 0x04,
+	0x05, 0xc2, 0xff, 0x14, 0x34, 0xdc, 0x12, 0x95, 0x5f, 0x17, 0x1c, 0x7e, 0xdd, 0x70, 0x28, 0x56,
+	0x5e, 0x7d, 0x08, 0x20, 0xce, 0x15, 0x47, 0x11, 0xc9, 0x18, 0x1f, 0x56, 0x42, 0x48, 0x92, 0x10,
+	0xbe, 0xd5, 0xf4, 0xda, 0x5e, 0x70, 0x55, 0xcf, 0x77, 0xcd, 0xb4, 0x65, 0xf5, 0x48, 0xe8, 0xbf,
+	0x0f, 0x96, 0xc7, 0x89, 0xbc, 0xdf, 0x97, 0x44, 0x19, 0xda, 0x45, 0x43, 0x5b, 0x1c, 0xd1, 0xf6,
+	0xcc, 0x9c, 0x26, 0xfd, 0x08, 0x5c, 0x1f, 0x27, 0x25, 0xf8, 0xa4, 0xb2, 0x28, 0xe1, 0xdb, 0x4d,
+	0xaf, 0x7d, 0x25, 0x58, 0x1e, 0xf1, 0x76, 0xf0, 0x89, 0xb5, 0x28, 0xfd, 0x4d, 0x70, 0x2d, 0x14,
+	0x04, 0x2b, 0x82, 0x70, 0x96, 0x21, 0x9a, 0x4a, 0x85, 0x14, 0x4d, 0x08, 0xcf, 0x15, 0x9c, 0x75,
+	0x2c, 0x7a, 0xa9, 0x00, 0x77, 0xb2, 0x6c, 0x3b, 0x95, 0x6a, 0xbf, 0x40, 0x6a, 0x91, 0x3c, 0x8b,
+	0x9c, 0x22, 0x73, 0x2e, 0x91, 0x02, 0x3c, 0x2d, 0x12, 0x11, 0x46, 0x5c, 0x22, 0xc0, 0x25, 0x52,
+	0x80, 0x4f, 0x89, 0x3c, 0x06, 0x37, 0xca, 0xe5, 0x84, 0x2c, 0x97, 0x8a, 0x88, 0x49, 0xa1, 0x4b,
+	0x0e, 0x21, 0x58, 0x10, 0x36, 0x0b, 0xfc, 0x29, 0xb1, 0x72, 0x59, 0x4e, 0xb1, 0xcb, 0x2e, 0xb1,
+	0x82, 0xe0, 0x16, 0x2b, 0x97, 0xe7, 0x14, 0xbb, 0xe2, 0x12, 0x2b, 0x08, 0x0e, 0xb1, 0x7b, 0xc0,
+	0x4f, 0xb0, 0x11, 0x49, 0x79, 0x44, 0x50, 0x9f, 0xe1, 0x23, 0x2e, 0xe0, 0x7c, 0xd3, 0x6b, 0xcf,
+	0x05, 0x0b, 0xc5, 0xcc, 0x2e, 0x8f, 0xc8, 0xc7, 0x66, 0xdc, 0xff, 0x00, 0x5c, 0xd3, 0x29, 0xa1,
+	0x04, 0x0e, 0x9f, 0x91, 0x08, 0x45, 0x89, 0xf6, 0x81, 0x92, 0x54, 0x49, 0xb8, 0x60, 0x8a, 0x63,
+	0x29, 0xc1, 0x27, 0xfb, 0xc5, 0x6c, 0x37, 0x21, 0x9b, 0xc5, 0x9c, 0xf6, 0x98, 0xa6, 0x7d, 0x96,
+	0x9f, 0xa0, 0xe8, 0xb0, 0xaa, 0x58, 0x41, 0x14, 0x49, 0xb5, 0x77, 0xd0, 0x77, 0x79, 0x5c, 0x10,
+	0xba, 0x87, 0x65, 0xad, 0x06, 0x16, 0xed, 0xef, 0x82, 0x7a, 0xc8, 0x78, 0x1e, 0x31, 0xa2, 0x50,
+	0x82, 0x75, 0x76, 0xa6, 0x38, 0x0d, 0x49, 0xb5, 0xfe, 0x45, 0xed, 0xc8, 0x29, 0xb5, 0x9a, 0x65,
+	0xec, 0x8c, 0x08, 0x76, 0x07, 0x3a, 0x60, 0xb9, 0x8c, 0xcd, 0x51, 0x82, 0x32, 0xce, 0x59, 0xa5,
+	0x74, 0xd5, 0xe1, 0xd7, 0x62, 0x81, 0xfd, 0x2c, 0x79, 0xc2, 0x39, 0x9b, 0x0e, 0xaf, 0x12, 0xb9,
+	0x54, 0x28, 0xe3, 0x8c, 0x86, 0xc3, 0x4a, 0x67, 0xf9, 0xec, 0xf0, 0xee, 0x6b, 0xfc, 0x13, 0x03,
+	0xb7, 0x62, 0xbf, 0x04, 0xb7, 0xf4, 0xbe, 0xe2, 0x8c, 0xbe, 0xb1, 0x2d, 0x5f, 0x73, 0x75, 0xce,
+	0x28, 0x21, 0x9d, 0x8c, 0x9e, 0xdd, 0x94, 0x0f, 0xc1, 0x5d, 0x7d, 0x0c, 0x21, 0x72, 0xa4, 0xe3,
+	0xf2, 0x46, 0x7d, 0xe8, 0xd0, 0xbf, 0xa5, 0xc9, 0x5b, 0x86, 0x7b, 0xb6, 0x8d, 0x08, 0xb4, 0x43,
+	0x46, 0x70, 0x9a, 0x67, 0x48, 0x10, 0xa9, 0xc7, 0x0e, 0x19, 0x41, 0xa6, 0xab, 0x54, 0xf9, 0xaa,
+	0x43, 0x41, 0x13, 0x02, 0xaf, 0x3b, 0x8c, 0xdc, 0x2e, 0xd9, 0x41, 0x45, 0xee, 0xe4, 0x8a, 0xdb,
+	0xd4, 0x2d, 0x99, 0x7e, 0x0c, 0xd6, 0x46, 0x29, 0x55, 0xe5, 0x43, 0x2e, 0x71, 0x4c, 0x1c, 0x19,
+	0x56, 0x73, 0xd8, 0xb9, 0x63, 0x33, 0x6c, 0xb3, 0x64, 0x1f, 0x68, 0xf2, 0x54, 0xba, 0x75, 0xab,
+	0xb6, 0x56, 0x59, 0xb1, 0x71, 0xbd, 0xe1, 0x50, 0xbd, 0x6a, 0x7b, 0x40, 0x81, 0xb5, 0x41, 0xed,
+	0x56, 0x7d, 0x6d, 0x4a, 0xa5, 0xee, 0x52, 0xb1, 0xc5, 0x3f, 0xa9, 0xf2, 0x63, 0x50, 0x67, 0x3c,
+	0x34, 0x10, 0xa4, 0x28, 0x23, 0x48, 0xd2, 0x88, 0x20, 0x46, 0xd2, 0x58, 0x0d, 0xd0, 0xb3, 0x04,
+	0xae, 0x68, 0xa9, 0x00, 0x5a, 0xcc, 0x3e, 0x65, 0xa4, 0x47, 0x23, 0xf2, 0x89, 0x01, 0x3c, 0x4e,
+	0xfc, 0xdf, 0x7b, 0xe0, 0x23, 0x77, 0xfc, 0x53, 0x45, 0xd3, 0x9c, 0xe7, 0x12, 0x7d, 0x91, 0x13,
+	0x7d, 0x92, 0xb9, 0x52, 0x42, 0xc2, 0x46, 0xf3, 0x7c, 0xfb, 0xd2, 0x83, 0x95, 0xfb, 0xd5, 0x55,
+	0xe6, 0xfe, 0x74, 0xfc, 0x83, 0x0f, 0x1c, 0x49, 0x62, 0xe5, 0x3f, 0x2d, 0xd4, 0xa7, 0x59, 0x52,
+	0xa7, 0xe6, 0x28, 0xa0, 0x11, 0x3f, 0x4e, 0x25, 0x4e, 0x32, 0x46, 0x22, 0x47, 0x34, 0x57, 0x5d,
+	0xa9, 0x69, 0xa3, 0xd9, 0x1d, 0x51, 0xa7, 0x62, 0x89, 0xc7, 0x6d, 0xb8, 0x36, 0x62, 0x64, 0xa3,
+	0xe9, 0xb0, 0xd1, 0xb2, 0x36, 0xb6, 0x4e, 0xaf, 0x70, 0x64, 0xa2, 0x07, 0x56, 0x71, 0x96, 0x99,
+	0x86, 0x5c, 0x74, 0x46, 0x64, 0x8b, 0xa1, 0xaa, 0xac, 0x9b, 0x0e, 0xe9, 0x7a, 0x49, 0x2a, 0x3a,
+	0xe6, 0x66, 0x41, 0xa9, 0x4a, 0xea, 0x29, 0x78, 0xd7, 0x96, 0x8e, 0xa9, 0x23, 0x19, 0x62, 0x5d,
+	0x52, 0x47, 0x44, 0xe0, 0x98, 0xa6, 0x31, 0x8a, 0x4a, 0x19, 0x73, 0xba, 0xb7, 0x4c, 0x12, 0xdc,
+	0x2e, 0x09, 0xba, 0x76, 0x7a, 0x1a, 0xde, 0xb1, 0x68, 0x6b, 0x53, 0x1f, 0xf7, 0x4f, 0x40, 0xc3,
+	0x21, 0x2c, 0x88, 0x12, 0x43, 0x14, 0x11, 0x86, 0x87, 0xf0, 0x96, 0xc3, 0xd9, 0xda, 0x69, 0x6d,
+	0x7d, 0xfd, 0x19, 0x76, 0x35, 0xde, 0xdf, 0x05, 0x2b, 0xc5, 0x6d, 0xaf, 0xec, 0x81, 0x09, 0x4d,
+	0x91, 0x12, 0x34, 0x8e, 0x89, 0x30, 0x19, 0x0f, 0x6f, 0x3b, 0x04, 0xaf, 0x1b, 0x4a, 0xd1, 0x06,
+	0x77, 0x68, 0xba, 0x5f, 0xe0, 0x75, 0xd6, 0xeb, 0xf3, 0x29, 0xa2, 0xd2, 0xb4, 0x10, 0xa1, 0xcb,
+	0x87, 0xd1, 0x84, 0x2a, 0x78, 0xa7, 0xe9, 0xb5, 0x67, 0x83, 0x85, 0x72, 0x26, 0xc0, 0x8a, 0x7c,
+	0xa2, 0xc7, 0xfd, 0x87, 0xa0, 0x36, 0x42, 0xa1, 0xf1, 0xa3, 0x8a, 0x66, 0x12, 0xde, 0x35, 0x3b,
+	0xb3, 0x2c, 0x2c, 0x7c, 0xa7, 0x3a, 0xab, 0xb6, 0x33, 0xe9, 0x3f, 0x05, 0x37, 0x05, 0x91, 0x3c,
+	0x17, 0x21, 0x41, 0x32, 0xc5, 0x99, 0x1c, 0x70, 0x85, 0xd4, 0x40, 0x10, 0x1c, 0x8d, 0x62, 0xf7,
+	0xae, 0xc3, 0xfb, 0x86, 0xa5, 0xf5, 0x4a, 0xd6, 0xbe, 0x21, 0x55, 0xd1, 0xfb, 0x39, 0x68, 0x65,
+	0x0c, 0xab, 0x3e, 0x17, 0x09, 0x1a, 0x60, 0x73, 0x58, 0x9b, 0x03, 0x2b, 0xe3, 0x8c, 0x8d, 0x94,
+	0xd7, 0x5c, 0xca, 0x96, 0xf7, 0x08, 0x6f, 0x97, 0xac, 0x27, 0x9c, 0xb1, 0x4a, 0x19, 0x83, 0xbb,
+	0x4e, 0x65, 0x1c, 0x2a, 0x7a, 0x44, 0x10, 0x39, 0xc9, 0xa8, 0x28, 0x0e, 0x46, 0xf8, 0x9e, 0x2b,
+	0x9f, 0xa7, 0xe5, 0x3b, 0x86, 0xb9, 0x65, 0x88, 0x66, 0xff, 0x7f, 0x08, 0x16, 0xc2, 0x50, 0x24,
+	0xe6, 0x38, 0xb2, 0x1d, 0xeb, 0x9e, 0x43, 0x6b, 0x5e, 0xa3, 0x3a, 0x19, 0xb5, 0xad, 0x6a, 0x00,
+	0x6e, 0xf4, 0x69, 0x1a, 0x8d, 0xda, 0x9d, 0x0c, 0xb9, 0x20, 0xe8, 0x98, 0xd0, 0x78, 0xa0, 0x24,
+	0xfc, 0x7e, 0xd3, 0x6b, 0x5f, 0x7a, 0x70, 0x6b, 0xac, 0x93, 0x7c, 0x4c, 0xd3, 0xc8, 0xf6, 0xbb,
+	0x9e, 0xc6, 0x3e, 0x2d, 0xa0, 0x1b, 0x17, 0x9e, 0x7f, 0xbd, 0x3a, 0x13, 0xc0, 0xfe, 0x19, 0xf3,
+	0x3e, 0x06, 0xb5, 0x49, 0x4b, 0x11, 0x95, 0x4a, 0xd0, 0xc3, 0xdc, 0xd4, 0xf1, 0xfd, 0xa6, 0xd7,
+	0x9e, 0x3f, 0xd3, 0x50, 0x77, 0x0c, 0x3a, 0x69, 0x62, 0x7c, 0x46, 0xbf, 0x0c, 0x4e, 0x2d, 0x46,
+	0xd1, 0xf0, 0xd9, 0x10, 0x49, 0x22, 0x25, 0xe5, 0xa9, 0x84, 0xeb, 0x26, 0x1f, 0x6b, 0x13, 0x3e,
+	0x1a, 0x48, 0xaf, 0x44, 0xf8, 0x3f, 0x01, 0x75, 0x9d, 0x8e, 0xfc, 0xf0, 0x73, 0x12, 0x2a, 0x34,
+	0xa0, 0x52, 0x71, 0x31, 0x44, 0x82, 0x1c, 0xd1, 0x42, 0xe1, 0x07, 0xe6, 0xfa, 0x74, 0x3d, 0xc1,
+	0x27, 0x7b, 0x06, 0xf2, 0xa8, 0x40, 0x04, 0x16, 0xf0, 0xf0, 0xbd, 0xbf, 0x7f, 0x0b, 0xbd, 0x7f,
+	0x7e, 0x0b, 0xbd, 0x5f, 0xbf, 0x82, 0xde, 0x97, 0xaf, 0xa0, 0xf7, 0xaf, 0xd7, 0xf0, 0x92, 0x7d,
+	0xe4, 0x3d, 0x26, 0xc3, 0xff, 0xbc, 0x86, 0xde, 0x1f, 0xff, 0x0d, 0x2f, 0xa4, 0x3c, 0x25, 0x3f,
+	0xbb, 0x30, 0xfb, 0xce, 0xc2, 0x42, 0x50, 0x67, 0x1c, 0x47, 0xe8, 0x10, 0x33, 0x1d, 0x59, 0x61,
+	0xca, 0x21, 0xe3, 0x42, 0x21, 0x81, 0xd3, 0x98, 0xb4, 0x7e, 0xeb, 0x01, 0x78, 0xd6, 0xa6, 0xfb,
+	0x35, 0x30, 0xab, 0xb7, 0x51, 0x13, 0xa1, 0x67, 0x9e, 0x0b, 0xd5, 0xb7, 0x0f, 0xc1, 0xdb, 0x0c,
+	0x2b, 0x92, 0x86, 0x43, 0xf3, 0x36, 0xf4, 0x02, 0xfb, 0xe9, 0xdf, 0x01, 0xf3, 0x55, 0x09, 0x99,
+	0xa3, 0xd8, 0x3c, 0x99, 0xbc, 0xe0, 0x8a, 0x1d, 0x35, 0x47, 0xac, 0x7e, 0x91, 0x16, 0x8f, 0x23,
+	0xf3, 0x1a, 0xf2, 0x82, 0xf2, 0xab, 0xf5, 0x39, 0xf0, 0x1d, 0xf7, 0x89, 0x36, 0x98, 0xad, 0x8a,
+	0xc4, 0x73, 0x64, 0x5e, 0x35, 0xeb, 0xaf, 0x81, 0xb9, 0x51, 0x03, 0x77, 0x3d, 0x5b, 0x47, 0xd3,
+	0x6b, 0x72, 0x72, 0xf1, 0x13, 0xe1, 0xbe, 0x06, 0x16, 0xbb, 0xdb, 0xbd, 0xfd, 0x60, 0x7b, 0xe3,
+	0x60, 0x7f, 0x7b, 0x6f, 0x17, 0x05, 0x9d, 0xdd, 0xee, 0xde, 0xce, 0xc2, 0x8c, 0xdf, 0x04, 0xf5,
+	0x89, 0x89, 0xa7, 0x5b, 0xdb, 0x3f, 0x7d, 0xb4, 0xbf, 0xd5, 0xb5, 0x08, 0xcf, 0xaf, 0x03, 0x38,
+	0x49, 0xdd, 0x3b, 0xd8, 0xed, 0xa2, 0x60, 0x6f, 0x63, 0x7b, 0x77, 0xe1, 0xdc, 0x83, 0x7f, 0x9c,
+	0x03, 0x55, 0xc8, 0x3a, 0x19, 0xf5, 0x73, 0x30, 0x7f, 0x60, 0x0e, 0x7a, 0x3b, 0xe8, 0x2f, 0x8e,
+	0x25, 0xaa, 0x1d, 0xac, 0x7d, 0x6f, 0x6c, 0x30, 0x30, 0x7f, 0x1d, 0xb4, 0x3e, 0xfa, 0xe6, 0x15,
+	0xac, 0x07, 0xe5, 0xae, 0x6e, 0xf2, 0xb4, 0x4f, 0xe3, 0x7b, 0x1d, 0xb3, 0x6f, 0x3b, 0x38, 0xc5,
+	0x31, 0xb9, 0xf7, 0x9b, 0x3f, 0xfd, 0xed, 0x77, 0xe7, 0xae, 0xb6, 0x16, 0xd6, 0x8b, 0x9b, 0xc4,
+	0xba, 0xfd, 0x37, 0xe2, 0xa1, 0xb7, 0xe6, 0x4b, 0x70, 0x45, 0x5f, 0xae, 0xd4, 0x77, 0xb6, 0xfa,
+	0xf0, 0xff, 0xb2, 0xba, 0xd4, 0x7a, 0x67, 0x5d, 0x68, 0xfd, 0x09, 0xa3, 0x5f, 0x80, 0xcb, 0xbd,
+	0x01, 0x3f, 0x7e, 0xb3, 0x4d, 0xd7, 0x60, 0xeb, 0xc3, 0x6f, 0x5e, 0xc1, 0x9a, 0xd3, 0xea, 0x67,
+	0x94, 0x1c, 0x17, 0x36, 0x17, 0x5b, 0xf3, 0xeb, 0x72, 0xc0, 0x8f, 0xc7, 0x4d, 0x6e, 0xd4, 0x9f,
+	0xff, 0xb5, 0x31, 0xf3, 0xfc, 0x45, 0xc3, 0xfb, 0xea, 0x45, 0xc3, 0xfb, 0xcb, 0x8b, 0x86, 0xf7,
+	0xe5, 0xcb, 0xc6, 0xcc, 0x57, 0x2f, 0x1b, 0x33, 0x7f, 0x7e, 0xd9, 0x98, 0x39, 0xbc, 0x68, 0xcc,
+	0xbc, 0xff, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x51, 0x01, 0x46, 0x59, 0xa9, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -480,6 +591,44 @@ func (m *Settings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxObjectHistoryRevisions != 0 {
+		i = encodeVarintSettings(dAtA, i, uint64(m.MaxObjectHistoryRevisions))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.FindCloudletStickySessions {
+		i--
+		if m.FindCloudletStickySessions {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.FindCloudletDistribution != 0 {
+		i = encodeVarintSettings(dAtA, i, uint64(m.FindCloudletDistribution))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf0
+	}
+	{
+		size, err := m.FindCloudletScoreWeights.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSettings(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xea
 	if m.CcrmApiTimeout != 0 {
 		i = encodeVarintSettings(dAtA, i, uint64(m.CcrmApiTimeout))
 		i--
@@ -764,6 +913,53 @@ func (m *Settings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FindCloudletScoreWeights) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FindCloudletScoreWeights) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FindCloudletScoreWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Health != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Health))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.ResourceUsage != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ResourceUsage))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.Latency != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Latency))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Distance != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Distance))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CollectionInterval) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1021,6 +1217,21 @@ func (m *Settings) Matches(o *Settings, fopts ...MatchOpt) bool {
 			return false
 		}
 	}
+	if !opts.Filter || o.FindCloudletDistribution != 0 {
+		if o.FindCloudletDistribution != m.FindCloudletDistribution {
+			return false
+		}
+	}
+	if !opts.Filter || o.FindCloudletStickySessions != false {
+		if o.FindCloudletStickySessions != m.FindCloudletStickySessions {
+			return false
+		}
+	}
+	if !opts.Filter || o.MaxObjectHistoryRevisions != 0 {
+		if o.MaxObjectHistoryRevisions != m.MaxObjectHistoryRevisions {
+			return false
+		}
+	}
 	return true
 }
 
@@ -1066,6 +1277,14 @@ const SettingsFieldResourceSnapshotThreadInterval = "41"
 const SettingsFieldPlatformHaInstancePollInterval = "42"
 const SettingsFieldPlatformHaInstanceActiveExpireTime = "43"
 const SettingsFieldCcrmApiTimeout = "44"
+const SettingsFieldFindCloudletScoreWeights = "45"
+const SettingsFieldFindCloudletScoreWeightsDistance = "45.1"
+const SettingsFieldFindCloudletScoreWeightsLatency = "45.2"
+const SettingsFieldFindCloudletScoreWeightsResourceUsage = "45.3"
+const SettingsFieldFindCloudletScoreWeightsHealth = "45.4"
+const SettingsFieldFindCloudletDistribution = "46"
+const SettingsFieldFindCloudletStickySessions = "47"
+const SettingsFieldMaxObjectHistoryRevisions = "48"
 
 var SettingsAllFields = []string{
 	SettingsFieldShepherdMetricsCollectionInterval,
@@ -1109,6 +1328,13 @@ var SettingsAllFields = []string{
 	SettingsFieldPlatformHaInstancePollInterval,
 	SettingsFieldPlatformHaInstanceActiveExpireTime,
 	SettingsFieldCcrmApiTimeout,
+	SettingsFieldFindCloudletScoreWeightsDistance,
+	SettingsFieldFindCloudletScoreWeightsLatency,
+	SettingsFieldFindCloudletScoreWeightsResourceUsage,
+	SettingsFieldFindCloudletScoreWeightsHealth,
+	SettingsFieldFindCloudletDistribution,
+	SettingsFieldFindCloudletStickySessions,
+	SettingsFieldMaxObjectHistoryRevisions,
 }
 
 var SettingsAllFieldsMap = NewFieldMap(map[string]struct{}{
@@ -1153,6 +1379,13 @@ var SettingsAllFieldsMap = NewFieldMap(map[string]struct{}{
 	SettingsFieldPlatformHaInstancePollInterval:                                 struct{}{},
 	SettingsFieldPlatformHaInstanceActiveExpireTime:                             struct{}{},
 	SettingsFieldCcrmApiTimeout:                                                 struct{}{},
+	SettingsFieldFindCloudletScoreWeightsDistance:                               struct{}{},
+	SettingsFieldFindCloudletScoreWeightsLatency:                                struct{}{},
+	SettingsFieldFindCloudletScoreWeightsResourceUsage:                          struct{}{},
+	SettingsFieldFindCloudletScoreWeightsHealth:                                 struct{}{},
+	SettingsFieldFindCloudletDistribution:                                       struct{}{},
+	SettingsFieldFindCloudletStickySessions:                                     struct{}{},
+	SettingsFieldMaxObjectHistoryRevisions:                                      struct{}{},
 })
 
 var SettingsAllFieldsStringMap = map[string]string{
@@ -1197,6 +1430,13 @@ var SettingsAllFieldsStringMap = map[string]string{
 	SettingsFieldPlatformHaInstancePollInterval:                                 "Platform Ha Instance Poll Interval",
 	SettingsFieldPlatformHaInstanceActiveExpireTime:                             "Platform Ha Instance Active Expire Time",
 	SettingsFieldCcrmApiTimeout:                                                 "Ccrm Api Timeout",
+	SettingsFieldFindCloudletScoreWeightsDistance:                               "Find Cloudlet Score Weights Distance",
+	SettingsFieldFindCloudletScoreWeightsLatency:                                "Find Cloudlet Score Weights Latency",
+	SettingsFieldFindCloudletScoreWeightsResourceUsage:                          "Find Cloudlet Score Weights Resource Usage",
+	SettingsFieldFindCloudletScoreWeightsHealth:                                 "Find Cloudlet Score Weights Health",
+	SettingsFieldFindCloudletDistribution:                                       "Find Cloudlet Distribution",
+	SettingsFieldFindCloudletStickySessions:                                     "Find Cloudlet Sticky Sessions",
+	SettingsFieldMaxObjectHistoryRevisions:                                      "Max Object History Revisions",
 }
 
 func (m *Settings) IsKeyField(s string) bool {
@@ -1339,6 +1579,31 @@ func (m *Settings) DiffFields(o *Settings, fields *FieldMap) {
 	if m.CcrmApiTimeout != o.CcrmApiTimeout {
 		fields.Set(SettingsFieldCcrmApiTimeout)
 	}
+	if m.FindCloudletScoreWeights.Distance != o.FindCloudletScoreWeights.Distance {
+		fields.Set(SettingsFieldFindCloudletScoreWeightsDistance)
+		fields.Set(SettingsFieldFindCloudletScoreWeights)
+	}
+	if m.FindCloudletScoreWeights.Latency != o.FindCloudletScoreWeights.Latency {
+		fields.Set(SettingsFieldFindCloudletScoreWeightsLatency)
+		fields.Set(SettingsFieldFindCloudletScoreWeights)
+	}
+	if m.FindCloudletScoreWeights.ResourceUsage != o.FindCloudletScoreWeights.ResourceUsage {
+		fields.Set(SettingsFieldFindCloudletScoreWeightsResourceUsage)
+		fields.Set(SettingsFieldFindCloudletScoreWeights)
+	}
+	if m.FindCloudletScoreWeights.Health != o.FindCloudletScoreWeights.Health {
+		fields.Set(SettingsFieldFindCloudletScoreWeightsHealth)
+		fields.Set(SettingsFieldFindCloudletScoreWeights)
+	}
+	if m.FindCloudletDistribution != o.FindCloudletDistribution {
+		fields.Set(SettingsFieldFindCloudletDistribution)
+	}
+	if m.FindCloudletStickySessions != o.FindCloudletStickySessions {
+		fields.Set(SettingsFieldFindCloudletStickySessions)
+	}
+	if m.MaxObjectHistoryRevisions != o.MaxObjectHistoryRevisions {
+		fields.Set(SettingsFieldMaxObjectHistoryRevisions)
+	}
 }
 
 func (m *Settings) GetDiffFields(o *Settings) *FieldMap {
@@ -1390,6 +1655,14 @@ var UpdateSettingsFieldsMap = NewFieldMap(map[string]struct{}{
 	SettingsFieldPlatformHaInstancePollInterval:                                 struct{}{},
 	SettingsFieldPlatformHaInstanceActiveExpireTime:                             struct{}{},
 	SettingsFieldCcrmApiTimeout:                                                 struct{}{},
+	SettingsFieldFindCloudletScoreWeights:                                       struct{}{},
+	SettingsFieldFindCloudletScoreWeightsDistance:                               struct{}{},
+	SettingsFieldFindCloudletScoreWeightsLatency:                                struct{}{},
+	SettingsFieldFindCloudletScoreWeightsResourceUsage:                          struct{}{},
+	SettingsFieldFindCloudletScoreWeightsHealth:                                 struct{}{},
+	SettingsFieldFindCloudletDistribution:                                       struct{}{},
+	SettingsFieldFindCloudletStickySessions:                                     struct{}{},
+	SettingsFieldMaxObjectHistoryRevisions:                                      struct{}{},
 })
 
 func (m *Settings) ValidateUpdateFields() error {
@@ -1712,6 +1985,50 @@ func (m *Settings) CopyInFields(src *Settings) int {
 			changed++
 		}
 	}
+	if fmap.HasOrHasChild("45") {
+		if fmap.Has("45.1") {
+			if m.FindCloudletScoreWeights.Distance != src.FindCloudletScoreWeights.Distance {
+				m.FindCloudletScoreWeights.Distance = src.FindCloudletScoreWeights.Distance
+				changed++
+			}
+		}
+		if fmap.Has("45.2") {
+			if m.FindCloudletScoreWeights.Latency != src.FindCloudletScoreWeights.Latency {
+				m.FindCloudletScoreWeights.Latency = src.FindCloudletScoreWeights.Latency
+				changed++
+			}
+		}
+		if fmap.Has("45.3") {
+			if m.FindCloudletScoreWeights.ResourceUsage != src.FindCloudletScoreWeights.ResourceUsage {
+				m.FindCloudletScoreWeights.ResourceUsage = src.FindCloudletScoreWeights.ResourceUsage
+				changed++
+			}
+		}
+		if fmap.Has("45.4") {
+			if m.FindCloudletScoreWeights.Health != src.FindCloudletScoreWeights.Health {
+				m.FindCloudletScoreWeights.Health = src.FindCloudletScoreWeights.Health
+				changed++
+			}
+		}
+	}
+	if fmap.Has("46") {
+		if m.FindCloudletDistribution != src.FindCloudletDistribution {
+			m.FindCloudletDistribution = src.FindCloudletDistribution
+			changed++
+		}
+	}
+	if fmap.Has("47") {
+		if m.FindCloudletStickySessions != src.FindCloudletStickySessions {
+			m.FindCloudletStickySessions = src.FindCloudletStickySessions
+			changed++
+		}
+	}
+	if fmap.Has("48") {
+		if m.MaxObjectHistoryRevisions != src.MaxObjectHistoryRevisions {
+			m.MaxObjectHistoryRevisions = src.MaxObjectHistoryRevisions
+			changed++
+		}
+	}
 	return changed
 }
 
@@ -1765,6 +2082,10 @@ func (m *Settings) DeepCopyIn(src *Settings) {
 	m.PlatformHaInstancePollInterval = src.PlatformHaInstancePollInterval
 	m.PlatformHaInstanceActiveExpireTime = src.PlatformHaInstanceActiveExpireTime
 	m.CcrmApiTimeout = src.CcrmApiTimeout
+	m.FindCloudletScoreWeights.DeepCopyIn(&src.FindCloudletScoreWeights)
+	m.FindCloudletDistribution = src.FindCloudletDistribution
+	m.FindCloudletStickySessions = src.FindCloudletStickySessions
+	m.MaxObjectHistoryRevisions = src.MaxObjectHistoryRevisions
 }
 
 func (s *Settings) HasFields() bool {
@@ -2407,6 +2728,12 @@ func (m *Settings) ValidateEnums() error {
 			return err
 		}
 	}
+	if err := m.FindCloudletScoreWeights.ValidateEnums(); err != nil {
+		return err
+	}
+	if _, ok := FindCloudletDistribution_name[int32(m.FindCloudletDistribution)]; !ok {
+		return errors.New("invalid FindCloudletDistribution")
+	}
 	return nil
 }
 
@@ -2416,6 +2743,49 @@ func (s *Settings) ClearTagged(tags map[string]struct{}) {
 			s.EdgeEventsMetricsContinuousQueriesCollectionIntervals[ii].ClearTagged(tags)
 		}
 	}
+	s.FindCloudletScoreWeights.ClearTagged(tags)
+}
+
+func (m *FindCloudletScoreWeights) Clone() *FindCloudletScoreWeights {
+	cp := &FindCloudletScoreWeights{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *FindCloudletScoreWeights) CopyInFields(src *FindCloudletScoreWeights) int {
+	changed := 0
+	if m.Distance != src.Distance {
+		m.Distance = src.Distance
+		changed++
+	}
+	if m.Latency != src.Latency {
+		m.Latency = src.Latency
+		changed++
+	}
+	if m.ResourceUsage != src.ResourceUsage {
+		m.ResourceUsage = src.ResourceUsage
+		changed++
+	}
+	if m.Health != src.Health {
+		m.Health = src.Health
+		changed++
+	}
+	return changed
+}
+
+func (m *FindCloudletScoreWeights) DeepCopyIn(src *FindCloudletScoreWeights) {
+	m.Distance = src.Distance
+	m.Latency = src.Latency
+	m.ResourceUsage = src.ResourceUsage
+	m.Health = src.Health
+}
+
+// Helper method to check that enums have valid values
+func (m *FindCloudletScoreWeights) ValidateEnums() error {
+	return nil
+}
+
+func (s *FindCloudletScoreWeights) ClearTagged(tags map[string]struct{}) {
 }
 
 func (m *CollectionInterval) Clone() *CollectionInterval {
@@ -2450,6 +2820,121 @@ func (m *CollectionInterval) ValidateEnums() error {
 func (s *CollectionInterval) ClearTagged(tags map[string]struct{}) {
 }
 
+var FindCloudletDistributionStrings = []string{
+	"DISTRIBUTION_RANDOM",
+	"DISTRIBUTION_WEIGHTED_RANDOM",
+	"DISTRIBUTION_ROUND_ROBIN",
+}
+
+const (
+	FindCloudletDistributionDISTRIBUTION_RANDOM          uint64 = 1 << 0
+	FindCloudletDistributionDISTRIBUTION_WEIGHTED_RANDOM uint64 = 1 << 1
+	FindCloudletDistributionDISTRIBUTION_ROUND_ROBIN     uint64 = 1 << 2
+)
+
+var FindCloudletDistribution_CamelName = map[int32]string{
+	// DISTRIBUTION_RANDOM -> DistributionRandom
+	0: "DistributionRandom",
+	// DISTRIBUTION_WEIGHTED_RANDOM -> DistributionWeightedRandom
+	1: "DistributionWeightedRandom",
+	// DISTRIBUTION_ROUND_ROBIN -> DistributionRoundRobin
+	2: "DistributionRoundRobin",
+}
+var FindCloudletDistribution_CamelValue = map[string]int32{
+	"DistributionRandom":         0,
+	"DistributionWeightedRandom": 1,
+	"DistributionRoundRobin":     2,
+}
+
+func ParseFindCloudletDistribution(data interface{}) (FindCloudletDistribution, error) {
+	if val, ok := data.(FindCloudletDistribution); ok {
+		return val, nil
+	} else if str, ok := data.(string); ok {
+		val, ok := FindCloudletDistribution_CamelValue[util.CamelCase(str)]
+		if !ok {
+			// may have omitted common prefix
+			val, ok = FindCloudletDistribution_CamelValue["Distribution"+util.CamelCase(str)]
+		}
+		if !ok {
+			// may be int value instead of enum name
+			ival, err := strconv.Atoi(str)
+			val = int32(ival)
+			if err == nil {
+				_, ok = FindCloudletDistribution_CamelName[val]
+			}
+		}
+		if !ok {
+			return FindCloudletDistribution(0), fmt.Errorf("Invalid FindCloudletDistribution value %q", str)
+		}
+		return FindCloudletDistribution(val), nil
+	} else if ival, ok := data.(int32); ok {
+		if _, ok := FindCloudletDistribution_CamelName[ival]; ok {
+			return FindCloudletDistribution(ival), nil
+		} else {
+			return FindCloudletDistribution(0), fmt.Errorf("Invalid FindCloudletDistribution value %d", ival)
+		}
+	}
+	return FindCloudletDistribution(0), fmt.Errorf("Invalid FindCloudletDistribution value %v", data)
+}
+
+func (e *FindCloudletDistribution) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	err := unmarshal(&str)
+	if err != nil {
+		return err
+	}
+	val, err := ParseFindCloudletDistribution(str)
+	if err != nil {
+		return err
+	}
+	*e = val
+	return nil
+}
+
+func (e FindCloudletDistribution) MarshalYAML() (interface{}, error) {
+	str := proto.EnumName(FindCloudletDistribution_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "Distribution")
+	return str, nil
+}
+
+// custom JSON encoding/decoding
+func (e *FindCloudletDistribution) UnmarshalJSON(b []byte) error {
+	var str string
+	err := json.Unmarshal(b, &str)
+	if err == nil {
+		val, err := ParseFindCloudletDistribution(str)
+		if err != nil {
+			return &json.UnmarshalTypeError{
+				Value: "string " + str,
+				Type:  reflect.TypeOf(FindCloudletDistribution(0)),
+			}
+		}
+		*e = FindCloudletDistribution(val)
+		return nil
+	}
+	var ival int32
+	err = json.Unmarshal(b, &ival)
+	if err == nil {
+		val, err := ParseFindCloudletDistribution(ival)
+		if err == nil {
+			*e = val
+			return nil
+		}
+	}
+	return &json.UnmarshalTypeError{
+		Value: "value " + string(b),
+		Type:  reflect.TypeOf(FindCloudletDistribution(0)),
+	}
+}
+
+func (e FindCloudletDistribution) MarshalJSON() ([]byte, error) {
+	str := proto.EnumName(FindCloudletDistribution_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "Distribution")
+	return json.Marshal(str)
+}
+
+var FindCloudletDistributionCommonPrefix = "Distribution"
+
 func (m *Settings) IsValidArgsForUpdateSettings() error {
 	return nil
 }
@@ -2594,6 +3079,38 @@ func (m *Settings) Size() (n int) {
 	if m.CcrmApiTimeout != 0 {
 		n += 2 + sovSettings(uint64(m.CcrmApiTimeout))
 	}
+	l = m.FindCloudletScoreWeights.Size()
+	n += 2 + l + sovSettings(uint64(l))
+	if m.FindCloudletDistribution != 0 {
+		n += 2 + sovSettings(uint64(m.FindCloudletDistribution))
+	}
+	if m.FindCloudletStickySessions {
+		n += 3
+	}
+	if m.MaxObjectHistoryRevisions != 0 {
+		n += 2 + sovSettings(uint64(m.MaxObjectHistoryRevisions))
+	}
+	return n
+}
+
+func (m *FindCloudletScoreWeights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Distance != 0 {
+		n += 9
+	}
+	if m.Latency != 0 {
+		n += 9
+	}
+	if m.ResourceUsage != 0 {
+		n += 9
+	}
+	if m.Health != 0 {
+		n += 9
+	}
 	return n
 }
 
@@ -3452,6 +3969,191 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FindCloudletScoreWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FindCloudletScoreWeights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 46:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FindCloudletDistribution", wireType)
+			}
+			m.FindCloudletDistribution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FindCloudletDistribution |= FindCloudletDistribution(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 47:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FindCloudletStickySessions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FindCloudletStickySessions = bool(v != 0)
+		case 48:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxObjectHistoryRevisions", wireType)
+			}
+			m.MaxObjectHistoryRevisions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxObjectHistoryRevisions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FindCloudletScoreWeights) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FindCloudletScoreWeights: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FindCloudletScoreWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distance", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Distance = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Latency = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceUsage", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ResourceUsage = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Health = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSettings(dAtA[iNdEx:])
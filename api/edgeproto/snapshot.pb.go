@@ -0,0 +1,2799 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: snapshot.proto
+
+package edgeproto
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/googleapis/google/api"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DataSnapshotRequest specifies the data to export
+type DataSnapshotRequest struct {
+	// Database revision to export the data at, defaults to the latest revision. Older revisions are only available until the database history is compacted.
+	Revision int64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (m *DataSnapshotRequest) Reset()         { *m = DataSnapshotRequest{} }
+func (m *DataSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*DataSnapshotRequest) ProtoMessage()    {}
+func (*DataSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8aab8e59648e0b, []int{0}
+}
+func (m *DataSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataSnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataSnapshotRequest.Merge(m, src)
+}
+func (m *DataSnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataSnapshotRequest proto.InternalMessageInfo
+
+// DataSnapshotEntry is a raw database entry
+type DataSnapshotEntry struct {
+	// Database key, without the region prefix
+	DbKey string `protobuf:"bytes,1,opt,name=db_key,json=dbKey,proto3" json:"db_key,omitempty"`
+	// Database value
+	DbValue string `protobuf:"bytes,2,opt,name=db_value,json=dbValue,proto3" json:"db_value,omitempty"`
+}
+
+func (m *DataSnapshotEntry) Reset()         { *m = DataSnapshotEntry{} }
+func (m *DataSnapshotEntry) String() string { return proto.CompactTextString(m) }
+func (*DataSnapshotEntry) ProtoMessage()    {}
+func (*DataSnapshotEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8aab8e59648e0b, []int{1}
+}
+func (m *DataSnapshotEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataSnapshotEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataSnapshotEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataSnapshotEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataSnapshotEntry.Merge(m, src)
+}
+func (m *DataSnapshotEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataSnapshotEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataSnapshotEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataSnapshotEntry proto.InternalMessageInfo
+
+// DataSnapshot is an export of all data in a region
+type DataSnapshot struct {
+	// Region of the exported data
+	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	// Data model version hash of the exported data
+	DataModelHash string `protobuf:"bytes,2,opt,name=data_model_hash,json=dataModelHash,proto3" json:"data_model_hash,omitempty"`
+	// Data model version ID of the exported data
+	DataModelId int32 `protobuf:"varint,3,opt,name=data_model_id,json=dataModelId,proto3" json:"data_model_id,omitempty"`
+	// Database revision the data was exported at
+	Revision int64 `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Objects to restore, only accepted for the current data model version
+	Data AllData `protobuf:"bytes,5,opt,name=data,proto3" json:"data"`
+	// Exported database entries, upgraded on restore if from an older data model version
+	InternalEntries []DataSnapshotEntry `protobuf:"bytes,6,rep,name=internal_entries,json=internalEntries,proto3" json:"internal_entries"`
+}
+
+func (m *DataSnapshot) Reset()         { *m = DataSnapshot{} }
+func (m *DataSnapshot) String() string { return proto.CompactTextString(m) }
+func (*DataSnapshot) ProtoMessage()    {}
+func (*DataSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8aab8e59648e0b, []int{2}
+}
+func (m *DataSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataSnapshot.Merge(m, src)
+}
+func (m *DataSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataSnapshot proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*DataSnapshotRequest)(nil), "edgeproto.DataSnapshotRequest")
+	proto.RegisterType((*DataSnapshotEntry)(nil), "edgeproto.DataSnapshotEntry")
+	proto.RegisterType((*DataSnapshot)(nil), "edgeproto.DataSnapshot")
+}
+
+func init() { proto.RegisterFile("snapshot.proto", fileDescriptor_0c8aab8e59648e0b) }
+
+var fileDescriptor_0c8aab8e59648e0b = []byte{
+	// 450 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x3b, 0xfd, 0xe5, 0x76, 0xda, 0x5a, 0x3b, 0xfe, 0xd8, 0x18, 0x4a, 0x2c, 0x39, 0x48,
+	0x11, 0x69, 0xb0, 0xde, 0xbc, 0xed, 0x62, 0x41, 0x91, 0xbd, 0x64, 0xc1, 0xa3, 0x61, 0xc2, 0x3c,
+	0xd2, 0xe0, 0x38, 0x13, 0x67, 0xa6, 0x8b, 0xbd, 0x7a, 0xf5, 0x22, 0xf8, 0x3f, 0x49, 0x8f, 0x0b,
+	0x5e, 0x3c, 0x89, 0xb6, 0xfe, 0x21, 0x32, 0xd3, 0xb4, 0x64, 0x91, 0xde, 0xde, 0x7b, 0xdf, 0xef,
+	0xfb, 0xbc, 0x79, 0x2f, 0xc1, 0xb7, 0xb5, 0xa0, 0x85, 0x5e, 0x48, 0x33, 0x2d, 0x94, 0x34, 0x92,
+	0x74, 0x80, 0x65, 0xe0, 0x42, 0xbf, 0x4f, 0x39, 0x67, 0xd4, 0xd0, 0x9d, 0xe2, 0xf7, 0x14, 0xe8,
+	0x25, 0x2f, 0x7d, 0xfe, 0x28, 0x93, 0x32, 0xe3, 0x10, 0xd1, 0x22, 0x8f, 0xa8, 0x10, 0xd2, 0x50,
+	0x93, 0x4b, 0xa1, 0x4b, 0xf5, 0x5e, 0x26, 0x33, 0xe9, 0xc2, 0xc8, 0x46, 0xbb, 0x6a, 0xf8, 0x0c,
+	0xdf, 0x7d, 0x49, 0x0d, 0xbd, 0x2c, 0x27, 0xc6, 0xf0, 0x71, 0x09, 0xda, 0x10, 0x1f, 0x9f, 0x28,
+	0xb8, 0xca, 0x75, 0x2e, 0x85, 0x87, 0xc6, 0x68, 0xd2, 0x88, 0x0f, 0x79, 0x38, 0xc7, 0xc3, 0x6a,
+	0xcb, 0x5c, 0x18, 0xb5, 0x22, 0xf7, 0x71, 0x9b, 0xa5, 0xc9, 0x7b, 0x58, 0x39, 0x7b, 0x27, 0x6e,
+	0xb1, 0xf4, 0x0d, 0xac, 0xc8, 0x43, 0x7c, 0xc2, 0xd2, 0xe4, 0x8a, 0xf2, 0x25, 0x78, 0x75, 0x27,
+	0xdc, 0x62, 0xe9, 0x5b, 0x9b, 0x86, 0x5f, 0xea, 0xb8, 0x57, 0xe5, 0x90, 0x07, 0xb8, 0xad, 0x20,
+	0xdb, 0x4f, 0xec, 0xc4, 0x65, 0x46, 0x1e, 0xe3, 0x81, 0x5d, 0x39, 0xf9, 0x20, 0x19, 0xf0, 0x64,
+	0x41, 0xf5, 0xa2, 0x44, 0xf5, 0x6d, 0xf9, 0xc2, 0x56, 0x5f, 0x51, 0xbd, 0x20, 0x21, 0xee, 0x57,
+	0x7c, 0x39, 0xf3, 0x1a, 0x63, 0x34, 0x69, 0xc5, 0xdd, 0x83, 0xeb, 0x35, 0xbb, 0xb1, 0x57, 0xf3,
+	0xe6, 0x5e, 0xe4, 0x29, 0x6e, 0x5a, 0xab, 0xd7, 0x1a, 0xa3, 0x49, 0x77, 0x46, 0xa6, 0x87, 0xab,
+	0x4f, 0xcf, 0x38, 0xb7, 0x2f, 0x3d, 0x6f, 0xae, 0x7f, 0x3d, 0xaa, 0xc5, 0xce, 0x45, 0x2e, 0xf0,
+	0x9d, 0x5c, 0x18, 0x50, 0x82, 0xf2, 0x04, 0x84, 0x51, 0x39, 0x68, 0xaf, 0x3d, 0x6e, 0x4c, 0xba,
+	0xb3, 0x51, 0xa5, 0xf3, 0xbf, 0x43, 0x95, 0x8c, 0xc1, 0xbe, 0x77, 0xbe, 0x6b, 0x9d, 0x7d, 0x47,
+	0x78, 0x50, 0x35, 0x9f, 0x15, 0x39, 0x79, 0x87, 0xf1, 0xfc, 0x53, 0x21, 0x95, 0xb1, 0x02, 0x09,
+	0x8e, 0x60, 0xcb, 0x4f, 0xe6, 0x9f, 0x1e, 0xd1, 0xc3, 0xd3, 0xcf, 0x3f, 0xfe, 0x7e, 0xab, 0x0f,
+	0xc3, 0x5e, 0x04, 0x8e, 0x16, 0xd9, 0xf7, 0xbf, 0x40, 0x4f, 0xc8, 0x25, 0xee, 0xc6, 0xa0, 0x8d,
+	0x54, 0xe0, 0x06, 0x1c, 0x03, 0xf8, 0xc3, 0x8a, 0x10, 0xbb, 0x1f, 0x2e, 0xf4, 0x1c, 0x93, 0x84,
+	0xfd, 0x48, 0xed, 0x08, 0x7b, 0xe8, 0xf9, 0x68, 0xfd, 0x27, 0xa8, 0xad, 0x37, 0x01, 0xba, 0xde,
+	0x04, 0xe8, 0xf7, 0x26, 0x40, 0x5f, 0xb7, 0x41, 0xed, 0x7a, 0x1b, 0xd4, 0x7e, 0x6e, 0x83, 0x5a,
+	0xda, 0x76, 0x94, 0xe7, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x63, 0xa1, 0x39, 0xe0, 0xe3, 0x02,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DataSnapshotApiClient is the client API for DataSnapshotApi service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DataSnapshotApiClient interface {
+	// Export all data in the region
+	ExportData(ctx context.Context, in *DataSnapshotRequest, opts ...grpc.CallOption) (*DataSnapshot, error)
+	// Restore exported data into a region without any data
+	RestoreData(ctx context.Context, in *DataSnapshot, opts ...grpc.CallOption) (*Result, error)
+}
+
+type dataSnapshotApiClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDataSnapshotApiClient(cc *grpc.ClientConn) DataSnapshotApiClient {
+	return &dataSnapshotApiClient{cc}
+}
+
+func (c *dataSnapshotApiClient) ExportData(ctx context.Context, in *DataSnapshotRequest, opts ...grpc.CallOption) (*DataSnapshot, error) {
+	out := new(DataSnapshot)
+	err := c.cc.Invoke(ctx, "/edgeproto.DataSnapshotApi/ExportData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataSnapshotApiClient) RestoreData(ctx context.Context, in *DataSnapshot, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/edgeproto.DataSnapshotApi/RestoreData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DataSnapshotApiServer is the server API for DataSnapshotApi service.
+type DataSnapshotApiServer interface {
+	// Export all data in the region
+	ExportData(context.Context, *DataSnapshotRequest) (*DataSnapshot, error)
+	// Restore exported data into a region without any data
+	RestoreData(context.Context, *DataSnapshot) (*Result, error)
+}
+
+// UnimplementedDataSnapshotApiServer can be embedded to have forward compatible implementations.
+type UnimplementedDataSnapshotApiServer struct {
+}
+
+func (*UnimplementedDataSnapshotApiServer) ExportData(ctx context.Context, req *DataSnapshotRequest) (*DataSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportData not implemented")
+}
+func (*UnimplementedDataSnapshotApiServer) RestoreData(ctx context.Context, req *DataSnapshot) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreData not implemented")
+}
+
+func RegisterDataSnapshotApiServer(s *grpc.Server, srv DataSnapshotApiServer) {
+	s.RegisterService(&_DataSnapshotApi_serviceDesc, srv)
+}
+
+func _DataSnapshotApi_ExportData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSnapshotApiServer).ExportData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgeproto.DataSnapshotApi/ExportData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSnapshotApiServer).ExportData(ctx, req.(*DataSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataSnapshotApi_RestoreData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataSnapshot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSnapshotApiServer).RestoreData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgeproto.DataSnapshotApi/RestoreData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSnapshotApiServer).RestoreData(ctx, req.(*DataSnapshot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DataSnapshotApi_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "edgeproto.DataSnapshotApi",
+	HandlerType: (*DataSnapshotApiServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportData",
+			Handler:    _DataSnapshotApi_ExportData_Handler,
+		},
+		{
+			MethodName: "RestoreData",
+			Handler:    _DataSnapshotApi_RestoreData_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "snapshot.proto",
+}
+
+func (m *DataSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataSnapshotEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataSnapshotEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataSnapshotEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DbValue) > 0 {
+		i -= len(m.DbValue)
+		copy(dAtA[i:], m.DbValue)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.DbValue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DbKey) > 0 {
+		i -= len(m.DbKey)
+		copy(dAtA[i:], m.DbKey)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.DbKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InternalEntries) > 0 {
+		for iNdEx := len(m.InternalEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InternalEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSnapshot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSnapshot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Revision != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DataModelId != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.DataModelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DataModelHash) > 0 {
+		i -= len(m.DataModelHash)
+		copy(dAtA[i:], m.DataModelHash)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.DataModelHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Region) > 0 {
+		i -= len(m.Region)
+		copy(dAtA[i:], m.Region)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Region)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSnapshot(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSnapshot(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DataSnapshotRequest) Clone() *DataSnapshotRequest {
+	cp := &DataSnapshotRequest{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *DataSnapshotRequest) CopyInFields(src *DataSnapshotRequest) int {
+	changed := 0
+	if m.Revision != src.Revision {
+		m.Revision = src.Revision
+		changed++
+	}
+	return changed
+}
+
+func (m *DataSnapshotRequest) DeepCopyIn(src *DataSnapshotRequest) {
+	m.Revision = src.Revision
+}
+
+// Helper method to check that enums have valid values
+func (m *DataSnapshotRequest) ValidateEnums() error {
+	return nil
+}
+
+func (s *DataSnapshotRequest) ClearTagged(tags map[string]struct{}) {
+}
+
+func (m *DataSnapshotEntry) Clone() *DataSnapshotEntry {
+	cp := &DataSnapshotEntry{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *DataSnapshotEntry) CopyInFields(src *DataSnapshotEntry) int {
+	changed := 0
+	if m.DbKey != src.DbKey {
+		m.DbKey = src.DbKey
+		changed++
+	}
+	if m.DbValue != src.DbValue {
+		m.DbValue = src.DbValue
+		changed++
+	}
+	return changed
+}
+
+func (m *DataSnapshotEntry) DeepCopyIn(src *DataSnapshotEntry) {
+	m.DbKey = src.DbKey
+	m.DbValue = src.DbValue
+}
+
+// Helper method to check that enums have valid values
+func (m *DataSnapshotEntry) ValidateEnums() error {
+	return nil
+}
+
+func (s *DataSnapshotEntry) ClearTagged(tags map[string]struct{}) {
+}
+
+func (m *DataSnapshot) Clone() *DataSnapshot {
+	cp := &DataSnapshot{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *DataSnapshot) AddDataSettingsEdgeEventsMetricsContinuousQueriesCollectionIntervals(vals ...*CollectionInterval) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.Settings.EdgeEventsMetricsContinuousQueriesCollectionIntervals {
+		cur[v.String()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.String()]; found {
+			continue // duplicate
+		}
+		m.Data.Settings.EdgeEventsMetricsContinuousQueriesCollectionIntervals = append(m.Data.Settings.EdgeEventsMetricsContinuousQueriesCollectionIntervals, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataSettingsEdgeEventsMetricsContinuousQueriesCollectionIntervals(vals ...*CollectionInterval) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.String()] = struct{}{}
+	}
+	for i := len(m.Data.Settings.EdgeEventsMetricsContinuousQueriesCollectionIntervals); i >= 0; i-- {
+		if _, found := remove[m.Data.Settings.EdgeEventsMetricsContinuousQueriesCollectionIntervals[i].String()]; found {
+			m.Data.Settings.EdgeEventsMetricsContinuousQueriesCollectionIntervals = append(m.Data.Settings.EdgeEventsMetricsContinuousQueriesCollectionIntervals[:i], m.Data.Settings.EdgeEventsMetricsContinuousQueriesCollectionIntervals[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataFlavors(vals ...Flavor) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.Flavors {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Data.Flavors = append(m.Data.Flavors, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataFlavors(vals ...Flavor) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Data.Flavors); i >= 0; i-- {
+		if _, found := remove[m.Data.Flavors[i].GetKey().GetKeyString()]; found {
+			m.Data.Flavors = append(m.Data.Flavors[:i], m.Data.Flavors[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataOperatorCodes(vals ...OperatorCode) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.OperatorCodes {
+		cur[v.String()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.String()]; found {
+			continue // duplicate
+		}
+		m.Data.OperatorCodes = append(m.Data.OperatorCodes, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataOperatorCodes(vals ...OperatorCode) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.String()] = struct{}{}
+	}
+	for i := len(m.Data.OperatorCodes); i >= 0; i-- {
+		if _, found := remove[m.Data.OperatorCodes[i].String()]; found {
+			m.Data.OperatorCodes = append(m.Data.OperatorCodes[:i], m.Data.OperatorCodes[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataResTagTables(vals ...ResTagTable) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.ResTagTables {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Data.ResTagTables = append(m.Data.ResTagTables, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataResTagTables(vals ...ResTagTable) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Data.ResTagTables); i >= 0; i-- {
+		if _, found := remove[m.Data.ResTagTables[i].GetKey().GetKeyString()]; found {
+			m.Data.ResTagTables = append(m.Data.ResTagTables[:i], m.Data.ResTagTables[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataCloudlets(vals ...Cloudlet) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.Cloudlets {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Data.Cloudlets = append(m.Data.Cloudlets, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataCloudlets(vals ...Cloudlet) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Data.Cloudlets); i >= 0; i-- {
+		if _, found := remove[m.Data.Cloudlets[i].GetKey().GetKeyString()]; found {
+			m.Data.Cloudlets = append(m.Data.Cloudlets[:i], m.Data.Cloudlets[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataCloudletInfos(vals ...CloudletInfo) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.CloudletInfos {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Data.CloudletInfos = append(m.Data.CloudletInfos, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataCloudletInfos(vals ...CloudletInfo) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Data.CloudletInfos); i >= 0; i-- {
+		if _, found := remove[m.Data.CloudletInfos[i].GetKey().GetKeyString()]; found {
+			m.Data.CloudletInfos = append(m.Data.CloudletInfos[:i], m.Data.CloudletInfos[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataZonePools(vals ...ZonePool) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.ZonePools {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Data.ZonePools = append(m.Data.ZonePools, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataZonePools(vals ...ZonePool) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Data.ZonePools); i >= 0; i-- {
+		if _, found := remove[m.Data.ZonePools[i].GetKey().GetKeyString()]; found {
+			m.Data.ZonePools = append(m.Data.ZonePools[:i], m.Data.ZonePools[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataAutoProvPolicies(vals ...AutoProvPolicy) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.AutoProvPolicies {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Data.AutoProvPolicies = append(m.Data.AutoProvPolicies, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataAutoProvPolicies(vals ...AutoProvPolicy) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Data.AutoProvPolicies); i >= 0; i-- {
+		if _, found := remove[m.Data.AutoProvPolicies[i].GetKey().GetKeyString()]; found {
+			m.Data.AutoProvPolicies = append(m.Data.AutoProvPolicies[:i], m.Data.AutoProvPolicies[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataAutoProvPolicyZones(vals ...AutoProvPolicyZone) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.AutoProvPolicyZones {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Data.AutoProvPolicyZones = append(m.Data.AutoProvPolicyZones, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataAutoProvPolicyZones(vals ...AutoProvPolicyZone) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Data.AutoProvPolicyZones); i >= 0; i-- {
+		if _, found := remove[m.Data.AutoProvPolicyZones[i].GetKey().GetKeyString()]; found {
+			m.Data.AutoProvPolicyZones = append(m.Data.AutoProvPolicyZones[:i], m.Data.AutoProvPolicyZones[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataAutoScalePolicies(vals ...AutoScalePolicy) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.AutoScalePolicies {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Data.AutoScalePolicies = append(m.Data.AutoScalePolicies, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataAutoScalePolicies(vals ...AutoScalePolicy) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Data.AutoScalePolicies); i >= 0; i-- {
+		if _, found := remove[m.Data.AutoScalePolicies[i].GetKey().GetKeyString()]; found {
+			m.Data.AutoScalePolicies = append(m.Data.AutoScalePolicies[:i], m.Data.AutoScalePolicies[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataTrustPolicies(vals ...TrustPolicy) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.TrustPolicies {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Data.TrustPolicies = append(m.Data.TrustPolicies, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataTrustPolicies(vals ...TrustPolicy) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Data.TrustPolicies); i >= 0; i-- {
+		if _, found := remove[m.Data.TrustPolicies[i].GetKey().GetKeyString()]; found {
+			m.Data.TrustPolicies = append(m.Data.TrustPolicies[:i], m.Data.TrustPolicies[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataClusterInsts(vals ...ClusterInst) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.ClusterInsts {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Data.ClusterInsts = append(m.Data.ClusterInsts, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataClusterInsts(vals ...ClusterInst) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Data.ClusterInsts); i >= 0; i-- {
+		if _, found := remove[m.Data.ClusterInsts[i].GetKey().GetKeyString()]; found {
+			m.Data.ClusterInsts = append(m.Data.ClusterInsts[:i], m.Data.ClusterInsts[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataApps(vals ...App) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.Apps {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Data.Apps = append(m.Data.Apps, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataApps(vals ...App) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Data.Apps); i >= 0; i-- {
+		if _, found := remove[m.Data.Apps[i].GetKey().GetKeyString()]; found {
+			m.Data.Apps = append(m.Data.Apps[:i], m.Data.Apps[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataAppInstances(vals ...AppInst) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.AppInstances {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Data.AppInstances = append(m.Data.AppInstances, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataAppInstances(vals ...AppInst) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Data.AppInstances); i >= 0; i-- {
+		if _, found := remove[m.Data.AppInstances[i].GetKey().GetKeyString()]; found {
+			m.Data.AppInstances = append(m.Data.AppInstances[:i], m.Data.AppInstances[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataAppInstRefs(vals ...AppInstRefs) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.AppInstRefs {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Data.AppInstRefs = append(m.Data.AppInstRefs, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataAppInstRefs(vals ...AppInstRefs) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Data.AppInstRefs); i >= 0; i-- {
+		if _, found := remove[m.Data.AppInstRefs[i].GetKey().GetKeyString()]; found {
+			m.Data.AppInstRefs = append(m.Data.AppInstRefs[:i], m.Data.AppInstRefs[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataVmPools(vals ...VMPool) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.VmPools {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Data.VmPools = append(m.Data.VmPools, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataVmPools(vals ...VMPool) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Data.VmPools); i >= 0; i-- {
+		if _, found := remove[m.Data.VmPools[i].GetKey().GetKeyString()]; found {
+			m.Data.VmPools = append(m.Data.VmPools[:i], m.Data.VmPools[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataGpuDrivers(vals ...GPUDriver) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.GpuDrivers {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Data.GpuDrivers = append(m.Data.GpuDrivers, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataGpuDrivers(vals ...GPUDriver) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Data.GpuDrivers); i >= 0; i-- {
+		if _, found := remove[m.Data.GpuDrivers[i].GetKey().GetKeyString()]; found {
+			m.Data.GpuDrivers = append(m.Data.GpuDrivers[:i], m.Data.GpuDrivers[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataAlertPolicies(vals ...AlertPolicy) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.AlertPolicies {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Data.AlertPolicies = append(m.Data.AlertPolicies, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataAlertPolicies(vals ...AlertPolicy) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Data.AlertPolicies); i >= 0; i-- {
+		if _, found := remove[m.Data.AlertPolicies[i].GetKey().GetKeyString()]; found {
+			m.Data.AlertPolicies = append(m.Data.AlertPolicies[:i], m.Data.AlertPolicies[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataFlowRateLimitSettings(vals ...FlowRateLimitSettings) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.FlowRateLimitSettings {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Data.FlowRateLimitSettings = append(m.Data.FlowRateLimitSettings, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataFlowRateLimitSettings(vals ...FlowRateLimitSettings) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Data.FlowRateLimitSettings); i >= 0; i-- {
+		if _, found := remove[m.Data.FlowRateLimitSettings[i].GetKey().GetKeyString()]; found {
+			m.Data.FlowRateLimitSettings = append(m.Data.FlowRateLimitSettings[:i], m.Data.FlowRateLimitSettings[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataMaxReqsRateLimitSettings(vals ...MaxReqsRateLimitSettings) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.MaxReqsRateLimitSettings {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Data.MaxReqsRateLimitSettings = append(m.Data.MaxReqsRateLimitSettings, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataMaxReqsRateLimitSettings(vals ...MaxReqsRateLimitSettings) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Data.MaxReqsRateLimitSettings); i >= 0; i-- {
+		if _, found := remove[m.Data.MaxReqsRateLimitSettings[i].GetKey().GetKeyString()]; found {
+			m.Data.MaxReqsRateLimitSettings = append(m.Data.MaxReqsRateLimitSettings[:i], m.Data.MaxReqsRateLimitSettings[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataNetworks(vals ...Network) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.Networks {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Data.Networks = append(m.Data.Networks, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataNetworks(vals ...Network) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Data.Networks); i >= 0; i-- {
+		if _, found := remove[m.Data.Networks[i].GetKey().GetKeyString()]; found {
+			m.Data.Networks = append(m.Data.Networks[:i], m.Data.Networks[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataTrustPolicyExceptions(vals ...TrustPolicyException) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.TrustPolicyExceptions {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Data.TrustPolicyExceptions = append(m.Data.TrustPolicyExceptions, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataTrustPolicyExceptions(vals ...TrustPolicyException) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Data.TrustPolicyExceptions); i >= 0; i-- {
+		if _, found := remove[m.Data.TrustPolicyExceptions[i].GetKey().GetKeyString()]; found {
+			m.Data.TrustPolicyExceptions = append(m.Data.TrustPolicyExceptions[:i], m.Data.TrustPolicyExceptions[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataClusterRefs(vals ...ClusterRefs) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.ClusterRefs {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Data.ClusterRefs = append(m.Data.ClusterRefs, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataClusterRefs(vals ...ClusterRefs) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Data.ClusterRefs); i >= 0; i-- {
+		if _, found := remove[m.Data.ClusterRefs[i].GetKey().GetKeyString()]; found {
+			m.Data.ClusterRefs = append(m.Data.ClusterRefs[:i], m.Data.ClusterRefs[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataPlatformFeatures(vals ...PlatformFeatures) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.PlatformFeatures {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Data.PlatformFeatures = append(m.Data.PlatformFeatures, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataPlatformFeatures(vals ...PlatformFeatures) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Data.PlatformFeatures); i >= 0; i-- {
+		if _, found := remove[m.Data.PlatformFeatures[i].GetKey().GetKeyString()]; found {
+			m.Data.PlatformFeatures = append(m.Data.PlatformFeatures[:i], m.Data.PlatformFeatures[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataZones(vals ...Zone) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.Zones {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Data.Zones = append(m.Data.Zones, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataZones(vals ...Zone) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Data.Zones); i >= 0; i-- {
+		if _, found := remove[m.Data.Zones[i].GetKey().GetKeyString()]; found {
+			m.Data.Zones = append(m.Data.Zones[:i], m.Data.Zones[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddDataGeoFencePolicies(vals ...GeoFencePolicy) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Data.GeoFencePolicies {
+		cur[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.GetKey().GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Data.GeoFencePolicies = append(m.Data.GeoFencePolicies, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveDataGeoFencePolicies(vals ...GeoFencePolicy) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKey().GetKeyString()] = struct{}{}
+	}
+	for i := len(m.Data.GeoFencePolicies); i >= 0; i-- {
+		if _, found := remove[m.Data.GeoFencePolicies[i].GetKey().GetKeyString()]; found {
+			m.Data.GeoFencePolicies = append(m.Data.GeoFencePolicies[:i], m.Data.GeoFencePolicies[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) AddInternalEntries(vals ...DataSnapshotEntry) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.InternalEntries {
+		cur[v.String()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.String()]; found {
+			continue // duplicate
+		}
+		m.InternalEntries = append(m.InternalEntries, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *DataSnapshot) RemoveInternalEntries(vals ...DataSnapshotEntry) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.String()] = struct{}{}
+	}
+	for i := len(m.InternalEntries); i >= 0; i-- {
+		if _, found := remove[m.InternalEntries[i].String()]; found {
+			m.InternalEntries = append(m.InternalEntries[:i], m.InternalEntries[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *DataSnapshot) CopyInFields(src *DataSnapshot) int {
+	updateListAction := "replace"
+	changed := 0
+	if m.Region != src.Region {
+		m.Region = src.Region
+		changed++
+	}
+	if m.DataModelHash != src.DataModelHash {
+		m.DataModelHash = src.DataModelHash
+		changed++
+	}
+	if m.DataModelId != src.DataModelId {
+		m.DataModelId = src.DataModelId
+		changed++
+	}
+	if m.Revision != src.Revision {
+		m.Revision = src.Revision
+		changed++
+	}
+	if src.Data.Settings != nil {
+		if m.Data.Settings == nil {
+			m.Data.Settings = &Settings{}
+		}
+		if m.Data.Settings.ShepherdMetricsCollectionInterval != src.Data.Settings.ShepherdMetricsCollectionInterval {
+			m.Data.Settings.ShepherdMetricsCollectionInterval = src.Data.Settings.ShepherdMetricsCollectionInterval
+			changed++
+		}
+		if m.Data.Settings.ShepherdHealthCheckRetries != src.Data.Settings.ShepherdHealthCheckRetries {
+			m.Data.Settings.ShepherdHealthCheckRetries = src.Data.Settings.ShepherdHealthCheckRetries
+			changed++
+		}
+		if m.Data.Settings.ShepherdHealthCheckInterval != src.Data.Settings.ShepherdHealthCheckInterval {
+			m.Data.Settings.ShepherdHealthCheckInterval = src.Data.Settings.ShepherdHealthCheckInterval
+			changed++
+		}
+		if m.Data.Settings.AutoDeployIntervalSec != src.Data.Settings.AutoDeployIntervalSec {
+			m.Data.Settings.AutoDeployIntervalSec = src.Data.Settings.AutoDeployIntervalSec
+			changed++
+		}
+		if m.Data.Settings.AutoDeployOffsetSec != src.Data.Settings.AutoDeployOffsetSec {
+			m.Data.Settings.AutoDeployOffsetSec = src.Data.Settings.AutoDeployOffsetSec
+			changed++
+		}
+		if m.Data.Settings.AutoDeployMaxIntervals != src.Data.Settings.AutoDeployMaxIntervals {
+			m.Data.Settings.AutoDeployMaxIntervals = src.Data.Settings.AutoDeployMaxIntervals
+			changed++
+		}
+		if m.Data.Settings.CreateAppInstTimeout != src.Data.Settings.CreateAppInstTimeout {
+			m.Data.Settings.CreateAppInstTimeout = src.Data.Settings.CreateAppInstTimeout
+			changed++
+		}
+		if m.Data.Settings.UpdateAppInstTimeout != src.Data.Settings.UpdateAppInstTimeout {
+			m.Data.Settings.UpdateAppInstTimeout = src.Data.Settings.UpdateAppInstTimeout
+			changed++
+		}
+		if m.Data.Settings.DeleteAppInstTimeout != src.Data.Settings.DeleteAppInstTimeout {
+			m.Data.Settings.DeleteAppInstTimeout = src.Data.Settings.DeleteAppInstTimeout
+			changed++
+		}
+		if m.Data.Settings.CreateClusterInstTimeout != src.Data.Settings.CreateClusterInstTimeout {
+			m.Data.Settings.CreateClusterInstTimeout = src.Data.Settings.CreateClusterInstTimeout
+			changed++
+		}
+		if m.Data.Settings.UpdateClusterInstTimeout != src.Data.Settings.UpdateClusterInstTimeout {
+			m.Data.Settings.UpdateClusterInstTimeout = src.Data.Settings.UpdateClusterInstTimeout
+			changed++
+		}
+		if m.Data.Settings.DeleteClusterInstTimeout != src.Data.Settings.DeleteClusterInstTimeout {
+			m.Data.Settings.DeleteClusterInstTimeout = src.Data.Settings.DeleteClusterInstTimeout
+			changed++
+		}
+		if m.Data.Settings.MasterNodeFlavor != src.Data.Settings.MasterNodeFlavor {
+			m.Data.Settings.MasterNodeFlavor = src.Data.Settings.MasterNodeFlavor
+			changed++
+		}
+		if m.Data.Settings.MaxTrackedDmeClients != src.Data.Settings.MaxTrackedDmeClients {
+			m.Data.Settings.MaxTrackedDmeClients = src.Data.Settings.MaxTrackedDmeClients
+			changed++
+		}
+		if m.Data.Settings.InfluxDbMetricsRetention != src.Data.Settings.InfluxDbMetricsRetention {
+			m.Data.Settings.InfluxDbMetricsRetention = src.Data.Settings.InfluxDbMetricsRetention
+			changed++
+		}
+		if m.Data.Settings.CloudletMaintenanceTimeout != src.Data.Settings.CloudletMaintenanceTimeout {
+			m.Data.Settings.CloudletMaintenanceTimeout = src.Data.Settings.CloudletMaintenanceTimeout
+			changed++
+		}
+		if m.Data.Settings.ShepherdAlertEvaluationInterval != src.Data.Settings.ShepherdAlertEvaluationInterval {
+			m.Data.Settings.ShepherdAlertEvaluationInterval = src.Data.Settings.ShepherdAlertEvaluationInterval
+			changed++
+		}
+		if m.Data.Settings.UpdateVmPoolTimeout != src.Data.Settings.UpdateVmPoolTimeout {
+			m.Data.Settings.UpdateVmPoolTimeout = src.Data.Settings.UpdateVmPoolTimeout
+			changed++
+		}
+		if m.Data.Settings.UpdateTrustPolicyTimeout != src.Data.Settings.UpdateTrustPolicyTimeout {
+			m.Data.Settings.UpdateTrustPolicyTimeout = src.Data.Settings.UpdateTrustPolicyTimeout
+			changed++
+		}
+		if m.Data.Settings.DmeApiMetricsCollectionInterval != src.Data.Settings.DmeApiMetricsCollectionInterval {
+			m.Data.Settings.DmeApiMetricsCollectionInterval = src.Data.Settings.DmeApiMetricsCollectionInterval
+			changed++
+		}
+		if m.Data.Settings.EdgeEventsMetricsCollectionInterval != src.Data.Settings.EdgeEventsMetricsCollectionInterval {
+			m.Data.Settings.EdgeEventsMetricsCollectionInterval = src.Data.Settings.EdgeEventsMetricsCollectionInterval
+			changed++
+		}
+		if m.Data.Settings.CleanupReservableAutoClusterIdletime != src.Data.Settings.CleanupReservableAutoClusterIdletime {
+			m.Data.Settings.CleanupReservableAutoClusterIdletime = src.Data.Settings.CleanupReservableAutoClusterIdletime
+			changed++
+		}
+		if m.Data.Settings.InfluxDbCloudletUsageMetricsRetention != src.Data.Settings.InfluxDbCloudletUsageMetricsRetention {
+			m.Data.Settings.InfluxDbCloudletUsageMetricsRetention = src.Data.Settings.InfluxDbCloudletUsageMetricsRetention
+			changed++
+		}
+		if m.Data.Settings.CreateCloudletTimeout != src.Data.Settings.CreateCloudletTimeout {
+			m.Data.Settings.CreateCloudletTimeout = src.Data.Settings.CreateCloudletTimeout
+			changed++
+		}
+		if m.Data.Settings.UpdateCloudletTimeout != src.Data.Settings.UpdateCloudletTimeout {
+			m.Data.Settings.UpdateCloudletTimeout = src.Data.Settings.UpdateCloudletTimeout
+			changed++
+		}
+		if m.Data.Settings.LocationTileSideLengthKm != src.Data.Settings.LocationTileSideLengthKm {
+			m.Data.Settings.LocationTileSideLengthKm = src.Data.Settings.LocationTileSideLengthKm
+			changed++
+		}
+		if src.Data.Settings.EdgeEventsMetricsContinuousQueriesCollectionIntervals != nil {
+			if updateListAction == "add" {
+				changed += m.AddDataSettingsEdgeEventsMetricsContinuousQueriesCollectionIntervals(src.Data.Settings.EdgeEventsMetricsContinuousQueriesCollectionIntervals...)
+			} else if updateListAction == "remove" {
+				changed += m.RemoveDataSettingsEdgeEventsMetricsContinuousQueriesCollectionIntervals(src.Data.Settings.EdgeEventsMetricsContinuousQueriesCollectionIntervals...)
+			} else {
+				m.Data.Settings.EdgeEventsMetricsContinuousQueriesCollectionIntervals = make([]*CollectionInterval, 0)
+				for k2, _ := range src.Data.Settings.EdgeEventsMetricsContinuousQueriesCollectionIntervals {
+					m.Data.Settings.EdgeEventsMetricsContinuousQueriesCollectionIntervals = append(m.Data.Settings.EdgeEventsMetricsContinuousQueriesCollectionIntervals, src.Data.Settings.EdgeEventsMetricsContinuousQueriesCollectionIntervals[k2].Clone())
+				}
+				changed++
+			}
+		} else if m.Data.Settings.EdgeEventsMetricsContinuousQueriesCollectionIntervals != nil {
+			m.Data.Settings.EdgeEventsMetricsContinuousQueriesCollectionIntervals = nil
+			changed++
+		}
+		if m.Data.Settings.InfluxDbDownsampledMetricsRetention != src.Data.Settings.InfluxDbDownsampledMetricsRetention {
+			m.Data.Settings.InfluxDbDownsampledMetricsRetention = src.Data.Settings.InfluxDbDownsampledMetricsRetention
+			changed++
+		}
+		if m.Data.Settings.InfluxDbEdgeEventsMetricsRetention != src.Data.Settings.InfluxDbEdgeEventsMetricsRetention {
+			m.Data.Settings.InfluxDbEdgeEventsMetricsRetention = src.Data.Settings.InfluxDbEdgeEventsMetricsRetention
+			changed++
+		}
+		if m.Data.Settings.AppinstClientCleanupInterval != src.Data.Settings.AppinstClientCleanupInterval {
+			m.Data.Settings.AppinstClientCleanupInterval = src.Data.Settings.AppinstClientCleanupInterval
+			changed++
+		}
+		if m.Data.Settings.ClusterAutoScaleAveragingDurationSec != src.Data.Settings.ClusterAutoScaleAveragingDurationSec {
+			m.Data.Settings.ClusterAutoScaleAveragingDurationSec = src.Data.Settings.ClusterAutoScaleAveragingDurationSec
+			changed++
+		}
+		if m.Data.Settings.ClusterAutoScaleRetryDelay != src.Data.Settings.ClusterAutoScaleRetryDelay {
+			m.Data.Settings.ClusterAutoScaleRetryDelay = src.Data.Settings.ClusterAutoScaleRetryDelay
+			changed++
+		}
+		if m.Data.Settings.AlertPolicyMinTriggerTime != src.Data.Settings.AlertPolicyMinTriggerTime {
+			m.Data.Settings.AlertPolicyMinTriggerTime = src.Data.Settings.AlertPolicyMinTriggerTime
+			changed++
+		}
+		if m.Data.Settings.DisableRateLimit != src.Data.Settings.DisableRateLimit {
+			m.Data.Settings.DisableRateLimit = src.Data.Settings.DisableRateLimit
+			changed++
+		}
+		if m.Data.Settings.RateLimitMaxTrackedIps != src.Data.Settings.RateLimitMaxTrackedIps {
+			m.Data.Settings.RateLimitMaxTrackedIps = src.Data.Settings.RateLimitMaxTrackedIps
+			changed++
+		}
+		if m.Data.Settings.ShepherdMetricsScrapeInterval != src.Data.Settings.ShepherdMetricsScrapeInterval {
+			m.Data.Settings.ShepherdMetricsScrapeInterval = src.Data.Settings.ShepherdMetricsScrapeInterval
+			changed++
+		}
+		if m.Data.Settings.ResourceSnapshotThreadInterval != src.Data.Settings.ResourceSnapshotThreadInterval {
+			m.Data.Settings.ResourceSnapshotThreadInterval = src.Data.Settings.ResourceSnapshotThreadInterval
+			changed++
+		}
+		if m.Data.Settings.PlatformHaInstancePollInterval != src.Data.Settings.PlatformHaInstancePollInterval {
+			m.Data.Settings.PlatformHaInstancePollInterval = src.Data.Settings.PlatformHaInstancePollInterval
+			changed++
+		}
+		if m.Data.Settings.PlatformHaInstanceActiveExpireTime != src.Data.Settings.PlatformHaInstanceActiveExpireTime {
+			m.Data.Settings.PlatformHaInstanceActiveExpireTime = src.Data.Settings.PlatformHaInstanceActiveExpireTime
+			changed++
+		}
+		if m.Data.Settings.CcrmApiTimeout != src.Data.Settings.CcrmApiTimeout {
+			m.Data.Settings.CcrmApiTimeout = src.Data.Settings.CcrmApiTimeout
+			changed++
+		}
+		if m.Data.Settings.FindCloudletScoreWeights.Distance != src.Data.Settings.FindCloudletScoreWeights.Distance {
+			m.Data.Settings.FindCloudletScoreWeights.Distance = src.Data.Settings.FindCloudletScoreWeights.Distance
+			changed++
+		}
+		if m.Data.Settings.FindCloudletScoreWeights.Latency != src.Data.Settings.FindCloudletScoreWeights.Latency {
+			m.Data.Settings.FindCloudletScoreWeights.Latency = src.Data.Settings.FindCloudletScoreWeights.Latency
+			changed++
+		}
+		if m.Data.Settings.FindCloudletScoreWeights.ResourceUsage != src.Data.Settings.FindCloudletScoreWeights.ResourceUsage {
+			m.Data.Settings.FindCloudletScoreWeights.ResourceUsage = src.Data.Settings.FindCloudletScoreWeights.ResourceUsage
+			changed++
+		}
+		if m.Data.Settings.FindCloudletScoreWeights.Health != src.Data.Settings.FindCloudletScoreWeights.Health {
+			m.Data.Settings.FindCloudletScoreWeights.Health = src.Data.Settings.FindCloudletScoreWeights.Health
+			changed++
+		}
+		if m.Data.Settings.FindCloudletDistribution != src.Data.Settings.FindCloudletDistribution {
+			m.Data.Settings.FindCloudletDistribution = src.Data.Settings.FindCloudletDistribution
+			changed++
+		}
+		if m.Data.Settings.FindCloudletStickySessions != src.Data.Settings.FindCloudletStickySessions {
+			m.Data.Settings.FindCloudletStickySessions = src.Data.Settings.FindCloudletStickySessions
+			changed++
+		}
+		if m.Data.Settings.MaxObjectHistoryRevisions != src.Data.Settings.MaxObjectHistoryRevisions {
+			m.Data.Settings.MaxObjectHistoryRevisions = src.Data.Settings.MaxObjectHistoryRevisions
+			changed++
+		}
+	} else if m.Data.Settings != nil {
+		m.Data.Settings = nil
+		changed++
+	}
+	if src.Data.Flavors != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataFlavors(src.Data.Flavors...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataFlavors(src.Data.Flavors...)
+		} else {
+			m.Data.Flavors = make([]Flavor, 0)
+			for k1, _ := range src.Data.Flavors {
+				m.Data.Flavors = append(m.Data.Flavors, *src.Data.Flavors[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.Flavors != nil {
+		m.Data.Flavors = nil
+		changed++
+	}
+	if src.Data.OperatorCodes != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataOperatorCodes(src.Data.OperatorCodes...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataOperatorCodes(src.Data.OperatorCodes...)
+		} else {
+			m.Data.OperatorCodes = make([]OperatorCode, 0)
+			for k1, _ := range src.Data.OperatorCodes {
+				m.Data.OperatorCodes = append(m.Data.OperatorCodes, *src.Data.OperatorCodes[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.OperatorCodes != nil {
+		m.Data.OperatorCodes = nil
+		changed++
+	}
+	if src.Data.ResTagTables != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataResTagTables(src.Data.ResTagTables...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataResTagTables(src.Data.ResTagTables...)
+		} else {
+			m.Data.ResTagTables = make([]ResTagTable, 0)
+			for k1, _ := range src.Data.ResTagTables {
+				m.Data.ResTagTables = append(m.Data.ResTagTables, *src.Data.ResTagTables[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.ResTagTables != nil {
+		m.Data.ResTagTables = nil
+		changed++
+	}
+	if src.Data.Cloudlets != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataCloudlets(src.Data.Cloudlets...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataCloudlets(src.Data.Cloudlets...)
+		} else {
+			m.Data.Cloudlets = make([]Cloudlet, 0)
+			for k1, _ := range src.Data.Cloudlets {
+				m.Data.Cloudlets = append(m.Data.Cloudlets, *src.Data.Cloudlets[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.Cloudlets != nil {
+		m.Data.Cloudlets = nil
+		changed++
+	}
+	if src.Data.CloudletInfos != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataCloudletInfos(src.Data.CloudletInfos...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataCloudletInfos(src.Data.CloudletInfos...)
+		} else {
+			m.Data.CloudletInfos = make([]CloudletInfo, 0)
+			for k1, _ := range src.Data.CloudletInfos {
+				m.Data.CloudletInfos = append(m.Data.CloudletInfos, *src.Data.CloudletInfos[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.CloudletInfos != nil {
+		m.Data.CloudletInfos = nil
+		changed++
+	}
+	if src.Data.ZonePools != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataZonePools(src.Data.ZonePools...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataZonePools(src.Data.ZonePools...)
+		} else {
+			m.Data.ZonePools = make([]ZonePool, 0)
+			for k1, _ := range src.Data.ZonePools {
+				m.Data.ZonePools = append(m.Data.ZonePools, *src.Data.ZonePools[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.ZonePools != nil {
+		m.Data.ZonePools = nil
+		changed++
+	}
+	if src.Data.AutoProvPolicies != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataAutoProvPolicies(src.Data.AutoProvPolicies...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataAutoProvPolicies(src.Data.AutoProvPolicies...)
+		} else {
+			m.Data.AutoProvPolicies = make([]AutoProvPolicy, 0)
+			for k1, _ := range src.Data.AutoProvPolicies {
+				m.Data.AutoProvPolicies = append(m.Data.AutoProvPolicies, *src.Data.AutoProvPolicies[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.AutoProvPolicies != nil {
+		m.Data.AutoProvPolicies = nil
+		changed++
+	}
+	if src.Data.AutoProvPolicyZones != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataAutoProvPolicyZones(src.Data.AutoProvPolicyZones...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataAutoProvPolicyZones(src.Data.AutoProvPolicyZones...)
+		} else {
+			m.Data.AutoProvPolicyZones = make([]AutoProvPolicyZone, 0)
+			for k1, _ := range src.Data.AutoProvPolicyZones {
+				m.Data.AutoProvPolicyZones = append(m.Data.AutoProvPolicyZones, *src.Data.AutoProvPolicyZones[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.AutoProvPolicyZones != nil {
+		m.Data.AutoProvPolicyZones = nil
+		changed++
+	}
+	if src.Data.AutoScalePolicies != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataAutoScalePolicies(src.Data.AutoScalePolicies...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataAutoScalePolicies(src.Data.AutoScalePolicies...)
+		} else {
+			m.Data.AutoScalePolicies = make([]AutoScalePolicy, 0)
+			for k1, _ := range src.Data.AutoScalePolicies {
+				m.Data.AutoScalePolicies = append(m.Data.AutoScalePolicies, *src.Data.AutoScalePolicies[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.AutoScalePolicies != nil {
+		m.Data.AutoScalePolicies = nil
+		changed++
+	}
+	if src.Data.TrustPolicies != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataTrustPolicies(src.Data.TrustPolicies...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataTrustPolicies(src.Data.TrustPolicies...)
+		} else {
+			m.Data.TrustPolicies = make([]TrustPolicy, 0)
+			for k1, _ := range src.Data.TrustPolicies {
+				m.Data.TrustPolicies = append(m.Data.TrustPolicies, *src.Data.TrustPolicies[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.TrustPolicies != nil {
+		m.Data.TrustPolicies = nil
+		changed++
+	}
+	if src.Data.ClusterInsts != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataClusterInsts(src.Data.ClusterInsts...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataClusterInsts(src.Data.ClusterInsts...)
+		} else {
+			m.Data.ClusterInsts = make([]ClusterInst, 0)
+			for k1, _ := range src.Data.ClusterInsts {
+				m.Data.ClusterInsts = append(m.Data.ClusterInsts, *src.Data.ClusterInsts[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.ClusterInsts != nil {
+		m.Data.ClusterInsts = nil
+		changed++
+	}
+	if src.Data.Apps != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataApps(src.Data.Apps...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataApps(src.Data.Apps...)
+		} else {
+			m.Data.Apps = make([]App, 0)
+			for k1, _ := range src.Data.Apps {
+				m.Data.Apps = append(m.Data.Apps, *src.Data.Apps[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.Apps != nil {
+		m.Data.Apps = nil
+		changed++
+	}
+	if src.Data.AppInstances != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataAppInstances(src.Data.AppInstances...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataAppInstances(src.Data.AppInstances...)
+		} else {
+			m.Data.AppInstances = make([]AppInst, 0)
+			for k1, _ := range src.Data.AppInstances {
+				m.Data.AppInstances = append(m.Data.AppInstances, *src.Data.AppInstances[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.AppInstances != nil {
+		m.Data.AppInstances = nil
+		changed++
+	}
+	if src.Data.AppInstRefs != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataAppInstRefs(src.Data.AppInstRefs...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataAppInstRefs(src.Data.AppInstRefs...)
+		} else {
+			m.Data.AppInstRefs = make([]AppInstRefs, 0)
+			for k1, _ := range src.Data.AppInstRefs {
+				m.Data.AppInstRefs = append(m.Data.AppInstRefs, *src.Data.AppInstRefs[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.AppInstRefs != nil {
+		m.Data.AppInstRefs = nil
+		changed++
+	}
+	if src.Data.VmPools != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataVmPools(src.Data.VmPools...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataVmPools(src.Data.VmPools...)
+		} else {
+			m.Data.VmPools = make([]VMPool, 0)
+			for k1, _ := range src.Data.VmPools {
+				m.Data.VmPools = append(m.Data.VmPools, *src.Data.VmPools[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.VmPools != nil {
+		m.Data.VmPools = nil
+		changed++
+	}
+	if src.Data.IdleReservableClusterInsts != nil {
+		if m.Data.IdleReservableClusterInsts == nil {
+			m.Data.IdleReservableClusterInsts = &IdleReservableClusterInsts{}
+		}
+		if m.Data.IdleReservableClusterInsts.IdleTime != src.Data.IdleReservableClusterInsts.IdleTime {
+			m.Data.IdleReservableClusterInsts.IdleTime = src.Data.IdleReservableClusterInsts.IdleTime
+			changed++
+		}
+	} else if m.Data.IdleReservableClusterInsts != nil {
+		m.Data.IdleReservableClusterInsts = nil
+		changed++
+	}
+	if src.Data.GpuDrivers != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataGpuDrivers(src.Data.GpuDrivers...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataGpuDrivers(src.Data.GpuDrivers...)
+		} else {
+			m.Data.GpuDrivers = make([]GPUDriver, 0)
+			for k1, _ := range src.Data.GpuDrivers {
+				m.Data.GpuDrivers = append(m.Data.GpuDrivers, *src.Data.GpuDrivers[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.GpuDrivers != nil {
+		m.Data.GpuDrivers = nil
+		changed++
+	}
+	if src.Data.AlertPolicies != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataAlertPolicies(src.Data.AlertPolicies...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataAlertPolicies(src.Data.AlertPolicies...)
+		} else {
+			m.Data.AlertPolicies = make([]AlertPolicy, 0)
+			for k1, _ := range src.Data.AlertPolicies {
+				m.Data.AlertPolicies = append(m.Data.AlertPolicies, *src.Data.AlertPolicies[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.AlertPolicies != nil {
+		m.Data.AlertPolicies = nil
+		changed++
+	}
+	if src.Data.FlowRateLimitSettings != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataFlowRateLimitSettings(src.Data.FlowRateLimitSettings...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataFlowRateLimitSettings(src.Data.FlowRateLimitSettings...)
+		} else {
+			m.Data.FlowRateLimitSettings = make([]FlowRateLimitSettings, 0)
+			for k1, _ := range src.Data.FlowRateLimitSettings {
+				m.Data.FlowRateLimitSettings = append(m.Data.FlowRateLimitSettings, *src.Data.FlowRateLimitSettings[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.FlowRateLimitSettings != nil {
+		m.Data.FlowRateLimitSettings = nil
+		changed++
+	}
+	if src.Data.MaxReqsRateLimitSettings != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataMaxReqsRateLimitSettings(src.Data.MaxReqsRateLimitSettings...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataMaxReqsRateLimitSettings(src.Data.MaxReqsRateLimitSettings...)
+		} else {
+			m.Data.MaxReqsRateLimitSettings = make([]MaxReqsRateLimitSettings, 0)
+			for k1, _ := range src.Data.MaxReqsRateLimitSettings {
+				m.Data.MaxReqsRateLimitSettings = append(m.Data.MaxReqsRateLimitSettings, *src.Data.MaxReqsRateLimitSettings[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.MaxReqsRateLimitSettings != nil {
+		m.Data.MaxReqsRateLimitSettings = nil
+		changed++
+	}
+	if src.Data.Networks != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataNetworks(src.Data.Networks...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataNetworks(src.Data.Networks...)
+		} else {
+			m.Data.Networks = make([]Network, 0)
+			for k1, _ := range src.Data.Networks {
+				m.Data.Networks = append(m.Data.Networks, *src.Data.Networks[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.Networks != nil {
+		m.Data.Networks = nil
+		changed++
+	}
+	if src.Data.TrustPolicyExceptions != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataTrustPolicyExceptions(src.Data.TrustPolicyExceptions...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataTrustPolicyExceptions(src.Data.TrustPolicyExceptions...)
+		} else {
+			m.Data.TrustPolicyExceptions = make([]TrustPolicyException, 0)
+			for k1, _ := range src.Data.TrustPolicyExceptions {
+				m.Data.TrustPolicyExceptions = append(m.Data.TrustPolicyExceptions, *src.Data.TrustPolicyExceptions[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.TrustPolicyExceptions != nil {
+		m.Data.TrustPolicyExceptions = nil
+		changed++
+	}
+	if src.Data.ClusterRefs != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataClusterRefs(src.Data.ClusterRefs...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataClusterRefs(src.Data.ClusterRefs...)
+		} else {
+			m.Data.ClusterRefs = make([]ClusterRefs, 0)
+			for k1, _ := range src.Data.ClusterRefs {
+				m.Data.ClusterRefs = append(m.Data.ClusterRefs, *src.Data.ClusterRefs[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.ClusterRefs != nil {
+		m.Data.ClusterRefs = nil
+		changed++
+	}
+	if src.Data.PlatformFeatures != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataPlatformFeatures(src.Data.PlatformFeatures...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataPlatformFeatures(src.Data.PlatformFeatures...)
+		} else {
+			m.Data.PlatformFeatures = make([]PlatformFeatures, 0)
+			for k1, _ := range src.Data.PlatformFeatures {
+				m.Data.PlatformFeatures = append(m.Data.PlatformFeatures, *src.Data.PlatformFeatures[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.PlatformFeatures != nil {
+		m.Data.PlatformFeatures = nil
+		changed++
+	}
+	if src.Data.Zones != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataZones(src.Data.Zones...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataZones(src.Data.Zones...)
+		} else {
+			m.Data.Zones = make([]Zone, 0)
+			for k1, _ := range src.Data.Zones {
+				m.Data.Zones = append(m.Data.Zones, *src.Data.Zones[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.Zones != nil {
+		m.Data.Zones = nil
+		changed++
+	}
+	if src.Data.GeoFencePolicies != nil {
+		if updateListAction == "add" {
+			changed += m.AddDataGeoFencePolicies(src.Data.GeoFencePolicies...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveDataGeoFencePolicies(src.Data.GeoFencePolicies...)
+		} else {
+			m.Data.GeoFencePolicies = make([]GeoFencePolicy, 0)
+			for k1, _ := range src.Data.GeoFencePolicies {
+				m.Data.GeoFencePolicies = append(m.Data.GeoFencePolicies, *src.Data.GeoFencePolicies[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Data.GeoFencePolicies != nil {
+		m.Data.GeoFencePolicies = nil
+		changed++
+	}
+	if src.InternalEntries != nil {
+		if updateListAction == "add" {
+			changed += m.AddInternalEntries(src.InternalEntries...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveInternalEntries(src.InternalEntries...)
+		} else {
+			m.InternalEntries = make([]DataSnapshotEntry, 0)
+			for k0, _ := range src.InternalEntries {
+				m.InternalEntries = append(m.InternalEntries, *src.InternalEntries[k0].Clone())
+			}
+			changed++
+		}
+	} else if m.InternalEntries != nil {
+		m.InternalEntries = nil
+		changed++
+	}
+	return changed
+}
+
+func (m *DataSnapshot) DeepCopyIn(src *DataSnapshot) {
+	m.Region = src.Region
+	m.DataModelHash = src.DataModelHash
+	m.DataModelId = src.DataModelId
+	m.Revision = src.Revision
+	m.Data.DeepCopyIn(&src.Data)
+	if src.InternalEntries != nil {
+		m.InternalEntries = make([]DataSnapshotEntry, len(src.InternalEntries), len(src.InternalEntries))
+		for ii, s := range src.InternalEntries {
+			m.InternalEntries[ii].DeepCopyIn(&s)
+		}
+	} else {
+		m.InternalEntries = nil
+	}
+}
+
+// Helper method to check that enums have valid values
+func (m *DataSnapshot) ValidateEnums() error {
+	if err := m.Data.ValidateEnums(); err != nil {
+		return err
+	}
+	for _, e := range m.InternalEntries {
+		if err := e.ValidateEnums(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *DataSnapshot) ClearTagged(tags map[string]struct{}) {
+	s.Data.ClearTagged(tags)
+	if s.InternalEntries != nil {
+		for ii := 0; ii < len(s.InternalEntries); ii++ {
+			s.InternalEntries[ii].ClearTagged(tags)
+		}
+	}
+}
+
+func IgnoreDataSnapshotFields(taglist string) cmp.Option {
+	names := []string{}
+	tags := make(map[string]struct{})
+	for _, tag := range strings.Split(taglist, ",") {
+		tags[tag] = struct{}{}
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.Cloudlets.Errors")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.Cloudlets.NotifySrvAddr")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.Cloudlets.Config.NotifyCtrlAddrs")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.Cloudlets.Config.PlatformTag")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.Cloudlets.Config.Span")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.Cloudlets.Config.CrmAccessPrivateKey")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.Cloudlets.Config.AccessApiAddr")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.Cloudlets.Config.SecondaryCrmAccessPrivateKey")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.Cloudlets.AccessVars")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.Cloudlets.CrmAccessPublicKey")
+	}
+	if _, found := tags["timestamp"]; found {
+		names = append(names, "Data.Cloudlets.CreatedAt")
+	}
+	if _, found := tags["timestamp"]; found {
+		names = append(names, "Data.Cloudlets.UpdatedAt")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.Cloudlets.HostController")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.Cloudlets.SecondaryCrmAccessPublicKey")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.Cloudlets.SecondaryNotifySrvAddr")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.Cloudlets.ObjId")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.Cloudlets.DbModelId")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.CloudletInfos.NotifyId")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.CloudletInfos.Controller")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.CloudletInfos.Status")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.CloudletInfos.CompatibilityVersion")
+	}
+	if _, found := tags["timestamp"]; found {
+		names = append(names, "Data.ZonePools.CreatedAt")
+	}
+	if _, found := tags["timestamp"]; found {
+		names = append(names, "Data.ZonePools.UpdatedAt")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.ClusterInsts.Errors")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.ClusterInsts.AllocatedIp")
+	}
+	if _, found := tags["timestamp"]; found {
+		names = append(names, "Data.ClusterInsts.CreatedAt")
+	}
+	if _, found := tags["timestamp"]; found {
+		names = append(names, "Data.ClusterInsts.UpdatedAt")
+	}
+	if _, found := tags["timestamp"]; found {
+		names = append(names, "Data.ClusterInsts.ReservationEndedAt")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.ClusterInsts.ObjId")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.ClusterInsts.CompatibilityVersion")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.ClusterInsts.DbModelId")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.ClusterInsts.InfraAnnotations")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.Apps.AuthPublicKey")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.Apps.Revision")
+	}
+	if _, found := tags["timestamp"]; found {
+		names = append(names, "Data.Apps.CreatedAt")
+	}
+	if _, found := tags["timestamp"]; found {
+		names = append(names, "Data.Apps.UpdatedAt")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.Apps.ObjId")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.Apps.CompatibilityVersion")
+	}
+	if _, found := tags["timestamp"]; found {
+		names = append(names, "Data.AppInstances.CreatedAt")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.AppInstances.Revision")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.AppInstances.ForceUpdate")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.AppInstances.UpdateMultiple")
+	}
+	if _, found := tags["timestamp"]; found {
+		names = append(names, "Data.AppInstances.UpdatedAt")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.AppInstances.CompatibilityVersion")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.AppInstances.VirtualClusterKey")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.AppInstances.ObjId")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.AppInstances.DbModelId")
+	}
+	if _, found := tags["timestamp"]; found {
+		names = append(names, "Data.VmPools.Vms.UpdatedAt")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.VmPools.Errors")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.AlertPolicies.DeletePrepare")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.Zones.ObjId")
+	}
+	if _, found := tags["timestamp"]; found {
+		names = append(names, "Data.Zones.CreatedAt")
+	}
+	if _, found := tags["timestamp"]; found {
+		names = append(names, "Data.Zones.UpdatedAt")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Data.GeoFencePolicies.DeletePrepare")
+	}
+	return cmpopts.IgnoreFields(DataSnapshot{}, names...)
+}
+
+func (m *DataSnapshotRequest) IsValidArgsForExportData() error {
+	return nil
+}
+
+func (m *DataSnapshot) IsValidArgsForRestoreData() error {
+	return nil
+}
+
+func (m *DataSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		n += 1 + sovSnapshot(uint64(m.Revision))
+	}
+	return n
+}
+
+func (m *DataSnapshotEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DbKey)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	l = len(m.DbValue)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
+
+func (m *DataSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Region)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	l = len(m.DataModelHash)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	if m.DataModelId != 0 {
+		n += 1 + sovSnapshot(uint64(m.DataModelId))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovSnapshot(uint64(m.Revision))
+	}
+	l = m.Data.Size()
+	n += 1 + l + sovSnapshot(uint64(l))
+	if len(m.InternalEntries) > 0 {
+		for _, e := range m.InternalEntries {
+			l = e.Size()
+			n += 1 + l + sovSnapshot(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSnapshot(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSnapshot(x uint64) (n int) {
+	return sovSnapshot(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DataSnapshotRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataSnapshotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataSnapshotEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataSnapshotEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataSnapshotEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataModelHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataModelHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataModelId", wireType)
+			}
+			m.DataModelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataModelId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InternalEntries = append(m.InternalEntries, DataSnapshotEntry{})
+			if err := m.InternalEntries[len(m.InternalEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSnapshot(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSnapshot
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSnapshot
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSnapshot
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSnapshot        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSnapshot          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSnapshot = fmt.Errorf("proto: unexpected end of group")
+)
@@ -125,55 +125,102 @@ func (m *TrustPolicy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TrustPolicy proto.InternalMessageInfo
 
+// TrustPolicyHistory is a Trust Policy as of a past revision
+type TrustPolicyHistory struct {
+	// Database revision of the change
+	Revision int64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// TrustPolicy as of the revision
+	Obj TrustPolicy `protobuf:"bytes,2,opt,name=obj,proto3" json:"obj"`
+}
+
+func (m *TrustPolicyHistory) Reset()         { *m = TrustPolicyHistory{} }
+func (m *TrustPolicyHistory) String() string { return proto.CompactTextString(m) }
+func (*TrustPolicyHistory) ProtoMessage()    {}
+func (*TrustPolicyHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ac2a49c998f3261, []int{2}
+}
+func (m *TrustPolicyHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrustPolicyHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrustPolicyHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrustPolicyHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustPolicyHistory.Merge(m, src)
+}
+func (m *TrustPolicyHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrustPolicyHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustPolicyHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustPolicyHistory proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*SecurityRule)(nil), "edgeproto.SecurityRule")
 	proto.RegisterType((*TrustPolicy)(nil), "edgeproto.TrustPolicy")
+	proto.RegisterType((*TrustPolicyHistory)(nil), "edgeproto.TrustPolicyHistory")
 }
 
 func init() { proto.RegisterFile("trustpolicy.proto", fileDescriptor_0ac2a49c998f3261) }
 
 var fileDescriptor_0ac2a49c998f3261 = []byte{
-	// 632 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x93, 0x3f, 0x4f, 0x14, 0x4f,
-	0x18, 0xc7, 0x6f, 0x38, 0x7e, 0x04, 0xe6, 0x80, 0x1f, 0xb7, 0xf2, 0x67, 0x72, 0xc1, 0xe5, 0x72,
-	0xb1, 0xb8, 0xe0, 0x79, 0x6b, 0xb0, 0x23, 0xa1, 0x00, 0xec, 0x08, 0x8a, 0x8b, 0xd8, 0x5e, 0x86,
-	0xdd, 0xc7, 0x65, 0xc2, 0xde, 0xce, 0x66, 0x66, 0x36, 0x70, 0x56, 0xc6, 0xd6, 0x86, 0x68, 0x22,
-	0xc6, 0x57, 0x40, 0xb4, 0x31, 0xbe, 0x06, 0x0b, 0x4a, 0x12, 0x1b, 0x2b, 0xa3, 0x60, 0x61, 0xa8,
-	0x4c, 0x58, 0xa8, 0xcd, 0xcd, 0x2e, 0x97, 0xf5, 0x90, 0xc4, 0xd0, 0xd8, 0x3d, 0xcf, 0xf7, 0x79,
-	0x66, 0x9e, 0xcf, 0xf3, 0xdd, 0x59, 0x5c, 0x54, 0x22, 0x92, 0x2a, 0xe4, 0x3e, 0x73, 0x5a, 0xf5,
-	0x50, 0x70, 0xc5, 0x8d, 0x01, 0x70, 0x3d, 0xd0, 0x61, 0x69, 0xd2, 0xe3, 0xdc, 0xf3, 0xc1, 0xa2,
-	0x21, 0xb3, 0x68, 0x10, 0x70, 0x45, 0x15, 0xe3, 0x81, 0x4c, 0x1a, 0x4b, 0x83, 0x02, 0x64, 0xe4,
-	0xab, 0x34, 0xbb, 0xae, 0x38, 0xf7, 0xa5, 0xa5, 0x13, 0x0f, 0x82, 0x4e, 0x90, 0x96, 0x47, 0x3d,
-	0xee, 0x71, 0x1d, 0x5a, 0xed, 0x28, 0x55, 0xc7, 0x68, 0xa4, 0xb8, 0x74, 0xa8, 0x0f, 0x59, 0x84,
-	0xca, 0x2e, 0xc2, 0x83, 0xab, 0xe0, 0x44, 0x82, 0xa9, 0x96, 0x1d, 0xf9, 0x60, 0x94, 0x70, 0xbf,
-	0xae, 0x38, 0xdc, 0x27, 0xa8, 0x8c, 0xaa, 0x03, 0x76, 0x27, 0x37, 0x6e, 0xe0, 0xe1, 0x90, 0x0b,
-	0xd5, 0x10, 0x34, 0xf0, 0xa0, 0xd1, 0x64, 0x01, 0xe9, 0x29, 0xa3, 0xea, 0x90, 0x3d, 0xd8, 0x56,
-	0xed, 0xb6, 0xb8, 0xcc, 0x82, 0xee, 0x2e, 0xba, 0x4d, 0xf2, 0xdd, 0x5d, 0x74, 0xdb, 0x98, 0xc2,
-	0x05, 0x01, 0x4d, 0xae, 0xa0, 0xe1, 0x30, 0x57, 0x90, 0x5e, 0x3d, 0x0a, 0x27, 0xd2, 0x22, 0x73,
-	0x45, 0xe5, 0x63, 0x0f, 0x2e, 0x3c, 0x6c, 0x5b, 0xb6, 0xa2, 0x79, 0x8d, 0x71, 0xdc, 0xf7, 0x98,
-	0x81, 0xef, 0x4a, 0x82, 0xca, 0xf9, 0xea, 0x80, 0x9d, 0x66, 0x46, 0x0d, 0xe7, 0x37, 0xa1, 0xa5,
-	0x49, 0x0a, 0x33, 0xa3, 0xf5, 0x8e, 0xa5, 0xf5, 0xe4, 0xdc, 0x12, 0xb4, 0x16, 0x7a, 0xf7, 0xbf,
-	0x4c, 0xe5, 0xec, 0x76, 0x9b, 0xb1, 0x86, 0x27, 0x78, 0xa4, 0xd6, 0x79, 0x14, 0xb8, 0x0d, 0x99,
-	0xee, 0xdd, 0x10, 0x91, 0x0f, 0x92, 0xe4, 0xcb, 0xf9, 0x6a, 0x61, 0x66, 0x22, 0x73, 0x43, 0xd6,
-	0x98, 0xf4, 0x92, 0xb1, 0xf3, 0xd3, 0xd9, 0x9a, 0x34, 0x6e, 0xe2, 0x61, 0x17, 0x7c, 0x50, 0xd0,
-	0x08, 0x05, 0x84, 0x54, 0x80, 0x5e, 0xa8, 0x7f, 0xa1, 0x77, 0x2f, 0x26, 0xc8, 0x1e, 0x4a, 0x6a,
-	0x2b, 0x49, 0x69, 0x76, 0xf3, 0xc7, 0x09, 0x41, 0x3f, 0x4f, 0x08, 0x7a, 0x1a, 0x13, 0xb4, 0x13,
-	0x13, 0xf4, 0x3a, 0x26, 0xe8, 0x7d, 0x4c, 0xd0, 0x8b, 0x53, 0x32, 0x74, 0x37, 0xdb, 0xfa, 0xe6,
-	0x94, 0x4c, 0x07, 0xb4, 0x09, 0x73, 0x4b, 0xd0, 0xaa, 0xdf, 0xa3, 0x4d, 0xa8, 0x39, 0x3e, 0x8f,
-	0x5c, 0x1f, 0x14, 0x17, 0x9e, 0x16, 0xef, 0x0b, 0x8f, 0x06, 0xec, 0x89, 0x7e, 0x2d, 0x1f, 0xce,
-	0xc8, 0xc8, 0x26, 0xb4, 0xe6, 0xb2, 0xda, 0xcc, 0xf3, 0xff, 0xf0, 0x70, 0xc6, 0xc6, 0xf9, 0x90,
-	0x19, 0xef, 0x10, 0x2e, 0x2e, 0x0a, 0xa0, 0x0a, 0x7e, 0xf3, 0x37, 0xb3, 0x78, 0x46, 0x2f, 0x15,
-	0x33, 0xba, 0xad, 0x9f, 0x61, 0x85, 0x1d, 0xc7, 0xc4, 0xb2, 0x41, 0xf2, 0x48, 0x38, 0xb0, 0x98,
-	0x12, 0xc9, 0xda, 0xbc, 0xd3, 0x9e, 0xb8, 0x4c, 0x03, 0xea, 0x41, 0xad, 0x1b, 0x6e, 0xef, 0x94,
-	0xa0, 0xb7, 0x67, 0x64, 0xa4, 0x5b, 0x7f, 0xf6, 0xe9, 0xfb, 0xcb, 0x1e, 0x52, 0xb9, 0x66, 0x39,
-	0x9a, 0xc8, 0xca, 0xfc, 0x25, 0xb3, 0x68, 0xfa, 0x36, 0x32, 0x76, 0x11, 0x2e, 0x26, 0xa6, 0x5c,
-	0x91, 0x76, 0xed, 0x8a, 0xb4, 0x1d, 0xb2, 0xe4, 0xeb, 0xfd, 0x99, 0x6c, 0x2d, 0x74, 0xe9, 0xbf,
-	0x23, 0x8b, 0xf4, 0xf4, 0x8b, 0x64, 0xaf, 0x10, 0xfe, 0x7f, 0x75, 0x83, 0x6f, 0xfd, 0x0d, 0xd7,
-	0x25, 0x7a, 0xe5, 0xc1, 0x71, 0x4c, 0x6e, 0x5d, 0x06, 0xf7, 0x88, 0xc1, 0xd6, 0x05, 0xb4, 0x83,
-	0x73, 0xb4, 0xf1, 0x4a, 0xd1, 0x92, 0x1b, 0x7c, 0xeb, 0x02, 0xd8, 0xc2, 0xe4, 0xfe, 0x37, 0x33,
-	0xb7, 0x7f, 0x68, 0xa2, 0x83, 0x43, 0x13, 0x7d, 0x3d, 0x34, 0xd1, 0xce, 0x91, 0x99, 0x3b, 0x38,
-	0x32, 0x73, 0x9f, 0x8f, 0xcc, 0xdc, 0x7a, 0x9f, 0xa6, 0xb8, 0xf3, 0x2b, 0x00, 0x00, 0xff, 0xff,
-	0x79, 0xfa, 0x4b, 0xcd, 0x2b, 0x05, 0x00, 0x00,
+	// 711 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x93, 0x31, 0x4f, 0x1b, 0x49,
+	0x14, 0xc7, 0x3d, 0x18, 0x21, 0x18, 0x03, 0x87, 0xf7, 0xc0, 0x8c, 0x2c, 0x30, 0xd6, 0xea, 0x0a,
+	0x8b, 0xf3, 0x79, 0x4f, 0x5c, 0x87, 0x44, 0x01, 0x5c, 0x71, 0x12, 0xe2, 0x8e, 0x5b, 0x8e, 0xd3,
+	0x75, 0xbe, 0xf1, 0xee, 0xcb, 0x32, 0xb0, 0xde, 0x59, 0xcd, 0xce, 0x06, 0x9c, 0x2a, 0xca, 0x27,
+	0x40, 0x89, 0x14, 0xa2, 0x7c, 0x02, 0x14, 0x9a, 0x28, 0x5d, 0xfa, 0x14, 0x2e, 0x91, 0xd2, 0xa4,
+	0x8a, 0x12, 0x48, 0x11, 0x51, 0x45, 0xc2, 0x50, 0x47, 0x9e, 0x5d, 0x5b, 0x1b, 0x1b, 0xa4, 0x84,
+	0x26, 0xdd, 0xbc, 0xff, 0x7b, 0xf3, 0xf6, 0xf7, 0xfe, 0xf3, 0x16, 0x67, 0xa5, 0x08, 0x03, 0xe9,
+	0x73, 0x97, 0x59, 0x8d, 0x8a, 0x2f, 0xb8, 0xe4, 0xda, 0x08, 0xd8, 0x0e, 0xa8, 0x63, 0x7e, 0xc6,
+	0xe1, 0xdc, 0x71, 0xc1, 0xa0, 0x3e, 0x33, 0xa8, 0xe7, 0x71, 0x49, 0x25, 0xe3, 0x5e, 0x10, 0x15,
+	0xe6, 0x47, 0x05, 0x04, 0xa1, 0x2b, 0xe3, 0x68, 0x56, 0x72, 0xee, 0x06, 0x86, 0x0a, 0x1c, 0xf0,
+	0xba, 0x87, 0x38, 0x3d, 0xe9, 0x70, 0x87, 0xab, 0xa3, 0xd1, 0x3e, 0xc5, 0xea, 0x14, 0x0d, 0x25,
+	0x0f, 0x2c, 0xea, 0x42, 0x12, 0x41, 0x3f, 0x44, 0x78, 0x74, 0x13, 0xac, 0x50, 0x30, 0xd9, 0x30,
+	0x43, 0x17, 0xb4, 0x3c, 0x1e, 0x56, 0x19, 0x8b, 0xbb, 0x04, 0x15, 0x51, 0x69, 0xc4, 0xec, 0xc6,
+	0xda, 0x4f, 0x78, 0xdc, 0xe7, 0x42, 0x56, 0x05, 0xf5, 0x1c, 0xa8, 0xd6, 0x99, 0x47, 0x06, 0x8a,
+	0xa8, 0x34, 0x66, 0x8e, 0xb6, 0x55, 0xb3, 0x2d, 0xae, 0x33, 0xaf, 0xb7, 0x8a, 0xee, 0x93, 0x74,
+	0x6f, 0x15, 0xdd, 0xd7, 0xe6, 0x70, 0x46, 0x40, 0x9d, 0x4b, 0xa8, 0x5a, 0xcc, 0x16, 0x64, 0x50,
+	0x7d, 0x0a, 0x47, 0xd2, 0x2a, 0xb3, 0x85, 0xfe, 0x6a, 0x00, 0x67, 0xfe, 0x69, 0x5b, 0xb6, 0xa1,
+	0x78, 0xb5, 0x1c, 0x1e, 0xba, 0xc3, 0xc0, 0xb5, 0x03, 0x82, 0x8a, 0xe9, 0xd2, 0x88, 0x19, 0x47,
+	0x5a, 0x19, 0xa7, 0x77, 0xa1, 0xa1, 0x48, 0x32, 0x0b, 0x93, 0x95, 0xae, 0xa5, 0x95, 0xe8, 0xde,
+	0x1a, 0x34, 0x56, 0x06, 0x9b, 0x6f, 0xe7, 0x52, 0x66, 0xbb, 0x4c, 0xdb, 0xc2, 0xd3, 0x3c, 0x94,
+	0x35, 0x1e, 0x7a, 0x76, 0x35, 0x88, 0xe7, 0xae, 0x8a, 0xd0, 0x85, 0x80, 0xa4, 0x8b, 0xe9, 0x52,
+	0x66, 0x61, 0x3a, 0xd1, 0x21, 0x69, 0x4c, 0xdc, 0x64, 0xaa, 0x73, 0x3b, 0x99, 0x0b, 0xb4, 0x9f,
+	0xf1, 0xb8, 0x0d, 0x2e, 0x48, 0xa8, 0xfa, 0x02, 0x7c, 0x2a, 0x40, 0x0d, 0x34, 0xbc, 0x32, 0x78,
+	0xd4, 0x22, 0xc8, 0x1c, 0x8b, 0x72, 0x1b, 0x51, 0x6a, 0x71, 0xf7, 0xe3, 0x05, 0x41, 0x9f, 0x2e,
+	0x08, 0xba, 0xdf, 0x22, 0xe8, 0xa0, 0x45, 0xd0, 0x93, 0x16, 0x41, 0xcf, 0x5b, 0x04, 0x3d, 0xbc,
+	0x24, 0x63, 0xbf, 0x27, 0x4b, 0x9f, 0x5e, 0x92, 0x79, 0x8f, 0xd6, 0x61, 0x69, 0x0d, 0x1a, 0x95,
+	0x3f, 0x69, 0x1d, 0xca, 0x96, 0xcb, 0x43, 0xdb, 0x05, 0xc9, 0x85, 0xa3, 0xc4, 0xbf, 0x84, 0x43,
+	0x3d, 0x76, 0x4f, 0x6d, 0xcb, 0x8b, 0x2b, 0x32, 0xb1, 0x0b, 0x8d, 0xa5, 0xa4, 0xa6, 0xff, 0x8f,
+	0xb5, 0x84, 0x8b, 0x7f, 0xb0, 0x40, 0x72, 0xd1, 0x68, 0xbf, 0xb2, 0x80, 0xbb, 0x2c, 0x60, 0xdc,
+	0x53, 0xaf, 0x9c, 0x36, 0xbb, 0xb1, 0x56, 0xc1, 0x69, 0x5e, 0xdb, 0x89, 0x0d, 0xcd, 0x25, 0xec,
+	0x48, 0xf4, 0xe9, 0x58, 0xca, 0x6b, 0x3b, 0x0b, 0x2f, 0x87, 0xf0, 0x78, 0x22, 0xb5, 0xec, 0x33,
+	0xed, 0x18, 0xe1, 0xec, 0xaa, 0x00, 0x2a, 0xe1, 0x8b, 0x17, 0xbc, 0xbe, 0x57, 0x3e, 0x9b, 0xd0,
+	0x4d, 0xb5, 0xe8, 0x3a, 0x3b, 0x6f, 0x11, 0xc3, 0x84, 0x80, 0x87, 0xc2, 0x82, 0xd5, 0x78, 0xe6,
+	0xa0, 0xbc, 0x6c, 0xb5, 0x67, 0x5a, 0xa7, 0x1e, 0x75, 0xa0, 0xdc, 0x3b, 0xfe, 0xd1, 0x25, 0x41,
+	0xcf, 0xae, 0xc8, 0x44, 0xaf, 0xfe, 0xe0, 0xf5, 0x87, 0x47, 0x03, 0x44, 0xff, 0xd1, 0xb0, 0x14,
+	0x91, 0x91, 0xf8, 0x0f, 0x17, 0xd1, 0xfc, 0xaf, 0x48, 0x3b, 0x44, 0x38, 0x1b, 0xd9, 0x7e, 0x4b,
+	0xda, 0xad, 0x5b, 0xd2, 0x76, 0xc9, 0xa2, 0xfd, 0xb8, 0x9e, 0x6c, 0xcb, 0xb7, 0xe9, 0xf7, 0x23,
+	0x0b, 0xd5, 0xd7, 0xfb, 0xc9, 0x1e, 0x23, 0xfc, 0xc3, 0xe6, 0x36, 0xdf, 0xfb, 0x1a, 0xae, 0x1b,
+	0x74, 0xfd, 0xef, 0xf3, 0x16, 0xf9, 0xe5, 0x26, 0xb8, 0x7f, 0x19, 0xec, 0xf5, 0xa1, 0x9d, 0x74,
+	0xd0, 0x72, 0x7a, 0xd6, 0x08, 0xb6, 0xf9, 0x5e, 0x3f, 0xd8, 0x31, 0xc2, 0xb9, 0x1e, 0xb0, 0xce,
+	0xd2, 0xdf, 0xc4, 0x37, 0x7b, 0xbd, 0x1e, 0x5f, 0xd3, 0xff, 0xfb, 0x66, 0xcc, 0x66, 0x07, 0x73,
+	0x56, 0x27, 0x7d, 0x98, 0xdb, 0x51, 0x5b, 0x45, 0xbb, 0x32, 0xd3, 0x7c, 0x5f, 0x48, 0x35, 0x4f,
+	0x0b, 0xe8, 0xe4, 0xb4, 0x80, 0xde, 0x9d, 0x16, 0xd0, 0xc1, 0x59, 0x21, 0x75, 0x72, 0x56, 0x48,
+	0xbd, 0x39, 0x2b, 0xa4, 0x6a, 0x43, 0x8a, 0xe9, 0xb7, 0xcf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe0,
+	0xf6, 0x02, 0x5d, 0x3b, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -196,6 +243,8 @@ type TrustPolicyApiClient interface {
 	UpdateTrustPolicy(ctx context.Context, in *TrustPolicy, opts ...grpc.CallOption) (TrustPolicyApi_UpdateTrustPolicyClient, error)
 	// Show Trust Policies. Any fields specified will be used to filter results.
 	ShowTrustPolicy(ctx context.Context, in *TrustPolicy, opts ...grpc.CallOption) (TrustPolicyApi_ShowTrustPolicyClient, error)
+	// Show the past revisions of a Trust Policy, newest first
+	ShowTrustPolicyHistory(ctx context.Context, in *TrustPolicy, opts ...grpc.CallOption) (TrustPolicyApi_ShowTrustPolicyHistoryClient, error)
 }
 
 type trustPolicyApiClient struct {
@@ -334,6 +383,38 @@ func (x *trustPolicyApiShowTrustPolicyClient) Recv() (*TrustPolicy, error) {
 	return m, nil
 }
 
+func (c *trustPolicyApiClient) ShowTrustPolicyHistory(ctx context.Context, in *TrustPolicy, opts ...grpc.CallOption) (TrustPolicyApi_ShowTrustPolicyHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TrustPolicyApi_serviceDesc.Streams[4], "/edgeproto.TrustPolicyApi/ShowTrustPolicyHistory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &trustPolicyApiShowTrustPolicyHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TrustPolicyApi_ShowTrustPolicyHistoryClient interface {
+	Recv() (*TrustPolicyHistory, error)
+	grpc.ClientStream
+}
+
+type trustPolicyApiShowTrustPolicyHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *trustPolicyApiShowTrustPolicyHistoryClient) Recv() (*TrustPolicyHistory, error) {
+	m := new(TrustPolicyHistory)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // TrustPolicyApiServer is the server API for TrustPolicyApi service.
 type TrustPolicyApiServer interface {
 	// Create a Trust Policy
@@ -344,6 +425,8 @@ type TrustPolicyApiServer interface {
 	UpdateTrustPolicy(*TrustPolicy, TrustPolicyApi_UpdateTrustPolicyServer) error
 	// Show Trust Policies. Any fields specified will be used to filter results.
 	ShowTrustPolicy(*TrustPolicy, TrustPolicyApi_ShowTrustPolicyServer) error
+	// Show the past revisions of a Trust Policy, newest first
+	ShowTrustPolicyHistory(*TrustPolicy, TrustPolicyApi_ShowTrustPolicyHistoryServer) error
 }
 
 // UnimplementedTrustPolicyApiServer can be embedded to have forward compatible implementations.
@@ -362,6 +445,9 @@ func (*UnimplementedTrustPolicyApiServer) UpdateTrustPolicy(req *TrustPolicy, sr
 func (*UnimplementedTrustPolicyApiServer) ShowTrustPolicy(req *TrustPolicy, srv TrustPolicyApi_ShowTrustPolicyServer) error {
 	return status.Errorf(codes.Unimplemented, "method ShowTrustPolicy not implemented")
 }
+func (*UnimplementedTrustPolicyApiServer) ShowTrustPolicyHistory(req *TrustPolicy, srv TrustPolicyApi_ShowTrustPolicyHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method ShowTrustPolicyHistory not implemented")
+}
 
 func RegisterTrustPolicyApiServer(s *grpc.Server, srv TrustPolicyApiServer) {
 	s.RegisterService(&_TrustPolicyApi_serviceDesc, srv)
@@ -451,6 +537,27 @@ func (x *trustPolicyApiShowTrustPolicyServer) Send(m *TrustPolicy) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _TrustPolicyApi_ShowTrustPolicyHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TrustPolicy)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TrustPolicyApiServer).ShowTrustPolicyHistory(m, &trustPolicyApiShowTrustPolicyHistoryServer{stream})
+}
+
+type TrustPolicyApi_ShowTrustPolicyHistoryServer interface {
+	Send(*TrustPolicyHistory) error
+	grpc.ServerStream
+}
+
+type trustPolicyApiShowTrustPolicyHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *trustPolicyApiShowTrustPolicyHistoryServer) Send(m *TrustPolicyHistory) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _TrustPolicyApi_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "edgeproto.TrustPolicyApi",
 	HandlerType: (*TrustPolicyApiServer)(nil),
@@ -476,6 +583,11 @@ var _TrustPolicyApi_serviceDesc = grpc.ServiceDesc{
 			Handler:       _TrustPolicyApi_ShowTrustPolicy_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ShowTrustPolicyHistory",
+			Handler:       _TrustPolicyApi_ShowTrustPolicyHistory_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "trustpolicy.proto",
 }
@@ -593,6 +705,44 @@ func (m *TrustPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TrustPolicyHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrustPolicyHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrustPolicyHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Obj.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTrustpolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Revision != 0 {
+		i = encodeVarintTrustpolicy(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTrustpolicy(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTrustpolicy(v)
 	base := offset
@@ -1575,6 +1725,98 @@ func (s *TrustPolicy) ClearTagged(tags map[string]struct{}) {
 	}
 }
 
+func (m *TrustPolicyHistory) Clone() *TrustPolicyHistory {
+	cp := &TrustPolicyHistory{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *TrustPolicyHistory) AddObjOutboundSecurityRules(vals ...SecurityRule) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Obj.OutboundSecurityRules {
+		cur[v.String()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.String()]; found {
+			continue // duplicate
+		}
+		m.Obj.OutboundSecurityRules = append(m.Obj.OutboundSecurityRules, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *TrustPolicyHistory) RemoveObjOutboundSecurityRules(vals ...SecurityRule) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.String()] = struct{}{}
+	}
+	for i := len(m.Obj.OutboundSecurityRules); i >= 0; i-- {
+		if _, found := remove[m.Obj.OutboundSecurityRules[i].String()]; found {
+			m.Obj.OutboundSecurityRules = append(m.Obj.OutboundSecurityRules[:i], m.Obj.OutboundSecurityRules[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *TrustPolicyHistory) CopyInFields(src *TrustPolicyHistory) int {
+	updateListAction := "replace"
+	changed := 0
+	if m.Revision != src.Revision {
+		m.Revision = src.Revision
+		changed++
+	}
+	if m.Obj.Key.Organization != src.Obj.Key.Organization {
+		m.Obj.Key.Organization = src.Obj.Key.Organization
+		changed++
+	}
+	if m.Obj.Key.Name != src.Obj.Key.Name {
+		m.Obj.Key.Name = src.Obj.Key.Name
+		changed++
+	}
+	if src.Obj.OutboundSecurityRules != nil {
+		if updateListAction == "add" {
+			changed += m.AddObjOutboundSecurityRules(src.Obj.OutboundSecurityRules...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveObjOutboundSecurityRules(src.Obj.OutboundSecurityRules...)
+		} else {
+			m.Obj.OutboundSecurityRules = make([]SecurityRule, 0)
+			for k1, _ := range src.Obj.OutboundSecurityRules {
+				m.Obj.OutboundSecurityRules = append(m.Obj.OutboundSecurityRules, *src.Obj.OutboundSecurityRules[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Obj.OutboundSecurityRules != nil {
+		m.Obj.OutboundSecurityRules = nil
+		changed++
+	}
+	if m.Obj.DeletePrepare != src.Obj.DeletePrepare {
+		m.Obj.DeletePrepare = src.Obj.DeletePrepare
+		changed++
+	}
+	return changed
+}
+
+func (m *TrustPolicyHistory) DeepCopyIn(src *TrustPolicyHistory) {
+	m.Revision = src.Revision
+	m.Obj.DeepCopyIn(&src.Obj)
+}
+
+// Helper method to check that enums have valid values
+func (m *TrustPolicyHistory) ValidateEnums() error {
+	if err := m.Obj.ValidateEnums(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *TrustPolicyHistory) ClearTagged(tags map[string]struct{}) {
+	s.Obj.ClearTagged(tags)
+}
+
 func (m *TrustPolicy) IsValidArgsForCreateTrustPolicy() error {
 	if m.DeletePrepare != false {
 		return fmt.Errorf("Invalid field specified: DeletePrepare, this field is only for internal use")
@@ -1645,6 +1887,20 @@ func (m *TrustPolicy) Size() (n int) {
 	return n
 }
 
+func (m *TrustPolicyHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		n += 1 + sovTrustpolicy(uint64(m.Revision))
+	}
+	l = m.Obj.Size()
+	n += 1 + l + sovTrustpolicy(uint64(l))
+	return n
+}
+
 func sovTrustpolicy(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1972,6 +2228,108 @@ func (m *TrustPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TrustPolicyHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrustpolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrustPolicyHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrustPolicyHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrustpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Obj", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrustpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrustpolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrustpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Obj.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrustpolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrustpolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTrustpolicy(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -133,6 +133,31 @@ func request_TrustPolicyApi_ShowTrustPolicy_0(ctx context.Context, marshaler run
 
 }
 
+func request_TrustPolicyApi_ShowTrustPolicyHistory_0(ctx context.Context, marshaler runtime.Marshaler, client TrustPolicyApiClient, req *http.Request, pathParams map[string]string) (TrustPolicyApi_ShowTrustPolicyHistoryClient, runtime.ServerMetadata, error) {
+	var protoReq TrustPolicy
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	stream, err := client.ShowTrustPolicyHistory(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+
+}
+
 // RegisterTrustPolicyApiHandlerServer registers the http handlers for service TrustPolicyApi to "mux".
 // UnaryRPC     :call TrustPolicyApiServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
@@ -167,6 +192,13 @@ func RegisterTrustPolicyApiHandlerServer(ctx context.Context, mux *runtime.Serve
 		return
 	})
 
+	mux.Handle("POST", pattern_TrustPolicyApi_ShowTrustPolicyHistory_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+
 	return nil
 }
 
@@ -288,6 +320,26 @@ func RegisterTrustPolicyApiHandlerClient(ctx context.Context, mux *runtime.Serve
 
 	})
 
+	mux.Handle("POST", pattern_TrustPolicyApi_ShowTrustPolicyHistory_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_TrustPolicyApi_ShowTrustPolicyHistory_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_TrustPolicyApi_ShowTrustPolicyHistory_0(ctx, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+
+	})
+
 	return nil
 }
 
@@ -299,6 +351,8 @@ var (
 	pattern_TrustPolicyApi_UpdateTrustPolicy_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"update", "trustpolicy"}, "", runtime.AssumeColonVerbOpt(true)))
 
 	pattern_TrustPolicyApi_ShowTrustPolicy_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"show", "trustpolicy"}, "", runtime.AssumeColonVerbOpt(true)))
+
+	pattern_TrustPolicyApi_ShowTrustPolicyHistory_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"show", "trustpolicyhistory"}, "", runtime.AssumeColonVerbOpt(true)))
 )
 
 var (
@@ -309,4 +363,6 @@ var (
 	forward_TrustPolicyApi_UpdateTrustPolicy_0 = runtime.ForwardResponseStream
 
 	forward_TrustPolicyApi_ShowTrustPolicy_0 = runtime.ForwardResponseStream
+
+	forward_TrustPolicyApi_ShowTrustPolicyHistory_0 = runtime.ForwardResponseStream
 )
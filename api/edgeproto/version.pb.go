@@ -42,6 +42,8 @@ const (
 	VersionHash_HASH_b25b4e18e9a1dadfd3006e23fabfbf95 VersionHash = 58
 	VersionHash_HASH_abec45b13db5cd29e3bcf63d3b80be29 VersionHash = 59
 	VersionHash_HASH_2d0b51b0cb6eaff42225cd1795e168e7 VersionHash = 60
+	VersionHash_HASH_bc1137f8b94a59cf27408cd1083d85c7 VersionHash = 61
+	VersionHash_HASH_969dbb43bfdb718b1c9b10532d763384 VersionHash = 62
 )
 
 var VersionHash_name = map[int32]string{
@@ -55,6 +57,8 @@ var VersionHash_name = map[int32]string{
 	58: "HASH_b25b4e18e9a1dadfd3006e23fabfbf95",
 	59: "HASH_abec45b13db5cd29e3bcf63d3b80be29",
 	60: "HASH_2d0b51b0cb6eaff42225cd1795e168e7",
+	61: "HASH_bc1137f8b94a59cf27408cd1083d85c7",
+	62: "HASH_969dbb43bfdb718b1c9b10532d763384",
 }
 
 var VersionHash_value = map[string]int32{
@@ -68,6 +72,8 @@ var VersionHash_value = map[string]int32{
 	"HASH_b25b4e18e9a1dadfd3006e23fabfbf95": 58,
 	"HASH_abec45b13db5cd29e3bcf63d3b80be29": 59,
 	"HASH_2d0b51b0cb6eaff42225cd1795e168e7": 60,
+	"HASH_bc1137f8b94a59cf27408cd1083d85c7": 61,
+	"HASH_969dbb43bfdb718b1c9b10532d763384": 62,
 }
 
 func (x VersionHash) String() string {
@@ -85,40 +91,43 @@ func init() {
 func init() { proto.RegisterFile("version.proto", fileDescriptor_7d2c07d79758f814) }
 
 var fileDescriptor_7d2c07d79758f814 = []byte{
-	// 528 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x92, 0xbf, 0x6b, 0x14, 0x41,
-	0x14, 0x80, 0xef, 0x40, 0xc4, 0x24, 0x06, 0xd7, 0x23, 0xc5, 0xb2, 0xc4, 0xc5, 0xc6, 0x42, 0x0b,
-	0xf3, 0xe6, 0xf7, 0x4c, 0x0c, 0x48, 0xfc, 0x11, 0x12, 0x02, 0x89, 0x18, 0xb4, 0xb0, 0x91, 0x99,
-	0x79, 0x6f, 0xd6, 0x88, 0xd9, 0x3d, 0xef, 0x56, 0xc1, 0xd6, 0xf2, 0x2a, 0xff, 0x2a, 0x49, 0x99,
-	0xd2, 0x52, 0x73, 0xff, 0x82, 0x0b, 0x96, 0x92, 0xbb, 0xcb, 0x75, 0xdf, 0x0c, 0xdf, 0x7c, 0xc5,
-	0x7b, 0xb3, 0xba, 0xfe, 0x95, 0x46, 0xe3, 0xd3, 0xa6, 0x7e, 0x3c, 0x1c, 0x35, 0x6d, 0x33, 0x58,
-	0x21, 0xac, 0x68, 0x86, 0xc5, 0xbd, 0xb6, 0x69, 0x3e, 0x8d, 0xb7, 0x66, 0x87, 0x8a, 0xea, 0x25,
-	0xcc, 0xcd, 0x62, 0xa3, 0x6a, 0xaa, 0x66, 0x86, 0x5b, 0x57, 0x34, 0xbf, 0x7d, 0xf4, 0xf3, 0xc6,
-	0xea, 0xda, 0xdb, 0x79, 0x71, 0xdf, 0x8f, 0x3f, 0x0c, 0x1e, 0xae, 0x3e, 0xd8, 0xdf, 0x3d, 0xd9,
-	0x7f, 0x8f, 0x92, 0xa1, 0x8d, 0xe8, 0x6c, 0x02, 0x08, 0x1c, 0x24, 0x39, 0x0b, 0xe0, 0x9c, 0xa5,
-	0x98, 0xac, 0xe4, 0x86, 0xb2, 0xde, 0x52, 0x8d, 0x1c, 0xad, 0xe5, 0x20, 0x44, 0x80, 0xc8, 0x64,
-	0xe2, 0x36, 0x52, 0x24, 0xc9, 0x62, 0x92, 0xc0, 0x00, 0x34, 0x64, 0x72, 0xf0, 0x74, 0xa1, 0x32,
-	0xe9, 0x49, 0x46, 0xc3, 0x59, 0x64, 0xc1, 0x47, 0xd2, 0x64, 0x85, 0x71, 0x08, 0xa0, 0x99, 0x37,
-	0xdc, 0x1b, 0x93, 0xa9, 0x62, 0x63, 0xd2, 0xe5, 0xd9, 0x9b, 0x61, 0x35, 0xf2, 0x48, 0xcf, 0x47,
-	0x67, 0xc7, 0xf5, 0x4b, 0xac, 0x68, 0xb0, 0xb3, 0x08, 0x50, 0x4a, 0x0e, 0x85, 0xd7, 0xd1, 0xc8,
-	0x84, 0xc0, 0xad, 0x04, 0x4a, 0x91, 0x40, 0x21, 0x73, 0x56, 0x92, 0xc5, 0x4c, 0x17, 0x77, 0x27,
-	0x5d, 0xbe, 0xbe, 0x8b, 0x78, 0xd2, 0xfa, 0xf6, 0x34, 0xee, 0x7d, 0xc6, 0x7a, 0x70, 0x78, 0xfd,
-	0xda, 0x47, 0xa5, 0x0d, 0x83, 0x08, 0x4c, 0xa0, 0x53, 0x12, 0x83, 0x60, 0x44, 0x41, 0x80, 0x0e,
-	0x8a, 0x49, 0x2f, 0x33, 0x53, 0xdc, 0x9f, 0x74, 0xf9, 0xe6, 0x41, 0x3d, 0x6e, 0x7d, 0x1d, 0xe9,
-	0x90, 0xbe, 0x8d, 0x5f, 0x53, 0x75, 0xda, 0xd4, 0x27, 0xb1, 0x19, 0x12, 0x1e, 0xf9, 0x33, 0x1a,
-	0x6c, 0x2f, 0x62, 0x46, 0x59, 0x2b, 0x90, 0x49, 0x00, 0xd0, 0x12, 0x02, 0xa7, 0x98, 0xb4, 0x93,
-	0x89, 0x2c, 0x25, 0xc7, 0x1c, 0x0f, 0x99, 0x2d, 0xee, 0x4c, 0xba, 0x7c, 0xed, 0x5d, 0x53, 0xd3,
-	0x1e, 0xf9, 0xf6, 0xcb, 0x88, 0x96, 0x73, 0x20, 0xad, 0xa2, 0x70, 0x14, 0xb9, 0x97, 0xd6, 0x59,
-	0x21, 0x11, 0x41, 0x93, 0x35, 0xc9, 0x70, 0xe1, 0x92, 0xf3, 0x36, 0x73, 0xf3, 0x39, 0x1c, 0x35,
-	0x48, 0xaf, 0xae, 0xb6, 0x7a, 0x1d, 0x30, 0x8b, 0x40, 0xe0, 0x2a, 0x48, 0x62, 0x96, 0x9c, 0x67,
-	0xe8, 0x31, 0xa1, 0x00, 0xd0, 0xc4, 0x45, 0xf2, 0x21, 0x85, 0xe4, 0x54, 0xb6, 0x5d, 0xdc, 0x9e,
-	0x74, 0xf9, 0xad, 0xdd, 0xe1, 0xf0, 0x38, 0x7c, 0x3c, 0x78, 0xb1, 0x5c, 0x96, 0x0f, 0x14, 0xa5,
-	0x0a, 0x4c, 0x60, 0x50, 0x11, 0xb9, 0x23, 0x11, 0x62, 0xd2, 0x02, 0x45, 0xb0, 0x10, 0x88, 0xbb,
-	0xec, 0xc9, 0x52, 0xe5, 0x08, 0x41, 0xb1, 0x00, 0x31, 0x68, 0xf2, 0x29, 0x49, 0xce, 0xb9, 0x8a,
-	0xc8, 0x8c, 0x53, 0xc4, 0xb4, 0x25, 0x93, 0xed, 0x14, 0x2b, 0xff, 0xfe, 0xe6, 0xfd, 0xef, 0x5d,
-	0xde, 0xe7, 0xcf, 0x36, 0xcf, 0xff, 0x94, 0xbd, 0xf3, 0xcb, 0xb2, 0x7f, 0x71, 0x59, 0xf6, 0x7f,
-	0x5f, 0x96, 0xfd, 0x1f, 0xd3, 0xb2, 0x77, 0x31, 0x2d, 0x7b, 0xbf, 0xa6, 0x65, 0x2f, 0xdc, 0x9c,
-	0xfd, 0x36, 0xf1, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x91, 0x8d, 0xbb, 0x5a, 0xbe, 0x02, 0x00, 0x00,
+	// 572 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x92, 0xbd, 0x6e, 0x13, 0x41,
+	0x10, 0x80, 0xed, 0x02, 0x44, 0x12, 0x22, 0x0e, 0x2b, 0x85, 0x75, 0x0a, 0x16, 0x0d, 0x05, 0x14,
+	0x64, 0xf6, 0x7f, 0x37, 0x04, 0x50, 0xf8, 0x89, 0x12, 0x45, 0x4a, 0x10, 0x11, 0x14, 0x34, 0x68,
+	0x67, 0x67, 0xd6, 0x04, 0x91, 0x3b, 0x63, 0x1b, 0x24, 0x5a, 0x4a, 0x57, 0x3c, 0x00, 0x0f, 0x94,
+	0x32, 0x25, 0x25, 0x24, 0xaf, 0x80, 0x25, 0x4a, 0x14, 0xdb, 0xb9, 0xee, 0xdb, 0xd5, 0xb7, 0x5f,
+	0x31, 0xb3, 0xcb, 0xab, 0x5f, 0x79, 0x38, 0x3a, 0xae, 0xab, 0x87, 0x83, 0x61, 0x3d, 0xae, 0x3b,
+	0x4b, 0x4c, 0x7d, 0x9e, 0x61, 0x79, 0x67, 0x5c, 0xd7, 0x9f, 0x46, 0x1b, 0xb3, 0x43, 0x9f, 0xab,
+	0x06, 0xe6, 0x66, 0xb9, 0xd6, 0xaf, 0xfb, 0xf5, 0x0c, 0x37, 0x2e, 0x69, 0x7e, 0xfb, 0xe0, 0xe7,
+	0xb5, 0xe5, 0x95, 0xb7, 0xf3, 0xe2, 0x6e, 0x1c, 0x7d, 0xe8, 0xdc, 0x5f, 0xbe, 0xb7, 0xbb, 0x7d,
+	0xb4, 0xfb, 0x9e, 0xb4, 0x20, 0x9f, 0x28, 0xf8, 0x0c, 0x80, 0x12, 0x34, 0x07, 0x0f, 0x10, 0x82,
+	0xe7, 0x94, 0xbd, 0x96, 0x8e, 0x8b, 0x56, 0xa3, 0x26, 0x49, 0xde, 0x4b, 0x50, 0x0a, 0x21, 0x09,
+	0x9d, 0xa5, 0x4f, 0x9c, 0x58, 0x8b, 0x94, 0x35, 0x08, 0x00, 0x0b, 0x85, 0xee, 0x3c, 0x5d, 0xa8,
+	0x42, 0x47, 0xd6, 0xc9, 0x49, 0x91, 0x04, 0xc6, 0xc4, 0x96, 0xbd, 0x72, 0x81, 0x00, 0xac, 0x88,
+	0x4e, 0x46, 0xe7, 0x0a, 0x53, 0xae, 0x4d, 0xa6, 0xdd, 0xe2, 0xcd, 0xa0, 0x3f, 0x8c, 0xc4, 0xcf,
+	0x87, 0x27, 0x87, 0xd5, 0x4b, 0xea, 0x73, 0x67, 0x6b, 0x11, 0xe0, 0x9c, 0x03, 0xa9, 0x68, 0x93,
+	0xd3, 0x99, 0x40, 0x7a, 0x0d, 0x9c, 0x13, 0x83, 0x21, 0x11, 0xbc, 0x66, 0x4f, 0x85, 0x2d, 0x6f,
+	0x4f, 0xa6, 0xdd, 0xd5, 0x6d, 0xa2, 0xa3, 0x71, 0x1c, 0x1f, 0xa7, 0x9d, 0xcf, 0x54, 0x75, 0xf6,
+	0xaf, 0x5e, 0xc7, 0x64, 0xac, 0x13, 0x90, 0x40, 0x28, 0x0a, 0x46, 0x13, 0x2a, 0xc1, 0x8c, 0x0a,
+	0x2c, 0x1a, 0xa1, 0xa3, 0x2e, 0x5c, 0x79, 0x77, 0x32, 0xed, 0xae, 0xef, 0x55, 0xa3, 0x71, 0xac,
+	0x12, 0xef, 0xf3, 0xb7, 0xd1, 0x6b, 0xee, 0x1f, 0xd7, 0xd5, 0x51, 0xaa, 0x07, 0x4c, 0x07, 0xf1,
+	0x84, 0x3b, 0x9b, 0x8b, 0x98, 0x33, 0xde, 0x2b, 0x12, 0x1a, 0x00, 0xac, 0x06, 0x94, 0x9c, 0xb2,
+	0x0d, 0x3a, 0xb3, 0xe7, 0x1c, 0x44, 0x90, 0x58, 0xf8, 0xf2, 0xd6, 0x64, 0xda, 0x5d, 0x79, 0x57,
+	0x57, 0xbc, 0xc3, 0x71, 0xfc, 0x65, 0xc8, 0xcd, 0x1c, 0xd8, 0x9a, 0xa4, 0x02, 0x27, 0x19, 0xb5,
+	0x0f, 0x5e, 0x69, 0x22, 0xb0, 0xec, 0x5d, 0x76, 0x52, 0x85, 0x1c, 0xa2, 0x2f, 0xc2, 0x7c, 0x0e,
+	0x07, 0x35, 0xf1, 0xab, 0xcb, 0xad, 0x5e, 0x05, 0xdc, 0x22, 0x80, 0xd2, 0xa0, 0x66, 0xe1, 0x39,
+	0x44, 0x41, 0x91, 0x32, 0x29, 0x00, 0xcb, 0x52, 0xe5, 0x88, 0x19, 0x73, 0x30, 0xc5, 0x66, 0x79,
+	0x73, 0x32, 0xed, 0xde, 0xd8, 0x1e, 0x0c, 0x0e, 0xf1, 0xe3, 0xde, 0x8b, 0x66, 0x59, 0x11, 0x39,
+	0x69, 0x83, 0x42, 0x11, 0x9a, 0x44, 0x32, 0xb0, 0xc2, 0x94, 0xad, 0x22, 0x85, 0x1e, 0x90, 0x65,
+	0x28, 0x1e, 0x35, 0xaa, 0x24, 0x40, 0x23, 0x10, 0x12, 0x5a, 0x8e, 0x39, 0x6b, 0x29, 0xa5, 0x49,
+	0x24, 0x5c, 0x30, 0x2c, 0xac, 0x67, 0x57, 0x6c, 0x35, 0x2a, 0x26, 0x21, 0x94, 0xcb, 0x1e, 0x83,
+	0x8e, 0x26, 0xa4, 0x2c, 0x9d, 0x06, 0x9f, 0x48, 0x80, 0x57, 0xe4, 0x4d, 0x72, 0xc5, 0xe3, 0x46,
+	0x0d, 0x36, 0x10, 0xa2, 0x56, 0x98, 0x09, 0x9d, 0xf0, 0x28, 0x52, 0x40, 0x01, 0x46, 0x49, 0x72,
+	0x56, 0x29, 0xaf, 0x8b, 0x27, 0xe5, 0xd2, 0xbf, 0xbf, 0xdd, 0xf6, 0xf7, 0x69, 0xb7, 0x2d, 0x9f,
+	0xad, 0x9f, 0xfe, 0xe9, 0xb5, 0x4e, 0xcf, 0x7b, 0xed, 0xb3, 0xf3, 0x5e, 0xfb, 0xf7, 0x79, 0xaf,
+	0xfd, 0xe3, 0xa2, 0xd7, 0x3a, 0xbb, 0xe8, 0xb5, 0x7e, 0x5d, 0xf4, 0x5a, 0x78, 0x7d, 0xf6, 0x87,
+	0xd5, 0xff, 0x01, 0x00, 0x1c, 0x08, 0x23, 0xb8, 0x14, 0x03, 0x00, 0x00,
 }
 var VersionHashStrings = []string{
 	"HASH_d41d8cd98f00b204e9800998ecf8427e",
@@ -131,6 +140,8 @@ var VersionHashStrings = []string{
 	"HASH_b25b4e18e9a1dadfd3006e23fabfbf95",
 	"HASH_abec45b13db5cd29e3bcf63d3b80be29",
 	"HASH_2d0b51b0cb6eaff42225cd1795e168e7",
+	"HASH_bc1137f8b94a59cf27408cd1083d85c7",
+	"HASH_969dbb43bfdb718b1c9b10532d763384",
 }
 
 const (
@@ -144,6 +155,8 @@ const (
 	VersionHashHASHB25B4E18E9A1Dadfd3006E23Fabfbf95  uint64 = 1 << 7
 	VersionHashHASHAbec45B13Db5Cd29E3Bcf63D3B80Be29  uint64 = 1 << 8
 	VersionHashHASH_2D0B51B0Cb6Eaff42225Cd1795E168E7 uint64 = 1 << 9
+	VersionHashHASHBc1137F8B94A59Cf27408Cd1083D85C7  uint64 = 1 << 10
+	VersionHashHASH_969Dbb43Bfdb718B1C9B10532D763384 uint64 = 1 << 11
 )
 
 var VersionHash_CamelName = map[int32]string{
@@ -167,6 +180,10 @@ var VersionHash_CamelName = map[int32]string{
 	59: "HashAbec45B13Db5Cd29E3Bcf63D3B80Be29",
 	// HASH_2d0b51b0cb6eaff42225cd1795e168e7 -> Hash2D0B51B0Cb6Eaff42225Cd1795E168E7
 	60: "Hash2D0B51B0Cb6Eaff42225Cd1795E168E7",
+	// HASH_bc1137f8b94a59cf27408cd1083d85c7 -> HashBc1137F8B94A59Cf27408Cd1083D85C7
+	61: "HashBc1137F8B94A59Cf27408Cd1083D85C7",
+	// HASH_969dbb43bfdb718b1c9b10532d763384 -> Hash969Dbb43Bfdb718B1C9B10532D763384
+	62: "Hash969Dbb43Bfdb718B1C9B10532D763384",
 }
 var VersionHash_CamelValue = map[string]int32{
 	"HashD41D8Cd98F00B204E9800998Ecf8427E": 0,
@@ -179,6 +196,8 @@ var VersionHash_CamelValue = map[string]int32{
 	"HashB25B4E18E9A1Dadfd3006E23Fabfbf95": 58,
 	"HashAbec45B13Db5Cd29E3Bcf63D3B80Be29": 59,
 	"Hash2D0B51B0Cb6Eaff42225Cd1795E168E7": 60,
+	"HashBc1137F8B94A59Cf27408Cd1083D85C7": 61,
+	"Hash969Dbb43Bfdb718B1C9B10532D763384": 62,
 }
 
 func ParseVersionHash(data interface{}) (VersionHash, error) {
@@ -272,6 +291,8 @@ var VersionHashCommonPrefix = "Hash"
 
 // Keys being hashed:
 // AlertPolicyKey
+// AlertReceiverKey
+// AlertSilenceKey
 // AppInstKey
 // AppInstKeyV1
 // AppInstKeyV2
@@ -309,7 +330,7 @@ type DataModelVersion struct {
 
 func GetDataModelVersion() *DataModelVersion {
 	return &DataModelVersion{
-		Hash: "2d0b51b0cb6eaff42225cd1795e168e7",
-		ID:   60,
+		Hash: "969dbb43bfdb718b1c9b10532d763384",
+		ID:   62,
 	}
 }
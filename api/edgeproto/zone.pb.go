@@ -136,65 +136,112 @@ func (m *ZoneGPUs) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ZoneGPUs proto.InternalMessageInfo
 
+// ZoneHistory is a Zone as of a past revision
+type ZoneHistory struct {
+	// Database revision of the change
+	Revision int64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Zone as of the revision
+	Obj Zone `protobuf:"bytes,2,opt,name=obj,proto3" json:"obj"`
+}
+
+func (m *ZoneHistory) Reset()         { *m = ZoneHistory{} }
+func (m *ZoneHistory) String() string { return proto.CompactTextString(m) }
+func (*ZoneHistory) ProtoMessage()    {}
+func (*ZoneHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b71619a26b0a679a, []int{2}
+}
+func (m *ZoneHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ZoneHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ZoneHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ZoneHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZoneHistory.Merge(m, src)
+}
+func (m *ZoneHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ZoneHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZoneHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZoneHistory proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Zone)(nil), "edgeproto.Zone")
 	proto.RegisterType((*ZoneGPUs)(nil), "edgeproto.ZoneGPUs")
+	proto.RegisterType((*ZoneHistory)(nil), "edgeproto.ZoneHistory")
 }
 
 func init() { proto.RegisterFile("zone.proto", fileDescriptor_b71619a26b0a679a) }
 
 var fileDescriptor_b71619a26b0a679a = []byte{
-	// 799 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xcf, 0x6b, 0x2b, 0x55,
-	0x14, 0xc7, 0x73, 0x9b, 0xa4, 0x2f, 0xb9, 0x4d, 0xda, 0xd7, 0x79, 0xbe, 0xc7, 0x7d, 0xe5, 0xbd,
-	0x18, 0x46, 0x17, 0xa1, 0x6f, 0xc8, 0x48, 0x1f, 0xb8, 0x28, 0x3c, 0x34, 0xa9, 0xb4, 0x94, 0x56,
-	0x2d, 0xa3, 0x11, 0x5a, 0x85, 0x30, 0x99, 0x39, 0x99, 0x4e, 0x33, 0xb9, 0x77, 0x98, 0x99, 0x58,
-	0xd3, 0x95, 0xb8, 0x76, 0x51, 0x74, 0x23, 0xfe, 0x05, 0x22, 0x08, 0xd2, 0xbf, 0xa2, 0xcb, 0x82,
-	0x1b, 0x71, 0x21, 0xda, 0xba, 0x28, 0x5d, 0x09, 0x4d, 0xba, 0x96, 0x7b, 0xe7, 0x4e, 0x1c, 0x62,
-	0xc5, 0x8a, 0xb8, 0x9b, 0xf3, 0xf3, 0x73, 0xce, 0x99, 0xef, 0xc5, 0xf8, 0x88, 0x51, 0xa8, 0xfb,
-	0x01, 0x8b, 0x98, 0x52, 0x04, 0xdb, 0x01, 0xf1, 0xb9, 0xf4, 0xc4, 0x61, 0xcc, 0xf1, 0x40, 0x37,
-	0x7d, 0x57, 0x37, 0x29, 0x65, 0x91, 0x19, 0xb9, 0x8c, 0x86, 0x71, 0xe2, 0xd2, 0xd3, 0x88, 0x31,
-	0x2f, 0xd4, 0x85, 0xe1, 0x00, 0x9d, 0x7c, 0xc8, 0xf0, 0xa2, 0xe5, 0xb1, 0x81, 0xed, 0x41, 0xd4,
-	0x83, 0xa1, 0x74, 0x95, 0x02, 0x08, 0x07, 0x5e, 0x24, 0xad, 0xf9, 0x24, 0x41, 0xda, 0x2f, 0x39,
-	0xcc, 0x61, 0xe2, 0x53, 0xe7, 0x5f, 0xd2, 0x5b, 0xb6, 0xfb, 0xa0, 0x7b, 0xcc, 0x92, 0xe6, 0x42,
-	0x00, 0x21, 0x1b, 0x04, 0x16, 0xc8, 0x29, 0xd4, 0xef, 0xf2, 0x38, 0xb7, 0xc7, 0x28, 0x28, 0x8f,
-	0xf0, 0x6c, 0xd7, 0x05, 0xcf, 0x0e, 0x09, 0xaa, 0x66, 0x6b, 0x45, 0x43, 0x5a, 0xca, 0x32, 0xce,
-	0xf6, 0x60, 0x48, 0x66, 0xaa, 0xa8, 0x36, 0xb7, 0xa2, 0xd4, 0x27, 0xdb, 0xd5, 0x79, 0xd5, 0x16,
-	0x0c, 0x9b, 0xb9, 0xd3, 0x9f, 0x5f, 0xce, 0x18, 0x3c, 0x49, 0xa9, 0xe2, 0x39, 0x1b, 0x42, 0x2b,
-	0x70, 0x7d, 0xbe, 0x28, 0xc9, 0x56, 0x51, 0xad, 0x68, 0xa4, 0x5d, 0xca, 0x9b, 0xb8, 0xec, 0xd2,
-	0x6e, 0x60, 0xb6, 0xbb, 0x9e, 0xf9, 0x31, 0x0b, 0x42, 0x92, 0xab, 0x66, 0x6b, 0x73, 0x2b, 0x0f,
-	0x53, 0x7d, 0xd7, 0x45, 0x64, 0x93, 0x76, 0x59, 0x33, 0xf7, 0xcd, 0x88, 0x20, 0xa3, 0x24, 0x2a,
-	0x62, 0x77, 0xa8, 0xbc, 0x81, 0x0b, 0x1e, 0xb3, 0xc4, 0x25, 0x49, 0x5e, 0x0c, 0xf5, 0xb4, 0x6e,
-	0xbb, 0x61, 0x14, 0xb8, 0x9d, 0x41, 0x04, 0x76, 0xbb, 0x6f, 0x46, 0xd6, 0x7e, 0x1b, 0xa8, 0xe3,
-	0x52, 0xa8, 0x6f, 0x33, 0x4b, 0xce, 0x37, 0x29, 0x52, 0x5e, 0xc5, 0xb3, 0xac, 0x73, 0xd0, 0x76,
-	0x6d, 0xb2, 0xcb, 0xe7, 0x6b, 0x96, 0x39, 0xe4, 0x8b, 0x93, 0xc7, 0x79, 0xca, 0xac, 0xbe, 0x6f,
-	0xe4, 0x59, 0xe7, 0x60, 0xd3, 0x56, 0x9e, 0xe1, 0x79, 0x1b, 0x3c, 0x88, 0xa0, 0xed, 0x07, 0xe0,
-	0x9b, 0x01, 0x90, 0xbd, 0x2a, 0xaa, 0x15, 0xe4, 0x48, 0xe5, 0x38, 0xb6, 0x13, 0x87, 0x94, 0x5d,
-	0x8c, 0xad, 0x00, 0x4c, 0x8e, 0x37, 0x23, 0xf2, 0xa1, 0x98, 0xea, 0x95, 0xbf, 0x9f, 0xea, 0x7d,
-	0xb7, 0x0f, 0x61, 0x64, 0xf6, 0xfd, 0xe6, 0x43, 0xc9, 0x2e, 0x46, 0x89, 0x4b, 0x0c, 0x5b, 0x94,
-	0xdd, 0x1a, 0x11, 0x6f, 0x3d, 0xf0, 0xed, 0xa4, 0xf5, 0x47, 0xff, 0xbd, 0xb5, 0xec, 0xd6, 0x88,
-	0x56, 0x4f, 0xd0, 0xe5, 0x35, 0x41, 0xbf, 0x5f, 0x13, 0xf4, 0xe9, 0x88, 0xa0, 0xe3, 0x11, 0x41,
-	0x5f, 0xf1, 0x92, 0x31, 0x69, 0xad, 0x25, 0x78, 0xad, 0x95, 0x64, 0x6b, 0x6f, 0xa5, 0x37, 0xd6,
-	0xb6, 0x60, 0x58, 0x5f, 0x07, 0x1b, 0x02, 0x1e, 0x7d, 0x37, 0x70, 0x4c, 0xea, 0x1e, 0x89, 0xf3,
-	0x6a, 0xdb, 0xf2, 0xce, 0x9a, 0x0c, 0xbb, 0x8c, 0xae, 0x31, 0xda, 0x75, 0x9d, 0xaf, 0xc7, 0x44,
-	0xa5, 0x66, 0x1f, 0x5e, 0xf0, 0xda, 0x77, 0xcc, 0x3e, 0x68, 0x2c, 0x70, 0x84, 0x91, 0xae, 0xff,
-	0x7e, 0x4c, 0x32, 0x3f, 0x8d, 0x49, 0x76, 0x0b, 0x86, 0x27, 0x37, 0xe4, 0x7e, 0x0f, 0x86, 0x2f,
-	0xd2, 0x61, 0xb5, 0x87, 0x0b, 0x5c, 0x78, 0x1b, 0x3b, 0xad, 0x50, 0x79, 0x8e, 0x0b, 0xfc, 0xe1,
-	0xb5, 0xb9, 0x3e, 0xd1, 0x3f, 0xe8, 0xf3, 0xde, 0x51, 0x6c, 0x2a, 0xcb, 0x38, 0xe7, 0xf8, 0x83,
-	0x90, 0xcc, 0x08, 0xe1, 0x3d, 0x4a, 0x15, 0x6c, 0xec, 0xb4, 0x0c, 0xf9, 0x3a, 0x0c, 0x91, 0xb3,
-	0x72, 0x99, 0xc3, 0xf7, 0x78, 0x9b, 0x86, 0xef, 0x2a, 0x9f, 0x23, 0x8c, 0xe3, 0xbb, 0x88, 0xe7,
-	0xb2, 0x30, 0x45, 0x5a, 0x5a, 0x4c, 0x39, 0x0c, 0xf1, 0x4e, 0xd5, 0xbd, 0xab, 0x11, 0x79, 0x96,
-	0xb4, 0xe4, 0x49, 0xa1, 0xd6, 0xb0, 0xf8, 0x16, 0x6f, 0x9b, 0xd4, 0x74, 0xe2, 0x23, 0xa6, 0x97,
-	0xfb, 0xf6, 0x86, 0xdc, 0x9f, 0xf6, 0x7d, 0xf6, 0xc3, 0x6f, 0x5f, 0xce, 0x2c, 0xaa, 0x25, 0x3d,
-	0x96, 0x84, 0xce, 0xd7, 0x58, 0x45, 0xcb, 0xca, 0x27, 0x18, 0xc7, 0x7f, 0xe4, 0xce, 0xd3, 0xac,
-	0xff, 0xcb, 0x69, 0x26, 0xe4, 0x58, 0xeb, 0x69, 0x72, 0xac, 0x8a, 0xff, 0x9f, 0x1c, 0x6b, 0x75,
-	0x42, 0xee, 0xe2, 0xc2, 0x7b, 0xfb, 0xec, 0xf0, 0x76, 0xee, 0xb4, 0x43, 0x7d, 0xfd, 0x6a, 0x44,
-	0x1e, 0xdf, 0x46, 0xfd, 0xc0, 0x85, 0x43, 0xed, 0x6c, 0x4c, 0x90, 0xe0, 0x2c, 0xa8, 0x58, 0x0f,
-	0xf7, 0xd9, 0x61, 0x42, 0x79, 0x0d, 0x29, 0x01, 0x2e, 0x25, 0x1c, 0xa1, 0xb3, 0xbf, 0xb0, 0x1e,
-	0x4c, 0x39, 0x78, 0x96, 0xba, 0x7a, 0x37, 0xde, 0x03, 0x75, 0xfe, 0x4f, 0x1e, 0x57, 0x99, 0x60,
-	0x36, 0x9f, 0x9c, 0xfe, 0x5a, 0xc9, 0x9c, 0x9e, 0x57, 0xd0, 0xd9, 0x79, 0x05, 0xfd, 0x72, 0x5e,
-	0x41, 0xc7, 0x17, 0x95, 0xcc, 0xd9, 0x45, 0x25, 0xf3, 0xe3, 0x45, 0x25, 0xd3, 0x99, 0x15, 0xac,
-	0xe7, 0x7f, 0x04, 0x00, 0x00, 0xff, 0xff, 0xd5, 0x95, 0xae, 0xc5, 0x69, 0x06, 0x00, 0x00,
+	// 879 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xc4, 0x76, 0x6a, 0x4f, 0x7e, 0x35, 0x53, 0x1a, 0x4d, 0xa3, 0xd6, 0x58, 0x0b, 0x12,
+	0x26, 0xb5, 0xbc, 0x28, 0x95, 0x38, 0x44, 0xaa, 0x20, 0x2e, 0x4a, 0xa9, 0xd2, 0x42, 0xb4, 0x10,
+	0xa4, 0x06, 0x24, 0x6b, 0xbd, 0xfb, 0xbc, 0x99, 0x64, 0xbd, 0xb3, 0x9a, 0x5d, 0x37, 0x38, 0x27,
+	0xc4, 0x15, 0x0e, 0x15, 0x5c, 0x10, 0x7f, 0x01, 0x42, 0x42, 0x42, 0xf9, 0x2b, 0x7c, 0x8c, 0xc4,
+	0x05, 0x71, 0x40, 0x90, 0x70, 0x40, 0x39, 0x21, 0xd5, 0xce, 0x19, 0xcd, 0xec, 0xac, 0xbb, 0x72,
+	0x5b, 0x11, 0x84, 0xb8, 0xcd, 0xfb, 0xde, 0x8f, 0xef, 0x7b, 0x6f, 0xde, 0xc3, 0xf8, 0x90, 0x07,
+	0xd0, 0x08, 0x05, 0x8f, 0x39, 0x29, 0x83, 0xeb, 0x81, 0x7a, 0x2e, 0x5f, 0xf7, 0x38, 0xf7, 0x7c,
+	0x30, 0xed, 0x90, 0x99, 0x76, 0x10, 0xf0, 0xd8, 0x8e, 0x19, 0x0f, 0xa2, 0x24, 0x70, 0xf9, 0x46,
+	0xcc, 0xb9, 0x1f, 0x99, 0xca, 0xf0, 0x20, 0x18, 0x3f, 0xb4, 0x7b, 0xd1, 0xf1, 0x79, 0xcf, 0xf5,
+	0x21, 0xde, 0x87, 0xbe, 0x86, 0x66, 0x05, 0x44, 0x3d, 0x3f, 0xd6, 0xd6, 0x7c, 0x1a, 0xa0, 0xed,
+	0x97, 0x3c, 0xee, 0x71, 0xf5, 0x34, 0xe5, 0x4b, 0xa3, 0x73, 0x6e, 0x17, 0x4c, 0x9f, 0x3b, 0xda,
+	0x5c, 0x10, 0x10, 0xf1, 0x9e, 0x70, 0x40, 0xab, 0x30, 0x7e, 0x28, 0xe2, 0xc2, 0x0e, 0x0f, 0x80,
+	0x2c, 0xe1, 0xe9, 0x0e, 0x03, 0xdf, 0x8d, 0x28, 0xaa, 0xe6, 0x6b, 0x65, 0x4b, 0x5b, 0x64, 0x05,
+	0xe7, 0xf7, 0xa1, 0x4f, 0xa7, 0xaa, 0xa8, 0x36, 0xb3, 0x4a, 0x1a, 0xe3, 0xee, 0x1a, 0x32, 0x6b,
+	0x13, 0xfa, 0xcd, 0xc2, 0xe0, 0xd7, 0x97, 0x73, 0x96, 0x0c, 0x22, 0x55, 0x3c, 0xe3, 0x42, 0xe4,
+	0x08, 0x16, 0xca, 0x46, 0x69, 0xbe, 0x8a, 0x6a, 0x65, 0x2b, 0x0b, 0x91, 0xb7, 0xf1, 0x1c, 0x0b,
+	0x3a, 0xc2, 0x6e, 0x75, 0x7c, 0xfb, 0x11, 0x17, 0x11, 0x2d, 0x54, 0xf3, 0xb5, 0x99, 0xd5, 0xab,
+	0x99, 0xba, 0x1b, 0xca, 0x73, 0x2f, 0xe8, 0xf0, 0x66, 0xe1, 0xbb, 0x21, 0x45, 0xd6, 0xac, 0xca,
+	0x48, 0xe0, 0x88, 0xbc, 0x85, 0x4b, 0x3e, 0x77, 0xd4, 0x24, 0x69, 0x51, 0x89, 0xba, 0xd1, 0x70,
+	0x59, 0x14, 0x0b, 0xd6, 0xee, 0xc5, 0xe0, 0xb6, 0xba, 0x76, 0xec, 0xec, 0xb6, 0x20, 0xf0, 0x58,
+	0x00, 0x8d, 0xfb, 0xdc, 0xd1, 0xfa, 0xc6, 0x49, 0xe4, 0x55, 0x3c, 0xcd, 0xdb, 0x7b, 0x2d, 0xe6,
+	0xd2, 0x87, 0x52, 0x5f, 0x73, 0x4e, 0x92, 0x7c, 0x75, 0x74, 0xad, 0x18, 0x70, 0xa7, 0x1b, 0x5a,
+	0x45, 0xde, 0xde, 0xbb, 0xe7, 0x92, 0x9b, 0x78, 0xde, 0x05, 0x1f, 0x62, 0x68, 0x85, 0x02, 0x42,
+	0x5b, 0x00, 0xdd, 0xa9, 0xa2, 0x5a, 0x49, 0x4b, 0x9a, 0x4b, 0x7c, 0x5b, 0x89, 0x8b, 0x3c, 0xc4,
+	0xd8, 0x11, 0x60, 0x4b, 0x7a, 0x3b, 0xa6, 0x1f, 0x2b, 0x55, 0xaf, 0xbc, 0x58, 0xd5, 0x87, 0xac,
+	0x0b, 0x51, 0x6c, 0x77, 0xc3, 0xe6, 0x55, 0xcd, 0x5d, 0x8e, 0x53, 0x48, 0x89, 0x2d, 0xeb, 0x6a,
+	0xeb, 0xb1, 0x2c, 0xdd, 0x0b, 0xdd, 0xb4, 0xf4, 0x27, 0xff, 0xbd, 0xb4, 0xae, 0xb6, 0x1e, 0xaf,
+	0x1d, 0xa1, 0x3f, 0x9f, 0x50, 0xf4, 0xd7, 0x13, 0x8a, 0x3e, 0x1b, 0x52, 0xf4, 0x78, 0x48, 0xd1,
+	0x37, 0x32, 0x65, 0x44, 0xb7, 0xef, 0xa4, 0xf4, 0xf5, 0xed, 0x34, 0xba, 0xfe, 0x4e, 0xb6, 0xe3,
+	0xfa, 0x26, 0xf4, 0x1b, 0x1b, 0xe0, 0x82, 0x90, 0xde, 0xf7, 0x85, 0x67, 0x07, 0xec, 0x50, 0x8d,
+	0xb7, 0x7e, 0x5f, 0xcf, 0xb9, 0xae, 0xdd, 0x8c, 0x07, 0x77, 0x78, 0xd0, 0x61, 0xde, 0xb7, 0x23,
+	0x6a, 0x04, 0x76, 0x17, 0x6e, 0xcb, 0xdc, 0xf7, 0xec, 0x2e, 0xd4, 0xb9, 0xf0, 0x94, 0x91, 0xcd,
+	0xff, 0x71, 0x44, 0x73, 0xbf, 0x8c, 0x68, 0x7e, 0x13, 0xfa, 0x47, 0xe7, 0xf4, 0xf2, 0x3e, 0xf4,
+	0x6f, 0x67, 0xdd, 0xc6, 0x3e, 0x2e, 0xc9, 0xc5, 0xbb, 0xbb, 0xb5, 0x1d, 0x91, 0x5b, 0xb8, 0x24,
+	0x0f, 0xaf, 0x25, 0xf7, 0x13, 0xfd, 0xc3, 0x7e, 0x5e, 0x3a, 0x4c, 0x4c, 0xb2, 0x82, 0x0b, 0x5e,
+	0xd8, 0x8b, 0xe8, 0x94, 0x5a, 0xbc, 0xa5, 0x4c, 0xc2, 0xdd, 0xad, 0x6d, 0x4b, 0x5f, 0x87, 0xa5,
+	0x62, 0x0c, 0x0b, 0xcf, 0xc8, 0x2a, 0xef, 0xb2, 0x28, 0xe6, 0xa2, 0x4f, 0x96, 0x71, 0x49, 0xc0,
+	0x23, 0x16, 0xc9, 0xd5, 0x93, 0x7c, 0x79, 0x6b, 0x6c, 0x93, 0xd7, 0x70, 0x9e, 0xb7, 0xf7, 0xf4,
+	0x99, 0x2c, 0x4c, 0xc8, 0x48, 0x6f, 0x84, 0xb7, 0xf7, 0x56, 0xcf, 0x8b, 0xf8, 0x92, 0xc4, 0xd6,
+	0x43, 0x46, 0xbe, 0x44, 0x18, 0x27, 0xb3, 0x56, 0x27, 0x38, 0x99, 0xb6, 0xbc, 0x98, 0x01, 0x2c,
+	0x75, 0xfb, 0xc6, 0xce, 0xd9, 0x90, 0xde, 0x4c, 0x65, 0xca, 0xa0, 0xa8, 0xbe, 0xee, 0xc8, 0xc9,
+	0x3c, 0xb0, 0x03, 0xdb, 0x4b, 0x3e, 0x26, 0x3b, 0xb0, 0xef, 0xcf, 0xe9, 0xe5, 0x49, 0xec, 0xf3,
+	0x9f, 0xfe, 0xf8, 0x7a, 0x6a, 0xd1, 0x98, 0x35, 0x93, 0x35, 0x33, 0xe5, 0x68, 0xd6, 0xd0, 0x0a,
+	0xf9, 0x14, 0xe3, 0xe4, 0x97, 0x2f, 0xac, 0x66, 0xe3, 0x5f, 0xaa, 0x19, 0x33, 0x27, 0xf7, 0x93,
+	0x65, 0x4e, 0x36, 0xed, 0xff, 0x67, 0x4e, 0xf6, 0x7f, 0xcc, 0xdc, 0xc1, 0xa5, 0x0f, 0x76, 0xf9,
+	0xc1, 0xf3, 0x79, 0x27, 0x01, 0xe3, 0xcd, 0xb3, 0x21, 0xbd, 0xf6, 0x3c, 0xd6, 0x8f, 0x18, 0x1c,
+	0xd4, 0x8f, 0x47, 0x14, 0x29, 0x9e, 0x05, 0x03, 0x9b, 0xd1, 0x2e, 0x3f, 0x48, 0x59, 0xde, 0x40,
+	0xe4, 0x0b, 0x84, 0x17, 0x52, 0xa2, 0x74, 0x9f, 0x9e, 0xe1, 0x5b, 0x9a, 0x00, 0x74, 0xa0, 0xf1,
+	0xe0, 0x6c, 0x48, 0x5f, 0x7f, 0x21, 0xed, 0x64, 0xab, 0x83, 0x54, 0xc6, 0x92, 0xb1, 0xf8, 0x54,
+	0xc6, 0x6e, 0x52, 0x2b, 0x51, 0x23, 0xf0, 0x6c, 0x2a, 0x46, 0x5d, 0xd2, 0x33, 0x4a, 0xae, 0x4c,
+	0x00, 0x32, 0xca, 0x58, 0xbb, 0x58, 0xf7, 0x57, 0x8c, 0xf9, 0xa7, 0xb4, 0xf2, 0x8e, 0x14, 0x67,
+	0xf3, 0xfa, 0xe0, 0xf7, 0x4a, 0x6e, 0x70, 0x52, 0x41, 0xc7, 0x27, 0x15, 0xf4, 0xdb, 0x49, 0x05,
+	0x3d, 0x3e, 0xad, 0xe4, 0x8e, 0x4f, 0x2b, 0xb9, 0x9f, 0x4f, 0x2b, 0xb9, 0xf6, 0xb4, 0xe2, 0xba,
+	0xf5, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x89, 0xd3, 0xf6, 0x2c, 0x4b, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -217,6 +264,8 @@ type ZoneApiClient interface {
 	UpdateZone(ctx context.Context, in *Zone, opts ...grpc.CallOption) (*Result, error)
 	// Show Zones
 	ShowZone(ctx context.Context, in *Zone, opts ...grpc.CallOption) (ZoneApi_ShowZoneClient, error)
+	// Show the past revisions of a Zone, newest first
+	ShowZoneHistory(ctx context.Context, in *Zone, opts ...grpc.CallOption) (ZoneApi_ShowZoneHistoryClient, error)
 	// Show zone GPU information
 	ShowZoneGPUs(ctx context.Context, in *Zone, opts ...grpc.CallOption) (ZoneApi_ShowZoneGPUsClient, error)
 }
@@ -288,8 +337,40 @@ func (x *zoneApiShowZoneClient) Recv() (*Zone, error) {
 	return m, nil
 }
 
+func (c *zoneApiClient) ShowZoneHistory(ctx context.Context, in *Zone, opts ...grpc.CallOption) (ZoneApi_ShowZoneHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ZoneApi_serviceDesc.Streams[1], "/edgeproto.ZoneApi/ShowZoneHistory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &zoneApiShowZoneHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ZoneApi_ShowZoneHistoryClient interface {
+	Recv() (*ZoneHistory, error)
+	grpc.ClientStream
+}
+
+type zoneApiShowZoneHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *zoneApiShowZoneHistoryClient) Recv() (*ZoneHistory, error) {
+	m := new(ZoneHistory)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *zoneApiClient) ShowZoneGPUs(ctx context.Context, in *Zone, opts ...grpc.CallOption) (ZoneApi_ShowZoneGPUsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ZoneApi_serviceDesc.Streams[1], "/edgeproto.ZoneApi/ShowZoneGPUs", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ZoneApi_serviceDesc.Streams[2], "/edgeproto.ZoneApi/ShowZoneGPUs", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -330,6 +411,8 @@ type ZoneApiServer interface {
 	UpdateZone(context.Context, *Zone) (*Result, error)
 	// Show Zones
 	ShowZone(*Zone, ZoneApi_ShowZoneServer) error
+	// Show the past revisions of a Zone, newest first
+	ShowZoneHistory(*Zone, ZoneApi_ShowZoneHistoryServer) error
 	// Show zone GPU information
 	ShowZoneGPUs(*Zone, ZoneApi_ShowZoneGPUsServer) error
 }
@@ -350,6 +433,9 @@ func (*UnimplementedZoneApiServer) UpdateZone(ctx context.Context, req *Zone) (*
 func (*UnimplementedZoneApiServer) ShowZone(req *Zone, srv ZoneApi_ShowZoneServer) error {
 	return status.Errorf(codes.Unimplemented, "method ShowZone not implemented")
 }
+func (*UnimplementedZoneApiServer) ShowZoneHistory(req *Zone, srv ZoneApi_ShowZoneHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method ShowZoneHistory not implemented")
+}
 func (*UnimplementedZoneApiServer) ShowZoneGPUs(req *Zone, srv ZoneApi_ShowZoneGPUsServer) error {
 	return status.Errorf(codes.Unimplemented, "method ShowZoneGPUs not implemented")
 }
@@ -433,6 +519,27 @@ func (x *zoneApiShowZoneServer) Send(m *Zone) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ZoneApi_ShowZoneHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Zone)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ZoneApiServer).ShowZoneHistory(m, &zoneApiShowZoneHistoryServer{stream})
+}
+
+type ZoneApi_ShowZoneHistoryServer interface {
+	Send(*ZoneHistory) error
+	grpc.ServerStream
+}
+
+type zoneApiShowZoneHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *zoneApiShowZoneHistoryServer) Send(m *ZoneHistory) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ZoneApi_ShowZoneGPUs_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(Zone)
 	if err := stream.RecvMsg(m); err != nil {
@@ -477,6 +584,11 @@ var _ZoneApi_serviceDesc = grpc.ServiceDesc{
 			Handler:       _ZoneApi_ShowZone_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ShowZoneHistory",
+			Handler:       _ZoneApi_ShowZoneHistory_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ShowZoneGPUs",
 			Handler:       _ZoneApi_ShowZoneGPUs_Handler,
@@ -651,6 +763,44 @@ func (m *ZoneGPUs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ZoneHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ZoneHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZoneHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Obj.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintZone(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Revision != 0 {
+		i = encodeVarintZone(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintZone(dAtA []byte, offset int, v uint64) int {
 	offset -= sovZone(v)
 	base := offset
@@ -2104,6 +2254,188 @@ func (s *ZoneGPUs) ClearTagged(tags map[string]struct{}) {
 	}
 }
 
+func (m *ZoneHistory) Clone() *ZoneHistory {
+	cp := &ZoneHistory{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *ZoneHistory) AddObjInfraFlavors(vals ...*FlavorInfo) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Obj.InfraFlavors {
+		cur[v.String()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.String()]; found {
+			continue // duplicate
+		}
+		m.Obj.InfraFlavors = append(m.Obj.InfraFlavors, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *ZoneHistory) RemoveObjInfraFlavors(vals ...*FlavorInfo) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.String()] = struct{}{}
+	}
+	for i := len(m.Obj.InfraFlavors); i >= 0; i-- {
+		if _, found := remove[m.Obj.InfraFlavors[i].String()]; found {
+			m.Obj.InfraFlavors = append(m.Obj.InfraFlavors[:i], m.Obj.InfraFlavors[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *ZoneHistory) CopyInFields(src *ZoneHistory) int {
+	updateListAction := "replace"
+	changed := 0
+	if m.Revision != src.Revision {
+		m.Revision = src.Revision
+		changed++
+	}
+	if m.Obj.Key.Organization != src.Obj.Key.Organization {
+		m.Obj.Key.Organization = src.Obj.Key.Organization
+		changed++
+	}
+	if m.Obj.Key.Name != src.Obj.Key.Name {
+		m.Obj.Key.Name = src.Obj.Key.Name
+		changed++
+	}
+	if m.Obj.Key.FederatedOrganization != src.Obj.Key.FederatedOrganization {
+		m.Obj.Key.FederatedOrganization = src.Obj.Key.FederatedOrganization
+		changed++
+	}
+	if m.Obj.Description != src.Obj.Description {
+		m.Obj.Description = src.Obj.Description
+		changed++
+	}
+	if src.Obj.InfraFlavors != nil {
+		if updateListAction == "add" {
+			changed += m.AddObjInfraFlavors(src.Obj.InfraFlavors...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveObjInfraFlavors(src.Obj.InfraFlavors...)
+		} else {
+			m.Obj.InfraFlavors = make([]*FlavorInfo, 0)
+			for k1, _ := range src.Obj.InfraFlavors {
+				m.Obj.InfraFlavors = append(m.Obj.InfraFlavors, src.Obj.InfraFlavors[k1].Clone())
+			}
+			changed++
+		}
+	} else if m.Obj.InfraFlavors != nil {
+		m.Obj.InfraFlavors = nil
+		changed++
+	}
+	if m.Obj.Location.Latitude != src.Obj.Location.Latitude {
+		m.Obj.Location.Latitude = src.Obj.Location.Latitude
+		changed++
+	}
+	if m.Obj.Location.Longitude != src.Obj.Location.Longitude {
+		m.Obj.Location.Longitude = src.Obj.Location.Longitude
+		changed++
+	}
+	if m.Obj.Location.HorizontalAccuracy != src.Obj.Location.HorizontalAccuracy {
+		m.Obj.Location.HorizontalAccuracy = src.Obj.Location.HorizontalAccuracy
+		changed++
+	}
+	if m.Obj.Location.VerticalAccuracy != src.Obj.Location.VerticalAccuracy {
+		m.Obj.Location.VerticalAccuracy = src.Obj.Location.VerticalAccuracy
+		changed++
+	}
+	if m.Obj.Location.Altitude != src.Obj.Location.Altitude {
+		m.Obj.Location.Altitude = src.Obj.Location.Altitude
+		changed++
+	}
+	if m.Obj.Location.Course != src.Obj.Location.Course {
+		m.Obj.Location.Course = src.Obj.Location.Course
+		changed++
+	}
+	if m.Obj.Location.Speed != src.Obj.Location.Speed {
+		m.Obj.Location.Speed = src.Obj.Location.Speed
+		changed++
+	}
+	if src.Obj.Location.Timestamp != nil {
+		if m.Obj.Location.Timestamp == nil {
+			m.Obj.Location.Timestamp = &distributed_match_engine.Timestamp{}
+		}
+		if m.Obj.Location.Timestamp.Seconds != src.Obj.Location.Timestamp.Seconds {
+			m.Obj.Location.Timestamp.Seconds = src.Obj.Location.Timestamp.Seconds
+			changed++
+		}
+		if m.Obj.Location.Timestamp.Nanos != src.Obj.Location.Timestamp.Nanos {
+			m.Obj.Location.Timestamp.Nanos = src.Obj.Location.Timestamp.Nanos
+			changed++
+		}
+	} else if m.Obj.Location.Timestamp != nil {
+		m.Obj.Location.Timestamp = nil
+		changed++
+	}
+	if m.Obj.ObjId != src.Obj.ObjId {
+		m.Obj.ObjId = src.Obj.ObjId
+		changed++
+	}
+	if m.Obj.DeletePrepare != src.Obj.DeletePrepare {
+		m.Obj.DeletePrepare = src.Obj.DeletePrepare
+		changed++
+	}
+	if m.Obj.CreatedAt.Seconds != src.Obj.CreatedAt.Seconds {
+		m.Obj.CreatedAt.Seconds = src.Obj.CreatedAt.Seconds
+		changed++
+	}
+	if m.Obj.CreatedAt.Nanos != src.Obj.CreatedAt.Nanos {
+		m.Obj.CreatedAt.Nanos = src.Obj.CreatedAt.Nanos
+		changed++
+	}
+	if m.Obj.UpdatedAt.Seconds != src.Obj.UpdatedAt.Seconds {
+		m.Obj.UpdatedAt.Seconds = src.Obj.UpdatedAt.Seconds
+		changed++
+	}
+	if m.Obj.UpdatedAt.Nanos != src.Obj.UpdatedAt.Nanos {
+		m.Obj.UpdatedAt.Nanos = src.Obj.UpdatedAt.Nanos
+		changed++
+	}
+	return changed
+}
+
+func (m *ZoneHistory) DeepCopyIn(src *ZoneHistory) {
+	m.Revision = src.Revision
+	m.Obj.DeepCopyIn(&src.Obj)
+}
+
+// Helper method to check that enums have valid values
+func (m *ZoneHistory) ValidateEnums() error {
+	if err := m.Obj.ValidateEnums(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *ZoneHistory) ClearTagged(tags map[string]struct{}) {
+	s.Obj.ClearTagged(tags)
+}
+
+func IgnoreZoneHistoryFields(taglist string) cmp.Option {
+	names := []string{}
+	tags := make(map[string]struct{})
+	for _, tag := range strings.Split(taglist, ",") {
+		tags[tag] = struct{}{}
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Obj.ObjId")
+	}
+	if _, found := tags["timestamp"]; found {
+		names = append(names, "Obj.CreatedAt")
+	}
+	if _, found := tags["timestamp"]; found {
+		names = append(names, "Obj.UpdatedAt")
+	}
+	return cmpopts.IgnoreFields(ZoneHistory{}, names...)
+}
+
 func (m *Zone) IsValidArgsForCreateZone() error {
 	if m.Location.Latitude != 0 {
 		return fmt.Errorf("Invalid field specified: Location.Latitude, this field is only for internal use")
@@ -2290,6 +2622,20 @@ func (m *ZoneGPUs) Size() (n int) {
 	return n
 }
 
+func (m *ZoneHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		n += 1 + sovZone(uint64(m.Revision))
+	}
+	l = m.Obj.Size()
+	n += 1 + l + sovZone(uint64(l))
+	return n
+}
+
 func sovZone(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2745,6 +3091,108 @@ func (m *ZoneGPUs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ZoneHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZone
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ZoneHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ZoneHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Obj", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Obj.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZone(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthZone
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipZone(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -160,6 +160,31 @@ func request_ZoneApi_ShowZone_0(ctx context.Context, marshaler runtime.Marshaler
 
 }
 
+func request_ZoneApi_ShowZoneHistory_0(ctx context.Context, marshaler runtime.Marshaler, client ZoneApiClient, req *http.Request, pathParams map[string]string) (ZoneApi_ShowZoneHistoryClient, runtime.ServerMetadata, error) {
+	var protoReq Zone
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	stream, err := client.ShowZoneHistory(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+
+}
+
 func request_ZoneApi_ShowZoneGPUs_0(ctx context.Context, marshaler runtime.Marshaler, client ZoneApiClient, req *http.Request, pathParams map[string]string) (ZoneApi_ShowZoneGPUsClient, runtime.ServerMetadata, error) {
 	var protoReq Zone
 	var metadata runtime.ServerMetadata
@@ -267,6 +292,13 @@ func RegisterZoneApiHandlerServer(ctx context.Context, mux *runtime.ServeMux, se
 		return
 	})
 
+	mux.Handle("POST", pattern_ZoneApi_ShowZoneHistory_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+
 	mux.Handle("POST", pattern_ZoneApi_ShowZoneGPUs_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
 		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
@@ -395,6 +427,26 @@ func RegisterZoneApiHandlerClient(ctx context.Context, mux *runtime.ServeMux, cl
 
 	})
 
+	mux.Handle("POST", pattern_ZoneApi_ShowZoneHistory_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_ZoneApi_ShowZoneHistory_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_ZoneApi_ShowZoneHistory_0(ctx, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+
+	})
+
 	mux.Handle("POST", pattern_ZoneApi_ShowZoneGPUs_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -427,6 +479,8 @@ var (
 
 	pattern_ZoneApi_ShowZone_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"show", "zone"}, "", runtime.AssumeColonVerbOpt(true)))
 
+	pattern_ZoneApi_ShowZoneHistory_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"show", "zonehistory"}, "", runtime.AssumeColonVerbOpt(true)))
+
 	pattern_ZoneApi_ShowZoneGPUs_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"show", "zonegpus"}, "", runtime.AssumeColonVerbOpt(true)))
 )
 
@@ -439,5 +493,7 @@ var (
 
 	forward_ZoneApi_ShowZone_0 = runtime.ForwardResponseStream
 
+	forward_ZoneApi_ShowZoneHistory_0 = runtime.ForwardResponseStream
+
 	forward_ZoneApi_ShowZoneGPUs_0 = runtime.ForwardResponseStream
 )
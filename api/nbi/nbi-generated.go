@@ -13,6 +13,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
@@ -85,6 +86,12 @@ const (
 	EdgeCloudZoneStatusUnknown  EdgeCloudZoneStatus = "unknown"
 )
 
+// Defines values for InstanceEventDataResourceType.
+const (
+	AppInstance InstanceEventDataResourceType = "appInstance"
+	Cluster     InstanceEventDataResourceType = "cluster"
+)
+
 // Defines values for K8sNetworkingAdditionalNetworksInterfaceType.
 const (
 	Interface K8sNetworkingAdditionalNetworksInterfaceType = "interface"
@@ -127,6 +134,31 @@ const (
 	OTHER                  OperatingSystemVersion = "OTHER"
 )
 
+// Defines values for OperationStatusState.
+const (
+	FAILED     OperationStatusState = "FAILED"
+	INPROGRESS OperationStatusState = "IN_PROGRESS"
+	PENDING    OperationStatusState = "PENDING"
+	SUCCEEDED  OperationStatusState = "SUCCEEDED"
+)
+
+// Defines values for SinkCredentialCredentialType.
+const (
+	ACCESSTOKEN SinkCredentialCredentialType = "ACCESSTOKEN"
+)
+
+// Defines values for SubscriptionEventType.
+const (
+	OrgCamaraprojectEdgeApplicationManagementV0AppInstanceDeleted   SubscriptionEventType = "org.camaraproject.edge-application-management.v0.app-instance-deleted"
+	OrgCamaraprojectEdgeApplicationManagementV0AppInstanceFailed    SubscriptionEventType = "org.camaraproject.edge-application-management.v0.app-instance-failed"
+	OrgCamaraprojectEdgeApplicationManagementV0AppInstanceHealthy   SubscriptionEventType = "org.camaraproject.edge-application-management.v0.app-instance-healthy"
+	OrgCamaraprojectEdgeApplicationManagementV0AppInstanceReady     SubscriptionEventType = "org.camaraproject.edge-application-management.v0.app-instance-ready"
+	OrgCamaraprojectEdgeApplicationManagementV0AppInstanceUnhealthy SubscriptionEventType = "org.camaraproject.edge-application-management.v0.app-instance-unhealthy"
+	OrgCamaraprojectEdgeApplicationManagementV0ClusterDeleted       SubscriptionEventType = "org.camaraproject.edge-application-management.v0.cluster-deleted"
+	OrgCamaraprojectEdgeApplicationManagementV0ClusterFailed        SubscriptionEventType = "org.camaraproject.edge-application-management.v0.cluster-failed"
+	OrgCamaraprojectEdgeApplicationManagementV0ClusterReady         SubscriptionEventType = "org.camaraproject.edge-application-management.v0.cluster-ready"
+)
+
 // Defines values for VmResourcesInfraKind.
 const (
 	VirtualMachine VmResourcesInfraKind = "virtualMachine"
@@ -235,6 +267,25 @@ type AppInstanceInfoStatus string
 // AppInstanceName Name of the App instance, scoped to the AppProvider
 type AppInstanceName = string
 
+// AppInstanceUpdate Configuration of an application instance that may be changed
+// after instantiation.
+type AppInstanceUpdate struct {
+	// EnvVars Environment variables to set for all containers of the
+	// application instance.
+	EnvVars *[]EnvVar `json:"envVars,omitempty"`
+
+	// FlavorId Identifier of the flavor used for the application instance
+	// resources.
+	FlavorId *string `json:"flavorId,omitempty"`
+
+	// KubernetesResources Definition of Kubernetes Cluster Infrastructure
+	KubernetesResources *KubernetesResources `json:"kubernetesResources,omitempty"`
+
+	// Replicas Number of replicas for Kubernetes deployments. Zero uses
+	// the replica count from the application manifest.
+	Replicas *int `json:"replicas,omitempty"`
+}
+
 // AppManifest Application information and requirements provided by the
 // Application Provider
 type AppManifest struct {
@@ -390,6 +441,31 @@ type AppManifestPackageType string
 // AppProvider Human readable name of the Application Provider.
 type AppProvider = string
 
+// CloudEvent Notification of an Application Instance or Cluster event,
+// formatted as a CloudEvent in structured JSON mode.
+type CloudEvent struct {
+	// Data Details of the instance the event is for
+	Data InstanceEventData `json:"data"`
+
+	// Datacontenttype Media type of the event data
+	Datacontenttype *string `json:"datacontenttype,omitempty"`
+
+	// Id Identifier of the event
+	Id string `json:"id"`
+
+	// Source Identifies the context in which the event happened
+	Source string `json:"source"`
+
+	// Specversion Version of the CloudEvents specification
+	Specversion string `json:"specversion"`
+
+	// Time Time the event occurred
+	Time time.Time `json:"time"`
+
+	// Type Type of event to be notified of
+	Type SubscriptionEventType `json:"type"`
+}
+
 // ClusterInfo Kubernetes cluster information
 type ClusterInfo struct {
 	// ClusterRef A global unique identifier associated with a Kubernetes cluster
@@ -429,6 +505,30 @@ type ContainerResources struct {
 // ContainerResourcesInfraKind Type of infrastructure for the application.
 type ContainerResourcesInfraKind string
 
+// DeadLetter A notification that could not be delivered
+type DeadLetter struct {
+	// Attempts Number of delivery attempts
+	Attempts int `json:"attempts"`
+
+	// Event Notification of an Application Instance or Cluster event,
+	// formatted as a CloudEvent in structured JSON mode.
+	Event CloudEvent `json:"event"`
+
+	// FailedAt Time of the last delivery attempt
+	FailedAt time.Time `json:"failedAt"`
+
+	// LastError Error from the last delivery attempt
+	LastError string `json:"lastError"`
+
+	// Sink Sink the notification was sent to
+	Sink string `json:"sink"`
+
+	// SubscriptionId A unique identifier of the subscription.
+	// Edge Cloud Platform generates this identifier when
+	// the subscription is created.
+	SubscriptionId SubscriptionId `json:"subscriptionId"`
+}
+
 // DockerComposeResources Definition of Docker Compose Infrastructure
 type DockerComposeResources struct {
 	// Gpu Information about the supported GPUs
@@ -497,6 +597,15 @@ type EdgeCloudZoneStatus string
 // instantiate an Application Instance.
 type EdgeCloudZones = []EdgeCloudZone
 
+// EnvVar Environment variable
+type EnvVar struct {
+	// Name Name of the environment variable
+	Name string `json:"name"`
+
+	// Value Value of the environment variable
+	Value string `json:"value"`
+}
+
 // ErrorInfo Information about the error
 type ErrorInfo struct {
 	// Code Code given to this error
@@ -521,6 +630,32 @@ type GpuInfo struct {
 	NumGPU int `json:"numGPU"`
 }
 
+// InstanceEventData Details of the instance the event is for
+type InstanceEventData struct {
+	// Message Additional information, such as the failure reason
+	Message *string `json:"message,omitempty"`
+
+	// Name Name of the instance
+	Name string `json:"name"`
+
+	// Provider Provider of the instance
+	Provider string `json:"provider"`
+
+	// ResourceId Application Instance identifier or Cluster reference
+	ResourceId string `json:"resourceId"`
+
+	// ResourceType Type of the instance
+	ResourceType InstanceEventDataResourceType `json:"resourceType"`
+
+	// SubscriptionId A unique identifier of the subscription.
+	// Edge Cloud Platform generates this identifier when
+	// the subscription is created.
+	SubscriptionId SubscriptionId `json:"subscriptionId"`
+}
+
+// InstanceEventDataResourceType Type of the instance
+type InstanceEventDataResourceType string
+
 // Ipv4Addr IP of the device. A single IPv4 address may be specified in
 // dotted-quad form 1.2.3.4. Only this exact IP number will match the flow
 // control rule.
@@ -765,6 +900,46 @@ type OperatingSystemLicense string
 // OperatingSystemVersion Version of the OS
 type OperatingSystemVersion string
 
+// OperationId A unique identifier of an asynchronous operation.
+// Edge Cloud Platform generates this identifier when
+// the operation is accepted.
+type OperationId = string
+
+// OperationStatus Status of an asynchronous operation.
+type OperationStatus struct {
+	// Action Action performed by the operation
+	Action string `json:"action"`
+
+	// CreatedAt Time the operation was accepted
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// Error Error message if the operation failed
+	Error *string `json:"error,omitempty"`
+
+	// Messages Progress messages of the operation
+	Messages *[]string `json:"messages,omitempty"`
+
+	// OperationId A unique identifier of an asynchronous operation.
+	// Edge Cloud Platform generates this identifier when
+	// the operation is accepted.
+	OperationId OperationId `json:"operationId"`
+
+	// ResourceId Identifier of the resource the operation acts on
+	ResourceId string `json:"resourceId"`
+
+	// ResourceType Type of resource the operation acts on
+	ResourceType string `json:"resourceType"`
+
+	// State State of the operation
+	State OperationStatusState `json:"state"`
+
+	// UpdatedAt Time the operation status last changed
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// OperationStatusState State of the operation
+type OperationStatusState string
+
 // Port Port to stablish the connection
 type Port = int
 
@@ -774,6 +949,19 @@ type RequiredResources struct {
 	union json.RawMessage
 }
 
+// SinkCredential Credential used to authenticate notifications to the sink
+type SinkCredential struct {
+	// AccessToken Access token sent as a Bearer token in the Authorization
+	// header of each notification
+	AccessToken string `json:"accessToken"`
+
+	// CredentialType Type of the credential
+	CredentialType SinkCredentialCredentialType `json:"credentialType"`
+}
+
+// SinkCredentialCredentialType Type of the credential
+type SinkCredentialCredentialType string
+
 // SubmittedApp Information about the submitted app
 type SubmittedApp struct {
 	// AppId A globally unique identifier associated with the application.
@@ -782,6 +970,43 @@ type SubmittedApp struct {
 	AppId *AppId `json:"appId,omitempty"`
 }
 
+// Subscription An event subscription
+type Subscription struct {
+	// Id A unique identifier of the subscription.
+	// Edge Cloud Platform generates this identifier when
+	// the subscription is created.
+	Id SubscriptionId `json:"id"`
+
+	// Sink URL notifications are sent to
+	Sink string `json:"sink"`
+
+	// StartsAt Time the subscription was created
+	StartsAt *time.Time `json:"startsAt,omitempty"`
+
+	// Types Event types notifications are sent for
+	Types []SubscriptionEventType `json:"types"`
+}
+
+// SubscriptionEventType Type of event to be notified of
+type SubscriptionEventType string
+
+// SubscriptionId A unique identifier of the subscription.
+// Edge Cloud Platform generates this identifier when
+// the subscription is created.
+type SubscriptionId = string
+
+// SubscriptionRequest Request to create an event subscription
+type SubscriptionRequest struct {
+	// Sink URL to send notifications to
+	Sink string `json:"sink"`
+
+	// SinkCredential Credential used to authenticate notifications to the sink
+	SinkCredential *SinkCredential `json:"sinkCredential,omitempty"`
+
+	// Types Event types to send notifications for
+	Types []SubscriptionEventType `json:"types"`
+}
+
 // Uri A Uniform Resource Identifier (URI) as per RFC 3986,
 // identifies the endpoint within an Edge Cloud Zone where the user
 // equipment may connect to the selected application instance
@@ -888,6 +1113,18 @@ type DeleteAppInstanceParams struct {
 	XCorrelator *XCorrelator `json:"x-correlator,omitempty"`
 }
 
+// UpdateAppInstanceParams defines parameters for UpdateAppInstance.
+type UpdateAppInstanceParams struct {
+	// XCorrelator Correlation id for the different services
+	XCorrelator *XCorrelator `json:"x-correlator,omitempty"`
+}
+
+// ReplaceAppInstanceParams defines parameters for ReplaceAppInstance.
+type ReplaceAppInstanceParams struct {
+	// XCorrelator Correlation id for the different services
+	XCorrelator *XCorrelator `json:"x-correlator,omitempty"`
+}
+
 // GetAppsParams defines parameters for GetApps.
 type GetAppsParams struct {
 	// XCorrelator Correlation id for the different services
@@ -938,12 +1175,57 @@ type GetEdgeCloudZonesParams struct {
 	XCorrelator *XCorrelator `json:"x-correlator,omitempty"`
 }
 
+// GetOperationParams defines parameters for GetOperation.
+type GetOperationParams struct {
+	// XCorrelator Correlation id for the different services
+	XCorrelator *XCorrelator `json:"x-correlator,omitempty"`
+}
+
+// GetSubscriptionsParams defines parameters for GetSubscriptions.
+type GetSubscriptionsParams struct {
+	// XCorrelator Correlation id for the different services
+	XCorrelator *XCorrelator `json:"x-correlator,omitempty"`
+}
+
+// CreateSubscriptionParams defines parameters for CreateSubscription.
+type CreateSubscriptionParams struct {
+	// XCorrelator Correlation id for the different services
+	XCorrelator *XCorrelator `json:"x-correlator,omitempty"`
+}
+
+// DeleteSubscriptionParams defines parameters for DeleteSubscription.
+type DeleteSubscriptionParams struct {
+	// XCorrelator Correlation id for the different services
+	XCorrelator *XCorrelator `json:"x-correlator,omitempty"`
+}
+
+// GetSubscriptionParams defines parameters for GetSubscription.
+type GetSubscriptionParams struct {
+	// XCorrelator Correlation id for the different services
+	XCorrelator *XCorrelator `json:"x-correlator,omitempty"`
+}
+
+// GetSubscriptionDeadLettersParams defines parameters for GetSubscriptionDeadLetters.
+type GetSubscriptionDeadLettersParams struct {
+	// XCorrelator Correlation id for the different services
+	XCorrelator *XCorrelator `json:"x-correlator,omitempty"`
+}
+
 // CreateAppInstanceJSONRequestBody defines body for CreateAppInstance for application/json ContentType.
 type CreateAppInstanceJSONRequestBody CreateAppInstanceJSONBody
 
+// UpdateAppInstanceJSONRequestBody defines body for UpdateAppInstance for application/json ContentType.
+type UpdateAppInstanceJSONRequestBody = AppInstanceUpdate
+
+// ReplaceAppInstanceJSONRequestBody defines body for ReplaceAppInstance for application/json ContentType.
+type ReplaceAppInstanceJSONRequestBody = AppInstanceUpdate
+
 // SubmitAppJSONRequestBody defines body for SubmitApp for application/json ContentType.
 type SubmitAppJSONRequestBody = AppManifest
 
+// CreateSubscriptionJSONRequestBody defines body for CreateSubscription for application/json ContentType.
+type CreateSubscriptionJSONRequestBody = SubscriptionRequest
+
 // AsAccessEndpoint0 returns the union data inside the AccessEndpoint as a AccessEndpoint0
 func (t AccessEndpoint) AsAccessEndpoint0() (AccessEndpoint0, error) {
 	var body AccessEndpoint0
@@ -1340,6 +1622,16 @@ type ClientInterface interface {
 	// DeleteAppInstance request
 	DeleteAppInstance(ctx context.Context, appInstanceId AppInstanceId, params *DeleteAppInstanceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UpdateAppInstanceWithBody request with any body
+	UpdateAppInstanceWithBody(ctx context.Context, appInstanceId AppInstanceId, params *UpdateAppInstanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateAppInstance(ctx context.Context, appInstanceId AppInstanceId, params *UpdateAppInstanceParams, body UpdateAppInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReplaceAppInstanceWithBody request with any body
+	ReplaceAppInstanceWithBody(ctx context.Context, appInstanceId AppInstanceId, params *ReplaceAppInstanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReplaceAppInstance(ctx context.Context, appInstanceId AppInstanceId, params *ReplaceAppInstanceParams, body ReplaceAppInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetApps request
 	GetApps(ctx context.Context, params *GetAppsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1359,6 +1651,26 @@ type ClientInterface interface {
 
 	// GetEdgeCloudZones request
 	GetEdgeCloudZones(ctx context.Context, params *GetEdgeCloudZonesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOperation request
+	GetOperation(ctx context.Context, operationId OperationId, params *GetOperationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSubscriptions request
+	GetSubscriptions(ctx context.Context, params *GetSubscriptionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSubscriptionWithBody request with any body
+	CreateSubscriptionWithBody(ctx context.Context, params *CreateSubscriptionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSubscription(ctx context.Context, params *CreateSubscriptionParams, body CreateSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSubscription request
+	DeleteSubscription(ctx context.Context, subscriptionId SubscriptionId, params *DeleteSubscriptionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSubscription request
+	GetSubscription(ctx context.Context, subscriptionId SubscriptionId, params *GetSubscriptionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSubscriptionDeadLetters request
+	GetSubscriptionDeadLetters(ctx context.Context, subscriptionId SubscriptionId, params *GetSubscriptionDeadLettersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) GetAppInstance(ctx context.Context, params *GetAppInstanceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -1409,6 +1721,54 @@ func (c *Client) DeleteAppInstance(ctx context.Context, appInstanceId AppInstanc
 	return c.Client.Do(req)
 }
 
+func (c *Client) UpdateAppInstanceWithBody(ctx context.Context, appInstanceId AppInstanceId, params *UpdateAppInstanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAppInstanceRequestWithBody(c.Server, appInstanceId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateAppInstance(ctx context.Context, appInstanceId AppInstanceId, params *UpdateAppInstanceParams, body UpdateAppInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAppInstanceRequest(c.Server, appInstanceId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReplaceAppInstanceWithBody(ctx context.Context, appInstanceId AppInstanceId, params *ReplaceAppInstanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplaceAppInstanceRequestWithBody(c.Server, appInstanceId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReplaceAppInstance(ctx context.Context, appInstanceId AppInstanceId, params *ReplaceAppInstanceParams, body ReplaceAppInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplaceAppInstanceRequest(c.Server, appInstanceId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetApps(ctx context.Context, params *GetAppsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetAppsRequest(c.Server, params)
 	if err != nil {
@@ -1493,6 +1853,90 @@ func (c *Client) GetEdgeCloudZones(ctx context.Context, params *GetEdgeCloudZone
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetOperation(ctx context.Context, operationId OperationId, params *GetOperationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOperationRequest(c.Server, operationId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSubscriptions(ctx context.Context, params *GetSubscriptionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSubscriptionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSubscriptionWithBody(ctx context.Context, params *CreateSubscriptionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSubscriptionRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSubscription(ctx context.Context, params *CreateSubscriptionParams, body CreateSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSubscriptionRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteSubscription(ctx context.Context, subscriptionId SubscriptionId, params *DeleteSubscriptionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSubscriptionRequest(c.Server, subscriptionId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSubscription(ctx context.Context, subscriptionId SubscriptionId, params *GetSubscriptionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSubscriptionRequest(c.Server, subscriptionId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSubscriptionDeadLetters(ctx context.Context, subscriptionId SubscriptionId, params *GetSubscriptionDeadLettersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSubscriptionDeadLettersRequest(c.Server, subscriptionId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewGetAppInstanceRequest generates requests for GetAppInstance
 func NewGetAppInstanceRequest(server string, params *GetAppInstanceParams) (*http.Request, error) {
 	var err error
@@ -1693,16 +2137,34 @@ func NewDeleteAppInstanceRequest(server string, appInstanceId AppInstanceId, par
 	return req, nil
 }
 
-// NewGetAppsRequest generates requests for GetApps
-func NewGetAppsRequest(server string, params *GetAppsParams) (*http.Request, error) {
+// NewUpdateAppInstanceRequest calls the generic UpdateAppInstance builder with application/json body
+func NewUpdateAppInstanceRequest(server string, appInstanceId AppInstanceId, params *UpdateAppInstanceParams, body UpdateAppInstanceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateAppInstanceRequestWithBody(server, appInstanceId, params, "application/json", bodyReader)
+}
+
+// NewUpdateAppInstanceRequestWithBody generates requests for UpdateAppInstance with any type of body
+func NewUpdateAppInstanceRequestWithBody(server string, appInstanceId AppInstanceId, params *UpdateAppInstanceParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appInstanceId", runtime.ParamLocationPath, appInstanceId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/apps")
+	operationPath := fmt.Sprintf("/appinstances/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1712,11 +2174,13 @@ func NewGetAppsRequest(server string, params *GetAppsParams) (*http.Request, err
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	if params != nil {
 
 		if params.XCorrelator != nil {
@@ -1735,22 +2199,126 @@ func NewGetAppsRequest(server string, params *GetAppsParams) (*http.Request, err
 	return req, nil
 }
 
-// NewSubmitAppRequest calls the generic SubmitApp builder with application/json body
-func NewSubmitAppRequest(server string, params *SubmitAppParams, body SubmitAppJSONRequestBody) (*http.Request, error) {
+// NewReplaceAppInstanceRequest calls the generic ReplaceAppInstance builder with application/json body
+func NewReplaceAppInstanceRequest(server string, appInstanceId AppInstanceId, params *ReplaceAppInstanceParams, body ReplaceAppInstanceJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewSubmitAppRequestWithBody(server, params, "application/json", bodyReader)
+	return NewReplaceAppInstanceRequestWithBody(server, appInstanceId, params, "application/json", bodyReader)
 }
 
-// NewSubmitAppRequestWithBody generates requests for SubmitApp with any type of body
-func NewSubmitAppRequestWithBody(server string, params *SubmitAppParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewReplaceAppInstanceRequestWithBody generates requests for ReplaceAppInstance with any type of body
+func NewReplaceAppInstanceRequestWithBody(server string, appInstanceId AppInstanceId, params *ReplaceAppInstanceParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	serverURL, err := url.Parse(server)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appInstanceId", runtime.ParamLocationPath, appInstanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/appinstances/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCorrelator != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "x-correlator", runtime.ParamLocationHeader, *params.XCorrelator)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("x-correlator", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetAppsRequest generates requests for GetApps
+func NewGetAppsRequest(server string, params *GetAppsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/apps")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XCorrelator != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "x-correlator", runtime.ParamLocationHeader, *params.XCorrelator)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("x-correlator", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewSubmitAppRequest calls the generic SubmitApp builder with application/json body
+func NewSubmitAppRequest(server string, params *SubmitAppParams, body SubmitAppJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSubmitAppRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewSubmitAppRequestWithBody generates requests for SubmitApp with any type of body
+func NewSubmitAppRequestWithBody(server string, params *SubmitAppParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -2048,327 +2616,417 @@ func NewGetEdgeCloudZonesRequest(server string, params *GetEdgeCloudZonesParams)
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewGetOperationRequest generates requests for GetOperation
+func NewGetOperationRequest(server string, operationId OperationId, params *GetOperationParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "operationId", runtime.ParamLocationPath, operationId)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	operationPath := fmt.Sprintf("/operations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XCorrelator != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "x-correlator", runtime.ParamLocationHeader, *params.XCorrelator)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("x-correlator", headerParam0)
 		}
-		c.Server = newBaseURL.String()
-		return nil
+
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetAppInstanceWithResponse request
-	GetAppInstanceWithResponse(ctx context.Context, params *GetAppInstanceParams, reqEditors ...RequestEditorFn) (*GetAppInstanceResponse, error)
+	return req, nil
+}
 
-	// CreateAppInstanceWithBodyWithResponse request with any body
-	CreateAppInstanceWithBodyWithResponse(ctx context.Context, params *CreateAppInstanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppInstanceResponse, error)
+// NewGetSubscriptionsRequest generates requests for GetSubscriptions
+func NewGetSubscriptionsRequest(server string, params *GetSubscriptionsParams) (*http.Request, error) {
+	var err error
 
-	CreateAppInstanceWithResponse(ctx context.Context, params *CreateAppInstanceParams, body CreateAppInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppInstanceResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteAppInstanceWithResponse request
-	DeleteAppInstanceWithResponse(ctx context.Context, appInstanceId AppInstanceId, params *DeleteAppInstanceParams, reqEditors ...RequestEditorFn) (*DeleteAppInstanceResponse, error)
+	operationPath := fmt.Sprintf("/subscriptions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetAppsWithResponse request
-	GetAppsWithResponse(ctx context.Context, params *GetAppsParams, reqEditors ...RequestEditorFn) (*GetAppsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// SubmitAppWithBodyWithResponse request with any body
-	SubmitAppWithBodyWithResponse(ctx context.Context, params *SubmitAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitAppResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	SubmitAppWithResponse(ctx context.Context, params *SubmitAppParams, body SubmitAppJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitAppResponse, error)
+	if params != nil {
 
-	// DeleteAppWithResponse request
-	DeleteAppWithResponse(ctx context.Context, appId AppId, params *DeleteAppParams, reqEditors ...RequestEditorFn) (*DeleteAppResponse, error)
+		if params.XCorrelator != nil {
+			var headerParam0 string
 
-	// GetAppWithResponse request
-	GetAppWithResponse(ctx context.Context, appId AppId, params *GetAppParams, reqEditors ...RequestEditorFn) (*GetAppResponse, error)
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "x-correlator", runtime.ParamLocationHeader, *params.XCorrelator)
+			if err != nil {
+				return nil, err
+			}
 
-	// GetClustersWithResponse request
-	GetClustersWithResponse(ctx context.Context, params *GetClustersParams, reqEditors ...RequestEditorFn) (*GetClustersResponse, error)
+			req.Header.Set("x-correlator", headerParam0)
+		}
 
-	// GetEdgeCloudZonesWithResponse request
-	GetEdgeCloudZonesWithResponse(ctx context.Context, params *GetEdgeCloudZonesParams, reqEditors ...RequestEditorFn) (*GetEdgeCloudZonesResponse, error)
-}
+	}
 
-type GetAppInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]AppInstanceInfo
-	JSON401      *N401
-	JSON403      *N403
-	JSON404      *N404
-	JSON500      *N500
-	JSON503      *N503
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAppInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewCreateSubscriptionRequest calls the generic CreateSubscription builder with application/json body
+func NewCreateSubscriptionRequest(server string, params *CreateSubscriptionParams, body CreateSubscriptionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSubscriptionRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAppInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewCreateSubscriptionRequestWithBody generates requests for CreateSubscription with any type of body
+func NewCreateSubscriptionRequestWithBody(server string, params *CreateSubscriptionParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// GetBody returns the HTTP response's body
-func (r GetAppInstanceResponse) GetBody() []byte {
-	return r.Body
-}
+	operationPath := fmt.Sprintf("/subscriptions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-type CreateAppInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON202      *AppInstanceInfo
-	JSON400      *N400
-	JSON401      *N401
-	JSON403      *N403
-	JSON409      *ErrorInfo
-	JSON500      *N500
-	JSON501      *N501
-	JSON503      *N503
-}
-
-// Status returns HTTPResponse.Status
-func (r CreateAppInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateAppInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// GetBody returns the HTTP response's body
-func (r CreateAppInstanceResponse) GetBody() []byte {
-	return r.Body
-}
+	req.Header.Add("Content-Type", contentType)
 
-type DeleteAppInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400
-	JSON401      *N401
-	JSON403      *N403
-	JSON404      *N404
-	JSON500      *N500
-	JSON503      *N503
-}
+	if params != nil {
 
-// Status returns HTTPResponse.Status
-func (r DeleteAppInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.XCorrelator != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "x-correlator", runtime.ParamLocationHeader, *params.XCorrelator)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("x-correlator", headerParam0)
+		}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAppInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-// GetBody returns the HTTP response's body
-func (r DeleteAppInstanceResponse) GetBody() []byte {
-	return r.Body
+	return req, nil
 }
 
-type GetAppsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]AppManifest
-	JSON401      *N401
-	JSON403      *N403
-	JSON404      *N404
-	JSON500      *N500
-	JSON503      *N503
-}
+// NewDeleteSubscriptionRequest generates requests for DeleteSubscription
+func NewDeleteSubscriptionRequest(server string, subscriptionId SubscriptionId, params *DeleteSubscriptionParams) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r GetAppsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "subscriptionId", runtime.ParamLocationPath, subscriptionId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAppsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// GetBody returns the HTTP response's body
-func (r GetAppsResponse) GetBody() []byte {
-	return r.Body
-}
+	operationPath := fmt.Sprintf("/subscriptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-type SubmitAppResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *SubmittedApp
-	JSON400      *N400
-	JSON401      *N401
-	JSON403      *N403
-	JSON409      *ErrorInfo
-	JSON500      *N500
-	JSON501      *N501
-	JSON503      *N503
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r SubmitAppResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SubmitAppResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	if params != nil {
+
+		if params.XCorrelator != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "x-correlator", runtime.ParamLocationHeader, *params.XCorrelator)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("x-correlator", headerParam0)
+		}
+
 	}
-	return 0
-}
 
-// GetBody returns the HTTP response's body
-func (r SubmitAppResponse) GetBody() []byte {
-	return r.Body
+	return req, nil
 }
 
-type DeleteAppResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400
-	JSON401      *N401
-	JSON403      *N403
-	JSON404      *N404
-	JSON409      *ErrorInfo
-	JSON500      *N500
-	JSON503      *N503
-}
+// NewGetSubscriptionRequest generates requests for GetSubscription
+func NewGetSubscriptionRequest(server string, subscriptionId SubscriptionId, params *GetSubscriptionParams) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r DeleteAppResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "subscriptionId", runtime.ParamLocationPath, subscriptionId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAppResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// GetBody returns the HTTP response's body
-func (r DeleteAppResponse) GetBody() []byte {
-	return r.Body
-}
+	operationPath := fmt.Sprintf("/subscriptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-type GetAppResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		// AppManifest Application information and requirements provided by the
-		// Application Provider
-		AppManifest *AppManifest `json:"appManifest,omitempty"`
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	JSON401 *N401
-	JSON403 *N403
-	JSON404 *N404
-	JSON500 *N500
-	JSON503 *N503
-}
 
-// Status returns HTTPResponse.Status
-func (r GetAppResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAppResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	if params != nil {
+
+		if params.XCorrelator != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "x-correlator", runtime.ParamLocationHeader, *params.XCorrelator)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("x-correlator", headerParam0)
+		}
+
 	}
-	return 0
-}
 
-// GetBody returns the HTTP response's body
-func (r GetAppResponse) GetBody() []byte {
-	return r.Body
+	return req, nil
 }
 
-type GetClustersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]ClusterInfo
-	JSON401      *N401
-	JSON403      *N403
-	JSON404      *N404
-	JSON500      *N500
-	JSON503      *N503
-}
+// NewGetSubscriptionDeadLettersRequest generates requests for GetSubscriptionDeadLetters
+func NewGetSubscriptionDeadLettersRequest(server string, subscriptionId SubscriptionId, params *GetSubscriptionDeadLettersParams) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r GetClustersResponse) Status() string {
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "subscriptionId", runtime.ParamLocationPath, subscriptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/subscriptions/%s/dead-letters", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XCorrelator != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "x-correlator", runtime.ParamLocationHeader, *params.XCorrelator)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("x-correlator", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAppInstanceWithResponse request
+	GetAppInstanceWithResponse(ctx context.Context, params *GetAppInstanceParams, reqEditors ...RequestEditorFn) (*GetAppInstanceResponse, error)
+
+	// CreateAppInstanceWithBodyWithResponse request with any body
+	CreateAppInstanceWithBodyWithResponse(ctx context.Context, params *CreateAppInstanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppInstanceResponse, error)
+
+	CreateAppInstanceWithResponse(ctx context.Context, params *CreateAppInstanceParams, body CreateAppInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppInstanceResponse, error)
+
+	// DeleteAppInstanceWithResponse request
+	DeleteAppInstanceWithResponse(ctx context.Context, appInstanceId AppInstanceId, params *DeleteAppInstanceParams, reqEditors ...RequestEditorFn) (*DeleteAppInstanceResponse, error)
+
+	// UpdateAppInstanceWithBodyWithResponse request with any body
+	UpdateAppInstanceWithBodyWithResponse(ctx context.Context, appInstanceId AppInstanceId, params *UpdateAppInstanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppInstanceResponse, error)
+
+	UpdateAppInstanceWithResponse(ctx context.Context, appInstanceId AppInstanceId, params *UpdateAppInstanceParams, body UpdateAppInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppInstanceResponse, error)
+
+	// ReplaceAppInstanceWithBodyWithResponse request with any body
+	ReplaceAppInstanceWithBodyWithResponse(ctx context.Context, appInstanceId AppInstanceId, params *ReplaceAppInstanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceAppInstanceResponse, error)
+
+	ReplaceAppInstanceWithResponse(ctx context.Context, appInstanceId AppInstanceId, params *ReplaceAppInstanceParams, body ReplaceAppInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceAppInstanceResponse, error)
+
+	// GetAppsWithResponse request
+	GetAppsWithResponse(ctx context.Context, params *GetAppsParams, reqEditors ...RequestEditorFn) (*GetAppsResponse, error)
+
+	// SubmitAppWithBodyWithResponse request with any body
+	SubmitAppWithBodyWithResponse(ctx context.Context, params *SubmitAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitAppResponse, error)
+
+	SubmitAppWithResponse(ctx context.Context, params *SubmitAppParams, body SubmitAppJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitAppResponse, error)
+
+	// DeleteAppWithResponse request
+	DeleteAppWithResponse(ctx context.Context, appId AppId, params *DeleteAppParams, reqEditors ...RequestEditorFn) (*DeleteAppResponse, error)
+
+	// GetAppWithResponse request
+	GetAppWithResponse(ctx context.Context, appId AppId, params *GetAppParams, reqEditors ...RequestEditorFn) (*GetAppResponse, error)
+
+	// GetClustersWithResponse request
+	GetClustersWithResponse(ctx context.Context, params *GetClustersParams, reqEditors ...RequestEditorFn) (*GetClustersResponse, error)
+
+	// GetEdgeCloudZonesWithResponse request
+	GetEdgeCloudZonesWithResponse(ctx context.Context, params *GetEdgeCloudZonesParams, reqEditors ...RequestEditorFn) (*GetEdgeCloudZonesResponse, error)
+
+	// GetOperationWithResponse request
+	GetOperationWithResponse(ctx context.Context, operationId OperationId, params *GetOperationParams, reqEditors ...RequestEditorFn) (*GetOperationResponse, error)
+
+	// GetSubscriptionsWithResponse request
+	GetSubscriptionsWithResponse(ctx context.Context, params *GetSubscriptionsParams, reqEditors ...RequestEditorFn) (*GetSubscriptionsResponse, error)
+
+	// CreateSubscriptionWithBodyWithResponse request with any body
+	CreateSubscriptionWithBodyWithResponse(ctx context.Context, params *CreateSubscriptionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSubscriptionResponse, error)
+
+	CreateSubscriptionWithResponse(ctx context.Context, params *CreateSubscriptionParams, body CreateSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSubscriptionResponse, error)
+
+	// DeleteSubscriptionWithResponse request
+	DeleteSubscriptionWithResponse(ctx context.Context, subscriptionId SubscriptionId, params *DeleteSubscriptionParams, reqEditors ...RequestEditorFn) (*DeleteSubscriptionResponse, error)
+
+	// GetSubscriptionWithResponse request
+	GetSubscriptionWithResponse(ctx context.Context, subscriptionId SubscriptionId, params *GetSubscriptionParams, reqEditors ...RequestEditorFn) (*GetSubscriptionResponse, error)
+
+	// GetSubscriptionDeadLettersWithResponse request
+	GetSubscriptionDeadLettersWithResponse(ctx context.Context, subscriptionId SubscriptionId, params *GetSubscriptionDeadLettersParams, reqEditors ...RequestEditorFn) (*GetSubscriptionDeadLettersResponse, error)
+}
+
+type GetAppInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AppInstanceInfo
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON500      *N500
+	JSON503      *N503
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppInstanceResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2376,7 +3034,7 @@ func (r GetClustersResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetClustersResponse) StatusCode() int {
+func (r GetAppInstanceResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -2384,23 +3042,25 @@ func (r GetClustersResponse) StatusCode() int {
 }
 
 // GetBody returns the HTTP response's body
-func (r GetClustersResponse) GetBody() []byte {
+func (r GetAppInstanceResponse) GetBody() []byte {
 	return r.Body
 }
 
-type GetEdgeCloudZonesResponse struct {
+type CreateAppInstanceResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EdgeCloudZones
+	JSON202      *AppInstanceInfo
+	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
-	JSON404      *N404
+	JSON409      *ErrorInfo
 	JSON500      *N500
+	JSON501      *N501
 	JSON503      *N503
 }
 
 // Status returns HTTPResponse.Status
-func (r GetEdgeCloudZonesResponse) Status() string {
+func (r CreateAppInstanceResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2408,7 +3068,7 @@ func (r GetEdgeCloudZonesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetEdgeCloudZonesResponse) StatusCode() int {
+func (r CreateAppInstanceResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -2416,1198 +3076,3307 @@ func (r GetEdgeCloudZonesResponse) StatusCode() int {
 }
 
 // GetBody returns the HTTP response's body
-func (r GetEdgeCloudZonesResponse) GetBody() []byte {
+func (r CreateAppInstanceResponse) GetBody() []byte {
 	return r.Body
 }
 
-// GetAppInstanceWithResponse request returning *GetAppInstanceResponse
-func (c *ClientWithResponses) GetAppInstanceWithResponse(ctx context.Context, params *GetAppInstanceParams, reqEditors ...RequestEditorFn) (*GetAppInstanceResponse, error) {
-	rsp, err := c.GetAppInstance(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetAppInstanceResponse(rsp)
+type DeleteAppInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON500      *N500
+	JSON503      *N503
 }
 
-// CreateAppInstanceWithBodyWithResponse request with arbitrary body returning *CreateAppInstanceResponse
-func (c *ClientWithResponses) CreateAppInstanceWithBodyWithResponse(ctx context.Context, params *CreateAppInstanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppInstanceResponse, error) {
-	rsp, err := c.CreateAppInstanceWithBody(ctx, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r DeleteAppInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseCreateAppInstanceResponse(rsp)
+	return http.StatusText(0)
 }
 
-func (c *ClientWithResponses) CreateAppInstanceWithResponse(ctx context.Context, params *CreateAppInstanceParams, body CreateAppInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppInstanceResponse, error) {
-	rsp, err := c.CreateAppInstance(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAppInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseCreateAppInstanceResponse(rsp)
+	return 0
 }
 
-// DeleteAppInstanceWithResponse request returning *DeleteAppInstanceResponse
-func (c *ClientWithResponses) DeleteAppInstanceWithResponse(ctx context.Context, appInstanceId AppInstanceId, params *DeleteAppInstanceParams, reqEditors ...RequestEditorFn) (*DeleteAppInstanceResponse, error) {
-	rsp, err := c.DeleteAppInstance(ctx, appInstanceId, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteAppInstanceResponse(rsp)
+// GetBody returns the HTTP response's body
+func (r DeleteAppInstanceResponse) GetBody() []byte {
+	return r.Body
 }
 
-// GetAppsWithResponse request returning *GetAppsResponse
-func (c *ClientWithResponses) GetAppsWithResponse(ctx context.Context, params *GetAppsParams, reqEditors ...RequestEditorFn) (*GetAppsResponse, error) {
-	rsp, err := c.GetApps(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetAppsResponse(rsp)
+type UpdateAppInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *OperationStatus
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON500      *N500
+	JSON503      *N503
 }
 
-// SubmitAppWithBodyWithResponse request with arbitrary body returning *SubmitAppResponse
-func (c *ClientWithResponses) SubmitAppWithBodyWithResponse(ctx context.Context, params *SubmitAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitAppResponse, error) {
-	rsp, err := c.SubmitAppWithBody(ctx, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r UpdateAppInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseSubmitAppResponse(rsp)
+	return http.StatusText(0)
 }
 
-func (c *ClientWithResponses) SubmitAppWithResponse(ctx context.Context, params *SubmitAppParams, body SubmitAppJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitAppResponse, error) {
-	rsp, err := c.SubmitApp(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAppInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseSubmitAppResponse(rsp)
+	return 0
 }
 
-// DeleteAppWithResponse request returning *DeleteAppResponse
-func (c *ClientWithResponses) DeleteAppWithResponse(ctx context.Context, appId AppId, params *DeleteAppParams, reqEditors ...RequestEditorFn) (*DeleteAppResponse, error) {
-	rsp, err := c.DeleteApp(ctx, appId, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteAppResponse(rsp)
+// GetBody returns the HTTP response's body
+func (r UpdateAppInstanceResponse) GetBody() []byte {
+	return r.Body
 }
 
-// GetAppWithResponse request returning *GetAppResponse
-func (c *ClientWithResponses) GetAppWithResponse(ctx context.Context, appId AppId, params *GetAppParams, reqEditors ...RequestEditorFn) (*GetAppResponse, error) {
-	rsp, err := c.GetApp(ctx, appId, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
+type ReplaceAppInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *OperationStatus
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON500      *N500
+	JSON503      *N503
+}
+
+// Status returns HTTPResponse.Status
+func (r ReplaceAppInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReplaceAppInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetBody returns the HTTP response's body
+func (r ReplaceAppInstanceResponse) GetBody() []byte {
+	return r.Body
+}
+
+type GetAppsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AppManifest
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON500      *N500
+	JSON503      *N503
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetBody returns the HTTP response's body
+func (r GetAppsResponse) GetBody() []byte {
+	return r.Body
+}
+
+type SubmitAppResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SubmittedApp
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON409      *ErrorInfo
+	JSON500      *N500
+	JSON501      *N501
+	JSON503      *N503
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitAppResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitAppResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetBody returns the HTTP response's body
+func (r SubmitAppResponse) GetBody() []byte {
+	return r.Body
+}
+
+type DeleteAppResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON409      *ErrorInfo
+	JSON500      *N500
+	JSON503      *N503
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAppResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAppResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetBody returns the HTTP response's body
+func (r DeleteAppResponse) GetBody() []byte {
+	return r.Body
+}
+
+type GetAppResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// AppManifest Application information and requirements provided by the
+		// Application Provider
+		AppManifest *AppManifest `json:"appManifest,omitempty"`
+	}
+	JSON401 *N401
+	JSON403 *N403
+	JSON404 *N404
+	JSON500 *N500
+	JSON503 *N503
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetBody returns the HTTP response's body
+func (r GetAppResponse) GetBody() []byte {
+	return r.Body
+}
+
+type GetClustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ClusterInfo
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON500      *N500
+	JSON503      *N503
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetBody returns the HTTP response's body
+func (r GetClustersResponse) GetBody() []byte {
+	return r.Body
+}
+
+type GetEdgeCloudZonesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EdgeCloudZones
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON500      *N500
+	JSON503      *N503
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEdgeCloudZonesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEdgeCloudZonesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetBody returns the HTTP response's body
+func (r GetEdgeCloudZonesResponse) GetBody() []byte {
+	return r.Body
+}
+
+type GetOperationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OperationStatus
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON500      *N500
+	JSON503      *N503
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOperationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOperationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetBody returns the HTTP response's body
+func (r GetOperationResponse) GetBody() []byte {
+	return r.Body
+}
+
+type GetSubscriptionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Subscription
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+	JSON503      *N503
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSubscriptionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSubscriptionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetBody returns the HTTP response's body
+func (r GetSubscriptionsResponse) GetBody() []byte {
+	return r.Body
+}
+
+type CreateSubscriptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Subscription
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+	JSON503      *N503
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetBody returns the HTTP response's body
+func (r CreateSubscriptionResponse) GetBody() []byte {
+	return r.Body
+}
+
+type DeleteSubscriptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON500      *N500
+	JSON503      *N503
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetBody returns the HTTP response's body
+func (r DeleteSubscriptionResponse) GetBody() []byte {
+	return r.Body
+}
+
+type GetSubscriptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Subscription
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON500      *N500
+	JSON503      *N503
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetBody returns the HTTP response's body
+func (r GetSubscriptionResponse) GetBody() []byte {
+	return r.Body
+}
+
+type GetSubscriptionDeadLettersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DeadLetter
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON500      *N500
+	JSON503      *N503
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSubscriptionDeadLettersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSubscriptionDeadLettersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetBody returns the HTTP response's body
+func (r GetSubscriptionDeadLettersResponse) GetBody() []byte {
+	return r.Body
+}
+
+// GetAppInstanceWithResponse request returning *GetAppInstanceResponse
+func (c *ClientWithResponses) GetAppInstanceWithResponse(ctx context.Context, params *GetAppInstanceParams, reqEditors ...RequestEditorFn) (*GetAppInstanceResponse, error) {
+	rsp, err := c.GetAppInstance(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppInstanceResponse(rsp)
+}
+
+// CreateAppInstanceWithBodyWithResponse request with arbitrary body returning *CreateAppInstanceResponse
+func (c *ClientWithResponses) CreateAppInstanceWithBodyWithResponse(ctx context.Context, params *CreateAppInstanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppInstanceResponse, error) {
+	rsp, err := c.CreateAppInstanceWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAppInstanceResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateAppInstanceWithResponse(ctx context.Context, params *CreateAppInstanceParams, body CreateAppInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppInstanceResponse, error) {
+	rsp, err := c.CreateAppInstance(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAppInstanceResponse(rsp)
+}
+
+// DeleteAppInstanceWithResponse request returning *DeleteAppInstanceResponse
+func (c *ClientWithResponses) DeleteAppInstanceWithResponse(ctx context.Context, appInstanceId AppInstanceId, params *DeleteAppInstanceParams, reqEditors ...RequestEditorFn) (*DeleteAppInstanceResponse, error) {
+	rsp, err := c.DeleteAppInstance(ctx, appInstanceId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAppInstanceResponse(rsp)
+}
+
+// UpdateAppInstanceWithBodyWithResponse request with arbitrary body returning *UpdateAppInstanceResponse
+func (c *ClientWithResponses) UpdateAppInstanceWithBodyWithResponse(ctx context.Context, appInstanceId AppInstanceId, params *UpdateAppInstanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppInstanceResponse, error) {
+	rsp, err := c.UpdateAppInstanceWithBody(ctx, appInstanceId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAppInstanceResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAppInstanceWithResponse(ctx context.Context, appInstanceId AppInstanceId, params *UpdateAppInstanceParams, body UpdateAppInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppInstanceResponse, error) {
+	rsp, err := c.UpdateAppInstance(ctx, appInstanceId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAppInstanceResponse(rsp)
+}
+
+// ReplaceAppInstanceWithBodyWithResponse request with arbitrary body returning *ReplaceAppInstanceResponse
+func (c *ClientWithResponses) ReplaceAppInstanceWithBodyWithResponse(ctx context.Context, appInstanceId AppInstanceId, params *ReplaceAppInstanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceAppInstanceResponse, error) {
+	rsp, err := c.ReplaceAppInstanceWithBody(ctx, appInstanceId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplaceAppInstanceResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReplaceAppInstanceWithResponse(ctx context.Context, appInstanceId AppInstanceId, params *ReplaceAppInstanceParams, body ReplaceAppInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceAppInstanceResponse, error) {
+	rsp, err := c.ReplaceAppInstance(ctx, appInstanceId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplaceAppInstanceResponse(rsp)
+}
+
+// GetAppsWithResponse request returning *GetAppsResponse
+func (c *ClientWithResponses) GetAppsWithResponse(ctx context.Context, params *GetAppsParams, reqEditors ...RequestEditorFn) (*GetAppsResponse, error) {
+	rsp, err := c.GetApps(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppsResponse(rsp)
+}
+
+// SubmitAppWithBodyWithResponse request with arbitrary body returning *SubmitAppResponse
+func (c *ClientWithResponses) SubmitAppWithBodyWithResponse(ctx context.Context, params *SubmitAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitAppResponse, error) {
+	rsp, err := c.SubmitAppWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitAppResponse(rsp)
+}
+
+func (c *ClientWithResponses) SubmitAppWithResponse(ctx context.Context, params *SubmitAppParams, body SubmitAppJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitAppResponse, error) {
+	rsp, err := c.SubmitApp(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitAppResponse(rsp)
+}
+
+// DeleteAppWithResponse request returning *DeleteAppResponse
+func (c *ClientWithResponses) DeleteAppWithResponse(ctx context.Context, appId AppId, params *DeleteAppParams, reqEditors ...RequestEditorFn) (*DeleteAppResponse, error) {
+	rsp, err := c.DeleteApp(ctx, appId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAppResponse(rsp)
+}
+
+// GetAppWithResponse request returning *GetAppResponse
+func (c *ClientWithResponses) GetAppWithResponse(ctx context.Context, appId AppId, params *GetAppParams, reqEditors ...RequestEditorFn) (*GetAppResponse, error) {
+	rsp, err := c.GetApp(ctx, appId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
 	return ParseGetAppResponse(rsp)
 }
 
-// GetClustersWithResponse request returning *GetClustersResponse
-func (c *ClientWithResponses) GetClustersWithResponse(ctx context.Context, params *GetClustersParams, reqEditors ...RequestEditorFn) (*GetClustersResponse, error) {
-	rsp, err := c.GetClusters(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// GetClustersWithResponse request returning *GetClustersResponse
+func (c *ClientWithResponses) GetClustersWithResponse(ctx context.Context, params *GetClustersParams, reqEditors ...RequestEditorFn) (*GetClustersResponse, error) {
+	rsp, err := c.GetClusters(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClustersResponse(rsp)
+}
+
+// GetEdgeCloudZonesWithResponse request returning *GetEdgeCloudZonesResponse
+func (c *ClientWithResponses) GetEdgeCloudZonesWithResponse(ctx context.Context, params *GetEdgeCloudZonesParams, reqEditors ...RequestEditorFn) (*GetEdgeCloudZonesResponse, error) {
+	rsp, err := c.GetEdgeCloudZones(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEdgeCloudZonesResponse(rsp)
+}
+
+// GetOperationWithResponse request returning *GetOperationResponse
+func (c *ClientWithResponses) GetOperationWithResponse(ctx context.Context, operationId OperationId, params *GetOperationParams, reqEditors ...RequestEditorFn) (*GetOperationResponse, error) {
+	rsp, err := c.GetOperation(ctx, operationId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOperationResponse(rsp)
+}
+
+// GetSubscriptionsWithResponse request returning *GetSubscriptionsResponse
+func (c *ClientWithResponses) GetSubscriptionsWithResponse(ctx context.Context, params *GetSubscriptionsParams, reqEditors ...RequestEditorFn) (*GetSubscriptionsResponse, error) {
+	rsp, err := c.GetSubscriptions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSubscriptionsResponse(rsp)
+}
+
+// CreateSubscriptionWithBodyWithResponse request with arbitrary body returning *CreateSubscriptionResponse
+func (c *ClientWithResponses) CreateSubscriptionWithBodyWithResponse(ctx context.Context, params *CreateSubscriptionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSubscriptionResponse, error) {
+	rsp, err := c.CreateSubscriptionWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSubscriptionResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSubscriptionWithResponse(ctx context.Context, params *CreateSubscriptionParams, body CreateSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSubscriptionResponse, error) {
+	rsp, err := c.CreateSubscription(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSubscriptionResponse(rsp)
+}
+
+// DeleteSubscriptionWithResponse request returning *DeleteSubscriptionResponse
+func (c *ClientWithResponses) DeleteSubscriptionWithResponse(ctx context.Context, subscriptionId SubscriptionId, params *DeleteSubscriptionParams, reqEditors ...RequestEditorFn) (*DeleteSubscriptionResponse, error) {
+	rsp, err := c.DeleteSubscription(ctx, subscriptionId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSubscriptionResponse(rsp)
+}
+
+// GetSubscriptionWithResponse request returning *GetSubscriptionResponse
+func (c *ClientWithResponses) GetSubscriptionWithResponse(ctx context.Context, subscriptionId SubscriptionId, params *GetSubscriptionParams, reqEditors ...RequestEditorFn) (*GetSubscriptionResponse, error) {
+	rsp, err := c.GetSubscription(ctx, subscriptionId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSubscriptionResponse(rsp)
+}
+
+// GetSubscriptionDeadLettersWithResponse request returning *GetSubscriptionDeadLettersResponse
+func (c *ClientWithResponses) GetSubscriptionDeadLettersWithResponse(ctx context.Context, subscriptionId SubscriptionId, params *GetSubscriptionDeadLettersParams, reqEditors ...RequestEditorFn) (*GetSubscriptionDeadLettersResponse, error) {
+	rsp, err := c.GetSubscriptionDeadLetters(ctx, subscriptionId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSubscriptionDeadLettersResponse(rsp)
+}
+
+// ParseGetAppInstanceResponse parses an HTTP response from a GetAppInstanceWithResponse call
+func ParseGetAppInstanceResponse(rsp *http.Response) (*GetAppInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAppInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AppInstanceInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateAppInstanceResponse parses an HTTP response from a CreateAppInstanceWithResponse call
+func ParseCreateAppInstanceResponse(rsp *http.Response) (*CreateAppInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateAppInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest AppInstanceInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAppInstanceResponse parses an HTTP response from a DeleteAppInstanceWithResponse call
+func ParseDeleteAppInstanceResponse(rsp *http.Response) (*DeleteAppInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAppInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateAppInstanceResponse parses an HTTP response from a UpdateAppInstanceWithResponse call
+func ParseUpdateAppInstanceResponse(rsp *http.Response) (*UpdateAppInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateAppInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest OperationStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReplaceAppInstanceResponse parses an HTTP response from a ReplaceAppInstanceWithResponse call
+func ParseReplaceAppInstanceResponse(rsp *http.Response) (*ReplaceAppInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReplaceAppInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest OperationStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAppsResponse parses an HTTP response from a GetAppsWithResponse call
+func ParseGetAppsResponse(rsp *http.Response) (*GetAppsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAppsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AppManifest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSubmitAppResponse parses an HTTP response from a SubmitAppWithResponse call
+func ParseSubmitAppResponse(rsp *http.Response) (*SubmitAppResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SubmitAppResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SubmittedApp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAppResponse parses an HTTP response from a DeleteAppWithResponse call
+func ParseDeleteAppResponse(rsp *http.Response) (*DeleteAppResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAppResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAppResponse parses an HTTP response from a GetAppWithResponse call
+func ParseGetAppResponse(rsp *http.Response) (*GetAppResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAppResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// AppManifest Application information and requirements provided by the
+			// Application Provider
+			AppManifest *AppManifest `json:"appManifest,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClustersResponse parses an HTTP response from a GetClustersWithResponse call
+func ParseGetClustersResponse(rsp *http.Response) (*GetClustersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClustersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ClusterInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEdgeCloudZonesResponse parses an HTTP response from a GetEdgeCloudZonesWithResponse call
+func ParseGetEdgeCloudZonesResponse(rsp *http.Response) (*GetEdgeCloudZonesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEdgeCloudZonesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EdgeCloudZones
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOperationResponse parses an HTTP response from a GetOperationWithResponse call
+func ParseGetOperationResponse(rsp *http.Response) (*GetOperationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOperationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OperationStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSubscriptionsResponse parses an HTTP response from a GetSubscriptionsWithResponse call
+func ParseGetSubscriptionsResponse(rsp *http.Response) (*GetSubscriptionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSubscriptionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Subscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSubscriptionResponse parses an HTTP response from a CreateSubscriptionWithResponse call
+func ParseCreateSubscriptionResponse(rsp *http.Response) (*CreateSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Subscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSubscriptionResponse parses an HTTP response from a DeleteSubscriptionWithResponse call
+func ParseDeleteSubscriptionResponse(rsp *http.Response) (*DeleteSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSubscriptionResponse parses an HTTP response from a GetSubscriptionWithResponse call
+func ParseGetSubscriptionResponse(rsp *http.Response) (*GetSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Subscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSubscriptionDeadLettersResponse parses an HTTP response from a GetSubscriptionDeadLettersWithResponse call
+func ParseGetSubscriptionDeadLettersResponse(rsp *http.Response) (*GetSubscriptionDeadLettersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSubscriptionDeadLettersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DeadLetter
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Retrieve the information of Application Instances for a given App
+	// (GET /appinstances)
+	GetAppInstance(ctx echo.Context, params GetAppInstanceParams) error
+	// Instantiation of an Application
+	// (POST /appinstances)
+	CreateAppInstance(ctx echo.Context, params CreateAppInstanceParams) error
+	// Terminate an Application Instance
+	// (DELETE /appinstances/{appInstanceId})
+	DeleteAppInstance(ctx echo.Context, appInstanceId AppInstanceId, params DeleteAppInstanceParams) error
+	// Update an Application Instance
+	// (PATCH /appinstances/{appInstanceId})
+	UpdateAppInstance(ctx echo.Context, appInstanceId AppInstanceId, params UpdateAppInstanceParams) error
+	// Replace the configuration of an Application Instance
+	// (PUT /appinstances/{appInstanceId})
+	ReplaceAppInstance(ctx echo.Context, appInstanceId AppInstanceId, params ReplaceAppInstanceParams) error
+	// Retrieve a list of existing Applications
+	// (GET /apps)
+	GetApps(ctx echo.Context, params GetAppsParams) error
+	// Submit application metadata to the Edge Cloud Provider.
+	// (POST /apps)
+	SubmitApp(ctx echo.Context, params SubmitAppParams) error
+	// Delete an Application from an Edge Cloud Provider
+	// (DELETE /apps/{appId})
+	DeleteApp(ctx echo.Context, appId AppId, params DeleteAppParams) error
+	// Retrieve the information of an Application
+	// (GET /apps/{appId})
+	GetApp(ctx echo.Context, appId AppId, params GetAppParams) error
+	// Retrieve a list of the available clusters
+	// (GET /clusters)
+	GetClusters(ctx echo.Context, params GetClustersParams) error
+	// Retrieve a list of the operators Edge Cloud Zones and their status
+	// (GET /edge-cloud-zones)
+	GetEdgeCloudZones(ctx echo.Context, params GetEdgeCloudZonesParams) error
+	// Retrieve the status of an asynchronous operation
+	// (GET /operations/{operationId})
+	GetOperation(ctx echo.Context, operationId OperationId, params GetOperationParams) error
+	// Retrieve a list of subscriptions
+	// (GET /subscriptions)
+	GetSubscriptions(ctx echo.Context, params GetSubscriptionsParams) error
+	// Subscribe to Application Instance and Cluster events
+	// (POST /subscriptions)
+	CreateSubscription(ctx echo.Context, params CreateSubscriptionParams) error
+	// Delete a subscription
+	// (DELETE /subscriptions/{subscriptionId})
+	DeleteSubscription(ctx echo.Context, subscriptionId SubscriptionId, params DeleteSubscriptionParams) error
+	// Retrieve a subscription
+	// (GET /subscriptions/{subscriptionId})
+	GetSubscription(ctx echo.Context, subscriptionId SubscriptionId, params GetSubscriptionParams) error
+	// Retrieve undelivered notifications
+	// (GET /subscriptions/{subscriptionId}/dead-letters)
+	GetSubscriptionDeadLetters(ctx echo.Context, subscriptionId SubscriptionId, params GetSubscriptionDeadLettersParams) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetAppInstance converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAppInstance(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OpenIdScopes, []string{"edge-application-management:instances:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAppInstanceParams
+	// ------------- Optional query parameter "appId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "appId", ctx.QueryParams(), &params.AppId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appId: %s", err))
+	}
+
+	// ------------- Optional query parameter "appInstanceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "appInstanceId", ctx.QueryParams(), &params.AppInstanceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appInstanceId: %s", err))
+	}
+
+	// ------------- Optional query parameter "region" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "region", ctx.QueryParams(), &params.Region)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter region: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "x-correlator" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
+		var XCorrelator XCorrelator
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
+		}
+
+		params.XCorrelator = &XCorrelator
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAppInstance(ctx, params)
+	return err
+}
+
+// CreateAppInstance converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateAppInstance(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OpenIdScopes, []string{"edge-application-management:instances:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateAppInstanceParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "x-correlator" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
+		var XCorrelator XCorrelator
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
+		}
+
+		params.XCorrelator = &XCorrelator
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateAppInstance(ctx, params)
+	return err
+}
+
+// DeleteAppInstance converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAppInstance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appInstanceId" -------------
+	var appInstanceId AppInstanceId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appInstanceId", ctx.Param("appInstanceId"), &appInstanceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appInstanceId: %s", err))
+	}
+
+	ctx.Set(OpenIdScopes, []string{"edge-application-management:instances:delete"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteAppInstanceParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "x-correlator" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
+		var XCorrelator XCorrelator
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
+		}
+
+		params.XCorrelator = &XCorrelator
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAppInstance(ctx, appInstanceId, params)
+	return err
+}
+
+// UpdateAppInstance converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateAppInstance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appInstanceId" -------------
+	var appInstanceId AppInstanceId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appInstanceId", ctx.Param("appInstanceId"), &appInstanceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appInstanceId: %s", err))
+	}
+
+	ctx.Set(OpenIdScopes, []string{"edge-application-management:instances:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateAppInstanceParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "x-correlator" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
+		var XCorrelator XCorrelator
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
+		}
+
+		params.XCorrelator = &XCorrelator
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateAppInstance(ctx, appInstanceId, params)
+	return err
+}
+
+// ReplaceAppInstance converts echo context to params.
+func (w *ServerInterfaceWrapper) ReplaceAppInstance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appInstanceId" -------------
+	var appInstanceId AppInstanceId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appInstanceId", ctx.Param("appInstanceId"), &appInstanceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appInstanceId: %s", err))
+	}
+
+	ctx.Set(OpenIdScopes, []string{"edge-application-management:instances:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReplaceAppInstanceParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "x-correlator" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
+		var XCorrelator XCorrelator
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
+		}
+
+		params.XCorrelator = &XCorrelator
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReplaceAppInstance(ctx, appInstanceId, params)
+	return err
+}
+
+// GetApps converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApps(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OpenIdScopes, []string{"edge-application-management:apps:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAppsParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "x-correlator" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
+		var XCorrelator XCorrelator
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
+		}
+
+		params.XCorrelator = &XCorrelator
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetApps(ctx, params)
+	return err
+}
+
+// SubmitApp converts echo context to params.
+func (w *ServerInterfaceWrapper) SubmitApp(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OpenIdScopes, []string{"edge-application-management:apps:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SubmitAppParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "x-correlator" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
+		var XCorrelator XCorrelator
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
+		}
+
+		params.XCorrelator = &XCorrelator
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SubmitApp(ctx, params)
+	return err
+}
+
+// DeleteApp converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteApp(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appId" -------------
+	var appId AppId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", ctx.Param("appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appId: %s", err))
+	}
+
+	ctx.Set(OpenIdScopes, []string{"edge-application-management:apps:delete"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteAppParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "x-correlator" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
+		var XCorrelator XCorrelator
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
+		}
+
+		params.XCorrelator = &XCorrelator
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteApp(ctx, appId, params)
+	return err
+}
+
+// GetApp converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApp(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appId" -------------
+	var appId AppId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", ctx.Param("appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appId: %s", err))
+	}
+
+	ctx.Set(OpenIdScopes, []string{"edge-application-management:apps:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAppParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "x-correlator" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
+		var XCorrelator XCorrelator
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
+		}
+
+		params.XCorrelator = &XCorrelator
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetApp(ctx, appId, params)
+	return err
+}
+
+// GetClusters converts echo context to params.
+func (w *ServerInterfaceWrapper) GetClusters(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OpenIdScopes, []string{"edge-application-management:clusters:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetClustersParams
+	// ------------- Optional query parameter "region" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "region", ctx.QueryParams(), &params.Region)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter region: %s", err))
+	}
+
+	// ------------- Optional query parameter "clusterRef" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "clusterRef", ctx.QueryParams(), &params.ClusterRef)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clusterRef: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "x-correlator" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
+		var XCorrelator XCorrelator
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
+		}
+
+		params.XCorrelator = &XCorrelator
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetClusters(ctx, params)
+	return err
+}
+
+// GetEdgeCloudZones converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEdgeCloudZones(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OpenIdScopes, []string{"edge-application-management:edge-cloud-zones:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEdgeCloudZonesParams
+	// ------------- Optional query parameter "region" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "region", ctx.QueryParams(), &params.Region)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter region: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "x-correlator" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
+		var XCorrelator XCorrelator
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
+		}
+
+		params.XCorrelator = &XCorrelator
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEdgeCloudZones(ctx, params)
+	return err
+}
+
+// GetOperation converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOperation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "operationId" -------------
+	var operationId OperationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "operationId", ctx.Param("operationId"), &operationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter operationId: %s", err))
+	}
+
+	ctx.Set(OpenIdScopes, []string{"edge-application-management:instances:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOperationParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "x-correlator" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
+		var XCorrelator XCorrelator
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
+		}
+
+		params.XCorrelator = &XCorrelator
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOperation(ctx, operationId, params)
+	return err
+}
+
+// GetSubscriptions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSubscriptions(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OpenIdScopes, []string{"edge-application-management:subscriptions:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSubscriptionsParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "x-correlator" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
+		var XCorrelator XCorrelator
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
+		}
+
+		params.XCorrelator = &XCorrelator
 	}
-	return ParseGetClustersResponse(rsp)
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSubscriptions(ctx, params)
+	return err
 }
 
-// GetEdgeCloudZonesWithResponse request returning *GetEdgeCloudZonesResponse
-func (c *ClientWithResponses) GetEdgeCloudZonesWithResponse(ctx context.Context, params *GetEdgeCloudZonesParams, reqEditors ...RequestEditorFn) (*GetEdgeCloudZonesResponse, error) {
-	rsp, err := c.GetEdgeCloudZones(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// CreateSubscription converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateSubscription(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OpenIdScopes, []string{"edge-application-management:subscriptions:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateSubscriptionParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "x-correlator" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
+		var XCorrelator XCorrelator
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
+		}
+
+		params.XCorrelator = &XCorrelator
 	}
-	return ParseGetEdgeCloudZonesResponse(rsp)
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateSubscription(ctx, params)
+	return err
 }
 
-// ParseGetAppInstanceResponse parses an HTTP response from a GetAppInstanceWithResponse call
-func ParseGetAppInstanceResponse(rsp *http.Response) (*GetAppInstanceResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// DeleteSubscription converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteSubscription(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId SubscriptionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", ctx.Param("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter subscriptionId: %s", err))
 	}
 
-	response := &GetAppInstanceResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(OpenIdScopes, []string{"edge-application-management:subscriptions:delete"})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []AppInstanceInfo
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteSubscriptionParams
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "x-correlator" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
+		var XCorrelator XCorrelator
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
 		}
-		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest N403
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
 		}
-		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+		params.XCorrelator = &XCorrelator
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteSubscription(ctx, subscriptionId, params)
+	return err
+}
+
+// GetSubscription converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSubscription(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId SubscriptionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", ctx.Param("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter subscriptionId: %s", err))
+	}
+
+	ctx.Set(OpenIdScopes, []string{"edge-application-management:subscriptions:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSubscriptionParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "x-correlator" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
+		var XCorrelator XCorrelator
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
 		}
-		response.JSON500 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
-		var dest N503
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
 		}
-		response.JSON503 = &dest
 
+		params.XCorrelator = &XCorrelator
 	}
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSubscription(ctx, subscriptionId, params)
+	return err
 }
 
-// ParseCreateAppInstanceResponse parses an HTTP response from a CreateAppInstanceWithResponse call
-func ParseCreateAppInstanceResponse(rsp *http.Response) (*CreateAppInstanceResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetSubscriptionDeadLetters converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSubscriptionDeadLetters(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId SubscriptionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", ctx.Param("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter subscriptionId: %s", err))
 	}
 
-	response := &CreateAppInstanceResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(OpenIdScopes, []string{"edge-application-management:subscriptions:read"})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest AppInstanceInfo
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSubscriptionDeadLettersParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "x-correlator" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
+		var XCorrelator XCorrelator
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
 		}
-		response.JSON202 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
 		}
-		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+		params.XCorrelator = &XCorrelator
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSubscriptionDeadLetters(ctx, subscriptionId, params)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/appinstances", wrapper.GetAppInstance)
+	router.POST(baseURL+"/appinstances", wrapper.CreateAppInstance)
+	router.DELETE(baseURL+"/appinstances/:appInstanceId", wrapper.DeleteAppInstance)
+	router.PATCH(baseURL+"/appinstances/:appInstanceId", wrapper.UpdateAppInstance)
+	router.PUT(baseURL+"/appinstances/:appInstanceId", wrapper.ReplaceAppInstance)
+	router.GET(baseURL+"/apps", wrapper.GetApps)
+	router.POST(baseURL+"/apps", wrapper.SubmitApp)
+	router.DELETE(baseURL+"/apps/:appId", wrapper.DeleteApp)
+	router.GET(baseURL+"/apps/:appId", wrapper.GetApp)
+	router.GET(baseURL+"/clusters", wrapper.GetClusters)
+	router.GET(baseURL+"/edge-cloud-zones", wrapper.GetEdgeCloudZones)
+	router.GET(baseURL+"/operations/:operationId", wrapper.GetOperation)
+	router.GET(baseURL+"/subscriptions", wrapper.GetSubscriptions)
+	router.POST(baseURL+"/subscriptions", wrapper.CreateSubscription)
+	router.DELETE(baseURL+"/subscriptions/:subscriptionId", wrapper.DeleteSubscription)
+	router.GET(baseURL+"/subscriptions/:subscriptionId", wrapper.GetSubscription)
+	router.GET(baseURL+"/subscriptions/:subscriptionId/dead-letters", wrapper.GetSubscriptionDeadLetters)
+
+}
+
+type N400ResponseHeaders struct {
+	XCorrelator openapi_types.UUID
+}
+type N400JSONResponse struct {
+	Body ErrorInfo
+
+	Headers N400ResponseHeaders
+}
+
+type N401ResponseHeaders struct {
+	XCorrelator openapi_types.UUID
+}
+type N401JSONResponse struct {
+	Body ErrorInfo
+
+	Headers N401ResponseHeaders
+}
+
+type N403ResponseHeaders struct {
+	XCorrelator openapi_types.UUID
+}
+type N403JSONResponse struct {
+	Body ErrorInfo
+
+	Headers N403ResponseHeaders
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest N403
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+type N404ResponseHeaders struct {
+	XCorrelator openapi_types.UUID
+}
+type N404JSONResponse struct {
+	Body ErrorInfo
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ErrorInfo
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+	Headers N404ResponseHeaders
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type N500ResponseHeaders struct {
+	XCorrelator openapi_types.UUID
+}
+type N500JSONResponse struct {
+	Body ErrorInfo
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
-		var dest N501
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON501 = &dest
+	Headers N500ResponseHeaders
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
-		var dest N503
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON503 = &dest
+type N501ResponseHeaders struct {
+	XCorrelator openapi_types.UUID
+}
+type N501JSONResponse struct {
+	Body ErrorInfo
 
-	}
+	Headers N501ResponseHeaders
+}
 
-	return response, nil
+type N503ResponseHeaders struct {
+	XCorrelator openapi_types.UUID
 }
+type N503JSONResponse struct {
+	Body ErrorInfo
 
-// ParseDeleteAppInstanceResponse parses an HTTP response from a DeleteAppInstanceWithResponse call
-func ParseDeleteAppInstanceResponse(rsp *http.Response) (*DeleteAppInstanceResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	Headers N503ResponseHeaders
+}
 
-	response := &DeleteAppInstanceResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+type GetAppInstanceRequestObject struct {
+	Params GetAppInstanceParams
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+type GetAppInstanceResponseObject interface {
+	VisitGetAppInstanceResponse(w http.ResponseWriter) error
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+type GetAppInstance200ResponseHeaders struct {
+	XCorrelator openapi_types.UUID
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest N403
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+type GetAppInstance200JSONResponse struct {
+	Body    []AppInstanceInfo
+	Headers GetAppInstance200ResponseHeaders
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+func (response GetAppInstance200JSONResponse) VisitGetAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(200)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
-		var dest N503
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON503 = &dest
+type GetAppInstance401JSONResponse struct{ N401JSONResponse }
 
-	}
+func (response GetAppInstance401JSONResponse) VisitGetAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(401)
 
-	return response, nil
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-// ParseGetAppsResponse parses an HTTP response from a GetAppsWithResponse call
-func ParseGetAppsResponse(rsp *http.Response) (*GetAppsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &GetAppsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+type GetAppInstance403JSONResponse struct{ N403JSONResponse }
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []AppManifest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+func (response GetAppInstance403JSONResponse) VisitGetAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(403)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest N403
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+type GetAppInstance404JSONResponse struct{ N404JSONResponse }
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+func (response GetAppInstance404JSONResponse) VisitGetAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(404)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
-		var dest N503
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON503 = &dest
+type GetAppInstance500JSONResponse struct{ N500JSONResponse }
 
-	}
+func (response GetAppInstance500JSONResponse) VisitGetAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(500)
 
-	return response, nil
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-// ParseSubmitAppResponse parses an HTTP response from a SubmitAppWithResponse call
-func ParseSubmitAppResponse(rsp *http.Response) (*SubmitAppResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type GetAppInstance503JSONResponse struct{ N503JSONResponse }
 
-	response := &SubmitAppResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response GetAppInstance503JSONResponse) VisitGetAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(503)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest SubmittedApp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+type CreateAppInstanceRequestObject struct {
+	Params CreateAppInstanceParams
+	Body   *CreateAppInstanceJSONRequestBody
+}
+
+type CreateAppInstanceResponseObject interface {
+	VisitCreateAppInstanceResponse(w http.ResponseWriter) error
+}
+
+type CreateAppInstance202ResponseHeaders struct {
+	Location    string
+	XCorrelator openapi_types.UUID
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+type CreateAppInstance202JSONResponse struct {
+	Body    AppInstanceInfo
+	Headers CreateAppInstance202ResponseHeaders
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest N403
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+func (response CreateAppInstance202JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(202)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ErrorInfo
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type CreateAppInstance400JSONResponse struct{ N400JSONResponse }
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
-		var dest N501
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON501 = &dest
+func (response CreateAppInstance400JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(400)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
-		var dest N503
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON503 = &dest
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	}
+type CreateAppInstance401JSONResponse struct{ N401JSONResponse }
 
-	return response, nil
+func (response CreateAppInstance401JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-// ParseDeleteAppResponse parses an HTTP response from a DeleteAppWithResponse call
-func ParseDeleteAppResponse(rsp *http.Response) (*DeleteAppResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type CreateAppInstance403JSONResponse struct{ N403JSONResponse }
 
-	response := &DeleteAppResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response CreateAppInstance403JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(403)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+type CreateAppInstance409JSONResponse ErrorInfo
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest N403
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+func (response CreateAppInstance409JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ErrorInfo
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+type CreateAppInstance500JSONResponse struct{ N500JSONResponse }
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+func (response CreateAppInstance500JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(500)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
-		var dest N503
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON503 = &dest
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	}
+type CreateAppInstance501JSONResponse struct{ N501JSONResponse }
 
-	return response, nil
+func (response CreateAppInstance501JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-// ParseGetAppResponse parses an HTTP response from a GetAppWithResponse call
-func ParseGetAppResponse(rsp *http.Response) (*GetAppResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type CreateAppInstance503JSONResponse struct{ N503JSONResponse }
 
-	response := &GetAppResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response CreateAppInstance503JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(503)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			// AppManifest Application information and requirements provided by the
-			// Application Provider
-			AppManifest *AppManifest `json:"appManifest,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+type DeleteAppInstanceRequestObject struct {
+	AppInstanceId AppInstanceId `json:"appInstanceId"`
+	Params        DeleteAppInstanceParams
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest N403
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+type DeleteAppInstanceResponseObject interface {
+	VisitDeleteAppInstanceResponse(w http.ResponseWriter) error
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+type DeleteAppInstance202ResponseHeaders struct {
+	XCorrelator openapi_types.UUID
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type DeleteAppInstance202Response struct {
+	Headers DeleteAppInstance202ResponseHeaders
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
-		var dest N503
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON503 = &dest
+func (response DeleteAppInstance202Response) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(202)
+	return nil
+}
 
-	}
+type DeleteAppInstance204Response struct {
+}
 
-	return response, nil
+func (response DeleteAppInstance204Response) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-// ParseGetClustersResponse parses an HTTP response from a GetClustersWithResponse call
-func ParseGetClustersResponse(rsp *http.Response) (*GetClustersResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type DeleteAppInstance400JSONResponse struct{ N400JSONResponse }
 
-	response := &GetClustersResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response DeleteAppInstance400JSONResponse) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(400)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []ClusterInfo
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+type DeleteAppInstance401JSONResponse struct{ N401JSONResponse }
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest N403
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+func (response DeleteAppInstance401JSONResponse) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(401)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type DeleteAppInstance403JSONResponse struct{ N403JSONResponse }
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
-		var dest N503
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON503 = &dest
+func (response DeleteAppInstance403JSONResponse) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(403)
 
-	}
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	return response, nil
+type DeleteAppInstance404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteAppInstance404JSONResponse) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-// ParseGetEdgeCloudZonesResponse parses an HTTP response from a GetEdgeCloudZonesWithResponse call
-func ParseGetEdgeCloudZonesResponse(rsp *http.Response) (*GetEdgeCloudZonesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type DeleteAppInstance500JSONResponse struct{ N500JSONResponse }
 
-	response := &GetEdgeCloudZonesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response DeleteAppInstance500JSONResponse) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(500)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EdgeCloudZones
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+type DeleteAppInstance503JSONResponse struct{ N503JSONResponse }
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest N403
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+func (response DeleteAppInstance503JSONResponse) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(503)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type UpdateAppInstanceRequestObject struct {
+	AppInstanceId AppInstanceId `json:"appInstanceId"`
+	Params        UpdateAppInstanceParams
+	Body          *UpdateAppInstanceJSONRequestBody
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
-		var dest N503
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON503 = &dest
+type UpdateAppInstanceResponseObject interface {
+	VisitUpdateAppInstanceResponse(w http.ResponseWriter) error
+}
 
-	}
+type UpdateAppInstance202ResponseHeaders struct {
+	Location    string
+	XCorrelator openapi_types.UUID
+}
 
-	return response, nil
+type UpdateAppInstance202JSONResponse struct {
+	Body    OperationStatus
+	Headers UpdateAppInstance202ResponseHeaders
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Retrieve the information of Application Instances for a given App
-	// (GET /appinstances)
-	GetAppInstance(ctx echo.Context, params GetAppInstanceParams) error
-	// Instantiation of an Application
-	// (POST /appinstances)
-	CreateAppInstance(ctx echo.Context, params CreateAppInstanceParams) error
-	// Terminate an Application Instance
-	// (DELETE /appinstances/{appInstanceId})
-	DeleteAppInstance(ctx echo.Context, appInstanceId AppInstanceId, params DeleteAppInstanceParams) error
-	// Retrieve a list of existing Applications
-	// (GET /apps)
-	GetApps(ctx echo.Context, params GetAppsParams) error
-	// Submit application metadata to the Edge Cloud Provider.
-	// (POST /apps)
-	SubmitApp(ctx echo.Context, params SubmitAppParams) error
-	// Delete an Application from an Edge Cloud Provider
-	// (DELETE /apps/{appId})
-	DeleteApp(ctx echo.Context, appId AppId, params DeleteAppParams) error
-	// Retrieve the information of an Application
-	// (GET /apps/{appId})
-	GetApp(ctx echo.Context, appId AppId, params GetAppParams) error
-	// Retrieve a list of the available clusters
-	// (GET /clusters)
-	GetClusters(ctx echo.Context, params GetClustersParams) error
-	// Retrieve a list of the operators Edge Cloud Zones and their status
-	// (GET /edge-cloud-zones)
-	GetEdgeCloudZones(ctx echo.Context, params GetEdgeCloudZonesParams) error
+func (response UpdateAppInstance202JSONResponse) VisitUpdateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+type UpdateAppInstance400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateAppInstance400JSONResponse) VisitUpdateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-// GetAppInstance converts echo context to params.
-func (w *ServerInterfaceWrapper) GetAppInstance(ctx echo.Context) error {
-	var err error
+type UpdateAppInstance401JSONResponse struct{ N401JSONResponse }
 
-	ctx.Set(OpenIdScopes, []string{"edge-application-management:instances:read"})
+func (response UpdateAppInstance401JSONResponse) VisitUpdateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(401)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAppInstanceParams
-	// ------------- Optional query parameter "appId" -------------
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "appId", ctx.QueryParams(), &params.AppId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appId: %s", err))
-	}
+type UpdateAppInstance403JSONResponse struct{ N403JSONResponse }
 
-	// ------------- Optional query parameter "appInstanceId" -------------
+func (response UpdateAppInstance403JSONResponse) VisitUpdateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(403)
 
-	err = runtime.BindQueryParameter("form", true, false, "appInstanceId", ctx.QueryParams(), &params.AppInstanceId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appInstanceId: %s", err))
-	}
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	// ------------- Optional query parameter "region" -------------
+type UpdateAppInstance404JSONResponse struct{ N404JSONResponse }
 
-	err = runtime.BindQueryParameter("form", true, false, "region", ctx.QueryParams(), &params.Region)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter region: %s", err))
-	}
+func (response UpdateAppInstance404JSONResponse) VisitUpdateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(404)
 
-	headers := ctx.Request().Header
-	// ------------- Optional header parameter "x-correlator" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
-		var XCorrelator XCorrelator
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
-		}
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
-		}
+type UpdateAppInstance500JSONResponse struct{ N500JSONResponse }
 
-		params.XCorrelator = &XCorrelator
-	}
+func (response UpdateAppInstance500JSONResponse) VisitUpdateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(500)
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetAppInstance(ctx, params)
-	return err
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-// CreateAppInstance converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateAppInstance(ctx echo.Context) error {
-	var err error
+type UpdateAppInstance503JSONResponse struct{ N503JSONResponse }
 
-	ctx.Set(OpenIdScopes, []string{"edge-application-management:instances:write"})
+func (response UpdateAppInstance503JSONResponse) VisitUpdateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(503)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params CreateAppInstanceParams
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	headers := ctx.Request().Header
-	// ------------- Optional header parameter "x-correlator" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
-		var XCorrelator XCorrelator
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
-		}
+type ReplaceAppInstanceRequestObject struct {
+	AppInstanceId AppInstanceId `json:"appInstanceId"`
+	Params        ReplaceAppInstanceParams
+	Body          *ReplaceAppInstanceJSONRequestBody
+}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
-		}
+type ReplaceAppInstanceResponseObject interface {
+	VisitReplaceAppInstanceResponse(w http.ResponseWriter) error
+}
 
-		params.XCorrelator = &XCorrelator
-	}
+type ReplaceAppInstance202ResponseHeaders struct {
+	Location    string
+	XCorrelator openapi_types.UUID
+}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateAppInstance(ctx, params)
-	return err
+type ReplaceAppInstance202JSONResponse struct {
+	Body    OperationStatus
+	Headers ReplaceAppInstance202ResponseHeaders
 }
 
-// DeleteAppInstance converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteAppInstance(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "appInstanceId" -------------
-	var appInstanceId AppInstanceId
+func (response ReplaceAppInstance202JSONResponse) VisitReplaceAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(202)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "appInstanceId", ctx.Param("appInstanceId"), &appInstanceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appInstanceId: %s", err))
-	}
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	ctx.Set(OpenIdScopes, []string{"edge-application-management:instances:delete"})
+type ReplaceAppInstance400JSONResponse struct{ N400JSONResponse }
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteAppInstanceParams
+func (response ReplaceAppInstance400JSONResponse) VisitReplaceAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(400)
 
-	headers := ctx.Request().Header
-	// ------------- Optional header parameter "x-correlator" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
-		var XCorrelator XCorrelator
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
-		}
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
-		}
+type ReplaceAppInstance401JSONResponse struct{ N401JSONResponse }
 
-		params.XCorrelator = &XCorrelator
-	}
+func (response ReplaceAppInstance401JSONResponse) VisitReplaceAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(401)
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteAppInstance(ctx, appInstanceId, params)
-	return err
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-// GetApps converts echo context to params.
-func (w *ServerInterfaceWrapper) GetApps(ctx echo.Context) error {
-	var err error
+type ReplaceAppInstance403JSONResponse struct{ N403JSONResponse }
 
-	ctx.Set(OpenIdScopes, []string{"edge-application-management:apps:read"})
+func (response ReplaceAppInstance403JSONResponse) VisitReplaceAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(403)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAppsParams
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	headers := ctx.Request().Header
-	// ------------- Optional header parameter "x-correlator" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
-		var XCorrelator XCorrelator
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
-		}
+type ReplaceAppInstance404JSONResponse struct{ N404JSONResponse }
 
-		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
-		}
+func (response ReplaceAppInstance404JSONResponse) VisitReplaceAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(404)
 
-		params.XCorrelator = &XCorrelator
-	}
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetApps(ctx, params)
-	return err
+type ReplaceAppInstance500JSONResponse struct{ N500JSONResponse }
+
+func (response ReplaceAppInstance500JSONResponse) VisitReplaceAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-// SubmitApp converts echo context to params.
-func (w *ServerInterfaceWrapper) SubmitApp(ctx echo.Context) error {
-	var err error
+type ReplaceAppInstance503JSONResponse struct{ N503JSONResponse }
 
-	ctx.Set(OpenIdScopes, []string{"edge-application-management:apps:write"})
+func (response ReplaceAppInstance503JSONResponse) VisitReplaceAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(503)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params SubmitAppParams
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	headers := ctx.Request().Header
-	// ------------- Optional header parameter "x-correlator" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
-		var XCorrelator XCorrelator
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
-		}
+type GetAppsRequestObject struct {
+	Params GetAppsParams
+}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
-		}
+type GetAppsResponseObject interface {
+	VisitGetAppsResponse(w http.ResponseWriter) error
+}
 
-		params.XCorrelator = &XCorrelator
-	}
+type GetApps200ResponseHeaders struct {
+	XCorrelator openapi_types.UUID
+}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SubmitApp(ctx, params)
-	return err
+type GetApps200JSONResponse struct {
+	Body    []AppManifest
+	Headers GetApps200ResponseHeaders
 }
 
-// DeleteApp converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteApp(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "appId" -------------
-	var appId AppId
+func (response GetApps200JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(200)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "appId", ctx.Param("appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appId: %s", err))
-	}
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	ctx.Set(OpenIdScopes, []string{"edge-application-management:apps:delete"})
+type GetApps401JSONResponse struct{ N401JSONResponse }
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteAppParams
+func (response GetApps401JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(401)
 
-	headers := ctx.Request().Header
-	// ------------- Optional header parameter "x-correlator" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
-		var XCorrelator XCorrelator
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
-		}
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
-		}
+type GetApps403JSONResponse struct{ N403JSONResponse }
 
-		params.XCorrelator = &XCorrelator
-	}
+func (response GetApps403JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(403)
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteApp(ctx, appId, params)
-	return err
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-// GetApp converts echo context to params.
-func (w *ServerInterfaceWrapper) GetApp(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "appId" -------------
-	var appId AppId
+type GetApps404JSONResponse struct{ N404JSONResponse }
 
-	err = runtime.BindStyledParameterWithOptions("simple", "appId", ctx.Param("appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appId: %s", err))
-	}
+func (response GetApps404JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(404)
 
-	ctx.Set(OpenIdScopes, []string{"edge-application-management:apps:read"})
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAppParams
+type GetApps500JSONResponse struct{ N500JSONResponse }
 
-	headers := ctx.Request().Header
-	// ------------- Optional header parameter "x-correlator" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
-		var XCorrelator XCorrelator
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
-		}
+func (response GetApps500JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(500)
 
-		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
-		}
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-		params.XCorrelator = &XCorrelator
-	}
+type GetApps503JSONResponse struct{ N503JSONResponse }
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetApp(ctx, appId, params)
-	return err
+func (response GetApps503JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-// GetClusters converts echo context to params.
-func (w *ServerInterfaceWrapper) GetClusters(ctx echo.Context) error {
-	var err error
+type SubmitAppRequestObject struct {
+	Params SubmitAppParams
+	Body   *SubmitAppJSONRequestBody
+}
 
-	ctx.Set(OpenIdScopes, []string{"edge-application-management:clusters:read"})
+type SubmitAppResponseObject interface {
+	VisitSubmitAppResponse(w http.ResponseWriter) error
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetClustersParams
-	// ------------- Optional query parameter "region" -------------
+type SubmitApp201ResponseHeaders struct {
+	XCorrelator openapi_types.UUID
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "region", ctx.QueryParams(), &params.Region)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter region: %s", err))
-	}
+type SubmitApp201JSONResponse struct {
+	Body    SubmittedApp
+	Headers SubmitApp201ResponseHeaders
+}
+
+func (response SubmitApp201JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	// ------------- Optional query parameter "clusterRef" -------------
+type SubmitApp400JSONResponse struct{ N400JSONResponse }
 
-	err = runtime.BindQueryParameter("form", true, false, "clusterRef", ctx.QueryParams(), &params.ClusterRef)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clusterRef: %s", err))
-	}
+func (response SubmitApp400JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(400)
 
-	headers := ctx.Request().Header
-	// ------------- Optional header parameter "x-correlator" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
-		var XCorrelator XCorrelator
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
-		}
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
-		}
+type SubmitApp401JSONResponse struct{ N401JSONResponse }
 
-		params.XCorrelator = &XCorrelator
-	}
+func (response SubmitApp401JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(401)
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetClusters(ctx, params)
-	return err
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-// GetEdgeCloudZones converts echo context to params.
-func (w *ServerInterfaceWrapper) GetEdgeCloudZones(ctx echo.Context) error {
-	var err error
-
-	ctx.Set(OpenIdScopes, []string{"edge-application-management:edge-cloud-zones:read"})
+type SubmitApp403JSONResponse struct{ N403JSONResponse }
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetEdgeCloudZonesParams
-	// ------------- Optional query parameter "region" -------------
+func (response SubmitApp403JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(403)
 
-	err = runtime.BindQueryParameter("form", true, false, "region", ctx.QueryParams(), &params.Region)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter region: %s", err))
-	}
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	// ------------- Optional query parameter "status" -------------
+type SubmitApp409JSONResponse ErrorInfo
 
-	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
-	}
+func (response SubmitApp409JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
 
-	headers := ctx.Request().Header
-	// ------------- Optional header parameter "x-correlator" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
-		var XCorrelator XCorrelator
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
-		}
+	return json.NewEncoder(w).Encode(response)
+}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
-		}
+type SubmitApp500JSONResponse struct{ N500JSONResponse }
 
-		params.XCorrelator = &XCorrelator
-	}
+func (response SubmitApp500JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(500)
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetEdgeCloudZones(ctx, params)
-	return err
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-}
+type SubmitApp501JSONResponse struct{ N501JSONResponse }
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
-}
+func (response SubmitApp501JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(501)
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
-	}
+type SubmitApp503JSONResponse struct{ N503JSONResponse }
 
-	router.GET(baseURL+"/appinstances", wrapper.GetAppInstance)
-	router.POST(baseURL+"/appinstances", wrapper.CreateAppInstance)
-	router.DELETE(baseURL+"/appinstances/:appInstanceId", wrapper.DeleteAppInstance)
-	router.GET(baseURL+"/apps", wrapper.GetApps)
-	router.POST(baseURL+"/apps", wrapper.SubmitApp)
-	router.DELETE(baseURL+"/apps/:appId", wrapper.DeleteApp)
-	router.GET(baseURL+"/apps/:appId", wrapper.GetApp)
-	router.GET(baseURL+"/clusters", wrapper.GetClusters)
-	router.GET(baseURL+"/edge-cloud-zones", wrapper.GetEdgeCloudZones)
+func (response SubmitApp503JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(503)
 
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type N400ResponseHeaders struct {
-	XCorrelator openapi_types.UUID
+type DeleteAppRequestObject struct {
+	AppId  AppId `json:"appId"`
+	Params DeleteAppParams
 }
-type N400JSONResponse struct {
-	Body ErrorInfo
 
-	Headers N400ResponseHeaders
+type DeleteAppResponseObject interface {
+	VisitDeleteAppResponse(w http.ResponseWriter) error
 }
 
-type N401ResponseHeaders struct {
+type DeleteApp202ResponseHeaders struct {
 	XCorrelator openapi_types.UUID
 }
-type N401JSONResponse struct {
-	Body ErrorInfo
 
-	Headers N401ResponseHeaders
+type DeleteApp202Response struct {
+	Headers DeleteApp202ResponseHeaders
 }
 
-type N403ResponseHeaders struct {
-	XCorrelator openapi_types.UUID
+func (response DeleteApp202Response) VisitDeleteAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(202)
+	return nil
 }
-type N403JSONResponse struct {
-	Body ErrorInfo
 
-	Headers N403ResponseHeaders
+type DeleteApp204Response struct {
 }
 
-type N404ResponseHeaders struct {
-	XCorrelator openapi_types.UUID
+func (response DeleteApp204Response) VisitDeleteAppResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
-type N404JSONResponse struct {
-	Body ErrorInfo
 
-	Headers N404ResponseHeaders
-}
+type DeleteApp400JSONResponse struct{ N400JSONResponse }
 
-type N500ResponseHeaders struct {
-	XCorrelator openapi_types.UUID
+func (response DeleteApp400JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
-type N500JSONResponse struct {
-	Body ErrorInfo
 
-	Headers N500ResponseHeaders
+type DeleteApp401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteApp401JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type N501ResponseHeaders struct {
-	XCorrelator openapi_types.UUID
+type DeleteApp403JSONResponse struct{ N403JSONResponse }
+
+func (response DeleteApp403JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
-type N501JSONResponse struct {
-	Body ErrorInfo
 
-	Headers N501ResponseHeaders
+type DeleteApp404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteApp404JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type N503ResponseHeaders struct {
-	XCorrelator openapi_types.UUID
+type DeleteApp409JSONResponse ErrorInfo
+
+func (response DeleteApp409JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
 }
-type N503JSONResponse struct {
-	Body ErrorInfo
 
-	Headers N503ResponseHeaders
+type DeleteApp500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteApp500JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetAppInstanceRequestObject struct {
-	Params GetAppInstanceParams
+type DeleteApp503JSONResponse struct{ N503JSONResponse }
+
+func (response DeleteApp503JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetAppInstanceResponseObject interface {
-	VisitGetAppInstanceResponse(w http.ResponseWriter) error
+type GetAppRequestObject struct {
+	AppId  AppId `json:"appId"`
+	Params GetAppParams
 }
 
-type GetAppInstance200ResponseHeaders struct {
-	XCorrelator openapi_types.UUID
+type GetAppResponseObject interface {
+	VisitGetAppResponse(w http.ResponseWriter) error
 }
 
-type GetAppInstance200JSONResponse struct {
-	Body    []AppInstanceInfo
-	Headers GetAppInstance200ResponseHeaders
+type GetApp200ResponseHeaders struct {
+	XCorrelator openapi_types.UUID
 }
 
-func (response GetAppInstance200JSONResponse) VisitGetAppInstanceResponse(w http.ResponseWriter) error {
+type GetApp200JSONResponse struct {
+	Body struct {
+		// AppManifest Application information and requirements provided by the
+		// Application Provider
+		AppManifest *AppManifest `json:"appManifest,omitempty"`
+	}
+	Headers GetApp200ResponseHeaders
+}
+
+func (response GetApp200JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(200)
@@ -3615,9 +6384,9 @@ func (response GetAppInstance200JSONResponse) VisitGetAppInstanceResponse(w http
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetAppInstance401JSONResponse struct{ N401JSONResponse }
+type GetApp401JSONResponse struct{ N401JSONResponse }
 
-func (response GetAppInstance401JSONResponse) VisitGetAppInstanceResponse(w http.ResponseWriter) error {
+func (response GetApp401JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(401)
@@ -3625,9 +6394,9 @@ func (response GetAppInstance401JSONResponse) VisitGetAppInstanceResponse(w http
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetAppInstance403JSONResponse struct{ N403JSONResponse }
+type GetApp403JSONResponse struct{ N403JSONResponse }
 
-func (response GetAppInstance403JSONResponse) VisitGetAppInstanceResponse(w http.ResponseWriter) error {
+func (response GetApp403JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(403)
@@ -3635,9 +6404,9 @@ func (response GetAppInstance403JSONResponse) VisitGetAppInstanceResponse(w http
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetAppInstance404JSONResponse struct{ N404JSONResponse }
+type GetApp404JSONResponse struct{ N404JSONResponse }
 
-func (response GetAppInstance404JSONResponse) VisitGetAppInstanceResponse(w http.ResponseWriter) error {
+func (response GetApp404JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(404)
@@ -3645,9 +6414,9 @@ func (response GetAppInstance404JSONResponse) VisitGetAppInstanceResponse(w http
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetAppInstance500JSONResponse struct{ N500JSONResponse }
+type GetApp500JSONResponse struct{ N500JSONResponse }
 
-func (response GetAppInstance500JSONResponse) VisitGetAppInstanceResponse(w http.ResponseWriter) error {
+func (response GetApp500JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(500)
@@ -3655,9 +6424,9 @@ func (response GetAppInstance500JSONResponse) VisitGetAppInstanceResponse(w http
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetAppInstance503JSONResponse struct{ N503JSONResponse }
+type GetApp503JSONResponse struct{ N503JSONResponse }
 
-func (response GetAppInstance503JSONResponse) VisitGetAppInstanceResponse(w http.ResponseWriter) error {
+func (response GetApp503JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(503)
@@ -3665,47 +6434,34 @@ func (response GetAppInstance503JSONResponse) VisitGetAppInstanceResponse(w http
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateAppInstanceRequestObject struct {
-	Params CreateAppInstanceParams
-	Body   *CreateAppInstanceJSONRequestBody
+type GetClustersRequestObject struct {
+	Params GetClustersParams
 }
 
-type CreateAppInstanceResponseObject interface {
-	VisitCreateAppInstanceResponse(w http.ResponseWriter) error
+type GetClustersResponseObject interface {
+	VisitGetClustersResponse(w http.ResponseWriter) error
 }
 
-type CreateAppInstance202ResponseHeaders struct {
-	Location    string
+type GetClusters200ResponseHeaders struct {
 	XCorrelator openapi_types.UUID
 }
 
-type CreateAppInstance202JSONResponse struct {
-	Body    AppInstanceInfo
-	Headers CreateAppInstance202ResponseHeaders
-}
-
-func (response CreateAppInstance202JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
-	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(202)
-
-	return json.NewEncoder(w).Encode(response.Body)
+type GetClusters200JSONResponse struct {
+	Body    []ClusterInfo
+	Headers GetClusters200ResponseHeaders
 }
 
-type CreateAppInstance400JSONResponse struct{ N400JSONResponse }
-
-func (response CreateAppInstance400JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+func (response GetClusters200JSONResponse) VisitGetClustersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateAppInstance401JSONResponse struct{ N401JSONResponse }
+type GetClusters401JSONResponse struct{ N401JSONResponse }
 
-func (response CreateAppInstance401JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+func (response GetClusters401JSONResponse) VisitGetClustersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(401)
@@ -3713,9 +6469,9 @@ func (response CreateAppInstance401JSONResponse) VisitCreateAppInstanceResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateAppInstance403JSONResponse struct{ N403JSONResponse }
+type GetClusters403JSONResponse struct{ N403JSONResponse }
 
-func (response CreateAppInstance403JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+func (response GetClusters403JSONResponse) VisitGetClustersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(403)
@@ -3723,38 +6479,29 @@ func (response CreateAppInstance403JSONResponse) VisitCreateAppInstanceResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateAppInstance409JSONResponse ErrorInfo
-
-func (response CreateAppInstance409JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type CreateAppInstance500JSONResponse struct{ N500JSONResponse }
+type GetClusters404JSONResponse struct{ N404JSONResponse }
 
-func (response CreateAppInstance500JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+func (response GetClusters404JSONResponse) VisitGetClustersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(500)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateAppInstance501JSONResponse struct{ N501JSONResponse }
+type GetClusters500JSONResponse struct{ N500JSONResponse }
 
-func (response CreateAppInstance501JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+func (response GetClusters500JSONResponse) VisitGetClustersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(501)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateAppInstance503JSONResponse struct{ N503JSONResponse }
+type GetClusters503JSONResponse struct{ N503JSONResponse }
 
-func (response CreateAppInstance503JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+func (response GetClusters503JSONResponse) VisitGetClustersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(503)
@@ -3762,50 +6509,34 @@ func (response CreateAppInstance503JSONResponse) VisitCreateAppInstanceResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteAppInstanceRequestObject struct {
-	AppInstanceId AppInstanceId `json:"appInstanceId"`
-	Params        DeleteAppInstanceParams
+type GetEdgeCloudZonesRequestObject struct {
+	Params GetEdgeCloudZonesParams
 }
 
-type DeleteAppInstanceResponseObject interface {
-	VisitDeleteAppInstanceResponse(w http.ResponseWriter) error
+type GetEdgeCloudZonesResponseObject interface {
+	VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error
 }
 
-type DeleteAppInstance202ResponseHeaders struct {
+type GetEdgeCloudZones200ResponseHeaders struct {
 	XCorrelator openapi_types.UUID
 }
 
-type DeleteAppInstance202Response struct {
-	Headers DeleteAppInstance202ResponseHeaders
-}
-
-func (response DeleteAppInstance202Response) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(202)
-	return nil
-}
-
-type DeleteAppInstance204Response struct {
-}
-
-func (response DeleteAppInstance204Response) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type GetEdgeCloudZones200JSONResponse struct {
+	Body    EdgeCloudZones
+	Headers GetEdgeCloudZones200ResponseHeaders
 }
 
-type DeleteAppInstance400JSONResponse struct{ N400JSONResponse }
-
-func (response DeleteAppInstance400JSONResponse) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+func (response GetEdgeCloudZones200JSONResponse) VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteAppInstance401JSONResponse struct{ N401JSONResponse }
+type GetEdgeCloudZones401JSONResponse struct{ N401JSONResponse }
 
-func (response DeleteAppInstance401JSONResponse) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+func (response GetEdgeCloudZones401JSONResponse) VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(401)
@@ -3813,9 +6544,9 @@ func (response DeleteAppInstance401JSONResponse) VisitDeleteAppInstanceResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteAppInstance403JSONResponse struct{ N403JSONResponse }
+type GetEdgeCloudZones403JSONResponse struct{ N403JSONResponse }
 
-func (response DeleteAppInstance403JSONResponse) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+func (response GetEdgeCloudZones403JSONResponse) VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(403)
@@ -3823,9 +6554,9 @@ func (response DeleteAppInstance403JSONResponse) VisitDeleteAppInstanceResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteAppInstance404JSONResponse struct{ N404JSONResponse }
+type GetEdgeCloudZones404JSONResponse struct{ N404JSONResponse }
 
-func (response DeleteAppInstance404JSONResponse) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+func (response GetEdgeCloudZones404JSONResponse) VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(404)
@@ -3833,9 +6564,9 @@ func (response DeleteAppInstance404JSONResponse) VisitDeleteAppInstanceResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteAppInstance500JSONResponse struct{ N500JSONResponse }
+type GetEdgeCloudZones500JSONResponse struct{ N500JSONResponse }
 
-func (response DeleteAppInstance500JSONResponse) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+func (response GetEdgeCloudZones500JSONResponse) VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(500)
@@ -3843,9 +6574,9 @@ func (response DeleteAppInstance500JSONResponse) VisitDeleteAppInstanceResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteAppInstance503JSONResponse struct{ N503JSONResponse }
+type GetEdgeCloudZones503JSONResponse struct{ N503JSONResponse }
 
-func (response DeleteAppInstance503JSONResponse) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+func (response GetEdgeCloudZones503JSONResponse) VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(503)
@@ -3853,24 +6584,25 @@ func (response DeleteAppInstance503JSONResponse) VisitDeleteAppInstanceResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetAppsRequestObject struct {
-	Params GetAppsParams
+type GetOperationRequestObject struct {
+	OperationId OperationId `json:"operationId"`
+	Params      GetOperationParams
 }
 
-type GetAppsResponseObject interface {
-	VisitGetAppsResponse(w http.ResponseWriter) error
+type GetOperationResponseObject interface {
+	VisitGetOperationResponse(w http.ResponseWriter) error
 }
 
-type GetApps200ResponseHeaders struct {
+type GetOperation200ResponseHeaders struct {
 	XCorrelator openapi_types.UUID
 }
 
-type GetApps200JSONResponse struct {
-	Body    []AppManifest
-	Headers GetApps200ResponseHeaders
+type GetOperation200JSONResponse struct {
+	Body    OperationStatus
+	Headers GetOperation200ResponseHeaders
 }
 
-func (response GetApps200JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+func (response GetOperation200JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(200)
@@ -3878,9 +6610,9 @@ func (response GetApps200JSONResponse) VisitGetAppsResponse(w http.ResponseWrite
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetApps401JSONResponse struct{ N401JSONResponse }
+type GetOperation401JSONResponse struct{ N401JSONResponse }
 
-func (response GetApps401JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+func (response GetOperation401JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(401)
@@ -3888,9 +6620,9 @@ func (response GetApps401JSONResponse) VisitGetAppsResponse(w http.ResponseWrite
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetApps403JSONResponse struct{ N403JSONResponse }
+type GetOperation403JSONResponse struct{ N403JSONResponse }
 
-func (response GetApps403JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+func (response GetOperation403JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(403)
@@ -3898,9 +6630,9 @@ func (response GetApps403JSONResponse) VisitGetAppsResponse(w http.ResponseWrite
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetApps404JSONResponse struct{ N404JSONResponse }
+type GetOperation404JSONResponse struct{ N404JSONResponse }
 
-func (response GetApps404JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+func (response GetOperation404JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(404)
@@ -3908,9 +6640,9 @@ func (response GetApps404JSONResponse) VisitGetAppsResponse(w http.ResponseWrite
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetApps500JSONResponse struct{ N500JSONResponse }
+type GetOperation500JSONResponse struct{ N500JSONResponse }
 
-func (response GetApps500JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+func (response GetOperation500JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(500)
@@ -3918,9 +6650,9 @@ func (response GetApps500JSONResponse) VisitGetAppsResponse(w http.ResponseWrite
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetApps503JSONResponse struct{ N503JSONResponse }
+type GetOperation503JSONResponse struct{ N503JSONResponse }
 
-func (response GetApps503JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+func (response GetOperation503JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(503)
@@ -3928,45 +6660,34 @@ func (response GetApps503JSONResponse) VisitGetAppsResponse(w http.ResponseWrite
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type SubmitAppRequestObject struct {
-	Params SubmitAppParams
-	Body   *SubmitAppJSONRequestBody
+type GetSubscriptionsRequestObject struct {
+	Params GetSubscriptionsParams
 }
 
-type SubmitAppResponseObject interface {
-	VisitSubmitAppResponse(w http.ResponseWriter) error
+type GetSubscriptionsResponseObject interface {
+	VisitGetSubscriptionsResponse(w http.ResponseWriter) error
 }
 
-type SubmitApp201ResponseHeaders struct {
+type GetSubscriptions200ResponseHeaders struct {
 	XCorrelator openapi_types.UUID
 }
 
-type SubmitApp201JSONResponse struct {
-	Body    SubmittedApp
-	Headers SubmitApp201ResponseHeaders
-}
-
-func (response SubmitApp201JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(201)
-
-	return json.NewEncoder(w).Encode(response.Body)
+type GetSubscriptions200JSONResponse struct {
+	Body    []Subscription
+	Headers GetSubscriptions200ResponseHeaders
 }
 
-type SubmitApp400JSONResponse struct{ N400JSONResponse }
-
-func (response SubmitApp400JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
+func (response GetSubscriptions200JSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type SubmitApp401JSONResponse struct{ N401JSONResponse }
+type GetSubscriptions401JSONResponse struct{ N401JSONResponse }
 
-func (response SubmitApp401JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
+func (response GetSubscriptions401JSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(401)
@@ -3974,9 +6695,9 @@ func (response SubmitApp401JSONResponse) VisitSubmitAppResponse(w http.ResponseW
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type SubmitApp403JSONResponse struct{ N403JSONResponse }
+type GetSubscriptions403JSONResponse struct{ N403JSONResponse }
 
-func (response SubmitApp403JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
+func (response GetSubscriptions403JSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(403)
@@ -3984,18 +6705,9 @@ func (response SubmitApp403JSONResponse) VisitSubmitAppResponse(w http.ResponseW
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type SubmitApp409JSONResponse ErrorInfo
-
-func (response SubmitApp409JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type SubmitApp500JSONResponse struct{ N500JSONResponse }
+type GetSubscriptions500JSONResponse struct{ N500JSONResponse }
 
-func (response SubmitApp500JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
+func (response GetSubscriptions500JSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(500)
@@ -4003,19 +6715,9 @@ func (response SubmitApp500JSONResponse) VisitSubmitAppResponse(w http.ResponseW
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type SubmitApp501JSONResponse struct{ N501JSONResponse }
-
-func (response SubmitApp501JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(501)
-
-	return json.NewEncoder(w).Encode(response.Body)
-}
-
-type SubmitApp503JSONResponse struct{ N503JSONResponse }
+type GetSubscriptions503JSONResponse struct{ N503JSONResponse }
 
-func (response SubmitApp503JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
+func (response GetSubscriptions503JSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(503)
@@ -4023,89 +6725,65 @@ func (response SubmitApp503JSONResponse) VisitSubmitAppResponse(w http.ResponseW
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteAppRequestObject struct {
-	AppId  AppId `json:"appId"`
-	Params DeleteAppParams
-}
-
-type DeleteAppResponseObject interface {
-	VisitDeleteAppResponse(w http.ResponseWriter) error
-}
-
-type DeleteApp202ResponseHeaders struct {
-	XCorrelator openapi_types.UUID
-}
-
-type DeleteApp202Response struct {
-	Headers DeleteApp202ResponseHeaders
-}
-
-func (response DeleteApp202Response) VisitDeleteAppResponse(w http.ResponseWriter) error {
-	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(202)
-	return nil
-}
-
-type DeleteApp204Response struct {
-}
-
-func (response DeleteApp204Response) VisitDeleteAppResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type CreateSubscriptionRequestObject struct {
+	Params CreateSubscriptionParams
+	Body   *CreateSubscriptionJSONRequestBody
 }
 
-type DeleteApp400JSONResponse struct{ N400JSONResponse }
-
-func (response DeleteApp400JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(400)
+type CreateSubscriptionResponseObject interface {
+	VisitCreateSubscriptionResponse(w http.ResponseWriter) error
+}
 
-	return json.NewEncoder(w).Encode(response.Body)
+type CreateSubscription201ResponseHeaders struct {
+	XCorrelator openapi_types.UUID
 }
 
-type DeleteApp401JSONResponse struct{ N401JSONResponse }
+type CreateSubscription201JSONResponse struct {
+	Body    Subscription
+	Headers CreateSubscription201ResponseHeaders
+}
 
-func (response DeleteApp401JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
+func (response CreateSubscription201JSONResponse) VisitCreateSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(401)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteApp403JSONResponse struct{ N403JSONResponse }
+type CreateSubscription400JSONResponse struct{ N400JSONResponse }
 
-func (response DeleteApp403JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
+func (response CreateSubscription400JSONResponse) VisitCreateSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(403)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteApp404JSONResponse struct{ N404JSONResponse }
+type CreateSubscription401JSONResponse struct{ N401JSONResponse }
 
-func (response DeleteApp404JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
+func (response CreateSubscription401JSONResponse) VisitCreateSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteApp409JSONResponse ErrorInfo
+type CreateSubscription403JSONResponse struct{ N403JSONResponse }
 
-func (response DeleteApp409JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
+func (response CreateSubscription403JSONResponse) VisitCreateSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(403)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteApp500JSONResponse struct{ N500JSONResponse }
+type CreateSubscription500JSONResponse struct{ N500JSONResponse }
 
-func (response DeleteApp500JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
+func (response CreateSubscription500JSONResponse) VisitCreateSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(500)
@@ -4113,9 +6791,9 @@ func (response DeleteApp500JSONResponse) VisitDeleteAppResponse(w http.ResponseW
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteApp503JSONResponse struct{ N503JSONResponse }
+type CreateSubscription503JSONResponse struct{ N503JSONResponse }
 
-func (response DeleteApp503JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
+func (response CreateSubscription503JSONResponse) VisitCreateSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(503)
@@ -4123,39 +6801,32 @@ func (response DeleteApp503JSONResponse) VisitDeleteAppResponse(w http.ResponseW
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetAppRequestObject struct {
-	AppId  AppId `json:"appId"`
-	Params GetAppParams
+type DeleteSubscriptionRequestObject struct {
+	SubscriptionId SubscriptionId `json:"subscriptionId"`
+	Params         DeleteSubscriptionParams
 }
 
-type GetAppResponseObject interface {
-	VisitGetAppResponse(w http.ResponseWriter) error
+type DeleteSubscriptionResponseObject interface {
+	VisitDeleteSubscriptionResponse(w http.ResponseWriter) error
 }
 
-type GetApp200ResponseHeaders struct {
+type DeleteSubscription204ResponseHeaders struct {
 	XCorrelator openapi_types.UUID
 }
 
-type GetApp200JSONResponse struct {
-	Body struct {
-		// AppManifest Application information and requirements provided by the
-		// Application Provider
-		AppManifest *AppManifest `json:"appManifest,omitempty"`
-	}
-	Headers GetApp200ResponseHeaders
+type DeleteSubscription204Response struct {
+	Headers DeleteSubscription204ResponseHeaders
 }
 
-func (response GetApp200JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response DeleteSubscription204Response) VisitDeleteSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response.Body)
+	w.WriteHeader(204)
+	return nil
 }
 
-type GetApp401JSONResponse struct{ N401JSONResponse }
+type DeleteSubscription401JSONResponse struct{ N401JSONResponse }
 
-func (response GetApp401JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
+func (response DeleteSubscription401JSONResponse) VisitDeleteSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(401)
@@ -4163,9 +6834,9 @@ func (response GetApp401JSONResponse) VisitGetAppResponse(w http.ResponseWriter)
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetApp403JSONResponse struct{ N403JSONResponse }
+type DeleteSubscription403JSONResponse struct{ N403JSONResponse }
 
-func (response GetApp403JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
+func (response DeleteSubscription403JSONResponse) VisitDeleteSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(403)
@@ -4173,9 +6844,9 @@ func (response GetApp403JSONResponse) VisitGetAppResponse(w http.ResponseWriter)
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetApp404JSONResponse struct{ N404JSONResponse }
+type DeleteSubscription404JSONResponse struct{ N404JSONResponse }
 
-func (response GetApp404JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
+func (response DeleteSubscription404JSONResponse) VisitDeleteSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(404)
@@ -4183,9 +6854,9 @@ func (response GetApp404JSONResponse) VisitGetAppResponse(w http.ResponseWriter)
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetApp500JSONResponse struct{ N500JSONResponse }
+type DeleteSubscription500JSONResponse struct{ N500JSONResponse }
 
-func (response GetApp500JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
+func (response DeleteSubscription500JSONResponse) VisitDeleteSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(500)
@@ -4193,9 +6864,9 @@ func (response GetApp500JSONResponse) VisitGetAppResponse(w http.ResponseWriter)
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetApp503JSONResponse struct{ N503JSONResponse }
+type DeleteSubscription503JSONResponse struct{ N503JSONResponse }
 
-func (response GetApp503JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
+func (response DeleteSubscription503JSONResponse) VisitDeleteSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(503)
@@ -4203,24 +6874,25 @@ func (response GetApp503JSONResponse) VisitGetAppResponse(w http.ResponseWriter)
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetClustersRequestObject struct {
-	Params GetClustersParams
+type GetSubscriptionRequestObject struct {
+	SubscriptionId SubscriptionId `json:"subscriptionId"`
+	Params         GetSubscriptionParams
 }
 
-type GetClustersResponseObject interface {
-	VisitGetClustersResponse(w http.ResponseWriter) error
+type GetSubscriptionResponseObject interface {
+	VisitGetSubscriptionResponse(w http.ResponseWriter) error
 }
 
-type GetClusters200ResponseHeaders struct {
+type GetSubscription200ResponseHeaders struct {
 	XCorrelator openapi_types.UUID
 }
 
-type GetClusters200JSONResponse struct {
-	Body    []ClusterInfo
-	Headers GetClusters200ResponseHeaders
+type GetSubscription200JSONResponse struct {
+	Body    Subscription
+	Headers GetSubscription200ResponseHeaders
 }
 
-func (response GetClusters200JSONResponse) VisitGetClustersResponse(w http.ResponseWriter) error {
+func (response GetSubscription200JSONResponse) VisitGetSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(200)
@@ -4228,9 +6900,9 @@ func (response GetClusters200JSONResponse) VisitGetClustersResponse(w http.Respo
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetClusters401JSONResponse struct{ N401JSONResponse }
+type GetSubscription401JSONResponse struct{ N401JSONResponse }
 
-func (response GetClusters401JSONResponse) VisitGetClustersResponse(w http.ResponseWriter) error {
+func (response GetSubscription401JSONResponse) VisitGetSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(401)
@@ -4238,9 +6910,9 @@ func (response GetClusters401JSONResponse) VisitGetClustersResponse(w http.Respo
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetClusters403JSONResponse struct{ N403JSONResponse }
+type GetSubscription403JSONResponse struct{ N403JSONResponse }
 
-func (response GetClusters403JSONResponse) VisitGetClustersResponse(w http.ResponseWriter) error {
+func (response GetSubscription403JSONResponse) VisitGetSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(403)
@@ -4248,9 +6920,9 @@ func (response GetClusters403JSONResponse) VisitGetClustersResponse(w http.Respo
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetClusters404JSONResponse struct{ N404JSONResponse }
+type GetSubscription404JSONResponse struct{ N404JSONResponse }
 
-func (response GetClusters404JSONResponse) VisitGetClustersResponse(w http.ResponseWriter) error {
+func (response GetSubscription404JSONResponse) VisitGetSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(404)
@@ -4258,9 +6930,9 @@ func (response GetClusters404JSONResponse) VisitGetClustersResponse(w http.Respo
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetClusters500JSONResponse struct{ N500JSONResponse }
+type GetSubscription500JSONResponse struct{ N500JSONResponse }
 
-func (response GetClusters500JSONResponse) VisitGetClustersResponse(w http.ResponseWriter) error {
+func (response GetSubscription500JSONResponse) VisitGetSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(500)
@@ -4268,9 +6940,9 @@ func (response GetClusters500JSONResponse) VisitGetClustersResponse(w http.Respo
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetClusters503JSONResponse struct{ N503JSONResponse }
+type GetSubscription503JSONResponse struct{ N503JSONResponse }
 
-func (response GetClusters503JSONResponse) VisitGetClustersResponse(w http.ResponseWriter) error {
+func (response GetSubscription503JSONResponse) VisitGetSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(503)
@@ -4278,24 +6950,25 @@ func (response GetClusters503JSONResponse) VisitGetClustersResponse(w http.Respo
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetEdgeCloudZonesRequestObject struct {
-	Params GetEdgeCloudZonesParams
+type GetSubscriptionDeadLettersRequestObject struct {
+	SubscriptionId SubscriptionId `json:"subscriptionId"`
+	Params         GetSubscriptionDeadLettersParams
 }
 
-type GetEdgeCloudZonesResponseObject interface {
-	VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error
+type GetSubscriptionDeadLettersResponseObject interface {
+	VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error
 }
 
-type GetEdgeCloudZones200ResponseHeaders struct {
+type GetSubscriptionDeadLetters200ResponseHeaders struct {
 	XCorrelator openapi_types.UUID
 }
 
-type GetEdgeCloudZones200JSONResponse struct {
-	Body    EdgeCloudZones
-	Headers GetEdgeCloudZones200ResponseHeaders
+type GetSubscriptionDeadLetters200JSONResponse struct {
+	Body    []DeadLetter
+	Headers GetSubscriptionDeadLetters200ResponseHeaders
 }
 
-func (response GetEdgeCloudZones200JSONResponse) VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error {
+func (response GetSubscriptionDeadLetters200JSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(200)
@@ -4303,9 +6976,9 @@ func (response GetEdgeCloudZones200JSONResponse) VisitGetEdgeCloudZonesResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetEdgeCloudZones401JSONResponse struct{ N401JSONResponse }
+type GetSubscriptionDeadLetters401JSONResponse struct{ N401JSONResponse }
 
-func (response GetEdgeCloudZones401JSONResponse) VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error {
+func (response GetSubscriptionDeadLetters401JSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(401)
@@ -4313,9 +6986,9 @@ func (response GetEdgeCloudZones401JSONResponse) VisitGetEdgeCloudZonesResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetEdgeCloudZones403JSONResponse struct{ N403JSONResponse }
+type GetSubscriptionDeadLetters403JSONResponse struct{ N403JSONResponse }
 
-func (response GetEdgeCloudZones403JSONResponse) VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error {
+func (response GetSubscriptionDeadLetters403JSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(403)
@@ -4323,9 +6996,9 @@ func (response GetEdgeCloudZones403JSONResponse) VisitGetEdgeCloudZonesResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetEdgeCloudZones404JSONResponse struct{ N404JSONResponse }
+type GetSubscriptionDeadLetters404JSONResponse struct{ N404JSONResponse }
 
-func (response GetEdgeCloudZones404JSONResponse) VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error {
+func (response GetSubscriptionDeadLetters404JSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(404)
@@ -4333,9 +7006,9 @@ func (response GetEdgeCloudZones404JSONResponse) VisitGetEdgeCloudZonesResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetEdgeCloudZones500JSONResponse struct{ N500JSONResponse }
+type GetSubscriptionDeadLetters500JSONResponse struct{ N500JSONResponse }
 
-func (response GetEdgeCloudZones500JSONResponse) VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error {
+func (response GetSubscriptionDeadLetters500JSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(500)
@@ -4343,9 +7016,9 @@ func (response GetEdgeCloudZones500JSONResponse) VisitGetEdgeCloudZonesResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetEdgeCloudZones503JSONResponse struct{ N503JSONResponse }
+type GetSubscriptionDeadLetters503JSONResponse struct{ N503JSONResponse }
 
-func (response GetEdgeCloudZones503JSONResponse) VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error {
+func (response GetSubscriptionDeadLetters503JSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(503)
@@ -4364,6 +7037,12 @@ type StrictServerInterface interface {
 	// Terminate an Application Instance
 	// (DELETE /appinstances/{appInstanceId})
 	DeleteAppInstance(ctx context.Context, request DeleteAppInstanceRequestObject) (DeleteAppInstanceResponseObject, error)
+	// Update an Application Instance
+	// (PATCH /appinstances/{appInstanceId})
+	UpdateAppInstance(ctx context.Context, request UpdateAppInstanceRequestObject) (UpdateAppInstanceResponseObject, error)
+	// Replace the configuration of an Application Instance
+	// (PUT /appinstances/{appInstanceId})
+	ReplaceAppInstance(ctx context.Context, request ReplaceAppInstanceRequestObject) (ReplaceAppInstanceResponseObject, error)
 	// Retrieve a list of existing Applications
 	// (GET /apps)
 	GetApps(ctx context.Context, request GetAppsRequestObject) (GetAppsResponseObject, error)
@@ -4382,6 +7061,24 @@ type StrictServerInterface interface {
 	// Retrieve a list of the operators Edge Cloud Zones and their status
 	// (GET /edge-cloud-zones)
 	GetEdgeCloudZones(ctx context.Context, request GetEdgeCloudZonesRequestObject) (GetEdgeCloudZonesResponseObject, error)
+	// Retrieve the status of an asynchronous operation
+	// (GET /operations/{operationId})
+	GetOperation(ctx context.Context, request GetOperationRequestObject) (GetOperationResponseObject, error)
+	// Retrieve a list of subscriptions
+	// (GET /subscriptions)
+	GetSubscriptions(ctx context.Context, request GetSubscriptionsRequestObject) (GetSubscriptionsResponseObject, error)
+	// Subscribe to Application Instance and Cluster events
+	// (POST /subscriptions)
+	CreateSubscription(ctx context.Context, request CreateSubscriptionRequestObject) (CreateSubscriptionResponseObject, error)
+	// Delete a subscription
+	// (DELETE /subscriptions/{subscriptionId})
+	DeleteSubscription(ctx context.Context, request DeleteSubscriptionRequestObject) (DeleteSubscriptionResponseObject, error)
+	// Retrieve a subscription
+	// (GET /subscriptions/{subscriptionId})
+	GetSubscription(ctx context.Context, request GetSubscriptionRequestObject) (GetSubscriptionResponseObject, error)
+	// Retrieve undelivered notifications
+	// (GET /subscriptions/{subscriptionId}/dead-letters)
+	GetSubscriptionDeadLetters(ctx context.Context, request GetSubscriptionDeadLettersRequestObject) (GetSubscriptionDeadLettersResponseObject, error)
 }
 
 type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
@@ -4478,6 +7175,70 @@ func (sh *strictHandler) DeleteAppInstance(ctx echo.Context, appInstanceId AppIn
 	return nil
 }
 
+// UpdateAppInstance operation middleware
+func (sh *strictHandler) UpdateAppInstance(ctx echo.Context, appInstanceId AppInstanceId, params UpdateAppInstanceParams) error {
+	var request UpdateAppInstanceRequestObject
+
+	request.AppInstanceId = appInstanceId
+	request.Params = params
+
+	var body UpdateAppInstanceJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateAppInstance(ctx.Request().Context(), request.(UpdateAppInstanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateAppInstance")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UpdateAppInstanceResponseObject); ok {
+		return validResponse.VisitUpdateAppInstanceResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ReplaceAppInstance operation middleware
+func (sh *strictHandler) ReplaceAppInstance(ctx echo.Context, appInstanceId AppInstanceId, params ReplaceAppInstanceParams) error {
+	var request ReplaceAppInstanceRequestObject
+
+	request.AppInstanceId = appInstanceId
+	request.Params = params
+
+	var body ReplaceAppInstanceJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReplaceAppInstance(ctx.Request().Context(), request.(ReplaceAppInstanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReplaceAppInstance")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ReplaceAppInstanceResponseObject); ok {
+		return validResponse.VisitReplaceAppInstanceResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetApps operation middleware
 func (sh *strictHandler) GetApps(ctx echo.Context, params GetAppsParams) error {
 	var request GetAppsRequestObject
@@ -4635,3 +7396,163 @@ func (sh *strictHandler) GetEdgeCloudZones(ctx echo.Context, params GetEdgeCloud
 	}
 	return nil
 }
+
+// GetOperation operation middleware
+func (sh *strictHandler) GetOperation(ctx echo.Context, operationId OperationId, params GetOperationParams) error {
+	var request GetOperationRequestObject
+
+	request.OperationId = operationId
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOperation(ctx.Request().Context(), request.(GetOperationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOperation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetOperationResponseObject); ok {
+		return validResponse.VisitGetOperationResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetSubscriptions operation middleware
+func (sh *strictHandler) GetSubscriptions(ctx echo.Context, params GetSubscriptionsParams) error {
+	var request GetSubscriptionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscriptions(ctx.Request().Context(), request.(GetSubscriptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscriptions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetSubscriptionsResponseObject); ok {
+		return validResponse.VisitGetSubscriptionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateSubscription operation middleware
+func (sh *strictHandler) CreateSubscription(ctx echo.Context, params CreateSubscriptionParams) error {
+	var request CreateSubscriptionRequestObject
+
+	request.Params = params
+
+	var body CreateSubscriptionJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateSubscription(ctx.Request().Context(), request.(CreateSubscriptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateSubscription")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CreateSubscriptionResponseObject); ok {
+		return validResponse.VisitCreateSubscriptionResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteSubscription operation middleware
+func (sh *strictHandler) DeleteSubscription(ctx echo.Context, subscriptionId SubscriptionId, params DeleteSubscriptionParams) error {
+	var request DeleteSubscriptionRequestObject
+
+	request.SubscriptionId = subscriptionId
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteSubscription(ctx.Request().Context(), request.(DeleteSubscriptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteSubscription")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteSubscriptionResponseObject); ok {
+		return validResponse.VisitDeleteSubscriptionResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetSubscription operation middleware
+func (sh *strictHandler) GetSubscription(ctx echo.Context, subscriptionId SubscriptionId, params GetSubscriptionParams) error {
+	var request GetSubscriptionRequestObject
+
+	request.SubscriptionId = subscriptionId
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscription(ctx.Request().Context(), request.(GetSubscriptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscription")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetSubscriptionResponseObject); ok {
+		return validResponse.VisitGetSubscriptionResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetSubscriptionDeadLetters operation middleware
+func (sh *strictHandler) GetSubscriptionDeadLetters(ctx echo.Context, subscriptionId SubscriptionId, params GetSubscriptionDeadLettersParams) error {
+	var request GetSubscriptionDeadLettersRequestObject
+
+	request.SubscriptionId = subscriptionId
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscriptionDeadLetters(ctx.Request().Context(), request.(GetSubscriptionDeadLettersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscriptionDeadLetters")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetSubscriptionDeadLettersResponseObject); ok {
+		return validResponse.VisitGetSubscriptionDeadLettersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/shepherd_common"
+	"github.com/edgexr/edge-cloud-platform/pkg/util/tasks"
+)
+
+// AppInst replicas scaled by cpu or memory utilization are handled
+// by a Kubernetes HorizontalPodAutoscaler. AppInst replicas scaled
+// by active connections are handled here, based on the connection
+// stats from the cloudlet prometheus. Like the cluster auto-scaler,
+// an alert is raised with the desired number of replicas, which is
+// acted upon by autoprov.
+
+var appInstAutoScalerWorkers tasks.KeyWorkers
+
+var appInstAutoScalers = make(map[edgeproto.AppInstKey]*AppInstAutoScaler)
+var appInstAutoScalersMux sync.Mutex
+
+func init() {
+	appInstAutoScalerWorkers.Init("appinst-autoscale", checkAppInstAutoScale)
+}
+
+type AppInstAutoScaler struct {
+	mux                       sync.Mutex
+	lastStabilizedActiveConns float64
+	statsValid                bool
+	scaleInProgress           bool // makes sure Alert gets deleted when done
+}
+
+func getAppInstAutoScaler(key *edgeproto.AppInstKey, create bool) *AppInstAutoScaler {
+	appInstAutoScalersMux.Lock()
+	defer appInstAutoScalersMux.Unlock()
+	autoScaler, found := appInstAutoScalers[*key]
+	if !found && create {
+		autoScaler = &AppInstAutoScaler{}
+		appInstAutoScalers[*key] = autoScaler
+	}
+	return autoScaler
+}
+
+// pruneAppInstAutoScalers removes auto-scalers and their alerts for
+// AppInsts that are no longer auto-scaled by active connections.
+func pruneAppInstAutoScalers(ctx context.Context, active map[edgeproto.AppInstKey]struct{}) {
+	appInstAutoScalersMux.Lock()
+	defer appInstAutoScalersMux.Unlock()
+	for key := range appInstAutoScalers {
+		if _, found := active[key]; found {
+			continue
+		}
+		log.SpanLog(ctx, log.DebugLevelMetrics, "removing appinst auto-scaler", "key", key)
+		delete(appInstAutoScalers, key)
+		AlertCache.Delete(ctx, getAppInstAutoScaleAlert(&key, 0), 0)
+	}
+}
+
+func (s *AppInstAutoScaler) updateConnStats(ctx context.Context, key edgeproto.AppInstKey, activeConns float64) {
+	needsWork := false
+	s.mux.Lock()
+	if !s.statsValid || s.lastStabilizedActiveConns != activeConns {
+		s.lastStabilizedActiveConns = activeConns
+		s.statsValid = true
+		needsWork = true
+	}
+	// Note scaleInProgress is needed to ensure alert is removed
+	// after scaling is done, in case stats remain constant.
+	if s.scaleInProgress {
+		needsWork = true
+	}
+	s.mux.Unlock()
+	if needsWork {
+		appInstAutoScalerWorkers.NeedsWork(ctx, key)
+	}
+}
+
+func (s *AppInstAutoScaler) setScaleInProgress(inProgress bool) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.scaleInProgress = inProgress
+}
+
+func checkAppInstAutoScale(ctx context.Context, k interface{}) {
+	key, ok := k.(edgeproto.AppInstKey)
+	if !ok {
+		log.SpanLog(ctx, log.DebugLevelApi, "Unexpected failure, checkAppInstAutoScale key not an AppInstKey", "key", k)
+		return
+	}
+	log.SetContextTags(ctx, key.GetTags())
+
+	autoScaler := getAppInstAutoScaler(&key, false)
+	if autoScaler == nil {
+		log.SpanLog(ctx, log.DebugLevelApi, "checkAppInstAutoScale auto-scaler not found", "key", key)
+		return
+	}
+	appInst := edgeproto.AppInst{}
+	if !AppInstCache.Get(&key, &appInst) {
+		log.SpanLog(ctx, log.DebugLevelApi, "checkAppInstAutoScale appinst not found", "key", key)
+		return
+	}
+	app := edgeproto.App{}
+	if !AppCache.Get(&appInst.AppKey, &app) {
+		log.SpanLog(ctx, log.DebugLevelApi, "checkAppInstAutoScale app not found", "key", appInst.AppKey)
+		return
+	}
+	policy := app.ReplicaAutoScalePolicy
+	if policy == nil || policy.TargetActiveConnections == 0 {
+		log.SpanLog(ctx, log.DebugLevelApi, "checkAppInstAutoScale app not auto-scaled by active connections", "key", appInst.AppKey)
+		return
+	}
+	curReplicas := uint32(appInst.Replicas)
+	if curReplicas == 0 {
+		curReplicas = policy.MinReplicas
+	}
+
+	// desiredReplicas = ceil(total-active-connections/target-per-replica)
+	autoScaler.mux.Lock()
+	if !autoScaler.statsValid {
+		autoScaler.mux.Unlock()
+		log.SpanLog(ctx, log.DebugLevelApi, "checkAppInstAutoScale no metrics to scale on")
+		return
+	}
+	activeConns := autoScaler.lastStabilizedActiveConns
+	autoScaler.mux.Unlock()
+	desiredRaw := activeConns / float64(policy.TargetActiveConnections)
+	reason := fmt.Sprintf("stabilized total active connections %f, target %d per replica", activeConns, policy.TargetActiveConnections)
+	log.SpanLog(ctx, log.DebugLevelApi, "checkAppInstAutoScale calculations", "key", key, "policy", policy, "desiredRaw", desiredRaw, "curReplicas", curReplicas, "reason", reason)
+
+	// Create alert if desired does not equal current, or delete if it does
+	desiredCeil := math.Ceil(desiredRaw)
+	if desiredCeil < float64(policy.MinReplicas) {
+		desiredCeil = float64(policy.MinReplicas)
+	}
+	if desiredCeil > float64(policy.MaxReplicas) {
+		desiredCeil = float64(policy.MaxReplicas)
+	}
+	alert := getAppInstAutoScaleAlert(&key, desiredCeil)
+	// Add 10% hysteresis at the step point, same as for the
+	// cluster auto-scaler.
+	if (desiredRaw < float64(curReplicas)*1.1 && desiredRaw >= float64(curReplicas)) || uint32(desiredCeil) == curReplicas {
+		log.SpanLog(ctx, log.DebugLevelApi, "checkAppInstAutoScale no scaling needed", "desiredRaw", desiredRaw, "desiredCeil", uint32(desiredCeil), "actual", curReplicas)
+		AlertCache.Delete(ctx, alert, 0)
+		autoScaler.setScaleInProgress(false)
+		return
+	}
+
+	// update alert if not already there with same number of desired replicas
+	alert.Annotations = make(map[string]string)
+	alert.Annotations["reason"] = reason
+	AlertCache.UpdateModFunc(ctx, alert.GetKey(), 0, func(old *edgeproto.Alert) (*edgeproto.Alert, bool) {
+		if old != nil && old.Value == alert.Value {
+			return nil, false
+		}
+		log.SpanLog(ctx, log.DebugLevelApi, "checkAppInstAutoScale updating alert")
+		return alert, true
+	})
+	autoScaler.setScaleInProgress(true)
+}
+
+func getAppInstAutoScaleAlert(key *edgeproto.AppInstKey, desiredReplicas float64) *edgeproto.Alert {
+	alert := &edgeproto.Alert{}
+	alert.Labels = key.GetTags()
+	alert.Labels["alertname"] = cloudcommon.AlertAppInstAutoScale
+	alert.Labels["region"] = *region
+	alert.State = "firing"
+	alert.Value = desiredReplicas
+	return alert
+}
+
+// updateAppInstReplicas reports the number of running replicas of
+// AppInsts scaled by a Kubernetes HorizontalPodAutoscaler, as they
+// are changed by Kubernetes rather than by the AppInst. Each pod of
+// an AppInst is a separate entry in the collected app stats.
+func updateAppInstReplicas(ctx context.Context, clusterKey *edgeproto.ClusterKey, appStatsMap map[shepherd_common.MetricAppInstKey]*shepherd_common.AppMetrics) {
+	pods := map[edgeproto.AppInstKey]int32{}
+	for key := range appStatsMap {
+		if key.AppInstName == "" || key.Pod == "" {
+			continue
+		}
+		pods[edgeproto.AppInstKey{
+			Name:         key.AppInstName,
+			Organization: key.AppInstOrg,
+		}]++
+	}
+	for key, replicas := range pods {
+		appInst := edgeproto.AppInst{}
+		if !AppInstCache.Get(&key, &appInst) || !appInst.GetClusterKey().Matches(clusterKey) {
+			continue
+		}
+		app := edgeproto.App{}
+		if !AppCache.Get(&appInst.AppKey, &app) {
+			continue
+		}
+		if app.ReplicaAutoScalePolicy == nil || !app.ReplicaAutoScalePolicy.UsesHorizontalPodAutoscaler() {
+			continue
+		}
+		AppInstInfoCache.UpdateModFunc(ctx, &key, 0, func(old *edgeproto.AppInstInfo) (*edgeproto.AppInstInfo, bool) {
+			if old != nil && old.RuntimeInfo.Replicas == replicas {
+				return old, false
+			}
+			log.SpanLog(ctx, log.DebugLevelMetrics, "updating appinst replicas", "appInst", key, "replicas", replicas)
+			info := &edgeproto.AppInstInfo{
+				Key: key,
+			}
+			info.RuntimeInfo.Replicas = replicas
+			return info, true
+		})
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/shepherd_common"
+	"github.com/edgexr/edge-cloud-platform/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppInstAutoScaler(t *testing.T) {
+	ctx := setupLog()
+	defer log.FinishTracer()
+	log.SetDebugLevel(log.DebugLevelMetrics | log.DebugLevelApi)
+
+	app := testutil.AppData()[0]
+	app.Deployment = cloudcommon.DeploymentTypeKubernetes
+	app.ReplicaAutoScalePolicy = &edgeproto.ReplicaAutoScalePolicy{
+		MinReplicas:             1,
+		MaxReplicas:             4,
+		TargetActiveConnections: 50,
+		StabilizationWindowSec:  20,
+	}
+	appInst := testutil.AppInstData()[0]
+	appInst.AppKey = app.Key
+	appInst.Replicas = 2
+
+	// inject data into caches
+	edgeproto.InitAppCache(&AppCache)
+	edgeproto.InitAppInstCache(&AppInstCache)
+	edgeproto.InitAlertCache(&AlertCache)
+	AppCache.Update(ctx, &app, 0)
+	AppInstCache.Update(ctx, &appInst, 0)
+	defer func() {
+		AppInstCache.Delete(ctx, &appInst, 0)
+		AppCache.Delete(ctx, &app, 0)
+	}()
+	autoScaler := getAppInstAutoScaler(&appInst.Key, true)
+	defer pruneAppInstAutoScalers(ctx, nil)
+
+	updateProxyStats := func(conns float64) {
+		autoScaler.updateConnStats(ctx, appInst.Key, conns)
+		appInstAutoScalerWorkers.WaitIdle()
+	}
+
+	alert := getAppInstAutoScaleAlert(&appInst.Key, 0)
+	checkAlert := func(exists bool, desiredReplicas float64) {
+		buf := edgeproto.Alert{}
+		found := AlertCache.Get(alert.GetKey(), &buf)
+		require.Equal(t, exists, found)
+		if exists {
+			require.Equal(t, desiredReplicas, buf.Value)
+		}
+	}
+
+	// num replicas is 2, and target is 50 per replica
+	updateProxyStats(100)
+	checkAlert(false, 0)
+	updateProxyStats(140)
+	checkAlert(true, 3)
+	updateProxyStats(200)
+	checkAlert(true, 4)
+	updateProxyStats(500) // test max replicas limit
+	checkAlert(true, 4)
+	updateProxyStats(100)
+	checkAlert(false, 0)
+	updateProxyStats(49)
+	checkAlert(true, 1)
+	updateProxyStats(0) // test min replicas limit
+	checkAlert(true, 1)
+
+	// check upper bound hysteresis (10% window)
+	updateProxyStats(100 * 1.09)
+	checkAlert(false, 0)
+	updateProxyStats(100 * 1.10)
+	checkAlert(true, 3)
+	// check lower bound hysteresis (ceil)
+	updateProxyStats(50 * 1.01)
+	checkAlert(false, 0)
+	updateProxyStats(50 * .99)
+	checkAlert(true, 1)
+
+	// replicas default to the min replicas
+	appInst.Replicas = 0
+	AppInstCache.Update(ctx, &appInst, 0)
+	updateProxyStats(49)
+	checkAlert(false, 0)
+	updateProxyStats(60)
+	checkAlert(true, 2)
+
+	// alert is removed if the AppInst is no longer auto-scaled
+	pruneAppInstAutoScalers(ctx, nil)
+	checkAlert(false, 0)
+	require.Nil(t, getAppInstAutoScaler(&appInst.Key, false))
+}
+
+func TestUpdateAppInstReplicas(t *testing.T) {
+	ctx := setupLog()
+	defer log.FinishTracer()
+	log.SetDebugLevel(log.DebugLevelMetrics | log.DebugLevelApi)
+
+	app := testutil.AppData()[0]
+	app.Deployment = cloudcommon.DeploymentTypeKubernetes
+	app.ReplicaAutoScalePolicy = &edgeproto.ReplicaAutoScalePolicy{
+		MinReplicas: 1,
+		MaxReplicas: 4,
+		TargetCpu:   50,
+	}
+	appInst := testutil.AppInstData()[0]
+	appInst.AppKey = app.Key
+
+	edgeproto.InitAppCache(&AppCache)
+	edgeproto.InitAppInstCache(&AppInstCache)
+	edgeproto.InitAppInstInfoCache(&AppInstInfoCache)
+	AppCache.Update(ctx, &app, 0)
+	AppInstCache.Update(ctx, &appInst, 0)
+	defer func() {
+		AppInstCache.Delete(ctx, &appInst, 0)
+		AppCache.Delete(ctx, &app, 0)
+	}()
+
+	getStats := func(pods ...string) map[shepherd_common.MetricAppInstKey]*shepherd_common.AppMetrics {
+		stats := map[shepherd_common.MetricAppInstKey]*shepherd_common.AppMetrics{}
+		for _, pod := range pods {
+			stats[shepherd_common.MetricAppInstKey{
+				ClusterKey:  *appInst.GetClusterKey(),
+				CloudletKey: appInst.CloudletKey,
+				Pod:         pod,
+				AppInstName: appInst.Key.Name,
+				AppInstOrg:  appInst.Key.Organization,
+			}] = &shepherd_common.AppMetrics{}
+		}
+		return stats
+	}
+	checkReplicas := func(exists bool, replicas int32) {
+		info := edgeproto.AppInstInfo{}
+		found := AppInstInfoCache.Get(&appInst.Key, &info)
+		require.Equal(t, exists, found)
+		if exists {
+			require.Equal(t, replicas, info.RuntimeInfo.Replicas)
+		}
+	}
+
+	updateAppInstReplicas(ctx, appInst.GetClusterKey(), getStats("pod1", "pod2"))
+	checkReplicas(true, 2)
+	// scaled by the HorizontalPodAutoscaler
+	updateAppInstReplicas(ctx, appInst.GetClusterKey(), getStats("pod1", "pod2", "pod3"))
+	checkReplicas(true, 3)
+	// stats from another cluster are ignored
+	otherCluster := edgeproto.ClusterKey{Name: "other", Organization: "other"}
+	updateAppInstReplicas(ctx, &otherCluster, getStats("pod1"))
+	checkReplicas(true, 3)
+
+	// replicas of AppInsts not scaled by the HorizontalPodAutoscaler
+	// are set by the AppInst
+	AppInstInfoCache.Delete(ctx, &edgeproto.AppInstInfo{Key: appInst.Key}, 0)
+	app.ReplicaAutoScalePolicy.TargetCpu = 0
+	app.ReplicaAutoScalePolicy.TargetActiveConnections = 10
+	AppCache.Update(ctx, &app, 0)
+	updateAppInstReplicas(ctx, appInst.GetClusterKey(), getStats("pod1"))
+	checkReplicas(false, 0)
+}
@@ -155,6 +155,45 @@ func getCloudletPrometheusStats(ctx context.Context, addr string, client ssh.Cli
 			}
 		}
 	}
+	getAppInstPrometheusStats(ctx, addr, client)
+}
+
+// getAppInstPrometheusStats gets the active connections for AppInsts
+// whose replicas are auto-scaled by active connections.
+func getAppInstPrometheusStats(ctx context.Context, addr string, client ssh.Client) {
+	appInsts := []edgeproto.AppInst{}
+	AppInstCache.Show(&edgeproto.AppInst{}, func(obj *edgeproto.AppInst) error {
+		appInsts = append(appInsts, *obj)
+		return nil
+	})
+	active := make(map[edgeproto.AppInstKey]struct{})
+	for _, appInst := range appInsts {
+		app := edgeproto.App{}
+		if !AppCache.Get(&appInst.AppKey, &app) {
+			continue
+		}
+		policy := app.ReplicaAutoScalePolicy
+		if policy == nil || policy.TargetActiveConnections == 0 {
+			continue
+		}
+		active[appInst.Key] = struct{}{}
+		autoScaler := getAppInstAutoScaler(&appInst.Key, true)
+		tags := make([]string, 0)
+		for k, v := range appInst.Key.GetTags() {
+			tags = append(tags, k+`="`+v+`"`)
+		}
+		q := "max_over_time(envoy_cluster_upstream_cx_active_total:avg{" + strings.Join(tags, ",") + "}[" + fmt.Sprintf("%d", policy.StabilizationWindowSec) + "s])"
+		q = url.QueryEscape(q)
+		resp, err := promutils.GetPromMetrics(ctx, addr, q, client)
+		if err == nil && resp.Status == "success" {
+			for _, metric := range resp.Data.Result {
+				if val, err := strconv.ParseFloat(metric.Values[1].(string), 64); err == nil {
+					autoScaler.updateConnStats(ctx, appInst.Key, val)
+				}
+			}
+		}
+	}
+	pruneAppInstAutoScalers(ctx, active)
 }
 
 func MarshalCloudletMetrics(data *shepherd_common.CloudletMetrics) []*edgeproto.Metric {
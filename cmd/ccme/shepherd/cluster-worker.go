@@ -196,6 +196,7 @@ func (p *ClusterWorker) RunNotify() {
 			if p.autoScaler.policyName != "" {
 				p.autoScaler.updateClusterStats(ctx, p.clusterKey, clusterStats)
 			}
+			updateAppInstReplicas(ctx, &p.clusterKey, appStatsMap)
 			zoneKey := edgeproto.ZoneKey{}
 			cloudlet := edgeproto.Cloudlet{}
 			if CloudletCache.Get(&cloudletKey, &cloudlet) {
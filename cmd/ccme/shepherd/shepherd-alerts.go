@@ -135,6 +135,10 @@ func UpdateAlerts(ctx context.Context, alerts []edgeproto.Alert, filterKey inter
 			// handled by cluster autoscaler
 			continue
 		}
+		if alertName == cloudcommon.AlertAppInstAutoScale {
+			// handled by appinst autoscaler
+			continue
+		}
 		log.SpanLog(ctx, log.DebugLevelMetrics, "Delete alert that is no longer firing", "alert", buf)
 		AlertCache.Delete(ctx, &buf, 0)
 		changeCount++
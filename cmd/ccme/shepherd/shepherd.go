@@ -94,6 +94,7 @@ var CloudletInfoCache edgeproto.CloudletInfoCache
 var CloudletInternalCache edgeproto.CloudletInternalCache
 var MetricSender *notify.MetricSend
 var AlertCache edgeproto.AlertCache
+var AppInstInfoCache edgeproto.AppInstInfoCache
 var AutoProvPoliciesCache edgeproto.AutoProvPolicyCache
 var AutoScalePoliciesCache edgeproto.AutoScalePolicyCache
 var SettingsCache edgeproto.SettingsCache
@@ -242,6 +243,7 @@ func appInstCb(ctx context.Context, old *edgeproto.AppInst, new *edgeproto.AppIn
 // It's possible that we may miss the transition from AppInst READY to another
 // state before it gets deleted, so we need to handle delete as well.
 func appInstDeletedCb(ctx context.Context, old *edgeproto.AppInst) {
+	AppInstInfoCache.Delete(ctx, &edgeproto.AppInstInfo{Key: old.Key}, 0)
 	old.State = edgeproto.TrackedState_NOT_PRESENT
 	appInstCb(ctx, old, old)
 }
@@ -585,6 +587,9 @@ func start() {
 	notifyClient.RegisterSend(MetricSender)
 	edgeproto.InitAlertCache(&AlertCache)
 	notifyClient.RegisterSendAlertCache(&AlertCache)
+	// register to send AppInst replicas
+	edgeproto.InitAppInstInfoCache(&AppInstInfoCache)
+	notifyClient.RegisterSendAppInstInfoCache(&AppInstInfoCache)
 	// register to send cloudletInfo, to receive appinst/clusterinst/cloudlet notifications from crm
 	edgeproto.InitCloudletInfoCache(&CloudletInfoCache)
 	notifyClient.RegisterSendCloudletInfoCache(&CloudletInfoCache)
@@ -0,0 +1,107 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Notify aggregation node that sits between the Controller and
+// CRMs/DMEs, see pkg/notifyagg.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon/svcnode"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/notify"
+	"github.com/edgexr/edge-cloud-platform/pkg/notifyagg"
+	"github.com/edgexr/edge-cloud-platform/pkg/tls"
+)
+
+var notifyAddr = flag.String("notifyAddr", "127.0.0.1:50101", "Notify listener address for CRMs/DMEs")
+var notifyParentAddrs = flag.String("notifyParentAddrs", "127.0.0.1:50001", "Comma separated list of controller notify listener addresses")
+var filterCloudlets = flag.Bool("filterCloudlets", false, "Only mirror data for the cloudlets of connected CRMs, DMEs cannot connect if set")
+var requireNotifyAccessKey = flag.Bool("requireNotifyAccessKey", true, "Require AccessKey authentication of CRMs on notify API")
+var notifyCompression = flag.String("notifyCompression", "none", "Compression to request for controller notify data, one of none, gzip, or zstd")
+var region = flag.String("region", "local", "region name")
+var debugLevels = flag.String("d", "", fmt.Sprintf("comma separated list of %v", log.DebugLevelStrings))
+
+var nodeMgr svcnode.SvcNodeMgr
+var sigChan chan os.Signal
+
+func main() {
+	nodeMgr.InitFlags()
+	flag.Parse()
+	log.SetDebugLevelStrs(*debugLevels)
+
+	compression, err := edgeproto.ParseNoticeCompression(*notifyCompression)
+	if err != nil {
+		log.FatalLog("Invalid notify compression", "err", err)
+	}
+	if *filterCloudlets && *requireNotifyAccessKey {
+		// access keys are verified against the mirrored cloudlets,
+		// which only include connected cloudlets when filtering.
+		log.FatalLog("Cannot filter cloudlets when requiring notify access keys")
+	}
+
+	ctx, span, err := nodeMgr.Init(svcnode.SvcNodeTypeNotifyAgg, svcnode.CertIssuerRegional, svcnode.WithRegion(*region))
+	if err != nil {
+		log.FatalLog("Failed to init node", "err", err)
+	}
+	defer nodeMgr.Finish()
+
+	agg := notifyagg.NewAggregator()
+	agg.FilterByCloudletKey = *filterCloudlets
+
+	clientTlsConfig, err := nodeMgr.InternalPki.GetClientTlsConfig(ctx,
+		nodeMgr.CommonNamePrefix(),
+		svcnode.CertIssuerRegional,
+		[]svcnode.MatchCA{svcnode.SameRegionalMatchCA()})
+	if err != nil {
+		log.FatalLog("Failed to get notify client tls config", "err", err)
+	}
+	addrs := strings.Split(*notifyParentAddrs, ",")
+	notifyClient := notify.NewClient(nodeMgr.Name(), addrs, tls.GetGrpcDialOption(clientTlsConfig), notify.ClientCompression(compression))
+	agg.RegisterClient(notifyClient)
+	nodeMgr.RegisterClient(notifyClient)
+
+	serverTlsConfig, err := nodeMgr.InternalPki.GetServerTlsConfig(ctx,
+		nodeMgr.CommonNamePrefix(),
+		svcnode.CertIssuerRegional,
+		[]svcnode.MatchCA{
+			svcnode.SameRegionalMatchCA(),
+			svcnode.SameRegionalCloudletMatchCA(),
+		})
+	if err != nil {
+		log.FatalLog("Failed to get notify server tls config", "err", err)
+	}
+	notifyServer := &notify.ServerMgr{}
+	agg.RegisterServer(notifyServer)
+	nodeMgr.RegisterServer(notifyServer)
+
+	notifyServer.Start(nodeMgr.Name(), *notifyAddr, serverTlsConfig, agg.AccessKeyServerOps(*requireNotifyAccessKey)...)
+	defer notifyServer.Stop()
+	notifyClient.Start()
+	defer notifyClient.Stop()
+
+	sigChan = make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt)
+
+	log.SpanLog(ctx, log.DebugLevelInfo, "Ready")
+	span.Finish()
+	sig := <-sigChan
+	fmt.Println(sig)
+}
@@ -0,0 +1,129 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/edgexr/edge-cloud-platform/api/nbi"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon/ratelimit"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	uaemcommon "github.com/edgexr/edge-cloud-platform/pkg/uaem-common"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/status"
+)
+
+// Middleware shared by the CAMARA APIs served by the DME.
+
+const HeaderCorrelator = "x-correlator"
+
+func camaraTracer(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		r := log.StartSpanHTTP(c.Request())
+		span := log.SpanFromContext(r.Context())
+		defer span.Finish()
+		c.SetRequest(r)
+		return next(c)
+	}
+}
+
+// camaraErrorHandler converts errors into CAMARA ErrorInfo
+// responses, and echoes back the x-correlator header.
+func camaraErrorHandler(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		xcor := c.Request().Header.Get(HeaderCorrelator)
+		if xcor != "" {
+			c.Response().Header().Set(HeaderCorrelator, xcor)
+		}
+		err := next(c)
+		if err == nil {
+			return nil
+		}
+		errorInfo, ok := err.(*nbi.ErrorInfo)
+		if !ok {
+			if httpErr, ok := err.(*echo.HTTPError); ok {
+				errorInfo = &nbi.ErrorInfo{
+					Status:  httpErr.Code,
+					Code:    http.StatusText(httpErr.Code),
+					Message: fmt.Sprint(httpErr.Message),
+				}
+			} else if st, ok := status.FromError(err); ok {
+				// errors from the grpc based APIs
+				code := gwruntime.HTTPStatusFromCode(st.Code())
+				errorInfo = &nbi.ErrorInfo{
+					Status:  code,
+					Code:    st.Code().String(),
+					Message: st.Message(),
+				}
+			} else {
+				errorInfo = &nbi.ErrorInfo{
+					Status:  http.StatusInternalServerError,
+					Code:    http.StatusText(http.StatusInternalServerError),
+					Message: err.Error(),
+				}
+			}
+		}
+		ctx := c.Request().Context()
+		log.SpanLog(ctx, log.DebugLevelDmereq, "CAMARA API request failed", "path", c.Request().URL.Path, "err", errorInfo)
+		if writeErr := c.JSON(errorInfo.Status, errorInfo); writeErr != nil {
+			log.SpanLog(ctx, log.DebugLevelDmereq, "CAMARA API failed to write error response", "err", err, "writeErr", writeErr)
+		}
+		return nil
+	}
+}
+
+// camaraRateLimiter rate limits requests to the named API by
+// client IP.
+func camaraRateLimiter(apiName string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			callerInfo := &ratelimit.CallerInfo{
+				Api: apiName,
+				Ip:  c.RealIP(),
+			}
+			limitStatus, err := uaemcommon.RateLimitMgr.Limit(c.Request().Context(), callerInfo)
+			if limitStatus != nil {
+				limitStatus.SetHTTPHeaders(c.Response().Header())
+			}
+			if err != nil {
+				return nbi.NewErrorInfo(http.StatusTooManyRequests, fmt.Sprintf("Request rate limited, please retry later. Error is: %s", err))
+			}
+			return next(c)
+		}
+	}
+}
+
+// camaraSessionCookieAuth requires the DME session cookie returned
+// by RegisterClient as a bearer token, the same as the grpc APIs.
+// The decoded cookie key is added to the request context.
+func camaraSessionCookieAuth(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+		auth := req.Header.Get(echo.HeaderAuthorization)
+		cookie, found := strings.CutPrefix(auth, "Bearer ")
+		if !found || cookie == "" {
+			return nbi.NewErrorInfo(http.StatusUnauthorized, "Missing bearer session cookie")
+		}
+		ckey, err := uaemcommon.VerifyCookie(req.Context(), cookie)
+		if err != nil {
+			return nbi.NewErrorInfo(http.StatusUnauthorized, "Invalid session cookie, "+err.Error())
+		}
+		c.SetRequest(req.WithContext(uaemcommon.NewCookieContext(req.Context(), ckey)))
+		return next(c)
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/nbi"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	uaemcommon "github.com/edgexr/edge-cloud-platform/pkg/uaem-common"
+	"github.com/labstack/echo/v4"
+)
+
+// CAMARA Simple Edge Discovery API. This lets standard CAMARA
+// clients find the closest Edge Cloud Zone, or the closest instance
+// of an App, without using the MatchEngine SDK. Requests require the
+// session cookie from RegisterClient. The device location is either
+// supplied by the client, or looked up from the operator network for
+// the device bound to the session.
+
+const (
+	EdgeDiscoveryApiName = "SimpleEdgeDiscovery"
+
+	EdgeDiscoveryFilterClosest = "closest"
+
+	HeaderIPv4Address             = "IPv4-Address"
+	HeaderIPv6Address             = "IPv6-Address"
+	HeaderPhoneNumber             = "Phone-Number"
+	HeaderNetworkAccessIdentifier = "Network-Access-Identifier"
+)
+
+func newEdgeDiscoveryHandler() *echo.Echo {
+	e := echo.New()
+	e.HideBanner = true
+	e.Use(camaraTracer, camaraErrorHandler, camaraRateLimiter(EdgeDiscoveryApiName), camaraSessionCookieAuth)
+
+	g := e.Group(cloudcommon.EdgeDiscoveryRootPath)
+	g.GET("/edge-cloud-zones", getClosestEdgeCloudZones)
+	g.GET("/app-instances", getClosestAppInstances)
+	return e
+}
+
+// getDeviceLocation gets the location supplied by the client, or
+// if not supplied, the location reported by the operator network
+// for the device. It also returns the device's carrier if known.
+// The network location is only looked up for the device that
+// registered the session, identified by its IP address, so that
+// callers cannot locate arbitrary subscribers.
+func getDeviceLocation(c echo.Context) (*dme.Loc, string, error) {
+	ctx := c.Request().Context()
+	latStr := c.QueryParam("latitude")
+	longStr := c.QueryParam("longitude")
+	if latStr != "" || longStr != "" {
+		lat, err := strconv.ParseFloat(latStr, 64)
+		if err != nil {
+			return nil, "", nbi.NewErrorInfo(http.StatusBadRequest, fmt.Sprintf("Invalid latitude %q", latStr))
+		}
+		long, err := strconv.ParseFloat(longStr, 64)
+		if err != nil {
+			return nil, "", nbi.NewErrorInfo(http.StatusBadRequest, fmt.Sprintf("Invalid longitude %q", longStr))
+		}
+		loc := &dme.Loc{
+			Latitude:  lat,
+			Longitude: long,
+		}
+		if err := uaemcommon.ValidateLocation(loc); err != nil {
+			return nil, "", nbi.NewErrorInfo(http.StatusBadRequest, "Invalid location")
+		}
+		return loc, "", nil
+	}
+
+	ckey, ok := uaemcommon.CookieFromContext(ctx)
+	if !ok {
+		return nil, "", nbi.NewErrorInfo(http.StatusUnauthorized, "No valid session cookie")
+	}
+	req := c.Request()
+	if req.Header.Get(HeaderPhoneNumber) != "" || req.Header.Get(HeaderNetworkAccessIdentifier) != "" {
+		return nil, "", nbi.NewErrorInfo(http.StatusForbidden, "Device cannot be verified against the session by phone number or network access identifier, please specify latitude and longitude")
+	}
+	ip := req.Header.Get(HeaderIPv4Address)
+	if ip == "" {
+		ip = req.Header.Get(HeaderIPv6Address)
+	}
+	if ip != "" && ip != ckey.PeerIP {
+		return nil, "", nbi.NewErrorInfo(http.StatusForbidden, "Device IP address does not match the session, please specify latitude and longitude")
+	}
+	if ckey.PeerIP == "" {
+		return nil, "", nbi.NewErrorInfo(http.StatusBadRequest, "No device bound to the session, please specify latitude and longitude")
+	}
+	tags := map[string]string{
+		cloudcommon.TagIpUserEquipment: ckey.PeerIP,
+	}
+	locReq := &dme.GetLocationRequest{
+		Tags: tags,
+	}
+	reply := &dme.GetLocationReply{}
+	if err := operatorApiGw.GetLocation(locReq, reply); err != nil {
+		log.SpanLog(ctx, log.DebugLevelDmereq, "get device location failed", "tags", tags, "err", err)
+		return nil, "", nbi.NewErrorInfo(http.StatusNotFound, "Unable to get device location from the network")
+	}
+	if reply.Status != dme.GetLocationReply_LOC_FOUND || reply.NetworkLocation == nil {
+		return nil, "", nbi.NewErrorInfo(http.StatusNotFound, "Device location not found")
+	}
+	log.SpanLog(ctx, log.DebugLevelDmereq, "got device location from network", "loc", reply.NetworkLocation, "carrier", reply.CarrierName)
+	return reply.NetworkLocation, reply.CarrierName, nil
+}
+
+func checkFilter(c echo.Context) error {
+	filter := c.QueryParam("filter")
+	if filter != "" && filter != EdgeDiscoveryFilterClosest {
+		return nbi.NewErrorInfo(http.StatusBadRequest, fmt.Sprintf("Unsupported filter %q, only %q is supported", filter, EdgeDiscoveryFilterClosest))
+	}
+	return nil
+}
+
+// getClosestEdgeCloudZones returns the closest Edge Cloud Zone to
+// the device. If an appId is given, the zone is the one with the
+// closest instance of the App.
+func getClosestEdgeCloudZones(c echo.Context) error {
+	ctx := c.Request().Context()
+	if err := checkFilter(c); err != nil {
+		return err
+	}
+	loc, carrier, err := getDeviceLocation(c)
+	if err != nil {
+		return err
+	}
+	zones := []nbi.EdgeCloudZone{}
+	if appId := c.QueryParam("appId"); appId != "" {
+		insts, err := findClosestAppInsts(c, appId, carrier, loc)
+		if err != nil {
+			return err
+		}
+		for _, inst := range insts {
+			zones = append(zones, edgeDiscoveryZone(inst.ZoneKey.Organization, inst.ZoneKey.Name, inst.ZoneId))
+		}
+	} else {
+		found := uaemcommon.FindClosestZones(ctx, carrier, loc, 1)
+		if len(found) == 0 {
+			return nbi.NewErrorInfo(http.StatusNotFound, "No Edge Cloud Zone found near the device")
+		}
+		for _, zone := range found {
+			zones = append(zones, edgeDiscoveryZone(zone.ZoneKey.Organization, zone.ZoneKey.Name, zone.ZoneId))
+		}
+	}
+	return c.JSON(http.StatusOK, zones)
+}
+
+// getClosestAppInstances returns the closest instance of the App
+// to the device.
+func getClosestAppInstances(c echo.Context) error {
+	if err := checkFilter(c); err != nil {
+		return err
+	}
+	appId := c.QueryParam("appId")
+	if appId == "" {
+		return nbi.NewErrorInfo(http.StatusBadRequest, "Missing appId")
+	}
+	loc, carrier, err := getDeviceLocation(c)
+	if err != nil {
+		return err
+	}
+	insts, err := findClosestAppInsts(c, appId, carrier, loc)
+	if err != nil {
+		return err
+	}
+	appInsts := []nbi.AppInstanceInfo{}
+	for _, inst := range insts {
+		appInsts = append(appInsts, edgeDiscoveryAppInst(inst))
+	}
+	return c.JSON(http.StatusOK, appInsts)
+}
+
+func findClosestAppInsts(c echo.Context, appId, carrier string, loc *dme.Loc) ([]*uaemcommon.DiscoveredAppInst, error) {
+	ctx := c.Request().Context()
+	appKey, found := uaemcommon.GetAppKeyByObjId(appId)
+	if !found {
+		return nil, nbi.NewErrorInfo(http.StatusNotFound, fmt.Sprintf("App %s not found", appId))
+	}
+	insts := uaemcommon.FindClosestAppInsts(ctx, &appKey, carrier, loc, 1)
+	if len(insts) == 0 {
+		return nil, nbi.NewErrorInfo(http.StatusNotFound, fmt.Sprintf("No instance of App %s found near the device", appId))
+	}
+	return insts, nil
+}
+
+func edgeDiscoveryZone(provider, name, id string) nbi.EdgeCloudZone {
+	status := nbi.EdgeCloudZoneStatusActive
+	return nbi.EdgeCloudZone{
+		EdgeCloudProvider:   provider,
+		EdgeCloudRegion:     region,
+		EdgeCloudZoneId:     id,
+		EdgeCloudZoneName:   name,
+		EdgeCloudZoneStatus: &status,
+	}
+}
+
+func edgeDiscoveryAppInst(in *uaemcommon.DiscoveredAppInst) nbi.AppInstanceInfo {
+	status := nbi.AppInstanceInfoStatusReady
+	ai := nbi.AppInstanceInfo{
+		AppId:           in.AppId,
+		AppInstanceId:   in.AppInstId,
+		AppProvider:     in.AppInstKey.Organization,
+		EdgeCloudZoneId: in.ZoneId,
+		Name:            in.AppInstKey.Name,
+		Status:          &status,
+	}
+	endpoints := []nbi.AppInstanceInfo_ComponentEndpointInfo{}
+	for _, port := range in.Ports {
+		fqdn := port.FqdnPrefix + in.Fqdn
+		endpoints = append(endpoints, nbi.AppInstanceInfo_ComponentEndpointInfo{
+			InterfaceId: port.Id,
+			AccessPoints: nbi.AccessEndpoint{
+				Fqdn: &fqdn,
+				Port: int(port.PublicPort),
+			},
+		})
+	}
+	ai.ComponentEndpointInfo = &endpoints
+	return ai
+}
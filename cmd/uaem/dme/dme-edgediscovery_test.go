@@ -0,0 +1,212 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/api/nbi"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	uaemcommon "github.com/edgexr/edge-cloud-platform/pkg/uaem-common"
+	uaemtest "github.com/edgexr/edge-cloud-platform/pkg/uaem-testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEdgeDiscovery(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelDmereq | log.DebugLevelDmedb)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	eehandler, err := initEdgeEventsPlugin(ctx, "standalone")
+	require.Nil(t, err, "init edge events plugin")
+	uaemcommon.SetupMatchEngine(eehandler)
+	uaemcommon.InitAppInstClients(time.Minute)
+	defer uaemcommon.StopAppInstClients()
+	operatorApiGw, _ = initOperator(ctx, "standalone")
+	initRateLimitMgr()
+	setupJwks()
+
+	for _, c := range uaemtest.Cloudlets {
+		cloudlet := edgeproto.Cloudlet{
+			Key: edgeproto.CloudletKey{
+				Organization: c.CarrierName,
+				Name:         c.Name,
+			},
+			Location: c.Location,
+			Zone:     c.Zone,
+		}
+		uaemcommon.SetInstStateFromCloudlet(ctx, &cloudlet)
+		uaemcommon.SetInstStateFromCloudletInfo(ctx, uaemtest.MakeCloudletInfo(&c))
+		zone := edgeproto.Zone{
+			Key: edgeproto.ZoneKey{
+				Organization: c.CarrierName,
+				Name:         c.Zone,
+			},
+			ObjId: "zone-" + c.Zone,
+		}
+		uaemcommon.DmeAppTbl.Zones.Update(ctx, &zone, 0)
+	}
+	for _, app := range uaemtest.GenerateApps() {
+		app.ObjId = "app-" + app.Key.Name
+		uaemcommon.AddApp(ctx, app)
+	}
+	for _, inst := range uaemtest.GenerateAppInsts() {
+		inst.ObjId = "appinst-" + inst.Key.Name
+		inst.MappedPorts = []edgeproto.InstPort{{
+			Id:         "web",
+			Proto:      dme.LProto_L_PROTO_TCP,
+			PublicPort: 443,
+			FqdnPrefix: "web.",
+		}, {
+			Id:              "internal",
+			Proto:           dme.LProto_L_PROTO_TCP,
+			PublicPort:      9000,
+			InternalVisOnly: true,
+		}}
+		uaemcommon.AddAppInst(ctx, inst)
+	}
+
+	// session cookie for a device registered from 10.10.10.10
+	app := uaemtest.GenerateApps()[0]
+	peerCtx := uaemcommon.PeerContext(ctx, "10.10.10.10", 123, log.SpanFromContext(ctx))
+	expiration := time.Hour
+	cookie, err := uaemcommon.GenerateCookie(&uaemcommon.CookieKey{
+		OrgName: app.Key.Organization,
+		AppName: app.Key.Name,
+		AppVers: app.Key.Version,
+	}, peerCtx, &expiration)
+	require.Nil(t, err)
+
+	handler := newEdgeDiscoveryHandler()
+	getAuth := func(cookie, path string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, cloudcommon.EdgeDiscoveryRootPath+path, nil)
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		if cookie != "" {
+			req.Header.Set("Authorization", "Bearer "+cookie)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+	get := func(path string, headers map[string]string) *httptest.ResponseRecorder {
+		return getAuth(cookie, path, headers)
+	}
+	getZones := func(path string) []nbi.EdgeCloudZone {
+		rec := get(path, nil)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		zones := []nbi.EdgeCloudZone{}
+		require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &zones))
+		return zones
+	}
+	requireError := func(rec *httptest.ResponseRecorder, status int, msg string) {
+		require.Equal(t, status, rec.Code, rec.Body.String())
+		errInfo := nbi.ErrorInfo{}
+		require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &errInfo))
+		require.Equal(t, status, errInfo.Status)
+		require.Contains(t, errInfo.Message, msg)
+	}
+
+	// closest zone to a supplied location, near Beacon
+	munich := "?filter=closest&latitude=48.1351&longitude=11.5820"
+	zones := getZones("/edge-cloud-zones" + munich)
+	require.Equal(t, 1, len(zones))
+	require.Equal(t, "Beacon", zones[0].EdgeCloudZoneName)
+	require.Equal(t, "zone-Beacon", zones[0].EdgeCloudZoneId)
+	require.Equal(t, "GDDT", zones[0].EdgeCloudProvider)
+	require.Equal(t, nbi.EdgeCloudZoneStatusActive, *zones[0].EdgeCloudZoneStatus)
+
+	// zones under maintenance are skipped
+	beacon := uaemtest.Cloudlets[2]
+	maint := edgeproto.Cloudlet{
+		Key: edgeproto.CloudletKey{
+			Organization: beacon.CarrierName,
+			Name:         beacon.Name,
+		},
+		Location:         beacon.Location,
+		Zone:             beacon.Zone,
+		MaintenanceState: dme.MaintenanceState_UNDER_MAINTENANCE,
+	}
+	uaemcommon.SetInstStateFromCloudlet(ctx, &maint)
+	zones = getZones("/edge-cloud-zones" + munich)
+	require.Equal(t, 1, len(zones))
+	require.Equal(t, "Buckhorn", zones[0].EdgeCloudZoneName)
+
+	// closest instance of an app
+	rec := get("/app-instances"+munich+"&appId=app-Untomt", map[string]string{
+		HeaderCorrelator: "abc123",
+	})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, "abc123", rec.Header().Get(HeaderCorrelator))
+	appInsts := []nbi.AppInstanceInfo{}
+	require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &appInsts))
+	require.Equal(t, 1, len(appInsts))
+	ai := appInsts[0]
+	require.Equal(t, "app-Untomt", ai.AppId)
+	require.Equal(t, "appinst-Untomt-Buckhorn-GDDT", ai.AppInstanceId)
+	require.Equal(t, "zone-Buckhorn", ai.EdgeCloudZoneId)
+	require.NotNil(t, ai.ComponentEndpointInfo)
+	require.Equal(t, 1, len(*ai.ComponentEndpointInfo))
+	endpoint := (*ai.ComponentEndpointInfo)[0]
+	require.Equal(t, "web", endpoint.InterfaceId)
+	require.Equal(t, "web.10.1.10.1", *endpoint.AccessPoints.Fqdn)
+	require.Equal(t, 443, endpoint.AccessPoints.Port)
+
+	// zone of the closest instance of an app
+	zones = getZones("/edge-cloud-zones" + munich + "&appId=app-Untomt")
+	require.Equal(t, 1, len(zones))
+	require.Equal(t, "zone-Buckhorn", zones[0].EdgeCloudZoneId)
+
+	// location from the network for the device of the session
+	zones = getZones("/edge-cloud-zones?filter=closest")
+	require.Equal(t, 1, len(zones))
+	rec = get("/edge-cloud-zones?filter=closest", map[string]string{
+		HeaderIPv4Address: "10.10.10.10",
+	})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	// network location of other devices is not allowed
+	requireError(get("/edge-cloud-zones?filter=closest", map[string]string{
+		HeaderIPv4Address: "10.10.10.11",
+	}), http.StatusForbidden, "does not match the session")
+	requireError(get("/edge-cloud-zones?filter=closest", map[string]string{
+		HeaderPhoneNumber: "+123456789",
+	}), http.StatusForbidden, "cannot be verified")
+	requireError(get("/edge-cloud-zones?filter=closest", map[string]string{
+		HeaderNetworkAccessIdentifier: "123456789@example.com",
+	}), http.StatusForbidden, "cannot be verified")
+
+	// session cookie is required
+	requireError(getAuth("", "/edge-cloud-zones"+munich, nil), http.StatusUnauthorized, "Missing bearer session cookie")
+	requireError(getAuth("bad-cookie", "/edge-cloud-zones"+munich, nil), http.StatusUnauthorized, "Invalid session cookie")
+
+	// errors
+	requireError(get("/edge-cloud-zones?filter=all"+"&latitude=1&longitude=1", nil), http.StatusBadRequest, "Unsupported filter")
+	requireError(get("/edge-cloud-zones?latitude=100&longitude=1", nil), http.StatusBadRequest, "Invalid location")
+	requireError(get("/edge-cloud-zones?latitude=abc&longitude=1", nil), http.StatusBadRequest, "Invalid latitude")
+	requireError(get("/app-instances"+munich, nil), http.StatusBadRequest, "Missing appId")
+	requireError(get("/app-instances"+munich+"&appId=unknown", nil), http.StatusNotFound, "App unknown not found")
+	requireError(get("/unknown", nil), http.StatusNotFound, "Not Found")
+}
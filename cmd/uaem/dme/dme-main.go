@@ -25,6 +25,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"time"
 
@@ -40,13 +41,17 @@ import (
 	op "github.com/edgexr/edge-cloud-platform/pkg/nrem-platform"
 	operator "github.com/edgexr/edge-cloud-platform/pkg/nrem-platform"
 	"github.com/edgexr/edge-cloud-platform/pkg/nrem-platform/defaultoperator"
+	"github.com/edgexr/edge-cloud-platform/pkg/nrem-platform/qod"
+	"github.com/edgexr/edge-cloud-platform/pkg/nrem-platform/stuboperator"
 	"github.com/edgexr/edge-cloud-platform/pkg/platform"
 	"github.com/edgexr/edge-cloud-platform/pkg/plugin/edgeevents"
 	pplat "github.com/edgexr/edge-cloud-platform/pkg/plugin/platform"
+	"github.com/edgexr/edge-cloud-platform/pkg/rediscache"
 	"github.com/edgexr/edge-cloud-platform/pkg/tls"
 	uaemcommon "github.com/edgexr/edge-cloud-platform/pkg/uaem-common"
 	"github.com/edgexr/edge-cloud-platform/pkg/util"
 	"github.com/edgexr/edge-cloud-platform/pkg/vault"
+	"github.com/go-redis/redis/v8"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/segmentio/ksuid"
@@ -82,9 +87,15 @@ var cloudletDme = flag.Bool("cloudletDme", false, "this is a cloudlet DME deploy
 // should be replaced by it, but requires dealing with carrier-specific
 // verify location API behavior and e2e test setups.
 var carrier = flag.String("carrier", "standalone", "carrier name for API connection, or standalone for no external APIs")
+var countryPolygonsFile = flag.String("countryPolygonsFile", "", "file of country polygons used to evaluate geo-fences defined by country codes")
 
 var operatorApiGw op.OperatorApiGw
 
+// Optional redis, used to share rate limit state across DME replicas
+var redisCfg rediscache.RedisConfig
+var redisClient *redis.Client
+var qosSessionMgr *qod.SessionManager
+
 // server is used to implement helloworld.GreeterServer.
 type server struct{}
 
@@ -119,11 +130,6 @@ func (s *server) FindCloudlet(ctx context.Context, req *dme.FindCloudletRequest)
 		defer app.Unlock()
 		log.SpanLog(ctx, log.DebugLevelDmereq, "FindCloudlet returned app", "QosSessionProfile", app.QosSessionProfile, "QosSessionDuration", app.QosSessionDuration)
 		qos := app.QosSessionProfile
-		duration := app.QosSessionDuration
-		if duration == 0 {
-			duration = 24 * time.Hour // 24 hours - default value
-		}
-		log.SpanLog(ctx, log.DebugLevelDmereq, "Session duration", "app.QosSessionDuration", app.QosSessionDuration, " derived duration", duration)
 
 		if qos != "DEFAULT" {
 			var protocol string
@@ -191,30 +197,21 @@ func (s *server) FindCloudlet(ctx context.Context, req *dme.FindCloudletRequest)
 				qosReq.PortApplicationServer = asPort
 				qosReq.Profile, _ = dme.ParseQosSessionProfile(qos)
 				qosReq.ProtocolIn, _ = dme.ParseQosSessionProtocol(protocol)
-				qosReq.SessionDuration = uint32(duration.Seconds())
 				log.SpanLog(ctx, log.DebugLevelDmereq, "Built new qosReq", "qosReq", qosReq)
-				sesReply, sesErr := operatorApiGw.CreatePrioritySession(ctx, qosReq)
+				// session duration is taken from the App
+				session, sesErr := qosSessionMgr.CreateSession(ctx, &appkey, qosReq, app.QosSessionDuration)
 				if sesErr != nil {
 					log.SpanLog(ctx, log.DebugLevelDmereq, "CreatePrioritySession failed.", "sesErr", sesErr)
 					reply.QosResult = dme.FindCloudletReply_QOS_SESSION_FAILED
-					reply.QosErrorMsg = sesErr.Error()
+					reply.QosErrorMsg = status.Convert(sesErr).Message()
 				} else {
-					sesReplyToLog := *sesReply // Copy so we can redact session Id in log
-					sesReplyToLog.SessionId = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
-					log.SpanLog(ctx, log.DebugLevelDmereq, "CreatePrioritySession() returned", "sesReply", sesReplyToLog)
+					log.SpanLog(ctx, log.DebugLevelDmereq, "CreatePrioritySession() returned", "expiresAt", session.ExpiresAt)
 
 					// Let the client know the session ID.
 					reply.Tags = make(map[string]string)
-					if sesReply.SessionId != "" {
-						reply.Tags[cloudcommon.TagPrioritySessionId] = sesReply.SessionId
-						reply.Tags[cloudcommon.TagQosProfileName] = qos
-						reply.QosResult = dme.FindCloudletReply_QOS_SESSION_CREATED
-					} else {
-						msg := "No session ID received from QOS API server"
-						log.SpanLog(ctx, log.DebugLevelDmereq, msg)
-						reply.QosResult = dme.FindCloudletReply_QOS_SESSION_FAILED
-						reply.QosErrorMsg = msg
-					}
+					reply.Tags[cloudcommon.TagPrioritySessionId] = session.Id
+					reply.Tags[cloudcommon.TagQosProfileName] = qos
+					reply.QosResult = dme.FindCloudletReply_QOS_SESSION_CREATED
 				}
 			}
 		}
@@ -286,15 +283,17 @@ func (s *server) QosPrioritySessionCreate(ctx context.Context, req *dme.QosPrior
 	log.SpanLog(ctx, log.DebugLevelDmereq, "appkey", "appkey", appkey, "appkey.Name", appkey.Name)
 	if *qosSesAddr != "" {
 		log.SpanLog(ctx, log.DebugLevelDmereq, "qosSesAddr defined", "qosSesAddr", qosSesAddr, "req", req)
-		sesReply, sesErr := operatorApiGw.CreatePrioritySession(ctx, req)
+		if err := validateQosNotificationUri(ctx, req.NotificationUri); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		appDuration, _ := uaemcommon.GetAppQosSessionDuration(&appkey)
+		session, sesErr := qosSessionMgr.CreateSession(ctx, &appkey, req, appDuration)
 		if sesErr != nil {
 			log.SpanLog(ctx, log.DebugLevelDmereq, "CreatePrioritySession failed.", "sesErr", sesErr)
 			return nil, sesErr
 		}
-		reply = sesReply
-		sesReplyToLog := *sesReply // Copy so we can redact session Id in log
-		sesReplyToLog.SessionId = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
-		log.SpanLog(ctx, log.DebugLevelDmereq, "operatorApiGw.CreatePrioritySession() returned", "sesReply", sesReplyToLog, "sesErr", sesErr)
+		reply = session.GetReply()
+		log.SpanLog(ctx, log.DebugLevelDmereq, "CreatePrioritySession() returned", "expiresAt", session.ExpiresAt)
 	} else {
 		return nil, status.Errorf(codes.InvalidArgument, "Cannot create session because qosSesAddr not defined.")
 	}
@@ -317,13 +316,20 @@ func (s *server) QosPrioritySessionDelete(ctx context.Context, req *dme.QosPrior
 		log.SpanLog(ctx, log.DebugLevelDmereq, "qosSesAddr defined", "qosSesAddr", qosSesAddr, "req", req)
 		sesId := req.SessionId
 		log.SpanLog(ctx, log.DebugLevelDmereq, "QOS Priority Session will be deleted", "sesId", sesId)
-		sesReply, sesErr := operatorApiGw.DeletePrioritySession(ctx, req)
+		_, sesErr := getOwnedQosSession(ctx, sesId)
+		if sesErr == nil {
+			sesErr = qosSessionMgr.DeleteSession(ctx, sesId)
+		}
+		if status.Code(sesErr) == codes.NotFound {
+			reply.Status = dme.QosPrioritySessionDeleteReply_QDEL_NOT_FOUND
+			return reply, nil
+		}
 		if sesErr != nil {
 			log.SpanLog(ctx, log.DebugLevelDmereq, "DeletePrioritySession failed.", "sesErr", sesErr)
 			return nil, sesErr
 		}
-		log.SpanLog(ctx, log.DebugLevelDmereq, "operatorApiGw.DeletePrioritySession() successful")
-		reply = sesReply
+		log.SpanLog(ctx, log.DebugLevelDmereq, "DeletePrioritySession() successful")
+		reply.Status = dme.QosPrioritySessionDeleteReply_QDEL_DELETED
 	}
 	return reply, nil
 }
@@ -539,6 +545,9 @@ func initOperator(ctx context.Context, operatorName string) (op.OperatorApiGw, e
 	if operatorName == "" || operatorName == "standalone" {
 		return &defaultoperator.OperatorApiGw{}, nil
 	}
+	if operatorName == stuboperator.OperatorName {
+		return &stuboperator.OperatorApiGw{}, nil
+	}
 	apiGw, err := pplat.GetOperatorApiGw(ctx, operatorName)
 	if err != nil {
 		return nil, err
@@ -606,12 +615,59 @@ func initRateLimitMgr() {
 	if *testMode {
 		disableRateLimit = true
 	}
-	uaemcommon.RateLimitMgr = ratelimit.NewRateLimitManager(disableRateLimit, int(uaemcommon.Settings.RateLimitMaxTrackedIps), 0)
+	ops := []ratelimit.RateLimitManagerOp{}
+	if redisClient != nil {
+		ops = append(ops, ratelimit.WithRedisClient(redisClient))
+	}
+	uaemcommon.RateLimitMgr = ratelimit.NewRateLimitManager(disableRateLimit, int(uaemcommon.Settings.RateLimitMaxTrackedIps), 0, ops...)
+}
+
+// initQosSessionMgr sets up tracking of QoS priority sessions.
+// Sessions are kept in redis if configured so that they are shared
+// by all replicas of the DME. Redis is required if QoS sessions are
+// enabled, otherwise sessions created before a restart or by another
+// replica could not be found to be deleted from the operator network.
+func initQosSessionMgr(ctx context.Context) error {
+	if *qosSesAddr != "" && redisClient == nil {
+		return fmt.Errorf("redis must be configured to share QoS sessions if qossesaddr is set")
+	}
+	var store qod.SessionStore
+	if redisClient != nil {
+		store = qod.NewRedisSessionStore(redisClient)
+	} else {
+		store = qod.NewMemSessionStore()
+	}
+	notifier := qod.NewWebhookNotifier(util.NewPublicHTTPClient(10*time.Second), cloudcommon.QodRootPath+"/sessions")
+	qosSessionMgr = qod.NewSessionManager(operatorApiGw, store, qod.WithSessionEndedCb(notifier))
+	return qosSessionMgr.Start(ctx)
+}
+
+// initEdgeEventsSessionStore sets up checkpointing of edge events
+// sessions. Sessions are kept in redis if configured so that devices
+// can resume their sessions on any replica of the DME.
+func initEdgeEventsSessionStore() {
+	if redisClient != nil {
+		uaemcommon.EESessionStore = uaemcommon.NewRedisEdgeEventsSessionStore(redisClient)
+	} else {
+		uaemcommon.EESessionStore = uaemcommon.NewMemEdgeEventsSessionStore()
+	}
+}
+
+// initFindCloudletStickyStore sets up the store for FindCloudlet
+// sticky sessions, which are shared by all replicas of the DME if
+// redis is configured.
+func initFindCloudletStickyStore() {
+	if redisClient != nil {
+		uaemcommon.FCStickyStore = uaemcommon.NewRedisFindCloudletStickyStore(redisClient)
+	} else {
+		uaemcommon.FCStickyStore = uaemcommon.NewMemFindCloudletStickyStore()
+	}
 }
 
 func main() {
 	nodeMgr.InitFlags()
 	nodeMgr.AccessKeyClient.InitFlags()
+	redisCfg.InitFlags(rediscache.DefaultCfgRedisOptional)
 	flag.Parse()
 	log.SetDebugLevelStrs(*debugLevels)
 	done := make(chan struct{})
@@ -670,6 +726,18 @@ func main() {
 	}
 
 	uaemcommon.SetupMatchEngine(eehandler)
+	if *countryPolygonsFile != "" {
+		resolver, err := uaemcommon.NewPolygonFileCountryResolver(*countryPolygonsFile)
+		if err != nil {
+			span.Finish()
+			log.FatalLog("Failed to load country polygons", "file", *countryPolygonsFile, "err", err)
+		}
+		uaemcommon.GeoCountryResolver = resolver
+	}
+	// let the controller know if country geo-fences can be evaluated
+	nodeMgr.UpdateNodeProps(ctx, map[string]string{
+		cloudcommon.NodePropGeoCountryResolver: strconv.FormatBool(uaemcommon.GeoCountryResolver != nil),
+	})
 	grpcOpts := make([]grpc.ServerOption, 0)
 
 	clientTlsConfig, err := nodeMgr.InternalPki.GetClientTlsConfig(ctx,
@@ -720,7 +788,26 @@ func main() {
 	uaemcommon.InitAppInstClients(time.Duration(uaemcommon.Settings.AppinstClientCleanupInterval))
 	defer uaemcommon.StopAppInstClients()
 
+	if redisCfg.AddrSpecified() {
+		redisClient, err = rediscache.NewClient(ctx, &redisCfg)
+		if err != nil {
+			span.Finish()
+			log.FatalLog("Failed to init redis client", "err", err)
+		}
+		if err := rediscache.IsServerReady(ctx, redisClient, rediscache.MaxRedisWait); err != nil {
+			span.Finish()
+			log.FatalLog("Redis server not ready", "err", err)
+		}
+		defer redisClient.Close()
+	}
 	initRateLimitMgr()
+	initEdgeEventsSessionStore()
+	initFindCloudletStickyStore()
+	if err := initQosSessionMgr(ctx); err != nil {
+		span.Finish()
+		log.FatalLog("Failed to init QoS session manager", "err", err)
+	}
+	defer qosSessionMgr.Stop()
 	grpcOpts = append(grpcOpts,
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(ratelimit.GetDmeUnaryRateLimiterInterceptor(uaemcommon.RateLimitMgr), uaemcommon.UnaryAuthInterceptor, uaemcommon.Stats.UnaryStatsInterceptor)),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(ratelimit.GetDmeStreamRateLimiterInterceptor(uaemcommon.RateLimitMgr), uaemcommon.GetStreamAuthInterceptor(), uaemcommon.Stats.GetStreamStatsInterceptor())))
@@ -847,6 +934,8 @@ func main() {
 		log.FatalLog("Failed to start grpc Gateway", "err", err)
 	}
 	mux.Handle("/", gw)
+	mux.Handle(cloudcommon.EdgeDiscoveryRootPath+"/", newEdgeDiscoveryHandler())
+	mux.Handle(cloudcommon.QodRootPath+"/", newQodHandler())
 	tlscfg, err := publicCertManager.GetServerTlsConfig(ctx)
 	if err != nil {
 		span.Finish()
@@ -170,7 +170,9 @@ func initNotifyClient(ctx context.Context, addrs string, tlsDialOption grpc.Dial
 	notifyClient := notify.NewClient(nodeMgr.Name(), strings.Split(addrs, ","), tlsDialOption, notifyOps...)
 	notifyClient.RegisterRecv(notify.GlobalSettingsRecv(&uaemcommon.Settings, uaemcommon.SettingsUpdated))
 	notifyClient.RegisterRecv(notify.NewAutoProvPolicyRecv(&uaemcommon.AutoProvPolicyHandler{}))
+	notifyClient.RegisterRecv(notify.NewGeoFencePolicyRecv(&uaemcommon.GeoFencePolicyHandler{}))
 	notifyClient.RegisterRecv(notify.NewOperatorCodeRecv(&uaemcommon.DmeAppTbl.OperatorCodes))
+	notifyClient.RegisterRecv(notify.NewZoneRecv(&uaemcommon.DmeAppTbl.Zones))
 	notifyClient.RegisterRecv(notify.NewAppRecv(&AppHandler{}))
 	notifyClient.RegisterRecv(notify.NewCloudletRecv(&CloudletHandler{}))
 	notifyClient.RegisterRecv(notify.NewAppInstRecv(&AppInstHandler{}))
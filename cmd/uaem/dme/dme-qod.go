@@ -0,0 +1,366 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/api/nbi"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/nrem-platform/qod"
+	uaemcommon "github.com/edgexr/edge-cloud-platform/pkg/uaem-common"
+	"github.com/edgexr/edge-cloud-platform/pkg/util"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CAMARA Quality-on-Demand API. Sessions are created on the
+// operator network via the operator API gateway, and are tracked
+// by the qosSessionMgr until they expire or are deleted.
+// Requests are authenticated by the DME session cookie, and
+// sessions are only visible to the App that created them.
+
+const QodApiName = "QualityOnDemand"
+
+// CAMARA QoS profiles
+const (
+	QodProfileE = "QOS_E"
+	QodProfileS = "QOS_S"
+	QodProfileM = "QOS_M"
+	QodProfileL = "QOS_L"
+)
+
+var qodProfiles = map[string]dme.QosSessionProfile{
+	QodProfileE: dme.QosSessionProfile_QOS_LOW_LATENCY,
+	QodProfileS: dme.QosSessionProfile_QOS_THROUGHPUT_DOWN_S,
+	QodProfileM: dme.QosSessionProfile_QOS_THROUGHPUT_DOWN_M,
+	QodProfileL: dme.QosSessionProfile_QOS_THROUGHPUT_DOWN_L,
+}
+
+type QodDevice struct {
+	Ipv4Address *QodDeviceIpv4Addr `json:"ipv4Address,omitempty"`
+	Ipv6Address string             `json:"ipv6Address,omitempty"`
+}
+
+type QodDeviceIpv4Addr struct {
+	PublicAddress  string `json:"publicAddress,omitempty"`
+	PrivateAddress string `json:"privateAddress,omitempty"`
+	PublicPort     int    `json:"publicPort,omitempty"`
+}
+
+type QodApplicationServer struct {
+	Ipv4Address string `json:"ipv4Address,omitempty"`
+	Ipv6Address string `json:"ipv6Address,omitempty"`
+}
+
+type QodPortsSpec struct {
+	Ranges []QodPortRange `json:"ranges,omitempty"`
+	Ports  []int          `json:"ports,omitempty"`
+}
+
+type QodPortRange struct {
+	From int `json:"from"`
+	To   int `json:"to"`
+}
+
+type QodWebhook struct {
+	NotificationUrl       string `json:"notificationUrl"`
+	NotificationAuthToken string `json:"notificationAuthToken,omitempty"`
+}
+
+type QodCreateSession struct {
+	Device                 *QodDevice            `json:"device,omitempty"`
+	ApplicationServer      *QodApplicationServer `json:"applicationServer,omitempty"`
+	DevicePorts            *QodPortsSpec         `json:"devicePorts,omitempty"`
+	ApplicationServerPorts *QodPortsSpec         `json:"applicationServerPorts,omitempty"`
+	QosProfile             string                `json:"qosProfile"`
+	Webhook                *QodWebhook           `json:"webhook,omitempty"`
+	// Duration in seconds
+	Duration int `json:"duration,omitempty"`
+	// AppId is an extension to associate the session with an App,
+	// whose QosSessionDuration is used if Duration is not specified.
+	AppId string `json:"appId,omitempty"`
+}
+
+type QodSessionInfo struct {
+	QodCreateSession
+	SessionId  string `json:"sessionId"`
+	StartedAt  int64  `json:"startedAt"`
+	ExpiresAt  int64  `json:"expiresAt"`
+	QosStatus  string `json:"qosStatus"`
+	StatusInfo string `json:"statusInfo,omitempty"`
+}
+
+type QodExtendSessionDuration struct {
+	// RequestedAdditionalDuration in seconds
+	RequestedAdditionalDuration int `json:"requestedAdditionalDuration"`
+}
+
+func newQodHandler() *echo.Echo {
+	e := echo.New()
+	e.HideBanner = true
+	e.Use(camaraTracer, camaraErrorHandler, camaraRateLimiter(QodApiName), camaraSessionCookieAuth)
+
+	g := e.Group(cloudcommon.QodRootPath)
+	g.POST("/sessions", createQodSession)
+	g.GET("/sessions/:sessionId", getQodSession)
+	g.POST("/sessions/:sessionId/extend", extendQodSession)
+	g.DELETE("/sessions/:sessionId", deleteQodSession)
+	return e
+}
+
+func createQodSession(c echo.Context) error {
+	ctx := c.Request().Context()
+	appKey, err := qodCookieAppKey(ctx)
+	if err != nil {
+		return err
+	}
+	if *qosSesAddr == "" {
+		return nbi.NewErrorInfo(http.StatusServiceUnavailable, "Cannot create session because qosSesAddr not defined")
+	}
+	in := QodCreateSession{}
+	if err := c.Bind(&in); err != nil {
+		return nbi.NewErrorInfo(http.StatusBadRequest, "Invalid request body, "+err.Error())
+	}
+	req := &dme.QosPrioritySessionCreateRequest{
+		ProtocolIn:  dme.QosSessionProtocol_ANY,
+		ProtocolOut: dme.QosSessionProtocol_ANY,
+	}
+	var ok bool
+	req.Profile, ok = qodProfiles[in.QosProfile]
+	if !ok {
+		return nbi.NewErrorInfo(http.StatusBadRequest, fmt.Sprintf("Invalid qosProfile %q", in.QosProfile))
+	}
+	if in.Device != nil {
+		if in.Device.Ipv4Address != nil {
+			req.IpUserEquipment = in.Device.Ipv4Address.PublicAddress
+		} else {
+			req.IpUserEquipment = in.Device.Ipv6Address
+		}
+	}
+	if req.IpUserEquipment == "" {
+		return nbi.NewErrorInfo(http.StatusBadRequest, "Device IP address must be specified")
+	}
+	if in.ApplicationServer != nil {
+		if in.ApplicationServer.Ipv4Address != "" {
+			req.IpApplicationServer = in.ApplicationServer.Ipv4Address
+		} else {
+			req.IpApplicationServer = in.ApplicationServer.Ipv6Address
+		}
+	}
+	if req.IpApplicationServer == "" {
+		return nbi.NewErrorInfo(http.StatusBadRequest, "Application server IP address must be specified")
+	}
+	if in.Duration < 0 {
+		return nbi.NewErrorInfo(http.StatusBadRequest, "Duration must not be negative")
+	}
+	req.SessionDuration = uint32(in.Duration)
+	req.PortUserEquipment = qodPortsToString(in.DevicePorts)
+	req.PortApplicationServer = qodPortsToString(in.ApplicationServerPorts)
+	if in.Webhook != nil {
+		if err := validateQosNotificationUri(ctx, in.Webhook.NotificationUrl); err != nil {
+			return nbi.NewErrorInfo(http.StatusBadRequest, err.Error())
+		}
+		req.NotificationUri = in.Webhook.NotificationUrl
+		req.NotificationAuthToken = in.Webhook.NotificationAuthToken
+	}
+
+	// The session is owned by the App of the session cookie.
+	if in.AppId != "" {
+		key, found := uaemcommon.GetAppKeyByObjId(in.AppId)
+		if !found {
+			return nbi.NewErrorInfo(http.StatusNotFound, fmt.Sprintf("App %s not found", in.AppId))
+		}
+		if key != *appKey {
+			return nbi.NewErrorInfo(http.StatusForbidden, fmt.Sprintf("App %s does not match session cookie", in.AppId))
+		}
+	}
+	appDuration, _ := uaemcommon.GetAppQosSessionDuration(appKey)
+	session, err := qosSessionMgr.CreateSession(ctx, appKey, req, appDuration)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, qodSessionInfo(session, in.AppId))
+}
+
+func getQodSession(c echo.Context) error {
+	ctx := c.Request().Context()
+	session, err := getOwnedQosSession(ctx, c.Param("sessionId"))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, qodSessionInfo(session, ""))
+}
+
+func extendQodSession(c echo.Context) error {
+	ctx := c.Request().Context()
+	in := QodExtendSessionDuration{}
+	if err := c.Bind(&in); err != nil {
+		return nbi.NewErrorInfo(http.StatusBadRequest, "Invalid request body, "+err.Error())
+	}
+	additional := time.Duration(in.RequestedAdditionalDuration) * time.Second
+	if _, err := getOwnedQosSession(ctx, c.Param("sessionId")); err != nil {
+		return err
+	}
+	session, err := qosSessionMgr.ExtendSession(ctx, c.Param("sessionId"), additional)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, qodSessionInfo(session, ""))
+}
+
+func deleteQodSession(c echo.Context) error {
+	ctx := c.Request().Context()
+	if _, err := getOwnedQosSession(ctx, c.Param("sessionId")); err != nil {
+		return err
+	}
+	if err := qosSessionMgr.DeleteSession(ctx, c.Param("sessionId")); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// qodCookieAppKey gets the AppKey from the verified session cookie.
+func qodCookieAppKey(ctx context.Context) (*edgeproto.AppKey, error) {
+	ckey, ok := uaemcommon.CookieFromContext(ctx)
+	if !ok {
+		return nil, nbi.NewErrorInfo(http.StatusUnauthorized, "No valid session cookie")
+	}
+	return &edgeproto.AppKey{
+		Organization: ckey.OrgName,
+		Name:         ckey.AppName,
+		Version:      ckey.AppVers,
+	}, nil
+}
+
+// getOwnedQosSession gets the session if it was created by the App
+// of the session cookie. Sessions owned by other Apps are reported
+// as not found so as not to leak their existence.
+func getOwnedQosSession(ctx context.Context, id string) (*qod.Session, error) {
+	appKey, err := qodCookieAppKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	session, err := qosSessionMgr.GetSession(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if session.AppKey != *appKey {
+		log.SpanLog(ctx, log.DebugLevelDmereq, "QoS session not owned by app", "id", id, "owner", session.AppKey, "app", *appKey)
+		return nil, status.Errorf(codes.NotFound, "Session %s not found", id)
+	}
+	return session, nil
+}
+
+// validateQosNotificationUri restricts session notification
+// callbacks to public https endpoints, since they are requested
+// from within the platform.
+func validateQosNotificationUri(ctx context.Context, uri string) error {
+	if uri == "" {
+		return nil
+	}
+	if err := util.ValidatePublicURL(ctx, uri, "https"); err != nil {
+		return fmt.Errorf("Invalid notification URL, %v", err)
+	}
+	return nil
+}
+
+func qodSessionInfo(session *qod.Session, appId string) *QodSessionInfo {
+	info := &QodSessionInfo{
+		SessionId:  session.Id,
+		StartedAt:  session.StartedAt.Unix(),
+		ExpiresAt:  session.ExpiresAt.Unix(),
+		QosStatus:  string(session.Status),
+		StatusInfo: string(session.StatusInfo),
+	}
+	info.Duration = int(session.Duration.Seconds())
+	info.AppId = appId
+	if info.AppId == "" && session.AppKey.Name != "" {
+		info.AppId = uaemcommon.GetAppObjId(&session.AppKey)
+	}
+	for name, profile := range qodProfiles {
+		if profile == session.Profile {
+			info.QosProfile = name
+		}
+	}
+	info.Device = &QodDevice{}
+	if strings.Contains(session.UeAddr, ":") {
+		info.Device.Ipv6Address = session.UeAddr
+	} else {
+		info.Device.Ipv4Address = &QodDeviceIpv4Addr{
+			PublicAddress: session.UeAddr,
+		}
+	}
+	info.ApplicationServer = &QodApplicationServer{}
+	if strings.Contains(session.AsAddr, ":") {
+		info.ApplicationServer.Ipv6Address = session.AsAddr
+	} else {
+		info.ApplicationServer.Ipv4Address = session.AsAddr
+	}
+	info.DevicePorts = qodPortsFromString(session.UePorts)
+	info.ApplicationServerPorts = qodPortsFromString(session.AsPorts)
+	if session.NotificationUri != "" {
+		// auth token is not returned
+		info.Webhook = &QodWebhook{
+			NotificationUrl: session.NotificationUri,
+		}
+	}
+	return info
+}
+
+// qodPortsToString converts the ports spec to the comma separated
+// ports and port ranges format used by the operator API, i.e.
+// "5000,6000-6010".
+func qodPortsToString(spec *QodPortsSpec) string {
+	if spec == nil {
+		return ""
+	}
+	strs := []string{}
+	for _, r := range spec.Ranges {
+		strs = append(strs, fmt.Sprintf("%d-%d", r.From, r.To))
+	}
+	for _, p := range spec.Ports {
+		strs = append(strs, strconv.Itoa(p))
+	}
+	return strings.Join(strs, ",")
+}
+
+func qodPortsFromString(str string) *QodPortsSpec {
+	if str == "" {
+		return nil
+	}
+	spec := &QodPortsSpec{}
+	for _, s := range strings.Split(str, ",") {
+		if from, to, found := strings.Cut(s, "-"); found {
+			fromPort, err1 := strconv.Atoi(strings.TrimSpace(from))
+			toPort, err2 := strconv.Atoi(strings.TrimSpace(to))
+			if err1 == nil && err2 == nil {
+				spec.Ranges = append(spec.Ranges, QodPortRange{From: fromPort, To: toPort})
+			}
+		} else if port, err := strconv.Atoi(strings.TrimSpace(s)); err == nil {
+			spec.Ports = append(spec.Ports, port)
+		}
+	}
+	return spec
+}
@@ -0,0 +1,193 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/api/nbi"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/nrem-platform/qod"
+	"github.com/edgexr/edge-cloud-platform/pkg/nrem-platform/stuboperator"
+	uaemcommon "github.com/edgexr/edge-cloud-platform/pkg/uaem-common"
+	uaemtest "github.com/edgexr/edge-cloud-platform/pkg/uaem-testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQod(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelDmereq | log.DebugLevelDmedb)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	eehandler, err := initEdgeEventsPlugin(ctx, "standalone")
+	require.Nil(t, err, "init edge events plugin")
+	uaemcommon.SetupMatchEngine(eehandler)
+	operatorApiGw, err = initOperator(ctx, stuboperator.OperatorName)
+	require.Nil(t, err)
+	initRateLimitMgr()
+	require.Nil(t, initQosSessionMgr(ctx))
+	defer qosSessionMgr.Stop()
+	setupJwks()
+	origQosSesAddr := *qosSesAddr
+	*qosSesAddr = "https://qos.example.com"
+	defer func() { *qosSesAddr = origQosSesAddr }()
+
+	// sessions must be in a shared store if QoS is enabled
+	err = initQosSessionMgr(ctx)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "redis must be configured")
+
+	apps := uaemtest.GenerateApps()
+	app := apps[0]
+	app.ObjId = "app-qod"
+	app.QosSessionDuration = edgeproto.Duration(time.Hour)
+	uaemcommon.AddApp(ctx, app)
+	otherApp := apps[1]
+	otherApp.ObjId = "app-qod-other"
+	uaemcommon.AddApp(ctx, otherApp)
+
+	genCookie := func(app *edgeproto.App) string {
+		peerCtx := uaemcommon.PeerContext(ctx, "127.0.0.1", 123, log.SpanFromContext(ctx))
+		expiration := time.Hour
+		cookie, err := uaemcommon.GenerateCookie(&uaemcommon.CookieKey{
+			OrgName: app.Key.Organization,
+			AppName: app.Key.Name,
+			AppVers: app.Key.Version,
+		}, peerCtx, &expiration)
+		require.Nil(t, err)
+		return cookie
+	}
+	appCookie := genCookie(app)
+	otherCookie := genCookie(otherApp)
+
+	handler := newQodHandler()
+	doAuth := func(cookie, method, path string, body interface{}) *httptest.ResponseRecorder {
+		var buf bytes.Buffer
+		if body != nil {
+			require.Nil(t, json.NewEncoder(&buf).Encode(body))
+		}
+		req := httptest.NewRequest(method, cloudcommon.QodRootPath+path, &buf)
+		req.Header.Set("Content-Type", "application/json")
+		if cookie != "" {
+			req.Header.Set("Authorization", "Bearer "+cookie)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+	do := func(method, path string, body interface{}) *httptest.ResponseRecorder {
+		return doAuth(appCookie, method, path, body)
+	}
+	getInfo := func(rec *httptest.ResponseRecorder, status int) *QodSessionInfo {
+		require.Equal(t, status, rec.Code, rec.Body.String())
+		info := &QodSessionInfo{}
+		require.Nil(t, json.Unmarshal(rec.Body.Bytes(), info))
+		return info
+	}
+	requireError := func(rec *httptest.ResponseRecorder, status int, msg string) {
+		require.Equal(t, status, rec.Code, rec.Body.String())
+		errInfo := nbi.ErrorInfo{}
+		require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &errInfo))
+		require.Equal(t, status, errInfo.Status)
+		require.Contains(t, errInfo.Message, msg)
+	}
+
+	create := QodCreateSession{
+		Device: &QodDevice{
+			Ipv4Address: &QodDeviceIpv4Addr{PublicAddress: "10.1.1.1"},
+		},
+		ApplicationServer: &QodApplicationServer{
+			Ipv4Address: "10.2.2.2",
+		},
+		DevicePorts: &QodPortsSpec{
+			Ranges: []QodPortRange{{From: 5000, To: 5010}},
+			Ports:  []int{6000},
+		},
+		QosProfile: QodProfileE,
+		AppId:      "app-qod",
+	}
+	// duration comes from the App
+	info := getInfo(do(http.MethodPost, "/sessions", &create), http.StatusCreated)
+	require.NotEmpty(t, info.SessionId)
+	require.Equal(t, 3600, info.Duration)
+	require.Equal(t, "app-qod", info.AppId)
+	require.Equal(t, QodProfileE, info.QosProfile)
+	require.Equal(t, string(qod.SessionStatusAvailable), info.QosStatus)
+	require.Equal(t, create.DevicePorts, info.DevicePorts)
+	require.Equal(t, info.StartedAt+3600, info.ExpiresAt)
+	sessionId := info.SessionId
+
+	check := getInfo(do(http.MethodGet, "/sessions/"+sessionId, nil), http.StatusOK)
+	require.Equal(t, info, check)
+
+	// session requires auth and is only visible to its owner
+	requireError(doAuth("", http.MethodGet, "/sessions/"+sessionId, nil), http.StatusUnauthorized, "Missing bearer session cookie")
+	requireError(doAuth("bad-cookie", http.MethodGet, "/sessions/"+sessionId, nil), http.StatusUnauthorized, "Invalid session cookie")
+	requireError(doAuth("", http.MethodPost, "/sessions", &create), http.StatusUnauthorized, "Missing bearer session cookie")
+	requireError(doAuth(otherCookie, http.MethodGet, "/sessions/"+sessionId, nil), http.StatusNotFound, "not found")
+	requireError(doAuth(otherCookie, http.MethodPost, "/sessions/"+sessionId+"/extend", &QodExtendSessionDuration{RequestedAdditionalDuration: 600}), http.StatusNotFound, "not found")
+	requireError(doAuth(otherCookie, http.MethodDelete, "/sessions/"+sessionId, nil), http.StatusNotFound, "not found")
+	requireError(doAuth(otherCookie, http.MethodPost, "/sessions", &create), http.StatusForbidden, "does not match session cookie")
+
+	extend := QodExtendSessionDuration{RequestedAdditionalDuration: 600}
+	check = getInfo(do(http.MethodPost, "/sessions/"+sessionId+"/extend", &extend), http.StatusOK)
+	require.Equal(t, 4200, check.Duration)
+	require.Equal(t, info.ExpiresAt+600, check.ExpiresAt)
+
+	rec := do(http.MethodDelete, "/sessions/"+sessionId, nil)
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+	requireError(do(http.MethodGet, "/sessions/"+sessionId, nil), http.StatusNotFound, "not found")
+	requireError(do(http.MethodDelete, "/sessions/"+sessionId, nil), http.StatusNotFound, "not found")
+
+	// errors
+	bad := create
+	bad.QosProfile = "QOS_X"
+	requireError(do(http.MethodPost, "/sessions", &bad), http.StatusBadRequest, "Invalid qosProfile")
+	bad = create
+	bad.Device = nil
+	requireError(do(http.MethodPost, "/sessions", &bad), http.StatusBadRequest, "Device IP address must be specified")
+	bad = create
+	bad.AppId = "app-unknown"
+	requireError(do(http.MethodPost, "/sessions", &bad), http.StatusNotFound, "App app-unknown not found")
+	bad = create
+	bad.Duration = int((48 * time.Hour).Seconds())
+	requireError(do(http.MethodPost, "/sessions", &bad), http.StatusBadRequest, "exceeds maximum")
+	bad = create
+	bad.ApplicationServer = &QodApplicationServer{Ipv4Address: "not-an-ip"}
+	requireError(do(http.MethodPost, "/sessions", &bad), http.StatusBadRequest, "Invalid Address")
+	for _, url := range []string{
+		"http://8.8.8.8/notify",
+		"https://127.0.0.1/notify",
+		"https://10.0.0.1/notify",
+		"https://169.254.169.254/latest/meta-data",
+		"https://[::1]/notify",
+	} {
+		bad = create
+		bad.Webhook = &QodWebhook{NotificationUrl: url}
+		requireError(do(http.MethodPost, "/sessions", &bad), http.StatusBadRequest, "Invalid notification URL")
+	}
+
+	*qosSesAddr = ""
+	requireError(do(http.MethodPost, "/sessions", &create), http.StatusServiceUnavailable, "qosSesAddr not defined")
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/edgexr/edge-cloud-platform/pkg/promutils"
 	"github.com/edgexr/edge-cloud-platform/pkg/util"
 	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
 var MEXPrometheusUserAlertsT = `additionalPrometheusRules:
@@ -37,7 +38,7 @@ var MEXPrometheusUserAlertsT = `additionalPrometheusRules:
     rules:
     [[- range .ClusterAlerts ]]
     - alert: [[ .Rule.Alert ]]
-      expr: [[ .Rule.Expr ]]
+      expr: [[ .ExprString ]]
       for: [[ .TriggerTimeString ]]
       labels:
         [[- range $key, $value := .Rule.Labels ]]
@@ -56,6 +57,8 @@ var MEXPrometheusUserAlertsT = `additionalPrometheusRules:
 type PrometheusClusterAlert struct {
 	prommgmt.Rule
 	TriggerTimeString string
+	// Rule.Expr as it is written into the yaml
+	ExprString string
 }
 
 type AlertArgs struct {
@@ -71,8 +74,8 @@ func getAlertRulesArgs(ctx context.Context, appInst *edgeproto.AppInst, alerts [
 		`",label_mexAppVersion="` + util.DNSSanitize(appInst.AppKey.Version) + `"}`
 
 	for ii := range alerts {
-		// if this is an envoy-based alert, skip it
-		if alerts[ii].ActiveConnLimit != 0 {
+		// if this is a cloudlet prometheus alert, skip it
+		if IsCloudletAlertPolicy(&alerts[ii]) {
 			continue
 		}
 		rule := getPromAlertFromEdgeprotoAlert(appInst, &alerts[ii])
@@ -81,24 +84,41 @@ func getAlertRulesArgs(ctx context.Context, appInst *edgeproto.AppInst, alerts [
 			TriggerTimeString: alerts[ii].TriggerTime.TimeDuration().String(),
 		}
 
-		// Create a prometheus expression for the alert rule
-		expressions := []string{}
-		if alerts[ii].CpuUtilizationLimit != 0 {
-			cpuQuery := promutils.GetPromQueryWithK8sLabels(labelFilter, promutils.PromQCpuPod)
-			exp := fmt.Sprintf("%s > %d", cpuQuery, alerts[ii].CpuUtilizationLimit)
-			expressions = append(expressions, exp)
+		if alerts[ii].CustomExpression != "" {
+			expr, err := GetCustomAlertExpr(appInst, &alerts[ii])
+			if err != nil {
+				log.SpanLog(ctx, log.DebugLevelInfo, "Skipping invalid custom alert expression", "appInst", appInst.Key,
+					"alert", alerts[ii].Key, "err", err)
+				continue
+			}
+			promAlert.Rule.Expr = expr
+			// user expression may contain characters that are special to yaml
+			promAlert.ExprString = "'" + strings.ReplaceAll(expr, "'", "''") + "'"
+			// shepherd identifies cluster Prometheus alerts by the
+			// pod labels, which the custom expression may aggregate away
+			promAlert.Rule.Labels[promutils.ClusterPrometheusAppLabel] = util.DNSSanitize(appInst.AppKey.Name)
+			promAlert.Rule.Labels[promutils.ClusterPrometheusAppVersionLabel] = util.DNSSanitize(appInst.AppKey.Version)
+		} else {
+			// Create a prometheus expression for the alert rule
+			expressions := []string{}
+			if alerts[ii].CpuUtilizationLimit != 0 {
+				cpuQuery := promutils.GetPromQueryWithK8sLabels(labelFilter, promutils.PromQCpuPod)
+				exp := fmt.Sprintf("%s > %d", cpuQuery, alerts[ii].CpuUtilizationLimit)
+				expressions = append(expressions, exp)
+			}
+			if alerts[ii].MemUtilizationLimit != 0 {
+				memQuery := promutils.GetPromQueryWithK8sLabels(labelFilter, promutils.PromQMemPercentPod)
+				exp := fmt.Sprintf("%s > %d", memQuery, alerts[ii].MemUtilizationLimit)
+				expressions = append(expressions, exp)
+			}
+			if alerts[ii].DiskUtilizationLimit != 0 {
+				diskQuery := promutils.GetPromQueryWithK8sLabels(labelFilter, promutils.PromQDiskPercentPod)
+				exp := fmt.Sprintf("%s > %d", diskQuery, alerts[ii].DiskUtilizationLimit)
+				expressions = append(expressions, exp)
+			}
+			promAlert.Rule.Expr = strings.Join(expressions, " and ")
+			promAlert.ExprString = promAlert.Rule.Expr
 		}
-		if alerts[ii].MemUtilizationLimit != 0 {
-			memQuery := promutils.GetPromQueryWithK8sLabels(labelFilter, promutils.PromQMemPercentPod)
-			exp := fmt.Sprintf("%s > %d", memQuery, alerts[ii].MemUtilizationLimit)
-			expressions = append(expressions, exp)
-		}
-		if alerts[ii].DiskUtilizationLimit != 0 {
-			diskQuery := promutils.GetPromQueryWithK8sLabels(labelFilter, promutils.PromQDiskPercentPod)
-			exp := fmt.Sprintf("%s > %d", diskQuery, alerts[ii].DiskUtilizationLimit)
-			expressions = append(expressions, exp)
-		}
-		promAlert.Rule.Expr = strings.Join(expressions, " and ")
 
 		log.SpanLog(ctx, log.DebugLevelInfo, "Adding Prometheus user alert rule", "appInst", appInst,
 			"alert", promAlert)
@@ -130,15 +150,25 @@ func GetCloudletAlertRules(ctx context.Context, appInst *edgeproto.AppInst, aler
 	grp := prommgmt.NewRuleGroup("user-alerts", appInst.AppKey.Organization)
 
 	for ii, _ := range alerts {
-		if alerts[ii].ActiveConnLimit == 0 {
+		if !IsCloudletAlertPolicy(&alerts[ii]) {
 			continue
 		}
 		rule := getPromAlertFromEdgeprotoAlert(appInst, &alerts[ii])
-		rule.Expr = `envoy_cluster_upstream_cx_active{` +
-			edgeproto.AppKeyTagName + `="` + appInst.AppKey.Name + `",` +
-			edgeproto.AppKeyTagVersion + `="` + appInst.AppKey.Version + `",` +
-			edgeproto.AppKeyTagOrganization + `="` + appInst.AppKey.Organization +
-			`"} > ` + fmt.Sprintf("%d", alerts[ii].ActiveConnLimit)
+		if alerts[ii].CustomExpression != "" {
+			expr, err := GetCustomAlertExpr(appInst, &alerts[ii])
+			if err != nil {
+				log.SpanLog(ctx, log.DebugLevelInfo, "Skipping invalid custom alert expression", "appInst", appInst.Key,
+					"alert", alerts[ii].Key, "err", err)
+				continue
+			}
+			rule.Expr = expr
+		} else {
+			rule.Expr = `envoy_cluster_upstream_cx_active{` +
+				edgeproto.AppKeyTagName + `="` + appInst.AppKey.Name + `",` +
+				edgeproto.AppKeyTagVersion + `="` + appInst.AppKey.Version + `",` +
+				edgeproto.AppKeyTagOrganization + `="` + appInst.AppKey.Organization +
+				`"} > ` + fmt.Sprintf("%d", alerts[ii].ActiveConnLimit)
+		}
 
 		log.SpanLog(ctx, log.DebugLevelInfo, "Adding Cloudlet Prometheus user alert rule", "appInst", appInst,
 			"rule", rule)
@@ -151,6 +181,65 @@ func GetCloudletAlertRules(ctx context.Context, appInst *edgeproto.AppInst, aler
 	return grp
 }
 
+// IsCloudletAlertPolicy returns true if the alert is evaluated by the
+// cloudlet Prometheus against load balancer metrics, rather than by
+// the Prometheus in the AppInst's cluster.
+func IsCloudletAlertPolicy(alert *edgeproto.AlertPolicy) bool {
+	if alert.CustomExpression != "" {
+		return alert.CustomMetricsSource == edgeproto.AlertMetricsSource_ALERT_METRICS_SOURCE_LOAD_BALANCER
+	}
+	return alert.ActiveConnLimit != 0
+}
+
+// Label matchers added to each metric of a custom alert expression
+// to restrict it to the AppInst.
+func getCustomAlertExprScope(appInst *edgeproto.AppInst, alert *edgeproto.AlertPolicy) map[string]string {
+	if IsCloudletAlertPolicy(alert) {
+		// envoy metrics are labeled by the cloudlet Prometheus targets
+		return map[string]string{
+			edgeproto.AppInstKeyTagName:         appInst.Key.Name,
+			edgeproto.AppInstKeyTagOrganization: appInst.Key.Organization,
+		}
+	}
+	// application pod metrics are labeled by the cluster
+	// Prometheus scrape config from the pod labels
+	labels := cloudcommon.GetAppInstLabels(appInst)
+	return map[string]string{
+		promutils.ClusterPrometheusAppInstLabel:    labels.AppInstNameLabel,
+		promutils.ClusterPrometheusAppInstOrgLabel: labels.AppInstOrgLabel,
+	}
+}
+
+// GetCustomAlertExpr gets the alert policy's custom expression
+// scoped to the AppInst.
+func GetCustomAlertExpr(appInst *edgeproto.AppInst, alert *edgeproto.AlertPolicy) (string, error) {
+	return promutils.ScopePromQLExpr(alert.CustomExpression, getCustomAlertExprScope(appInst, alert))
+}
+
+// ValidateCustomExpression checks that the alert policy's custom
+// expression, if any, can be scoped to an AppInst.
+func ValidateCustomExpression(alert *edgeproto.AlertPolicy) error {
+	if alert.CustomExpression == "" {
+		return nil
+	}
+	appInst := edgeproto.AppInst{}
+	appInst.Key.Name = "appinst"
+	appInst.Key.Organization = alert.Key.Organization
+	expr, err := GetCustomAlertExpr(&appInst, alert)
+	if err != nil {
+		return fmt.Errorf("Invalid custom expression, %s", err)
+	}
+	// alerting rules fire for each series of the result
+	parsed, err := parser.ParseExpr(expr)
+	if err != nil {
+		return fmt.Errorf("Invalid custom expression, %s", err)
+	}
+	if parsed.Type() != parser.ValueTypeVector && parsed.Type() != parser.ValueTypeScalar {
+		return fmt.Errorf("Invalid custom expression, expression must evaluate to an instant vector, not a %s", parsed.Type())
+	}
+	return nil
+}
+
 func getPromAlertFromEdgeprotoAlert(appInst *edgeproto.AppInst, alert *edgeproto.AlertPolicy) prommgmt.Rule {
 	rule := prommgmt.Rule{}
 	rule.Alert = alert.Key.Name
@@ -196,5 +285,8 @@ func getAlertPolicyDescription(in *edgeproto.AlertPolicy) string {
 		connStr := fmt.Sprintf("Number of active connections > %d", in.ActiveConnLimit)
 		defaultDescription = append(defaultDescription, connStr)
 	}
+	if in.CustomExpression != "" {
+		defaultDescription = append(defaultDescription, in.CustomExpression)
+	}
 	return strings.Join(defaultDescription, " and ")
 }
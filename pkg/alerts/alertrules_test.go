@@ -15,11 +15,18 @@
 package alerts
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/prommgmt"
+	"github.com/edgexr/edge-cloud-platform/pkg/promutils"
 	"github.com/edgexr/edge-cloud-platform/test/testutil"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
 )
 
 func TestGetPromAlertFromEdgeprotoAlert(t *testing.T) {
@@ -63,3 +70,84 @@ func TestGetPromAlertFromEdgeprotoAlert(t *testing.T) {
 	require.Equal(t, alert.Annotations[cloudcommon.AlertAnnotationTitle],
 		rule.Annotations[cloudcommon.AlertAnnotationTitle], "Title is in annotations")
 }
+
+func TestCustomExpressionAlertRules(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelInfo)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	appInst := testutil.AppInstData()[0]
+	appLabels := cloudcommon.GetAppInstLabels(&appInst)
+
+	appAlert := edgeproto.AlertPolicy{
+		Key: edgeproto.AlertPolicyKey{
+			Organization: appInst.AppKey.Organization,
+			Name:         "errorRate",
+		},
+		CustomExpression: "sum(rate(http_errors_total{code=~'5..'}[5m])) > 5",
+		Severity:         "error",
+		TriggerTime:      edgeproto.Duration(time.Minute),
+	}
+	lbAlert := edgeproto.AlertPolicy{
+		Key: edgeproto.AlertPolicyKey{
+			Organization: appInst.AppKey.Organization,
+			Name:         "connectFailures",
+		},
+		CustomExpression:    "sum(rate(envoy_cluster_upstream_cx_connect_fail[5m])) > 1",
+		CustomMetricsSource: edgeproto.AlertMetricsSource_ALERT_METRICS_SOURCE_LOAD_BALANCER,
+		Severity:            "warning",
+	}
+	require.Nil(t, ValidateCustomExpression(&appAlert))
+	require.Nil(t, ValidateCustomExpression(&lbAlert))
+	badAlert := appAlert
+	for _, expr := range []string{
+		"http_errors_total > > 5",
+		"rate(http_errors_total) > 5",
+		"http_errors_total[5m]",
+	} {
+		badAlert.CustomExpression = expr
+		err := ValidateCustomExpression(&badAlert)
+		require.NotNil(t, err, expr)
+		require.Contains(t, err.Error(), "Invalid custom expression", expr)
+	}
+	require.False(t, IsCloudletAlertPolicy(&appAlert))
+	require.True(t, IsCloudletAlertPolicy(&lbAlert))
+	policies := []edgeproto.AlertPolicy{appAlert, lbAlert}
+
+	// app metrics are evaluated by the cluster prometheus
+	rules, err := GetAlertRules(ctx, &appInst, policies)
+	require.Nil(t, err)
+	parsed := struct {
+		AdditionalPrometheusRules []struct {
+			Groups []prommgmt.RuleGroup `yaml:"groups"`
+		} `yaml:"additionalPrometheusRules"`
+	}{}
+	err = yaml.Unmarshal([]byte(rules), &parsed)
+	require.Nil(t, err, rules)
+	require.Equal(t, 1, len(parsed.AdditionalPrometheusRules))
+	require.Equal(t, 1, len(parsed.AdditionalPrometheusRules[0].Groups))
+	clusterRules := parsed.AdditionalPrometheusRules[0].Groups[0].Rules
+	require.Equal(t, 1, len(clusterRules))
+	expectedExpr := `sum(rate(http_errors_total{code=~"5..",` +
+		promutils.ClusterPrometheusAppInstLabel + `="` + appLabels.AppInstNameLabel + `",` +
+		promutils.ClusterPrometheusAppInstOrgLabel + `="` + appLabels.AppInstOrgLabel + `"}[5m])) > 5`
+	require.Equal(t, expectedExpr, clusterRules[0].Expr)
+	require.Equal(t, appAlert.Key.Name, clusterRules[0].Alert)
+	require.Equal(t, cloudcommon.AlertTypeUserDefined, clusterRules[0].Labels[cloudcommon.AlertTypeLabel])
+	require.Equal(t, "error", clusterRules[0].Labels[cloudcommon.AlertSeverityLabel])
+	require.NotEmpty(t, clusterRules[0].Labels[promutils.ClusterPrometheusAppLabel])
+	require.Equal(t, appAlert.CustomExpression, clusterRules[0].Annotations[cloudcommon.AlertAnnotationDescription])
+
+	// load balancer metrics are evaluated by the cloudlet prometheus
+	grp := GetCloudletAlertRules(ctx, &appInst, policies)
+	require.NotNil(t, grp)
+	require.Equal(t, 1, len(grp.Rules))
+	expectedExpr = `sum(rate(envoy_cluster_upstream_cx_connect_fail{` +
+		edgeproto.AppInstKeyTagName + `="` + appInst.Key.Name + `",` +
+		edgeproto.AppInstKeyTagOrganization + `="` + appInst.Key.Organization + `"}[5m])) > 1`
+	require.Equal(t, expectedExpr, grp.Rules[0].Expr)
+	require.Equal(t, lbAlert.Key.Name, grp.Rules[0].Alert)
+	_, found := grp.Rules[0].Labels[promutils.ClusterPrometheusAppLabel]
+	require.False(t, found)
+}
@@ -41,6 +41,8 @@ func alertChanged(ctx context.Context, old *edgeproto.Alert, new *edgeproto.Aler
 		fallthrough
 	case cloudcommon.AlertAutoScaleDown:
 		clusterAutoScaleWorkers.NeedsWork(ctx, new.GetKeyVal())
+	case cloudcommon.AlertAppInstAutoScale:
+		appInstAutoScaleWorkers.NeedsWork(ctx, new.GetKeyVal())
 	case cloudcommon.AlertAutoUndeploy:
 		handler = autoUndeploy
 	}
@@ -174,6 +174,10 @@ func (s *DummyController) ShowAppInst(in *edgeproto.AppInst, server edgeproto.Ap
 	return err
 }
 
+func (s *DummyController) MoveAppInst(in *edgeproto.AppInstMove, server edgeproto.AppInstApi_MoveAppInstServer) error {
+	return nil
+}
+
 func (s *DummyController) HandleFedAppInstEvent(ctx context.Context, event *edgeproto.FedAppInstEvent) (*edgeproto.Result, error) {
 	return &edgeproto.Result{}, nil
 }
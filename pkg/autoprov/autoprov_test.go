@@ -120,6 +120,40 @@ func testAutoScale(t *testing.T, ctx context.Context, ds *testutil.DummyServer,
 	dn.AlertCache.Delete(ctx, &scaledown, 0)
 	ds.ClusterInstCache.Delete(ctx, &cinst, 0)
 	require.Equal(t, 0, len(cacheData.frClusterInsts.InstsByCloudlet))
+
+	// initial state of AppInst
+	ainst := testutil.CreatedAppInstData()[0]
+	ainst.Replicas = 2
+	ds.AppInstCache.Update(ctx, &ainst, 0)
+
+	// appinst scale alert
+	appscale := edgeproto.Alert{}
+	appscale.Labels = ainst.Key.GetTags()
+	appscale.Labels["alertname"] = cloudcommon.AlertAppInstAutoScale
+	appscale.Annotations = make(map[string]string)
+	appscale.State = "firing"
+	appscale.Value = 3
+	dn.AlertCache.Update(ctx, &appscale, 0)
+	requireAppInstReplicas(t, &ds.AppInstCache, &ainst.Key, 3)
+	dn.AlertCache.Delete(ctx, &appscale, 0)
+	ds.AppInstCache.Delete(ctx, &ainst, 0)
+}
+
+func requireAppInstReplicas(t *testing.T, cache *edgeproto.AppInstCache, key *edgeproto.AppInstKey, replicas int32) {
+	checkCount := int32(-1)
+	for ii := 0; ii < 10; ii++ {
+		ainst := edgeproto.AppInst{}
+		if !cache.Get(key, &ainst) {
+			require.True(t, false, "app inst should have been found, %v", key)
+		}
+		checkCount = ainst.Replicas
+		if checkCount != replicas {
+			time.Sleep(10 * time.Millisecond)
+			continue
+		}
+		break
+	}
+	require.Equal(t, replicas, checkCount, "AppInst Replicas count mismatch")
 }
 
 func requireClusterInstNumNodes(t *testing.T, cache *edgeproto.ClusterInstCache, key *edgeproto.ClusterKey, numnodes int) {
@@ -30,9 +30,11 @@ import (
 )
 
 var clusterAutoScaleWorkers tasks.KeyWorkers
+var appInstAutoScaleWorkers tasks.KeyWorkers
 
 func init() {
 	clusterAutoScaleWorkers.Init("cluster-autoscale", runAutoScale)
+	appInstAutoScaleWorkers.Init("appinst-autoscale", runAppInstAutoScale)
 }
 
 func runAutoScale(ctx context.Context, k interface{}) {
@@ -119,3 +121,72 @@ func scaleClusterInst(ctx context.Context, name string, alert *edgeproto.Alert,
 	}
 	return err
 }
+
+func runAppInstAutoScale(ctx context.Context, k interface{}) {
+	key, ok := k.(edgeproto.AlertKey)
+	if !ok {
+		log.SpanLog(ctx, log.DebugLevelApi, "Unexpected failure, appinst autoscale key not an AlertKey", "key", k)
+		return
+	}
+	// get alert
+	alert := edgeproto.Alert{}
+	if !cacheData.alertCache.Get(&key, &alert) {
+		// no more alert, no work needed
+		return
+	}
+	log.SpanLog(ctx, log.DebugLevelApi, "processing appinst autoscale alert", "alert", alert)
+	if alert.State != "firing" {
+		return
+	}
+	name := alert.Labels["alertname"]
+
+	inst := edgeproto.AppInst{}
+	inst.Key.Organization = alert.Labels[edgeproto.AppInstKeyTagOrganization]
+	inst.Key.Name = alert.Labels[edgeproto.AppInstKeyTagName]
+	inst.Replicas = int32(alert.Value)
+	inst.Fields = []string{edgeproto.AppInstFieldReplicas}
+
+	log.SpanLog(ctx, log.DebugLevelApi, "auto scaling appinst", "alert", alert, "AppInst", inst)
+	err := scaleAppInst(ctx, name, &alert, &inst)
+	if err != nil && err.Error() != inst.Key.NotFoundError().Error() {
+		// retry
+		delay := settings.ClusterAutoScaleRetryDelay.TimeDuration()
+		log.SpanLog(ctx, log.DebugLevelApi, "Scaling AppInst failed, will retry", "AppInst", inst.Key, "retrydelay", delay.String(), "err", err)
+		time.Sleep(delay)
+		appInstAutoScaleWorkers.NeedsWork(ctx, key)
+	}
+}
+
+func scaleAppInst(ctx context.Context, name string, alert *edgeproto.Alert, inst *edgeproto.AppInst) error {
+	conn, err := grpc.Dial(*ctrlAddr, dialOpts, grpc.WithBlock(),
+		grpc.WithUnaryInterceptor(log.UnaryClientTraceGrpc),
+		grpc.WithStreamInterceptor(log.StreamClientTraceGrpc),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(&cloudcommon.ProtoCodec{})),
+	)
+	if err != nil {
+		return fmt.Errorf("Connect to controller %s failed, %v", *ctrlAddr, err)
+	}
+	defer conn.Close()
+
+	eventStart := time.Now()
+	client := edgeproto.NewAppInstApiClient(conn)
+	stream, err := client.UpdateAppInst(ctx, inst)
+	if err != nil {
+		return err
+	}
+	for {
+		_, err = stream.Recv()
+		if err == io.EOF {
+			err = nil
+			break
+		}
+		if err != nil {
+			break
+		}
+	}
+	if err == nil {
+		// only log event if scaling succeeded
+		nodeMgr.TimedEvent(ctx, name+" AppInst", inst.Key.Organization, svcnode.EventType, inst.Key.GetTags(), err, eventStart, time.Now(), "new replicas", strconv.Itoa(int(inst.Replicas)), "reason", alert.Annotations["reason"])
+	}
+	return err
+}
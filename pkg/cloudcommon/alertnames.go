@@ -19,6 +19,7 @@ const (
 	AlertAutoScaleUp                         = "AutoScaleUp"
 	AlertAutoScaleDown                       = "AutoScaleDown"
 	AlertClusterAutoScale                    = "ClusterAutoScale"
+	AlertAppInstAutoScale                    = "AppInstAutoScale"
 	AlertAppInstDown                         = "AppInstDown"
 	AlertClusterSvcAppInstFailure            = "ClusterSvcAppInstFailure"
 	AlertAutoUndeploy                        = "AutoProvUndeploy"
@@ -65,6 +66,19 @@ var AlertSeverityTypes = map[string]struct{}{
 	AlertSeverityInfo:  struct{}{},
 }
 
+// Ranks of severities, in the order of increasing severity
+var alertSeverityRanks = map[string]int{
+	AlertSeverityInfo:  1,
+	AlertSeverityWarn:  2,
+	AlertSeverityError: 3,
+}
+
+// IsAlertSeverityAtLeast checks if the severity is the same as or
+// more severe than the minimum severity.
+func IsAlertSeverityAtLeast(severity, minSeverity string) bool {
+	return alertSeverityRanks[severity] >= alertSeverityRanks[minSeverity]
+}
+
 // Map represents severities for the specific alerts that the platfrom generates
 var AlertSeverityValues = map[string]string{
 	AlertAppInstDown:              AlertSeverityError,
@@ -100,6 +114,7 @@ func IsMonitoredAlert(labels map[string]string) bool {
 		return true
 	}
 	if alertName == AlertClusterAutoScale ||
+		alertName == AlertAppInstAutoScale ||
 		alertName == AlertAutoScaleUp ||
 		alertName == AlertAutoScaleDown ||
 		alertName == AlertAppInstDown ||
@@ -28,6 +28,14 @@ func TestValidateSeverity(t *testing.T) {
 	require.False(t, IsAlertSeverityValid(""))
 }
 
+func TestAlertSeverityAtLeast(t *testing.T) {
+	require.True(t, IsAlertSeverityAtLeast(AlertSeverityError, AlertSeverityWarn))
+	require.True(t, IsAlertSeverityAtLeast(AlertSeverityWarn, AlertSeverityWarn))
+	require.True(t, IsAlertSeverityAtLeast(AlertSeverityInfo, ""))
+	require.False(t, IsAlertSeverityAtLeast(AlertSeverityInfo, AlertSeverityWarn))
+	require.False(t, IsAlertSeverityAtLeast(AlertSeverityWarn, AlertSeverityError))
+}
+
 func TestValidateMonitoredAlert(t *testing.T) {
 	labels := map[string]string{}
 	require.False(t, IsMonitoredAlert(labels))
@@ -27,6 +27,7 @@ import (
 	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
 	"github.com/edgexr/edge-cloud-platform/pkg/tls"
+	"github.com/golang/protobuf/proto"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -45,6 +46,23 @@ func ParseGrpcMethod(method string) (path string, cmd string) {
 	return path, cmd
 }
 
+// Rate limit status is passed back to grpc clients as trailer
+// metadata with these keys, and converted to http headers of the
+// same names by the grpc gateway. Times are in seconds.
+const (
+	RateLimitLimitKey     = "ratelimit-limit"
+	RateLimitRemainingKey = "ratelimit-remaining"
+	RateLimitResetKey     = "ratelimit-reset"
+	RetryAfterKey         = "retry-after"
+)
+
+var RateLimitKeys = []string{
+	RateLimitLimitKey,
+	RateLimitRemainingKey,
+	RateLimitResetKey,
+	RetryAfterKey,
+}
+
 type GrpcGWConfig struct {
 	ApiAddr        string
 	GetCertificate func(*ctls.CertificateRequestInfo) (*ctls.Certificate, error)
@@ -72,7 +90,16 @@ func GrpcGateway(cfg *GrpcGWConfig) (http.Handler, error) {
 	mux := gwruntime.NewServeMux(
 		// this is necessary to get error details properly
 		// marshalled in unary requests
-		gwruntime.WithProtoErrorHandler(gwruntime.DefaultHTTPProtoErrorHandler),
+		gwruntime.WithProtoErrorHandler(func(ctx context.Context, mux *gwruntime.ServeMux, marshaler gwruntime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+			// rate limited requests are converted to http 429
+			// by the default handler based on the grpc code
+			forwardRateLimitHeaders(ctx, w)
+			gwruntime.DefaultHTTPProtoErrorHandler(ctx, mux, marshaler, w, r, err)
+		}),
+		gwruntime.WithForwardResponseOption(func(ctx context.Context, w http.ResponseWriter, msg proto.Message) error {
+			forwardRateLimitHeaders(ctx, w)
+			return nil
+		}),
 	)
 	for _, f := range cfg.ApiHandles {
 		if err := f(ctx, mux, conn); err != nil {
@@ -82,6 +109,25 @@ func GrpcGateway(cfg *GrpcGWConfig) (http.Handler, error) {
 	return mux, nil
 }
 
+// forwardRateLimitHeaders copies rate limit status from the grpc
+// metadata to the http headers. The gateway only forwards trailers
+// if the http client asks for them, so they are copied explicitly.
+func forwardRateLimitHeaders(ctx context.Context, w http.ResponseWriter) {
+	md, ok := gwruntime.ServerMetadataFromContext(ctx)
+	if !ok {
+		return
+	}
+	for _, key := range RateLimitKeys {
+		vals := md.TrailerMD.Get(key)
+		if len(vals) == 0 {
+			vals = md.HeaderMD.Get(key)
+		}
+		if len(vals) > 0 {
+			w.Header().Set(key, vals[0])
+		}
+	}
+}
+
 func GrpcGatewayServe(server *http.Server, tlsCertFile string) {
 	// Serve REST gateway
 	cfg := server.TLSConfig
@@ -137,6 +137,10 @@ var InternalVMRegistry = "internal-vm-registry"
 
 var AutoProvMeasurement = "auto-prov-counts"
 
+// DME node property set to "true" if the DME is able to look up
+// the country of a location, required for country geo-fences.
+var NodePropGeoCountryResolver = "geo-country-resolver"
+
 // AppLabels for the application containers
 var MexAppInstNameLabel = "mexAppInstName"
 var MexAppInstOrgLabel = "mexAppInstOrg"
@@ -151,6 +155,7 @@ var ClusterInstEvent = "clusterinst"
 var ClusterInstCheckpoints = "clusterinst-checkpoints"
 var AppInstEvent = "appinst"
 var AppInstCheckpoints = "appinst-checkpoints"
+var AlertEvent = "alert"
 var MonthlyInterval = "MONTH"
 var DmeApiMeasurement = "dme-api"
 
@@ -227,6 +232,8 @@ var VmRegPullPath = "/storage/v1/pull"
 var VmRegHeaderMD5 = "X-Checksum-Md5"
 var ControllerEdgeprotoRESTPath = "/edgeproto/v1"
 var NBIRootPath = "/edge-application-management/vwip"
+var EdgeDiscoveryRootPath = "/simple-edge-discovery/v1"
+var QodRootPath = "/quality-on-demand/v0"
 
 // Map used to identify which metrics should go to persistent_metrics db. Value represents the measurement creation status
 var EdgeEventsMetrics = map[string]struct{}{
@@ -267,6 +274,8 @@ const (
 	AnnotationPreviousDNSName         = "previous-dns-name"
 	AnnotationFedPartnerAppProviderID = "fed-partner-app-provider-id"
 	AnnotationKubernetesVersion       = "kubernetes-version"
+	AnnotationMovedFQDN               = "moved-fqdn"
+	AnnotationMovedFQDNCloudlet       = "moved-fqdn-cloudlet"
 )
 
 var InstanceUp = "UP"
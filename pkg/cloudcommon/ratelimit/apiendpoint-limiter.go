@@ -21,6 +21,7 @@ import (
 
 	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/go-redis/redis/v8"
 )
 
 /*
@@ -40,6 +41,8 @@ type apiEndpointLimiter struct {
 	// Maximum number of Ips and/or Users allowed in hashmaps
 	maxNumIps   int
 	maxNumUsers int
+	// Optional redis client to share limiter state across replicas
+	redisClient *redis.Client
 }
 
 // Rate Limit Settings for an API endpoint
@@ -50,11 +53,12 @@ type apiEndpointRateLimitSettings struct {
 }
 
 // Create an ApiEndpointLimiter
-func newApiEndpointLimiter(apiName string, apiEndpointRateLimitSettings *apiEndpointRateLimitSettings, maxNumIps int, maxNumUsers int) *apiEndpointLimiter {
+func newApiEndpointLimiter(apiName string, apiEndpointRateLimitSettings *apiEndpointRateLimitSettings, maxNumIps int, maxNumUsers int, redisClient *redis.Client) *apiEndpointLimiter {
 	a := &apiEndpointLimiter{}
 	a.apiName = apiName
 	a.apiEndpointRateLimitSettings = apiEndpointRateLimitSettings
-	limiters := getLimitersFromRateLimitSettings(apiEndpointRateLimitSettings.AllRequestsRateLimitSettings)
+	a.redisClient = redisClient
+	limiters := a.getLimiters(apiEndpointRateLimitSettings.AllRequestsRateLimitSettings, a.allRequestsScope())
 	a.limitAllRequests = NewCompositeLimiter(limiters...)
 	a.limitsPerIp = make(map[string]*CompositeLimiter)
 	a.limitsPerUser = make(map[string]*CompositeLimiter)
@@ -64,26 +68,28 @@ func newApiEndpointLimiter(apiName string, apiEndpointRateLimitSettings *apiEndp
 }
 
 // Implements the Limiter interface
-func (a *apiEndpointLimiter) Limit(ctx context.Context, info *CallerInfo) error {
+func (a *apiEndpointLimiter) Limit(ctx context.Context, info *CallerInfo) (*LimitStatus, error) {
 	// Check for LimiterInfo which provides essential information about the api, ip, user, and org
 	if info == nil {
 		log.SpanLog(ctx, log.DebugLevelInfo, "nil CallerInfo - skipping rate limit")
-		return fmt.Errorf("nil CallerInfo - skipping rate limit")
+		return nil, fmt.Errorf("nil CallerInfo - skipping rate limit")
 	}
 
+	var status *LimitStatus
 	if a.doesLimitByIp() && info.Ip != "" {
 		// limit per ip
 		limiter, ok := a.limitsPerIp[info.Ip]
 		if !ok {
 			a.removeExcessIps()
 			// add ip
-			limiters := getLimitersFromRateLimitSettings(a.apiEndpointRateLimitSettings.PerIpRateLimitSettings)
+			limiters := a.getLimiters(a.apiEndpointRateLimitSettings.PerIpRateLimitSettings, a.perIpScope(info.Ip))
 			limiter = NewCompositeLimiter(limiters...)
 			a.limitsPerIp[info.Ip] = limiter
 		}
-		err := limiter.Limit(ctx, info)
+		limitStatus, err := limiter.Limit(ctx, info)
+		status = MostRestrictiveLimitStatus(status, limitStatus)
 		if err != nil {
-			return fmt.Errorf("Client exceeded api rate limit per ip. %s", err)
+			return status, fmt.Errorf("Client exceeded api rate limit per ip. %s", err)
 		}
 	}
 	if a.doesLimitByUser() && info.User != "" {
@@ -92,20 +98,22 @@ func (a *apiEndpointLimiter) Limit(ctx context.Context, info *CallerInfo) error
 		if !ok {
 			a.removeExcessUsers()
 			// add user
-			limiters := getLimitersFromRateLimitSettings(a.apiEndpointRateLimitSettings.PerUserRateLimitSettings)
+			limiters := a.getLimiters(a.apiEndpointRateLimitSettings.PerUserRateLimitSettings, a.perUserScope(info.User))
 			limiter = NewCompositeLimiter(limiters...)
 			a.limitsPerUser[info.User] = limiter
 		}
-		err := limiter.Limit(ctx, info)
+		limitStatus, err := limiter.Limit(ctx, info)
+		status = MostRestrictiveLimitStatus(status, limitStatus)
 		if err != nil {
-			return fmt.Errorf("user \"%s\" exceeded api rate limit per user. %s", info.User, err)
+			return status, fmt.Errorf("user \"%s\" exceeded api rate limit per user. %s", info.User, err)
 		}
 	}
 	if a.doesLimitByAllRequests() {
 		// limit for the entire endpoint
-		return a.limitAllRequests.Limit(ctx, info)
+		limitStatus, err := a.limitAllRequests.Limit(ctx, info)
+		return MostRestrictiveLimitStatus(status, limitStatus), err
 	}
-	return nil
+	return status, nil
 }
 
 func (a *apiEndpointLimiter) Type() string {
@@ -126,7 +134,7 @@ func (a *apiEndpointLimiter) updateFlowRateLimitSettings(flowRateLimitSettings *
 			}
 		}
 		a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings.UpdateFlowSettings(flowRateLimitSettings)
-		limiters := getLimitersFromRateLimitSettings(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings)
+		limiters := a.getLimiters(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings, a.allRequestsScope())
 		a.limitAllRequests = NewCompositeLimiter(limiters...)
 	case edgeproto.RateLimitTarget_PER_IP:
 		if a.apiEndpointRateLimitSettings.PerIpRateLimitSettings == nil {
@@ -153,7 +161,7 @@ func (a *apiEndpointLimiter) removeFlowRateLimitSettings(target edgeproto.RateLi
 	switch target {
 	case edgeproto.RateLimitTarget_ALL_REQUESTS:
 		a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings.RemoveFlowSettings(name)
-		limiters := getLimitersFromRateLimitSettings(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings)
+		limiters := a.getLimiters(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings, a.allRequestsScope())
 		a.limitAllRequests = NewCompositeLimiter(limiters...)
 	case edgeproto.RateLimitTarget_PER_IP:
 		a.apiEndpointRateLimitSettings.PerIpRateLimitSettings.RemoveFlowSettings(name)
@@ -178,7 +186,7 @@ func (a *apiEndpointLimiter) updateMaxReqsRateLimitSettings(maxReqsRateLimitSett
 			}
 		}
 		a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings.UpdateMaxReqsSettings(maxReqsRateLimitSettings)
-		limiters := getLimitersFromRateLimitSettings(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings)
+		limiters := a.getLimiters(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings, a.allRequestsScope())
 		a.limitAllRequests = NewCompositeLimiter(limiters...)
 	case edgeproto.RateLimitTarget_PER_IP:
 		if a.apiEndpointRateLimitSettings.PerIpRateLimitSettings == nil {
@@ -205,7 +213,7 @@ func (a *apiEndpointLimiter) removeMaxReqsRateLimitSettings(target edgeproto.Rat
 	switch target {
 	case edgeproto.RateLimitTarget_ALL_REQUESTS:
 		a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings.RemoveMaxReqsSettings(name)
-		limiters := getLimitersFromRateLimitSettings(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings)
+		limiters := a.getLimiters(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings, a.allRequestsScope())
 		a.limitAllRequests = NewCompositeLimiter(limiters...)
 	case edgeproto.RateLimitTarget_PER_IP:
 		a.apiEndpointRateLimitSettings.PerIpRateLimitSettings.RemoveMaxReqsSettings(name)
@@ -226,7 +234,7 @@ func (a *apiEndpointLimiter) pruneFlowRateLimitSettings(keys map[edgeproto.FlowR
 			}
 			if _, ok := keys[key]; !ok {
 				a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings.RemoveFlowSettings(name)
-				limiters := getLimitersFromRateLimitSettings(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings)
+				limiters := a.getLimiters(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings, a.allRequestsScope())
 				a.limitAllRequests = NewCompositeLimiter(limiters...)
 			}
 		}
@@ -269,7 +277,7 @@ func (a *apiEndpointLimiter) pruneMaxReqsRateLimitSettings(keys map[edgeproto.Ma
 			}
 			if _, ok := keys[key]; !ok {
 				a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings.RemoveMaxReqsSettings(name)
-				limiters := getLimitersFromRateLimitSettings(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings)
+				limiters := a.getLimiters(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings, a.allRequestsScope())
 				a.limitAllRequests = NewCompositeLimiter(limiters...)
 			}
 		}
@@ -370,6 +378,28 @@ func (a *apiEndpointLimiter) isEmpty() bool {
 	return numSettings == 0
 }
 
+// Helper functions that generate the redis key scope for the limiters
+func (a *apiEndpointLimiter) allRequestsScope() string {
+	return a.apiName + ":" + edgeproto.RateLimitTarget_ALL_REQUESTS.String()
+}
+
+func (a *apiEndpointLimiter) perIpScope(ip string) string {
+	return a.apiName + ":" + edgeproto.RateLimitTarget_PER_IP.String() + ":" + ip
+}
+
+func (a *apiEndpointLimiter) perUserScope(user string) string {
+	return a.apiName + ":" + edgeproto.RateLimitTarget_PER_USER.String() + ":" + user
+}
+
+// Helper function that creates the Limiters for the settings, using
+// redis backed limiters if a redis client is configured.
+func (a *apiEndpointLimiter) getLimiters(settings *edgeproto.RateLimitSettings, scope string) []Limiter {
+	if a.redisClient == nil {
+		return getLimitersFromRateLimitSettings(settings)
+	}
+	return getRedisLimitersFromRateLimitSettings(a.redisClient, settings, scope)
+}
+
 // Helper function that creates slice of Limiters to be passed into NewCompositeLimiter
 func getLimitersFromRateLimitSettings(settings *edgeproto.RateLimitSettings) []Limiter {
 	limiters := make([]Limiter, 0)
@@ -398,3 +428,34 @@ func getLimitersFromRateLimitSettings(settings *edgeproto.RateLimitSettings) []L
 	}
 	return limiters
 }
+
+// Helper function that creates slice of redis backed Limiters to be passed into NewCompositeLimiter
+func getRedisLimitersFromRateLimitSettings(client *redis.Client, settings *edgeproto.RateLimitSettings, scope string) []Limiter {
+	limiters := make([]Limiter, 0)
+	if settings == nil {
+		return limiters
+	}
+
+	// Generate Flow Limiters
+	for name, fsettings := range settings.FlowSettings {
+		key := GetRedisRateLimitKey(scope, "flow", name)
+		switch fsettings.FlowAlgorithm {
+		case edgeproto.FlowRateLimitAlgorithm_TOKEN_BUCKET_ALGORITHM:
+			limiters = append(limiters, NewRedisTokenBucketLimiter(client, key, fsettings.ReqsPerSecond, int(fsettings.BurstSize)))
+		case edgeproto.FlowRateLimitAlgorithm_LEAKY_BUCKET_ALGORITHM:
+			limiters = append(limiters, NewRedisLeakyBucketLimiter(client, key, fsettings.ReqsPerSecond))
+		default:
+		}
+	}
+
+	// Generate MaxReqs Limiters
+	for name, msettings := range settings.MaxReqsSettings {
+		key := GetRedisRateLimitKey(scope, "maxreqs", name)
+		switch msettings.MaxReqsAlgorithm {
+		case edgeproto.MaxReqsRateLimitAlgorithm_FIXED_WINDOW_ALGORITHM:
+			limiters = append(limiters, NewRedisIntervalLimiter(client, key, int(msettings.MaxRequests), time.Duration(msettings.Interval)))
+		default:
+		}
+	}
+	return limiters
+}
@@ -32,14 +32,16 @@ func NewCompositeLimiter(limiters ...Limiter) *CompositeLimiter {
 	return &s
 }
 
-func (c *CompositeLimiter) Limit(ctx context.Context, info *CallerInfo) error {
+func (c *CompositeLimiter) Limit(ctx context.Context, info *CallerInfo) (*LimitStatus, error) {
+	var status *LimitStatus
 	for _, limiter := range c.limiters {
-		err := limiter.Limit(ctx, info)
+		limitStatus, err := limiter.Limit(ctx, info)
+		status = MostRestrictiveLimitStatus(status, limitStatus)
 		if err != nil {
-			return err
+			return status, err
 		}
 	}
-	return nil
+	return status, nil
 }
 
 func (c *CompositeLimiter) Type() string {
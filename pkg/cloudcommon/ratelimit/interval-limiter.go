@@ -45,7 +45,7 @@ func NewIntervalLimiter(reqLimit int, interval time.Duration) *IntervalLimiter {
 }
 
 // TODO: Charge once surpass api limit
-func (i *IntervalLimiter) Limit(ctx context.Context, info *CallerInfo) error {
+func (i *IntervalLimiter) Limit(ctx context.Context, info *CallerInfo) (*LimitStatus, error) {
 	i.Lock()
 	defer i.Unlock()
 	// Check start of interval
@@ -53,12 +53,22 @@ func (i *IntervalLimiter) Limit(ctx context.Context, info *CallerInfo) error {
 		i.intervalStartTime = time.Now()
 		i.currentNumberOfRequests = 0
 	}
-	if i.currentNumberOfRequests >= i.requestLimit && i.requestLimit != 0 {
-		waitTime := i.interval - (time.Now().Sub(i.intervalStartTime))
-		return fmt.Errorf("Exceeded limit of %d, retry again in %v", i.requestLimit, waitTime)
+	if i.requestLimit == 0 {
+		// no limit, so no quota to report
+		return nil, nil
+	}
+	waitTime := i.interval - (time.Now().Sub(i.intervalStartTime))
+	status := &LimitStatus{
+		Limit:      int64(i.requestLimit),
+		ResetAfter: waitTime,
+	}
+	if i.currentNumberOfRequests >= i.requestLimit {
+		status.RetryAfter = waitTime
+		return status, fmt.Errorf("Exceeded limit of %d, retry again in %v", i.requestLimit, waitTime)
 	} else {
 		i.currentNumberOfRequests++
-		return nil
+		status.Remaining = int64(i.requestLimit - i.currentNumberOfRequests)
+		return status, nil
 	}
 }
 
@@ -40,13 +40,14 @@ func NewLeakyBucketLimiter(reqsPerSecond float64) *LeakyBucketLimiter {
 	return l
 }
 
-func (l *LeakyBucketLimiter) Limit(ctx context.Context, info *CallerInfo) error {
+func (l *LeakyBucketLimiter) Limit(ctx context.Context, info *CallerInfo) (*LimitStatus, error) {
+	// requests are queued instead of rejected, so there is no quota to report
 	err := l.limiter.Wait(ctx)
 	if err != nil {
 		log.SpanLog(ctx, log.DebugLevelInfo, "Error during leakybucket rate limiting", "error", err)
-		return fmt.Errorf("error during leakybucker rate limiting: %s", err)
+		return nil, fmt.Errorf("error during leakybucker rate limiting: %s", err)
 	}
-	return nil
+	return nil, nil
 }
 
 func (l *LeakyBucketLimiter) Type() string {
@@ -16,20 +16,97 @@ package ratelimit
 
 import (
 	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"google.golang.org/grpc/metadata"
 )
 
 /*
  * Limiter Interface
  * Structs that implement this inferface must provide a limit function that returns whether or not to allow a request to go through
- * Return value is an error
- * If the return value is non-nil, we will reject the request (ie. limit), a return value of nil will pass the request.
+ * Return values are a LimitStatus and an error
+ * If the error is non-nil, we will reject the request (ie. limit), an error of nil will pass the request.
+ * The LimitStatus reports the remaining quota so that clients can back off, and may be nil if the limiter does not track a quota (ie. leaky bucket)
  * Current implementations in: api_ratelimitmgr.go, apiendpoint-limiter.go, leakybucket.go, tokenbucket.go
  */
 type Limiter interface {
-	Limit(ctx context.Context, info *CallerInfo) error
+	Limit(ctx context.Context, info *CallerInfo) (*LimitStatus, error)
 	Type() string
 }
 
+/*
+ * LimitStatus is the state of a limiter's quota after a request
+ * Limit is the maximum number of requests allowed at once (burst size or max requests per interval)
+ * Remaining is the number of requests left before requests will be rejected
+ * ResetAfter is the time until the quota is fully restored
+ * RetryAfter is the time until the next request will be allowed, and is only set if the request was rejected
+ */
+type LimitStatus struct {
+	Limit      int64
+	Remaining  int64
+	ResetAfter time.Duration
+	RetryAfter time.Duration
+}
+
+// Returns the more restrictive of the two statuses, either of which may be nil.
+// This is used to report a single status for a request that went through
+// multiple limiters.
+func MostRestrictiveLimitStatus(a, b *LimitStatus) *LimitStatus {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if b.RetryAfter != a.RetryAfter {
+		if b.RetryAfter > a.RetryAfter {
+			return b
+		}
+		return a
+	}
+	if b.Remaining < a.Remaining || (b.Remaining == a.Remaining && b.ResetAfter > a.ResetAfter) {
+		return b
+	}
+	return a
+}
+
+// Get the rate limit status as key-value pairs, with times
+// rounded up to the nearest second. See cloudcommon.RateLimitKeys.
+func (s *LimitStatus) GetKeyVals() map[string]string {
+	kvs := map[string]string{
+		cloudcommon.RateLimitLimitKey:     strconv.FormatInt(s.Limit, 10),
+		cloudcommon.RateLimitRemainingKey: strconv.FormatInt(s.Remaining, 10),
+		cloudcommon.RateLimitResetKey:     strconv.FormatInt(durationToSeconds(s.ResetAfter), 10),
+	}
+	if s.RetryAfter > 0 {
+		kvs[cloudcommon.RetryAfterKey] = strconv.FormatInt(durationToSeconds(s.RetryAfter), 10)
+	}
+	return kvs
+}
+
+// Get the rate limit status as grpc metadata
+func (s *LimitStatus) GetMetadata() metadata.MD {
+	return metadata.New(s.GetKeyVals())
+}
+
+// Set the rate limit status as http headers
+func (s *LimitStatus) SetHTTPHeaders(header http.Header) {
+	for k, v := range s.GetKeyVals() {
+		header.Set(k, v)
+	}
+}
+
+func durationToSeconds(d time.Duration) int64 {
+	if d <= 0 {
+		return 0
+	}
+	return int64(math.Ceil(d.Seconds()))
+}
+
 // Struct used to supply client/caller information to Limiters
 type CallerInfo struct {
 	Api  string
@@ -45,7 +45,13 @@ func GetDmeUnaryRateLimiterInterceptor(limiter Limiter) grpc.UnaryServerIntercep
 			Ip:  p.Addr.String(),
 		}
 		// Call dynamic value's Limit function
-		err := limiter.Limit(ctx, callerInfo)
+		limitStatus, err := limiter.Limit(ctx, callerInfo)
+		if limitStatus != nil {
+			// Let the client know its quota so it can back off
+			if mdErr := grpc.SetTrailer(ctx, limitStatus.GetMetadata()); mdErr != nil {
+				log.SpanLog(ctx, log.DebugLevelDmereq, "Failed to set rate limit trailer", "api", method, "err", mdErr)
+			}
+		}
 		if err != nil {
 			errMsg := fmt.Sprintf("Request for %s rate limited, please retry later.", info.FullMethod)
 			if err != nil {
@@ -86,7 +92,11 @@ func GetDmeStreamRateLimiterInterceptor(limiter Limiter) grpc.StreamServerInterc
 			Ip:  p.Addr.String(),
 		}
 		// Call dynamic value's Limit function
-		err := limiter.Limit(cctx, callerInfo)
+		limitStatus, err := limiter.Limit(cctx, callerInfo)
+		if limitStatus != nil {
+			// Let the client know its quota so it can back off
+			ss.SetTrailer(limitStatus.GetMetadata())
+		}
 		if err != nil {
 			errMsg := fmt.Sprintf("Request for %s rate limited, please retry later.", info.FullMethod)
 			if err != nil {
@@ -100,5 +110,3 @@ func GetDmeStreamRateLimiterInterceptor(limiter Limiter) grpc.StreamServerInterc
 		return handler(srv, wrapper)
 	}
 }
-
-// TODO: Add grpc metatdata with rate limit information (limit, reqs remaining, limit restart) when MaxReqs is used
@@ -18,6 +18,8 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"net"
+	"net/http"
 	"strings"
 	"sync"
 	"testing"
@@ -28,6 +30,13 @@ import (
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func TestLeakyBucketLimiter(t *testing.T) {
@@ -64,9 +73,9 @@ func TestTokenBucketLimiter(t *testing.T) {
 
 	// test that TokenBucket rejects requests that come in too quickly
 	tokenBucket := NewTokenBucketLimiter(1, 1)
-	err := tokenBucket.Limit(ctx, nil)
+	_, err := tokenBucket.Limit(ctx, nil)
 	require.Nil(t, err)
-	err = tokenBucket.Limit(ctx, nil)
+	_, err = tokenBucket.Limit(ctx, nil)
 	require.NotNil(t, err)
 	require.True(t, strings.Contains(err.Error(), "Exceeded rate"))
 
@@ -78,7 +87,7 @@ func TestTokenBucketLimiter(t *testing.T) {
 	for i := 0; i < numClients; i++ {
 		go func() {
 			<-start
-			err = tokenBucket.Limit(ctx, nil)
+			_, err = tokenBucket.Limit(ctx, nil)
 			done <- err
 		}()
 	}
@@ -101,10 +110,10 @@ func TestIntervalLimiter(t *testing.T) {
 	numRequestsPerSecond := 3
 	intervalLimiter := NewIntervalLimiter(numRequestsPerSecond, time.Duration(time.Second))
 	for i := 0; i < numRequestsPerSecond; i++ {
-		err := intervalLimiter.Limit(ctx, nil)
+		_, err := intervalLimiter.Limit(ctx, nil)
 		assert.Nil(t, err)
 	}
-	err := intervalLimiter.Limit(ctx, nil)
+	_, err := intervalLimiter.Limit(ctx, nil)
 	assert.NotNil(t, err)
 	assert.True(t, strings.Contains(err.Error(), "Exceeded limit"))
 
@@ -113,7 +122,7 @@ func TestIntervalLimiter(t *testing.T) {
 	done := make(chan error, numRequestsPerSecond+1)
 	for i := 0; i < numRequestsPerSecond+1; i++ {
 		go func() {
-			err := intervalLimiter.Limit(ctx, nil)
+			_, err := intervalLimiter.Limit(ctx, nil)
 			done <- err
 		}()
 	}
@@ -138,13 +147,13 @@ func TestCompositeLimiter(t *testing.T) {
 	compositeLimiter := NewCompositeLimiter(intervalLimiter1, intervalLimiter2)
 
 	// test composite limiter serially
-	err := compositeLimiter.Limit(ctx, nil)
+	_, err := compositeLimiter.Limit(ctx, nil)
 	assert.Nil(t, err)
 	time.Sleep(time.Second)
-	err = compositeLimiter.Limit(ctx, nil)
+	_, err = compositeLimiter.Limit(ctx, nil)
 	assert.Nil(t, err)
 	time.Sleep(time.Second)
-	err = compositeLimiter.Limit(ctx, nil)
+	_, err = compositeLimiter.Limit(ctx, nil)
 	assert.NotNil(t, err)
 	assert.True(t, strings.Contains(err.Error(), "Exceeded limit"))
 
@@ -157,7 +166,7 @@ func TestCompositeLimiter(t *testing.T) {
 	for i := 0; i < numRequests+1; i++ {
 		go func() {
 			time.Sleep(time.Duration(rand.Intn(numRequests-1)) * time.Second)
-			err := compositeLimiter.Limit(ctx, nil)
+			_, err := compositeLimiter.Limit(ctx, nil)
 			done <- err
 		}()
 	}
@@ -266,7 +275,7 @@ func TestApiRateLimitMgr(t *testing.T) {
 				Api: apis[rand.Intn(len(apis))],
 				Ip:  fmt.Sprintf("client%d", idx),
 			}
-			err := mgr.Limit(ctx, callerInfo)
+			_, err := mgr.Limit(ctx, callerInfo)
 			require.Nil(t, err)
 		}(i)
 	}
@@ -283,7 +292,7 @@ func TestApiRateLimitMgr(t *testing.T) {
 				Api: apis[0],
 				Ip:  fmt.Sprintf("client%d", idx),
 			}
-			e := mgr.Limit(ctx, callerInfo)
+			_, e := mgr.Limit(ctx, callerInfo)
 			if e != nil {
 				err = e
 			}
@@ -312,3 +321,106 @@ func TestApiRateLimitMgr(t *testing.T) {
 	require.NotNil(t, err)
 	require.True(t, strings.Contains(err.Error(), "Exceeded limit"))
 }
+
+func TestLimitStatus(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelDmereq)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	// interval limiter reports remaining requests in the interval
+	intervalLimiter := NewIntervalLimiter(2, time.Minute)
+	status, err := intervalLimiter.Limit(ctx, nil)
+	require.Nil(t, err)
+	require.Equal(t, int64(2), status.Limit)
+	require.Equal(t, int64(1), status.Remaining)
+	require.Equal(t, time.Duration(0), status.RetryAfter)
+	_, err = intervalLimiter.Limit(ctx, nil)
+	require.Nil(t, err)
+	status, err = intervalLimiter.Limit(ctx, nil)
+	require.NotNil(t, err)
+	require.Equal(t, int64(0), status.Remaining)
+	require.True(t, status.RetryAfter > 0 && status.RetryAfter <= time.Minute)
+
+	// token bucket reports remaining tokens and time to next token
+	tokenBucket := NewTokenBucketLimiter(1, 2)
+	status, err = tokenBucket.Limit(ctx, nil)
+	require.Nil(t, err)
+	require.Equal(t, int64(2), status.Limit)
+	require.Equal(t, int64(1), status.Remaining)
+	require.Equal(t, time.Duration(0), status.RetryAfter)
+	// allowed requests do not need to retry even if no tokens are left
+	status, err = tokenBucket.Limit(ctx, nil)
+	require.Nil(t, err)
+	require.Equal(t, int64(0), status.Remaining)
+	require.Equal(t, time.Duration(0), status.RetryAfter)
+	status, err = tokenBucket.Limit(ctx, nil)
+	require.NotNil(t, err)
+	require.Equal(t, int64(0), status.Remaining)
+	require.True(t, status.RetryAfter > 0 && status.RetryAfter <= time.Second)
+	require.True(t, status.ResetAfter > time.Second && status.ResetAfter <= 2*time.Second)
+
+	// leaky bucket has no quota
+	leakyBucket := NewLeakyBucketLimiter(100)
+	status, err = leakyBucket.Limit(ctx, nil)
+	require.Nil(t, err)
+	require.Nil(t, status)
+
+	// composite limiter reports the most restrictive status
+	compositeLimiter := NewCompositeLimiter(NewIntervalLimiter(5, time.Minute), NewIntervalLimiter(2, time.Second), leakyBucket)
+	status, err = compositeLimiter.Limit(ctx, nil)
+	require.Nil(t, err)
+	require.Equal(t, int64(2), status.Limit)
+	require.Equal(t, int64(1), status.Remaining)
+
+	// conversion to headers
+	status = &LimitStatus{
+		Limit:      10,
+		Remaining:  0,
+		ResetAfter: 1500 * time.Millisecond,
+		RetryAfter: 100 * time.Millisecond,
+	}
+	header := http.Header{}
+	status.SetHTTPHeaders(header)
+	require.Equal(t, "10", header.Get("RateLimit-Limit"))
+	require.Equal(t, "0", header.Get("RateLimit-Remaining"))
+	require.Equal(t, "2", header.Get("RateLimit-Reset"))
+	require.Equal(t, "1", header.Get("Retry-After"))
+	status.RetryAfter = 0
+	require.Equal(t, []string(nil), status.GetMetadata().Get("retry-after"))
+}
+
+func TestRateLimitInterceptorTrailers(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelDmereq)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	limiter := NewIntervalLimiter(1, time.Minute)
+	server := grpc.NewServer(grpc.UnaryInterceptor(GetDmeUnaryRateLimiterInterceptor(limiter)))
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(lis)
+	defer server.Stop()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.Nil(t, err)
+	defer conn.Close()
+	client := grpc_health_v1.NewHealthClient(conn)
+
+	trailer := metadata.MD{}
+	_, err = client.Check(ctx, &grpc_health_v1.HealthCheckRequest{}, grpc.Trailer(&trailer))
+	require.Nil(t, err)
+	require.Equal(t, []string{"1"}, trailer.Get("ratelimit-limit"))
+	require.Equal(t, []string{"0"}, trailer.Get("ratelimit-remaining"))
+	require.Equal(t, []string{"60"}, trailer.Get("ratelimit-reset"))
+	require.Equal(t, 0, len(trailer.Get("retry-after")))
+
+	trailer = metadata.MD{}
+	_, err = client.Check(ctx, &grpc_health_v1.HealthCheckRequest{}, grpc.Trailer(&trailer))
+	require.NotNil(t, err)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Equal(t, []string{"0"}, trailer.Get("ratelimit-remaining"))
+	require.Equal(t, []string{"60"}, trailer.Get("retry-after"))
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
 	"github.com/edgexr/edge-cloud-platform/pkg/util"
+	"github.com/go-redis/redis/v8"
 )
 
 /*
@@ -34,15 +35,33 @@ type RateLimitManager struct {
 	disableRateLimit bool
 	maxTrackedIps    int
 	maxTrackedUsers  int
+	redisClient      *redis.Client
+}
+
+type RateLimitManagerOptions struct {
+	redisClient *redis.Client
+}
+
+type RateLimitManagerOp func(opts *RateLimitManagerOptions)
+
+// WithRedisClient stores limiter state in redis so that limits are
+// enforced across all replicas that share the same redis.
+func WithRedisClient(client *redis.Client) RateLimitManagerOp {
+	return func(opts *RateLimitManagerOptions) { opts.redisClient = client }
 }
 
 // Create a RateLimitManager
-func NewRateLimitManager(disableRateLimit bool, maxTrackedIps int, maxTrackedUsers int) *RateLimitManager {
+func NewRateLimitManager(disableRateLimit bool, maxTrackedIps int, maxTrackedUsers int, ops ...RateLimitManagerOp) *RateLimitManager {
+	opts := RateLimitManagerOptions{}
+	for _, op := range ops {
+		op(&opts)
+	}
 	r := &RateLimitManager{}
 	r.limitsPerApi = make(map[string]*apiEndpointLimiter)
 	r.disableRateLimit = disableRateLimit
 	r.maxTrackedIps = maxTrackedIps
 	r.maxTrackedUsers = maxTrackedUsers
+	r.redisClient = opts.redisClient
 	return r
 }
 
@@ -57,7 +76,7 @@ func (r *RateLimitManager) CreateApiEndpointLimiter(api string, allRequestsRateL
 		PerUserRateLimitSettings:     perUserRateLimitSettings,
 	}
 	// Map API to ApiEndpointLimiter for easy lookup
-	r.limitsPerApi[api] = newApiEndpointLimiter(api, apiEndpointRateLimitSettings, r.maxTrackedIps, r.maxTrackedUsers)
+	r.limitsPerApi[api] = newApiEndpointLimiter(api, apiEndpointRateLimitSettings, r.maxTrackedIps, r.maxTrackedUsers, r.redisClient)
 }
 
 // Update the flow rate limit settings for API that use the rate limit settings associated with the specified RateLimitSettingsKey
@@ -68,7 +87,7 @@ func (r *RateLimitManager) UpdateFlowRateLimitSettings(flowRateLimitSettings *ed
 	api := flowRateLimitSettings.Key.RateLimitKey.ApiName
 	limiter, ok := r.limitsPerApi[api]
 	if !ok || limiter == nil {
-		limiter = newApiEndpointLimiter(api, &apiEndpointRateLimitSettings{}, r.maxTrackedIps, r.maxTrackedUsers)
+		limiter = newApiEndpointLimiter(api, &apiEndpointRateLimitSettings{}, r.maxTrackedIps, r.maxTrackedUsers, r.redisClient)
 	}
 	// Update ApiEndpointLimiter with new RateLimitSettings
 	limiter.updateFlowRateLimitSettings(flowRateLimitSettings)
@@ -101,7 +120,7 @@ func (r *RateLimitManager) UpdateMaxReqsRateLimitSettings(maxReqsRateLimitSettin
 	api := maxReqsRateLimitSettings.Key.RateLimitKey.ApiName
 	limiter, ok := r.limitsPerApi[api]
 	if !ok || limiter == nil {
-		limiter = newApiEndpointLimiter(api, &apiEndpointRateLimitSettings{}, r.maxTrackedIps, r.maxTrackedUsers)
+		limiter = newApiEndpointLimiter(api, &apiEndpointRateLimitSettings{}, r.maxTrackedIps, r.maxTrackedUsers, r.redisClient)
 	}
 	// Update ApiEndpointLimiter with new RateLimitSettings
 	limiter.updateMaxReqsRateLimitSettings(maxReqsRateLimitSettings)
@@ -174,17 +193,17 @@ func (r *RateLimitManager) UpdateMaxTrackedUsers(max int) {
 }
 
 // Implements the Limiter interface
-func (r *RateLimitManager) Limit(ctx context.Context, info *CallerInfo) error {
+func (r *RateLimitManager) Limit(ctx context.Context, info *CallerInfo) (*LimitStatus, error) {
 	r.Lock()
 	defer r.Unlock()
 	// Skip rest of function if rate limiting is not enabled
 	if r.disableRateLimit {
-		return nil
+		return nil, nil
 	}
 	// Check for CallerInfo which provides essential information about the api, ip, user, and org
 	if info == nil {
 		log.DebugLog(log.DebugLevelInfo, "nil CallerInfo")
-		return fmt.Errorf("nil CallerInfo - skipping rate limit")
+		return nil, fmt.Errorf("nil CallerInfo - skipping rate limit")
 	}
 	// Check that api exists
 	api := info.Api
@@ -194,13 +213,13 @@ func (r *RateLimitManager) Limit(ctx context.Context, info *CallerInfo) error {
 		limiter, ok = r.limitsPerApi[edgeproto.GlobalApiName]
 		if !ok {
 			log.SpanLog(ctx, log.DebugLevelInfo, "Unable to find limiter for api or global limiter in ApiEndpointLimiter", "api", api)
-			return nil
+			return nil, nil
 		}
 	}
 	// Check that ApiEndpointLimiter is non nil (ie. does rate limiting)
 	if limiter == nil {
 		log.SpanLog(ctx, log.DebugLevelInfo, "No rate limiting on api", "api", api)
-		return nil
+		return nil, nil
 	}
 	return limiter.Limit(ctx, info)
 }
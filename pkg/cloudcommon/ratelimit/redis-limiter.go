@@ -0,0 +1,264 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/go-redis/redis/v8"
+)
+
+/*
+ * Redis backed limiters keep their state in redis instead of process
+ * memory, so that all replicas of a service (i.e. DMEs in a region)
+ * that share the same redis enforce a single combined limit.
+ * Each limiter operates on a single redis key, and all state changes
+ * are done atomically in lua scripts.
+ * Timestamps are supplied by the caller in milliseconds, so replicas
+ * are expected to have reasonably synchronized clocks.
+ * If redis is unreachable, requests are allowed through so that
+ * a redis outage does not take down the APIs being limited.
+ */
+
+const RedisRateLimitKeyPrefix = "ratelimit"
+
+// Token bucket state is stored in a hash of the number of tokens
+// and the time the tokens were last computed.
+// Returns {allowed, thousandths of tokens remaining}.
+var redisTokenBucketScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local ttl = tonumber(ARGV[4])
+local vals = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(vals[1])
+local ts = tonumber(vals[2])
+if tokens == nil or ts == nil then
+	tokens = burst
+	ts = now
+end
+if now > ts then
+	tokens = math.min(burst, tokens + (now - ts) * rate / 1000)
+	ts = now
+end
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+redis.call("HSET", KEYS[1], "tokens", tokens, "ts", ts)
+redis.call("PEXPIRE", KEYS[1], ttl)
+return {allowed, math.floor(tokens * 1000)}
+`)
+
+// Leaky bucket state is the time at which the next request
+// may leave the queue. The script reserves that slot and returns
+// how long the caller needs to wait for it.
+var redisLeakyBucketScript = redis.NewScript(`
+local interval = tonumber(ARGV[1])
+local now = tonumber(ARGV[2])
+local ttl = tonumber(ARGV[3])
+local nextTime = tonumber(redis.call("GET", KEYS[1]))
+if nextTime == nil or nextTime < now then
+	nextTime = now
+end
+redis.call("SET", KEYS[1], nextTime + interval, "PX", ttl)
+return math.ceil(nextTime - now)
+`)
+
+// Interval state is the number of requests allowed in the current
+// interval, which is reset by the key expiring at the end of the
+// interval. Rejected requests are not counted.
+// Returns {allowed, current count, milliseconds until reset}.
+var redisIntervalScript = redis.NewScript(`
+local limit = tonumber(ARGV[1])
+local interval = tonumber(ARGV[2])
+local count = tonumber(redis.call("GET", KEYS[1]))
+if count == nil then
+	count = 0
+end
+if count >= limit then
+	return {0, count, redis.call("PTTL", KEYS[1])}
+end
+count = redis.call("INCR", KEYS[1])
+if count == 1 then
+	redis.call("PEXPIRE", KEYS[1], interval)
+end
+return {1, count, redis.call("PTTL", KEYS[1])}
+`)
+
+// Get the redis key for a limiter.
+// The key scope identifies the api and caller (ip or user) being limited,
+// and the settings name identifies the flow or max reqs settings.
+func GetRedisRateLimitKey(scope, settingsType, settingsName string) string {
+	return fmt.Sprintf("%s:%s:%s:%s", RedisRateLimitKeyPrefix, scope, settingsType, settingsName)
+}
+
+func redisNowMillis() int64 {
+	return time.Now().UnixMilli()
+}
+
+/*
+ * Token bucket algorithm with state stored in redis. See TokenBucketLimiter.
+ * FlowRateLimitAlgorithm
+ */
+type RedisTokenBucketLimiter struct {
+	client          *redis.Client
+	key             string
+	tokensPerSecond float64
+	bucketSize      int
+}
+
+func NewRedisTokenBucketLimiter(client *redis.Client, key string, tokensPerSecond float64, bucketSize int) *RedisTokenBucketLimiter {
+	t := &RedisTokenBucketLimiter{}
+	t.client = client
+	t.key = key
+	t.tokensPerSecond = tokensPerSecond
+	t.bucketSize = bucketSize
+	return t
+}
+
+func (t *RedisTokenBucketLimiter) Limit(ctx context.Context, info *CallerInfo) (*LimitStatus, error) {
+	// keep state around long enough to refill the bucket
+	ttl := time.Minute
+	if t.tokensPerSecond > 0 {
+		fillTime := time.Duration(float64(t.bucketSize) / t.tokensPerSecond * float64(time.Second))
+		ttl = fillTime + time.Second
+	}
+	vals, err := redisTokenBucketScript.Run(ctx, t.client, []string{t.key}, t.tokensPerSecond, t.bucketSize, redisNowMillis(), ttl.Milliseconds()).Int64Slice()
+	if err != nil || len(vals) != 2 {
+		log.SpanLog(ctx, log.DebugLevelInfo, "redis token bucket failed, allowing request", "key", t.key, "vals", vals, "err", err)
+		return nil, nil
+	}
+	rejected := vals[0] == 0
+	status := getTokenBucketStatus(float64(vals[1])/1000, t.tokensPerSecond, t.bucketSize, rejected)
+	if rejected {
+		return status, fmt.Errorf("Exceeded rate of %f requests per second", t.tokensPerSecond)
+	}
+	return status, nil
+}
+
+func (t *RedisTokenBucketLimiter) Type() string {
+	return "RedisTokenBucketLimiter"
+}
+
+/*
+ * Leaky bucket algorithm as a queue with state stored in redis.
+ * See LeakyBucketLimiter. Requests are never rejected, they wait until
+ * their reserved slot, or until the context is done.
+ * FlowRateLimitAlgorithm
+ */
+type RedisLeakyBucketLimiter struct {
+	client        *redis.Client
+	key           string
+	reqsPerSecond float64
+}
+
+func NewRedisLeakyBucketLimiter(client *redis.Client, key string, reqsPerSecond float64) *RedisLeakyBucketLimiter {
+	l := &RedisLeakyBucketLimiter{}
+	l.client = client
+	l.key = key
+	l.reqsPerSecond = reqsPerSecond
+	return l
+}
+
+func (l *RedisLeakyBucketLimiter) Limit(ctx context.Context, info *CallerInfo) (*LimitStatus, error) {
+	// requests are queued instead of rejected, so there is no quota to report
+	if l.reqsPerSecond <= 0 {
+		return nil, fmt.Errorf("error during leakybucket rate limiting: invalid rate %f", l.reqsPerSecond)
+	}
+	intervalMs := 1000 / l.reqsPerSecond
+	// keep state around long enough to cover the queue
+	ttl := time.Minute + time.Duration(intervalMs)*time.Millisecond
+	waitMs, err := redisLeakyBucketScript.Run(ctx, l.client, []string{l.key}, intervalMs, redisNowMillis(), ttl.Milliseconds()).Int64()
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelInfo, "redis leaky bucket failed, allowing request", "key", l.key, "err", err)
+		return nil, nil
+	}
+	if waitMs <= 0 {
+		return nil, nil
+	}
+	wait := time.Duration(waitMs) * time.Millisecond
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+		return nil, fmt.Errorf("error during leakybucket rate limiting: wait of %v would exceed context deadline", wait)
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil, nil
+	case <-ctx.Done():
+		log.SpanLog(ctx, log.DebugLevelInfo, "Error during leakybucket rate limiting", "error", ctx.Err())
+		return nil, fmt.Errorf("error during leakybucket rate limiting: %s", ctx.Err())
+	}
+}
+
+func (l *RedisLeakyBucketLimiter) Type() string {
+	return "RedisLeakyBucketLimiter"
+}
+
+/*
+ * Fixed window interval limiting with state stored in redis.
+ * See IntervalLimiter.
+ * MaxReqsRateLimitAlgorithm
+ */
+type RedisIntervalLimiter struct {
+	client       *redis.Client
+	key          string
+	requestLimit int
+	interval     time.Duration
+}
+
+func NewRedisIntervalLimiter(client *redis.Client, key string, reqLimit int, interval time.Duration) *RedisIntervalLimiter {
+	return &RedisIntervalLimiter{
+		client:       client,
+		key:          key,
+		requestLimit: reqLimit,
+		interval:     interval,
+	}
+}
+
+func (i *RedisIntervalLimiter) Limit(ctx context.Context, info *CallerInfo) (*LimitStatus, error) {
+	if i.requestLimit == 0 {
+		return nil, nil
+	}
+	intervalMs := i.interval.Milliseconds()
+	if intervalMs <= 0 {
+		intervalMs = 1
+	}
+	vals, err := redisIntervalScript.Run(ctx, i.client, []string{i.key}, i.requestLimit, intervalMs).Int64Slice()
+	if err != nil || len(vals) != 3 {
+		log.SpanLog(ctx, log.DebugLevelInfo, "redis interval limiter failed, allowing request", "key", i.key, "vals", vals, "err", err)
+		return nil, nil
+	}
+	waitTime := time.Duration(vals[2]) * time.Millisecond
+	status := &LimitStatus{
+		Limit:      int64(i.requestLimit),
+		ResetAfter: waitTime,
+	}
+	if vals[0] == 0 {
+		status.RetryAfter = waitTime
+		return status, fmt.Errorf("Exceeded limit of %d, retry again in %v", i.requestLimit, waitTime)
+	}
+	status.Remaining = int64(i.requestLimit) - vals[1]
+	return status, nil
+}
+
+func (i *RedisIntervalLimiter) Type() string {
+	return "RedisIntervalLimiter"
+}
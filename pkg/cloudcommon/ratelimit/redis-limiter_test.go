@@ -0,0 +1,178 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/rediscache"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRedisClient(t *testing.T, ctx context.Context) (*rediscache.DummyRedis, *redis.Client) {
+	redisServer, err := rediscache.NewMockRedisServer()
+	require.Nil(t, err)
+	client, err := rediscache.NewClient(ctx, &rediscache.RedisConfig{
+		StandaloneAddr: redisServer.GetStandaloneAddr(),
+	})
+	require.Nil(t, err)
+	return redisServer, client
+}
+
+func limitErr(status *LimitStatus, err error) error {
+	return err
+}
+
+func TestRedisTokenBucketLimiter(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelDmereq)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	redisServer, client := newTestRedisClient(t, ctx)
+	defer redisServer.Close()
+	defer client.Close()
+
+	// two limiters on the same key emulate two replicas
+	key := GetRedisRateLimitKey("test", "flow", "tb")
+	tb1 := NewRedisTokenBucketLimiter(client, key, 1, 2)
+	tb2 := NewRedisTokenBucketLimiter(client, key, 1, 2)
+	require.Nil(t, limitErr(tb1.Limit(ctx, nil)))
+	require.Nil(t, limitErr(tb2.Limit(ctx, nil)))
+	_, err := tb1.Limit(ctx, nil)
+	require.NotNil(t, err)
+	require.True(t, strings.Contains(err.Error(), "Exceeded rate"))
+	_, err = tb2.Limit(ctx, nil)
+	require.NotNil(t, err)
+
+	// bucket refills
+	time.Sleep(time.Second)
+	require.Nil(t, limitErr(tb2.Limit(ctx, nil)))
+	require.NotNil(t, limitErr(tb1.Limit(ctx, nil)))
+}
+
+func TestRedisLeakyBucketLimiter(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelDmereq)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	redisServer, client := newTestRedisClient(t, ctx)
+	defer redisServer.Close()
+	defer client.Close()
+
+	numRequestsPerSecond := 5.0
+	numClients := 4
+	key := GetRedisRateLimitKey("test", "flow", "lb")
+	before := time.Now()
+	done := make(chan error, numClients)
+	for i := 0; i < numClients; i++ {
+		// each client has its own limiter to emulate replicas
+		leakyBucket := NewRedisLeakyBucketLimiter(client, key, numRequestsPerSecond)
+		go func() {
+			done <- limitErr(leakyBucket.Limit(ctx, nil))
+		}()
+	}
+	for i := 0; i < numClients; i++ {
+		require.Nil(t, <-done)
+	}
+	expectedTime := time.Duration((float64(numClients) - 1.0) / numRequestsPerSecond * float64(time.Second))
+	require.True(t, time.Since(before) >= expectedTime-10*time.Millisecond)
+}
+
+func TestRedisIntervalLimiter(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelDmereq)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	redisServer, client := newTestRedisClient(t, ctx)
+	defer redisServer.Close()
+	defer client.Close()
+
+	key := GetRedisRateLimitKey("test", "maxreqs", "interval")
+	il1 := NewRedisIntervalLimiter(client, key, 3, time.Minute)
+	il2 := NewRedisIntervalLimiter(client, key, 3, time.Minute)
+	require.Nil(t, limitErr(il1.Limit(ctx, nil)))
+	require.Nil(t, limitErr(il2.Limit(ctx, nil)))
+	require.Nil(t, limitErr(il1.Limit(ctx, nil)))
+	status, err := il2.Limit(ctx, nil)
+	require.NotNil(t, err)
+	require.True(t, strings.Contains(err.Error(), "Exceeded limit of 3"))
+	require.Equal(t, int64(3), status.Limit)
+	require.Equal(t, int64(0), status.Remaining)
+	require.True(t, status.RetryAfter > 0 && status.RetryAfter <= time.Minute)
+
+	// interval resets when the key expires
+	redisServer.FastForward(time.Minute)
+	status, err = il2.Limit(ctx, nil)
+	require.Nil(t, err)
+	require.Equal(t, int64(2), status.Remaining)
+	require.Equal(t, time.Duration(0), status.RetryAfter)
+}
+
+func TestRedisRateLimitManager(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelDmereq)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	redisServer, client := newTestRedisClient(t, ctx)
+	defer redisServer.Close()
+	defer client.Close()
+
+	// Two managers sharing the same redis emulate two DME replicas
+	mgr1 := NewRateLimitManager(false, 100, 100, WithRedisClient(client))
+	mgr2 := NewRateLimitManager(false, 100, 100, WithRedisClient(client))
+	maxReqs := &edgeproto.MaxReqsRateLimitSettings{
+		Key: edgeproto.MaxReqsRateLimitSettingsKey{
+			MaxReqsSettingsName: "perip",
+			RateLimitKey: edgeproto.RateLimitSettingsKey{
+				ApiName:         "FindCloudlet",
+				ApiEndpointType: edgeproto.ApiEndpointType_DME,
+				RateLimitTarget: edgeproto.RateLimitTarget_PER_IP,
+			},
+		},
+		Settings: edgeproto.MaxReqsSettings{
+			MaxReqsAlgorithm: edgeproto.MaxReqsRateLimitAlgorithm_FIXED_WINDOW_ALGORITHM,
+			MaxRequests:      2,
+			Interval:         edgeproto.Duration(time.Minute),
+		},
+	}
+	mgr1.UpdateMaxReqsRateLimitSettings(maxReqs)
+	mgr2.UpdateMaxReqsRateLimitSettings(maxReqs)
+
+	ip1 := &CallerInfo{
+		Api: "FindCloudlet",
+		Ip:  "10.0.0.1",
+	}
+	ip2 := &CallerInfo{
+		Api: "FindCloudlet",
+		Ip:  "10.0.0.2",
+	}
+	require.Nil(t, limitErr(mgr1.Limit(ctx, ip1)))
+	require.Nil(t, limitErr(mgr2.Limit(ctx, ip1)))
+	// limit is shared across managers
+	require.NotNil(t, limitErr(mgr1.Limit(ctx, ip1)))
+	require.NotNil(t, limitErr(mgr2.Limit(ctx, ip1)))
+	// but not across ips
+	require.Nil(t, limitErr(mgr2.Limit(ctx, ip2)))
+}
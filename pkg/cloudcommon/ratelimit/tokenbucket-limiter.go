@@ -17,6 +17,8 @@ package ratelimit
 import (
 	"context"
 	"fmt"
+	"math"
+	"time"
 
 	"golang.org/x/time/rate"
 )
@@ -43,15 +45,41 @@ func NewTokenBucketLimiter(tokensPerSecond float64, bucketSize int) *TokenBucket
 	return t
 }
 
-func (t *TokenBucketLimiter) Limit(ctx context.Context, info *CallerInfo) error {
-	tokenAvailable := t.limiter.Allow()
+func (t *TokenBucketLimiter) Limit(ctx context.Context, info *CallerInfo) (*LimitStatus, error) {
+	now := time.Now()
+	tokenAvailable := t.limiter.AllowN(now, 1)
+	status := getTokenBucketStatus(t.limiter.TokensAt(now), t.tokensPerSecond, t.bucketSize, !tokenAvailable)
 	if !tokenAvailable {
-		return fmt.Errorf("Exceeded rate of %f requests per second", t.tokensPerSecond)
+		return status, fmt.Errorf("Exceeded rate of %f requests per second", t.tokensPerSecond)
 	} else {
-		return nil
+		return status, nil
 	}
 }
 
+// Get the quota status of a token bucket with the given number of tokens left.
+// The retry time is only set if the request was rejected.
+func getTokenBucketStatus(tokens, tokensPerSecond float64, bucketSize int, rejected bool) *LimitStatus {
+	status := &LimitStatus{
+		Limit: int64(bucketSize),
+	}
+	if tokens > 0 {
+		status.Remaining = int64(math.Floor(tokens))
+	}
+	if tokensPerSecond <= 0 {
+		return status
+	}
+	tokenTime := func(n float64) time.Duration {
+		return time.Duration(math.Ceil(n / tokensPerSecond * float64(time.Second)))
+	}
+	if missing := float64(bucketSize) - tokens; missing > 0 {
+		status.ResetAfter = tokenTime(missing)
+	}
+	if rejected && tokens < 1 {
+		status.RetryAfter = tokenTime(1 - tokens)
+	}
+	return status
+}
+
 func (t *TokenBucketLimiter) Type() string {
 	return "TokenBucketLimiter"
 }
@@ -40,6 +40,7 @@ var SvcNodeTypeController = "controller"
 var SvcNodeTypeCCRM = "ccrm"
 var SvcNodeTypeClusterSvc = "cluster-svc"
 var SvcNodeTypeNotifyRoot = "notifyroot"
+var SvcNodeTypeNotifyAgg = "notifyagg"
 var SvcNodeTypeEdgeTurn = "edgeturn"
 var SvcNodeTypeMC = "mc"
 var SvcNodeTypeAutoProv = "autoprov"
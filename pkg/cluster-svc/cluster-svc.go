@@ -29,6 +29,7 @@ import (
 
 	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
 	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/alerts"
 	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
 	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon/svcnode"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
@@ -62,6 +63,37 @@ var clusterSvcPlugin pf.ClusterSvc
 var MEXPrometheusAppHelmTemplate = `prometheus:
   prometheusSpec:
     scrapeInterval: "{{.Interval}}"
+    ## Scrape application pods that expose their own metrics, for
+    ## user-defined alert policies with custom expressions.
+    ## Pod labels identifying the AppInst are kept with the same
+    ## names as in kube_pod_labels.
+    additionalScrapeConfigs:
+    - job_name: app-pods
+      kubernetes_sd_configs:
+      - role: pod
+      relabel_configs:
+      - source_labels: [__meta_kubernetes_pod_annotation_prometheus_io_scrape]
+        action: keep
+        regex: "true"
+      - source_labels: [__meta_kubernetes_pod_label_` + cloudcommon.MexAppInstNameLabel + `]
+        action: keep
+        regex: .+
+      - source_labels: [__meta_kubernetes_pod_annotation_prometheus_io_path]
+        action: replace
+        target_label: __metrics_path__
+        regex: (.+)
+      - source_labels: [__address__, __meta_kubernetes_pod_annotation_prometheus_io_port]
+        action: replace
+        regex: ([^:]+)(?::\d+)?;(\d+)
+        replacement: $1:$2
+        target_label: __address__
+      - action: labelmap
+        regex: __meta_kubernetes_pod_label_(mexApp.+)
+        replacement: label_$1
+      - source_labels: [__meta_kubernetes_namespace]
+        target_label: namespace
+      - source_labels: [__meta_kubernetes_pod_name]
+        target_label: pod
   service:
     type: LoadBalancer
 kubelet:
@@ -504,12 +536,10 @@ func appInstGetApi(ctx context.Context, apiClient edgeproto.AppInstApiClient, ap
 	return nil, err
 }
 
-// Only active connections limit is a cloudlet prometheus level alert
+// Active connections limit and load balancer custom expressions
+// are cloudlet prometheus level alerts
 func isClusterPrometheusAlert(alert *edgeproto.AlertPolicy) bool {
-	if alert.ActiveConnLimit != 0 {
-		return false
-	}
-	return true
+	return !alerts.IsCloudletAlertPolicy(alert)
 }
 
 // Get a unique AppInstKey for the sidecar app
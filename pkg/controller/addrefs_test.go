@@ -139,6 +139,7 @@ func (s *AddRefsDataGen) GetCreateAppTestObj() (*edgeproto.App, *testSupportData
 	flavor := testutil.FlavorData()[0]
 	autoProvPolicy := testutil.AutoProvPolicyData()[0]
 	alertPolicy := testutil.AlertPolicyData()[0]
+	geoFencePolicy := testutil.GeoFencePolicyData()[0]
 
 	app := testutil.AppData()[0]
 	app.KubernetesResources = nil
@@ -146,11 +147,13 @@ func (s *AddRefsDataGen) GetCreateAppTestObj() (*edgeproto.App, *testSupportData
 	app.DefaultFlavor = flavor.Key
 	app.AutoProvPolicies = []string{autoProvPolicy.Key.Name}
 	app.AlertPolicies = []string{alertPolicy.Key.Name}
+	app.GeoFencePolicy = geoFencePolicy.Key.Name
 
 	supportData := &testSupportData{}
 	supportData.Flavors = []edgeproto.Flavor{flavor}
 	supportData.AutoProvPolicies = []edgeproto.AutoProvPolicy{autoProvPolicy}
 	supportData.AlertPolicies = []edgeproto.AlertPolicy{alertPolicy}
+	supportData.GeoFencePolicies = []edgeproto.GeoFencePolicy{geoFencePolicy}
 	return &app, supportData
 }
 
@@ -311,6 +314,7 @@ func (s *AddRefsDataGen) GetUpdateAppTestObj() (*edgeproto.App, *testSupportData
 	updatable.DefaultFlavor = edgeproto.FlavorKey{}
 	updatable.AutoProvPolicies = []string{}
 	updatable.AlertPolicies = []string{}
+	updatable.GeoFencePolicy = ""
 
 	supportData.Apps = []edgeproto.App{updatable}
 
@@ -319,6 +323,7 @@ func (s *AddRefsDataGen) GetUpdateAppTestObj() (*edgeproto.App, *testSupportData
 		edgeproto.AppFieldDefaultFlavorName,
 		edgeproto.AppFieldAutoProvPolicies,
 		edgeproto.AppFieldAlertPolicies,
+		edgeproto.AppFieldGeoFencePolicy,
 	}
 	return testObj, supportData
 }
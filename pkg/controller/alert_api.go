@@ -46,6 +46,8 @@ func NewAlertApi(sync *regiondata.Sync, all *AllApis) *AlertApi {
 	edgeproto.InitAlertCache(&alertApi.sourceCache)
 	alertApi.sourceCache.SetUpdatedCb(alertApi.StoreUpdate)
 	alertApi.sourceCache.SetDeletedCb(alertApi.StoreDelete)
+	alertApi.cache.AddUpdatedCb(alertApi.alertHistoryUpdated)
+	alertApi.cache.AddDeletedCb(alertApi.alertHistoryDeleted)
 	sync.RegisterCache(&alertApi.cache)
 	return &alertApi
 }
@@ -191,7 +193,11 @@ func (s *AlertApi) Flush(ctx context.Context, notifyId int64) {
 func (s *AlertApi) Prune(ctx context.Context, keys map[edgeproto.AlertKey]struct{}) {}
 
 func (s *AlertApi) ShowAlert(in *edgeproto.Alert, cb edgeproto.AlertApi_ShowAlertServer) error {
+	ctx := cb.Context()
 	err := s.cache.Show(in, func(obj *edgeproto.Alert) error {
+		if s.all.alertSilenceApi.isAlertSuppressed(ctx, obj) {
+			return nil
+		}
 		err := cb.Send(obj)
 		return err
 	})
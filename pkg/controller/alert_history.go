@@ -0,0 +1,259 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon/influxsup"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon/svcnode"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/gogo/protobuf/types"
+	client "github.com/influxdata/influxdb/client/v2"
+	opentracing "github.com/opentracing/opentracing-go"
+)
+
+// Alerts are deleted when they resolve, so each time an alert fires
+// or resolves the transition is recorded in the events database, and
+// as an event. Like alert notifications, each transition is claimed
+// in redis so it is only recorded once across controller replicas
+// and restarts.
+
+var AlertHistoryClaimTTL = 7 * 24 * time.Hour
+
+func (s *AlertApi) alertHistoryUpdated(ctx context.Context, old *edgeproto.Alert, new *edgeproto.Alert) {
+	if !alertFired(old, new) {
+		return
+	}
+	s.recordAlertTransition(ctx, new, AlertStatusFiring, dme.TimestampToTime(new.ActiveAt))
+}
+
+func (s *AlertApi) alertHistoryDeleted(ctx context.Context, old *edgeproto.Alert) {
+	if old.State != AlertStatusFiring {
+		return
+	}
+	s.recordAlertTransition(ctx, old, AlertStatusResolved, time.Now())
+}
+
+func alertHistoryClaimKey(alert *edgeproto.Alert, status string) string {
+	return fmt.Sprintf("alert-history/%s/%d/%s", alertFingerprint(alert), alert.ActiveAt.Seconds, status)
+}
+
+func (s *AlertApi) recordAlertTransition(ctx context.Context, in *edgeproto.Alert, status string, ts time.Time) {
+	rclient := redisClient
+	if rclient == nil || services.events == nil {
+		return
+	}
+	alert := &edgeproto.Alert{}
+	alert.DeepCopyIn(in)
+
+	span := log.StartSpan(log.DebugLevelApi, "alert history", opentracing.ChildOf(log.SpanFromContext(ctx).Context()))
+	span.SetTag("alertname", alert.Labels["alertname"])
+	span.SetTag("status", status)
+	ctx = log.ContextWithSpan(context.Background(), span)
+
+	go func() {
+		defer span.Finish()
+		claimed, err := rclient.SetNX(ctx, alertHistoryClaimKey(alert, status), 1, AlertHistoryClaimTTL).Result()
+		if err != nil {
+			log.SpanLog(ctx, log.DebugLevelApi, "failed to claim alert history", "labels", alert.Labels, "err", err)
+			return
+		}
+		if !claimed {
+			// already recorded
+			return
+		}
+		services.events.AddMetric(alertHistoryMetric(alert, status, ts))
+
+		if status == AlertStatusFiring {
+			nodeMgr.EventAtTime(ctx, "alert firing", alertOrg(alert), svcnode.EventType, alert.Labels, nil, ts)
+		} else {
+			nodeMgr.TimedEvent(ctx, "alert resolved", alertOrg(alert), svcnode.EventType, alert.Labels, nil, dme.TimestampToTime(alert.ActiveAt), ts)
+		}
+	}()
+}
+
+// alertOrg is the first organization the alert belongs to
+func alertOrg(alert *edgeproto.Alert) string {
+	for _, label := range alertOrgLabels {
+		if org, ok := alert.Labels[label]; ok && org != "" {
+			return org
+		}
+	}
+	return svcnode.NoOrg
+}
+
+func alertHistoryMetric(alert *edgeproto.Alert, status string, ts time.Time) *edgeproto.Metric {
+	metric := edgeproto.Metric{}
+	metric.Name = cloudcommon.AlertEvent
+	pts, _ := types.TimestampProto(ts)
+	metric.Timestamp = *pts
+	for k, v := range alert.Labels {
+		if v == "" {
+			continue
+		}
+		metric.AddTag(k, v)
+	}
+	metric.AddStringVal(cloudcommon.MetricTagEvent, status)
+	duration := time.Duration(0)
+	if status == AlertStatusResolved {
+		duration = ts.Sub(dme.TimestampToTime(alert.ActiveAt))
+	}
+	metric.AddIntVal(cloudcommon.MetricTagDuration, uint64(duration))
+	return &metric
+}
+
+func influxQuoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `\"`) + `"`
+}
+
+func influxQuoteString(val string) string {
+	return `'` + strings.ReplaceAll(strings.ReplaceAll(val, `\`, `\\`), `'`, `\'`) + `'`
+}
+
+func getAlertHistoryQuery(in *edgeproto.AlertHistoryRequest, start, end time.Time) string {
+	selectors := []string{
+		"alertname",
+		cloudcommon.MetricTagEvent,
+		cloudcommon.MetricTagDuration,
+	}
+	where := []string{
+		"time >= " + influxQuoteString(start.Format(time.RFC3339Nano)),
+		"time < " + influxQuoteString(end.Format(time.RFC3339Nano)),
+	}
+	if in.Organization != "" && in.Organization != edgeproto.OrganizationEdgeCloud {
+		orgs := []string{}
+		for _, label := range alertOrgLabels {
+			orgs = append(orgs, influxQuoteIdent(label)+"="+influxQuoteString(in.Organization))
+		}
+		where = append(where, "("+strings.Join(orgs, " OR ")+")")
+	}
+	keys := []string{}
+	for k := range in.Labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		where = append(where, influxQuoteIdent(k)+"="+influxQuoteString(in.Labels[k]))
+	}
+	return fmt.Sprintf(`SELECT %s FROM %s WHERE %s`,
+		cloudcommon.GetInfluxSelectFields(selectors),
+		influxQuoteIdent(cloudcommon.AlertEvent),
+		strings.Join(where, " AND "))
+}
+
+// getAlertHistory summarizes the alert transitions per alert name.
+// Each value is of the format [time alertname event duration].
+func getAlertHistory(results []client.Result) ([]*edgeproto.AlertHistory, error) {
+	histories := map[string]*edgeproto.AlertHistory{}
+	for _, result := range results {
+		if result.Err != "" {
+			return nil, errors.New(result.Err)
+		}
+		for _, row := range result.Series {
+			for _, values := range row.Values {
+				if len(values) != 4 {
+					return nil, fmt.Errorf("Error parsing influx response")
+				}
+				ts, err := influxsup.ConvTime(values[0])
+				if err != nil {
+					return nil, fmt.Errorf("Unable to parse timestamp: %v", err)
+				}
+				name, err := influxsup.ConvString(values[1])
+				if err != nil {
+					return nil, fmt.Errorf("Unable to parse alertname: %v", err)
+				}
+				event, err := influxsup.ConvString(values[2])
+				if err != nil {
+					return nil, fmt.Errorf("Unable to parse event: %v", err)
+				}
+				hist, ok := histories[name]
+				if !ok {
+					hist = &edgeproto.AlertHistory{
+						Name: name,
+					}
+					histories[name] = hist
+				}
+				switch event {
+				case AlertStatusFiring:
+					hist.FiredCount++
+					if ts.After(dme.TimestampToTime(hist.LastFiredAt)) {
+						hist.LastFiredAt = dme.TimeToTimestamp(ts)
+					}
+				case AlertStatusResolved:
+					duration, err := influxsup.ConvInt(values[3])
+					if err != nil {
+						return nil, fmt.Errorf("Unable to parse duration: %v", err)
+					}
+					hist.ResolvedCount++
+					hist.TotalDuration += edgeproto.Duration(duration)
+					if edgeproto.Duration(duration) > hist.MaxDuration {
+						hist.MaxDuration = edgeproto.Duration(duration)
+					}
+					if ts.After(dme.TimestampToTime(hist.LastResolvedAt)) {
+						hist.LastResolvedAt = dme.TimeToTimestamp(ts)
+					}
+				}
+			}
+		}
+	}
+	out := []*edgeproto.AlertHistory{}
+	for _, hist := range histories {
+		out = append(out, hist)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Name < out[j].Name
+	})
+	return out, nil
+}
+
+func (s *AlertApi) ShowAlertHistory(in *edgeproto.AlertHistoryRequest, cb edgeproto.AlertApi_ShowAlertHistoryServer) error {
+	ctx := cb.Context()
+	end := time.Now()
+	if in.EndTime.Seconds != 0 || in.EndTime.Nanos != 0 {
+		end = dme.TimestampToTime(in.EndTime)
+	}
+	start := end.Add(-svcnode.DefaultTimeDuration)
+	if in.StartTime.Seconds != 0 || in.StartTime.Nanos != 0 {
+		start = dme.TimestampToTime(in.StartTime)
+	}
+	if !end.After(start) {
+		return errors.New("End time must be after the start time")
+	}
+	query := getAlertHistoryQuery(in, start, end)
+	log.SpanLog(ctx, log.DebugLevelApi, "show alert history", "query", query)
+	results, err := services.events.QueryDB(query)
+	if err != nil {
+		return fmt.Errorf("Unable to query influx: %v", err)
+	}
+	histories, err := getAlertHistory(results)
+	if err != nil {
+		return err
+	}
+	for _, hist := range histories {
+		if err := cb.Send(hist); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	influxq "github.com/edgexr/edge-cloud-platform/pkg/influxq_client"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/regiondata"
+	"github.com/edgexr/edge-cloud-platform/test/testutil"
+	influxdb "github.com/influxdata/influxdb/client/v2"
+	"github.com/influxdata/influxdb/models"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type showAlertHistory struct {
+	grpc.ServerStream
+	ctx  context.Context
+	Data []edgeproto.AlertHistory
+}
+
+func (x *showAlertHistory) Send(m *edgeproto.AlertHistory) error {
+	x.Data = append(x.Data, *m)
+	return nil
+}
+
+func (x *showAlertHistory) Context() context.Context {
+	return x.ctx
+}
+
+func TestAlertHistory(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelEtcd | log.DebugLevelApi)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+	testSvcs := testinit(ctx, t)
+	defer testfinish(testSvcs)
+
+	dummy := regiondata.InMemoryStore{}
+	dummy.Start()
+	defer dummy.Stop()
+
+	sync := regiondata.InitSync(&dummy)
+	apis := NewAllApis(sync)
+	sync.Start()
+	defer sync.Done()
+
+	// fake influx server
+	writes := make(chan string, 20)
+	queries := make(chan string, 20)
+	var queryRows []models.Row
+	influxServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Influxdb-Version", "1.0")
+		switch r.URL.Path {
+		case "/write":
+			data, err := io.ReadAll(r.Body)
+			require.Nil(t, err)
+			writes <- string(data)
+			w.WriteHeader(http.StatusNoContent)
+		case "/query":
+			q := r.FormValue("q")
+			res := influxdb.Result{}
+			if strings.HasPrefix(q, "SELECT") {
+				queries <- q
+				res.Series = queryRows
+			}
+			w.Header().Set("Content-Type", "application/json")
+			data, err := json.Marshal(influxdb.Response{
+				Results: []influxdb.Result{res},
+			})
+			require.Nil(t, err)
+			w.Write(data)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer influxServer.Close()
+	services.events = influxq.NewInfluxQ(cloudcommon.EventsDbName, "", "", time.Second)
+	err := services.events.Start(influxServer.URL)
+	require.Nil(t, err)
+	defer services.events.Stop()
+
+	devOrg := testutil.DevData()[0]
+	activeAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+	alert := edgeproto.Alert{
+		Labels: map[string]string{
+			"alertname":                         cloudcommon.AlertAppInstDown,
+			edgeproto.AppInstKeyTagName:         "historyInst",
+			edgeproto.AppInstKeyTagOrganization: devOrg,
+			cloudcommon.AlertScopeTypeTag:       cloudcommon.AlertScopeApp,
+		},
+		State:    "firing",
+		ActiveAt: dme.TimeToTimestamp(activeAt),
+	}
+
+	// firing and resolving are recorded
+	apis.alertApi.Update(ctx, &alert, 0)
+	waitAlertHistoryWrite(t, writes, `event="firing"`)
+	apis.alertApi.Delete(ctx, &alert, 0)
+	line := waitAlertHistoryWrite(t, writes, `event="resolved"`)
+	require.Contains(t, line, "appinst=historyInst")
+	require.Contains(t, line, "appinstorg="+devOrg)
+	require.Contains(t, line, "alertname=AppInstDown")
+
+	// reloading the same alert after a restart does not record it again
+	apis.alertApi.Update(ctx, &alert, 0)
+	apis.alertApi.Delete(ctx, &alert, 0)
+	for ii := 0; ii < 3; ii++ {
+		services.events.DoPush()
+		select {
+		case data := <-writes:
+			require.NotContains(t, data, cloudcommon.AlertEvent+",", "unexpected write %s", data)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	// show history
+	end := time.Now()
+	ts := func(d time.Duration) string {
+		return end.Add(-d).UTC().Format(time.RFC3339Nano)
+	}
+	queryRows = []models.Row{{
+		Name:    cloudcommon.AlertEvent,
+		Columns: []string{"time", "alertname", "event", "duration"},
+		Values: [][]interface{}{
+			{ts(50 * time.Minute), "AppInstDown", "firing", json.Number("0")},
+			{ts(45 * time.Minute), "AppInstDown", "resolved", json.Number("300000000000")},
+			{ts(30 * time.Minute), "CloudletResourceUsage", "firing", json.Number("0")},
+			{ts(20 * time.Minute), "AppInstDown", "firing", json.Number("0")},
+			{ts(10 * time.Minute), "AppInstDown", "resolved", json.Number("600000000000")},
+			{ts(5 * time.Minute), "AppInstDown", "firing", json.Number("0")},
+		},
+	}}
+	show := showAlertHistory{ctx: ctx}
+	err = apis.alertApi.ShowAlertHistory(&edgeproto.AlertHistoryRequest{
+		Organization: devOrg,
+		EndTime:      dme.TimeToTimestamp(end),
+		Labels: map[string]string{
+			edgeproto.AppInstKeyTagName: "history'Inst",
+		},
+	}, &show)
+	require.Nil(t, err)
+	query := <-queries
+	require.Contains(t, query, `FROM "alert" WHERE time >= '`+end.Add(-48*time.Hour).Format(time.RFC3339Nano)+`'`)
+	require.Contains(t, query, `("apporg"='`+devOrg+`' OR "appinstorg"='`+devOrg+`' OR "clusterorg"='`+devOrg+`' OR "cloudletorg"='`+devOrg+`')`)
+	require.Contains(t, query, `"appinst"='history\'Inst'`)
+	require.Equal(t, 2, len(show.Data))
+	require.Equal(t, "AppInstDown", show.Data[0].Name)
+	require.Equal(t, uint32(3), show.Data[0].FiredCount)
+	require.Equal(t, uint32(2), show.Data[0].ResolvedCount)
+	require.Equal(t, edgeproto.Duration(15*time.Minute), show.Data[0].TotalDuration)
+	require.Equal(t, edgeproto.Duration(10*time.Minute), show.Data[0].MaxDuration)
+	require.Equal(t, end.Add(-5*time.Minute).Unix(), show.Data[0].LastFiredAt.Seconds)
+	require.Equal(t, end.Add(-10*time.Minute).Unix(), show.Data[0].LastResolvedAt.Seconds)
+	require.Equal(t, "CloudletResourceUsage", show.Data[1].Name)
+	require.Equal(t, uint32(1), show.Data[1].FiredCount)
+	require.Equal(t, uint32(0), show.Data[1].ResolvedCount)
+
+	// admin sees all orgs
+	show = showAlertHistory{ctx: ctx}
+	err = apis.alertApi.ShowAlertHistory(&edgeproto.AlertHistoryRequest{
+		Organization: edgeproto.OrganizationEdgeCloud,
+	}, &show)
+	require.Nil(t, err)
+	query = <-queries
+	require.NotContains(t, query, "apporg")
+
+	// invalid time range
+	err = apis.alertApi.ShowAlertHistory(&edgeproto.AlertHistoryRequest{
+		StartTime: dme.TimeToTimestamp(end),
+		EndTime:   dme.TimeToTimestamp(end.Add(-time.Hour)),
+	}, &show)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "End time must be after the start time")
+}
+
+func waitAlertHistoryWrite(t *testing.T, writes chan string, match string) string {
+	for ii := 0; ii < 50; ii++ {
+		services.events.DoPush()
+		select {
+		case data := <-writes:
+			for _, line := range strings.Split(data, "\n") {
+				if strings.HasPrefix(line, cloudcommon.AlertEvent+",") && strings.Contains(line, match) {
+					return line
+				}
+			}
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	require.Fail(t, "alert history not written", match)
+	return ""
+}
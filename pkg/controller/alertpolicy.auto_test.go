@@ -34,6 +34,7 @@ type AllDeleteDataGen interface {
 	ClusterInstDeleteDataGen
 	FlavorDeleteDataGen
 	GPUDriverDeleteDataGen
+	GeoFencePolicyDeleteDataGen
 	NetworkDeleteDataGen
 	PlatformFeaturesDeleteDataGen
 	ResTagTableDeleteDataGen
@@ -52,6 +53,7 @@ func allDeleteChecks(t *testing.T, ctx context.Context, all *AllApis, dataGen Al
 	deleteClusterInstChecks(t, ctx, all, dataGen)
 	deleteFlavorChecks(t, ctx, all, dataGen)
 	deleteGPUDriverChecks(t, ctx, all, dataGen)
+	deleteGeoFencePolicyChecks(t, ctx, all, dataGen)
 	deleteNetworkChecks(t, ctx, all, dataGen)
 	deletePlatformFeaturesChecks(t, ctx, all, dataGen)
 	deleteResTagTableChecks(t, ctx, all, dataGen)
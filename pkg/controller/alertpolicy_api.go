@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/alerts"
 	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
 	"github.com/edgexr/edge-cloud-platform/pkg/regiondata"
@@ -51,6 +52,9 @@ func (a *AlertPolicyApi) CreateAlertPolicy(ctx context.Context, in *edgeproto.Al
 	if err = in.Validate(edgeproto.AlertPolicyAllFieldsMap); err != nil {
 		return &edgeproto.Result{}, err
 	}
+	if err = alerts.ValidateCustomExpression(in); err != nil {
+		return &edgeproto.Result{}, err
+	}
 
 	// Protect against user defined alerts that can oscillate too quickly
 	if in.TriggerTime < a.all.settingsApi.Get().AlertPolicyMinTriggerTime {
@@ -125,6 +129,15 @@ func (a *AlertPolicyApi) ShowAlertPolicy(in *edgeproto.AlertPolicy, cb edgeproto
 	return err
 }
 
+func (a *AlertPolicyApi) ShowAlertPolicyHistory(in *edgeproto.AlertPolicy, cb edgeproto.AlertPolicyApi_ShowAlertPolicyHistoryServer) error {
+	return showObjHistory(cb.Context(), a.all, a.sync, a.cache.GetTypeString(), in, func(rev int64, obj *edgeproto.AlertPolicy) error {
+		return cb.Send(&edgeproto.AlertPolicyHistory{
+			Revision: rev,
+			Obj:      *obj,
+		})
+	})
+}
+
 func (a *AlertPolicyApi) UpdateAlertPolicy(ctx context.Context, in *edgeproto.AlertPolicy) (*edgeproto.Result, error) {
 	cur := edgeproto.AlertPolicy{}
 	changed := 0
@@ -139,6 +152,9 @@ func (a *AlertPolicyApi) UpdateAlertPolicy(ctx context.Context, in *edgeproto.Al
 		if err := cur.Validate(nil); err != nil {
 			return err
 		}
+		if err := alerts.ValidateCustomExpression(&cur); err != nil {
+			return err
+		}
 		// Protect against user defined alerts that can oscillate too quickly
 		if cur.TriggerTime < a.all.settingsApi.Get().AlertPolicyMinTriggerTime {
 			return fmt.Errorf("Trigger time cannot be less than %s",
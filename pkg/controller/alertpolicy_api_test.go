@@ -106,6 +106,62 @@ func TestAlertPolicyApi(t *testing.T) {
 	_, err = apis.alertPolicyApi.CreateAlertPolicy(ctx, &userAlert)
 	require.NotNil(t, err, "Trigger Time cannot exceed 3 days")
 
+	// Custom expression cannot be combined with other triggers
+	userAlert = testutil.AlertPolicyData()[0]
+	userAlert.CustomExpression = "queue_depth > 100"
+	_, err = apis.alertPolicyApi.CreateAlertPolicy(ctx, &userAlert)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Custom expression alerts should not include any other triggers")
+
+	// Custom expression must be scopable to the AppInst
+	userAlert = testutil.AlertPolicyData()[0]
+	userAlert.CpuUtilizationLimit = 0
+	userAlert.MemUtilizationLimit = 0
+	userAlert.DiskUtilizationLimit = 0
+	userAlert.CustomExpression = `queue_depth{label_mexAppInstOrg="other"} > 100`
+	_, err = apis.alertPolicyApi.CreateAlertPolicy(ctx, &userAlert)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Invalid custom expression, label label_mexAppInstOrg is reserved")
+	userAlert.CustomExpression = `rate(queue_depth[5m] > 100`
+	_, err = apis.alertPolicyApi.CreateAlertPolicy(ctx, &userAlert)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "unclosed left parenthesis")
+
+	// Custom metrics source without custom expression
+	userAlert = testutil.AlertPolicyData()[0]
+	userAlert.CustomMetricsSource = edgeproto.AlertMetricsSource_ALERT_METRICS_SOURCE_LOAD_BALANCER
+	_, err = apis.alertPolicyApi.CreateAlertPolicy(ctx, &userAlert)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Custom metrics source requires a custom expression")
+
+	// Custom expression alert
+	customAlert := edgeproto.AlertPolicy{
+		Key: edgeproto.AlertPolicyKey{
+			Organization: testutil.AlertPolicyData()[0].Key.Organization,
+			Name:         "queueDepth",
+		},
+		CustomExpression: "max(queue_depth) > 100",
+		Severity:         "warning",
+		TriggerTime:      testutil.AlertPolicyData()[0].TriggerTime,
+	}
+	_, err = apis.alertPolicyApi.CreateAlertPolicy(ctx, &customAlert)
+	require.Nil(t, err)
+	customAlert.CustomExpression = `max(queue_depth{label_mexAppInstName="x"}) > 100`
+	customAlert.Fields = []string{edgeproto.AlertPolicyFieldCustomExpression}
+	_, err = apis.alertPolicyApi.UpdateAlertPolicy(ctx, &customAlert)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Invalid custom expression")
+	customAlert.CustomExpression = "sum(rate(envoy_cluster_upstream_cx_connect_fail[5m])) > 1"
+	customAlert.CustomMetricsSource = edgeproto.AlertMetricsSource_ALERT_METRICS_SOURCE_LOAD_BALANCER
+	customAlert.Fields = []string{
+		edgeproto.AlertPolicyFieldCustomExpression,
+		edgeproto.AlertPolicyFieldCustomMetricsSource,
+	}
+	_, err = apis.alertPolicyApi.UpdateAlertPolicy(ctx, &customAlert)
+	require.Nil(t, err)
+	_, err = apis.alertPolicyApi.DeleteAlertPolicy(ctx, &customAlert)
+	require.Nil(t, err)
+
 	// Delete non-existent user alert
 	userAlert = testutil.AlertPolicyData()[0]
 	_, err = apis.alertPolicyApi.DeleteAlertPolicy(ctx, &userAlert)
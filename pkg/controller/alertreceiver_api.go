@@ -0,0 +1,228 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/regiondata"
+	"github.com/edgexr/edge-cloud-platform/pkg/util"
+	"github.com/edgexr/edge-cloud-platform/pkg/vault"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+// Should only be one of these instantiated in main
+type AlertReceiverApi struct {
+	all    *AllApis
+	sync   *regiondata.Sync
+	store  edgeproto.AlertReceiverStore
+	cache  edgeproto.AlertReceiverCache
+	client *http.Client
+}
+
+func NewAlertReceiverApi(sync *regiondata.Sync, all *AllApis) *AlertReceiverApi {
+	alertReceiverApi := AlertReceiverApi{}
+	alertReceiverApi.all = all
+	alertReceiverApi.sync = sync
+	alertReceiverApi.store = edgeproto.NewAlertReceiverStore(sync.GetKVStore())
+	alertReceiverApi.client = util.NewPublicHTTPClient(AlertReceiverTimeout)
+	edgeproto.InitAlertReceiverCache(&alertReceiverApi.cache)
+	sync.RegisterCache(&alertReceiverApi.cache)
+	all.alertApi.cache.AddUpdatedCb(alertReceiverApi.alertUpdated)
+	all.alertApi.cache.AddDeletedCb(alertReceiverApi.alertDeleted)
+	return &alertReceiverApi
+}
+
+func validateAlertReceiverSeverity(in *edgeproto.AlertReceiver) error {
+	if in.Severity != "" && !cloudcommon.IsAlertSeverityValid(in.Severity) {
+		return fmt.Errorf("Invalid severity. Valid severities: %s", cloudcommon.GetValidAlertSeverityString())
+	}
+	return nil
+}
+
+// validateAlertReceiverURL restricts tenant supplied receiver URLs
+// to public addresses. Addresses are checked again when connecting
+// in case the host is re-bound to a different address.
+// This is replaced for unit tests.
+var validateAlertReceiverURL = func(ctx context.Context, in *edgeproto.AlertReceiver) error {
+	if in.Url == "" {
+		return nil
+	}
+	return util.ValidatePublicURL(ctx, in.Url, "http", "https")
+}
+
+func (s *AlertReceiverApi) CreateAlertReceiver(ctx context.Context, in *edgeproto.AlertReceiver) (*edgeproto.Result, error) {
+	log.SpanLog(ctx, log.DebugLevelApi, "CreateAlertReceiver", "receiver", in.Key.String())
+	if err := in.Validate(edgeproto.AlertReceiverAllFieldsMap); err != nil {
+		return &edgeproto.Result{}, err
+	}
+	if err := validateAlertReceiverSeverity(in); err != nil {
+		return &edgeproto.Result{}, err
+	}
+	if err := validateAlertReceiverURL(ctx, in); err != nil {
+		return &edgeproto.Result{}, err
+	}
+	err := s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+		if s.store.STMGet(stm, &in.Key, nil) {
+			return in.Key.ExistsError()
+		}
+		recv := edgeproto.AlertReceiver{}
+		recv.DeepCopyIn(in)
+		if err := saveAlertReceiverSecrets(ctx, &recv); err != nil {
+			return err
+		}
+		s.store.STMPut(stm, &recv)
+		return nil
+	})
+	return &edgeproto.Result{}, err
+}
+
+func (s *AlertReceiverApi) UpdateAlertReceiver(ctx context.Context, in *edgeproto.AlertReceiver) (*edgeproto.Result, error) {
+	if err := validateAlertReceiverURL(ctx, in); err != nil {
+		return &edgeproto.Result{}, err
+	}
+	err := s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+		cur := edgeproto.AlertReceiver{}
+		if !s.store.STMGet(stm, &in.Key, &cur) {
+			return in.Key.NotFoundError()
+		}
+		old := edgeproto.AlertReceiver{}
+		old.DeepCopyIn(&cur)
+		changed := cur.CopyInFields(in)
+		if changed == 0 {
+			return nil
+		}
+		if err := cur.Validate(nil); err != nil {
+			return err
+		}
+		if err := validateAlertReceiverSeverity(&cur); err != nil {
+			return err
+		}
+		if cur.Url != old.Url || cur.PagerDutyIntegrationKey != old.PagerDutyIntegrationKey {
+			// merge in unchanged secrets
+			secrets, err := getAlertReceiverSecrets(ctx, &old)
+			if err != nil {
+				return err
+			}
+			if cur.Url == old.Url {
+				cur.Url = secrets.Url
+			}
+			if cur.PagerDutyIntegrationKey == old.PagerDutyIntegrationKey {
+				cur.PagerDutyIntegrationKey = secrets.PagerDutyIntegrationKey
+			}
+			if !alertReceiverHasSecrets(&cur) {
+				if err := vault.DeleteData(vaultConfig, getAlertReceiverVaultPath(&cur.Key)); err != nil {
+					log.SpanLog(ctx, log.DebugLevelApi, "Failed to delete alert receiver secrets from vault", "key", cur.Key, "err", err)
+				}
+			} else if err := saveAlertReceiverSecrets(ctx, &cur); err != nil {
+				return err
+			}
+		}
+		s.store.STMPut(stm, &cur)
+		return nil
+	})
+	return &edgeproto.Result{}, err
+}
+
+func (s *AlertReceiverApi) DeleteAlertReceiver(ctx context.Context, in *edgeproto.AlertReceiver) (*edgeproto.Result, error) {
+	if !s.cache.HasKey(&in.Key) {
+		return &edgeproto.Result{}, in.Key.NotFoundError()
+	}
+	res, err := s.store.Delete(ctx, in, s.sync.SyncWait)
+	if err != nil {
+		return res, err
+	}
+	if err := vault.DeleteData(vaultConfig, getAlertReceiverVaultPath(&in.Key)); err != nil {
+		log.SpanLog(ctx, log.DebugLevelApi, "Failed to delete alert receiver secrets from vault", "key", in.Key, "err", err)
+	}
+	return res, nil
+}
+
+func (s *AlertReceiverApi) ShowAlertReceiver(in *edgeproto.AlertReceiver, cb edgeproto.AlertReceiverApi_ShowAlertReceiverServer) error {
+	err := s.cache.Show(in, func(obj *edgeproto.AlertReceiver) error {
+		err := cb.Send(obj)
+		return err
+	})
+	return err
+}
+
+// Alert receiver URLs (which for Slack and other webhooks typically
+// embed an access token) and PagerDuty integration keys are secrets,
+// so are stored in Vault. The stored object keeps a redacted copy,
+// which is what is shown to users and included in snapshots.
+
+type alertReceiverSecrets struct {
+	Url                     string `json:"url,omitempty"`
+	PagerDutyIntegrationKey string `json:"pagerdutyintegrationkey,omitempty"`
+}
+
+func getAlertReceiverVaultPath(key *edgeproto.AlertReceiverKey) string {
+	return fmt.Sprintf("secret/data/alertreceivers/%s/%s/%s", *region, key.Organization, key.Name)
+}
+
+func alertReceiverHasSecrets(recv *edgeproto.AlertReceiver) bool {
+	return recv.Url != "" || recv.PagerDutyIntegrationKey != ""
+}
+
+// saveAlertReceiverSecrets stores the secrets in Vault and replaces
+// them in the receiver with redacted values.
+func saveAlertReceiverSecrets(ctx context.Context, recv *edgeproto.AlertReceiver) error {
+	if !alertReceiverHasSecrets(recv) {
+		return nil
+	}
+	secrets := alertReceiverSecrets{
+		Url:                     recv.Url,
+		PagerDutyIntegrationKey: recv.PagerDutyIntegrationKey,
+	}
+	log.SpanLog(ctx, log.DebugLevelApi, "storing alert receiver secrets in vault", "key", recv.Key)
+	if err := vault.PutData(vaultConfig, getAlertReceiverVaultPath(&recv.Key), &secrets); err != nil {
+		return fmt.Errorf("Unable to store alert receiver secrets: %s", err)
+	}
+	recv.Url = redactAlertReceiverURL(recv.Url)
+	if recv.PagerDutyIntegrationKey != "" {
+		recv.PagerDutyIntegrationKey = RedactedAccessVarValue
+	}
+	return nil
+}
+
+func getAlertReceiverSecrets(ctx context.Context, recv *edgeproto.AlertReceiver) (*alertReceiverSecrets, error) {
+	secrets := alertReceiverSecrets{}
+	if !alertReceiverHasSecrets(recv) {
+		return &secrets, nil
+	}
+	if err := vault.GetData(vaultConfig, getAlertReceiverVaultPath(&recv.Key), 0, &secrets); err != nil {
+		return nil, fmt.Errorf("Unable to get alert receiver secrets: %s", err)
+	}
+	return &secrets, nil
+}
+
+// redactAlertReceiverURL keeps the scheme and host so that users
+// can tell where alerts are sent.
+func redactAlertReceiverURL(in string) string {
+	if in == "" {
+		return ""
+	}
+	u, err := url.Parse(in)
+	if err != nil || u.Host == "" {
+		return RedactedAccessVarValue
+	}
+	return u.Scheme + "://" + u.Host + "/" + RedactedAccessVarValue
+}
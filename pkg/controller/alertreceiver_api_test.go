@@ -0,0 +1,448 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/regiondata"
+	"github.com/edgexr/edge-cloud-platform/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertReceiverApi(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelEtcd | log.DebugLevelApi)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+	testSvcs := testinit(ctx, t)
+	defer testfinish(testSvcs)
+
+	dummy := regiondata.InMemoryStore{}
+	dummy.Start()
+	defer dummy.Stop()
+
+	sync := regiondata.InitSync(&dummy)
+	apis := NewAllApis(sync)
+	sync.Start()
+	defer sync.Done()
+
+	// invalid receivers
+	recv := testutil.AlertReceiverData()[0]
+	recv.Url = ""
+	_, err := apis.alertReceiverApi.CreateAlertReceiver(ctx, &recv)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "URL must be specified")
+
+	recv.Url = "ftp://hooks.example.com"
+	_, err = apis.alertReceiverApi.CreateAlertReceiver(ctx, &recv)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "must be an http or https URL")
+
+	// receivers must not target internal addresses
+	for _, url := range []string{
+		"http://127.0.0.1:9093/alerts",
+		"https://10.1.2.3/alerts",
+		"http://169.254.169.254/latest/meta-data",
+		"http://[::1]/alerts",
+	} {
+		recv.Url = url
+		_, err = apis.alertReceiverApi.CreateAlertReceiver(ctx, &recv)
+		require.NotNil(t, err)
+		require.Contains(t, err.Error(), "is not public")
+	}
+	recv = testutil.AlertReceiverData()[2]
+	recv.Url = "https://127.0.0.1/v2/enqueue"
+	_, err = apis.alertReceiverApi.CreateAlertReceiver(ctx, &recv)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "is not public")
+
+	// test servers and test data are local or not resolvable
+	defer allowLocalAlertReceivers(apis)()
+
+	recv = testutil.AlertReceiverData()[0]
+	recv.Severity = "critical"
+	_, err = apis.alertReceiverApi.CreateAlertReceiver(ctx, &recv)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Invalid severity")
+
+	recv = testutil.AlertReceiverData()[1]
+	recv.Email = "not an email"
+	_, err = apis.alertReceiverApi.CreateAlertReceiver(ctx, &recv)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Invalid email")
+
+	recv = testutil.AlertReceiverData()[2]
+	recv.PagerDutyIntegrationKey = ""
+	_, err = apis.alertReceiverApi.CreateAlertReceiver(ctx, &recv)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "integration key must be specified")
+
+	recv = testutil.AlertReceiverData()[0]
+	recv.Type = edgeproto.AlertReceiverType_ALERT_RECEIVER_TYPE_UNKNOWN
+	_, err = apis.alertReceiverApi.CreateAlertReceiver(ctx, &recv)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Invalid receiver type")
+
+	// secrets are stored in vault and redacted in the stored object
+	redacted := testutil.AlertReceiverData()
+	for ii := range redacted {
+		redacted[ii].Url = redactAlertReceiverURL(redacted[ii].Url)
+		if redacted[ii].PagerDutyIntegrationKey != "" {
+			redacted[ii].PagerDutyIntegrationKey = RedactedAccessVarValue
+		}
+	}
+	require.Equal(t, "https://hooks.example.com/***", redacted[0].Url)
+	testutil.InternalAlertReceiverTest(t, "cud", apis.alertReceiverApi, testutil.AlertReceiverData(), testutil.WithCreatedAlertReceiverTestData(redacted))
+	for _, recv := range testutil.AlertReceiverData() {
+		if !alertReceiverHasSecrets(&recv) {
+			continue
+		}
+		secrets, err := getAlertReceiverSecrets(ctx, &recv)
+		require.Nil(t, err)
+		require.Equal(t, recv.Url, secrets.Url)
+		require.Equal(t, recv.PagerDutyIntegrationKey, secrets.PagerDutyIntegrationKey)
+	}
+
+	// update receiver
+	recv = testutil.AlertReceiverData()[0]
+	recv.Severity = "error"
+	recv.Fields = []string{edgeproto.AlertReceiverFieldSeverity}
+	_, err = apis.alertReceiverApi.UpdateAlertReceiver(ctx, &recv)
+	require.Nil(t, err)
+	check := edgeproto.AlertReceiver{}
+	require.True(t, apis.alertReceiverApi.cache.Get(&recv.Key, &check))
+	require.Equal(t, "error", check.Severity)
+	require.Equal(t, redacted[0].Url, check.Url)
+	secrets, err := getAlertReceiverSecrets(ctx, &check)
+	require.Nil(t, err)
+	require.Equal(t, recv.Url, secrets.Url)
+
+	// update secret
+	recv.Url = "https://hooks.example.com/alerts/new-token"
+	recv.Fields = []string{edgeproto.AlertReceiverFieldUrl}
+	_, err = apis.alertReceiverApi.UpdateAlertReceiver(ctx, &recv)
+	require.Nil(t, err)
+	require.True(t, apis.alertReceiverApi.cache.Get(&recv.Key, &check))
+	require.Equal(t, redacted[0].Url, check.Url)
+	secrets, err = getAlertReceiverSecrets(ctx, &check)
+	require.Nil(t, err)
+	require.Equal(t, recv.Url, secrets.Url)
+
+	// update of other fields keeps secrets
+	pd := testutil.AlertReceiverData()[2]
+	pd.Severity = "warning"
+	pd.Fields = []string{edgeproto.AlertReceiverFieldSeverity}
+	_, err = apis.alertReceiverApi.UpdateAlertReceiver(ctx, &pd)
+	require.Nil(t, err)
+	require.True(t, apis.alertReceiverApi.cache.Get(&pd.Key, &check))
+	require.Equal(t, RedactedAccessVarValue, check.PagerDutyIntegrationKey)
+	secrets, err = getAlertReceiverSecrets(ctx, &check)
+	require.Nil(t, err)
+	require.Equal(t, testutil.AlertReceiverData()[2].PagerDutyIntegrationKey, secrets.PagerDutyIntegrationKey)
+
+	// email display names are dropped
+	email := testutil.AlertReceiverData()[1]
+	email.Email = "Ops Team <ops@example.com>"
+	email.Fields = []string{edgeproto.AlertReceiverFieldEmail}
+	_, err = apis.alertReceiverApi.UpdateAlertReceiver(ctx, &email)
+	require.Nil(t, err)
+	require.True(t, apis.alertReceiverApi.cache.Get(&email.Key, &check))
+	require.Equal(t, "ops@example.com", check.Email)
+
+	recv.Severity = "bad"
+	recv.Fields = []string{edgeproto.AlertReceiverFieldSeverity}
+	_, err = apis.alertReceiverApi.UpdateAlertReceiver(ctx, &recv)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Invalid severity")
+
+	testutil.InternalAlertReceiverDeleteAll(t, ctx, apis.alertReceiverApi, testutil.AlertReceiverData())
+
+	testAlertReceiverSend(t, ctx, apis)
+}
+
+// allowLocalAlertReceivers disables the public address checks so
+// that receivers can target local test servers.
+func allowLocalAlertReceivers(apis *AllApis) func() {
+	origValidate := validateAlertReceiverURL
+	origClient := apis.alertReceiverApi.client
+	validateAlertReceiverURL = func(ctx context.Context, in *edgeproto.AlertReceiver) error {
+		return nil
+	}
+	apis.alertReceiverApi.client = &http.Client{
+		Timeout: AlertReceiverTimeout,
+	}
+	return func() {
+		validateAlertReceiverURL = origValidate
+		apis.alertReceiverApi.client = origClient
+	}
+}
+
+func testAlertReceiverSend(t *testing.T, ctx context.Context, apis *AllApis) {
+	AlertReceiverRetryBackoff = 10 * time.Millisecond
+	defer func() {
+		AlertReceiverRetryBackoff = time.Second
+	}()
+
+	devOrg := testutil.DevData()[0]
+	operOrg := testutil.OperatorData()[0]
+
+	webhookCh := make(chan AlertNotification, 10)
+	webhookFails := 1
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if webhookFails > 0 {
+			// check retry
+			webhookFails--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		notification := AlertNotification{}
+		err := json.NewDecoder(r.Body).Decode(&notification)
+		require.Nil(t, err)
+		webhookCh <- notification
+	}))
+	defer webhook.Close()
+
+	slackCh := make(chan slackMessage, 10)
+	slack := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		msg := slackMessage{}
+		err := json.NewDecoder(r.Body).Decode(&msg)
+		require.Nil(t, err)
+		slackCh <- msg
+	}))
+	defer slack.Close()
+
+	pagerDutyCh := make(chan pagerDutyEvent, 10)
+	pagerDuty := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		event := pagerDutyEvent{}
+		err := json.NewDecoder(r.Body).Decode(&event)
+		require.Nil(t, err)
+		pagerDutyCh <- event
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer pagerDuty.Close()
+
+	mailCh := make(chan string, 10)
+	smtpAddr := "smtp.example.ut:25"
+	smtpFrom := "alerts@example.ut"
+	alertSmtpAddr = &smtpAddr
+	alertSmtpFrom = &smtpFrom
+	sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		require.Equal(t, smtpAddr, addr)
+		require.Equal(t, []string{"noc@ufgt.example.com"}, to)
+		mailCh <- string(msg)
+		return nil
+	}
+	defer func() {
+		sendMail = smtp.SendMail
+	}()
+
+	receivers := testutil.AlertReceiverData()
+	receivers[0].Url = webhook.URL
+	receivers[2].Url = pagerDuty.URL
+	receivers = append(receivers, edgeproto.AlertReceiver{
+		Key: edgeproto.AlertReceiverKey{
+			Name:         "other-dev-slack",
+			Organization: testutil.DevData()[1],
+		},
+		Type: edgeproto.AlertReceiverType_ALERT_RECEIVER_TYPE_SLACK,
+		Url:  slack.URL,
+	})
+	testutil.InternalAlertReceiverCreate(t, apis.alertReceiverApi, receivers)
+
+	appInstAlert := edgeproto.Alert{
+		Labels: map[string]string{
+			"alertname":                          cloudcommon.AlertAppInstDown,
+			edgeproto.AppInstKeyTagName:          "alertReceiverInst",
+			edgeproto.AppInstKeyTagOrganization:  devOrg,
+			edgeproto.AppKeyTagOrganization:      devOrg,
+			edgeproto.CloudletKeyTagName:         "cloudlet1",
+			edgeproto.CloudletKeyTagOrganization: operOrg,
+			cloudcommon.AlertSeverityLabel:       cloudcommon.AlertSeverityError,
+			cloudcommon.AlertScopeTypeTag:        cloudcommon.AlertScopeApp,
+			"job":                                "envoy-targets",
+		},
+		Annotations: map[string]string{
+			cloudcommon.AlertAnnotationTitle: "Application down",
+		},
+		State: "firing",
+		ActiveAt: dme.Timestamp{
+			Seconds: 1257894000,
+		},
+	}
+	apis.alertApi.Update(ctx, &appInstAlert, 0)
+
+	// dev webhook and admin pagerduty receive it, the operator email
+	// receiver filters on the CloudletDown alert only, and the slack
+	// receiver is for another org.
+	notification := waitAlertNotification(t, webhookCh)
+	require.Equal(t, receivers[0].Key.Name, notification.Receiver)
+	require.Equal(t, AlertStatusFiring, notification.Status)
+	require.Equal(t, "alertReceiverInst", notification.Labels[edgeproto.AppInstKeyTagName])
+	_, found := notification.Labels["job"]
+	require.False(t, found, "internal labels are not sent")
+	require.Equal(t, time.Unix(1257894000, 0).UTC(), notification.StartsAt.UTC())
+	require.Nil(t, notification.EndsAt)
+
+	event := waitAlertNotification(t, pagerDutyCh)
+	require.Equal(t, "trigger", event.EventAction)
+	require.Equal(t, receivers[2].PagerDutyIntegrationKey, event.RoutingKey)
+	require.Equal(t, notification.Fingerprint, event.DedupKey)
+	require.Equal(t, "error", event.Payload.Severity)
+	require.Equal(t, "[FIRING] AppInstDown: Application down", event.Payload.Summary)
+	_, found = event.Payload.CustomDetails["job"]
+	require.False(t, found, "internal labels are not sent")
+
+	// updates to the firing alert are not sent again
+	appInstAlert.Value = 2
+	apis.alertApi.Update(ctx, &appInstAlert, 0)
+	expectNoAlertNotification(t, webhookCh)
+	expectNoAlertNotification(t, slackCh)
+	expectNoAlertNotification(t, mailCh)
+
+	// resolve
+	apis.alertApi.Delete(ctx, &appInstAlert, 0)
+	notification = waitAlertNotification(t, webhookCh)
+	require.Equal(t, AlertStatusResolved, notification.Status)
+	require.NotNil(t, notification.EndsAt)
+	event = waitAlertNotification(t, pagerDutyCh)
+	require.Equal(t, "resolve", event.EventAction)
+	require.Equal(t, notification.Fingerprint, event.DedupKey)
+	require.Nil(t, event.Payload)
+
+	// cloudlet alert goes to the operator email receiver, and not
+	// to the dev receivers
+	cloudletAlert := edgeproto.Alert{
+		Labels: map[string]string{
+			"alertname":                          cloudcommon.AlertCloudletDown,
+			edgeproto.CloudletKeyTagName:         "cloudlet1",
+			edgeproto.CloudletKeyTagOrganization: operOrg,
+			cloudcommon.AlertSeverityLabel:       cloudcommon.AlertSeverityWarn,
+			cloudcommon.AlertScopeTypeTag:        cloudcommon.AlertScopeCloudlet,
+		},
+		Annotations: map[string]string{
+			cloudcommon.AlertAnnotationTitle:       cloudcommon.AlertCloudletDownDescription,
+			cloudcommon.AlertAnnotationDescription: "Cloudlet resource manager is offline",
+		},
+		State: "firing",
+		ActiveAt: dme.Timestamp{
+			Seconds: 1257894100,
+		},
+	}
+	apis.alertApi.Update(ctx, &cloudletAlert, 0)
+	mail := waitAlertNotification(t, mailCh)
+	require.Contains(t, mail, "Subject: [FIRING] CloudletDown: Cloudlet resource manager is offline\r\n")
+	require.Contains(t, mail, "From: alerts@example.ut\r\n")
+	require.Contains(t, mail, "cloudletorg: "+operOrg)
+	// warning is below the admin receiver's minimum severity
+	expectNoAlertNotification(t, pagerDutyCh)
+	expectNoAlertNotification(t, webhookCh)
+
+	apis.alertApi.Delete(ctx, &cloudletAlert, 0)
+	mail = waitAlertNotification(t, mailCh)
+	require.Contains(t, mail, "Subject: [RESOLVED] CloudletDown")
+	require.True(t, strings.Contains(mail, "Resolved at: "))
+
+	// slack receiver gets alerts for its own org
+	otherAlert := appInstAlert
+	otherAlert.Labels = map[string]string{
+		"alertname":                         cloudcommon.AlertAppInstDown,
+		edgeproto.AppInstKeyTagName:         "otherInst",
+		edgeproto.AppInstKeyTagOrganization: testutil.DevData()[1],
+		cloudcommon.AlertSeverityLabel:      cloudcommon.AlertSeverityWarn,
+		cloudcommon.AlertScopeTypeTag:       cloudcommon.AlertScopeApp,
+	}
+	apis.alertApi.Update(ctx, &otherAlert, 0)
+	msg := waitAlertNotification(t, slackCh)
+	require.True(t, strings.HasPrefix(msg.Text, "[FIRING] AppInstDown: Application down\n"))
+	require.Contains(t, msg.Text, "appinst: otherInst")
+	apis.alertApi.Delete(ctx, &otherAlert, 0)
+	msg = waitAlertNotification(t, slackCh)
+	require.True(t, strings.HasPrefix(msg.Text, "[RESOLVED] AppInstDown"))
+
+	// internal alerts are not sent
+	for _, name := range []string{
+		cloudcommon.AlertAutoScaleUp,
+		cloudcommon.AlertAutoScaleDown,
+		cloudcommon.AlertClusterAutoScale,
+		cloudcommon.AlertAppInstAutoScale,
+		cloudcommon.AlertAutoUndeploy,
+	} {
+		internalAlert := appInstAlert
+		internalAlert.Labels = map[string]string{
+			"alertname":                         name,
+			edgeproto.AppInstKeyTagName:         "alertReceiverInst",
+			edgeproto.AppInstKeyTagOrganization: devOrg,
+			edgeproto.AppKeyTagOrganization:     devOrg,
+		}
+		apis.alertApi.Update(ctx, &internalAlert, 0)
+		apis.alertApi.Delete(ctx, &internalAlert, 0)
+	}
+	expectNoAlertNotification(t, webhookCh)
+	expectNoAlertNotification(t, pagerDutyCh)
+
+	// A restarted controller reloads the same alert, which was
+	// already notified.
+	apis.alertApi.Update(ctx, &cloudletAlert, 0)
+	expectNoAlertNotification(t, mailCh)
+	apis.alertApi.Delete(ctx, &cloudletAlert, 0)
+	expectNoAlertNotification(t, mailCh)
+
+	testutil.InternalAlertReceiverDeleteAll(t, ctx, apis.alertReceiverApi, receivers)
+}
+
+func TestAlertNotificationSubject(t *testing.T) {
+	notification := AlertNotification{
+		Status: AlertStatusFiring,
+		Labels: map[string]string{
+			"alertname": "HighLoad\r\nBcc: victim@example.com",
+		},
+		Annotations: map[string]string{
+			cloudcommon.AlertAnnotationTitle: "load\nis high\r",
+		},
+	}
+	subject := alertNotificationSubject(&notification)
+	require.Equal(t, "[FIRING] HighLoad Bcc: victim@example.com: load is high ", subject)
+}
+
+func waitAlertNotification[T any](t *testing.T, ch chan T) T {
+	select {
+	case obj := <-ch:
+		return obj
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for alert notification")
+	}
+	var obj T
+	return obj
+}
+
+func expectNoAlertNotification[T any](t *testing.T, ch chan T) {
+	select {
+	case obj := <-ch:
+		require.Fail(t, "unexpected alert notification", "%v", obj)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
@@ -0,0 +1,454 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/smtp"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	opentracing "github.com/opentracing/opentracing-go"
+)
+
+// Alert receivers are sent a notification when an alert fires and
+// when it resolves. Alert cache updates run on every controller
+// replica, and again for all alerts when a controller restarts,
+// so each notification is claimed in redis to ensure it is only
+// delivered once.
+
+var (
+	AlertReceiverTimeout      = 10 * time.Second
+	AlertReceiverRetries      = 3
+	AlertReceiverRetryBackoff = time.Second
+	AlertReceiverClaimTTL     = 7 * 24 * time.Hour
+	PagerDutyEventsURL        = "https://events.pagerduty.com/v2/enqueue"
+)
+
+// SMTP credentials for Email receivers are read from the environment
+const (
+	AlertSmtpUsernameEnv = "ALERT_SMTP_USERNAME"
+	AlertSmtpPasswordEnv = "ALERT_SMTP_PASSWORD"
+)
+
+const (
+	AlertStatusFiring   = "firing"
+	AlertStatusResolved = "resolved"
+)
+
+// sendMail is replaced for unit tests
+var sendMail = smtp.SendMail
+
+// AlertNotification is posted as JSON to Webhook receivers
+type AlertNotification struct {
+	// Name of the receiver
+	Receiver string `json:"receiver"`
+	// Status is either firing or resolved
+	Status string `json:"status"`
+	// Fingerprint uniquely identifies the alert
+	Fingerprint string            `json:"fingerprint"`
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	StartsAt    time.Time         `json:"startsAt"`
+	EndsAt      *time.Time        `json:"endsAt,omitempty"`
+}
+
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+type pagerDutyEvent struct {
+	RoutingKey  string            `json:"routing_key"`
+	EventAction string            `json:"event_action"`
+	DedupKey    string            `json:"dedup_key"`
+	Payload     *pagerDutyPayload `json:"payload,omitempty"`
+}
+
+type pagerDutyPayload struct {
+	Summary       string            `json:"summary"`
+	Source        string            `json:"source"`
+	Severity      string            `json:"severity"`
+	Timestamp     string            `json:"timestamp,omitempty"`
+	CustomDetails map[string]string `json:"custom_details,omitempty"`
+}
+
+// alertFingerprint is a short unique id of the alert, derived
+// from its labels.
+func alertFingerprint(alert *edgeproto.Alert) string {
+	sum := sha256.Sum256([]byte(alert.GetKeyVal().GetKeyString()))
+	return hex.EncodeToString(sum[:8])
+}
+
+func alertSeverity(alert *edgeproto.Alert) string {
+	if severity, ok := alert.Labels[cloudcommon.AlertSeverityLabel]; ok {
+		return severity
+	}
+	return cloudcommon.GetSeverityForAlert(alert.Labels["alertname"])
+}
+
+// alertOrgLabels are the labels that identify the organizations
+// an alert belongs to.
+var alertOrgLabels = []string{
+	edgeproto.AppKeyTagOrganization,
+	edgeproto.AppInstKeyTagOrganization,
+	edgeproto.ClusterKeyTagOrganization,
+	edgeproto.CloudletKeyTagOrganization,
+}
+
+// alertInOrg checks if the alert belongs to the organization.
+// All alerts belong to the edge cloud organization.
+func alertInOrg(org string, alert *edgeproto.Alert) bool {
+	if org == edgeproto.OrganizationEdgeCloud {
+		return true
+	}
+	for _, label := range alertOrgLabels {
+		if val, ok := alert.Labels[label]; ok && val == org {
+			return true
+		}
+	}
+	return false
+}
+
+func alertReceiverMatches(recv *edgeproto.AlertReceiver, alert *edgeproto.Alert) bool {
+	if !alertInOrg(recv.Key.Organization, alert) {
+		return false
+	}
+	if recv.Severity != "" && !cloudcommon.IsAlertSeverityAtLeast(alertSeverity(alert), recv.Severity) {
+		return false
+	}
+	for k, v := range recv.Labels {
+		if val, ok := alert.Labels[k]; !ok || val != v {
+			return false
+		}
+	}
+	return true
+}
+
+// alertFired checks if the alert cache update is for a newly
+// firing alert.
+func alertFired(old *edgeproto.Alert, new *edgeproto.Alert) bool {
+	if new.State != AlertStatusFiring {
+		return false
+	}
+	if old != nil && old.State == AlertStatusFiring && old.ActiveAt.Seconds == new.ActiveAt.Seconds && old.ActiveAt.Nanos == new.ActiveAt.Nanos {
+		// already fired
+		return false
+	}
+	return true
+}
+
+func (s *AlertReceiverApi) alertUpdated(ctx context.Context, old *edgeproto.Alert, new *edgeproto.Alert) {
+	if !alertFired(old, new) {
+		return
+	}
+	s.dispatch(ctx, new, AlertStatusFiring)
+}
+
+func (s *AlertReceiverApi) alertDeleted(ctx context.Context, old *edgeproto.Alert) {
+	if old.State != AlertStatusFiring {
+		return
+	}
+	s.dispatch(ctx, old, AlertStatusResolved)
+}
+
+// redispatchFiring sends firing alerts to receivers again, to notify
+// for alerts that were suppressed when they fired. Alerts that were
+// already sent are not sent again, as notifications are claimed.
+func (s *AlertReceiverApi) redispatchFiring(ctx context.Context) {
+	alerts := []*edgeproto.Alert{}
+	s.all.alertApi.cache.Show(&edgeproto.Alert{}, func(obj *edgeproto.Alert) error {
+		if obj.State == AlertStatusFiring {
+			alert := &edgeproto.Alert{}
+			alert.DeepCopyIn(obj)
+			alerts = append(alerts, alert)
+		}
+		return nil
+	})
+	for _, alert := range alerts {
+		s.dispatch(ctx, alert, AlertStatusFiring)
+	}
+}
+
+func alertReceiverClaimKey(recvKey *edgeproto.AlertReceiverKey, alert *edgeproto.Alert, status string) string {
+	return fmt.Sprintf("alert-receiver/%s/%s/%s/%d/%s", recvKey.Organization, recvKey.Name, alertFingerprint(alert), alert.ActiveAt.Seconds, status)
+}
+
+// dispatch sends the alert notification to all matching receivers
+// in the background.
+func (s *AlertReceiverApi) dispatch(ctx context.Context, in *edgeproto.Alert, status string) {
+	client := redisClient
+	if client == nil {
+		return
+	}
+	if cloudcommon.IsInternalAlert(in.Labels) {
+		// auto-scale, auto-prov, and other alerts used internally
+		// to drive platform actions are not for users
+		return
+	}
+	if status == AlertStatusFiring && s.all.alertSilenceApi.isAlertSuppressed(ctx, in) {
+		log.SpanLog(ctx, log.DebugLevelApi, "alert suppressed, not notifying receivers", "labels", in.Labels)
+		return
+	}
+	receivers := []*edgeproto.AlertReceiver{}
+	s.cache.Mux.Lock()
+	for _, data := range s.cache.Objs {
+		if !alertReceiverMatches(data.Obj, in) {
+			continue
+		}
+		recv := &edgeproto.AlertReceiver{}
+		recv.DeepCopyIn(data.Obj)
+		receivers = append(receivers, recv)
+	}
+	s.cache.Mux.Unlock()
+	if len(receivers) == 0 {
+		return
+	}
+	alert := &edgeproto.Alert{}
+	alert.DeepCopyIn(in)
+	endsAt := time.Now()
+
+	span := log.StartSpan(log.DebugLevelApi, "alert notification", opentracing.ChildOf(log.SpanFromContext(ctx).Context()))
+	span.SetTag("alertname", alert.Labels["alertname"])
+	span.SetTag("status", status)
+	actx := log.ContextWithSpan(context.Background(), span)
+
+	go func() {
+		defer span.Finish()
+		wg := sync.WaitGroup{}
+		for _, recv := range receivers {
+			if status == AlertStatusResolved {
+				// Only send the resolution if the firing alert was
+				// sent, which it is not if the alert was suppressed
+				// or the receiver was created after the alert fired.
+				sent, err := client.Exists(actx, alertReceiverClaimKey(&recv.Key, alert, AlertStatusFiring)).Result()
+				if err != nil {
+					log.SpanLog(actx, log.DebugLevelApi, "failed to check alert notification", "receiver", recv.Key, "err", err)
+					continue
+				}
+				if sent == 0 {
+					continue
+				}
+			}
+			claimed, err := client.SetNX(actx, alertReceiverClaimKey(&recv.Key, alert, status), 1, AlertReceiverClaimTTL).Result()
+			if err != nil {
+				log.SpanLog(actx, log.DebugLevelApi, "failed to claim alert notification", "receiver", recv.Key, "err", err)
+				continue
+			}
+			if !claimed {
+				// already sent, or another replica is sending it
+				continue
+			}
+			notification := AlertNotification{
+				Receiver:    recv.Key.Name,
+				Status:      status,
+				Fingerprint: alertFingerprint(alert),
+				Labels:      externalAlertLabels(alert.Labels),
+				Annotations: alert.Annotations,
+				StartsAt:    dme.TimestampToTime(alert.ActiveAt),
+			}
+			if status == AlertStatusResolved {
+				notification.EndsAt = &endsAt
+			}
+			wg.Add(1)
+			go func(recv *edgeproto.AlertReceiver) {
+				defer wg.Done()
+				s.deliver(actx, recv, &notification)
+			}(recv)
+		}
+		wg.Wait()
+	}()
+}
+
+// deliver sends the notification to the receiver, retrying with
+// exponential backoff.
+func (s *AlertReceiverApi) deliver(ctx context.Context, recv *edgeproto.AlertReceiver, notification *AlertNotification) {
+	backoff := AlertReceiverRetryBackoff
+	attempts := 0
+	for {
+		attempts++
+		err := s.sendWithSecrets(ctx, recv, notification)
+		if err == nil {
+			log.SpanLog(ctx, log.DebugLevelApi, "sent alert notification", "receiver", recv.Key, "type", recv.Type, "status", notification.Status, "attempts", attempts)
+			return
+		}
+		log.SpanLog(ctx, log.DebugLevelApi, "failed to send alert notification", "receiver", recv.Key, "type", recv.Type, "status", notification.Status, "attempt", attempts, "err", err)
+		if attempts > AlertReceiverRetries {
+			return
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// sendWithSecrets fills in the receiver secrets from Vault and sends
+// the notification.
+func (s *AlertReceiverApi) sendWithSecrets(ctx context.Context, recv *edgeproto.AlertReceiver, notification *AlertNotification) error {
+	secrets, err := getAlertReceiverSecrets(ctx, recv)
+	if err != nil {
+		return err
+	}
+	target := edgeproto.AlertReceiver{}
+	target.DeepCopyIn(recv)
+	target.Url = secrets.Url
+	target.PagerDutyIntegrationKey = secrets.PagerDutyIntegrationKey
+	return s.send(ctx, &target, notification)
+}
+
+func (s *AlertReceiverApi) send(ctx context.Context, recv *edgeproto.AlertReceiver, notification *AlertNotification) error {
+	switch recv.Type {
+	case edgeproto.AlertReceiverType_ALERT_RECEIVER_TYPE_WEBHOOK:
+		return s.postJSON(ctx, recv.Url, notification)
+	case edgeproto.AlertReceiverType_ALERT_RECEIVER_TYPE_SLACK:
+		msg := slackMessage{
+			Text: alertNotificationSubject(notification) + "\n" + alertNotificationBody(notification),
+		}
+		return s.postJSON(ctx, recv.Url, &msg)
+	case edgeproto.AlertReceiverType_ALERT_RECEIVER_TYPE_PAGER_DUTY:
+		return s.sendPagerDuty(ctx, recv, notification)
+	case edgeproto.AlertReceiverType_ALERT_RECEIVER_TYPE_EMAIL:
+		return sendAlertEmail(recv.Email, notification)
+	}
+	return fmt.Errorf("unsupported receiver type %s", recv.Type.String())
+}
+
+func (s *AlertReceiverApi) postJSON(ctx context.Context, url string, obj interface{}) error {
+	out, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(out))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("receiver returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (s *AlertReceiverApi) sendPagerDuty(ctx context.Context, recv *edgeproto.AlertReceiver, notification *AlertNotification) error {
+	event := pagerDutyEvent{
+		RoutingKey: recv.PagerDutyIntegrationKey,
+		DedupKey:   notification.Fingerprint,
+	}
+	if notification.Status == AlertStatusResolved {
+		event.EventAction = "resolve"
+	} else {
+		event.EventAction = "trigger"
+		event.Payload = &pagerDutyPayload{
+			Summary:       alertNotificationSubject(notification),
+			Source:        notification.Labels["region"],
+			Severity:      pagerDutySeverity(notification.Labels[cloudcommon.AlertSeverityLabel]),
+			Timestamp:     notification.StartsAt.Format(time.RFC3339),
+			CustomDetails: notification.Labels,
+		}
+	}
+	url := recv.Url
+	if url == "" {
+		url = PagerDutyEventsURL
+	}
+	return s.postJSON(ctx, url, &event)
+}
+
+func pagerDutySeverity(severity string) string {
+	switch severity {
+	case cloudcommon.AlertSeverityError, cloudcommon.AlertSeverityWarn:
+		return severity
+	}
+	return cloudcommon.AlertSeverityInfo
+}
+
+func sendAlertEmail(to string, notification *AlertNotification) error {
+	if *alertSmtpAddr == "" {
+		return fmt.Errorf("no SMTP server configured for alert emails")
+	}
+	host := strings.Split(*alertSmtpAddr, ":")[0]
+	var auth smtp.Auth
+	if user := os.Getenv(AlertSmtpUsernameEnv); user != "" {
+		auth = smtp.PlainAuth("", user, os.Getenv(AlertSmtpPasswordEnv), host)
+	}
+	msg := bytes.Buffer{}
+	fmt.Fprintf(&msg, "From: %s\r\n", *alertSmtpFrom)
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", alertNotificationSubject(notification))
+	fmt.Fprintf(&msg, "Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	msg.WriteString(strings.ReplaceAll(alertNotificationBody(notification), "\n", "\r\n"))
+	return sendMail(*alertSmtpAddr, auth, *alertSmtpFrom, []string{to}, msg.Bytes())
+}
+
+// externalAlertLabels removes internal labels that are not
+// sent to receivers.
+func externalAlertLabels(labels map[string]string) map[string]string {
+	out := make(map[string]string)
+	for k, v := range labels {
+		if cloudcommon.IsLabelInternal(k) {
+			continue
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// alertNotificationSubject is used as the email Subject header,
+// so line breaks from user defined alert names and annotations
+// are removed to prevent header injection.
+func alertNotificationSubject(notification *AlertNotification) string {
+	subject := fmt.Sprintf("[%s] %s", strings.ToUpper(notification.Status), notification.Labels["alertname"])
+	if title, ok := notification.Annotations[cloudcommon.AlertAnnotationTitle]; ok && title != "" {
+		subject += ": " + title
+	}
+	return headerReplacer.Replace(subject)
+}
+
+var headerReplacer = strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ")
+
+func alertNotificationBody(notification *AlertNotification) string {
+	lines := []string{}
+	if desc, ok := notification.Annotations[cloudcommon.AlertAnnotationDescription]; ok && desc != "" {
+		lines = append(lines, desc)
+	}
+	lines = append(lines, "Started at: "+notification.StartsAt.Format(time.RFC3339))
+	if notification.EndsAt != nil {
+		lines = append(lines, "Resolved at: "+notification.EndsAt.Format(time.RFC3339))
+	}
+	keys := []string{}
+	for k := range notification.Labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		lines = append(lines, k+": "+notification.Labels[k])
+	}
+	return strings.Join(lines, "\n")
+}
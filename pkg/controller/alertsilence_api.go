@@ -0,0 +1,291 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/regiondata"
+	"github.com/opentracing/opentracing-go"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+// Alerts that are suppressed when they fire are not sent to alert
+// receivers. Firing alerts are dispatched again when a silence ends
+// or a cloudlet returns to normal operation, so that receivers are
+// notified of alerts that are still firing.
+
+var alertSilenceCheckInterval = time.Minute
+
+// Should only be one of these instantiated in main
+type AlertSilenceApi struct {
+	all      *AllApis
+	sync     *regiondata.Sync
+	store    edgeproto.AlertSilenceStore
+	cache    edgeproto.AlertSilenceCache
+	mux      sync.Mutex
+	matchers map[edgeproto.AlertSilenceKey]*compiledAlertSilence
+}
+
+// compiledAlertSilence caches the compiled matchers for a revision
+// of a silence.
+type compiledAlertSilence struct {
+	modRev   int64
+	matchers *edgeproto.AlertMatchers
+}
+
+func NewAlertSilenceApi(sync *regiondata.Sync, all *AllApis) *AlertSilenceApi {
+	alertSilenceApi := AlertSilenceApi{}
+	alertSilenceApi.all = all
+	alertSilenceApi.sync = sync
+	alertSilenceApi.matchers = make(map[edgeproto.AlertSilenceKey]*compiledAlertSilence)
+	alertSilenceApi.store = edgeproto.NewAlertSilenceStore(sync.GetKVStore())
+	edgeproto.InitAlertSilenceCache(&alertSilenceApi.cache)
+	sync.RegisterCache(&alertSilenceApi.cache)
+	alertSilenceApi.cache.AddUpdatedCb(alertSilenceApi.silenceUpdated)
+	alertSilenceApi.cache.AddDeletedCb(alertSilenceApi.silenceDeleted)
+	all.cloudletApi.cache.AddUpdatedCb(alertSilenceApi.cloudletUpdated)
+	return &alertSilenceApi
+}
+
+func (s *AlertSilenceApi) CreateAlertSilence(ctx context.Context, in *edgeproto.AlertSilence) (*edgeproto.Result, error) {
+	log.SpanLog(ctx, log.DebugLevelApi, "CreateAlertSilence", "silence", in.Key.String())
+	if in.StartTime.Seconds == 0 && in.StartTime.Nanos == 0 {
+		in.StartTime = dme.TimeToTimestamp(time.Now())
+	}
+	if err := in.Validate(edgeproto.AlertSilenceAllFieldsMap); err != nil {
+		return &edgeproto.Result{}, err
+	}
+	err := s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+		if s.store.STMGet(stm, &in.Key, nil) {
+			return in.Key.ExistsError()
+		}
+		s.store.STMPut(stm, in)
+		return nil
+	})
+	return &edgeproto.Result{}, err
+}
+
+func (s *AlertSilenceApi) UpdateAlertSilence(ctx context.Context, in *edgeproto.AlertSilence) (*edgeproto.Result, error) {
+	err := s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+		cur := edgeproto.AlertSilence{}
+		if !s.store.STMGet(stm, &in.Key, &cur) {
+			return in.Key.NotFoundError()
+		}
+		changed := cur.CopyInFields(in)
+		if changed == 0 {
+			return nil
+		}
+		if err := cur.Validate(nil); err != nil {
+			return err
+		}
+		s.store.STMPut(stm, &cur)
+		return nil
+	})
+	return &edgeproto.Result{}, err
+}
+
+func (s *AlertSilenceApi) DeleteAlertSilence(ctx context.Context, in *edgeproto.AlertSilence) (*edgeproto.Result, error) {
+	if !s.cache.HasKey(&in.Key) {
+		return &edgeproto.Result{}, in.Key.NotFoundError()
+	}
+	return s.store.Delete(ctx, in, s.sync.SyncWait)
+}
+
+func (s *AlertSilenceApi) ShowAlertSilence(in *edgeproto.AlertSilence, cb edgeproto.AlertSilenceApi_ShowAlertSilenceServer) error {
+	err := s.cache.Show(in, func(obj *edgeproto.AlertSilence) error {
+		err := cb.Send(obj)
+		return err
+	})
+	return err
+}
+
+// isAlertSuppressed checks if the alert should not be published,
+// either because it is silenced, or because it is an AppInst alert
+// for a cloudlet that is under maintenance.
+func (s *AlertSilenceApi) isAlertSuppressed(ctx context.Context, alert *edgeproto.Alert) bool {
+	if silence, found := s.getActiveSilence(alert, time.Now()); found {
+		log.SpanLog(ctx, log.DebugLevelApi, "alert silenced", "labels", alert.Labels, "silence", silence)
+		return true
+	}
+	if cloudletKey, found := s.getMaintenanceCloudlet(alert); found {
+		log.SpanLog(ctx, log.DebugLevelApi, "alert suppressed for cloudlet maintenance", "labels", alert.Labels, "cloudlet", cloudletKey)
+		return true
+	}
+	return false
+}
+
+func (s *AlertSilenceApi) getActiveSilence(alert *edgeproto.Alert, now time.Time) (edgeproto.AlertSilenceKey, bool) {
+	s.cache.Mux.Lock()
+	defer s.cache.Mux.Unlock()
+	for key, data := range s.cache.Objs {
+		silence := data.Obj
+		if !silence.IsActive(now) {
+			continue
+		}
+		if silence.Key.Organization != edgeproto.OrganizationEdgeCloud && silence.Key.Organization != alertOwnerOrg(alert) {
+			continue
+		}
+		matchers := s.getMatchers(silence, data.ModRev)
+		if matchers != nil && matchers.Matches(alert.Labels) {
+			return key, true
+		}
+	}
+	return edgeproto.AlertSilenceKey{}, false
+}
+
+// getMatchers gets the compiled matchers for the silence, compiling
+// them only if the silence has changed.
+func (s *AlertSilenceApi) getMatchers(silence *edgeproto.AlertSilence, modRev int64) *edgeproto.AlertMatchers {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if compiled, found := s.matchers[silence.Key]; found && compiled.modRev == modRev {
+		return compiled.matchers
+	}
+	// silences are validated so compile should not fail, but if it
+	// does the nil matchers do not match anything
+	matchers, _ := silence.CompileMatchers()
+	s.matchers[silence.Key] = &compiledAlertSilence{
+		modRev:   modRev,
+		matchers: matchers,
+	}
+	return matchers
+}
+
+// alertOwnerOrg gets the organization that owns the alert, which
+// is the developer for application alerts and the operator for
+// cloudlet alerts. Platform alerts are only owned by the edge cloud
+// organization.
+func alertOwnerOrg(alert *edgeproto.Alert) string {
+	appOrg := alert.Labels[edgeproto.AppKeyTagOrganization]
+	if appOrg == "" {
+		appOrg = alert.Labels[edgeproto.AppInstKeyTagOrganization]
+	}
+	switch alert.Labels[cloudcommon.AlertScopeTypeTag] {
+	case cloudcommon.AlertScopeApp:
+		return appOrg
+	case cloudcommon.AlertScopeCloudlet:
+		return alert.Labels[edgeproto.CloudletKeyTagOrganization]
+	case cloudcommon.AlertScopePlatform:
+		return ""
+	}
+	if appOrg != "" {
+		return appOrg
+	}
+	if org := alert.Labels[edgeproto.ClusterKeyTagOrganization]; org != "" {
+		return org
+	}
+	return alert.Labels[edgeproto.CloudletKeyTagOrganization]
+}
+
+func (s *AlertSilenceApi) silenceUpdated(ctx context.Context, old *edgeproto.AlertSilence, new *edgeproto.AlertSilence) {
+	// an update may end the silence or change what it matches
+	if old != nil && old.IsActive(time.Now()) {
+		s.all.alertReceiverApi.redispatchFiring(ctx)
+	}
+}
+
+func (s *AlertSilenceApi) silenceDeleted(ctx context.Context, old *edgeproto.AlertSilence) {
+	s.mux.Lock()
+	delete(s.matchers, old.Key)
+	s.mux.Unlock()
+	if old.IsActive(time.Now()) {
+		s.all.alertReceiverApi.redispatchFiring(ctx)
+	}
+}
+
+func (s *AlertSilenceApi) cloudletUpdated(ctx context.Context, old *edgeproto.Cloudlet, new *edgeproto.Cloudlet) {
+	if old != nil && old.MaintenanceState != dme.MaintenanceState_NORMAL_OPERATION &&
+		new.MaintenanceState == dme.MaintenanceState_NORMAL_OPERATION {
+		s.all.alertReceiverApi.redispatchFiring(ctx)
+	}
+}
+
+// hasExpired checks if any silence ended after the start time and
+// at or before the end time.
+func (s *AlertSilenceApi) hasExpired(start, end time.Time) bool {
+	s.cache.Mux.Lock()
+	defer s.cache.Mux.Unlock()
+	for _, data := range s.cache.Objs {
+		endTime := dme.TimestampToTime(data.Obj.EndTime)
+		if endTime.After(start) && !endTime.After(end) {
+			return true
+		}
+	}
+	return false
+}
+
+type AlertSilenceExpiryTaskable struct {
+	all       *AllApis
+	lastCheck time.Time
+}
+
+// NewAlertSilenceExpiryTaskable returns a PeriodicTaskable for
+// dispatching firing alerts when silences end
+func NewAlertSilenceExpiryTaskable(all *AllApis) *AlertSilenceExpiryTaskable {
+	return &AlertSilenceExpiryTaskable{
+		all:       all,
+		lastCheck: time.Now(),
+	}
+}
+
+func (s *AlertSilenceExpiryTaskable) GetInterval() time.Duration {
+	return alertSilenceCheckInterval
+}
+
+func (s *AlertSilenceExpiryTaskable) StartSpan() opentracing.Span {
+	return log.StartSpan(log.DebugLevelApi, "alert silence expiry check")
+}
+
+func (s *AlertSilenceExpiryTaskable) Run(ctx context.Context) {
+	now := time.Now()
+	if s.all.alertSilenceApi.hasExpired(s.lastCheck, now) {
+		log.SpanLog(ctx, log.DebugLevelApi, "alert silences expired, dispatching firing alerts")
+		s.all.alertReceiverApi.redispatchFiring(ctx)
+	}
+	s.lastCheck = now
+}
+
+// getMaintenanceCloudlet gets the cloudlet of an AppInst alert if the
+// cloudlet is not in normal operation.
+func (s *AlertSilenceApi) getMaintenanceCloudlet(alert *edgeproto.Alert) (edgeproto.CloudletKey, bool) {
+	appInstKey := edgeproto.AppInstKey{
+		Name:         alert.Labels[edgeproto.AppInstKeyTagName],
+		Organization: alert.Labels[edgeproto.AppInstKeyTagOrganization],
+	}
+	if appInstKey.Name == "" || appInstKey.Organization == "" {
+		return edgeproto.CloudletKey{}, false
+	}
+	var cloudletKey edgeproto.CloudletKey
+	appInst := edgeproto.AppInst{}
+	if s.all.appInstApi.cache.Get(&appInstKey, &appInst) {
+		cloudletKey = appInst.CloudletKey
+	} else {
+		cloudletKey.Name = alert.Labels[edgeproto.CloudletKeyTagName]
+		cloudletKey.Organization = alert.Labels[edgeproto.CloudletKeyTagOrganization]
+		cloudletKey.FederatedOrganization = alert.Labels[edgeproto.CloudletKeyTagFederatedOrganization]
+	}
+	cloudlet := edgeproto.Cloudlet{}
+	if !s.all.cloudletApi.cache.Get(&cloudletKey, &cloudlet) {
+		return cloudletKey, false
+	}
+	return cloudletKey, cloudlet.MaintenanceState != dme.MaintenanceState_NORMAL_OPERATION
+}
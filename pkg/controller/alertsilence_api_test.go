@@ -0,0 +1,270 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/regiondata"
+	"github.com/edgexr/edge-cloud-platform/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertSilenceApi(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelEtcd | log.DebugLevelApi)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+	testSvcs := testinit(ctx, t)
+	defer testfinish(testSvcs)
+
+	dummy := regiondata.InMemoryStore{}
+	dummy.Start()
+	defer dummy.Stop()
+
+	sync := regiondata.InitSync(&dummy)
+	apis := NewAllApis(sync)
+	sync.Start()
+	defer sync.Done()
+
+	// invalid silences
+	silence := testutil.AlertSilenceData()[0]
+	silence.Matchers = nil
+	_, err := apis.alertSilenceApi.CreateAlertSilence(ctx, &silence)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "At least one matcher must be specified")
+
+	silence = testutil.AlertSilenceData()[0]
+	silence.Matchers[0].Name = ""
+	_, err = apis.alertSilenceApi.CreateAlertSilence(ctx, &silence)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Matcher label name must be specified")
+
+	silence = testutil.AlertSilenceData()[0]
+	silence.Matchers[1].Value = "upgrade-("
+	_, err = apis.alertSilenceApi.CreateAlertSilence(ctx, &silence)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Invalid matcher regex")
+
+	silence = testutil.AlertSilenceData()[0]
+	silence.EndTime = dme.Timestamp{}
+	_, err = apis.alertSilenceApi.CreateAlertSilence(ctx, &silence)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Silence end time must be specified")
+
+	silence = testutil.AlertSilenceData()[0]
+	silence.EndTime = silence.StartTime
+	_, err = apis.alertSilenceApi.CreateAlertSilence(ctx, &silence)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Silence end time must be after the start time")
+
+	testutil.InternalAlertSilenceTest(t, "cud", apis.alertSilenceApi, testutil.AlertSilenceData())
+
+	// update cannot move the end before the start
+	silence = testutil.AlertSilenceData()[0]
+	silence.EndTime = dme.Timestamp{Seconds: silence.StartTime.Seconds - 60}
+	silence.Fields = []string{edgeproto.AlertSilenceFieldEndTimeSeconds}
+	_, err = apis.alertSilenceApi.UpdateAlertSilence(ctx, &silence)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Silence end time must be after the start time")
+
+	testutil.InternalAlertSilenceDeleteAll(t, ctx, apis.alertSilenceApi, testutil.AlertSilenceData())
+
+	// start time defaults to now
+	now := time.Now()
+	silence = testutil.AlertSilenceData()[0]
+	silence.StartTime = dme.Timestamp{}
+	silence.EndTime = dme.TimeToTimestamp(now.Add(time.Hour))
+	_, err = apis.alertSilenceApi.CreateAlertSilence(ctx, &silence)
+	require.Nil(t, err)
+	check := edgeproto.AlertSilence{}
+	require.True(t, apis.alertSilenceApi.cache.Get(&silence.Key, &check))
+	require.False(t, dme.TimestampToTime(check.StartTime).Before(now.Truncate(time.Second)))
+	require.True(t, check.IsActive(time.Now()))
+
+	// compiled matchers are reused until the silence changes
+	apis.alertSilenceApi.cache.Mux.Lock()
+	data := apis.alertSilenceApi.cache.Objs[silence.Key]
+	apis.alertSilenceApi.cache.Mux.Unlock()
+	matchers := apis.alertSilenceApi.getMatchers(data.Obj, data.ModRev)
+	require.NotNil(t, matchers)
+	require.True(t, matchers == apis.alertSilenceApi.getMatchers(data.Obj, data.ModRev))
+	require.False(t, matchers == apis.alertSilenceApi.getMatchers(data.Obj, data.ModRev+1))
+
+	devOrg := testutil.DevData()[0]
+	newAlert := func(name, appInst, org string) *edgeproto.Alert {
+		return &edgeproto.Alert{
+			Labels: map[string]string{
+				"alertname":                         name,
+				edgeproto.AppInstKeyTagName:         appInst,
+				edgeproto.AppInstKeyTagOrganization: org,
+				edgeproto.AppKeyTagOrganization:     org,
+				cloudcommon.AlertSeverityLabel:      cloudcommon.AlertSeverityError,
+				cloudcommon.AlertScopeTypeTag:       cloudcommon.AlertScopeApp,
+			},
+			State:    "firing",
+			ActiveAt: dme.TimeToTimestamp(now),
+		}
+	}
+	upgradeAlert := newAlert(cloudcommon.AlertAppInstDown, "upgrade-1", devOrg)
+	otherInstAlert := newAlert(cloudcommon.AlertAppInstDown, "other", devOrg)
+	otherNameAlert := newAlert(cloudcommon.AlertClusterSvcAppInstFailure, "upgrade-1", devOrg)
+	otherOrgAlert := newAlert(cloudcommon.AlertAppInstDown, "upgrade-1", testutil.DevData()[1])
+	require.True(t, apis.alertSilenceApi.isAlertSuppressed(ctx, upgradeAlert))
+	require.False(t, apis.alertSilenceApi.isAlertSuppressed(ctx, otherInstAlert))
+	require.False(t, apis.alertSilenceApi.isAlertSuppressed(ctx, otherNameAlert))
+	require.False(t, apis.alertSilenceApi.isAlertSuppressed(ctx, otherOrgAlert))
+	// silences only apply to alerts owned by the org, so a developer
+	// cannot silence cloudlet alerts for their AppInsts
+	cloudletScopeAlert := newAlert(cloudcommon.AlertAppInstDown, "upgrade-1", devOrg)
+	cloudletScopeAlert.Labels[cloudcommon.AlertScopeTypeTag] = cloudcommon.AlertScopeCloudlet
+	cloudletScopeAlert.Labels[edgeproto.CloudletKeyTagOrganization] = testutil.OperatorData()[0]
+	require.False(t, apis.alertSilenceApi.isAlertSuppressed(ctx, cloudletScopeAlert))
+
+	// edge cloud silences apply to all orgs
+	adminSilence := edgeproto.AlertSilence{
+		Key: edgeproto.AlertSilenceKey{
+			Name:         "admin-silence",
+			Organization: edgeproto.OrganizationEdgeCloud,
+		},
+		Matchers: []edgeproto.AlertMatcher{{
+			Name:  edgeproto.AppInstKeyTagName,
+			Value: "upgrade-1",
+		}},
+		EndTime: dme.TimeToTimestamp(now.Add(time.Hour)),
+	}
+	_, err = apis.alertSilenceApi.CreateAlertSilence(ctx, &adminSilence)
+	require.Nil(t, err)
+	require.True(t, apis.alertSilenceApi.isAlertSuppressed(ctx, otherOrgAlert))
+	require.True(t, apis.alertSilenceApi.isAlertSuppressed(ctx, otherNameAlert))
+	_, err = apis.alertSilenceApi.DeleteAlertSilence(ctx, &adminSilence)
+	require.Nil(t, err)
+
+	// silences are not active outside of their time window
+	_, found := apis.alertSilenceApi.getActiveSilence(upgradeAlert, now.Add(2*time.Hour))
+	require.False(t, found)
+	_, found = apis.alertSilenceApi.getActiveSilence(upgradeAlert, now.Add(-time.Minute))
+	require.False(t, found)
+	require.False(t, apis.alertSilenceApi.hasExpired(now, now.Add(time.Minute)))
+	require.True(t, apis.alertSilenceApi.hasExpired(now, now.Add(2*time.Hour)))
+	require.False(t, apis.alertSilenceApi.hasExpired(now.Add(2*time.Hour), now.Add(3*time.Hour)))
+
+	// AppInst alerts are suppressed while the cloudlet is under maintenance
+	cloudlet := testutil.CloudletData()[0]
+	maintAlert := newAlert(cloudcommon.AlertAppInstDown, "maint-inst", devOrg)
+	maintAlert.Labels[edgeproto.CloudletKeyTagName] = cloudlet.Key.Name
+	maintAlert.Labels[edgeproto.CloudletKeyTagOrganization] = cloudlet.Key.Organization
+	apis.cloudletApi.cache.Update(ctx, &cloudlet, 0)
+	require.False(t, apis.alertSilenceApi.isAlertSuppressed(ctx, maintAlert))
+	cloudlet.MaintenanceState = dme.MaintenanceState_UNDER_MAINTENANCE
+	apis.cloudletApi.cache.Update(ctx, &cloudlet, 0)
+	require.True(t, apis.alertSilenceApi.isAlertSuppressed(ctx, maintAlert))
+	// cloudlet alerts are not suppressed
+	cloudletAlert := &edgeproto.Alert{
+		Labels: map[string]string{
+			"alertname":                          cloudcommon.AlertCloudletDown,
+			edgeproto.CloudletKeyTagName:         cloudlet.Key.Name,
+			edgeproto.CloudletKeyTagOrganization: cloudlet.Key.Organization,
+		},
+		State: "firing",
+	}
+	require.False(t, apis.alertSilenceApi.isAlertSuppressed(ctx, cloudletAlert))
+
+	// suppressed alerts are not shown
+	for _, alert := range []*edgeproto.Alert{upgradeAlert, otherInstAlert, maintAlert} {
+		apis.alertApi.Update(ctx, alert, 0)
+	}
+	show := testutil.ShowAlert{}
+	show.Init()
+	show.Ctx = ctx
+	err = apis.alertApi.ShowAlert(&edgeproto.Alert{}, &show)
+	require.Nil(t, err)
+	show.AssertNotFound(t, upgradeAlert)
+	show.AssertNotFound(t, maintAlert)
+	require.True(t, show.CheckFound(otherInstAlert))
+
+	// suppressed alerts are not sent to receivers
+	AlertReceiverRetryBackoff = 10 * time.Millisecond
+	defer func() {
+		AlertReceiverRetryBackoff = time.Second
+	}()
+	webhookCh := make(chan AlertNotification, 10)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		notification := AlertNotification{}
+		err := json.NewDecoder(r.Body).Decode(&notification)
+		require.Nil(t, err)
+		webhookCh <- notification
+	}))
+	defer webhook.Close()
+	defer allowLocalAlertReceivers(apis)()
+	recv := testutil.AlertReceiverData()[0]
+	recv.Url = webhook.URL
+	_, err = apis.alertReceiverApi.CreateAlertReceiver(ctx, &recv)
+	require.Nil(t, err)
+
+	silencedAlert := newAlert(cloudcommon.AlertAppInstDown, "upgrade-2", devOrg)
+	apis.alertApi.Update(ctx, silencedAlert, 0)
+	expectNoAlertNotification(t, webhookCh)
+	apis.alertApi.Delete(ctx, silencedAlert, 0)
+	// resolution is not sent as the firing alert was not sent
+	expectNoAlertNotification(t, webhookCh)
+
+	notSilencedAlert := newAlert(cloudcommon.AlertAppInstDown, "not-silenced", devOrg)
+	apis.alertApi.Update(ctx, notSilencedAlert, 0)
+	notification := waitAlertNotification(t, webhookCh)
+	require.Equal(t, AlertStatusFiring, notification.Status)
+	require.Equal(t, "not-silenced", notification.Labels[edgeproto.AppInstKeyTagName])
+	apis.alertApi.Delete(ctx, notSilencedAlert, 0)
+	notification = waitAlertNotification(t, webhookCh)
+	require.Equal(t, AlertStatusResolved, notification.Status)
+
+	// firing alerts are sent once they are no longer suppressed
+	for _, alert := range []*edgeproto.Alert{upgradeAlert, otherInstAlert, maintAlert} {
+		apis.alertApi.Delete(ctx, alert, 0)
+	}
+	expectNoAlertNotification(t, webhookCh)
+	apis.alertApi.Update(ctx, silencedAlert, 0)
+	apis.alertApi.Update(ctx, maintAlert, 0)
+	expectNoAlertNotification(t, webhookCh)
+	cloudlet.MaintenanceState = dme.MaintenanceState_NORMAL_OPERATION
+	apis.cloudletApi.cache.Update(ctx, &cloudlet, 0)
+	notification = waitAlertNotification(t, webhookCh)
+	require.Equal(t, AlertStatusFiring, notification.Status)
+	require.Equal(t, "maint-inst", notification.Labels[edgeproto.AppInstKeyTagName])
+	expectNoAlertNotification(t, webhookCh)
+	_, err = apis.alertSilenceApi.DeleteAlertSilence(ctx, &silence)
+	require.Nil(t, err)
+	notification = waitAlertNotification(t, webhookCh)
+	require.Equal(t, AlertStatusFiring, notification.Status)
+	require.Equal(t, "upgrade-2", notification.Labels[edgeproto.AppInstKeyTagName])
+	expectNoAlertNotification(t, webhookCh)
+	for _, alert := range []*edgeproto.Alert{silencedAlert, maintAlert} {
+		apis.alertApi.Delete(ctx, alert, 0)
+		notification = waitAlertNotification(t, webhookCh)
+		require.Equal(t, AlertStatusResolved, notification.Status)
+	}
+
+	_, err = apis.alertReceiverApi.DeleteAlertReceiver(ctx, &recv)
+	require.Nil(t, err)
+}
@@ -85,6 +85,10 @@ func (s *testSupportData) put(t *testing.T, ctx context.Context, all *AllApis) {
 		_, err := all.clusterInstApi.store.Put(ctx, &obj, all.clusterInstApi.sync.SyncWait)
 		require.Nil(t, err)
 	}
+	for _, obj := range s.GeoFencePolicies {
+		_, err := all.geoFencePolicyApi.store.Put(ctx, &obj, all.geoFencePolicyApi.sync.SyncWait)
+		require.Nil(t, err)
+	}
 	for _, obj := range s.Apps {
 		_, err := all.appApi.store.Put(ctx, &obj, all.appApi.sync.SyncWait)
 		require.Nil(t, err)
@@ -160,6 +164,10 @@ func (s *testSupportData) delete(t *testing.T, ctx context.Context, all *AllApis
 		_, err := all.appApi.store.Delete(ctx, &obj, all.appApi.sync.SyncWait)
 		require.Nil(t, err)
 	}
+	for _, obj := range s.GeoFencePolicies {
+		_, err := all.geoFencePolicyApi.store.Delete(ctx, &obj, all.geoFencePolicyApi.sync.SyncWait)
+		require.Nil(t, err)
+	}
 	for _, obj := range s.ClusterInsts {
 		_, err := all.clusterInstApi.store.Delete(ctx, &obj, all.clusterInstApi.sync.SyncWait)
 		require.Nil(t, err)
@@ -324,6 +332,13 @@ func (s *testSupportData) getOneClusterInst() *edgeproto.ClusterInst {
 	return &s.ClusterInsts[0]
 }
 
+func (s *testSupportData) getOneGeoFencePolicy() *edgeproto.GeoFencePolicy {
+	if len(s.GeoFencePolicies) == 0 {
+		return nil
+	}
+	return &s.GeoFencePolicies[0]
+}
+
 func (s *testSupportData) getOneApp() *edgeproto.App {
 	if len(s.Apps) == 0 {
 		return nil
@@ -297,6 +297,23 @@ func CreateAppAddRefsChecks(t *testing.T, ctx context.Context, all *AllApis, dat
 		_, err = all.alertPolicyApi.store.Put(ctx, ref, all.alertPolicyApi.sync.SyncWait)
 		require.Nil(t, err)
 	}
+	{
+		// set delete_prepare on referenced GeoFencePolicy
+		ref := supportData.getOneGeoFencePolicy()
+		require.NotNil(t, ref, "support data must include one referenced GeoFencePolicy")
+		ref.DeletePrepare = true
+		_, err = all.geoFencePolicyApi.store.Put(ctx, ref, all.geoFencePolicyApi.sync.SyncWait)
+		require.Nil(t, err)
+		// api call must fail with object being deleted
+		testObj, _ = dataGen.GetCreateAppTestObj()
+		_, err = all.appApi.CreateApp(ctx, testObj)
+		require.NotNil(t, err, "CreateApp must fail with GeoFencePolicy.DeletePrepare set")
+		require.Equal(t, ref.GetKey().BeingDeletedError().Error(), err.Error())
+		// reset delete_prepare on referenced GeoFencePolicy
+		ref.DeletePrepare = false
+		_, err = all.geoFencePolicyApi.store.Put(ctx, ref, all.geoFencePolicyApi.sync.SyncWait)
+		require.Nil(t, err)
+	}
 
 	// wrap the stores so we can make sure all checks and changes
 	// happen in the same STM.
@@ -308,6 +325,8 @@ func CreateAppAddRefsChecks(t *testing.T, ctx context.Context, all *AllApis, dat
 	defer autoProvPolicyApiUnwrap()
 	alertPolicyApiStore, alertPolicyApiUnwrap := wrapAlertPolicyTrackerStore(all.alertPolicyApi)
 	defer alertPolicyApiUnwrap()
+	geoFencePolicyApiStore, geoFencePolicyApiUnwrap := wrapGeoFencePolicyTrackerStore(all.geoFencePolicyApi)
+	defer geoFencePolicyApiUnwrap()
 
 	// CreateApp should succeed if no references are in delete_prepare
 	testObj, _ = dataGen.GetCreateAppTestObj()
@@ -321,6 +340,8 @@ func CreateAppAddRefsChecks(t *testing.T, ctx context.Context, all *AllApis, dat
 	require.Equal(t, appApiStore.putSTM, autoProvPolicyApiStore.getSTM, "CreateApp check AutoProvPolicy ref must be done in same STM as App put")
 	require.NotNil(t, alertPolicyApiStore.getSTM, "CreateApp check AlertPolicy ref must be done in STM")
 	require.Equal(t, appApiStore.putSTM, alertPolicyApiStore.getSTM, "CreateApp check AlertPolicy ref must be done in same STM as App put")
+	require.NotNil(t, geoFencePolicyApiStore.getSTM, "CreateApp check GeoFencePolicy ref must be done in STM")
+	require.Equal(t, appApiStore.putSTM, geoFencePolicyApiStore.getSTM, "CreateApp check GeoFencePolicy ref must be done in same STM as App put")
 
 	// clean up
 	// delete created test obj
@@ -386,6 +407,23 @@ func UpdateAppAddRefsChecks(t *testing.T, ctx context.Context, all *AllApis, dat
 		_, err = all.alertPolicyApi.store.Put(ctx, ref, all.alertPolicyApi.sync.SyncWait)
 		require.Nil(t, err)
 	}
+	{
+		// set delete_prepare on referenced GeoFencePolicy
+		ref := supportData.getOneGeoFencePolicy()
+		require.NotNil(t, ref, "support data must include one referenced GeoFencePolicy")
+		ref.DeletePrepare = true
+		_, err = all.geoFencePolicyApi.store.Put(ctx, ref, all.geoFencePolicyApi.sync.SyncWait)
+		require.Nil(t, err)
+		// api call must fail with object being deleted
+		testObj, _ = dataGen.GetUpdateAppTestObj()
+		_, err = all.appApi.UpdateApp(ctx, testObj)
+		require.NotNil(t, err, "UpdateApp must fail with GeoFencePolicy.DeletePrepare set")
+		require.Equal(t, ref.GetKey().BeingDeletedError().Error(), err.Error())
+		// reset delete_prepare on referenced GeoFencePolicy
+		ref.DeletePrepare = false
+		_, err = all.geoFencePolicyApi.store.Put(ctx, ref, all.geoFencePolicyApi.sync.SyncWait)
+		require.Nil(t, err)
+	}
 
 	// wrap the stores so we can make sure all checks and changes
 	// happen in the same STM.
@@ -397,6 +435,8 @@ func UpdateAppAddRefsChecks(t *testing.T, ctx context.Context, all *AllApis, dat
 	defer autoProvPolicyApiUnwrap()
 	alertPolicyApiStore, alertPolicyApiUnwrap := wrapAlertPolicyTrackerStore(all.alertPolicyApi)
 	defer alertPolicyApiUnwrap()
+	geoFencePolicyApiStore, geoFencePolicyApiUnwrap := wrapGeoFencePolicyTrackerStore(all.geoFencePolicyApi)
+	defer geoFencePolicyApiUnwrap()
 
 	// UpdateApp should succeed if no references are in delete_prepare
 	testObj, _ = dataGen.GetUpdateAppTestObj()
@@ -410,6 +450,8 @@ func UpdateAppAddRefsChecks(t *testing.T, ctx context.Context, all *AllApis, dat
 	require.Equal(t, appApiStore.putSTM, autoProvPolicyApiStore.getSTM, "UpdateApp check AutoProvPolicy ref must be done in same STM as App put")
 	require.NotNil(t, alertPolicyApiStore.getSTM, "UpdateApp check AlertPolicy ref must be done in STM")
 	require.Equal(t, appApiStore.putSTM, alertPolicyApiStore.getSTM, "UpdateApp check AlertPolicy ref must be done in same STM as App put")
+	require.NotNil(t, geoFencePolicyApiStore.getSTM, "UpdateApp check GeoFencePolicy ref must be done in STM")
+	require.Equal(t, appApiStore.putSTM, geoFencePolicyApiStore.getSTM, "UpdateApp check GeoFencePolicy ref must be done in same STM as App put")
 
 	// clean up
 	supportData.delete(t, ctx, all)
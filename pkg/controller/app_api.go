@@ -463,6 +463,17 @@ func (s *AppApi) configureApp(ctx context.Context, stm concurrency.STM, in *edge
 	if in.VmAppOsType != edgeproto.VmAppOsType_VM_APP_OS_UNKNOWN && in.Deployment != cloudcommon.DeploymentTypeVM {
 		return fmt.Errorf("VM App OS Type is only supported for VM deployments")
 	}
+	if in.ReplicaAutoScalePolicy != nil {
+		if in.Deployment != cloudcommon.DeploymentTypeKubernetes {
+			return fmt.Errorf("replica auto scale policy is only supported for Kubernetes deployments")
+		}
+		if in.ScaleWithCluster {
+			return fmt.Errorf("replica auto scale policy is not supported with scale with cluster")
+		}
+		if err := in.ReplicaAutoScalePolicy.Validate(); err != nil {
+			return fmt.Errorf("invalid replica auto scale policy, %s", err)
+		}
+	}
 
 	if !cloudcommon.IsPlatformApp(in.Key.Organization, in.Key.Name) {
 		if in.ImageType == edgeproto.ImageType_IMAGE_TYPE_DOCKER && in.ImagePath != "" {
@@ -608,6 +619,9 @@ func (s *AppApi) configureApp(ctx context.Context, stm concurrency.STM, in *edge
 	if err := s.validateAlertPolicies(stm, in); err != nil {
 		return err
 	}
+	if err := s.validateGeoFencePolicy(stm, in); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -768,7 +782,14 @@ func (s *AppApi) UpdateApp(ctx context.Context, in *edgeproto.App) (*edgeproto.R
 			cur.QosSessionDuration = 0
 		}
 
+		var oldReplicaPolicy *edgeproto.ReplicaAutoScalePolicy
+		if cur.ReplicaAutoScalePolicy != nil {
+			oldReplicaPolicy = cur.ReplicaAutoScalePolicy.Clone()
+		}
 		cur.CopyInFields(in)
+		if appInstExists && replicaAutoScaleReservationChanged(oldReplicaPolicy, cur.ReplicaAutoScalePolicy) {
+			return fmt.Errorf("Cannot change replica auto scale policy max replicas or scaling targets between active connections and cpu/mem when AppInsts exist")
+		}
 		// for any changes that can affect trust policy, verify the app is still valid for all
 		// cloudlets onto which it is deployed.
 		if requiredOutboundSpecified ||
@@ -818,9 +839,26 @@ func (s *AppApi) UpdateApp(ctx context.Context, in *edgeproto.App) (*edgeproto.R
 	return &edgeproto.Result{}, err
 }
 
+// replicaAutoScaleReservationChanged checks if the change in policy
+// changes the resources reserved by AppInsts, or changes whether the
+// replicas are scaled by Kubernetes or by shepherd.
+func replicaAutoScaleReservationChanged(old, new *edgeproto.ReplicaAutoScalePolicy) bool {
+	if old == nil || new == nil {
+		return old != new
+	}
+	return old.MaxReplicas != new.MaxReplicas || old.UsesHorizontalPodAutoscaler() != new.UsesHorizontalPodAutoscaler()
+}
+
 func revisionUpdateNeeded(fmap *edgeproto.FieldMap) bool {
-	alertPoliciesSpecified := fmap.Has(edgeproto.AppFieldAlertPolicies)
-	if alertPoliciesSpecified && fmap.Count() == 1 {
+	// policy changes do not require instances to be updated
+	policyFields := 0
+	if fmap.Has(edgeproto.AppFieldAlertPolicies) {
+		policyFields++
+	}
+	if fmap.Has(edgeproto.AppFieldGeoFencePolicy) {
+		policyFields++
+	}
+	if policyFields > 0 && fmap.Count() == policyFields {
 		return false
 	}
 	return true
@@ -837,6 +875,10 @@ func (s *AppApi) DeleteApp(ctx context.Context, in *edgeproto.App) (res *edgepro
 		if cur.DeletePrepare {
 			return in.Key.BeingDeletedError()
 		}
+		rollout := edgeproto.AppRollout{}
+		if s.all.appRolloutApi.store.STMGet(stm, &in.Key, &rollout) && isAppRolloutActive(rollout.State) {
+			return errors.New("Application has an active App rollout, roll it back or wait for it to finish first")
+		}
 
 		// use refs to check existing AppInsts to avoid race conditions
 		refs := edgeproto.AppInstRefs{}
@@ -905,6 +947,8 @@ func (s *AppApi) DeleteApp(ctx context.Context, in *edgeproto.App) (res *edgepro
 		s.globalIdStore.STMDel(stm, app.GlobalId)
 		// delete refs
 		s.all.appInstRefsApi.deleteRef(stm, &in.Key)
+		// delete any finished rollout
+		s.all.appRolloutApi.store.STMDel(stm, &in.Key)
 		return nil
 	})
 	if err != nil {
@@ -926,6 +970,15 @@ func (s *AppApi) ShowApp(in *edgeproto.App, cb edgeproto.AppApi_ShowAppServer) e
 	return err
 }
 
+func (s *AppApi) ShowAppHistory(in *edgeproto.App, cb edgeproto.AppApi_ShowAppHistoryServer) error {
+	return showObjHistory(cb.Context(), s.all, s.sync, s.cache.GetTypeString(), in, func(rev int64, obj *edgeproto.App) error {
+		return cb.Send(&edgeproto.AppHistory{
+			Revision: rev,
+			Obj:      *obj,
+		})
+	})
+}
+
 func (s *AppApi) ShowPublicApp(in *edgeproto.App, cb edgeproto.AppApi_ShowPublicAppServer) error {
 	err := s.cache.Show(in, func(obj *edgeproto.App) error {
 		if obj.Key.Organization != edgeproto.OrganizationEdgeCloud {
@@ -1151,6 +1204,36 @@ func (s *AppApi) UsesAlertPolicy(key *edgeproto.AlertPolicyKey) *edgeproto.AppKe
 	return nil
 }
 
+func (s *AppApi) validateGeoFencePolicy(stm concurrency.STM, app *edgeproto.App) error {
+	if app.GeoFencePolicy == "" {
+		return nil
+	}
+	policyKey := edgeproto.PolicyKey{
+		Name:         app.GeoFencePolicy,
+		Organization: app.Key.Organization,
+	}
+	policy := edgeproto.GeoFencePolicy{}
+	if !s.all.geoFencePolicyApi.store.STMGet(stm, &policyKey, &policy) {
+		return policyKey.NotFoundError()
+	}
+	if policy.DeletePrepare {
+		return policyKey.BeingDeletedError()
+	}
+	return nil
+}
+
+func (s *AppApi) UsesGeoFencePolicy(key *edgeproto.PolicyKey) *edgeproto.AppKey {
+	s.cache.Mux.Lock()
+	defer s.cache.Mux.Unlock()
+	for k, data := range s.cache.Objs {
+		app := data.Obj
+		if app.Key.Organization == key.Organization && app.GeoFencePolicy == key.Name {
+			return &k
+		}
+	}
+	return nil
+}
+
 func (s *AppApi) tryDeployApp(ctx context.Context, stm concurrency.STM, app *edgeproto.App, appInst *edgeproto.AppInst, cloudlet *edgeproto.Cloudlet, cloudletInfo *edgeproto.CloudletInfo,
 	cloudletRefs *edgeproto.CloudletRefs, numNodes uint32) error {
 
@@ -497,9 +497,143 @@ func TestAppApi(t *testing.T) {
 		require.Nil(t, err)
 	}
 
+	testReplicaAutoScalePolicy(t, ctx, apis)
+
 	dummy.Stop()
 }
 
+func testReplicaAutoScalePolicy(t *testing.T, ctx context.Context, apis *AllApis) {
+	scaledApp := edgeproto.App{
+		Key: edgeproto.AppKey{
+			Organization: "org",
+			Name:         "replicaScaleTest",
+			Version:      "1.0",
+		},
+		ImageType:     edgeproto.ImageType_IMAGE_TYPE_DOCKER,
+		ImagePath:     "registry.mobiledgex.net/mobiledgex_AcmeAppCo/acmeapp:1.0",
+		AccessPorts:   "tcp:8080",
+		Deployment:    cloudcommon.DeploymentTypeKubernetes,
+		DefaultFlavor: testutil.FlavorData()[2].Key,
+	}
+	newPolicy := func() *edgeproto.ReplicaAutoScalePolicy {
+		return &edgeproto.ReplicaAutoScalePolicy{
+			MinReplicas: 1,
+			MaxReplicas: 3,
+			TargetCpu:   80,
+		}
+	}
+
+	// invalid policies
+	tests := []struct {
+		desc   string
+		modApp func(app *edgeproto.App)
+		expErr string
+	}{{
+		"docker deployment",
+		func(app *edgeproto.App) { app.Deployment = cloudcommon.DeploymentTypeDocker },
+		"replica auto scale policy is only supported for Kubernetes deployments",
+	}, {
+		"scale with cluster",
+		func(app *edgeproto.App) { app.ScaleWithCluster = true },
+		"replica auto scale policy is not supported with scale with cluster",
+	}, {
+		"no min replicas",
+		func(app *edgeproto.App) { app.ReplicaAutoScalePolicy.MinReplicas = 0 },
+		"Min replicas must be greater than 0",
+	}, {
+		"max not greater than min",
+		func(app *edgeproto.App) { app.ReplicaAutoScalePolicy.MaxReplicas = 1 },
+		"Max replicas must be greater than Min replicas",
+	}, {
+		"too many replicas",
+		func(app *edgeproto.App) { app.ReplicaAutoScalePolicy.MaxReplicas = 101 },
+		"Max replicas cannot exceed 100",
+	}, {
+		"no targets",
+		func(app *edgeproto.App) { app.ReplicaAutoScalePolicy.TargetCpu = 0 },
+		"One of target cpu or target mem or target active connections must be specified",
+	}, {
+		"invalid cpu target",
+		func(app *edgeproto.App) { app.ReplicaAutoScalePolicy.TargetCpu = 101 },
+		"Target cpu must be between 0 (disabled) and 100",
+	}, {
+		"active connections with cpu",
+		func(app *edgeproto.App) { app.ReplicaAutoScalePolicy.TargetActiveConnections = 100 },
+		"Target active connections cannot be combined with target cpu or target mem",
+	}}
+	for _, test := range tests {
+		app := scaledApp
+		app.ReplicaAutoScalePolicy = newPolicy()
+		test.modApp(&app)
+		_, err := apis.appApi.CreateApp(ctx, &app)
+		require.NotNil(t, err, test.desc)
+		require.Contains(t, err.Error(), test.expErr, test.desc)
+	}
+
+	// valid policy, stabilization window is defaulted
+	app := scaledApp
+	app.ReplicaAutoScalePolicy = newPolicy()
+	_, err := apis.appApi.CreateApp(ctx, &app)
+	require.Nil(t, err)
+	storedApp := edgeproto.App{}
+	require.True(t, apis.appApi.cache.Get(&app.Key, &storedApp))
+	require.Equal(t, uint32(edgeproto.DefaultStabilizationWindowSec), storedApp.ReplicaAutoScalePolicy.StabilizationWindowSec)
+
+	// switch to active connections
+	update := edgeproto.App{
+		Key: app.Key,
+		ReplicaAutoScalePolicy: &edgeproto.ReplicaAutoScalePolicy{
+			TargetActiveConnections: 100,
+		},
+		Fields: []string{
+			edgeproto.AppFieldReplicaAutoScalePolicyTargetCpu,
+			edgeproto.AppFieldReplicaAutoScalePolicyTargetActiveConnections,
+		},
+	}
+	_, err = apis.appApi.UpdateApp(ctx, &update)
+	require.Nil(t, err)
+	require.True(t, apis.appApi.cache.Get(&app.Key, &storedApp))
+	require.Equal(t, uint32(0), storedApp.ReplicaAutoScalePolicy.TargetCpu)
+	require.Equal(t, uint64(100), storedApp.ReplicaAutoScalePolicy.TargetActiveConnections)
+
+	// AppInst replicas are checked against the policy
+	appInst := edgeproto.AppInst{}
+	appInst.Replicas = 2
+	require.Nil(t, validateAppInstReplicas(&storedApp, &appInst))
+	appInst.Replicas = 4
+	err = validateAppInstReplicas(&storedApp, &appInst)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Replicas must be between the App replica auto scale policy min replicas 1 and max replicas 3")
+	err = validateAppInstReplicas(&app, &appInst)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Replicas cannot be specified when the App replicas are scaled by target cpu or target mem")
+
+	// AppInst resources are reserved for the max replicas
+	appInst.KubernetesResources = &edgeproto.KubernetesResources{
+		CpuPool: &edgeproto.NodePoolResources{
+			TotalVcpus:  *edgeproto.NewUdec64(1, 0),
+			TotalMemory: 1024,
+		},
+	}
+	kr, err := getAppInstReservedKubernetesResources(&storedApp, &appInst)
+	require.Nil(t, err)
+	require.Equal(t, uint64(3072), kr.CpuPool.TotalMemory)
+	require.Equal(t, uint64(1024), appInst.KubernetesResources.CpuPool.TotalMemory)
+	// without a policy, AppInst resources are reserved for the
+	// specified replicas
+	appInst.Replicas = 2
+	kr, err = getAppInstReservedKubernetesResources(&edgeproto.App{}, &appInst)
+	require.Nil(t, err)
+	require.Equal(t, uint64(2048), kr.CpuPool.TotalMemory)
+	appInst.Replicas = 0
+	kr, err = getAppInstReservedKubernetesResources(&edgeproto.App{}, &appInst)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1024), kr.CpuPool.TotalMemory)
+
+	_, err = apis.appApi.DeleteApp(ctx, &app)
+	require.Nil(t, err)
+}
+
 var testInvalidUrlHelmCfg = "http://invalidUrl"
 var testValidYmlHelmCfg = `nfs:
   path: /share
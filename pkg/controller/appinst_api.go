@@ -322,12 +322,16 @@ func removeProtocol(protos int32, protocolToRemove int32) int32 {
 	return protos & (^protocolToRemove)
 }
 
-func (s *AppInstApi) startAppInstStream(ctx context.Context, cctx *CallContext, streamCb *CbWrapper, modRev int64) (*streamSend, error) {
+func (s *AppInstApi) startAppInstStream(ctx context.Context, cctx *CallContext, key *edgeproto.AppInstKey, streamCb *CbWrapper, modRev int64) (*streamSend, error) {
 	streamSendObj, err := s.all.streamObjApi.startStream(ctx, cctx, streamCb, modRev)
 	if err != nil {
 		log.SpanLog(ctx, log.DebugLevelApi, "failed to start appinst stream", "err", err)
 		return nil, err
 	}
+	inst := edgeproto.AppInst{}
+	if s.cache.Get(key, &inst) {
+		streamSendObj.objID = inst.ObjId
+	}
 	return streamSendObj, err
 }
 
@@ -335,6 +339,9 @@ func (s *AppInstApi) stopAppInstStream(ctx context.Context, cctx *CallContext, k
 	if err := s.all.streamObjApi.stopStream(ctx, cctx, key.StreamKey(), streamSendObj, objErr, cleanupStream); err != nil {
 		log.SpanLog(ctx, log.DebugLevelApi, "failed to stop appinst stream", "err", err)
 	}
+	if objErr != nil && streamSendObj != nil && !streamSendObj.isCopy {
+		s.all.nbiEventsApi.appInstFailed(ctx, key, streamSendObj, objErr)
+	}
 }
 
 func (s *StreamObjApi) StreamAppInst(key *edgeproto.AppInstKey, cb edgeproto.StreamObjApi_StreamAppInstServer) error {
@@ -461,6 +468,7 @@ func (s *AppInstApi) createAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 	var err error
 	ctx := inCb.Context()
 	cctx.SetOverride(&in.CrmOverride)
+	cctx.SetDryRun(&in.DryRun)
 
 	// If the ClusterKey is left blank and a cluster is required,
 	// then a cluster will automatically be chosen or created.
@@ -507,7 +515,7 @@ func (s *AppInstApi) createAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 	in.CompatibilityVersion = cloudcommon.GetAppInstCompatibilityVersion()
 
 	defer func() {
-		if reterr != nil {
+		if reterr != nil || cctx.DryRun {
 			return
 		}
 		s.RecordAppInstEvent(ctx, in, cloudcommon.CREATED, cloudcommon.InstanceUp)
@@ -536,6 +544,7 @@ func (s *AppInstApi) createAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 		app = edgeproto.App{}
 		scaleSpec = nil
 		sendMsgs = nil
+		cctx.dryRunReset(true)
 
 		// lookup App so we can get flavor for reservable ClusterInst
 		if !s.all.appApi.store.STMGet(stm, &in.AppKey, &app) {
@@ -660,6 +669,7 @@ func (s *AppInstApi) createAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 				}
 				if err != nil {
 					log.SpanLog(ctx, log.DebugLevelApi, "failed to use potential cluster, will try another", "cluster", pc.existingCluster, "cloudlet", pc.cloudletKey, "err", err)
+					cctx.dryRunSkip("cluster", pc.existingCluster.Name, SkipReason(err.Error()))
 					continue
 				}
 				if pc.scaleSpec != nil {
@@ -676,6 +686,7 @@ func (s *AppInstApi) createAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 					}
 					if err != nil {
 						log.SpanLog(ctx, log.DebugLevelApi, "failed to confirm potential cluster resources, will try another", "cluster", pc.existingCluster, "cloudlet", pc.cloudletKey, "err", err)
+						cctx.dryRunSkip("cluster", pc.existingCluster.Name, ClusterNoResources)
 						continue
 					}
 				}
@@ -738,12 +749,14 @@ func (s *AppInstApi) createAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 					_, err = pc.resCalc.CloudletFitsCluster(ctx, autoCi, nil)
 					if err != nil {
 						log.SpanLog(ctx, log.DebugLevelApi, "skip potential cloudlet for reservable clusterinst", "cloudlet", pc.cloudlet.Key, "err", err)
+						cctx.dryRunSkip("cloudlet", pc.cloudlet.Key.Name, ClusterNoResources)
 						continue
 					}
 				} else {
 					_, err := pc.resCalc.CloudletFitsVMApp(ctx, &app, in)
 					if err != nil {
 						log.SpanLog(ctx, log.DebugLevelApi, "skip potential cloudlet for VMApp", "cloudlet", pc.cloudlet.Key, "err", err)
+						cctx.dryRunSkip("cloudlet", pc.cloudlet.Key.Name, ClusterNoResources)
 						continue
 					}
 				}
@@ -835,6 +848,13 @@ func (s *AppInstApi) createAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 			return fmt.Errorf("Direct Access Apps are no longer supported, please re-create App as ACCESS_TYPE_LOAD_BALANCER")
 		}
 
+		if in.Replicas != 0 && app.Deployment != cloudcommon.DeploymentTypeKubernetes {
+			return fmt.Errorf("Replicas can only be specified for kubernetes deployments")
+		}
+		if err := validateAppInstReplicas(&app, in); err != nil {
+			return err
+		}
+
 		refs := edgeproto.CloudletRefs{}
 		if !s.all.cloudletRefsApi.store.STMGet(stm, &in.CloudletKey, &refs) {
 			initCloudletRefs(&refs, &in.CloudletKey)
@@ -851,6 +871,9 @@ func (s *AppInstApi) createAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 				return err
 			}
 			s.all.clusterInstApi.handleResourceUsageAlerts(ctx, stm, &cloudlet.Key, warnings)
+			for _, warning := range warnings {
+				cctx.dryRunDetail("resource warning, %s", warning)
+			}
 			refs.VmAppInsts = append(refs.VmAppInsts, in.Key)
 			refsChanged = true
 		}
@@ -902,6 +925,10 @@ func (s *AppInstApi) createAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 				}
 			}
 		}
+		if cctx.DryRun {
+			// all checks passed, abort before committing any changes
+			return errDryRun
+		}
 		// Set new state to show autocluster clusterinst progress as part of
 		// appinst progress
 		in.State = edgeproto.TrackedState_CREATING_DEPENDENCIES
@@ -920,7 +947,15 @@ func (s *AppInstApi) createAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 	for _, msg := range sendMsgs {
 		cb.Send(&edgeproto.Result{Message: msg})
 	}
+	if err == errDryRun {
+		if err := s.addCreateDryRunDetails(ctx, cctx, in, &app, cloudletFeatures, createCluster, scaleSpec); err != nil {
+			return err
+		}
+		cctx.sendDryRunPlan(cb.Send)
+		return nil
+	}
 	if err != nil {
+		cctx.sendDryRunSkipped(cb.Send)
 		return err
 	}
 	if reservedCluster != nil {
@@ -991,7 +1026,7 @@ func (s *AppInstApi) createAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 		}
 	}()
 
-	sendObj, err := s.startAppInstStream(ctx, cctx, streamCb, modRev)
+	sendObj, err := s.startAppInstStream(ctx, cctx, &appInstKey, streamCb, modRev)
 	if err != nil {
 		return err
 	}
@@ -1255,6 +1290,9 @@ func (s *AppInstApi) createAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 			in.State = edgeproto.TrackedState_CREATE_REQUESTED
 		}
 		in.Uri = getAppInstURI(ctx, in, &app, &clusterInst, &cloudlet, cloudletFeatures)
+		if err := s.keepMovedFQDN(stm, in, &cloudlet); err != nil {
+			return err
+		}
 		if err := cloudcommon.CheckFQDNLengths("", in.Uri); err != nil {
 			return err
 		}
@@ -1326,6 +1364,25 @@ func (s *AppInstApi) createAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 	return err
 }
 
+// addCreateDryRunDetails adds the target and the resources the
+// AppInst would have used to the dry run plan.
+func (s *AppInstApi) addCreateDryRunDetails(ctx context.Context, cctx *CallContext, in *edgeproto.AppInst, app *edgeproto.App, features *edgeproto.PlatformFeatures, createCluster bool, scaleSpec *resspec.KubeResScaleSpec) error {
+	cctx.dryRunDetail("AppInst would be deployed to cloudlet %s", in.CloudletKey.GetKeyString())
+	if createCluster {
+		autoCi, err := s.buildAutocluster(ctx, in.ClusterKey, in.CloudletKey, features, app, in)
+		if err != nil {
+			return err
+		}
+		cctx.dryRunDetail("new cluster %s would be created, requesting %s", in.ClusterKey.GetKeyString(), s.all.clusterInstApi.sumRequestedClusterResources(autoCi).String())
+	} else if scaleSpec != nil {
+		cctx.dryRunDetail("AppInst would be deployed to cluster %s, after scaling up the cluster", in.ClusterKey.GetKeyString())
+	} else if in.ClusterKey.Name != "" {
+		cctx.dryRunDetail("AppInst would be deployed to cluster %s", in.ClusterKey.GetKeyString())
+	}
+	cctx.dryRunDetail("AppInst requests %s", s.sumRequestedAppInstResources(in).String())
+	return nil
+}
+
 func (s *AppInstApi) buildAutocluster(ctx context.Context, clusterKey edgeproto.ClusterKey, cloudletKey edgeproto.CloudletKey, features *edgeproto.PlatformFeatures, app *edgeproto.App, in *edgeproto.AppInst) (*edgeproto.ClusterInst, error) {
 	clusterInst := edgeproto.ClusterInst{}
 	clusterInst.Key = clusterKey
@@ -1501,7 +1558,7 @@ func (s *AppInstApi) refreshAppInstInternal(cctx *CallContext, key edgeproto.App
 		return false, err
 	}
 
-	sendObj, err := s.startAppInstStream(ctx, cctx, streamCb, modRev)
+	sendObj, err := s.startAppInstStream(ctx, cctx, &key, streamCb, modRev)
 	if err != nil {
 		return false, err
 	}
@@ -1541,8 +1598,11 @@ func (s *AppInstApi) refreshAppInstInternal(cctx *CallContext, key edgeproto.App
 				return false, err
 			}
 			api := edgeproto.NewAppInstPlatformAPIClient(conn)
+			// state must be included for the platform to act on it
 			curr.Fields = []string{edgeproto.AppInstFieldState}
-			curr.Fields = updateDiffFields.Fields()
+			if updateDiffFields != nil {
+				curr.Fields = append(curr.Fields, updateDiffFields.Fields()...)
+			}
 			outStream, err := api.ApplyAppInst(reqCtx, &curr)
 			if err != nil {
 				return false, cloudcommon.GRPCErrorUnwrap(err)
@@ -1705,6 +1765,10 @@ func (s *AppInstApi) UpdateAppInst(in *edgeproto.AppInst, cb edgeproto.AppInstAp
 	if fmap.HasOrHasChild(edgeproto.AppInstFieldFlavor) || fmap.HasOrHasChild(edgeproto.AppInstFieldKubernetesResources) || fmap.HasOrHasChild(edgeproto.AppFieldKubernetesResources) {
 		resChange = true
 	}
+	// resources are reserved per replica
+	if fmap.Has(edgeproto.AppInstFieldReplicas) {
+		resChange = true
+	}
 
 	cctx := DefCallContext()
 	cctx.SetOverride(&in.CrmOverride)
@@ -1749,6 +1813,14 @@ func (s *AppInstApi) UpdateAppInst(in *edgeproto.AppInst, cb edgeproto.AppInstAp
 				}
 			}
 		}
+		if fmap.Has(edgeproto.AppInstFieldReplicas) && in.Replicas != 0 && app.Deployment != cloudcommon.DeploymentTypeKubernetes {
+			return fmt.Errorf("Replicas can only be specified for kubernetes deployments")
+		}
+		if fmap.Has(edgeproto.AppInstFieldReplicas) {
+			if err := validateAppInstReplicas(&app, in); err != nil {
+				return err
+			}
+		}
 		old := edgeproto.AppInst{}
 		old.DeepCopyIn(&cur)
 		changeCount = cur.CopyInFields(in)
@@ -1964,6 +2036,7 @@ func (s *AppInstApi) deleteAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 				s.fedStore.STMDel(stm, &in.FedKey)
 			}
 			s.dnsLabelStore.STMDel(stm, &in.CloudletKey, in.DnsLabel)
+			s.releaseMovedFQDN(stm, in)
 			s.all.appInstRefsApi.removeRef(stm, &in.AppKey, &in.Key)
 			if cloudcommon.IsClusterInstReqd(&app) {
 				s.all.clusterRefsApi.removeRef(stm, in)
@@ -1982,7 +2055,7 @@ func (s *AppInstApi) deleteAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 		clusterInstReservationEvent(ctx, cloudcommon.FreeClusterEvent, in)
 	}
 
-	sendObj, err := s.startAppInstStream(ctx, cctx, streamCb, modRev)
+	sendObj, err := s.startAppInstStream(ctx, cctx, &appInstKey, streamCb, modRev)
 	if err != nil {
 		return err
 	}
@@ -2221,6 +2294,11 @@ func (s *AppInstApi) UpdateFromInfo(ctx context.Context, in *edgeproto.AppInstIn
 				applyUpdate = true
 			}
 		}
+		// replicas may be changed by a HorizontalPodAutoscaler
+		if fmap.Has(edgeproto.AppInstInfoFieldRuntimeInfoReplicas) && inst.RuntimeInfo.Replicas != in.RuntimeInfo.Replicas {
+			inst.RuntimeInfo.Replicas = in.RuntimeInfo.Replicas
+			applyUpdate = true
+		}
 		if applyUpdate {
 			s.store.STMPut(stm, &inst)
 		}
@@ -2267,6 +2345,7 @@ func (s *AppInstApi) DeleteFromInfo(ctx context.Context, in *edgeproto.AppInstIn
 			s.fedStore.STMDel(stm, &inst.FedKey)
 		}
 		s.dnsLabelStore.STMDel(stm, &inst.CloudletKey, inst.DnsLabel)
+		s.releaseMovedFQDN(stm, &inst)
 		s.all.appInstRefsApi.removeRef(stm, &inst.AppKey, &in.Key)
 		s.all.clusterRefsApi.removeRef(stm, &inst)
 		return nil
@@ -2312,6 +2391,7 @@ func (s *AppInstApi) ReplaceErrorState(ctx context.Context, in *edgeproto.AppIns
 				s.fedStore.STMDel(stm, &inst.FedKey)
 			}
 			s.dnsLabelStore.STMDel(stm, &inst.CloudletKey, inst.DnsLabel)
+			s.releaseMovedFQDN(stm, &inst)
 			s.all.appInstRefsApi.removeRef(stm, &inst.AppKey, &in.Key)
 			s.all.clusterRefsApi.removeRef(stm, &inst)
 		} else {
@@ -2444,6 +2524,22 @@ func setPortFQDNPrefix(port *edgeproto.InstPort, objs []runtime.Object) {
 	}
 }
 
+// validateAppInstReplicas checks the AppInst replicas against the
+// App's replica auto scale policy.
+func validateAppInstReplicas(app *edgeproto.App, appInst *edgeproto.AppInst) error {
+	policy := app.ReplicaAutoScalePolicy
+	if policy == nil || appInst.Replicas == 0 {
+		return nil
+	}
+	if policy.UsesHorizontalPodAutoscaler() {
+		return fmt.Errorf("Replicas cannot be specified when the App replicas are scaled by target cpu or target mem")
+	}
+	if appInst.Replicas < int32(policy.MinReplicas) || appInst.Replicas > int32(policy.MaxReplicas) {
+		return fmt.Errorf("Replicas must be between the App replica auto scale policy min replicas %d and max replicas %d", policy.MinReplicas, policy.MaxReplicas)
+	}
+	return nil
+}
+
 func (s *AppInstApi) sumRequestedAppInstResources(appInst *edgeproto.AppInst) resspec.ResValMap {
 	// Note this calculates the resource values as requested
 	// by the user, not the actual resources deployed in the
@@ -2517,6 +2613,10 @@ func (s *AppInstApi) updateURI(key *edgeproto.AppInstKey, cloudlet *edgeproto.Cl
 			log.SpanLog(ctx, log.DebugLevelApi, "AppInst is internal.")
 			return nil
 		}
+		if _, _, fqdn, ok := getMovedFQDN(&appInst); ok && appInst.Uri == fqdn {
+			log.SpanLog(ctx, log.DebugLevelApi, "AppInst keeps its FQDN from before it was moved", "uri", appInst.Uri)
+			return nil
+		}
 		app := edgeproto.App{}
 		if !s.all.appApi.store.STMGet(stm, &appInst.AppKey, &app) {
 			return appInst.AppKey.NotFoundError()
@@ -2593,7 +2693,7 @@ func (s *AppInstApi) updateURI(key *edgeproto.AppInstKey, cloudlet *edgeproto.Cl
 	if !needUpdate {
 		return nil
 	}
-	sendObj, err := s.startAppInstStream(ctx, cctx, streamCb, modRev)
+	sendObj, err := s.startAppInstStream(ctx, cctx, key, streamCb, modRev)
 	if err != nil {
 		return err
 	}
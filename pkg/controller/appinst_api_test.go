@@ -399,6 +399,8 @@ func TestAppInstApi(t *testing.T) {
 	testAppFlavorRequest(t, ctx, commonApi, responder, apis)
 	testSingleKubernetesCloudlet(t, ctx, apis, appDnsRoot)
 	testAppInstPotentialCloudlets(t, ctx, apis)
+	testAppInstMove(t, ctx, apis, responder, ccrm)
+	testAppRollout(t, ctx, apis)
 	testAppInstScaleSpec(t, ctx, apis)
 
 	// cleanup unused reservable auto clusters
@@ -1394,6 +1396,33 @@ func testAppInstPotentialCloudlets(t *testing.T, ctx context.Context, apis *AllA
 	// cloudlet1: cluster1 (5 vcpus) + cluster4 (2 vcpus)
 	// cloudlet2: cluster2 (4 vcpus) + cluster3 (3 vcpus)
 	insts := []*edgeproto.AppInst{}
+
+	// dry run reports the target without creating anything
+	clusterCount := apis.clusterInstApi.cache.GetCount()
+	dryRun := &edgeproto.AppInst{}
+	dryRun.Key.Name = "pcappinstdryrun"
+	dryRun.Key.Organization = app.Key.Organization
+	dryRun.AppKey = app.Key
+	dryRun.ZoneKey = zone.Key
+	dryRun.DryRun = true
+	out := NewStreamoutMsg(ctx)
+	err = apis.appInstApi.CreateAppInst(dryRun, out)
+	require.Nil(t, err)
+	require.False(t, apis.appInstApi.cache.HasKey(&dryRun.Key))
+	require.Equal(t, clusterCount, apis.clusterInstApi.cache.GetCount())
+	refs := edgeproto.CloudletRefs{}
+	apis.cloudletRefsApi.cache.Get(&cloudlets[0].Key, &refs)
+	require.Equal(t, uint64(0), refs.ReservedAutoClusterIds)
+	msgs := []string{}
+	for _, msg := range out.Msgs {
+		msgs = append(msgs, msg.Message)
+	}
+	autoClusterName := cloudcommon.BuildReservableClusterName(0, &cloudlets[0].Key)
+	require.Contains(t, msgs, "Dry run: AppInst would be deployed to cloudlet "+cloudlets[0].Key.GetKeyString())
+	require.Contains(t, msgs, "Dry run: AppInst requests Disk 0GB, RAM 1024MB, vCPUs 1")
+	require.Contains(t, strings.Join(msgs, "\n"), `Dry run: new cluster {"name":"`+autoClusterName)
+	require.Equal(t, "Dry run complete, no changes were made", msgs[len(msgs)-1])
+
 	for ii := 0; ii < 6; ii++ {
 		desc := fmt.Sprintf("create appInst%d", ii)
 		ai := &edgeproto.AppInst{}
@@ -1455,6 +1484,21 @@ func testAppInstPotentialCloudlets(t *testing.T, ctx context.Context, apis *AllA
 	err = apis.appInstApi.CreateAppInst(ai, testutil.NewCudStreamoutAppInst(ctx))
 	require.NotNil(t, err)
 	require.Equal(t, "no resources available for deployment", err.Error())
+
+	// dry run reports why each cloudlet was skipped
+	ai.DryRun = true
+	out = NewStreamoutMsg(ctx)
+	err = apis.appInstApi.CreateAppInst(ai, out)
+	require.NotNil(t, err)
+	require.Equal(t, "no resources available for deployment", err.Error())
+	skipped := 0
+	for _, msg := range out.Msgs {
+		if strings.HasPrefix(msg.Message, "Dry run: skipped cloudlet") {
+			require.Contains(t, msg.Message, ClusterNoResources)
+			skipped++
+		}
+	}
+	require.Equal(t, len(cloudlets), skipped)
 }
 
 func testAppInstScaleSpec(t *testing.T, ctx context.Context, apis *AllApis) {
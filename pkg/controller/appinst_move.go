@@ -0,0 +1,479 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/util"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+const DefaultMoveHealthCheckTimeout = 5 * time.Minute
+
+// annotations that are specific to an instance and should not
+// be copied to the replacement instance of a move.
+var moveSkipAnnotations = map[string]struct{}{
+	cloudcommon.AnnotationCloudletScopedName: {},
+	cloudcommon.AnnotationBadUpgrade55Name:   {},
+	cloudcommon.AnnotationPreviousDNSName:    {},
+	cloudcommon.AnnotationMovedFQDN:          {},
+	cloudcommon.AnnotationMovedFQDNCloudlet:  {},
+}
+
+// MoveAppInst moves an AppInst to a different cloudlet, keeping its
+// key and FQDN. To avoid downtime, a temporary AppInst is first
+// created on the target cloudlet. Once it is healthy, the original
+// AppInst is deleted, which causes the DME to send edge events to
+// clients connected to it, steering them to the temporary AppInst.
+// The AppInst is then recreated under its original key on the target
+// cloudlet with its FQDN pointing to the target cloudlet, and the
+// temporary AppInst is deleted, which steers clients back to it.
+func (s *AppInstApi) MoveAppInst(in *edgeproto.AppInstMove, cb edgeproto.AppInstApi_MoveAppInstServer) error {
+	return s.moveAppInst(DefCallContext(), in, cb)
+}
+
+func (s *AppInstApi) moveAppInst(cctx *CallContext, in *edgeproto.AppInstMove, cb edgeproto.AppInstApi_MoveAppInstServer) error {
+	ctx := cb.Context()
+
+	if err := in.Key.ValidateKey(); err != nil {
+		return err
+	}
+	orig := edgeproto.AppInst{}
+	if !s.cache.Get(&in.Key, &orig) {
+		return in.Key.NotFoundError()
+	}
+	if orig.State != edgeproto.TrackedState_READY {
+		return fmt.Errorf("AppInst %s must be ready to be moved, current state is %s", in.Key.Name, orig.State.String())
+	}
+	app := edgeproto.App{}
+	if !s.all.appApi.cache.Get(&orig.AppKey, &app) {
+		return orig.AppKey.NotFoundError()
+	}
+	if cloudcommon.IsSideCarApp(&app) {
+		return fmt.Errorf("cannot move sidecar AppInst %s, it is managed as part of its cluster", in.Key.Name)
+	}
+	if in.HealthCheckTimeout < 0 {
+		return fmt.Errorf("invalid health check timeout %s", in.HealthCheckTimeout.TimeDuration())
+	}
+	healthCheckTimeout := in.HealthCheckTimeout.TimeDuration()
+	if healthCheckTimeout == 0 {
+		healthCheckTimeout = DefaultMoveHealthCheckTimeout
+	}
+	soakTime := getMoveHealthSoakTime(s.all.settingsApi.Get())
+	if healthCheckTimeout <= soakTime {
+		return fmt.Errorf("health check timeout %s must be longer than the time of %s it takes to detect an unhealthy AppInst", healthCheckTimeout, soakTime)
+	}
+	tmpKey := getMoveTmpAppInstKey(&in.Key)
+	if s.cache.HasKey(&tmpKey) {
+		return fmt.Errorf("AppInst %s is already being moved", in.Key.Name)
+	}
+
+	// determine the candidate target cloudlets
+	targetZone := in.TargetZoneKey
+	if targetZone.Name == "" {
+		targetZone = orig.ZoneKey
+	}
+	var targets []edgeproto.CloudletKey
+	if in.TargetClusterKey.Name != "" {
+		if err := in.TargetClusterKey.ValidateKey(); err != nil {
+			return err
+		}
+		clusterInst := edgeproto.ClusterInst{}
+		if !s.all.clusterInstApi.cache.Get(&in.TargetClusterKey, &clusterInst) {
+			return in.TargetClusterKey.NotFoundError()
+		}
+		if in.TargetCloudletKey.Name != "" && !in.TargetCloudletKey.Matches(&clusterInst.CloudletKey) {
+			return fmt.Errorf("target cluster %s is not on target cloudlet %s", in.TargetClusterKey.Name, in.TargetCloudletKey.Name)
+		}
+		targets = []edgeproto.CloudletKey{clusterInst.CloudletKey}
+	} else if in.TargetCloudletKey.Name != "" {
+		if err := in.TargetCloudletKey.ValidateKey(); err != nil {
+			return err
+		}
+		targets = []edgeproto.CloudletKey{in.TargetCloudletKey}
+	} else {
+		var err error
+		targets, err = s.getMoveTargetCloudlets(ctx, cctx, &orig, &app, &targetZone)
+		if err != nil {
+			return err
+		}
+	}
+	if len(targets) == 1 && targets[0].Matches(&orig.CloudletKey) {
+		return fmt.Errorf("AppInst %s is already on cloudlet %s", in.Key.Name, orig.CloudletKey.Name)
+	}
+
+	// Create the temporary AppInst. The same App cannot be deployed
+	// twice to a dedicated cluster, so the temporary AppInst is
+	// placed automatically, leaving the target cluster for the
+	// recreated AppInst.
+	var tmp *edgeproto.AppInst
+	var createErr error
+	for _, target := range targets {
+		inst := buildMoveReplacement(&orig, &tmpKey, &target, &edgeproto.ClusterKey{})
+		cb.Send(&edgeproto.Result{Message: fmt.Sprintf("Creating temporary AppInst %s on cloudlet %s", inst.Key.Name, target.Name)})
+		createErr = s.createAppInstInternal(cctx, inst, cb)
+		if createErr == nil {
+			tmp = inst
+			break
+		}
+		log.SpanLog(ctx, log.DebugLevelApi, "failed to create temporary AppInst", "appinst", inst.Key, "cloudlet", target, "err", createErr)
+		cb.Send(&edgeproto.Result{Message: fmt.Sprintf("Failed to create temporary AppInst on cloudlet %s, %v", target.Name, createErr)})
+	}
+	if tmp == nil {
+		return fmt.Errorf("failed to create replacement for AppInst %s, %v", in.Key.Name, createErr)
+	}
+
+	// wait for the temporary AppInst to be healthy
+	cb.Send(&edgeproto.Result{Message: fmt.Sprintf("Waiting for temporary AppInst %s to pass health checks", tmp.Key.Name)})
+	err := s.waitForAppInstHealthy(ctx, &tmp.Key, healthCheckTimeout, soakTime)
+	if err != nil {
+		cb.Send(&edgeproto.Result{Message: fmt.Sprintf("Temporary AppInst %s is not healthy, deleting it", tmp.Key.Name)})
+		undoErr := s.deleteAppInstInternal(cctx.WithUndo(), &edgeproto.AppInst{Key: tmp.Key}, cb)
+		if undoErr != nil {
+			log.SpanLog(ctx, log.DebugLevelApi, "failed to delete unhealthy temporary AppInst", "appinst", tmp.Key, "err", undoErr)
+		}
+		return err
+	}
+
+	// Delete the original. The DME removes it from its tables which
+	// sends edge events to its connected clients to find the
+	// temporary instance.
+	cb.Send(&edgeproto.Result{Message: fmt.Sprintf("Temporary AppInst %s is healthy, deleting AppInst %s from cloudlet %s", tmp.Key.Name, in.Key.Name, orig.CloudletKey.Name)})
+	err = s.deleteAppInstInternal(cctx, &edgeproto.AppInst{Key: in.Key}, cb)
+	if err != nil {
+		return fmt.Errorf("failed to delete AppInst %s from cloudlet %s, temporary AppInst %s was left in place, %v", in.Key.Name, orig.CloudletKey.Name, tmp.Key.Name, err)
+	}
+
+	// recreate the AppInst on the target cloudlet
+	moved := buildMoveReplacement(&orig, &in.Key, &tmp.CloudletKey, &in.TargetClusterKey)
+	if !s.setMovedFQDN(&orig, moved) && orig.Uri != "" {
+		cb.Send(&edgeproto.Result{Message: fmt.Sprintf("AppInst %s uses the shared FQDN %s of its cloudlet or cluster, its FQDN will change", in.Key.Name, orig.Uri)})
+	}
+	cb.Send(&edgeproto.Result{Message: fmt.Sprintf("Recreating AppInst %s on cloudlet %s", in.Key.Name, tmp.CloudletKey.Name)})
+	err = s.createAppInstInternal(cctx, moved, cb)
+	if err == nil {
+		cb.Send(&edgeproto.Result{Message: fmt.Sprintf("Waiting for AppInst %s to pass health checks", in.Key.Name)})
+		err = s.waitForAppInstHealthy(ctx, &in.Key, healthCheckTimeout, soakTime)
+	}
+	if err != nil {
+		// keep the temporary AppInst so clients are still served
+		return fmt.Errorf("failed to recreate AppInst %s on cloudlet %s, clients are served by temporary AppInst %s, %v", in.Key.Name, tmp.CloudletKey.Name, tmp.Key.Name, err)
+	}
+
+	// Delete the temporary AppInst, which steers its clients to
+	// the recreated AppInst.
+	cb.Send(&edgeproto.Result{Message: fmt.Sprintf("AppInst %s is healthy, deleting temporary AppInst %s", in.Key.Name, tmp.Key.Name)})
+	err = s.deleteAppInstInternal(cctx, &edgeproto.AppInst{Key: tmp.Key}, cb)
+	if err != nil {
+		return fmt.Errorf("AppInst %s was moved but failed to delete temporary AppInst %s, %v", in.Key.Name, tmp.Key.Name, err)
+	}
+	nodeMgr.Event(ctx, "AppInst moved", in.Key.Organization, orig.GetTags(), nil,
+		"fromcloudlet", orig.CloudletKey.Name,
+		"tocloudlet", moved.CloudletKey.Name)
+	cb.Send(&edgeproto.Result{Message: fmt.Sprintf("AppInst %s moved to cloudlet %s", in.Key.Name, moved.CloudletKey.Name)})
+	return nil
+}
+
+// getMoveTmpAppInstKey gets the key of the temporary AppInst that
+// serves clients while the AppInst is moved.
+func getMoveTmpAppInstKey(key *edgeproto.AppInstKey) edgeproto.AppInstKey {
+	return edgeproto.AppInstKey{
+		Name:         util.DNSSanitize(key.Name + "-moving"),
+		Organization: key.Organization,
+	}
+}
+
+// getMoveHealthSoakTime gets the time an AppInst must stay healthy
+// before it is considered healthy. This is the time it takes for
+// Shepherd to report an AppInst as down.
+func getMoveHealthSoakTime(settings *edgeproto.Settings) time.Duration {
+	return settings.ShepherdHealthCheckInterval.TimeDuration() * time.Duration(settings.ShepherdHealthCheckRetries+1)
+}
+
+// getMoveTargetCloudlets gets the cloudlets in the zone that the
+// AppInst can be moved to, ordered by preference.
+func (s *AppInstApi) getMoveTargetCloudlets(ctx context.Context, cctx *CallContext, orig *edgeproto.AppInst, app *edgeproto.App, zoneKey *edgeproto.ZoneKey) ([]edgeproto.CloudletKey, error) {
+	zone := edgeproto.Zone{}
+	if !s.all.zoneApi.cache.Get(zoneKey, &zone) {
+		return nil, zoneKey.NotFoundError()
+	}
+	template := buildMoveReplacement(orig, &orig.Key, &orig.CloudletKey, &edgeproto.ClusterKey{})
+	skipReasons := SkipReasons{}
+	potentialCloudlets := []*potentialInstCloudlet{}
+	for _, ckey := range s.all.cloudletApi.cache.CloudletsForZone(zoneKey) {
+		if ckey.Matches(&orig.CloudletKey) {
+			continue
+		}
+		pc, skipReason, err := s.validatePotentialCloudlet(ctx, cctx, template, app, &ckey)
+		if err != nil {
+			log.SpanLog(ctx, log.DebugLevelApi, "skipping cloudlet as move target", "cloudlet", ckey, "err", err)
+			skipReasons.add(skipReason)
+			continue
+		}
+		potentialCloudlets = append(potentialCloudlets, pc)
+	}
+	if len(potentialCloudlets) == 0 {
+		reasonsStr := ""
+		if len(skipReasons) > 0 {
+			reasonsStr = ", some sites were skipped because " + skipReasons.String()
+		}
+		return nil, fmt.Errorf("no other available edge sites in zone %s to move AppInst %s to%s", zoneKey.Name, orig.Key.Name, reasonsStr)
+	}
+	sort.Sort(PotentialInstCloudletsByResource(potentialCloudlets))
+	targets := []edgeproto.CloudletKey{}
+	for _, pc := range potentialCloudlets {
+		targets = append(targets, pc.cloudlet.Key)
+	}
+	return targets, nil
+}
+
+// buildMoveReplacement builds the AppInst to create on the target
+// cloudlet for a move from the user-specified settings of the
+// original AppInst.
+func buildMoveReplacement(orig *edgeproto.AppInst, key *edgeproto.AppInstKey, target *edgeproto.CloudletKey, clusterKey *edgeproto.ClusterKey) *edgeproto.AppInst {
+	inst := &edgeproto.AppInst{}
+	inst.Key = *key
+	inst.AppKey = orig.AppKey
+	inst.CloudletKey = *target
+	inst.ClusterKey = *clusterKey
+	inst.Liveness = orig.Liveness
+	inst.Flavor = orig.Flavor
+	if orig.KubernetesResources != nil {
+		inst.KubernetesResources = orig.KubernetesResources.Clone()
+	}
+	if orig.NodeResources != nil {
+		inst.NodeResources = orig.NodeResources.Clone()
+	}
+	for _, config := range orig.Configs {
+		inst.Configs = append(inst.Configs, config.Clone())
+	}
+	inst.DedicatedIp = orig.DedicatedIp
+	inst.IsStandalone = orig.IsStandalone
+	inst.Replicas = orig.Replicas
+	inst.PowerState = orig.PowerState
+	inst.Annotations = map[string]string{}
+	for k, v := range orig.Annotations {
+		if _, skip := moveSkipAnnotations[k]; skip {
+			continue
+		}
+		inst.Annotations[k] = v
+	}
+	if len(orig.Tags) > 0 {
+		inst.Tags = map[string]string{}
+		for k, v := range orig.Tags {
+			inst.Tags[k] = v
+		}
+	}
+	return inst
+}
+
+// setMovedFQDN marks the recreated AppInst of a move to keep the
+// FQDN of the original AppInst. Only FQDNs specific to the AppInst
+// can be kept, FQDNs of shared cloudlet or cluster load balancers
+// belong to the load balancer. The FQDN's DNS label stays reserved
+// on the cloudlet it was allocated on, to avoid it being given to
+// another AppInst.
+func (s *AppInstApi) setMovedFQDN(orig, inst *edgeproto.AppInst) bool {
+	if _, _, fqdn, ok := getMovedFQDN(orig); ok && fqdn == orig.Uri {
+		// keep the FQDN from a previous move
+		inst.Annotations[cloudcommon.AnnotationMovedFQDN] = orig.Annotations[cloudcommon.AnnotationMovedFQDN]
+		inst.Annotations[cloudcommon.AnnotationMovedFQDNCloudlet] = orig.Annotations[cloudcommon.AnnotationMovedFQDNCloudlet]
+		return true
+	}
+	cloudlet := edgeproto.Cloudlet{}
+	if orig.Uri == "" || !s.all.cloudletApi.cache.Get(&orig.CloudletKey, &cloudlet) {
+		return false
+	}
+	if orig.Uri != getAppInstFQDN(orig, &cloudlet) {
+		return false
+	}
+	inst.Annotations[cloudcommon.AnnotationMovedFQDN] = orig.Uri
+	inst.Annotations[cloudcommon.AnnotationMovedFQDNCloudlet] = orig.CloudletKey.GetKeyString()
+	return true
+}
+
+// getMovedFQDN gets the FQDN kept by a move, and the cloudlet and
+// DNS label the FQDN was allocated from.
+func getMovedFQDN(inst *edgeproto.AppInst) (*edgeproto.CloudletKey, string, string, bool) {
+	fqdn, ok := inst.Annotations[cloudcommon.AnnotationMovedFQDN]
+	if !ok {
+		return nil, "", "", false
+	}
+	cloudletKey := edgeproto.CloudletKey{}
+	if err := json.Unmarshal([]byte(inst.Annotations[cloudcommon.AnnotationMovedFQDNCloudlet]), &cloudletKey); err != nil {
+		return nil, "", "", false
+	}
+	label, _, _ := strings.Cut(fqdn, ".")
+	return &cloudletKey, label, fqdn, true
+}
+
+// keepMovedFQDN sets the URI of an AppInst recreated by a move to
+// the FQDN it had before the move, and reserves the FQDN's DNS label.
+// It is called when the AppInst's URI is set on create.
+func (s *AppInstApi) keepMovedFQDN(stm concurrency.STM, in *edgeproto.AppInst, cloudlet *edgeproto.Cloudlet) error {
+	cloudletKey, label, fqdn, ok := getMovedFQDN(in)
+	if !ok {
+		return nil
+	}
+	if in.Uri != getAppInstFQDN(in, cloudlet) || (cloudletKey.Matches(&in.CloudletKey) && label == in.DnsLabel) {
+		// AppInst uses a shared FQDN on the target, or it is back
+		// on the original cloudlet with the same FQDN.
+		delete(in.Annotations, cloudcommon.AnnotationMovedFQDN)
+		delete(in.Annotations, cloudcommon.AnnotationMovedFQDNCloudlet)
+		return nil
+	}
+	if s.dnsLabelStore.STMHas(stm, cloudletKey, label) {
+		return fmt.Errorf("cannot keep FQDN %s of moved AppInst, it is in use by another instance", fqdn)
+	}
+	s.dnsLabelStore.STMPut(stm, cloudletKey, label)
+	in.Uri = fqdn
+	return nil
+}
+
+// releaseMovedFQDN frees the DNS label reserved for the FQDN kept
+// by a move. It is called when the AppInst is deleted.
+func (s *AppInstApi) releaseMovedFQDN(stm concurrency.STM, inst *edgeproto.AppInst) {
+	cloudletKey, label, fqdn, ok := getMovedFQDN(inst)
+	if ok && inst.Uri == fqdn {
+		s.dnsLabelStore.STMDel(stm, cloudletKey, label)
+	}
+}
+
+// waitForAppInstHealthy waits for the AppInst to be ready and to stay
+// healthy for the soak time. The health check defaults to OK once the
+// AppInst is ready, and is only changed once Shepherd reports the
+// AppInst as down after failed health checks, so the AppInst must stay
+// healthy long enough for Shepherd to have checked it.
+func (s *AppInstApi) waitForAppInstHealthy(ctx context.Context, key *edgeproto.AppInstKey, timeout, soakTime time.Duration) error {
+	changed := make(chan struct{}, 1)
+	cancel := s.cache.WatchKey(key, func(ctx context.Context) {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	})
+	defer cancel()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	var soakTimer *time.Timer
+	defer func() {
+		if soakTimer != nil {
+			soakTimer.Stop()
+		}
+	}()
+	for {
+		inst := edgeproto.AppInst{}
+		if !s.cache.Get(key, &inst) {
+			return key.NotFoundError()
+		}
+		healthy := inst.State == edgeproto.TrackedState_READY && inst.HealthCheck == dme.HealthCheck_HEALTH_CHECK_OK
+		if !healthy && soakTimer != nil {
+			soakTimer.Stop()
+			soakTimer = nil
+		} else if healthy && soakTimer == nil {
+			soakTimer = time.NewTimer(soakTime)
+		}
+		var soakDone <-chan time.Time
+		if soakTimer != nil {
+			soakDone = soakTimer.C
+		}
+		select {
+		case <-changed:
+		case <-soakDone:
+			// confirm that no change raced with the timer
+			if s.cache.Get(key, &inst) && inst.State == edgeproto.TrackedState_READY && inst.HealthCheck == dme.HealthCheck_HEALTH_CHECK_OK {
+				return nil
+			}
+			soakTimer = nil
+		case <-timer.C:
+			return fmt.Errorf("timed out after %s waiting for AppInst %s to pass health checks, last health check state %s", timeout.String(), key.Name, inst.HealthCheck.String())
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// EvacuateCloudlet moves all AppInsts off of the cloudlet, typically
+// in preparation for maintenance or decommissioning. Each AppInst is
+// moved the same way as MoveAppInst, one at a time.
+func (s *CloudletApi) EvacuateCloudlet(in *edgeproto.CloudletEvacuate, inCb edgeproto.CloudletApi_EvacuateCloudletServer) error {
+	ctx := inCb.Context()
+	cctx := DefCallContext()
+
+	if err := in.Key.ValidateKey(); err != nil {
+		return err
+	}
+	if !s.cache.HasKey(&in.Key) {
+		return in.Key.NotFoundError()
+	}
+
+	insts := []edgeproto.AppInst{}
+	filter := edgeproto.AppInst{
+		CloudletKey: in.Key,
+	}
+	err := s.all.appInstApi.cache.Show(&filter, func(obj *edgeproto.AppInst) error {
+		insts = append(insts, *obj)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sort.Slice(insts, func(i, j int) bool {
+		return insts[i].Key.GetKeyString() < insts[j].Key.GetKeyString()
+	})
+
+	moved := 0
+	failed := 0
+	for _, inst := range insts {
+		app := edgeproto.App{}
+		if s.all.appApi.cache.Get(&inst.AppKey, &app) && cloudcommon.IsSideCarApp(&app) {
+			continue
+		}
+		if inst.State != edgeproto.TrackedState_READY {
+			inCb.Send(&edgeproto.Result{Message: fmt.Sprintf("Skipping AppInst %s in state %s", inst.Key.Name, inst.State.String())})
+			failed++
+			continue
+		}
+		move := edgeproto.AppInstMove{
+			Key:                inst.Key,
+			TargetZoneKey:      in.TargetZoneKey,
+			HealthCheckTimeout: in.HealthCheckTimeout,
+		}
+		err := s.all.appInstApi.moveAppInst(cctx, &move, inCb)
+		if err != nil {
+			log.SpanLog(ctx, log.DebugLevelApi, "failed to move AppInst", "appinst", inst.Key, "err", err)
+			inCb.Send(&edgeproto.Result{Message: fmt.Sprintf("Failed to move AppInst %s, %v", inst.Key.Name, err)})
+			failed++
+			continue
+		}
+		moved++
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to move %d of %d AppInsts off of cloudlet %s", failed, moved+failed, in.Key.Name)
+	}
+	inCb.Send(&edgeproto.Result{Message: fmt.Sprintf("Moved %d AppInsts off of cloudlet %s", moved, in.Key.Name)})
+	return nil
+}
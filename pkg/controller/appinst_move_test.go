@@ -0,0 +1,199 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/ccrmdummy"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/test/testutil"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+func testAppInstMove(t *testing.T, ctx context.Context, apis *AllApis, responder *DummyInfoResponder, ccrm *ccrmdummy.CCRMDummy) {
+	zone, cloudlets, _, cleanup := testPotentialCloudletsCreateDeps(t, ctx, apis)
+	defer cleanup()
+	// AppInsts with a dedicated IP get their own FQDN
+	fakeFeatures := edgeproto.PlatformFeatures{}
+	fakeFeaturesKey := edgeproto.PlatformFeaturesKey(cloudlets[0].PlatformType)
+	require.True(t, apis.platformFeaturesApi.cache.Get(&fakeFeaturesKey, &fakeFeatures))
+	features := fakeFeatures
+	features.SupportsAppInstDedicatedIp = true
+	apis.platformFeaturesApi.Update(ctx, &features, 0)
+	defer apis.platformFeaturesApi.Update(ctx, &fakeFeatures, 0)
+	for ii, cloudlet := range cloudlets {
+		cloudlet.DnsLabel = fmt.Sprintf("c%d-pcorg", ii)
+		_, err := apis.cloudletApi.store.Put(ctx, cloudlet, apis.cloudletApi.sync.SyncWait)
+		require.Nil(t, err)
+	}
+
+	app := edgeproto.App{
+		Key: edgeproto.AppKey{
+			Organization: "mvdev",
+			Name:         "mvapp",
+			Version:      "1.0",
+		},
+		ImageType:   edgeproto.ImageType_IMAGE_TYPE_DOCKER,
+		AccessPorts: "tcp:443",
+		KubernetesResources: &edgeproto.KubernetesResources{
+			CpuPool: &edgeproto.NodePoolResources{
+				TotalVcpus:  *edgeproto.NewUdec64(1, 0),
+				TotalMemory: 1024,
+			},
+		},
+	}
+	_, err := apis.appApi.CreateApp(ctx, &app)
+	require.Nil(t, err)
+	defer func() {
+		apis.appApi.DeleteApp(ctx, &app)
+	}()
+
+	ai := &edgeproto.AppInst{}
+	ai.Key.Name = "mvappinst"
+	ai.Key.Organization = app.Key.Organization
+	ai.AppKey = app.Key
+	ai.ZoneKey = zone.Key
+	ai.DedicatedIp = true
+	err = apis.appInstApi.CreateAppInst(ai, testutil.NewCudStreamoutAppInst(ctx))
+	require.Nil(t, err)
+	orig := edgeproto.AppInst{}
+	require.True(t, apis.appInstApi.cache.Get(&ai.Key, &orig))
+	require.Equal(t, cloudlets[0].Key, orig.CloudletKey)
+	require.Equal(t, getAppInstFQDN(&orig, cloudlets[0]), orig.Uri)
+
+	// negative tests
+	move := edgeproto.AppInstMove{}
+	move.Key = ai.Key
+	move.Key.Name = "nosuchappinst"
+	err = apis.appInstApi.MoveAppInst(&move, testutil.NewCudStreamoutAppInst(ctx))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "not found")
+
+	move.Key = ai.Key
+	move.TargetCloudletKey = cloudlets[0].Key
+	err = apis.appInstApi.MoveAppInst(&move, testutil.NewCudStreamoutAppInst(ctx))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "already on cloudlet")
+
+	// health checks are given time to detect an unhealthy AppInst
+	settings := *apis.settingsApi.Get()
+	defaultSettings := settings
+	move.HealthCheckTimeout = edgeproto.Duration(time.Second)
+	err = apis.appInstApi.MoveAppInst(&move, testutil.NewCudStreamoutAppInst(ctx))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "must be longer than the time")
+	settings.ShepherdHealthCheckInterval = edgeproto.Duration(10 * time.Millisecond)
+	settings.Fields = []string{edgeproto.SettingsFieldShepherdHealthCheckInterval}
+	_, err = apis.settingsApi.UpdateSettings(ctx, &settings)
+	require.Nil(t, err)
+	defer func() {
+		defaultSettings.Fields = []string{edgeproto.SettingsFieldShepherdHealthCheckInterval}
+		apis.settingsApi.UpdateSettings(ctx, &defaultSettings)
+	}()
+
+	// replacement fails to create, original is kept
+	responder.SetSimulateAppCreateFailure(true)
+	ccrm.SetSimulateAppCreateFailure(true)
+	move.TargetCloudletKey = edgeproto.CloudletKey{}
+	err = apis.appInstApi.MoveAppInst(&move, testutil.NewCudStreamoutAppInst(ctx))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "failed to create replacement")
+	responder.SetSimulateAppCreateFailure(false)
+	ccrm.SetSimulateAppCreateFailure(false)
+	tmpKey := getMoveTmpAppInstKey(&ai.Key)
+	require.False(t, apis.appInstApi.cache.HasKey(&tmpKey))
+	require.True(t, apis.appInstApi.cache.HasKey(&ai.Key))
+
+	// unhealthy instance times out waiting for health checks
+	soakTime := 10 * time.Millisecond
+	apis.appInstApi.HealthCheckUpdate(ctx, &ai.Key, dme.HealthCheck_HEALTH_CHECK_SERVER_FAIL)
+	err = apis.appInstApi.waitForAppInstHealthy(ctx, &ai.Key, 100*time.Millisecond, soakTime)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "timed out")
+	apis.appInstApi.HealthCheckUpdate(ctx, &ai.Key, dme.HealthCheck_HEALTH_CHECK_OK)
+	err = apis.appInstApi.waitForAppInstHealthy(ctx, &ai.Key, 100*time.Millisecond, soakTime)
+	require.Nil(t, err)
+	// instance must stay healthy for the soak time
+	go func() {
+		time.Sleep(soakTime / 2)
+		apis.appInstApi.HealthCheckUpdate(ctx, &ai.Key, dme.HealthCheck_HEALTH_CHECK_SERVER_FAIL)
+	}()
+	err = apis.appInstApi.waitForAppInstHealthy(ctx, &ai.Key, 100*time.Millisecond, soakTime)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "timed out")
+	apis.appInstApi.HealthCheckUpdate(ctx, &ai.Key, dme.HealthCheck_HEALTH_CHECK_OK)
+
+	labelReserved := func() bool {
+		found := false
+		apis.appInstApi.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+			found = apis.cloudletApi.objectDnsLabelStore.STMHas(stm, &orig.CloudletKey, orig.DnsLabel)
+			return nil
+		})
+		return found
+	}
+
+	// move within the zone picks the next best cloudlet, and keeps
+	// the AppInst's key and FQDN.
+	move.HealthCheckTimeout = edgeproto.Duration(5 * time.Second)
+	err = apis.appInstApi.MoveAppInst(&move, testutil.NewCudStreamoutAppInst(ctx))
+	require.Nil(t, err)
+	require.False(t, apis.appInstApi.cache.HasKey(&tmpKey))
+	moved := edgeproto.AppInst{}
+	require.True(t, apis.appInstApi.cache.Get(&ai.Key, &moved))
+	require.Equal(t, cloudlets[1].Key, moved.CloudletKey)
+	require.Equal(t, orig.Uri, moved.Uri)
+	require.Equal(t, orig.Uri, moved.Annotations[cloudcommon.AnnotationMovedFQDN])
+	require.True(t, labelReserved())
+
+	// FQDN label stays reserved on the original cloudlet
+	other := edgeproto.AppInst{}
+	other.Key = ai.Key
+	other.CloudletKey = orig.CloudletKey
+	require.Nil(t, apis.appInstApi.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+		return apis.appInstApi.setDnsLabel(stm, &other)
+	}))
+	require.NotEqual(t, orig.DnsLabel, other.DnsLabel)
+
+	// explicit move to a specific cloudlet keeps the FQDN
+	move.TargetCloudletKey = cloudlets[2].Key
+	err = apis.appInstApi.MoveAppInst(&move, testutil.NewCudStreamoutAppInst(ctx))
+	require.Nil(t, err)
+	require.True(t, apis.appInstApi.cache.Get(&ai.Key, &moved))
+	require.Equal(t, cloudlets[2].Key, moved.CloudletKey)
+	require.Equal(t, orig.Uri, moved.Uri)
+	require.True(t, labelReserved())
+
+	// evacuate the cloudlet
+	evac := edgeproto.CloudletEvacuate{}
+	evac.Key = cloudlets[2].Key
+	evac.HealthCheckTimeout = edgeproto.Duration(5 * time.Second)
+	err = apis.cloudletApi.EvacuateCloudlet(&evac, testutil.NewCudStreamoutCloudlet(ctx))
+	require.Nil(t, err)
+	require.True(t, apis.appInstApi.cache.Get(&ai.Key, &moved))
+	require.NotEqual(t, cloudlets[2].Key, moved.CloudletKey)
+	require.Equal(t, orig.Uri, moved.Uri)
+
+	// cleanup
+	err = apis.appInstApi.DeleteAppInst(&moved, testutil.NewCudStreamoutAppInst(ctx))
+	require.Nil(t, err)
+	require.False(t, labelReserved())
+}
@@ -105,6 +105,7 @@ func (s *AppInstApi) getPotentialCloudlets(ctx context.Context, cctx *CallContex
 			}
 			log.SpanLog(ctx, log.DebugLevelApi, "skipping potential cloudlet from AppInst create", "cloudlet", ckey, "err", err)
 			skipReasons.add(skipReason)
+			cctx.dryRunSkip("cloudlet", ckey.Name, skipReason)
 			continue
 		}
 		log.SpanLog(ctx, log.DebugLevelApi, "adding potential cloudlet for AppInst create", "appInst", in.Key, "cloudlet", ckey)
@@ -263,6 +264,7 @@ func (s *AppInstApi) getPotentialCloudletClusters(ctx context.Context, cctx *Cal
 				// mismatched deployment types or cluster owned by
 				// a different tenant should not be shown.
 				skipReasons.add(skipReason)
+				cctx.dryRunSkip("cluster", key.Name, skipReason)
 			}
 			continue
 		}
@@ -0,0 +1,629 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/regiondata"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+// App rollouts update the App definition up front, then refresh the
+// App's AppInsts to the new revision in waves. AppInsts that have not
+// been refreshed yet keep running the previous revision. After each
+// wave the updated AppInsts are monitored for the soak time, and any
+// failed health checks or firing alerts either pause the rollout, or
+// roll it back. A rollback restores the previous App definition and
+// refreshes all AppInsts that are not on the previous revision.
+//
+// The rollout runs on the Controller that received the API call. If
+// that Controller restarts, the rollout can be continued by pausing
+// and resuming it, or a roll back can be continued by rolling back
+// again. A rollout that is not running on the Controller can also be
+// deleted.
+
+const DefaultAppRolloutWaveSoakTime = time.Minute
+
+var appRolloutCheckInterval = 10 * time.Second
+
+type AppRolloutApi struct {
+	all     *AllApis
+	sync    *regiondata.Sync
+	store   edgeproto.AppRolloutStore
+	cache   edgeproto.AppRolloutCache
+	mux     sync.Mutex
+	running map[edgeproto.AppKey]bool
+	wg      sync.WaitGroup
+}
+
+func NewAppRolloutApi(sync *regiondata.Sync, all *AllApis) *AppRolloutApi {
+	appRolloutApi := AppRolloutApi{}
+	appRolloutApi.all = all
+	appRolloutApi.sync = sync
+	appRolloutApi.store = edgeproto.NewAppRolloutStore(sync.GetKVStore())
+	appRolloutApi.running = make(map[edgeproto.AppKey]bool)
+	edgeproto.InitAppRolloutCache(&appRolloutApi.cache)
+	sync.RegisterCache(&appRolloutApi.cache)
+	return &appRolloutApi
+}
+
+func isAppRolloutActive(state edgeproto.AppRolloutState) bool {
+	return state == edgeproto.AppRolloutState_APP_ROLLOUT_IN_PROGRESS ||
+		state == edgeproto.AppRolloutState_APP_ROLLOUT_PAUSED ||
+		state == edgeproto.AppRolloutState_APP_ROLLOUT_ROLLING_BACK
+}
+
+func (s *AppRolloutApi) CreateAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error) {
+	log.SpanLog(ctx, log.DebugLevelApi, "CreateAppRollout", "app", in.Key)
+	if err := in.Validate(edgeproto.AppRolloutAllFieldsMap); err != nil {
+		return &edgeproto.Result{}, err
+	}
+	if in.App == nil || len(in.App.Fields) == 0 {
+		return &edgeproto.Result{}, errors.New("App fields to update must be specified")
+	}
+	if !revisionUpdateNeeded(edgeproto.MakeFieldMap(in.App.Fields)) {
+		return &edgeproto.Result{}, errors.New("App fields to update do not require App Instances to be updated")
+	}
+	if in.WaveSoakTime == 0 {
+		in.WaveSoakTime = edgeproto.Duration(DefaultAppRolloutWaveSoakTime)
+	}
+
+	prevApp := edgeproto.App{}
+	err := s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+		cur := edgeproto.AppRollout{}
+		if s.store.STMGet(stm, &in.Key, &cur) && isAppRolloutActive(cur.State) {
+			return fmt.Errorf("App rollout for %s is already %s", in.Key.GetKeyString(), edgeproto.AppRolloutState_CamelName[int32(cur.State)])
+		}
+		if !s.all.appApi.store.STMGet(stm, &in.Key, &prevApp) {
+			return in.Key.NotFoundError()
+		}
+		if prevApp.DeletePrepare {
+			return in.Key.BeingDeletedError()
+		}
+		return s.checkZones(stm, in)
+	})
+	if err != nil {
+		return &edgeproto.Result{}, err
+	}
+
+	// update the App definition, AppInsts are not affected until
+	// they are refreshed. The revision must change so that the
+	// rollout can tell updated AppInsts from the rest.
+	update := *in.App
+	update.Key = in.Key
+	update.Fields = append([]string{}, in.App.Fields...)
+	if update.Revision == "" {
+		update.Revision = time.Now().Format("2006-01-02T150405")
+		update.Fields = append(update.Fields, edgeproto.AppFieldRevision)
+	}
+	if update.Revision == prevApp.Revision {
+		return &edgeproto.Result{}, fmt.Errorf("App is already at revision %s, please specify a new revision", prevApp.Revision)
+	}
+	if _, err := s.all.appApi.UpdateApp(ctx, &update); err != nil {
+		return &edgeproto.Result{}, err
+	}
+	app := edgeproto.App{}
+	if !s.all.appApi.cache.Get(&in.Key, &app) {
+		return &edgeproto.Result{}, in.Key.NotFoundError()
+	}
+
+	in.State = edgeproto.AppRolloutState_APP_ROLLOUT_IN_PROGRESS
+	in.Revision = app.Revision
+	in.PreviousApp = &prevApp
+	in.Waves = s.getWaves(in)
+	in.WavesDone = 0
+	in.Errors = nil
+	in.CreatedAt = dme.TimeToTimestamp(time.Now())
+	in.UpdatedAt = in.CreatedAt
+	err = s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+		cur := edgeproto.AppRollout{}
+		if s.store.STMGet(stm, &in.Key, &cur) && isAppRolloutActive(cur.State) {
+			return fmt.Errorf("App rollout for %s is already %s", in.Key.GetKeyString(), edgeproto.AppRolloutState_CamelName[int32(cur.State)])
+		}
+		s.store.STMPut(stm, in)
+		return nil
+	})
+	if err != nil {
+		return &edgeproto.Result{}, err
+	}
+	nodeMgr.Event(ctx, "App rollout started", in.Key.Organization, in.Key.GetTags(), nil, "revision", in.Revision, "waves", fmt.Sprintf("%d", len(in.Waves)))
+	s.startRollout(&in.Key)
+	return &edgeproto.Result{Message: fmt.Sprintf("Rolling out App revision %s to App Instances in %d waves", in.Revision, len(in.Waves))}, nil
+}
+
+func (s *AppRolloutApi) checkZones(stm concurrency.STM, in *edgeproto.AppRollout) error {
+	dups := map[edgeproto.ZoneKey]struct{}{}
+	for _, zoneKey := range in.Zones {
+		if !s.all.zoneApi.store.STMGet(stm, &zoneKey, nil) {
+			return zoneKey.NotFoundError()
+		}
+		if _, found := dups[zoneKey]; found {
+			return fmt.Errorf("duplicate zone %s in list", zoneKey.GetKeyString())
+		}
+		dups[zoneKey] = struct{}{}
+	}
+	return nil
+}
+
+// getWaves divides the App's AppInsts into waves.
+func (s *AppRolloutApi) getWaves(in *edgeproto.AppRollout) []edgeproto.AppRolloutWave {
+	insts := []edgeproto.AppInst{}
+	filter := edgeproto.AppInst{
+		AppKey: in.Key,
+	}
+	s.all.appInstApi.cache.Show(&filter, func(obj *edgeproto.AppInst) error {
+		insts = append(insts, *obj)
+		return nil
+	})
+	sort.Slice(insts, func(i, j int) bool {
+		return insts[i].Key.GetKeyString() < insts[j].Key.GetKeyString()
+	})
+
+	waves := []edgeproto.AppRolloutWave{}
+	if in.WavesByZone {
+		byZone := map[edgeproto.ZoneKey][]edgeproto.AppInstKey{}
+		zones := []edgeproto.ZoneKey{}
+		for _, inst := range insts {
+			if _, found := byZone[inst.ZoneKey]; !found {
+				zones = append(zones, inst.ZoneKey)
+			}
+			byZone[inst.ZoneKey] = append(byZone[inst.ZoneKey], inst.Key)
+		}
+		sort.Slice(zones, func(i, j int) bool {
+			return zones[i].GetKeyString() < zones[j].GetKeyString()
+		})
+		// specified zones go first
+		ordered := []edgeproto.ZoneKey{}
+		for _, zoneKey := range in.Zones {
+			if _, found := byZone[zoneKey]; found {
+				ordered = append(ordered, zoneKey)
+			}
+		}
+		for _, zoneKey := range zones {
+			found := false
+			for _, zk := range in.Zones {
+				if zk.Matches(&zoneKey) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				ordered = append(ordered, zoneKey)
+			}
+		}
+		for _, zoneKey := range ordered {
+			waves = append(waves, edgeproto.AppRolloutWave{
+				AppInsts: byZone[zoneKey],
+				ZoneKey:  zoneKey,
+			})
+		}
+		return waves
+	}
+
+	percentages := in.WavePercentages
+	if len(percentages) == 0 {
+		percentages = []uint32{100}
+	}
+	start := 0
+	for _, pct := range percentages {
+		// round up so every wave updates at least one AppInst
+		end := (len(insts)*int(pct) + 99) / 100
+		if end <= start {
+			continue
+		}
+		wave := edgeproto.AppRolloutWave{}
+		for _, inst := range insts[start:end] {
+			wave.AppInsts = append(wave.AppInsts, inst.Key)
+		}
+		waves = append(waves, wave)
+		start = end
+	}
+	return waves
+}
+
+func (s *AppRolloutApi) DeleteAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error) {
+	err := s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+		cur := edgeproto.AppRollout{}
+		if !s.store.STMGet(stm, &in.Key, &cur) {
+			return in.Key.NotFoundError()
+		}
+		// A rollout left in progress by a restarted Controller is
+		// not running, and can be deleted.
+		if (cur.State == edgeproto.AppRolloutState_APP_ROLLOUT_IN_PROGRESS || cur.State == edgeproto.AppRolloutState_APP_ROLLOUT_ROLLING_BACK) && s.isRunning(&in.Key) {
+			return fmt.Errorf("Cannot delete App rollout while it is %s, pause it or wait for it to finish first", edgeproto.AppRolloutState_CamelName[int32(cur.State)])
+		}
+		s.store.STMDel(stm, &in.Key)
+		return nil
+	})
+	return &edgeproto.Result{}, err
+}
+
+func (s *AppRolloutApi) PauseAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error) {
+	err := s.setState(ctx, &in.Key, edgeproto.AppRolloutState_APP_ROLLOUT_PAUSED, "", edgeproto.AppRolloutState_APP_ROLLOUT_IN_PROGRESS)
+	if err != nil {
+		return &edgeproto.Result{}, err
+	}
+	return &edgeproto.Result{Message: "App rollout will pause after the current wave"}, nil
+}
+
+func (s *AppRolloutApi) ResumeAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error) {
+	err := s.setState(ctx, &in.Key, edgeproto.AppRolloutState_APP_ROLLOUT_IN_PROGRESS, "", edgeproto.AppRolloutState_APP_ROLLOUT_PAUSED)
+	if err != nil {
+		return &edgeproto.Result{}, err
+	}
+	s.startRollout(&in.Key)
+	return &edgeproto.Result{Message: "App rollout resumed"}, nil
+}
+
+func (s *AppRolloutApi) RollbackAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error) {
+	// Rolling back a rollout that is already rolling back continues
+	// a roll back that was interrupted by a Controller restart.
+	err := s.setState(ctx, &in.Key, edgeproto.AppRolloutState_APP_ROLLOUT_ROLLING_BACK, "",
+		edgeproto.AppRolloutState_APP_ROLLOUT_ROLLING_BACK,
+		edgeproto.AppRolloutState_APP_ROLLOUT_IN_PROGRESS,
+		edgeproto.AppRolloutState_APP_ROLLOUT_PAUSED,
+		edgeproto.AppRolloutState_APP_ROLLOUT_COMPLETED,
+		edgeproto.AppRolloutState_APP_ROLLOUT_FAILED)
+	if err != nil {
+		return &edgeproto.Result{}, err
+	}
+	s.startRollout(&in.Key)
+	return &edgeproto.Result{Message: "Rolling back App rollout"}, nil
+}
+
+func (s *AppRolloutApi) ShowAppRollout(in *edgeproto.AppRollout, cb edgeproto.AppRolloutApi_ShowAppRolloutServer) error {
+	err := s.cache.Show(in, func(obj *edgeproto.AppRollout) error {
+		err := cb.Send(obj)
+		return err
+	})
+	return err
+}
+
+// setState changes the rollout state if the current state is one of
+// the allowed states. Any error message is added to the rollout.
+func (s *AppRolloutApi) setState(ctx context.Context, key *edgeproto.AppKey, state edgeproto.AppRolloutState, errMsg string, allowed ...edgeproto.AppRolloutState) error {
+	return s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+		cur := edgeproto.AppRollout{}
+		if !s.store.STMGet(stm, key, &cur) {
+			return key.NotFoundError()
+		}
+		ok := false
+		for _, st := range allowed {
+			if cur.State == st {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return fmt.Errorf("Cannot change App rollout to %s while it is %s", edgeproto.AppRolloutState_CamelName[int32(state)], edgeproto.AppRolloutState_CamelName[int32(cur.State)])
+		}
+		cur.State = state
+		if errMsg != "" {
+			cur.Errors = append(cur.Errors, errMsg)
+		}
+		cur.UpdatedAt = dme.TimeToTimestamp(time.Now())
+		s.store.STMPut(stm, &cur)
+		return nil
+	})
+}
+
+// startRollout runs the rollout in the background if it is not
+// already running. If it is running, it will re-check the rollout
+// state before finishing.
+func (s *AppRolloutApi) startRollout(key *edgeproto.AppKey) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if _, found := s.running[*key]; found {
+		s.running[*key] = true
+		return
+	}
+	s.running[*key] = false
+	s.wg.Add(1)
+	go func(key edgeproto.AppKey) {
+		defer s.wg.Done()
+		span := log.StartSpan(log.DebugLevelApi, "app rollout")
+		log.SetTags(span, key.GetTags())
+		defer span.Finish()
+		ctx := log.ContextWithSpan(context.Background(), span)
+		for {
+			s.runRollout(ctx, &key)
+			s.mux.Lock()
+			if s.running[key] {
+				s.running[key] = false
+				s.mux.Unlock()
+				continue
+			}
+			delete(s.running, key)
+			s.mux.Unlock()
+			return
+		}
+	}(*key)
+}
+
+// isRunning checks if the rollout is running on this Controller.
+func (s *AppRolloutApi) isRunning(key *edgeproto.AppKey) bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	_, found := s.running[*key]
+	return found
+}
+
+// wait waits for any running rollouts to finish or pause.
+func (s *AppRolloutApi) wait() {
+	s.wg.Wait()
+}
+
+func (s *AppRolloutApi) runRollout(ctx context.Context, key *edgeproto.AppKey) {
+	for {
+		rollout := edgeproto.AppRollout{}
+		if !s.store.Get(ctx, key, &rollout) {
+			return
+		}
+		switch rollout.State {
+		case edgeproto.AppRolloutState_APP_ROLLOUT_IN_PROGRESS:
+			s.runWave(ctx, &rollout)
+		case edgeproto.AppRolloutState_APP_ROLLOUT_ROLLING_BACK:
+			s.rollback(ctx, &rollout)
+		default:
+			return
+		}
+	}
+}
+
+// runWave updates the AppInsts of the next wave and monitors them.
+func (s *AppRolloutApi) runWave(ctx context.Context, rollout *edgeproto.AppRollout) {
+	if int(rollout.WavesDone) >= len(rollout.Waves) {
+		s.finishState(ctx, rollout, edgeproto.AppRolloutState_APP_ROLLOUT_COMPLETED, nil)
+		return
+	}
+	waveNum := int(rollout.WavesDone)
+	wave := rollout.Waves[waveNum]
+	log.SpanLog(ctx, log.DebugLevelApi, "app rollout wave", "app", rollout.Key, "wave", waveNum+1, "numWaves", len(rollout.Waves))
+
+	cb := &StreamoutCb{ctx: ctx}
+	var err error
+	for _, instKey := range wave.AppInsts {
+		if !s.all.appInstApi.cache.HasKey(&instKey) {
+			// deleted in the meantime
+			continue
+		}
+		_, err = s.all.appInstApi.refreshAppInstInternal(DefCallContext(), instKey, rollout.Key, cb, false, false, nil)
+		if err != nil {
+			err = fmt.Errorf("wave %d failed to update AppInst %s, %v", waveNum+1, instKey.GetKeyString(), err)
+			break
+		}
+	}
+	if err == nil {
+		err = s.soak(ctx, rollout, waveNum)
+	}
+	if err != nil {
+		s.waveFailed(ctx, rollout, err)
+		return
+	}
+
+	err = s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+		cur := edgeproto.AppRollout{}
+		if !s.store.STMGet(stm, &rollout.Key, &cur) {
+			return nil
+		}
+		if int(cur.WavesDone) != waveNum {
+			return nil
+		}
+		cur.WavesDone++
+		if cur.State == edgeproto.AppRolloutState_APP_ROLLOUT_IN_PROGRESS {
+			if int(cur.WavesDone) == len(cur.Waves) {
+				cur.State = edgeproto.AppRolloutState_APP_ROLLOUT_COMPLETED
+			} else if cur.PauseBetweenWaves {
+				cur.State = edgeproto.AppRolloutState_APP_ROLLOUT_PAUSED
+			}
+		}
+		cur.UpdatedAt = dme.TimeToTimestamp(time.Now())
+		s.store.STMPut(stm, &cur)
+		*rollout = cur
+		return nil
+	})
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelApi, "failed to update app rollout wave", "app", rollout.Key, "err", err)
+		return
+	}
+	if rollout.State == edgeproto.AppRolloutState_APP_ROLLOUT_COMPLETED {
+		nodeMgr.Event(ctx, "App rollout completed", rollout.Key.Organization, rollout.Key.GetTags(), nil, "revision", rollout.Revision)
+	}
+}
+
+// soak monitors the AppInsts updated so far for the soak time.
+// It returns early if the rollout is changed to roll back.
+func (s *AppRolloutApi) soak(ctx context.Context, rollout *edgeproto.AppRollout, waveNum int) error {
+	deadline := time.Now().Add(rollout.WaveSoakTime.TimeDuration())
+	for {
+		if err := s.checkWaves(rollout, waveNum); err != nil {
+			return err
+		}
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil
+		}
+		if remaining > appRolloutCheckInterval {
+			remaining = appRolloutCheckInterval
+		}
+		time.Sleep(remaining)
+		cur := edgeproto.AppRollout{}
+		if !s.cache.Get(&rollout.Key, &cur) || cur.State == edgeproto.AppRolloutState_APP_ROLLOUT_ROLLING_BACK {
+			return nil
+		}
+	}
+}
+
+// checkWaves checks the health and alerts of the AppInsts updated
+// in waves up to and including the given wave.
+func (s *AppRolloutApi) checkWaves(rollout *edgeproto.AppRollout, waveNum int) error {
+	updated := map[edgeproto.AppInstKey]struct{}{}
+	for ii := 0; ii <= waveNum && ii < len(rollout.Waves); ii++ {
+		for _, instKey := range rollout.Waves[ii].AppInsts {
+			inst := edgeproto.AppInst{}
+			if !s.all.appInstApi.cache.Get(&instKey, &inst) {
+				continue
+			}
+			if inst.State != edgeproto.TrackedState_READY {
+				return fmt.Errorf("AppInst %s is %s", instKey.GetKeyString(), inst.State.String())
+			}
+			if inst.HealthCheck != dme.HealthCheck_HEALTH_CHECK_OK {
+				return fmt.Errorf("AppInst %s health check is %s", instKey.GetKeyString(), inst.HealthCheck.String())
+			}
+			updated[instKey] = struct{}{}
+		}
+	}
+	var alertErr error
+	s.all.alertApi.cache.Show(&edgeproto.Alert{}, func(alert *edgeproto.Alert) error {
+		if alert.State != "firing" || !isAppRolloutHealthAlert(alert.Labels) {
+			return nil
+		}
+		instKey := edgeproto.AppInstKey{
+			Name:         alert.Labels[edgeproto.AppInstKeyTagName],
+			Organization: alert.Labels[edgeproto.AppInstKeyTagOrganization],
+		}
+		if _, found := updated[instKey]; found {
+			alertErr = fmt.Errorf("alert %s is firing for AppInst %s", alert.Labels["alertname"], instKey.GetKeyString())
+		}
+		return nil
+	})
+	return alertErr
+}
+
+// isAppRolloutHealthAlert checks if the alert reflects the health of
+// the AppInst. Alerts used internally to trigger auto-provisioning
+// and auto-scaling are expected as load changes, and do not indicate
+// a problem with the new revision.
+func isAppRolloutHealthAlert(labels map[string]string) bool {
+	switch labels["alertname"] {
+	case cloudcommon.AlertAutoScaleUp,
+		cloudcommon.AlertAutoScaleDown,
+		cloudcommon.AlertClusterAutoScale,
+		cloudcommon.AlertAppInstAutoScale,
+		cloudcommon.AlertAutoUndeploy:
+		return false
+	case cloudcommon.AlertAppInstDown:
+		return true
+	}
+	return labels[cloudcommon.AlertScopeTypeTag] == cloudcommon.AlertScopeApp ||
+		labels[cloudcommon.AlertTypeLabel] == cloudcommon.AlertTypeUserDefined
+}
+
+// waveFailed either pauses the rollout or rolls it back.
+func (s *AppRolloutApi) waveFailed(ctx context.Context, rollout *edgeproto.AppRollout, waveErr error) {
+	log.SpanLog(ctx, log.DebugLevelApi, "app rollout wave failed", "app", rollout.Key, "err", waveErr)
+	state := edgeproto.AppRolloutState_APP_ROLLOUT_PAUSED
+	if rollout.AutoRollback {
+		state = edgeproto.AppRolloutState_APP_ROLLOUT_ROLLING_BACK
+	}
+	err := s.setState(ctx, &rollout.Key, state, waveErr.Error(),
+		edgeproto.AppRolloutState_APP_ROLLOUT_IN_PROGRESS,
+		edgeproto.AppRolloutState_APP_ROLLOUT_PAUSED)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelApi, "failed to update app rollout state", "app", rollout.Key, "err", err)
+	}
+	nodeMgr.Event(ctx, "App rollout wave failed", rollout.Key.Organization, rollout.Key.GetTags(), waveErr, "revision", rollout.Revision, "rollback", fmt.Sprintf("%t", rollout.AutoRollback))
+}
+
+// rollback restores the previous App definition and refreshes the
+// AppInsts back to the previous revision.
+func (s *AppRolloutApi) rollback(ctx context.Context, rollout *edgeproto.AppRollout) {
+	if rollout.PreviousApp == nil {
+		s.finishState(ctx, rollout, edgeproto.AppRolloutState_APP_ROLLOUT_FAILED, errors.New("previous App definition not found"))
+		return
+	}
+	prevApp := rollout.PreviousApp
+	err := s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+		cur := edgeproto.App{}
+		if !s.all.appApi.store.STMGet(stm, &rollout.Key, &cur) {
+			return rollout.Key.NotFoundError()
+		}
+		if cur.Revision == prevApp.Revision {
+			// already restored
+			return nil
+		}
+		if cur.Revision != rollout.Revision {
+			return fmt.Errorf("App was updated to revision %s after the rollout started", cur.Revision)
+		}
+		restored := *prevApp
+		restored.DeletePrepare = cur.DeletePrepare
+		restored.UpdatedAt = dme.TimeToTimestamp(time.Now())
+		s.all.appApi.store.STMPut(stm, &restored)
+		return nil
+	})
+	if err != nil {
+		s.finishState(ctx, rollout, edgeproto.AppRolloutState_APP_ROLLOUT_FAILED, fmt.Errorf("failed to restore previous App definition, %v", err))
+		return
+	}
+
+	keys := []edgeproto.AppInstKey{}
+	filter := edgeproto.AppInst{
+		AppKey: rollout.Key,
+	}
+	s.all.appInstApi.cache.Show(&filter, func(obj *edgeproto.AppInst) error {
+		if obj.Revision != prevApp.Revision {
+			keys = append(keys, obj.Key)
+		}
+		return nil
+	})
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].GetKeyString() < keys[j].GetKeyString()
+	})
+	cb := &StreamoutCb{ctx: ctx}
+	var failed error
+	for _, instKey := range keys {
+		_, err := s.all.appInstApi.refreshAppInstInternal(DefCallContext(), instKey, rollout.Key, cb, false, false, nil)
+		if err != nil {
+			log.SpanLog(ctx, log.DebugLevelApi, "failed to roll back AppInst", "appinst", instKey, "err", err)
+			failed = fmt.Errorf("failed to roll back AppInst %s, %v", instKey.GetKeyString(), err)
+		}
+	}
+	if failed != nil {
+		s.finishState(ctx, rollout, edgeproto.AppRolloutState_APP_ROLLOUT_FAILED, failed)
+		return
+	}
+	s.finishState(ctx, rollout, edgeproto.AppRolloutState_APP_ROLLOUT_ROLLED_BACK, nil)
+	nodeMgr.Event(ctx, "App rollout rolled back", rollout.Key.Organization, rollout.Key.GetTags(), nil, "revision", prevApp.Revision)
+}
+
+// finishState sets the final state of a rollout.
+func (s *AppRolloutApi) finishState(ctx context.Context, rollout *edgeproto.AppRollout, state edgeproto.AppRolloutState, finishErr error) {
+	err := s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+		cur := edgeproto.AppRollout{}
+		if !s.store.STMGet(stm, &rollout.Key, &cur) {
+			return nil
+		}
+		cur.State = state
+		if finishErr != nil {
+			cur.Errors = append(cur.Errors, finishErr.Error())
+		}
+		cur.UpdatedAt = dme.TimeToTimestamp(time.Now())
+		s.store.STMPut(stm, &cur)
+		return nil
+	})
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelApi, "failed to update app rollout state", "app", rollout.Key, "state", state, "err", err)
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func testAppRollout(t *testing.T, ctx context.Context, apis *AllApis) {
+	zone, _, _, cleanup := testPotentialCloudletsCreateDeps(t, ctx, apis)
+	defer cleanup()
+
+	defer func(interval time.Duration) {
+		appRolloutCheckInterval = interval
+	}(appRolloutCheckInterval)
+	appRolloutCheckInterval = 10 * time.Millisecond
+
+	app := edgeproto.App{
+		Key: edgeproto.AppKey{
+			Organization: "rodev",
+			Name:         "roapp",
+			Version:      "1.0",
+		},
+		ImageType:   edgeproto.ImageType_IMAGE_TYPE_DOCKER,
+		AccessPorts: "tcp:443",
+		KubernetesResources: &edgeproto.KubernetesResources{
+			CpuPool: &edgeproto.NodePoolResources{
+				TotalVcpus:  *edgeproto.NewUdec64(1, 0),
+				TotalMemory: 1024,
+			},
+		},
+	}
+	_, err := apis.appApi.CreateApp(ctx, &app)
+	require.Nil(t, err)
+	defer func() {
+		apis.appApi.DeleteApp(ctx, &app)
+	}()
+
+	insts := []edgeproto.AppInst{}
+	for ii := 0; ii < 4; ii++ {
+		ai := edgeproto.AppInst{}
+		ai.Key.Name = fmt.Sprintf("roappinst%d", ii)
+		ai.Key.Organization = app.Key.Organization
+		ai.AppKey = app.Key
+		ai.ZoneKey = zone.Key
+		err = apis.appInstApi.CreateAppInst(&ai, testutil.NewCudStreamoutAppInst(ctx))
+		require.Nil(t, err)
+		insts = append(insts, ai)
+	}
+	defer func() {
+		for _, ai := range insts {
+			apis.appInstApi.DeleteAppInst(&ai, testutil.NewCudStreamoutAppInst(ctx))
+		}
+	}()
+	origApp := edgeproto.App{}
+	require.True(t, apis.appApi.cache.Get(&app.Key, &origApp))
+
+	getRollout := func() *edgeproto.AppRollout {
+		rollout := edgeproto.AppRollout{}
+		require.True(t, apis.appRolloutApi.cache.Get(&app.Key, &rollout))
+		return &rollout
+	}
+	countRevision := func(revision string) int {
+		count := 0
+		for _, ai := range insts {
+			inst := edgeproto.AppInst{}
+			require.True(t, apis.appInstApi.cache.Get(&ai.Key, &inst))
+			if inst.Revision == revision {
+				count++
+			}
+		}
+		return count
+	}
+
+	// negative tests
+	rollout := edgeproto.AppRollout{
+		Key: app.Key,
+		App: &edgeproto.App{
+			Command:  "run v2",
+			Revision: "v2",
+			Fields:   []string{edgeproto.AppFieldCommand, edgeproto.AppFieldRevision},
+		},
+	}
+	rollout.WavePercentages = []uint32{50, 25}
+	_, err = apis.appRolloutApi.CreateAppRollout(ctx, &rollout)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "must be increasing")
+	rollout.WavePercentages = []uint32{25, 50}
+	_, err = apis.appRolloutApi.CreateAppRollout(ctx, &rollout)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "must be 100")
+	rollout.WavePercentages = nil
+	rollout.App.Fields = []string{edgeproto.AppFieldAlertPolicies}
+	_, err = apis.appRolloutApi.CreateAppRollout(ctx, &rollout)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "do not require App Instances to be updated")
+	rollout.App.Fields = []string{edgeproto.AppFieldCommand, edgeproto.AppFieldRevision}
+	rollout.Key.Name = "nosuchapp"
+	_, err = apis.appRolloutApi.CreateAppRollout(ctx, &rollout)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "not found")
+	rollout.Key = app.Key
+
+	// rollout in two waves, pausing between waves
+	rollout.WavePercentages = []uint32{25, 100}
+	rollout.WaveSoakTime = edgeproto.Duration(50 * time.Millisecond)
+	rollout.PauseBetweenWaves = true
+	_, err = apis.appRolloutApi.CreateAppRollout(ctx, &rollout)
+	require.Nil(t, err)
+	apis.appRolloutApi.wait()
+	check := getRollout()
+	require.Equal(t, edgeproto.AppRolloutState_APP_ROLLOUT_PAUSED, check.State, check.Errors)
+	require.Equal(t, 2, len(check.Waves))
+	require.Equal(t, 1, len(check.Waves[0].AppInsts))
+	require.Equal(t, 3, len(check.Waves[1].AppInsts))
+	require.Equal(t, int32(1), check.WavesDone)
+	require.Equal(t, 1, countRevision(check.Revision))
+	require.Equal(t, 3, countRevision(origApp.Revision))
+
+	// only one active rollout per App
+	_, err = apis.appRolloutApi.CreateAppRollout(ctx, &rollout)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "already AppRolloutPaused")
+	// App cannot be deleted during a rollout
+	_, err = apis.appApi.DeleteApp(ctx, &app)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "active App rollout")
+
+	_, err = apis.appRolloutApi.ResumeAppRollout(ctx, &rollout)
+	require.Nil(t, err)
+	apis.appRolloutApi.wait()
+	check = getRollout()
+	require.Equal(t, edgeproto.AppRolloutState_APP_ROLLOUT_COMPLETED, check.State, check.Errors)
+	require.Equal(t, int32(2), check.WavesDone)
+	require.Equal(t, 4, countRevision(check.Revision))
+	updatedApp := edgeproto.App{}
+	require.True(t, apis.appApi.cache.Get(&app.Key, &updatedApp))
+	require.Equal(t, "run v2", updatedApp.Command)
+
+	_, err = apis.appRolloutApi.ResumeAppRollout(ctx, &rollout)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "while it is AppRolloutCompleted")
+	_, err = apis.appRolloutApi.CreateAppRollout(ctx, &rollout)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "please specify a new revision")
+
+	// firing alert on an updated AppInst rolls back automatically
+	alert := edgeproto.Alert{}
+	alert.Labels = map[string]string{
+		"alertname":                         "RolloutTestAlert",
+		cloudcommon.AlertScopeTypeTag:       cloudcommon.AlertScopeApp,
+		edgeproto.AppInstKeyTagName:         insts[0].Key.Name,
+		edgeproto.AppInstKeyTagOrganization: insts[0].Key.Organization,
+	}
+	alert.State = "firing"
+	apis.alertApi.Update(ctx, &alert, 0)
+	for ii := 0; ii < 50; ii++ {
+		if apis.alertApi.cache.HasKey(alert.GetKey()) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.True(t, apis.alertApi.cache.HasKey(alert.GetKey()))
+
+	rollout.App = &edgeproto.App{
+		Command:  "run v3",
+		Revision: "v3",
+		Fields:   []string{edgeproto.AppFieldCommand, edgeproto.AppFieldRevision},
+	}
+	rollout.PauseBetweenWaves = false
+	rollout.AutoRollback = true
+	_, err = apis.appRolloutApi.CreateAppRollout(ctx, &rollout)
+	require.Nil(t, err)
+	apis.appRolloutApi.wait()
+	check = getRollout()
+	require.Equal(t, edgeproto.AppRolloutState_APP_ROLLOUT_ROLLED_BACK, check.State, check.Errors)
+	require.Equal(t, int32(0), check.WavesDone)
+	require.Equal(t, 1, len(check.Errors))
+	require.Contains(t, check.Errors[0], "alert RolloutTestAlert is firing")
+	rolledBack := edgeproto.App{}
+	require.True(t, apis.appApi.cache.Get(&app.Key, &rolledBack))
+	require.Equal(t, "run v2", rolledBack.Command)
+	require.Equal(t, updatedApp.Revision, rolledBack.Revision)
+	require.Equal(t, 4, countRevision(updatedApp.Revision))
+	apis.alertApi.Delete(ctx, &alert, 0)
+
+	// a roll back interrupted by a Controller restart can be
+	// continued, or deleted as it is not running
+	setInterrupted := func() {
+		check := getRollout()
+		check.State = edgeproto.AppRolloutState_APP_ROLLOUT_ROLLING_BACK
+		_, err := apis.appRolloutApi.store.Put(ctx, check, apis.appRolloutApi.sync.SyncWait)
+		require.Nil(t, err)
+	}
+	setInterrupted()
+	_, err = apis.appRolloutApi.RollbackAppRollout(ctx, &rollout)
+	require.Nil(t, err)
+	apis.appRolloutApi.wait()
+	check = getRollout()
+	require.Equal(t, edgeproto.AppRolloutState_APP_ROLLOUT_ROLLED_BACK, check.State, check.Errors)
+	setInterrupted()
+	_, err = apis.appRolloutApi.DeleteAppRollout(ctx, &rollout)
+	require.Nil(t, err)
+	require.False(t, apis.appRolloutApi.cache.HasKey(&app.Key))
+	_, err = apis.appRolloutApi.store.Put(ctx, check, apis.appRolloutApi.sync.SyncWait)
+	require.Nil(t, err)
+
+	// finished rollouts can be deleted
+	_, err = apis.appRolloutApi.DeleteAppRollout(ctx, &rollout)
+	require.Nil(t, err)
+	require.False(t, apis.appRolloutApi.cache.HasKey(&app.Key))
+}
+
+func TestIsAppRolloutHealthAlert(t *testing.T) {
+	tests := []struct {
+		labels map[string]string
+		health bool
+	}{{
+		map[string]string{"alertname": "AppAlert", cloudcommon.AlertScopeTypeTag: cloudcommon.AlertScopeApp},
+		true,
+	}, {
+		map[string]string{"alertname": "UserAlert", cloudcommon.AlertTypeLabel: cloudcommon.AlertTypeUserDefined},
+		true,
+	}, {
+		map[string]string{"alertname": cloudcommon.AlertAppInstDown},
+		true,
+	}, {
+		map[string]string{"alertname": cloudcommon.AlertAppInstAutoScale, cloudcommon.AlertScopeTypeTag: cloudcommon.AlertScopeApp},
+		false,
+	}, {
+		map[string]string{"alertname": cloudcommon.AlertAutoUndeploy},
+		false,
+	}, {
+		map[string]string{"alertname": cloudcommon.AlertCloudletDown, cloudcommon.AlertScopeTypeTag: cloudcommon.AlertScopeCloudlet},
+		false,
+	}}
+	for _, test := range tests {
+		require.Equal(t, test.health, isAppRolloutHealthAlert(test.labels), test.labels)
+	}
+}
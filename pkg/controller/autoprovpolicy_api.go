@@ -152,6 +152,15 @@ func (s *AutoProvPolicyApi) ShowAutoProvPolicy(in *edgeproto.AutoProvPolicy, cb
 	return err
 }
 
+func (s *AutoProvPolicyApi) ShowAutoProvPolicyHistory(in *edgeproto.AutoProvPolicy, cb edgeproto.AutoProvPolicyApi_ShowAutoProvPolicyHistoryServer) error {
+	return showObjHistory(cb.Context(), s.all, s.sync, s.cache.GetTypeString(), in, func(rev int64, obj *edgeproto.AutoProvPolicy) error {
+		return cb.Send(&edgeproto.AutoProvPolicyHistory{
+			Revision: rev,
+			Obj:      *obj,
+		})
+	})
+}
+
 func (s *AutoProvPolicyApi) AddAutoProvPolicyZone(ctx context.Context, in *edgeproto.AutoProvPolicyZone) (*edgeproto.Result, error) {
 	err := s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
 		cur := edgeproto.AutoProvPolicy{}
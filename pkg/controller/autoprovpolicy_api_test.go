@@ -30,6 +30,7 @@ import (
 	"github.com/edgexr/edge-cloud-platform/pkg/regiondata"
 	"github.com/edgexr/edge-cloud-platform/test/testutil"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -115,6 +116,8 @@ func TestAutoProvPolicyApi(t *testing.T) {
 	require.True(t, found, "get auto prov policy %v", pc2.Key)
 	require.Equal(t, 0, len(policy.Zones))
 
+	testAutoProvPolicyHistory(t, ctx, apis, &policy, pc.ZoneKey, pc2.ZoneKey)
+
 	// try to add policy for non-existent zone
 	pc.ZoneKey.Name = ""
 	_, err = apis.autoProvPolicyApi.AddAutoProvPolicyZone(ctx, &pc)
@@ -533,3 +536,79 @@ func (s *autoProvPolicyTest) expectAppInsts(t *testing.T, ctx context.Context, a
 	})
 	require.Equal(t, expected, actual, "expected %d insts for policy %s but found %d", expected, s.policy.Key.Name, actual)
 }
+
+type showAutoProvPolicyHistory struct {
+	grpc.ServerStream
+	ctx  context.Context
+	data []edgeproto.AutoProvPolicyHistory
+}
+
+func (x *showAutoProvPolicyHistory) Send(m *edgeproto.AutoProvPolicyHistory) error {
+	x.data = append(x.data, *m)
+	return nil
+}
+
+func (x *showAutoProvPolicyHistory) Context() context.Context {
+	return x.ctx
+}
+
+func testAutoProvPolicyHistory(t *testing.T, ctx context.Context, apis *AllApis, policy *edgeproto.AutoProvPolicy, zone1, zone2 edgeproto.ZoneKey) {
+	showHistory := func(in *edgeproto.AutoProvPolicy) ([]edgeproto.AutoProvPolicyHistory, error) {
+		show := showAutoProvPolicyHistory{ctx: ctx}
+		err := apis.autoProvPolicyApi.ShowAutoProvPolicyHistory(in, &show)
+		return show.data, err
+	}
+
+	// zones were added then removed, newest revision first
+	hist, err := showHistory(policy)
+	require.Nil(t, err)
+	require.GreaterOrEqual(t, len(hist), 5)
+	require.Equal(t, 0, len(hist[0].Obj.Zones))
+	require.Equal(t, []*edgeproto.ZoneKey{&zone2}, hist[1].Obj.Zones)
+	require.Equal(t, []*edgeproto.ZoneKey{&zone1, &zone2}, hist[2].Obj.Zones)
+	require.Equal(t, []*edgeproto.ZoneKey{&zone1}, hist[3].Obj.Zones)
+	for ii := 1; ii < len(hist); ii++ {
+		require.Less(t, hist[ii].Revision, hist[ii-1].Revision)
+		require.Equal(t, policy.Key, hist[ii].Obj.Key)
+	}
+
+	// restore a past revision through the update path
+	restore := hist[2].Obj
+	restore.Fields = []string{edgeproto.AutoProvPolicyFieldZones}
+	_, err = apis.autoProvPolicyApi.UpdateAutoProvPolicy(ctx, &restore)
+	require.Nil(t, err)
+	check := edgeproto.AutoProvPolicy{}
+	require.True(t, apis.autoProvPolicyApi.cache.Get(&policy.Key, &check))
+	require.Equal(t, []*edgeproto.ZoneKey{&zone1, &zone2}, check.Zones)
+
+	// number of revisions is limited by settings
+	settings := *apis.settingsApi.Get()
+	settings.MaxObjectHistoryRevisions = 2
+	settings.Fields = []string{edgeproto.SettingsFieldMaxObjectHistoryRevisions}
+	_, err = apis.settingsApi.UpdateSettings(ctx, &settings)
+	require.Nil(t, err)
+	hist, err = showHistory(policy)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(hist))
+	require.Equal(t, 2, len(hist[0].Obj.Zones))
+	settings.MaxObjectHistoryRevisions = edgeproto.GetDefaultSettings().MaxObjectHistoryRevisions
+	_, err = apis.settingsApi.UpdateSettings(ctx, &settings)
+	require.Nil(t, err)
+
+	// put back the state before the restore
+	restore = hist[1].Obj
+	restore.Fields = []string{edgeproto.AutoProvPolicyFieldZones}
+	_, err = apis.autoProvPolicyApi.UpdateAutoProvPolicy(ctx, &restore)
+	require.Nil(t, err)
+
+	// negative tests
+	missing := edgeproto.AutoProvPolicy{}
+	missing.Key = policy.Key
+	missing.Key.Name = "nosuchpolicy"
+	_, err = showHistory(&missing)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "not found")
+	missing.Key.Name = ""
+	_, err = showHistory(&missing)
+	require.NotNil(t, err)
+}
@@ -117,3 +117,12 @@ func (s *AutoScalePolicyApi) ShowAutoScalePolicy(in *edgeproto.AutoScalePolicy,
 	})
 	return err
 }
+
+func (s *AutoScalePolicyApi) ShowAutoScalePolicyHistory(in *edgeproto.AutoScalePolicy, cb edgeproto.AutoScalePolicyApi_ShowAutoScalePolicyHistoryServer) error {
+	return showObjHistory(cb.Context(), s.all, s.sync, s.cache.GetTypeString(), in, func(rev int64, obj *edgeproto.AutoScalePolicy) error {
+		return cb.Send(&edgeproto.AutoScalePolicyHistory{
+			Revision: rev,
+			Obj:      *obj,
+		})
+	})
+}
@@ -14,7 +14,12 @@
 
 package controller
 
-import "github.com/edgexr/edge-cloud-platform/api/edgeproto"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+)
 
 // Generic caller context
 
@@ -25,8 +30,14 @@ type CallContext struct {
 	AutoCluster            bool
 	SkipCloudletReadyCheck bool
 	StreamObjs             map[string]*streamSend
+	DryRun                 bool
+	DryRunPlan             *DryRunPlan
 }
 
+// errDryRun is returned from the STM apply func of a dry run
+// request to abort the transaction, so nothing gets committed.
+var errDryRun = errors.New("dry run")
+
 func DefCallContext() *CallContext {
 	return &CallContext{}
 }
@@ -86,6 +97,19 @@ func (c *CallContext) SetOverride(o *edgeproto.CRMOverride) {
 	*o = edgeproto.CRMOverride_NO_OVERRIDE
 }
 
+// SetDryRun takes the dry run flag specified by the user,
+// and removes it from the input object. Like the override,
+// dry run only applies to the current operation and must not be
+// persisted on the object.
+func (c *CallContext) SetDryRun(dryRun *bool) {
+	if !*dryRun {
+		return
+	}
+	c.DryRun = true
+	c.DryRunPlan = &DryRunPlan{}
+	*dryRun = false
+}
+
 func (c *CallContext) Clone() *CallContext {
 	clone := *c
 	if c.StreamObjs != nil {
@@ -96,3 +120,59 @@ func (c *CallContext) Clone() *CallContext {
 	}
 	return &clone
 }
+
+// DryRunPlan collects what a create or update would have done,
+// for reporting back to the user on a dry run request.
+type DryRunPlan struct {
+	Skipped []string
+	Details []string
+}
+
+// dryRunSkip records why a potential cloudlet or cluster was
+// not chosen. Reasons that should not be shown to the user,
+// like clusters owned by other tenants, are not recorded.
+func (c *CallContext) dryRunSkip(objType, name string, reason SkipReason) {
+	if !c.DryRun || reason == NoSkipReason {
+		return
+	}
+	c.DryRunPlan.Skipped = append(c.DryRunPlan.Skipped, fmt.Sprintf("skipped %s %s, %s", objType, name, reason))
+}
+
+func (c *CallContext) dryRunDetail(format string, a ...interface{}) {
+	if !c.DryRun {
+		return
+	}
+	c.DryRunPlan.Details = append(c.DryRunPlan.Details, fmt.Sprintf(format, a...))
+}
+
+// dryRunReset clears the plan in case the STM apply func reruns.
+// Skip reasons may be collected outside of the STM, in which
+// case they should not be cleared.
+func (c *CallContext) dryRunReset(skipped bool) {
+	if !c.DryRun {
+		return
+	}
+	if skipped {
+		c.DryRunPlan.Skipped = nil
+	}
+	c.DryRunPlan.Details = nil
+}
+
+// sendDryRunSkipped sends why candidates were skipped, which
+// is useful even if the dry run failed.
+func (c *CallContext) sendDryRunSkipped(send func(*edgeproto.Result) error) {
+	if !c.DryRun {
+		return
+	}
+	for _, msg := range c.DryRunPlan.Skipped {
+		send(&edgeproto.Result{Message: "Dry run: " + msg})
+	}
+}
+
+func (c *CallContext) sendDryRunPlan(send func(*edgeproto.Result) error) {
+	c.sendDryRunSkipped(send)
+	for _, msg := range c.DryRunPlan.Details {
+		send(&edgeproto.Result{Message: "Dry run: " + msg})
+	}
+	send(&edgeproto.Result{Message: "Dry run complete, no changes were made"})
+}
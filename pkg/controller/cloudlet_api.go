@@ -1001,10 +1001,39 @@ func (s *CloudletApi) updateTrustPolicyInternal(ctx context.Context, ckey *edgep
 	return nil
 }
 
+// addUpdateDryRunDetails adds the changes the cloudlet update would
+// have made to the dry run plan.
+func (s *CloudletApi) addUpdateDryRunDetails(cctx *CallContext, diffFields *edgeproto.FieldMap, maintenanceChanged bool, maintenanceState dme.MaintenanceState, crmUpdateReqd, trustPolicyChanged, defaultMTClusterChanged bool) {
+	changed := []string{}
+	for _, field := range diffFields.Fields() {
+		if name, ok := edgeproto.CloudletAllFieldsStringMap[field]; ok {
+			changed = append(changed, name)
+		}
+	}
+	if len(changed) > 0 {
+		cctx.dryRunDetail("changed fields would be %s", strings.Join(changed, ", "))
+	} else {
+		cctx.dryRunDetail("no fields would be changed")
+	}
+	if maintenanceChanged {
+		cctx.dryRunDetail("maintenance state would change to %s", maintenanceState.String())
+	}
+	if crmUpdateReqd && !ignoreCRM(cctx) {
+		cctx.dryRunDetail("cloudlet services would be updated by the CRM")
+	}
+	if trustPolicyChanged && !ignoreCRM(cctx) {
+		cctx.dryRunDetail("trust policy would be updated by the CRM")
+	}
+	if defaultMTClusterChanged {
+		cctx.dryRunDetail("default multi-tenant cluster would be updated")
+	}
+}
+
 func (s *CloudletApi) UpdateCloudlet(in *edgeproto.Cloudlet, inCb edgeproto.CloudletApi_UpdateCloudletServer) (reterr error) {
 	ctx := inCb.Context()
 	cctx := DefCallContext()
 	cctx.SetOverride(&in.CrmOverride)
+	cctx.SetDryRun(&in.DryRun)
 
 	if err := validateAllianceOrgs(ctx, in); err != nil {
 		return err
@@ -1069,8 +1098,9 @@ func (s *CloudletApi) UpdateCloudlet(in *edgeproto.Cloudlet, inCb edgeproto.Clou
 		in.KafkaCluster = ""
 		in.KafkaUser = ""
 		in.KafkaPassword = ""
-		client, err := vaultConfig.Login()
-		if err == nil {
+		if cctx.DryRun {
+			cctx.dryRunDetail("kafka credentials would be removed")
+		} else if client, err := vaultConfig.Login(); err == nil {
 			vault.DeleteKV(client, svcnode.GetKafkaVaultPath(*region, in.Key.Name, in.Key.Organization))
 		} else {
 			log.SpanLog(ctx, log.DebugLevelApi, "Failed to login in to vault to delete kafka credentials", "err", err)
@@ -1097,9 +1127,13 @@ func (s *CloudletApi) UpdateCloudlet(in *edgeproto.Cloudlet, inCb edgeproto.Clou
 			return errors.New("Please also specify endpoint when changing username and password")
 		}
 		// write back changes
-		err = vault.PutData(vaultConfig, path, kafkaCreds)
-		if err != nil {
-			return fmt.Errorf("Unable to store kafka details: %s", err)
+		if cctx.DryRun {
+			cctx.dryRunDetail("kafka credentials would be updated")
+		} else {
+			err = vault.PutData(vaultConfig, path, kafkaCreds)
+			if err != nil {
+				return fmt.Errorf("Unable to store kafka details: %s", err)
+			}
 		}
 	}
 	in.KafkaUser = ""
@@ -1118,14 +1152,20 @@ func (s *CloudletApi) UpdateCloudlet(in *edgeproto.Cloudlet, inCb edgeproto.Clou
 		return err
 	}
 	if fmap.HasOrHasChild(edgeproto.CloudletFieldAccessVars) {
-		err = accessvars.UpdateCloudletAccessVars(ctx, *region, in, nodeMgr.VaultConfig, accessVars, features.AccessVars)
-		if err != nil {
-			return err
+		if cctx.DryRun {
+			cctx.dryRunDetail("access vars would be updated")
+		} else {
+			err = accessvars.UpdateCloudletAccessVars(ctx, *region, in, nodeMgr.VaultConfig, accessVars, features.AccessVars)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
 	var newMaintenanceState dme.MaintenanceState
 	maintenanceChanged := false
+	maintenanceWindowScheduled := false
+	maintenanceWindowCancelled := false
 	privPolUpdateRequested := fmap.Has(edgeproto.CloudletFieldTrustPolicy)
 	updateDefaultMultiTenantCluster := false
 	var diffFields *edgeproto.FieldMap
@@ -1134,6 +1174,8 @@ func (s *CloudletApi) UpdateCloudlet(in *edgeproto.Cloudlet, inCb edgeproto.Clou
 	var oldZone string
 	modRev, err := s.sync.ApplySTMWaitRev(ctx, func(stm concurrency.STM) error {
 		updateDefaultMultiTenantCluster = false
+		maintenanceWindowScheduled = false
+		maintenanceWindowCancelled = false
 		diffFields = edgeproto.NewFieldMap(nil)
 
 		if !s.store.STMGet(stm, &in.Key, cur) {
@@ -1221,6 +1263,37 @@ func (s *CloudletApi) UpdateCloudlet(in *edgeproto.Cloudlet, inCb edgeproto.Clou
 			if old.MaintenanceState != dme.MaintenanceState_NORMAL_OPERATION {
 				return fmt.Errorf("Cloudlet must be in NormalOperation before starting maintenance")
 			}
+			if old.MaintenanceWindow != nil {
+				return fmt.Errorf("Cannot start maintenance while a maintenance window is scheduled, set NormalOperation to cancel the maintenance window")
+			}
+		}
+		if fmap.HasOrHasChild(edgeproto.CloudletFieldMaintenanceWindow) {
+			if maintenanceChanged {
+				return fmt.Errorf("Cannot change both maintenance state and maintenance window at the same time")
+			}
+			if old.MaintenanceWindow != nil && old.MaintenanceWindow.State != edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_SCHEDULED {
+				return fmt.Errorf("Cannot change maintenance window after it has started, set NormalOperation to cancel the maintenance window")
+			}
+			if cur.MaintenanceWindow != nil {
+				if old.MaintenanceState != dme.MaintenanceState_NORMAL_OPERATION {
+					return fmt.Errorf("Cloudlet must be in NormalOperation to schedule maintenance")
+				}
+				if err := cur.MaintenanceWindow.Validate(); err != nil {
+					return err
+				}
+				if !dme.TimestampToTime(cur.MaintenanceWindow.EndTime).After(time.Now()) {
+					return fmt.Errorf("Maintenance window end time must be in the future")
+				}
+				cur.MaintenanceWindow.State = edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_SCHEDULED
+				maintenanceWindowScheduled = true
+			} else if old.MaintenanceWindow != nil {
+				maintenanceWindowCancelled = true
+			}
+		} else if fmap.Has(edgeproto.CloudletFieldMaintenanceState) && newMaintenanceState == dme.MaintenanceState_NORMAL_OPERATION && cur.MaintenanceWindow != nil {
+			// returning to normal operation cancels the maintenance window
+			cur.MaintenanceWindow = nil
+			diffFields.Set(edgeproto.CloudletFieldMaintenanceWindow)
+			maintenanceWindowCancelled = true
 		}
 		if privPolUpdateRequested {
 			if maintenanceChanged {
@@ -1273,11 +1346,20 @@ func (s *CloudletApi) UpdateCloudlet(in *edgeproto.Cloudlet, inCb edgeproto.Clou
 				}
 			}
 		}
+		if cctx.DryRun {
+			// all checks passed, abort before committing any changes
+			return errDryRun
+		}
 		cur.UpdatedAt = dme.TimeToTimestamp(time.Now())
 		s.store.STMPut(stm, cur)
 		return nil
 	})
 
+	if err == errDryRun {
+		s.addUpdateDryRunDetails(cctx, diffFields, maintenanceChanged, newMaintenanceState, crmUpdateReqd, privPolUpdateRequested, updateDefaultMultiTenantCluster)
+		cctx.sendDryRunPlan(cb.Send)
+		return nil
+	}
 	if err != nil {
 		return err
 	}
@@ -1396,6 +1478,13 @@ func (s *CloudletApi) UpdateCloudlet(in *edgeproto.Cloudlet, inCb edgeproto.Clou
 		s.updateZoneForCloudlet(ctx, &in.Key)
 		s.updateZoneLocation(ctx, in.Key.Organization, oldZone, cur.Zone)
 	}
+	if maintenanceWindowScheduled {
+		mw := cur.MaintenanceWindow
+		nodeMgr.Event(ctx, "Cloudlet maintenance window scheduled", in.Key.Organization, in.Key.GetTags(), nil, "start", dme.TimestampToTime(mw.StartTime).Format(time.RFC3339), "end", dme.TimestampToTime(mw.EndTime).Format(time.RFC3339))
+	}
+	if maintenanceWindowCancelled {
+		nodeMgr.Event(ctx, "Cloudlet maintenance window cancelled", in.Key.Organization, in.Key.GetTags(), nil)
+	}
 
 	// since default maintenance state is NORMAL_OPERATION, it is better to check
 	// if the field is set before handling maintenance state
@@ -1408,119 +1497,141 @@ func (s *CloudletApi) UpdateCloudlet(in *edgeproto.Cloudlet, inCb edgeproto.Clou
 
 	switch newMaintenanceState {
 	case dme.MaintenanceState_NORMAL_OPERATION:
-		log.SpanLog(ctx, log.DebugLevelApi, "Stop CRM maintenance")
-		if !ignoreCRMState(cctx) {
-			timeout := s.all.settingsApi.Get().CloudletMaintenanceTimeout.TimeDuration()
-			err = s.setMaintenanceState(ctx, &in.Key, dme.MaintenanceState_NORMAL_OPERATION_INIT, reqCtx, nodeType)
-			if err != nil {
-				return err
-			}
-			cloudletInfo := edgeproto.CloudletInfo{}
-			err = s.all.cloudletInfoApi.waitForMaintenanceState(ctx, &in.Key, dme.MaintenanceState_NORMAL_OPERATION, dme.MaintenanceState_CRM_ERROR, timeout, &cloudletInfo)
-			if err != nil {
-				return err
-			}
-			if cloudletInfo.MaintenanceState == dme.MaintenanceState_CRM_ERROR {
-				return fmt.Errorf("CRM encountered some errors, aborting")
-			}
-		}
-		err = s.setMaintenanceState(ctx, &in.Key, dme.MaintenanceState_NORMAL_OPERATION, reqCtx, nodeType)
-		if err != nil {
-			return err
-		}
-		cb.Send(&edgeproto.Result{Message: "Cloudlet is back to normal operation"})
+		return s.stopMaintenance(ctx, cctx, &in.Key, reqCtx, nodeType, cb.Send)
 	case dme.MaintenanceState_MAINTENANCE_START:
 		// This is a state machine to transition into cloudlet
 		// maintenance. Start by triggering AutoProv failovers.
-		log.SpanLog(ctx, log.DebugLevelApi, "Start AutoProv failover")
-		timeout := s.all.settingsApi.Get().CloudletMaintenanceTimeout.TimeDuration()
-		err := cb.Send(&edgeproto.Result{
-			Message: "Starting AutoProv failover",
-		})
+		err := s.startAutoProvFailover(ctx, &in.Key, reqCtx, nodeType, cb.Send)
 		if err != nil {
 			return err
 		}
-		autoProvInfo := edgeproto.AutoProvInfo{}
-		// first reset any old AutoProvInfo
-		autoProvInfo = edgeproto.AutoProvInfo{
-			Key:              in.Key,
-			MaintenanceState: dme.MaintenanceState_NORMAL_OPERATION,
-		}
-		s.all.autoProvInfoApi.Update(ctx, &autoProvInfo, 0)
+		// proceed to next state
+		return s.startCRMMaintenance(ctx, cctx, &in.Key, reqCtx, nodeType, cb.Send)
+	case dme.MaintenanceState_MAINTENANCE_START_NO_FAILOVER:
+		return s.startCRMMaintenance(ctx, cctx, &in.Key, reqCtx, nodeType, cb.Send)
+	}
+	return nil
+}
 
-		err = s.setMaintenanceState(ctx, &in.Key, dme.MaintenanceState_FAILOVER_REQUESTED, reqCtx, nodeType)
+// stopMaintenance returns the cloudlet to normal operation.
+func (s *CloudletApi) stopMaintenance(ctx context.Context, cctx *CallContext, key *edgeproto.CloudletKey, reqCtx context.Context, nodeType string, send func(*edgeproto.Result) error) error {
+	log.SpanLog(ctx, log.DebugLevelApi, "Stop CRM maintenance")
+	if !ignoreCRMState(cctx) {
+		timeout := s.all.settingsApi.Get().CloudletMaintenanceTimeout.TimeDuration()
+		err := s.setMaintenanceState(ctx, key, dme.MaintenanceState_NORMAL_OPERATION_INIT, reqCtx, nodeType)
 		if err != nil {
 			return err
 		}
-		err = s.all.autoProvInfoApi.waitForMaintenanceState(ctx, &in.Key, dme.MaintenanceState_FAILOVER_DONE, dme.MaintenanceState_FAILOVER_ERROR, timeout, &autoProvInfo)
+		cloudletInfo := edgeproto.CloudletInfo{}
+		err = s.all.cloudletInfoApi.waitForMaintenanceState(ctx, key, dme.MaintenanceState_NORMAL_OPERATION, dme.MaintenanceState_CRM_ERROR, timeout, &cloudletInfo)
 		if err != nil {
 			return err
 		}
-		for _, str := range autoProvInfo.Completed {
-			res := edgeproto.Result{
-				Message: str,
-			}
-			if err := cb.Send(&res); err != nil {
-				return err
-			}
+		if cloudletInfo.MaintenanceState == dme.MaintenanceState_CRM_ERROR {
+			return fmt.Errorf("CRM encountered some errors, aborting")
 		}
-		for _, str := range autoProvInfo.Errors {
-			res := edgeproto.Result{
-				Message: str,
-			}
-			if err := cb.Send(&res); err != nil {
-				return err
-			}
+	}
+	err := s.setMaintenanceState(ctx, key, dme.MaintenanceState_NORMAL_OPERATION, reqCtx, nodeType)
+	if err != nil {
+		return err
+	}
+	send(&edgeproto.Result{Message: "Cloudlet is back to normal operation"})
+	return nil
+}
+
+// startAutoProvFailover triggers AutoProv to move auto-provisioned
+// AppInsts off of the cloudlet, and waits for it to finish.
+func (s *CloudletApi) startAutoProvFailover(ctx context.Context, key *edgeproto.CloudletKey, reqCtx context.Context, nodeType string, send func(*edgeproto.Result) error) error {
+	log.SpanLog(ctx, log.DebugLevelApi, "Start AutoProv failover")
+	timeout := s.all.settingsApi.Get().CloudletMaintenanceTimeout.TimeDuration()
+	err := send(&edgeproto.Result{
+		Message: "Starting AutoProv failover",
+	})
+	if err != nil {
+		return err
+	}
+	autoProvInfo := edgeproto.AutoProvInfo{}
+	// first reset any old AutoProvInfo
+	autoProvInfo = edgeproto.AutoProvInfo{
+		Key:              *key,
+		MaintenanceState: dme.MaintenanceState_NORMAL_OPERATION,
+	}
+	s.all.autoProvInfoApi.Update(ctx, &autoProvInfo, 0)
+
+	err = s.setMaintenanceState(ctx, key, dme.MaintenanceState_FAILOVER_REQUESTED, reqCtx, nodeType)
+	if err != nil {
+		return err
+	}
+	err = s.all.autoProvInfoApi.waitForMaintenanceState(ctx, key, dme.MaintenanceState_FAILOVER_DONE, dme.MaintenanceState_FAILOVER_ERROR, timeout, &autoProvInfo)
+	if err != nil {
+		return err
+	}
+	for _, str := range autoProvInfo.Completed {
+		res := edgeproto.Result{
+			Message: str,
 		}
-		if len(autoProvInfo.Errors) > 0 {
-			undoErr := s.setMaintenanceState(ctx, &in.Key, dme.MaintenanceState_NORMAL_OPERATION, reqCtx, nodeType)
-			log.SpanLog(ctx, log.DebugLevelApi, "AutoProv maintenance failures", "err", err, "undoErr", undoErr)
-			return fmt.Errorf("AutoProv failover encountered some errors, aborting maintenance")
+		if err := send(&res); err != nil {
+			return err
 		}
-		cb.Send(&edgeproto.Result{
-			Message: "AutoProv failover completed",
-		})
+	}
+	for _, str := range autoProvInfo.Errors {
+		res := edgeproto.Result{
+			Message: str,
+		}
+		if err := send(&res); err != nil {
+			return err
+		}
+	}
+	if len(autoProvInfo.Errors) > 0 {
+		undoErr := s.setMaintenanceState(ctx, key, dme.MaintenanceState_NORMAL_OPERATION, reqCtx, nodeType)
+		log.SpanLog(ctx, log.DebugLevelApi, "AutoProv maintenance failures", "err", err, "undoErr", undoErr)
+		return fmt.Errorf("AutoProv failover encountered some errors, aborting maintenance")
+	}
+	send(&edgeproto.Result{
+		Message: "AutoProv failover completed",
+	})
 
-		log.SpanLog(ctx, log.DebugLevelApi, "AutoProv failover complete")
+	log.SpanLog(ctx, log.DebugLevelApi, "AutoProv failover complete")
+	return nil
+}
 
-		// proceed to next state
-		fallthrough
-	case dme.MaintenanceState_MAINTENANCE_START_NO_FAILOVER:
-		log.SpanLog(ctx, log.DebugLevelApi, "Start CRM maintenance")
-		cb.Send(&edgeproto.Result{
-			Message: "Starting CRM maintenance",
-		})
-		if !ignoreCRMState(cctx) {
-			timeout := s.all.settingsApi.Get().CloudletMaintenanceTimeout.TimeDuration()
-			// Tell CRM to go into maintenance mode
-			err = s.setMaintenanceState(ctx, &in.Key, dme.MaintenanceState_CRM_REQUESTED, reqCtx, nodeType)
-			if err != nil {
-				return err
-			}
-			cloudletInfo := edgeproto.CloudletInfo{}
-			err = s.all.cloudletInfoApi.waitForMaintenanceState(ctx, &in.Key, dme.MaintenanceState_CRM_UNDER_MAINTENANCE, dme.MaintenanceState_CRM_ERROR, timeout, &cloudletInfo)
-			if err != nil {
-				return err
-			}
-			if cloudletInfo.MaintenanceState == dme.MaintenanceState_CRM_ERROR {
-				undoErr := s.setMaintenanceState(ctx, &in.Key, dme.MaintenanceState_NORMAL_OPERATION, reqCtx, nodeType)
-				log.SpanLog(ctx, log.DebugLevelApi, "CRM maintenance failures", "err", err, "undoErr", undoErr)
-				return fmt.Errorf("CRM encountered some errors, aborting maintenance")
-			}
+// startCRMMaintenance tells the CRM to go into maintenance mode,
+// and then puts the cloudlet under maintenance.
+func (s *CloudletApi) startCRMMaintenance(ctx context.Context, cctx *CallContext, key *edgeproto.CloudletKey, reqCtx context.Context, nodeType string, send func(*edgeproto.Result) error) error {
+	log.SpanLog(ctx, log.DebugLevelApi, "Start CRM maintenance")
+	send(&edgeproto.Result{
+		Message: "Starting CRM maintenance",
+	})
+	if !ignoreCRMState(cctx) {
+		timeout := s.all.settingsApi.Get().CloudletMaintenanceTimeout.TimeDuration()
+		// Tell CRM to go into maintenance mode
+		err := s.setMaintenanceState(ctx, key, dme.MaintenanceState_CRM_REQUESTED, reqCtx, nodeType)
+		if err != nil {
+			return err
 		}
-		cb.Send(&edgeproto.Result{
-			Message: "CRM maintenance started",
-		})
-		log.SpanLog(ctx, log.DebugLevelApi, "CRM maintenance started")
-		// transition to maintenance
-		err = s.setMaintenanceState(ctx, &in.Key, dme.MaintenanceState_UNDER_MAINTENANCE, reqCtx, nodeType)
+		cloudletInfo := edgeproto.CloudletInfo{}
+		err = s.all.cloudletInfoApi.waitForMaintenanceState(ctx, key, dme.MaintenanceState_CRM_UNDER_MAINTENANCE, dme.MaintenanceState_CRM_ERROR, timeout, &cloudletInfo)
 		if err != nil {
 			return err
 		}
-		cb.Send(&edgeproto.Result{
-			Message: "Cloudlet is in maintenance",
-		})
+		if cloudletInfo.MaintenanceState == dme.MaintenanceState_CRM_ERROR {
+			undoErr := s.setMaintenanceState(ctx, key, dme.MaintenanceState_NORMAL_OPERATION, reqCtx, nodeType)
+			log.SpanLog(ctx, log.DebugLevelApi, "CRM maintenance failures", "err", err, "undoErr", undoErr)
+			return fmt.Errorf("CRM encountered some errors, aborting maintenance")
+		}
 	}
+	send(&edgeproto.Result{
+		Message: "CRM maintenance started",
+	})
+	log.SpanLog(ctx, log.DebugLevelApi, "CRM maintenance started")
+	// transition to maintenance
+	err := s.setMaintenanceState(ctx, key, dme.MaintenanceState_UNDER_MAINTENANCE, reqCtx, nodeType)
+	if err != nil {
+		return err
+	}
+	send(&edgeproto.Result{
+		Message: "Cloudlet is in maintenance",
+	})
 	return nil
 }
 
@@ -232,6 +232,8 @@ func TestCloudletApi(t *testing.T) {
 	testShowFlavorsForZone(t, ctx, apis)
 	testShowPlatformFeaturesForZone(t, ctx, apis)
 	testAllianceOrgs(t, ctx, apis)
+	testCloudletUpdateDryRun(t, ctx, apis)
+	testCloudletMaintenanceWindow(t, ctx, apis)
 	testCloudletEdgeboxOnly(t, ctx, cloudletData[2], apis)
 	testCloudletUpdateInfo(t, ctx, apis)
 	testCloudletManagedClusters(t, ctx, apis)
@@ -712,6 +714,42 @@ func testShowPlatformFeaturesForZone(t *testing.T, ctx context.Context, apis *Al
 	require.Equal(t, "fakesinglecluster", show.Data[1].PlatformType)
 }
 
+func testCloudletUpdateDryRun(t *testing.T, ctx context.Context, apis *AllApis) {
+	cur := edgeproto.Cloudlet{}
+	require.True(t, apis.cloudletApi.cache.Get(&testutil.CloudletData()[0].Key, &cur))
+
+	update := edgeproto.Cloudlet{}
+	update.Key = cur.Key
+	update.NumDynamicIps = cur.NumDynamicIps + 5
+	update.DryRun = true
+	update.Fields = []string{
+		edgeproto.CloudletFieldNumDynamicIps,
+		edgeproto.CloudletFieldDryRun,
+	}
+	out := NewStreamoutMsg(ctx)
+	err := apis.cloudletApi.UpdateCloudlet(&update, out)
+	require.Nil(t, err)
+	msgs := []string{}
+	for _, msg := range out.Msgs {
+		msgs = append(msgs, msg.Message)
+	}
+	require.Equal(t, []string{
+		"Dry run: changed fields would be Num Dynamic Ips",
+		"Dry run complete, no changes were made",
+	}, msgs)
+	check := edgeproto.Cloudlet{}
+	require.True(t, apis.cloudletApi.cache.Get(&cur.Key, &check))
+	require.Equal(t, cur.NumDynamicIps, check.NumDynamicIps)
+	require.False(t, check.DryRun)
+
+	// validation errors are still reported
+	update.NumDynamicIps = 0
+	update.DryRun = true
+	err = apis.cloudletApi.UpdateCloudlet(&update, NewStreamoutMsg(ctx))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Cannot specify less than one dynamic IP")
+}
+
 func testAllianceOrgs(t *testing.T, ctx context.Context, apis *AllApis) {
 	data := testutil.CloudletData()
 	cloudlet := data[0]
@@ -0,0 +1,284 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/opentracing/opentracing-go"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+// Cloudlet maintenance windows are run by a periodic task on
+// every Controller. Each step of the window is claimed by changing
+// the window state in etcd, so only one Controller runs each step.
+//
+// A scheduled window goes into failover at the start time minus
+// the failover lead time. DMEs stop sending new clients to the
+// cloudlet, and AutoProv moves auto-provisioned AppInsts off of it.
+// At the start time the cloudlet is put under maintenance, and at
+// the end time it is returned to normal operation and the window
+// is removed. The end time also ends windows stuck in failover or
+// that never reached maintenance, which is recorded as a failure.
+
+var maintenanceWindowCheckInterval = time.Minute
+
+type maintenanceWindowAction int
+
+const (
+	maintenanceWindowNoAction maintenanceWindowAction = iota
+	maintenanceWindowFailover
+	maintenanceWindowStart
+	maintenanceWindowEnd
+	maintenanceWindowExpired
+)
+
+type MaintenanceWindowTaskable struct {
+	all        *AllApis
+	mux        sync.Mutex
+	inProgress map[edgeproto.CloudletKey]struct{}
+	wg         sync.WaitGroup
+}
+
+// NewMaintenanceWindowTaskable returns a PeriodicTaskable for running
+// cloudlet maintenance windows
+func NewMaintenanceWindowTaskable(all *AllApis) *MaintenanceWindowTaskable {
+	return &MaintenanceWindowTaskable{
+		all:        all,
+		inProgress: make(map[edgeproto.CloudletKey]struct{}),
+	}
+}
+
+func (s *MaintenanceWindowTaskable) GetInterval() time.Duration {
+	return maintenanceWindowCheckInterval
+}
+
+func (s *MaintenanceWindowTaskable) StartSpan() opentracing.Span {
+	return log.StartSpan(log.DebugLevelApi, "cloudlet maintenance window check")
+}
+
+func (s *MaintenanceWindowTaskable) Run(ctx context.Context) {
+	s.checkWindows(ctx, time.Now())
+}
+
+// checkWindows starts the maintenance window steps that are due.
+func (s *MaintenanceWindowTaskable) checkWindows(ctx context.Context, now time.Time) {
+	defaultLeadTime := s.all.settingsApi.Get().CloudletMaintenanceTimeout.TimeDuration()
+	keys := []edgeproto.CloudletKey{}
+	s.all.cloudletApi.cache.Show(&edgeproto.Cloudlet{}, func(cloudlet *edgeproto.Cloudlet) error {
+		if getMaintenanceWindowAction(cloudlet, now, defaultLeadTime) != maintenanceWindowNoAction {
+			keys = append(keys, cloudlet.Key)
+		}
+		return nil
+	})
+	for _, key := range keys {
+		s.mux.Lock()
+		if _, found := s.inProgress[key]; found {
+			s.mux.Unlock()
+			continue
+		}
+		s.inProgress[key] = struct{}{}
+		s.mux.Unlock()
+
+		s.wg.Add(1)
+		go func(key edgeproto.CloudletKey) {
+			defer s.wg.Done()
+			defer func() {
+				s.mux.Lock()
+				delete(s.inProgress, key)
+				s.mux.Unlock()
+			}()
+			span, ctx := log.ChildSpan(ctx, log.DebugLevelApi, "cloudlet maintenance window")
+			defer span.Finish()
+			log.SetTags(span, key.GetTags())
+			s.all.cloudletApi.runMaintenanceWindow(ctx, &key, now, defaultLeadTime)
+		}(key)
+	}
+}
+
+// wait waits for any running maintenance window steps to finish.
+func (s *MaintenanceWindowTaskable) wait() {
+	s.wg.Wait()
+}
+
+func getMaintenanceWindowAction(cloudlet *edgeproto.Cloudlet, now time.Time, defaultLeadTime time.Duration) maintenanceWindowAction {
+	mw := cloudlet.MaintenanceWindow
+	if mw == nil {
+		return maintenanceWindowNoAction
+	}
+	start := dme.TimestampToTime(mw.StartTime)
+	end := dme.TimestampToTime(mw.EndTime)
+	leadTime := mw.FailoverLeadTime.TimeDuration()
+	if leadTime == 0 {
+		leadTime = defaultLeadTime
+	}
+	switch mw.State {
+	case edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_SCHEDULED:
+		if !now.Before(end) {
+			return maintenanceWindowExpired
+		}
+		if !now.Before(start.Add(-leadTime)) {
+			return maintenanceWindowFailover
+		}
+	case edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_FAILOVER:
+		if !now.Before(end) {
+			// failover did not finish in time, restore
+			// normal operation if failover changed the state.
+			if cloudlet.MaintenanceState != dme.MaintenanceState_NORMAL_OPERATION {
+				return maintenanceWindowEnd
+			}
+			return maintenanceWindowExpired
+		}
+		// wait for failover to finish
+		failoverDone := mw.NoFailover || cloudlet.MaintenanceState == dme.MaintenanceState_FAILOVER_DONE
+		if failoverDone && !now.Before(start) {
+			return maintenanceWindowStart
+		}
+	case edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_IN_PROGRESS:
+		if !now.Before(end) {
+			// end maintenance even if the cloudlet never
+			// reached or left the under maintenance state.
+			if cloudlet.MaintenanceState != dme.MaintenanceState_NORMAL_OPERATION {
+				return maintenanceWindowEnd
+			}
+			return maintenanceWindowExpired
+		}
+	}
+	return maintenanceWindowNoAction
+}
+
+// runMaintenanceWindow claims and runs the next step of the
+// cloudlet's maintenance window.
+func (s *CloudletApi) runMaintenanceWindow(ctx context.Context, key *edgeproto.CloudletKey, now time.Time, defaultLeadTime time.Duration) {
+	var action maintenanceWindowAction
+	var windowState edgeproto.MaintenanceWindowState
+	var maintenanceState dme.MaintenanceState
+	cloudlet := edgeproto.Cloudlet{}
+	err := s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+		if !s.store.STMGet(stm, key, &cloudlet) {
+			return key.NotFoundError()
+		}
+		action = getMaintenanceWindowAction(&cloudlet, now, defaultLeadTime)
+		if cloudlet.MaintenanceWindow != nil {
+			windowState = cloudlet.MaintenanceWindow.State
+		}
+		maintenanceState = cloudlet.MaintenanceState
+		switch action {
+		case maintenanceWindowNoAction:
+			// another Controller already claimed it
+			return nil
+		case maintenanceWindowFailover:
+			cloudlet.MaintenanceWindow.State = edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_FAILOVER
+		case maintenanceWindowStart:
+			cloudlet.MaintenanceWindow.State = edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_IN_PROGRESS
+		case maintenanceWindowEnd, maintenanceWindowExpired:
+			cloudlet.MaintenanceWindow = nil
+		}
+		s.store.STMPut(stm, &cloudlet)
+		return nil
+	})
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelApi, "claim maintenance window failed", "cloudlet", key, "err", err)
+		return
+	}
+	if action == maintenanceWindowNoAction {
+		return
+	}
+	log.SpanLog(ctx, log.DebugLevelApi, "run maintenance window", "cloudlet", key, "action", action)
+
+	features, err := s.all.platformFeaturesApi.GetCloudletFeatures(ctx, cloudlet.PlatformType)
+	if err != nil {
+		s.maintenanceWindowFailed(ctx, key, err)
+		return
+	}
+	reqCtx, reqCancel := context.WithTimeout(ctx, s.all.settingsApi.Get().UpdateCloudletTimeout.TimeDuration())
+	defer reqCancel()
+	cctx := DefCallContext()
+	send := func(res *edgeproto.Result) error {
+		log.SpanLog(ctx, log.DebugLevelApi, "maintenance window", "cloudlet", key, "msg", res.Message)
+		return nil
+	}
+
+	var eventName string
+	switch action {
+	case maintenanceWindowFailover:
+		eventName = "Cloudlet maintenance window failover started"
+		nodeMgr.Event(ctx, eventName, key.Organization, key.GetTags(), nil, "nofailover", strconv.FormatBool(cloudlet.MaintenanceWindow.NoFailover))
+		if cloudlet.MaintenanceWindow.NoFailover {
+			return
+		}
+		err = s.startAutoProvFailover(ctx, key, reqCtx, features.NodeType, send)
+		if err == nil {
+			err = s.setMaintenanceState(ctx, key, dme.MaintenanceState_FAILOVER_DONE, reqCtx, features.NodeType)
+		}
+		eventName = "Cloudlet maintenance window failover done"
+	case maintenanceWindowStart:
+		err = s.startCRMMaintenance(ctx, cctx, key, reqCtx, features.NodeType, send)
+		eventName = "Cloudlet maintenance window started"
+	case maintenanceWindowEnd:
+		err = s.stopMaintenance(ctx, cctx, key, reqCtx, features.NodeType, send)
+		eventName = "Cloudlet maintenance window ended"
+		if err == nil && windowState == edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_FAILOVER {
+			err = errors.New("maintenance window ended before failover finished")
+		} else if err == nil && maintenanceState != dme.MaintenanceState_UNDER_MAINTENANCE {
+			err = fmt.Errorf("maintenance window ended with cloudlet in maintenance state %s", maintenanceState.String())
+		}
+	case maintenanceWindowExpired:
+		switch windowState {
+		case edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_FAILOVER:
+			err = errors.New("maintenance window ended before failover finished")
+		case edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_IN_PROGRESS:
+			err = errors.New("maintenance window ended but cloudlet was not under maintenance")
+		default:
+			err = errors.New("maintenance window ended before it was started")
+		}
+	}
+	if err != nil {
+		s.maintenanceWindowFailed(ctx, key, err)
+		return
+	}
+	nodeMgr.Event(ctx, eventName, key.Organization, key.GetTags(), nil)
+}
+
+// maintenanceWindowFailed removes the cloudlet's maintenance window.
+// The cloudlet's maintenance state is left as is, to be fixed by
+// the operator.
+func (s *CloudletApi) maintenanceWindowFailed(ctx context.Context, key *edgeproto.CloudletKey, failErr error) {
+	log.SpanLog(ctx, log.DebugLevelApi, "maintenance window failed", "cloudlet", key, "err", failErr)
+	err := s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+		cloudlet := edgeproto.Cloudlet{}
+		if !s.store.STMGet(stm, key, &cloudlet) {
+			return key.NotFoundError()
+		}
+		if cloudlet.MaintenanceWindow == nil {
+			return nil
+		}
+		cloudlet.MaintenanceWindow = nil
+		s.store.STMPut(stm, &cloudlet)
+		return nil
+	})
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelApi, "failed to remove maintenance window", "cloudlet", key, "err", err)
+	}
+	nodeMgr.Event(ctx, "Cloudlet maintenance window failed", key.Organization, key.GetTags(), failErr)
+}
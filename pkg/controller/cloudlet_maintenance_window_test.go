@@ -0,0 +1,265 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon/svcnode"
+	"github.com/edgexr/edge-cloud-platform/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func testCloudletMaintenanceWindow(t *testing.T, ctx context.Context, apis *AllApis) {
+	cloudlet := testutil.CloudletData()[0]
+	now := time.Now()
+	leadTime := 10 * time.Minute
+	start := now.Add(time.Hour)
+	end := now.Add(2 * time.Hour)
+
+	update := edgeproto.Cloudlet{}
+	update.Key = cloudlet.Key
+	update.Fields = []string{
+		edgeproto.CloudletFieldMaintenanceWindowStartTimeSeconds,
+		edgeproto.CloudletFieldMaintenanceWindowEndTimeSeconds,
+		edgeproto.CloudletFieldMaintenanceWindowFailoverLeadTime,
+	}
+
+	// negative tests
+	update.MaintenanceWindow = &edgeproto.MaintenanceWindow{
+		StartTime: dme.TimeToTimestamp(end),
+		EndTime:   dme.TimeToTimestamp(start),
+	}
+	err := apis.cloudletApi.UpdateCloudlet(&update, testutil.NewCudStreamoutCloudlet(ctx))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "end time must be after the start time")
+
+	update.MaintenanceWindow = &edgeproto.MaintenanceWindow{
+		StartTime: dme.TimeToTimestamp(now.Add(-2 * time.Hour)),
+		EndTime:   dme.TimeToTimestamp(now.Add(-time.Hour)),
+	}
+	err = apis.cloudletApi.UpdateCloudlet(&update, testutil.NewCudStreamoutCloudlet(ctx))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "end time must be in the future")
+
+	// schedule the window
+	update.MaintenanceWindow = &edgeproto.MaintenanceWindow{
+		StartTime:        dme.TimeToTimestamp(start),
+		EndTime:          dme.TimeToTimestamp(end),
+		FailoverLeadTime: edgeproto.Duration(leadTime),
+	}
+	err = apis.cloudletApi.UpdateCloudlet(&update, testutil.NewCudStreamoutCloudlet(ctx))
+	require.Nil(t, err)
+	eMock.verifyEvent(t, "cloudlet maintenance window scheduled", []svcnode.EventTag{
+		svcnode.EventTag{
+			Key:   "start",
+			Value: dme.TimestampToTime(dme.TimeToTimestamp(start)).Format(time.RFC3339),
+		},
+	})
+
+	// cannot start maintenance while a window is scheduled
+	maint := edgeproto.Cloudlet{}
+	maint.Key = cloudlet.Key
+	maint.MaintenanceState = dme.MaintenanceState_MAINTENANCE_START
+	maint.Fields = []string{edgeproto.CloudletFieldMaintenanceState}
+	err = apis.cloudletApi.UpdateCloudlet(&maint, testutil.NewCudStreamoutCloudlet(ctx))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "maintenance window is scheduled")
+
+	// respond to maintenance state changes in place of AutoProv and the CRM
+	cancel := apis.cloudletApi.cache.WatchKey(&cloudlet.Key, func(ctx context.Context) {
+		cl := edgeproto.Cloudlet{}
+		if !apis.cloudletApi.cache.Get(&cloudlet.Key, &cl) {
+			return
+		}
+		switch cl.MaintenanceState {
+		case dme.MaintenanceState_FAILOVER_REQUESTED:
+			info := edgeproto.AutoProvInfo{}
+			info.Key = cloudlet.Key
+			info.MaintenanceState = dme.MaintenanceState_FAILOVER_DONE
+			apis.autoProvInfoApi.cache.Update(ctx, &info, 0)
+		case dme.MaintenanceState_CRM_REQUESTED, dme.MaintenanceState_NORMAL_OPERATION_INIT:
+			info := edgeproto.CloudletInfo{}
+			if !apis.cloudletInfoApi.cache.Get(&cloudlet.Key, &info) {
+				info.Key = cloudlet.Key
+			}
+			info.MaintenanceState = dme.MaintenanceState_CRM_UNDER_MAINTENANCE
+			if cl.MaintenanceState == dme.MaintenanceState_NORMAL_OPERATION_INIT {
+				info.MaintenanceState = dme.MaintenanceState_NORMAL_OPERATION
+			}
+			apis.cloudletInfoApi.cache.Update(ctx, &info, 0)
+		}
+	})
+	defer cancel()
+
+	checkWindow := func(checkTime time.Time, state edgeproto.MaintenanceWindowState, maintenanceState dme.MaintenanceState) {
+		task := NewMaintenanceWindowTaskable(apis)
+		task.checkWindows(ctx, checkTime)
+		task.wait()
+		check := edgeproto.Cloudlet{}
+		require.True(t, apis.cloudletApi.cache.Get(&cloudlet.Key, &check))
+		require.NotNil(t, check.MaintenanceWindow)
+		require.Equal(t, state, check.MaintenanceWindow.State)
+		require.Equal(t, maintenanceState, check.MaintenanceState)
+	}
+
+	// nothing happens before the failover lead time
+	checkWindow(start.Add(-leadTime-time.Minute), edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_SCHEDULED, dme.MaintenanceState_NORMAL_OPERATION)
+	// failover starts ahead of the window
+	checkWindow(start.Add(-leadTime), edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_FAILOVER, dme.MaintenanceState_FAILOVER_DONE)
+	eMock.verifyEvent(t, "cloudlet maintenance window failover started", []svcnode.EventTag{
+		svcnode.EventTag{
+			Key:   "nofailover",
+			Value: "false",
+		},
+	})
+	// window cannot be changed once it has started
+	err = apis.cloudletApi.UpdateCloudlet(&update, testutil.NewCudStreamoutCloudlet(ctx))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "after it has started")
+	// maintenance starts at the start time
+	checkWindow(start, edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_IN_PROGRESS, dme.MaintenanceState_UNDER_MAINTENANCE)
+	// back to normal operation at the end time
+	task := NewMaintenanceWindowTaskable(apis)
+	task.checkWindows(ctx, end)
+	task.wait()
+	check := edgeproto.Cloudlet{}
+	require.True(t, apis.cloudletApi.cache.Get(&cloudlet.Key, &check))
+	require.Nil(t, check.MaintenanceWindow)
+	require.Equal(t, dme.MaintenanceState_NORMAL_OPERATION, check.MaintenanceState)
+
+	// schedule a window without failover, and cancel it during failover
+	update.MaintenanceWindow.NoFailover = true
+	update.Fields = append(update.Fields, edgeproto.CloudletFieldMaintenanceWindowNoFailover)
+	err = apis.cloudletApi.UpdateCloudlet(&update, testutil.NewCudStreamoutCloudlet(ctx))
+	require.Nil(t, err)
+	checkWindow(start.Add(-leadTime), edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_FAILOVER, dme.MaintenanceState_NORMAL_OPERATION)
+	maint.MaintenanceState = dme.MaintenanceState_NORMAL_OPERATION
+	err = apis.cloudletApi.UpdateCloudlet(&maint, testutil.NewCudStreamoutCloudlet(ctx))
+	require.Nil(t, err)
+	check = edgeproto.Cloudlet{}
+	require.True(t, apis.cloudletApi.cache.Get(&cloudlet.Key, &check))
+	require.Nil(t, check.MaintenanceWindow)
+	require.Equal(t, dme.MaintenanceState_NORMAL_OPERATION, check.MaintenanceState)
+
+	// an expired window is removed
+	update.MaintenanceWindow.NoFailover = false
+	err = apis.cloudletApi.UpdateCloudlet(&update, testutil.NewCudStreamoutCloudlet(ctx))
+	require.Nil(t, err)
+	task = NewMaintenanceWindowTaskable(apis)
+	task.checkWindows(ctx, end)
+	task.wait()
+	check = edgeproto.Cloudlet{}
+	require.True(t, apis.cloudletApi.cache.Get(&cloudlet.Key, &check))
+	require.Nil(t, check.MaintenanceWindow)
+	require.Equal(t, dme.MaintenanceState_NORMAL_OPERATION, check.MaintenanceState)
+}
+
+func TestGetMaintenanceWindowAction(t *testing.T) {
+	now := time.Now()
+	leadTime := 10 * time.Minute
+	start := now.Add(time.Hour)
+	end := now.Add(2 * time.Hour)
+
+	tests := []struct {
+		desc             string
+		state            edgeproto.MaintenanceWindowState
+		maintenanceState dme.MaintenanceState
+		checkTime        time.Time
+		expAction        maintenanceWindowAction
+	}{{
+		"scheduled before lead time",
+		edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_SCHEDULED,
+		dme.MaintenanceState_NORMAL_OPERATION,
+		start.Add(-leadTime - time.Minute),
+		maintenanceWindowNoAction,
+	}, {
+		"scheduled at lead time",
+		edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_SCHEDULED,
+		dme.MaintenanceState_NORMAL_OPERATION,
+		start.Add(-leadTime),
+		maintenanceWindowFailover,
+	}, {
+		"scheduled past end",
+		edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_SCHEDULED,
+		dme.MaintenanceState_NORMAL_OPERATION,
+		end,
+		maintenanceWindowExpired,
+	}, {
+		"failover not done at start",
+		edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_FAILOVER,
+		dme.MaintenanceState_FAILOVER_REQUESTED,
+		start,
+		maintenanceWindowNoAction,
+	}, {
+		"failover done at start",
+		edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_FAILOVER,
+		dme.MaintenanceState_FAILOVER_DONE,
+		start,
+		maintenanceWindowStart,
+	}, {
+		"failover stuck past end",
+		edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_FAILOVER,
+		dme.MaintenanceState_FAILOVER_REQUESTED,
+		end,
+		maintenanceWindowEnd,
+	}, {
+		"failover reverted past end",
+		edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_FAILOVER,
+		dme.MaintenanceState_NORMAL_OPERATION,
+		end,
+		maintenanceWindowExpired,
+	}, {
+		"in progress before end",
+		edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_IN_PROGRESS,
+		dme.MaintenanceState_UNDER_MAINTENANCE,
+		end.Add(-time.Minute),
+		maintenanceWindowNoAction,
+	}, {
+		"in progress at end",
+		edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_IN_PROGRESS,
+		dme.MaintenanceState_UNDER_MAINTENANCE,
+		end,
+		maintenanceWindowEnd,
+	}, {
+		"in progress stuck past end",
+		edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_IN_PROGRESS,
+		dme.MaintenanceState_CRM_REQUESTED,
+		end,
+		maintenanceWindowEnd,
+	}, {
+		"in progress reverted past end",
+		edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_IN_PROGRESS,
+		dme.MaintenanceState_NORMAL_OPERATION,
+		end,
+		maintenanceWindowExpired,
+	}}
+	for _, test := range tests {
+		cloudlet := edgeproto.Cloudlet{
+			MaintenanceState: test.maintenanceState,
+			MaintenanceWindow: &edgeproto.MaintenanceWindow{
+				StartTime: dme.TimeToTimestamp(start),
+				EndTime:   dme.TimeToTimestamp(end),
+				State:     test.state,
+			},
+		}
+		action := getMaintenanceWindowAction(&cloudlet, test.checkTime, leadTime)
+		require.Equal(t, test.expAction, action, test.desc)
+	}
+}
@@ -47,7 +47,10 @@ func (s *ClusterInstApi) calcKubernetesClusterUsedResources(refs *edgeproto.Clus
 			// when being sized for an App.
 			return nil
 		}
-		kr := appInst.KubernetesResources
+		kr, err := getAppInstReservedKubernetesResources(app, appInst)
+		if err != nil {
+			return err
+		}
 		if err := cpuPoolVals.AddNodePoolResources(kr.CpuPool); err != nil {
 			return err
 		}
@@ -59,6 +62,24 @@ func (s *ClusterInstApi) calcKubernetesClusterUsedResources(refs *edgeproto.Clus
 	return cpuPoolVals, gpuPoolVals, err
 }
 
+// getAppInstReservedKubernetesResources gets the Kubernetes resources
+// reserved for the AppInst. If the App replicas are auto-scaled, or
+// the AppInst specifies the number of replicas, the AppInst resources
+// are per replica, so resources are reserved for the maximum number
+// of replicas, or for the specified number of replicas.
+func getAppInstReservedKubernetesResources(app *edgeproto.App, appInst *edgeproto.AppInst) (*edgeproto.KubernetesResources, error) {
+	if appInst.KubernetesResources == nil {
+		return nil, nil
+	}
+	if app.ReplicaAutoScalePolicy != nil {
+		return resspec.ScaleKubernetesResources(appInst.KubernetesResources, app.ReplicaAutoScalePolicy.MaxReplicas)
+	}
+	if appInst.Replicas > 0 {
+		return resspec.ScaleKubernetesResources(appInst.KubernetesResources, uint32(appInst.Replicas))
+	}
+	return appInst.KubernetesResources, nil
+}
+
 // Calculate used resources within a VM-based cluster.
 // If a newAppInst is being created, we will ignore it if it's
 // already in the refs due to an earlier failed create.
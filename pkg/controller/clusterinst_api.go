@@ -195,12 +195,16 @@ func validateAndDefaultIPAccess(ctx context.Context, clusterInst *edgeproto.Clus
 	return nil
 }
 
-func (s *ClusterInstApi) startClusterInstStream(ctx context.Context, cctx *CallContext, streamCb *CbWrapper, modRev int64) (*streamSend, error) {
+func (s *ClusterInstApi) startClusterInstStream(ctx context.Context, cctx *CallContext, key *edgeproto.ClusterKey, streamCb *CbWrapper, modRev int64) (*streamSend, error) {
 	streamSendObj, err := s.all.streamObjApi.startStream(ctx, cctx, streamCb, modRev)
 	if err != nil {
 		log.SpanLog(ctx, log.DebugLevelApi, "failed to start ClusterInst stream", "err", err)
 		return nil, err
 	}
+	inst := edgeproto.ClusterInst{}
+	if s.cache.Get(key, &inst) {
+		streamSendObj.objID = inst.ObjId
+	}
 	return streamSendObj, err
 }
 
@@ -208,6 +212,9 @@ func (s *ClusterInstApi) stopClusterInstStream(ctx context.Context, cctx *CallCo
 	if err := s.all.streamObjApi.stopStream(ctx, cctx, key.StreamKey(), streamSendObj, objErr, cleanupStream); err != nil {
 		log.SpanLog(ctx, log.DebugLevelApi, "failed to stop ClusterInst stream", "err", err)
 	}
+	if objErr != nil && streamSendObj != nil && !streamSendObj.isCopy {
+		s.all.nbiEventsApi.clusterInstFailed(ctx, key, streamSendObj, objErr)
+	}
 }
 
 func (s *StreamObjApi) StreamClusterInst(key *edgeproto.ClusterKey, cb edgeproto.StreamObjApi_StreamClusterInstServer) error {
@@ -604,6 +611,7 @@ func (s *ClusterInstApi) resolveResourcesSpec(ctx context.Context, stm *edgeprot
 // bypassing static assignment. It is also used to create auto-cluster insts.
 func (s *ClusterInstApi) createClusterInstInternal(cctx *CallContext, in *edgeproto.ClusterInst, inCb edgeproto.ClusterInstApi_CreateClusterInstServer) (reterr error) {
 	cctx.SetOverride(&in.CrmOverride)
+	cctx.SetDryRun(&in.DryRun)
 	if err := in.Key.ValidateKey(); err != nil {
 		return err
 	}
@@ -728,6 +736,7 @@ func (s *ClusterInstApi) createClusterInstInternal(cctx *CallContext, in *edgepr
 	applyCreateReq := func(stm concurrency.STM) error {
 		// reset input data if STM was rerun
 		in.DeepCopyIn(&inCopy)
+		cctx.dryRunReset(false)
 
 		if s.all.clusterInstApi.store.STMGet(stm, &in.Key, in) {
 			if !cctx.Undo && in.State != edgeproto.TrackedState_DELETE_ERROR && !ignoreTransient(cctx, in.State) {
@@ -918,6 +927,15 @@ func (s *ClusterInstApi) createClusterInstInternal(cctx *CallContext, in *edgepr
 		if err := s.setDnsLabel(stm, in); err != nil {
 			return err
 		}
+		if cctx.DryRun {
+			// all checks passed, abort before committing any changes
+			cctx.dryRunDetail("ClusterInst would be created on cloudlet %s", in.CloudletKey.GetKeyString())
+			cctx.dryRunDetail("ClusterInst requests %s", s.sumRequestedClusterResources(in).String())
+			for _, warning := range resourceWarnings {
+				cctx.dryRunDetail("resource warning, %s", warning)
+			}
+			return errDryRun
+		}
 		in.Fqdn = getClusterInstFQDN(in, &cloudlet)
 		in.StaticFqdn = in.Fqdn
 
@@ -947,9 +965,14 @@ func (s *ClusterInstApi) createClusterInstInternal(cctx *CallContext, in *edgepr
 		crmOnEdge = false
 		resourceFailure = false
 		modRev, err = s.sync.ApplySTMWaitRev(ctx, applyCreateReq)
+		if err == errDryRun {
+			cctx.sendDryRunPlan(cb.Send)
+			return nil
+		}
 		if err != nil {
 			if resourceFailure {
 				log.SpanLog(ctx, log.DebugLevelApi, "createCluster failed with resource error, will try next potential cloudlet", "targetCloudlet", pc.cloudlet.Key.GetKeyString(), "err", err)
+				cctx.dryRunSkip("cloudlet", pc.cloudlet.Key.Name, ClusterNoResources)
 				continue // try the next cloudlet
 			}
 			return err
@@ -958,10 +981,11 @@ func (s *ClusterInstApi) createClusterInstInternal(cctx *CallContext, in *edgepr
 	}
 	if err != nil {
 		// if we get here, then all sites had resourceFailures.
+		cctx.sendDryRunSkipped(cb.Send)
 		return fmt.Errorf("not enough resources available to create the cluster")
 	}
 
-	sendObj, err := s.startClusterInstStream(ctx, cctx, streamCb, modRev)
+	sendObj, err := s.startClusterInstStream(ctx, cctx, &clusterKey, streamCb, modRev)
 	if err != nil {
 		return err
 	}
@@ -1200,7 +1224,7 @@ func (s *ClusterInstApi) updateClusterInstInternal(cctx *CallContext, in *edgepr
 		return nil
 	}
 
-	sendObj, err := s.startClusterInstStream(ctx, cctx, streamCb, modRev)
+	sendObj, err := s.startClusterInstStream(ctx, cctx, &clusterKey, streamCb, modRev)
 	if err != nil {
 		return err
 	}
@@ -1429,7 +1453,7 @@ func (s *ClusterInstApi) deleteClusterInstInternal(cctx *CallContext, in *edgepr
 		}
 	}()
 
-	sendObj, err := s.startClusterInstStream(ctx, cctx, streamCb, modRev)
+	sendObj, err := s.startClusterInstStream(ctx, cctx, &clusterKey, streamCb, modRev)
 	if err != nil {
 		return err
 	}
@@ -2147,7 +2171,7 @@ func (s *ClusterInstApi) updateRootLbFQDN(key *edgeproto.ClusterKey, cloudlet *e
 	if !needUpdate {
 		return nil
 	}
-	sendObj, err := s.startClusterInstStream(ctx, cctx, streamCb, modRev)
+	sendObj, err := s.startClusterInstStream(ctx, cctx, key, streamCb, modRev)
 	if err != nil {
 		return err
 	}
@@ -2186,7 +2210,11 @@ func (s *ClusterInstApi) setInfraFlavor(ctx context.Context, stm *edgeproto.Opti
 func setClusterResourcesForReqs(ctx context.Context, ci *edgeproto.ClusterInst, app *edgeproto.App, ai *edgeproto.AppInst) error {
 	log.SpanLog(ctx, log.DebugLevelApi, "set cluster resources", "ci", ci.Key, "app", app.Key, "ai", ai.Key)
 	if cloudcommon.AppDeploysToKubernetes(app.Deployment) {
-		nodePools, err := GetNodePoolsFromReqs(ctx, ai.KubernetesResources)
+		kr, err := getAppInstReservedKubernetesResources(app, ai)
+		if err != nil {
+			return err
+		}
+		nodePools, err := GetNodePoolsFromReqs(ctx, kr)
 		if err != nil {
 			return err
 		}
@@ -2242,7 +2270,11 @@ func (s *ClusterInstApi) fitsAppResources(ctx context.Context, ci *edgeproto.Clu
 		if err != nil {
 			return nil, noFreeRes, err
 		}
-		return resspec.KubernetesResourcesFits(ctx, ci, appInst.KubernetesResources, cpuUsed, gpuUsed, flavorLookup, clusterSpecified)
+		kr, err := getAppInstReservedKubernetesResources(app, appInst)
+		if err != nil {
+			return nil, noFreeRes, err
+		}
+		return resspec.KubernetesResourcesFits(ctx, ci, kr, cpuUsed, gpuUsed, flavorLookup, clusterSpecified)
 	} else {
 		used, err := s.calcVMClusterUsedResources(refs, appInst)
 		if err != nil {
@@ -1186,6 +1186,41 @@ func testClusterPotentialCloudlets(t *testing.T, ctx context.Context, apis *AllA
 	// cloudlet1: cluster1 (5 nodes) + cluster4 (2 nodes)
 	// cloudlet2: cluster2 (4 nodes) + cluster3 (3 nodes)
 	clusters := []*edgeproto.ClusterInst{}
+
+	// dry run reports the chosen cloudlet without creating the cluster
+	refs := edgeproto.CloudletRefs{}
+	apis.cloudletRefsApi.cache.Get(&cloudlets[0].Key, &refs)
+	dryRun := &edgeproto.ClusterInst{}
+	dryRun.Key.Name = "pcclustdryrun"
+	dryRun.Key.Organization = "pcdev"
+	dryRun.ZoneKey = zone.Key
+	dryRun.DryRun = true
+	dryRun.NodePools = []*edgeproto.NodePool{{
+		Name:     "cpupool",
+		NumNodes: 6,
+		NodeResources: &edgeproto.NodeResources{
+			Vcpus: 1,
+			Ram:   1024,
+			Disk:  10,
+		},
+	}}
+	out := NewStreamoutMsg(ctx)
+	err := apis.clusterInstApi.CreateClusterInst(dryRun, out)
+	require.Nil(t, err)
+	require.False(t, apis.clusterInstApi.cache.HasKey(&dryRun.Key))
+	checkRefs := edgeproto.CloudletRefs{}
+	apis.cloudletRefsApi.cache.Get(&cloudlets[0].Key, &checkRefs)
+	require.Equal(t, refs, checkRefs)
+	msgs := []string{}
+	for _, msg := range out.Msgs {
+		msgs = append(msgs, msg.Message)
+	}
+	require.Equal(t, []string{
+		"Dry run: ClusterInst would be created on cloudlet " + cloudlets[0].Key.GetKeyString(),
+		"Dry run: ClusterInst requests Disk 60GB, Instances 7, RAM 6144MB, vCPUs 6",
+		"Dry run complete, no changes were made",
+	}, msgs)
+
 	for ii := 0; ii < 6; ii++ {
 		ci := &edgeproto.ClusterInst{}
 		ci.Key.Name = fmt.Sprintf("pcclust%d", ii)
@@ -1234,9 +1269,22 @@ func testClusterPotentialCloudlets(t *testing.T, ctx context.Context, apis *AllA
 			Disk:  40,
 		},
 	}}
-	err := apis.clusterInstApi.CreateClusterInst(ci, testutil.NewCudStreamoutClusterInst(ctx))
+	err = apis.clusterInstApi.CreateClusterInst(ci, testutil.NewCudStreamoutClusterInst(ctx))
 	require.NotNil(t, err)
 	require.Equal(t, "not enough resources available to create the cluster", err.Error())
+
+	// dry run reports why each cloudlet was skipped
+	ci.CloudletKey = edgeproto.CloudletKey{}
+	ci.DryRun = true
+	out = NewStreamoutMsg(ctx)
+	err = apis.clusterInstApi.CreateClusterInst(ci, out)
+	require.NotNil(t, err)
+	require.Equal(t, "not enough resources available to create the cluster", err.Error())
+	require.Equal(t, len(cloudlets), len(out.Msgs))
+	for _, msg := range out.Msgs {
+		require.Contains(t, msg.Message, "Dry run: skipped cloudlet")
+		require.Contains(t, msg.Message, ClusterNoResources)
+	}
 }
 
 func testPotentialCloudletsCreateDeps(t *testing.T, ctx context.Context, apis *AllApis) (*edgeproto.Zone, []*edgeproto.Cloudlet, []*edgeproto.CloudletInfo, func()) {
@@ -65,6 +65,7 @@ func (s *ClusterInstApi) getPotentialCloudlets(ctx context.Context, cctx *CallCo
 			}
 			log.SpanLog(ctx, log.DebugLevelApi, "skipping potential cloudlet from ClusterInst create", "cloudlet", ckey, "err", err)
 			skipReasons.add(skipReason)
+			cctx.dryRunSkip("cloudlet", ckey.Name, skipReason)
 			continue
 		}
 		potentialCloudlets = append(potentialCloudlets, pc)
@@ -35,6 +35,7 @@ import (
 	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/api/nbi"
 	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon/ratelimit"
 	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon/svcnode"
 	influxq "github.com/edgexr/edge-cloud-platform/pkg/influxq_client"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
@@ -86,6 +87,8 @@ var testMode = flag.Bool("testMode", false, "Run controller in test mode")
 var checkpointInterval = flag.String("checkpointInterval", "MONTH", "Interval at which to checkpoint cluster usage")
 var appDNSRoot = flag.String("appDNSRoot", "appdnsroot.net", "App domain name root")
 var requireNotifyAccessKey = flag.Bool("requireNotifyAccessKey", false, "Require AccessKey authentication on notify API")
+var alertSmtpAddr = flag.String("alertSmtpAddr", "", "SMTP server host:port used to send alert emails")
+var alertSmtpFrom = flag.String("alertSmtpFrom", "", "sender address of alert emails")
 var dnsZone = flag.String("dnsZone", "", "comma separated list of allowed dns zones for DNS update requests")
 var platformServiceAddrs arrayFlags
 
@@ -151,6 +154,8 @@ type Services struct {
 	nbiApis                     *NBIAPI
 	periodicClusterInstCleanup  *tasks.PeriodicTask
 	periodicCloudletCertRefresh *tasks.PeriodicTask
+	periodicMaintenanceWindow   *tasks.PeriodicTask
+	periodicAlertSilenceExpiry  *tasks.PeriodicTask
 	checkpointer                *Checkpointer
 	regAuthMgr                  *cloudcommon.RegistryAuthMgr
 	platformServiceConnCache    *cloudcommon.GRPCConnCache
@@ -281,6 +286,8 @@ func startServices() error {
 
 	sync.Start()
 	services.sync = sync
+	// reconnecting CRMs/DMEs only need changes since their last sync
+	notify.ServerMgrOne.SetSyncHistory(sync)
 	// requireNotifyAccessKey allows for backwards compatibility when
 	// set to false, because it allows CRMs to connect to notify without
 	// an access key (as long as pki internal cert is verified).
@@ -303,6 +310,10 @@ func startServices() error {
 	services.periodicClusterInstCleanup.Start()
 	services.periodicCloudletCertRefresh = tasks.NewPeriodicTask(NewCloudletCertRefreshTaskable(allApis))
 	services.periodicCloudletCertRefresh.Start()
+	services.periodicMaintenanceWindow = tasks.NewPeriodicTask(NewMaintenanceWindowTaskable(allApis))
+	services.periodicMaintenanceWindow.Start()
+	services.periodicAlertSilenceExpiry = tasks.NewPeriodicTask(NewAlertSilenceExpiryTaskable(allApis))
+	services.periodicAlertSilenceExpiry.Start()
 
 	err = allApis.flowRateLimitSettingsApi.initDefaultRateLimitSettings(ctx)
 	if err != nil {
@@ -502,8 +513,13 @@ func startServices() error {
 	edgeproto.RegisterAppInstLatencyApiServer(server, allApis.appInstLatencyApi)
 	edgeproto.RegisterGPUDriverApiServer(server, allApis.gpuDriverApi)
 	edgeproto.RegisterAlertPolicyApiServer(server, allApis.alertPolicyApi)
+	edgeproto.RegisterAlertReceiverApiServer(server, allApis.alertReceiverApi)
+	edgeproto.RegisterAlertSilenceApiServer(server, allApis.alertSilenceApi)
+	edgeproto.RegisterGeoFencePolicyApiServer(server, allApis.geoFencePolicyApi)
+	edgeproto.RegisterAppRolloutApiServer(server, allApis.appRolloutApi)
 	edgeproto.RegisterNetworkApiServer(server, allApis.networkApi)
 	edgeproto.RegisterPlatformFeaturesApiServer(server, allApis.platformFeaturesApi)
+	edgeproto.RegisterDataSnapshotApiServer(server, allApis.dataSnapshotApi)
 
 	go func() {
 		// Serve will block until interrupted and Stop is called
@@ -546,7 +562,12 @@ func startServices() error {
 			edgeproto.RegisterDeviceApiHandler,
 			edgeproto.RegisterOrganizationApiHandler,
 			edgeproto.RegisterAlertPolicyApiHandler,
+			edgeproto.RegisterAlertReceiverApiHandler,
+			edgeproto.RegisterAlertSilenceApiHandler,
+			edgeproto.RegisterGeoFencePolicyApiHandler,
+			edgeproto.RegisterAppRolloutApiHandler,
 			edgeproto.RegisterPlatformFeaturesApiHandler,
+			edgeproto.RegisterDataSnapshotApiHandler,
 		},
 	}
 	gw, err := cloudcommon.GrpcGateway(gwcfg)
@@ -564,7 +585,9 @@ func startServices() error {
 	e := echo.New()
 	e.Use(log.EchoTraceHandler, NBIErrorHandler, log.EchoAuditLogger)
 	e.HideBanner = true
-	nbiHandler := nbi.NewStrictHandler(nbiApis, []nbi.StrictMiddlewareFunc{})
+	nbiHandler := nbi.NewStrictHandler(nbiApis, []nbi.StrictMiddlewareFunc{
+		NBIRateLimiter(allApis.controllerRateLimitMgr),
+	})
 	nbi.RegisterHandlersWithBaseURL(e, nbiHandler, cloudcommon.NBIRootPath)
 	// note that the trailing / is needed to do sub-path matching
 	mux.Handle(cloudcommon.NBIRootPath+"/", e)
@@ -611,6 +634,12 @@ func stopServices() {
 	if services.periodicCloudletCertRefresh != nil {
 		services.periodicCloudletCertRefresh.Stop()
 	}
+	if services.periodicMaintenanceWindow != nil {
+		services.periodicMaintenanceWindow.Stop()
+	}
+	if services.periodicAlertSilenceExpiry != nil {
+		services.periodicAlertSilenceExpiry.Stop()
+	}
 	if services.httpServer != nil {
 		services.httpServer.Shutdown(context.Background())
 	}
@@ -742,6 +771,7 @@ type AllApis struct {
 	settingsApi                 *SettingsApi
 	flowRateLimitSettingsApi    *FlowRateLimitSettingsApi
 	maxReqsRateLimitSettingsApi *MaxReqsRateLimitSettingsApi
+	controllerRateLimitMgr      *ratelimit.RateLimitManager
 	appInstClientKeyApi         *AppInstClientKeyApi
 	appInstClientApi            *AppInstClientApi
 	deviceApi                   *DeviceApi
@@ -749,8 +779,14 @@ type AllApis struct {
 	appInstLatencyApi           *AppInstLatencyApi
 	gpuDriverApi                *GPUDriverApi
 	alertPolicyApi              *AlertPolicyApi
+	alertReceiverApi            *AlertReceiverApi
+	alertSilenceApi             *AlertSilenceApi
+	geoFencePolicyApi           *GeoFencePolicyApi
+	appRolloutApi               *AppRolloutApi
 	networkApi                  *NetworkApi
 	platformFeaturesApi         *PlatformFeaturesApi
+	dataSnapshotApi             *DataSnapshotApi
+	nbiEventsApi                *NBIEventsApi
 	syncLeaseData               *SyncLeaseData
 }
 
@@ -786,6 +822,7 @@ func NewAllApis(sync *regiondata.Sync) *AllApis {
 	all.settingsApi = NewSettingsApi(sync, all)
 	all.flowRateLimitSettingsApi = NewFlowRateLimitSettingsApi(sync, all)
 	all.maxReqsRateLimitSettingsApi = NewMaxReqsRateLimitSettingsApi(sync, all)
+	all.initControllerRateLimitMgr()
 	all.appInstClientKeyApi = NewAppInstClientKeyApi(sync, all)
 	all.appInstClientApi = NewAppInstClientApi(all)
 	all.deviceApi = NewDeviceApi(sync, all)
@@ -793,8 +830,14 @@ func NewAllApis(sync *regiondata.Sync) *AllApis {
 	all.appInstLatencyApi = NewAppInstLatencyApi(sync, all)
 	all.gpuDriverApi = NewGPUDriverApi(sync, all)
 	all.alertPolicyApi = NewAlertPolicyApi(sync, all)
+	all.alertReceiverApi = NewAlertReceiverApi(sync, all)
+	all.alertSilenceApi = NewAlertSilenceApi(sync, all)
+	all.geoFencePolicyApi = NewGeoFencePolicyApi(sync, all)
+	all.appRolloutApi = NewAppRolloutApi(sync, all)
 	all.networkApi = NewNetworkApi(sync, all)
 	all.platformFeaturesApi = NewPlatformFeaturesApi(sync, all)
+	all.dataSnapshotApi = NewDataSnapshotApi(sync, all)
+	all.nbiEventsApi = NewNBIEventsApi(sync, all)
 	all.syncLeaseData = NewSyncLeaseData(sync, all)
 	return all
 }
@@ -831,6 +874,8 @@ func InitNotify(metricsInflux *influxq.InfluxQ, edgeEventsInflux *influxq.Influx
 	notify.ServerMgrOne.RegisterSendCloudletInfoCache(&allApis.cloudletInfoApi.cache)
 	notify.ServerMgrOne.RegisterSendAutoScalePolicyCache(&allApis.autoScalePolicyApi.cache)
 	notify.ServerMgrOne.RegisterSendAutoProvPolicyCache(&allApis.autoProvPolicyApi.cache)
+	// GeoFencePolicies must be sent before Apps, because Apps reference them.
+	notify.ServerMgrOne.RegisterSendGeoFencePolicyCache(&allApis.geoFencePolicyApi.cache)
 	notify.ServerMgrOne.RegisterSendNetworkCache(&allApis.networkApi.cache)
 	notify.ServerMgrOne.RegisterSendClusterInstCache(&allApis.clusterInstApi.cache)
 	notify.ServerMgrOne.RegisterSendAppCache(&allApis.appApi.cache)
@@ -976,5 +1021,14 @@ func (s *AllApis) GetAutoProvPolicyApi() edgeproto.AutoProvPolicyApiServer {
 func (s *AllApis) GetTrustPolicyExceptionApi() edgeproto.TrustPolicyExceptionApiServer {
 	return s.trustPolicyExceptionApi
 }
+func (s *AllApis) GetGeoFencePolicyApi() edgeproto.GeoFencePolicyApiServer {
+	return s.geoFencePolicyApi
+}
+func (s *AllApis) GetAlertReceiverApi() edgeproto.AlertReceiverApiServer {
+	return s.alertReceiverApi
+}
+func (s *AllApis) GetAlertSilenceApi() edgeproto.AlertSilenceApiServer {
+	return s.alertSilenceApi
+}
 func (s *AllApis) GetNetworkApi() edgeproto.NetworkApiServer           { return s.networkApi }
 func (s *AllApis) GetCloudletNodeApi() edgeproto.CloudletNodeApiServer { return s.cloudletNodeApi }
@@ -114,6 +114,18 @@ func (s *DeleteDataGen) GetAppAutoProvPoliciesRef(key *edgeproto.PolicyKey) (*ed
 	return &ref, noSupportData
 }
 
+// GeoFencePolicy
+func (s *DeleteDataGen) GetGeoFencePolicyTestObj() (*edgeproto.GeoFencePolicy, *testSupportData) {
+	obj := testutil.GeoFencePolicyData()[0]
+	return &obj, noSupportData
+}
+func (s *DeleteDataGen) GetAppGeoFencePolicyRef(key *edgeproto.PolicyKey) (*edgeproto.App, *testSupportData) {
+	ref := testutil.AppData()[0]
+	ref.Key.Organization = key.Organization
+	ref.GeoFencePolicy = key.Name
+	return &ref, noSupportData
+}
+
 // AutoScalePolicy
 func (s *DeleteDataGen) GetAutoScalePolicyTestObj() (*edgeproto.AutoScalePolicy, *testSupportData) {
 	obj := testutil.AutoScalePolicyData()[0]
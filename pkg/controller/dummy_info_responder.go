@@ -75,14 +75,14 @@ func (d *DummyInfoResponder) InitDummyInfoResponder() {
 	// init appinst obj
 	if d.AppInstCache != nil {
 		d.AppInstCache.SetNotifyCb(d.runAppInstChanged)
-		d.AppInstCache.SetDeletedCb(d.runAppInstDeleted)
+		d.AppInstCache.AddDeletedCb(d.runAppInstDeleted)
 	}
 	edgeproto.InitAppInstInfoCache(&d.AppInstInfoCache)
 	d.AppInstInfoCache.SetNotifyCb(d.appInstInfoCb)
 	// init clusterinst obj
 	if d.ClusterInstCache != nil {
 		d.ClusterInstCache.SetNotifyCb(d.runClusterInstChanged)
-		d.ClusterInstCache.SetDeletedCb(d.runClusterInstDeleted)
+		d.ClusterInstCache.AddDeletedCb(d.runClusterInstDeleted)
 	}
 	edgeproto.InitClusterInstInfoCache(&d.ClusterInstInfoCache)
 	d.ClusterInstInfoCache.SetNotifyCb(d.clusterInstInfoCb)
@@ -151,6 +151,15 @@ func (s *FlavorApi) ShowFlavor(in *edgeproto.Flavor, cb edgeproto.FlavorApi_Show
 	return err
 }
 
+func (s *FlavorApi) ShowFlavorHistory(in *edgeproto.Flavor, cb edgeproto.FlavorApi_ShowFlavorHistoryServer) error {
+	return showObjHistory(cb.Context(), s.all, s.sync, s.cache.GetTypeString(), in, func(rev int64, obj *edgeproto.Flavor) error {
+		return cb.Send(&edgeproto.FlavorHistory{
+			Revision: rev,
+			Obj:      *obj,
+		})
+	})
+}
+
 func (s *FlavorApi) AddFlavorRes(ctx context.Context, in *edgeproto.Flavor) (*edgeproto.Result, error) {
 
 	var flav edgeproto.Flavor
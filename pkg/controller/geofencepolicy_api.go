@@ -0,0 +1,181 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon/svcnode"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/regiondata"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+// Should only be one of these instantiated in main
+type GeoFencePolicyApi struct {
+	all   *AllApis
+	sync  *regiondata.Sync
+	store edgeproto.GeoFencePolicyStore
+	cache edgeproto.GeoFencePolicyCache
+}
+
+func NewGeoFencePolicyApi(sync *regiondata.Sync, all *AllApis) *GeoFencePolicyApi {
+	geoFencePolicyApi := GeoFencePolicyApi{}
+	geoFencePolicyApi.all = all
+	geoFencePolicyApi.sync = sync
+	geoFencePolicyApi.store = edgeproto.NewGeoFencePolicyStore(sync.GetKVStore())
+	edgeproto.InitGeoFencePolicyCache(&geoFencePolicyApi.cache)
+	sync.RegisterCache(&geoFencePolicyApi.cache)
+	return &geoFencePolicyApi
+}
+
+func (s *GeoFencePolicyApi) CreateGeoFencePolicy(ctx context.Context, in *edgeproto.GeoFencePolicy) (*edgeproto.Result, error) {
+	log.SpanLog(ctx, log.DebugLevelApi, "CreateGeoFencePolicy", "policy", in.Key.String())
+	if err := in.Validate(edgeproto.GeoFencePolicyAllFieldsMap); err != nil {
+		return &edgeproto.Result{}, err
+	}
+	if err := checkGeoFenceCountrySupport(in); err != nil {
+		return &edgeproto.Result{}, err
+	}
+	err := s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+		if s.store.STMGet(stm, &in.Key, nil) {
+			return in.Key.ExistsError()
+		}
+		s.store.STMPut(stm, in)
+		return nil
+	})
+	return &edgeproto.Result{}, err
+}
+
+func (s *GeoFencePolicyApi) UpdateGeoFencePolicy(ctx context.Context, in *edgeproto.GeoFencePolicy) (*edgeproto.Result, error) {
+	err := s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+		cur := edgeproto.GeoFencePolicy{}
+		if !s.store.STMGet(stm, &in.Key, &cur) {
+			return in.Key.NotFoundError()
+		}
+		if cur.DeletePrepare {
+			return in.Key.BeingDeletedError()
+		}
+		changed := cur.CopyInFields(in)
+		if changed == 0 {
+			return nil
+		}
+		if err := cur.Validate(nil); err != nil {
+			return err
+		}
+		if err := checkGeoFenceCountrySupport(&cur); err != nil {
+			return err
+		}
+		s.store.STMPut(stm, &cur)
+		return nil
+	})
+	return &edgeproto.Result{}, err
+}
+
+// checkGeoFenceCountrySupport rejects policies with country fences
+// if any DME is unable to resolve countries, as such DMEs would
+// deny all devices outside of the policy's polygon fences.
+func checkGeoFenceCountrySupport(in *edgeproto.GeoFencePolicy) error {
+	hasCountryCodes := false
+	for _, fence := range in.Fences {
+		if len(fence.CountryCodes) > 0 {
+			hasCountryCodes = true
+			break
+		}
+	}
+	if !hasCountryCodes {
+		return nil
+	}
+	missing := []string{}
+	nodeMgr.SvcNodeCache.Mux.Lock()
+	for key, data := range nodeMgr.SvcNodeCache.Objs {
+		if key.Type != svcnode.SvcNodeTypeDME {
+			continue
+		}
+		if data.Obj.Properties[cloudcommon.NodePropGeoCountryResolver] != "true" {
+			missing = append(missing, key.Name)
+		}
+	}
+	nodeMgr.SvcNodeCache.Mux.Unlock()
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("Country code geo-fences are not supported by DMEs %s, DMEs must be configured with -countryPolygonsFile", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func (s *GeoFencePolicyApi) DeleteGeoFencePolicy(ctx context.Context, in *edgeproto.GeoFencePolicy) (res *edgeproto.Result, reterr error) {
+	err := s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+		cur := edgeproto.GeoFencePolicy{}
+		if !s.store.STMGet(stm, &in.Key, &cur) {
+			return in.Key.NotFoundError()
+		}
+		if cur.DeletePrepare {
+			return in.Key.BeingDeletedError()
+		}
+		cur.DeletePrepare = true
+		s.store.STMPut(stm, &cur)
+		return nil
+	})
+	if err != nil {
+		return &edgeproto.Result{}, err
+	}
+	defer func() {
+		if reterr == nil {
+			return
+		}
+		err := s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+			cur := edgeproto.GeoFencePolicy{}
+			if !s.store.STMGet(stm, &in.Key, &cur) {
+				return nil
+			}
+			if cur.DeletePrepare {
+				cur.DeletePrepare = false
+				s.store.STMPut(stm, &cur)
+			}
+			return nil
+		})
+		if err != nil {
+			log.SpanLog(ctx, log.DebugLevelApi, "undo delete prepare failed", "key", in.Key, "err", err)
+		}
+	}()
+
+	if appKey := s.all.appApi.UsesGeoFencePolicy(&in.Key); appKey != nil {
+		return &edgeproto.Result{}, fmt.Errorf("Policy in use by App %s", appKey.GetKeyString())
+	}
+	return s.store.Delete(ctx, in, s.sync.SyncWait)
+}
+
+func (s *GeoFencePolicyApi) ShowGeoFencePolicy(in *edgeproto.GeoFencePolicy, cb edgeproto.GeoFencePolicyApi_ShowGeoFencePolicyServer) error {
+	err := s.cache.Show(in, func(obj *edgeproto.GeoFencePolicy) error {
+		err := cb.Send(obj)
+		return err
+	})
+	return err
+}
+
+func (s *GeoFencePolicyApi) ShowGeoFencePolicyHistory(in *edgeproto.GeoFencePolicy, cb edgeproto.GeoFencePolicyApi_ShowGeoFencePolicyHistoryServer) error {
+	return showObjHistory(cb.Context(), s.all, s.sync, s.cache.GetTypeString(), in, func(rev int64, obj *edgeproto.GeoFencePolicy) error {
+		return cb.Send(&edgeproto.GeoFencePolicyHistory{
+			Revision: rev,
+			Obj:      *obj,
+		})
+	})
+}
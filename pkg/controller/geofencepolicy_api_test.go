@@ -0,0 +1,177 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon/svcnode"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/regiondata"
+	"github.com/edgexr/edge-cloud-platform/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeoFencePolicyApi(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelEtcd | log.DebugLevelApi)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+	testSvcs := testinit(ctx, t)
+	defer testfinish(testSvcs)
+
+	dummy := regiondata.InMemoryStore{}
+	dummy.Start()
+	defer dummy.Stop()
+
+	sync := regiondata.InitSync(&dummy)
+	apis := NewAllApis(sync)
+	sync.Start()
+	defer sync.Done()
+
+	testutil.InternalFlavorCreate(t, apis.flavorApi, testutil.FlavorData())
+
+	// invalid policies
+	policy := testutil.GeoFencePolicyData()[0]
+	policy.Fences = nil
+	_, err := apis.geoFencePolicyApi.CreateGeoFencePolicy(ctx, &policy)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "At least one geo-fence must be specified")
+
+	policy = testutil.GeoFencePolicyData()[0]
+	policy.Fences = []edgeproto.GeoFence{{
+		Name: "empty",
+	}}
+	_, err = apis.geoFencePolicyApi.CreateGeoFencePolicy(ctx, &policy)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "polygon or country codes must be specified")
+
+	policy.Fences = []edgeproto.GeoFence{{
+		Name:         "lowercase",
+		CountryCodes: []string{"de"},
+	}}
+	_, err = apis.geoFencePolicyApi.CreateGeoFencePolicy(ctx, &policy)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "invalid country code")
+
+	policy.Fences = []edgeproto.GeoFence{{
+		Name: "line",
+		Polygon: []dme.Loc{{
+			Latitude:  1,
+			Longitude: 1,
+		}, {
+			Latitude:  2,
+			Longitude: 2,
+		}},
+	}}
+	_, err = apis.geoFencePolicyApi.CreateGeoFencePolicy(ctx, &policy)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "polygon must have at least 3 points")
+
+	policy.Fences = []edgeproto.GeoFence{{
+		Name: "badlat",
+		Polygon: []dme.Loc{{
+			Latitude: 91,
+		}, {
+			Latitude:  2,
+			Longitude: 2,
+		}, {
+			Latitude:  3,
+			Longitude: 1,
+		}},
+	}}
+	_, err = apis.geoFencePolicyApi.CreateGeoFencePolicy(ctx, &policy)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "invalid polygon latitude")
+
+	policy = testutil.GeoFencePolicyData()[0]
+	policy.Fences = append(policy.Fences, policy.Fences[0])
+	_, err = apis.geoFencePolicyApi.CreateGeoFencePolicy(ctx, &policy)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Duplicate geo-fence name")
+
+	// country fences are rejected if DMEs cannot resolve countries
+	if nodeMgr.SvcNodeCache.Objs == nil {
+		edgeproto.InitSvcNodeCache(&nodeMgr.SvcNodeCache.SvcNodeCache)
+	}
+	dmeNode := edgeproto.SvcNode{
+		Key: edgeproto.SvcNodeKey{
+			Name: "dme1",
+			Type: svcnode.SvcNodeTypeDME,
+		},
+	}
+	nodeMgr.SvcNodeCache.SvcNodeCache.Update(ctx, &dmeNode, 0)
+	defer nodeMgr.SvcNodeCache.SvcNodeCache.Delete(ctx, &dmeNode, 0)
+	policy = testutil.GeoFencePolicyData()[0]
+	_, err = apis.geoFencePolicyApi.CreateGeoFencePolicy(ctx, &policy)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Country code geo-fences are not supported by DMEs dme1")
+	dmeNode.Properties = map[string]string{
+		cloudcommon.NodePropGeoCountryResolver: "true",
+	}
+	nodeMgr.SvcNodeCache.SvcNodeCache.Update(ctx, &dmeNode, 0)
+
+	testutil.InternalGeoFencePolicyTest(t, "cud", apis.geoFencePolicyApi, testutil.GeoFencePolicyData())
+
+	// App cannot refer to a non-existent policy
+	app := testutil.AppData()[0]
+	app.GeoFencePolicy = "nonexistent"
+	_, err = apis.appApi.CreateApp(ctx, &app)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "not found")
+
+	app.GeoFencePolicy = testutil.GeoFencePolicyData()[0].Key.Name
+	_, err = apis.appApi.CreateApp(ctx, &app)
+	require.Nil(t, err)
+
+	// policy cannot be deleted while in use
+	policy = testutil.GeoFencePolicyData()[0]
+	_, err = apis.geoFencePolicyApi.DeleteGeoFencePolicy(ctx, &policy)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Policy in use by App")
+	check := edgeproto.GeoFencePolicy{}
+	require.True(t, apis.geoFencePolicyApi.cache.Get(&policy.Key, &check))
+	require.False(t, check.DeletePrepare)
+
+	// update policy
+	policy.Fences[0].CountryCodes = []string{"DE"}
+	policy.Fields = []string{
+		edgeproto.GeoFencePolicyFieldFences,
+		edgeproto.GeoFencePolicyFieldFencesCountryCodes,
+	}
+	_, err = apis.geoFencePolicyApi.UpdateGeoFencePolicy(ctx, &policy)
+	require.Nil(t, err)
+	require.True(t, apis.geoFencePolicyApi.cache.Get(&policy.Key, &check))
+	require.Equal(t, []string{"DE"}, check.Fences[0].CountryCodes)
+
+	policy.Fences[0].CountryCodes = []string{"Germany"}
+	_, err = apis.geoFencePolicyApi.UpdateGeoFencePolicy(ctx, &policy)
+	require.NotNil(t, err)
+
+	// remove policy from App
+	app.GeoFencePolicy = ""
+	app.Fields = []string{edgeproto.AppFieldGeoFencePolicy}
+	_, err = apis.appApi.UpdateApp(ctx, &app)
+	require.Nil(t, err)
+
+	_, err = apis.geoFencePolicyApi.DeleteGeoFencePolicy(ctx, &policy)
+	require.Nil(t, err)
+	_, err = apis.appApi.DeleteApp(ctx, &app)
+	require.Nil(t, err)
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/api/nbi"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
 	"google.golang.org/grpc"
 )
@@ -198,6 +199,70 @@ func (s *NBIAPI) DeleteAppInstance(ctx context.Context, request nbi.DeleteAppIns
 	return resp, nil
 }
 
+func (s *NBIAPI) UpdateAppInstance(ctx context.Context, request nbi.UpdateAppInstanceRequestObject) (nbi.UpdateAppInstanceResponseObject, error) {
+	status, err := s.updateAppInstance(ctx, request.AppInstanceId, request.Body, NBIOperationActionUpdate)
+	if err != nil {
+		return nil, err
+	}
+	resp := nbi.UpdateAppInstance202JSONResponse{}
+	resp.Body = *status
+	resp.Headers.Location = nbiOperationLocation(status.OperationId)
+	return resp, nil
+}
+
+func (s *NBIAPI) ReplaceAppInstance(ctx context.Context, request nbi.ReplaceAppInstanceRequestObject) (nbi.ReplaceAppInstanceResponseObject, error) {
+	status, err := s.updateAppInstance(ctx, request.AppInstanceId, request.Body, NBIOperationActionReplace)
+	if err != nil {
+		return nil, err
+	}
+	resp := nbi.ReplaceAppInstance202JSONResponse{}
+	resp.Body = *status
+	resp.Headers.Location = nbiOperationLocation(status.OperationId)
+	return resp, nil
+}
+
+// updateAppInstance validates the update and then runs it as
+// an asynchronous operation.
+func (s *NBIAPI) updateAppInstance(ctx context.Context, appInstID string, body *nbi.AppInstanceUpdate, action string) (*nbi.OperationStatus, error) {
+	if body == nil {
+		return nil, nbi.NewErrorInfo(http.StatusBadRequest, "missing request body")
+	}
+	cur, err := s.allApis.appInstApi.getAppInstByID(ctx, appInstID)
+	if err != nil {
+		return nil, nbi.NewErrorInfo(http.StatusBadRequest, err.Error())
+	}
+	if cur == nil {
+		return nil, nbi.NewErrorInfo(http.StatusNotFound, "app instance not found")
+	}
+	replace := action == NBIOperationActionReplace
+	appInst, err := ProtoAppInstUpdate(cur, body, replace)
+	if err != nil {
+		return nil, nbi.NewErrorInfo(http.StatusBadRequest, err.Error())
+	}
+	app := edgeproto.App{}
+	if !s.allApis.appApi.cache.Get(&cur.AppKey, &app) {
+		return nil, nbi.NewErrorInfo(http.StatusNotFound, "app not found")
+	}
+	if appInst.Replicas != 0 && app.Deployment != cloudcommon.DeploymentTypeKubernetes {
+		return nil, nbi.NewErrorInfo(http.StatusBadRequest, "replicas can only be specified for kubernetes deployments")
+	}
+	if err := appInst.ValidateUpdateFields(); err != nil {
+		return nil, nbi.NewErrorInfo(http.StatusBadRequest, err.Error())
+	}
+	if err := appInst.Validate(edgeproto.MakeFieldMap(appInst.Fields)); err != nil {
+		return nil, nbi.NewErrorInfo(http.StatusBadRequest, err.Error())
+	}
+	op, err := newNBIOperation(ctx, NBIOperationResourceAppInst, appInstID, action)
+	if err != nil {
+		return nil, nbi.NewErrorInfo(http.StatusInternalServerError, err.Error())
+	}
+	op.run(ctx, func(ctx context.Context, cb *StreamoutOperation) error {
+		return s.allApis.appInstApi.UpdateAppInst(appInst, cb)
+	})
+	status := op.Status()
+	return &status, nil
+}
+
 type StreamoutAppInst struct {
 	grpc.ServerStream
 	ctx context.Context
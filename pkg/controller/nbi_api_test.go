@@ -16,14 +16,22 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/api/nbi"
 	"github.com/edgexr/edge-cloud-platform/pkg/ccrmdummy"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
 	"github.com/edgexr/edge-cloud-platform/pkg/regiondata"
+	"github.com/edgexr/edge-cloud-platform/pkg/vault"
 	"github.com/edgexr/edge-cloud-platform/test/nbitest"
 	"github.com/edgexr/edge-cloud-platform/test/testutil"
 	"github.com/stretchr/testify/require"
@@ -66,6 +74,10 @@ func TestNBIAPI(t *testing.T) {
 
 	nbiApis := NewNBIAPI(apis)
 
+	sink := newTestNBISink(t, ctx, nbiApis)
+	defer sink.close()
+	appInstIDs := []string{}
+
 	// Test NBI by iterating over every App, creating the App,
 	// deploying the App to every Zone, and then cleaning up.
 	for _, appData := range nbitest.AppData() {
@@ -122,6 +134,7 @@ func TestNBIAPI(t *testing.T) {
 			require.True(t, ok)
 			require.NotNil(t, resp202.Body.AppInstanceId)
 			appInstID := resp202.Body.AppInstanceId
+			appInstIDs = append(appInstIDs, appInstID)
 
 			// do a get to check it
 			getReq := nbi.GetAppInstanceRequestObject{}
@@ -159,6 +172,9 @@ func TestNBIAPI(t *testing.T) {
 			appInstOut.KubernetesClusterRef = nil
 			require.Equal(t, *expInst, appInstOut)
 
+			// update the AppInst
+			testNBIUpdateAppInst(t, ctx, apis, nbiApis, appInstID)
+
 			// delete the AppInst
 			delReq := nbi.DeleteAppInstanceRequestObject{}
 			delReq.AppInstanceId = appInstID
@@ -191,6 +207,7 @@ func TestNBIAPI(t *testing.T) {
 		_, err = nbiApis.GetApp(ctx, getReq)
 		requireErrRespCode(t, http.StatusNotFound, err)
 	}
+	sink.verify(t, ctx, nbiApis, appInstIDs)
 }
 
 func requireErrRespCode(t *testing.T, code int, err error) {
@@ -203,3 +220,392 @@ func requireErrRespCode(t *testing.T, code int, err error) {
 	}
 	require.Equal(t, code, errorInfo.Status)
 }
+
+func testNBIUpdateAppInst(t *testing.T, ctx context.Context, apis *AllApis, nbiApis *NBIAPI, appInstID string) {
+	getAppInst := func() *edgeproto.AppInst {
+		appInst, err := apis.appInstApi.getAppInstByID(ctx, appInstID)
+		require.Nil(t, err)
+		require.NotNil(t, appInst)
+		return appInst
+	}
+	waitOp := func(opID string) nbi.OperationStatus {
+		var status nbi.OperationStatus
+		for ii := 0; ii < 50; ii++ {
+			resp, err := nbiApis.GetOperation(ctx, nbi.GetOperationRequestObject{
+				OperationId: opID,
+			})
+			require.Nil(t, err)
+			resp200, ok := resp.(nbi.GetOperation200JSONResponse)
+			require.True(t, ok)
+			status = resp200.Body
+			if status.State == nbi.SUCCEEDED || status.State == nbi.FAILED {
+				break
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+		return status
+	}
+
+	requireOpSucceeded := func(t *testing.T, status nbi.OperationStatus) {
+		if status.State == nbi.FAILED && status.Error != nil {
+			require.Fail(t, "operation failed", *status.Error)
+		}
+		require.Equal(t, nbi.SUCCEEDED, status.State)
+	}
+
+	app := edgeproto.App{}
+	require.True(t, apis.appApi.cache.Get(&getAppInst().AppKey, &app))
+	isKubernetes := app.Deployment == cloudcommon.DeploymentTypeKubernetes
+
+	// patch env vars and replicas
+	envVars := []nbi.EnvVar{{
+		Name:  "FOO",
+		Value: "bar",
+	}}
+	updReq := nbi.UpdateAppInstanceRequestObject{
+		AppInstanceId: appInstID,
+		Body: &nbi.AppInstanceUpdate{
+			EnvVars: &envVars,
+			// resources are reserved per replica, so the cluster
+			// created for the AppInst only fits one replica
+			Replicas: toPtr(1),
+		},
+	}
+	if !isKubernetes {
+		// replicas only apply to kubernetes deployments
+		_, err := nbiApis.UpdateAppInstance(ctx, updReq)
+		requireErrRespCode(t, http.StatusBadRequest, err)
+		updReq.Body.Replicas = nil
+	}
+	updResp, err := nbiApis.UpdateAppInstance(ctx, updReq)
+	require.Nil(t, err)
+	updResp202, ok := updResp.(nbi.UpdateAppInstance202JSONResponse)
+	require.True(t, ok)
+	opID := updResp202.Body.OperationId
+	require.NotEmpty(t, opID)
+	require.Equal(t, appInstID, updResp202.Body.ResourceId)
+	require.Equal(t, NBIOperationActionUpdate, updResp202.Body.Action)
+	require.Equal(t, cloudcommon.NBIRootPath+"/operations/"+opID, updResp202.Headers.Location)
+	status := waitOp(opID)
+	requireOpSucceeded(t, status)
+	require.NotNil(t, status.CreatedAt)
+	require.NotNil(t, status.UpdatedAt)
+
+	appInst := getAppInst()
+	if isKubernetes {
+		require.Equal(t, int32(1), appInst.Replicas)
+	}
+	require.Equal(t, 1, len(appInst.Configs))
+	require.Equal(t, edgeproto.AppConfigEnvYaml, appInst.Configs[0].Kind)
+	require.Equal(t, "- name: FOO\n  value: bar\n", appInst.Configs[0].Config)
+	flavor := appInst.Flavor
+
+	// replace resets unspecified fields
+	replReq := nbi.ReplaceAppInstanceRequestObject{
+		AppInstanceId: appInstID,
+		Body: &nbi.AppInstanceUpdate{
+			KubernetesResources: nbiKubernetesResources(appInst.KubernetesResources, appInst.IsStandalone),
+		},
+	}
+	replResp, err := nbiApis.ReplaceAppInstance(ctx, replReq)
+	require.Nil(t, err)
+	replResp202, ok := replResp.(nbi.ReplaceAppInstance202JSONResponse)
+	require.True(t, ok)
+	require.Equal(t, NBIOperationActionReplace, replResp202.Body.Action)
+	status = waitOp(replResp202.Body.OperationId)
+	requireOpSucceeded(t, status)
+	appInst = getAppInst()
+	require.Equal(t, int32(0), appInst.Replicas)
+	require.Equal(t, 0, len(appInst.Configs))
+	require.Equal(t, "", appInst.Flavor.Name)
+
+	// restore the flavor
+	if flavor.Name != "" {
+		updReq = nbi.UpdateAppInstanceRequestObject{
+			AppInstanceId: appInstID,
+			Body: &nbi.AppInstanceUpdate{
+				FlavorId: &flavor.Name,
+			},
+		}
+		updResp, err = nbiApis.UpdateAppInstance(ctx, updReq)
+		require.Nil(t, err)
+		updResp202, ok = updResp.(nbi.UpdateAppInstance202JSONResponse)
+		require.True(t, ok)
+		status = waitOp(updResp202.Body.OperationId)
+		requireOpSucceeded(t, status)
+		require.Equal(t, flavor, getAppInst().Flavor)
+	}
+
+	// errors
+	updReq = nbi.UpdateAppInstanceRequestObject{
+		AppInstanceId: appInstID,
+		Body: &nbi.AppInstanceUpdate{
+			FlavorId:            toPtr("x1.small"),
+			KubernetesResources: &nbi.KubernetesResources{},
+		},
+	}
+	_, err = nbiApis.UpdateAppInstance(ctx, updReq)
+	requireErrRespCode(t, http.StatusBadRequest, err)
+	updReq.Body = &nbi.AppInstanceUpdate{
+		Replicas: toPtr(-1),
+	}
+	_, err = nbiApis.UpdateAppInstance(ctx, updReq)
+	requireErrRespCode(t, http.StatusBadRequest, err)
+	updReq.Body = &nbi.AppInstanceUpdate{}
+	_, err = nbiApis.UpdateAppInstance(ctx, updReq)
+	requireErrRespCode(t, http.StatusBadRequest, err)
+	updReq.AppInstanceId = "no-such-id"
+	updReq.Body = &nbi.AppInstanceUpdate{
+		Replicas: toPtr(1),
+	}
+	_, err = nbiApis.UpdateAppInstance(ctx, updReq)
+	requireErrRespCode(t, http.StatusNotFound, err)
+	_, err = nbiApis.ReplaceAppInstance(ctx, nbi.ReplaceAppInstanceRequestObject{
+		AppInstanceId: appInstID,
+		Body:          &nbi.AppInstanceUpdate{},
+	})
+	requireErrRespCode(t, http.StatusBadRequest, err)
+	_, err = nbiApis.GetOperation(ctx, nbi.GetOperationRequestObject{
+		OperationId: "no-such-id",
+	})
+	requireErrRespCode(t, http.StatusNotFound, err)
+
+	// failed operations report the error
+	updReq.AppInstanceId = appInstID
+	updReq.Body = &nbi.AppInstanceUpdate{
+		FlavorId: toPtr("no-such-flavor"),
+	}
+	updResp, err = nbiApis.UpdateAppInstance(ctx, updReq)
+	require.Nil(t, err)
+	updResp202, ok = updResp.(nbi.UpdateAppInstance202JSONResponse)
+	require.True(t, ok)
+	status = waitOp(updResp202.Body.OperationId)
+	require.Equal(t, nbi.FAILED, status.State)
+	require.NotNil(t, status.Error)
+	require.Contains(t, *status.Error, "not found")
+}
+
+// testNBISink subscribes to AppInst events and records the
+// notifications it receives. A second subscription points to a
+// sink that always fails, to check dead letters.
+type testNBISink struct {
+	server     *httptest.Server
+	failServer *httptest.Server
+	subID      string
+	failSubID  string
+	mux        sync.Mutex
+	events     map[nbi.SubscriptionEventType][]nbi.CloudEvent
+	authFailed bool
+	oldBackoff time.Duration
+	restore    func()
+}
+
+func newTestNBISink(t *testing.T, ctx context.Context, nbiApis *NBIAPI) *testNBISink {
+	sink := &testNBISink{
+		events:     map[nbi.SubscriptionEventType][]nbi.CloudEvent{},
+		oldBackoff: NBIEventRetryBackoff,
+	}
+	NBIEventRetryBackoff = time.Millisecond
+	sink.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		event := nbi.CloudEvent{}
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		sink.mux.Lock()
+		defer sink.mux.Unlock()
+		if r.Header.Get("Authorization") != "Bearer sink-token" {
+			sink.authFailed = true
+		}
+		sink.events[event.Type] = append(sink.events[event.Type], event)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	sink.failServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+
+	// sinks must be public addresses
+	for _, sinkURL := range []string{
+		sink.server.URL,
+		"http://169.254.169.254/latest/meta-data",
+		"http://10.1.1.1/hook",
+	} {
+		_, err := nbiApis.CreateSubscription(ctx, nbi.CreateSubscriptionRequestObject{
+			Body: &nbi.SubscriptionRequest{
+				Sink:  sinkURL,
+				Types: []nbi.SubscriptionEventType{nbi.OrgCamaraprojectEdgeApplicationManagementV0AppInstanceReady},
+			},
+		})
+		requireErrRespCode(t, http.StatusBadRequest, err)
+	}
+	sink.restore = allowLocalNBISinks(nbiApis.allApis)
+
+	// invalid requests
+	badReqs := []nbi.SubscriptionRequest{{
+		Sink:  "not-a-url",
+		Types: []nbi.SubscriptionEventType{nbi.OrgCamaraprojectEdgeApplicationManagementV0AppInstanceReady},
+	}, {
+		Sink: sink.server.URL,
+	}, {
+		Sink:  sink.server.URL,
+		Types: []nbi.SubscriptionEventType{"foo"},
+	}, {
+		Sink:  sink.server.URL,
+		Types: []nbi.SubscriptionEventType{nbi.OrgCamaraprojectEdgeApplicationManagementV0AppInstanceReady},
+		SinkCredential: &nbi.SinkCredential{
+			CredentialType: nbi.ACCESSTOKEN,
+		},
+	}}
+	for _, badReq := range badReqs {
+		_, err := nbiApis.CreateSubscription(ctx, nbi.CreateSubscriptionRequestObject{
+			Body: &badReq,
+		})
+		requireErrRespCode(t, http.StatusBadRequest, err)
+	}
+
+	create := func(req *nbi.SubscriptionRequest) string {
+		resp, err := nbiApis.CreateSubscription(ctx, nbi.CreateSubscriptionRequestObject{
+			Body: req,
+		})
+		require.Nil(t, err)
+		resp201, ok := resp.(nbi.CreateSubscription201JSONResponse)
+		require.True(t, ok, "expect 201 but got %T", resp)
+		require.NotEmpty(t, resp201.Body.Id)
+		require.Equal(t, req.Sink, resp201.Body.Sink)
+		require.Equal(t, req.Types, resp201.Body.Types)
+		return resp201.Body.Id
+	}
+	sink.subID = create(&nbi.SubscriptionRequest{
+		Sink: sink.server.URL,
+		Types: []nbi.SubscriptionEventType{
+			nbi.OrgCamaraprojectEdgeApplicationManagementV0AppInstanceReady,
+			nbi.OrgCamaraprojectEdgeApplicationManagementV0AppInstanceDeleted,
+		},
+		SinkCredential: &nbi.SinkCredential{
+			CredentialType: nbi.ACCESSTOKEN,
+			AccessToken:    "sink-token",
+		},
+	})
+	sink.failSubID = create(&nbi.SubscriptionRequest{
+		Sink: sink.failServer.URL,
+		Types: []nbi.SubscriptionEventType{
+			nbi.OrgCamaraprojectEdgeApplicationManagementV0AppInstanceDeleted,
+		},
+	})
+
+	getResp, err := nbiApis.GetSubscriptions(ctx, nbi.GetSubscriptionsRequestObject{})
+	require.Nil(t, err)
+	getResp200, ok := getResp.(nbi.GetSubscriptions200JSONResponse)
+	require.True(t, ok)
+	require.Equal(t, 2, len(getResp200.Body))
+
+	// access token is only stored in vault
+	stored, err := getNBISubscription(ctx, sink.subID)
+	require.Nil(t, err)
+	require.Equal(t, RedactedAccessVarValue, stored.SinkCredential.AccessToken)
+	raw, err := redisClient.HGet(ctx, nbiSubscriptionsKey, sink.subID).Result()
+	require.Nil(t, err)
+	require.NotContains(t, raw, "sink-token")
+	token, err := stored.getAccessToken(ctx)
+	require.Nil(t, err)
+	require.Equal(t, "sink-token", token)
+	return sink
+}
+
+// allowLocalNBISinks disables the public address checks so that
+// subscriptions can target local test servers.
+func allowLocalNBISinks(apis *AllApis) func() {
+	origValidate := validateNBISinkURL
+	origClient := apis.nbiEventsApi.client
+	validateNBISinkURL = func(ctx context.Context, sink string) error {
+		u, err := url.Parse(sink)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid URL %q", sink)
+		}
+		return nil
+	}
+	apis.nbiEventsApi.client = &http.Client{
+		Timeout: NBIEventTimeout,
+	}
+	return func() {
+		validateNBISinkURL = origValidate
+		apis.nbiEventsApi.client = origClient
+	}
+}
+
+func (s *testNBISink) close() {
+	s.server.Close()
+	s.failServer.Close()
+	NBIEventRetryBackoff = s.oldBackoff
+	if s.restore != nil {
+		s.restore()
+	}
+}
+
+func (s *testNBISink) verify(t *testing.T, ctx context.Context, nbiApis *NBIAPI, appInstIDs []string) {
+	hasEvents := func(eventType nbi.SubscriptionEventType) bool {
+		s.mux.Lock()
+		defer s.mux.Unlock()
+		found := map[string]bool{}
+		for _, event := range s.events[eventType] {
+			found[event.Data.ResourceId] = true
+		}
+		for _, id := range appInstIDs {
+			if !found[id] {
+				return false
+			}
+		}
+		return true
+	}
+	require.Eventually(t, func() bool {
+		return hasEvents(nbi.OrgCamaraprojectEdgeApplicationManagementV0AppInstanceReady) &&
+			hasEvents(nbi.OrgCamaraprojectEdgeApplicationManagementV0AppInstanceDeleted)
+	}, 5*time.Second, 10*time.Millisecond)
+	s.mux.Lock()
+	require.False(t, s.authFailed)
+	for _, events := range s.events {
+		for _, event := range events {
+			require.Equal(t, s.subID, event.Data.SubscriptionId)
+			require.Equal(t, nbi.AppInstance, event.Data.ResourceType)
+			require.Equal(t, "1.0", event.Specversion)
+		}
+	}
+	s.mux.Unlock()
+
+	// failed deliveries are kept as dead letters
+	require.Eventually(t, func() bool {
+		resp, err := nbiApis.GetSubscriptionDeadLetters(ctx, nbi.GetSubscriptionDeadLettersRequestObject{
+			SubscriptionId: s.failSubID,
+		})
+		require.Nil(t, err)
+		resp200, ok := resp.(nbi.GetSubscriptionDeadLetters200JSONResponse)
+		require.True(t, ok)
+		return len(resp200.Body) == len(appInstIDs)
+	}, 5*time.Second, 10*time.Millisecond)
+	resp, err := nbiApis.GetSubscriptionDeadLetters(ctx, nbi.GetSubscriptionDeadLettersRequestObject{
+		SubscriptionId: s.failSubID,
+	})
+	require.Nil(t, err)
+	for _, dl := range resp.(nbi.GetSubscriptionDeadLetters200JSONResponse).Body {
+		require.Equal(t, NBIEventRetries+1, dl.Attempts)
+		require.Equal(t, s.failServer.URL, dl.Sink)
+		require.Equal(t, nbi.OrgCamaraprojectEdgeApplicationManagementV0AppInstanceDeleted, dl.Event.Type)
+	}
+
+	// delete subscriptions
+	for _, id := range []string{s.subID, s.failSubID} {
+		delResp, err := nbiApis.DeleteSubscription(ctx, nbi.DeleteSubscriptionRequestObject{
+			SubscriptionId: id,
+		})
+		require.Nil(t, err)
+		_, ok := delResp.(nbi.DeleteSubscription204Response)
+		require.True(t, ok)
+		_, err = nbiApis.GetSubscription(ctx, nbi.GetSubscriptionRequestObject{
+			SubscriptionId: id,
+		})
+		requireErrRespCode(t, http.StatusNotFound, err)
+	}
+	secrets := nbiSubscriptionSecrets{}
+	err = vault.GetData(vaultConfig, getNBISubscriptionVaultPath(s.subID), 0, &secrets)
+	require.NotNil(t, err)
+}
@@ -0,0 +1,298 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/api/nbi"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/regiondata"
+	"github.com/edgexr/edge-cloud-platform/pkg/util"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	opentracing "github.com/opentracing/opentracing-go"
+)
+
+// NBIEventsApi sends CloudEvents to NBI subscriptions when
+// AppInsts and ClusterInsts change state. Ready, health and
+// delete events are derived from cache updates, which run on
+// every controller replica, so each event is claimed in redis
+// to ensure only one replica delivers it. Failure events come
+// from the API call that failed, via the object's stream.
+
+var (
+	NBIEventTimeout      = 10 * time.Second
+	NBIEventRetries      = 3
+	NBIEventRetryBackoff = time.Second
+	NBIEventClaimTTL     = time.Hour
+)
+
+type NBIEventsApi struct {
+	all    *AllApis
+	client *http.Client
+}
+
+func NewNBIEventsApi(sync *regiondata.Sync, all *AllApis) *NBIEventsApi {
+	nbiEventsApi := NBIEventsApi{}
+	nbiEventsApi.all = all
+	nbiEventsApi.client = util.NewPublicHTTPClient(NBIEventTimeout)
+	all.appInstApi.cache.AddUpdatedCb(nbiEventsApi.appInstUpdated)
+	all.appInstApi.cache.AddDeletedCb(nbiEventsApi.appInstDeleted)
+	all.clusterInstApi.cache.AddUpdatedCb(nbiEventsApi.clusterInstUpdated)
+	all.clusterInstApi.cache.AddDeletedCb(nbiEventsApi.clusterInstDeleted)
+	return &nbiEventsApi
+}
+
+func nbiEventClaimKey(subID string, eventType nbi.SubscriptionEventType, eventKey string) string {
+	return "nbi-event/" + subID + "/" + string(eventType) + "/" + eventKey
+}
+
+func nbiAppInstEventData(inst *edgeproto.AppInst) nbi.InstanceEventData {
+	return nbi.InstanceEventData{
+		ResourceType: nbi.AppInstance,
+		ResourceId:   inst.ObjId,
+		Name:         inst.Key.Name,
+		Provider:     inst.Key.Organization,
+	}
+}
+
+func nbiClusterInstEventData(inst *edgeproto.ClusterInst) nbi.InstanceEventData {
+	return nbi.InstanceEventData{
+		ResourceType: nbi.Cluster,
+		ResourceId:   inst.ObjId,
+		Name:         inst.Key.Name,
+		Provider:     inst.Key.Organization,
+	}
+}
+
+// becameReady checks for a transition to the ready state. Objects
+// without a previous state are being loaded from etcd at startup
+// rather than changing state, so are ignored.
+func becameReady(old *edgeproto.TrackedState, new edgeproto.TrackedState) bool {
+	return old != nil && *old != edgeproto.TrackedState_READY && new == edgeproto.TrackedState_READY
+}
+
+func healthCheckFailed(hc dme.HealthCheck) bool {
+	return hc != dme.HealthCheck_HEALTH_CHECK_OK && hc != dme.HealthCheck_HEALTH_CHECK_UNKNOWN
+}
+
+func (s *NBIEventsApi) appInstUpdated(ctx context.Context, old *edgeproto.AppInst, new *edgeproto.AppInst) {
+	events := []nbi.SubscriptionEventType{}
+	var oldState *edgeproto.TrackedState
+	if old != nil {
+		oldState = &old.State
+	}
+	if becameReady(oldState, new.State) {
+		events = append(events, nbi.OrgCamaraprojectEdgeApplicationManagementV0AppInstanceReady)
+	}
+	if old != nil && old.HealthCheck == dme.HealthCheck_HEALTH_CHECK_OK && healthCheckFailed(new.HealthCheck) {
+		events = append(events, nbi.OrgCamaraprojectEdgeApplicationManagementV0AppInstanceUnhealthy)
+	}
+	if old != nil && healthCheckFailed(old.HealthCheck) && new.HealthCheck == dme.HealthCheck_HEALTH_CHECK_OK {
+		events = append(events, nbi.OrgCamaraprojectEdgeApplicationManagementV0AppInstanceHealthy)
+	}
+	if len(events) == 0 {
+		return
+	}
+	// Cache callbacks are run in order by the sync thread, so the
+	// cached modRev is the revision of this update.
+	var modRev int64
+	if !s.all.appInstApi.cache.GetWithRev(&new.Key, &edgeproto.AppInst{}, &modRev) {
+		return
+	}
+	data := nbiAppInstEventData(new)
+	if healthCheckFailed(new.HealthCheck) {
+		data.Message = toPtr(new.HealthCheck.String())
+	}
+	for _, eventType := range events {
+		s.send(ctx, eventType, data, fmt.Sprintf("%s/%d", new.ObjId, modRev))
+	}
+}
+
+func (s *NBIEventsApi) appInstDeleted(ctx context.Context, old *edgeproto.AppInst) {
+	s.send(ctx, nbi.OrgCamaraprojectEdgeApplicationManagementV0AppInstanceDeleted, nbiAppInstEventData(old), old.ObjId)
+}
+
+func (s *NBIEventsApi) clusterInstUpdated(ctx context.Context, old *edgeproto.ClusterInst, new *edgeproto.ClusterInst) {
+	var oldState *edgeproto.TrackedState
+	if old != nil {
+		oldState = &old.State
+	}
+	if !becameReady(oldState, new.State) {
+		return
+	}
+	var modRev int64
+	if !s.all.clusterInstApi.cache.GetWithRev(&new.Key, &edgeproto.ClusterInst{}, &modRev) {
+		return
+	}
+	s.send(ctx, nbi.OrgCamaraprojectEdgeApplicationManagementV0ClusterReady, nbiClusterInstEventData(new), fmt.Sprintf("%s/%d", new.ObjId, modRev))
+}
+
+func (s *NBIEventsApi) clusterInstDeleted(ctx context.Context, old *edgeproto.ClusterInst) {
+	s.send(ctx, nbi.OrgCamaraprojectEdgeApplicationManagementV0ClusterDeleted, nbiClusterInstEventData(old), old.ObjId)
+}
+
+// appInstFailed is called when an AppInst API call fails after
+// its stream was started.
+func (s *NBIEventsApi) appInstFailed(ctx context.Context, key *edgeproto.AppInstKey, sendObj *streamSend, objErr error) {
+	data := nbi.InstanceEventData{
+		ResourceType: nbi.AppInstance,
+		ResourceId:   sendObj.objID,
+		Name:         key.Name,
+		Provider:     key.Organization,
+		Message:      toPtr(objErr.Error()),
+	}
+	s.send(ctx, nbi.OrgCamaraprojectEdgeApplicationManagementV0AppInstanceFailed, data, fmt.Sprintf("%s/%d", sendObj.objID, sendObj.modRev))
+}
+
+// clusterInstFailed is called when a ClusterInst API call fails
+// after its stream was started.
+func (s *NBIEventsApi) clusterInstFailed(ctx context.Context, key *edgeproto.ClusterKey, sendObj *streamSend, objErr error) {
+	data := nbi.InstanceEventData{
+		ResourceType: nbi.Cluster,
+		ResourceId:   sendObj.objID,
+		Name:         key.Name,
+		Provider:     key.Organization,
+		Message:      toPtr(objErr.Error()),
+	}
+	s.send(ctx, nbi.OrgCamaraprojectEdgeApplicationManagementV0ClusterFailed, data, fmt.Sprintf("%s/%d", sendObj.objID, sendObj.modRev))
+}
+
+// send delivers the event to all subscriptions for the event type
+// in the background. The eventKey must uniquely identify the event
+// of the given type across controller replicas.
+func (s *NBIEventsApi) send(ctx context.Context, eventType nbi.SubscriptionEventType, data nbi.InstanceEventData, eventKey string) {
+	client := redisClient
+	if client == nil {
+		return
+	}
+	span := log.StartSpan(log.DebugLevelApi, "nbi event", opentracing.ChildOf(log.SpanFromContext(ctx).Context()))
+	span.SetTag("type", string(eventType))
+	span.SetTag("resourceId", data.ResourceId)
+	ectx := log.ContextWithSpan(context.Background(), span)
+
+	go func() {
+		defer span.Finish()
+		subs, err := getNBISubscriptions(ectx, client)
+		if err != nil {
+			log.SpanLog(ectx, log.DebugLevelApi, "failed to get nbi subscriptions", "err", err)
+			return
+		}
+		wg := sync.WaitGroup{}
+		for _, sub := range subs {
+			if !sub.hasType(eventType) {
+				continue
+			}
+			claimed, err := client.SetNX(ectx, nbiEventClaimKey(sub.Id, eventType, eventKey), 1, NBIEventClaimTTL).Result()
+			if err != nil {
+				log.SpanLog(ectx, log.DebugLevelApi, "failed to claim nbi event", "subscription", sub.Id, "event", eventKey, "err", err)
+				continue
+			}
+			if !claimed {
+				// another replica is sending it
+				continue
+			}
+			subData := data
+			subData.SubscriptionId = sub.Id
+			event := nbi.CloudEvent{
+				Id:              uuid.New().String(),
+				Source:          cloudcommon.NBIRootPath,
+				Type:            eventType,
+				Specversion:     "1.0",
+				Datacontenttype: toPtr("application/json"),
+				Time:            time.Now(),
+				Data:            subData,
+			}
+			wg.Add(1)
+			go func(sub *nbiSubscription) {
+				defer wg.Done()
+				s.deliver(ectx, client, sub, &event)
+			}(sub)
+		}
+		wg.Wait()
+	}()
+}
+
+// deliver sends the event to the subscription's sink, retrying
+// with exponential backoff. If all attempts fail the event is
+// saved as a dead letter.
+func (s *NBIEventsApi) deliver(ctx context.Context, client *redis.Client, sub *nbiSubscription, event *nbi.CloudEvent) {
+	backoff := NBIEventRetryBackoff
+	attempts := 0
+	var err error
+	for {
+		attempts++
+		err = s.post(ctx, sub, event)
+		if err == nil {
+			log.SpanLog(ctx, log.DebugLevelApi, "sent nbi event", "subscription", sub.Id, "sink", sub.Sink, "type", event.Type, "attempts", attempts)
+			return
+		}
+		log.SpanLog(ctx, log.DebugLevelApi, "failed to send nbi event", "subscription", sub.Id, "sink", sub.Sink, "type", event.Type, "attempt", attempts, "err", err)
+		if attempts > NBIEventRetries {
+			break
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+	dl := nbi.DeadLetter{
+		SubscriptionId: sub.Id,
+		Sink:           sub.Sink,
+		Event:          *event,
+		Attempts:       attempts,
+		LastError:      err.Error(),
+		FailedAt:       time.Now(),
+	}
+	if err := addNBIDeadLetter(ctx, client, &dl); err != nil {
+		log.SpanLog(ctx, log.DebugLevelApi, "failed to save nbi event dead letter", "subscription", sub.Id, "err", err)
+	}
+}
+
+func (s *NBIEventsApi) post(ctx context.Context, sub *nbiSubscription, event *nbi.CloudEvent) error {
+	out, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.Sink, bytes.NewBuffer(out))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/cloudevents+json")
+	token, err := sub.getAccessToken(ctx)
+	if err != nil {
+		return err
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("sink returned status %d", resp.StatusCode)
+	}
+	return nil
+}
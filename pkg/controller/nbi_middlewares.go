@@ -16,9 +16,11 @@ package controller
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/edgexr/edge-cloud-platform/api/nbi"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon/ratelimit"
 	"github.com/edgexr/edge-cloud-platform/pkg/echoutil"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
 	"github.com/labstack/echo/v4"
@@ -64,3 +66,31 @@ func NBIErrorHandler(next echo.HandlerFunc) echo.HandlerFunc {
 		return nil
 	}
 }
+
+// NBIRateLimiter rate limits NBI requests by operation ID and
+// client IP. The rate limit status is returned in the response headers
+// so clients can back off, and rejected requests get a 429 response.
+func NBIRateLimiter(limiter ratelimit.Limiter) nbi.StrictMiddlewareFunc {
+	return func(f nbi.StrictHandlerFunc, operationID string) nbi.StrictHandlerFunc {
+		return func(c echo.Context, request interface{}) (interface{}, error) {
+			ctx := c.Request().Context()
+			callerInfo := &ratelimit.CallerInfo{
+				Api: operationID,
+				Ip:  c.RealIP(),
+			}
+			limitStatus, err := limiter.Limit(ctx, callerInfo)
+			if limitStatus != nil {
+				limitStatus.SetHTTPHeaders(c.Response().Header())
+			}
+			if err != nil {
+				log.SpanLog(ctx, log.DebugLevelApi, "NBI API rate limited", "api", operationID, "err", err)
+				return nil, &nbi.ErrorInfo{
+					Status:  http.StatusTooManyRequests,
+					Code:    "TOO_MANY_REQUESTS",
+					Message: fmt.Sprintf("Request for %s rate limited, please retry later. Error is: %s.", operationID, err),
+				}
+			}
+			return f(c, request)
+		}
+	}
+}
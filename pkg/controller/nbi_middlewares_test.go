@@ -0,0 +1,66 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/nbi"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon/ratelimit"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNBIRateLimiter(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelApi)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+
+	e := echo.New()
+	e.Use(log.EchoTraceHandler, NBIErrorHandler)
+	limiter := ratelimit.NewIntervalLimiter(1, time.Minute)
+	handler := func(c echo.Context, request interface{}) (interface{}, error) {
+		return nil, c.NoContent(http.StatusOK)
+	}
+	handler = NBIRateLimiter(limiter)(handler, "GetApps")
+	e.GET("/apps", func(c echo.Context) error {
+		_, err := handler(c, nil)
+		return err
+	})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/apps", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "1", rec.Header().Get("RateLimit-Limit"))
+	require.Equal(t, "0", rec.Header().Get("RateLimit-Remaining"))
+	require.Equal(t, "60", rec.Header().Get("RateLimit-Reset"))
+	require.Equal(t, "", rec.Header().Get("Retry-After"))
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/apps", nil))
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "0", rec.Header().Get("RateLimit-Remaining"))
+	require.Equal(t, "60", rec.Header().Get("Retry-After"))
+	errInfo := nbi.ErrorInfo{}
+	err := json.Unmarshal(rec.Body.Bytes(), &errInfo)
+	require.Nil(t, err)
+	require.Equal(t, http.StatusTooManyRequests, errInfo.Status)
+	require.Equal(t, "TOO_MANY_REQUESTS", errInfo.Code)
+}
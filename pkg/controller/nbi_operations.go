@@ -0,0 +1,207 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/api/nbi"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/go-redis/redis/v8"
+	"github.com/oklog/ulid/v2"
+	opentracing "github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc"
+)
+
+// NBI operations track long running NBI API calls so that
+// clients can poll for progress instead of blocking until the
+// final state. Operations are stored in redis so that any
+// controller replica can serve the status, and expire after
+// NBIOperationTTL.
+
+const (
+	NBIOperationResourceAppInst = "appInstance"
+	NBIOperationActionUpdate    = "update"
+	NBIOperationActionReplace   = "replace"
+)
+
+var NBIOperationTTL = 24 * time.Hour
+
+func nbiOperationKey(id string) string {
+	return "nbi-operation/" + id
+}
+
+func nbiOperationLocation(id string) string {
+	return cloudcommon.NBIRootPath + "/operations/" + id
+}
+
+// NBIOperation tracks the status of a single operation.
+type NBIOperation struct {
+	mux    sync.Mutex
+	status nbi.OperationStatus
+}
+
+func newNBIOperation(ctx context.Context, resourceType, resourceId, action string) (*NBIOperation, error) {
+	now := time.Now()
+	op := &NBIOperation{}
+	op.status = nbi.OperationStatus{
+		OperationId:  ulid.Make().String(),
+		ResourceType: resourceType,
+		ResourceId:   resourceId,
+		Action:       action,
+		State:        nbi.PENDING,
+		CreatedAt:    &now,
+		UpdatedAt:    &now,
+	}
+	if err := op.saveLocked(ctx); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Status returns a copy of the current status
+func (s *NBIOperation) Status() nbi.OperationStatus {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	status := s.status
+	if s.status.Messages != nil {
+		msgs := append([]string{}, *s.status.Messages...)
+		status.Messages = &msgs
+	}
+	return status
+}
+
+func (s *NBIOperation) update(ctx context.Context, fn func(status *nbi.OperationStatus)) error {
+	// hold the lock while saving so that concurrent updates
+	// are written to redis in order
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	fn(&s.status)
+	now := time.Now()
+	s.status.UpdatedAt = &now
+	return s.saveLocked(ctx)
+}
+
+func (s *NBIOperation) saveLocked(ctx context.Context) error {
+	data, err := json.Marshal(&s.status)
+	if err != nil {
+		return err
+	}
+	return redisClient.Set(ctx, nbiOperationKey(s.status.OperationId), string(data), NBIOperationTTL).Err()
+}
+
+func (s *NBIOperation) addMessage(ctx context.Context, msg string) error {
+	return s.update(ctx, func(status *nbi.OperationStatus) {
+		if status.Messages == nil {
+			status.Messages = &[]string{}
+		}
+		*status.Messages = append(*status.Messages, msg)
+	})
+}
+
+// run runs the operation in the background. The passed in
+// context is only used for tracing, as the request context
+// will be cancelled once the NBI call returns.
+func (s *NBIOperation) run(ctx context.Context, fn func(ctx context.Context, cb *StreamoutOperation) error) {
+	span := log.StartSpan(log.DebugLevelApi, "nbi operation", opentracing.ChildOf(log.SpanFromContext(ctx).Context()))
+	status := s.Status()
+	span.SetTag("operationId", status.OperationId)
+	span.SetTag("resourceId", status.ResourceId)
+	span.SetTag("action", status.Action)
+	octx := log.ContextWithSpan(context.Background(), span)
+
+	go func() {
+		defer span.Finish()
+		err := s.update(octx, func(status *nbi.OperationStatus) {
+			status.State = nbi.INPROGRESS
+		})
+		if err != nil {
+			log.SpanLog(octx, log.DebugLevelApi, "failed to update nbi operation", "err", err)
+		}
+		opErr := fn(octx, NewStreamoutOperation(octx, s))
+		err = s.update(octx, func(status *nbi.OperationStatus) {
+			if opErr != nil {
+				status.State = nbi.FAILED
+				status.Error = toPtr(opErr.Error())
+			} else {
+				status.State = nbi.SUCCEEDED
+			}
+		})
+		if err != nil {
+			log.SpanLog(octx, log.DebugLevelApi, "failed to update nbi operation", "err", err)
+		}
+	}()
+}
+
+func getNBIOperation(ctx context.Context, id string) (*nbi.OperationStatus, error) {
+	data, err := redisClient.Get(ctx, nbiOperationKey(id)).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	status := &nbi.OperationStatus{}
+	if err := json.Unmarshal([]byte(data), status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// StreamoutOperation records API progress messages on the operation.
+type StreamoutOperation struct {
+	grpc.ServerStream
+	ctx context.Context
+	op  *NBIOperation
+}
+
+func NewStreamoutOperation(ctx context.Context, op *NBIOperation) *StreamoutOperation {
+	return &StreamoutOperation{
+		ctx: ctx,
+		op:  op,
+	}
+}
+
+func (s *StreamoutOperation) Send(res *edgeproto.Result) error {
+	log.SpanLog(s.ctx, log.DebugLevelApi, res.Message)
+	if err := s.op.addMessage(s.ctx, res.Message); err != nil {
+		log.SpanLog(s.ctx, log.DebugLevelApi, "failed to add nbi operation message", "err", err)
+	}
+	return nil
+}
+
+func (s *StreamoutOperation) Context() context.Context {
+	return s.ctx
+}
+
+func (s *NBIAPI) GetOperation(ctx context.Context, request nbi.GetOperationRequestObject) (nbi.GetOperationResponseObject, error) {
+	status, err := getNBIOperation(ctx, request.OperationId)
+	if err != nil {
+		return nil, nbi.NewErrorInfo(http.StatusInternalServerError, err.Error())
+	}
+	if status == nil {
+		return nil, nbi.NewErrorInfo(http.StatusNotFound, "operation not found")
+	}
+	resp := nbi.GetOperation200JSONResponse{}
+	resp.Body = *status
+	return resp, nil
+}
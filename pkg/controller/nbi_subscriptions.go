@@ -0,0 +1,322 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/nbi"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/util"
+	"github.com/edgexr/edge-cloud-platform/pkg/vault"
+	"github.com/go-redis/redis/v8"
+	"github.com/oklog/ulid/v2"
+)
+
+// NBI subscriptions register a sink URL that is sent CloudEvents
+// when AppInsts or ClusterInsts change state. Subscriptions are
+// stored in redis so that they are shared by all controller
+// replicas. Notifications that cannot be delivered are kept as
+// dead letters on the subscription. Sink access tokens are secrets,
+// so are stored in Vault, and redis only keeps a redacted copy.
+
+const nbiSubscriptionsKey = "nbi-subscriptions"
+
+// NBIDeadLetterMax is the number of dead letters kept per subscription.
+var NBIDeadLetterMax int64 = 100
+
+var nbiSubscriptionEventTypes = []nbi.SubscriptionEventType{
+	nbi.OrgCamaraprojectEdgeApplicationManagementV0AppInstanceReady,
+	nbi.OrgCamaraprojectEdgeApplicationManagementV0AppInstanceFailed,
+	nbi.OrgCamaraprojectEdgeApplicationManagementV0AppInstanceHealthy,
+	nbi.OrgCamaraprojectEdgeApplicationManagementV0AppInstanceUnhealthy,
+	nbi.OrgCamaraprojectEdgeApplicationManagementV0AppInstanceDeleted,
+	nbi.OrgCamaraprojectEdgeApplicationManagementV0ClusterReady,
+	nbi.OrgCamaraprojectEdgeApplicationManagementV0ClusterFailed,
+	nbi.OrgCamaraprojectEdgeApplicationManagementV0ClusterDeleted,
+}
+
+// nbiSubscription is the stored subscription. The sink
+// credential is never returned by the API, and its access token
+// is redacted once saved to Vault.
+type nbiSubscription struct {
+	nbi.Subscription
+	SinkCredential *nbi.SinkCredential `json:"sinkCredential,omitempty"`
+}
+
+type nbiSubscriptionSecrets struct {
+	AccessToken string `json:"accesstoken,omitempty"`
+}
+
+func (s *nbiSubscription) hasType(eventType nbi.SubscriptionEventType) bool {
+	return slices.Contains(s.Types, eventType)
+}
+
+func (s *nbiSubscription) hasAccessToken() bool {
+	return s.SinkCredential != nil && s.SinkCredential.AccessToken != ""
+}
+
+func getNBISubscriptionVaultPath(id string) string {
+	return fmt.Sprintf("secret/data/nbi-subscriptions/%s/%s", *region, id)
+}
+
+// saveNBISubscriptionSecrets stores the sink access token in Vault
+// and replaces it in the subscription with a redacted value.
+func saveNBISubscriptionSecrets(ctx context.Context, sub *nbiSubscription) error {
+	if !sub.hasAccessToken() {
+		return nil
+	}
+	secrets := nbiSubscriptionSecrets{
+		AccessToken: sub.SinkCredential.AccessToken,
+	}
+	log.SpanLog(ctx, log.DebugLevelApi, "storing nbi subscription secrets in vault", "id", sub.Id)
+	if err := vault.PutData(vaultConfig, getNBISubscriptionVaultPath(sub.Id), &secrets); err != nil {
+		return fmt.Errorf("unable to store subscription secrets, %s", err)
+	}
+	cred := *sub.SinkCredential
+	cred.AccessToken = RedactedAccessVarValue
+	sub.SinkCredential = &cred
+	return nil
+}
+
+// getAccessToken gets the sink access token from Vault.
+func (s *nbiSubscription) getAccessToken(ctx context.Context) (string, error) {
+	if !s.hasAccessToken() {
+		return "", nil
+	}
+	secrets := nbiSubscriptionSecrets{}
+	if err := vault.GetData(vaultConfig, getNBISubscriptionVaultPath(s.Id), 0, &secrets); err != nil {
+		return "", fmt.Errorf("unable to get subscription secrets, %s", err)
+	}
+	return secrets.AccessToken, nil
+}
+
+func nbiDeadLettersKey(id string) string {
+	return "nbi-subscription-dead-letters/" + id
+}
+
+// validateNBISinkURL restricts tenant supplied sink URLs to public
+// addresses. Addresses are checked again when connecting in case
+// the host is re-bound to a different address.
+// This is replaced for unit tests.
+var validateNBISinkURL = func(ctx context.Context, sink string) error {
+	return util.ValidatePublicURL(ctx, sink, "http", "https")
+}
+
+func validateSubscriptionRequest(ctx context.Context, req *nbi.SubscriptionRequest) error {
+	if err := validateNBISinkURL(ctx, req.Sink); err != nil {
+		return fmt.Errorf("invalid sink, %s", err)
+	}
+	if len(req.Types) == 0 {
+		return errors.New("at least one event type must be specified")
+	}
+	for _, t := range req.Types {
+		if !slices.Contains(nbiSubscriptionEventTypes, t) {
+			return fmt.Errorf("invalid event type %q", t)
+		}
+	}
+	if cred := req.SinkCredential; cred != nil {
+		if cred.CredentialType != nbi.ACCESSTOKEN {
+			return fmt.Errorf("invalid sink credential type %q, only %s is supported", cred.CredentialType, nbi.ACCESSTOKEN)
+		}
+		if cred.AccessToken == "" {
+			return errors.New("sink credential access token cannot be empty")
+		}
+	}
+	return nil
+}
+
+func saveNBISubscription(ctx context.Context, sub *nbiSubscription) error {
+	data, err := json.Marshal(sub)
+	if err != nil {
+		return err
+	}
+	return redisClient.HSet(ctx, nbiSubscriptionsKey, sub.Id, string(data)).Err()
+}
+
+func getNBISubscription(ctx context.Context, id string) (*nbiSubscription, error) {
+	data, err := redisClient.HGet(ctx, nbiSubscriptionsKey, id).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	sub := &nbiSubscription{}
+	if err := json.Unmarshal([]byte(data), sub); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+func getNBISubscriptions(ctx context.Context, client *redis.Client) ([]*nbiSubscription, error) {
+	vals, err := client.HGetAll(ctx, nbiSubscriptionsKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	subs := []*nbiSubscription{}
+	for id, data := range vals {
+		sub := &nbiSubscription{}
+		if err := json.Unmarshal([]byte(data), sub); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal subscription %s, %s", id, err)
+		}
+		subs = append(subs, sub)
+	}
+	slices.SortFunc(subs, func(a, b *nbiSubscription) int {
+		return strings.Compare(a.Id, b.Id)
+	})
+	return subs, nil
+}
+
+// deleteNBISubscription deletes the subscription and its dead
+// letters, and returns false if the subscription was not found.
+func deleteNBISubscription(ctx context.Context, id string) (bool, error) {
+	var del *redis.IntCmd
+	_, err := redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		del = pipe.HDel(ctx, nbiSubscriptionsKey, id)
+		pipe.Del(ctx, nbiDeadLettersKey(id))
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return del.Val() > 0, nil
+}
+
+func addNBIDeadLetter(ctx context.Context, client *redis.Client, dl *nbi.DeadLetter) error {
+	data, err := json.Marshal(dl)
+	if err != nil {
+		return err
+	}
+	key := nbiDeadLettersKey(dl.SubscriptionId)
+	_, err = client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.LPush(ctx, key, string(data))
+		pipe.LTrim(ctx, key, 0, NBIDeadLetterMax-1)
+		return nil
+	})
+	return err
+}
+
+func getNBIDeadLetters(ctx context.Context, id string) ([]nbi.DeadLetter, error) {
+	vals, err := redisClient.LRange(ctx, nbiDeadLettersKey(id), 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+	dls := []nbi.DeadLetter{}
+	for _, data := range vals {
+		dl := nbi.DeadLetter{}
+		if err := json.Unmarshal([]byte(data), &dl); err != nil {
+			return nil, err
+		}
+		dls = append(dls, dl)
+	}
+	return dls, nil
+}
+
+func (s *NBIAPI) CreateSubscription(ctx context.Context, request nbi.CreateSubscriptionRequestObject) (nbi.CreateSubscriptionResponseObject, error) {
+	req := request.Body
+	if req == nil {
+		return nil, nbi.NewErrorInfo(http.StatusBadRequest, "missing request body")
+	}
+	if err := validateSubscriptionRequest(ctx, req); err != nil {
+		return nil, nbi.NewErrorInfo(http.StatusBadRequest, err.Error())
+	}
+	now := time.Now()
+	sub := nbiSubscription{
+		Subscription: nbi.Subscription{
+			Id:       ulid.Make().String(),
+			Sink:     req.Sink,
+			Types:    req.Types,
+			StartsAt: &now,
+		},
+		SinkCredential: req.SinkCredential,
+	}
+	if err := saveNBISubscriptionSecrets(ctx, &sub); err != nil {
+		return nil, nbi.NewErrorInfo(http.StatusInternalServerError, err.Error())
+	}
+	if err := saveNBISubscription(ctx, &sub); err != nil {
+		if sub.hasAccessToken() {
+			vault.DeleteData(vaultConfig, getNBISubscriptionVaultPath(sub.Id))
+		}
+		return nil, nbi.NewErrorInfo(http.StatusInternalServerError, err.Error())
+	}
+	resp := nbi.CreateSubscription201JSONResponse{}
+	resp.Body = sub.Subscription
+	return resp, nil
+}
+
+func (s *NBIAPI) GetSubscriptions(ctx context.Context, request nbi.GetSubscriptionsRequestObject) (nbi.GetSubscriptionsResponseObject, error) {
+	subs, err := getNBISubscriptions(ctx, redisClient)
+	if err != nil {
+		return nil, nbi.NewErrorInfo(http.StatusInternalServerError, err.Error())
+	}
+	resp := nbi.GetSubscriptions200JSONResponse{}
+	resp.Body = []nbi.Subscription{}
+	for _, sub := range subs {
+		resp.Body = append(resp.Body, sub.Subscription)
+	}
+	return resp, nil
+}
+
+func (s *NBIAPI) GetSubscription(ctx context.Context, request nbi.GetSubscriptionRequestObject) (nbi.GetSubscriptionResponseObject, error) {
+	sub, err := getNBISubscription(ctx, request.SubscriptionId)
+	if err != nil {
+		return nil, nbi.NewErrorInfo(http.StatusInternalServerError, err.Error())
+	}
+	if sub == nil {
+		return nil, nbi.NewErrorInfo(http.StatusNotFound, "subscription not found")
+	}
+	resp := nbi.GetSubscription200JSONResponse{}
+	resp.Body = sub.Subscription
+	return resp, nil
+}
+
+func (s *NBIAPI) DeleteSubscription(ctx context.Context, request nbi.DeleteSubscriptionRequestObject) (nbi.DeleteSubscriptionResponseObject, error) {
+	found, err := deleteNBISubscription(ctx, request.SubscriptionId)
+	if err != nil {
+		return nil, nbi.NewErrorInfo(http.StatusInternalServerError, err.Error())
+	}
+	if !found {
+		return nil, nbi.NewErrorInfo(http.StatusNotFound, "subscription not found")
+	}
+	if err := vault.DeleteData(vaultConfig, getNBISubscriptionVaultPath(request.SubscriptionId)); err != nil {
+		log.SpanLog(ctx, log.DebugLevelApi, "failed to delete nbi subscription secrets from vault", "id", request.SubscriptionId, "err", err)
+	}
+	return nbi.DeleteSubscription204Response{}, nil
+}
+
+func (s *NBIAPI) GetSubscriptionDeadLetters(ctx context.Context, request nbi.GetSubscriptionDeadLettersRequestObject) (nbi.GetSubscriptionDeadLettersResponseObject, error) {
+	sub, err := getNBISubscription(ctx, request.SubscriptionId)
+	if err != nil {
+		return nil, nbi.NewErrorInfo(http.StatusInternalServerError, err.Error())
+	}
+	if sub == nil {
+		return nil, nbi.NewErrorInfo(http.StatusNotFound, "subscription not found")
+	}
+	dls, err := getNBIDeadLetters(ctx, sub.Id)
+	if err != nil {
+		return nil, nbi.NewErrorInfo(http.StatusInternalServerError, err.Error())
+	}
+	resp := nbi.GetSubscriptionDeadLetters200JSONResponse{}
+	resp.Body = dls
+	return resp, nil
+}
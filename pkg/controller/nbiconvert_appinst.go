@@ -15,10 +15,12 @@
 package controller
 
 import (
+	"errors"
 	"strings"
 
 	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/api/nbi"
+	"sigs.k8s.io/yaml"
 )
 
 func (s *NBIAPI) NBIAppInst(in *edgeproto.AppInst) (*nbi.AppInstanceInfo, error) {
@@ -80,6 +82,76 @@ func (s *NBIAPI) NBIAppInst(in *edgeproto.AppInst) (*nbi.AppInstanceInfo, error)
 	return &ai, nil
 }
 
+// ProtoAppInstUpdate converts the NBI update into an AppInst with
+// the fields to update set. If replace is true, all updatable
+// fields are set so that unspecified fields are reset.
+func ProtoAppInstUpdate(cur *edgeproto.AppInst, in *nbi.AppInstanceUpdate, replace bool) (*edgeproto.AppInst, error) {
+	ai := &edgeproto.AppInst{}
+	ai.Key = cur.Key
+	if in.FlavorId != nil && in.KubernetesResources != nil {
+		return nil, errors.New("only one of flavorId and kubernetesResources may be specified")
+	}
+	if replace && in.FlavorId == nil && in.KubernetesResources == nil {
+		return nil, errors.New("one of flavorId or kubernetesResources must be specified")
+	}
+	if in.EnvVars != nil || replace {
+		// preserve any non-env var configs
+		for _, cfg := range cur.Configs {
+			if cfg.Kind != edgeproto.AppConfigEnvYaml {
+				ai.Configs = append(ai.Configs, cfg)
+			}
+		}
+		if in.EnvVars != nil && len(*in.EnvVars) > 0 {
+			for _, v := range *in.EnvVars {
+				if v.Name == "" {
+					return nil, errors.New("environment variable name cannot be empty")
+				}
+			}
+			out, err := yaml.Marshal(*in.EnvVars)
+			if err != nil {
+				return nil, err
+			}
+			ai.Configs = append(ai.Configs, &edgeproto.ConfigFile{
+				Kind:   edgeproto.AppConfigEnvYaml,
+				Config: string(out),
+			})
+		}
+		ai.Fields = append(ai.Fields, edgeproto.AppInstFieldConfigs)
+	}
+	if in.FlavorId != nil {
+		ai.Flavor.Name = *in.FlavorId
+	}
+	if in.KubernetesResources != nil {
+		kr, err := protoKubernetesResources(in.KubernetesResources)
+		if err != nil {
+			return nil, err
+		}
+		ai.KubernetesResources = kr
+		ai.Fields = append(ai.Fields, edgeproto.AppInstFieldKubernetesResources)
+	}
+	// a specified flavor overrides the kubernetes resources,
+	// so clear it if resources are specified.
+	if in.FlavorId != nil || in.KubernetesResources != nil || replace {
+		ai.Fields = append(ai.Fields, edgeproto.AppInstFieldFlavorName)
+	}
+	if replace && in.KubernetesResources == nil {
+		ai.Fields = append(ai.Fields, edgeproto.AppInstFieldKubernetesResources)
+	}
+	if in.Replicas != nil || replace {
+		if in.Replicas != nil {
+			if *in.Replicas < 0 {
+				return nil, errors.New("replicas cannot be negative")
+			}
+			ai.Replicas = int32(*in.Replicas)
+		}
+		ai.Fields = append(ai.Fields, edgeproto.AppInstFieldReplicas)
+	}
+	if len(ai.Fields) == 0 {
+		return nil, errors.New("nothing specified to update")
+	}
+	return ai, nil
+}
+
 func toPtr[T any](v T) *T {
 	return &v
 }
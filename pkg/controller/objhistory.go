@@ -0,0 +1,61 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/objstore"
+	"github.com/edgexr/edge-cloud-platform/pkg/regiondata"
+)
+
+// Object history is read from the revision history of the database,
+// so no extra data is stored for it. The history of an object starts
+// from when it was last created, and is bounded by the max object
+// history revisions setting, and by compaction of the database
+// history. Past revisions can be restored by updating the object
+// with the fields from the past revision, see edgectl Restore.
+
+// showObjHistory sends the past revisions of the object specified
+// by the key of in, newest first.
+func showObjHistory[T any, PT interface {
+	*T
+	objstore.Obj
+}](ctx context.Context, all *AllApis, sync *regiondata.Sync, typ string, in PT, send func(rev int64, obj PT) error) error {
+	key := in.GetObjKey()
+	if err := key.ValidateKey(); err != nil {
+		return err
+	}
+	maxRevs := int(all.settingsApi.Get().MaxObjectHistoryRevisions)
+	count := 0
+	err := sync.History(ctx, typ, key, maxRevs, func(val []byte, modRev int64) error {
+		var obj T
+		if err := json.Unmarshal(val, &obj); err != nil {
+			log.SpanLog(ctx, log.DebugLevelApi, "failed to unmarshal object history", "typ", typ, "key", key.GetKeyString(), "rev", modRev, "err", err)
+			return err
+		}
+		count++
+		return send(modRev, &obj)
+	})
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return key.NotFoundError()
+	}
+	return nil
+}
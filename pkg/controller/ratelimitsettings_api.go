@@ -67,6 +67,45 @@ func NewMaxReqsRateLimitSettingsApi(sync *regiondata.Sync, all *AllApis) *MaxReq
 	return &rateLimitSettingsApi
 }
 
+// Init the rate limit manager for APIs served directly by the
+// Controller (ie. the NBI), which uses the rate limit settings for
+// the Controller ApiEndpointType. Limits are shared by all
+// Controller replicas via redis.
+func (s *AllApis) initControllerRateLimitMgr() {
+	defaultSettings := edgeproto.GetDefaultSettings()
+	ops := []ratelimit.RateLimitManagerOp{}
+	if redisClient != nil {
+		ops = append(ops, ratelimit.WithRedisClient(redisClient))
+	}
+	mgr := ratelimit.NewRateLimitManager(defaultSettings.DisableRateLimit, int(defaultSettings.RateLimitMaxTrackedIps), 0, ops...)
+
+	s.flowRateLimitSettingsApi.cache.AddUpdatedCb(func(ctx context.Context, old *edgeproto.FlowRateLimitSettings, new *edgeproto.FlowRateLimitSettings) {
+		if new.Key.RateLimitKey.ApiEndpointType == edgeproto.ApiEndpointType_CONTROLLER {
+			mgr.UpdateFlowRateLimitSettings(new)
+		}
+	})
+	s.flowRateLimitSettingsApi.cache.AddDeletedCb(func(ctx context.Context, old *edgeproto.FlowRateLimitSettings) {
+		if old.Key.RateLimitKey.ApiEndpointType == edgeproto.ApiEndpointType_CONTROLLER {
+			mgr.RemoveFlowRateLimitSettings(old.Key)
+		}
+	})
+	s.maxReqsRateLimitSettingsApi.cache.AddUpdatedCb(func(ctx context.Context, old *edgeproto.MaxReqsRateLimitSettings, new *edgeproto.MaxReqsRateLimitSettings) {
+		if new.Key.RateLimitKey.ApiEndpointType == edgeproto.ApiEndpointType_CONTROLLER {
+			mgr.UpdateMaxReqsRateLimitSettings(new)
+		}
+	})
+	s.maxReqsRateLimitSettingsApi.cache.AddDeletedCb(func(ctx context.Context, old *edgeproto.MaxReqsRateLimitSettings) {
+		if old.Key.RateLimitKey.ApiEndpointType == edgeproto.ApiEndpointType_CONTROLLER {
+			mgr.RemoveMaxReqsRateLimitSettings(old.Key)
+		}
+	})
+	s.settingsApi.cache.AddUpdatedCb(func(ctx context.Context, old *edgeproto.Settings, new *edgeproto.Settings) {
+		mgr.UpdateDisableRateLimit(new.DisableRateLimit)
+		mgr.UpdateMaxTrackedIps(int(new.RateLimitMaxTrackedIps))
+	})
+	s.controllerRateLimitMgr = mgr
+}
+
 // Store initial default Flow and MaxReqs RateLimitSettings
 func (r *FlowRateLimitSettingsApi) initDefaultRateLimitSettings(ctx context.Context) error {
 	err := r.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
@@ -162,6 +162,10 @@ func (s *SettingsApi) initDefaults(ctx context.Context) error {
 			cur.CcrmApiTimeout = edgeproto.GetDefaultSettings().CcrmApiTimeout
 			modified = true
 		}
+		if cur.FindCloudletScoreWeights.IsZero() {
+			cur.FindCloudletScoreWeights = edgeproto.GetDefaultSettings().FindCloudletScoreWeights
+			modified = true
+		}
 		if modified {
 			s.store.STMPut(stm, cur)
 		}
@@ -0,0 +1,492 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/objstore"
+	"github.com/edgexr/edge-cloud-platform/pkg/regiondata"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+// DataSnapshotApi exports and restores all of the region's data.
+// Data is exported as raw database entries so that data from older
+// data model versions can be upgraded before it is decoded.
+type DataSnapshotApi struct {
+	all  *AllApis
+	sync *regiondata.Sync
+}
+
+func NewDataSnapshotApi(sync *regiondata.Sync, all *AllApis) *DataSnapshotApi {
+	dataSnapshotApi := DataSnapshotApi{}
+	dataSnapshotApi.all = all
+	dataSnapshotApi.sync = sync
+	return &dataSnapshotApi
+}
+
+// snapshotObjType maps a database object type to its AllData field.
+type snapshotObjType struct {
+	typ string
+	// add unmarshals the database value and adds it to the data
+	add func(data *edgeproto.AllData, val []byte) error
+	// objs gets the objects of the type from the data
+	objs func(data *edgeproto.AllData) []objstore.Obj
+}
+
+func snapshotObjs[T any, PT interface {
+	*T
+	objstore.Obj
+}](typ string, field func(data *edgeproto.AllData) *[]T) snapshotObjType {
+	return snapshotObjType{
+		typ: typ,
+		add: func(data *edgeproto.AllData, val []byte) error {
+			var obj T
+			if err := json.Unmarshal(val, &obj); err != nil {
+				return err
+			}
+			list := field(data)
+			*list = append(*list, obj)
+			return nil
+		},
+		objs: func(data *edgeproto.AllData) []objstore.Obj {
+			list := field(data)
+			objs := []objstore.Obj{}
+			for ii := range *list {
+				objs = append(objs, PT(&(*list)[ii]))
+			}
+			return objs
+		},
+	}
+}
+
+// Object types in the order they are restored, which follows
+// the dependencies between objects.
+var snapshotObjTypes = []snapshotObjType{
+	snapshotObjs("Flavor", func(d *edgeproto.AllData) *[]edgeproto.Flavor { return &d.Flavors }),
+	{
+		typ: "Settings",
+		add: func(data *edgeproto.AllData, val []byte) error {
+			settings := edgeproto.Settings{}
+			if err := json.Unmarshal(val, &settings); err != nil {
+				return err
+			}
+			data.Settings = &settings
+			return nil
+		},
+		objs: func(data *edgeproto.AllData) []objstore.Obj {
+			if data.Settings == nil {
+				return nil
+			}
+			return []objstore.Obj{data.Settings}
+		},
+	},
+	snapshotObjs("OperatorCode", func(d *edgeproto.AllData) *[]edgeproto.OperatorCode { return &d.OperatorCodes }),
+	snapshotObjs("ResTagTable", func(d *edgeproto.AllData) *[]edgeproto.ResTagTable { return &d.ResTagTables }),
+	snapshotObjs("TrustPolicy", func(d *edgeproto.AllData) *[]edgeproto.TrustPolicy { return &d.TrustPolicies }),
+	snapshotObjs("GPUDriver", func(d *edgeproto.AllData) *[]edgeproto.GPUDriver { return &d.GpuDrivers }),
+	snapshotObjs("PlatformFeatures", func(d *edgeproto.AllData) *[]edgeproto.PlatformFeatures { return &d.PlatformFeatures }),
+	snapshotObjs("Zone", func(d *edgeproto.AllData) *[]edgeproto.Zone { return &d.Zones }),
+	snapshotObjs("Cloudlet", func(d *edgeproto.AllData) *[]edgeproto.Cloudlet { return &d.Cloudlets }),
+	snapshotObjs("CloudletInfo", func(d *edgeproto.AllData) *[]edgeproto.CloudletInfo { return &d.CloudletInfos }),
+	snapshotObjs("ZonePool", func(d *edgeproto.AllData) *[]edgeproto.ZonePool { return &d.ZonePools }),
+	snapshotObjs("Network", func(d *edgeproto.AllData) *[]edgeproto.Network { return &d.Networks }),
+	snapshotObjs("AutoProvPolicy", func(d *edgeproto.AllData) *[]edgeproto.AutoProvPolicy { return &d.AutoProvPolicies }),
+	snapshotObjs("AutoScalePolicy", func(d *edgeproto.AllData) *[]edgeproto.AutoScalePolicy { return &d.AutoScalePolicies }),
+	snapshotObjs("ClusterInst", func(d *edgeproto.AllData) *[]edgeproto.ClusterInst { return &d.ClusterInsts }),
+	snapshotObjs("GeoFencePolicy", func(d *edgeproto.AllData) *[]edgeproto.GeoFencePolicy { return &d.GeoFencePolicies }),
+	snapshotObjs("App", func(d *edgeproto.AllData) *[]edgeproto.App { return &d.Apps }),
+	snapshotObjs("AppInst", func(d *edgeproto.AllData) *[]edgeproto.AppInst { return &d.AppInstances }),
+	snapshotObjs("AppInstRefs", func(d *edgeproto.AllData) *[]edgeproto.AppInstRefs { return &d.AppInstRefs }),
+	snapshotObjs("ClusterRefs", func(d *edgeproto.AllData) *[]edgeproto.ClusterRefs { return &d.ClusterRefs }),
+	snapshotObjs("VMPool", func(d *edgeproto.AllData) *[]edgeproto.VMPool { return &d.VmPools }),
+	snapshotObjs("AlertPolicy", func(d *edgeproto.AllData) *[]edgeproto.AlertPolicy { return &d.AlertPolicies }),
+	snapshotObjs("FlowRateLimitSettings", func(d *edgeproto.AllData) *[]edgeproto.FlowRateLimitSettings { return &d.FlowRateLimitSettings }),
+	snapshotObjs("MaxReqsRateLimitSettings", func(d *edgeproto.AllData) *[]edgeproto.MaxReqsRateLimitSettings { return &d.MaxReqsRateLimitSettings }),
+	snapshotObjs("TrustPolicyException", func(d *edgeproto.AllData) *[]edgeproto.TrustPolicyException { return &d.TrustPolicyExceptions }),
+}
+
+// Database entries that are not exported. Controllers and alerts
+// are tied to the leases of running controllers, and the data
+// model version is exported separately.
+var snapshotSkipTypes = map[string]struct{}{
+	"Controller":            {},
+	"Alert":                 {},
+	"SpanEtcdKey":           {},
+	DataModelVersion0Prefix: {},
+	DataModelVersion2Prefix: {},
+}
+
+// Objects created by running services that may be present before
+// a restore, and are replaced by the restored data.
+var snapshotDefaultTypes = map[string]struct{}{
+	"Settings":                 {},
+	"FlowRateLimitSettings":    {},
+	"MaxReqsRateLimitSettings": {},
+	"PlatformFeatures":         {},
+}
+
+// Restored entries are written in batches to stay within the
+// database's limit on operations per transaction.
+var snapshotRestoreBatchSize = 100
+
+// snapshotAllFields is a field map with all fields set, so that
+// restored objects are fully validated.
+type snapshotAllFields struct{}
+
+func (s snapshotAllFields) Has(fieldKey string) bool { return true }
+func (s snapshotAllFields) Fields() []string         { return nil }
+
+// snapshotKeyType gets the object type from a database key with
+// the region prefix removed.
+func snapshotKeyType(key string) string {
+	typ, _, _ := strings.Cut(key, "/")
+	return typ
+}
+
+func (s *DataSnapshotApi) ExportData(ctx context.Context, in *edgeproto.DataSnapshotRequest) (*edgeproto.DataSnapshot, error) {
+	log.SpanLog(ctx, log.DebugLevelApi, "ExportData", "revision", in.Revision)
+
+	snapshot := &edgeproto.DataSnapshot{
+		Region: *region,
+	}
+	var vers0, vers2 []byte
+	prefix := objstore.DbKeyPrefixString("")
+	ops := []objstore.KVOp{}
+	if in.Revision != 0 {
+		ops = append(ops, objstore.WithRevision(in.Revision))
+	}
+	err := s.sync.GetKVStore().List(prefix, func(key, val []byte, rev, modRev int64) error {
+		snapshot.Revision = rev
+		dbKey := strings.TrimPrefix(string(key), prefix)
+		typ := snapshotKeyType(dbKey)
+		switch typ {
+		case DataModelVersion0Prefix:
+			vers0 = val
+		case DataModelVersion2Prefix:
+			vers2 = val
+		}
+		if _, found := snapshotSkipTypes[typ]; found {
+			return nil
+		}
+		snapshot.InternalEntries = append(snapshot.InternalEntries, edgeproto.DataSnapshotEntry{
+			DbKey:   dbKey,
+			DbValue: string(val),
+		})
+		return nil
+	}, ops...)
+	if err == objstore.ErrKVStoreCompacted {
+		return nil, fmt.Errorf("revision %d is no longer available, the database history has been compacted", in.Revision)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if in.Revision != 0 {
+		// listed revision is the latest revision
+		snapshot.Revision = in.Revision
+	}
+	if vers2 != nil {
+		vers := edgeproto.DataModelVersion{}
+		if err := json.Unmarshal(vers2, &vers); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal data model version %s, %s", string(vers2), err)
+		}
+		snapshot.DataModelHash = vers.Hash
+		snapshot.DataModelId = vers.ID
+	} else if vers0 != nil {
+		snapshot.DataModelHash = string(vers0)
+	} else {
+		return nil, fmt.Errorf("data model version not found in database")
+	}
+	return snapshot, nil
+}
+
+func (s *DataSnapshotApi) RestoreData(ctx context.Context, in *edgeproto.DataSnapshot) (*edgeproto.Result, error) {
+	log.SpanLog(ctx, log.DebugLevelApi, "RestoreData", "region", in.Region, "revision", in.Revision, "dataModelHash", in.DataModelHash, "dataModelId", in.DataModelId)
+
+	if in.Region != "" && in.Region != *region {
+		return nil, fmt.Errorf("cannot restore data for region %s into region %s", in.Region, *region)
+	}
+	fromVers, err := s.checkVersion(in)
+	if err != nil {
+		return nil, err
+	}
+	upgrade := fromVers.ID < edgeproto.GetDataModelVersion().ID
+	prefix := objstore.DbKeyPrefixString("")
+	entries := []edgeproto.DataSnapshotEntry{}
+	for _, objType := range snapshotObjTypes {
+		for _, obj := range objType.objs(&in.Data) {
+			keyStr := obj.GetObjKey().GetKeyString()
+			if upgrade {
+				// objects have already lost any fields that are
+				// not in the current data model
+				return nil, fmt.Errorf("%s %s cannot be restored from data model version %d as an object, it must be a database entry so that it can be upgraded", objType.typ, keyStr, fromVers.ID)
+			}
+			val, err := json.Marshal(obj)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal %s %s, %s", objType.typ, keyStr, err)
+			}
+			entries = append(entries, edgeproto.DataSnapshotEntry{
+				DbKey:   strings.TrimPrefix(objstore.DbKeyString(objType.typ, obj.GetObjKey()), prefix),
+				DbValue: string(val),
+			})
+		}
+	}
+	for _, entry := range in.InternalEntries {
+		typ := snapshotKeyType(entry.DbKey)
+		if _, found := snapshotSkipTypes[typ]; found {
+			return nil, fmt.Errorf("invalid entry %s, %s data cannot be restored", entry.DbKey, typ)
+		}
+		entries = append(entries, entry)
+	}
+	if !upgrade {
+		// validate all of the data before writing anything,
+		// older data is validated after it is upgraded
+		if err := validateSnapshotEntries(entries); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.checkEmpty(); err != nil {
+		return nil, err
+	}
+
+	rev, err := s.restoreEntries(ctx, fromVers, entries)
+	if err != nil {
+		return nil, err
+	}
+	if rev != 0 {
+		s.sync.SyncWait(rev)
+	}
+	return &edgeproto.Result{
+		Message: fmt.Sprintf("Restored %d entries from data model version %d", len(entries), fromVers.ID),
+	}, nil
+}
+
+// validateSnapshotEntries fully validates the objects in the
+// entries, which must be of the current data model version.
+func validateSnapshotEntries(entries []edgeproto.DataSnapshotEntry) error {
+	objTypes := map[string]snapshotObjType{}
+	for _, objType := range snapshotObjTypes {
+		objTypes[objType.typ] = objType
+	}
+	data := edgeproto.AllData{}
+	for _, entry := range entries {
+		objType, found := objTypes[snapshotKeyType(entry.DbKey)]
+		if !found {
+			continue
+		}
+		if err := objType.add(&data, []byte(entry.DbValue)); err != nil {
+			return fmt.Errorf("failed to unmarshal %s, %s", entry.DbKey, err)
+		}
+	}
+	for _, objType := range snapshotObjTypes {
+		for _, obj := range objType.objs(&data) {
+			if err := obj.Validate(snapshotAllFields{}); err != nil {
+				return fmt.Errorf("invalid %s %s, %s", objType.typ, obj.GetObjKey().GetKeyString(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// restoreEntries writes the entries in batched transactions, and
+// upgrades the data to the current data model version. If anything
+// fails after data has been written, the restored data is cleared
+// so that the restore can be retried.
+func (s *DataSnapshotApi) restoreEntries(ctx context.Context, fromVers *edgeproto.DataModelVersion, entries []edgeproto.DataSnapshotEntry) (int64, error) {
+	objStore := s.sync.GetKVStore()
+	prefix := objstore.DbKeyPrefixString("")
+	var rev int64
+	for ii := 0; ii < len(entries); ii += snapshotRestoreBatchSize {
+		batch := entries[ii:min(ii+snapshotRestoreBatchSize, len(entries))]
+		first := ii == 0
+		var err error
+		rev, err = objStore.ApplySTM(ctx, func(stm concurrency.STM) error {
+			if first {
+				// data may have been added since the region
+				// was checked
+				if err := s.checkEmpty(); err != nil {
+					return err
+				}
+				for _, entry := range batch {
+					if _, ok := snapshotDefaultTypes[snapshotKeyType(entry.DbKey)]; ok {
+						continue
+					}
+					if stm.Get(prefix+entry.DbKey) != "" {
+						return fmt.Errorf("data can only be restored into a region without data, found %s", entry.DbKey)
+					}
+				}
+			}
+			for _, entry := range batch {
+				stm.Put(prefix+entry.DbKey, entry.DbValue)
+			}
+			return nil
+		})
+		if err != nil {
+			if first {
+				// nothing was written
+				return 0, err
+			}
+			return 0, s.clearRestoredOnErr(ctx, err)
+		}
+	}
+	log.SpanLog(ctx, log.DebugLevelApi, "RestoreData wrote data", "count", len(entries), "rev", rev)
+
+	curVers := edgeproto.GetDataModelVersion()
+	if fromVers.ID < curVers.ID {
+		// bring the restored data up to the current data model
+		if err := s.upgradeRestored(ctx, fromVers, curVers); err != nil {
+			return 0, s.clearRestoredOnErr(ctx, err)
+		}
+	}
+	return rev, nil
+}
+
+// upgradeRestored upgrades the raw restored data, then validates
+// the upgraded objects.
+func (s *DataSnapshotApi) upgradeRestored(ctx context.Context, fromVers, curVers *edgeproto.DataModelVersion) error {
+	objStore := s.sync.GetKVStore()
+	if err := writeDataModelVersionV2(ctx, objStore, fromVers); err != nil {
+		return err
+	}
+	upgradeSupport := &UpgradeSupport{
+		region:      *region,
+		vaultConfig: vaultConfig,
+	}
+	if err := upgradeToLatest(fromVers, objStore, s.all, upgradeSupport, VersionHash_UpgradeFuncs); err != nil {
+		return fmt.Errorf("failed to upgrade restored data from version %d, %s", fromVers.ID, err)
+	}
+	// versions without upgrade funcs are not written by the upgrade
+	if err := writeDataModelVersionV2(ctx, objStore, curVers); err != nil {
+		return err
+	}
+	prefix := objstore.DbKeyPrefixString("")
+	entries := []edgeproto.DataSnapshotEntry{}
+	err := objStore.List(prefix, func(key, val []byte, rev, modRev int64) error {
+		entries = append(entries, edgeproto.DataSnapshotEntry{
+			DbKey:   strings.TrimPrefix(string(key), prefix),
+			DbValue: string(val),
+		})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := validateSnapshotEntries(entries); err != nil {
+		return fmt.Errorf("restored data from version %d is not valid after upgrade, %s", fromVers.ID, err)
+	}
+	return nil
+}
+
+// clearRestoredOnErr clears partially restored data after the
+// restore failed with err, and returns err.
+func (s *DataSnapshotApi) clearRestoredOnErr(ctx context.Context, err error) error {
+	if clearErr := s.clearRestored(ctx); clearErr != nil {
+		log.SpanLog(ctx, log.DebugLevelApi, "RestoreData failed to clear partially restored data", "err", clearErr)
+	}
+	return err
+}
+
+// clearRestored deletes all of the data that checkEmpty does not
+// allow, and resets the data model version in case the restored
+// data was being upgraded.
+func (s *DataSnapshotApi) clearRestored(ctx context.Context) error {
+	objStore := s.sync.GetKVStore()
+	prefix := objstore.DbKeyPrefixString("")
+	keys := []string{}
+	err := objStore.List(prefix, func(key, val []byte, rev, modRev int64) error {
+		typ := snapshotKeyType(strings.TrimPrefix(string(key), prefix))
+		if _, ok := snapshotSkipTypes[typ]; ok {
+			return nil
+		}
+		if _, ok := snapshotDefaultTypes[typ]; ok {
+			return nil
+		}
+		keys = append(keys, string(key))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	var rev int64
+	for ii := 0; ii < len(keys); ii += snapshotRestoreBatchSize {
+		batch := keys[ii:min(ii+snapshotRestoreBatchSize, len(keys))]
+		rev, err = objStore.ApplySTM(ctx, func(stm concurrency.STM) error {
+			for _, key := range batch {
+				stm.Del(key)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if err := writeDataModelVersionV2(ctx, objStore, edgeproto.GetDataModelVersion()); err != nil {
+		return err
+	}
+	if rev != 0 {
+		s.sync.SyncWait(rev)
+	}
+	return nil
+}
+
+// checkVersion verifies the snapshot's data model version is one
+// that the upgrade functions can bring up to the current version.
+func (s *DataSnapshotApi) checkVersion(in *edgeproto.DataSnapshot) (*edgeproto.DataModelVersion, error) {
+	curVers := edgeproto.GetDataModelVersion()
+	if in.DataModelId > curVers.ID {
+		return nil, fmt.Errorf("data model version %d is newer than the current version %d", in.DataModelId, curVers.ID)
+	}
+	for _, upgrade := range VersionHash_UpgradeFuncs {
+		if upgrade.id == in.DataModelId && upgrade.hash == in.DataModelHash {
+			return &edgeproto.DataModelVersion{
+				Hash: in.DataModelHash,
+				ID:   in.DataModelId,
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown data model version %d with hash %q", in.DataModelId, in.DataModelHash)
+}
+
+// checkEmpty verifies that the region does not have any data aside
+// from data created by running services.
+func (s *DataSnapshotApi) checkEmpty() error {
+	prefix := objstore.DbKeyPrefixString("")
+	var found string
+	err := s.sync.GetKVStore().List(prefix, func(key, val []byte, rev, modRev int64) error {
+		typ := snapshotKeyType(strings.TrimPrefix(string(key), prefix))
+		if _, ok := snapshotSkipTypes[typ]; ok {
+			return nil
+		}
+		if _, ok := snapshotDefaultTypes[typ]; ok {
+			return nil
+		}
+		if found == "" {
+			found = strings.TrimPrefix(string(key), prefix)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if found != "" {
+		return fmt.Errorf("data can only be restored into a region without data, found %s", found)
+	}
+	return nil
+}
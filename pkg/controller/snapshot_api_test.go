@@ -0,0 +1,236 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/objstore"
+	"github.com/edgexr/edge-cloud-platform/pkg/regiondata"
+	"github.com/edgexr/edge-cloud-platform/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSnapshotApi(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelEtcd | log.DebugLevelApi)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+	testSvcs := testinit(ctx, t)
+	defer testfinish(testSvcs)
+
+	newRegion := func() (*regiondata.InMemoryStore, *regiondata.Sync, *AllApis) {
+		dummy := &regiondata.InMemoryStore{}
+		dummy.Start()
+		sync := regiondata.InitSync(dummy)
+		apis := NewAllApis(sync)
+		sync.Start()
+		require.Nil(t, writeDataModelVersionV2(ctx, dummy, edgeproto.GetDataModelVersion()))
+		require.Nil(t, apis.settingsApi.initDefaults(ctx))
+		return dummy, sync, apis
+	}
+
+	dummy, sync, apis := newRegion()
+	defer dummy.Stop()
+	defer sync.Done()
+
+	put := func(typ string, obj objstore.Obj) {
+		val, err := json.Marshal(obj)
+		require.Nil(t, err)
+		rev, err := dummy.Put(ctx, objstore.DbKeyString(typ, obj.GetObjKey()), string(val))
+		require.Nil(t, err)
+		sync.SyncWait(rev)
+	}
+	flavors := testutil.FlavorData()
+	for ii := range flavors {
+		put("Flavor", &flavors[ii])
+	}
+	cloudlets := testutil.CloudletData()
+	for ii := range cloudlets {
+		put("Cloudlet", &cloudlets[ii])
+	}
+	cloudletRefs := testutil.CloudletRefsData()
+	for ii := range cloudletRefs {
+		put("CloudletRefs", &cloudletRefs[ii])
+	}
+	apps := testutil.AppData()
+	for ii := range apps {
+		put("App", &apps[ii])
+	}
+	ctrl := edgeproto.Controller{
+		Key: edgeproto.ControllerKey{Addr: "127.0.0.1:55001"},
+	}
+	put("Controller", &ctrl)
+
+	// export
+	snapshot, err := apis.dataSnapshotApi.ExportData(ctx, &edgeproto.DataSnapshotRequest{})
+	require.Nil(t, err)
+	curVers := edgeproto.GetDataModelVersion()
+	require.Equal(t, curVers.Hash, snapshot.DataModelHash)
+	require.Equal(t, curVers.ID, snapshot.DataModelId)
+	require.Equal(t, *region, snapshot.Region)
+	require.Equal(t, sync.GetSyncRev(), snapshot.Revision)
+	// all data is exported as entries, controllers are not exported
+	require.Empty(t, snapshot.Data.Flavors)
+	counts := map[string]int{}
+	for _, entry := range snapshot.InternalEntries {
+		counts[snapshotKeyType(entry.DbKey)]++
+	}
+	require.Equal(t, len(flavors), counts["Flavor"])
+	require.Equal(t, len(cloudlets), counts["Cloudlet"])
+	require.Equal(t, len(apps), counts["App"])
+	require.Equal(t, len(cloudletRefs), counts["CloudletRefs"])
+	require.Equal(t, 1, counts["Settings"])
+	require.Equal(t, 0, counts["Controller"])
+
+	// in-memory store has no history
+	_, err = apis.dataSnapshotApi.ExportData(ctx, &edgeproto.DataSnapshotRequest{
+		Revision: snapshot.Revision - 1,
+	})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "database history has been compacted")
+
+	// cannot restore into a region with data
+	_, err = apis.dataSnapshotApi.RestoreData(ctx, snapshot)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "only be restored into a region without data")
+
+	// restore into a new region
+	dummy2, sync2, apis2 := newRegion()
+	defer dummy2.Stop()
+	defer sync2.Done()
+
+	bad := *snapshot
+	bad.DataModelId = curVers.ID + 1
+	_, err = apis2.dataSnapshotApi.RestoreData(ctx, &bad)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "newer than the current version")
+	bad.DataModelId = curVers.ID
+	bad.DataModelHash = "badhash"
+	_, err = apis2.dataSnapshotApi.RestoreData(ctx, &bad)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "unknown data model version")
+	bad = *snapshot
+	bad.Region = "other"
+	_, err = apis2.dataSnapshotApi.RestoreData(ctx, &bad)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "into region")
+
+	// all data is validated before anything is written
+	badFlavor := flavors[0]
+	badFlavor.Ram = 0
+	bad = *snapshot
+	bad.InternalEntries = replaceSnapshotEntry(t, snapshot.InternalEntries, "Flavor", &badFlavor)
+	_, err = apis2.dataSnapshotApi.RestoreData(ctx, &bad)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "invalid Flavor")
+	require.Nil(t, apis2.dataSnapshotApi.checkEmpty())
+	bad = *snapshot
+	bad.InternalEntries = nil
+	bad.Data.Flavors = []edgeproto.Flavor{badFlavor}
+	_, err = apis2.dataSnapshotApi.RestoreData(ctx, &bad)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "invalid Flavor")
+	require.Nil(t, apis2.dataSnapshotApi.checkEmpty())
+
+	// emptiness is checked again when data is written
+	_, err = apis2.dataSnapshotApi.restoreEntries(ctx, curVers, snapshot.InternalEntries)
+	require.Nil(t, err)
+	require.NotNil(t, apis2.dataSnapshotApi.checkEmpty())
+	_, err = apis2.dataSnapshotApi.restoreEntries(ctx, curVers, snapshot.InternalEntries)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "only be restored into a region without data")
+	// existing data is not cleared by a failed restore
+	require.Equal(t, len(flavors), apis2.flavorApi.cache.GetCount())
+
+	// partially restored data is cleared so the restore can be retried
+	require.Nil(t, apis2.dataSnapshotApi.clearRestored(ctx))
+	require.Nil(t, apis2.dataSnapshotApi.checkEmpty())
+
+	_, err = apis2.dataSnapshotApi.RestoreData(ctx, snapshot)
+	require.Nil(t, err)
+	require.Equal(t, len(flavors), apis2.flavorApi.cache.GetCount())
+	require.Equal(t, len(cloudlets), apis2.cloudletApi.cache.GetCount())
+	require.Equal(t, len(apps), apis2.appApi.cache.GetCount())
+	require.Equal(t, len(cloudletRefs), apis2.cloudletRefsApi.cache.GetCount())
+	snapshot2, err := apis2.dataSnapshotApi.ExportData(ctx, &edgeproto.DataSnapshotRequest{})
+	require.Nil(t, err)
+	require.Equal(t, snapshotEntries(snapshot), snapshotEntries(snapshot2))
+
+	// restore from an older data model version upgrades the data
+	dummy3, sync3, apis3 := newRegion()
+	defer dummy3.Stop()
+	defer sync3.Done()
+
+	prevVers := VersionHash_UpgradeFuncs[len(VersionHash_UpgradeFuncs)-2]
+	old := *snapshot
+	old.DataModelId = prevVers.id
+	old.DataModelHash = prevVers.hash
+	// objects cannot be upgraded
+	old.Data.Flavors = []edgeproto.Flavor{flavors[0]}
+	_, err = apis3.dataSnapshotApi.RestoreData(ctx, &old)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "must be a database entry")
+	require.Nil(t, apis3.dataSnapshotApi.checkEmpty())
+
+	// data that is invalid after the upgrade is cleared
+	old.Data.Flavors = nil
+	old.InternalEntries = replaceSnapshotEntry(t, snapshot.InternalEntries, "Flavor", &badFlavor)
+	_, err = apis3.dataSnapshotApi.RestoreData(ctx, &old)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "not valid after upgrade")
+	require.Nil(t, apis3.dataSnapshotApi.checkEmpty())
+
+	old.InternalEntries = snapshot.InternalEntries
+	_, err = apis3.dataSnapshotApi.RestoreData(ctx, &old)
+	require.Nil(t, err)
+	vers, err := getDataVersion(ctx, dummy3, curVers)
+	require.Nil(t, err)
+	require.Equal(t, curVers, vers)
+	require.Equal(t, len(apps), apis3.appApi.cache.GetCount())
+}
+
+// replaceSnapshotEntry returns a copy of the entries with the
+// entry for the object replaced.
+func replaceSnapshotEntry(t *testing.T, entries []edgeproto.DataSnapshotEntry, typ string, obj objstore.Obj) []edgeproto.DataSnapshotEntry {
+	val, err := json.Marshal(obj)
+	require.Nil(t, err)
+	dbKey := strings.TrimPrefix(objstore.DbKeyString(typ, obj.GetObjKey()), objstore.DbKeyPrefixString(""))
+	replaced := []edgeproto.DataSnapshotEntry{}
+	found := false
+	for _, entry := range entries {
+		if entry.DbKey == dbKey {
+			entry.DbValue = string(val)
+			found = true
+		}
+		replaced = append(replaced, entry)
+	}
+	require.True(t, found, dbKey)
+	return replaced
+}
+
+// snapshotEntries gets the database entries for the snapshot.
+func snapshotEntries(snapshot *edgeproto.DataSnapshot) map[string]string {
+	entries := map[string]string{}
+	for _, entry := range snapshot.InternalEntries {
+		entries[entry.DbKey] = entry.DbValue
+	}
+	return entries
+}
@@ -100,6 +100,9 @@ type streamSend struct {
 	id        string
 	modRev    int64
 	isCopy    bool
+	// ID of the object, which may be gone by the time the
+	// stream is stopped if a create was undone.
+	objID string
 }
 
 func (s *streamSend) Copy() *streamSend {
@@ -112,6 +115,7 @@ func (s *streamSend) Copy() *streamSend {
 	cp.id = s.id
 	cp.modRev = s.modRev
 	cp.isCopy = true
+	cp.objID = s.objID
 	return &cp
 }
 
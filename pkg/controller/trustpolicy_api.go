@@ -136,6 +136,15 @@ func (s *TrustPolicyApi) ShowTrustPolicy(in *edgeproto.TrustPolicy, cb edgeproto
 	return err
 }
 
+func (s *TrustPolicyApi) ShowTrustPolicyHistory(in *edgeproto.TrustPolicy, cb edgeproto.TrustPolicyApi_ShowTrustPolicyHistoryServer) error {
+	return showObjHistory(cb.Context(), s.all, s.sync, s.cache.GetTypeString(), in, func(rev int64, obj *edgeproto.TrustPolicy) error {
+		return cb.Send(&edgeproto.TrustPolicyHistory{
+			Revision: rev,
+			Obj:      *obj,
+		})
+	})
+}
+
 func (s *TrustPolicyApi) GetTrustPolicies(policies map[edgeproto.PolicyKey]*edgeproto.TrustPolicy) {
 	s.cache.Mux.Lock()
 	defer s.cache.Mux.Unlock()
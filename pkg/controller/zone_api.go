@@ -165,6 +165,15 @@ func (s *ZoneApi) ShowZone(in *edgeproto.Zone, cb edgeproto.ZoneApi_ShowZoneServ
 	return err
 }
 
+func (s *ZoneApi) ShowZoneHistory(in *edgeproto.Zone, cb edgeproto.ZoneApi_ShowZoneHistoryServer) error {
+	return showObjHistory(cb.Context(), s.all, s.sync, s.cache.GetTypeString(), in, func(rev int64, obj *edgeproto.Zone) error {
+		return cb.Send(&edgeproto.ZoneHistory{
+			Revision: rev,
+			Obj:      *obj,
+		})
+	})
+}
+
 // getZoneByID finds the Zone by ID. If not found returns nil Zone instead
 // of an error.
 func (s *ZoneApi) getZoneByID(ctx context.Context, id string) (*edgeproto.Zone, error) {
@@ -66,6 +66,7 @@ var appDNSRoot = flag.String("appDNSRoot", "appdnsroot.net", "App domain name ro
 var ansiblePublicAddr = flag.String("ansiblePublicAddr", "", "ansible webserver address")
 var upgrade = flag.Bool("upgrade", false, "Flag to initiate upgrade run as part of crm bringup")
 var cacheDir = flag.String("cacheDir", "/tmp/", "Cache used by CRM to store frequently accessed data")
+var notifyCompression = flag.String("notifyCompression", "none", "Compression to request for controller notify data, one of none, gzip, or zstd")
 
 // myCloudletInfo is the information for the cloudlet in which the CRM is instantiated.
 // The key for myCloudletInfo is provided as a configuration - either command line or
@@ -186,6 +187,10 @@ func Start(builders map[string]pf.PlatformBuilder) error {
 
 	//ctl notify
 	addrs := strings.Split(*notifyAddrs, ",")
+	compression, err := edgeproto.ParseNoticeCompression(*notifyCompression)
+	if err != nil {
+		return err
+	}
 	notifyClientTls, err := nodeMgr.InternalPki.GetClientTlsConfig(ctx,
 		nodeMgr.CommonNamePrefix(),
 		svcnode.CertIssuerRegionalCloudlet,
@@ -204,6 +209,7 @@ func Start(builders map[string]pf.PlatformBuilder) error {
 	notifyClient = notify.NewClient(nodeMgr.Name(), addrs, dialOption,
 		notify.ClientUnaryInterceptors(nodeMgr.AccessKeyClient.UnaryAddAccessKey),
 		notify.ClientStreamInterceptors(nodeMgr.AccessKeyClient.StreamAddAccessKey),
+		notify.ClientCompression(compression),
 	)
 	notifyClient.SetFilterByCloudletKey()
 	InitClientNotify(notifyClient, &nodeMgr, crmdata, crmdata.CRMHandler)
@@ -397,7 +403,7 @@ func Start(builders map[string]pf.PlatformBuilder) error {
 
 	// setup crm notify listener (for shepherd)
 	var notifyServ notify.ServerMgr
-	InitSrvNotify(&notifyServ, &nodeMgr, crmdata.CRMHandler)
+	InitSrvNotify(&notifyServ, &nodeMgr, crmdata, crmdata.CRMHandler)
 	notifyServ.Start(nodeMgr.Name(), *notifySrvAddr, notifyServerTls)
 	notifyServer = &notifyServ
 
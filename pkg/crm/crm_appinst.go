@@ -110,6 +110,22 @@ func (s *CRMData) appInstInfoCheckState(ctx context.Context, key *edgeproto.AppI
 	})
 }
 
+// setAppInstReplicas updates the running replicas of the AppInst
+// reported by Shepherd.
+func (s *CRMData) setAppInstReplicas(ctx context.Context, key *edgeproto.AppInstKey, replicas int32) {
+	info := edgeproto.AppInstInfo{}
+	if !s.AppInstInfoCache.Get(key, &info) || info.RuntimeInfo.Replicas == replicas {
+		return
+	}
+	log.SpanLog(ctx, log.DebugLevelInfra, "set appinst replicas", "appInst", key, "replicas", replicas)
+	sender := edgeproto.NewAppInstInfoCacheUpdater(ctx, &s.AppInstInfoCache, *key)
+	sender.SendUpdate(func(update *edgeproto.AppInstInfo) error {
+		update.Fields = []string{edgeproto.AppInstInfoFieldRuntimeInfoReplicas}
+		update.RuntimeInfo.Replicas = replicas
+		return nil
+	})
+}
+
 func (s *CRMData) RefreshAppInstRuntime(ctx context.Context) {
 	log.SpanLog(ctx, log.DebugLevelInfra, "Refresh appinst runtime info")
 	s.refreshAppInstRuntime(ctx, nil, nil)
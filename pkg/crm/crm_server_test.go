@@ -469,7 +469,7 @@ func TestNotifyOrder(t *testing.T) {
 	defer nodeMgr.Finish()
 	crmdata = NewCRMData(nil, &edgeproto.CloudletKey{}, &nodeMgr, &highAvailabilityManager)
 	mgr := notify.ServerMgr{}
-	InitSrvNotify(&mgr, &nodeMgr, crmdata.CRMHandler)
+	InitSrvNotify(&mgr, &nodeMgr, crmdata, crmdata.CRMHandler)
 	testservices.CheckNotifySendOrder(t, mgr.GetSendOrder())
 }
 
@@ -57,7 +57,7 @@ func InitClientNotify(client *notify.Client, nodeMgr *svcnode.SvcNodeMgr, crmd *
 	nodeMgr.RegisterClient(client)
 }
 
-func InitSrvNotify(notifyServer *notify.ServerMgr, nodeMgr *svcnode.SvcNodeMgr, controllerData *crmutil.CRMHandler) {
+func InitSrvNotify(notifyServer *notify.ServerMgr, nodeMgr *svcnode.SvcNodeMgr, crmd *CRMData, controllerData *crmutil.CRMHandler) {
 	notifyServer.RegisterSendSettingsCache(&controllerData.SettingsCache)
 	notifyServer.RegisterSendFlavorCache(&controllerData.FlavorCache)
 	notifyServer.RegisterSendVMPoolCache(&controllerData.VMPoolCache)
@@ -78,6 +78,14 @@ func InitSrvNotify(notifyServer *notify.ServerMgr, nodeMgr *svcnode.SvcNodeMgr,
 	var DummyCloudletInfoRecvCache edgeproto.CloudletInfoCache
 	edgeproto.InitCloudletInfoCache(&DummyCloudletInfoRecvCache)
 	notifyServer.RegisterRecvCloudletInfoCache(&DummyCloudletInfoRecvCache)
+	// Shepherd sends the running replicas of AppInsts, which are
+	// forwarded to the controller as part of the CRM's AppInstInfo.
+	var shepherdAppInstInfoCache edgeproto.AppInstInfoCache
+	edgeproto.InitAppInstInfoCache(&shepherdAppInstInfoCache)
+	shepherdAppInstInfoCache.SetUpdatedCb(func(ctx context.Context, old *edgeproto.AppInstInfo, new *edgeproto.AppInstInfo) {
+		crmd.setAppInstReplicas(ctx, &new.Key, new.RuntimeInfo.Replicas)
+	})
+	notifyServer.RegisterRecvAppInstInfoCache(&shepherdAppInstInfoCache)
 	nodeMgr.RegisterServer(notifyServer)
 }
 
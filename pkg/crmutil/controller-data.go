@@ -649,7 +649,12 @@ func (cd *CRMHandler) appInstInfoRuntime(ctx context.Context, sender edgeproto.A
 			edgeproto.AppInstInfoFieldStatus,
 		}
 		info.State = state
+		// keep replicas reported by Shepherd
+		replicas := info.RuntimeInfo.Replicas
 		info.RuntimeInfo = *rt
+		if info.RuntimeInfo.Replicas == 0 {
+			info.RuntimeInfo.Replicas = replicas
+		}
 		info.Status.SetTask(edgeproto.TrackedState_CamelName[int32(state)])
 		return nil
 	})
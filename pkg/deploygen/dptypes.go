@@ -39,6 +39,7 @@ type AppSpec struct {
 	Ports            []util.PortSpec `json:"ports"`
 	ScaleWithCluster bool            `json:"scalewithcluster"`
 	ImageHost        string          `json:"imagehost"`
+	Replicas         uint32          `json:"replicas"`
 }
 
 func NewAppSpec(app *edgeproto.App) (*AppSpec, error) {
@@ -53,6 +54,10 @@ func NewAppSpec(app *edgeproto.App) (*AppSpec, error) {
 		Args:             app.CommandArgs,
 		Annotations:      app.Annotations,
 		ScaleWithCluster: app.ScaleWithCluster,
+		Replicas:         1,
+	}
+	if app.ReplicaAutoScalePolicy != nil && app.ReplicaAutoScalePolicy.MinReplicas > 0 {
+		out.Replicas = app.ReplicaAutoScalePolicy.MinReplicas
 	}
 	urlObj, err := util.ImagePathParse(app.ImagePath)
 	if err != nil {
@@ -226,6 +226,10 @@ func (g *kubeBasicGen) kubeApp() {
 		// "kubectl create secret docker-registry" cannot include ":"
 		registrySecret = hostname[0] + "-" + hostname[1]
 	}
+	replicas := g.app.Replicas
+	if replicas == 0 {
+		replicas = 1
+	}
 	data := appData{
 		Name:           util.DNSSanitize(appID(g.app) + "-deployment"),
 		DNSName:        util.DNSSanitize(appID(g.app)),
@@ -236,6 +240,7 @@ func (g *kubeBasicGen) kubeApp() {
 		Args:           g.app.Args,
 		RegistrySecret: registrySecret,
 		MexDeployGen:   MexDeployGenLabel,
+		Replicas:       replicas,
 	}
 	buf := bytes.Buffer{}
 	if g.app.ScaleWithCluster {
@@ -259,6 +264,7 @@ type appData struct {
 	Args           []string
 	RegistrySecret string
 	MexDeployGen   string
+	Replicas       uint32
 }
 
 var dpTemplate = `apiVersion: apps/v1
@@ -266,7 +272,7 @@ kind: Deployment
 metadata:
   name: {{.Name}}
 spec:
-  replicas: 1`
+  replicas: {{.Replicas}}`
 
 var dsTemplate = `apiVersion: apps/v1
 kind: DaemonSet
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/util"
 	"github.com/stretchr/testify/require"
 )
@@ -51,6 +52,39 @@ func TestDeploygenBasic(t *testing.T) {
 	require.Equal(t, basicManifest, manifest)
 }
 
+func TestDeploygenReplicas(t *testing.T) {
+	app := edgeproto.App{
+		Key: edgeproto.AppKey{
+			Name:         "myapp",
+			Organization: "myorg",
+			Version:      "1.0",
+		},
+		ImagePath:   "docker.io/company/imagename:latest",
+		ImageType:   edgeproto.ImageType_IMAGE_TYPE_DOCKER,
+		AccessPorts: "tcp:443",
+	}
+	appSpec, err := NewAppSpec(&app)
+	require.Nil(t, err)
+	require.Equal(t, uint32(1), appSpec.Replicas)
+	manifest, err := kubeBasic(appSpec)
+	require.Nil(t, err)
+	require.Contains(t, manifest, "kind: Deployment")
+	require.Contains(t, manifest, "  replicas: 1\n")
+
+	// auto-scaled apps start with the min replicas
+	app.ReplicaAutoScalePolicy = &edgeproto.ReplicaAutoScalePolicy{
+		MinReplicas: 2,
+		MaxReplicas: 4,
+		TargetCpu:   80,
+	}
+	appSpec, err = NewAppSpec(&app)
+	require.Nil(t, err)
+	require.Equal(t, uint32(2), appSpec.Replicas)
+	manifest, err = kubeBasic(appSpec)
+	require.Nil(t, err)
+	require.Contains(t, manifest, "  replicas: 2\n")
+}
+
 var basicManifest = `apiVersion: v1
 kind: Service
 metadata:
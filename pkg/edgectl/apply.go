@@ -0,0 +1,621 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cli"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/gencmd"
+	"github.com/edgexr/edge-cloud-platform/pkg/objstore"
+	"github.com/spf13/pflag"
+)
+
+// Apply and Diff take an AllData file describing the desired
+// state of the region, and compare it against the objects in
+// the region. Only the object types present in the file are
+// compared. Objects in the file that are missing from the region
+// are created, and objects that differ in the fields specified
+// in the file are updated. With prune, objects in the region
+// that are missing from the file are deleted, limited to the
+// organizations present in the file. Objects created by the
+// platform, such as auto-provisioned AppInsts and auto-clusters,
+// are never pruned.
+
+var applyPrune bool
+
+var applyCmd = &cli.Command{
+	Use:          "Apply",
+	Short:        "Create, update, and optionally delete objects to match the data",
+	DataFlagOnly: true,
+	ReqData:      &edgeproto.AllData{},
+	ReplyData:    &edgeproto.Result{},
+	Run:          runApply,
+	AddFlagsFunc: addApplyFlags,
+}
+
+var diffCmd = &cli.Command{
+	Use:          "Diff",
+	Short:        "Show the changes Apply would make, without making them",
+	DataFlagOnly: true,
+	ReqData:      &edgeproto.AllData{},
+	ReplyData:    &edgeproto.Result{},
+	Run:          runDiff,
+	AddFlagsFunc: addApplyFlags,
+}
+
+func addApplyFlags(flagSet *pflag.FlagSet) {
+	flagSet.BoolVar(&applyPrune, "prune", false, "delete objects not in the data, for the object types and organizations in the data, except objects created by the platform")
+}
+
+func runApply(c *cli.Command, args []string) error {
+	plan, err := getApplyPlan(c, args)
+	if err != nil {
+		return err
+	}
+	plan.print()
+	if err := plan.err(); err != nil {
+		return err
+	}
+	for _, step := range plan.steps() {
+		fmt.Printf("%s\n", step)
+		if err := step.run(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func runDiff(c *cli.Command, args []string) error {
+	plan, err := getApplyPlan(c, args)
+	if err != nil {
+		return err
+	}
+	plan.print()
+	return plan.err()
+}
+
+func getApplyPlan(c *cli.Command, args []string) (*applyPlan, error) {
+	mapped, err := c.ParseInput(args)
+	if err != nil {
+		return nil, err
+	}
+	for dataKey := range mapped.Data {
+		if _, found := applyDataKeys[dataKey]; !found {
+			return nil, fmt.Errorf("%s are not supported by apply", dataKey)
+		}
+	}
+	desired := c.ReqData.(*edgeproto.AllData)
+	current, err := showApplyData(context.Background(), mapped)
+	if err != nil {
+		return nil, err
+	}
+	return newApplyPlan(desired, mapped, current, applyPrune)
+}
+
+// applyDataKeys are the AllData fields that apply supports,
+// keyed by json name.
+var applyDataKeys = map[string]struct{}{
+	"flavors":             {},
+	"zones":               {},
+	"auto_scale_policies": {},
+	"auto_prov_policies":  {},
+	"geo_fence_policies":  {},
+	"trust_policies":      {},
+	"alert_policies":      {},
+	"apps":                {},
+	"cluster_insts":       {},
+	"app_instances":       {},
+}
+
+// showApplyData gets the current objects in the region for the
+// object types present in the mapped data.
+func showApplyData(ctx context.Context, mapped *cli.MapData) (*edgeproto.AllData, error) {
+	data := &edgeproto.AllData{}
+	has := func(dataKey string) bool {
+		_, found := mapped.Data[dataKey]
+		return found
+	}
+	var err error
+	if has("flavors") {
+		data.Flavors, err = recvObjs[edgeproto.Flavor](gencmd.FlavorApiCmd.ShowFlavor(ctx, &edgeproto.Flavor{}))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if has("zones") {
+		data.Zones, err = recvObjs[edgeproto.Zone](gencmd.ZoneApiCmd.ShowZone(ctx, &edgeproto.Zone{}))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if has("auto_scale_policies") {
+		data.AutoScalePolicies, err = recvObjs[edgeproto.AutoScalePolicy](gencmd.AutoScalePolicyApiCmd.ShowAutoScalePolicy(ctx, &edgeproto.AutoScalePolicy{}))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if has("auto_prov_policies") {
+		data.AutoProvPolicies, err = recvObjs[edgeproto.AutoProvPolicy](gencmd.AutoProvPolicyApiCmd.ShowAutoProvPolicy(ctx, &edgeproto.AutoProvPolicy{}))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if has("geo_fence_policies") {
+		data.GeoFencePolicies, err = recvObjs[edgeproto.GeoFencePolicy](gencmd.GeoFencePolicyApiCmd.ShowGeoFencePolicy(ctx, &edgeproto.GeoFencePolicy{}))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if has("trust_policies") {
+		data.TrustPolicies, err = recvObjs[edgeproto.TrustPolicy](gencmd.TrustPolicyApiCmd.ShowTrustPolicy(ctx, &edgeproto.TrustPolicy{}))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if has("alert_policies") {
+		data.AlertPolicies, err = recvObjs[edgeproto.AlertPolicy](gencmd.AlertPolicyApiCmd.ShowAlertPolicy(ctx, &edgeproto.AlertPolicy{}))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if has("apps") {
+		data.Apps, err = recvObjs[edgeproto.App](gencmd.AppApiCmd.ShowApp(ctx, &edgeproto.App{}))
+		if err != nil {
+			return nil, err
+		}
+		data.AppInstRefs, err = recvObjs[edgeproto.AppInstRefs](gencmd.AppInstRefsApiCmd.ShowAppInstRefs(ctx, &edgeproto.AppInstRefs{}))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if has("cluster_insts") {
+		data.ClusterInsts, err = recvObjs[edgeproto.ClusterInst](gencmd.ClusterInstApiCmd.ShowClusterInst(ctx, &edgeproto.ClusterInst{}))
+		if err != nil {
+			return nil, err
+		}
+		data.ClusterRefs, err = recvObjs[edgeproto.ClusterRefs](gencmd.ClusterRefsApiCmd.ShowClusterRefs(ctx, &edgeproto.ClusterRefs{}))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if has("app_instances") {
+		data.AppInstances, err = recvObjs[edgeproto.AppInst](gencmd.AppInstApiCmd.ShowAppInst(ctx, &edgeproto.AppInst{}))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+func recvObjs[T any](stream cloudcommon.GRPCStreamRecv[*T], err error) ([]T, error) {
+	if err != nil {
+		return nil, cloudcommon.GRPCErrorUnwrap(err)
+	}
+	objs := []T{}
+	err = cloudcommon.StreamRecv(context.Background(), stream, func(obj *T) error {
+		objs = append(objs, *obj)
+		return nil
+	})
+	return objs, err
+}
+
+const (
+	applyCreate = "create"
+	applyUpdate = "update"
+	applyDelete = "delete"
+)
+
+type applyStep struct {
+	action  string
+	objType string
+	key     string
+	// names of the changed fields, for updates
+	fields []string
+	run    func(c *cli.Command) error
+}
+
+func (s *applyStep) String() string {
+	str := s.action + " " + s.objType + " " + s.key
+	if len(s.fields) > 0 {
+		str += ": " + strings.Join(s.fields, ", ")
+	}
+	return str
+}
+
+// applyPlan is the set of changes needed to make the region
+// match the desired data. Creates and updates are done first,
+// in dependency order, followed by deletes in reverse dependency
+// order.
+type applyPlan struct {
+	prune   bool
+	orgs    map[string]struct{}
+	applies []*applyStep
+	deletes []*applyStep
+	// keys of objects to delete, by type
+	deleting map[string]map[string]struct{}
+	errs     []string
+}
+
+// applyOps are the per-type functions used to plan and run
+// changes for an object type.
+type applyOps[T any, PT applyObjPtr[T]] struct {
+	objType    string
+	dataKey    string
+	fieldNames map[string]string
+	// org is nil for types that do not belong to an organization
+	org       func(obj PT) string
+	setFields func(obj PT, fields []string)
+	// keep prevents objects owned by the platform from being pruned
+	keep   func(obj PT) bool
+	create func(c *cli.Command, obj PT) error
+	update func(c *cli.Command, obj PT) error
+	delete func(c *cli.Command, obj PT) error
+}
+
+type applyObjPtr[T any] interface {
+	*T
+	GetObjKey() objstore.ObjKey
+	GetDiffFields(o *T) *edgeproto.FieldMap
+	IsKeyField(s string) bool
+}
+
+func newApplyPlan(desired *edgeproto.AllData, mapped *cli.MapData, current *edgeproto.AllData, prune bool) (*applyPlan, error) {
+	p := &applyPlan{
+		prune:    prune,
+		orgs:     map[string]struct{}{},
+		deleting: map[string]map[string]struct{}{},
+	}
+	for _, obj := range desired.Zones {
+		p.orgs[obj.Key.Organization] = struct{}{}
+	}
+	for _, obj := range desired.AutoScalePolicies {
+		p.orgs[obj.Key.Organization] = struct{}{}
+	}
+	for _, obj := range desired.AutoProvPolicies {
+		p.orgs[obj.Key.Organization] = struct{}{}
+	}
+	for _, obj := range desired.GeoFencePolicies {
+		p.orgs[obj.Key.Organization] = struct{}{}
+	}
+	for _, obj := range desired.TrustPolicies {
+		p.orgs[obj.Key.Organization] = struct{}{}
+	}
+	for _, obj := range desired.AlertPolicies {
+		p.orgs[obj.Key.Organization] = struct{}{}
+	}
+	for _, obj := range desired.Apps {
+		p.orgs[obj.Key.Organization] = struct{}{}
+	}
+	for _, obj := range desired.ClusterInsts {
+		p.orgs[obj.Key.Organization] = struct{}{}
+	}
+	for _, obj := range desired.AppInstances {
+		p.orgs[obj.Key.Organization] = struct{}{}
+	}
+
+	// plan in dependency order
+	err := planObjs(p, mapped, desired.Flavors, current.Flavors, &applyOps[edgeproto.Flavor, *edgeproto.Flavor]{
+		objType:    "Flavor",
+		dataKey:    "flavors",
+		fieldNames: edgeproto.FlavorAllFieldsStringMap,
+		setFields:  func(obj *edgeproto.Flavor, fields []string) { obj.Fields = fields },
+		create:     gencmd.CreateFlavor,
+		update:     gencmd.UpdateFlavor,
+		delete:     gencmd.DeleteFlavor,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = planObjs(p, mapped, desired.Zones, current.Zones, &applyOps[edgeproto.Zone, *edgeproto.Zone]{
+		objType:    "Zone",
+		dataKey:    "zones",
+		fieldNames: edgeproto.ZoneAllFieldsStringMap,
+		org:        func(obj *edgeproto.Zone) string { return obj.Key.Organization },
+		setFields:  func(obj *edgeproto.Zone, fields []string) { obj.Fields = fields },
+		create:     gencmd.CreateZone,
+		update:     gencmd.UpdateZone,
+		delete:     gencmd.DeleteZone,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = planObjs(p, mapped, desired.AutoScalePolicies, current.AutoScalePolicies, &applyOps[edgeproto.AutoScalePolicy, *edgeproto.AutoScalePolicy]{
+		objType:    "AutoScalePolicy",
+		dataKey:    "auto_scale_policies",
+		fieldNames: edgeproto.AutoScalePolicyAllFieldsStringMap,
+		org:        func(obj *edgeproto.AutoScalePolicy) string { return obj.Key.Organization },
+		setFields:  func(obj *edgeproto.AutoScalePolicy, fields []string) { obj.Fields = fields },
+		create:     gencmd.CreateAutoScalePolicy,
+		update:     gencmd.UpdateAutoScalePolicy,
+		delete:     gencmd.DeleteAutoScalePolicy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = planObjs(p, mapped, desired.AutoProvPolicies, current.AutoProvPolicies, &applyOps[edgeproto.AutoProvPolicy, *edgeproto.AutoProvPolicy]{
+		objType:    "AutoProvPolicy",
+		dataKey:    "auto_prov_policies",
+		fieldNames: edgeproto.AutoProvPolicyAllFieldsStringMap,
+		org:        func(obj *edgeproto.AutoProvPolicy) string { return obj.Key.Organization },
+		setFields:  func(obj *edgeproto.AutoProvPolicy, fields []string) { obj.Fields = fields },
+		create:     gencmd.CreateAutoProvPolicy,
+		update:     gencmd.UpdateAutoProvPolicy,
+		delete:     gencmd.DeleteAutoProvPolicy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = planObjs(p, mapped, desired.GeoFencePolicies, current.GeoFencePolicies, &applyOps[edgeproto.GeoFencePolicy, *edgeproto.GeoFencePolicy]{
+		objType:    "GeoFencePolicy",
+		dataKey:    "geo_fence_policies",
+		fieldNames: edgeproto.GeoFencePolicyAllFieldsStringMap,
+		org:        func(obj *edgeproto.GeoFencePolicy) string { return obj.Key.Organization },
+		setFields:  func(obj *edgeproto.GeoFencePolicy, fields []string) { obj.Fields = fields },
+		create:     gencmd.CreateGeoFencePolicy,
+		update:     gencmd.UpdateGeoFencePolicy,
+		delete:     gencmd.DeleteGeoFencePolicy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = planObjs(p, mapped, desired.TrustPolicies, current.TrustPolicies, &applyOps[edgeproto.TrustPolicy, *edgeproto.TrustPolicy]{
+		objType:    "TrustPolicy",
+		dataKey:    "trust_policies",
+		fieldNames: edgeproto.TrustPolicyAllFieldsStringMap,
+		org:        func(obj *edgeproto.TrustPolicy) string { return obj.Key.Organization },
+		setFields:  func(obj *edgeproto.TrustPolicy, fields []string) { obj.Fields = fields },
+		create:     gencmd.CreateTrustPolicy,
+		update:     gencmd.UpdateTrustPolicy,
+		delete:     gencmd.DeleteTrustPolicy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = planObjs(p, mapped, desired.AlertPolicies, current.AlertPolicies, &applyOps[edgeproto.AlertPolicy, *edgeproto.AlertPolicy]{
+		objType:    "AlertPolicy",
+		dataKey:    "alert_policies",
+		fieldNames: edgeproto.AlertPolicyAllFieldsStringMap,
+		org:        func(obj *edgeproto.AlertPolicy) string { return obj.Key.Organization },
+		setFields:  func(obj *edgeproto.AlertPolicy, fields []string) { obj.Fields = fields },
+		create:     gencmd.CreateAlertPolicy,
+		update:     gencmd.UpdateAlertPolicy,
+		delete:     gencmd.DeleteAlertPolicy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = planObjs(p, mapped, desired.Apps, current.Apps, &applyOps[edgeproto.App, *edgeproto.App]{
+		objType:    "App",
+		dataKey:    "apps",
+		fieldNames: edgeproto.AppAllFieldsStringMap,
+		org:        func(obj *edgeproto.App) string { return obj.Key.Organization },
+		setFields:  func(obj *edgeproto.App, fields []string) { obj.Fields = fields },
+		create:     gencmd.CreateApp,
+		update:     gencmd.UpdateApp,
+		delete:     gencmd.DeleteApp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = planObjs(p, mapped, desired.ClusterInsts, current.ClusterInsts, &applyOps[edgeproto.ClusterInst, *edgeproto.ClusterInst]{
+		objType:    "ClusterInst",
+		dataKey:    "cluster_insts",
+		fieldNames: edgeproto.ClusterInstAllFieldsStringMap,
+		org:        func(obj *edgeproto.ClusterInst) string { return obj.Key.Organization },
+		setFields:  func(obj *edgeproto.ClusterInst, fields []string) { obj.Fields = fields },
+		keep:       func(obj *edgeproto.ClusterInst) bool { return obj.Reservable || isPlatformCreated(obj.Liveness) },
+		create:     gencmd.CreateClusterInst,
+		update:     gencmd.UpdateClusterInst,
+		delete:     gencmd.DeleteClusterInst,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = planObjs(p, mapped, desired.AppInstances, current.AppInstances, &applyOps[edgeproto.AppInst, *edgeproto.AppInst]{
+		objType:    "AppInst",
+		dataKey:    "app_instances",
+		fieldNames: edgeproto.AppInstAllFieldsStringMap,
+		org:        func(obj *edgeproto.AppInst) string { return obj.Key.Organization },
+		setFields:  func(obj *edgeproto.AppInst, fields []string) { obj.Fields = fields },
+		keep:       func(obj *edgeproto.AppInst) bool { return isPlatformCreated(obj.Liveness) },
+		create:     gencmd.CreateAppInst,
+		update:     gencmd.UpdateAppInst,
+		delete:     gencmd.DeleteAppInst,
+	})
+	if err != nil {
+		return nil, err
+	}
+	p.checkDeleteRefs(current)
+	return p, nil
+}
+
+// isPlatformCreated checks if the object was created by the
+// platform, i.e. by auto-provisioning or as an auto-cluster,
+// rather than by the user.
+func isPlatformCreated(liveness edgeproto.Liveness) bool {
+	return liveness == edgeproto.Liveness_LIVENESS_AUTOPROV || liveness == edgeproto.Liveness_LIVENESS_DYNAMIC
+}
+
+// planObjs adds the steps needed to change the current objects
+// of one type into the desired objects.
+func planObjs[T any, PT applyObjPtr[T]](p *applyPlan, mapped *cli.MapData, desired, current []T, ops *applyOps[T, PT]) error {
+	dataList, found := mapped.Data[ops.dataKey]
+	if !found {
+		return nil
+	}
+	curObjs := map[string]PT{}
+	for ii := range current {
+		obj := PT(&current[ii])
+		curObjs[obj.GetObjKey().GetKeyString()] = obj
+	}
+	desiredKeys := map[string]struct{}{}
+	for ii := range desired {
+		obj := PT(&desired[ii])
+		key := obj.GetObjKey().GetKeyString()
+		desiredKeys[key] = struct{}{}
+		cur, found := curObjs[key]
+		if !found {
+			p.applies = append(p.applies, &applyStep{
+				action:  applyCreate,
+				objType: ops.objType,
+				key:     key,
+				run:     func(c *cli.Command) error { return ops.create(c, obj) },
+			})
+			continue
+		}
+		// only fields specified in the data are compared
+		objMap, err := getDataListObj(dataList, ii)
+		if err != nil {
+			return fmt.Errorf("invalid data map for %s %s: %v", ops.objType, key, err)
+		}
+		specified := cli.GetSpecifiedFields(&cli.MapData{
+			Namespace: mapped.Namespace,
+			Data:      objMap,
+		}, obj)
+		diffFields := obj.GetDiffFields(cur)
+		fields := []string{}
+		for _, field := range specified {
+			if !obj.IsKeyField(field) && diffFields.Has(field) {
+				fields = append(fields, field)
+			}
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		sort.Strings(fields)
+		ops.setFields(obj, fields)
+		names := []string{}
+		for _, field := range fields {
+			names = append(names, ops.fieldNames[field])
+		}
+		p.applies = append(p.applies, &applyStep{
+			action:  applyUpdate,
+			objType: ops.objType,
+			key:     key,
+			fields:  names,
+			run:     func(c *cli.Command) error { return ops.update(c, obj) },
+		})
+	}
+	if !p.prune {
+		return nil
+	}
+	deletes := []*applyStep{}
+	deleting := map[string]struct{}{}
+	for ii := range current {
+		obj := PT(&current[ii])
+		key := obj.GetObjKey().GetKeyString()
+		if _, found := desiredKeys[key]; found {
+			continue
+		}
+		if ops.org != nil {
+			if _, found := p.orgs[ops.org(obj)]; !found {
+				continue
+			}
+		}
+		if ops.keep != nil && ops.keep(obj) {
+			continue
+		}
+		deletes = append(deletes, &applyStep{
+			action:  applyDelete,
+			objType: ops.objType,
+			key:     key,
+			run:     func(c *cli.Command) error { return ops.delete(c, obj) },
+		})
+		deleting[key] = struct{}{}
+	}
+	// types are planned in dependency order, so deletes
+	// for later types must be run first
+	p.deletes = append(deletes, p.deletes...)
+	p.deleting[ops.objType] = deleting
+	return nil
+}
+
+// getDataListObj gets the mapped data for an object in a list.
+// Lists converted from yaml are already lists of maps.
+func getDataListObj(dataList interface{}, idx int) (map[string]interface{}, error) {
+	if list, ok := dataList.([]map[string]interface{}); ok && idx < len(list) {
+		return list[idx], nil
+	}
+	return cli.GetGenericObjFromList(dataList, idx)
+}
+
+// checkDeleteRefs flags deletes of objects that are still in use
+// by objects that are not being deleted.
+func (p *applyPlan) checkDeleteRefs(current *edgeproto.AllData) {
+	isDeleting := func(objType, key string) bool {
+		_, found := p.deleting[objType][key]
+		return found
+	}
+	for _, refs := range current.AppInstRefs {
+		appKey := refs.Key.GetKeyString()
+		if !isDeleting("App", appKey) {
+			continue
+		}
+		instKeys := []string{}
+		for instKey := range refs.Insts {
+			instKeys = append(instKeys, instKey)
+		}
+		sort.Strings(instKeys)
+		for _, instKey := range instKeys {
+			if !isDeleting("AppInst", instKey) {
+				p.errs = append(p.errs, fmt.Sprintf("cannot delete App %s, in use by AppInst %s", appKey, instKey))
+			}
+		}
+	}
+	for _, refs := range current.ClusterRefs {
+		clusterKey := refs.Key.GetKeyString()
+		if !isDeleting("ClusterInst", clusterKey) {
+			continue
+		}
+		for _, instKey := range refs.Apps {
+			if !isDeleting("AppInst", instKey.GetKeyString()) {
+				p.errs = append(p.errs, fmt.Sprintf("cannot delete ClusterInst %s, in use by AppInst %s", clusterKey, instKey.GetKeyString()))
+			}
+		}
+	}
+}
+
+func (p *applyPlan) steps() []*applyStep {
+	return append(append([]*applyStep{}, p.applies...), p.deletes...)
+}
+
+func (p *applyPlan) err() error {
+	if len(p.errs) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(p.errs, "; "))
+}
+
+func (p *applyPlan) print() {
+	counts := map[string]int{}
+	steps := p.steps()
+	for _, step := range steps {
+		counts[step.action]++
+	}
+	fmt.Printf("Plan: %d to create, %d to update, %d to delete\n", counts[applyCreate], counts[applyUpdate], counts[applyDelete])
+	for _, step := range steps {
+		fmt.Printf("  %s\n", step)
+	}
+	for _, msg := range p.errs {
+		fmt.Printf("  error: %s\n", msg)
+	}
+}
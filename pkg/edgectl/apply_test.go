@@ -0,0 +1,194 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cli"
+	"github.com/stretchr/testify/require"
+)
+
+var applyTestData = `
+flavors:
+- key:
+    name: x1.small
+  ram: 1024
+  vcpus: 1
+  disk: 10
+apps:
+- key:
+    organization: dev
+    name: app1
+    version: "1.0"
+  accessports: tcp:443
+- key:
+    organization: dev
+    name: app2
+    version: "1.0"
+  imagepath: docker.example.com/app2
+clusterinsts:
+- key:
+    organization: dev
+    name: cluster1
+appinstances:
+- key:
+    organization: dev
+    name: inst2
+  appkey:
+    organization: dev
+    name: app2
+    version: "1.0"
+`
+
+func TestApplyPlan(t *testing.T) {
+	flavor := edgeproto.Flavor{
+		Key:   edgeproto.FlavorKey{Name: "x1.small"},
+		Ram:   1024,
+		Vcpus: 1,
+		Disk:  10,
+	}
+	oldFlavor := edgeproto.Flavor{
+		Key: edgeproto.FlavorKey{Name: "x1.old"},
+	}
+	app1 := edgeproto.App{
+		Key: edgeproto.AppKey{
+			Organization: "dev",
+			Name:         "app1",
+			Version:      "1.0",
+		},
+		AccessPorts: "tcp:80",
+		ImagePath:   "docker.example.com/app1",
+	}
+	app3 := edgeproto.App{
+		Key: edgeproto.AppKey{
+			Organization: "dev",
+			Name:         "app3",
+			Version:      "1.0",
+		},
+	}
+	otherApp := edgeproto.App{
+		Key: edgeproto.AppKey{
+			Organization: "other",
+			Name:         "app1",
+			Version:      "1.0",
+		},
+	}
+	inst3 := edgeproto.AppInst{
+		Key: edgeproto.AppInstKey{
+			Organization: "dev",
+			Name:         "inst3",
+		},
+		AppKey: app3.Key,
+	}
+	// created by the platform, so never pruned
+	autoProvInst := edgeproto.AppInst{
+		Key: edgeproto.AppInstKey{
+			Organization: "dev",
+			Name:         "autoprov-inst",
+		},
+		AppKey:   app1.Key,
+		Liveness: edgeproto.Liveness_LIVENESS_AUTOPROV,
+	}
+	autoCluster := edgeproto.ClusterInst{
+		Key: edgeproto.ClusterKey{
+			Organization: "dev",
+			Name:         "autocluster1",
+		},
+		Liveness: edgeproto.Liveness_LIVENESS_DYNAMIC,
+	}
+	cluster1 := edgeproto.ClusterInst{
+		Key: edgeproto.ClusterKey{
+			Organization: "dev",
+			Name:         "cluster1",
+		},
+		Liveness: edgeproto.Liveness_LIVENESS_STATIC,
+	}
+	current := &edgeproto.AllData{
+		Flavors:      []edgeproto.Flavor{flavor, oldFlavor},
+		Apps:         []edgeproto.App{app1, app3, otherApp},
+		ClusterInsts: []edgeproto.ClusterInst{cluster1, autoCluster},
+		AppInstances: []edgeproto.AppInst{inst3, autoProvInst},
+		AppInstRefs: []edgeproto.AppInstRefs{{
+			Key: app3.Key,
+			Insts: map[string]uint32{
+				inst3.Key.GetKeyString(): 1,
+			},
+		}},
+	}
+
+	getPlan := func(prune bool) *applyPlan {
+		c := &cli.Command{
+			ReqData: &edgeproto.AllData{},
+		}
+		cli.Data = applyTestData
+		defer func() {
+			cli.Data = ""
+		}()
+		mapped, err := c.ParseInput([]string{})
+		require.Nil(t, err)
+		plan, err := newApplyPlan(c.ReqData.(*edgeproto.AllData), mapped, current, prune)
+		require.Nil(t, err)
+		return plan
+	}
+	stepStrs := func(plan *applyPlan) []string {
+		strs := []string{}
+		for _, step := range plan.steps() {
+			strs = append(strs, step.String())
+		}
+		return strs
+	}
+	inst2Key := edgeproto.AppInstKey{
+		Organization: "dev",
+		Name:         "inst2",
+	}
+
+	// only specified fields are compared, unchanged objects are skipped
+	plan := getPlan(false)
+	require.Nil(t, plan.err())
+	require.Equal(t, []string{
+		"update App " + app1.Key.GetKeyString() + ": Access Ports",
+		`create App {"organization":"dev","name":"app2","version":"1.0"}`,
+		"create AppInst " + inst2Key.GetKeyString(),
+	}, stepStrs(plan))
+
+	// prune deletes in reverse dependency order, only for
+	// organizations in the data
+	plan = getPlan(true)
+	require.Nil(t, plan.err())
+	require.Equal(t, []string{
+		"update App " + app1.Key.GetKeyString() + ": Access Ports",
+		`create App {"organization":"dev","name":"app2","version":"1.0"}`,
+		"create AppInst " + inst2Key.GetKeyString(),
+		"delete AppInst " + inst3.Key.GetKeyString(),
+		"delete App " + app3.Key.GetKeyString(),
+		`delete Flavor {"name":"x1.old"}`,
+	}, stepStrs(plan))
+
+	// apps still in use cannot be deleted
+	otherInst := edgeproto.AppInst{
+		Key: edgeproto.AppInstKey{
+			Organization: "other",
+			Name:         "inst3",
+		},
+		AppKey: app3.Key,
+	}
+	current.AppInstances = append(current.AppInstances, otherInst)
+	current.AppInstRefs[0].Insts[otherInst.Key.GetKeyString()] = 1
+	plan = getPlan(true)
+	require.NotNil(t, plan.err())
+	require.Equal(t, "cannot delete App "+app3.Key.GetKeyString()+", in use by AppInst "+otherInst.Key.GetKeyString(), plan.err().Error())
+}
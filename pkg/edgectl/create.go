@@ -55,6 +55,7 @@ func runCreate(c *cli.Command, args []string) error {
 	gencmd.CreateCloudlets(c, data.Cloudlets, &err)
 	gencmd.CreateAutoScalePolicys(c, data.AutoScalePolicies, &err)
 	gencmd.CreateAutoProvPolicys(c, data.AutoProvPolicies, &err)
+	gencmd.CreateGeoFencePolicys(c, data.GeoFencePolicies, &err)
 	gencmd.CreateApps(c, data.Apps, &err)
 	gencmd.CreateTrustPolicys(c, data.TrustPolicies, &err)
 	gencmd.CreateTrustPolicyExceptions(c, data.TrustPolicyExceptions, &err)
@@ -86,6 +87,7 @@ func runDelete(c *cli.Command, args []string) error {
 	gencmd.DeleteTrustPolicyExceptions(c, data.TrustPolicyExceptions, &err)
 	gencmd.DeleteNetworks(c, data.Networks, &err)
 	gencmd.DeleteApps(c, data.Apps, &err)
+	gencmd.DeleteGeoFencePolicys(c, data.GeoFencePolicies, &err)
 	gencmd.DeleteAutoProvPolicys(c, data.AutoProvPolicies, &err)
 	gencmd.DeleteAutoScalePolicys(c, data.AutoScalePolicies, &err)
 	gencmd.DeleteCloudlets(c, data.Cloudlets, &err)
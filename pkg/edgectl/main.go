@@ -93,6 +93,7 @@ func connect(cmd *cobra.Command, args []string) error {
 	gencmd.ClusterInstApiCmd = edgeproto.NewClusterInstApiClient(conn)
 	gencmd.CloudletApiCmd = edgeproto.NewCloudletApiClient(conn)
 	gencmd.VMPoolApiCmd = edgeproto.NewVMPoolApiClient(conn)
+	gencmd.ZoneApiCmd = edgeproto.NewZoneApiClient(conn)
 	gencmd.AppInstApiCmd = edgeproto.NewAppInstApiClient(conn)
 	gencmd.CloudletInfoApiCmd = edgeproto.NewCloudletInfoApiClient(conn)
 	gencmd.AppInstInfoApiCmd = edgeproto.NewAppInstInfoApiClient(conn)
@@ -116,8 +117,13 @@ func connect(cmd *cobra.Command, args []string) error {
 	gencmd.AppInstLatencyApiCmd = edgeproto.NewAppInstLatencyApiClient(conn)
 	gencmd.GPUDriverApiCmd = edgeproto.NewGPUDriverApiClient(conn)
 	gencmd.AlertPolicyApiCmd = edgeproto.NewAlertPolicyApiClient(conn)
+	gencmd.AlertReceiverApiCmd = edgeproto.NewAlertReceiverApiClient(conn)
+	gencmd.AlertSilenceApiCmd = edgeproto.NewAlertSilenceApiClient(conn)
+	gencmd.GeoFencePolicyApiCmd = edgeproto.NewGeoFencePolicyApiClient(conn)
+	gencmd.AppRolloutApiCmd = edgeproto.NewAppRolloutApiClient(conn)
 	gencmd.RateLimitSettingsApiCmd = edgeproto.NewRateLimitSettingsApiClient(conn)
 	gencmd.NetworkApiCmd = edgeproto.NewNetworkApiClient(conn)
+	gencmd.DataSnapshotApiCmd = edgeproto.NewDataSnapshotApiClient(conn)
 	return nil
 }
 
@@ -151,6 +157,7 @@ func main() {
 	controllerCmd.AddCommand(gencmd.ClusterInstApiCmds...)
 	controllerCmd.AddCommand(gencmd.CloudletApiCmds...)
 	controllerCmd.AddCommand(gencmd.VMPoolApiCmds...)
+	controllerCmd.AddCommand(gencmd.ZoneApiCmds...)
 	controllerCmd.AddCommand(gencmd.AppInstApiCmds...)
 	controllerCmd.AddCommand(gencmd.DebugApiCmds...)
 	controllerCmd.AddCommand(gencmd.CloudletInfoApiCmds...)
@@ -170,9 +177,17 @@ func main() {
 	controllerCmd.AddCommand(gencmd.AppInstLatencyApiCmds...)
 	controllerCmd.AddCommand(gencmd.GPUDriverApiCmds...)
 	controllerCmd.AddCommand(gencmd.AlertPolicyApiCmds...)
+	controllerCmd.AddCommand(gencmd.AlertReceiverApiCmds...)
+	controllerCmd.AddCommand(gencmd.AlertSilenceApiCmds...)
+	controllerCmd.AddCommand(gencmd.GeoFencePolicyApiCmds...)
+	controllerCmd.AddCommand(gencmd.AppRolloutApiCmds...)
 	controllerCmd.AddCommand(gencmd.RateLimitSettingsApiCmds...)
+	controllerCmd.AddCommand(gencmd.DataSnapshotApiCmds...)
 	controllerCmd.AddCommand(createCmd.GenCmd())
 	controllerCmd.AddCommand(deleteCmd.GenCmd())
+	controllerCmd.AddCommand(applyCmd.GenCmd())
+	controllerCmd.AddCommand(diffCmd.GenCmd())
+	controllerCmd.AddCommand(getRestoreCmds()...)
 	gencmd.RunCommandCmd.Run = runRunCommand
 	gencmd.RunCommandCmd.AddFlagsFunc = cli.AddTtyFlags
 	gencmd.ShowLogsCmd.Run = runShowLogs
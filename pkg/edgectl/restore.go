@@ -0,0 +1,258 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cli"
+	"github.com/edgexr/edge-cloud-platform/pkg/gencmd"
+	"github.com/edgexr/edge-cloud-platform/pkg/objstore"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Restore commands update an object back to a past revision from
+// its history. The fields that differ from the current revision are
+// updated via the object's Update API, so the same checks apply as
+// for any other update. Fields that cannot be updated are skipped.
+
+var restoreRevision int64
+
+func addRestoreFlags(flagSet *pflag.FlagSet) {
+	flagSet.Int64Var(&restoreRevision, "revision", 0, "revision from the object history to restore")
+}
+
+// restoreOps are the per-type functions used to restore an object.
+type restoreOps[T any, PT restoreObjPtr[T]] struct {
+	objType      string
+	fieldNames   map[string]string
+	updateFields *edgeproto.FieldMap
+	setFields    func(obj PT, fields []string)
+	// history gets the revisions of the object, keyed by revision
+	history func(ctx context.Context, in PT) (map[int64]PT, error)
+	update  func(c *cli.Command, obj PT) error
+}
+
+type restoreObjPtr[T any] interface {
+	*T
+	GetObjKey() objstore.ObjKey
+	GetDiffFields(o *T) *edgeproto.FieldMap
+	IsKeyField(s string) bool
+}
+
+func newRestoreCmd[T any, PT restoreObjPtr[T]](showHistory *cli.Command, ops *restoreOps[T, PT]) *cli.Command {
+	return &cli.Command{
+		Use:          "Restore" + ops.objType,
+		Short:        "Update the " + ops.objType + " back to a past revision from ShowHistory",
+		RequiredArgs: showHistory.RequiredArgs,
+		OptionalArgs: showHistory.OptionalArgs,
+		AliasArgs:    showHistory.AliasArgs,
+		SpecialArgs:  showHistory.SpecialArgs,
+		Comments:     showHistory.Comments,
+		ReqData:      PT(new(T)),
+		ReplyData:    &edgeproto.Result{},
+		Run: func(c *cli.Command, args []string) error {
+			return runRestore(c, args, ops)
+		},
+		AddFlagsFunc: addRestoreFlags,
+	}
+}
+
+func runRestore[T any, PT restoreObjPtr[T]](c *cli.Command, args []string, ops *restoreOps[T, PT]) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	in := c.ReqData.(PT)
+	if _, err := c.ParseInput(args); err != nil {
+		return err
+	}
+	if restoreRevision == 0 {
+		return errors.New("revision to restore must be specified")
+	}
+	key := in.GetObjKey().GetKeyString()
+	revs, err := ops.history(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	prev, found := revs[restoreRevision]
+	if !found {
+		return fmt.Errorf("revision %d not found in the history of %s %s", restoreRevision, ops.objType, key)
+	}
+	// the latest revision is the current object
+	var curRev int64
+	for rev := range revs {
+		if rev > curRev {
+			curRev = rev
+		}
+	}
+	if curRev == restoreRevision {
+		return fmt.Errorf("revision %d is the current revision of %s %s", restoreRevision, ops.objType, key)
+	}
+	fields, skipped := getRestoreFields(prev, revs[curRev], ops.updateFields)
+	for _, field := range skipped {
+		fmt.Printf("Skipping field %s which cannot be updated\n", ops.fieldNames[field])
+	}
+	if len(fields) == 0 {
+		return fmt.Errorf("no fields to restore for %s %s", ops.objType, key)
+	}
+	ops.setFields(prev, fields)
+	return ops.update(c, prev)
+}
+
+// getRestoreFields gets the fields that differ between the past and
+// current revisions, split into the fields that can be updated
+// and the fields that cannot be.
+func getRestoreFields[T any, PT restoreObjPtr[T]](prev, cur PT, updateFields *edgeproto.FieldMap) ([]string, []string) {
+	fields := []string{}
+	skipped := []string{}
+	for _, field := range prev.GetDiffFields(cur).Fields() {
+		if prev.IsKeyField(field) {
+			continue
+		}
+		if updateFields.Has(field) {
+			fields = append(fields, field)
+		} else if !strings.Contains(field, ".") {
+			// only report top-level fields
+			skipped = append(skipped, field)
+		}
+	}
+	sort.Strings(fields)
+	sort.Strings(skipped)
+	return fields, skipped
+}
+
+func historyRevs[H any, T any](hist []H, get func(h *H) (int64, *T)) map[int64]*T {
+	revs := map[int64]*T{}
+	for ii := range hist {
+		rev, obj := get(&hist[ii])
+		revs[rev] = obj
+	}
+	return revs
+}
+
+func getRestoreCmds() []*cobra.Command {
+	return []*cobra.Command{
+		newRestoreCmd(gencmd.ShowFlavorHistoryCmd, &restoreOps[edgeproto.Flavor, *edgeproto.Flavor]{
+			objType:      "Flavor",
+			fieldNames:   edgeproto.FlavorAllFieldsStringMap,
+			updateFields: edgeproto.UpdateFlavorFieldsMap,
+			setFields:    func(obj *edgeproto.Flavor, fields []string) { obj.Fields = fields },
+			history: func(ctx context.Context, in *edgeproto.Flavor) (map[int64]*edgeproto.Flavor, error) {
+				hist, err := recvObjs[edgeproto.FlavorHistory](gencmd.FlavorApiCmd.ShowFlavorHistory(ctx, in))
+				return historyRevs(hist, func(h *edgeproto.FlavorHistory) (int64, *edgeproto.Flavor) {
+					return h.Revision, &h.Obj
+				}), err
+			},
+			update: gencmd.UpdateFlavor,
+		}).GenCmd(),
+		newRestoreCmd(gencmd.ShowZoneHistoryCmd, &restoreOps[edgeproto.Zone, *edgeproto.Zone]{
+			objType:      "Zone",
+			fieldNames:   edgeproto.ZoneAllFieldsStringMap,
+			updateFields: edgeproto.UpdateZoneFieldsMap,
+			setFields:    func(obj *edgeproto.Zone, fields []string) { obj.Fields = fields },
+			history: func(ctx context.Context, in *edgeproto.Zone) (map[int64]*edgeproto.Zone, error) {
+				hist, err := recvObjs[edgeproto.ZoneHistory](gencmd.ZoneApiCmd.ShowZoneHistory(ctx, in))
+				return historyRevs(hist, func(h *edgeproto.ZoneHistory) (int64, *edgeproto.Zone) {
+					return h.Revision, &h.Obj
+				}), err
+			},
+			update: gencmd.UpdateZone,
+		}).GenCmd(),
+		newRestoreCmd(gencmd.ShowAutoScalePolicyHistoryCmd, &restoreOps[edgeproto.AutoScalePolicy, *edgeproto.AutoScalePolicy]{
+			objType:      "AutoScalePolicy",
+			fieldNames:   edgeproto.AutoScalePolicyAllFieldsStringMap,
+			updateFields: edgeproto.UpdateAutoScalePolicyFieldsMap,
+			setFields:    func(obj *edgeproto.AutoScalePolicy, fields []string) { obj.Fields = fields },
+			history: func(ctx context.Context, in *edgeproto.AutoScalePolicy) (map[int64]*edgeproto.AutoScalePolicy, error) {
+				hist, err := recvObjs[edgeproto.AutoScalePolicyHistory](gencmd.AutoScalePolicyApiCmd.ShowAutoScalePolicyHistory(ctx, in))
+				return historyRevs(hist, func(h *edgeproto.AutoScalePolicyHistory) (int64, *edgeproto.AutoScalePolicy) {
+					return h.Revision, &h.Obj
+				}), err
+			},
+			update: gencmd.UpdateAutoScalePolicy,
+		}).GenCmd(),
+		newRestoreCmd(gencmd.ShowAutoProvPolicyHistoryCmd, &restoreOps[edgeproto.AutoProvPolicy, *edgeproto.AutoProvPolicy]{
+			objType:      "AutoProvPolicy",
+			fieldNames:   edgeproto.AutoProvPolicyAllFieldsStringMap,
+			updateFields: edgeproto.UpdateAutoProvPolicyFieldsMap,
+			setFields:    func(obj *edgeproto.AutoProvPolicy, fields []string) { obj.Fields = fields },
+			history: func(ctx context.Context, in *edgeproto.AutoProvPolicy) (map[int64]*edgeproto.AutoProvPolicy, error) {
+				hist, err := recvObjs[edgeproto.AutoProvPolicyHistory](gencmd.AutoProvPolicyApiCmd.ShowAutoProvPolicyHistory(ctx, in))
+				return historyRevs(hist, func(h *edgeproto.AutoProvPolicyHistory) (int64, *edgeproto.AutoProvPolicy) {
+					return h.Revision, &h.Obj
+				}), err
+			},
+			update: gencmd.UpdateAutoProvPolicy,
+		}).GenCmd(),
+		newRestoreCmd(gencmd.ShowGeoFencePolicyHistoryCmd, &restoreOps[edgeproto.GeoFencePolicy, *edgeproto.GeoFencePolicy]{
+			objType:      "GeoFencePolicy",
+			fieldNames:   edgeproto.GeoFencePolicyAllFieldsStringMap,
+			updateFields: edgeproto.UpdateGeoFencePolicyFieldsMap,
+			setFields:    func(obj *edgeproto.GeoFencePolicy, fields []string) { obj.Fields = fields },
+			history: func(ctx context.Context, in *edgeproto.GeoFencePolicy) (map[int64]*edgeproto.GeoFencePolicy, error) {
+				hist, err := recvObjs[edgeproto.GeoFencePolicyHistory](gencmd.GeoFencePolicyApiCmd.ShowGeoFencePolicyHistory(ctx, in))
+				return historyRevs(hist, func(h *edgeproto.GeoFencePolicyHistory) (int64, *edgeproto.GeoFencePolicy) {
+					return h.Revision, &h.Obj
+				}), err
+			},
+			update: gencmd.UpdateGeoFencePolicy,
+		}).GenCmd(),
+		newRestoreCmd(gencmd.ShowTrustPolicyHistoryCmd, &restoreOps[edgeproto.TrustPolicy, *edgeproto.TrustPolicy]{
+			objType:      "TrustPolicy",
+			fieldNames:   edgeproto.TrustPolicyAllFieldsStringMap,
+			updateFields: edgeproto.UpdateTrustPolicyFieldsMap,
+			setFields:    func(obj *edgeproto.TrustPolicy, fields []string) { obj.Fields = fields },
+			history: func(ctx context.Context, in *edgeproto.TrustPolicy) (map[int64]*edgeproto.TrustPolicy, error) {
+				hist, err := recvObjs[edgeproto.TrustPolicyHistory](gencmd.TrustPolicyApiCmd.ShowTrustPolicyHistory(ctx, in))
+				return historyRevs(hist, func(h *edgeproto.TrustPolicyHistory) (int64, *edgeproto.TrustPolicy) {
+					return h.Revision, &h.Obj
+				}), err
+			},
+			update: gencmd.UpdateTrustPolicy,
+		}).GenCmd(),
+		newRestoreCmd(gencmd.ShowAlertPolicyHistoryCmd, &restoreOps[edgeproto.AlertPolicy, *edgeproto.AlertPolicy]{
+			objType:      "AlertPolicy",
+			fieldNames:   edgeproto.AlertPolicyAllFieldsStringMap,
+			updateFields: edgeproto.UpdateAlertPolicyFieldsMap,
+			setFields:    func(obj *edgeproto.AlertPolicy, fields []string) { obj.Fields = fields },
+			history: func(ctx context.Context, in *edgeproto.AlertPolicy) (map[int64]*edgeproto.AlertPolicy, error) {
+				hist, err := recvObjs[edgeproto.AlertPolicyHistory](gencmd.AlertPolicyApiCmd.ShowAlertPolicyHistory(ctx, in))
+				return historyRevs(hist, func(h *edgeproto.AlertPolicyHistory) (int64, *edgeproto.AlertPolicy) {
+					return h.Revision, &h.Obj
+				}), err
+			},
+			update: gencmd.UpdateAlertPolicy,
+		}).GenCmd(),
+		newRestoreCmd(gencmd.ShowAppHistoryCmd, &restoreOps[edgeproto.App, *edgeproto.App]{
+			objType:      "App",
+			fieldNames:   edgeproto.AppAllFieldsStringMap,
+			updateFields: edgeproto.UpdateAppFieldsMap,
+			setFields:    func(obj *edgeproto.App, fields []string) { obj.Fields = fields },
+			history: func(ctx context.Context, in *edgeproto.App) (map[int64]*edgeproto.App, error) {
+				hist, err := recvObjs[edgeproto.AppHistory](gencmd.AppApiCmd.ShowAppHistory(ctx, in))
+				return historyRevs(hist, func(h *edgeproto.AppHistory) (int64, *edgeproto.App) {
+					return h.Revision, &h.Obj
+				}), err
+			},
+			update: gencmd.UpdateApp,
+		}).GenCmd(),
+	}
+}
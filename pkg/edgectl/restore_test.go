@@ -0,0 +1,52 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRestoreFields(t *testing.T) {
+	prev := &edgeproto.AutoProvPolicy{
+		Key: edgeproto.PolicyKey{
+			Organization: "dev",
+			Name:         "policy",
+		},
+		MinActiveInstances: 2,
+		MaxInstances:       4,
+		Zones: []*edgeproto.ZoneKey{{
+			Organization: "op",
+			Name:         "zone1",
+		}},
+	}
+	cur := prev.Clone()
+	cur.MinActiveInstances = 1
+	cur.Zones = nil
+	cur.DeletePrepare = true
+
+	fields, skipped := getRestoreFields(prev, cur, edgeproto.UpdateAutoProvPolicyFieldsMap)
+	require.Contains(t, fields, edgeproto.AutoProvPolicyFieldMinActiveInstances)
+	require.Contains(t, fields, edgeproto.AutoProvPolicyFieldZones)
+	require.NotContains(t, fields, edgeproto.AutoProvPolicyFieldMaxInstances)
+	require.Equal(t, []string{edgeproto.AutoProvPolicyFieldDeletePrepare}, skipped)
+
+	// no differences, nothing to restore
+	fields, skipped = getRestoreFields(prev, prev.Clone(), edgeproto.UpdateAutoProvPolicyFieldsMap)
+	require.Empty(t, fields)
+	require.Empty(t, skipped)
+}
@@ -125,8 +125,85 @@ func ShowAlerts(c *cli.Command, data []edgeproto.Alert, err *error) {
 	}
 }
 
+var ShowAlertHistoryCmd = &cli.Command{
+	Use:          "ShowAlertHistory",
+	OptionalArgs: strings.Join(append(AlertHistoryRequestRequiredArgs, AlertHistoryRequestOptionalArgs...), " "),
+	AliasArgs:    strings.Join(AlertHistoryRequestAliasArgs, " "),
+	SpecialArgs:  &AlertHistoryRequestSpecialArgs,
+	Comments:     AlertHistoryRequestComments,
+	ReqData:      &edgeproto.AlertHistoryRequest{},
+	ReplyData:    &edgeproto.AlertHistory{},
+	Run:          runShowAlertHistory,
+}
+
+func runShowAlertHistory(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.AlertHistoryRequest)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return ShowAlertHistory(c, obj)
+}
+
+func ShowAlertHistory(c *cli.Command, in *edgeproto.AlertHistoryRequest) error {
+	if AlertApiCmd == nil {
+		return fmt.Errorf("AlertApi client not initialized")
+	}
+	ctx := context.Background()
+	stream, err := AlertApiCmd.ShowAlertHistory(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("ShowAlertHistory failed: %s", errstr)
+	}
+
+	objs := make([]*edgeproto.AlertHistory, 0)
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errstr := err.Error()
+			st, ok := status.FromError(err)
+			if ok {
+				errstr = st.Message()
+			}
+			return fmt.Errorf("ShowAlertHistory recv failed: %s", errstr)
+		}
+		objs = append(objs, obj)
+	}
+	if len(objs) == 0 {
+		return nil
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), objs, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func ShowAlertHistorys(c *cli.Command, data []edgeproto.AlertHistoryRequest, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("ShowAlertHistory %v\n", data[ii])
+		myerr := ShowAlertHistory(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
 var AlertApiCmds = []*cobra.Command{
 	ShowAlertCmd.GenCmd(),
+	ShowAlertHistoryCmd.GenCmd(),
 }
 
 var AlertRequiredArgs = []string{}
@@ -153,3 +230,41 @@ var AlertSpecialArgs = map[string]string{
 	"annotations": "StringToString",
 	"labels":      "StringToString",
 }
+var AlertHistoryRequestRequiredArgs = []string{}
+var AlertHistoryRequestOptionalArgs = []string{
+	"organization",
+	"starttime",
+	"endtime",
+	"labels",
+}
+var AlertHistoryRequestAliasArgs = []string{}
+var AlertHistoryRequestComments = map[string]string{
+	"organization": "Only include alerts for this organizations Apps, Clusters, or Cloudlets",
+	"starttime":    "Start of the time range, defaults to 48 hours before the end time",
+	"endtime":      "End of the time range, defaults to now",
+	"labels":       "Only include alerts with all of these labels",
+}
+var AlertHistoryRequestSpecialArgs = map[string]string{
+	"labels": "StringToString",
+}
+var AlertHistoryRequiredArgs = []string{}
+var AlertHistoryOptionalArgs = []string{
+	"name",
+	"firedcount",
+	"resolvedcount",
+	"totalduration",
+	"maxduration",
+	"lastfiredat",
+	"lastresolvedat",
+}
+var AlertHistoryAliasArgs = []string{}
+var AlertHistoryComments = map[string]string{
+	"name":           "Alert name",
+	"firedcount":     "Number of times the alert fired",
+	"resolvedcount":  "Number of times the alert resolved",
+	"totalduration":  "Total time the resolved alerts were firing",
+	"maxduration":    "Longest time a resolved alert was firing",
+	"lastfiredat":    "Last time the alert fired",
+	"lastresolvedat": "Last time the alert resolved",
+}
+var AlertHistorySpecialArgs = map[string]string{}
@@ -38,6 +38,19 @@ func AlertPolicyHideTags(in *edgeproto.AlertPolicy) {
 	}
 }
 
+func AlertPolicyHistoryHideTags(in *edgeproto.AlertPolicyHistory) {
+	if cli.HideTags == "" {
+		return
+	}
+	tags := make(map[string]struct{})
+	for _, tag := range strings.Split(cli.HideTags, ",") {
+		tags[tag] = struct{}{}
+	}
+	if _, found := tags["nocmp"]; found {
+		in.Obj.DeletePrepare = false
+	}
+}
+
 var AlertPolicyApiCmd edgeproto.AlertPolicyApiClient
 
 var CreateAlertPolicyCmd = &cli.Command{
@@ -289,11 +302,90 @@ func ShowAlertPolicys(c *cli.Command, data []edgeproto.AlertPolicy, err *error)
 	}
 }
 
+var ShowAlertPolicyHistoryCmd = &cli.Command{
+	Use:          "ShowAlertPolicyHistory",
+	RequiredArgs: strings.Join(AlertPolicyRequiredArgs, " "),
+	OptionalArgs: strings.Join(AlertPolicyOptionalArgs, " "),
+	AliasArgs:    strings.Join(AlertPolicyAliasArgs, " "),
+	SpecialArgs:  &AlertPolicySpecialArgs,
+	Comments:     AlertPolicyComments,
+	ReqData:      &edgeproto.AlertPolicy{},
+	ReplyData:    &edgeproto.AlertPolicyHistory{},
+	Run:          runShowAlertPolicyHistory,
+}
+
+func runShowAlertPolicyHistory(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.AlertPolicy)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return ShowAlertPolicyHistory(c, obj)
+}
+
+func ShowAlertPolicyHistory(c *cli.Command, in *edgeproto.AlertPolicy) error {
+	if AlertPolicyApiCmd == nil {
+		return fmt.Errorf("AlertPolicyApi client not initialized")
+	}
+	ctx := context.Background()
+	stream, err := AlertPolicyApiCmd.ShowAlertPolicyHistory(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("ShowAlertPolicyHistory failed: %s", errstr)
+	}
+
+	objs := make([]*edgeproto.AlertPolicyHistory, 0)
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errstr := err.Error()
+			st, ok := status.FromError(err)
+			if ok {
+				errstr = st.Message()
+			}
+			return fmt.Errorf("ShowAlertPolicyHistory recv failed: %s", errstr)
+		}
+		AlertPolicyHistoryHideTags(obj)
+		objs = append(objs, obj)
+	}
+	if len(objs) == 0 {
+		return nil
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), objs, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func ShowAlertPolicyHistorys(c *cli.Command, data []edgeproto.AlertPolicy, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("ShowAlertPolicyHistory %v\n", data[ii])
+		myerr := ShowAlertPolicyHistory(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
 var AlertPolicyApiCmds = []*cobra.Command{
 	CreateAlertPolicyCmd.GenCmd(),
 	DeleteAlertPolicyCmd.GenCmd(),
 	UpdateAlertPolicyCmd.GenCmd(),
 	ShowAlertPolicyCmd.GenCmd(),
+	ShowAlertPolicyHistoryCmd.GenCmd(),
 }
 
 var AlertPolicyKeyRequiredArgs = []string{}
@@ -321,6 +413,8 @@ var AlertPolicyOptionalArgs = []string{
 	"labels",
 	"annotations",
 	"description",
+	"customexpression",
+	"metricssource",
 }
 var AlertPolicyAliasArgs = []string{
 	"alertorg=key.organization",
@@ -330,6 +424,8 @@ var AlertPolicyAliasArgs = []string{
 	"diskutilization=diskutilizationlimit",
 	"activeconnections=activeconnlimit",
 	"triggertime=triggertime",
+	"customexpression=customexpression",
+	"metricssource=custommetricssource",
 }
 var AlertPolicyComments = map[string]string{
 	"alertorg":          "Name of the organization for the app that this alert can be applied to",
@@ -344,12 +440,56 @@ var AlertPolicyComments = map[string]string{
 	"annotations":       "Additional Annotations for extra information about the alert, specify annotations:empty=true to clear",
 	"description":       "Description of the alert policy",
 	"deleteprepare":     "Preparing to be deleted",
+	"customexpression":  "Custom Prometheus (PromQL) alert expression, for example rate(http_errors_total[5m]) > 5. Label matchers that scope every metric to the AppInst are added automatically",
+	"metricssource":     "Metrics the custom expression is evaluated against, one of App (metrics exposed by the application) or LoadBalancer (load balancer metrics), one of App, LoadBalancer",
 }
 var AlertPolicySpecialArgs = map[string]string{
 	"annotations": "StringToString",
 	"fields":      "StringArray",
 	"labels":      "StringToString",
 }
+var AlertPolicyHistoryRequiredArgs = []string{}
+var AlertPolicyHistoryOptionalArgs = []string{
+	"revision",
+	"obj.fields",
+	"obj.key.organization",
+	"obj.key.name",
+	"obj.cpuutilizationlimit",
+	"obj.memutilizationlimit",
+	"obj.diskutilizationlimit",
+	"obj.activeconnlimit",
+	"obj.severity",
+	"obj.triggertime",
+	"obj.labels",
+	"obj.annotations",
+	"obj.description",
+	"obj.deleteprepare",
+	"obj.customexpression",
+	"obj.custommetricssource",
+}
+var AlertPolicyHistoryAliasArgs = []string{}
+var AlertPolicyHistoryComments = map[string]string{
+	"revision":                 "Database revision of the change",
+	"obj.key.organization":     "Name of the organization for the app that this alert can be applied to",
+	"obj.key.name":             "Alert Policy name",
+	"obj.cpuutilizationlimit":  "Container or pod CPU utilization rate(percentage) across all nodes. Valid values 1-100",
+	"obj.memutilizationlimit":  "Container or pod memory utilization rate(percentage) across all nodes. Valid values 1-100",
+	"obj.diskutilizationlimit": "Container or pod disk utilization rate(percentage) across all nodes. Valid values 1-100",
+	"obj.activeconnlimit":      "Active Connections alert threshold. Valid values 1-4294967295",
+	"obj.severity":             "Alert severity level - one of info, warning, error",
+	"obj.triggertime":          "Duration for which alert interval is active (max 72 hours)",
+	"obj.labels":               "Additional Labels",
+	"obj.annotations":          "Additional Annotations for extra information about the alert",
+	"obj.description":          "Description of the alert policy",
+	"obj.deleteprepare":        "Preparing to be deleted",
+	"obj.customexpression":     "Custom Prometheus (PromQL) alert expression, for example rate(http_errors_total[5m]) > 5. Label matchers that scope every metric to the AppInst are added automatically",
+	"obj.custommetricssource":  "Metrics the custom expression is evaluated against, one of App (metrics exposed by the application) or LoadBalancer (load balancer metrics), one of App, LoadBalancer",
+}
+var AlertPolicyHistorySpecialArgs = map[string]string{
+	"obj.annotations": "StringToString",
+	"obj.fields":      "StringArray",
+	"obj.labels":      "StringToString",
+}
 var CreateAlertPolicyRequiredArgs = []string{
 	"alertorg",
 	"name",
@@ -364,4 +504,6 @@ var CreateAlertPolicyOptionalArgs = []string{
 	"labels",
 	"annotations",
 	"description",
+	"customexpression",
+	"metricssource",
 }
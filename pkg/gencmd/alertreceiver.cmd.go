@@ -0,0 +1,338 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: alertreceiver.proto
+
+package gencmd
+
+import (
+	"context"
+	fmt "fmt"
+	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cli"
+	_ "github.com/edgexr/edge-cloud-platform/tools/protogen"
+	_ "github.com/gogo/googleapis/google/api"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+	"io"
+	math "math"
+	"strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// Auto-generated code: DO NOT EDIT
+var AlertReceiverApiCmd edgeproto.AlertReceiverApiClient
+
+var CreateAlertReceiverCmd = &cli.Command{
+	Use:          "CreateAlertReceiver",
+	RequiredArgs: strings.Join(CreateAlertReceiverRequiredArgs, " "),
+	OptionalArgs: strings.Join(CreateAlertReceiverOptionalArgs, " "),
+	AliasArgs:    strings.Join(AlertReceiverAliasArgs, " "),
+	SpecialArgs:  &AlertReceiverSpecialArgs,
+	Comments:     AlertReceiverComments,
+	ReqData:      &edgeproto.AlertReceiver{},
+	ReplyData:    &edgeproto.Result{},
+	Run:          runCreateAlertReceiver,
+}
+
+func runCreateAlertReceiver(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.AlertReceiver)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return CreateAlertReceiver(c, obj)
+}
+
+func CreateAlertReceiver(c *cli.Command, in *edgeproto.AlertReceiver) error {
+	if AlertReceiverApiCmd == nil {
+		return fmt.Errorf("AlertReceiverApi client not initialized")
+	}
+	ctx := context.Background()
+	obj, err := AlertReceiverApiCmd.CreateAlertReceiver(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("CreateAlertReceiver failed: %s", errstr)
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func CreateAlertReceivers(c *cli.Command, data []edgeproto.AlertReceiver, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("CreateAlertReceiver %v\n", data[ii])
+		myerr := CreateAlertReceiver(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var DeleteAlertReceiverCmd = &cli.Command{
+	Use:          "DeleteAlertReceiver",
+	RequiredArgs: strings.Join(AlertReceiverRequiredArgs, " "),
+	OptionalArgs: strings.Join(AlertReceiverOptionalArgs, " "),
+	AliasArgs:    strings.Join(AlertReceiverAliasArgs, " "),
+	SpecialArgs:  &AlertReceiverSpecialArgs,
+	Comments:     AlertReceiverComments,
+	ReqData:      &edgeproto.AlertReceiver{},
+	ReplyData:    &edgeproto.Result{},
+	Run:          runDeleteAlertReceiver,
+}
+
+func runDeleteAlertReceiver(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.AlertReceiver)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return DeleteAlertReceiver(c, obj)
+}
+
+func DeleteAlertReceiver(c *cli.Command, in *edgeproto.AlertReceiver) error {
+	if AlertReceiverApiCmd == nil {
+		return fmt.Errorf("AlertReceiverApi client not initialized")
+	}
+	ctx := context.Background()
+	obj, err := AlertReceiverApiCmd.DeleteAlertReceiver(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("DeleteAlertReceiver failed: %s", errstr)
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func DeleteAlertReceivers(c *cli.Command, data []edgeproto.AlertReceiver, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("DeleteAlertReceiver %v\n", data[ii])
+		myerr := DeleteAlertReceiver(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var UpdateAlertReceiverCmd = &cli.Command{
+	Use:          "UpdateAlertReceiver",
+	RequiredArgs: strings.Join(AlertReceiverRequiredArgs, " "),
+	OptionalArgs: strings.Join(AlertReceiverOptionalArgs, " "),
+	AliasArgs:    strings.Join(AlertReceiverAliasArgs, " "),
+	SpecialArgs:  &AlertReceiverSpecialArgs,
+	Comments:     AlertReceiverComments,
+	ReqData:      &edgeproto.AlertReceiver{},
+	ReplyData:    &edgeproto.Result{},
+	Run:          runUpdateAlertReceiver,
+}
+
+func runUpdateAlertReceiver(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.AlertReceiver)
+	jsonMap, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	obj.Fields = cli.GetSpecifiedFields(jsonMap, c.ReqData)
+	return UpdateAlertReceiver(c, obj)
+}
+
+func UpdateAlertReceiver(c *cli.Command, in *edgeproto.AlertReceiver) error {
+	if AlertReceiverApiCmd == nil {
+		return fmt.Errorf("AlertReceiverApi client not initialized")
+	}
+	ctx := context.Background()
+	obj, err := AlertReceiverApiCmd.UpdateAlertReceiver(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("UpdateAlertReceiver failed: %s", errstr)
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func UpdateAlertReceivers(c *cli.Command, data []edgeproto.AlertReceiver, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("UpdateAlertReceiver %v\n", data[ii])
+		myerr := UpdateAlertReceiver(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var ShowAlertReceiverCmd = &cli.Command{
+	Use:          "ShowAlertReceiver",
+	OptionalArgs: strings.Join(append(AlertReceiverRequiredArgs, AlertReceiverOptionalArgs...), " "),
+	AliasArgs:    strings.Join(AlertReceiverAliasArgs, " "),
+	SpecialArgs:  &AlertReceiverSpecialArgs,
+	Comments:     AlertReceiverComments,
+	ReqData:      &edgeproto.AlertReceiver{},
+	ReplyData:    &edgeproto.AlertReceiver{},
+	Run:          runShowAlertReceiver,
+}
+
+func runShowAlertReceiver(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.AlertReceiver)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return ShowAlertReceiver(c, obj)
+}
+
+func ShowAlertReceiver(c *cli.Command, in *edgeproto.AlertReceiver) error {
+	if AlertReceiverApiCmd == nil {
+		return fmt.Errorf("AlertReceiverApi client not initialized")
+	}
+	ctx := context.Background()
+	stream, err := AlertReceiverApiCmd.ShowAlertReceiver(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("ShowAlertReceiver failed: %s", errstr)
+	}
+
+	objs := make([]*edgeproto.AlertReceiver, 0)
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errstr := err.Error()
+			st, ok := status.FromError(err)
+			if ok {
+				errstr = st.Message()
+			}
+			return fmt.Errorf("ShowAlertReceiver recv failed: %s", errstr)
+		}
+		objs = append(objs, obj)
+	}
+	if len(objs) == 0 {
+		return nil
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), objs, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func ShowAlertReceivers(c *cli.Command, data []edgeproto.AlertReceiver, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("ShowAlertReceiver %v\n", data[ii])
+		myerr := ShowAlertReceiver(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var AlertReceiverApiCmds = []*cobra.Command{
+	CreateAlertReceiverCmd.GenCmd(),
+	DeleteAlertReceiverCmd.GenCmd(),
+	UpdateAlertReceiverCmd.GenCmd(),
+	ShowAlertReceiverCmd.GenCmd(),
+}
+
+var AlertReceiverKeyRequiredArgs = []string{}
+var AlertReceiverKeyOptionalArgs = []string{
+	"organization",
+	"name",
+}
+var AlertReceiverKeyAliasArgs = []string{}
+var AlertReceiverKeyComments = map[string]string{
+	"organization": "Name of the organization that the receiver belongs to",
+	"name":         "Alert Receiver name",
+}
+var AlertReceiverKeySpecialArgs = map[string]string{}
+var AlertReceiverRequiredArgs = []string{
+	"alertreceiverorg",
+	"name",
+}
+var AlertReceiverOptionalArgs = []string{
+	"type",
+	"severity",
+	"labels",
+	"email",
+	"url",
+	"pagerdutyintegrationkey",
+}
+var AlertReceiverAliasArgs = []string{
+	"alertreceiverorg=key.organization",
+	"name=key.name",
+}
+var AlertReceiverComments = map[string]string{
+	"fields":                  "Fields are used for the Update API to specify which fields to apply",
+	"alertreceiverorg":        "Name of the organization that the receiver belongs to",
+	"name":                    "Alert Receiver name",
+	"type":                    "Receiver type, one of Webhook, Email, Slack, or PagerDuty, one of Unknown, Webhook, Email, Slack, PagerDuty",
+	"severity":                "Minimum alert severity to notify for, one of info, warning, error. Defaults to all severities",
+	"labels":                  "Only notify for alerts with all of these labels, specify labels:empty=true to clear",
+	"email":                   "Email address for Email receivers",
+	"url":                     "URL for Webhook and Slack receivers, optional events API URL for PagerDuty receivers",
+	"pagerdutyintegrationkey": "Integration (routing) key for PagerDuty receivers",
+}
+var AlertReceiverSpecialArgs = map[string]string{
+	"fields": "StringArray",
+	"labels": "StringToString",
+}
+var CreateAlertReceiverRequiredArgs = []string{
+	"alertreceiverorg",
+	"name",
+	"type",
+}
+var CreateAlertReceiverOptionalArgs = []string{
+	"severity",
+	"labels",
+	"email",
+	"url",
+	"pagerdutyintegrationkey",
+}
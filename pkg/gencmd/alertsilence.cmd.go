@@ -0,0 +1,343 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: alertsilence.proto
+
+package gencmd
+
+import (
+	"context"
+	fmt "fmt"
+	_ "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cli"
+	_ "github.com/edgexr/edge-cloud-platform/tools/protogen"
+	_ "github.com/gogo/googleapis/google/api"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+	"io"
+	math "math"
+	"strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// Auto-generated code: DO NOT EDIT
+var AlertSilenceApiCmd edgeproto.AlertSilenceApiClient
+
+var CreateAlertSilenceCmd = &cli.Command{
+	Use:          "CreateAlertSilence",
+	RequiredArgs: strings.Join(AlertSilenceRequiredArgs, " "),
+	OptionalArgs: strings.Join(AlertSilenceOptionalArgs, " "),
+	AliasArgs:    strings.Join(AlertSilenceAliasArgs, " "),
+	SpecialArgs:  &AlertSilenceSpecialArgs,
+	Comments:     AlertSilenceComments,
+	ReqData:      &edgeproto.AlertSilence{},
+	ReplyData:    &edgeproto.Result{},
+	Run:          runCreateAlertSilence,
+}
+
+func runCreateAlertSilence(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.AlertSilence)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return CreateAlertSilence(c, obj)
+}
+
+func CreateAlertSilence(c *cli.Command, in *edgeproto.AlertSilence) error {
+	if AlertSilenceApiCmd == nil {
+		return fmt.Errorf("AlertSilenceApi client not initialized")
+	}
+	ctx := context.Background()
+	obj, err := AlertSilenceApiCmd.CreateAlertSilence(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("CreateAlertSilence failed: %s", errstr)
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func CreateAlertSilences(c *cli.Command, data []edgeproto.AlertSilence, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("CreateAlertSilence %v\n", data[ii])
+		myerr := CreateAlertSilence(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var DeleteAlertSilenceCmd = &cli.Command{
+	Use:          "DeleteAlertSilence",
+	RequiredArgs: strings.Join(AlertSilenceRequiredArgs, " "),
+	OptionalArgs: strings.Join(AlertSilenceOptionalArgs, " "),
+	AliasArgs:    strings.Join(AlertSilenceAliasArgs, " "),
+	SpecialArgs:  &AlertSilenceSpecialArgs,
+	Comments:     AlertSilenceComments,
+	ReqData:      &edgeproto.AlertSilence{},
+	ReplyData:    &edgeproto.Result{},
+	Run:          runDeleteAlertSilence,
+}
+
+func runDeleteAlertSilence(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.AlertSilence)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return DeleteAlertSilence(c, obj)
+}
+
+func DeleteAlertSilence(c *cli.Command, in *edgeproto.AlertSilence) error {
+	if AlertSilenceApiCmd == nil {
+		return fmt.Errorf("AlertSilenceApi client not initialized")
+	}
+	ctx := context.Background()
+	obj, err := AlertSilenceApiCmd.DeleteAlertSilence(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("DeleteAlertSilence failed: %s", errstr)
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func DeleteAlertSilences(c *cli.Command, data []edgeproto.AlertSilence, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("DeleteAlertSilence %v\n", data[ii])
+		myerr := DeleteAlertSilence(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var UpdateAlertSilenceCmd = &cli.Command{
+	Use:          "UpdateAlertSilence",
+	RequiredArgs: strings.Join(AlertSilenceRequiredArgs, " "),
+	OptionalArgs: strings.Join(AlertSilenceOptionalArgs, " "),
+	AliasArgs:    strings.Join(AlertSilenceAliasArgs, " "),
+	SpecialArgs:  &AlertSilenceSpecialArgs,
+	Comments:     AlertSilenceComments,
+	ReqData:      &edgeproto.AlertSilence{},
+	ReplyData:    &edgeproto.Result{},
+	Run:          runUpdateAlertSilence,
+}
+
+func runUpdateAlertSilence(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.AlertSilence)
+	jsonMap, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	obj.Fields = cli.GetSpecifiedFields(jsonMap, c.ReqData)
+	return UpdateAlertSilence(c, obj)
+}
+
+func UpdateAlertSilence(c *cli.Command, in *edgeproto.AlertSilence) error {
+	if AlertSilenceApiCmd == nil {
+		return fmt.Errorf("AlertSilenceApi client not initialized")
+	}
+	ctx := context.Background()
+	obj, err := AlertSilenceApiCmd.UpdateAlertSilence(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("UpdateAlertSilence failed: %s", errstr)
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func UpdateAlertSilences(c *cli.Command, data []edgeproto.AlertSilence, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("UpdateAlertSilence %v\n", data[ii])
+		myerr := UpdateAlertSilence(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var ShowAlertSilenceCmd = &cli.Command{
+	Use:          "ShowAlertSilence",
+	OptionalArgs: strings.Join(append(AlertSilenceRequiredArgs, AlertSilenceOptionalArgs...), " "),
+	AliasArgs:    strings.Join(AlertSilenceAliasArgs, " "),
+	SpecialArgs:  &AlertSilenceSpecialArgs,
+	Comments:     AlertSilenceComments,
+	ReqData:      &edgeproto.AlertSilence{},
+	ReplyData:    &edgeproto.AlertSilence{},
+	Run:          runShowAlertSilence,
+}
+
+func runShowAlertSilence(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.AlertSilence)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return ShowAlertSilence(c, obj)
+}
+
+func ShowAlertSilence(c *cli.Command, in *edgeproto.AlertSilence) error {
+	if AlertSilenceApiCmd == nil {
+		return fmt.Errorf("AlertSilenceApi client not initialized")
+	}
+	ctx := context.Background()
+	stream, err := AlertSilenceApiCmd.ShowAlertSilence(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("ShowAlertSilence failed: %s", errstr)
+	}
+
+	objs := make([]*edgeproto.AlertSilence, 0)
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errstr := err.Error()
+			st, ok := status.FromError(err)
+			if ok {
+				errstr = st.Message()
+			}
+			return fmt.Errorf("ShowAlertSilence recv failed: %s", errstr)
+		}
+		objs = append(objs, obj)
+	}
+	if len(objs) == 0 {
+		return nil
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), objs, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func ShowAlertSilences(c *cli.Command, data []edgeproto.AlertSilence, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("ShowAlertSilence %v\n", data[ii])
+		myerr := ShowAlertSilence(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var AlertSilenceApiCmds = []*cobra.Command{
+	CreateAlertSilenceCmd.GenCmd(),
+	DeleteAlertSilenceCmd.GenCmd(),
+	UpdateAlertSilenceCmd.GenCmd(),
+	ShowAlertSilenceCmd.GenCmd(),
+}
+
+var AlertSilenceKeyRequiredArgs = []string{}
+var AlertSilenceKeyOptionalArgs = []string{
+	"organization",
+	"name",
+}
+var AlertSilenceKeyAliasArgs = []string{}
+var AlertSilenceKeyComments = map[string]string{
+	"organization": "Name of the organization that the silence belongs to",
+	"name":         "Alert Silence name",
+}
+var AlertSilenceKeySpecialArgs = map[string]string{}
+var AlertMatcherRequiredArgs = []string{}
+var AlertMatcherOptionalArgs = []string{
+	"name",
+	"value",
+	"regex",
+}
+var AlertMatcherAliasArgs = []string{}
+var AlertMatcherComments = map[string]string{
+	"name":  "Label name",
+	"value": "Label value, or a regular expression matching the whole label value if regex is set",
+	"regex": "Match the value as a regular expression",
+}
+var AlertMatcherSpecialArgs = map[string]string{}
+var AlertSilenceRequiredArgs = []string{
+	"alertsilenceorg",
+	"name",
+}
+var AlertSilenceOptionalArgs = []string{
+	"matchers:empty",
+	"matchers:#.name",
+	"matchers:#.value",
+	"matchers:#.regex",
+	"starttime",
+	"endtime",
+	"createdby",
+	"comment",
+}
+var AlertSilenceAliasArgs = []string{
+	"alertsilenceorg=key.organization",
+	"name=key.name",
+}
+var AlertSilenceComments = map[string]string{
+	"fields":           "Fields are used for the Update API to specify which fields to apply",
+	"alertsilenceorg":  "Name of the organization that the silence belongs to",
+	"name":             "Alert Silence name",
+	"matchers:empty":   "Alerts with labels that match all of the matchers are silenced, specify matchers:empty=true to clear",
+	"matchers:#.name":  "Label name",
+	"matchers:#.value": "Label value, or a regular expression matching the whole label value if regex is set",
+	"matchers:#.regex": "Match the value as a regular expression",
+	"starttime":        "Start of the silence, defaults to the time the silence is created",
+	"endtime":          "End of the silence",
+	"createdby":        "Name of the user that created the silence",
+	"comment":          "Reason for the silence",
+}
+var AlertSilenceSpecialArgs = map[string]string{
+	"fields": "StringArray",
+}
@@ -227,6 +227,15 @@ func AllDataHideTags(in *edgeproto.AllData) {
 			}
 		}
 	}
+	for i0 := 0; i0 < len(in.GeoFencePolicies); i0++ {
+		for i1 := 0; i1 < len(in.GeoFencePolicies[i0].Fences); i1++ {
+			for i2 := 0; i2 < len(in.GeoFencePolicies[i0].Fences[i1].Polygon); i2++ {
+			}
+		}
+		if _, found := tags["nocmp"]; found {
+			in.GeoFencePolicies[i0].DeletePrepare = false
+		}
+	}
 	for i0 := 0; i0 < len(in.Apps); i0++ {
 		if _, found := tags["nocmp"]; found {
 			in.Apps[i0].AuthPublicKey = ""
@@ -393,6 +402,13 @@ var AllDataOptionalArgs = []string{
 	"settings.platformhainstancepollinterval",
 	"settings.platformhainstanceactiveexpiretime",
 	"settings.ccrmapitimeout",
+	"settings.findcloudletscoreweights.distance",
+	"settings.findcloudletscoreweights.latency",
+	"settings.findcloudletscoreweights.resourceusage",
+	"settings.findcloudletscoreweights.health",
+	"settings.findcloudletdistribution",
+	"settings.findcloudletstickysessions",
+	"settings.maxobjecthistoryrevisions",
 	"operatorcodes:#.code",
 	"operatorcodes:#.organization",
 	"restagtables:#.fields",
@@ -613,6 +629,12 @@ var AllDataOptionalArgs = []string{
 	"cloudlets:#.objid",
 	"cloudlets:#.annotations",
 	"cloudlets:#.dbmodelid",
+	"cloudlets:#.dryrun",
+	"cloudlets:#.maintenancewindow.starttime",
+	"cloudlets:#.maintenancewindow.endtime",
+	"cloudlets:#.maintenancewindow.failoverleadtime",
+	"cloudlets:#.maintenancewindow.nofailover",
+	"cloudlets:#.maintenancewindow.state",
 	"cloudletinfos:#.fields",
 	"cloudletinfos:#.key.organization",
 	"cloudletinfos:#.key.name",
@@ -838,7 +860,22 @@ var AllDataOptionalArgs = []string{
 	"clusterinsts:#.disabledynamicappinstplacement",
 	"clusterinsts:#.cloudletmanagedclusterid",
 	"clusterinsts:#.cloudletmanagedclustername",
+	"clusterinsts:#.dryrun",
 	"clusterinsts:#.tags",
+	"geofencepolicies:#.fields",
+	"geofencepolicies:#.key.organization",
+	"geofencepolicies:#.key.name",
+	"geofencepolicies:#.fences:#.name",
+	"geofencepolicies:#.fences:#.polygon:#.latitude",
+	"geofencepolicies:#.fences:#.polygon:#.longitude",
+	"geofencepolicies:#.fences:#.polygon:#.horizontalaccuracy",
+	"geofencepolicies:#.fences:#.polygon:#.verticalaccuracy",
+	"geofencepolicies:#.fences:#.polygon:#.altitude",
+	"geofencepolicies:#.fences:#.polygon:#.course",
+	"geofencepolicies:#.fences:#.polygon:#.speed",
+	"geofencepolicies:#.fences:#.polygon:#.timestamp",
+	"geofencepolicies:#.fences:#.countrycodes",
+	"geofencepolicies:#.deleteprepare",
 	"apps:#.fields",
 	"apps:#.key.organization",
 	"apps:#.key.name",
@@ -947,6 +984,17 @@ var AllDataOptionalArgs = []string{
 	"apps:#.isstandalone",
 	"apps:#.managesownnamespaces",
 	"apps:#.compatibilityversion",
+	"apps:#.findcloudletscoreweights.distance",
+	"apps:#.findcloudletscoreweights.latency",
+	"apps:#.findcloudletscoreweights.resourceusage",
+	"apps:#.findcloudletscoreweights.health",
+	"apps:#.geofencepolicy",
+	"apps:#.replicaautoscalepolicy.minreplicas",
+	"apps:#.replicaautoscalepolicy.maxreplicas",
+	"apps:#.replicaautoscalepolicy.targetcpu",
+	"apps:#.replicaautoscalepolicy.targetmem",
+	"apps:#.replicaautoscalepolicy.targetactiveconnections",
+	"apps:#.replicaautoscalepolicy.stabilizationwindowsec",
 	"apps:#.tags",
 	"appinstances:#.fields",
 	"appinstances:#.key.name",
@@ -991,6 +1039,7 @@ var AllDataOptionalArgs = []string{
 	"appinstances:#.errors",
 	"appinstances:#.crmoverride",
 	"appinstances:#.runtimeinfo.containerids",
+	"appinstances:#.runtimeinfo.replicas",
 	"appinstances:#.createdat",
 	"appinstances:#.autoclusteripaccess",
 	"appinstances:#.revision",
@@ -1070,6 +1119,8 @@ var AllDataOptionalArgs = []string{
 	"appinstances:#.noderesources.infranodeflavor",
 	"appinstances:#.noderesources.externalvolumesize",
 	"appinstances:#.isstandalone",
+	"appinstances:#.replicas",
+	"appinstances:#.dryrun",
 	"appinstances:#.tags",
 	"appinstrefs:#.key.organization",
 	"appinstrefs:#.key.name",
@@ -1116,6 +1167,8 @@ var AllDataOptionalArgs = []string{
 	"alertpolicies:#.annotations",
 	"alertpolicies:#.description",
 	"alertpolicies:#.deleteprepare",
+	"alertpolicies:#.customexpression",
+	"alertpolicies:#.custommetricssource",
 	"flowratelimitsettings:#.fields",
 	"flowratelimitsettings:#.key.flowsettingsname",
 	"flowratelimitsettings:#.key.ratelimitkey.apiname",
@@ -1201,6 +1254,13 @@ var AllDataComments = map[string]string{
 	"settings.platformhainstancepollinterval":                                    "Platform HA instance poll interval",
 	"settings.platformhainstanceactiveexpiretime":                                "Platform HA instance active time",
 	"settings.ccrmapitimeout":                                                    "Timeout for controller platform-specific API calls to CCRM",
+	"settings.findcloudletscoreweights.distance":                                 "Weight per km of distance from the client to the cloudlet",
+	"settings.findcloudletscoreweights.latency":                                  "Weight per ms of average latency reported by clients of the AppInst",
+	"settings.findcloudletscoreweights.resourceusage":                            "Weight per percent used of the most used cloudlet infra resource",
+	"settings.findcloudletscoreweights.health":                                   "Weight added if the AppInst health check has not reported healthy",
+	"settings.findcloudletdistribution":                                          "How FindCloudlet distributes clients between AppInsts with near equal scores, one of Random, WeightedRandom, RoundRobin",
+	"settings.findcloudletstickysessions":                                        "Keep sending a client to the same AppInst until the client moves to a different location tile",
+	"settings.maxobjecthistoryrevisions":                                         "Maximum number of past revisions returned by the object history APIs, older revisions are also lost when the database history is compacted",
 	"operatorcodes:#.code":                                                       "MCC plus MNC code, or custom carrier code designation.",
 	"operatorcodes:#.organization":                                               "Operator Organization name",
 	"restagtables:#.key.name":                                                    "Resource Table Name",
@@ -1420,6 +1480,12 @@ var AllDataComments = map[string]string{
 	"cloudlets:#.objid":                                                          "Universally unique object ID",
 	"cloudlets:#.annotations":                                                    "Annotations",
 	"cloudlets:#.dbmodelid":                                                      "database version model ID",
+	"cloudlets:#.dryrun":                                                         "Preview the update without applying it, reports the fields that would change and whether the CRM would be updated",
+	"cloudlets:#.maintenancewindow.starttime":                                    "Start time of the maintenance",
+	"cloudlets:#.maintenancewindow.endtime":                                      "End time of the maintenance, after which the cloudlet returns to normal operation",
+	"cloudlets:#.maintenancewindow.failoverleadtime":                             "How long before the start time to stop sending new clients to the cloudlet and move auto-provisioned AppInsts off of it, defaults to the cloudlet maintenance timeout",
+	"cloudlets:#.maintenancewindow.nofailover":                                   "Do not move auto-provisioned AppInsts off of the cloudlet",
+	"cloudlets:#.maintenancewindow.state":                                        "Progress of the maintenance window, one of Scheduled, Failover, InProgress",
 	"cloudletinfos:#.fields":                                                     "Fields are used for the Update API to specify which fields to apply",
 	"cloudletinfos:#.key.organization":                                           "Organization of the cloudlet site",
 	"cloudletinfos:#.key.name":                                                   "Name of the cloudlet",
@@ -1645,7 +1711,22 @@ var AllDataComments = map[string]string{
 	"clusterinsts:#.disabledynamicappinstplacement":                              "Disables dynamic placement of AppInsts on this cluster",
 	"clusterinsts:#.cloudletmanagedclusterid":                                    "Cloudlet managed cluster ID, if cluster based on cloudlet managed cluster",
 	"clusterinsts:#.cloudletmanagedclustername":                                  "Cloudlet managed cluster name, if cluster based on cloudlet managed cluster",
+	"clusterinsts:#.dryrun":                                                      "Preview the create without applying it, reports the chosen cloudlet, the resources required, and why other cloudlets were skipped",
 	"clusterinsts:#.tags":                                                        "Vendor-specific data",
+	"geofencepolicies:#.fields":                                                  "Fields are used for the Update API to specify which fields to apply",
+	"geofencepolicies:#.key.organization":                                        "Name of the organization for the cluster that this policy will apply to",
+	"geofencepolicies:#.key.name":                                                "Policy name",
+	"geofencepolicies:#.fences:#.name":                                           "Name of the geo-fence",
+	"geofencepolicies:#.fences:#.polygon:#.latitude":                             "Latitude in WGS 84 coordinates",
+	"geofencepolicies:#.fences:#.polygon:#.longitude":                            "Longitude in WGS 84 coordinates",
+	"geofencepolicies:#.fences:#.polygon:#.horizontalaccuracy":                   "Horizontal accuracy (radius in meters)",
+	"geofencepolicies:#.fences:#.polygon:#.verticalaccuracy":                     "Vertical accuracy (meters)",
+	"geofencepolicies:#.fences:#.polygon:#.altitude":                             "On android only lat and long are guaranteed to be supplied Altitude in meters",
+	"geofencepolicies:#.fences:#.polygon:#.course":                               "Course (IOS) / bearing (Android) (degrees east relative to true north)",
+	"geofencepolicies:#.fences:#.polygon:#.speed":                                "Speed (IOS) / velocity (Android) (meters/sec)",
+	"geofencepolicies:#.fences:#.polygon:#.timestamp":                            "Timestamp",
+	"geofencepolicies:#.fences:#.countrycodes":                                   "ISO 3166-1 alpha-2 codes of countries enclosed by the geo-fence",
+	"geofencepolicies:#.deleteprepare":                                           "Preparing to be deleted",
 	"apps:#.fields":                                                              "Fields are used for the Update API to specify which fields to apply",
 	"apps:#.key.organization":                                                    "App developer organization",
 	"apps:#.key.name":                                                            "App name",
@@ -1749,11 +1830,22 @@ var AllDataComments = map[string]string{
 	"apps:#.noderesources.optresmap":                                             "Optional resources request, i.e. optresmap=restype=resname:1",
 	"apps:#.noderesources.infranodeflavor":                                       "Infrastructure specific node flavor",
 	"apps:#.noderesources.externalvolumesize":                                    "Size of external volume to be attached to nodes. This is for the root partition",
-	"apps:#.objid":                                                              "Universally unique object ID",
-	"apps:#.appannotations":                                                     "Internal Annotations",
-	"apps:#.isstandalone":                                                       "A standalone App will not share a cluster with another App unless explicitly targeted to the same cluster",
-	"apps:#.managesownnamespaces":                                               "Specifies if the kubernetes application manages creating and deleting its own namespaces. If true, it is disallowed from deployment to multi-tenant clusters, and it is up to the application developer to manage namespace conflicts if they deploy multiple applications to the same cluster. If false, each application instance is deployed to its own namespace set by the platform.",
-	"apps:#.compatibilityversion":                                               "Internal compatibility version",
+	"apps:#.objid":                                                               "Universally unique object ID",
+	"apps:#.appannotations":                                                      "Internal Annotations",
+	"apps:#.isstandalone":                                                        "A standalone App will not share a cluster with another App unless explicitly targeted to the same cluster",
+	"apps:#.managesownnamespaces":                                                "Specifies if the kubernetes application manages creating and deleting its own namespaces. If true, it is disallowed from deployment to multi-tenant clusters, and it is up to the application developer to manage namespace conflicts if they deploy multiple applications to the same cluster. If false, each application instance is deployed to its own namespace set by the platform.",
+	"apps:#.compatibilityversion":                                                "Internal compatibility version",
+	"apps:#.findcloudletscoreweights.distance":                                   "Weight per km of distance from the client to the cloudlet",
+	"apps:#.findcloudletscoreweights.latency":                                    "Weight per ms of average latency reported by clients of the AppInst",
+	"apps:#.findcloudletscoreweights.resourceusage":                              "Weight per percent used of the most used cloudlet infra resource",
+	"apps:#.findcloudletscoreweights.health":                                     "Weight added if the AppInst health check has not reported healthy",
+	"apps:#.geofencepolicy":                                                      "Geo-fence policy name, restricts which cloudlets may serve devices by location",
+	"apps:#.replicaautoscalepolicy.minreplicas":                                  "Minimum number of replicas",
+	"apps:#.replicaautoscalepolicy.maxreplicas":                                  "Maximum number of replicas",
+	"apps:#.replicaautoscalepolicy.targetcpu":                                    "Target average cpu utilization per replica (percentage 1 to 100 of requested cpu), 0 means disabled",
+	"apps:#.replicaautoscalepolicy.targetmem":                                    "Target average memory utilization per replica (percentage 1 to 100 of requested memory), 0 means disabled",
+	"apps:#.replicaautoscalepolicy.targetactiveconnections":                      "Target number of active connections per replica, 0 means disabled",
+	"apps:#.replicaautoscalepolicy.stabilizationwindowsec":                       "Stabilization window is the time for which past recommendations are considered before scaling down",
 	"apps:#.tags":                                                               "Vendor-specific data",
 	"appinstances:#.fields":                                                     "Fields are used for the Update API to specify which fields to apply",
 	"appinstances:#.key.name":                                                   "App Instance name",
@@ -1798,6 +1890,7 @@ var AllDataComments = map[string]string{
 	"appinstances:#.errors":                                                     "Any errors trying to create, update, or delete the AppInst on the Cloudlet",
 	"appinstances:#.crmoverride":                                                "Override actions to CRM, one of NoOverride, IgnoreCrmErrors, IgnoreCrm, IgnoreTransientState, IgnoreCrmAndTransientState",
 	"appinstances:#.runtimeinfo.containerids":                                   "List of container names",
+	"appinstances:#.runtimeinfo.replicas":                                       "Number of running replicas for Kubernetes deployments",
 	"appinstances:#.createdat":                                                  "Created at time",
 	"appinstances:#.autoclusteripaccess":                                        "(Deprecated) IpAccess for auto-clusters. Ignored otherwise., one of Unknown, Dedicated, Shared",
 	"appinstances:#.revision":                                                   "Revision changes each time the App is updated.  Refreshing the App Instance will sync the revision with that of the App",
@@ -1877,6 +1970,8 @@ var AllDataComments = map[string]string{
 	"appinstances:#.noderesources.infranodeflavor":                              "Infrastructure specific node flavor",
 	"appinstances:#.noderesources.externalvolumesize":                           "Size of external volume to be attached to nodes. This is for the root partition",
 	"appinstances:#.isstandalone":                                               "A standalone AppInst will not share a cluster with another AppInst unless explicitly targeted to the same cluster",
+	"appinstances:#.replicas":                                                   "Number of replicas for Kubernetes deployments, 0 uses the App manifest default",
+	"appinstances:#.dryrun":                                                     "Preview the create without applying it, reports the chosen cloudlet and cluster, the resources required, and why other candidates were skipped",
 	"appinstances:#.tags":                                                       "Vendor-specific data",
 	"appinstrefs:#.key.organization":                                            "App developer organization",
 	"appinstrefs:#.key.name":                                                    "App name",
@@ -1922,10 +2017,12 @@ var AllDataComments = map[string]string{
 	"alertpolicies:#.annotations":                                               "Additional Annotations for extra information about the alert",
 	"alertpolicies:#.description":                                               "Description of the alert policy",
 	"alertpolicies:#.deleteprepare":                                             "Preparing to be deleted",
+	"alertpolicies:#.customexpression":                                          "Custom Prometheus (PromQL) alert expression, for example rate(http_errors_total[5m]) > 5. Label matchers that scope every metric to the AppInst are added automatically",
+	"alertpolicies:#.custommetricssource":                                       "Metrics the custom expression is evaluated against, one of App (metrics exposed by the application) or LoadBalancer (load balancer metrics), one of App, LoadBalancer",
 	"flowratelimitsettings:#.fields":                                            "Fields are used for the Update API to specify which fields to apply",
 	"flowratelimitsettings:#.key.flowsettingsname":                              "Unique name for FlowRateLimitSettings (there can be multiple FlowSettings per RateLimitSettingsKey)",
 	"flowratelimitsettings:#.key.ratelimitkey.apiname":                          "Name of API (eg. CreateApp or RegisterClient) (Use Global if not a specific API)",
-	"flowratelimitsettings:#.key.ratelimitkey.apiendpointtype":                  "API Endpoint type, one of UnknownApiEndpointType, Dme",
+	"flowratelimitsettings:#.key.ratelimitkey.apiendpointtype":                  "API Endpoint type, one of UnknownApiEndpointType, Dme, Controller",
 	"flowratelimitsettings:#.key.ratelimitkey.ratelimittarget":                  "Target to rate limit, one of UnknownTarget, AllRequests, PerIp, PerUser",
 	"flowratelimitsettings:#.settings.flowalgorithm":                            "Flow Rate Limit algorithm, one of UnknownFlowAlgorithm, TokenBucketAlgorithm, LeakyBucketAlgorithm",
 	"flowratelimitsettings:#.settings.reqspersecond":                            "Requests per second for flow rate limiting",
@@ -1933,7 +2030,7 @@ var AllDataComments = map[string]string{
 	"maxreqsratelimitsettings:#.fields":                                         "Fields are used for the Update API to specify which fields to apply",
 	"maxreqsratelimitsettings:#.key.maxreqssettingsname":                        "Unique name for MaxReqsRateLimitSettings (there can be multiple MaxReqsSettings per RateLimitSettingsKey)",
 	"maxreqsratelimitsettings:#.key.ratelimitkey.apiname":                       "Name of API (eg. CreateApp or RegisterClient) (Use Global if not a specific API)",
-	"maxreqsratelimitsettings:#.key.ratelimitkey.apiendpointtype":               "API Endpoint type, one of UnknownApiEndpointType, Dme",
+	"maxreqsratelimitsettings:#.key.ratelimitkey.apiendpointtype":               "API Endpoint type, one of UnknownApiEndpointType, Dme, Controller",
 	"maxreqsratelimitsettings:#.key.ratelimitkey.ratelimittarget":               "Target to rate limit, one of UnknownTarget, AllRequests, PerIp, PerUser",
 	"maxreqsratelimitsettings:#.settings.maxreqsalgorithm":                      "MaxReqs Rate Limit Algorithm, one of UnknownMaxReqsAlgorithm, FixedWindowAlgorithm",
 	"maxreqsratelimitsettings:#.settings.maxrequests":                           "Maximum number of requests for the given Interval",
@@ -2007,6 +2104,8 @@ var AllDataSpecialArgs = map[string]string{
 	"flavors:#.fields":                                          "StringArray",
 	"flavors:#.optresmap":                                       "StringToString",
 	"flowratelimitsettings:#.fields":                            "StringArray",
+	"geofencepolicies:#.fences:#.countrycodes":                  "StringArray",
+	"geofencepolicies:#.fields":                                 "StringArray",
 	"gpudrivers:#.fields":                                       "StringArray",
 	"gpudrivers:#.properties":                                   "StringToString",
 	"maxreqsratelimitsettings:#.fields":                         "StringArray",
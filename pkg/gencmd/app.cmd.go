@@ -111,6 +111,48 @@ func DeploymentZoneRequestHideTags(in *edgeproto.DeploymentZoneRequest) {
 	}
 }
 
+func AppHistoryHideTags(in *edgeproto.AppHistory) {
+	if cli.HideTags == "" {
+		return
+	}
+	tags := make(map[string]struct{})
+	for _, tag := range strings.Split(cli.HideTags, ",") {
+		tags[tag] = struct{}{}
+	}
+	if _, found := tags["nocmp"]; found {
+		in.Obj.AuthPublicKey = ""
+	}
+	for i1 := 0; i1 < len(in.Obj.Configs); i1++ {
+	}
+	if _, found := tags["nocmp"]; found {
+		in.Obj.Revision = ""
+	}
+	if _, found := tags["timestamp"]; found {
+		in.Obj.CreatedAt = distributed_match_engine.Timestamp{}
+	}
+	if _, found := tags["timestamp"]; found {
+		in.Obj.UpdatedAt = distributed_match_engine.Timestamp{}
+	}
+	for i1 := 0; i1 < len(in.Obj.RequiredOutboundConnections); i1++ {
+	}
+	for i3 := 0; i3 < len(in.Obj.KubernetesResources.CpuPool.TotalGpus); i3++ {
+	}
+	for i4 := 0; i4 < len(in.Obj.KubernetesResources.CpuPool.Topology.MinNodeGpus); i4++ {
+	}
+	for i3 := 0; i3 < len(in.Obj.KubernetesResources.GpuPool.TotalGpus); i3++ {
+	}
+	for i4 := 0; i4 < len(in.Obj.KubernetesResources.GpuPool.Topology.MinNodeGpus); i4++ {
+	}
+	for i2 := 0; i2 < len(in.Obj.NodeResources.Gpus); i2++ {
+	}
+	if _, found := tags["nocmp"]; found {
+		in.Obj.ObjId = ""
+	}
+	if _, found := tags["nocmp"]; found {
+		in.Obj.CompatibilityVersion = 0
+	}
+}
+
 var AppApiCmd edgeproto.AppApiClient
 
 var CreateAppCmd = &cli.Command{
@@ -362,6 +404,84 @@ func ShowApps(c *cli.Command, data []edgeproto.App, err *error) {
 	}
 }
 
+var ShowAppHistoryCmd = &cli.Command{
+	Use:          "ShowAppHistory",
+	RequiredArgs: strings.Join(AppRequiredArgs, " "),
+	OptionalArgs: strings.Join(AppOptionalArgs, " "),
+	AliasArgs:    strings.Join(AppAliasArgs, " "),
+	SpecialArgs:  &AppSpecialArgs,
+	Comments:     AppComments,
+	ReqData:      &edgeproto.App{},
+	ReplyData:    &edgeproto.AppHistory{},
+	Run:          runShowAppHistory,
+}
+
+func runShowAppHistory(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.App)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return ShowAppHistory(c, obj)
+}
+
+func ShowAppHistory(c *cli.Command, in *edgeproto.App) error {
+	if AppApiCmd == nil {
+		return fmt.Errorf("AppApi client not initialized")
+	}
+	ctx := context.Background()
+	stream, err := AppApiCmd.ShowAppHistory(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("ShowAppHistory failed: %s", errstr)
+	}
+
+	objs := make([]*edgeproto.AppHistory, 0)
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errstr := err.Error()
+			st, ok := status.FromError(err)
+			if ok {
+				errstr = st.Message()
+			}
+			return fmt.Errorf("ShowAppHistory recv failed: %s", errstr)
+		}
+		AppHistoryHideTags(obj)
+		objs = append(objs, obj)
+	}
+	if len(objs) == 0 {
+		return nil
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), objs, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func ShowAppHistorys(c *cli.Command, data []edgeproto.App, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("ShowAppHistory %v\n", data[ii])
+		myerr := ShowAppHistory(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
 var AddAppAutoProvPolicyCmd = &cli.Command{
 	Use:          "AddAppAutoProvPolicy",
 	RequiredArgs: strings.Join(AppAutoProvPolicyRequiredArgs, " "),
@@ -753,6 +873,7 @@ var AppApiCmds = []*cobra.Command{
 	DeleteAppCmd.GenCmd(),
 	UpdateAppCmd.GenCmd(),
 	ShowAppCmd.GenCmd(),
+	ShowAppHistoryCmd.GenCmd(),
 	AddAppAutoProvPolicyCmd.GenCmd(),
 	RemoveAppAutoProvPolicyCmd.GenCmd(),
 	AddAppAlertPolicyCmd.GenCmd(),
@@ -896,6 +1017,17 @@ var AppOptionalArgs = []string{
 	"appannotations",
 	"isstandalone",
 	"managesownnamespaces",
+	"findcloudletscoreweights.distance",
+	"findcloudletscoreweights.latency",
+	"findcloudletscoreweights.resourceusage",
+	"findcloudletscoreweights.health",
+	"geofencepolicy",
+	"replicaautoscalepolicy.minreplicas",
+	"replicaautoscalepolicy.maxreplicas",
+	"replicaautoscalepolicy.targetcpu",
+	"replicaautoscalepolicy.targetmem",
+	"replicaautoscalepolicy.targetactiveconnections",
+	"replicaautoscalepolicy.stabilizationwindowsec",
 	"tags",
 }
 var AppAliasArgs = []string{
@@ -1020,7 +1152,18 @@ var AppComments = map[string]string{
 	"isstandalone":                                               "A standalone App will not share a cluster with another App unless explicitly targeted to the same cluster",
 	"managesownnamespaces":                                       "Specifies if the kubernetes application manages creating and deleting its own namespaces. If true, it is disallowed from deployment to multi-tenant clusters, and it is up to the application developer to manage namespace conflicts if they deploy multiple applications to the same cluster. If false, each application instance is deployed to its own namespace set by the platform.",
 	"compatibilityversion":                                       "Internal compatibility version",
-	"tags":                                                       "Vendor-specific data, specify tags:empty=true to clear",
+	"findcloudletscoreweights.distance":                          "Weight per km of distance from the client to the cloudlet",
+	"findcloudletscoreweights.latency":                           "Weight per ms of average latency reported by clients of the AppInst",
+	"findcloudletscoreweights.resourceusage":                     "Weight per percent used of the most used cloudlet infra resource",
+	"findcloudletscoreweights.health":                            "Weight added if the AppInst health check has not reported healthy",
+	"geofencepolicy":                                             "Geo-fence policy name, restricts which cloudlets may serve devices by location",
+	"replicaautoscalepolicy.minreplicas":                         "Minimum number of replicas",
+	"replicaautoscalepolicy.maxreplicas":                         "Maximum number of replicas",
+	"replicaautoscalepolicy.targetcpu":                           "Target average cpu utilization per replica (percentage 1 to 100 of requested cpu), 0 means disabled",
+	"replicaautoscalepolicy.targetmem":                           "Target average memory utilization per replica (percentage 1 to 100 of requested memory), 0 means disabled",
+	"replicaautoscalepolicy.targetactiveconnections":             "Target number of active connections per replica, 0 means disabled",
+	"replicaautoscalepolicy.stabilizationwindowsec":              "Stabilization window is the time for which past recommendations are considered before scaling down",
+	"tags": "Vendor-specific data, specify tags:empty=true to clear",
 }
 var AppSpecialArgs = map[string]string{
 	"alertpolicies":    "StringArray",
@@ -1058,6 +1201,25 @@ var ServerlessConfigComments = map[string]string{
 	"gpuconfig.ram":    "required memory in megabytes",
 }
 var ServerlessConfigSpecialArgs = map[string]string{}
+var ReplicaAutoScalePolicyRequiredArgs = []string{}
+var ReplicaAutoScalePolicyOptionalArgs = []string{
+	"minreplicas",
+	"maxreplicas",
+	"targetcpu",
+	"targetmem",
+	"targetactiveconnections",
+	"stabilizationwindowsec",
+}
+var ReplicaAutoScalePolicyAliasArgs = []string{}
+var ReplicaAutoScalePolicyComments = map[string]string{
+	"minreplicas":             "Minimum number of replicas",
+	"maxreplicas":             "Maximum number of replicas",
+	"targetcpu":               "Target average cpu utilization per replica (percentage 1 to 100 of requested cpu), 0 means disabled",
+	"targetmem":               "Target average memory utilization per replica (percentage 1 to 100 of requested memory), 0 means disabled",
+	"targetactiveconnections": "Target number of active connections per replica, 0 means disabled",
+	"stabilizationwindowsec":  "Stabilization window is the time for which past recommendations are considered before scaling down",
+}
+var ReplicaAutoScalePolicySpecialArgs = map[string]string{}
 var GpuConfigRequiredArgs = []string{}
 var GpuConfigOptionalArgs = []string{
 	"type",
@@ -1217,6 +1379,17 @@ var DeploymentZoneRequestOptionalArgs = []string{
 	"app.isstandalone",
 	"app.managesownnamespaces",
 	"app.compatibilityversion",
+	"app.findcloudletscoreweights.distance",
+	"app.findcloudletscoreweights.latency",
+	"app.findcloudletscoreweights.resourceusage",
+	"app.findcloudletscoreweights.health",
+	"app.geofencepolicy",
+	"app.replicaautoscalepolicy.minreplicas",
+	"app.replicaautoscalepolicy.maxreplicas",
+	"app.replicaautoscalepolicy.targetcpu",
+	"app.replicaautoscalepolicy.targetmem",
+	"app.replicaautoscalepolicy.targetactiveconnections",
+	"app.replicaautoscalepolicy.stabilizationwindowsec",
 	"app.tags",
 	"dryrundeploy",
 	"numnodes",
@@ -1334,9 +1507,20 @@ var DeploymentZoneRequestComments = map[string]string{
 	"app.isstandalone":                                               "A standalone App will not share a cluster with another App unless explicitly targeted to the same cluster",
 	"app.managesownnamespaces":                                       "Specifies if the kubernetes application manages creating and deleting its own namespaces. If true, it is disallowed from deployment to multi-tenant clusters, and it is up to the application developer to manage namespace conflicts if they deploy multiple applications to the same cluster. If false, each application instance is deployed to its own namespace set by the platform.",
 	"app.compatibilityversion":                                       "Internal compatibility version",
-	"app.tags":                                                       "Vendor-specific data",
-	"dryrundeploy":                                                   "Attempt to qualify zones resources for deployment",
-	"numnodes":                                                       "Optional number of worker VMs in dry run K8s Cluster, default = 2",
+	"app.findcloudletscoreweights.distance":                          "Weight per km of distance from the client to the cloudlet",
+	"app.findcloudletscoreweights.latency":                           "Weight per ms of average latency reported by clients of the AppInst",
+	"app.findcloudletscoreweights.resourceusage":                     "Weight per percent used of the most used cloudlet infra resource",
+	"app.findcloudletscoreweights.health":                            "Weight added if the AppInst health check has not reported healthy",
+	"app.geofencepolicy":                                             "Geo-fence policy name, restricts which cloudlets may serve devices by location",
+	"app.replicaautoscalepolicy.minreplicas":                         "Minimum number of replicas",
+	"app.replicaautoscalepolicy.maxreplicas":                         "Maximum number of replicas",
+	"app.replicaautoscalepolicy.targetcpu":                           "Target average cpu utilization per replica (percentage 1 to 100 of requested cpu), 0 means disabled",
+	"app.replicaautoscalepolicy.targetmem":                           "Target average memory utilization per replica (percentage 1 to 100 of requested memory), 0 means disabled",
+	"app.replicaautoscalepolicy.targetactiveconnections":             "Target number of active connections per replica, 0 means disabled",
+	"app.replicaautoscalepolicy.stabilizationwindowsec":              "Stabilization window is the time for which past recommendations are considered before scaling down",
+	"app.tags":     "Vendor-specific data",
+	"dryrundeploy": "Attempt to qualify zones resources for deployment",
+	"numnodes":     "Optional number of worker VMs in dry run K8s Cluster, default = 2",
 }
 var DeploymentZoneRequestSpecialArgs = map[string]string{
 	"app.alertpolicies":    "StringArray",
@@ -1353,6 +1537,269 @@ var DeploymentZoneRequestSpecialArgs = map[string]string{
 	"app.secretenvvars":                                      "StringToString",
 	"app.tags":                                               "StringToString",
 }
+var AppHistoryRequiredArgs = []string{}
+var AppHistoryOptionalArgs = []string{
+	"revision",
+	"obj.fields",
+	"obj.key.organization",
+	"obj.key.name",
+	"obj.key.version",
+	"obj.imagepath",
+	"obj.imagetype",
+	"obj.accessports",
+	"obj.defaultflavor.name",
+	"obj.authpublickey",
+	"obj.command",
+	"obj.commandargs",
+	"obj.annotations",
+	"obj.deployment",
+	"obj.deploymentmanifest",
+	"obj.deploymentgenerator",
+	"obj.androidpackagename",
+	"obj.delopt",
+	"obj.configs:#.kind",
+	"obj.configs:#.config",
+	"obj.scalewithcluster",
+	"obj.internalports",
+	"obj.revision",
+	"obj.officialfqdn",
+	"obj.md5sum",
+	"obj.autoprovpolicy",
+	"obj.accesstype",
+	"obj.deleteprepare",
+	"obj.autoprovpolicies",
+	"obj.templatedelimiter",
+	"obj.skiphcports",
+	"obj.createdat",
+	"obj.updatedat",
+	"obj.trusted",
+	"obj.requiredoutboundconnections:#.protocol",
+	"obj.requiredoutboundconnections:#.portrangemin",
+	"obj.requiredoutboundconnections:#.portrangemax",
+	"obj.requiredoutboundconnections:#.remotecidr",
+	"obj.allowserverless",
+	"obj.serverlessconfig.vcpus",
+	"obj.serverlessconfig.ram",
+	"obj.serverlessconfig.minreplicas",
+	"obj.serverlessconfig.gpuconfig.type",
+	"obj.serverlessconfig.gpuconfig.model",
+	"obj.serverlessconfig.gpuconfig.numgpu",
+	"obj.serverlessconfig.gpuconfig.ram",
+	"obj.vmappostype",
+	"obj.alertpolicies",
+	"obj.qossessionprofile",
+	"obj.qossessionduration",
+	"obj.globalid",
+	"obj.envvars",
+	"obj.secretenvvars",
+	"obj.updatelistaction",
+	"obj.kubernetesresources.cpupool.totalvcpus",
+	"obj.kubernetesresources.cpupool.totalmemory",
+	"obj.kubernetesresources.cpupool.totaldisk",
+	"obj.kubernetesresources.cpupool.totalgpus:#.modelid",
+	"obj.kubernetesresources.cpupool.totalgpus:#.count",
+	"obj.kubernetesresources.cpupool.totalgpus:#.vendor",
+	"obj.kubernetesresources.cpupool.totalgpus:#.memory",
+	"obj.kubernetesresources.cpupool.totalgpus:#.inuse",
+	"obj.kubernetesresources.cpupool.totaloptres",
+	"obj.kubernetesresources.cpupool.topology.minnodevcpus",
+	"obj.kubernetesresources.cpupool.topology.minnodememory",
+	"obj.kubernetesresources.cpupool.topology.minnodedisk",
+	"obj.kubernetesresources.cpupool.topology.minnodegpus:#.modelid",
+	"obj.kubernetesresources.cpupool.topology.minnodegpus:#.count",
+	"obj.kubernetesresources.cpupool.topology.minnodegpus:#.vendor",
+	"obj.kubernetesresources.cpupool.topology.minnodegpus:#.memory",
+	"obj.kubernetesresources.cpupool.topology.minnodegpus:#.inuse",
+	"obj.kubernetesresources.cpupool.topology.minnodeoptres",
+	"obj.kubernetesresources.cpupool.topology.minnumberofnodes",
+	"obj.kubernetesresources.gpupool.totalvcpus",
+	"obj.kubernetesresources.gpupool.totalmemory",
+	"obj.kubernetesresources.gpupool.totaldisk",
+	"obj.kubernetesresources.gpupool.totalgpus:#.modelid",
+	"obj.kubernetesresources.gpupool.totalgpus:#.count",
+	"obj.kubernetesresources.gpupool.totalgpus:#.vendor",
+	"obj.kubernetesresources.gpupool.totalgpus:#.memory",
+	"obj.kubernetesresources.gpupool.totalgpus:#.inuse",
+	"obj.kubernetesresources.gpupool.totaloptres",
+	"obj.kubernetesresources.gpupool.topology.minnodevcpus",
+	"obj.kubernetesresources.gpupool.topology.minnodememory",
+	"obj.kubernetesresources.gpupool.topology.minnodedisk",
+	"obj.kubernetesresources.gpupool.topology.minnodegpus:#.modelid",
+	"obj.kubernetesresources.gpupool.topology.minnodegpus:#.count",
+	"obj.kubernetesresources.gpupool.topology.minnodegpus:#.vendor",
+	"obj.kubernetesresources.gpupool.topology.minnodegpus:#.memory",
+	"obj.kubernetesresources.gpupool.topology.minnodegpus:#.inuse",
+	"obj.kubernetesresources.gpupool.topology.minnodeoptres",
+	"obj.kubernetesresources.gpupool.topology.minnumberofnodes",
+	"obj.kubernetesresources.minkubernetesversion",
+	"obj.noderesources.vcpus",
+	"obj.noderesources.ram",
+	"obj.noderesources.disk",
+	"obj.noderesources.gpus:#.modelid",
+	"obj.noderesources.gpus:#.count",
+	"obj.noderesources.gpus:#.vendor",
+	"obj.noderesources.gpus:#.memory",
+	"obj.noderesources.gpus:#.inuse",
+	"obj.noderesources.optresmap",
+	"obj.noderesources.infranodeflavor",
+	"obj.noderesources.externalvolumesize",
+	"obj.objid",
+	"obj.appannotations",
+	"obj.isstandalone",
+	"obj.managesownnamespaces",
+	"obj.compatibilityversion",
+	"obj.findcloudletscoreweights.distance",
+	"obj.findcloudletscoreweights.latency",
+	"obj.findcloudletscoreweights.resourceusage",
+	"obj.findcloudletscoreweights.health",
+	"obj.geofencepolicy",
+	"obj.replicaautoscalepolicy.minreplicas",
+	"obj.replicaautoscalepolicy.maxreplicas",
+	"obj.replicaautoscalepolicy.targetcpu",
+	"obj.replicaautoscalepolicy.targetmem",
+	"obj.replicaautoscalepolicy.targetactiveconnections",
+	"obj.replicaautoscalepolicy.stabilizationwindowsec",
+	"obj.tags",
+}
+var AppHistoryAliasArgs = []string{}
+var AppHistoryComments = map[string]string{
+	"revision":                "Database revision of the change",
+	"obj.fields":              "Fields are used for the Update API to specify which fields to apply",
+	"obj.key.organization":    "App developer organization",
+	"obj.key.name":            "App name",
+	"obj.key.version":         "App version",
+	"obj.imagepath":           "URI of where image resides",
+	"obj.imagetype":           "Image type, one of Unknown, Docker, Qcow, Helm, Ovf, Ova",
+	"obj.accessports":         "Comma separated list of protocol:port pairs that the App listens on. Ex: tcp:80,udp:10002. Also supports additional configurations per port: (1) tls (tcp-only) - Enables TLS on specified port. Ex: tcp:443:tls. (2) nginx (udp-only) - Use NGINX LB instead of envoy for specified port. Ex: udp:10001:nginx. (3) maxpktsize (udp-only) - Configures maximum UDP datagram size allowed on port for both upstream/downstream traffic. Ex: udp:10001:maxpktsize=8000. (4) intvis (internal-visibility)- Port is not externally accessible. Ex: tcp:9000:intvis (5) id - Port ID. Ex: tcp:9000:id=p9000 (6) pathprefix (http-only) - Specifies the path prefix to use in the kubernetes ingress, required if multiple http ports are present, defaults to / (7) svcname - For Kubernetes apps, if there are multiple of the same port on different services, this denotes the service name. Ex: tcp:9000:tls:svcname=svc1",
+	"obj.defaultflavor.name":  "Flavor name",
+	"obj.authpublickey":       "Public key used for authentication",
+	"obj.command":             "Command that the container runs to start service, separate multiple commands by a space",
+	"obj.commandargs":         "Command args to append to command, on cli specify multiple times in order",
+	"obj.annotations":         "Annotations is a comma separated map of arbitrary key value pairs, for example: key1=val1,key2=val2,key3=val 3",
+	"obj.deployment":          "Deployment type (kubernetes, docker, or vm)",
+	"obj.deploymentmanifest":  "Deployment manifest is the deployment specific manifest file/config. For docker deployment, this can be a docker-compose or docker run file. For kubernetes deployment, this can be a kubernetes yaml or helm chart file.",
+	"obj.deploymentgenerator": "Deployment generator target to generate a basic deployment manifest",
+	"obj.androidpackagename":  "Android package name used to match the App name from the Android package",
+	"obj.delopt":              "Override actions to Controller, one of NoAutoDelete, AutoDelete",
+	"obj.configs:#.kind":      "Kind (type) of config, i.e. envVarsYaml, helmCustomizationYaml",
+	"obj.configs:#.config":    "Config file contents or URI reference",
+	"obj.scalewithcluster":    "True indicates App runs on all nodes of the cluster as it scales",
+	"obj.internalports":       "True indicates App is used internally with other Apps only, and no ports are exposed externally",
+	"obj.revision":            "Revision can be specified or defaults to current timestamp when app is updated",
+	"obj.officialfqdn":        "Official FQDN is the FQDN that the app uses to connect by default",
+	"obj.md5sum":              "MD5Sum of the VM-based app image",
+	"obj.autoprovpolicy":      "(_deprecated_) Auto provisioning policy name",
+	"obj.accesstype":          "(_deprecated_) Access type, one of DefaultForDeployment, Direct, LoadBalancer",
+	"obj.deleteprepare":       "Preparing to be deleted",
+	"obj.autoprovpolicies":    "Auto provisioning policy names, may be specified multiple times",
+	"obj.templatedelimiter":   "Delimiter to be used for template parsing, defaults to [[ ]]",
+	"obj.skiphcports":         "Comma separated list of protocol:port pairs that we should not run health check on. Should be configured in case app does not always listen on these ports. all can be specified if no health check to be run for this app. Numerical values must be decimal format. i.e. tcp:80,udp:10002",
+	"obj.createdat":           "Created at time",
+	"obj.updatedat":           "Updated at time",
+	"obj.trusted":             "Indicates that an instance of this app can be started on a trusted cloudlet",
+	"obj.requiredoutboundconnections:#.protocol":                     "TCP, UDP, ICMP",
+	"obj.requiredoutboundconnections:#.portrangemin":                 "TCP or UDP port range start",
+	"obj.requiredoutboundconnections:#.portrangemax":                 "TCP or UDP port range end",
+	"obj.requiredoutboundconnections:#.remotecidr":                   "Remote CIDR X.X.X.X/X for IPv4 or e.g. XXXX:XXXX::XXXX/XX for IPv6",
+	"obj.allowserverless":                                            "App is allowed to deploy as serverless containers",
+	"obj.serverlessconfig.vcpus":                                     "Virtual CPUs allocation per container when serverless, may be decimal in increments of 0.001",
+	"obj.serverlessconfig.ram":                                       "RAM allocation in megabytes per container when serverless",
+	"obj.serverlessconfig.minreplicas":                               "Minimum number of replicas when serverless",
+	"obj.serverlessconfig.gpuconfig.type":                            "GPU Type, one of None, Any, Vgpu, Pci",
+	"obj.serverlessconfig.gpuconfig.model":                           "Model name or vgpu type",
+	"obj.serverlessconfig.gpuconfig.numgpu":                          "Number of instances",
+	"obj.serverlessconfig.gpuconfig.ram":                             "required memory in megabytes",
+	"obj.vmappostype":                                                "OS Type for VM Apps, one of Unknown, Linux, Windows10, Windows2012, Windows2016, Windows2019",
+	"obj.alertpolicies":                                              "Alert Policies",
+	"obj.qossessionprofile":                                          "Qualifier for the requested latency profile, one of NoPriority, LowLatency, ThroughputDownS, ThroughputDownM, ThroughputDownL",
+	"obj.qossessionduration":                                         "Session duration in seconds. Maximal value of 24 hours is used if not set",
+	"obj.globalid":                                                   "A globally unique id for the App to be used with federation",
+	"obj.envvars":                                                    "Environment variables",
+	"obj.secretenvvars":                                              "Environment variables with sensitive information, stored in encrypted storage",
+	"obj.updatelistaction":                                           "For updating list and map fields, set to add, remove, or replace to define how to resolve specified entries against existing entries",
+	"obj.kubernetesresources.cpupool.totalvcpus":                     "Total Vcpus to be allocated in the pool, in increments of 0.001",
+	"obj.kubernetesresources.cpupool.totalmemory":                    "Total RAM in megabytes to be allocated in the pool",
+	"obj.kubernetesresources.cpupool.totaldisk":                      "Total Disk in gigabytes to be allocated in the pool",
+	"obj.kubernetesresources.cpupool.totalgpus:#.modelid":            "GPU model unique identifier",
+	"obj.kubernetesresources.cpupool.totalgpus:#.count":              "Count of how many of this GPU are required/present",
+	"obj.kubernetesresources.cpupool.totalgpus:#.vendor":             "GPU vendor (nvidia, amd, etc)",
+	"obj.kubernetesresources.cpupool.totalgpus:#.memory":             "Memory in GB",
+	"obj.kubernetesresources.cpupool.totalgpus:#.inuse":              "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"obj.kubernetesresources.cpupool.totaloptres":                    "Total optional resources to be allocated in the pool, follows the NodeResources.OptResMap format.",
+	"obj.kubernetesresources.cpupool.topology.minnodevcpus":          "Minimum number of vcpus per node",
+	"obj.kubernetesresources.cpupool.topology.minnodememory":         "Minimum amount of RAM in megabytes per node",
+	"obj.kubernetesresources.cpupool.topology.minnodedisk":           "Minimum amount of root partition disk space in gigabytes per node",
+	"obj.kubernetesresources.cpupool.topology.minnodegpus:#.modelid": "GPU model unique identifier",
+	"obj.kubernetesresources.cpupool.topology.minnodegpus:#.count":   "Count of how many of this GPU are required/present",
+	"obj.kubernetesresources.cpupool.topology.minnodegpus:#.vendor":  "GPU vendor (nvidia, amd, etc)",
+	"obj.kubernetesresources.cpupool.topology.minnodegpus:#.memory":  "Memory in GB",
+	"obj.kubernetesresources.cpupool.topology.minnodegpus:#.inuse":   "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"obj.kubernetesresources.cpupool.topology.minnodeoptres":         "Minimum number of optional resources per node",
+	"obj.kubernetesresources.cpupool.topology.minnumberofnodes":      "Minimum number of nodes in pool, to satisfy HA/replication requirements",
+	"obj.kubernetesresources.gpupool.totalvcpus":                     "Total Vcpus to be allocated in the pool, in increments of 0.001",
+	"obj.kubernetesresources.gpupool.totalmemory":                    "Total RAM in megabytes to be allocated in the pool",
+	"obj.kubernetesresources.gpupool.totaldisk":                      "Total Disk in gigabytes to be allocated in the pool",
+	"obj.kubernetesresources.gpupool.totalgpus:#.modelid":            "GPU model unique identifier",
+	"obj.kubernetesresources.gpupool.totalgpus:#.count":              "Count of how many of this GPU are required/present",
+	"obj.kubernetesresources.gpupool.totalgpus:#.vendor":             "GPU vendor (nvidia, amd, etc)",
+	"obj.kubernetesresources.gpupool.totalgpus:#.memory":             "Memory in GB",
+	"obj.kubernetesresources.gpupool.totalgpus:#.inuse":              "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"obj.kubernetesresources.gpupool.totaloptres":                    "Total optional resources to be allocated in the pool, follows the NodeResources.OptResMap format.",
+	"obj.kubernetesresources.gpupool.topology.minnodevcpus":          "Minimum number of vcpus per node",
+	"obj.kubernetesresources.gpupool.topology.minnodememory":         "Minimum amount of RAM in megabytes per node",
+	"obj.kubernetesresources.gpupool.topology.minnodedisk":           "Minimum amount of root partition disk space in gigabytes per node",
+	"obj.kubernetesresources.gpupool.topology.minnodegpus:#.modelid": "GPU model unique identifier",
+	"obj.kubernetesresources.gpupool.topology.minnodegpus:#.count":   "Count of how many of this GPU are required/present",
+	"obj.kubernetesresources.gpupool.topology.minnodegpus:#.vendor":  "GPU vendor (nvidia, amd, etc)",
+	"obj.kubernetesresources.gpupool.topology.minnodegpus:#.memory":  "Memory in GB",
+	"obj.kubernetesresources.gpupool.topology.minnodegpus:#.inuse":   "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"obj.kubernetesresources.gpupool.topology.minnodeoptres":         "Minimum number of optional resources per node",
+	"obj.kubernetesresources.gpupool.topology.minnumberofnodes":      "Minimum number of nodes in pool, to satisfy HA/replication requirements",
+	"obj.kubernetesresources.minkubernetesversion":                   "Minimum Kubernetes version",
+	"obj.noderesources.vcpus":                                        "Vcpus to be allocated to the VM, must be either 1 or an even number",
+	"obj.noderesources.ram":                                          "Total RAM in megabytes to be allocated to the VM",
+	"obj.noderesources.disk":                                         "Total disk space in gigabytes to be allocated to the VMs root partition",
+	"obj.noderesources.gpus:#.modelid":                               "GPU model unique identifier",
+	"obj.noderesources.gpus:#.count":                                 "Count of how many of this GPU are required/present",
+	"obj.noderesources.gpus:#.vendor":                                "GPU vendor (nvidia, amd, etc)",
+	"obj.noderesources.gpus:#.memory":                                "Memory in GB",
+	"obj.noderesources.gpus:#.inuse":                                 "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"obj.noderesources.optresmap":                                    "Optional resources request, i.e. optresmap=restype=resname:1",
+	"obj.noderesources.infranodeflavor":                              "Infrastructure specific node flavor",
+	"obj.noderesources.externalvolumesize":                           "Size of external volume to be attached to nodes. This is for the root partition",
+	"obj.objid":                                                      "Universally unique object ID",
+	"obj.appannotations":                                             "Internal Annotations",
+	"obj.isstandalone":                                               "A standalone App will not share a cluster with another App unless explicitly targeted to the same cluster",
+	"obj.managesownnamespaces":                                       "Specifies if the kubernetes application manages creating and deleting its own namespaces. If true, it is disallowed from deployment to multi-tenant clusters, and it is up to the application developer to manage namespace conflicts if they deploy multiple applications to the same cluster. If false, each application instance is deployed to its own namespace set by the platform.",
+	"obj.compatibilityversion":                                       "Internal compatibility version",
+	"obj.findcloudletscoreweights.distance":                          "Weight per km of distance from the client to the cloudlet",
+	"obj.findcloudletscoreweights.latency":                           "Weight per ms of average latency reported by clients of the AppInst",
+	"obj.findcloudletscoreweights.resourceusage":                     "Weight per percent used of the most used cloudlet infra resource",
+	"obj.findcloudletscoreweights.health":                            "Weight added if the AppInst health check has not reported healthy",
+	"obj.geofencepolicy":                                             "Geo-fence policy name, restricts which cloudlets may serve devices by location",
+	"obj.replicaautoscalepolicy.minreplicas":                         "Minimum number of replicas",
+	"obj.replicaautoscalepolicy.maxreplicas":                         "Maximum number of replicas",
+	"obj.replicaautoscalepolicy.targetcpu":                           "Target average cpu utilization per replica (percentage 1 to 100 of requested cpu), 0 means disabled",
+	"obj.replicaautoscalepolicy.targetmem":                           "Target average memory utilization per replica (percentage 1 to 100 of requested memory), 0 means disabled",
+	"obj.replicaautoscalepolicy.targetactiveconnections":             "Target number of active connections per replica, 0 means disabled",
+	"obj.replicaautoscalepolicy.stabilizationwindowsec":              "Stabilization window is the time for which past recommendations are considered before scaling down",
+	"obj.tags": "Vendor-specific data",
+}
+var AppHistorySpecialArgs = map[string]string{
+	"obj.alertpolicies":    "StringArray",
+	"obj.appannotations":   "StringToString",
+	"obj.autoprovpolicies": "StringArray",
+	"obj.commandargs":      "StringArray",
+	"obj.envvars":          "StringToString",
+	"obj.fields":           "StringArray",
+	"obj.kubernetesresources.cpupool.topology.minnodeoptres": "StringToString",
+	"obj.kubernetesresources.cpupool.totaloptres":            "StringToString",
+	"obj.kubernetesresources.gpupool.topology.minnodeoptres": "StringToString",
+	"obj.kubernetesresources.gpupool.totaloptres":            "StringToString",
+	"obj.noderesources.optresmap":                            "StringToString",
+	"obj.secretenvvars":                                      "StringToString",
+	"obj.tags":                                               "StringToString",
+}
 var CreateAppRequiredArgs = []string{
 	"apporg",
 	"appname",
@@ -1456,6 +1903,17 @@ var CreateAppOptionalArgs = []string{
 	"appannotations",
 	"isstandalone",
 	"managesownnamespaces",
+	"findcloudletscoreweights.distance",
+	"findcloudletscoreweights.latency",
+	"findcloudletscoreweights.resourceusage",
+	"findcloudletscoreweights.health",
+	"geofencepolicy",
+	"replicaautoscalepolicy.minreplicas",
+	"replicaautoscalepolicy.maxreplicas",
+	"replicaautoscalepolicy.targetcpu",
+	"replicaautoscalepolicy.targetmem",
+	"replicaautoscalepolicy.targetactiveconnections",
+	"replicaautoscalepolicy.stabilizationwindowsec",
 	"tags",
 }
 var DeleteAppRequiredArgs = []string{
@@ -1561,6 +2019,17 @@ var DeleteAppOptionalArgs = []string{
 	"appannotations",
 	"isstandalone",
 	"managesownnamespaces",
+	"findcloudletscoreweights.distance",
+	"findcloudletscoreweights.latency",
+	"findcloudletscoreweights.resourceusage",
+	"findcloudletscoreweights.health",
+	"geofencepolicy",
+	"replicaautoscalepolicy.minreplicas",
+	"replicaautoscalepolicy.maxreplicas",
+	"replicaautoscalepolicy.targetcpu",
+	"replicaautoscalepolicy.targetmem",
+	"replicaautoscalepolicy.targetactiveconnections",
+	"replicaautoscalepolicy.stabilizationwindowsec",
 	"tags",
 }
 var ShowAppRequiredArgs = []string{
@@ -1666,6 +2135,17 @@ var ShowAppOptionalArgs = []string{
 	"appannotations",
 	"isstandalone",
 	"managesownnamespaces",
+	"findcloudletscoreweights.distance",
+	"findcloudletscoreweights.latency",
+	"findcloudletscoreweights.resourceusage",
+	"findcloudletscoreweights.health",
+	"geofencepolicy",
+	"replicaautoscalepolicy.minreplicas",
+	"replicaautoscalepolicy.maxreplicas",
+	"replicaautoscalepolicy.targetcpu",
+	"replicaautoscalepolicy.targetmem",
+	"replicaautoscalepolicy.targetactiveconnections",
+	"replicaautoscalepolicy.stabilizationwindowsec",
 	"tags",
 }
 var ShowPublicAppRequiredArgs = []string{}
@@ -1770,5 +2250,16 @@ var ShowPublicAppOptionalArgs = []string{
 	"appannotations",
 	"isstandalone",
 	"managesownnamespaces",
+	"findcloudletscoreweights.distance",
+	"findcloudletscoreweights.latency",
+	"findcloudletscoreweights.resourceusage",
+	"findcloudletscoreweights.health",
+	"geofencepolicy",
+	"replicaautoscalepolicy.minreplicas",
+	"replicaautoscalepolicy.maxreplicas",
+	"replicaautoscalepolicy.targetcpu",
+	"replicaautoscalepolicy.targetmem",
+	"replicaautoscalepolicy.targetactiveconnections",
+	"replicaautoscalepolicy.stabilizationwindowsec",
 	"tags",
 }
@@ -497,6 +497,87 @@ func ShowAppInsts(c *cli.Command, data []edgeproto.AppInst, err *error) {
 	}
 }
 
+var MoveAppInstCmd = &cli.Command{
+	Use:          "MoveAppInst",
+	RequiredArgs: strings.Join(AppInstMoveRequiredArgs, " "),
+	OptionalArgs: strings.Join(AppInstMoveOptionalArgs, " "),
+	AliasArgs:    strings.Join(AppInstMoveAliasArgs, " "),
+	SpecialArgs:  &AppInstMoveSpecialArgs,
+	Comments:     AppInstMoveComments,
+	ReqData:      &edgeproto.AppInstMove{},
+	ReplyData:    &edgeproto.Result{},
+	Run:          runMoveAppInst,
+}
+
+func runMoveAppInst(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.AppInstMove)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return MoveAppInst(c, obj)
+}
+
+func MoveAppInst(c *cli.Command, in *edgeproto.AppInstMove) error {
+	if AppInstApiCmd == nil {
+		return fmt.Errorf("AppInstApi client not initialized")
+	}
+	ctx := context.Background()
+	stream, err := AppInstApiCmd.MoveAppInst(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("MoveAppInst failed: %s", errstr)
+	}
+
+	objs := make([]*edgeproto.Result, 0)
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errstr := err.Error()
+			st, ok := status.FromError(err)
+			if ok {
+				errstr = st.Message()
+			}
+			return fmt.Errorf("MoveAppInst recv failed: %s", errstr)
+		}
+		if cli.OutputStream {
+			c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+			continue
+		}
+		objs = append(objs, obj)
+	}
+	if len(objs) == 0 {
+		return nil
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), objs, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func MoveAppInsts(c *cli.Command, data []edgeproto.AppInstMove, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("MoveAppInst %v\n", data[ii])
+		myerr := MoveAppInst(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
 var HandleFedAppInstEventCmd = &cli.Command{
 	Use:          "HandleFedAppInstEvent",
 	RequiredArgs: strings.Join(FedAppInstEventRequiredArgs, " "),
@@ -560,6 +641,7 @@ var AppInstApiCmds = []*cobra.Command{
 	RefreshAppInstCmd.GenCmd(),
 	UpdateAppInstCmd.GenCmd(),
 	ShowAppInstCmd.GenCmd(),
+	MoveAppInstCmd.GenCmd(),
 	HandleFedAppInstEventCmd.GenCmd(),
 }
 
@@ -943,6 +1025,8 @@ var AppInstOptionalArgs = []string{
 	"noderesources.infranodeflavor",
 	"noderesources.externalvolumesize",
 	"isstandalone",
+	"replicas",
+	"dryrun",
 	"tags",
 }
 var AppInstAliasArgs = []string{
@@ -1006,6 +1090,7 @@ var AppInstComments = map[string]string{
 	"errors":                                 "Any errors trying to create, update, or delete the AppInst on the Cloudlet, specify errors:empty=true to clear",
 	"crmoverride":                            "Override actions to CRM, one of NoOverride, IgnoreCrmErrors, IgnoreCrm, IgnoreTransientState, IgnoreCrmAndTransientState",
 	"runtimeinfo.containerids":               "List of container names, specify runtimeinfo.containerids:empty=true to clear",
+	"runtimeinfo.replicas":                   "Number of running replicas for Kubernetes deployments",
 	"createdat":                              "Created at time",
 	"autoclusteripaccess":                    "(Deprecated) IpAccess for auto-clusters. Ignored otherwise., one of Unknown, Dedicated, Shared",
 	"revision":                               "Revision changes each time the App is updated.  Refreshing the App Instance will sync the revision with that of the App",
@@ -1091,6 +1176,8 @@ var AppInstComments = map[string]string{
 	"noderesources.infranodeflavor":                              "Infrastructure specific node flavor",
 	"noderesources.externalvolumesize":                           "Size of external volume to be attached to nodes. This is for the root partition",
 	"isstandalone":                                               "A standalone AppInst will not share a cluster with another AppInst unless explicitly targeted to the same cluster",
+	"replicas":                                                   "Number of replicas for Kubernetes deployments, 0 uses the App manifest default",
+	"dryrun":                                                     "Preview the create without applying it, reports the chosen cloudlet and cluster, the resources required, and why other candidates were skipped",
 	"tags":                                                       "Vendor-specific data, specify tags:empty=true to clear",
 }
 var AppInstSpecialArgs = map[string]string{
@@ -1109,10 +1196,12 @@ var AppInstSpecialArgs = map[string]string{
 var AppInstRuntimeRequiredArgs = []string{}
 var AppInstRuntimeOptionalArgs = []string{
 	"containerids",
+	"replicas",
 }
 var AppInstRuntimeAliasArgs = []string{}
 var AppInstRuntimeComments = map[string]string{
 	"containerids": "List of container names",
+	"replicas":     "Number of running replicas for Kubernetes deployments",
 }
 var AppInstRuntimeSpecialArgs = map[string]string{
 	"containerids": "StringArray",
@@ -1148,6 +1237,45 @@ var InstPortComments = map[string]string{
 	"servicename":     "Service name for Kubernetes port, use with a custom manifest or Helm chart that uses same port number on different services in the app.",
 }
 var InstPortSpecialArgs = map[string]string{}
+var AppInstMoveRequiredArgs = []string{
+	"appinstname",
+	"appinstorg",
+}
+var AppInstMoveOptionalArgs = []string{
+	"zoneorg",
+	"zone",
+	"targetzonekey.federatedorganization",
+	"cloudletorg",
+	"cloudlet",
+	"targetcloudletkey.federatedorganization",
+	"cluster",
+	"clusterorg",
+	"healthchecktimeout",
+}
+var AppInstMoveAliasArgs = []string{
+	"appinstname=key.name",
+	"appinstorg=key.organization",
+	"zoneorg=targetzonekey.organization",
+	"zone=targetzonekey.name",
+	"cloudletorg=targetcloudletkey.organization",
+	"cloudlet=targetcloudletkey.name",
+	"cluster=targetclusterkey.name",
+	"clusterorg=targetclusterkey.organization",
+}
+var AppInstMoveComments = map[string]string{
+	"appinstname":                         "App Instance name",
+	"appinstorg":                          "App Instance organization",
+	"zoneorg":                             "Organization owner of the Zone",
+	"zone":                                "Name of the Zone",
+	"targetzonekey.federatedorganization": "Federated operator organization who shared this Zone",
+	"cloudletorg":                         "Organization of the cloudlet site",
+	"cloudlet":                            "Name of the cloudlet",
+	"targetcloudletkey.federatedorganization": "Federated operator organization who shared this cloudlet",
+	"cluster":            "Cluster name",
+	"clusterorg":         "Name of the organization that this cluster belongs to",
+	"healthchecktimeout": "Time to wait for the replacement AppInst to pass health checks, defaults to 5m",
+}
+var AppInstMoveSpecialArgs = map[string]string{}
 var AppInstInfoRequiredArgs = []string{
 	"key.name",
 	"key.organization",
@@ -1157,6 +1285,7 @@ var AppInstInfoOptionalArgs = []string{
 	"state",
 	"errors",
 	"runtimeinfo.containerids",
+	"runtimeinfo.replicas",
 	"status.tasknumber",
 	"status.maxtasks",
 	"status.taskname",
@@ -1189,6 +1318,7 @@ var AppInstInfoComments = map[string]string{
 	"state":                      "Current state of the AppInst on the Cloudlet, one of TrackedStateUnknown, NotPresent, CreateRequested, Creating, CreateError, Ready, UpdateRequested, Updating, UpdateError, DeleteRequested, Deleting, DeleteError, DeletePrepare, CrmInitok, CreatingDependencies, DeleteDone",
 	"errors":                     "Any errors trying to create, update, or delete the AppInst on the Cloudlet",
 	"runtimeinfo.containerids":   "List of container names",
+	"runtimeinfo.replicas":       "Number of running replicas for Kubernetes deployments",
 	"status.tasknumber":          "Task number",
 	"status.maxtasks":            "Max tasks",
 	"status.taskname":            "Task name",
@@ -1419,6 +1549,8 @@ var CreateAppInstOptionalArgs = []string{
 	"noderesources.infranodeflavor",
 	"noderesources.externalvolumesize",
 	"isstandalone",
+	"replicas",
+	"dryrun",
 	"tags",
 }
 var DeleteAppInstRequiredArgs = []string{
@@ -1498,6 +1630,7 @@ var DeleteAppInstOptionalArgs = []string{
 	"noderesources.infranodeflavor",
 	"noderesources.externalvolumesize",
 	"isstandalone",
+	"replicas",
 	"tags",
 }
 var RefreshAppInstRequiredArgs = []string{
@@ -1573,6 +1706,7 @@ var RefreshAppInstOptionalArgs = []string{
 	"noderesources.infranodeflavor",
 	"noderesources.externalvolumesize",
 	"isstandalone",
+	"replicas",
 	"tags",
 }
 var UpdateAppInstRequiredArgs = []string{
@@ -1652,5 +1786,6 @@ var UpdateAppInstOptionalArgs = []string{
 	"noderesources.infranodeflavor",
 	"noderesources.externalvolumesize",
 	"isstandalone",
+	"replicas",
 	"tags",
 }
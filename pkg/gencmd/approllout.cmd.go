@@ -0,0 +1,911 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: approllout.proto
+
+package gencmd
+
+import (
+	"context"
+	fmt "fmt"
+	_ "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	distributed_match_engine "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cli"
+	_ "github.com/edgexr/edge-cloud-platform/tools/protogen"
+	_ "github.com/gogo/googleapis/google/api"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+	"io"
+	math "math"
+	"strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// Auto-generated code: DO NOT EDIT
+func AppRolloutHideTags(in *edgeproto.AppRollout) {
+	if cli.HideTags == "" {
+		return
+	}
+	tags := make(map[string]struct{})
+	for _, tag := range strings.Split(cli.HideTags, ",") {
+		tags[tag] = struct{}{}
+	}
+	if _, found := tags["nocmp"]; found {
+		in.App.AuthPublicKey = ""
+	}
+	for i1 := 0; i1 < len(in.App.Configs); i1++ {
+	}
+	if _, found := tags["nocmp"]; found {
+		in.App.Revision = ""
+	}
+	if _, found := tags["timestamp"]; found {
+		in.App.CreatedAt = distributed_match_engine.Timestamp{}
+	}
+	if _, found := tags["timestamp"]; found {
+		in.App.UpdatedAt = distributed_match_engine.Timestamp{}
+	}
+	for i1 := 0; i1 < len(in.App.RequiredOutboundConnections); i1++ {
+	}
+	for i3 := 0; i3 < len(in.App.KubernetesResources.CpuPool.TotalGpus); i3++ {
+	}
+	for i4 := 0; i4 < len(in.App.KubernetesResources.CpuPool.Topology.MinNodeGpus); i4++ {
+	}
+	for i3 := 0; i3 < len(in.App.KubernetesResources.GpuPool.TotalGpus); i3++ {
+	}
+	for i4 := 0; i4 < len(in.App.KubernetesResources.GpuPool.Topology.MinNodeGpus); i4++ {
+	}
+	for i2 := 0; i2 < len(in.App.NodeResources.Gpus); i2++ {
+	}
+	if _, found := tags["nocmp"]; found {
+		in.App.ObjId = ""
+	}
+	if _, found := tags["nocmp"]; found {
+		in.App.CompatibilityVersion = 0
+	}
+	for i0 := 0; i0 < len(in.Zones); i0++ {
+	}
+	for i0 := 0; i0 < len(in.Waves); i0++ {
+		for i1 := 0; i1 < len(in.Waves[i0].AppInsts); i1++ {
+		}
+	}
+	if _, found := tags["nocmp"]; found {
+		in.PreviousApp = nil
+	}
+	if _, found := tags["timestamp"]; found {
+		in.CreatedAt = distributed_match_engine.Timestamp{}
+	}
+	if _, found := tags["timestamp"]; found {
+		in.UpdatedAt = distributed_match_engine.Timestamp{}
+	}
+}
+
+var AppRolloutApiCmd edgeproto.AppRolloutApiClient
+
+var CreateAppRolloutCmd = &cli.Command{
+	Use:          "CreateAppRollout",
+	RequiredArgs: strings.Join(AppRolloutRequiredArgs, " "),
+	OptionalArgs: strings.Join(AppRolloutOptionalArgs, " "),
+	AliasArgs:    strings.Join(AppRolloutAliasArgs, " "),
+	SpecialArgs:  &AppRolloutSpecialArgs,
+	Comments:     AppRolloutComments,
+	ReqData:      &edgeproto.AppRollout{},
+	ReplyData:    &edgeproto.Result{},
+	Run:          runCreateAppRollout,
+}
+
+func runCreateAppRollout(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.AppRollout)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return CreateAppRollout(c, obj)
+}
+
+func CreateAppRollout(c *cli.Command, in *edgeproto.AppRollout) error {
+	if AppRolloutApiCmd == nil {
+		return fmt.Errorf("AppRolloutApi client not initialized")
+	}
+	ctx := context.Background()
+	obj, err := AppRolloutApiCmd.CreateAppRollout(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("CreateAppRollout failed: %s", errstr)
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func CreateAppRollouts(c *cli.Command, data []edgeproto.AppRollout, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("CreateAppRollout %v\n", data[ii])
+		myerr := CreateAppRollout(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var DeleteAppRolloutCmd = &cli.Command{
+	Use:          "DeleteAppRollout",
+	RequiredArgs: strings.Join(AppRolloutRequiredArgs, " "),
+	OptionalArgs: strings.Join(AppRolloutOptionalArgs, " "),
+	AliasArgs:    strings.Join(AppRolloutAliasArgs, " "),
+	SpecialArgs:  &AppRolloutSpecialArgs,
+	Comments:     AppRolloutComments,
+	ReqData:      &edgeproto.AppRollout{},
+	ReplyData:    &edgeproto.Result{},
+	Run:          runDeleteAppRollout,
+}
+
+func runDeleteAppRollout(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.AppRollout)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return DeleteAppRollout(c, obj)
+}
+
+func DeleteAppRollout(c *cli.Command, in *edgeproto.AppRollout) error {
+	if AppRolloutApiCmd == nil {
+		return fmt.Errorf("AppRolloutApi client not initialized")
+	}
+	ctx := context.Background()
+	obj, err := AppRolloutApiCmd.DeleteAppRollout(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("DeleteAppRollout failed: %s", errstr)
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func DeleteAppRollouts(c *cli.Command, data []edgeproto.AppRollout, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("DeleteAppRollout %v\n", data[ii])
+		myerr := DeleteAppRollout(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var PauseAppRolloutCmd = &cli.Command{
+	Use:          "PauseAppRollout",
+	RequiredArgs: strings.Join(AppRolloutRequiredArgs, " "),
+	OptionalArgs: strings.Join(AppRolloutOptionalArgs, " "),
+	AliasArgs:    strings.Join(AppRolloutAliasArgs, " "),
+	SpecialArgs:  &AppRolloutSpecialArgs,
+	Comments:     AppRolloutComments,
+	ReqData:      &edgeproto.AppRollout{},
+	ReplyData:    &edgeproto.Result{},
+	Run:          runPauseAppRollout,
+}
+
+func runPauseAppRollout(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.AppRollout)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return PauseAppRollout(c, obj)
+}
+
+func PauseAppRollout(c *cli.Command, in *edgeproto.AppRollout) error {
+	if AppRolloutApiCmd == nil {
+		return fmt.Errorf("AppRolloutApi client not initialized")
+	}
+	ctx := context.Background()
+	obj, err := AppRolloutApiCmd.PauseAppRollout(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("PauseAppRollout failed: %s", errstr)
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func PauseAppRollouts(c *cli.Command, data []edgeproto.AppRollout, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("PauseAppRollout %v\n", data[ii])
+		myerr := PauseAppRollout(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var ResumeAppRolloutCmd = &cli.Command{
+	Use:          "ResumeAppRollout",
+	RequiredArgs: strings.Join(AppRolloutRequiredArgs, " "),
+	OptionalArgs: strings.Join(AppRolloutOptionalArgs, " "),
+	AliasArgs:    strings.Join(AppRolloutAliasArgs, " "),
+	SpecialArgs:  &AppRolloutSpecialArgs,
+	Comments:     AppRolloutComments,
+	ReqData:      &edgeproto.AppRollout{},
+	ReplyData:    &edgeproto.Result{},
+	Run:          runResumeAppRollout,
+}
+
+func runResumeAppRollout(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.AppRollout)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return ResumeAppRollout(c, obj)
+}
+
+func ResumeAppRollout(c *cli.Command, in *edgeproto.AppRollout) error {
+	if AppRolloutApiCmd == nil {
+		return fmt.Errorf("AppRolloutApi client not initialized")
+	}
+	ctx := context.Background()
+	obj, err := AppRolloutApiCmd.ResumeAppRollout(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("ResumeAppRollout failed: %s", errstr)
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func ResumeAppRollouts(c *cli.Command, data []edgeproto.AppRollout, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("ResumeAppRollout %v\n", data[ii])
+		myerr := ResumeAppRollout(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var RollbackAppRolloutCmd = &cli.Command{
+	Use:          "RollbackAppRollout",
+	RequiredArgs: strings.Join(AppRolloutRequiredArgs, " "),
+	OptionalArgs: strings.Join(AppRolloutOptionalArgs, " "),
+	AliasArgs:    strings.Join(AppRolloutAliasArgs, " "),
+	SpecialArgs:  &AppRolloutSpecialArgs,
+	Comments:     AppRolloutComments,
+	ReqData:      &edgeproto.AppRollout{},
+	ReplyData:    &edgeproto.Result{},
+	Run:          runRollbackAppRollout,
+}
+
+func runRollbackAppRollout(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.AppRollout)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return RollbackAppRollout(c, obj)
+}
+
+func RollbackAppRollout(c *cli.Command, in *edgeproto.AppRollout) error {
+	if AppRolloutApiCmd == nil {
+		return fmt.Errorf("AppRolloutApi client not initialized")
+	}
+	ctx := context.Background()
+	obj, err := AppRolloutApiCmd.RollbackAppRollout(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("RollbackAppRollout failed: %s", errstr)
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func RollbackAppRollouts(c *cli.Command, data []edgeproto.AppRollout, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("RollbackAppRollout %v\n", data[ii])
+		myerr := RollbackAppRollout(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var ShowAppRolloutCmd = &cli.Command{
+	Use:          "ShowAppRollout",
+	OptionalArgs: strings.Join(append(AppRolloutRequiredArgs, AppRolloutOptionalArgs...), " "),
+	AliasArgs:    strings.Join(AppRolloutAliasArgs, " "),
+	SpecialArgs:  &AppRolloutSpecialArgs,
+	Comments:     AppRolloutComments,
+	ReqData:      &edgeproto.AppRollout{},
+	ReplyData:    &edgeproto.AppRollout{},
+	Run:          runShowAppRollout,
+}
+
+func runShowAppRollout(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.AppRollout)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return ShowAppRollout(c, obj)
+}
+
+func ShowAppRollout(c *cli.Command, in *edgeproto.AppRollout) error {
+	if AppRolloutApiCmd == nil {
+		return fmt.Errorf("AppRolloutApi client not initialized")
+	}
+	ctx := context.Background()
+	stream, err := AppRolloutApiCmd.ShowAppRollout(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("ShowAppRollout failed: %s", errstr)
+	}
+
+	objs := make([]*edgeproto.AppRollout, 0)
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errstr := err.Error()
+			st, ok := status.FromError(err)
+			if ok {
+				errstr = st.Message()
+			}
+			return fmt.Errorf("ShowAppRollout recv failed: %s", errstr)
+		}
+		AppRolloutHideTags(obj)
+		objs = append(objs, obj)
+	}
+	if len(objs) == 0 {
+		return nil
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), objs, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func ShowAppRollouts(c *cli.Command, data []edgeproto.AppRollout, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("ShowAppRollout %v\n", data[ii])
+		myerr := ShowAppRollout(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var AppRolloutApiCmds = []*cobra.Command{
+	CreateAppRolloutCmd.GenCmd(),
+	DeleteAppRolloutCmd.GenCmd(),
+	PauseAppRolloutCmd.GenCmd(),
+	ResumeAppRolloutCmd.GenCmd(),
+	RollbackAppRolloutCmd.GenCmd(),
+	ShowAppRolloutCmd.GenCmd(),
+}
+
+var AppRolloutWaveRequiredArgs = []string{}
+var AppRolloutWaveOptionalArgs = []string{
+	"appinsts:#.name",
+	"appinsts:#.organization",
+	"zonekey.organization",
+	"zonekey.name",
+	"zonekey.federatedorganization",
+}
+var AppRolloutWaveAliasArgs = []string{}
+var AppRolloutWaveComments = map[string]string{
+	"appinsts:#.name":               "App Instance name",
+	"appinsts:#.organization":       "App Instance organization",
+	"zonekey.organization":          "Organization owner of the Zone",
+	"zonekey.name":                  "Name of the Zone",
+	"zonekey.federatedorganization": "Federated operator organization who shared this Zone",
+}
+var AppRolloutWaveSpecialArgs = map[string]string{}
+var AppRolloutRequiredArgs = []string{
+	"apporg",
+	"appname",
+	"appvers",
+}
+var AppRolloutOptionalArgs = []string{
+	"app.fields",
+	"app.key.organization",
+	"app.key.name",
+	"app.key.version",
+	"app.imagepath",
+	"app.imagetype",
+	"app.accessports",
+	"app.defaultflavor.name",
+	"app.authpublickey",
+	"app.command",
+	"app.commandargs",
+	"app.annotations",
+	"app.deployment",
+	"app.deploymentmanifest",
+	"app.deploymentgenerator",
+	"app.androidpackagename",
+	"app.delopt",
+	"app.configs:#.kind",
+	"app.configs:#.config",
+	"app.scalewithcluster",
+	"app.internalports",
+	"app.revision",
+	"app.officialfqdn",
+	"app.md5sum",
+	"app.autoprovpolicy",
+	"app.accesstype",
+	"app.deleteprepare",
+	"app.autoprovpolicies",
+	"app.templatedelimiter",
+	"app.skiphcports",
+	"app.createdat",
+	"app.updatedat",
+	"app.trusted",
+	"app.requiredoutboundconnections:#.protocol",
+	"app.requiredoutboundconnections:#.portrangemin",
+	"app.requiredoutboundconnections:#.portrangemax",
+	"app.requiredoutboundconnections:#.remotecidr",
+	"app.allowserverless",
+	"app.serverlessconfig.vcpus",
+	"app.serverlessconfig.ram",
+	"app.serverlessconfig.minreplicas",
+	"app.serverlessconfig.gpuconfig.type",
+	"app.serverlessconfig.gpuconfig.model",
+	"app.serverlessconfig.gpuconfig.numgpu",
+	"app.serverlessconfig.gpuconfig.ram",
+	"app.vmappostype",
+	"app.alertpolicies",
+	"app.qossessionprofile",
+	"app.qossessionduration",
+	"app.globalid",
+	"app.envvars",
+	"app.secretenvvars",
+	"app.updatelistaction",
+	"app.kubernetesresources.cpupool.totalvcpus",
+	"app.kubernetesresources.cpupool.totalmemory",
+	"app.kubernetesresources.cpupool.totaldisk",
+	"app.kubernetesresources.cpupool.totalgpus:#.modelid",
+	"app.kubernetesresources.cpupool.totalgpus:#.count",
+	"app.kubernetesresources.cpupool.totalgpus:#.vendor",
+	"app.kubernetesresources.cpupool.totalgpus:#.memory",
+	"app.kubernetesresources.cpupool.totalgpus:#.inuse",
+	"app.kubernetesresources.cpupool.totaloptres",
+	"app.kubernetesresources.cpupool.topology.minnodevcpus",
+	"app.kubernetesresources.cpupool.topology.minnodememory",
+	"app.kubernetesresources.cpupool.topology.minnodedisk",
+	"app.kubernetesresources.cpupool.topology.minnodegpus:#.modelid",
+	"app.kubernetesresources.cpupool.topology.minnodegpus:#.count",
+	"app.kubernetesresources.cpupool.topology.minnodegpus:#.vendor",
+	"app.kubernetesresources.cpupool.topology.minnodegpus:#.memory",
+	"app.kubernetesresources.cpupool.topology.minnodegpus:#.inuse",
+	"app.kubernetesresources.cpupool.topology.minnodeoptres",
+	"app.kubernetesresources.cpupool.topology.minnumberofnodes",
+	"app.kubernetesresources.gpupool.totalvcpus",
+	"app.kubernetesresources.gpupool.totalmemory",
+	"app.kubernetesresources.gpupool.totaldisk",
+	"app.kubernetesresources.gpupool.totalgpus:#.modelid",
+	"app.kubernetesresources.gpupool.totalgpus:#.count",
+	"app.kubernetesresources.gpupool.totalgpus:#.vendor",
+	"app.kubernetesresources.gpupool.totalgpus:#.memory",
+	"app.kubernetesresources.gpupool.totalgpus:#.inuse",
+	"app.kubernetesresources.gpupool.totaloptres",
+	"app.kubernetesresources.gpupool.topology.minnodevcpus",
+	"app.kubernetesresources.gpupool.topology.minnodememory",
+	"app.kubernetesresources.gpupool.topology.minnodedisk",
+	"app.kubernetesresources.gpupool.topology.minnodegpus:#.modelid",
+	"app.kubernetesresources.gpupool.topology.minnodegpus:#.count",
+	"app.kubernetesresources.gpupool.topology.minnodegpus:#.vendor",
+	"app.kubernetesresources.gpupool.topology.minnodegpus:#.memory",
+	"app.kubernetesresources.gpupool.topology.minnodegpus:#.inuse",
+	"app.kubernetesresources.gpupool.topology.minnodeoptres",
+	"app.kubernetesresources.gpupool.topology.minnumberofnodes",
+	"app.kubernetesresources.minkubernetesversion",
+	"app.noderesources.vcpus",
+	"app.noderesources.ram",
+	"app.noderesources.disk",
+	"app.noderesources.gpus:#.modelid",
+	"app.noderesources.gpus:#.count",
+	"app.noderesources.gpus:#.vendor",
+	"app.noderesources.gpus:#.memory",
+	"app.noderesources.gpus:#.inuse",
+	"app.noderesources.optresmap",
+	"app.noderesources.infranodeflavor",
+	"app.noderesources.externalvolumesize",
+	"app.objid",
+	"app.appannotations",
+	"app.isstandalone",
+	"app.managesownnamespaces",
+	"app.compatibilityversion",
+	"app.findcloudletscoreweights.distance",
+	"app.findcloudletscoreweights.latency",
+	"app.findcloudletscoreweights.resourceusage",
+	"app.findcloudletscoreweights.health",
+	"app.geofencepolicy",
+	"app.replicaautoscalepolicy.minreplicas",
+	"app.replicaautoscalepolicy.maxreplicas",
+	"app.replicaautoscalepolicy.targetcpu",
+	"app.replicaautoscalepolicy.targetmem",
+	"app.replicaautoscalepolicy.targetactiveconnections",
+	"app.replicaautoscalepolicy.stabilizationwindowsec",
+	"app.tags",
+	"wavepercentages",
+	"wavesbyzone",
+	"zones:#.organization",
+	"zones:#.name",
+	"zones:#.federatedorganization",
+	"wavesoaktime",
+	"pausebetweenwaves",
+	"autorollback",
+}
+var AppRolloutAliasArgs = []string{
+	"apporg=key.organization",
+	"appname=key.name",
+	"appvers=key.version",
+}
+var AppRolloutComments = map[string]string{
+	"fields":                  "Fields are used for the Update API to specify which fields to apply",
+	"apporg":                  "App developer organization",
+	"appname":                 "App name",
+	"appvers":                 "App version",
+	"app.fields":              "Fields are used for the Update API to specify which fields to apply",
+	"app.key.organization":    "App developer organization",
+	"app.key.name":            "App name",
+	"app.key.version":         "App version",
+	"app.imagepath":           "URI of where image resides",
+	"app.imagetype":           "Image type, one of Unknown, Docker, Qcow, Helm, Ovf, Ova",
+	"app.accessports":         "Comma separated list of protocol:port pairs that the App listens on. Ex: tcp:80,udp:10002. Also supports additional configurations per port: (1) tls (tcp-only) - Enables TLS on specified port. Ex: tcp:443:tls. (2) nginx (udp-only) - Use NGINX LB instead of envoy for specified port. Ex: udp:10001:nginx. (3) maxpktsize (udp-only) - Configures maximum UDP datagram size allowed on port for both upstream/downstream traffic. Ex: udp:10001:maxpktsize=8000. (4) intvis (internal-visibility)- Port is not externally accessible. Ex: tcp:9000:intvis (5) id - Port ID. Ex: tcp:9000:id=p9000 (6) pathprefix (http-only) - Specifies the path prefix to use in the kubernetes ingress, required if multiple http ports are present, defaults to / (7) svcname - For Kubernetes apps, if there are multiple of the same port on different services, this denotes the service name. Ex: tcp:9000:tls:svcname=svc1",
+	"app.defaultflavor.name":  "Flavor name",
+	"app.authpublickey":       "Public key used for authentication",
+	"app.command":             "Command that the container runs to start service, separate multiple commands by a space",
+	"app.commandargs":         "Command args to append to command, on cli specify multiple times in order",
+	"app.annotations":         "Annotations is a comma separated map of arbitrary key value pairs, for example: key1=val1,key2=val2,key3=val 3",
+	"app.deployment":          "Deployment type (kubernetes, docker, or vm)",
+	"app.deploymentmanifest":  "Deployment manifest is the deployment specific manifest file/config. For docker deployment, this can be a docker-compose or docker run file. For kubernetes deployment, this can be a kubernetes yaml or helm chart file.",
+	"app.deploymentgenerator": "Deployment generator target to generate a basic deployment manifest",
+	"app.androidpackagename":  "Android package name used to match the App name from the Android package",
+	"app.delopt":              "Override actions to Controller, one of NoAutoDelete, AutoDelete",
+	"app.configs:#.kind":      "Kind (type) of config, i.e. envVarsYaml, helmCustomizationYaml",
+	"app.configs:#.config":    "Config file contents or URI reference",
+	"app.scalewithcluster":    "True indicates App runs on all nodes of the cluster as it scales",
+	"app.internalports":       "True indicates App is used internally with other Apps only, and no ports are exposed externally",
+	"app.revision":            "Revision can be specified or defaults to current timestamp when app is updated",
+	"app.officialfqdn":        "Official FQDN is the FQDN that the app uses to connect by default",
+	"app.md5sum":              "MD5Sum of the VM-based app image",
+	"app.autoprovpolicy":      "(_deprecated_) Auto provisioning policy name",
+	"app.accesstype":          "(_deprecated_) Access type, one of DefaultForDeployment, Direct, LoadBalancer",
+	"app.deleteprepare":       "Preparing to be deleted",
+	"app.autoprovpolicies":    "Auto provisioning policy names, may be specified multiple times",
+	"app.templatedelimiter":   "Delimiter to be used for template parsing, defaults to [[ ]]",
+	"app.skiphcports":         "Comma separated list of protocol:port pairs that we should not run health check on. Should be configured in case app does not always listen on these ports. all can be specified if no health check to be run for this app. Numerical values must be decimal format. i.e. tcp:80,udp:10002",
+	"app.createdat":           "Created at time",
+	"app.updatedat":           "Updated at time",
+	"app.trusted":             "Indicates that an instance of this app can be started on a trusted cloudlet",
+	"app.requiredoutboundconnections:#.protocol":                     "TCP, UDP, ICMP",
+	"app.requiredoutboundconnections:#.portrangemin":                 "TCP or UDP port range start",
+	"app.requiredoutboundconnections:#.portrangemax":                 "TCP or UDP port range end",
+	"app.requiredoutboundconnections:#.remotecidr":                   "Remote CIDR X.X.X.X/X for IPv4 or e.g. XXXX:XXXX::XXXX/XX for IPv6",
+	"app.allowserverless":                                            "App is allowed to deploy as serverless containers",
+	"app.serverlessconfig.vcpus":                                     "Virtual CPUs allocation per container when serverless, may be decimal in increments of 0.001",
+	"app.serverlessconfig.ram":                                       "RAM allocation in megabytes per container when serverless",
+	"app.serverlessconfig.minreplicas":                               "Minimum number of replicas when serverless",
+	"app.serverlessconfig.gpuconfig.type":                            "GPU Type, one of None, Any, Vgpu, Pci",
+	"app.serverlessconfig.gpuconfig.model":                           "Model name or vgpu type",
+	"app.serverlessconfig.gpuconfig.numgpu":                          "Number of instances",
+	"app.serverlessconfig.gpuconfig.ram":                             "required memory in megabytes",
+	"app.vmappostype":                                                "OS Type for VM Apps, one of Unknown, Linux, Windows10, Windows2012, Windows2016, Windows2019",
+	"app.alertpolicies":                                              "Alert Policies",
+	"app.qossessionprofile":                                          "Qualifier for the requested latency profile, one of NoPriority, LowLatency, ThroughputDownS, ThroughputDownM, ThroughputDownL",
+	"app.qossessionduration":                                         "Session duration in seconds. Maximal value of 24 hours is used if not set",
+	"app.globalid":                                                   "A globally unique id for the App to be used with federation",
+	"app.envvars":                                                    "Environment variables",
+	"app.secretenvvars":                                              "Environment variables with sensitive information, stored in encrypted storage",
+	"app.updatelistaction":                                           "For updating list and map fields, set to add, remove, or replace to define how to resolve specified entries against existing entries",
+	"app.kubernetesresources.cpupool.totalvcpus":                     "Total Vcpus to be allocated in the pool, in increments of 0.001",
+	"app.kubernetesresources.cpupool.totalmemory":                    "Total RAM in megabytes to be allocated in the pool",
+	"app.kubernetesresources.cpupool.totaldisk":                      "Total Disk in gigabytes to be allocated in the pool",
+	"app.kubernetesresources.cpupool.totalgpus:#.modelid":            "GPU model unique identifier",
+	"app.kubernetesresources.cpupool.totalgpus:#.count":              "Count of how many of this GPU are required/present",
+	"app.kubernetesresources.cpupool.totalgpus:#.vendor":             "GPU vendor (nvidia, amd, etc)",
+	"app.kubernetesresources.cpupool.totalgpus:#.memory":             "Memory in GB",
+	"app.kubernetesresources.cpupool.totalgpus:#.inuse":              "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"app.kubernetesresources.cpupool.totaloptres":                    "Total optional resources to be allocated in the pool, follows the NodeResources.OptResMap format.",
+	"app.kubernetesresources.cpupool.topology.minnodevcpus":          "Minimum number of vcpus per node",
+	"app.kubernetesresources.cpupool.topology.minnodememory":         "Minimum amount of RAM in megabytes per node",
+	"app.kubernetesresources.cpupool.topology.minnodedisk":           "Minimum amount of root partition disk space in gigabytes per node",
+	"app.kubernetesresources.cpupool.topology.minnodegpus:#.modelid": "GPU model unique identifier",
+	"app.kubernetesresources.cpupool.topology.minnodegpus:#.count":   "Count of how many of this GPU are required/present",
+	"app.kubernetesresources.cpupool.topology.minnodegpus:#.vendor":  "GPU vendor (nvidia, amd, etc)",
+	"app.kubernetesresources.cpupool.topology.minnodegpus:#.memory":  "Memory in GB",
+	"app.kubernetesresources.cpupool.topology.minnodegpus:#.inuse":   "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"app.kubernetesresources.cpupool.topology.minnodeoptres":         "Minimum number of optional resources per node",
+	"app.kubernetesresources.cpupool.topology.minnumberofnodes":      "Minimum number of nodes in pool, to satisfy HA/replication requirements",
+	"app.kubernetesresources.gpupool.totalvcpus":                     "Total Vcpus to be allocated in the pool, in increments of 0.001",
+	"app.kubernetesresources.gpupool.totalmemory":                    "Total RAM in megabytes to be allocated in the pool",
+	"app.kubernetesresources.gpupool.totaldisk":                      "Total Disk in gigabytes to be allocated in the pool",
+	"app.kubernetesresources.gpupool.totalgpus:#.modelid":            "GPU model unique identifier",
+	"app.kubernetesresources.gpupool.totalgpus:#.count":              "Count of how many of this GPU are required/present",
+	"app.kubernetesresources.gpupool.totalgpus:#.vendor":             "GPU vendor (nvidia, amd, etc)",
+	"app.kubernetesresources.gpupool.totalgpus:#.memory":             "Memory in GB",
+	"app.kubernetesresources.gpupool.totalgpus:#.inuse":              "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"app.kubernetesresources.gpupool.totaloptres":                    "Total optional resources to be allocated in the pool, follows the NodeResources.OptResMap format.",
+	"app.kubernetesresources.gpupool.topology.minnodevcpus":          "Minimum number of vcpus per node",
+	"app.kubernetesresources.gpupool.topology.minnodememory":         "Minimum amount of RAM in megabytes per node",
+	"app.kubernetesresources.gpupool.topology.minnodedisk":           "Minimum amount of root partition disk space in gigabytes per node",
+	"app.kubernetesresources.gpupool.topology.minnodegpus:#.modelid": "GPU model unique identifier",
+	"app.kubernetesresources.gpupool.topology.minnodegpus:#.count":   "Count of how many of this GPU are required/present",
+	"app.kubernetesresources.gpupool.topology.minnodegpus:#.vendor":  "GPU vendor (nvidia, amd, etc)",
+	"app.kubernetesresources.gpupool.topology.minnodegpus:#.memory":  "Memory in GB",
+	"app.kubernetesresources.gpupool.topology.minnodegpus:#.inuse":   "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"app.kubernetesresources.gpupool.topology.minnodeoptres":         "Minimum number of optional resources per node",
+	"app.kubernetesresources.gpupool.topology.minnumberofnodes":      "Minimum number of nodes in pool, to satisfy HA/replication requirements",
+	"app.kubernetesresources.minkubernetesversion":                   "Minimum Kubernetes version",
+	"app.noderesources.vcpus":                                        "Vcpus to be allocated to the VM, must be either 1 or an even number",
+	"app.noderesources.ram":                                          "Total RAM in megabytes to be allocated to the VM",
+	"app.noderesources.disk":                                         "Total disk space in gigabytes to be allocated to the VMs root partition",
+	"app.noderesources.gpus:#.modelid":                               "GPU model unique identifier",
+	"app.noderesources.gpus:#.count":                                 "Count of how many of this GPU are required/present",
+	"app.noderesources.gpus:#.vendor":                                "GPU vendor (nvidia, amd, etc)",
+	"app.noderesources.gpus:#.memory":                                "Memory in GB",
+	"app.noderesources.gpus:#.inuse":                                 "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"app.noderesources.optresmap":                                    "Optional resources request, i.e. optresmap=restype=resname:1",
+	"app.noderesources.infranodeflavor":                              "Infrastructure specific node flavor",
+	"app.noderesources.externalvolumesize":                           "Size of external volume to be attached to nodes. This is for the root partition",
+	"app.objid":                                                      "Universally unique object ID",
+	"app.appannotations":                                             "Internal Annotations",
+	"app.isstandalone":                                               "A standalone App will not share a cluster with another App unless explicitly targeted to the same cluster",
+	"app.managesownnamespaces":                                       "Specifies if the kubernetes application manages creating and deleting its own namespaces. If true, it is disallowed from deployment to multi-tenant clusters, and it is up to the application developer to manage namespace conflicts if they deploy multiple applications to the same cluster. If false, each application instance is deployed to its own namespace set by the platform.",
+	"app.compatibilityversion":                                       "Internal compatibility version",
+	"app.findcloudletscoreweights.distance":                          "Weight per km of distance from the client to the cloudlet",
+	"app.findcloudletscoreweights.latency":                           "Weight per ms of average latency reported by clients of the AppInst",
+	"app.findcloudletscoreweights.resourceusage":                     "Weight per percent used of the most used cloudlet infra resource",
+	"app.findcloudletscoreweights.health":                            "Weight added if the AppInst health check has not reported healthy",
+	"app.geofencepolicy":                                             "Geo-fence policy name, restricts which cloudlets may serve devices by location",
+	"app.replicaautoscalepolicy.minreplicas":                         "Minimum number of replicas",
+	"app.replicaautoscalepolicy.maxreplicas":                         "Maximum number of replicas",
+	"app.replicaautoscalepolicy.targetcpu":                           "Target average cpu utilization per replica (percentage 1 to 100 of requested cpu), 0 means disabled",
+	"app.replicaautoscalepolicy.targetmem":                           "Target average memory utilization per replica (percentage 1 to 100 of requested memory), 0 means disabled",
+	"app.replicaautoscalepolicy.targetactiveconnections":             "Target number of active connections per replica, 0 means disabled",
+	"app.replicaautoscalepolicy.stabilizationwindowsec":              "Stabilization window is the time for which past recommendations are considered before scaling down",
+	"app.tags":                                                       "Vendor-specific data",
+	"wavepercentages":                                                "Cumulative percentages of App Instances updated by each wave, i.e. 10,50,100. Defaults to a single wave of all App Instances",
+	"wavesbyzone":                                                    "Update the App Instances one zone per wave, instead of by percentage",
+	"zones:#.organization":                                           "Organization owner of the Zone",
+	"zones:#.name":                                                   "Name of the Zone",
+	"zones:#.federatedorganization":                                  "Federated operator organization who shared this Zone",
+	"wavesoaktime":                                                   "Time to monitor health checks and alerts after each wave before continuing, defaults to 1m",
+	"pausebetweenwaves":                                              "Pause after each wave until resumed",
+	"autorollback":                                                   "Automatically roll back to the previous App definition if health checks fail or alerts fire",
+	"state":                                                          "State of the rollout, one of Unknown, InProgress, Paused, Completed, RollingBack, RolledBack, Failed",
+	"wavesdone":                                                      "Number of waves completed",
+	"waves:#.appinsts:#.name":                                        "App Instance name",
+	"waves:#.appinsts:#.organization":                                "App Instance organization",
+	"waves:#.zonekey.organization":                                   "Organization owner of the Zone",
+	"waves:#.zonekey.name":                                           "Name of the Zone",
+	"waves:#.zonekey.federatedorganization":                          "Federated operator organization who shared this Zone",
+	"revision":                                                       "App revision being rolled out",
+	"previousapp.fields":                                             "Fields are used for the Update API to specify which fields to apply",
+	"previousapp.key.organization":                                   "App developer organization",
+	"previousapp.key.name":                                           "App name",
+	"previousapp.key.version":                                        "App version",
+	"previousapp.imagepath":                                          "URI of where image resides",
+	"previousapp.imagetype":                                          "Image type, one of Unknown, Docker, Qcow, Helm, Ovf, Ova",
+	"previousapp.accessports":                                        "Comma separated list of protocol:port pairs that the App listens on. Ex: tcp:80,udp:10002. Also supports additional configurations per port: (1) tls (tcp-only) - Enables TLS on specified port. Ex: tcp:443:tls. (2) nginx (udp-only) - Use NGINX LB instead of envoy for specified port. Ex: udp:10001:nginx. (3) maxpktsize (udp-only) - Configures maximum UDP datagram size allowed on port for both upstream/downstream traffic. Ex: udp:10001:maxpktsize=8000. (4) intvis (internal-visibility)- Port is not externally accessible. Ex: tcp:9000:intvis (5) id - Port ID. Ex: tcp:9000:id=p9000 (6) pathprefix (http-only) - Specifies the path prefix to use in the kubernetes ingress, required if multiple http ports are present, defaults to / (7) svcname - For Kubernetes apps, if there are multiple of the same port on different services, this denotes the service name. Ex: tcp:9000:tls:svcname=svc1",
+	"previousapp.defaultflavor.name":                                 "Flavor name",
+	"previousapp.authpublickey":                                      "Public key used for authentication",
+	"previousapp.command":                                            "Command that the container runs to start service, separate multiple commands by a space",
+	"previousapp.commandargs":                                        "Command args to append to command, on cli specify multiple times in order",
+	"previousapp.annotations":                                        "Annotations is a comma separated map of arbitrary key value pairs, for example: key1=val1,key2=val2,key3=val 3",
+	"previousapp.deployment":                                         "Deployment type (kubernetes, docker, or vm)",
+	"previousapp.deploymentmanifest":                                 "Deployment manifest is the deployment specific manifest file/config. For docker deployment, this can be a docker-compose or docker run file. For kubernetes deployment, this can be a kubernetes yaml or helm chart file.",
+	"previousapp.deploymentgenerator":                                "Deployment generator target to generate a basic deployment manifest",
+	"previousapp.androidpackagename":                                 "Android package name used to match the App name from the Android package",
+	"previousapp.delopt":                                             "Override actions to Controller, one of NoAutoDelete, AutoDelete",
+	"previousapp.configs:#.kind":                                     "Kind (type) of config, i.e. envVarsYaml, helmCustomizationYaml",
+	"previousapp.configs:#.config":                                   "Config file contents or URI reference",
+	"previousapp.scalewithcluster":                                   "True indicates App runs on all nodes of the cluster as it scales",
+	"previousapp.internalports":                                      "True indicates App is used internally with other Apps only, and no ports are exposed externally",
+	"previousapp.revision":                                           "Revision can be specified or defaults to current timestamp when app is updated",
+	"previousapp.officialfqdn":                                       "Official FQDN is the FQDN that the app uses to connect by default",
+	"previousapp.md5sum":                                             "MD5Sum of the VM-based app image",
+	"previousapp.autoprovpolicy":                                     "(_deprecated_) Auto provisioning policy name",
+	"previousapp.accesstype":                                         "(_deprecated_) Access type, one of DefaultForDeployment, Direct, LoadBalancer",
+	"previousapp.deleteprepare":                                      "Preparing to be deleted",
+	"previousapp.autoprovpolicies":                                   "Auto provisioning policy names, may be specified multiple times",
+	"previousapp.templatedelimiter":                                  "Delimiter to be used for template parsing, defaults to [[ ]]",
+	"previousapp.skiphcports":                                        "Comma separated list of protocol:port pairs that we should not run health check on. Should be configured in case app does not always listen on these ports. all can be specified if no health check to be run for this app. Numerical values must be decimal format. i.e. tcp:80,udp:10002",
+	"previousapp.createdat":                                          "Created at time",
+	"previousapp.updatedat":                                          "Updated at time",
+	"previousapp.trusted":                                            "Indicates that an instance of this app can be started on a trusted cloudlet",
+	"previousapp.requiredoutboundconnections:#.protocol":             "TCP, UDP, ICMP",
+	"previousapp.requiredoutboundconnections:#.portrangemin":         "TCP or UDP port range start",
+	"previousapp.requiredoutboundconnections:#.portrangemax":         "TCP or UDP port range end",
+	"previousapp.requiredoutboundconnections:#.remotecidr":           "Remote CIDR X.X.X.X/X for IPv4 or e.g. XXXX:XXXX::XXXX/XX for IPv6",
+	"previousapp.allowserverless":                                    "App is allowed to deploy as serverless containers",
+	"previousapp.serverlessconfig.vcpus":                             "Virtual CPUs allocation per container when serverless, may be decimal in increments of 0.001",
+	"previousapp.serverlessconfig.ram":                               "RAM allocation in megabytes per container when serverless",
+	"previousapp.serverlessconfig.minreplicas":                       "Minimum number of replicas when serverless",
+	"previousapp.serverlessconfig.gpuconfig.type":                    "GPU Type, one of None, Any, Vgpu, Pci",
+	"previousapp.serverlessconfig.gpuconfig.model":                   "Model name or vgpu type",
+	"previousapp.serverlessconfig.gpuconfig.numgpu":                  "Number of instances",
+	"previousapp.serverlessconfig.gpuconfig.ram":                     "required memory in megabytes",
+	"previousapp.vmappostype":                                        "OS Type for VM Apps, one of Unknown, Linux, Windows10, Windows2012, Windows2016, Windows2019",
+	"previousapp.alertpolicies":                                      "Alert Policies",
+	"previousapp.qossessionprofile":                                  "Qualifier for the requested latency profile, one of NoPriority, LowLatency, ThroughputDownS, ThroughputDownM, ThroughputDownL",
+	"previousapp.qossessionduration":                                 "Session duration in seconds. Maximal value of 24 hours is used if not set",
+	"previousapp.globalid":                                           "A globally unique id for the App to be used with federation",
+	"previousapp.envvars":                                            "Environment variables",
+	"previousapp.secretenvvars":                                      "Environment variables with sensitive information, stored in encrypted storage",
+	"previousapp.updatelistaction":                                   "For updating list and map fields, set to add, remove, or replace to define how to resolve specified entries against existing entries",
+	"previousapp.kubernetesresources.cpupool.totalvcpus":             "Total Vcpus to be allocated in the pool, in increments of 0.001",
+	"previousapp.kubernetesresources.cpupool.totalmemory":            "Total RAM in megabytes to be allocated in the pool",
+	"previousapp.kubernetesresources.cpupool.totaldisk":              "Total Disk in gigabytes to be allocated in the pool",
+	"previousapp.kubernetesresources.cpupool.totalgpus:#.modelid":    "GPU model unique identifier",
+	"previousapp.kubernetesresources.cpupool.totalgpus:#.count":      "Count of how many of this GPU are required/present",
+	"previousapp.kubernetesresources.cpupool.totalgpus:#.vendor":     "GPU vendor (nvidia, amd, etc)",
+	"previousapp.kubernetesresources.cpupool.totalgpus:#.memory":     "Memory in GB",
+	"previousapp.kubernetesresources.cpupool.totalgpus:#.inuse":      "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"previousapp.kubernetesresources.cpupool.totaloptres":            "Total optional resources to be allocated in the pool, follows the NodeResources.OptResMap format.",
+	"previousapp.kubernetesresources.cpupool.topology.minnodevcpus":  "Minimum number of vcpus per node",
+	"previousapp.kubernetesresources.cpupool.topology.minnodememory": "Minimum amount of RAM in megabytes per node",
+	"previousapp.kubernetesresources.cpupool.topology.minnodedisk":   "Minimum amount of root partition disk space in gigabytes per node",
+	"previousapp.kubernetesresources.cpupool.topology.minnodegpus:#.modelid": "GPU model unique identifier",
+	"previousapp.kubernetesresources.cpupool.topology.minnodegpus:#.count":   "Count of how many of this GPU are required/present",
+	"previousapp.kubernetesresources.cpupool.topology.minnodegpus:#.vendor":  "GPU vendor (nvidia, amd, etc)",
+	"previousapp.kubernetesresources.cpupool.topology.minnodegpus:#.memory":  "Memory in GB",
+	"previousapp.kubernetesresources.cpupool.topology.minnodegpus:#.inuse":   "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"previousapp.kubernetesresources.cpupool.topology.minnodeoptres":         "Minimum number of optional resources per node",
+	"previousapp.kubernetesresources.cpupool.topology.minnumberofnodes":      "Minimum number of nodes in pool, to satisfy HA/replication requirements",
+	"previousapp.kubernetesresources.gpupool.totalvcpus":                     "Total Vcpus to be allocated in the pool, in increments of 0.001",
+	"previousapp.kubernetesresources.gpupool.totalmemory":                    "Total RAM in megabytes to be allocated in the pool",
+	"previousapp.kubernetesresources.gpupool.totaldisk":                      "Total Disk in gigabytes to be allocated in the pool",
+	"previousapp.kubernetesresources.gpupool.totalgpus:#.modelid":            "GPU model unique identifier",
+	"previousapp.kubernetesresources.gpupool.totalgpus:#.count":              "Count of how many of this GPU are required/present",
+	"previousapp.kubernetesresources.gpupool.totalgpus:#.vendor":             "GPU vendor (nvidia, amd, etc)",
+	"previousapp.kubernetesresources.gpupool.totalgpus:#.memory":             "Memory in GB",
+	"previousapp.kubernetesresources.gpupool.totalgpus:#.inuse":              "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"previousapp.kubernetesresources.gpupool.totaloptres":                    "Total optional resources to be allocated in the pool, follows the NodeResources.OptResMap format.",
+	"previousapp.kubernetesresources.gpupool.topology.minnodevcpus":          "Minimum number of vcpus per node",
+	"previousapp.kubernetesresources.gpupool.topology.minnodememory":         "Minimum amount of RAM in megabytes per node",
+	"previousapp.kubernetesresources.gpupool.topology.minnodedisk":           "Minimum amount of root partition disk space in gigabytes per node",
+	"previousapp.kubernetesresources.gpupool.topology.minnodegpus:#.modelid": "GPU model unique identifier",
+	"previousapp.kubernetesresources.gpupool.topology.minnodegpus:#.count":   "Count of how many of this GPU are required/present",
+	"previousapp.kubernetesresources.gpupool.topology.minnodegpus:#.vendor":  "GPU vendor (nvidia, amd, etc)",
+	"previousapp.kubernetesresources.gpupool.topology.minnodegpus:#.memory":  "Memory in GB",
+	"previousapp.kubernetesresources.gpupool.topology.minnodegpus:#.inuse":   "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"previousapp.kubernetesresources.gpupool.topology.minnodeoptres":         "Minimum number of optional resources per node",
+	"previousapp.kubernetesresources.gpupool.topology.minnumberofnodes":      "Minimum number of nodes in pool, to satisfy HA/replication requirements",
+	"previousapp.kubernetesresources.minkubernetesversion":                   "Minimum Kubernetes version",
+	"previousapp.noderesources.vcpus":                                        "Vcpus to be allocated to the VM, must be either 1 or an even number",
+	"previousapp.noderesources.ram":                                          "Total RAM in megabytes to be allocated to the VM",
+	"previousapp.noderesources.disk":                                         "Total disk space in gigabytes to be allocated to the VMs root partition",
+	"previousapp.noderesources.gpus:#.modelid":                               "GPU model unique identifier",
+	"previousapp.noderesources.gpus:#.count":                                 "Count of how many of this GPU are required/present",
+	"previousapp.noderesources.gpus:#.vendor":                                "GPU vendor (nvidia, amd, etc)",
+	"previousapp.noderesources.gpus:#.memory":                                "Memory in GB",
+	"previousapp.noderesources.gpus:#.inuse":                                 "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"previousapp.noderesources.optresmap":                                    "Optional resources request, i.e. optresmap=restype=resname:1",
+	"previousapp.noderesources.infranodeflavor":                              "Infrastructure specific node flavor",
+	"previousapp.noderesources.externalvolumesize":                           "Size of external volume to be attached to nodes. This is for the root partition",
+	"previousapp.objid":                                          "Universally unique object ID",
+	"previousapp.appannotations":                                 "Internal Annotations",
+	"previousapp.isstandalone":                                   "A standalone App will not share a cluster with another App unless explicitly targeted to the same cluster",
+	"previousapp.managesownnamespaces":                           "Specifies if the kubernetes application manages creating and deleting its own namespaces. If true, it is disallowed from deployment to multi-tenant clusters, and it is up to the application developer to manage namespace conflicts if they deploy multiple applications to the same cluster. If false, each application instance is deployed to its own namespace set by the platform.",
+	"previousapp.compatibilityversion":                           "Internal compatibility version",
+	"previousapp.findcloudletscoreweights.distance":              "Weight per km of distance from the client to the cloudlet",
+	"previousapp.findcloudletscoreweights.latency":               "Weight per ms of average latency reported by clients of the AppInst",
+	"previousapp.findcloudletscoreweights.resourceusage":         "Weight per percent used of the most used cloudlet infra resource",
+	"previousapp.findcloudletscoreweights.health":                "Weight added if the AppInst health check has not reported healthy",
+	"previousapp.geofencepolicy":                                 "Geo-fence policy name, restricts which cloudlets may serve devices by location",
+	"previousapp.replicaautoscalepolicy.minreplicas":             "Minimum number of replicas",
+	"previousapp.replicaautoscalepolicy.maxreplicas":             "Maximum number of replicas",
+	"previousapp.replicaautoscalepolicy.targetcpu":               "Target average cpu utilization per replica (percentage 1 to 100 of requested cpu), 0 means disabled",
+	"previousapp.replicaautoscalepolicy.targetmem":               "Target average memory utilization per replica (percentage 1 to 100 of requested memory), 0 means disabled",
+	"previousapp.replicaautoscalepolicy.targetactiveconnections": "Target number of active connections per replica, 0 means disabled",
+	"previousapp.replicaautoscalepolicy.stabilizationwindowsec":  "Stabilization window is the time for which past recommendations are considered before scaling down",
+	"previousapp.tags":                                           "Vendor-specific data",
+	"errors":                                                     "Any errors that paused or rolled back the rollout",
+	"createdat":                                                  "Created at time",
+	"updatedat":                                                  "Updated at time",
+}
+var AppRolloutSpecialArgs = map[string]string{
+	"app.alertpolicies":    "StringArray",
+	"app.appannotations":   "StringToString",
+	"app.autoprovpolicies": "StringArray",
+	"app.commandargs":      "StringArray",
+	"app.envvars":          "StringToString",
+	"app.fields":           "StringArray",
+	"app.kubernetesresources.cpupool.topology.minnodeoptres": "StringToString",
+	"app.kubernetesresources.cpupool.totaloptres":            "StringToString",
+	"app.kubernetesresources.gpupool.topology.minnodeoptres": "StringToString",
+	"app.kubernetesresources.gpupool.totaloptres":            "StringToString",
+	"app.noderesources.optresmap":                            "StringToString",
+	"app.secretenvvars":                                      "StringToString",
+	"app.tags":                                               "StringToString",
+	"errors":                                                 "StringArray",
+	"fields":                                                 "StringArray",
+	"previousapp.alertpolicies":                              "StringArray",
+	"previousapp.appannotations":                             "StringToString",
+	"previousapp.autoprovpolicies":                           "StringArray",
+	"previousapp.commandargs":                                "StringArray",
+	"previousapp.envvars":                                    "StringToString",
+	"previousapp.fields":                                     "StringArray",
+	"previousapp.kubernetesresources.cpupool.topology.minnodeoptres": "StringToString",
+	"previousapp.kubernetesresources.cpupool.totaloptres":            "StringToString",
+	"previousapp.kubernetesresources.gpupool.topology.minnodeoptres": "StringToString",
+	"previousapp.kubernetesresources.gpupool.totaloptres":            "StringToString",
+	"previousapp.noderesources.optresmap":                            "StringToString",
+	"previousapp.secretenvvars":                                      "StringToString",
+	"previousapp.tags":                                               "StringToString",
+}
@@ -290,6 +290,83 @@ func ShowAutoProvPolicys(c *cli.Command, data []edgeproto.AutoProvPolicy, err *e
 	}
 }
 
+var ShowAutoProvPolicyHistoryCmd = &cli.Command{
+	Use:          "ShowAutoProvPolicyHistory",
+	RequiredArgs: strings.Join(AutoProvPolicyRequiredArgs, " "),
+	OptionalArgs: strings.Join(AutoProvPolicyOptionalArgs, " "),
+	AliasArgs:    strings.Join(AutoProvPolicyAliasArgs, " "),
+	SpecialArgs:  &AutoProvPolicySpecialArgs,
+	Comments:     AutoProvPolicyComments,
+	ReqData:      &edgeproto.AutoProvPolicy{},
+	ReplyData:    &edgeproto.AutoProvPolicyHistory{},
+	Run:          runShowAutoProvPolicyHistory,
+}
+
+func runShowAutoProvPolicyHistory(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.AutoProvPolicy)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return ShowAutoProvPolicyHistory(c, obj)
+}
+
+func ShowAutoProvPolicyHistory(c *cli.Command, in *edgeproto.AutoProvPolicy) error {
+	if AutoProvPolicyApiCmd == nil {
+		return fmt.Errorf("AutoProvPolicyApi client not initialized")
+	}
+	ctx := context.Background()
+	stream, err := AutoProvPolicyApiCmd.ShowAutoProvPolicyHistory(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("ShowAutoProvPolicyHistory failed: %s", errstr)
+	}
+
+	objs := make([]*edgeproto.AutoProvPolicyHistory, 0)
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errstr := err.Error()
+			st, ok := status.FromError(err)
+			if ok {
+				errstr = st.Message()
+			}
+			return fmt.Errorf("ShowAutoProvPolicyHistory recv failed: %s", errstr)
+		}
+		objs = append(objs, obj)
+	}
+	if len(objs) == 0 {
+		return nil
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), objs, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func ShowAutoProvPolicyHistorys(c *cli.Command, data []edgeproto.AutoProvPolicy, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("ShowAutoProvPolicyHistory %v\n", data[ii])
+		myerr := ShowAutoProvPolicyHistory(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
 var AddAutoProvPolicyZoneCmd = &cli.Command{
 	Use:          "AddAutoProvPolicyZone",
 	RequiredArgs: strings.Join(AutoProvPolicyZoneRequiredArgs, " "),
@@ -409,6 +486,7 @@ var AutoProvPolicyApiCmds = []*cobra.Command{
 	DeleteAutoProvPolicyCmd.GenCmd(),
 	UpdateAutoProvPolicyCmd.GenCmd(),
 	ShowAutoProvPolicyCmd.GenCmd(),
+	ShowAutoProvPolicyHistoryCmd.GenCmd(),
 	AddAutoProvPolicyZoneCmd.GenCmd(),
 	RemoveAutoProvPolicyZoneCmd.GenCmd(),
 }
@@ -528,6 +606,43 @@ var AutoProvPolicyZoneComments = map[string]string{
 	"federatedorg": "Federated operator organization who shared this Zone",
 }
 var AutoProvPolicyZoneSpecialArgs = map[string]string{}
+var AutoProvPolicyHistoryRequiredArgs = []string{}
+var AutoProvPolicyHistoryOptionalArgs = []string{
+	"revision",
+	"obj.fields",
+	"obj.key.organization",
+	"obj.key.name",
+	"obj.deployclientcount",
+	"obj.deployintervalcount",
+	"obj.zones:#.organization",
+	"obj.zones:#.name",
+	"obj.zones:#.federatedorganization",
+	"obj.minactiveinstances",
+	"obj.maxinstances",
+	"obj.undeployclientcount",
+	"obj.undeployintervalcount",
+	"obj.deleteprepare",
+}
+var AutoProvPolicyHistoryAliasArgs = []string{}
+var AutoProvPolicyHistoryComments = map[string]string{
+	"revision":                          "Database revision of the change",
+	"obj.fields":                        "Fields are used for the Update API to specify which fields to apply",
+	"obj.key.organization":              "Name of the organization for the cluster that this policy will apply to",
+	"obj.key.name":                      "Policy name",
+	"obj.deployclientcount":             "Minimum number of clients within the auto deploy interval to trigger deployment",
+	"obj.deployintervalcount":           "Number of intervals to check before triggering deployment",
+	"obj.zones:#.organization":          "Organization owner of the Zone",
+	"obj.zones:#.name":                  "Name of the Zone",
+	"obj.zones:#.federatedorganization": "Federated operator organization who shared this Zone",
+	"obj.minactiveinstances":            "Minimum number of active instances for High-Availability",
+	"obj.maxinstances":                  "Maximum number of instances (active or not)",
+	"obj.undeployclientcount":           "Number of active clients for the undeploy interval below which trigers undeployment, 0 (default) disables auto undeploy",
+	"obj.undeployintervalcount":         "Number of intervals to check before triggering undeployment",
+	"obj.deleteprepare":                 "Preparing to be deleted",
+}
+var AutoProvPolicyHistorySpecialArgs = map[string]string{
+	"obj.fields": "StringArray",
+}
 var AutoProvInfoRequiredArgs = []string{
 	"key.organization",
 	"key.name",
@@ -275,11 +275,89 @@ func ShowAutoScalePolicys(c *cli.Command, data []edgeproto.AutoScalePolicy, err
 	}
 }
 
+var ShowAutoScalePolicyHistoryCmd = &cli.Command{
+	Use:          "ShowAutoScalePolicyHistory",
+	RequiredArgs: strings.Join(AutoScalePolicyRequiredArgs, " "),
+	OptionalArgs: strings.Join(AutoScalePolicyOptionalArgs, " "),
+	AliasArgs:    strings.Join(AutoScalePolicyAliasArgs, " "),
+	SpecialArgs:  &AutoScalePolicySpecialArgs,
+	Comments:     AutoScalePolicyComments,
+	ReqData:      &edgeproto.AutoScalePolicy{},
+	ReplyData:    &edgeproto.AutoScalePolicyHistory{},
+	Run:          runShowAutoScalePolicyHistory,
+}
+
+func runShowAutoScalePolicyHistory(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.AutoScalePolicy)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return ShowAutoScalePolicyHistory(c, obj)
+}
+
+func ShowAutoScalePolicyHistory(c *cli.Command, in *edgeproto.AutoScalePolicy) error {
+	if AutoScalePolicyApiCmd == nil {
+		return fmt.Errorf("AutoScalePolicyApi client not initialized")
+	}
+	ctx := context.Background()
+	stream, err := AutoScalePolicyApiCmd.ShowAutoScalePolicyHistory(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("ShowAutoScalePolicyHistory failed: %s", errstr)
+	}
+
+	objs := make([]*edgeproto.AutoScalePolicyHistory, 0)
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errstr := err.Error()
+			st, ok := status.FromError(err)
+			if ok {
+				errstr = st.Message()
+			}
+			return fmt.Errorf("ShowAutoScalePolicyHistory recv failed: %s", errstr)
+		}
+		objs = append(objs, obj)
+	}
+	if len(objs) == 0 {
+		return nil
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), objs, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func ShowAutoScalePolicyHistorys(c *cli.Command, data []edgeproto.AutoScalePolicy, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("ShowAutoScalePolicyHistory %v\n", data[ii])
+		myerr := ShowAutoScalePolicyHistory(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
 var AutoScalePolicyApiCmds = []*cobra.Command{
 	CreateAutoScalePolicyCmd.GenCmd(),
 	DeleteAutoScalePolicyCmd.GenCmd(),
 	UpdateAutoScalePolicyCmd.GenCmd(),
 	ShowAutoScalePolicyCmd.GenCmd(),
+	ShowAutoScalePolicyHistoryCmd.GenCmd(),
 }
 
 var PolicyKeyRequiredArgs = []string{}
@@ -330,6 +408,43 @@ var AutoScalePolicyComments = map[string]string{
 var AutoScalePolicySpecialArgs = map[string]string{
 	"fields": "StringArray",
 }
+var AutoScalePolicyHistoryRequiredArgs = []string{}
+var AutoScalePolicyHistoryOptionalArgs = []string{
+	"revision",
+	"obj.fields",
+	"obj.key.organization",
+	"obj.key.name",
+	"obj.minnodes",
+	"obj.maxnodes",
+	"obj.scaleupcputhresh",
+	"obj.scaledowncputhresh",
+	"obj.triggertimesec",
+	"obj.stabilizationwindowsec",
+	"obj.targetcpu",
+	"obj.targetmem",
+	"obj.targetactiveconnections",
+	"obj.deleteprepare",
+}
+var AutoScalePolicyHistoryAliasArgs = []string{}
+var AutoScalePolicyHistoryComments = map[string]string{
+	"revision":                    "Database revision of the change",
+	"obj.fields":                  "Fields are used for the Update API to specify which fields to apply",
+	"obj.key.organization":        "Name of the organization for the cluster that this policy will apply to",
+	"obj.key.name":                "Policy name",
+	"obj.minnodes":                "Minimum number of cluster nodes",
+	"obj.maxnodes":                "Maximum number of cluster nodes",
+	"obj.scaleupcputhresh":        "(Deprecated) Scale up cpu threshold (percentage 1 to 100), 0 means disabled",
+	"obj.scaledowncputhresh":      "(Deprecated) Scale down cpu threshold (percentage 1 to 100), 0 means disabled",
+	"obj.triggertimesec":          "(Deprecated) Trigger time defines how long the target must be satified in seconds before acting upon it.",
+	"obj.stabilizationwindowsec":  "Stabilization window is the time for which past triggers are considered; the largest scale factor is always taken.",
+	"obj.targetcpu":               "Target per-node cpu utilization (percentage 1 to 100), 0 means disabled",
+	"obj.targetmem":               "Target per-node memory utilization (percentage 1 to 100), 0 means disabled",
+	"obj.targetactiveconnections": "Target per-node number of active connections, 0 means disabled",
+	"obj.deleteprepare":           "Preparing to be deleted",
+}
+var AutoScalePolicyHistorySpecialArgs = map[string]string{
+	"obj.fields": "StringArray",
+}
 var CreateAutoScalePolicyRequiredArgs = []string{
 	"clusterorg",
 	"name",
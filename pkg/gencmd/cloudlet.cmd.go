@@ -2409,6 +2409,87 @@ func ChangeCloudletDNSs(c *cli.Command, data []edgeproto.CloudletKey, err *error
 	}
 }
 
+var EvacuateCloudletCmd = &cli.Command{
+	Use:          "EvacuateCloudlet",
+	RequiredArgs: strings.Join(CloudletEvacuateRequiredArgs, " "),
+	OptionalArgs: strings.Join(CloudletEvacuateOptionalArgs, " "),
+	AliasArgs:    strings.Join(CloudletEvacuateAliasArgs, " "),
+	SpecialArgs:  &CloudletEvacuateSpecialArgs,
+	Comments:     CloudletEvacuateComments,
+	ReqData:      &edgeproto.CloudletEvacuate{},
+	ReplyData:    &edgeproto.Result{},
+	Run:          runEvacuateCloudlet,
+}
+
+func runEvacuateCloudlet(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.CloudletEvacuate)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return EvacuateCloudlet(c, obj)
+}
+
+func EvacuateCloudlet(c *cli.Command, in *edgeproto.CloudletEvacuate) error {
+	if CloudletApiCmd == nil {
+		return fmt.Errorf("CloudletApi client not initialized")
+	}
+	ctx := context.Background()
+	stream, err := CloudletApiCmd.EvacuateCloudlet(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("EvacuateCloudlet failed: %s", errstr)
+	}
+
+	objs := make([]*edgeproto.Result, 0)
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errstr := err.Error()
+			st, ok := status.FromError(err)
+			if ok {
+				errstr = st.Message()
+			}
+			return fmt.Errorf("EvacuateCloudlet recv failed: %s", errstr)
+		}
+		if cli.OutputStream {
+			c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+			continue
+		}
+		objs = append(objs, obj)
+	}
+	if len(objs) == 0 {
+		return nil
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), objs, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func EvacuateCloudlets(c *cli.Command, data []edgeproto.CloudletEvacuate, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("EvacuateCloudlet %v\n", data[ii])
+		myerr := EvacuateCloudlet(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
 var RefreshCertsCmd = &cli.Command{
 	Use:          "RefreshCerts",
 	RequiredArgs: strings.Join(RefreshCertsRequiredArgs, " "),
@@ -2512,6 +2593,7 @@ var CloudletApiCmds = []*cobra.Command{
 	GenerateAccessKeyCmd.GenCmd(),
 	GetCloudletGPUDriverLicenseConfigCmd.GenCmd(),
 	ChangeCloudletDNSCmd.GenCmd(),
+	EvacuateCloudletCmd.GenCmd(),
 	RefreshCertsCmd.GenCmd(),
 }
 
@@ -3435,6 +3517,23 @@ var GPUConfigComments = map[string]string{
 var GPUConfigSpecialArgs = map[string]string{
 	"properties": "StringToString",
 }
+var MaintenanceWindowRequiredArgs = []string{}
+var MaintenanceWindowOptionalArgs = []string{
+	"starttime",
+	"endtime",
+	"failoverleadtime",
+	"nofailover",
+	"state",
+}
+var MaintenanceWindowAliasArgs = []string{}
+var MaintenanceWindowComments = map[string]string{
+	"starttime":        "Start time of the maintenance",
+	"endtime":          "End time of the maintenance, after which the cloudlet returns to normal operation",
+	"failoverleadtime": "How long before the start time to stop sending new clients to the cloudlet and move auto-provisioned AppInsts off of it, defaults to the cloudlet maintenance timeout",
+	"nofailover":       "Do not move auto-provisioned AppInsts off of the cloudlet",
+	"state":            "Progress of the maintenance window, one of Scheduled, Failover, InProgress",
+}
+var MaintenanceWindowSpecialArgs = map[string]string{}
 var CloudletRequiredArgs = []string{
 	"cloudletorg",
 	"cloudlet",
@@ -3511,6 +3610,11 @@ var CloudletOptionalArgs = []string{
 	"objid",
 	"annotations",
 	"dbmodelid",
+	"dryrun",
+	"maintenancewindow.starttime",
+	"maintenancewindow.endtime",
+	"maintenancewindow.failoverleadtime",
+	"maintenancewindow.nofailover",
 }
 var CloudletAliasArgs = []string{
 	"cloudletorg=key.organization",
@@ -3637,6 +3741,12 @@ var CloudletComments = map[string]string{
 	"objid":                                  "Universally unique object ID",
 	"annotations":                            "Annotations, specify annotations:empty=true to clear",
 	"dbmodelid":                              "database version model ID",
+	"dryrun":                                 "Preview the update without applying it, reports the fields that would change and whether the CRM would be updated",
+	"maintenancewindow.starttime":            "Start time of the maintenance",
+	"maintenancewindow.endtime":              "End time of the maintenance, after which the cloudlet returns to normal operation",
+	"maintenancewindow.failoverleadtime":     "How long before the start time to stop sending new clients to the cloudlet and move auto-provisioned AppInsts off of it, defaults to the cloudlet maintenance timeout",
+	"maintenancewindow.nofailover":           "Do not move auto-provisioned AppInsts off of the cloudlet",
+	"maintenancewindow.state":                "Progress of the maintenance window, one of Scheduled, Failover, InProgress",
 }
 var CloudletSpecialArgs = map[string]string{
 	"accessvars":             "StringToString",
@@ -3838,6 +3948,34 @@ var CloudletAllianceOrgComments = map[string]string{
 	"organization": "Alliance organization",
 }
 var CloudletAllianceOrgSpecialArgs = map[string]string{}
+var CloudletEvacuateRequiredArgs = []string{
+	"cloudletorg",
+	"cloudlet",
+}
+var CloudletEvacuateOptionalArgs = []string{
+	"federatedorg",
+	"zoneorg",
+	"zone",
+	"targetzonekey.federatedorganization",
+	"healthchecktimeout",
+}
+var CloudletEvacuateAliasArgs = []string{
+	"cloudletorg=key.organization",
+	"cloudlet=key.name",
+	"federatedorg=key.federatedorganization",
+	"zoneorg=targetzonekey.organization",
+	"zone=targetzonekey.name",
+}
+var CloudletEvacuateComments = map[string]string{
+	"cloudletorg":                         "Organization of the cloudlet site",
+	"cloudlet":                            "Name of the cloudlet",
+	"federatedorg":                        "Federated operator organization who shared this cloudlet",
+	"zoneorg":                             "Organization owner of the Zone",
+	"zone":                                "Name of the Zone",
+	"targetzonekey.federatedorganization": "Federated operator organization who shared this Zone",
+	"healthchecktimeout":                  "Time to wait for each replacement AppInst to pass health checks, defaults to 5m",
+}
+var CloudletEvacuateSpecialArgs = map[string]string{}
 var FlavorInfoRequiredArgs = []string{}
 var FlavorInfoOptionalArgs = []string{
 	"name",
@@ -4409,6 +4547,11 @@ var UpdateCloudletOptionalArgs = []string{
 	"objid",
 	"annotations",
 	"dbmodelid",
+	"dryrun",
+	"maintenancewindow.starttime",
+	"maintenancewindow.endtime",
+	"maintenancewindow.failoverleadtime",
+	"maintenancewindow.nofailover",
 }
 var ShowCloudletRequiredArgs = []string{
 	"cloudletorg",
@@ -4483,6 +4626,11 @@ var ShowCloudletOptionalArgs = []string{
 	"objid",
 	"annotations",
 	"dbmodelid",
+	"dryrun",
+	"maintenancewindow.starttime",
+	"maintenancewindow.endtime",
+	"maintenancewindow.failoverleadtime",
+	"maintenancewindow.nofailover",
 }
 var GetCloudletPropsRequiredArgs = []string{
 	"platformtype",
@@ -4576,6 +4724,11 @@ var ShowCloudletResourceUsageOptionalArgs = []string{
 	"objid",
 	"annotations",
 	"dbmodelid",
+	"dryrun",
+	"maintenancewindow.starttime",
+	"maintenancewindow.endtime",
+	"maintenancewindow.failoverleadtime",
+	"maintenancewindow.nofailover",
 }
 var ShowCloudletGPUUsageRequiredArgs = []string{}
 var ShowCloudletGPUUsageOptionalArgs = []string{
@@ -4649,6 +4802,11 @@ var ShowCloudletGPUUsageOptionalArgs = []string{
 	"objid",
 	"annotations",
 	"dbmodelid",
+	"dryrun",
+	"maintenancewindow.starttime",
+	"maintenancewindow.endtime",
+	"maintenancewindow.failoverleadtime",
+	"maintenancewindow.nofailover",
 }
 var ShowFlavorsForZoneRequiredArgs = []string{}
 var ShowFlavorsForZoneOptionalArgs = []string{
@@ -181,8 +181,8 @@ func CreateClusterInsts(c *cli.Command, data []edgeproto.ClusterInst, err *error
 
 var DeleteClusterInstCmd = &cli.Command{
 	Use:          "DeleteClusterInst",
-	RequiredArgs: strings.Join(ClusterInstRequiredArgs, " "),
-	OptionalArgs: strings.Join(ClusterInstOptionalArgs, " "),
+	RequiredArgs: strings.Join(DeleteClusterInstRequiredArgs, " "),
+	OptionalArgs: strings.Join(DeleteClusterInstOptionalArgs, " "),
 	AliasArgs:    strings.Join(ClusterInstAliasArgs, " "),
 	SpecialArgs:  &ClusterInstSpecialArgs,
 	Comments:     ClusterInstComments,
@@ -734,6 +734,7 @@ var ClusterInstOptionalArgs = []string{
 	"disabledynamicappinstplacement",
 	"cloudletmanagedclusterid",
 	"cloudletmanagedclustername",
+	"dryrun",
 	"tags",
 }
 var ClusterInstAliasArgs = []string{
@@ -839,6 +840,7 @@ var ClusterInstComments = map[string]string{
 	"disabledynamicappinstplacement":               "Disables dynamic placement of AppInsts on this cluster",
 	"cloudletmanagedclusterid":                     "Cloudlet managed cluster ID, if cluster based on cloudlet managed cluster",
 	"cloudletmanagedclustername":                   "Cloudlet managed cluster name, if cluster based on cloudlet managed cluster",
+	"dryrun":                                       "Preview the create without applying it, reports the chosen cloudlet, the resources required, and why other cloudlets were skipped",
 	"tags":                                         "Vendor-specific data, specify tags:empty=true to clear",
 }
 var ClusterInstSpecialArgs = map[string]string{
@@ -1073,6 +1075,63 @@ var ClusterInstInfoSpecialArgs = map[string]string{
 	"infraannotations": "StringToString",
 	"status.msgs":      "StringArray",
 }
+var DeleteClusterInstRequiredArgs = []string{
+	"cluster",
+	"clusterorg",
+}
+var DeleteClusterInstOptionalArgs = []string{
+	"zoneorg",
+	"zone",
+	"zonekey.federatedorganization",
+	"flavor",
+	"crmoverride",
+	"ipaccess",
+	"deployment",
+	"nummasters",
+	"numnodes",
+	"autoscalepolicy",
+	"imagename",
+	"reservable",
+	"sharedvolumesize",
+	"skipcrmcleanuponfailure",
+	"multitenant",
+	"networks",
+	"enableipv6",
+	"objid",
+	"annotations",
+	"dbmodelid",
+	"noderesources.vcpus",
+	"noderesources.ram",
+	"noderesources.disk",
+	"noderesources.gpus:#.modelid",
+	"noderesources.gpus:#.count",
+	"noderesources.gpus:#.vendor",
+	"noderesources.gpus:#.memory",
+	"noderesources.gpus:#.inuse",
+	"noderesources.optresmap",
+	"noderesources.infranodeflavor",
+	"noderesources.externalvolumesize",
+	"nodepools:#.name",
+	"nodepools:#.numnodes",
+	"nodepools:#.noderesources.vcpus",
+	"nodepools:#.noderesources.ram",
+	"nodepools:#.noderesources.disk",
+	"nodepools:#.noderesources.gpus:#.modelid",
+	"nodepools:#.noderesources.gpus:#.count",
+	"nodepools:#.noderesources.gpus:#.vendor",
+	"nodepools:#.noderesources.gpus:#.memory",
+	"nodepools:#.noderesources.gpus:#.inuse",
+	"nodepools:#.noderesources.optresmap",
+	"nodepools:#.noderesources.infranodeflavor",
+	"nodepools:#.noderesources.externalvolumesize",
+	"nodepools:#.scalable",
+	"infraannotations",
+	"kubernetesversion",
+	"disabledynamicappinstplacement",
+	"cloudletmanagedclusterid",
+	"cloudletmanagedclustername",
+	"tags",
+}
 var UpdateClusterInstRequiredArgs = []string{
 	"cluster",
 	"clusterorg",
@@ -1152,5 +1211,6 @@ var ShowClusterResourceUsageOptionalArgs = []string{
 	"disabledynamicappinstplacement",
 	"cloudletmanagedclusterid",
 	"cloudletmanagedclustername",
+	"dryrun",
 	"tags",
 }
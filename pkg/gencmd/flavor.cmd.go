@@ -275,6 +275,83 @@ func ShowFlavors(c *cli.Command, data []edgeproto.Flavor, err *error) {
 	}
 }
 
+var ShowFlavorHistoryCmd = &cli.Command{
+	Use:          "ShowFlavorHistory",
+	RequiredArgs: strings.Join(FlavorRequiredArgs, " "),
+	OptionalArgs: strings.Join(FlavorOptionalArgs, " "),
+	AliasArgs:    strings.Join(FlavorAliasArgs, " "),
+	SpecialArgs:  &FlavorSpecialArgs,
+	Comments:     FlavorComments,
+	ReqData:      &edgeproto.Flavor{},
+	ReplyData:    &edgeproto.FlavorHistory{},
+	Run:          runShowFlavorHistory,
+}
+
+func runShowFlavorHistory(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.Flavor)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return ShowFlavorHistory(c, obj)
+}
+
+func ShowFlavorHistory(c *cli.Command, in *edgeproto.Flavor) error {
+	if FlavorApiCmd == nil {
+		return fmt.Errorf("FlavorApi client not initialized")
+	}
+	ctx := context.Background()
+	stream, err := FlavorApiCmd.ShowFlavorHistory(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("ShowFlavorHistory failed: %s", errstr)
+	}
+
+	objs := make([]*edgeproto.FlavorHistory, 0)
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errstr := err.Error()
+			st, ok := status.FromError(err)
+			if ok {
+				errstr = st.Message()
+			}
+			return fmt.Errorf("ShowFlavorHistory recv failed: %s", errstr)
+		}
+		objs = append(objs, obj)
+	}
+	if len(objs) == 0 {
+		return nil
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), objs, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func ShowFlavorHistorys(c *cli.Command, data []edgeproto.Flavor, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("ShowFlavorHistory %v\n", data[ii])
+		myerr := ShowFlavorHistory(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
 var AddFlavorResCmd = &cli.Command{
 	Use:          "AddFlavorRes",
 	RequiredArgs: strings.Join(FlavorRequiredArgs, " "),
@@ -394,6 +471,7 @@ var FlavorApiCmds = []*cobra.Command{
 	DeleteFlavorCmd.GenCmd(),
 	UpdateFlavorCmd.GenCmd(),
 	ShowFlavorCmd.GenCmd(),
+	ShowFlavorHistoryCmd.GenCmd(),
 	AddFlavorResCmd.GenCmd(),
 	RemoveFlavorResCmd.GenCmd(),
 }
@@ -444,6 +522,42 @@ var FlavorSpecialArgs = map[string]string{
 	"fields":    "StringArray",
 	"optresmap": "StringToString",
 }
+var FlavorHistoryRequiredArgs = []string{}
+var FlavorHistoryOptionalArgs = []string{
+	"revision",
+	"obj.fields",
+	"obj.key.name",
+	"obj.ram",
+	"obj.vcpus",
+	"obj.disk",
+	"obj.gpus:#.modelid",
+	"obj.gpus:#.count",
+	"obj.gpus:#.vendor",
+	"obj.gpus:#.memory",
+	"obj.gpus:#.inuse",
+	"obj.optresmap",
+	"obj.deleteprepare",
+}
+var FlavorHistoryAliasArgs = []string{}
+var FlavorHistoryComments = map[string]string{
+	"revision":           "Database revision of the change",
+	"obj.fields":         "Fields are used for the Update API to specify which fields to apply",
+	"obj.key.name":       "Flavor name",
+	"obj.ram":            "RAM in megabytes",
+	"obj.vcpus":          "Number of virtual CPUs",
+	"obj.disk":           "Amount of disk space in gigabytes",
+	"obj.gpus:#.modelid": "GPU model unique identifier",
+	"obj.gpus:#.count":   "Count of how many of this GPU are required/present",
+	"obj.gpus:#.vendor":  "GPU vendor (nvidia, amd, etc)",
+	"obj.gpus:#.memory":  "Memory in GB",
+	"obj.gpus:#.inuse":   "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"obj.optresmap":      "Optional Resources request, key = gpu form: $resource=$kind:[$alias]$count ex: optresmap=gpu=vgpu:nvidia-63:1",
+	"obj.deleteprepare":  "Preparing to be deleted",
+}
+var FlavorHistorySpecialArgs = map[string]string{
+	"obj.fields":    "StringArray",
+	"obj.optresmap": "StringToString",
+}
 var CreateFlavorRequiredArgs = []string{
 	"name",
 	"ram",
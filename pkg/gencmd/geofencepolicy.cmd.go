@@ -0,0 +1,512 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: geofencepolicy.proto
+
+package gencmd
+
+import (
+	"context"
+	fmt "fmt"
+	_ "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cli"
+	_ "github.com/edgexr/edge-cloud-platform/tools/protogen"
+	_ "github.com/gogo/googleapis/google/api"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+	"io"
+	math "math"
+	"strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// Auto-generated code: DO NOT EDIT
+func GeoFencePolicyHideTags(in *edgeproto.GeoFencePolicy) {
+	if cli.HideTags == "" {
+		return
+	}
+	tags := make(map[string]struct{})
+	for _, tag := range strings.Split(cli.HideTags, ",") {
+		tags[tag] = struct{}{}
+	}
+	for i0 := 0; i0 < len(in.Fences); i0++ {
+		for i1 := 0; i1 < len(in.Fences[i0].Polygon); i1++ {
+		}
+	}
+	if _, found := tags["nocmp"]; found {
+		in.DeletePrepare = false
+	}
+}
+
+func GeoFencePolicyHistoryHideTags(in *edgeproto.GeoFencePolicyHistory) {
+	if cli.HideTags == "" {
+		return
+	}
+	tags := make(map[string]struct{})
+	for _, tag := range strings.Split(cli.HideTags, ",") {
+		tags[tag] = struct{}{}
+	}
+	for i1 := 0; i1 < len(in.Obj.Fences); i1++ {
+		for i2 := 0; i2 < len(in.Obj.Fences[i1].Polygon); i2++ {
+		}
+	}
+	if _, found := tags["nocmp"]; found {
+		in.Obj.DeletePrepare = false
+	}
+}
+
+var GeoFencePolicyApiCmd edgeproto.GeoFencePolicyApiClient
+
+var CreateGeoFencePolicyCmd = &cli.Command{
+	Use:          "CreateGeoFencePolicy",
+	RequiredArgs: strings.Join(GeoFencePolicyRequiredArgs, " "),
+	OptionalArgs: strings.Join(GeoFencePolicyOptionalArgs, " "),
+	AliasArgs:    strings.Join(GeoFencePolicyAliasArgs, " "),
+	SpecialArgs:  &GeoFencePolicySpecialArgs,
+	Comments:     GeoFencePolicyComments,
+	ReqData:      &edgeproto.GeoFencePolicy{},
+	ReplyData:    &edgeproto.Result{},
+	Run:          runCreateGeoFencePolicy,
+}
+
+func runCreateGeoFencePolicy(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.GeoFencePolicy)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return CreateGeoFencePolicy(c, obj)
+}
+
+func CreateGeoFencePolicy(c *cli.Command, in *edgeproto.GeoFencePolicy) error {
+	if GeoFencePolicyApiCmd == nil {
+		return fmt.Errorf("GeoFencePolicyApi client not initialized")
+	}
+	ctx := context.Background()
+	obj, err := GeoFencePolicyApiCmd.CreateGeoFencePolicy(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("CreateGeoFencePolicy failed: %s", errstr)
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func CreateGeoFencePolicys(c *cli.Command, data []edgeproto.GeoFencePolicy, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("CreateGeoFencePolicy %v\n", data[ii])
+		myerr := CreateGeoFencePolicy(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var DeleteGeoFencePolicyCmd = &cli.Command{
+	Use:          "DeleteGeoFencePolicy",
+	RequiredArgs: strings.Join(GeoFencePolicyRequiredArgs, " "),
+	OptionalArgs: strings.Join(GeoFencePolicyOptionalArgs, " "),
+	AliasArgs:    strings.Join(GeoFencePolicyAliasArgs, " "),
+	SpecialArgs:  &GeoFencePolicySpecialArgs,
+	Comments:     GeoFencePolicyComments,
+	ReqData:      &edgeproto.GeoFencePolicy{},
+	ReplyData:    &edgeproto.Result{},
+	Run:          runDeleteGeoFencePolicy,
+}
+
+func runDeleteGeoFencePolicy(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.GeoFencePolicy)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return DeleteGeoFencePolicy(c, obj)
+}
+
+func DeleteGeoFencePolicy(c *cli.Command, in *edgeproto.GeoFencePolicy) error {
+	if GeoFencePolicyApiCmd == nil {
+		return fmt.Errorf("GeoFencePolicyApi client not initialized")
+	}
+	ctx := context.Background()
+	obj, err := GeoFencePolicyApiCmd.DeleteGeoFencePolicy(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("DeleteGeoFencePolicy failed: %s", errstr)
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func DeleteGeoFencePolicys(c *cli.Command, data []edgeproto.GeoFencePolicy, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("DeleteGeoFencePolicy %v\n", data[ii])
+		myerr := DeleteGeoFencePolicy(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var UpdateGeoFencePolicyCmd = &cli.Command{
+	Use:          "UpdateGeoFencePolicy",
+	RequiredArgs: strings.Join(GeoFencePolicyRequiredArgs, " "),
+	OptionalArgs: strings.Join(GeoFencePolicyOptionalArgs, " "),
+	AliasArgs:    strings.Join(GeoFencePolicyAliasArgs, " "),
+	SpecialArgs:  &GeoFencePolicySpecialArgs,
+	Comments:     GeoFencePolicyComments,
+	ReqData:      &edgeproto.GeoFencePolicy{},
+	ReplyData:    &edgeproto.Result{},
+	Run:          runUpdateGeoFencePolicy,
+}
+
+func runUpdateGeoFencePolicy(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.GeoFencePolicy)
+	jsonMap, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	obj.Fields = cli.GetSpecifiedFields(jsonMap, c.ReqData)
+	return UpdateGeoFencePolicy(c, obj)
+}
+
+func UpdateGeoFencePolicy(c *cli.Command, in *edgeproto.GeoFencePolicy) error {
+	if GeoFencePolicyApiCmd == nil {
+		return fmt.Errorf("GeoFencePolicyApi client not initialized")
+	}
+	ctx := context.Background()
+	obj, err := GeoFencePolicyApiCmd.UpdateGeoFencePolicy(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("UpdateGeoFencePolicy failed: %s", errstr)
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func UpdateGeoFencePolicys(c *cli.Command, data []edgeproto.GeoFencePolicy, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("UpdateGeoFencePolicy %v\n", data[ii])
+		myerr := UpdateGeoFencePolicy(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var ShowGeoFencePolicyCmd = &cli.Command{
+	Use:          "ShowGeoFencePolicy",
+	OptionalArgs: strings.Join(append(GeoFencePolicyRequiredArgs, GeoFencePolicyOptionalArgs...), " "),
+	AliasArgs:    strings.Join(GeoFencePolicyAliasArgs, " "),
+	SpecialArgs:  &GeoFencePolicySpecialArgs,
+	Comments:     GeoFencePolicyComments,
+	ReqData:      &edgeproto.GeoFencePolicy{},
+	ReplyData:    &edgeproto.GeoFencePolicy{},
+	Run:          runShowGeoFencePolicy,
+}
+
+func runShowGeoFencePolicy(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.GeoFencePolicy)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return ShowGeoFencePolicy(c, obj)
+}
+
+func ShowGeoFencePolicy(c *cli.Command, in *edgeproto.GeoFencePolicy) error {
+	if GeoFencePolicyApiCmd == nil {
+		return fmt.Errorf("GeoFencePolicyApi client not initialized")
+	}
+	ctx := context.Background()
+	stream, err := GeoFencePolicyApiCmd.ShowGeoFencePolicy(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("ShowGeoFencePolicy failed: %s", errstr)
+	}
+
+	objs := make([]*edgeproto.GeoFencePolicy, 0)
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errstr := err.Error()
+			st, ok := status.FromError(err)
+			if ok {
+				errstr = st.Message()
+			}
+			return fmt.Errorf("ShowGeoFencePolicy recv failed: %s", errstr)
+		}
+		GeoFencePolicyHideTags(obj)
+		objs = append(objs, obj)
+	}
+	if len(objs) == 0 {
+		return nil
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), objs, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func ShowGeoFencePolicys(c *cli.Command, data []edgeproto.GeoFencePolicy, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("ShowGeoFencePolicy %v\n", data[ii])
+		myerr := ShowGeoFencePolicy(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var ShowGeoFencePolicyHistoryCmd = &cli.Command{
+	Use:          "ShowGeoFencePolicyHistory",
+	RequiredArgs: strings.Join(GeoFencePolicyRequiredArgs, " "),
+	OptionalArgs: strings.Join(GeoFencePolicyOptionalArgs, " "),
+	AliasArgs:    strings.Join(GeoFencePolicyAliasArgs, " "),
+	SpecialArgs:  &GeoFencePolicySpecialArgs,
+	Comments:     GeoFencePolicyComments,
+	ReqData:      &edgeproto.GeoFencePolicy{},
+	ReplyData:    &edgeproto.GeoFencePolicyHistory{},
+	Run:          runShowGeoFencePolicyHistory,
+}
+
+func runShowGeoFencePolicyHistory(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.GeoFencePolicy)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return ShowGeoFencePolicyHistory(c, obj)
+}
+
+func ShowGeoFencePolicyHistory(c *cli.Command, in *edgeproto.GeoFencePolicy) error {
+	if GeoFencePolicyApiCmd == nil {
+		return fmt.Errorf("GeoFencePolicyApi client not initialized")
+	}
+	ctx := context.Background()
+	stream, err := GeoFencePolicyApiCmd.ShowGeoFencePolicyHistory(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("ShowGeoFencePolicyHistory failed: %s", errstr)
+	}
+
+	objs := make([]*edgeproto.GeoFencePolicyHistory, 0)
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errstr := err.Error()
+			st, ok := status.FromError(err)
+			if ok {
+				errstr = st.Message()
+			}
+			return fmt.Errorf("ShowGeoFencePolicyHistory recv failed: %s", errstr)
+		}
+		GeoFencePolicyHistoryHideTags(obj)
+		objs = append(objs, obj)
+	}
+	if len(objs) == 0 {
+		return nil
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), objs, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func ShowGeoFencePolicyHistorys(c *cli.Command, data []edgeproto.GeoFencePolicy, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("ShowGeoFencePolicyHistory %v\n", data[ii])
+		myerr := ShowGeoFencePolicyHistory(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var GeoFencePolicyApiCmds = []*cobra.Command{
+	CreateGeoFencePolicyCmd.GenCmd(),
+	DeleteGeoFencePolicyCmd.GenCmd(),
+	UpdateGeoFencePolicyCmd.GenCmd(),
+	ShowGeoFencePolicyCmd.GenCmd(),
+	ShowGeoFencePolicyHistoryCmd.GenCmd(),
+}
+
+var GeoFenceRequiredArgs = []string{}
+var GeoFenceOptionalArgs = []string{
+	"name",
+	"polygon:#.latitude",
+	"polygon:#.longitude",
+	"polygon:#.horizontalaccuracy",
+	"polygon:#.verticalaccuracy",
+	"polygon:#.altitude",
+	"polygon:#.course",
+	"polygon:#.speed",
+	"polygon:#.timestamp",
+	"countrycodes",
+}
+var GeoFenceAliasArgs = []string{}
+var GeoFenceComments = map[string]string{
+	"name":                         "Name of the geo-fence",
+	"polygon:#.latitude":           "Latitude in WGS 84 coordinates",
+	"polygon:#.longitude":          "Longitude in WGS 84 coordinates",
+	"polygon:#.horizontalaccuracy": "Horizontal accuracy (radius in meters)",
+	"polygon:#.verticalaccuracy":   "Vertical accuracy (meters)",
+	"polygon:#.altitude":           "On android only lat and long are guaranteed to be supplied Altitude in meters",
+	"polygon:#.course":             "Course (IOS) / bearing (Android) (degrees east relative to true north)",
+	"polygon:#.speed":              "Speed (IOS) / velocity (Android) (meters/sec)",
+	"polygon:#.timestamp":          "Timestamp",
+	"countrycodes":                 "ISO 3166-1 alpha-2 codes of countries enclosed by the geo-fence",
+}
+var GeoFenceSpecialArgs = map[string]string{
+	"countrycodes": "StringArray",
+}
+var GeoFencePolicyRequiredArgs = []string{
+	"apporg",
+	"name",
+}
+var GeoFencePolicyOptionalArgs = []string{
+	"fences:empty",
+	"fences:#.name",
+	"fences:#.polygon:empty",
+	"fences:#.polygon:#.latitude",
+	"fences:#.polygon:#.longitude",
+	"fences:#.polygon:#.horizontalaccuracy",
+	"fences:#.polygon:#.verticalaccuracy",
+	"fences:#.polygon:#.altitude",
+	"fences:#.polygon:#.course",
+	"fences:#.polygon:#.speed",
+	"fences:#.polygon:#.timestamp",
+	"fences:#.countrycodes",
+}
+var GeoFencePolicyAliasArgs = []string{
+	"apporg=key.organization",
+	"name=key.name",
+}
+var GeoFencePolicyComments = map[string]string{
+	"fields":                                "Fields are used for the Update API to specify which fields to apply",
+	"apporg":                                "Name of the organization for the cluster that this policy will apply to",
+	"name":                                  "Policy name",
+	"fences:empty":                          "Geo-fences, specify fences:empty=true to clear",
+	"fences:#.name":                         "Name of the geo-fence",
+	"fences:#.polygon:empty":                "Vertices of a polygon enclosing the geo-fence, at least 3 are required, specify fences:#.polygon:empty=true to clear",
+	"fences:#.polygon:#.latitude":           "Latitude in WGS 84 coordinates",
+	"fences:#.polygon:#.longitude":          "Longitude in WGS 84 coordinates",
+	"fences:#.polygon:#.horizontalaccuracy": "Horizontal accuracy (radius in meters)",
+	"fences:#.polygon:#.verticalaccuracy":   "Vertical accuracy (meters)",
+	"fences:#.polygon:#.altitude":           "On android only lat and long are guaranteed to be supplied Altitude in meters",
+	"fences:#.polygon:#.course":             "Course (IOS) / bearing (Android) (degrees east relative to true north)",
+	"fences:#.polygon:#.speed":              "Speed (IOS) / velocity (Android) (meters/sec)",
+	"fences:#.polygon:#.timestamp":          "Timestamp",
+	"fences:#.countrycodes":                 "ISO 3166-1 alpha-2 codes of countries enclosed by the geo-fence, specify fences:#.countrycodes:empty=true to clear",
+	"deleteprepare":                         "Preparing to be deleted",
+}
+var GeoFencePolicySpecialArgs = map[string]string{
+	"fences:#.countrycodes": "StringArray",
+	"fields":                "StringArray",
+}
+var GeoFencePolicyHistoryRequiredArgs = []string{}
+var GeoFencePolicyHistoryOptionalArgs = []string{
+	"revision",
+	"obj.fields",
+	"obj.key.organization",
+	"obj.key.name",
+	"obj.fences:#.name",
+	"obj.fences:#.polygon:#.latitude",
+	"obj.fences:#.polygon:#.longitude",
+	"obj.fences:#.polygon:#.horizontalaccuracy",
+	"obj.fences:#.polygon:#.verticalaccuracy",
+	"obj.fences:#.polygon:#.altitude",
+	"obj.fences:#.polygon:#.course",
+	"obj.fences:#.polygon:#.speed",
+	"obj.fences:#.polygon:#.timestamp",
+	"obj.fences:#.countrycodes",
+	"obj.deleteprepare",
+}
+var GeoFencePolicyHistoryAliasArgs = []string{}
+var GeoFencePolicyHistoryComments = map[string]string{
+	"revision":                                  "Database revision of the change",
+	"obj.fields":                                "Fields are used for the Update API to specify which fields to apply",
+	"obj.key.organization":                      "Name of the organization for the cluster that this policy will apply to",
+	"obj.key.name":                              "Policy name",
+	"obj.fences:#.name":                         "Name of the geo-fence",
+	"obj.fences:#.polygon:#.latitude":           "Latitude in WGS 84 coordinates",
+	"obj.fences:#.polygon:#.longitude":          "Longitude in WGS 84 coordinates",
+	"obj.fences:#.polygon:#.horizontalaccuracy": "Horizontal accuracy (radius in meters)",
+	"obj.fences:#.polygon:#.verticalaccuracy":   "Vertical accuracy (meters)",
+	"obj.fences:#.polygon:#.altitude":           "On android only lat and long are guaranteed to be supplied Altitude in meters",
+	"obj.fences:#.polygon:#.course":             "Course (IOS) / bearing (Android) (degrees east relative to true north)",
+	"obj.fences:#.polygon:#.speed":              "Speed (IOS) / velocity (Android) (meters/sec)",
+	"obj.fences:#.polygon:#.timestamp":          "Timestamp",
+	"obj.fences:#.countrycodes":                 "ISO 3166-1 alpha-2 codes of countries enclosed by the geo-fence",
+	"obj.deleteprepare":                         "Preparing to be deleted",
+}
+var GeoFencePolicyHistorySpecialArgs = map[string]string{
+	"obj.fences:#.countrycodes": "StringArray",
+	"obj.fields":                "StringArray",
+}
@@ -17,6 +17,40 @@ var _ = fmt.Errorf
 var _ = math.Inf
 
 // Auto-generated code: DO NOT EDIT
+var NoticeBatchRequiredArgs = []string{}
+var NoticeBatchOptionalArgs = []string{
+	"notices:#.action",
+	"notices:#.version",
+	"notices:#.any.typeurl",
+	"notices:#.any.value",
+	"notices:#.wantobjs",
+	"notices:#.filtercloudletkey",
+	"notices:#.span",
+	"notices:#.modrev",
+	"notices:#.tags",
+	"notices:#.filterfederatedcloudlet",
+	"notices:#.compression",
+	"notices:#.batch",
+}
+var NoticeBatchAliasArgs = []string{}
+var NoticeBatchComments = map[string]string{
+	"notices:#.action":                  "Action to perform, one of None, Update, Delete, Version, SendallEnd, Batch",
+	"notices:#.version":                 "Protocol version supported by sender",
+	"notices:#.any.typeurl":             "A URL/resource name that uniquely identifies the type of the serialized protocol buffer message. This string must contain at least one / character. The last segment of the URLs path must represent the fully qualified name of the type (as in `path/google.protobuf.Duration`). The name should be in a canonical form (e.g., leading . is not accepted). In practice, teams usually precompile into the binary all types that they expect it to use in the context of Any. However, for URLs which use the scheme `http`, `https`, or no scheme, one can optionally set up a type server that maps type URLs to message definitions as follows: * If no scheme is provided, `https` is assumed. * An HTTP GET on the URL must yield a [google.protobuf.Type][]   value in binary format, or produce an error. * Applications are allowed to cache lookup results based on the   URL, or have them precompiled into a binary to avoid any   lookup. Therefore, binary compatibility needs to be preserved   on changes to types. (Use versioned type names to manage   breaking changes.) Note: this functionality is not currently available in the official protobuf release, and it is not used for type URLs beginning with type.googleapis.com. Schemes other than `http`, `https` (or the empty scheme) might be used with implementation specific semantics.",
+	"notices:#.any.value":               "Must be a valid serialized protocol buffer of the above specified type.",
+	"notices:#.wantobjs":                "Wanted Objects",
+	"notices:#.filtercloudletkey":       "Filter by cloudlet key",
+	"notices:#.span":                    "Opentracing span",
+	"notices:#.modrev":                  "Database revision for which object was last modified",
+	"notices:#.tags":                    "Extra tags",
+	"notices:#.filterfederatedcloudlet": "Filter by federated cloudlet",
+	"notices:#.compression":             "Compression for batched notices. In the version exchange, the compression requested by the client, and in the reply, the compression that will be used by both sides., one of None, Gzip, Zstd",
+	"notices:#.batch":                   "Marshaled NoticeBatch for the BATCH action, compressed according to compression.",
+}
+var NoticeBatchSpecialArgs = map[string]string{
+	"notices:#.tags":     "StringToString",
+	"notices:#.wantobjs": "StringArray",
+}
 var NoticeRequiredArgs = []string{}
 var NoticeOptionalArgs = []string{
 	"action",
@@ -29,10 +63,12 @@ var NoticeOptionalArgs = []string{
 	"modrev",
 	"tags",
 	"filterfederatedcloudlet",
+	"compression",
+	"batch",
 }
 var NoticeAliasArgs = []string{}
 var NoticeComments = map[string]string{
-	"action":                  "Action to perform, one of None, Update, Delete, Version, SendallEnd",
+	"action":                  "Action to perform, one of None, Update, Delete, Version, SendallEnd, Batch",
 	"version":                 "Protocol version supported by sender",
 	"any.typeurl":             "A URL/resource name that uniquely identifies the type of the serialized protocol buffer message. This string must contain at least one / character. The last segment of the URLs path must represent the fully qualified name of the type (as in `path/google.protobuf.Duration`). The name should be in a canonical form (e.g., leading . is not accepted). In practice, teams usually precompile into the binary all types that they expect it to use in the context of Any. However, for URLs which use the scheme `http`, `https`, or no scheme, one can optionally set up a type server that maps type URLs to message definitions as follows: * If no scheme is provided, `https` is assumed. * An HTTP GET on the URL must yield a [google.protobuf.Type][]   value in binary format, or produce an error. * Applications are allowed to cache lookup results based on the   URL, or have them precompiled into a binary to avoid any   lookup. Therefore, binary compatibility needs to be preserved   on changes to types. (Use versioned type names to manage   breaking changes.) Note: this functionality is not currently available in the official protobuf release, and it is not used for type URLs beginning with type.googleapis.com. Schemes other than `http`, `https` (or the empty scheme) might be used with implementation specific semantics.",
 	"any.value":               "Must be a valid serialized protocol buffer of the above specified type.",
@@ -42,6 +78,8 @@ var NoticeComments = map[string]string{
 	"modrev":                  "Database revision for which object was last modified",
 	"tags":                    "Extra tags",
 	"filterfederatedcloudlet": "Filter by federated cloudlet",
+	"compression":             "Compression for batched notices. In the version exchange, the compression requested by the client, and in the reply, the compression that will be used by both sides., one of None, Gzip, Zstd",
+	"batch":                   "Marshaled NoticeBatch for the BATCH action, compressed according to compression.",
 }
 var NoticeSpecialArgs = map[string]string{
 	"tags":     "StringToString",
@@ -635,7 +635,7 @@ var FlowRateLimitSettingsKeyAliasArgs = []string{}
 var FlowRateLimitSettingsKeyComments = map[string]string{
 	"flowsettingsname":             "Unique name for FlowRateLimitSettings (there can be multiple FlowSettings per RateLimitSettingsKey)",
 	"ratelimitkey.apiname":         "Name of API (eg. CreateApp or RegisterClient) (Use Global if not a specific API)",
-	"ratelimitkey.apiendpointtype": "API Endpoint type, one of UnknownApiEndpointType, Dme",
+	"ratelimitkey.apiendpointtype": "API Endpoint type, one of UnknownApiEndpointType, Dme, Controller",
 	"ratelimitkey.ratelimittarget": "Target to rate limit, one of UnknownTarget, AllRequests, PerIp, PerUser",
 }
 var FlowRateLimitSettingsKeySpecialArgs = map[string]string{}
@@ -663,7 +663,7 @@ var FlowRateLimitSettingsComments = map[string]string{
 	"fields":           "Fields are used for the Update API to specify which fields to apply",
 	"flowsettingsname": "Unique name for FlowRateLimitSettings (there can be multiple FlowSettings per RateLimitSettingsKey)",
 	"apiname":          "Name of API (eg. CreateApp or RegisterClient) (Use Global if not a specific API)",
-	"apiendpointtype":  "API Endpoint type, one of UnknownApiEndpointType, Dme",
+	"apiendpointtype":  "API Endpoint type, one of UnknownApiEndpointType, Dme, Controller",
 	"ratelimittarget":  "Target to rate limit, one of UnknownTarget, AllRequests, PerIp, PerUser",
 	"flowalgorithm":    "Flow Rate Limit algorithm, one of UnknownFlowAlgorithm, TokenBucketAlgorithm, LeakyBucketAlgorithm",
 	"reqspersecond":    "Requests per second for flow rate limiting",
@@ -696,7 +696,7 @@ var MaxReqsRateLimitSettingsKeyAliasArgs = []string{}
 var MaxReqsRateLimitSettingsKeyComments = map[string]string{
 	"maxreqssettingsname":          "Unique name for MaxReqsRateLimitSettings (there can be multiple MaxReqsSettings per RateLimitSettingsKey)",
 	"ratelimitkey.apiname":         "Name of API (eg. CreateApp or RegisterClient) (Use Global if not a specific API)",
-	"ratelimitkey.apiendpointtype": "API Endpoint type, one of UnknownApiEndpointType, Dme",
+	"ratelimitkey.apiendpointtype": "API Endpoint type, one of UnknownApiEndpointType, Dme, Controller",
 	"ratelimitkey.ratelimittarget": "Target to rate limit, one of UnknownTarget, AllRequests, PerIp, PerUser",
 }
 var MaxReqsRateLimitSettingsKeySpecialArgs = map[string]string{}
@@ -724,7 +724,7 @@ var MaxReqsRateLimitSettingsComments = map[string]string{
 	"fields":              "Fields are used for the Update API to specify which fields to apply",
 	"maxreqssettingsname": "Unique name for MaxReqsRateLimitSettings (there can be multiple MaxReqsSettings per RateLimitSettingsKey)",
 	"apiname":             "Name of API (eg. CreateApp or RegisterClient) (Use Global if not a specific API)",
-	"apiendpointtype":     "API Endpoint type, one of UnknownApiEndpointType, Dme",
+	"apiendpointtype":     "API Endpoint type, one of UnknownApiEndpointType, Dme, Controller",
 	"ratelimittarget":     "Target to rate limit, one of UnknownTarget, AllRequests, PerIp, PerUser",
 	"maxreqsalgorithm":    "MaxReqs Rate Limit Algorithm, one of UnknownMaxReqsAlgorithm, FixedWindowAlgorithm",
 	"maxrequests":         "Maximum number of requests for the given Interval",
@@ -742,7 +742,7 @@ var RateLimitSettingsKeyOptionalArgs = []string{
 var RateLimitSettingsKeyAliasArgs = []string{}
 var RateLimitSettingsKeyComments = map[string]string{
 	"apiname":         "Name of API (eg. CreateApp or RegisterClient) (Use Global if not a specific API)",
-	"apiendpointtype": "API Endpoint type, one of UnknownApiEndpointType, Dme",
+	"apiendpointtype": "API Endpoint type, one of UnknownApiEndpointType, Dme, Controller",
 	"ratelimittarget": "Target to rate limit, one of UnknownTarget, AllRequests, PerIp, PerUser",
 }
 var RateLimitSettingsKeySpecialArgs = map[string]string{}
@@ -759,7 +759,7 @@ var RateLimitSettingsAliasArgs = []string{
 }
 var RateLimitSettingsComments = map[string]string{
 	"apiname":         "Name of API (eg. CreateApp or RegisterClient) (Use Global if not a specific API)",
-	"apiendpointtype": "API Endpoint type, one of UnknownApiEndpointType, Dme",
+	"apiendpointtype": "API Endpoint type, one of UnknownApiEndpointType, Dme, Controller",
 	"ratelimittarget": "Target to rate limit, one of UnknownTarget, AllRequests, PerIp, PerUser",
 }
 var RateLimitSettingsSpecialArgs = map[string]string{}
@@ -772,7 +772,7 @@ var RateLimitSettingsDataOptionalArgs = []string{
 var RateLimitSettingsDataAliasArgs = []string{}
 var RateLimitSettingsDataComments = map[string]string{
 	"settings:#.key.apiname":         "Name of API (eg. CreateApp or RegisterClient) (Use Global if not a specific API)",
-	"settings:#.key.apiendpointtype": "API Endpoint type, one of UnknownApiEndpointType, Dme",
+	"settings:#.key.apiendpointtype": "API Endpoint type, one of UnknownApiEndpointType, Dme, Controller",
 	"settings:#.key.ratelimittarget": "Target to rate limit, one of UnknownTarget, AllRequests, PerIp, PerUser",
 }
 var RateLimitSettingsDataSpecialArgs = map[string]string{}
@@ -238,6 +238,13 @@ var SettingsOptionalArgs = []string{
 	"platformhainstancepollinterval",
 	"platformhainstanceactiveexpiretime",
 	"ccrmapitimeout",
+	"findcloudletscoreweights.distance",
+	"findcloudletscoreweights.latency",
+	"findcloudletscoreweights.resourceusage",
+	"findcloudletscoreweights.health",
+	"findcloudletdistribution",
+	"findcloudletstickysessions",
+	"maxobjecthistoryrevisions",
 }
 var SettingsAliasArgs = []string{}
 var SettingsComments = map[string]string{
@@ -284,10 +291,32 @@ var SettingsComments = map[string]string{
 	"platformhainstancepollinterval":                                    "Platform HA instance poll interval",
 	"platformhainstanceactiveexpiretime":                                "Platform HA instance active time",
 	"ccrmapitimeout":                                                    "Timeout for controller platform-specific API calls to CCRM",
+	"findcloudletscoreweights.distance":                                 "Weight per km of distance from the client to the cloudlet",
+	"findcloudletscoreweights.latency":                                  "Weight per ms of average latency reported by clients of the AppInst",
+	"findcloudletscoreweights.resourceusage":                            "Weight per percent used of the most used cloudlet infra resource",
+	"findcloudletscoreweights.health":                                   "Weight added if the AppInst health check has not reported healthy",
+	"findcloudletdistribution":                                          "How FindCloudlet distributes clients between AppInsts with near equal scores, one of Random, WeightedRandom, RoundRobin",
+	"findcloudletstickysessions":                                        "Keep sending a client to the same AppInst until the client moves to a different location tile",
+	"maxobjecthistoryrevisions":                                         "Maximum number of past revisions returned by the object history APIs, older revisions are also lost when the database history is compacted",
 }
 var SettingsSpecialArgs = map[string]string{
 	"fields": "StringArray",
 }
+var FindCloudletScoreWeightsRequiredArgs = []string{}
+var FindCloudletScoreWeightsOptionalArgs = []string{
+	"distance",
+	"latency",
+	"resourceusage",
+	"health",
+}
+var FindCloudletScoreWeightsAliasArgs = []string{}
+var FindCloudletScoreWeightsComments = map[string]string{
+	"distance":      "Weight per km of distance from the client to the cloudlet",
+	"latency":       "Weight per ms of average latency reported by clients of the AppInst",
+	"resourceusage": "Weight per percent used of the most used cloudlet infra resource",
+	"health":        "Weight added if the AppInst health check has not reported healthy",
+}
+var FindCloudletScoreWeightsSpecialArgs = map[string]string{}
 var CollectionIntervalRequiredArgs = []string{}
 var CollectionIntervalOptionalArgs = []string{
 	"interval",
@@ -0,0 +1,2283 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: snapshot.proto
+
+package gencmd
+
+import (
+	"context"
+	fmt "fmt"
+	distributed_match_engine "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cli"
+	_ "github.com/gogo/googleapis/google/api"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+	math "math"
+	"strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// Auto-generated code: DO NOT EDIT
+func DataSnapshotHideTags(in *edgeproto.DataSnapshot) {
+	if cli.HideTags == "" {
+		return
+	}
+	tags := make(map[string]struct{})
+	for _, tag := range strings.Split(cli.HideTags, ",") {
+		tags[tag] = struct{}{}
+	}
+	for i1 := 0; i1 < len(in.Data.Flavors); i1++ {
+		for i2 := 0; i2 < len(in.Data.Flavors[i1].Gpus); i2++ {
+		}
+	}
+	for i2 := 0; i2 < len(in.Data.Settings.EdgeEventsMetricsContinuousQueriesCollectionIntervals); i2++ {
+	}
+	for i1 := 0; i1 < len(in.Data.OperatorCodes); i1++ {
+	}
+	for i1 := 0; i1 < len(in.Data.ResTagTables); i1++ {
+	}
+	for i1 := 0; i1 < len(in.Data.TrustPolicies); i1++ {
+		for i2 := 0; i2 < len(in.Data.TrustPolicies[i1].OutboundSecurityRules); i2++ {
+		}
+	}
+	for i1 := 0; i1 < len(in.Data.GpuDrivers); i1++ {
+		for i2 := 0; i2 < len(in.Data.GpuDrivers[i1].Builds); i2++ {
+		}
+	}
+	for i1 := 0; i1 < len(in.Data.PlatformFeatures); i1++ {
+		for i2 := 0; i2 < len(in.Data.PlatformFeatures[i1].ResourceQuotaProperties); i2++ {
+		}
+	}
+	for i1 := 0; i1 < len(in.Data.Zones); i1++ {
+		for i2 := 0; i2 < len(in.Data.Zones[i1].InfraFlavors); i2++ {
+			for i3 := 0; i3 < len(in.Data.Zones[i1].InfraFlavors[i2].Gpus); i3++ {
+			}
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.Zones[i1].ObjId = ""
+		}
+		if _, found := tags["timestamp"]; found {
+			in.Data.Zones[i1].CreatedAt = distributed_match_engine.Timestamp{}
+		}
+		if _, found := tags["timestamp"]; found {
+			in.Data.Zones[i1].UpdatedAt = distributed_match_engine.Timestamp{}
+		}
+	}
+	for i1 := 0; i1 < len(in.Data.Cloudlets); i1++ {
+		if _, found := tags["nocmp"]; found {
+			in.Data.Cloudlets[i1].Errors = nil
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.Cloudlets[i1].NotifySrvAddr = ""
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.Cloudlets[i1].Config.NotifyCtrlAddrs = ""
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.Cloudlets[i1].Config.PlatformTag = ""
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.Cloudlets[i1].Config.Span = ""
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.Cloudlets[i1].Config.CrmAccessPrivateKey = ""
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.Cloudlets[i1].Config.AccessApiAddr = ""
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.Cloudlets[i1].Config.SecondaryCrmAccessPrivateKey = ""
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.Cloudlets[i1].CrmAccessPublicKey = ""
+		}
+		if _, found := tags["timestamp"]; found {
+			in.Data.Cloudlets[i1].CreatedAt = distributed_match_engine.Timestamp{}
+		}
+		if _, found := tags["timestamp"]; found {
+			in.Data.Cloudlets[i1].UpdatedAt = distributed_match_engine.Timestamp{}
+		}
+		for i2 := 0; i2 < len(in.Data.Cloudlets[i1].ResourceQuotas); i2++ {
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.Cloudlets[i1].HostController = ""
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.Cloudlets[i1].SecondaryCrmAccessPublicKey = ""
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.Cloudlets[i1].SecondaryNotifySrvAddr = ""
+		}
+		for i2 := 0; i2 < len(in.Data.Cloudlets[i1].InfraFlavors); i2++ {
+			for i3 := 0; i3 < len(in.Data.Cloudlets[i1].InfraFlavors[i2].Gpus); i3++ {
+			}
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.Cloudlets[i1].ObjId = ""
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.Cloudlets[i1].DbModelId = 0
+		}
+	}
+	for i1 := 0; i1 < len(in.Data.CloudletInfos); i1++ {
+		if _, found := tags["nocmp"]; found {
+			in.Data.CloudletInfos[i1].NotifyId = 0
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.CloudletInfos[i1].Controller = ""
+		}
+		for i2 := 0; i2 < len(in.Data.CloudletInfos[i1].Flavors); i2++ {
+			for i3 := 0; i3 < len(in.Data.CloudletInfos[i1].Flavors[i2].Gpus); i3++ {
+			}
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.CloudletInfos[i1].Status = edgeproto.StatusInfo{}
+		}
+		for i2 := 0; i2 < len(in.Data.CloudletInfos[i1].AvailabilityZones); i2++ {
+		}
+		for i2 := 0; i2 < len(in.Data.CloudletInfos[i1].OsImages); i2++ {
+		}
+		for i3 := 0; i3 < len(in.Data.CloudletInfos[i1].ResourcesSnapshot.PlatformVms); i3++ {
+			for i4 := 0; i4 < len(in.Data.CloudletInfos[i1].ResourcesSnapshot.PlatformVms[i3].Ipaddresses); i4++ {
+			}
+			for i4 := 0; i4 < len(in.Data.CloudletInfos[i1].ResourcesSnapshot.PlatformVms[i3].Containers); i4++ {
+			}
+		}
+		for i3 := 0; i3 < len(in.Data.CloudletInfos[i1].ResourcesSnapshot.Info); i3++ {
+		}
+		for i3 := 0; i3 < len(in.Data.CloudletInfos[i1].ResourcesSnapshot.ClusterInsts); i3++ {
+		}
+		for i3 := 0; i3 < len(in.Data.CloudletInfos[i1].ResourcesSnapshot.VmAppInsts); i3++ {
+		}
+		for i3 := 0; i3 < len(in.Data.CloudletInfos[i1].ResourcesSnapshot.K8SAppInsts); i3++ {
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.CloudletInfos[i1].CompatibilityVersion = 0
+		}
+		for i2 := 0; i2 < len(in.Data.CloudletInfos[i1].NodeInfos); i2++ {
+			for i3 := 0; i3 < len(in.Data.CloudletInfos[i1].NodeInfos[i2].Gpus); i3++ {
+			}
+		}
+		for i2 := 0; i2 < len(in.Data.CloudletInfos[i1].NodePools); i2++ {
+			for i4 := 0; i4 < len(in.Data.CloudletInfos[i1].NodePools[i2].NodeResources.Gpus); i4++ {
+			}
+		}
+	}
+	for i1 := 0; i1 < len(in.Data.ZonePools); i1++ {
+		for i2 := 0; i2 < len(in.Data.ZonePools[i1].Zones); i2++ {
+		}
+		if _, found := tags["timestamp"]; found {
+			in.Data.ZonePools[i1].CreatedAt = distributed_match_engine.Timestamp{}
+		}
+		if _, found := tags["timestamp"]; found {
+			in.Data.ZonePools[i1].UpdatedAt = distributed_match_engine.Timestamp{}
+		}
+	}
+	for i1 := 0; i1 < len(in.Data.Networks); i1++ {
+		for i2 := 0; i2 < len(in.Data.Networks[i1].Routes); i2++ {
+		}
+	}
+	for i1 := 0; i1 < len(in.Data.AutoProvPolicies); i1++ {
+		for i2 := 0; i2 < len(in.Data.AutoProvPolicies[i1].Zones); i2++ {
+		}
+	}
+	for i1 := 0; i1 < len(in.Data.AutoProvPolicyZones); i1++ {
+	}
+	for i1 := 0; i1 < len(in.Data.AutoScalePolicies); i1++ {
+	}
+	for i1 := 0; i1 < len(in.Data.ClusterInsts); i1++ {
+		if _, found := tags["nocmp"]; found {
+			in.Data.ClusterInsts[i1].Errors = nil
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.ClusterInsts[i1].AllocatedIp = ""
+		}
+		for i3 := 0; i3 < len(in.Data.ClusterInsts[i1].Resources.Vms); i3++ {
+			for i4 := 0; i4 < len(in.Data.ClusterInsts[i1].Resources.Vms[i3].Ipaddresses); i4++ {
+			}
+			for i4 := 0; i4 < len(in.Data.ClusterInsts[i1].Resources.Vms[i3].Containers); i4++ {
+			}
+		}
+		if _, found := tags["timestamp"]; found {
+			in.Data.ClusterInsts[i1].CreatedAt = distributed_match_engine.Timestamp{}
+		}
+		if _, found := tags["timestamp"]; found {
+			in.Data.ClusterInsts[i1].UpdatedAt = distributed_match_engine.Timestamp{}
+		}
+		if _, found := tags["timestamp"]; found {
+			in.Data.ClusterInsts[i1].ReservationEndedAt = distributed_match_engine.Timestamp{}
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.ClusterInsts[i1].ObjId = ""
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.ClusterInsts[i1].CompatibilityVersion = 0
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.ClusterInsts[i1].DbModelId = 0
+		}
+		for i3 := 0; i3 < len(in.Data.ClusterInsts[i1].NodeResources.Gpus); i3++ {
+		}
+		for i2 := 0; i2 < len(in.Data.ClusterInsts[i1].NodePools); i2++ {
+			for i4 := 0; i4 < len(in.Data.ClusterInsts[i1].NodePools[i2].NodeResources.Gpus); i4++ {
+			}
+		}
+	}
+	for i1 := 0; i1 < len(in.Data.GeoFencePolicies); i1++ {
+		for i2 := 0; i2 < len(in.Data.GeoFencePolicies[i1].Fences); i2++ {
+			for i3 := 0; i3 < len(in.Data.GeoFencePolicies[i1].Fences[i2].Polygon); i3++ {
+			}
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.GeoFencePolicies[i1].DeletePrepare = false
+		}
+	}
+	for i1 := 0; i1 < len(in.Data.Apps); i1++ {
+		if _, found := tags["nocmp"]; found {
+			in.Data.Apps[i1].AuthPublicKey = ""
+		}
+		for i2 := 0; i2 < len(in.Data.Apps[i1].Configs); i2++ {
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.Apps[i1].Revision = ""
+		}
+		if _, found := tags["timestamp"]; found {
+			in.Data.Apps[i1].CreatedAt = distributed_match_engine.Timestamp{}
+		}
+		if _, found := tags["timestamp"]; found {
+			in.Data.Apps[i1].UpdatedAt = distributed_match_engine.Timestamp{}
+		}
+		for i2 := 0; i2 < len(in.Data.Apps[i1].RequiredOutboundConnections); i2++ {
+		}
+		for i4 := 0; i4 < len(in.Data.Apps[i1].KubernetesResources.CpuPool.TotalGpus); i4++ {
+		}
+		for i5 := 0; i5 < len(in.Data.Apps[i1].KubernetesResources.CpuPool.Topology.MinNodeGpus); i5++ {
+		}
+		for i4 := 0; i4 < len(in.Data.Apps[i1].KubernetesResources.GpuPool.TotalGpus); i4++ {
+		}
+		for i5 := 0; i5 < len(in.Data.Apps[i1].KubernetesResources.GpuPool.Topology.MinNodeGpus); i5++ {
+		}
+		for i3 := 0; i3 < len(in.Data.Apps[i1].NodeResources.Gpus); i3++ {
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.Apps[i1].ObjId = ""
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.Apps[i1].CompatibilityVersion = 0
+		}
+	}
+	for i1 := 0; i1 < len(in.Data.AppInstances); i1++ {
+		for i2 := 0; i2 < len(in.Data.AppInstances[i1].MappedPorts); i2++ {
+		}
+		if _, found := tags["timestamp"]; found {
+			in.Data.AppInstances[i1].CreatedAt = distributed_match_engine.Timestamp{}
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.AppInstances[i1].Revision = ""
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.AppInstances[i1].ForceUpdate = false
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.AppInstances[i1].UpdateMultiple = false
+		}
+		for i2 := 0; i2 < len(in.Data.AppInstances[i1].Configs); i2++ {
+		}
+		if _, found := tags["timestamp"]; found {
+			in.Data.AppInstances[i1].UpdatedAt = distributed_match_engine.Timestamp{}
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.AppInstances[i1].CompatibilityVersion = 0
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.AppInstances[i1].VirtualClusterKey = edgeproto.ClusterKey{}
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.AppInstances[i1].ObjId = ""
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.AppInstances[i1].DbModelId = 0
+		}
+		for i4 := 0; i4 < len(in.Data.AppInstances[i1].KubernetesResources.CpuPool.TotalGpus); i4++ {
+		}
+		for i5 := 0; i5 < len(in.Data.AppInstances[i1].KubernetesResources.CpuPool.Topology.MinNodeGpus); i5++ {
+		}
+		for i4 := 0; i4 < len(in.Data.AppInstances[i1].KubernetesResources.GpuPool.TotalGpus); i4++ {
+		}
+		for i5 := 0; i5 < len(in.Data.AppInstances[i1].KubernetesResources.GpuPool.Topology.MinNodeGpus); i5++ {
+		}
+		for i3 := 0; i3 < len(in.Data.AppInstances[i1].NodeResources.Gpus); i3++ {
+		}
+	}
+	for i1 := 0; i1 < len(in.Data.AppInstRefs); i1++ {
+	}
+	for i1 := 0; i1 < len(in.Data.ClusterRefs); i1++ {
+		for i2 := 0; i2 < len(in.Data.ClusterRefs[i1].Apps); i2++ {
+		}
+	}
+	for i1 := 0; i1 < len(in.Data.VmPools); i1++ {
+		for i2 := 0; i2 < len(in.Data.VmPools[i1].Vms); i2++ {
+			if _, found := tags["timestamp"]; found {
+				in.Data.VmPools[i1].Vms[i2].UpdatedAt = types.Timestamp{}
+			}
+			for i4 := 0; i4 < len(in.Data.VmPools[i1].Vms[i2].Flavor.Gpus); i4++ {
+			}
+		}
+		if _, found := tags["nocmp"]; found {
+			in.Data.VmPools[i1].Errors = nil
+		}
+	}
+	for i1 := 0; i1 < len(in.Data.AlertPolicies); i1++ {
+		if _, found := tags["nocmp"]; found {
+			in.Data.AlertPolicies[i1].DeletePrepare = false
+		}
+	}
+	for i1 := 0; i1 < len(in.Data.FlowRateLimitSettings); i1++ {
+	}
+	for i1 := 0; i1 < len(in.Data.MaxReqsRateLimitSettings); i1++ {
+	}
+	for i1 := 0; i1 < len(in.Data.TrustPolicyExceptions); i1++ {
+		for i2 := 0; i2 < len(in.Data.TrustPolicyExceptions[i1].OutboundSecurityRules); i2++ {
+		}
+	}
+	for i0 := 0; i0 < len(in.InternalEntries); i0++ {
+	}
+}
+
+var DataSnapshotApiCmd edgeproto.DataSnapshotApiClient
+
+var ExportDataCmd = &cli.Command{
+	Use:          "ExportData",
+	RequiredArgs: strings.Join(DataSnapshotRequestRequiredArgs, " "),
+	OptionalArgs: strings.Join(DataSnapshotRequestOptionalArgs, " "),
+	AliasArgs:    strings.Join(DataSnapshotRequestAliasArgs, " "),
+	SpecialArgs:  &DataSnapshotRequestSpecialArgs,
+	Comments:     DataSnapshotRequestComments,
+	ReqData:      &edgeproto.DataSnapshotRequest{},
+	ReplyData:    &edgeproto.DataSnapshot{},
+	Run:          runExportData,
+}
+
+func runExportData(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.DataSnapshotRequest)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return ExportData(c, obj)
+}
+
+func ExportData(c *cli.Command, in *edgeproto.DataSnapshotRequest) error {
+	if DataSnapshotApiCmd == nil {
+		return fmt.Errorf("DataSnapshotApi client not initialized")
+	}
+	ctx := context.Background()
+	obj, err := DataSnapshotApiCmd.ExportData(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("ExportData failed: %s", errstr)
+	}
+	DataSnapshotHideTags(obj)
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func ExportDatas(c *cli.Command, data []edgeproto.DataSnapshotRequest, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("ExportData %v\n", data[ii])
+		myerr := ExportData(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var RestoreDataCmd = &cli.Command{
+	Use:          "RestoreData",
+	RequiredArgs: strings.Join(DataSnapshotRequiredArgs, " "),
+	OptionalArgs: strings.Join(DataSnapshotOptionalArgs, " "),
+	AliasArgs:    strings.Join(DataSnapshotAliasArgs, " "),
+	SpecialArgs:  &DataSnapshotSpecialArgs,
+	Comments:     DataSnapshotComments,
+	ReqData:      &edgeproto.DataSnapshot{},
+	ReplyData:    &edgeproto.Result{},
+	Run:          runRestoreData,
+}
+
+func runRestoreData(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.DataSnapshot)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return RestoreData(c, obj)
+}
+
+func RestoreData(c *cli.Command, in *edgeproto.DataSnapshot) error {
+	if DataSnapshotApiCmd == nil {
+		return fmt.Errorf("DataSnapshotApi client not initialized")
+	}
+	ctx := context.Background()
+	obj, err := DataSnapshotApiCmd.RestoreData(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("RestoreData failed: %s", errstr)
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func RestoreDatas(c *cli.Command, data []edgeproto.DataSnapshot, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("RestoreData %v\n", data[ii])
+		myerr := RestoreData(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var DataSnapshotApiCmds = []*cobra.Command{
+	ExportDataCmd.GenCmd(),
+	RestoreDataCmd.GenCmd(),
+}
+
+var DataSnapshotRequestRequiredArgs = []string{}
+var DataSnapshotRequestOptionalArgs = []string{
+	"revision",
+}
+var DataSnapshotRequestAliasArgs = []string{}
+var DataSnapshotRequestComments = map[string]string{
+	"revision": "Database revision to export the data at, defaults to the latest revision. Older revisions are only available until the database history is compacted.",
+}
+var DataSnapshotRequestSpecialArgs = map[string]string{}
+var DataSnapshotEntryRequiredArgs = []string{}
+var DataSnapshotEntryOptionalArgs = []string{
+	"dbkey",
+	"dbvalue",
+}
+var DataSnapshotEntryAliasArgs = []string{}
+var DataSnapshotEntryComments = map[string]string{
+	"dbkey":   "Database key, without the region prefix",
+	"dbvalue": "Database value",
+}
+var DataSnapshotEntrySpecialArgs = map[string]string{}
+var DataSnapshotRequiredArgs = []string{}
+var DataSnapshotOptionalArgs = []string{
+	"region",
+	"datamodelhash",
+	"datamodelid",
+	"revision",
+	"data.flavors:#.fields",
+	"data.flavors:#.key.name",
+	"data.flavors:#.ram",
+	"data.flavors:#.vcpus",
+	"data.flavors:#.disk",
+	"data.flavors:#.gpus:#.modelid",
+	"data.flavors:#.gpus:#.count",
+	"data.flavors:#.gpus:#.vendor",
+	"data.flavors:#.gpus:#.memory",
+	"data.flavors:#.gpus:#.inuse",
+	"data.flavors:#.optresmap",
+	"data.flavors:#.deleteprepare",
+	"data.settings.fields",
+	"data.settings.shepherdmetricscollectioninterval",
+	"data.settings.shepherdalertevaluationinterval",
+	"data.settings.shepherdmetricsscrapeinterval",
+	"data.settings.shepherdhealthcheckretries",
+	"data.settings.shepherdhealthcheckinterval",
+	"data.settings.autodeployintervalsec",
+	"data.settings.autodeployoffsetsec",
+	"data.settings.autodeploymaxintervals",
+	"data.settings.createappinsttimeout",
+	"data.settings.updateappinsttimeout",
+	"data.settings.deleteappinsttimeout",
+	"data.settings.createclusterinsttimeout",
+	"data.settings.updateclusterinsttimeout",
+	"data.settings.deleteclusterinsttimeout",
+	"data.settings.masternodeflavor",
+	"data.settings.maxtrackeddmeclients",
+	"data.settings.influxdbmetricsretention",
+	"data.settings.cloudletmaintenancetimeout",
+	"data.settings.updatevmpooltimeout",
+	"data.settings.updatetrustpolicytimeout",
+	"data.settings.dmeapimetricscollectioninterval",
+	"data.settings.edgeeventsmetricscollectioninterval",
+	"data.settings.cleanupreservableautoclusteridletime",
+	"data.settings.influxdbcloudletusagemetricsretention",
+	"data.settings.createcloudlettimeout",
+	"data.settings.updatecloudlettimeout",
+	"data.settings.locationtilesidelengthkm",
+	"data.settings.edgeeventsmetricscontinuousqueriescollectionintervals:#.interval",
+	"data.settings.edgeeventsmetricscontinuousqueriescollectionintervals:#.retention",
+	"data.settings.influxdbdownsampledmetricsretention",
+	"data.settings.influxdbedgeeventsmetricsretention",
+	"data.settings.appinstclientcleanupinterval",
+	"data.settings.clusterautoscaleaveragingdurationsec",
+	"data.settings.clusterautoscaleretrydelay",
+	"data.settings.alertpolicymintriggertime",
+	"data.settings.disableratelimit",
+	"data.settings.ratelimitmaxtrackedips",
+	"data.settings.resourcesnapshotthreadinterval",
+	"data.settings.platformhainstancepollinterval",
+	"data.settings.platformhainstanceactiveexpiretime",
+	"data.settings.ccrmapitimeout",
+	"data.settings.findcloudletscoreweights.distance",
+	"data.settings.findcloudletscoreweights.latency",
+	"data.settings.findcloudletscoreweights.resourceusage",
+	"data.settings.findcloudletscoreweights.health",
+	"data.settings.findcloudletdistribution",
+	"data.settings.findcloudletstickysessions",
+	"data.settings.maxobjecthistoryrevisions",
+	"data.operatorcodes:#.code",
+	"data.operatorcodes:#.organization",
+	"data.restagtables:#.fields",
+	"data.restagtables:#.key.name",
+	"data.restagtables:#.key.organization",
+	"data.restagtables:#.tags",
+	"data.restagtables:#.azone",
+	"data.restagtables:#.deleteprepare",
+	"data.trustpolicies:#.fields",
+	"data.trustpolicies:#.key.organization",
+	"data.trustpolicies:#.key.name",
+	"data.trustpolicies:#.outboundsecurityrules:#.protocol",
+	"data.trustpolicies:#.outboundsecurityrules:#.portrangemin",
+	"data.trustpolicies:#.outboundsecurityrules:#.portrangemax",
+	"data.trustpolicies:#.outboundsecurityrules:#.remotecidr",
+	"data.trustpolicies:#.deleteprepare",
+	"data.gpudrivers:#.fields",
+	"data.gpudrivers:#.key.name",
+	"data.gpudrivers:#.key.organization",
+	"data.gpudrivers:#.builds:#.name",
+	"data.gpudrivers:#.builds:#.driverpath",
+	"data.gpudrivers:#.builds:#.driverpathcreds",
+	"data.gpudrivers:#.builds:#.operatingsystem",
+	"data.gpudrivers:#.builds:#.kernelversion",
+	"data.gpudrivers:#.builds:#.hypervisorinfo",
+	"data.gpudrivers:#.builds:#.md5sum",
+	"data.gpudrivers:#.builds:#.storagepath",
+	"data.gpudrivers:#.licenseconfig",
+	"data.gpudrivers:#.licenseconfigmd5sum",
+	"data.gpudrivers:#.properties",
+	"data.gpudrivers:#.state",
+	"data.gpudrivers:#.ignorestate",
+	"data.gpudrivers:#.deleteprepare",
+	"data.gpudrivers:#.storagebucketname",
+	"data.gpudrivers:#.licenseconfigstoragepath",
+	"data.platformfeatures:#.platformtype",
+	"data.platformfeatures:#.nodetype",
+	"data.platformfeatures:#.supportsmultitenantcluster",
+	"data.platformfeatures:#.supportssharedvolume",
+	"data.platformfeatures:#.supportstrustpolicy",
+	"data.platformfeatures:#.supportskubernetesonly",
+	"data.platformfeatures:#.kubernetesrequiresworkernodes",
+	"data.platformfeatures:#.cloudletserviceslocal",
+	"data.platformfeatures:#.ipallocatedperservice",
+	"data.platformfeatures:#.supportsimagetypeovf",
+	"data.platformfeatures:#.supportsimagetypeova",
+	"data.platformfeatures:#.isvmpool",
+	"data.platformfeatures:#.isfake",
+	"data.platformfeatures:#.ismock",
+	"data.platformfeatures:#.supportsadditionalnetworks",
+	"data.platformfeatures:#.issinglekubernetescluster",
+	"data.platformfeatures:#.supportsappinstdedicatedip",
+	"data.platformfeatures:#.supportsplatformhighavailabilityonk8s",
+	"data.platformfeatures:#.supportsplatformhighavailabilityondocker",
+	"data.platformfeatures:#.nokubernetesclusterautoscale",
+	"data.platformfeatures:#.isprebuiltkubernetescluster",
+	"data.platformfeatures:#.noclustersupport",
+	"data.platformfeatures:#.isedgebox",
+	"data.platformfeatures:#.supportsipv6",
+	"data.platformfeatures:#.requirescrmonedge",
+	"data.platformfeatures:#.requirescrmoffedge",
+	"data.platformfeatures:#.requirescertrefresh",
+	"data.platformfeatures:#.supportsmultiplenodepools",
+	"data.platformfeatures:#.managesk8scontrolnodes",
+	"data.platformfeatures:#.usesingress",
+	"data.platformfeatures:#.requiresgpudriver",
+	"data.platformfeatures:#.usesrootlb",
+	"data.platformfeatures:#.supportscloudletmanagedclusters",
+	"data.platformfeatures:#.resourcequotaproperties:#.name",
+	"data.platformfeatures:#.resourcequotaproperties:#.value",
+	"data.platformfeatures:#.resourcequotaproperties:#.inframaxvalue",
+	"data.platformfeatures:#.resourcequotaproperties:#.quotamaxvalue",
+	"data.platformfeatures:#.resourcequotaproperties:#.description",
+	"data.platformfeatures:#.resourcequotaproperties:#.units",
+	"data.platformfeatures:#.resourcequotaproperties:#.type",
+	"data.platformfeatures:#.resourcequotaproperties:#.alertthreshold",
+	"data.platformfeatures:#.deleteprepare",
+	"data.zones:#.fields",
+	"data.zones:#.key.organization",
+	"data.zones:#.key.name",
+	"data.zones:#.key.federatedorganization",
+	"data.zones:#.description",
+	"data.zones:#.infraflavors:#.name",
+	"data.zones:#.infraflavors:#.vcpus",
+	"data.zones:#.infraflavors:#.ram",
+	"data.zones:#.infraflavors:#.disk",
+	"data.zones:#.infraflavors:#.gpus:#.modelid",
+	"data.zones:#.infraflavors:#.gpus:#.count",
+	"data.zones:#.infraflavors:#.gpus:#.vendor",
+	"data.zones:#.infraflavors:#.gpus:#.memory",
+	"data.zones:#.infraflavors:#.gpus:#.inuse",
+	"data.zones:#.infraflavors:#.propmap",
+	"data.zones:#.location.latitude",
+	"data.zones:#.location.longitude",
+	"data.zones:#.location.horizontalaccuracy",
+	"data.zones:#.location.verticalaccuracy",
+	"data.zones:#.location.altitude",
+	"data.zones:#.location.course",
+	"data.zones:#.location.speed",
+	"data.zones:#.location.timestamp",
+	"data.zones:#.objid",
+	"data.zones:#.deleteprepare",
+	"data.zones:#.createdat",
+	"data.zones:#.updatedat",
+	"data.cloudlets:#.fields",
+	"data.cloudlets:#.key.organization",
+	"data.cloudlets:#.key.name",
+	"data.cloudlets:#.key.federatedorganization",
+	"data.cloudlets:#.location.latitude",
+	"data.cloudlets:#.location.longitude",
+	"data.cloudlets:#.location.horizontalaccuracy",
+	"data.cloudlets:#.location.verticalaccuracy",
+	"data.cloudlets:#.location.altitude",
+	"data.cloudlets:#.location.course",
+	"data.cloudlets:#.location.speed",
+	"data.cloudlets:#.location.timestamp",
+	"data.cloudlets:#.zone",
+	"data.cloudlets:#.ipsupport",
+	"data.cloudlets:#.staticips",
+	"data.cloudlets:#.numdynamicips",
+	"data.cloudlets:#.timelimits.createclusterinsttimeout",
+	"data.cloudlets:#.timelimits.updateclusterinsttimeout",
+	"data.cloudlets:#.timelimits.deleteclusterinsttimeout",
+	"data.cloudlets:#.timelimits.createappinsttimeout",
+	"data.cloudlets:#.timelimits.updateappinsttimeout",
+	"data.cloudlets:#.timelimits.deleteappinsttimeout",
+	"data.cloudlets:#.errors",
+	"data.cloudlets:#.state",
+	"data.cloudlets:#.crmoverride",
+	"data.cloudlets:#.deploymentlocal",
+	"data.cloudlets:#.platformtype",
+	"data.cloudlets:#.notifysrvaddr",
+	"data.cloudlets:#.flavor.name",
+	"data.cloudlets:#.physicalname",
+	"data.cloudlets:#.envvar",
+	"data.cloudlets:#.containerversion",
+	"data.cloudlets:#.config.containerregistrypath",
+	"data.cloudlets:#.config.cloudletvmimagepath",
+	"data.cloudlets:#.config.notifyctrladdrs",
+	"data.cloudlets:#.config.tlscertfile",
+	"data.cloudlets:#.config.tlskeyfile",
+	"data.cloudlets:#.config.tlscafile",
+	"data.cloudlets:#.config.envvar",
+	"data.cloudlets:#.config.platformtag",
+	"data.cloudlets:#.config.testmode",
+	"data.cloudlets:#.config.span",
+	"data.cloudlets:#.config.cleanupmode",
+	"data.cloudlets:#.config.region",
+	"data.cloudlets:#.config.commercialcerts",
+	"data.cloudlets:#.config.usevaultpki",
+	"data.cloudlets:#.config.appdnsroot",
+	"data.cloudlets:#.config.deploymenttag",
+	"data.cloudlets:#.config.crmaccessprivatekey",
+	"data.cloudlets:#.config.accessapiaddr",
+	"data.cloudlets:#.config.cachedir",
+	"data.cloudlets:#.config.secondarycrmaccessprivatekey",
+	"data.cloudlets:#.config.thanosrecvaddr",
+	"data.cloudlets:#.config.ansiblepublicaddr",
+	"data.cloudlets:#.config.envoywithcurlimage",
+	"data.cloudlets:#.config.nginxwithcurlimage",
+	"data.cloudlets:#.accessvars",
+	"data.cloudlets:#.vmimageversion",
+	"data.cloudlets:#.deployment",
+	"data.cloudlets:#.infraapiaccess",
+	"data.cloudlets:#.infraconfig.externalnetworkname",
+	"data.cloudlets:#.infraconfig.flavorname",
+	"data.cloudlets:#.maintenancestate",
+	"data.cloudlets:#.overridepolicycontainerversion",
+	"data.cloudlets:#.vmpool",
+	"data.cloudlets:#.crmaccesspublickey",
+	"data.cloudlets:#.crmaccesskeyupgraderequired",
+	"data.cloudlets:#.createdat",
+	"data.cloudlets:#.updatedat",
+	"data.cloudlets:#.trustpolicy",
+	"data.cloudlets:#.trustpolicystate",
+	"data.cloudlets:#.resourcequotas:#.name",
+	"data.cloudlets:#.resourcequotas:#.value",
+	"data.cloudlets:#.resourcequotas:#.alertthreshold",
+	"data.cloudlets:#.resourcequotas:#.resourcetype",
+	"data.cloudlets:#.defaultresourcealertthreshold",
+	"data.cloudlets:#.hostcontroller",
+	"data.cloudlets:#.kafkacluster",
+	"data.cloudlets:#.kafkauser",
+	"data.cloudlets:#.kafkapassword",
+	"data.cloudlets:#.gpuconfig.driver.name",
+	"data.cloudlets:#.gpuconfig.driver.organization",
+	"data.cloudlets:#.gpuconfig.properties",
+	"data.cloudlets:#.gpuconfig.licenseconfig",
+	"data.cloudlets:#.gpuconfig.licenseconfigmd5sum",
+	"data.cloudlets:#.enabledefaultserverlesscluster",
+	"data.cloudlets:#.allianceorgs",
+	"data.cloudlets:#.singlekubernetesclusterowner",
+	"data.cloudlets:#.deleteprepare",
+	"data.cloudlets:#.platformhighavailability",
+	"data.cloudlets:#.secondarycrmaccesspublickey",
+	"data.cloudlets:#.secondarycrmaccesskeyupgraderequired",
+	"data.cloudlets:#.secondarynotifysrvaddr",
+	"data.cloudlets:#.dnslabel",
+	"data.cloudlets:#.rootlbfqdn",
+	"data.cloudlets:#.staticrootlbfqdn",
+	"data.cloudlets:#.federationconfig.federationcontextid",
+	"data.cloudlets:#.federationconfig.partnerfederationaddr",
+	"data.cloudlets:#.federationconfig.federationdbid",
+	"data.cloudlets:#.federationconfig.federationname",
+	"data.cloudlets:#.licenseconfigstoragepath",
+	"data.cloudlets:#.infraflavors:#.name",
+	"data.cloudlets:#.infraflavors:#.vcpus",
+	"data.cloudlets:#.infraflavors:#.ram",
+	"data.cloudlets:#.infraflavors:#.disk",
+	"data.cloudlets:#.infraflavors:#.gpus:#.modelid",
+	"data.cloudlets:#.infraflavors:#.gpus:#.count",
+	"data.cloudlets:#.infraflavors:#.gpus:#.vendor",
+	"data.cloudlets:#.infraflavors:#.gpus:#.memory",
+	"data.cloudlets:#.infraflavors:#.gpus:#.inuse",
+	"data.cloudlets:#.infraflavors:#.propmap",
+	"data.cloudlets:#.edgeboxonly",
+	"data.cloudlets:#.crmonedge",
+	"data.cloudlets:#.objid",
+	"data.cloudlets:#.annotations",
+	"data.cloudlets:#.dbmodelid",
+	"data.cloudlets:#.dryrun",
+	"data.cloudlets:#.maintenancewindow.starttime",
+	"data.cloudlets:#.maintenancewindow.endtime",
+	"data.cloudlets:#.maintenancewindow.failoverleadtime",
+	"data.cloudlets:#.maintenancewindow.nofailover",
+	"data.cloudlets:#.maintenancewindow.state",
+	"data.cloudletinfos:#.fields",
+	"data.cloudletinfos:#.key.organization",
+	"data.cloudletinfos:#.key.name",
+	"data.cloudletinfos:#.key.federatedorganization",
+	"data.cloudletinfos:#.state",
+	"data.cloudletinfos:#.notifyid",
+	"data.cloudletinfos:#.controller",
+	"data.cloudletinfos:#.osmaxram",
+	"data.cloudletinfos:#.osmaxvcores",
+	"data.cloudletinfos:#.osmaxvolgb",
+	"data.cloudletinfos:#.errors",
+	"data.cloudletinfos:#.flavors:#.name",
+	"data.cloudletinfos:#.flavors:#.vcpus",
+	"data.cloudletinfos:#.flavors:#.ram",
+	"data.cloudletinfos:#.flavors:#.disk",
+	"data.cloudletinfos:#.flavors:#.gpus:#.modelid",
+	"data.cloudletinfos:#.flavors:#.gpus:#.count",
+	"data.cloudletinfos:#.flavors:#.gpus:#.vendor",
+	"data.cloudletinfos:#.flavors:#.gpus:#.memory",
+	"data.cloudletinfos:#.flavors:#.gpus:#.inuse",
+	"data.cloudletinfos:#.flavors:#.propmap",
+	"data.cloudletinfos:#.status.tasknumber",
+	"data.cloudletinfos:#.status.maxtasks",
+	"data.cloudletinfos:#.status.taskname",
+	"data.cloudletinfos:#.status.stepname",
+	"data.cloudletinfos:#.status.msgcount",
+	"data.cloudletinfos:#.status.msgs",
+	"data.cloudletinfos:#.containerversion",
+	"data.cloudletinfos:#.availabilityzones:#.name",
+	"data.cloudletinfos:#.availabilityzones:#.status",
+	"data.cloudletinfos:#.osimages:#.name",
+	"data.cloudletinfos:#.osimages:#.tags",
+	"data.cloudletinfos:#.osimages:#.properties",
+	"data.cloudletinfos:#.osimages:#.diskformat",
+	"data.cloudletinfos:#.controllercachereceived",
+	"data.cloudletinfos:#.maintenancestate",
+	"data.cloudletinfos:#.resourcessnapshot.platformvms:#.name",
+	"data.cloudletinfos:#.resourcessnapshot.platformvms:#.type",
+	"data.cloudletinfos:#.resourcessnapshot.platformvms:#.status",
+	"data.cloudletinfos:#.resourcessnapshot.platformvms:#.infraflavor",
+	"data.cloudletinfos:#.resourcessnapshot.platformvms:#.ipaddresses:#.externalip",
+	"data.cloudletinfos:#.resourcessnapshot.platformvms:#.ipaddresses:#.internalip",
+	"data.cloudletinfos:#.resourcessnapshot.platformvms:#.containers:#.name",
+	"data.cloudletinfos:#.resourcessnapshot.platformvms:#.containers:#.type",
+	"data.cloudletinfos:#.resourcessnapshot.platformvms:#.containers:#.status",
+	"data.cloudletinfos:#.resourcessnapshot.platformvms:#.containers:#.clusterip",
+	"data.cloudletinfos:#.resourcessnapshot.platformvms:#.containers:#.restarts",
+	"data.cloudletinfos:#.resourcessnapshot.info:#.name",
+	"data.cloudletinfos:#.resourcessnapshot.info:#.value",
+	"data.cloudletinfos:#.resourcessnapshot.info:#.inframaxvalue",
+	"data.cloudletinfos:#.resourcessnapshot.info:#.quotamaxvalue",
+	"data.cloudletinfos:#.resourcessnapshot.info:#.description",
+	"data.cloudletinfos:#.resourcessnapshot.info:#.units",
+	"data.cloudletinfos:#.resourcessnapshot.info:#.type",
+	"data.cloudletinfos:#.resourcessnapshot.info:#.alertthreshold",
+	"data.cloudletinfos:#.resourcessnapshot.clusterinsts:#.name",
+	"data.cloudletinfos:#.resourcessnapshot.clusterinsts:#.organization",
+	"data.cloudletinfos:#.resourcessnapshot.vmappinsts:#.name",
+	"data.cloudletinfos:#.resourcessnapshot.vmappinsts:#.organization",
+	"data.cloudletinfos:#.resourcessnapshot.k8sappinsts:#.name",
+	"data.cloudletinfos:#.resourcessnapshot.k8sappinsts:#.organization",
+	"data.cloudletinfos:#.trustpolicystate",
+	"data.cloudletinfos:#.compatibilityversion",
+	"data.cloudletinfos:#.properties",
+	"data.cloudletinfos:#.nodeinfos:#.name",
+	"data.cloudletinfos:#.nodeinfos:#.gpus:#.modelid",
+	"data.cloudletinfos:#.nodeinfos:#.gpus:#.count",
+	"data.cloudletinfos:#.nodeinfos:#.gpus:#.vendor",
+	"data.cloudletinfos:#.nodeinfos:#.gpus:#.memory",
+	"data.cloudletinfos:#.nodeinfos:#.gpus:#.inuse",
+	"data.cloudletinfos:#.nodeinfos:#.gpusoftware.driverversion",
+	"data.cloudletinfos:#.nodeinfos:#.gpusoftware.runtimeversion",
+	"data.cloudletinfos:#.activecrminstance",
+	"data.cloudletinfos:#.standbycrm",
+	"data.cloudletinfos:#.releaseversion",
+	"data.cloudletinfos:#.nodepools:#.name",
+	"data.cloudletinfos:#.nodepools:#.numnodes",
+	"data.cloudletinfos:#.nodepools:#.noderesources.vcpus",
+	"data.cloudletinfos:#.nodepools:#.noderesources.ram",
+	"data.cloudletinfos:#.nodepools:#.noderesources.disk",
+	"data.cloudletinfos:#.nodepools:#.noderesources.gpus:#.modelid",
+	"data.cloudletinfos:#.nodepools:#.noderesources.gpus:#.count",
+	"data.cloudletinfos:#.nodepools:#.noderesources.gpus:#.vendor",
+	"data.cloudletinfos:#.nodepools:#.noderesources.gpus:#.memory",
+	"data.cloudletinfos:#.nodepools:#.noderesources.gpus:#.inuse",
+	"data.cloudletinfos:#.nodepools:#.noderesources.optresmap",
+	"data.cloudletinfos:#.nodepools:#.noderesources.infranodeflavor",
+	"data.cloudletinfos:#.nodepools:#.noderesources.externalvolumesize",
+	"data.cloudletinfos:#.nodepools:#.scalable",
+	"data.zonepools:#.fields",
+	"data.zonepools:#.key.organization",
+	"data.zonepools:#.key.name",
+	"data.zonepools:#.zones:#.organization",
+	"data.zonepools:#.zones:#.name",
+	"data.zonepools:#.zones:#.federatedorganization",
+	"data.zonepools:#.createdat",
+	"data.zonepools:#.updatedat",
+	"data.zonepools:#.deleteprepare",
+	"data.networks:#.fields",
+	"data.networks:#.key.cloudletkey.organization",
+	"data.networks:#.key.cloudletkey.name",
+	"data.networks:#.key.cloudletkey.federatedorganization",
+	"data.networks:#.key.name",
+	"data.networks:#.routes:#.destinationcidr",
+	"data.networks:#.routes:#.nexthopip",
+	"data.networks:#.connectiontype",
+	"data.networks:#.deleteprepare",
+	"data.autoprovpolicies:#.fields",
+	"data.autoprovpolicies:#.key.organization",
+	"data.autoprovpolicies:#.key.name",
+	"data.autoprovpolicies:#.deployclientcount",
+	"data.autoprovpolicies:#.deployintervalcount",
+	"data.autoprovpolicies:#.zones:#.organization",
+	"data.autoprovpolicies:#.zones:#.name",
+	"data.autoprovpolicies:#.zones:#.federatedorganization",
+	"data.autoprovpolicies:#.minactiveinstances",
+	"data.autoprovpolicies:#.maxinstances",
+	"data.autoprovpolicies:#.undeployclientcount",
+	"data.autoprovpolicies:#.undeployintervalcount",
+	"data.autoprovpolicies:#.deleteprepare",
+	"data.autoprovpolicyzones:#.key.organization",
+	"data.autoprovpolicyzones:#.key.name",
+	"data.autoprovpolicyzones:#.zonekey.organization",
+	"data.autoprovpolicyzones:#.zonekey.name",
+	"data.autoprovpolicyzones:#.zonekey.federatedorganization",
+	"data.autoscalepolicies:#.fields",
+	"data.autoscalepolicies:#.key.organization",
+	"data.autoscalepolicies:#.key.name",
+	"data.autoscalepolicies:#.minnodes",
+	"data.autoscalepolicies:#.maxnodes",
+	"data.autoscalepolicies:#.scaleupcputhresh",
+	"data.autoscalepolicies:#.scaledowncputhresh",
+	"data.autoscalepolicies:#.triggertimesec",
+	"data.autoscalepolicies:#.stabilizationwindowsec",
+	"data.autoscalepolicies:#.targetcpu",
+	"data.autoscalepolicies:#.targetmem",
+	"data.autoscalepolicies:#.targetactiveconnections",
+	"data.autoscalepolicies:#.deleteprepare",
+	"data.idlereservableclusterinsts.idletime",
+	"data.clusterinsts:#.fields",
+	"data.clusterinsts:#.key.name",
+	"data.clusterinsts:#.key.organization",
+	"data.clusterinsts:#.cloudletkey.organization",
+	"data.clusterinsts:#.cloudletkey.name",
+	"data.clusterinsts:#.cloudletkey.federatedorganization",
+	"data.clusterinsts:#.zonekey.organization",
+	"data.clusterinsts:#.zonekey.name",
+	"data.clusterinsts:#.zonekey.federatedorganization",
+	"data.clusterinsts:#.flavor.name",
+	"data.clusterinsts:#.liveness",
+	"data.clusterinsts:#.auto",
+	"data.clusterinsts:#.state",
+	"data.clusterinsts:#.errors",
+	"data.clusterinsts:#.crmoverride",
+	"data.clusterinsts:#.ipaccess",
+	"data.clusterinsts:#.allocatedip",
+	"data.clusterinsts:#.nodeflavor",
+	"data.clusterinsts:#.deployment",
+	"data.clusterinsts:#.nummasters",
+	"data.clusterinsts:#.numnodes",
+	"data.clusterinsts:#.externalvolumesize",
+	"data.clusterinsts:#.autoscalepolicy",
+	"data.clusterinsts:#.availabilityzone",
+	"data.clusterinsts:#.imagename",
+	"data.clusterinsts:#.reservable",
+	"data.clusterinsts:#.reservedby",
+	"data.clusterinsts:#.sharedvolumesize",
+	"data.clusterinsts:#.masternodeflavor",
+	"data.clusterinsts:#.skipcrmcleanuponfailure",
+	"data.clusterinsts:#.optres",
+	"data.clusterinsts:#.resources.vms:#.name",
+	"data.clusterinsts:#.resources.vms:#.type",
+	"data.clusterinsts:#.resources.vms:#.status",
+	"data.clusterinsts:#.resources.vms:#.infraflavor",
+	"data.clusterinsts:#.resources.vms:#.ipaddresses:#.externalip",
+	"data.clusterinsts:#.resources.vms:#.ipaddresses:#.internalip",
+	"data.clusterinsts:#.resources.vms:#.containers:#.name",
+	"data.clusterinsts:#.resources.vms:#.containers:#.type",
+	"data.clusterinsts:#.resources.vms:#.containers:#.status",
+	"data.clusterinsts:#.resources.vms:#.containers:#.clusterip",
+	"data.clusterinsts:#.resources.vms:#.containers:#.restarts",
+	"data.clusterinsts:#.createdat",
+	"data.clusterinsts:#.updatedat",
+	"data.clusterinsts:#.reservationendedat",
+	"data.clusterinsts:#.multitenant",
+	"data.clusterinsts:#.networks",
+	"data.clusterinsts:#.deleteprepare",
+	"data.clusterinsts:#.dnslabel",
+	"data.clusterinsts:#.fqdn",
+	"data.clusterinsts:#.staticfqdn",
+	"data.clusterinsts:#.enableipv6",
+	"data.clusterinsts:#.objid",
+	"data.clusterinsts:#.compatibilityversion",
+	"data.clusterinsts:#.annotations",
+	"data.clusterinsts:#.dbmodelid",
+	"data.clusterinsts:#.noderesources.vcpus",
+	"data.clusterinsts:#.noderesources.ram",
+	"data.clusterinsts:#.noderesources.disk",
+	"data.clusterinsts:#.noderesources.gpus:#.modelid",
+	"data.clusterinsts:#.noderesources.gpus:#.count",
+	"data.clusterinsts:#.noderesources.gpus:#.vendor",
+	"data.clusterinsts:#.noderesources.gpus:#.memory",
+	"data.clusterinsts:#.noderesources.gpus:#.inuse",
+	"data.clusterinsts:#.noderesources.optresmap",
+	"data.clusterinsts:#.noderesources.infranodeflavor",
+	"data.clusterinsts:#.noderesources.externalvolumesize",
+	"data.clusterinsts:#.nodepools:#.name",
+	"data.clusterinsts:#.nodepools:#.numnodes",
+	"data.clusterinsts:#.nodepools:#.noderesources.vcpus",
+	"data.clusterinsts:#.nodepools:#.noderesources.ram",
+	"data.clusterinsts:#.nodepools:#.noderesources.disk",
+	"data.clusterinsts:#.nodepools:#.noderesources.gpus:#.modelid",
+	"data.clusterinsts:#.nodepools:#.noderesources.gpus:#.count",
+	"data.clusterinsts:#.nodepools:#.noderesources.gpus:#.vendor",
+	"data.clusterinsts:#.nodepools:#.noderesources.gpus:#.memory",
+	"data.clusterinsts:#.nodepools:#.noderesources.gpus:#.inuse",
+	"data.clusterinsts:#.nodepools:#.noderesources.optresmap",
+	"data.clusterinsts:#.nodepools:#.noderesources.infranodeflavor",
+	"data.clusterinsts:#.nodepools:#.noderesources.externalvolumesize",
+	"data.clusterinsts:#.nodepools:#.scalable",
+	"data.clusterinsts:#.infraannotations",
+	"data.clusterinsts:#.kubernetesversion",
+	"data.clusterinsts:#.disabledynamicappinstplacement",
+	"data.clusterinsts:#.cloudletmanagedclusterid",
+	"data.clusterinsts:#.cloudletmanagedclustername",
+	"data.clusterinsts:#.dryrun",
+	"data.clusterinsts:#.tags",
+	"data.geofencepolicies:#.fields",
+	"data.geofencepolicies:#.key.organization",
+	"data.geofencepolicies:#.key.name",
+	"data.geofencepolicies:#.fences:#.name",
+	"data.geofencepolicies:#.fences:#.polygon:#.latitude",
+	"data.geofencepolicies:#.fences:#.polygon:#.longitude",
+	"data.geofencepolicies:#.fences:#.polygon:#.horizontalaccuracy",
+	"data.geofencepolicies:#.fences:#.polygon:#.verticalaccuracy",
+	"data.geofencepolicies:#.fences:#.polygon:#.altitude",
+	"data.geofencepolicies:#.fences:#.polygon:#.course",
+	"data.geofencepolicies:#.fences:#.polygon:#.speed",
+	"data.geofencepolicies:#.fences:#.polygon:#.timestamp",
+	"data.geofencepolicies:#.fences:#.countrycodes",
+	"data.geofencepolicies:#.deleteprepare",
+	"data.apps:#.fields",
+	"data.apps:#.key.organization",
+	"data.apps:#.key.name",
+	"data.apps:#.key.version",
+	"data.apps:#.imagepath",
+	"data.apps:#.imagetype",
+	"data.apps:#.accessports",
+	"data.apps:#.defaultflavor.name",
+	"data.apps:#.authpublickey",
+	"data.apps:#.command",
+	"data.apps:#.commandargs",
+	"data.apps:#.annotations",
+	"data.apps:#.deployment",
+	"data.apps:#.deploymentmanifest",
+	"data.apps:#.deploymentgenerator",
+	"data.apps:#.androidpackagename",
+	"data.apps:#.delopt",
+	"data.apps:#.configs:#.kind",
+	"data.apps:#.configs:#.config",
+	"data.apps:#.scalewithcluster",
+	"data.apps:#.internalports",
+	"data.apps:#.revision",
+	"data.apps:#.officialfqdn",
+	"data.apps:#.md5sum",
+	"data.apps:#.autoprovpolicy",
+	"data.apps:#.accesstype",
+	"data.apps:#.deleteprepare",
+	"data.apps:#.autoprovpolicies",
+	"data.apps:#.templatedelimiter",
+	"data.apps:#.skiphcports",
+	"data.apps:#.createdat",
+	"data.apps:#.updatedat",
+	"data.apps:#.trusted",
+	"data.apps:#.requiredoutboundconnections:#.protocol",
+	"data.apps:#.requiredoutboundconnections:#.portrangemin",
+	"data.apps:#.requiredoutboundconnections:#.portrangemax",
+	"data.apps:#.requiredoutboundconnections:#.remotecidr",
+	"data.apps:#.allowserverless",
+	"data.apps:#.serverlessconfig.vcpus",
+	"data.apps:#.serverlessconfig.ram",
+	"data.apps:#.serverlessconfig.minreplicas",
+	"data.apps:#.serverlessconfig.gpuconfig.type",
+	"data.apps:#.serverlessconfig.gpuconfig.model",
+	"data.apps:#.serverlessconfig.gpuconfig.numgpu",
+	"data.apps:#.serverlessconfig.gpuconfig.ram",
+	"data.apps:#.vmappostype",
+	"data.apps:#.alertpolicies",
+	"data.apps:#.qossessionprofile",
+	"data.apps:#.qossessionduration",
+	"data.apps:#.globalid",
+	"data.apps:#.envvars",
+	"data.apps:#.secretenvvars",
+	"data.apps:#.updatelistaction",
+	"data.apps:#.kubernetesresources.cpupool.totalvcpus",
+	"data.apps:#.kubernetesresources.cpupool.totalmemory",
+	"data.apps:#.kubernetesresources.cpupool.totaldisk",
+	"data.apps:#.kubernetesresources.cpupool.totalgpus:#.modelid",
+	"data.apps:#.kubernetesresources.cpupool.totalgpus:#.count",
+	"data.apps:#.kubernetesresources.cpupool.totalgpus:#.vendor",
+	"data.apps:#.kubernetesresources.cpupool.totalgpus:#.memory",
+	"data.apps:#.kubernetesresources.cpupool.totalgpus:#.inuse",
+	"data.apps:#.kubernetesresources.cpupool.totaloptres",
+	"data.apps:#.kubernetesresources.cpupool.topology.minnodevcpus",
+	"data.apps:#.kubernetesresources.cpupool.topology.minnodememory",
+	"data.apps:#.kubernetesresources.cpupool.topology.minnodedisk",
+	"data.apps:#.kubernetesresources.cpupool.topology.minnodegpus:#.modelid",
+	"data.apps:#.kubernetesresources.cpupool.topology.minnodegpus:#.count",
+	"data.apps:#.kubernetesresources.cpupool.topology.minnodegpus:#.vendor",
+	"data.apps:#.kubernetesresources.cpupool.topology.minnodegpus:#.memory",
+	"data.apps:#.kubernetesresources.cpupool.topology.minnodegpus:#.inuse",
+	"data.apps:#.kubernetesresources.cpupool.topology.minnodeoptres",
+	"data.apps:#.kubernetesresources.cpupool.topology.minnumberofnodes",
+	"data.apps:#.kubernetesresources.gpupool.totalvcpus",
+	"data.apps:#.kubernetesresources.gpupool.totalmemory",
+	"data.apps:#.kubernetesresources.gpupool.totaldisk",
+	"data.apps:#.kubernetesresources.gpupool.totalgpus:#.modelid",
+	"data.apps:#.kubernetesresources.gpupool.totalgpus:#.count",
+	"data.apps:#.kubernetesresources.gpupool.totalgpus:#.vendor",
+	"data.apps:#.kubernetesresources.gpupool.totalgpus:#.memory",
+	"data.apps:#.kubernetesresources.gpupool.totalgpus:#.inuse",
+	"data.apps:#.kubernetesresources.gpupool.totaloptres",
+	"data.apps:#.kubernetesresources.gpupool.topology.minnodevcpus",
+	"data.apps:#.kubernetesresources.gpupool.topology.minnodememory",
+	"data.apps:#.kubernetesresources.gpupool.topology.minnodedisk",
+	"data.apps:#.kubernetesresources.gpupool.topology.minnodegpus:#.modelid",
+	"data.apps:#.kubernetesresources.gpupool.topology.minnodegpus:#.count",
+	"data.apps:#.kubernetesresources.gpupool.topology.minnodegpus:#.vendor",
+	"data.apps:#.kubernetesresources.gpupool.topology.minnodegpus:#.memory",
+	"data.apps:#.kubernetesresources.gpupool.topology.minnodegpus:#.inuse",
+	"data.apps:#.kubernetesresources.gpupool.topology.minnodeoptres",
+	"data.apps:#.kubernetesresources.gpupool.topology.minnumberofnodes",
+	"data.apps:#.kubernetesresources.minkubernetesversion",
+	"data.apps:#.noderesources.vcpus",
+	"data.apps:#.noderesources.ram",
+	"data.apps:#.noderesources.disk",
+	"data.apps:#.noderesources.gpus:#.modelid",
+	"data.apps:#.noderesources.gpus:#.count",
+	"data.apps:#.noderesources.gpus:#.vendor",
+	"data.apps:#.noderesources.gpus:#.memory",
+	"data.apps:#.noderesources.gpus:#.inuse",
+	"data.apps:#.noderesources.optresmap",
+	"data.apps:#.noderesources.infranodeflavor",
+	"data.apps:#.noderesources.externalvolumesize",
+	"data.apps:#.objid",
+	"data.apps:#.appannotations",
+	"data.apps:#.isstandalone",
+	"data.apps:#.managesownnamespaces",
+	"data.apps:#.compatibilityversion",
+	"data.apps:#.findcloudletscoreweights.distance",
+	"data.apps:#.findcloudletscoreweights.latency",
+	"data.apps:#.findcloudletscoreweights.resourceusage",
+	"data.apps:#.findcloudletscoreweights.health",
+	"data.apps:#.geofencepolicy",
+	"data.apps:#.replicaautoscalepolicy.minreplicas",
+	"data.apps:#.replicaautoscalepolicy.maxreplicas",
+	"data.apps:#.replicaautoscalepolicy.targetcpu",
+	"data.apps:#.replicaautoscalepolicy.targetmem",
+	"data.apps:#.replicaautoscalepolicy.targetactiveconnections",
+	"data.apps:#.replicaautoscalepolicy.stabilizationwindowsec",
+	"data.apps:#.tags",
+	"data.appinstances:#.fields",
+	"data.appinstances:#.key.name",
+	"data.appinstances:#.key.organization",
+	"data.appinstances:#.appkey.organization",
+	"data.appinstances:#.appkey.name",
+	"data.appinstances:#.appkey.version",
+	"data.appinstances:#.clusterkey.name",
+	"data.appinstances:#.clusterkey.organization",
+	"data.appinstances:#.cloudletkey.organization",
+	"data.appinstances:#.cloudletkey.name",
+	"data.appinstances:#.cloudletkey.federatedorganization",
+	"data.appinstances:#.zonekey.organization",
+	"data.appinstances:#.zonekey.name",
+	"data.appinstances:#.zonekey.federatedorganization",
+	"data.appinstances:#.cloudletloc.latitude",
+	"data.appinstances:#.cloudletloc.longitude",
+	"data.appinstances:#.cloudletloc.horizontalaccuracy",
+	"data.appinstances:#.cloudletloc.verticalaccuracy",
+	"data.appinstances:#.cloudletloc.altitude",
+	"data.appinstances:#.cloudletloc.course",
+	"data.appinstances:#.cloudletloc.speed",
+	"data.appinstances:#.cloudletloc.timestamp",
+	"data.appinstances:#.uri",
+	"data.appinstances:#.staticuri",
+	"data.appinstances:#.liveness",
+	"data.appinstances:#.mappedports:#.proto",
+	"data.appinstances:#.mappedports:#.internalport",
+	"data.appinstances:#.mappedports:#.publicport",
+	"data.appinstances:#.mappedports:#.pathprefix",
+	"data.appinstances:#.mappedports:#.fqdnprefix",
+	"data.appinstances:#.mappedports:#.endport",
+	"data.appinstances:#.mappedports:#.tls",
+	"data.appinstances:#.mappedports:#.nginx",
+	"data.appinstances:#.mappedports:#.maxpktsize",
+	"data.appinstances:#.mappedports:#.internalvisonly",
+	"data.appinstances:#.mappedports:#.id",
+	"data.appinstances:#.mappedports:#.servicename",
+	"data.appinstances:#.flavor.name",
+	"data.appinstances:#.cloudletflavor",
+	"data.appinstances:#.state",
+	"data.appinstances:#.errors",
+	"data.appinstances:#.crmoverride",
+	"data.appinstances:#.runtimeinfo.containerids",
+	"data.appinstances:#.runtimeinfo.replicas",
+	"data.appinstances:#.createdat",
+	"data.appinstances:#.autoclusteripaccess",
+	"data.appinstances:#.revision",
+	"data.appinstances:#.forceupdate",
+	"data.appinstances:#.updatemultiple",
+	"data.appinstances:#.configs:#.kind",
+	"data.appinstances:#.configs:#.config",
+	"data.appinstances:#.healthcheck",
+	"data.appinstances:#.powerstate",
+	"data.appinstances:#.externalvolumesize",
+	"data.appinstances:#.availabilityzone",
+	"data.appinstances:#.vmflavor",
+	"data.appinstances:#.optres",
+	"data.appinstances:#.updatedat",
+	"data.appinstances:#.realclustername",
+	"data.appinstances:#.internalporttolbip",
+	"data.appinstances:#.dedicatedip",
+	"data.appinstances:#.uniqueid",
+	"data.appinstances:#.dnslabel",
+	"data.appinstances:#.fedkey.federationname",
+	"data.appinstances:#.fedkey.appinstid",
+	"data.appinstances:#.compatibilityversion",
+	"data.appinstances:#.virtualclusterkey.name",
+	"data.appinstances:#.virtualclusterkey.organization",
+	"data.appinstances:#.enableipv6",
+	"data.appinstances:#.objid",
+	"data.appinstances:#.annotations",
+	"data.appinstances:#.dbmodelid",
+	"data.appinstances:#.kubernetesresources.cpupool.totalvcpus",
+	"data.appinstances:#.kubernetesresources.cpupool.totalmemory",
+	"data.appinstances:#.kubernetesresources.cpupool.totaldisk",
+	"data.appinstances:#.kubernetesresources.cpupool.totalgpus:#.modelid",
+	"data.appinstances:#.kubernetesresources.cpupool.totalgpus:#.count",
+	"data.appinstances:#.kubernetesresources.cpupool.totalgpus:#.vendor",
+	"data.appinstances:#.kubernetesresources.cpupool.totalgpus:#.memory",
+	"data.appinstances:#.kubernetesresources.cpupool.totalgpus:#.inuse",
+	"data.appinstances:#.kubernetesresources.cpupool.totaloptres",
+	"data.appinstances:#.kubernetesresources.cpupool.topology.minnodevcpus",
+	"data.appinstances:#.kubernetesresources.cpupool.topology.minnodememory",
+	"data.appinstances:#.kubernetesresources.cpupool.topology.minnodedisk",
+	"data.appinstances:#.kubernetesresources.cpupool.topology.minnodegpus:#.modelid",
+	"data.appinstances:#.kubernetesresources.cpupool.topology.minnodegpus:#.count",
+	"data.appinstances:#.kubernetesresources.cpupool.topology.minnodegpus:#.vendor",
+	"data.appinstances:#.kubernetesresources.cpupool.topology.minnodegpus:#.memory",
+	"data.appinstances:#.kubernetesresources.cpupool.topology.minnodegpus:#.inuse",
+	"data.appinstances:#.kubernetesresources.cpupool.topology.minnodeoptres",
+	"data.appinstances:#.kubernetesresources.cpupool.topology.minnumberofnodes",
+	"data.appinstances:#.kubernetesresources.gpupool.totalvcpus",
+	"data.appinstances:#.kubernetesresources.gpupool.totalmemory",
+	"data.appinstances:#.kubernetesresources.gpupool.totaldisk",
+	"data.appinstances:#.kubernetesresources.gpupool.totalgpus:#.modelid",
+	"data.appinstances:#.kubernetesresources.gpupool.totalgpus:#.count",
+	"data.appinstances:#.kubernetesresources.gpupool.totalgpus:#.vendor",
+	"data.appinstances:#.kubernetesresources.gpupool.totalgpus:#.memory",
+	"data.appinstances:#.kubernetesresources.gpupool.totalgpus:#.inuse",
+	"data.appinstances:#.kubernetesresources.gpupool.totaloptres",
+	"data.appinstances:#.kubernetesresources.gpupool.topology.minnodevcpus",
+	"data.appinstances:#.kubernetesresources.gpupool.topology.minnodememory",
+	"data.appinstances:#.kubernetesresources.gpupool.topology.minnodedisk",
+	"data.appinstances:#.kubernetesresources.gpupool.topology.minnodegpus:#.modelid",
+	"data.appinstances:#.kubernetesresources.gpupool.topology.minnodegpus:#.count",
+	"data.appinstances:#.kubernetesresources.gpupool.topology.minnodegpus:#.vendor",
+	"data.appinstances:#.kubernetesresources.gpupool.topology.minnodegpus:#.memory",
+	"data.appinstances:#.kubernetesresources.gpupool.topology.minnodegpus:#.inuse",
+	"data.appinstances:#.kubernetesresources.gpupool.topology.minnodeoptres",
+	"data.appinstances:#.kubernetesresources.gpupool.topology.minnumberofnodes",
+	"data.appinstances:#.kubernetesresources.minkubernetesversion",
+	"data.appinstances:#.noderesources.vcpus",
+	"data.appinstances:#.noderesources.ram",
+	"data.appinstances:#.noderesources.disk",
+	"data.appinstances:#.noderesources.gpus:#.modelid",
+	"data.appinstances:#.noderesources.gpus:#.count",
+	"data.appinstances:#.noderesources.gpus:#.vendor",
+	"data.appinstances:#.noderesources.gpus:#.memory",
+	"data.appinstances:#.noderesources.gpus:#.inuse",
+	"data.appinstances:#.noderesources.optresmap",
+	"data.appinstances:#.noderesources.infranodeflavor",
+	"data.appinstances:#.noderesources.externalvolumesize",
+	"data.appinstances:#.isstandalone",
+	"data.appinstances:#.replicas",
+	"data.appinstances:#.dryrun",
+	"data.appinstances:#.tags",
+	"data.appinstrefs:#.key.organization",
+	"data.appinstrefs:#.key.name",
+	"data.appinstrefs:#.key.version",
+	"data.clusterrefs:#.key.name",
+	"data.clusterrefs:#.key.organization",
+	"data.clusterrefs:#.apps:#.name",
+	"data.clusterrefs:#.apps:#.organization",
+	"data.vmpools:#.fields",
+	"data.vmpools:#.key.organization",
+	"data.vmpools:#.key.name",
+	"data.vmpools:#.vms:#.name",
+	"data.vmpools:#.vms:#.netinfo.externalip",
+	"data.vmpools:#.vms:#.netinfo.internalip",
+	"data.vmpools:#.vms:#.groupname",
+	"data.vmpools:#.vms:#.state",
+	"data.vmpools:#.vms:#.updatedat.seconds",
+	"data.vmpools:#.vms:#.updatedat.nanos",
+	"data.vmpools:#.vms:#.internalname",
+	"data.vmpools:#.vms:#.flavor.name",
+	"data.vmpools:#.vms:#.flavor.vcpus",
+	"data.vmpools:#.vms:#.flavor.ram",
+	"data.vmpools:#.vms:#.flavor.disk",
+	"data.vmpools:#.vms:#.flavor.gpus:#.modelid",
+	"data.vmpools:#.vms:#.flavor.gpus:#.count",
+	"data.vmpools:#.vms:#.flavor.gpus:#.vendor",
+	"data.vmpools:#.vms:#.flavor.gpus:#.memory",
+	"data.vmpools:#.vms:#.flavor.gpus:#.inuse",
+	"data.vmpools:#.vms:#.flavor.propmap",
+	"data.vmpools:#.state",
+	"data.vmpools:#.errors",
+	"data.vmpools:#.crmoverride",
+	"data.vmpools:#.deleteprepare",
+	"data.alertpolicies:#.fields",
+	"data.alertpolicies:#.key.organization",
+	"data.alertpolicies:#.key.name",
+	"data.alertpolicies:#.cpuutilizationlimit",
+	"data.alertpolicies:#.memutilizationlimit",
+	"data.alertpolicies:#.diskutilizationlimit",
+	"data.alertpolicies:#.activeconnlimit",
+	"data.alertpolicies:#.severity",
+	"data.alertpolicies:#.triggertime",
+	"data.alertpolicies:#.labels",
+	"data.alertpolicies:#.annotations",
+	"data.alertpolicies:#.description",
+	"data.alertpolicies:#.deleteprepare",
+	"data.alertpolicies:#.customexpression",
+	"data.alertpolicies:#.custommetricssource",
+	"data.flowratelimitsettings:#.fields",
+	"data.flowratelimitsettings:#.key.flowsettingsname",
+	"data.flowratelimitsettings:#.key.ratelimitkey.apiname",
+	"data.flowratelimitsettings:#.key.ratelimitkey.apiendpointtype",
+	"data.flowratelimitsettings:#.key.ratelimitkey.ratelimittarget",
+	"data.flowratelimitsettings:#.settings.flowalgorithm",
+	"data.flowratelimitsettings:#.settings.reqspersecond",
+	"data.flowratelimitsettings:#.settings.burstsize",
+	"data.maxreqsratelimitsettings:#.fields",
+	"data.maxreqsratelimitsettings:#.key.maxreqssettingsname",
+	"data.maxreqsratelimitsettings:#.key.ratelimitkey.apiname",
+	"data.maxreqsratelimitsettings:#.key.ratelimitkey.apiendpointtype",
+	"data.maxreqsratelimitsettings:#.key.ratelimitkey.ratelimittarget",
+	"data.maxreqsratelimitsettings:#.settings.maxreqsalgorithm",
+	"data.maxreqsratelimitsettings:#.settings.maxrequests",
+	"data.maxreqsratelimitsettings:#.settings.interval",
+	"data.trustpolicyexceptions:#.fields",
+	"data.trustpolicyexceptions:#.key.appkey.organization",
+	"data.trustpolicyexceptions:#.key.appkey.name",
+	"data.trustpolicyexceptions:#.key.appkey.version",
+	"data.trustpolicyexceptions:#.key.zonepoolkey.organization",
+	"data.trustpolicyexceptions:#.key.zonepoolkey.name",
+	"data.trustpolicyexceptions:#.key.name",
+	"data.trustpolicyexceptions:#.state",
+	"data.trustpolicyexceptions:#.outboundsecurityrules:#.protocol",
+	"data.trustpolicyexceptions:#.outboundsecurityrules:#.portrangemin",
+	"data.trustpolicyexceptions:#.outboundsecurityrules:#.portrangemax",
+	"data.trustpolicyexceptions:#.outboundsecurityrules:#.remotecidr",
+	"internalentries:#.dbkey",
+	"internalentries:#.dbvalue",
+}
+var DataSnapshotAliasArgs = []string{}
+var DataSnapshotComments = map[string]string{
+	"region":                                              "Region of the exported data",
+	"datamodelhash":                                       "Data model version hash of the exported data",
+	"datamodelid":                                         "Data model version ID of the exported data",
+	"revision":                                            "Database revision the data was exported at",
+	"data.flavors:#.fields":                               "Fields are used for the Update API to specify which fields to apply",
+	"data.flavors:#.key.name":                             "Flavor name",
+	"data.flavors:#.ram":                                  "RAM in megabytes",
+	"data.flavors:#.vcpus":                                "Number of virtual CPUs",
+	"data.flavors:#.disk":                                 "Amount of disk space in gigabytes",
+	"data.flavors:#.gpus:#.modelid":                       "GPU model unique identifier",
+	"data.flavors:#.gpus:#.count":                         "Count of how many of this GPU are required/present",
+	"data.flavors:#.gpus:#.vendor":                        "GPU vendor (nvidia, amd, etc)",
+	"data.flavors:#.gpus:#.memory":                        "Memory in GB",
+	"data.flavors:#.gpus:#.inuse":                         "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"data.flavors:#.optresmap":                            "Optional Resources request, key = gpu form: $resource=$kind:[$alias]$count ex: optresmap=gpu=vgpu:nvidia-63:1",
+	"data.flavors:#.deleteprepare":                        "Preparing to be deleted",
+	"data.settings.fields":                                "Fields are used for the Update API to specify which fields to apply",
+	"data.settings.shepherdmetricscollectioninterval":     "Shepherd metrics collection interval for k8s and docker appInstances (duration)",
+	"data.settings.shepherdalertevaluationinterval":       "Shepherd alert evaluation interval for k8s and docker appInstances (duration)",
+	"data.settings.shepherdmetricsscrapeinterval":         "Shepherd metrics scraping interval (how often metrics are pulled by prometheus, vs pushed to Controller by Shepherd collection)",
+	"data.settings.shepherdhealthcheckretries":            "Number of times Shepherd Health Check fails before we mark appInst down",
+	"data.settings.shepherdhealthcheckinterval":           "Health Checking probing frequency (duration)",
+	"data.settings.autodeployintervalsec":                 "Auto Provisioning Stats push and analysis interval (seconds)",
+	"data.settings.autodeployoffsetsec":                   "Auto Provisioning analysis offset from interval (seconds)",
+	"data.settings.autodeploymaxintervals":                "Auto Provisioning Policy max allowed intervals",
+	"data.settings.createappinsttimeout":                  "Create AppInst timeout (duration)",
+	"data.settings.updateappinsttimeout":                  "Update AppInst timeout (duration)",
+	"data.settings.deleteappinsttimeout":                  "Delete AppInst timeout (duration)",
+	"data.settings.createclusterinsttimeout":              "Create ClusterInst timeout (duration)",
+	"data.settings.updateclusterinsttimeout":              "Update ClusterInst timeout (duration)",
+	"data.settings.deleteclusterinsttimeout":              "Delete ClusterInst timeout (duration)",
+	"data.settings.masternodeflavor":                      "Default flavor for k8s master VM and > 0  workers",
+	"data.settings.maxtrackeddmeclients":                  "Max DME clients to be tracked at the same time.",
+	"data.settings.influxdbmetricsretention":              "Default influxDB metrics retention policy (duration)",
+	"data.settings.cloudletmaintenancetimeout":            "Default Cloudlet Maintenance timeout (used twice for AutoProv and Cloudlet)",
+	"data.settings.updatevmpooltimeout":                   "Update VM pool timeout (duration)",
+	"data.settings.updatetrustpolicytimeout":              "Update Trust Policy timeout (duration)",
+	"data.settings.dmeapimetricscollectioninterval":       "Metrics collection interval for DME API counts (duration)",
+	"data.settings.edgeeventsmetricscollectioninterval":   "Collection interval for edgeevents metrics (latency, device, and custom)",
+	"data.settings.cleanupreservableautoclusteridletime":  "Idle reservable ClusterInst clean up time",
+	"data.settings.influxdbcloudletusagemetricsretention": "Default influxDB cloudlet usage metrics retention policy (duration)",
+	"data.settings.createcloudlettimeout":                 "Create Cloudlet timeout (duration)",
+	"data.settings.updatecloudlettimeout":                 "Update Cloudlet timeout (duration)",
+	"data.settings.locationtilesidelengthkm":              "Length of location tiles side for latency metrics (km)",
+	"data.settings.edgeeventsmetricscontinuousqueriescollectionintervals:#.interval":  "Collection interval for Influxdb (Specifically used for continuous query intervals) (Data from old continuous queries will be inaccessible if intervals are updated)",
+	"data.settings.edgeeventsmetricscontinuousqueriescollectionintervals:#.retention": "Retention duration for Influxdb interval (0 uses default retention policy)",
+	"data.settings.influxdbdownsampledmetricsretention":                               "Default retention policy for downsampled influx db (duration)",
+	"data.settings.influxdbedgeeventsmetricsretention":                                "Default retention policy for edgeevents metrics influx db (duration)",
+	"data.settings.appinstclientcleanupinterval":                                      "AppInstClient cleanup thread run interval",
+	"data.settings.clusterautoscaleaveragingdurationsec":                              "Cluster auto scale averaging duration for stats to avoid spikes (seconds), avoid setting below 30s or it will not capture any measurements to average",
+	"data.settings.clusterautoscaleretrydelay":                                        "Cluster auto scale retry delay if scaling failed",
+	"data.settings.alertpolicymintriggertime":                                         "Minimmum trigger time for alert policies",
+	"data.settings.disableratelimit":                                                  "Disable rate limiting for APIs (default is false)",
+	"data.settings.ratelimitmaxtrackedips":                                            "Maximum number of IPs to track for rate limiting",
+	"data.settings.resourcesnapshotthreadinterval":                                    "ResourceSnapshot Refresh thread run interval",
+	"data.settings.platformhainstancepollinterval":                                    "Platform HA instance poll interval",
+	"data.settings.platformhainstanceactiveexpiretime":                                "Platform HA instance active time",
+	"data.settings.ccrmapitimeout":                                                    "Timeout for controller platform-specific API calls to CCRM",
+	"data.settings.findcloudletscoreweights.distance":                                 "Weight per km of distance from the client to the cloudlet",
+	"data.settings.findcloudletscoreweights.latency":                                  "Weight per ms of average latency reported by clients of the AppInst",
+	"data.settings.findcloudletscoreweights.resourceusage":                            "Weight per percent used of the most used cloudlet infra resource",
+	"data.settings.findcloudletscoreweights.health":                                   "Weight added if the AppInst health check has not reported healthy",
+	"data.settings.findcloudletdistribution":                                          "How FindCloudlet distributes clients between AppInsts with near equal scores, one of Random, WeightedRandom, RoundRobin",
+	"data.settings.findcloudletstickysessions":                                        "Keep sending a client to the same AppInst until the client moves to a different location tile",
+	"data.settings.maxobjecthistoryrevisions":                                         "Maximum number of past revisions returned by the object history APIs, older revisions are also lost when the database history is compacted",
+	"data.operatorcodes:#.code":                                                       "MCC plus MNC code, or custom carrier code designation.",
+	"data.operatorcodes:#.organization":                                               "Operator Organization name",
+	"data.restagtables:#.key.name":                                                    "Resource Table Name",
+	"data.restagtables:#.key.organization":                                            "Operator organization of the cloudlet site.",
+	"data.restagtables:#.tags":                                                        "One or more string tags",
+	"data.restagtables:#.azone":                                                       "Availability zone(s) of resource if required",
+	"data.restagtables:#.deleteprepare":                                               "Preparing to be deleted",
+	"data.trustpolicies:#.fields":                                                     "Fields are used for the Update API to specify which fields to apply",
+	"data.trustpolicies:#.key.organization":                                           "Name of the organization for the cluster that this policy will apply to",
+	"data.trustpolicies:#.key.name":                                                   "Policy name",
+	"data.trustpolicies:#.outboundsecurityrules:#.protocol":                           "TCP, UDP, ICMP",
+	"data.trustpolicies:#.outboundsecurityrules:#.portrangemin":                       "TCP or UDP port range start",
+	"data.trustpolicies:#.outboundsecurityrules:#.portrangemax":                       "TCP or UDP port range end",
+	"data.trustpolicies:#.outboundsecurityrules:#.remotecidr":                         "Remote CIDR X.X.X.X/X for IPv4 or e.g. XXXX:XXXX::XXXX/XX for IPv6",
+	"data.trustpolicies:#.deleteprepare":                                              "Preparing to be deleted",
+	"data.gpudrivers:#.fields":                                                        "Fields are used for the Update API to specify which fields to apply",
+	"data.gpudrivers:#.key.name":                                                      "Name of the driver",
+	"data.gpudrivers:#.key.organization":                                              "Organization to which the driver belongs to",
+	"data.gpudrivers:#.builds:#.name":                                                 "Unique identifier key",
+	"data.gpudrivers:#.builds:#.driverpath":                                           "Path where the driver package is located, must be either a public path or the URL to the driver uploaded to the internal artifact registry.",
+	"data.gpudrivers:#.builds:#.driverpathcreds":                                      "(deprecated) Optional credentials (username:password) to access driver path",
+	"data.gpudrivers:#.builds:#.operatingsystem":                                      "Operator System supported by GPU driver build, one of Linux, Windows, Others",
+	"data.gpudrivers:#.builds:#.kernelversion":                                        "Kernel Version supported by GPU driver build",
+	"data.gpudrivers:#.builds:#.hypervisorinfo":                                       "Info on hypervisor supported by vGPU driver",
+	"data.gpudrivers:#.builds:#.md5sum":                                               "Driver package md5sum to ensure package is not corrupted",
+	"data.gpudrivers:#.builds:#.storagepath":                                          "GPU driver build storage path",
+	"data.gpudrivers:#.licenseconfig":                                                 "URL to license config file to setup license",
+	"data.gpudrivers:#.licenseconfigmd5sum":                                           "License config md5sum, to ensure integrity of license config",
+	"data.gpudrivers:#.properties":                                                    "Additional properties associated with GPU driver build For example: license server information, driver release date, etc",
+	"data.gpudrivers:#.state":                                                         "State to figure out if any action on the GPU driver is in-progress",
+	"data.gpudrivers:#.ignorestate":                                                   "Ignore state will ignore any action in-progress on the GPU driver",
+	"data.gpudrivers:#.deleteprepare":                                                 "Preparing to be deleted",
+	"data.gpudrivers:#.storagebucketname":                                             "(deprecated) GPU driver storage bucket name",
+	"data.gpudrivers:#.licenseconfigstoragepath":                                      "GPU driver license config storage path",
+	"data.platformfeatures:#.platformtype":                                            "Platform type",
+	"data.platformfeatures:#.nodetype":                                                "Node type that supports this platform",
+	"data.platformfeatures:#.supportsmultitenantcluster":                              "Platform supports multi tenant kubernetes clusters",
+	"data.platformfeatures:#.supportssharedvolume":                                    "Platform supports shared volumes",
+	"data.platformfeatures:#.supportstrustpolicy":                                     "Platform supports trust policies",
+	"data.platformfeatures:#.supportskubernetesonly":                                  "Platform only supports kubernetes deployments",
+	"data.platformfeatures:#.kubernetesrequiresworkernodes":                           "Kubernetes clusters requires worker nodes and cannot be master only",
+	"data.platformfeatures:#.cloudletserviceslocal":                                   "Cloudlet servicess run local to the controller",
+	"data.platformfeatures:#.ipallocatedperservice":                                   "Every kubernetes services gets a public IP (public cloud)",
+	"data.platformfeatures:#.supportsimagetypeovf":                                    "Platform supports OVF images for VM deployments",
+	"data.platformfeatures:#.supportsimagetypeova":                                    "Platform supports OVA images for VM deployments",
+	"data.platformfeatures:#.isvmpool":                                                "Platform is a a pool of pre-existing virtual machines",
+	"data.platformfeatures:#.isfake":                                                  "Platform is a fake platform for unit/e2e testing",
+	"data.platformfeatures:#.ismock":                                                  "Platform is a mock platform for developer/regression testing",
+	"data.platformfeatures:#.supportsadditionalnetworks":                              "Platform supports adding networks",
+	"data.platformfeatures:#.issinglekubernetescluster":                               "The entire platform is a single kubernetes cluster",
+	"data.platformfeatures:#.supportsappinstdedicatedip":                              "Platform supports per AppInst dedicated IPs",
+	"data.platformfeatures:#.supportsplatformhighavailabilityonk8s":                   "Supports high availability with two CRMs on kubernetes",
+	"data.platformfeatures:#.supportsplatformhighavailabilityondocker":                "Supports high availability with two CRMs on docker",
+	"data.platformfeatures:#.nokubernetesclusterautoscale":                            "No support for kubernetes cluster auto-scale",
+	"data.platformfeatures:#.isprebuiltkubernetescluster":                             "Kubernetes cluster is created externally and already exists",
+	"data.platformfeatures:#.noclustersupport":                                        "No cluster support. Some platforms, like Federation, do not support clusters.",
+	"data.platformfeatures:#.isedgebox":                                               "Edgebox platforms are for user-hosted cloudlets, and must use public images and do not get DNS mapping, as they do not get access to sensitive data.",
+	"data.platformfeatures:#.supportsipv6":                                            "Supports IPv6",
+	"data.platformfeatures:#.requirescrmonedge":                                       "Requires on-edge-site CRM",
+	"data.platformfeatures:#.requirescrmoffedge":                                      "Requires off-edge-site CRM, i.e. CCRM",
+	"data.platformfeatures:#.requirescertrefresh":                                     "Requires certificate refresh (deprecated, not used)",
+	"data.platformfeatures:#.supportsmultiplenodepools":                               "Kubernetes clusters support more than one node pool",
+	"data.platformfeatures:#.managesk8scontrolnodes":                                  "Platform manages Kubernetes control nodes",
+	"data.platformfeatures:#.usesingress":                                             "Platform uses ingress for inbound Kubernetes HTTP traffic",
+	"data.platformfeatures:#.requiresgpudriver":                                       "Platform requires GPU Driver to be managed by Edge Cloud for installation onto new VMs or nodes with the license provided by the operator.",
+	"data.platformfeatures:#.usesrootlb":                                              "Platform users a shared root load balancer",
+	"data.platformfeatures:#.supportscloudletmanagedclusters":                         "Platform supports cloudlet managed clusters",
+	"data.platformfeatures:#.resourcequotaproperties:#.name":                          "Resource name",
+	"data.platformfeatures:#.resourcequotaproperties:#.value":                         "Resource value",
+	"data.platformfeatures:#.resourcequotaproperties:#.inframaxvalue":                 "Resource infra max value",
+	"data.platformfeatures:#.resourcequotaproperties:#.quotamaxvalue":                 "Resource quota max value",
+	"data.platformfeatures:#.resourcequotaproperties:#.description":                   "Resource description",
+	"data.platformfeatures:#.resourcequotaproperties:#.units":                         "Resource units",
+	"data.platformfeatures:#.resourcequotaproperties:#.type":                          "Resource type category, i.e. gpu",
+	"data.platformfeatures:#.resourcequotaproperties:#.alertthreshold":                "Generate alert when more than threshold percentage of resource is used",
+	"data.platformfeatures:#.deleteprepare":                                           "Preparing to be deleted",
+	"data.zones:#.fields":                                                             "Fields are used for the Update API to specify which fields to apply",
+	"data.zones:#.key.organization":                                                   "Organization owner of the Zone",
+	"data.zones:#.key.name":                                                           "Name of the Zone",
+	"data.zones:#.key.federatedorganization":                                          "Federated operator organization who shared this Zone",
+	"data.zones:#.description":                                                        "Description of Zone",
+	"data.zones:#.infraflavors:#.name":                                                "Name of the infra flavor",
+	"data.zones:#.infraflavors:#.vcpus":                                               "Number of VCPU cores on the infra flavor",
+	"data.zones:#.infraflavors:#.ram":                                                 "Ram in MB on the infra flavor",
+	"data.zones:#.infraflavors:#.disk":                                                "Amount of disk in GB on the infra flavor",
+	"data.zones:#.infraflavors:#.gpus:#.modelid":                                      "GPU model unique identifier",
+	"data.zones:#.infraflavors:#.gpus:#.count":                                        "Count of how many of this GPU are required/present",
+	"data.zones:#.infraflavors:#.gpus:#.vendor":                                       "GPU vendor (nvidia, amd, etc)",
+	"data.zones:#.infraflavors:#.gpus:#.memory":                                       "Memory in GB",
+	"data.zones:#.infraflavors:#.gpus:#.inuse":                                        "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"data.zones:#.infraflavors:#.propmap":                                             "Infra flavor Properties, if any",
+	"data.zones:#.location.latitude":                                                  "Latitude in WGS 84 coordinates",
+	"data.zones:#.location.longitude":                                                 "Longitude in WGS 84 coordinates",
+	"data.zones:#.location.horizontalaccuracy":                                        "Horizontal accuracy (radius in meters)",
+	"data.zones:#.location.verticalaccuracy":                                          "Vertical accuracy (meters)",
+	"data.zones:#.location.altitude":                                                  "On android only lat and long are guaranteed to be supplied Altitude in meters",
+	"data.zones:#.location.course":                                                    "Course (IOS) / bearing (Android) (degrees east relative to true north)",
+	"data.zones:#.location.speed":                                                     "Speed (IOS) / velocity (Android) (meters/sec)",
+	"data.zones:#.location.timestamp":                                                 "Timestamp",
+	"data.zones:#.objid":                                                              "Universally unique object ID",
+	"data.zones:#.deleteprepare":                                                      "Preparing to be deleted",
+	"data.zones:#.createdat":                                                          "Created at time",
+	"data.zones:#.updatedat":                                                          "Updated at time",
+	"data.cloudlets:#.fields":                                                         "Fields are used for the Update API to specify which fields to apply",
+	"data.cloudlets:#.key.organization":                                               "Organization of the cloudlet site",
+	"data.cloudlets:#.key.name":                                                       "Name of the cloudlet",
+	"data.cloudlets:#.key.federatedorganization":                                      "Federated operator organization who shared this cloudlet",
+	"data.cloudlets:#.location.latitude":                                              "Latitude in WGS 84 coordinates",
+	"data.cloudlets:#.location.longitude":                                             "Longitude in WGS 84 coordinates",
+	"data.cloudlets:#.location.horizontalaccuracy":                                    "Horizontal accuracy (radius in meters)",
+	"data.cloudlets:#.location.verticalaccuracy":                                      "Vertical accuracy (meters)",
+	"data.cloudlets:#.location.altitude":                                              "On android only lat and long are guaranteed to be supplied Altitude in meters",
+	"data.cloudlets:#.location.course":                                                "Course (IOS) / bearing (Android) (degrees east relative to true north)",
+	"data.cloudlets:#.location.speed":                                                 "Speed (IOS) / velocity (Android) (meters/sec)",
+	"data.cloudlets:#.location.timestamp":                                             "Timestamp",
+	"data.cloudlets:#.zone":                                                           "Zone assignment, must be set to allow deployment to cloudlet",
+	"data.cloudlets:#.ipsupport":                                                      "Type of IP support provided by Cloudlet (see IpSupport), one of Unknown, Static, Dynamic",
+	"data.cloudlets:#.staticips":                                                      "List of static IPs for static IP support",
+	"data.cloudlets:#.numdynamicips":                                                  "Number of dynamic IPs available for dynamic IP support",
+	"data.cloudlets:#.timelimits.createclusterinsttimeout":                            "Override default max time to create a cluster instance (duration)",
+	"data.cloudlets:#.timelimits.updateclusterinsttimeout":                            "Override default max time to update a cluster instance (duration)",
+	"data.cloudlets:#.timelimits.deleteclusterinsttimeout":                            "Override default max time to delete a cluster instance (duration)",
+	"data.cloudlets:#.timelimits.createappinsttimeout":                                "Override default max time to create an app instance (duration)",
+	"data.cloudlets:#.timelimits.updateappinsttimeout":                                "Override default max time to update an app instance (duration)",
+	"data.cloudlets:#.timelimits.deleteappinsttimeout":                                "Override default max time to delete an app instance (duration)",
+	"data.cloudlets:#.errors":                                                         "Any errors trying to create, update, or delete the Cloudlet.",
+	"data.cloudlets:#.state":                                                          "Current state of the crm, one of TrackedStateUnknown, NotPresent, CreateRequested, Creating, CreateError, Ready, UpdateRequested, Updating, UpdateError, DeleteRequested, Deleting, DeleteError, DeletePrepare, CrmInitok, CreatingDependencies, DeleteDone",
+	"data.cloudlets:#.crmoverride":                                                    "Override actions to CRM, one of NoOverride, IgnoreCrmErrors, IgnoreCrm, IgnoreTransientState, IgnoreCrmAndTransientState",
+	"data.cloudlets:#.deploymentlocal":                                                "Deploy cloudlet services locally for testing purposes",
+	"data.cloudlets:#.platformtype":                                                   "Platform type",
+	"data.cloudlets:#.notifysrvaddr":                                                  "Address for the CRM notify listener to run on",
+	"data.cloudlets:#.flavor.name":                                                    "Flavor name",
+	"data.cloudlets:#.physicalname":                                                   "Physical infrastructure cloudlet name",
+	"data.cloudlets:#.envvar":                                                         "Single Key-Value pair of env var to be passed to CRM",
+	"data.cloudlets:#.containerversion":                                               "Cloudlet container version",
+	"data.cloudlets:#.config.containerregistrypath":                                   "Path to Docker registry holding edge-cloud image",
+	"data.cloudlets:#.config.cloudletvmimagepath":                                     "Path to platform base image",
+	"data.cloudlets:#.config.notifyctrladdrs":                                         "Address of controller notify port (can be multiple of these)",
+	"data.cloudlets:#.config.tlscertfile":                                             "TLS cert file",
+	"data.cloudlets:#.config.tlskeyfile":                                              "TLS key file",
+	"data.cloudlets:#.config.tlscafile":                                               "TLS ca file",
+	"data.cloudlets:#.config.envvar":                                                  "Environment variables",
+	"data.cloudlets:#.config.platformtag":                                             "Tag of edge-cloud image",
+	"data.cloudlets:#.config.testmode":                                                "Internal Test flag",
+	"data.cloudlets:#.config.span":                                                    "Span string",
+	"data.cloudlets:#.config.cleanupmode":                                             "Internal cleanup flag",
+	"data.cloudlets:#.config.region":                                                  "Region",
+	"data.cloudlets:#.config.commercialcerts":                                         "Get certs from vault or generate your own for the root load balancer",
+	"data.cloudlets:#.config.usevaultpki":                                             "Use Vault certs and CAs for internal TLS communication",
+	"data.cloudlets:#.config.appdnsroot":                                              "App domain name root",
+	"data.cloudlets:#.config.deploymenttag":                                           "Deployment Tag",
+	"data.cloudlets:#.config.crmaccessprivatekey":                                     "crm access private key",
+	"data.cloudlets:#.config.accessapiaddr":                                           "controller access API address",
+	"data.cloudlets:#.config.cachedir":                                                "cache dir",
+	"data.cloudlets:#.config.secondarycrmaccessprivatekey":                            "secondary crm access private key",
+	"data.cloudlets:#.config.thanosrecvaddr":                                          "Thanos Receive remote write address",
+	"data.cloudlets:#.config.ansiblepublicaddr":                                       "Ansible public address for CRM to connect to CCRM",
+	"data.cloudlets:#.config.envoywithcurlimage":                                      "docker image path for envoy with curl",
+	"data.cloudlets:#.config.nginxwithcurlimage":                                      "docker image path for nginx with curl",
+	"data.cloudlets:#.accessvars":                                                     "Secrets required to access cloudlet, will be saved in encrypted storage, and cleared on the cloudlet object",
+	"data.cloudlets:#.vmimageversion":                                                 "EdgeCloud baseimage version where CRM services reside",
+	"data.cloudlets:#.deployment":                                                     "Deployment type to bring up CRM services (docker, kubernetes)",
+	"data.cloudlets:#.infraapiaccess":                                                 "Infra Access Type is the type of access available to Infra API Endpoint, one of DirectAccess, RestrictedAccess",
+	"data.cloudlets:#.infraconfig.externalnetworkname":                                "Infra specific external network name",
+	"data.cloudlets:#.infraconfig.flavorname":                                         "Infra specific flavor name",
+	"data.cloudlets:#.maintenancestate":                                               "State for maintenance, one of NormalOperation, MaintenanceStart, MaintenanceStartNoFailover",
+	"data.cloudlets:#.overridepolicycontainerversion":                                 "Override container version from policy file",
+	"data.cloudlets:#.vmpool":                                                         "VM Pool",
+	"data.cloudlets:#.crmaccesspublickey":                                             "CRM access public key",
+	"data.cloudlets:#.crmaccesskeyupgraderequired":                                    "CRM access key upgrade required",
+	"data.cloudlets:#.createdat":                                                      "Created at time",
+	"data.cloudlets:#.updatedat":                                                      "Updated at time",
+	"data.cloudlets:#.trustpolicy":                                                    "Optional Trust Policy",
+	"data.cloudlets:#.trustpolicystate":                                               "State of trust policy, one of TrackedStateUnknown, NotPresent, CreateRequested, Creating, CreateError, Ready, UpdateRequested, Updating, UpdateError, DeleteRequested, Deleting, DeleteError, DeletePrepare, CrmInitok, CreatingDependencies, DeleteDone",
+	"data.cloudlets:#.resourcequotas:#.name":                                          "Resource name on which to set quota",
+	"data.cloudlets:#.resourcequotas:#.value":                                         "Quota value of the resource",
+	"data.cloudlets:#.resourcequotas:#.alertthreshold":                                "Generate alert when more than threshold percentage of resource is used",
+	"data.cloudlets:#.resourcequotas:#.resourcetype":                                  "Resource type if non-generic resource, i.e. gpu",
+	"data.cloudlets:#.defaultresourcealertthreshold":                                  "Default resource alert threshold percentage",
+	"data.cloudlets:#.hostcontroller":                                                 "Address of the controller hosting the cloudlet services if it is running locally",
+	"data.cloudlets:#.kafkacluster":                                                   "Operator provided kafka cluster endpoint to push events to",
+	"data.cloudlets:#.kafkauser":                                                      "Username for kafka SASL/PLAIN authentification, stored securely in secret storage and never visible externally",
+	"data.cloudlets:#.kafkapassword":                                                  "Password for kafka SASL/PLAIN authentification, stored securely in secret storage and never visible externally",
+	"data.cloudlets:#.gpuconfig.driver.name":                                          "Name of the driver",
+	"data.cloudlets:#.gpuconfig.driver.organization":                                  "Organization to which the driver belongs to",
+	"data.cloudlets:#.gpuconfig.properties":                                           "Properties to identify specifics of GPU",
+	"data.cloudlets:#.gpuconfig.licenseconfig":                                        "Cloudlet specific license config to setup license",
+	"data.cloudlets:#.gpuconfig.licenseconfigmd5sum":                                  "Cloudlet specific license config md5sum, to ensure integrity of license config",
+	"data.cloudlets:#.enabledefaultserverlesscluster":                                 "Enable experimental default multitenant (serverless) cluster",
+	"data.cloudlets:#.allianceorgs":                                                   "This cloudlet will be treated as directly connected to these additional operator organizations for the purposes of FindCloudlet",
+	"data.cloudlets:#.singlekubernetesclusterowner":                                   "For single kubernetes cluster cloudlet platforms, cluster is owned by this organization instead of multi-tenant",
+	"data.cloudlets:#.deleteprepare":                                                  "Preparing to be deleted",
+	"data.cloudlets:#.platformhighavailability":                                       "Enable platform H/A",
+	"data.cloudlets:#.secondarycrmaccesspublickey":                                    "CRM secondary access public key for H/A",
+	"data.cloudlets:#.secondarycrmaccesskeyupgraderequired":                           "CRM secondary access key upgrade required for H/A",
+	"data.cloudlets:#.secondarynotifysrvaddr":                                         "Address for the secondary CRM notify listener to run on",
+	"data.cloudlets:#.dnslabel":                                                       "DNS label that is unique within the region",
+	"data.cloudlets:#.rootlbfqdn":                                                     "Root LB FQDN which is globally unique",
+	"data.cloudlets:#.staticrootlbfqdn":                                               "Static Root LB FQDN gets set when the object is created and cannot be changed",
+	"data.cloudlets:#.federationconfig.federationcontextid":                           "Federation context id",
+	"data.cloudlets:#.federationconfig.partnerfederationaddr":                         "Partner federation address",
+	"data.cloudlets:#.federationconfig.federationdbid":                                "Federation database id",
+	"data.cloudlets:#.federationconfig.federationname":                                "Federation Name",
+	"data.cloudlets:#.licenseconfigstoragepath":                                       "GPU driver license config storage path",
+	"data.cloudlets:#.infraflavors:#.name":                                            "Name of the infra flavor",
+	"data.cloudlets:#.infraflavors:#.vcpus":                                           "Number of VCPU cores on the infra flavor",
+	"data.cloudlets:#.infraflavors:#.ram":                                             "Ram in MB on the infra flavor",
+	"data.cloudlets:#.infraflavors:#.disk":                                            "Amount of disk in GB on the infra flavor",
+	"data.cloudlets:#.infraflavors:#.gpus:#.modelid":                                  "GPU model unique identifier",
+	"data.cloudlets:#.infraflavors:#.gpus:#.count":                                    "Count of how many of this GPU are required/present",
+	"data.cloudlets:#.infraflavors:#.gpus:#.vendor":                                   "GPU vendor (nvidia, amd, etc)",
+	"data.cloudlets:#.infraflavors:#.gpus:#.memory":                                   "Memory in GB",
+	"data.cloudlets:#.infraflavors:#.gpus:#.inuse":                                    "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"data.cloudlets:#.infraflavors:#.propmap":                                         "Infra flavor Properties, if any",
+	"data.cloudlets:#.edgeboxonly":                                                    "Edgebox only cloudlets allow for developers to set up cloudlets anywhere (laptop, etc) but can only use public images and do not support DNS mapping.",
+	"data.cloudlets:#.crmonedge":                                                      "CRM shall run on the edge site if true (required for restricted cloudlets), otherwise runs centrally (default)",
+	"data.cloudlets:#.objid":                                                          "Universally unique object ID",
+	"data.cloudlets:#.annotations":                                                    "Annotations",
+	"data.cloudlets:#.dbmodelid":                                                      "database version model ID",
+	"data.cloudlets:#.dryrun":                                                         "Preview the update without applying it, reports the fields that would change and whether the CRM would be updated",
+	"data.cloudlets:#.maintenancewindow.starttime":                                    "Start time of the maintenance",
+	"data.cloudlets:#.maintenancewindow.endtime":                                      "End time of the maintenance, after which the cloudlet returns to normal operation",
+	"data.cloudlets:#.maintenancewindow.failoverleadtime":                             "How long before the start time to stop sending new clients to the cloudlet and move auto-provisioned AppInsts off of it, defaults to the cloudlet maintenance timeout",
+	"data.cloudlets:#.maintenancewindow.nofailover":                                   "Do not move auto-provisioned AppInsts off of the cloudlet",
+	"data.cloudlets:#.maintenancewindow.state":                                        "Progress of the maintenance window, one of Scheduled, Failover, InProgress",
+	"data.cloudletinfos:#.fields":                                                     "Fields are used for the Update API to specify which fields to apply",
+	"data.cloudletinfos:#.key.organization":                                           "Organization of the cloudlet site",
+	"data.cloudletinfos:#.key.name":                                                   "Name of the cloudlet",
+	"data.cloudletinfos:#.key.federatedorganization":                                  "Federated operator organization who shared this cloudlet",
+	"data.cloudletinfos:#.state":                                                      "State of cloudlet, one of Unknown, Errors, Ready, Offline, NotPresent, Init, Upgrade, NeedSync",
+	"data.cloudletinfos:#.notifyid":                                                   "Id of client assigned by server (internal use only)",
+	"data.cloudletinfos:#.controller":                                                 "Connected controller unique id",
+	"data.cloudletinfos:#.osmaxram":                                                   "Maximum Ram in MB on the Cloudlet",
+	"data.cloudletinfos:#.osmaxvcores":                                                "Maximum number of VCPU cores on the Cloudlet",
+	"data.cloudletinfos:#.osmaxvolgb":                                                 "Maximum amount of disk in GB on the Cloudlet",
+	"data.cloudletinfos:#.errors":                                                     "Any errors encountered while making changes to the Cloudlet",
+	"data.cloudletinfos:#.flavors:#.name":                                             "Name of the infra flavor",
+	"data.cloudletinfos:#.flavors:#.vcpus":                                            "Number of VCPU cores on the infra flavor",
+	"data.cloudletinfos:#.flavors:#.ram":                                              "Ram in MB on the infra flavor",
+	"data.cloudletinfos:#.flavors:#.disk":                                             "Amount of disk in GB on the infra flavor",
+	"data.cloudletinfos:#.flavors:#.gpus:#.modelid":                                   "GPU model unique identifier",
+	"data.cloudletinfos:#.flavors:#.gpus:#.count":                                     "Count of how many of this GPU are required/present",
+	"data.cloudletinfos:#.flavors:#.gpus:#.vendor":                                    "GPU vendor (nvidia, amd, etc)",
+	"data.cloudletinfos:#.flavors:#.gpus:#.memory":                                    "Memory in GB",
+	"data.cloudletinfos:#.flavors:#.gpus:#.inuse":                                     "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"data.cloudletinfos:#.flavors:#.propmap":                                          "Infra flavor Properties, if any",
+	"data.cloudletinfos:#.status.tasknumber":                                          "Task number",
+	"data.cloudletinfos:#.status.maxtasks":                                            "Max tasks",
+	"data.cloudletinfos:#.status.taskname":                                            "Task name",
+	"data.cloudletinfos:#.status.stepname":                                            "Step name",
+	"data.cloudletinfos:#.status.msgcount":                                            "Message count",
+	"data.cloudletinfos:#.status.msgs":                                                "Messages",
+	"data.cloudletinfos:#.containerversion":                                           "Cloudlet container version",
+	"data.cloudletinfos:#.availabilityzones:#.name":                                   "OpenStack availability zone name",
+	"data.cloudletinfos:#.availabilityzones:#.status":                                 "OpenStack availability zone status",
+	"data.cloudletinfos:#.osimages:#.name":                                            "image name",
+	"data.cloudletinfos:#.osimages:#.tags":                                            "optional tags present on image",
+	"data.cloudletinfos:#.osimages:#.properties":                                      "image properties/metadata",
+	"data.cloudletinfos:#.osimages:#.diskformat":                                      "format qcow2, img, etc",
+	"data.cloudletinfos:#.controllercachereceived":                                    "Indicates all controller data has been sent to CRM",
+	"data.cloudletinfos:#.maintenancestate":                                           "State for maintenance, one of NormalOperation, MaintenanceStart, MaintenanceStartNoFailover",
+	"data.cloudletinfos:#.resourcessnapshot.platformvms:#.name":                       "Virtual machine name",
+	"data.cloudletinfos:#.resourcessnapshot.platformvms:#.type":                       "Type can be platformvm, platform-cluster-master, platform-cluster-primary-node, platform-cluster-secondary-node, sharedrootlb, dedicatedrootlb, cluster-master, cluster-k8s-node, cluster-docker-node, appvm",
+	"data.cloudletinfos:#.resourcessnapshot.platformvms:#.status":                     "Runtime status of the VM",
+	"data.cloudletinfos:#.resourcessnapshot.platformvms:#.infraflavor":                "Flavor allocated within the cloudlet infrastructure, distinct from the control plane flavor",
+	"data.cloudletinfos:#.resourcessnapshot.platformvms:#.ipaddresses:#.externalip":   "External IP address",
+	"data.cloudletinfos:#.resourcessnapshot.platformvms:#.ipaddresses:#.internalip":   "Internal IP address",
+	"data.cloudletinfos:#.resourcessnapshot.platformvms:#.containers:#.name":          "Name of the container",
+	"data.cloudletinfos:#.resourcessnapshot.platformvms:#.containers:#.type":          "Type can be docker or kubernetes",
+	"data.cloudletinfos:#.resourcessnapshot.platformvms:#.containers:#.status":        "Runtime status of the container",
+	"data.cloudletinfos:#.resourcessnapshot.platformvms:#.containers:#.clusterip":     "IP within the CNI and is applicable to kubernetes only",
+	"data.cloudletinfos:#.resourcessnapshot.platformvms:#.containers:#.restarts":      "Restart count, applicable to kubernetes only",
+	"data.cloudletinfos:#.resourcessnapshot.info:#.name":                              "Resource name",
+	"data.cloudletinfos:#.resourcessnapshot.info:#.value":                             "Resource value",
+	"data.cloudletinfos:#.resourcessnapshot.info:#.inframaxvalue":                     "Resource infra max value",
+	"data.cloudletinfos:#.resourcessnapshot.info:#.quotamaxvalue":                     "Resource quota max value",
+	"data.cloudletinfos:#.resourcessnapshot.info:#.description":                       "Resource description",
+	"data.cloudletinfos:#.resourcessnapshot.info:#.units":                             "Resource units",
+	"data.cloudletinfos:#.resourcessnapshot.info:#.type":                              "Resource type category, i.e. gpu",
+	"data.cloudletinfos:#.resourcessnapshot.info:#.alertthreshold":                    "Generate alert when more than threshold percentage of resource is used",
+	"data.cloudletinfos:#.resourcessnapshot.clusterinsts:#.name":                      "Cluster name",
+	"data.cloudletinfos:#.resourcessnapshot.clusterinsts:#.organization":              "Name of the organization that this cluster belongs to",
+	"data.cloudletinfos:#.resourcessnapshot.vmappinsts:#.name":                        "App Instance name",
+	"data.cloudletinfos:#.resourcessnapshot.vmappinsts:#.organization":                "App Instance organization",
+	"data.cloudletinfos:#.resourcessnapshot.k8sappinsts:#.name":                       "App Instance name",
+	"data.cloudletinfos:#.resourcessnapshot.k8sappinsts:#.organization":               "App Instance organization",
+	"data.cloudletinfos:#.trustpolicystate":                                           "Trust Policy State, one of TrackedStateUnknown, NotPresent, CreateRequested, Creating, CreateError, Ready, UpdateRequested, Updating, UpdateError, DeleteRequested, Deleting, DeleteError, DeletePrepare, CrmInitok, CreatingDependencies, DeleteDone",
+	"data.cloudletinfos:#.compatibilityversion":                                       "Version for compatibility tracking",
+	"data.cloudletinfos:#.properties":                                                 "Cloudlet properties",
+	"data.cloudletinfos:#.nodeinfos:#.name":                                           "Node name",
+	"data.cloudletinfos:#.nodeinfos:#.gpus:#.modelid":                                 "GPU model unique identifier",
+	"data.cloudletinfos:#.nodeinfos:#.gpus:#.count":                                   "Count of how many of this GPU are required/present",
+	"data.cloudletinfos:#.nodeinfos:#.gpus:#.vendor":                                  "GPU vendor (nvidia, amd, etc)",
+	"data.cloudletinfos:#.nodeinfos:#.gpus:#.memory":                                  "Memory in GB",
+	"data.cloudletinfos:#.nodeinfos:#.gpus:#.inuse":                                   "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"data.cloudletinfos:#.nodeinfos:#.gpusoftware.driverversion":                      "driver version",
+	"data.cloudletinfos:#.nodeinfos:#.gpusoftware.runtimeversion":                     "Runtime version",
+	"data.cloudletinfos:#.activecrminstance":                                          "Active HA instance",
+	"data.cloudletinfos:#.standbycrm":                                                 "Denotes if info was reported by inactive",
+	"data.cloudletinfos:#.releaseversion":                                             "Cloudlet release version",
+	"data.cloudletinfos:#.nodepools:#.name":                                           "Node pool name",
+	"data.cloudletinfos:#.nodepools:#.numnodes":                                       "Number of nodes in the pool",
+	"data.cloudletinfos:#.nodepools:#.noderesources.vcpus":                            "Vcpus to be allocated to the VM, must be either 1 or an even number",
+	"data.cloudletinfos:#.nodepools:#.noderesources.ram":                              "Total RAM in megabytes to be allocated to the VM",
+	"data.cloudletinfos:#.nodepools:#.noderesources.disk":                             "Total disk space in gigabytes to be allocated to the VMs root partition",
+	"data.cloudletinfos:#.nodepools:#.noderesources.gpus:#.modelid":                   "GPU model unique identifier",
+	"data.cloudletinfos:#.nodepools:#.noderesources.gpus:#.count":                     "Count of how many of this GPU are required/present",
+	"data.cloudletinfos:#.nodepools:#.noderesources.gpus:#.vendor":                    "GPU vendor (nvidia, amd, etc)",
+	"data.cloudletinfos:#.nodepools:#.noderesources.gpus:#.memory":                    "Memory in GB",
+	"data.cloudletinfos:#.nodepools:#.noderesources.gpus:#.inuse":                     "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"data.cloudletinfos:#.nodepools:#.noderesources.optresmap":                        "Optional resources request, i.e. optresmap=restype=resname:1",
+	"data.cloudletinfos:#.nodepools:#.noderesources.infranodeflavor":                  "Infrastructure specific node flavor",
+	"data.cloudletinfos:#.nodepools:#.noderesources.externalvolumesize":               "Size of external volume to be attached to nodes. This is for the root partition",
+	"data.cloudletinfos:#.nodepools:#.scalable":                                       "Scalable indicates the system may scale the number of nodes",
+	"data.zonepools:#.fields":                                                         "Fields are used for the Update API to specify which fields to apply",
+	"data.zonepools:#.key.organization":                                               "Name of the organization this pool belongs to",
+	"data.zonepools:#.key.name":                                                       "ZonePool Name",
+	"data.zonepools:#.zones:#.organization":                                           "Organization owner of the Zone",
+	"data.zonepools:#.zones:#.name":                                                   "Name of the Zone",
+	"data.zonepools:#.zones:#.federatedorganization":                                  "Federated operator organization who shared this Zone",
+	"data.zonepools:#.createdat":                                                      "Created at time",
+	"data.zonepools:#.updatedat":                                                      "Updated at time",
+	"data.zonepools:#.deleteprepare":                                                  "Preparing to be deleted",
+	"data.networks:#.fields":                                                          "Fields are used for the Update API to specify which fields to apply",
+	"data.networks:#.key.cloudletkey.organization":                                    "Organization of the cloudlet site",
+	"data.networks:#.key.cloudletkey.name":                                            "Name of the cloudlet",
+	"data.networks:#.key.cloudletkey.federatedorganization":                           "Federated operator organization who shared this cloudlet",
+	"data.networks:#.key.name":                                                        "Network Name",
+	"data.networks:#.routes:#.destinationcidr":                                        "Destination CIDR",
+	"data.networks:#.routes:#.nexthopip":                                              "Next hop IP",
+	"data.networks:#.connectiontype":                                                  "Network connection type, one of Undefined, ConnectToLoadBalancer, ConnectToClusterNodes, ConnectToAll",
+	"data.networks:#.deleteprepare":                                                   "Preparing to be deleted",
+	"data.autoprovpolicies:#.fields":                                                  "Fields are used for the Update API to specify which fields to apply",
+	"data.autoprovpolicies:#.key.organization":                                        "Name of the organization for the cluster that this policy will apply to",
+	"data.autoprovpolicies:#.key.name":                                                "Policy name",
+	"data.autoprovpolicies:#.deployclientcount":                                       "Minimum number of clients within the auto deploy interval to trigger deployment",
+	"data.autoprovpolicies:#.deployintervalcount":                                     "Number of intervals to check before triggering deployment",
+	"data.autoprovpolicies:#.zones:#.organization":                                    "Organization owner of the Zone",
+	"data.autoprovpolicies:#.zones:#.name":                                            "Name of the Zone",
+	"data.autoprovpolicies:#.zones:#.federatedorganization":                           "Federated operator organization who shared this Zone",
+	"data.autoprovpolicies:#.minactiveinstances":                                      "Minimum number of active instances for High-Availability",
+	"data.autoprovpolicies:#.maxinstances":                                            "Maximum number of instances (active or not)",
+	"data.autoprovpolicies:#.undeployclientcount":                                     "Number of active clients for the undeploy interval below which trigers undeployment, 0 (default) disables auto undeploy",
+	"data.autoprovpolicies:#.undeployintervalcount":                                   "Number of intervals to check before triggering undeployment",
+	"data.autoprovpolicies:#.deleteprepare":                                           "Preparing to be deleted",
+	"data.autoprovpolicyzones:#.key.organization":                                     "Name of the organization for the cluster that this policy will apply to",
+	"data.autoprovpolicyzones:#.key.name":                                             "Policy name",
+	"data.autoprovpolicyzones:#.zonekey.organization":                                 "Organization owner of the Zone",
+	"data.autoprovpolicyzones:#.zonekey.name":                                         "Name of the Zone",
+	"data.autoprovpolicyzones:#.zonekey.federatedorganization":                        "Federated operator organization who shared this Zone",
+	"data.autoscalepolicies:#.fields":                                                 "Fields are used for the Update API to specify which fields to apply",
+	"data.autoscalepolicies:#.key.organization":                                       "Name of the organization for the cluster that this policy will apply to",
+	"data.autoscalepolicies:#.key.name":                                               "Policy name",
+	"data.autoscalepolicies:#.minnodes":                                               "Minimum number of cluster nodes",
+	"data.autoscalepolicies:#.maxnodes":                                               "Maximum number of cluster nodes",
+	"data.autoscalepolicies:#.scaleupcputhresh":                                       "(Deprecated) Scale up cpu threshold (percentage 1 to 100), 0 means disabled",
+	"data.autoscalepolicies:#.scaledowncputhresh":                                     "(Deprecated) Scale down cpu threshold (percentage 1 to 100), 0 means disabled",
+	"data.autoscalepolicies:#.triggertimesec":                                         "(Deprecated) Trigger time defines how long the target must be satified in seconds before acting upon it.",
+	"data.autoscalepolicies:#.stabilizationwindowsec":                                 "Stabilization window is the time for which past triggers are considered; the largest scale factor is always taken.",
+	"data.autoscalepolicies:#.targetcpu":                                              "Target per-node cpu utilization (percentage 1 to 100), 0 means disabled",
+	"data.autoscalepolicies:#.targetmem":                                              "Target per-node memory utilization (percentage 1 to 100), 0 means disabled",
+	"data.autoscalepolicies:#.targetactiveconnections":                                "Target per-node number of active connections, 0 means disabled",
+	"data.autoscalepolicies:#.deleteprepare":                                          "Preparing to be deleted",
+	"data.idlereservableclusterinsts.idletime":                                        "Idle time (duration)",
+	"data.clusterinsts:#.fields":                                                      "Fields are used for the Update API to specify which fields to apply",
+	"data.clusterinsts:#.key.name":                                                    "Cluster name",
+	"data.clusterinsts:#.key.organization":                                            "Name of the organization that this cluster belongs to",
+	"data.clusterinsts:#.cloudletkey.organization":                                    "Organization of the cloudlet site",
+	"data.clusterinsts:#.cloudletkey.name":                                            "Name of the cloudlet",
+	"data.clusterinsts:#.cloudletkey.federatedorganization":                           "Federated operator organization who shared this cloudlet",
+	"data.clusterinsts:#.zonekey.organization":                                        "Organization owner of the Zone",
+	"data.clusterinsts:#.zonekey.name":                                                "Name of the Zone",
+	"data.clusterinsts:#.zonekey.federatedorganization":                               "Federated operator organization who shared this Zone",
+	"data.clusterinsts:#.flavor.name":                                                 "Flavor name",
+	"data.clusterinsts:#.liveness":                                                    "Liveness of instance (see Liveness), one of Unknown, Static, Dynamic, Autoprov",
+	"data.clusterinsts:#.auto":                                                        "Auto is set to true when automatically created by back-end (internal use only)",
+	"data.clusterinsts:#.state":                                                       "State of the cluster instance, one of TrackedStateUnknown, NotPresent, CreateRequested, Creating, CreateError, Ready, UpdateRequested, Updating, UpdateError, DeleteRequested, Deleting, DeleteError, DeletePrepare, CrmInitok, CreatingDependencies, DeleteDone",
+	"data.clusterinsts:#.errors":                                                      "Any errors trying to create, update, or delete the ClusterInst on the Cloudlet.",
+	"data.clusterinsts:#.crmoverride":                                                 "Override actions to CRM, one of NoOverride, IgnoreCrmErrors, IgnoreCrm, IgnoreTransientState, IgnoreCrmAndTransientState",
+	"data.clusterinsts:#.ipaccess":                                                    "IP access type (RootLB Type), one of Unknown, Dedicated, Shared",
+	"data.clusterinsts:#.allocatedip":                                                 "Allocated IP for dedicated access",
+	"data.clusterinsts:#.nodeflavor":                                                  "(_deprecated_) Replaced by NodePools.NodeResources.InfraNodeFlavor. Cloudlet specific node flavor.",
+	"data.clusterinsts:#.deployment":                                                  "Deployment type (kubernetes or docker)",
+	"data.clusterinsts:#.nummasters":                                                  "Number of k8s masters (In case of docker deployment, this field is not required)",
+	"data.clusterinsts:#.numnodes":                                                    "(_deprecated_) Replaced by NodePools.NumNodes. Number of k8s nodes.",
+	"data.clusterinsts:#.externalvolumesize":                                          "(_deprecated_) Replaced by NodePools.NodeResources.ExternalVolumeSize. Size of external volume to be attached to nodes. This is for the root partition",
+	"data.clusterinsts:#.autoscalepolicy":                                             "Auto scale policy name",
+	"data.clusterinsts:#.availabilityzone":                                            "Optional Resource AZ if any",
+	"data.clusterinsts:#.imagename":                                                   "Optional resource specific image to launch",
+	"data.clusterinsts:#.reservable":                                                  "If ClusterInst is reservable",
+	"data.clusterinsts:#.reservedby":                                                  "For reservable EdgeCloud ClusterInsts, the current developer tenant",
+	"data.clusterinsts:#.sharedvolumesize":                                            "Size of an optional shared volume to be mounted on the master",
+	"data.clusterinsts:#.masternodeflavor":                                            "Generic flavor for k8s master VM when worker nodes > 0",
+	"data.clusterinsts:#.skipcrmcleanuponfailure":                                     "Prevents cleanup of resources on failure within CRM, used for diagnostic purposes",
+	"data.clusterinsts:#.optres":                                                      "Optional Resources required by OS flavor if any",
+	"data.clusterinsts:#.resources.vms:#.name":                                        "Virtual machine name",
+	"data.clusterinsts:#.resources.vms:#.type":                                        "Type can be platformvm, platform-cluster-master, platform-cluster-primary-node, platform-cluster-secondary-node, sharedrootlb, dedicatedrootlb, cluster-master, cluster-k8s-node, cluster-docker-node, appvm",
+	"data.clusterinsts:#.resources.vms:#.status":                                      "Runtime status of the VM",
+	"data.clusterinsts:#.resources.vms:#.infraflavor":                                 "Flavor allocated within the cloudlet infrastructure, distinct from the control plane flavor",
+	"data.clusterinsts:#.resources.vms:#.ipaddresses:#.externalip":                    "External IP address",
+	"data.clusterinsts:#.resources.vms:#.ipaddresses:#.internalip":                    "Internal IP address",
+	"data.clusterinsts:#.resources.vms:#.containers:#.name":                           "Name of the container",
+	"data.clusterinsts:#.resources.vms:#.containers:#.type":                           "Type can be docker or kubernetes",
+	"data.clusterinsts:#.resources.vms:#.containers:#.status":                         "Runtime status of the container",
+	"data.clusterinsts:#.resources.vms:#.containers:#.clusterip":                      "IP within the CNI and is applicable to kubernetes only",
+	"data.clusterinsts:#.resources.vms:#.containers:#.restarts":                       "Restart count, applicable to kubernetes only",
+	"data.clusterinsts:#.createdat":                                                   "Created at time",
+	"data.clusterinsts:#.updatedat":                                                   "Updated at time",
+	"data.clusterinsts:#.reservationendedat":                                          "For reservable ClusterInsts, when the last reservation ended",
+	"data.clusterinsts:#.multitenant":                                                 "Multi-tenant kubernetes cluster",
+	"data.clusterinsts:#.networks":                                                    "networks to connect to",
+	"data.clusterinsts:#.deleteprepare":                                               "Preparing to be deleted",
+	"data.clusterinsts:#.dnslabel":                                                    "DNS label that is unique within the cloudlet and among other AppInsts/ClusterInsts",
+	"data.clusterinsts:#.fqdn":                                                        "FQDN is a globally unique DNS id for the ClusterInst",
+	"data.clusterinsts:#.staticfqdn":                                                  "Static startup FQDN is an immutable globally unique name, set when object is created",
+	"data.clusterinsts:#.enableipv6":                                                  "Enable IPv6 addressing, requires platform and cloudlet support, defaults to platform setting",
+	"data.clusterinsts:#.objid":                                                       "Universally unique object ID",
+	"data.clusterinsts:#.compatibilityversion":                                        "internal compatibility version",
+	"data.clusterinsts:#.annotations":                                                 "Annotations",
+	"data.clusterinsts:#.dbmodelid":                                                   "database version model ID",
+	"data.clusterinsts:#.noderesources.vcpus":                                         "Vcpus to be allocated to the VM, must be either 1 or an even number",
+	"data.clusterinsts:#.noderesources.ram":                                           "Total RAM in megabytes to be allocated to the VM",
+	"data.clusterinsts:#.noderesources.disk":                                          "Total disk space in gigabytes to be allocated to the VMs root partition",
+	"data.clusterinsts:#.noderesources.gpus:#.modelid":                                "GPU model unique identifier",
+	"data.clusterinsts:#.noderesources.gpus:#.count":                                  "Count of how many of this GPU are required/present",
+	"data.clusterinsts:#.noderesources.gpus:#.vendor":                                 "GPU vendor (nvidia, amd, etc)",
+	"data.clusterinsts:#.noderesources.gpus:#.memory":                                 "Memory in GB",
+	"data.clusterinsts:#.noderesources.gpus:#.inuse":                                  "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"data.clusterinsts:#.noderesources.optresmap":                                     "Optional resources request, i.e. optresmap=restype=resname:1",
+	"data.clusterinsts:#.noderesources.infranodeflavor":                               "Infrastructure specific node flavor",
+	"data.clusterinsts:#.noderesources.externalvolumesize":                            "Size of external volume to be attached to nodes. This is for the root partition",
+	"data.clusterinsts:#.nodepools:#.name":                                            "Node pool name",
+	"data.clusterinsts:#.nodepools:#.numnodes":                                        "Number of nodes in the pool",
+	"data.clusterinsts:#.nodepools:#.noderesources.vcpus":                             "Vcpus to be allocated to the VM, must be either 1 or an even number",
+	"data.clusterinsts:#.nodepools:#.noderesources.ram":                               "Total RAM in megabytes to be allocated to the VM",
+	"data.clusterinsts:#.nodepools:#.noderesources.disk":                              "Total disk space in gigabytes to be allocated to the VMs root partition",
+	"data.clusterinsts:#.nodepools:#.noderesources.gpus:#.modelid":                    "GPU model unique identifier",
+	"data.clusterinsts:#.nodepools:#.noderesources.gpus:#.count":                      "Count of how many of this GPU are required/present",
+	"data.clusterinsts:#.nodepools:#.noderesources.gpus:#.vendor":                     "GPU vendor (nvidia, amd, etc)",
+	"data.clusterinsts:#.nodepools:#.noderesources.gpus:#.memory":                     "Memory in GB",
+	"data.clusterinsts:#.nodepools:#.noderesources.gpus:#.inuse":                      "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"data.clusterinsts:#.nodepools:#.noderesources.optresmap":                         "Optional resources request, i.e. optresmap=restype=resname:1",
+	"data.clusterinsts:#.nodepools:#.noderesources.infranodeflavor":                   "Infrastructure specific node flavor",
+	"data.clusterinsts:#.nodepools:#.noderesources.externalvolumesize":                "Size of external volume to be attached to nodes. This is for the root partition",
+	"data.clusterinsts:#.nodepools:#.scalable":                                        "Scalable indicates the system may scale the number of nodes",
+	"data.clusterinsts:#.infraannotations":                                            "Annotations added by the implementing infrastructure",
+	"data.clusterinsts:#.kubernetesversion":                                           "Kubernetes version of cluster if applicable",
+	"data.clusterinsts:#.disabledynamicappinstplacement":                              "Disables dynamic placement of AppInsts on this cluster",
+	"data.clusterinsts:#.cloudletmanagedclusterid":                                    "Cloudlet managed cluster ID, if cluster based on cloudlet managed cluster",
+	"data.clusterinsts:#.cloudletmanagedclustername":                                  "Cloudlet managed cluster name, if cluster based on cloudlet managed cluster",
+	"data.clusterinsts:#.dryrun":                                                      "Preview the create without applying it, reports the chosen cloudlet, the resources required, and why other cloudlets were skipped",
+	"data.clusterinsts:#.tags":                                                        "Vendor-specific data",
+	"data.geofencepolicies:#.fields":                                                  "Fields are used for the Update API to specify which fields to apply",
+	"data.geofencepolicies:#.key.organization":                                        "Name of the organization for the cluster that this policy will apply to",
+	"data.geofencepolicies:#.key.name":                                                "Policy name",
+	"data.geofencepolicies:#.fences:#.name":                                           "Name of the geo-fence",
+	"data.geofencepolicies:#.fences:#.polygon:#.latitude":                             "Latitude in WGS 84 coordinates",
+	"data.geofencepolicies:#.fences:#.polygon:#.longitude":                            "Longitude in WGS 84 coordinates",
+	"data.geofencepolicies:#.fences:#.polygon:#.horizontalaccuracy":                   "Horizontal accuracy (radius in meters)",
+	"data.geofencepolicies:#.fences:#.polygon:#.verticalaccuracy":                     "Vertical accuracy (meters)",
+	"data.geofencepolicies:#.fences:#.polygon:#.altitude":                             "On android only lat and long are guaranteed to be supplied Altitude in meters",
+	"data.geofencepolicies:#.fences:#.polygon:#.course":                               "Course (IOS) / bearing (Android) (degrees east relative to true north)",
+	"data.geofencepolicies:#.fences:#.polygon:#.speed":                                "Speed (IOS) / velocity (Android) (meters/sec)",
+	"data.geofencepolicies:#.fences:#.polygon:#.timestamp":                            "Timestamp",
+	"data.geofencepolicies:#.fences:#.countrycodes":                                   "ISO 3166-1 alpha-2 codes of countries enclosed by the geo-fence",
+	"data.geofencepolicies:#.deleteprepare":                                           "Preparing to be deleted",
+	"data.apps:#.fields":                                                              "Fields are used for the Update API to specify which fields to apply",
+	"data.apps:#.key.organization":                                                    "App developer organization",
+	"data.apps:#.key.name":                                                            "App name",
+	"data.apps:#.key.version":                                                         "App version",
+	"data.apps:#.imagepath":                                                           "URI of where image resides",
+	"data.apps:#.imagetype":                                                           "Image type, one of Unknown, Docker, Qcow, Helm, Ovf, Ova",
+	"data.apps:#.accessports":                                                         "Comma separated list of protocol:port pairs that the App listens on. Ex: tcp:80,udp:10002. Also supports additional configurations per port: (1) tls (tcp-only) - Enables TLS on specified port. Ex: tcp:443:tls. (2) nginx (udp-only) - Use NGINX LB instead of envoy for specified port. Ex: udp:10001:nginx. (3) maxpktsize (udp-only) - Configures maximum UDP datagram size allowed on port for both upstream/downstream traffic. Ex: udp:10001:maxpktsize=8000. (4) intvis (internal-visibility)- Port is not externally accessible. Ex: tcp:9000:intvis (5) id - Port ID. Ex: tcp:9000:id=p9000 (6) pathprefix (http-only) - Specifies the path prefix to use in the kubernetes ingress, required if multiple http ports are present, defaults to / (7) svcname - For Kubernetes apps, if there are multiple of the same port on different services, this denotes the service name. Ex: tcp:9000:tls:svcname=svc1",
+	"data.apps:#.defaultflavor.name":                                                  "Flavor name",
+	"data.apps:#.authpublickey":                                                       "Public key used for authentication",
+	"data.apps:#.command":                                                             "Command that the container runs to start service, separate multiple commands by a space",
+	"data.apps:#.commandargs":                                                         "Command args to append to command, on cli specify multiple times in order",
+	"data.apps:#.annotations":                                                         "Annotations is a comma separated map of arbitrary key value pairs, for example: key1=val1,key2=val2,key3=val 3",
+	"data.apps:#.deployment":                                                          "Deployment type (kubernetes, docker, or vm)",
+	"data.apps:#.deploymentmanifest":                                                  "Deployment manifest is the deployment specific manifest file/config. For docker deployment, this can be a docker-compose or docker run file. For kubernetes deployment, this can be a kubernetes yaml or helm chart file.",
+	"data.apps:#.deploymentgenerator":                                                 "Deployment generator target to generate a basic deployment manifest",
+	"data.apps:#.androidpackagename":                                                  "Android package name used to match the App name from the Android package",
+	"data.apps:#.delopt":                                                              "Override actions to Controller, one of NoAutoDelete, AutoDelete",
+	"data.apps:#.configs:#.kind":                                                      "Kind (type) of config, i.e. envVarsYaml, helmCustomizationYaml",
+	"data.apps:#.configs:#.config":                                                    "Config file contents or URI reference",
+	"data.apps:#.scalewithcluster":                                                    "True indicates App runs on all nodes of the cluster as it scales",
+	"data.apps:#.internalports":                                                       "True indicates App is used internally with other Apps only, and no ports are exposed externally",
+	"data.apps:#.revision":                                                            "Revision can be specified or defaults to current timestamp when app is updated",
+	"data.apps:#.officialfqdn":                                                        "Official FQDN is the FQDN that the app uses to connect by default",
+	"data.apps:#.md5sum":                                                              "MD5Sum of the VM-based app image",
+	"data.apps:#.autoprovpolicy":                                                      "(_deprecated_) Auto provisioning policy name",
+	"data.apps:#.accesstype":                                                          "(_deprecated_) Access type, one of DefaultForDeployment, Direct, LoadBalancer",
+	"data.apps:#.deleteprepare":                                                       "Preparing to be deleted",
+	"data.apps:#.autoprovpolicies":                                                    "Auto provisioning policy names, may be specified multiple times",
+	"data.apps:#.templatedelimiter":                                                   "Delimiter to be used for template parsing, defaults to [[ ]]",
+	"data.apps:#.skiphcports":                                                         "Comma separated list of protocol:port pairs that we should not run health check on. Should be configured in case app does not always listen on these ports. all can be specified if no health check to be run for this app. Numerical values must be decimal format. i.e. tcp:80,udp:10002",
+	"data.apps:#.createdat":                                                           "Created at time",
+	"data.apps:#.updatedat":                                                           "Updated at time",
+	"data.apps:#.trusted":                                                             "Indicates that an instance of this app can be started on a trusted cloudlet",
+	"data.apps:#.requiredoutboundconnections:#.protocol":                              "TCP, UDP, ICMP",
+	"data.apps:#.requiredoutboundconnections:#.portrangemin":                          "TCP or UDP port range start",
+	"data.apps:#.requiredoutboundconnections:#.portrangemax":                          "TCP or UDP port range end",
+	"data.apps:#.requiredoutboundconnections:#.remotecidr":                            "Remote CIDR X.X.X.X/X for IPv4 or e.g. XXXX:XXXX::XXXX/XX for IPv6",
+	"data.apps:#.allowserverless":                                                     "App is allowed to deploy as serverless containers",
+	"data.apps:#.serverlessconfig.vcpus":                                              "Virtual CPUs allocation per container when serverless, may be decimal in increments of 0.001",
+	"data.apps:#.serverlessconfig.ram":                                                "RAM allocation in megabytes per container when serverless",
+	"data.apps:#.serverlessconfig.minreplicas":                                        "Minimum number of replicas when serverless",
+	"data.apps:#.serverlessconfig.gpuconfig.type":                                     "GPU Type, one of None, Any, Vgpu, Pci",
+	"data.apps:#.serverlessconfig.gpuconfig.model":                                    "Model name or vgpu type",
+	"data.apps:#.serverlessconfig.gpuconfig.numgpu":                                   "Number of instances",
+	"data.apps:#.serverlessconfig.gpuconfig.ram":                                      "required memory in megabytes",
+	"data.apps:#.vmappostype":                                                         "OS Type for VM Apps, one of Unknown, Linux, Windows10, Windows2012, Windows2016, Windows2019",
+	"data.apps:#.alertpolicies":                                                       "Alert Policies",
+	"data.apps:#.qossessionprofile":                                                   "Qualifier for the requested latency profile, one of NoPriority, LowLatency, ThroughputDownS, ThroughputDownM, ThroughputDownL",
+	"data.apps:#.qossessionduration":                                                  "Session duration in seconds. Maximal value of 24 hours is used if not set",
+	"data.apps:#.globalid":                                                            "A globally unique id for the App to be used with federation",
+	"data.apps:#.envvars":                                                             "Environment variables",
+	"data.apps:#.secretenvvars":                                                       "Environment variables with sensitive information, stored in encrypted storage",
+	"data.apps:#.updatelistaction":                                                    "For updating list and map fields, set to add, remove, or replace to define how to resolve specified entries against existing entries",
+	"data.apps:#.kubernetesresources.cpupool.totalvcpus":                              "Total Vcpus to be allocated in the pool, in increments of 0.001",
+	"data.apps:#.kubernetesresources.cpupool.totalmemory":                             "Total RAM in megabytes to be allocated in the pool",
+	"data.apps:#.kubernetesresources.cpupool.totaldisk":                               "Total Disk in gigabytes to be allocated in the pool",
+	"data.apps:#.kubernetesresources.cpupool.totalgpus:#.modelid":                     "GPU model unique identifier",
+	"data.apps:#.kubernetesresources.cpupool.totalgpus:#.count":                       "Count of how many of this GPU are required/present",
+	"data.apps:#.kubernetesresources.cpupool.totalgpus:#.vendor":                      "GPU vendor (nvidia, amd, etc)",
+	"data.apps:#.kubernetesresources.cpupool.totalgpus:#.memory":                      "Memory in GB",
+	"data.apps:#.kubernetesresources.cpupool.totalgpus:#.inuse":                       "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"data.apps:#.kubernetesresources.cpupool.totaloptres":                             "Total optional resources to be allocated in the pool, follows the NodeResources.OptResMap format.",
+	"data.apps:#.kubernetesresources.cpupool.topology.minnodevcpus":                   "Minimum number of vcpus per node",
+	"data.apps:#.kubernetesresources.cpupool.topology.minnodememory":                  "Minimum amount of RAM in megabytes per node",
+	"data.apps:#.kubernetesresources.cpupool.topology.minnodedisk":                    "Minimum amount of root partition disk space in gigabytes per node",
+	"data.apps:#.kubernetesresources.cpupool.topology.minnodegpus:#.modelid":          "GPU model unique identifier",
+	"data.apps:#.kubernetesresources.cpupool.topology.minnodegpus:#.count":            "Count of how many of this GPU are required/present",
+	"data.apps:#.kubernetesresources.cpupool.topology.minnodegpus:#.vendor":           "GPU vendor (nvidia, amd, etc)",
+	"data.apps:#.kubernetesresources.cpupool.topology.minnodegpus:#.memory":           "Memory in GB",
+	"data.apps:#.kubernetesresources.cpupool.topology.minnodegpus:#.inuse":            "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"data.apps:#.kubernetesresources.cpupool.topology.minnodeoptres":                  "Minimum number of optional resources per node",
+	"data.apps:#.kubernetesresources.cpupool.topology.minnumberofnodes":               "Minimum number of nodes in pool, to satisfy HA/replication requirements",
+	"data.apps:#.kubernetesresources.gpupool.totalvcpus":                              "Total Vcpus to be allocated in the pool, in increments of 0.001",
+	"data.apps:#.kubernetesresources.gpupool.totalmemory":                             "Total RAM in megabytes to be allocated in the pool",
+	"data.apps:#.kubernetesresources.gpupool.totaldisk":                               "Total Disk in gigabytes to be allocated in the pool",
+	"data.apps:#.kubernetesresources.gpupool.totalgpus:#.modelid":                     "GPU model unique identifier",
+	"data.apps:#.kubernetesresources.gpupool.totalgpus:#.count":                       "Count of how many of this GPU are required/present",
+	"data.apps:#.kubernetesresources.gpupool.totalgpus:#.vendor":                      "GPU vendor (nvidia, amd, etc)",
+	"data.apps:#.kubernetesresources.gpupool.totalgpus:#.memory":                      "Memory in GB",
+	"data.apps:#.kubernetesresources.gpupool.totalgpus:#.inuse":                       "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"data.apps:#.kubernetesresources.gpupool.totaloptres":                             "Total optional resources to be allocated in the pool, follows the NodeResources.OptResMap format.",
+	"data.apps:#.kubernetesresources.gpupool.topology.minnodevcpus":                   "Minimum number of vcpus per node",
+	"data.apps:#.kubernetesresources.gpupool.topology.minnodememory":                  "Minimum amount of RAM in megabytes per node",
+	"data.apps:#.kubernetesresources.gpupool.topology.minnodedisk":                    "Minimum amount of root partition disk space in gigabytes per node",
+	"data.apps:#.kubernetesresources.gpupool.topology.minnodegpus:#.modelid":          "GPU model unique identifier",
+	"data.apps:#.kubernetesresources.gpupool.topology.minnodegpus:#.count":            "Count of how many of this GPU are required/present",
+	"data.apps:#.kubernetesresources.gpupool.topology.minnodegpus:#.vendor":           "GPU vendor (nvidia, amd, etc)",
+	"data.apps:#.kubernetesresources.gpupool.topology.minnodegpus:#.memory":           "Memory in GB",
+	"data.apps:#.kubernetesresources.gpupool.topology.minnodegpus:#.inuse":            "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"data.apps:#.kubernetesresources.gpupool.topology.minnodeoptres":                  "Minimum number of optional resources per node",
+	"data.apps:#.kubernetesresources.gpupool.topology.minnumberofnodes":               "Minimum number of nodes in pool, to satisfy HA/replication requirements",
+	"data.apps:#.kubernetesresources.minkubernetesversion":                            "Minimum Kubernetes version",
+	"data.apps:#.noderesources.vcpus":                                                 "Vcpus to be allocated to the VM, must be either 1 or an even number",
+	"data.apps:#.noderesources.ram":                                                   "Total RAM in megabytes to be allocated to the VM",
+	"data.apps:#.noderesources.disk":                                                  "Total disk space in gigabytes to be allocated to the VMs root partition",
+	"data.apps:#.noderesources.gpus:#.modelid":                                        "GPU model unique identifier",
+	"data.apps:#.noderesources.gpus:#.count":                                          "Count of how many of this GPU are required/present",
+	"data.apps:#.noderesources.gpus:#.vendor":                                         "GPU vendor (nvidia, amd, etc)",
+	"data.apps:#.noderesources.gpus:#.memory":                                         "Memory in GB",
+	"data.apps:#.noderesources.gpus:#.inuse":                                          "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"data.apps:#.noderesources.optresmap":                                             "Optional resources request, i.e. optresmap=restype=resname:1",
+	"data.apps:#.noderesources.infranodeflavor":                                       "Infrastructure specific node flavor",
+	"data.apps:#.noderesources.externalvolumesize":                                    "Size of external volume to be attached to nodes. This is for the root partition",
+	"data.apps:#.objid":                                                               "Universally unique object ID",
+	"data.apps:#.appannotations":                                                      "Internal Annotations",
+	"data.apps:#.isstandalone":                                                        "A standalone App will not share a cluster with another App unless explicitly targeted to the same cluster",
+	"data.apps:#.managesownnamespaces":                                                "Specifies if the kubernetes application manages creating and deleting its own namespaces. If true, it is disallowed from deployment to multi-tenant clusters, and it is up to the application developer to manage namespace conflicts if they deploy multiple applications to the same cluster. If false, each application instance is deployed to its own namespace set by the platform.",
+	"data.apps:#.compatibilityversion":                                                "Internal compatibility version",
+	"data.apps:#.findcloudletscoreweights.distance":                                   "Weight per km of distance from the client to the cloudlet",
+	"data.apps:#.findcloudletscoreweights.latency":                                    "Weight per ms of average latency reported by clients of the AppInst",
+	"data.apps:#.findcloudletscoreweights.resourceusage":                              "Weight per percent used of the most used cloudlet infra resource",
+	"data.apps:#.findcloudletscoreweights.health":                                     "Weight added if the AppInst health check has not reported healthy",
+	"data.apps:#.geofencepolicy":                                                      "Geo-fence policy name, restricts which cloudlets may serve devices by location",
+	"data.apps:#.replicaautoscalepolicy.minreplicas":                                  "Minimum number of replicas",
+	"data.apps:#.replicaautoscalepolicy.maxreplicas":                                  "Maximum number of replicas",
+	"data.apps:#.replicaautoscalepolicy.targetcpu":                                    "Target average cpu utilization per replica (percentage 1 to 100 of requested cpu), 0 means disabled",
+	"data.apps:#.replicaautoscalepolicy.targetmem":                                    "Target average memory utilization per replica (percentage 1 to 100 of requested memory), 0 means disabled",
+	"data.apps:#.replicaautoscalepolicy.targetactiveconnections":                      "Target number of active connections per replica, 0 means disabled",
+	"data.apps:#.replicaautoscalepolicy.stabilizationwindowsec":                       "Stabilization window is the time for which past recommendations are considered before scaling down",
+	"data.apps:#.tags":                                                                "Vendor-specific data",
+	"data.appinstances:#.fields":                                                      "Fields are used for the Update API to specify which fields to apply",
+	"data.appinstances:#.key.name":                                                    "App Instance name",
+	"data.appinstances:#.key.organization":                                            "App Instance organization",
+	"data.appinstances:#.appkey.organization":                                         "App developer organization",
+	"data.appinstances:#.appkey.name":                                                 "App name",
+	"data.appinstances:#.appkey.version":                                              "App version",
+	"data.appinstances:#.clusterkey.name":                                             "Cluster name",
+	"data.appinstances:#.clusterkey.organization":                                     "Name of the organization that this cluster belongs to",
+	"data.appinstances:#.cloudletkey.organization":                                    "Organization of the cloudlet site",
+	"data.appinstances:#.cloudletkey.name":                                            "Name of the cloudlet",
+	"data.appinstances:#.cloudletkey.federatedorganization":                           "Federated operator organization who shared this cloudlet",
+	"data.appinstances:#.zonekey.organization":                                        "Organization owner of the Zone",
+	"data.appinstances:#.zonekey.name":                                                "Name of the Zone",
+	"data.appinstances:#.zonekey.federatedorganization":                               "Federated operator organization who shared this Zone",
+	"data.appinstances:#.cloudletloc.latitude":                                        "Latitude in WGS 84 coordinates",
+	"data.appinstances:#.cloudletloc.longitude":                                       "Longitude in WGS 84 coordinates",
+	"data.appinstances:#.cloudletloc.horizontalaccuracy":                              "Horizontal accuracy (radius in meters)",
+	"data.appinstances:#.cloudletloc.verticalaccuracy":                                "Vertical accuracy (meters)",
+	"data.appinstances:#.cloudletloc.altitude":                                        "On android only lat and long are guaranteed to be supplied Altitude in meters",
+	"data.appinstances:#.cloudletloc.course":                                          "Course (IOS) / bearing (Android) (degrees east relative to true north)",
+	"data.appinstances:#.cloudletloc.speed":                                           "Speed (IOS) / velocity (Android) (meters/sec)",
+	"data.appinstances:#.cloudletloc.timestamp":                                       "Timestamp",
+	"data.appinstances:#.uri":                                                         "Base FQDN (not really URI) for the App. See Service FQDN for endpoint access.",
+	"data.appinstances:#.staticuri":                                                   "Static startup FQDN gets set when the object is created and cannot be changed",
+	"data.appinstances:#.liveness":                                                    "Liveness of instance (see Liveness), one of Unknown, Static, Dynamic, Autoprov",
+	"data.appinstances:#.mappedports:#.proto":                                         "TCP (L4) or UDP (L4) protocol, one of Unknown, Tcp, Udp, Http",
+	"data.appinstances:#.mappedports:#.internalport":                                  "Container port",
+	"data.appinstances:#.mappedports:#.publicport":                                    "Public facing port for TCP/UDP (may be mapped on shared LB reverse proxy)",
+	"data.appinstances:#.mappedports:#.pathprefix":                                    "PathPrefix for HTTP ports in Kubernetes ingress",
+	"data.appinstances:#.mappedports:#.fqdnprefix":                                    "FQDN prefix to append to base FQDN in FindCloudlet response. May be empty.",
+	"data.appinstances:#.mappedports:#.endport":                                       "A non-zero end port indicates a port range from internal port to end port, inclusive.",
+	"data.appinstances:#.mappedports:#.tls":                                           "TLS termination for this port",
+	"data.appinstances:#.mappedports:#.nginx":                                         "Use nginx proxy for this port if you really need a transparent proxy (udp only)",
+	"data.appinstances:#.mappedports:#.maxpktsize":                                    "Maximum datagram size (udp only)",
+	"data.appinstances:#.mappedports:#.internalvisonly":                               "Internal visibility only",
+	"data.appinstances:#.mappedports:#.id":                                            "Port ID for NBI compatibility",
+	"data.appinstances:#.mappedports:#.servicename":                                   "Service name for Kubernetes port, use with a custom manifest or Helm chart that uses same port number on different services in the app.",
+	"data.appinstances:#.flavor.name":                                                 "Flavor name",
+	"data.appinstances:#.cloudletflavor":                                              "(_deprecated_) Cloudlet-specific flavor instead of regional flavor, replaced by NodeResources.InfraNodeFlavor.",
+	"data.appinstances:#.state":                                                       "Current state of the AppInst on the Cloudlet, one of TrackedStateUnknown, NotPresent, CreateRequested, Creating, CreateError, Ready, UpdateRequested, Updating, UpdateError, DeleteRequested, Deleting, DeleteError, DeletePrepare, CrmInitok, CreatingDependencies, DeleteDone",
+	"data.appinstances:#.errors":                                                      "Any errors trying to create, update, or delete the AppInst on the Cloudlet",
+	"data.appinstances:#.crmoverride":                                                 "Override actions to CRM, one of NoOverride, IgnoreCrmErrors, IgnoreCrm, IgnoreTransientState, IgnoreCrmAndTransientState",
+	"data.appinstances:#.runtimeinfo.containerids":                                    "List of container names",
+	"data.appinstances:#.runtimeinfo.replicas":                                        "Number of running replicas for Kubernetes deployments",
+	"data.appinstances:#.createdat":                                                   "Created at time",
+	"data.appinstances:#.autoclusteripaccess":                                         "(Deprecated) IpAccess for auto-clusters. Ignored otherwise., one of Unknown, Dedicated, Shared",
+	"data.appinstances:#.revision":                                                    "Revision changes each time the App is updated.  Refreshing the App Instance will sync the revision with that of the App",
+	"data.appinstances:#.forceupdate":                                                 "Force Appinst refresh even if revision number matches App revision number.",
+	"data.appinstances:#.updatemultiple":                                              "Allow multiple instances to be updated at once",
+	"data.appinstances:#.configs:#.kind":                                              "Kind (type) of config, i.e. envVarsYaml, helmCustomizationYaml",
+	"data.appinstances:#.configs:#.config":                                            "Config file contents or URI reference",
+	"data.appinstances:#.healthcheck":                                                 "Health Check status, one of Unknown, RootlbOffline, ServerFail, Ok, CloudletOffline",
+	"data.appinstances:#.powerstate":                                                  "Power State of the AppInst, one of PowerOn, PowerOff, Reboot",
+	"data.appinstances:#.externalvolumesize":                                          "Size of external volume to be attached to nodes.  This is for the root partition",
+	"data.appinstances:#.availabilityzone":                                            "Optional Availability Zone if any",
+	"data.appinstances:#.vmflavor":                                                    "(_deprecated_) Replaced by NodeResources.InfraNodeFlavor; OS node flavor to use",
+	"data.appinstances:#.optres":                                                      "(_deprecated_) Optional Resources required by OS flavor if any",
+	"data.appinstances:#.updatedat":                                                   "Updated at time",
+	"data.appinstances:#.realclustername":                                             "(_deprecated_) Real ClusterInst name",
+	"data.appinstances:#.internalporttolbip":                                          "mapping of ports to load balancer IPs",
+	"data.appinstances:#.dedicatedip":                                                 "Dedicated IP assigns an IP for this AppInst but requires platform support",
+	"data.appinstances:#.uniqueid":                                                    "A unique id for the AppInst within the region to be used by platforms",
+	"data.appinstances:#.dnslabel":                                                    "DNS label that is unique within the cloudlet and among other AppInsts/ClusterInsts",
+	"data.appinstances:#.fedkey.federationname":                                       "Federation name",
+	"data.appinstances:#.fedkey.appinstid":                                            "Federated AppInst ID",
+	"data.appinstances:#.compatibilityversion":                                        "Internal compatibility version",
+	"data.appinstances:#.virtualclusterkey.name":                                      "Cluster name",
+	"data.appinstances:#.virtualclusterkey.organization":                              "Name of the organization that this cluster belongs to",
+	"data.appinstances:#.enableipv6":                                                  "Enable IPv6 addressing, requires platform and cloudlet support, defaults to platform setting for VM Apps and auto-clusters, otherwise defaults to target cluster instance setting.",
+	"data.appinstances:#.objid":                                                       "Universally unique object ID",
+	"data.appinstances:#.annotations":                                                 "Annotations",
+	"data.appinstances:#.dbmodelid":                                                   "database version model ID",
+	"data.appinstances:#.kubernetesresources.cpupool.totalvcpus":                      "Total Vcpus to be allocated in the pool, in increments of 0.001",
+	"data.appinstances:#.kubernetesresources.cpupool.totalmemory":                     "Total RAM in megabytes to be allocated in the pool",
+	"data.appinstances:#.kubernetesresources.cpupool.totaldisk":                       "Total Disk in gigabytes to be allocated in the pool",
+	"data.appinstances:#.kubernetesresources.cpupool.totalgpus:#.modelid":             "GPU model unique identifier",
+	"data.appinstances:#.kubernetesresources.cpupool.totalgpus:#.count":               "Count of how many of this GPU are required/present",
+	"data.appinstances:#.kubernetesresources.cpupool.totalgpus:#.vendor":              "GPU vendor (nvidia, amd, etc)",
+	"data.appinstances:#.kubernetesresources.cpupool.totalgpus:#.memory":              "Memory in GB",
+	"data.appinstances:#.kubernetesresources.cpupool.totalgpus:#.inuse":               "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"data.appinstances:#.kubernetesresources.cpupool.totaloptres":                     "Total optional resources to be allocated in the pool, follows the NodeResources.OptResMap format.",
+	"data.appinstances:#.kubernetesresources.cpupool.topology.minnodevcpus":           "Minimum number of vcpus per node",
+	"data.appinstances:#.kubernetesresources.cpupool.topology.minnodememory":          "Minimum amount of RAM in megabytes per node",
+	"data.appinstances:#.kubernetesresources.cpupool.topology.minnodedisk":            "Minimum amount of root partition disk space in gigabytes per node",
+	"data.appinstances:#.kubernetesresources.cpupool.topology.minnodegpus:#.modelid":  "GPU model unique identifier",
+	"data.appinstances:#.kubernetesresources.cpupool.topology.minnodegpus:#.count":    "Count of how many of this GPU are required/present",
+	"data.appinstances:#.kubernetesresources.cpupool.topology.minnodegpus:#.vendor":   "GPU vendor (nvidia, amd, etc)",
+	"data.appinstances:#.kubernetesresources.cpupool.topology.minnodegpus:#.memory":   "Memory in GB",
+	"data.appinstances:#.kubernetesresources.cpupool.topology.minnodegpus:#.inuse":    "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"data.appinstances:#.kubernetesresources.cpupool.topology.minnodeoptres":          "Minimum number of optional resources per node",
+	"data.appinstances:#.kubernetesresources.cpupool.topology.minnumberofnodes":       "Minimum number of nodes in pool, to satisfy HA/replication requirements",
+	"data.appinstances:#.kubernetesresources.gpupool.totalvcpus":                      "Total Vcpus to be allocated in the pool, in increments of 0.001",
+	"data.appinstances:#.kubernetesresources.gpupool.totalmemory":                     "Total RAM in megabytes to be allocated in the pool",
+	"data.appinstances:#.kubernetesresources.gpupool.totaldisk":                       "Total Disk in gigabytes to be allocated in the pool",
+	"data.appinstances:#.kubernetesresources.gpupool.totalgpus:#.modelid":             "GPU model unique identifier",
+	"data.appinstances:#.kubernetesresources.gpupool.totalgpus:#.count":               "Count of how many of this GPU are required/present",
+	"data.appinstances:#.kubernetesresources.gpupool.totalgpus:#.vendor":              "GPU vendor (nvidia, amd, etc)",
+	"data.appinstances:#.kubernetesresources.gpupool.totalgpus:#.memory":              "Memory in GB",
+	"data.appinstances:#.kubernetesresources.gpupool.totalgpus:#.inuse":               "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"data.appinstances:#.kubernetesresources.gpupool.totaloptres":                     "Total optional resources to be allocated in the pool, follows the NodeResources.OptResMap format.",
+	"data.appinstances:#.kubernetesresources.gpupool.topology.minnodevcpus":           "Minimum number of vcpus per node",
+	"data.appinstances:#.kubernetesresources.gpupool.topology.minnodememory":          "Minimum amount of RAM in megabytes per node",
+	"data.appinstances:#.kubernetesresources.gpupool.topology.minnodedisk":            "Minimum amount of root partition disk space in gigabytes per node",
+	"data.appinstances:#.kubernetesresources.gpupool.topology.minnodegpus:#.modelid":  "GPU model unique identifier",
+	"data.appinstances:#.kubernetesresources.gpupool.topology.minnodegpus:#.count":    "Count of how many of this GPU are required/present",
+	"data.appinstances:#.kubernetesresources.gpupool.topology.minnodegpus:#.vendor":   "GPU vendor (nvidia, amd, etc)",
+	"data.appinstances:#.kubernetesresources.gpupool.topology.minnodegpus:#.memory":   "Memory in GB",
+	"data.appinstances:#.kubernetesresources.gpupool.topology.minnodegpus:#.inuse":    "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"data.appinstances:#.kubernetesresources.gpupool.topology.minnodeoptres":          "Minimum number of optional resources per node",
+	"data.appinstances:#.kubernetesresources.gpupool.topology.minnumberofnodes":       "Minimum number of nodes in pool, to satisfy HA/replication requirements",
+	"data.appinstances:#.kubernetesresources.minkubernetesversion":                    "Minimum Kubernetes version",
+	"data.appinstances:#.noderesources.vcpus":                                         "Vcpus to be allocated to the VM, must be either 1 or an even number",
+	"data.appinstances:#.noderesources.ram":                                           "Total RAM in megabytes to be allocated to the VM",
+	"data.appinstances:#.noderesources.disk":                                          "Total disk space in gigabytes to be allocated to the VMs root partition",
+	"data.appinstances:#.noderesources.gpus:#.modelid":                                "GPU model unique identifier",
+	"data.appinstances:#.noderesources.gpus:#.count":                                  "Count of how many of this GPU are required/present",
+	"data.appinstances:#.noderesources.gpus:#.vendor":                                 "GPU vendor (nvidia, amd, etc)",
+	"data.appinstances:#.noderesources.gpus:#.memory":                                 "Memory in GB",
+	"data.appinstances:#.noderesources.gpus:#.inuse":                                  "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"data.appinstances:#.noderesources.optresmap":                                     "Optional resources request, i.e. optresmap=restype=resname:1",
+	"data.appinstances:#.noderesources.infranodeflavor":                               "Infrastructure specific node flavor",
+	"data.appinstances:#.noderesources.externalvolumesize":                            "Size of external volume to be attached to nodes. This is for the root partition",
+	"data.appinstances:#.isstandalone":                                                "A standalone AppInst will not share a cluster with another AppInst unless explicitly targeted to the same cluster",
+	"data.appinstances:#.replicas":                                                    "Number of replicas for Kubernetes deployments, 0 uses the App manifest default",
+	"data.appinstances:#.dryrun":                                                      "Preview the create without applying it, reports the chosen cloudlet and cluster, the resources required, and why other candidates were skipped",
+	"data.appinstances:#.tags":                                                        "Vendor-specific data",
+	"data.appinstrefs:#.key.organization":                                             "App developer organization",
+	"data.appinstrefs:#.key.name":                                                     "App name",
+	"data.appinstrefs:#.key.version":                                                  "App version",
+	"data.clusterrefs:#.key.name":                                                     "Cluster name",
+	"data.clusterrefs:#.key.organization":                                             "Name of the organization that this cluster belongs to",
+	"data.clusterrefs:#.apps:#.name":                                                  "App Instance name",
+	"data.clusterrefs:#.apps:#.organization":                                          "App Instance organization",
+	"data.vmpools:#.fields":                                                           "Fields are used for the Update API to specify which fields to apply",
+	"data.vmpools:#.key.organization":                                                 "Organization of the vmpool",
+	"data.vmpools:#.key.name":                                                         "Name of the vmpool",
+	"data.vmpools:#.vms:#.name":                                                       "VM Name",
+	"data.vmpools:#.vms:#.netinfo.externalip":                                         "External IP",
+	"data.vmpools:#.vms:#.netinfo.internalip":                                         "Internal IP",
+	"data.vmpools:#.vms:#.groupname":                                                  "VM Group Name",
+	"data.vmpools:#.vms:#.state":                                                      "VM State, one of ForceFree",
+	"data.vmpools:#.vms:#.updatedat.seconds":                                          "Represents seconds of UTC time since Unix epoch 1970-01-01T00:00:00Z. Must be from 0001-01-01T00:00:00Z to 9999-12-31T23:59:59Z inclusive.",
+	"data.vmpools:#.vms:#.updatedat.nanos":                                            "Non-negative fractions of a second at nanosecond resolution. Negative second values with fractions must still have non-negative nanos values that count forward in time. Must be from 0 to 999,999,999 inclusive.",
+	"data.vmpools:#.vms:#.internalname":                                               "VM Internal Name",
+	"data.vmpools:#.vms:#.flavor.name":                                                "Name of the infra flavor",
+	"data.vmpools:#.vms:#.flavor.vcpus":                                               "Number of VCPU cores on the infra flavor",
+	"data.vmpools:#.vms:#.flavor.ram":                                                 "Ram in MB on the infra flavor",
+	"data.vmpools:#.vms:#.flavor.disk":                                                "Amount of disk in GB on the infra flavor",
+	"data.vmpools:#.vms:#.flavor.gpus:#.modelid":                                      "GPU model unique identifier",
+	"data.vmpools:#.vms:#.flavor.gpus:#.count":                                        "Count of how many of this GPU are required/present",
+	"data.vmpools:#.vms:#.flavor.gpus:#.vendor":                                       "GPU vendor (nvidia, amd, etc)",
+	"data.vmpools:#.vms:#.flavor.gpus:#.memory":                                       "Memory in GB",
+	"data.vmpools:#.vms:#.flavor.gpus:#.inuse":                                        "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"data.vmpools:#.vms:#.flavor.propmap":                                             "Infra flavor Properties, if any",
+	"data.vmpools:#.state":                                                            "Current state of the VM pool, one of TrackedStateUnknown, NotPresent, CreateRequested, Creating, CreateError, Ready, UpdateRequested, Updating, UpdateError, DeleteRequested, Deleting, DeleteError, DeletePrepare, CrmInitok, CreatingDependencies, DeleteDone",
+	"data.vmpools:#.errors":                                                           "Any errors trying to add/remove VM to/from VM Pool",
+	"data.vmpools:#.crmoverride":                                                      "Override actions to CRM, one of NoOverride, IgnoreCrmErrors, IgnoreCrm, IgnoreTransientState, IgnoreCrmAndTransientState",
+	"data.vmpools:#.deleteprepare":                                                    "Preparing to be deleted",
+	"data.alertpolicies:#.key.organization":                                           "Name of the organization for the app that this alert can be applied to",
+	"data.alertpolicies:#.key.name":                                                   "Alert Policy name",
+	"data.alertpolicies:#.cpuutilizationlimit":                                        "Container or pod CPU utilization rate(percentage) across all nodes. Valid values 1-100",
+	"data.alertpolicies:#.memutilizationlimit":                                        "Container or pod memory utilization rate(percentage) across all nodes. Valid values 1-100",
+	"data.alertpolicies:#.diskutilizationlimit":                                       "Container or pod disk utilization rate(percentage) across all nodes. Valid values 1-100",
+	"data.alertpolicies:#.activeconnlimit":                                            "Active Connections alert threshold. Valid values 1-4294967295",
+	"data.alertpolicies:#.severity":                                                   "Alert severity level - one of info, warning, error",
+	"data.alertpolicies:#.triggertime":                                                "Duration for which alert interval is active (max 72 hours)",
+	"data.alertpolicies:#.labels":                                                     "Additional Labels",
+	"data.alertpolicies:#.annotations":                                                "Additional Annotations for extra information about the alert",
+	"data.alertpolicies:#.description":                                                "Description of the alert policy",
+	"data.alertpolicies:#.deleteprepare":                                              "Preparing to be deleted",
+	"data.alertpolicies:#.customexpression":                                           "Custom Prometheus (PromQL) alert expression, for example rate(http_errors_total[5m]) > 5. Label matchers that scope every metric to the AppInst are added automatically",
+	"data.alertpolicies:#.custommetricssource":                                        "Metrics the custom expression is evaluated against, one of App (metrics exposed by the application) or LoadBalancer (load balancer metrics), one of App, LoadBalancer",
+	"data.flowratelimitsettings:#.fields":                                             "Fields are used for the Update API to specify which fields to apply",
+	"data.flowratelimitsettings:#.key.flowsettingsname":                               "Unique name for FlowRateLimitSettings (there can be multiple FlowSettings per RateLimitSettingsKey)",
+	"data.flowratelimitsettings:#.key.ratelimitkey.apiname":                           "Name of API (eg. CreateApp or RegisterClient) (Use Global if not a specific API)",
+	"data.flowratelimitsettings:#.key.ratelimitkey.apiendpointtype":                   "API Endpoint type, one of UnknownApiEndpointType, Dme, Controller",
+	"data.flowratelimitsettings:#.key.ratelimitkey.ratelimittarget":                   "Target to rate limit, one of UnknownTarget, AllRequests, PerIp, PerUser",
+	"data.flowratelimitsettings:#.settings.flowalgorithm":                             "Flow Rate Limit algorithm, one of UnknownFlowAlgorithm, TokenBucketAlgorithm, LeakyBucketAlgorithm",
+	"data.flowratelimitsettings:#.settings.reqspersecond":                             "Requests per second for flow rate limiting",
+	"data.flowratelimitsettings:#.settings.burstsize":                                 "Burst size for flow rate limiting (required for TokenBucketAlgorithm)",
+	"data.maxreqsratelimitsettings:#.fields":                                          "Fields are used for the Update API to specify which fields to apply",
+	"data.maxreqsratelimitsettings:#.key.maxreqssettingsname":                         "Unique name for MaxReqsRateLimitSettings (there can be multiple MaxReqsSettings per RateLimitSettingsKey)",
+	"data.maxreqsratelimitsettings:#.key.ratelimitkey.apiname":                        "Name of API (eg. CreateApp or RegisterClient) (Use Global if not a specific API)",
+	"data.maxreqsratelimitsettings:#.key.ratelimitkey.apiendpointtype":                "API Endpoint type, one of UnknownApiEndpointType, Dme, Controller",
+	"data.maxreqsratelimitsettings:#.key.ratelimitkey.ratelimittarget":                "Target to rate limit, one of UnknownTarget, AllRequests, PerIp, PerUser",
+	"data.maxreqsratelimitsettings:#.settings.maxreqsalgorithm":                       "MaxReqs Rate Limit Algorithm, one of UnknownMaxReqsAlgorithm, FixedWindowAlgorithm",
+	"data.maxreqsratelimitsettings:#.settings.maxrequests":                            "Maximum number of requests for the given Interval",
+	"data.maxreqsratelimitsettings:#.settings.interval":                               "Time interval",
+	"data.trustpolicyexceptions:#.fields":                                             "Fields are used for the Update API to specify which fields to apply",
+	"data.trustpolicyexceptions:#.key.appkey.organization":                            "App developer organization",
+	"data.trustpolicyexceptions:#.key.appkey.name":                                    "App name",
+	"data.trustpolicyexceptions:#.key.appkey.version":                                 "App version",
+	"data.trustpolicyexceptions:#.key.zonepoolkey.organization":                       "Name of the organization this pool belongs to",
+	"data.trustpolicyexceptions:#.key.zonepoolkey.name":                               "ZonePool Name",
+	"data.trustpolicyexceptions:#.key.name":                                           "TrustPolicyExceptionKey name",
+	"data.trustpolicyexceptions:#.state":                                              "State of the exception within the approval process, one of Unknown, ApprovalRequested, Active, Rejected",
+	"data.trustpolicyexceptions:#.outboundsecurityrules:#.protocol":                   "TCP, UDP, ICMP",
+	"data.trustpolicyexceptions:#.outboundsecurityrules:#.portrangemin":               "TCP or UDP port range start",
+	"data.trustpolicyexceptions:#.outboundsecurityrules:#.portrangemax":               "TCP or UDP port range end",
+	"data.trustpolicyexceptions:#.outboundsecurityrules:#.remotecidr":                 "Remote CIDR X.X.X.X/X for IPv4 or e.g. XXXX:XXXX::XXXX/XX for IPv6",
+	"internalentries:#.dbkey":                                                         "Database key, without the region prefix",
+	"internalentries:#.dbvalue":                                                       "Database value",
+}
+var DataSnapshotSpecialArgs = map[string]string{
+	"data.alertpolicies:#.annotations":                                       "StringToString",
+	"data.alertpolicies:#.fields":                                            "StringArray",
+	"data.alertpolicies:#.labels":                                            "StringToString",
+	"data.appinstances:#.annotations":                                        "StringToString",
+	"data.appinstances:#.errors":                                             "StringArray",
+	"data.appinstances:#.fields":                                             "StringArray",
+	"data.appinstances:#.internalporttolbip":                                 "StringToString",
+	"data.appinstances:#.kubernetesresources.cpupool.topology.minnodeoptres": "StringToString",
+	"data.appinstances:#.kubernetesresources.cpupool.totaloptres":            "StringToString",
+	"data.appinstances:#.kubernetesresources.gpupool.topology.minnodeoptres": "StringToString",
+	"data.appinstances:#.kubernetesresources.gpupool.totaloptres":            "StringToString",
+	"data.appinstances:#.noderesources.optresmap":                            "StringToString",
+	"data.appinstances:#.runtimeinfo.containerids":                           "StringArray",
+	"data.appinstances:#.tags":                                               "StringToString",
+	"data.apps:#.alertpolicies":                                              "StringArray",
+	"data.apps:#.appannotations":                                             "StringToString",
+	"data.apps:#.autoprovpolicies":                                           "StringArray",
+	"data.apps:#.commandargs":                                                "StringArray",
+	"data.apps:#.envvars":                                                    "StringToString",
+	"data.apps:#.fields":                                                     "StringArray",
+	"data.apps:#.kubernetesresources.cpupool.topology.minnodeoptres":         "StringToString",
+	"data.apps:#.kubernetesresources.cpupool.totaloptres":                    "StringToString",
+	"data.apps:#.kubernetesresources.gpupool.topology.minnodeoptres":         "StringToString",
+	"data.apps:#.kubernetesresources.gpupool.totaloptres":                    "StringToString",
+	"data.apps:#.noderesources.optresmap":                                    "StringToString",
+	"data.apps:#.secretenvvars":                                              "StringToString",
+	"data.apps:#.tags":                                                       "StringToString",
+	"data.autoprovpolicies:#.fields":                                         "StringArray",
+	"data.autoscalepolicies:#.fields":                                        "StringArray",
+	"data.cloudletinfos:#.errors":                                            "StringArray",
+	"data.cloudletinfos:#.fields":                                            "StringArray",
+	"data.cloudletinfos:#.flavors:#.propmap":                                 "StringToString",
+	"data.cloudletinfos:#.nodepools:#.noderesources.optresmap":               "StringToString",
+	"data.cloudletinfos:#.properties":                                        "StringToString",
+	"data.cloudletinfos:#.status.msgs":                                       "StringArray",
+	"data.cloudlets:#.accessvars":                                            "StringToString",
+	"data.cloudlets:#.allianceorgs":                                          "StringArray",
+	"data.cloudlets:#.annotations":                                           "StringToString",
+	"data.cloudlets:#.config.envvar":                                         "StringToString",
+	"data.cloudlets:#.envvar":                                                "StringToString",
+	"data.cloudlets:#.errors":                                                "StringArray",
+	"data.cloudlets:#.fields":                                                "StringArray",
+	"data.cloudlets:#.gpuconfig.properties":                                  "StringToString",
+	"data.cloudlets:#.infraflavors:#.propmap":                                "StringToString",
+	"data.clusterinsts:#.annotations":                                        "StringToString",
+	"data.clusterinsts:#.errors":                                             "StringArray",
+	"data.clusterinsts:#.fields":                                             "StringArray",
+	"data.clusterinsts:#.infraannotations":                                   "StringToString",
+	"data.clusterinsts:#.networks":                                           "StringArray",
+	"data.clusterinsts:#.nodepools:#.noderesources.optresmap":                "StringToString",
+	"data.clusterinsts:#.noderesources.optresmap":                            "StringToString",
+	"data.clusterinsts:#.tags":                                               "StringToString",
+	"data.flavors:#.fields":                                                  "StringArray",
+	"data.flavors:#.optresmap":                                               "StringToString",
+	"data.flowratelimitsettings:#.fields":                                    "StringArray",
+	"data.geofencepolicies:#.fences:#.countrycodes":                          "StringArray",
+	"data.geofencepolicies:#.fields":                                         "StringArray",
+	"data.gpudrivers:#.fields":                                               "StringArray",
+	"data.gpudrivers:#.properties":                                           "StringToString",
+	"data.maxreqsratelimitsettings:#.fields":                                 "StringArray",
+	"data.networks:#.fields":                                                 "StringArray",
+	"data.restagtables:#.fields":                                             "StringArray",
+	"data.restagtables:#.tags":                                               "StringToString",
+	"data.settings.fields":                                                   "StringArray",
+	"data.trustpolicies:#.fields":                                            "StringArray",
+	"data.trustpolicyexceptions:#.fields":                                    "StringArray",
+	"data.vmpools:#.errors":                                                  "StringArray",
+	"data.vmpools:#.fields":                                                  "StringArray",
+	"data.vmpools:#.vms:#.flavor.propmap":                                    "StringToString",
+	"data.zonepools:#.fields":                                                "StringArray",
+	"data.zones:#.fields":                                                    "StringArray",
+	"data.zones:#.infraflavors:#.propmap":                                    "StringToString",
+}
@@ -347,11 +347,89 @@ func ShowTrustPolicys(c *cli.Command, data []edgeproto.TrustPolicy, err *error)
 	}
 }
 
+var ShowTrustPolicyHistoryCmd = &cli.Command{
+	Use:          "ShowTrustPolicyHistory",
+	RequiredArgs: strings.Join(TrustPolicyRequiredArgs, " "),
+	OptionalArgs: strings.Join(TrustPolicyOptionalArgs, " "),
+	AliasArgs:    strings.Join(TrustPolicyAliasArgs, " "),
+	SpecialArgs:  &TrustPolicySpecialArgs,
+	Comments:     TrustPolicyComments,
+	ReqData:      &edgeproto.TrustPolicy{},
+	ReplyData:    &edgeproto.TrustPolicyHistory{},
+	Run:          runShowTrustPolicyHistory,
+}
+
+func runShowTrustPolicyHistory(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.TrustPolicy)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return ShowTrustPolicyHistory(c, obj)
+}
+
+func ShowTrustPolicyHistory(c *cli.Command, in *edgeproto.TrustPolicy) error {
+	if TrustPolicyApiCmd == nil {
+		return fmt.Errorf("TrustPolicyApi client not initialized")
+	}
+	ctx := context.Background()
+	stream, err := TrustPolicyApiCmd.ShowTrustPolicyHistory(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("ShowTrustPolicyHistory failed: %s", errstr)
+	}
+
+	objs := make([]*edgeproto.TrustPolicyHistory, 0)
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errstr := err.Error()
+			st, ok := status.FromError(err)
+			if ok {
+				errstr = st.Message()
+			}
+			return fmt.Errorf("ShowTrustPolicyHistory recv failed: %s", errstr)
+		}
+		objs = append(objs, obj)
+	}
+	if len(objs) == 0 {
+		return nil
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), objs, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func ShowTrustPolicyHistorys(c *cli.Command, data []edgeproto.TrustPolicy, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("ShowTrustPolicyHistory %v\n", data[ii])
+		myerr := ShowTrustPolicyHistory(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
 var TrustPolicyApiCmds = []*cobra.Command{
 	CreateTrustPolicyCmd.GenCmd(),
 	DeleteTrustPolicyCmd.GenCmd(),
 	UpdateTrustPolicyCmd.GenCmd(),
 	ShowTrustPolicyCmd.GenCmd(),
+	ShowTrustPolicyHistoryCmd.GenCmd(),
 }
 
 var SecurityRuleRequiredArgs = []string{}
@@ -398,3 +476,30 @@ var TrustPolicyComments = map[string]string{
 var TrustPolicySpecialArgs = map[string]string{
 	"fields": "StringArray",
 }
+var TrustPolicyHistoryRequiredArgs = []string{}
+var TrustPolicyHistoryOptionalArgs = []string{
+	"revision",
+	"obj.fields",
+	"obj.key.organization",
+	"obj.key.name",
+	"obj.outboundsecurityrules:#.protocol",
+	"obj.outboundsecurityrules:#.portrangemin",
+	"obj.outboundsecurityrules:#.portrangemax",
+	"obj.outboundsecurityrules:#.remotecidr",
+	"obj.deleteprepare",
+}
+var TrustPolicyHistoryAliasArgs = []string{}
+var TrustPolicyHistoryComments = map[string]string{
+	"revision":                             "Database revision of the change",
+	"obj.fields":                           "Fields are used for the Update API to specify which fields to apply",
+	"obj.key.organization":                 "Name of the organization for the cluster that this policy will apply to",
+	"obj.key.name":                         "Policy name",
+	"obj.outboundsecurityrules:#.protocol": "TCP, UDP, ICMP",
+	"obj.outboundsecurityrules:#.portrangemin": "TCP or UDP port range start",
+	"obj.outboundsecurityrules:#.portrangemax": "TCP or UDP port range end",
+	"obj.outboundsecurityrules:#.remotecidr":   "Remote CIDR X.X.X.X/X for IPv4 or e.g. XXXX:XXXX::XXXX/XX for IPv6",
+	"obj.deleteprepare":                        "Preparing to be deleted",
+}
+var TrustPolicyHistorySpecialArgs = map[string]string{
+	"obj.fields": "StringArray",
+}
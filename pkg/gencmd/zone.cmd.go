@@ -50,6 +50,29 @@ func ZoneHideTags(in *edgeproto.Zone) {
 	}
 }
 
+func ZoneHistoryHideTags(in *edgeproto.ZoneHistory) {
+	if cli.HideTags == "" {
+		return
+	}
+	tags := make(map[string]struct{})
+	for _, tag := range strings.Split(cli.HideTags, ",") {
+		tags[tag] = struct{}{}
+	}
+	for i1 := 0; i1 < len(in.Obj.InfraFlavors); i1++ {
+		for i2 := 0; i2 < len(in.Obj.InfraFlavors[i1].Gpus); i2++ {
+		}
+	}
+	if _, found := tags["nocmp"]; found {
+		in.Obj.ObjId = ""
+	}
+	if _, found := tags["timestamp"]; found {
+		in.Obj.CreatedAt = distributed_match_engine.Timestamp{}
+	}
+	if _, found := tags["timestamp"]; found {
+		in.Obj.UpdatedAt = distributed_match_engine.Timestamp{}
+	}
+}
+
 var ZoneApiCmd edgeproto.ZoneApiClient
 
 var CreateZoneCmd = &cli.Command{
@@ -301,6 +324,84 @@ func ShowZones(c *cli.Command, data []edgeproto.Zone, err *error) {
 	}
 }
 
+var ShowZoneHistoryCmd = &cli.Command{
+	Use:          "ShowZoneHistory",
+	RequiredArgs: strings.Join(ZoneRequiredArgs, " "),
+	OptionalArgs: strings.Join(ZoneOptionalArgs, " "),
+	AliasArgs:    strings.Join(ZoneAliasArgs, " "),
+	SpecialArgs:  &ZoneSpecialArgs,
+	Comments:     ZoneComments,
+	ReqData:      &edgeproto.Zone{},
+	ReplyData:    &edgeproto.ZoneHistory{},
+	Run:          runShowZoneHistory,
+}
+
+func runShowZoneHistory(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.Zone)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return ShowZoneHistory(c, obj)
+}
+
+func ShowZoneHistory(c *cli.Command, in *edgeproto.Zone) error {
+	if ZoneApiCmd == nil {
+		return fmt.Errorf("ZoneApi client not initialized")
+	}
+	ctx := context.Background()
+	stream, err := ZoneApiCmd.ShowZoneHistory(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("ShowZoneHistory failed: %s", errstr)
+	}
+
+	objs := make([]*edgeproto.ZoneHistory, 0)
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errstr := err.Error()
+			st, ok := status.FromError(err)
+			if ok {
+				errstr = st.Message()
+			}
+			return fmt.Errorf("ShowZoneHistory recv failed: %s", errstr)
+		}
+		ZoneHistoryHideTags(obj)
+		objs = append(objs, obj)
+	}
+	if len(objs) == 0 {
+		return nil
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), objs, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func ShowZoneHistorys(c *cli.Command, data []edgeproto.Zone, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("ShowZoneHistory %v\n", data[ii])
+		myerr := ShowZoneHistory(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
 var ShowZoneGPUsCmd = &cli.Command{
 	Use:          "ShowZoneGPUs",
 	OptionalArgs: strings.Join(append(ZoneRequiredArgs, ZoneOptionalArgs...), " "),
@@ -382,6 +483,7 @@ var ZoneApiCmds = []*cobra.Command{
 	DeleteZoneCmd.GenCmd(),
 	UpdateZoneCmd.GenCmd(),
 	ShowZoneCmd.GenCmd(),
+	ShowZoneHistoryCmd.GenCmd(),
 	ShowZoneGPUsCmd.GenCmd(),
 }
 
@@ -467,3 +569,69 @@ var ZoneGPUsComments = map[string]string{
 	"gpus:#.inuse":                  "Read-only indication of how many GPUs are in use by tenants for usage APIs",
 }
 var ZoneGPUsSpecialArgs = map[string]string{}
+var ZoneHistoryRequiredArgs = []string{}
+var ZoneHistoryOptionalArgs = []string{
+	"revision",
+	"obj.fields",
+	"obj.key.organization",
+	"obj.key.name",
+	"obj.key.federatedorganization",
+	"obj.description",
+	"obj.infraflavors:#.name",
+	"obj.infraflavors:#.vcpus",
+	"obj.infraflavors:#.ram",
+	"obj.infraflavors:#.disk",
+	"obj.infraflavors:#.gpus:#.modelid",
+	"obj.infraflavors:#.gpus:#.count",
+	"obj.infraflavors:#.gpus:#.vendor",
+	"obj.infraflavors:#.gpus:#.memory",
+	"obj.infraflavors:#.gpus:#.inuse",
+	"obj.infraflavors:#.propmap",
+	"obj.location.latitude",
+	"obj.location.longitude",
+	"obj.location.horizontalaccuracy",
+	"obj.location.verticalaccuracy",
+	"obj.location.altitude",
+	"obj.location.course",
+	"obj.location.speed",
+	"obj.location.timestamp",
+	"obj.objid",
+	"obj.deleteprepare",
+	"obj.createdat",
+	"obj.updatedat",
+}
+var ZoneHistoryAliasArgs = []string{}
+var ZoneHistoryComments = map[string]string{
+	"revision":                          "Database revision of the change",
+	"obj.fields":                        "Fields are used for the Update API to specify which fields to apply",
+	"obj.key.organization":              "Organization owner of the Zone",
+	"obj.key.name":                      "Name of the Zone",
+	"obj.key.federatedorganization":     "Federated operator organization who shared this Zone",
+	"obj.description":                   "Description of Zone",
+	"obj.infraflavors:#.name":           "Name of the infra flavor",
+	"obj.infraflavors:#.vcpus":          "Number of VCPU cores on the infra flavor",
+	"obj.infraflavors:#.ram":            "Ram in MB on the infra flavor",
+	"obj.infraflavors:#.disk":           "Amount of disk in GB on the infra flavor",
+	"obj.infraflavors:#.gpus:#.modelid": "GPU model unique identifier",
+	"obj.infraflavors:#.gpus:#.count":   "Count of how many of this GPU are required/present",
+	"obj.infraflavors:#.gpus:#.vendor":  "GPU vendor (nvidia, amd, etc)",
+	"obj.infraflavors:#.gpus:#.memory":  "Memory in GB",
+	"obj.infraflavors:#.gpus:#.inuse":   "Read-only indication of how many GPUs are in use by tenants for usage APIs",
+	"obj.infraflavors:#.propmap":        "Infra flavor Properties, if any",
+	"obj.location.latitude":             "Latitude in WGS 84 coordinates",
+	"obj.location.longitude":            "Longitude in WGS 84 coordinates",
+	"obj.location.horizontalaccuracy":   "Horizontal accuracy (radius in meters)",
+	"obj.location.verticalaccuracy":     "Vertical accuracy (meters)",
+	"obj.location.altitude":             "On android only lat and long are guaranteed to be supplied Altitude in meters",
+	"obj.location.course":               "Course (IOS) / bearing (Android) (degrees east relative to true north)",
+	"obj.location.speed":                "Speed (IOS) / velocity (Android) (meters/sec)",
+	"obj.location.timestamp":            "Timestamp",
+	"obj.objid":                         "Universally unique object ID",
+	"obj.deleteprepare":                 "Preparing to be deleted",
+	"obj.createdat":                     "Created at time",
+	"obj.updatedat":                     "Updated at time",
+}
+var ZoneHistorySpecialArgs = map[string]string{
+	"obj.fields":                 "StringArray",
+	"obj.infraflavors:#.propmap": "StringToString",
+}
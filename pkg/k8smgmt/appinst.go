@@ -27,6 +27,7 @@ import (
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
 	"github.com/edgexr/edge-cloud-platform/pkg/platform"
 	"github.com/edgexr/edge-cloud-platform/pkg/platform/pc"
+	"github.com/edgexr/edge-cloud-platform/pkg/resspec"
 	ssh "github.com/edgexr/golang-ssh"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
@@ -454,7 +455,20 @@ func GenerateAppInstPolicyManifest(ctx context.Context, names *KubeNames, app *e
 		// does not define resource limits will not be allowed
 		// to be deployed. We can evaluate later if it should
 		// also be applied in a non-multi-tenant context.
-		rq, err := GetResourceQuota(ctx, names, appInst.KubernetesResources)
+		kr := appInst.KubernetesResources
+		if app.ReplicaAutoScalePolicy != nil && kr != nil {
+			// resources are per replica
+			kr, err = resspec.ScaleKubernetesResources(kr, app.ReplicaAutoScalePolicy.MaxReplicas)
+			if err != nil {
+				return "", err
+			}
+		} else if appInst.Replicas > 0 && kr != nil {
+			kr, err = resspec.ScaleKubernetesResources(kr, uint32(appInst.Replicas))
+			if err != nil {
+				return "", err
+			}
+		}
+		rq, err := GetResourceQuota(ctx, names, kr)
 		if err != nil {
 			return "", err
 		}
@@ -583,16 +597,19 @@ func GetAppInstRuntime(ctx context.Context, client ssh.Client, names *KubeNames,
 	for ii, _ := range objs {
 		name = ""
 		namespace := ""
+		hasReplicas := false
 		switch obj := objs[ii].(type) {
 		case *appsv1.Deployment:
 			name = obj.ObjectMeta.Name
 			namespace = obj.ObjectMeta.Namespace
+			hasReplicas = true
 		case *appsv1.DaemonSet:
 			name = obj.ObjectMeta.Name
 			namespace = obj.ObjectMeta.Namespace
 		case *appsv1.StatefulSet:
 			name = obj.ObjectMeta.Name
 			namespace = obj.ObjectMeta.Namespace
+			hasReplicas = true
 		}
 		if name == "" {
 			continue
@@ -623,6 +640,9 @@ func GetAppInstRuntime(ctx context.Context, client ssh.Client, names *KubeNames,
 			if podName == "" {
 				continue
 			}
+			if hasReplicas {
+				rt.Replicas++
+			}
 			cmd = fmt.Sprintf("kubectl %s get pod %s -n %s -o json | jq -r '.spec.containers[] | .name'",
 				kconfArg, podName, namespace)
 			out, err = client.Output(cmd)
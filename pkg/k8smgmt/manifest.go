@@ -233,9 +233,112 @@ func addResourceLimits(template *v1.PodTemplateSpec, kr *edgeproto.KubernetesRes
 	return nil
 }
 
+func addResourceRequests(template *v1.PodTemplateSpec, kr *edgeproto.KubernetesResources) error {
+	res, err := getKubernetesResourceQuants(kr)
+	if err != nil {
+		return err
+	}
+	for j, _ := range template.Spec.Containers {
+		resources := &template.Spec.Containers[j].Resources
+		if len(resources.Requests) > 0 {
+			continue
+		}
+		resources.Requests = v1.ResourceList{}
+		resources.Requests[v1.ResourceCPU] = res.VCPUs
+		resources.Requests[v1.ResourceMemory] = res.Memory
+	}
+	return nil
+}
+
+type horizontalPodAutoscalerArgs struct {
+	Labels                 map[string]string
+	Name                   string
+	Namespace              string
+	Kind                   string
+	MinReplicas            uint32
+	MaxReplicas            uint32
+	TargetCPU              uint32
+	TargetMem              uint32
+	StabilizationWindowSec uint32
+}
+
+var k8sHorizontalPodAutoscalerTemplate = template.Must(template.New("hpa").Parse(`apiVersion: autoscaling/v2
+kind: HorizontalPodAutoscaler
+metadata:
+{{- if .Labels }}
+  labels:
+{{- range $key, $value := .Labels }}
+    {{ $key }}: {{ $value }}
+{{- end }}
+{{- end }}
+  name: {{ .Name }}
+{{- if .Namespace }}
+  namespace: {{ .Namespace }}
+{{- end }}
+spec:
+  scaleTargetRef:
+    apiVersion: apps/v1
+    kind: {{ .Kind }}
+    name: {{ .Name }}
+  minReplicas: {{ .MinReplicas }}
+  maxReplicas: {{ .MaxReplicas }}
+  metrics:
+{{- if .TargetCPU }}
+  - type: Resource
+    resource:
+      name: cpu
+      target:
+        type: Utilization
+        averageUtilization: {{ .TargetCPU }}
+{{- end }}
+{{- if .TargetMem }}
+  - type: Resource
+    resource:
+      name: memory
+      target:
+        type: Utilization
+        averageUtilization: {{ .TargetMem }}
+{{- end }}
+  behavior:
+    scaleDown:
+      stabilizationWindowSeconds: {{ .StabilizationWindowSec }}
+`))
+
+// getHorizontalPodAutoscaler returns a HorizontalPodAutoscaler for the
+// target object if the App is auto-scaled by cpu or memory utilization.
+func getHorizontalPodAutoscaler(app *edgeproto.App, names *KubeNames, kind string, target *metav1.ObjectMeta) (string, error) {
+	policy := app.ReplicaAutoScalePolicy
+	if policy == nil || !policy.UsesHorizontalPodAutoscaler() {
+		return "", nil
+	}
+	args := horizontalPodAutoscalerArgs{
+		Labels: map[string]string{
+			ConfigLabel: getConfigLabel(names),
+		},
+		Name:                   target.Name,
+		Namespace:              target.Namespace,
+		Kind:                   kind,
+		MinReplicas:            policy.MinReplicas,
+		MaxReplicas:            policy.MaxReplicas,
+		TargetCPU:              policy.TargetCpu,
+		TargetMem:              policy.TargetMem,
+		StabilizationWindowSec: policy.StabilizationWindowSec,
+	}
+	buf := bytes.Buffer{}
+	err := k8sHorizontalPodAutoscalerTemplate.Execute(&buf, &args)
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
 func GetAppEnvVars(ctx context.Context, app *edgeproto.App, authApi cloudcommon.RegistryAuthApi, deploymentVars *deployvars.DeploymentReplaceVars) (*[]v1.EnvVar, error) {
+	return getConfigsEnvVars(ctx, app, app.Configs, authApi, deploymentVars)
+}
+
+func getConfigsEnvVars(ctx context.Context, app *edgeproto.App, configs []*edgeproto.ConfigFile, authApi cloudcommon.RegistryAuthApi, deploymentVars *deployvars.DeploymentReplaceVars) (*[]v1.EnvVar, error) {
 	var envVars []v1.EnvVar
-	for _, v := range app.Configs {
+	for _, v := range configs {
 		if v.Kind == edgeproto.AppConfigEnvYaml {
 			var curVars []v1.EnvVar
 			cfg, err := cloudcommon.GetDeploymentManifest(ctx, authApi, v.Config)
@@ -270,7 +373,11 @@ func MergeEnvVars(ctx context.Context, accessApi platform.AccessApi, app *edgepr
 
 	deploymentVars, varsFound := ctx.Value(deployvars.DeploymentReplaceVarsKey).(*deployvars.DeploymentReplaceVars)
 	log.SpanLog(ctx, log.DebugLevelInfra, "MergeEnvVars", "deploymentVars", deploymentVars, "varsFound", varsFound)
-	envVars, err := GetAppEnvVars(ctx, app, accessApi, deploymentVars)
+	// AppInst env vars are appended after the App env vars
+	// so that they take precedence.
+	configs := append([]*edgeproto.ConfigFile{}, app.Configs...)
+	configs = append(configs, appInst.Configs...)
+	envVars, err := getConfigsEnvVars(ctx, app, configs, accessApi, deploymentVars)
 	if err != nil {
 		return "", err
 	}
@@ -334,6 +441,7 @@ func MergeEnvVars(ctx context.Context, accessApi platform.AccessApi, app *edgepr
 	//walk the objects
 	var template *v1.PodTemplateSpec
 	var name string
+	hpaMf := ""
 	for i, _ := range objs {
 		// convert obj to generic metaObject to set labels on every obj
 		var metaObj metav1.Object
@@ -358,14 +466,24 @@ func MergeEnvVars(ctx context.Context, accessApi platform.AccessApi, app *edgepr
 		case *appsv1.Deployment:
 			template = &obj.Spec.Template
 			name = obj.ObjectMeta.Name
-			obj.Spec.Replicas = getDefaultReplicas(app, names, *obj.Spec.Replicas)
+			obj.Spec.Replicas = getDefaultReplicas(app, appInst, names, obj.Spec.Replicas)
+			hpa, err := getHorizontalPodAutoscaler(app, names, "Deployment", &obj.ObjectMeta)
+			if err != nil {
+				return "", err
+			}
+			hpaMf = AddManifest(hpaMf, hpa)
 		case *appsv1.DaemonSet:
 			template = &obj.Spec.Template
 			name = obj.ObjectMeta.Name
 		case *appsv1.StatefulSet:
 			template = &obj.Spec.Template
 			name = obj.ObjectMeta.Name
-			obj.Spec.Replicas = getDefaultReplicas(app, names, *obj.Spec.Replicas)
+			obj.Spec.Replicas = getDefaultReplicas(app, appInst, names, obj.Spec.Replicas)
+			hpa, err := getHorizontalPodAutoscaler(app, names, "StatefulSet", &obj.ObjectMeta)
+			if err != nil {
+				return "", err
+			}
+			hpaMf = AddManifest(hpaMf, hpa)
 		}
 		if template == nil {
 			continue
@@ -381,6 +499,15 @@ func MergeEnvVars(ctx context.Context, accessApi platform.AccessApi, app *edgepr
 			if err := addResourceLimits(template, appInst.KubernetesResources); err != nil {
 				return "", err
 			}
+		} else if app.ReplicaAutoScalePolicy != nil && app.ReplicaAutoScalePolicy.UsesHorizontalPodAutoscaler() {
+			// utilization targets are relative to the requested
+			// resources, so set the requests for manifests generated
+			// by our deployment generator.
+			if _, ok := template.ObjectMeta.Labels[deploygen.MexDeployGenLabel]; ok {
+				if err := addResourceRequests(template, kr); err != nil {
+					return "", err
+				}
+			}
 		}
 		gpuCountsByVendor := map[string]int{}
 		if kr.GpuPool != nil {
@@ -427,7 +554,7 @@ func MergeEnvVars(ctx context.Context, accessApi platform.AccessApi, app *edgepr
 	if err != nil {
 		return "", err
 	}
-	return mf, nil
+	return AddManifest(mf, hpaMf), nil
 }
 
 func AddManifest(mf, addmf string) string {
@@ -440,14 +567,32 @@ func AddManifest(mf, addmf string) string {
 	return mf + "---\n" + addmf
 }
 
-func getDefaultReplicas(app *edgeproto.App, names *KubeNames, curReplica int32) *int32 {
+func getDefaultReplicas(app *edgeproto.App, appInst *edgeproto.AppInst, names *KubeNames, curReplica *int32) *int32 {
+	if policy := app.ReplicaAutoScalePolicy; policy != nil {
+		if policy.UsesHorizontalPodAutoscaler() {
+			// replicas are managed by the HorizontalPodAutoscaler,
+			// leave unset so re-applying the manifest does not
+			// reset them.
+			return nil
+		}
+		val := int32(policy.MinReplicas)
+		// replicas are set on the AppInst by the auto-scaler
+		if appInst.Replicas > 0 {
+			val = appInst.Replicas
+		}
+		return &val
+	}
 	val := int32(1)
-	if curReplica != 0 {
-		val = curReplica
+	if curReplica != nil && *curReplica != 0 {
+		val = *curReplica
 	}
 	if names.InstanceNamespace != "" && app.ServerlessConfig != nil {
 		val = int32(app.ServerlessConfig.MinReplicas)
 	}
+	// replicas specified on the AppInst override the manifest
+	if appInst.Replicas > 0 {
+		val = appInst.Replicas
+	}
 	return &val
 }
 
@@ -25,6 +25,9 @@ import (
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
 	"github.com/edgexr/edge-cloud-platform/test/testutil"
 	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/api/core/v1"
 )
 
 func TestGenerateAppInstManifest(t *testing.T) {
@@ -543,6 +546,94 @@ func TestImagePullSecrets(t *testing.T) {
 	require.Equal(t, expectedDeploymentManifest, newMf)
 }
 
+func TestReplicaAutoScaleManifest(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelEtcd | log.DebugLevelApi | log.DebugLevelNotify)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	appInst := &testutil.AppInstData()[0]
+	app := &testutil.AppData()[0]
+	app.Deployment = cloudcommon.DeploymentTypeKubernetes
+	app.DeploymentGenerator = ""
+	app.ReplicaAutoScalePolicy = &edgeproto.ReplicaAutoScalePolicy{
+		MinReplicas:            2,
+		MaxReplicas:            5,
+		TargetCpu:              70,
+		StabilizationWindowSec: 300,
+	}
+	baseMf, err := cloudcommon.GetAppDeploymentManifest(ctx, nil, app)
+	require.Nil(t, err)
+	require.Contains(t, baseMf, "  replicas: 2\n")
+	app.DeploymentManifest = baseMf
+	app.CompatibilityVersion = cloudcommon.GetAppCompatibilityVersion()
+	appInst.KubernetesResources = &edgeproto.KubernetesResources{
+		CpuPool: &edgeproto.NodePoolResources{
+			TotalVcpus:  *edgeproto.NewUdec64(1, 500*edgeproto.DecMillis),
+			TotalMemory: 1024,
+		},
+	}
+	appInst.CompatibilityVersion = cloudcommon.GetAppInstCompatibilityVersion()
+	names, err := GetKubeNames(&edgeproto.ClusterInst{}, app, appInst)
+	require.Nil(t, err)
+
+	// cpu target is scaled by a HorizontalPodAutoscaler
+	mf, err := GenerateAppInstManifest(ctx, &accessapi.TestHandler{}, names, app, appInst)
+	require.Nil(t, err)
+	objs, _, err := cloudcommon.DecodeK8SYaml(mf)
+	require.Nil(t, err)
+	var dep *appsv1.Deployment
+	var hpa *autoscalingv2.HorizontalPodAutoscaler
+	for _, obj := range objs {
+		switch v := obj.(type) {
+		case *appsv1.Deployment:
+			dep = v
+		case *autoscalingv2.HorizontalPodAutoscaler:
+			hpa = v
+		}
+	}
+	require.NotNil(t, dep)
+	require.Nil(t, dep.Spec.Replicas)
+	requests := dep.Spec.Template.Spec.Containers[0].Resources.Requests
+	require.Equal(t, "1500m", requests.Cpu().String())
+	require.Equal(t, "1Gi", requests.Memory().String())
+	require.NotNil(t, hpa)
+	require.Equal(t, dep.Name, hpa.Name)
+	require.Equal(t, getConfigLabel(names), hpa.Labels[ConfigLabel])
+	require.Equal(t, "Deployment", hpa.Spec.ScaleTargetRef.Kind)
+	require.Equal(t, dep.Name, hpa.Spec.ScaleTargetRef.Name)
+	require.Equal(t, int32(2), *hpa.Spec.MinReplicas)
+	require.Equal(t, int32(5), hpa.Spec.MaxReplicas)
+	require.Equal(t, 1, len(hpa.Spec.Metrics))
+	require.Equal(t, v1.ResourceCPU, hpa.Spec.Metrics[0].Resource.Name)
+	require.Equal(t, int32(70), *hpa.Spec.Metrics[0].Resource.Target.AverageUtilization)
+	require.Equal(t, int32(300), *hpa.Spec.Behavior.ScaleDown.StabilizationWindowSeconds)
+
+	// resource quota allows for the max replicas
+	names.MultiTenantRestricted = true
+	mf, err = GenerateAppInstPolicyManifest(ctx, names, app, appInst)
+	require.Nil(t, err)
+	require.Contains(t, mf, `limits.cpu: "7500m"`)
+	require.Contains(t, mf, "limits.memory: 5Gi")
+	names.MultiTenantRestricted = false
+
+	// active connections target is scaled by setting the
+	// AppInst replicas
+	app.ReplicaAutoScalePolicy = &edgeproto.ReplicaAutoScalePolicy{
+		MinReplicas:             2,
+		MaxReplicas:             5,
+		TargetActiveConnections: 100,
+	}
+	mf, err = GenerateAppInstManifest(ctx, &accessapi.TestHandler{}, names, app, appInst)
+	require.Nil(t, err)
+	require.NotContains(t, mf, "HorizontalPodAutoscaler")
+	require.Contains(t, mf, "  replicas: 2\n")
+	appInst.Replicas = 3
+	mf, err = GenerateAppInstManifest(ctx, &accessapi.TestHandler{}, names, app, appInst)
+	require.Nil(t, err)
+	require.Contains(t, mf, "  replicas: 3\n")
+}
+
 func TestNames(t *testing.T) {
 	// CleanupClusterConfig requires that we can get names for just
 	// the ClusterInst without any data in App or AppInst
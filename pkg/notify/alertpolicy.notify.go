@@ -5,6 +5,7 @@ package notify
 
 import (
 	"context"
+	"encoding/json"
 	fmt "fmt"
 	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
@@ -49,6 +50,7 @@ type AlertPolicySend struct {
 	handler     SendAlertPolicyHandler
 	Keys        map[edgeproto.AlertPolicyKey]AlertPolicySendContext
 	keysToSend  map[edgeproto.AlertPolicyKey]AlertPolicySendContext
+	syncDeletes map[edgeproto.AlertPolicyKey]AlertPolicySyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.AlertPolicy
@@ -62,6 +64,11 @@ type AlertPolicySendContext struct {
 	forceDelete bool
 }
 
+type AlertPolicySyncDelete struct {
+	obj    *edgeproto.AlertPolicy
+	modRev int64
+}
+
 func NewAlertPolicySend(handler SendAlertPolicyHandler) *AlertPolicySend {
 	send := &AlertPolicySend{}
 	send.Name = "AlertPolicy"
@@ -96,6 +103,9 @@ func (s *AlertPolicySend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.AlertPolicy, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = AlertPolicySendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -104,6 +114,64 @@ func (s *AlertPolicySend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *AlertPolicySend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.AlertPolicy{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted AlertPolicy", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.AlertPolicyKey]AlertPolicySyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = AlertPolicySyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *AlertPolicySend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.AlertPolicyKey]AlertPolicySyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.AlertPolicy
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = AlertPolicySendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *AlertPolicySend) Update(ctx context.Context, obj *edgeproto.AlertPolicy, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
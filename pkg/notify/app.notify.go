@@ -5,6 +5,7 @@ package notify
 
 import (
 	"context"
+	"encoding/json"
 	fmt "fmt"
 	_ "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
 	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
@@ -50,6 +51,7 @@ type AppSend struct {
 	handler     SendAppHandler
 	Keys        map[edgeproto.AppKey]AppSendContext
 	keysToSend  map[edgeproto.AppKey]AppSendContext
+	syncDeletes map[edgeproto.AppKey]AppSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.App
@@ -63,6 +65,11 @@ type AppSendContext struct {
 	forceDelete bool
 }
 
+type AppSyncDelete struct {
+	obj    *edgeproto.App
+	modRev int64
+}
+
 func NewAppSend(handler SendAppHandler) *AppSend {
 	send := &AppSend{}
 	send.Name = "App"
@@ -100,6 +107,9 @@ func (s *AppSend) UpdateAll(ctx context.Context) {
 		if !s.UpdateAllOkLocked(obj) { // to be implemented by hand
 			return
 		}
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = AppSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -108,6 +118,67 @@ func (s *AppSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *AppSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.App{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted App", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.AppKey]AppSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = AppSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *AppSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.AppKey]AppSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.App
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		if !s.UpdateOk(ctx, del.obj) { // to be implemented by hand
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = AppSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *AppSend) Update(ctx context.Context, obj *edgeproto.App, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
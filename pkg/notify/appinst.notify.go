@@ -5,6 +5,7 @@ package notify
 
 import (
 	"context"
+	"encoding/json"
 	fmt "fmt"
 	_ "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
 	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
@@ -51,6 +52,7 @@ type AppInstSend struct {
 	handler     SendAppInstHandler
 	Keys        map[edgeproto.AppInstKey]AppInstSendContext
 	keysToSend  map[edgeproto.AppInstKey]AppInstSendContext
+	syncDeletes map[edgeproto.AppInstKey]AppInstSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.AppInst
@@ -64,6 +66,11 @@ type AppInstSendContext struct {
 	forceDelete bool
 }
 
+type AppInstSyncDelete struct {
+	obj    *edgeproto.AppInst
+	modRev int64
+}
+
 func NewAppInstSend(handler SendAppInstHandler) *AppInstSend {
 	send := &AppInstSend{}
 	send.Name = "AppInst"
@@ -101,6 +108,9 @@ func (s *AppInstSend) UpdateAll(ctx context.Context) {
 		if !s.UpdateAllOkLocked(obj) { // to be implemented by hand
 			return
 		}
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = AppInstSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -109,6 +119,67 @@ func (s *AppInstSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *AppInstSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.AppInst{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted AppInst", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.AppInstKey]AppInstSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = AppInstSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *AppInstSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.AppInstKey]AppInstSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.AppInst
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		if !s.UpdateOk(ctx, del.obj) { // to be implemented by hand
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = AppInstSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *AppInstSend) Update(ctx context.Context, obj *edgeproto.AppInst, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -147,6 +218,9 @@ func (s *AppInstSend) SendForCloudlet(ctx context.Context, action edgeproto.Noti
 	})
 	for k, data := range keys {
 		if action == edgeproto.NoticeAction_UPDATE {
+			if s.sendrecv.isSendSynced(s.MessageName, data.ModRev) {
+				continue
+			}
 			s.Update(ctx, data.Obj, data.ModRev)
 		} else if action == edgeproto.NoticeAction_DELETE {
 			s.ForceDelete(ctx, &k, data.ModRev)
@@ -415,6 +489,7 @@ type AppInstInfoSend struct {
 	handler     SendAppInstInfoHandler
 	Keys        map[edgeproto.AppInstKey]AppInstInfoSendContext
 	keysToSend  map[edgeproto.AppInstKey]AppInstInfoSendContext
+	syncDeletes map[edgeproto.AppInstKey]AppInstInfoSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.AppInstInfo
@@ -428,6 +503,11 @@ type AppInstInfoSendContext struct {
 	forceDelete bool
 }
 
+type AppInstInfoSyncDelete struct {
+	obj    *edgeproto.AppInstInfo
+	modRev int64
+}
+
 func NewAppInstInfoSend(handler SendAppInstInfoHandler) *AppInstInfoSend {
 	send := &AppInstInfoSend{}
 	send.Name = "AppInstInfo"
@@ -462,6 +542,9 @@ func (s *AppInstInfoSend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.AppInstInfo, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = AppInstInfoSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -470,6 +553,64 @@ func (s *AppInstInfoSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *AppInstInfoSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.AppInstInfo{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted AppInstInfo", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.AppInstKey]AppInstInfoSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = AppInstInfoSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *AppInstInfoSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.AppInstKey]AppInstInfoSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.AppInstInfo
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = AppInstInfoSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *AppInstInfoSend) Update(ctx context.Context, obj *edgeproto.AppInstInfo, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -789,6 +930,10 @@ func (s *FedAppInstEventSend) GetNotifyId() int64 {
 }
 func (s *FedAppInstEventSend) UpdateAll(ctx context.Context) {}
 
+func (s *FedAppInstEventSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {}
+
+func (s *FedAppInstEventSend) SendSyncDeletes(ctx context.Context) {}
+
 func (s *FedAppInstEventSend) Update(ctx context.Context, msg *edgeproto.FedAppInstEvent) bool {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return false
@@ -5,6 +5,7 @@ package notify
 
 import (
 	"context"
+	"encoding/json"
 	fmt "fmt"
 	_ "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
 	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
@@ -50,6 +51,7 @@ type AppInstClientKeySend struct {
 	handler     SendAppInstClientKeyHandler
 	Keys        map[edgeproto.AppInstClientKey]AppInstClientKeySendContext
 	keysToSend  map[edgeproto.AppInstClientKey]AppInstClientKeySendContext
+	syncDeletes map[edgeproto.AppInstClientKey]AppInstClientKeySyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.AppInstClientKey
@@ -63,6 +65,11 @@ type AppInstClientKeySendContext struct {
 	forceDelete bool
 }
 
+type AppInstClientKeySyncDelete struct {
+	obj    *edgeproto.AppInstClientKey
+	modRev int64
+}
+
 func NewAppInstClientKeySend(handler SendAppInstClientKeyHandler) *AppInstClientKeySend {
 	send := &AppInstClientKeySend{}
 	send.Name = "AppInstClientKey"
@@ -97,6 +104,9 @@ func (s *AppInstClientKeySend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.AppInstClientKey, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = AppInstClientKeySendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -105,6 +115,64 @@ func (s *AppInstClientKeySend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *AppInstClientKeySend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.AppInstClientKey{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted AppInstClientKey", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.AppInstClientKey]AppInstClientKeySyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = AppInstClientKeySyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *AppInstClientKeySend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.AppInstClientKey]AppInstClientKeySyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.AppInstClientKey
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = AppInstClientKeySendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *AppInstClientKeySend) Update(ctx context.Context, obj *edgeproto.AppInstClientKey, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -423,6 +491,10 @@ func (s *AppInstClientSend) GetNotifyId() int64 {
 }
 func (s *AppInstClientSend) UpdateAll(ctx context.Context) {}
 
+func (s *AppInstClientSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {}
+
+func (s *AppInstClientSend) SendSyncDeletes(ctx context.Context) {}
+
 func (s *AppInstClientSend) Update(ctx context.Context, msg *edgeproto.AppInstClient) bool {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return false
@@ -5,6 +5,7 @@ package notify
 
 import (
 	"context"
+	"encoding/json"
 	fmt "fmt"
 	_ "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
 	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
@@ -51,6 +52,7 @@ type AutoProvPolicySend struct {
 	handler     SendAutoProvPolicyHandler
 	Keys        map[edgeproto.PolicyKey]AutoProvPolicySendContext
 	keysToSend  map[edgeproto.PolicyKey]AutoProvPolicySendContext
+	syncDeletes map[edgeproto.PolicyKey]AutoProvPolicySyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.AutoProvPolicy
@@ -64,6 +66,11 @@ type AutoProvPolicySendContext struct {
 	forceDelete bool
 }
 
+type AutoProvPolicySyncDelete struct {
+	obj    *edgeproto.AutoProvPolicy
+	modRev int64
+}
+
 func NewAutoProvPolicySend(handler SendAutoProvPolicyHandler) *AutoProvPolicySend {
 	send := &AutoProvPolicySend{}
 	send.Name = "AutoProvPolicy"
@@ -98,6 +105,9 @@ func (s *AutoProvPolicySend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.AutoProvPolicy, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = AutoProvPolicySendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -106,6 +116,64 @@ func (s *AutoProvPolicySend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *AutoProvPolicySend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.AutoProvPolicy{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted AutoProvPolicy", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.PolicyKey]AutoProvPolicySyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = AutoProvPolicySyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *AutoProvPolicySend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.PolicyKey]AutoProvPolicySyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.AutoProvPolicy
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = AutoProvPolicySendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *AutoProvPolicySend) Update(ctx context.Context, obj *edgeproto.AutoProvPolicy, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -424,6 +492,10 @@ func (s *AutoProvCountsSend) GetNotifyId() int64 {
 }
 func (s *AutoProvCountsSend) UpdateAll(ctx context.Context) {}
 
+func (s *AutoProvCountsSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {}
+
+func (s *AutoProvCountsSend) SendSyncDeletes(ctx context.Context) {}
+
 func (s *AutoProvCountsSend) Update(ctx context.Context, msg *edgeproto.AutoProvCounts) bool {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return false
@@ -643,6 +715,7 @@ type AutoProvInfoSend struct {
 	handler     SendAutoProvInfoHandler
 	Keys        map[edgeproto.CloudletKey]AutoProvInfoSendContext
 	keysToSend  map[edgeproto.CloudletKey]AutoProvInfoSendContext
+	syncDeletes map[edgeproto.CloudletKey]AutoProvInfoSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.AutoProvInfo
@@ -656,6 +729,11 @@ type AutoProvInfoSendContext struct {
 	forceDelete bool
 }
 
+type AutoProvInfoSyncDelete struct {
+	obj    *edgeproto.AutoProvInfo
+	modRev int64
+}
+
 func NewAutoProvInfoSend(handler SendAutoProvInfoHandler) *AutoProvInfoSend {
 	send := &AutoProvInfoSend{}
 	send.Name = "AutoProvInfo"
@@ -690,6 +768,9 @@ func (s *AutoProvInfoSend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.AutoProvInfo, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = AutoProvInfoSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -698,6 +779,64 @@ func (s *AutoProvInfoSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *AutoProvInfoSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.AutoProvInfo{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted AutoProvInfo", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.CloudletKey]AutoProvInfoSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = AutoProvInfoSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *AutoProvInfoSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.CloudletKey]AutoProvInfoSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.AutoProvInfo
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = AutoProvInfoSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *AutoProvInfoSend) Update(ctx context.Context, obj *edgeproto.AutoProvInfo, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
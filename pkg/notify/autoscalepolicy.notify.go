@@ -5,6 +5,7 @@ package notify
 
 import (
 	"context"
+	"encoding/json"
 	fmt "fmt"
 	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
@@ -49,6 +50,7 @@ type AutoScalePolicySend struct {
 	handler     SendAutoScalePolicyHandler
 	Keys        map[edgeproto.PolicyKey]AutoScalePolicySendContext
 	keysToSend  map[edgeproto.PolicyKey]AutoScalePolicySendContext
+	syncDeletes map[edgeproto.PolicyKey]AutoScalePolicySyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.AutoScalePolicy
@@ -62,6 +64,11 @@ type AutoScalePolicySendContext struct {
 	forceDelete bool
 }
 
+type AutoScalePolicySyncDelete struct {
+	obj    *edgeproto.AutoScalePolicy
+	modRev int64
+}
+
 func NewAutoScalePolicySend(handler SendAutoScalePolicyHandler) *AutoScalePolicySend {
 	send := &AutoScalePolicySend{}
 	send.Name = "AutoScalePolicy"
@@ -96,6 +103,9 @@ func (s *AutoScalePolicySend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.AutoScalePolicy, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = AutoScalePolicySendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -104,6 +114,64 @@ func (s *AutoScalePolicySend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *AutoScalePolicySend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.AutoScalePolicy{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted AutoScalePolicy", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.PolicyKey]AutoScalePolicySyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = AutoScalePolicySyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *AutoScalePolicySend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.PolicyKey]AutoScalePolicySyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.AutoScalePolicy
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = AutoScalePolicySendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *AutoScalePolicySend) Update(ctx context.Context, obj *edgeproto.AutoScalePolicy, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
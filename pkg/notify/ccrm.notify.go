@@ -77,6 +77,10 @@ func (s *StreamStatusSend) GetNotifyId() int64 {
 }
 func (s *StreamStatusSend) UpdateAll(ctx context.Context) {}
 
+func (s *StreamStatusSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {}
+
+func (s *StreamStatusSend) SendSyncDeletes(ctx context.Context) {}
+
 func (s *StreamStatusSend) Update(ctx context.Context, msg *edgeproto.StreamStatus) bool {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return false
@@ -5,6 +5,7 @@ package notify
 
 import (
 	"context"
+	"encoding/json"
 	fmt "fmt"
 	_ "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
 	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
@@ -50,6 +51,7 @@ type CloudletInternalSend struct {
 	handler     SendCloudletInternalHandler
 	Keys        map[edgeproto.CloudletKey]CloudletInternalSendContext
 	keysToSend  map[edgeproto.CloudletKey]CloudletInternalSendContext
+	syncDeletes map[edgeproto.CloudletKey]CloudletInternalSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.CloudletInternal
@@ -63,6 +65,11 @@ type CloudletInternalSendContext struct {
 	forceDelete bool
 }
 
+type CloudletInternalSyncDelete struct {
+	obj    *edgeproto.CloudletInternal
+	modRev int64
+}
+
 func NewCloudletInternalSend(handler SendCloudletInternalHandler) *CloudletInternalSend {
 	send := &CloudletInternalSend{}
 	send.Name = "CloudletInternal"
@@ -97,6 +104,9 @@ func (s *CloudletInternalSend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.CloudletInternal, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = CloudletInternalSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -105,6 +115,64 @@ func (s *CloudletInternalSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *CloudletInternalSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.CloudletInternal{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted CloudletInternal", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.CloudletKey]CloudletInternalSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = CloudletInternalSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *CloudletInternalSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.CloudletKey]CloudletInternalSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.CloudletInternal
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = CloudletInternalSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *CloudletInternalSend) Update(ctx context.Context, obj *edgeproto.CloudletInternal, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -394,6 +462,7 @@ type PlatformFeaturesSend struct {
 	handler     SendPlatformFeaturesHandler
 	Keys        map[edgeproto.PlatformFeaturesKey]PlatformFeaturesSendContext
 	keysToSend  map[edgeproto.PlatformFeaturesKey]PlatformFeaturesSendContext
+	syncDeletes map[edgeproto.PlatformFeaturesKey]PlatformFeaturesSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.PlatformFeatures
@@ -407,6 +476,11 @@ type PlatformFeaturesSendContext struct {
 	forceDelete bool
 }
 
+type PlatformFeaturesSyncDelete struct {
+	obj    *edgeproto.PlatformFeatures
+	modRev int64
+}
+
 func NewPlatformFeaturesSend(handler SendPlatformFeaturesHandler) *PlatformFeaturesSend {
 	send := &PlatformFeaturesSend{}
 	send.Name = "PlatformFeatures"
@@ -441,6 +515,9 @@ func (s *PlatformFeaturesSend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.PlatformFeatures, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = PlatformFeaturesSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -449,6 +526,64 @@ func (s *PlatformFeaturesSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *PlatformFeaturesSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.PlatformFeatures{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted PlatformFeatures", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.PlatformFeaturesKey]PlatformFeaturesSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = PlatformFeaturesSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *PlatformFeaturesSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.PlatformFeaturesKey]PlatformFeaturesSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.PlatformFeatures
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = PlatformFeaturesSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *PlatformFeaturesSend) Update(ctx context.Context, obj *edgeproto.PlatformFeatures, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -739,6 +874,7 @@ type GPUDriverSend struct {
 	handler     SendGPUDriverHandler
 	Keys        map[edgeproto.GPUDriverKey]GPUDriverSendContext
 	keysToSend  map[edgeproto.GPUDriverKey]GPUDriverSendContext
+	syncDeletes map[edgeproto.GPUDriverKey]GPUDriverSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.GPUDriver
@@ -752,6 +888,11 @@ type GPUDriverSendContext struct {
 	forceDelete bool
 }
 
+type GPUDriverSyncDelete struct {
+	obj    *edgeproto.GPUDriver
+	modRev int64
+}
+
 func NewGPUDriverSend(handler SendGPUDriverHandler) *GPUDriverSend {
 	send := &GPUDriverSend{}
 	send.Name = "GPUDriver"
@@ -789,6 +930,9 @@ func (s *GPUDriverSend) UpdateAll(ctx context.Context) {
 		if !s.UpdateAllOkLocked(obj) { // to be implemented by hand
 			return
 		}
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = GPUDriverSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -797,6 +941,67 @@ func (s *GPUDriverSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *GPUDriverSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.GPUDriver{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted GPUDriver", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.GPUDriverKey]GPUDriverSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = GPUDriverSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *GPUDriverSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.GPUDriverKey]GPUDriverSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.GPUDriver
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		if !s.UpdateOk(ctx, del.obj) { // to be implemented by hand
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = GPUDriverSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *GPUDriverSend) Update(ctx context.Context, obj *edgeproto.GPUDriver, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -835,6 +1040,9 @@ func (s *GPUDriverSend) SendForCloudlet(ctx context.Context, action edgeproto.No
 	})
 	for k, data := range keys {
 		if action == edgeproto.NoticeAction_UPDATE {
+			if s.sendrecv.isSendSynced(s.MessageName, data.ModRev) {
+				continue
+			}
 			s.Update(ctx, data.Obj, data.ModRev)
 		} else if action == edgeproto.NoticeAction_DELETE {
 			s.ForceDelete(ctx, &k, data.ModRev)
@@ -1103,6 +1311,7 @@ type CloudletSend struct {
 	handler     SendCloudletHandler
 	Keys        map[edgeproto.CloudletKey]CloudletSendContext
 	keysToSend  map[edgeproto.CloudletKey]CloudletSendContext
+	syncDeletes map[edgeproto.CloudletKey]CloudletSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.Cloudlet
@@ -1116,6 +1325,11 @@ type CloudletSendContext struct {
 	forceDelete bool
 }
 
+type CloudletSyncDelete struct {
+	obj    *edgeproto.Cloudlet
+	modRev int64
+}
+
 func NewCloudletSend(handler SendCloudletHandler) *CloudletSend {
 	send := &CloudletSend{}
 	send.Name = "Cloudlet"
@@ -1153,6 +1367,9 @@ func (s *CloudletSend) UpdateAll(ctx context.Context) {
 		if !s.UpdateAllOkLocked(obj) { // to be implemented by hand
 			return
 		}
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = CloudletSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -1161,6 +1378,67 @@ func (s *CloudletSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *CloudletSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.Cloudlet{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted Cloudlet", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.CloudletKey]CloudletSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = CloudletSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *CloudletSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.CloudletKey]CloudletSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.Cloudlet
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		if !s.UpdateOk(ctx, del.obj) { // to be implemented by hand
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = CloudletSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *CloudletSend) Update(ctx context.Context, obj *edgeproto.Cloudlet, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -1454,6 +1732,7 @@ type CloudletInfoSend struct {
 	handler     SendCloudletInfoHandler
 	Keys        map[edgeproto.CloudletKey]CloudletInfoSendContext
 	keysToSend  map[edgeproto.CloudletKey]CloudletInfoSendContext
+	syncDeletes map[edgeproto.CloudletKey]CloudletInfoSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.CloudletInfo
@@ -1467,6 +1746,11 @@ type CloudletInfoSendContext struct {
 	forceDelete bool
 }
 
+type CloudletInfoSyncDelete struct {
+	obj    *edgeproto.CloudletInfo
+	modRev int64
+}
+
 func NewCloudletInfoSend(handler SendCloudletInfoHandler) *CloudletInfoSend {
 	send := &CloudletInfoSend{}
 	send.Name = "CloudletInfo"
@@ -1501,6 +1785,9 @@ func (s *CloudletInfoSend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.CloudletInfo, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = CloudletInfoSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -1509,6 +1796,64 @@ func (s *CloudletInfoSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *CloudletInfoSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.CloudletInfo{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted CloudletInfo", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.CloudletKey]CloudletInfoSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = CloudletInfoSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *CloudletInfoSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.CloudletKey]CloudletInfoSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.CloudletInfo
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = CloudletInfoSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *CloudletInfoSend) Update(ctx context.Context, obj *edgeproto.CloudletInfo, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
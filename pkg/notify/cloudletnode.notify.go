@@ -5,6 +5,7 @@ package notify
 
 import (
 	"context"
+	"encoding/json"
 	fmt "fmt"
 	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
@@ -50,6 +51,7 @@ type CloudletNodeSend struct {
 	handler     SendCloudletNodeHandler
 	Keys        map[edgeproto.CloudletNodeKey]CloudletNodeSendContext
 	keysToSend  map[edgeproto.CloudletNodeKey]CloudletNodeSendContext
+	syncDeletes map[edgeproto.CloudletNodeKey]CloudletNodeSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.CloudletNode
@@ -63,6 +65,11 @@ type CloudletNodeSendContext struct {
 	forceDelete bool
 }
 
+type CloudletNodeSyncDelete struct {
+	obj    *edgeproto.CloudletNode
+	modRev int64
+}
+
 func NewCloudletNodeSend(handler SendCloudletNodeHandler) *CloudletNodeSend {
 	send := &CloudletNodeSend{}
 	send.Name = "CloudletNode"
@@ -97,6 +104,9 @@ func (s *CloudletNodeSend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.CloudletNode, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = CloudletNodeSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -105,6 +115,64 @@ func (s *CloudletNodeSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *CloudletNodeSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.CloudletNode{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted CloudletNode", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.CloudletNodeKey]CloudletNodeSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = CloudletNodeSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *CloudletNodeSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.CloudletNodeKey]CloudletNodeSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.CloudletNode
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = CloudletNodeSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *CloudletNodeSend) Update(ctx context.Context, obj *edgeproto.CloudletNode, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -140,6 +208,9 @@ func (s *CloudletNodeSend) SendForCloudlet(ctx context.Context, action edgeproto
 	})
 	for k, data := range keys {
 		if action == edgeproto.NoticeAction_UPDATE {
+			if s.sendrecv.isSendSynced(s.MessageName, data.ModRev) {
+				continue
+			}
 			s.Update(ctx, data.Obj, data.ModRev)
 		} else if action == edgeproto.NoticeAction_DELETE {
 			s.ForceDelete(ctx, &k, data.ModRev)
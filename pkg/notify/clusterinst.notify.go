@@ -5,6 +5,7 @@ package notify
 
 import (
 	"context"
+	"encoding/json"
 	fmt "fmt"
 	_ "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
 	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
@@ -51,6 +52,7 @@ type ClusterInstSend struct {
 	handler     SendClusterInstHandler
 	Keys        map[edgeproto.ClusterKey]ClusterInstSendContext
 	keysToSend  map[edgeproto.ClusterKey]ClusterInstSendContext
+	syncDeletes map[edgeproto.ClusterKey]ClusterInstSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.ClusterInst
@@ -64,6 +66,11 @@ type ClusterInstSendContext struct {
 	forceDelete bool
 }
 
+type ClusterInstSyncDelete struct {
+	obj    *edgeproto.ClusterInst
+	modRev int64
+}
+
 func NewClusterInstSend(handler SendClusterInstHandler) *ClusterInstSend {
 	send := &ClusterInstSend{}
 	send.Name = "ClusterInst"
@@ -101,6 +108,9 @@ func (s *ClusterInstSend) UpdateAll(ctx context.Context) {
 		if !s.UpdateAllOkLocked(obj) { // to be implemented by hand
 			return
 		}
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = ClusterInstSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -109,6 +119,67 @@ func (s *ClusterInstSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *ClusterInstSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.ClusterInst{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted ClusterInst", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.ClusterKey]ClusterInstSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = ClusterInstSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *ClusterInstSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.ClusterKey]ClusterInstSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.ClusterInst
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		if !s.UpdateOk(ctx, del.obj) { // to be implemented by hand
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = ClusterInstSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *ClusterInstSend) Update(ctx context.Context, obj *edgeproto.ClusterInst, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -147,6 +218,9 @@ func (s *ClusterInstSend) SendForCloudlet(ctx context.Context, action edgeproto.
 	})
 	for k, data := range keys {
 		if action == edgeproto.NoticeAction_UPDATE {
+			if s.sendrecv.isSendSynced(s.MessageName, data.ModRev) {
+				continue
+			}
 			s.Update(ctx, data.Obj, data.ModRev)
 		} else if action == edgeproto.NoticeAction_DELETE {
 			s.ForceDelete(ctx, &k, data.ModRev)
@@ -415,6 +489,7 @@ type ClusterInstInfoSend struct {
 	handler     SendClusterInstInfoHandler
 	Keys        map[edgeproto.ClusterKey]ClusterInstInfoSendContext
 	keysToSend  map[edgeproto.ClusterKey]ClusterInstInfoSendContext
+	syncDeletes map[edgeproto.ClusterKey]ClusterInstInfoSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.ClusterInstInfo
@@ -428,6 +503,11 @@ type ClusterInstInfoSendContext struct {
 	forceDelete bool
 }
 
+type ClusterInstInfoSyncDelete struct {
+	obj    *edgeproto.ClusterInstInfo
+	modRev int64
+}
+
 func NewClusterInstInfoSend(handler SendClusterInstInfoHandler) *ClusterInstInfoSend {
 	send := &ClusterInstInfoSend{}
 	send.Name = "ClusterInstInfo"
@@ -462,6 +542,9 @@ func (s *ClusterInstInfoSend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.ClusterInstInfo, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = ClusterInstInfoSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -470,6 +553,64 @@ func (s *ClusterInstInfoSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *ClusterInstInfoSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.ClusterInstInfo{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted ClusterInstInfo", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.ClusterKey]ClusterInstInfoSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = ClusterInstInfoSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *ClusterInstInfoSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.ClusterKey]ClusterInstInfoSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.ClusterInstInfo
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = ClusterInstInfoSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *ClusterInstInfoSend) Update(ctx context.Context, obj *edgeproto.ClusterInstInfo, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -78,6 +78,10 @@ func (s *DebugRequestSend) GetNotifyId() int64 {
 }
 func (s *DebugRequestSend) UpdateAll(ctx context.Context) {}
 
+func (s *DebugRequestSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {}
+
+func (s *DebugRequestSend) SendSyncDeletes(ctx context.Context) {}
+
 func (s *DebugRequestSend) Update(ctx context.Context, msg *edgeproto.DebugRequest) bool {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return false
@@ -346,6 +350,10 @@ func (s *DebugReplySend) GetNotifyId() int64 {
 }
 func (s *DebugReplySend) UpdateAll(ctx context.Context) {}
 
+func (s *DebugReplySend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {}
+
+func (s *DebugReplySend) SendSyncDeletes(ctx context.Context) {}
+
 func (s *DebugReplySend) Update(ctx context.Context, msg *edgeproto.DebugReply) bool {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return false
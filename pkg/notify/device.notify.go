@@ -5,6 +5,7 @@ package notify
 
 import (
 	"context"
+	"encoding/json"
 	fmt "fmt"
 	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
@@ -50,6 +51,7 @@ type DeviceSend struct {
 	handler     SendDeviceHandler
 	Keys        map[edgeproto.DeviceKey]DeviceSendContext
 	keysToSend  map[edgeproto.DeviceKey]DeviceSendContext
+	syncDeletes map[edgeproto.DeviceKey]DeviceSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.Device
@@ -63,6 +65,11 @@ type DeviceSendContext struct {
 	forceDelete bool
 }
 
+type DeviceSyncDelete struct {
+	obj    *edgeproto.Device
+	modRev int64
+}
+
 func NewDeviceSend(handler SendDeviceHandler) *DeviceSend {
 	send := &DeviceSend{}
 	send.Name = "Device"
@@ -97,6 +104,9 @@ func (s *DeviceSend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.Device, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = DeviceSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -105,6 +115,64 @@ func (s *DeviceSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *DeviceSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.Device{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted Device", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.DeviceKey]DeviceSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = DeviceSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *DeviceSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.DeviceKey]DeviceSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.Device
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = DeviceSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *DeviceSend) Update(ctx context.Context, obj *edgeproto.Device, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -373,7 +373,7 @@ func (s *Client) WaitForSendAllEnd(count uint64) error {
 	var cnt uint64
 	for i := 0; i < 10; i++ {
 		cnt = s.sendrecv.stats.SendAllEnd
-		if cnt == cnt {
+		if cnt >= count {
 			return nil
 		}
 		time.Sleep(50 * time.Millisecond)
@@ -77,6 +77,10 @@ func (s *ExecRequestSend) GetNotifyId() int64 {
 }
 func (s *ExecRequestSend) UpdateAll(ctx context.Context) {}
 
+func (s *ExecRequestSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {}
+
+func (s *ExecRequestSend) SendSyncDeletes(ctx context.Context) {}
+
 func (s *ExecRequestSend) Update(ctx context.Context, msg *edgeproto.ExecRequest) bool {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return false
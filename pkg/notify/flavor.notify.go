@@ -5,6 +5,7 @@ package notify
 
 import (
 	"context"
+	"encoding/json"
 	fmt "fmt"
 	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
@@ -49,6 +50,7 @@ type FlavorSend struct {
 	handler     SendFlavorHandler
 	Keys        map[edgeproto.FlavorKey]FlavorSendContext
 	keysToSend  map[edgeproto.FlavorKey]FlavorSendContext
+	syncDeletes map[edgeproto.FlavorKey]FlavorSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.Flavor
@@ -62,6 +64,11 @@ type FlavorSendContext struct {
 	forceDelete bool
 }
 
+type FlavorSyncDelete struct {
+	obj    *edgeproto.Flavor
+	modRev int64
+}
+
 func NewFlavorSend(handler SendFlavorHandler) *FlavorSend {
 	send := &FlavorSend{}
 	send.Name = "Flavor"
@@ -96,6 +103,9 @@ func (s *FlavorSend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.Flavor, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = FlavorSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -104,6 +114,64 @@ func (s *FlavorSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *FlavorSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.Flavor{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted Flavor", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.FlavorKey]FlavorSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = FlavorSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *FlavorSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.FlavorKey]FlavorSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.Flavor
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = FlavorSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *FlavorSend) Update(ctx context.Context, obj *edgeproto.Flavor, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
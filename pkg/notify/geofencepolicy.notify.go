@@ -0,0 +1,439 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: geofencepolicy.proto
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	fmt "fmt"
+	_ "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	_ "github.com/edgexr/edge-cloud-platform/tools/protogen"
+	_ "github.com/gogo/googleapis/google/api"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	opentracing "github.com/opentracing/opentracing-go"
+	math "math"
+	"sync"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// Auto-generated code: DO NOT EDIT
+
+type SendGeoFencePolicyHandler interface {
+	GetAllLocked(ctx context.Context, cb func(key *edgeproto.GeoFencePolicy, modRev int64))
+	GetWithRev(key *edgeproto.PolicyKey, buf *edgeproto.GeoFencePolicy, modRev *int64) bool
+}
+
+type RecvGeoFencePolicyHandler interface {
+	Update(ctx context.Context, in *edgeproto.GeoFencePolicy, rev int64)
+	Delete(ctx context.Context, in *edgeproto.GeoFencePolicy, rev int64)
+	Prune(ctx context.Context, keys map[edgeproto.PolicyKey]struct{})
+	Flush(ctx context.Context, notifyId int64)
+}
+
+type GeoFencePolicyCacheHandler interface {
+	SendGeoFencePolicyHandler
+	RecvGeoFencePolicyHandler
+	AddNotifyCb(fn func(ctx context.Context, obj *edgeproto.GeoFencePolicy, modRev int64))
+}
+
+type GeoFencePolicySend struct {
+	Name        string
+	MessageName string
+	handler     SendGeoFencePolicyHandler
+	Keys        map[edgeproto.PolicyKey]GeoFencePolicySendContext
+	keysToSend  map[edgeproto.PolicyKey]GeoFencePolicySendContext
+	syncDeletes map[edgeproto.PolicyKey]GeoFencePolicySyncDelete
+	notifyId    int64
+	Mux         sync.Mutex
+	buf         edgeproto.GeoFencePolicy
+	SendCount   uint64
+	sendrecv    *SendRecv
+}
+
+type GeoFencePolicySendContext struct {
+	ctx         context.Context
+	modRev      int64
+	forceDelete bool
+}
+
+type GeoFencePolicySyncDelete struct {
+	obj    *edgeproto.GeoFencePolicy
+	modRev int64
+}
+
+func NewGeoFencePolicySend(handler SendGeoFencePolicyHandler) *GeoFencePolicySend {
+	send := &GeoFencePolicySend{}
+	send.Name = "GeoFencePolicy"
+	send.MessageName = proto.MessageName((*edgeproto.GeoFencePolicy)(nil))
+	send.handler = handler
+	send.Keys = make(map[edgeproto.PolicyKey]GeoFencePolicySendContext)
+	return send
+}
+
+func (s *GeoFencePolicySend) SetSendRecv(sendrecv *SendRecv) {
+	s.sendrecv = sendrecv
+}
+
+func (s *GeoFencePolicySend) GetMessageName() string {
+	return s.MessageName
+}
+
+func (s *GeoFencePolicySend) GetName() string {
+	return s.Name
+}
+
+func (s *GeoFencePolicySend) GetSendCount() uint64 {
+	return s.SendCount
+}
+
+func (s *GeoFencePolicySend) GetNotifyId() int64 {
+	return s.notifyId
+}
+func (s *GeoFencePolicySend) UpdateAll(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.GeoFencePolicy, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
+		s.Keys[*obj.GetKey()] = GeoFencePolicySendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
+func (s *GeoFencePolicySend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.GeoFencePolicy{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted GeoFencePolicy", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.PolicyKey]GeoFencePolicySyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = GeoFencePolicySyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *GeoFencePolicySend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.PolicyKey]GeoFencePolicySyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.GeoFencePolicy
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = GeoFencePolicySendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
+func (s *GeoFencePolicySend) Update(ctx context.Context, obj *edgeproto.GeoFencePolicy, modRev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	forceDelete := false
+	s.updateInternal(ctx, obj.GetKey(), modRev, forceDelete)
+}
+
+func (s *GeoFencePolicySend) ForceDelete(ctx context.Context, key *edgeproto.PolicyKey, modRev int64) {
+	forceDelete := true
+	s.updateInternal(ctx, key, modRev, forceDelete)
+}
+
+func (s *GeoFencePolicySend) updateInternal(ctx context.Context, key *edgeproto.PolicyKey, modRev int64, forceDelete bool) {
+	s.Mux.Lock()
+	log.SpanLog(ctx, log.DebugLevelNotify, "updateInternal GeoFencePolicy", "key", key, "modRev", modRev)
+	s.Keys[*key] = GeoFencePolicySendContext{
+		ctx:         ctx,
+		modRev:      modRev,
+		forceDelete: forceDelete,
+	}
+	s.Mux.Unlock()
+	s.sendrecv.wakeup()
+}
+
+func (s *GeoFencePolicySend) SendForCloudlet(ctx context.Context, action edgeproto.NoticeAction, cloudlet *edgeproto.Cloudlet) {
+}
+
+func (s *GeoFencePolicySend) Send(stream StreamNotify, notice *edgeproto.Notice, peer string) error {
+	s.Mux.Lock()
+	keys := s.keysToSend
+	s.keysToSend = nil
+	s.Mux.Unlock()
+	for key, sendContext := range keys {
+		ctx := sendContext.ctx
+		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
+		if found && !sendContext.forceDelete {
+			notice.Action = edgeproto.NoticeAction_UPDATE
+		} else {
+			notice.Action = edgeproto.NoticeAction_DELETE
+			notice.ModRev = sendContext.modRev
+			s.buf.Reset()
+			s.buf.SetKey(&key)
+		}
+		any, err := types.MarshalAny(&s.buf)
+		if err != nil {
+			s.sendrecv.stats.MarshalErrors++
+			err = nil
+			continue
+		}
+		notice.Any = *any
+		notice.Span = log.SpanToString(ctx)
+		log.SpanLog(ctx, log.DebugLevelNotify,
+			fmt.Sprintf("%s send GeoFencePolicy", s.sendrecv.cliserv),
+			"peerAddr", peer,
+			"peer", s.sendrecv.peer,
+			"local", s.sendrecv.name,
+			"action", notice.Action,
+			"key", key,
+			"modRev", notice.ModRev)
+		err = stream.Send(notice)
+		if err != nil {
+			s.sendrecv.stats.SendErrors++
+			return err
+		}
+		s.sendrecv.stats.Send++
+		// object specific counter
+		s.SendCount++
+	}
+	return nil
+}
+
+func (s *GeoFencePolicySend) PrepData() bool {
+	s.Mux.Lock()
+	defer s.Mux.Unlock()
+	if len(s.Keys) > 0 {
+		s.keysToSend = s.Keys
+		s.Keys = make(map[edgeproto.PolicyKey]GeoFencePolicySendContext)
+		return true
+	}
+	return false
+}
+
+// Server accepts multiple clients so needs to track multiple
+// peers to send to.
+type GeoFencePolicySendMany struct {
+	handler SendGeoFencePolicyHandler
+	Mux     sync.Mutex
+	sends   map[string]*GeoFencePolicySend
+}
+
+func NewGeoFencePolicySendMany(handler SendGeoFencePolicyHandler) *GeoFencePolicySendMany {
+	s := &GeoFencePolicySendMany{}
+	s.handler = handler
+	s.sends = make(map[string]*GeoFencePolicySend)
+	return s
+}
+
+func (s *GeoFencePolicySendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
+	send := NewGeoFencePolicySend(s.handler)
+	send.notifyId = notifyId
+	s.Mux.Lock()
+	s.sends[peerAddr] = send
+	s.Mux.Unlock()
+	return send
+}
+
+func (s *GeoFencePolicySendMany) DoneSend(peerAddr string, send NotifySend) {
+	asend, ok := send.(*GeoFencePolicySend)
+	if !ok {
+		return
+	}
+	// another connection may come from the same client so remove
+	// only if it matches
+	s.Mux.Lock()
+	if remove, _ := s.sends[peerAddr]; remove == asend {
+		delete(s.sends, peerAddr)
+	}
+	s.Mux.Unlock()
+}
+func (s *GeoFencePolicySendMany) Update(ctx context.Context, obj *edgeproto.GeoFencePolicy, modRev int64) {
+	s.Mux.Lock()
+	defer s.Mux.Unlock()
+	for _, send := range s.sends {
+		send.Update(ctx, obj, modRev)
+	}
+}
+
+func (s *GeoFencePolicySendMany) GetTypeString() string {
+	return "GeoFencePolicy"
+}
+
+type GeoFencePolicyRecv struct {
+	Name        string
+	MessageName string
+	handler     RecvGeoFencePolicyHandler
+	sendAllKeys map[edgeproto.PolicyKey]struct{}
+	Mux         sync.Mutex
+	buf         edgeproto.GeoFencePolicy
+	RecvCount   uint64
+	sendrecv    *SendRecv
+}
+
+func NewGeoFencePolicyRecv(handler RecvGeoFencePolicyHandler) *GeoFencePolicyRecv {
+	recv := &GeoFencePolicyRecv{}
+	recv.Name = "GeoFencePolicy"
+	recv.MessageName = proto.MessageName((*edgeproto.GeoFencePolicy)(nil))
+	recv.handler = handler
+	return recv
+}
+
+func (s *GeoFencePolicyRecv) SetSendRecv(sendrecv *SendRecv) {
+	s.sendrecv = sendrecv
+}
+
+func (s *GeoFencePolicyRecv) GetMessageName() string {
+	return s.MessageName
+}
+
+func (s *GeoFencePolicyRecv) GetName() string {
+	return s.Name
+}
+
+func (s *GeoFencePolicyRecv) GetRecvCount() uint64 {
+	return s.RecvCount
+}
+
+func (s *GeoFencePolicyRecv) Recv(ctx context.Context, notice *edgeproto.Notice, notifyId int64, peerAddr string) {
+	span := opentracing.SpanFromContext(ctx)
+	if span != nil {
+		span.SetTag("objtype", "GeoFencePolicy")
+	}
+
+	buf := &edgeproto.GeoFencePolicy{}
+	err := types.UnmarshalAny(&notice.Any, buf)
+	if err != nil {
+		s.sendrecv.stats.UnmarshalErrors++
+		log.SpanLog(ctx, log.DebugLevelNotify, "Unmarshal Error", "err", err)
+		return
+	}
+	if span != nil {
+		log.SetTags(span, buf.GetKey().GetTags())
+	}
+	log.SpanLog(ctx, log.DebugLevelNotify,
+		fmt.Sprintf("%s recv GeoFencePolicy", s.sendrecv.cliserv),
+		"peerAddr", peerAddr,
+		"peer", s.sendrecv.peer,
+		"local", s.sendrecv.name,
+		"action", notice.Action,
+		"key", buf.GetKeyVal(),
+		"modRev", notice.ModRev)
+	if notice.Action == edgeproto.NoticeAction_UPDATE {
+		s.handler.Update(ctx, buf, notice.ModRev)
+		s.Mux.Lock()
+		if s.sendAllKeys != nil {
+			s.sendAllKeys[buf.GetKeyVal()] = struct{}{}
+		}
+		s.Mux.Unlock()
+	} else if notice.Action == edgeproto.NoticeAction_DELETE {
+		s.handler.Delete(ctx, buf, notice.ModRev)
+	}
+	s.sendrecv.stats.Recv++
+	// object specific counter
+	s.RecvCount++
+}
+
+func (s *GeoFencePolicyRecv) RecvAllStart() {
+	s.Mux.Lock()
+	defer s.Mux.Unlock()
+	s.sendAllKeys = make(map[edgeproto.PolicyKey]struct{})
+}
+
+func (s *GeoFencePolicyRecv) RecvAllEnd(ctx context.Context, cleanup Cleanup) {
+	s.Mux.Lock()
+	validKeys := s.sendAllKeys
+	s.sendAllKeys = nil
+	s.Mux.Unlock()
+	if cleanup == CleanupPrune {
+		s.handler.Prune(ctx, validKeys)
+	}
+}
+func (s *GeoFencePolicyRecv) Flush(ctx context.Context, notifyId int64) {
+	s.handler.Flush(ctx, notifyId)
+}
+
+type GeoFencePolicyRecvMany struct {
+	handler RecvGeoFencePolicyHandler
+}
+
+func NewGeoFencePolicyRecvMany(handler RecvGeoFencePolicyHandler) *GeoFencePolicyRecvMany {
+	s := &GeoFencePolicyRecvMany{}
+	s.handler = handler
+	return s
+}
+
+func (s *GeoFencePolicyRecvMany) NewRecv() NotifyRecv {
+	recv := NewGeoFencePolicyRecv(s.handler)
+	return recv
+}
+
+func (s *GeoFencePolicyRecvMany) Flush(ctx context.Context, notifyId int64) {
+	s.handler.Flush(ctx, notifyId)
+}
+func (mgr *ServerMgr) RegisterSendGeoFencePolicyCache(cache GeoFencePolicyCacheHandler) {
+	send := NewGeoFencePolicySendMany(cache)
+	mgr.RegisterSend(send)
+	cache.AddNotifyCb(send.Update)
+}
+
+func (mgr *ServerMgr) RegisterRecvGeoFencePolicyCache(cache GeoFencePolicyCacheHandler) {
+	recv := NewGeoFencePolicyRecvMany(cache)
+	mgr.RegisterRecv(recv)
+}
+
+func (s *Client) RegisterSendGeoFencePolicyCache(cache GeoFencePolicyCacheHandler) {
+	send := NewGeoFencePolicySend(cache)
+	s.RegisterSend(send)
+	cache.AddNotifyCb(send.Update)
+}
+
+func (s *Client) RegisterRecvGeoFencePolicyCache(cache GeoFencePolicyCacheHandler) {
+	recv := NewGeoFencePolicyRecv(cache)
+	s.RegisterRecv(recv)
+}
@@ -77,6 +77,10 @@ func (s *MetricSend) GetNotifyId() int64 {
 }
 func (s *MetricSend) UpdateAll(ctx context.Context) {}
 
+func (s *MetricSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {}
+
+func (s *MetricSend) SendSyncDeletes(ctx context.Context) {}
+
 func (s *MetricSend) Update(ctx context.Context, msg *edgeproto.Metric) bool {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return false
@@ -5,6 +5,7 @@ package notify
 
 import (
 	"context"
+	"encoding/json"
 	fmt "fmt"
 	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
@@ -49,6 +50,7 @@ type NetworkSend struct {
 	handler     SendNetworkHandler
 	Keys        map[edgeproto.NetworkKey]NetworkSendContext
 	keysToSend  map[edgeproto.NetworkKey]NetworkSendContext
+	syncDeletes map[edgeproto.NetworkKey]NetworkSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.Network
@@ -62,6 +64,11 @@ type NetworkSendContext struct {
 	forceDelete bool
 }
 
+type NetworkSyncDelete struct {
+	obj    *edgeproto.Network
+	modRev int64
+}
+
 func NewNetworkSend(handler SendNetworkHandler) *NetworkSend {
 	send := &NetworkSend{}
 	send.Name = "Network"
@@ -96,6 +103,9 @@ func (s *NetworkSend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.Network, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = NetworkSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -104,6 +114,64 @@ func (s *NetworkSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *NetworkSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.Network{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted Network", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.NetworkKey]NetworkSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = NetworkSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *NetworkSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.NetworkKey]NetworkSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.Network
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = NetworkSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *NetworkSend) Update(ctx context.Context, obj *edgeproto.Network, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
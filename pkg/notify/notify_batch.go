@@ -0,0 +1,207 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+// Batching reduces the number of messages sent over the stream by
+// sending multiple notices in a single BATCH notice, which can
+// optionally be compressed. Batching is used if both sides support
+// it, and the compression is requested by the client during the
+// version exchange.
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/klauspost/compress/zstd"
+)
+
+// NotifyVersionBatch is the first version that supports batching.
+const NotifyVersionBatch uint32 = 3
+
+var (
+	// Max number of notices in a batch
+	NotifyBatchMaxNotices = 100
+	// Max size of a batch in bytes before compression. A batch
+	// is sent once it reaches this size.
+	NotifyBatchMaxSize = 512 * 1024
+	// Max size of a received batch after decompression
+	NotifyBatchMaxRecvSize = 64 * 1024 * 1024
+)
+
+// ClientCompression sets the compression to request from the server
+// for batched notices.
+func ClientCompression(compression edgeproto.NoticeCompression) ClientOp {
+	return func(opts *ClientOptions) {
+		opts.compression = compression
+	}
+}
+
+// negotiateCompression gets the compression to use based on the
+// client's request.
+func negotiateCompression(version uint32, compression edgeproto.NoticeCompression) edgeproto.NoticeCompression {
+	if version < NotifyVersionBatch {
+		return edgeproto.NoticeCompression_NOTICE_COMPRESSION_NONE
+	}
+	if _, found := edgeproto.NoticeCompression_name[int32(compression)]; !found {
+		// unknown to this version
+		return edgeproto.NoticeCompression_NOTICE_COMPRESSION_NONE
+	}
+	return compression
+}
+
+// setBatch sets the batching state after the version exchange.
+func (s *SendRecv) setBatch(version uint32, compression edgeproto.NoticeCompression) {
+	s.batchOk = version >= NotifyVersionBatch
+	s.compression = negotiateCompression(version, compression)
+}
+
+// noticeBatcher collects notices to send as a batch. It is passed
+// to NotifySend.Send in place of the stream. Flush must be called
+// to send any remaining notices.
+type noticeBatcher struct {
+	StreamNotify
+	compression edgeproto.NoticeCompression
+	batch       edgeproto.NoticeBatch
+	size        int
+	stats       *Stats
+}
+
+func newNoticeBatcher(stream StreamNotify, compression edgeproto.NoticeCompression, stats *Stats) *noticeBatcher {
+	return &noticeBatcher{
+		StreamNotify: stream,
+		compression:  compression,
+		stats:        stats,
+	}
+}
+
+func (s *noticeBatcher) Send(notice *edgeproto.Notice) error {
+	// Notice is reused by the caller, so copy it. The Any data is
+	// allocated for each object marshaled, so is not reused.
+	s.batch.Notices = append(s.batch.Notices, *notice)
+	s.size += notice.Size()
+	if len(s.batch.Notices) >= NotifyBatchMaxNotices || s.size >= NotifyBatchMaxSize {
+		return s.Flush()
+	}
+	return nil
+}
+
+// Flush sends any notices collected so far.
+func (s *noticeBatcher) Flush() error {
+	if len(s.batch.Notices) == 0 {
+		return nil
+	}
+	defer func() {
+		s.batch.Notices = nil
+		s.size = 0
+	}()
+	if len(s.batch.Notices) == 1 && s.compression == edgeproto.NoticeCompression_NOTICE_COMPRESSION_NONE {
+		// no benefit to batching
+		return s.StreamNotify.Send(&s.batch.Notices[0])
+	}
+	data, err := s.batch.Marshal()
+	if err != nil {
+		s.stats.MarshalErrors++
+		return err
+	}
+	data, err = compressNotices(s.compression, data)
+	if err != nil {
+		s.stats.MarshalErrors++
+		return err
+	}
+	notice := edgeproto.Notice{
+		Action:      edgeproto.NoticeAction_BATCH,
+		Compression: s.compression,
+		Batch:       data,
+	}
+	if err := s.StreamNotify.Send(&notice); err != nil {
+		return err
+	}
+	s.stats.BatchSend++
+	return nil
+}
+
+// decodeBatch gets the notices from a BATCH notice.
+func decodeBatch(notice *edgeproto.Notice) (*edgeproto.NoticeBatch, error) {
+	data, err := decompressNotices(notice.Compression, notice.Batch)
+	if err != nil {
+		return nil, err
+	}
+	batch := edgeproto.NoticeBatch{}
+	if err := batch.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return &batch, nil
+}
+
+func compressNotices(compression edgeproto.NoticeCompression, data []byte) ([]byte, error) {
+	switch compression {
+	case edgeproto.NoticeCompression_NOTICE_COMPRESSION_NONE:
+		return data, nil
+	case edgeproto.NoticeCompression_NOTICE_COMPRESSION_GZIP:
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case edgeproto.NoticeCompression_NOTICE_COMPRESSION_ZSTD:
+		w, err := zstd.NewWriter(nil)
+		if err != nil {
+			return nil, err
+		}
+		defer w.Close()
+		return w.EncodeAll(data, nil), nil
+	}
+	return nil, fmt.Errorf("unsupported notice compression %s", compression.String())
+}
+
+func decompressNotices(compression edgeproto.NoticeCompression, data []byte) ([]byte, error) {
+	var r io.Reader
+	switch compression {
+	case edgeproto.NoticeCompression_NOTICE_COMPRESSION_NONE:
+		return data, nil
+	case edgeproto.NoticeCompression_NOTICE_COMPRESSION_GZIP:
+		gr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer gr.Close()
+		r = gr
+	case edgeproto.NoticeCompression_NOTICE_COMPRESSION_ZSTD:
+		zr, err := zstd.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		r = zr
+	default:
+		return nil, fmt.Errorf("unsupported notice compression %s", compression.String())
+	}
+	// limit the decompressed size to guard against corrupt data
+	out, err := io.ReadAll(io.LimitReader(r, int64(NotifyBatchMaxRecvSize)+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(out) > NotifyBatchMaxRecvSize {
+		return nil, fmt.Errorf("decompressed notice batch exceeds max size %d", NotifyBatchMaxRecvSize)
+	}
+	return out, nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/test/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestNotifyBatch(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelNotify)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	NotifyRetryTime = 10 * time.Millisecond
+
+	addr := "127.0.0.1:61237"
+	serverAddrs := []string{addr}
+
+	serverHandler := NewDummyHandler()
+	serverMgr := ServerMgr{}
+	serverHandler.RegisterServer(&serverMgr)
+	apps := testutil.AppData()
+	for ii := range apps {
+		serverHandler.AppCache.Update(ctx, &apps[ii], 0)
+	}
+	serverMgr.Start("ctrl", addr, nil)
+	defer serverMgr.Stop()
+
+	compressions := []edgeproto.NoticeCompression{
+		edgeproto.NoticeCompression_NOTICE_COMPRESSION_NONE,
+		edgeproto.NoticeCompression_NOTICE_COMPRESSION_GZIP,
+		edgeproto.NoticeCompression_NOTICE_COMPRESSION_ZSTD,
+	}
+	for _, compression := range compressions {
+		dmeHandler := NewDummyHandler()
+		clientDME := NewClient("dme", serverAddrs, grpc.WithInsecure(), ClientCompression(compression))
+		dmeHandler.RegisterDMEClient(clientDME)
+		clientDME.Start()
+
+		require.Nil(t, clientDME.WaitForConnect(1))
+		require.Nil(t, clientDME.WaitForSendAllEnd(1))
+		require.Nil(t, dmeHandler.WaitForApps(len(apps)))
+		require.Equal(t, NotifyVersion, clientDME.version)
+		require.Equal(t, compression, clientDME.sendrecv.compression)
+
+		// initial send all is batched
+		stats := serverMgr.GetStats(clientDME.GetLocalAddr())
+		require.Equal(t, uint64(len(apps)), stats.ObjSend["App"])
+		require.Less(t, uint64(0), stats.BatchSend)
+		clientStats := Stats{}
+		clientDME.GetStats(&clientStats)
+		require.Equal(t, stats.BatchSend, clientStats.BatchRecv)
+
+		// updates after the send all
+		updated := apps[0]
+		updated.ImagePath = "updated-" + compression.String()
+		serverHandler.AppCache.Update(ctx, &updated, 0)
+		serverHandler.AppCache.Delete(ctx, &apps[1], 0)
+		require.Nil(t, dmeHandler.WaitForApps(len(apps)-1))
+		check := edgeproto.App{}
+		require.True(t, dmeHandler.AppCache.Get(&apps[0].Key, &check))
+		require.Equal(t, updated.ImagePath, check.ImagePath)
+		serverHandler.AppCache.Update(ctx, &apps[1], 0)
+		require.Nil(t, dmeHandler.WaitForApps(len(apps)))
+
+		clientDME.Stop()
+	}
+
+	// peers on an older version do not get batches
+	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	require.Nil(t, err)
+	defer conn.Close()
+	api := edgeproto.NewNotifyApiClient(conn)
+	stream, err := api.StreamNotice(ctx)
+	require.Nil(t, err)
+	err = stream.Send(&edgeproto.Notice{
+		Action:      edgeproto.NoticeAction_VERSION,
+		Version:     NotifyVersionSyncRevs,
+		WantObjs:    []string{"edgeproto.App"},
+		Compression: edgeproto.NoticeCompression_NOTICE_COMPRESSION_GZIP,
+	})
+	require.Nil(t, err)
+	reply, err := stream.Recv()
+	require.Nil(t, err)
+	require.Equal(t, NotifyVersionSyncRevs, reply.Version)
+	require.Equal(t, edgeproto.NoticeCompression_NOTICE_COMPRESSION_NONE, reply.Compression)
+	for ii := 0; ii < len(apps); ii++ {
+		notice, err := stream.Recv()
+		require.Nil(t, err)
+		require.Equal(t, edgeproto.NoticeAction_UPDATE, notice.Action)
+	}
+	notice, err := stream.Recv()
+	require.Nil(t, err)
+	require.Equal(t, edgeproto.NoticeAction_SENDALL_END, notice.Action)
+	require.Nil(t, stream.CloseSend())
+}
+
+func TestNotifyBatchCompression(t *testing.T) {
+	data := bytes.Repeat([]byte("notice data "), 1000)
+	for name := range edgeproto.NoticeCompression_value {
+		compression := edgeproto.NoticeCompression(edgeproto.NoticeCompression_value[name])
+		out, err := compressNotices(compression, data)
+		require.Nil(t, err, name)
+		if compression != edgeproto.NoticeCompression_NOTICE_COMPRESSION_NONE {
+			require.Less(t, len(out), len(data), name)
+		}
+		in, err := decompressNotices(compression, out)
+		require.Nil(t, err, name)
+		require.Equal(t, data, in, name)
+
+		// decompressed size is limited
+		if compression != edgeproto.NoticeCompression_NOTICE_COMPRESSION_NONE {
+			maxSize := NotifyBatchMaxRecvSize
+			NotifyBatchMaxRecvSize = len(data) - 1
+			_, err = decompressNotices(compression, out)
+			NotifyBatchMaxRecvSize = maxSize
+			require.NotNil(t, err, name)
+			require.Contains(t, err.Error(), "exceeds max size", name)
+		}
+	}
+	_, err := decompressNotices(edgeproto.NoticeCompression(99), data)
+	require.NotNil(t, err)
+
+	// unknown compressions from newer peers fall back to none
+	require.Equal(t, edgeproto.NoticeCompression_NOTICE_COMPRESSION_NONE,
+		negotiateCompression(NotifyVersionBatch, edgeproto.NoticeCompression(99)))
+	require.Equal(t, edgeproto.NoticeCompression_NOTICE_COMPRESSION_NONE,
+		negotiateCompression(NotifyVersionSyncRevs, edgeproto.NoticeCompression_NOTICE_COMPRESSION_ZSTD))
+	require.Equal(t, edgeproto.NoticeCompression_NOTICE_COMPRESSION_ZSTD,
+		negotiateCompression(NotifyVersionBatch, edgeproto.NoticeCompression_NOTICE_COMPRESSION_ZSTD))
+}
@@ -86,6 +86,9 @@ func (s *Client) Start() {
 	s.running = make(chan struct{})
 	s.addrIdx = rand.Int() % len(s.addrs)
 	s.mux.Unlock()
+	// received data may be changed while stopped, so
+	// do not rely on it for an incremental sync.
+	s.sendrecv.resetRecvSyncRevs()
 	go s.run()
 }
 
@@ -220,6 +223,8 @@ func (s *Client) negotiate(stream StreamNotify) error {
 	request.WantObjs = s.sendrecv.localWanted
 	request.FilterCloudletKey = s.sendrecv.filterCloudletKeys
 	request.FilterFederatedCloudlet = s.sendrecv.filterFederatedCloudlet
+	request.SyncRevs = s.sendrecv.getRecvSyncRevs()
+	request.Compression = s.options.compression
 	request.Tags = map[string]string{
 		"name": s.name,
 	}
@@ -239,6 +244,9 @@ func (s *Client) negotiate(stream StreamNotify) error {
 		s.version = request.Version
 	}
 	s.sendrecv.setRemoteWanted(reply.WantObjs)
+	s.sendrecv.syncRevsOk = s.version >= NotifyVersionSyncRevs
+	s.sendrecv.setRecvIncremental(reply.SyncRevs)
+	s.sendrecv.setBatch(s.version, reply.Compression)
 	if reply.Tags != nil {
 		if peer, found := reply.Tags["name"]; found {
 			s.sendrecv.peer = peer
@@ -255,6 +263,8 @@ func (s *Client) negotiate(stream StreamNotify) error {
 		"remoteWanted", s.sendrecv.remoteWanted,
 		"filterCloudletKey", s.sendrecv.filterCloudletKeys,
 		"filterFederatedCloudlet", s.sendrecv.filterFederatedCloudlet,
+		"syncRevs", reply.SyncRevs,
+		"compression", reply.Compression,
 		"tries", s.sendrecv.stats.Tries,
 		"connects", s.sendrecv.stats.Connects)
 	return nil
@@ -318,6 +328,7 @@ func (s *grpcStatsHandler) HandleConn(ctx context.Context, connStats stats.ConnS
 type ClientOptions struct {
 	unaryInterceptors  []grpc.UnaryClientInterceptor
 	streamInterceptors []grpc.StreamClientInterceptor
+	compression        edgeproto.NoticeCompression
 }
 
 type ClientOp func(s *ClientOptions)
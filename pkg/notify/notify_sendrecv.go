@@ -53,6 +53,11 @@ type NotifySend interface {
 	UpdateAll(ctx context.Context)
 	// Send cloudlet-filtered objects after receiving cloudletinfo
 	SendForCloudlet(ctx context.Context, action edgeproto.NoticeAction, cloudlet *edgeproto.Cloudlet)
+	// Add an object deleted since the revision the remote is in
+	// sync with, for an incremental sync
+	AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64)
+	// Queue deletes added for an incremental sync for send
+	SendSyncDeletes(ctx context.Context)
 }
 
 // NotifyRecv is implemented by auto-generated code. The same
@@ -99,6 +104,8 @@ type Stats struct {
 	SendErrors      uint64
 	MarshalErrors   uint64
 	UnmarshalErrors uint64
+	BatchSend       uint64
+	BatchRecv       uint64
 	ObjSend         map[string]uint64
 	ObjRecv         map[string]uint64
 }
@@ -119,6 +126,9 @@ type Cleanup int
 const (
 	CleanupPrune Cleanup = iota
 	CleanupFlush
+	// No cleanup is done after an incremental sync, as deleted
+	// objects are sent explicitly.
+	CleanupNone
 )
 
 type SendRecv struct {
@@ -148,6 +158,15 @@ type SendRecv struct {
 	sendAllEnd              bool
 	manualSendAllEnd        bool
 	sendAllRecvHandler      SendAllRecv
+	// incremental sync state, see notify_syncrev.go
+	syncRevsOk      bool
+	sendAllRev      int64
+	sendSyncRevs    map[string]int64
+	recvSyncRevs    map[string]int64
+	recvIncremental map[string]struct{}
+	// batching state, see notify_batch.go
+	batchOk     bool
+	compression edgeproto.NoticeCompression
 }
 
 func (s *SendRecv) init(name, cliserv string) {
@@ -159,6 +178,7 @@ func (s *SendRecv) init(name, cliserv string) {
 	s.remoteWanted = make(map[string]struct{})
 	s.cloudletKeys = make(map[edgeproto.CloudletKey]struct{})
 	s.signal = make(chan bool, 1)
+	s.recvSyncRevs = make(map[string]int64)
 }
 
 func (s *SendRecv) registerSend(send NotifySend) {
@@ -265,6 +285,9 @@ func (s *SendRecv) send(stream StreamNotify) {
 		for ii := len(s.sendlist) - 1; ii >= 0; ii-- {
 			if sendAll {
 				s.sendlist[ii].UpdateAll(sendAllCtx)
+				if !s.filterCloudletKeys {
+					s.sendlist[ii].SendSyncDeletes(sendAllCtx)
+				}
 			}
 			if s.sendlist[ii].PrepData() {
 				hasData = true
@@ -284,8 +307,14 @@ func (s *SendRecv) send(stream StreamNotify) {
 		// may have dependencies on other objects. It's up to the
 		// caller to make sure CacheSend objects are registered
 		// in the desired send order.
+		var sendStream StreamNotify = stream
+		var batcher *noticeBatcher
+		if s.batchOk {
+			batcher = newNoticeBatcher(stream, s.compression, &s.stats)
+			sendStream = batcher
+		}
 		for _, send := range s.sendlist {
-			err = send.Send(stream, &notice, s.peerAddr)
+			err = send.Send(sendStream, &notice, s.peerAddr)
 			if err != nil {
 				break
 			}
@@ -299,16 +328,26 @@ func (s *SendRecv) send(stream StreamNotify) {
 			notice.Action = edgeproto.NoticeAction_SENDALL_END
 			notice.Any = types.Any{}
 			notice.Span = log.SpanToString(sendAllCtx)
-			err = stream.Send(&notice)
+			s.mux.Lock()
+			notice.ModRev = s.sendAllRev
+			s.mux.Unlock()
+			err = sendStream.Send(&notice)
+			if err == nil && batcher != nil {
+				err = batcher.Flush()
+			}
 			if err != nil {
 				log.SpanLog(sendAllCtx, log.DebugLevelNotify,
 					fmt.Sprintf("%s send sendall end", s.cliserv),
 					"peer", s.peer, "local", s.name, "err", err)
 				break
 			}
+			s.sendSyncDone()
 			sendAllSpan.Finish()
 			sendAllSpan = nil
 		}
+		if batcher != nil {
+			err = batcher.Flush()
+		}
 		if sendAll {
 			sendAll = false
 		}
@@ -334,6 +373,50 @@ func (s *SendRecv) recv(stream StreamNotify, notifyId int64, cleanup Cleanup) {
 	for _, recv := range s.recvmap {
 		recv.RecvAllStart()
 	}
+	handleNotice := func(notice *edgeproto.Notice) {
+		// inner func so we can use defer to close span
+		ctx := context.Background()
+		name, err := types.AnyMessageName(&notice.Any)
+		if notice.Span != "" {
+			spanName := fmt.Sprintf("notify-recv %s", name)
+			span := log.NewSpanFromString(log.DebugLevelNotify, notice.Span, spanName)
+			span.SetTag("action", notice.Action)
+			span.SetTag("cliserv", s.cliserv)
+			span.SetTag("peer", s.peerAddr)
+			defer span.Finish()
+			ctx = opentracing.ContextWithSpan(ctx, span)
+		}
+		if err != nil && notice.Action != edgeproto.NoticeAction_SENDALL_END {
+			log.SpanLog(ctx, log.DebugLevelNotify, fmt.Sprintf("%s hit error", s.cliserv), "peer", s.peer, "local", s.name, "err", err)
+			return
+		}
+		if recvAll && notice.Action == edgeproto.NoticeAction_SENDALL_END {
+			log.SpanLog(ctx, log.DebugLevelNotify, fmt.Sprintf("%s recv sendall end", s.cliserv), "peer", s.peer, "local", s.name)
+			for _, recv := range s.recvmap {
+				recv.RecvAllEnd(ctx, s.getRecvAllEndCleanup(recv.GetMessageName(), cleanup))
+			}
+			s.recvSyncDone(notice.ModRev)
+			sendAllRecv := s.sendAllRecvHandler
+			if sendAllRecv != nil {
+				sendAllRecv.RecvAllEnd(ctx)
+			}
+			recvAll = false
+			s.stats.SendAllEnd++
+			return
+		}
+		recv := s.recvmap[name]
+		if recv != nil {
+			recv.Recv(ctx, notice, notifyId, s.peerAddr)
+		} else {
+			log.DebugLog(log.DebugLevelNotify,
+				fmt.Sprintf("%s recv unhandled", s.cliserv),
+				"peerAddr", s.peerAddr,
+				"peer", s.peer,
+				"local", s.name,
+				"action", notice.Action,
+				"name", name)
+		}
+	}
 	for !s.done {
 		notice, err := stream.Recv()
 		if s.done {
@@ -344,49 +427,23 @@ func (s *SendRecv) recv(stream StreamNotify, notifyId int64, cleanup Cleanup) {
 				fmt.Sprintf("%s receive", s.cliserv), "err", err)
 			break
 		}
-		func() {
-			// anonymous inner func so we can use defer to close span
-			ctx := context.Background()
-			name, err := types.AnyMessageName(&notice.Any)
-			if notice.Span != "" {
-				spanName := fmt.Sprintf("notify-recv %s", name)
-				span := log.NewSpanFromString(log.DebugLevelNotify, notice.Span, spanName)
-				span.SetTag("action", notice.Action)
-				span.SetTag("cliserv", s.cliserv)
-				span.SetTag("peer", s.peerAddr)
-				defer span.Finish()
-				ctx = opentracing.ContextWithSpan(ctx, span)
-			}
-			if err != nil && notice.Action != edgeproto.NoticeAction_SENDALL_END {
-				log.SpanLog(ctx, log.DebugLevelNotify, fmt.Sprintf("%s hit error", s.cliserv), "peer", s.peer, "local", s.name, "err", err)
-				return
-			}
-			if recvAll && notice.Action == edgeproto.NoticeAction_SENDALL_END {
-				log.SpanLog(ctx, log.DebugLevelNotify, fmt.Sprintf("%s recv sendall end", s.cliserv), "peer", s.peer, "local", s.name)
-				for _, recv := range s.recvmap {
-					recv.RecvAllEnd(ctx, cleanup)
-				}
-				sendAllRecv := s.sendAllRecvHandler
-				if sendAllRecv != nil {
-					sendAllRecv.RecvAllEnd(ctx)
-				}
-				recvAll = false
-				s.stats.SendAllEnd++
-				return
-			}
-			recv := s.recvmap[name]
-			if recv != nil {
-				recv.Recv(ctx, notice, notifyId, s.peerAddr)
-			} else {
+		if notice.Action == edgeproto.NoticeAction_BATCH {
+			batch, err := decodeBatch(notice)
+			if err != nil {
+				// batch is lost, reconnect to resync
 				log.DebugLog(log.DebugLevelNotify,
-					fmt.Sprintf("%s recv unhandled", s.cliserv),
-					"peerAddr", s.peerAddr,
-					"peer", s.peer,
-					"local", s.name,
-					"action", notice.Action,
-					"name", name)
+					fmt.Sprintf("%s decode batch", s.cliserv),
+					"peer", s.peer, "local", s.name, "err", err)
+				s.stats.RecvErrors++
+				break
+			}
+			s.stats.BatchRecv++
+			for ii := range batch.Notices {
+				handleNotice(&batch.Notices[ii])
 			}
-		}()
+			continue
+		}
+		handleNotice(notice)
 	}
 	close(s.recvRunning)
 }
@@ -434,7 +491,13 @@ func (s *SendRecv) setObjStats(stats *Stats) {
 }
 
 func (s *SendRecv) sendForCloudlet(ctx context.Context, action edgeproto.NoticeAction, cloudlet *edgeproto.Cloudlet) {
+	syncing := action == edgeproto.NoticeAction_UPDATE && s.isSendSyncing()
 	for _, send := range s.sendlist {
 		send.SendForCloudlet(ctx, action, cloudlet)
+		if syncing {
+			// deletes for cloudlet-filtered objects can only be
+			// filtered once the cloudlet keys are known.
+			send.SendSyncDeletes(ctx)
+		}
 	}
 }
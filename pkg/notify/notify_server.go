@@ -50,7 +50,7 @@ import (
 
 var NotifyRetryTime time.Duration = 250 * time.Millisecond
 
-const NotifyVersion uint32 = 1
+const NotifyVersion uint32 = 3
 
 // Server is on the upstream side and sends data to downstream clients.
 // On first connect, it will send all data from the database that is
@@ -75,6 +75,7 @@ type ServerMgr struct {
 	serv     *grpc.Server
 	name     string
 	regServ  func(s *grpc.Server)
+	history  SyncHistory
 }
 
 // NotifySendMany and NotifyRecvMany are implemented by auto-generated code.
@@ -205,10 +206,10 @@ func (mgr *ServerMgr) StreamNotice(stream edgeproto.NotifyApi_StreamNoticeServer
 	}
 	mgr.mux.Unlock()
 
-	// do initial version exchange before registering sends,
+	// receive initial version exchange before registering sends,
 	// otherwise sends could start before cloudlet/federation
 	// filters specified during negotiate are applied.
-	err := server.negotiate(spctx, stream, mgr.name)
+	req, err := server.negotiateRecv(spctx, stream)
 	if err != nil {
 		server.logDisconnect(spctx, err)
 		close(server.running)
@@ -223,6 +224,23 @@ func (mgr *ServerMgr) StreamNotice(stream edgeproto.NotifyApi_StreamNoticeServer
 	}
 	mgr.mux.Unlock()
 
+	// set up incremental sync after registering sends,
+	// so that no changes are missed.
+	mgr.setupSendSync(spctx, &server.sendrecv, req, server.version)
+
+	err = server.negotiateReply(spctx, stream, mgr.name)
+	if err != nil {
+		server.logDisconnect(spctx, err)
+		mgr.mux.Lock()
+		for ii, _ := range mgr.sends {
+			mgr.sends[ii].DoneSend(peerAddr, server.sendrecv.sendlist[ii])
+		}
+		mgr.mux.Unlock()
+		close(server.running)
+		span.Finish()
+		return err
+	}
+
 	// register server by client addr
 	mgr.mux.Lock()
 	mgr.table[peerAddr] = &server
@@ -294,20 +312,20 @@ func (s *ServerMgr) GetSendOrder() map[string]int {
 	return order
 }
 
-func (s *Server) negotiate(ctx context.Context, stream edgeproto.NotifyApi_StreamNoticeServer, name string) error {
-	var notice edgeproto.Notice
+// negotiateRecv receives the initial exchange from the client.
+func (s *Server) negotiateRecv(ctx context.Context, stream edgeproto.NotifyApi_StreamNoticeServer) (*edgeproto.Notice, error) {
 	// initial connection is version exchange
 	// this also sets the connection Id so we can ignore spurious old
 	// buffered messages
 	req, err := stream.Recv()
 	if err != nil {
 		s.sendrecv.stats.NegotiateErrors++
-		return err
+		return nil, err
 	}
 	if req.Action != edgeproto.NoticeAction_VERSION {
-		log.DebugLog(log.DebugLevelNotify, "Notify server bad action", "expected", edgeproto.NoticeAction_VERSION, "got", notice.Action)
+		log.DebugLog(log.DebugLevelNotify, "Notify server bad action", "expected", edgeproto.NoticeAction_VERSION, "got", req.Action)
 		s.sendrecv.stats.NegotiateErrors++
-		return errors.New("Notify server expected action version")
+		return nil, errors.New("Notify server expected action version")
 	}
 	s.sendrecv.setRemoteWanted(req.WantObjs)
 	s.sendrecv.filterCloudletKeys = req.FilterCloudletKey
@@ -320,24 +338,32 @@ func (s *Server) negotiate(ctx context.Context, stream edgeproto.NotifyApi_Strea
 	}
 	// use lowest common version
 	if req.Version > NotifyVersion {
-		s.version = req.Version
-	} else {
 		s.version = NotifyVersion
+	} else {
+		s.version = req.Version
 	}
+	s.sendrecv.setBatch(s.version, req.Compression)
 	if req.Tags != nil {
 		if peer, found := req.Tags["name"]; found {
 			s.sendrecv.peer = peer
 		}
 	}
+	return req, nil
+}
 
+// negotiateReply replies to the initial exchange from the client.
+func (s *Server) negotiateReply(ctx context.Context, stream edgeproto.NotifyApi_StreamNoticeServer, name string) error {
+	var notice edgeproto.Notice
 	// send back my version
 	notice.Action = edgeproto.NoticeAction_VERSION
 	notice.Version = s.version
 	notice.WantObjs = s.sendrecv.localWanted
+	notice.SyncRevs = s.sendrecv.getSendSyncRevs()
+	notice.Compression = s.sendrecv.compression
 	notice.Tags = map[string]string{
 		"name": name,
 	}
-	err = stream.Send(&notice)
+	err := stream.Send(&notice)
 	if err != nil {
 		s.sendrecv.stats.NegotiateErrors++
 		return err
@@ -349,7 +375,9 @@ func (s *Server) negotiate(ctx context.Context, stream edgeproto.NotifyApi_Strea
 		"notifyid", s.notifyId,
 		"remoteWanted", s.sendrecv.remoteWanted,
 		"filterCloudletKey", s.sendrecv.filterCloudletKeys,
-		"filterFederatedCloudlet", s.sendrecv.filterFederatedCloudlet)
+		"filterFederatedCloudlet", s.sendrecv.filterFederatedCloudlet,
+		"syncRevs", notice.SyncRevs,
+		"compression", notice.Compression)
 	return nil
 }
 
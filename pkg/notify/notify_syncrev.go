@@ -0,0 +1,238 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+// Incremental sync allows a client that reconnects to be sent only
+// the changes since the revision it was last in sync with, rather
+// than all of the data. The client advertises the revision per
+// wanted object type during negotiation. If the server has access
+// to the database history, it sends only objects modified after
+// that revision, plus the objects deleted since then, and the client
+// does not prune data at the end of the send all. If the history is
+// not available, i.e. the revision has been compacted, the server
+// falls back to sending all data.
+
+import (
+	"context"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// NotifyVersionSyncRevs is the first version that supports
+// incremental sync.
+const NotifyVersionSyncRevs uint32 = 2
+
+// SyncHistory is implemented by servers whose sent caches are backed
+// by a database that keeps a revision history, i.e. the Controller.
+type SyncHistory interface {
+	// GetSyncRev gets the database revision the caches are in sync with.
+	GetSyncRev() int64
+	// DeletedSince calls cb for each object deleted after rev, up to
+	// endRev, with the value of the object before it was deleted.
+	// The type is the object type name, i.e. "AppInst". An error is
+	// returned if the history since rev is not available.
+	DeletedSince(ctx context.Context, rev, endRev int64, cb func(typ string, prevVal []byte, modRev int64)) error
+}
+
+// Objects sent to cloudlet-filtered clients because they are
+// referenced by other objects. A change in references may require
+// sending an unchanged object that the client never received, so
+// these are always fully synced.
+var syncRevsFilterCloudletExcludes = map[string]struct{}{
+	proto.MessageName((*edgeproto.VMPool)(nil)):    {},
+	proto.MessageName((*edgeproto.GPUDriver)(nil)): {},
+}
+
+// SetSyncHistory enables incremental sync for reconnecting clients.
+func (mgr *ServerMgr) SetSyncHistory(history SyncHistory) {
+	mgr.mux.Lock()
+	defer mgr.mux.Unlock()
+	mgr.history = history
+}
+
+type syncDelete struct {
+	send    NotifySend
+	prevVal []byte
+	modRev  int64
+}
+
+// setupSendSync is called after sends are registered for a new
+// client connection. If the client is in sync with a revision that
+// is still in the history, only changes since then will be sent.
+func (mgr *ServerMgr) setupSendSync(ctx context.Context, s *SendRecv, req *edgeproto.Notice, version uint32) {
+	mgr.mux.Lock()
+	history := mgr.history
+	mgr.mux.Unlock()
+	if history == nil {
+		return
+	}
+	// sends are already registered, so any changes after this
+	// revision will be queued for send.
+	sendAllRev := history.GetSyncRev()
+	s.mux.Lock()
+	s.sendAllRev = sendAllRev
+	s.mux.Unlock()
+
+	if version < NotifyVersionSyncRevs || len(req.SyncRevs) == 0 {
+		return
+	}
+	syncRevs := make(map[string]int64)
+	var minRev int64
+	for name, rev := range req.SyncRevs {
+		if rev <= 0 || !s.isRemoteWanted(name) {
+			continue
+		}
+		if _, found := syncRevsFilterCloudletExcludes[name]; found && s.filterCloudletKeys {
+			continue
+		}
+		if rev > sendAllRev {
+			// The client has data newer than the server's
+			// caches, so the history between them is unknown.
+			log.SpanLog(ctx, log.DebugLevelNotify, "Client revision is ahead of server, sending all data", "name", name, "rev", rev, "sendAllRev", sendAllRev)
+			return
+		}
+		syncRevs[name] = rev
+		if minRev == 0 || rev < minRev {
+			minRev = rev
+		}
+	}
+	if len(syncRevs) == 0 {
+		return
+	}
+	sends := make(map[string]NotifySend)
+	for _, send := range s.sendlist {
+		sends[send.GetName()] = send
+	}
+	deletes := []syncDelete{}
+	err := history.DeletedSince(ctx, minRev, sendAllRev, func(typ string, prevVal []byte, modRev int64) {
+		send, found := sends[typ]
+		if !found {
+			return
+		}
+		rev, found := syncRevs[send.GetMessageName()]
+		if !found || modRev <= rev {
+			return
+		}
+		deletes = append(deletes, syncDelete{
+			send:    send,
+			prevVal: prevVal,
+			modRev:  modRev,
+		})
+	})
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Unable to get deleted objects for incremental sync, sending all data", "rev", minRev, "err", err)
+		return
+	}
+	for _, del := range deletes {
+		del.send.AddSyncDelete(ctx, del.prevVal, del.modRev)
+	}
+	log.SpanLog(ctx, log.DebugLevelNotify, "Incremental sync", "syncRevs", syncRevs, "sendAllRev", sendAllRev, "numDeleted", len(deletes))
+	s.mux.Lock()
+	s.sendSyncRevs = syncRevs
+	s.mux.Unlock()
+}
+
+// getSendSyncRevs gets the revisions of objects that will only
+// have changes sent.
+func (s *SendRecv) getSendSyncRevs() map[string]int64 {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	revs := make(map[string]int64)
+	for name, rev := range s.sendSyncRevs {
+		revs[name] = rev
+	}
+	return revs
+}
+
+// isSendSynced checks if the remote peer already has the object
+// as part of an incremental sync.
+func (s *SendRecv) isSendSynced(name string, modRev int64) bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	rev, found := s.sendSyncRevs[name]
+	return found && modRev <= rev
+}
+
+func (s *SendRecv) isSendSyncing() bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return len(s.sendSyncRevs) > 0
+}
+
+// sendSyncDone is called once the send all end has been sent.
+func (s *SendRecv) sendSyncDone() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.sendSyncRevs = nil
+}
+
+// getRecvSyncRevs gets the revisions to advertise to the server.
+func (s *SendRecv) getRecvSyncRevs() map[string]int64 {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	revs := make(map[string]int64)
+	for name, rev := range s.recvSyncRevs {
+		if rev > 0 {
+			revs[name] = rev
+		}
+	}
+	return revs
+}
+
+// setRecvIncremental sets the objects that the server will only
+// send changes for, based on the negotiated revisions.
+func (s *SendRecv) setRecvIncremental(syncRevs map[string]int64) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.recvIncremental = make(map[string]struct{})
+	for name := range syncRevs {
+		s.recvIncremental[name] = struct{}{}
+	}
+}
+
+// getRecvAllEndCleanup gets the cleanup for the end of the send all.
+// Incrementally synced objects must not be pruned because only
+// changed objects were sent, deleted objects were sent explicitly.
+func (s *SendRecv) getRecvAllEndCleanup(name string, cleanup Cleanup) Cleanup {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if _, found := s.recvIncremental[name]; found {
+		return CleanupNone
+	}
+	return cleanup
+}
+
+// recvSyncDone is called after the send all end is received, to
+// track the revision the received data is now in sync with.
+func (s *SendRecv) recvSyncDone(rev int64) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.recvIncremental = nil
+	if !s.syncRevsOk {
+		rev = 0
+	}
+	for name := range s.recvmap {
+		s.recvSyncRevs[name] = rev
+	}
+}
+
+func (s *SendRecv) resetRecvSyncRevs() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.recvSyncRevs = make(map[string]int64)
+	s.recvIncremental = nil
+}
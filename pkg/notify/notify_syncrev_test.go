@@ -0,0 +1,200 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/test/testutil"
+	proto "github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type testSyncDeleted struct {
+	typ     string
+	prevVal []byte
+	modRev  int64
+}
+
+type testSyncHistory struct {
+	rev        int64
+	compactRev int64
+	deleted    []testSyncDeleted
+	mux        sync.Mutex
+}
+
+func (s *testSyncHistory) GetSyncRev() int64 {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return s.rev
+}
+
+func (s *testSyncHistory) DeletedSince(ctx context.Context, rev, endRev int64, cb func(typ string, prevVal []byte, modRev int64)) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if rev < s.compactRev {
+		return errors.New("compacted")
+	}
+	for _, del := range s.deleted {
+		if del.modRev > rev && del.modRev <= endRev {
+			cb(del.typ, del.prevVal, del.modRev)
+		}
+	}
+	return nil
+}
+
+func TestNotifySyncRevs(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelNotify)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	NotifyRetryTime = 10 * time.Millisecond
+
+	addr := "127.0.0.1:61236"
+	serverAddrs := []string{addr}
+
+	history := &testSyncHistory{}
+	serverHandler := NewDummyHandler()
+	serverMgr := ServerMgr{}
+	serverHandler.RegisterServer(&serverMgr)
+	serverMgr.SetSyncHistory(history)
+
+	apps := testutil.AppData()
+	var rev int64
+	updateApp := func(app *edgeproto.App) {
+		history.mux.Lock()
+		defer history.mux.Unlock()
+		rev++
+		serverHandler.AppCache.Update(ctx, app, rev)
+		history.rev = rev
+	}
+	deleteApp := func(app *edgeproto.App, recordHistory bool) {
+		history.mux.Lock()
+		defer history.mux.Unlock()
+		rev++
+		serverHandler.AppCache.Delete(ctx, app, rev)
+		if recordHistory {
+			val, err := json.Marshal(app)
+			require.Nil(t, err)
+			history.deleted = append(history.deleted, testSyncDeleted{
+				typ:     "App",
+				prevVal: val,
+				modRev:  rev,
+			})
+		}
+		history.rev = rev
+	}
+	for ii := 0; ii < 5; ii++ {
+		updateApp(&apps[ii])
+	}
+	serverMgr.Start("ctrl", addr, nil)
+
+	dmeHandler := NewDummyHandler()
+	clientDME := NewClient("dme", serverAddrs, grpc.WithInsecure())
+	dmeHandler.RegisterDMEClient(clientDME)
+	clientDME.Start()
+	defer clientDME.Stop()
+
+	appName := proto.MessageName((*edgeproto.App)(nil))
+
+	// initial connect sends all data
+	require.Nil(t, clientDME.WaitForConnect(1))
+	require.Nil(t, clientDME.WaitForSendAllEnd(1))
+	require.Nil(t, dmeHandler.WaitForApps(5))
+	stats := serverMgr.GetStats(clientDME.GetLocalAddr())
+	require.Equal(t, uint64(5), stats.ObjSend["App"])
+	require.Equal(t, int64(5), clientDME.sendrecv.getRecvSyncRevs()[appName])
+
+	// change data while disconnected
+	serverMgr.Stop()
+	updated := apps[0]
+	updated.ImagePath = "updated-image"
+	updateApp(&updated)
+	deleteApp(&apps[1], true)
+	updateApp(&apps[5])
+
+	// reconnect only sends changes, and deletes are not pruned
+	serverMgr.Start("ctrl", addr, nil)
+	require.Nil(t, clientDME.WaitForConnect(2))
+	require.Nil(t, clientDME.WaitForSendAllEnd(2))
+	require.Nil(t, dmeHandler.WaitForApps(5))
+	stats = serverMgr.GetStats(clientDME.GetLocalAddr())
+	require.Equal(t, uint64(3), stats.ObjSend["App"])
+	require.Equal(t, int64(8), clientDME.sendrecv.getRecvSyncRevs()[appName])
+	check := edgeproto.App{}
+	require.True(t, dmeHandler.AppCache.Get(&apps[0].Key, &check))
+	require.Equal(t, "updated-image", check.ImagePath)
+	require.False(t, dmeHandler.AppCache.HasKey(&apps[1].Key))
+	require.True(t, dmeHandler.AppCache.HasKey(&apps[5].Key))
+
+	// history has been compacted, all data is sent and pruned
+	serverMgr.Stop()
+	deleteApp(&apps[2], false)
+	history.mux.Lock()
+	history.compactRev = rev
+	history.mux.Unlock()
+	serverMgr.Start("ctrl", addr, nil)
+	require.Nil(t, clientDME.WaitForConnect(3))
+	require.Nil(t, clientDME.WaitForSendAllEnd(3))
+	require.Nil(t, dmeHandler.WaitForApps(4))
+	stats = serverMgr.GetStats(clientDME.GetLocalAddr())
+	require.Equal(t, uint64(4), stats.ObjSend["App"])
+	require.False(t, dmeHandler.AppCache.HasKey(&apps[2].Key))
+	require.Equal(t, int64(9), clientDME.sendrecv.getRecvSyncRevs()[appName])
+
+	// restarting the client does not use the old revisions
+	clientDME.Stop()
+	clientDME.Start()
+	require.Nil(t, clientDME.WaitForConnect(4))
+	require.Nil(t, clientDME.WaitForSendAllEnd(4))
+	stats = serverMgr.GetStats(clientDME.GetLocalAddr())
+	require.Equal(t, uint64(4), stats.ObjSend["App"])
+
+	// server behind the client, i.e. restarted without knowing
+	// of a delete, sends all data and prunes
+	serverMgr.Stop()
+	deleteApp(&apps[3], false)
+	history.mux.Lock()
+	history.rev = 8
+	history.mux.Unlock()
+	serverMgr.Start("ctrl", addr, nil)
+	require.Nil(t, clientDME.WaitForConnect(5))
+	require.Nil(t, clientDME.WaitForSendAllEnd(5))
+	require.Nil(t, dmeHandler.WaitForApps(3))
+	stats = serverMgr.GetStats(clientDME.GetLocalAddr())
+	require.Equal(t, uint64(3), stats.ObjSend["App"])
+	require.False(t, dmeHandler.AppCache.HasKey(&apps[3].Key))
+	require.Equal(t, int64(8), clientDME.sendrecv.getRecvSyncRevs()[appName])
+
+	// server without history does not send revisions
+	serverMgr.Stop()
+	serverMgr.SetSyncHistory(nil)
+	serverMgr.Start("ctrl", addr, nil)
+	require.Nil(t, clientDME.WaitForConnect(6))
+	require.Nil(t, clientDME.WaitForSendAllEnd(6))
+	stats = serverMgr.GetStats(clientDME.GetLocalAddr())
+	require.Equal(t, uint64(3), stats.ObjSend["App"])
+	require.Equal(t, 0, len(clientDME.sendrecv.getRecvSyncRevs()))
+	serverMgr.Stop()
+}
@@ -5,6 +5,7 @@ package notify
 
 import (
 	"context"
+	"encoding/json"
 	fmt "fmt"
 	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
@@ -49,6 +50,7 @@ type OperatorCodeSend struct {
 	handler     SendOperatorCodeHandler
 	Keys        map[edgeproto.OperatorCodeKey]OperatorCodeSendContext
 	keysToSend  map[edgeproto.OperatorCodeKey]OperatorCodeSendContext
+	syncDeletes map[edgeproto.OperatorCodeKey]OperatorCodeSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.OperatorCode
@@ -62,6 +64,11 @@ type OperatorCodeSendContext struct {
 	forceDelete bool
 }
 
+type OperatorCodeSyncDelete struct {
+	obj    *edgeproto.OperatorCode
+	modRev int64
+}
+
 func NewOperatorCodeSend(handler SendOperatorCodeHandler) *OperatorCodeSend {
 	send := &OperatorCodeSend{}
 	send.Name = "OperatorCode"
@@ -96,6 +103,9 @@ func (s *OperatorCodeSend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.OperatorCode, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = OperatorCodeSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -104,6 +114,64 @@ func (s *OperatorCodeSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *OperatorCodeSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.OperatorCode{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted OperatorCode", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.OperatorCodeKey]OperatorCodeSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = OperatorCodeSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *OperatorCodeSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.OperatorCodeKey]OperatorCodeSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.OperatorCode
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = OperatorCodeSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *OperatorCodeSend) Update(ctx context.Context, obj *edgeproto.OperatorCode, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -5,6 +5,7 @@ package notify
 
 import (
 	"context"
+	"encoding/json"
 	fmt "fmt"
 	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
@@ -49,6 +50,7 @@ type FlowRateLimitSettingsSend struct {
 	handler     SendFlowRateLimitSettingsHandler
 	Keys        map[edgeproto.FlowRateLimitSettingsKey]FlowRateLimitSettingsSendContext
 	keysToSend  map[edgeproto.FlowRateLimitSettingsKey]FlowRateLimitSettingsSendContext
+	syncDeletes map[edgeproto.FlowRateLimitSettingsKey]FlowRateLimitSettingsSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.FlowRateLimitSettings
@@ -62,6 +64,11 @@ type FlowRateLimitSettingsSendContext struct {
 	forceDelete bool
 }
 
+type FlowRateLimitSettingsSyncDelete struct {
+	obj    *edgeproto.FlowRateLimitSettings
+	modRev int64
+}
+
 func NewFlowRateLimitSettingsSend(handler SendFlowRateLimitSettingsHandler) *FlowRateLimitSettingsSend {
 	send := &FlowRateLimitSettingsSend{}
 	send.Name = "FlowRateLimitSettings"
@@ -96,6 +103,9 @@ func (s *FlowRateLimitSettingsSend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.FlowRateLimitSettings, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = FlowRateLimitSettingsSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -104,6 +114,64 @@ func (s *FlowRateLimitSettingsSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *FlowRateLimitSettingsSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.FlowRateLimitSettings{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted FlowRateLimitSettings", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.FlowRateLimitSettingsKey]FlowRateLimitSettingsSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = FlowRateLimitSettingsSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *FlowRateLimitSettingsSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.FlowRateLimitSettingsKey]FlowRateLimitSettingsSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.FlowRateLimitSettings
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = FlowRateLimitSettingsSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *FlowRateLimitSettingsSend) Update(ctx context.Context, obj *edgeproto.FlowRateLimitSettings, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -393,6 +461,7 @@ type MaxReqsRateLimitSettingsSend struct {
 	handler     SendMaxReqsRateLimitSettingsHandler
 	Keys        map[edgeproto.MaxReqsRateLimitSettingsKey]MaxReqsRateLimitSettingsSendContext
 	keysToSend  map[edgeproto.MaxReqsRateLimitSettingsKey]MaxReqsRateLimitSettingsSendContext
+	syncDeletes map[edgeproto.MaxReqsRateLimitSettingsKey]MaxReqsRateLimitSettingsSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.MaxReqsRateLimitSettings
@@ -406,6 +475,11 @@ type MaxReqsRateLimitSettingsSendContext struct {
 	forceDelete bool
 }
 
+type MaxReqsRateLimitSettingsSyncDelete struct {
+	obj    *edgeproto.MaxReqsRateLimitSettings
+	modRev int64
+}
+
 func NewMaxReqsRateLimitSettingsSend(handler SendMaxReqsRateLimitSettingsHandler) *MaxReqsRateLimitSettingsSend {
 	send := &MaxReqsRateLimitSettingsSend{}
 	send.Name = "MaxReqsRateLimitSettings"
@@ -440,6 +514,9 @@ func (s *MaxReqsRateLimitSettingsSend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.MaxReqsRateLimitSettings, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = MaxReqsRateLimitSettingsSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -448,6 +525,64 @@ func (s *MaxReqsRateLimitSettingsSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *MaxReqsRateLimitSettingsSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.MaxReqsRateLimitSettings{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted MaxReqsRateLimitSettings", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.MaxReqsRateLimitSettingsKey]MaxReqsRateLimitSettingsSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = MaxReqsRateLimitSettingsSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *MaxReqsRateLimitSettingsSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.MaxReqsRateLimitSettingsKey]MaxReqsRateLimitSettingsSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.MaxReqsRateLimitSettings
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = MaxReqsRateLimitSettingsSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *MaxReqsRateLimitSettingsSend) Update(ctx context.Context, obj *edgeproto.MaxReqsRateLimitSettings, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
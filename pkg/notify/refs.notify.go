@@ -5,6 +5,7 @@ package notify
 
 import (
 	"context"
+	"encoding/json"
 	fmt "fmt"
 	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
@@ -48,6 +49,7 @@ type ClusterRefsSend struct {
 	handler     SendClusterRefsHandler
 	Keys        map[edgeproto.ClusterKey]ClusterRefsSendContext
 	keysToSend  map[edgeproto.ClusterKey]ClusterRefsSendContext
+	syncDeletes map[edgeproto.ClusterKey]ClusterRefsSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.ClusterRefs
@@ -61,6 +63,11 @@ type ClusterRefsSendContext struct {
 	forceDelete bool
 }
 
+type ClusterRefsSyncDelete struct {
+	obj    *edgeproto.ClusterRefs
+	modRev int64
+}
+
 func NewClusterRefsSend(handler SendClusterRefsHandler) *ClusterRefsSend {
 	send := &ClusterRefsSend{}
 	send.Name = "ClusterRefs"
@@ -95,6 +102,9 @@ func (s *ClusterRefsSend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.ClusterRefs, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = ClusterRefsSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -103,6 +113,64 @@ func (s *ClusterRefsSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *ClusterRefsSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.ClusterRefs{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted ClusterRefs", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.ClusterKey]ClusterRefsSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = ClusterRefsSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *ClusterRefsSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.ClusterKey]ClusterRefsSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.ClusterRefs
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = ClusterRefsSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *ClusterRefsSend) Update(ctx context.Context, obj *edgeproto.ClusterRefs, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -392,6 +460,7 @@ type AppInstRefsSend struct {
 	handler     SendAppInstRefsHandler
 	Keys        map[edgeproto.AppKey]AppInstRefsSendContext
 	keysToSend  map[edgeproto.AppKey]AppInstRefsSendContext
+	syncDeletes map[edgeproto.AppKey]AppInstRefsSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.AppInstRefs
@@ -405,6 +474,11 @@ type AppInstRefsSendContext struct {
 	forceDelete bool
 }
 
+type AppInstRefsSyncDelete struct {
+	obj    *edgeproto.AppInstRefs
+	modRev int64
+}
+
 func NewAppInstRefsSend(handler SendAppInstRefsHandler) *AppInstRefsSend {
 	send := &AppInstRefsSend{}
 	send.Name = "AppInstRefs"
@@ -439,6 +513,9 @@ func (s *AppInstRefsSend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.AppInstRefs, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = AppInstRefsSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -447,6 +524,64 @@ func (s *AppInstRefsSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *AppInstRefsSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.AppInstRefs{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted AppInstRefs", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.AppKey]AppInstRefsSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = AppInstRefsSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *AppInstRefsSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.AppKey]AppInstRefsSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.AppInstRefs
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = AppInstRefsSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *AppInstRefsSend) Update(ctx context.Context, obj *edgeproto.AppInstRefs, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -5,6 +5,7 @@ package notify
 
 import (
 	"context"
+	"encoding/json"
 	fmt "fmt"
 	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
@@ -49,6 +50,7 @@ type ResTagTableSend struct {
 	handler     SendResTagTableHandler
 	Keys        map[edgeproto.ResTagTableKey]ResTagTableSendContext
 	keysToSend  map[edgeproto.ResTagTableKey]ResTagTableSendContext
+	syncDeletes map[edgeproto.ResTagTableKey]ResTagTableSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.ResTagTable
@@ -62,6 +64,11 @@ type ResTagTableSendContext struct {
 	forceDelete bool
 }
 
+type ResTagTableSyncDelete struct {
+	obj    *edgeproto.ResTagTable
+	modRev int64
+}
+
 func NewResTagTableSend(handler SendResTagTableHandler) *ResTagTableSend {
 	send := &ResTagTableSend{}
 	send.Name = "ResTagTable"
@@ -96,6 +103,9 @@ func (s *ResTagTableSend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.ResTagTable, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = ResTagTableSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -104,6 +114,64 @@ func (s *ResTagTableSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *ResTagTableSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.ResTagTable{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted ResTagTable", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.ResTagTableKey]ResTagTableSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = ResTagTableSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *ResTagTableSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.ResTagTableKey]ResTagTableSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.ResTagTable
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = ResTagTableSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *ResTagTableSend) Update(ctx context.Context, obj *edgeproto.ResTagTable, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
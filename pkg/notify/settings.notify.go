@@ -5,6 +5,7 @@ package notify
 
 import (
 	"context"
+	"encoding/json"
 	fmt "fmt"
 	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
@@ -49,6 +50,7 @@ type SettingsSend struct {
 	handler     SendSettingsHandler
 	Keys        map[edgeproto.SettingsKey]SettingsSendContext
 	keysToSend  map[edgeproto.SettingsKey]SettingsSendContext
+	syncDeletes map[edgeproto.SettingsKey]SettingsSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.Settings
@@ -62,6 +64,11 @@ type SettingsSendContext struct {
 	forceDelete bool
 }
 
+type SettingsSyncDelete struct {
+	obj    *edgeproto.Settings
+	modRev int64
+}
+
 func NewSettingsSend(handler SendSettingsHandler) *SettingsSend {
 	send := &SettingsSend{}
 	send.Name = "Settings"
@@ -96,6 +103,9 @@ func (s *SettingsSend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.Settings, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = SettingsSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -104,6 +114,64 @@ func (s *SettingsSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *SettingsSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.Settings{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted Settings", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.SettingsKey]SettingsSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = SettingsSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *SettingsSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.SettingsKey]SettingsSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.Settings
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = SettingsSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *SettingsSend) Update(ctx context.Context, obj *edgeproto.Settings, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -5,6 +5,7 @@ package notify
 
 import (
 	"context"
+	"encoding/json"
 	fmt "fmt"
 	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
@@ -49,6 +50,7 @@ type SvcNodeSend struct {
 	handler     SendSvcNodeHandler
 	Keys        map[edgeproto.SvcNodeKey]SvcNodeSendContext
 	keysToSend  map[edgeproto.SvcNodeKey]SvcNodeSendContext
+	syncDeletes map[edgeproto.SvcNodeKey]SvcNodeSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.SvcNode
@@ -62,6 +64,11 @@ type SvcNodeSendContext struct {
 	forceDelete bool
 }
 
+type SvcNodeSyncDelete struct {
+	obj    *edgeproto.SvcNode
+	modRev int64
+}
+
 func NewSvcNodeSend(handler SendSvcNodeHandler) *SvcNodeSend {
 	send := &SvcNodeSend{}
 	send.Name = "SvcNode"
@@ -96,6 +103,9 @@ func (s *SvcNodeSend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.SvcNode, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = SvcNodeSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -104,6 +114,64 @@ func (s *SvcNodeSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *SvcNodeSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.SvcNode{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted SvcNode", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.SvcNodeKey]SvcNodeSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = SvcNodeSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *SvcNodeSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.SvcNodeKey]SvcNodeSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.SvcNode
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = SvcNodeSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *SvcNodeSend) Update(ctx context.Context, obj *edgeproto.SvcNode, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
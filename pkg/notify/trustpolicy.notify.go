@@ -5,6 +5,7 @@ package notify
 
 import (
 	"context"
+	"encoding/json"
 	fmt "fmt"
 	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
@@ -49,6 +50,7 @@ type TrustPolicySend struct {
 	handler     SendTrustPolicyHandler
 	Keys        map[edgeproto.PolicyKey]TrustPolicySendContext
 	keysToSend  map[edgeproto.PolicyKey]TrustPolicySendContext
+	syncDeletes map[edgeproto.PolicyKey]TrustPolicySyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.TrustPolicy
@@ -62,6 +64,11 @@ type TrustPolicySendContext struct {
 	forceDelete bool
 }
 
+type TrustPolicySyncDelete struct {
+	obj    *edgeproto.TrustPolicy
+	modRev int64
+}
+
 func NewTrustPolicySend(handler SendTrustPolicyHandler) *TrustPolicySend {
 	send := &TrustPolicySend{}
 	send.Name = "TrustPolicy"
@@ -96,6 +103,9 @@ func (s *TrustPolicySend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.TrustPolicy, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = TrustPolicySendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -104,6 +114,64 @@ func (s *TrustPolicySend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *TrustPolicySend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.TrustPolicy{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted TrustPolicy", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.PolicyKey]TrustPolicySyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = TrustPolicySyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *TrustPolicySend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.PolicyKey]TrustPolicySyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.TrustPolicy
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = TrustPolicySendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *TrustPolicySend) Update(ctx context.Context, obj *edgeproto.TrustPolicy, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
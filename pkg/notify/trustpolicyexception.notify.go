@@ -5,6 +5,7 @@ package notify
 
 import (
 	"context"
+	"encoding/json"
 	fmt "fmt"
 	_ "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
 	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
@@ -51,6 +52,7 @@ type TrustPolicyExceptionSend struct {
 	handler     SendTrustPolicyExceptionHandler
 	Keys        map[edgeproto.TrustPolicyExceptionKey]TrustPolicyExceptionSendContext
 	keysToSend  map[edgeproto.TrustPolicyExceptionKey]TrustPolicyExceptionSendContext
+	syncDeletes map[edgeproto.TrustPolicyExceptionKey]TrustPolicyExceptionSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.TrustPolicyException
@@ -64,6 +66,11 @@ type TrustPolicyExceptionSendContext struct {
 	forceDelete bool
 }
 
+type TrustPolicyExceptionSyncDelete struct {
+	obj    *edgeproto.TrustPolicyException
+	modRev int64
+}
+
 func NewTrustPolicyExceptionSend(handler SendTrustPolicyExceptionHandler) *TrustPolicyExceptionSend {
 	send := &TrustPolicyExceptionSend{}
 	send.Name = "TrustPolicyException"
@@ -101,6 +108,9 @@ func (s *TrustPolicyExceptionSend) UpdateAll(ctx context.Context) {
 		if !s.UpdateAllOkLocked(obj) { // to be implemented by hand
 			return
 		}
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = TrustPolicyExceptionSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -109,6 +119,67 @@ func (s *TrustPolicyExceptionSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *TrustPolicyExceptionSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.TrustPolicyException{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted TrustPolicyException", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.TrustPolicyExceptionKey]TrustPolicyExceptionSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = TrustPolicyExceptionSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *TrustPolicyExceptionSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.TrustPolicyExceptionKey]TrustPolicyExceptionSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.TrustPolicyException
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		if !s.UpdateOk(ctx, del.obj) { // to be implemented by hand
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = TrustPolicyExceptionSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *TrustPolicyExceptionSend) Update(ctx context.Context, obj *edgeproto.TrustPolicyException, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -147,6 +218,9 @@ func (s *TrustPolicyExceptionSend) SendForCloudlet(ctx context.Context, action e
 	})
 	for k, data := range keys {
 		if action == edgeproto.NoticeAction_UPDATE {
+			if s.sendrecv.isSendSynced(s.MessageName, data.ModRev) {
+				continue
+			}
 			s.Update(ctx, data.Obj, data.ModRev)
 		} else if action == edgeproto.NoticeAction_DELETE {
 			s.ForceDelete(ctx, &k, data.ModRev)
@@ -416,6 +490,7 @@ type TPEInstanceStateSend struct {
 	handler     SendTPEInstanceStateHandler
 	Keys        map[edgeproto.TPEInstanceKey]TPEInstanceStateSendContext
 	keysToSend  map[edgeproto.TPEInstanceKey]TPEInstanceStateSendContext
+	syncDeletes map[edgeproto.TPEInstanceKey]TPEInstanceStateSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.TPEInstanceState
@@ -429,6 +504,11 @@ type TPEInstanceStateSendContext struct {
 	forceDelete bool
 }
 
+type TPEInstanceStateSyncDelete struct {
+	obj    *edgeproto.TPEInstanceState
+	modRev int64
+}
+
 func NewTPEInstanceStateSend(handler SendTPEInstanceStateHandler) *TPEInstanceStateSend {
 	send := &TPEInstanceStateSend{}
 	send.Name = "TPEInstanceState"
@@ -466,6 +546,9 @@ func (s *TPEInstanceStateSend) UpdateAll(ctx context.Context) {
 		if !s.UpdateAllOkLocked(obj) { // to be implemented by hand
 			return
 		}
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = TPEInstanceStateSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -474,6 +557,67 @@ func (s *TPEInstanceStateSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *TPEInstanceStateSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.TPEInstanceState{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted TPEInstanceState", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.TPEInstanceKey]TPEInstanceStateSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = TPEInstanceStateSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *TPEInstanceStateSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.TPEInstanceKey]TPEInstanceStateSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.TPEInstanceState
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		if !s.UpdateOk(ctx, del.obj) { // to be implemented by hand
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = TPEInstanceStateSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *TPEInstanceStateSend) Update(ctx context.Context, obj *edgeproto.TPEInstanceState, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -512,6 +656,9 @@ func (s *TPEInstanceStateSend) SendForCloudlet(ctx context.Context, action edgep
 	})
 	for k, data := range keys {
 		if action == edgeproto.NoticeAction_UPDATE {
+			if s.sendrecv.isSendSynced(s.MessageName, data.ModRev) {
+				continue
+			}
 			s.Update(ctx, data.Obj, data.ModRev)
 		} else if action == edgeproto.NoticeAction_DELETE {
 			s.ForceDelete(ctx, &k, data.ModRev)
@@ -5,6 +5,7 @@ package notify
 
 import (
 	"context"
+	"encoding/json"
 	fmt "fmt"
 	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
@@ -51,6 +52,7 @@ type VMPoolSend struct {
 	handler     SendVMPoolHandler
 	Keys        map[edgeproto.VMPoolKey]VMPoolSendContext
 	keysToSend  map[edgeproto.VMPoolKey]VMPoolSendContext
+	syncDeletes map[edgeproto.VMPoolKey]VMPoolSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.VMPool
@@ -64,6 +66,11 @@ type VMPoolSendContext struct {
 	forceDelete bool
 }
 
+type VMPoolSyncDelete struct {
+	obj    *edgeproto.VMPool
+	modRev int64
+}
+
 func NewVMPoolSend(handler SendVMPoolHandler) *VMPoolSend {
 	send := &VMPoolSend{}
 	send.Name = "VMPool"
@@ -101,6 +108,9 @@ func (s *VMPoolSend) UpdateAll(ctx context.Context) {
 		if !s.UpdateAllOkLocked(obj) { // to be implemented by hand
 			return
 		}
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = VMPoolSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -109,6 +119,67 @@ func (s *VMPoolSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *VMPoolSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.VMPool{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted VMPool", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.VMPoolKey]VMPoolSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = VMPoolSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *VMPoolSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.VMPoolKey]VMPoolSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.VMPool
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		if !s.UpdateOk(ctx, del.obj) { // to be implemented by hand
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = VMPoolSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *VMPoolSend) Update(ctx context.Context, obj *edgeproto.VMPool, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -147,6 +218,9 @@ func (s *VMPoolSend) SendForCloudlet(ctx context.Context, action edgeproto.Notic
 	})
 	for k, data := range keys {
 		if action == edgeproto.NoticeAction_UPDATE {
+			if s.sendrecv.isSendSynced(s.MessageName, data.ModRev) {
+				continue
+			}
 			s.Update(ctx, data.Obj, data.ModRev)
 		} else if action == edgeproto.NoticeAction_DELETE {
 			s.ForceDelete(ctx, &k, data.ModRev)
@@ -415,6 +489,7 @@ type VMPoolInfoSend struct {
 	handler     SendVMPoolInfoHandler
 	Keys        map[edgeproto.VMPoolKey]VMPoolInfoSendContext
 	keysToSend  map[edgeproto.VMPoolKey]VMPoolInfoSendContext
+	syncDeletes map[edgeproto.VMPoolKey]VMPoolInfoSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.VMPoolInfo
@@ -428,6 +503,11 @@ type VMPoolInfoSendContext struct {
 	forceDelete bool
 }
 
+type VMPoolInfoSyncDelete struct {
+	obj    *edgeproto.VMPoolInfo
+	modRev int64
+}
+
 func NewVMPoolInfoSend(handler SendVMPoolInfoHandler) *VMPoolInfoSend {
 	send := &VMPoolInfoSend{}
 	send.Name = "VMPoolInfo"
@@ -462,6 +542,9 @@ func (s *VMPoolInfoSend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.VMPoolInfo, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = VMPoolInfoSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -470,6 +553,64 @@ func (s *VMPoolInfoSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *VMPoolInfoSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.VMPoolInfo{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted VMPoolInfo", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.VMPoolKey]VMPoolInfoSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = VMPoolInfoSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *VMPoolInfoSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.VMPoolKey]VMPoolInfoSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.VMPoolInfo
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = VMPoolInfoSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *VMPoolInfoSend) Update(ctx context.Context, obj *edgeproto.VMPoolInfo, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -5,6 +5,7 @@ package notify
 
 import (
 	"context"
+	"encoding/json"
 	fmt "fmt"
 	_ "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
 	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
@@ -50,6 +51,7 @@ type ZoneSend struct {
 	handler     SendZoneHandler
 	Keys        map[edgeproto.ZoneKey]ZoneSendContext
 	keysToSend  map[edgeproto.ZoneKey]ZoneSendContext
+	syncDeletes map[edgeproto.ZoneKey]ZoneSyncDelete
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.Zone
@@ -63,6 +65,11 @@ type ZoneSendContext struct {
 	forceDelete bool
 }
 
+type ZoneSyncDelete struct {
+	obj    *edgeproto.Zone
+	modRev int64
+}
+
 func NewZoneSend(handler SendZoneHandler) *ZoneSend {
 	send := &ZoneSend{}
 	send.Name = "Zone"
@@ -97,6 +104,9 @@ func (s *ZoneSend) UpdateAll(ctx context.Context) {
 	}
 	s.Mux.Lock()
 	s.handler.GetAllLocked(ctx, func(obj *edgeproto.Zone, modRev int64) {
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = ZoneSendContext{
 			ctx:    ctx,
 			modRev: modRev,
@@ -105,6 +115,64 @@ func (s *ZoneSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *ZoneSend) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &edgeproto.Zone{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted Zone", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[edgeproto.ZoneKey]ZoneSyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = ZoneSyncDelete{
+		obj:    obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *ZoneSend) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[edgeproto.ZoneKey]ZoneSyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf edgeproto.Zone
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = ZoneSendContext{
+				ctx:         ctx,
+				modRev:      del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *ZoneSend) Update(ctx context.Context, obj *edgeproto.Zone, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -0,0 +1,288 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package notifyagg implements a notify aggregation node, which sits
+// between the Controller and site CRMs/DMEs in the notify tree.
+// It keeps a single connection to the Controller, mirrors the data
+// the Controller sends, and fans that data out to the nodes connected
+// below it, filtered by cloudlet key for CRMs. In the other direction,
+// info objects, alerts, and messages like metrics from the nodes below
+// are aggregated and forwarded to the Controller. This keeps the
+// number of connections to the Controller bounded regardless of the
+// number of cloudlets in the region.
+//
+// Like the Controller, the aggregator verifies the access keys of
+// CRMs connecting to it, using the CRM access public keys of the
+// Cloudlets mirrored from the Controller.
+package notifyagg
+
+import (
+	"context"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon/svcnode"
+	"github.com/edgexr/edge-cloud-platform/pkg/notify"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+)
+
+// Aggregator holds the mirrored data and the message relays
+// for an aggregation node.
+type Aggregator struct {
+	// FilterByCloudletKey requests only data for the cloudlets of
+	// the CRMs connected below from the Controller. This reduces
+	// the data mirrored by the aggregator, but means DMEs which
+	// require all data for the region cannot connect to it.
+	FilterByCloudletKey bool
+
+	// Caches received from the Controller and sent down
+	SettingsCache                 edgeproto.SettingsCache
+	FlowRateLimitSettingsCache    edgeproto.FlowRateLimitSettingsCache
+	MaxReqsRateLimitSettingsCache edgeproto.MaxReqsRateLimitSettingsCache
+	OperatorCodeCache             edgeproto.OperatorCodeCache
+	FlavorCache                   edgeproto.FlavorCache
+	AlertPolicyCache              edgeproto.AlertPolicyCache
+	GPUDriverCache                edgeproto.GPUDriverCache
+	VMPoolCache                   edgeproto.VMPoolCache
+	ResTagTableCache              edgeproto.ResTagTableCache
+	TrustPolicyCache              edgeproto.TrustPolicyCache
+	ZoneCache                     edgeproto.ZoneCache
+	CloudletCache                 edgeproto.CloudletCache
+	CloudletInfoCache             edgeproto.CloudletInfoCache
+	AutoScalePolicyCache          edgeproto.AutoScalePolicyCache
+	AutoProvPolicyCache           edgeproto.AutoProvPolicyCache
+	GeoFencePolicyCache           edgeproto.GeoFencePolicyCache
+	NetworkCache                  edgeproto.NetworkCache
+	ClusterInstCache              edgeproto.ClusterInstCache
+	AppCache                      edgeproto.AppCache
+	AppInstCache                  edgeproto.AppInstCache
+	AppInstClientKeyCache         edgeproto.AppInstClientKeyCache
+	TrustPolicyExceptionCache     edgeproto.TrustPolicyExceptionCache
+	TPEInstanceStateCache         edgeproto.TPEInstanceStateCache
+
+	// Caches received from nodes below and sent up. CloudletInfo
+	// is separate from the cache sent down because the Controller
+	// sends the CloudletInfos for the whole region to DMEs.
+	SiteCloudletInfoCache edgeproto.CloudletInfoCache
+	AppInstInfoCache      edgeproto.AppInstInfoCache
+	ClusterInstInfoCache  edgeproto.ClusterInstInfoCache
+	VMPoolInfoCache       edgeproto.VMPoolInfoCache
+	AlertCache            edgeproto.AlertCache
+	DeviceCache           edgeproto.DeviceCache
+
+	// Verifies CRM access keys against the mirrored Cloudlets
+	accessKeyServer *svcnode.AccessKeyServer
+
+	// Message relays
+	execRequestSendUp   *notify.ExecRequestSend
+	execRequestSendDown *notify.ExecRequestSendMany
+	metricSend          *notify.MetricSend
+	autoProvCountsSend  *notify.AutoProvCountsSend
+	appInstClientSend   *notify.AppInstClientSend
+}
+
+func NewAggregator() *Aggregator {
+	s := &Aggregator{}
+	edgeproto.InitSettingsCache(&s.SettingsCache)
+	edgeproto.InitFlowRateLimitSettingsCache(&s.FlowRateLimitSettingsCache)
+	edgeproto.InitMaxReqsRateLimitSettingsCache(&s.MaxReqsRateLimitSettingsCache)
+	edgeproto.InitOperatorCodeCache(&s.OperatorCodeCache)
+	edgeproto.InitFlavorCache(&s.FlavorCache)
+	edgeproto.InitAlertPolicyCache(&s.AlertPolicyCache)
+	edgeproto.InitGPUDriverCache(&s.GPUDriverCache)
+	edgeproto.InitVMPoolCache(&s.VMPoolCache)
+	edgeproto.InitResTagTableCache(&s.ResTagTableCache)
+	edgeproto.InitTrustPolicyCache(&s.TrustPolicyCache)
+	edgeproto.InitZoneCache(&s.ZoneCache)
+	edgeproto.InitCloudletCache(&s.CloudletCache)
+	edgeproto.InitCloudletInfoCache(&s.CloudletInfoCache)
+	edgeproto.InitAutoScalePolicyCache(&s.AutoScalePolicyCache)
+	edgeproto.InitAutoProvPolicyCache(&s.AutoProvPolicyCache)
+	edgeproto.InitGeoFencePolicyCache(&s.GeoFencePolicyCache)
+	edgeproto.InitNetworkCache(&s.NetworkCache)
+	edgeproto.InitClusterInstCache(&s.ClusterInstCache)
+	edgeproto.InitAppCache(&s.AppCache)
+	edgeproto.InitAppInstCache(&s.AppInstCache)
+	edgeproto.InitAppInstClientKeyCache(&s.AppInstClientKeyCache)
+	edgeproto.InitTrustPolicyExceptionCache(&s.TrustPolicyExceptionCache)
+	edgeproto.InitTPEInstanceStateCache(&s.TPEInstanceStateCache)
+
+	edgeproto.InitCloudletInfoCache(&s.SiteCloudletInfoCache)
+	edgeproto.InitAppInstInfoCache(&s.AppInstInfoCache)
+	edgeproto.InitClusterInstInfoCache(&s.ClusterInstInfoCache)
+	edgeproto.InitVMPoolInfoCache(&s.VMPoolInfoCache)
+	edgeproto.InitAlertCache(&s.AlertCache)
+	edgeproto.InitDeviceCache(&s.DeviceCache)
+
+	// Vault credentials are only used to upgrade access keys,
+	// which is done directly with the Controller.
+	s.accessKeyServer = svcnode.NewAccessKeyServer(&s.CloudletCache, "")
+
+	s.execRequestSendUp = notify.NewExecRequestSend()
+	s.execRequestSendDown = notify.NewExecRequestSendMany()
+	s.metricSend = notify.NewMetricSend()
+	s.autoProvCountsSend = notify.NewAutoProvCountsSend()
+	s.appInstClientSend = notify.NewAppInstClientSend()
+	return s
+}
+
+// RegisterClient registers the aggregator with the notify client
+// connected to the Controller.
+func (s *Aggregator) RegisterClient(client *notify.Client) {
+	if s.FilterByCloudletKey {
+		client.SetFilterByCloudletKey()
+	}
+	client.RegisterRecvSettingsCache(&s.SettingsCache)
+	client.RegisterRecvFlowRateLimitSettingsCache(&s.FlowRateLimitSettingsCache)
+	client.RegisterRecvMaxReqsRateLimitSettingsCache(&s.MaxReqsRateLimitSettingsCache)
+	client.RegisterRecvOperatorCodeCache(&s.OperatorCodeCache)
+	client.RegisterRecvFlavorCache(&s.FlavorCache)
+	client.RegisterRecvAlertPolicyCache(&s.AlertPolicyCache)
+	client.RegisterRecvGPUDriverCache(&s.GPUDriverCache)
+	client.RegisterRecvVMPoolCache(&s.VMPoolCache)
+	client.RegisterRecvResTagTableCache(&s.ResTagTableCache)
+	client.RegisterRecvTrustPolicyCache(&s.TrustPolicyCache)
+	client.RegisterRecvZoneCache(&s.ZoneCache)
+	client.RegisterRecvCloudletCache(&s.CloudletCache)
+	if !s.FilterByCloudletKey {
+		// The CloudletInfo recv hook treats CloudletInfos as coming
+		// from CRMs below when filtering, so only regional data
+		// for DMEs is received when not filtering.
+		client.RegisterRecvCloudletInfoCache(&s.CloudletInfoCache)
+	}
+	client.RegisterRecvAutoScalePolicyCache(&s.AutoScalePolicyCache)
+	client.RegisterRecvAutoProvPolicyCache(&s.AutoProvPolicyCache)
+	client.RegisterRecvGeoFencePolicyCache(&s.GeoFencePolicyCache)
+	client.RegisterRecvNetworkCache(&s.NetworkCache)
+	client.RegisterRecvClusterInstCache(&s.ClusterInstCache)
+	client.RegisterRecvAppCache(&s.AppCache)
+	client.RegisterRecvAppInstCache(&s.AppInstCache)
+	client.RegisterRecvAppInstClientKeyCache(&s.AppInstClientKeyCache)
+	client.RegisterRecvTrustPolicyExceptionCache(&s.TrustPolicyExceptionCache)
+	client.RegisterRecvTPEInstanceStateCache(&s.TPEInstanceStateCache)
+	client.RegisterRecv(notify.NewExecRequestRecv(&execRequestDown{s}))
+
+	client.RegisterSendCloudletInfoCache(&s.SiteCloudletInfoCache)
+	client.RegisterSendAppInstInfoCache(&s.AppInstInfoCache)
+	client.RegisterSendClusterInstInfoCache(&s.ClusterInstInfoCache)
+	client.RegisterSendVMPoolInfoCache(&s.VMPoolInfoCache)
+	client.RegisterSendAlertCache(&s.AlertCache)
+	client.RegisterSendDeviceCache(&s.DeviceCache)
+	client.RegisterSend(s.execRequestSendUp)
+	client.RegisterSend(s.metricSend)
+	client.RegisterSend(s.autoProvCountsSend)
+	client.RegisterSend(s.appInstClientSend)
+}
+
+// RegisterServer registers the aggregator with the notify server
+// that CRMs/DMEs connect to. Send order follows the Controller,
+// as objects must be sent after the objects they depend on.
+func (s *Aggregator) RegisterServer(server *notify.ServerMgr) {
+	server.RegisterSendSettingsCache(&s.SettingsCache)
+	server.RegisterSendFlowRateLimitSettingsCache(&s.FlowRateLimitSettingsCache)
+	server.RegisterSendMaxReqsRateLimitSettingsCache(&s.MaxReqsRateLimitSettingsCache)
+	server.RegisterSendOperatorCodeCache(&s.OperatorCodeCache)
+	server.RegisterSendFlavorCache(&s.FlavorCache)
+	server.RegisterSendAlertPolicyCache(&s.AlertPolicyCache)
+	server.RegisterSendGPUDriverCache(&s.GPUDriverCache)
+	server.RegisterSendVMPoolCache(&s.VMPoolCache)
+	server.RegisterSendResTagTableCache(&s.ResTagTableCache)
+	server.RegisterSendTrustPolicyCache(&s.TrustPolicyCache)
+	server.RegisterSendZoneCache(&s.ZoneCache)
+	server.RegisterSendCloudletCache(&s.CloudletCache)
+	if !s.FilterByCloudletKey {
+		server.RegisterSendCloudletInfoCache(&s.CloudletInfoCache)
+	}
+	server.RegisterSendAutoScalePolicyCache(&s.AutoScalePolicyCache)
+	server.RegisterSendAutoProvPolicyCache(&s.AutoProvPolicyCache)
+	server.RegisterSendGeoFencePolicyCache(&s.GeoFencePolicyCache)
+	server.RegisterSendNetworkCache(&s.NetworkCache)
+	server.RegisterSendClusterInstCache(&s.ClusterInstCache)
+	server.RegisterSendAppCache(&s.AppCache)
+	server.RegisterSendAppInstCache(&s.AppInstCache)
+	server.RegisterSendAppInstClientKeyCache(&s.AppInstClientKeyCache)
+	server.RegisterSendTrustPolicyExceptionCache(&s.TrustPolicyExceptionCache)
+	server.RegisterSendTPEInstanceStateCache(&s.TPEInstanceStateCache)
+	server.RegisterSend(s.execRequestSendDown)
+
+	server.RegisterRecvCloudletInfoCache(&s.SiteCloudletInfoCache)
+	server.RegisterRecvAppInstInfoCache(&s.AppInstInfoCache)
+	server.RegisterRecvClusterInstInfoCache(&s.ClusterInstInfoCache)
+	server.RegisterRecvVMPoolInfoCache(&s.VMPoolInfoCache)
+	server.RegisterRecvAlertCache(&s.AlertCache)
+	server.RegisterRecvDeviceCache(&s.DeviceCache)
+	server.RegisterRecv(notify.NewExecRequestRecvMany(&execRequestUp{s}))
+	server.RegisterRecv(notify.NewMetricRecvMany(s))
+	server.RegisterRecv(notify.NewAutoProvCountsRecvMany(s))
+	server.RegisterRecv(notify.NewAppInstClientRecvMany(s))
+}
+
+// AccessKeyServerOps returns the notify server options to verify
+// the access keys of CRMs connecting to the aggregator, the same as
+// the Controller's notify server. If requireAccessKey is false,
+// CRMs are only authenticated by their regional certificates.
+// Access keys are verified against the mirrored Cloudlets, so all
+// Cloudlets must be mirrored, which is not the case if
+// FilterByCloudletKey is set.
+func (s *Aggregator) AccessKeyServerOps(requireAccessKey bool) []notify.ServerOp {
+	s.accessKeyServer.SetRequireTlsAccessKey(requireAccessKey)
+	unaryInterceptor := grpc.UnaryInterceptor(
+		grpc_middleware.ChainUnaryServer(
+			cloudcommon.AuditUnaryInterceptor,
+			s.accessKeyServer.UnaryTlsAccessKey,
+		))
+	streamInterceptor := grpc.StreamInterceptor(
+		grpc_middleware.ChainStreamServer(
+			cloudcommon.AuditStreamInterceptor,
+			s.accessKeyServer.StreamTlsAccessKey,
+		))
+	return []notify.ServerOp{
+		notify.ServerUnaryInterceptor(unaryInterceptor),
+		notify.ServerStreamInterceptor(streamInterceptor),
+	}
+}
+
+// ExecRequests are relayed in both directions, requests go down
+// to the CRM and replies come back up from the CRM.
+type execRequestDown struct {
+	agg *Aggregator
+}
+
+func (s *execRequestDown) RecvExecRequest(ctx context.Context, msg *edgeproto.ExecRequest) {
+	s.agg.execRequestSendDown.Update(ctx, msg)
+}
+
+type execRequestUp struct {
+	agg *Aggregator
+}
+
+func (s *execRequestUp) RecvExecRequest(ctx context.Context, msg *edgeproto.ExecRequest) {
+	s.agg.execRequestSendUp.Update(ctx, msg)
+}
+
+// forward to controller
+func (s *Aggregator) RecvMetric(ctx context.Context, msg *edgeproto.Metric) {
+	s.metricSend.Update(ctx, msg)
+}
+
+// forward to controller
+func (s *Aggregator) RecvAutoProvCounts(ctx context.Context, msg *edgeproto.AutoProvCounts) {
+	s.autoProvCountsSend.Update(ctx, msg)
+}
+
+// forward to controller
+func (s *Aggregator) RecvAppInstClient(ctx context.Context, msg *edgeproto.AppInstClient) {
+	s.appInstClientSend.Update(ctx, msg)
+}
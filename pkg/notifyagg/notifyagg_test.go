@@ -0,0 +1,333 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifyagg
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon/svcnode"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/notify"
+	"github.com/edgexr/edge-cloud-platform/test/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestAggregator(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelNotify)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+
+	notify.NotifyRetryTime = 10 * time.Millisecond
+
+	t.Run("unfiltered", func(t *testing.T) {
+		testAggregator(t, false, "127.0.0.1:61241", "127.0.0.1:61242")
+	})
+	t.Run("filtered", func(t *testing.T) {
+		testAggregator(t, true, "127.0.0.1:61243", "127.0.0.1:61244")
+	})
+}
+
+func TestAggregatorAccessKey(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelNotify | log.DebugLevelApi)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	agg := NewAggregator()
+	agg.AccessKeyServerOps(true)
+
+	keyPair, err := svcnode.GenerateAccessKey()
+	require.Nil(t, err)
+	otherKeyPair, err := svcnode.GenerateAccessKey()
+	require.Nil(t, err)
+
+	cloudlet := testutil.CloudletData()[0]
+	cloudlet.CrmAccessPublicKey = keyPair.PublicPEM
+	agg.CloudletCache.Update(ctx, &cloudlet, 0)
+
+	sigCtx := func(key *edgeproto.CloudletKey, privPEM string) context.Context {
+		keyStr, err := json.Marshal(key)
+		require.Nil(t, err)
+		priv, err := svcnode.LoadPrivPEM([]byte(privPEM))
+		require.Nil(t, err)
+		sig := ed25519.Sign(priv, keyStr)
+		md := metadata.Pairs(
+			cloudcommon.AccessKeyData, string(keyStr),
+			cloudcommon.AccessKeySig, base64.StdEncoding.EncodeToString(sig))
+		return metadata.NewIncomingContext(ctx, md)
+	}
+	method := "/edgeproto.NotifyApi/StreamNotice"
+
+	// access key of a mirrored cloudlet is verified
+	verified, err := agg.accessKeyServer.VerifyAccessKeySig(sigCtx(&cloudlet.Key, keyPair.PrivatePEM), method)
+	require.Nil(t, err)
+	require.Equal(t, cloudlet.Key, verified.Key)
+
+	// wrong key
+	_, err = agg.accessKeyServer.VerifyAccessKeySig(sigCtx(&cloudlet.Key, otherKeyPair.PrivatePEM), method)
+	require.NotNil(t, err)
+
+	// cloudlet not mirrored
+	otherCloudlet := testutil.CloudletData()[1]
+	_, err = agg.accessKeyServer.VerifyAccessKeySig(sigCtx(&otherCloudlet.Key, keyPair.PrivatePEM), method)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "failed to find cloudlet")
+
+	// no access key
+	_, err = agg.accessKeyServer.VerifyAccessKeySig(ctx, method)
+	require.NotNil(t, err)
+}
+
+func testAggregator(t *testing.T, filter bool, ctrlAddr, aggAddr string) {
+	ctx := log.StartTestSpan(context.Background())
+
+	// controller
+	ctrl := notify.NewDummyHandler()
+	ctrlMgr := &notify.ServerMgr{}
+	ctrl.RegisterServer(ctrlMgr)
+	ctrlMetrics := &testMetrics{}
+	ctrlMgr.RegisterRecv(notify.NewMetricRecvMany(ctrlMetrics))
+	ctrlExecReplies := &testExecReqs{}
+	ctrlMgr.RegisterRecv(notify.NewExecRequestRecvMany(ctrlExecReplies))
+	ctrlExecSend := notify.NewExecRequestSendMany()
+	ctrlMgr.RegisterSend(ctrlExecSend)
+	ctrlMgr.Start("ctrl", ctrlAddr, nil)
+	defer ctrlMgr.Stop()
+
+	// aggregator
+	agg := NewAggregator()
+	agg.FilterByCloudletKey = filter
+	aggClient := notify.NewClient("agg", []string{ctrlAddr}, grpc.WithInsecure())
+	agg.RegisterClient(aggClient)
+	aggMgr := &notify.ServerMgr{}
+	agg.RegisterServer(aggMgr)
+	aggMgr.Start("agg", aggAddr, nil)
+	defer aggMgr.Stop()
+	aggClient.Start()
+	defer aggClient.Stop()
+
+	// CRMs below the aggregator
+	crms := []*testCRM{}
+	for ii := 0; ii < 2; ii++ {
+		crm := newTestCRM(aggAddr)
+		crm.client.Start()
+		defer crm.client.Stop()
+		crms = append(crms, crm)
+	}
+	// DMEs require all data for the region
+	var dme *notify.DummyHandler
+	if !filter {
+		dme = notify.NewDummyHandler()
+		dmeClient := notify.NewClient("dme", []string{aggAddr}, grpc.WithInsecure())
+		dme.RegisterDMEClient(dmeClient)
+		dmeClient.Start()
+		defer dmeClient.Stop()
+	}
+
+	require.Nil(t, aggClient.WaitForConnect(1))
+	for _, crm := range crms {
+		require.Nil(t, crm.client.WaitForConnect(1))
+	}
+
+	// controller is connected to the aggregator
+	require.NotNil(t, ctrlMgr.GetStats(aggClient.GetLocalAddr()))
+
+	cloudletData := testutil.CloudletData()
+	ctrl.CloudletCache.Update(ctx, &cloudletData[0], 0)
+	ctrl.CloudletCache.Update(ctx, &cloudletData[1], 0)
+
+	// CloudletInfos are relayed up, which triggers the send of
+	// cloudlet data down.
+	crms[0].handler.CloudletInfoCache.Update(ctx, &testutil.CloudletInfoData()[0], 0)
+	crms[1].handler.CloudletInfoCache.Update(ctx, &testutil.CloudletInfoData()[1], 0)
+	require.Nil(t, ctrl.WaitForCloudletInfo(2))
+
+	for _, obj := range testutil.FlavorData()[:3] {
+		ctrl.FlavorCache.Update(ctx, &obj, 0)
+	}
+	for _, obj := range testutil.CreatedClusterInstData() {
+		obj.State = edgeproto.TrackedState_CREATE_REQUESTED
+		ctrl.ClusterInstCache.Update(ctx, &obj, 0)
+	}
+	numAppInsts := 0
+	for _, obj := range testutil.CreatedAppInstData() {
+		obj.State = edgeproto.TrackedState_CREATE_REQUESTED
+		ctrl.AppInstCache.Update(ctx, &obj, 0)
+		numAppInsts++
+	}
+
+	// CRMs only get data for their cloudlet
+	checkCRMCache(t, crms[0].handler, 3, 4, 10, 1)
+	checkCRMCache(t, crms[1].handler, 3, 3, 4, 1)
+	if filter {
+		// aggregator only has data for its cloudlets
+		require.Nil(t, notify.WaitFor(&agg.AppInstCache, 14))
+		require.Equal(t, 14, agg.AppInstCache.GetCount())
+		require.Equal(t, 2, agg.CloudletCache.GetCount())
+	} else {
+		require.Nil(t, dme.WaitForAppInsts(numAppInsts))
+		require.Equal(t, numAppInsts, len(dme.AppInstCache.Objs))
+		require.Equal(t, numAppInsts, agg.AppInstCache.GetCount())
+	}
+
+	// infos are relayed up
+	crms[0].handler.AppInstInfoCache.Update(ctx, &testutil.AppInstInfoData()[0], 0)
+	crms[1].handler.AppInstInfoCache.Update(ctx, &testutil.AppInstInfoData()[1], 0)
+	require.Nil(t, ctrl.WaitForAppInstInfo(2))
+	require.Equal(t, 2, len(ctrl.AppInstInfoCache.Objs))
+
+	// metrics are relayed up
+	for _, crm := range crms {
+		crm.metricSend.Update(ctx, &edgeproto.Metric{Name: "test-metric"})
+	}
+	require.Nil(t, notify.WaitFor(ctrlMetrics, 2))
+
+	// exec requests are routed to the CRM for the cloudlet and the
+	// reply is relayed back up.
+	req := edgeproto.ExecRequest{
+		CloudletKey: testutil.CloudletInfoData()[1].Key,
+		Offer:       "offer",
+	}
+	ctrlExecSend.Update(ctx, &req)
+	require.Nil(t, notify.WaitFor(&crms[1].execReqs, 1))
+	require.Nil(t, notify.WaitFor(ctrlExecReplies, 1))
+	require.Equal(t, "answer", ctrlExecReplies.get()[0].Answer)
+	require.Equal(t, 0, len(crms[0].execReqs.get()))
+
+	// disconnect of a CRM flushes its data from the controller
+	crms[0].client.Stop()
+	require.Nil(t, ctrl.WaitForCloudletInfo(1))
+	require.Nil(t, ctrl.WaitForAppInstInfo(1))
+	require.Equal(t, 1, len(ctrl.CloudletInfoCache.Objs))
+	require.Equal(t, 1, len(ctrl.AppInstInfoCache.Objs))
+	crms[0].client.Start()
+	require.Nil(t, crms[0].client.WaitForConnect(2))
+	checkCRMCache(t, crms[0].handler, 3, 4, 10, 1)
+	require.Nil(t, ctrl.WaitForCloudletInfo(2))
+
+	// deletes propagate through the aggregator
+	for _, obj := range testutil.CreatedAppInstData() {
+		ctrl.AppInstCache.Delete(ctx, &obj, 0)
+	}
+	for _, obj := range testutil.CreatedClusterInstData() {
+		ctrl.ClusterInstCache.Delete(ctx, &obj, 0)
+	}
+	for _, obj := range testutil.FlavorData()[:3] {
+		ctrl.FlavorCache.Delete(ctx, &obj, 0)
+	}
+	ctrl.CloudletCache.Delete(ctx, &cloudletData[0], 0)
+	ctrl.CloudletCache.Delete(ctx, &cloudletData[1], 0)
+	checkCRMCache(t, crms[0].handler, 0, 0, 0, 0)
+	checkCRMCache(t, crms[1].handler, 0, 0, 0, 0)
+	if !filter {
+		require.Nil(t, dme.WaitForAppInsts(0))
+		require.Equal(t, 0, len(dme.AppInstCache.Objs))
+	}
+}
+
+func checkCRMCache(t *testing.T, h *notify.DummyHandler, flavors, clusterInsts, appInsts, cloudlets int) {
+	h.WaitForFlavors(flavors)
+	h.WaitForClusterInsts(clusterInsts)
+	h.WaitForAppInsts(appInsts)
+	h.WaitForCloudlets(cloudlets)
+	require.Equal(t, flavors, len(h.FlavorCache.Objs), "num flavors")
+	require.Equal(t, clusterInsts, len(h.ClusterInstCache.Objs), "num clusterinsts")
+	require.Equal(t, appInsts, len(h.AppInstCache.Objs), "num appinsts")
+	require.Equal(t, cloudlets, len(h.CloudletCache.Objs), "num cloudlets")
+}
+
+type testCRM struct {
+	handler    *notify.DummyHandler
+	client     *notify.Client
+	metricSend *notify.MetricSend
+	execSend   *notify.ExecRequestSend
+	execReqs   testExecReqs
+}
+
+func newTestCRM(addr string) *testCRM {
+	s := &testCRM{}
+	s.handler = notify.NewDummyHandler()
+	s.client = notify.NewClient("crm", []string{addr}, grpc.WithInsecure())
+	s.handler.RegisterCRMClient(s.client)
+	s.metricSend = notify.NewMetricSend()
+	s.client.RegisterSend(s.metricSend)
+	s.execSend = notify.NewExecRequestSend()
+	s.client.RegisterSend(s.execSend)
+	s.client.RegisterRecv(notify.NewExecRequestRecv(s))
+	return s
+}
+
+func (s *testCRM) RecvExecRequest(ctx context.Context, msg *edgeproto.ExecRequest) {
+	s.execReqs.RecvExecRequest(ctx, msg)
+	reply := *msg
+	reply.Answer = "answer"
+	s.execSend.Update(ctx, &reply)
+}
+
+type testMetrics struct {
+	metrics []edgeproto.Metric
+	mux     sync.Mutex
+}
+
+func (s *testMetrics) RecvMetric(ctx context.Context, msg *edgeproto.Metric) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.metrics = append(s.metrics, *msg)
+}
+
+func (s *testMetrics) GetCount() int {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return len(s.metrics)
+}
+
+func (s *testMetrics) GetTypeString() string {
+	return "Metrics"
+}
+
+type testExecReqs struct {
+	reqs []edgeproto.ExecRequest
+	mux  sync.Mutex
+}
+
+func (s *testExecReqs) RecvExecRequest(ctx context.Context, msg *edgeproto.ExecRequest) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.reqs = append(s.reqs, *msg)
+}
+
+func (s *testExecReqs) get() []edgeproto.ExecRequest {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return append([]edgeproto.ExecRequest{}, s.reqs...)
+}
+
+func (s *testExecReqs) GetCount() int {
+	return len(s.get())
+}
+
+func (s *testExecReqs) GetTypeString() string {
+	return "ExecRequests"
+}
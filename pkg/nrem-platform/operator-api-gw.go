@@ -16,6 +16,7 @@ package operator
 
 import (
 	"context"
+	"time"
 
 	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
 )
@@ -50,3 +51,10 @@ type OperatorApiGw interface {
 	// DeletePrioritySession removes a previously created priority session
 	DeletePrioritySession(ctx context.Context, req *dme.QosPrioritySessionDeleteRequest) (*dme.QosPrioritySessionDeleteReply, error)
 }
+
+// QosSessionExtender is optionally implemented by an OperatorApiGw
+// that can extend the duration of an existing priority session.
+type QosSessionExtender interface {
+	// ExtendPrioritySession sets the new total duration of the session
+	ExtendPrioritySession(ctx context.Context, sessionId string, profile dme.QosSessionProfile, duration time.Duration) error
+}
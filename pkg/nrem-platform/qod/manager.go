@@ -0,0 +1,353 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package qod
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	operator "github.com/edgexr/edge-cloud-platform/pkg/nrem-platform"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var DefaultSessionDuration = 24 * time.Hour
+var DefaultMaxSessionDuration = 24 * time.Hour
+
+// SessionEndedCb is called after a session has ended, either because
+// it expired or was deleted. The session's Status and StatusInfo
+// are set to why it ended.
+type SessionEndedCb func(ctx context.Context, session *Session)
+
+// SessionManager creates sessions on the operator network via the
+// OperatorApiGw, persists them, and ends them when their duration
+// expires.
+// If the store is shared by multiple managers, each manager only
+// tracks expiry of the sessions it created or loaded at Start.
+// Ending a session is safe to race between managers, only one will
+// run the ended callbacks.
+type SessionManager struct {
+	gw      operator.OperatorApiGw
+	store   SessionStore
+	opts    SessionManagerOptions
+	mux     sync.Mutex
+	timers  map[string]*time.Timer
+	stopped bool
+	timeNow func() time.Time
+	endedWg sync.WaitGroup
+}
+
+type SessionManagerOptions struct {
+	defaultDuration time.Duration
+	maxDuration     time.Duration
+	endedCbs        []SessionEndedCb
+}
+
+type SessionManagerOp func(opts *SessionManagerOptions)
+
+// WithDefaultDuration sets the duration for sessions when neither
+// the request nor the App specify one.
+func WithDefaultDuration(d time.Duration) SessionManagerOp {
+	return func(opts *SessionManagerOptions) { opts.defaultDuration = d }
+}
+
+// WithMaxDuration limits the total duration of sessions, including
+// extensions.
+func WithMaxDuration(d time.Duration) SessionManagerOp {
+	return func(opts *SessionManagerOptions) { opts.maxDuration = d }
+}
+
+// WithSessionEndedCb adds a callback for when a session ends.
+func WithSessionEndedCb(cb SessionEndedCb) SessionManagerOp {
+	return func(opts *SessionManagerOptions) { opts.endedCbs = append(opts.endedCbs, cb) }
+}
+
+func NewSessionManager(gw operator.OperatorApiGw, store SessionStore, ops ...SessionManagerOp) *SessionManager {
+	opts := SessionManagerOptions{
+		defaultDuration: DefaultSessionDuration,
+		maxDuration:     DefaultMaxSessionDuration,
+	}
+	for _, op := range ops {
+		op(&opts)
+	}
+	s := &SessionManager{
+		gw:      gw,
+		store:   store,
+		opts:    opts,
+		timers:  make(map[string]*time.Timer),
+		timeNow: time.Now,
+	}
+	return s
+}
+
+// Start loads persisted sessions and starts their expiry timers.
+// Sessions that expired while no manager was running are ended.
+func (s *SessionManager) Start(ctx context.Context) error {
+	sessions, err := s.store.List(ctx)
+	if err != nil {
+		return err
+	}
+	now := s.timeNow()
+	for _, session := range sessions {
+		if session.IsExpired(now) {
+			s.endSession(ctx, session.Id, StatusInfoDurationExpired)
+			continue
+		}
+		s.startTimer(session)
+	}
+	log.SpanLog(ctx, log.DebugLevelDmereq, "started QoD session manager", "sessions", len(sessions))
+	return nil
+}
+
+// Stop cancels all expiry timers. Persisted sessions are kept so
+// that they can be restored by Start.
+func (s *SessionManager) Stop() {
+	s.mux.Lock()
+	s.stopped = true
+	for id, timer := range s.timers {
+		timer.Stop()
+		delete(s.timers, id)
+	}
+	s.mux.Unlock()
+	s.endedWg.Wait()
+}
+
+// CreateSession creates a session on the operator network.
+// The session duration is taken from the request, or if not
+// specified, the App's QosSessionDuration, or if not specified,
+// the default duration.
+func (s *SessionManager) CreateSession(ctx context.Context, appKey *edgeproto.AppKey, req *dme.QosPrioritySessionCreateRequest, appDuration time.Duration) (*Session, error) {
+	if req.Profile == dme.QosSessionProfile_QOS_NO_PRIORITY {
+		return nil, status.Errorf(codes.InvalidArgument, "Cannot create session with profile %s", req.Profile.String())
+	}
+	duration := time.Duration(req.SessionDuration) * time.Second
+	if duration == 0 {
+		duration = appDuration
+	}
+	if duration == 0 {
+		duration = s.opts.defaultDuration
+	}
+	if duration > s.opts.maxDuration {
+		return nil, status.Errorf(codes.InvalidArgument, "Session duration %s exceeds maximum of %s", duration, s.opts.maxDuration)
+	}
+	gwReq := *req
+	gwReq.SessionDuration = uint32(duration.Seconds())
+
+	reply, err := s.gw.CreatePrioritySession(ctx, &gwReq)
+	if err != nil {
+		return nil, err
+	}
+	if reply == nil {
+		return nil, status.Errorf(codes.Unimplemented, "Operator %s does not support QoS priority sessions", s.gw.GetOperatorName())
+	}
+	if reply.SessionId == "" {
+		return nil, status.Errorf(codes.Internal, "No session ID received from QOS API server")
+	}
+
+	now := s.timeNow()
+	session := &Session{
+		Id:                    reply.SessionId,
+		UeAddr:                req.IpUserEquipment,
+		AsAddr:                req.IpApplicationServer,
+		UePorts:               req.PortUserEquipment,
+		AsPorts:               req.PortApplicationServer,
+		ProtocolIn:            req.ProtocolIn,
+		ProtocolOut:           req.ProtocolOut,
+		Profile:               req.Profile,
+		Duration:              duration,
+		StartedAt:             now,
+		ExpiresAt:             now.Add(duration),
+		NotificationUri:       req.NotificationUri,
+		NotificationAuthToken: req.NotificationAuthToken,
+		Status:                SessionStatusAvailable,
+	}
+	if appKey != nil {
+		session.AppKey = *appKey
+	}
+	// prefer the operator's view of the session times
+	if reply.StartedAt != 0 {
+		session.StartedAt = time.Unix(int64(reply.StartedAt), 0)
+	}
+	if reply.ExpiresAt != 0 {
+		session.ExpiresAt = time.Unix(int64(reply.ExpiresAt), 0)
+		session.Duration = session.ExpiresAt.Sub(session.StartedAt)
+	}
+	if err := s.store.Put(ctx, session); err != nil {
+		log.SpanLog(ctx, log.DebugLevelDmereq, "failed to store QoD session, deleting it", "id", session.Id, "err", err)
+		s.deleteOperatorSession(ctx, session)
+		return nil, err
+	}
+	s.startTimer(session)
+	log.SpanLog(ctx, log.DebugLevelDmereq, "created QoD session", "id", session.Id, "app", session.AppKey, "profile", session.Profile, "expiresAt", session.ExpiresAt)
+	return session, nil
+}
+
+// GetSession gets the session by ID.
+func (s *SessionManager) GetSession(ctx context.Context, id string) (*Session, error) {
+	session, err := s.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		return nil, status.Errorf(codes.NotFound, "Session %s not found", id)
+	}
+	return session, nil
+}
+
+// ExtendSession extends the duration of the session. The total
+// duration of the session may not exceed the max duration.
+func (s *SessionManager) ExtendSession(ctx context.Context, id string, additional time.Duration) (*Session, error) {
+	if additional <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Additional duration must be positive")
+	}
+	session, err := s.GetSession(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if session.Status != SessionStatusAvailable {
+		return nil, status.Errorf(codes.FailedPrecondition, "Session %s is not available", id)
+	}
+	duration := session.Duration + additional
+	if duration > s.opts.maxDuration {
+		// CAMARA caps the extension at the max duration
+		duration = s.opts.maxDuration
+	}
+	extender, ok := s.gw.(operator.QosSessionExtender)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "Operator %s does not support extending QoS priority sessions", s.gw.GetOperatorName())
+	}
+	if err := extender.ExtendPrioritySession(ctx, id, session.Profile, duration); err != nil {
+		return nil, err
+	}
+	session.Duration = duration
+	session.ExpiresAt = session.StartedAt.Add(duration)
+	if err := s.store.Put(ctx, session); err != nil {
+		return nil, err
+	}
+	s.startTimer(session)
+	log.SpanLog(ctx, log.DebugLevelDmereq, "extended QoD session", "id", id, "expiresAt", session.ExpiresAt)
+	return session, nil
+}
+
+// DeleteSession deletes the session from the operator network.
+func (s *SessionManager) DeleteSession(ctx context.Context, id string) error {
+	session, err := s.GetSession(ctx, id)
+	if err != nil {
+		return err
+	}
+	reply, err := s.deleteOperatorSession(ctx, session)
+	if err != nil {
+		return err
+	}
+	if reply != nil && reply.Status == dme.QosPrioritySessionDeleteReply_QDEL_NOT_FOUND {
+		// already gone from the network, still end it here
+		log.SpanLog(ctx, log.DebugLevelDmereq, "QoD session not found by operator", "id", id)
+	}
+	s.endSession(ctx, id, StatusInfoDeleteRequested)
+	return nil
+}
+
+// SessionTerminated is called if the operator network ended the
+// session on its own.
+func (s *SessionManager) SessionTerminated(ctx context.Context, id string) {
+	s.endSession(ctx, id, StatusInfoNetworkTerminated)
+}
+
+func (s *SessionManager) deleteOperatorSession(ctx context.Context, session *Session) (*dme.QosPrioritySessionDeleteReply, error) {
+	req := &dme.QosPrioritySessionDeleteRequest{
+		Profile:   session.Profile,
+		SessionId: session.Id,
+	}
+	reply, err := s.gw.DeletePrioritySession(ctx, req)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelDmereq, "operator delete QoD session failed", "id", session.Id, "err", err)
+	}
+	return reply, err
+}
+
+func (s *SessionManager) startTimer(session *Session) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.stopped {
+		return
+	}
+	if timer, found := s.timers[session.Id]; found {
+		timer.Stop()
+	}
+	id := session.Id
+	s.timers[id] = time.AfterFunc(session.ExpiresAt.Sub(s.timeNow()), func() {
+		span := log.StartSpan(log.DebugLevelDmereq, "QoD session expired")
+		defer span.Finish()
+		ctx := log.ContextWithSpan(context.Background(), span)
+		s.expireSession(ctx, id)
+	})
+}
+
+// expireSession is called when the session's expiry timer fires.
+// The session may have been extended by another manager sharing
+// the store, so the stored expiry time is checked before ending
+// the session.
+func (s *SessionManager) expireSession(ctx context.Context, id string) {
+	session, err := s.store.Get(ctx, id)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelDmereq, "failed to get expired QoD session", "id", id, "err", err)
+		return
+	}
+	if session == nil {
+		// already ended, possibly by another manager
+		s.mux.Lock()
+		delete(s.timers, id)
+		s.mux.Unlock()
+		return
+	}
+	if !session.IsExpired(s.timeNow()) {
+		log.SpanLog(ctx, log.DebugLevelDmereq, "QoD session was extended, restarting timer", "id", id, "expiresAt", session.ExpiresAt)
+		s.startTimer(session)
+		return
+	}
+	s.endSession(ctx, id, StatusInfoDurationExpired)
+}
+
+// endSession removes the session and runs the ended callbacks.
+func (s *SessionManager) endSession(ctx context.Context, id string, info StatusInfo) {
+	s.mux.Lock()
+	if timer, found := s.timers[id]; found {
+		timer.Stop()
+		delete(s.timers, id)
+	}
+	s.endedWg.Add(1)
+	s.mux.Unlock()
+	defer s.endedWg.Done()
+
+	session, err := s.store.Delete(ctx, id)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelDmereq, "failed to delete QoD session", "id", id, "err", err)
+		return
+	}
+	if session == nil {
+		// already ended, possibly by another manager
+		return
+	}
+	session.Status = SessionStatusUnavailable
+	session.StatusInfo = info
+	log.SpanLog(ctx, log.DebugLevelDmereq, "QoD session ended", "id", id, "statusInfo", info)
+	for _, cb := range s.opts.endedCbs {
+		cb(ctx, session)
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package qod
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/nrem-platform/defaultoperator"
+	"github.com/edgexr/edge-cloud-platform/pkg/nrem-platform/stuboperator"
+	"github.com/edgexr/edge-cloud-platform/pkg/rediscache"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var testAppKey = edgeproto.AppKey{
+	Organization: "devorg",
+	Name:         "app",
+	Version:      "1.0",
+}
+
+func testCreateReq() *dme.QosPrioritySessionCreateRequest {
+	return &dme.QosPrioritySessionCreateRequest{
+		IpUserEquipment:     "10.0.0.1",
+		IpApplicationServer: "10.0.0.2",
+		Profile:             dme.QosSessionProfile_QOS_LOW_LATENCY,
+	}
+}
+
+func TestSessionManager(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelDmereq)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	gw := &stuboperator.OperatorApiGw{}
+	ended := make(chan *Session, 10)
+	mgr := NewSessionManager(gw, NewMemSessionStore(),
+		WithMaxDuration(2*time.Hour),
+		WithSessionEndedCb(func(ctx context.Context, session *Session) {
+			ended <- session
+		}))
+	require.Nil(t, mgr.Start(ctx))
+	defer mgr.Stop()
+
+	// duration comes from the App if not in the request
+	session, err := mgr.CreateSession(ctx, &testAppKey, testCreateReq(), time.Hour)
+	require.Nil(t, err)
+	require.Equal(t, time.Hour, session.Duration)
+	require.Equal(t, SessionStatusAvailable, session.Status)
+	require.Equal(t, testAppKey, session.AppKey)
+	_, found := gw.GetSession(session.Id)
+	require.True(t, found)
+
+	// request duration overrides the App
+	req := testCreateReq()
+	req.SessionDuration = 60
+	session2, err := mgr.CreateSession(ctx, &testAppKey, req, time.Hour)
+	require.Nil(t, err)
+	require.Equal(t, time.Minute, session2.Duration)
+
+	// too long
+	req.SessionDuration = uint32((3 * time.Hour).Seconds())
+	_, err = mgr.CreateSession(ctx, &testAppKey, req, 0)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// no priority
+	req = testCreateReq()
+	req.Profile = dme.QosSessionProfile_QOS_NO_PRIORITY
+	_, err = mgr.CreateSession(ctx, &testAppKey, req, 0)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// get
+	check, err := mgr.GetSession(ctx, session.Id)
+	require.Nil(t, err)
+	require.Equal(t, session.ExpiresAt.Unix(), check.ExpiresAt.Unix())
+
+	// extend is capped at the max duration
+	check, err = mgr.ExtendSession(ctx, session.Id, 30*time.Minute)
+	require.Nil(t, err)
+	require.Equal(t, 90*time.Minute, check.Duration)
+	require.Equal(t, session.StartedAt.Add(90*time.Minute).Unix(), check.ExpiresAt.Unix())
+	check, err = mgr.ExtendSession(ctx, session.Id, time.Hour)
+	require.Nil(t, err)
+	require.Equal(t, 2*time.Hour, check.Duration)
+	gwSession, _ := gw.GetSession(session.Id)
+	require.Equal(t, uint32(7200), gwSession.SessionDuration)
+
+	// delete
+	require.Nil(t, mgr.DeleteSession(ctx, session.Id))
+	endedSession := <-ended
+	require.Equal(t, session.Id, endedSession.Id)
+	require.Equal(t, SessionStatusUnavailable, endedSession.Status)
+	require.Equal(t, StatusInfoDeleteRequested, endedSession.StatusInfo)
+	_, found = gw.GetSession(session.Id)
+	require.False(t, found)
+	_, err = mgr.GetSession(ctx, session.Id)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	err = mgr.DeleteSession(ctx, session.Id)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = mgr.ExtendSession(ctx, session.Id, time.Minute)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// expiry
+	req = testCreateReq()
+	req.SessionDuration = 1
+	session3, err := mgr.CreateSession(ctx, &testAppKey, req, 0)
+	require.Nil(t, err)
+	select {
+	case endedSession = <-ended:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for session to expire")
+	}
+	require.Equal(t, session3.Id, endedSession.Id)
+	require.Equal(t, StatusInfoDurationExpired, endedSession.StatusInfo)
+	_, err = mgr.GetSession(ctx, session3.Id)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// network terminated
+	mgr.SessionTerminated(ctx, session2.Id)
+	endedSession = <-ended
+	require.Equal(t, session2.Id, endedSession.Id)
+	require.Equal(t, StatusInfoNetworkTerminated, endedSession.StatusInfo)
+	require.Equal(t, 0, len(ended))
+}
+
+func TestSessionManagerSharedExtend(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelDmereq)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	gw := &stuboperator.OperatorApiGw{}
+	store := NewMemSessionStore()
+	ended := make(chan *Session, 10)
+	endedCb := WithSessionEndedCb(func(ctx context.Context, session *Session) {
+		ended <- session
+	})
+	mgr1 := NewSessionManager(gw, store, endedCb)
+	require.Nil(t, mgr1.Start(ctx))
+	defer mgr1.Stop()
+	mgr2 := NewSessionManager(gw, store, endedCb)
+	require.Nil(t, mgr2.Start(ctx))
+	defer mgr2.Stop()
+
+	// session extended by another manager is not ended
+	// by the original expiry timer
+	req := testCreateReq()
+	req.SessionDuration = 1
+	session, err := mgr1.CreateSession(ctx, &testAppKey, req, 0)
+	require.Nil(t, err)
+	_, err = mgr2.ExtendSession(ctx, session.Id, time.Hour)
+	require.Nil(t, err)
+	select {
+	case endedSession := <-ended:
+		require.Fail(t, "extended session ended", "id", endedSession.Id)
+	case <-time.After(2 * time.Second):
+	}
+	check, err := mgr1.GetSession(ctx, session.Id)
+	require.Nil(t, err)
+	require.Equal(t, SessionStatusAvailable, check.Status)
+	mgr1.mux.Lock()
+	_, found := mgr1.timers[session.Id]
+	mgr1.mux.Unlock()
+	require.True(t, found)
+}
+
+func TestSessionManagerUnsupported(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelDmereq)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	// default operator does not implement sessions
+	mgr := NewSessionManager(&defaultoperator.OperatorApiGw{}, NewMemSessionStore())
+	_, err := mgr.CreateSession(ctx, &testAppKey, testCreateReq(), 0)
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestRedisSessionStore(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelDmereq)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	redisServer, err := rediscache.NewMockRedisServer()
+	require.Nil(t, err)
+	defer redisServer.Close()
+	client, err := rediscache.NewClient(ctx, &rediscache.RedisConfig{
+		StandaloneAddr: redisServer.GetStandaloneAddr(),
+	})
+	require.Nil(t, err)
+	defer client.Close()
+
+	// two managers sharing redis emulate two replicas
+	gw := &stuboperator.OperatorApiGw{}
+	ended := make(chan *Session, 10)
+	endedCb := WithSessionEndedCb(func(ctx context.Context, session *Session) {
+		ended <- session
+	})
+	mgr1 := NewSessionManager(gw, NewRedisSessionStore(client), endedCb)
+	mgr2 := NewSessionManager(gw, NewRedisSessionStore(client), endedCb)
+
+	session, err := mgr1.CreateSession(ctx, &testAppKey, testCreateReq(), time.Hour)
+	require.Nil(t, err)
+	check, err := mgr2.GetSession(ctx, session.Id)
+	require.Nil(t, err)
+	require.Equal(t, session.Id, check.Id)
+	require.Equal(t, session.Profile, check.Profile)
+	require.Equal(t, session.AppKey, check.AppKey)
+	require.Equal(t, session.ExpiresAt.Unix(), check.ExpiresAt.Unix())
+
+	// session is restored on start
+	require.Nil(t, mgr2.Start(ctx))
+	sessions, err := mgr2.store.List(ctx)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(sessions))
+
+	// only one replica ends the session
+	require.Nil(t, mgr2.DeleteSession(ctx, session.Id))
+	mgr1.SessionTerminated(ctx, session.Id)
+	endedSession := <-ended
+	require.Equal(t, StatusInfoDeleteRequested, endedSession.StatusInfo)
+	require.Equal(t, 0, len(ended))
+
+	// sessions that expired while no manager was running end on start
+	expired := *session
+	expired.Id = "expired-session"
+	expired.StartedAt = time.Now().Add(-2 * time.Hour)
+	expired.ExpiresAt = time.Now().Add(-time.Hour)
+	require.Nil(t, mgr1.store.Put(ctx, &expired))
+	require.Nil(t, mgr1.Start(ctx))
+	endedSession = <-ended
+	require.Equal(t, "expired-session", endedSession.Id)
+	require.Equal(t, StatusInfoDurationExpired, endedSession.StatusInfo)
+	mgr1.Stop()
+	mgr2.Stop()
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelDmereq)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	events := make(chan *StatusChangedEvent, 1)
+	var authHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeader = r.Header.Get("Authorization")
+		event := &StatusChangedEvent{}
+		require.Nil(t, json.NewDecoder(r.Body).Decode(event))
+		events <- event
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	gw := &stuboperator.OperatorApiGw{}
+	mgr := NewSessionManager(gw, NewMemSessionStore(),
+		WithSessionEndedCb(NewWebhookNotifier(server.Client(), "dme-test")))
+	defer mgr.Stop()
+
+	req := testCreateReq()
+	req.NotificationUri = server.URL + "/notify"
+	req.NotificationAuthToken = "token123"
+	session, err := mgr.CreateSession(ctx, &testAppKey, req, time.Hour)
+	require.Nil(t, err)
+	require.Nil(t, mgr.DeleteSession(ctx, session.Id))
+
+	event := <-events
+	require.Equal(t, "Bearer token123", authHeader)
+	require.Equal(t, QosStatusChangedEventType, event.Type)
+	require.Equal(t, "dme-test", event.Source)
+	require.Equal(t, session.Id, event.Data.SessionId)
+	require.Equal(t, SessionStatusUnavailable, event.Data.QosStatus)
+	require.Equal(t, StatusInfoDeleteRequested, event.Data.StatusInfo)
+}
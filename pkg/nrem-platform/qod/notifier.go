@@ -0,0 +1,97 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package qod
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/google/uuid"
+)
+
+const QosStatusChangedEventType = "org.camaraproject.quality-on-demand.v0.qos-status-changed"
+
+// StatusChangedEvent is the CloudEvent sent to a session's
+// notification URI when its status changes.
+type StatusChangedEvent struct {
+	Id          string                 `json:"id"`
+	Source      string                 `json:"source"`
+	Type        string                 `json:"type"`
+	SpecVersion string                 `json:"specversion"`
+	Time        time.Time              `json:"time"`
+	Data        StatusChangedEventData `json:"data"`
+}
+
+type StatusChangedEventData struct {
+	SessionId  string        `json:"sessionId"`
+	QosStatus  SessionStatus `json:"qosStatus"`
+	StatusInfo StatusInfo    `json:"statusInfo,omitempty"`
+}
+
+// NewWebhookNotifier returns a SessionEndedCb that sends a status
+// changed event to the session's notification URI, if it has one.
+// The source identifies the sender of the event.
+func NewWebhookNotifier(client *http.Client, source string) SessionEndedCb {
+	return func(ctx context.Context, session *Session) {
+		if session.NotificationUri == "" {
+			return
+		}
+		if err := sendStatusChangedEvent(ctx, client, source, session); err != nil {
+			log.SpanLog(ctx, log.DebugLevelDmereq, "failed to send QoD session notification", "id", session.Id, "uri", session.NotificationUri, "err", err)
+		}
+	}
+}
+
+func sendStatusChangedEvent(ctx context.Context, client *http.Client, source string, session *Session) error {
+	event := StatusChangedEvent{
+		Id:          uuid.New().String(),
+		Source:      source,
+		Type:        QosStatusChangedEventType,
+		SpecVersion: "1.0",
+		Time:        time.Now(),
+		Data: StatusChangedEventData{
+			SessionId:  session.Id,
+			QosStatus:  session.Status,
+			StatusInfo: session.StatusInfo,
+		},
+	}
+	out, err := json.Marshal(&event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, session.NotificationUri, bytes.NewBuffer(out))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/cloudevents+json")
+	if session.NotificationAuthToken != "" {
+		req.Header.Set("Authorization", "Bearer "+session.NotificationAuthToken)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("notification returned status %d", resp.StatusCode)
+	}
+	log.SpanLog(ctx, log.DebugLevelDmereq, "sent QoD session notification", "id", session.Id, "uri", session.NotificationUri, "qosStatus", session.Status)
+	return nil
+}
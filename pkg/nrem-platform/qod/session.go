@@ -0,0 +1,80 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package qod tracks the lifecycle of Quality-on-Demand (QoS priority)
+// sessions created through the operator API gateway, following the
+// CAMARA Quality-on-Demand session model.
+package qod
+
+import (
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+)
+
+// SessionStatus is the CAMARA qosStatus of a session.
+type SessionStatus string
+
+const (
+	SessionStatusRequested   SessionStatus = "REQUESTED"
+	SessionStatusAvailable   SessionStatus = "AVAILABLE"
+	SessionStatusUnavailable SessionStatus = "UNAVAILABLE"
+)
+
+// StatusInfo is the CAMARA reason a session became unavailable.
+type StatusInfo string
+
+const (
+	StatusInfoDurationExpired   StatusInfo = "DURATION_EXPIRED"
+	StatusInfoNetworkTerminated StatusInfo = "NETWORK_TERMINATED"
+	StatusInfoDeleteRequested   StatusInfo = "DELETE_REQUESTED"
+)
+
+// Session is a QoS priority session created on the operator network.
+type Session struct {
+	Id                    string                 `json:"id"`
+	AppKey                edgeproto.AppKey       `json:"appKey"`
+	UeAddr                string                 `json:"ueAddr"`
+	AsAddr                string                 `json:"asAddr"`
+	UePorts               string                 `json:"uePorts,omitempty"`
+	AsPorts               string                 `json:"asPorts,omitempty"`
+	ProtocolIn            dme.QosSessionProtocol `json:"protocolIn"`
+	ProtocolOut           dme.QosSessionProtocol `json:"protocolOut"`
+	Profile               dme.QosSessionProfile  `json:"profile"`
+	Duration              time.Duration          `json:"duration"`
+	StartedAt             time.Time              `json:"startedAt"`
+	ExpiresAt             time.Time              `json:"expiresAt"`
+	NotificationUri       string                 `json:"notificationUri,omitempty"`
+	NotificationAuthToken string                 `json:"notificationAuthToken,omitempty"`
+	Status                SessionStatus          `json:"status"`
+	StatusInfo            StatusInfo             `json:"statusInfo,omitempty"`
+}
+
+// GetReply converts the session into the reply returned by the
+// DME QosPrioritySessionCreate API.
+func (s *Session) GetReply() *dme.QosPrioritySessionReply {
+	return &dme.QosPrioritySessionReply{
+		SessionDuration: uint32(s.Duration.Seconds()),
+		Profile:         s.Profile,
+		SessionId:       s.Id,
+		StartedAt:       uint32(s.StartedAt.Unix()),
+		ExpiresAt:       uint32(s.ExpiresAt.Unix()),
+	}
+}
+
+// IsExpired checks if the session has expired as of the given time.
+func (s *Session) IsExpired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
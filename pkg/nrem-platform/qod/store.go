@@ -0,0 +1,183 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package qod
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// SessionStore persists sessions by ID.
+type SessionStore interface {
+	// Get returns the session, or nil if not found.
+	Get(ctx context.Context, id string) (*Session, error)
+	// Put creates or updates the session.
+	Put(ctx context.Context, session *Session) error
+	// Delete removes the session and returns it, or nil if
+	// it was not found. Only one caller will get the session
+	// back if there are concurrent deletes.
+	Delete(ctx context.Context, id string) (*Session, error)
+	// List returns all sessions.
+	List(ctx context.Context) ([]*Session, error)
+}
+
+// MemSessionStore keeps sessions in process memory.
+type MemSessionStore struct {
+	mux      sync.Mutex
+	sessions map[string]*Session
+}
+
+func NewMemSessionStore() *MemSessionStore {
+	return &MemSessionStore{
+		sessions: make(map[string]*Session),
+	}
+}
+
+func (s *MemSessionStore) Get(ctx context.Context, id string) (*Session, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	session, ok := s.sessions[id]
+	if !ok {
+		return nil, nil
+	}
+	cp := *session
+	return &cp, nil
+}
+
+func (s *MemSessionStore) Put(ctx context.Context, session *Session) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	cp := *session
+	s.sessions[session.Id] = &cp
+	return nil
+}
+
+func (s *MemSessionStore) Delete(ctx context.Context, id string) (*Session, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	session, ok := s.sessions[id]
+	if !ok {
+		return nil, nil
+	}
+	delete(s.sessions, id)
+	return session, nil
+}
+
+func (s *MemSessionStore) List(ctx context.Context) ([]*Session, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	sessions := []*Session{}
+	for _, session := range s.sessions {
+		cp := *session
+		sessions = append(sessions, &cp)
+	}
+	return sessions, nil
+}
+
+const RedisSessionKeyPrefix = "qodsession"
+
+// Keep expired sessions in redis for a little while after they
+// expire, so that a replica can still send the expiry notification.
+var RedisSessionExpiryGrace = 5 * time.Minute
+
+// RedisSessionStore keeps sessions in redis so that they are
+// shared by all replicas (i.e. DMEs in a region).
+type RedisSessionStore struct {
+	client *redis.Client
+}
+
+func NewRedisSessionStore(client *redis.Client) *RedisSessionStore {
+	return &RedisSessionStore{
+		client: client,
+	}
+}
+
+func getRedisSessionKey(id string) string {
+	return fmt.Sprintf("%s:%s", RedisSessionKeyPrefix, id)
+}
+
+func (s *RedisSessionStore) Get(ctx context.Context, id string) (*Session, error) {
+	val, err := s.client.Get(ctx, getRedisSessionKey(id)).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalSession(id, val)
+}
+
+func (s *RedisSessionStore) Put(ctx context.Context, session *Session) error {
+	out, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	ttl := time.Until(session.ExpiresAt) + RedisSessionExpiryGrace
+	return s.client.Set(ctx, getRedisSessionKey(session.Id), string(out), ttl).Err()
+}
+
+func (s *RedisSessionStore) Delete(ctx context.Context, id string) (*Session, error) {
+	key := getRedisSessionKey(id)
+	var getCmd *redis.StringCmd
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		getCmd = pipe.Get(ctx, key)
+		pipe.Del(ctx, key)
+		return nil
+	})
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalSession(id, getCmd.Val())
+}
+
+func (s *RedisSessionStore) List(ctx context.Context) ([]*Session, error) {
+	sessions := []*Session{}
+	iter := s.client.Scan(ctx, 0, RedisSessionKeyPrefix+":*", 0).Iterator()
+	for iter.Next(ctx) {
+		val, err := s.client.Get(ctx, iter.Val()).Result()
+		if err == redis.Nil {
+			// deleted since scan
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		session, err := unmarshalSession(iter.Val(), val)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+func unmarshalSession(id, val string) (*Session, error) {
+	session := &Session{}
+	if err := json.Unmarshal([]byte(val), session); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal QoD session %s, %s", id, err)
+	}
+	return session, nil
+}
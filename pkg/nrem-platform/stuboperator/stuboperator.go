@@ -0,0 +1,136 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package stuboperator is a local operator API gateway that keeps
+// QoS priority sessions in memory, so that session handling can be
+// exercised without an operator network.
+package stuboperator
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	operator "github.com/edgexr/edge-cloud-platform/pkg/nrem-platform"
+	simulatedloc "github.com/edgexr/edge-cloud-platform/pkg/nrem-platform/defaultoperator/simulated-location"
+	simulatedqos "github.com/edgexr/edge-cloud-platform/pkg/nrem-platform/defaultoperator/simulated-qos"
+	"github.com/edgexr/edge-cloud-platform/pkg/version"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const OperatorName = "stub"
+
+// OperatorApiGw represents an Operator API Gateway
+type OperatorApiGw struct {
+	Servers  *operator.OperatorApiGwServers
+	mux      sync.Mutex
+	sessions map[string]*dme.QosPrioritySessionReply
+}
+
+func (*OperatorApiGw) GetOperatorName() string {
+	return OperatorName
+}
+
+// Init is called once during startup.
+func (o *OperatorApiGw) Init(operatorName string, servers *operator.OperatorApiGwServers) error {
+	log.DebugLog(log.DebugLevelDmereq, "init for stub operator", "operatorName", operatorName)
+	o.Servers = servers
+	return nil
+}
+
+func (*OperatorApiGw) VerifyLocation(mreq *dme.VerifyLocationRequest, mreply *dme.VerifyLocationReply) error {
+	return simulatedloc.VerifySimulatedClientLoc(mreq, mreply)
+}
+
+func (*OperatorApiGw) GetLocation(mreq *dme.GetLocationRequest, mreply *dme.GetLocationReply) error {
+	return simulatedloc.GetSimulatedClientLoc(mreq, mreply)
+}
+
+func (*OperatorApiGw) GetQOSPositionKPI(mreq *dme.QosPositionRequest, getQosSvr dme.QosPositionKpi_GetQosPositionKpiServer) error {
+	return simulatedqos.GetSimulatedQOSPositionKPI(mreq, getQosSvr)
+}
+
+func (*OperatorApiGw) GetVersionProperties(ctx context.Context) map[string]string {
+	return version.BuildProps(ctx, "StubOperator")
+}
+
+func (o *OperatorApiGw) CreatePrioritySession(ctx context.Context, req *dme.QosPrioritySessionCreateRequest) (*dme.QosPrioritySessionReply, error) {
+	log.SpanLog(ctx, log.DebugLevelDmereq, "stub CreatePrioritySession", "req", req)
+	if net.ParseIP(req.IpUserEquipment) == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid Address for IpUserEquipment: %s", req.IpUserEquipment)
+	}
+	if net.ParseIP(req.IpApplicationServer) == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid Address for IpApplicationServer: %s", req.IpApplicationServer)
+	}
+	now := time.Now()
+	reply := &dme.QosPrioritySessionReply{
+		SessionDuration: req.SessionDuration,
+		Profile:         req.Profile,
+		SessionId:       uuid.New().String(),
+		StartedAt:       uint32(now.Unix()),
+		ExpiresAt:       uint32(now.Unix()) + req.SessionDuration,
+	}
+	o.mux.Lock()
+	defer o.mux.Unlock()
+	if o.sessions == nil {
+		o.sessions = make(map[string]*dme.QosPrioritySessionReply)
+	}
+	o.sessions[reply.SessionId] = reply
+	cp := *reply
+	return &cp, nil
+}
+
+func (o *OperatorApiGw) DeletePrioritySession(ctx context.Context, req *dme.QosPrioritySessionDeleteRequest) (*dme.QosPrioritySessionDeleteReply, error) {
+	log.SpanLog(ctx, log.DebugLevelDmereq, "stub DeletePrioritySession", "sessionId", req.SessionId)
+	o.mux.Lock()
+	defer o.mux.Unlock()
+	reply := &dme.QosPrioritySessionDeleteReply{}
+	if _, found := o.sessions[req.SessionId]; found {
+		delete(o.sessions, req.SessionId)
+		reply.Status = dme.QosPrioritySessionDeleteReply_QDEL_DELETED
+	} else {
+		reply.Status = dme.QosPrioritySessionDeleteReply_QDEL_NOT_FOUND
+	}
+	return reply, nil
+}
+
+func (o *OperatorApiGw) ExtendPrioritySession(ctx context.Context, sessionId string, profile dme.QosSessionProfile, duration time.Duration) error {
+	log.SpanLog(ctx, log.DebugLevelDmereq, "stub ExtendPrioritySession", "sessionId", sessionId, "duration", duration)
+	o.mux.Lock()
+	defer o.mux.Unlock()
+	session, found := o.sessions[sessionId]
+	if !found {
+		return status.Errorf(codes.NotFound, "Session %s not found", sessionId)
+	}
+	session.SessionDuration = uint32(duration.Seconds())
+	session.ExpiresAt = session.StartedAt + session.SessionDuration
+	return nil
+}
+
+// GetSession returns the session as known by the stub operator network.
+func (o *OperatorApiGw) GetSession(sessionId string) (*dme.QosPrioritySessionReply, bool) {
+	o.mux.Lock()
+	defer o.mux.Unlock()
+	session, found := o.sessions[sessionId]
+	if !found {
+		return nil, false
+	}
+	cp := *session
+	return &cp, true
+}
@@ -48,7 +48,10 @@ type KVStore interface {
 	// Put the key-value pair, regardless of whether it already exists or not.
 	Put(ctx context.Context, key, val string, opts ...KVOp) (int64, error)
 	// List retrives all objects that have the given key string prefix.
-	List(key string, cb ListCb) error
+	// If a revision is specified, the objects are retrieved as of that
+	// revision, which fails with ErrKVStoreCompacted if the history for
+	// the revision is no longer present.
+	List(key string, cb ListCb, opts ...KVOp) error
 	// Sync is a blocking call used to keep in sync with the database.
 	// The initial call (or sometimes after a reconnect), the full set
 	// of objects will be called back. Afterwards, or after reconnect
@@ -83,7 +86,23 @@ type KVStore interface {
 	KeepAlive(ctx context.Context, leaseID int64) error
 }
 
+// KVStoreHistory is implemented by KVStores that keep a history
+// of revisions.
+type KVStoreHistory interface {
+	// DeletedSince calls cb for each object with the given key prefix
+	// that was deleted after rev, up to and including endRev, with
+	// the value of the object before it was deleted. It returns
+	// ErrKVStoreCompacted if the history since rev is no longer present.
+	DeletedSince(ctx context.Context, key string, rev, endRev int64, cb func(key, prevVal []byte, modRev int64)) error
+	// History calls cb for the revisions of the object with the
+	// given key, newest first, since the object was last created.
+	// It stops after maxRevs revisions if maxRevs is non-zero, or
+	// at the oldest revision that has not been compacted.
+	History(ctx context.Context, key string, maxRevs int, cb func(val []byte, modRev int64) error) error
+}
+
 var ErrKVStoreNotInitialized = errors.New("Object Storage not initialized")
+var ErrKVStoreCompacted = errors.New("Object Storage revision has been compacted")
 
 // Any object that wants to be stored in the database
 // needs to implement the Obj interface.
@@ -0,0 +1,88 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package promutils
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// ScopePromQLExpr adds the given label matchers to every metric
+// selector in the PromQL expression, so that the expression can only
+// query series that carry those labels. The expression is parsed
+// with the Prometheus parser, so invalid expressions are rejected,
+// and the scoped expression is returned in its canonical form.
+// Expressions that set any of the scope labels themselves are
+// rejected.
+func ScopePromQLExpr(expr string, scope map[string]string) (string, error) {
+	if strings.TrimSpace(expr) == "" {
+		return "", errors.New("empty expression")
+	}
+	scopeNames := []string{}
+	for name := range scope {
+		scopeNames = append(scopeNames, name)
+	}
+	sort.Strings(scopeNames)
+	scopeMatchers := []*labels.Matcher{}
+	for _, name := range scopeNames {
+		matcher, err := labels.NewMatcher(labels.MatchEqual, name, scope[name])
+		if err != nil {
+			return "", err
+		}
+		scopeMatchers = append(scopeMatchers, matcher)
+	}
+
+	parsed, err := parser.ParseExpr(expr)
+	if err != nil {
+		return "", err
+	}
+	numSelectors := 0
+	err = parser.Walk(scopeVisitor(func(node parser.Node) error {
+		vs, ok := node.(*parser.VectorSelector)
+		if !ok {
+			return nil
+		}
+		for _, matcher := range vs.LabelMatchers {
+			if _, found := scope[matcher.Name]; found {
+				return fmt.Errorf("label %s is reserved for scoping the expression and may not be used in matchers", matcher.Name)
+			}
+		}
+		matchers := append([]*labels.Matcher{}, scopeMatchers...)
+		vs.LabelMatchers = append(matchers, vs.LabelMatchers...)
+		numSelectors++
+		return nil
+	}), parsed, nil)
+	if err != nil {
+		return "", err
+	}
+	if numSelectors == 0 {
+		return "", errors.New("expression does not query any metrics")
+	}
+	return parsed.String(), nil
+}
+
+type scopeVisitor func(node parser.Node) error
+
+func (s scopeVisitor) Visit(node parser.Node, path []parser.Node) (parser.Visitor, error) {
+	if node == nil {
+		return nil, nil
+	}
+	return s, s(node)
+}
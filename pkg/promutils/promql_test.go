@@ -0,0 +1,80 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package promutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScopePromQLExpr(t *testing.T) {
+	scope := map[string]string{
+		"label_mexAppInstName": "app1",
+		"label_mexAppInstOrg":  "dev",
+	}
+	sc := `label_mexAppInstName="app1",label_mexAppInstOrg="dev"`
+
+	tests := []struct {
+		expr   string
+		expect string
+	}{{
+		`queue_depth > 100`,
+		`queue_depth{` + sc + `} > 100`,
+	}, {
+		`rate(http_errors_total{code=~"5.."}[5m]) > 0.5`,
+		`rate(http_errors_total{code=~"5..",` + sc + `}[5m]) > 0.5`,
+	}, {
+		`sum by (pod) (rate(errors_total[5m])) / sum by (pod) (rate(requests_total[5m])) > 1e-2`,
+		`sum by (pod) (rate(errors_total{` + sc + `}[5m])) / sum by (pod) (rate(requests_total{` + sc + `}[5m])) > 0.01`,
+	}, {
+		`max_over_time(latency_seconds {quantile="0.99"}[10m:1m] offset 5m) > 2`,
+		`max_over_time(latency_seconds{` + sc + `,quantile="0.99"}[10m:1m] offset 5m) > 2`,
+	}, {
+		`{__name__=~"job:.*"} * ON(pod) group_left up == 1`,
+		`{__name__=~"job:.*",` + sc + `} * on (pod) group_left () up{` + sc + `} == 1`,
+	}, {
+		`queue_depth > 1 # comment`,
+		`queue_depth{` + sc + `} > 1`,
+	}, {
+		"label_replace(queue_depth{}, \"q\", \"$1\", \"name\", \"(.*)\")\n  > 10",
+		`label_replace(queue_depth{` + sc + `}, "q", "$1", "name", "(.*)") > 10`,
+	}}
+	for _, test := range tests {
+		out, err := ScopePromQLExpr(test.expr, scope)
+		require.Nil(t, err, test.expr)
+		require.Equal(t, test.expect, out, test.expr)
+	}
+
+	errTests := []struct {
+		expr   string
+		expect string
+	}{
+		{"", "empty expression"},
+		{`vector(1) > 0`, "expression does not query any metrics"},
+		{`queue_depth{label_mexAppInstOrg="other"} > 1`, "label label_mexAppInstOrg is reserved"},
+		{`rate(queue_depth[5m] > 1`, "unclosed left parenthesis"},
+		{`rate(queue_depth[5m])) > 1`, "unexpected right parenthesis"},
+		{`queue_depth{name="x" > 1`, "unexpected character inside braces"},
+		{`queue_depth{name="x} > 1`, "unterminated quoted string"},
+		{`queue_depth > > 5`, "parse error"},
+		{`rate(queue_depth)`, "expected type range vector"},
+	}
+	for _, test := range errTests {
+		_, err := ScopePromQLExpr(test.expr, scope)
+		require.NotNil(t, err, test.expr)
+		require.Contains(t, err.Error(), test.expect, test.expr)
+	}
+}
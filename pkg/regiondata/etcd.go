@@ -191,13 +191,19 @@ func (e *EtcdClient) Put(ctx context.Context, key, val string, ops ...objstore.K
 }
 
 // Get records that have the given key prefix
-func (e *EtcdClient) List(key string, cb objstore.ListCb) error {
+func (e *EtcdClient) List(key string, cb objstore.ListCb, ops ...objstore.KVOp) error {
 	if e.client == nil {
 		return objstore.ErrKVStoreNotInitialized
 	}
+	etcdOps := edgeproto.GetSTMOpts(ops...)
+	etcdOps = append(etcdOps, clientv3.WithPrefix())
+
 	ctx, cancel := context.WithTimeout(context.Background(), ReadRequestTimeout)
-	resp, err := e.client.Get(ctx, key, clientv3.WithPrefix())
+	resp, err := e.client.Get(ctx, key, etcdOps...)
 	cancel()
+	if err == rpctypes.ErrCompacted {
+		return objstore.ErrKVStoreCompacted
+	}
 	if err != nil {
 		return err
 	}
@@ -340,6 +346,84 @@ func (e *EtcdClient) Sync(ctx context.Context, key string, cb objstore.SyncCb) e
 	return nil
 }
 
+// DeletedSince uses a watch on the history to find deleted objects.
+func (e *EtcdClient) DeletedSince(ctx context.Context, key string, rev, endRev int64, cb func(key, prevVal []byte, modRev int64)) error {
+	if rev >= endRev {
+		return nil
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ch := e.client.Watch(ctx, key, clientv3.WithPrefix(), clientv3.WithRev(rev+1), clientv3.WithPrevKV())
+	for resp := range ch {
+		if err := resp.Err(); err != nil {
+			if err == rpctypes.ErrCompacted {
+				return objstore.ErrKVStoreCompacted
+			}
+			return err
+		}
+		// endRev is the revision of an event on the prefix,
+		// so the watch will always reach it.
+		done := false
+		for _, event := range resp.Events {
+			if event.Kv.ModRevision > endRev {
+				done = true
+				break
+			}
+			if event.Kv.ModRevision == endRev {
+				done = true
+			}
+			if event.Type == mvccpb.DELETE && event.PrevKv != nil {
+				cb(event.Kv.Key, event.PrevKv.Value, event.Kv.ModRevision)
+			}
+		}
+		if done {
+			return nil
+		}
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return errors.New("etcd history watch closed")
+}
+
+// History walks back through the revisions of the key by getting
+// the key as of the revision before each change.
+func (e *EtcdClient) History(ctx context.Context, key string, maxRevs int, cb func(val []byte, modRev int64) error) error {
+	if e.client == nil {
+		return objstore.ErrKVStoreNotInitialized
+	}
+	var rev int64
+	for ii := 0; maxRevs <= 0 || ii < maxRevs; ii++ {
+		ops := []clientv3.OpOption{}
+		if rev > 0 {
+			ops = append(ops, clientv3.WithRev(rev))
+		}
+		getCtx, cancel := context.WithTimeout(ctx, ReadRequestTimeout)
+		resp, err := e.client.Get(getCtx, key, ops...)
+		cancel()
+		if err == rpctypes.ErrCompacted {
+			// older revisions are no longer present
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(resp.Kvs) == 0 {
+			return nil
+		}
+		kv := resp.Kvs[0]
+		if err := cb(kv.Value, kv.ModRevision); err != nil {
+			return err
+		}
+		if kv.Version <= 1 {
+			// first revision since the object was created
+			return nil
+		}
+		rev = kv.ModRevision - 1
+	}
+	return nil
+}
+
 func (e *EtcdClient) ApplySTM(ctx context.Context, apply func(concurrency.STM) error) (int64, error) {
 	var resp *clientv3.TxnResponse
 	var err error
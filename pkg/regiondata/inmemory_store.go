@@ -40,18 +40,30 @@ type inMemData struct {
 	modRev int64
 }
 
+type inMemDeleted struct {
+	key     string
+	prevVal string
+	modRev  int64
+}
+
 type InMemoryStore struct {
-	db       map[string]*inMemData
-	watchers map[string][]*watcher
-	rev      int64
-	syncCb   objstore.SyncCb
-	mux      util.Mutex
+	db         map[string]*inMemData
+	watchers   map[string][]*watcher
+	rev        int64
+	syncCb     objstore.SyncCb
+	mux        util.Mutex
+	deleted    []inMemDeleted
+	revisions  map[string][]inMemData
+	compactRev int64
 }
 
 func (e *InMemoryStore) Start() error {
 	e.db = make(map[string]*inMemData)
 	e.watchers = make(map[string][]*watcher)
 	e.rev = 1
+	e.deleted = nil
+	e.revisions = make(map[string][]inMemData)
+	e.compactRev = 0
 	return nil
 }
 
@@ -77,6 +89,7 @@ func (e *InMemoryStore) Create(ctx context.Context, key, val string) (int64, err
 		vers:   1,
 		modRev: e.rev,
 	}
+	e.recordRevision(key)
 	log.DebugLog(log.DebugLevelEtcd, "Created", "key", key, "val", val, "rev", e.rev)
 	e.triggerWatcher(ctx, objstore.SyncUpdate, key, val, e.rev)
 	return e.rev, nil
@@ -100,6 +113,7 @@ func (e *InMemoryStore) Update(ctx context.Context, key, val string, version int
 	data.val = val
 	data.vers++
 	data.modRev = e.rev
+	e.recordRevision(key)
 	log.DebugLog(log.DebugLevelEtcd, "Updated", "key", key, "val", val, "ver", data.vers, "rev", e.rev)
 	e.triggerWatcher(ctx, objstore.SyncUpdate, key, val, e.rev)
 	return e.rev, nil
@@ -120,6 +134,7 @@ func (e *InMemoryStore) Put(ctx context.Context, key, val string, ops ...objstor
 	data.val = val
 	data.vers++
 	data.modRev = e.rev
+	e.recordRevision(key)
 	log.DebugLog(log.DebugLevelEtcd, "Put", "key", key, "val", val, "ver", data.vers, "rev", e.rev)
 	e.triggerWatcher(ctx, objstore.SyncUpdate, key, val, e.rev)
 	return e.rev, nil
@@ -131,8 +146,8 @@ func (e *InMemoryStore) Delete(ctx context.Context, key string) (int64, error) {
 	if e.db == nil {
 		return 0, objstore.ErrKVStoreNotInitialized
 	}
-	delete(e.db, key)
 	e.rev++
+	e.recordDelete(key)
 	log.DebugLog(log.DebugLevelEtcd, "Delete", "key", key, "rev", e.rev)
 	e.triggerWatcher(ctx, objstore.SyncDelete, key, "", e.rev)
 	return e.rev, nil
@@ -152,13 +167,23 @@ func (e *InMemoryStore) Get(key string, opts ...objstore.KVOp) ([]byte, int64, i
 	return ([]byte)(data.val), data.vers, data.modRev, nil
 }
 
-func (e *InMemoryStore) List(key string, cb objstore.ListCb) error {
+func (e *InMemoryStore) List(key string, cb objstore.ListCb, opts ...objstore.KVOp) error {
 	kvs := make(map[string]*inMemData)
+	kvopts := objstore.GetKVOptions(opts)
 	e.mux.Lock()
 	if e.db == nil {
 		e.mux.Unlock()
 		return objstore.ErrKVStoreNotInitialized
 	}
+	// only the latest revision is kept
+	if kvopts.Rev > e.rev {
+		e.mux.Unlock()
+		return fmt.Errorf("revision %d is a future revision", kvopts.Rev)
+	}
+	if kvopts.Rev != 0 && kvopts.Rev < e.rev {
+		e.mux.Unlock()
+		return objstore.ErrKVStoreCompacted
+	}
 	for k, v := range e.db {
 		if !strings.HasPrefix(k, key) {
 			continue
@@ -212,6 +237,8 @@ func (e *InMemoryStore) Sync(ctx context.Context, prefix string, cb objstore.Syn
 	data.Action = objstore.SyncListEnd
 	data.Key = nil
 	data.Value = nil
+	data.Rev = e.rev
+	data.ModRev = 0
 	cb(ctx, &data)
 
 	e.mux.Unlock()
@@ -254,6 +281,89 @@ func (e *InMemoryStore) triggerWatcher(ctx context.Context, action objstore.Sync
 	}
 }
 
+// recordDelete deletes the key and records it in the history.
+// Caller must hold the lock and have incremented the revision.
+func (e *InMemoryStore) recordDelete(key string) {
+	if data, ok := e.db[key]; ok {
+		e.deleted = append(e.deleted, inMemDeleted{
+			key:     key,
+			prevVal: data.val,
+			modRev:  e.rev,
+		})
+		delete(e.db, key)
+		delete(e.revisions, key)
+	}
+}
+
+// recordRevision records the current value of the key in the
+// revision history. Caller must hold the lock.
+func (e *InMemoryStore) recordRevision(key string) {
+	data := e.db[key]
+	if data.vers == 1 {
+		// new object, history starts over
+		e.revisions[key] = nil
+	}
+	e.revisions[key] = append(e.revisions[key], *data)
+}
+
+// Compact removes the history up to and including the revision.
+func (e *InMemoryStore) Compact(rev int64) {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+	deleted := []inMemDeleted{}
+	for _, del := range e.deleted {
+		if del.modRev > rev {
+			deleted = append(deleted, del)
+		}
+	}
+	e.deleted = deleted
+	// like etcd, revisions superseded at or before the compacted
+	// revision are removed
+	for key, revs := range e.revisions {
+		for len(revs) > 1 && revs[1].modRev <= rev {
+			revs = revs[1:]
+		}
+		e.revisions[key] = revs
+	}
+	if rev > e.compactRev {
+		e.compactRev = rev
+	}
+}
+
+func (e *InMemoryStore) DeletedSince(ctx context.Context, key string, rev, endRev int64, cb func(key, prevVal []byte, modRev int64)) error {
+	e.mux.Lock()
+	if rev < e.compactRev {
+		e.mux.Unlock()
+		return objstore.ErrKVStoreCompacted
+	}
+	deleted := []inMemDeleted{}
+	for _, del := range e.deleted {
+		if del.modRev > rev && del.modRev <= endRev && strings.HasPrefix(del.key, key) {
+			deleted = append(deleted, del)
+		}
+	}
+	e.mux.Unlock()
+	for _, del := range deleted {
+		cb([]byte(del.key), []byte(del.prevVal), del.modRev)
+	}
+	return nil
+}
+
+func (e *InMemoryStore) History(ctx context.Context, key string, maxRevs int, cb func(val []byte, modRev int64) error) error {
+	e.mux.Lock()
+	revs := append([]inMemData{}, e.revisions[key]...)
+	e.mux.Unlock()
+	for ii := len(revs) - 1; ii >= 0; ii-- {
+		if maxRevs > 0 && len(revs)-ii > maxRevs {
+			break
+		}
+		if err := cb([]byte(revs[ii].val), revs[ii].modRev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (e *InMemoryStore) Grant(ctx context.Context, ttl int64) (int64, error) {
 	return 0, errors.New("dummy etcd grant unsupported")
 }
@@ -341,7 +451,7 @@ func (e *InMemoryStore) commit(ctx context.Context, stm *inMemorySTM) (int64, er
 
 		if val == "" {
 			// delete
-			delete(e.db, key)
+			e.recordDelete(key)
 			log.DebugLog(log.DebugLevelEtcd, "Delete",
 				"key", key, "rev", e.rev)
 			e.triggerWatcher(ctx, objstore.SyncDelete, key, "", e.rev)
@@ -354,6 +464,7 @@ func (e *InMemoryStore) commit(ctx context.Context, stm *inMemorySTM) (int64, er
 			dd.val = val
 			dd.vers++
 			dd.modRev = e.rev
+			e.recordRevision(key)
 			log.DebugLog(log.DebugLevelEtcd, "Commit", "key", key,
 				"val", val, "ver", dd.vers, "rev", e.rev)
 			e.triggerWatcher(ctx, objstore.SyncUpdate, key, val, e.rev)
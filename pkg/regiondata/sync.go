@@ -30,6 +30,7 @@ import (
 type Sync struct {
 	store      objstore.KVStore
 	rev        int64
+	modRev     int64
 	mux        util.Mutex
 	cond       sync.Cond
 	initWait   bool
@@ -135,6 +136,11 @@ func (s *Sync) syncCb(ctx context.Context, data *objstore.SyncCbData) {
 		for _, cache := range s.caches {
 			cache.SyncListEnd(ctx)
 		}
+		// The list revision covers objects deleted before the
+		// list, which the ModRevs of the listed objects do not.
+		if data.Rev > s.modRev {
+			s.modRev = data.Rev
+		}
 	case objstore.SyncList:
 		fallthrough
 	case objstore.SyncUpdate:
@@ -154,6 +160,9 @@ func (s *Sync) syncCb(ctx context.Context, data *objstore.SyncCbData) {
 				} else if d.data.Action == objstore.SyncDelete {
 					d.cache.SyncDelete(ctx, d.data.Key, d.data.Rev, d.data.ModRev)
 				}
+				if d.data.ModRev > s.modRev {
+					s.modRev = d.data.ModRev
+				}
 			}
 			s.batch = nil
 			s.rev = data.Rev
@@ -193,6 +202,46 @@ func (s *Sync) ApplySTMWaitRev(ctx context.Context, apply func(concurrency.STM)
 	return rev, err
 }
 
+// GetSyncRev gets the revision the caches are in sync with. This
+// is the revision of the latest change to the caches, or of the
+// initial list of the data, so that changes before it, including
+// deletes, are in the revision history.
+func (s *Sync) GetSyncRev() int64 {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return s.modRev
+}
+
+// DeletedSince gets objects deleted from the caches after the
+// revision, up to endRev, from the history of the KVStore.
+func (s *Sync) DeletedSince(ctx context.Context, rev, endRev int64, cb func(typ string, prevVal []byte, modRev int64)) error {
+	history, ok := s.store.(objstore.KVStoreHistory)
+	if !ok {
+		return fmt.Errorf("KVStore does not support history")
+	}
+	prefix := fmt.Sprintf("%d/", objstore.GetRegion())
+	return history.DeletedSince(ctx, prefix, rev, endRev, func(key, prevVal []byte, modRev int64) {
+		if _, found := s.GetCache(ctx, key); !found {
+			return
+		}
+		_, typ, _, err := objstore.DbKeyPrefixParse(string(key))
+		if err != nil {
+			return
+		}
+		cb(typ, prevVal, modRev)
+	})
+}
+
+// History gets the revisions of an object from the history of the
+// KVStore, newest first, up to maxRevs revisions.
+func (s *Sync) History(ctx context.Context, typ string, key objstore.ObjKey, maxRevs int, cb func(val []byte, modRev int64) error) error {
+	history, ok := s.store.(objstore.KVStoreHistory)
+	if !ok {
+		return fmt.Errorf("KVStore does not support history")
+	}
+	return history.History(ctx, objstore.DbKeyString(typ, key), maxRevs, cb)
+}
+
 func (s *Sync) UsesOrg(org string) []string {
 	usedBy := []string{}
 	for _, cache := range s.caches {
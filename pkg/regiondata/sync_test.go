@@ -15,10 +15,15 @@
 package regiondata
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
 	"github.com/edgexr/edge-cloud-platform/pkg/objstore"
+	"github.com/edgexr/edge-cloud-platform/pkg/process"
 	"github.com/stretchr/testify/require"
 )
 
@@ -55,3 +60,360 @@ func TestSyncInsertBatch(t *testing.T) {
 		require.Equal(t, name, string(sy.batch[ii].data.Key), "%d: %s", ii, name)
 	}
 }
+
+func TestSyncDeletedSince(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelEtcd | log.DebugLevelApi)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	t.Run("inmemory", func(t *testing.T) {
+		store := &InMemoryStore{}
+		store.Start()
+		defer store.Stop()
+		testSyncDeletedSince(t, ctx, store, store.Compact)
+	})
+	t.Run("etcd", func(t *testing.T) {
+		etcd, err := StartLocalEtcdServer(process.WithCleanStartup())
+		require.Nil(t, err)
+		defer etcd.StopLocal()
+		store, err := GetEtcdClientBasic(etcd.ClientAddrs)
+		require.Nil(t, err)
+		defer store.Close()
+		err = store.CheckConnected(50, 20*time.Millisecond)
+		require.Nil(t, err)
+		compact := func(rev int64) {
+			_, err := store.client.Compact(ctx, rev)
+			require.Nil(t, err)
+		}
+		testSyncDeletedSince(t, ctx, store, compact)
+	})
+}
+
+func testSyncDeletedSince(t *testing.T, ctx context.Context, store objstore.KVStore, compact func(rev int64)) {
+	sync := InitSync(store)
+	appCache := edgeproto.NewAppCache()
+	sync.RegisterCache(appCache)
+	sync.Start()
+	defer sync.Done()
+
+	put := func(name, image string) {
+		app := edgeproto.App{
+			Key: edgeproto.AppKey{
+				Name:         name,
+				Organization: "devorg",
+				Version:      "1.0",
+			},
+			ImagePath: image,
+		}
+		val, err := json.Marshal(&app)
+		require.Nil(t, err)
+		rev, err := store.Put(ctx, objstore.DbKeyString("App", app.GetKey()), string(val))
+		require.Nil(t, err)
+		sync.SyncWait(rev)
+	}
+	del := func(name string) int64 {
+		key := edgeproto.AppKey{
+			Name:         name,
+			Organization: "devorg",
+			Version:      "1.0",
+		}
+		rev, err := store.Delete(ctx, objstore.DbKeyString("App", &key))
+		require.Nil(t, err)
+		sync.SyncWait(rev)
+		return rev
+	}
+	deletedSince := func(rev int64) (map[string]int64, error) {
+		deleted := make(map[string]int64)
+		err := sync.DeletedSince(ctx, rev, sync.GetSyncRev(), func(typ string, prevVal []byte, modRev int64) {
+			require.Equal(t, "App", typ)
+			app := edgeproto.App{}
+			require.Nil(t, json.Unmarshal(prevVal, &app))
+			deleted[app.Key.Name] = modRev
+		})
+		return deleted, err
+	}
+
+	put("app1", "image1")
+	put("app2", "image2")
+	put("app3", "image3")
+	startRev := sync.GetSyncRev()
+	require.Equal(t, 3, appCache.GetCount())
+
+	del1 := del("app1")
+	put("app2", "image2-updated")
+	del3 := del("app3")
+	require.Equal(t, del3, sync.GetSyncRev())
+
+	deleted, err := deletedSince(startRev)
+	require.Nil(t, err)
+	require.Equal(t, map[string]int64{
+		"app1": del1,
+		"app3": del3,
+	}, deleted)
+
+	deleted, err = deletedSince(del1)
+	require.Nil(t, err)
+	require.Equal(t, map[string]int64{
+		"app3": del3,
+	}, deleted)
+
+	deleted, err = deletedSince(sync.GetSyncRev())
+	require.Nil(t, err)
+	require.Equal(t, 0, len(deleted))
+
+	compact(del3)
+	_, err = deletedSince(startRev)
+	require.Equal(t, objstore.ErrKVStoreCompacted, err)
+}
+
+func TestSyncRestartDeletedSince(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelEtcd | log.DebugLevelApi)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	t.Run("inmemory", func(t *testing.T) {
+		store := &InMemoryStore{}
+		store.Start()
+		defer store.Stop()
+		testSyncRestartDeletedSince(t, ctx, store)
+	})
+	t.Run("etcd", func(t *testing.T) {
+		etcd, err := StartLocalEtcdServer(process.WithCleanStartup())
+		require.Nil(t, err)
+		defer etcd.StopLocal()
+		store, err := GetEtcdClientBasic(etcd.ClientAddrs)
+		require.Nil(t, err)
+		defer store.Close()
+		err = store.CheckConnected(50, 20*time.Millisecond)
+		require.Nil(t, err)
+		testSyncRestartDeletedSince(t, ctx, store)
+	})
+}
+
+// testSyncRestartDeletedSince checks that a delete done while the
+// sync was stopped, after the latest change to the remaining
+// objects, is found after the sync restarts.
+func testSyncRestartDeletedSince(t *testing.T, ctx context.Context, store objstore.KVStore) {
+	appKey := func(name string) string {
+		key := edgeproto.AppKey{
+			Name:         name,
+			Organization: "devorg",
+			Version:      "1.0",
+		}
+		return objstore.DbKeyString("App", &key)
+	}
+	put := func(name string) {
+		app := edgeproto.App{
+			Key: edgeproto.AppKey{
+				Name:         name,
+				Organization: "devorg",
+				Version:      "1.0",
+			},
+		}
+		val, err := json.Marshal(&app)
+		require.Nil(t, err)
+		_, err = store.Put(ctx, appKey(name), string(val))
+		require.Nil(t, err)
+	}
+
+	put("app1")
+	put("app2")
+
+	sync := InitSync(store)
+	sync.RegisterCache(edgeproto.NewAppCache())
+	sync.Start()
+	clientRev := sync.GetSyncRev()
+	sync.Done()
+
+	delRev, err := store.Delete(ctx, appKey("app2"))
+	require.Nil(t, err)
+
+	sync = InitSync(store)
+	appCache := edgeproto.NewAppCache()
+	sync.RegisterCache(appCache)
+	sync.Start()
+	defer sync.Done()
+	require.Equal(t, 1, appCache.GetCount())
+	require.GreaterOrEqual(t, sync.GetSyncRev(), delRev)
+
+	deleted := []string{}
+	err = sync.DeletedSince(ctx, clientRev, sync.GetSyncRev(), func(typ string, prevVal []byte, modRev int64) {
+		app := edgeproto.App{}
+		require.Nil(t, json.Unmarshal(prevVal, &app))
+		deleted = append(deleted, app.Key.Name)
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{"app2"}, deleted)
+}
+
+func TestSyncHistory(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelEtcd | log.DebugLevelApi)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	t.Run("inmemory", func(t *testing.T) {
+		store := &InMemoryStore{}
+		store.Start()
+		defer store.Stop()
+		testSyncHistory(t, ctx, store, store.Compact)
+	})
+	t.Run("etcd", func(t *testing.T) {
+		etcd, err := StartLocalEtcdServer(process.WithCleanStartup())
+		require.Nil(t, err)
+		defer etcd.StopLocal()
+		store, err := GetEtcdClientBasic(etcd.ClientAddrs)
+		require.Nil(t, err)
+		defer store.Close()
+		err = store.CheckConnected(50, 20*time.Millisecond)
+		require.Nil(t, err)
+		compact := func(rev int64) {
+			_, err := store.client.Compact(ctx, rev)
+			require.Nil(t, err)
+		}
+		testSyncHistory(t, ctx, store, compact)
+	})
+}
+
+func testSyncHistory(t *testing.T, ctx context.Context, store objstore.KVStore, compact func(rev int64)) {
+	sync := InitSync(store)
+	appCache := edgeproto.NewAppCache()
+	sync.RegisterCache(appCache)
+	sync.Start()
+	defer sync.Done()
+
+	key := edgeproto.AppKey{
+		Name:         "app1",
+		Organization: "devorg",
+		Version:      "1.0",
+	}
+	put := func(image string) int64 {
+		app := edgeproto.App{
+			Key:       key,
+			ImagePath: image,
+		}
+		val, err := json.Marshal(&app)
+		require.Nil(t, err)
+		rev, err := store.Put(ctx, objstore.DbKeyString("App", &key), string(val))
+		require.Nil(t, err)
+		sync.SyncWait(rev)
+		return rev
+	}
+	history := func(maxRevs int) ([]string, []int64) {
+		images := []string{}
+		revs := []int64{}
+		err := sync.History(ctx, "App", &key, maxRevs, func(val []byte, modRev int64) error {
+			app := edgeproto.App{}
+			require.Nil(t, json.Unmarshal(val, &app))
+			images = append(images, app.ImagePath)
+			revs = append(revs, modRev)
+			return nil
+		})
+		require.Nil(t, err)
+		return images, revs
+	}
+
+	images, _ := history(0)
+	require.Equal(t, 0, len(images))
+
+	rev1 := put("image1")
+	rev2 := put("image2")
+	rev3 := put("image3")
+	images, revs := history(0)
+	require.Equal(t, []string{"image3", "image2", "image1"}, images)
+	require.Equal(t, []int64{rev3, rev2, rev1}, revs)
+
+	images, _ = history(2)
+	require.Equal(t, []string{"image3", "image2"}, images)
+
+	// history starts over when the object is recreated
+	rev, err := store.Delete(ctx, objstore.DbKeyString("App", &key))
+	require.Nil(t, err)
+	sync.SyncWait(rev)
+	images, _ = history(0)
+	require.Equal(t, 0, len(images))
+	put("image4")
+	rev5 := put("image5")
+	put("image6")
+	images, _ = history(0)
+	require.Equal(t, []string{"image6", "image5", "image4"}, images)
+
+	// compacted revisions are no longer available
+	compact(rev5)
+	images, _ = history(0)
+	require.Equal(t, []string{"image6", "image5"}, images)
+}
+
+func TestListRevision(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelEtcd)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	t.Run("inmemory", func(t *testing.T) {
+		store := &InMemoryStore{}
+		store.Start()
+		defer store.Stop()
+		testListRevision(t, ctx, store, false, nil)
+	})
+	t.Run("etcd", func(t *testing.T) {
+		etcd, err := StartLocalEtcdServer(process.WithCleanStartup())
+		require.Nil(t, err)
+		defer etcd.StopLocal()
+		store, err := GetEtcdClientBasic(etcd.ClientAddrs)
+		require.Nil(t, err)
+		defer store.Close()
+		err = store.CheckConnected(50, 20*time.Millisecond)
+		require.Nil(t, err)
+		compact := func(rev int64) {
+			_, err := store.client.Compact(ctx, rev)
+			require.Nil(t, err)
+		}
+		testListRevision(t, ctx, store, true, compact)
+	})
+}
+
+func testListRevision(t *testing.T, ctx context.Context, store objstore.KVStore, hasHistory bool, compact func(rev int64)) {
+	list := func(opts ...objstore.KVOp) (map[string]string, error) {
+		kvs := make(map[string]string)
+		err := store.List("listrev/", func(key, val []byte, rev, modRev int64) error {
+			kvs[string(key)] = string(val)
+			return nil
+		}, opts...)
+		return kvs, err
+	}
+
+	_, err := store.Put(ctx, "listrev/a", "a1")
+	require.Nil(t, err)
+	rev1, err := store.Put(ctx, "listrev/b", "b1")
+	require.Nil(t, err)
+	_, err = store.Put(ctx, "listrev/a", "a2")
+	require.Nil(t, err)
+	rev2, err := store.Delete(ctx, "listrev/b")
+	require.Nil(t, err)
+
+	kvs, err := list()
+	require.Nil(t, err)
+	require.Equal(t, map[string]string{"listrev/a": "a2"}, kvs)
+	kvs, err = list(objstore.WithRevision(rev2))
+	require.Nil(t, err)
+	require.Equal(t, map[string]string{"listrev/a": "a2"}, kvs)
+
+	// in-memory store does not keep history
+	kvs, err = list(objstore.WithRevision(rev1))
+	if !hasHistory {
+		require.Equal(t, objstore.ErrKVStoreCompacted, err)
+		return
+	}
+	require.Nil(t, err)
+	require.Equal(t, map[string]string{
+		"listrev/a": "a1",
+		"listrev/b": "b1",
+	}, kvs)
+
+	compact(rev2)
+	_, err = list(objstore.WithRevision(rev1))
+	require.Equal(t, objstore.ErrKVStoreCompacted, err)
+}
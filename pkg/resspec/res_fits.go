@@ -314,3 +314,34 @@ func GetInfraNodeResources(nr *edgeproto.NodeResources, flavorLookup edgeproto.F
 	}
 	return nr.Clone(), nil
 }
+
+// ScaleKubernetesResources returns a copy of the per-replica
+// Kubernetes resources scaled up by the number of replicas.
+// The topology is per node, so is not scaled.
+func ScaleKubernetesResources(kr *edgeproto.KubernetesResources, replicas uint32) (*edgeproto.KubernetesResources, error) {
+	scaled := kr.Clone()
+	for _, pool := range []*edgeproto.NodePoolResources{scaled.CpuPool, scaled.GpuPool} {
+		if pool == nil {
+			continue
+		}
+		pool.TotalVcpus.Mult(replicas)
+		pool.TotalMemory *= uint64(replicas)
+		pool.TotalDisk *= uint64(replicas)
+		for _, gpu := range pool.TotalGpus {
+			gpu.Count *= replicas
+		}
+		for resName, val := range pool.TotalOptRes {
+			typ, spec, count, err := cloudcommon.ParseOptResVal(val)
+			if err != nil {
+				return nil, err
+			}
+			vals := []string{typ}
+			if spec != "" {
+				vals = append(vals, spec)
+			}
+			vals = append(vals, fmt.Sprintf("%d", count*int(replicas)))
+			pool.TotalOptRes[resName] = strings.Join(vals, ":")
+		}
+	}
+	return scaled, nil
+}
@@ -690,3 +690,41 @@ func TestNodeResourcesFits(t *testing.T) {
 		}
 	}
 }
+
+func TestScaleKubernetesResources(t *testing.T) {
+	kr := &edgeproto.KubernetesResources{
+		CpuPool: &edgeproto.NodePoolResources{
+			TotalVcpus:  *edgeproto.NewUdec64(1, 500*edgeproto.DecMillis),
+			TotalMemory: 1024,
+			TotalDisk:   10,
+			Topology: edgeproto.NodePoolTopology{
+				MinNodeVcpus: 2,
+			},
+		},
+		GpuPool: &edgeproto.NodePoolResources{
+			TotalVcpus:  *edgeproto.NewUdec64(2, 0),
+			TotalMemory: 2048,
+			TotalGpus: []*edgeproto.GPUResource{{
+				ModelId: "nvidia-t4",
+				Count:   1,
+			}},
+			TotalOptRes: map[string]string{
+				"gpu": "pci:t4:1",
+			},
+		},
+	}
+	scaled, err := ScaleKubernetesResources(kr, 3)
+	require.Nil(t, err)
+	require.Equal(t, "4.5", scaled.CpuPool.TotalVcpus.DecString())
+	require.Equal(t, uint64(3072), scaled.CpuPool.TotalMemory)
+	require.Equal(t, uint64(30), scaled.CpuPool.TotalDisk)
+	require.Equal(t, uint64(2), scaled.CpuPool.Topology.MinNodeVcpus)
+	require.Equal(t, "6", scaled.GpuPool.TotalVcpus.DecString())
+	require.Equal(t, uint64(6144), scaled.GpuPool.TotalMemory)
+	require.Equal(t, uint32(3), scaled.GpuPool.TotalGpus[0].Count)
+	require.Equal(t, "pci:t4:3", scaled.GpuPool.TotalOptRes["gpu"])
+	// original is unchanged
+	require.Equal(t, uint64(1024), kr.CpuPool.TotalMemory)
+	require.Equal(t, uint32(1), kr.GpuPool.TotalGpus[0].Count)
+	require.Equal(t, "pci:t4:1", kr.GpuPool.TotalOptRes["gpu"])
+}
@@ -0,0 +1,183 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmecommon
+
+import (
+	"context"
+	"sort"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+)
+
+// Edge discovery finds the closest zones and AppInsts to a device
+// without a registered client session. It backs standard APIs like
+// CAMARA Simple Edge Discovery, which identify Apps, AppInsts, and
+// Zones by their object IDs instead of by their keys.
+
+// DiscoveredZone is a zone with usable cloudlets near the device.
+type DiscoveredZone struct {
+	ZoneKey edgeproto.ZoneKey
+	ZoneId  string
+	// Distance in km to the closest usable cloudlet in the zone
+	Distance float64
+}
+
+// DiscoveredAppInst is a usable AppInst near the device.
+type DiscoveredAppInst struct {
+	AppKey     edgeproto.AppKey
+	AppId      string
+	AppInstKey edgeproto.AppInstKey
+	AppInstId  string
+	ZoneKey    edgeproto.ZoneKey
+	ZoneId     string
+	Fqdn       string
+	// Ports that are publicly visible
+	Ports []edgeproto.InstPort
+	// Distance in km to the AppInst's cloudlet
+	Distance float64
+}
+
+// GetAppKeyByObjId looks up the App key for the App's object ID.
+func GetAppKeyByObjId(objId string) (edgeproto.AppKey, bool) {
+	tbl := DmeAppTbl
+	tbl.RLock()
+	defer tbl.RUnlock()
+	for key, app := range tbl.Apps {
+		app.RLock()
+		match := app.ObjId == objId
+		app.RUnlock()
+		if match {
+			return key, true
+		}
+	}
+	return edgeproto.AppKey{}, false
+}
+
+// GetAppObjId gets the unique ID of the App, or an empty string
+// if the App is not found.
+func GetAppObjId(key *edgeproto.AppKey) string {
+	tbl := DmeAppTbl
+	tbl.RLock()
+	defer tbl.RUnlock()
+	app, found := tbl.Apps[*key]
+	if !found {
+		return ""
+	}
+	app.RLock()
+	defer app.RUnlock()
+	return app.ObjId
+}
+
+func getZoneObjId(key *edgeproto.ZoneKey) string {
+	zone := edgeproto.Zone{}
+	if DmeAppTbl.Zones.Get(key, &zone) {
+		return zone.ObjId
+	}
+	return ""
+}
+
+// FindClosestZones returns the zones with usable cloudlets for the
+// carrier, sorted by distance from the location. An empty carrier
+// searches all carriers.
+func FindClosestZones(ctx context.Context, carrier string, loc *dme.Loc, resultLimit int) []*DiscoveredZone {
+	tbl := DmeAppTbl
+	carrier = translateCarrierName(carrier)
+
+	tbl.RLock()
+	zones := map[edgeproto.ZoneKey]*DiscoveredZone{}
+	for _, cloudlet := range tbl.Cloudlets {
+		if cloudlet.ZoneKey.Name == "" {
+			continue
+		}
+		if carrier != "" && cloudlet.CloudletKey.Organization != carrier {
+			if _, found := cloudlet.AllianceCarriers[carrier]; !found {
+				continue
+			}
+		}
+		if !AreStatesUsable(cloudlet.MaintenanceState, cloudlet.State, dme.HealthCheck_HEALTH_CHECK_OK) {
+			continue
+		}
+		dist := DistanceBetween(*loc, cloudlet.GpsLocation)
+		if zone, found := zones[cloudlet.ZoneKey]; found && zone.Distance <= dist {
+			continue
+		}
+		zones[cloudlet.ZoneKey] = &DiscoveredZone{
+			ZoneKey:  cloudlet.ZoneKey,
+			Distance: dist,
+		}
+	}
+	tbl.RUnlock()
+
+	results := []*DiscoveredZone{}
+	for _, zone := range zones {
+		zone.ZoneId = getZoneObjId(&zone.ZoneKey)
+		results = append(results, zone)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Distance == results[j].Distance {
+			return results[i].ZoneKey.GetKeyString() < results[j].ZoneKey.GetKeyString()
+		}
+		return results[i].Distance < results[j].Distance
+	})
+	if resultLimit > 0 && len(results) > resultLimit {
+		results = results[:resultLimit]
+	}
+	log.SpanLog(ctx, log.DebugLevelDmereq, "find closest zones", "carrier", carrier, "loc", *loc, "found", len(results))
+	return results
+}
+
+// FindClosestAppInsts returns the usable AppInsts of the App for the
+// carrier, sorted by distance from the location. This uses the same
+// search as FindCloudlet.
+func FindClosestAppInsts(ctx context.Context, appKey *edgeproto.AppKey, carrier string, loc *dme.Loc, resultLimit int) []*DiscoveredAppInst {
+	// platform apps are not discoverable, same as FindCloudlet
+	if cloudcommon.IsPlatformApp(appKey.Organization, appKey.Name) {
+		return nil
+	}
+	list, app := findBestForCarrier(ctx, carrier, appKey, loc, resultLimit)
+	if app == nil {
+		return nil
+	}
+	app.RLock()
+	appId := app.ObjId
+	app.RUnlock()
+
+	results := []*DiscoveredAppInst{}
+	for _, found := range list {
+		inst := found.AppInst
+		ports := []edgeproto.InstPort{}
+		for _, port := range inst.ports {
+			if port.InternalVisOnly {
+				continue
+			}
+			ports = append(ports, port)
+		}
+		results = append(results, &DiscoveredAppInst{
+			AppKey:     *appKey,
+			AppId:      appId,
+			AppInstKey: inst.key,
+			AppInstId:  inst.objId,
+			ZoneKey:    inst.zoneKey,
+			ZoneId:     getZoneObjId(&inst.zoneKey),
+			Fqdn:       inst.Uri,
+			Ports:      ports,
+			Distance:   found.distance,
+		})
+	}
+	return results
+}
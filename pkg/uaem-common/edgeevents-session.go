@@ -0,0 +1,325 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmecommon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/go-redis/redis/v8"
+)
+
+// EdgeEventsSession is the state of a device's edge events
+// persistent connection. It is checkpointed so that a device that
+// reconnects, to this DME or any other DME replica, resumes where
+// it left off instead of starting over.
+type EdgeEventsSession struct {
+	// Id identifies the device and app, see GetEdgeEventsSessionId
+	Id string `json:"id"`
+	// AppInst the device is connected to
+	AppInstKey edgeproto.AppInstKey `json:"appinstkey"`
+	// Last location reported by the device
+	LastLocation dme.Loc `json:"lastlocation"`
+	// Last carrier reported by the device
+	Carrier           string                 `json:"carrier,omitempty"`
+	DeviceInfoStatic  *dme.DeviceInfoStatic  `json:"deviceinfostatic,omitempty"`
+	DeviceInfoDynamic *dme.DeviceInfoDynamic `json:"deviceinfodynamic,omitempty"`
+	// Most recent latency samples, up to EdgeEventsSessionMaxSamples
+	LatencySamples []*dme.Sample `json:"latencysamples,omitempty"`
+	// Statistics of the last processed latency samples
+	LatencyStats *dme.Statistics `json:"latencystats,omitempty"`
+	// AppInst the device was told to move to, which it has not
+	// connected to yet.
+	MovedTo   *edgeproto.AppInstKey `json:"movedto,omitempty"`
+	UpdatedAt time.Time             `json:"updatedat"`
+}
+
+// EdgeEventsSessionMaxSamples limits the number of latency samples
+// kept per session.
+var EdgeEventsSessionMaxSamples = 100
+
+// EdgeEventsSessionStore checkpoints edge events sessions.
+// Sessions are removed once their ttl has passed.
+type EdgeEventsSessionStore interface {
+	// Get returns the session, or nil if not found.
+	Get(ctx context.Context, id string) (*EdgeEventsSession, error)
+	// Put creates or updates the session.
+	Put(ctx context.Context, session *EdgeEventsSession, ttl time.Duration) error
+	// Delete removes the session.
+	Delete(ctx context.Context, id string) error
+}
+
+// EESessionStore checkpoints edge events sessions. If nil, sessions
+// are not checkpointed.
+var EESessionStore EdgeEventsSessionStore
+
+// GetEdgeEventsSessionId gets the session ID for the device and
+// app of the session cookie.
+func GetEdgeEventsSessionId(key *CookieKey) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s", key.OrgName, key.AppName, key.AppVers, key.UniqueIdType, key.UniqueId)
+}
+
+// MemEdgeEventsSessionStore keeps sessions in process memory.
+type MemEdgeEventsSessionStore struct {
+	mux       sync.Mutex
+	sessions  map[string]*memEdgeEventsSession
+	lastSweep time.Time
+}
+
+type memEdgeEventsSession struct {
+	data      []byte
+	expiresAt time.Time
+}
+
+// memEdgeEventsSessionSweepInterval is how often expired sessions
+// are removed from memory.
+var memEdgeEventsSessionSweepInterval = time.Minute
+
+func NewMemEdgeEventsSessionStore() *MemEdgeEventsSessionStore {
+	return &MemEdgeEventsSessionStore{
+		sessions:  make(map[string]*memEdgeEventsSession),
+		lastSweep: time.Now(),
+	}
+}
+
+func (s *MemEdgeEventsSessionStore) Get(ctx context.Context, id string) (*EdgeEventsSession, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	ms, ok := s.sessions[id]
+	if !ok {
+		return nil, nil
+	}
+	if time.Now().After(ms.expiresAt) {
+		delete(s.sessions, id)
+		return nil, nil
+	}
+	return unmarshalEdgeEventsSession(id, ms.data)
+}
+
+func (s *MemEdgeEventsSessionStore) Put(ctx context.Context, session *EdgeEventsSession, ttl time.Duration) error {
+	// store a serialized copy, which also keeps behavior the
+	// same as the redis store.
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	now := time.Now()
+	s.sessions[session.Id] = &memEdgeEventsSession{
+		data:      data,
+		expiresAt: now.Add(ttl),
+	}
+	if now.Sub(s.lastSweep) > memEdgeEventsSessionSweepInterval {
+		for id, ms := range s.sessions {
+			if now.After(ms.expiresAt) {
+				delete(s.sessions, id)
+			}
+		}
+		s.lastSweep = now
+	}
+	return nil
+}
+
+func (s *MemEdgeEventsSessionStore) Delete(ctx context.Context, id string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	delete(s.sessions, id)
+	return nil
+}
+
+const RedisEdgeEventsSessionKeyPrefix = "edgeeventssession"
+
+// RedisEdgeEventsSessionStore keeps sessions in redis so that they
+// are shared by all DME replicas and survive DME restarts.
+type RedisEdgeEventsSessionStore struct {
+	client *redis.Client
+}
+
+func NewRedisEdgeEventsSessionStore(client *redis.Client) *RedisEdgeEventsSessionStore {
+	return &RedisEdgeEventsSessionStore{
+		client: client,
+	}
+}
+
+func getRedisEdgeEventsSessionKey(id string) string {
+	return fmt.Sprintf("%s:%s", RedisEdgeEventsSessionKeyPrefix, id)
+}
+
+func (s *RedisEdgeEventsSessionStore) Get(ctx context.Context, id string) (*EdgeEventsSession, error) {
+	val, err := s.client.Get(ctx, getRedisEdgeEventsSessionKey(id)).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalEdgeEventsSession(id, []byte(val))
+}
+
+func (s *RedisEdgeEventsSessionStore) Put(ctx context.Context, session *EdgeEventsSession, ttl time.Duration) error {
+	out, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	return s.client.Set(ctx, getRedisEdgeEventsSessionKey(session.Id), string(out), ttl).Err()
+}
+
+func (s *RedisEdgeEventsSessionStore) Delete(ctx context.Context, id string) error {
+	return s.client.Del(ctx, getRedisEdgeEventsSessionKey(id)).Err()
+}
+
+func unmarshalEdgeEventsSession(id string, data []byte) (*EdgeEventsSession, error) {
+	session := &EdgeEventsSession{}
+	if err := json.Unmarshal(data, session); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal edge events session %s, %s", id, err)
+	}
+	return session, nil
+}
+
+// edgeEventsSessionTracker tracks and checkpoints the session of a
+// single persistent connection. It is safe for concurrent use, as
+// the edge events plugin may send events to the client while the
+// connection is processing client events.
+type edgeEventsSessionTracker struct {
+	mux     sync.Mutex
+	session EdgeEventsSession
+	ttl     time.Duration
+}
+
+// newEdgeEventsSessionTracker loads the checkpointed session for
+// the device. The session is only resumed if the device is
+// reconnecting to the same AppInst, otherwise a new session is
+// started.
+func newEdgeEventsSessionTracker(ctx context.Context, cookieKey *CookieKey, appInstKey edgeproto.AppInstKey, ttl time.Duration) (*edgeEventsSessionTracker, bool) {
+	tracker := &edgeEventsSessionTracker{
+		ttl: ttl,
+	}
+	tracker.session.Id = GetEdgeEventsSessionId(cookieKey)
+	tracker.session.AppInstKey = appInstKey
+	store := EESessionStore
+	if store == nil {
+		return tracker, false
+	}
+	session, err := store.Get(ctx, tracker.session.Id)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelDmereq, "failed to get edge events session", "id", tracker.session.Id, "err", err)
+		return tracker, false
+	}
+	if session == nil || session.AppInstKey != appInstKey {
+		return tracker, false
+	}
+	tracker.session = *session
+	log.SpanLog(ctx, log.DebugLevelDmereq, "resuming edge events session", "id", session.Id, "appinst", appInstKey, "updatedAt", session.UpdatedAt)
+	return tracker, true
+}
+
+// get returns a copy of the session
+func (s *edgeEventsSessionTracker) get() EdgeEventsSession {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return s.session
+}
+
+// update modifies the session and checkpoints it.
+func (s *edgeEventsSessionTracker) update(ctx context.Context, fn func(session *EdgeEventsSession)) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	fn(&s.session)
+	s.session.UpdatedAt = time.Now()
+	store := EESessionStore
+	if store == nil {
+		return
+	}
+	// save while holding the lock so that checkpoints are
+	// written in order.
+	if err := store.Put(ctx, &s.session, s.ttl); err != nil {
+		log.SpanLog(ctx, log.DebugLevelDmereq, "failed to checkpoint edge events session", "id", s.session.Id, "err", err)
+	}
+}
+
+// delete removes the checkpoint once the client terminates
+// the connection.
+func (s *edgeEventsSessionTracker) delete(ctx context.Context) {
+	store := EESessionStore
+	if store == nil {
+		return
+	}
+	if err := store.Delete(ctx, s.session.Id); err != nil {
+		log.SpanLog(ctx, log.DebugLevelDmereq, "failed to delete edge events session", "id", s.session.Id, "err", err)
+	}
+}
+
+func (s *EdgeEventsSession) addLatencySamples(samples []*dme.Sample, stats *dme.Statistics) {
+	s.LatencySamples = append(s.LatencySamples, samples...)
+	if extra := len(s.LatencySamples) - EdgeEventsSessionMaxSamples; extra > 0 {
+		s.LatencySamples = s.LatencySamples[extra:]
+	}
+	s.LatencyStats = stats
+}
+
+// getMovedToCloudlet creates the FindCloudletReply for the AppInst
+// the device was previously told to move to, so that it can be
+// resent without searching for a cloudlet again. Returns nil if the
+// AppInst is no longer available.
+func getMovedToCloudlet(ctx context.Context, appKey *edgeproto.AppKey, appInstKey *edgeproto.AppInstKey, loc *dme.Loc, edgeEventsCookieExpiration time.Duration) *dme.FindCloudletReply {
+	tbl := DmeAppTbl
+	tbl.RLock()
+	defer tbl.RUnlock()
+	cloudletKey, ok := tbl.CarriersByAppInst[*appInstKey]
+	if !ok {
+		return nil
+	}
+	app, ok := tbl.Apps[*appKey]
+	if !ok {
+		return nil
+	}
+	app.RLock()
+	defer app.RUnlock()
+	insts, ok := app.Carriers[cloudletKey.Organization]
+	if !ok {
+		return nil
+	}
+	inst, ok := insts.Insts[*appInstKey]
+	if !ok || !IsAppInstUsable(inst) {
+		return nil
+	}
+	reply := new(dme.FindCloudletReply)
+	ConstructFindCloudletReplyFromDmeAppInst(ctx, inst, loc, reply, edgeEventsCookieExpiration)
+	return reply
+}
+
+// getNewCloudletAppInstKey gets the AppInst that an event tells
+// the client to move to, if any.
+func getNewCloudletAppInstKey(event *dme.ServerEdgeEvent) *edgeproto.AppInstKey {
+	reply := event.NewCloudlet
+	if reply == nil || reply.Status != dme.FindCloudletReply_FIND_FOUND || reply.Tags == nil {
+		return nil
+	}
+	key := edgeproto.AppInstKey{
+		Name:         reply.Tags[edgeproto.AppInstKeyTagName],
+		Organization: reply.Tags[edgeproto.AppInstKeyTagOrganization],
+	}
+	if key.Name == "" {
+		return nil
+	}
+	return &key
+}
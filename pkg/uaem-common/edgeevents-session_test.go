@@ -0,0 +1,188 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmecommon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/rediscache"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEdgeEventsSessionStores(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelDmereq)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	redisServer, err := rediscache.NewMockRedisServer()
+	require.Nil(t, err)
+	defer redisServer.Close()
+	client, err := rediscache.NewClient(ctx, &rediscache.RedisConfig{
+		StandaloneAddr: redisServer.GetStandaloneAddr(),
+	})
+	require.Nil(t, err)
+	defer client.Close()
+
+	t.Run("mem", func(t *testing.T) {
+		sleep := func(d time.Duration) { time.Sleep(d) }
+		testEdgeEventsSessionStore(t, ctx, NewMemEdgeEventsSessionStore(), sleep)
+	})
+	t.Run("redis", func(t *testing.T) {
+		testEdgeEventsSessionStore(t, ctx, NewRedisEdgeEventsSessionStore(client), redisServer.FastForward)
+	})
+}
+
+func testEdgeEventsSessionStore(t *testing.T, ctx context.Context, store EdgeEventsSessionStore, fastForward func(d time.Duration)) {
+	defer func() {
+		EESessionStore = nil
+	}()
+	EESessionStore = store
+
+	cookieKey := &CookieKey{
+		OrgName:      "devorg",
+		AppName:      "app",
+		AppVers:      "1.0",
+		UniqueIdType: "dme",
+		UniqueId:     "device1",
+	}
+	appInstKey := edgeproto.AppInstKey{
+		Name:         "inst1",
+		Organization: "devorg",
+	}
+	otherAppInstKey := edgeproto.AppInstKey{
+		Name:         "inst2",
+		Organization: "devorg",
+	}
+	loc := dme.Loc{
+		Latitude:  50,
+		Longitude: 10,
+	}
+
+	// no session yet
+	tracker, resumed := newEdgeEventsSessionTracker(ctx, cookieKey, appInstKey, time.Minute)
+	require.False(t, resumed)
+	require.Equal(t, GetEdgeEventsSessionId(cookieKey), tracker.get().Id)
+
+	// checkpoint the session
+	tracker.update(ctx, func(session *EdgeEventsSession) {
+		session.LastLocation = loc
+		session.Carrier = "carrier1"
+	})
+	stats := &dme.Statistics{
+		Avg:        5,
+		NumSamples: 2,
+	}
+	tracker.update(ctx, func(session *EdgeEventsSession) {
+		session.addLatencySamples([]*dme.Sample{{Value: 4}, {Value: 6}}, stats)
+	})
+	tracker.update(ctx, func(session *EdgeEventsSession) {
+		session.MovedTo = &otherAppInstKey
+	})
+
+	// reconnect to the same AppInst resumes the session
+	tracker2, resumed := newEdgeEventsSessionTracker(ctx, cookieKey, appInstKey, time.Minute)
+	require.True(t, resumed)
+	session := tracker2.get()
+	require.Equal(t, loc.Latitude, session.LastLocation.Latitude)
+	require.Equal(t, loc.Longitude, session.LastLocation.Longitude)
+	require.Equal(t, "carrier1", session.Carrier)
+	require.Equal(t, 2, len(session.LatencySamples))
+	require.Equal(t, stats.Avg, session.LatencyStats.Avg)
+	require.NotNil(t, session.MovedTo)
+	require.Equal(t, otherAppInstKey, *session.MovedTo)
+
+	// connecting to a different AppInst starts a new session
+	tracker3, resumed := newEdgeEventsSessionTracker(ctx, cookieKey, otherAppInstKey, time.Minute)
+	require.False(t, resumed)
+	require.Nil(t, tracker3.get().MovedTo)
+	require.Equal(t, 0, len(tracker3.get().LatencySamples))
+
+	// terminating the connection removes the session
+	tracker2.delete(ctx)
+	_, resumed = newEdgeEventsSessionTracker(ctx, cookieKey, appInstKey, time.Minute)
+	require.False(t, resumed)
+
+	// sessions expire
+	shortTracker, _ := newEdgeEventsSessionTracker(ctx, cookieKey, appInstKey, time.Second)
+	shortTracker.update(ctx, func(session *EdgeEventsSession) {})
+	_, resumed = newEdgeEventsSessionTracker(ctx, cookieKey, appInstKey, time.Minute)
+	require.True(t, resumed)
+	fastForward(2 * time.Second)
+	_, resumed = newEdgeEventsSessionTracker(ctx, cookieKey, appInstKey, time.Minute)
+	require.False(t, resumed)
+}
+
+func TestMemEdgeEventsSessionStoreSweep(t *testing.T) {
+	defer func(interval time.Duration) {
+		memEdgeEventsSessionSweepInterval = interval
+	}(memEdgeEventsSessionSweepInterval)
+	memEdgeEventsSessionSweepInterval = 0
+	ctx := context.Background()
+
+	// expired sessions are removed even if they are never read
+	store := NewMemEdgeEventsSessionStore()
+	require.Nil(t, store.Put(ctx, &EdgeEventsSession{Id: "expired"}, time.Millisecond))
+	time.Sleep(5 * time.Millisecond)
+	require.Nil(t, store.Put(ctx, &EdgeEventsSession{Id: "active"}, time.Minute))
+	require.Equal(t, 1, len(store.sessions))
+	_, found := store.sessions["active"]
+	require.True(t, found)
+}
+
+func TestEdgeEventsSessionLatencySamples(t *testing.T) {
+	defer func(max int) {
+		EdgeEventsSessionMaxSamples = max
+	}(EdgeEventsSessionMaxSamples)
+	EdgeEventsSessionMaxSamples = 3
+
+	session := EdgeEventsSession{}
+	session.addLatencySamples([]*dme.Sample{{Value: 1}, {Value: 2}}, nil)
+	session.addLatencySamples([]*dme.Sample{{Value: 3}, {Value: 4}}, &dme.Statistics{Avg: 3.5})
+	require.Equal(t, 3, len(session.LatencySamples))
+	require.Equal(t, float64(2), session.LatencySamples[0].Value)
+	require.Equal(t, float64(4), session.LatencySamples[2].Value)
+	require.Equal(t, 3.5, session.LatencyStats.Avg)
+}
+
+func TestGetNewCloudletAppInstKey(t *testing.T) {
+	event := &dme.ServerEdgeEvent{
+		EventType: dme.ServerEdgeEvent_EVENT_LATENCY_PROCESSED,
+	}
+	require.Nil(t, getNewCloudletAppInstKey(event))
+
+	event = &dme.ServerEdgeEvent{
+		EventType: dme.ServerEdgeEvent_EVENT_APPINST_HEALTH,
+		NewCloudlet: &dme.FindCloudletReply{
+			Status: dme.FindCloudletReply_FIND_FOUND,
+			Tags: map[string]string{
+				edgeproto.AppInstKeyTagName:         "inst2",
+				edgeproto.AppInstKeyTagOrganization: "devorg",
+			},
+		},
+	}
+	key := getNewCloudletAppInstKey(event)
+	require.NotNil(t, key)
+	require.Equal(t, "inst2", key.Name)
+	require.Equal(t, "devorg", key.Organization)
+
+	event.NewCloudlet.Status = dme.FindCloudletReply_FIND_NOTFOUND
+	require.Nil(t, getNewCloudletAppInstKey(event))
+}
@@ -0,0 +1,282 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmecommon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/go-redis/redis/v8"
+)
+
+// FindCloudlet distributes clients between AppInsts whose scores
+// are near equal to the best score, to avoid sending every client
+// to the same cloudlet. Optionally, clients are kept on the same
+// AppInst until they move to a different location tile.
+
+// FindCloudletMaxCandidates is the maximum number of AppInsts
+// considered when distributing clients.
+var FindCloudletMaxCandidates = 10
+
+// FindCloudletStickySession is the AppInst a client was sent to.
+type FindCloudletStickySession struct {
+	AppInstKey   edgeproto.AppInstKey `json:"appinstkey"`
+	LocationTile string               `json:"locationtile"`
+}
+
+// FindCloudletStickyStore stores sticky sessions by client.
+// Sessions are removed once their ttl has passed.
+type FindCloudletStickyStore interface {
+	// Get returns the session, or nil if not found.
+	Get(ctx context.Context, id string) (*FindCloudletStickySession, error)
+	// Put creates or updates the session.
+	Put(ctx context.Context, id string, session *FindCloudletStickySession, ttl time.Duration) error
+}
+
+// FCStickyStore stores sticky sessions. If nil, sessions are not
+// sticky regardless of the settings.
+var FCStickyStore FindCloudletStickyStore
+
+type ctxCurrentAppInstKey struct{}
+
+// NewCurrentAppInstContext adds the AppInst the client is currently
+// connected to, so that FindCloudlet will keep the client on it if
+// it is one of the best choices.
+func NewCurrentAppInstContext(ctx context.Context, key *edgeproto.AppInstKey) context.Context {
+	return context.WithValue(ctx, ctxCurrentAppInstKey{}, key)
+}
+
+func currentAppInstFromContext(ctx context.Context) (*edgeproto.AppInstKey, bool) {
+	key, ok := ctx.Value(ctxCurrentAppInstKey{}).(*edgeproto.AppInstKey)
+	return key, ok
+}
+
+// getFindCloudletCandidates gets the search results that are near
+// equal to the best result, sorted by AppInst key.
+func getFindCloudletCandidates(list []*foundAppInst) []*foundAppInst {
+	best := list[0]
+	for _, found := range list {
+		if found.score < best.score {
+			best = found
+		}
+	}
+	candidates := []*foundAppInst{}
+	for _, found := range list {
+		if found.score-best.score < VeryCloseDistanceKm {
+			candidates = append(candidates, found)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].AppInst.key.GetKeyString() < candidates[j].AppInst.key.GetKeyString()
+	})
+	return candidates
+}
+
+// selectFindCloudletAppInst chooses the AppInst to return from the
+// search results.
+func selectFindCloudletAppInst(ctx context.Context, app *DmeApp, list []*foundAppInst, loc *dme.Loc, stickyTTL time.Duration) *foundAppInst {
+	if !OptionFindCloudletRandomizeVeryClose {
+		return list[0]
+	}
+	candidates := getFindCloudletCandidates(list)
+
+	if key, ok := currentAppInstFromContext(ctx); ok {
+		if found := findCandidate(candidates, key); found != nil {
+			log.SpanLog(ctx, log.DebugLevelDmereq, "findCloudlet keeping current appinst", "appinst", key)
+			return found
+		}
+	}
+
+	stickyStore := FCStickyStore
+	stickyId := ""
+	tile := ""
+	if Settings.FindCloudletStickySessions && stickyStore != nil {
+		if ckey, ok := CookieFromContext(ctx); ok {
+			stickyId = GetEdgeEventsSessionId(ckey)
+			tile = GetLocationTileFromGpsLocation(loc, getLocationTileSideLengthKm())
+			sticky, err := stickyStore.Get(ctx, stickyId)
+			if err != nil {
+				log.SpanLog(ctx, log.DebugLevelDmereq, "failed to get findCloudlet sticky session", "id", stickyId, "err", err)
+			} else if sticky != nil && sticky.LocationTile == tile {
+				if found := findCandidate(candidates, &sticky.AppInstKey); found != nil {
+					log.SpanLog(ctx, log.DebugLevelDmereq, "findCloudlet using sticky appinst", "appinst", sticky.AppInstKey)
+					return found
+				}
+			}
+		}
+	}
+
+	selected := distributeFindCloudlet(app, candidates, Settings.FindCloudletDistribution)
+	log.SpanLog(ctx, log.DebugLevelDmereq, "findCloudlet distributed appinst", "appinst", selected.AppInst.key, "candidates", len(candidates), "distribution", Settings.FindCloudletDistribution)
+
+	if stickyId != "" {
+		sticky := &FindCloudletStickySession{
+			AppInstKey:   selected.AppInst.key,
+			LocationTile: tile,
+		}
+		if err := stickyStore.Put(ctx, stickyId, sticky, stickyTTL); err != nil {
+			log.SpanLog(ctx, log.DebugLevelDmereq, "failed to save findCloudlet sticky session", "id", stickyId, "err", err)
+		}
+	}
+	return selected
+}
+
+func findCandidate(candidates []*foundAppInst, key *edgeproto.AppInstKey) *foundAppInst {
+	for _, found := range candidates {
+		if found.AppInst.key == *key {
+			return found
+		}
+	}
+	return nil
+}
+
+func distributeFindCloudlet(app *DmeApp, candidates []*foundAppInst, distribution edgeproto.FindCloudletDistribution) *foundAppInst {
+	if len(candidates) == 1 {
+		return candidates[0]
+	}
+	switch distribution {
+	case edgeproto.FindCloudletDistribution_DISTRIBUTION_ROUND_ROBIN:
+		count := app.roundRobinCount.Add(1) - 1
+		return candidates[count%uint64(len(candidates))]
+	case edgeproto.FindCloudletDistribution_DISTRIBUTION_WEIGHTED_RANDOM:
+		// weight by free resources so that less loaded
+		// cloudlets get more clients.
+		weights := make([]float64, len(candidates))
+		total := 0.0
+		for ii, found := range candidates {
+			weights[ii] = max(1, 100-found.AppInst.CloudletResourceUsage)
+			total += weights[ii]
+		}
+		r := rand.Float64() * total
+		for ii, weight := range weights {
+			if r < weight {
+				return candidates[ii]
+			}
+			r -= weight
+		}
+		return candidates[len(candidates)-1]
+	default:
+		return candidates[rand.Intn(len(candidates))]
+	}
+}
+
+func getLocationTileSideLengthKm() int {
+	if Settings.LocationTileSideLengthKm > 0 {
+		return int(Settings.LocationTileSideLengthKm)
+	}
+	return int(edgeproto.GetDefaultSettings().LocationTileSideLengthKm)
+}
+
+// MemFindCloudletStickyStore keeps sticky sessions in process memory.
+type MemFindCloudletStickyStore struct {
+	mux       sync.Mutex
+	sessions  map[string]*memFindCloudletStickySession
+	lastSweep time.Time
+}
+
+type memFindCloudletStickySession struct {
+	session   FindCloudletStickySession
+	expiresAt time.Time
+}
+
+// memFindCloudletStickySweepInterval is how often expired sessions
+// are removed from memory.
+var memFindCloudletStickySweepInterval = time.Minute
+
+func NewMemFindCloudletStickyStore() *MemFindCloudletStickyStore {
+	return &MemFindCloudletStickyStore{
+		sessions:  make(map[string]*memFindCloudletStickySession),
+		lastSweep: time.Now(),
+	}
+}
+
+func (s *MemFindCloudletStickyStore) Get(ctx context.Context, id string) (*FindCloudletStickySession, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	ms, ok := s.sessions[id]
+	if !ok || time.Now().After(ms.expiresAt) {
+		return nil, nil
+	}
+	session := ms.session
+	return &session, nil
+}
+
+func (s *MemFindCloudletStickyStore) Put(ctx context.Context, id string, session *FindCloudletStickySession, ttl time.Duration) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	now := time.Now()
+	s.sessions[id] = &memFindCloudletStickySession{
+		session:   *session,
+		expiresAt: now.Add(ttl),
+	}
+	if now.Sub(s.lastSweep) > memFindCloudletStickySweepInterval {
+		for id, ms := range s.sessions {
+			if now.After(ms.expiresAt) {
+				delete(s.sessions, id)
+			}
+		}
+		s.lastSweep = now
+	}
+	return nil
+}
+
+const RedisFindCloudletStickyKeyPrefix = "findcloudletsticky"
+
+// RedisFindCloudletStickyStore keeps sticky sessions in redis so
+// that they are shared by all DME replicas.
+type RedisFindCloudletStickyStore struct {
+	client *redis.Client
+}
+
+func NewRedisFindCloudletStickyStore(client *redis.Client) *RedisFindCloudletStickyStore {
+	return &RedisFindCloudletStickyStore{
+		client: client,
+	}
+}
+
+func getRedisFindCloudletStickyKey(id string) string {
+	return fmt.Sprintf("%s:%s", RedisFindCloudletStickyKeyPrefix, id)
+}
+
+func (s *RedisFindCloudletStickyStore) Get(ctx context.Context, id string) (*FindCloudletStickySession, error) {
+	val, err := s.client.Get(ctx, getRedisFindCloudletStickyKey(id)).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	session := &FindCloudletStickySession{}
+	if err := json.Unmarshal([]byte(val), session); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal findCloudlet sticky session %s, %s", id, err)
+	}
+	return session, nil
+}
+
+func (s *RedisFindCloudletStickyStore) Put(ctx context.Context, id string, session *FindCloudletStickySession, ttl time.Duration) error {
+	out, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	return s.client.Set(ctx, getRedisFindCloudletStickyKey(id), string(out), ttl).Err()
+}
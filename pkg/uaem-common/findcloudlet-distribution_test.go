@@ -0,0 +1,212 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmecommon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/rediscache"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindCloudletDistribution(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelDmereq)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	defer func(settings edgeproto.Settings, store FindCloudletStickyStore, randomize bool) {
+		Settings = settings
+		FCStickyStore = store
+		OptionFindCloudletRandomizeVeryClose = randomize
+	}(Settings, FCStickyStore, OptionFindCloudletRandomizeVeryClose)
+	Settings = *edgeproto.GetDefaultSettings()
+	FCStickyStore = NewMemFindCloudletStickyStore()
+	OptionFindCloudletRandomizeVeryClose = true
+
+	newFound := func(name string, score float64) *foundAppInst {
+		return &foundAppInst{
+			distance: score,
+			score:    score,
+			AppInst: &DmeAppInst{
+				key: edgeproto.AppInstKey{
+					Name:         name,
+					Organization: "devorg",
+				},
+			},
+		}
+	}
+	a := newFound("a", 10.5)
+	b := newFound("b", 10.2)
+	c := newFound("c", 11)
+	d := newFound("d", 30)
+	list := []*foundAppInst{b, a, c, d}
+
+	// only AppInsts within VeryCloseDistanceKm of the best are candidates
+	candidates := getFindCloudletCandidates(list)
+	require.Equal(t, []string{"a", "b", "c"}, foundNames(candidates))
+
+	app := &DmeApp{}
+	loc := &dme.Loc{
+		Latitude:  50,
+		Longitude: 10,
+	}
+	selectNames := func(ctx context.Context, count int) []string {
+		names := []string{}
+		for ii := 0; ii < count; ii++ {
+			found := selectFindCloudletAppInst(ctx, app, list, loc, time.Minute)
+			names = append(names, found.AppInst.key.Name)
+		}
+		return names
+	}
+
+	// no distribution when randomization is disabled
+	OptionFindCloudletRandomizeVeryClose = false
+	require.Equal(t, []string{"b", "b", "b"}, selectNames(ctx, 3))
+	OptionFindCloudletRandomizeVeryClose = true
+
+	// round robin
+	Settings.FindCloudletDistribution = edgeproto.FindCloudletDistribution_DISTRIBUTION_ROUND_ROBIN
+	require.Equal(t, []string{"a", "b", "c", "a", "b", "c"}, selectNames(ctx, 6))
+
+	// random only chooses candidates
+	Settings.FindCloudletDistribution = edgeproto.FindCloudletDistribution_DISTRIBUTION_RANDOM
+	counts := countNames(selectNames(ctx, 300))
+	require.Equal(t, 3, len(counts))
+	require.Equal(t, 0, counts["d"])
+
+	// weighted random favors cloudlets with free resources
+	Settings.FindCloudletDistribution = edgeproto.FindCloudletDistribution_DISTRIBUTION_WEIGHTED_RANDOM
+	a.AppInst.CloudletResourceUsage = 99
+	b.AppInst.CloudletResourceUsage = 99
+	c.AppInst.CloudletResourceUsage = 0
+	counts = countNames(selectNames(ctx, 300))
+	require.Greater(t, counts["c"], counts["a"]+counts["b"])
+	require.Equal(t, 0, counts["d"])
+
+	// current AppInst is kept if it is a candidate
+	Settings.FindCloudletDistribution = edgeproto.FindCloudletDistribution_DISTRIBUTION_ROUND_ROBIN
+	currentCtx := NewCurrentAppInstContext(ctx, &a.AppInst.key)
+	require.Equal(t, []string{"a", "a", "a"}, selectNames(currentCtx, 3))
+	currentCtx = NewCurrentAppInstContext(ctx, &d.AppInst.key)
+	require.NotContains(t, selectNames(currentCtx, 3), "d")
+
+	// sticky sessions keep the client on the same AppInst
+	Settings.FindCloudletStickySessions = true
+	cookieKey := &CookieKey{
+		OrgName:      "devorg",
+		AppName:      "app",
+		AppVers:      "1.0",
+		UniqueIdType: "dme",
+		UniqueId:     "device1",
+	}
+	cookieCtx := NewCookieContext(ctx, cookieKey)
+	names := selectNames(cookieCtx, 3)
+	require.Equal(t, names[0], names[1])
+	require.Equal(t, names[0], names[2])
+	// clients without a cookie are not sticky
+	require.Equal(t, 3, len(countNames(selectNames(ctx, 3))))
+
+	// moving to a different location tile allows a new AppInst
+	first := names[0]
+	loc.Latitude = 51
+	names = selectNames(cookieCtx, 3)
+	require.NotEqual(t, first, names[0])
+	require.Equal(t, names[0], names[1])
+	require.Equal(t, names[0], names[2])
+	loc.Latitude = 50
+
+	// sticky AppInst that is no longer a candidate is replaced
+	moved := names[0]
+	list = []*foundAppInst{}
+	for _, found := range []*foundAppInst{a, b, c} {
+		if found.AppInst.key.Name != moved {
+			list = append(list, found)
+		}
+	}
+	loc.Latitude = 51
+	names = selectNames(cookieCtx, 2)
+	require.NotEqual(t, moved, names[0])
+	require.Equal(t, names[0], names[1])
+}
+
+func TestFindCloudletStickyStores(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelDmereq)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	redisServer, err := rediscache.NewMockRedisServer()
+	require.Nil(t, err)
+	defer redisServer.Close()
+	client, err := rediscache.NewClient(ctx, &rediscache.RedisConfig{
+		StandaloneAddr: redisServer.GetStandaloneAddr(),
+	})
+	require.Nil(t, err)
+	defer client.Close()
+
+	t.Run("mem", func(t *testing.T) {
+		sleep := func(d time.Duration) { time.Sleep(d) }
+		testFindCloudletStickyStore(t, ctx, NewMemFindCloudletStickyStore(), sleep)
+	})
+	t.Run("redis", func(t *testing.T) {
+		testFindCloudletStickyStore(t, ctx, NewRedisFindCloudletStickyStore(client), redisServer.FastForward)
+	})
+}
+
+func testFindCloudletStickyStore(t *testing.T, ctx context.Context, store FindCloudletStickyStore, fastForward func(d time.Duration)) {
+	session, err := store.Get(ctx, "id1")
+	require.Nil(t, err)
+	require.Nil(t, session)
+
+	in := &FindCloudletStickySession{
+		AppInstKey: edgeproto.AppInstKey{
+			Name:         "inst1",
+			Organization: "devorg",
+		},
+		LocationTile: "tile1",
+	}
+	err = store.Put(ctx, "id1", in, 100*time.Millisecond)
+	require.Nil(t, err)
+	session, err = store.Get(ctx, "id1")
+	require.Nil(t, err)
+	require.Equal(t, in, session)
+
+	fastForward(200 * time.Millisecond)
+	session, err = store.Get(ctx, "id1")
+	require.Nil(t, err)
+	require.Nil(t, session)
+}
+
+func foundNames(list []*foundAppInst) []string {
+	names := []string{}
+	for _, found := range list {
+		names = append(names, found.AppInst.key.Name)
+	}
+	return names
+}
+
+func countNames(names []string) map[string]int {
+	counts := map[string]int{}
+	for _, name := range names {
+		counts[name]++
+	}
+	return counts
+}
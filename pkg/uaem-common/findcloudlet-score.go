@@ -0,0 +1,101 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmecommon
+
+import (
+	"context"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+)
+
+// FindCloudletScorer scores AppInsts to rank them for FindCloudlet.
+// Lower scores are better. Scores are in units of distance (km), so
+// that AppInsts whose scores are within VeryCloseDistanceKm of each
+// other are treated as equivalent.
+type FindCloudletScorer interface {
+	Score(ctx context.Context, in *FindCloudletScoreInput) float64
+}
+
+// FindCloudletScoreInput is the AppInst to be scored.
+type FindCloudletScoreInput struct {
+	App     *DmeApp
+	AppInst *DmeAppInst
+	// Carrier of the AppInst
+	Carrier string
+	// Distance in km from the client to the AppInst, padded to
+	// favor the requested carrier.
+	Distance float64
+}
+
+// FCScorer is the scorer used by FindCloudlet. It may be replaced
+// to customize how AppInsts are ranked.
+var FCScorer FindCloudletScorer = &WeightedFindCloudletScorer{}
+
+// WeightedFindCloudletScorer scores AppInsts by a weighted sum of
+// distance, latency reported by clients, cloudlet resource usage,
+// and AppInst health. Weights are taken from the App, or from the
+// global settings if the App does not specify them.
+type WeightedFindCloudletScorer struct{}
+
+func (s *WeightedFindCloudletScorer) Score(ctx context.Context, in *FindCloudletScoreInput) float64 {
+	weights := GetFindCloudletScoreWeights(in.App)
+	score := weights.Distance * in.Distance
+	if weights.Latency > 0 {
+		if latency, ok := AppInstLatencies.Get(in.AppInst.key); ok {
+			score += weights.Latency * latency
+		}
+	}
+	if weights.ResourceUsage > 0 {
+		score += weights.ResourceUsage * in.AppInst.CloudletResourceUsage
+	}
+	if weights.Health > 0 && in.AppInst.AppInstHealth != dme.HealthCheck_HEALTH_CHECK_OK {
+		score += weights.Health
+	}
+	return score
+}
+
+// GetFindCloudletScoreWeights gets the weights to score the App's
+// AppInsts. If no weights are set, only distance is used.
+func GetFindCloudletScoreWeights(app *DmeApp) *edgeproto.FindCloudletScoreWeights {
+	if app != nil && app.FindCloudletScoreWeights != nil && !app.FindCloudletScoreWeights.IsZero() {
+		return app.FindCloudletScoreWeights
+	}
+	weights := Settings.FindCloudletScoreWeights
+	if weights.IsZero() {
+		return &defaultFindCloudletScoreWeights
+	}
+	return &weights
+}
+
+var defaultFindCloudletScoreWeights = edgeproto.FindCloudletScoreWeights{
+	Distance: 1,
+}
+
+// getCloudletResourceUsage gets the usage percent of the most used
+// infra resource on the cloudlet.
+func getCloudletResourceUsage(info *edgeproto.CloudletInfo) float64 {
+	usage := 0.0
+	for _, res := range info.ResourcesSnapshot.Info {
+		if res.InfraMaxValue == 0 {
+			continue
+		}
+		resUsage := float64(res.Value) * 100 / float64(res.InfraMaxValue)
+		if resUsage > usage {
+			usage = resUsage
+		}
+	}
+	return usage
+}
@@ -0,0 +1,160 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmecommon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindCloudletScore(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelDmereq)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	defer func(settings edgeproto.Settings, latencies *AppInstLatencyTracker, randomize bool) {
+		Settings = settings
+		AppInstLatencies = latencies
+		OptionFindCloudletRandomizeVeryClose = randomize
+	}(Settings, AppInstLatencies, OptionFindCloudletRandomizeVeryClose)
+	Settings = *edgeproto.GetDefaultSettings()
+	AppInstLatencies = NewAppInstLatencyTracker()
+	OptionFindCloudletRandomizeVeryClose = false
+
+	clientLoc := dme.Loc{
+		Latitude:  50,
+		Longitude: 10,
+	}
+	newInst := func(name string, lat float64) *DmeAppInst {
+		return &DmeAppInst{
+			key: edgeproto.AppInstKey{
+				Name:         name,
+				Organization: "devorg",
+			},
+			Location: dme.Loc{
+				Latitude:  lat,
+				Longitude: 10,
+			},
+			TrackedState:  edgeproto.TrackedState_READY,
+			CloudletState: dme.CloudletState_CLOUDLET_STATE_READY,
+			AppInstHealth: dme.HealthCheck_HEALTH_CHECK_OK,
+		}
+	}
+	// near is about 11km away, far is about 111km away
+	near := newInst("near", 50.1)
+	far := newInst("far", 51)
+	app := &DmeApp{
+		AppKey: edgeproto.AppKey{
+			Name:         "app",
+			Organization: "devorg",
+			Version:      "1.0",
+		},
+	}
+	insts := map[edgeproto.AppInstKey]*DmeAppInst{
+		near.key: near,
+		far.key:  far,
+	}
+	search := func() []string {
+		s := searchAppInst{
+			app:         app,
+			scorer:      FCScorer,
+			loc:         &clientLoc,
+			resultLimit: 2,
+		}
+		s.searchAppInsts(ctx, "carrier", insts)
+		names := []string{}
+		for _, found := range s.results {
+			names = append(names, found.AppInst.key.Name)
+		}
+		return names
+	}
+
+	// default is by distance
+	require.Equal(t, []string{"near", "far"}, search())
+
+	// near is overloaded, but resource usage is not weighted
+	near.CloudletResourceUsage = 95
+	far.CloudletResourceUsage = 10
+	require.Equal(t, []string{"near", "far"}, search())
+
+	// weight resource usage in global settings,
+	// 85% difference in usage is worth 170km
+	Settings.FindCloudletScoreWeights.ResourceUsage = 2
+	require.Equal(t, []string{"far", "near"}, search())
+
+	// App weights override settings
+	app.FindCloudletScoreWeights = &edgeproto.FindCloudletScoreWeights{
+		Distance: 1,
+		Latency:  1,
+	}
+	require.Equal(t, []string{"near", "far"}, search())
+
+	// near has high latency
+	AppInstLatencies.Record(near.key, []*dme.Sample{{Value: 200}, {Value: 220}})
+	AppInstLatencies.Record(far.key, []*dme.Sample{{Value: 20}, {Value: 40}})
+	require.Equal(t, []string{"far", "near"}, search())
+	latency, ok := AppInstLatencies.Get(near.key)
+	require.True(t, ok)
+	require.Equal(t, float64(210), latency)
+
+	// latency is no longer used once it expires
+	defer func(exp time.Duration) {
+		AppInstLatencyExpiration = exp
+	}(AppInstLatencyExpiration)
+	AppInstLatencyExpiration = time.Millisecond
+	time.Sleep(2 * time.Millisecond)
+	_, ok = AppInstLatencies.Get(near.key)
+	require.False(t, ok)
+	require.Equal(t, []string{"near", "far"}, search())
+
+	// unknown health is penalized if weighted
+	app.FindCloudletScoreWeights = &edgeproto.FindCloudletScoreWeights{
+		Distance: 1,
+		Health:   500,
+	}
+	near.AppInstHealth = dme.HealthCheck_HEALTH_CHECK_UNKNOWN
+	require.Equal(t, []string{"far", "near"}, search())
+
+	// all zero weights fall back to settings
+	app.FindCloudletScoreWeights = &edgeproto.FindCloudletScoreWeights{}
+	Settings.FindCloudletScoreWeights = edgeproto.FindCloudletScoreWeights{}
+	require.Equal(t, []string{"near", "far"}, search())
+}
+
+func TestGetCloudletResourceUsage(t *testing.T) {
+	info := &edgeproto.CloudletInfo{}
+	require.Equal(t, float64(0), getCloudletResourceUsage(info))
+
+	info.ResourcesSnapshot.Info = []edgeproto.InfraResource{{
+		Name:          "RAM",
+		Value:         512,
+		InfraMaxValue: 1024,
+	}, {
+		Name:          "vCPUs",
+		Value:         30,
+		InfraMaxValue: 40,
+	}, {
+		Name:  "External IPs",
+		Value: 10,
+	}}
+	require.Equal(t, float64(75), getCloudletResourceUsage(info))
+}
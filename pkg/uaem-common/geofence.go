@@ -0,0 +1,221 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmecommon
+
+import (
+	"context"
+
+	"github.com/AsGz/geo/georeverse"
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+)
+
+// Geo-fence policies restrict which AppInsts may serve a device
+// based on the device's location. A device within one or more
+// geo-fences of the App's policy may only be served by AppInsts
+// on cloudlets within the same geo-fences. Devices outside of all
+// geo-fences are not restricted. If the country of the device cannot
+// be determined because no country resolver is configured, devices
+// outside of the policy's polygon fences are denied if the policy
+// has country fences, rather than being treated as unrestricted.
+
+// CountryResolver looks up the country of a location.
+type CountryResolver interface {
+	// GetCountryCode returns the ISO 3166-1 alpha-2 code of the
+	// country containing the location, or an empty string if
+	// not known.
+	GetCountryCode(loc *dme.Loc) string
+}
+
+// GeoCountryResolver is used to evaluate geo-fences defined by
+// country codes. If nil, geo-fences defined by country codes
+// cannot be evaluated and fail closed.
+var GeoCountryResolver CountryResolver
+
+// PolygonFileCountryResolver resolves countries from a file of
+// country polygons, in the format used by the offline country
+// reverse geocoder.
+type PolygonFileCountryResolver struct {
+	reverser *georeverse.CountryReverser
+}
+
+func NewPolygonFileCountryResolver(file string) (*PolygonFileCountryResolver, error) {
+	reverser, err := georeverse.NewCountryReverser(file)
+	if err != nil {
+		return nil, err
+	}
+	return &PolygonFileCountryResolver{
+		reverser: reverser,
+	}, nil
+}
+
+func (s *PolygonFileCountryResolver) GetCountryCode(loc *dme.Loc) string {
+	return s.reverser.GetCountryCode(loc.Longitude, loc.Latitude)
+}
+
+func getCountryCode(loc *dme.Loc) string {
+	if GeoCountryResolver == nil {
+		return ""
+	}
+	return GeoCountryResolver.GetCountryCode(loc)
+}
+
+// geoFenceContains checks if the location, and its country code if
+// known, is within the geo-fence.
+func geoFenceContains(fence *edgeproto.GeoFence, loc *dme.Loc, countryCode string) bool {
+	if countryCode != "" {
+		for _, cc := range fence.CountryCodes {
+			if cc == countryCode {
+				return true
+			}
+		}
+	}
+	return len(fence.Polygon) >= 3 && polygonContains(fence.Polygon, loc)
+}
+
+// polygonContains checks if the location is within the polygon
+// using the ray casting algorithm, treating longitude and latitude
+// as planar coordinates. Polygons that cross the antimeridian are
+// not supported.
+func polygonContains(polygon []dme.Loc, loc *dme.Loc) bool {
+	inside := false
+	jj := len(polygon) - 1
+	for ii := range polygon {
+		pi := &polygon[ii]
+		pj := &polygon[jj]
+		if (pi.Latitude > loc.Latitude) != (pj.Latitude > loc.Latitude) {
+			lon := (pj.Longitude-pi.Longitude)*(loc.Latitude-pi.Latitude)/(pj.Latitude-pi.Latitude) + pi.Longitude
+			if loc.Longitude < lon {
+				inside = !inside
+			}
+		}
+		jj = ii
+	}
+	return inside
+}
+
+// geoFenceFilter filters AppInsts for a device based on the
+// geo-fences the device is in.
+type geoFenceFilter struct {
+	fences []*edgeproto.GeoFence
+	// denyAll is set if the filter cannot be determined
+	denyAll bool
+}
+
+func policyHasCountryCodes(policy *edgeproto.GeoFencePolicy) bool {
+	for ii := range policy.Fences {
+		if len(policy.Fences[ii].CountryCodes) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// newGeoFenceFilter gets the filter for the device location. It
+// returns nil if the device is not restricted.
+func newGeoFenceFilter(ctx context.Context, policy *edgeproto.GeoFencePolicy, loc *dme.Loc) *geoFenceFilter {
+	if policy == nil || loc == nil {
+		return nil
+	}
+	countryCode := getCountryCode(loc)
+	filter := &geoFenceFilter{}
+	for ii := range policy.Fences {
+		fence := &policy.Fences[ii]
+		if geoFenceContains(fence, loc, countryCode) {
+			filter.fences = append(filter.fences, fence)
+		}
+	}
+	if len(filter.fences) == 0 {
+		if GeoCountryResolver == nil && policyHasCountryCodes(policy) {
+			// The device may be within a country fence, but the
+			// country is not known, so fail closed.
+			log.SpanLog(ctx, log.DebugLevelInfo, "device denied, geo-fence policy has country codes but no country resolver is configured", "policy", policy.Key)
+			filter.denyAll = true
+			return filter
+		}
+		log.SpanLog(ctx, log.DebugLevelDmereq, "device not within any geo-fence", "policy", policy.Key, "country", countryCode)
+		return nil
+	}
+	log.SpanLog(ctx, log.DebugLevelDmereq, "device within geo-fences", "policy", policy.Key, "country", countryCode, "num-fences", len(filter.fences))
+	return filter
+}
+
+// allows checks if the AppInst may serve the device.
+func (s *geoFenceFilter) allows(appInst *DmeAppInst) bool {
+	if s == nil {
+		return true
+	}
+	if s.denyAll {
+		return false
+	}
+	for _, fence := range s.fences {
+		if geoFenceContains(fence, &appInst.Location, appInst.countryCode) {
+			return true
+		}
+	}
+	return false
+}
+
+type GeoFencePolicyHandler struct{}
+
+func (s *GeoFencePolicyHandler) Update(ctx context.Context, in *edgeproto.GeoFencePolicy, rev int64) {
+	tbl := DmeAppTbl
+	tbl.Lock()
+	defer tbl.Unlock()
+	// Policies are never modified once added to the table, so
+	// that apps may refer to them without the table lock.
+	policy := in.Clone()
+	tbl.GeoFencePolicies[in.Key] = policy
+	log.SpanLog(ctx, log.DebugLevelDmereq, "update GeoFencePolicy", "policy", in)
+	setAppsGeoFencePolicy(tbl, &in.Key, policy)
+}
+
+func (s *GeoFencePolicyHandler) Delete(ctx context.Context, in *edgeproto.GeoFencePolicy, rev int64) {
+	tbl := DmeAppTbl
+	tbl.Lock()
+	defer tbl.Unlock()
+	delete(tbl.GeoFencePolicies, in.Key)
+	setAppsGeoFencePolicy(tbl, &in.Key, nil)
+}
+
+func (s *GeoFencePolicyHandler) Prune(ctx context.Context, keys map[edgeproto.PolicyKey]struct{}) {
+	tbl := DmeAppTbl
+	tbl.Lock()
+	defer tbl.Unlock()
+	for key := range tbl.GeoFencePolicies {
+		if _, found := keys[key]; !found {
+			delete(tbl.GeoFencePolicies, key)
+			setAppsGeoFencePolicy(tbl, &key, nil)
+		}
+	}
+}
+
+func (s *GeoFencePolicyHandler) Flush(ctx context.Context, notifyId int64) {}
+
+// setAppsGeoFencePolicy updates the policy on apps that use it.
+// Caller must hold the table lock.
+func setAppsGeoFencePolicy(tbl *DmeApps, key *edgeproto.PolicyKey, policy *edgeproto.GeoFencePolicy) {
+	for _, app := range tbl.Apps {
+		if app.AppKey.Organization != key.Organization {
+			continue
+		}
+		app.Lock()
+		if app.GeoFencePolicyName == key.Name {
+			app.GeoFencePolicy = policy
+		}
+		app.Unlock()
+	}
+}
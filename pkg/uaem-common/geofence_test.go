@@ -0,0 +1,185 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmecommon
+
+import (
+	"context"
+	"testing"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolygonContains(t *testing.T) {
+	// square around the SF bay area
+	square := []dme.Loc{
+		{Latitude: 38.2, Longitude: -123.2},
+		{Latitude: 38.2, Longitude: -121.5},
+		{Latitude: 36.9, Longitude: -121.5},
+		{Latitude: 36.9, Longitude: -123.2},
+	}
+	require.True(t, polygonContains(square, &dme.Loc{Latitude: 37.77, Longitude: -122.42}))
+	require.False(t, polygonContains(square, &dme.Loc{Latitude: 34.05, Longitude: -118.24}))
+	require.False(t, polygonContains(square, &dme.Loc{Latitude: 37.77, Longitude: -121}))
+
+	// concave polygon, notch cut out of the top
+	notched := []dme.Loc{
+		{Latitude: 0, Longitude: 0},
+		{Latitude: 10, Longitude: 0},
+		{Latitude: 10, Longitude: 4},
+		{Latitude: 5, Longitude: 5},
+		{Latitude: 10, Longitude: 6},
+		{Latitude: 10, Longitude: 10},
+		{Latitude: 0, Longitude: 10},
+	}
+	require.True(t, polygonContains(notched, &dme.Loc{Latitude: 8, Longitude: 2}))
+	require.True(t, polygonContains(notched, &dme.Loc{Latitude: 3, Longitude: 5}))
+	require.False(t, polygonContains(notched, &dme.Loc{Latitude: 8, Longitude: 5}))
+}
+
+type testCountryResolver map[float64]string
+
+func (s testCountryResolver) GetCountryCode(loc *dme.Loc) string {
+	return s[loc.Latitude]
+}
+
+func TestGeoFenceSearch(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelDmereq | log.DebugLevelDmedb)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	defer func(settings edgeproto.Settings, resolver CountryResolver, randomize bool) {
+		Settings = settings
+		GeoCountryResolver = resolver
+		OptionFindCloudletRandomizeVeryClose = randomize
+	}(Settings, GeoCountryResolver, OptionFindCloudletRandomizeVeryClose)
+	Settings = *edgeproto.GetDefaultSettings()
+	OptionFindCloudletRandomizeVeryClose = false
+
+	// countries by latitude
+	berlin := dme.Loc{Latitude: 52.52, Longitude: 13.40}
+	zurich := dme.Loc{Latitude: 47.37, Longitude: 8.54}
+	london := dme.Loc{Latitude: 51.51, Longitude: -0.13}
+	konstanz := dme.Loc{Latitude: 47.66, Longitude: 9.18}
+	GeoCountryResolver = testCountryResolver{
+		berlin.Latitude:   "DE",
+		zurich.Latitude:   "CH",
+		london.Latitude:   "GB",
+		konstanz.Latitude: "DE",
+	}
+
+	eehandler := &EmptyEdgeEventsHandler{}
+	SetupMatchEngine(eehandler)
+
+	appKey := edgeproto.AppKey{
+		Name:         "app",
+		Organization: "devorg",
+		Version:      "1.0",
+	}
+	policy := edgeproto.GeoFencePolicy{
+		Key: edgeproto.PolicyKey{
+			Name:         "eu-only",
+			Organization: appKey.Organization,
+		},
+		Fences: []edgeproto.GeoFence{{
+			Name:         "eu",
+			CountryCodes: []string{"DE", "FR"},
+		}},
+	}
+	handler := &GeoFencePolicyHandler{}
+	handler.Update(ctx, &policy, 0)
+
+	AddApp(ctx, &edgeproto.App{
+		Key:            appKey,
+		GeoFencePolicy: policy.Key.Name,
+	})
+	app := DmeAppTbl.Apps[appKey]
+	require.NotNil(t, app.GeoFencePolicy)
+
+	insts := map[edgeproto.AppInstKey]*DmeAppInst{}
+	for name, loc := range map[string]dme.Loc{
+		"berlin": berlin,
+		"zurich": zurich,
+		"london": london,
+	} {
+		inst := &DmeAppInst{
+			key: edgeproto.AppInstKey{
+				Name:         name,
+				Organization: appKey.Organization,
+			},
+			Location:      loc,
+			countryCode:   getCountryCode(&loc),
+			TrackedState:  edgeproto.TrackedState_READY,
+			CloudletState: dme.CloudletState_CLOUDLET_STATE_READY,
+			AppInstHealth: dme.HealthCheck_HEALTH_CHECK_OK,
+		}
+		insts[inst.key] = inst
+	}
+	app.Carriers["carrier"] = &DmeAppInsts{
+		Insts: insts,
+	}
+	search := func(loc dme.Loc) []string {
+		list := SearchAppInsts(ctx, "carrier", app, &loc, app.Carriers, 3, nil)
+		names := []string{}
+		for _, found := range list {
+			names = append(names, found.AppInst.key.Name)
+		}
+		return names
+	}
+
+	// device in Germany next to Switzerland only gets German cloudlets
+	require.Equal(t, []string{"berlin"}, search(konstanz))
+	// device outside of the geo-fences is not restricted
+	require.Equal(t, []string{"zurich", "berlin", "london"}, search(zurich))
+
+	// polygon around Konstanz and Zurich
+	policy.Fences = append(policy.Fences, edgeproto.GeoFence{
+		Name: "lake-constance",
+		Polygon: []dme.Loc{
+			{Latitude: 48, Longitude: 8},
+			{Latitude: 48, Longitude: 10},
+			{Latitude: 47, Longitude: 10},
+			{Latitude: 47, Longitude: 8},
+		},
+	})
+	handler.Update(ctx, &policy, 0)
+	require.Equal(t, []string{"zurich", "berlin"}, search(konstanz))
+	require.Equal(t, []string{"zurich"}, search(zurich))
+
+	// deleting the policy removes restrictions
+	handler.Delete(ctx, &policy, 0)
+	require.Nil(t, app.GeoFencePolicy)
+	require.Equal(t, []string{"zurich", "berlin", "london"}, search(konstanz))
+
+	// policy added after the App applies to the App
+	handler.Update(ctx, &policy, 0)
+	require.NotNil(t, app.GeoFencePolicy)
+	require.Equal(t, []string{"zurich", "berlin"}, search(konstanz))
+
+	// without country information, polygons still apply, but
+	// devices outside of them are denied since they may be
+	// within a country fence.
+	GeoCountryResolver = nil
+	require.Equal(t, []string{}, search(berlin))
+	require.Equal(t, []string{"zurich"}, search(konstanz))
+
+	// prune removes policies
+	handler.Prune(ctx, map[edgeproto.PolicyKey]struct{}{})
+	require.Nil(t, app.GeoFencePolicy)
+	require.Equal(t, 0, len(DmeAppTbl.GeoFencePolicies))
+}
@@ -102,3 +102,79 @@ func (l *LatencyStat) Update(info *LatencyStatInfo) {
 		}
 	}
 }
+
+// AppInstLatencyExpiration is how long latency reported by clients
+// of an AppInst is used to score the AppInst for FindCloudlet.
+var AppInstLatencyExpiration = 10 * time.Minute
+
+// appInstLatencySmoothing is the weight of new samples in the
+// moving average of latency per AppInst.
+const appInstLatencySmoothing = 0.2
+
+// AppInstLatencies tracks the average latency reported by clients of
+// each AppInst, for scoring AppInsts in FindCloudlet.
+var AppInstLatencies = NewAppInstLatencyTracker()
+
+type AppInstLatencyTracker struct {
+	latencies map[edgeproto.AppInstKey]*appInstLatency
+	mux       sync.Mutex
+}
+
+type appInstLatency struct {
+	avg       float64
+	updatedAt time.Time
+}
+
+func NewAppInstLatencyTracker() *AppInstLatencyTracker {
+	return &AppInstLatencyTracker{
+		latencies: make(map[edgeproto.AppInstKey]*appInstLatency),
+	}
+}
+
+// Record adds latency samples (ms) reported by a client of the AppInst.
+func (s *AppInstLatencyTracker) Record(key edgeproto.AppInstKey, samples []*dme.Sample) {
+	sum := 0.0
+	count := 0
+	for _, sample := range samples {
+		if sample.Value >= 0 {
+			sum += sample.Value
+			count++
+		}
+	}
+	if count == 0 {
+		return
+	}
+	avg := sum / float64(count)
+	now := time.Now()
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	latency, found := s.latencies[key]
+	if !found || now.Sub(latency.updatedAt) > AppInstLatencyExpiration {
+		s.latencies[key] = &appInstLatency{
+			avg:       avg,
+			updatedAt: now,
+		}
+		return
+	}
+	latency.avg += appInstLatencySmoothing * (avg - latency.avg)
+	latency.updatedAt = now
+}
+
+// Get returns the average latency (ms) of the AppInst, if clients
+// have reported it recently.
+func (s *AppInstLatencyTracker) Get(key edgeproto.AppInstKey) (float64, bool) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	latency, found := s.latencies[key]
+	if !found || time.Since(latency.updatedAt) > AppInstLatencyExpiration {
+		return 0, false
+	}
+	return latency.avg, true
+}
+
+func (s *AppInstLatencyTracker) Delete(key edgeproto.AppInstKey) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	delete(s.latencies, key)
+}
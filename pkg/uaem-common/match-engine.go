@@ -25,6 +25,7 @@ import (
 	"net"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
@@ -45,6 +46,8 @@ const VeryCloseDistanceKm = 1
 type DmeAppInst struct {
 	// AppInst key
 	key edgeproto.AppInstKey
+	// Unique AppInst ID
+	objId string
 	// Unique identifier key for the clusterInst
 	clusterKey edgeproto.ClusterKey
 	// cloudlet key
@@ -63,9 +66,13 @@ type DmeAppInst struct {
 	// State of the cloudlet - copy of the DmeCloudlet
 	CloudletState    dme.CloudletState
 	MaintenanceState dme.MaintenanceState
+	// Usage percent of the most used cloudlet resource - copy of the DmeCloudlet
+	CloudletResourceUsage float64
 	// Health state of the appInst
 	AppInstHealth dme.HealthCheck
 	TrackedState  edgeproto.TrackedState
+	// Country of the cloudlet site, if known
+	countryCode string
 }
 
 type DmeAppInstState struct {
@@ -82,6 +89,7 @@ type DmeAppInsts struct {
 type DmeApp struct {
 	sync.RWMutex
 	AppKey              edgeproto.AppKey
+	ObjId               string
 	Carriers            map[string]*DmeAppInsts
 	AuthPublicKey       string
 	AndroidPackageName  string
@@ -92,8 +100,15 @@ type DmeApp struct {
 	NodeResources       *edgeproto.NodeResources
 	QosSessionProfile   string
 	QosSessionDuration  time.Duration
+	// Weights for scoring AppInsts, overrides Settings if set
+	FindCloudletScoreWeights *edgeproto.FindCloudletScoreWeights
 	// Non mapped AppPorts from App definition (used for AppOfficialFqdnReply)
 	Ports []edgeproto.InstPort
+	// For round robin distribution of clients by FindCloudlet
+	roundRobinCount atomic.Uint64
+	// Geo-fence policy restricting which AppInsts may serve devices
+	GeoFencePolicyName string
+	GeoFencePolicy     *edgeproto.GeoFencePolicy
 }
 
 type DmeCloudlet struct {
@@ -105,6 +120,8 @@ type DmeCloudlet struct {
 	AllianceCarriers map[string]struct{}
 	AppInstKeys      map[edgeproto.AppInstKey]*edgeproto.AppKey
 	ZoneKey          edgeproto.ZoneKey
+	// Usage percent of the most used infra resource
+	ResourceUsage float64
 }
 
 type AutoProvPolicy struct {
@@ -123,9 +140,11 @@ type DmeApps struct {
 	Cloudlets                  map[edgeproto.CloudletKey]*DmeCloudlet
 	CarriersByAppInst          map[edgeproto.AppInstKey]edgeproto.CloudletKey // for delete
 	AutoProvPolicies           map[edgeproto.PolicyKey]*AutoProvPolicy
+	GeoFencePolicies           map[edgeproto.PolicyKey]*edgeproto.GeoFencePolicy
 	CloudletLocsByZone         CloudletLocsByZone
 	FreeReservableClusterInsts edgeproto.FreeReservableClusterInstCache
 	OperatorCodes              edgeproto.OperatorCodeCache
+	Zones                      edgeproto.ZoneCache
 }
 
 type ClientToken struct {
@@ -166,9 +185,11 @@ func SetupMatchEngine(eehandler EdgeEventsHandler) {
 	DmeAppTbl.CarriersByAppInst = make(map[edgeproto.AppInstKey]edgeproto.CloudletKey)
 	DmeAppTbl.Cloudlets = make(map[edgeproto.CloudletKey]*DmeCloudlet)
 	DmeAppTbl.AutoProvPolicies = make(map[edgeproto.PolicyKey]*AutoProvPolicy)
+	DmeAppTbl.GeoFencePolicies = make(map[edgeproto.PolicyKey]*edgeproto.GeoFencePolicy)
 	DmeAppTbl.CloudletLocsByZone = CloudletLocsByZone{}
 	DmeAppTbl.FreeReservableClusterInsts.Init()
 	edgeproto.InitOperatorCodeCache(&DmeAppTbl.OperatorCodes)
+	edgeproto.InitZoneCache(&DmeAppTbl.Zones)
 	EEHandler = eehandler
 }
 
@@ -223,6 +244,7 @@ func AddApp(ctx context.Context, in *edgeproto.App) {
 	}
 	app.Lock()
 	defer app.Unlock()
+	app.ObjId = in.ObjId
 	app.AuthPublicKey = in.AuthPublicKey
 	app.AndroidPackageName = in.AndroidPackageName
 	app.OfficialFqdn = in.OfficialFqdn
@@ -233,6 +255,20 @@ func AddApp(ctx context.Context, in *edgeproto.App) {
 	app.Ports = ports
 	app.QosSessionProfile = in.QosSessionProfile.String()
 	app.QosSessionDuration = in.QosSessionDuration.TimeDuration()
+	app.FindCloudletScoreWeights = in.FindCloudletScoreWeights
+	app.GeoFencePolicyName = in.GeoFencePolicy
+	app.GeoFencePolicy = nil
+	if in.GeoFencePolicy != "" {
+		gfKey := edgeproto.PolicyKey{
+			Organization: in.Key.Organization,
+			Name:         in.GeoFencePolicy,
+		}
+		if gf, found := tbl.GeoFencePolicies[gfKey]; found {
+			app.GeoFencePolicy = gf
+		} else {
+			log.SpanLog(ctx, log.DebugLevelDmedb, "GeoFencePolicy on App not found", "app", in.Key, "policy", in.GeoFencePolicy)
+		}
+	}
 	log.SpanLog(ctx, log.DebugLevelDmedb, "QOS Priority Session values", "QosSessionProfile", app.QosSessionProfile, "QosSessionDuration", app.QosSessionDuration)
 	clearAutoProvStats := []string{}
 	inAP := make(map[string]struct{})
@@ -307,10 +343,14 @@ func AddAppInst(ctx context.Context, appInst *edgeproto.AppInst) {
 		logMsg = "Adding app inst"
 	}
 	// update existing app inst
+	cl.objId = appInst.ObjId
 	cl.clusterKey = appInst.ClusterKey
 	cl.cloudletKey = appInst.CloudletKey
 	cl.zoneKey = appInst.ZoneKey
 	cl.Uri = appInst.Uri
+	if !foundAppInst || cl.Location.Latitude != appInst.CloudletLoc.Latitude || cl.Location.Longitude != appInst.CloudletLoc.Longitude {
+		cl.countryCode = getCountryCode(&appInst.CloudletLoc)
+	}
 	cl.Location = appInst.CloudletLoc
 	cl.ports = appInst.MappedPorts
 	cl.TrackedState = appInst.State
@@ -340,6 +380,7 @@ func AddAppInst(ctx context.Context, appInst *edgeproto.AppInst) {
 	// Check if Cloudlet states have changed
 	cl.CloudletState = cloudlet.State
 	cl.MaintenanceState = cloudlet.MaintenanceState
+	cl.CloudletResourceUsage = cloudlet.ResourceUsage
 	// add to alliance carriers
 	for allianceCarrier, _ := range cloudlet.AllianceCarriers {
 		addAppInstAlliance(ctx, app, cl, allianceCarrier)
@@ -440,6 +481,7 @@ func RemoveAppInst(ctx context.Context, appInst *edgeproto.AppInst) {
 	}
 	delete(tbl.AppInstApps, appInst.Key)
 	delete(tbl.CarriersByAppInst, appInst.Key)
+	AppInstLatencies.Delete(appInst.Key)
 	carrierName := cloudletKey.Organization
 	app.Lock()
 	defer app.Unlock()
@@ -525,6 +567,7 @@ func PruneAppInsts(ctx context.Context, appInsts map[edgeproto.AppInstKey]struct
 					}(appinstState)
 
 					delete(carr.Insts, key)
+					AppInstLatencies.Delete(key)
 				}
 			}
 			if len(carr.Insts) == 0 {
@@ -688,6 +731,17 @@ func (s *AutoProvPolicyHandler) Prune(ctx context.Context, keys map[edgeproto.Po
 
 func (s *AutoProvPolicyHandler) Flush(ctx context.Context, notifyId int64) {}
 
+// getCloudletMaintenanceState gets the maintenance state used for
+// steering clients. Once a scheduled maintenance window enters failover,
+// the cloudlet is treated as under maintenance so that new clients are
+// no longer sent to it.
+func getCloudletMaintenanceState(in *edgeproto.Cloudlet) dme.MaintenanceState {
+	if in.MaintenanceWindow != nil && in.MaintenanceWindow.State != edgeproto.MaintenanceWindowState_MAINTENANCE_WINDOW_SCHEDULED {
+		return dme.MaintenanceState_UNDER_MAINTENANCE
+	}
+	return in.MaintenanceState
+}
+
 // SetInstStateFromCloudlet - Sets the current maintenance state of the appInstances for the cloudlet
 func SetInstStateFromCloudlet(ctx context.Context, in *edgeproto.Cloudlet) {
 	log.SpanLog(ctx, log.DebugLevelDmereq, "SetInstStateFromCloudlet called", "cloudlet", in)
@@ -708,9 +762,10 @@ func SetInstStateFromCloudlet(ctx context.Context, in *edgeproto.Cloudlet) {
 		oldZone = cloudlet.ZoneKey.Clone()
 	}
 	sendAvailableAppInst := false
+	maintenanceState := getCloudletMaintenanceState(in)
 	// Check if CloudletMaintenance state has changed
-	if cloudlet.MaintenanceState != in.MaintenanceState && foundCloudlet {
-		cloudlet.MaintenanceState = in.MaintenanceState
+	if cloudlet.MaintenanceState != maintenanceState && foundCloudlet {
+		cloudlet.MaintenanceState = maintenanceState
 		if cloudlet.MaintenanceState == dme.MaintenanceState_NORMAL_OPERATION {
 			// send msg to clients that are not on this cloudlet but are closer to this cloudlet that it is available
 			sendAvailableAppInst = true
@@ -761,8 +816,8 @@ func SetInstStateFromCloudlet(ctx context.Context, in *edgeproto.Cloudlet) {
 			log.SpanLog(ctx, log.DebugLevelDmedb, "SetInstStateFromCloudlet: appInst lookup failed", "key", appInstKey, "err", err)
 			continue
 		}
-		log.SpanLog(ctx, log.DebugLevelDmedb, "SetInstStateFromCloudlet: set appInst maintenance", "key", appInstKey, "maintenance", in.MaintenanceState, "existingState", appinst.CloudletState)
-		appinst.MaintenanceState = in.MaintenanceState
+		log.SpanLog(ctx, log.DebugLevelDmedb, "SetInstStateFromCloudlet: set appInst maintenance", "key", appInstKey, "maintenance", maintenanceState, "existingState", appinst.CloudletState)
+		appinst.MaintenanceState = maintenanceState
 		if sendAvailableAppInst && IsAppInstUsable(appinst) {
 			go EEHandler.SendAvailableAppInst(ctx, app, appInstKey, appinst, carrier)
 		}
@@ -793,6 +848,7 @@ func SetInstStateFromCloudletInfo(ctx context.Context, info *edgeproto.CloudletI
 		return
 	}
 	sendAvailableAppInst := false
+	cloudlet.ResourceUsage = getCloudletResourceUsage(info)
 	// Check if Cloudlet state has changed
 	if cloudlet.State != info.State {
 		cloudlet.State = info.State
@@ -817,6 +873,7 @@ func SetInstStateFromCloudletInfo(ctx context.Context, info *edgeproto.CloudletI
 		log.SpanLog(ctx, log.DebugLevelDmedb, "SetInstStateFromCloudletInfo: set appInst state", "key", appInstKey, "cloudletState", info.State, "maintenance", info.MaintenanceState)
 		appinst.CloudletState = info.State
 		appinst.MaintenanceState = info.MaintenanceState
+		appinst.CloudletResourceUsage = cloudlet.ResourceUsage
 		if sendAvailableAppInst && IsAppInstUsable(appinst) {
 			go EEHandler.SendAvailableAppInst(ctx, app, appInstKey, appinst, carrier)
 		}
@@ -868,6 +925,8 @@ func translateCarrierName(carrierName string) string {
 }
 
 type searchAppInst struct {
+	app                    *DmeApp
+	scorer                 FindCloudletScorer
 	loc                    *dme.Loc
 	reqCarrier             string
 	results                []*foundAppInst
@@ -876,10 +935,12 @@ type searchAppInst struct {
 	appNodeResources       *edgeproto.NodeResources
 	resultDist             float64
 	resultLimit            int
+	geoFence               *geoFenceFilter
 }
 
 type foundAppInst struct {
 	distance       float64
+	score          float64
 	AppInst        *DmeAppInst
 	appInstCarrier string
 }
@@ -920,12 +981,15 @@ func SearchAppInsts(ctx context.Context, carrierName string, app *DmeApp, loc *d
 	// Eventually when we have FindCloudlet policies, we should look it
 	// up here and apply it to the search config.
 	search := searchAppInst{
+		app:                    app,
+		scorer:                 FCScorer,
 		loc:                    loc,
 		reqCarrier:             carrierName,
 		appDeployment:          app.Deployment,
 		appKubernetesResources: app.KubernetesResources,
 		appNodeResources:       app.NodeResources,
 		resultLimit:            resultLimit,
+		geoFence:               newGeoFenceFilter(ctx, app.GeoFencePolicy, loc),
 	}
 
 	for cname, carrierData := range app.Carriers {
@@ -947,6 +1011,7 @@ func SearchAppInsts(ctx context.Context, carrierName string, app *DmeApp, loc *d
 			"latitude", found.AppInst.Location.Latitude,
 			"longitude", found.AppInst.Location.Longitude,
 			"distance", found.distance,
+			"score", found.score,
 			"uri", found.AppInst.Uri,
 			"IP", ipaddr.String())
 	}
@@ -1040,8 +1105,20 @@ func (s *searchAppInst) searchAppInsts(ctx context.Context, carrier string, appI
 		if !usable {
 			continue
 		}
+		if !s.geoFence.allows(i) {
+			log.SpanLog(ctx, log.DebugLevelDmereq, "appinst outside of device geo-fences", "appinst", i.key)
+			continue
+		}
+		score := s.scorer.Score(ctx, &FindCloudletScoreInput{
+			App:      s.app,
+			AppInst:  i,
+			Carrier:  carrier,
+			Distance: d,
+		})
+		log.SpanLog(ctx, log.DebugLevelDmereq, "scored appinst", "appinst", i.key, "score", score)
 		found := &foundAppInst{
 			distance:       d,
+			score:          score,
 			AppInst:        i,
 			appInstCarrier: carrier,
 		}
@@ -1084,20 +1161,21 @@ func (s *searchAppInst) insertResult(found *foundAppInst) bool {
 }
 
 func (s *searchAppInst) less(f1, f2 *foundAppInst) bool {
-	// For now we sort by distance, but in the future
-	// we may sort by latency or some other metric.
-	if f1.distance == f2.distance {
+	// Sort by score, which is distance unless the
+	// scorer is configured to use other metrics.
+	if f1.score == f2.score {
 		// same cloudlet, go by name
 		return f1.AppInst.key.GetKeyString() < f2.AppInst.key.GetKeyString()
 	}
-	return f1.distance < f2.distance
+	return f1.score < f2.score
 }
 
 func (s *searchAppInst) veryClose(f1, f2 *foundAppInst) bool {
-	if f1.distance > f2.distance {
-		return f1.distance-f2.distance < VeryCloseDistanceKm
+	// scores are in units of distance
+	if f1.score > f2.score {
+		return f1.score-f2.score < VeryCloseDistanceKm
 	}
-	return f2.distance-f1.distance < VeryCloseDistanceKm
+	return f2.score-f1.score < VeryCloseDistanceKm
 }
 
 func (s *searchAppInst) searchPolicyZones(ctx context.Context, key *edgeproto.AppKey, potential *policySearch, carrier string, list []*edgeproto.ZoneKey, cloudletLocsByZone CloudletLocsByZone) {
@@ -1231,9 +1309,9 @@ func FindCloudlet(ctx context.Context, appkey *edgeproto.AppKey, carrier string,
 	var app *DmeApp
 
 	// first find carrier cloudlet
-	list, app := findBestForCarrier(ctx, carrier, appkey, loc, 1)
+	list, app := findBestForCarrier(ctx, carrier, appkey, loc, FindCloudletMaxCandidates)
 	if len(list) > 0 {
-		best := list[0]
+		best := selectFindCloudletAppInst(ctx, app, list, loc, edgeEventsCookieExpiration)
 		ConstructFindCloudletReplyFromDmeAppInst(ctx, best.AppInst, loc, mreply, edgeEventsCookieExpiration)
 		// Update Context variable if passed
 		updateContextWithCloudletDetails(ctx, best.AppInst, best.appInstCarrier)
@@ -1377,11 +1455,21 @@ func StreamEdgeEvent(ctx context.Context, svr dme.MatchEngineApi_StreamEdgeEvent
 	var lastLocation *dme.Loc
 	var lastCarrier string = ""
 	var lastDeviceInfoDynamic *dme.DeviceInfoDynamic
+	// Session checkpoint so the device can resume after reconnecting
+	var sessionTracker *edgeEventsSessionTracker
 	// Intialize send function to be passed to plugin functions
 	sendFunc := func(event *dme.ServerEdgeEvent) {
 		err := svr.Send(event)
 		if err != nil {
 			log.SpanLog(ctx, log.DebugLevelDmereq, "error sending event to client", "error", err, "eventType", event.EventType)
+			return
+		}
+		// Remember if the client was told to move, so that the
+		// event can be resent if the client reconnects.
+		if movedTo := getNewCloudletAppInstKey(event); movedTo != nil && *movedTo != appInst.Key && sessionTracker != nil {
+			sessionTracker.update(ctx, func(session *EdgeEventsSession) {
+				session.MovedTo = movedTo
+			})
 		}
 	}
 	// Receive first msg from stream
@@ -1443,12 +1531,37 @@ func StreamEdgeEvent(ctx context.Context, svr dme.MatchEngineApi_StreamEdgeEvent
 				Organization: edgeEventsCookieKey.CloudletOrg,
 			},
 		}
-		// Send first deviceinfo stats for client
-		deviceInfo := &DeviceInfo{
-			DeviceInfoStatic:  deviceInfoStatic,
-			DeviceInfoDynamic: lastDeviceInfoDynamic,
+		// Resume the checkpointed session if the device is
+		// reconnecting, possibly to a different DME.
+		var resumed bool
+		sessionTracker, resumed = newEdgeEventsSessionTracker(ctx, sessionCookieKey, appInst.Key, edgeEventsCookieExpiration)
+		session := sessionTracker.get()
+		if resumed {
+			// session location is more recent than the cookie's
+			lastLocation = &session.LastLocation
+			if initMsg.DeviceInfoStatic == nil && session.DeviceInfoStatic != nil {
+				deviceInfoStatic = session.DeviceInfoStatic
+			}
+			if lastDeviceInfoDynamic == nil {
+				lastDeviceInfoDynamic = session.DeviceInfoDynamic
+				lastCarrier = session.Carrier
+			}
+		}
+		// Send first deviceinfo stats for client, unless they were
+		// already sent for the resumed session.
+		if !resumed || !isDeviceInfoDynamicEqual(lastDeviceInfoDynamic, session.DeviceInfoDynamic) {
+			deviceInfo := &DeviceInfo{
+				DeviceInfoStatic:  deviceInfoStatic,
+				DeviceInfoDynamic: lastDeviceInfoDynamic,
+			}
+			updateDeviceInfoStats(ctx, &appInst, deviceInfo, lastLocation, "event init connection")
 		}
-		updateDeviceInfoStats(ctx, &appInst, deviceInfo, lastLocation, "event init connection")
+		sessionTracker.update(ctx, func(session *EdgeEventsSession) {
+			session.LastLocation = *lastLocation
+			session.Carrier = lastCarrier
+			session.DeviceInfoStatic = deviceInfoStatic
+			session.DeviceInfoDynamic = lastDeviceInfoDynamic
+		})
 		// Add Client to edgeevents plugin
 		EEHandler.AddClient(ctx, appInst.Key, *sessionCookieKey, *lastLocation, lastCarrier, sendFunc)
 		// Remove Client from edgeevents plugin when StreamEdgeEvent exits
@@ -1456,7 +1569,26 @@ func StreamEdgeEvent(ctx context.Context, svr dme.MatchEngineApi_StreamEdgeEvent
 		// Send successful init response
 		initServerEdgeEvent := new(dme.ServerEdgeEvent)
 		initServerEdgeEvent.EventType = dme.ServerEdgeEvent_EVENT_INIT_CONNECTION
+		if resumed {
+			// Include the last latency results of the resumed session
+			initServerEdgeEvent.Statistics = session.LatencyStats
+		}
 		EEHandler.SendEdgeEventToClient(ctx, initServerEdgeEvent, appInst.Key, *sessionCookieKey)
+		// If the client was told to move before it disconnected,
+		// tell it again if the AppInst is still usable.
+		if resumed && session.MovedTo != nil {
+			newCloudlet := getMovedToCloudlet(ctx, &appInst.AppKey, session.MovedTo, lastLocation, edgeEventsCookieExpiration)
+			if newCloudlet != nil {
+				newCloudletEdgeEvent := new(dme.ServerEdgeEvent)
+				newCloudletEdgeEvent.EventType = dme.ServerEdgeEvent_EVENT_CLOUDLET_UPDATE
+				newCloudletEdgeEvent.NewCloudlet = newCloudlet
+				EEHandler.SendEdgeEventToClient(ctx, newCloudletEdgeEvent, appInst.Key, *sessionCookieKey)
+			} else {
+				sessionTracker.update(ctx, func(session *EdgeEventsSession) {
+					session.MovedTo = nil
+				})
+			}
+		}
 	} else {
 		return fmt.Errorf("First message should have event type EVENT_INIT_CONNECTION")
 	}
@@ -1478,7 +1610,7 @@ loop:
 		cupdate, err := svr.Recv()
 		ctx = svr.Context()
 		// Rate limit
-		err = RateLimitMgr.Limit(ctx, callerInfo)
+		_, err = RateLimitMgr.Limit(ctx, callerInfo)
 		if err != nil {
 			log.SpanLog(ctx, log.DebugLevelDmereq, "Limiting client messages", "err", err)
 			sendErrorEventToClient(ctx, fmt.Sprintf("Limiting client messages. Most recent ClientEdgeEvent will not be processed: %v. Error is: %s", cupdate, err), &appInst, *sessionCookieKey)
@@ -1503,6 +1635,7 @@ loop:
 		case dme.ClientEdgeEvent_EVENT_TERMINATE_CONNECTION:
 			// Client initiated termination
 			log.SpanLog(ctx, log.DebugLevelDmereq, "Client initiated termination of persistent connection")
+			sessionTracker.delete(ctx)
 			break loop
 		case dme.ClientEdgeEvent_EVENT_LATENCY_SAMPLES:
 			// Client sent latency samples to be processed
@@ -1513,12 +1646,16 @@ loop:
 				continue
 			}
 			// Process latency samples and send results to client
-			_, err = EEHandler.ProcessLatencySamples(ctx, appInst.Key, *sessionCookieKey, cupdate.Samples)
+			stats, err := EEHandler.ProcessLatencySamples(ctx, appInst.Key, *sessionCookieKey, cupdate.Samples)
 			if err != nil {
 				log.SpanLog(ctx, log.DebugLevelDmereq, "ClientEdgeEvent latency unable to process latency samples", "err", err)
 				sendErrorEventToClient(ctx, fmt.Sprintf("ClientEdgeEvent latency unable to process latency samples, error is: %s", err), &appInst, *sessionCookieKey)
 				continue
 			}
+			AppInstLatencies.Record(appInst.Key, cupdate.Samples)
+			sessionTracker.update(ctx, func(session *EdgeEventsSession) {
+				session.addLatencySamples(cupdate.Samples, stats)
+			})
 			// Latency stats update
 			deviceInfoDynamic := cupdate.DeviceInfoDynamic
 			deviceInfo := &DeviceInfo{
@@ -1549,8 +1686,10 @@ loop:
 				DeviceInfoStatic:  deviceInfoStatic,
 				DeviceInfoDynamic: deviceInfoDynamic,
 			}
+			sessionChanged := false
 			// Update deviceinfo stats if DeviceInfoDynamic has changed or Location has moved to different tile
 			if !isDeviceInfoDynamicEqual(deviceInfoDynamic, lastDeviceInfoDynamic) || !isLocationInSameTile(cupdate.GpsLocation, lastLocation) {
+				sessionChanged = true
 				updateDeviceInfoStats(ctx, &appInst, deviceInfo, cupdate.GpsLocation, "event location update")
 				lastDeviceInfoDynamic = deviceInfoDynamic
 				if lastDeviceInfoDynamic != nil {
@@ -1564,10 +1703,19 @@ loop:
 			if cupdate.GpsLocation.Latitude != lastLocation.Latitude || cupdate.GpsLocation.Longitude != lastLocation.Longitude {
 				EEHandler.UpdateClientLastLocation(ctx, appInst.Key, *sessionCookieKey, *cupdate.GpsLocation)
 				lastLocation = cupdate.GpsLocation
+				sessionChanged = true
+			}
+			if sessionChanged {
+				sessionTracker.update(ctx, func(session *EdgeEventsSession) {
+					session.LastLocation = *lastLocation
+					session.Carrier = lastCarrier
+					session.DeviceInfoDynamic = lastDeviceInfoDynamic
+				})
 			}
 			// Check if there is a better cloudlet based on location update
 			fcreply := new(dme.FindCloudletReply)
-			err, _ = FindCloudlet(ctx, &appInst.AppKey, lastCarrier, cupdate.GpsLocation, fcreply, edgeEventsCookieExpiration)
+			fcctx := NewCurrentAppInstContext(ctx, &appInst.Key)
+			err, _ = FindCloudlet(fcctx, &appInst.AppKey, lastCarrier, cupdate.GpsLocation, fcreply, edgeEventsCookieExpiration)
 			if err != nil {
 				log.SpanLog(ctx, log.DebugLevelDmereq, "Error trying to find closer cloudlet", "err", err)
 				continue
@@ -1740,6 +1888,21 @@ func AppExists(orgname string, appname string, appvers string) bool {
 	return ok
 }
 
+// GetAppQosSessionDuration gets the QoS priority session duration
+// configured on the App, which may be zero if not set.
+func GetAppQosSessionDuration(key *edgeproto.AppKey) (time.Duration, bool) {
+	tbl := DmeAppTbl
+	tbl.RLock()
+	app, ok := tbl.Apps[*key]
+	tbl.RUnlock()
+	if !ok {
+		return 0, false
+	}
+	app.RLock()
+	defer app.RUnlock()
+	return app.QosSessionDuration, true
+}
+
 func ValidateLocation(loc *dme.Loc) error {
 	if loc == nil {
 		return grpc.Errorf(codes.InvalidArgument, "Missing GpsLocation")
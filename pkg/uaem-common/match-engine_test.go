@@ -92,6 +92,7 @@ func testInsertObj(t *testing.T, params *insertTestParams) {
 	for _, val := range params.in {
 		f := &foundAppInst{
 			distance: val,
+			score:    val,
 		}
 		s.insertResult(f)
 	}
@@ -176,6 +177,7 @@ func BenchmarkSort(b *testing.B) {
 					for n := 0; n < num; n++ {
 						f := &foundAppInst{
 							distance: floats[n],
+							score:    floats[n],
 						}
 						s.insertResult(f)
 					}
@@ -187,6 +189,7 @@ func BenchmarkSort(b *testing.B) {
 					for n := 0; n < num; n++ {
 						f := &foundAppInst{
 							distance: floats[n],
+							score:    floats[n],
 						}
 						all = append(all, f)
 					}
@@ -0,0 +1,134 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// Tenant supplied URLs (webhooks, notification callbacks, etc) are
+// requested by platform services, so must not be allowed to target
+// internal services. These functions restrict such URLs to public
+// addresses.
+
+var cgnatNet = mustParseCIDR("100.64.0.0/10")
+
+func mustParseCIDR(cidr string) *net.IPNet {
+	_, ipnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+	return ipnet
+}
+
+// IsPublicIP returns false for loopback, private, link-local
+// (including cloud metadata addresses), shared, multicast,
+// and unspecified addresses.
+func IsPublicIP(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	if cgnatNet.Contains(ip) {
+		return false
+	}
+	return true
+}
+
+// ValidatePublicURL checks that the URL has one of the allowed
+// schemes, and that its host resolves only to public addresses.
+func ValidatePublicURL(ctx context.Context, rawURL string, schemes ...string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid URL %q, %v", rawURL, err)
+	}
+	if len(schemes) > 0 {
+		found := false
+		for _, scheme := range schemes {
+			if u.Scheme == scheme {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("invalid URL %q, scheme must be one of %s", rawURL, strings.Join(schemes, ", "))
+		}
+	}
+	host := u.Hostname()
+	if host == "" {
+		return fmt.Errorf("invalid URL %q, missing host", rawURL)
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		if !IsPublicIP(ip) {
+			return fmt.Errorf("invalid URL %q, host address %s is not public", rawURL, ip)
+		}
+		return nil
+	}
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return fmt.Errorf("invalid URL %q, failed to resolve host, %v", rawURL, err)
+	}
+	for _, addr := range addrs {
+		if !IsPublicIP(addr.IP) {
+			return fmt.Errorf("invalid URL %q, host resolves to non-public address %s", rawURL, addr.IP)
+		}
+	}
+	return nil
+}
+
+// publicDialControl is run after DNS resolution on the address
+// actually being connected to, which guards against DNS rebinding
+// after ValidatePublicURL has passed.
+func publicDialControl(network, address string, c syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if !IsPublicIP(ip) {
+		return fmt.Errorf("connection to non-public address %s not allowed", host)
+	}
+	return nil
+}
+
+// NewPublicHTTPClient returns an http client that only connects to
+// public addresses and does not follow redirects.
+func NewPublicHTTPClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 30 * time.Second,
+		Control: publicDialControl,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2024 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	tests := []struct {
+		ip     string
+		public bool
+	}{
+		{"8.8.8.8", true},
+		{"2001:4860:4860::8888", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"fd00::1", false},
+		{"100.64.0.1", false},
+		{"0.0.0.0", false},
+		{"224.0.0.1", false},
+		{"::ffff:127.0.0.1", false},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.public, IsPublicIP(net.ParseIP(test.ip)), test.ip)
+	}
+	require.False(t, IsPublicIP(nil))
+}
+
+func TestValidatePublicURL(t *testing.T) {
+	ctx := context.Background()
+	require.Nil(t, ValidatePublicURL(ctx, "https://8.8.8.8/hook", "https"))
+	require.Nil(t, ValidatePublicURL(ctx, "http://8.8.8.8/hook", "http", "https"))
+	require.NotNil(t, ValidatePublicURL(ctx, "http://8.8.8.8/hook", "https"))
+	require.NotNil(t, ValidatePublicURL(ctx, "https://127.0.0.1/hook", "https"))
+	require.NotNil(t, ValidatePublicURL(ctx, "https://[::1]:8080/hook", "https"))
+	require.NotNil(t, ValidatePublicURL(ctx, "https://169.254.169.254/latest", "https"))
+	require.NotNil(t, ValidatePublicURL(ctx, "https://localhost/hook", "https"))
+	require.NotNil(t, ValidatePublicURL(ctx, "https:///hook", "https"))
+}
+
+func TestPublicHTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewPublicHTTPClient(time.Second)
+	_, err := client.Get(server.URL)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "non-public address")
+	require.NotNil(t, client.CheckRedirect(nil, nil))
+}
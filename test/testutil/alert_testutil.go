@@ -255,6 +255,40 @@ func (r *Run) AlertApi(data *[]edgeproto.Alert, dataMap interface{}, dataOut int
 	}
 }
 
+func (r *Run) AlertApi_AlertHistoryRequest(data *[]edgeproto.AlertHistoryRequest, dataMap interface{}, dataOut interface{}) {
+	log.DebugLog(log.DebugLevelApi, "API for AlertHistoryRequest", "mode", r.Mode)
+	if r.Mode == "show" {
+		obj := &edgeproto.AlertHistoryRequest{}
+		out, err := r.client.ShowAlertHistory(r.ctx, obj)
+		if err != nil {
+			r.logErr("AlertApi_AlertHistoryRequest", err)
+		} else {
+			outp, ok := dataOut.(*[]edgeproto.AlertHistory)
+			if !ok {
+				panic(fmt.Sprintf("RunAlertApi_AlertHistoryRequest expected dataOut type *[]edgeproto.AlertHistory, but was %T", dataOut))
+			}
+			*outp = append(*outp, out...)
+		}
+		return
+	}
+	for ii, objD := range *data {
+		obj := &objD
+		switch r.Mode {
+		case "showalerthistory":
+			out, err := r.client.ShowAlertHistory(r.ctx, obj)
+			if err != nil {
+				r.logErr(fmt.Sprintf("AlertApi_AlertHistoryRequest[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.AlertHistory)
+				if !ok {
+					panic(fmt.Sprintf("RunAlertApi_AlertHistoryRequest expected dataOut type *[]edgeproto.AlertHistory, but was %T", dataOut))
+				}
+				*outp = append(*outp, out...)
+			}
+		}
+	}
+}
+
 func (s *DummyServer) ShowAlert(in *edgeproto.Alert, server edgeproto.AlertApi_ShowAlertServer) error {
 	var err error
 	obj := &edgeproto.Alert{}
@@ -314,14 +348,52 @@ func (s *CliClient) ShowAlert(ctx context.Context, in *edgeproto.Alert) ([]edgep
 	return output, err
 }
 
+type AlertHistoryStream interface {
+	Recv() (*edgeproto.AlertHistory, error)
+}
+
+func AlertHistoryReadStream(stream AlertHistoryStream) ([]edgeproto.AlertHistory, error) {
+	output := []edgeproto.AlertHistory{}
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return output, fmt.Errorf("read AlertHistory stream failed, %v", err)
+		}
+		output = append(output, *obj)
+	}
+	return output, nil
+}
+
+func (s *ApiClient) ShowAlertHistory(ctx context.Context, in *edgeproto.AlertHistoryRequest) ([]edgeproto.AlertHistory, error) {
+	api := edgeproto.NewAlertApiClient(s.Conn)
+	stream, err := api.ShowAlertHistory(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return AlertHistoryReadStream(stream)
+}
+
+func (s *CliClient) ShowAlertHistory(ctx context.Context, in *edgeproto.AlertHistoryRequest) ([]edgeproto.AlertHistory, error) {
+	output := []edgeproto.AlertHistory{}
+	args := append(s.BaseArgs, "controller", "ShowAlertHistory")
+	err := wrapper.RunEdgectlObjs(args, in, &output, s.RunOps...)
+	return output, err
+}
+
 type AlertApiClient interface {
 	ShowAlert(ctx context.Context, in *edgeproto.Alert) ([]edgeproto.Alert, error)
+	ShowAlertHistory(ctx context.Context, in *edgeproto.AlertHistoryRequest) ([]edgeproto.AlertHistory, error)
 }
 
 type DummyServer struct {
 	CustomData
 	AlertCache                    edgeproto.AlertCache
 	AlertPolicyCache              edgeproto.AlertPolicyCache
+	AlertReceiverCache            edgeproto.AlertReceiverCache
+	AlertSilenceCache             edgeproto.AlertSilenceCache
 	SettingsCache                 edgeproto.SettingsCache
 	FlavorCache                   edgeproto.FlavorCache
 	OperatorCodeCache             edgeproto.OperatorCodeCache
@@ -351,9 +423,11 @@ type DummyServer struct {
 	CloudletRefsCache             edgeproto.CloudletRefsCache
 	ClusterRefsCache              edgeproto.ClusterRefsCache
 	AppInstRefsCache              edgeproto.AppInstRefsCache
+	GeoFencePolicyCache           edgeproto.GeoFencePolicyCache
 	FlowRateLimitSettingsCache    edgeproto.FlowRateLimitSettingsCache
 	MaxReqsRateLimitSettingsCache edgeproto.MaxReqsRateLimitSettingsCache
 	AppInstClientKeyCache         edgeproto.AppInstClientKeyCache
+	AppRolloutCache               edgeproto.AppRolloutCache
 	CloudletNodeCache             edgeproto.CloudletNodeCache
 	ControllerCache               edgeproto.ControllerCache
 	SvcNodeCache                  edgeproto.SvcNodeCache
@@ -368,6 +442,8 @@ func RegisterDummyServer(server *grpc.Server) *DummyServer {
 	d.MidstreamFailChs = make(map[string]chan bool)
 	edgeproto.InitAlertCache(&d.AlertCache)
 	edgeproto.InitAlertPolicyCache(&d.AlertPolicyCache)
+	edgeproto.InitAlertReceiverCache(&d.AlertReceiverCache)
+	edgeproto.InitAlertSilenceCache(&d.AlertSilenceCache)
 	edgeproto.InitSettingsCache(&d.SettingsCache)
 	edgeproto.InitFlavorCache(&d.FlavorCache)
 	edgeproto.InitOperatorCodeCache(&d.OperatorCodeCache)
@@ -397,15 +473,19 @@ func RegisterDummyServer(server *grpc.Server) *DummyServer {
 	edgeproto.InitCloudletRefsCache(&d.CloudletRefsCache)
 	edgeproto.InitClusterRefsCache(&d.ClusterRefsCache)
 	edgeproto.InitAppInstRefsCache(&d.AppInstRefsCache)
+	edgeproto.InitGeoFencePolicyCache(&d.GeoFencePolicyCache)
 	edgeproto.InitFlowRateLimitSettingsCache(&d.FlowRateLimitSettingsCache)
 	edgeproto.InitMaxReqsRateLimitSettingsCache(&d.MaxReqsRateLimitSettingsCache)
 	edgeproto.InitAppInstClientKeyCache(&d.AppInstClientKeyCache)
+	edgeproto.InitAppRolloutCache(&d.AppRolloutCache)
 	edgeproto.InitCloudletNodeCache(&d.CloudletNodeCache)
 	edgeproto.InitControllerCache(&d.ControllerCache)
 	edgeproto.InitSvcNodeCache(&d.SvcNodeCache)
 	edgeproto.InitDeviceCache(&d.DeviceCache)
 	edgeproto.RegisterAlertApiServer(server, d)
 	edgeproto.RegisterAlertPolicyApiServer(server, d)
+	edgeproto.RegisterAlertReceiverApiServer(server, d)
+	edgeproto.RegisterAlertSilenceApiServer(server, d)
 	edgeproto.RegisterSettingsApiServer(server, d)
 	edgeproto.RegisterFlavorApiServer(server, d)
 	edgeproto.RegisterOperatorCodeApiServer(server, d)
@@ -430,8 +510,10 @@ func RegisterDummyServer(server *grpc.Server) *DummyServer {
 	edgeproto.RegisterCloudletRefsApiServer(server, d)
 	edgeproto.RegisterClusterRefsApiServer(server, d)
 	edgeproto.RegisterAppInstRefsApiServer(server, d)
+	edgeproto.RegisterGeoFencePolicyApiServer(server, d)
 	edgeproto.RegisterRateLimitSettingsApiServer(server, d)
 	edgeproto.RegisterAppInstClientApiServer(server, d)
+	edgeproto.RegisterAppRolloutApiServer(server, d)
 	edgeproto.RegisterCloudletNodeApiServer(server, d)
 	edgeproto.RegisterControllerApiServer(server, d)
 	edgeproto.RegisterSvcNodeApiServer(server, d)
@@ -459,6 +541,8 @@ type CliClient struct {
 type Client interface {
 	AlertApiClient
 	AlertPolicyApiClient
+	AlertReceiverApiClient
+	AlertSilenceApiClient
 	SettingsApiClient
 	FlavorApiClient
 	OperatorCodeApiClient
@@ -488,8 +572,10 @@ type Client interface {
 	CloudletRefsApiClient
 	ClusterRefsApiClient
 	AppInstRefsApiClient
+	GeoFencePolicyApiClient
 	RateLimitSettingsApiClient
 	AppInstClientApiClient
+	AppRolloutApiClient
 	ExecApiClient
 	CloudletAccessApiClient
 	CloudletNodeApiClient
@@ -497,11 +583,14 @@ type Client interface {
 	SvcNodeApiClient
 	DebugApiClient
 	DeviceApiClient
+	DataSnapshotApiClient
 	StreamObjApiClient
 }
 
 type InternalCUDAPIs interface {
 	GetAlertPolicyApi() edgeproto.AlertPolicyApiServer
+	GetAlertReceiverApi() edgeproto.AlertReceiverApiServer
+	GetAlertSilenceApi() edgeproto.AlertSilenceApiServer
 	GetFlavorApi() edgeproto.FlavorApiServer
 	GetOperatorCodeApi() edgeproto.OperatorCodeApiServer
 	GetResTagTableApi() edgeproto.ResTagTableApiServer
@@ -518,5 +607,6 @@ type InternalCUDAPIs interface {
 	GetTrustPolicyExceptionApi() edgeproto.TrustPolicyExceptionApiServer
 	GetNetworkApi() edgeproto.NetworkApiServer
 	GetClusterInstApi() edgeproto.ClusterInstApiServer
+	GetGeoFencePolicyApi() edgeproto.GeoFencePolicyApiServer
 	GetCloudletNodeApi() edgeproto.CloudletNodeApiServer
 }
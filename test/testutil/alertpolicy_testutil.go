@@ -445,6 +445,17 @@ func (r *Run) AlertPolicyApi(data *[]edgeproto.AlertPolicy, dataMap interface{},
 				}
 				*outp = append(*outp, out...)
 			}
+		case "showalertpolicyhistory":
+			out, err := r.client.ShowAlertPolicyHistory(r.ctx, obj)
+			if err != nil {
+				r.logErr(fmt.Sprintf("AlertPolicyApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.AlertPolicyHistory)
+				if !ok {
+					panic(fmt.Sprintf("RunAlertPolicyApi expected dataOut type *[]edgeproto.AlertPolicyHistory, but was %T", dataOut))
+				}
+				*outp = append(*outp, out...)
+			}
 		}
 	}
 }
@@ -497,6 +508,25 @@ func (s *DummyServer) ShowAlertPolicy(in *edgeproto.AlertPolicy, server edgeprot
 	return err
 }
 
+func (s *DummyServer) ShowAlertPolicyHistory(in *edgeproto.AlertPolicy, server edgeproto.AlertPolicyApi_ShowAlertPolicyHistoryServer) error {
+	var err error
+	if true {
+		for ii := 0; ii < s.ShowDummyCount; ii++ {
+			server.Send(&edgeproto.AlertPolicyHistory{})
+		}
+		if ch, ok := s.MidstreamFailChs["ShowAlertPolicyHistory"]; ok {
+			// Wait until client receives the SendMsg, since they
+			// are buffered and dropped once we return err here.
+			select {
+			case <-ch:
+			case <-time.After(5 * time.Second):
+			}
+			return fmt.Errorf("midstream failure!")
+		}
+	}
+	return err
+}
+
 func (s *ApiClient) CreateAlertPolicy(ctx context.Context, in *edgeproto.AlertPolicy) (*edgeproto.Result, error) {
 	api := edgeproto.NewAlertPolicyApiClient(s.Conn)
 	return api.CreateAlertPolicy(ctx, in)
@@ -568,9 +598,45 @@ func (s *CliClient) ShowAlertPolicy(ctx context.Context, in *edgeproto.AlertPoli
 	return output, err
 }
 
+type AlertPolicyHistoryStream interface {
+	Recv() (*edgeproto.AlertPolicyHistory, error)
+}
+
+func AlertPolicyHistoryReadStream(stream AlertPolicyHistoryStream) ([]edgeproto.AlertPolicyHistory, error) {
+	output := []edgeproto.AlertPolicyHistory{}
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return output, fmt.Errorf("read AlertPolicyHistory stream failed, %v", err)
+		}
+		output = append(output, *obj)
+	}
+	return output, nil
+}
+
+func (s *ApiClient) ShowAlertPolicyHistory(ctx context.Context, in *edgeproto.AlertPolicy) ([]edgeproto.AlertPolicyHistory, error) {
+	api := edgeproto.NewAlertPolicyApiClient(s.Conn)
+	stream, err := api.ShowAlertPolicyHistory(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return AlertPolicyHistoryReadStream(stream)
+}
+
+func (s *CliClient) ShowAlertPolicyHistory(ctx context.Context, in *edgeproto.AlertPolicy) ([]edgeproto.AlertPolicyHistory, error) {
+	output := []edgeproto.AlertPolicyHistory{}
+	args := append(s.BaseArgs, "controller", "ShowAlertPolicyHistory")
+	err := wrapper.RunEdgectlObjs(args, in, &output, s.RunOps...)
+	return output, err
+}
+
 type AlertPolicyApiClient interface {
 	CreateAlertPolicy(ctx context.Context, in *edgeproto.AlertPolicy) (*edgeproto.Result, error)
 	DeleteAlertPolicy(ctx context.Context, in *edgeproto.AlertPolicy) (*edgeproto.Result, error)
 	UpdateAlertPolicy(ctx context.Context, in *edgeproto.AlertPolicy) (*edgeproto.Result, error)
 	ShowAlertPolicy(ctx context.Context, in *edgeproto.AlertPolicy) ([]edgeproto.AlertPolicy, error)
+	ShowAlertPolicyHistory(ctx context.Context, in *edgeproto.AlertPolicy) ([]edgeproto.AlertPolicyHistory, error)
 }
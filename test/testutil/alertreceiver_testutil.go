@@ -0,0 +1,576 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: alertreceiver.proto
+
+package testutil
+
+import (
+	"context"
+	fmt "fmt"
+	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cli"
+	"github.com/edgexr/edge-cloud-platform/pkg/edgectl/wrapper"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	_ "github.com/edgexr/edge-cloud-platform/tools/protogen"
+	_ "github.com/gogo/googleapis/google/api"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"io"
+	math "math"
+	"testing"
+	"time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// Auto-generated code: DO NOT EDIT
+
+type ShowAlertReceiver struct {
+	Data map[string]edgeproto.AlertReceiver
+	grpc.ServerStream
+	Ctx context.Context
+}
+
+func (x *ShowAlertReceiver) Init() {
+	x.Data = make(map[string]edgeproto.AlertReceiver)
+}
+
+func (x *ShowAlertReceiver) Send(m *edgeproto.AlertReceiver) error {
+	x.Data[m.GetKey().GetKeyString()] = *m
+	return nil
+}
+
+func (x *ShowAlertReceiver) Context() context.Context {
+	return x.Ctx
+}
+
+var AlertReceiverShowExtraCount = 0
+
+func (x *ShowAlertReceiver) ReadStream(stream edgeproto.AlertReceiverApi_ShowAlertReceiverClient, err error) {
+	x.Data = make(map[string]edgeproto.AlertReceiver)
+	if err != nil {
+		return
+	}
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			break
+		}
+		x.Data[obj.GetKey().GetKeyString()] = *obj
+	}
+}
+
+func (x *ShowAlertReceiver) CheckFound(obj *edgeproto.AlertReceiver) bool {
+	_, found := x.Data[obj.GetKey().GetKeyString()]
+	return found
+}
+
+func (x *ShowAlertReceiver) AssertFound(t *testing.T, obj *edgeproto.AlertReceiver) {
+	check, found := x.Data[obj.GetKey().GetKeyString()]
+	require.True(t, found, "find AlertReceiver %s", obj.GetKey().GetKeyString())
+	if found && !check.Matches(obj, edgeproto.MatchIgnoreBackend(), edgeproto.MatchSortArrayedKeys()) {
+		require.Equal(t, *obj, check, "AlertReceiver are equal")
+	}
+	if found {
+		// remove in case there are dups in the list, so the
+		// same object cannot be used again
+		delete(x.Data, obj.GetKey().GetKeyString())
+	}
+}
+
+func (x *ShowAlertReceiver) AssertNotFound(t *testing.T, obj *edgeproto.AlertReceiver) {
+	_, found := x.Data[obj.GetKey().GetKeyString()]
+	require.False(t, found, "do not find AlertReceiver %s", obj.GetKey().GetKeyString())
+}
+
+func WaitAssertFoundAlertReceiver(t *testing.T, api edgeproto.AlertReceiverApiClient, obj *edgeproto.AlertReceiver, count int, retry time.Duration) {
+	show := ShowAlertReceiver{}
+	for ii := 0; ii < count; ii++ {
+		ctx, cancel := context.WithTimeout(context.Background(), retry)
+		stream, err := api.ShowAlertReceiver(ctx, obj)
+		show.ReadStream(stream, err)
+		cancel()
+		if show.CheckFound(obj) {
+			break
+		}
+		time.Sleep(retry)
+	}
+	show.AssertFound(t, obj)
+}
+
+func WaitAssertNotFoundAlertReceiver(t *testing.T, api edgeproto.AlertReceiverApiClient, obj *edgeproto.AlertReceiver, count int, retry time.Duration) {
+	show := ShowAlertReceiver{}
+	filterNone := edgeproto.AlertReceiver{}
+	for ii := 0; ii < count; ii++ {
+		ctx, cancel := context.WithTimeout(context.Background(), retry)
+		stream, err := api.ShowAlertReceiver(ctx, &filterNone)
+		show.ReadStream(stream, err)
+		cancel()
+		if !show.CheckFound(obj) {
+			break
+		}
+		time.Sleep(retry)
+	}
+	show.AssertNotFound(t, obj)
+}
+
+// Wrap the api with a common interface
+type AlertReceiverCommonApi struct {
+	internal_api edgeproto.AlertReceiverApiServer
+	client_api   edgeproto.AlertReceiverApiClient
+}
+
+func (x *AlertReceiverCommonApi) CreateAlertReceiver(ctx context.Context, in *edgeproto.AlertReceiver) (*edgeproto.Result, error) {
+	copy := &edgeproto.AlertReceiver{}
+	*copy = *in
+	if x.internal_api != nil {
+		return x.internal_api.CreateAlertReceiver(ctx, copy)
+	} else {
+		res, err := x.client_api.CreateAlertReceiver(ctx, copy)
+		return res, unwrapGrpcError(err)
+	}
+}
+
+func (x *AlertReceiverCommonApi) DeleteAlertReceiver(ctx context.Context, in *edgeproto.AlertReceiver) (*edgeproto.Result, error) {
+	copy := &edgeproto.AlertReceiver{}
+	*copy = *in
+	if x.internal_api != nil {
+		return x.internal_api.DeleteAlertReceiver(ctx, copy)
+	} else {
+		res, err := x.client_api.DeleteAlertReceiver(ctx, copy)
+		return res, unwrapGrpcError(err)
+	}
+}
+
+func (x *AlertReceiverCommonApi) UpdateAlertReceiver(ctx context.Context, in *edgeproto.AlertReceiver) (*edgeproto.Result, error) {
+	copy := &edgeproto.AlertReceiver{}
+	*copy = *in
+	if x.internal_api != nil {
+		return x.internal_api.UpdateAlertReceiver(ctx, copy)
+	} else {
+		res, err := x.client_api.UpdateAlertReceiver(ctx, copy)
+		return res, unwrapGrpcError(err)
+	}
+}
+
+func (x *AlertReceiverCommonApi) ShowAlertReceiver(ctx context.Context, filter *edgeproto.AlertReceiver, showData *ShowAlertReceiver) error {
+	if x.internal_api != nil {
+		showData.Ctx = ctx
+		return x.internal_api.ShowAlertReceiver(filter, showData)
+	} else {
+		stream, err := x.client_api.ShowAlertReceiver(ctx, filter)
+		showData.ReadStream(stream, err)
+		return unwrapGrpcError(err)
+	}
+}
+
+func NewInternalAlertReceiverApi(api edgeproto.AlertReceiverApiServer) *AlertReceiverCommonApi {
+	apiWrap := AlertReceiverCommonApi{}
+	apiWrap.internal_api = api
+	return &apiWrap
+}
+
+func NewClientAlertReceiverApi(api edgeproto.AlertReceiverApiClient) *AlertReceiverCommonApi {
+	apiWrap := AlertReceiverCommonApi{}
+	apiWrap.client_api = api
+	return &apiWrap
+}
+
+type AlertReceiverTestOptions struct {
+	createdData []edgeproto.AlertReceiver
+}
+
+type AlertReceiverTestOp func(opts *AlertReceiverTestOptions)
+
+func WithCreatedAlertReceiverTestData(createdData []edgeproto.AlertReceiver) AlertReceiverTestOp {
+	return func(opts *AlertReceiverTestOptions) { opts.createdData = createdData }
+}
+
+func InternalAlertReceiverTest(t *testing.T, test string, api edgeproto.AlertReceiverApiServer, testData []edgeproto.AlertReceiver, ops ...AlertReceiverTestOp) {
+	span := log.StartSpan(log.DebugLevelApi, "InternalAlertReceiverTest")
+	defer span.Finish()
+	ctx := log.ContextWithSpan(context.Background(), span)
+
+	switch test {
+	case "cud":
+		basicAlertReceiverCudTest(t, ctx, NewInternalAlertReceiverApi(api), testData, ops...)
+	case "show":
+		basicAlertReceiverShowTest(t, ctx, NewInternalAlertReceiverApi(api), testData)
+	}
+}
+
+func ClientAlertReceiverTest(t *testing.T, test string, api edgeproto.AlertReceiverApiClient, testData []edgeproto.AlertReceiver, ops ...AlertReceiverTestOp) {
+	span := log.StartSpan(log.DebugLevelApi, "ClientAlertReceiverTest")
+	defer span.Finish()
+	ctx := log.ContextWithSpan(context.Background(), span)
+
+	switch test {
+	case "cud":
+		basicAlertReceiverCudTest(t, ctx, NewClientAlertReceiverApi(api), testData, ops...)
+	case "show":
+		basicAlertReceiverShowTest(t, ctx, NewClientAlertReceiverApi(api), testData)
+	}
+}
+
+func basicAlertReceiverShowTest(t *testing.T, ctx context.Context, api *AlertReceiverCommonApi, testData []edgeproto.AlertReceiver) {
+	var err error
+
+	show := ShowAlertReceiver{}
+	show.Init()
+	filterNone := edgeproto.AlertReceiver{}
+	err = api.ShowAlertReceiver(ctx, &filterNone, &show)
+	require.Nil(t, err, "show data")
+	require.Equal(t, len(testData)+AlertReceiverShowExtraCount, len(show.Data), "Show count")
+	for _, obj := range testData {
+		show.AssertFound(t, &obj)
+	}
+}
+
+func GetAlertReceiver(t *testing.T, ctx context.Context, api *AlertReceiverCommonApi, key *edgeproto.AlertReceiverKey, out *edgeproto.AlertReceiver) bool {
+	var err error
+
+	show := ShowAlertReceiver{}
+	show.Init()
+	filter := edgeproto.AlertReceiver{}
+	filter.SetKey(key)
+	err = api.ShowAlertReceiver(ctx, &filter, &show)
+	require.Nil(t, err, "show data")
+	obj, found := show.Data[key.GetKeyString()]
+	if found {
+		*out = obj
+	}
+	return found
+}
+
+func basicAlertReceiverCudTest(t *testing.T, ctx context.Context, api *AlertReceiverCommonApi, testData []edgeproto.AlertReceiver, ops ...AlertReceiverTestOp) {
+	var err error
+
+	if len(testData) < 3 {
+		require.True(t, false, "Need at least 3 test data objects")
+		return
+	}
+	options := AlertReceiverTestOptions{}
+	for _, op := range ops {
+		op(&options)
+	}
+	createdData := testData
+	if options.createdData != nil {
+		createdData = options.createdData
+	}
+
+	// test create
+	CreateAlertReceiverData(t, ctx, api, testData)
+
+	// test duplicate Create - should fail
+	_, err = api.CreateAlertReceiver(ctx, &testData[0])
+	require.NotNil(t, err, "Create duplicate AlertReceiver")
+
+	// test show all items
+	basicAlertReceiverShowTest(t, ctx, api, createdData)
+
+	// test Delete
+	_, err = api.DeleteAlertReceiver(ctx, &createdData[0])
+	require.Nil(t, err, "Delete AlertReceiver %s", testData[0].GetKey().GetKeyString())
+	show := ShowAlertReceiver{}
+	show.Init()
+	filterNone := edgeproto.AlertReceiver{}
+	err = api.ShowAlertReceiver(ctx, &filterNone, &show)
+	require.Nil(t, err, "show data")
+	require.Equal(t, len(createdData)-1+AlertReceiverShowExtraCount, len(show.Data), "Show count")
+	show.AssertNotFound(t, &createdData[0])
+	// test update of missing object
+	_, err = api.UpdateAlertReceiver(ctx, &createdData[0])
+	require.NotNil(t, err, "Update missing object")
+	// Create it back
+	_, err = api.CreateAlertReceiver(ctx, &testData[0])
+	require.Nil(t, err, "Create AlertReceiver %s", testData[0].GetKey().GetKeyString())
+
+	// test invalid keys
+	bad := edgeproto.AlertReceiver{}
+	_, err = api.CreateAlertReceiver(ctx, &bad)
+	require.NotNil(t, err, "Create AlertReceiver with no key info")
+
+}
+
+func InternalAlertReceiverCreate(t *testing.T, api edgeproto.AlertReceiverApiServer, testData []edgeproto.AlertReceiver) {
+	span := log.StartSpan(log.DebugLevelApi, "InternalAlertReceiverCreate")
+	defer span.Finish()
+	ctx := log.ContextWithSpan(context.Background(), span)
+
+	CreateAlertReceiverData(t, ctx, NewInternalAlertReceiverApi(api), testData)
+}
+
+func ClientAlertReceiverCreate(t *testing.T, api edgeproto.AlertReceiverApiClient, testData []edgeproto.AlertReceiver) {
+	span := log.StartSpan(log.DebugLevelApi, "ClientAlertReceiverCreate")
+	defer span.Finish()
+	ctx := log.ContextWithSpan(context.Background(), span)
+
+	CreateAlertReceiverData(t, ctx, NewClientAlertReceiverApi(api), testData)
+}
+
+func CreateAlertReceiverData(t *testing.T, ctx context.Context, api *AlertReceiverCommonApi, testData []edgeproto.AlertReceiver) {
+	var err error
+
+	for ii := range testData {
+		obj := testData[ii]
+		_, err = api.CreateAlertReceiver(ctx, &obj)
+		require.Nil(t, err, "Create AlertReceiver %s", obj.GetKey().GetKeyString())
+	}
+}
+
+func InternalAlertReceiverDelete(t *testing.T, api edgeproto.AlertReceiverApiServer, testData []edgeproto.AlertReceiver) {
+	span := log.StartSpan(log.DebugLevelApi, "InternalAlertReceiverDelete")
+	defer span.Finish()
+	ctx := log.ContextWithSpan(context.Background(), span)
+
+	DeleteAlertReceiverData(t, ctx, NewInternalAlertReceiverApi(api), testData)
+}
+
+func InternalAlertReceiverDeleteAll(t *testing.T, ctx context.Context, api edgeproto.AlertReceiverApiServer, data []edgeproto.AlertReceiver) {
+	intapi := NewInternalAlertReceiverApi(api)
+	log.SpanLog(ctx, log.DebugLevelInfo, "deleting all AlertReceivers", "count", len(data))
+	DeleteAlertReceiverData(t, ctx, intapi, data)
+}
+
+func ClientAlertReceiverDelete(t *testing.T, api edgeproto.AlertReceiverApiClient, testData []edgeproto.AlertReceiver) {
+	span := log.StartSpan(log.DebugLevelApi, "ClientAlertReceiverDelete")
+	defer span.Finish()
+	ctx := log.ContextWithSpan(context.Background(), span)
+
+	DeleteAlertReceiverData(t, ctx, NewClientAlertReceiverApi(api), testData)
+}
+
+func DeleteAlertReceiverData(t *testing.T, ctx context.Context, api *AlertReceiverCommonApi, testData []edgeproto.AlertReceiver) {
+	var err error
+
+	for ii := range testData {
+		obj := testData[ii]
+		_, err = api.DeleteAlertReceiver(ctx, &obj)
+		require.Nil(t, err, "Delete AlertReceiver %s", obj.GetKey().GetKeyString())
+	}
+}
+
+func FindAlertReceiverData(key *edgeproto.AlertReceiverKey, testData []edgeproto.AlertReceiver) (*edgeproto.AlertReceiver, bool) {
+	for ii, _ := range testData {
+		if testData[ii].GetKey().Matches(key) {
+			return &testData[ii], true
+		}
+	}
+	return nil, false
+}
+
+func (r *Run) AlertReceiverApi(data *[]edgeproto.AlertReceiver, dataMap interface{}, dataOut interface{}) {
+	log.DebugLog(log.DebugLevelApi, "API for AlertReceiver", "mode", r.Mode)
+	if r.Mode == "show" {
+		obj := &edgeproto.AlertReceiver{}
+		out, err := r.client.ShowAlertReceiver(r.ctx, obj)
+		if err != nil {
+			r.logErr("AlertReceiverApi", err)
+		} else {
+			outp, ok := dataOut.(*[]edgeproto.AlertReceiver)
+			if !ok {
+				panic(fmt.Sprintf("RunAlertReceiverApi expected dataOut type *[]edgeproto.AlertReceiver, but was %T", dataOut))
+			}
+			*outp = append(*outp, out...)
+		}
+		return
+	}
+	for ii, objD := range *data {
+		obj := &objD
+		switch r.Mode {
+		case "create":
+			out, err := r.client.CreateAlertReceiver(r.ctx, obj)
+			if err != nil {
+				err = ignoreExpectedErrors(r.Mode, obj.GetKey(), err)
+				r.logErr(fmt.Sprintf("AlertReceiverApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.Result)
+				if !ok {
+					panic(fmt.Sprintf("RunAlertReceiverApi expected dataOut type *[]edgeproto.Result, but was %T", dataOut))
+				}
+				*outp = append(*outp, *out)
+			}
+		case "delete":
+			out, err := r.client.DeleteAlertReceiver(r.ctx, obj)
+			if err != nil {
+				err = ignoreExpectedErrors(r.Mode, obj.GetKey(), err)
+				r.logErr(fmt.Sprintf("AlertReceiverApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.Result)
+				if !ok {
+					panic(fmt.Sprintf("RunAlertReceiverApi expected dataOut type *[]edgeproto.Result, but was %T", dataOut))
+				}
+				*outp = append(*outp, *out)
+			}
+		case "update":
+			// set specified fields
+			objMap, err := cli.GetGenericObjFromList(dataMap, ii)
+			if err != nil {
+				log.DebugLog(log.DebugLevelApi, "bad dataMap for AlertReceiver", "err", err)
+				*r.Rc = false
+				return
+			}
+			yamlData := cli.MapData{
+				Namespace: cli.YamlNamespace,
+				Data:      objMap,
+			}
+			obj.Fields = cli.GetSpecifiedFields(&yamlData, obj)
+
+			out, err := r.client.UpdateAlertReceiver(r.ctx, obj)
+			if err != nil {
+				err = ignoreExpectedErrors(r.Mode, obj.GetKey(), err)
+				r.logErr(fmt.Sprintf("AlertReceiverApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.Result)
+				if !ok {
+					panic(fmt.Sprintf("RunAlertReceiverApi expected dataOut type *[]edgeproto.Result, but was %T", dataOut))
+				}
+				*outp = append(*outp, *out)
+			}
+		case "showfiltered":
+			out, err := r.client.ShowAlertReceiver(r.ctx, obj)
+			if err != nil {
+				r.logErr(fmt.Sprintf("AlertReceiverApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.AlertReceiver)
+				if !ok {
+					panic(fmt.Sprintf("RunAlertReceiverApi expected dataOut type *[]edgeproto.AlertReceiver, but was %T", dataOut))
+				}
+				*outp = append(*outp, out...)
+			}
+		}
+	}
+}
+
+func (s *DummyServer) CreateAlertReceiver(ctx context.Context, in *edgeproto.AlertReceiver) (*edgeproto.Result, error) {
+	if s.CudNoop {
+		return &edgeproto.Result{}, nil
+	}
+	s.AlertReceiverCache.Update(ctx, in, 0)
+	return &edgeproto.Result{}, nil
+}
+
+func (s *DummyServer) DeleteAlertReceiver(ctx context.Context, in *edgeproto.AlertReceiver) (*edgeproto.Result, error) {
+	if s.CudNoop {
+		return &edgeproto.Result{}, nil
+	}
+	s.AlertReceiverCache.Delete(ctx, in, 0)
+	return &edgeproto.Result{}, nil
+}
+
+func (s *DummyServer) UpdateAlertReceiver(ctx context.Context, in *edgeproto.AlertReceiver) (*edgeproto.Result, error) {
+	if s.CudNoop {
+		return &edgeproto.Result{}, nil
+	}
+	s.AlertReceiverCache.Update(ctx, in, 0)
+	return &edgeproto.Result{}, nil
+}
+
+func (s *DummyServer) ShowAlertReceiver(in *edgeproto.AlertReceiver, server edgeproto.AlertReceiverApi_ShowAlertReceiverServer) error {
+	var err error
+	obj := &edgeproto.AlertReceiver{}
+	if obj.Matches(in, edgeproto.MatchFilter()) {
+		for ii := 0; ii < s.ShowDummyCount; ii++ {
+			server.Send(&edgeproto.AlertReceiver{})
+		}
+		if ch, ok := s.MidstreamFailChs["ShowAlertReceiver"]; ok {
+			// Wait until client receives the SendMsg, since they
+			// are buffered and dropped once we return err here.
+			select {
+			case <-ch:
+			case <-time.After(5 * time.Second):
+			}
+			return fmt.Errorf("midstream failure!")
+		}
+	}
+	err = s.AlertReceiverCache.Show(in, func(obj *edgeproto.AlertReceiver) error {
+		err := server.Send(obj)
+		return err
+	})
+	return err
+}
+
+func (s *ApiClient) CreateAlertReceiver(ctx context.Context, in *edgeproto.AlertReceiver) (*edgeproto.Result, error) {
+	api := edgeproto.NewAlertReceiverApiClient(s.Conn)
+	return api.CreateAlertReceiver(ctx, in)
+}
+
+func (s *CliClient) CreateAlertReceiver(ctx context.Context, in *edgeproto.AlertReceiver) (*edgeproto.Result, error) {
+	out := edgeproto.Result{}
+	args := append(s.BaseArgs, "controller", "CreateAlertReceiver")
+	err := wrapper.RunEdgectlObjs(args, in, &out, s.RunOps...)
+	return &out, err
+}
+
+func (s *ApiClient) DeleteAlertReceiver(ctx context.Context, in *edgeproto.AlertReceiver) (*edgeproto.Result, error) {
+	api := edgeproto.NewAlertReceiverApiClient(s.Conn)
+	return api.DeleteAlertReceiver(ctx, in)
+}
+
+func (s *CliClient) DeleteAlertReceiver(ctx context.Context, in *edgeproto.AlertReceiver) (*edgeproto.Result, error) {
+	out := edgeproto.Result{}
+	args := append(s.BaseArgs, "controller", "DeleteAlertReceiver")
+	err := wrapper.RunEdgectlObjs(args, in, &out, s.RunOps...)
+	return &out, err
+}
+
+func (s *ApiClient) UpdateAlertReceiver(ctx context.Context, in *edgeproto.AlertReceiver) (*edgeproto.Result, error) {
+	api := edgeproto.NewAlertReceiverApiClient(s.Conn)
+	return api.UpdateAlertReceiver(ctx, in)
+}
+
+func (s *CliClient) UpdateAlertReceiver(ctx context.Context, in *edgeproto.AlertReceiver) (*edgeproto.Result, error) {
+	out := edgeproto.Result{}
+	args := append(s.BaseArgs, "controller", "UpdateAlertReceiver")
+	err := wrapper.RunEdgectlObjs(args, in, &out, s.RunOps...)
+	return &out, err
+}
+
+type AlertReceiverStream interface {
+	Recv() (*edgeproto.AlertReceiver, error)
+}
+
+func AlertReceiverReadStream(stream AlertReceiverStream) ([]edgeproto.AlertReceiver, error) {
+	output := []edgeproto.AlertReceiver{}
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return output, fmt.Errorf("read AlertReceiver stream failed, %v", err)
+		}
+		output = append(output, *obj)
+	}
+	return output, nil
+}
+
+func (s *ApiClient) ShowAlertReceiver(ctx context.Context, in *edgeproto.AlertReceiver) ([]edgeproto.AlertReceiver, error) {
+	api := edgeproto.NewAlertReceiverApiClient(s.Conn)
+	stream, err := api.ShowAlertReceiver(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return AlertReceiverReadStream(stream)
+}
+
+func (s *CliClient) ShowAlertReceiver(ctx context.Context, in *edgeproto.AlertReceiver) ([]edgeproto.AlertReceiver, error) {
+	output := []edgeproto.AlertReceiver{}
+	args := append(s.BaseArgs, "controller", "ShowAlertReceiver")
+	err := wrapper.RunEdgectlObjs(args, in, &output, s.RunOps...)
+	return output, err
+}
+
+type AlertReceiverApiClient interface {
+	CreateAlertReceiver(ctx context.Context, in *edgeproto.AlertReceiver) (*edgeproto.Result, error)
+	DeleteAlertReceiver(ctx context.Context, in *edgeproto.AlertReceiver) (*edgeproto.Result, error)
+	UpdateAlertReceiver(ctx context.Context, in *edgeproto.AlertReceiver) (*edgeproto.Result, error)
+	ShowAlertReceiver(ctx context.Context, in *edgeproto.AlertReceiver) ([]edgeproto.AlertReceiver, error)
+}
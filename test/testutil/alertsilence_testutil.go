@@ -0,0 +1,577 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: alertsilence.proto
+
+package testutil
+
+import (
+	"context"
+	fmt "fmt"
+	_ "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cli"
+	"github.com/edgexr/edge-cloud-platform/pkg/edgectl/wrapper"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	_ "github.com/edgexr/edge-cloud-platform/tools/protogen"
+	_ "github.com/gogo/googleapis/google/api"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"io"
+	math "math"
+	"testing"
+	"time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// Auto-generated code: DO NOT EDIT
+
+type ShowAlertSilence struct {
+	Data map[string]edgeproto.AlertSilence
+	grpc.ServerStream
+	Ctx context.Context
+}
+
+func (x *ShowAlertSilence) Init() {
+	x.Data = make(map[string]edgeproto.AlertSilence)
+}
+
+func (x *ShowAlertSilence) Send(m *edgeproto.AlertSilence) error {
+	x.Data[m.GetKey().GetKeyString()] = *m
+	return nil
+}
+
+func (x *ShowAlertSilence) Context() context.Context {
+	return x.Ctx
+}
+
+var AlertSilenceShowExtraCount = 0
+
+func (x *ShowAlertSilence) ReadStream(stream edgeproto.AlertSilenceApi_ShowAlertSilenceClient, err error) {
+	x.Data = make(map[string]edgeproto.AlertSilence)
+	if err != nil {
+		return
+	}
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			break
+		}
+		x.Data[obj.GetKey().GetKeyString()] = *obj
+	}
+}
+
+func (x *ShowAlertSilence) CheckFound(obj *edgeproto.AlertSilence) bool {
+	_, found := x.Data[obj.GetKey().GetKeyString()]
+	return found
+}
+
+func (x *ShowAlertSilence) AssertFound(t *testing.T, obj *edgeproto.AlertSilence) {
+	check, found := x.Data[obj.GetKey().GetKeyString()]
+	require.True(t, found, "find AlertSilence %s", obj.GetKey().GetKeyString())
+	if found && !check.Matches(obj, edgeproto.MatchIgnoreBackend(), edgeproto.MatchSortArrayedKeys()) {
+		require.Equal(t, *obj, check, "AlertSilence are equal")
+	}
+	if found {
+		// remove in case there are dups in the list, so the
+		// same object cannot be used again
+		delete(x.Data, obj.GetKey().GetKeyString())
+	}
+}
+
+func (x *ShowAlertSilence) AssertNotFound(t *testing.T, obj *edgeproto.AlertSilence) {
+	_, found := x.Data[obj.GetKey().GetKeyString()]
+	require.False(t, found, "do not find AlertSilence %s", obj.GetKey().GetKeyString())
+}
+
+func WaitAssertFoundAlertSilence(t *testing.T, api edgeproto.AlertSilenceApiClient, obj *edgeproto.AlertSilence, count int, retry time.Duration) {
+	show := ShowAlertSilence{}
+	for ii := 0; ii < count; ii++ {
+		ctx, cancel := context.WithTimeout(context.Background(), retry)
+		stream, err := api.ShowAlertSilence(ctx, obj)
+		show.ReadStream(stream, err)
+		cancel()
+		if show.CheckFound(obj) {
+			break
+		}
+		time.Sleep(retry)
+	}
+	show.AssertFound(t, obj)
+}
+
+func WaitAssertNotFoundAlertSilence(t *testing.T, api edgeproto.AlertSilenceApiClient, obj *edgeproto.AlertSilence, count int, retry time.Duration) {
+	show := ShowAlertSilence{}
+	filterNone := edgeproto.AlertSilence{}
+	for ii := 0; ii < count; ii++ {
+		ctx, cancel := context.WithTimeout(context.Background(), retry)
+		stream, err := api.ShowAlertSilence(ctx, &filterNone)
+		show.ReadStream(stream, err)
+		cancel()
+		if !show.CheckFound(obj) {
+			break
+		}
+		time.Sleep(retry)
+	}
+	show.AssertNotFound(t, obj)
+}
+
+// Wrap the api with a common interface
+type AlertSilenceCommonApi struct {
+	internal_api edgeproto.AlertSilenceApiServer
+	client_api   edgeproto.AlertSilenceApiClient
+}
+
+func (x *AlertSilenceCommonApi) CreateAlertSilence(ctx context.Context, in *edgeproto.AlertSilence) (*edgeproto.Result, error) {
+	copy := &edgeproto.AlertSilence{}
+	*copy = *in
+	if x.internal_api != nil {
+		return x.internal_api.CreateAlertSilence(ctx, copy)
+	} else {
+		res, err := x.client_api.CreateAlertSilence(ctx, copy)
+		return res, unwrapGrpcError(err)
+	}
+}
+
+func (x *AlertSilenceCommonApi) DeleteAlertSilence(ctx context.Context, in *edgeproto.AlertSilence) (*edgeproto.Result, error) {
+	copy := &edgeproto.AlertSilence{}
+	*copy = *in
+	if x.internal_api != nil {
+		return x.internal_api.DeleteAlertSilence(ctx, copy)
+	} else {
+		res, err := x.client_api.DeleteAlertSilence(ctx, copy)
+		return res, unwrapGrpcError(err)
+	}
+}
+
+func (x *AlertSilenceCommonApi) UpdateAlertSilence(ctx context.Context, in *edgeproto.AlertSilence) (*edgeproto.Result, error) {
+	copy := &edgeproto.AlertSilence{}
+	*copy = *in
+	if x.internal_api != nil {
+		return x.internal_api.UpdateAlertSilence(ctx, copy)
+	} else {
+		res, err := x.client_api.UpdateAlertSilence(ctx, copy)
+		return res, unwrapGrpcError(err)
+	}
+}
+
+func (x *AlertSilenceCommonApi) ShowAlertSilence(ctx context.Context, filter *edgeproto.AlertSilence, showData *ShowAlertSilence) error {
+	if x.internal_api != nil {
+		showData.Ctx = ctx
+		return x.internal_api.ShowAlertSilence(filter, showData)
+	} else {
+		stream, err := x.client_api.ShowAlertSilence(ctx, filter)
+		showData.ReadStream(stream, err)
+		return unwrapGrpcError(err)
+	}
+}
+
+func NewInternalAlertSilenceApi(api edgeproto.AlertSilenceApiServer) *AlertSilenceCommonApi {
+	apiWrap := AlertSilenceCommonApi{}
+	apiWrap.internal_api = api
+	return &apiWrap
+}
+
+func NewClientAlertSilenceApi(api edgeproto.AlertSilenceApiClient) *AlertSilenceCommonApi {
+	apiWrap := AlertSilenceCommonApi{}
+	apiWrap.client_api = api
+	return &apiWrap
+}
+
+type AlertSilenceTestOptions struct {
+	createdData []edgeproto.AlertSilence
+}
+
+type AlertSilenceTestOp func(opts *AlertSilenceTestOptions)
+
+func WithCreatedAlertSilenceTestData(createdData []edgeproto.AlertSilence) AlertSilenceTestOp {
+	return func(opts *AlertSilenceTestOptions) { opts.createdData = createdData }
+}
+
+func InternalAlertSilenceTest(t *testing.T, test string, api edgeproto.AlertSilenceApiServer, testData []edgeproto.AlertSilence, ops ...AlertSilenceTestOp) {
+	span := log.StartSpan(log.DebugLevelApi, "InternalAlertSilenceTest")
+	defer span.Finish()
+	ctx := log.ContextWithSpan(context.Background(), span)
+
+	switch test {
+	case "cud":
+		basicAlertSilenceCudTest(t, ctx, NewInternalAlertSilenceApi(api), testData, ops...)
+	case "show":
+		basicAlertSilenceShowTest(t, ctx, NewInternalAlertSilenceApi(api), testData)
+	}
+}
+
+func ClientAlertSilenceTest(t *testing.T, test string, api edgeproto.AlertSilenceApiClient, testData []edgeproto.AlertSilence, ops ...AlertSilenceTestOp) {
+	span := log.StartSpan(log.DebugLevelApi, "ClientAlertSilenceTest")
+	defer span.Finish()
+	ctx := log.ContextWithSpan(context.Background(), span)
+
+	switch test {
+	case "cud":
+		basicAlertSilenceCudTest(t, ctx, NewClientAlertSilenceApi(api), testData, ops...)
+	case "show":
+		basicAlertSilenceShowTest(t, ctx, NewClientAlertSilenceApi(api), testData)
+	}
+}
+
+func basicAlertSilenceShowTest(t *testing.T, ctx context.Context, api *AlertSilenceCommonApi, testData []edgeproto.AlertSilence) {
+	var err error
+
+	show := ShowAlertSilence{}
+	show.Init()
+	filterNone := edgeproto.AlertSilence{}
+	err = api.ShowAlertSilence(ctx, &filterNone, &show)
+	require.Nil(t, err, "show data")
+	require.Equal(t, len(testData)+AlertSilenceShowExtraCount, len(show.Data), "Show count")
+	for _, obj := range testData {
+		show.AssertFound(t, &obj)
+	}
+}
+
+func GetAlertSilence(t *testing.T, ctx context.Context, api *AlertSilenceCommonApi, key *edgeproto.AlertSilenceKey, out *edgeproto.AlertSilence) bool {
+	var err error
+
+	show := ShowAlertSilence{}
+	show.Init()
+	filter := edgeproto.AlertSilence{}
+	filter.SetKey(key)
+	err = api.ShowAlertSilence(ctx, &filter, &show)
+	require.Nil(t, err, "show data")
+	obj, found := show.Data[key.GetKeyString()]
+	if found {
+		*out = obj
+	}
+	return found
+}
+
+func basicAlertSilenceCudTest(t *testing.T, ctx context.Context, api *AlertSilenceCommonApi, testData []edgeproto.AlertSilence, ops ...AlertSilenceTestOp) {
+	var err error
+
+	if len(testData) < 3 {
+		require.True(t, false, "Need at least 3 test data objects")
+		return
+	}
+	options := AlertSilenceTestOptions{}
+	for _, op := range ops {
+		op(&options)
+	}
+	createdData := testData
+	if options.createdData != nil {
+		createdData = options.createdData
+	}
+
+	// test create
+	CreateAlertSilenceData(t, ctx, api, testData)
+
+	// test duplicate Create - should fail
+	_, err = api.CreateAlertSilence(ctx, &testData[0])
+	require.NotNil(t, err, "Create duplicate AlertSilence")
+
+	// test show all items
+	basicAlertSilenceShowTest(t, ctx, api, createdData)
+
+	// test Delete
+	_, err = api.DeleteAlertSilence(ctx, &createdData[0])
+	require.Nil(t, err, "Delete AlertSilence %s", testData[0].GetKey().GetKeyString())
+	show := ShowAlertSilence{}
+	show.Init()
+	filterNone := edgeproto.AlertSilence{}
+	err = api.ShowAlertSilence(ctx, &filterNone, &show)
+	require.Nil(t, err, "show data")
+	require.Equal(t, len(createdData)-1+AlertSilenceShowExtraCount, len(show.Data), "Show count")
+	show.AssertNotFound(t, &createdData[0])
+	// test update of missing object
+	_, err = api.UpdateAlertSilence(ctx, &createdData[0])
+	require.NotNil(t, err, "Update missing object")
+	// Create it back
+	_, err = api.CreateAlertSilence(ctx, &testData[0])
+	require.Nil(t, err, "Create AlertSilence %s", testData[0].GetKey().GetKeyString())
+
+	// test invalid keys
+	bad := edgeproto.AlertSilence{}
+	_, err = api.CreateAlertSilence(ctx, &bad)
+	require.NotNil(t, err, "Create AlertSilence with no key info")
+
+}
+
+func InternalAlertSilenceCreate(t *testing.T, api edgeproto.AlertSilenceApiServer, testData []edgeproto.AlertSilence) {
+	span := log.StartSpan(log.DebugLevelApi, "InternalAlertSilenceCreate")
+	defer span.Finish()
+	ctx := log.ContextWithSpan(context.Background(), span)
+
+	CreateAlertSilenceData(t, ctx, NewInternalAlertSilenceApi(api), testData)
+}
+
+func ClientAlertSilenceCreate(t *testing.T, api edgeproto.AlertSilenceApiClient, testData []edgeproto.AlertSilence) {
+	span := log.StartSpan(log.DebugLevelApi, "ClientAlertSilenceCreate")
+	defer span.Finish()
+	ctx := log.ContextWithSpan(context.Background(), span)
+
+	CreateAlertSilenceData(t, ctx, NewClientAlertSilenceApi(api), testData)
+}
+
+func CreateAlertSilenceData(t *testing.T, ctx context.Context, api *AlertSilenceCommonApi, testData []edgeproto.AlertSilence) {
+	var err error
+
+	for ii := range testData {
+		obj := testData[ii]
+		_, err = api.CreateAlertSilence(ctx, &obj)
+		require.Nil(t, err, "Create AlertSilence %s", obj.GetKey().GetKeyString())
+	}
+}
+
+func InternalAlertSilenceDelete(t *testing.T, api edgeproto.AlertSilenceApiServer, testData []edgeproto.AlertSilence) {
+	span := log.StartSpan(log.DebugLevelApi, "InternalAlertSilenceDelete")
+	defer span.Finish()
+	ctx := log.ContextWithSpan(context.Background(), span)
+
+	DeleteAlertSilenceData(t, ctx, NewInternalAlertSilenceApi(api), testData)
+}
+
+func InternalAlertSilenceDeleteAll(t *testing.T, ctx context.Context, api edgeproto.AlertSilenceApiServer, data []edgeproto.AlertSilence) {
+	intapi := NewInternalAlertSilenceApi(api)
+	log.SpanLog(ctx, log.DebugLevelInfo, "deleting all AlertSilences", "count", len(data))
+	DeleteAlertSilenceData(t, ctx, intapi, data)
+}
+
+func ClientAlertSilenceDelete(t *testing.T, api edgeproto.AlertSilenceApiClient, testData []edgeproto.AlertSilence) {
+	span := log.StartSpan(log.DebugLevelApi, "ClientAlertSilenceDelete")
+	defer span.Finish()
+	ctx := log.ContextWithSpan(context.Background(), span)
+
+	DeleteAlertSilenceData(t, ctx, NewClientAlertSilenceApi(api), testData)
+}
+
+func DeleteAlertSilenceData(t *testing.T, ctx context.Context, api *AlertSilenceCommonApi, testData []edgeproto.AlertSilence) {
+	var err error
+
+	for ii := range testData {
+		obj := testData[ii]
+		_, err = api.DeleteAlertSilence(ctx, &obj)
+		require.Nil(t, err, "Delete AlertSilence %s", obj.GetKey().GetKeyString())
+	}
+}
+
+func FindAlertSilenceData(key *edgeproto.AlertSilenceKey, testData []edgeproto.AlertSilence) (*edgeproto.AlertSilence, bool) {
+	for ii, _ := range testData {
+		if testData[ii].GetKey().Matches(key) {
+			return &testData[ii], true
+		}
+	}
+	return nil, false
+}
+
+func (r *Run) AlertSilenceApi(data *[]edgeproto.AlertSilence, dataMap interface{}, dataOut interface{}) {
+	log.DebugLog(log.DebugLevelApi, "API for AlertSilence", "mode", r.Mode)
+	if r.Mode == "show" {
+		obj := &edgeproto.AlertSilence{}
+		out, err := r.client.ShowAlertSilence(r.ctx, obj)
+		if err != nil {
+			r.logErr("AlertSilenceApi", err)
+		} else {
+			outp, ok := dataOut.(*[]edgeproto.AlertSilence)
+			if !ok {
+				panic(fmt.Sprintf("RunAlertSilenceApi expected dataOut type *[]edgeproto.AlertSilence, but was %T", dataOut))
+			}
+			*outp = append(*outp, out...)
+		}
+		return
+	}
+	for ii, objD := range *data {
+		obj := &objD
+		switch r.Mode {
+		case "create":
+			out, err := r.client.CreateAlertSilence(r.ctx, obj)
+			if err != nil {
+				err = ignoreExpectedErrors(r.Mode, obj.GetKey(), err)
+				r.logErr(fmt.Sprintf("AlertSilenceApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.Result)
+				if !ok {
+					panic(fmt.Sprintf("RunAlertSilenceApi expected dataOut type *[]edgeproto.Result, but was %T", dataOut))
+				}
+				*outp = append(*outp, *out)
+			}
+		case "delete":
+			out, err := r.client.DeleteAlertSilence(r.ctx, obj)
+			if err != nil {
+				err = ignoreExpectedErrors(r.Mode, obj.GetKey(), err)
+				r.logErr(fmt.Sprintf("AlertSilenceApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.Result)
+				if !ok {
+					panic(fmt.Sprintf("RunAlertSilenceApi expected dataOut type *[]edgeproto.Result, but was %T", dataOut))
+				}
+				*outp = append(*outp, *out)
+			}
+		case "update":
+			// set specified fields
+			objMap, err := cli.GetGenericObjFromList(dataMap, ii)
+			if err != nil {
+				log.DebugLog(log.DebugLevelApi, "bad dataMap for AlertSilence", "err", err)
+				*r.Rc = false
+				return
+			}
+			yamlData := cli.MapData{
+				Namespace: cli.YamlNamespace,
+				Data:      objMap,
+			}
+			obj.Fields = cli.GetSpecifiedFields(&yamlData, obj)
+
+			out, err := r.client.UpdateAlertSilence(r.ctx, obj)
+			if err != nil {
+				err = ignoreExpectedErrors(r.Mode, obj.GetKey(), err)
+				r.logErr(fmt.Sprintf("AlertSilenceApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.Result)
+				if !ok {
+					panic(fmt.Sprintf("RunAlertSilenceApi expected dataOut type *[]edgeproto.Result, but was %T", dataOut))
+				}
+				*outp = append(*outp, *out)
+			}
+		case "showfiltered":
+			out, err := r.client.ShowAlertSilence(r.ctx, obj)
+			if err != nil {
+				r.logErr(fmt.Sprintf("AlertSilenceApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.AlertSilence)
+				if !ok {
+					panic(fmt.Sprintf("RunAlertSilenceApi expected dataOut type *[]edgeproto.AlertSilence, but was %T", dataOut))
+				}
+				*outp = append(*outp, out...)
+			}
+		}
+	}
+}
+
+func (s *DummyServer) CreateAlertSilence(ctx context.Context, in *edgeproto.AlertSilence) (*edgeproto.Result, error) {
+	if s.CudNoop {
+		return &edgeproto.Result{}, nil
+	}
+	s.AlertSilenceCache.Update(ctx, in, 0)
+	return &edgeproto.Result{}, nil
+}
+
+func (s *DummyServer) DeleteAlertSilence(ctx context.Context, in *edgeproto.AlertSilence) (*edgeproto.Result, error) {
+	if s.CudNoop {
+		return &edgeproto.Result{}, nil
+	}
+	s.AlertSilenceCache.Delete(ctx, in, 0)
+	return &edgeproto.Result{}, nil
+}
+
+func (s *DummyServer) UpdateAlertSilence(ctx context.Context, in *edgeproto.AlertSilence) (*edgeproto.Result, error) {
+	if s.CudNoop {
+		return &edgeproto.Result{}, nil
+	}
+	s.AlertSilenceCache.Update(ctx, in, 0)
+	return &edgeproto.Result{}, nil
+}
+
+func (s *DummyServer) ShowAlertSilence(in *edgeproto.AlertSilence, server edgeproto.AlertSilenceApi_ShowAlertSilenceServer) error {
+	var err error
+	obj := &edgeproto.AlertSilence{}
+	if obj.Matches(in, edgeproto.MatchFilter()) {
+		for ii := 0; ii < s.ShowDummyCount; ii++ {
+			server.Send(&edgeproto.AlertSilence{})
+		}
+		if ch, ok := s.MidstreamFailChs["ShowAlertSilence"]; ok {
+			// Wait until client receives the SendMsg, since they
+			// are buffered and dropped once we return err here.
+			select {
+			case <-ch:
+			case <-time.After(5 * time.Second):
+			}
+			return fmt.Errorf("midstream failure!")
+		}
+	}
+	err = s.AlertSilenceCache.Show(in, func(obj *edgeproto.AlertSilence) error {
+		err := server.Send(obj)
+		return err
+	})
+	return err
+}
+
+func (s *ApiClient) CreateAlertSilence(ctx context.Context, in *edgeproto.AlertSilence) (*edgeproto.Result, error) {
+	api := edgeproto.NewAlertSilenceApiClient(s.Conn)
+	return api.CreateAlertSilence(ctx, in)
+}
+
+func (s *CliClient) CreateAlertSilence(ctx context.Context, in *edgeproto.AlertSilence) (*edgeproto.Result, error) {
+	out := edgeproto.Result{}
+	args := append(s.BaseArgs, "controller", "CreateAlertSilence")
+	err := wrapper.RunEdgectlObjs(args, in, &out, s.RunOps...)
+	return &out, err
+}
+
+func (s *ApiClient) DeleteAlertSilence(ctx context.Context, in *edgeproto.AlertSilence) (*edgeproto.Result, error) {
+	api := edgeproto.NewAlertSilenceApiClient(s.Conn)
+	return api.DeleteAlertSilence(ctx, in)
+}
+
+func (s *CliClient) DeleteAlertSilence(ctx context.Context, in *edgeproto.AlertSilence) (*edgeproto.Result, error) {
+	out := edgeproto.Result{}
+	args := append(s.BaseArgs, "controller", "DeleteAlertSilence")
+	err := wrapper.RunEdgectlObjs(args, in, &out, s.RunOps...)
+	return &out, err
+}
+
+func (s *ApiClient) UpdateAlertSilence(ctx context.Context, in *edgeproto.AlertSilence) (*edgeproto.Result, error) {
+	api := edgeproto.NewAlertSilenceApiClient(s.Conn)
+	return api.UpdateAlertSilence(ctx, in)
+}
+
+func (s *CliClient) UpdateAlertSilence(ctx context.Context, in *edgeproto.AlertSilence) (*edgeproto.Result, error) {
+	out := edgeproto.Result{}
+	args := append(s.BaseArgs, "controller", "UpdateAlertSilence")
+	err := wrapper.RunEdgectlObjs(args, in, &out, s.RunOps...)
+	return &out, err
+}
+
+type AlertSilenceStream interface {
+	Recv() (*edgeproto.AlertSilence, error)
+}
+
+func AlertSilenceReadStream(stream AlertSilenceStream) ([]edgeproto.AlertSilence, error) {
+	output := []edgeproto.AlertSilence{}
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return output, fmt.Errorf("read AlertSilence stream failed, %v", err)
+		}
+		output = append(output, *obj)
+	}
+	return output, nil
+}
+
+func (s *ApiClient) ShowAlertSilence(ctx context.Context, in *edgeproto.AlertSilence) ([]edgeproto.AlertSilence, error) {
+	api := edgeproto.NewAlertSilenceApiClient(s.Conn)
+	stream, err := api.ShowAlertSilence(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return AlertSilenceReadStream(stream)
+}
+
+func (s *CliClient) ShowAlertSilence(ctx context.Context, in *edgeproto.AlertSilence) ([]edgeproto.AlertSilence, error) {
+	output := []edgeproto.AlertSilence{}
+	args := append(s.BaseArgs, "controller", "ShowAlertSilence")
+	err := wrapper.RunEdgectlObjs(args, in, &output, s.RunOps...)
+	return output, err
+}
+
+type AlertSilenceApiClient interface {
+	CreateAlertSilence(ctx context.Context, in *edgeproto.AlertSilence) (*edgeproto.Result, error)
+	DeleteAlertSilence(ctx context.Context, in *edgeproto.AlertSilence) (*edgeproto.Result, error)
+	UpdateAlertSilence(ctx context.Context, in *edgeproto.AlertSilence) (*edgeproto.Result, error)
+	ShowAlertSilence(ctx context.Context, in *edgeproto.AlertSilence) ([]edgeproto.AlertSilence, error)
+}
@@ -38,6 +38,7 @@ type AllDataOut struct {
 	AutoScalePolicies          []edgeproto.Result
 	IdleReservableClusterInsts *edgeproto.Result
 	ClusterInsts               [][]edgeproto.Result
+	GeoFencePolicies           []edgeproto.Result
 	Apps                       []edgeproto.Result
 	AppInstances               [][]edgeproto.Result
 	VmPools                    []edgeproto.Result
@@ -89,6 +90,8 @@ func RunAllDataApis(run *Run, in *edgeproto.AllData, inMap map[string]interface{
 	apicb("idlereservableclusterinsts")
 	run.ClusterInstApi(&in.ClusterInsts, inMap["clusterinsts"], &out.ClusterInsts)
 	apicb("clusterinsts")
+	run.GeoFencePolicyApi(&in.GeoFencePolicies, inMap["geofencepolicies"], &out.GeoFencePolicies)
+	apicb("geofencepolicies")
 	run.AppApi(&in.Apps, inMap["apps"], &out.Apps)
 	apicb("apps")
 	run.AppInstApi(&in.AppInstances, inMap["appinstances"], &out.AppInstances)
@@ -121,6 +124,8 @@ func RunAllDataReverseApis(run *Run, in *edgeproto.AllData, inMap map[string]int
 	run.AppInstApi(&in.AppInstances, inMap["appinstances"], &out.AppInstances)
 	apicb("apps")
 	run.AppApi(&in.Apps, inMap["apps"], &out.Apps)
+	apicb("geofencepolicies")
+	run.GeoFencePolicyApi(&in.GeoFencePolicies, inMap["geofencepolicies"], &out.GeoFencePolicies)
 	apicb("clusterinsts")
 	run.ClusterInstApi(&in.ClusterInsts, inMap["clusterinsts"], &out.ClusterInsts)
 	apicb("idlereservableclusterinsts")
@@ -205,6 +210,9 @@ func RunAllDataShowApis(run *Run, in *edgeproto.AllData, selector edgeproto.AllS
 	if selector.Has("clusterinsts") {
 		run.ClusterInstApi(&in.ClusterInsts, nil, &out.ClusterInsts)
 	}
+	if selector.Has("geofencepolicies") {
+		run.GeoFencePolicyApi(&in.GeoFencePolicies, nil, &out.GeoFencePolicies)
+	}
 	if selector.Has("apps") {
 		run.AppApi(&in.Apps, nil, &out.Apps)
 	}
@@ -240,6 +248,7 @@ func DeleteAllAllDataInternal(t *testing.T, ctx context.Context, apis InternalCU
 	InternalVMPoolDeleteAll(t, ctx, apis.GetVMPoolApi(), in.VmPools)
 	InternalAppInstDeleteAll(t, ctx, apis.GetAppInstApi(), in.AppInstances)
 	InternalAppDeleteAll(t, ctx, apis.GetAppApi(), in.Apps)
+	InternalGeoFencePolicyDeleteAll(t, ctx, apis.GetGeoFencePolicyApi(), in.GeoFencePolicies)
 	InternalClusterInstDeleteAll(t, ctx, apis.GetClusterInstApi(), in.ClusterInsts)
 	InternalAutoScalePolicyDeleteAll(t, ctx, apis.GetAutoScalePolicyApi(), in.AutoScalePolicies)
 	InternalAutoProvPolicyDeleteAll(t, ctx, apis.GetAutoProvPolicyApi(), in.AutoProvPolicies)
@@ -50,6 +50,10 @@ func (s *DummyServer) HandleFedAppInstEvent(ctx context.Context, in *edgeproto.F
 	return &edgeproto.Result{}, nil
 }
 
+func (s *DummyServer) MoveAppInst(in *edgeproto.AppInstMove, cb edgeproto.AppInstApi_MoveAppInstServer) error {
+	return nil
+}
+
 // minimal bits not currently generated for cloudletkey.proto and app.proto
 // in support of CLI test for an rpc that streams cloudletkeys as its result
 type ShowZonesForAppDeployment struct {
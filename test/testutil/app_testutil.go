@@ -446,6 +446,17 @@ func (r *Run) AppApi(data *[]edgeproto.App, dataMap interface{}, dataOut interfa
 				}
 				*outp = append(*outp, out...)
 			}
+		case "showapphistory":
+			out, err := r.client.ShowAppHistory(r.ctx, obj)
+			if err != nil {
+				r.logErr(fmt.Sprintf("AppApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.AppHistory)
+				if !ok {
+					panic(fmt.Sprintf("RunAppApi expected dataOut type *[]edgeproto.AppHistory, but was %T", dataOut))
+				}
+				*outp = append(*outp, out...)
+			}
 		case "showpublicapp":
 			out, err := r.client.ShowPublicApp(r.ctx, obj)
 			if err != nil {
@@ -606,6 +617,25 @@ func (s *DummyServer) ShowApp(in *edgeproto.App, server edgeproto.AppApi_ShowApp
 	return err
 }
 
+func (s *DummyServer) ShowAppHistory(in *edgeproto.App, server edgeproto.AppApi_ShowAppHistoryServer) error {
+	var err error
+	if true {
+		for ii := 0; ii < s.ShowDummyCount; ii++ {
+			server.Send(&edgeproto.AppHistory{})
+		}
+		if ch, ok := s.MidstreamFailChs["ShowAppHistory"]; ok {
+			// Wait until client receives the SendMsg, since they
+			// are buffered and dropped once we return err here.
+			select {
+			case <-ch:
+			case <-time.After(5 * time.Second):
+			}
+			return fmt.Errorf("midstream failure!")
+		}
+	}
+	return err
+}
+
 func (s *DummyServer) ShowPublicApp(in *edgeproto.App, server edgeproto.AppApi_ShowPublicAppServer) error {
 	var err error
 	obj := &edgeproto.App{}
@@ -701,6 +731,41 @@ func (s *CliClient) ShowApp(ctx context.Context, in *edgeproto.App) ([]edgeproto
 	return output, err
 }
 
+type AppHistoryStream interface {
+	Recv() (*edgeproto.AppHistory, error)
+}
+
+func AppHistoryReadStream(stream AppHistoryStream) ([]edgeproto.AppHistory, error) {
+	output := []edgeproto.AppHistory{}
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return output, fmt.Errorf("read AppHistory stream failed, %v", err)
+		}
+		output = append(output, *obj)
+	}
+	return output, nil
+}
+
+func (s *ApiClient) ShowAppHistory(ctx context.Context, in *edgeproto.App) ([]edgeproto.AppHistory, error) {
+	api := edgeproto.NewAppApiClient(s.Conn)
+	stream, err := api.ShowAppHistory(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return AppHistoryReadStream(stream)
+}
+
+func (s *CliClient) ShowAppHistory(ctx context.Context, in *edgeproto.App) ([]edgeproto.AppHistory, error) {
+	output := []edgeproto.AppHistory{}
+	args := append(s.BaseArgs, "controller", "ShowAppHistory")
+	err := wrapper.RunEdgectlObjs(args, in, &output, s.RunOps...)
+	return output, err
+}
+
 func (s *ApiClient) AddAppAutoProvPolicy(ctx context.Context, in *edgeproto.AppAutoProvPolicy) (*edgeproto.Result, error) {
 	api := edgeproto.NewAppApiClient(s.Conn)
 	return api.AddAppAutoProvPolicy(ctx, in)
@@ -805,6 +870,7 @@ type AppApiClient interface {
 	DeleteApp(ctx context.Context, in *edgeproto.App) (*edgeproto.Result, error)
 	UpdateApp(ctx context.Context, in *edgeproto.App) (*edgeproto.Result, error)
 	ShowApp(ctx context.Context, in *edgeproto.App) ([]edgeproto.App, error)
+	ShowAppHistory(ctx context.Context, in *edgeproto.App) ([]edgeproto.AppHistory, error)
 	AddAppAutoProvPolicy(ctx context.Context, in *edgeproto.AppAutoProvPolicy) (*edgeproto.Result, error)
 	RemoveAppAutoProvPolicy(ctx context.Context, in *edgeproto.AppAutoProvPolicy) (*edgeproto.Result, error)
 	AddAppAlertPolicy(ctx context.Context, in *edgeproto.AppAlertPolicy) (*edgeproto.Result, error)
@@ -696,6 +696,27 @@ func (r *Run) AppInstApi(data *[]edgeproto.AppInst, dataMap interface{}, dataOut
 	}
 }
 
+func (r *Run) AppInstApi_AppInstMove(data *[]edgeproto.AppInstMove, dataMap interface{}, dataOut interface{}) {
+	log.DebugLog(log.DebugLevelApi, "API for AppInstMove", "mode", r.Mode)
+	for ii, objD := range *data {
+		obj := &objD
+		switch r.Mode {
+		case "moveappinst":
+			out, err := r.client.MoveAppInst(r.ctx, obj)
+			if err != nil {
+				err = ignoreExpectedErrors(r.Mode, obj.GetKey(), err)
+				r.logErr(fmt.Sprintf("AppInstApi_AppInstMove[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[][]edgeproto.Result)
+				if !ok {
+					panic(fmt.Sprintf("RunAppInstApi_AppInstMove expected dataOut type *[][]edgeproto.Result, but was %T", dataOut))
+				}
+				*outp = append(*outp, out)
+			}
+		}
+	}
+}
+
 func (r *Run) AppInstApi_FedAppInstEvent(data *[]edgeproto.FedAppInstEvent, dataMap interface{}, dataOut interface{}) {
 	log.DebugLog(log.DebugLevelApi, "API for FedAppInstEvent", "mode", r.Mode)
 	for ii, objD := range *data {
@@ -1032,6 +1053,22 @@ func (s *CliClient) ShowAppInst(ctx context.Context, in *edgeproto.AppInst) ([]e
 	return output, err
 }
 
+func (s *ApiClient) MoveAppInst(ctx context.Context, in *edgeproto.AppInstMove) ([]edgeproto.Result, error) {
+	api := edgeproto.NewAppInstApiClient(s.Conn)
+	stream, err := api.MoveAppInst(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return ResultReadStream(stream)
+}
+
+func (s *CliClient) MoveAppInst(ctx context.Context, in *edgeproto.AppInstMove) ([]edgeproto.Result, error) {
+	output := []edgeproto.Result{}
+	args := append(s.BaseArgs, "controller", "MoveAppInst")
+	err := wrapper.RunEdgectlObjs(args, in, &output, s.RunOps...)
+	return output, err
+}
+
 func (s *ApiClient) HandleFedAppInstEvent(ctx context.Context, in *edgeproto.FedAppInstEvent) (*edgeproto.Result, error) {
 	api := edgeproto.NewAppInstApiClient(s.Conn)
 	return api.HandleFedAppInstEvent(ctx, in)
@@ -1050,6 +1087,7 @@ type AppInstApiClient interface {
 	RefreshAppInst(ctx context.Context, in *edgeproto.AppInst) ([]edgeproto.Result, error)
 	UpdateAppInst(ctx context.Context, in *edgeproto.AppInst) ([]edgeproto.Result, error)
 	ShowAppInst(ctx context.Context, in *edgeproto.AppInst) ([]edgeproto.AppInst, error)
+	MoveAppInst(ctx context.Context, in *edgeproto.AppInstMove) ([]edgeproto.Result, error)
 	HandleFedAppInstEvent(ctx context.Context, in *edgeproto.FedAppInstEvent) (*edgeproto.Result, error)
 }
 
@@ -0,0 +1,286 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: approllout.proto
+
+package testutil
+
+import (
+	"context"
+	fmt "fmt"
+	_ "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/edgectl/wrapper"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	_ "github.com/edgexr/edge-cloud-platform/tools/protogen"
+	_ "github.com/gogo/googleapis/google/api"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	"io"
+	math "math"
+	"time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// Auto-generated code: DO NOT EDIT
+
+func (r *Run) AppRolloutApi(data *[]edgeproto.AppRollout, dataMap interface{}, dataOut interface{}) {
+	log.DebugLog(log.DebugLevelApi, "API for AppRollout", "mode", r.Mode)
+	if r.Mode == "show" {
+		obj := &edgeproto.AppRollout{}
+		out, err := r.client.ShowAppRollout(r.ctx, obj)
+		if err != nil {
+			r.logErr("AppRolloutApi", err)
+		} else {
+			outp, ok := dataOut.(*[]edgeproto.AppRollout)
+			if !ok {
+				panic(fmt.Sprintf("RunAppRolloutApi expected dataOut type *[]edgeproto.AppRollout, but was %T", dataOut))
+			}
+			*outp = append(*outp, out...)
+		}
+		return
+	}
+	for ii, objD := range *data {
+		obj := &objD
+		switch r.Mode {
+		case "create":
+			out, err := r.client.CreateAppRollout(r.ctx, obj)
+			if err != nil {
+				err = ignoreExpectedErrors(r.Mode, obj.GetKey(), err)
+				r.logErr(fmt.Sprintf("AppRolloutApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.Result)
+				if !ok {
+					panic(fmt.Sprintf("RunAppRolloutApi expected dataOut type *[]edgeproto.Result, but was %T", dataOut))
+				}
+				*outp = append(*outp, *out)
+			}
+		case "delete":
+			out, err := r.client.DeleteAppRollout(r.ctx, obj)
+			if err != nil {
+				err = ignoreExpectedErrors(r.Mode, obj.GetKey(), err)
+				r.logErr(fmt.Sprintf("AppRolloutApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.Result)
+				if !ok {
+					panic(fmt.Sprintf("RunAppRolloutApi expected dataOut type *[]edgeproto.Result, but was %T", dataOut))
+				}
+				*outp = append(*outp, *out)
+			}
+		case "pause":
+			out, err := r.client.PauseAppRollout(r.ctx, obj)
+			if err != nil {
+				err = ignoreExpectedErrors(r.Mode, obj.GetKey(), err)
+				r.logErr(fmt.Sprintf("AppRolloutApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.Result)
+				if !ok {
+					panic(fmt.Sprintf("RunAppRolloutApi expected dataOut type *[]edgeproto.Result, but was %T", dataOut))
+				}
+				*outp = append(*outp, *out)
+			}
+		case "resume":
+			out, err := r.client.ResumeAppRollout(r.ctx, obj)
+			if err != nil {
+				err = ignoreExpectedErrors(r.Mode, obj.GetKey(), err)
+				r.logErr(fmt.Sprintf("AppRolloutApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.Result)
+				if !ok {
+					panic(fmt.Sprintf("RunAppRolloutApi expected dataOut type *[]edgeproto.Result, but was %T", dataOut))
+				}
+				*outp = append(*outp, *out)
+			}
+		case "rollback":
+			out, err := r.client.RollbackAppRollout(r.ctx, obj)
+			if err != nil {
+				err = ignoreExpectedErrors(r.Mode, obj.GetKey(), err)
+				r.logErr(fmt.Sprintf("AppRolloutApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.Result)
+				if !ok {
+					panic(fmt.Sprintf("RunAppRolloutApi expected dataOut type *[]edgeproto.Result, but was %T", dataOut))
+				}
+				*outp = append(*outp, *out)
+			}
+		case "showfiltered":
+			out, err := r.client.ShowAppRollout(r.ctx, obj)
+			if err != nil {
+				r.logErr(fmt.Sprintf("AppRolloutApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.AppRollout)
+				if !ok {
+					panic(fmt.Sprintf("RunAppRolloutApi expected dataOut type *[]edgeproto.AppRollout, but was %T", dataOut))
+				}
+				*outp = append(*outp, out...)
+			}
+		}
+	}
+}
+
+func (s *DummyServer) CreateAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error) {
+	if s.CudNoop {
+		return &edgeproto.Result{}, nil
+	}
+	s.AppRolloutCache.Update(ctx, in, 0)
+	return &edgeproto.Result{}, nil
+}
+
+func (s *DummyServer) DeleteAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error) {
+	if s.CudNoop {
+		return &edgeproto.Result{}, nil
+	}
+	s.AppRolloutCache.Delete(ctx, in, 0)
+	return &edgeproto.Result{}, nil
+}
+
+func (s *DummyServer) PauseAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error) {
+	if s.CudNoop {
+		return &edgeproto.Result{}, nil
+	}
+	return &edgeproto.Result{}, nil
+}
+
+func (s *DummyServer) ResumeAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error) {
+	if s.CudNoop {
+		return &edgeproto.Result{}, nil
+	}
+	return &edgeproto.Result{}, nil
+}
+
+func (s *DummyServer) RollbackAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error) {
+	if s.CudNoop {
+		return &edgeproto.Result{}, nil
+	}
+	return &edgeproto.Result{}, nil
+}
+
+func (s *DummyServer) ShowAppRollout(in *edgeproto.AppRollout, server edgeproto.AppRolloutApi_ShowAppRolloutServer) error {
+	var err error
+	obj := &edgeproto.AppRollout{}
+	if obj.Matches(in, edgeproto.MatchFilter()) {
+		for ii := 0; ii < s.ShowDummyCount; ii++ {
+			server.Send(&edgeproto.AppRollout{})
+		}
+		if ch, ok := s.MidstreamFailChs["ShowAppRollout"]; ok {
+			// Wait until client receives the SendMsg, since they
+			// are buffered and dropped once we return err here.
+			select {
+			case <-ch:
+			case <-time.After(5 * time.Second):
+			}
+			return fmt.Errorf("midstream failure!")
+		}
+	}
+	err = s.AppRolloutCache.Show(in, func(obj *edgeproto.AppRollout) error {
+		err := server.Send(obj)
+		return err
+	})
+	return err
+}
+
+func (s *ApiClient) CreateAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error) {
+	api := edgeproto.NewAppRolloutApiClient(s.Conn)
+	return api.CreateAppRollout(ctx, in)
+}
+
+func (s *CliClient) CreateAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error) {
+	out := edgeproto.Result{}
+	args := append(s.BaseArgs, "controller", "CreateAppRollout")
+	err := wrapper.RunEdgectlObjs(args, in, &out, s.RunOps...)
+	return &out, err
+}
+
+func (s *ApiClient) DeleteAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error) {
+	api := edgeproto.NewAppRolloutApiClient(s.Conn)
+	return api.DeleteAppRollout(ctx, in)
+}
+
+func (s *CliClient) DeleteAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error) {
+	out := edgeproto.Result{}
+	args := append(s.BaseArgs, "controller", "DeleteAppRollout")
+	err := wrapper.RunEdgectlObjs(args, in, &out, s.RunOps...)
+	return &out, err
+}
+
+func (s *ApiClient) PauseAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error) {
+	api := edgeproto.NewAppRolloutApiClient(s.Conn)
+	return api.PauseAppRollout(ctx, in)
+}
+
+func (s *CliClient) PauseAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error) {
+	out := edgeproto.Result{}
+	args := append(s.BaseArgs, "controller", "PauseAppRollout")
+	err := wrapper.RunEdgectlObjs(args, in, &out, s.RunOps...)
+	return &out, err
+}
+
+func (s *ApiClient) ResumeAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error) {
+	api := edgeproto.NewAppRolloutApiClient(s.Conn)
+	return api.ResumeAppRollout(ctx, in)
+}
+
+func (s *CliClient) ResumeAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error) {
+	out := edgeproto.Result{}
+	args := append(s.BaseArgs, "controller", "ResumeAppRollout")
+	err := wrapper.RunEdgectlObjs(args, in, &out, s.RunOps...)
+	return &out, err
+}
+
+func (s *ApiClient) RollbackAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error) {
+	api := edgeproto.NewAppRolloutApiClient(s.Conn)
+	return api.RollbackAppRollout(ctx, in)
+}
+
+func (s *CliClient) RollbackAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error) {
+	out := edgeproto.Result{}
+	args := append(s.BaseArgs, "controller", "RollbackAppRollout")
+	err := wrapper.RunEdgectlObjs(args, in, &out, s.RunOps...)
+	return &out, err
+}
+
+type AppRolloutStream interface {
+	Recv() (*edgeproto.AppRollout, error)
+}
+
+func AppRolloutReadStream(stream AppRolloutStream) ([]edgeproto.AppRollout, error) {
+	output := []edgeproto.AppRollout{}
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return output, fmt.Errorf("read AppRollout stream failed, %v", err)
+		}
+		output = append(output, *obj)
+	}
+	return output, nil
+}
+
+func (s *ApiClient) ShowAppRollout(ctx context.Context, in *edgeproto.AppRollout) ([]edgeproto.AppRollout, error) {
+	api := edgeproto.NewAppRolloutApiClient(s.Conn)
+	stream, err := api.ShowAppRollout(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return AppRolloutReadStream(stream)
+}
+
+func (s *CliClient) ShowAppRollout(ctx context.Context, in *edgeproto.AppRollout) ([]edgeproto.AppRollout, error) {
+	output := []edgeproto.AppRollout{}
+	args := append(s.BaseArgs, "controller", "ShowAppRollout")
+	err := wrapper.RunEdgectlObjs(args, in, &output, s.RunOps...)
+	return output, err
+}
+
+type AppRolloutApiClient interface {
+	CreateAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error)
+	DeleteAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error)
+	PauseAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error)
+	ResumeAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error)
+	RollbackAppRollout(ctx context.Context, in *edgeproto.AppRollout) (*edgeproto.Result, error)
+	ShowAppRollout(ctx context.Context, in *edgeproto.AppRollout) ([]edgeproto.AppRollout, error)
+}
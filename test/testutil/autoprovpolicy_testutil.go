@@ -467,6 +467,17 @@ func (r *Run) AutoProvPolicyApi(data *[]edgeproto.AutoProvPolicy, dataMap interf
 				}
 				*outp = append(*outp, out...)
 			}
+		case "showautoprovpolicyhistory":
+			out, err := r.client.ShowAutoProvPolicyHistory(r.ctx, obj)
+			if err != nil {
+				r.logErr(fmt.Sprintf("AutoProvPolicyApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.AutoProvPolicyHistory)
+				if !ok {
+					panic(fmt.Sprintf("RunAutoProvPolicyApi expected dataOut type *[]edgeproto.AutoProvPolicyHistory, but was %T", dataOut))
+				}
+				*outp = append(*outp, out...)
+			}
 		}
 	}
 }
@@ -552,6 +563,25 @@ func (s *DummyServer) ShowAutoProvPolicy(in *edgeproto.AutoProvPolicy, server ed
 	return err
 }
 
+func (s *DummyServer) ShowAutoProvPolicyHistory(in *edgeproto.AutoProvPolicy, server edgeproto.AutoProvPolicyApi_ShowAutoProvPolicyHistoryServer) error {
+	var err error
+	if true {
+		for ii := 0; ii < s.ShowDummyCount; ii++ {
+			server.Send(&edgeproto.AutoProvPolicyHistory{})
+		}
+		if ch, ok := s.MidstreamFailChs["ShowAutoProvPolicyHistory"]; ok {
+			// Wait until client receives the SendMsg, since they
+			// are buffered and dropped once we return err here.
+			select {
+			case <-ch:
+			case <-time.After(5 * time.Second):
+			}
+			return fmt.Errorf("midstream failure!")
+		}
+	}
+	return err
+}
+
 func (s *ApiClient) CreateAutoProvPolicy(ctx context.Context, in *edgeproto.AutoProvPolicy) (*edgeproto.Result, error) {
 	api := edgeproto.NewAutoProvPolicyApiClient(s.Conn)
 	return api.CreateAutoProvPolicy(ctx, in)
@@ -623,6 +653,41 @@ func (s *CliClient) ShowAutoProvPolicy(ctx context.Context, in *edgeproto.AutoPr
 	return output, err
 }
 
+type AutoProvPolicyHistoryStream interface {
+	Recv() (*edgeproto.AutoProvPolicyHistory, error)
+}
+
+func AutoProvPolicyHistoryReadStream(stream AutoProvPolicyHistoryStream) ([]edgeproto.AutoProvPolicyHistory, error) {
+	output := []edgeproto.AutoProvPolicyHistory{}
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return output, fmt.Errorf("read AutoProvPolicyHistory stream failed, %v", err)
+		}
+		output = append(output, *obj)
+	}
+	return output, nil
+}
+
+func (s *ApiClient) ShowAutoProvPolicyHistory(ctx context.Context, in *edgeproto.AutoProvPolicy) ([]edgeproto.AutoProvPolicyHistory, error) {
+	api := edgeproto.NewAutoProvPolicyApiClient(s.Conn)
+	stream, err := api.ShowAutoProvPolicyHistory(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return AutoProvPolicyHistoryReadStream(stream)
+}
+
+func (s *CliClient) ShowAutoProvPolicyHistory(ctx context.Context, in *edgeproto.AutoProvPolicy) ([]edgeproto.AutoProvPolicyHistory, error) {
+	output := []edgeproto.AutoProvPolicyHistory{}
+	args := append(s.BaseArgs, "controller", "ShowAutoProvPolicyHistory")
+	err := wrapper.RunEdgectlObjs(args, in, &output, s.RunOps...)
+	return output, err
+}
+
 func (s *ApiClient) AddAutoProvPolicyZone(ctx context.Context, in *edgeproto.AutoProvPolicyZone) (*edgeproto.Result, error) {
 	api := edgeproto.NewAutoProvPolicyApiClient(s.Conn)
 	return api.AddAutoProvPolicyZone(ctx, in)
@@ -652,6 +717,7 @@ type AutoProvPolicyApiClient interface {
 	DeleteAutoProvPolicy(ctx context.Context, in *edgeproto.AutoProvPolicy) (*edgeproto.Result, error)
 	UpdateAutoProvPolicy(ctx context.Context, in *edgeproto.AutoProvPolicy) (*edgeproto.Result, error)
 	ShowAutoProvPolicy(ctx context.Context, in *edgeproto.AutoProvPolicy) ([]edgeproto.AutoProvPolicy, error)
+	ShowAutoProvPolicyHistory(ctx context.Context, in *edgeproto.AutoProvPolicy) ([]edgeproto.AutoProvPolicyHistory, error)
 	AddAutoProvPolicyZone(ctx context.Context, in *edgeproto.AutoProvPolicyZone) (*edgeproto.Result, error)
 	RemoveAutoProvPolicyZone(ctx context.Context, in *edgeproto.AutoProvPolicyZone) (*edgeproto.Result, error)
 }
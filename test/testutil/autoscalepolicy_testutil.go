@@ -465,6 +465,17 @@ func (r *Run) AutoScalePolicyApi(data *[]edgeproto.AutoScalePolicy, dataMap inte
 				}
 				*outp = append(*outp, out...)
 			}
+		case "showautoscalepolicyhistory":
+			out, err := r.client.ShowAutoScalePolicyHistory(r.ctx, obj)
+			if err != nil {
+				r.logErr(fmt.Sprintf("AutoScalePolicyApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.AutoScalePolicyHistory)
+				if !ok {
+					panic(fmt.Sprintf("RunAutoScalePolicyApi expected dataOut type *[]edgeproto.AutoScalePolicyHistory, but was %T", dataOut))
+				}
+				*outp = append(*outp, out...)
+			}
 		}
 	}
 }
@@ -517,6 +528,25 @@ func (s *DummyServer) ShowAutoScalePolicy(in *edgeproto.AutoScalePolicy, server
 	return err
 }
 
+func (s *DummyServer) ShowAutoScalePolicyHistory(in *edgeproto.AutoScalePolicy, server edgeproto.AutoScalePolicyApi_ShowAutoScalePolicyHistoryServer) error {
+	var err error
+	if true {
+		for ii := 0; ii < s.ShowDummyCount; ii++ {
+			server.Send(&edgeproto.AutoScalePolicyHistory{})
+		}
+		if ch, ok := s.MidstreamFailChs["ShowAutoScalePolicyHistory"]; ok {
+			// Wait until client receives the SendMsg, since they
+			// are buffered and dropped once we return err here.
+			select {
+			case <-ch:
+			case <-time.After(5 * time.Second):
+			}
+			return fmt.Errorf("midstream failure!")
+		}
+	}
+	return err
+}
+
 func (s *ApiClient) CreateAutoScalePolicy(ctx context.Context, in *edgeproto.AutoScalePolicy) (*edgeproto.Result, error) {
 	api := edgeproto.NewAutoScalePolicyApiClient(s.Conn)
 	return api.CreateAutoScalePolicy(ctx, in)
@@ -588,9 +618,45 @@ func (s *CliClient) ShowAutoScalePolicy(ctx context.Context, in *edgeproto.AutoS
 	return output, err
 }
 
+type AutoScalePolicyHistoryStream interface {
+	Recv() (*edgeproto.AutoScalePolicyHistory, error)
+}
+
+func AutoScalePolicyHistoryReadStream(stream AutoScalePolicyHistoryStream) ([]edgeproto.AutoScalePolicyHistory, error) {
+	output := []edgeproto.AutoScalePolicyHistory{}
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return output, fmt.Errorf("read AutoScalePolicyHistory stream failed, %v", err)
+		}
+		output = append(output, *obj)
+	}
+	return output, nil
+}
+
+func (s *ApiClient) ShowAutoScalePolicyHistory(ctx context.Context, in *edgeproto.AutoScalePolicy) ([]edgeproto.AutoScalePolicyHistory, error) {
+	api := edgeproto.NewAutoScalePolicyApiClient(s.Conn)
+	stream, err := api.ShowAutoScalePolicyHistory(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return AutoScalePolicyHistoryReadStream(stream)
+}
+
+func (s *CliClient) ShowAutoScalePolicyHistory(ctx context.Context, in *edgeproto.AutoScalePolicy) ([]edgeproto.AutoScalePolicyHistory, error) {
+	output := []edgeproto.AutoScalePolicyHistory{}
+	args := append(s.BaseArgs, "controller", "ShowAutoScalePolicyHistory")
+	err := wrapper.RunEdgectlObjs(args, in, &output, s.RunOps...)
+	return output, err
+}
+
 type AutoScalePolicyApiClient interface {
 	CreateAutoScalePolicy(ctx context.Context, in *edgeproto.AutoScalePolicy) (*edgeproto.Result, error)
 	DeleteAutoScalePolicy(ctx context.Context, in *edgeproto.AutoScalePolicy) (*edgeproto.Result, error)
 	UpdateAutoScalePolicy(ctx context.Context, in *edgeproto.AutoScalePolicy) (*edgeproto.Result, error)
 	ShowAutoScalePolicy(ctx context.Context, in *edgeproto.AutoScalePolicy) ([]edgeproto.AutoScalePolicy, error)
+	ShowAutoScalePolicyHistory(ctx context.Context, in *edgeproto.AutoScalePolicy) ([]edgeproto.AutoScalePolicyHistory, error)
 }
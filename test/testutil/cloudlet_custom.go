@@ -100,6 +100,10 @@ func (s *DummyServer) RefreshCerts(key *edgeproto.CloudletKey, inCb edgeproto.Cl
 	return nil
 }
 
+func (s *DummyServer) EvacuateCloudlet(in *edgeproto.CloudletEvacuate, inCb edgeproto.CloudletApi_EvacuateCloudletServer) error {
+	return nil
+}
+
 // minimal bits not currently generated for flavorkey.proto to stream flavorKey objs
 // for ShowFlavorsForZone cli
 type ShowFlavorsForZone struct {
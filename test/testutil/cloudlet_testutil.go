@@ -1448,6 +1448,27 @@ func (r *Run) CloudletApi_CloudletAllianceOrg(data *[]edgeproto.CloudletAlliance
 	}
 }
 
+func (r *Run) CloudletApi_CloudletEvacuate(data *[]edgeproto.CloudletEvacuate, dataMap interface{}, dataOut interface{}) {
+	log.DebugLog(log.DebugLevelApi, "API for CloudletEvacuate", "mode", r.Mode)
+	for ii, objD := range *data {
+		obj := &objD
+		switch r.Mode {
+		case "evacuatecloudlet":
+			out, err := r.client.EvacuateCloudlet(r.ctx, obj)
+			if err != nil {
+				err = ignoreExpectedErrors(r.Mode, obj.GetKey(), err)
+				r.logErr(fmt.Sprintf("CloudletApi_CloudletEvacuate[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[][]edgeproto.Result)
+				if !ok {
+					panic(fmt.Sprintf("RunCloudletApi_CloudletEvacuate expected dataOut type *[][]edgeproto.Result, but was %T", dataOut))
+				}
+				*outp = append(*outp, out)
+			}
+		}
+	}
+}
+
 func (r *Run) CloudletApi_CloudletKey(data *[]edgeproto.CloudletKey, dataMap interface{}, dataOut interface{}) {
 	log.DebugLog(log.DebugLevelApi, "API for CloudletKey", "mode", r.Mode)
 	for ii, objD := range *data {
@@ -2583,6 +2604,22 @@ func (s *CliClient) ChangeCloudletDNS(ctx context.Context, in *edgeproto.Cloudle
 	return output, err
 }
 
+func (s *ApiClient) EvacuateCloudlet(ctx context.Context, in *edgeproto.CloudletEvacuate) ([]edgeproto.Result, error) {
+	api := edgeproto.NewCloudletApiClient(s.Conn)
+	stream, err := api.EvacuateCloudlet(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return ResultReadStream(stream)
+}
+
+func (s *CliClient) EvacuateCloudlet(ctx context.Context, in *edgeproto.CloudletEvacuate) ([]edgeproto.Result, error) {
+	output := []edgeproto.Result{}
+	args := append(s.BaseArgs, "controller", "EvacuateCloudlet")
+	err := wrapper.RunEdgectlObjs(args, in, &output, s.RunOps...)
+	return output, err
+}
+
 func (s *ApiClient) RefreshCerts(ctx context.Context, in *edgeproto.CloudletKey) ([]edgeproto.Result, error) {
 	api := edgeproto.NewCloudletApiClient(s.Conn)
 	stream, err := api.RefreshCerts(ctx, in)
@@ -2621,6 +2658,7 @@ type CloudletApiClient interface {
 	GenerateAccessKey(ctx context.Context, in *edgeproto.CloudletKey) (*edgeproto.Result, error)
 	GetCloudletGPUDriverLicenseConfig(ctx context.Context, in *edgeproto.CloudletKey) (*edgeproto.Result, error)
 	ChangeCloudletDNS(ctx context.Context, in *edgeproto.CloudletKey) ([]edgeproto.Result, error)
+	EvacuateCloudlet(ctx context.Context, in *edgeproto.CloudletEvacuate) ([]edgeproto.Result, error)
 	RefreshCerts(ctx context.Context, in *edgeproto.CloudletKey) ([]edgeproto.Result, error)
 }
 
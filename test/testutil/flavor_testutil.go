@@ -445,6 +445,17 @@ func (r *Run) FlavorApi(data *[]edgeproto.Flavor, dataMap interface{}, dataOut i
 				}
 				*outp = append(*outp, out...)
 			}
+		case "showflavorhistory":
+			out, err := r.client.ShowFlavorHistory(r.ctx, obj)
+			if err != nil {
+				r.logErr(fmt.Sprintf("FlavorApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.FlavorHistory)
+				if !ok {
+					panic(fmt.Sprintf("RunFlavorApi expected dataOut type *[]edgeproto.FlavorHistory, but was %T", dataOut))
+				}
+				*outp = append(*outp, out...)
+			}
 		case "addflavorres":
 			out, err := r.client.AddFlavorRes(r.ctx, obj)
 			if err != nil {
@@ -521,6 +532,25 @@ func (s *DummyServer) ShowFlavor(in *edgeproto.Flavor, server edgeproto.FlavorAp
 	return err
 }
 
+func (s *DummyServer) ShowFlavorHistory(in *edgeproto.Flavor, server edgeproto.FlavorApi_ShowFlavorHistoryServer) error {
+	var err error
+	if true {
+		for ii := 0; ii < s.ShowDummyCount; ii++ {
+			server.Send(&edgeproto.FlavorHistory{})
+		}
+		if ch, ok := s.MidstreamFailChs["ShowFlavorHistory"]; ok {
+			// Wait until client receives the SendMsg, since they
+			// are buffered and dropped once we return err here.
+			select {
+			case <-ch:
+			case <-time.After(5 * time.Second):
+			}
+			return fmt.Errorf("midstream failure!")
+		}
+	}
+	return err
+}
+
 func (s *DummyServer) AddFlavorRes(ctx context.Context, in *edgeproto.Flavor) (*edgeproto.Result, error) {
 	if s.CudNoop {
 		return &edgeproto.Result{}, nil
@@ -606,6 +636,41 @@ func (s *CliClient) ShowFlavor(ctx context.Context, in *edgeproto.Flavor) ([]edg
 	return output, err
 }
 
+type FlavorHistoryStream interface {
+	Recv() (*edgeproto.FlavorHistory, error)
+}
+
+func FlavorHistoryReadStream(stream FlavorHistoryStream) ([]edgeproto.FlavorHistory, error) {
+	output := []edgeproto.FlavorHistory{}
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return output, fmt.Errorf("read FlavorHistory stream failed, %v", err)
+		}
+		output = append(output, *obj)
+	}
+	return output, nil
+}
+
+func (s *ApiClient) ShowFlavorHistory(ctx context.Context, in *edgeproto.Flavor) ([]edgeproto.FlavorHistory, error) {
+	api := edgeproto.NewFlavorApiClient(s.Conn)
+	stream, err := api.ShowFlavorHistory(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return FlavorHistoryReadStream(stream)
+}
+
+func (s *CliClient) ShowFlavorHistory(ctx context.Context, in *edgeproto.Flavor) ([]edgeproto.FlavorHistory, error) {
+	output := []edgeproto.FlavorHistory{}
+	args := append(s.BaseArgs, "controller", "ShowFlavorHistory")
+	err := wrapper.RunEdgectlObjs(args, in, &output, s.RunOps...)
+	return output, err
+}
+
 func (s *ApiClient) AddFlavorRes(ctx context.Context, in *edgeproto.Flavor) (*edgeproto.Result, error) {
 	api := edgeproto.NewFlavorApiClient(s.Conn)
 	return api.AddFlavorRes(ctx, in)
@@ -635,6 +700,7 @@ type FlavorApiClient interface {
 	DeleteFlavor(ctx context.Context, in *edgeproto.Flavor) (*edgeproto.Result, error)
 	UpdateFlavor(ctx context.Context, in *edgeproto.Flavor) (*edgeproto.Result, error)
 	ShowFlavor(ctx context.Context, in *edgeproto.Flavor) ([]edgeproto.Flavor, error)
+	ShowFlavorHistory(ctx context.Context, in *edgeproto.Flavor) ([]edgeproto.FlavorHistory, error)
 	AddFlavorRes(ctx context.Context, in *edgeproto.Flavor) (*edgeproto.Result, error)
 	RemoveFlavorRes(ctx context.Context, in *edgeproto.Flavor) (*edgeproto.Result, error)
 }
@@ -0,0 +1,643 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: geofencepolicy.proto
+
+package testutil
+
+import (
+	"context"
+	fmt "fmt"
+	_ "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cli"
+	"github.com/edgexr/edge-cloud-platform/pkg/edgectl/wrapper"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	_ "github.com/edgexr/edge-cloud-platform/tools/protogen"
+	_ "github.com/gogo/googleapis/google/api"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"io"
+	math "math"
+	"testing"
+	"time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// Auto-generated code: DO NOT EDIT
+
+type ShowGeoFencePolicy struct {
+	Data map[string]edgeproto.GeoFencePolicy
+	grpc.ServerStream
+	Ctx context.Context
+}
+
+func (x *ShowGeoFencePolicy) Init() {
+	x.Data = make(map[string]edgeproto.GeoFencePolicy)
+}
+
+func (x *ShowGeoFencePolicy) Send(m *edgeproto.GeoFencePolicy) error {
+	x.Data[m.GetKey().GetKeyString()] = *m
+	return nil
+}
+
+func (x *ShowGeoFencePolicy) Context() context.Context {
+	return x.Ctx
+}
+
+var GeoFencePolicyShowExtraCount = 0
+
+func (x *ShowGeoFencePolicy) ReadStream(stream edgeproto.GeoFencePolicyApi_ShowGeoFencePolicyClient, err error) {
+	x.Data = make(map[string]edgeproto.GeoFencePolicy)
+	if err != nil {
+		return
+	}
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			break
+		}
+		x.Data[obj.GetKey().GetKeyString()] = *obj
+	}
+}
+
+func (x *ShowGeoFencePolicy) CheckFound(obj *edgeproto.GeoFencePolicy) bool {
+	_, found := x.Data[obj.GetKey().GetKeyString()]
+	return found
+}
+
+func (x *ShowGeoFencePolicy) AssertFound(t *testing.T, obj *edgeproto.GeoFencePolicy) {
+	check, found := x.Data[obj.GetKey().GetKeyString()]
+	require.True(t, found, "find GeoFencePolicy %s", obj.GetKey().GetKeyString())
+	if found && !check.Matches(obj, edgeproto.MatchIgnoreBackend(), edgeproto.MatchSortArrayedKeys()) {
+		require.Equal(t, *obj, check, "GeoFencePolicy are equal")
+	}
+	if found {
+		// remove in case there are dups in the list, so the
+		// same object cannot be used again
+		delete(x.Data, obj.GetKey().GetKeyString())
+	}
+}
+
+func (x *ShowGeoFencePolicy) AssertNotFound(t *testing.T, obj *edgeproto.GeoFencePolicy) {
+	_, found := x.Data[obj.GetKey().GetKeyString()]
+	require.False(t, found, "do not find GeoFencePolicy %s", obj.GetKey().GetKeyString())
+}
+
+func WaitAssertFoundGeoFencePolicy(t *testing.T, api edgeproto.GeoFencePolicyApiClient, obj *edgeproto.GeoFencePolicy, count int, retry time.Duration) {
+	show := ShowGeoFencePolicy{}
+	for ii := 0; ii < count; ii++ {
+		ctx, cancel := context.WithTimeout(context.Background(), retry)
+		stream, err := api.ShowGeoFencePolicy(ctx, obj)
+		show.ReadStream(stream, err)
+		cancel()
+		if show.CheckFound(obj) {
+			break
+		}
+		time.Sleep(retry)
+	}
+	show.AssertFound(t, obj)
+}
+
+func WaitAssertNotFoundGeoFencePolicy(t *testing.T, api edgeproto.GeoFencePolicyApiClient, obj *edgeproto.GeoFencePolicy, count int, retry time.Duration) {
+	show := ShowGeoFencePolicy{}
+	filterNone := edgeproto.GeoFencePolicy{}
+	for ii := 0; ii < count; ii++ {
+		ctx, cancel := context.WithTimeout(context.Background(), retry)
+		stream, err := api.ShowGeoFencePolicy(ctx, &filterNone)
+		show.ReadStream(stream, err)
+		cancel()
+		if !show.CheckFound(obj) {
+			break
+		}
+		time.Sleep(retry)
+	}
+	show.AssertNotFound(t, obj)
+}
+
+// Wrap the api with a common interface
+type GeoFencePolicyCommonApi struct {
+	internal_api edgeproto.GeoFencePolicyApiServer
+	client_api   edgeproto.GeoFencePolicyApiClient
+}
+
+func (x *GeoFencePolicyCommonApi) CreateGeoFencePolicy(ctx context.Context, in *edgeproto.GeoFencePolicy) (*edgeproto.Result, error) {
+	copy := &edgeproto.GeoFencePolicy{}
+	*copy = *in
+	if x.internal_api != nil {
+		return x.internal_api.CreateGeoFencePolicy(ctx, copy)
+	} else {
+		res, err := x.client_api.CreateGeoFencePolicy(ctx, copy)
+		return res, unwrapGrpcError(err)
+	}
+}
+
+func (x *GeoFencePolicyCommonApi) DeleteGeoFencePolicy(ctx context.Context, in *edgeproto.GeoFencePolicy) (*edgeproto.Result, error) {
+	copy := &edgeproto.GeoFencePolicy{}
+	*copy = *in
+	if x.internal_api != nil {
+		return x.internal_api.DeleteGeoFencePolicy(ctx, copy)
+	} else {
+		res, err := x.client_api.DeleteGeoFencePolicy(ctx, copy)
+		return res, unwrapGrpcError(err)
+	}
+}
+
+func (x *GeoFencePolicyCommonApi) UpdateGeoFencePolicy(ctx context.Context, in *edgeproto.GeoFencePolicy) (*edgeproto.Result, error) {
+	copy := &edgeproto.GeoFencePolicy{}
+	*copy = *in
+	if x.internal_api != nil {
+		return x.internal_api.UpdateGeoFencePolicy(ctx, copy)
+	} else {
+		res, err := x.client_api.UpdateGeoFencePolicy(ctx, copy)
+		return res, unwrapGrpcError(err)
+	}
+}
+
+func (x *GeoFencePolicyCommonApi) ShowGeoFencePolicy(ctx context.Context, filter *edgeproto.GeoFencePolicy, showData *ShowGeoFencePolicy) error {
+	if x.internal_api != nil {
+		showData.Ctx = ctx
+		return x.internal_api.ShowGeoFencePolicy(filter, showData)
+	} else {
+		stream, err := x.client_api.ShowGeoFencePolicy(ctx, filter)
+		showData.ReadStream(stream, err)
+		return unwrapGrpcError(err)
+	}
+}
+
+func NewInternalGeoFencePolicyApi(api edgeproto.GeoFencePolicyApiServer) *GeoFencePolicyCommonApi {
+	apiWrap := GeoFencePolicyCommonApi{}
+	apiWrap.internal_api = api
+	return &apiWrap
+}
+
+func NewClientGeoFencePolicyApi(api edgeproto.GeoFencePolicyApiClient) *GeoFencePolicyCommonApi {
+	apiWrap := GeoFencePolicyCommonApi{}
+	apiWrap.client_api = api
+	return &apiWrap
+}
+
+type GeoFencePolicyTestOptions struct {
+	createdData []edgeproto.GeoFencePolicy
+}
+
+type GeoFencePolicyTestOp func(opts *GeoFencePolicyTestOptions)
+
+func WithCreatedGeoFencePolicyTestData(createdData []edgeproto.GeoFencePolicy) GeoFencePolicyTestOp {
+	return func(opts *GeoFencePolicyTestOptions) { opts.createdData = createdData }
+}
+
+func InternalGeoFencePolicyTest(t *testing.T, test string, api edgeproto.GeoFencePolicyApiServer, testData []edgeproto.GeoFencePolicy, ops ...GeoFencePolicyTestOp) {
+	span := log.StartSpan(log.DebugLevelApi, "InternalGeoFencePolicyTest")
+	defer span.Finish()
+	ctx := log.ContextWithSpan(context.Background(), span)
+
+	switch test {
+	case "cud":
+		basicGeoFencePolicyCudTest(t, ctx, NewInternalGeoFencePolicyApi(api), testData, ops...)
+	case "show":
+		basicGeoFencePolicyShowTest(t, ctx, NewInternalGeoFencePolicyApi(api), testData)
+	}
+}
+
+func ClientGeoFencePolicyTest(t *testing.T, test string, api edgeproto.GeoFencePolicyApiClient, testData []edgeproto.GeoFencePolicy, ops ...GeoFencePolicyTestOp) {
+	span := log.StartSpan(log.DebugLevelApi, "ClientGeoFencePolicyTest")
+	defer span.Finish()
+	ctx := log.ContextWithSpan(context.Background(), span)
+
+	switch test {
+	case "cud":
+		basicGeoFencePolicyCudTest(t, ctx, NewClientGeoFencePolicyApi(api), testData, ops...)
+	case "show":
+		basicGeoFencePolicyShowTest(t, ctx, NewClientGeoFencePolicyApi(api), testData)
+	}
+}
+
+func basicGeoFencePolicyShowTest(t *testing.T, ctx context.Context, api *GeoFencePolicyCommonApi, testData []edgeproto.GeoFencePolicy) {
+	var err error
+
+	show := ShowGeoFencePolicy{}
+	show.Init()
+	filterNone := edgeproto.GeoFencePolicy{}
+	err = api.ShowGeoFencePolicy(ctx, &filterNone, &show)
+	require.Nil(t, err, "show data")
+	require.Equal(t, len(testData)+GeoFencePolicyShowExtraCount, len(show.Data), "Show count")
+	for _, obj := range testData {
+		show.AssertFound(t, &obj)
+	}
+}
+
+func GetGeoFencePolicy(t *testing.T, ctx context.Context, api *GeoFencePolicyCommonApi, key *edgeproto.PolicyKey, out *edgeproto.GeoFencePolicy) bool {
+	var err error
+
+	show := ShowGeoFencePolicy{}
+	show.Init()
+	filter := edgeproto.GeoFencePolicy{}
+	filter.SetKey(key)
+	err = api.ShowGeoFencePolicy(ctx, &filter, &show)
+	require.Nil(t, err, "show data")
+	obj, found := show.Data[key.GetKeyString()]
+	if found {
+		*out = obj
+	}
+	return found
+}
+
+func basicGeoFencePolicyCudTest(t *testing.T, ctx context.Context, api *GeoFencePolicyCommonApi, testData []edgeproto.GeoFencePolicy, ops ...GeoFencePolicyTestOp) {
+	var err error
+
+	if len(testData) < 3 {
+		require.True(t, false, "Need at least 3 test data objects")
+		return
+	}
+	options := GeoFencePolicyTestOptions{}
+	for _, op := range ops {
+		op(&options)
+	}
+	createdData := testData
+	if options.createdData != nil {
+		createdData = options.createdData
+	}
+
+	// test create
+	CreateGeoFencePolicyData(t, ctx, api, testData)
+
+	// test duplicate Create - should fail
+	_, err = api.CreateGeoFencePolicy(ctx, &testData[0])
+	require.NotNil(t, err, "Create duplicate GeoFencePolicy")
+
+	// test show all items
+	basicGeoFencePolicyShowTest(t, ctx, api, createdData)
+
+	// test Delete
+	_, err = api.DeleteGeoFencePolicy(ctx, &createdData[0])
+	require.Nil(t, err, "Delete GeoFencePolicy %s", testData[0].GetKey().GetKeyString())
+	show := ShowGeoFencePolicy{}
+	show.Init()
+	filterNone := edgeproto.GeoFencePolicy{}
+	err = api.ShowGeoFencePolicy(ctx, &filterNone, &show)
+	require.Nil(t, err, "show data")
+	require.Equal(t, len(createdData)-1+GeoFencePolicyShowExtraCount, len(show.Data), "Show count")
+	show.AssertNotFound(t, &createdData[0])
+	// test update of missing object
+	_, err = api.UpdateGeoFencePolicy(ctx, &createdData[0])
+	require.NotNil(t, err, "Update missing object")
+	// Create it back
+	_, err = api.CreateGeoFencePolicy(ctx, &testData[0])
+	require.Nil(t, err, "Create GeoFencePolicy %s", testData[0].GetKey().GetKeyString())
+
+	// test invalid keys
+	bad := edgeproto.GeoFencePolicy{}
+	_, err = api.CreateGeoFencePolicy(ctx, &bad)
+	require.NotNil(t, err, "Create GeoFencePolicy with no key info")
+
+}
+
+func InternalGeoFencePolicyCreate(t *testing.T, api edgeproto.GeoFencePolicyApiServer, testData []edgeproto.GeoFencePolicy) {
+	span := log.StartSpan(log.DebugLevelApi, "InternalGeoFencePolicyCreate")
+	defer span.Finish()
+	ctx := log.ContextWithSpan(context.Background(), span)
+
+	CreateGeoFencePolicyData(t, ctx, NewInternalGeoFencePolicyApi(api), testData)
+}
+
+func ClientGeoFencePolicyCreate(t *testing.T, api edgeproto.GeoFencePolicyApiClient, testData []edgeproto.GeoFencePolicy) {
+	span := log.StartSpan(log.DebugLevelApi, "ClientGeoFencePolicyCreate")
+	defer span.Finish()
+	ctx := log.ContextWithSpan(context.Background(), span)
+
+	CreateGeoFencePolicyData(t, ctx, NewClientGeoFencePolicyApi(api), testData)
+}
+
+func CreateGeoFencePolicyData(t *testing.T, ctx context.Context, api *GeoFencePolicyCommonApi, testData []edgeproto.GeoFencePolicy) {
+	var err error
+
+	for ii := range testData {
+		obj := testData[ii]
+		_, err = api.CreateGeoFencePolicy(ctx, &obj)
+		require.Nil(t, err, "Create GeoFencePolicy %s", obj.GetKey().GetKeyString())
+	}
+}
+
+func InternalGeoFencePolicyDelete(t *testing.T, api edgeproto.GeoFencePolicyApiServer, testData []edgeproto.GeoFencePolicy) {
+	span := log.StartSpan(log.DebugLevelApi, "InternalGeoFencePolicyDelete")
+	defer span.Finish()
+	ctx := log.ContextWithSpan(context.Background(), span)
+
+	DeleteGeoFencePolicyData(t, ctx, NewInternalGeoFencePolicyApi(api), testData)
+}
+
+func InternalGeoFencePolicyDeleteAll(t *testing.T, ctx context.Context, api edgeproto.GeoFencePolicyApiServer, data []edgeproto.GeoFencePolicy) {
+	intapi := NewInternalGeoFencePolicyApi(api)
+	log.SpanLog(ctx, log.DebugLevelInfo, "deleting all GeoFencePolicys", "count", len(data))
+	DeleteGeoFencePolicyData(t, ctx, intapi, data)
+}
+
+func ClientGeoFencePolicyDelete(t *testing.T, api edgeproto.GeoFencePolicyApiClient, testData []edgeproto.GeoFencePolicy) {
+	span := log.StartSpan(log.DebugLevelApi, "ClientGeoFencePolicyDelete")
+	defer span.Finish()
+	ctx := log.ContextWithSpan(context.Background(), span)
+
+	DeleteGeoFencePolicyData(t, ctx, NewClientGeoFencePolicyApi(api), testData)
+}
+
+func DeleteGeoFencePolicyData(t *testing.T, ctx context.Context, api *GeoFencePolicyCommonApi, testData []edgeproto.GeoFencePolicy) {
+	var err error
+
+	for ii := range testData {
+		obj := testData[ii]
+		_, err = api.DeleteGeoFencePolicy(ctx, &obj)
+		require.Nil(t, err, "Delete GeoFencePolicy %s", obj.GetKey().GetKeyString())
+	}
+}
+
+func FindGeoFencePolicyData(key *edgeproto.PolicyKey, testData []edgeproto.GeoFencePolicy) (*edgeproto.GeoFencePolicy, bool) {
+	for ii, _ := range testData {
+		if testData[ii].GetKey().Matches(key) {
+			return &testData[ii], true
+		}
+	}
+	return nil, false
+}
+
+func (r *Run) GeoFencePolicyApi(data *[]edgeproto.GeoFencePolicy, dataMap interface{}, dataOut interface{}) {
+	log.DebugLog(log.DebugLevelApi, "API for GeoFencePolicy", "mode", r.Mode)
+	if r.Mode == "show" {
+		obj := &edgeproto.GeoFencePolicy{}
+		out, err := r.client.ShowGeoFencePolicy(r.ctx, obj)
+		if err != nil {
+			r.logErr("GeoFencePolicyApi", err)
+		} else {
+			outp, ok := dataOut.(*[]edgeproto.GeoFencePolicy)
+			if !ok {
+				panic(fmt.Sprintf("RunGeoFencePolicyApi expected dataOut type *[]edgeproto.GeoFencePolicy, but was %T", dataOut))
+			}
+			*outp = append(*outp, out...)
+		}
+		return
+	}
+	for ii, objD := range *data {
+		obj := &objD
+		switch r.Mode {
+		case "create":
+			out, err := r.client.CreateGeoFencePolicy(r.ctx, obj)
+			if err != nil {
+				err = ignoreExpectedErrors(r.Mode, obj.GetKey(), err)
+				r.logErr(fmt.Sprintf("GeoFencePolicyApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.Result)
+				if !ok {
+					panic(fmt.Sprintf("RunGeoFencePolicyApi expected dataOut type *[]edgeproto.Result, but was %T", dataOut))
+				}
+				*outp = append(*outp, *out)
+			}
+		case "delete":
+			out, err := r.client.DeleteGeoFencePolicy(r.ctx, obj)
+			if err != nil {
+				err = ignoreExpectedErrors(r.Mode, obj.GetKey(), err)
+				r.logErr(fmt.Sprintf("GeoFencePolicyApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.Result)
+				if !ok {
+					panic(fmt.Sprintf("RunGeoFencePolicyApi expected dataOut type *[]edgeproto.Result, but was %T", dataOut))
+				}
+				*outp = append(*outp, *out)
+			}
+		case "update":
+			// set specified fields
+			objMap, err := cli.GetGenericObjFromList(dataMap, ii)
+			if err != nil {
+				log.DebugLog(log.DebugLevelApi, "bad dataMap for GeoFencePolicy", "err", err)
+				*r.Rc = false
+				return
+			}
+			yamlData := cli.MapData{
+				Namespace: cli.YamlNamespace,
+				Data:      objMap,
+			}
+			obj.Fields = cli.GetSpecifiedFields(&yamlData, obj)
+
+			out, err := r.client.UpdateGeoFencePolicy(r.ctx, obj)
+			if err != nil {
+				err = ignoreExpectedErrors(r.Mode, obj.GetKey(), err)
+				r.logErr(fmt.Sprintf("GeoFencePolicyApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.Result)
+				if !ok {
+					panic(fmt.Sprintf("RunGeoFencePolicyApi expected dataOut type *[]edgeproto.Result, but was %T", dataOut))
+				}
+				*outp = append(*outp, *out)
+			}
+		case "showfiltered":
+			out, err := r.client.ShowGeoFencePolicy(r.ctx, obj)
+			if err != nil {
+				r.logErr(fmt.Sprintf("GeoFencePolicyApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.GeoFencePolicy)
+				if !ok {
+					panic(fmt.Sprintf("RunGeoFencePolicyApi expected dataOut type *[]edgeproto.GeoFencePolicy, but was %T", dataOut))
+				}
+				*outp = append(*outp, out...)
+			}
+		case "showgeofencepolicyhistory":
+			out, err := r.client.ShowGeoFencePolicyHistory(r.ctx, obj)
+			if err != nil {
+				r.logErr(fmt.Sprintf("GeoFencePolicyApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.GeoFencePolicyHistory)
+				if !ok {
+					panic(fmt.Sprintf("RunGeoFencePolicyApi expected dataOut type *[]edgeproto.GeoFencePolicyHistory, but was %T", dataOut))
+				}
+				*outp = append(*outp, out...)
+			}
+		}
+	}
+}
+
+func (s *DummyServer) CreateGeoFencePolicy(ctx context.Context, in *edgeproto.GeoFencePolicy) (*edgeproto.Result, error) {
+	if s.CudNoop {
+		return &edgeproto.Result{}, nil
+	}
+	s.GeoFencePolicyCache.Update(ctx, in, 0)
+	return &edgeproto.Result{}, nil
+}
+
+func (s *DummyServer) DeleteGeoFencePolicy(ctx context.Context, in *edgeproto.GeoFencePolicy) (*edgeproto.Result, error) {
+	if s.CudNoop {
+		return &edgeproto.Result{}, nil
+	}
+	s.GeoFencePolicyCache.Delete(ctx, in, 0)
+	return &edgeproto.Result{}, nil
+}
+
+func (s *DummyServer) UpdateGeoFencePolicy(ctx context.Context, in *edgeproto.GeoFencePolicy) (*edgeproto.Result, error) {
+	if s.CudNoop {
+		return &edgeproto.Result{}, nil
+	}
+	s.GeoFencePolicyCache.Update(ctx, in, 0)
+	return &edgeproto.Result{}, nil
+}
+
+func (s *DummyServer) ShowGeoFencePolicy(in *edgeproto.GeoFencePolicy, server edgeproto.GeoFencePolicyApi_ShowGeoFencePolicyServer) error {
+	var err error
+	obj := &edgeproto.GeoFencePolicy{}
+	if obj.Matches(in, edgeproto.MatchFilter()) {
+		for ii := 0; ii < s.ShowDummyCount; ii++ {
+			server.Send(&edgeproto.GeoFencePolicy{})
+		}
+		if ch, ok := s.MidstreamFailChs["ShowGeoFencePolicy"]; ok {
+			// Wait until client receives the SendMsg, since they
+			// are buffered and dropped once we return err here.
+			select {
+			case <-ch:
+			case <-time.After(5 * time.Second):
+			}
+			return fmt.Errorf("midstream failure!")
+		}
+	}
+	err = s.GeoFencePolicyCache.Show(in, func(obj *edgeproto.GeoFencePolicy) error {
+		err := server.Send(obj)
+		return err
+	})
+	return err
+}
+
+func (s *DummyServer) ShowGeoFencePolicyHistory(in *edgeproto.GeoFencePolicy, server edgeproto.GeoFencePolicyApi_ShowGeoFencePolicyHistoryServer) error {
+	var err error
+	if true {
+		for ii := 0; ii < s.ShowDummyCount; ii++ {
+			server.Send(&edgeproto.GeoFencePolicyHistory{})
+		}
+		if ch, ok := s.MidstreamFailChs["ShowGeoFencePolicyHistory"]; ok {
+			// Wait until client receives the SendMsg, since they
+			// are buffered and dropped once we return err here.
+			select {
+			case <-ch:
+			case <-time.After(5 * time.Second):
+			}
+			return fmt.Errorf("midstream failure!")
+		}
+	}
+	return err
+}
+
+func (s *ApiClient) CreateGeoFencePolicy(ctx context.Context, in *edgeproto.GeoFencePolicy) (*edgeproto.Result, error) {
+	api := edgeproto.NewGeoFencePolicyApiClient(s.Conn)
+	return api.CreateGeoFencePolicy(ctx, in)
+}
+
+func (s *CliClient) CreateGeoFencePolicy(ctx context.Context, in *edgeproto.GeoFencePolicy) (*edgeproto.Result, error) {
+	out := edgeproto.Result{}
+	args := append(s.BaseArgs, "controller", "CreateGeoFencePolicy")
+	err := wrapper.RunEdgectlObjs(args, in, &out, s.RunOps...)
+	return &out, err
+}
+
+func (s *ApiClient) DeleteGeoFencePolicy(ctx context.Context, in *edgeproto.GeoFencePolicy) (*edgeproto.Result, error) {
+	api := edgeproto.NewGeoFencePolicyApiClient(s.Conn)
+	return api.DeleteGeoFencePolicy(ctx, in)
+}
+
+func (s *CliClient) DeleteGeoFencePolicy(ctx context.Context, in *edgeproto.GeoFencePolicy) (*edgeproto.Result, error) {
+	out := edgeproto.Result{}
+	args := append(s.BaseArgs, "controller", "DeleteGeoFencePolicy")
+	err := wrapper.RunEdgectlObjs(args, in, &out, s.RunOps...)
+	return &out, err
+}
+
+func (s *ApiClient) UpdateGeoFencePolicy(ctx context.Context, in *edgeproto.GeoFencePolicy) (*edgeproto.Result, error) {
+	api := edgeproto.NewGeoFencePolicyApiClient(s.Conn)
+	return api.UpdateGeoFencePolicy(ctx, in)
+}
+
+func (s *CliClient) UpdateGeoFencePolicy(ctx context.Context, in *edgeproto.GeoFencePolicy) (*edgeproto.Result, error) {
+	out := edgeproto.Result{}
+	args := append(s.BaseArgs, "controller", "UpdateGeoFencePolicy")
+	err := wrapper.RunEdgectlObjs(args, in, &out, s.RunOps...)
+	return &out, err
+}
+
+type GeoFencePolicyStream interface {
+	Recv() (*edgeproto.GeoFencePolicy, error)
+}
+
+func GeoFencePolicyReadStream(stream GeoFencePolicyStream) ([]edgeproto.GeoFencePolicy, error) {
+	output := []edgeproto.GeoFencePolicy{}
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return output, fmt.Errorf("read GeoFencePolicy stream failed, %v", err)
+		}
+		output = append(output, *obj)
+	}
+	return output, nil
+}
+
+func (s *ApiClient) ShowGeoFencePolicy(ctx context.Context, in *edgeproto.GeoFencePolicy) ([]edgeproto.GeoFencePolicy, error) {
+	api := edgeproto.NewGeoFencePolicyApiClient(s.Conn)
+	stream, err := api.ShowGeoFencePolicy(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return GeoFencePolicyReadStream(stream)
+}
+
+func (s *CliClient) ShowGeoFencePolicy(ctx context.Context, in *edgeproto.GeoFencePolicy) ([]edgeproto.GeoFencePolicy, error) {
+	output := []edgeproto.GeoFencePolicy{}
+	args := append(s.BaseArgs, "controller", "ShowGeoFencePolicy")
+	err := wrapper.RunEdgectlObjs(args, in, &output, s.RunOps...)
+	return output, err
+}
+
+type GeoFencePolicyHistoryStream interface {
+	Recv() (*edgeproto.GeoFencePolicyHistory, error)
+}
+
+func GeoFencePolicyHistoryReadStream(stream GeoFencePolicyHistoryStream) ([]edgeproto.GeoFencePolicyHistory, error) {
+	output := []edgeproto.GeoFencePolicyHistory{}
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return output, fmt.Errorf("read GeoFencePolicyHistory stream failed, %v", err)
+		}
+		output = append(output, *obj)
+	}
+	return output, nil
+}
+
+func (s *ApiClient) ShowGeoFencePolicyHistory(ctx context.Context, in *edgeproto.GeoFencePolicy) ([]edgeproto.GeoFencePolicyHistory, error) {
+	api := edgeproto.NewGeoFencePolicyApiClient(s.Conn)
+	stream, err := api.ShowGeoFencePolicyHistory(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return GeoFencePolicyHistoryReadStream(stream)
+}
+
+func (s *CliClient) ShowGeoFencePolicyHistory(ctx context.Context, in *edgeproto.GeoFencePolicy) ([]edgeproto.GeoFencePolicyHistory, error) {
+	output := []edgeproto.GeoFencePolicyHistory{}
+	args := append(s.BaseArgs, "controller", "ShowGeoFencePolicyHistory")
+	err := wrapper.RunEdgectlObjs(args, in, &output, s.RunOps...)
+	return output, err
+}
+
+type GeoFencePolicyApiClient interface {
+	CreateGeoFencePolicy(ctx context.Context, in *edgeproto.GeoFencePolicy) (*edgeproto.Result, error)
+	DeleteGeoFencePolicy(ctx context.Context, in *edgeproto.GeoFencePolicy) (*edgeproto.Result, error)
+	UpdateGeoFencePolicy(ctx context.Context, in *edgeproto.GeoFencePolicy) (*edgeproto.Result, error)
+	ShowGeoFencePolicy(ctx context.Context, in *edgeproto.GeoFencePolicy) ([]edgeproto.GeoFencePolicy, error)
+	ShowGeoFencePolicyHistory(ctx context.Context, in *edgeproto.GeoFencePolicy) ([]edgeproto.GeoFencePolicyHistory, error)
+}
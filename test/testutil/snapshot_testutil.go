@@ -0,0 +1,92 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: snapshot.proto
+
+package testutil
+
+import (
+	"context"
+	fmt "fmt"
+	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/edgectl/wrapper"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	_ "github.com/gogo/googleapis/google/api"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// Auto-generated code: DO NOT EDIT
+
+func (r *Run) DataSnapshotApi(data *[]edgeproto.DataSnapshot, dataMap interface{}, dataOut interface{}) {
+	log.DebugLog(log.DebugLevelApi, "API for DataSnapshot", "mode", r.Mode)
+	for ii, objD := range *data {
+		obj := &objD
+		switch r.Mode {
+		case "restoredata":
+			out, err := r.client.RestoreData(r.ctx, obj)
+			if err != nil {
+				r.logErr(fmt.Sprintf("DataSnapshotApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.Result)
+				if !ok {
+					panic(fmt.Sprintf("RunDataSnapshotApi expected dataOut type *[]edgeproto.Result, but was %T", dataOut))
+				}
+				*outp = append(*outp, *out)
+			}
+		}
+	}
+}
+
+func (r *Run) DataSnapshotApi_DataSnapshotRequest(data *[]edgeproto.DataSnapshotRequest, dataMap interface{}, dataOut interface{}) {
+	log.DebugLog(log.DebugLevelApi, "API for DataSnapshotRequest", "mode", r.Mode)
+	for ii, objD := range *data {
+		obj := &objD
+		switch r.Mode {
+		case "exportdata":
+			out, err := r.client.ExportData(r.ctx, obj)
+			if err != nil {
+				r.logErr(fmt.Sprintf("DataSnapshotApi_DataSnapshotRequest[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.DataSnapshot)
+				if !ok {
+					panic(fmt.Sprintf("RunDataSnapshotApi_DataSnapshotRequest expected dataOut type *[]edgeproto.DataSnapshot, but was %T", dataOut))
+				}
+				*outp = append(*outp, *out)
+			}
+		}
+	}
+}
+
+func (s *ApiClient) ExportData(ctx context.Context, in *edgeproto.DataSnapshotRequest) (*edgeproto.DataSnapshot, error) {
+	api := edgeproto.NewDataSnapshotApiClient(s.Conn)
+	return api.ExportData(ctx, in)
+}
+
+func (s *CliClient) ExportData(ctx context.Context, in *edgeproto.DataSnapshotRequest) (*edgeproto.DataSnapshot, error) {
+	out := edgeproto.DataSnapshot{}
+	args := append(s.BaseArgs, "controller", "ExportData")
+	err := wrapper.RunEdgectlObjs(args, in, &out, s.RunOps...)
+	return &out, err
+}
+
+func (s *ApiClient) RestoreData(ctx context.Context, in *edgeproto.DataSnapshot) (*edgeproto.Result, error) {
+	api := edgeproto.NewDataSnapshotApiClient(s.Conn)
+	return api.RestoreData(ctx, in)
+}
+
+func (s *CliClient) RestoreData(ctx context.Context, in *edgeproto.DataSnapshot) (*edgeproto.Result, error) {
+	out := edgeproto.Result{}
+	args := append(s.BaseArgs, "controller", "RestoreData")
+	err := wrapper.RunEdgectlObjs(args, in, &out, s.RunOps...)
+	return &out, err
+}
+
+type DataSnapshotApiClient interface {
+	ExportData(ctx context.Context, in *edgeproto.DataSnapshotRequest) (*edgeproto.DataSnapshot, error)
+	RestoreData(ctx context.Context, in *edgeproto.DataSnapshot) (*edgeproto.Result, error)
+}
@@ -1918,6 +1918,135 @@ func AutoProvPolicyData() []edgeproto.AutoProvPolicy {
 	}}
 }
 
+func GeoFencePolicyData() []edgeproto.GeoFencePolicy {
+	devData := DevData()
+	return []edgeproto.GeoFencePolicy{{
+		Key: edgeproto.PolicyKey{
+			Name:         "geo-fence-policy",
+			Organization: devData[0],
+		},
+		Fences: []edgeproto.GeoFence{{
+			Name:         "eu",
+			CountryCodes: []string{"DE", "FR", "IT", "ES", "NL"},
+		}},
+	}, { // edgeproto.GeoFencePolicy
+		Key: edgeproto.PolicyKey{
+			Name:         "geo-fence-policy",
+			Organization: devData[1],
+		},
+		Fences: []edgeproto.GeoFence{{
+			Name: "bay-area",
+			Polygon: []dme.Loc{{
+				Latitude:  38.2,
+				Longitude: -123.2,
+			}, {
+				Latitude:  38.2,
+				Longitude: -121.5,
+			}, {
+				Latitude:  36.9,
+				Longitude: -121.5,
+			}, {
+				Latitude:  36.9,
+				Longitude: -123.2,
+			}},
+		}},
+	}, { // edgeproto.GeoFencePolicy
+		Key: edgeproto.PolicyKey{
+			Name:         "geo-fence-policy2",
+			Organization: devData[0],
+		},
+		Fences: []edgeproto.GeoFence{{
+			Name:         "us",
+			CountryCodes: []string{"US"},
+		}, {
+			Name:         "ca",
+			CountryCodes: []string{"CA"},
+		}},
+	}}
+}
+
+func AlertReceiverData() []edgeproto.AlertReceiver {
+	devData := DevData()
+	operatorData := OperatorData()
+	return []edgeproto.AlertReceiver{{
+		Key: edgeproto.AlertReceiverKey{
+			Name:         "dev-webhook",
+			Organization: devData[0],
+		},
+		Type:     edgeproto.AlertReceiverType_ALERT_RECEIVER_TYPE_WEBHOOK,
+		Severity: "warning",
+		Url:      "https://hooks.example.com/alerts",
+	}, { // edgeproto.AlertReceiver
+		Key: edgeproto.AlertReceiverKey{
+			Name:         "oper-email",
+			Organization: operatorData[0],
+		},
+		Type:  edgeproto.AlertReceiverType_ALERT_RECEIVER_TYPE_EMAIL,
+		Email: "noc@ufgt.example.com",
+		Labels: map[string]string{
+			"alertname": "CloudletDown",
+		},
+	}, { // edgeproto.AlertReceiver
+		Key: edgeproto.AlertReceiverKey{
+			Name:         "admin-pagerduty",
+			Organization: edgeproto.OrganizationEdgeCloud,
+		},
+		Type:                    edgeproto.AlertReceiverType_ALERT_RECEIVER_TYPE_PAGER_DUTY,
+		Severity:                "error",
+		PagerDutyIntegrationKey: "0123456789abcdef0123456789abcdef",
+	}}
+}
+
+func AlertSilenceData() []edgeproto.AlertSilence {
+	devData := DevData()
+	operatorData := OperatorData()
+	return []edgeproto.AlertSilence{{
+		Key: edgeproto.AlertSilenceKey{
+			Name:         "dev-upgrade",
+			Organization: devData[0],
+		},
+		Matchers: []edgeproto.AlertMatcher{{
+			Name:  "alertname",
+			Value: "AppInstDown",
+		}, {
+			Name:  "appinst",
+			Value: "upgrade-.*",
+			Regex: true,
+		}},
+		StartTime: dme.Timestamp{Seconds: 1767225600},
+		EndTime:   dme.Timestamp{Seconds: 1767232800},
+		CreatedBy: "devuser",
+		Comment:   "planned upgrade",
+	}, { // edgeproto.AlertSilence
+		Key: edgeproto.AlertSilenceKey{
+			Name:         "oper-utilization",
+			Organization: operatorData[0],
+		},
+		Matchers: []edgeproto.AlertMatcher{{
+			Name:  "alertname",
+			Value: "CloudletResourceUsage",
+		}},
+		StartTime: dme.Timestamp{Seconds: 1767225600},
+		EndTime:   dme.Timestamp{Seconds: 1767830400},
+		CreatedBy: "operuser",
+		Comment:   "resource expansion in progress",
+	}, { // edgeproto.AlertSilence
+		Key: edgeproto.AlertSilenceKey{
+			Name:         "admin-cloudlet-move",
+			Organization: edgeproto.OrganizationEdgeCloud,
+		},
+		Matchers: []edgeproto.AlertMatcher{{
+			Name:  "cloudlet",
+			Value: "San Jose Site|New York Site",
+			Regex: true,
+		}},
+		StartTime: dme.Timestamp{Seconds: 1767312000},
+		EndTime:   dme.Timestamp{Seconds: 1767315600},
+		CreatedBy: "admin",
+		Comment:   "moving cloudlet hardware",
+	}}
+}
+
 func TrustPolicyData() []edgeproto.TrustPolicy {
 	cloudletData := CloudletData()
 	return []edgeproto.TrustPolicy{{
@@ -487,6 +487,17 @@ func (r *Run) TrustPolicyApi(data *[]edgeproto.TrustPolicy, dataMap interface{},
 				}
 				*outp = append(*outp, out...)
 			}
+		case "showtrustpolicyhistory":
+			out, err := r.client.ShowTrustPolicyHistory(r.ctx, obj)
+			if err != nil {
+				r.logErr(fmt.Sprintf("TrustPolicyApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.TrustPolicyHistory)
+				if !ok {
+					panic(fmt.Sprintf("RunTrustPolicyApi expected dataOut type *[]edgeproto.TrustPolicyHistory, but was %T", dataOut))
+				}
+				*outp = append(*outp, out...)
+			}
 		}
 	}
 }
@@ -575,6 +586,25 @@ func (s *DummyServer) ShowTrustPolicy(in *edgeproto.TrustPolicy, server edgeprot
 	return err
 }
 
+func (s *DummyServer) ShowTrustPolicyHistory(in *edgeproto.TrustPolicy, server edgeproto.TrustPolicyApi_ShowTrustPolicyHistoryServer) error {
+	var err error
+	if true {
+		for ii := 0; ii < s.ShowDummyCount; ii++ {
+			server.Send(&edgeproto.TrustPolicyHistory{})
+		}
+		if ch, ok := s.MidstreamFailChs["ShowTrustPolicyHistory"]; ok {
+			// Wait until client receives the SendMsg, since they
+			// are buffered and dropped once we return err here.
+			select {
+			case <-ch:
+			case <-time.After(5 * time.Second):
+			}
+			return fmt.Errorf("midstream failure!")
+		}
+	}
+	return err
+}
+
 type ResultStream interface {
 	Recv() (*edgeproto.Result, error)
 }
@@ -677,9 +707,45 @@ func (s *CliClient) ShowTrustPolicy(ctx context.Context, in *edgeproto.TrustPoli
 	return output, err
 }
 
+type TrustPolicyHistoryStream interface {
+	Recv() (*edgeproto.TrustPolicyHistory, error)
+}
+
+func TrustPolicyHistoryReadStream(stream TrustPolicyHistoryStream) ([]edgeproto.TrustPolicyHistory, error) {
+	output := []edgeproto.TrustPolicyHistory{}
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return output, fmt.Errorf("read TrustPolicyHistory stream failed, %v", err)
+		}
+		output = append(output, *obj)
+	}
+	return output, nil
+}
+
+func (s *ApiClient) ShowTrustPolicyHistory(ctx context.Context, in *edgeproto.TrustPolicy) ([]edgeproto.TrustPolicyHistory, error) {
+	api := edgeproto.NewTrustPolicyApiClient(s.Conn)
+	stream, err := api.ShowTrustPolicyHistory(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return TrustPolicyHistoryReadStream(stream)
+}
+
+func (s *CliClient) ShowTrustPolicyHistory(ctx context.Context, in *edgeproto.TrustPolicy) ([]edgeproto.TrustPolicyHistory, error) {
+	output := []edgeproto.TrustPolicyHistory{}
+	args := append(s.BaseArgs, "controller", "ShowTrustPolicyHistory")
+	err := wrapper.RunEdgectlObjs(args, in, &output, s.RunOps...)
+	return output, err
+}
+
 type TrustPolicyApiClient interface {
 	CreateTrustPolicy(ctx context.Context, in *edgeproto.TrustPolicy) ([]edgeproto.Result, error)
 	DeleteTrustPolicy(ctx context.Context, in *edgeproto.TrustPolicy) ([]edgeproto.Result, error)
 	UpdateTrustPolicy(ctx context.Context, in *edgeproto.TrustPolicy) ([]edgeproto.Result, error)
 	ShowTrustPolicy(ctx context.Context, in *edgeproto.TrustPolicy) ([]edgeproto.TrustPolicy, error)
+	ShowTrustPolicyHistory(ctx context.Context, in *edgeproto.TrustPolicy) ([]edgeproto.TrustPolicyHistory, error)
 }
@@ -432,6 +432,17 @@ func (r *Run) ZoneApi(data *[]edgeproto.Zone, dataMap interface{}, dataOut inter
 				}
 				*outp = append(*outp, out...)
 			}
+		case "showzonehistory":
+			out, err := r.client.ShowZoneHistory(r.ctx, obj)
+			if err != nil {
+				r.logErr(fmt.Sprintf("ZoneApi[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.ZoneHistory)
+				if !ok {
+					panic(fmt.Sprintf("RunZoneApi expected dataOut type *[]edgeproto.ZoneHistory, but was %T", dataOut))
+				}
+				*outp = append(*outp, out...)
+			}
 		case "showzonegpus":
 			out, err := r.client.ShowZoneGPUs(r.ctx, obj)
 			if err != nil {
@@ -495,6 +506,25 @@ func (s *DummyServer) ShowZone(in *edgeproto.Zone, server edgeproto.ZoneApi_Show
 	return err
 }
 
+func (s *DummyServer) ShowZoneHistory(in *edgeproto.Zone, server edgeproto.ZoneApi_ShowZoneHistoryServer) error {
+	var err error
+	if true {
+		for ii := 0; ii < s.ShowDummyCount; ii++ {
+			server.Send(&edgeproto.ZoneHistory{})
+		}
+		if ch, ok := s.MidstreamFailChs["ShowZoneHistory"]; ok {
+			// Wait until client receives the SendMsg, since they
+			// are buffered and dropped once we return err here.
+			select {
+			case <-ch:
+			case <-time.After(5 * time.Second):
+			}
+			return fmt.Errorf("midstream failure!")
+		}
+	}
+	return err
+}
+
 func (s *DummyServer) ShowZoneGPUs(in *edgeproto.Zone, server edgeproto.ZoneApi_ShowZoneGPUsServer) error {
 	var err error
 	if true {
@@ -585,6 +615,41 @@ func (s *CliClient) ShowZone(ctx context.Context, in *edgeproto.Zone) ([]edgepro
 	return output, err
 }
 
+type ZoneHistoryStream interface {
+	Recv() (*edgeproto.ZoneHistory, error)
+}
+
+func ZoneHistoryReadStream(stream ZoneHistoryStream) ([]edgeproto.ZoneHistory, error) {
+	output := []edgeproto.ZoneHistory{}
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return output, fmt.Errorf("read ZoneHistory stream failed, %v", err)
+		}
+		output = append(output, *obj)
+	}
+	return output, nil
+}
+
+func (s *ApiClient) ShowZoneHistory(ctx context.Context, in *edgeproto.Zone) ([]edgeproto.ZoneHistory, error) {
+	api := edgeproto.NewZoneApiClient(s.Conn)
+	stream, err := api.ShowZoneHistory(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return ZoneHistoryReadStream(stream)
+}
+
+func (s *CliClient) ShowZoneHistory(ctx context.Context, in *edgeproto.Zone) ([]edgeproto.ZoneHistory, error) {
+	output := []edgeproto.ZoneHistory{}
+	args := append(s.BaseArgs, "controller", "ShowZoneHistory")
+	err := wrapper.RunEdgectlObjs(args, in, &output, s.RunOps...)
+	return output, err
+}
+
 type ZoneGPUsStream interface {
 	Recv() (*edgeproto.ZoneGPUs, error)
 }
@@ -625,5 +690,6 @@ type ZoneApiClient interface {
 	DeleteZone(ctx context.Context, in *edgeproto.Zone) (*edgeproto.Result, error)
 	UpdateZone(ctx context.Context, in *edgeproto.Zone) (*edgeproto.Result, error)
 	ShowZone(ctx context.Context, in *edgeproto.Zone) ([]edgeproto.Zone, error)
+	ShowZoneHistory(ctx context.Context, in *edgeproto.Zone) ([]edgeproto.ZoneHistory, error)
 	ShowZoneGPUs(ctx context.Context, in *edgeproto.Zone) ([]edgeproto.ZoneGPUs, error)
 }
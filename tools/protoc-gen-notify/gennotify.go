@@ -32,6 +32,7 @@ type GenNotify struct {
 	importLog         bool
 	importContext     bool
 	importOpentracing bool
+	importJson        bool
 }
 
 func (g *GenNotify) Name() string {
@@ -58,6 +59,9 @@ func (g *GenNotify) GenerateImports(file *generator.FileDescriptor) {
 	if g.importOpentracing {
 		g.PrintImport("opentracing", "github.com/opentracing/opentracing-go")
 	}
+	if g.importJson {
+		g.PrintImport("", "encoding/json")
+	}
 }
 
 func (g *GenNotify) Generate(file *generator.FileDescriptor) {
@@ -65,6 +69,7 @@ func (g *GenNotify) Generate(file *generator.FileDescriptor) {
 	g.importLog = false
 	g.importContext = false
 	g.importOpentracing = false
+	g.importJson = false
 
 	g.support.InitFile()
 	if !g.support.GenFile(*file.FileDescriptorProto.Name) {
@@ -127,6 +132,9 @@ func (g *GenNotify) generateMessage(file *generator.FileDescriptor, desc *genera
 	if args.PrintSendRecv {
 		g.importOpentracing = true
 	}
+	if args.Cache {
+		g.importJson = true
+	}
 }
 
 type tmplArgs struct {
@@ -177,6 +185,7 @@ type {{.Name}}Send struct {
 	handler Send{{.Name}}Handler
 	Keys map[{{.KeyType}}]{{.Name}}SendContext
 	keysToSend map[{{.KeyType}}]{{.Name}}SendContext
+	syncDeletes map[{{.KeyType}}]{{.Name}}SyncDelete
 {{- else}}
 	Data []*{{.NameType}}
 	dataToSend []*{{.NameType}}
@@ -196,6 +205,14 @@ type {{.Name}}SendContext struct {
 	forceDelete bool
 }
 
+{{- if .Cache}}
+
+type {{.Name}}SyncDelete struct {
+	obj *{{.NameType}}
+	modRev int64
+}
+{{- end}}
+
 {{- if .Cache}}
 func New{{.Name}}Send(handler Send{{.Name}}Handler) *{{.Name}}Send {
 {{- else}}
@@ -245,6 +262,9 @@ func (s *{{.Name}}Send) UpdateAll(ctx context.Context) {
 			return
 		}
 {{- end}}
+		if s.sendrecv.isSendSynced(s.MessageName, modRev) {
+			return
+		}
 		s.Keys[*obj.GetKey()] = {{.Name}}SendContext{
 			ctx: ctx,
 			modRev: modRev,
@@ -253,6 +273,69 @@ func (s *{{.Name}}Send) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *{{.Name}}Send) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {
+	obj := &{{.NameType}}{}
+	err := json.Unmarshal(prevVal, obj)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelNotify, "Failed to parse deleted {{.Name}}", "val", string(prevVal), "err", err)
+		return
+	}
+	s.Mux.Lock()
+	if s.syncDeletes == nil {
+		s.syncDeletes = make(map[{{.KeyType}}]{{.Name}}SyncDelete)
+	}
+	s.syncDeletes[*obj.GetKey()] = {{.Name}}SyncDelete{
+		obj: obj,
+		modRev: modRev,
+	}
+	s.Mux.Unlock()
+}
+
+func (s *{{.Name}}Send) SendSyncDeletes(ctx context.Context) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	deletes := make(map[{{.KeyType}}]{{.Name}}SyncDelete)
+	for key, del := range s.syncDeletes {
+		deletes[key] = del
+	}
+	s.Mux.Unlock()
+	var buf {{.NameType}}
+	var modRev int64
+	queued := false
+	for key, del := range deletes {
+		if s.handler.GetWithRev(&key, &buf, &modRev) {
+			// re-created since, so sent as an update if needed
+			s.Mux.Lock()
+			delete(s.syncDeletes, key)
+			s.Mux.Unlock()
+			continue
+		}
+{{- if .CustomUpdate}}
+		if !s.UpdateOk(ctx, del.obj) { // to be implemented by hand
+			continue
+		}
+{{- end}}
+		s.Mux.Lock()
+		delete(s.syncDeletes, key)
+		if _, found := s.Keys[key]; !found {
+			// force delete in case it is re-created but
+			// filtered before it is sent.
+			s.Keys[key] = {{.Name}}SendContext{
+				ctx: ctx,
+				modRev: del.modRev,
+				forceDelete: true,
+			}
+			queued = true
+		}
+		s.Mux.Unlock()
+	}
+	if queued {
+		s.sendrecv.wakeup()
+	}
+}
+
 func (s *{{.Name}}Send) Update(ctx context.Context, obj *{{.NameType}}, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -288,6 +371,10 @@ func (s *{{.Name}}Send) updateInternal(ctx context.Context, key *{{.KeyType}}, m
 {{- else}}
 func (s *{{.Name}}Send) UpdateAll(ctx context.Context) {}
 
+func (s *{{.Name}}Send) AddSyncDelete(ctx context.Context, prevVal []byte, modRev int64) {}
+
+func (s *{{.Name}}Send) SendSyncDeletes(ctx context.Context) {}
+
 func (s *{{.Name}}Send) Update(ctx context.Context, msg *{{.NameType}}) bool {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return false
@@ -317,6 +404,9 @@ func (s *{{.Name}}Send) SendForCloudlet(ctx context.Context, action edgeproto.No
 	})
 	for k, data := range keys {
 		if action == edgeproto.NoticeAction_UPDATE {
+			if s.sendrecv.isSendSynced(s.MessageName, data.ModRev) {
+				continue
+			}
 			s.Update(ctx, data.Obj, data.ModRev)
 		} else if action == edgeproto.NoticeAction_DELETE {
 			s.ForceDelete(ctx, &k, data.ModRev)